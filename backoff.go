@@ -0,0 +1,56 @@
+package work
+
+import (
+	"math"
+	"time"
+)
+
+// maxBackoffSeconds is where LinearBackoff and ExponentialBackoff saturate. It's the longest wait a time.Duration can
+// hold, and leaves room to add the current time to it for a retry's score, or to use it as a Redis expire.
+const maxBackoffSeconds = int64(math.MaxInt64 / time.Second)
+
+// ConstantBackoff returns a BackoffCalculator that always waits the given number of seconds between attempts.
+func ConstantBackoff(seconds int64) BackoffCalculator {
+	return func(job *Job) int64 {
+		return seconds
+	}
+}
+
+// LinearBackoff returns a BackoffCalculator that waits step times the number of fails so far: step after the first
+// failure, 2*step after the second, and so on. Retries are scheduled to the second, so the wait is rounded up to one.
+func LinearBackoff(step time.Duration) BackoffCalculator {
+	return func(job *Job) int64 {
+		if step <= 0 || job.Fails <= 0 {
+			return 0
+		}
+		if job.Fails > math.MaxInt64/int64(step) {
+			return maxBackoffSeconds
+		}
+		delay := step * time.Duration(job.Fails)
+		return int64((delay + time.Second - 1) / time.Second)
+	}
+}
+
+// ExponentialBackoff returns a BackoffCalculator that waits base seconds after the first failure and doubles the wait
+// after each failure after that, up to max seconds. A max of 0 means there's no cap besides maxBackoffSeconds.
+func ExponentialBackoff(base, max int64) BackoffCalculator {
+	return func(job *Job) int64 {
+		delay := base
+		for i := int64(1); i < job.Fails; i++ {
+			if max > 0 && delay >= max {
+				return max
+			}
+			if delay > maxBackoffSeconds/2 {
+				return maxBackoffSeconds
+			}
+			delay *= 2
+		}
+		if max > 0 && delay > max {
+			return max
+		}
+		if delay > maxBackoffSeconds {
+			return maxBackoffSeconds
+		}
+		return delay
+	}
+}
@@ -0,0 +1,47 @@
+package work
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantBackoff(t *testing.T) {
+	backoff := ConstantBackoff(30)
+	for _, fails := range []int64{1, 2, 10} {
+		assert.EqualValues(t, 30, backoff(&Job{Fails: fails}))
+	}
+}
+
+func TestLinearBackoff(t *testing.T) {
+	step := 10 * time.Second
+	backoff := LinearBackoff(step)
+	for fails := int64(1); fails <= 5; fails++ {
+		assert.EqualValues(t, int64(step*time.Duration(fails)/time.Second), backoff(&Job{Fails: fails}))
+	}
+
+	// Waits are rounded up to the second
+	subSecond := LinearBackoff(300 * time.Millisecond)
+	assert.EqualValues(t, 1, subSecond(&Job{Fails: 1}))
+	assert.EqualValues(t, 1, subSecond(&Job{Fails: 3}))
+	assert.EqualValues(t, 2, subSecond(&Job{Fails: 4}))
+
+	assert.EqualValues(t, maxBackoffSeconds, LinearBackoff(time.Hour)(&Job{Fails: math.MaxInt64}))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(5, 100)
+	assert.EqualValues(t, 5, backoff(&Job{Fails: 1}))
+	assert.EqualValues(t, 10, backoff(&Job{Fails: 2}))
+	assert.EqualValues(t, 20, backoff(&Job{Fails: 3}))
+	assert.EqualValues(t, 80, backoff(&Job{Fails: 5}))
+	assert.EqualValues(t, 100, backoff(&Job{Fails: 6}))
+	assert.EqualValues(t, 100, backoff(&Job{Fails: 1000}))
+
+	uncapped := ExponentialBackoff(1, 0)
+	assert.EqualValues(t, 1024, uncapped(&Job{Fails: 11}))
+	assert.EqualValues(t, maxBackoffSeconds, uncapped(&Job{Fails: 1000}))
+	assert.EqualValues(t, maxBackoffSeconds, ExponentialBackoff(math.MaxInt64, 0)(&Job{Fails: 1}))
+}
@@ -1,10 +1,13 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
@@ -17,10 +20,23 @@ var ErrNotDeleted = fmt.Errorf("nothing deleted")
 // no object was actually retried by those commmands.
 var ErrNotRetried = fmt.Errorf("nothing retried")
 
+// ErrJobNotFound is returned by functions that look up a single job to indicate that no job with the given ID exists.
+var ErrJobNotFound = fmt.Errorf("job not found")
+
+// ErrWorkerPoolActive is returned by RemoveWorkerPool for a pool that's still heartbeating.
+var ErrWorkerPoolActive = fmt.Errorf("worker pool is still active")
+
+// ErrNoThroughput is returned by EstimatedDrain for a queue with jobs waiting when none have finished recently, so
+// there's no rate to project from.
+var ErrNoThroughput = fmt.Errorf("no recent throughput")
+
 // Client implements all of the functionality of the web UI. It can be used to inspect the status of a running cluster and retry dead jobs.
+// A Client holds no mutable state of its own and every method checks out its own connection from the pool, so a single
+// Client is safe for concurrent use by multiple goroutines.
 type Client struct {
-	namespace string
-	pool      *redis.Pool
+	namespace   string
+	pool        *redis.Pool
+	readTimeout time.Duration
 }
 
 // NewClient creates a new Client with the specified redis namespace and connection pool.
@@ -31,6 +47,24 @@ func NewClient(namespace string, pool *redis.Pool) *Client {
 	}
 }
 
+// SetReadTimeout limits how long the client waits for Redis to reply to each command it sends, which are mostly the
+// reads behind the web UI. Past it, the call fails with a timeout error instead of holding a connection that an
+// enqueue sharing the pool might be waiting for. The default, 0, uses the pool's own timeouts. It isn't safe to call
+// while the client is in use.
+func (c *Client) SetReadTimeout(d time.Duration) {
+	c.readTimeout = d
+}
+
+func (c *Client) getConn() redis.Conn {
+	return getConnWithTimeout(c.pool, c.readTimeout)
+}
+
+// PoolStats returns the connection statistics of the Client's underlying redis pool, such as the number of active and idle connections.
+// It doesn't talk to Redis, so it's cheap enough to poll.
+func (c *Client) PoolStats() redis.PoolStats {
+	return c.pool.Stats()
+}
+
 // WorkerPoolHeartbeat represents the heartbeat from a worker pool. WorkerPool's write a heartbeat every 5 seconds so we know they're alive and includes config information.
 type WorkerPoolHeartbeat struct {
 	WorkerPoolID string   `json:"worker_pool_id"`
@@ -45,7 +79,7 @@ type WorkerPoolHeartbeat struct {
 
 // WorkerPoolHeartbeats queries Redis and returns all WorkerPoolHeartbeat's it finds (even for those worker pools which don't have a current heartbeat).
 func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 
 	workerPoolsKey := redisKeyWorkerPools(c.namespace)
@@ -117,6 +151,182 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	return heartbeats, nil
 }
 
+// PoolHeartbeat is the ID of a registered worker pool and when it last wrote a heartbeat.
+type PoolHeartbeat struct {
+	WorkerPoolID string `json:"worker_pool_id"`
+	HeartbeatAt  int64  `json:"heartbeat_at"`
+}
+
+// WorkerPoolHeartbeatTimes returns the ID and last heartbeat time of every registered worker pool. It only reads the
+// heartbeat time, so it's a cheaper liveness check than WorkerPoolHeartbeats. Pools that are registered but have no
+// heartbeat have a HeartbeatAt of 0; pools that haven't heartbeat in a while are the ones the reaper will clean up.
+func (c *Client) WorkerPoolHeartbeatTimes() ([]*PoolHeartbeat, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	workerPoolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(workerPoolIDs)
+
+	for _, wpid := range workerPoolIDs {
+		conn.Send("HGET", redisKeyHeartbeat(c.namespace, wpid), "heartbeat_at")
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError("worker_pool_heartbeat_times.flush", err)
+		return nil, err
+	}
+
+	heartbeats := make([]*PoolHeartbeat, 0, len(workerPoolIDs))
+	for _, wpid := range workerPoolIDs {
+		heartbeatAt, err := redis.Int64(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			logError("worker_pool_heartbeat_times.receive", err)
+			return nil, err
+		}
+
+		heartbeats = append(heartbeats, &PoolHeartbeat{WorkerPoolID: wpid, HeartbeatAt: heartbeatAt})
+	}
+
+	return heartbeats, nil
+}
+
+// throughputRetention is how far back Client.Throughput can look. Workers expire older counts.
+const throughputRetention = time.Hour
+
+// Throughput returns how many jobName jobs per second workers have finished, successfully or not, over the last
+// window. The window can be at most an hour.
+func (c *Client) Throughput(jobName string, window time.Duration) (float64, error) {
+	if window < time.Second || window > throughputRetention {
+		return 0, fmt.Errorf("throughput window must be between 1s and %s", throughputRetention)
+	}
+
+	seconds := int64(window / time.Second)
+	now := nowEpochSeconds()
+	from := now - seconds + 1
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	for minute := from / 60; minute <= now/60; minute++ {
+		conn.Send("HGETALL", redisKeyJobsThroughput(c.namespace, jobName, minute))
+	}
+	if err := conn.Flush(); err != nil {
+		logError("client.throughput.flush", err)
+		return 0, err
+	}
+
+	var count int64
+	for minute := from / 60; minute <= now/60; minute++ {
+		counts, err := redis.Int64Map(conn.Receive())
+		if err != nil {
+			logError("client.throughput.receive", err)
+			return 0, err
+		}
+		for second, n := range counts {
+			sec, err := strconv.ParseInt(second, 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			if sec >= from && sec <= now {
+				count += n
+			}
+		}
+	}
+
+	return float64(count) / float64(seconds), nil
+}
+
+// drainWindow is how much recent throughput EstimatedDrain projects from.
+const drainWindow = 5 * time.Minute
+
+// EstimatedDrain estimates how long it'll take to work off the jobName jobs waiting on its queue, at the rate workers
+// finished them over the last 5 minutes. It's a best-effort projection: it doesn't account for jobs enqueued in the
+// meantime, retries, or workers being added or removed. An empty queue drains in 0; a queue with jobs waiting but no
+// recent throughput returns ErrNoThroughput.
+func (c *Client) EstimatedDrain(jobName string) (time.Duration, error) {
+	conn := c.getConn()
+	count, err := redis.Int64(conn.Do("LLEN", redisKeyJobs(c.namespace, jobName)))
+	conn.Close()
+	if err != nil {
+		logError("client.estimated_drain.llen", err)
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	rate, err := c.Throughput(jobName, drainWindow)
+	if err != nil {
+		return 0, err
+	}
+	if rate == 0 {
+		return 0, ErrNoThroughput
+	}
+	return time.Duration(float64(count) / rate * float64(time.Second)), nil
+}
+
+// FailureCategories returns how many times jobs have failed in each category, as picked by the worker pools'
+// ErrorClassifiers. Failures of pools without a classifier are counted as "error".
+func (c *Client) FailureCategories() (map[string]int64, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	categories, err := redis.Int64Map(conn.Do("HGETALL", redisKeyFailureCategories(c.namespace)))
+	if err != nil {
+		logError("client.failure_categories.hgetall", err)
+		return nil, err
+	}
+	return categories, nil
+}
+
+// ResetStats zeroes the namespace's statistics: throughput, failure categories, and the record of recently completed
+// jobs. Jobs themselves, whether queued, scheduled, retrying, or dead, are left alone.
+func (c *Client) ResetStats() error {
+	conn := c.getConn()
+	defer conn.Close()
+
+	keys := []interface{}{redisKeyFailureCategories(c.namespace), redisKeyCompleted(c.namespace)}
+
+	// Throughput is kept per job per minute, so find all of those keys
+	pattern := escapeGlob(redisKeyJobsPrefix(c.namespace)) + "*:throughput:*"
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", pattern, "COUNT", 1000))
+		if err != nil {
+			logError("client.reset_stats.scan", err)
+			return err
+		}
+		if len(values) != 2 {
+			return fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return err
+		}
+		found, err := redis.Strings(values[1], nil)
+		if err != nil {
+			return err
+		}
+		for _, key := range found {
+			keys = append(keys, key)
+		}
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	if _, err := conn.Do("DEL", keys...); err != nil {
+		logError("client.reset_stats.del", err)
+		return err
+	}
+	return nil
+}
+
 // WorkerObservation represents the latest observation taken from a worker. The observation indicates whether the worker is busy processing a job, and if so, information about that job.
 type WorkerObservation struct {
 	WorkerID string `json:"worker_id"`
@@ -133,15 +343,17 @@ type WorkerObservation struct {
 
 // WorkerObservations returns all of the WorkerObservation's it finds for all worker pools' workers.
 func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
-
+	// Fetch heartbeats before checking out our own connection: holding one while WorkerPoolHeartbeats waits for
+	// another can exhaust a bounded pool when many callers do this at once.
 	hbs, err := c.WorkerPoolHeartbeats()
 	if err != nil {
 		logError("worker_observations.worker_pool_heartbeats", err)
 		return nil, err
 	}
 
+	conn := c.getConn()
+	defer conn.Close()
+
 	var workerIDs []string
 	for _, hb := range hbs {
 		workerIDs = append(workerIDs, hb.WorkerIDs...)
@@ -202,18 +414,276 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	return observations, nil
 }
 
+// RequeueInProgressByIDs puts the jobs with the given IDs that poolID was working on back on their queues, and
+// releases the locks they held. It's for recovering only some of a crashed pool's jobs: the rest stay in progress,
+// to be dealt with by hand or by the dead pool reaper. Jobs are looked for under every known job name and the
+// pool's last heartbeat. It returns how many jobs were requeued; IDs that weren't found are ignored.
+func (c *Client) RequeueInProgressByIDs(poolID string, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	jobNames, err := c.poolJobNames(conn, poolID)
+	if err != nil {
+		logError("client.requeue_in_progress_by_ids.job_names", err)
+		return 0, err
+	}
+
+	args := []interface{}{redisKeyPoolInProgress(c.namespace, poolID)} // KEYS[1]
+	for _, jobName := range jobNames {
+		args = append(args,
+			redisKeyJobsInProgress(c.namespace, poolID, jobName),
+			redisKeyJobs(c.namespace, jobName),
+			redisKeyJobsLock(c.namespace, jobName),
+			redisKeyJobsLockInfo(c.namespace, jobName),
+		)
+	}
+	keyCount := len(args)
+	args = append(args, poolID, redisKeyJobsPrefix(c.namespace)) // ARGV[1-2]
+	for _, id := range ids {
+		args = append(args, id) // ARGV[3...]
+	}
+
+	script := redis.NewScript(keyCount, redisLuaRequeueInProgressByIDs)
+	requeued, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError("client.requeue_in_progress_by_ids.script", err)
+		return 0, err
+	}
+	return requeued, nil
+}
+
+// RemoveWorkerPool unregisters a worker pool that went away without stopping, right away rather than once the dead
+// pool reaper notices, so it stops showing up in WorkerPoolHeartbeats. Like the reaper, it first puts the pool's in
+// progress jobs back on their queues and releases their locks; then it deletes the pool's heartbeat and its workers'
+// observations. It returns ErrWorkerPoolActive, and removes nothing, if the pool heartbeated within the last 10
+// seconds, and ErrNotDeleted if there's no such pool.
+func (c *Client) RemoveWorkerPool(poolID string) error {
+	return c.removeWorkerPool(poolID, false)
+}
+
+// ForceRemoveWorkerPool is RemoveWorkerPool for a pool that's still heartbeating, eg one whose workers are wedged. If
+// the pool is in fact still running, it registers itself again on its next heartbeat, and the jobs it was running can
+// be run twice, since they're requeued.
+func (c *Client) ForceRemoveWorkerPool(poolID string) error {
+	return c.removeWorkerPool(poolID, true)
+}
+
+func (c *Client) removeWorkerPool(poolID string, force bool) error {
+	conn := c.getConn()
+	defer conn.Close()
+
+	conn.Send("SISMEMBER", redisKeyWorkerPools(c.namespace), poolID)
+	conn.Send("HMGET", redisKeyHeartbeat(c.namespace, poolID), "heartbeat_at", "worker_ids")
+	if err := conn.Flush(); err != nil {
+		logError("client.remove_worker_pool.flush", err)
+		return err
+	}
+	registered, err := redis.Bool(conn.Receive())
+	if err != nil {
+		logError("client.remove_worker_pool.sismember", err)
+		return err
+	}
+	heartbeat, err := redis.Strings(conn.Receive())
+	if err != nil {
+		logError("client.remove_worker_pool.hmget", err)
+		return err
+	}
+	heartbeatAt, workerIDs := heartbeat[0], heartbeat[1]
+	if !registered && heartbeatAt == "" {
+		return ErrNotDeleted
+	}
+	if !force && heartbeatAt != "" {
+		at, err := strconv.ParseInt(heartbeatAt, 10, 64)
+		if err == nil && at+int64(deadTime/time.Second) > nowEpochSeconds() {
+			return ErrWorkerPoolActive
+		}
+	}
+
+	jobNames, err := c.poolJobNames(conn, poolID)
+	if err != nil {
+		logError("client.remove_worker_pool.job_names", err)
+		return err
+	}
+	if workerIDs != "" {
+		for _, workerID := range strings.Split(workerIDs, ",") {
+			conn.Send("DEL", redisKeyWorkerObservation(c.namespace, workerID))
+		}
+		if _, err := conn.Do(""); err != nil {
+			logError("client.remove_worker_pool.del_observations", err)
+			return err
+		}
+	}
+
+	if err := newDeadPoolReaper(c.namespace, c.pool, jobNames).reapPool(poolID, jobNames); err != nil {
+		logError("client.remove_worker_pool.reap", err)
+		return err
+	}
+	return nil
+}
+
+// InProgressJobs returns the jobs poolID has taken off their queues and not finished with yet, read straight from its
+// in progress lists. Unlike WorkerObservations, which workers update as they go, it can't lag behind or miss a job,
+// so it's the one to trust when recovering a pool's jobs or looking into stuck ones. Jobs are looked for under every
+// known job name and the pool's last heartbeat. Jobs that can't be decoded are skipped.
+func (c *Client) InProgressJobs(poolID string) ([]*Job, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	jobNames, err := c.poolJobNames(conn, poolID)
+	if err != nil {
+		logError("client.in_progress_jobs.job_names", err)
+		return nil, err
+	}
+
+	inProgKeys := []string{redisKeyPoolInProgress(c.namespace, poolID)}
+	for _, jobName := range jobNames {
+		inProgKeys = append(inProgKeys, redisKeyJobsInProgress(c.namespace, poolID, jobName))
+	}
+	for _, key := range inProgKeys {
+		conn.Send("LRANGE", key, 0, -1)
+	}
+	if err := conn.Flush(); err != nil {
+		logError("client.in_progress_jobs.flush", err)
+		return nil, err
+	}
+
+	var jobs []*Job
+	for _, key := range inProgKeys {
+		rawJSONs, err := redis.ByteSlices(conn.Receive())
+		if err != nil {
+			logError("client.in_progress_jobs.lrange", err)
+			return nil, err
+		}
+		for _, rawJSON := range rawJSONs {
+			job, err := newJob(rawJSON, nil, []byte(key))
+			if err != nil {
+				logError("client.in_progress_jobs.new_job", err)
+				continue
+			}
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, nil
+}
+
+// StuckJobs returns the in progress jobs of every worker pool that were fetched more than olderThan ago. A job whose
+// handler hangs keeps its pool's heartbeat going, so the dead pool reaper never recovers it; this is how to find one.
+// Jobs fetched by workers that don't record when they fetched them are left out.
+func (c *Client) StuckJobs(olderThan time.Duration) ([]*Job, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		logError("client.stuck_jobs.worker_pools", err)
+		return nil, err
+	}
+	sort.Strings(poolIDs)
+
+	cutoff := nowEpochSeconds() - int64(olderThan/time.Second)
+	var stuck []*Job
+	for _, poolID := range poolIDs {
+		jobs, err := c.InProgressJobs(poolID)
+		if err != nil {
+			return nil, err
+		}
+		if len(jobs) == 0 {
+			continue
+		}
+
+		args := []interface{}{redisKeyPoolClaimedAt(c.namespace, poolID)}
+		for _, job := range jobs {
+			args = append(args, job.ID)
+		}
+		claimedAts, err := redis.Values(conn.Do("HMGET", args...))
+		if err != nil {
+			logError("client.stuck_jobs.claimed_at", err)
+			return nil, err
+		}
+		for i, job := range jobs {
+			claimedAt, err := redis.Int64(claimedAts[i], nil)
+			if err == redis.ErrNil {
+				continue
+			} else if err != nil {
+				logError("client.stuck_jobs.claimed_at", err)
+				return nil, err
+			}
+			if claimedAt <= cutoff {
+				stuck = append(stuck, job)
+			}
+		}
+	}
+
+	return stuck, nil
+}
+
+// poolJobNames returns every job name poolID might have jobs in progress for: the known jobs, and those in the pool's
+// last heartbeat, which can include jobs that were never enqueued through an Enqueuer.
+func (c *Client) poolJobNames(conn redis.Conn, poolID string) ([]string, error) {
+	conn.Send("SMEMBERS", redisKeyKnownJobs(c.namespace))
+	conn.Send("HGET", redisKeyHeartbeat(c.namespace, poolID), "job_names")
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	knownJobNames, err := redis.Strings(conn.Receive())
+	if err != nil {
+		return nil, err
+	}
+	heartbeatJobNames, err := redis.String(conn.Receive())
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+	if heartbeatJobNames != "" {
+		knownJobNames = append(knownJobNames, strings.Split(heartbeatJobNames, ",")...)
+	}
+
+	var jobNames []string
+	seen := make(map[string]bool)
+	for _, jobName := range knownJobNames {
+		if !seen[jobName] {
+			seen[jobName] = true
+			jobNames = append(jobNames, jobName)
+		}
+	}
+	return jobNames, nil
+}
+
 // Queue represents a queue that holds jobs with the same name. It indicates their name, count, and latency (in seconds). Latency is a measurement of how long ago the next job to be processed was enqueued.
+// RetryCount is how many jobs with the name failed and are waiting in the retry set, and aren't in Count.
 type Queue struct {
 	JobName        string `json:"job_name"`
 	Count          int64  `json:"count"`
 	Latency        int64  `json:"latency"`
 	MaxConcurrency int64  `json:"max_concurrency"`
 	LockCount      int64  `json:"lock_count"`
+	RetryCount     int64  `json:"retry_count"`
+}
+
+// KnownJobNames returns, sorted, the name of every job that's been enqueued in the namespace or registered by a worker
+// pool, whether or not its queue has jobs in it now. It's the same set of names Queues reports on, without reading
+// anything else.
+func (c *Client) KnownJobNames() ([]string, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError("client.known_job_names.smembers", err)
+		return nil, err
+	}
+	sort.Strings(jobNames)
+	return jobNames, nil
 }
 
-// Queues returns the Queue's it finds.
+// Queues returns the Queue's it finds. Filling in RetryCount scans the whole retry set, so it gets slower as more jobs
+// are waiting to be retried.
 func (c *Client) Queues() ([]*Queue, error) {
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 
 	key := redisKeyKnownJobs(c.namespace)
@@ -290,27 +760,565 @@ func (c *Client) Queues() ([]*Queue, error) {
 			job, err := newJob(b, nil, nil)
 			if err != nil {
 				logError("client.queues.new_job", err)
+				continue
 			}
 			s.Latency = now - job.EnqueuedAt
 		}
 	}
 
+	retryCounts, err := c.retryCountsByName(conn)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range queues {
+		s.RetryCount = retryCounts[s.JobName]
+	}
+
 	return queues, nil
 }
 
-// RetryJob represents a job in the retry queue.
-type RetryJob struct {
-	RetryAt int64 `json:"retry_at"`
-	*Job
+// retryCountsByName counts the jobs in the retry set by name. There's no index by name, so it has to scan and decode
+// the whole retry set, which makes Queues O(retries) as well as O(queues).
+func (c *Client) retryCountsByName(conn redis.Conn) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", redisKeyRetry(c.namespace), cursor, "COUNT", 1000))
+		if err != nil {
+			logError("client.retry_counts_by_name.zscan", err)
+			return nil, err
+		}
+		if len(values) != 2 {
+			return nil, fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return nil, err
+		}
+		members, err := redis.ByteSlices(values[1], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// members alternates between the member and its score
+		for i := 0; i < len(members); i += 2 {
+			var job struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(members[i], &job); err != nil {
+				continue
+			}
+			counts[job.Name]++
+		}
+
+		if cursor == 0 {
+			return counts, nil
+		}
+	}
 }
 
-// ScheduledJob represents a job in the scheduled queue.
-type ScheduledJob struct {
-	RunAt int64 `json:"run_at"`
-	*Job
+// Backlog is how much work is waiting, in total and for each job name. See Client.Backlog.
+type Backlog struct {
+	Ready         int64         `json:"ready"`
+	ScheduledSoon int64         `json:"scheduled_soon"`
+	Retrying      int64         `json:"retrying"`
+	Jobs          []*JobBacklog `json:"jobs"`
 }
 
-// DeadJob represents a job in the dead queue.
+// JobBacklog is the part of a Backlog for one job name.
+type JobBacklog struct {
+	JobName       string `json:"job_name"`
+	Ready         int64  `json:"ready"`
+	ScheduledSoon int64  `json:"scheduled_soon"`
+	Retrying      int64  `json:"retrying"`
+}
+
+// backlogScheduledWindow is how soon a scheduled job has to be due to count towards the backlog.
+const backlogScheduledWindow = 5 * time.Minute
+
+// Backlog returns how many jobs are waiting to be run: those ready on their queues, those scheduled to run in the next
+// five minutes, and those waiting to be retried, both in total and for each job name, sorted by name. The queue
+// lengths and the scheduled jobs due soon are read in one pipeline; the retry set is scanned like in Queues, so it
+// gets slower as more jobs are waiting to be retried.
+func (c *Client) Backlog() (*Backlog, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError("client.backlog.known_jobs", err)
+		return nil, err
+	}
+
+	for _, jobName := range jobNames {
+		conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+	}
+	soon := nowEpochSeconds() + int64(backlogScheduledWindow/time.Second)
+	conn.Send("ZRANGEBYSCORE", redisKeyScheduled(c.namespace), "-inf", soon)
+	if err := conn.Flush(); err != nil {
+		logError("client.backlog.flush", err)
+		return nil, err
+	}
+
+	byName := make(map[string]*JobBacklog)
+	jobBacklog := func(jobName string) *JobBacklog {
+		jb := byName[jobName]
+		if jb == nil {
+			jb = &JobBacklog{JobName: jobName}
+			byName[jobName] = jb
+		}
+		return jb
+	}
+
+	backlog := &Backlog{}
+	for _, jobName := range jobNames {
+		count, err := redis.Int64(conn.Receive())
+		if err != nil {
+			logError("client.backlog.llen", err)
+			return nil, err
+		}
+		jobBacklog(jobName).Ready = count
+		backlog.Ready += count
+	}
+
+	scheduled, err := redis.ByteSlices(conn.Receive())
+	if err != nil {
+		logError("client.backlog.scheduled", err)
+		return nil, err
+	}
+	for _, rawJSON := range scheduled {
+		var job struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(rawJSON, &job); err != nil {
+			continue
+		}
+		jobBacklog(job.Name).ScheduledSoon++
+		backlog.ScheduledSoon++
+	}
+
+	retryCounts, err := c.retryCountsByName(conn)
+	if err != nil {
+		return nil, err
+	}
+	for jobName, count := range retryCounts {
+		jobBacklog(jobName).Retrying = count
+		backlog.Retrying += count
+	}
+
+	backlog.Jobs = make([]*JobBacklog, 0, len(byName))
+	for _, jb := range byName {
+		backlog.Jobs = append(backlog.Jobs, jb)
+	}
+	sort.Slice(backlog.Jobs, func(i, j int) bool { return backlog.Jobs[i].JobName < backlog.Jobs[j].JobName })
+
+	return backlog, nil
+}
+
+// OldestPendingAge returns how long the job that's been waiting longest to run has waited, wherever it's waiting: the
+// next job on any queue, counted from when it reached the queue, or a scheduled or retry job that's overdue, counted
+// from when it was due. Jobs in progress, or not yet due, aren't pending. It's 0 when nothing is pending. Queues that
+// are paused, or at their max concurrency, still count, since their jobs should be running. It reads the next job of
+// each queue and the first of each set, so it's cheap enough to alert on.
+func (c *Client) OldestPendingAge() (time.Duration, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError("client.oldest_pending_age.smembers", err)
+		return 0, err
+	}
+
+	for _, jobName := range jobNames {
+		conn.Send("LINDEX", redisKeyJobs(c.namespace, jobName), -1)
+	}
+	conn.Send("ZRANGE", redisKeyScheduled(c.namespace), 0, 0, "WITHSCORES")
+	conn.Send("ZRANGE", redisKeyRetry(c.namespace), 0, 0, "WITHSCORES")
+	if err := conn.Flush(); err != nil {
+		logError("client.oldest_pending_age.flush", err)
+		return 0, err
+	}
+
+	now := nowEpochSeconds()
+	var oldest int64
+	for range jobNames {
+		b, err := redis.Bytes(conn.Receive())
+		if err == redis.ErrNil {
+			continue
+		}
+		if err != nil {
+			logError("client.oldest_pending_age.lindex", err)
+			return 0, err
+		}
+		job, err := newJob(b, nil, nil)
+		if err != nil {
+			logError("client.oldest_pending_age.new_job", err)
+			continue
+		}
+		if age := now - job.EnqueuedAt; age > oldest {
+			oldest = age
+		}
+	}
+	for i := 0; i < 2; i++ {
+		first, err := redis.Int64Map(conn.Receive())
+		if err != nil {
+			logError("client.oldest_pending_age.zrange", err)
+			return 0, err
+		}
+		for _, dueAt := range first {
+			if age := now - dueAt; age > oldest {
+				oldest = age
+			}
+		}
+	}
+
+	return time.Duration(oldest) * time.Second, nil
+}
+
+// JobCounts is how many jobs of one name are in each state. See Client.JobCounts.
+type JobCounts struct {
+	JobName    string `json:"job_name"`
+	Ready      int64  `json:"ready"`
+	InProgress int64  `json:"in_progress"`
+	Scheduled  int64  `json:"scheduled"`
+	Retry      int64  `json:"retry"`
+	Dead       int64  `json:"dead"`
+}
+
+// JobCounts returns how many jobs named jobName are ready on its queue, in progress, scheduled, waiting to be retried,
+// and dead. The ready and in progress counts, and the dead count of jobs with JobOptions.SeparateDeadSet, are read in
+// one pipeline. The scheduled, retry, and dead sets are shared by every job name though, so counting them means
+// scanning each whole set for jobName's jobs: it gets slower as those sets grow, whichever jobs they hold.
+func (c *Client) JobCounts(jobName string) (*JobCounts, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+	conn.Send("GET", redisKeyJobsLock(c.namespace, jobName))
+	conn.Send("ZCARD", redisKeyJobsDead(c.namespace, jobName))
+	if err := conn.Flush(); err != nil {
+		logError("client.job_counts.flush", err)
+		return nil, err
+	}
+
+	counts := &JobCounts{JobName: jobName}
+	var err error
+	if counts.Ready, err = redis.Int64(conn.Receive()); err != nil {
+		logError("client.job_counts.llen", err)
+		return nil, err
+	}
+	if counts.InProgress, err = redis.Int64(conn.Receive()); err != nil && err != redis.ErrNil {
+		logError("client.job_counts.lock", err)
+		return nil, err
+	}
+	if counts.Dead, err = redis.Int64(conn.Receive()); err != nil {
+		logError("client.job_counts.separate_dead", err)
+		return nil, err
+	}
+
+	for _, zset := range []struct {
+		key   string
+		count *int64
+	}{
+		{redisKeyScheduled(c.namespace), &counts.Scheduled},
+		{redisKeyRetry(c.namespace), &counts.Retry},
+		{redisKeyDead(c.namespace), &counts.Dead},
+	} {
+		n, err := c.countJobsNamed(conn, zset.key, jobName)
+		if err != nil {
+			return nil, err
+		}
+		*zset.count += n
+	}
+
+	return counts, nil
+}
+
+// countJobsNamed counts the jobs named jobName in the zset at key. Redis only hands back jobs that look like they
+// could have the name, to keep the scan cheap, and those are decoded to be sure.
+func (c *Client) countJobsNamed(conn redis.Conn, key, jobName string) (int64, error) {
+	var count int64
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", key, cursor, "MATCH", `*"name":"`+jobName+`"*`, "COUNT", 1000))
+		if err != nil {
+			logError("client.count_jobs_named.zscan", err)
+			return 0, err
+		}
+		if len(values) != 2 {
+			return 0, fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return 0, err
+		}
+		members, err := redis.ByteSlices(values[1], nil)
+		if err != nil {
+			return 0, err
+		}
+
+		// members alternates between the member and its score
+		for i := 0; i < len(members); i += 2 {
+			var job struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(members[i], &job); err == nil && job.Name == jobName {
+				count++
+			}
+		}
+
+		if cursor == 0 {
+			return count, nil
+		}
+	}
+}
+
+// PeekQueue returns the job that workers will pick up next from jobName's queue, without taking it off the queue. It
+// returns nil if the queue is empty.
+func (c *Client) PeekQueue(jobName string) (*Job, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	// Workers pop from the right, so the last element is next
+	rawJSON, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(c.namespace, jobName), -1))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		logError("client.peek_queue.lindex", err)
+		return nil, err
+	}
+
+	return newJob(rawJSON, nil, nil)
+}
+
+// QueueCount is the number of jobs waiting on a job name's queue.
+type QueueCount struct {
+	JobName string `json:"job_name"`
+	Count   int64  `json:"count"`
+}
+
+// Summary is a snapshot of everything a dashboard needs at a glance: the depth of each queue, how many worker pools
+// are registered, how many workers are busy, and how many jobs are waiting to be retried, dead, or scheduled.
+type Summary struct {
+	Queues         []*QueueCount `json:"queues"`
+	WorkerPools    int64         `json:"worker_pools"`
+	BusyWorkers    int64         `json:"busy_workers"`
+	RetryCount     int64         `json:"retry_count"`
+	DeadCount      int64         `json:"dead_count"`
+	ScheduledCount int64         `json:"scheduled_count"`
+}
+
+// Summary returns a Summary in a single round trip to Redis, so the numbers are consistent with each other. Queues
+// are sorted by job name.
+func (c *Client) Summary() (*Summary, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	script := redis.NewScript(5, redisLuaSummaryCmd)
+	vals, err := redis.Values(script.Do(conn,
+		redisKeyKnownJobs(c.namespace),
+		redisKeyWorkerPools(c.namespace),
+		redisKeyRetry(c.namespace),
+		redisKeyDead(c.namespace),
+		redisKeyScheduled(c.namespace),
+		redisKeyJobsPrefix(c.namespace),
+		redisKeyHeartbeat(c.namespace, ""),
+		redisKeyWorkerObservation(c.namespace, ""),
+	))
+	if err != nil {
+		logError("client.summary.script", err)
+		return nil, err
+	}
+
+	var rawQueues []interface{}
+	summary := &Summary{}
+	if _, err := redis.Scan(vals, &rawQueues, &summary.WorkerPools, &summary.BusyWorkers, &summary.RetryCount, &summary.DeadCount, &summary.ScheduledCount); err != nil {
+		logError("client.summary.scan", err)
+		return nil, err
+	}
+
+	summary.Queues = make([]*QueueCount, 0, len(rawQueues)/2)
+	for len(rawQueues) > 0 {
+		q := &QueueCount{}
+		if rawQueues, err = redis.Scan(rawQueues, &q.JobName, &q.Count); err != nil {
+			logError("client.summary.scan_queue", err)
+			return nil, err
+		}
+		summary.Queues = append(summary.Queues, q)
+	}
+
+	return summary, nil
+}
+
+// CompletedJob represents a job that finished successfully. They're only recorded by worker pools that set WorkerPoolOptions.KeepCompletedJobs.
+type CompletedJob struct {
+	Name       string                 `json:"name"`
+	ID         string                 `json:"id"`
+	DurationMS int64                  `json:"duration_ms"`
+	FinishedAt int64                  `json:"finished_at"`
+	Args       map[string]interface{} `json:"args,omitempty"`
+}
+
+// RecentCompleted returns up to limit of the most recently completed jobs, newest first.
+func (c *Client) RecentCompleted(limit int) ([]*CompletedJob, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	values, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyCompleted(c.namespace), 0, limit-1))
+	if err != nil {
+		logError("client.recent_completed.lrange", err)
+		return nil, err
+	}
+
+	jobs := make([]*CompletedJob, 0, len(values))
+	for _, v := range values {
+		var job CompletedJob
+		if err := json.Unmarshal(v, &job); err != nil {
+			logError("client.recent_completed.unmarshal", err)
+			return nil, err
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// RerunCompletedJob enqueues a new job with the name and args of the recently completed job with ID jobID, eg to
+// process it again after a bug fix, and returns it. It returns ErrJobNotFound if jobID isn't among the recorded
+// completed jobs, eg because newer ones have pushed it out. Jobs recorded before completed jobs kept their args are
+// rerun without any.
+func (c *Client) RerunCompletedJob(jobID string) (*Job, error) {
+	conn := c.getConn()
+	values, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyCompleted(c.namespace), 0, -1))
+	conn.Close()
+	if err != nil {
+		logError("client.rerun_completed_job.lrange", err)
+		return nil, err
+	}
+
+	for _, v := range values {
+		var completed CompletedJob
+		if err := json.Unmarshal(v, &completed); err != nil {
+			logError("client.rerun_completed_job.unmarshal", err)
+			continue
+		}
+		if completed.ID == jobID {
+			return NewEnqueuer(c.namespace, c.pool).Enqueue(completed.Name, completed.Args)
+		}
+	}
+
+	return nil, ErrJobNotFound
+}
+
+// CompletionEvent is published by worker pools with WorkerPoolOptions.PublishCompletions every time one of their jobs
+// finishes. See Client.SubscribeCompletions.
+type CompletionEvent struct {
+	JobName string           `json:"job_name"`
+	JobID   string           `json:"job_id"`
+	Result  CompletionResult `json:"result"`
+	Err     string           `json:"err,omitempty"`   // what the job failed with, if it did
+	Fails   int64            `json:"fails,omitempty"` // how many times the job has failed, including this time
+	At      int64            `json:"at"`              // when the job finished, in epoch seconds
+}
+
+// CompletionResult is how a job finished, in a CompletionEvent.
+type CompletionResult string
+
+const (
+	// CompletionSucceeded is for jobs whose handler returned nil.
+	CompletionSucceeded CompletionResult = "succeeded"
+	// CompletionFailed is for jobs that failed. They may still be retried: compare Fails to the job's MaxFails.
+	CompletionFailed CompletionResult = "failed"
+)
+
+// SubscribeCompletions subscribes to the CompletionEvents published by worker pools with
+// WorkerPoolOptions.PublishCompletions, and returns a channel that receives them until ctx is done. Once it returns,
+// every job that finishes is on the channel, but like any Redis pub/sub, events published while the subscription is
+// down aren't replayed. The channel is closed when ctx is done or the subscription's connection fails; the connection
+// is held out of the pool until then.
+func (c *Client) SubscribeCompletions(ctx context.Context) (<-chan CompletionEvent, error) {
+	// Not getConn: a subscription spends most of its time waiting, so a read timeout would end it
+	psc := redis.PubSubConn{Conn: c.pool.Get()}
+	if err := psc.Subscribe(redisKeyCompletions(c.namespace)); err != nil {
+		psc.Close()
+		logError("client.subscribe_completions.subscribe", err)
+		return nil, err
+	}
+	// Wait for the subscription to be confirmed, so no event published after we return is missed
+	for subscribed := false; !subscribed; {
+		switch v := psc.Receive().(type) {
+		case redis.Subscription:
+			subscribed = true
+		case error:
+			psc.Close()
+			logError("client.subscribe_completions.receive", v)
+			return nil, v
+		}
+	}
+
+	events := make(chan CompletionEvent)
+	unsubscribed := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() {
+		defer close(unsubscribed)
+		psc.Unsubscribe()
+	})
+	go func() {
+		defer close(events)
+		defer func() {
+			// If the unsubscribe has already started it's writing to the connection, so let it finish first
+			if !stop() {
+				<-unsubscribed
+			}
+			psc.Close()
+		}()
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				var event CompletionEvent
+				if err := json.Unmarshal(v.Data, &event); err != nil {
+					logError("client.subscribe_completions.unmarshal", err)
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			case redis.Subscription:
+				if v.Count == 0 {
+					return
+				}
+			case error:
+				if ctx.Err() == nil {
+					logError("client.subscribe_completions.receive", v)
+				}
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// RetryJob represents a job in the retry queue.
+type RetryJob struct {
+	RetryAt int64 `json:"retry_at"`
+	*Job
+}
+
+// ScheduledJob represents a job in the scheduled queue.
+type ScheduledJob struct {
+	RunAt int64 `json:"run_at"`
+	*Job
+}
+
+// DeadJob represents a job in the dead queue.
 type DeadJob struct {
 	DiedAt int64 `json:"died_at"`
 	*Job
@@ -334,6 +1342,46 @@ func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
 	return jobs, count, nil
 }
 
+// ScheduledJobsBetween returns the scheduled jobs due to run from from to to, inclusive and to the second, ordered by
+// when they run. A zero from or to leaves that end of the range open. Unlike ScheduledJobs it isn't paginated, so
+// wide ranges of a large scheduled set can return a lot of jobs.
+func (c *Client) ScheduledJobsBetween(from, to time.Time) ([]*ScheduledJob, error) {
+	var min, max interface{} = "-inf", "+inf"
+	if !from.IsZero() {
+		min = from.Unix()
+	}
+	if !to.IsZero() {
+		max = to.Unix()
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", redisKeyScheduled(c.namespace), min, max, "WITHSCORES"))
+	if err != nil {
+		logError("client.scheduled_jobs_between.values", err)
+		return nil, err
+	}
+
+	var jobsWithScores []jobScore
+	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
+		logError("client.scheduled_jobs_between.scan_slice", err)
+		return nil, err
+	}
+
+	jobs := make([]*ScheduledJob, 0, len(jobsWithScores))
+	for _, jws := range jobsWithScores {
+		job, err := newJob(jws.JobBytes, nil, nil)
+		if err != nil {
+			logError("client.scheduled_jobs_between.new_job", err)
+			return nil, err
+		}
+		jobs = append(jobs, &ScheduledJob{RunAt: jws.Score, Job: job})
+	}
+
+	return jobs, nil
+}
+
 // RetryJobs returns a list of RetryJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of retry jobs is also returned.
 func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
 	key := redisKeyRetry(c.namespace)
@@ -354,7 +1402,16 @@ func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
 
 // DeadJobs returns a list of DeadJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of dead jobs is also returned.
 func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
-	key := redisKeyDead(c.namespace)
+	return c.deadJobsPage(redisKeyDead(c.namespace), page)
+}
+
+// DeadJobsForJob returns a page of the dead jobs of jobName, if it's registered with JobOptions.SeparateDeadSet, along
+// with how many there are in total. They don't show up in DeadJobs.
+func (c *Client) DeadJobsForJob(jobName string, page uint) ([]*DeadJob, int64, error) {
+	return c.deadJobsPage(redisKeyJobsDead(c.namespace, jobName), page)
+}
+
+func (c *Client) deadJobsPage(key string, page uint) ([]*DeadJob, int64, error) {
 	jobsWithScores, count, err := c.getZsetPage(key, page)
 	if err != nil {
 		logError("client.dead_jobs.get_zset_page", err)
@@ -370,9 +1427,131 @@ func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
 	return jobs, count, nil
 }
 
+// DeadJobsByTag returns all dead jobs tagged with tag, oldest first. Unlike DeadJobs it isn't paginated, since it has
+// to scan the whole dead queue anyway.
+func (c *Client) DeadJobsByTag(tag string) ([]*DeadJob, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	var jobs []*DeadJob
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", redisKeyDead(c.namespace), cursor, "MATCH", `*"tags":*`, "COUNT", 100))
+		if err != nil {
+			logError("client.dead_jobs_by_tag.zscan", err)
+			return nil, err
+		}
+		if len(values) != 2 {
+			return nil, fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return nil, err
+		}
+		members, err := redis.Values(values[1], nil)
+		if err != nil {
+			return nil, err
+		}
+		var jobsWithScores []jobScore
+		if err := redis.ScanSlice(members, &jobsWithScores); err != nil {
+			logError("client.dead_jobs_by_tag.scan_slice", err)
+			return nil, err
+		}
+
+		for _, jws := range jobsWithScores {
+			job, err := newJob(jws.JobBytes, nil, nil)
+			if err != nil {
+				logError("client.dead_jobs_by_tag.new_job", err)
+				continue
+			}
+			if job.hasTag(tag) {
+				jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: job})
+			}
+		}
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].DiedAt < jobs[j].DiedAt })
+
+	return jobs, nil
+}
+
+// ScanSet calls fn with each job in the "scheduled", "retry", or "dead" set, stopping at and returning the first error
+// fn returns. Rather than loading the set at once, it pages through it with ZSCAN, so only a page of jobs (around 100)
+// is in memory at a time, which makes it suitable for sets with millions of jobs. Jobs come in no particular order.
+// If the set changes while it's being scanned, jobs added or removed meanwhile may or may not be seen, and jobs can
+// occasionally be seen twice; jobs that are in the set throughout are always seen. Jobs that can't be decoded are
+// skipped.
+func (c *Client) ScanSet(set string, fn func(*Job) error) error {
+	var key string
+	switch set {
+	case "scheduled":
+		key = redisKeyScheduled(c.namespace)
+	case "retry":
+		key = redisKeyRetry(c.namespace)
+	case "dead":
+		key = redisKeyDead(c.namespace)
+	default:
+		return fmt.Errorf("unknown job set %q", set)
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", key, cursor, "COUNT", 100))
+		if err != nil {
+			logError("client.scan_set.zscan", err)
+			return err
+		}
+		if len(values) != 2 {
+			return fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return err
+		}
+		members, err := redis.ByteSlices(values[1], nil)
+		if err != nil {
+			return err
+		}
+
+		// members alternates between the member and its score
+		for i := 0; i < len(members); i += 2 {
+			job, err := newJob(members[i], nil, nil)
+			if err != nil {
+				logError("client.scan_set.new_job", err)
+				continue
+			}
+			if err := fn(job); err != nil {
+				return err
+			}
+		}
+
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
 // DeleteDeadJob deletes a dead job from Redis.
 func (c *Client) DeleteDeadJob(diedAt int64, jobID string) error {
-	ok, _, err := c.deleteZsetJob(redisKeyDead(c.namespace), diedAt, jobID)
+	return c.deleteDeadJob(redisKeyDead(c.namespace), diedAt, jobID)
+}
+
+// DeleteDeadJobForJob is DeleteDeadJob for the dead jobs of jobName, if it's registered with JobOptions.SeparateDeadSet.
+func (c *Client) DeleteDeadJobForJob(jobName string, diedAt int64, jobID string) error {
+	return c.deleteDeadJob(redisKeyJobsDead(c.namespace, jobName), diedAt, jobID)
+}
+
+func (c *Client) deleteDeadJob(deadKey string, diedAt int64, jobID string) error {
+	ok, _, err := c.deleteZsetJob(deadKey, diedAt, jobID)
 	if err != nil {
 		return err
 	}
@@ -384,23 +1563,25 @@ func (c *Client) DeleteDeadJob(diedAt int64, jobID string) error {
 
 // RetryDeadJob retries a dead job. The job will be re-queued on the normal work queue for eventual processing by a worker.
 func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
-	// Get queues for job names
-	queues, err := c.Queues()
+	return c.retryDeadJob(redisKeyDead(c.namespace), diedAt, jobID)
+}
+
+// RetryDeadJobForJob is RetryDeadJob for the dead jobs of jobName, if it's registered with JobOptions.SeparateDeadSet.
+func (c *Client) RetryDeadJobForJob(jobName string, diedAt int64, jobID string) error {
+	return c.retryDeadJob(redisKeyJobsDead(c.namespace, jobName), diedAt, jobID)
+}
+
+func (c *Client) retryDeadJob(deadKey string, diedAt int64, jobID string) error {
+	// The known jobs are the queues a job can be requeued on. Queues reads them too, but also scans the retry set
+	jobNames, err := c.KnownJobNames()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
-	// Extract job names
-	var jobNames []string
-	for _, q := range queues {
-		jobNames = append(jobNames, q.JobName)
-	}
-
 	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueSingleDeadCmd)
 
 	args := make([]interface{}, 0, len(jobNames)+1+3)
-	args = append(args, redisKeyDead(c.namespace)) // KEY[1]
+	args = append(args, deadKey) // KEY[1]
 	for _, jobName := range jobNames {
 		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
 	}
@@ -409,7 +1590,7 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 	args = append(args, diedAt)
 	args = append(args, jobID)
 
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 
 	cnt, err := redis.Int64(script.Do(conn, args...))
@@ -425,33 +1606,47 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 	return nil
 }
 
-// RetryAllDeadJobs requeues all dead jobs. In other words, it puts them all back on the normal work queue for workers to pull from and process.
-func (c *Client) RetryAllDeadJobs() error {
-	// Get queues for job names
-	queues, err := c.Queues()
+// RescheduleDeadJob moves a dead job to the scheduled queue to run at runAt, rather than putting it straight back on
+// its work queue like RetryDeadJob does. This is useful when whatever the job depends on won't be back until later.
+// As with RetryDeadJob, the job's fails are cleared.
+func (c *Client) RescheduleDeadJob(diedAt int64, jobID string, runAt time.Time) error {
+	script := redis.NewScript(2, redisLuaRescheduleSingleDeadCmd)
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	cnt, err := redis.Int64(script.Do(conn, redisKeyDead(c.namespace), redisKeyScheduled(c.namespace), diedAt, jobID, runAt.Unix()))
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		logError("client.reschedule_dead_job.do", err)
 		return err
 	}
 
-	// Extract job names
-	var jobNames []string
-	for _, q := range queues {
-		jobNames = append(jobNames, q.JobName)
+	if cnt == 0 {
+		return ErrNotRetried
 	}
 
-	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueAllDeadCmd)
+	return nil
+}
 
-	args := make([]interface{}, 0, len(jobNames)+1+3)
-	args = append(args, redisKeyDead(c.namespace)) // KEY[1]
-	for _, jobName := range jobNames {
-		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+// RetryAllDeadJobs requeues all dead jobs. In other words, it puts them all back on the normal work queue for workers to pull from and process.
+func (c *Client) RetryAllDeadJobs() error {
+	return c.retryAllDeadJobs(redisKeyDead(c.namespace))
+}
+
+// RetryAllDeadJobsForJob is RetryAllDeadJobs for the dead jobs of jobName, if it's registered with
+// JobOptions.SeparateDeadSet.
+func (c *Client) RetryAllDeadJobsForJob(jobName string) error {
+	return c.retryAllDeadJobs(redisKeyJobsDead(c.namespace, jobName))
+}
+
+func (c *Client) retryAllDeadJobs(deadKey string) error {
+	script, args, err := c.requeueDeadJobsScript(deadKey, 1000)
+	if err != nil {
+		logError("client.retry_all_dead_jobs.queues", err)
+		return err
 	}
-	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
-	args = append(args, nowEpochSeconds())
-	args = append(args, 1000)
 
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 
 	// Cap iterations for safety (which could reprocess 1k*1k jobs).
@@ -471,11 +1666,69 @@ func (c *Client) RetryAllDeadJobs() error {
 	return nil
 }
 
+// RetryDeadJobs requeues at most limit dead jobs, oldest first, and returns the number of jobs actually requeued.
+// Unlike RetryAllDeadJobs, this lets a recovering system be fed its dead jobs a batch at a time.
+func (c *Client) RetryDeadJobs(limit int64) (int64, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	script, args, err := c.requeueDeadJobsScript(redisKeyDead(c.namespace), limit)
+	if err != nil {
+		logError("client.retry_dead_jobs.queues", err)
+		return 0, err
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	cnt, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError("client.retry_dead_jobs.do", err)
+		return 0, err
+	}
+
+	return cnt, nil
+}
+
+// requeueDeadJobsScript builds the script and args to requeue up to limit jobs from deadKey onto the queues of known
+// jobs.
+func (c *Client) requeueDeadJobsScript(deadKey string, limit int64) (*redis.Script, []interface{}, error) {
+	// The known jobs are the queues a job can be requeued on. Queues reads them too, but also scans the retry set
+	jobNames, err := c.KnownJobNames()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueAllDeadCmd)
+
+	args := make([]interface{}, 0, len(jobNames)+1+3)
+	args = append(args, deadKey) // KEY[1]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+	args = append(args, nowEpochSeconds())
+	args = append(args, limit)
+
+	return script, args, nil
+}
+
 // DeleteAllDeadJobs deletes all dead jobs.
 func (c *Client) DeleteAllDeadJobs() error {
-	conn := c.pool.Get()
+	return c.deleteAllDeadJobs(redisKeyDead(c.namespace))
+}
+
+// DeleteAllDeadJobsForJob is DeleteAllDeadJobs for the dead jobs of jobName, if it's registered with
+// JobOptions.SeparateDeadSet.
+func (c *Client) DeleteAllDeadJobsForJob(jobName string) error {
+	return c.deleteAllDeadJobs(redisKeyJobsDead(c.namespace, jobName))
+}
+
+func (c *Client) deleteAllDeadJobs(deadKey string) error {
+	conn := c.getConn()
 	defer conn.Close()
-	_, err := conn.Do("DEL", redisKeyDead(c.namespace))
+	_, err := conn.Do("DEL", deadKey)
 	if err != nil {
 		logError("client.delete_all_dead_jobs", err)
 		return err
@@ -500,12 +1753,16 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 		}
 
 		if job.Unique {
-			uniqueKey, err := redisKeyUniqueJob(c.namespace, job.Name, job.Args)
-			if err != nil {
-				logError("client.delete_scheduled_job.redis_key_unique_job", err)
-				return err
+			// Jobs enqueued before UniqueKey was stored on them didn't have one
+			uniqueKey := job.UniqueKey
+			if uniqueKey == "" {
+				uniqueKey, err = redisKeyUniqueJob(c.namespace, job.Name, job.Args)
+				if err != nil {
+					logError("client.delete_scheduled_job.redis_key_unique_job", err)
+					return err
+				}
 			}
-			conn := c.pool.Get()
+			conn := c.getConn()
 			defer conn.Close()
 
 			_, err = conn.Do("DEL", uniqueKey)
@@ -522,6 +1779,95 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	return nil
 }
 
+// DeleteScheduledJobs deletes every job in the namespace's scheduled queue, and returns how many there were. Jobs
+// waiting on their queues, retrying, or dead are left alone. Unique jobs can be enqueued again straight away.
+func (c *Client) DeleteScheduledJobs() (int64, error) {
+	conn := c.getConn()
+	defer conn.Close()
+
+	n, err := redis.Int64(redis.NewScript(1, redisLuaDeleteAllScheduledCmd).Do(conn, redisKeyScheduled(c.namespace)))
+	if err != nil {
+		logError("client.delete_scheduled_jobs", err)
+		return 0, err
+	}
+	return n, nil
+}
+
+// UpdateScheduledJobArgs replaces the args of a job in the scheduled queue, eg to correct an address that changed since
+// it was scheduled, keeping its ID and when it runs. The job is swapped in one step, so the requeuer either moves the
+// old job onto its queue first, in which case ErrJobNotFound is returned, or the new one. A unique job is still
+// deduplicated by the args it was enqueued with.
+func (c *Client) UpdateScheduledJobArgs(runAt int64, jobID string, args map[string]interface{}) error {
+	key := redisKeyScheduled(c.namespace)
+	conn := c.getConn()
+	defer conn.Close()
+
+	rawJobs, err := redis.ByteSlices(conn.Do("ZRANGEBYSCORE", key, runAt, runAt))
+	if err != nil {
+		logError("client.update_scheduled_job_args.zrangebyscore", err)
+		return err
+	}
+	for _, rawJSON := range rawJobs {
+		job, err := newJob(rawJSON, nil, nil)
+		if err != nil || job.ID != jobID {
+			continue
+		}
+
+		job.Args = args
+		updated, err := job.serialize()
+		if err != nil {
+			return err
+		}
+		replaced, err := redis.Int64(redis.NewScript(1, redisLuaReplaceZsetJobCmd).Do(conn, key, rawJSON, updated, runAt))
+		if err != nil {
+			logError("client.update_scheduled_job_args.replace", err)
+			return err
+		}
+		if replaced == 0 {
+			return ErrJobNotFound
+		}
+		return nil
+	}
+	return ErrJobNotFound
+}
+
+// RetryJobNow requeues a job in the retry queue on its normal work queue right away, rather than waiting for its backoff
+// to run out. The job keeps its fail count, so it still counts towards its MaxFails.
+func (c *Client) RetryJobNow(retryAt int64, jobID string) error {
+	// The known jobs are the queues a job can be requeued on. Queues reads them too, but also scans the retry set
+	jobNames, err := c.KnownJobNames()
+	if err != nil {
+		return err
+	}
+
+	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueSingleRetryCmd)
+
+	args := make([]interface{}, 0, len(jobNames)+1+4)
+	args = append(args, redisKeyRetry(c.namespace)) // KEY[1]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+	args = append(args, nowEpochSeconds())
+	args = append(args, retryAt)
+	args = append(args, jobID)
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	cnt, err := redis.Int64(script.Do(conn, args...))
+	if err != nil {
+		logError("client.retry_job_now.do", err)
+		return err
+	}
+
+	if cnt == 0 {
+		return ErrNotRetried
+	}
+
+	return nil
+}
+
 // DeleteRetryJob deletes a job in the retry queue.
 func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
 	ok, _, err := c.deleteZsetJob(redisKeyRetry(c.namespace), retryAt, jobID)
@@ -534,6 +1880,181 @@ func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
 	return nil
 }
 
+// MigrateNamespace copies jobs from the client's namespace to the dst namespace, eg while moving to a new one. sets
+// names what to copy: "scheduled", "retry", "dead", or "jobs:<job name>" for a job's queue. With no sets, the
+// scheduled, retry, and dead sets are copied. Scores, such as when a job died, are kept, and queued jobs keep their
+// order and are added after any already queued in dst. The source is left as is. It returns how many jobs were copied.
+func (c *Client) MigrateNamespace(dst string, sets ...string) (int64, error) {
+	if redisNamespacePrefix(dst) == redisNamespacePrefix(c.namespace) {
+		return 0, fmt.Errorf("work: can't migrate namespace %q onto itself", dst)
+	}
+	if len(sets) == 0 {
+		sets = []string{"scheduled", "retry", "dead"}
+	}
+
+	type migration struct {
+		srcKey, dstKey string
+		jobName        string // set for queues, which are lists
+	}
+	migrations := make([]migration, 0, len(sets))
+	for _, set := range sets {
+		switch {
+		case set == "scheduled":
+			migrations = append(migrations, migration{srcKey: redisKeyScheduled(c.namespace), dstKey: redisKeyScheduled(dst)})
+		case set == "retry":
+			migrations = append(migrations, migration{srcKey: redisKeyRetry(c.namespace), dstKey: redisKeyRetry(dst)})
+		case set == "dead":
+			migrations = append(migrations, migration{srcKey: redisKeyDead(c.namespace), dstKey: redisKeyDead(dst)})
+		case strings.HasPrefix(set, "jobs:") && len(set) > len("jobs:"):
+			jobName := strings.TrimPrefix(set, "jobs:")
+			migrations = append(migrations, migration{srcKey: redisKeyJobs(c.namespace, jobName), dstKey: redisKeyJobs(dst, jobName), jobName: jobName})
+		default:
+			return 0, fmt.Errorf("unknown job set %q", set)
+		}
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	for _, m := range migrations {
+		if m.jobName != "" {
+			conn.Send("LRANGE", m.srcKey, 0, -1)
+		} else {
+			conn.Send("ZRANGE", m.srcKey, 0, -1, "WITHSCORES")
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		logError("client.migrate_namespace.flush", err)
+		return 0, err
+	}
+
+	contents := make([][]interface{}, len(migrations))
+	for i := range migrations {
+		values, err := redis.Values(conn.Receive())
+		if err != nil {
+			logError("client.migrate_namespace.receive", err)
+			return 0, err
+		}
+		contents[i] = values
+	}
+
+	// Write everything in one transaction so dst never has only some of the sets
+	var copied int64
+	conn.Send("MULTI")
+	for i, m := range migrations {
+		values := contents[i]
+		if len(values) == 0 {
+			continue
+		}
+		if m.jobName != "" {
+			// LRANGE returns the list head first, so pushing onto the tail in that order keeps it
+			conn.Send("RPUSH", append([]interface{}{m.dstKey}, values...)...)
+			conn.Send("SADD", redisKeyKnownJobs(dst), m.jobName)
+			copied += int64(len(values))
+			continue
+		}
+		// ZRANGE WITHSCORES alternates member and score, and ZADD wants score then member
+		args := make([]interface{}, 0, len(values)+1)
+		args = append(args, m.dstKey)
+		for j := 0; j+1 < len(values); j += 2 {
+			args = append(args, values[j+1], values[j])
+		}
+		conn.Send("ZADD", args...)
+		copied += int64(len(values) / 2)
+	}
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError("client.migrate_namespace.exec", err)
+		return 0, err
+	}
+
+	return copied, nil
+}
+
+// escapeGlob escapes the characters that are special in a Redis MATCH pattern, so that s only matches itself.
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// RawJob returns the JSON payload exactly as it is stored in Redis for the job with the given ID. set is one of
+// "scheduled", "retry", or "dead". The payload isn't decoded, so this works for jobs that fail to deserialize.
+func (c *Client) RawJob(set string, jobID string) (string, error) {
+	var key string
+	switch set {
+	case "scheduled":
+		key = redisKeyScheduled(c.namespace)
+	case "retry":
+		key = redisKeyRetry(c.namespace)
+	case "dead":
+		key = redisKeyDead(c.namespace)
+	default:
+		return "", fmt.Errorf("unknown job set %q", set)
+	}
+
+	conn := c.getConn()
+	defer conn.Close()
+
+	// Match on the raw bytes rather than decoding each member, since the whole point is to find payloads that don't decode.
+	// The needle can also be in a job's args, so members that do decode have to have the ID themselves; a member that
+	// doesn't decode is only returned if no member that does has the ID.
+	needle := `"id":"` + jobID + `"`
+	pattern := "*" + escapeGlob(needle) + "*"
+	var undecodable string
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", key, cursor, "MATCH", pattern, "COUNT", 100))
+		if err != nil {
+			logError("client.raw_job.zscan", err)
+			return "", err
+		}
+		if len(values) != 2 {
+			return "", fmt.Errorf("need 2 elements back from redis command")
+		}
+
+		cursor, err = redis.Int64(values[0], nil)
+		if err != nil {
+			return "", err
+		}
+		members, err := redis.Strings(values[1], nil)
+		if err != nil {
+			return "", err
+		}
+
+		// members alternates between the member and its score
+		for i := 0; i < len(members); i += 2 {
+			if !strings.Contains(members[i], needle) {
+				continue
+			}
+			var job struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal([]byte(members[i]), &job); err != nil {
+				if undecodable == "" {
+					undecodable = members[i]
+				}
+				continue
+			}
+			if job.ID == jobID {
+				return members[i], nil
+			}
+		}
+
+		if cursor == 0 {
+			if undecodable != "" {
+				return undecodable, nil
+			}
+			return "", ErrJobNotFound
+		}
+	}
+}
+
 // deleteZsetJob deletes the job in the specified zset (dead, retry, or scheduled queue). zsetKey is like "work:dead" or "work:scheduled". The function deletes all jobs with the given jobID with the specified zscore (there should only be one, but in theory there could be bad data). It will return if at least one job is deleted and if
 func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool, []byte, error) {
 	script := redis.NewScript(1, redisLuaDeleteSingleCmd)
@@ -543,9 +2064,13 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 	args = append(args, zscore)  // ARGV[1]
 	args = append(args, jobID)   // ARGV[2]
 
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 	values, err := redis.Values(script.Do(conn, args...))
+	if err != nil {
+		logError("client.delete_zset_job.do", err)
+		return false, nil, err
+	}
 	if len(values) != 2 {
 		return false, nil, fmt.Errorf("need 2 elements back from redis command")
 	}
@@ -567,7 +2092,7 @@ type jobScore struct {
 }
 
 func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
-	conn := c.pool.Get()
+	conn := c.getConn()
 	defer conn.Close()
 
 	if page == 0 {
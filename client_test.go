@@ -2,11 +2,15 @@ package work
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/rafaeljusto/redigomock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +68,65 @@ func TestClientWorkerPoolHeartbeats(t *testing.T) {
 	assert.Equal(t, 0, len(hbs))
 }
 
+func TestClientWorkerPoolHeartbeatTimes(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+
+	wp2 := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp2.Job("foo", func(job *Job) error { return nil })
+	wp2.Start()
+
+	time.Sleep(20 * time.Millisecond)
+
+	client := NewClient(ns, pool)
+
+	hbs, err := client.WorkerPoolHeartbeatTimes()
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(hbs)) {
+		ids := []string{hbs[0].WorkerPoolID, hbs[1].WorkerPoolID}
+		assert.ElementsMatch(t, []string{wp.workerPoolID, wp2.workerPoolID}, ids)
+		for _, hb := range hbs {
+			assert.True(t, hb.HeartbeatAt > nowEpochSeconds()-10)
+			assert.True(t, hb.HeartbeatAt <= nowEpochSeconds())
+		}
+	}
+
+	wp.Stop()
+	wp2.Stop()
+
+	hbs, err = client.WorkerPoolHeartbeatTimes()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(hbs))
+}
+
+func TestClientPoolStats(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	idle := client.PoolStats()
+	assert.Equal(t, 0, idle.ActiveCount-idle.IdleCount)
+
+	// Hold a connection as if a query were mid-flight
+	conn := pool.Get()
+	_, err := conn.Do("PING")
+	assert.NoError(t, err)
+
+	busy := client.PoolStats()
+	assert.Equal(t, 1, busy.ActiveCount-busy.IdleCount)
+
+	conn.Close()
+	done := client.PoolStats()
+	assert.Equal(t, 0, done.ActiveCount-done.IdleCount)
+	assert.True(t, done.IdleCount >= 1)
+}
+
 func TestClientWorkerObservations(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -135,6 +198,366 @@ func TestClientWorkerObservations(t *testing.T) {
 	assert.Equal(t, 0, len(observations))
 }
 
+func TestClientInProgressJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueued, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		close(started)
+		<-release
+		return nil
+	})
+	wp.Start()
+	<-started
+
+	client := NewClient(ns, pool)
+	jobs, err := client.InProgressJobs(wp.workerPoolID)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, enqueued.ID, jobs[0].ID)
+		assert.Equal(t, "wat", jobs[0].Name)
+		assert.EqualValues(t, 1, jobs[0].ArgInt64("a"))
+	}
+
+	close(release)
+	wp.Drain()
+	wp.Stop()
+
+	jobs, err = client.InProgressJobs(wp.workerPoolID)
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestClientKnownJobNames(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	names, err := client.KnownJobNames()
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("foo", 10, nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	names, err = client.KnownJobNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "wat"}, names)
+}
+
+func TestClientKnownJobNamesRegisteredOnStart(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	client := NewClient(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.JobWithOptions("bob", JobOptions{RetryQueue: "bob_retries"}, func(job *Job) error { return nil })
+	assert.NoError(t, wp.RegisterJobs())
+	names, err := client.KnownJobNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob", "bob_retries", "wat"}, names)
+
+	// Start registers them before it returns, with nothing enqueued
+	cleanKeyspace(ns, pool)
+	wp.Start()
+	defer wp.Stop()
+	names, err = client.KnownJobNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob", "bob_retries", "wat"}, names)
+
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	assert.Len(t, queues, 3)
+}
+
+func TestClientRemoveWorkerPool(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// Pool "stale" crashed an hour ago with a job in progress; pool "fresh" is still heartbeating
+	now := nowEpochSeconds()
+	conn.Send("SADD", redisKeyWorkerPools(ns), "stale", "fresh")
+	conn.Send("HMSET", redisKeyHeartbeat(ns, "stale"), "heartbeat_at", now-3600, "job_names", "wat", "worker_ids", "w1,w2")
+	conn.Send("HMSET", redisKeyHeartbeat(ns, "fresh"), "heartbeat_at", now, "job_names", "wat", "worker_ids", "w3")
+	conn.Send("HMSET", redisKeyWorkerObservation(ns, "w1"), "job_name", "wat", "job_id", "1")
+	conn.Send("HMSET", redisKeyWorkerObservation(ns, "w3"), "job_name", "wat", "job_id", "2")
+	conn.Send("LPUSH", redisKeyJobsInProgress(ns, "stale", "wat"), `{"name":"wat","id":"1"}`)
+	conn.Send("INCR", redisKeyJobsLock(ns, "wat"))
+	conn.Send("HINCRBY", redisKeyJobsLockInfo(ns, "wat"), "stale", 1)
+	_, err := conn.Do("")
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.RemoveWorkerPool("stale"))
+
+	heartbeats, err := client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Len(t, heartbeats, 1)
+	assert.Equal(t, "fresh", heartbeats[0].WorkerPoolID)
+	exists, err := redis.Bool(conn.Do("EXISTS", redisKeyHeartbeat(ns, "stale")))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = redis.Bool(conn.Do("EXISTS", redisKeyWorkerObservation(ns, "w1")))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// Its job is requeued and its lock released
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "stale", "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+
+	assert.Equal(t, ErrNotDeleted, client.RemoveWorkerPool("stale"))
+
+	// An active pool is only removed when forced
+	assert.Equal(t, ErrWorkerPoolActive, client.RemoveWorkerPool("fresh"))
+	exists, err = redis.Bool(conn.Do("EXISTS", redisKeyHeartbeat(ns, "fresh")))
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, client.ForceRemoveWorkerPool("fresh"))
+	heartbeats, err = client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Empty(t, heartbeats)
+	exists, err = redis.Bool(conn.Do("EXISTS", redisKeyWorkerObservation(ns, "w3")))
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestClientSubscribeCompletions(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := NewClient(ns, pool)
+	events, err := client.SubscribeCompletions(ctx)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{PublishCompletions: true})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.JobWithOptions("ohno", JobOptions{MaxFails: 3}, func(job *Job) error { return fmt.Errorf("ohno") })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	ok, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Start()
+	defer wp.Stop()
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "wat", event.JobName)
+		assert.Equal(t, ok.ID, event.JobID)
+		assert.Equal(t, CompletionSucceeded, event.Result)
+		assert.Empty(t, event.Err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no completion event")
+	}
+
+	failed, err := enqueuer.Enqueue("ohno", nil)
+	assert.NoError(t, err)
+	select {
+	case event := <-events:
+		assert.Equal(t, failed.ID, event.JobID)
+		assert.Equal(t, CompletionFailed, event.Result)
+		assert.Equal(t, "ohno", event.Err)
+		assert.EqualValues(t, 1, event.Fails)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no completion event")
+	}
+
+	// Cancelling the context closes the channel
+	cancel()
+	select {
+	case _, open := <-events:
+		assert.False(t, open)
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel wasn't closed")
+	}
+}
+
+func TestClientOldestPendingAge(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient(ns, pool)
+	age, err := client.OldestPendingAge()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, age)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	setNowEpochSecondsMock(now - 100)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(now)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	// Not due yet, so not pending however long ago it was scheduled
+	_, err = enqueuer.EnqueueIn("foo", 60, nil)
+	assert.NoError(t, err)
+
+	age, err = client.OldestPendingAge()
+	assert.NoError(t, err)
+	assert.Equal(t, 100*time.Second, age)
+
+	// An overdue scheduled job is older
+	setNowEpochSecondsMock(now - 1000)
+	_, err = enqueuer.EnqueueIn("bar", 700, nil)
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(now)
+
+	age, err = client.OldestPendingAge()
+	assert.NoError(t, err)
+	assert.Equal(t, 300*time.Second, age)
+
+	// And so is an overdue retry
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("ZADD", redisKeyRetry(ns), now-500, `{"name":"wat","id":"1","t":1}`)
+	assert.NoError(t, err)
+
+	age, err = client.OldestPendingAge()
+	assert.NoError(t, err)
+	assert.Equal(t, 500*time.Second, age)
+}
+
+func TestClientReadTimeout(t *testing.T) {
+	client := NewClient("work", newUnresponsiveTestPool(t))
+	client.SetReadTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	_, err := client.Queues()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+	_, err = client.JobCounts("wat")
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClientStuckJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	hung, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		close(started)
+		<-release
+		return nil
+	})
+	wp.Start()
+	<-started
+
+	client := NewClient(ns, pool)
+	jobs, err := client.StuckJobs(time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	setNowEpochSecondsMock(now + 61)
+	jobs, err = client.StuckJobs(time.Minute)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, hung.ID, jobs[0].ID)
+	}
+
+	close(release)
+	wp.Drain()
+	wp.Stop()
+
+	jobs, err = client.StuckJobs(time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.False(t, hexists(pool, redisKeyPoolClaimedAt(ns, wp.workerPoolID), hung.ID))
+}
+
+func TestClientRequeueInProgressByIDs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	poolID := "aaa"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// The crashed pool was working on three wat jobs, one in the pool's consolidated list
+	inProgress := map[string]string{
+		"wat1": redisKeyJobsInProgress(ns, poolID, "wat"),
+		"wat2": redisKeyJobsInProgress(ns, poolID, "wat"),
+		"wat3": redisKeyPoolInProgress(ns, poolID),
+	}
+	for _, id := range []string{"wat1", "wat2", "wat3"} {
+		job := &Job{Name: "wat", ID: id, EnqueuedAt: 12345}
+		rawJSON, err := job.serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("LPUSH", inProgress[id], rawJSON)
+		assert.NoError(t, err)
+	}
+	_, err := conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+	_, err = conn.Do("SET", redisKeyJobsLock(ns, "wat"), 3)
+	assert.NoError(t, err)
+	_, err = conn.Do("HSET", redisKeyJobsLockInfo(ns, "wat"), poolID, 3)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	requeued, err := client.RequeueInProgressByIDs(poolID, []string{"wat2", "wat3", "nope"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, requeued)
+
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsInProgress(ns, poolID, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyPoolInProgress(ns, poolID)))
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyJobsLockInfo(ns, "wat"), poolID))
+
+	left := jobOnQueue(pool, redisKeyJobsInProgress(ns, poolID, "wat"))
+	assert.Equal(t, "wat1", left.ID)
+
+	// Already requeued, so there's nothing left to do
+	requeued, err = client.RequeueInProgressByIDs(poolID, []string{"wat2"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, requeued)
+}
+
 func TestClientQueues(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -180,6 +603,17 @@ func TestClientQueues(t *testing.T) {
 	_, err = enqueuer.Enqueue("wat", nil)
 	assert.NoError(t, err)
 
+	// Two zaz jobs failed and are waiting to be retried
+	conn := pool.Get()
+	defer conn.Close()
+	for i := 0; i < 2; i++ {
+		failed := &Job{Name: "zaz", ID: makeIdentifier(), EnqueuedAt: 1425263409, Fails: 1, LastErr: "ohno"}
+		rawJSON, err := failed.serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyRetry(ns), 1425263809, rawJSON)
+		assert.NoError(t, err)
+	}
+
 	setNowEpochSecondsMock(1425263709)
 	client := NewClient(ns, pool)
 	queues, err := client.Queues()
@@ -203,32 +637,303 @@ func TestClientQueues(t *testing.T) {
 	assert.EqualValues(t, 0, queues[2].Latency)
 	assert.EqualValues(t, 0, queues[2].MaxConcurrency)
 	assert.EqualValues(t, 1, queues[2].LockCount)
+	assert.EqualValues(t, 2, queues[2].RetryCount)
+	assert.EqualValues(t, 0, queues[0].RetryCount)
 }
 
-func TestClientScheduledJobs(t *testing.T) {
+func TestClientSummary(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
 	cleanKeyspace(ns, pool)
 
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	wp.Job("bad", func(job *Job) error { return fmt.Errorf("ohno") })
+	wp.Start()
+	defer wp.Stop()
+
+	wp2 := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp2.Start()
+	defer wp2.Stop()
+
 	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	<-started
+	defer close(release)
 
-	setNowEpochSecondsMock(1425263409)
-	defer resetNowEpochSecondsMock()
-	_, err := enqueuer.EnqueueIn("wat", 0, Q{"a": 1, "b": 2})
-	_, err = enqueuer.EnqueueIn("zaz", 4, Q{"a": 3, "b": 4})
-	_, err = enqueuer.EnqueueIn("foo", 2, Q{"a": 3, "b": 4})
+	_, err = enqueuer.Enqueue("bad", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("foo", 300, nil)
+	assert.NoError(t, err)
+	insertDeadJob(ns, pool, "foo", nowEpochSeconds(), nowEpochSeconds())
 
 	client := NewClient(ns, pool)
-	jobs, count, err := client.ScheduledJobs(1)
+	assert.Eventually(t, func() bool {
+		_, count, err := client.RetryJobs(0)
+		return err == nil && count == 1
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(10 * time.Millisecond) // let the observer record the busy worker
+
+	summary, err := client.Summary()
 	assert.NoError(t, err)
-	assert.Equal(t, 3, len(jobs))
-	assert.EqualValues(t, 3, count)
-	if len(jobs) == 3 {
-		assert.EqualValues(t, 1425263409, jobs[0].RunAt)
-		assert.EqualValues(t, 1425263411, jobs[1].RunAt)
-		assert.EqualValues(t, 1425263413, jobs[2].RunAt)
 
-		assert.Equal(t, "wat", jobs[0].Name)
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, len(queues), len(summary.Queues)) {
+		for i, q := range queues {
+			assert.Equal(t, q.JobName, summary.Queues[i].JobName)
+			assert.Equal(t, q.Count, summary.Queues[i].Count)
+		}
+	}
+	assert.Equal(t, []*QueueCount{{"bad", 0}, {"foo", 2}, {"wat", 0}}, summary.Queues)
+
+	heartbeats, err := client.WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.EqualValues(t, len(heartbeats), summary.WorkerPools)
+	assert.EqualValues(t, 2, summary.WorkerPools)
+
+	observations, err := client.WorkerObservations()
+	assert.NoError(t, err)
+	busy := 0
+	for _, ob := range observations {
+		if ob.IsBusy {
+			busy++
+		}
+	}
+	assert.EqualValues(t, busy, summary.BusyWorkers)
+	assert.True(t, summary.BusyWorkers >= 1)
+
+	_, retryCount, err := client.RetryJobs(0)
+	assert.NoError(t, err)
+	assert.Equal(t, retryCount, summary.RetryCount)
+	_, deadCount, err := client.DeadJobs(0)
+	assert.NoError(t, err)
+	assert.Equal(t, deadCount, summary.DeadCount)
+	_, scheduledCount, err := client.ScheduledJobs(0)
+	assert.NoError(t, err)
+	assert.Equal(t, scheduledCount, summary.ScheduledCount)
+	assert.EqualValues(t, 1, summary.ScheduledCount)
+}
+
+func TestClientPeekQueue(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	job, err := client.PeekQueue("wat")
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	first, err := enqueuer.Enqueue("wat", Q{"n": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"n": 2})
+	assert.NoError(t, err)
+
+	job, err = client.PeekQueue("wat")
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, first.ID, job.ID)
+		assert.EqualValues(t, 1, job.ArgInt64("n"))
+	}
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// It's the job a worker would take
+	w := newWorker(ns, "1", pool, tstCtxType, nil, map[string]*jobType{"wat": {Name: "wat", JobOptions: JobOptions{Priority: 1}}}, nil)
+	fetched, err := w.fetchJob()
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetched) {
+		assert.Equal(t, first.ID, fetched.ID)
+	}
+}
+
+func TestClientBacklog(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"wat", "wat", "foo"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	_, err := enqueuer.EnqueueIn("wat", 60, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("bar", 120, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("foo", 3600, nil) // not due soon
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	for _, name := range []string{"foo", "foo", "bar"} {
+		rawJSON, err := (&Job{Name: name, ID: makeIdentifier(), Fails: 1}).serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyRetry(ns), nowEpochSeconds()+30, rawJSON)
+		assert.NoError(t, err)
+	}
+
+	client := NewClient(ns, pool)
+	backlog, err := client.Backlog()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, backlog.Ready)
+	assert.EqualValues(t, 2, backlog.ScheduledSoon)
+	assert.EqualValues(t, 3, backlog.Retrying)
+	assert.Equal(t, []*JobBacklog{
+		{JobName: "bar", ScheduledSoon: 1, Retrying: 1},
+		{JobName: "foo", Ready: 1, Retrying: 2},
+		{JobName: "wat", Ready: 2, ScheduledSoon: 1},
+	}, backlog.Jobs)
+}
+
+func TestClientJobCounts(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"wat", "wat", "wat", "foo"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+
+	// One of the wats is in progress. The others can run once it's released, while the pool stops.
+	started := make(chan struct{})
+	var startedOnce sync.Once
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		startedOnce.Do(func() { close(started) })
+		<-release
+		return nil
+	})
+	wp.Start()
+	<-started
+	defer wp.Stop()
+	defer close(release)
+
+	for _, name := range []string{"wat", "foo", "wat_2"} {
+		_, err := enqueuer.EnqueueIn(name, 60, nil)
+		assert.NoError(t, err)
+	}
+	conn := pool.Get()
+	defer conn.Close()
+	for _, name := range []string{"wat", "wat", "foo"} {
+		rawJSON, err := (&Job{Name: name, ID: makeIdentifier(), Fails: 1}).serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyRetry(ns), nowEpochSeconds()+30, rawJSON)
+		assert.NoError(t, err)
+	}
+	insertDeadJob(ns, pool, "wat", 1, 2)
+	insertDeadJob(ns, pool, "foo", 1, 2)
+
+	client := NewClient(ns, pool)
+	counts, err := client.JobCounts("wat")
+	assert.NoError(t, err)
+	assert.Equal(t, &JobCounts{JobName: "wat", Ready: 2, InProgress: 1, Scheduled: 1, Retry: 2, Dead: 1}, counts)
+
+	counts, err = client.JobCounts("nope")
+	assert.NoError(t, err)
+	assert.Equal(t, &JobCounts{JobName: "nope"}, counts)
+}
+
+func TestClientQueuesMalformedJob(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("LPUSH", redisKeyJobs(ns, "wat"), "{not json")
+	assert.NoError(t, err)
+	_, err = conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 1, queues[0].Count)
+		assert.EqualValues(t, 0, queues[0].Latency)
+	}
+}
+
+func TestClientConcurrentUse(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.EnqueueIn("wat", 60, Q{"i": i})
+		assert.NoError(t, err)
+		insertDeadJob(ns, pool, "wat", 12345, 12347+int64(i))
+	}
+
+	client := NewClient(ns, pool)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Queues()
+			assert.NoError(t, err)
+			_, _, err = client.ScheduledJobs(1)
+			assert.NoError(t, err)
+			_, _, err = client.DeadJobs(1)
+			assert.NoError(t, err)
+			_, err = client.WorkerPoolHeartbeats()
+			assert.NoError(t, err)
+			_, err = client.WorkerObservations()
+			assert.NoError(t, err)
+			err = client.DeleteRetryJob(1, "nope")
+			assert.Equal(t, ErrNotDeleted, err)
+		}()
+	}
+	wg.Wait()
+
+	_, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, count)
+}
+
+func TestClientScheduledJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+	_, err := enqueuer.EnqueueIn("wat", 0, Q{"a": 1, "b": 2})
+	_, err = enqueuer.EnqueueIn("zaz", 4, Q{"a": 3, "b": 4})
+	_, err = enqueuer.EnqueueIn("foo", 2, Q{"a": 3, "b": 4})
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(jobs))
+	assert.EqualValues(t, 3, count)
+	if len(jobs) == 3 {
+		assert.EqualValues(t, 1425263409, jobs[0].RunAt)
+		assert.EqualValues(t, 1425263411, jobs[1].RunAt)
+		assert.EqualValues(t, 1425263413, jobs[2].RunAt)
+
+		assert.Equal(t, "wat", jobs[0].Name)
 		assert.Equal(t, "foo", jobs[1].Name)
 		assert.Equal(t, "zaz", jobs[2].Name)
 
@@ -253,6 +958,44 @@ func TestClientScheduledJobs(t *testing.T) {
 	}
 }
 
+func TestClientScheduledJobsBetween(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := time.Unix(1425263400, 0)
+	SetNowFunc(func() time.Time { return now })
+	defer SetNowFunc(nil)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, secs := range []int64{300, 10, 3600, 60, 7200} {
+		_, err := enqueuer.EnqueueIn("wat", secs, Q{"secs": secs})
+		assert.NoError(t, err)
+	}
+
+	client := NewClient(ns, pool)
+	jobs, err := client.ScheduledJobsBetween(now.Add(time.Minute), now.Add(time.Hour))
+	assert.NoError(t, err)
+	var secs []int64
+	for _, job := range jobs {
+		assert.Equal(t, job.RunAt-now.Unix(), job.ArgInt64("secs"))
+		secs = append(secs, job.ArgInt64("secs"))
+	}
+	assert.Equal(t, []int64{60, 300, 3600}, secs)
+
+	// Open ended
+	jobs, err = client.ScheduledJobsBetween(now.Add(time.Hour+time.Second), time.Time{})
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.EqualValues(t, 7200, jobs[0].ArgInt64("secs"))
+	}
+	jobs, err = client.ScheduledJobsBetween(time.Time{}, now.Add(time.Minute-time.Second))
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.EqualValues(t, 10, jobs[0].ArgInt64("secs"))
+	}
+}
+
 func TestClientRetryJobs(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -292,59 +1035,347 @@ func TestClientRetryJobs(t *testing.T) {
 	}
 }
 
-func TestClientDeadJobs(t *testing.T) {
+func TestClientDeadJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", Q{"a": 1, "b": 2})
+	assert.Nil(t, err)
+
+	setNowEpochSecondsMock(1425263429)
+
+	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{Priority: 1, MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobs))
+	assert.EqualValues(t, 1, count)
+
+	deadJob := jobs[0]
+	if len(jobs) == 1 {
+		assert.EqualValues(t, 1425263429, jobs[0].FailedAt)
+		assert.Equal(t, "wat", jobs[0].Name)
+		assert.EqualValues(t, 1425263409, jobs[0].EnqueuedAt)
+		assert.EqualValues(t, interface{}(1), jobs[0].Args["a"])
+		assert.EqualValues(t, 1, jobs[0].Fails)
+		assert.EqualValues(t, 1425263429, jobs[0].Job.FailedAt)
+		assert.Equal(t, "ohno", jobs[0].LastErr)
+	}
+
+	// Test pagination a bit
+	jobs, count, err = client.DeadJobs(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobs))
+	assert.EqualValues(t, 1, count)
+
+	// Delete it!
+	err = client.DeleteDeadJob(deadJob.DiedAt, deadJob.ID)
+	assert.NoError(t, err)
+
+	jobs, count, err = client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobs))
+	assert.EqualValues(t, 0, count)
+}
+
+func TestClientDeadJobsByTag(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	gold1, err := enqueuer.EnqueueTagged("wat", []string{"tier:gold", "region:us"}, Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueTagged("wat", []string{"tier:silver", "region:us"}, Q{"a": 2})
+	assert.NoError(t, err)
+	gold2, err := enqueuer.EnqueueTagged("bob", []string{"tier:gold"}, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("bob", nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error { return fmt.Errorf("ohno") })
+	wp.JobWithOptions("bob", JobOptions{MaxFails: 1}, func(job *Job) error { return fmt.Errorf("ohno") })
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyDead(ns)))
+
+	client := NewClient(ns, pool)
+	jobs, err := client.DeadJobsByTag("tier:gold")
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(jobs)) {
+		ids := []string{jobs[0].ID, jobs[1].ID}
+		assert.ElementsMatch(t, []string{gold1.ID, gold2.ID}, ids)
+		for _, j := range jobs {
+			assert.Contains(t, j.Tags, "tier:gold")
+			assert.True(t, j.DiedAt > 0)
+		}
+	}
+
+	jobs, err = client.DeadJobsByTag("region:us")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(jobs))
+
+	jobs, err = client.DeadJobsByTag("tier")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobs))
+}
+
+func TestClientFailureCategories(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	categories, err := client.FailureCategories()
+	assert.NoError(t, err)
+	assert.Empty(t, categories)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.SetErrorClassifier(func(err error) string {
+		switch {
+		case strings.HasPrefix(err.Error(), "timeout"):
+			return "timeout"
+		case strings.Contains(err.Error(), "connection refused"):
+			return "connection refused"
+		}
+		return ""
+	})
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("%s", job.ArgString("err"))
+	})
+	wp.Job("ok", func(job *Job) error { return nil })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, msg := range []string{"timeout after 5s", "timeout after 10s", "dial tcp: connection refused", "ohno"} {
+		_, err := enqueuer.Enqueue("wat", Q{"err": msg})
+		assert.NoError(t, err)
+	}
+	_, err = enqueuer.Enqueue("ok", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	categories, err = client.FailureCategories()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"timeout": 2, "connection refused": 1, "error": 1}, categories)
+}
+
+func TestClientResetStats(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, WorkerPoolOptions{KeepCompletedJobs: 10})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.JobWithOptions("foo", JobOptions{MaxFails: 1}, func(job *Job) error { return fmt.Errorf("ohno") })
+	wp.Job("bar", func(job *Job) error { return nil })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+		_, err = enqueuer.Enqueue("foo", nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// These are jobs, not stats
+	_, err := enqueuer.Enqueue("bar", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("bar", 300, nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	throughput, err := client.Throughput("wat", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, throughput > 0)
+	categories, err := client.FailureCategories()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, categories["error"])
+	completed, err := client.RecentCompleted(10)
+	assert.NoError(t, err)
+	assert.Len(t, completed, 3)
+
+	assert.NoError(t, client.ResetStats())
+
+	for _, name := range []string{"wat", "foo"} {
+		throughput, err = client.Throughput(name, time.Minute)
+		assert.NoError(t, err)
+		assert.Zero(t, throughput)
+	}
+	categories, err = client.FailureCategories()
+	assert.NoError(t, err)
+	assert.Empty(t, categories)
+	completed, err = client.RecentCompleted(10)
+	assert.NoError(t, err)
+	assert.Empty(t, completed)
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "bar")))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+
+	// Resetting with nothing to reset is fine
+	assert.NoError(t, client.ResetStats())
+
+	// A namespace with glob characters in it only resets its own stats
+	conn := pool.Get()
+	defer conn.Close()
+	other := redisKeyJobsThroughput("wx", "wat", 1)
+	_, err = conn.Do("HSET", other, 60, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, NewClient("w?", pool).ResetStats())
+	assert.EqualValues(t, 1, hgetInt64(pool, other, "60"))
+}
+
+func TestClientThroughput(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 30; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	wp := NewWorkerPool(TestContext{}, 4, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Job("bob", func(job *Job) error { return nil })
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	rate, err := client.Throughput("wat", time.Minute)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.5, rate, 0.001)
+
+	rate, err = client.Throughput("wat", 10*time.Second)
+	assert.NoError(t, err)
+	assert.InDelta(t, 3, rate, 0.001)
+
+	rate, err = client.Throughput("bob", time.Minute)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, rate)
+
+	// Jobs fall out of the window as time goes on
+	setNowEpochSecondsMock(now + 30)
+	rate, err = client.Throughput("wat", time.Minute)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.5, rate, 0.001)
+	setNowEpochSecondsMock(now + 60)
+	rate, err = client.Throughput("wat", time.Minute)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, rate)
+
+	_, err = client.Throughput("wat", 2*time.Hour)
+	assert.Error(t, err)
+}
+
+func TestClientEstimatedDrain(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "testwork"
 	cleanKeyspace(ns, pool)
 
-	setNowEpochSecondsMock(1425263409)
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
 	defer resetNowEpochSecondsMock()
 
+	client := NewClient(ns, pool)
+	d, err := client.EstimatedDrain("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, d)
+
 	enqueuer := NewEnqueuer(ns, pool)
-	_, err := enqueuer.Enqueue("wat", Q{"a": 1, "b": 2})
-	assert.Nil(t, err)
+	for i := 0; i < 60; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+	_, err = client.EstimatedDrain("wat")
+	assert.Equal(t, ErrNoThroughput, err)
 
-	setNowEpochSecondsMock(1425263429)
+	// 600 jobs finished over the last 5 minutes is 2 a second, so 60 waiting take 30s
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("HSET", redisKeyJobsThroughput(ns, "wat", (now-100)/60), now-100, 400)
+	assert.NoError(t, err)
+	_, err = conn.Do("HSET", redisKeyJobsThroughput(ns, "wat", now/60), now, 200)
+	assert.NoError(t, err)
+	// Older than the window, so not counted
+	_, err = conn.Do("HSET", redisKeyJobsThroughput(ns, "wat", (now-600)/60), now-600, 1000)
+	assert.NoError(t, err)
 
-	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
-	wp.JobWithOptions("wat", JobOptions{Priority: 1, MaxFails: 1}, func(job *Job) error {
-		return fmt.Errorf("ohno")
-	})
+	d, err = client.EstimatedDrain("wat")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, d)
+}
+
+func TestClientRecentCompleted(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var ids []string
+	for i := 0; i < 4; i++ {
+		job, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, job.ID)
+	}
+	_, err := enqueuer.Enqueue("bad", nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{KeepCompletedJobs: 3})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.JobWithOptions("bad", JobOptions{MaxFails: 1, SkipDead: true}, func(job *Job) error { return fmt.Errorf("ohno") })
 	wp.Start()
 	wp.Drain()
 	wp.Stop()
 
 	client := NewClient(ns, pool)
-	jobs, count, err := client.DeadJobs(1)
+	jobs, err := client.RecentCompleted(10)
 	assert.NoError(t, err)
-	assert.Equal(t, 1, len(jobs))
-	assert.EqualValues(t, 1, count)
 
-	deadJob := jobs[0]
-	if len(jobs) == 1 {
-		assert.EqualValues(t, 1425263429, jobs[0].FailedAt)
-		assert.Equal(t, "wat", jobs[0].Name)
-		assert.EqualValues(t, 1425263409, jobs[0].EnqueuedAt)
-		assert.EqualValues(t, interface{}(1), jobs[0].Args["a"])
-		assert.EqualValues(t, 1, jobs[0].Fails)
-		assert.EqualValues(t, 1425263429, jobs[0].Job.FailedAt)
-		assert.Equal(t, "ohno", jobs[0].LastErr)
+	// Capped at 3, newest first, failures not recorded
+	if assert.Equal(t, 3, len(jobs)) {
+		assert.Equal(t, ids[3], jobs[0].ID)
+		assert.Equal(t, ids[2], jobs[1].ID)
+		assert.Equal(t, ids[1], jobs[2].ID)
+		for _, j := range jobs {
+			assert.Equal(t, "wat", j.Name)
+			assert.True(t, j.DurationMS >= 0)
+			assert.True(t, (nowEpochSeconds()-j.FinishedAt) <= 2)
+		}
 	}
 
-	// Test pagination a bit
-	jobs, count, err = client.DeadJobs(2)
-	assert.NoError(t, err)
-	assert.Equal(t, 0, len(jobs))
-	assert.EqualValues(t, 1, count)
-
-	// Delete it!
-	err = client.DeleteDeadJob(deadJob.DiedAt, deadJob.ID)
+	jobs, err = client.RecentCompleted(1)
 	assert.NoError(t, err)
-
-	jobs, count, err = client.DeadJobs(1)
-	assert.NoError(t, err)
-	assert.Equal(t, 0, len(jobs))
-	assert.EqualValues(t, 0, count)
+	if assert.Equal(t, 1, len(jobs)) {
+		assert.Equal(t, ids[3], jobs[0].ID)
+	}
 }
 
 func TestClientDeleteDeadJob(t *testing.T) {
@@ -432,6 +1463,57 @@ func TestClientRetryDeadJob(t *testing.T) {
 	assert.EqualValues(t, 0, job1.FailedAt)
 }
 
+func TestClientDeadJobsForJobRetryDelete(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+	for i := int64(0); i < 4; i++ {
+		rawJSON, err := (&Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 1, LastErr: "sorry", FailedAt: 12347 + i}).serialize()
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyJobsDead(ns, "wat"), 12347+i, rawJSON)
+		assert.NoError(t, err)
+	}
+	// A job in the shared dead set, which the ForJob variants leave alone
+	insertDeadJob(ns, pool, "wat", 12345, 12347)
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.DeadJobsForJob("wat", 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, count)
+
+	// Retrying or deleting them in the shared dead set doesn't find them
+	assert.Equal(t, ErrNotRetried, client.RetryDeadJob(jobs[0].DiedAt, jobs[0].ID))
+	assert.Equal(t, ErrNotDeleted, client.DeleteDeadJob(jobs[0].DiedAt, jobs[0].ID))
+
+	assert.NoError(t, client.RetryDeadJobForJob("wat", jobs[0].DiedAt, jobs[0].ID))
+	assert.NoError(t, client.DeleteDeadJobForJob("wat", jobs[1].DiedAt, jobs[1].ID))
+	assert.Equal(t, ErrNotDeleted, client.DeleteDeadJobForJob("wat", jobs[1].DiedAt, jobs[1].ID))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyJobsDead(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	assert.NoError(t, client.RetryAllDeadJobsForJob("wat"))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyJobsDead(ns, "wat")))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+	job := getQueuedJob(ns, pool, "wat")
+	if assert.NotNil(t, job) {
+		assert.EqualValues(t, 0, job.Fails)
+		assert.Equal(t, "", job.LastErr)
+	}
+
+	_, err = conn.Do("ZADD", redisKeyJobsDead(ns, "wat"), 12350, `{"name":"wat","id":"x","t":12345}`)
+	assert.NoError(t, err)
+	assert.NoError(t, client.DeleteAllDeadJobsForJob("wat"))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyJobsDead(ns, "wat")))
+
+	// The shared dead set was never touched
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
 func TestClientRetryDeadJobWithArgs(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "testwork"
@@ -476,6 +1558,120 @@ func TestClientRetryDeadJobWithArgs(t *testing.T) {
 	}
 }
 
+func TestClientRescheduleDeadJob(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	job := insertDeadJob(ns, pool, "wat", 12345, 12347)
+
+	client := NewClient(ns, pool)
+	runAt := time.Unix(1425263409, 0).Add(5 * time.Second)
+	err := client.RescheduleDeadJob(12347, job.ID, runAt)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+
+	score, scheduled := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263414, score)
+	assert.Equal(t, job.ID, scheduled.ID)
+	assert.Equal(t, "wat", scheduled.Name)
+	assert.EqualValues(t, 0, scheduled.Fails)
+	assert.Equal(t, "", scheduled.LastErr)
+	assert.EqualValues(t, 0, scheduled.FailedAt)
+
+	err = client.RescheduleDeadJob(12347, job.ID, runAt)
+	assert.Equal(t, ErrNotRetried, err)
+}
+
+func TestClientScanSet(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueued := make(map[string]bool)
+	for i := 0; i < 300; i++ {
+		job, err := enqueuer.EnqueueIn("wat", int64(i+60), Q{"i": i})
+		assert.NoError(t, err)
+		enqueued[job.ID] = true
+	}
+
+	client := NewClient(ns, pool)
+	seen := make(map[string]int)
+	err := client.ScanSet("scheduled", func(job *Job) error {
+		seen[job.ID]++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, seen, len(enqueued))
+	for id, n := range seen {
+		assert.True(t, enqueued[id])
+		assert.Equal(t, 1, n)
+	}
+
+	// An error from the callback stops the scan
+	stop := errors.New("stop")
+	calls := 0
+	err = client.ScanSet("scheduled", func(job *Job) error {
+		calls++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, calls)
+
+	assert.NoError(t, client.ScanSet("dead", func(job *Job) error {
+		t.Errorf("unexpected dead job %s", job.ID)
+		return nil
+	}))
+	assert.Error(t, client.ScanSet("wat", func(job *Job) error { return nil }))
+}
+
+func TestClientMigrateNamespace(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	dst := "testwork2"
+	cleanKeyspace(ns, pool)
+	cleanKeyspace(dst, pool)
+
+	job := insertDeadJob(ns, pool, "wat", 12345, 12347)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	first, err := enqueuer.Enqueue("foo", Q{"n": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", Q{"n": 2})
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	copied, err := client.MigrateNamespace(dst, "dead", "retry", "jobs:foo")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, copied)
+
+	score, migrated := jobOnZset(pool, redisKeyDead(dst))
+	assert.EqualValues(t, 12347, score)
+	assert.Equal(t, job.ID, migrated.ID)
+	assert.Equal(t, "wat", migrated.Name)
+	assert.EqualValues(t, 12347, migrated.FailedAt)
+
+	// The queue keeps its order, so workers in dst pick up the same job next
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(dst, "foo")))
+	next, err := NewClient(dst, pool).PeekQueue("foo")
+	assert.NoError(t, err)
+	if assert.NotNil(t, next) {
+		assert.Equal(t, first.ID, next.ID)
+	}
+	assert.Equal(t, []string{"foo"}, knownJobs(pool, redisKeyKnownJobs(dst)))
+
+	// The source is untouched
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	_, err = client.MigrateNamespace(dst, "wat")
+	assert.Error(t, err)
+	_, err = client.MigrateNamespace(ns + ":")
+	assert.Error(t, err)
+}
+
 func TestClientDeleteAllDeadJobs(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "testwork"
@@ -560,6 +1756,37 @@ func TestClientRetryAllDeadJobs(t *testing.T) {
 	assert.EqualValues(t, 0, job.FailedAt)
 }
 
+func TestClientRetryDeadJobsWithLimit(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	insertDeadJob(ns, pool, "wat", 12345, 12347)
+	insertDeadJob(ns, pool, "wat", 12345, 12348)
+	insertDeadJob(ns, pool, "wat", 12345, 12349)
+	insertDeadJob(ns, pool, "wat", 12345, 12350)
+	insertDeadJob(ns, pool, "wat", 12345, 12351)
+
+	client := NewClient(ns, pool)
+	n, err := client.RetryDeadJobs(2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	jobs, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	if assert.Equal(t, 3, len(jobs)) {
+		// The oldest ones went first
+		assert.EqualValues(t, 12349, jobs[0].DiedAt)
+	}
+
+	n, err = client.RetryDeadJobs(10)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, n)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+}
+
 func TestClientRetryAllDeadJobsBig(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "testwork"
@@ -650,6 +1877,88 @@ func TestClientDeleteScheduledJob(t *testing.T) {
 	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
 }
 
+func TestClientDeleteScheduledJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	n, err := client.DeleteScheduledJobs()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, n)
+
+	enq := NewEnqueuer(ns, pool)
+	_, err = enq.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = enq.EnqueueIn("foo", int64(10+i), Q{"i": i})
+		assert.NoError(t, err)
+	}
+	j, err := enq.EnqueueUniqueIn("bar", 10, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+
+	n, err = client.DeleteScheduledJobs()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, n)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	// The unique job can be scheduled again
+	j, err = enq.EnqueueUniqueIn("bar", 10, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, j)
+}
+
+func TestClientUpdateScheduledJobArgs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263409)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient(ns, pool)
+	err := client.UpdateScheduledJobArgs(3, "bob", Q{"a": 1})
+	assert.Equal(t, ErrJobNotFound, err)
+
+	enq := NewEnqueuer(ns, pool)
+	j, err := enq.EnqueueIn("send_email", 10, Q{"addr": "old@example.com", "n": 1})
+	assert.NoError(t, err)
+	_, err = enq.EnqueueIn("send_email", 10, Q{"addr": "other@example.com"})
+	assert.NoError(t, err)
+
+	err = client.UpdateScheduledJobArgs(j.RunAt, j.ID, Q{"addr": "new@example.com", "n": 1})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+
+	jobs, _, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	for _, job := range jobs {
+		assert.Equal(t, j.RunAt, job.RunAt)
+		assert.NotEqual(t, "old@example.com", job.ArgString("addr"))
+	}
+
+	got := make(chan string, 2)
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("send_email", func(job *Job) error {
+		if job.ID == j.ID {
+			got <- job.ArgString("addr")
+		}
+		return nil
+	})
+	setNowEpochSecondsMock(now + 20)
+	wp.Start()
+	defer wp.Stop()
+	select {
+	case addr := <-got:
+		assert.Equal(t, "new@example.com", addr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("job didn't run")
+	}
+}
+
 func TestClientDeleteScheduledUniqueJob(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "testwork"
@@ -705,6 +2014,115 @@ func TestClientDeleteRetryJob(t *testing.T) {
 	}
 }
 
+func TestClientRetryJobNow(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("wat", Q{"a": 1, "b": 2})
+	assert.Nil(t, err)
+
+	setNowEpochSecondsMock(1425263429)
+
+	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	jobs, count, err := client.RetryJobs(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(jobs))
+	if assert.EqualValues(t, 1, count) {
+		err = client.RetryJobNow(jobs[0].RetryAt, job.ID)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+		queued := getQueuedJob(ns, pool, "wat")
+		if assert.NotNil(t, queued) {
+			assert.Equal(t, job.ID, queued.ID)
+			assert.EqualValues(t, 1, queued.Fails)
+			assert.Equal(t, "ohno", queued.LastErr)
+			assert.EqualValues(t, 1425263429, queued.EnqueuedAt)
+		}
+
+		err = client.RetryJobNow(jobs[0].RetryAt, job.ID)
+		assert.Equal(t, ErrNotRetried, err)
+	}
+}
+
+func TestClientRetryJobNowDoesntScanRetries_WithMock(t *testing.T) {
+	// Only the known jobs and the script are mocked, so scanning the retry set, as Queues does, would fail
+	pool, conn := newMockTestPool(t)
+	conn.Command("SMEMBERS", "work:known_jobs").Expect([]interface{}{[]byte("wat")})
+	sha := redis.NewScript(2, redisLuaRequeueSingleRetryCmd).Hash()
+	anyData := redigomock.NewAnyData()
+	conn.Command("EVALSHA", sha, 2, "work:retry", "work:jobs:wat", "work:jobs:", anyData, int64(12345), "abc123").Expect(int64(1))
+
+	client := NewClient("work", pool)
+	assert.NoError(t, client.RetryJobNow(12345, "abc123"))
+}
+
+func TestClientRawJob(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	// A truncated payload that newJob can't decode
+	malformed := `{"name":"wat","id":"abc123","t":12345,"args":{"a":`
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("ZADD", redisKeyDead(ns), 12347, malformed)
+	assert.NoError(t, err)
+	insertDeadJob(ns, pool, "wat", 12345, 12348)
+
+	client := NewClient(ns, pool)
+	_, _, err = client.DeadJobs(1)
+	assert.Error(t, err)
+
+	raw, err := client.RawJob("dead", "abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, malformed, raw)
+
+	_, err = client.RawJob("dead", "nope")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	_, err = client.RawJob("retry", "abc123")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	_, err = client.RawJob("bogus", "abc123")
+	assert.Error(t, err)
+
+	// IDs are matched literally, not as patterns
+	odd := `{"name":"wat","id":"x[1]*?","t":12345,"args":{"a":`
+	_, err = conn.Do("ZADD", redisKeyDead(ns), 12349, odd)
+	assert.NoError(t, err)
+	raw, err = client.RawJob("dead", "x[1]*?")
+	assert.NoError(t, err)
+	assert.Equal(t, odd, raw)
+	_, err = client.RawJob("dead", "abc*")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	// A job whose args have an "id" isn't mistaken for the job with that ID
+	rawJSON, err := (&Job{Name: "wat", ID: "def456", Args: map[string]interface{}{"id": "ghi789"}}).serialize()
+	assert.NoError(t, err)
+	_, err = conn.Do("ZADD", redisKeyDead(ns), 12350, rawJSON)
+	assert.NoError(t, err)
+	_, err = client.RawJob("dead", "ghi789")
+	assert.Equal(t, ErrJobNotFound, err)
+	raw, err = client.RawJob("dead", "def456")
+	assert.NoError(t, err)
+	assert.Equal(t, string(rawJSON), raw)
+}
+
 func insertDeadJob(ns string, pool *redis.Pool, name string, encAt, failAt int64) *Job {
 	job := &Job{
 		Name:       name,
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -12,8 +13,9 @@ import (
 const (
 	deadTime          = 10 * time.Second // 2 x heartbeat
 	reapPeriod        = 10 * time.Minute
-	reapJitterSecs    = 30
+	reapJitterDivisor = 20 // reaps are up to a twentieth of the period late, eg 30s for the default period
 	requeueKeysPerJob = 4
+	deadSweepBatch    = 500 // how many dead jobs sweepDeadJobs reads at a time
 )
 
 type deadPoolReaper struct {
@@ -22,6 +24,7 @@ type deadPoolReaper struct {
 	deadTime    time.Duration
 	reapPeriod  time.Duration
 	curJobTypes []string
+	deadTTLs    map[string]time.Duration // JobOptions.DeadTTL of the jobs that have one
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
@@ -60,50 +63,142 @@ func (r *deadPoolReaper) loop() {
 			return
 		case <-timer.C:
 			// Schedule next occurrence periodically with jitter
-			timer.Reset(r.reapPeriod + time.Duration(rand.Intn(reapJitterSecs))*time.Second)
+			timer.Reset(r.nextReap())
 
 			// Reap
 			if err := r.reap(); err != nil {
 				logError("dead_pool_reaper.reap", err)
 			}
+			if err := r.sweepDeadJobs(); err != nil {
+				logError("dead_pool_reaper.sweep_dead_jobs", err)
+			}
 		}
 	}
 }
 
-func (r *deadPoolReaper) reap() error {
-	// Get dead pools
-	deadPoolIDs, err := r.findDeadPools()
-	if err != nil {
-		return err
+// nextReap is how long to wait before reaping again. Each wait is randomly longer than the period, so the reapers of
+// pools that started together drift apart rather than all scanning the worker pools at once, but never by more than a
+// twentieth of the period, which bounds how long a dead pool can go unreaped.
+func (r *deadPoolReaper) nextReap() time.Duration {
+	return r.reapPeriod + time.Duration(rand.Int63n(int64(r.reapPeriod/reapJitterDivisor)+1))
+}
+
+// sweepDeadJobs deletes the dead jobs that have been dead for longer than their job's DeadTTL. The shared dead set is
+// scored by when jobs died, so only the jobs older than the shortest DeadTTL are read, a batch at a time.
+func (r *deadPoolReaper) sweepDeadJobs() error {
+	if len(r.deadTTLs) == 0 {
+		return nil
 	}
 
 	conn := r.pool.Get()
 	defer conn.Close()
 
-	workerPoolsKey := redisKeyWorkerPools(r.namespace)
+	now := nowEpochSeconds()
+	var minTTL int64
+	for jobName, ttl := range r.deadTTLs {
+		secs := int64(ttl / time.Second)
+		if minTTL == 0 || secs < minTTL {
+			minTTL = secs
+		}
+		if _, err := conn.Do("ZREMRANGEBYSCORE", redisKeyJobsDead(r.namespace, jobName), "-inf", now-secs); err != nil {
+			return err
+		}
+	}
 
-	// Cleanup all dead pools
-	for deadPoolID, jobTypes := range deadPoolIDs {
-		lockJobTypes := jobTypes
-		// if we found jobs from the heartbeat, requeue them and remove the heartbeat
-		if len(jobTypes) > 0 {
-			r.requeueInProgressJobs(deadPoolID, jobTypes)
-			if _, err = conn.Do("DEL", redisKeyHeartbeat(r.namespace, deadPoolID)); err != nil {
+	deadKey := redisKeyDead(r.namespace)
+	offset := 0
+	for {
+		values, err := redis.Values(conn.Do("ZRANGEBYSCORE", deadKey, "-inf", now-minTTL, "WITHSCORES", "LIMIT", offset, deadSweepBatch))
+		if err != nil {
+			return err
+		}
+
+		expired := []interface{}{deadKey}
+		for i := 0; i+1 < len(values); i += 2 {
+			rawJSON, err := redis.Bytes(values[i], nil)
+			if err != nil {
+				return err
+			}
+			diedAt, err := redis.Int64(values[i+1], nil)
+			if err != nil {
+				return err
+			}
+			var job struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(rawJSON, &job); err != nil {
+				continue
+			}
+			if ttl, ok := r.deadTTLs[job.Name]; ok && diedAt <= now-int64(ttl/time.Second) {
+				expired = append(expired, rawJSON)
+			}
+		}
+		if len(expired) > 1 {
+			if _, err := conn.Do("ZREM", expired...); err != nil {
 				return err
 			}
-		} else {
-			// try to clean up locks for the current set of jobs if heartbeat was not found
-			lockJobTypes = r.curJobTypes
 		}
-		// Cleanup any stale lock info
-		if err = r.cleanStaleLockInfo(deadPoolID, lockJobTypes); err != nil {
+
+		read := len(values) / 2
+		if read < deadSweepBatch {
+			return nil
+		}
+		// What was deleted no longer counts towards the offset
+		offset += read - (len(expired) - 1)
+	}
+}
+
+func (r *deadPoolReaper) reap() error {
+	// Get dead pools
+	deadPoolIDs, err := r.findDeadPools()
+	if err != nil {
+		return err
+	}
+
+	// Cleanup all dead pools
+	for deadPoolID, jobTypes := range deadPoolIDs {
+		if err := r.reapPool(deadPoolID, jobTypes); err != nil {
 			return err
 		}
+	}
 
-		// Remove dead pool from worker pools set
-		if _, err = conn.Do("SREM", workerPoolsKey, deadPoolID); err != nil {
+	return nil
+}
+
+// reapPool requeues the in progress jobs of a dead pool, cleans up its locks and unregisters it. jobTypes are the job
+// names from the pool's heartbeat, if it still had one.
+func (r *deadPoolReaper) reapPool(deadPoolID string, jobTypes []string) error {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	lockJobTypes := jobTypes
+	// if we found jobs from the heartbeat, requeue them and remove the heartbeat
+	if len(jobTypes) > 0 {
+		r.requeueInProgressJobs(deadPoolID, jobTypes)
+		if err := r.requeuePoolInProgressJobs(deadPoolID, jobTypes); err != nil {
+			// Keep the heartbeat, so that the pool is reaped again on the next pass
 			return err
 		}
+		if _, err := conn.Do("DEL", redisKeyHeartbeat(r.namespace, deadPoolID)); err != nil {
+			return err
+		}
+	} else {
+		// try to clean up locks for the current set of jobs if heartbeat was not found
+		lockJobTypes = r.curJobTypes
+	}
+	// Cleanup any stale lock info
+	if err := r.cleanStaleLockInfo(deadPoolID, lockJobTypes); err != nil {
+		return err
+	}
+
+	// Its jobs are no longer in progress, so neither are their claim times
+	if _, err := conn.Do("DEL", redisKeyPoolClaimedAt(r.namespace, deadPoolID)); err != nil {
+		return err
+	}
+
+	// Remove dead pool from worker pools set
+	if _, err := conn.Do("SREM", redisKeyWorkerPools(r.namespace), deadPoolID); err != nil {
+		return err
 	}
 
 	return nil
@@ -157,6 +252,34 @@ func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string)
 	}
 }
 
+// requeuePoolInProgressJobs is requeueInProgressJobs for pools that keep a single in progress list for all job types.
+// It's a no-op for pools that don't.
+func (r *deadPoolReaper) requeuePoolInProgressJobs(poolID string, jobTypes []string) error {
+	numKeys := 2 + len(jobTypes)*3
+	redisRequeueScript := redis.NewScript(numKeys, redisLuaReenqueuePoolJob)
+	var scriptArgs = make([]interface{}, 0, numKeys+3)
+
+	scriptArgs = append(scriptArgs, redisKeyPoolInProgress(r.namespace, poolID), redisKeyDead(r.namespace)) // KEYS[1-2]
+	for _, jobType := range jobTypes {
+		scriptArgs = append(scriptArgs, redisKeyJobs(r.namespace, jobType), redisKeyJobsLock(r.namespace, jobType), redisKeyJobsLockInfo(r.namespace, jobType)) // KEYS[3-5 * N]
+	}
+	scriptArgs = append(scriptArgs, redisKeyJobsPrefix(r.namespace), poolID, nowEpochSeconds(), nil) // ARGV[1-4]
+
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	// Keep moving jobs until the list is empty
+	for {
+		scriptArgs[len(scriptArgs)-1] = makeIdentifier()
+		_, err := redis.Bytes(redisRequeueScript.Do(conn, scriptArgs...))
+		if err == redis.ErrNil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
 func (r *deadPoolReaper) findDeadPools() (map[string][]string, error) {
 	conn := r.pool.Get()
 	defer conn.Close()
@@ -182,7 +305,7 @@ func (r *deadPoolReaper) findDeadPools() (map[string][]string, error) {
 		}
 
 		// Check that last heartbeat was long enough ago to consider the pool dead
-		if time.Unix(heartbeatAt, 0).Add(r.deadTime).After(time.Now()) {
+		if time.Unix(heartbeatAt, 0).Add(r.deadTime).After(nowTime()) {
 			continue
 		}
 
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -262,6 +263,104 @@ func TestDeadPoolReaperNoJobTypes(t *testing.T) {
 	assert.Equal(t, 0, jobsCount)
 }
 
+func TestDeadPoolReaperConsolidatedInProgress(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{ConsolidateInProgress: true})
+	wp.Job("type1", func(job *Job) error { return nil })
+	wp.Job("type2", func(job *Job) error { return nil })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("type1", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("type2", nil)
+	assert.NoError(t, err)
+
+	// Fetch both jobs without finishing them, as if the pool crashed mid-job
+	w := wp.workers[0]
+	for i := 0; i < 2; i++ {
+		job, err := w.fetchJob()
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+	}
+
+	assert.EqualValues(t, 2, listSize(pool, redisKeyPoolInProgress(ns, wp.workerPoolID)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "type1")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "type1")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "type2")))
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, "type1")))
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, "type2")))
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), wp.workerPoolID)
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, wp.workerPoolID),
+		"heartbeat_at", time.Now().Add(-1*time.Hour).Unix(),
+		"job_names", "type1,type2",
+	)
+	assert.NoError(t, err)
+
+	reaper := newDeadPoolReaper(ns, pool, []string{})
+	err = reaper.reap()
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyPoolInProgress(ns, wp.workerPoolID)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "type1")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "type2")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "type1")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "type2")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+
+	// And a fresh pool can run them
+	wp2 := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{ConsolidateInProgress: true})
+	var ran int
+	wp2.Job("type1", func(job *Job) error { ran++; return nil })
+	wp2.Job("type2", func(job *Job) error { ran++; return nil })
+	wp2.Start()
+	wp2.Drain()
+	wp2.Stop()
+
+	assert.Equal(t, 2, ran)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyPoolInProgress(ns, wp2.workerPoolID)))
+}
+
+func TestDeadPoolReaperConsolidatedInProgressUndecodable(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	poolID := "deadpool"
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("RPUSH", redisKeyPoolInProgress(ns, poolID), `{"name":`)
+	assert.NoError(t, err)
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), poolID)
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, poolID),
+		"heartbeat_at", time.Now().Add(-1*time.Hour).Unix(),
+		"job_names", "type1",
+	)
+	assert.NoError(t, err)
+
+	assert.NoError(t, newDeadPoolReaper(ns, pool, []string{}).reap())
+	assert.EqualValues(t, 0, listSize(pool, redisKeyPoolInProgress(ns, poolID)))
+
+	// The payload is kept on the dead set, where it can be looked at
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	if assert.NotNil(t, job) {
+		assert.Equal(t, DeadReasonUndecodable, job.DeadReason)
+		assert.Contains(t, job.LastErr, "decoding job")
+		assert.Equal(t, `{"name":`, job.ArgString("raw_json"))
+
+		raw, err := NewClient(ns, pool).RawJob("dead", job.ID)
+		assert.NoError(t, err)
+		assert.Contains(t, raw, "raw_json")
+	}
+}
+
 func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -302,6 +401,109 @@ func TestDeadPoolReaperWithWorkerPools(t *testing.T) {
 	wp.deadPoolReaper.stop()
 }
 
+func TestDeadPoolReaperInterval(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+	interval := 50 * time.Millisecond
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(job *Job) error { return nil })
+	wp.SetReaperInterval(interval)
+	wp.Start()
+	assert.Equal(t, interval, wp.deadPoolReaper.reapPeriod)
+	wp.Stop()
+
+	// Two reapers on the same interval wait different, bounded, times between reaps
+	r1 := newDeadPoolReaper(ns, pool, []string{job1})
+	r1.reapPeriod = interval
+	r2 := newDeadPoolReaper(ns, pool, []string{job1})
+	r2.reapPeriod = interval
+	lockstep := true
+	for i := 0; i < 5; i++ {
+		d1, d2 := r1.nextReap(), r2.nextReap()
+		assert.True(t, d1 >= interval && d1 <= interval+interval/20)
+		assert.True(t, d2 >= interval && d2 <= interval+interval/20)
+		lockstep = lockstep && d1 == d2
+	}
+	assert.False(t, lockstep)
+
+	// A pool that dies after the first reap is reaped by a later one
+	r1.deadTime = 5 * time.Millisecond
+	r1.start()
+	defer r1.stop()
+	time.Sleep(2 * r1.deadTime)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), "aaa")
+	assert.NoError(t, err)
+	_, err = conn.Do("HSET", redisKeyHeartbeat(ns, "aaa"), "heartbeat_at", nowEpochSeconds()-60, "job_names", job1)
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "aaa", job1), `{"name":"job1","id":"1"}`)
+	assert.NoError(t, err)
+
+	deadline := time.Now().Add(interval + interval/20 + 100*time.Millisecond)
+	for listSize(pool, redisKeyJobs(ns, job1)) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "aaa", job1)))
+
+	assert.Panics(t, func() { wp.SetReaperInterval(0) })
+}
+
+func TestDeadPoolReaperSweepDeadJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263409)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	failing := func(job *Job) error { return fmt.Errorf("ohno") }
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.SetDefaultJobOptions(JobOptions{MaxFails: 1, DeadTTL: 90 * 24 * time.Hour})
+	wp.JobWithOptions("noisy", JobOptions{DeadTTL: time.Hour}, failing)
+	wp.JobWithOptions("noisy_separate", JobOptions{DeadTTL: time.Hour, SeparateDeadSet: true}, failing)
+	wp.Job("compliance", failing)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"noisy", "noisy_separate", "compliance"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyJobsDead(ns, "noisy_separate")))
+
+	// Nothing has expired yet
+	assert.NoError(t, wp.deadPoolReaper.sweepDeadJobs())
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyJobsDead(ns, "noisy_separate")))
+
+	// The shorter DeadTTL is swept first
+	setNowEpochSecondsMock(now + 2*60*60)
+	assert.NoError(t, wp.deadPoolReaper.sweepDeadJobs())
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyJobsDead(ns, "noisy_separate")))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "compliance", job.Name)
+
+	setNowEpochSecondsMock(now + 91*24*60*60)
+	assert.NoError(t, wp.deadPoolReaper.sweepDeadJobs())
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+
+	// Under a second would truncate to deleting dead jobs as soon as they're buried
+	assert.Panics(t, func() {
+		NewWorkerPool(TestContext{}, 1, ns, pool).JobWithOptions("wat", JobOptions{DeadTTL: 500 * time.Millisecond}, failing)
+	})
+}
+
 func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -353,3 +555,50 @@ func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
 	v, err = conn.Do("HGET", lockInfo2, workerPoolID2)
 	assert.Nil(t, v)
 }
+
+func TestDeadPoolReaperDisabled(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	stalePoolID := "aaa"
+	cleanKeyspace(ns, pool)
+
+	// A pool that died without a heartbeat, leaving a job in progress
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), stalePoolID)
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, stalePoolID, job1), `{"name":"job1","id":"1"}`)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{
+		DisableRequeuers:      true,
+		DisableDeadPoolReaper: true,
+	})
+	wp.Job(job1, func(job *Job) error { return nil })
+	wp.Start()
+
+	// Nothing is listening for a stop, because the loops were never started
+	select {
+	case wp.deadPoolReaper.stopChan <- struct{}{}:
+		t.Error("dead pool reaper is running")
+	case wp.retrier.stopChan <- struct{}{}:
+		t.Error("retrier is running")
+	case wp.scheduler.stopChan <- struct{}{}:
+		t.Error("scheduler is running")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// The stale pool is dead, it just isn't this pool's job to reap it
+	deadPoolIDs, err := wp.deadPoolReaper.findDeadPools()
+	assert.NoError(t, err)
+	assert.Contains(t, deadPoolIDs, stalePoolID)
+
+	wp.Stop()
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsInProgress(ns, stalePoolID, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	isMember, err := redis.Bool(conn.Do("SISMEMBER", redisKeyWorkerPools(ns), stalePoolID))
+	assert.NoError(t, err)
+	assert.True(t, isMember)
+}
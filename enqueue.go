@@ -1,7 +1,13 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,25 +16,100 @@ import (
 
 var ErrReplicationFailed = errors.New("replication failed")
 
+// ErrRedisFull is returned, wrapping Redis' own error, when an enqueue is rejected because Redis has reached its
+// maxmemory limit. Check for it with errors.Is to shed load, eg by dropping jobs that aren't critical.
+var ErrRedisFull = errors.New("redis is out of memory")
+
+// ErrQueueFull is returned by EnqueueBounded when the job's queue already holds as many jobs as it's allowed.
+var ErrQueueFull = errors.New("queue is full")
+
+// redisFullError wraps err in ErrRedisFull if it's Redis rejecting a write because it's out of memory. Other errors are
+// returned as is.
+func redisFullError(err error) error {
+	if rerr, ok := err.(redis.Error); ok && strings.Contains(string(rerr), "OOM command not allowed") {
+		return fmt.Errorf("%w: %v", ErrRedisFull, err)
+	}
+	return err
+}
+
 // Enqueuer can enqueue jobs.
 type Enqueuer struct {
 	Namespace string // eg, "myapp-work"
 	Pool      *redis.Pool
 	Option    EnqueuerOption
 
-	queuePrefix           string // eg, "myapp-work:jobs:"
-	knownJobs             map[string]int64
-	enqueueUniqueScript   *redis.Script
-	enqueueUniqueInScript *redis.Script
-	mtx                   sync.RWMutex
+	queuePrefix                   string // eg, "myapp-work:jobs:"
+	knownJobs                     map[string]int64
+	enqueueUniqueScript           *redis.Script
+	enqueueUniqueInScript         *redis.Script
+	enqueueUniqueInEarliestScript *redis.Script
+	metricsSink                   EnqueueMetricsSink
+	contextInjector               ContextInjector
+	middleware                    []EnqueueMiddleware
+	writeTimeout                  time.Duration
+	mtx                           sync.RWMutex
+}
+
+// EnqueueMiddleware is run with the name and args of each job before it's enqueued, eg to check that every job carries
+// the args a system requires. It can change the args. If it returns an error, the job isn't enqueued and the enqueue
+// returns the error.
+type EnqueueMiddleware func(jobName string, args map[string]interface{}) error
+
+// EnqueueMetricsSink receives the name of each enqueue operation, eg "enqueue" or "enqueue_unique_in", along with how
+// long it took and the error it returned, if any.
+type EnqueueMetricsSink func(op string, d time.Duration, err error)
+
+// ContextInjector copies whatever needs to travel with a job from ctx into its args, eg trace and baggage headers. A
+// WorkerPool's ArgsContextExtractor can then rebuild the context for the handler.
+type ContextInjector func(ctx context.Context, args map[string]interface{})
+
+// EnqueueOptions can be passed to EnqueueWithOptions.
+type EnqueueOptions struct {
+	// OnSuccess is enqueued when the job's handler succeeds.
+	OnSuccess *FollowUp
+	// OnFailure is enqueued when the job fails for good: when it's buried, or dropped because of SkipDead, after its
+	// last retry. Failures that will be retried don't enqueue it, so it's enqueued at most once.
+	OnFailure *FollowUp
+	// Deadline, if set, is when the job must have run by. A job that's dequeued after its deadline is buried instead of
+	// run, with a "deadline exceeded" error, and one that fails after it is buried instead of retried. Deadlines are to
+	// the second.
+	Deadline time.Time
 }
 
 // EnqueuerOption can be passed to NewEnqueuerWithOptions.
 type EnqueuerOption struct {
 	MinWaitReplicas  int // MinWaitReplicas is passed as numreplicas in redis wait command, if zero then skips wait command altogether
 	MaxWaitTimeoutMS int // MaxWaitTimeoutMS is passed as timeout in redis wait command
+
+	// UniqueKeyHasher, if set, decides which unique jobs count as the same: jobs with the same name whose args (or
+	// keyMap, for the ByKey variants) hash to the same string are deduplicated. By default the args are JSON encoded,
+	// so they have to be exactly equal.
+	UniqueKeyHasher UniqueKeyHasher
+
+	// CompressArgsOver, if positive, gzips the args of jobs whose JSON encoded args are longer than this many bytes,
+	// to save Redis memory and bandwidth on large payloads. Workers decompress them before the handler sees them, and
+	// keep them compressed when the job is retried or buried. Only workers from a version that understands compressed
+	// args can run these jobs, so it's off by default.
+	CompressArgsOver int
+
+	// Generation tags every job the enqueuer enqueues, and puts it on a queue of its own that only worker pools with
+	// the same WorkerPoolOptions.Generation work, eg to deploy a new version of the workers alongside the old one and
+	// send it traffic just by switching which generation producers enqueue with. Generations share the namespace, so
+	// unlike separate namespaces they share the retry, scheduled, and dead sets, unique keys, locks, pauses, and max
+	// concurrencies, and show up in one web UI. Retries and scheduled jobs go back to their generation's queue.
+	Generation string
+
+	// MillisecondEnqueuedAt also records when each job was enqueued to the millisecond, in Job.EnqueuedAtMs, so jobs
+	// enqueued in the same second can be told apart and ordered. EnqueuedAt stays in seconds, so workers and clients
+	// from versions that don't know about it can still decode the jobs. Schedules, retries, and deadlines are still to
+	// the second.
+	MillisecondEnqueuedAt bool
 }
 
+// UniqueKeyHasher hashes a unique job's args into the string that identifies it among jobs with the same name. It
+// can eg drop fields that don't matter, like timestamps, or normalize values before encoding them.
+type UniqueKeyHasher func(jobName string, args map[string]interface{}) (string, error)
+
 // NewEnqueuer creates a new enqueuer with the specified Redis namespace and Redis pool.
 func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
 	return NewEnqueuerWithOptions(namespace, pool, EnqueuerOption{})
@@ -39,49 +120,491 @@ func NewEnqueuerWithOptions(namespace string, pool *redis.Pool, opt EnqueuerOpti
 	if pool == nil {
 		panic("NewEnqueuer needs a non-nil *redis.Pool")
 	}
+	validateGeneration(opt.Generation)
 
 	return &Enqueuer{
-		Namespace:             namespace,
-		Pool:                  pool,
-		Option:                opt,
-		queuePrefix:           redisKeyJobsPrefix(namespace),
-		knownJobs:             make(map[string]int64),
-		enqueueUniqueScript:   redis.NewScript(2, redisLuaEnqueueUnique),
-		enqueueUniqueInScript: redis.NewScript(2, redisLuaEnqueueUniqueIn),
+		Namespace:                     namespace,
+		Pool:                          pool,
+		Option:                        opt,
+		queuePrefix:                   redisKeyJobsPrefix(namespace),
+		knownJobs:                     make(map[string]int64),
+		enqueueUniqueScript:           redis.NewScript(2, redisLuaEnqueueUnique),
+		enqueueUniqueInScript:         redis.NewScript(2, redisLuaEnqueueUniqueIn),
+		enqueueUniqueInEarliestScript: redis.NewScript(3, redisLuaEnqueueUniqueInEarliest),
+	}
+}
+
+// SetMetricsSink sets a function to call after every enqueue, so that producers can report their enqueue rate and
+// latency to their own metrics system. It isn't safe to call while other goroutines are enqueueing.
+func (e *Enqueuer) SetMetricsSink(sink EnqueueMetricsSink) {
+	e.metricsSink = sink
+}
+
+// SetContextInjector sets the function EnqueueContext uses to put values from its context into the job's args. Like
+// SetMetricsSink, it isn't safe to call while other goroutines are enqueueing.
+func (e *Enqueuer) SetContextInjector(injector ContextInjector) {
+	e.contextInjector = injector
+}
+
+// SetWriteTimeout limits how long each enqueue waits for Redis to reply to each command it sends, so that an enqueue
+// fails with a timeout error rather than hanging when Redis is slow, eg busy with a long scan. With
+// EnqueuerOption.MinWaitReplicas, keep it above MaxWaitTimeoutMS, since WAIT takes up to that long. The default, 0,
+// uses the pool's own timeouts. Like SetMetricsSink, it isn't safe to call while other goroutines are enqueueing.
+func (e *Enqueuer) SetWriteTimeout(d time.Duration) {
+	e.writeTimeout = d
+}
+
+func (e *Enqueuer) getConn() redis.Conn {
+	return getConnWithTimeout(e.Pool, e.writeTimeout)
+}
+
+// Use adds middleware to run before every enqueue, in the order it's added, by every Enqueue method. Like
+// SetMetricsSink, it isn't safe to call while other goroutines are enqueueing.
+func (e *Enqueuer) Use(mw EnqueueMiddleware) {
+	e.middleware = append(e.middleware, mw)
+}
+
+// checkJob validates the job's name and runs the enqueue middleware over it.
+func (e *Enqueuer) checkJob(jobName string, args map[string]interface{}) error {
+	if err := validateJobName(jobName); err != nil {
+		return err
+	}
+	for _, mw := range e.middleware {
+		if err := mw(jobName, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Enqueuer) observe(op string, start time.Time, err *error) {
+	if e.metricsSink == nil {
+		return
 	}
+	e.metricsSink(op, time.Since(start), *err)
 }
 
 // Enqueue will enqueue the specified job name and arguments. The args param can be nil if no args ar needed.
 // Example: e.Enqueue("send_email", work.Q{"addr": "test@example.com"})
-func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue", time.Now(), &err)
+
+	return e.enqueue(&Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	})
+}
+
+// EnqueueWeighted enqueues a job under one of the names in weights, picked at random in proportion to its weight, eg to
+// send 5% of a job's traffic to a new version of its handler registered under another name:
+// e.EnqueueWeighted(map[string]int{"process_v1": 95, "process_v2": 5}, work.Q{"id": 1}). Names with a weight of 0
+// are never picked. The returned job's Name is the one that was.
+func (e *Enqueuer) EnqueueWeighted(weights map[string]int, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_weighted", time.Now(), &err)
+
+	jobName, err := pickWeighted(weights)
+	if err != nil {
+		return nil, err
+	}
+	return e.enqueue(&Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	})
+}
+
+// pickWeighted picks a key of weights at random, in proportion to its value.
+func pickWeighted(weights map[string]int) (string, error) {
+	names := make([]string, 0, len(weights))
+	total := 0
+	for name, weight := range weights {
+		if weight < 0 {
+			return "", fmt.Errorf("work: weight of %q is negative", name)
+		}
+		names = append(names, name)
+		total += weight
+	}
+	if total == 0 {
+		return "", fmt.Errorf("work: no job has a positive weight")
+	}
+	// Sorted, so that the same random number always picks the same name
+	sort.Strings(names)
+
+	n := rand.Intn(total)
+	for _, name := range names {
+		if n < weights[name] {
+			return name, nil
+		}
+		n -= weights[name]
+	}
+	return names[len(names)-1], nil
+}
+
+// EnqueueRaw enqueues a job whose input is payload rather than args, for binary data such as an encoded protobuf that
+// wouldn't survive being turned into JSON args. The handler reads it with Job.RawPayload; the job's Args are nil.
+func (e *Enqueuer) EnqueueRaw(jobName string, payload []byte) (_ *Job, err error) {
+	defer e.observe("enqueue_raw", time.Now(), &err)
+
+	return e.enqueue(&Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		payload:    payload,
+	})
+}
+
+// EnqueueTagged is like Enqueue, but labels the job with tags. Tags stay with the job through retries and death,
+// so dead jobs can be filtered by them with Client.DeadJobsByTag.
+// Example: e.EnqueueTagged("send_email", []string{"tier:gold", "region:us"}, work.Q{"addr": "test@example.com"})
+func (e *Enqueuer) EnqueueTagged(jobName string, tags []string, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_tagged", time.Now(), &err)
+
+	return e.enqueue(&Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Tags:       tags,
+	})
+}
+
+// EnqueueContext enqueues a job like Enqueue does, after letting the ContextInjector add values from ctx to its args.
+// args itself isn't modified. Without a ContextInjector, ctx is ignored.
+func (e *Enqueuer) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_context", time.Now(), &err)
+
+	if e.contextInjector != nil {
+		injected := make(map[string]interface{}, len(args))
+		for k, v := range args {
+			injected[k] = v
+		}
+		e.contextInjector(ctx, injected)
+		args = injected
+	}
+
+	return e.enqueue(&Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	})
+}
+
+// EnqueueWithOptions enqueues a job like Enqueue does, along with follow-up jobs for the worker to enqueue once it's
+// done with it. Follow-ups are plain jobs: they can't have follow-ups of their own, and they're enqueued at most once.
+// Example: e.EnqueueWithOptions("charge", work.Q{"order": 7}, work.EnqueueOptions{OnSuccess: &work.FollowUp{Name: "ship", Args: work.Q{"order": 7}}})
+func (e *Enqueuer) EnqueueWithOptions(jobName string, args map[string]interface{}, opts EnqueueOptions) (_ *Job, err error) {
+	defer e.observe("enqueue_with_options", time.Now(), &err)
+
+	for _, f := range []*FollowUp{opts.OnSuccess, opts.OnFailure} {
+		if f == nil {
+			continue
+		}
+		if err := validateJobName(f.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	job := &Job{
 		Name:       jobName,
 		ID:         makeIdentifier(),
 		EnqueuedAt: nowEpochSeconds(),
 		Args:       args,
+		OnSuccess:  opts.OnSuccess,
+		OnFailure:  opts.OnFailure,
+	}
+	if !opts.Deadline.IsZero() {
+		job.Deadline = opts.Deadline.Unix()
+	}
+	return e.enqueue(job)
+}
+
+// queueKey is the queue the enqueuer pushes jobName's jobs onto, which depends on its Option.Generation.
+func (e *Enqueuer) queueKey(jobName string) string {
+	if e.Option.Generation == "" {
+		return e.queuePrefix + jobName
+	}
+	return e.queuePrefix + jobName + ":gen:" + e.Option.Generation
+}
+
+// serialize encodes job, tagging it with Option.Generation, stamping it to the millisecond if
+// Option.MillisecondEnqueuedAt is set, and compressing its args if they're longer than Option.CompressArgsOver.
+func (e *Enqueuer) serialize(job *Job) ([]byte, error) {
+	job.Generation = e.Option.Generation
+	if e.Option.MillisecondEnqueuedAt {
+		job.EnqueuedAtMs = nowEpochMillis()
+		job.EnqueuedAt = job.EnqueuedAtMs / 1000
+	}
+	if e.Option.CompressArgsOver > 0 && !job.gzipArgs {
+		rawArgs, err := json.Marshal(job.Args)
+		if err != nil {
+			return nil, err
+		}
+		job.gzipArgs = len(rawArgs) > e.Option.CompressArgsOver
+	}
+	return job.serialize()
+}
+
+func (e *Enqueuer) enqueue(job *Job) (*Job, error) {
+	if err := e.checkJob(job.Name, job.Args); err != nil {
+		return nil, err
 	}
 
-	rawJSON, err := job.serialize()
+	rawJSON, err := e.serialize(job)
 	if err != nil {
 		return nil, err
 	}
 
-	conn := e.Pool.Get()
+	conn := e.getConn()
 	defer conn.Close()
 
-	if _, err := e.redisDoHelper(conn, "LPUSH", e.queuePrefix+jobName, rawJSON); err != nil {
+	if _, err := e.redisDoHelper(conn, "LPUSH", e.queueKey(job.Name), rawJSON); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, job.Name); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// EnqueueDebounced enqueues a job like Enqueue does, unless a job with the same name and args was enqueued by
+// EnqueueDebounced less than window ago, eg to ignore a flaky client submitting the same thing twice. It returns nil
+// for a job that's ignored. Unlike EnqueueUnique, it doesn't matter whether the first job has run yet: only the time
+// since it was enqueued does. The window is counted from the last job that was enqueued, not ignored ones, so a
+// client that keeps retrying still gets a job through once a window. Args are compared the same way as unique jobs',
+// so an EnqueuerOption.UniqueKeyHasher applies to them too.
+func (e *Enqueuer) EnqueueDebounced(jobName string, window time.Duration, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_debounced", time.Now(), &err)
+
+	if window < time.Millisecond {
+		return nil, fmt.Errorf("work: debounce window must be at least a millisecond")
+	}
+	if err := e.checkJob(jobName, args); err != nil {
+		return nil, err
+	}
+
+	var hash string
+	if e.Option.UniqueKeyHasher != nil {
+		if hash, err = e.Option.UniqueKeyHasher(jobName, args); err != nil {
+			return nil, err
+		}
+	} else {
+		argsJSON, err := json.Marshal(args)
+		if err != nil {
+			return nil, err
+		}
+		hash = string(argsJSON)
+	}
+
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	}
+	rawJSON, err := e.serialize(job)
+	if err != nil {
 		return nil, err
 	}
 
+	conn := e.getConn()
+	defer conn.Close()
+
+	script := redis.NewScript(2, redisLuaEnqueueDebounced)
+	res, err := redis.String(script.Do(conn, e.queueKey(jobName), redisKeyDebounce(e.Namespace, jobName, hash), rawJSON, nowTime().UnixMilli(), window.Milliseconds()))
+	if err != nil {
+		return nil, redisFullError(err)
+	}
+	if err := e.waitForReplicas(conn); err != nil {
+		return nil, err
+	}
+	if res == "dup" {
+		return nil, nil
+	}
+
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
 		return job, err
 	}
+	return job, nil
+}
+
+// EnqueueBounded enqueues a job like Enqueue does, unless the job's queue already holds maxLen or more jobs waiting to
+// run, in which case it returns ErrQueueFull and enqueues nothing. It sheds load, rather than letting a backlog build
+// up that can never be worked off. Only jobs waiting on the queue count, not those in progress, scheduled, or retrying.
+func (e *Enqueuer) EnqueueBounded(jobName string, maxLen int64, args map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_bounded", time.Now(), &err)
+
+	if maxLen < 1 {
+		return nil, fmt.Errorf("work: queue length bound must be at least 1")
+	}
+	if err := e.checkJob(jobName, args); err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	}
+	rawJSON, err := e.serialize(job)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.getConn()
+	defer conn.Close()
+
+	script := redis.NewScript(1, redisLuaEnqueueBounded)
+	res, err := redis.String(script.Do(conn, e.queueKey(jobName), rawJSON, maxLen))
+	if err != nil {
+		return nil, redisFullError(err)
+	}
+	if res == "full" {
+		return nil, ErrQueueFull
+	}
+	if err := e.waitForReplicas(conn); err != nil {
+		return nil, err
+	}
 
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
 	return job, nil
 }
 
+// Batch is a group of jobs to enqueue together, all or nothing. Make one with Enqueuer.NewBatch.
+type Batch struct {
+	enqueuer *Enqueuer
+	jobs     []*Job
+	err      error
+}
+
+// NewBatch starts an empty batch of jobs, to be enqueued by Batch.Commit.
+func (e *Enqueuer) NewBatch() *Batch {
+	return &Batch{enqueuer: e}
+}
+
+// Add puts a job in the batch, to be enqueued like Enqueue would when the batch is committed. The job's name is
+// checked and the enqueue middleware run right away; if either fails, the error is returned, and the batch can no
+// longer be committed.
+func (b *Batch) Add(jobName string, args map[string]interface{}) error {
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.enqueuer.checkJob(jobName, args); err != nil {
+		b.err = err
+		return err
+	}
+
+	b.jobs = append(b.jobs, &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	})
+	return nil
+}
+
+// Commit enqueues every job in the batch in one Redis transaction, so no worker sees some of them without the others,
+// and a batch that Redis rejects, eg because it's out of memory, enqueues none of them. If a job failed to be added,
+// Commit returns its error and enqueues nothing. Redis doesn't roll back a transaction whose commands have begun to
+// run, though, so if a queue's key has been overwritten with something that isn't a list, the other jobs are still
+// enqueued and Commit returns the error.
+func (b *Batch) Commit() (_ []*Job, err error) {
+	e := b.enqueuer
+	defer e.observe("enqueue_batch", time.Now(), &err)
+
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.jobs) == 0 {
+		return nil, nil
+	}
+
+	conn := e.getConn()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	names := []interface{}{redisKeyKnownJobs(e.Namespace)}
+	for _, job := range b.jobs {
+		rawJSON, err := e.serialize(job)
+		if err != nil {
+			conn.Do("DISCARD")
+			return nil, err
+		}
+		conn.Send("LPUSH", e.queueKey(job.Name), rawJSON)
+		names = append(names, job.Name)
+	}
+	conn.Send("SADD", names...)
+
+	// Redis checks each command as it's queued, and aborts the transaction if one is rejected
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(b.jobs)+2; i++ {
+		if _, err := conn.Receive(); err != nil {
+			conn.Do("DISCARD")
+			return nil, redisFullError(err)
+		}
+	}
+
+	replies, err := redis.Values(e.redisDoHelper(conn, "EXEC"))
+	if err != nil {
+		return nil, redisFullError(err)
+	}
+	for _, reply := range replies {
+		if rerr, ok := reply.(redis.Error); ok {
+			return b.jobs, rerr
+		}
+	}
+
+	return b.jobs, nil
+}
+
 // EnqueueIn enqueues a job in the scheduled job queue for execution in secondsFromNow seconds.
-func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+//
+// When several scheduled jobs are due at once, worker pools move them to their queues highest Priority first (see
+// JobOptions). That only orders the jobs that are due by the time a pool's scheduler next looks, about once a second,
+// and only the first 100 of them; a job due in a later pass is queued after those before it, whatever its priority.
+func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (_ *ScheduledJob, err error) {
+	defer e.observe("enqueue_in", time.Now(), &err)
+
+	return e.enqueueAt(jobName, nowEpochSeconds()+secondsFromNow, args)
+}
+
+// EnqueueAtCron enqueues a single job to run at the next time cronSpec fires after now, in loc. It doesn't recur: use
+// WorkerPool.PeriodicallyEnqueue for that. cronSpec is in the same format PeriodicallyEnqueue takes, so "0 0 9 * * *"
+// is 9am. A nil loc means local time.
+func (e *Enqueuer) EnqueueAtCron(jobName, cronSpec string, loc *time.Location, args map[string]interface{}) (_ *ScheduledJob, err error) {
+	defer e.observe("enqueue_at_cron", time.Now(), &err)
+
+	schedule, err := cronParser.Parse(cronSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if loc == nil {
+		loc = time.Local
+	}
+	next := schedule.Next(time.Unix(nowEpochSeconds(), 0).In(loc))
+	if next.IsZero() {
+		return nil, fmt.Errorf("work: cron spec %q never fires", cronSpec)
+	}
+
+	return e.enqueueAt(jobName, next.Unix(), args)
+}
+
+func (e *Enqueuer) enqueueAt(jobName string, runAt int64, args map[string]interface{}) (*ScheduledJob, error) {
+	if err := e.checkJob(jobName, args); err != nil {
+		return nil, err
+	}
+
 	job := &Job{
 		Name:       jobName,
 		ID:         makeIdentifier(),
@@ -89,16 +612,16 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 		Args:       args,
 	}
 
-	rawJSON, err := job.serialize()
+	rawJSON, err := e.serialize(job)
 	if err != nil {
 		return nil, err
 	}
 
-	conn := e.Pool.Get()
+	conn := e.getConn()
 	defer conn.Close()
 
 	scheduledJob := &ScheduledJob{
-		RunAt: nowEpochSeconds() + secondsFromNow,
+		RunAt: runAt,
 		Job:   job,
 	}
 
@@ -135,13 +658,15 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 // Any failed jobs in the retry queue or dead queue don't count against the uniqueness -- so if a job fails and is retried, two unique jobs with the same name and arguments can be enqueued at once.
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUniqueByKey returns the job if it was enqueued and nil if it wasn't
-func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (_ *Job, err error) {
+	defer e.observe("enqueue_unique", time.Now(), &err)
+
 	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := enqueue(nil)
+	res, err := enqueue(nil, false)
 
 	if res == "ok" && err == nil {
 		return job, nil
@@ -151,7 +676,9 @@ func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{
 
 // EnqueueUniqueInByKey enqueues a job in the scheduled job queue that is unique on specified key for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 // Subsequent calls with same key will update arguments
-func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (_ *ScheduledJob, err error) {
+	defer e.observe("enqueue_unique_in", time.Now(), &err)
+
 	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
 	if err != nil {
 		return nil, err
@@ -162,13 +689,55 @@ func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, ar
 		Job:   job,
 	}
 
-	res, err := enqueue(&scheduledJob.RunAt)
+	res, err := enqueue(&scheduledJob.RunAt, false)
 	if res == "ok" && err == nil {
 		return scheduledJob, nil
 	}
 	return nil, err
 }
 
+// EnqueueUniqueInEarliest is like EnqueueUniqueIn, except that if the unique job is already waiting in the scheduled
+// job queue to run later than secondsFromNow, it is moved up to run at secondsFromNow instead. In other words, the job
+// runs at the earliest time asked for. Only jobs enqueued with EnqueueUniqueInEarliest can be moved this way.
+// It returns the newly enqueued job, the existing job with its new run time if it was moved up, or nil otherwise.
+func (e *Enqueuer) EnqueueUniqueInEarliest(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueUniqueInEarliestByKey(jobName, secondsFromNow, args, nil)
+}
+
+// EnqueueUniqueInEarliestByKey is like EnqueueUniqueInEarliest, but unique on the specified key as per EnqueueUniqueInByKey.
+func (e *Enqueuer) EnqueueUniqueInEarliestByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (_ *ScheduledJob, err error) {
+	defer e.observe("enqueue_unique_in_earliest", time.Now(), &err)
+
+	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	res, err := enqueue(&scheduledJob.RunAt, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res {
+	case "ok":
+		return scheduledJob, nil
+	case "dup":
+		return nil, nil
+	}
+
+	// The existing job was moved up, and res is how it sits in the scheduled queue
+	existing, err := newJob([]byte(res), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledJob{RunAt: scheduledJob.RunAt, Job: existing}, nil
+}
+
 func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	needSadd := true
 	now := time.Now().Unix()
@@ -184,7 +753,7 @@ func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	}
 	if needSadd {
 		if _, err := conn.Do("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
-			return err
+			return redisFullError(err)
 		}
 
 		e.mtx.Lock()
@@ -195,18 +764,32 @@ func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	return nil
 }
 
-type enqueueFnType func(*int64) (string, error)
+type enqueueFnType func(runAt *int64, earliest bool) (string, error)
 
 func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
+	if err := e.checkJob(jobName, args); err != nil {
+		return nil, nil, err
+	}
+
 	useDefaultKeys := false
 	if keyMap == nil {
 		useDefaultKeys = true
 		keyMap = args
 	}
 
-	uniqueKey, err := redisKeyUniqueJob(e.Namespace, jobName, keyMap)
-	if err != nil {
-		return nil, nil, err
+	var uniqueKey string
+	if e.Option.UniqueKeyHasher != nil {
+		hash, err := e.Option.UniqueKeyHasher(jobName, keyMap)
+		if err != nil {
+			return nil, nil, err
+		}
+		uniqueKey = redisKeyUniqueJobHash(e.Namespace, jobName, hash)
+	} else {
+		var err error
+		uniqueKey, err = redisKeyUniqueJob(e.Namespace, jobName, keyMap)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	job := &Job{
@@ -218,25 +801,26 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		UniqueKey:  uniqueKey,
 	}
 
-	rawJSON, err := job.serialize()
+	rawJSON, err := e.serialize(job)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	enqueueFn := func(runAt *int64) (string, error) {
-		conn := e.Pool.Get()
+	enqueueFn := func(runAt *int64, earliest bool) (string, error) {
+		conn := e.getConn()
 		defer conn.Close()
 
 		if err := e.addToKnownJobs(conn, jobName); err != nil {
 			return "", err
 		}
 
+		keys := []interface{}{}
 		scriptArgs := []interface{}{}
 		script := e.enqueueUniqueScript
 
-		scriptArgs = append(scriptArgs, e.queuePrefix+jobName) // KEY[1]
-		scriptArgs = append(scriptArgs, uniqueKey)             // KEY[2]
-		scriptArgs = append(scriptArgs, rawJSON)               // ARGV[1]
+		keys = append(keys, e.queueKey(jobName)) // KEY[1]
+		keys = append(keys, uniqueKey)           // KEY[2]
+		scriptArgs = append(scriptArgs, rawJSON) // ARGV[1]
 		if useDefaultKeys {
 			// keying on arguments so arguments can't be updated
 			// we'll just get them off the original job so to save space, make this "1"
@@ -248,15 +832,19 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		}
 
 		if runAt != nil { // Scheduled job so different job queue with additional arg
-			scriptArgs[0] = redisKeyScheduled(e.Namespace) // KEY[1]
-			scriptArgs = append(scriptArgs, *runAt)        // ARGV[3]
+			keys[0] = redisKeyScheduled(e.Namespace) // KEY[1]
+			scriptArgs = append(scriptArgs, *runAt)  // ARGV[3]
 
 			script = e.enqueueUniqueInScript
+			if earliest {
+				keys = append(keys, redisKeyUniqueJobScheduled(uniqueKey)) // KEY[3]
+				script = e.enqueueUniqueInEarliestScript
+			}
 		}
 
-		status, err := redis.String(script.Do(conn, scriptArgs...))
+		status, err := redis.String(script.Do(conn, append(keys, scriptArgs...)...))
 		if err != nil {
-			return "", err
+			return "", redisFullError(err)
 		}
 		if e.Option.MinWaitReplicas > 0 {
 			numReplicas, err := redis.Int(conn.Do("WAIT", e.Option.MinWaitReplicas, e.Option.MaxWaitTimeoutMS))
@@ -273,6 +861,22 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 	return enqueueFn, job, nil
 }
 
+// waitForReplicas WAITs for EnqueuerOption.MinWaitReplicas replicas to acknowledge what was just written on conn, for
+// writes that can't go through redisDoHelper. It returns ErrReplicationFailed if too few do.
+func (e *Enqueuer) waitForReplicas(conn redis.Conn) error {
+	if e.Option.MinWaitReplicas <= 0 {
+		return nil
+	}
+	numReplicas, err := redis.Int(conn.Do("WAIT", e.Option.MinWaitReplicas, e.Option.MaxWaitTimeoutMS))
+	if err != nil {
+		return err
+	}
+	if numReplicas < e.Option.MinWaitReplicas {
+		return ErrReplicationFailed
+	}
+	return nil
+}
+
 func (e *Enqueuer) redisDoHelper(c redis.Conn, cmdName string, args ...interface{}) (reply interface{}, err error) {
 	if err = c.Send(cmdName, args...); err != nil {
 		return
@@ -287,6 +891,7 @@ func (e *Enqueuer) redisDoHelper(c redis.Conn, cmdName string, args ...interface
 
 	reply, err = c.Receive()
 	if err != nil {
+		err = redisFullError(err)
 		return
 	}
 	if e.Option.MinWaitReplicas > 0 {
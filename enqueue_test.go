@@ -3,10 +3,12 @@ package work
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/rafaeljusto/redigomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -58,6 +60,396 @@ func TestEnqueue(t *testing.T) {
 	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
 }
 
+func TestEnqueueMetricsSink(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	type call struct {
+		op  string
+		d   time.Duration
+		err error
+	}
+	var calls []call
+	enqueuer.SetMetricsSink(func(op string, d time.Duration, err error) {
+		calls = append(calls, call{op, d, err})
+	})
+
+	_, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("wat", 10, Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUnique("wat", Q{"a": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueIn("wat", 10, Q{"a": 3})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"a": make(chan int)})
+	assert.Error(t, err)
+
+	ops := []string{"enqueue", "enqueue_in", "enqueue_unique", "enqueue_unique_in", "enqueue"}
+	if assert.Equal(t, len(ops), len(calls)) {
+		for i, c := range calls {
+			assert.Equal(t, ops[i], c.op)
+			assert.True(t, c.d > 0)
+			assert.True(t, c.d < 5*time.Second)
+		}
+		for _, c := range calls[:4] {
+			assert.NoError(t, c.err)
+		}
+		assert.Equal(t, err, calls[4].err)
+	}
+}
+
+func TestEnqueueInvalidJobName(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	_, err := enqueuer.Enqueue("", nil)
+	assert.EqualError(t, err, "work: job name is empty")
+
+	_, err = enqueuer.Enqueue(strings.Repeat("a", MaxJobNameLength+1), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "is longer than 128 characters")
+	}
+
+	_, err = enqueuer.EnqueueIn("wat\nwat", 10, nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `job name "wat\nwat" doesn't match`)
+	}
+
+	_, err = enqueuer.EnqueueUnique("wat,wat", nil)
+	assert.Error(t, err)
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.Empty(t, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	// The rules can be changed
+	defer func(max int) { MaxJobNameLength = max }(MaxJobNameLength)
+	MaxJobNameLength = 3
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("watt", nil)
+	assert.Error(t, err)
+}
+
+func TestEnqueueReservedJobName(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	for _, name := range []string{"wat:lock", "wat:dead", "wat:pool1:inprogress", "wat:throughput:123"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		if assert.Error(t, err, name) {
+			assert.Contains(t, err.Error(), "is reserved")
+		}
+	}
+	assert.Empty(t, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	// Only parts after a colon are reserved
+	for _, name := range []string{"lock", "dead:letters", "wat:locked"} {
+		_, err := enqueuer.Enqueue(name, nil)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestEnqueuerMiddleware(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var seen []string
+	enqueuer.Use(func(jobName string, args map[string]interface{}) error {
+		seen = append(seen, jobName)
+		return nil
+	})
+	enqueuer.Use(func(jobName string, args map[string]interface{}) error {
+		if _, ok := args["tenant_id"]; !ok {
+			return fmt.Errorf("%s is missing tenant_id", jobName)
+		}
+		args["checked"] = true
+		return nil
+	})
+
+	job, err := enqueuer.Enqueue("wat", Q{"tenant_id": 1})
+	assert.NoError(t, err)
+	assert.True(t, job.ArgBool("checked"))
+	assert.True(t, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ArgBool("checked")) // takes it off the queue
+
+	_, err = enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.EqualError(t, err, "wat is missing tenant_id")
+	_, err = enqueuer.EnqueueIn("foo", 10, nil)
+	assert.EqualError(t, err, "foo is missing tenant_id")
+	_, err = enqueuer.EnqueueUnique("bar", Q{"a": 1})
+	assert.EqualError(t, err, "bar is missing tenant_id")
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "bar")))
+	assert.Equal(t, []string{"wat", "wat", "foo", "bar"}, seen)
+}
+
+func TestEnqueueDebounced(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := time.Unix(1425263400, 0)
+	SetNowFunc(func() time.Time { return now })
+	defer SetNowFunc(nil)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueDebounced("wat", 5*time.Second, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	now = now.Add(4 * time.Second)
+	job, err = enqueuer.EnqueueDebounced("wat", 5*time.Second, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	// Other args aren't fenced off
+	job, err = enqueuer.EnqueueDebounced("wat", 5*time.Second, Q{"a": 2})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	now = now.Add(time.Second)
+	job, err = enqueuer.EnqueueDebounced("wat", 5*time.Second, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.Equal(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	_, err = enqueuer.EnqueueDebounced("wat", 0, Q{"a": 1})
+	assert.Error(t, err)
+}
+
+func TestEnqueueBounded(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	for i := 0; i < 3; i++ {
+		job, err := enqueuer.EnqueueBounded("wat", 3, Q{"i": i})
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+	}
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	job, err := enqueuer.EnqueueBounded("wat", 3, Q{"i": 3})
+	assert.Equal(t, ErrQueueFull, err)
+	assert.Nil(t, job)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Once a job's taken off the queue there's room again
+	jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	_, err = enqueuer.EnqueueBounded("wat", 3, Q{"i": 4})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	_, err = enqueuer.EnqueueBounded("wat", 0, nil)
+	assert.Error(t, err)
+}
+
+func TestEnqueueWriteTimeout(t *testing.T) {
+	enqueuer := NewEnqueuer("work", newUnresponsiveTestPool(t))
+	enqueuer.SetWriteTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestEnqueueBatch(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch()
+	assert.NoError(t, batch.Add("reserve", Q{"order": 1}))
+	assert.NoError(t, batch.Add("charge", Q{"order": 1}))
+	assert.NoError(t, batch.Add("reserve", Q{"order": 2}))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "reserve")))
+
+	jobs, err := batch.Commit()
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 3)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "reserve")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "charge")))
+	assert.ElementsMatch(t, []string{"reserve", "charge"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "charge"))
+	assert.Equal(t, jobs[1].ID, j.ID)
+	assert.EqualValues(t, 1, j.ArgInt64("order"))
+
+	// One bad job keeps the whole batch from being enqueued
+	cleanKeyspace(ns, pool)
+	batch = enqueuer.NewBatch()
+	assert.NoError(t, batch.Add("reserve", Q{"order": 3}))
+	assert.Error(t, batch.Add("bad name", nil))
+	assert.Error(t, batch.Add("charge", Q{"order": 3}))
+	jobs, err = batch.Commit()
+	assert.Error(t, err)
+	assert.Nil(t, jobs)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "reserve")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "charge")))
+	assert.Empty(t, knownJobs(pool, redisKeyKnownJobs(ns)))
+}
+
+func TestEnqueueCompressArgs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	blob := strings.Repeat("all work and no play makes jack a dull boy. ", 1000)
+	_, err := NewEnqueuer(ns, pool).Enqueue("plain", Q{"blob": blob})
+	assert.NoError(t, err)
+	enqueuer := NewEnqueuerWithOptions(ns, pool, EnqueuerOption{CompressArgsOver: 1024})
+	_, err = enqueuer.Enqueue("compressed", Q{"blob": blob})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("small", Q{"blob": "x"})
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	plain, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "plain"), 0))
+	assert.NoError(t, err)
+	compressed, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "compressed"), 0))
+	assert.NoError(t, err)
+	assert.Less(t, len(compressed)*10, len(plain))
+	small, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "small"), 0))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(small), "args_gz")
+
+	var got string
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("compressed", JobOptions{MaxFails: 3}, func(job *Job) error {
+		got = job.ArgString("blob")
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.Equal(t, blob, got)
+
+	// The retried job stays compressed
+	retried, err := redis.Values(conn.Do("ZRANGE", redisKeyRetry(ns), 0, -1))
+	assert.NoError(t, err)
+	assert.Len(t, retried, 1)
+	assert.Less(t, len(retried[0].([]byte))*10, len(plain))
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, blob, job.ArgString("blob"))
+}
+
+func TestEnqueueRaw(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	payload := []byte{0x08, 0x96, 0x01, 0x00, 0xff, '"', '\\', 0x7f}
+	enqueued, err := NewEnqueuer(ns, pool).EnqueueRaw("proto", payload)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, enqueued.RawPayload())
+
+	conn := pool.Get()
+	defer conn.Close()
+	rawJSON, err := redis.Bytes(conn.Do("LINDEX", redisKeyJobs(ns, "proto"), 0))
+	assert.NoError(t, err)
+	job, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, job.RawPayload())
+	assert.Nil(t, job.Args)
+
+	var got []byte
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("proto", JobOptions{MaxFails: 3}, func(job *Job) error {
+		got = job.RawPayload()
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.Equal(t, payload, got)
+
+	// The retried job keeps its payload
+	_, job = jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, payload, job.RawPayload())
+}
+
+func TestEnqueueMillisecondEnqueuedAt(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := time.Unix(1700000000, 250*int64(time.Millisecond))
+	SetNowFunc(func() time.Time { return now })
+	defer SetNowFunc(nil)
+
+	enqueuer := NewEnqueuerWithOptions(ns, pool, EnqueuerOption{MillisecondEnqueuedAt: true})
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	now = now.Add(300 * time.Millisecond)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	// Oldest first, as the queue is LPUSHed
+	conn := pool.Get()
+	defer conn.Close()
+	rawJSONs, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyJobs(ns, "wat"), 0, -1))
+	assert.NoError(t, err)
+	assert.Len(t, rawJSONs, 2)
+	second, err := newJob(rawJSONs[0], nil, nil)
+	assert.NoError(t, err)
+	first, err := newJob(rawJSONs[1], nil, nil)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 1700000000, first.EnqueuedAt)
+	assert.EqualValues(t, 1700000000, second.EnqueuedAt)
+	assert.EqualValues(t, 1700000000250, first.EnqueuedAtMs)
+	assert.EqualValues(t, 1700000000550, second.EnqueuedAtMs)
+	assert.True(t, first.EnqueuedAtTime().Before(second.EnqueuedAtTime()))
+	assert.Equal(t, 300*time.Millisecond, second.EnqueuedAtTime().Sub(first.EnqueuedAtTime()))
+
+	// Off by default, and then the time is to the second
+	job, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, job.EnqueuedAtMs)
+	assert.Equal(t, time.Unix(job.EnqueuedAt, 0), job.EnqueuedAtTime())
+}
+
+func TestEnqueueWeighted(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	weights := map[string]int{"jobv1": 95, "jobv2": 5, "jobv3": 0}
+	for i := 0; i < 2000; i++ {
+		job, err := enqueuer.EnqueueWeighted(weights, Q{"i": i})
+		assert.NoError(t, err)
+		assert.Contains(t, []string{"jobv1", "jobv2"}, job.Name)
+	}
+	v1 := listSize(pool, redisKeyJobs(ns, "jobv1"))
+	v2 := listSize(pool, redisKeyJobs(ns, "jobv2"))
+	assert.EqualValues(t, 2000, v1+v2)
+	assert.InDelta(t, 100, v2, 40)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "jobv3")))
+
+	_, err := enqueuer.EnqueueWeighted(map[string]int{"jobv1": 0}, nil)
+	assert.Error(t, err)
+	_, err = enqueuer.EnqueueWeighted(map[string]int{"jobv1": 1, "jobv2": -1}, nil)
+	assert.Error(t, err)
+}
+
 func TestEnqueue_WithMock(t *testing.T) {
 	ns := "work"
 	jobName := "test"
@@ -149,6 +541,78 @@ func TestEnqueue_WithMock(t *testing.T) {
 	}
 }
 
+func TestEnqueueDebouncedWaitsForReplicas_WithMock(t *testing.T) {
+	pool, conn := newMockTestPool(t)
+	enqueuer := NewEnqueuerWithOptions("work", pool, EnqueuerOption{MinWaitReplicas: 2, MaxWaitTimeoutMS: 1000})
+	sha := redis.NewScript(2, redisLuaEnqueueDebounced).Hash()
+	anyData := redigomock.NewAnyData()
+	conn.Command("EVALSHA", sha, 2, anyData, anyData, anyData, anyData, anyData).Expect([]byte("ok"))
+	conn.Command("WAIT", 2, 1000).Expect(int64(1))
+	conn.Command("SADD", "work:known_jobs", "test").Expect(1)
+
+	job, err := enqueuer.EnqueueDebounced("test", time.Minute, nil)
+	assert.Equal(t, ErrReplicationFailed, err)
+	assert.Nil(t, job)
+
+	conn.Command("WAIT", 2, 1000).Expect(int64(2))
+	job, err = enqueuer.EnqueueDebounced("test", time.Minute, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func TestEnqueueBoundedWaitsForReplicas_WithMock(t *testing.T) {
+	pool, conn := newMockTestPool(t)
+	enqueuer := NewEnqueuerWithOptions("work", pool, EnqueuerOption{MinWaitReplicas: 2, MaxWaitTimeoutMS: 1000})
+	sha := redis.NewScript(1, redisLuaEnqueueBounded).Hash()
+	conn.Command("EVALSHA", sha, 1, "work:jobs:test", redigomock.NewAnyData(), int64(10)).Expect([]byte("ok"))
+	conn.Command("WAIT", 2, 1000).Expect(int64(1))
+	conn.Command("SADD", "work:known_jobs", "test").Expect(1)
+
+	job, err := enqueuer.EnqueueBounded("test", 10, nil)
+	assert.Equal(t, ErrReplicationFailed, err)
+	assert.Nil(t, job)
+
+	conn.Command("WAIT", 2, 1000).Expect(int64(2))
+	job, err = enqueuer.EnqueueBounded("test", 10, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func TestEnqueueRedisFull_WithMock(t *testing.T) {
+	oom := redis.Error("OOM command not allowed when used memory > 'maxmemory'.")
+
+	pool, conn := newMockTestPool(t)
+	enqueuer := NewEnqueuer("work", pool)
+	conn.Command("LPUSH", "work:jobs:test", redigomock.NewAnyData()).ExpectError(oom)
+	conn.Command("ZADD", "work:scheduled", redigomock.NewAnyData(), redigomock.NewAnyData()).ExpectError(oom)
+
+	_, err := enqueuer.Enqueue("test", nil)
+	assert.True(t, errors.Is(err, ErrRedisFull))
+	assert.Contains(t, err.Error(), "OOM command not allowed")
+
+	_, err = enqueuer.EnqueueIn("test", 10, nil)
+	assert.True(t, errors.Is(err, ErrRedisFull))
+
+	// A batch is discarded rather than committed
+	conn.Command("MULTI").Expect("OK")
+	conn.Command("SADD", "work:known_jobs", "test").Expect("QUEUED")
+	conn.Command("DISCARD").Expect("OK")
+	exec := conn.Command("EXEC").Expect([]interface{}{})
+	batch := enqueuer.NewBatch()
+	assert.NoError(t, batch.Add("test", nil))
+	_, err = batch.Commit()
+	assert.True(t, errors.Is(err, ErrRedisFull))
+	assert.Equal(t, 0, conn.Stats(exec))
+
+	// Other errors aren't mistaken for it
+	pool, conn = newMockTestPool(t)
+	enqueuer = NewEnqueuer("work", pool)
+	conn.Command("LPUSH", "work:jobs:test", redigomock.NewAnyData()).ExpectError(redis.Error("READONLY You can't write against a read only replica."))
+	_, err = enqueuer.Enqueue("test", nil)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrRedisFull))
+}
+
 func TestEnqueueIn(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -196,6 +660,40 @@ func TestEnqueueIn(t *testing.T) {
 	assert.NoError(t, j.ArgError())
 }
 
+func TestEnqueueAtCron(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	setNowEpochSecondsMock(time.Date(2024, 3, 10, 8, 30, 0, 0, loc).Unix())
+	defer resetNowEpochSecondsMock()
+
+	// Before 9am it's today
+	job, err := enqueuer.EnqueueAtCron("wat", "0 0 9 * * *", loc, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.EqualValues(t, time.Date(2024, 3, 10, 9, 0, 0, 0, loc).Unix(), job.RunAt)
+		assert.EqualValues(t, 1, job.ArgInt64("a"))
+	}
+
+	// After 9am it's tomorrow
+	setNowEpochSecondsMock(time.Date(2024, 3, 10, 9, 0, 1, 0, loc).Unix())
+	job, err = enqueuer.EnqueueAtCron("wat", "0 9 * * *", loc, nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.EqualValues(t, time.Date(2024, 3, 11, 9, 0, 0, 0, loc).Unix(), job.RunAt)
+	}
+
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	_, err = enqueuer.EnqueueAtCron("wat", "not a spec", loc, nil)
+	assert.Error(t, err)
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
 func TestEnqueueIn_WithMock(t *testing.T) {
 	ns := "work"
 	jobName := "test"
@@ -291,6 +789,51 @@ func TestEnqueueIn_WithMock(t *testing.T) {
 	}
 }
 
+func TestEnqueueUniqueKeyHasher(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// Requests are the same whenever they were made
+	enqueuer := NewEnqueuerWithOptions(ns, pool, EnqueuerOption{
+		UniqueKeyHasher: func(jobName string, args map[string]interface{}) (string, error) {
+			return fmt.Sprint(args["user_id"]), nil
+		},
+	})
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"user_id": 1, "requested_at": 100})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	job, err = enqueuer.EnqueueUnique("wat", Q{"user_id": 1, "requested_at": 200})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueUnique("wat", Q{"user_id": 2, "requested_at": 200})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Without it, the args have to match exactly
+	cleanKeyspace(ns, pool)
+	enqueuer = NewEnqueuer(ns, pool)
+	job, err = enqueuer.EnqueueUnique("wat", Q{"user_id": 1, "requested_at": 100})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = enqueuer.EnqueueUnique("wat", Q{"user_id": 1, "requested_at": 200})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// Its errors are returned
+	enqueuer = NewEnqueuerWithOptions(ns, pool, EnqueuerOption{
+		UniqueKeyHasher: func(jobName string, args map[string]interface{}) (string, error) {
+			return "", fmt.Errorf("no user_id")
+		},
+	})
+	_, err = enqueuer.EnqueueUnique("wat", nil)
+	assert.EqualError(t, err, "no user_id")
+}
+
 func TestEnqueueUnique(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -523,6 +1066,50 @@ func TestEnqueueUniqueIn(t *testing.T) {
 	assert.NotNil(t, job)
 }
 
+func TestEnqueueUniqueInEarliest(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	job, err := enqueuer.EnqueueUniqueInEarliest("wat", 60, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.EqualValues(t, 1425263409+60, job.RunAt)
+	}
+
+	// A later time doesn't move it back
+	later, err := enqueuer.EnqueueUniqueInEarliest("wat", 120, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, later)
+
+	// An earlier time moves it up
+	earlier, err := enqueuer.EnqueueUniqueInEarliest("wat", 10, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, earlier) {
+		assert.Equal(t, job.ID, earlier.ID)
+		assert.EqualValues(t, 1425263409+10, earlier.RunAt)
+	}
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.EqualValues(t, 1425263409+10, score)
+	assert.Equal(t, job.ID, j.ID)
+
+	// Once due, the scheduler moves it onto the queue at the earlier time
+	setNowEpochSecondsMock(1425263409 + 10)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.start()
+	re.drain()
+	re.stop()
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
 func TestEnqueueUniqueIn_WithMock(t *testing.T) {
 	ns := "work"
 	jobName := "test"
@@ -0,0 +1,194 @@
+package work
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// inFlightCheckInterval is how often a pool looks for jobs that have been in progress for longer than their
+// JobOptions.MaxInFlight. Claim times are recorded to the second, so checking more often wouldn't help.
+const inFlightCheckInterval = time.Second
+
+// inFlightJobs tracks the running jobs of a job type with MaxInFlight, so the pool's inFlightWatcher can take back the
+// ones that run too long, and their workers know to leave them alone once their handlers do return.
+type inFlightJobs struct {
+	mtx     sync.Mutex
+	running map[string]*Job
+	expired map[string]bool
+}
+
+func newInFlightJobs() *inFlightJobs {
+	return &inFlightJobs{
+		running: make(map[string]*Job),
+		expired: make(map[string]bool),
+	}
+}
+
+func (f *inFlightJobs) start(job *Job) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.running[job.ID] = job
+}
+
+// finish records that job's handler is done, and reports whether the job was taken back while it ran.
+func (f *inFlightJobs) finish(job *Job) bool {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	delete(f.running, job.ID)
+	if f.expired[job.ID] {
+		delete(f.expired, job.ID)
+		return true
+	}
+	return false
+}
+
+// inFlightWatcher takes back a pool's jobs that have been in progress for longer than their JobOptions.MaxInFlight,
+// failing them as if their handlers had returned an error.
+type inFlightWatcher struct {
+	namespace string
+	poolID    string
+	pool      *redis.Pool
+	jobTypes  []*jobType
+	worker    *worker // one of the pool's workers, which decides what happens to a failed job the way they all would
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newInFlightWatcher(namespace, poolID string, pool *redis.Pool, jobTypes []*jobType, w *worker) *inFlightWatcher {
+	return &inFlightWatcher{
+		namespace:        namespace,
+		poolID:           poolID,
+		pool:             pool,
+		jobTypes:         jobTypes,
+		worker:           w,
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (iw *inFlightWatcher) start() {
+	go iw.loop()
+}
+
+func (iw *inFlightWatcher) stop() {
+	iw.stopChan <- struct{}{}
+	<-iw.doneStoppingChan
+}
+
+func (iw *inFlightWatcher) loop() {
+	ticker := time.NewTicker(inFlightCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-iw.stopChan:
+			iw.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			for _, jt := range iw.jobTypes {
+				if err := iw.check(jt); err != nil {
+					logError("in_flight_watcher.check", err)
+				}
+			}
+		}
+	}
+}
+
+// check takes back jt's jobs that were claimed longer than its MaxInFlight ago.
+func (iw *inFlightWatcher) check(jt *jobType) error {
+	jt.inFlight.mtx.Lock()
+	defer jt.inFlight.mtx.Unlock()
+	if len(jt.inFlight.running) == 0 {
+		return nil
+	}
+
+	jobs := make([]*Job, 0, len(jt.inFlight.running))
+	args := []interface{}{redisKeyPoolClaimedAt(iw.namespace, iw.poolID)}
+	for _, job := range jt.inFlight.running {
+		jobs = append(jobs, job)
+		args = append(args, job.ID)
+	}
+
+	conn := iw.pool.Get()
+	defer conn.Close()
+
+	claimedAts, err := redis.Int64s(conn.Do("HMGET", args...))
+	if err != nil {
+		return err
+	}
+
+	now := nowEpochSeconds()
+	maxInFlight := int64(jt.MaxInFlight / time.Second)
+	for i, job := range jobs {
+		// A missing claim time reads as 0, which only happens if the job was just claimed by a worker that failed to
+		// record it; leave those alone
+		if claimedAts[i] == 0 || now-claimedAts[i] < maxInFlight {
+			continue
+		}
+		expired, err := iw.expire(conn, jt, job)
+		if err != nil {
+			return err
+		}
+		if expired {
+			logWarning("in_flight_watcher.expired", fmt.Sprintf("job_name=%s job_id=%s max_in_flight=%v", job.Name, job.ID, jt.MaxInFlight))
+			delete(jt.inFlight.running, job.ID)
+			jt.inFlight.expired[job.ID] = true
+		}
+	}
+	return nil
+}
+
+// expire fails job and takes it out of progress, with the same fate as if its handler had returned an error: it's
+// retried or buried, counted, followed up and published just as a worker would, in the same transaction that takes it
+// out of progress. It reports whether the job was expired; if it wasn't still in progress, it's left alone, and if the
+// in-progress list changed while it was being checked, it's left for the next check.
+func (iw *inFlightWatcher) expire(conn redis.Conn, jt *jobType, job *Job) (bool, error) {
+	// WATCH the in-progress list, so that the job can't leave it, by its handler returning or its pool being reaped,
+	// between finding it there and failing it
+	if _, err := conn.Do("WATCH", job.inProgQueue); err != nil {
+		return false, err
+	}
+	inProgress, err := redis.ByteSlices(conn.Do("LRANGE", job.inProgQueue, 0, -1))
+	if err != nil {
+		conn.Do("UNWATCH")
+		return false, err
+	}
+	if !inList(inProgress, job.rawJSON) {
+		conn.Do("UNWATCH")
+		return false, nil
+	}
+
+	// The handler still has job, so fail a copy
+	failed := *job
+	runErr := fmt.Errorf("in flight for longer than MaxInFlight (%v)", jt.MaxInFlight)
+	failed.failed(runErr)
+	w := iw.worker
+	fate := w.publishCompletion(w.countFailure(w.jobFate(jt, &failed, runErr), runErr), &failed, runErr)
+
+	conn.Send("MULTI")
+	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
+	conn.Send("DECR", redisKeyJobsLock(iw.namespace, job.Name))
+	conn.Send("HINCRBY", redisKeyJobsLockInfo(iw.namespace, job.Name), iw.poolID, -1)
+	conn.Send("HDEL", redisKeyPoolClaimedAt(iw.namespace, iw.poolID), job.ID)
+	fate(conn)
+	reply, err := conn.Do("EXEC")
+	if err != nil {
+		return false, err
+	}
+	// A nil reply means the WATCH fired and nothing was done
+	return reply != nil, nil
+}
+
+func inList(list [][]byte, member []byte) bool {
+	for _, m := range list {
+		if bytes.Equal(m, member) {
+			return true
+		}
+	}
+	return false
+}
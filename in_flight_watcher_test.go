@@ -0,0 +1,157 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInFlightWatcher(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithContext("wat", JobOptions{MaxFails: 3, MaxInFlight: time.Second}, func(ctx context.Context, job *Job) error {
+		defer close(returned)
+		close(started)
+		// Wedged: ignores ctx
+		<-release
+		return fmt.Errorf("too late")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueued, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+
+	// Within a couple of checks of the job's MaxInFlight passing, it's failed and put on the retry set
+	deadline := time.Now().Add(5 * time.Second)
+	for zsetSize(pool, redisKeyRetry(ns)) == 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "wat")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.False(t, hexists(pool, redisKeyPoolClaimedAt(ns, wp.workerPoolID), enqueued.ID))
+
+	// When the handler finally returns, what it returns is ignored
+	close(release)
+	<-returned
+	wp.Stop()
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, enqueued.ID, job.ID)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Contains(t, job.LastErr, "MaxInFlight")
+}
+
+func TestInFlightWatcherFailsLikeAWorker(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.SetErrorClassifier(func(err error) string { return "timeout" })
+	wp.JobWithContext("wat", JobOptions{MaxFails: 1, MaxInFlight: time.Second}, func(ctx context.Context, job *Job) error {
+		close(started)
+		<-release
+		return nil
+	})
+
+	enqueued, err := NewEnqueuer(ns, pool).EnqueueWithOptions("wat", nil, EnqueueOptions{OnFailure: &FollowUp{Name: "cleanup"}})
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	deadline := time.Now().Add(5 * time.Second)
+	for zsetSize(pool, redisKeyDead(ns)) == 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	close(release)
+	wp.Stop()
+
+	// Out of retries, it's buried, counted, and followed up just as if its handler had failed
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	if assert.NotNil(t, job) {
+		assert.Equal(t, enqueued.ID, job.ID)
+		assert.Equal(t, DeadReasonMaxFails, job.DeadReason)
+	}
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyFailureCategories(ns), "timeout"))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "cleanup")))
+}
+
+// racingConn pushes another job onto the in-progress list right after it's read, as another of the pool's workers might.
+type racingConn struct {
+	redis.Conn
+	push func()
+}
+
+func (c racingConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	reply, err := c.Conn.Do(cmd, args...)
+	if cmd == "LRANGE" {
+		c.push()
+	}
+	return reply, err
+}
+
+func TestInFlightWatcherExpireIsAtomic(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 3, MaxInFlight: time.Second}, func(job *Job) error { return nil })
+	jt := wp.jobTypes["wat"]
+
+	inProgQueue := redisKeyJobsInProgress(ns, wp.workerPoolID, "wat")
+	rawJSON, err := (&Job{Name: "wat", ID: "abc123"}).serialize()
+	assert.NoError(t, err)
+	job, err := newJob(rawJSON, nil, []byte(inProgQueue))
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	iw := newInFlightWatcher(ns, wp.workerPoolID, pool, []*jobType{jt}, wp.workers[0])
+
+	// Not in progress, so it's left alone
+	expired, err := iw.expire(conn, jt, job)
+	assert.NoError(t, err)
+	assert.False(t, expired)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+	// If the list changes while it's being checked, nothing happens until the next check
+	_, err = conn.Do("LPUSH", inProgQueue, rawJSON)
+	assert.NoError(t, err)
+	racing := racingConn{Conn: conn, push: func() {
+		other := pool.Get()
+		defer other.Close()
+		_, err := other.Do("LPUSH", inProgQueue, "other")
+		assert.NoError(t, err)
+	}}
+	expired, err = iw.expire(racing, jt, job)
+	assert.NoError(t, err)
+	assert.False(t, expired)
+	assert.EqualValues(t, 2, listSize(pool, inProgQueue))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+	expired, err = iw.expire(conn, jt, job)
+	assert.NoError(t, err)
+	assert.True(t, expired)
+	assert.EqualValues(t, 1, listSize(pool, inProgQueue))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+}
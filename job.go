@@ -1,32 +1,132 @@
 package work
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
+	"regexp"
+	"strings"
+	"time"
 )
 
 // Job represents a job.
 type Job struct {
 	// Inputs when making a new job
-	Name       string                 `json:"name,omitempty"`
-	ID         string                 `json:"id"`
-	EnqueuedAt int64                  `json:"t"`
-	Args       map[string]interface{} `json:"args"`
-	Unique     bool                   `json:"unique,omitempty"`
-	UniqueKey  string                 `json:"unique_key,omitempty"`
+	Name         string                 `json:"name,omitempty"`
+	ID           string                 `json:"id"`
+	EnqueuedAt   int64                  `json:"t"`
+	EnqueuedAtMs int64                  `json:"t_ms,omitempty"` // with EnqueuerOption.MillisecondEnqueuedAt; see EnqueuedAtTime
+	Args         map[string]interface{} `json:"args"`
+	Unique       bool                   `json:"unique,omitempty"`
+	UniqueKey    string                 `json:"unique_key,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	OnSuccess    *FollowUp              `json:"on_success,omitempty"`
+	OnFailure    *FollowUp              `json:"on_failure,omitempty"`
+	Deadline     int64                  `json:"deadline,omitempty"` // epoch seconds the job must run by; see EnqueueOptions
+	Generation   string                 `json:"gen,omitempty"`      // only pools of the same generation run the job; see EnqueuerOption
 
 	// Inputs when retrying
 	Fails    int64  `json:"fails,omitempty"` // number of times this job has failed
 	LastErr  string `json:"err,omitempty"`
 	FailedAt int64  `json:"failed_at,omitempty"`
 
-	rawJSON      []byte
-	dequeuedFrom []byte
-	inProgQueue  []byte
-	argError     error
-	observer     *observer
+	// Set when the job is buried
+	DeadReason DeadReason `json:"reason,omitempty"`
+
+	rawJSON       []byte
+	rawArgs       []byte // with WorkerPoolOptions.LazyArgs, the args not yet decoded into Args
+	gzipArgs      bool   // whether serialize compresses the args; see EnqueuerOption.CompressArgsOver
+	payload       []byte // set by Enqueuer.EnqueueRaw instead of Args; see RawPayload
+	dequeuedFrom  []byte
+	inProgQueue   []byte
+	argError      error
+	observer      *observer
+	workerPoolID  string
+	workerID      string
+	retryDisabled bool
+	retryIn       time.Duration // if set, overrides the backoff before the next retry; see RetryIn
+}
+
+// DeadReason is why a job was put on the dead queue. It's in DeadJob, and the web UI's dead jobs, as "reason".
+type DeadReason string
+
+const (
+	// DeadReasonMaxFails is for jobs that failed as many times as their MaxFails.
+	DeadReasonMaxFails DeadReason = "max_fails"
+	// DeadReasonFatal is for jobs that failed after calling DisableRetry.
+	DeadReasonFatal DeadReason = "fatal"
+	// DeadReasonDeadline is for jobs that missed their EnqueueOptions.Deadline.
+	DeadReasonDeadline DeadReason = "deadline"
+	// DeadReasonNoHandler is for jobs that no worker pool had a handler for.
+	DeadReasonNoHandler DeadReason = "no_handler"
+	// DeadReasonInvalidArgs is for jobs whose args failed JobOptions.Validate.
+	DeadReasonInvalidArgs DeadReason = "invalid_args"
+	// DeadReasonUndecodable is for jobs that couldn't be decoded, with DecodeErrorBury.
+	DeadReasonUndecodable DeadReason = "undecodable"
+	// DeadReasonEvicted is for jobs evicted from a full retry set, with RetryEvictionBury.
+	DeadReasonEvicted DeadReason = "evicted"
+	// DeadReasonRetryPolicy is for jobs the pool's RetryPolicy decided not to retry.
+	DeadReasonRetryPolicy DeadReason = "retry_policy"
+)
+
+// FollowUp is a job to enqueue once another job is done with. See EnqueueOptions.
+type FollowUp struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// MaxJobNameLength is the longest job name that can be enqueued or registered.
+var MaxJobNameLength = 128
+
+// JobNamePattern is what job names must match to be enqueued or registered. By default, names may contain ASCII
+// letters, digits, and any of "_-.:/". Anything else, like whitespace or commas, would break the Redis keys and lists
+// job names are stored in, and their display in the web UI. It can be replaced to allow or forbid more.
+var JobNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.:/-]+$`)
+
+func validateJobName(name string) error {
+	if name == "" {
+		return fmt.Errorf("work: job name is empty")
+	}
+	if len(name) > MaxJobNameLength {
+		return fmt.Errorf("work: job name %q is longer than %d characters", name, MaxJobNameLength)
+	}
+	if !JobNamePattern.MatchString(name) {
+		return fmt.Errorf("work: job name %q doesn't match %s", name, JobNamePattern)
+	}
+	// A job's other keys are its queue's key plus a suffix, like "jobs:wat:lock", so a name like "wat:lock" would
+	// share its queue with wat's lock.
+	for _, part := range strings.Split(name, ":")[1:] {
+		if reservedJobKeySuffixes[part] {
+			return fmt.Errorf("work: job name %q is reserved: %q after a colon is used in the keys of other jobs", name, part)
+		}
+	}
+	return nil
+}
+
+// generationPattern is what EnqueuerOption.Generation and WorkerPoolOptions.Generation must match.
+var generationPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func validateGeneration(generation string) {
+	if generation != "" && !generationPattern.MatchString(generation) {
+		panic(fmt.Sprintf("work: generation %q doesn't match %s", generation, generationPattern))
+	}
+}
+
+// reservedJobKeySuffixes are what's appended to a job's queue key, after a colon, to make its other keys.
+var reservedJobKeySuffixes = map[string]bool{
+	"inprogress":      true,
+	"paused":          true,
+	"lock":            true,
+	"lock_info":       true,
+	"throughput":      true,
+	"dead":            true,
+	"max_concurrency": true,
+	"serial":          true,
+	"gen":             true,
 }
 
 // Q is a shortcut to easily specify arguments for jobs when enqueueing them.
@@ -39,24 +139,186 @@ func newJob(rawJSON, dequeuedFrom, inProgQueue []byte) (*Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	if job.Args == nil && (bytes.Contains(rawJSON, []byte(`"args_gz"`)) || bytes.Contains(rawJSON, []byte(`"payload"`))) {
+		var aux struct {
+			ArgsGzip []byte `json:"args_gz"`
+			Payload  []byte `json:"payload"`
+		}
+		if err := json.Unmarshal(rawJSON, &aux); err != nil {
+			return nil, err
+		}
+		if aux.ArgsGzip != nil {
+			rawArgs, err := gunzipArgs(aux.ArgsGzip)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(rawArgs, &job.Args); err != nil {
+				return nil, err
+			}
+			job.gzipArgs = true
+		}
+		job.payload = aux.Payload
+	}
 	job.rawJSON = rawJSON
 	job.dequeuedFrom = dequeuedFrom
 	job.inProgQueue = inProgQueue
 	return &job, nil
 }
 
+// newLazyJob is newJob without decoding the job's args, which are decoded by LoadArgs when needed.
+func newLazyJob(rawJSON, dequeuedFrom, inProgQueue []byte) (*Job, error) {
+	var job Job
+	aux := lazyJob{plainJob: (*plainJob)(&job)}
+	if err := json.Unmarshal(rawJSON, &aux); err != nil {
+		return nil, err
+	}
+	if len(aux.Args) > 0 && string(aux.Args) != "null" {
+		job.rawArgs = aux.Args
+	} else if aux.ArgsGzip != nil {
+		rawArgs, err := gunzipArgs(aux.ArgsGzip)
+		if err != nil {
+			return nil, err
+		}
+		job.rawArgs = rawArgs
+		job.gzipArgs = true
+	}
+	job.payload = aux.Payload
+	job.rawJSON = rawJSON
+	job.dequeuedFrom = dequeuedFrom
+	job.inProgQueue = inProgQueue
+	return &job, nil
+}
+
+// plainJob has Job's fields without its methods, so that lazyJob can embed it and replace its Args.
+type plainJob Job
+
+// lazyJob is how a Job with undecoded or compressed args is encoded and decoded: the outer Args hides the embedded
+// one. Compressed args are in ArgsGzip, with Args null. A raw payload is in Payload, base64 encoded.
+type lazyJob struct {
+	*plainJob
+	Args     json.RawMessage `json:"args"`
+	ArgsGzip []byte          `json:"args_gz,omitempty"`
+	Payload  []byte          `json:"payload,omitempty"`
+}
+
 func (j *Job) serialize() ([]byte, error) {
+	if j.gzipArgs {
+		rawArgs := j.rawArgs
+		if rawArgs == nil {
+			var err error
+			if rawArgs, err = json.Marshal(j.Args); err != nil {
+				return nil, err
+			}
+		}
+		gz, err := gzipArgs(rawArgs)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(lazyJob{plainJob: (*plainJob)(j), ArgsGzip: gz, Payload: j.payload})
+	}
+	if j.rawArgs != nil || j.payload != nil {
+		rawArgs := j.rawArgs
+		if rawArgs == nil {
+			var err error
+			if rawArgs, err = json.Marshal(j.Args); err != nil {
+				return nil, err
+			}
+		}
+		return json.Marshal(lazyJob{plainJob: (*plainJob)(j), Args: rawArgs, Payload: j.payload})
+	}
 	return json.Marshal(j)
 }
 
+func gzipArgs(rawArgs []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(rawArgs); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipArgs(gz []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// LoadArgs decodes the job's args into Args, if they haven't been already. It's only needed in pools with
+// WorkerPoolOptions.LazyArgs, where Args is nil until it's called; elsewhere, it does nothing.
+func (j *Job) LoadArgs() error {
+	if j.rawArgs == nil {
+		return nil
+	}
+	var args map[string]interface{}
+	if err := json.Unmarshal(j.rawArgs, &args); err != nil {
+		return err
+	}
+	j.Args = args
+	j.rawArgs = nil
+	return nil
+}
+
+// arg looks up a single arg. If the args haven't been decoded yet, only the arg's value is.
+func (j *Job) arg(key string) (interface{}, bool) {
+	if j.rawArgs == nil {
+		v, ok := j.Args[key]
+		return v, ok
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(j.rawArgs))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, false
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		if t == key {
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return nil, false
+			}
+			return v, true
+		}
+		var skipped json.RawMessage
+		if err := dec.Decode(&skipped); err != nil {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
 // setArg sets a single named argument on the job.
 func (j *Job) setArg(key string, val interface{}) {
+	j.LoadArgs()
 	if j.Args == nil {
 		j.Args = make(map[string]interface{})
 	}
 	j.Args[key] = val
 }
 
+func (j *Job) hasTag(tag string) bool {
+	for _, t := range j.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// pastDeadline returns whether the job has a deadline and it's passed.
+func (j *Job) pastDeadline() bool {
+	return j.Deadline > 0 && nowEpochSeconds() > j.Deadline
+}
+
 func (j *Job) failed(err error) {
 	j.Fails++
 	j.LastErr = err.Error()
@@ -70,11 +332,105 @@ func (j *Job) Checkin(msg string) {
 	}
 }
 
+// DisableRetry marks the running job as not safe to retry, eg because it has already had side effects that running it
+// again from the start would repeat. If the handler then returns an error, the job goes straight to the dead queue (or
+// is dropped, with SkipDead) however many retries it has left.
+func (j *Job) DisableRetry() {
+	j.retryDisabled = true
+}
+
+// RawPayload returns the bytes the job was enqueued with by Enqueuer.EnqueueRaw, or nil for jobs enqueued with args.
+func (j *Job) RawPayload() []byte {
+	return j.payload
+}
+
+// EnqueuedAtTime returns when the job was enqueued: to the millisecond if it was enqueued with
+// EnqueuerOption.MillisecondEnqueuedAt, and otherwise to the second. Jobs requeued from the retry, scheduled, or dead
+// sets are stamped again to the second, so they fall back to EnqueuedAt.
+func (j *Job) EnqueuedAtTime() time.Time {
+	if j.EnqueuedAtMs != 0 && j.EnqueuedAtMs/1000 == j.EnqueuedAt {
+		return time.Unix(0, j.EnqueuedAtMs*int64(time.Millisecond))
+	}
+	return time.Unix(j.EnqueuedAt, 0)
+}
+
+// RetryIn asks for the running job, if the handler then returns an error, to be retried after d rather than after the
+// job type's backoff, eg to honor a Retry-After header from a rate-limited service. It applies to this attempt only,
+// and is rounded up to the second. It has no effect if the job has no retries left, or after DisableRetry.
+func (j *Job) RetryIn(d time.Duration) {
+	j.retryIn = d
+}
+
+// WorkerPoolID returns the ID of the worker pool running the job. It's the same ID the pool's heartbeat is registered
+// under, and is empty if the job isn't being run by a worker.
+func (j *Job) WorkerPoolID() string {
+	return j.workerPoolID
+}
+
+// WorkerID returns the ID of the worker within its pool that is running the job, as listed in the pool's heartbeat.
+// It's empty if the job isn't being run by a worker.
+func (j *Job) WorkerID() string {
+	return j.workerID
+}
+
+// Clone starts a new job from j: it has j's name and a copy of its args, which the returned JobBuilder can change
+// before enqueueing it. It's for handlers enqueueing a job that follows on from the one they're running.
+// Example: job.Clone().SetArg("page", page+1).Enqueue()
+func (j *Job) Clone() *JobBuilder {
+	j.LoadArgs()
+	args := make(map[string]interface{}, len(j.Args))
+	for k, v := range j.Args {
+		args[k] = v
+	}
+	return &JobBuilder{name: j.Name, args: args, observer: j.observer}
+}
+
+// JobBuilder is a job derived from another with Job.Clone. Only the args map is copied, so args holding maps or
+// slices share them with the original job.
+type JobBuilder struct {
+	name     string
+	args     map[string]interface{}
+	observer *observer
+}
+
+// Name changes the name of the job, to derive a job of another kind.
+func (b *JobBuilder) Name(name string) *JobBuilder {
+	b.name = name
+	return b
+}
+
+// SetArg sets a single arg, replacing the one copied from the original job.
+func (b *JobBuilder) SetArg(key string, val interface{}) *JobBuilder {
+	b.args[key] = val
+	return b
+}
+
+// DeleteArg removes an arg copied from the original job.
+func (b *JobBuilder) DeleteArg(key string) *JobBuilder {
+	delete(b.args, key)
+	return b
+}
+
+// FollowUp returns the job as a FollowUp, to be enqueued only once another job is done with; see EnqueueOptions.
+func (b *JobBuilder) FollowUp() *FollowUp {
+	return &FollowUp{Name: b.name, Args: b.args}
+}
+
+// Enqueue enqueues the job right away, to the Redis and namespace that the original job was fetched from. It's only
+// for jobs cloned in a handler; others can pass b.FollowUp()'s Name and Args to an Enqueuer. Enqueuer middleware
+// doesn't run, as there's no Enqueuer involved.
+func (b *JobBuilder) Enqueue() (*Job, error) {
+	if b.observer == nil {
+		return nil, fmt.Errorf("work: can't enqueue %q: the job it was cloned from isn't being run by a worker", b.name)
+	}
+	return NewEnqueuer(b.observer.namespace, b.observer.pool).Enqueue(b.name, b.args)
+}
+
 // ArgString returns j.Args[key] typed to a string. If the key is missing or of the wrong type, it sets an argument error
 // on the job. This function is meant to be used in the body of a job handling function while extracting arguments,
 // followed by a single call to j.ArgError().
 func (j *Job) ArgString(key string) string {
-	v, ok := j.Args[key]
+	v, ok := j.arg(key)
 	if ok {
 		typedV, ok := v.(string)
 		if ok {
@@ -91,7 +447,7 @@ func (j *Job) ArgString(key string) string {
 // on the job. This function is meant to be used in the body of a job handling function while extracting arguments,
 // followed by a single call to j.ArgError().
 func (j *Job) ArgInt64(key string) int64 {
-	v, ok := j.Args[key]
+	v, ok := j.arg(key)
 	if ok {
 		rVal := reflect.ValueOf(v)
 		if isIntKind(rVal) {
@@ -119,7 +475,7 @@ func (j *Job) ArgInt64(key string) int64 {
 // on the job. This function is meant to be used in the body of a job handling function while extracting arguments,
 // followed by a single call to j.ArgError().
 func (j *Job) ArgFloat64(key string) float64 {
-	v, ok := j.Args[key]
+	v, ok := j.arg(key)
 	if ok {
 		rVal := reflect.ValueOf(v)
 		if isIntKind(rVal) {
@@ -140,7 +496,7 @@ func (j *Job) ArgFloat64(key string) float64 {
 // on the job. This function is meant to be used in the body of a job handling function while extracting arguments,
 // followed by a single call to j.ArgError().
 func (j *Job) ArgBool(key string) bool {
-	v, ok := j.Args[key]
+	v, ok := j.arg(key)
 	if ok {
 		typedV, ok := v.(bool)
 		if ok {
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"fmt"
 	"math"
 	"testing"
 
@@ -254,3 +255,72 @@ func TestJobArgumentExtractionBadFloat(t *testing.T) {
 		j.argError = nil
 	}
 }
+
+func TestJobLazyArgs(t *testing.T) {
+	job := &Job{Name: "wat", ID: "1", EnqueuedAt: 12345, Args: Q{"tenant_id": "t1", "n": 3, "ok": true, "nested": Q{"a": []interface{}{1, "b"}}}}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+
+	eager, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	lazy, err := newLazyJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, lazy.Args)
+	assert.Equal(t, "wat", lazy.Name)
+	assert.EqualValues(t, 12345, lazy.EnqueuedAt)
+
+	// Single args come out the same without decoding the rest
+	assert.Equal(t, "t1", lazy.ArgString("tenant_id"))
+	assert.EqualValues(t, 3, lazy.ArgInt64("n"))
+	assert.True(t, lazy.ArgBool("ok"))
+	assert.NoError(t, lazy.ArgError())
+	lazy.ArgString("missing")
+	assert.Error(t, lazy.ArgError())
+	assert.Nil(t, lazy.Args)
+
+	// It's stored unchanged while undecoded
+	reserialized, err := lazy.serialize()
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(rawJSON), string(reserialized))
+
+	assert.NoError(t, lazy.LoadArgs())
+	assert.Equal(t, eager.Args, lazy.Args)
+	assert.NoError(t, lazy.LoadArgs())
+	assert.Equal(t, eager.Args, lazy.Args)
+
+	noArgs, err := newLazyJob([]byte(`{"name":"wat","id":"2","t":1,"args":null}`), nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, noArgs.LoadArgs())
+	assert.Nil(t, noArgs.Args)
+	assert.Equal(t, "", noArgs.ArgString("tenant_id"))
+}
+
+func BenchmarkJobArgs(b *testing.B) {
+	args := Q{"tenant_id": "t1"}
+	for i := 0; i < 500; i++ {
+		args[fmt.Sprintf("field_%d", i)] = Q{"id": i, "name": "some longer string value", "tags": []string{"a", "b", "c"}}
+	}
+	rawJSON, err := (&Job{Name: "wat", ID: "1", Args: args}).serialize()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("eager", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			job, _ := newJob(rawJSON, nil, nil)
+			if job.ArgString("tenant_id") != "t1" {
+				b.Fatal("wrong tenant_id")
+			}
+		}
+	})
+	b.Run("lazy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			job, _ := newLazyJob(rawJSON, nil, nil)
+			if job.ArgString("tenant_id") != "t1" {
+				b.Fatal("wrong tenant_id")
+			}
+		}
+	})
+}
@@ -1,7 +1,18 @@
 package work
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// logOutput is where the package logs to. Tests swap it out to check what's logged.
+var logOutput io.Writer = os.Stdout
 
 func logError(key string, err error) {
-	fmt.Printf("ERROR: %s - %s\n", key, err.Error())
+	fmt.Fprintf(logOutput, "ERROR: %s - %s\n", key, err.Error())
+}
+
+func logWarning(key string, msg string) {
+	fmt.Fprintf(logOutput, "WARNING: %s - %s\n", key, msg)
 }
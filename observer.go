@@ -14,6 +14,9 @@ type observer struct {
 	workerID  string
 	pool      *redis.Pool
 
+	// how often observations are written to redis
+	flushInterval time.Duration
+
 	// nil: worker isn't doing anything that we know of
 	// not nil: the last started observation that we received on the channel.
 	// if we get an checkin, we'll just update the existing observation
@@ -49,8 +52,9 @@ type observation struct {
 	jobID   string
 
 	// These need to be set when starting a job
-	startedAt int64
-	arguments map[string]interface{}
+	startedAt    int64
+	arguments    map[string]interface{}
+	rawArguments []byte // JSON args of jobs whose args weren't decoded, instead of arguments
 
 	// If we're done w/ the job, err will indicate the success/failure of it
 	err error // nil: success. not nil: the error we got when running the job
@@ -62,11 +66,18 @@ type observation struct {
 
 const observerBufferSize = 1024
 
+// defaultObserverFlushInterval is how often observers write to redis unless the pool sets otherwise.
+const defaultObserverFlushInterval = time.Second
+
+// minObserverFlushInterval is the shortest flush interval allowed. Each busy worker writes to redis once per interval.
+const minObserverFlushInterval = 10 * time.Millisecond
+
 func newObserver(namespace string, pool *redis.Pool, workerID string) *observer {
 	return &observer{
 		namespace:        namespace,
 		workerID:         workerID,
 		pool:             pool,
+		flushInterval:    defaultObserverFlushInterval,
 		observationsChan: make(chan *observation, observerBufferSize),
 
 		stopChan:         make(chan struct{}),
@@ -101,6 +112,17 @@ func (o *observer) observeStarted(jobName, jobID string, arguments map[string]in
 	}
 }
 
+// observeStartedRaw is observeStarted for a job whose args are still JSON, as they are with LazyArgs.
+func (o *observer) observeStartedRaw(jobName, jobID string, rawArguments []byte) {
+	o.observationsChan <- &observation{
+		kind:         observationKindStarted,
+		jobName:      jobName,
+		jobID:        jobID,
+		startedAt:    nowEpochSeconds(),
+		rawArguments: rawArguments,
+	}
+}
+
 func (o *observer) observeDone(jobName, jobID string, err error) {
 	o.observationsChan <- &observation{
 		kind:    observationKindDone,
@@ -124,7 +146,7 @@ func (o *observer) loop() {
 	// Every tick we'll update redis if necessary
 	// We don't update it on every job because the only purpose of this data is for humans to inspect the system,
 	// and a fast worker could move onto new jobs every few ms.
-	ticker := time.Tick(1000 * time.Millisecond)
+	ticker := time.Tick(o.flushInterval)
 
 	for {
 		select {
@@ -202,7 +224,9 @@ func (o *observer) writeStatus(obv *observation) error {
 		// checkin_at -> obv.checkinAt
 
 		var argsJSON []byte
-		if len(obv.arguments) == 0 {
+		if obv.rawArguments != nil {
+			argsJSON = obv.rawArguments
+		} else if len(obv.arguments) == 0 {
 			argsJSON = []byte("")
 		} else {
 			var err error
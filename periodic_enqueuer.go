@@ -14,6 +14,9 @@ const (
 	periodicEnqueuerHorizon = 4 * time.Minute
 )
 
+// cronParser parses the specs given to PeriodicallyEnqueue and EnqueueAtCron. The seconds field is optional.
+var cronParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 type periodicEnqueuer struct {
 	namespace             string
 	pool                  *redis.Pool
@@ -2,15 +2,19 @@ package work
 
 import (
 	"math/rand"
+	"sort"
 )
 
 type prioritySampler struct {
 	sum     uint
 	samples []sampleItem
+	banded  bool
 }
 
 type sampleItem struct {
 	priority uint
+	band     int    // lower bands are always sorted ahead of higher ones
+	jobName  string // set by workers, to look the sample's job type back up when aging priorities
 
 	// payload:
 	redisJobs               string
@@ -22,8 +26,15 @@ type sampleItem struct {
 }
 
 func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency string) {
+	s.addInBand(0, priority, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency)
+}
+
+// addInBand adds a sample to the given band. Every sample in band 0 sorts ahead of every sample in band 1, and so on;
+// within a band, samples are ordered randomly by weight as usual.
+func (s *prioritySampler) addInBand(band int, priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency string) {
 	sample := sampleItem{
 		priority:                priority,
+		band:                    band,
 		redisJobs:               redisJobs,
 		redisJobsInProg:         redisJobsInProg,
 		redisJobsPaused:         redisJobsPaused,
@@ -33,10 +44,25 @@ func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJo
 	}
 	s.samples = append(s.samples, sample)
 	s.sum += priority
+	if band != 0 {
+		s.banded = true
+	}
 }
 
-// sample re-sorts s.samples, modifying it in-place. Higher weighted things will tend to go towards the beginning.
-// NOTE: as written currently makes 0 allocations.
+// reweigh recomputes the sum and whether there are bands after samples' priorities or bands were changed in place.
+func (s *prioritySampler) reweigh() {
+	s.sum = 0
+	s.banded = false
+	for _, sample := range s.samples {
+		s.sum += sample.priority
+		if sample.band != 0 {
+			s.banded = true
+		}
+	}
+}
+
+// sample re-sorts s.samples, modifying it in-place. Lower bands go first, and within a band higher weighted things will tend to go towards the beginning.
+// NOTE: as written currently makes 0 allocations when there's only one band.
 // NOTE2: this is an O(n^2 algorithm) that is:
 //
 //	5492ns for 50 jobs (50 is a large number of unique jobs in my experience)
@@ -44,9 +70,32 @@ func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJo
 //	~1ms for 1000 jobs
 //	~4ms for 2000 jobs
 func (s *prioritySampler) sample() []sampleItem {
-	lenSamples := len(s.samples)
+	if !s.banded {
+		weightedShuffle(s.samples, s.sum)
+		return s.samples
+	}
+
+	sort.SliceStable(s.samples, func(i, j int) bool { return s.samples[i].band < s.samples[j].band })
+	start := 0
+	for start < len(s.samples) {
+		end := start
+		sum := uint(0)
+		for end < len(s.samples) && s.samples[end].band == s.samples[start].band {
+			sum += s.samples[end].priority
+			end++
+		}
+		weightedShuffle(s.samples[start:end], sum)
+		start = end
+	}
+
+	return s.samples
+}
+
+// weightedShuffle sorts samples in-place so that higher weighted things tend to go towards the beginning. sum is the total of their priorities.
+func weightedShuffle(samples []sampleItem, sum uint) {
+	lenSamples := len(samples)
 	remaining := lenSamples
-	sumRemaining := s.sum
+	sumRemaining := sum
 	lastValidIdx := 0
 
 	// Algorithm is as follows:
@@ -61,10 +110,10 @@ func (s *prioritySampler) sample() []sampleItem {
 
 		prevSum := uint(0)
 		for i := lenSamples - 1; i >= lastValidIdx; i-- {
-			sample := s.samples[i]
+			sample := samples[i]
 			if rn < (sample.priority + prevSum) {
 				// move the sample to the beginning
-				s.samples[i], s.samples[lastValidIdx] = s.samples[lastValidIdx], s.samples[i]
+				samples[i], samples[lastValidIdx] = samples[lastValidIdx], samples[i]
 
 				sumRemaining -= sample.priority
 				break
@@ -76,6 +125,4 @@ func (s *prioritySampler) sample() []sampleItem {
 		lastValidIdx++
 		remaining--
 	}
-
-	return s.samples
 }
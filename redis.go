@@ -4,8 +4,37 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
 )
 
+// getConnWithTimeout gets a conn from pool whose commands each fail if Redis takes longer than timeout to reply to
+// them, or a plain conn if timeout is 0.
+func getConnWithTimeout(pool *redis.Pool, timeout time.Duration) redis.Conn {
+	conn := pool.Get()
+	if timeout <= 0 {
+		return conn
+	}
+	return timeoutConn{Conn: conn, timeout: timeout}
+}
+
+// timeoutConn overrides the read timeout its conn was dialed with for each command sent through it, so that different
+// kinds of calls can share a pool but not its timeout. A command that times out leaves the conn broken, so the pool
+// closes it rather than reusing it.
+type timeoutConn struct {
+	redis.Conn
+	timeout time.Duration
+}
+
+func (c timeoutConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	return redis.DoWithTimeout(c.Conn, c.timeout, commandName, args...)
+}
+
+func (c timeoutConn) Receive() (interface{}, error) {
+	return redis.ReceiveWithTimeout(c.Conn, c.timeout)
+}
+
 func redisNamespacePrefix(namespace string) string {
 	l := len(namespace)
 	if (l > 0) && (namespace[l-1] != ':') {
@@ -28,10 +57,29 @@ func redisKeyJobs(namespace, jobName string) string {
 	return redisKeyJobsPrefix(namespace) + jobName
 }
 
+// redisKeyJobsGeneration is the queue of jobName's jobs that were enqueued with generation. Jobs without one are on
+// the job's plain queue.
+func redisKeyJobsGeneration(namespace, jobName, generation string) string {
+	if generation == "" {
+		return redisKeyJobs(namespace, jobName)
+	}
+	return redisKeyJobs(namespace, jobName) + ":gen:" + generation
+}
+
 func redisKeyJobsInProgress(namespace, poolID, jobName string) string {
 	return fmt.Sprintf("%s:%s:inprogress", redisKeyJobs(namespace, jobName), poolID)
 }
 
+// redisKeyPoolInProgress is the one in progress list shared by all job types of a pool that consolidates them.
+func redisKeyPoolInProgress(namespace, poolID string) string {
+	return fmt.Sprintf("%sinprogress:%s", redisNamespacePrefix(namespace), poolID)
+}
+
+// redisKeyPoolClaimedAt is a hash of the epoch seconds each of a pool's in progress jobs was fetched at, by job ID.
+func redisKeyPoolClaimedAt(namespace, poolID string) string {
+	return fmt.Sprintf("%sclaimed_at:%s", redisNamespacePrefix(namespace), poolID)
+}
+
 func redisKeyRetry(namespace string) string {
 	return redisNamespacePrefix(namespace) + "retry"
 }
@@ -44,6 +92,10 @@ func redisKeyScheduled(namespace string) string {
 	return redisNamespacePrefix(namespace) + "scheduled"
 }
 
+func redisKeyCompleted(namespace string) string {
+	return redisNamespacePrefix(namespace) + "completed"
+}
+
 func redisKeyWorkerObservation(namespace, workerID string) string {
 	return redisNamespacePrefix(namespace) + "worker:" + workerID
 }
@@ -68,6 +120,31 @@ func redisKeyJobsLockInfo(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":lock_info"
 }
 
+// redisKeyJobsThroughput is a hash of the number of jobName jobs finished in each second of the given minute.
+func redisKeyJobsThroughput(namespace, jobName string, minute int64) string {
+	return fmt.Sprintf("%s:throughput:%d", redisKeyJobs(namespace, jobName), minute)
+}
+
+// redisKeyFailureCategories is a hash of how many times jobs failed with errors in each ErrorClassifier category.
+func redisKeyFailureCategories(namespace string) string {
+	return redisNamespacePrefix(namespace) + "failure_categories"
+}
+
+// redisKeyCompletions is the pub/sub channel CompletionEvents are published to. It's a channel, not a key.
+func redisKeyCompletions(namespace string) string {
+	return redisNamespacePrefix(namespace) + "completions"
+}
+
+// redisKeyJobsDead is the dead set of a job with JobOptions.SeparateDeadSet.
+func redisKeyJobsDead(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":dead"
+}
+
+// redisKeyJobsSerialLock is held while a job with JobOptions.SerializeByArg runs with value as that arg.
+func redisKeyJobsSerialLock(namespace, jobName, value string) string {
+	return redisKeyJobs(namespace, jobName) + ":serial:" + value
+}
+
 func redisKeyJobsConcurrency(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":max_concurrency"
 }
@@ -90,6 +167,21 @@ func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (
 	return buf.String(), nil
 }
 
+// redisKeyUniqueJobHash is the unique key for a job whose args were hashed by an EnqueuerOption.UniqueKeyHasher.
+func redisKeyUniqueJobHash(namespace, jobName, hash string) string {
+	return redisNamespacePrefix(namespace) + "unique:" + jobName + ":" + hash
+}
+
+// redisKeyDebounce is the fence that Enqueuer.EnqueueDebounced sets for a job, hash being its args' JSON or hash.
+func redisKeyDebounce(namespace, jobName, hash string) string {
+	return redisNamespacePrefix(namespace) + "debounce:" + jobName + ":" + hash
+}
+
+// Holds the scheduled zset member for a unique job enqueued with EnqueueUniqueInEarliest, so that a later enqueue can find and reschedule it.
+func redisKeyUniqueJobScheduled(uniqueKey string) string {
+	return uniqueKey + ":scheduled"
+}
+
 func redisKeyLastPeriodicEnqueue(namespace string) string {
 	return redisNamespacePrefix(namespace) + "last_periodic_enqueue"
 }
@@ -153,6 +245,32 @@ for i=1,keylen,%d do
 end
 return nil`, fetchKeysPerJobType)
 
+// redisLuaGenerationQueue is put in front of the scripts that move jobs back onto their queues, so that a job
+// enqueued with a generation goes back onto its generation's queue rather than the job's plain one.
+var redisLuaGenerationQueue = `
+local function generationQueue(queue, j)
+  if type(j) == 'table' and type(j['gen']) == 'string' and j['gen'] ~= '' then
+    return queue .. ':gen:' .. j['gen']
+  end
+  return queue
+end
+`
+
+// Used by WorkerPool.Start to make sure no other live pool has the ID it was given
+//
+// KEYS[1] = the pool's heartbeat hash
+// ARGV[1] = current time in epoch seconds
+// ARGV[2] = how many seconds after its last heartbeat a pool is dead
+// Returns: 1 if the ID was free and is now claimed, or 0 if a live pool has it
+var redisLuaClaimWorkerPoolID = `
+local at = tonumber(redis.call('hget', KEYS[1], 'heartbeat_at'))
+if at and at + tonumber(ARGV[2]) > tonumber(ARGV[1]) then
+  return 0
+end
+redis.call('hset', KEYS[1], 'heartbeat_at', ARGV[1])
+return 1
+`
+
 // Used by the reaper to re-enqueue jobs that were in progress
 //
 // KEYS[1] = the 1st job's in progress queue
@@ -163,7 +281,7 @@ return nil`, fetchKeysPerJobType)
 // KEYS[N] = the last job's in progress queue
 // KEYS[N+1] = the last job's job queue
 // ARGV[1] = workerPoolID for job queue
-var redisLuaReenqueueJob = fmt.Sprintf(`
+var redisLuaReenqueueJob = redisLuaGenerationQueue + fmt.Sprintf(`
 local function releaseLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('decr', lockKey)
   redis.call('hincrby', lockInfoKey, workerPoolID, -1)
@@ -178,14 +296,132 @@ for i=1,keylen,%d do
   jobQueue = KEYS[i+1]
   lockKey = KEYS[i+2]
   lockInfoKey = KEYS[i+3]
-  res = redis.call('rpoplpush', inProgQueue, jobQueue)
+  res = redis.call('rpop', inProgQueue)
   if res then
+    local ok, j = pcall(cjson.decode, res)
+    jobQueue = generationQueue(jobQueue, ok and j)
+    redis.call('lpush', jobQueue, res)
     releaseLock(lockKey, lockInfoKey, workerPoolID)
     return {res, inProgQueue, jobQueue}
   end
 end
 return nil`, requeueKeysPerJob)
 
+// Used by the reaper to re-enqueue jobs that were in progress in a pool that consolidates its in progress lists.
+// Since the list holds jobs of every type, each job is decoded to find which queue and lock it belongs to.
+//
+// KEYS[1] = the pool's in progress list
+// KEYS[2] = zset of dead jobs, for jobs whose type the pool didn't know about or that don't decode
+// KEYS[3] = the 1st job's job queue
+// KEYS[4] = the 1st job's lock
+// KEYS[5] = the 1st job's lock info hash
+// ...
+// ARGV[1] = jobs prefix, eg, "work:jobs:"
+// ARGV[2] = workerPoolID for job queue
+// ARGV[3] = current time in epoch seconds
+// ARGV[4] = an ID for the dead job, if the job doesn't decode
+// Returns: the job that was re-enqueued or buried, or nil once the in progress list is empty
+var redisLuaReenqueuePoolJob = redisLuaGenerationQueue + `
+local res, ok, j, queue, buried
+res = redis.call('rpop', KEYS[1])
+if not res then
+  return nil
+end
+
+ok, j = pcall(cjson.decode, res)
+if not ok or type(j) ~= 'table' or type(j['name']) ~= 'string' then
+  -- nothing can run a job that doesn't decode, and there's no telling which lock it held, so bury it as is, the way
+  -- workers do with DecodeErrorBury
+  buried = cjson.encode({
+    id = ARGV[4],
+    t = tonumber(ARGV[3]),
+    args = {raw_json = res},
+    err = 'decoding job: ' .. (ok and 'not a job' or tostring(j)),
+    failed_at = tonumber(ARGV[3]),
+    reason = 'undecodable'
+  })
+  redis.call('zadd', KEYS[2], ARGV[3], buried)
+  return buried
+end
+
+queue = ARGV[1] .. j['name']
+for i=3,#KEYS,3 do
+  if KEYS[i] == queue then
+    redis.call('lpush', generationQueue(queue, j), res)
+    redis.call('decr', KEYS[i+1])
+    redis.call('hincrby', KEYS[i+2], ARGV[2], -1)
+    return res
+  end
+end
+
+j['err'] = 'unknown job when requeueing'
+j['reason'] = 'no_handler'
+j['failed_at'] = tonumber(ARGV[3])
+buried = cjson.encode(j)
+redis.call('zadd', KEYS[2], ARGV[3], buried)
+return buried
+`
+
+// Used by Client.RequeueInProgressByIDs to put only some of a pool's in progress jobs back on their queues.
+//
+// KEYS[1] = the pool's consolidated in progress list
+// KEYS[2] = the 1st job's in progress queue
+// KEYS[3] = the 1st job's job queue
+// KEYS[4] = the 1st job's lock
+// KEYS[5] = the 1st job's lock info hash
+// ...
+// ARGV[1] = workerPoolID for job queue
+// ARGV[2] = jobs prefix, eg, "work:jobs:"
+// ARGV[3...] = IDs of the jobs to requeue
+// Returns: the number of jobs requeued
+var redisLuaRequeueInProgressByIDs = redisLuaGenerationQueue + `
+local wanted = {}
+for i=3,#ARGV do
+  wanted[ARGV[i]] = true
+end
+
+local requeued = 0
+local function requeue(inProgQueue, res, j, keyIdx)
+  redis.call('lrem', inProgQueue, 1, res)
+  redis.call('lpush', generationQueue(KEYS[keyIdx], j), res)
+  redis.call('decr', KEYS[keyIdx+1])
+  redis.call('hincrby', KEYS[keyIdx+2], ARGV[1], -1)
+  wanted[j['id']] = nil
+  requeued = requeued + 1
+end
+
+local function wantedID(res)
+  local ok, j = pcall(cjson.decode, res)
+  if ok and type(j) == 'table' and type(j['id']) == 'string' and wanted[j['id']] then
+    return j
+  end
+  return nil
+end
+
+for i=2,#KEYS,4 do
+  for _, res in ipairs(redis.call('lrange', KEYS[i], 0, -1)) do
+    local j = wantedID(res)
+    if j then
+      requeue(KEYS[i], res, j, i+1)
+    end
+  end
+end
+
+for _, res in ipairs(redis.call('lrange', KEYS[1], 0, -1)) do
+  local j = wantedID(res)
+  if j and type(j['name']) == 'string' then
+    for i=3,#KEYS,4 do
+      if KEYS[i] == ARGV[2] .. j['name'] then
+        requeue(KEYS[1], res, j, i)
+        break
+      end
+    end
+  end
+end
+
+return requeued
+`
+
 // Used by the reaper to clean up stale locks
 //
 // KEYS[1] = the 1st job's lock
@@ -218,29 +454,124 @@ end
 return nil
 `
 
+// Used by workers to release the lock of an AtMostOnce job when its handler returns. The job was taken off the
+// in-progress list before it ran, so if its pool was stopped with StopAndRequeue or reaped in the meantime, its share
+// of the lock has already been cleared along with the pool's lock info, and mustn't be released twice.
+//
+// KEYS[1] = the job's lock
+// KEYS[2] = the job's lock info hash
+// ARGV[1] = the worker pool id
+var redisLuaReleaseConsumedLock = `
+local held = tonumber(redis.call('hget', KEYS[2], ARGV[1]))
+if held and held > 0 then
+  redis.call('decr', KEYS[1])
+  redis.call('hincrby', KEYS[2], ARGV[1], -1)
+end
+return nil
+`
+
+// Used by workers with WorkerPool.SetMaxRetryJobs to trim the retry set after adding to it.
+//
+// KEYS[1] = the retry set
+// KEYS[2] = the dead set
+// ARGV[1] = how many jobs the retry set may hold
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = "1" to bury the evicted jobs, "" to drop them
+// ARGV[4] = how many jobs the dead set may hold, or 0 for no cap
+// Returns: the number of jobs evicted
+var redisLuaTrimRetryCmd = `
+local excess = redis.call('zcard', KEYS[1]) - tonumber(ARGV[1])
+if excess <= 0 then
+  return 0
+end
+local evicted = redis.call('zrange', KEYS[1], -excess, -1)
+redis.call('zremrangebyrank', KEYS[1], -excess, -1)
+if ARGV[3] == "1" then
+  for _, res in ipairs(evicted) do
+    local ok, j = pcall(cjson.decode, res)
+    if ok and type(j) == 'table' then
+      j['reason'] = 'evicted'
+      res = cjson.encode(j)
+    end
+    redis.call('zadd', KEYS[2], ARGV[2], res)
+  end
+  local maxDead = tonumber(ARGV[4])
+  if maxDead > 0 then
+    redis.call('zremrangebyrank', KEYS[2], 0, -maxDead-1)
+  end
+end
+return excess
+`
+
+// KEYS[1] = a serial lock, eg work:jobs:charge:serial:42
+// ARGV[1] = the id of the worker that should be holding it
+// ARGV[2] = milliseconds to extend it by, or "" to release it
+// Returns 1 if the worker held the lock, 0 otherwise
+var redisLuaSerialLockCmd = `
+if redis.call('get', KEYS[1]) ~= ARGV[1] then
+  return 0
+end
+if ARGV[2] == "" then
+  redis.call('del', KEYS[1])
+else
+  redis.call('pexpire', KEYS[1], ARGV[2])
+end
+return 1
+`
+
 // KEYS[1] = zset of jobs (retry or scheduled), eg work:retry
 // KEYS[2] = zset of dead, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
 // KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
-var redisLuaZremLpushCmd = `
+// ARGV[3] = epoch seconds to hold back jobs named in ARGV[5...] until
+// ARGV[4] = JSON object of job name to priority, or "". If set, the highest priority of the first ARGV[5] due jobs is requeued rather than the first.
+// ARGV[5] = how many due jobs to choose between when ARGV[4] is set
+// ARGV[6...] = names of jobs that are being requeued too often and should be held back instead of requeued
+// Returns: {'ok', jobName}, {'held', jobName}, {'dead', ""} or nil if nothing is due
+var redisLuaZremLpushCmd = redisLuaGenerationQueue + `
 local res, j, queue
-res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
+if ARGV[4] ~= '' then
+  local priorities = cjson.decode(ARGV[4])
+  local due = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, tonumber(ARGV[5]))
+  local best, bestPriority = 0, -1
+  for i, raw in ipairs(due) do
+    -- A member that doesn't decode counts as priority 0, rather than failing every pass
+    local ok, j = pcall(cjson.decode, raw)
+    local priority = 0
+    if ok and type(j) == 'table' then
+      priority = priorities[j['name']] or 0
+    end
+    if priority > bestPriority then
+      best, bestPriority = i, priority
+    end
+  end
+  res = {due[best]}
+else
+  res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
+end
 if #res > 0 then
   j = cjson.decode(res[1])
+  for i = 6, #ARGV do
+    if ARGV[i] == j['name'] then
+      redis.call('zadd', KEYS[1], ARGV[3], res[1])
+      return {'held', j['name']}
+    end
+  end
   redis.call('zrem', KEYS[1], res[1])
   queue = ARGV[1] .. j['name']
   for _,v in pairs(KEYS) do
     if v == queue then
       j['t'] = tonumber(ARGV[2])
-      redis.call('lpush', queue, cjson.encode(j))
-      return 'ok'
+      redis.call('lpush', generationQueue(queue, j), cjson.encode(j))
+      return {'ok', j['name']}
     end
   end
   j['err'] = 'unknown job when requeueing'
+  j['reason'] = 'no_handler'
   j['failed_at'] = tonumber(ARGV[2])
   redis.call('zadd', KEYS[2], ARGV[2], cjson.encode(j))
-  return 'dead' -- put on dead queue
+  return {'dead', ''} -- put on dead queue
 end
 return nil
 `
@@ -268,6 +599,61 @@ end
 return {deletedCount, jobBytes}
 `
 
+// Used by Client.DeleteScheduledJobs to empty the scheduled set, along with the unique keys of the unique jobs in it
+//
+// KEYS[1] = zset of scheduled jobs, eg, work:scheduled
+// Returns: the number of jobs deleted
+var redisLuaDeleteAllScheduledCmd = `
+local jobs = redis.call('zrange', KEYS[1], 0, -1)
+for _, raw in ipairs(jobs) do
+  local ok, j = pcall(cjson.decode, raw)
+  if ok and type(j) == 'table' and j['unique'] and type(j['unique_key']) == 'string' then
+    redis.call('del', j['unique_key'], j['unique_key'] .. ':scheduled')
+  end
+end
+redis.call('del', KEYS[1])
+return #jobs
+`
+
+// KEYS[1] = zset of (scheduled|retry), eg, work:scheduled
+// ARGV[1] = the job as it's stored now
+// ARGV[2] = the job to replace it with
+// ARGV[3] = the z rank of the job, which the replacement keeps
+// Returns: 1 if the job was replaced, or 0 if it's no longer there, eg because the requeuer has moved it onto its queue
+var redisLuaReplaceZsetJobCmd = `
+if redis.call('zrem', KEYS[1], ARGV[1]) == 0 then
+  return 0
+end
+redis.call('zadd', KEYS[1], ARGV[3], ARGV[2])
+return 1
+`
+
+// KEYS[1] = zset of dead jobs, eg, work:dead
+// KEYS[2] = zset of scheduled jobs, eg, work:scheduled
+// ARGV[1] = died at. The z rank of the job.
+// ARGV[2] = job ID to reschedule
+// ARGV[3] = epoch seconds to run the job at
+// Returns: number of jobs rescheduled (typically 1 or 0)
+var redisLuaRescheduleSingleDeadCmd = `
+local jobs, i, j, rescheduledCount
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[1], ARGV[1])
+local jobCount = #jobs
+rescheduledCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['id'] == ARGV[2] then
+    redis.call('zrem', KEYS[1], jobs[i])
+    j['fails'] = nil
+    j['failed_at'] = nil
+    j['err'] = nil
+    j['reason'] = nil
+    redis.call('zadd', KEYS[2], ARGV[3], cjson.encode(j))
+    rescheduledCount = rescheduledCount + 1
+  end
+end
+return rescheduledCount
+`
+
 // KEYS[1] = zset of dead jobs, eg, work:dead
 // KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
@@ -275,7 +661,7 @@ return {deletedCount, jobBytes}
 // ARGV[3] = died at. The z rank of the job.
 // ARGV[4] = job ID to requeue
 // Returns: number of jobs requeued (typically 1 or 0)
-var redisLuaRequeueSingleDeadCmd = `
+var redisLuaRequeueSingleDeadCmd = redisLuaGenerationQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
 local jobCount = #jobs
@@ -292,7 +678,8 @@ for i=1,jobCount do
         j['fails'] = nil
         j['failed_at'] = nil
         j['err'] = nil
-        redis.call('lpush', queue, cjson.encode(j))
+        j['reason'] = nil
+        redis.call('lpush', generationQueue(queue, j), cjson.encode(j))
         requeuedCount = requeuedCount + 1
         found = true
         break
@@ -300,6 +687,7 @@ for i=1,jobCount do
     end
     if not found then
       j['err'] = 'unknown job when requeueing'
+      j['reason'] = 'no_handler'
       j['failed_at'] = tonumber(ARGV[2])
       redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
     end
@@ -308,13 +696,44 @@ end
 return requeuedCount
 `
 
+// KEYS[1] = zset of retry jobs, eg, work:retry
+// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = retry at. The z rank of the job.
+// ARGV[4] = job ID to requeue
+// Returns: number of jobs requeued (typically 1 or 0). Jobs of unknown names are left where they are.
+// Unlike requeueing a dead job, the job's fails are kept so that it still counts towards MaxFails.
+var redisLuaRequeueSingleRetryCmd = redisLuaGenerationQueue + `
+local jobs, i, j, queue, requeuedCount
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
+local jobCount = #jobs
+requeuedCount = 0
+for i=1,jobCount do
+  j = cjson.decode(jobs[i])
+  if j['id'] == ARGV[4] then
+    queue = ARGV[1] .. j['name']
+    for _,v in pairs(KEYS) do
+      if v == queue then
+        redis.call('zrem', KEYS[1], jobs[i])
+        j['t'] = tonumber(ARGV[2])
+        redis.call('lpush', generationQueue(queue, j), cjson.encode(j))
+        requeuedCount = requeuedCount + 1
+        break
+      end
+    end
+  end
+end
+return requeuedCount
+`
+
 // KEYS[1] = zset of dead jobs, eg work:dead
 // KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = max number of jobs to requeue
 // Returns: number of jobs requeued
-var redisLuaRequeueAllDeadCmd = `
+var redisLuaRequeueAllDeadCmd = redisLuaGenerationQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
 local jobCount = #jobs
@@ -330,7 +749,8 @@ for i=1,jobCount do
       j['fails'] = nil
       j['failed_at'] = nil
       j['err'] = nil
-      redis.call('lpush', queue, cjson.encode(j))
+      j['reason'] = nil
+      redis.call('lpush', generationQueue(queue, j), cjson.encode(j))
       requeuedCount = requeuedCount + 1
       found = true
       break
@@ -338,6 +758,7 @@ for i=1,jobCount do
   end
   if not found then
     j['err'] = 'unknown job when requeueing'
+    j['reason'] = 'no_handler'
     j['failed_at'] = tonumber(ARGV[2])
     redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
   end
@@ -357,6 +778,33 @@ end
 return 'dup'
 `
 
+// KEYS[1] = job queue to push onto
+// KEYS[2] = the job's debounce fence, holding when it's up in epoch milliseconds
+// ARGV[1] = job
+// ARGV[2] = current time in epoch milliseconds
+// ARGV[3] = the window in milliseconds
+var redisLuaEnqueueDebounced = `
+local now = tonumber(ARGV[2])
+local fencedUntil = tonumber(redis.call('get', KEYS[2]))
+if fencedUntil and fencedUntil > now then
+  return 'dup'
+end
+redis.call('set', KEYS[2], now + tonumber(ARGV[3]), 'PX', ARGV[3])
+redis.call('lpush', KEYS[1], ARGV[1])
+return 'ok'
+`
+
+// KEYS[1] = job queue to push onto
+// ARGV[1] = job
+// ARGV[2] = the most jobs the queue may hold
+var redisLuaEnqueueBounded = `
+if redis.call('llen', KEYS[1]) >= tonumber(ARGV[2]) then
+  return 'full'
+end
+redis.call('lpush', KEYS[1], ARGV[1])
+return 'ok'
+`
+
 // KEYS[1] = scheduled job queue
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
 // ARGV[1] = job
@@ -369,3 +817,57 @@ if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
 end
 return 'dup'
 `
+
+// KEYS[1] = scheduled job queue
+// KEYS[2] = Unique job's key. Test for existence and set if we push.
+// KEYS[3] = Unique job's scheduled member key. Holds the job as it sits in the scheduled queue.
+// ARGV[1] = job
+// ARGV[2] = updated job or just a 1 if arguments don't update
+// ARGV[3] = epoch seconds for job to be run at
+// Returns 'ok' if enqueued, 'dup' if a unique job already exists, or the existing job if it was moved up to ARGV[3].
+var redisLuaEnqueueUniqueInEarliest = `
+if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
+  redis.call('zadd', KEYS[1], ARGV[3], ARGV[1])
+  redis.call('set', KEYS[3], ARGV[1], 'EX', '86400')
+  return 'ok'
+end
+local member = redis.call('get', KEYS[3])
+if member then
+  local score = redis.call('zscore', KEYS[1], member)
+  if score and tonumber(score) > tonumber(ARGV[3]) then
+    redis.call('zadd', KEYS[1], ARGV[3], member)
+    return member
+  end
+end
+return 'dup'
+`
+
+// KEYS[1] = set of known jobs, eg, work:known_jobs
+// KEYS[2] = set of worker pool ids, eg, work:worker_pools
+// KEYS[3] = zset of retry jobs, eg, work:retry
+// KEYS[4] = zset of dead jobs, eg, work:dead
+// KEYS[5] = zset of scheduled jobs, eg, work:scheduled
+// ARGV[1] = jobs prefix, eg, "work:jobs:"
+// ARGV[2] = heartbeat prefix, eg, "work:worker_pools:"
+// ARGV[3] = worker observation prefix, eg, "work:worker:"
+// Returns {{name1, count1, name2, count2, ...}, pool count, busy worker count, retry count, dead count, scheduled count}
+var redisLuaSummaryCmd = `
+local names = redis.call('smembers', KEYS[1])
+table.sort(names)
+local queues = {}
+for i, name in ipairs(names) do
+  queues[#queues + 1] = name
+  queues[#queues + 1] = redis.call('llen', ARGV[1] .. name)
+end
+local poolIDs = redis.call('smembers', KEYS[2])
+local busy = 0
+for i, poolID in ipairs(poolIDs) do
+  local workerIDs = redis.call('hget', ARGV[2] .. poolID, 'worker_ids')
+  if workerIDs then
+    for workerID in string.gmatch(workerIDs, '[^,]+') do
+      busy = busy + redis.call('exists', ARGV[3] .. workerID)
+    end
+  end
+end
+return {queues, #poolIDs, busy, redis.call('zcard', KEYS[3]), redis.call('zcard', KEYS[4]), redis.call('zcard', KEYS[5])}
+`
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -14,6 +15,14 @@ type requeuer struct {
 	redisRequeueScript *redis.Script
 	redisRequeueArgs   []interface{}
 
+	// If > 0, jobs of any one name are requeued at most this many times per minute; the rest are held until the next minute.
+	maxRequeuesPerMinute int
+	requeueWindow        int64 // start of the current minute, in epoch seconds
+	requeueCounts        map[string]int
+
+	// If set, the highest priority job of those due is requeued first, looking at up to requeuePriorityWindow of them.
+	priorities map[string]uint
+
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 
@@ -21,6 +30,10 @@ type requeuer struct {
 	doneDrainingChan chan struct{}
 }
 
+// requeuePriorityWindow is how many due jobs the requeuer chooses between when it orders them by priority. It bounds
+// the work of each requeue when there's a large backlog of due jobs.
+const requeuePriorityWindow = 100
+
 func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames []string) *requeuer {
 	args := make([]interface{}, 0, len(jobNames)+2+2)
 	args = append(args, requeueKey)              // KEY[1]
@@ -29,7 +42,7 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 		args = append(args, redisKeyJobs(namespace, jobName)) // KEY[3, 4, ...]
 	}
 	args = append(args, redisKeyJobsPrefix(namespace)) // ARGV[1]
-	args = append(args, 0)                             // ARGV[2] -- NOTE: We're going to change this one on every call
+	// ARGV[2...] are filled in on every call
 
 	return &requeuer{
 		namespace: namespace,
@@ -37,6 +50,7 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 
 		redisRequeueScript: redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd),
 		redisRequeueArgs:   args,
+		requeueCounts:      make(map[string]int),
 
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
@@ -87,9 +101,21 @@ func (r *requeuer) process() bool {
 	conn := r.pool.Get()
 	defer conn.Close()
 
-	r.redisRequeueArgs[len(r.redisRequeueArgs)-1] = nowEpochSeconds()
+	now := nowEpochSeconds()
+	if window := now - now%60; window != r.requeueWindow {
+		r.requeueWindow = window
+		r.requeueCounts = make(map[string]int)
+	}
+
+	args := make([]interface{}, len(r.redisRequeueArgs), len(r.redisRequeueArgs)+4+len(r.requeueCounts))
+	copy(args, r.redisRequeueArgs)
+	args = append(args, now)                   // ARGV[2]
+	args = append(args, r.requeueWindow+60)    // ARGV[3]
+	args = append(args, r.prioritiesJSON())    // ARGV[4]
+	args = append(args, requeuePriorityWindow) // ARGV[5]
+	args = append(args, r.heldJobNames()...)   // ARGV[6...]
 
-	res, err := redis.String(r.redisRequeueScript.Do(conn, r.redisRequeueArgs...))
+	res, err := redis.Strings(r.redisRequeueScript.Do(conn, args...))
 	if err == redis.ErrNil {
 		return false
 	} else if err != nil {
@@ -97,14 +123,57 @@ func (r *requeuer) process() bool {
 		return false
 	}
 
-	if res == "" {
+	if len(res) != 2 {
 		return false
-	} else if res == "dead" {
+	}
+
+	switch res[0] {
+	case "dead":
 		logError("requeuer.process.dead", fmt.Errorf("no job name"))
 		return true
-	} else if res == "ok" {
+	case "held":
+		return true
+	case "ok":
+		r.requeueCounts[res[1]]++
+		if r.maxRequeuesPerMinute > 0 && r.requeueCounts[res[1]] == r.maxRequeuesPerMinute {
+			logError("requeuer.process.held", fmt.Errorf("%s was requeued %d times this minute, holding the rest until the next minute", res[1], r.maxRequeuesPerMinute))
+		}
 		return true
 	}
 
 	return false
 }
+
+// heldJobNames returns the names of jobs that have used up their requeues for the current minute.
+func (r *requeuer) heldJobNames() []interface{} {
+	if r.maxRequeuesPerMinute <= 0 {
+		return nil
+	}
+
+	var names []interface{}
+	for name, count := range r.requeueCounts {
+		if count >= r.maxRequeuesPerMinute {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// prioritiesJSON returns r.priorities for the requeue script, or "" if there's nothing to order by because every job
+// has the same priority.
+func (r *requeuer) prioritiesJSON() string {
+	distinct := make(map[uint]bool)
+	for _, priority := range r.priorities {
+		distinct[priority] = true
+	}
+	if len(distinct) < 2 {
+		return ""
+	}
+
+	b, err := json.Marshal(r.priorities)
+	if err != nil {
+		logError("requeuer.priorities_json", err)
+		return ""
+	}
+	return string(b)
+}
@@ -1,7 +1,9 @@
 package work
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -78,4 +80,145 @@ func TestRequeueUnknown(t *testing.T) {
 	assert.Equal(t, nowish, rank)
 	assert.Equal(t, nowish, job.FailedAt)
 	assert.Equal(t, "unknown job when requeueing", job.LastErr)
+	assert.Equal(t, DeadReasonNoHandler, job.DeadReason)
+}
+
+func TestRequeueMaxPerMinute(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400) // the start of a minute
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: now, Fails: 1, LastErr: "ohno"}
+	rawJSON, err := job.serialize()
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("ZADD", redisKeyRetry(ns), now, rawJSON)
+	assert.NoError(t, err)
+
+	re := newRequeuer(ns, pool, redisKeyRetry(ns), []string{"wat"})
+	re.maxRequeuesPerMinute = 5
+
+	// Simulate a job that fails instantly with no backoff: each time it's requeued, it goes straight back on the retry queue
+	requeues := 0
+	for i := 0; i < 50; i++ {
+		re.process()
+		raw, err := conn.Do("RPOP", redisKeyJobs(ns, "wat"))
+		assert.NoError(t, err)
+		if raw != nil {
+			requeues++
+			_, err = conn.Do("ZADD", redisKeyRetry(ns), now, raw)
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 5, requeues)
+	assert.False(t, re.process())
+
+	// The job is held until the next minute rather than dropped
+	score, held := jobOnZset(pool, redisKeyRetry(ns))
+	assert.EqualValues(t, now+60, score)
+	assert.Equal(t, job.ID, held.ID)
+
+	// Once the next minute starts it flows again
+	setNowEpochSecondsMock(now + 60)
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestRequeueWithFakeClock(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := time.Unix(1425263400, 0)
+	SetNowFunc(func() time.Time { return now })
+	defer SetNowFunc(nil)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueIn("wat", 60, nil)
+	assert.NoError(t, err)
+
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	assert.False(t, re.process())
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	now = now.Add(59 * time.Second)
+	assert.False(t, re.process())
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	now = now.Add(time.Second)
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, now.Unix(), j.EnqueuedAt)
+}
+
+func TestSetNowFuncWhileRunning(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	defer SetNowFunc(nil)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueIn("wat", 3600, nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	var ran atomic.Bool
+	wp.Job("wat", func(job *Job) error {
+		ran.Store(true)
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	// Jumping the clock an hour ahead while the requeuer is polling makes the job due
+	later := time.Now().Add(time.Hour + time.Minute)
+	SetNowFunc(func() time.Time { return later })
+	assert.Eventually(t, ran.Load, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRequeuePriority(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	// Both are due at the same second. Scheduled jobs with the same score sort by their JSON, which puts "a_low" first.
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueIn("a_low", 0, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("b_high", 0, nil)
+	assert.NoError(t, err)
+
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"a_low", "b_high"})
+	re.priorities = map[string]uint{"a_low": 1, "b_high": 10}
+
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "b_high")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "a_low")))
+
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "a_low")))
+	assert.False(t, re.process())
+
+	// A due member that doesn't decode doesn't hold up the others
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("ZADD", redisKeyScheduled(ns), now, "{not json")
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("b_high", -10, nil)
+	assert.NoError(t, err)
+	assert.True(t, re.process())
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "b_high")))
 }
@@ -3,11 +3,13 @@ package work
 import (
 	"fmt"
 	"reflect"
+	"runtime/debug"
 )
 
 // returns an error if the job fails, or there's a panic, or we couldn't reflect correctly.
 // if we return an error, it signals we want the job to be retried.
-func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
+// If the job panics and panicHandler is non-nil, it's called with the recovered value and stack before the panic is turned into an error.
+func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType, panicHandler PanicHandler) (returnCtx reflect.Value, returnError error) {
 	returnCtx = reflect.New(ctxType)
 	currentMiddleware := 0
 	maxMiddleware := len(middleware)
@@ -40,6 +42,9 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
+			if panicHandler != nil {
+				runPanicHandler(panicHandler, job, panicErr, debug.Stack())
+			}
 			// err turns out to be interface{}, of actual type "runtime.errorCString"
 			// Luckily, the err sprints nicely via fmt.
 			errorishError := fmt.Errorf("%v", panicErr)
@@ -52,3 +57,13 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 	return
 }
+
+// runPanicHandler calls the user's panic handler, making sure a panic in the handler itself can't take down the worker.
+func runPanicHandler(panicHandler PanicHandler, job *Job, recovered interface{}, stack []byte) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			logError("runJob.panic_handler", fmt.Errorf("%v", panicErr))
+		}
+	}()
+	panicHandler(job, recovered, stack)
+}
@@ -48,7 +48,7 @@ func TestRunBasicMiddleware(t *testing.T) {
 		Args: map[string]interface{}{"a": "foo"},
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(job, tstCtxType, middleware, jt, nil)
 	assert.NoError(t, err)
 	c := v.Interface().(*tstCtx)
 	assert.Equal(t, "mw1mw2mw3h1foo", c.String())
@@ -77,7 +77,7 @@ func TestRunHandlerError(t *testing.T) {
 		Name: "foo",
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(job, tstCtxType, middleware, jt, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "h1_err", err.Error())
 
@@ -108,7 +108,7 @@ func TestRunMwError(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(job, tstCtxType, middleware, jt, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "mw1_err", err.Error())
 }
@@ -137,7 +137,7 @@ func TestRunHandlerPanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(job, tstCtxType, middleware, jt, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
@@ -165,7 +165,63 @@ func TestRunMiddlewarePanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(job, tstCtxType, middleware, jt, nil)
+	assert.Error(t, err)
+	assert.Equal(t, "dayam", err.Error())
+}
+
+func TestRunHandlerPanicWithPanicHandler(t *testing.T) {
+	h1 := func(c *tstCtx, j *Job) error {
+		panic("dayam")
+	}
+
+	jt := &jobType{
+		Name:           "foo",
+		IsGeneric:      false,
+		DynamicHandler: reflect.ValueOf(h1),
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	var gotJob *Job
+	var gotRecovered interface{}
+	var gotStack []byte
+	panicHandler := func(j *Job, recovered interface{}, stack []byte) {
+		gotJob = j
+		gotRecovered = recovered
+		gotStack = stack
+	}
+
+	_, err := runJob(job, tstCtxType, nil, jt, panicHandler)
+	assert.Error(t, err)
+	assert.Equal(t, "dayam", err.Error())
+	assert.Equal(t, job, gotJob)
+	assert.Equal(t, "dayam", gotRecovered)
+	assert.NotEmpty(t, gotStack)
+}
+
+func TestRunPanicHandlerPanic(t *testing.T) {
+	h1 := func(c *tstCtx, j *Job) error {
+		panic("dayam")
+	}
+
+	jt := &jobType{
+		Name:           "foo",
+		IsGeneric:      false,
+		DynamicHandler: reflect.ValueOf(h1),
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	panicHandler := func(j *Job, recovered interface{}, stack []byte) {
+		panic("dayam again")
+	}
+
+	_, err := runJob(job, tstCtxType, nil, jt, panicHandler)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
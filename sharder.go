@@ -0,0 +1,73 @@
+package work
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// shardReplicas is how many points each namespace gets on the hash ring. More points spread keys more evenly.
+const shardReplicas = 160
+
+// Sharder spreads jobs across several namespaces by a shard key, using consistent hashing: a key always maps to the
+// same namespace, and adding or removing a namespace only moves the keys that mapped to it or now map to it. Every
+// service that shards the same way must use the same namespaces. A Sharder is safe for concurrent use.
+type Sharder struct {
+	enqueuers map[string]*Enqueuer
+	ring      []uint32
+	owners    map[uint32]string
+}
+
+// NewSharder creates a Sharder over namespaces, all of which live in pool. The order of namespaces doesn't matter.
+func NewSharder(namespaces []string, pool *redis.Pool) *Sharder {
+	if len(namespaces) == 0 {
+		panic("work: NewSharder needs at least one namespace")
+	}
+
+	s := &Sharder{
+		enqueuers: make(map[string]*Enqueuer, len(namespaces)),
+		owners:    make(map[uint32]string, len(namespaces)*shardReplicas),
+	}
+	for _, ns := range namespaces {
+		if _, ok := s.enqueuers[ns]; ok {
+			continue
+		}
+		s.enqueuers[ns] = NewEnqueuer(ns, pool)
+		for i := 0; i < shardReplicas; i++ {
+			point := shardHash(ns + "#" + strconv.Itoa(i))
+			// On the rare collision, let the lowest namespace win so the ring doesn't depend on their order
+			if owner, ok := s.owners[point]; !ok {
+				s.ring = append(s.ring, point)
+			} else if owner < ns {
+				continue
+			}
+			s.owners[point] = ns
+		}
+	}
+	sort.Slice(s.ring, func(i, j int) bool { return s.ring[i] < s.ring[j] })
+
+	return s
+}
+
+// Namespace returns the namespace that shardKey maps to.
+func (s *Sharder) Namespace(shardKey string) string {
+	h := shardHash(shardKey)
+	i := sort.Search(len(s.ring), func(i int) bool { return s.ring[i] >= h })
+	if i == len(s.ring) {
+		i = 0
+	}
+	return s.owners[s.ring[i]]
+}
+
+// Enqueuer returns the Enqueuer for the namespace that shardKey maps to.
+func (s *Sharder) Enqueuer(shardKey string) *Enqueuer {
+	return s.enqueuers[s.Namespace(shardKey)]
+}
+
+func shardHash(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
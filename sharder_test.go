@@ -0,0 +1,52 @@
+package work
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharder(t *testing.T) {
+	pool := newTestPool(t)
+	namespaces := []string{"work-0", "work-1", "work-2", "work-3"}
+	sharder := NewSharder(namespaces, pool)
+
+	// The same key always maps to the same namespace, whatever order the namespaces are given in
+	reordered := NewSharder([]string{"work-3", "work-1", "work-0", "work-2"}, pool)
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("user:%d", i)
+		ns := sharder.Namespace(key)
+		assert.Equal(t, ns, sharder.Namespace(key))
+		assert.Equal(t, ns, reordered.Namespace(key))
+		assert.Equal(t, ns, sharder.Enqueuer(key).Namespace)
+		counts[ns]++
+	}
+
+	// Roughly even: each namespace gets within 30% of its fair share
+	assert.Len(t, counts, len(namespaces))
+	for ns, n := range counts {
+		assert.InDelta(t, 2500, n, 750, ns)
+	}
+
+	// Adding a namespace only moves keys onto it
+	grown := NewSharder(append(namespaces, "work-4"), pool)
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("user:%d", i)
+		if ns := grown.Namespace(key); ns != "work-4" {
+			assert.Equal(t, sharder.Namespace(key), ns)
+		}
+	}
+
+	// Jobs land in the namespace the key maps to
+	ns := "work-2"
+	cleanKeyspace(ns, pool)
+	var key string
+	for i := 0; sharder.Namespace(key) != ns; i++ {
+		key = fmt.Sprintf("user:%d", i)
+	}
+	_, err := sharder.Enqueuer(key).Enqueue("wat", Q{"user": key})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
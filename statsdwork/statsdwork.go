@@ -0,0 +1,200 @@
+// Package statsdwork periodically reports the state of a work namespace to a StatsD server. It speaks the plain StatsD
+// line protocol over UDP, so it works with statsd, Datadog's agent, and anything else that accepts it, without pulling
+// a StatsD client into the core package.
+package statsdwork
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opendoor-labs/work"
+)
+
+// DefaultInterval is how often a Reporter polls Redis when Options.Interval is zero.
+const DefaultInterval = 10 * time.Second
+
+// maxPacketSize keeps each datagram under the usual Ethernet MTU so metrics aren't dropped by fragmentation.
+const maxPacketSize = 1432
+
+// Options configures a Reporter.
+type Options struct {
+	// Prefix is prepended to every metric name, for example "myapp.work.". A trailing dot is added if it's missing.
+	Prefix string
+	// Interval is how often to poll the client. Defaults to DefaultInterval.
+	Interval time.Duration
+	// OnError, if set, is called with any error from polling Redis or writing to the StatsD server. Errors don't
+	// stop the Reporter; it tries again on the next tick.
+	OnError func(error)
+}
+
+// Reporter polls a *work.Client and sends gauges to a StatsD server. Each report emits:
+//
+//	<prefix>queue.<job_name>.depth
+//	<prefix>workers.busy
+//	<prefix>worker_pools
+//	<prefix>retry.count
+//	<prefix>dead.count
+//	<prefix>scheduled.count
+type Reporter struct {
+	client   *work.Client
+	conn     net.Conn
+	prefix   string
+	interval time.Duration
+	onError  func(error)
+
+	mtx              sync.Mutex
+	started          bool
+	stopped          bool
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+// NewReporter creates a Reporter that reads from client and sends to the StatsD server at addr (host:port).
+func NewReporter(client *work.Client, addr string, opts Options) (*Reporter, error) {
+	if client == nil {
+		return nil, errors.New("statsdwork: client must not be nil")
+	}
+	if opts.Interval < 0 {
+		return nil, errors.New("statsdwork: interval must not be negative")
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := opts.Prefix
+	if prefix != "" && !strings.HasSuffix(prefix, ".") {
+		prefix += "."
+	}
+	interval := opts.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+
+	return &Reporter{
+		client:           client,
+		conn:             conn,
+		prefix:           prefix,
+		interval:         interval,
+		onError:          opts.OnError,
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}, nil
+}
+
+// Start reports immediately and then once per interval until Stop is called.
+func (r *Reporter) Start() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.started || r.stopped {
+		return
+	}
+	r.started = true
+	go r.loop()
+}
+
+// Stop stops reporting, waits for any report in flight, and closes the UDP socket. It's safe to call more than once,
+// and to call on a Reporter that was never started.
+func (r *Reporter) Stop() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.stopped {
+		return
+	}
+	r.stopped = true
+	if r.started {
+		close(r.stopChan)
+		<-r.doneStoppingChan
+	}
+	r.conn.Close()
+}
+
+func (r *Reporter) loop() {
+	defer close(r.doneStoppingChan)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.handleError(r.Report())
+
+		select {
+		case <-r.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Report polls the client once and sends the result. Start calls it on every tick; it's exported so callers can flush
+// on demand, for example just before shutting down.
+func (r *Reporter) Report() error {
+	summary, err := r.client.Summary()
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+	for _, q := range summary.Queues {
+		lines = append(lines, r.gauge("queue."+sanitize(q.JobName)+".depth", q.Count))
+	}
+	lines = append(lines,
+		r.gauge("workers.busy", summary.BusyWorkers),
+		r.gauge("worker_pools", summary.WorkerPools),
+		r.gauge("retry.count", summary.RetryCount),
+		r.gauge("dead.count", summary.DeadCount),
+		r.gauge("scheduled.count", summary.ScheduledCount),
+	)
+
+	return r.send(lines)
+}
+
+func (r *Reporter) gauge(name string, value int64) string {
+	return r.prefix + name + ":" + strconv.FormatInt(value, 10) + "|g"
+}
+
+// send packs lines into as few datagrams as fit under maxPacketSize, newline-separated as StatsD expects.
+func (r *Reporter) send(lines []string) error {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		if buf.Len() > 0 && buf.Len()+1+len(line) > maxPacketSize {
+			if _, err := r.conn.Write(buf.Bytes()); err != nil {
+				return err
+			}
+			buf.Reset()
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(line)
+	}
+	if buf.Len() > 0 {
+		if _, err := r.conn.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reporter) handleError(err error) {
+	if err != nil && r.onError != nil {
+		r.onError(err)
+	}
+}
+
+// sanitize replaces the characters that are part of the StatsD line syntax so a job name can't corrupt the packet.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', '@':
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,122 @@
+package statsdwork
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/opendoor-labs/work"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("send:email", nil)
+	assert.NoError(t, err)
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	r, err := NewReporter(work.NewClient(ns, pool), listener.LocalAddr().String(), Options{Prefix: "myapp.work", Interval: time.Hour})
+	assert.NoError(t, err)
+	r.Start()
+	defer r.Stop()
+
+	metrics := readMetrics(t, listener)
+	assert.Equal(t, "2|g", metrics["myapp.work.queue.wat.depth"])
+	assert.Equal(t, "1|g", metrics["myapp.work.queue.send_email.depth"])
+	assert.Equal(t, "0|g", metrics["myapp.work.workers.busy"])
+	assert.Equal(t, "0|g", metrics["myapp.work.worker_pools"])
+	assert.Equal(t, "0|g", metrics["myapp.work.retry.count"])
+	assert.Equal(t, "0|g", metrics["myapp.work.dead.count"])
+	assert.Equal(t, "0|g", metrics["myapp.work.scheduled.count"])
+}
+
+func TestReporterSplitsPackets(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	for i := 0; i < 100; i++ {
+		_, err := enqueuer.Enqueue("a_rather_long_job_name_to_fill_up_the_packet_"+string(rune('a'+i%26))+string(rune('a'+i/26)), nil)
+		assert.NoError(t, err)
+	}
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	r, err := NewReporter(work.NewClient(ns, pool), listener.LocalAddr().String(), Options{})
+	assert.NoError(t, err)
+	defer r.Stop()
+	assert.NoError(t, r.Report())
+
+	metrics := map[string]string{}
+	buf := make([]byte, 64*1024)
+	for len(metrics) < 105 {
+		assert.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := listener.ReadFrom(buf)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, n <= maxPacketSize)
+		parseMetrics(string(buf[:n]), metrics)
+	}
+	assert.Equal(t, "1|g", metrics["queue.a_rather_long_job_name_to_fill_up_the_packet_ab.depth"])
+}
+
+func TestNewReporterValidation(t *testing.T) {
+	_, err := NewReporter(nil, "127.0.0.1:8125", Options{})
+	assert.Error(t, err)
+
+	_, err = NewReporter(work.NewClient("work", newTestPool(t)), "127.0.0.1:8125", Options{Interval: -time.Second})
+	assert.Error(t, err)
+}
+
+func readMetrics(t *testing.T, listener net.PacketConn) map[string]string {
+	t.Helper()
+
+	assert.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 64*1024)
+	n, _, err := listener.ReadFrom(buf)
+	assert.NoError(t, err)
+
+	metrics := map[string]string{}
+	parseMetrics(string(buf[:n]), metrics)
+	return metrics
+}
+
+func parseMetrics(packet string, metrics map[string]string) {
+	for _, line := range strings.Split(packet, "\n") {
+		name, value, _ := strings.Cut(line, ":")
+		metrics[name] = value
+	}
+}
+
+func newTestPool(t testing.TB) *redis.Pool {
+	t.Helper()
+
+	s, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(s.Close)
+	return &redis.Pool{
+		MaxActive:   3,
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", s.Addr())
+		},
+		Wait: true,
+	}
+}
@@ -1,14 +1,47 @@
 package work
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 var nowMock int64
 
+// nowFunc holds the clock used for scheduling, retries, and reaping. Tests can swap it out with SetNowFunc. It's nil
+// until then, which means time.Now.
+var nowFunc atomic.Pointer[func() time.Time]
+
+// SetNowFunc replaces the clock the package uses to decide when scheduled and retried jobs are due and when worker
+// pools are dead, so that tests can drive those with a fake clock instead of sleeping. Passing nil restores time.Now.
+// It's meant for tests, but it's safe to call while worker pools or enqueuers are running.
+func SetNowFunc(f func() time.Time) {
+	if f == nil {
+		nowFunc.Store(nil)
+		return
+	}
+	nowFunc.Store(&f)
+}
+
+// nowTime returns the time according to the clock set with SetNowFunc.
+func nowTime() time.Time {
+	if f := nowFunc.Load(); f != nil {
+		return (*f)()
+	}
+	return time.Now()
+}
+
 func nowEpochSeconds() int64 {
 	if nowMock != 0 {
 		return nowMock
 	}
-	return time.Now().Unix()
+	return nowTime().Unix()
+}
+
+func nowEpochMillis() int64 {
+	if nowMock != 0 {
+		return nowMock * 1000
+	}
+	return nowTime().UnixMilli()
 }
 
 func setNowEpochSecondsMock(t int64) {
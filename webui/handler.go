@@ -14,20 +14,48 @@ import (
 // mux.Handle("/workerui/", http.StripPrefix("/workerui", handler))
 // ```
 func NewHandler(client *work.Client) *http.ServeMux {
-	ctx := context{client: client}
+	return NewHandlerWithOptions(client, HandlerOptions{})
+}
+
+// JSONCase is how the keys of JSON responses are cased.
+type JSONCase int
+
+const (
+	// SnakeCase keys look like "job_name". It's the default.
+	SnakeCase JSONCase = iota
+	// CamelCase keys look like "jobName". The keys of job args are left as they were enqueued.
+	CamelCase
+)
+
+// HandlerOptions can be passed to NewHandlerWithOptions.
+type HandlerOptions struct {
+	JSONCase JSONCase // The casing of keys in every JSON response. Defaults to SnakeCase
+}
+
+// NewHandlerWithOptions returns a handler like NewHandler does, but permits you to specify additional options such as
+// the casing of JSON keys.
+func NewHandlerWithOptions(client *work.Client, opts HandlerOptions) *http.ServeMux {
+	ctx := context{client: client, jsonCase: opts.JSONCase}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("GET /ping", ctx.ping)
 	mux.HandleFunc("GET /queues", ctx.queues)
 	mux.HandleFunc("GET /worker_pools", ctx.workerPools)
 	mux.HandleFunc("GET /busy_workers", ctx.busyWorkers)
+	mux.HandleFunc("GET /pool_stats", ctx.poolStats)
+	mux.HandleFunc("GET /summary", ctx.summary)
 	mux.HandleFunc("GET /retry_jobs", ctx.retryJobs)
 	mux.HandleFunc("GET /scheduled_jobs", ctx.scheduledJobs)
 	mux.HandleFunc("GET /dead_jobs", ctx.deadJobs)
+	mux.HandleFunc("GET /completed_jobs", ctx.completedJobs)
 	mux.HandleFunc("POST /delete_dead_job/{died_at}/{job_id}", ctx.deleteDeadJob)
 	mux.HandleFunc("POST /retry_dead_job/{died_at}/{job_id}", ctx.retryDeadJob)
+	mux.HandleFunc("POST /reschedule_dead_job/{died_at}/{job_id}", ctx.rescheduleDeadJob)
 	mux.HandleFunc("POST /delete_all_dead_jobs", ctx.deleteAllDeadJobs)
 	mux.HandleFunc("POST /retry_all_dead_jobs", ctx.retryAllDeadJobs)
+	mux.HandleFunc("POST /retry_dead_jobs", ctx.retryDeadJobs)
+	mux.HandleFunc("POST /run_retry_job_now/{retry_at}/{job_id}", ctx.runRetryJobNow)
+	mux.HandleFunc("POST /rerun_completed_job/{job_id}", ctx.rerunCompletedJob)
 	mux.HandleFunc("GET /", ctx.indexPage)
 	mux.HandleFunc("GET /work.js", ctx.workJS)
 
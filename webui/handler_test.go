@@ -12,6 +12,7 @@ import (
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/opendoor-labs/work"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -50,6 +51,83 @@ func TestNewHTTPHandler(t *testing.T) {
 	suite.Run(t, new(TestWebUIHandlerSuite))
 }
 
+func TestHandlerClientError(t *testing.T) {
+	brokenPool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return nil, fmt.Errorf("dial \"redis\": connection refused")
+		},
+	}
+	handler := NewHandler(work.NewClient("work", brokenPool))
+
+	for _, path := range []string{"/queues", "/worker_pools", "/busy_workers", "/summary", "/retry_jobs", "/dead_jobs"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, 500, rec.Code, path)
+		assert.Equal(t, "application/json; charset=utf-8", rec.Header().Get("Content-Type"), path)
+		var res map[string]string
+		if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res), path) {
+			assert.Equal(t, map[string]string{"error": `dial "redis": connection refused`}, res, path)
+		}
+	}
+}
+
+func TestHandlerCamelCase(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Stop()
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", work.Q{"user_id": 7})
+	assert.NoError(t, err)
+
+	handler := NewHandlerWithOptions(work.NewClient(ns, pool), HandlerOptions{JSONCase: CamelCase})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/queues", nil))
+	assert.Equal(t, 200, rec.Code)
+	var queues []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &queues))
+	if assert.Len(t, queues, 1) {
+		assert.Equal(t, "wat", queues[0]["jobName"])
+		assert.Contains(t, queues[0], "maxConcurrency")
+		assert.NotContains(t, queues[0], "job_name")
+	}
+
+	// Job args are left alone
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/dead_jobs", nil))
+	assert.Equal(t, 200, rec.Code)
+	var dead struct {
+		Jobs []struct {
+			DiedAt  int64                  `json:"diedAt"`
+			LastErr string                 `json:"err"`
+			Args    map[string]interface{} `json:"args"`
+		} `json:"jobs"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dead))
+	if assert.Len(t, dead.Jobs, 1) {
+		assert.True(t, dead.Jobs[0].DiedAt > 0)
+		assert.Equal(t, "ohno", dead.Jobs[0].LastErr)
+		assert.EqualValues(t, 7, dead.Jobs[0].Args["user_id"])
+	}
+
+	// Errors keep their envelope
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/delete_dead_job/1/nope", nil))
+	assert.Equal(t, 404, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error"`)
+}
+
 func (s *TestWebUIHandlerSuite) TestPing() {
 	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/ping", nil)
 	s.NoError(err)
@@ -206,6 +284,128 @@ func (s *TestWebUIHandlerSuite) TestBusyWorkers() {
 	}
 }
 
+func (s *TestWebUIHandlerSuite) TestBusyWorkersClearedOnStop() {
+	started := sync.WaitGroup{}
+	releaseWat := make(chan struct{})
+	releaseFoo := make(chan struct{})
+
+	handler := func(release chan struct{}) func(*work.Job) error {
+		return func(job *work.Job) error {
+			started.Done()
+			<-release
+			return nil
+		}
+	}
+
+	wp := work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool)
+	wp.Job("wat", handler(releaseWat))
+	wp.Start()
+
+	wp2 := work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool)
+	wp2.Job("foo", handler(releaseFoo))
+	wp2.Start()
+	defer wp2.Stop()
+
+	started.Add(2)
+	s.enqueuer.Enqueue("wat", nil)
+	s.enqueuer.Enqueue("foo", nil)
+	started.Wait()
+	time.Sleep(5 * time.Millisecond) // need to let obsever process
+
+	busyJobNames := func() []string {
+		req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/busy_workers", nil)
+		s.NoError(err)
+		resp, err := s.server.Client().Do(req)
+		s.NoError(err)
+		s.Equal(200, resp.StatusCode)
+		var res []struct {
+			JobName string `json:"job_name"`
+		}
+		s.NoError(json.NewDecoder(resp.Body).Decode(&res))
+
+		var names []string
+		for _, ob := range res {
+			names = append(names, ob.JobName)
+		}
+		return names
+	}
+	s.ElementsMatch([]string{"wat", "foo"}, busyJobNames())
+
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/worker_pools", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	var pools []struct {
+		JobNames  []string `json:"job_names"`
+		WorkerIDs []string `json:"worker_ids"`
+	}
+	s.NoError(json.NewDecoder(resp.Body).Decode(&pools))
+	workerIDs := map[string][]string{}
+	for _, p := range pools {
+		workerIDs[p.JobNames[0]] = p.WorkerIDs
+	}
+
+	// Stop the first pool while the second one is still busy
+	close(releaseWat)
+	wp.Stop()
+
+	// The other pool's busy worker is left alone
+	s.Equal([]string{"foo"}, busyJobNames())
+
+	conn := s.pool.Get()
+	defer conn.Close()
+	for name, wantExists := range map[string]bool{"wat": false, "foo": true} {
+		for _, id := range workerIDs[name] {
+			exists, err := redis.Bool(conn.Do("EXISTS", s.ns+":worker:"+id))
+			s.NoError(err)
+			s.Equal(wantExists, exists)
+		}
+	}
+	close(releaseFoo)
+}
+
+func (s *TestWebUIHandlerSuite) TestPoolStats() {
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/pool_stats", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+
+	var res map[string]int64
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.Contains(res, "active_count")
+	s.Contains(res, "idle_count")
+}
+
+func (s *TestWebUIHandlerSuite) TestSummary() {
+	// Registering the jobs with a pool makes them known
+	wp := work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool)
+	wp.Job("wat", func(job *work.Job) error { return nil })
+	wp.Job("foo", func(job *work.Job) error { return nil })
+	wp.Start()
+	wp.Stop()
+
+	s.enqueuer.Enqueue("wat", nil)
+	s.enqueuer.Enqueue("wat", nil)
+	s.enqueuer.EnqueueIn("foo", 60, nil)
+
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/summary", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+
+	var res work.Summary
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.Equal([]*work.QueueCount{{JobName: "foo", Count: 0}, {JobName: "wat", Count: 2}}, res.Queues)
+	s.EqualValues(1, res.ScheduledCount)
+	s.EqualValues(0, res.RetryCount)
+	s.EqualValues(0, res.DeadCount)
+	s.EqualValues(0, res.WorkerPools)
+}
+
 func (s *TestWebUIHandlerSuite) TestRetryJobs() {
 
 	enqueuer := s.enqueuer
@@ -245,6 +445,110 @@ func (s *TestWebUIHandlerSuite) TestRetryJobs() {
 	}
 }
 
+func (s *TestWebUIHandlerSuite) TestRunRetryJobNow() {
+
+	enqueuer := s.enqueuer
+	_, err := enqueuer.Enqueue("wat", nil)
+	s.Nil(err)
+
+	wp := work.NewWorkerPool(TestContext{}, 2, s.ns, s.pool)
+	wp.Job("wat", func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/retry_jobs", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			RetryAt int64  `json:"retry_at"`
+			ID      string `json:"id"`
+		} `json:"jobs"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.Equal(1, len(res.Jobs))
+	if len(res.Jobs) != 1 {
+		return
+	}
+	runNowPath := fmt.Sprintf(s.pathPrefix()+"/run_retry_job_now/%d/%s", res.Jobs[0].RetryAt, res.Jobs[0].ID)
+
+	req, err = http.NewRequest(http.MethodPost, runNowPath, nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+
+	// Make sure retry queue is empty
+	req, err = http.NewRequest(http.MethodGet, s.pathPrefix()+"/retry_jobs", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.EqualValues(0, res.Count)
+
+	// Make sure the "wat" queue has 1 item in it
+	req, err = http.NewRequest(http.MethodGet, s.pathPrefix()+"/queues", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var queueRes []struct {
+		JobName string `json:"job_name"`
+		Count   int64  `json:"count"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&queueRes)
+	s.NoError(err)
+	if s.Equal(1, len(queueRes)) {
+		s.Equal("wat", queueRes[0].JobName)
+		s.EqualValues(1, queueRes[0].Count)
+	}
+
+	// Running it again is a 404 since it's no longer in the retry queue
+	req, err = http.NewRequest(http.MethodPost, runNowPath, nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(404, resp.StatusCode)
+	var errRes map[string]string
+	err = json.NewDecoder(resp.Body).Decode(&errRes)
+	s.NoError(err)
+	s.Equal(map[string]string{"error": work.ErrNotRetried.Error()}, errRes)
+}
+
+func (s *TestWebUIHandlerSuite) TestScheduledJobsBetween() {
+	now := time.Now().Unix()
+	for _, secs := range []int64{10, 100, 1000} {
+		_, err := s.enqueuer.EnqueueIn("watter", secs, nil)
+		s.NoError(err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/scheduled_jobs?from=%d&to=%d", s.pathPrefix(), now+50, now+500), nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			RunAt int64 `json:"run_at"`
+		} `json:"jobs"`
+	}
+	s.NoError(json.NewDecoder(resp.Body).Decode(&res))
+	s.EqualValues(1, res.Count)
+	if s.Len(res.Jobs, 1) {
+		s.InDelta(now+100, res.Jobs[0].RunAt, 1)
+	}
+}
+
 func (s *TestWebUIHandlerSuite) TestScheduledJobs() {
 	enqueuer := s.enqueuer
 	_, err := enqueuer.EnqueueIn("watter", 1, nil)
@@ -300,6 +604,7 @@ func (s *TestWebUIHandlerSuite) TestDeadJobs() {
 			Name   string `json:"name"`
 			ID     string `json:"id"`
 			Fails  int64  `json:"fails"`
+			Reason string `json:"reason"`
 		} `json:"jobs"`
 	}
 	err = json.NewDecoder(resp.Body).Decode(&res)
@@ -313,6 +618,7 @@ func (s *TestWebUIHandlerSuite) TestDeadJobs() {
 		s.True(res.Jobs[0].DiedAt > 0)
 		s.Equal("wat", res.Jobs[0].Name)
 		s.EqualValues(1, res.Jobs[0].Fails)
+		s.Equal("max_fails", res.Jobs[0].Reason)
 
 		diedAt0, diedAt1 = res.Jobs[0].DiedAt, res.Jobs[1].DiedAt
 		id0, id1 = res.Jobs[0].ID, res.Jobs[1].ID
@@ -361,6 +667,62 @@ func (s *TestWebUIHandlerSuite) TestDeadJobs() {
 	}
 }
 
+func (s *TestWebUIHandlerSuite) TestDeadJobsForJob() {
+	_, err := s.enqueuer.Enqueue("wat", nil)
+	s.NoError(err)
+
+	wp := work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool)
+	wp.JobWithOptions("wat", work.JobOptions{MaxFails: 1, SeparateDeadSet: true}, func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	deadCount := func(query string) int64 {
+		req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/dead_jobs"+query, nil)
+		s.NoError(err)
+		resp, err := s.server.Client().Do(req)
+		s.NoError(err)
+		s.Equal(200, resp.StatusCode)
+		var res struct {
+			Count int64 `json:"count"`
+		}
+		s.NoError(json.NewDecoder(resp.Body).Decode(&res))
+		return res.Count
+	}
+
+	s.EqualValues(0, deadCount(""))
+	s.EqualValues(1, deadCount("?job_name=wat"))
+	s.EqualValues(0, deadCount("?job_name=foo"))
+
+	post := func(path string) int {
+		req, err := http.NewRequest(http.MethodPost, s.pathPrefix()+path, nil)
+		s.NoError(err)
+		resp, err := s.server.Client().Do(req)
+		s.NoError(err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// job_name picks the job's own dead set to retry and delete from too
+	jobs, _, err := work.NewClient(s.ns, s.pool).DeadJobsForJob("wat", 1)
+	s.NoError(err)
+	s.Require().Len(jobs, 1)
+	s.Equal(404, post(fmt.Sprintf("/retry_dead_job/%d/%s", jobs[0].DiedAt, jobs[0].ID)))
+	s.Equal(200, post(fmt.Sprintf("/retry_dead_job/%d/%s?job_name=wat", jobs[0].DiedAt, jobs[0].ID)))
+	s.EqualValues(0, deadCount("?job_name=wat"))
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	s.EqualValues(1, deadCount("?job_name=wat"))
+	s.Equal(200, post("/delete_all_dead_jobs"))
+	s.EqualValues(1, deadCount("?job_name=wat"))
+	s.Equal(200, post("/delete_all_dead_jobs?job_name=wat"))
+	s.EqualValues(0, deadCount("?job_name=wat"))
+}
+
 func (s *TestWebUIHandlerSuite) TestDeadJobsDeleteRetryAll() {
 
 	enqueuer := s.enqueuer
@@ -464,6 +826,221 @@ func (s *TestWebUIHandlerSuite) TestDeadJobsDeleteRetryAll() {
 	s.EqualValues(0, res.Count)
 }
 
+func (s *TestWebUIHandlerSuite) TestRetryDeadJobsWithLimit() {
+	enqueuer := s.enqueuer
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		s.NoError(err)
+	}
+
+	wp := work.NewWorkerPool(TestContext{}, 2, s.ns, s.pool)
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	req, err := http.NewRequest(http.MethodPost, s.pathPrefix()+"/retry_dead_jobs?limit=2", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var res map[string]int64
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.EqualValues(2, res["retried"])
+
+	// Missing limit is an error
+	req, err = http.NewRequest(http.MethodPost, s.pathPrefix()+"/retry_dead_jobs", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(400, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodGet, s.pathPrefix()+"/dead_jobs", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	var deadRes struct {
+		Count int64 `json:"count"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&deadRes)
+	s.NoError(err)
+	s.EqualValues(1, deadRes.Count)
+}
+
+func (s *TestWebUIHandlerSuite) TestRescheduleDeadJob() {
+	enqueuer := s.enqueuer
+	_, err := enqueuer.Enqueue("wat", nil)
+	s.NoError(err)
+
+	wp := work.NewWorkerPool(TestContext{}, 2, s.ns, s.pool)
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/dead_jobs", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	var deadRes struct {
+		Jobs []struct {
+			DiedAt int64  `json:"died_at"`
+			ID     string `json:"id"`
+		} `json:"jobs"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&deadRes)
+	s.NoError(err)
+	if !s.Equal(1, len(deadRes.Jobs)) {
+		return
+	}
+	path := fmt.Sprintf(s.pathPrefix()+"/reschedule_dead_job/%d/%s", deadRes.Jobs[0].DiedAt, deadRes.Jobs[0].ID)
+
+	// Missing delay is an error
+	req, err = http.NewRequest(http.MethodPost, path, nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(400, resp.StatusCode)
+
+	before := time.Now().Unix()
+	req, err = http.NewRequest(http.MethodPost, path+"?delay=300", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodGet, s.pathPrefix()+"/scheduled_jobs", nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	var scheduledRes struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			RunAt int64  `json:"run_at"`
+			ID    string `json:"id"`
+		} `json:"jobs"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&scheduledRes)
+	s.NoError(err)
+	s.EqualValues(1, scheduledRes.Count)
+	if s.Equal(1, len(scheduledRes.Jobs)) {
+		s.Equal(deadRes.Jobs[0].ID, scheduledRes.Jobs[0].ID)
+		s.True(scheduledRes.Jobs[0].RunAt >= before+300)
+		s.True(scheduledRes.Jobs[0].RunAt <= time.Now().Unix()+300)
+	}
+}
+
+func (s *TestWebUIHandlerSuite) TestCompletedJobs() {
+	_, err := s.enqueuer.Enqueue("wat", nil)
+	s.NoError(err)
+	_, err = s.enqueuer.Enqueue("wat", nil)
+	s.NoError(err)
+
+	wp := work.NewWorkerPoolWithOptions(TestContext{}, 1, s.ns, s.pool, work.WorkerPoolOptions{KeepCompletedJobs: 10})
+	wp.Job("wat", func(job *work.Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/completed_jobs?limit=1", nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var res []struct {
+		Name       string `json:"name"`
+		ID         string `json:"id"`
+		FinishedAt int64  `json:"finished_at"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	s.NoError(err)
+	s.Equal(1, len(res))
+	if len(res) == 1 {
+		s.Equal("wat", res[0].Name)
+		s.True(res[0].FinishedAt > 0)
+	}
+}
+
+func (s *TestWebUIHandlerSuite) TestRerunCompletedJob() {
+	completed, err := s.enqueuer.Enqueue("wat", work.Q{"a": 1})
+	s.NoError(err)
+
+	wp := work.NewWorkerPoolWithOptions(TestContext{}, 1, s.ns, s.pool, work.WorkerPoolOptions{KeepCompletedJobs: 1})
+	var ran []*work.Job
+	wp.Job("wat", func(job *work.Job) error {
+		ran = append(ran, job)
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	req, err := http.NewRequest(http.MethodPost, s.pathPrefix()+"/rerun_completed_job/"+completed.ID, nil)
+	s.NoError(err)
+	resp, err := s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(200, resp.StatusCode)
+	var res map[string]string
+	s.NoError(json.NewDecoder(resp.Body).Decode(&res))
+	s.NotEqual(completed.ID, res["job_id"])
+
+	queues, err := work.NewClient(s.ns, s.pool).Queues()
+	s.NoError(err)
+	if s.Len(queues, 1) {
+		s.EqualValues(1, queues[0].Count)
+	}
+
+	// Running the copy pushes the original out of the completed jobs
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	if s.Len(ran, 2) {
+		s.Equal(res["job_id"], ran[1].ID)
+		s.EqualValues(1, ran[1].ArgInt64("a"))
+	}
+
+	req, err = http.NewRequest(http.MethodPost, s.pathPrefix()+"/rerun_completed_job/"+completed.ID, nil)
+	s.NoError(err)
+	resp, err = s.server.Client().Do(req)
+	s.NoError(err)
+	s.Equal(404, resp.StatusCode)
+}
+
+func (s *TestWebUIHandlerSuite) TestBadParams() {
+	for _, tc := range []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/retry_jobs?page=nope"},
+		{http.MethodGet, "/scheduled_jobs?from=nope"},
+		{http.MethodGet, "/dead_jobs?page=-1"},
+		{http.MethodGet, "/completed_jobs?limit=nope"},
+		{http.MethodPost, "/delete_dead_job/nope/abc"},
+		{http.MethodPost, "/retry_dead_job/nope/abc"},
+		{http.MethodPost, "/reschedule_dead_job/12345/abc?delay=nope"},
+		{http.MethodPost, "/run_retry_job_now/nope/abc"},
+		{http.MethodPost, "/retry_dead_jobs?limit=nope"},
+	} {
+		req, err := http.NewRequest(tc.method, s.pathPrefix()+tc.path, nil)
+		s.NoError(err)
+		resp, err := s.server.Client().Do(req)
+		s.NoError(err)
+		s.Equal(400, resp.StatusCode, tc.path)
+		var errRes map[string]string
+		err = json.NewDecoder(resp.Body).Decode(&errRes)
+		s.NoError(err)
+		s.NotEmpty(errRes["error"], tc.path)
+		resp.Body.Close()
+	}
+}
+
 func (s *TestWebUIHandlerSuite) TestAssets() {
 	req, err := http.NewRequest(http.MethodGet, s.pathPrefix()+"/", nil)
 	s.NoError(err)
@@ -477,4 +1054,8 @@ func (s *TestWebUIHandlerSuite) TestAssets() {
 	s.NoError(err)
 	resp, err = s.server.Client().Do(req)
 	s.NoError(err)
+	bytes, err = io.ReadAll(resp.Body)
+	s.NoError(err)
+	// The bundle shows enqueue times to the millisecond where jobs have them
+	s.Contains(string(bytes), "t_ms")
 }
@@ -98,7 +98,7 @@ func indexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _workJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x0b\x77\xdb\x36\xb6\x28\xfc\x57\x24\x9e\x7c\x2c\x50\xc1\xb4\x94\xb4\x69\x4b\x05\xd5\xa4\x4e\x3a\x4d\x9b\x34\x99\x38\x69\x3b\xa3\x68\x7c\x61\x0a\x92\x58\xd3\x80\x0a\x42\x76\x5c\x49\xff\xfd\x5b\xd8\x00\x48\x90\xa2\x9d\xb4\x67\xee\x59\x73\xcf\x9a\xd5\xae\x58\xc4\xfb\xb9\xdf\x7b\xa3\xbf\xd8\x88\x4c\xe7\x52\x20\x8e\xb7\xfe\x77\x4f\x23\x85\xb7\xf9\x02\x89\xa9\x9a\x61\xc5\xf5\x46\x89\x9e\xf9\x9d\xf0\xf7\x6b\xa9\x74\x39\xbe\x62\xaa\x27\xa9\x49\xa2\xdb\x3c\x55\xa4\x48\xfb\x23\xe2\x32\xd3\xed\x7e\x3f\x76\x95\xb8\xa9\x94\xb1\xa2\x40\xd2\xd7\x25\x92\xd4\xbf\x35\x26\x32\x29\x68\x7f\x58\xa7\xed\x4d\xdb\x82\x6e\xf7\x63\x9d\x5c\x52\x4e\x74\x92\x51\x41\x74\x32\xa7\xf5\x50\x89\x20\x0a\x6f\x75\x22\xcd\x4f\xbc\xdb\xbd\x3c\xff\x95\x67\x3a\x99\xf3\x45\x2e\xf8\x2b\x25\xd7\x5c\xe9\x1b\x28\xb6\xcd\xa4\x58\xe4\xcb\x8d\x62\xe7\x05\x87\x41\x8a\xcd\x25\x77\x5f\x43\xb2\xe4\x3a\x55\x7b\xbc\x27\x3a\x11\x34\x5c\x0a\x3b\x08\x1e\xc7\x3c\x39\x3b\xe3\xe5\x0b\x39\xdf\x14\x7c\x52\x95\xc0\x5b\x3f\x41\xd3\x29\xdb\x14\x7a\x9f\x76\x64\x56\xeb\xa0\x93\x39\x12\x24\x62\x11\x11\x98\x08\xd3\x9d\x0c\xa7\xa3\xab\x2a\x6e\x26\x6b\x25\xb5\xd4\x37\x6b\x9e\xac\x58\xf9\xf2\x5a\xf8\x39\xd9\xb5\x34\x15\x4c\x1b\x6b\x1a\x45\x44\x23\x9d\x94\xf4\xb3\x11\xde\xa3\x69\xd8\x24\x11\x78\x1b\x6d\x4a\xde\x2b\xb5\xca\x33\x1d\x8d\xb9\x5f\x61\x2a\xd0\x67\xf7\xf1\x9e\xdc\x59\xda\x2c\x80\xa2\xcd\x22\x44\x11\x49\x18\x29\x48\x0e\xa7\xa3\xef\xd6\xa9\x1c\xe7\x0b\x74\x25\xf3\x79\x6f\x48\x29\xd5\xb8\xa4\x82\x5f\xf7\x9e\x2a\x25\x15\x8a\x5e\xe4\x22\x5f\xe4\x7c\xde\xe3\xef\x33\xbe\x86\xd3\x25\xb3\x6c\xa3\x14\x9f\x8f\x7b\xa6\x43\xbd\xe2\x3d\x21\xc5\xd1\xa5\x2f\x38\xe7\x57\x3d\x2e\xae\x72\x25\xc5\x25\x17\xba\xb7\x90\x0a\x0a\x2d\x36\x45\xd1\xe3\xa6\xd5\xde\x25\x2f\x4b\xb6\xe4\x3d\x26\xe6\x3d\x36\x9f\xe7\xa6\x59\x56\xf4\x56\xbc\x58\x2f\x36\x45\xef\x9a\x29\x91\x8b\x65\x99\x44\x78\xcc\x8b\x92\xc3\x28\x33\x3a\x0d\x26\x30\x23\x1b\x3a\x1c\x87\x23\xd5\x89\xe2\xeb\x82\x65\x1c\x1d\xff\x7f\xe5\xf1\x92\x1c\x6e\x67\x36\xdd\x0c\x06\xb3\x3d\xc6\xa4\x4c\x04\xbb\xe4\x34\x7a\x26\xae\x98\xca\x99\xd0\xbd\x9f\x72\x59\x30\x53\x3c\xda\xeb\x95\x92\xd7\xbd\x32\x59\x28\x76\xc9\xcb\x37\xf2\x95\x5c\xd3\x11\x29\xf7\xfb\x60\x07\xd4\xe1\xea\x37\xb6\xe7\x01\x46\x1d\x1b\x14\x16\x79\xd8\x5d\xa4\x63\x0f\x05\x1a\x62\x22\x4d\x95\xfb\xb8\xb1\x53\x0a\xdb\xa1\xba\x9d\xca\x14\x67\x9a\x1f\x29\xce\x32\x7d\x94\x15\xac\x2c\x7b\x99\xdc\x14\xf3\x9e\x90\xba\xb7\xc8\xc5\x1c\x76\xe1\xb5\xc9\xee\x49\x7b\x4c\x7b\xcf\x16\xbd\x1b\xb9\xe9\x31\xc5\x7b\x9b\x32\x17\xcb\x5e\x99\xa9\x7c\xad\x7b\x9a\x2d\x4b\xd2\xbb\x64\x17\xbc\x57\x6e\x94\xd9\x64\xa6\x5d\xd5\xbc\xec\x9d\x73\x53\xb4\x90\x6c\xce\xe7\xbd\x73\xbe\x90\x8a\xf7\x0e\x7b\x37\xbb\x67\x66\xc0\x28\x32\xbb\xa4\x92\x13\x79\xb9\x96\x82\x0b\x8d\x93\xcd\x7a\xce\x34\x57\xc1\x8a\x4a\x14\x14\x20\x2a\xc9\xcb\x9f\x58\x91\xcf\x9f\x16\xdc\x1c\x22\xc2\xfe\xe0\x79\xc7\xdb\x0f\x6c\x57\xa3\x72\x05\x38\x95\x81\x1d\x1e\x60\x6e\x8a\x82\x52\xbe\xdb\x6d\x12\xd6\x1a\x0e\xe2\x78\x5f\xd5\x91\x41\x1d\x53\x7f\xb7\x7b\xac\x14\xbb\x49\xf2\x12\xfe\x22\x8e\x0d\x14\xe2\x57\x5c\xdd\x20\x15\xd4\x63\x0d\xc0\xb1\x40\xdb\x3d\x01\xc0\x50\x15\x28\x3c\x20\xd3\x94\x27\x06\x98\x10\x41\x19\xd2\x1e\x62\x19\x98\x52\x12\x6e\x60\xcd\xba\x84\x93\x21\x92\x6c\x95\x17\x73\xc5\x85\xad\xa7\x68\x1e\xa4\x11\x81\xc7\x2a\x29\xb8\x58\xea\x55\x1c\xfb\x8c\xd7\x72\xa3\x79\x49\x15\x26\x73\x5e\x70\xcd\x7b\x75\x85\xbd\x5f\x88\x7a\x48\xb9\x1d\xb3\x85\xae\xd3\x99\x07\x8f\x66\x85\x4e\x5c\xad\x64\x21\xd5\x53\x96\xad\x10\x27\x21\x40\xce\x17\xa8\x73\x1d\x71\xbe\x40\x76\x76\x89\x3d\x42\x30\xa0\x6f\x95\xbc\x84\xe3\xe6\x0a\xfa\xf9\x7c\xb8\x24\x0c\x70\xac\xe2\x58\x24\xeb\x4d\xb9\x32\x2b\x6e\x40\x48\xcf\x7d\x9a\x35\xc5\x7b\x03\xc3\xab\x39\x95\xc1\xfe\x99\xbd\x9c\x70\x9a\x23\x8e\x53\x1e\xc7\xfd\xc3\x9d\x44\x9c\x4e\xf9\x0c\x13\xbe\x37\x88\x4d\x12\x9d\x30\x5a\x10\x9d\x9c\x53\x8b\x55\x33\x7b\x5f\x37\x54\x24\x02\x65\x98\x2c\xa8\x43\x09\xac\x2c\xf3\xa5\xd8\xed\x1a\x08\x5b\x2a\x64\x37\x78\x34\xd6\x8f\x98\x5a\x6e\xcc\x14\x4a\xb7\x49\x63\x3d\x18\xf8\xb5\xae\xf2\xa6\x7a\x36\xf6\xd5\x54\x2f\x17\x3d\x81\x3f\x0a\xe7\x18\x8c\x6b\x46\x6f\x50\x3e\x90\x05\xfb\x0a\xc9\x7d\xe0\x72\xe8\x00\x83\x1a\x4c\xaf\xdd\xf2\xbf\x62\x7a\x45\x75\xb2\x66\xaa\xf4\xbf\x97\x5c\xff\x6d\xc3\xd5\xcd\xa9\x56\xb9\x58\xfe\xc4\x8a\x0d\xec\x8f\xcb\x35\x0d\xae\xef\xc8\x67\xf3\x79\x3b\xf7\x8d\x84\x3c\x0b\xf2\x2a\x78\xf8\xb9\x81\x87\x28\x5c\xc8\x16\xa2\xdf\x23\x85\xc9\x5d\x0d\xb6\x27\x6c\x5b\x66\x88\x9b\x96\x55\xb2\x66\x7a\x65\x30\x04\xc9\xa9\x4a\x4a\xce\x54\xb6\x22\x25\x55\x66\x61\x57\xfe\xcc\x17\x68\xeb\x8b\xa5\x92\xd8\x42\x69\x3e\x40\x47\x23\x4a\x69\x9e\xe4\x62\xce\xdf\xbf\x5c\xa0\x68\x12\xe1\x49\x34\x89\xd2\x28\x8e\xf0\x40\x0f\x22\x1a\x0d\x04\x31\x0d\xa5\xa5\x25\x5c\xee\x5e\x96\x26\x95\xe1\x0e\x83\x19\xa7\xa2\xa2\x1e\xa7\xa4\xc2\x8f\x33\xa7\xe2\xd6\x71\x2a\x3f\x4e\x59\x21\x4b\x03\x9a\x5f\xf3\xe5\xd3\xf7\x6b\x14\xa1\xe9\x24\x9e\xe1\x08\x46\x39\x65\x47\xbf\x3f\x3e\xfa\xc7\xf0\xe8\xab\xd9\x00\xc5\x13\x1c\xe1\x83\x53\x62\x3b\x88\x26\x91\xa1\x1a\x26\x3a\x15\x7b\x6c\x27\x96\xdb\x89\xdd\x75\x1a\xee\x9c\x96\x9b\x89\xd9\x89\x4b\xa6\xb3\x55\x63\x90\x66\x8c\x76\x88\x28\x1c\x23\x8e\x30\xf6\x53\x96\x71\x2c\xa7\xa3\xd9\x9e\xb4\x29\x42\x03\x48\x6d\x8b\xc7\xff\x44\x2b\xad\xd7\xe5\x24\xc5\x93\x77\xc7\xef\x8e\xa7\xff\x7c\x77\x3c\xfb\xf4\xb8\x6a\xc2\x02\x7f\x3d\xe1\x29\x4f\xca\xcd\x79\x09\x53\x40\x7a\x3a\x9c\xb9\xcb\x69\x80\x08\x6b\x1c\xff\xc3\xce\x0c\x34\x21\xc2\x10\x79\xca\xfc\x63\x8a\x57\xc7\xe2\xbf\x22\x3c\x3e\x1a\xf5\x29\x65\x71\x8c\x94\xb9\x1d\x55\x2f\x0c\x13\xdd\x48\x18\x12\x86\x2d\x2e\x2d\xc2\x26\x26\x91\x1f\x2e\x34\x54\x18\xa0\xde\xa8\x57\x1c\x36\x54\x60\x4c\x22\xd8\xb0\x38\x46\x9a\x46\xc7\x11\x26\xf5\xf9\xd0\xfe\x7c\xb8\x13\xaa\xf6\x7b\x62\xba\x0c\x2e\x7c\x0b\xa6\x57\x48\x32\xb2\x5d\x44\x94\x1a\x10\x24\x17\x3d\xee\x19\x0f\x3e\xf6\x4b\x7f\xce\x4a\x0e\xc7\x55\x50\x5e\x9f\x5d\x03\xd5\xab\x1d\x07\xe0\xb5\x22\x8c\x22\xbd\xdb\x45\x11\x1e\x08\xbf\x23\x2a\x8e\xa3\x49\xd4\xa7\x54\xc5\x31\x62\x03\x83\xb1\xa4\xfd\x25\x31\x61\x1d\x40\xac\x82\x16\x9f\xe1\xf1\xc1\xe8\x14\xac\xfa\x74\xca\x93\x9c\x28\x12\x45\xb3\x19\x76\x4c\xd1\x76\x75\xa9\xd2\xfe\x70\x3f\x96\x89\x56\x4c\x94\x0b\xa9\x2e\x3d\x00\x12\xe8\xfe\x57\x18\x29\x22\x01\x93\xca\x8c\x15\xa5\x81\xa9\x35\x9d\xe1\x12\x3f\x44\xac\x74\xf3\x3b\x9a\x44\x35\x08\x8b\xc8\xf6\xca\xdc\x18\x33\x14\x03\xcb\x1c\xd2\x0f\x29\x1c\x8f\x06\x38\x6d\x63\x0e\xa2\xa9\xc7\x58\x44\xd0\xe1\x58\x3c\xe2\x63\x31\x18\x60\x3d\x15\xb3\x00\x95\x88\x59\xcd\xdf\xfc\x2a\x73\x81\xa2\x5e\x84\x3f\x84\x0e\x6a\x20\x3c\x36\x28\x4e\x7d\x68\xae\x9d\xb4\x15\xaf\x89\xf5\x69\xf2\xe9\x60\xf2\xcf\x7b\xdb\x3d\xc2\xbb\xe9\xbb\xd9\xbb\x77\xb3\xe3\x25\x89\xde\xbd\xbb\x17\x47\x6d\x22\xab\x46\x97\x51\x44\x0c\xfd\x41\xa4\xf9\x87\xb9\xed\x21\x05\x1d\x92\x9c\x1e\xa7\x1e\x30\x9c\xdd\x9b\xd5\x20\xe2\xec\xde\xec\x53\xbc\x7b\xf7\xe9\xbb\x4f\x77\xe6\x7f\xb4\x7b\x87\x77\xef\xde\x99\xbf\xef\xde\xe1\xe3\xe5\x98\xd1\x3c\xe1\xef\x79\x86\x38\x1e\x63\x66\x6f\x99\xbb\x55\xd2\xd2\x0e\x3c\x29\x8b\x3c\xe3\xa8\x20\x2e\x1b\x63\xa2\x07\x54\x75\x65\x60\xc2\xa6\xa3\xd9\x04\xe9\x01\x8d\xd0\xf4\x9f\xc7\x06\x40\x11\x47\x83\x98\x1c\x8c\xd3\xe8\xd3\x4f\xcd\x45\x64\xd3\xa1\x2f\x97\x7c\x5a\x17\x8a\xca\x75\xc1\x74\x04\xe5\x0e\x8a\x4d\x3a\xcb\xa1\xaa\x1c\x14\x9b\xa4\x51\x1a\xe1\x46\x1a\x36\x78\xe8\xdd\xbb\x66\x41\xf3\x6d\x52\xab\xa2\x06\x32\x40\xba\x01\xfa\xd2\x8f\x79\x38\xc3\xa4\xa0\x79\x52\xb0\x52\x3f\x33\x93\xac\x10\x4c\x9f\x52\x5e\xd3\x95\x07\x8b\xe5\xf3\x0e\x57\xab\xce\xb1\x40\x48\x73\x25\x52\x4e\x14\x5f\xf2\xf7\xeb\x53\xb9\x51\x99\x01\x49\x6b\xa6\xd8\xe5\x8f\x86\x17\x4b\x05\xd1\xf2\x82\x8b\x32\x95\xfb\x06\x1d\x5d\x53\xd1\x53\x3e\xdb\xed\x90\xf9\x03\xd0\x17\x13\xf3\xb3\x41\x52\x1b\x7c\x13\x1d\x47\x30\xe8\x6c\xc5\xd4\x63\x8d\x86\x96\xae\x8b\x8e\xa3\x01\xb7\x60\x20\x40\x46\xe1\x68\x00\xcf\xd6\xe3\x21\x05\x15\x89\x1d\xd1\xb8\xd5\xa4\x9f\xda\xd1\xc8\xb4\xad\x06\x34\x3a\x9e\x44\x98\xd8\xbd\x2c\xa6\x45\x95\x3d\x73\xd9\xf7\x1c\xb7\x94\x53\xdd\x81\xf4\xfe\x19\x0d\x14\x89\x72\x83\xe3\x2a\xa0\x9b\xe3\x00\x57\x41\xdd\x92\xe6\xd3\xe1\x8c\x64\x15\xcc\x47\x1e\x20\x40\xb5\x0c\x20\xb6\x1d\x68\xe9\x07\x5a\xd6\x03\x6d\xb4\x97\xc1\x72\x64\x8e\x4a\xdc\x2a\x7e\xc9\x72\xc3\x94\xbf\xf2\xb8\x22\x0b\x37\x46\xda\x0f\xc0\xf1\x65\x9a\xbb\x1d\x1e\xe1\xe4\x92\xad\x1b\x84\x9a\xbf\xff\x71\x3c\xe7\x99\x9c\xf3\xb7\xaf\x9f\x55\x5c\x9f\xe1\xaa\xf0\xbe\xc1\x6c\x54\x15\xcc\x76\x04\x2b\xdf\xa0\xde\xcd\x8e\x6a\xaa\x77\xbb\xed\xbe\x22\x8d\x83\x0d\xb4\x1b\x44\x24\x35\x00\x22\x8a\x48\x4e\x87\xa4\x34\x70\x23\xf3\x70\x63\xe1\x7f\xcc\xfd\x8f\x35\x1d\x92\x73\xea\xb9\xa5\xf1\xfa\xd1\xf9\x78\x30\x58\x1b\x5e\xc5\xee\x20\xca\xa8\x9a\xae\x67\x78\xb7\x73\xb7\x38\xc3\x73\xda\x64\x16\x74\x02\x37\x13\x4f\xdc\x8f\x69\x3e\x18\xcc\x52\xf7\x41\xcc\x1a\xf7\xe9\x7c\xb7\x93\x5f\x0f\x77\xbb\x0d\xc2\xa8\x3f\xc2\x3e\x35\x8e\x51\x31\xa0\x5c\xb8\x05\x42\x73\x6c\xe5\x20\x3d\xd3\x3f\xb2\xdd\x95\x53\x39\x33\xb3\x91\x03\x3a\xaa\x33\xb1\xcd\x04\xdc\xb7\xa2\x65\xb2\x96\x6b\x84\xc7\xf2\x88\x8e\x88\x9c\x94\x53\x79\x34\x9a\x0d\xe8\x2a\x2d\x06\x74\xb5\xaf\x2a\x39\x80\x90\xe1\xc2\x80\x8d\x68\x1c\x64\xe0\x3a\x03\x07\x19\x29\x24\xd7\xd7\xc7\x2c\xcc\x82\x66\x01\xa5\x31\xc2\x64\x4e\xf5\x74\x31\xbb\x63\xa6\x94\xce\xe1\x44\x4a\xbc\xb5\x43\xa3\x51\x54\x6d\xe0\x25\x55\x15\xa5\x93\x61\xb2\x34\x84\x38\x1c\xaa\x4b\xe2\xb7\x05\x93\x2b\x7a\x34\x22\x37\x74\x38\xbe\x79\xb4\xf4\x7b\x75\x33\x18\x60\xbf\x14\xcb\xe9\xcd\x0c\x6f\xaf\xe8\xcd\xf8\x5c\x71\x76\xb1\xbf\x0a\x87\xb0\xa6\x97\x83\xab\xa3\xd1\xde\x2e\x44\xbd\x3c\xd5\xba\xd7\x07\x73\x8e\xd3\xe2\x96\x8c\x71\xab\x76\x66\x4a\x66\x15\x25\xfa\x88\x06\x3d\x16\x35\xd6\x7b\x77\x3c\x30\x68\xee\x38\xc2\xc0\x47\x5a\x0e\x32\x07\x6e\xb2\xe6\x23\x47\x5d\x7c\xa4\xa5\xc5\x00\x06\xfc\x39\xd4\xdb\x92\x89\xf2\x74\xeb\x68\x8b\x94\xef\xf7\x87\xcc\x9e\xa1\x68\x4c\x23\xe5\x63\xc5\x9f\xfe\xb6\x61\x05\x70\x72\x4c\xf3\x30\xc1\x0e\xea\xb9\x2b\x5a\x25\x00\xf9\x1f\x72\x6f\x92\x46\x7e\x50\x35\x2d\x76\x7a\x73\x79\x2e\x8b\x38\x8e\x4a\xf8\xd1\xce\x48\x72\xcd\x15\xd3\x52\x4d\x3a\x00\x89\x27\x36\x03\xf1\x6d\x63\xaa\x77\x74\xc7\x93\x4c\x8a\x52\xab\x4d\xa6\xa5\xa2\x94\x56\xe9\x7d\xff\xbb\x66\xa9\x27\x7e\x6c\x69\xd5\x21\x61\xff\xfe\x9c\xbd\x41\x50\xe6\x14\xe5\x54\x19\x66\xa0\xb4\xbc\x73\x46\x91\x42\x25\x26\x02\x7d\x81\xe1\x84\xa1\xd1\x17\xe6\x80\xa1\xe6\xb6\x75\x2c\x28\x43\x1d\x87\x90\x70\xe0\xf6\x5a\x47\x20\xa0\x53\xbb\x69\xd4\xaf\x87\x71\x6c\x4f\x86\x61\x82\xaa\x45\x18\xce\x26\xe1\x47\x1a\x1d\x47\x44\x1f\x56\x1e\x75\x56\x1e\x85\x95\x47\xb3\x74\x93\xbc\x7a\xf9\x8a\x88\xc3\xea\xf7\x3b\xab\xdf\x0f\xab\xdf\x9f\xa5\x66\x7a\x86\x75\x3b\x60\x6d\x26\x68\x48\xb2\x9a\xf5\xc3\x20\x2e\x72\x77\x3e\xe0\xb6\x2b\x1e\x67\xb7\x33\xd3\x70\xbc\x95\x97\x28\x18\xfe\xc6\xb1\x59\xc0\xf4\xc0\x37\xdc\x2c\x53\xc4\xfc\x25\x0c\x96\x30\xd5\xe4\x82\xdf\xa4\x62\xdf\x64\x68\x1d\xfb\x3d\xb5\xb2\xdc\xde\x13\xa6\xf9\xcc\xc0\xe5\x83\x43\xa3\xa5\xe5\xc0\x9d\xf2\xc1\x70\xae\xf3\xc3\x4b\x5c\x01\x0b\x8e\x00\x92\xe4\x0b\xa4\x29\xa5\xc2\x51\x05\x7d\x2f\x7e\xa9\x15\x05\x93\x68\x23\x2c\x0b\x33\x8f\x52\x89\x34\x10\x19\xaa\x4f\x69\x2d\xa2\x16\xad\x32\x02\x7b\x2a\xa3\x3f\x32\xa5\xc3\xeb\x09\x8c\xdd\x90\xe4\x9e\xd5\xb1\x30\x33\xb2\xd3\x83\x7c\x18\xd4\x02\x69\x1c\xc7\x0b\x24\x70\x57\xf1\x96\xc4\x4e\x63\x7b\xfc\xaa\xbb\x7a\xc1\x6f\x4a\xa4\x0d\x25\x1b\x26\x88\x8a\xef\x67\xee\x84\x18\x2a\xad\xa2\x69\x99\x93\xde\x56\xab\xaf\x6a\x10\x83\xf4\x54\xcd\x88\xbd\x77\xd5\xcd\x6b\x0e\xc2\x8c\x54\xd7\xed\x8a\xaa\x5d\xdd\x6e\x57\x93\x46\xcb\xad\x66\xfb\xa3\xfd\xb8\x0b\x1e\x77\x2a\xa2\xb8\x99\x99\xd9\x25\xf3\xd7\x00\x3b\x7f\x16\x21\xcd\x7f\x98\x0c\x7b\x1a\x21\xd9\xfe\x34\x89\xe6\x40\x42\x92\xf9\x11\xc7\x73\x43\xb5\xc3\x91\x74\xe7\xe6\x83\x6c\x62\x88\x7b\x88\x3f\x50\x7c\xaa\x6b\x7d\x64\xad\x63\x7a\x14\x0d\xc4\xe0\x93\xaf\x7b\xe5\xaa\xd2\x57\xac\xd8\x15\xef\xb1\x5e\xf4\xc9\x40\x0f\x3e\x89\x7a\x6b\x25\xd7\x9f\x58\x54\xa9\x88\x48\xe6\x86\x57\x66\x51\x87\x8e\x87\xed\xb1\xcf\x3f\xef\xca\x2f\xea\xfc\x79\x57\x7e\xb9\xf7\x72\x00\x81\x1e\x60\xc2\x28\x32\xf8\x57\x62\x62\x8f\x0b\x92\x49\xb9\x62\x6b\x8e\xd1\xb6\xc8\x4b\xcd\x45\x2a\x13\xd3\x48\x92\x97\xaf\xf9\x6f\x9b\x5c\xf1\x39\x31\xec\x4f\x47\xb2\x43\xfd\x1d\x39\x4b\xd9\x99\xf8\x0d\xcb\x2e\x3a\x33\xbe\x95\xea\x9a\xa9\xf9\x61\xde\x3e\x18\xa6\x14\xfc\xe5\xe2\xcd\x8d\x19\xea\xd4\x16\x24\x32\xb1\x80\x6c\x86\xeb\xf3\xdf\x2a\xc9\x88\x4c\xec\x85\x9b\x19\xac\x71\x4b\x6b\xb6\x04\x91\x09\xb7\x32\xf7\x99\x41\x2d\x9d\x65\xf3\x7a\x40\xcc\xdc\x85\x97\x0b\x8c\x72\x3c\xfb\x63\x84\x4b\xc5\xf1\x50\xbe\xdb\x55\xa0\x85\x3b\xed\x97\x39\x47\xa6\x37\x77\x96\x1a\x38\xb9\x97\x31\x61\x4e\xd3\x39\xef\x19\xd8\xc7\xe7\xbd\xeb\x5c\xaf\x80\xc1\xe9\x49\xd5\xab\x41\x53\x75\xff\xdd\x70\x39\xde\xdb\x53\xe0\x9a\x5b\x72\x1d\xe0\x5d\x4b\x1d\x94\x35\x05\x70\x1b\x82\xae\xc1\x4c\x5d\x62\xed\xf2\x9e\x95\x4f\x2b\x55\x76\xa0\xaa\x0a\x8e\xa4\x56\x37\xa0\xa6\x6d\x4c\xa9\x06\x9f\x16\xad\x9a\xe9\x5b\xf0\x8e\x22\x76\x9e\x45\x00\x84\xf9\xf4\xf3\x19\x8d\xe6\x3c\x22\xd1\xe7\x01\x4a\x68\xce\x02\x18\x29\xc4\xb1\xe1\xec\xab\x46\x6b\xba\x65\xbb\x77\xa2\xa3\xd1\xd0\xc9\x8e\xa2\xb3\x68\xe0\x30\xc9\x42\xc9\xcb\x93\x15\x53\x27\x72\xce\x91\xc0\x33\x2a\x00\x9a\x0f\x47\xf7\x1f\x7c\xf6\xf9\xc3\x2f\xbe\xfc\xca\xf0\x98\x77\xf4\xaa\x6f\xe5\x0c\xb5\xe1\xd7\xb1\x13\x47\x45\xb8\x39\x5d\x45\xb7\x5e\x25\x6f\x26\x3b\xe7\x8b\xe5\x2a\xff\xf5\xa2\xb8\x14\x72\xfd\x9b\x2a\x75\x64\xb8\xab\x5c\x9b\x8a\x95\x1a\xaa\xd1\xc7\x94\xcf\x28\xdf\x63\xd2\x5d\xbb\x5e\x29\xc0\x0a\x8d\x75\x47\xdb\x3d\x51\xb8\x1e\xd8\x3e\x03\x26\xbd\x1a\x78\x7f\xb4\xdf\x23\x3c\x69\xd4\x49\x9b\x90\xb9\xe2\x4d\x49\x4e\x4a\x6a\x8e\x36\xc9\xe8\x68\x9c\x1d\x92\x87\x99\x21\x0f\x85\xbf\x52\x35\x4d\x92\xcd\x70\xb5\x43\x1b\x4b\x22\x32\x4f\x04\x6e\x0c\x3e\x2c\xa7\x1b\x43\x04\x6e\x66\x70\x0c\x24\xde\xe6\xd4\x20\xdd\xaa\xd2\x82\x0e\xc7\x8b\x47\xb9\xef\x67\x31\x18\xe0\xc2\x37\x90\x4f\x17\x33\xdb\x86\xf9\x65\x9a\x81\xbf\x78\xbf\xaf\x60\xe3\xbf\x9e\xdf\x08\x05\x7c\x66\x8d\xcc\x79\x47\xbc\x97\x8b\x52\x33\x91\x19\x62\x4b\xe3\xee\x8b\x7e\x62\xaf\xb6\x19\x7c\x8f\xf5\xac\xbe\x9b\x95\x3d\xd6\xab\x48\x8a\x43\x5d\xab\x35\x7a\xa8\x9b\x7b\xcd\x17\x5c\x71\x91\xf9\x36\xf5\x2a\x2f\x7b\x2b\x56\x8a\x4f\x0c\xcc\xe0\xa2\x97\x8b\x5c\xe7\xac\xc8\x4b\x3e\xef\x1d\xf5\xca\xcd\x9a\x2b\x84\x1b\x25\x2c\x5c\xa9\x00\x48\x5f\xef\x76\x9e\x64\xe9\x7b\x7a\x51\x87\x7c\x48\x9d\x3a\xe1\xa9\x3e\x10\x4d\x35\x88\xa2\xb0\x05\xcb\x47\x53\xdd\xbd\x1a\xa7\x66\x68\x3d\xfe\x7e\xad\x78\x59\x9a\xe6\x2e\x37\xa5\xee\xf1\x5c\xaf\xb8\x32\xe0\xcf\x83\xbc\x7a\x79\x08\xe0\xd9\x68\xe0\x7b\xc0\x63\x5e\x43\xa9\x16\xb3\xa9\x0d\xad\x52\x65\x82\x51\x8e\x67\x9c\x52\x27\xa6\xe6\x0d\xcb\x9c\x11\xb9\x56\xb9\xf6\x56\x3a\x4d\x1b\x9e\xe1\x7e\x8f\x89\x8e\x63\x7f\xbf\x4a\xae\x5f\xf9\xa6\x5f\x2e\x26\x9d\xa9\xb0\x36\xa9\x39\x47\x30\x8a\xb3\x33\xaa\xf1\xfe\x8f\x4a\xd1\x9d\x7c\x2d\x94\xa0\xd7\x34\x6f\xf3\x86\x02\xe0\xf3\x34\x1b\x80\x3f\xa7\x4e\xd0\x20\x34\x4f\xea\xb9\xd2\xf0\x63\xb7\xeb\x8f\x88\x4a\xc2\xf9\x1a\x26\x3a\x82\x31\x44\xb9\xb0\x6a\x87\xc4\xaf\x0d\xed\x0f\x3d\xc6\x3e\xb4\x7d\x52\x06\x08\x11\x55\x5f\xbf\x80\x4a\x14\x01\x9d\x28\xe2\x98\xa3\x70\x77\x04\x26\x0a\xd2\x88\xc2\x44\x1b\xa8\x04\x8c\xdf\xd0\x40\x1b\xe0\xfb\x0c\xc7\x77\xdf\x30\x7c\x0a\x6d\x0c\x2b\x70\x8b\x09\x59\xa0\x14\x37\xf7\x82\x68\x4c\x98\xfd\x85\x74\xbd\x11\x95\xf1\xd6\xb2\xb9\x61\x1a\xe3\x84\xad\xd7\xc5\x8d\xad\x52\xc1\x30\x5c\x11\xc9\x05\xd2\x84\x63\x92\x23\x4d\xa6\x5b\xc3\xe0\x44\x8a\x8b\x39\x57\x11\xb1\x5b\x76\xc0\x41\x9a\x23\x6f\xb8\x1c\x34\xe2\x0f\x3e\x35\xad\x5a\xd3\x87\x44\x97\x15\xfa\xce\x3c\x37\xe0\x4e\xae\x37\x0a\x88\x74\x7e\x69\x0e\xc3\x9c\x69\xfe\x26\xbf\xe4\xa9\xe1\x8a\x9e\x9d\xbe\x74\x98\x13\xef\x49\x2b\xc1\x09\x9b\x86\x64\xf4\x15\xae\xa5\x36\x47\x4e\x66\x53\xa5\x44\x6f\x22\x12\xf5\x22\x8c\xf7\xfb\x99\x59\x6c\x54\x0f\xa0\xb6\x76\x19\xcf\x61\xa0\xe6\xb6\x96\x74\xab\xcb\x74\x51\x15\x12\x9b\xcb\x73\xae\x42\x12\x2e\x50\xf5\xcc\xff\x03\x6e\xff\x03\x6e\xff\x03\x6e\xff\xb7\x81\x5b\x81\x3e\xff\x1c\x93\x35\x55\x68\x8e\xc9\x39\x3d\xfe\x27\x9a\xbe\x9b\x5b\x35\xe5\xe0\x78\xb6\xfd\x6c\x8f\x3f\x45\xcd\xa4\xfb\x7b\x4a\xf1\xae\x99\xf6\x60\x4f\x31\x9e\xdc\x3b\x26\xab\xff\x67\xe0\x77\x00\xb2\x99\x5a\x96\xb5\x26\xab\x32\x15\xb8\x15\x7c\x97\x6b\x26\x22\x50\x1a\x90\xc8\xfc\xeb\xd8\x9c\x16\xbb\x85\xa2\x35\xbb\x29\x24\x9b\x47\x38\x8e\xcf\x13\xcd\x4b\x8d\x78\xe2\xd2\x30\x36\xfc\x54\xf5\x49\xbf\x3f\x7d\xf9\xa3\x15\xe1\x21\xa6\xe5\x79\x58\x90\x8c\x5a\x9c\x00\xc7\xb5\x7e\x93\xd3\xba\xa4\x63\x02\x34\x0e\xda\xdd\x42\x9b\x67\x60\xe8\x9a\x46\xe6\xd2\xb3\xde\x15\x2b\xf2\x79\xcf\xf4\xe8\x17\xa7\x2a\x5f\x9d\xb7\xdb\xe6\xbe\xf6\xe9\x64\x5b\xaa\x2c\xe5\x04\x64\x8b\xfd\x11\xc9\x64\x51\xb0\x75\xc9\xe7\x69\xfd\xdb\xa2\xaf\xf2\xf1\x42\x73\xf5\x1c\x46\x9c\x3e\xb8\x4f\xe6\x79\xb9\x2e\xd8\xcd\x13\xa6\x19\x20\xa1\xb4\x3f\xda\xdf\x8d\xb1\x56\x21\xc6\x32\x9b\x15\xe0\x2c\x67\x3b\xda\x8d\xb3\x56\x7f\xcc\x98\x6c\xac\x93\x57\x6f\x4f\xbf\xa3\x91\xf9\x37\x22\x3a\x79\xfd\xf4\xd5\xf3\xc7\x27\x4f\x69\xe4\x7e\x98\xb4\x57\x2f\x5f\xd1\xe8\xd5\xcb\x57\xd1\x1f\x6e\x9b\xcd\xe7\x4f\xaf\xb8\xd0\xcf\x41\x56\xc3\xdb\xf6\xd0\x81\xb0\xac\x5d\x72\x72\x98\x04\x80\xa0\x3f\x32\x70\x91\x69\xcd\xb2\x15\xe4\xa2\x48\x8a\x68\x60\x1a\x33\xcb\xa0\xf8\xa5\xbc\xe2\x1f\xd9\x67\x47\xe1\x49\x67\x6a\xd0\xf3\x9c\xdf\xd2\x73\xb9\x59\x83\x00\xe1\xbb\xbc\xd4\x32\x94\xde\xfb\xdb\x77\x9d\x8b\xb9\xbc\x4e\x04\xbb\xca\x97\x4c\x4b\x95\x6c\x4a\xae\x1e\x2f\xb9\xd0\x0e\x97\x5a\xcb\x35\x5e\xdb\x17\x3d\x16\x73\x25\xf3\x79\xef\x7e\x62\xee\xd3\x6d\xb9\x9f\x25\xc3\x08\xef\x76\xed\xec\x17\xf2\x3c\x2f\x78\xef\x94\x2d\x98\xca\x6d\x81\x7e\xa3\xc0\xc9\x4a\xc9\x4b\xde\x95\xf3\x33\x8c\xb4\xec\xbd\x5a\x49\xc1\x23\x6c\x78\x52\x37\xf8\x95\x9d\x5c\x1c\x47\xeb\x4d\xb9\x3a\xd5\x4c\x03\x98\x6f\xe6\x36\x96\xe3\xaf\xf2\xe7\x5c\xaf\xe4\x46\xbf\xe6\xe6\xb6\xbd\x2d\x73\xb1\xfc\x8e\x95\x2b\x7a\x20\xfa\x83\x09\xdc\xbe\x48\xf5\xf0\xbe\xcd\x15\x5f\xc8\xf7\x51\xa3\x9f\x57\x72\x0d\x72\xd1\x97\xc2\xb4\x9e\xad\x98\x58\xf2\xff\x46\x1f\x6f\x54\x3e\xe7\x42\xdb\x3e\xf2\xf2\xe9\x7b\xad\x98\xe0\x72\x53\xf5\x03\x27\xa0\x4b\x45\x53\xcb\xc7\xac\xa0\xd6\x6d\xdc\x9d\xbd\x9d\xa8\xfc\xe5\xe9\x87\x2d\x7f\xfe\x43\x6f\xfe\x87\xde\xfc\x0f\xbd\xf9\xff\x20\xbd\xf9\x65\x40\x6e\xfe\xdb\x10\x8b\x56\xd9\x73\xba\x92\xd7\x07\x04\x63\x0d\x5d\x46\x20\xf3\xe7\xd4\xd2\x8e\x5a\x6a\x56\xbc\x62\x4b\xbe\xdb\xbd\x60\x7a\x95\xb0\xf3\x12\x05\x44\xe5\x9a\x2d\xf9\x11\xc7\x8f\xe8\x68\xbf\x27\x1f\xa0\x48\xf3\x05\x1a\xb6\x5b\x6d\xd8\x3f\xd5\xc6\x95\xd3\x19\xb1\x5a\xfc\x56\x71\x50\xe2\x6b\x4a\x5b\x23\x98\x70\x6b\x00\x77\x2b\x2d\x5b\xe4\x91\x1d\x9d\x26\x00\xd6\x7e\x34\xf4\x5c\x45\xe5\x25\x2c\xd3\xf9\x15\xdf\x93\x8f\xa1\x85\x35\xc6\x38\x85\xfe\xeb\xd5\x44\x1a\xff\x81\x21\xdc\xd1\x0f\x8b\xc8\x56\x8a\x93\x22\xcf\x2e\xd2\x60\x8e\xbf\x6e\x2e\xd7\x6f\x24\x02\x0f\xb6\xce\xee\xc1\xe4\xed\xbf\xb9\x08\xf3\xbc\x34\x37\x68\xfe\x71\xcb\x10\x25\x49\x84\xf1\x87\x85\x40\x9b\x22\x22\xdb\xae\xee\xd6\x6c\x99\x0b\xd0\xaa\xee\x09\xa8\x36\xed\xe9\xa9\xb6\x3a\x02\x47\xc3\x43\x6d\x21\x9c\xc2\x8c\xe7\x45\x78\x0c\xa1\xd6\x89\xdc\x08\x7d\x1c\x1e\x0d\xae\xe0\x8c\xdd\x49\x76\x9f\x87\x64\xb7\x39\x4c\x77\x89\x8a\x88\x6b\xf2\xce\x32\xf5\x60\xee\x2c\x66\x37\x35\x28\xd2\xd6\x2c\x06\x24\xfe\xf9\x1f\xd6\xfc\x06\x29\x70\xf3\xc0\x61\xb4\x32\x5f\x34\x14\x0b\xca\xe8\x74\x66\x00\x6b\xc6\x34\xb2\xda\xf3\x5a\x63\x06\xa2\x38\xab\xa5\x08\x20\x0b\x1e\x8b\x6e\x98\x13\x12\x1d\x96\x26\x28\xe2\x18\x95\xa6\xcf\x7e\x8e\x2d\x16\xc8\x0d\x7f\x60\xd2\xd9\x23\x1e\xc7\xe5\x18\x97\xb4\x3f\x22\xda\x76\x62\xdb\x1b\x0c\x88\x24\x0a\xf8\xcc\xdc\x64\x16\xe1\x78\x7b\x8d\xbe\x33\x3c\x66\x5f\x53\xd3\x50\x1c\xdb\xc9\x09\x84\xf1\x7e\x6f\x2d\x0f\x86\xa4\x30\xf5\xa1\x11\xe8\xc6\xdb\x1d\x8e\x25\xc2\x2d\x0a\xa9\xb5\x5a\x26\x45\xe1\x6d\xb1\xdb\x21\x3d\xf1\x2d\x6b\x8c\x53\xc4\xa6\x7c\x46\x15\x41\x05\x1d\x0c\x72\x4a\xa9\xc4\x71\x2c\x80\x9d\x26\x0c\x63\xaf\xbd\xf4\x56\xa8\x84\xd1\xe9\x6c\x6c\x61\x1e\x95\x15\x9c\xf3\xe5\x9d\x6b\x01\x0c\x72\x38\xe6\x1d\xaa\x33\x33\x2e\x0d\x0e\xb9\x2d\x63\x03\x24\xac\xd3\x9a\xf9\x4f\x27\x8c\x2a\x30\x64\x93\x1f\x69\x43\x3e\x02\xc7\x46\xb0\x22\x27\xcc\x22\xb1\x02\xbe\x19\x26\x39\x15\xe8\x33\xc0\x6d\x89\x40\xb9\xc1\x6e\xa0\x91\xdf\x58\x8d\x7c\x06\xe6\x4b\x43\x6c\xf0\x9c\x40\xf7\xef\x5b\x44\xf7\xd0\x20\xba\x7f\x7f\xab\xac\xf3\xff\x45\x56\x70\x2b\x5a\x22\x8c\xb6\x4e\xc4\x00\x70\x35\x02\xc7\x3c\x75\x22\x85\xe6\xef\x75\x44\x2e\xf3\xf7\xb9\x28\xd3\xa9\x53\x67\x2e\x12\x86\x51\xa4\xa0\x4c\x84\x67\xa4\x02\x79\xe9\xd6\x26\xa6\xd9\xa1\x8c\x81\x78\xb3\x97\xce\x4c\xa8\x57\xa6\x99\x35\x32\x68\xc0\x48\xa6\xd8\x65\xd9\x59\x29\xf3\x70\xb7\xb3\x62\x03\x75\xa4\xd9\x21\x40\x5c\x72\xfd\x24\x70\xc4\x3c\xc4\x0e\xdb\x66\x13\x45\xc2\x9a\xf8\x68\xbf\x27\xe0\x69\xe9\xd6\xe9\x83\x4b\x00\x5d\x9e\x04\x35\x3a\xba\x74\xb5\x03\xbc\x63\x53\x4c\x5f\x8d\xd1\x74\xda\x0c\x39\x69\xdc\xc4\xfc\x0d\xdb\x68\xa2\x51\xeb\xbd\x6e\x29\xab\x6e\x21\x1f\xd1\x01\x51\x44\x04\xad\x8d\x96\x88\xa2\xda\x0e\xa9\xf4\xde\x53\xec\xb2\x24\x39\xd5\x49\xbd\x1d\xa4\xf4\x85\x94\xb9\xf5\x86\x1a\x73\x03\xcc\xe3\x18\x65\x34\x4f\x14\x9f\x6f\x32\xfe\x3a\x5f\xae\x74\x68\x3c\x25\x49\x11\x58\x8b\x54\x70\x4e\x3b\xce\x40\x4d\x8b\x19\xc9\xbc\x56\x7d\x63\x8e\x61\x4e\x98\x81\x20\xdb\xea\x74\x09\x7f\x62\x98\x3d\x54\x69\x4e\xdc\x9a\x96\xf6\xc7\x2b\x77\xa0\xfc\x99\x53\x7b\x03\x57\x5d\x9b\xf3\x24\xc3\x06\x3e\x2f\x2a\x1f\x5a\xaa\x2b\xab\x69\x8d\x3d\x1c\x59\x19\x38\xa2\x3f\x0e\x8e\x68\xb2\x32\x70\x64\x31\x5d\xcd\xa8\x9e\xae\xac\x62\x3f\x30\x8a\xab\x6d\xed\x64\xc3\xd6\xee\x1c\x49\xec\x8c\xdf\x2e\x69\x60\x18\xbf\x34\x7d\xcb\x8f\xeb\x5b\x92\xa5\xe9\xfb\x72\xba\x9c\x51\xde\x3a\x05\x72\xba\x9c\x91\x35\x02\x42\x69\xb9\x27\x8b\x80\xfe\xba\xac\x40\x5d\xbb\x0e\x59\xe0\x3d\xc9\xb0\x37\xff\xa6\x99\xe1\xa8\xec\xdf\xe2\xd0\x39\x38\xc3\xbb\x9d\x74\xa6\xd3\xd9\x7e\x8f\xc7\x06\xc1\x7c\x48\xc0\xd8\x25\xa4\x88\xfe\xf2\x97\xcc\x5e\x99\xd3\xcd\x79\x99\xa9\xfc\x9c\xab\xe3\x68\xc0\x5b\xee\x45\x80\x19\x88\x20\xd2\xda\x66\x30\x2a\x07\xd1\x71\xe1\x64\x70\x65\x44\x0a\x48\xe0\x57\x5c\x58\x07\x98\x88\xe4\x90\x52\xfa\x46\xa3\xca\x7b\x90\x6e\x0f\xaf\x36\x02\x9b\x1a\x3d\x95\x33\x5a\x36\x48\x34\x7c\xd7\xc5\x86\x5b\x55\x85\xe3\x30\x2d\x70\xd3\xc2\xb6\x1e\x06\xdc\x54\x73\xb4\xab\x71\xd8\x94\x7c\xb6\x27\x86\x8f\xf0\xf7\xea\xe7\xbc\x28\x5e\x58\x4a\x30\xb0\x31\x32\x25\xd9\x0c\x98\x1c\xdb\x0c\x1d\xb6\xea\xbc\xe6\x19\xcf\xaf\xf8\x01\x94\x73\xe5\x07\x83\xa0\xfc\x93\x7c\xfe\x16\x5c\xfb\xbb\x01\xc3\xd8\x75\x77\x48\x61\xd4\x40\x48\x23\x3e\x2d\xc0\xea\x71\x4f\xc4\x34\x9f\x75\xf8\x55\x42\x53\xbe\xb8\x6b\xd1\x3a\x1d\xe1\xd0\xa4\x4f\x9b\x89\x69\xe8\x2e\x2f\x34\x57\x9d\xbd\xf5\x29\xe5\xb6\xaf\x96\x47\x51\xf7\x69\x60\xa5\x7e\x0d\x90\x8f\x5b\xe1\xb0\x3b\x08\xf6\x68\xac\x98\x98\x17\xdc\x6d\xa2\x5d\x07\x7f\x46\x36\xa2\xfb\x94\xdc\x76\x40\xdc\xa9\x78\x66\x05\x54\x20\xe9\xbc\xf5\x54\x00\xac\x75\x2d\x4d\xe5\x6c\x82\xdc\x31\x31\xb3\x6f\x66\x25\xf5\xa9\x21\x1c\xa7\xdb\x7d\x73\xeb\x3a\x4f\x47\x50\x3d\x8e\x91\x3b\x59\x07\x0d\x57\xb3\x43\xee\x58\x60\xfc\x91\xc7\xc8\x4e\xe5\xa0\x27\xcb\x56\x72\x0d\x53\x47\x1f\x3b\xa5\x76\xa7\x6f\xc5\x65\xe7\x89\xcf\x83\xb9\x20\x4c\xfc\xac\xac\x53\x85\x39\x77\xc5\xac\xed\xe9\x6a\x08\x22\x3b\x28\x33\xa2\x29\x9b\xb9\x23\x32\x6e\x8d\xd4\x6d\x22\x9b\x51\x83\x28\xed\xc9\x32\x80\x4b\x02\x65\xcc\x1c\xad\x0d\xb4\x6c\x0e\xb4\x2d\x18\xe6\xe7\x09\xb3\x96\xa5\x68\x5b\x5f\x63\x93\xd8\x36\xff\x0c\xee\xbd\xc9\x3e\x34\x20\xf8\x90\x6d\xe5\x5d\xd6\xb1\xb2\xb6\x8e\xbd\xcd\xba\xb6\xa2\xdd\x1f\x80\x13\xbe\x23\xde\x1d\xfe\x53\x95\x75\x2c\x18\xc1\xaa\x5b\x8d\x60\x0f\x73\x96\xb2\x33\x11\x8c\x60\xbb\x32\xbc\x11\xec\x61\x5e\xc9\x35\x10\xa0\xcf\x39\xbb\xe2\xdf\x49\xd9\x55\x3f\x2f\x1f\x83\x98\xe5\x30\x6b\x8f\x71\x65\xd7\x19\xce\xa7\x36\xea\xb7\x46\xb4\xcd\x1e\xbd\x61\xff\x61\x96\xb3\xe7\x77\x26\xb3\xce\xa0\xbf\xab\xa4\x41\xa4\x3e\xfd\x83\x9b\xd8\x56\x75\xfd\xb1\xc8\x08\xed\xa6\x5d\x6c\x0d\xb0\xfb\x1f\x7b\x9e\xf1\xd1\x7d\xc3\x51\x2a\x0c\x78\xd7\xf3\xfe\x1f\x62\xec\x6e\x1b\xd7\x97\x96\xd3\x43\x5f\x58\x3e\xef\xfe\x67\x86\xd1\xeb\xe6\x55\xee\xd0\x26\x78\xb6\x70\xf4\x85\x95\x79\x8e\xee\x1b\xb6\xf0\x00\xd3\xfc\x49\x4f\x13\x73\x6d\x29\x4f\x0c\x2e\xde\x28\xc5\x85\xf6\xbe\x2c\xe0\xae\xbe\xe4\xfa\x6d\x09\x4c\xcd\x22\x57\x97\x36\x9d\x51\x2b\xe6\xaa\x0a\x66\xb4\x72\x6c\xae\xd2\x36\xa6\xb2\x24\x0b\x0a\x16\xf9\x56\x29\x1b\x3a\x1a\xba\x1f\xe7\x06\x05\xaf\xcc\x3f\x97\xe6\x9f\xe5\xa1\xf2\xa8\xb7\x8e\xe3\x75\x62\x4f\x10\x84\x98\x78\xf5\xf2\xd5\xe4\xb2\xd2\xe2\xac\x4d\xf3\x38\x9d\x07\x49\x73\x9b\x74\x34\xda\x93\xab\x0e\x34\xba\x44\x78\x3c\xa7\x9c\xcc\x1b\x8d\xbe\x3d\xfd\x6e\x72\x19\x48\x63\x2e\x2b\x53\x28\x3d\x18\x61\x32\x85\x46\x67\x38\x6d\xd4\x72\xda\x5a\x20\x16\xf5\x8c\xda\x8e\xc9\xaa\xdb\x08\xd7\xfb\x37\xcc\x0d\x92\x27\x9d\x8e\x46\xe7\x5d\xf8\xdc\xe7\xd1\xf3\x8f\x42\xe8\x67\x5d\x0d\xaf\xee\x68\x78\x45\x57\x1f\xd5\xf0\xfb\x96\xc7\x05\x1a\x12\x95\x14\x52\xae\x1f\x97\x37\x22\xc3\xe8\xdc\x4b\x5d\xda\x22\x7d\x34\x24\x45\xed\xae\x72\x3e\xd5\x33\xc2\x3b\x5c\x7a\x7a\x56\x17\xc4\x27\x86\xf0\x48\x05\xc2\x4d\x70\x80\xb7\x22\x8e\x3b\x7c\x92\x04\xea\x6c\x4c\xa3\x3e\xe8\x57\xf1\x1e\xa7\xc1\xef\x3d\xb9\x6e\xac\xcf\x1c\xbc\x69\xca\x43\xf7\x12\x8c\xe6\x84\xe3\xdd\x6e\x7d\x7b\x81\x35\x14\x40\x6b\xca\xc9\xfb\x70\x10\x56\xdf\xb5\x06\x63\x7d\xf8\xe1\x84\xd6\x80\x4e\xdb\x87\xce\x4b\x5e\xd0\x90\xc8\x20\xe0\x04\x46\x73\x4c\xd4\x61\x2a\xc7\x63\x45\x29\x15\x6e\x54\x4d\x67\x26\x8c\xe6\xce\x5d\xc5\x69\x41\x41\x14\xe3\x7b\xac\x8e\x2b\xde\xf3\xf6\x7d\xba\x02\x57\xae\x83\x0b\x01\xcb\xc6\x20\x00\xd0\x61\x89\xea\xf0\x43\xa9\xac\x2a\x55\x79\xd8\xce\x0d\x54\x6b\x76\x64\x67\x5b\xd0\xf6\x65\x25\x8b\x20\x09\x20\x86\x0b\x13\x52\xc4\x31\xfc\x5d\xc4\xf1\x06\x15\x47\x0b\xbc\xdf\x1b\x6a\xe7\xa2\xeb\x90\x5f\xa3\x53\xc4\x89\x5b\x56\xbc\x27\x4f\xef\x2a\xe4\xd7\x02\xef\xc9\xcb\x0e\xa0\xb3\x41\x47\x23\xbc\x27\xaf\x3a\xb3\x4c\xce\x49\x47\x0e\xc8\xc5\x15\x13\x73\x79\x89\x70\xe5\x7f\x86\x1e\x3c\xc4\xde\x31\xfd\x3e\x59\xec\x76\x0f\xf1\x9e\xfc\xda\x31\xb6\x8e\xcd\xde\x93\xd3\xee\x98\x31\x7f\xce\xb3\xef\x04\x79\x6e\x15\x4e\x4f\xd3\x77\x11\x3b\xd4\xe6\x5d\x0d\xb6\x87\x28\x21\xd5\xc4\x32\x86\xdf\x40\xf4\xb3\xf4\xc6\x7d\xa6\x67\x04\x82\x86\x40\x44\xbb\x37\x32\xbd\xb6\xde\x40\x17\x15\xe1\xf3\xd4\x10\x3a\x1b\x4f\xd8\xbc\x0c\x08\x99\x57\x4e\x42\xf3\x03\xbf\x49\x4f\x48\x3d\xfb\x34\x5c\x0a\x97\xfe\x9d\xe2\x8b\xf4\x57\xd2\x1c\x76\x7a\xba\xdf\x07\xa8\x3a\xfb\xc3\xd6\x32\x19\x13\x6f\x4b\xfe\xe4\xe5\x0b\xda\x47\x81\x28\xa1\x02\x31\xd6\x70\x61\xb7\xeb\x3b\x0b\x86\xb9\xcc\x60\x45\x0f\x53\x9a\x7c\xff\x1f\x24\x66\x88\x4e\x96\x92\xea\x36\x22\xa5\xba\x81\x6b\xad\x03\xa3\xaa\xcd\x6c\x74\x17\x8a\xb6\xa9\xad\xcd\x6b\x87\x93\x32\x64\x84\x21\x4e\x46\x5f\x5a\xea\xe4\xc1\x57\x20\x86\x36\xc4\x8a\xa1\x36\xee\x3f\xb4\x04\x7a\xb5\x3c\x71\xdc\x87\x13\x7a\x97\xed\x07\x46\x0d\xd2\xa4\x8e\x3f\x64\x40\xc1\x05\xbf\xa9\x4f\x9f\x3a\x38\x7d\x35\xb1\xe9\x96\xd5\x83\xdc\x3a\x66\x8e\xa3\x38\xdb\xf9\x2e\x8c\x0e\x38\x95\xb6\xf3\x20\xae\x8e\x25\x47\xf5\x04\x0d\x09\x4b\x14\x67\x73\x60\x5a\x30\xd2\x38\xb5\x8b\xb2\x27\x8e\x1e\x31\xbb\xb6\xe9\x5e\xbe\x03\x82\xcb\xad\x27\xd8\xd7\xd1\xa6\x31\x8e\x05\xbf\xbb\xdd\x76\x1f\xd8\xca\xd1\x6d\x6d\xf5\x06\x77\x1b\x7c\x8f\xbb\xb6\xaf\x53\x4c\xa9\xbd\x39\x50\x66\x8b\x42\x48\x37\xd2\x3e\x0f\x87\x6b\x1f\xe2\x25\xd8\xc0\x5b\x0d\x6b\xcc\x82\xec\x76\x1c\x65\xc8\xbb\x3b\xe2\xfd\x18\xaa\xb4\x8d\xc2\xb0\x1b\x0b\x89\xd6\xae\x81\x88\x68\x1f\x43\xe4\x10\x30\x72\xb4\x41\xb8\x02\x2c\xbd\x12\xb0\xd7\x5d\xad\xae\xaa\x13\x05\xa1\x47\x83\x16\xa1\x62\x87\xb1\x58\xf7\x88\x88\xef\xea\xce\x1a\xcd\xde\x5a\xf6\x40\x55\x50\x55\x87\x5a\x95\xa5\x68\xc7\x15\xb8\xb5\xb8\x98\x25\x25\xbb\xe2\xee\x5c\x29\x33\x68\x6d\xa5\x84\x6a\x4f\x80\x00\x6a\x02\x76\xcb\x5f\x34\x6e\x76\x07\xb2\x5a\x34\x68\x9b\xe0\x2c\xb4\x4e\x5b\x65\x16\x86\xb8\xd7\x90\xef\x9d\x61\x5e\x13\x98\xfc\x37\xfa\x70\x4d\x75\x76\xb3\x94\xb4\xc5\x7c\xb5\x2a\x2f\x25\x3a\xf0\x9d\x0d\x54\x7e\xcd\x85\x9e\x8e\x66\xe0\x14\x2e\x29\x9f\x3e\x00\xfd\x5d\xbf\x56\xde\x99\x4f\xdd\xa9\xda\x39\xd7\x92\x79\xb7\x67\x85\xa4\xc7\x74\x53\x51\xd1\xf4\x32\x29\x21\x9e\x4d\x79\x8b\x17\x5e\x74\xfc\xe9\x7f\xf5\x6c\x91\xb7\xaf\x9f\xd3\x68\xe0\x2b\xbc\x96\x52\x0f\xf8\x20\xea\x7d\x7a\x1c\xed\x31\xf6\xed\x4d\xd9\xcc\x7b\xc6\xbd\x13\x91\x57\x75\x99\x0e\x83\xc4\x4e\xc9\x6c\xdd\xd1\x0b\xb6\x5e\xe7\x62\x69\xfa\x9b\x33\xcd\x52\xb6\x5e\x17\xb9\xdd\xad\xe3\x5f\x4b\x29\xc6\xd9\x8a\xa9\x92\x6b\xba\xd1\x8b\xa3\x2f\xc7\xe7\xac\xe4\x0f\x3f\x23\xd1\xc0\xcc\x16\x6d\x04\x2f\x33\xb6\xe6\xa8\x23\x46\x07\x18\xfa\x5a\x72\x39\x5f\xdc\x98\x23\x87\xb1\x9b\x41\x87\xdb\x65\x05\x2d\xa6\x41\x70\x2c\x4f\xc1\x74\xdc\x68\x90\xfe\x34\x56\x51\xd7\xa1\xc7\x34\xe1\x95\x54\x5c\x1b\x22\x24\xfa\xcb\x25\x9f\xe7\xac\x17\x0d\xcc\xe7\x20\xda\x46\x03\x35\x88\xf6\x51\xaa\x02\x9f\x47\xb0\xf9\xa3\x4d\xe5\xeb\x21\xbd\x6f\x43\x51\x4e\xe1\xf8\x71\x17\xc1\xac\x52\x54\xd2\xed\x9e\x48\x3a\x1c\xcb\x47\x30\x3e\xa7\xdf\x94\x5e\xbf\xc9\x40\x92\x35\x95\xb3\xe9\x70\x36\x8e\xac\x0c\xa9\x6e\x1a\x22\xd2\x4d\xd9\x8c\xf6\x87\x78\x6f\x9a\xb4\x2d\xf1\x83\x66\x0a\xca\xa7\xb2\xa3\x81\x02\x82\x48\xa9\xa9\xf9\x3b\xdb\xed\x90\x88\xe3\x7e\x61\xa6\x6f\xfe\xa1\x22\x35\x80\x02\x7e\x46\x28\x1a\x14\xb0\x10\x18\x62\x01\xa3\x68\x20\x06\x11\x8e\x30\xd1\x2e\x5c\x27\x08\xd0\x74\x87\x65\x62\x53\x4b\xde\xb6\xed\xe2\x1d\xb6\x5d\x7c\x2a\x66\x44\xd2\xf3\xa9\x4a\xf2\xf9\xcc\xf9\x47\x1a\x80\xb8\x28\x07\x83\x6a\xe9\x18\x1d\x8e\xd9\x23\x99\xac\x99\xaa\x15\xc3\x6c\x30\xc0\x2e\x69\xca\x66\x48\x55\x3f\xed\x92\x8f\xd9\x23\x75\x6b\x05\x3b\x91\x4d\x50\x09\xc4\x82\x10\xd7\xd8\x77\x5a\xd8\x30\x66\xc3\xee\x86\x8a\xee\x26\xc6\x6e\x26\x74\x9b\xcf\x53\xf3\x8b\x98\xa9\xa4\x23\x02\x85\xd2\x62\xbf\x3f\xb4\xbf\xac\x57\x69\x3a\x23\xc1\x29\x39\xdc\x5b\x06\x7b\x0b\xd1\xff\xcc\x55\x9b\xb0\xe9\x70\x36\xb0\xbf\x53\xf3\x9b\xe4\x94\x4d\x47\x33\x52\x52\x36\xbd\x3f\x23\x19\x65\xd3\x07\x33\xb2\xa1\xdb\xac\x2c\xd3\x9c\xc0\x31\x4f\x4b\x52\xdd\xed\x34\xdb\x8f\xd5\xb4\x98\x4d\xcc\x3f\x8d\x95\xc1\xa9\x0f\xd5\x3a\x2d\xec\x64\x0a\x37\x85\xe9\x66\x56\xc7\x54\x10\x07\xe6\x9e\x76\x22\x97\x88\x27\xb9\x28\xb9\xd2\xcf\x84\x96\xa0\x1e\xeb\x8b\xc0\x8a\xd2\xdb\x93\xca\x4d\x31\x17\x9f\xb8\x48\xc8\xac\x57\xea\x9b\x82\xf7\x34\x53\x4b\xae\x93\xde\x9b\x55\x5e\xf6\xd6\x4a\x9e\xb3\xf3\xe2\xa6\x77\xc9\x99\x28\x6d\xcc\x63\xbd\xe2\x3d\x30\x1e\xab\xc2\x58\x7f\x52\x77\xf6\x49\x0f\x94\x85\x5c\x73\xd5\xcb\xcb\x5e\x2e\xc0\xce\xdf\xc7\x3d\x56\xf4\x66\x7a\x53\xc7\xf9\x02\xbd\x9d\x96\xeb\xc8\xd9\x49\x9b\x46\x1e\x6b\xac\x26\x2a\x11\xfc\xbd\x3e\xcd\xcf\x8b\x5c\x2c\x27\xc2\x65\x59\xc6\x02\x69\xd2\xc8\x36\x6b\xc5\xd6\x6b\x2e\xe6\xa0\x2f\x32\x74\xdb\x41\x05\x91\x2c\x72\x55\x5a\x85\x12\x26\x16\x27\x22\x1d\x44\x90\x3a\x97\x5a\xcb\xcb\xd6\x38\xda\xed\xda\xc0\xdb\x81\xae\xb1\x32\x56\xad\x2b\xed\x76\xfd\xfa\x23\xb1\x81\xa0\x0f\x57\x7e\x7a\x0a\x4b\xfd\x5c\xb2\x39\x57\xb3\x77\xe2\x9d\xe8\x3d\xb3\x2b\x15\xac\x6c\xbd\x90\x9f\xf8\x06\x3f\xe9\xa1\x4f\x24\x44\x1c\x2f\xab\x4e\x3e\xc1\xbd\x85\xdc\x88\x79\xf2\x4e\xf4\x5e\x6c\x4a\xf0\xec\xff\x44\xcb\xf5\x27\xa4\xf7\x89\x9d\xd6\x27\xa4\x27\x95\x0f\xc3\xfe\x4e\xf4\x6c\x98\xcf\xf4\xf8\x78\x99\xeb\xd5\xe6\x3c\xc9\xe4\xe5\xf1\x35\x3f\x5f\xb3\xec\xe2\x28\x93\x42\xab\xfc\xfc\x18\xce\xc2\x11\xc4\xb3\x56\xff\x65\xbb\x62\x1a\x1b\xa4\xe5\x62\x41\x34\xcf\xd8\x20\xea\x45\x83\xc3\x89\x8f\x0f\x76\x42\x86\xd1\xcc\x8a\x56\x28\x03\x73\x07\xb8\xd0\x3f\xca\x39\xaf\x9d\xdc\xc3\x54\x47\xaa\xd9\x0d\x71\x71\xe9\x34\xbd\xa9\xa5\x03\x78\xac\xbf\xa6\xc3\x38\xbe\x01\xaf\xf7\xcc\xf4\x38\xc2\xad\xf0\x69\xb6\x52\x37\x5f\x86\x22\x98\x78\x1d\xfc\x00\x1c\x1b\x54\x09\x31\x96\x69\xa4\xf9\x7b\x7d\x9c\x95\x65\x44\x32\x83\xc8\x6c\x1e\x26\xf6\xf2\x11\xdd\x0a\xa0\x7c\x77\x47\x45\x2e\x2e\x3e\xdc\x8f\x4f\x57\xbc\xa0\x76\x6c\xe5\x8a\x73\xfd\xc1\x01\x64\x16\x1e\x34\x43\xbc\x1c\xca\x1e\x21\x02\x7b\xc9\xf5\x63\x6d\x76\x7d\xa3\x39\x12\x44\x4f\x05\xe8\x1a\xab\xb6\x36\x01\x6c\xb1\xe1\xe6\x81\xd0\xaa\x63\x81\x82\xc9\x4c\x59\x3a\x5b\x76\x46\x83\x2c\x64\x73\xaa\x40\x7a\x01\xb5\xb0\x1f\x43\x1e\x65\x7b\x68\xad\xcc\xc5\xb2\xe0\x5a\x3a\xe1\x7b\x46\xaf\x06\x83\xb1\xa0\xcb\xdd\x0e\x2d\x69\x8e\x34\xc6\x44\xd1\x45\x72\x9e\x8b\xb9\x35\xd2\x12\x24\x23\x7d\x10\xa2\x1f\xa4\x0e\x9d\xa0\x89\x27\x15\xb0\xed\x24\x0b\xdf\xbe\x7e\x7e\x5b\xba\xdb\x2e\xbb\x80\x77\x95\x53\xfc\x4a\x5e\x7c\xa0\xdc\x37\x85\x3c\xbf\x95\x2e\x9d\x20\x41\x21\x00\x8f\xa2\xeb\xc6\x44\x34\xcc\xad\x5e\xb0\x02\x09\x4c\x44\xb2\x52\x7c\x11\xc7\x1d\x5d\x23\x9b\x87\xf7\x38\x45\x02\x96\x8c\x28\x3a\x0f\x9b\xec\x68\x70\x5f\x1d\x41\xd5\x10\x01\x5b\x91\xa4\xfb\x17\xf6\x09\x42\x39\x96\x65\x1c\xeb\x04\x50\x19\x24\xc0\x2f\x93\x54\x2d\xb4\xf5\xb3\xf0\x5f\x6e\xdf\xc7\x0a\x71\xaa\xdd\xae\x48\x14\x02\x81\x85\x7f\x88\xc1\xee\xbb\xa4\x62\x12\x45\xa9\x32\x5d\x59\x87\x36\x38\xf5\xa7\xe6\xd4\xe3\xf0\xc3\x14\x78\xc3\xdf\x6b\xfa\x1e\xc0\x4a\xfd\x2a\x02\x6b\x5f\x39\x53\xca\x40\x0f\x24\x31\x29\x20\x1e\x65\x5e\xcc\x4d\x42\x39\x2e\xa6\x7a\x66\x4e\x6f\x08\x57\x4c\x1a\x26\x3e\xd4\xd1\x84\x37\x41\x18\x23\x90\x9f\xf2\x06\x72\x60\xe1\x8c\xe6\x21\x2e\x86\x71\x82\x8d\x0f\x2c\x15\xc4\x83\xb2\x21\x86\x82\x4b\x17\x41\x5e\x44\x14\x26\x1f\x9e\xaf\x18\x57\x84\xd2\x98\x07\x88\x6d\x8c\x5b\xf0\x31\x44\x7a\xe3\xe6\x78\x6f\x5b\x21\x81\xc3\x89\xac\x5b\x71\x83\x61\x2a\x10\x57\xdb\x6f\x6f\x15\x68\x16\x02\x23\x65\x52\x5c\x71\xa5\xdf\xc8\xc7\xe7\xa5\x2c\x36\x9a\xbf\x55\x45\x19\xc7\x72\x8c\x6e\xc9\xdb\xed\x18\xc4\x02\xa5\x67\x48\x61\x1b\xbc\x58\x0d\x68\xf4\x4e\xfc\x01\x1e\xe8\x4f\x73\x3d\xb2\xe6\x7a\xbc\xb9\xa7\x41\xd1\xe6\xae\xa2\xa9\x9a\x91\xad\xb9\xa3\x69\x0d\x8b\xf7\x98\xe4\x94\xc3\x15\x1b\xdb\x3f\xb4\x03\x4e\xa0\x02\x93\xbc\xfb\x7a\xe6\xd6\x06\xf5\xdc\xd0\x98\x1d\xb1\xb1\xc7\x87\x20\xb2\xed\xc0\x64\x23\x54\xf3\x5b\x1c\x0b\xc0\xf5\xe1\xb0\xb6\x65\xb7\xe3\xd8\xef\x79\xfd\x2b\x61\x45\x11\xc7\x5e\x52\xc9\xb4\x3c\xdf\x63\x72\xd9\xc1\xf8\x55\x21\x66\x7a\x21\xe6\x68\x3c\x91\x62\x90\x46\xf5\x22\x41\x6d\x33\x2c\x6c\x04\xb4\xd0\xbd\xc9\x75\xf7\xdd\x9b\x17\xcf\x9f\x7d\x6b\x48\x1c\x2f\x13\xd5\xea\x66\xab\xa8\xb2\x96\x0c\x42\x3f\xf1\xa3\x5c\x71\x36\x0f\x62\xcb\x80\xca\x64\x0f\x71\x9b\xd5\xbe\xe2\x24\xc5\x2c\x9c\x7c\x2d\xc2\xa8\xe6\xfa\x1b\xc4\x5d\xe7\x05\xcf\xb4\x54\x36\xe4\xdb\xd2\x6a\x5f\xae\xe8\x90\xdc\x18\xea\xff\x8c\x0a\xf4\xf9\x03\xdc\x15\x7a\xa8\xf2\x54\xaa\x85\xc0\x15\xf5\xf7\xe4\xe9\x37\x6f\xff\x1a\xc7\xee\xcf\x01\x71\xe8\x47\x70\x48\x06\xbe\x59\xf1\x5e\x48\x68\x05\xb1\x99\x36\x25\x9f\xf7\x72\xd1\x63\xf0\x90\xcc\xb9\x92\xd7\x25\x57\xe1\x1b\x32\x11\x1e\xbb\x00\xaf\x44\x5b\x32\x80\xd6\x26\x70\xde\xc3\xc9\x66\x4c\xdc\x5f\x50\xe7\xd6\x78\x76\xb7\x8b\xce\xa5\x2c\x38\x13\x61\x8d\x20\x3b\x44\xca\x74\x85\xcc\x01\x0b\x48\x3e\xc8\xaf\x3f\x69\x64\xb6\x29\xaa\xcb\x18\x5a\x18\xd5\x1f\xd4\xd3\xd8\x5e\x12\x28\xdd\xb3\x14\x1e\xf9\x00\xbe\xeb\xb2\x4c\x66\x66\x6b\x0a\x3a\x1c\x17\x8f\x7c\x9c\xb8\x71\xe1\x79\xb2\x9c\x0a\xb0\xf3\xa2\xe7\xd3\x1c\x78\xd8\x12\xb8\xd7\xa3\x23\xc2\x2c\x95\x5f\x62\x43\x5e\x98\xf3\x80\xa4\xa3\x92\x2c\x07\x6f\x6f\xbc\x69\x95\x1d\xb4\x6a\x38\xb8\xa2\xb2\x0e\xb7\x4d\xd6\x03\xca\xe8\x70\x9c\x3d\x2a\x9b\x5c\x69\x36\x18\x60\x97\x34\xcd\x66\x08\x8f\xdd\xdb\x21\xe7\xd3\xd2\x8c\xcb\x70\x9e\x30\xf1\xf7\x87\xb2\xe2\x5a\xac\x12\x6a\x47\x6b\x01\xe9\x54\xcf\xa8\x20\xdc\x2b\x62\xbf\xb1\x9b\xd6\x90\x2e\xed\x3f\xf8\x70\x4e\x53\xd4\x54\x59\x7d\x9b\x33\x06\x9a\xc8\x8f\x7e\xa5\xa9\x0e\x7e\x58\x45\x79\x3a\x60\xa9\x6b\xd9\x5d\x38\x11\x5d\x59\x4c\x7a\x99\xa3\x21\x51\x9c\x5d\x49\x9e\x30\x0c\x4a\xe1\x9b\xda\x32\xf6\xc6\xdb\xc5\xde\x38\xab\xd8\x96\x6f\x80\xc0\xdb\xa7\x71\xfc\xb4\xaa\x60\x48\x8f\xc9\x02\x3d\x25\x02\xa7\xae\xd9\xcc\x36\x1b\x6a\x60\xe1\x9d\xad\x89\xe9\x3b\x55\x93\x05\xda\x40\x18\x2b\x52\xdb\xbb\xf2\x3d\x36\x0d\x58\x25\x73\x93\x4c\x69\x4c\x4d\x10\x69\xb9\x97\xdd\xae\x12\x41\x2a\x48\x75\xc1\xec\x51\x69\x3a\x0f\xba\x06\x22\x47\x4c\x34\x12\x38\xd5\x8e\x24\x33\xff\xdc\xd0\x8d\x7d\xea\x66\x1b\x18\x60\xab\x3d\x6e\x0c\x40\xd9\x09\xf3\x89\x46\xbc\xae\xde\x0c\xca\x86\x98\xe9\xf1\x86\x70\x43\xe8\xc8\xa4\xe0\xec\xca\x3e\x09\x53\x92\x9c\xca\xc4\xca\xb1\x5d\x42\x46\x65\xc2\x85\xe6\xca\x7e\x8f\x2f\x50\x41\x6e\x0c\xdd\xd3\xd6\xf7\xf3\x86\xab\x6e\x16\x30\x5b\xfb\x9a\xa3\xb8\xc4\xe4\x1a\xe5\xe4\xa6\xb9\xd2\x76\x81\x74\x63\x81\x80\x58\x7b\x8f\x32\x62\xa6\x13\x4e\x70\x5e\xe3\x9b\x8f\x8e\x6d\x1a\xc7\xe1\x57\xcd\x4c\x9d\x9d\xe5\xf3\xb3\xb3\xdd\x4e\x83\x92\xdb\x7e\xd1\x97\x83\x01\x6e\x10\x37\x81\xe3\xf9\x2d\xa1\xd7\x5e\x4d\xcd\xa8\x66\x66\xa2\xb7\xad\x4a\x8f\x87\x93\x38\xb7\xbb\x74\xf7\xe9\xab\x58\x5e\xd5\x74\xa0\x41\x78\xfc\x94\x76\x9d\xc7\x4a\x14\x27\xe9\x1a\x35\xb7\xfa\x29\x0e\xb7\xd9\xec\xbb\xd3\x56\xd9\x98\xde\xb2\x92\xfa\x41\x87\x45\x1c\xe7\x8f\xca\xf1\x60\x90\xe3\x82\xca\x69\x3e\x33\x2c\xb3\x40\x45\x63\x23\x56\xd6\x47\xc8\xdf\xbe\xf0\x55\x85\x75\x9d\x4a\xb4\xef\x49\xd0\x21\x68\x3e\x5c\x4f\x5e\x2e\xdb\x70\xe5\x7d\xa4\xc6\x83\x81\xc0\x1a\x44\x8e\xa8\x96\x00\x07\xd4\xe6\x65\xc0\x33\x03\x27\x1f\xc7\xc8\x01\xd1\x57\x53\x3d\x23\x0a\xbd\xc2\xbb\x1d\x3a\x89\x63\x74\x82\x30\x39\xb1\xa6\x89\xe4\xd7\x38\x46\xbf\x22\x4c\x7e\xb5\xdf\x38\x98\xc8\x12\x55\xd4\xab\xa4\x7d\x53\x9f\x30\x3a\x47\xda\x30\x88\xe3\x6a\x87\x99\x81\xae\x86\xf4\x3c\x31\x73\x08\x54\xd8\xe8\xdc\x90\xe3\x61\xca\x5b\x61\x10\xb5\xe9\x90\x76\x65\x20\x08\xdb\x1f\xc0\xf7\x4b\xa4\x43\x7a\xfa\x0a\xb5\x20\x08\xde\xde\x84\xc0\x0b\x40\x03\x00\x04\x9c\x4a\x24\x1a\x80\xc3\xdc\x25\x07\x3a\x6c\x48\x04\x1b\x6d\x49\xe1\x54\xc6\xb1\xab\x26\xcd\x36\x7a\x1a\xcc\x0e\x2f\x88\xe3\x5a\x77\x15\x74\x23\x50\x97\x79\x97\xc1\xf5\x0a\x5a\xba\x31\xc4\xea\x99\x07\x2f\x85\x39\x73\x98\xbc\xa7\x67\x89\xda\x88\xa7\x06\x7a\x7c\x27\xe5\x45\x49\xae\x6d\xca\x09\x00\x18\x9b\x74\x61\x93\x2a\x93\xc3\x92\x3c\xf5\x07\xe6\x25\x1d\x91\x57\x5d\xc1\xbb\xc9\x89\x2f\xf2\xab\xd7\xa0\x3a\x7b\x83\xca\x3a\x51\x10\x78\x0a\x20\x95\x0d\x6b\x03\xd3\x4b\x2e\x96\x70\x09\xd2\xa5\xb7\x3c\xb8\xda\x5b\x33\xd3\xea\xcd\x35\x34\x1a\x62\x22\xd0\xc3\xcf\xb1\x55\x67\x3f\x7c\x68\xf5\xd9\x0f\x9d\xf5\xdc\xc3\x2f\xad\x53\xd5\xc3\xaf\x30\xd9\xfc\xfb\x7b\x4d\xfd\xc9\xe0\x04\x1d\x72\x08\x9e\xe8\x15\xb7\x46\xb9\x7f\xe4\x61\xb8\x46\xf4\x0e\x89\x98\xc3\x62\x87\xe6\xa6\x3a\xb9\x6d\xb7\x6a\xbb\x53\x9d\xf8\x9f\x80\x80\x0f\xa8\x0a\xcf\x4f\x37\x1c\x67\x9c\xb7\x8c\xac\x7c\x68\x6a\x1c\x50\x5d\x2c\x43\x3b\xd9\x72\x54\x11\xee\xca\x51\x09\xef\x95\x9d\x83\x7b\x5e\x7d\x46\xfe\x37\xed\x79\xbd\x68\x81\xb3\x8b\xb2\x14\x5f\x1d\xea\x42\xe1\xaf\xe9\xb0\xf2\x62\xff\x10\xfd\x07\xe3\x82\x47\x52\xcd\xe0\xea\xb7\xa1\xba\xdf\xfe\xb3\x41\x37\xce\x37\x5a\xcb\xb6\x2f\x81\x23\x1e\xfb\x88\x27\x97\x5c\xb3\x1f\xf8\xcd\x6e\xc7\x13\x56\x68\xf7\x2b\xd3\xaa\x70\x3f\xcb\x55\xbe\x30\xc9\xed\x77\x00\xff\x05\x44\xec\xc8\x13\xb1\xc3\x83\x07\xfd\x9c\x36\xb6\xe3\xae\x4c\x38\xaa\x4f\x21\x4e\xf9\xde\xb2\x0c\x2e\xe0\x80\x48\x84\x0f\x39\xf0\x19\xb8\x62\x26\xc2\x07\x1d\x78\x80\xc9\xda\xda\x87\xcf\x0d\x18\x1a\x61\x4c\xce\x21\x7f\x8d\xc9\x8a\x0a\x74\xff\x81\x61\xbf\xad\xef\xe6\xf2\xdf\xff\x28\x92\x2b\xba\x38\x70\x74\x7c\x9e\x8b\x8b\x03\xff\xc6\xcb\xe4\xbc\xe1\xdf\x98\x75\xf9\xf7\xad\x92\xf3\x7d\xe8\xf9\xa8\x65\x5a\xf9\x90\x85\x01\x94\xe7\x4e\x86\x43\xe6\xde\x84\x7c\x0e\x16\xeb\x33\x4c\x6c\xa0\x00\x50\xae\xa4\xf3\xd0\xc0\xfc\x8a\x9f\x54\x3e\xee\x55\x7d\x29\x8a\x1b\x0b\x70\x5e\x0a\xeb\x3e\x30\x4f\x0c\x47\x1c\x9a\xa0\x7b\x6f\x7f\xdb\x05\xb1\xca\xb1\xaa\x89\x8f\x71\x79\x3c\xec\xa5\x3f\x22\xc0\xf5\xa7\xdb\xfd\x7e\x4f\x9c\x87\x0c\xf4\x12\xee\xb1\x21\x9c\x6b\x7f\x43\x37\x0e\xe7\x04\xd2\x48\x43\x1c\x93\x3e\xaf\x9f\xc0\x04\x77\x08\x3e\x0f\x3d\x83\xbc\x73\x88\x73\x21\x1c\xeb\xdd\xee\xdc\x79\x91\xf5\xad\x09\xa7\xb4\x2f\x39\x86\x3e\xfb\x30\xd5\xdd\x0e\xf1\x64\x6d\xdb\x74\x13\x45\x95\x2a\x3a\x6f\xfa\xf8\x43\xf0\x03\xbc\xbf\xdb\x11\xd2\x79\x40\xc2\xfb\x9d\x12\xcc\xc8\x5b\xfb\x03\x2f\x9e\x05\x1b\x09\x26\xe5\x07\x8b\x68\xa3\x05\x93\x69\xa4\x65\x44\xa2\x56\x13\x55\x0a\x34\x10\x91\xe8\xa0\x7a\x34\x33\x37\xb4\x6b\x69\xf2\x05\xda\x58\x45\x86\xae\x43\xb0\xb1\x8e\x40\x10\xa5\x15\x64\x2c\x68\x8e\x34\xd9\xe0\x71\x69\x45\x82\x9b\xa4\xb6\x47\x44\x0b\x4c\x0c\x7b\x68\x8d\x97\x65\x1c\xf7\x0b\x24\x31\x8e\xe3\x4d\x85\xea\xd0\x82\x80\x08\x54\xc4\x31\x2a\x93\x2a\x10\xc3\x24\xf8\x3d\xa0\x51\x2f\x1a\x88\x34\x48\xa2\xc2\x0a\x4b\x4b\x2b\x1f\xa6\x4b\x83\x78\xdd\x07\x91\xb8\x8e\x31\xd2\x3d\x74\x5b\xbc\x15\xca\x22\x38\x87\x86\xf3\x74\xbe\x85\x57\x1f\xe9\xb6\xfe\x59\xcb\x6d\xfd\x81\x21\xb0\x90\xf3\x5b\xb7\xa0\x2e\x70\xed\x01\xb7\x74\x78\xce\x65\xe4\x5e\xdb\x78\x60\x40\xa5\x3c\x74\x9b\xe6\xf3\x5c\xf1\x4c\xdb\x57\x20\xf2\xac\x4c\xb7\x77\x3c\x74\x9a\x1e\x4a\x2e\x1b\x9c\x78\xcd\x76\x40\xb4\x6e\x73\xde\xc1\x7e\x90\xda\x6f\x73\xae\xa5\xa5\x6a\x5b\xa6\x26\x15\x4d\xed\x49\x0f\x49\x3d\x49\x51\x49\xc0\xc7\xf6\x21\x29\x10\x84\x6b\x19\x3c\x04\x54\xb9\xce\x58\x6e\xd0\x1c\x7b\x59\xab\x9e\xcd\x77\x65\x3a\xe2\x68\x93\x0a\x37\x6b\xb3\x6a\x0b\x43\xa0\xbf\xb6\x8e\xb6\xf0\x1e\x18\xf2\xe5\x48\x71\x34\xb2\x86\x98\xc1\x23\x3a\x9f\xde\x3b\x86\x80\xac\x03\xd3\xf2\xb8\xd9\x79\x69\x58\x04\xe8\x99\x05\x4f\x7a\x8e\x45\x60\x5f\xc9\x08\x08\x4a\x53\x27\x30\xdd\xed\x94\xfd\xe1\xed\x24\xbd\xf5\xa2\x7b\x8f\x03\x6c\xbc\x00\x0c\x86\x23\xbc\x2d\xca\xb7\x7d\xf7\x51\x8f\xd5\xd7\x74\x38\x56\x47\x47\x9e\x37\x33\xd8\x85\x30\x2a\x61\x48\xbb\x5d\x14\x41\x20\x44\xca\x3a\xe7\x25\x88\x21\x2b\x58\x1d\xab\xeb\x38\xc2\xd8\x3e\x66\xe4\x2d\xa8\xa2\x81\xd8\x37\x70\x89\x69\x37\x65\x1e\xf0\x9b\xcd\xae\xbf\xb4\xac\x7e\x87\x90\xdf\x2e\x03\xf3\x58\xc4\x4e\xbf\xfa\x74\xe7\x24\xdd\x24\x19\xf1\x8e\xcd\xe6\xa3\x0b\xf8\xe5\x16\xd4\xfa\x2b\xb5\xf8\x43\x64\xbb\x3d\xc6\x19\xc2\xf6\xe1\xc2\x0e\x6b\xab\x4a\x36\xcf\x10\x46\xa6\x2f\x81\xc1\x3c\x1c\x88\xf9\xfa\xe5\x86\x87\x70\x31\xed\xcb\x0d\xe0\x6c\xf7\x45\xeb\x4a\x7e\x19\x90\x2f\x7f\xca\xa7\xe9\xdf\x9f\x60\x01\x7f\xab\x2f\xff\x35\x0e\x57\xf7\xed\xaa\x7d\xd1\x36\x6a\x0e\xec\xf5\x2b\x5d\x93\xd9\x8f\xe0\x2d\xf6\xfb\xc3\xe3\x25\x89\x06\x11\xd8\x13\x4b\x1b\x71\x93\x2c\x3a\x0d\x30\x9b\x28\xb4\x43\x32\xf6\xd1\x8e\x5c\x82\x72\x04\x1a\x65\x5d\x8f\x0b\x5e\x35\xaa\xdf\x6c\x0d\x9e\xa6\x1d\x77\x84\x64\x33\x18\x47\xd2\x0c\x93\x8e\x3c\x16\xc7\x88\xd1\x8d\xc7\x89\x5d\xcb\x3a\xf1\xa1\x4d\x2d\x40\x01\xc9\x1c\xfc\xa2\x0c\xf9\x27\x57\x1a\xcf\xa5\x61\x4c\xb8\x21\xaf\x1b\xf1\xb8\x9d\x1e\xc6\x3d\x46\xdb\x7c\x50\x55\xdc\xf2\x2e\x51\xd9\x7e\x97\xc8\xc0\x1a\x14\x2a\x1f\xcc\xfa\x18\xbe\x15\x4c\xc8\xd9\x24\x9a\x44\x03\x96\x46\xd1\xbe\xb6\x4b\xf6\x65\x3a\x1c\x1f\x0e\xef\xe4\x02\x89\x6e\xa9\xca\xbe\xe9\x23\xd1\xb1\x4c\xa2\x29\x82\xea\xd2\x08\x34\x7d\xa6\x38\x01\x3c\x01\xb2\x54\x2f\xf8\xb8\xa3\xdd\x2e\x3f\x2e\x0e\x2f\x08\x41\x03\xe0\x9b\xd1\x59\x1d\x28\xbe\x39\xe2\xc4\xed\x1a\x86\x88\x17\xd6\x85\xa3\xb3\x82\x3f\xef\xad\x3a\x81\x1b\x47\x67\xb5\x3a\xbf\xbb\x26\x38\x7a\xdc\x51\x13\x08\xaf\xce\x9a\x87\x1b\xd6\x80\x4c\x07\x2f\xc6\x2a\xf7\x62\xac\xfe\x7a\x34\xd1\x47\xa3\x74\x68\x6e\xcf\x68\x2c\x1f\x69\xb0\x09\x54\xf6\x81\xbe\xfa\x9e\xc9\x99\x13\x22\x88\x96\x9a\xc3\xe9\x6b\x05\x99\x86\xe3\xaa\x4c\x8f\x15\x0e\xcc\x80\xfc\xdd\x60\xee\x6e\xc0\x7b\x4e\xc1\x1b\x64\x18\x55\xf3\x22\x0b\xb0\x3b\xc0\x0d\x1f\x97\x0f\x61\x97\xff\x97\x41\xb7\x01\xd9\xec\x4f\x80\x6c\x19\x38\xaf\xfc\x8f\x01\xd9\xea\x65\xe7\xce\x3e\xf9\x04\x49\x1b\xe1\x92\x06\xaf\x40\xc7\x31\x1a\x3a\x43\x38\x4b\x8e\x25\x5a\x3e\x97\xd7\x5c\x9d\xb0\x92\x23\x5c\x91\x3c\xb2\x99\x8e\x27\x28\x78\xcc\x2a\xa8\x5d\x43\x53\x59\x3d\x10\x59\xf7\x46\xa5\x7d\xf7\x9a\x07\x6f\x5f\x85\x0d\x01\x61\x95\x06\xe5\xa3\xc8\x43\xe4\xb2\x1d\x0a\xa0\xb6\xc2\xf7\x6f\x5b\x77\x83\xe2\xe2\x00\x14\x8b\xe0\xed\x2d\xc2\xa8\x25\xa1\xa5\xf3\xb0\x3d\x1a\xe1\x89\x4c\xe5\x20\x3a\x8e\x48\xee\xf2\x44\x4d\x5b\x4f\x44\xf5\xac\x6a\x2a\x1a\x90\x5a\x07\x4f\x78\xb3\x41\xfe\x67\x01\x79\xfe\x7f\x1b\x90\xb3\x10\x90\xe7\xff\x7d\x40\x9e\x7f\x24\x20\x2f\xad\x87\xd0\x47\x01\x70\x57\xf6\x0f\x00\xee\x46\x8d\x8f\x02\xd8\x8d\x1a\xff\x03\x80\x3a\xd8\xdf\xdb\x60\xb5\x19\x52\x08\xa3\x0f\x40\x6d\xfe\x67\xe4\xef\x1d\xbb\xe6\xe9\x76\x8e\x31\xe8\x8b\xfe\x00\xe1\xfe\x87\xdd\x08\x2b\x07\x37\x43\x06\x7a\xa7\xa4\xb6\xf7\xdf\xc7\x85\x4c\xd8\xfe\x6d\x23\x35\x7b\xfa\x3e\xe3\x7c\xce\xe7\x60\xd2\x92\xf6\x87\xe4\x6f\x6f\x5f\xbe\x79\x7c\xf6\xf4\x97\x93\xa7\x4f\x9f\x3c\x7d\x72\xf6\xf4\xf5\x6b\xfb\x5e\x3b\xa3\xdb\x53\x9e\x6d\x54\xae\x6f\x7c\xd9\x7d\x27\x01\x1e\xfd\xe5\x2f\x2e\x62\x37\x04\x24\x1a\x87\x03\x3d\xa0\x03\x9d\x31\x51\x69\x63\x00\x9f\x6a\xa9\xd8\x92\x83\x25\x91\xa3\x10\x27\x9d\x25\x9c\xa1\xdc\x33\xcd\x2f\x51\x61\x16\x3e\xed\x2e\x56\x72\x5d\x95\x21\x2d\xfb\x31\x73\xd1\x2a\xab\xa4\x7c\x81\xd8\x94\x27\x06\xde\xf8\xa7\xc3\xc0\x9d\xc2\xa7\xc5\xf1\xb0\x8e\x73\xdd\xea\xc4\x41\x66\x57\xcb\x5a\x09\xf1\xfd\xbe\xb1\x5b\x87\x92\x8d\xc0\xc3\x50\xdf\xd2\xf0\x32\x18\xfd\xdd\x63\x05\x13\x5c\x6b\x80\x65\xcf\x64\xf0\x14\x80\x0e\xaa\xee\xff\x98\xce\xa9\x31\x52\x7f\xda\xc1\x86\xad\x7e\x03\xcf\x9c\x7b\xb0\x2d\x6a\x9f\x7b\xe0\xd3\xfe\x67\xdd\x6e\x6f\xd3\x98\xfd\x27\x9c\xf7\x7f\xc2\x79\xff\x5f\x08\xe7\x7d\xf5\x9f\xe8\xdc\xdd\xd1\xb9\x3f\x83\xd7\x60\x04\xfa\xec\x2b\x4c\xce\xa9\xf2\xfa\xb1\xcf\x1f\x62\x72\x49\x15\x5a\x81\xe9\x26\xfa\xfc\x0b\x4c\xae\xa8\x42\x4b\x4c\x6e\xcc\xe7\x97\x98\x9c\x51\x85\x6e\x30\x79\x6f\x3e\xbf\xc2\xe4\x9a\x2a\xf4\x1e\x93\x0b\x2a\xd0\xc3\x21\x26\x4f\x6d\xe0\xef\x97\x54\xa1\xa7\x98\xbc\xa2\x02\x7d\x85\xc9\x09\x05\xc3\x90\x5f\xff\x7d\xc2\x80\xdf\x1a\xa1\xbb\x12\xea\xdf\x12\xcc\x79\x9e\x5f\x35\xa2\x39\xbf\xac\x0b\x4a\xa1\x59\x2e\xb8\xf2\x9a\xa7\x4b\xa6\x96\xb9\x78\x23\xd7\xe9\x83\x21\xb1\x1f\xdf\x80\x7d\x66\xfa\x70\xb8\xbf\x23\xc4\xf4\x8a\x33\x18\x1b\xd8\x8f\xdc\x5e\x6a\xe4\xc3\x50\x2f\xe5\xaf\xfc\xe2\xf8\x5a\xaa\x8b\x08\xe3\x3b\x2a\xb8\x26\xef\x28\x72\xfb\xe4\x94\xbc\xbe\x63\xc4\x97\x2c\x17\xb7\xae\x4a\xf1\x62\x3e\x1a\xee\x49\x18\xd8\xd3\x09\x73\xef\x18\x09\x2b\xf3\x39\xbf\xab\xc9\xfb\x77\x0c\x47\xb0\xab\x0f\xad\x5e\x2b\x26\x37\x1a\x92\x93\x9a\x4b\xa8\xbb\x12\xec\x8a\x34\xbe\x5e\xe5\x45\x51\x36\x93\x4e\x35\xcb\x2e\xf8\x1c\xdf\x31\xa0\x22\xff\xc0\x78\x2e\x92\xe7\xb9\xb8\x20\x5b\x2d\xd3\xe8\x78\xad\x64\xc6\xcb\x92\x97\xd1\x9e\x44\xaf\xaa\x8f\xbb\xf6\xf6\x8f\x75\xf0\xdb\x86\x6f\x6c\xeb\x7f\xb3\xbf\xfe\x75\x4d\x2b\xae\xd5\xcd\xd9\xaf\xf2\x1c\x9a\x7f\x6d\xbe\x7a\xdf\x9b\xaf\x7f\x5d\x17\x65\xb6\xe2\x86\x48\x98\x57\xdd\x9c\xfa\x94\x7f\x75\x57\x73\xce\xea\x5e\x9e\x70\x56\x75\x80\xad\x16\xf6\x8e\x98\xea\xbf\x86\x31\xd5\x2b\xed\x45\x1d\xe9\xdc\xbd\x61\xdc\x08\xb4\x8b\x86\x64\x9e\x58\xc8\x84\x6f\x8d\x61\x7f\x91\xd8\x38\xc7\x64\xeb\x9c\xfb\xd3\x0b\x08\xb2\xe1\xe8\xf9\xdb\x4f\xa1\xab\x68\x99\x76\x78\xe0\xbe\xb2\x84\x4d\x7f\xfd\xf8\x6a\xf5\xe9\x0c\xea\x7f\x3c\x00\x3d\xaf\xdf\x95\x3a\xdf\x94\x37\x3f\x4b\x75\xc1\xd5\xdb\xd7\xcf\xd3\x28\x39\x36\x09\x67\xd7\x90\x52\x46\xc4\xfe\x78\x25\x65\xe1\xb2\x6d\xc2\xd9\x5a\xca\xa2\x8c\x0c\x9f\x78\xfb\x3e\xb7\x07\xed\x4e\xfc\x9f\x1a\xf1\x55\x3d\xe2\x8d\x2a\xcc\x40\xfc\xfd\xf9\x43\x43\x08\x6e\xc6\x9f\x1a\xc6\xd9\xc1\x30\xc2\xbb\xf6\x87\x86\xd2\xba\x41\x7f\x6a\x38\xd7\x07\xc3\x69\xdf\xcb\x3f\x34\xa4\xfa\xa6\xfd\xa9\xd1\x5c\xd6\xa3\x59\x70\x9d\xad\xdc\x89\x09\x5a\x85\xc5\x72\xc9\x76\xe1\x7c\xa6\xcb\x7b\x5c\x14\x8d\x6c\x56\x14\x67\x41\x7d\x6b\x25\x5b\xb5\x6b\x3e\x82\x16\x6c\x42\xdd\x84\x2b\xd0\x6c\xe3\x43\x2b\x02\x56\x13\x5e\x1b\x4f\xb6\xa0\x41\x8d\x22\xd2\xc6\x09\x18\x63\x52\xb1\x5e\x4b\xee\x15\xf3\xdf\xdc\x3c\x9b\xa3\x88\xad\xd7\x11\xfe\x93\x7e\x0a\x34\xf2\x4e\xcb\x8a\xb3\x4c\xff\x5a\x26\x52\x2d\x8f\xe7\x32\x2b\x8f\xe1\x59\xb9\xa3\x39\xcf\xe4\x9c\xab\x64\xa5\x2f\x8b\x49\x2e\xae\x98\xca\x99\xd0\x34\x1a\x70\x22\xe8\x68\x2c\x0e\x25\xd7\xf0\x82\xf7\x80\x46\x31\x53\xcb\x72\x3a\x33\x45\x0f\x7d\xc6\x6a\xa1\x91\xa8\xac\xdd\xa2\x17\xb9\xc8\x17\x39\x9f\xf7\xc0\xf8\xa0\x07\x03\xe8\xfd\x57\x34\xe0\x83\x68\xdc\xbb\xca\xcb\x1c\xd8\x92\x41\x54\x39\xc8\x2f\x0c\xf3\x72\xc9\xcb\x92\x2d\x39\xbc\xb2\x5e\x72\x48\x17\x52\x1c\x5d\xfa\xc6\xe6\xfc\x2a\x74\xf0\x81\xca\x50\x11\xda\x2f\x21\x1a\x04\x9b\xcf\x21\x8e\x16\x2b\x7a\x2b\x5e\xac\x17\x9b\xa2\x77\xcd\x94\xc8\xc5\xb2\x4c\xa2\x8e\x87\x13\x6a\xc2\x86\x72\x12\x9a\xbb\x50\x6d\x3f\x15\x5f\x94\xf4\xb1\xfd\xbd\x81\xf0\xbc\x8a\x8a\xdd\xee\xc7\x90\xf3\xc4\xdb\x16\x93\xf7\x2f\x6a\x39\x6f\xb8\x17\x12\x49\xb7\x7b\xc2\xac\x4b\x56\x61\x63\x9c\x3b\x45\x5f\x9f\xda\x40\xe1\xa0\x4b\xa8\x04\xf1\x3a\x01\x47\x58\x54\xd8\x5f\x98\x04\x39\x17\x1c\x74\x28\x34\x8a\x06\xda\x06\x91\xd3\xf8\x3b\x1f\x3d\x5c\xe1\x38\xee\x7f\xdb\x7e\x28\x11\x54\x0f\x72\xaa\x66\x54\x83\x65\xa3\x65\xce\xda\xa7\xe6\xe8\xbe\x19\xd4\x88\x52\x9a\x63\x59\x87\x34\x17\x95\x3d\xc7\xe8\x51\x5e\x1f\xdb\xd2\x09\x26\xe1\xad\x86\xe1\x38\x7b\x94\x5b\x3f\xa1\x69\x16\x8a\x23\xb3\xc1\xfd\xd9\x38\x68\xac\x04\x8f\xa5\x38\x0e\x6c\xba\xe4\xba\xac\x57\x20\xa7\xad\x9c\x3a\xde\xf9\x54\xcd\xfc\x2c\xf2\xc0\x3e\x73\x7b\xef\x9e\xe5\x92\xd3\x6b\x02\x5e\x8d\x1c\x62\xab\x32\xa2\xf8\x22\x2d\xc0\x50\xa2\x4c\x25\x39\x93\xd7\x82\xab\xf4\x59\x92\x59\x79\xf7\xbe\xe1\xd0\x5e\x1b\x45\x1e\x36\x07\x7e\xeb\xc4\x3e\x5e\x63\xda\xe4\x66\x47\x5c\xbb\xdc\x99\x7c\xb9\xd6\x79\x62\x7f\xec\x1b\xce\xea\x95\xf0\xaf\xed\xc3\xc6\x2b\xc1\x01\xac\x88\xef\x99\x52\x7a\xdd\xf0\x50\xaf\x5c\x15\x23\x1a\xa5\x11\x1d\x46\x24\x4a\xcd\x8f\xfb\x51\xf5\x3c\xfe\xbd\x68\x80\xa2\x68\x10\xaa\xe2\xa7\x34\x9d\x1d\x2f\x3b\x63\x44\xc2\xa3\xfb\x9d\x6e\xca\xf9\x02\xfd\xee\x85\x76\xce\x80\xe5\xf7\x64\x2d\xd7\xb5\x5b\x83\x4c\x14\x2f\x37\x85\xa6\x9c\x48\x73\xfc\x5e\x29\xbe\xc8\xdf\x53\x4d\x24\x98\x11\x52\x41\x64\x75\x5d\x14\xfc\xde\x08\x4d\x87\x44\x3a\xa6\x70\x6b\xab\xdb\x6d\xb2\x95\x53\x0d\xa3\x4c\x85\x37\x9e\x4c\x15\x81\x6a\xe9\x70\xdf\xf2\xa1\xe1\xbe\x77\x1b\x08\x27\x18\x80\x4b\xb0\x63\xb0\xbf\xfd\x30\xaa\x4f\x3b\x92\xd1\xf0\xeb\xdf\xab\x07\x3e\x7f\xf7\x11\x42\x0f\x5c\x83\x89\xac\xa2\xe7\xb8\xed\x1a\x37\x3c\x24\x29\x8b\xe3\xca\xcb\xd0\x7c\xee\x76\x88\x5b\x47\x8d\xea\x85\x95\x71\x10\x01\x02\x17\xb4\x3f\xb4\x77\xa9\xbc\xce\x75\xb6\x42\x0c\x6f\x33\x56\x72\xaf\x4d\x4a\xe1\xc3\x45\xd7\x49\xa1\x34\x98\xfe\x8c\x21\xdd\x1d\x9e\xd4\xd5\xad\x8f\x8b\x6d\xa4\x77\x0d\xd5\x7b\x17\x50\x71\x6f\xae\x59\x51\xbf\xfc\x22\x21\x66\x10\xa5\x54\x4f\xa2\x24\x1a\xac\x10\x27\x43\x9c\x6a\x4c\x60\x84\x05\x1d\x12\x4d\xeb\xfc\x54\x0f\xa2\x34\x22\x8f\xdd\x23\x19\xf6\x9e\x73\x5c\x3d\x68\x90\xd3\xe1\x38\xaf\x83\xc8\xe4\x83\x01\xde\x32\xca\xa7\xb9\x55\x12\x97\x54\x0f\x56\x88\x91\x1c\x8f\x8b\x01\x5d\x23\x46\x4a\x58\xcd\x2a\x40\xa6\x5f\x91\x0e\x69\x1c\x9f\x94\xb0\x84\x29\x2a\xe9\xf3\x38\xe6\xd3\xe7\xb3\xdd\x8e\x4f\xa3\xbf\xfc\xc5\x3f\x88\x12\xcd\x48\xd9\xf5\xa0\x4a\x39\x29\x53\xcb\x66\x77\x65\xc2\xd8\x39\x2d\x9d\x99\x34\x86\xa7\x6f\xfa\x88\x51\x0e\xf1\x56\x10\xc6\xc9\x5c\x0a\x3e\xc6\x8c\xb2\x04\x84\x13\xa4\x9a\xc6\x60\x80\x49\x63\x22\x75\x6c\x95\xfa\x61\x06\xe6\x45\x7f\x06\x2e\x73\x62\x25\x7f\x0a\x3d\x18\x91\x68\x6a\x4b\xb9\x28\x25\x33\xd0\xf3\x4d\x5c\xd5\xde\x75\xae\x57\xbd\x0b\x7e\x53\xf6\xb6\xd1\xa0\xf5\x08\xad\x75\x82\x24\xbd\x08\x43\xd0\x28\x41\xa2\xa8\x56\xa7\x17\x4d\xc7\xac\xd0\x5b\xc1\x3f\xec\x31\x4c\xcd\x51\x36\xa4\x4c\xc3\xe7\x60\xd5\x30\x02\xbf\x13\x28\xb9\xd0\xb4\x66\x44\x93\x8d\x0b\x50\x9a\xea\x46\x88\xcf\x86\x93\x13\x81\xb7\x71\x4d\x8a\x5b\x68\x7f\x07\x89\xf6\x17\xb0\xe1\xa8\xb6\x6c\xc5\xa4\x76\xb7\x1b\x6c\x10\xab\xab\x3d\xe6\xf4\xc3\x6d\x1e\x9c\xd6\xc9\x15\xe2\x44\x35\x9e\x30\x0a\xdd\xda\x52\x37\xb5\x38\x46\x36\x84\xab\x39\x1d\x88\x13\x39\x40\x7d\xe8\x1b\x1c\xec\x60\x2f\x40\x8d\x6c\x56\x20\x8a\x52\x00\xb2\xb0\x0c\x15\xa0\x7d\x4b\xa2\x7b\xf1\xb1\xd9\x23\xb0\xe0\xc3\x98\x28\x0f\x53\x70\xe8\x1f\xe5\x60\x6c\x0d\x56\xa2\x68\x6c\x07\x21\x00\x9f\x9b\xa6\xc5\x2d\xcd\x12\x4d\x17\x48\x13\x06\xd5\x89\xd9\xef\x25\xd1\x98\xcc\x91\x0e\xba\xb8\xa9\x8c\x91\x7f\xf3\x58\xae\x01\x7f\xec\x01\xad\x4e\xe6\xfd\x51\x60\x9b\x01\xf2\xda\x33\x2a\xd0\xe8\x33\x4c\xde\xdf\xf5\x60\x91\x7b\x25\x68\x21\x15\xb9\xa6\xef\x27\xf5\x27\x8a\x80\x7c\xf5\x1c\x76\x84\xd3\x87\xc3\xd1\xf0\x01\xb9\xe8\x2c\xb5\x96\x4a\xb3\xc2\x15\x7a\x48\x9e\x76\x16\x5a\x28\xb6\x0c\xda\xfa\x82\xbc\xec\x2c\x66\xe9\xeb\xb3\x4b\x39\xe7\xae\xe4\x97\xe4\x55\x77\xaf\x4a\x2e\xf2\x82\x2b\x5b\x6c\xf4\x19\x39\xb9\xad\xd8\x55\x3e\xf7\xc5\x86\x5f\x91\x5f\x3b\x8b\xb9\x73\xe8\x1a\x1b\x92\xd3\xee\x49\xd8\x88\xaf\x67\x8a\x2f\x5c\xc9\xfb\xe4\x45\xf7\x3c\x36\xe5\x9a\x8b\xd2\x4d\x62\xf4\x80\xbc\xe9\x2c\x76\xc9\x2f\xa5\x2b\xf2\x39\x79\xdd\x59\xa4\x60\xbf\xdf\xb8\x22\x0f\xc9\xf3\x8f\xd8\x4e\x0f\x5d\xc9\x8f\x74\x9b\x97\xf0\x32\x04\x9f\x1f\x32\x82\xfd\xd1\x9e\x70\x01\x7c\xf8\xb7\x52\x65\xfc\xf0\xf5\x8f\x2a\xff\x75\x10\x37\xb1\xb3\xc0\xa9\x7b\x43\xa1\x91\xb9\x27\x8f\xe9\x76\x3f\x96\x81\x35\x4d\x5e\x02\x2b\x52\xf1\x2d\x10\xc6\x2c\x7c\x2f\xce\xb5\xd3\x11\xd0\xe0\x00\xbb\x74\xea\x7a\x6a\x38\xd7\xba\x22\x5f\x7e\x8e\xf1\x38\x24\xf2\x93\xd6\xd0\xad\xe0\xdb\x5c\xec\xc8\x8f\x22\xc2\xcd\xd1\x2d\xea\x65\x6a\x48\xe1\xbb\x9a\x0d\x96\xd4\xb7\x1c\x05\xf5\x4d\xd3\x2c\x50\x03\x05\xdd\x00\xde\x7d\x42\x83\xc7\xbb\x20\xde\x07\x1b\x3f\x69\xbc\xf7\x55\x90\x33\xf4\x24\x1c\x1e\x26\x4f\x92\xbc\x7c\xb5\x51\xbc\xb5\xc6\xce\x7a\xea\x19\xdd\x3a\x48\x02\xf0\x72\x4f\xbe\xa3\x1f\xb0\x76\x22\xdf\x52\x90\xf4\xf7\x87\x40\x2c\xf7\x87\xe4\xec\xac\xe4\x85\xff\x05\x71\x4f\x40\x3d\xfe\x96\x1e\xbf\x3b\x1e\x1c\x2f\xc9\xef\x74\x3a\x23\xbf\xb5\x3b\xfa\x3b\xdd\xda\x21\xd9\xd4\x27\x79\xb9\x66\x3a\x5b\x71\x95\xfe\x46\xc2\x8c\x6f\x4c\x2a\x04\x91\x5d\xa6\x5b\x7f\x81\x5c\x1b\x61\xb9\x97\x96\xfc\x27\xcf\xca\x53\x79\xc9\xdd\xa3\x2e\xea\x71\xa6\x73\xb1\x4c\xab\xbe\xcd\xf9\xb6\x86\x62\xe9\xd9\x7e\xac\x93\x13\xcf\xb7\x6c\x2f\xd9\x3a\x6d\x33\xf7\x87\x2f\xda\x73\x67\x67\x50\x87\x3d\x70\x58\x08\xa2\x86\x82\xba\x68\x4f\x9c\x5f\xf9\x47\x35\x67\xe0\x7e\xed\x4c\x0f\x2d\x18\xe8\x7f\x59\x41\x7f\x47\x28\x77\x20\xb9\xf3\xd0\x3d\xbf\x41\x1b\xec\xad\x3e\x62\x4f\xb4\x04\x6c\xd9\xe1\x5b\xd0\x9c\x80\xb6\xdd\x77\x63\x52\xa2\xf7\xe0\x6e\xd4\xf6\xf0\xe9\x1b\xbc\xda\xba\x51\xa3\xcf\x1e\x84\x48\x67\x4f\x02\xd9\x2d\x3c\x99\xf2\xad\x83\xf8\xf4\x29\xd1\xc9\x2b\x07\xad\xe9\x2b\xf3\xb1\x51\xbc\x2e\x5b\x10\x9d\x9c\x02\xd8\x7f\x21\xe7\x9c\xbe\x34\x9f\x6e\xf3\xe9\x0b\xa2\x93\xb3\xb3\xd3\xa7\x27\xaf\x9f\xbe\x39\x7b\xf6\xe3\x9b\xa7\xaf\x7f\x7c\xfc\xfc\xf4\xec\xc9\xcb\xb3\x1f\x5f\xbe\x39\x7b\x7b\xfa\xf4\xec\xe5\xeb\xb3\xbf\xbf\x7c\x7b\xf6\xf3\xb3\xe7\xcf\xcf\xbe\x79\x7a\xf6\xed\xb3\xd7\x4f\x9f\xd0\xbf\x13\x9d\x64\x85\x14\x5e\x32\x74\xf0\x92\x7b\x83\x72\xad\x1f\xde\x6e\x4d\xf1\xfe\xc3\x2f\x08\xc7\x3e\x94\xdc\x19\xb8\x8d\x5a\x2e\x11\x83\xbb\xcf\x05\xbf\x81\x48\x4d\x86\x89\x34\xdc\xae\xe5\x18\x43\x71\x40\x1d\x7c\xe6\x50\x12\x40\x72\x5a\xb1\xb0\x1f\x90\x0a\x58\xc6\xd5\x30\x96\x70\x4d\x9b\x5c\x35\x90\xe9\x1d\x39\x60\xdb\x98\xd9\x07\xcb\x2a\xa1\x42\xd6\x29\x54\xc8\xac\x50\x21\x9b\x05\x61\x92\xa6\xd9\x2c\x30\x27\x2c\x27\xe5\x34\x9b\xa5\x26\xd5\x2a\x84\xb3\x3b\x64\x0e\xd9\x6d\x32\x87\x0c\x6f\xbd\xac\x21\xab\xa3\x05\x6c\xe8\x70\xbc\x79\x94\x8d\x37\x20\x73\xd8\x84\x32\x87\xcd\x81\xcc\xe1\xc3\xac\xfd\x2d\xe2\x82\x1c\x8e\xa8\x15\x18\xba\x87\x9e\xba\x83\x54\xb7\xc2\x18\x59\x46\x84\xd3\xba\xcb\x5f\xc9\x59\xc6\x8a\x6c\x53\x98\x96\xc0\x95\x7c\xfe\x4d\xae\xcb\x54\x93\x33\xb7\x9d\x3f\x39\x55\x7d\xe3\xfb\xbe\x49\xd0\x2b\xc5\xd9\xdc\x3e\xeb\x3a\x24\xaf\x1c\x71\x02\x40\x8e\x9c\x48\x51\x6e\x2e\xdd\x97\x39\x6a\x3e\x3b\xe8\xfb\x84\x9c\x79\xc6\x9a\x9b\x22\xbe\x0e\xe5\xf5\xec\xfc\xa1\xcf\xab\x94\x6f\x99\xc1\x19\x37\x1d\xe6\x39\x79\x10\x77\x2d\x74\x3c\x02\xbc\xc3\x0d\x3c\xf0\x6d\xbc\xe6\x8b\x43\xb7\x8e\x26\xa4\x37\x85\x1d\x89\xd4\x28\xcd\x0f\x25\x32\xa7\xde\x01\xc5\x42\x8e\xe6\xfb\x71\x34\x23\x96\xee\xb9\xb3\x89\xd7\xe4\x0c\x0c\x23\x38\x39\x2b\x35\xd3\x9b\x32\x3d\x1a\x91\x33\x27\x92\xa8\xc6\x63\x28\xac\xce\x7d\xae\x1b\x7a\xe3\x85\x4d\x99\xbc\x5c\x33\xc5\xd3\xfa\x04\xb8\x07\x15\xa1\xa5\x4d\xc9\x4f\x58\x51\x9c\xb3\xec\xa2\xfb\xe0\xdc\x20\x1c\x16\x72\xcf\x2d\xda\x8a\x77\x1d\x38\x5f\xcf\x96\x09\xab\x3d\xe1\xe7\x9b\x25\x1c\x1e\xda\x20\xbb\x20\xf3\xe9\x62\xc1\xb3\xbb\x9b\xb4\x45\xc2\x16\x9f\x5d\xae\x0d\x75\x98\x5f\xf1\xef\xc0\x91\xee\x00\x32\x36\x1b\x68\x17\xf7\xef\x2b\xd8\xc6\x9e\xb3\x1b\xb9\xd1\x1f\x31\x8e\xb0\x60\x38\x9a\x17\xb7\x6d\x8e\xaf\x68\x0a\x84\x15\x5e\xc3\xf3\x91\xea\x03\xa3\x76\xa5\x9a\x83\xed\x3e\x91\x75\x15\x88\x14\x63\x8b\x1e\xda\xb2\x35\x0b\xbb\x00\xe2\x50\xfc\x8a\xab\x32\x97\x82\x46\xa3\x87\xc9\xe8\xb3\x64\x18\x7d\xbc\xe2\x20\x14\x58\x4b\xf3\xe5\xcc\xf2\xd1\x67\x9f\xe1\x31\xc8\xe6\xb8\xfe\xd9\x8a\xcd\x4f\x58\xb6\xe2\x10\x16\xe0\x20\xe4\x57\xdb\x1c\xc7\x32\xa6\xee\x3d\x4d\xc3\xfb\xb3\x2a\x04\x55\x10\x53\x97\x15\x45\x2e\x96\xbd\x57\x5e\xc1\xda\x83\xb0\xae\x86\x6d\x28\x7b\x56\x7b\x52\xdc\xf4\xf2\x12\x6c\x97\xdc\x6b\x09\x7c\xde\x3b\xbf\x01\x7d\xc0\xff\x31\xa0\xf5\xc8\x5c\x9a\xf2\xff\xf4\xd6\x2c\xbb\x60\x4b\x9e\xf4\xde\x96\xbc\x6e\x2f\xc9\x56\x3c\xbb\xa8\x3e\x11\xee\x69\x69\x6d\xc8\xf4\x8a\x5f\x26\xbd\xd7\x9c\xcd\x7b\x97\x52\xf1\x1e\xd3\xbd\x95\xd6\xeb\xf4\xf8\x78\x71\x9e\x5c\xf2\xe3\x4d\xc9\x8f\xa0\xf2\x51\xdd\x4b\x84\x9d\xbd\x61\x9e\x58\x0b\xef\x67\x5e\x73\xd2\xfb\x29\x97\x05\x58\xc4\x46\x24\x0f\x24\x8c\x81\x41\x0c\xdf\xf3\x40\x45\x4c\xbd\x0f\xce\x16\x1e\x8e\x4d\x39\x39\xcf\x97\xb9\xd0\xe6\x87\x94\x45\x6a\x09\xad\x94\x13\x2b\xbc\x4b\x39\xb1\x9c\x47\xca\x89\x95\xed\x99\x1f\xc0\x68\xa5\x9c\x30\x61\x1a\x80\x86\x5e\x2e\x52\x4d\x1c\xbf\x9c\x72\xff\xeb\x8d\x05\x2c\xde\xcc\x0e\x0a\x09\x39\xe7\x55\xb3\x90\x02\x6e\xe3\xfe\x2f\x54\xd1\x04\x9e\x2f\x33\x4d\xbe\x67\x99\x4e\x35\x69\xae\x67\x2a\xc9\xc1\xf1\x48\x55\x65\x3c\x2e\x92\xaa\x24\x15\x44\x7c\xc8\x1a\xb2\x3e\x55\x91\x23\xb5\x1c\x81\xf5\xea\xf1\xe9\xe9\xd9\x9b\xef\x9e\x9d\x76\x92\x59\x7f\xe8\xa8\xdf\x12\x94\xee\xec\xec\xf5\xd3\xc7\x27\x6f\xce\x9e\x3c\xfd\xe9\xcd\xcb\x97\xcf\x4f\xcf\xfe\xfa\xfc\xe5\x37\x8f\x9f\x9f\x7d\xf7\xf2\xe5\x0f\x67\x67\x9d\x41\x40\xee\xae\x62\x0f\xde\x93\x93\xa7\x60\x26\xfa\x91\x65\x91\x0a\x8c\x47\x0d\x97\x25\x0b\x9e\x80\x3e\x0b\x62\xfe\x2b\x84\x83\x9b\x27\xd0\x67\x0f\xff\xa3\x1e\xfc\x9f\x53\x0f\xda\x58\xbe\xa4\x20\x39\x29\x7d\xd0\xdd\x8f\x79\x68\x9e\x3c\xc0\xd6\xec\xb8\xb2\x95\xcf\x82\x5d\x06\xa6\x5d\x8a\xa7\xd5\x26\x37\x6d\x58\xa1\x5b\xd7\x29\xc9\xf0\xf6\x37\x49\xfb\x23\xf2\x77\x69\x15\x10\xd2\x35\xf9\x8d\xec\x7c\xbd\xbe\xf0\x5a\x07\x5f\x9f\x58\x5f\x7d\xd6\x6d\x63\x47\x7e\xb3\x01\xc8\xfa\xbf\xc9\x36\x8f\xf5\xd5\x97\xd8\xfb\x2a\xfe\x5d\x8e\x9b\x83\xf8\x59\xee\x76\xe8\x67\x49\xfb\x43\xf2\x93\xa4\x8b\x70\x0a\x79\x5b\x16\x6b\x16\x69\xb7\x8b\x36\xe2\x42\xc8\x6b\x71\x04\x71\x12\xcc\xbd\x77\xe4\xdb\x1b\x08\xa2\x40\xff\x2a\x91\xc0\xa4\x40\x8a\x68\xff\x98\x0c\x37\x27\xbf\x59\x0a\x48\xab\x40\xe3\x06\x63\xff\x9b\xac\x54\x0a\xdc\xb0\x1c\xff\x90\x9e\xc2\xfc\x87\x9c\xf2\x19\x11\xf4\x6f\x32\x8c\x7f\x0d\xf6\xc7\x47\xa3\x47\xa2\xcd\x56\x7e\xf5\x10\x58\x2e\x93\xff\xbd\x84\x68\x9d\x10\xe4\x20\xe1\xef\xb5\x62\x99\x86\xe7\x4f\xca\x76\x1d\xcb\xa6\x41\x79\xaa\xc1\x55\x07\x66\x08\x00\xb0\x0e\x4a\x66\x5d\xbc\x9c\xc4\xd6\xcd\xaf\xa0\x62\x2a\x67\x24\xa7\x9a\x94\x54\x9a\x6e\xef\xc9\x36\x77\x54\x1e\x8c\xf1\x2b\x52\x62\x3c\xbe\x27\xa7\xe5\x8c\x16\x63\xcb\xc3\x14\xc9\x7a\xc5\x4a\x3e\x7f\xcd\x97\x79\xa9\x15\xe0\xa4\x1f\xd9\x25\x2f\x5d\xac\x06\x33\x0c\x66\x86\xb0\xc1\x9b\x76\x0f\x0c\xc7\x71\x86\x36\x53\x36\x83\x43\x3e\x66\xb4\x3f\xb4\x2a\x96\x22\x51\xad\xf6\x26\x28\x43\x87\xa9\x50\x8f\x98\x7a\x38\x65\x4e\x5b\xd5\x67\xed\x71\x7f\x49\x24\x3c\xb5\xba\xdf\xb7\x42\x70\x3b\x9e\x98\xb3\x29\x9f\xb5\xcf\xe0\x70\x08\xab\x0b\x79\x54\x13\x6d\xff\x06\x2b\x3c\x15\xb3\x64\xce\xd7\x86\x9e\x17\x59\xce\xcb\x2e\x85\x27\x11\x66\x50\x66\x0d\xea\x30\x38\x6d\x79\x13\xd2\xb8\xf6\xb4\xd7\xf6\xd5\x94\x7f\x1c\xec\x86\xc6\xbb\xdd\x3f\xe4\x54\xcf\xfa\x94\xda\x7b\x03\x5f\x07\xa3\xbe\x0f\x2f\x2d\x40\x1e\x95\xa6\xfb\xe1\x7e\x2f\xe2\xb8\x44\x4d\x6d\xa9\x9d\x37\xfd\x45\x22\x8e\x6f\xb3\x0a\x57\xed\x85\xbc\xff\xe5\x10\xfb\xb0\xee\xee\x51\x9d\x1f\xe5\x9c\x8f\x2d\xc7\xf8\x83\x84\x98\xd6\xe0\x2e\xec\xb3\x1c\x0b\x6f\xdf\x95\x6d\xea\x41\x25\x9b\x30\xf3\xbf\xd7\x34\xa4\x8c\xd1\x29\x9f\xa5\x92\x51\x1e\x6a\x32\x61\x74\xfe\x7d\x18\x4e\x25\x23\x9a\x32\x88\xd4\xcc\x18\x95\xce\x22\xc1\xcc\x88\x68\xa7\x22\x1b\x8e\x79\x6d\x8d\xcd\x07\x03\xbc\x40\xda\x6c\xf0\xbe\xa5\x6f\x0a\x1c\xb3\x74\x5b\xb9\xe4\xf5\x1c\x75\x09\x97\x12\x2a\x8b\x42\x4a\x76\x89\xb0\x73\x6a\xa1\x92\x79\xdf\x41\xca\xd8\x6e\x87\x2e\x11\x26\x6b\xd4\xa1\x4a\x81\xa9\x95\x0c\x87\x9d\xe0\x71\xc9\xe0\x75\xb3\xda\xdb\xa3\x60\x9e\xa4\x5f\xe4\x82\x15\xc5\xcd\xd6\x14\x19\x91\x65\x23\x52\xf7\x4d\x18\xb7\x69\xc3\x2c\x56\x98\x33\x78\xe9\xb0\xfa\x5e\x98\xef\x38\x46\x19\x4b\x34\x2f\x35\xe2\x78\x32\x87\x83\xdd\x1f\xa6\x68\xe1\x7e\x91\xfe\x28\x1c\xec\x59\x43\xb7\xee\x34\x6b\xc2\xba\xcc\x08\xd8\xde\x3a\x5e\x93\x53\xed\x56\x46\xff\x56\x3b\x5c\x1d\x2d\xab\x1f\xb6\x74\x64\x94\xfa\xe0\x4e\x56\x3b\xec\xf5\xd0\x3e\x59\xc5\x71\xd5\xd9\xa4\x2f\x12\x96\x65\x7c\xad\x4b\x17\x5f\xb5\x4c\xa3\x39\xd3\xec\x28\xea\x53\x8a\x38\x6d\xbb\x62\xfa\x17\x40\x3f\xc7\x38\x8e\x23\x43\x4e\x40\x49\x8e\xc7\xde\x39\xa4\xd2\x06\xd4\x13\x7d\x7f\x30\x51\xc3\x0b\x07\x82\x32\xbd\xdb\xd5\x8b\x51\x47\x5a\xcd\x17\x48\xd5\x4b\x10\xac\x11\x76\xcb\xe1\x56\xc9\x6a\xb9\x1f\xf8\xae\x35\xcc\xbb\xf7\x59\x35\x14\xd3\x83\x4d\xfb\x3c\xf5\x1e\x70\xe5\x8f\xec\x47\xa4\xb1\x4d\x7e\xd8\x4e\xde\xed\x46\x5f\xeb\x7d\x35\x95\x6a\x26\xd7\x21\x09\xe1\x90\x7e\x6b\xfd\xe8\x7d\x3b\xa1\x07\xf6\xcf\x67\xe6\x8f\x35\x07\x62\x3e\xf0\x39\xc4\xb2\x51\x1d\x89\xe5\x9a\x65\x9c\x4a\x9b\x73\xb9\x29\xf5\xdb\xb2\xf2\x45\xa0\xa2\xb6\xd7\x36\xdf\xd0\x88\xb3\x41\x02\xe9\x8a\xeb\xa5\x64\x22\xd7\xf9\xef\xf0\x5c\x13\xab\x47\x7e\x11\x4a\x66\xa7\xa3\x59\xa2\xe5\xdb\xf5\xda\xef\x6b\x5d\xee\x69\x3b\x2e\xfd\x9a\x1d\x42\xcc\xc9\x9a\x4d\xf5\x0c\x84\x22\x63\xbf\x29\x72\x02\xa6\x38\x30\x96\x14\x0e\xd9\xfd\x0a\x58\xc4\x31\x8a\x24\xd8\x10\x4c\x87\xb3\xdd\x2e\x7a\xe9\x7f\x9b\x2b\x13\x09\xfb\x05\x4f\x5c\xfd\xe8\x7f\x63\x8c\x77\x3b\xf4\x1e\x59\xba\xc7\xc6\x5f\x73\xb2\x33\x65\x43\x0b\x99\xde\x26\x66\x34\xee\x3c\x83\xee\xdc\xbb\xb8\xd5\x41\xe6\xc1\xff\xa4\x11\x76\x5e\x13\x50\xa7\xe2\x54\xb6\xd7\x78\xc2\xa7\xb2\xb1\xc0\xf6\x21\x70\x68\xf9\x41\xdf\xcf\x2e\x8e\xa3\x28\x15\x29\xd2\x54\x36\xb7\x8f\xa8\x76\x0a\x6c\x28\xb9\x7b\x74\x48\xba\x76\x89\xa0\xe6\xd0\x48\x7b\x68\x64\x1c\xf7\x87\xd6\x1e\x20\x4a\xcd\x80\x89\x9a\x34\x27\xf2\xe3\x29\x50\x56\xa2\x63\x82\x02\x37\x22\x82\xbe\x6c\x3d\x29\x7b\xab\x85\x85\xb5\xaf\xe0\xf4\x39\x03\x03\x0b\xd6\x61\x61\xd1\x1d\x1a\xce\xda\x57\xd4\x3d\xbe\x72\x98\x10\x82\xe7\xf2\xc4\x49\xd4\xf0\xb6\xfa\x49\x87\x15\xbe\x03\xb1\xdb\x58\x53\x0d\x6c\x91\x93\xb8\x99\xe3\x0c\xd1\x19\x1b\x0e\xbf\xc3\xb0\x35\x40\x8e\x95\x5f\x2a\xa9\x9b\x1e\x85\xcd\x84\xac\x55\x47\x0b\x75\xad\xfb\xcd\x5a\x21\x0a\x38\x69\xbc\xd8\x52\xe9\x60\xbc\xb1\x5e\xd7\x92\x54\xb8\x27\x09\x22\x3b\xed\x76\xd6\xff\xd0\x1e\x60\xa8\x7a\xe0\x2e\x5e\x2b\x78\xbc\x39\x8f\x83\x6f\xef\x99\x83\x52\x91\xd7\x84\x44\x16\x7a\x9d\x55\x19\xaf\xac\xe6\xdc\x26\x5f\xd4\xc9\x5e\xb5\x6d\x33\xae\xab\x8c\x5a\x4d\xe2\xb2\x7e\xad\xb3\xbc\xc2\xd9\x66\x9c\x1e\x64\x3c\xcf\x4b\x1d\xed\x1b\x96\x2e\xd5\x14\x6e\x33\x44\x7a\x59\xb5\xe2\x84\x93\x95\xbc\xd9\x75\xf3\xf4\xa0\x80\x97\x59\xbb\x02\x27\x2c\xf5\xc7\xc6\x0a\x7c\x2b\x11\xb3\x39\x09\xe1\x4a\x6b\xb7\xd2\x51\x44\x5a\x7b\x80\x22\x83\xb2\xf4\x24\xfa\xb6\x92\x2d\x23\xe0\x4c\x71\x94\x06\x69\x91\x43\x0e\x2f\xfc\x98\xcc\x31\xb0\x08\xc7\x66\xbc\x6e\x64\x38\x27\x01\x9b\xf5\x86\xa5\x40\x06\x36\x4e\xff\xa4\x3a\x5f\xf6\xb6\xd4\x95\xeb\xa7\xad\x1a\xbc\xd0\xaf\xb5\x5c\x3d\x8a\xc6\x73\xb9\xe5\xb5\x89\x97\x66\xcb\x0a\xef\x39\x74\xe7\x50\x19\xfc\xf9\xc2\xfe\x19\x0d\xed\xdf\xaf\x52\x1f\x88\xca\xda\x8d\xf5\x78\x85\xaf\x3d\x4b\x77\x36\xe7\xe7\x9b\x25\xe8\x42\xc1\xc6\xc6\x7e\x9f\x82\x56\x96\x30\x5a\xcf\xdd\x82\x61\x02\x64\x04\x3d\x41\xca\x26\x63\xa2\x28\x23\x8c\x46\x11\x91\x13\x46\xa3\x1e\x62\x86\xdf\x97\x89\x39\x79\x66\xdd\x2b\xeb\x95\x25\x23\x51\x84\x07\x51\x6a\x72\x8b\x5c\xf0\x1f\x41\x32\x06\xcb\x6f\xcd\x5d\xa2\x1e\xb2\x3a\x02\x90\x10\x56\x2f\xc1\x09\x1a\xbd\x13\xbd\x5e\xcf\x10\xfc\xd1\x00\xa9\xdd\x2e\x7a\x6b\x19\xd0\x08\x0f\xd8\x5e\x0f\xa8\x20\x1c\x0e\x06\xf8\xf6\x5e\xaf\xf2\x82\x87\xd1\xcf\xea\x85\x3d\x35\x0b\xdb\x24\xa9\xdc\x2d\xab\xa9\xa5\x86\xc5\x5d\xc3\xcc\xee\xd0\x30\xaf\x16\x44\xa5\xd5\xed\x6d\xd2\x43\x51\x14\x80\x93\x17\xc1\x2b\xb0\xb0\x7a\xfe\x0d\x58\x33\x7a\x21\xe7\xb0\x5e\x86\xbe\xca\xc5\x7a\xa3\x6d\xac\x8a\x06\x19\x66\xb0\x26\xc8\x9a\xce\xe5\xfb\xc8\xd2\x19\x91\x62\xf3\xdc\xe2\xd8\x00\x0c\xbf\xa9\x7b\x32\x9d\x4e\x6c\x2d\x33\x4e\xe7\x0c\x49\x04\xad\x3d\xf8\x02\x24\xff\x84\x97\x99\xca\xd7\xf0\x66\x44\x68\x27\x10\x38\x38\xc2\x3b\x3b\x51\x34\xa8\x78\xab\x0e\xfe\x2b\xd0\xf8\x89\x4e\xe1\x1b\xc4\x99\xb8\x25\xa7\xe4\xba\x7a\x1c\xc7\x14\x83\x78\x00\x25\xaf\x5e\x0c\xb9\xcd\x65\x53\x83\xa3\x6b\xe8\xb9\x4a\x96\xbc\xcb\x75\x41\xd6\x2f\x76\xe0\x3d\x29\x79\x4b\x57\x6e\xcd\xf5\x58\xf0\xac\x07\x07\x77\x81\x3b\xfa\x0d\x7c\x69\x45\xe0\x90\xba\xc7\x10\x78\xe3\xa7\x5b\x3c\x1b\x15\x74\xde\xca\xad\x46\xb0\x27\xa5\x96\xeb\x37\x8a\x65\x17\xb9\x58\x86\xd5\x79\x72\x06\xbb\x08\x79\xdc\xbe\x10\xe2\xfc\x1e\xe0\xf9\x84\x06\x5f\xfe\xda\xda\xc8\x36\x6a\x40\x98\xcb\x66\x1b\x6f\x9a\xb6\x69\xcf\xbd\xc6\x3e\xe0\x45\x2a\xbc\x1d\x56\x1c\x87\xb1\x23\x9d\x89\x88\x7b\x74\x19\x26\x86\xec\x69\x19\xd7\xde\xe5\x48\xd9\x33\xc9\x13\x77\x28\x27\x91\x56\x1b\x1e\xa5\xd1\x82\x15\x25\x8f\x52\x6e\xad\x2d\x31\x41\x9c\x2a\xec\x9e\x95\x05\x0f\x63\xdb\x22\xc7\xf0\xe4\x55\x35\xd6\x1f\x5b\x0f\x10\xd9\x56\x7d\x8f\x6f\xa5\x0b\x90\xe2\x2e\xe6\x89\xcd\x76\xca\x39\xe2\x52\xed\x26\xb8\xb4\xab\xf0\xc3\x35\xe7\x8c\x03\xc5\x44\xa4\x3c\x39\xbb\x56\xcc\x10\xd1\xa0\xc0\x49\x9c\xd3\xb9\x6b\x38\xb4\xa2\x7e\x1c\x8e\xcc\x45\x68\x48\xc2\x1e\x0d\x8d\xd7\x4c\x21\x76\x3b\xfb\xf5\x44\x26\xd5\xaf\xba\xa8\xeb\x6b\x2c\xe8\xa9\xb7\x11\xb0\x8b\x36\x71\x7f\x53\x01\x34\x55\x38\x4e\xba\x6d\x0e\x34\x55\xc4\x25\xd8\xc9\x5b\x53\x6b\x25\x8b\x82\xcf\xd3\x26\x98\xf1\x72\xbf\x1a\xd6\x40\xca\xa4\x35\xd2\xb4\x31\x94\xe0\x0c\x3e\xb1\xeb\x60\xb3\x81\x78\x73\x35\x70\x1c\x1b\xc6\xa3\x02\x4f\x44\x1b\x5e\xb9\xae\xf8\xcc\x56\xb4\xf5\xdd\xd9\x3a\x45\xda\x1f\x10\xe5\x06\x52\xf3\x88\x02\xd7\x6f\x91\x52\x35\x81\xf8\x42\x06\x02\x59\x60\x0a\xd5\x0c\x31\x06\x3f\x4c\x69\xa0\x04\xe1\x8b\x02\x73\x90\x56\x79\xf0\xdd\xce\xae\xcd\x7c\xcb\xcd\xf9\x65\x0e\x30\xda\xe0\x23\x50\xa2\xc0\x47\xe3\x89\x83\x43\xba\xdf\x81\x5f\x3c\xd6\x6d\xa0\xe9\x73\x26\xdf\x9a\xc9\x3a\xc6\x00\xa7\x87\xe5\xc2\xd3\x12\xe1\x38\x0e\xca\x9b\xa5\x09\xb3\x31\x26\xfe\xd4\xb9\x15\xf6\x76\x6d\xed\x93\x04\x13\x6d\x26\xd1\x7e\xbf\x5d\x2a\xd8\x99\xef\x02\x89\xcb\xad\x73\x31\xd4\xd8\xdd\xc3\xaf\x1c\xfb\xab\x7d\xec\x57\x6b\xdb\xa7\x54\xc5\xb1\x5b\xdb\x3e\x2c\x74\x60\xdd\x02\x5d\xd4\x61\x16\xdc\x91\xa8\xc2\x8a\x00\x08\xed\xbe\xa8\xf6\x9e\x89\xdd\x4e\x87\x87\xa2\xda\x68\x6d\x6e\x4e\x38\x4a\xaa\xf7\x82\x5a\x02\x9e\x00\x09\x69\x8f\x45\x1d\x23\xea\x70\xe1\xee\x06\x11\x07\xad\x84\xa6\xd8\xdf\x56\x8a\xa0\xfa\x20\xeb\x38\xe6\x09\x98\x9f\x54\xaf\x44\xd9\x88\xbf\xde\xd0\x01\xf8\x3a\xc7\xa6\x18\x76\xb3\x31\xfc\x0f\xac\x44\xda\x2c\x1e\x9e\xfc\x76\x33\x22\x44\x11\x6f\x1b\x54\xaa\x3b\xf6\xdf\xca\xca\x40\xae\x7a\x2c\x85\x37\xac\xc4\x6a\x23\x6b\x3d\xa0\xf0\x2e\x55\x40\xa0\xfd\xde\x14\x23\x52\x03\xbc\x2b\x8f\x5d\xff\x3e\xbd\xc6\x04\x69\xfa\x16\xe9\xda\x7b\xdd\xde\xe3\xca\xbc\xc7\x6c\x61\xdd\xe8\x6f\x0d\x31\x94\xa1\xb5\xdc\xa3\xa2\xa6\x2b\x1d\x46\x8b\xb7\x4f\xe1\x8a\xf0\x29\x5c\x3d\x8d\xee\x45\x03\x31\x95\xb3\x19\xed\x0f\xa1\x64\xc7\x03\xc3\x92\x1e\x1e\xf4\x7b\x86\x44\x12\x33\x0f\xab\xe0\x77\x09\x0f\x72\xf1\x79\x1f\xf8\x7c\xd4\x48\xa3\x12\xa2\x1c\x2b\x9f\xee\x16\xff\xd4\x67\xfb\x67\x1e\xb7\x76\x0c\x51\x34\x38\x45\x02\x13\xe7\xcb\xd2\xf5\x8a\xaf\x99\xed\x54\xba\x11\x18\x40\x58\xf1\x98\x26\xb5\xac\x1b\x06\x2d\x0d\xb2\x3d\xcb\xce\x9e\xf1\xd8\x5f\xb4\xdd\xce\x96\xc9\x4b\x43\xe1\xcc\x77\x3b\xa4\xe1\xa1\x60\xbc\xf7\x25\x1c\xb6\x0e\x86\x5d\xef\xc5\xdf\x1b\x21\x2d\x01\x0e\x31\xb1\xe4\x4a\x6e\xca\xe2\xe6\x94\xeb\x67\x42\x70\xf5\xdd\x9b\x17\xcf\xdb\x2a\x87\xc0\x32\xbd\xc2\xe8\x0d\x3c\xdd\x85\xc8\xab\xa3\xf3\x81\x2b\x10\xe2\xec\x9f\x9b\xd4\x04\xf4\x51\xdb\xcc\x3b\x9b\x3f\x5a\x9f\x3d\xa2\x5b\x38\x9d\x78\x4c\x14\xcc\xb2\x3d\x9b\xfb\x56\x35\xd5\x74\x50\x10\xd8\xc5\xd3\x19\x7d\x4d\xfd\x19\x3c\x50\x19\x3d\xc0\xc0\x89\x4d\x87\xb3\xbd\xa6\x62\xef\xc0\x3b\x88\x44\x22\xe0\x95\xf4\xfe\x36\xbc\x6f\x97\xc6\x1c\x9a\x60\x43\x7e\x0a\xa7\xdb\x40\xad\x07\xc8\x24\x70\x4d\x80\xe3\x27\x88\xe8\x57\x00\x34\xc0\x94\xa2\xc6\x3a\x61\xfd\xc0\xc3\xcf\x03\x9a\x0e\x28\x23\x1c\xce\xea\x53\xd5\x09\x82\x54\xb0\x55\xdf\x34\xf8\x29\xfe\x5e\x9f\xd8\xa7\xf4\xc6\x16\xb0\xdf\xbe\xdf\x86\x20\xb0\x27\x35\x38\xb3\x35\xfc\xaf\x7b\xf8\x21\x60\x18\x3d\xa7\x58\x5e\x2d\x3d\xbb\x17\x39\x8b\x93\xeb\xeb\xeb\xe4\xfa\x01\x28\xe1\xef\x0f\x87\xc3\x63\x53\xc4\x0a\xe1\x2f\x99\x5e\xdd\x51\x7a\xf4\xd5\x57\x5f\x1e\xbf\x60\x7a\x05\xff\xbc\x78\x1e\xb5\xd9\xc7\xce\x2a\x5f\x1d\xbf\x5f\xe9\xcb\x22\x64\x2d\x7f\x69\x00\x4e\x27\xaf\xda\xed\xee\xae\x0f\x0f\x88\xfd\x80\x34\x4e\xef\x9a\x08\x05\xb7\x9f\x68\x21\x15\xcf\x97\xe2\x65\xe5\xde\xa4\x27\x77\xb7\x9e\x06\x20\xf8\xaf\xd5\xb5\xaf\xce\x5a\xe3\x2d\x4f\x73\x53\xe2\x18\x5e\x34\x4b\x0a\xe6\x52\xe3\xf8\x01\x68\x44\x0c\x67\xfc\xa6\xd6\x8a\x00\x69\x85\x6c\xf2\x4f\x7e\xc7\x1a\xfb\x4f\x03\x8c\xf2\xb7\xd6\xc3\x20\x7e\x85\xa6\x2d\xd6\x7a\x06\x71\xcc\x83\x04\x22\xa6\xd1\xcf\xfc\xfc\x22\xd7\xd1\x80\xcf\x68\x74\xed\x7e\x6b\x93\xf1\x42\xfe\x6e\x53\x2f\xcd\x0f\x4d\x82\xe7\x3e\xfe\xe1\x38\xa7\x67\xa0\x03\x75\xdd\xc1\x07\x50\x37\x4f\xc2\x64\x17\x3c\x92\x08\x0a\xc9\xb5\x5e\x53\x60\xb3\x22\x5d\x7c\x35\x64\x7f\xc7\x1a\x0d\x53\x61\x58\xf1\xca\x4c\xa9\x1a\xca\xf7\xf5\xfd\xf8\x81\x19\x8e\x2c\x90\x8a\xb4\xad\x53\xf9\x75\xef\x05\x5b\x93\x1f\x98\xe1\xb3\xec\xa3\x21\x21\x62\xbe\x17\x5c\x35\x22\x28\xb7\x6f\xd5\xb2\x42\x73\x25\x98\xe6\xa0\x28\x1c\x6b\x27\x82\x19\x63\x4d\xab\xdf\x80\xb2\x38\xd5\xe3\xb9\xdc\x9a\xca\xc3\x3e\x45\xa3\xe1\xfd\x87\xb1\x4e\x38\x18\xf2\xbd\x61\x4b\x2b\xe5\xf7\x55\x30\xe1\xd5\xef\x4a\x9a\xe3\x25\x65\x0f\x2c\xf7\xc1\x96\x13\x91\x36\xa5\x66\x9c\xbb\x95\x1f\x3d\xb0\x92\x13\xb6\xac\xa1\xc3\x25\xbf\x94\xf9\xef\xdc\x86\x97\x0b\x5c\xa0\x6a\x10\x00\x84\x41\x3d\x21\xf7\xf4\x68\xa3\x9e\x87\x4c\x75\x20\x66\x03\xe1\x56\x37\x73\xc5\x34\x9f\x77\xcb\xf2\xb4\x1f\x95\x59\xc1\xfe\xa1\x6d\xf8\xe8\xcb\x2f\x43\xa2\x4a\xf0\x10\xa6\x55\xc3\x71\x5c\x76\xa0\x46\x43\x9a\x9a\x16\x0f\xac\xe9\x4d\x73\x95\xe4\xcb\xf4\x67\x65\xfb\x7c\x5f\x07\xd7\xe2\x44\x52\x3d\x1e\x7b\x0b\x06\xe1\x77\xaa\x6e\x9c\xd9\x00\xf3\xce\x67\x95\x35\xc7\xe1\xca\x14\xa1\xf6\x12\x49\x88\x5e\x0f\xbb\x87\xb7\x82\xca\xb1\xe1\x25\x73\xb1\xe1\x7b\x1b\xaa\x1e\xac\x56\x00\x65\x9a\xaa\xf6\x97\xb5\x65\x30\xed\xc3\xe7\xb8\x18\x5b\x5b\xc3\x90\x52\xd1\x1c\x31\x4c\xf8\xd8\xa5\xcb\x66\xba\x1e\x17\xb4\x48\x4a\xfb\x32\xfe\xbe\x6b\x61\xe1\x1a\x39\xe1\x04\xa5\xd2\x8f\x51\x50\x46\x24\x2d\xea\xa7\x85\xad\x65\x63\x7f\x44\xca\x6a\x38\xa5\x1d\x4e\x49\x2d\xd6\x37\x64\x92\xaf\x56\xcd\xa9\xa4\xd6\x78\xc0\x64\x4a\xca\x88\xa8\x32\x4b\x5a\x56\x03\x33\x9b\xe7\xdc\xe4\x4b\x3f\xf9\xee\xe6\x0d\x1d\xc7\x6e\x6b\xbe\x30\xfd\xdf\xda\x7c\x7b\xf6\x5f\x61\xbc\xb7\xd3\xaf\x76\x0f\x2c\x1d\xda\x96\x41\x43\xbb\x4a\x0f\xfa\xa0\x7c\x66\xcb\xbb\x0e\x94\xa8\x0d\x11\xbc\x31\x8f\xd5\x6f\x85\x81\xf1\x94\x3f\xf1\x7d\xc4\x29\x9c\x67\xdc\x50\x9c\xd4\x56\x6c\x7c\x6c\xd7\xe0\x73\x7f\xa7\x77\xbb\x87\xfe\x67\xb5\xd1\xf6\xf1\x75\x7b\x60\xdc\x5f\xb7\x8b\x54\x13\xed\x09\x31\xbb\x93\xa6\x28\xf8\x5f\xd8\xe3\x0b\x40\xa9\xaf\xfd\x42\x8d\xbd\x0d\x90\xad\x6e\x98\x53\xd7\x50\x5b\xb7\x53\x83\xaf\x7d\x55\xbb\xea\xd4\xfd\x80\xbe\xfd\x16\x74\xdf\x7d\xc9\x0f\x02\xb8\xb7\x1c\x2e\x87\xc1\xda\x3a\x0f\x5d\x9d\x1e\xb8\xad\x36\x13\xb4\x8d\x7f\xbc\x29\x57\xce\x0c\x0c\x44\xb2\x1c\xa7\x2e\x15\xd9\xaf\x83\x4a\x53\x5e\x85\x77\xd5\x38\x9d\x72\xa2\x67\x81\xb1\x9a\x37\xc9\xde\x1e\xf4\xce\x2b\x46\xce\xea\x1c\xe3\x58\xfb\xf8\xd5\xa1\x8b\x7c\xe1\xb7\x3d\x80\x5f\x67\x73\xe7\xa3\xf5\x1c\x62\xf8\x72\x55\xc2\x13\x38\x55\xf2\x33\x67\xcf\x5a\x1e\x12\xc3\xba\xf6\x2d\x57\x74\x38\x56\x81\x4e\xb9\xcf\x93\xbc\x34\x48\x91\x2d\xc1\x6e\xe9\x54\xcb\xf5\x9a\xcf\x11\x1e\xab\xc1\x00\x83\xcd\xda\x54\xcd\x08\x84\xd4\xb6\x02\x1b\x1d\xc7\xde\x94\x6d\xdc\x35\x2a\x1f\x0e\xe0\x70\x60\x3e\x27\x2f\x5f\x71\x55\x42\x79\x8d\x30\xbc\xd8\x15\x08\xc7\x15\x2f\xb8\x21\x1a\x1a\xf6\x7f\x39\x0f\xb4\x87\xd6\x77\x1a\xfd\xc2\xa8\xe4\xe8\x17\x46\x38\x36\x58\xee\x17\x46\x7e\x71\x06\x37\x2e\x50\xa9\xd9\x86\x82\x63\xf2\xcb\x81\xcd\xd5\xe7\x96\x65\xf8\xd9\xdf\x60\x4e\x7f\x92\xe4\x67\xb0\xe4\xfb\xc9\x59\xf2\x85\x22\x37\x18\x4d\xcd\x48\xf3\xea\x81\x23\x9e\x94\x2a\x73\x72\x83\xdd\xce\xc6\x0f\x05\xa7\x69\xa5\x78\xb9\x96\x62\x9e\x8b\xe5\xdb\xd2\x4b\x16\xc0\x09\xfa\xd6\x5c\x4c\x2c\xaa\xf5\x14\xda\x04\x9e\x89\xe1\x02\x5e\x57\x0f\xe9\xbf\xac\x82\x09\x82\xd5\x02\x65\x4e\x23\x29\xa2\x81\x0f\xa1\x0d\x06\x7f\x3e\x9c\x4e\x85\xc3\x80\xc1\xec\x8e\x6f\x6a\xa3\xe4\x61\xa2\x9b\x6a\x70\x4e\x22\x87\xd1\xc0\x4b\xba\x43\xc7\x00\xd6\x4b\x21\x79\x03\x52\xe5\xad\x21\x09\xd7\xcf\xf9\x15\x2f\xde\x80\xbb\xa4\xdd\x7a\x01\x7e\x0b\x60\x30\xe8\x93\xec\x5a\x9a\x43\xe2\x53\xe0\xb0\x68\xa9\xbc\x3d\xad\x8d\x29\xf1\x57\x56\x1d\xda\xbf\xb2\xc3\xa8\x12\x0b\xde\x90\x4c\x54\xa5\xbd\xf6\xc3\xd4\x69\xc6\xd9\x68\x8c\x8f\x13\x69\xad\xe7\x4e\x6f\x4a\xcd\x2f\xbf\x2d\xd8\xb2\x84\xf8\x1a\xe1\x88\x35\x91\x8d\xe1\xd6\x41\x37\xc2\xb6\x52\x4e\xda\x2d\xa5\x8a\x04\xed\xa4\x9a\xd4\xad\xa4\x82\x54\xf3\x4d\xa7\xb3\xd0\x10\xad\x41\xbe\xd4\x35\x0c\x5f\x6a\x08\x40\x38\x01\x66\xa1\xeb\xf5\x82\x55\x11\xd8\xe1\x35\x17\x93\xd9\x9c\x74\x73\xb4\x94\x45\x05\x54\x85\x88\xc7\x07\x77\x7c\x26\x16\xb2\xc6\xe0\x63\x55\x51\x9f\xa6\xbc\xfb\xad\xa8\x41\x6c\xca\x12\x8c\x86\x10\xba\xb5\x25\x40\xa3\xca\x61\x0e\x6d\x71\x21\xf9\xdc\xf2\x86\x0f\x9d\xe0\xe3\x70\xd8\x44\xd0\x5f\x38\x52\xd8\xd1\xa9\x02\x37\x24\x44\xed\x53\x61\x43\x99\x02\x65\xe7\x73\xa6\x62\xe6\xfc\x02\xcd\x8d\x0d\xb7\x0e\x8f\xc1\xe2\x36\xd8\x25\xf7\xde\x42\xa3\x14\x38\x0e\xb6\xf7\x6e\xec\xc4\xde\xa8\xd8\xd1\x87\x9f\xd5\x1e\x72\xb9\x3d\xae\x25\x1d\x8e\xcb\x47\xdf\x57\x8f\xe5\x96\x3e\xc0\x6a\x46\xbf\x97\xd3\x72\x36\xce\xe2\x18\x65\x34\x6b\x1a\xcb\x82\xd1\x09\x03\x97\x10\x43\x1d\xe7\x06\x92\xe5\x24\x33\xe4\x03\x47\x79\x08\xf9\xd6\x3c\x10\x0e\xf7\x81\x8d\x01\xab\xc8\x36\x23\x9d\x29\x59\x14\x51\x7a\x02\xa6\x39\xee\x0b\x1e\xab\x0d\x62\x9c\x2c\x64\xb6\x29\x9d\xba\xf5\xbc\xd8\x28\x5f\xdc\xa6\x9b\xd2\xc4\x26\x40\x26\x7c\x83\xb2\x10\xb9\x04\x6b\x3a\xe4\x92\x5c\x25\x1b\x90\x25\xe8\x24\x33\xdb\x51\xb8\x5e\xb2\x42\x96\x3c\x4a\x01\x64\xc5\x31\x34\xce\xdb\xa3\xca\x05\xf8\xb9\x78\x9d\xb0\x95\x57\xdb\x0f\x2b\xae\x4e\x6d\x61\xcf\xcd\x83\x39\xcc\x37\x2c\xb0\x5c\x8e\xe3\x57\x96\x3a\xd8\x0b\xc7\x71\x59\x4b\x9a\xc0\xa4\x92\x87\xac\xeb\xf7\x48\xe3\xb1\xf5\xe8\x07\x5c\x1c\x8a\x54\xab\xf5\xde\x63\xc2\x3e\x58\x24\x30\xcc\xe4\x87\x96\x99\xdb\xf3\x42\x66\x17\x7c\xfe\x52\xa4\x9c\x34\x40\x84\x3e\x04\x11\x0f\xee\xef\x44\x03\x4a\x48\x52\xdd\xa9\x34\x0c\x79\x74\xe9\xe6\xd2\x3a\x01\x87\x9b\xfb\xbd\x45\x87\xe1\x5a\xcf\x15\x5b\xc2\xcb\xdd\xae\xa0\xf9\x86\x37\xa0\xa3\xf4\xde\x61\xe9\x4b\xb9\x29\xb9\xbc\xaa\x4a\xdb\xef\x8d\x8e\x52\x5e\x1c\x14\x5e\xcb\xdc\x34\x1c\x14\xf7\x29\xa6\x82\x2e\x12\xab\x54\x45\x3a\x71\xe9\xcf\xe6\x8d\x63\xb0\x94\xda\x65\x64\x6c\xad\x37\x8a\xbb\x56\x0a\x59\x1e\x64\x88\xce\xd6\x82\x35\x5a\xf2\xa6\x91\xe1\x81\xdd\x56\xe3\xde\xf7\x29\x65\x13\xc4\xe9\xaa\xb2\xa4\x25\xac\xe6\x4f\x6b\xae\x56\xd3\xbf\x72\x43\x4c\xc5\xf1\x5b\x86\x2a\x3a\xb1\xbd\x93\x3b\xaa\x1a\xc4\xdc\x55\xe3\x64\x78\x8b\x89\xe6\xae\xb9\xf1\x7d\xcf\xe8\x92\xa3\xef\x19\xa9\x2b\x10\x6f\x82\x1a\x6c\x9d\x2b\x7d\x0f\x4a\xdf\xeb\x2c\x1d\x6c\x9d\xa7\x5b\x0a\x53\x9a\x17\x5d\xa5\x1b\x7b\x67\x01\xa3\xac\x17\xb6\x22\x1f\x0a\xb8\x5d\x8c\x2c\x39\xd2\x05\xc8\x40\x18\xb6\x66\x58\x41\xa3\x75\xab\x1d\x3b\xea\x5a\x6a\x34\x4f\x44\xd0\xae\xb8\xb3\xdd\x0e\x6b\xd2\x9b\x00\x4b\xfe\xc2\x91\x47\x94\x38\x30\x75\xad\xee\xfd\x3d\xd4\x48\x07\xc1\x10\x08\x38\x90\xc3\x51\xb8\xc1\x87\x6b\xca\x0d\x16\xc2\xb5\x19\x5a\x75\x9f\xa9\x73\xc6\xf8\x5d\x26\x1b\x43\xde\x9e\x17\xfc\x4c\x6d\xc4\xcf\xb9\x5e\xbd\x52\xb9\x54\xb9\xbe\x81\xc7\x2f\xed\xcf\x30\x4e\xc0\xef\x0c\x01\xe0\xf0\xca\xcf\x07\x4e\x70\x12\xa0\x52\x27\x04\x69\x88\xe8\xc2\xbe\xad\x24\x0f\xb0\xf0\x6d\x08\xd8\x99\x12\x86\xb5\x9a\xec\xd4\x59\x9b\xa0\xae\x4b\xb6\x8d\x14\xde\xc0\xaa\x06\xd0\x8b\x1c\x9e\x79\x12\xf4\x4f\x5a\x98\xb7\x63\x23\xe0\x1a\x85\xcc\x9a\x95\x5f\xbf\x35\x6b\x43\x9a\xcb\xdc\x1f\xf9\x4d\x0f\xde\xd9\x7d\x5f\x21\xc5\x33\x8b\x59\x44\x05\x0f\x82\x8b\x77\xcd\x91\x15\x14\xfc\x0d\xbc\x2f\x86\x8f\xfe\xe1\x89\xc2\xb1\x37\xd8\xff\x07\x9b\x0e\x67\xe3\xee\x65\xd8\x72\x33\xce\x30\x85\xd4\xdc\xc7\xb7\x0c\xf1\x90\xce\xfa\xef\x2f\x93\x5f\xa3\x49\x63\xfe\xe9\x3f\x98\x7d\xc4\x18\x55\x3a\xa1\xef\x59\x1c\x9f\x19\x28\x61\x28\x07\x07\xe3\xab\xa1\xdd\xb3\x99\xf7\x20\xf3\x5e\x2b\x93\x17\x90\xc9\x0b\x50\xf1\x15\x2e\x53\xd7\x58\xee\x3d\xc7\xe6\x36\x06\x9f\x81\xf1\x33\xf7\xf1\xa7\xea\xe1\x79\x61\x7f\xf3\x94\x91\xbf\xb1\xdd\x0e\x16\x7d\x48\xc2\x0b\xe2\x23\xa9\x56\xce\xd8\x61\xe6\x8f\x52\x5d\xb2\xc2\x5f\x1e\x72\xcd\x1b\xc6\xb7\x4f\x79\x2b\xb4\x7a\x2d\x9f\xbf\x00\xa2\x02\xe4\x2e\xc1\x0e\xe3\xed\x05\x47\xb0\xbb\x84\xd7\x34\x9b\xf5\xd7\xab\x8f\x41\x10\x0d\xff\x1f\xcc\x86\xc3\x0f\x27\x07\x06\x36\xad\xc9\x19\x2c\x63\xa8\xd2\x7a\x2f\x2e\x2c\xc4\x6e\x6c\xc1\x85\x85\xcb\x8d\x95\xbf\xb0\xd0\xb7\xb1\xe0\xba\xb1\xde\xe6\x0b\xa8\x5d\x55\x84\x23\x54\x54\x15\x53\x31\x23\x1f\x31\x38\x2b\xa6\x19\xd6\x0d\x80\x30\x58\x15\x66\x1d\xbc\x05\x75\x70\xa0\xc7\xf8\x86\xa3\x4a\xc5\x14\x66\xc5\xb1\x2a\xaa\x83\x57\xdb\x40\x77\x3e\x2e\x10\x68\x6f\xe9\xfd\xca\xbf\xcd\x8c\x58\x9a\x3f\x83\xd1\x8c\x30\xcb\x9d\x22\x39\x1d\xb6\xac\xe6\x07\xb2\x7a\x87\x09\x8f\x19\xdd\xde\xe2\xb0\x95\x6e\xcf\x37\xe7\xe7\x05\x9f\xa7\x8c\x38\x6c\x32\x4f\xd9\x20\x3a\x71\x98\x65\x4f\x42\x6f\xa7\x74\xaa\x66\x96\xc2\xf2\x07\x2a\xd5\x7b\x52\x5a\x3c\xa3\x88\xc6\x24\xf7\xbf\x19\x26\x45\x31\x95\x33\xca\x02\xf2\xc1\x91\x93\x5b\x4f\xb0\x86\x66\x34\x27\xbc\xe9\xc8\x57\x5a\x8c\xa5\xb1\xb7\x35\xae\xb4\x01\x6a\x72\x3f\x55\xce\xc0\x74\x98\x2a\xfa\x2b\x0f\x02\x3b\x68\x32\x22\x3c\x24\x80\x7a\xa3\x54\xd1\xd3\x5b\x8b\x54\xba\x2c\xfa\xe2\xb0\xcc\x5e\x4c\x78\xc2\xe6\x73\x00\x25\x5e\x12\x83\x34\x51\x86\xd6\x4e\x6f\xcb\x0a\x67\xf5\x6b\xc8\x3e\xb3\xdd\xee\x12\xf9\x10\x2b\x2f\x38\x61\x34\x67\xe3\xbc\xf2\xfc\x59\x41\xbc\x43\x5b\xb8\xf2\xfa\x41\x39\xa3\x06\x6b\x37\xfd\x7e\x4e\x83\x66\xd7\x05\x9a\x17\xa4\x31\xfa\xca\x5d\x25\xb0\xeb\x6c\xf2\xf1\xe7\x05\x6e\x5e\xec\x38\x3e\x1a\x3d\x92\x45\x40\xfd\x63\x20\xdc\x1a\xed\x91\x7f\x54\x12\x02\xcb\xd4\xda\xa9\xbc\xa9\xdb\x0e\x84\xef\x12\x03\x45\xad\x6a\x83\xa4\xee\x1e\xe4\xad\xcd\x9b\x3a\xfd\xab\xb0\x04\xde\xba\x36\x09\xa7\x20\x99\xf0\x31\x8a\xac\xfb\xeb\x15\x9a\x73\xa0\x11\xdd\xe2\x6d\xac\xb8\x2b\x58\xb8\x37\xcd\x65\x10\x86\xa3\x55\x15\x38\x41\xc0\x29\x1b\xd2\xc4\xcd\xec\x9e\xe1\x96\xc3\x29\x59\x78\x50\x4f\xde\x50\x5c\x9a\x2d\xc7\x9e\xe2\x61\x0d\x4a\x47\x18\x4a\x47\x06\xb2\x65\x67\xa0\xdc\xa0\x52\x6c\x15\x79\x3b\xa1\xf2\xc0\xfa\xae\xb0\xe5\x44\xde\x49\x97\x34\x1a\x97\x4e\x75\x5d\x41\xd7\x7a\xc1\xee\x5e\xad\x4e\x29\xf1\x6b\xde\x19\xab\x51\xef\x76\x95\x51\x72\x2d\xb8\xda\xed\x7c\x4c\xce\x28\x15\xbb\x9d\xb7\x11\xea\x07\x05\x9c\x63\xd5\xaa\x68\xab\x0f\x0d\xd1\xb1\x2a\xa6\x7c\x36\x41\x51\x34\xd0\x38\xd1\x2a\xbf\x44\x38\xd5\x83\x68\xfd\x3e\xf0\x97\x7e\xee\xb1\x26\xf8\x24\xde\x14\xbc\xc6\x45\x36\x0a\x50\x97\xc9\x97\xa8\x4c\xb9\xac\x1b\x5b\xf5\x5c\xf1\xd1\x51\x84\x89\xa4\xaf\x39\x12\x44\x03\x4a\xc0\xe3\x68\x51\x48\x06\x4a\x64\x67\x4c\x90\x95\xe5\xb7\x90\x84\xbd\xb7\x4b\xdd\x30\x91\x38\x35\x90\x99\xca\xe0\xac\xfd\xc8\x43\x8d\x72\xbe\x40\x4b\x33\xb1\xca\xc3\xad\x36\xcf\xf0\xcf\x91\xdf\x6e\x68\xd2\x56\x78\x3c\xf8\x02\x22\xa3\xe2\x80\x0a\xbc\xbd\x72\x68\xc9\x52\x59\x23\x35\x1b\x7c\x38\x74\x7e\xc8\x87\x1e\x13\xb7\xb6\x1b\xc7\xd1\xd9\x19\x68\xd1\x73\x71\x7b\xa9\xf6\xd0\x1f\x8e\x9c\xc6\xcb\x0d\x08\x36\x2f\x8e\x0f\xdf\x37\xb2\x39\xed\xda\xf7\x61\xd6\xc1\x22\x3f\xae\x17\xd9\xf9\xf3\xd4\xbb\x1a\xf9\x8b\x77\xe0\xc9\xa2\x93\xbc\x6c\xf9\xb0\x44\xf0\xf6\x13\x60\xc6\xa3\xf7\x97\x95\xa0\x45\x16\x52\x1d\xb9\xf8\x8b\x2e\x6d\x21\x85\x3e\x5a\xb0\xec\xe0\xfb\xa8\x54\xd9\x41\xda\x46\xe5\x07\x69\x0b\x43\x94\xe9\x83\x64\xc1\x2e\x7d\x9b\x97\x79\x59\xe6\x62\x79\xb4\x2c\x6e\xd6\x95\x71\x46\x7f\xd4\xf6\x64\x0c\x03\x0d\x3f\xa9\xae\xc4\x57\x0d\x81\xf7\x6e\x37\x1a\xb5\x24\xe0\x69\xcb\x52\x6f\xec\xe5\x37\x1c\x8f\x35\xd5\xac\xf1\x5a\x68\x43\xb3\x01\xea\x8b\x35\x47\xa0\xbd\xe0\x8d\xf8\xac\xcf\x78\xc3\x37\xf6\x3b\xcf\x09\x55\x78\x1b\x71\xb0\x00\xec\x0a\x8d\xe1\x65\xe7\x13\xff\xc3\x59\x47\xb5\xd4\x72\x81\x7f\x2c\x6f\x5a\x17\xee\x76\x3c\x39\x97\xf3\x1b\x17\xf4\x20\xb0\xcf\xb3\xc9\x81\xed\x22\xf7\x61\x2a\xc6\x60\xee\x13\x58\x78\x60\xde\xb4\xf8\x38\xb4\x56\x78\xdb\x90\x77\x41\x53\x63\x4e\x87\xf5\x62\x8d\x85\xd5\xe0\xb5\xac\x42\x4c\x92\xa2\x7c\x20\x42\x13\x10\xff\x56\x22\x7f\x64\xc8\x7d\xf5\x75\xa5\xb2\xdf\x42\xd8\x14\x41\xe4\x62\x51\x72\x9d\xea\x23\xbe\x1f\x73\xaa\xf6\x3c\xb5\xe3\x76\x7d\x08\x88\x01\x7c\x6a\xd5\x7b\x78\x2b\x68\x23\xc1\x3b\xd3\xec\x4d\x7a\xad\xf1\xd8\x0b\x17\x0e\x60\x0f\xe3\x6f\xd8\x59\xfd\xce\x43\x0b\x9d\x3e\xea\xf3\xdd\xae\xaf\x6d\x90\x59\x80\xd7\x90\xf2\xa0\x1f\x1e\x26\x30\x42\xb0\x56\x30\xba\x3e\x61\xb6\xa5\xa0\x5b\x9c\x46\x0e\x61\x95\x06\x5c\xf0\x49\x85\xc0\x4a\xa4\x71\xda\xef\x9b\x6f\x88\x48\xe5\xcf\xe4\x2b\x59\x42\xe0\x8c\x38\xee\xf7\xd1\xe8\x61\x7c\x6b\x01\xa4\x9b\x0e\x85\xbf\x79\xf6\xd4\xb2\xa2\x4e\x7b\xa4\xe9\x77\x1c\xe1\xb1\x0e\x9f\x81\xe3\x89\x81\x50\xcf\xbe\x55\xec\xd2\x9f\xa3\x31\xde\x02\x6a\xec\x7e\xba\xda\xc5\x4e\x15\xfa\x67\xfb\xa4\x5d\xe1\x5f\xa8\x5c\x29\xbe\xa8\xe3\x6d\x08\xda\x1f\xed\xad\x1a\xc1\x12\x99\x9c\xb6\x6a\xda\xe1\xf0\xea\x4d\xbc\x0a\xed\x06\xfa\x9e\xbf\x87\x7a\x0a\x73\x52\xbd\xf7\x4d\xf8\xbb\x69\x0f\x54\x49\x94\xe2\x18\xd5\x0e\x3a\xf0\x05\x11\x5f\xad\xcd\x89\xb5\x93\xb7\x2f\x6b\x37\x92\x34\x2f\x1a\xdf\x1b\xd5\xfc\x5e\xb3\xb2\xbc\x96\x6a\x5e\x27\x62\xa8\xf5\x5e\x33\xc5\x99\x77\xf6\x01\x47\x09\x28\xe1\xa6\xfc\x74\x6e\xdf\x32\x0b\x0d\x18\xbb\x65\xad\xe7\x1b\xad\x2b\x8f\x71\x3b\x7e\x27\xbd\x06\x4b\x4d\xf7\x51\x75\xe8\xa1\x60\x5f\x27\x6c\xa3\xe5\xb7\x32\xdb\x94\x1d\xa2\xac\x9f\x1a\xc7\xba\x31\x5e\xf0\x34\x5d\x3b\xc5\x1b\x7c\x09\x69\x9d\x8b\xfc\x77\xc7\x11\xa8\xb0\x76\x6d\xfd\xdc\x95\xf9\x07\x10\x69\x65\x20\xfe\x81\x12\xb7\x16\x48\x2c\x26\x0e\xcc\x0e\x6b\x28\xe7\x8c\x99\x21\x1c\x75\x03\x60\x78\x15\x98\xbf\xb5\x3e\xa4\xa1\x77\x10\xb7\x87\x77\x7f\x08\x05\x7f\xb0\x9a\x48\xca\x93\xb5\xe2\x57\xb9\xdc\x94\x1e\xe6\xd4\x76\x13\xc3\x31\xb7\x50\xea\xcb\x06\xee\xa9\x2d\xeb\xe6\x4c\x43\x40\x07\xc3\x73\xdf\x14\xbb\x9d\xf9\x7b\xed\xfe\xbe\xb7\x86\x3b\x40\x25\x06\x41\x45\x8f\x8e\x2c\x6d\x6b\x8a\x9c\x15\x71\xac\x07\x83\x7d\xc7\x28\xba\x49\xd8\x5f\xc2\xeb\x34\xfd\xb5\x00\xf1\x54\x6d\x24\x15\xc8\x32\x42\x05\xb1\x87\xb5\x9a\x8a\xe9\x8b\x62\xb6\xdb\x09\x53\xb5\x32\xb0\xad\xcc\x56\x2a\xa9\xb6\x3d\x01\x9e\xa2\x33\xb4\xa3\xff\xe5\xec\x43\x6c\xb8\x0a\x58\x42\x2f\x9b\x72\x2b\x25\xec\xb8\x6a\x66\xc1\x15\xab\x61\x03\xa7\x82\x34\xc7\xd7\x3d\xd7\xbf\xb6\x14\xeb\xa6\xd9\xdd\x8e\x9b\x19\x10\x03\xc2\x3f\xef\x3b\xe3\x33\xab\x36\x74\x3f\x47\x0f\xea\xdf\xd5\xcf\xca\x34\xab\x32\x56\xf4\x78\xfd\x73\x6f\xc1\x66\x2d\x62\x78\x68\x11\xd3\x08\x14\xd2\x34\x27\x79\x10\xc2\xea\x7f\x34\x06\x3a\x3d\x85\x15\x6e\x4c\xe5\x7b\x28\x31\x97\xdb\x43\xef\xc9\x38\xae\xe6\x51\x07\x67\x6d\xd7\xbf\xd7\xc0\xd8\xe1\xb8\x2c\x80\x0e\xc9\x0b\xcb\xf3\xfd\x20\x1d\xd3\x57\x3f\xa0\xed\xb8\x2b\x69\xe8\xa2\xca\xcf\xd5\xab\x1a\xa4\x38\x29\xf2\xec\xc2\x3b\x62\xda\xaf\x93\x86\xae\x45\x8a\x27\x72\x73\x5e\xf0\x66\xc1\x20\xad\x5d\xfc\x85\xdc\x94\xfc\x89\xbc\x16\x87\x29\x9d\x45\x5f\xc8\xab\x8e\x94\xce\xa2\x6f\xd7\xed\xef\xce\x62\x4f\xad\x4a\x04\x49\xda\x97\x95\x21\x3d\x86\xb7\x22\x5c\x78\x17\x49\xfb\xc8\x43\x6c\x07\x2c\x6b\x9f\x50\x40\x30\x85\xb3\xc2\xe5\x2d\x2c\x61\x0d\x4b\xfa\xf2\xc0\xdf\x97\x3b\xc4\x79\xe0\xc5\x2e\x3a\x23\x60\xb7\xb9\x98\xfb\x0f\x46\x44\x93\x2a\xb3\x96\x84\x07\x06\x98\xda\x33\xb2\x48\x53\x38\x1b\x22\xf1\x06\x35\x36\x1c\xf3\x6d\xa1\x8d\xa6\x7a\x06\x3a\x65\xd1\x65\x9b\x23\x79\x67\x3a\xd1\x98\x88\x2e\x8b\x9d\x66\xf9\x2a\x9d\x34\xfc\x1b\xb5\xf6\xd6\x4a\x60\x96\xfe\x51\xa3\x0c\x03\xb0\xf1\xe4\xac\x61\xdd\x30\x9d\x8d\xf5\x18\x8b\xca\x00\x4b\xd3\xef\x41\x5f\x00\x16\xbd\x95\x35\xff\x78\xf8\x48\x1f\x1d\x8d\x31\xd7\x48\x4c\xf5\x8c\x44\x5e\x2c\x18\x79\x89\xaf\x01\xee\xba\xf6\x40\xd1\x83\x41\x5d\xd8\x49\x13\x23\xd2\x30\x33\x12\xd5\xaa\xf3\x38\x16\xa0\x56\x68\xce\xa6\x1d\xe2\x09\x2c\x5b\xbb\xb7\xa7\x5d\xf4\x7f\x78\x53\x94\xae\x1e\xdf\xfe\xe0\x1c\xcc\xb4\x9b\x7b\x60\xad\xa8\x02\xeb\x3b\x68\xcd\x1a\xb6\x85\x2a\x16\xa6\x6d\x08\xa4\xe7\x95\x27\xfe\x73\x0b\x9e\x60\x19\xe9\xeb\x82\xa8\x6a\xc3\x88\xa4\xf5\xeb\xb1\x6f\x8a\xc9\x9b\xc2\xb9\x57\xbe\x29\x42\x6e\x83\x30\x5a\x19\x53\xd4\x21\x93\x54\x1c\x8b\x29\x9f\xc1\x43\x45\x7c\x06\x81\x93\x9c\x45\xad\x3a\xe2\x6e\xb7\x47\x63\xfd\x88\x16\xa6\xa0\x3a\xd2\xb6\x28\x3b\xd2\x33\xd8\xf8\x71\x35\x3c\xea\x05\xce\x9c\x8c\x1e\xe9\xc9\xe8\xa8\xe2\xe1\x02\xfb\xbb\x3a\x54\x65\xa8\x76\xca\x75\x18\xa9\xbf\x36\x0f\xd3\xb5\xac\x0e\xc2\xc7\x34\x17\xdc\x07\x98\x09\x16\xd8\xc7\x99\x69\x98\x43\x11\x4e\xfd\x63\x58\x95\x31\xdc\x33\x03\xdd\x0c\xcf\xdd\x8c\x98\xc6\xf1\x81\x9f\xb8\x34\xc7\xcb\xbb\x0f\x4d\x4c\x43\x53\x39\xa3\x1a\x09\x9c\x46\xb6\xe3\x08\x82\xbd\xd8\x58\x37\x36\x6a\x9c\x4a\x7d\x39\x61\x6a\x55\x54\xb9\x29\x92\x97\x4f\x7c\xdc\x6a\x10\xa4\xf3\x39\xf5\x6e\xa1\x75\x44\x6b\x97\x33\x39\x4c\x4a\x21\x62\x90\x37\x17\xb6\x3e\x2f\x93\x42\xa7\xb9\x9b\x79\x97\xd1\x21\x75\x99\x81\xa9\x9b\x6e\x48\x41\xa1\xa6\x15\xeb\x4b\x59\xb4\x0c\xbc\x5a\x99\x0d\x63\x2f\xbb\xae\xac\x28\x42\x21\xae\xac\x68\x12\x7e\x0d\x25\x50\x2d\xd4\xae\x6c\xd9\x74\x6d\x7f\xdb\x78\x98\x7b\x95\x1f\x44\xa0\xbb\xff\xc5\x57\x18\x8f\x79\x32\xe7\x7e\xfb\x10\x26\xa3\xe1\xd7\x9d\xa3\x8e\xe3\xf6\x78\x0f\x0c\xda\xec\x05\xae\x8b\xd0\xe9\x8c\xc0\xc3\xec\xe6\x83\xcf\x69\xa6\x09\xf7\xd6\x92\x74\x13\x30\x64\x73\xdd\xc9\xb4\x5c\xf0\x9b\xcd\xda\xf3\x22\x47\xa3\x3e\xa5\x4f\x6a\x21\x37\x04\x35\x3f\x31\x0c\xf0\xd8\x17\x9e\x03\x5a\x77\x6b\x74\xff\xfe\x57\x3e\x0e\xba\x29\x55\x15\x82\x37\xb7\x43\xf3\x90\x79\x4d\x0c\x58\x2b\x94\xca\x9b\xfd\x8e\xe0\x56\x6b\xdd\x32\xb0\x9c\x73\xcd\xf2\x82\x74\xbd\x9e\x03\xa1\xb5\x3c\x6f\x6e\x7e\xb7\x5c\x17\xce\xbb\x67\x0f\xcf\x2f\x5a\x46\x9c\x8b\x3a\xc6\xc4\x5a\xfb\x00\x56\xc1\x6c\xbc\x3c\xfb\x3e\x4c\xf9\x7a\x95\x67\x2b\x17\xd5\xe7\xef\x05\x28\x35\x0b\x57\xc5\x80\xad\x67\x96\xfd\xab\x06\xaf\x61\x50\x84\x53\x4a\x7f\x2f\xe2\xf8\xef\x85\xa3\x4c\x5b\xf3\xb7\x94\x70\x60\x3f\xa4\x2b\xf9\xe0\xcf\x1e\x98\xb6\x07\x6d\x49\x94\xfe\xb3\x22\x8e\xdd\x1e\x4f\x10\xa7\x06\x06\x93\xe7\x05\x7d\x5d\xd0\x37\x85\x0b\x43\x59\xd0\xfe\x88\x70\xfb\x0a\x4f\x5b\x7e\xb8\x66\xa5\xae\x4d\x31\xa0\x44\x6b\xfe\x70\xde\x75\x92\x69\x55\xfc\x00\x2f\xf5\x18\xfe\xc1\xfd\xba\xe4\x9a\xfd\xc0\x6f\xc0\x1d\xd9\x15\x88\x63\x5f\xc0\x79\x30\x67\x2b\xa6\xe2\x78\xf4\xc8\xfe\xf2\xf7\xb4\x72\xf3\x30\x89\xd6\x12\x1d\x96\xd6\x67\xd8\x37\x8f\x92\x85\x92\x97\x27\x2b\xa6\xcc\x31\xab\x8a\xec\x0f\x46\x7b\xcb\x7e\xbe\x2d\xe2\x38\xba\x90\xe0\x91\x06\x62\x8f\xc2\x39\x6d\xd8\x6d\xf9\xc0\x26\x5c\xea\x3f\x2b\xca\x08\xa4\x18\x93\x7e\xff\x9b\x62\x6a\x89\xcf\x59\xda\x14\x3b\x04\x16\x4a\xba\xa5\xa9\xe0\xb4\xd4\xf5\xed\x46\x3f\x14\x66\x9d\xc4\x92\x5b\x80\xe5\xdf\x1b\xa0\x91\x4d\x8d\xc8\x1c\x09\x4c\x00\x2f\x87\xfe\xb9\x57\x16\x60\xf1\x06\x28\xb9\xf1\x50\xec\x39\xfa\x5b\xc3\x87\x20\xa8\x78\x56\x9f\x3f\xdf\x45\x68\xca\x1f\x8c\xfc\xbd\xc1\x82\xbf\x14\x71\x8c\x7e\x29\xe0\xa2\x66\x2b\xc0\x60\x28\x92\xc2\xc7\xfd\xf2\x83\xbc\xd6\x98\xfc\xb5\xa0\xbf\x38\x5b\x83\xc0\x36\xc3\x0f\xc9\x91\x04\xc0\x98\x85\x51\xc3\xe2\xf8\x46\xa3\xbf\x16\x18\x82\x52\xd2\xa5\xf9\x4d\x38\x01\x4b\x6c\x4c\x72\x86\x73\x5e\x29\xf7\x6a\xcd\xe4\x39\xba\xd2\xa1\xa6\x28\xaf\x43\x11\x06\x86\x0c\xd5\xca\x3b\xeb\x2b\x70\xdd\x43\x66\x5a\xe4\x97\x82\x6a\x33\x60\xf1\xff\x73\xf7\x27\xcc\x6d\xdb\x5a\xe3\x38\xfc\x55\x24\x4d\x86\x43\xdc\xc0\xaa\x64\x3b\x8b\xa9\x20\x9a\xd4\x71\xda\xb4\x71\x9c\x66\x69\xda\xba\x7e\x3d\x30\x05\x59\xac\x29\x40\x21\x21\x2f\xb1\xf4\xdd\xdf\xc1\xc1\x42\x80\xa4\x1c\xe7\xde\xfb\x3c\xbf\x67\xfe\x99\x89\x05\x82\x20\x96\x03\xe0\xe0\xe0\xac\xf8\x8f\xbc\x4f\xe5\xb7\xc6\x86\x12\x8d\xe4\xb4\x38\xfe\x3a\x90\x95\x1f\xb8\x11\xea\x0b\x46\x26\xb8\x07\xd7\xd5\xca\xe0\xe3\xea\x01\x30\xa7\x0f\x73\x0d\x00\x4f\xfa\xee\x4f\x11\xdc\xd0\x6a\xa5\x7d\xca\xec\x9d\x57\x38\xb8\xee\xb4\x4c\x6e\xed\xd3\x7d\x6f\x17\xd4\xc9\x94\x2a\x1a\xc1\x39\x93\x87\x62\x92\x4d\x33\x63\x14\x3a\x6e\x66\xc5\x0c\x25\xdd\x6e\xcc\xc8\x83\xfc\x98\x9d\xa0\x28\xea\x76\x21\x36\x62\x25\x75\xf6\x5c\x82\xef\xfb\xbe\x85\x64\xcd\x76\x5d\x33\x07\x07\x5d\x18\xc2\xf0\x07\x46\xc8\xf0\x07\x89\x56\x2b\xa6\xb5\x71\x64\x37\xd8\x5b\x87\xd5\xd8\xcb\x4c\xdb\xdf\xb9\x53\xa8\x35\x6e\x91\x73\x86\xd7\xe6\xd1\x4d\xba\xb7\x32\x54\x8d\x72\x6e\x7f\x4c\x40\x39\x5c\x04\x19\x46\xf5\xc9\xa0\x3f\xd0\x9c\x0e\x30\xa1\xf1\xbd\xaa\xe5\x16\xdc\x97\x5b\x40\x74\x97\xcc\x86\x07\x01\xdb\x8b\xd5\xaa\xab\x86\x72\xac\x1e\x4e\xb0\xd4\xbf\xa8\xaa\xa9\x49\xac\x7e\x94\xa1\xf1\xb5\xe6\x2f\x03\x82\x92\x8e\x9f\x9b\xec\x85\xfc\x70\x99\xc8\x9a\xc4\xc5\x4c\xc1\x2c\xb3\x50\x98\x64\xab\xd5\x24\xeb\x12\xf2\x33\x8b\x39\x32\xe7\x22\x27\x93\x0c\x57\x0b\xfd\x83\xa4\x85\x54\x67\x34\x8f\xa2\x3f\x75\x31\x72\x5b\xaa\xcc\x84\xf7\xc3\x52\x98\xf1\x89\x9f\x79\xc0\x27\x6b\x55\x5f\xcc\xc3\x8e\xa8\xcb\x58\xe8\xb5\xc1\x5a\x33\x67\xec\xca\xda\x5e\x20\xb5\x04\x3f\xd8\xaa\x62\x84\x39\xb9\xa5\x3c\x9d\x89\x02\x8c\x28\x78\xbf\x7a\xc0\x3a\x79\xa4\x45\x17\xf6\x8d\x7e\xc4\x80\x17\xcc\x27\x2e\xad\x73\xdd\x07\xde\xd3\x1a\xe1\xb3\x2c\x8a\x0e\x65\x7c\x96\x61\x07\x93\xb3\x0c\x68\xfa\x00\xab\x4f\x33\x33\x52\xbc\xc8\xb0\xb6\x35\x32\x68\xdd\x70\x21\x9c\x41\x84\x02\xa8\xc1\xee\xfe\xb5\xee\xbd\x7f\x42\x39\x6a\xcc\x92\x0b\xe6\xd8\xd4\xd4\x91\x15\x65\xf5\x6d\x36\x8a\xa2\xe1\x8e\xd5\xd9\x22\xc3\x1d\x94\x30\x22\xf1\x70\x60\x94\x89\x20\x0b\xef\x6c\x3f\x83\x3d\x06\xc6\x28\x63\x96\x78\x2b\xea\x0d\xb4\x3d\x78\xfe\x36\x03\x0f\x1f\xd6\x68\xed\x4d\x76\xfc\x36\x3b\xc1\xfa\x47\xd3\x1f\x6f\xb3\xad\x2d\xdf\xa9\x90\x59\x8a\x6f\xb3\x87\x0f\x6d\x39\xf7\x7d\xe5\xcb\xda\xdf\xc2\x2f\x64\xc8\x0e\x03\x8f\xe2\x26\xca\x8a\x76\x21\x1d\xb0\xc5\x5e\x64\x23\xeb\x83\x2d\x60\x9c\x15\x51\x54\xf4\x4f\x4f\xc1\xb3\x3b\xdc\xa5\x38\xcd\x0f\x8d\x19\xea\x27\x3e\xa7\xe5\x05\x9b\x80\x0c\xcd\x06\x03\xf1\x18\xb9\x1b\x3f\x3c\x6c\x7c\xa6\x39\x72\x98\x5a\xff\x17\xc6\xad\x35\x85\x2b\xd8\xb1\x70\xf6\xc4\x85\x31\x01\xf2\x5d\x10\x7f\x4f\xef\xe4\xe6\xf2\xcd\x4e\x11\x8a\xb0\xe7\xbc\xf4\xa5\xac\x39\xb0\xec\xba\xe5\x51\x7d\x03\xa0\xf5\x3d\x8e\x79\x14\xc2\x6b\x85\xac\xdf\xc8\xf8\x75\x86\xf0\x1b\x19\xbf\xcc\x7c\x0f\x36\xd2\xb3\x52\x78\x99\xd9\x19\xed\x12\xf2\xa2\x61\x4d\xf9\xf8\x29\x42\xa3\xb7\xaa\x02\xb5\xfe\xdf\xaa\x0a\x03\xf9\xec\x2b\xe9\x2b\x58\x89\xfa\x94\x32\xcb\xbb\xf6\xbb\x8c\x5b\xb8\x6e\x42\xed\x3b\x1f\x20\x15\xc3\x5a\x90\xc6\xcb\xb8\xd2\x12\x04\x8f\xe0\x02\xb0\xb0\xf6\x0e\xde\x8c\xb3\x35\x78\x8a\xf7\xc1\xc5\xad\xf3\x9e\x87\x69\xdd\xa9\x06\xc7\xb7\xeb\xc0\x27\xf3\xa7\xf0\x96\x13\xae\x04\xa4\x48\xcc\x4d\x73\xcb\x8a\xf3\x70\x6e\x57\xab\x17\x19\xfe\x39\x23\x15\xa8\xb1\x86\x28\xb3\x10\x7d\x9d\x55\x71\xac\xfc\x63\xe1\xeb\xdd\xd0\xed\x36\x8c\x5a\x1f\xab\x5b\x2d\x57\x17\x0d\x33\x31\x3f\x67\x08\x8b\xfb\x77\x95\x30\x1c\x2c\x1a\xd7\x51\x94\x98\xfc\xe6\x12\xf8\xa2\x96\x9a\xb9\xb6\x7c\xce\x62\x64\x94\xe8\x7e\xcf\x2c\xdd\xbe\xb7\xa7\x75\xe6\x7e\xac\x72\x9e\xea\x9c\x5f\xab\x9c\x27\x3a\xe7\x8f\x2a\xe7\xb1\xce\xf9\xa9\xca\x79\xe4\x6e\x04\x75\xf6\xff\x76\xa0\xbe\xf1\xa7\x6c\x7a\xaf\xe8\xec\xed\xd9\x7a\x7e\xcf\x74\xcd\x7b\x4f\x6d\xce\x8f\x36\xe7\x89\xcd\xf9\xd5\xe6\x38\x4f\xc9\x7f\xd8\x1c\xe7\x52\xf9\xa7\xec\x9e\xfd\xf9\x5c\xa7\x90\xa0\x83\xf8\x95\x26\x77\x3c\x99\x62\xf3\x9a\xa1\x4b\x7e\xca\x9c\xdf\x6e\x27\x8b\xab\x63\x08\x42\xc8\x83\x6c\x1c\x3f\xc8\xc8\x31\x3b\xc1\xac\x24\x9f\xb2\xf8\xf7\x0c\xff\xa1\x08\xdf\x07\x99\x65\x59\xe0\xdf\x32\x4f\xe4\x66\x18\x82\x56\x62\x54\x5a\x4d\x6b\x56\x8e\xac\x05\xe8\xd7\x4c\xdd\x4e\xfe\x08\xa8\xe5\x3f\xcc\x77\x5d\x59\x3a\x49\xd4\x83\x0c\xdd\xca\xd2\xc6\x4a\x64\x44\xd3\xf8\xfa\xec\x7b\x90\x8d\x3e\xcb\x78\x6f\xcf\x57\xad\xd7\xba\x12\xa1\x77\x75\x4b\xfe\x1c\xb3\x93\xd1\x44\xdc\x72\xc2\xe3\xee\xc0\x59\x98\x59\x3b\x80\xf5\x1a\xe1\x07\xda\xa0\xab\x52\xcc\x30\x01\x87\x6d\x5f\xa2\x48\x01\xe2\x41\x66\xb9\x87\x0f\x87\x08\xa0\xf8\x7b\x86\x7f\x95\x60\x00\x69\x2e\x1d\xaa\xcb\x43\xff\xc2\xf1\x53\x7d\x0e\xf8\x0f\x64\x38\xc0\xc3\xc1\x93\x9d\x27\xbb\xc3\xa7\xdb\xc3\xad\x78\xf8\x30\x8e\xbd\x67\xf6\x50\xfe\x30\x1c\xa0\x1f\xf8\x6a\x80\xd0\xbf\x3c\xb1\xc0\x6f\x15\x65\xdb\x8c\xf2\x7e\x2b\x89\x71\xe7\x83\x30\x23\x2d\x81\xe7\x9c\xda\x19\x43\x5e\x4c\x39\x7e\x12\x45\xb1\xfa\x01\x9d\xdd\x36\x09\xff\x5f\x30\x39\x25\xc9\x4b\x42\xcb\x9a\x21\xc1\x2f\x1e\x41\x22\x4a\x17\x18\xf7\x8d\x8c\x45\x69\xa9\x9b\xbe\x8d\x8f\xd6\x0f\x82\xaf\xf9\xe7\xfd\x03\x59\x69\x16\xfb\xe2\x46\x7b\xfe\x07\x5e\x13\xcc\x99\x75\x70\xbd\xc8\x34\x1b\xfb\x63\x36\x67\xcf\x24\x6a\xcd\x27\x12\x7b\x32\xce\xf6\x2f\x81\x33\xdf\xfa\x2d\x72\xc6\xf0\xdd\xf8\x9b\xd5\x20\xa3\x46\xb1\xa1\xae\xb5\x27\x1a\xf4\x24\x3d\x26\xc8\x16\x2d\x09\xc3\x1a\xc8\xdc\x46\xa4\xb4\x47\xb4\xaf\xdb\x8c\xaa\x00\xd2\x46\x07\x48\xc3\x16\x14\xf1\x59\xd0\xe4\x73\x20\xf5\x5e\xa8\x2d\xa4\xa6\xc2\x2f\x5d\xbf\x8f\xcb\x2a\xac\x6b\x56\xea\x4b\x55\x77\xa8\xad\x9f\xc0\x45\x9c\x3a\x0d\x9b\x6a\x04\x51\xe4\x16\xed\x8e\x36\xeb\x8c\x33\x35\x0c\x49\xaa\x7c\x84\x25\xb9\x75\xf1\xf1\xb0\x38\x2b\x59\x71\xe9\xa2\xf3\x71\x95\xcb\x5d\xd0\x4c\x42\x48\x5e\xfa\xae\x39\x68\x3d\xae\xc8\xce\x40\x91\x0f\x79\x49\x24\xa6\x65\x00\x18\x72\x1b\x8e\x3e\x19\x60\x7f\xc4\x89\xc4\xc6\xec\x9a\x15\xa5\x89\x45\xa7\xc3\x79\x28\x98\x83\xc6\x01\x71\xd7\x1d\x16\xae\x54\x4f\x42\xc4\x35\x57\x56\x87\x96\xfd\x6d\xc9\x96\x8c\xdc\x9e\x51\x13\x7f\x2c\xa9\x39\x39\xc1\xea\x0d\x14\xd2\xd1\x04\xcb\x19\x2d\xd8\x24\xb9\x55\x9d\xce\xf8\xb9\x0d\x2a\x08\xae\x5b\x6c\x9f\x2a\x09\x0e\xaf\x54\x47\xbd\xe6\xb0\x0c\x1a\xd7\xb4\xbb\xdc\xdc\x21\x97\xf6\x3a\xa3\x73\x75\x75\xa6\x4f\xac\xaf\x13\xae\x37\xcc\xb8\x94\x29\x7d\x2b\xc7\x5a\x44\x38\xd6\x80\x39\xc3\x36\x48\xac\x09\x1a\x2b\xb1\xa4\xe7\xc9\x00\x2f\xe8\x4d\x2e\xa8\x76\x6b\x89\x53\x63\x01\xa2\x9f\xbc\x35\xa0\x35\x3f\x7c\xaa\x93\xf2\x9a\x97\x33\xbd\x23\xbc\xe1\x22\xa3\x5d\x0b\xdd\x1f\x39\xd5\x08\x0d\xe2\x91\x35\x6d\x18\x4b\x33\xc5\x49\x6c\x52\x5a\x0b\x0d\x73\x93\xaf\x76\x88\xf9\x88\x48\x6f\x1a\x72\x1e\xde\x44\x2a\x4c\x54\xe1\x83\x82\x83\x03\x07\x5c\x9f\x2a\x55\xbe\x82\xb4\xeb\x4a\xec\xe5\x12\xdb\x2f\x6c\x13\x68\x63\x0f\x3d\x91\x13\xaf\x87\x18\x08\x1a\x1e\x95\xea\x0c\x1a\x59\xc5\xef\xaa\x0b\x20\xfd\x02\x40\x39\x00\x55\x80\x0d\xbc\xd0\xb8\x08\x75\x14\x5a\x1f\xe9\x1f\x92\xeb\x2e\xe9\x1f\x92\xad\x29\xc9\x71\xbd\x4a\x8d\xbf\x32\x1f\x56\x16\x9b\x65\x95\x31\x65\x46\xb2\x60\x16\xa3\x28\xce\x3c\xb0\xe4\x9e\xf6\x2d\xa8\x9e\xdb\x8e\xe8\xd8\xaa\xc2\x5b\xd7\x29\x19\xe0\xa5\x09\xb5\x62\xfc\xf7\x12\x27\x90\x37\xed\x3a\x07\x18\x0b\x92\x19\x4f\x29\x71\x4e\x16\x35\x84\x89\x9e\x19\x78\x9e\x35\x16\x76\xbd\x68\x7d\x9d\x2f\xfa\x61\x06\x2c\xfb\x05\x18\xbe\xdb\xa5\xbf\xe8\x9b\x54\xb5\xfe\x17\x7d\x9b\xf4\x76\x81\x5d\xb3\x93\x71\x3c\x25\x13\x72\x86\x97\xa4\x44\xc9\x84\x4c\x34\xd0\xcf\x70\xfe\x5c\x1b\x95\xe7\xc6\x89\xa1\x19\xe4\x24\x8a\x62\x57\xaa\xde\xff\x0a\x21\xff\x0f\xf6\x1c\xe1\xbf\x8a\x38\xc7\xf5\x1a\xd1\x88\x25\x00\xd6\x19\x61\x78\x4e\x16\x56\x22\x91\x13\x89\xcf\x08\xc7\x73\xcf\x13\xfb\x30\x69\x0d\x06\x10\xcf\xc8\xdc\x76\x02\xa1\xdb\x92\xcc\x34\x7b\xec\x0c\x97\xd8\xda\x9f\x77\xd8\xba\x24\x33\xa7\xac\x61\x1c\xbb\xcf\x2a\xd7\x58\x64\x6b\x77\xb0\xf7\x24\xf2\x72\x56\x8f\x77\x47\xc6\x3e\x27\x0b\xda\xb0\x9b\x35\xce\xdb\x7c\x5e\xcc\xc6\x41\xf3\xc9\x0c\x79\x81\x58\x72\x64\x7b\x50\x1a\x7a\xcc\xef\xa2\x6e\x6e\x3b\x51\x3b\x74\xb0\xb6\x36\x7d\x15\x34\xf5\x31\xee\xfa\x47\x76\xb6\x61\xd3\x1a\x5c\xec\x0e\xc9\xb1\xcb\x22\xc7\x8b\x93\xc4\xc8\x0f\x17\x95\xce\xba\xea\xfb\x82\x2c\x60\x31\xa0\xd5\x6a\x41\xd4\x26\xf0\x7d\x5e\x35\x51\x81\x25\x5f\x16\xa4\x7d\xb3\x63\x0f\x93\x90\x8d\x1b\x7f\xbd\x76\xcb\x77\x49\xca\xc4\x2c\xc7\x29\xf6\x76\x2c\x59\x06\x55\x4d\xf0\x2f\x45\x9c\x9a\x70\x7e\x3e\xc5\x94\xe2\xda\x79\x4a\x4a\x3f\x48\x96\x1f\x39\x5a\xe1\x51\x0b\x23\x97\x0a\x08\x29\xed\x57\x4d\xeb\x84\x30\x5f\x27\xc4\x0b\x6f\x05\xba\x07\x76\x26\x46\x21\xfe\x99\xc6\xd5\x2b\xeb\x26\x94\x62\x4a\x78\x2b\xef\xa1\xe1\x1a\x6a\x88\x05\x42\x23\xe3\x2d\x9d\x06\x02\x89\xa5\x87\xcc\x1b\x7e\xd2\xb0\xba\xb2\x80\xc9\x1a\x77\x71\x54\xbc\xc5\xc6\xc7\x32\xb1\x3e\x3c\x41\x48\x14\xc2\x8b\x63\x1d\xab\x23\x04\x2c\xac\x31\x0f\xf7\x7a\x33\xe3\xdf\x07\xa7\x3c\x0c\xf6\x17\x48\x68\x2b\x16\x56\x5b\xe4\x8e\x7e\x39\x13\xcb\x7c\xe2\xa2\x1d\xea\x08\xfb\xe3\x0d\xf9\x31\x84\xf5\x43\x49\xd7\x8b\x7b\xbf\x5a\xf9\x4f\xad\x51\xf4\x57\xab\xb8\x7b\x28\x63\x05\xb7\xd5\x4a\xa5\x04\xa6\x3e\xaf\x74\xc2\x43\x4b\xbd\xee\x10\x0b\xf2\x22\xc3\xd4\x6a\x39\x6b\xe6\x91\xe5\x9e\xd6\x65\xcd\xd4\x9d\x55\x74\x4c\x89\xe4\x31\x85\x38\x33\x2f\x65\x2c\xd1\xf8\xe7\x2c\xf1\x78\x2f\x45\x58\x63\x89\x29\x89\xad\xef\x71\x52\x78\xec\xb4\x02\x8d\x81\xb3\x29\x50\xf2\x22\x03\x7f\xb3\xec\xaa\xa3\x86\x40\x5b\xe6\xce\x6a\x6a\x02\xa8\xbd\x4a\x4c\xce\xd8\xfc\x6a\x22\xca\x52\x80\x05\x99\x96\xd8\x9b\x1e\x20\x2c\x42\x5e\xcd\xab\xec\x8c\x15\x84\xe1\xff\x94\x1b\x29\xbe\x97\x1b\xe9\xdd\xf7\x16\xde\x9a\x67\x76\x44\x6d\x4b\x49\x82\x3a\x3d\xcc\xf7\xe7\x2c\xcf\xdf\xb3\x94\x65\x97\x10\x31\xa0\x04\xe7\x59\x9b\x5e\xc2\xb2\x68\xaf\xf0\xd3\xdb\x0f\x2f\x5e\x1d\x9c\xde\x59\xef\xb7\xca\xe8\xea\x4d\xbf\xf5\x7d\x69\x5a\xf6\x19\xff\xa2\xf6\xd3\x7b\x1d\xa5\x43\xcb\xc0\xa4\x2d\x85\x6b\x57\xae\xb3\x16\x1a\xae\xe2\xc5\xe9\x18\x4a\x25\xe1\xd8\x18\xd3\x35\x10\x83\xe8\x17\x6c\x5a\x92\x65\x89\xe1\x52\x62\xa8\xbd\x70\x69\xdf\xb9\xa8\x85\x2d\x6a\x17\x37\x6d\x5d\xdc\x55\x31\x58\xba\x14\x21\x0c\xe4\xa7\x8e\x29\xb5\xb1\x7b\x54\x07\x2e\x78\xc9\x8a\xec\xd2\xe4\xbd\x2a\xc4\x1c\xa0\xd7\x36\x2f\x34\x8a\x62\x83\x09\xa9\x42\x65\x1b\xea\xdd\x30\xa7\x9b\x5a\x5a\xad\x5a\x8a\x03\xf3\xf7\x03\xa7\x8b\x72\x26\xe4\x8f\x6c\x2a\x0a\xa6\x11\x91\x5f\xda\xe3\x23\xb7\x2d\x86\x43\xb1\xe4\xb2\x55\xdb\x53\xf4\x9b\x05\xc1\xf1\x96\xd8\xbc\xca\xdb\xbe\x89\xa2\xb6\xdc\xb8\x15\x00\x77\xf6\xf1\x8e\x97\x31\xc2\x12\x7c\x39\x1a\x1c\xb3\x61\x0d\x0b\x3b\x1b\xc6\x05\xd0\x3d\xe6\x7f\x43\x37\x5d\x17\x5e\x66\x13\xd3\xbd\x90\xde\xd9\xf5\x7d\xea\xf8\x27\x7c\x75\xfb\xe3\x6a\xe1\xa3\x56\xd8\xb7\x59\xe8\x19\x3f\x17\xfd\x53\x10\x1f\x1a\x0d\xf6\xe0\x71\xb8\xc1\x5f\xe3\xce\x60\x0f\x59\x33\x68\xcf\xd5\xc6\xba\x95\x4f\xbe\xab\x03\x9e\xea\x5d\xd8\xeb\x3d\x64\x35\xff\x16\x9e\x83\x64\xd5\xff\xd6\x78\x2d\xe6\x0d\xfc\xf4\x4f\xb5\x49\xc7\x7b\x36\x25\x6a\xb7\x42\x66\x12\xcb\x20\x06\xba\xe1\xbb\x01\x26\x00\x4f\xcd\x26\xa2\x97\xc1\x0d\xb7\x6b\x67\xdb\xcf\xc6\x26\xa8\x89\x3c\xa6\x27\x89\xfa\x43\xd8\x5a\x1d\x0d\x55\x2b\x14\x4b\x20\x1f\xad\x2d\x89\x07\xc1\x7a\xa8\xcd\x5d\x63\x86\xe9\xc0\x58\x2b\xb0\x07\xf1\x49\x5b\x4c\x32\xe6\xd5\x75\xbe\xd2\x8c\xe9\x3a\x33\x9d\x1a\xfc\x87\xb8\x77\xac\xa7\xd3\x04\xaa\x39\xe9\x11\x62\x85\xec\x15\x71\x20\x85\xd1\x1d\xd2\x12\x73\x34\x36\x6b\xa0\x73\x95\xc9\x59\xe7\x82\xdd\x94\x9d\xdb\xde\xc3\x50\x36\xdf\xff\x47\x64\x3c\xee\xe1\x4e\x0f\x3d\xec\xad\x7b\x89\xd4\x06\x9b\x95\x7e\x0e\xaf\x7b\x9c\xb0\x94\x66\x15\x66\x21\xa7\xa5\x09\xa0\x6f\x79\x01\xc5\x38\x2e\x80\xe0\x35\x01\xf8\x39\xf6\x4b\x11\x88\x41\x01\x4c\x29\x93\x11\xbe\x35\x37\x7d\xfb\x08\xc4\xab\x77\x7b\x79\xea\x6b\x2a\xc7\x56\x29\xb2\xcb\x1a\x1e\x41\x5d\x6f\x46\x48\x93\x47\x18\xbc\xbe\x19\xdb\x97\x56\x4b\x0c\x51\xb1\x5f\x99\x75\x60\xec\x5c\x92\x8c\x90\x5b\xb9\x17\xec\x46\xdb\x14\x6b\x8d\x41\x6c\x23\x03\xc6\x52\xab\x12\x62\xd0\xd7\x96\xf5\xc6\x7c\x86\x4e\x8d\x83\x74\x23\x8c\xd3\x4d\x5d\x01\x19\x28\xea\x45\x7f\x6d\x3d\x31\x56\xbc\x98\xd8\x9c\x97\x4e\x0b\x45\x7f\x53\x60\x1b\x3f\x45\xd1\x5d\x9e\xb9\x0b\x1a\xc7\x6a\xe8\xba\x6b\xc5\x33\x3e\x8e\x3d\x4f\xc9\x64\x1b\x73\x94\x14\xc0\x6f\xa9\x65\x7a\x2c\xf7\xcc\x37\xd2\x74\xb1\x53\xbd\x36\x80\xfd\xe6\x7d\x1f\xd0\x39\x95\x52\x69\x4d\xb0\x22\x57\x2b\xf0\x84\x07\xb6\x2b\xb1\x24\x57\x22\xe6\x8a\x04\x54\x64\x18\x50\x16\xba\x38\x51\xb0\x51\xbb\x9e\xc2\x02\x0c\xf3\xbd\xbb\x50\x6b\x33\x1a\xeb\xc8\xbe\x17\xf8\xde\x85\x45\x55\x80\x81\x3a\x35\x9d\x81\x70\xa1\x50\x06\x71\x28\x17\x17\x55\x4b\x0a\x44\x05\x39\x15\x26\x56\x28\xe6\x30\xf5\xe6\x4b\xa3\x2c\xee\x7a\x7e\x67\x3d\xde\xa5\xe7\x0e\xc0\xec\x76\x9d\x0f\x5b\xb9\xc9\x93\x80\xb6\x54\xf2\x73\xc2\xc2\xd9\x7c\xa1\x47\x0d\xb7\x1e\x28\x1d\x66\x29\xa0\x5f\xdc\x07\xe8\x9e\xad\xdc\xf1\xc9\xc6\x19\x98\xb6\x04\xe6\xad\x46\xf4\xc4\x9f\xea\x6b\xbf\x55\x2c\xbe\x7b\xb2\x27\xde\xa9\xd8\xb4\xfb\x6b\x33\xf7\x73\x5a\x7c\x6a\x99\xf5\x7a\x0f\xa5\x6d\xbe\xd6\xc8\xa8\x2d\x14\xa1\x77\x70\x55\xee\xcb\xea\x51\x09\x6f\x5c\x1c\x3f\xae\x16\x8a\x89\xa1\x63\x70\x44\xcb\x42\xe1\x08\xf3\x6a\xa1\xe8\x1b\x8c\xce\x32\x7d\xe1\xb5\xb8\x8c\x1d\x98\xad\x4d\x1d\x57\xc7\xd6\xa4\x04\x89\xfc\x51\xec\xd4\xbd\x3a\x00\xeb\xea\x1b\x43\xbd\x04\x23\x36\x07\x52\xbb\x7d\xda\xa2\x4e\xa1\x5b\x40\x8c\x61\x64\x09\xdf\x14\x81\xb2\xcd\xe8\x32\xb0\xe1\x82\x60\xaf\xa0\x27\xa4\x31\x84\x0e\x4e\x8a\x5a\x27\xc0\xb3\xcd\x73\x13\xc0\xef\x98\x00\xd5\x35\xd0\xf5\xd7\xdb\x95\x10\x72\x4d\xc7\x66\x7d\xea\xa9\xa8\x02\x6e\xa8\xf5\x97\x54\xd8\x23\x71\x0a\xb6\x1e\xe4\xab\x0a\x97\x61\x41\x03\x75\x0e\x50\xe7\xa8\x7d\x7c\xd5\xc6\x00\xe8\x6b\x80\xf3\x0d\x00\x3f\xf3\x77\x51\x1b\x60\x8b\x16\xc0\xba\x38\x51\x8a\x08\x3d\x67\x12\x3a\xa4\xe5\x2d\xe0\xbe\xa7\xd7\x7b\xa8\xea\x6b\x85\x6d\xe1\x60\x5b\x38\xd8\x16\x9b\x61\xeb\x5a\xd0\x3b\xbb\x80\xf3\x90\x27\xf0\x6b\x1b\x2d\x02\xa8\xab\x0e\x14\x75\xa8\x0b\xac\xbf\x48\x52\xfd\x5e\xf5\xae\x06\xf4\xfb\xb4\xb4\x74\x5f\x9b\x99\x28\x60\x26\x0a\xb4\x11\x20\x53\xfb\x45\x35\x19\x52\xa1\xe5\xd6\xc9\x98\x01\x5f\x26\xc3\x9e\xb1\x56\xaa\x8f\x64\xc7\x6d\xa7\x78\x46\x28\x19\xe0\xb9\x66\xb8\x1b\x50\x4e\xa3\x68\xf6\x2c\xb3\x3c\xb6\xd9\xc3\x87\xe8\x76\xaa\xcf\xe0\xe7\xb3\x71\x3c\x27\x53\xc3\xa7\x47\xc9\x9c\x4c\x1d\xa9\xa0\xea\x3f\x27\x8b\xb8\xc0\x53\x9c\x1d\xcf\x4e\x70\xe9\xbb\xb4\x39\x77\x11\xcc\xa7\x51\x14\x4f\xc9\xdc\x7a\x5f\x53\xb7\x16\x77\x2c\x9f\xfb\xc7\xb2\x54\x55\x21\x4c\x49\x1e\x9f\x63\x8a\x67\x8e\x16\x5e\x8e\x53\x72\x9e\x2c\x1d\x99\x71\x8e\x97\xe4\x1c\x4f\xc9\x7c\x0d\x4c\x60\x42\xb2\x9a\xce\x3a\xd7\x35\xa5\x5e\x7f\xa6\x46\x56\x5d\x1b\xa8\xa5\x42\xa6\x64\x12\x17\x76\x18\x08\x22\x72\xe6\xf1\xb4\xd1\x8b\xa9\xd7\x8b\x29\x5e\x92\xa9\xd3\x21\x4a\xc1\xcd\xd8\x94\x08\x68\x7a\x53\x33\x73\x72\x16\x4f\x71\x81\x67\x7e\x53\x55\x24\xaf\xb9\x0f\x8e\xa9\xf5\x91\x67\xda\x9f\xc3\x92\x9a\x25\xf0\xab\xc1\x34\x6f\x74\x70\xee\x75\x70\x8e\x97\x0a\xec\x15\x2d\x34\x6d\x75\x8f\x6a\x71\x6f\x0c\x6e\x30\x11\x4e\x3d\xea\x3f\x86\xf5\x84\x53\x8d\x4f\x97\xe4\x28\xd6\x93\xdc\x72\x95\x5b\xd6\x2f\x58\x8f\x06\xc8\x5b\x10\x71\x49\x96\x9a\xde\x2f\x9b\x81\x1b\x1e\x0d\x51\xa5\xce\x35\x25\x66\xb9\xce\x48\x86\xe7\x24\x23\x03\x6c\x28\xcb\x4b\xa2\xe5\xbe\xb1\xb3\x70\x9e\x45\x51\xf7\xb2\x3f\x11\x9c\x8d\xe6\x0f\x1f\x7a\x05\xd0\xed\xcc\xac\xe0\xf9\x38\x3e\x27\x33\x3c\x33\x2b\xf8\x9c\xcc\x82\x15\x7c\x43\x16\x31\xc5\x33\x7c\xa9\x8d\xdb\x70\xea\x2f\xe2\x1b\xb7\x88\x67\x51\x14\xcf\xc8\xb9\xb7\x88\x67\x6e\x11\xdf\x84\x8b\x18\xa6\x24\x23\x79\x7c\x83\x33\x3c\x77\xb3\x33\x1d\x2f\xc9\x4d\xe2\xb6\x0f\xb9\xc1\x53\x72\x83\x67\x04\x62\x18\xe8\x31\x54\xcb\x17\xea\x58\x7a\x3d\x99\x99\xe5\xbb\x61\xb4\x76\x7d\x5d\x92\x49\x4c\xbd\xb1\x68\x67\xc5\x79\x7c\xd9\xe8\xca\xa5\xd7\x95\x4b\x3c\x25\x97\x6e\xa1\x2c\x61\x25\xcf\x88\x80\x5e\x7c\xbb\xc5\xb3\x78\x86\x29\x9e\xd7\x5a\xad\x16\xf5\xa5\x0f\x9e\x59\x6d\x51\x5f\xc2\xa2\x9e\x27\x97\x7a\x51\x7f\x6f\x5f\xa1\xc6\x3b\x17\x35\xd5\x8b\x7a\x69\x51\x66\x55\x08\x0b\x9c\xe3\xd2\x3a\x7f\x6e\x9c\x34\xb9\x1b\x40\x1e\x45\x79\x75\x46\xb8\x69\xcf\x55\x97\xc1\x5d\x74\x4e\xf2\xda\x89\xa1\xf9\x0a\xcb\x3b\x6b\x55\xb3\xbb\xb4\x61\xa3\xf3\x96\x33\xcc\xb8\x65\x59\xea\x96\x70\x4a\x84\x5d\xf6\xa9\x96\x88\xa6\xe6\xa0\x5f\xba\xa3\x30\xf5\x24\x73\x4f\x92\x6c\x1a\x7b\x1d\x47\xb7\x6a\xd0\xa9\x85\x24\xc2\x82\xd0\x38\xc5\x8d\xae\x63\x51\xd1\x5b\x8c\x38\x63\xea\x75\xe8\xd7\x0e\x9a\x0f\x2f\x2a\xba\xad\xbb\x9b\xd1\xb5\x1b\x32\x32\xc5\xf9\xc6\xd6\xd6\x50\xc0\xee\x37\x09\x0f\x38\x25\xae\xde\xb5\x37\xb2\x71\x2c\x14\xe1\x58\x1f\x89\xa5\x23\x4b\x9c\xeb\xd5\x15\x36\x85\x92\xb8\x54\xb4\xaf\xae\x48\x97\xb1\xdd\x0c\x68\xdf\x12\xe1\xb2\xea\xb4\x50\x9d\x2e\xfd\x9a\x4a\x77\x1e\x67\x31\xab\x08\x03\x33\x7d\xa9\x59\x28\x96\xca\x1a\x19\xd9\x94\x9e\xba\x14\x9e\x76\xad\x27\xb6\x28\xda\xe8\x8a\x4d\xc7\x8e\xf1\x73\xc2\xc2\xe1\x6d\x09\x4a\x87\x59\x7a\x62\x44\x38\x31\x02\xe7\xb5\xfb\xd2\x86\xf9\x80\x6f\xfd\xe9\x10\xaa\x02\x57\xdb\x5a\x28\x72\xdf\x07\x59\x58\x8f\x0f\xa1\x75\x1b\xa1\x98\xb7\x10\x8a\xce\x48\x39\x27\xbd\xde\x43\x27\x19\x14\x51\xf4\xd8\xb9\xae\x8b\xdb\x07\xd5\x32\xd9\xdc\x76\xfa\xea\xae\x8e\x22\x0c\x93\xa8\xe9\xb3\xdc\x51\x66\x33\x87\x2f\xd4\xab\x23\xef\xcd\x3c\x78\xb3\x8c\x22\x20\x98\x73\x84\x2b\x01\x73\x14\x75\x53\xd4\x12\xc5\x7c\x98\x18\x59\xb6\x8d\x6a\x61\x3c\x20\x54\xe7\xe2\x76\x3d\x8e\xbb\x8d\xa5\xdf\x73\x52\xb5\x5e\xc5\xb7\xd3\x23\xf4\xb8\x4e\x97\xdc\x5a\xfa\xab\x23\xa4\xae\x9a\x35\x7c\xb2\x5b\xe9\x40\x7b\x5c\x9b\x1b\x1e\x58\x64\xbe\x95\xf1\x65\x69\x54\xbf\xcf\x4b\xa3\xb1\x3c\x2f\xf1\xac\xd4\xc1\xaa\x2a\x17\x2c\x26\xec\xba\x1e\xdb\x30\x91\x3a\xdc\xad\xb5\x5d\xb1\x71\x33\xc6\x3a\x4a\x7d\xb9\xa0\x29\xfb\xf4\xfe\x75\xf2\x87\xf6\x05\xd9\xeb\xd5\x5d\x67\x32\x02\x3e\x5e\xc6\xbe\xbf\xa7\x44\x62\x69\xe2\x91\xda\x0a\xac\xe7\x69\xed\xb1\x43\x81\x09\x4b\xf2\x87\x76\x32\xfb\x46\xf5\xd4\x76\xd8\xbf\x93\x9f\x72\xad\x1c\xaf\xde\xbe\x51\xe3\x82\x9f\xcb\xd2\x2b\x72\xad\x39\xb6\x3c\xbe\x74\x6a\x92\xc8\xb8\x58\xbe\xe4\xf1\xbc\xca\xc4\x5c\x37\x67\x62\xc5\x4b\xe3\x21\xb1\x06\xae\x20\x7e\xe8\x15\xd4\x7d\x5e\x7a\x91\x84\x58\x14\xc5\x41\x87\x7c\x5f\xbe\xdc\x7a\xdd\xb1\xc1\x83\x2a\x56\x9f\x8b\xfa\x25\xab\xa8\x5f\x9c\xc8\xf6\xa8\x5f\xd6\x79\xa3\xd1\x29\xe0\x60\x1c\x6e\x83\x78\xa9\x4b\x07\x58\x5d\x82\xa7\x1c\xef\xe1\xaa\x5a\xed\xd2\xb9\x98\x1c\xee\xd9\x36\x03\x51\xa7\x6d\x16\xa4\x39\xfd\x82\x5d\x32\x9a\x1f\x15\x13\xc3\xb9\x1f\x74\x49\xfc\x78\x37\x08\x7f\xd6\xac\x99\x07\x2e\x6f\xd0\xed\x1d\x91\x8f\x5c\xa4\xad\xb6\xe8\x47\x8e\xf5\x17\xc4\x40\x72\xb9\xba\xba\xff\x66\x24\xa4\xd6\x5b\xd9\x41\xa0\x82\x77\xeb\x74\x1a\x13\x86\xe1\xa8\x49\x7c\xe5\xb5\x23\xb5\x2c\x6b\x4c\xf5\xed\xa1\xbf\x14\xde\xf1\x5a\x5c\x25\xdf\xca\x2d\xf4\xf3\x5b\x45\x0d\xaa\x45\x6c\xcd\xa6\xb1\xb1\x4e\x03\xd3\xb4\xbb\xed\xd2\xf6\xad\xd8\xd3\xe8\x14\x64\xd3\xf8\xaa\x24\x39\xbe\x28\x41\x54\xdd\x94\x81\xd7\x14\x1e\x4d\x56\x4d\x55\x64\x80\x4f\xab\xc5\xcf\x9d\x31\x9f\x4d\x85\xd5\x8e\xff\x29\x93\x0f\x25\x66\x84\x83\xca\x40\xb3\x36\x42\xae\x4a\x74\x9b\x93\x81\x89\xb3\xd2\xd2\x5c\x37\xde\x7e\xf4\x3c\xaf\xdf\x3c\x76\x06\xea\xe6\x91\x3f\x24\x43\x7c\x54\x92\x83\x72\xe3\x00\xbc\xde\x1e\x56\x3d\x31\x7a\xe9\xed\xfd\x51\x6b\xd2\xfb\x6c\xbf\x34\xe1\x6a\xbb\x84\x1c\x54\x4a\xf3\x07\x9a\xa2\xc6\x57\x25\x19\x98\x3e\x5c\x98\x6e\xbc\x2b\x49\x77\x88\x9b\x71\xb3\x06\xad\x58\xfb\x1f\xb5\x74\xb4\xe2\xfd\x6d\x00\x3d\xad\x6f\x50\xa9\x98\xba\x47\x4f\xd1\xf5\x4b\x95\xf4\xf4\xda\x42\xb6\xe8\x51\x39\xbe\x28\x6b\xf3\x7d\x54\x12\x96\x1c\x95\xe4\xc8\x28\xe4\x32\x7c\xe4\xb9\x83\xf8\xc0\x63\x7f\xa1\x1e\x38\xe3\x82\x8b\xd2\x53\xcb\x74\x9a\x13\x6c\x5c\x9b\x77\xdd\x0d\x40\x0b\xcc\x02\xca\x60\xe0\xcd\x7d\x4a\x4c\x5f\x02\x3f\xf6\x47\x6a\xb1\x1e\x94\x84\x39\xf5\x70\xbb\xd2\x1a\x22\x2c\x05\x5d\x55\x12\x37\xe0\x78\x50\xb6\x68\x0b\xbb\x57\x6d\x7a\xbb\x26\x5b\xeb\x72\x7e\xb1\x59\x5f\xb4\x76\x69\x43\x93\xfa\x5e\x00\xb6\x48\xc6\x87\xf3\x61\x80\x62\xda\xe4\x94\x63\x19\x33\xe4\x07\xaa\xfb\xe8\x89\x23\x3f\x68\xcb\x4b\xa9\x3b\xe6\x5d\x3a\xeb\x7e\x90\x76\x86\x6a\xb7\x70\x90\x81\xbd\x67\x0a\x89\xb1\xc9\x7b\x36\x59\xa6\xac\x20\xcc\xc8\x5d\x0f\x4a\x5c\x57\x61\xe5\xff\x25\xdd\xd5\xba\x3a\x1b\x0f\xf5\xd8\x82\xfa\xa8\xa9\x0b\x68\x54\x37\x35\x46\x19\x35\x23\xc6\xef\x42\x4a\xa8\xc2\x18\xfa\xae\x96\xd6\x76\x31\x90\x73\xcf\xae\xcc\xa2\x9d\x36\x34\x34\xeb\xe5\xeb\x7a\x9a\x69\x5d\x4f\x93\x02\xe8\x93\xb4\xaf\x13\x98\xd1\x73\x56\x18\xf8\xa9\xda\xbc\x47\xfd\x4e\xaf\x2d\xf3\xc6\x68\x97\x35\xb4\x4e\xcb\x71\x9c\x91\x92\x4c\x71\xae\xa8\xdc\xd2\xdc\xd0\xc9\x14\x2f\x9f\x5f\x94\x4d\x45\xb2\x46\x1e\x59\xe2\x5f\x8a\x78\x89\x4c\x88\x0b\x03\xc2\x32\x8a\x62\x57\xd7\xfd\x75\x53\xff\x87\xc7\x0c\xfa\xaa\x4b\x5c\x6f\x45\x11\xf5\x61\x5d\x40\x37\xfa\x95\x24\x2c\x16\xd8\xf6\x02\x8d\xd4\x45\x52\x55\x1b\x1a\x16\xa5\x51\x94\xc2\xf2\xa9\x60\x9b\x13\x91\x94\x56\x9f\xb2\xcc\x62\x51\x3f\xf2\xd0\x6a\x65\x2d\x36\xea\x87\xa1\x2a\x5b\x69\xea\xe5\xe6\x49\xaf\xdf\x12\x87\xdb\xc8\x7c\x61\x76\xf7\xb1\xac\x2b\x15\x3a\x1f\x45\x9e\x35\xfe\xfb\xff\xa9\x3d\x5c\xb5\x86\x69\xb5\x7b\x71\x7e\x07\x55\x49\xd1\x6d\xb8\x1d\x47\x66\x47\xdb\x3d\x3d\x11\xb7\x39\x61\x71\x8e\x33\x3b\x09\x38\x23\x99\x3f\x09\x99\x06\x7d\x99\xc5\xf9\x77\x00\x39\xc7\x8e\xa0\x73\xd0\x05\x09\xaf\x07\x79\xd4\x0a\xec\xdc\x02\x3b\xc7\xc2\x83\xea\x1b\x0f\xaa\xea\x4c\x1d\x6d\xc4\xaa\xda\x08\x9b\xc5\xa8\xd9\x2b\xbf\x79\x06\xb7\xa4\x2f\xda\xd2\xc3\xb7\x24\xc1\x16\xca\xfa\xa9\x65\x46\x92\x43\x8e\x1b\x1d\x4f\xd8\x5a\x9b\x8b\x99\xaf\xc9\x1f\xdc\x73\xd1\x7f\x01\x57\x8e\xc6\x02\xf2\xbd\x38\xbc\xe5\x0d\x59\x2e\x23\xb7\x92\x9e\x27\x0c\xeb\x18\x83\x89\xc4\xe0\x15\x49\xdc\x24\x1c\x4f\xd8\x02\x02\xe2\x55\xe7\x95\x54\x07\x78\x60\x37\x61\xa6\x60\x1c\x4b\x72\x5b\x69\x48\x98\xe2\x61\x61\xa0\x1a\x3d\x2d\x0a\x6b\x42\xa2\x6e\xe8\x81\x7e\x45\x65\x7f\xd1\x5a\x3e\x89\x8b\x9a\xc1\x05\xb3\xe6\x28\x45\xad\x05\x14\xb8\x5c\x79\xc1\x2b\x27\x16\x6a\xdb\x84\x90\xf2\x0c\xb0\x1b\xfa\x7e\xb0\x1c\x14\x02\xad\xd4\x9f\x18\x16\xb5\xa9\x7f\xcb\xe3\xe1\x4a\x7d\x66\xe2\xf0\x7b\xe1\x25\x40\xbe\xe6\x0b\xd5\x5f\x37\x9a\xf8\x00\x2b\x8e\x34\x3e\x32\x7a\x4a\x3a\xdf\xdb\x77\x96\xa6\xca\x49\x9d\x3a\x51\x85\x28\xc9\xfb\x66\x22\x2d\xf3\xa4\x88\xa2\x77\x3c\x2e\xb0\x7a\xb1\x28\x91\x1f\xb4\x48\x2d\x0c\xd5\x19\x8a\x0b\xb4\xbe\xf7\x30\x69\xa0\x26\xf0\x73\x68\x70\xf4\x92\xc7\x8f\x86\x8f\xf1\x2e\x0e\x25\xe2\xaf\xc2\x52\xaf\xdb\x4b\x7d\x6a\x94\xda\xc5\xdb\xb5\x32\x5f\xbf\x4d\xfa\xe8\x3d\x8a\xc1\x8a\xd6\xb3\x3e\xd5\xa2\x3a\xb4\xd6\xa2\x51\xad\x7a\x51\xdd\x63\xdd\x57\x8e\x7e\x67\xc1\xc7\xc1\xdd\x65\x8d\x8c\x4b\x3a\xcf\x22\x9a\xd7\x6d\x48\x49\x75\xff\x76\xcd\xf0\x31\xb7\x61\x69\x8f\xd9\x89\xee\x09\x36\xe3\xfc\xca\x83\xb0\x21\x0c\x05\xb6\xbf\x7f\xf2\xc0\x29\xfa\xe7\x10\x54\xff\x34\x96\x35\x39\x66\xd5\x4a\x94\xc6\xc5\xd2\x89\xbf\x2d\x7e\x0f\xec\xa6\x60\x1d\x4a\xd2\xf8\xc4\xb8\x6d\xe0\xb5\xb5\x16\x4a\x92\x2b\x21\xad\x84\xd5\x26\x71\x71\x3c\x3c\x41\xe3\xe2\x78\x70\x92\xc4\xbc\xd9\x33\xd5\x13\xdf\xb0\xf9\x7f\xab\x2b\x7a\x8e\xbf\xdd\xa1\x5f\x6b\x9a\xe9\x5f\x64\x8c\xc0\x9e\xf9\xe9\xf3\x62\xbc\xf7\x34\x29\xfc\xb5\xc1\xc0\x62\x19\x61\xf5\xfe\xc9\xb3\x62\xbc\xf7\x24\x7c\xaf\xab\xb8\x2e\x1d\x11\x33\xf2\xd2\x2d\xc3\x94\xc5\x8d\xaa\x73\x88\x30\x8f\x2b\x57\x49\xfe\x37\xc5\xda\xb7\xf9\xfb\xa3\xd6\xd9\xd7\x45\x0c\x04\x92\xd7\x60\x41\x7e\x2e\xe2\x02\x1b\x7e\x68\x9d\xbc\x2b\xea\x54\x9d\xb0\x64\x5c\x8d\x7e\xd3\x7c\xb7\x8a\xc6\xaa\xdf\x1f\xad\x86\x73\x8d\xfc\x27\x5a\x99\x19\x50\xb5\x48\x62\x93\x32\xf4\xba\xa1\xfd\x41\xcb\xc6\xd2\x13\x02\xd3\xc0\x38\x4d\x5d\xb0\x2f\x4a\xe7\x0b\x99\x46\x11\x85\x1c\xa4\x6e\xcc\x03\x2c\xea\x14\xee\x55\x89\x9b\x64\xef\x55\xe9\xee\x82\x1b\x6c\x2e\x6c\x5f\x75\x58\x11\x5a\xb7\x3d\xab\x4c\xe3\xa8\x39\xb7\x6a\xa7\x37\x42\xd6\xfe\x3d\xaf\x15\xd0\x67\x72\x46\x68\x9c\x63\xed\x13\x58\x84\xe4\x2b\xc5\xc2\x23\x5e\x35\xe9\x99\x61\xc7\x86\xab\x5c\xc2\xaf\x5f\x15\x10\xb7\xc6\x37\x5f\x0f\x76\xcf\x5c\xc4\x8f\xb4\x34\x03\xfe\x0c\x14\xed\xe7\x8b\x6d\x7a\x2f\x0f\xde\x1c\x7c\x3c\x78\xd9\xc3\x46\xfb\xc4\xcf\xf0\xad\x12\x7c\x5d\x1f\x5f\xc9\xd1\xd9\xeb\x78\x47\xee\x38\xf6\x9f\x42\x05\x4b\x56\x53\xb0\x64\x81\x82\x65\xf8\xd6\x33\xa4\x0f\x79\xd2\x3e\x17\xfd\x51\xe2\x3b\x9d\xa9\xed\xff\x58\x12\x30\x8b\xf6\xa2\x56\xf0\xd0\x21\x9c\x7b\xdb\xf4\x15\x67\x76\x20\x88\x33\x03\x58\x74\x07\x46\xcc\xf3\x38\x69\x34\xd7\x33\xbe\xd1\xf4\xda\x35\x4a\xed\x3b\x41\x1f\xbe\x5d\xef\x70\x27\xb9\xc3\xfd\xe2\x5f\x96\xad\xff\xa6\xb4\x94\xea\xfb\x52\xbb\x1d\xb7\xec\x5f\xd0\xf6\x35\x40\x33\x0e\x31\x25\xf9\x91\xd5\x62\x3b\xa0\xd5\xca\x15\x72\x96\xd4\x9e\xf9\xdd\x70\xb0\xfd\x28\xf2\xd5\xbd\xb7\xf1\x1b\x60\x4a\x41\x8c\xc3\x8f\x25\x61\x68\xf4\x13\x8f\x3f\x96\xea\x6c\x52\x8f\xf8\x7d\xa9\x5a\x91\x5e\xb4\x0b\x73\xaf\xbc\x5f\xbd\xaa\x0e\x6f\xa0\xbf\x38\xbe\x77\x65\x8a\xef\xbc\x0d\x78\x6e\xba\x3d\xbf\xe2\xbe\xbb\x71\x1d\x78\xc3\x7c\x06\x55\x57\xfe\x0b\x9c\x64\xa4\x4b\xc8\xc7\xb2\x62\x80\x2a\x50\xbd\xb1\xcf\xba\x03\xd0\xb7\x01\x76\x01\x16\xcd\x42\xcb\xa6\xb1\xeb\xc0\x6a\xd5\x9b\x31\x3a\xe9\xe9\x73\xa6\x77\x26\x26\x37\x26\xdd\xfd\x9d\x69\x8d\x36\x9f\x2f\x8e\x8c\x6d\xdb\xfb\x72\x24\x47\xc8\x6c\x58\x2c\x35\xe8\xfc\x09\x52\x8d\xe8\x3e\x68\x07\x4f\x7a\xd9\x83\x40\xa7\x6e\xbf\xd1\x8d\x03\x46\x5a\xc5\xd6\xd7\xb1\x25\x1b\xd7\xc0\x27\x08\xcc\x3c\x2d\x70\xbd\x56\xf1\x77\x7a\xd9\x3f\xf5\xbd\xea\xdf\xea\x15\x10\x86\x04\x70\x52\xc4\xca\xd5\x7e\x97\x90\x9b\x3c\x8a\xd4\xef\x95\xf9\xbd\xce\x57\x2b\xeb\x7a\xdf\xfb\x7a\xfd\xde\xf8\xb0\xd0\x5f\xbe\x2f\xc9\xc7\x72\x0c\x4d\x54\xc2\x4f\xbf\x31\xad\x13\xd7\x64\x64\xb3\x22\x86\xde\x7d\x34\xec\x55\x58\x71\x9e\x47\x85\xc2\xb3\xea\xd3\xe2\x05\xc7\x98\x1e\x9f\x95\xb1\xf1\xb2\x0c\xca\x74\x0b\x50\x54\xd3\x85\x6a\x1e\x68\x79\xe1\xab\xc4\x71\x30\x7e\x50\x48\xdf\x1d\x86\x05\x9b\x3a\xee\xad\xa2\x47\x04\xc2\x05\xd9\xaf\x4c\xf7\xa8\xca\xa9\x38\xe2\x2f\xca\x40\x07\x7a\x45\x86\xd8\xf4\xd4\xa8\xa2\x6a\x21\x49\x52\x73\x29\x50\x77\x41\xe0\x2a\x88\xc8\xd6\xa3\xe1\x93\x86\xed\xe6\x33\x22\x5a\x1c\x52\x90\x01\xc2\x67\xba\x35\x11\x38\xab\x2e\xc2\x70\xbd\x3e\x3f\xd5\x1c\x78\xdc\xc7\xc6\x2d\xea\x42\xf9\x6a\x75\x2e\xe2\xdc\x19\xe2\x76\x09\xdc\x55\x2a\x0f\x28\x55\xa8\x03\x1b\xa0\xc5\x2b\xca\x83\xa2\x8a\x58\xf7\xb4\x9d\x41\xa9\xcf\xe8\xa8\x6a\x29\x2f\x58\xeb\x15\x6c\xaa\xc1\x8f\x99\x27\x4a\x32\x33\xcd\x00\x82\x92\x9e\x93\xe1\x23\xac\xb5\x61\x49\x8e\x85\x1e\x67\x6e\xc6\xe9\x04\xad\xb9\x75\xc3\x85\xc5\x33\x1a\x45\xb1\x20\x79\xb8\xc1\x31\xaf\xba\x8d\xe3\x8a\xf6\x1f\xf3\xe4\x50\xa2\x58\xe0\x02\xfc\x47\x15\x60\x2b\x02\x9d\x42\x63\x03\x68\x1a\xea\xb7\xab\x09\x07\xa5\xfb\x1c\xa2\xd3\x7d\x6b\x14\x9e\x85\x40\xb1\x31\xa4\xb2\xc6\x9e\x87\x32\xae\xa1\xa5\x66\xaf\x8c\x3b\x92\xa1\x1a\x27\x1a\x37\x05\x1d\xf5\x05\x83\xdd\x20\x50\x92\x57\x1d\xa0\xbe\x0b\xf2\x22\xf4\x73\xa8\xf6\x83\x5b\x3d\x95\xe6\xac\x9b\x7e\x66\xba\x04\xea\xb4\xa1\xee\xfe\x8a\x0c\xb7\x9f\xfa\x6e\xc0\x83\xbd\xa7\x37\xdc\x4b\x19\xf3\x9a\x85\x9c\xdd\x7e\x94\xbc\x90\xb1\x84\xc5\x61\x36\x22\xff\xb7\x36\x22\xff\x7f\xb7\x11\xb3\xa2\xa6\x80\x0b\xc3\xb5\x63\xef\x0e\x46\x9f\xc0\x55\x28\x20\x4e\x08\xaa\xab\x8e\x0e\x3b\x58\x27\x1e\x75\x3e\xcd\x2a\x90\xc7\x1e\xbd\x6d\x85\x62\x8d\x0c\x0f\x18\xdb\x08\x4f\xb8\xb1\xf6\xc0\x67\x3c\x76\x21\xab\x0b\xd5\x0b\x5f\xb2\xeb\x23\x09\xaf\x6d\x9c\xd5\x85\xc7\x23\xa3\x8b\x43\x32\xc3\xb6\xcf\xad\x61\x23\x4e\x8d\x2d\xc1\x46\xb3\xc9\xd4\x2d\xa4\x74\x9c\x12\xc9\xe3\x14\x25\x71\xda\xb6\x18\x70\xaa\x57\x41\x8a\xac\xce\x16\xdf\x6c\x06\x39\x6d\x73\x2e\xb0\x6c\xb5\x59\xcc\x37\xd9\x2c\x8e\xa6\xad\x56\x8b\xf9\x3d\xcc\x5c\x5b\x3f\xdb\x58\x7e\xb5\x02\x96\x6a\xb1\x5a\x95\x0a\x0e\x28\x8a\x16\x6a\x5e\x14\x32\x4b\x11\xae\x3c\x8e\x4c\x1a\x6c\xdd\xdc\x98\x08\x4e\x70\x09\x37\x66\x9c\xab\x99\x2c\xeb\xe5\xb0\xa9\x7e\xd2\x25\xa4\x5c\xad\x2a\x0f\x76\xab\x55\x59\x8e\x5b\x9c\x42\x74\x96\xda\x66\x54\x2d\x8e\xa5\x5a\x28\x8d\x2a\x11\x8e\x33\x52\x96\xab\xd5\x54\x97\xca\x70\x81\x27\xa0\x80\x8a\xc6\xf1\xf7\x00\x6e\xb3\xb9\x67\xde\x6e\xee\xd9\x3a\x85\x6d\x55\xb6\xe5\xb6\x1b\x79\xde\xd9\xb3\x3b\x5e\xc6\x68\x43\x75\x6d\xc6\x98\x32\x34\xc6\x44\xc9\x37\x46\xb2\xf9\x53\x5c\xdb\x7f\xc0\x46\xad\xb9\x73\x40\x56\x41\x8e\x14\xd8\xae\x92\x12\xbb\x8d\x49\x52\x5c\x90\xec\x3f\xe9\x43\x41\xba\x43\x83\xaf\x42\xf4\x60\xbc\x2b\x35\xd1\x84\xeb\x91\xb4\x0a\x81\x81\x01\xd5\x38\x4b\x7e\x93\xd6\xbe\x25\x53\x4b\x6e\x23\x1a\x69\xba\xaf\xff\xb7\xd0\x08\xbe\x13\x85\xc4\xff\x0d\x1c\x82\xfe\xef\xa0\x90\x16\xb4\x50\x2d\x0c\x1f\x7d\x34\xd0\x0c\xf6\xaa\x9e\xfc\x5b\xe8\xa3\x51\x25\xc2\xf1\xb2\x8e\x3e\x4a\x3c\xf9\x7e\xf4\xa1\xc1\x7c\x1f\x70\x59\x43\xf4\x7b\x20\x10\x5b\x69\x6b\x76\x5c\x40\x3f\xef\x8f\x47\xaa\xda\x36\xbf\x35\x95\xde\x03\x9d\xd8\xda\x1a\x1b\xf2\x7b\x96\x65\xfd\xf3\xed\x47\x8f\x43\x84\xd4\x06\x44\xd7\xf8\x6a\x95\x05\xaa\x3f\x66\xc5\x96\x4d\x7d\xa0\xd5\xea\x8e\x6e\x76\xbf\xd9\xcd\x7f\xbf\x21\x35\xa0\x7b\xa0\xc9\x49\x1b\x9a\x9c\xd4\xd0\xe4\xf2\xff\x7b\x90\x01\xe4\x6d\xa9\xeb\xb2\x08\x28\x69\xcf\xc4\xb6\x76\x31\x31\x17\x02\x13\x1e\xa8\x45\x43\x10\x78\x32\x45\x14\x75\x9d\x3e\xb0\x88\xa2\xaf\x60\xe1\x8d\xbb\x43\x54\xdd\x39\x46\x45\x70\x64\xbc\xad\x94\xaf\xa4\x11\x47\xe7\xed\xf1\xbe\xda\xd0\x35\x70\x4a\x8c\xf4\xcd\xdc\xe1\xbd\x00\x35\xe1\x45\xa0\xa2\x9b\xf3\x71\x6c\x2f\x63\x21\x93\x40\x51\xcc\xd4\x5d\x13\xf4\x4b\xc8\xcc\xe0\xb2\x64\xae\x12\x99\x2e\x13\x2e\xa7\xc2\xf8\x72\xf0\x46\x3d\x70\x35\x79\x36\xc5\x85\xef\x6a\xdc\x8b\x93\x67\x79\x91\xc6\xb3\xcb\x58\x3b\x7f\xae\xe5\x36\x32\xb4\x06\xa6\xf1\xc4\x9c\xb8\x64\x14\x99\xcf\xed\x21\xaa\x66\x40\x6b\x0c\x87\xda\xe9\xbe\xed\x70\x11\x48\x24\xb0\x20\xf6\x5e\x5e\x09\x07\xcc\x59\x4e\x6e\xdc\xa4\xe1\xcc\x5c\x58\xe2\xa2\x6d\x55\xa0\xd5\xca\xbc\xd8\x8e\x72\x54\xb1\xeb\x59\x75\x73\xac\x1d\x0e\x08\x17\xe3\x38\x23\xdd\x41\xed\x1a\xf6\xf8\x91\x93\x01\x56\x06\xe3\x8d\xf5\xee\x04\x33\xb4\x3f\x35\x6e\xa5\x56\xab\xae\xce\x58\xf2\x12\x22\x91\x9e\x52\x55\xea\xe3\x2c\x2b\x5f\xb9\x22\x71\xbe\x22\x43\x50\x02\xbe\x29\xf1\x30\xca\xab\x3b\xa5\x17\xc5\xb8\xeb\x57\x1b\x45\x7f\xf1\x58\x91\x3a\xda\xbd\xa7\xf7\x06\x53\x30\x46\x06\x67\x56\x78\x60\xcc\x63\x69\xc5\x07\x18\x10\x05\x0d\x0d\x5c\x64\x22\x42\x3a\x6d\xda\x50\xa5\xd2\x2a\xd5\xc2\xdf\xc4\x3c\x61\x6a\x19\x09\x9e\x4f\x57\xc7\x67\xa0\xa0\x76\x50\x77\x4b\xa0\x7a\x94\x61\xe1\xac\x75\x79\xd5\x1d\x5a\x39\x06\x68\x2c\xea\x97\xa5\xdb\x0b\x14\x73\xcb\x58\x11\x84\x56\xe6\x1b\x1b\x94\x4b\xf5\x47\x15\x47\x4e\x60\xee\xfb\x1e\xac\xcf\xba\xe5\x96\x1a\x7e\x4d\x35\x02\x03\x5f\xea\xc3\x97\x6b\xf7\x06\x21\xfb\x3e\x18\x53\x00\x62\xb0\xae\xfa\x1e\x00\x23\xe8\x60\x15\xb0\xd3\x18\xab\x93\xcc\xc2\x3b\x1b\xa1\xcc\x36\xc6\x41\x43\xa6\x06\x6f\xa1\x7a\x08\x5a\xb0\xa2\xea\x14\x77\x80\x16\xb8\xdd\x99\xed\xe0\xae\x09\xe0\x5e\x6c\xad\x10\x6d\x79\xb3\xc1\x9b\x88\x29\x98\x10\xbe\x0e\x00\xfd\xfd\x8b\xd7\xad\x3d\x1c\xb0\x21\xec\xe2\x43\xff\x97\x57\x37\x0f\xce\x42\xdb\xd0\xf7\xcc\x81\xb7\x09\xee\x04\xb3\x9e\x9e\x70\x62\x3c\xaf\x06\x86\xb9\xd6\xe0\x2a\xb5\xb9\x1b\x26\xe6\xd0\xdd\xe4\x25\x95\xb7\x55\x52\xcf\x24\x12\xe1\x07\xd2\xcd\x53\xe8\xf8\xa0\x76\xd2\xeb\x13\xbe\xb6\x41\xad\xae\x5d\x5e\xd7\xfe\x25\xb7\x59\xf9\x23\x4d\x2f\xae\x68\x31\x29\xc1\x1b\xb0\x3a\x83\x9d\xf2\x94\x7b\x84\xb0\x24\xc6\x81\x70\x4e\x4b\x99\x14\x58\xd2\x2c\x4f\x38\xfc\x54\x73\x90\x0c\x20\xe3\x50\x4c\x58\x22\xb0\xa7\xa5\x44\xd7\x49\x9c\xf7\xbd\xc6\x88\xc4\x79\xdf\xd5\xaf\xe1\xef\x65\xb8\x06\xc9\x00\xe7\x20\xaf\x04\x32\x4f\x55\x4e\xb8\x49\x54\xcd\x42\x21\xdb\x30\x11\xe6\x0b\x23\xe1\xf4\x19\xa3\x8b\xf0\x94\xac\x1f\x8d\x82\x14\xbe\x39\x05\xa6\xa4\x80\x6a\x41\xee\x67\x24\x02\xc1\x6e\xd5\x47\x63\x5c\x78\x47\x2a\x42\xa8\x20\xc3\xa8\x58\x6d\x87\xdc\xbb\xc7\xbb\x81\x5e\xb4\xde\x7b\xe6\xcc\x65\xfe\x99\xcb\x12\xbd\xed\xac\xfd\x05\x0f\x02\x0d\x7b\x32\xaa\x76\x64\x1c\x45\xb0\x40\x39\x1a\x05\x56\x24\xfa\x93\xfa\x2b\x57\x85\x31\x03\x61\xa1\x19\x08\xc3\x0e\xd9\x04\x66\x20\xac\x0a\x31\xdd\x61\x81\x21\x08\x6b\x35\x04\x61\xce\x10\x84\x55\x86\x20\x55\x05\x9e\x0f\x70\x56\x37\x02\x71\x8b\xde\xc3\x1c\xeb\x22\x22\x10\x6b\x56\x1f\xf8\x45\x1d\x6d\xb5\x6d\x6e\x3d\x64\x23\xda\x16\x26\xec\xdb\x54\x14\xb0\x18\x7b\x00\x72\x6e\x41\x8e\x45\x60\xba\xcf\x47\x88\x11\xde\x74\xdc\x5b\x11\x32\x60\x3b\x04\x32\x0a\x0e\x3e\x29\x1d\x10\x38\x11\x9e\x8b\x63\xe1\x1a\xf0\x85\x5f\xe0\x41\xd1\x7d\xe2\x9d\x33\x1a\x1b\x4e\x8a\x58\x11\x80\x80\x1f\x69\xa0\x74\x67\x6d\xb9\x74\x60\x41\xbb\xb1\xec\x58\xf4\x21\xd0\xda\x62\xcd\x4e\x92\x3b\x2f\x62\xc2\xf3\x12\x14\x0e\xae\x92\xd7\x09\x6b\x9e\x5d\x19\x26\x56\xb6\x81\x10\xe3\x5a\x28\x0a\x60\xad\xfb\x3d\x30\x58\xfd\xae\xae\x4b\x71\xce\xe4\x8c\x15\xbd\xc4\x8e\xb5\xd2\xa5\x30\x0a\x7e\x6d\xdf\xba\xa0\x10\x2d\xb3\x5d\xa1\xf9\x1a\x01\x7f\xe6\x10\x80\x77\x00\xca\xd0\x67\x7a\xcd\xb7\xbc\x51\xd8\xad\xcb\x65\x46\xda\xd4\x8a\x88\x28\xfa\xa5\x88\x2b\xe9\x44\xcd\x01\x3e\x6f\x84\x43\x76\xed\x9a\x0f\xbc\xfd\x57\x37\xd9\xdf\xa9\xac\xfb\x7d\x63\xad\x00\x3f\x6c\xa2\xa7\x2a\xc2\xa3\x3a\x4f\x1d\x2e\xa9\x76\xa9\xb7\xad\xfc\x95\xfb\x2d\x1a\x6d\x14\x2e\xd3\x8d\xf0\x9e\x15\x0d\x9d\x12\x8d\xa5\xcd\x0e\x9c\x65\x93\x09\xe3\xbd\x04\x24\xfe\x0a\xd3\x56\xe6\x55\xfe\x4a\xf5\xdd\x6d\xf9\x4e\xa6\x38\xa9\xf9\xd6\x72\x9b\x8d\x99\xb3\x42\xdd\x2d\xc3\xbe\xfa\x4b\x2f\x15\x79\x4e\x17\x25\x9b\xf4\x12\x5e\xef\x41\x51\x47\x02\x4e\x56\xea\xf7\xa0\x68\x6c\x79\xe3\x9c\x64\x2c\x3d\x53\x2b\x55\x71\xd0\x25\x9d\x0e\xfa\x95\x14\x21\x48\x3d\x17\x11\x45\x18\x9f\x26\x3c\xb5\x46\xce\xff\x50\xa5\xaf\xb3\x6d\x0c\x44\x1f\x9b\xdf\x47\xd6\x0a\xd6\xda\x8d\x1a\xeb\x75\xf8\x79\x6a\x72\xcd\x47\xd6\xb8\x74\xb7\x11\x0e\xb3\x33\xb4\x59\x2f\x2d\xbb\x17\x45\xd1\x6b\x19\xeb\xfb\x96\xf5\x2e\x6d\xca\x9c\xf2\x18\xd5\xc2\xdc\xf0\x80\x77\xc0\x6b\xb7\x61\x1d\x71\xc2\xc6\x62\xa8\xdf\x9d\xeb\x19\x06\x3d\x86\xb8\xb8\xda\x4b\xab\x55\xf7\x01\x07\xe7\x47\x0d\x6e\xcd\x61\xa6\x6e\x7e\x55\x8f\x1f\x25\x57\x70\x17\xe4\xa4\xc5\x10\x95\x1a\x9e\x77\xb8\x75\x75\xca\x17\xeb\x7d\xcc\x8c\x9b\x4e\xa1\x7d\x0e\x6b\x69\xaa\x15\xf0\x36\x04\xaa\x55\x08\x0d\x11\x5a\x4c\x56\x35\xd6\x63\x0e\x3d\xf6\x82\xa2\x1b\x5b\x1c\x56\xb7\x92\x85\x21\xa3\x5b\x11\xc0\xd9\x0d\xc1\x0a\x04\x43\x11\xa0\x3d\x13\x8f\xff\xc9\x4f\x88\xc4\xe2\xf8\x43\x7e\x42\x72\x45\x4b\xc2\x16\xc9\xa6\x05\x9d\xbb\x00\xf3\x9a\x79\xaf\x1f\xd8\xfc\x4c\x6d\x9c\x77\x2c\xee\xe5\x82\x4e\x7a\xce\x76\x5d\xef\xad\xcb\x6c\xc2\x84\x29\x4a\x97\x93\x4c\xf4\x92\x2a\x76\xf5\x8f\xd4\x8f\x48\xf3\x8e\xc5\x3f\xd2\x63\x76\x52\xab\xa1\x14\xcb\x22\x65\xba\x05\xa6\xe0\x50\x6f\x22\x9b\x9f\x9b\x06\xb2\x39\x3d\xb7\x9d\xcc\x33\x7e\x51\xfb\x08\x6f\xea\xe4\x54\x14\x73\x5d\xb6\x60\x25\x93\xae\x6c\xb9\x3c\x9b\x67\xb2\x5e\x5a\x87\xe0\x2d\xf5\x07\x52\x9c\x9f\xe7\xac\xd1\x25\x1d\xfa\xf6\x85\x36\x90\x57\xe5\x32\x7e\x49\xf3\x0c\x5a\xc6\x2f\x59\xcc\x71\x4f\xf0\x7d\x1d\x70\x32\x1c\xad\x8e\x76\x97\x88\xfe\xe9\x55\x41\x17\x0b\xab\x98\x78\x7b\x45\xcb\xc3\x65\x2e\xb3\x45\xce\x92\x6e\x37\xef\xcf\xcd\xc3\xfa\xbb\xaa\x77\x9e\x25\x93\xcf\xf7\xeb\xdb\xfa\x2d\x8b\xa9\x2a\x68\x08\x28\x8b\x17\xb3\x4e\xc6\x3b\x39\x02\xc7\x17\xb5\x80\xdb\x99\x11\x8b\x97\x24\x3f\xce\x4e\x46\x3d\x4b\x2a\xf7\x08\x21\xd9\xb8\xe1\x8f\xa0\x1c\x0b\x3f\xca\xb9\x31\x89\x62\xe4\xb8\xfa\x10\x97\x27\x28\x69\x38\x2d\x28\xa3\xa8\xfe\x65\xaf\xf7\xb0\xf9\xb1\xca\x3c\x41\x09\xa3\xcd\x8e\xda\x3d\x5c\x46\x11\x0c\x3c\x43\x6b\xb3\x11\xdc\xc2\xd7\x33\xf9\x5e\x9d\xed\x3f\x2b\x90\x81\xea\x60\x2b\x44\xa1\xcc\x8f\xb1\x68\x9d\x4f\xbd\x71\x16\xc0\x0e\x4d\x42\xd2\xa5\x95\xf1\x2f\xf8\x7e\x9e\x81\xd3\x7b\xd1\x17\x1c\x82\x9a\x92\xd7\x0c\xad\xc1\x37\x5b\x68\x35\xeb\x45\xf1\xa9\x63\x39\x1d\x05\xc1\x0c\x29\x23\x7b\xe0\xe4\xb0\x52\x8e\x4c\x6a\x91\x23\x41\xdf\xf7\x32\x07\x08\xfe\x1a\x53\x84\x4c\xc6\xb8\x57\xa6\x45\xb6\x80\x48\xa9\x74\x1c\x33\x92\xf5\xb5\xf9\x8a\xf1\x34\x10\xf7\x26\xd9\x65\x4f\x7b\xaa\xe4\xac\xf8\xf9\xe3\xe1\x1b\xd2\x7b\xa6\xbf\x79\xfe\xec\xef\x1f\x4c\xaa\x87\x35\x8d\x3f\x17\x97\x0c\x34\x16\x63\xe6\xab\x2f\xa2\xa4\xb1\x36\x44\x3f\x2b\xc7\xcd\xe6\x28\xbe\xcd\xca\x44\xbd\x5c\xa3\xa4\xa5\x3b\x14\xd9\x90\x9b\xd0\x63\x60\x1e\x81\x61\x85\xd9\x33\xe3\xcc\x25\x49\x77\x90\x28\xc2\xf5\x2b\x83\x30\x1b\x2a\x41\xf4\x33\x42\x28\x69\x54\xfd\xf6\x43\xac\x95\x9c\x0c\x9e\x64\x80\x27\x05\xfe\xa0\x11\x7f\x77\x08\x5c\x5a\x0f\xef\x12\x86\x33\xf2\x42\xed\x21\x81\xfe\x1d\x6c\xca\x10\x2e\x2d\xb5\x7d\x27\x46\x2d\xc9\x60\x54\x7a\x18\xb5\x74\x18\xb5\x3c\xc1\x0c\x8d\x6a\xb5\xb4\x60\xd5\x66\x53\xf7\xc4\xac\xcc\xc7\xac\xcd\x5a\x1a\xd8\x95\x85\xd8\xb5\xf9\x45\x2b\x86\x6d\xe9\x9e\xc5\xb2\x4c\x6b\x4d\xbc\xd5\x89\x00\xa5\xb1\xbb\xf1\xa1\xdd\x91\x25\xf9\xea\xb9\x5d\x09\xb7\x2e\xbb\x1b\x15\x0b\x0f\x15\xdb\xb8\x16\x02\xdf\x82\x8b\x26\x6b\xad\xf2\x7d\x9d\xf2\x91\xb4\x19\xda\x9f\xdf\x35\x34\x8b\x59\x4a\x22\x34\xfe\x36\xf7\x97\x94\xe8\x70\x67\xb9\x42\xde\x29\x02\xcf\x42\x35\x9c\x98\x23\xeb\x81\x2c\x3d\xce\x4f\x46\xbd\x52\xde\xe4\x10\x13\x39\x1f\xbf\x61\x31\xc3\x4b\x94\xf4\x26\xaa\xb1\x42\x2c\xcb\xfc\xe6\x03\x93\xaf\xed\x96\xd7\xa5\x34\x32\x8a\x97\x64\x39\x5e\xf6\x4f\x4f\x67\x72\x9e\x1b\x28\xa0\x28\x7a\x41\x4d\x15\xfe\x99\x90\x37\xcf\x84\xe5\x38\x74\x82\x4c\x57\xab\x9e\xfa\x5d\xa2\x28\xfa\xc9\x54\x51\x3f\x0e\x96\xfa\x55\xaf\xf7\x50\xbd\x2d\x97\x0b\x88\xd9\x6e\xce\x86\x83\x49\x06\x72\x83\xcf\xb4\xe0\xda\x83\x33\x48\xa8\x6c\xa9\x9f\x41\x83\x37\x13\xbc\xf6\x9e\x2e\xa5\x78\x05\xa1\xb0\x75\x46\xdc\x3c\x44\x72\x64\x86\xbc\x34\x67\x48\x6e\x65\x1c\xcb\x28\x3a\x88\x19\xce\xf1\x12\x67\x68\xf3\xc1\xc2\xd4\xc1\xc2\xb0\x50\x98\x63\xd3\xc1\xc2\xd4\xc1\xc2\x5a\x57\xae\x6e\x4b\x68\x97\x60\x51\x04\x3e\x87\x5f\x48\x59\x64\x67\x4b\xc9\x6c\x10\x71\x05\x95\x0f\xb1\x29\x84\x36\xac\xf2\x0a\x25\x7a\x6b\x5a\xdd\xe4\x8d\xbb\x31\xdd\x12\x1f\x7f\x89\x19\x0e\x8b\xa8\xae\xbb\x8e\xd8\xe8\xc3\xba\x3f\x8d\xc2\x61\x01\xef\x34\xbd\xe3\x38\x2c\xbd\xe3\x90\x05\xc7\xe1\x67\x8d\x59\x5b\x4e\x3e\x1e\xb8\x14\x6f\x51\x66\x6c\xdc\x6b\x1e\x27\x3a\x78\x60\x93\xac\x7f\xaf\xb1\x7b\x5d\x89\x53\x54\x04\x7c\xc3\x33\xb8\xf0\x1c\x22\xdf\x4d\xcd\xd7\x2f\x1c\xb8\x8d\x9a\x1f\xc7\xe1\xad\x49\x34\x94\x73\xb8\x39\x91\xf4\xf9\x0e\xc0\xd5\x7c\x89\x16\xc5\x25\x4e\xe2\x6f\x91\x02\xc8\x9c\x7b\x1f\xd9\x35\xb8\x03\x52\xb4\x8d\x6d\xc2\x3f\xde\x78\x0b\x20\x87\xee\xf6\xf7\x46\xc6\x37\x25\xc2\xb1\x68\xa8\xa4\xb4\xc9\x19\x5b\xd4\x5e\x39\xf8\xfd\x75\x6a\xbd\x02\x0b\x62\x38\x43\xa0\x2d\xbe\xd1\x1d\x4e\x25\xe1\x03\xf0\x25\x31\x6d\x46\x89\xb0\x95\x52\xcc\xdd\x25\x9d\x3a\x99\x26\x7c\xa1\xf9\xa0\xd6\xe5\x15\x38\xa0\x0b\xdc\x94\x5b\xd2\x0b\x84\xd1\xbe\x75\x0d\x35\xe6\x55\xe6\x31\x07\x6d\xd5\x8d\xfe\xcd\x6b\xa5\xad\xc0\xc6\xb3\xfd\x41\x08\xf3\x28\xea\x0a\xcd\x2c\xf6\xc5\x62\x95\x42\x6f\xb7\x0d\x10\x77\x09\x4c\x55\x55\xc3\xc8\x63\x5a\x8f\x8b\x94\x10\xf2\xab\x22\xa1\x8b\x94\xfc\x56\xa2\x24\x2e\xd2\xae\xce\x81\xc4\x6f\xe5\x6a\xa5\x5e\xfd\x55\x02\xd7\x9b\x94\x95\xde\x16\x4b\xa3\x28\x7e\x27\x62\x96\x62\x9e\x22\xbc\x0f\xa9\x32\x45\x08\x21\x1c\xf3\xd5\xaa\x6d\x93\x1a\x5f\xab\xb0\x68\x76\x03\x8e\x41\xfd\x76\x3e\x1c\xd8\xd7\xbf\xc8\xda\x9b\x27\xf7\x60\x47\x0c\xf7\xd4\xde\x36\xab\xd1\x40\xac\xb9\x28\x51\x9d\x4b\x47\xdb\xc4\xe1\x5e\x00\x2c\x51\x89\x2a\xb4\xed\x0f\x45\xb3\x22\xd6\x98\xdc\x32\xd7\x0d\x00\x7d\x2d\xeb\x36\x7e\x3f\x1c\xcb\x75\x6e\x7f\x5e\x67\xcd\x5e\x70\x75\x40\x6b\xde\x5f\x4d\xae\x80\x6d\xcb\x60\xc2\x57\xb7\x19\xd7\xc6\x7b\x35\x8d\x69\x8a\xdb\xe6\x03\x1c\xbe\x55\x8b\x13\xbe\xf2\x57\x2e\x37\xae\xa2\xbd\xf5\xeb\x97\xaf\xb8\xcd\x1e\x83\x99\x12\x81\x73\xc2\xfd\x35\x1d\x81\xf4\xae\xee\xd5\x9f\x36\x9a\xc2\x34\x30\x54\xd3\x0e\xc8\x68\x8d\x0f\x0f\xc8\x20\xde\x24\x0c\xac\x1b\x14\x92\xdc\x09\x28\x4d\x0b\xa1\x9e\x51\x2d\xd3\x93\x0d\xd2\xa6\xbf\x22\x1a\xb2\x8d\x0d\x2f\xbf\xbd\x2b\xad\x61\x58\x9b\xdd\x6b\xe8\xfa\x3b\x71\xaa\x13\xaf\x86\x1d\xae\x1f\x4c\xf5\xbe\x37\x22\xd0\xdc\xa1\x38\x9f\xd7\x38\xdf\x6d\x03\x74\x84\x5e\x52\xb7\x68\xcd\xeb\x5d\x0f\x02\x8d\xe6\x41\xa4\x55\x3f\xea\x68\xde\xaf\x1e\xd6\x81\xbf\x43\xcb\xed\xb2\x6a\x1d\x15\xba\x5a\x6d\x57\xba\x39\x39\x71\x6c\x54\x6d\xbd\x04\x5c\x35\x8a\x5a\x77\x8f\xf6\x5b\x15\x6c\x1e\x4a\xba\x03\x08\x4a\xd9\xb2\x71\x78\x63\xe3\xf0\xc6\xc6\xd1\x9b\x6f\xe0\xed\x20\x75\x83\x89\x22\xcb\xda\x76\x59\x87\x62\xa2\x50\x75\xee\xc9\x58\x2c\xd7\x9c\x6c\xde\x53\xdc\x59\x56\xc7\xb2\x79\x52\x70\xe7\xbc\x69\xfb\x5f\x45\x19\xa3\x2d\xd1\x22\x42\x7d\x2e\x6a\xe2\xd2\x28\x1a\x3e\x6b\xb0\x0c\x2c\x28\x1c\x32\x69\x9c\xc8\xad\x02\x0e\xc2\xb7\x86\x68\x24\x7c\xd1\xee\x38\xae\x18\xdb\x75\xf1\x53\x0e\x67\xba\x1e\xa1\x83\xf2\xb8\x62\xd0\xe7\x89\x2b\x89\x75\x29\x92\x07\x74\x46\xd7\x82\x73\x0c\xc6\x70\xcd\xa1\xc5\xf5\x2c\xa2\x00\xf3\xf0\xd1\x40\x4d\x91\x29\x8f\x85\x2f\x7a\xc6\xc2\x30\xe6\x3d\x79\x96\x37\x1d\x81\xbf\x8c\x36\xf8\x42\x03\x35\xb9\x1d\x96\xbe\x96\x95\x5e\xc1\x74\x3c\x8c\xe4\x6a\x3b\x19\x46\xea\x18\x33\xee\xe2\xeb\x22\x9f\xc7\x58\xf3\xf1\xfd\xb0\x2b\x85\x1f\x67\x3d\xf0\x6d\xf9\x52\xc6\xcc\x3f\xf8\x9c\xf5\xa4\x9b\x58\xbb\x89\x76\x07\x7b\x8f\x23\x30\x1a\xae\x87\x7e\x94\xab\xc7\xbb\x98\xf9\x6e\xee\x77\xd4\x91\xd9\xc2\xbe\x37\xa7\x57\x1c\x34\xd0\xf0\x34\xbd\xfd\xf4\x91\xe7\x3c\x6d\x43\x73\x96\xf3\x6e\x8a\x81\xaf\x46\x7c\x07\x1d\x19\x34\x89\xbf\x67\x30\xc3\xbd\x5a\x55\xd5\xab\x90\xf0\xd8\x40\x72\xd8\x7e\x85\xb6\xc2\x9d\x9a\x88\xe6\xb2\x08\x3c\x10\x6a\x16\x00\xc3\x9a\xd7\x92\x48\x5c\x4a\x9a\x5e\x24\xff\xc4\xd2\xb7\x22\xbf\xa9\x99\x69\xe9\xd2\xd8\x68\x58\xa6\x17\x4e\x96\x54\x99\x6c\x81\xc4\xe9\x9f\x98\x23\xe4\xe1\xa8\x7d\x63\x96\xa7\x45\x61\xfe\x95\x4d\xd1\x1c\x43\xe2\xcc\x76\xf7\x63\xe7\x40\xb3\xb8\xb9\x4d\x05\x2f\x45\xce\xfa\xc0\xcb\x51\x3d\x73\x16\xef\x25\x83\x85\x2d\x96\x32\xf6\x1d\x72\x68\x0f\xaa\x6b\x7f\x08\xa7\x66\x08\xaa\x3e\x13\xd3\xa2\x71\x26\xc9\x0d\xd1\xd0\x6a\x91\xea\x3e\xf1\xb9\xb6\xcc\xa8\xc2\xcd\x2f\x75\x1c\x1c\xaf\xbd\xeb\x40\x01\xb3\x60\xd3\xc0\x05\x5d\x6b\x98\xd4\x8e\x04\x1f\x01\xd6\x17\x49\xa3\x72\x40\x9f\x35\x67\x23\x95\x13\xd1\x50\x7e\xe9\xcb\xd8\x6a\xc2\x34\x2b\x63\xdb\xde\x36\x4b\x64\x54\xc5\x6e\xdd\x7e\xf4\xd8\x27\x24\x2b\xca\xb9\x32\xf6\xad\xdd\x2f\x1b\x1a\x3c\x2c\xb8\x04\x4a\x1d\xc4\xa0\x4d\xbf\x38\xae\xc7\xb6\xd1\x94\xf1\x98\x7b\x36\x1a\x1c\x61\x81\x9a\x91\x0a\xdb\xaa\x23\x72\xed\x0f\x67\x27\x31\x1b\xd7\xdc\x9b\xcd\x3e\x0a\xe9\xf1\x3a\x52\x7b\xbc\x13\x38\x5a\x2d\x9c\x77\x4d\xb5\x5c\x5b\x8e\xdd\x58\xfa\x41\x45\x6a\xbe\x8e\x90\xdb\x2f\x26\x4c\xb4\xf1\x47\x19\x43\xb4\xb2\x88\xa1\xca\xf8\xad\x00\x1b\x56\x70\xcd\x33\x72\x29\x12\xf8\x0c\xd2\xee\x4b\x8a\x18\xad\xb9\xf1\x74\x64\xdd\x95\xc1\x82\xf2\x56\xde\xc1\xff\x6a\xb7\xf5\x15\xdc\xeb\xf5\x7d\xba\x78\xe4\xe4\xc4\x2e\x22\xca\xfd\x97\xab\x76\x4f\x74\x50\xc4\x3b\x98\x23\x6f\xed\x32\x3f\xcc\x1b\xde\x8d\x3c\x35\x3c\xe4\x3b\x48\x65\x4d\xdb\xa3\xd8\x70\x49\xac\xc7\xb3\xd6\x98\x4b\xb5\x6b\xab\x5a\xa5\xdc\x46\xca\x09\x0d\xfa\x47\xac\x45\x6f\xbf\xe9\x2d\xee\x7e\xeb\xda\x11\x15\xe0\x66\xc1\x9b\xc1\x5a\x00\xee\x94\xc7\x1c\x5c\x03\x21\xef\x6c\xdc\x54\xdc\x28\xc2\x72\x2f\xc4\xae\x51\x15\x45\x6e\x46\x34\x63\xc1\x77\xad\xc1\x6c\x21\x4f\xa1\xbc\x62\xd3\x75\x86\x6d\x05\xd6\xae\x5f\xc1\xf6\x7c\x54\x85\x4a\xf1\x67\xcd\x8d\x91\x00\x25\x19\x4c\x62\x14\x7d\x66\x55\x10\xab\x10\xe2\x51\xc4\xfa\x53\x91\x2e\xcb\x18\x39\x67\x1c\xc1\x8e\xaf\xe1\xba\x9d\xa4\x0e\x54\x98\xe7\x9a\x7e\x9a\x85\x1e\xf7\x55\x29\x50\x98\x1f\xde\xce\xc3\x77\x9e\x37\xe5\x28\x3a\x60\xea\x30\x74\x47\xbd\x45\x44\x7a\x6d\x9b\x35\xbf\x3d\xbc\x17\x62\x7a\x57\xdf\x3e\x2d\x67\xc9\x41\x1a\x45\x07\x69\x2c\x11\xbe\xeb\x28\xd8\x6d\xec\xb1\xe0\x36\x22\x6b\x6b\xac\x7a\xe3\xbb\x66\x41\x16\x1b\x68\xd7\x0b\x23\xed\xeb\x88\x8f\xf7\x9e\x24\xbc\xee\xeb\x88\x91\xc2\x3a\xd9\x04\xcf\x11\x06\xe9\xf9\xba\xef\x95\xf6\x08\x1c\xfe\x3c\xf6\xce\xfb\xa5\x88\x85\x5a\x4a\xce\x2d\x84\xc1\x30\x0c\x62\x00\xad\xd1\x3a\x58\x8e\xd7\x85\xd6\x96\x90\x77\x07\x3c\xe6\xad\xc7\x7b\x14\x9d\x16\x10\xc9\xcb\x5f\xe2\x8f\x74\x9d\x7e\xd6\x6e\xf2\xde\x4e\x87\x87\xe1\xf6\x83\xe3\xdf\xf3\x6f\xeb\xf4\xb9\x75\x4c\x01\xef\x8a\xdf\x16\x42\x18\xb3\xe6\x6d\x9e\x35\x2f\xbb\xb8\x61\xa0\xcd\x9a\x4c\x0a\xd6\x64\x52\x04\xea\x39\xf5\x5e\x04\x99\x1e\x37\xd5\xc3\x19\x32\x8a\xf6\x81\x20\xab\x1c\x0f\x17\x5e\xc0\x8d\x47\xc4\x79\x44\xd9\xa9\x92\xbb\x36\xe9\xf9\x06\x86\xaf\x8c\x03\x92\x8a\x66\xf2\x3d\xbc\x18\xe7\xe6\xff\x14\xb1\xac\x4e\xa8\xca\x95\x88\xe7\x9d\xbb\xb6\x73\x06\x95\x5c\x43\x06\xb8\x86\x37\xdc\x06\x09\xd2\x1d\xfa\x53\xbb\x63\x71\x88\x24\x35\x7b\x16\x2c\x48\x77\x50\x57\xef\xab\x35\x3c\x44\x68\x3d\x7c\x1c\x62\xb0\xf8\xa7\x18\x62\x55\xfa\x3a\xe2\x11\xd9\x1a\x3e\x41\x23\x96\x48\xa3\x10\xc9\x46\x23\x13\xe0\xc5\x21\xa6\x36\x5d\x55\xee\x74\x55\xff\x29\x62\xfb\x80\xc0\xcd\x88\x53\x1e\xeb\xb0\x35\x77\x05\xd7\xda\xc8\xa0\xa6\xb7\x6a\xdf\x06\xba\x61\x8f\x6c\x50\xd5\x28\x7a\x5c\x25\x87\x4f\x6d\x5a\x77\x64\x3b\x38\x63\xad\xde\x6d\x47\xfa\x1e\x47\xad\x92\xd3\x2e\xb1\x61\x5a\xbd\x72\x3c\xd4\xe2\xd5\x7d\xd0\xdc\x12\xf0\x52\x14\x36\xa0\x3d\xa8\xd4\x8e\x9e\x0e\x5b\xaf\xc5\xf8\x10\xd4\x85\xb1\x44\xc9\x47\x9b\xf2\x3c\x22\xd7\x74\xa9\x61\xf1\x61\x41\xd4\xf2\x2c\x56\xab\xc7\xea\x07\xdc\x6e\x21\x46\xc4\xd8\x5b\xeb\x89\xef\x55\x26\xe3\xa5\xa4\x3c\x65\x58\x8e\x9f\xd6\x04\x13\x5e\x48\x02\x55\x8c\x15\xe6\x00\x07\x3a\x2c\xe1\x2d\x79\x71\xad\x0a\x58\x9a\x55\x2d\x58\xd6\xbf\xe1\x08\x62\x0b\x72\x2c\xfb\x74\xa1\x76\xad\x51\x4e\xa8\xae\x57\x3a\x2e\x2e\x90\x13\xef\x85\x00\x06\x16\x2c\x57\xe4\xbb\x18\x74\x2c\x5e\x69\x45\x54\xa0\x76\xe6\x89\xab\x2a\xae\xf0\x6e\xe0\x31\xaf\xb2\xe6\xd0\x8c\x60\x07\xd4\xc0\x52\x82\x3b\x15\xf0\xd6\xf7\x9e\xcb\xe9\xff\xf6\x9c\xb4\x41\x99\xd7\x60\x75\xcf\xa1\x7d\xfc\xc6\xd0\x5a\xdf\x7b\x9e\x78\xdd\xd0\x2c\x36\x03\x67\xf9\xc4\xd8\xb0\xe9\xad\xd3\xcd\xd0\x6d\x46\x72\x8b\xe4\xb4\x2a\xfd\x28\xd8\xdf\x59\x43\x14\x37\x40\xc8\x29\xc1\x91\xcc\x77\x97\x11\xa0\x32\xea\xd0\x58\x87\xd5\x10\x99\x20\xa2\x86\xc8\xa8\x43\x64\x1d\xb6\xce\x88\xb5\x37\x5a\x67\xa4\x3b\x50\x9b\xf0\x91\x8e\xe5\xa3\x10\xf7\x63\x9b\x54\xa8\xda\x8e\xad\x24\x0c\xa7\x04\xa2\xca\xe1\x29\x49\x47\x23\x45\x6b\xbf\x2b\xe2\x12\x4f\xf1\xd2\x2d\xce\xa9\x86\x6f\x14\xed\xc2\x03\x68\xf8\x87\x7b\x7f\x8a\xa7\xb6\x94\x93\x58\x4e\x21\x26\x4e\x9b\xf6\xfe\xb4\x15\x23\x4e\x1d\x46\x9c\x56\xda\xfb\x5e\x05\xae\xc0\x7a\x5a\xc7\x82\xf6\x0d\xf4\xc2\x4e\x29\x1d\xc7\x25\x11\x6a\x78\x1e\xac\xd5\xce\x2d\xab\x9d\x5b\xfa\x9b\xdf\xd7\x1b\x4a\x51\x52\xd6\x32\x50\x22\x82\x1c\xaf\x5a\xe4\x02\x5e\xec\x56\x50\xf6\x3c\xa4\x5b\x8d\x6a\xe1\xf7\xa5\x65\x2a\x6d\x04\x1f\x3b\xae\x1c\xe7\xf6\xe3\xca\x30\xc2\xb5\x05\x84\x64\x0e\xf1\x3c\x6b\xed\xdc\xa7\x9a\x6c\x1a\xe7\x95\x79\x44\x30\x3f\x79\xeb\xfc\xe4\x6e\x7e\x72\xdf\xba\xc2\xec\x81\xdc\xbd\xc7\x0e\x06\xa0\x16\xd5\x1d\xa2\x75\x5e\x9f\x30\x57\x36\x60\xab\x57\x8e\x9b\xef\xcf\x0e\x69\xd2\xc0\xfe\x3d\xf3\x42\xdd\x33\x25\x0a\xd5\x8a\x47\x81\xaf\x41\x8f\xbc\xac\x98\x3c\x9e\x0e\x74\x4d\xf0\xd1\x16\xe1\x40\xdf\x28\x05\x30\x4f\x02\x0d\x58\x8f\xfa\x03\xdb\x9e\x26\x39\xc8\x9d\x0f\x7d\x38\xde\x8d\xb2\x97\xd1\xdd\xd0\x52\xd7\x5e\x41\x27\x99\x30\x0c\xfe\x9b\x85\xbb\xd9\x08\x08\x9d\x13\x45\x2f\x63\xf0\x5a\xf4\x82\x69\xc5\x31\x49\x20\x25\x10\xa6\x64\x30\xa2\xcf\x72\xab\xaf\x45\x1f\x92\x6d\xeb\x9d\x3f\x3f\xa6\x27\xb8\x54\x3f\x0f\x87\xbe\x0e\x4e\x36\x7e\xc3\x62\x8e\xcb\xbb\x75\x70\xb2\xf1\x0b\x6a\x4a\x85\xaa\x97\x3f\xe9\xdc\x83\x98\x43\xac\x41\xe9\x68\x37\x16\xea\xa4\xbc\x86\x2e\xb7\xeb\xa2\xfc\xde\x78\x67\x15\x48\xd4\x29\x1b\x28\x4a\xf5\x3d\x3d\x29\x7c\xc7\xbb\x50\xdf\x84\xd5\xf4\x4d\xd8\xf8\x4b\xcc\x43\x15\x12\x06\xfa\x26\xb2\x4b\x82\x2f\x8d\x2c\xbc\xae\x82\xd2\xfc\xbc\xa9\x81\x92\xb4\x94\x71\xba\x82\xc7\x27\x49\xaf\xa7\x5a\x44\xeb\x75\x70\xcd\x7e\x9c\xdc\x4f\x65\x7b\xbb\x62\x84\xc3\x8d\xd8\x0f\xc6\xec\x74\x35\xea\x4b\x19\xd5\xf4\xe8\x65\x70\xd1\x32\xf5\x98\x1b\x30\x08\x71\x4c\x9a\x74\x87\xf8\x80\xc5\x75\x13\x71\x77\x39\x6e\xdc\xd5\xc1\xb5\xa0\xac\x7c\x54\x85\xe6\x9d\x8a\x6a\x4f\x62\xa1\xa5\x63\x56\x8c\xb3\x4c\x41\xd8\x51\x31\x9f\x9d\xb5\x1a\x37\xa7\xac\xbb\x9c\xa0\xdc\xb7\x90\xc7\x62\x0c\x21\xfc\x60\x41\xb7\x3b\xbc\x28\x99\xb4\xaa\x55\xe3\xe0\x29\xee\x99\xf0\x5c\x3d\xdc\xe3\x82\xb3\x1e\xee\x65\xf3\x85\x28\x24\xe5\xb2\x87\x92\xdc\x46\xef\x22\xfa\x2d\x4a\xe2\xb0\x69\x5a\x67\xaf\x9a\x5e\x58\x57\xdf\x5a\x42\x5e\xc9\xca\x1b\x8a\x5e\xae\x7d\x34\xa6\xb6\x31\xe3\x4f\x5e\x57\xe5\x7a\xf0\x9e\x79\x9d\xa5\x1e\xd5\xf7\xd8\x81\x85\xb5\xae\x01\x31\xee\x81\x22\x56\xa0\x99\x6f\x0f\xec\xca\xe6\xcf\xb3\x72\x68\x61\xaa\x34\x4d\xea\x7d\x5e\xc9\x6d\x5e\x57\x6b\xc1\xb9\x6f\xe8\x97\x07\x47\x10\xff\x37\x2c\x03\xad\x69\x20\x6f\x39\xba\xbe\xc3\x30\x90\xff\x07\x86\x81\xde\x11\xf3\x16\x58\x0a\x35\x19\x50\xe3\xcd\xfd\x78\xd6\x6f\x03\xbe\x43\xed\xf4\x70\xe2\x87\xdb\x26\x5b\xc1\x19\xff\x56\x67\x99\xbd\xc2\x81\xf9\x52\xc0\x02\x60\x57\x9d\x9f\xc1\x72\xab\x11\x6d\x53\x5a\x82\x7e\x21\x3c\x8f\xe5\xe0\xd2\x83\xab\xd5\xaa\x0d\x5d\x78\x9f\x4e\x26\x86\x37\x35\x63\x3c\x2e\x70\x81\x50\x20\xae\x79\x51\x99\xbf\x11\xc1\xe3\xca\xe2\x5a\xd2\x73\xb2\x83\xd5\x65\xe9\x26\x17\x74\x42\x6e\x0d\xaf\xd6\xf3\x32\x5d\x58\xd9\x92\xb3\x42\x81\x58\xb3\x67\x34\xbd\x20\x1e\x3b\x6a\x91\xae\x56\xf1\x22\x55\x78\xe3\x2c\x25\x05\xc2\x46\xca\xb5\xc6\x9e\xab\xe1\x97\x77\xf6\x63\xe4\x2e\x2f\x37\x0b\xb6\xd1\x6f\xc8\xa8\x5d\xda\x53\x54\x14\x82\xee\x6d\x35\x28\xaf\x97\x66\x08\xa6\x6f\xb8\x88\x05\x5a\xaf\xb5\xbd\x8f\x3f\x57\xa1\xe0\x99\xfa\x9e\x4d\x5c\x83\x34\xe0\x4a\xef\x53\x99\xce\xb4\x25\x53\x0b\x70\x5a\x1c\xa3\x14\x95\x26\xd7\x2c\x1d\xcf\x52\x58\x06\x1f\x98\x8c\x8f\xe5\x2c\x2b\x4f\x50\x32\x4b\xf5\xb4\xce\xb2\xd2\x01\xd3\x1a\x95\x5b\x41\xa1\x7a\xd9\xec\x46\x6c\x85\x81\xb7\xee\xd5\x07\x90\x43\x56\x82\xf7\xa4\xd7\x5b\xa3\x35\xf2\xe7\xe6\x75\xe1\xe0\x13\x3f\x28\xa3\xf8\xf7\x72\xf5\x63\x89\x50\x97\x90\x3f\xcb\xb1\x0b\x4b\x33\xdc\x8a\xd5\x49\xf0\xc3\x70\xb0\x1a\xa0\x44\xe1\xd0\xab\x74\x7c\x95\x26\x57\x29\x69\x2f\xe3\xc5\x2f\x28\xbc\x60\xfd\xda\x72\x17\x44\x2a\xda\x78\xd7\x2a\x45\x54\x01\x70\x8c\x01\x26\x38\x84\x37\x5f\xec\x46\x95\x37\xe5\xbd\x3d\x88\xc3\x5e\x95\xaf\x62\xe7\x6c\x83\x6b\x93\x07\x65\xf4\x7b\xa9\xfb\xef\xd4\xbc\x52\x3f\x5a\x1f\x62\xe4\x27\x19\x33\x3c\x58\xf1\xbe\xd4\x72\xcf\xc3\x72\xb5\x7a\xc4\x76\xf0\xf6\xa3\x01\x6a\x33\x1d\xee\xec\xed\x25\x8c\x78\x7d\xf4\xf8\x5b\x7b\x4f\x13\x53\xe1\xf0\xd1\x00\x0f\x07\x81\xd5\x48\x67\xcf\x30\xa7\xf7\x1e\xdb\x52\xae\x1d\xbf\xd4\xa3\x84\x91\xed\x3b\x59\x61\x3b\xdb\x8f\x51\x5d\x35\x82\xa5\x51\x04\x18\x38\x8d\xa2\xad\x2d\xe6\xc7\x1e\x78\x55\xc9\xaf\x1e\x0d\x9e\x9d\xa6\x86\x58\x39\x4d\xc9\x00\x5f\x9b\x18\xe6\x0e\xfb\x81\x08\x3f\xe0\x59\x7f\x32\x0b\xcf\x32\x09\x61\x36\xaa\xf1\xeb\xc8\x24\x0f\xca\xe8\xb3\x81\x74\x14\xf9\x8b\x87\xc0\xe2\xf9\xac\xb0\x68\x12\x7f\x51\x3f\xf8\x41\x49\x74\xb9\x5f\x65\x8c\x50\xa2\x33\xe3\xdd\xe8\x41\xa9\x4b\xaf\x56\x7b\x4f\xb5\x78\x7b\x6f\x4f\xf3\x58\x5c\xc4\xe8\x74\x7c\xa3\xb7\xc9\x21\x5d\xc4\xc7\x10\x4a\xe0\x04\x25\xb1\xf3\x1e\x13\x73\x72\x93\x42\x90\x77\x86\xd0\x6a\xc5\x9f\x4b\x14\x45\x37\xa9\xa2\x29\xf4\x20\x3c\x8c\xf8\xe9\xff\x85\x37\x07\x87\xe0\xcc\x11\x66\xec\xcd\xab\x73\xb1\x88\xa2\xca\xce\x5f\x04\x28\x09\x16\xa3\x3b\x59\xbb\x84\x14\xe6\x44\x25\x85\xa7\x1a\x57\xb4\x1a\x23\xab\xee\xb4\xbe\x21\xd2\xd7\x27\x68\x75\x6f\x62\xc6\x72\xc7\xc7\x10\x92\x5f\x8f\x48\x77\xbf\xd0\xd7\x43\x61\xbd\x2b\x55\xec\xc5\x75\xe1\x8a\xd6\x95\x7b\x14\xd8\x53\xa2\x13\xbf\x80\xa4\x01\x54\x53\xff\x2a\xa3\xe8\x9d\x88\x05\xe6\x29\x02\x1d\x53\xa1\x26\x12\x0b\x2f\x4c\x49\x70\x46\x03\x53\x5e\x75\x93\x4d\x7c\x6b\x6d\xe9\x74\xae\xe0\xde\x47\x0c\x43\xff\x9d\x66\xd8\x83\xde\x5a\xf7\x48\x04\xde\xda\xa5\x9b\x72\x55\xe7\xbb\x8c\x9f\x9b\x2a\x9d\xa8\x4d\xcb\x4c\x7e\xe5\xe2\x8a\x9b\x8a\xde\xb0\x4b\x96\x63\x46\xf8\x73\x36\xe6\x09\xc3\xdb\xcf\xc1\xe0\x1b\xae\xa7\x83\xc4\xdb\x95\xb0\xec\x4d\xd4\xce\x66\xaf\x11\x73\xa7\x48\x0d\xe4\x5e\x78\xb0\xaa\xcc\xbb\x22\x13\x45\x26\x6f\xc8\xde\x9e\x97\x0b\xb4\xc5\x8f\x32\xfe\x5c\xf8\xa4\x03\xf2\x64\xb3\x92\x00\xec\x40\x0b\xce\xff\x6a\xe4\x3c\x8e\x7b\xea\x27\xb5\x8a\xad\xc0\xa5\xbd\x9b\x83\xd6\xde\x0d\xbc\xb6\x75\xec\x0c\xd5\x52\x0d\x99\x14\x64\x6f\x2f\x19\xaa\xe4\x6a\xb5\x6d\x73\x1e\x25\x71\x41\x86\x83\x7f\xc5\xde\x19\x23\xd1\x56\x2d\xa7\x40\xb8\x20\x83\xe7\xa4\x18\xef\xed\x25\xdb\x8f\x74\xea\x69\xf2\xc8\x26\x9f\x24\x7b\x8f\xbc\x2b\x8c\xa1\x17\x9a\x3d\x05\x4f\xbe\x9b\x86\x06\x62\x1a\xf1\x9c\x14\x96\xd1\xc2\xbb\x84\xfc\x96\x45\xd1\xd7\x2c\xe6\x68\xbd\xf1\x3b\xd9\x06\x92\x02\x4b\x52\x1b\x7f\xcb\x8c\x25\xbf\xcb\xb8\xc0\x7f\x86\xb9\xf8\xd6\x1c\x59\x49\x13\x2e\xea\xf4\x5d\xa3\xfa\x5a\x90\x6b\x0f\xff\xfd\x59\x1d\x0b\x57\xea\x28\xa8\x36\x88\x0e\x6a\xf2\x8f\xde\x0c\x58\xe3\x67\x8f\xa4\x85\x25\x63\xb7\x15\x37\x7a\x11\x61\x4b\xb8\x41\x3f\xd4\x3d\xe4\x6f\x3f\xd1\xe7\x4c\x26\x62\x6d\x71\xa8\x0e\x30\xb8\x03\xa4\xab\xd5\x1f\xda\x59\x89\x93\x8a\xa5\x76\xa6\x1e\x94\xa3\x07\xe5\x8a\xfc\x5e\x3a\x9b\x54\x4a\x7e\x53\xab\x68\x04\x9a\x3e\x4c\xc4\x36\x88\xb6\x53\xf6\xf9\xc9\x10\xa1\xd9\x34\xfe\x4b\xc6\x70\xfa\x08\xfc\xb5\x72\x5e\x47\x35\x96\xf9\xc3\xf6\x50\x12\x91\x62\xdb\x81\x77\x42\xff\x6a\x18\xf8\x72\x18\xe9\x62\x4e\x53\x40\x25\x3c\x2b\x67\x6c\xf2\x59\x14\x17\x0a\x14\xba\x6e\x3f\xa8\x8a\x2b\x52\x57\xa2\xc4\x82\x14\x29\x66\xe6\x1c\xb6\x44\xc6\xaf\xa5\xa6\x15\xfe\x28\xeb\x67\xff\xee\x23\x7b\xaf\xff\xa9\x4c\x60\x8e\xb6\x9f\xf1\xf1\x76\x12\xca\x55\x7f\x2b\xd5\x25\xdf\x76\x5f\x18\x04\xf3\x01\x22\xcf\x4c\x34\x8a\xc1\xdc\xe0\xda\x0d\x48\x8c\x14\x02\xcc\x41\x83\xd5\x49\xd3\x28\x1a\x0e\x9e\xc5\x94\x2c\xd3\x87\xd3\x14\xd6\x99\x56\x7e\x48\xd3\xca\x05\x51\x0d\x5d\x1a\x64\x92\xaf\x56\xf9\x73\xb5\x60\xea\x05\x08\xb7\x00\x37\x93\xb7\x36\x8b\x2b\xce\xf5\x62\x43\x51\xa4\x37\xac\x1e\x61\xe5\x7f\x43\xe8\x15\xd8\xa8\xd0\xb9\x2a\xb1\x54\xdd\xcf\x94\x4f\x72\x46\x8e\xf2\x58\x88\x70\x13\x51\xdb\xa8\x10\xa1\xb5\x55\xe7\xaf\xff\x1e\x0c\xd3\x34\x8a\x00\x06\xb0\x5a\xc2\xce\xa2\xd5\x8a\x2a\xb0\xdc\x03\x2e\x16\x2c\xd4\x81\x85\xfe\x5b\x60\x09\x70\x6e\x97\x90\x3c\x1d\x8b\x3a\x72\xcd\x53\x8d\x45\x92\xda\xfc\x8f\x05\x19\x24\x71\xa3\x38\x4d\xd1\x96\x22\x67\xa9\xd1\x89\xad\xbf\xe7\x68\x8b\x62\x41\xe8\x96\xc0\x83\xe7\x02\x3c\xe6\x0c\x14\x50\xe3\xe1\xf6\xe0\xb9\x18\x0f\xb7\x07\xc9\xee\x53\x95\xda\x7d\x3a\x48\x86\x03\x48\xaa\x9f\x64\xb8\xa7\x0b\xec\x6d\x0f\x92\x1d\xb6\xf3\x5c\x8c\x77\xd8\x4e\xb2\xbb\x03\xb9\xea\x27\x19\xee\x3d\x1e\xfc\xeb\x53\x19\x8b\x1f\x54\x0a\xa1\x2d\x81\xf9\x33\x61\x9c\xf2\xa8\xf5\xfb\x4c\x28\x58\x7c\x73\x25\x88\x8d\x2b\xe1\x17\x58\x09\x01\xc8\x68\x65\x05\x93\xa5\xe8\x36\x27\x34\x35\x5e\x30\x33\xb8\x61\x08\x32\x58\x65\xfd\xb3\x65\x79\x73\x98\xf1\x97\x4b\xbd\xe3\x0f\x4b\x3c\x78\x4e\x84\x06\x21\x75\x25\x5e\xb2\x9c\xde\x1c\x96\x38\x07\xd8\x6d\xc5\xf5\x99\x40\x5b\xb1\x2a\x1a\x5e\x50\x14\x09\x44\xf2\x67\x84\x8e\x07\x09\x7d\x28\xb6\x72\x3b\x52\xbb\x60\xbf\x67\xc4\xc1\x90\x37\x5d\x3a\xf6\x10\x60\x51\x8d\x0b\x6b\x27\x8b\x47\x5f\xd5\x4e\xa9\x75\x7b\xe0\xee\xcf\xdf\xa2\xd9\x24\xd1\xa1\xe2\xa4\x1f\x10\xf5\xdf\x3b\x56\xa4\x7f\xac\xc8\xc6\xb1\xc2\x5b\x8e\x15\xe1\x1f\x2b\x0f\x8a\x7b\x1d\x2b\xdc\x3f\x56\x44\xed\x58\xe1\xee\x58\x91\xe6\x58\xa9\x8e\x56\x9f\x95\x94\xd6\x75\xaa\x1f\x0f\x15\xc1\xf6\x1f\x9c\x30\xb2\x3a\x5c\xd4\x24\xeb\x46\xdb\x27\xe5\xf7\x22\xf6\x85\x64\x37\xa9\xd5\x31\xba\x49\x47\x37\xa9\x53\x84\xa9\xf3\xa8\x80\x8c\xf8\x47\xc4\x10\x47\xef\x0b\xe8\xaf\x20\xac\x2e\x77\x1e\xb5\xf1\x63\xa0\xf1\x6c\x00\x3e\x04\x85\x24\x4b\x4d\x83\xde\x8b\x09\xc0\x16\x2b\x78\x22\xef\x9e\xe8\x55\xf5\x6b\xb3\xaa\x88\x6c\x6d\x63\x55\xe3\xe7\xf2\xdf\xaa\xf2\x0f\x77\x17\x0c\xe0\xec\x34\x7f\x5a\x01\x3b\x70\xd7\x83\x60\xa3\xa9\xd9\xdc\x1a\x3a\x9a\x39\xdc\x84\x5b\x43\xfc\x2e\xf7\x95\xb8\x65\x8a\x8c\x07\xb2\xb4\xa6\xa0\xc3\x47\x4e\x29\xd4\x0a\xa9\x8b\xc0\x04\x40\xdd\xa4\x80\x4f\x06\x37\xb3\xfd\xca\xd5\x7a\xe9\x85\xa3\xf4\x95\x5d\xc1\x4e\x20\x50\xc7\x69\xaa\xfa\x87\xca\x59\x57\x3c\x2e\x6a\xca\x59\xea\x93\x40\x49\xd1\xc8\xc2\x87\x7b\x89\x56\xb3\x0f\x5e\x0e\x92\x5f\x64\x5c\x20\x5f\x69\x86\xa5\x84\x61\x99\x82\x9f\x29\x67\x27\x61\xb8\x80\x29\x91\x6a\xdf\xfc\x5a\x62\x61\x96\x5b\x9e\x12\x9a\xfa\x97\x9e\xcc\xbc\x28\x15\xcd\x9a\xa6\x41\x94\x21\xb3\x29\x6f\xad\xec\x5f\x2d\x05\xbb\x41\xc3\x60\xf1\xef\x4a\x54\xf9\x9b\xaa\xc7\x05\x6f\x99\x01\x13\xf9\xb7\x82\x6b\x5c\x04\x01\x79\xb5\x27\x28\x50\xa0\xd3\x21\xfc\xdb\xc3\xcd\x3b\xe2\xd1\x19\x9f\xba\x69\xb7\xe8\xb3\x48\xc9\x1f\x30\x7c\xa9\x80\x04\x64\x37\x4b\xec\x45\x05\xd3\xea\x03\x9c\x13\x99\xe2\x8c\x98\xfb\x2c\x4f\x71\x1e\xf8\xc9\x1c\xec\x3e\xc5\x79\xa0\xb5\x96\x37\x14\xd6\xec\x09\x16\x45\x0d\xef\xfc\x59\x2b\xcb\x33\x03\xfe\xb2\xf5\xf5\x92\x8d\x1c\x1f\x2f\x37\x4c\xbc\x5b\xed\x73\xc0\xb3\x75\x1a\xa5\xe3\x38\x0f\xd8\xe3\x69\x68\x7c\x56\x53\xd3\x4b\x1b\x0e\xef\x6b\x5c\x94\x7a\x68\x70\x94\xd4\xea\x37\x02\x9a\xa6\xf6\x1f\x5a\x6b\x37\x07\x0d\x23\x58\x3c\xad\x62\x95\x4f\xd4\xa0\x26\x04\x44\x2f\x5a\xb3\x02\xb2\x17\x64\xba\x31\x3e\xf3\x02\x4d\xac\x10\x7a\xe1\x09\x5e\xaa\xbb\xee\x99\xf7\xb1\x96\xf0\x4c\x2a\x11\xd4\x99\x67\xb9\x1c\x77\x75\xce\x5d\x26\xbc\xdd\x25\x02\x0a\x79\xa2\x3b\x36\x23\xd3\x36\xb9\x04\x21\x64\xa6\x0b\xcc\x2d\x63\x79\x34\x07\x7e\x72\x89\x70\xf0\x05\x99\x6b\xfd\x85\x99\x79\x5b\x4d\xea\xd4\x4e\x6a\x36\x8d\xa7\x35\x93\xb1\x3c\x50\xb8\xdb\xde\x7b\x3a\xc4\x43\xa7\x69\xe1\xab\x28\x54\x8a\xbe\xb9\x0e\x01\xf5\xa4\x82\xcb\x39\x11\xdc\xa3\xab\x8c\x6d\xf0\x39\x94\xdb\xc6\x94\xc7\x39\x3e\x47\xeb\xc6\x0a\xa8\x73\x5f\x41\xd3\xc6\x68\xf7\xe7\xc6\x1d\xf9\x25\x11\xfd\x45\xc6\xcf\xf7\x69\x3a\xf3\x81\x72\x39\x8e\x83\x57\x00\x9c\x57\x25\xce\x2c\x94\xf0\x25\xb0\x0b\x4b\x9c\x21\x1b\x79\x56\x11\xee\x19\xb9\x04\xc6\x62\x89\x8c\x63\xe2\x66\x69\xdc\xcd\x40\x24\x93\x2b\x88\x01\x30\x73\xcd\xf0\xbb\x21\x53\x9f\xf2\x12\xb8\xc4\x39\x1a\x95\x5a\x56\x73\x83\x6f\xd0\x3a\x80\xee\xee\x60\xef\x31\x9e\x36\x98\x87\x6e\xac\x9e\xde\x4d\x10\x61\x7d\x8a\x46\x19\xd1\x84\x43\xbc\x1f\xe7\xda\xe0\x67\xb5\xea\xbd\xe8\xbc\x67\x34\x95\x1d\x27\x0b\xe8\xa1\x87\xbd\x4e\x69\x2f\x32\x1d\xa8\xa4\xe3\xcc\xdb\x70\xe7\x6c\x29\x3b\x5c\x74\xec\xb2\xec\x7c\x7a\xdd\xb9\xa2\x65\xa7\x5c\xb0\x34\x9b\x66\x6c\xd2\xff\x9b\xff\xcd\x5f\x4c\x26\x1d\xda\x79\xf6\xc1\x44\x33\x75\xa5\x49\xbf\xdf\x7f\x5e\xb5\xd5\x99\x65\xe7\x33\x56\x74\x32\xde\x91\x33\xd6\x91\x05\x63\x1d\x29\x3a\x8b\x42\x5c\x66\x13\xd6\xa1\x9d\x5c\x50\x85\x46\x3b\x19\x9f\x64\x29\x95\xa2\xe8\x88\xa2\xb3\xc8\x69\xca\x66\x22\x9f\xb0\x42\x95\x36\x92\xd5\x7e\xef\xe1\x3f\x0a\xba\x6b\x30\xb0\xfe\xc5\xd8\xac\xff\x54\x22\x9c\x91\xcb\x02\x62\x78\xda\x9d\x6c\x4e\xcc\xa9\x77\x62\xee\x24\x25\xc9\xf0\xbd\x20\x9d\xf3\x78\x8a\x5f\x14\xf1\x14\x54\x26\x50\xa8\xfc\x35\x4c\x6c\xd4\xa4\x53\x85\x20\x6e\x16\x0c\x5f\x93\x69\xa8\xb3\xa2\xf6\xcf\xe3\xdd\xc8\x6b\x4c\xad\x9a\x16\x84\x7a\xba\x51\xca\xe5\x54\x0b\xaf\x5b\x31\xf1\x75\xbb\xf0\xc9\xca\x93\x56\xab\xee\x0c\x1c\xbd\xc4\xd7\x08\xac\xc7\xef\x3d\xec\x97\x8d\x61\xaf\xef\x58\x71\x6b\x75\x48\x89\x58\xa6\x9e\x52\xb9\x24\xac\x12\x0d\x9b\x0b\x46\x15\x6b\xb4\x70\x9a\xeb\x15\xb9\x6c\x39\xaa\x5f\x83\x43\xda\x8a\x8a\xc7\xfb\xa5\xcf\x39\xfd\xcb\x12\x6b\xcf\xd4\x25\x6c\xfb\x19\x8b\xa2\x98\xa6\x84\x21\x4f\x8b\x9a\x3d\xcb\xdd\xbb\x5c\x11\x1c\x59\x4a\x7c\x15\xd6\x5f\xb4\x82\xf4\xf3\x52\x5d\xcc\xcb\x34\x88\xed\xa6\xc8\xfd\x5b\x9f\xcf\x2e\xd3\x11\x92\x29\x91\x7a\x98\x55\xc8\x43\xd1\x28\x17\x45\xdd\xbf\xb2\x18\xb5\x16\x97\xa2\xba\xf1\xbc\x2e\xe3\x20\xe4\x2e\xe6\xa9\x67\x38\x59\x37\xf4\x0e\x3c\x15\x73\x67\xcc\xa1\x0e\x7d\x01\x1a\xab\x5f\xca\xc0\x88\x0d\x4b\x2f\x62\x22\xb4\x2a\x53\xc2\xec\xf9\x26\x15\x09\x51\x9d\xce\x20\xb9\xaf\x88\x0a\x40\xfc\x83\xdd\xa7\x91\x4c\x03\x4d\x61\xa0\x31\xe6\x45\xac\xa8\x12\x70\xf6\x30\x34\x97\x2a\x08\x00\x9b\xb6\x49\x07\x2a\x7d\x4d\x4e\x06\xb8\x70\xa5\x7c\xf1\x85\xa6\x6b\x8a\x86\xf1\x3b\x69\x15\x56\x8c\xc4\x73\x2d\x3c\x17\x08\x53\x93\xa4\x08\x2b\x42\xd8\xea\x01\xb4\x77\x45\xfb\x4f\x77\xe2\x7a\xc7\xdf\xe7\x95\x7f\x06\x3b\x6e\x16\x6e\x59\xa7\xae\xe0\x11\x52\x40\xd7\x07\x8e\x3d\x52\xff\xd1\xa3\xed\x43\x9f\x0a\x4e\xb3\x22\xc8\xdd\x14\xcc\xb7\x5e\x69\x60\x6e\x10\x54\x8d\xf0\xf0\x99\x3f\x5d\xed\x4d\x8d\x37\x37\x94\xd4\x87\x53\x6f\x0c\xa1\x75\xcd\x85\x75\x2c\xc9\x79\xa1\x16\x78\x25\x2d\x09\xfc\x3c\x0c\x76\x9f\x60\x1f\xbe\xf1\x5d\x91\x88\xf5\x35\xab\x46\xbc\xfa\x61\x07\x62\x18\xb1\xf5\xc9\x52\xcd\x9f\x5a\xd7\x21\x46\x92\xd5\x46\x0a\x1c\x9b\xfc\x62\xec\x7f\xbd\x30\x9b\xc2\xe7\x40\xd4\x5c\xfc\x7a\x12\x9e\x36\xf7\x0d\xf2\x39\x1b\x4b\x1f\x27\x09\xaf\x32\x10\x90\x9a\x0a\x3e\xcb\x78\x6f\x0f\xd3\xba\xee\x46\xbd\x2f\x54\x33\x04\x6e\x27\xe2\x36\x13\x31\x0a\x87\x74\x9e\x22\x2b\xcd\xfe\x36\xef\xc3\x5e\x49\xfd\x7b\x2c\xf0\x2e\xdb\x2f\xb1\xbe\x65\x41\xdd\x13\xca\xf7\x5d\x86\x81\x15\xea\x2e\x74\x75\x85\xb8\x27\x10\x10\xf7\xbf\x20\x40\xc2\x9b\x38\xad\x03\xe3\xb4\xb5\x10\xb1\x0e\xfa\xdd\x14\xf0\x11\x8a\xc5\xb3\x36\x36\xee\xd8\x94\x0d\x32\xdb\x0a\x6e\x12\xf8\x91\x41\x02\x35\x37\x6b\xa9\x56\x7e\x58\x91\xd8\x1a\xa2\xaa\x37\x15\x8f\xd6\x21\x04\x8f\x6d\x3b\xf0\x4a\x7a\x5c\xb2\x0a\x77\x54\x79\xaa\xac\x65\x7b\xc5\x6a\x77\x98\xdb\x32\x4f\xd5\x9b\xe1\x33\xcf\xfc\x63\x6c\x6f\xb9\x41\x68\x71\xbe\x31\xb4\x38\x25\x3c\x40\x48\x09\x25\x3c\xa9\x65\x3a\xbf\x33\x56\x16\x60\x18\x3d\x3f\x96\x8d\x33\xea\x22\x27\x67\xb9\x61\x99\x7e\x61\x5a\x10\xf8\x27\x03\x47\xa2\xe0\x5b\x0b\x34\x4c\x33\xc1\xc1\xd9\x42\x2f\xe3\x9d\x0c\xe9\x4b\xe7\x6d\xa9\x72\x92\xac\x1f\x16\xc1\x8c\x4f\xfc\xcc\x03\x3e\x59\x6b\x91\x39\x4b\x6e\x4b\x12\x97\x24\xab\x79\xbb\x8a\xa2\xd2\x69\x85\x66\xec\x6a\xb5\xba\xca\xf8\x44\x5c\x59\xaf\x79\x60\x66\x6d\x6b\x53\x65\xfd\x67\xdd\xdf\x14\xfc\xfa\x90\xb4\x5f\x50\x7e\xce\xf6\xc5\x92\x4b\x74\x5b\x92\xb4\x4f\x79\x3a\x13\x05\x10\x83\xc6\xb1\x9e\xc9\x3a\x9a\x4e\x4b\x26\xf1\x94\xa4\xda\xbc\x12\x8a\xa4\xf6\x49\xbf\x05\x0e\x56\xa5\x11\x8f\xa7\x2e\xe9\xd9\xe9\x87\x36\x49\x3a\xd2\xe4\x00\x2f\xc8\xd6\x10\x9f\xa9\x3f\x33\x32\xc0\x73\x32\xc0\xe7\x24\xc3\x97\xba\xb0\x74\x36\x11\xf6\x5c\xbe\x19\x9d\xeb\xe8\x92\x6a\x14\x4b\x1d\xe7\xfb\xdc\x0b\xe6\x1e\x2f\xc8\xe4\xe1\x12\x61\x55\x6a\xaa\x4b\xa5\x2d\xa5\xce\xc8\xe4\x61\x8a\xb0\xba\x30\x57\xf9\x51\x14\x4f\x1e\x9a\x67\xd0\x74\x34\x4a\xcf\x95\x7d\xcd\x0d\x39\xf7\x5d\x81\x8e\xd0\x25\x39\xc7\xe7\xe4\x66\xe4\x99\x6e\x9c\x83\xdd\x86\x1e\x26\x30\x3a\x2e\x09\xf8\x8a\x7d\xf8\x70\x46\xa0\xcb\xaa\x8b\x08\xab\xdc\xa9\xca\x9d\x13\xe8\xa2\xea\x52\xd8\x50\x10\x98\x5d\x73\xa8\xce\xc9\x25\xbe\x24\xe7\x9e\xbd\xc1\xfa\x9c\xdc\xac\x4b\xb2\xa5\x68\x9b\xc5\x6a\x05\xbf\x67\xc6\xe9\x8d\x5e\x75\x0b\x58\x66\x67\x46\xe3\xdf\x84\xce\x2e\x15\x08\x4d\x81\x01\x14\x18\x04\x05\x46\x07\x39\xb9\xa5\xa9\xcc\x2e\xad\x4b\xd1\x97\x4c\xaa\xbb\xa7\x8e\x1e\x8e\x61\xee\xd9\x44\xbd\x4b\x32\xec\x56\xf0\x7b\xb5\xa8\x92\x72\x8d\xcf\x72\xd2\x1d\xe2\x49\xaa\x6f\x36\x6a\x75\xe4\xc2\x37\xe2\xac\x70\xf8\xa4\xce\x3e\xde\xd9\x19\x20\x34\x5a\x8a\x78\x92\x62\x86\x54\x1d\x93\xd4\xdb\xd6\xeb\xf0\xa4\x99\xa4\x68\xe4\x37\xa3\x26\x22\x23\x0c\x97\x95\x03\xf9\x49\x6a\xa8\xb6\x53\x55\x53\xe5\x6c\x24\x9b\xc6\xc3\xc7\xd1\x69\x14\xfd\x14\x4f\x52\xdf\x3c\xb4\x87\xf0\x70\xfb\x69\x74\xaa\xbf\xba\x56\x5f\x05\xc4\xa7\xbd\xe4\xe8\xf7\x57\xe4\x1a\x7c\x3b\x98\xdc\xab\xf6\x4b\xd3\xd5\xf8\x4a\xfb\x74\x48\xae\x02\xa4\x82\xd6\x56\xbb\x7d\x38\xd8\x81\x36\xb5\x05\x42\xf2\xa1\x88\x27\xa9\x1a\x7e\xc0\xb4\x08\x74\xb7\x1e\x6f\x2a\x84\xdf\xa8\x7c\x8f\x5a\x57\x50\x0a\x78\x9c\xdb\xbb\x49\xed\xa3\xe1\x60\xfb\x51\xad\xcc\xd3\xb6\x32\xdf\xaa\x7b\x37\xf9\x46\x81\xa7\xc9\x92\x4c\x52\xfc\x5e\x5d\x7e\x97\xb8\x44\x78\xbf\x88\x97\x68\xdd\x9c\xe7\xff\x99\xc5\x92\x4d\xe3\x2b\x72\x90\xe3\x6b\x40\xe1\xf8\x94\x5c\xf5\xbd\xc5\x8c\x4b\x72\xd5\x0f\x97\x33\xbe\xee\x12\x72\x1a\xa9\x85\x72\x1a\xa2\xe3\x28\xfa\xca\xe2\x5a\x5e\x7f\x62\x12\x66\xdb\xe0\x53\xe4\xc2\x37\x94\x51\xf4\x27\x8b\x4f\x15\x8d\x7e\x4d\xca\x3e\xec\x3e\x7c\x45\xca\x3e\xe3\x13\xec\xd8\x36\x6a\x01\x5d\x91\x6b\xe7\x1b\xd8\x3f\x50\x4e\xc7\xf1\x69\xed\x10\x21\xd7\xf8\x34\x38\x42\xc8\x21\x95\xb3\xfe\x3c\xe3\xf1\x15\x3e\xd5\x3a\x95\x16\x8f\xa1\x24\xbe\x22\xf1\x35\xa9\xf5\x79\xb5\x9a\x54\x07\xcc\x75\xdb\x01\x83\xaf\x6a\x47\x4b\x7c\x45\xae\x6a\xa7\x0b\x5e\x92\x53\xdf\xcf\xb5\x69\x14\x67\x55\x87\xec\x98\x97\x08\x97\xc4\x0d\x18\xc6\x3f\xce\x12\xaf\x98\x02\xc8\x12\xe1\xee\x55\x9f\x5d\x4b\xc6\x27\x51\x94\x3d\x57\x54\xf1\x92\x94\xb8\x24\x19\xce\xc8\x52\xb5\xf7\x89\xc5\xa7\x38\x43\x78\xaa\x53\x25\xc2\xcb\x28\x9a\x46\x51\xac\x6e\x78\x57\xde\x11\xb7\x5a\x5d\x79\x07\x9c\x3a\x39\x00\xc9\x57\xd9\xfa\x18\x83\x17\x02\x92\xea\x95\x3b\xee\xc0\x60\xcd\x7e\xe0\x1d\x7b\x90\xaf\xcb\xeb\x49\xbd\x36\xbe\x30\x60\xe1\xc4\x08\x5f\xf7\x4b\x26\x61\x9a\x62\xdd\x22\xb6\xf5\x23\x7c\x65\xec\xc0\x5e\xe4\x39\x14\x2f\x63\x84\xb3\xe7\xe5\x38\xbe\xea\xd3\xc9\x44\xd7\x70\xad\x8a\x69\x10\xc4\xba\x07\xd8\x35\x88\x92\x18\xaa\x3f\x68\x79\x87\x83\x3a\xe0\x1f\xbe\x26\xc7\x27\x70\x4e\x5d\x91\xd3\x91\x9a\xbe\xea\x10\x19\x21\x75\x6c\x5c\x79\x07\xe1\x75\x7f\xb1\x2c\x67\xb1\xd3\xac\xbe\xc2\x39\x9b\xca\xe4\xaa\x5f\xa6\x85\xc8\xf3\x37\x6c\x2a\xb1\x14\x0b\x97\xf1\x51\x2c\xd6\x08\x6a\x6f\x65\x18\x01\xcc\xd4\xfe\x31\x0e\x1a\xf0\x29\x19\x8c\x4e\x9f\x5d\x5b\xab\xa2\xd3\x87\x0f\xd1\x15\xb9\x3e\x3e\x3d\x51\xe3\xd5\x6d\x7a\x4d\x91\xab\xbe\x6a\xbe\xf1\xee\xa3\x58\x90\xab\xbe\x14\x8b\xb5\x3a\x6f\xba\x17\x39\x3e\xc8\xc9\x45\xee\xc8\x74\x8b\x67\x71\xfd\x84\x38\xad\x42\x7e\xb9\xd3\xe1\xa2\x71\x3a\xec\x3c\x8e\x2e\xa2\xe8\xa8\x88\x4f\x71\x1d\xa1\xc1\x01\x71\x81\x6e\xaf\xcd\x32\x06\x9a\xe9\x40\xd5\x10\xba\xf9\x39\xd0\x75\x1f\x11\xff\x84\xb1\xb2\xb1\x49\x1a\xd8\x6c\x5e\x93\xa3\x9a\x38\xfb\x9a\x1c\xad\xdb\xfc\x3a\x8c\x0f\xe2\x6b\x94\x1c\xb8\x01\x5e\xaf\xff\xd7\xd0\xe7\xc4\x50\xe7\xbf\x64\x5a\x82\x6c\x3c\xba\x79\xc0\x56\xa3\x9f\xa7\x68\x9e\x2a\x0a\xe0\x3c\x25\x0c\x5f\xa6\x44\x56\xaa\xa0\x30\x17\x1e\xf0\x65\xd8\x26\x0e\x9e\x74\x63\x93\x94\x6c\x56\x81\x1c\x18\xd6\xd2\x2c\x35\x62\xad\x9a\x6e\x9a\xba\x5b\x5c\xa7\xe3\xd3\xf4\xe1\xc3\x24\xb6\x1a\xc4\x0c\x25\x90\x6c\x81\xee\x45\x1a\x45\x17\x69\xec\xbb\x09\x10\x56\xee\xbd\xb0\x00\x5c\xc0\xe8\x18\x39\x4b\xf1\x99\x95\x32\x8f\x2a\x3d\x74\xab\x58\xac\x09\xb1\xf8\x57\xeb\xe6\xd3\xe3\xad\xe5\x75\x56\x9c\x5b\x88\x2c\x5c\x88\xe0\x49\xf5\xd1\xe3\x88\xa1\x28\xba\x6a\x1e\xad\xc0\xfe\x7a\x34\xdc\x8e\x18\x5a\xad\xe6\xe9\x6a\x15\xcf\xc1\x9e\xe1\x77\x19\xef\x3d\xc1\x4d\x2b\x02\x50\x33\x00\x1a\x10\xb5\x4d\xb8\xeb\x9f\x2a\xa7\x96\xce\x9e\x22\xa1\x2f\x9d\x58\x7d\xef\xc9\xb3\xcb\x74\xbc\xf7\x24\xb9\x4c\x9d\xb5\x58\xaa\x2e\xb9\x9f\x65\xcc\x70\x29\x7c\x11\x75\x29\x62\x7f\xf5\x9d\xa7\xe6\xba\x6e\xe2\xcc\x33\x72\x0e\x7a\x27\xe7\x06\x80\xdf\xe4\x16\xec\x0c\x91\x75\x1a\xf7\x40\xeb\x3d\x98\x8b\x1a\xf3\xd4\x0b\xbc\xab\x9d\x1f\x8d\x4c\xed\x7c\xc3\x68\x30\x5a\x47\x00\xb4\xc0\xb7\xc0\x77\x39\x22\xba\x28\xe2\x47\x98\x23\x7c\xa0\x7f\xd7\x95\x9e\x85\x67\x31\xb4\x69\xbb\x81\x1e\x06\x37\x57\x73\xb3\xee\x59\x63\xd9\x33\xe2\x14\x8e\x1f\x94\x44\x82\x7e\x02\xee\x0e\xbc\x08\xaf\xc2\x1a\x27\x48\x72\x59\xc4\x1c\x43\x80\x21\x63\x37\x53\x6d\x03\x84\x29\x0f\xd4\x48\xac\x8a\xbc\x57\x04\x45\x91\xd6\xb2\xa8\x82\xb4\x0a\xa7\x6c\x59\xa9\x77\x43\x8b\x60\xca\xe3\xf6\xb3\x65\xdf\x34\x55\x01\xcc\x97\x16\x9e\x22\xe6\xfa\xd3\x4a\xa3\xab\x7a\x6b\x85\xd6\x81\x48\xa2\xd5\x51\xcb\x9d\x76\x36\xad\x61\xd3\x8b\xfb\xca\x1d\x0a\x84\xd0\x2d\x53\xa0\x04\xbd\x10\x46\x5e\x16\xd0\xe7\x1a\x28\x39\x06\xfd\xe3\x4f\xea\xa5\xff\xaa\x52\x3c\xfe\x52\xc4\x95\x66\xa0\xa7\x48\xe0\x05\x66\x14\x75\xe7\x07\x95\xe4\xaf\x12\xd9\x17\xfd\x09\xcb\x99\x64\xb1\x44\x18\x14\xcd\x59\x14\xf1\x14\x62\x46\x19\x45\xf3\xd5\x0a\x12\xbf\x95\x51\xd4\xd0\x7a\x04\xd5\xac\x65\xfa\x6c\x9a\x8e\xb5\x52\x5e\x8a\x92\x54\x21\x87\xe4\x48\x2b\x5c\x45\x91\x56\xce\x93\x4d\x0d\xbf\x28\x92\xcf\xf8\x6a\xd5\xe4\xec\x70\x8d\x0a\x7d\x83\xb3\x85\xf0\xb5\x5b\xea\xe6\x63\x56\x4d\xbf\x1a\x89\x24\x03\x87\xb5\x8d\xe6\xae\x24\x3f\x03\xd0\x35\x96\x6c\x2c\x54\xd9\xb2\x3e\xcf\x84\x39\xdc\x7a\x4b\x3e\x61\xd3\x8c\xb3\x89\x47\x72\x9c\xbe\x3f\x78\xb1\xff\xf1\xf4\xe5\xc1\xef\x1f\x8f\x8e\xde\x7c\x38\xfd\xe9\xcd\xd1\x8f\x2f\xde\x9c\xfe\x7c\x74\xf4\xeb\xe9\x69\x88\x87\x24\xb9\xbb\xb4\x36\xba\xce\xca\x97\x59\x49\xcf\x72\x36\x59\xad\xba\xb2\x5f\x2e\x17\x0b\x51\xc8\xf2\x55\x76\xc6\xac\x56\x75\x77\x30\xaa\xd0\x8c\xec\x67\xfc\x1f\x96\xca\x98\xa1\xd1\x45\x5a\xd9\x55\x31\xeb\x7f\x50\xf0\x7d\x31\x9f\x67\x12\x6a\x78\x2f\x84\x84\xa5\x66\x44\xc2\x8f\x77\x89\x71\xbe\x6c\xf1\x9a\x87\xa7\xaa\xa3\x7d\xbd\xc6\x07\xdf\xac\xdb\xfa\x2a\x54\xcb\xd6\xff\xd4\x4b\xdb\xfe\x7b\xb1\xd0\x84\x0d\xd5\x89\x6e\xc1\x60\x4b\xd2\x73\xc2\x30\x24\x2f\xd8\x0d\xe1\x3a\xe9\x5c\xa7\x82\x4d\x17\x38\x32\x82\xa4\x0d\xbd\x06\x65\x9c\xd1\xa0\xae\xe8\x66\x61\x52\xbe\x8b\x35\x2d\x04\x52\xb9\x19\x9f\xb0\x6b\x32\xc0\xa6\x9e\xa9\xf7\x2a\x70\x55\x24\x75\x5e\xe0\x08\x09\x72\x42\x25\x0a\xc8\xf2\x55\x07\x82\x32\x9e\x83\x23\x9d\xaf\xba\x59\xe8\x74\xe5\xae\xd3\x74\xc6\x97\x2f\xa8\xe7\x40\x00\xa1\x32\xea\x08\xbc\x82\x4a\x5d\xc1\x0d\xea\x6f\x44\x83\x55\xb9\xa1\x13\x27\x2f\xae\x9a\x37\x21\x96\xeb\xcd\xae\xfc\x79\xf2\x9c\xb0\x8a\xca\x03\x53\x37\xee\x82\x73\x93\x45\x21\xa4\x30\xe2\xf5\x2e\xeb\x67\x25\x08\xd8\xf7\x2d\x62\xf4\xbe\xbe\x74\x1b\xab\x89\x4a\x9d\x1b\x60\x68\x62\x3c\x4c\x06\x81\xdf\x2e\xe6\x7b\x8b\xcc\xa6\x31\xb4\xfc\xe0\x81\xfe\x18\x11\x42\xf6\xa9\x33\x99\x1b\x8e\x8c\x05\xee\x61\x95\xb7\x6b\xcf\xbb\x6d\xcf\xf1\x68\x0d\xbb\x54\xac\x18\x8f\xf9\xaf\x63\x56\x72\xa2\xa0\x04\x6e\x64\xd4\x79\x7a\xc1\x6e\x30\xd3\xfa\x20\x38\x74\xe8\xc7\xfc\x27\xac\xcf\x14\x63\xc2\x89\xb9\x1f\x15\x27\xf0\x15\x55\x4d\x0d\x0b\xbd\x6d\xa1\x24\xe6\xf5\xc5\xc9\x83\xf5\xc3\x1b\x6b\x83\x37\xbd\x73\xf1\xba\x84\x09\x6d\x08\xde\xdc\x2e\xe1\x69\xd8\x6e\x35\x1c\x74\xf3\x9a\x83\x6e\xfe\x0d\x07\xdd\xfc\x1b\x0e\xba\xf9\x1d\x0e\xba\x65\xdd\x41\x37\x6f\x75\xd0\x2d\xdb\x1d\x74\xd7\xfd\x39\x87\x0e\xba\xe5\x46\x07\xdd\xd2\x77\xd0\xed\xb9\x39\xf6\xe2\x46\x1a\x04\xc3\xf4\x2f\x84\x89\x9c\x6a\xf7\xac\xbe\x61\xe9\xa9\xdd\x57\xe0\xb8\xc7\x7a\xbc\xd8\xd6\x7a\xd2\xad\x4e\x01\x18\x82\x3d\x01\x9a\x36\x9e\x63\xfd\x46\xd0\x18\x86\x32\xf2\xc8\xb2\xf9\x43\xcf\x16\x9d\x6b\x6a\x6d\xbf\xaf\x85\xb5\x5f\x2b\x18\x57\x5d\x70\x0e\x48\xde\xd1\x24\x23\x4f\x31\x5d\x91\x27\x3e\x5b\xed\xca\x65\x07\xfe\xcb\x2e\x68\xe5\x65\x71\x2e\xe2\xe1\x36\xe6\x58\xe2\xa7\x2b\x8a\x70\xb0\x09\xc8\x05\xc5\x7a\x03\xe8\x54\xc3\x15\xbd\xd1\x24\xf9\xa7\x56\xdf\x0e\xd4\x07\xb5\xc1\xc7\xff\xd0\x5a\xbd\xff\xdc\x55\xdb\x87\x5a\x6d\x7b\x55\x6d\x7e\x1d\x1f\x36\xd4\x61\xef\xc9\x0a\xcc\x75\x65\x40\x1f\x15\x39\x77\xd4\x0e\x15\x69\x70\x1f\x28\x98\x0d\x07\xa1\xae\xcc\x91\xca\xdc\x0b\xf3\xf6\xa1\x60\xcd\xa3\xd2\x21\x64\xee\x86\x99\x1f\x21\xf3\xb1\xb5\x92\x0c\xde\xbd\x57\xef\xb6\xb7\x2b\x8f\x75\xa1\xc0\x71\x67\x60\x34\x25\xd8\x98\x25\x76\x14\xb8\xd7\xab\x0c\x67\xa5\x02\x52\x66\x61\x14\xfa\xce\x65\x58\xbb\x8d\x21\xa2\xe9\x0c\x3d\x50\xaf\xb8\x6e\x1e\x24\x00\xfc\x27\x98\xe1\x02\x83\xd4\xbe\x11\xdd\xc4\x13\x1b\x5f\x39\xc2\xd5\xff\xf8\xb1\x21\xdd\xbe\xfd\xfd\x45\xfd\x7b\x18\xd4\xae\xf3\x01\xed\x16\xfd\xb8\x4a\x26\xc7\x27\x06\x9d\xcb\x16\x57\xef\x3c\x88\xf4\x72\x1b\x78\x14\x49\xea\x0e\x92\x6c\x5c\x4e\x5b\x33\x74\x3a\x9b\x2f\x34\x24\x75\x5c\x70\xd6\x0f\x33\xd6\x3e\xf8\x0e\xaa\x3b\x97\x25\x8b\x0c\x25\x12\xea\xb3\x68\x23\x77\xaf\x69\x4b\x3d\x55\x6a\x7c\x3e\x4d\x63\x3b\xe4\x7d\xbd\x51\x6c\x1d\xbc\xad\x04\xdd\xba\x3f\x75\xdd\xed\xa0\x11\x13\x8d\xd4\x75\xce\x86\x26\xd5\xa5\xac\x6f\x16\x43\xdb\x35\x25\xdf\x41\xb6\x2f\xe1\xae\xc8\x23\x4f\xae\xeb\x32\xbd\xdb\x83\x23\x93\x9a\xf2\x68\x57\x3c\x94\x3a\x57\x94\xd6\xa6\x7c\x5f\x5c\xee\x51\xb0\x47\x35\xba\xa1\x59\x87\xaf\x32\xd1\xb4\x93\x1a\x98\x8b\xcb\x73\xad\x9c\x45\xbc\x35\xf0\xee\x9b\x55\xe3\xa2\xad\xce\x11\x7f\x26\x37\x49\xd7\x25\xc2\x71\xf1\x5c\xae\x56\x03\x50\x6c\x70\x92\xf2\x46\x29\x79\x6f\x09\xbc\xbc\xbf\x04\xde\xf3\x5a\x6a\xc6\x26\x9f\x37\x79\x6d\x55\xdf\x7d\x98\x4b\x5f\x85\xa3\x01\xe3\x81\x0d\x4d\xf1\x1c\x02\x1d\xff\xc7\x4a\x0b\xf2\x79\xdb\x07\x9b\xc0\xf5\x70\x88\xb0\x1a\x49\x6b\x6d\x77\x18\xc1\x49\xff\x7e\xfb\x4f\x6d\xba\xeb\xa6\x3f\x60\x26\xc7\xad\xed\x7e\x0b\x7c\x7d\xea\xfa\x83\x23\x2c\x74\x85\x94\x38\x77\xee\x38\xd7\x97\xe1\x8c\xa4\x65\xbf\x34\x4a\xaa\xa3\x5c\x5d\x8d\x73\x4c\x71\x86\x46\x0c\xfc\x20\x69\x27\x98\xa1\xdb\x68\xb8\xe9\x8d\x64\xa2\xa8\xee\x07\x31\x47\xda\x0b\xc1\xd0\x3a\xeb\x6c\x28\xb7\x0c\x0c\x2b\xad\x24\xdc\xd3\x3d\x2d\x6b\xba\xa7\x65\xcd\xd1\x1c\xbb\xb6\x2a\xbd\xd2\xf3\xba\xfd\x52\xc6\xfa\x5a\x87\xd0\x6d\xf8\x4d\xcd\xb7\xf5\xa1\xa1\x1e\x0f\x59\x71\xce\xb4\x4b\xc6\xfd\xb0\xd6\xf5\x5a\x55\xd0\xa6\xb7\x59\xa2\x51\x7d\x0c\x43\x6d\xdd\x55\xe3\x22\xa5\xc6\x59\xf7\x48\xf7\x2c\x05\x9f\xa1\xaf\xd4\xf5\x37\xc5\xa5\xa7\x20\xca\x49\xa9\x79\xd7\x9c\xbc\xc8\x6a\x77\x09\x69\x47\x3b\x96\x15\x9e\x4c\x64\x23\xaa\x33\x96\x44\xf0\x38\xc7\x99\x3a\x96\x1a\xee\x68\xd8\x1a\x8b\x4a\xcc\x25\x34\xa5\x2b\xec\x49\xa7\x63\x9e\x39\xc7\x2b\x02\x58\x4b\x54\x9d\x70\xaf\x0a\x1d\xcb\xd6\xbb\xfd\x1d\xda\x3b\x99\xc7\xea\xc4\x5d\xeb\xf0\xc8\xd7\x82\x72\xb4\x4e\xd3\x77\x77\x2d\x2a\x04\x6b\xf8\xe9\xf6\x7c\x73\x9a\x25\xcf\x3d\xf7\x98\x1b\xdc\x5c\xfb\x7e\x9b\xa2\x08\xf8\x80\xc5\x8d\xe7\x95\xc2\x3d\x07\xdb\xe0\xbd\x69\xc0\x34\x84\xa1\x05\xdf\xc1\xb6\x79\xe1\xb9\xf3\x13\x95\x1b\x1e\xdd\x34\x8f\xa2\x2e\x6c\x3d\x7b\x72\x19\x57\x15\xea\x5e\xec\x8e\x69\x2c\x14\x7d\xb1\xe3\x85\xe9\xd7\x06\xfa\x4f\xb4\xbd\xfe\x78\x27\x19\xa0\x51\xe1\x19\xc1\x09\x8f\x98\x28\x30\xe7\xb1\x40\x98\x1d\x1f\xe6\x27\xa4\xa8\x6c\x7f\xb4\x16\x8d\x8c\xa2\x33\x16\x33\xbc\x07\xfc\x51\xe7\x0f\x92\x25\xac\xa6\xb7\xa3\x0f\xc8\xd3\xcc\xec\x83\xf7\x42\x48\x52\x78\x1e\x9b\xc2\xfb\x3a\xd3\x1b\x97\x79\xf2\xb8\xbd\xda\xf3\xb0\x5e\x20\x7e\x1a\x64\xac\x56\xbd\x0e\x6c\xbd\x2d\xe0\xfd\x6c\x2d\x44\xc6\xe5\x96\x35\xd1\xe8\xf4\x5c\xe1\xdf\x75\xa8\x45\xcf\xeb\x52\xc5\xf6\x95\xab\x55\x2c\x09\x1b\xd7\x87\x57\x97\x71\x27\x7e\x7c\x5c\x4d\x51\x49\xd2\x8d\xbb\xd2\xa8\xda\x7a\xbd\xea\xca\xfe\x8c\x96\x5e\xe0\xc7\x09\x95\x74\x0b\x7a\x5a\x08\x21\x7b\x08\x21\xdc\x95\x95\xa1\xd3\xc8\xe2\x5b\xa8\x7b\x84\x42\xff\x9a\xbc\x0a\x32\xce\xae\xf4\x02\x19\x60\x39\xbe\x35\xcb\x21\xe9\x0e\xd6\x36\xa8\xa7\xe7\xce\xc9\xd1\xbf\x15\x0a\x6e\xf5\x9a\x0b\xa1\xd5\xac\x56\x18\x0d\x67\x6f\x03\x77\x5a\xd8\x8b\xa2\x18\x09\xd2\x70\x7b\x7e\x28\xe2\x1c\x8d\x32\xd8\xf3\x31\x43\xeb\xf5\x07\x11\x4b\x9c\x63\x86\x45\xa5\xac\xba\xa1\x33\xe4\x85\x88\x15\xc9\x8e\x1b\x5d\x69\xbb\x8b\x0a\x6b\xee\x74\x47\x3f\xca\xaa\x1f\xbf\x16\x7e\xe8\x14\xbf\x57\xee\xea\x01\xdf\x78\x7e\x97\x6a\xec\xeb\x9d\x67\xb4\x38\x87\x35\x51\x1a\x99\xae\x67\xc7\xe7\x5e\x1d\xef\x9c\x8c\xfd\x07\x1d\xf9\xc6\x84\xa1\xb1\x77\xb3\xe4\x94\xe2\x0b\xa6\x7d\xd6\x11\x52\x68\xac\xd9\xeb\x3d\x2c\xb0\xbb\x0e\x30\x1c\x12\xf9\xb2\x4e\xc1\xfb\x9c\xf6\x9f\x83\xd3\x7b\xfb\x7e\x1d\xdd\xf6\x3b\xba\x6d\x3a\x9a\x4d\xe3\xee\x5b\x11\xcb\x46\x10\xa1\xc1\xa0\xf2\x9d\x68\x21\x03\xe8\x46\x5b\x6f\xbd\x12\x84\xc7\x03\x84\x3f\xa9\xdf\xe1\x2e\xc2\x5f\x55\x62\xf7\x09\x28\xa3\x74\x5f\x89\x7a\x75\x4e\x2b\xf6\x0b\x84\x91\xfc\x53\xdf\x25\xf1\x67\x78\xfa\xdd\x3c\xfd\x28\xc8\xad\xe0\xf0\x4d\xe2\x33\x80\xbf\x80\x17\xc4\x3f\x05\x61\xeb\x35\xfe\xd5\x14\xfe\xc3\xfc\xfe\x64\x7e\x7f\x33\xbf\x7f\x09\x72\xbb\xc6\xbf\x08\x72\x7c\x82\x1f\x40\x9a\x51\xf5\x57\xc2\x5f\x4e\x49\xb7\x95\xbb\xae\x95\x42\x56\xab\x4a\x67\x05\x32\x1c\x5e\xd8\xfc\x26\x0c\x53\x8d\x70\x41\x8d\x9d\xaf\xf9\xa5\xe6\x37\xa7\xe4\x0c\x67\x54\x0d\xb8\x84\xbf\x29\x25\x3f\xfc\xff\x8e\x93\x17\x5b\x7f\x9d\xd2\xad\xaf\x7f\x2f\x07\x83\xfd\xc1\x96\xfa\x79\xf9\x18\xfe\x3e\x85\x87\x57\xf0\xf0\x0a\x1e\xb6\x5f\xbd\xfa\x7b\x39\xd8\x79\x02\xc5\x76\x9e\xbc\x84\xbf\xaf\xb6\xfe\x5e\x0e\x5f\xa9\x37\xdb\x83\xc1\xfe\x16\xfc\xbc\x54\x7f\xa1\xd8\xf6\xf0\xa9\x7a\xb3\x3f\x80\x87\x57\x07\xaf\xfe\x5e\xee\x0c\x06\xc3\xad\xbf\x97\x2f\x9f\xa8\x6f\x5e\xed\xc1\x9b\x57\x2f\xf7\xd5\xc3\xcb\x57\xf0\xf0\xea\xd5\xcb\x93\xff\xab\x1d\xfb\x7b\xab\x3f\xd8\xda\x53\x4d\xff\xf8\x44\x35\x33\xd0\x6d\x3e\x86\x66\x76\x5e\x41\x33\xbb\x83\x93\x7f\x3d\xf8\x01\x2f\x29\x39\x02\x16\x4a\xc5\x29\xae\xb9\x96\xc4\x53\x58\x15\x13\xf8\xbb\x50\x7f\xab\xa0\xf9\x9d\x0d\x8e\x5e\x3b\xbe\x0f\x53\xfb\xb0\x3f\x63\xe9\x05\x9b\x74\x5c\x14\xf6\xce\xdd\x61\x90\x3b\x9b\xe2\x1f\x77\xb4\xcb\xd9\x7e\xb9\xc8\x33\x19\xf7\x3a\x3d\xd4\x62\xe9\x8d\x6e\x17\xf4\x98\x9d\x00\x55\x70\x05\x27\x44\x77\x68\xb9\x15\xdd\x21\x5a\x23\x7c\x7c\xdc\xa3\x69\xca\x16\x72\x7f\x46\x0b\x08\xfd\x6d\x9e\xb7\x52\x93\x71\x82\x8f\x7b\x69\x4e\xcb\xf2\x2d\x9d\xb3\x1e\xd6\x69\xc8\x9d\xc9\x79\xfe\x4a\x14\x3d\xdc\x9b\x8a\xc2\xe4\xc8\xc5\xc1\x97\x65\x76\xd9\xc3\x90\xde\x62\xf0\x70\x72\xd2\xda\x37\xa3\xcc\x7f\x3c\x38\x19\x2d\xe8\xb1\xb4\xdd\x94\x78\x08\xdd\x3c\x1e\x9e\x04\x3d\xed\xa5\x21\x84\x7a\xb8\x37\x29\xe8\xf9\xb9\x49\x97\x0b\x96\xe7\x00\xde\x1e\x36\x31\x95\xdb\x9b\x0d\x41\xb2\x0d\x6d\xf5\xa5\x78\x23\xae\x58\xb1\x4f\x4b\x66\x74\x04\x5c\xb3\x74\x29\xc5\x7b\x76\xc9\x8a\x52\x35\xc3\xae\xcd\xf1\xc3\x74\x14\xb0\xf2\xbd\x1a\x63\xc1\x26\x00\x86\x74\x59\x9a\xee\xa8\x49\x63\xc5\x25\x7b\x91\x2f\x66\xf4\x3b\x7a\xe2\xb7\xdd\xa3\x79\x2e\xae\x5e\x2d\xf3\xfc\x43\x5a\x30\xc6\x3b\xb4\xbc\xe1\x69\xc7\x85\xbc\x86\xd4\xbb\x9c\xde\x74\x14\x68\x0a\x91\x97\x76\x99\xa9\x5f\x56\x74\x26\x46\x6c\x67\x13\xef\xb2\x54\x2e\x0b\xf6\x9a\x9b\x44\x67\x2a\x8a\xf9\x5b\xf1\x3b\xcd\xb3\x09\x95\xac\xa3\x43\x21\x76\x72\x21\x16\x1d\x2e\x0e\xc5\x64\x99\xb3\x0e\xaf\xde\x8b\x05\xe3\x9d\x45\x4e\x6f\xca\xd7\x3c\xcf\x38\x53\x74\xd6\xe4\x88\xe7\x37\x9d\xc2\x40\xa1\x53\x68\x48\x4d\x3a\x65\x2a\x16\xea\x87\xd1\x79\xce\xca\xb2\x93\x49\x36\xff\xa0\xf2\xbe\x73\xcd\xee\x7c\x73\x82\x52\xbd\xa7\x7a\xb8\x67\x3d\x02\x43\x52\x42\x96\x56\x0f\x64\x93\x7b\x4d\xc1\x0e\xee\x0e\x6a\xfb\xa3\x97\xd2\x85\x02\x95\x5a\x6d\xe2\x8a\x43\x04\xfd\xfb\x54\xb5\xdb\xdc\x6a\xbd\x54\xe4\x65\x0f\xf7\x0a\x71\xa5\x7e\xca\xec\xab\x5e\xb7\x94\xdf\xab\xc6\xc7\x2d\x35\x16\xe2\xea\x83\xfa\x1e\xf7\x40\xbb\xf0\x5e\xf5\x3c\xba\x1b\xa0\x70\xfa\x9e\x51\xf2\xc3\xf1\xdf\x5b\xc9\x49\x7c\x4c\xb7\xbe\x9e\xa0\x1f\xce\x47\x80\x17\xb8\xdc\x9a\xb1\xec\x7c\x26\x3b\x34\xcf\xce\xb9\x3a\xc8\xb6\xce\x68\xc9\x60\x31\xd0\x82\x9e\x65\xe9\x96\x5a\x53\x1d\x9b\xb9\x55\xce\xb2\xa9\xec\xa4\x74\x61\x3f\x4c\xf3\x6c\xb1\xb5\xa0\x72\xa6\x53\x85\x5a\x62\xa9\xc8\x45\xb1\x05\x94\xdd\x42\xe4\xd4\x28\x78\x34\xf2\xb6\xa6\x99\xba\x25\x95\xe6\xdd\xa2\x10\xd3\xcc\x7d\xed\x0c\x62\x3b\x13\x31\xcf\x38\xf5\x7b\xc6\xb8\x5a\xfd\x5b\xea\xb2\x79\x5e\x88\x25\x9f\x74\xa6\x59\x9e\x6f\x89\x05\x4d\x33\x79\xa3\x1f\xa0\x23\xd3\x5c\x88\xc9\x16\x54\x68\xd2\xae\x8c\xe0\x72\x6b\x4a\xe7\x59\x6e\xd2\x6a\xfa\xaa\xd4\x16\x9d\xfc\xb3\x2c\xa5\xc9\x90\x05\x93\xe9\xcc\x3e\xdc\xe4\xa6\xe0\x25\x2d\x32\xca\x4d\xa1\x2b\x0d\x8e\xf3\xfc\x66\x31\xdb\xe2\x74\xce\x4c\x52\x14\x99\xa5\xe5\xb6\x66\xa2\xc8\xbe\x2a\x72\x2f\x6f\x79\x79\xc9\x0a\x99\xa5\x34\xef\x40\xa9\x2d\x3a\xb9\xdc\xba\x36\x69\x51\x64\xe7\x19\xdf\xba\xee\x64\x73\x7a\xce\x3c\xd0\xe4\x4c\x4a\x56\x6c\x95\x6a\x50\xea\x51\x75\x21\xe3\xe7\x66\xc4\x73\x5a\x5c\xb0\x62\x8b\xf1\x89\x4d\xce\x33\x97\x84\x05\xd6\x11\x97\xac\x80\x79\x5d\x88\x32\xd3\xb6\x54\x36\x47\xce\xb2\xf4\x82\xab\xad\xbe\xa0\xea\xc6\x25\x8a\x09\x2b\x3a\x0b\xca\x45\xc9\xb6\x86\x1d\xb8\x86\xa9\xda\x2f\x15\x61\x59\xd9\x2f\xc3\x14\x73\xd9\x29\x67\x74\xe1\x77\xb5\x94\x62\x61\xfa\x05\x49\x3b\x11\xa5\x2c\xb2\x0b\xa6\xc8\xc6\xe5\xf9\xac\xea\x46\x98\x5d\xf5\xa5\x94\x85\xb8\x60\x5b\x13\x5a\xce\x68\x51\xd0\x1b\x3f\x43\x6b\x8c\xda\x1c\x35\x88\x94\x2e\xfc\xc7\x7f\x44\xc6\xed\xf3\x3c\x93\x6a\xa0\xf3\xcc\x7d\xe0\xf5\x48\x3d\x5e\x65\x13\x39\xeb\x48\x76\x2d\xb7\xb4\x62\xad\x4e\x4f\x58\x2a\xf4\xc9\xad\x9f\xab\x11\x2a\xea\xb2\x06\xcc\x2a\xab\x1a\xc1\x92\x67\xa9\x98\xb0\xad\xb3\x6c\x92\xb9\x07\xd0\xef\x55\x4f\xb2\xdc\x5a\x28\xa8\xce\x3b\x97\x5b\x54\x9d\x35\x67\x4c\x66\x69\xe7\x72\x6b\x46\xf9\xb9\x6a\xe5\x72\x2b\x9b\x30\x71\x5e\xd0\xc5\x0c\xf2\xe7\x54\xce\xd8\x9c\xea\xa5\x73\xc9\x52\x29\x8a\x2d\x2d\xae\xed\xa8\x15\x05\xeb\xe8\x46\x27\xdd\x32\xf2\x9f\x6e\x3a\x57\xa2\x98\xb8\x25\x74\x55\x64\xb0\x82\xe6\x62\xc2\x3a\xd7\xf3\x9c\x97\xc9\x75\x9e\xf1\x8b\xce\xb5\xd9\xf0\xdf\x44\xf7\x55\xc0\x23\x30\x23\x8f\xcf\x28\xbe\x40\xad\x14\x41\x70\x36\x42\x2b\x09\x4d\xe5\x52\x9d\x4c\xe6\xa9\x48\x0b\x91\xdb\x27\x2f\x59\xce\xc4\x95\x49\xca\x4c\xba\x6c\x45\xe9\xfd\x37\xfb\x07\x44\x4f\xf2\xc3\x0f\x57\x57\x57\xfd\xab\x9d\xbe\x28\xce\x7f\x18\xee\xed\xed\xfd\x00\xad\xf5\x1c\xd6\xbe\x9e\xe7\x89\xc2\x4d\x3d\x0c\xc9\x9c\xf2\x73\x93\x54\x50\xdd\x44\xb7\xfc\x67\xfd\xf8\xe3\xf0\x8d\xea\xcb\xd3\x1f\x14\xaa\xd1\xcd\xb8\xfe\x48\x7a\xf6\x9a\x4f\xd8\xb5\xa2\xee\x0a\x51\x96\x47\x30\xd3\xf7\x3a\x4c\x86\xdf\x38\x9d\x17\xb4\x0f\x83\xff\x19\xb4\x59\xe0\xa3\x9e\xcb\xe8\xe9\xcf\xcd\x54\xce\x20\xe7\x5b\x20\x1c\xa8\x0e\x97\x45\xaa\x4a\xea\x0f\xa8\x66\x00\x00\x15\x3a\x7f\xa1\x1f\xfe\xc3\xae\x0f\xec\x39\x38\xa3\xe4\x95\xe8\x9f\x9e\x7e\x38\xd8\x7f\x7f\xf0\xf1\xf4\xf5\xdb\x8f\x07\xef\xdf\xbe\x78\xf3\xe1\xf4\xe5\xd1\xe9\xdb\xa3\x8f\xa7\x9f\x3e\x1c\x9c\x1e\xbd\x3f\xfd\xf3\xe8\xd3\xe9\xe7\xd7\x6f\xde\x9c\xfe\x78\x70\xfa\xea\xf5\xfb\x83\x97\xa3\x59\xd3\x39\xbd\x56\x3a\xd1\x5c\xb4\x97\x59\xb9\xa0\x32\x9d\xb1\xa2\x87\x56\xab\x78\x46\xfb\xed\x6f\xc9\xad\x61\xbb\x69\xff\xde\x08\x7f\xa3\xde\x1f\xd5\x67\xfb\x82\x4f\xb3\xf3\xb6\x8a\xbd\xd7\xe4\xd6\x72\xd9\x4d\xd5\x30\xde\x39\xc5\xe7\xea\xb6\x19\xf7\xff\x85\x8e\xff\xfe\xfb\xef\x1f\x4e\x7e\xc0\x97\x94\xb4\xb0\x59\x3e\xdc\xcc\xcf\x44\x1e\x45\xfa\x57\x81\x1b\xdf\x50\x72\x49\xc7\x55\x46\xdc\x03\x5e\x8e\x15\xd5\xf6\x50\xf2\x78\x30\x1c\xec\xe0\xd3\x0d\xe5\x20\x54\x40\x6e\x8a\x3d\xc6\xd7\x1b\x8a\x4d\x0b\x7a\xee\xd5\xf7\x04\x5f\x6d\x28\xa8\x8e\x84\x54\x9e\x2a\xcc\x64\xca\x3e\xc5\x17\x9b\xda\xd6\xb4\x44\xa1\x0b\x0e\x77\xf1\xc1\xe6\x82\x97\xd9\xc4\x16\x1c\xec\xe1\xa3\x0d\x05\x0d\xef\xdc\x54\x38\xc0\xef\x36\x97\x33\x73\xec\xf5\x74\x38\xc4\xfb\x9b\x86\x2f\x8a\x2b\x5a\x4c\x4e\xd5\xe2\xd7\x65\xb7\xf1\x3f\x9b\x20\x60\xa6\xd8\x14\xdc\xc1\x1f\xbe\x51\xf0\x34\xcf\x4a\xd3\xe5\xed\x01\x3e\xdc\x50\x7a\xce\xe6\xc2\x54\xf9\x08\x7f\xdc\x50\x28\xa7\x5f\x6f\x4c\xa1\xc7\xf8\xfd\x86\x42\x67\xb9\x48\x2f\x4c\x7b\x43\xfc\xe6\x3e\x2b\x4d\x1d\xc3\x54\x8a\x02\xbf\xa5\xe4\x56\x5d\x41\x93\x8d\x28\x43\xbd\xed\x61\x75\xe0\x6d\x2c\xf5\xf4\x87\x43\x2a\x67\xf0\xe7\xf0\x4d\x0f\x97\x97\xe7\x6d\x05\xb7\x07\x83\xc1\x0f\xe5\xe5\x79\x6f\x8d\x5f\xd0\x40\xe7\x50\x73\xbe\x3c\x46\x91\xf3\x17\x7f\xf8\xe1\xc5\x62\x11\x45\xf0\xd3\x67\xd7\x2c\xfd\xc4\x4b\x3a\x65\x6f\x44\x4a\xf3\x57\xa6\x86\x71\x15\x38\xc0\x32\x65\xef\x2c\x1f\x37\x75\xda\x19\x04\x69\x5c\xa3\x75\xc2\xd6\x35\x1f\x6f\x60\x55\xed\xd0\xfd\xa7\xf7\xaf\xbb\x84\xbc\xa5\xfd\xf2\xf2\x7c\xb5\xea\x39\x06\x44\x2f\xe3\x1d\x86\x58\xdf\x65\x18\x83\x05\x50\xd0\x9f\x53\x32\xa7\x95\x8d\x54\xc8\xc2\x8a\x7b\x93\xec\xb2\x87\xf0\x9c\x7a\x1f\xf7\x9e\x95\x97\xe7\xcf\x7b\x0f\x8d\x4b\xfb\xa3\x69\x8c\xfa\x52\x7c\x00\x45\xa0\x18\x3d\xec\x3d\xfb\x01\xde\x63\x49\xe6\xd4\x63\x9d\x8f\x7c\x3e\x7a\x9d\xd7\xcd\x02\xc3\x53\x30\x1c\x90\xb5\xe2\x7e\xa4\x33\xff\x1d\x5a\xaf\x11\x7e\x49\xc9\x2d\xe5\xd9\x1c\xa8\x30\xc6\x27\xc9\x6f\x71\xef\x85\x7d\xee\xe1\x2a\x7d\xc0\x27\x3d\x84\x5d\x51\xbd\xd4\x32\xc1\x37\x7e\xf0\xda\x96\xf0\x3f\xd3\xa6\xa5\x9b\x3e\xd1\x06\x6d\x08\xcb\x82\x72\x4d\xf5\x99\x1e\x7d\x74\x19\x3d\xec\x3d\x40\x9f\xd6\xf8\x35\xb0\x9e\x7e\x06\xd6\x13\xa7\x51\x14\xff\x4c\xc9\x9d\xd3\x62\x43\xb8\x54\x83\x53\x84\xb7\x9a\x6d\xcb\xc2\x8c\xb5\x5a\x72\xe7\x25\xed\xfb\xd0\xa9\x1e\x70\x4b\x01\x07\x93\xbb\x8b\x01\x0c\xaa\x47\x14\x8c\xa8\xde\x91\xea\xfb\x00\x28\xde\x93\x3e\x9d\x5e\x51\xfc\x89\xe2\xaf\x14\x7f\xa1\xe4\xaf\xb8\xe7\x77\xba\x87\xf0\x9f\x61\x66\xe6\x4d\xcd\xe7\xf0\x55\x69\xa6\xe0\x77\xc8\x0e\xda\xec\x21\xfc\x23\x25\x3d\x7a\x26\x0a\x75\x6b\xe5\x0b\xe0\xae\xe8\x5f\x73\xb9\xe8\x4c\x8c\x7f\xd0\x74\x06\x44\x38\x9b\x2f\x64\xc6\x26\x1d\xc6\xd3\xe2\x66\x21\x21\x35\x51\x7f\x8b\x42\x14\xe0\x2f\x89\x4d\x26\x54\x52\x93\x9c\x33\x49\xdd\xa3\xbe\x54\x2d\xe8\xb2\x64\xc0\x57\x81\x3f\x8a\xac\x5e\x14\xe2\xbc\x50\xd4\x7f\x41\x25\x33\x0d\x95\x8c\x5d\x00\x47\x85\x5d\xe8\x0b\x12\xcd\x73\xf5\xac\xc5\xfe\x1d\x99\xcd\x99\xd6\x42\xec\x5c\x8a\x7c\x39\xb7\x9f\x5d\x51\xa0\xd0\x7d\x3a\x17\xff\x4a\x15\x19\xd4\x26\xc6\xf9\xcc\xe8\xc5\x21\x5d\x8c\xcd\x6f\x72\x48\x17\x08\xff\x61\x58\xd3\x3f\x51\x72\x7c\x82\x7f\x03\xae\xf4\x5f\x90\xfe\xc5\xbc\x79\x60\x7e\x99\xb1\x40\x93\xb9\x75\xfc\x8f\x79\x95\x2c\x72\xf5\x8d\xc8\x49\x6f\x2e\x96\x25\x9b\x88\x2b\xde\x81\xd4\x72\xd1\x91\x62\x99\xce\x52\xca\x53\x96\xeb\x34\x8c\x49\x25\x34\x90\xe8\xf2\x1a\xa2\x34\x76\x26\x67\xb9\x4e\x98\x1b\xa5\xf9\xc6\x3c\x41\x9d\x26\xbd\x5c\x74\x26\x05\x3d\x57\x15\xa9\x5f\x5d\xcf\xa4\x10\x0b\xed\xef\xca\x4d\xba\xff\xa8\x0b\x5d\xb0\x1b\xa8\xe8\x82\xdd\x00\xf7\x55\x25\x96\x8b\x0e\x44\xdf\x82\x6b\xdc\x6b\x48\xa5\xb9\x28\x59\xc7\x74\x20\x15\x8b\x9b\x4e\xba\x54\xd3\x59\x4a\xd6\xd1\x7d\x34\x73\x60\x88\x81\x39\xe3\xcb\x4e\xc1\xe0\xd2\xb9\x3c\x9b\x67\xc1\xe5\x08\xd3\x9c\x68\x16\x62\xe7\x2c\x5f\x16\xa6\xeb\x92\xe9\x54\xce\xe8\x25\xd3\xc0\x52\x37\x6e\x93\x52\xad\xe9\xb1\x42\xa6\x4d\x2f\x65\xe7\x5c\x48\x07\x1f\x60\x60\x75\x72\x51\xd6\xb2\x82\xd6\xf3\x5c\x21\x98\xac\x9a\xac\xb2\x4a\xa6\x39\x39\xd6\x7b\x42\xd1\xdb\xfa\xf7\x0b\xc5\xd5\x8e\x52\x48\x0a\xff\xe9\xe7\x54\x98\x11\x7f\xf6\xf3\x35\xfa\xc3\x3d\xb3\xa7\x74\xea\x9d\x4b\x79\xfb\xac\x7a\xf5\xd1\x65\x84\x5b\xcf\xcb\xd8\xb7\x19\x66\x37\x86\x29\xbb\x33\x1b\xe9\x49\xf8\xab\x76\xac\xf7\xdb\x80\xa1\xce\x7b\xa7\xf3\xf6\x5d\x1e\x30\x05\x83\x1f\xbd\xe3\xdd\xc3\x4b\xff\xc1\x22\x00\x97\x71\x18\x66\x94\x06\x42\x2a\xfd\xc1\xa5\x1b\x73\xa7\x33\x1b\x7d\x31\x08\x24\x4c\x19\x64\x12\x26\x0d\x1e\x09\x53\x1a\xa7\x84\x29\x8d\x5f\x82\x54\x85\x6b\xcc\xc3\x27\xf3\xf0\x3b\xc5\x1e\x42\x85\x65\xd1\xb3\x08\xa8\x4a\x9d\x8c\x8e\x58\xdc\x83\x55\x0e\x7f\xec\x0e\x32\x3f\x7a\xe7\x98\xbf\xb0\xc3\xf4\x5f\x6f\x13\x99\xf4\xa1\x4e\xab\x8d\x67\x77\x9f\xfa\xef\x70\x05\x5d\x5e\xef\x87\x48\x63\x22\x96\x67\x39\x33\x99\x1e\x72\x38\xa8\x21\x09\x7a\xfe\xa1\x42\x17\xf0\x47\x6f\x4d\xfd\x57\x23\x02\xfb\xf7\x92\xe6\xd9\xc4\xfd\x7a\xb8\xe3\x65\x1d\x87\xbc\xf3\x90\xc9\x05\xbb\xf9\xb4\xe8\xd4\x10\xe1\x4b\x1f\x25\xc2\xef\xa7\x85\x41\x28\xf6\x2f\x9c\x15\xe1\x89\xd1\x8e\x0a\xf7\x37\x22\xc6\x97\x21\x92\x34\xa9\x4f\x0b\xff\xb4\x51\xc9\x7d\x93\x04\x8c\x65\xf0\x56\x75\x08\xc1\xd9\x73\x06\x0c\x31\xfd\xd3\x40\xe1\xfb\x6d\xe8\xfc\x20\xc4\xeb\x90\xd4\xb0\x0e\x8e\x2d\xfd\x60\xf6\xb5\x8f\xa8\x06\x08\xab\xd5\xa3\xa6\xa8\xe3\xfe\x54\x88\xf2\xc0\xa5\xd8\x75\xa6\x67\xf2\xc0\x26\x34\x12\x55\xa9\x37\x2e\x05\x88\x53\x25\x8e\x1c\x5a\x55\x94\xa7\x4e\x1d\xba\x94\xc2\xa9\x90\x38\xb2\x89\x0a\x0d\x1f\x79\xb8\x17\xbe\x35\xe9\x43\x2f\xed\xa1\xea\xa3\x76\xac\x0d\xb5\x68\xe3\x6e\xfb\x23\xc5\xf9\x79\xce\xaa\x9f\x65\x3a\x83\xfa\x21\x05\xb5\x5f\xcd\x18\xcb\xf5\xdf\x00\x48\x43\x00\x52\x9a\xe3\x6d\xe4\xbc\x64\x2f\x73\xd2\x73\xa4\x84\xf1\x5d\x60\x9e\xbd\x23\xad\x7e\x14\x6e\x3e\x2a\x0d\x02\xf0\x9b\x9d\xe6\x64\x30\x9a\xe6\xcf\x96\x2e\x68\xe6\x34\x7f\xf8\x10\x95\x10\x27\x30\x5e\xe6\xc7\xd3\xfc\x04\x0f\x34\x35\x37\xc9\xc9\x57\x51\xb9\x4e\xfd\x54\xb2\xe2\x47\x75\x1d\x04\x43\x12\xad\x5f\x8b\x17\x61\x99\x62\xc9\x3f\x67\x72\xe6\x5e\x9f\xe5\xa4\x3b\xc0\xb3\xdc\x23\xef\xdd\xb9\x03\xce\x0f\x92\xee\x00\x9f\x01\x23\xfa\xf5\x9c\x9e\xab\xb9\x2b\x59\x3d\xf3\x43\x9e\xa5\xac\x96\xf7\x39\x9b\xc8\x99\xce\xbb\x7e\x95\xb3\x6b\x2f\xf9\x53\x21\x96\x0b\xf3\x7c\x54\x4c\x32\x4e\x73\x97\x95\xaa\x35\x5b\xb5\xac\x1f\x4b\x95\x9c\x9a\x4a\xa6\xba\x86\x2b\x9b\x7e\x07\xb0\xbc\x64\xf6\xf9\xc3\xac\xc8\xf8\x85\x7d\x7a\xcb\xce\xa9\xff\xf6\x48\x75\x50\x3d\x9c\x17\xd9\xe4\x45\xc1\xa8\x4d\xbf\xd7\x35\x9a\xe4\x01\x9f\x78\x4f\x1f\x16\x94\xfb\x8f\x70\x3b\x31\xcf\xfb\xd0\xc3\xf0\xc9\xfb\x5a\x67\xf8\x15\x98\x1c\x5b\xc7\x54\x70\xf9\x19\xd8\xbc\xea\x29\xcf\x38\xdb\xcf\xe9\x7c\x61\x1f\x7e\x76\xaf\x0c\xaf\x1c\x92\x76\x10\xa2\x58\xcc\xa8\x06\x8f\xa4\x67\x1f\xb2\xaf\x30\xce\xab\x6c\x22\xae\x20\xf3\x2b\x30\x25\x21\x25\xc4\x1c\x9a\xcb\xf2\xfc\xa8\xaa\x09\x24\x34\xde\x73\x29\xc5\x22\x78\x2c\xc4\x05\x7b\x69\x39\xff\x61\x96\xe6\xfd\x57\x79\x87\x8e\xbd\x5f\xe5\x35\xea\xb2\xcb\x62\x8d\xe7\x8a\x22\xfa\xcc\xce\x2e\x32\x75\x3c\xcf\xd5\x79\x7a\x28\xbe\xf6\x70\xef\x48\x1d\x6e\x5a\x99\xe0\x82\xdd\x94\xf1\x2c\x6f\xe7\x2a\xcf\xf3\xd6\xe8\x81\x92\xc8\x87\xac\x9f\xce\x68\xf1\x42\xc6\x03\x75\x75\xfe\xb4\x58\x58\xee\xe4\x43\xd6\x2f\x97\x67\xda\xa8\x26\x1e\x22\x3c\xcb\x8f\xe5\x09\x99\xe5\xc7\xec\x64\x6d\x39\x96\xe7\x39\xf9\x24\xe2\x5b\x75\x48\x66\x92\xcd\xa1\xb3\xb7\xd4\x2c\x95\x33\x5a\xea\x95\x5e\x98\xe5\xa9\x7e\xd8\xfc\x8c\xc1\x8c\xcf\x20\x37\x9b\x9f\xc3\x8f\xc2\x06\x2a\x71\xc1\x6e\xce\x19\x37\x33\x0a\x2b\x53\x11\x30\xea\x77\x41\x0b\x0a\xd3\xa2\x25\xe1\x30\x8f\x05\x4d\xa1\xcc\x15\x34\xb1\x46\xf8\x32\x27\x6f\x69\x7f\x26\xe7\x39\xbe\xc9\x49\xef\x41\x0f\x9f\xe6\xa4\xf7\xc3\x83\x1e\xbe\x56\x8f\xe3\x1e\xbe\x52\xbf\xdd\x1e\xb6\xce\x2b\x0e\xcc\xef\x51\xde\xc6\x0d\x2a\x99\xfc\xa8\xf5\xfc\xc7\x55\xd2\x68\xba\xe1\x77\xad\x9f\xa4\x39\xa3\x85\xfd\xc8\x7f\xb0\x9f\xed\xe7\xda\x4f\x4b\x41\xf9\x44\xcc\x7d\x86\xc5\xce\x63\xd4\x2f\x15\x7e\x88\xb7\x11\xfe\x27\x27\xbd\x9a\x56\xef\x6b\x13\x81\xfd\x41\xef\xe1\x7e\x8e\x3f\x54\x05\xe0\x8e\xab\x2d\x11\x8a\x52\xbf\x3d\xac\xde\x3a\xd5\x36\xf3\xe1\x47\x33\xe4\xf7\xe6\xf7\x8d\xfe\x1d\x7d\x12\x71\xe9\x29\xa5\xe0\xdb\x45\x01\xe2\xb3\x97\x46\x9b\xd5\x63\x07\x19\x83\x50\xc8\x7f\xa7\x4b\xb1\x09\xe9\x0e\x8c\xbb\x01\x6d\x7c\x00\xc8\x04\xba\x36\xd2\x6a\xe3\x61\x7d\xe3\x7a\x46\x8c\x92\xde\x92\x5f\x70\x71\xe5\x05\x42\xb4\xe6\xee\xa0\xd7\x62\xd5\x5c\xed\x33\xe9\x0e\x8d\xc6\x67\x56\xbe\xac\xf7\x26\x97\x68\x0d\x9b\xf4\x5d\x21\x16\xf4\x5c\xb3\x56\x1a\x2a\x7a\x1b\xba\x5a\xfb\x6e\xdc\xc8\xd9\xd0\x59\x4d\x8f\xfc\xb8\x3c\x3b\xcb\x99\x09\x8b\x55\x65\x90\xee\xc0\x75\xd7\xab\xea\x83\x14\x8b\x85\xed\xf0\x82\x15\x65\x56\x36\x81\x9d\x95\xef\xf4\x1b\xc6\x25\xc9\xe5\x1a\xfb\x19\x49\x26\xf1\x84\x95\xb2\x58\xa6\xd2\xd7\x52\x33\x83\xc4\x95\x21\x8a\x2d\xd3\x87\x35\x35\xa5\xa9\x0e\xf5\xce\xc0\x05\x33\x52\xa5\x40\x68\x01\x2e\xe1\x6a\x90\xd1\x75\x9c\x4a\x5a\x9c\x33\xa9\x96\xa2\xce\x98\x18\xf1\x81\x61\xf4\x7b\xe6\xc5\x6d\x63\xdc\x34\x59\x99\x31\xed\x39\xb5\xd5\xd9\xb5\x6e\x4c\x8d\x5d\xfe\x1b\x18\x31\x2b\x8c\x7b\xb5\x35\xc2\xa5\xac\x06\x43\x6e\xd5\x4c\x18\xcd\x58\xe8\xa8\x4e\x1b\x9e\xf7\x47\x9d\xd5\xe4\x6b\x42\x5d\x18\x3a\xf3\x6e\x46\x8d\x94\x02\x9f\xc1\xac\x95\xa6\x0a\x98\x48\x45\x18\x98\xea\xb3\x39\xfb\x20\xd5\xf1\xd3\xe4\xd0\x76\x74\x68\x07\x78\xbd\x5a\xbd\xa4\x92\xf5\xb9\xb8\x8a\xd1\x1a\xd7\xf7\x8c\x31\x8b\x2a\x3f\x16\xcb\xd2\x3e\xaa\x55\x6b\x7c\x11\x05\xdc\xdf\xca\x5b\x70\x8c\x6e\x3d\x2f\xbe\xd5\x30\x8a\x3e\x5d\x2c\xf2\x1b\x88\x0a\x8a\x9d\x5a\xa5\xd6\x8d\x2c\x00\x90\x23\x6f\x83\x93\xa2\x4a\x9b\x68\x9a\xea\x0a\xee\x1c\x2e\x7f\x82\xc0\x7a\x9e\x3d\xb3\xff\x40\x82\x57\xfe\xd2\x22\x1c\x73\x6f\x46\xd4\xf9\xb0\xc6\xde\x82\xc3\xda\x48\x58\x8f\xaf\x30\x09\x3c\x95\x31\x47\x98\xaf\x55\xa2\x34\x86\x2e\x6f\x73\xe2\x20\x11\xdf\xaa\x4b\xab\x15\x4c\xbd\xd8\xf8\xe6\x65\x4e\x8e\xf7\xf0\x70\x07\x6f\x3f\xc1\x3b\xdb\x27\xf8\x75\x4e\x38\x8d\xa2\xde\x7e\x45\x49\xd6\x38\x82\xf8\x67\x83\xff\xa0\x9c\xe5\x72\x1e\x8a\x09\x53\x65\x26\xce\x05\x59\xfc\x73\x5e\xf1\x40\xfd\x62\x86\x5d\x68\x1a\xfa\xc8\xae\x65\xad\x85\x28\xea\xfe\x9c\xe3\x4f\xba\x40\xdc\x7d\x9d\xaf\x56\x3f\xe7\x51\xf4\xf4\x99\xfa\x3b\x1c\x3e\x27\x3f\xe7\x08\x7f\xcd\x89\x3e\x06\xfa\xd3\x42\xcc\xf7\x67\xb4\xd8\x17\x13\x16\xef\x6c\x23\xfc\x25\x27\xb7\x67\x6c\x2a\x0a\x06\x54\x73\x72\xbb\x50\x6b\x74\xf2\x9e\x9d\x67\xa5\xd4\xf4\xe7\x5b\x3a\x67\x65\x72\xab\x57\xec\x24\xe9\x09\xfe\x63\xf5\x41\x0f\x9b\x6b\x7c\xfd\x85\xbd\xc8\xab\x75\x59\x59\x0c\x27\xc7\xbd\x90\x0c\xef\xe1\x9e\xbd\x56\xaa\x0b\xb8\xa5\xde\xd5\x05\x5f\xdd\x12\x7b\x27\x6b\xec\x7d\xa0\x28\xba\x7b\xf5\x70\x3f\xf8\x26\xec\x64\xf8\x6e\x43\x3f\xf5\x9d\xbe\x76\x65\xd8\xc0\x4c\x73\x37\x5f\xff\x06\x11\xf4\x5b\x93\x99\xdf\xdb\x73\xc3\x33\xd9\xd0\x77\x78\x7b\xdf\xde\xdf\xc9\x0c\xfc\x76\xff\x35\x53\xe4\xbb\x07\x60\x79\x29\x1b\x46\xa0\x5f\xdf\x77\x08\x86\x23\xfc\x5d\x63\x58\xe3\x3f\xc1\x87\xe5\x67\xf8\xfb\x7b\x4e\x6e\x01\x37\x42\xb0\x9a\xe4\x4b\x8e\xd9\xb5\xa2\xf3\xf4\x9e\x2a\x13\x5f\xe8\xa4\x4d\x6b\x01\x73\x8d\xb2\x69\xfc\x3a\x47\x2c\xb9\x0d\x0d\xbd\x7b\x75\xf8\xf6\x12\x6d\x88\xf0\x25\xef\xd7\xe7\x3e\xb0\x21\xae\x6f\x81\xa4\xfe\xc9\x01\x9f\x6c\xfc\xc0\xdc\x55\x1b\xdf\x68\x60\x3a\xcb\x28\x1b\x6a\x5e\x5b\x47\x7d\xce\xc7\x13\x69\xbd\xc5\xb4\xb4\x86\x92\x9e\x81\x6b\x4f\xfb\xa7\xd9\xde\xde\x03\x9b\x9c\x0b\x76\xa3\x50\x45\xcb\x57\x30\x2c\xa7\x30\x4f\xc7\xf1\xa7\x3c\x8a\x7a\x17\xa2\xa7\x5d\xfb\x8a\x94\x02\x95\xf2\x39\x5f\xad\x68\x97\xb4\x7d\x3c\xa6\xa4\x9e\x7f\xc0\x27\x51\xf4\x39\x87\x18\x6a\x14\xa2\xf4\xc6\x1f\x73\x52\x28\x72\xd2\x68\xc2\x66\xbc\xf3\x31\x1f\x7f\xcc\xb5\x50\x2e\xf9\x98\xfb\xde\x0a\x61\x9e\x07\x08\x61\x4a\xde\xe6\xfd\x73\x26\xdf\x09\x91\xb3\x49\x4c\xcd\x7c\x62\x31\xa6\x7d\x85\xd1\x89\xb0\x11\xa9\x63\x41\x16\xea\x78\x00\xc0\x98\x77\x08\x0b\x19\x53\x84\x05\xa1\x28\x31\x4a\xf6\x31\x23\xaf\xf2\xf1\x99\x06\x62\x32\xd3\xbf\x68\x1c\x4b\xf2\xc2\x6f\xe9\x4b\xde\xf7\xb0\xa9\x6d\x56\xea\x8a\x99\xaa\x57\x22\x94\x78\x51\x70\xc0\xa6\x4c\x26\xce\x91\x82\x48\x8e\x05\x96\x27\xeb\x35\xfe\x31\x27\xb7\xa0\x35\xa6\x6e\x21\x13\x6d\x09\x03\xbf\xea\xf8\x57\xe9\x9e\x7d\xd8\x02\x68\xf7\xf4\x15\x88\x66\x70\x17\x9a\x0b\xae\xef\xfd\x7c\x39\x3f\xd3\xb7\xd4\x05\x2d\xcb\x2b\x51\xc0\x0d\x09\xb4\xae\xe0\xc6\xc3\x68\x91\x42\x41\xc9\x72\xfd\x73\x0d\xb7\x25\xdb\xca\xb2\x80\xec\x2b\xc6\x2e\xe0\x0a\xf6\xab\xea\x16\xf0\x5a\xee\x89\x0e\x0c\x3f\x3b\xc0\x01\x90\x77\xf7\xc6\x37\xe2\x06\x60\x6f\xfa\xac\x4a\x6f\xff\x2f\x17\x75\xf6\x4f\x6d\xf3\xff\x61\x6e\x21\x3f\x99\xdf\xdf\x14\x1a\xd0\x42\xc7\xdf\x72\x92\xb2\xb8\x07\x75\xf6\xd4\xe4\x77\x5b\xcf\xdf\xd5\x6a\xef\x59\xfb\xc1\xac\x4f\xe6\xbf\x42\x94\xf2\x6b\x8e\x4f\xb3\x12\xe6\x1e\x70\xca\x07\xed\x60\x88\x4d\x92\xdf\xee\x89\x6c\x88\x1c\xff\xc6\x62\x89\x12\x43\x40\x50\x22\xc0\xf2\x4a\x01\x36\x8a\xaa\x74\xa8\xed\x33\xaa\x5c\x57\xab\x0d\x4c\x41\x2c\xae\x46\x46\x4c\x0c\xf9\x2c\x67\x2a\x2d\xb4\x85\xa7\xb6\x86\x3a\x95\xce\xbf\xc5\x5c\xc6\x02\xa1\x6c\x1a\xff\x96\xa3\x9c\xbc\x33\x72\xf3\x9c\x1c\x48\xe3\x29\xfb\x42\x02\x22\x89\xfd\xee\xa0\x28\xf2\x5a\x09\x3b\x14\x45\xb1\xd6\x66\x3e\x13\xd7\x55\xc3\xab\xd5\xff\x9f\xbd\x77\x61\x6b\x5b\x57\x16\x86\xff\x4a\xf0\x5e\x9b\x1d\xaf\xca\x69\x9c\x0b\x17\x67\xb9\xfd\x5a\x28\x2d\x2d\xb4\x14\xda\x52\xca\xe2\xf0\x38\xb6\x92\xb8\x38\x76\xb0\x1d\x2e\x0d\xf9\xef\xdf\xa3\x9b\x2d\xc9\xb2\x93\x40\xf7\xde\xef\xfb\x3e\xe7\x74\x9f\x45\x2c\x8d\x46\xa3\xd1\x68\x34\xba\xcd\x68\xb1\xe3\xf9\x11\x47\xca\xfa\x7a\x3d\xb0\x3f\xa5\xb8\x05\x01\xfe\x08\x68\xd8\x6f\xe6\xa3\x26\xad\x07\x98\x3f\x3d\x7f\x7d\xdd\xaf\x43\x10\x81\x54\x07\x58\x48\xa8\xae\xaa\x43\x3b\x6a\x5c\xde\xc6\xce\x64\x02\x63\xf6\xae\x91\x3c\x75\x8d\xa3\x20\x80\xde\xfa\xba\x46\x46\x41\x5e\xef\xfa\xfa\x5e\x3d\x02\x2c\x19\x44\x44\x9d\x20\x99\x79\x2f\x98\x7f\x37\x3e\xbc\x25\x76\xb4\x07\x53\x34\xb4\xa8\x29\xf8\x47\x60\xcf\x5e\x05\xa9\xa5\x39\x41\xfa\x01\xde\x6b\x60\x87\xd4\x66\x69\x6e\x1a\x07\x38\xe5\x10\xa6\x8e\xa5\x8d\x61\xea\xe0\xcf\x93\x91\x3f\x48\x2d\x0d\xdf\x22\x46\x09\x73\x00\x7d\x1b\x0d\x33\xbb\x09\x42\x1f\x4d\x4f\x31\xfe\x6f\xe4\xdb\xef\x83\xac\xfe\x04\x5f\x97\xff\x4e\x48\x20\x1f\x67\x74\xcd\x10\xf8\x30\x4c\xbf\xf3\x1f\x34\x67\xe2\x0c\xe1\xf7\xfc\x27\x83\x27\x74\x51\x4c\x94\x0a\xf2\x45\xda\x40\x7e\x53\x7a\xc9\xc7\x10\x22\x59\xf7\x07\x3e\xe5\xab\xf5\x33\x05\xfd\x69\x9a\x46\x21\x5b\xc4\xa0\xdf\x74\x11\x13\xc3\xc0\x49\xa1\x27\xaf\x83\xf8\x85\x8b\x00\x82\xfd\x92\x21\x7b\x94\x9e\xf6\xe3\xf7\x89\x49\xec\xd2\xcf\x97\x48\xc4\xb9\xb7\x89\x39\xa0\x3e\x07\xe3\xe8\x06\xff\xfc\x2e\xd4\x83\x06\x41\x96\x43\x6e\x83\xe4\xb1\x4d\x58\x3a\xf5\x16\x06\xb3\xd7\xc2\xd0\x47\xf5\x12\x3e\x83\xd0\x7f\xa9\x65\x3b\xe7\x1a\xf1\x98\x47\x9e\x4b\x52\x08\x23\xb5\x9a\x56\x1d\x75\x58\x13\x34\x39\x4a\xce\x4a\x29\x39\x2b\xa1\xe4\x8c\x52\x92\x66\x94\xa4\x39\x25\x67\x20\x5e\x40\xc9\x19\xa1\x24\x66\x94\xcc\x75\xe0\xf8\x76\xe4\x67\xa2\x43\x37\xe3\xf7\xe9\x3a\x10\x5f\xea\x25\x3f\xc9\x75\x56\x2a\x21\xc8\x86\x9a\xc6\xd9\xba\x36\x1c\xc2\x30\xf5\x9d\xe0\x48\x4c\xf7\x03\x26\x6a\xe8\x27\x15\xa9\xf4\xd6\x4f\x18\x1a\x52\xd9\x97\x6c\x85\x8c\x56\xe8\xfe\xd8\x89\xef\xd9\x88\x09\x7c\x7b\x86\xdb\x83\x8f\x35\xac\x59\x2c\xcd\x1a\x68\x5e\x38\xcc\xf2\x35\x79\xd5\xc0\x8e\x2e\x34\xa0\x65\x67\x16\x68\x71\x80\x3f\xf0\x19\x48\x05\x4a\x9c\xbf\x34\x4a\xda\x96\x0a\x3a\x8f\x38\x88\x02\xda\xfc\x98\x04\x2d\x62\xf2\xd3\x11\x0e\x75\x05\xbd\x47\x1c\xc4\x2a\xa8\xe7\xc0\xf7\x85\xa9\x28\xf0\x17\x4c\x38\xf9\xc3\x59\xae\xf9\x48\xce\x1e\x1e\x04\xdc\x28\x09\xb0\x8b\x0e\x11\xd1\xf6\x3c\x0c\x4d\xc1\x0f\x6d\x49\x3c\x9b\x76\x6b\x3d\xc2\x31\x6c\xe4\x01\x1f\x0a\xc3\xf8\xe1\x61\x2d\x58\x5f\x5f\x73\xe4\xf8\x1f\x8e\x1d\x37\xc8\xec\x67\xdb\x76\xfc\x32\xb6\x70\x8a\xf8\x16\x1b\xd9\x71\xbc\x87\x6b\x87\x3a\x42\x3e\xc5\xe5\xd8\xe4\x19\xe0\x91\x18\xd8\x59\x7c\x88\x7a\x8a\xfe\xa7\xa0\x2b\x53\x35\xfa\xcb\xef\x78\xfa\x25\x2e\x0c\xe9\x43\xe0\x3f\xea\xa9\xde\xab\xa7\x6b\xb6\xed\x3f\x3c\x74\xf1\x6b\xe8\xd4\x19\xae\xaf\x6f\xb0\x9f\x3a\x89\x8a\x44\x5c\xc1\xe3\x49\x95\xae\xfb\x71\xf5\x5c\xe8\x1f\x96\x58\xe0\xa6\xd8\x05\x34\xe4\x44\xe4\x03\xd7\x0e\xfc\x46\x2e\xe4\x60\x9a\x7d\x63\xe9\x03\x03\xda\x31\x1a\x9e\xb2\xf9\x4e\x21\x9e\xdc\x84\xae\x5c\xc3\x75\xd5\x13\xdb\xa1\x88\x79\x79\x24\xa8\x79\xe1\x47\xc8\xe9\xb7\x46\x42\x9b\x50\x87\x5f\xc1\x4b\xc7\xfa\x0c\xeb\x01\x32\xb4\x49\x4a\x4a\x52\x52\x1d\xd0\x08\x16\xd4\x1e\x76\x01\x99\xbc\x81\x4b\xfc\x1a\x0d\x9e\x69\x01\x11\x6d\xb7\x41\x36\xd1\x6c\x08\x5c\xb1\x3f\x6c\x07\x84\x02\x92\x29\xb3\xa3\xc3\x0c\x09\x24\x43\x2f\x64\x48\x1c\x20\x75\xaa\x0d\x41\x6c\x07\x60\x60\xa7\x20\x5e\x5f\x1f\xa0\xd5\xdb\x20\x8a\xeb\x89\x1d\x83\xa9\x3d\x00\x81\xdd\x04\xd0\x4e\x7a\xb0\x07\xed\xf7\xb0\x0e\x75\x3d\x78\xf6\x8c\x6c\x57\xa2\x49\xd9\x9e\xf6\xd2\x5e\x8a\x72\x52\x5d\x87\x34\xa7\xd7\xfc\x2b\x30\x60\x4f\x4f\x50\x7a\xa2\x83\xc0\x30\x58\x3a\x34\x82\x9e\x3e\x45\xe9\x53\x1d\x40\x96\x8e\x00\xb0\x04\x26\xb6\x6d\x4f\x1f\x1e\xf0\x1f\xce\x3f\x02\x5b\xe2\x31\x84\x14\xc1\x9c\xee\x3f\xf2\x91\x1c\x10\x3e\xe2\x37\xfd\xfc\xa2\x17\xaf\xaf\xc7\x24\x70\x06\xf3\x7f\x5a\x0f\xec\x98\x43\xfc\xf0\x80\x84\x7d\xaa\xf7\xf4\x84\xb8\x00\x8f\x75\x10\x23\xe4\x31\x39\x43\x8d\xb1\x17\xf1\xf5\xf5\x41\x01\xcb\x40\x89\x25\x26\x58\x06\x3a\x18\x20\x2c\x03\x82\x65\x60\x37\x7b\x83\xbf\x92\xec\x7c\xf4\xd9\x33\x3d\x4c\xeb\xc9\xf9\xe0\x02\x68\x74\x01\xa0\x01\x97\xc1\xc6\x0c\xae\xf9\xd7\x00\xf1\x25\x4c\xeb\x31\x06\x65\x0b\x03\x0d\xe4\x9e\x00\x68\x18\xbb\x48\x7f\x79\xee\x5e\x58\xe7\x2e\x08\x91\x86\x4b\x7c\xd5\xa1\x08\x3d\xa3\xf2\x93\x97\xd9\x2f\xeb\x24\x05\xae\xbf\xf0\x29\xec\xd4\xb7\x17\xec\xcb\x99\xe6\x0b\xf5\xbe\x1c\x18\xf8\xf6\x8c\x18\xdc\xcb\x2d\x83\x88\x8f\x7d\x71\x19\x44\xd2\x16\xec\x7f\xe4\x37\x45\xd8\x25\x0f\xb2\x1a\x12\xd7\x41\xc5\x5b\x68\xd5\x2b\x23\xcf\x27\x2b\xa2\x09\xfd\xdb\xa7\x7f\x47\xd8\x06\x1d\x8b\x53\xc9\x60\xf1\x54\x02\x1c\x2c\xe8\x11\x5a\x7a\xd4\x45\xf5\x9d\xf1\xcd\xda\x46\x09\xb9\x0b\x8a\xd8\x92\xb5\x3a\x58\xab\x3b\xf6\x5a\xa4\xeb\x33\x68\xcd\x22\xfb\x7d\x3d\x42\x1a\x26\x75\x1a\x8c\x57\xd9\x0d\x80\xc0\x6e\xf6\x82\xbf\x1c\x26\x53\xc1\xb3\x67\x68\xf5\xb2\x16\x61\x0f\xc0\xce\x79\x70\xa1\xeb\xb3\x08\x2d\xf6\xd8\x7e\x48\x64\xaf\x35\xe7\x08\xf9\x1c\x3b\x84\x50\x38\x5b\x29\x2c\xbd\xd8\x36\x0f\xe6\xb7\x66\x91\x45\xd2\xc3\x83\x96\xc6\x53\xba\x98\x92\xde\xf1\xa2\x59\xc0\xf3\xed\x08\x71\x35\x65\x2c\xe5\xc3\x66\x90\x55\x8b\xd5\xf7\xed\x89\x6f\xd3\x2e\xe0\xb3\xf3\x4d\x2c\x0b\x75\x44\x93\xcf\xe3\x24\x41\xb3\x72\xe8\xe9\x84\x7e\x51\xe9\xd0\x98\x63\x18\xd2\x93\x5f\x52\xec\x70\x82\xa0\x90\x45\xc2\xf2\x07\xf5\x29\x8d\x72\x43\x20\xd8\x26\x90\xc5\xbe\x10\x7a\x8a\x90\xa2\x12\x22\x78\xcf\xc1\xd0\xe7\x97\x4b\xd9\xe5\x05\x6c\xce\xd0\x7b\x94\xce\x24\x21\x2e\x94\xa8\xa9\x98\xc0\xa9\x97\x59\xf6\xd4\x3a\xbc\x11\xd0\xb8\x81\x3f\xe9\x47\x4e\x8c\xaf\x9b\x29\x96\x12\x9a\x00\x80\xed\xeb\x97\xb0\x21\x96\xa2\x3e\x0b\x84\x44\x64\x24\xdf\x0b\xeb\x2b\x71\xd1\x42\x89\xb9\xf4\xed\xd9\x9b\xc4\xb5\xb4\x37\x89\xeb\x4c\xa0\x06\x4e\x26\x8e\x0b\xfb\x4e\x6c\x69\x35\x0d\x1c\x40\xb4\x8e\x7b\x15\xc7\xd1\x2d\xfa\xa9\x81\xaf\x13\xfa\xf9\x75\xa2\x81\x63\x6c\x5a\x93\x6f\xfc\x5b\x03\xbb\xd1\x6d\x48\x53\xd0\x4f\x0d\xec\xc2\xc0\xd2\x76\xf1\x55\x61\x0d\x9c\xfa\xa1\xa5\x7d\x3a\x41\xeb\xc5\x70\x6a\x69\x3b\xf9\x75\x30\x0d\xbc\x9a\x4c\x12\x29\xe9\x04\xdf\xa7\xb1\x34\xf2\xf7\x20\x72\xaf\x34\x70\x18\xfd\x3a\x8a\xfd\x10\xcb\x20\x5a\xbb\x69\x5f\x43\xdf\x43\x66\xfc\xc0\x87\x9e\x36\x07\x77\xbe\x3d\xdb\xb2\xb4\xd7\x8e\x7b\x45\xdf\x4b\x6d\x5b\xda\x17\xa7\xaf\x01\xb3\x65\x69\x3b\x01\x74\x62\x0d\x98\x6d\x4b\xa3\x06\xad\xb9\x61\x69\x78\xb9\xaa\x01\x73\x93\xd4\x1f\x47\x81\x06\xcc\x2d\x4b\x7b\x15\xa0\xd4\x6d\x4b\x3b\x72\x90\xd5\x01\x5a\x4d\x4b\xdb\x71\x26\x09\xa1\xa4\xb5\x99\x33\xad\xdd\xc2\xec\x6a\xb7\x11\xec\x10\x22\xe6\xb4\x3b\xe4\x37\x61\x43\xbb\x8b\x6a\xf4\x34\xd0\xde\xb0\xb4\x77\xd1\x18\x95\xd9\x14\x38\xdb\xde\xe2\x38\xdb\xde\x16\xd9\xda\x69\x0a\x4c\xed\x74\x2d\x6d\x3f\x4c\x60\x8c\xb2\x36\x72\xfe\x9a\xa8\x8d\x7b\x26\xfa\xd1\xb6\xb4\xbd\x16\xfa\xd1\xb1\xb4\xbd\x36\xfa\xd1\xb5\xb4\xbd\x0e\xfa\xb1\x61\x69\x7b\x5d\xf4\x63\xd3\xd2\xf6\x36\xd0\x8f\x2d\x4b\xdb\xdb\x44\x3f\xb6\x2d\x6d\x6f\x0b\xb1\xaa\x69\x69\x7b\xdb\xe8\x87\x89\x10\x36\xd1\x2f\x8c\x1a\xe1\x6e\x21\xdc\x26\x42\xde\xe9\x58\xda\xc7\xe9\x98\xf0\xc3\x44\x54\xf1\x5d\xd5\x6a\x75\x2c\xed\x10\xa6\x8e\x36\x07\xb7\x82\x24\x5e\xc1\x7b\x79\x09\x89\xbd\x25\xb2\xe7\x72\x97\xfe\x39\xfe\xbe\x78\x78\xc0\x7f\xb1\x15\x29\x74\xb4\x10\x62\x92\x0e\xd3\xfc\x70\x25\x5f\x41\xd6\xa1\x7d\x9c\xd6\xa1\x0e\x70\xa8\x69\xf8\x92\x76\xbb\xa5\x3a\x22\x82\xba\xb4\x35\xcc\xf6\x71\x88\x6e\xc8\x91\xde\x51\xf2\x50\xa9\x8b\x87\x07\x91\x32\x4b\xd3\xe6\x20\x88\x5c\xea\xd7\xe5\xb1\xdb\x11\x31\x9c\x40\x87\x2e\x38\xc9\x06\x73\xf9\x36\x85\x4b\x5b\xa0\xd2\x1e\x0a\x9e\x60\x86\x58\xcd\x39\xa0\x4d\x28\x29\xb6\x90\x0d\x19\x0f\x10\xae\xdb\x91\xef\x8e\x56\x22\x60\xe5\x3a\xe6\x3a\xb8\x12\x56\xfd\x9e\x93\x3a\xf8\x81\xc2\x00\xc6\x4c\x9f\xbd\x11\x04\x8d\xdc\x61\x4c\xf8\x83\xec\x2f\x7c\x12\x99\x1c\x3c\x21\xad\xb4\x4b\x2a\xba\x51\xd1\x2b\x73\x1d\x7c\x12\x14\xfd\x84\x9a\x62\xab\x4e\x17\x47\x62\x9b\x61\x90\x3a\xdf\x55\x8c\x26\x39\x6c\x82\xa0\x70\x1a\xbe\x64\xb8\xcb\x65\x19\xb0\xc1\xa5\xa1\xae\xc3\xa0\x67\xa5\x28\xcf\x04\x94\x67\x3c\xca\x33\x05\x4a\x01\x40\x91\x9f\xd5\xf8\x83\xed\x35\x06\xa9\x83\xcc\x4c\xd6\xde\x1d\x69\x6d\xbf\xec\x66\xb2\x8f\xcf\x26\xea\x90\x38\x29\x8a\x94\x3e\xe6\xa8\x95\x93\xc9\xa4\x45\xc2\x48\x23\x1d\x11\xea\x42\x89\x72\x13\x01\xda\xb7\x7e\xd1\xce\xe1\xad\x27\x68\xdf\x0b\x10\x78\x63\x1f\xd7\xd5\xc2\x07\x4d\x64\x2b\xb1\x58\x1d\xbb\x00\xcd\xac\x1c\x7a\xfb\x99\x37\x81\x38\x72\xf2\x0d\x33\x95\x89\x94\xad\xb9\xf9\x4f\xb4\x2e\xb6\x8a\x16\x16\xb4\x23\x81\x5e\x64\x5e\xc9\x96\x16\xf7\x95\x66\x58\xd8\x7d\x5d\xee\x93\xac\x78\xf3\x6f\xae\xce\xec\xa2\x76\xf6\x1d\x61\x76\x5e\x09\x95\x73\xd7\x91\x29\x1c\xbb\x88\xcc\x7f\x72\xed\xce\x6f\x25\x23\x64\x6f\x78\x64\xb5\x6b\x87\x84\x3f\x39\xa3\x7f\x4f\x1d\x0b\xda\x43\x01\xe4\x1b\x4a\xfa\x24\x90\x40\xee\xf1\x22\x6c\xef\x05\x93\x95\x5c\xd8\xb5\xa0\x7d\x24\x76\x6f\x34\xb9\x67\x6c\xcd\x18\x4e\x4e\xf4\x2d\x68\xdf\x08\xb0\xc5\xc7\x09\x04\x5c\xf1\x54\x80\xe2\xe1\xef\x88\x8b\x69\x9c\x2c\x70\xd7\x98\xc5\x94\x5c\x02\xf8\xbd\x11\x21\x85\xc8\xb4\xe3\x4b\xa1\x9c\xa0\x9d\xa4\xb9\x1b\x67\xc8\x6d\x51\x44\xd9\x09\x63\x8a\x83\x63\xcc\xe7\x68\xc4\x7d\x96\xbd\x82\x99\x4d\x53\xd7\x7b\x9f\x23\xfb\x55\x1c\x3b\xf7\xdc\xca\x14\x5f\xcd\x23\xbe\xdc\xb4\x63\xe6\xfc\x1c\x0f\xee\xa3\x60\x3a\xf4\xc3\xda\x09\x76\x8e\xc6\xa7\xbc\xc9\x76\x6e\xf8\x54\x72\xb4\x26\x94\xc4\x56\x3f\x9f\xc2\xdd\xd2\xe0\x92\xf9\x15\xa2\x0e\x92\x3a\x39\xe0\xfa\xe9\xdb\x6f\x61\xef\x43\x64\xff\x80\xe0\x7b\x64\xff\xf4\xc1\xdb\xc8\xfe\x0c\xc1\xb4\x3e\x2b\x90\x64\xed\xf8\x40\x49\x95\xe5\xfb\xa0\x40\x98\xf5\x23\x00\x05\xda\xac\xb1\x0f\xd4\xe4\x59\xdf\xd8\xd3\xee\x13\x1f\x1c\xfa\xe0\x8b\x0f\x8e\x7d\x70\xe0\xdb\xe7\x17\xe0\xa3\x6f\x1b\x26\x78\xe5\xdb\xb3\x39\xd8\xf5\xf3\xa7\xe6\xaf\xfc\x39\xd8\xe7\xbe\xd7\xcc\x39\x78\xe7\xdb\xaf\x7c\xb0\xe7\x57\x5e\xc7\xfe\x2a\x66\x27\xee\x08\x7a\xd3\x00\xee\x50\x3f\x9f\xe0\x97\x98\x4f\x24\x31\xcb\xbd\x96\x90\xc3\xeb\x29\x4c\xd2\x23\xc7\x0f\x53\x70\x26\xe6\x85\xd1\x2d\x38\x15\x93\x86\x90\x3d\x72\x67\xe4\x60\x6f\xb6\xe0\x9b\x08\xb6\x3f\x1e\x43\xcf\x77\x52\x98\x11\xfd\xda\x5f\x7c\x0d\xfd\x83\x08\xf3\x31\x8a\xc7\x4e\x90\xe5\x7e\x17\x73\x0f\xa2\xdb\x2c\xeb\xad\x54\xbb\x17\xe4\x15\x7f\xc6\x8c\xff\x21\xf1\x6c\x14\x4d\x03\xef\xcc\x87\x81\x07\xde\xfb\x76\xe6\x9e\xef\xda\x7f\x79\xed\xf3\xd7\xe4\xe6\xe0\x0f\xba\xf9\x00\x59\x10\x8f\x04\x2d\x5d\xc3\xc4\x3e\xf3\xeb\x3a\x88\x13\xdb\x84\x9d\x17\x61\xf2\xf2\xcc\x57\x5c\xaf\x43\x30\x46\x98\xcc\x41\x94\x48\x3e\x06\x80\x43\xf1\x05\xf4\xaf\x4f\xff\x26\x18\xbf\x9b\xd8\xe5\x4e\x05\xc0\x34\xb1\xeb\x21\xbc\xad\xed\x45\x8d\x3c\xbc\x46\x23\x86\x83\x04\x0c\x12\x7b\xe6\x27\x87\xd1\x14\x5f\xb2\x2b\x9a\x08\x6b\x24\x56\x87\xc2\xb5\xaf\xbe\xbe\xfe\x47\x1d\xea\xc8\x94\x9b\x03\x18\x5e\x4f\xe1\x14\x9e\xe0\xd8\x82\x29\x94\xe6\x70\x7d\x56\x82\x83\xba\x47\xc5\x3e\x86\x23\xc1\xc7\x70\x6c\xbf\x8b\xeb\x31\x76\xf9\x08\x22\x3b\x0a\xeb\x31\xfe\x95\xb9\xb4\x4d\x41\xd6\x09\x61\xe6\xf8\x35\x5c\x5f\xaf\x47\xb9\x03\xdb\x90\x86\x99\x8a\xb0\x03\x5b\x88\xd6\xfb\x8c\xd0\x63\xe2\xad\xe3\xdf\x4c\x6c\xea\x0c\x6d\xf3\xf7\x11\xbd\x17\xc5\x2e\x7d\xd2\x65\x89\x6f\xca\xab\x28\x0e\x09\xc5\xb1\x40\x71\x88\x28\x0e\x01\x42\x0f\x62\x44\x31\xd6\xf7\x31\xa6\xb8\x95\x53\x99\x66\x54\xa6\xeb\xeb\xf5\x38\xa7\x32\xa5\x54\xc6\x94\xca\x10\xef\xc3\x25\x74\x2a\xf0\x13\xfc\x17\x4c\x12\x7b\x18\xd6\xd7\x9a\x3a\xe8\x93\x5f\xa6\x0e\x46\x09\x1a\x5f\x63\x4e\xbe\x47\xc9\x1c\x0c\xa5\xef\x1b\xe9\xfb\x9e\xfb\x6e\xce\xc1\x65\x41\xdc\x73\xe7\x1c\xe0\xae\x72\x2c\xdc\x26\x76\x13\x5c\xd1\xc1\xf3\x86\xfe\xfd\x44\xff\x1e\xe1\xc1\xb4\x93\xd8\xb3\x18\x3a\x5e\x16\xdc\x23\x04\xd3\x24\xd3\x97\xd6\x27\xf2\x49\x33\xc9\x17\x09\x91\x42\x3f\xf6\xc7\x13\xfc\xda\xe5\x06\x92\xab\xe6\x34\xf9\xc0\xb9\x8f\xa6\xa9\x00\x79\x08\xc7\x11\xfd\x79\x0c\xbd\xa9\x0b\xe3\xec\x6b\x40\x7f\x11\x11\x25\xbf\x77\x61\x7f\x3a\xc4\x17\xba\x33\x30\x3a\xbb\x66\x00\x03\x18\xc7\xd0\xe3\x61\xf2\x17\xde\xd6\xa7\x70\x0e\x7e\x56\x37\xee\x54\x68\x5c\xca\x37\xee\x9d\xba\x71\xf2\xe8\x61\x56\xaf\x9d\xcb\x77\x2e\xf5\x2f\x43\xec\x59\xc3\x49\xeb\xe7\xf0\x82\x1c\x4c\x81\xdd\xb0\xde\x01\x2d\xf0\x2b\xe4\xe2\xfb\xe3\x68\x67\xa1\x3e\x2f\xf0\x4d\x94\x7b\x5a\x17\xc5\x80\xbd\x33\x67\x6c\x15\x21\xc9\x48\xf8\x19\xd6\xb3\x6d\xf9\x34\x77\x84\x4d\x43\xbe\xa4\x00\xda\xd8\xdb\x8c\x14\x64\xe6\x1c\x82\xf4\x02\xc0\x39\xdf\x4d\x72\xab\x89\x6e\x50\xe1\x27\xcd\xae\xa7\xba\x95\x82\x58\xc2\x1c\x37\xfa\x0e\xed\x62\x1b\xd5\x1e\x37\xf0\x40\xb7\x67\xd4\xad\x37\x5d\x41\x51\xd9\xa6\x9b\x05\x4e\x92\x1e\x63\x6f\x55\xd0\x63\xd4\x40\x21\x95\x88\x4c\x3a\xc7\x51\x08\x59\x61\xfb\x3b\xcf\xdf\xab\x04\x31\xf8\x5c\xa2\x07\xc0\x8b\x39\x93\xbe\xa2\x8f\x23\xbe\x71\x30\x1f\x8e\x70\x0e\x52\x39\x2a\xcf\x3c\x97\xdc\x03\x59\x72\xcf\x24\xc9\x7d\xa3\x90\x5c\x55\xdf\x1d\x20\x52\x40\x6c\x87\xe7\xcd\x0b\x10\xd9\xe1\xb9\x79\xc1\xa8\x79\x27\xb8\xe0\x20\xe0\x77\x9c\xb6\xe3\x7e\x17\x3b\x1d\x47\x48\x8b\x70\x20\x37\x3f\x74\x82\xe0\x7e\xc6\x83\x87\xf3\x39\xc0\xfd\xaf\x83\x78\x2e\x0d\xa7\x22\x8b\x0e\x88\x8e\x0b\xed\xf4\xbc\x79\x91\x0b\x42\x7a\x6e\x5e\x80\xf3\xd3\xb0\xfe\xa1\x20\xe3\xe7\x29\x80\x17\x3a\x39\x12\x3a\xa9\x1e\x9a\xdf\xca\x87\xe6\x9e\x7a\x68\x5e\x17\xf5\xce\xd7\x5c\xef\xbc\x16\xf4\xce\x97\x4c\xef\xbc\xe2\xf4\x4e\xd1\x44\xf9\x12\xd6\x0f\xe9\xc0\xfc\x0d\x9d\x4a\xb0\xa9\x7b\x75\xef\xff\x94\x5e\xa5\x44\xaa\x7b\xf5\xdb\xa2\x5e\x3d\xfc\x6f\xf6\xea\xf1\x92\xbd\x7a\xfc\x5b\x7b\xf5\xf8\xff\x86\x5e\x3d\x7e\x52\xaf\x7e\xa1\x16\xc3\x31\xfd\x7b\x80\x2d\x87\x8f\x05\xd3\xe3\xd3\x6d\x08\x63\xf0\x0a\xe7\xee\x26\xf6\x2c\x8f\x4c\xc0\xb6\x81\x69\x2c\x02\xab\x39\xef\x9d\xf8\xb6\xc8\xce\x3c\x9a\x69\x4a\x42\x23\x48\xc1\x4c\xbb\x2c\xd0\xc4\xc3\xc3\x46\x16\x73\x42\x74\xbb\xc3\x05\x8f\xcb\x43\x80\x75\x58\x14\x8e\xdc\x0a\xa5\x81\x1b\x66\xf4\x07\x8b\x97\x18\x82\x90\xe5\xf5\xdc\x28\x4c\xfd\x70\x0a\xe7\xfe\xa0\x1e\xe2\x8b\x20\x64\x47\x21\x8b\x19\x8c\x49\xa0\x61\xcd\x7a\x7c\xd8\x59\x16\x6e\xf4\xe1\x81\xfd\xe2\xee\x91\xf4\xb8\x70\xa4\x59\xf1\xac\x7e\xfa\x03\x93\x41\xf3\xd0\xa0\xf2\x6d\x61\xd5\xf5\x45\xe4\x9c\x78\x25\x48\x0a\x22\x87\xaf\xe5\xac\xd9\x76\xcc\x3c\xe9\xa7\xc5\x40\xd8\x37\x61\x7d\x9c\xc5\x4f\xd2\x01\x8d\x36\x14\xd2\xed\x45\x72\x51\xd4\x72\xec\x8f\xf5\x00\x38\x48\xd0\xd1\x8f\x18\xc1\x9d\x5f\xf0\xbb\x41\xd1\x04\x1f\xef\x5b\x8e\xfd\x8b\x41\xfe\x52\x43\xd2\xfb\xae\x96\x43\xdf\x01\x39\x60\x46\x2e\x85\xd3\x9b\xef\xa8\x28\x7d\x21\x54\xc8\x91\x71\x21\x4d\xe2\xc4\xd0\x41\xd8\xce\x58\xbd\x67\x85\x7a\xd9\x46\x50\x7e\x0d\x21\x7b\x9f\xe7\x34\xa2\x10\x3b\x63\x58\x5f\x57\x46\xaf\xcd\x72\xeb\x41\x23\x0a\xf1\x26\xa6\xbd\x0f\xf5\xf9\x47\x48\x4e\x68\xf1\x4d\x23\x90\xf4\xc2\xfc\xc2\x87\x5f\xf3\xc3\x9a\x83\x0f\xb6\x63\xd9\x59\x9e\xaf\xaf\xaf\x17\x3c\xe8\xf9\x59\x40\x0f\xe7\xdc\xbf\xd0\x49\xe0\xba\x7b\x72\xf5\xd7\xc7\xf1\x18\x12\x84\x32\x20\xd9\x81\x5c\x3c\xc1\x97\xb6\x1e\x1e\xea\xa1\x3d\x9b\xeb\x20\x3c\x4f\x2e\x6c\x4d\x23\x43\x40\x2b\xf1\x0d\xae\xad\xd9\xb6\xbf\xbe\x9e\xf9\x10\x67\xdf\xd5\xce\xc0\x65\x28\xd9\x27\x38\xcb\xcf\x1c\x44\xd3\x84\x3a\x54\xb4\xf9\x25\x7c\x78\xa8\xa3\x4e\xd2\x2d\xb4\xf0\x7e\x78\x38\xbf\xd0\xc9\x85\x15\x9f\x46\xa2\xcd\xb9\x19\xb3\xf0\x32\xf1\xb9\x7f\x41\x6e\x67\x51\x86\xbd\x44\x3c\x61\xef\x68\x95\xec\x76\xd7\x6c\x3b\xa0\xb7\x65\xd6\x6c\xf7\xe1\x81\xfc\x08\x74\x99\xd1\x08\x2d\x51\x44\x84\xdd\xfa\x9a\x82\xd5\x0f\x0f\xee\xfa\xba\xab\x4a\x57\x75\x41\x86\xcc\xd5\x15\x65\xd6\xd7\x83\xf3\xe4\x62\xcd\xb6\xdd\xf3\xe4\xa2\xd8\x87\x28\x55\xa7\x61\x72\x10\xab\x18\xbb\x00\xcc\xd8\xa4\x23\xad\xee\x56\xf7\x34\x6a\xdb\xcb\xba\x6b\xbb\x2f\xdd\xc6\xe5\x25\x76\x58\x47\xd9\x15\xd8\xc1\xcb\x40\x4a\xa3\x5c\x5a\x5f\x47\xaa\xd2\xc5\xb7\xb4\xc5\xae\x71\x75\xdd\xca\xc5\x06\x23\x47\x7a\xcf\x7d\x78\x60\xd1\x16\xb3\xb1\xe5\xe6\x37\xb8\xf3\x34\xdc\x0a\x11\xa5\xa6\x3d\x73\x75\xeb\xf7\xc8\x9e\x5a\xd4\xea\x59\xb3\x76\x61\x3d\x02\xbe\x0e\xe0\xc3\x03\x25\x9b\x30\x55\x21\x84\xae\xae\xcf\xc3\x02\x07\xa8\xc4\x90\xe8\x36\x10\xd4\x53\x21\x0e\x27\xbe\x40\xc9\x05\x0c\x7e\xb0\x3b\xfa\x7c\x0e\x8e\x8b\x4a\x5b\x9f\x85\x24\xce\x74\x01\x1c\xeb\x94\xfd\x04\xbc\x4b\x54\xf7\xa6\x4e\xa1\x73\x75\x02\xd3\x97\xf4\xaf\x75\x02\x53\xb0\x57\x0a\x29\x79\xd2\x02\x5f\x13\xf2\xd2\xdc\x85\x7e\x00\x7e\x55\xed\x2d\x5c\x97\x4c\xf0\x67\x89\xdd\x04\xa7\x89\xbd\x05\xbe\x25\xb6\xb9\x01\x5e\x27\x76\xbb\x05\x3e\xa0\xd4\xef\x89\x6d\x82\xb7\x89\xdd\x02\x9f\x13\xbb\x0d\x7e\x24\x76\x07\xbc\x4f\xec\x2e\xf8\x23\xb1\xcf\x12\x00\x69\xac\xf9\x94\xfe\x0d\x5d\xbb\x09\x62\xd7\xfe\x90\x80\x88\x26\x39\xae\x9d\x47\xd2\x06\x81\xf0\xe5\x53\x98\x04\x15\x73\x5d\x64\x66\x4c\xd1\xcf\x81\x6b\x77\x9b\x4d\xe0\xd1\xec\x09\xce\xe9\xd3\xaf\x11\xfd\x3b\xc6\xa9\x2c\xda\x3d\x89\x9a\x7f\x4f\xbf\x2e\x11\x96\x3b\xfa\x71\xeb\xda\xcd\xde\x7e\x62\xab\x56\xbd\x91\x2d\xc7\x3f\xec\xd1\x89\x9f\x04\xdd\x65\x21\xc5\xf8\x68\xb4\xf8\xfe\xa5\x38\x2b\xaf\xe1\x97\x1e\xfb\x7e\x36\xe9\x22\x9b\xa9\xd9\x63\x41\x68\xa2\xbf\x42\x9d\x3d\x59\x23\xd6\x54\x2a\x44\x06\x73\x63\x1c\x91\x3c\xae\xf3\x57\x94\x6a\x5d\xcb\x1f\xd4\xef\xd0\xe2\x1b\x74\xd6\x53\x1c\x78\x77\x7d\xdd\x24\x9b\x12\x4e\x83\xf8\xd5\xcf\x6e\x19\xc8\x51\x1c\x53\x65\xa4\x5b\x13\x48\x17\x9d\x6a\xa6\xb5\x9b\xd6\x53\xf6\x02\xe4\x6b\x5a\x4f\x05\x1a\x3a\xd6\x7d\x58\x4f\xf9\x88\x90\x62\x14\x46\x01\xd8\x6c\x5a\x88\x9f\x02\x67\xc8\x73\x0e\x80\x78\x88\x8f\x57\x2e\xe9\xd9\x1e\xf8\x98\xd6\xa3\x04\x38\x8d\x4b\xca\x32\x12\x39\x49\x4e\xb0\x23\xa1\x82\xb6\x95\xf7\x8e\xb4\x6e\x67\x9c\x68\x92\xa7\x64\xb4\xfd\x2a\x2e\xe8\xeb\xeb\xd1\x0b\x3b\x7c\x39\x8d\xa9\x24\x58\xf5\x8f\x69\xfd\x3e\x01\xe6\xfa\x3d\x67\x36\xa5\x76\x9f\x01\xb0\x4b\xcb\xe9\xcb\x94\x99\x71\xe4\x5a\x72\x4f\x51\x50\x20\x78\xdb\xc2\x77\xf1\x94\xbd\xf1\xc2\x0e\x41\x73\x8d\xc6\x25\xe7\xe2\x91\x63\x89\x61\xcd\x99\x30\x1a\x7a\x82\x4e\xd9\xe8\xe0\xdb\x73\x32\x13\x18\xa1\x0e\x7e\x43\x97\xb9\x05\xa7\x23\xb1\x91\x3a\x7e\x40\x23\x21\x13\xc2\xf9\xf6\x8a\x87\xd2\xec\x70\x2d\xe3\xc1\x1c\x0b\x2e\xbd\x53\x8d\x7f\x33\x73\xb3\x20\x7b\x4d\x41\xbc\x5b\x8c\x05\x24\x1c\x34\x1d\x5a\xd8\x6d\x82\x18\x9c\x1b\xa4\xc5\x04\xae\xc9\x2d\x64\xff\x89\x23\x11\x38\xf6\xab\xb4\x9e\x82\x5d\x9f\xb3\x76\xb1\xe3\x53\x1d\x38\xf6\x4e\xc8\x96\x3f\x11\x80\xc0\x41\x89\x02\x42\x13\x14\xa2\xdc\x3a\xd9\x92\xc2\x51\x1a\x8f\x8c\xa7\x6c\x57\x10\x3f\xb7\xca\xa3\xe0\xe2\xc8\xf6\x64\xdf\x5c\xde\x55\xa2\xed\xe1\x27\x16\xb2\x7f\x88\xdf\x7b\x51\x5b\x7e\xad\xd9\xc3\x23\x90\x5d\x5c\x5f\x33\x7b\x2a\x3c\x88\x3c\x32\x20\xf9\x60\x4b\x24\xe5\x25\xfd\x4b\x16\x68\x21\xd2\x1e\xf4\xe1\x58\xd4\x18\xc2\x74\x17\xc6\xfe\x0d\xc5\xb5\x17\x47\x63\xa2\xd0\x14\x2d\xf5\xd7\xd7\xa7\x88\x93\x11\xf0\x01\x1e\x96\x84\xf2\xd8\x1e\x24\x42\x7c\x58\x07\x8d\xd8\xe2\xe6\xbd\x9d\x82\x3e\x29\x0e\x31\xdf\xed\x24\xce\x3b\x63\xad\x09\x70\x30\x27\xdc\x4a\xc2\xb0\x26\x48\x33\x00\xd2\x53\xd9\xa2\x91\x69\x38\x3a\xa2\x36\x2c\x68\xcd\xa8\xec\x0b\x51\xc7\x7f\xab\x60\x1d\xd5\x1d\x1d\x98\x98\xad\x97\xa8\xb1\xd3\x84\x9e\x1f\xe3\xd6\x26\xd3\x20\x65\xd2\xef\xd8\x59\x12\x0b\x19\xec\x80\x80\x3c\x46\xb0\x6f\x22\x84\x07\xda\x9f\xd3\xba\x83\xd8\x18\xd0\x31\xd1\xb4\x52\x3b\xe0\x1a\x8c\x98\x24\x06\x37\x36\xad\xd4\xf6\xab\x21\x10\x48\x58\x0d\xd2\xb1\x52\x3b\xe6\x40\x10\x1d\x64\x1c\x42\x1d\x44\x1c\xbc\x14\x3c\xb9\xdd\xdc\x00\x8e\x18\x2b\xb9\x47\x09\xa7\xdf\xd9\x80\x96\x67\x47\x20\x75\x0c\x7e\x56\xeb\x58\x9f\xd3\x7a\x84\xd6\x72\x01\xd1\x27\x11\xee\x65\xd6\xd4\x27\xe2\xf4\x8b\x38\xf1\x44\x41\xa6\x55\x84\x95\x8f\xa4\x4e\x57\xf5\x90\xac\x1a\x10\x2a\x39\x60\xd8\x56\x4b\xd7\x7b\x54\x69\x15\xc8\x10\xf5\xad\x93\x0d\xc8\x97\x0e\xa3\x90\xee\x8d\x90\x5d\x10\x90\x90\x61\x40\x97\xe0\xa0\x1e\xc9\x38\x58\x31\x1d\x29\x13\x1d\x19\x01\xfc\xd4\x93\xd9\x10\x58\xe0\xf3\x59\x98\x6e\xc4\x20\xdb\xf4\x38\xb1\x5f\xc3\x7a\xe9\x14\xcd\x7b\x2e\x06\x5f\x12\x3b\x05\x8e\x7d\x90\x60\x2f\x35\x0e\x5e\x89\x86\x76\x3f\xa9\xd3\x10\x6b\x11\xd1\x92\x24\xae\x7d\xd8\x0b\x7b\x3a\x1f\x78\xdf\x68\xaf\x73\x9f\x0f\x66\xb3\xd5\xe1\xb7\x36\xc8\x0e\x4d\xca\x7a\x23\xa4\x26\x4d\x36\x94\x25\x59\xea\x66\xc1\xd9\xb1\x8d\xc3\xfa\x65\x7d\xfd\x47\xc8\xfa\x4d\x2d\x0d\x6c\xa5\x68\xc3\x97\x92\x1d\x46\x5f\xbc\xd9\x4e\x1e\xeb\xfd\x1b\xc4\x42\xf2\x92\x9c\x6c\xb3\xc7\xe6\xc1\xfa\x3a\xce\x08\x0a\xc6\xbd\xb9\xa1\x03\x27\x26\x7d\x57\x6a\x76\xbd\x2c\x4e\x7a\x65\x06\x17\x7d\x8c\x64\xd5\x29\x5f\x7c\x41\xbb\xe9\x80\x32\x63\xc3\x12\x43\xa6\x66\x6c\xc8\x2e\x60\x21\xeb\x87\xc2\x64\x96\x0b\x33\xd2\x68\xfa\x02\x5b\x0d\x14\x04\x9a\x55\xf6\x92\x75\xf9\x44\x90\x04\x8b\xef\x4b\xa6\x8f\x99\xf2\x79\xe2\xa0\x0d\x8b\x83\x76\x93\xe1\xa4\xd5\xca\xc4\x4a\x34\x6c\x91\x5b\x53\x66\xab\xb2\x58\x2e\x09\x85\x36\x34\x2d\xfc\xb8\x41\xb6\x4c\x0b\x0d\xf1\x65\xa3\x16\x47\x8d\xc4\x86\x2d\x0d\xfa\x2b\xa1\x40\xca\x83\xd8\xb7\x89\x6c\xdf\x4a\x09\x76\xc0\x26\x2e\xbc\x59\x91\xd8\x3e\x35\x98\x9b\xe4\x15\x50\xe2\xd7\x13\x10\xe8\x2f\x9b\x56\xf3\x41\x19\x21\xb3\x71\xe9\x3a\x81\x3b\x0d\x32\xcf\x9a\xde\x6b\x3f\x4d\x5e\x96\xa4\x63\x64\x56\xbe\x02\xd3\x89\xb5\xe9\x67\x4c\xc2\x06\x0d\xfb\x58\x5f\x5f\xe3\x96\x34\x33\x5e\x2b\x65\x61\x7f\xf1\x90\xc7\x5a\x84\xbe\xeb\x4b\x32\xd1\x5e\x5f\xaf\xb3\xd8\xc3\x76\xaa\xb3\xcd\xdf\xa4\xc7\x36\x7e\x92\x06\xff\xcc\x86\x5b\x77\xb9\xfa\xcc\xb7\x69\xc7\xe5\x9e\x26\x6d\x97\x6a\x32\xca\x63\x0a\x3c\x25\x7b\xb5\xd3\x2c\xb0\x30\x0e\x05\x8c\xec\xea\x69\x23\xea\xe3\x78\x69\xb8\xe9\xeb\x81\xae\xcf\x4c\xdb\xb6\x13\xb2\x75\x5c\x9f\x92\x9b\x03\xc4\x1c\x9e\xd2\xcb\x03\x4e\x58\x4f\xc0\x54\x47\xfd\x24\x8e\xef\xbf\x42\xdc\x1c\x39\x56\xbe\x0e\xa6\x76\x92\x5b\x17\xac\x33\xa7\xeb\xeb\x53\x15\x02\x39\x11\x21\xf8\x23\xcd\xd8\x84\x44\xd4\x55\x95\x93\x13\x6d\xd6\x05\xf3\xa9\x3d\xc5\x31\xb7\x69\x4f\xf8\xb6\xd9\xcc\xdb\x48\xc2\x48\xe3\x00\xcc\xf8\xbe\x32\xd6\x80\x8c\xb1\x39\xbf\x7d\xdd\x67\xfd\x94\xf4\xb2\x0e\xf5\xed\x84\xf1\xd8\x27\x3c\xf6\xf1\xbe\xf7\x8c\x7f\x2d\x33\xcf\xb1\x60\xe1\xa5\x33\x81\xae\xcf\xb2\xae\x67\xb8\x01\x42\x48\x0b\x65\x89\xf3\xc4\xf6\xe7\x74\xdc\x3a\xe2\x50\x2d\x99\x35\xb6\xd9\x68\x67\x4b\x4a\x6c\x6c\x09\xe3\x35\xb2\x83\x1c\x55\xbe\x1e\x48\xc3\xba\x03\x82\xfc\x5e\x14\x2f\x1d\xf8\xea\x0b\xb2\xd5\xa4\x15\x42\xb9\x02\xec\x28\xe8\xc0\x66\x9e\x48\x8c\x4e\x93\x29\x88\x0e\x62\xda\x58\x40\x54\x2c\xc5\x96\xcd\x85\x11\xd3\x61\x18\x5e\x6e\x58\x5e\x60\xf3\xc9\xfa\xf7\x29\xf6\x32\x5b\xe5\xe0\xd5\xcb\xcb\x3a\xb4\xd7\x9a\xe0\x2b\xf1\xa8\x02\xed\x35\x33\x63\xba\x47\x8c\x1f\x47\x67\xab\x01\x6c\xdb\x4b\x6b\x01\x98\xb7\x2a\xeb\xdc\x6c\xd9\x2b\x19\xa6\x66\x77\x83\x2e\x2e\xe9\xa6\xda\x15\xdd\xe4\x79\x43\xfe\xf6\x0e\x22\xee\x2e\x27\x59\xac\x09\xee\xd5\x48\xbc\xf8\x42\xf8\xe8\x3c\x9e\xb5\x3e\x07\x02\x8e\x69\x88\x43\x3e\x17\xc3\xfe\x2a\x90\xa4\xb6\x34\xe5\xf6\x4e\x22\xd2\x54\x1a\x67\x8a\xf7\xf7\x87\x63\x60\x93\x5b\xe5\x73\xb0\x27\x86\x00\xf1\x07\x75\xf2\x24\x24\x8f\xc3\x9e\xda\x6f\xd3\x3a\xbe\x0c\x65\x76\x9b\xc0\x6c\x36\xf5\xde\x1e\x35\x53\x68\xf0\xef\xf9\x1c\x7c\x15\xd1\xe4\x38\xd6\xd7\xeb\x18\xba\x4d\xa1\xdb\xba\x3e\x07\xbf\x96\xa8\x74\x9f\x58\x70\xef\xe2\x7a\x4a\x1b\x41\x2f\x4d\x09\xf5\xc6\x4e\x61\x77\x8d\x6d\x0e\x88\xa7\x4e\xfe\xa0\xbe\x5f\xa7\x4b\xc2\x10\x07\x12\x01\xb9\x23\x92\x90\x3a\x04\x21\xa2\x49\x4f\x10\x43\x1b\xf6\x42\xfa\xa6\x1c\x1f\x72\xe9\xc8\xe2\xe4\xbe\x09\x50\xd8\xb8\x9e\xc2\xf8\x9e\x5c\x63\x8d\xe2\x57\x41\x40\x5d\xca\x9c\xa3\x4a\x6c\xed\xd9\xfb\x93\x4f\x1f\x1b\x64\x3b\xdb\x1f\xdc\xd7\x35\xed\x59\xaa\x3f\xfb\xd7\xc5\x39\xd6\x90\x94\x86\x8b\x7f\x21\xba\x9a\xbd\xf4\xaf\x90\xbd\x67\x4c\x9f\x3d\x63\x3b\x85\xe1\x79\x8a\x0f\x2a\x22\x32\x6c\xa2\xc6\x20\x8a\xc7\x98\x5f\xe8\x07\xdb\x2b\xfc\x01\xeb\x11\xb9\xe4\xef\x48\xeb\x8a\xed\xa6\xae\xf7\x0e\xea\x91\x0e\xf6\xf1\x10\x9c\xcf\xe7\xca\x73\xaf\x6f\xf5\x7c\x25\x27\x9e\xaf\x11\xc6\xe0\x77\xf3\xc4\x4b\xca\xfa\xfa\x75\x1d\x82\xb5\xb5\xb0\xc1\xc2\x54\x82\x14\x47\xc9\x9a\x83\xbe\xfd\x3a\x06\xa3\x8a\x83\xc5\x3f\x92\xde\x1f\xc9\x83\xdd\xc1\xc7\xd2\x74\xd8\x9d\xa6\xf5\xed\x2d\x00\x85\xb3\x63\x52\x28\x3f\xb4\xae\xff\x91\xd8\x0e\x5a\xac\x9c\x25\xeb\xeb\x1f\xd2\x3a\xaa\x6c\xcc\x0f\x8f\xfa\x1f\xc9\x7a\xdd\x7c\xf8\x96\x3c\xbc\x4e\x74\x06\x58\xff\x86\x44\xd7\x8f\xea\x48\xf0\x02\xc7\x56\x9d\xbc\x53\x8a\x5a\x3c\x45\xb0\x9e\x8a\x55\x87\x62\xd5\x58\x09\x7c\x72\xed\x19\x7d\x7f\x71\xfe\x16\x82\xcf\x10\xfc\x80\x60\x0a\xfe\x88\x40\x94\x02\x5e\xc4\x1d\x58\x87\x20\x4e\xf5\x39\xf0\xc0\x04\x1c\x42\xe0\x43\xe0\x47\x80\xbf\x96\x7c\x31\xef\xad\xa9\x42\xa4\x0d\xfc\xd0\xc3\x5b\x1b\xaf\xef\xdf\x45\x49\xca\x3c\x57\xf6\xfa\x51\x9d\x1c\x6d\x42\x30\x8b\x6e\x60\x1c\xfb\x1e\x7c\x17\x45\x57\x27\xf9\x2e\x0c\x4b\xe6\x96\x28\x09\x4c\x4f\xe8\xc9\x3f\xf5\xe8\xca\x1c\xcb\x90\xfb\xcd\xf4\xa2\x64\xc8\xb9\xb5\xcc\x37\xf2\x8f\xe1\xc0\x2a\xdf\xe5\x47\x84\xf2\x14\xbe\xbe\xc7\x64\x2b\xfd\xc0\xd8\x31\xac\xc3\x7c\xe9\x45\x0c\x04\x98\x2f\x20\xe6\xa0\xa4\xd9\x2a\x6c\xe9\x4b\xfc\x72\x8a\xdc\xf8\x95\xa9\x48\xf6\x22\x44\x77\x0c\x93\x91\xd8\x52\x75\x62\x14\xa5\x19\xa3\x28\x84\xc0\xa7\xfc\x6a\x36\x69\x1c\xd1\xa3\xfa\xbc\x3e\x2b\x23\xf8\x3b\x04\xfd\x29\xc2\x80\x3d\xa3\x34\xc1\x0d\x8c\x13\x3f\x0a\x2d\xcd\xdc\x68\x98\x9d\x46\x53\x03\x64\xb6\x80\xf1\x91\xe3\x5e\x39\x43\x48\xfc\x80\x90\xa8\xff\x5e\x34\xd6\xe6\x68\xda\x5b\x3d\x6c\x9b\xfd\xc9\x05\x2c\x9a\xce\x11\x8e\x46\x66\xbf\x8b\x40\x8a\xe5\x69\xf7\xd3\x21\xde\x44\x93\xb4\x30\xe9\x0e\x28\x7b\xaa\x30\xb1\xae\x61\x8f\xb6\x33\x37\x36\xcc\x8d\x8e\xf2\x0e\xad\x3f\xa8\xe7\xf7\x50\x88\x37\x9c\xe2\x22\x82\x4d\x94\xf2\x13\x89\xad\x2d\x5d\xef\x49\x7b\x23\x1b\x5b\x80\x77\x45\x0d\xf5\x7c\x8b\x08\x4b\x53\x76\xdf\xa0\x44\x9e\xd2\xc6\x20\x98\x26\xa3\x93\xfb\xd0\xb5\x0b\xa1\xa5\xb0\xe6\xa0\x7a\x63\x0d\x8d\xf2\x02\x45\x2c\x94\x7b\xa6\x2c\x4c\x59\x7d\x6d\x8b\xea\x8b\x53\x5c\x48\x79\x00\xaa\xb0\x52\xb6\x81\x52\x98\xb7\x96\x8a\x4a\xbf\x9b\x4d\xeb\x29\xb2\x60\x42\x1d\x61\x2c\x58\x1b\x8f\x45\x68\x52\x84\xd4\xf4\xc8\x6e\xbd\xbf\x4a\x55\xd2\x82\x90\x43\x19\x79\x27\xc7\xbd\xb6\xc6\x44\x03\x8d\xaa\xcc\x4d\xf4\xfa\x7a\xfd\x43\xcc\xdf\x66\x62\x24\x50\x3a\xd6\x4c\xde\x5a\x51\xa2\xa0\x6f\x05\x78\x43\x46\xc7\x81\x0e\x31\xed\xd4\xca\xee\x63\xb5\xe4\x11\x85\x96\xa0\x49\x89\xcb\x14\x86\x85\xf2\x76\xec\x3b\x12\xff\xbf\xf5\x57\xe6\xe8\x96\xce\xcc\xfc\xd6\x37\xcb\x3a\x6f\x5d\xbc\xe4\x3f\x2c\x6a\xd0\xa5\xfc\x13\x10\xd4\x49\x27\xd3\x7e\x1a\x43\xb8\x1f\xa6\x51\xde\x1a\x69\xaa\x8c\x32\xee\x86\xea\xae\xcb\x47\x2a\x17\xa0\x5f\xfd\xda\x40\xda\x5d\xdd\x12\x3a\x9e\x54\xb7\x66\x82\x08\x93\x4a\x15\x93\x9d\x29\xa6\x39\x90\x45\x4a\x9b\x26\xb0\x46\x42\x11\x6a\x3d\x88\xd6\x86\x51\x9c\x26\x76\x58\xef\x6c\xe9\x0b\xa0\x33\x87\xc2\x31\x3f\xf3\x42\x66\xee\xd0\x7b\x08\xa9\xde\x83\x16\xbe\x19\xd5\x63\xb7\x83\x43\xc3\x7c\xf1\xe2\x85\x09\x22\x1b\x9e\xc7\xd8\x0e\x5a\xab\x67\x3d\x80\x56\xdc\x7f\x05\xf5\x08\xbb\x8b\x63\x5b\xd0\x08\xce\x4e\x01\x3c\x0f\x2f\xec\x08\x84\x76\x3c\xcf\xdd\x19\x47\xc2\x2c\x04\xcf\x9b\x17\x20\x67\x21\x55\x1b\x39\xb4\x23\x85\x76\xcf\xb4\x1a\x7e\x68\x9d\xb5\x61\x12\x4d\x88\x03\xbe\x90\xa4\x23\x58\x3b\xa4\x2d\x21\xad\x68\x22\xfa\x59\x63\xe3\xbf\xa2\x1e\xb3\x86\x5a\x7f\xd6\xe3\x67\xa6\x6e\x98\xc0\xb7\xe1\xb9\x73\x01\x12\xdb\x79\x66\x02\xd7\x86\xe7\x89\x58\xa1\xbf\xbe\xde\x7c\x11\x90\xab\x1a\x59\xa2\x4b\x12\x5d\xe0\xa3\xe5\x10\x6a\xb8\x0b\x50\x49\x3b\x04\xb1\x9d\xe8\x16\x49\xf3\x01\x42\x8d\xd3\x9c\x7c\x67\x97\xe3\x63\x86\x26\x94\xf9\xc8\xa3\x9b\xcf\xe7\xd2\xe3\x72\x72\x64\x97\xf1\x2b\x10\xfb\x36\x89\xe2\x14\xc7\x3d\x30\xd2\xfc\x77\x8f\x3b\x2f\x0d\x5f\x86\x16\x6c\xf8\x9e\x91\x36\x7c\x2f\x47\xe3\x63\x7f\xd4\x94\x77\xa9\x1d\xd5\x3f\x66\xbb\x38\xa9\x70\x43\x2e\xcd\xde\x56\xe8\x0e\x02\xca\x5b\x86\x37\x23\x63\xec\x53\xff\x2f\x1b\xd2\x36\xf5\x10\x10\xe0\x68\x29\x1c\xc2\x83\xb8\x7e\x80\x56\x16\xa4\x52\x4e\x6e\x12\xaa\xf4\xbe\xa2\x55\x26\xa2\x0f\xac\xed\xe9\xf9\x1e\x44\x54\x3f\xd0\xf5\x3d\xb4\x1a\x1d\xd4\x5d\x4a\x87\x82\xf8\xf5\x75\xaf\x4e\x8f\xb1\x08\x6d\x06\xe4\x2b\x71\xb1\x01\x3e\xdb\x43\x75\x7c\x5d\x5f\x27\x95\x4d\xea\xba\x0e\xde\x31\xef\xf7\xb1\xbd\x8f\x27\x1e\xbc\x51\x52\x0f\x75\xb0\x8b\xeb\x66\x35\xec\xae\xaf\xd7\xd7\xea\xbb\x52\xab\x5e\x84\xfa\xc3\x03\x5c\x5f\x5f\xeb\xd7\x75\xbd\xc7\x0e\xfd\x76\x33\xe6\x71\x5b\x32\x81\x3e\xdb\xe5\x9e\xd5\x20\x3d\xbb\x6f\xef\x36\x26\xfc\x3b\xb4\x1e\xd9\x46\x0b\x0a\xf5\xfc\x65\x87\x7a\x2f\xb4\x53\xe1\x71\x5b\x5d\x07\xaa\x38\x06\x2f\xb9\x6a\x5c\x6b\xd7\x26\x3c\x5c\x5f\x77\xea\x07\x3a\x40\x2d\x23\xfb\x4a\xe8\xb3\x47\xda\xc8\x6d\xf9\xec\xea\x64\x67\x8e\x5d\x75\x40\x5f\x03\x81\xd5\x03\xc2\xea\x01\xc7\x6a\xbc\x67\xb6\x66\x32\xc9\x9d\x66\xf3\xf3\x2e\x6b\x67\x8c\xf8\x6c\x72\x1d\x32\x45\xbd\x2e\xbe\x92\xce\x4e\x8d\x0c\xb3\x47\x8f\x99\x29\xc2\x56\xb7\x29\x9d\x2f\xe4\xfb\x9d\xd2\x7e\xb9\x09\x3b\xd9\x45\x42\x9a\xd4\x85\xed\x39\xf6\xa4\x3e\xc0\x4b\x84\x3e\x18\x61\xf7\x0e\x79\x30\xce\x8c\x75\x2c\xd2\xa0\xe2\x06\xe2\x21\x4c\x12\x67\x88\x37\x5e\x43\x18\x90\x8e\x1e\x93\xd6\xd1\xb3\xf8\x1b\x7e\xc1\x94\x33\x74\xac\x23\xa1\xa2\xfb\x0b\x52\xf7\xf5\xc6\xf5\xb5\x26\x80\x3a\x20\x98\xe6\xae\x43\xd9\x41\x26\x97\x3c\x72\x45\xfd\x06\x34\x75\x00\xe7\x73\x70\x6f\xe7\x5e\xe9\x7b\x22\x3e\xbb\x78\x8d\x3a\x87\x35\xee\xe7\x60\x20\xd8\x19\x8c\x3e\x2e\x40\x46\x7d\x00\x10\x39\x56\x7d\x6c\x43\x20\xd5\x8e\x16\x58\xd2\x7c\x3e\xb4\x39\x18\xf2\xd8\x65\xc2\x13\xc1\x07\xd1\xa8\x0f\x75\xb4\x80\x2d\x90\xb8\x66\xce\xc1\x88\x67\xcc\x20\x8a\x5d\xb8\x17\x3b\x63\x78\x2c\xd8\x72\xfa\x8c\xec\x87\x62\x56\x5e\xda\xd4\x03\xcd\x04\xc6\x68\xa5\x8e\x96\x03\xe0\x8e\x25\xa2\x56\x83\x5b\xf6\x95\xd3\x08\xae\xec\xcc\x71\x4d\x4e\x99\x24\x0c\xf9\x1d\xb8\x28\x4c\xa2\x80\xce\x52\x6f\xb2\x92\xf8\x35\x6a\x16\x2d\x13\x13\xda\x53\xc8\x0b\x05\xa7\xcf\x53\x45\xf8\xf5\x75\x8a\xbb\x81\x43\xc8\xd5\xb5\x2f\x23\x3f\xa9\xf5\xe3\xe8\x36\x81\x71\xcd\x8b\x60\x12\xfe\x2b\xad\x25\xc4\xbd\x6d\x4d\x89\xa2\x51\x3b\x74\xae\x60\x2d\x99\xc6\xb0\x96\x8e\x9c\xb4\x76\x1f\x4d\x71\xa8\xc8\x9a\x53\x9b\x44\xc1\xfd\xc0\x0f\x82\x9a\x1f\xd6\xa2\xc0\x83\x31\x43\x9d\x34\x6a\xa3\x34\x9d\x24\xd6\xf3\xe7\x83\x7e\x63\x0c\x9f\x93\x95\x10\x83\x4f\x34\x5e\x9f\x64\x2d\x79\xb3\x1a\xb9\x2a\x06\xfd\x9b\xa8\x45\x6a\xab\x70\xdf\xe3\x52\x79\xcb\xe3\x12\x8d\x02\x7d\xe1\x78\xb9\xa4\xe1\x1e\x72\xdd\xf7\xc9\xbe\x5b\x76\xb0\x51\x40\xe3\x13\xd1\x36\x47\xf8\xe9\x1e\xd1\x0d\x3f\x6d\xc3\x04\x27\x76\x17\x1c\xda\xcd\x9e\x62\x14\xd4\x64\xcd\xf0\xc2\x3e\x9c\xf3\x5b\x3d\xfa\x4c\x30\x79\xcb\x06\x09\xd4\x67\xcd\x17\xf0\xe1\xc1\x6c\x75\xff\x82\x2f\xa5\x6e\x13\x0b\xd5\x52\xe7\x0a\x26\xb8\x03\x48\x6c\xa9\x9a\x1f\xa6\xb5\x3e\x4c\x6f\x21\x0c\x6b\xcd\x9a\x13\x7a\x35\xb3\xd5\x05\x35\x54\xcc\x0f\x87\xb5\x01\x2a\x89\xd6\x57\x49\x6d\xe4\x0f\x47\x30\x46\x5d\x19\x22\x98\xda\x60\x92\xd4\xfc\xa4\x16\x46\x69\x6d\x1a\x26\xcc\x2d\xb3\xa6\x5b\x27\x76\xf3\x2f\xf8\x12\xdf\x65\x1c\x04\x51\x14\xd7\x4d\xd8\x7e\x0e\x75\xab\x4b\x36\x79\xbe\x90\xd0\x91\x82\x6a\x05\xc7\xf6\x17\x1c\xe3\xbb\xd5\x23\x7f\xcd\x46\x14\x8e\x09\x88\x5a\xc3\xee\xe8\x65\xea\xf5\xd0\x86\xcf\x4e\xf0\xa4\xbe\x43\x14\xed\xcb\xe3\xc6\x24\x4a\x52\x5a\x63\x9d\x1e\xe3\x72\x3d\xa5\xcb\x3a\x58\x51\x00\xb0\x33\x32\x54\x4e\xd6\xaa\x3b\x36\x04\x47\x0f\x0f\x08\x67\x13\x28\x0a\xcb\x6a\x34\xd4\x67\x3f\xed\x5b\x7e\xd1\x06\xeb\x72\x3b\xf4\x39\x5e\x3d\x0a\xba\xf5\xaa\xfe\x53\xc7\x62\x45\x84\xed\x00\xbf\xd4\x47\xff\x79\x65\x9b\x20\x9b\x76\xdb\x78\xda\x05\xc4\xec\x41\xff\xf9\x65\x8f\x78\x41\xe6\x5f\x9a\xdb\x5d\x5e\xc2\x0a\x2f\xe0\xf1\x05\x28\xd5\xeb\x75\xbb\xc3\x67\x88\x8f\xde\xed\x36\x9f\x77\x84\xa3\xa7\x67\x97\xd7\xd2\xea\xe7\xf4\x76\x4b\x58\x56\x0a\x5e\x00\x04\x96\x43\xd1\xaa\x12\x06\x0a\x7b\x22\xf2\xe6\x0e\xba\x53\x54\x80\xe7\xe1\xde\xc3\xc3\xbb\x87\x87\x7a\x66\x5c\x8a\xcb\xca\x12\x57\x01\x8a\xc1\xbb\x2f\x97\xdb\xc3\x87\x9a\x94\x28\x71\x81\x9f\x15\xc2\x76\x17\x5f\x2e\x84\x77\xa9\xd0\x2e\x6a\x1d\xed\x67\xd6\x11\x35\x8a\xe8\x6d\x18\x62\x04\xb7\xa5\xc7\x13\xa9\xbd\x3f\xa7\xaf\xac\x7b\xfb\x76\x2a\xee\xbb\xe6\x1b\x27\xfb\xf8\x71\x12\x57\x3b\x0e\x15\xa9\xe4\x92\xb4\xd6\xce\xdd\x2d\xd8\xbf\x84\x1c\xd1\xcb\x83\x64\x2a\x14\x2c\x3d\xb1\x2d\xd4\x86\xa3\x36\x9e\xec\x17\xa4\xcd\xb5\x08\xf2\x2d\x4a\xab\x5a\x24\xfb\x95\x10\x46\x1d\x70\xf2\xc7\x36\x92\xd2\x50\x4d\x2a\xdc\x25\x42\xe6\xb6\xd6\x69\x78\x30\x70\xee\x7b\xbe\x9d\xfb\x3f\xcf\x2f\xda\x35\xff\xf2\x5f\x06\xcf\x7c\x2b\x00\x4e\x31\xdf\xc1\x41\x86\xa2\x69\xfa\x32\xfb\x65\x21\x7b\x98\xda\xe5\x36\xfa\x0d\x7c\x3b\xc8\xe2\x3f\xd8\xfe\x33\x07\x40\x7b\xe6\x7b\xd6\xab\x67\xcf\x00\x13\x77\x2b\x04\xc2\xea\xc1\x82\x20\xb3\xc9\x2d\x1f\x88\x2b\x08\xcb\x01\xd9\xe2\xcc\x32\xcc\x39\xf0\x5f\x04\x2f\xeb\xdc\x4a\xd2\xf6\x41\x5c\xff\x08\xf2\xbd\x63\xb2\x6a\xc0\x87\x8c\xf5\x8f\x3a\x5a\x34\xbd\x9c\xd4\x75\xeb\x2b\x1a\x2e\x68\x05\xe0\x1b\x81\x8e\x6f\xe4\x73\x38\x1c\xbc\xcc\x83\x3a\x28\x8c\x2d\xa4\x36\x85\xee\xc9\x5d\x58\x28\x0e\xe2\x0a\x9d\x52\x87\x6c\x97\x10\x2f\xca\xd8\x1a\x99\x2c\xcc\xf2\x25\x5a\x98\xbd\xf1\x0e\xb3\x77\x64\x94\x5b\xeb\xeb\xa1\xb0\x72\x45\xdf\xd2\x22\x4b\x5e\x75\x3d\x3c\xf4\xeb\xe2\x20\xbd\x8d\x9d\x89\x52\x09\xd1\xa3\x35\x46\x58\xe1\x49\x61\x71\x34\x96\x84\x7c\x12\xe4\x79\xbe\xc2\xd6\x4f\xbe\xf9\xb2\xbe\x0e\x1b\x97\x97\x30\x39\x8c\x90\xfc\xbf\x84\xd6\x2c\x1b\x4b\xe2\x7e\x0d\xdd\xa3\x5d\xc3\x61\xc4\xc8\xa6\x7a\x34\xa8\x65\xdb\x9a\x68\x72\xfe\x72\x3f\x81\x64\x93\x4b\xdb\x71\x42\x34\xc3\x23\x7e\xd6\x9c\x9a\x1b\x38\x49\x52\x73\x90\x05\x91\x99\x5b\xba\xb0\xbf\xc3\xb0\x43\x0e\xdd\x31\x1c\xc0\x18\x86\x2e\xc3\x89\x5a\x5f\x1b\x39\xd8\x82\xec\x23\xc3\xc3\x0f\xfd\xd4\x77\x02\x3f\x81\x5e\xcd\x40\x46\x25\x8c\xeb\xba\x00\xe1\x92\x88\xc3\xd9\x5e\x68\xfa\xf0\xc0\xc6\x6b\x66\xb3\xa6\xbc\x11\x98\xa7\xbe\x84\x56\x5a\xd8\x52\x11\x36\xd0\x79\x0c\x6c\x6b\x41\xcd\x8d\x13\x44\x5a\x0d\xde\xe1\x67\x2e\x08\xdd\x78\x9a\xa4\x35\xe8\xa7\xc8\x32\xea\x43\xbc\x7d\x53\x8b\x62\x8e\x3d\x00\x1b\x48\xda\x33\x56\x83\xde\x83\x5c\x58\x2e\xba\x05\x4f\xb6\x4f\xeb\xe9\xfa\xba\x10\xd1\x8f\x0b\xd3\x65\xd1\xe7\x76\x10\xc0\x70\x8a\x2c\xb2\x7e\x00\xad\x35\x13\xdc\xc6\xe4\x25\x0e\x89\xd9\x18\x0e\xfc\xe1\x94\xe6\x61\x4f\x76\xe9\xfa\x7a\x9d\xd6\x91\xc0\xf4\x88\xa1\xfe\x34\x78\xa9\x4c\xc5\xbc\xb1\x90\x1c\x61\x2a\x2e\x2f\xed\x54\x9f\x53\x48\xb2\x54\xca\x5e\xee\xa4\x40\xcb\xa5\x4d\x63\xaf\x01\xd7\x9a\x73\x76\x4d\x99\x1b\x0c\x19\xf3\xa1\xfc\xa0\xb4\xd9\x0b\xff\x4a\xd9\x36\x5e\xc8\x8e\x68\x63\x3b\x3d\x0f\x2f\x7a\x71\x23\x6f\xab\xcd\x7f\x3c\x3c\xac\x99\x20\x6e\xf0\xed\x45\x1a\x27\x8f\x67\x13\x63\x3f\x21\x8c\x37\xf8\x8a\xa4\xba\x15\x10\xc4\x8d\x2b\x78\x0f\x62\x3d\xdb\x8a\xcb\xe8\xa6\x4f\x81\x98\xd2\x59\x5f\x47\x36\x5a\xde\x3b\xa1\x0e\x62\x9c\x86\xc3\xd1\xcc\xe7\x75\x1d\x24\x76\x58\x6f\xea\xc0\xb5\x63\xe2\x7b\x3a\xac\xb7\x74\x30\xb0\xe3\xfa\x54\x07\x9e\x1d\xd6\xcd\xae\x0e\x26\x76\x5c\xf7\x74\xd0\xb7\xc3\x7a\xb7\xa9\x83\x91\x1d\xd7\xfb\x78\x2b\xa0\xbe\xa5\x83\xa1\x1d\xd7\xc7\x3a\xb8\x41\xb0\x1b\x3a\xb8\xb7\xe3\xfa\x8d\x0e\x2e\xed\xb0\xbe\xad\x83\x3b\x3b\xae\x5f\xea\xe0\xb6\x3c\xca\x5c\x66\x64\x60\xf5\x92\xea\xc0\x21\xbf\xea\x69\xde\xa3\x0f\x0f\x94\x13\x43\xb1\xe7\x53\x5d\x57\xeb\xa6\xec\x58\x28\xa8\xe3\xf7\xc7\x7e\x3d\x05\xe7\xd8\xb7\xa6\x36\x71\xe2\x04\xbe\x4f\xa2\x50\x03\x37\xd2\x33\x6c\x3c\xc2\xc8\x3e\x3a\xe9\x5d\xe1\x10\x8c\xd3\x88\xf8\x38\x1f\x23\x42\x73\x21\xb1\xc3\xb3\x53\x83\x19\xce\xb8\xc4\x4b\x19\x4b\x43\x03\xc9\xa9\x91\x40\xda\xa8\x18\xab\x15\x62\x95\x49\x48\x22\xc7\x02\x05\x7a\xf8\xbb\x1e\x4c\x5b\xbb\xcc\xdf\x3d\x1d\x7e\xd4\x21\x62\x5d\xf3\xfc\x1b\x0d\xcc\xb0\xaa\xc3\x67\x87\xc3\x0c\x10\x8b\x13\x79\x89\x9e\xf8\x37\x70\x0e\x4a\x71\x60\xc8\x2a\x2c\x55\x65\xfb\x91\x77\xaf\x91\xf3\x9b\x72\xa0\x78\x21\xc4\x88\x42\x68\xa8\x7e\x4d\x5f\x06\xf2\x15\xeb\xf6\xe5\xc0\x71\xd0\x29\xe8\xd5\x5e\xa5\xcb\xc1\xef\x8c\xa0\x7b\x65\xf8\xe1\xca\x05\x34\x9d\x86\xe2\x9c\xe0\xab\xa0\xb7\x51\x7c\x05\xe3\xc6\xd8\x99\x08\x01\x72\x17\x75\x2c\xe2\x19\x16\x93\x94\x62\xb8\xf4\xbd\xaa\x7e\xf0\x28\x19\x69\xe3\x67\xd4\xbf\x0c\x9d\x31\xac\x22\xda\x5b\xd0\x21\xf7\x2c\x1d\xcc\x9c\x78\x98\x58\xb0\x91\x8d\x9f\x7a\xda\x40\x49\x97\x3f\x93\x28\xd4\xe7\xfa\x53\x6a\x99\xe4\xb5\xa4\x89\x45\xb7\xc6\xa1\x77\xe9\xa4\xbf\x17\x2f\x0e\x45\xe4\x87\x4b\xe3\xcd\x4a\xe8\xa8\x81\xba\x3e\x9f\x5f\x20\xb5\x59\xcf\x4b\xe6\x4e\xc1\x7a\xb7\xb8\x9f\xb1\x67\x4e\x7b\x46\xba\xca\x1a\x64\x80\x38\x62\xf3\xa7\x41\x3d\x4f\x21\x96\x80\xde\xf0\x93\x63\x78\x3d\xf5\x63\xe8\xd1\x4b\x65\xe5\xba\x92\x84\x5a\x0d\x41\x0c\x82\x5e\xa6\x30\xb3\x4b\xaa\xbe\x2d\x1f\x53\x02\xea\x56\xaa\xee\x23\x05\xdf\xec\xb9\x7f\xf9\x3d\xf7\xd9\x33\x3d\x39\x77\x2f\xb8\x83\x4b\x37\x73\xe2\x10\xda\xb1\xcd\xf4\x2f\xb2\x6d\x97\xd2\xc0\xc8\xcc\xa1\x6a\x18\x82\x73\x54\xf8\x82\xb9\x2a\x4a\x90\x35\x1d\x93\x23\x78\x7b\xd6\x9f\x26\xf7\xa7\x84\x31\xe7\x17\x80\xb0\xe8\x28\x8a\x02\xeb\xfc\x62\x0e\x02\x3b\x04\x4e\x3d\x06\x41\x85\xee\x76\x19\xb7\x77\x7d\x0f\x3b\x5e\xab\xd6\x99\xdc\xe0\xcb\xab\xfe\x7a\x7c\xb0\xbe\x3e\x80\x58\x65\x97\xe4\xeb\x8d\x74\x04\xc3\xba\xea\xce\x4a\x03\x49\x7b\x5d\x9f\xcb\x20\xa9\x3e\x4b\x91\x31\x9b\x50\x37\x6e\x75\xbe\xb1\x29\x3e\x9b\x2e\xa8\x02\xd4\x74\x35\x35\x42\xfe\xe3\xa8\x21\xa6\xca\xf9\x45\x2f\x15\x35\x0e\xd4\x67\x9a\xb6\x66\xc3\xc6\x28\x4a\x90\xe5\x48\x8e\x5c\x21\x22\x90\xa7\x9e\xeb\x9c\x10\x51\x9f\xcd\x55\x43\x98\xbe\x9e\x26\xf7\x28\x87\xb4\x4e\x35\x8d\x92\x25\xc6\x79\xee\x1b\x04\x35\x0d\x0b\x01\xc7\x68\x91\xae\x50\x9f\xc1\x5c\xc1\x25\x0d\x3f\x74\x83\xa9\x07\x93\x7a\x98\xa7\xea\xd8\xd4\x44\xf4\x86\x98\xa1\xbf\x75\x02\x4d\x88\x4f\xfc\x45\x13\xd4\x08\x3a\xb8\x2a\xa2\xea\x8f\xe2\xc8\x85\x49\x02\x2b\xa7\x9e\x09\xd3\x26\x39\x17\x72\xf6\xb2\x91\xaa\xd5\x08\x57\x6a\x13\x82\xb2\x0e\x13\xbd\x51\xd3\x80\x9a\x75\x59\x21\xc7\xc5\x1b\xa2\x04\x5f\x3d\xd1\x6b\xd1\x34\xad\x45\x03\x56\x90\xa4\xe3\xf8\xfd\x40\x6b\x68\x7a\x09\x0d\xf2\x9c\xc4\xce\x6e\x0b\x9d\x5d\xcf\x43\x3d\x2e\xb0\x44\x84\x19\x6b\x12\x45\xc1\xa5\xef\x81\xdc\xb0\xa8\x37\xc1\x1d\x43\xa0\xd7\x73\x33\x63\xe2\x84\x30\x00\xd2\x37\x0d\xe5\xac\x57\x4c\x7b\xff\xaf\x98\x3f\xf9\xcc\x83\xc6\x27\xd0\x2c\xd4\x93\x8d\x89\xef\x2d\x33\x5d\x65\xa6\x8d\xf6\x6f\x9b\x69\xb5\x03\x27\x49\x6b\xef\xa0\x13\xa7\x7d\xe8\xa4\x2b\xd4\x34\x62\x65\x96\xaf\x6b\x27\x0a\xc9\xf5\x43\xf7\x1e\xb3\xc1\xcd\xbf\x2b\x11\x2c\xc5\xe6\x99\x1b\x05\x27\x13\x27\xb4\xb4\x8e\x36\x07\xda\x09\x8c\x6f\x7c\x7c\x7a\x50\xde\xa4\x51\xde\x24\x3f\x85\x63\x2b\x37\xb1\x92\xb9\x8e\xc7\xd7\x6f\xa6\x2a\xac\x18\xe8\x4e\xe8\x61\xa6\x70\x3a\x93\x00\x1b\x5c\x29\xdf\x0b\xe0\xef\x27\x6b\xe9\x51\xf8\x9b\x47\xf9\x2d\x60\x76\x55\x88\xcd\x31\x6c\x92\xe9\xf9\xdc\xc4\x69\x3b\x0d\x48\x51\xd8\xc9\x4c\xd0\x54\xcc\x48\x15\x7a\x10\x3e\xb3\x15\x0c\x9e\xe3\x73\x95\x0a\x4b\xf0\x8a\xb7\x04\x05\xcb\x82\x33\x08\xc9\x6d\x70\x20\x4c\xf5\x85\xec\x39\x48\x59\x8a\x7d\xf5\xbf\x3b\x6c\xff\xbb\xc3\xf6\xbf\x3b\x6c\xff\xef\xed\xb0\x75\x4d\x6e\x87\xed\xff\x98\x7d\xb2\xdf\x61\x53\x4f\x03\x61\x3a\xc8\x4c\x82\xc6\x34\x98\xf3\xeb\x21\x34\x9b\x4a\xca\x97\xf7\x87\xfb\x57\xfb\x65\x69\x15\x81\x4f\x8d\xcd\x10\xa8\x2a\x0a\xfc\x39\x92\xdd\xb6\x6d\x87\x4f\xc2\x01\x79\x4a\xe9\x3c\xdb\x06\x5a\x6d\x1c\xc5\x50\xd3\x33\x87\x72\xd5\x3b\x04\x7d\x7e\x5e\xc0\x16\x44\xd5\xfe\x00\x99\x01\x84\xfd\x01\x6e\x36\xe8\x17\x67\x03\x7a\x55\xb6\xde\x6d\xe9\x3d\xe6\x3b\x2b\xf7\x3c\x87\x64\xdf\x3e\x3f\x87\x0d\x1f\xc4\x40\xd3\x2e\x2e\xc8\x88\x8c\xec\xd9\x68\x1c\xa3\x11\xda\x8b\x1a\x29\x8e\x18\x13\xc5\x63\xea\x3f\xb2\x17\xd6\x5b\xdb\x3a\xf6\xc9\xdd\x8b\x49\x30\xf7\x04\x3f\xd9\x63\x97\x7e\x59\xa2\xe2\xe2\x6f\x6a\xf3\x77\x83\x5b\x5b\x3a\xf6\xef\x4a\xd7\x6f\x98\x8c\x7f\x35\x2e\x5b\xad\xb6\xff\xc6\x98\x06\xb5\xd9\xdf\x61\xad\xe6\xf9\xc9\x24\x70\xee\xad\x9a\x1f\x06\x7e\x08\x7b\x28\x2d\xf0\x93\xd4\xc0\x2e\xb2\xac\x5a\x18\xd1\xc4\x89\xe3\x61\x1f\xbf\xb5\x66\xef\xef\x70\xfe\x77\xf8\x77\xd8\xb8\x34\x3b\xb7\xed\x5b\x23\xf0\xcb\x50\x49\x60\x96\x33\x48\x61\x4c\x80\x69\xfc\x36\x64\x6b\xd7\x34\x05\x68\xe0\x24\xa9\x81\x9f\x5f\x5a\x24\xaa\xbb\x5c\x0c\xdb\x62\x95\xe5\x94\xb5\xd1\xba\xfe\x85\xba\x03\xb1\x86\xf0\xd2\x9e\x4d\x03\x4b\xcb\x58\xa3\x81\xc0\xb7\xb4\x0c\xa9\x26\x1d\xb4\xa1\x65\x33\x63\x73\x71\x09\xae\xba\x2d\x46\xee\x7b\xad\xaf\xd3\x7b\x5f\x2c\x1e\x13\xbe\x54\xcd\xcf\x6f\x74\x6e\x1b\xf8\x77\x5f\xe3\x20\xc1\x97\xbb\xfc\x18\x26\x35\xa9\x1c\x09\x09\xba\x06\x15\xfe\xda\xa4\x67\x22\xa1\x4d\x15\xa5\x1b\x05\xcf\xb4\xe7\xcf\xb5\x67\x74\x95\x13\xdb\xe1\x33\x64\x04\xa6\x23\x64\x4b\x37\x62\xe2\x61\xbe\xfe\xfc\xef\xe7\xe7\xff\xf3\xf7\xf3\x8b\x3f\xff\x78\x0e\xb4\xe7\x5a\xee\x41\x39\x87\x98\xc6\xc1\xdf\xc9\x9f\x7f\xd7\xeb\xf5\x97\xd6\xf9\xff\xe8\xf5\x8b\x87\xbf\xb3\x9f\xcf\x1e\xfe\xae\xe3\x1f\x7f\xfe\xad\xeb\xf8\xff\xf5\xbf\xf5\xe7\x43\x1f\x14\x5f\x7f\xe1\x67\xac\xb1\x3f\xae\xeb\x39\xea\xff\xd1\xea\x8d\x3f\x75\xed\x8f\xe7\x40\xf9\x34\x20\x9d\xf3\xb0\xff\x42\xb0\xff\xaa\x80\x45\x3c\x7a\xfe\x3f\xf5\x7f\x3c\x78\x4e\xea\x58\x0f\xa3\x34\x9d\x58\x7f\x3f\xff\xfb\xf9\x03\xb9\x38\x86\x7f\x0e\xfc\x00\xe2\x5f\x7f\x3f\xd7\x9f\xfb\x8d\x14\x26\xd8\x3d\x8f\xc0\x43\x27\x3f\x69\x6f\xe2\xd8\x83\x7e\xe8\xc1\xbb\x4f\x83\x3a\xe2\xa7\xfe\x32\xb2\xe4\x54\x4d\x7f\x19\x3e\x8b\xac\xf8\x59\xc4\x91\xfb\x77\xe3\xef\xe7\xcf\x81\xa6\xe9\x40\x9b\xc6\x41\xbd\xf0\x3e\xd1\xd1\x9f\x69\xba\x86\x77\x7d\x1e\x31\xac\x9f\xff\xb9\xf6\x77\x58\xfb\xb3\xf6\x16\x86\xf8\x16\x96\x57\x9b\x26\x68\x61\x95\x8e\x60\xed\x75\x14\xa5\x49\x1a\x3b\x93\xda\xce\x34\x49\xa3\xb1\xff\x0b\xc6\xb5\x3a\xbb\x3d\x37\x84\x69\x9f\x01\x34\xdc\x68\xfc\xdc\x8b\xdc\xe4\x79\xbb\xd1\x79\xee\x32\xe8\xe7\x3a\xc6\x4d\x5c\xd2\xd7\x12\xe7\x06\x7a\xb5\x34\xca\xee\xdf\x0d\xfd\x24\x6d\x0c\xfd\x74\x34\xed\x13\x04\xce\x36\xec\xc0\x56\x77\xab\xd5\xde\x6a\x6d\x76\x9d\x2d\x77\x03\xba\xdb\x5e\xab\xdd\xef\xf7\x5b\x70\xbb\x85\x90\x3d\x67\x04\xe7\xc4\xdd\xb4\x1b\x9d\x86\x59\x41\x18\xa3\x62\x72\x1f\xfb\xc3\x51\x5a\x6b\x35\x4d\xd3\x68\x35\xcd\xed\xda\x97\x5b\x3f\x4d\x61\x0c\x6a\xfb\xa1\xdb\xc0\x50\x07\xbe\x0b\x43\x64\xc3\xa2\xc1\x18\xd7\x0e\xf7\xbf\x70\x88\x73\x52\xd3\xdb\x7e\xf2\x3c\xab\xe5\x79\x3f\x88\xfa\xcf\xc7\x4e\x92\xc2\xf8\xf9\xc1\xfe\xce\x9b\x8f\x27\x6f\x74\x46\x6d\x2d\xc4\x77\x97\xfc\x5f\xb0\xe1\x26\x09\xa2\xb6\xd9\x68\xd7\x1e\x30\x6a\x5a\x5b\xed\xa1\xc6\xe1\x0e\xa1\x1b\x05\x4e\xf2\x5c\x2c\xf7\xe7\xf3\x51\x3a\x0e\x66\x83\x28\x4c\x8d\x81\x33\xf6\x83\x7b\x2b\x71\xc2\xc4\x48\x60\xec\x0f\x7a\xc6\x38\x31\x52\x78\x97\x1a\x89\xff\x0b\x1a\x8e\xf7\x73\x9a\xa4\x96\xd9\x6c\xfe\xb3\x67\xdc\xc2\xfe\x95\x9f\xaa\x73\xe7\xfd\xc8\xbb\x9f\x8d\x9d\x78\xe8\x87\x56\x73\xee\xc4\xa9\xef\x06\x10\x38\x89\xef\x41\x1a\x3d\x3f\x01\x03\x7f\xe8\x3a\xd8\xc3\x2b\xc0\xb6\x1a\x04\x83\x28\x42\x5c\x23\xdb\x6b\x60\x34\x8c\xa3\xe9\x04\x8c\x1d\x3f\x04\x63\x18\x4e\x41\xe8\xdc\x00\xba\x43\x07\x92\xe9\x78\xec\xc4\xf7\x33\xa6\xe3\xfb\x41\xe4\x5e\xcd\x9d\xa9\xe7\x47\xc0\x75\xc2\x1b\x27\x01\x93\x38\x1a\x22\x5b\x1c\xdc\xf8\x1e\x8c\x32\x48\x32\x19\x18\xb8\x40\xef\x06\x22\xd2\x9c\xc0\x70\x02\x7f\x18\x5a\x7d\x27\x81\x28\x97\x20\xb2\xc2\x28\xad\x9f\xbb\x24\xe4\x61\x72\xa1\x67\x28\xf0\x1c\x44\x23\xa7\x37\xe7\xe7\xc4\x23\xcb\x05\x48\xe1\x78\x12\x38\x29\x14\xe0\xe6\xce\xac\xef\xb8\x57\xa8\x2d\xa1\x67\xb8\x51\x10\xc5\x16\x9e\x62\xc9\x03\xe2\xb9\x63\x91\x55\x3d\x70\xac\x51\x74\x03\xe3\x59\x34\x4d\x11\x09\x88\x6d\xfd\x7e\x7c\x9e\xfa\x69\x00\x2f\x66\xfd\x28\xf6\x60\x6c\xf4\xa3\x34\x8d\xc6\x84\x00\xcc\x79\x0f\xba\x11\xb9\x01\x62\x61\xc9\xc2\x13\x5d\x69\x4e\xcd\x8b\xd2\x14\x7a\xf3\x3e\x48\xd2\x38\x0a\x87\xa4\xdb\x6f\x49\x4b\xfa\x51\xe0\xcd\xbd\x41\x48\x12\xc9\x74\xeb\xa7\x4e\xe0\xbb\xf3\x91\x49\x13\xfd\x5f\xd0\x6a\xc1\x71\x8f\x75\x6d\x63\x63\x13\x8e\x6b\xcd\xf9\xd8\x89\xaf\xb8\x76\x5a\xff\x18\x0c\x9a\x3d\xd2\xd8\x7f\x34\x9b\xcd\x79\x32\x76\x82\x80\xc3\xb1\xd5\xfc\xe7\x3c\x99\xf6\x41\x32\x9d\x70\xa9\x9b\xdd\x7f\xf6\x70\xe7\x30\xde\xf6\xc8\x6d\xcf\x28\xb4\x70\x70\x4e\xff\x06\x96\xf6\x18\xc2\x94\x46\x13\xcb\x68\x36\xba\x70\x8c\x90\xcf\x28\xb3\x8c\x66\xa3\x85\x92\xfc\xf1\x90\xb2\xd1\x6a\xce\x93\x9b\x21\xee\x5e\x2b\x8e\xa2\x54\xc7\xef\x66\x07\x41\x74\x6b\x91\xbe\x9c\x13\x81\x64\x12\x6c\xc2\x71\xad\xd3\x9c\xdc\xcd\x47\xf1\x8c\xc9\x7d\x3f\xba\x43\x44\x23\x0b\x84\x4e\xe6\x28\xa9\x67\x8c\xa3\x5f\x65\x79\x25\xc9\x99\x20\x4d\x62\x98\x13\xe2\x4c\xd3\x68\xee\x46\x1e\x04\x57\x7d\x0f\x4c\x62\x08\x12\x67\x3c\x11\xc6\xe9\x38\x0a\x23\x1c\xc4\x13\xd4\xb2\x9f\xbd\x9c\x9b\x26\x1c\xcf\x49\x28\x35\x80\xdf\xa1\x83\x68\x92\x92\x21\x45\x5e\x73\x03\xf6\xe0\x7b\x46\x3a\xca\x0f\x47\x30\xf6\x53\x8c\x21\xfb\xc8\xc6\x30\xc1\x94\xd3\x77\xe3\x27\x7e\x3f\x80\xac\x06\x82\x72\x86\x25\x2f\xb3\x20\xc9\x00\xa0\x10\x48\xcd\xd4\xc8\x83\x78\xf2\xe2\x9d\xa4\x6b\x17\x80\x4f\x8c\x61\x02\x53\x29\x2d\x99\xf6\xc7\x7e\xaa\x5d\x64\xbc\x77\x26\x13\xe8\xc4\xf8\x51\x2d\x41\xd2\x73\xa7\x71\x12\xc5\x16\x0d\xd9\x45\xab\x3c\xf7\xfc\x04\x2d\xf2\xbc\x0b\xbe\xf2\x2c\x71\x46\x0b\x51\x6b\x9a\x16\xb2\x2c\xdc\x85\x38\x54\x9d\xe1\x87\x21\x8c\x09\x2d\xc5\xf4\x4c\x98\x7a\xcc\x14\x6d\xce\x31\xe8\x8c\x97\x61\xa2\x6d\xe7\x7c\x7b\xf0\x79\x62\x3f\xba\x93\x9b\x4e\x3c\x00\xa8\x24\x2c\x1b\xfd\x45\x01\xe3\xb2\xd4\xa9\x12\x71\xb4\x32\x7a\xc9\xef\xc2\xb2\x58\x75\xb8\x4d\x46\x32\xf1\x43\x83\x97\x9a\x52\xf8\x68\x9a\x8a\xf0\x33\xda\x62\x2c\xb9\x42\xff\x41\x27\x76\x47\xea\xfe\x43\x02\x33\xf0\x61\xe0\xf5\x7e\xfb\xc0\x52\xd2\x90\xd3\x4f\x52\x0c\x72\x53\x57\xd5\xe4\xd2\x22\xb9\x66\x55\xb5\x08\xcb\x3c\x6e\x52\x02\x53\x26\x23\xe6\xe4\xae\x96\x44\x81\xef\xd5\xfe\xe1\x36\xd1\xbf\x6c\x60\xd5\x5a\x13\xae\x8f\x1a\xed\x2e\xd2\xa7\x8d\x8d\x16\xf9\xbb\x89\x14\x57\x00\x87\x30\xf4\x54\xe2\x96\x8d\x60\x51\x6d\xb0\x81\x5e\x54\xef\x78\xd3\x83\x4d\x26\x6e\x14\xe0\x08\x9b\x16\xfb\xd1\xa3\x19\x48\x93\xd0\x0a\x3c\x90\x8e\x66\x79\x85\xc8\xee\x38\x89\xa6\xb1\x0b\xad\x9a\xc2\x7c\x19\x75\xfb\x13\x6c\x4f\x74\x8d\x7e\xe4\x07\x30\xc6\xf3\xa1\x60\xc6\x24\xb1\xfb\xdc\x4d\x92\xe7\x68\x5a\xa7\x06\xc8\xff\x87\xef\x6e\xd7\x26\xb1\x1f\xa6\xb3\x3f\xc1\x9f\x74\x81\x05\xfe\x24\x2b\xa6\x59\x3e\x8f\xd4\xd6\xfc\x31\x32\x3b\x9d\x30\x25\x93\x5c\x32\x72\xbc\xe8\x16\xf3\x9c\xcf\xe3\x66\x22\x6e\xae\xe5\x21\x04\x69\x2b\x41\x52\x9a\x35\x77\x80\x83\x15\x60\x0a\xbd\x59\xe9\x64\x3b\x77\xce\x47\x31\x1c\x5c\x64\xad\x20\x4b\x3e\xad\x56\xd7\x6a\x4e\x9a\xc6\x75\x94\xab\xd7\x90\x91\xcd\xcd\xf4\xa5\xd0\x38\x9b\x82\x63\xc4\xff\x63\x6b\xff\xd0\x28\x3c\xc8\x92\x7e\x3a\x37\x4e\xe2\xc6\xfe\x24\xb5\xb4\x02\x32\x0d\xcd\x31\x00\x9b\x3e\xd7\xd3\x28\x85\x0a\xf1\xdc\xde\xde\xee\x4d\x9c\x21\x34\xf0\xb5\x66\xc3\x0f\x91\xcd\x66\x39\x37\x91\xef\xcd\x53\x64\x99\x65\xd6\x0d\x96\x25\x83\x18\x6b\x06\x96\xb7\x79\x1a\x03\x34\xcb\x96\x95\x47\x79\x63\xe7\xce\xb8\xf5\xbd\x74\x84\x0d\x45\x9e\xa9\x13\x30\x6a\x81\x51\x7b\x16\xc5\x93\x91\x13\x26\x56\xbb\x77\xeb\x7b\xd1\x6d\x62\xb5\xe7\x24\x83\x43\x8b\xdb\x45\xb1\x36\xee\x7e\x19\xce\x6b\x23\x74\x6e\xfa\x4e\x2c\xda\x5e\x8d\xcb\xb6\xf1\xfd\x6b\x60\xf4\xd3\xf0\x45\xe3\x74\xf0\xfd\xf8\xcc\x70\x9d\x18\xa6\xa0\x71\xf7\xf9\xb0\xf3\xdd\xf0\xe2\x68\x32\x9d\xbc\x28\x03\x63\x03\x25\x8d\x26\x86\x52\x08\x51\x05\xc3\xab\xb7\x27\x46\xe0\xf4\x61\xa0\xe0\x26\xb2\x7d\x1a\x83\x77\xef\xba\x81\x51\x3d\xf4\x04\xa4\x5c\x81\x5a\xea\x01\xf1\x7b\x54\xb4\x27\xff\x31\x18\x0c\x24\xaa\xae\xbf\x5e\x1d\x93\x12\x06\xa9\x12\x2d\x91\x46\xa0\x2c\xc7\x53\xd0\xee\x79\x1e\x8f\x74\xfe\xe7\x6f\x9e\x99\xe6\x85\x81\xfe\x9b\xf1\xe7\x0b\x1b\x62\x0f\x35\x27\x77\xf9\xb2\xc5\x99\x18\x23\x7f\x38\x0a\x90\x6e\xa4\x6c\x8c\x87\x7d\xa7\xde\x04\xf8\x9f\x4e\x96\x30\xbc\xb9\xa5\xbd\x83\xc1\x0d\x44\xc6\x67\xed\x23\x9c\x42\x0d\x64\xdf\xe0\x55\xec\x3b\x01\xe0\xd6\x4d\x5c\xad\x9d\xc9\x9d\x60\xd4\x9a\x8d\x4e\x6b\xab\xbb\x69\x76\xda\xcc\x40\x6e\xb7\xdb\x3d\x65\x9f\x92\x19\x0c\x08\x26\x56\x6e\xb5\xf1\xb4\xf1\xb6\x1b\xa9\x97\xa5\xf0\x55\xd3\xb4\x39\xb3\xf8\xfe\xd1\x6e\x6f\x3a\xfd\xcd\xc2\x7a\x81\xac\x5b\xc8\x7a\x04\x38\x16\xb6\x79\x58\x91\x56\xbb\xdb\xda\x74\xcb\x97\x18\x73\x06\xcf\xd6\x31\xdd\xc9\x5d\x0d\xcd\x4a\x35\xc6\x7a\x62\x42\xc5\x7e\x38\x24\x4d\xed\x51\x48\x23\x1a\x0c\x12\x98\x5a\x46\x6b\x72\x27\x19\xe0\x4d\xac\x3a\x24\xd3\x7f\xec\x7b\x5e\x80\xc6\x78\xeb\xd7\x71\x9a\x18\xfe\x78\x68\xc4\xd9\x11\xbf\xb8\x30\xec\x89\x5a\xa7\xc7\x5b\x2a\x8d\x4b\xb3\x63\xde\x9e\x92\xf2\xa8\x03\x20\x1b\x0c\x06\xb2\xc8\xa6\x89\xb5\x31\xb9\x43\x43\x6a\x7c\xf4\xed\x35\x86\x4a\x47\xd3\x71\x3f\x74\xfc\x20\x9b\x18\x2b\xfa\x58\xd9\xb1\x3d\xe5\x68\xeb\x89\xd5\x76\x78\x71\xcd\x43\xf1\x38\x41\x50\x6b\xb4\x92\x1a\x74\x12\x68\xf8\x21\xb2\xc3\x7a\x46\xb4\x08\x62\x41\xb6\x72\x71\x5c\xcd\xb4\xc9\xdb\xb7\x63\xcc\x0e\xd7\x8f\xdd\x5c\xb3\x51\xe2\xbb\xcd\x7f\xa2\xd5\x12\xe9\x3f\xa4\x40\xad\x16\x1a\x7e\xf4\x9b\xae\xcc\x70\x52\x66\xd6\xe4\xca\x96\xe7\x0b\x84\xb8\x8b\x27\x9e\xf1\xc3\x48\x62\x23\x0a\x83\xfb\x59\xb6\x2a\x74\xfa\x49\x14\x4c\x53\xd8\xa3\x64\x4e\xb2\xd5\x94\xc9\x5b\x55\xcc\xd8\x32\x50\xaa\xb4\xd4\xeb\xb9\x81\x3f\xb1\x62\xe8\xa6\xf5\x26\xa8\xd1\xff\xe9\x19\x55\xa8\xee\xe8\xf8\x76\xca\xea\x26\xd2\x8b\x8f\xd1\xe8\xba\xbd\x1c\x80\x8c\x83\x8c\xd8\x24\x75\x52\xdf\xa5\xa4\x22\x2e\xf2\x1c\xcd\xec\xc1\x9e\xbc\xc2\x22\x04\x62\xae\x9f\xc7\x51\xc0\x2d\x9c\x66\xd2\xaa\x67\x64\x92\x19\x14\x8c\x3a\x60\xd4\x05\xa3\x0d\xd0\xd8\x73\x77\x5f\x6f\x1b\x23\x13\x51\x79\x9c\xbe\xd9\x32\x46\x2d\xf4\xf3\xdd\xaf\xed\x33\x63\xd4\x46\x3f\x9b\x5f\xdf\x34\x8d\x51\x07\xcd\x0c\xfe\xb0\xf3\xdd\x18\x75\x41\xe3\xd2\xdc\x3f\xed\xfb\xc6\x68\xa3\x5c\xc7\x50\xa3\xb2\xdb\x6c\x4a\x82\x6f\xf6\x84\xd5\xe4\x7c\x64\xd6\xf0\xe2\x1f\x8c\x5a\xec\x47\x9b\xfd\xe8\xb0\x1f\x5d\xf6\x63\x83\xfe\xc8\x08\x67\xdf\x19\xf9\x79\x02\x6d\x44\x9e\x40\x9b\x92\x25\xb0\x06\x65\x09\xac\x59\xac\x36\xb3\xd6\xb8\x34\xef\xae\xdf\xc4\x46\x46\xa2\x94\xd0\x96\x13\x3a\x72\x42\x57\x4e\xd8\x90\x12\xf2\xa6\x48\xe9\x79\x93\x0a\x19\xac\x69\x85\x0c\xd6\x44\x39\x23\x6b\xaa\x9c\x91\x35\x59\xc8\x10\x16\x06\x1d\xb9\x0f\xd9\xbc\xb4\xb9\xb9\x89\x64\x2a\x17\x22\x22\x3c\x4c\x8e\x88\xfc\x50\x52\x17\x8c\x74\x13\xef\x9e\x98\x25\xbd\xcb\xf7\xaa\xd8\xcd\x7c\xf7\x8a\xfd\x5d\xe4\x67\x09\x9f\x55\xfc\x55\x33\x5e\xc5\x70\x65\x4f\x70\x46\xc5\x46\xf7\x9f\x73\x3c\x7a\xb2\x81\x84\x07\x50\x36\x96\xd0\x20\xcc\x87\x13\xc7\x24\xb3\x8c\x49\x9d\x32\x81\xe6\x04\x59\x92\xec\x7c\xd8\x14\x44\xbc\x28\x2a\x25\x32\xa4\x90\x9d\x12\xa1\x2a\x4a\x78\xa5\x94\xb1\x9d\x3d\x41\x94\xb8\xac\x36\x9a\x5e\x05\xc9\xe2\x33\x31\x4b\x04\x41\xe3\x36\x22\x3b\x98\x5f\x5c\x9b\x78\x6b\x6f\x0b\x65\xf2\x7d\x31\x13\x8d\xb2\xb9\xd8\x35\x5c\x26\xb2\x3f\x26\x99\xe9\x51\x6b\xd6\x70\xc7\x34\x5e\x7f\x89\x0e\xc6\x46\x80\x96\x40\x8a\x69\x8c\x2b\xbf\xc1\x3e\xe9\x00\x6b\x17\x94\x64\x67\x4e\xd7\xbc\xf5\xb1\x1f\xd2\x39\x76\x73\x63\x6b\x72\xa7\xcf\xf8\x6a\xb8\xa6\x9a\x93\xbb\xf9\x3c\x63\xb7\x92\xc7\x5b\xdd\x7f\xe2\x7d\x58\x04\xb0\xf3\x75\x10\x18\x78\x53\x96\x4d\x82\x8d\x16\x1c\xab\x8c\x11\x77\xb0\x05\xdb\x68\x3e\xf7\xde\x8c\xde\x92\xdd\xfc\x00\x0e\xe8\x4e\x1e\x31\xb4\xd0\x37\x82\x68\x1b\xc7\x07\x04\x02\x9f\x77\xf0\x20\x38\x01\xc1\xb4\x3e\x5f\x5d\x11\x18\x17\xa2\x49\x89\x07\x22\x29\x68\x46\xdd\x7f\xfd\x3e\x22\x50\x3f\xa7\x49\xea\x0f\xee\x79\x30\x9a\x84\xe0\x76\xb7\xda\x21\x81\x0b\xa3\xdb\xd8\x99\xcc\x6e\x47\x7e\x0a\xf1\xfe\x04\xb4\x48\x12\xb2\xcc\x26\xfb\x07\xa7\x94\xf4\xe8\x16\xc6\xae\x93\x40\x79\x27\x32\xcb\x98\x37\xde\xbc\x7b\xeb\xed\x11\xf0\xe9\x64\xa2\x06\xcf\x32\xe6\x8d\x8f\x1f\x8e\xa2\x5f\xb4\x49\xce\x04\x6f\x88\xff\x2a\xc0\xe7\x39\xf3\xc6\x97\x83\x43\x9f\x16\x18\x4f\x53\xe8\xcd\x38\x75\xda\x18\x1b\xdf\x52\x93\x64\x4e\x62\x1f\x1f\x61\x08\xb6\xf8\xdc\x51\x80\x30\x4b\x5c\x95\x25\x1a\xe7\x5b\x1b\xcd\x6d\x64\xb3\x98\xc3\xf7\x9f\x77\xe9\xd9\xcc\xd4\x75\x61\x92\x81\xb4\xdd\xcd\x8d\xb6\x37\x77\x54\x30\x59\x3d\xaa\x3c\xb1\xa2\x7e\xb7\xd3\x72\x11\xef\xc3\x0f\x6f\xdf\x13\x40\x3f\x1c\x44\x59\x2d\xe6\x66\x73\x6b\x80\x6a\x91\x00\xf2\x2a\xe4\x0c\x11\x7f\xa7\xdb\xda\xd8\x46\x0d\x39\xd8\x3a\x72\x09\xd4\x2d\x89\xc9\xc5\x40\xb6\x9c\x0d\xaf\xdd\xc7\x0d\x29\xc0\x70\x0d\x29\xe6\x09\x15\x6d\x6c\x74\x4d\xdc\x90\xd6\x67\x78\x7d\x47\x00\x49\xd0\x33\x06\xe1\x6c\x77\x3a\x9d\x16\xaa\xa7\x00\x92\x57\x53\xcc\x12\x6a\xd9\xea\xb4\xbb\xed\xce\xbc\xf1\xed\xed\xd6\xc6\x89\xd1\x1f\xca\x7d\x8f\x97\x04\x85\xb1\x99\x49\x84\x5c\x2c\xab\xb7\x90\x41\x6a\x2d\xa2\x62\x82\xb1\x37\x6a\x6e\x3b\xa8\x00\x93\x8a\x22\xa8\x37\x18\x34\xbd\xad\xb9\x53\x80\xcd\x6a\x2d\x64\x94\xd5\xea\x9a\xb0\xd5\x47\xca\xa5\xbd\x6b\x74\x23\x54\x02\xcb\x88\xa2\xce\x6d\xe8\x0d\x36\xb1\xb8\xf0\x90\x9c\xb0\x08\xc9\x65\xf5\x39\x03\x6f\x9b\x2c\x17\xda\x67\x1f\x43\x04\xce\x64\xa6\x54\xf1\x39\x45\x60\xae\x57\xe5\x9c\xb2\x8a\x07\x9b\xd0\xed\x77\xf1\xb8\xdb\xd8\x7c\x85\x4a\x50\x19\x52\x80\xb6\x3c\xe8\x41\x32\xfe\x04\x58\x7e\xf0\x89\x19\x65\xb5\xc2\x4e\x7f\xbb\x8f\x06\x49\xdb\xb9\x3f\x18\x19\x78\x7b\x8c\x6c\xc7\x31\xad\xcf\x66\xa8\xed\xcc\xd6\xb0\x3a\xcd\xc9\x5d\xad\x59\xe3\x96\x5e\x99\xf9\x21\x2e\xba\xa6\x01\x88\x02\xde\x62\x69\xaa\xcc\x95\x69\x50\xc3\x80\xe8\xbf\xd3\xa0\x16\xe1\xdf\x79\x39\x0a\x8a\x97\x51\x69\x7f\xe3\xd8\xc0\x57\x79\xa6\x21\x3e\x5d\xf4\x32\x32\xd1\x0c\x63\xa1\x49\x32\xbb\xe7\x43\xb7\xef\x5a\xce\x91\x3f\x25\x85\xc8\xd2\x74\x41\x11\x46\x22\xce\x35\xba\x78\xe1\x5e\xc0\xf1\x22\xf0\xd5\xa7\xc1\x0c\x77\x4c\xd6\x36\xf9\x12\x92\xe0\x43\xe8\xbc\x4a\x96\x20\xae\xce\xbd\x14\x78\xde\x4c\xbd\x1d\x30\xf7\x52\xc1\xf6\xde\x6c\x36\xe7\x5e\x66\x4f\x90\x36\x55\xd8\x06\x97\xad\xdd\x1f\xd1\xd8\xf0\x02\x63\x14\xc5\xfe\xaf\x28\x4c\x9d\xa0\x86\x50\x06\x91\x93\xe2\x79\x9a\xad\x84\x37\x50\xff\x62\xbf\x51\x24\x59\x9e\xab\x0b\xcb\x60\x0c\x90\x25\xc2\x20\xf0\x27\x89\x9f\xf4\x94\x13\xae\x92\x0a\xb1\x15\xe6\x16\x62\x05\xbf\xb1\x0d\xf0\x6f\xcf\x49\x1d\x23\x8a\xfd\xa1\x1f\x3a\x81\x41\x0f\xb7\xe9\x3a\x76\x04\x03\x3c\x9d\x6f\xbf\xbf\xda\x35\xb2\x7b\xbf\x63\xce\xc4\xd9\x6e\xfe\xb3\x57\x3a\x53\x73\xdb\xda\xcc\xe8\x41\x22\x5a\xe3\x96\x23\xd8\x9a\x93\xcd\xb5\xcd\x46\x37\x1f\x0b\xac\xa3\xf9\x91\x90\x23\xae\x4d\xb8\xfb\x5c\xdc\x3e\x7a\x6d\x1a\x94\x64\x44\x7c\x46\x61\x54\x70\x80\xf4\xee\x03\x97\x42\xac\x3d\x2e\x41\x34\xfc\xc4\x0d\x2e\xe1\x68\x7d\xc1\x7e\x23\x32\x44\x0a\x35\xb3\xbd\x58\x99\x00\x45\xba\x40\x07\xcd\xcf\xcf\x18\xfe\xfe\xbb\xd5\x34\x3b\xb5\x5a\xed\xef\xbf\x5f\x35\x35\xd4\x9f\x9f\xae\xf6\x0f\x8c\xbc\xbc\x11\xc3\x1b\x18\x27\x3c\xca\xc6\xa5\xb9\x7b\xbb\x7f\x6c\x4c\xa6\x41\x40\x8d\x4b\x71\x2c\x9a\x85\xc1\xd8\x2c\x8a\x34\xdb\x81\x62\xa3\x97\xeb\x43\xa1\x7b\x9b\x15\x44\x95\x72\xa3\x48\xa2\x04\x5a\x81\xb3\x84\x91\x0a\x94\x02\x64\x05\x46\x55\x17\x54\x63\xae\xee\xb4\xaa\x7e\x62\xed\x24\x07\x4d\xcb\x70\x84\x40\x2e\x64\xc8\x12\x08\x79\xc0\xa5\xd9\xb1\x04\x5e\x45\x01\x5e\x82\x5f\x35\xb1\xfc\x22\x41\xd6\xe6\x8e\xe7\xc5\xc8\x54\x2a\x5d\xf4\xd1\xd9\x27\x1e\x3b\x41\xc9\xc8\x9b\x37\xde\x0e\xbe\x0d\xce\x8c\x2c\x34\xc3\x42\xf1\xc6\x29\xb4\xc6\x58\xde\x25\x24\x20\x78\x4b\xb0\x7c\xae\x90\x6b\xa4\xb9\x5d\xac\x97\x8b\xc5\xb6\xb7\x5b\x15\xc5\xb6\x37\x4b\x8a\x99\xad\x66\xb3\xa2\x9c\x69\x92\x82\x8d\x4b\xf3\xcb\xf0\xdd\x49\x9e\x6f\x0c\x82\xa9\xef\xfd\x36\x36\x34\x2e\xdb\xdf\x7f\x0e\xbf\x19\x71\x74\x3b\x13\xc0\x0d\x1e\x03\xb1\x0a\x4c\x62\x16\xb4\x8d\x37\x77\xdf\x51\x01\x23\x8c\x8c\xe1\x34\x4d\x61\x9c\x88\x65\x9b\x3d\x71\x5e\x56\x97\xa9\x9d\xe3\xfb\xe2\x7f\xda\x9a\x1b\x05\x86\x76\x21\xb5\xa9\x29\xa9\xad\x79\xe3\xb2\xb3\x6f\x9e\x61\x23\xce\xb8\x4b\x0c\x13\xd4\x1a\x3f\x7e\x7e\x70\xda\x38\x21\x19\xe3\x84\xcb\xd6\xf5\xe7\xef\x2e\x4e\x19\x7b\x34\xa5\x99\x52\x98\x60\x48\x53\x76\xc3\xc9\x3d\x43\xd3\x42\x29\xa6\xdf\x9a\x0e\x19\x1e\x9c\xd2\x4a\xdb\x9b\xa7\x0c\x0f\x49\x79\x17\xb4\xef\x19\x1e\x94\xf2\xe9\xfb\x64\xeb\x92\xa1\x69\x63\x90\x9d\xb0\xbb\xc7\xd0\xa0\x94\x57\xfd\x36\x7c\xc7\xb0\x10\x90\x8d\xb7\xc9\x19\xc3\x42\x52\x8e\x5f\xa5\xbf\x18\x9a\x0e\xa8\x35\x06\x4e\x73\x3f\x66\x58\x50\xc2\x68\xd2\x7c\x33\x65\x58\x3a\x98\xde\xcd\x9b\xed\x6b\x86\x05\xa7\xb4\xce\xda\xb7\xc7\x0c\x4b\x17\xa5\xb4\x47\x97\xaf\xdf\x32\x34\x28\x25\x31\x0e\xaf\x5a\x0c\x0d\x01\x99\x44\x5f\x5a\x0c\x0d\x4e\x69\x1d\x1c\x0c\x7c\x86\x66\x03\xa7\x4c\x6f\xe2\x13\x86\x06\xa7\xb4\x9d\xd3\x7e\x97\xe1\xc1\x29\xe6\xd5\x87\x74\x83\xe1\x21\x29\xc1\x99\xf9\x8d\xe1\xd9\xc4\xa5\x0e\x7f\x0c\x32\x16\x93\x14\xf7\x7d\xb4\xc1\xf0\x90\x94\xbb\x5f\xc7\x4d\x86\x67\x93\x30\xfd\x6e\xef\x8e\xe1\xd9\xc2\x29\x43\xdf\x7b\xc3\xf0\x90\x94\xa3\x63\xe7\x84\xe1\x41\x29\x07\xef\x0d\x23\x61\x68\x08\x48\xf4\x69\xf0\x93\xa1\xd9\x26\xfd\xf0\x7a\xbf\xc3\xd0\x90\x94\xdd\x91\xf7\x96\xa1\xc1\x29\xed\xeb\x8d\x78\x9f\xe1\x41\x29\x93\xb1\xff\xfa\x7b\x26\x7f\x4d\xc4\xd3\xee\x15\x59\x56\x60\x01\x6c\xe2\xa6\x47\x1f\x77\x0e\x33\x09\x6c\x12\xd4\x67\xa7\x3f\x32\x11\x44\x49\xf7\xa7\xa1\x9f\xf1\xd9\x44\x52\x19\xf8\x1f\xbf\x66\xb2\x63\x12\x39\xdd\x19\x7f\x85\x19\x26\x9c\x64\x5e\xff\x3c\xd9\xcd\x30\x11\x28\x6f\xe8\x1a\x19\x2a\x2c\xaa\xed\xfb\x60\xa7\x9b\xe1\x22\xd2\xbb\xbb\x73\x9a\x8f\x0b\x92\xb4\xf7\x31\x0e\x33\x5c\xad\x59\xf1\xca\x20\xd2\x56\xc5\x63\xa1\x4a\x9d\xa3\x1a\xa5\xc5\x01\xa7\x18\x3a\xf2\x28\x50\x48\x74\x51\x38\x8b\x62\xa6\x10\x18\xb9\xef\x8b\xbd\x58\xec\x0d\x99\xab\xdc\x42\x61\x5e\xcc\xcc\xcf\xfa\xe6\x32\x2e\x36\x0d\x98\x8d\x0d\xf2\x7f\x9b\xff\x9c\xcb\x14\x50\x98\xad\x76\xa3\x4d\xff\xef\x9f\x73\x99\xee\x6c\x16\xc2\x59\x42\x23\x69\xd6\xc6\x06\x5f\x85\xc4\x1a\x0a\xd3\xdd\x12\xab\x10\x18\xca\x60\x9a\x38\x4b\xe0\x3e\xcd\xea\x08\xad\x90\xfa\x8c\xc2\xb4\x85\x56\x88\x1d\x4d\x41\x5a\xa4\x11\x82\x50\x30\x1e\x8a\x8d\x10\x45\x89\xf1\x89\xaf\x60\x7a\xb5\x75\xf0\x99\x41\x60\x3b\xc5\x6c\xcd\xa8\x80\xe2\xfe\x08\x3f\x1f\x19\xdf\x45\x00\x93\x02\x88\x9d\x72\xd9\x3e\x6d\xbd\xbb\x14\x21\x9b\x14\x52\xea\x1a\x73\xeb\x7a\x7f\x24\x40\x6e\x53\x40\xdc\x3f\x3b\x6f\x86\x93\x40\xc8\xde\xa2\xd9\x52\x1f\xb5\xbf\x7e\x3b\x14\xf1\x6c\x52\x40\xa9\xa3\xcc\xa3\x37\xa3\x1b\x01\x70\x83\x01\xa2\x36\x7e\xbb\xdb\xbb\x39\x15\xb2\xbb\x34\xbb\x23\x37\xf1\x7a\x92\x0a\x80\x1d\x0a\xd8\x96\x5a\xd8\xfa\x01\x3d\x01\xb0\x4d\x01\x49\xe7\xb5\x27\x46\x2a\xb2\x3d\xe3\xba\xd0\xc4\xe3\xdd\x37\xd7\x12\xf7\x19\x4b\x45\x49\xdc\x33\x4f\x45\x7c\x8c\xf5\xec\xd4\xfc\xd5\xd1\xcd\x7e\x0e\x90\x8c\x50\x3f\x13\xad\x83\xbb\xf9\xb2\xbd\xb3\xd9\xde\x15\x01\x4c\x02\x20\x76\xf3\xa8\xbb\xf1\xee\x4e\x84\x6b\x12\x38\xb9\x93\x8f\xb6\xdb\x47\x02\xe0\x36\x81\xa3\x63\xf0\x63\xff\xf6\x56\xc8\xde\x22\xd9\xf2\x38\xfc\xd0\x86\xaf\x04\xb8\x4d\x02\x27\x8f\xc5\xf0\xb5\xfb\x45\x80\xdb\xa0\x70\xa4\x79\x13\x98\x8a\xd4\x74\x49\xb6\x3c\x26\xf7\xaf\xaf\x4f\x05\xb8\x0e\x81\x13\x3b\xf8\x70\x27\x9d\x6e\x08\x60\x6d\x02\x46\xbb\xf7\xe8\xd0\x8b\x85\x6c\xc6\x6c\xa9\x71\xb7\x5f\xb7\xdb\x22\x33\x29\x2f\x45\x56\x8e\x3f\x7f\x9e\x08\x60\x94\xe5\xb4\x73\x5b\xdb\x6f\x7e\x64\xaa\x8e\x5c\x66\x41\xdd\x2b\x6c\x8b\x90\x5e\x6e\x8d\x06\x74\x6a\xe7\x20\x4d\x01\x52\xec\xee\xa3\xaf\xfe\xd1\x54\x2e\xd0\x14\x0a\xc8\x7a\x77\xe3\x74\xef\x58\x2a\xb1\x2d\x14\x20\x02\x60\xba\x3b\xef\xa0\x04\xb7\x25\xc0\xc9\xa3\xfd\xfb\x97\x74\x5b\x2a\xb0\x29\x14\x90\x44\xa2\x1d\x4f\xaf\x4d\xa9\xc0\x86\x58\x80\x30\xe5\xfd\x8d\x77\x2b\xc1\x75\x05\x38\x59\x0d\x1c\xb8\xe6\xae\x54\xa0\x23\x14\x90\xd5\xc1\xe1\xdb\x6f\x7d\xa9\x40\x5b\x28\x40\xe5\xe6\xdb\x9b\x83\x6f\x12\x9c\xd4\x8f\x92\x00\x5d\x7f\x79\x27\x37\x51\xec\x4e\x89\x25\x93\xce\xf8\x48\x82\x6f\x2e\xbd\x0b\x58\x5c\x2e\x28\x0c\x7f\xd9\x86\x2f\x98\xe3\x45\xcb\x5a\x65\x24\x17\xcc\x5d\x85\xe1\x2a\xdb\xa0\x45\x6b\x52\x61\x15\x4a\xc6\x9d\x68\x99\xc8\x99\xbc\x65\x22\xe1\x52\x5a\x26\x12\x05\x25\x96\x89\x40\xb7\x68\x99\x08\x8d\x54\x5b\x26\x22\x6b\x4a\x2c\x13\x81\xa1\x82\x65\x22\x72\x5f\x69\x99\x88\x5d\xa6\x34\x4c\xa4\x7e\x16\x2c\x13\x51\x28\x94\x96\x89\x28\x49\x2a\xc3\xe4\xb2\x15\x0c\x9d\x8f\x0c\x44\x65\x99\x5c\x6e\xef\xbe\x31\x8f\x45\x08\xb5\x69\x72\xf5\xe6\xd7\x95\x21\x02\xaa\x2d\x93\x8f\x69\xeb\xa7\x27\x00\x0a\x86\xc9\x65\x3b\xb9\x3d\xda\x11\xf2\xcb\x2c\x93\x77\xdf\xbc\x53\x01\x50\x6d\x99\xdc\x7e\x79\xb3\xe7\x08\x70\x82\x61\xf2\xe5\xe0\x7a\xe7\x5e\xc8\x2e\x31\x4c\xcc\xee\xe5\x7b\x91\x30\xb5\x61\x72\xf5\x61\x3a\xfc\x20\xc0\x89\x76\x89\x39\x1c\xdc\xfe\x10\xf2\xd5\x76\xc9\xd7\x83\xb7\xaf\x8f\x44\x8e\xaa\xed\x92\xcd\x0d\x53\xe4\x83\x68\x97\xdc\x7c\xbb\x4e\x6e\xf2\x7c\x85\x59\x12\xc7\xbf\x5e\x8b\x00\x4a\xb3\xe4\xd2\xdc\xf0\xdf\xfd\x10\x01\xd5\x76\x49\x7b\xf8\xa9\x73\x29\x00\x8a\x76\x89\xff\x85\x17\xbb\x72\xbb\xa4\x7b\x78\xfd\x45\x80\x53\xdb\x25\xed\xd3\xd7\x3b\xbb\x02\x9c\x68\x97\xdc\xfb\x1b\x77\x42\xb6\xd2\x2e\x79\xe7\x5f\xa6\xae\x00\xa6\x34\x4b\x2e\xdb\x3b\xbb\xad\x33\x01\x4e\xb0\x4b\x5a\x1f\x8e\xbe\x4d\x85\xec\x12\xbb\xe4\x26\x4e\xa1\xc8\x4c\x95\x5d\xd2\xf2\xdf\x9e\x1c\x08\x60\x92\x5d\x32\x7c\xfd\x29\xd3\x11\x95\x76\x89\x19\x5e\x5d\x1b\x32\x64\x95\x5d\x72\xd9\x76\xfb\x07\x05\xdc\x95\x86\x89\x79\x76\xfd\xf5\x4a\x2a\xa1\x30\x4c\x82\xee\x01\xdd\xd4\xc9\xc1\x2a\xed\x12\xf3\x32\xb8\x6b\x4a\x05\xaa\xec\x92\xcf\x83\xf4\xf8\x4e\x82\x57\x99\x25\xe6\x8e\xff\xea\x95\x04\x57\x6d\x96\x7c\xf8\x74\x7f\x28\x15\xa8\x34\x4b\xda\xdf\xb7\x4e\x4e\xa4\x02\x2a\xb3\xa4\x35\xbd\xbb\x31\x25\xb8\x4a\xb3\xa4\x7d\xb0\x7b\xf0\x5e\xee\x9c\x0a\xb3\xa4\x75\xfd\x26\xfd\x20\xc1\xcb\x66\x49\xc5\xd6\xb1\x6a\xd7\x52\xde\x7f\x2c\x6c\x25\x16\x76\x05\x15\xfb\x7b\x85\x9d\xba\xe2\x9e\x5b\x61\xf7\x4c\xb1\x11\xa6\xda\xd2\x2a\xee\x4d\x49\x5b\x4c\xd2\x96\x89\x94\xc9\x1b\x26\x32\x32\xa5\x65\x22\x13\x51\x62\x9a\x08\xb4\x8b\xa6\x89\xd0\xd0\x12\xd3\x44\x60\x8f\xda\x34\x11\x99\xca\x9b\x26\x62\x07\x28\x2d\x13\xb1\xd3\x94\x96\x89\xd8\xd1\xe2\x96\x89\x20\x14\xea\x2d\x13\x51\x94\x54\x96\x49\xff\xe7\xe7\x93\x98\x41\x28\x0d\x93\xd6\x71\x73\xf8\x45\x84\x28\xd9\x33\x31\xef\x6f\xc3\xae\x08\xa9\xb6\x4c\xce\xda\x63\xe3\xa7\x00\x28\x5a\x26\xad\xab\xfb\xab\x6d\x21\x5f\x6d\x99\x78\x61\x7c\xf8\x56\x80\x2b\xdb\x32\xf9\xf5\xf1\x6c\x20\x00\x0a\x96\xc9\xa5\x19\x0c\xef\xde\x09\xf9\x6a\xd3\x64\xb0\x7f\x69\x9c\x08\x70\x65\x5b\x26\xf7\x3b\xa6\xc8\x56\xd1\x34\x69\xc5\xd3\x0f\xa6\x90\xaf\x36\x4d\x2e\xdb\x93\xab\xbb\xcf\x22\x4f\xd5\xb6\xc9\xc7\xdd\x41\x2a\xc0\x49\x7b\x26\x2d\xef\x2a\xf8\x94\x03\x14\x8d\x93\xd6\xa7\xf6\x97\x13\x11\x40\x6d\x9c\xb4\xdf\x6d\x9e\x75\x44\x40\xb5\x71\xd2\x3a\xda\x3f\x69\x0a\x80\x82\x71\x62\x5e\x7f\xfe\xec\x0b\xd9\x4a\xe3\xe4\x6c\xeb\xf0\xf8\x8b\x00\x56\x62\x9b\x5c\x7e\x39\xfd\x2c\xc0\x89\xb6\xc9\x87\x4f\xf1\x4f\x21\xbb\x64\xcf\xe4\x68\x7a\xb2\x25\xc0\xa9\x8d\x93\xd6\xaf\x7d\xe3\x52\x80\x13\x37\x4d\x76\x63\x5f\xac\xae\xc4\x38\x39\xdc\x34\x24\x66\x2a\x37\x4d\xae\x8e\x9c\x5b\x01\x4c\x34\x4e\xda\x47\xb7\x83\x6c\x24\x54\x19\x27\xc9\xbb\xbe\x7f\x2b\x03\x56\xdb\x26\x43\x78\x6d\xca\x25\x2a\x6d\x93\xf6\xfd\xe4\xbd\x2b\x95\x50\xd8\x26\xf7\xde\xee\xf4\x44\x02\xab\xb6\x4d\x0e\x4f\xda\xaf\xa5\x02\x95\x7b\x26\xe6\xab\xad\xe6\x48\x2a\xa0\x32\x4e\xda\xc3\xf6\xe4\x52\x82\xab\x34\x4e\x5a\x7b\x3f\x3f\xcb\x2d\xac\x32\x4e\xbe\xc3\xfe\xaf\x50\x82\x57\xd8\x26\xdf\x6f\xb7\xe3\xb1\x04\x56\x65\x9a\xec\x7c\x89\xde\x79\x72\xd7\x94\x5b\x26\xd1\xf7\x37\xee\x81\x04\xbe\x84\x61\x92\x1d\x4e\xab\x4e\x4f\xe5\x73\x50\xc5\x99\x66\xf1\x7c\xb2\x78\xd4\x58\x3c\x34\x2c\x1c\xff\x15\x0f\xf2\x0a\x47\x72\x8a\xb3\x35\xe5\x21\x99\x74\xd6\x25\xda\x26\x52\xa6\x60\x9b\x48\xc8\xd4\xb6\x89\x44\x84\xda\x36\x11\x69\xe7\x6d\x13\xb1\x9d\x25\xa6\x89\xc0\x1c\xb5\x69\x22\xb2\x54\xdc\x35\x11\xf8\xaf\x3e\xcf\x11\x7b\xad\x64\xdb\x44\xe8\x6b\xd1\x3a\x11\x04\xa3\xc4\x3a\x11\xc4\x49\xb9\x6f\xd2\x6e\x5d\xde\x42\x06\xa2\x36\x4f\x3a\xf0\xcd\xad\x08\x51\x62\x9e\xb4\xb6\xaf\x9d\x6b\x11\xb2\xec\x48\xe7\x64\xf3\x2c\x12\x20\x25\xfb\x64\x67\xf8\xe9\xb5\x90\x5f\xb6\x73\xf2\xfe\xa6\x79\x20\x00\x96\x19\x28\x93\x77\x87\xbf\x04\x40\xd1\x40\x69\xfd\xba\x49\xee\x85\x7c\xb5\x81\x92\xec\x25\xe3\x33\x01\x4e\x6d\xa0\x7c\xf5\xdb\xfb\x8e\x00\x27\xd8\x27\xa7\xe3\xe1\xf7\x1b\x21\xbb\xc4\x3c\x69\x7d\x38\x7c\x33\x16\x79\xaa\x32\x4f\x3e\x9f\x1e\x7f\x71\x05\x30\xd9\x3a\xd9\xfb\x74\x78\x9a\x03\x14\xac\x93\x7d\xef\xf4\x57\x2c\xe6\x97\x18\x27\xd7\xb7\xed\xbe\x08\x58\xb2\x73\x72\xf0\xfd\x67\x53\x00\x14\x8d\x93\x8d\x6b\xff\x9b\x90\xad\xde\x39\x69\x7d\xfa\x7c\xb5\x21\xc0\x95\x58\x27\x91\x99\x40\x01\x4e\xb0\x4e\x5a\xde\xf8\xfe\x83\x90\xad\xb6\x4e\xda\x93\x78\xba\x2d\xc0\x95\x58\x27\xc1\xeb\xaf\x67\x02\x9c\x68\x9d\xec\x4d\x77\x45\x76\xab\xad\x93\xd6\xd9\xe9\x3b\x43\xe4\xa6\xd2\x3a\xf1\xbf\x7d\xbc\x15\xc0\x04\xeb\x64\xfa\xf9\xf8\x2c\xd3\x9a\xd5\x3b\x27\xe9\xe9\x5d\x5f\x86\xac\xb4\x4e\xcc\xe6\xbe\x19\xcb\x25\xaa\xad\x93\x5f\xdf\x8f\xe5\x3a\x54\x47\x3a\xed\x77\x1f\xc7\x77\x12\x5c\xf5\x91\xce\x78\xff\x2a\x94\x0a\x54\x9a\x27\xad\xd6\xc9\xd7\xd7\x52\x01\xf5\xde\xc9\x71\xc7\x95\xe0\x2a\xcd\x13\xf3\xd5\xe9\x55\x2a\x15\xa8\xdc\x3b\x69\xbd\xfe\xf5\xeb\x95\x54\x40\x79\xa4\xf3\x66\x78\xe7\x4b\x70\xd5\x7b\x27\x9f\xdf\xfd\x7c\x2b\x77\x4f\xd5\x91\xce\xcf\x2f\x57\x72\x53\x0b\x26\x0a\xf5\x01\x51\xe5\x0f\x8c\x78\x79\x70\xa3\xa0\x70\xad\x4c\x7a\x47\x2c\xba\xe1\x70\xa3\x60\x3a\x0e\x7b\xc4\x20\xc0\x97\xe7\x99\xfb\x08\x09\x0f\x60\x6e\x24\x56\xc4\x0f\x83\x80\xc7\x4e\x3d\xb7\x65\x97\xdd\xd2\x68\x62\x6d\x71\x37\x69\xe8\x2d\x49\x94\x94\xdf\x3a\xee\xc9\x8f\xca\xd2\x51\xf1\x9d\x19\xef\x2d\x83\x7b\x7d\x2e\x3d\x46\x57\xdc\xbb\xe7\x4b\xbe\xc0\xae\x4a\x5e\xa4\xf1\x8b\x74\x04\xc4\x8c\x7e\xe4\xdd\x2b\x33\x06\x51\x94\x2a\x33\x32\x54\x5e\x19\x2a\x39\x23\x43\x95\xdd\x70\xc4\x9c\x28\xb9\x96\x2d\xf9\x35\x48\xa3\x49\xc9\x5b\x78\xcf\xf3\x4a\x5b\x29\x7b\x47\x20\x9c\x91\x9e\x78\xb4\x2a\x70\xd1\x1e\x7d\xc6\x70\x5a\x03\x3f\x66\x17\xd7\x0b\x3c\x71\xa3\x00\x7b\x7c\x59\x0e\x1a\x03\x89\x10\x0b\xd0\x57\xd2\xe2\xad\x44\x8b\xdc\x37\x0b\x69\xf1\x38\xb7\x2f\x56\x73\x5e\xec\xf2\x67\xf8\xbf\x3c\x54\x05\x5f\x6b\xa2\xfb\x17\xa5\x9b\x8f\xc6\xa5\x19\xfc\x30\xde\x1a\x6c\x28\x87\x1e\xf6\x15\x29\x0a\x71\x19\x0c\x2f\xcf\x65\x30\xbc\x68\x2f\xac\xcb\x5b\xa2\xae\x0a\x18\x85\xec\xd3\x0b\xb7\x0a\xcf\x33\x6a\xb7\x33\x25\xc0\x12\x3f\xd4\x20\x02\x3b\xd4\x20\x02\x37\x16\x54\xe4\x2d\xae\xa8\x1c\x84\x63\xc5\x6f\x6f\x67\x4e\xa1\xe8\x1b\x92\x2a\xc9\x16\x79\xfa\x74\x9f\xec\x7d\xa2\x45\x93\x34\xf6\x27\x1c\xe1\x56\x98\x8e\x8c\x68\x60\xa4\xf7\x13\x58\x8f\x3c\x4f\x57\x09\xe7\x36\xfa\x87\x67\x43\xaf\xfb\x83\x22\xc2\xef\xd4\x72\x34\xc4\x6b\xa5\xa2\x6c\x17\xfd\x2b\xd3\x57\x5e\xe3\xb2\x95\xde\x9c\x9d\x19\xcc\x8b\x46\x89\x62\xad\x04\xcb\xf9\x5b\x09\x96\x73\x73\xb9\x4a\xab\xc1\x72\xe9\x59\xa6\x52\x11\xa6\x74\x0a\x59\x04\x46\x2b\x5d\x04\x56\x52\x69\xc9\x14\xb8\x08\xac\xa4\x52\xa5\x03\x2a\xda\xd9\x15\x82\x52\xe8\x25\xfa\xe0\xb1\xba\xcc\x68\xe5\x32\xaa\x02\x74\x90\x2e\x10\xe2\x17\x72\x87\xae\x5c\x8b\x8a\x35\x70\x0b\xfd\xab\x1a\x07\x9f\x86\x6f\x5b\xec\x2d\x6d\xd5\x40\xa8\x80\x13\x46\x42\x05\x9c\x30\x14\x96\xa9\x77\x01\x9c\x30\x18\x16\xd7\x2b\x01\x55\x0d\x87\x6a\xb8\x5c\x34\xab\xe1\xca\xea\x2d\x1f\x11\xd5\x70\x65\xf5\xaa\x3a\x9e\x3e\xa4\x5e\x3c\x26\x78\x54\x4b\x0f\x8a\x15\x0b\x29\x4b\x2c\x3f\x2c\xc4\xae\x5d\xbd\x1e\x25\x7f\x9a\x70\xdb\xdd\xa8\x1a\x18\xfb\xe9\x7e\x17\xbf\xf8\xae\x1a\x15\x65\x40\xc2\x90\x28\x03\x12\xc6\xc3\xc2\xea\xaa\x80\x84\x91\xb0\xa0\x3a\x1e\xa2\x6a\x0c\x54\x00\xe5\x82\x58\x01\xa4\xac\xae\x5c\xf4\x2b\x80\x94\xd5\x29\x3b\x95\xbc\xe4\x5f\x2c\xf4\x19\x9e\xa5\x25\x7e\x95\x12\x45\xf0\xe5\x65\x9d\xeb\xbc\x15\x6b\x50\x31\xc4\xed\xc0\xf6\xa0\x5d\x2e\xe5\x1f\xe2\xe3\xc3\x2d\xe6\x60\xa0\x5c\xce\xab\xc0\x38\x49\xaf\x02\xe3\x64\x7d\xa9\x4a\xab\xc1\x38\x79\x5f\xa2\x52\x11\xa6\x5c\xe6\x17\x80\x31\x31\x5c\x00\x56\x52\x69\x99\xe4\x2f\x00\x2b\xa9\x54\x69\x06\x31\x6f\x3a\xd5\xd2\x2f\x60\x5a\x52\xfe\x57\x2d\xa3\x2a\xb0\xec\x18\x90\x3a\x74\xe5\x5a\x94\xac\x71\x06\x2d\xd7\x2d\x1f\x07\x3b\xb7\x3b\xc3\x8f\xd4\xe3\x45\xf9\x30\xa8\x80\xe2\x46\x41\x05\x14\x37\x08\x96\xa9\xb1\x12\x8a\x1b\x02\x8b\x6b\x14\x40\xca\x07\x40\x35\x14\x13\xc5\x6a\x28\x75\x8d\x65\xd2\x5f\x0d\xa5\xae\x51\xd9\xc1\xc4\xb1\xc9\x22\xd9\xe7\x11\x2d\x29\xfa\x2b\x16\x51\xc0\x2f\x2b\xf8\x62\x37\xae\x5a\x87\xd2\xfa\xef\xbb\xae\x8b\x1d\x25\x45\x6f\xef\x7e\x52\x5c\x9c\x9b\x52\xee\x6d\x62\xa3\x69\xfe\x33\x73\xcb\x61\xdc\x09\xef\xbb\x13\x37\x86\x30\xc4\xf1\x1e\xeb\xf9\x4e\xe8\xe6\xc6\x26\x39\xde\x2e\x41\x5e\xba\x5f\x8a\x1f\x3d\x66\x55\xdd\x33\x1f\x20\xc6\x38\xc9\x5c\x80\xd0\x67\xed\x28\x09\x11\x32\xf2\xf1\xb6\x6e\xe2\xc6\x51\x10\xf4\x9d\x58\xed\xc1\xb4\xb4\x9d\x2f\x84\x5d\xb0\xa2\x73\x98\x25\x4a\xc9\xbb\x22\xcb\x94\x10\x37\x83\x96\x29\x21\xee\x0d\xad\x44\x95\xb7\x32\x55\x4b\x97\xe0\x36\x92\xd4\x1e\xd0\x4a\x90\x54\xee\xb9\x55\xf1\x7d\xe1\xbe\x14\xbf\x65\x5a\xd1\x8a\x85\x9b\x74\x4f\xc3\x93\xf7\xd6\xd3\xf0\xe4\x7d\xf8\x9b\xda\xf5\x44\x3c\x79\x7f\xf3\x78\x66\x05\x27\x26\x8f\xee\x3d\xce\x59\xcd\x53\x3a\xef\x29\x68\xb8\xbe\x7b\x0a\x1a\xae\xeb\x7e\x4b\xa3\x9e\x86\x86\xeb\x38\xce\xed\x8f\xe0\x98\xe6\x11\x1d\xc7\x66\xa9\x1c\x65\xb5\x7e\xaa\x26\xee\xa9\x68\x54\xd4\x3c\x9e\x55\x22\x1a\x29\xce\x51\x73\xce\xbb\xf0\x57\x3b\xed\x92\x26\x13\xc1\xf3\x26\x75\xca\x52\xf0\x41\xa9\x70\x6b\x25\x9f\xc2\x11\xef\xe2\x24\x00\x56\x34\x75\x47\x78\xa7\x2f\x0a\xad\xb1\x13\xfa\x93\x69\x40\xc2\xe5\x95\xe7\x88\xae\x99\x79\xe7\x7e\xfe\xd8\x19\x52\x67\x65\x85\x39\x94\x3b\x08\xce\xbc\x57\x6f\x4c\xee\x6a\x66\x4b\x74\x59\x55\xe5\x6f\xbc\xd4\x87\xf8\x34\x81\xb1\x41\x9c\xc8\x93\xea\xb1\x43\x7d\x45\x6a\x52\x4c\x94\x13\xf8\x6e\x21\xfe\xe9\x00\x9f\x42\x77\x45\x0b\x19\xd2\xae\xa9\x2a\x1f\x1e\xec\xec\x1a\x65\x18\x4b\xf3\x45\xc4\x02\xd8\x13\x1d\xd1\xf3\x44\x64\xb6\x67\x79\xd3\xc5\xaa\x39\x07\xff\x4a\x1f\xfb\xc5\x16\x96\x37\x6a\xa6\x96\xa1\x2c\x5c\x98\x2a\xa4\x89\x1f\x26\x30\xad\x35\x6b\xed\xc9\x5d\x0d\x35\x9f\x8f\x70\xd0\x30\x5b\x5d\xbd\xb7\x0a\xf0\x5c\xa4\xed\xeb\xd1\xd8\x35\x58\x34\x25\x9e\x6e\x2e\xee\x12\x8b\x83\x93\x27\xd5\xf8\x01\x4d\x47\x49\x18\xa1\xe1\x19\x44\xb7\xd0\xeb\x0d\xfc\x00\xbb\x90\x0a\x26\x23\xa7\x1e\x4d\x1c\xd7\x4f\xef\xed\x8d\xae\xde\xa3\xbf\xad\xc6\x46\xb7\x2c\x7a\x8b\x1c\xb2\x05\x3b\x94\x2c\xa5\x58\x41\x1b\x0f\x3f\xa3\x63\xd7\x80\x37\x30\x4c\x13\xd6\x61\x9b\x46\x07\x7a\x28\xdf\xa0\x71\xa3\x66\x8b\xa2\x38\xf4\xf2\x98\x1f\x78\x43\x08\x2f\x07\x0a\x68\x32\x51\x2a\xe4\x94\x8a\x94\x62\xb5\xb1\x81\xfe\x49\x15\x6e\xb9\xe8\x9f\xb2\x4e\x72\x76\xb7\x3a\x4a\xc7\x43\xff\x94\x28\x33\x31\x56\xb5\x43\x38\x5d\x71\x83\xcb\xf0\xca\x88\x26\x30\x7c\xd1\xb8\x6c\x7d\x08\xcf\xde\xe0\x78\x2c\x5e\x74\x1b\x1a\x69\x34\x1c\x06\x70\x65\x6e\x33\x62\x2b\xb4\xed\xd2\x4d\xc8\x46\xfb\x82\x86\x30\xb8\x47\x35\xa7\xbc\x12\x59\x87\x2e\xa2\x82\xc2\x3d\x8e\x8a\xd2\x4a\x4a\xf4\xee\x02\x62\x24\xf0\x47\xd1\xb4\x82\xd4\x7b\x1d\xf4\x6f\x69\xa9\x97\x15\x41\x79\x1f\xe4\x9a\x81\xc2\x28\xd5\xd9\x0a\x9d\x5a\xa8\xba\x94\xa1\x5c\xd5\x04\x66\xc9\xaa\xab\x7a\x48\xa8\x7a\x61\x97\xe6\x15\x89\xa0\xcb\x11\x22\x76\xdf\x13\xf4\x62\xad\x71\x69\x7e\xff\xde\xfe\x65\xf4\x1d\x6f\x08\x17\x39\x4c\xc6\x9e\xcc\xbf\xf5\x8f\x0e\x30\x8a\x15\xbc\x2c\x4b\xc4\xb4\xe0\x86\xe7\x74\x54\xc8\x32\x0e\x17\x72\x94\x12\xab\xae\x93\xb8\x63\x96\xea\x34\x5b\xad\x7e\xa7\xa9\xac\x53\xd0\xd3\x2b\xa0\x6c\x35\x3b\xde\xa6\xba\x19\x99\x9e\x56\xb5\x63\x65\x3d\xbd\x22\xcf\x19\xb1\x4b\xe8\xe9\x85\x4d\xc8\x46\xdb\x82\x86\xac\xa2\xa7\x4b\x7a\x40\x55\x89\xac\xa7\x17\x51\xb1\x82\x4e\x5c\x41\xf6\x4a\xf4\xf4\x02\x62\x1e\xa1\xa7\x9f\x24\xf5\xb8\x2b\x97\x96\xfa\x52\x3d\x5d\x80\x5c\x4e\x4f\xaf\xd0\xa9\xa5\x7a\xba\xaa\xea\x0a\xf5\xb8\x9a\x16\xa9\xd4\xd3\x15\x14\x2c\xa1\xa7\x17\x74\xdf\x93\xb5\xa3\x5a\x5b\x33\x24\x65\x97\x1f\x8f\x9a\x57\x29\xc6\x22\xc5\x2a\x50\x8b\x51\xd7\xed\x6f\x75\x5d\x89\xa4\x8e\xeb\xc0\x8e\xab\x42\x96\xb3\x5a\xce\x59\x41\x74\x3b\x9d\x6d\xaf\x23\x8b\x6e\xab\xdb\xdd\x68\x75\x95\x75\x2e\xa1\xb0\x95\x28\xdb\xdb\x5b\x9d\xf6\xb6\x12\x65\xae\xb0\x15\xed\x58\x5d\x61\xaf\xc6\x73\x46\xec\x12\x0a\x7b\x61\x13\xf2\x61\x57\xdd\x90\x95\x14\xb6\xba\x07\x54\x95\x14\x14\xf6\x02\x2a\x56\x51\x8e\xcb\xcb\x5e\x99\xc2\xae\x26\xe6\x31\x0a\xfb\x09\x52\x4f\xba\x72\x69\xa9\x2f\x57\xd8\x32\xe4\x92\x0a\x7b\xf9\x4e\x2d\x57\xd8\x15\x55\x57\xe9\xc9\x95\xb4\x48\xb5\xc2\x2e\xa7\x60\x19\x85\x5d\xdd\x7d\x4f\xd6\x8e\x6a\x85\xcd\x90\x94\x28\xec\x56\xf7\xfe\xb6\x85\xb1\xf0\x31\x5f\x4a\xb4\x75\xdf\x6d\x7a\xb2\x8e\xe8\x6c\xf4\xb7\x3c\xa7\x80\x29\x63\xb2\x98\xbc\x8a\xc4\x9a\xfd\xa6\xd7\x95\x4d\x8c\xfe\x86\xb7\xd5\x2d\xd6\xb6\x84\x92\x56\xe2\x6b\x6d\x6c\x3b\x7d\xb7\x88\x2f\x0f\x08\x28\x93\xbf\xb2\x7a\x5e\x85\xc3\x8c\xc6\x65\x8c\xe9\x4a\xca\xb3\xe1\x55\x45\xff\x2a\x5a\x59\xc5\xef\x02\x7a\x59\x1f\x57\x56\xbe\x82\xe2\x5b\x4a\xb4\x4a\xd4\x70\x15\x0d\x8f\xd0\xc1\x8f\x16\x67\xd2\x5f\xcb\x89\x73\xa9\xf6\x15\xc1\x96\x53\xbd\x4b\xf5\x5c\xa9\xd2\x2d\xad\xb1\x42\xd1\x2d\xab\x09\x2a\x75\x6d\x59\xc5\x4b\x28\xda\xaa\x3e\x7a\x9a\x56\x2b\x51\xb1\x14\x83\x5a\xc5\x1a\xe9\x70\xef\x13\xc6\x20\x45\xbd\x52\xcb\xc9\xa0\xe9\x78\x1d\x99\x1c\x08\x9d\x56\x7b\x43\x81\x8b\x31\xb7\x90\xb1\x82\x64\x42\x77\x7b\xd3\x94\xb7\x70\xb6\xb7\xba\x83\xa6\xa7\xaa\x71\x09\x55\xab\xc4\xe8\x75\xb7\xba\x66\x4b\x85\x91\x69\x53\x55\x23\x56\x55\xb7\x2b\x72\x9b\x51\xba\x84\xc2\x5d\x44\x3f\x1b\x59\x0b\x5a\xb1\x82\xd2\x2d\xe1\xbd\xa2\x0a\x49\xf1\x2e\x22\x61\x79\x7d\xb7\xbc\xc0\xa9\xd5\xef\x02\x4a\x56\x57\xc0\x4f\x11\x74\xd2\x83\xcb\x0a\x7a\x99\x12\x2e\x00\x2e\xa5\x86\x97\xef\xcb\x32\x55\x5c\x55\x6f\xb9\x32\x5c\x49\x63\x54\x29\xe4\x8a\xea\x17\xab\xe4\x05\xbd\xf6\x54\x2d\x58\xb2\xb1\x4c\x71\x94\x6d\x55\x0c\x3e\x9d\xf6\xc9\xf6\xb4\x10\x24\xb0\x44\x76\xb6\xbb\xed\x4e\x41\xa5\x75\xda\x83\xb6\xa3\xc0\x95\x2f\x31\xa4\x8c\x15\xa4\xd5\xdd\x6e\x37\x5b\xb2\xc1\xb0\xb9\x61\xba\xe6\xb6\xaa\xc6\x25\xd4\xb2\x12\xa3\xe3\xb6\xb6\xc9\x1a\x50\xc6\x98\xef\x52\x14\x1b\xb1\xfa\x26\xc5\x4a\xdc\x66\x94\x2e\x73\xf8\xb7\x80\xfe\x7c\xa1\x59\xd9\x8a\x95\x76\x28\x94\xbc\x57\x54\x51\xd8\x9f\xa8\x26\x61\x95\xad\x80\x65\x05\xae\x6c\x73\xa2\x92\x92\xc7\xec\x4d\x3c\x5e\xd0\x49\x0f\x2e\x2b\xe8\xe5\x3b\x13\x12\xe0\x92\x1b\x13\xcb\xf6\x65\xf9\xb6\x44\x79\xbd\x55\x9b\x01\x2b\x68\x8c\xea\x3d\x89\xd2\xea\x97\xd9\x92\xa8\xec\xb5\xa7\x6a\x41\xb5\x5a\x66\x38\xca\x36\x24\xae\xba\x37\x5f\x31\x92\xc0\x0f\xaf\x0a\xd1\xcd\x95\x7b\xd9\xf4\x86\x54\xb3\x50\x1c\xc8\x09\xdc\xc2\x5e\x48\x2f\x3c\x17\x15\xb3\x17\x5d\x83\x91\x88\xae\xf2\x0a\xb2\xf4\x85\x97\xc5\x6d\xc9\x96\x72\x62\x72\xb6\x82\x52\xb5\x7c\x26\xf4\x1d\xef\xad\x64\x25\xf4\x59\x70\xdf\x76\xb7\xb5\xe9\x16\x6e\x44\x4d\x43\x0f\xc6\x81\x1f\x2a\x66\xdf\xaa\x2a\x97\x5c\xd0\x2e\x43\xe6\x92\x2b\xd5\x8a\xa6\x65\x4e\x4e\x8a\x37\xbd\x3a\x67\x5b\xd7\xfb\xa4\xd8\x10\xd5\xbc\xbd\x73\x7f\x82\x3f\xb1\xf3\x0a\x23\x18\xbe\x10\x2e\x1f\xf1\x11\x2c\xf3\x88\xe2\x59\x68\x73\xe9\xfa\x1f\x75\x44\x23\xc9\xf6\x06\xb9\xbf\xd6\xfc\xe4\x4f\xc8\x9e\xdf\x18\x55\x0c\xdf\x7c\xda\xe1\x2a\x4e\xc6\xca\x8a\xbb\xa8\x5e\x29\x52\x66\xab\x50\x6f\x57\xaa\xb1\x4d\xdc\x0e\x5c\xed\xdc\xbc\xc1\x55\xdc\x61\x6d\xf3\xaa\xf5\xe5\x8e\xab\xf1\x2e\x51\x37\x95\xdc\x3b\x7b\x4c\x85\xed\xb1\xef\x12\x25\x82\xef\x84\x4a\xb1\x31\x05\x47\x78\x12\xe8\xb3\x62\x61\x2e\xbe\x6b\x77\x72\x37\xf7\xc3\xc9\x34\x3d\x4f\xef\x27\xd0\xd6\x92\x69\x7f\xec\xa7\xda\x45\xa1\x10\xe0\xa1\x62\x98\xc0\x85\x40\xfd\x69\x9a\x46\xa1\x02\x4a\xf2\x29\x7c\x1f\x1f\x7e\xca\x79\x07\x1a\xf0\xe3\x87\xfb\x0f\x1c\x33\xd9\xb5\x55\x45\xdc\x2b\xe5\x75\x59\xe5\x35\xd7\x62\x45\x7c\x0f\x95\x57\x2a\xf6\x63\x81\x00\xd1\x3d\x61\x79\x05\x6c\x38\x2e\x53\x4d\x3e\x74\x2b\xf0\x51\x6d\xb6\x14\xbe\x4c\xf3\x55\xe0\x63\x1a\x7e\x29\x84\xf9\x74\x50\x8e\x51\x9e\x3a\x96\x41\x2c\x5d\x07\xfd\x65\xf8\xa1\x07\xef\xac\x56\x91\xb7\xfc\x1d\xcb\x67\x42\x47\x2e\x80\x2c\x48\x9b\x02\x5e\x4a\x59\x88\xbf\x08\x2f\xa5\xcc\xc4\xe8\x8c\x78\x40\x9b\x5f\xbe\x6f\x90\x73\x8a\x34\x8a\x82\xbe\x13\xcf\x14\x81\x9d\x0b\x40\x35\x91\x16\x55\x7e\xb1\x85\x4a\xa8\x60\x38\xf9\x61\xe0\xe1\x4a\x69\x14\x44\xb9\x50\xe4\xc5\x82\x8a\x5f\x2c\x55\xf1\x0b\x55\xc5\x82\x53\x35\xea\xd4\xa5\x5c\x50\xc3\x28\xad\xf3\x4f\x46\x74\x92\x92\xdf\xb1\x27\x09\x25\x16\xb9\x3e\x53\xd8\x46\x55\xc3\x8c\x7b\x9b\x22\x87\xca\x5c\xb2\xdc\xe3\xe8\x4b\xa3\x09\x79\x4d\x91\x51\x2a\x3a\xba\x92\x32\x17\x90\x93\xd7\x5e\xe4\x9f\x72\x18\x2b\x89\x2b\x96\xe5\xa9\x45\x7c\x29\x23\x56\xc8\x53\xd2\x2a\x8f\x98\x05\x8a\x55\x4a\x59\x42\x2a\x04\x45\xbe\x8c\x10\xc8\x55\x54\x75\x69\x09\xb3\xd5\xac\x5d\x0d\xaf\xb2\x27\x7e\xa7\x94\xc8\xf4\x54\xc9\x4a\xe9\xd8\xf8\x3d\x72\x50\x2b\x13\xbc\xb2\x09\x87\x5b\x83\x97\x95\xcd\x1e\x47\x2c\x18\x23\xcf\xca\xca\x8b\xc1\x34\xb7\xe4\x58\x9a\x5b\x25\x1a\x8b\x37\x86\x97\xc4\x8d\x8d\x41\x31\x50\x67\x4b\x85\x7d\x99\x56\xff\x27\x9e\x4a\xac\x4e\x56\x61\x49\xb8\xfc\xaa\x2f\xeb\xa8\x5a\xe3\x74\xf0\xfd\xf8\xcc\x70\x9d\x18\xa6\x05\xa5\xcc\xb3\x5d\x84\xa4\x92\x48\x7d\x2d\xd3\x66\xc9\x02\x4a\x72\x9b\xf3\xc6\xdd\xe7\xc3\xce\x77\x4c\x3e\x16\xcb\x25\xd1\x36\x6b\x14\xf1\x7c\x29\x7b\xa7\xd2\x2a\x5a\xda\x2e\x2e\xc2\xbe\x50\x3d\x29\xa3\x51\xf4\x33\x07\x97\xbd\x52\xd7\x93\x95\xc4\x57\x55\xc5\x79\xcf\x5c\xaa\xfd\xcf\x56\x36\xc1\x55\x16\xdc\xf2\xc4\x2e\x5d\xdf\xd2\x16\x1d\x5a\x42\x21\x83\x4e\x8e\xa3\xbd\x94\x19\xbd\x78\xd2\x2a\x4e\x54\xcb\x19\xfc\x15\x53\x4a\x99\xaa\xee\x4c\xee\x7a\x65\xb3\x0a\x97\xa7\x9c\x57\xaa\xb5\xfc\x52\x24\x57\xcd\x3a\x0b\x67\x97\xd5\xa6\xc1\x62\x6b\x24\x3e\xac\x22\xff\x4f\xb6\x3c\x56\xa8\xea\x51\x16\xc8\xef\xc3\x5f\x69\x89\xfc\x5e\xb9\xf8\x8f\xd8\x24\x45\xa9\x99\x37\x86\xbf\x0e\xb7\x5d\x8e\xa6\x9f\xd3\x24\xf5\x07\x3e\xf4\x66\x82\xb3\x60\x5e\x77\x92\x57\xbf\x81\x73\x1f\x4d\x53\x6b\xe0\xdf\x41\x8f\xdb\x08\x0e\x9c\x49\x02\xad\x04\x4e\x9c\xd8\x49\x61\x05\x7e\x71\x5a\xa8\x02\x93\x35\x51\xa5\x17\x63\x46\xe8\x3f\xab\xab\xae\x58\x33\x0b\x9b\x35\xab\xe1\xe8\x3a\x6f\xb7\x6f\x73\x81\x19\xc3\x70\xca\xb9\x1e\x3f\xf7\x9c\xd4\xa1\x52\xc4\x36\x8b\x12\xed\x82\x67\x44\x4d\xd8\x73\x72\x3c\x3f\xd2\x2e\x40\x79\xb9\x8a\xa9\x69\x45\x4c\xea\x72\xee\x08\xba\x57\xfd\xe8\xee\xb7\x10\x91\x23\xcb\xb7\x95\x9c\x7e\x12\x05\xd3\x14\xf6\xdc\xc0\x9f\x58\x31\x74\xd3\x7a\x13\xd4\xe8\xff\xf4\x9e\xfa\xb9\xa6\xb9\xf5\xad\x7d\x68\x84\xce\xcd\x4c\x30\x1d\x9b\x85\x67\xe3\x81\x9f\xa4\xd4\xe7\x89\x5c\xf4\x45\xe0\x57\xec\xae\x61\xcb\x41\x02\x7f\xe1\x2c\x2a\xd0\x13\x37\x78\xe9\x86\x86\x80\x22\x3f\x55\x12\x93\xc9\x86\xb3\x6a\x97\x59\x75\x57\x05\xca\x4d\x91\xcf\x86\x5e\x38\xdc\xe6\xf5\x42\x58\x25\x51\x0a\xb0\xa5\x76\xc5\x7b\x8a\xf7\xbf\x0b\xf6\xfe\xb3\x6a\x6b\xfc\x09\xa3\x03\x4a\x73\x8a\x04\x4b\xf9\x65\xc7\x56\x10\x16\x6e\xb6\xe3\xf3\x23\x91\x88\xcb\xf6\xcf\xdb\x11\x44\xbf\x0d\xcf\xbf\xf1\x3d\x18\xcf\xd8\x5e\x75\x66\xf7\x58\xdb\xd8\x96\x66\x9e\x75\x98\xb7\x1d\x45\x95\x5d\xf4\xaf\x20\x08\x2f\xfc\xf1\x70\x96\x1b\xa1\xec\xa0\xc7\xf5\xcf\x8e\x70\xc5\xa9\xd3\x4f\x24\xc7\x09\x05\xb7\x3c\x22\x34\x92\xe8\x7c\x0f\x41\x1a\x0b\x74\x0b\xae\x50\xe2\x85\xc3\xec\x3a\x1a\x6f\x63\x91\x13\x82\x12\xc7\x06\x05\x0f\x05\x35\xf4\xff\xcd\x5a\x53\x5d\x29\x73\x30\x2c\xdd\xea\x80\x35\xf2\x9f\x92\xe6\x49\x4e\x6b\x91\x80\x2c\x84\xc9\xb7\x96\x17\x42\x0a\xe2\xdd\xed\x76\x99\x24\xd3\x47\x8b\x95\x0f\xc1\xa5\xce\x91\xcc\x00\xe5\x81\x97\x40\x4f\xe3\xb2\x1d\x1e\xbc\x7f\x85\xbf\xf3\x19\x98\x9b\x73\x65\x1f\x1a\x4b\x21\xc8\x45\x42\x29\x5f\x65\x65\x72\xa1\xa0\xd5\x75\x27\x77\x45\x7f\x1b\x4b\xa2\xc3\x3e\x44\xbe\xed\x66\x93\x62\xc9\x2c\x89\x96\x15\x68\x92\xec\xe5\xd3\x65\x79\xb0\xec\x65\xdb\xae\x30\x14\x72\xeb\xe0\xb1\xcc\xc0\xfe\x4b\x56\x2d\x4b\xdd\xc4\x14\xc7\xc9\xd2\x5c\x5c\x20\xfa\xcb\x15\x2b\x19\x0d\x4b\x16\xa6\x5a\x55\xe9\x24\xec\xc9\x9d\xf5\xc2\xa9\x54\x77\x2b\x68\x98\xff\x4b\x38\x28\x2a\x07\x7c\xe9\x61\xde\xb8\x6c\xfb\xdd\xab\x53\x8c\x66\xe2\x07\x81\xa4\xd4\x95\xf9\x39\xe3\x04\xa9\x2a\x02\x3e\x0b\x7c\x31\x88\x4a\x09\x9c\x82\x53\x8b\x81\x72\x3f\x2a\x8b\x41\x97\x79\xf3\xc1\xa6\xe5\xf6\x64\xf0\xed\x07\x46\x97\xa4\x8e\x7b\xa5\xd0\x69\x2a\x08\xae\xa9\x34\x94\x83\xbc\x5d\xb1\x40\xdf\xaa\x01\x8a\x75\x3f\x4d\x7f\xfe\xc7\xf4\xe5\xa3\xf4\xe3\x2a\xfa\xd0\xdc\x4a\x3e\x1f\x64\xa3\x81\x63\x69\x71\xde\x2a\x03\x5d\x5a\x5f\x96\x22\x50\x08\xee\xd2\xb0\x9c\x49\xb9\x6c\x89\x47\xaa\xc3\xea\xf6\x3f\x5a\x07\xfe\x9f\xc8\x15\xa5\x8a\xdb\x1b\xf6\x8f\x76\x11\x1a\xc3\x8d\xc2\x14\x86\x29\xc2\x71\x6d\x7e\xdb\xc3\x69\x13\x27\x84\x99\x5c\x92\x51\xa6\x2c\xc0\x9f\x9a\xcb\xab\x36\x51\x35\x97\x0c\x20\x79\x3b\xf3\x31\x7b\x27\xa1\xd9\x3d\x0c\x8d\x89\x33\xf4\x43\xbc\x00\x52\x3b\x33\x53\x2e\x52\x71\x78\xa3\x9a\x52\xc0\x65\xac\xfc\x48\x25\x88\x95\x30\xa8\x67\x55\xc9\xc9\xc4\xa9\xbe\xc7\x51\xf4\x4a\x26\x1f\xde\x97\x2d\x09\xc4\x3b\x78\x4b\xae\x5e\x4b\x8d\xe6\x92\x56\x31\x19\x2c\x6b\x5b\x55\xbe\xc3\x6e\x82\x94\x16\x26\xe2\x9a\xdd\xbb\xe8\x89\xd7\xd9\x96\x59\x41\x96\x51\x2e\x04\x20\x52\xf7\x8d\x00\x82\xfb\x49\x98\xa5\xca\x64\x72\x89\xed\x5c\x55\x65\x9c\x53\xbe\x12\x72\x38\x08\x4c\xcd\x23\x77\xc7\xd5\x14\x14\xf5\xd0\x22\x10\x44\xc3\x62\xa8\x72\x01\x51\xa0\x5b\x16\xb6\x5c\x70\x54\x48\x45\x21\xca\xc6\x05\x92\xf4\x85\x6b\x48\xa5\x33\x06\x66\xfe\xa8\x6a\x17\x77\x65\xca\x79\x54\x84\xab\x6a\xbc\x02\xba\x82\x03\xd2\xce\xd0\x52\x40\xcb\xd7\xae\xd8\x69\x5a\x66\x53\x49\xe1\x7f\x08\x8f\xcd\x83\xee\x7e\x67\xc8\x55\x68\x04\x43\xaa\x2e\x4b\x72\x88\xc6\x7c\xd4\x65\xcd\xb2\xda\x64\x65\xb0\x04\x94\x3c\x02\xf9\x31\xbe\x51\xa9\x01\xf0\x15\xd1\x92\x1a\x44\x25\xb0\x18\xa8\x52\x0f\x14\xc9\x90\xb3\x91\x19\x19\x9f\xb5\xae\xf9\x3a\x92\x31\x65\x7f\x59\x96\xc0\xff\x25\xef\xac\x96\x56\x24\x73\x7e\x19\xb0\x2a\xd6\xb7\x2b\x59\xdf\xae\x68\xb2\xc4\xfb\x25\xa0\x2a\x99\x5f\x24\x44\xce\x9e\x37\xfa\x37\x97\x87\x11\xb6\xa9\x82\x59\x31\xba\xe2\xb2\x93\x73\xe5\x46\x9f\xea\x1a\x7b\xb3\x86\x0a\x9b\xf2\x25\x8a\xa6\x78\xe1\xa2\x1c\x0a\xd9\x70\xb7\x37\x07\x21\xa1\xdc\xc0\x91\xf9\xb2\xf1\x48\x03\xcf\x99\xe3\xdb\x2d\x9a\x3f\x82\x0e\xca\x9a\x15\xb6\xdf\x7b\x65\xd6\xb4\xa2\x45\x15\xfd\xac\x62\xbd\x9a\x04\xd5\xea\x4d\x7d\x88\x48\xf8\xed\x87\x23\x18\xfb\x78\x0f\xdc\x6c\x7d\xfe\x4c\x91\xa5\x7e\x9a\x3b\x54\xc7\x01\x0b\xd5\x0e\x71\xc9\x60\xd8\xc8\xc2\x71\x56\x60\x23\x66\x7f\x21\x35\x19\x3b\x41\xa0\xcc\x69\x5c\x9a\x77\xd7\x6f\x62\xa3\x1c\x02\xe7\x94\xe0\x15\x4a\x67\x87\x11\x1c\x7d\xd7\x69\xbf\x4b\x4b\x0c\xa2\x28\x85\xb1\xaa\xf3\x4a\x22\x92\x95\x07\xd2\x5c\x30\x1e\x16\x8d\x5b\x6e\xb4\xa0\x16\xdc\x1c\x7e\x38\x35\xf0\x11\x12\xbd\x76\x21\xe5\x6f\xb4\xbe\xf8\xb4\x0d\xec\xdc\x53\x51\xac\xe8\x18\xbf\xba\x9a\x5a\x23\x1e\xfd\x38\xf8\xc8\xa5\x18\x7e\x0a\xc7\x8f\xac\xbd\x04\x9b\x78\x87\xc7\x54\xac\x44\x16\xd2\xc9\x2b\xcc\x92\x5a\x44\x7f\xe9\x8f\xa2\x7f\xc5\x5a\x84\x28\x9f\x8f\x1e\xd9\x0b\x1a\x9e\x2b\xe8\x32\x8a\xf8\xdb\x08\x8f\x6b\xf6\x2a\x75\xc8\x7b\x2c\xbf\x75\x14\x28\x94\xdc\xb3\xa7\x4a\xdf\x93\xef\x2c\x94\x51\xf5\xb4\xea\xb3\x9b\x70\x05\x44\xcf\x94\x1a\x4b\x55\x54\x60\x1e\x17\xee\x41\x96\x83\x25\x22\x44\x2c\x27\x3a\x95\x21\x38\xca\xa8\xa9\xd1\x58\xbc\x8f\xa0\xaa\xac\xe8\x42\xea\x6a\x72\x74\x69\x7a\x03\xb4\x5b\xb8\x01\xda\x2d\x48\x21\x87\xa6\x4a\xa7\xa8\xc9\x17\x2c\xbb\x32\x54\x4b\x58\x7b\x4b\xe8\x8a\x12\xe4\x0b\xe3\x13\xff\xc6\x86\xac\x5a\x57\x29\x1a\x64\x74\xfd\xa7\x48\x5e\x50\xd7\x7f\xb7\x73\x6a\x72\xe0\x8f\xff\x5c\x5f\x55\x57\xfd\x58\x76\xfe\x3b\x1b\xf4\xa4\xaa\x1f\xcf\xa6\xd1\x7f\xaf\x87\xaa\xaa\x7e\x3c\x9b\xfe\x7d\x0d\x5a\xad\xea\x8a\xa1\xf7\x1b\x87\x57\xb9\xbd\xf4\xef\x1e\x5d\xa5\x35\x3f\x45\xc2\xff\x4d\xcd\x79\x4a\xcd\x4f\x91\xef\xff\x56\xef\x54\xd4\xfc\x14\xe9\xfe\x6f\xf5\xce\x48\x61\xb2\xaf\x38\x6d\xad\x4c\x3a\xb7\x97\x54\x82\xa8\xea\x36\xf3\xaa\x8b\x05\x75\x0d\x94\x35\x7c\x42\xfc\xef\x68\xc9\x6a\xf5\x94\xe1\x40\xb6\xfd\x7f\x82\xd6\xca\x7a\xfe\xeb\x9d\xf2\x38\x13\xe1\xc9\x7d\xb4\xbc\x79\xb0\x34\x2b\xff\x7d\x2d\x79\x42\xb5\x8f\x64\xce\x63\x8c\x82\xdf\xd0\x27\xcb\x5a\x38\x2b\x30\xe7\xdf\xd5\x92\x55\xaa\x9d\xfd\x47\x46\xd1\x7f\x5e\xd1\x2d\x6d\x07\xac\x24\xcc\xff\x79\x25\xf8\xa8\x76\x2c\x14\xe5\xff\x46\x7f\x2c\x69\xc8\xac\x24\xc8\xff\x8d\xfe\x50\x59\x30\xcb\x1c\x3d\xbd\x28\x1e\xe7\x3c\xab\xda\xe9\x2a\x02\x97\x34\xaa\x9c\x99\xcf\x8a\x58\x96\xe3\x93\xa2\xe0\xac\xf4\xbc\xa1\x5c\x49\x2c\x63\x0f\x96\x53\xbf\x8c\xad\x2f\x6c\x75\x17\x76\x6d\x15\xd1\x19\x97\xdd\xec\x5b\x14\x5f\x76\x71\x4d\xe5\x31\x65\x9f\x40\xc1\xd2\x48\x57\x0a\x4e\xfb\x24\x82\x96\x44\xba\x52\x94\xdb\x27\x11\xb4\x24\xd2\x95\xc2\xe5\xfe\x9e\x2e\xab\x46\xba\x52\xdc\xdd\xdf\xd3\x65\x8f\x20\x48\x1d\xc0\xf7\x77\x75\x59\x65\x54\xe0\x15\xc7\xdc\xaa\x93\xc3\x13\x71\xae\x12\x51\xf8\x77\x0d\xb8\x55\xc9\x29\x89\x4c\xfc\xbb\x86\xdb\xaa\xe4\x94\x44\x38\xfe\x5d\x83\xed\xd1\x9d\xf5\x1b\xc9\x59\x0e\xe7\xf2\x11\x97\x7f\xdf\x40\x2b\x5a\x31\x59\x14\xe7\x15\xfa\x4e\x9c\x9e\x7f\x53\xe7\x2d\x42\x5a\x19\xbc\xf9\xb7\x12\xb4\x24\xd2\xe5\x1b\x23\xdb\x3b\xbf\x85\x43\x45\xa4\xcb\x37\xe6\xa9\x04\xa9\x91\x16\x5f\x3c\xac\x42\xa0\x10\x38\xfb\x37\xd1\x57\x8d\x73\xf9\x38\xde\xbf\x67\x00\x3e\x86\x9c\x92\x58\xe9\xbf\x9d\x3b\x4b\x4a\x53\x49\xcc\xf5\xdf\xce\x9d\xc5\xb2\xa4\xac\x43\xbe\x07\xd0\xcb\x6d\xf7\xcb\xd6\xb7\xad\xd3\x63\xba\xb6\x51\x5d\x4f\x6a\x35\xc9\x93\x44\x19\xac\x56\x71\x95\xb0\xe4\x55\x63\x25\x8a\x67\x2a\x7c\xcc\x3b\xa7\xb2\xb0\xf2\xc6\x9f\xe2\x55\xec\x72\x25\xcb\xef\xb0\x14\x97\x8d\x4f\xc7\x59\xb8\x13\x56\xb1\xa2\x54\xd6\x56\x79\xfb\xa4\xb4\xdd\xc5\x52\x25\x24\xd6\xca\x17\xbd\xa5\x6f\xcf\x3d\x7f\xcf\xa1\xf0\x2c\x8c\xb3\xe2\xee\xb5\x0a\x4e\x7d\xab\x68\x41\xc8\x6d\xe1\xe6\xdf\xe3\x6a\x58\xbe\xcf\xf9\xe3\x99\xc7\xd5\x55\x12\x88\x80\xb6\xa2\x24\xc8\xad\x1a\xfb\xd2\xbd\x58\xd1\x10\xf1\x1d\x16\x69\x8b\xb9\xdf\x6d\xa5\x14\x96\x05\x78\x55\xbf\x42\x50\x81\x56\x77\xe2\x0a\x51\x79\x57\xaf\xe4\x49\xfd\xb8\x7a\x75\x8f\x0b\x7f\xa9\xc4\xfe\xfb\xbb\x32\x6f\xce\xf8\xcd\xb0\x45\xc1\x59\xf4\x47\x69\xb4\x6c\xc0\x6d\x77\x43\x0d\xba\x60\x48\xba\x9b\x1b\x6d\xd5\x83\x0b\x6f\x30\x68\x7a\x5b\xf2\xa8\x5c\xb9\x9e\xa7\x0d\xcc\x95\xab\x2b\xf1\x46\x4f\xdb\x52\x94\x5a\xdc\xfa\xd2\x0a\xfe\x0d\xc3\x93\xb5\xa8\x15\x7f\xdd\xa1\x53\x24\x09\x19\x27\x32\xba\xef\xc2\xad\x81\xa9\x80\x5b\xd0\x9b\xe6\x66\x73\x4b\x1d\xd9\x04\x7a\x03\x79\x78\xae\x58\xc9\x93\xba\x72\xc5\xba\xca\xa2\x0a\x90\x56\x28\x42\xea\xa1\x76\xab\xb1\xff\xfe\x4e\xcc\xda\x62\x46\xbf\xae\xbe\x52\x70\x16\x89\x4a\x64\xf1\xc0\x81\x7d\x1c\x27\x5d\x01\x5a\xdd\x95\x5b\xce\x86\xd7\xee\xab\x94\x90\x3b\xd8\x82\xed\xde\x53\xeb\x79\x52\x6f\xae\x5e\x5d\xc9\xa4\x49\xdb\x52\x68\x24\x69\x7d\x69\x05\xbf\xbf\x4f\xb3\x16\xb5\xbd\x1f\x2d\x83\x4d\xd4\x24\x8a\x8d\xc8\x6a\x04\xe7\x99\x4a\xc8\xea\x1e\x75\xb6\x3b\x9d\x4e\x4b\xd5\xa3\x2d\x0f\x16\x82\xd3\xad\x5c\xcd\x93\x3a\x74\xe5\xda\x4a\xfa\x93\xb6\xa4\x18\xfe\x05\xb7\xbd\x0c\xff\xef\xef\xce\xac\x3d\xad\xaf\xdd\x6f\xae\xe1\x06\xd0\x89\x07\xfe\x9d\xd5\x87\x83\x28\xc6\xae\x77\xc5\x74\x67\x90\x12\xcf\x1f\xbb\x3f\xa2\xb1\xe1\x05\xc6\x28\x8a\xfd\x5f\x51\x98\x3a\x41\xcd\xf3\xb8\x62\xea\x7c\x5a\xfc\xed\xe0\xdb\xe0\x0c\xbf\x64\x77\xfc\x10\xc6\x59\x31\x39\x9d\xd5\x66\x7e\x19\xbe\x3b\xc9\xd3\x8d\x41\x30\xf5\xb9\xca\xd4\xd9\xac\x70\xfb\xfb\xcf\xe1\x37\x23\x8e\x6e\xf3\x02\x79\x52\x5e\x83\xe4\xb3\x9c\xc7\x2e\x67\xd1\x42\x2b\x78\x32\x64\xc8\x56\x28\x92\x51\xc6\x5c\x66\x71\x14\x65\x49\x59\x77\x48\x9d\xcd\x75\x84\x9c\x83\x8b\x48\x7e\x0d\xa9\x4f\x01\x4b\xab\x69\x45\x51\x58\xd0\xe5\x25\x5d\x5a\xdd\x75\xc5\xbe\x29\xef\x88\xd5\xb9\x5d\x64\x5d\x29\x9f\x08\x37\x70\x5b\xad\x7e\x94\x8e\x90\x26\x85\x83\xaf\x5d\x83\xf8\x27\x51\x86\xdd\x10\xdc\x73\x60\xff\x23\xfc\x8b\x75\xec\x86\xa4\x71\x69\xee\xde\xee\x1f\x1b\x93\x69\x10\x10\x40\xea\x33\x05\xff\xae\xad\xf9\xe3\x49\x14\xa7\x0e\x76\x82\xd5\xfe\xfe\xfd\xf4\x03\x81\x44\x08\x38\x57\x37\x02\xdc\xd8\x85\x3f\x3e\x1a\x23\xdf\x13\x5d\x43\x48\xb8\x76\xae\x8f\xee\x8c\x64\x14\xdd\x8a\x34\x8b\x50\xad\x1f\xc3\x9f\xdf\x0d\x3f\xbc\xf1\x13\xbf\x1f\xc0\x19\xfe\xeb\x07\x7e\x7a\x4f\x5d\xb8\x21\xfa\xef\xb7\x4e\xc7\x06\x76\x67\x80\xeb\x1c\x44\x61\x6a\x35\x9f\x37\x6b\x4e\xaf\x18\xc5\x07\x83\x09\x8e\x9c\xab\x62\xfe\x70\x5b\x31\x66\x77\x3c\x0e\x0c\x52\x69\x79\xb3\x8c\xf7\xbf\x3e\xfc\x34\x9c\xc1\xc0\xbf\xcb\x9d\x38\x60\x9f\x9b\xf3\xff\xcf\x18\x27\xc6\x8d\x0f\x6f\x11\x30\xf5\x5d\xe3\xc1\x1b\xdf\x85\xe4\x3d\x0a\xe2\xc9\xd1\xcf\xf1\x9d\x41\xdb\x8a\x03\xb7\xdc\xec\x0d\x5d\x98\xa5\xe0\xe0\x31\xed\xeb\x2f\x3f\xb6\xb3\xa4\xb1\x07\x1a\xe9\xd5\xd6\xf8\x34\x4b\x09\x86\x15\x5c\x37\x77\x47\xce\x15\x57\x03\x8d\x8a\xd2\xb8\x6c\x7d\x7c\x6f\x7c\xe1\x33\x88\xeb\x0a\x24\x9a\xf1\xcf\xdb\x1f\xc5\x9c\xbc\xa4\xfb\x76\xf2\x93\x23\x31\xcb\x30\x3b\x9f\x5e\xdf\xf1\x19\x39\xca\xfd\xd7\x3b\xb0\x98\x93\x97\xec\x6f\x36\x3f\x72\x4d\xcc\xeb\xda\xfb\x18\x7f\xe7\x33\x72\x94\x87\x3b\x1f\x15\x39\xac\xe4\xfd\xaf\x9d\xe1\x01\xc7\xa2\xbc\xaa\x57\x5e\xb7\xc5\x67\x64\x18\x5b\xa3\x9d\xf1\xa7\x62\x8e\x34\xce\x64\x16\x67\x9e\x64\x32\x5f\x81\x9b\x1b\x9b\xd4\xab\x8f\xd4\xbb\xe5\x72\x8f\x55\x5d\x45\x01\x9c\x2f\x14\x88\x17\x41\x23\xcd\x25\x94\x18\x29\xc4\x2d\xf5\x16\xa2\x71\x61\x10\xf0\x78\xaa\x1a\xac\x16\xb6\xf2\x66\x57\xe1\x2a\x91\x4f\xc9\xd3\xca\xd2\x94\x55\xc9\xb4\xd2\x2d\x8c\x1a\xb3\xe4\x2e\xa8\xe6\x84\x1e\x5f\xdf\xf6\xb6\x89\xeb\x93\x47\xf1\xa2\x9e\x2f\x85\x57\x75\x7c\x35\xb0\xa2\xdf\x0b\x3a\x25\xf5\x16\x21\x29\xef\xf5\x25\x39\x50\xa2\x25\x96\x91\x85\x65\x6b\x28\x51\x37\x4b\x49\xc8\xd2\x75\x54\x29\xae\xc7\xc8\xcd\xf6\x76\x4b\x51\x9f\x69\x6e\x6f\x53\x9d\x21\x29\xfb\xc5\x3a\xa3\xac\x80\x5a\x67\x54\x42\x2b\x75\x86\x3c\xfb\x60\x9d\x51\x8d\x66\x09\xe9\x59\xc4\x07\xf5\x8c\xb0\x92\xf8\x2c\xaa\xa2\x64\x6e\x59\x4d\x7e\x16\xb6\xa3\x6a\x9a\x7a\x8c\x00\x99\xad\x66\x13\xa3\x2e\x35\x02\x4a\x24\xa5\x14\x5e\x25\x28\xd5\xc0\x0a\x39\x91\x0b\x20\x31\x59\x80\x64\x09\x29\xc9\x1a\xab\x9e\xce\x57\x12\x87\x0c\x57\x99\x09\xb0\x5a\xc7\xe7\xe8\x96\xb3\x1b\x16\x77\xb1\x62\xd6\xba\x0e\x3e\xbe\xa7\x26\x28\x3f\x33\xcb\x36\xc8\x23\xf4\xda\x57\xf7\x68\x8b\x61\xe6\xb4\xff\x12\x98\x17\x48\xfc\x97\xfd\x83\xab\x37\x0c\x33\xa7\x19\x96\xc0\xcc\xb1\xf4\xf6\xbb\xb3\xcb\x90\x54\xd8\xb7\xc8\x84\x7e\xb5\xfb\xb3\x9f\x71\x7f\x12\xfb\x61\xba\xc8\x56\x23\x40\xd5\x45\x4b\xd5\x6d\x45\x19\xb5\xc6\x5d\x54\x40\xa9\x74\x8b\x85\x88\xde\x5d\x88\xac\x30\xa8\x1a\x97\xed\xa1\x77\xea\x8a\x85\x96\xb3\x69\x33\x3e\x2d\x44\x50\x94\xea\xc6\x65\xeb\xdd\xce\xfb\x81\x54\x4a\x1a\x68\x0b\xea\x5d\x02\x83\x62\xa8\x36\x2e\x5b\xd1\xfd\x37\x99\xde\x55\x6c\xf9\xac\xfe\x65\xf1\x2c\x1a\xdb\x14\xe1\xdd\x4f\xd7\x7c\xcb\xa4\xba\x5a\x52\xe7\xff\x02\x9a\x76\xa1\x83\xb4\x11\x44\xae\x13\x24\xf6\x2c\x74\x6e\xfa\x4e\x6c\x69\x77\xbf\x0c\xe7\xb5\x41\xbe\x34\xd0\x4f\x43\x4b\xcb\xfd\xf9\x6b\x00\x87\x00\xb2\x34\x2e\x1e\x90\x06\x48\xcc\x20\x4b\xe3\x23\x08\x69\x20\x70\xfa\x30\x40\x85\x87\x57\x6f\x4f\x0c\xfc\xa5\x01\x72\xe7\x5c\xe3\xee\x3a\x6b\x40\x13\xef\x42\x68\xa8\x8c\xe2\x8e\x04\x2d\xfc\x9a\x7e\x96\x42\x69\xfe\x78\xc8\xdd\x86\x40\xe8\x5a\xbf\x8e\xd3\xc4\x90\xd2\x81\x3f\x1e\x1e\xe7\xf7\xe3\xcb\xa0\x08\x3a\xb4\x9c\x27\xa4\x99\x1d\xf3\xf6\xd4\xe0\x13\x31\x22\xf2\x5b\x9d\x8f\x51\xa4\xa3\xe9\xb8\x1f\x3a\x7e\x80\xdb\x37\x3e\xfa\xf6\xda\x10\x93\x11\x9a\x2f\xec\xab\x0c\x06\xa3\x72\xfd\xd8\x0d\x70\xc3\xcc\xc9\xdb\xb7\x63\x83\x4b\x43\x48\x76\xf0\x4f\x65\xae\x96\xc4\x46\x14\x06\xf7\x98\x29\x13\xcf\xf8\x61\xb0\x04\x90\xc4\x9f\xc2\xe0\xbe\x98\xce\x8a\x90\x50\xd0\xb8\xc7\x10\x50\x74\x7c\x3b\x35\x8a\x59\x14\xcd\x1e\x4b\xa8\x02\x1d\x99\x96\xb6\xe7\xee\xbe\xde\x36\x46\xa6\x06\x46\x2d\x04\x7b\x9c\xbe\xd9\x32\x46\x2d\x0d\x8c\xda\xe8\xf3\xdd\xaf\xed\x33\x63\xd4\xd6\xc0\xa8\x83\x3e\x9b\x5f\xdf\x34\x8d\x51\x47\x03\xa3\x2e\x62\x90\x3f\xec\x7c\x37\x46\x5d\x0d\x8c\x36\x50\x6b\xf7\x4f\xfb\xbe\x31\xda\xd0\x00\xf6\x3a\x84\x52\x72\x1f\x44\x1a\x08\xa0\xe3\x59\xda\xeb\x2f\xd1\xc1\xd8\x40\xbf\x35\x30\x76\xe2\x2b\x04\xb5\xf3\x75\x10\x18\xe8\x03\x89\x22\xbc\x4b\xf1\x56\x14\xe6\xae\xf7\x66\xf4\xd6\xc8\x93\x00\xfa\x79\x00\x07\xa9\x2a\x8f\x14\xc5\x7b\x5c\xb8\x6c\xdb\x38\x3e\x30\xb8\x34\x5c\xf8\x18\xef\x9b\xa9\x72\x49\x71\xb2\xf3\x86\xcb\xb7\x3e\x5f\x5d\x19\x7c\x22\x46\xb0\x83\x7f\xab\xf3\x09\x0a\xe2\x9a\x95\xf4\xf0\xfe\xeb\xf7\x91\x21\xa4\x62\x24\xef\xc9\x47\x09\x04\x41\x13\x46\xb7\xb1\x33\xc1\x58\x76\xb7\xda\xa1\xc1\x27\x62\x24\x1f\xf1\x6f\x75\x3e\x65\x63\x74\x0b\x63\xd7\x49\xb0\xc0\xb4\x27\xfb\x07\xa7\x86\x94\x4e\x18\xca\x3e\x4b\xa1\x08\xba\xe9\x64\x92\xa1\x7b\xf3\xee\xad\xb7\x67\x48\xc9\x18\xdb\x57\xf6\x59\x06\x44\x19\xed\x4c\xfc\xd4\x09\xfc\x5f\x08\xdb\xc7\x0f\x47\xd1\x2f\x43\x4e\x27\xfc\xce\xbe\x4b\xc1\x08\xc2\xf1\x34\xc5\xfa\xe1\xcb\xc1\xa1\x4f\x81\x48\x12\x46\x73\x88\x7e\xaa\x32\x49\x61\x7a\x43\x40\xb3\xb4\xb1\xf1\x2d\x35\x0d\x21\x11\x23\x38\x22\x1f\x6a\x00\x82\x84\x1e\x49\x63\xe1\x19\xbe\xff\xbc\x6b\x08\xa9\x18\xcb\x09\xf9\x28\x81\x20\x68\xfc\x70\x10\xe1\x0e\x0b\x3f\xbc\x7d\x6f\xe4\x49\x18\xc1\x7e\x38\x88\x54\x79\xa4\x28\x3d\x7b\xc3\x14\x1c\x6c\x1d\xb9\x86\x90\x8a\x11\x9c\x92\x8f\x12\x08\x82\x86\x1c\xf9\x60\xd1\xfb\x0c\xaf\xef\x0c\x3e\x11\x23\xd9\x25\x81\xf2\x95\xf9\x5a\x7f\xc8\xb1\xf3\xdb\xdb\xad\x8d\x13\x83\x4b\x02\xfd\x61\xc6\xca\x62\x26\x2a\x9c\xb3\x71\x6f\xd4\xdc\x76\x0c\x2e\x09\xf4\x87\x19\x07\x8b\x99\xa8\x70\xc6\xbc\x5d\xa3\x1b\x19\x2c\x01\xf4\x87\x8c\x71\x62\x3a\x2a\xc2\x31\xad\xd5\x3e\xfb\x18\x1a\x5c\x1a\xe8\x0f\x73\x86\x15\x73\x51\xf1\x9c\x59\xe6\x70\x63\xf3\x95\x91\x27\x81\xfe\x30\x63\x54\x21\x4f\x9b\x38\x43\x88\xcf\xe9\x48\xe1\xb6\x73\x7f\x30\x32\xf8\x44\x80\x3e\xde\xe1\xdf\xea\x7c\x0d\xdf\x67\x9b\x86\x38\xbe\x0e\x9e\x1a\x5b\x69\x7f\xe3\xd8\x10\x93\x01\xfa\xfc\x4a\xbf\xca\x60\x08\x2a\x62\xe5\x60\x44\xce\x91\x3f\x35\xf8\x44\x8c\x66\x1f\xff\x56\xe7\x6b\xc2\xe1\x0c\xd1\x5b\x85\x33\x1b\x0d\x78\xc1\xbb\xec\xab\x0c\xc6\x0f\xfd\xd4\x77\x02\x3f\x19\xa3\x86\x6f\xbf\xbf\xda\x35\xf2\x24\xc4\x75\x64\x86\x5d\x4f\xa3\x14\x1a\x31\xbc\x81\x31\x55\x70\x9f\xae\xf6\x0f\x0c\x45\x1e\xc8\xd3\x8e\x49\x52\x25\xb4\x96\x9f\x9b\xe0\x5e\x95\xce\x52\x34\x80\x7e\x67\xf3\x48\x21\x37\x3f\x85\xd2\xa4\x63\x29\x0d\x68\xd2\x51\x14\xc6\xaf\x3a\xa3\xe2\xd0\xec\xe1\x33\xab\x52\xb8\x38\xba\x45\xad\x61\x47\x59\x1a\xd0\xe2\xe8\xd6\x08\x23\x63\x38\x4d\x53\x18\x63\x6d\xd4\x36\xde\xdc\x7d\x37\xa4\x74\x54\xf2\x63\xf4\x96\x7c\x95\x02\x69\x6e\x14\x18\x77\x89\x61\x22\x3c\x9d\x7d\xf3\x0c\x9f\xa9\x90\x14\xe0\x46\xc1\xf7\xc4\x54\x64\xe0\x52\xc9\x18\x97\xfa\xf1\xf3\x83\xd3\x36\xb2\x04\x54\xe8\x64\x6c\x16\xd3\x71\x99\xb1\x47\x6a\x6a\x5d\x7f\xfe\xee\x1a\x59\x0a\x2a\x74\xe8\x99\x8a\x0c\x5c\x2a\x18\xd2\x52\xcd\x94\xa2\xc4\x29\xa8\xd4\xc1\xd0\x54\x64\xb0\x56\xb5\x88\x98\x86\x93\x7b\x23\x4b\x21\xad\x6a\x29\x32\x58\xab\x70\x29\xd3\x6f\x4d\x87\x46\x96\x42\x9a\xd5\x52\x64\xb0\x76\x91\xba\xd2\xf6\xe6\xa9\x91\xa5\x90\x76\xb5\x14\x19\xac\x5d\xa4\xd4\xbb\xa0\x7d\x6f\x64\x29\xa4\x5d\x2d\x45\x06\x6b\x57\x5b\xb3\xb4\x4f\xdf\x27\x5b\x97\x46\x96\x40\x9a\xd5\x2e\xa6\xb3\x56\xb5\x71\x4d\x3b\x61\x77\xcf\xc8\x52\x48\xab\xda\x8a\x0c\xd6\x2a\x54\xea\x55\xbf\x0d\xdf\x19\x59\x02\x69\x54\xbb\x98\xce\xda\x44\x6a\xda\x78\x9b\x9c\x19\x59\x0a\x69\x53\x5b\x91\xc1\xda\xd4\xc1\xa5\x8e\x5f\xa5\xbf\x8c\x2c\x85\x34\xaa\xa3\xc8\x60\xad\x42\xa5\x06\x4e\x73\x3f\x36\xb2\x04\xd2\xa8\x4e\x31\x9d\xb5\x09\x95\x19\x4d\x9a\x6f\xa6\x46\x96\x40\xda\xd4\x29\xa6\xb3\x36\x61\xea\xcc\xcd\x9b\xed\x6b\x23\x4b\x21\x6d\xea\x28\x32\x58\x9b\xba\xb8\x4d\x67\xed\xdb\x63\x23\x4b\x21\x6d\xea\x2a\x32\x58\x9b\x70\xa9\xf6\xe8\xf2\xf5\x5b\x23\x4b\x21\x8d\xea\x2a\x32\x58\xab\x50\xa9\xc4\x38\xbc\x6a\x19\x59\x02\x69\x55\xb7\x98\xce\x5a\x45\x6a\x9a\x44\x5f\x5a\x46\x96\x42\x5a\xd5\x55\x64\xb0\x56\x6d\xe0\x56\x1d\x1c\x0c\x7c\x23\x4b\x21\xad\xda\x50\x64\xb0\x56\x91\x52\xd3\x9b\xf8\xc4\xc8\x52\x48\xab\x36\x14\x19\xac\x55\x1b\x64\xee\x3c\xed\x77\x8d\x2c\x85\x34\x6b\x43\x91\xc1\xda\x85\x4b\x99\x57\x1f\xd2\x0d\x23\x4b\x21\xed\xda\x50\x64\xb0\x76\x6d\xe2\x52\xc1\x99\xf9\xcd\xf8\xff\x99\x7b\x13\xf5\xb6\x71\xa5\x41\xf4\x55\x64\x9e\x1e\xfd\xc4\x11\xa8\x70\x11\x29\x52\x0a\xa2\x49\xbc\x24\xe9\x4e\x62\x27\xce\xd2\x69\x45\xc7\x1f\x17\xd0\x66\x2c\x93\x0a\x49\x79\x89\xa5\x79\x9f\x79\x8d\x7b\x5f\xec\x7e\x00\xb8\x00\x14\xe9\xe4\x4c\xff\x39\x73\xa3\xfe\xda\x52\xa1\x50\x04\x50\x40\x6d\x00\x8a\x15\x84\xf5\x6b\xdc\x52\x50\xf6\x8b\xd6\x32\x5e\xff\x15\x56\x42\x61\x5c\xf4\x6b\xdc\x52\x50\xf6\x8b\xd5\xf2\x7f\x4f\x2c\xa5\x82\xb0\x7e\x8d\x5b\x0a\xca\x7e\xb1\x5a\xb7\xdf\xdf\xa9\x4a\x05\x61\xfd\x1a\xb7\x14\x94\xfd\xb2\x99\x8c\xb9\x3d\xba\x55\x2a\x08\xeb\x97\xdd\x52\x50\xf6\x8b\xd5\x3a\x8f\x82\x43\xa5\x82\xb0\x7e\xd9\x2d\x05\x65\xbf\x58\xad\x93\x77\xee\xa9\x52\x41\x58\xbf\xec\x96\x82\xb2\x5f\xa4\xd6\xab\xdf\x15\x25\x53\x2a\x00\xeb\x96\xbd\x0b\x2f\x7b\xe5\x30\x2f\xf9\x38\xfc\xaa\x54\x10\xd6\x2b\xa7\xa5\xa0\xec\x15\xab\x65\x3d\x7b\x39\x52\x2a\x08\xeb\x95\xd3\x52\x50\xf6\x8a\xd5\x3a\xb8\x08\x9e\x2b\x15\x84\xf5\xca\x69\x29\x28\x7b\xe5\xb0\x40\x8b\x95\xbe\x54\x2a\x08\xeb\x96\xd3\x52\x50\x69\x62\x55\x9a\x48\xab\xab\xe8\xd9\x9f\x4a\x0d\x29\x54\xb1\xda\x52\x52\xe9\x62\x52\x2f\x33\x2f\xd9\xa9\x88\x02\x52\x68\x63\xb5\xa5\xa4\xd2\xc7\x2a\x9d\xf6\xc9\x9b\xfd\xd7\x4a\x0d\x2a\x34\xb2\xda\x56\x54\xe9\x64\x95\x0d\xcb\xe7\x4f\x7f\x29\x35\xa8\xd0\xca\x6a\x5b\x51\xd5\x47\xa2\xce\xef\x3e\xc5\x51\x25\x28\xb4\xca\xdc\xd0\x5a\x4a\xaa\x3e\x92\x7a\xcb\xe8\xcd\x87\x4a\x55\x69\x95\xc5\xa1\xb5\x94\x54\x7d\x64\xe6\xc3\xfe\xd5\x07\xac\xd4\xa0\xa2\x8f\x5a\x5b\x51\xd5\x47\x5a\x53\xfb\xf6\xf5\xf4\x40\xa9\x41\x45\x1f\xb5\xb6\xa2\xaa\x8f\x4c\xb5\x07\xe7\xbe\xa2\xd4\xa0\xa2\x93\x7a\x5b\x51\xd5\x4b\x5a\xd3\xb8\x5b\xee\x9b\x4a\x0d\x2a\xba\xa9\xb7\x15\x55\xfd\x2c\x0c\x9e\xfd\x4f\xb5\x0d\x55\x5a\x21\xec\x99\xcd\xa2\xaa\x9f\xac\xe6\xd1\x9b\x34\x56\x6a\x50\xd1\x4f\xbd\xad\xa8\xec\x27\xb5\x8d\x69\xf5\xf5\xa5\xfd\xea\xad\xd2\x00\xb3\x0e\x9f\xac\x97\x4b\x42\xa6\x1d\x45\x24\x45\x46\x3c\x7e\x7b\xa2\xfc\xa9\x34\xc0\x1c\x29\xad\x0b\x45\x24\x45\x27\xa8\xf1\x49\x7f\x71\xa6\x34\xe0\x1c\x2d\xb5\x13\x47\x20\x46\x57\xb3\x66\x7f\x7b\x79\xa1\x88\xe0\x9a\x94\xd3\x85\x21\x10\x22\xc2\x6e\xff\xf0\x7c\xb5\x54\x44\x68\x4d\xc7\xee\x40\x10\xc8\x30\x5d\xf0\xe1\xe3\x6b\xf1\x69\x63\x8e\xce\xb8\x0b\x43\x20\xc4\x94\xe5\xc9\xe1\xc5\xb5\x22\x82\x6b\x42\x56\x17\x86\x40\x88\x58\x13\x1f\x6f\x8f\xae\x3f\x29\x22\xb4\xa6\x63\x76\x20\x08\x64\x46\x8c\x69\xdf\x56\xb9\x22\x82\x6b\x3a\xa3\x2e\x0c\x81\x90\xc1\xc2\x7c\x7f\xe1\x40\x11\xc1\x35\x21\xa3\x0b\x43\x20\xc4\xd6\xe3\x4a\xc9\xc5\xa9\xcb\x4f\x6e\xbd\x0b\x43\x9c\x90\xd2\x44\x7a\x77\x70\xf8\xad\x31\x6f\xf9\xd9\xd8\x81\x20\x90\x61\x72\xf7\x48\xfb\x24\x3e\x8d\x9f\xd5\x6a\x17\x46\x4d\x28\xbb\x28\x56\xbd\xf6\xf4\xe4\xfa\xa5\xd2\x80\x97\xa4\xb2\x0b\xba\xfc\xdb\x71\x44\x62\x54\x54\x1a\xfb\x63\xe3\x40\x69\xc0\x39\x62\x5a\x27\x8e\x48\x8c\xf4\xf1\xc2\xb4\x5e\xdc\x2a\x0d\x30\x47\x4b\xed\x42\x11\x48\xb1\x85\x7b\xe2\x18\x27\x8a\x08\xae\x29\x39\x5d\x18\x02\x21\x66\xdb\xbc\xf1\x6e\x6e\x14\x11\x5c\x13\xb2\xbb\x30\x04\x42\xcc\xd0\xfc\xc3\xc0\x4f\x15\x11\x5c\x13\x1a\x77\x61\x08\x84\x98\x4d\x1d\x3f\xf3\xdf\x2b\x22\xb8\x26\x64\x75\x61\x08\x84\x0a\x47\x00\xe7\xe2\x08\x98\x1c\x21\xb3\x0b\x43\x20\xc4\xbc\xb8\x97\xdf\xbe\x7d\x52\x44\x70\x4d\x68\xd4\x85\x21\x10\x22\x8b\xf7\xf5\x7e\xbe\xb6\x14\x11\x5a\xd3\x31\x3a\x10\x04\x32\x6c\xe9\x9e\xbc\x0e\x52\x45\x04\xd7\x74\xf4\x2e\x0c\x71\x42\x52\x9e\xdd\x7c\x70\x0c\x71\xb6\xf1\xf3\xb1\x0b\x43\x20\xc4\xcc\xad\xab\xb7\x6f\x57\x8a\x08\xae\x09\xa9\x5d\x18\x25\xa1\x24\x0c\x33\x9c\x97\x4a\xdb\x39\xfc\xab\x32\x75\xeb\x12\x46\xee\x98\xfe\x66\x1a\xbc\x03\xaf\x49\x94\xd9\x4a\x17\x61\x61\x75\x0b\x25\x02\x51\xed\x01\xbc\x26\x51\xd2\xeb\x93\x0f\xd1\xc9\xba\x89\xab\x8a\x34\xd5\x6e\xb4\x06\xc9\xc2\x92\xff\x74\xf4\x4e\x69\x16\xf0\x14\x9d\x6e\xac\x06\x41\xba\xbc\x35\x7f\xff\x05\x56\x9a\x05\x3c\x41\xbb\x1b\xab\x41\x90\x69\xe8\x3f\xdf\xe7\x8e\xd2\x2c\xe0\x09\x8e\xbb\xb1\x1a\x04\x99\x33\x9c\xae\xbf\x69\x4a\xb3\x80\x27\x68\x75\x63\x35\x08\xb2\xf8\xc4\xef\xd7\xc1\x8d\xd2\x2c\xe0\x09\x9a\xdd\x58\x0d\x82\x4c\x77\xbf\xf2\xb5\x03\xa5\x59\xc0\x13\x1c\x75\x63\x35\x08\x32\x1d\xfe\xfa\xf9\x47\x4f\x69\x16\xf0\x04\x8d\x6e\xac\x06\x41\x26\x10\x3e\x1e\xbe\xfa\xa8\x34\x0b\x78\x82\x7a\x37\x56\x73\x6a\x33\x07\xe1\xfd\x8b\xe6\x70\x37\x56\x4b\x37\x56\x83\x20\x33\x5a\x57\xa3\xab\x13\xa5\x59\xc0\x13\x54\xbb\xb1\x4a\x47\xa2\x36\xcd\xcf\xf4\xe5\xb9\xfb\x46\x69\xc0\x99\x4b\x51\xda\xe6\x1d\x38\x22\x31\xda\x5b\xe7\xe0\x50\x7b\xa7\x34\xe0\x1c\x31\xad\x13\x47\x24\x46\x7a\x7a\x79\xf8\xfd\x52\x51\x1a\x60\x8e\x96\xda\x85\x22\x90\x22\xc2\xe0\x4d\xae\x7f\x0d\x14\x11\x5a\x13\x72\x3a\x10\x04\x32\x54\x04\x18\xd9\xcd\xc9\xbe\x22\x82\x6b\x3a\x76\x17\x86\x40\x88\x2d\xfd\x17\x1f\x83\x4f\x8a\x08\xae\x09\x8d\xbb\x30\x04\x42\x64\xc9\xdf\xbc\x3f\x3c\x72\x15\x11\x5a\xd3\xb1\x3a\x10\x04\x32\x26\xdd\x38\xfd\xb6\x7f\xa7\x88\xd0\x9a\x8c\xd9\x81\x20\x90\x61\x51\x50\xf3\xec\x77\xb1\xf7\x23\x8e\xce\xa8\x0b\x43\x20\x44\x96\xf5\xe5\x1f\xeb\xf3\x3f\x14\x11\x5a\xd3\x31\x3a\x10\x04\x32\xcc\x7c\x3d\x0f\x6f\xfe\x52\x44\x70\x4d\x47\xef\xc2\x10\xa7\xa2\x34\x91\x3e\xbc\x7a\xfe\xec\x44\x9c\x66\xfc\x44\xec\x40\x10\xc8\x30\xc3\x7c\x6c\x69\x22\x53\xf9\x09\xad\x76\x61\xd4\x84\x4a\xc3\xfc\xfa\xe3\xb7\xec\x5a\x69\x80\x4b\x4a\xcc\x2e\x6f\x47\x11\x49\x31\xb3\x3c\x4d\xbf\x3f\x53\x1a\x70\x8e\x96\xd6\x89\x23\x12\x63\xd6\x8b\x15\xbd\xf8\x4b\x69\xc0\x39\x62\x6a\x27\x8e\x40\x8c\xc5\xc7\xce\x8f\x47\x67\x8a\x08\xae\x49\x39\x5d\x18\x02\x21\x66\x98\x47\xef\x79\xe9\x55\x19\xe6\x8c\x90\xdd\x85\x21\x10\x62\x86\xb9\xf9\xfa\xdb\x7b\x45\x04\xd7\x84\xc6\x5d\x18\x02\x21\xa6\xa9\x3f\x3d\xdb\x3f\x50\x44\x70\x4d\xc8\xea\xc2\x10\x08\x31\xc3\xfc\x2e\xb2\x6e\x15\x11\x5c\x13\x32\xbb\x30\x04\x42\x64\xe9\xbe\x88\xce\x72\x5f\x11\xa1\x35\x9d\x51\x07\x82\x40\xc6\x60\x3e\xde\x81\xfe\x59\x11\xc1\x35\x1d\xa3\x0b\x43\x20\xc4\xb4\xd2\x1f\x27\x1f\xd7\x8a\x08\xae\x09\xe9\x5d\x18\xe2\x94\xa4\x3c\xbb\x4e\x73\x2c\xce\x36\x7e\x42\x76\x61\x08\x84\xd8\xe2\x8d\x9e\x9f\xbe\x52\x44\x70\x4d\x48\xed\xc2\x28\x09\x89\x86\xf9\xf9\xb3\xe3\x2a\x1c\x29\x1a\xe6\xa7\x57\xbc\x61\xde\x81\xd7\x24\xca\x7a\x1a\x5f\x7e\x53\x9a\xc8\x9a\x48\x54\x7b\x00\xaf\x49\x94\x59\x1b\xbe\xf7\x6a\xa7\x05\xaa\x48\x54\x7d\x00\xaf\x41\x94\x79\xdc\x9f\xbf\x7d\xb8\x54\x9a\x05\x3c\x49\xa7\x1b\xab\x41\x90\x2c\xf0\xa5\xf9\xaa\xd8\x8d\xe2\xe1\x3c\x3d\xbb\x13\xa9\x41\x8e\x2d\xf3\xb3\xe5\xad\xaa\x34\x0b\x78\x7a\xe3\x6e\xac\x06\x41\xb2\xdc\xdf\x86\xf9\xbb\x5b\xa5\x09\xe7\xe9\x59\x9d\x48\x0d\x72\x74\xd1\x6b\xfb\xd1\xd3\xa7\x4a\xb3\x80\xa7\x67\x76\x63\x35\x08\x32\xb3\xfc\x8f\xe3\xbb\xd7\x4a\xb3\x80\x27\x38\xea\xc6\x6a\x10\x64\x62\xe0\x4f\xfb\xf4\x54\x69\x16\xf0\x04\x8d\x6e\xac\x06\x41\xb6\x60\xd6\xb7\xd7\x9a\xd2\x2c\xe0\x09\xea\xdd\x58\xcd\x89\xcd\x3c\x91\x83\x57\xbf\x37\xe7\xab\x38\xad\xbb\xb1\x1a\x04\x99\x78\xf8\x76\x98\xff\xa1\x34\x0b\x78\x82\x6a\x37\x56\x19\xa5\xaf\xcd\x72\xef\xeb\xdb\x53\x16\x76\xe0\xc0\x2c\x5c\x5f\x5a\xe5\xed\x28\x22\x29\xe6\xb1\xbf\x53\xcf\xdf\x2b\x0d\x38\x47\x4b\xeb\xc4\x11\x89\x31\x0d\x7f\x77\x13\x9b\x4a\x03\xce\x11\x53\x3b\x71\x04\x62\x44\x10\x7c\x36\xae\x94\xaf\x8a\x08\xad\x29\x39\x1d\x08\x02\x19\xa6\xdf\x2f\xef\x2e\x1d\x45\x04\xd7\x74\xec\x2e\x0c\x81\x10\x59\xf8\x41\x9c\xbe\x7e\xae\x88\xd0\x9a\xce\xb8\x03\x41\x20\xc3\x22\xe6\xdf\xdf\x7c\x0e\x15\x11\x5c\xd3\xb1\xba\x30\x04\x42\x6c\xa1\x2f\xcf\x6f\x5f\x28\x22\xb8\x26\x64\x76\x61\x08\x84\xe8\x31\x88\x97\x67\xca\xa9\x22\x42\x6b\x3a\xa3\x0e\x04\x81\x0c\xf3\xb6\xef\xf6\x35\x71\xc6\x19\x1c\x1d\xa3\x0b\x43\x20\xc4\x96\x73\xba\xfe\x43\x53\x44\x70\x4d\x48\xef\xc2\x10\xa7\x23\x73\x86\x2f\x6f\xdf\x8a\x33\x8d\x9f\x8c\x5d\x18\x02\x21\xb6\x7c\xdf\x1c\x84\xb9\x22\x82\x6b\x42\x6a\x17\x46\x4d\xa8\x8a\x99\xeb\xc1\xe5\xf2\x58\x69\xc0\x4b\x52\x45\xcc\xbc\x1d\x47\x24\xc6\x96\xee\xb1\xf1\xfe\x54\x69\xc0\x39\x62\x5a\x27\x8e\x48\x8c\xe9\xf2\x17\xe3\xcf\x23\xa5\x01\xe7\x88\xa9\x9d\x38\x02\x31\x16\x5e\x3b\x79\x79\xaa\x2a\x22\xb8\x26\xe5\x74\x61\x08\x84\x58\x58\xed\xdb\xdb\xb7\x91\x22\x82\x6b\x42\x76\x17\x86\x40\x88\x2c\xde\xcf\xf6\xeb\x77\xef\x15\x11\x5a\xd3\x19\x77\x20\x08\x64\x98\x69\x7e\xf6\xfe\xd3\x5b\x45\x04\xd7\x74\xac\x2e\x0c\x81\x90\x59\x28\xd5\xf4\xab\x22\x82\x6b\x42\x66\x17\x86\x40\x88\xc5\xcc\x4f\xd6\xa7\xb6\x22\x82\x6b\x42\xa3\x2e\x0c\x81\x10\x3b\x78\xf5\xfd\xa5\x72\xa6\x88\xe0\x9a\x90\xd1\x85\x21\x10\x62\x41\xb2\x83\x34\x12\x1b\xce\xcf\x70\xbd\x0b\x43\x9c\x92\x2c\x7c\x37\x56\x1a\xb3\x8d\x9f\x90\x5d\x18\x02\x21\xa6\x95\x2e\x4f\xdc\x1b\x45\x04\xd7\x84\xd4\x2e\x8c\x92\x90\x60\x9c\x1b\x27\x37\x61\x25\xe5\x45\xe3\xfc\x75\xc0\x19\xe7\x5d\x78\x4d\xa2\xa4\xa7\xd9\x0b\x2f\xba\x69\xe2\x6a\x22\x4d\xad\x1b\xad\x49\x92\x2d\xe7\x73\xfc\x4d\x6b\x22\xab\x22\x4d\xf5\x01\xbc\x06\x51\xe6\x73\xdf\xad\x7e\xf7\x95\x66\x01\x4f\xd2\xe9\xc6\x6a\x10\x24\xcb\xfb\x2e\x38\x58\x9f\x2a\x4d\x38\x4f\xcf\xee\x44\x6a\x90\x63\xa6\xf9\xeb\x53\xe3\x99\xd2\x2c\xe0\xe9\x8d\xbb\xb1\x1a\x04\x99\xae\x7e\x6a\xab\x17\x4a\xb3\x80\x27\x68\x75\x63\x35\x08\xb2\x65\x7f\x6e\xac\xce\x94\x66\x01\x4f\xd0\xec\xc6\x6a\x10\x64\xcb\xff\xe8\xeb\xdb\xe6\x60\x8f\x04\x82\xa3\x6e\xac\x06\x41\x22\x06\xfe\xc4\xde\xf7\x58\x69\xc2\x79\x7a\x46\x27\x52\x83\x1c\x59\x2e\x7f\xde\x38\xe9\x95\xd2\x84\xf3\xe4\xf4\x4e\xa4\xe6\xb4\x96\x26\xd2\xfe\xfb\xe4\x45\xd0\x9c\xac\xe2\x9c\xee\x44\x6a\x90\x23\x8b\x24\xf9\xf3\xd0\x7f\xa5\x34\xe1\x3c\x39\xb5\x13\xa9\x3c\x00\xc3\xc5\xca\x0d\xfd\xec\x86\x85\x0a\x38\x38\x3b\x0a\x53\xc5\xca\xdb\x71\x44\x62\x4c\xb7\x8f\xf0\xe1\x8d\xd2\x80\x73\xc4\xb4\x4e\x1c\x91\x18\xb3\x5f\x9c\x6f\xee\x37\xa5\x01\xe7\x88\xa9\x9d\x38\x02\x31\xe6\x9f\x9f\x8e\x3f\x27\x8a\x08\xae\x49\x39\x5d\x18\x02\x21\x66\x98\xef\x9f\x1f\x3f\x53\x44\x70\x4d\xc8\xee\xc2\x10\x08\xb1\x78\xf9\xef\xd7\xea\x2b\x45\x04\xd7\x84\xc6\x5d\x18\x02\x21\xb6\xdc\x57\x2f\x5e\x7f\x57\x44\x70\x4d\xc8\xea\xc2\x10\x08\xb1\xad\xb1\xef\xd7\xd9\x9d\x22\x82\x6b\x42\x66\x17\x86\x40\x88\x2c\xef\xec\x28\xbb\xfa\xac\x88\xd0\x9a\xce\xa8\x03\x41\x20\x43\x16\xf5\x87\xc8\x78\xe9\x2a\x22\xb4\x26\x63\x74\x20\x08\x64\xc8\x04\xff\x74\x75\xfe\xe7\xb5\x22\x42\x6b\x32\x7a\x07\x82\x38\x1d\x59\xf4\xee\xf5\xe1\x95\x38\xd3\xf8\xc9\xd8\x85\x21\x10\x22\xd3\xfa\xed\xa7\x77\xef\x7d\x45\x84\xd6\x74\xd4\x0e\x84\x9a\x4c\x6d\x94\x1f\x1d\xbf\xfe\xa4\x34\xe0\x25\xa5\xd2\x28\x6f\xc5\x11\x89\x91\xde\xbd\x0c\x3e\x7d\x4f\x95\x06\x98\xa3\xa5\x75\xa1\x88\xa4\x98\x0e\xff\x76\x63\x78\x4a\x03\xce\xd1\x52\x3b\x71\x04\x62\x4c\x77\xbf\xfa\xf3\xab\xaa\x88\xe0\x9a\x94\xd3\x85\x21\x10\x62\x26\xb9\xf5\x2d\xfa\xa8\x88\xe0\x9a\x90\xdd\x85\x21\x10\x1a\x33\xef\xe5\xed\xa5\xa5\x88\xe0\x9a\xd0\xb8\x0b\x43\x20\xc4\x8c\xf2\x44\xcb\xb0\x22\x82\x6b\x42\x56\x17\x86\x40\x88\x2d\xdb\xe0\xea\xee\x0f\x45\x04\xd7\x84\xcc\x2e\x0c\x81\x10\x0b\x99\xad\xd2\xb5\xa3\x88\xe0\x9a\xd0\xa8\x0b\x43\x20\xc4\x8c\xf2\xe5\xb3\x0f\x9f\x15\x11\x5c\x13\x32\xba\x30\x04\x42\x4c\x37\x1d\xad\x0f\xc4\xe9\xcb\xcf\x70\xbd\x0b\x43\x9c\x94\xb4\x45\x9f\x3f\xbd\x50\xc4\xf9\xc6\x4f\xc9\x2e\x0c\x81\x10\x33\xca\xa3\x8f\x6f\x6e\x14\x11\x5c\x13\x52\xbb\x30\x4a\x42\xbc\x51\xbe\x7e\xfb\xee\x73\x75\x96\x56\xb4\xc9\x5f\x9d\xd7\x36\x79\x17\x5a\x93\x24\x73\x3e\xf2\x4f\xb7\x5e\x13\x59\x13\x69\x6a\x0f\xe0\x35\x89\xb2\x3e\xab\x2f\xb5\xb4\x89\xac\x8a\x44\xd5\x07\xf0\x1a\x44\xd9\xc2\xfe\xfe\xe7\xbb\x66\x03\x1c\x81\xa4\xd3\x8d\xd5\x20\xc8\xf6\xb1\x5f\xbc\xb9\xba\x55\x9a\x05\x3c\x41\xbb\x1b\xab\x41\x90\xe9\xe7\xab\x97\x97\xb1\xd2\x2c\xe0\x09\x8e\xbb\xb1\x1a\x04\xd9\xc9\x35\xfd\xf4\xc3\x33\xa5\x59\xc0\x13\xb4\xba\xb1\x1a\x04\x8b\x98\xf9\xbb\x91\xaf\x34\x0b\x78\x82\x66\x37\x56\x83\x20\xdb\xeb\x7e\xfa\xe9\x32\x57\x9a\x05\x3c\xc1\x51\x37\x56\x83\x20\x13\x04\xcf\xbe\x7f\x7f\xaa\x34\x0b\x78\x82\x46\x37\x56\x83\x20\x13\x08\x87\xe7\xb7\x91\xd2\x2c\xe0\x09\xea\xdd\x58\xcd\xc9\x4d\x09\xbe\x7d\xf1\xf5\x79\x73\xce\x8a\x53\xbb\x1b\xab\x41\x90\x69\xbf\xaf\xef\x2f\x9b\xc3\x2d\xae\x15\xb5\x1b\xab\x48\x5d\xe1\x27\x71\x80\xe3\xac\x48\x10\xb1\xfc\x4b\x79\xae\x34\x0b\x58\xf2\x8a\xfd\xf2\x77\x37\x5e\x41\x32\xcb\xd3\x68\x55\x5c\xab\xbd\xcb\x8e\x8e\x15\x11\xcc\xc8\x9d\xb2\x5f\x5d\x38\x05\xa9\x8b\xe4\xba\xb8\xe4\xfb\x36\x30\xff\x52\x78\x20\x23\xf3\x82\x7c\x6f\x2f\x77\xfd\xbc\x48\x8e\x91\x5f\x7f\xfe\xac\xb0\x9f\x12\xcc\xaa\x8b\xe4\xfa\xf1\xf9\x73\xbd\xbe\x04\x1d\xb1\x7b\xce\xfa\xcb\xfc\xa5\x59\x5c\x72\xbe\x29\x6f\x30\xff\x91\xbe\x7b\x6d\xd7\xb7\x97\x83\xe2\x72\xf2\xfe\xcd\xfe\xf9\x9b\xfa\x62\x72\xf3\x6d\x26\x4c\xf5\xb6\xbd\xe7\xa4\x68\xbd\x90\xc3\xa3\x0b\x93\xa6\x9d\x20\x0d\xc3\xaf\xf6\x0f\x58\x12\x0a\x09\x06\x11\xcd\x44\x41\xc7\xef\xc3\xc9\x95\xaf\x94\x00\x09\x4a\x5e\x1e\x97\xef\x60\x20\x2d\x18\x2b\x23\x1c\x28\x3c\x10\x7a\x79\x7c\xc0\xbe\xb7\x97\x27\x2b\x1c\x4f\x24\x7f\x79\x16\x5f\x2a\xe4\xbb\x04\xa5\x20\x4d\x56\x41\x72\x13\x2b\x79\x72\x7e\x5e\x24\xd2\xf8\x23\xfe\x7c\xa8\x34\x0b\x60\x09\x78\x4f\x7f\x77\xe3\xd1\xcc\xca\x64\x32\xd5\x79\x96\x8b\xc6\xd7\x97\xe0\xcf\xb4\x8f\xde\xc9\x2b\x85\x07\x92\xc6\x57\xf7\xe0\xdb\xca\x29\x09\x3e\xa3\xc0\x89\x7a\xc9\x52\xd3\xd6\x97\xe1\xf3\x98\xcb\x27\xb0\x5b\x4e\x49\x94\x17\xe2\x75\xf3\xee\x46\x57\x2a\x08\xa9\x5c\x5c\x89\x6f\x96\xd0\x6a\xf5\xa5\x78\x25\x3f\x3f\x62\xf9\x6d\xeb\x4b\xf1\x79\x5c\xdd\x8a\x6f\x29\x66\xac\xab\xaf\xc5\x87\xc7\x9f\x3c\x85\x83\x51\xc6\x55\x17\xe3\x77\x4a\x69\xf5\x65\x14\x5f\xb2\x5d\x1d\xf3\xfa\x83\x52\x41\x48\xd5\x57\x51\x7c\xd9\x52\xc2\xaa\xd1\x6b\xfc\xa3\xcf\xf6\xb7\x97\x4a\xf1\x9b\x56\x39\xdf\x81\x4a\x75\x32\x5f\x56\x49\x77\xf6\xef\x4e\x15\x01\x4a\xaa\x3e\x27\x3f\x68\xfd\x36\x04\xc6\xa5\x2b\xba\x44\xd4\xe3\x68\xa5\x14\xbf\x29\x6f\xae\x76\xa0\xdc\x43\x59\x25\x1d\x1f\x1e\xef\x2b\x02\xb4\x7a\x28\xad\xdf\x86\x40\xa9\xdc\x66\x6c\x78\xf6\xaf\x0f\x95\xe2\x37\xa9\xf9\x67\xb6\x03\xe5\x1e\xca\x2a\x69\x4f\xf5\xf7\xb7\x8a\x00\xad\x1e\x4a\xeb\xb7\x21\x50\x2a\xf4\xba\x3c\x53\xf5\x91\xcf\xb8\xc6\x40\xa4\xfe\x33\xf2\xad\xad\xac\x6e\x00\x13\xa6\x42\xbe\xe4\xfa\xd1\x6d\x65\xd2\x6e\xca\x65\x69\x22\x75\x65\x63\xae\x69\x7d\x2c\x20\x0f\xe1\x4a\x5c\x9e\x67\x3a\x2c\xcd\xe4\xcf\x94\xdc\xfb\x22\x11\x74\x6b\xb9\x14\xc5\xab\x75\xce\xf5\x6d\x79\xbe\xfa\x4b\xe1\x81\x90\xfe\xa8\xfa\xb7\x5b\xce\xf5\x90\x25\x80\x89\xa8\xce\x39\xff\xfe\xda\xf1\x95\xb6\xb2\xaa\x8f\xbf\x97\xa0\x07\xb1\x6b\x91\x77\x85\xe3\x35\x69\xa4\xe9\x3e\x77\x6e\x14\x11\x5c\x89\xbb\xd7\x38\x5e\x77\xe1\xc4\xee\x35\xbd\xc0\x54\xe4\xb5\x96\xa0\x14\xbb\xd7\x4a\x10\x5d\x47\x65\x0e\x8b\xaf\x37\x17\x58\xe1\x81\xa4\xce\x01\xfb\xde\x5e\x4e\x49\xe4\xae\xc7\x66\xb3\x1f\x7d\x3e\x51\x2a\x08\xa9\xfc\xde\xf5\xb2\x96\x12\x5a\x8d\x1f\xaf\x33\x23\x7e\xf5\xfb\x53\x45\x04\x13\x02\xdc\x28\xb5\xe3\x94\x9d\x64\xba\xeb\xe3\x41\xd5\xeb\xe2\x29\xab\x68\xb9\x64\x59\x14\x22\xf3\xf2\x93\x52\x83\x08\xf5\x13\xf2\xad\xad\x8c\x56\xcd\x72\xd7\xbf\x2c\x9a\xb7\x0a\x3f\xfe\xa5\xf0\x40\x52\xfd\x94\x7d\x6f\x2f\xaf\x86\x46\xec\xa8\x66\x67\x6f\x5f\x29\x2d\x65\xe5\x70\xf1\x3d\xee\x46\x26\x6a\x5e\x29\x52\xaf\x4b\x13\xe9\xe8\xdc\x3b\x39\x50\x78\x18\xd1\xed\xfb\x65\x6a\xf6\x96\x62\x4a\x60\xe5\x16\xf9\x42\xbe\x69\x1f\x8f\x94\x0a\x42\xea\x9e\xb8\x2c\x53\x48\xa3\x64\xe5\x9e\x47\xb1\x4b\x93\x68\x4b\xb1\x66\xbe\x8e\x95\x1a\xc2\xb2\xa2\x14\x3f\x98\x58\x7e\x65\xbe\x1c\x9d\x2b\x22\x94\xa3\x41\x04\x73\x2b\x0a\x4f\xa8\x90\xcf\xe9\x67\xfd\x9b\x22\x82\x39\x4a\x4c\x5a\xb7\xe3\xc4\x78\x39\x91\xb8\xd7\xa5\x51\xf2\x65\x16\x77\xda\xfd\x46\x66\xf7\xa2\xd2\xb3\x24\xb8\x6b\x2d\x95\x84\xb7\x34\xd0\xd6\xed\xbe\xbc\xa1\x20\xf2\x82\xfd\xea\xc2\x29\x48\xe5\x51\x5e\x24\x24\xd3\xf4\xb7\x6f\x15\x1e\xc8\xc8\xbc\x27\xdf\xdb\xcb\x25\xfe\x9d\x0e\x6c\x37\xb6\xf9\xa6\x87\x82\xc8\x11\xfd\xd1\x81\x21\xd5\xaf\x57\x63\x47\xee\xc4\x57\xae\xb1\x0c\x32\xa5\x24\xdc\x2d\x95\xc4\x97\x48\xb0\x10\xd4\xee\xcb\x25\x8a\x96\xec\x17\x3f\x3b\xb1\xb8\xd6\x28\x51\x8e\xc9\x14\x48\x2f\xfe\x7a\xf5\x46\x69\xc2\xeb\x66\xbd\xcc\xf1\x55\x27\x96\xc4\xbd\xd9\x8d\xb2\xbc\xf9\xba\xb7\xa2\x61\x95\xa8\xdf\x2d\x97\x84\x17\x8b\xb1\x40\xd4\xce\xfb\xc6\x0a\x32\xb5\x61\xdb\x8a\x23\x09\x2f\xb6\xa2\x63\xb5\xfb\xbe\xab\x82\x14\x67\x66\xb6\xe1\x48\xc2\xfb\x94\x28\xa9\xdd\xd7\x2c\x15\xa4\x38\x73\xb3\x0d\x47\xaa\x5f\xe9\xc3\xce\x77\x88\xaf\xf9\x29\x88\x94\x66\xe7\x4e\xa9\x24\xbc\x40\x86\xb6\x64\xf7\xbd\x32\x05\x11\x2e\x8d\x55\x1b\x8e\xc4\xbf\xbb\x84\xae\xaf\x9d\x37\x9a\x94\x03\x5d\x1a\xa2\x6d\x18\xd5\x6b\x28\xa4\xc6\x7b\x29\x88\x27\xcb\xbd\xa3\x81\xb6\x75\xe7\xcd\x0d\x12\x64\xbf\x4a\xc3\xa8\x0d\x83\x25\x1b\x2a\xf3\xdd\x35\xde\xc6\xc0\x52\x0d\x15\xf9\xee\x76\xca\x2e\xa2\x00\x4f\xa4\xfa\xcd\x0c\x12\xcc\x2e\x58\x32\xa0\xea\x3d\x0c\xc4\xfc\x28\xb2\x5b\x92\x2e\x88\x6f\x5e\x28\xb3\x7d\xd1\xba\xf4\xd4\x8e\xf0\xd2\x05\x96\xe9\xeb\x05\x7d\xc8\x6e\x19\xcb\x71\x49\xcf\x3a\xd7\x2f\x51\x90\x20\x7d\x4d\xc2\x44\xe2\xde\x99\x20\x41\xa9\x4e\xca\xce\x36\xd6\xc5\x6c\xf4\x12\x2c\xbe\x53\xd3\x73\xb7\x54\xaa\x73\x73\x4b\x13\xa9\x99\xd5\xbc\xaa\x4d\xe4\xf7\x6e\xa1\x54\xe7\x65\x66\x81\x76\x31\xab\x75\x55\xfb\x75\xd0\x5a\x2a\xd5\x39\x7f\xa5\x89\xd4\xcc\x76\x5c\xd5\x26\x5a\x68\xb7\x50\x6a\xa6\xc9\xa7\x83\xdc\x9a\x40\x9f\x1b\x83\x6a\xb2\x74\x20\x4a\x3b\x29\xee\xd9\xc1\xa5\xd6\x5c\xfa\x1c\xdd\x3a\x75\x57\x17\xea\x2e\x65\xae\xd9\x0f\x65\xd7\xdf\x79\x4a\xd5\x87\x87\x6b\x49\xcd\xdc\xf1\xcc\x06\x6c\xcb\x2a\xcf\xb1\xb9\x24\xde\x85\x28\xed\xe4\x72\xa7\x1d\x68\x4f\x25\xcf\xd1\xad\x06\xa8\x13\x75\x97\x32\x37\x40\x0f\xa5\x91\xdf\x79\x4a\x35\x40\x0f\xd7\x92\x9a\xd9\xd1\xe9\x93\x5a\xf3\xa6\x73\x33\xb9\x22\xde\x81\x28\xed\xe4\x2a\x97\x8a\xfc\x0d\x2d\x69\xcc\x39\xba\xf5\x0c\xea\x42\xdd\xa5\xcc\x35\xfb\xa1\x34\xe9\x3b\x4f\xa9\xfa\xf0\x70\x2d\xa9\x99\x2f\x5c\x9a\x48\xed\x89\xc4\xb9\xb5\x5a\xd0\xee\xc2\x93\x76\xf2\x7c\x33\x9f\xb9\x35\xa3\x38\x47\xb6\x9e\x3f\x5d\xa8\xbb\x94\xb9\x69\xff\x50\x8a\xf1\x9d\xa7\x54\x6b\xe0\xe1\x5a\x52\x95\x58\x9c\x59\x75\x42\xae\xf1\x52\x86\x33\x8f\xbf\x59\x26\x55\x99\xc3\x69\x55\x31\x99\x78\x59\x95\xda\xcc\x3b\x65\x52\x95\x1a\x5c\x9a\x48\x8d\x64\xe1\x65\x4d\x22\x6f\x77\x8a\xa4\x2a\x1d\x38\xb3\xa9\x85\x0c\xe1\x65\x4d\x16\x8a\x69\x96\x49\x42\x0e\x67\x2a\xea\x77\x13\x69\x57\xc3\x78\x42\x7e\x75\xe1\x48\x2d\x69\xb0\xd9\x91\x9e\x8e\x1c\xd9\x22\xd9\x2a\x0a\xd2\x8d\x2e\xb5\x25\x9c\xa6\x5d\xee\x4c\x87\x2d\x3e\xa3\x5e\x89\x0f\x54\x68\x7d\x0a\x37\xe1\x7e\x90\xfa\xba\xed\x89\xd5\xb4\xfb\x61\x5d\x89\xcf\x81\x2d\x4d\xa4\xdd\xc4\xd8\x25\x3f\x0b\x5e\xb4\x20\x6c\xb7\x30\x5c\xc7\x3e\xf1\xc3\x64\x0c\x73\x18\x83\xfb\xbd\x0a\x90\xc3\x14\xdc\xe3\x21\xbe\x5d\x25\x69\x9e\xa1\x54\x8e\x65\x15\x80\xad\xac\x72\x75\xc0\x7d\x8a\xf3\x75\x1a\xf7\x78\x50\xf9\xbd\x97\xcb\x29\xb8\x8f\x42\x39\x9e\xa7\x0b\x50\x20\x92\xef\x25\xcd\xe9\xb5\x9b\xf6\x12\x44\x40\xe8\x3e\x9a\xa4\x70\x39\xd9\xd3\x60\x51\x38\xb9\xdf\x6e\xa7\x45\x25\x4c\x2a\xf9\xee\x72\x29\x27\x65\x5d\x98\xc0\xfa\x7b\x0e\x60\x32\x5c\xa2\x3d\xb5\x86\x6d\x09\xed\x18\xdd\x57\x34\xf2\xe1\x15\xc2\x30\x1f\xfa\x28\x86\xf9\x30\x40\x5c\xc7\x63\xd2\xd3\x7c\x98\x90\xaf\x60\xb3\x39\xf6\xbe\x62\x3f\x1f\x06\x38\x8c\x62\x7c\x92\x26\x2b\x9c\xe6\x77\x14\xed\x1e\xc7\xeb\x2b\x9c\xd2\xa4\xcf\x7b\x2a\x3c\xc7\xf9\x24\xdd\x82\x2d\xcc\x87\x29\xe2\x47\x40\x5a\xc7\xac\x76\x20\xed\xa1\xfc\x6e\x85\x93\xb0\x77\x7a\x77\xe5\x25\xcb\x7e\x9f\xfd\x1d\xe6\xc9\x69\x9e\x46\xf1\xf9\x7b\xf7\xbc\xdf\xef\x7a\xe2\x2e\x2e\xbc\xbf\x76\x97\x6b\x3c\x91\x5e\x27\xc1\x7a\x89\xa5\x2d\x80\x5d\x95\xa5\xb3\x33\x9c\x15\x68\x65\xb5\x3d\x95\x35\x37\x17\xba\x4f\x99\xa4\xf5\xe3\x7e\x5f\xc6\x28\x97\x31\x00\xd0\xee\xc7\x25\xc7\xf0\x34\x0a\xe5\x11\x29\x95\x12\xfa\x28\x09\x95\x7d\xc2\xfd\x3e\xf9\x6f\x58\x3f\xa9\xae\x44\xc6\x3f\x45\x45\xe3\xfc\x14\xbb\x39\x96\xe3\xf5\x72\x09\x08\xb9\x7c\x98\xca\x69\x57\xd3\x53\x28\x55\x5e\x58\x63\xc4\x59\x2f\xf0\x16\x40\x9d\x36\x28\xa3\xe3\x52\x0f\x32\x06\x61\x92\xca\x74\x5a\xf5\xa2\xb8\x87\x41\x3e\x0c\xe4\x14\x26\xf5\x94\xcd\xab\x29\x8b\xe7\xf9\x62\x3b\xf4\xa2\x38\xa0\xed\x82\x09\x00\xe5\x5c\x49\xc9\x18\xc5\x02\x4b\xd9\x74\x6a\xf4\x76\x56\x61\xd4\x54\x87\x45\xdb\xb7\x93\x96\x42\x6e\x36\x06\x72\x0c\x25\x57\x82\x31\x80\x31\x79\x5c\xc2\xb3\xa4\x6e\x65\x31\x44\xab\x34\xc9\x13\xd2\xc9\xe1\x85\x9b\x1d\xdf\xc4\xe5\x60\xb1\x55\x41\x2a\x10\x1a\x2b\x24\x49\x30\x97\xf3\x61\x86\x46\x36\xd8\xca\x73\x6e\x31\xc7\x64\x8a\x97\x8b\x19\x8b\x0b\xbf\xea\x5e\x85\x70\x7f\x8d\xd3\x8c\x46\x90\xf4\xa1\x35\xd4\x74\x69\x3b\x95\xe2\xf5\x95\x87\xd3\x9a\xfb\x67\x67\xb8\xdf\x97\xcf\xce\x30\x8a\xc1\xae\x20\x61\xfc\x8f\x65\xdd\x02\xb2\x74\x73\x99\x49\x00\x26\x28\x96\xb5\x31\x80\x2e\x8a\x65\x03\x0c\xd9\xec\x86\x4b\x24\x95\x75\x6b\xda\xee\x54\xae\x1b\xd3\x22\x6f\xd2\x39\x5e\x6c\x36\x32\xf9\x83\x96\xfd\xbe\xcb\x7e\x2e\x67\xee\x24\x01\xb2\x54\xac\x1b\x69\x80\x01\xd8\x82\x61\x96\x27\x29\x46\xe9\x8f\xfa\xdc\xb6\x6a\x6f\xa2\x38\x48\x6e\xfa\x7d\xf6\x77\xf8\xda\xcd\x2f\x10\x22\xff\x9f\x31\xc8\xa4\xad\x52\x86\x97\x61\xbf\x4f\xfe\xcf\x57\x20\xbf\x27\x47\x65\x0b\xa4\x72\x22\x5c\x44\x99\x04\x64\xd0\x36\xbc\xe7\x74\x78\xcf\x5b\x87\xb7\x6e\xf6\x5e\x2c\xdb\x40\xde\x9d\x6c\xe3\x3d\xd4\xbe\xbe\xee\xb7\x74\xe2\xdd\x13\xf1\xd5\x52\x6d\xbb\x05\x43\x77\x0b\xda\x47\xeb\x7e\xdb\x98\x80\xd3\x36\x36\x71\xf3\x37\xe6\x66\x68\xf7\x24\x19\x17\x93\xc3\x02\x1c\xbd\x58\x1e\x81\x59\xb3\x46\xc9\xff\x61\x48\x21\x89\xac\xc1\x18\x00\x6e\xb1\x89\x78\x64\x91\xa3\x18\xe2\x07\x9e\xad\xa9\xec\xe1\x86\xc9\x66\xa6\x6e\x00\xb8\x6c\x1f\xba\x69\x3e\x0c\x59\xb3\x5a\x8b\x77\x1a\x11\x85\x72\x2a\x63\x00\x73\xe4\xca\x39\xdc\x53\x01\x4c\xe5\x18\xc0\x04\xe4\xe9\x5d\xd9\xc2\x65\x81\xbc\xf5\xdd\xdc\xbf\x20\x53\x7c\x1b\x85\xb2\x74\x8e\x73\x29\x8a\x7b\xf1\x66\x23\x65\xc5\x57\x90\x5f\xa4\xc9\x4d\xef\xfd\xdd\x0a\x1f\xa6\x69\x92\xca\xd2\x53\x1a\x15\x4a\xd2\xac\x17\x27\x79\x2f\x5b\xaf\xc8\xc0\xe1\x60\x4f\x2a\x25\x99\x44\x05\x26\xad\x4d\x84\x3b\x1d\x8c\x21\x85\x81\x9d\x31\xe1\xe7\x14\xbf\xe2\xea\xb6\xee\xed\x61\x99\x6b\x67\x01\x54\xb7\x0f\x0c\xee\xa8\x18\x5c\x5d\x07\xad\x33\xa5\x66\xa8\x9c\x10\xc5\xf3\x10\x9f\xb4\x2e\x12\x51\x28\xef\x91\x71\xde\x19\x20\x3c\x90\x7a\x11\x1b\x1c\x37\xee\x31\xed\x55\x0f\x4e\xef\x67\x47\xa0\x18\xcb\x1d\xed\x37\x23\x7a\x63\x0f\x21\x3c\x69\x91\x60\x0f\x11\xbf\x7f\xa0\x9b\x86\x53\x8c\xd8\x98\xef\x6e\x31\xdf\x2e\xf1\x5d\xb6\xd9\xb4\x0e\x1f\x86\x49\x73\xf4\x04\x29\xa1\x3e\xf0\xc8\x62\xf5\xb1\xf9\x6f\xd2\xf9\x1f\xcb\x16\x80\x11\xf9\x09\xa0\xb8\xb4\xcb\x9a\x3e\x5c\xc3\x10\x06\x08\xf7\xb3\xe1\x11\x5c\xd1\xbf\xcf\xa1\x47\xff\x9e\xc2\x0b\xfa\xf7\x04\x5e\xd1\xbf\xcf\xe0\x39\xfd\xfb\x09\x5e\xa3\xd5\x2c\x99\x24\xf3\x9c\xc8\x6b\xf2\x07\xdd\x6f\x01\xbc\x43\xd7\xb5\x8a\x83\x67\x68\x35\x4b\x27\xde\x2c\x9d\xe7\x8b\x89\x9c\x52\xdc\xfb\x2d\xa8\x31\xa6\x44\xcb\xfb\x44\xc3\xaf\xfa\x7d\x39\x46\x39\x80\x31\x90\xd7\x68\x2f\xe8\xf7\xcf\xfa\xfd\xeb\x24\x0a\x7a\xea\x1e\x42\x67\x73\x7f\x01\xfa\xfd\x48\xbe\x86\x3e\xd8\x6c\xe4\x10\xad\x67\x04\x36\x89\xe7\xfe\x02\x5e\xcf\xfd\x05\x5a\xf5\xfb\x35\xf3\x2a\x09\x4e\x90\x66\x04\x69\x72\xd5\xef\xaf\x67\xae\x1c\xc2\x14\x4c\xce\xfb\x7d\x52\x80\x50\x38\x6b\x9a\x06\x9c\x41\x95\x33\x7b\x92\x58\x39\x17\x51\xd6\x8b\xe2\x2c\x77\x63\x9f\x59\x27\xf7\xd9\x4d\x44\x56\x8f\x9b\x9e\xaf\xaf\x70\x9c\x67\xc3\x25\x8e\xcf\xf3\x0b\x70\xef\xbb\x19\xee\xa9\x93\x52\x6a\xe2\x9b\x1e\x9e\x52\x98\x26\xc0\xe4\x1c\x30\xb0\xde\x00\x13\x09\xd2\x84\xc0\xb4\x82\xe1\xa1\xbb\x5a\x2d\xef\x68\x93\x60\xf5\x74\xc0\xd9\x23\xd5\xe0\x22\xcc\xb1\x22\xdf\xca\x21\x98\x5c\xf0\x83\x54\xcd\xf0\x70\xe6\xca\xa5\x3e\xa3\x52\x1e\x86\x60\x12\xc2\x8b\x7e\x5f\x96\xaf\x87\xd7\x51\x9a\xaf\xdd\xe5\x66\x53\x7f\x27\xac\x06\x64\x00\x43\x48\x26\xc3\xbb\x7e\xff\xae\xdf\xdf\xbb\x9b\xfb\x8b\x7e\x7f\x29\xdf\x41\x1f\x86\x00\x80\xed\x34\x1b\x1e\x21\x0d\x66\xc3\xe7\x48\x87\xd9\xf0\x14\x8d\x60\x36\x3c\x41\x36\xcc\x86\xcf\x90\x66\xc1\x6c\xf8\x09\x19\xa4\xe4\x03\xb2\x48\xd1\x3b\xa4\xe9\x36\xac\xa7\x7a\xf6\x33\xab\xba\x56\x51\x82\x89\x29\x6b\x7d\x0c\xa0\x9f\xc4\x61\x74\xbe\x2e\x61\x3a\x81\xdd\xa4\x51\x5e\xfc\x1e\x91\xdf\xcc\x14\xcd\x9b\xc2\xaf\x54\x94\x2a\x4c\xa9\xda\x1f\xa6\x6e\x1c\x24\x57\xf2\xc3\xe2\xaf\xb0\x58\x64\x69\xe8\x27\xb1\xef\xe6\x32\x9b\xc2\x08\x21\x3c\x93\xa4\x09\x86\x12\x38\x93\xa0\x3c\x18\xc4\x83\x14\x54\xfe\x80\x6c\x58\x0f\x4a\xcc\x1f\x0a\x5d\x26\x55\xa8\x8e\xda\x95\x1d\x44\xd7\xdd\x6f\xc9\x5c\xa0\xca\xed\x65\x76\x58\x8d\xd3\xee\x23\xa5\x75\x86\x7b\xc4\x1a\xf7\x73\x69\x5a\x3e\xdf\xd4\x81\xbc\xa7\x82\x69\x2c\x1b\x23\x20\xb3\x36\x43\x89\xfd\x95\x04\x2f\x92\x4c\xcb\xe1\x59\x8e\x8a\x7e\x11\x95\x49\x21\x11\xe2\xc5\x16\xeb\x19\x86\x39\x2a\xf0\x61\x5c\x7c\x8b\xca\x79\x1c\x3f\x41\x79\xb1\xa6\x66\x85\xcf\xc3\x86\x12\x06\x49\x4c\xfd\x9f\x89\x8c\x51\x4a\xd7\x4b\xf9\x8c\x01\xc2\x45\x95\xd2\x4d\xc2\x05\xb6\xb6\x05\x5d\x86\x10\xe5\xad\x8f\xa3\x65\xc9\xe7\x70\x99\x24\xe9\xc3\xc3\x1d\x65\x6f\xdc\x37\x32\x46\x03\x0c\x66\xea\x44\xc6\x4f\xd4\x59\x3a\x89\x81\x8c\x1f\x12\xdc\x0d\x45\x47\x54\x0e\x42\x78\xd7\x16\xd8\x77\xe3\xff\xca\x7b\x64\x19\xf6\xae\x70\x7e\x91\x04\xbd\x24\xee\xf5\x88\x09\xdc\xa5\xee\x7e\x46\xb9\x92\xb6\x54\xea\x55\xf0\xed\x62\x98\x50\x4f\xae\x4d\x34\xc8\xc4\xac\x2e\x67\x29\xe8\xf7\xf7\x52\x39\x41\xa5\x25\x08\x2a\x42\x94\x40\x47\x6d\xca\x88\xe3\xf0\x07\x95\xf7\xfe\xde\xe3\xbb\x06\x31\x89\xaf\x71\x9a\x17\x06\x43\x2f\x4f\x7a\xab\x34\xba\x8a\xf2\xe8\x1a\xf7\x98\x41\xb5\xcb\xb0\xca\x3c\x2e\x9f\xfb\xf0\x54\xa8\x5a\x33\xcc\x96\x91\x8f\x65\x1b\x2a\xda\x83\x4b\x99\x78\x51\x44\xfb\xd7\x6e\xd4\x0f\xec\x29\xce\x41\xfa\xa1\x65\x55\x18\xc0\x44\xff\x27\x73\xe9\xec\xcc\x4f\x52\xac\x7c\xcd\xce\xb2\x0b\x37\xc5\xc1\xd9\x99\xc4\x94\x75\x6b\x09\x11\xea\xed\xce\x1a\xe7\x05\x14\xfe\x19\xf9\x83\x2a\xed\x9c\xcf\xf2\xc9\x3d\x59\x61\xb2\x54\x78\x9b\x99\x04\xe7\x0b\x30\x5c\xad\xb3\x0b\xb9\xf2\x40\xd3\x61\xf1\x0d\x5e\x25\x01\x9e\xc4\xb2\x36\x02\x33\x69\xb5\x4e\xb1\x34\x91\xce\x97\x89\xe7\x2e\x25\xe8\x27\xab\x3b\xf6\xc2\x76\xe9\xff\xf9\xdf\x3d\x5d\xd5\xd5\xde\x01\x8e\xa3\xac\x77\xb2\xce\x2e\x2e\xdd\x14\x5f\xf7\xe4\xef\xcb\x24\x4a\x13\xff\x72\x98\xae\x81\xb4\xbb\xae\x39\x47\xd0\x4f\xe2\x2c\x4f\xd7\x7e\x9e\xa4\x50\x74\x77\x60\x94\x9d\x94\xca\xf1\x38\x84\x6d\xc2\x11\xe6\xc9\xab\xc4\x77\xd9\xc9\xd5\xf8\x1c\x96\x13\x02\x16\x53\x5a\x1a\x66\xab\x65\x94\xcb\x12\x94\x1e\x70\x9b\xc7\x60\x18\x52\xae\x94\x5e\x09\x90\x25\x2e\x0e\x24\x75\x2d\x57\xea\x1b\xf6\xfb\x7b\x89\x8c\x51\x3c\xc3\x13\x5e\x99\xbb\xa0\xdf\x27\x86\xa2\x0b\xef\x45\x0d\xa7\x56\xda\xac\x6d\x9a\xc4\xb2\xa5\x83\x69\x19\x58\xe1\x0c\x46\x8b\x35\x4d\xd3\x99\xc1\xb8\xd3\x44\x18\x21\x69\xff\xf4\xf4\xdd\x7a\x89\x5f\x45\x59\x0e\xf7\x4f\x4f\x4f\xf3\xbb\x25\x3e\xc0\xfe\xd2\x4d\xe9\xf1\x0f\x02\xfb\x48\x9e\xcd\x10\x96\x11\x8e\xf3\x77\xd8\xcf\xe9\xcf\x83\xe3\xd7\xfc\x77\x46\xb9\xfc\xf5\x3e\xb9\xc4\x31\xfb\xe1\xe6\xee\xfb\xd4\x8d\xb3\x10\xa7\x2f\x73\x7c\x45\x81\x47\x51\xf1\xd0\x17\xef\x5f\xbf\x7a\xba\xa4\xa7\x19\x30\xed\x16\x85\x34\x7e\x1e\x25\xe9\xd5\xe1\x12\x13\xa3\x88\xfe\x3e\xc5\xa4\xb4\x84\xbc\xc6\x41\xe4\x52\x62\xaf\xa3\x2b\x4c\xc4\xc5\xd3\x34\x75\xef\xe0\x1b\xf7\x0a\x07\x6f\x92\x00\xbf\x76\x57\x90\xfc\xa5\x38\x27\x6e\x44\xfa\xf0\x6d\x8d\x33\xd6\xf4\x93\xe5\xfa\x3c\x8a\x8b\x3f\xac\xe6\xe9\xc7\xe7\xaf\xa8\xc6\xa1\x08\xa7\x1f\x9f\xbf\xa1\xce\x7f\xf9\xeb\xc4\xcd\x2f\x4e\xf1\x79\xf5\x33\x89\xe2\xbc\xfc\xc1\x8d\xc2\xe9\xc7\xe7\xac\xdf\x49\xca\x3a\x7d\x9a\xac\x53\x1f\x3f\x5b\x87\x61\x49\x8b\x0c\xf7\xe9\x05\xc6\xac\xfa\x7b\x7c\x9b\xbf\x4f\x5d\xff\x72\xbf\x18\xf0\x0a\xc0\x7e\x25\x6b\x9f\xb6\x88\x9f\xa2\x30\x43\xea\x34\x7b\x1c\x15\x2a\x72\x9a\x0d\x06\x85\xfd\x8f\xa2\x79\xb6\x80\x6b\x94\x12\x63\x3a\x44\xeb\x7e\x7f\xcd\x9b\xe8\xfd\xfe\x5e\x38\x5f\x2e\xfa\xfd\x44\x0e\xe1\x12\xfa\x00\xba\xc4\xf6\x73\x87\x74\x08\x5a\xe6\x19\x73\xae\xf5\x07\x16\x85\xe8\xa8\x68\x23\x36\xef\x0c\x95\x79\x2a\x64\xc9\x74\x08\x45\x66\xa2\x27\x45\xc8\x89\x08\xb3\xe2\x2b\x72\x67\xf7\xdb\x49\x5a\x15\x10\x61\x26\x9d\x49\x08\xe1\xa1\x7f\xe1\xa6\x4f\x73\x59\x05\x9b\x0d\x26\xae\x46\xbe\xd9\x44\x72\x0e\x71\x69\x24\x2c\x87\x21\xd1\xdc\xed\x86\x53\xe1\xaf\x9d\xe3\x9c\x13\x1e\xec\x21\x3f\x69\x9e\x72\x41\x0c\x6a\x5f\x5c\x45\xb1\xcc\xbe\xb8\xb7\xb4\x1e\xb1\xf8\x7f\xd2\x10\x23\x23\x45\x07\xae\x10\x26\xc6\x58\x70\xf1\xc8\x02\xce\x88\xa8\x31\x01\xf4\x09\x0b\x6c\x00\xd7\xa4\x58\x03\x30\x2c\x16\x76\x94\xe3\xd4\xcd\x93\x54\x02\x30\x40\x7b\xf2\x7c\x41\x7d\xd1\x7e\x5f\x8a\xf1\x6d\x2e\x45\x71\x01\x90\x01\x80\x2b\xb4\x1b\x55\x22\xa6\xd6\xb6\x53\x64\x41\x0f\x5e\xc0\x2b\x78\x0e\xee\x33\x39\x86\x39\xf4\x00\x6d\xfa\x35\xbc\x83\x67\xf0\x76\xc7\xd7\x0f\xfa\x7d\xca\x91\xe3\x52\x93\x1f\xcf\xf1\x62\x5a\xb8\x56\x98\xf9\x52\x4c\x59\x4e\xe8\x57\xca\xaf\x4c\x9a\x34\xf7\x2a\x6a\x6d\x8c\x6f\x7a\x31\xf3\x8d\x88\x2d\xf6\xb3\x78\xf0\x06\xe5\x03\xa9\xf7\xb2\x1c\x1a\x78\x89\xca\x67\x21\x74\x01\x0f\xd1\x9e\x06\x8f\x05\x77\xea\x04\x1d\xcf\xc3\xc5\x66\x73\x3c\x97\xfe\xe7\xff\xac\x86\x74\xb1\xd9\x5c\xf4\xfb\xc7\xf3\x8b\x05\xdc\x47\x27\x9b\xcd\xad\x7c\x01\xe0\x57\x74\x31\xbb\x9c\xdd\xca\x12\x8e\xf3\x34\xc2\x99\x04\x26\xfb\xa5\x2d\x7b\x8a\x24\xba\x88\x88\xc5\xd3\xef\x1f\x0f\x0b\x94\xcd\xe6\x84\x18\x45\xa7\xfd\xbe\x7c\x86\xd6\xf2\x29\xb3\x31\xa8\x3b\x08\x00\xd8\x43\xa8\x19\x4c\xee\xf7\xcf\x86\x84\x7f\xfd\xbe\xec\xcb\x67\xf0\x86\x85\xa8\x36\x9b\x16\x67\xef\x8c\xb6\x7b\x29\x9f\xc1\x10\xae\x00\x80\x97\xfd\xfe\x49\xbf\x5f\x76\x77\x0f\xa1\x93\x61\xec\x5e\xe1\x7e\x5f\x3e\x44\x7b\x2a\xdc\x6f\x99\x03\x27\xac\x3d\x64\xf8\xc0\x16\xc0\xb4\xdf\xdf\x3b\xdf\x6c\x08\x37\xf7\x0e\x49\xf7\xd9\x03\x8e\x61\x08\xf7\x01\x8c\xe6\xf9\x02\xed\xc3\x68\x7e\xb3\x40\x2b\x78\x01\xa2\x50\xbe\x46\x6c\xe5\x65\x93\xcb\xd9\xfe\xe4\x56\x2e\x1f\x0e\x20\xe1\xf5\xe4\x8a\x01\x0b\x23\xa9\x18\x92\xc9\xd7\x2d\x3c\xa7\x9b\x02\x77\x64\xc6\x5c\x03\xfa\xe7\x78\xb3\x71\xe5\x63\x78\x07\xaf\xe7\x77\x0b\x30\xc5\xcb\x0c\xf7\x12\x39\x19\x9e\x0c\x92\xe1\xd1\x3f\xe5\x60\xb3\x39\x04\x30\x87\xd7\x95\xe9\x7c\xdd\xb2\xd2\x84\x08\xec\x88\x98\x99\xff\x6e\x20\x36\x26\x0e\x9c\x2c\x05\xd1\xb5\x04\xfe\xfd\x90\x6c\xc3\x7c\x2f\x4c\xb8\x61\x90\xf8\xd4\xb9\x87\x2e\x4a\xe5\x84\x6a\xfc\xa4\xd8\x7f\x29\xf4\xd9\x0f\x2c\x47\x77\xd6\xc0\x97\x31\x98\xdc\xb7\x05\xf9\xf8\x50\xad\xf5\x50\xeb\x8a\x20\xa0\x59\x18\x0c\x7a\x21\x7e\x74\x13\xc8\xd2\xcb\xc3\xb3\x93\x77\xc7\xef\x8f\xa9\xb9\xc0\xf5\x7d\xcb\x87\x9d\x6a\xcf\xaf\x18\xb3\x28\x4c\xdd\x2b\x2c\x01\x98\x22\xb7\xd4\x4c\x84\xcb\xf9\x90\xbe\x6f\x6f\x58\xbc\x13\x18\x49\x71\x12\x63\x09\xc6\xb2\xa5\x82\xa1\xbb\x5a\xe1\x38\xd8\xbf\x88\x96\x81\x9c\x03\x98\x0f\xb3\xd4\x47\xd2\x57\xf7\xda\xcd\xfc\x34\x5a\xe5\x13\x09\xca\x18\xe5\xc3\xe2\x00\xee\x27\x16\xf3\x2f\x07\x14\x0c\x93\x15\x8e\x65\x00\xf1\xf0\x26\x8d\x72\x2c\x4b\x8f\x59\xb5\x27\x25\xc6\xf0\xa8\x60\xf4\xe3\x2f\x8f\x8a\x22\x32\x13\x87\xfe\x32\xc9\xb0\x4c\x64\x2c\x1e\x1e\x4d\x53\x45\x99\x82\x00\x2f\x71\x8e\x7b\x59\xbd\x16\xe7\xee\x3c\x5d\x2c\xca\x09\x97\xc9\x60\xbb\x1b\xfe\x63\x6c\xe1\x03\x80\x95\xeb\x51\x39\xc0\x45\x48\x72\x26\x47\x5c\x48\x87\x46\xa1\x63\x44\x44\x41\x04\xf9\x02\xba\xf3\x15\xcf\x97\x0b\x84\xc1\x24\x46\x99\x0c\x60\x15\x7a\xc8\x67\xf1\x24\x21\xf2\xf8\x21\xdf\xc1\x64\xac\x75\x8a\xd8\xe1\x18\xc8\x7b\x5a\x2b\x77\x3b\xdd\x4b\xe6\x4c\x46\xd4\x4b\x21\xc6\x06\xf4\xd1\x7c\x41\x99\x19\x93\xb5\x1a\x81\x78\x0f\x2d\xc9\x34\x8e\x60\x0c\xfa\x7d\x9f\xb9\x07\x31\xb3\x48\xa7\xa5\xb7\xff\x24\x9b\x02\x8a\x82\xf2\x79\x36\x18\x2c\x40\xbf\x2f\xff\x2f\x57\xf6\xe9\x8e\x6e\x55\xa7\x5a\xd0\xfe\x43\xbe\xd6\x68\x37\xf2\x2a\x4b\xdf\x25\xd0\x1a\x0b\x91\xd5\x72\x37\x60\xd2\x16\xd7\x61\xc1\x0e\x44\x39\x30\xc3\xa5\x5d\x25\x81\x49\x41\x77\xc7\xf7\xef\x88\x05\x97\x81\x21\x89\x75\x57\x82\x52\xc5\x44\x09\x4c\x3b\x4d\x0e\x62\xa2\xfe\x1b\x11\xe3\xc6\x30\xb0\x87\xef\x98\xf5\x2e\x51\x3f\x45\x08\x91\x76\x6d\x57\x64\x55\x21\xc6\xad\x0c\xba\xe4\x0d\x35\xca\x60\x0c\x97\x95\x94\xe5\x82\x5e\x1f\xaa\x6d\xb5\x09\x0b\x79\x10\xe0\x9b\xf5\x72\x29\x4d\xca\x5d\x5f\xce\xdd\x6f\x18\x60\xf5\xee\x0a\xdd\xe4\xad\x77\x56\xb6\x72\x8e\xaa\x81\x87\x09\x00\xb3\x78\xe2\xce\x52\x39\x07\x13\xe9\xb8\x0c\xef\xcb\x4b\x44\x20\xa0\x35\xe8\x99\x53\x25\x86\xf1\x8c\x1b\x82\xc9\xb2\x3d\x1c\x30\x8d\xbb\x2c\x21\x19\x4c\x49\x23\x63\x14\x6f\x36\x64\x51\x76\x6e\x0b\xd6\x6d\xdf\xd9\x7e\x28\xb7\x26\xe5\x18\xb1\xaf\x60\xcb\x09\xe4\xf6\xf8\xc4\x23\x65\xf6\x25\x18\xc8\x5f\x86\x5f\x82\x01\x98\xfd\x8f\xd9\xa3\x1f\x84\x29\xf0\xf0\x8a\x3e\xff\xc7\xa6\x66\x75\xe8\x23\xe5\xab\x37\xb6\xcb\xf1\xe4\xbe\xd8\x1d\x9f\xe0\xed\xb6\x5d\x29\xe6\x9d\x07\x17\x20\x9d\xdc\xcf\xdc\x0c\x6b\xd6\xfb\x0b\x7c\x45\x45\x35\x95\x88\xc4\xcd\x89\xe2\x73\x94\x0f\x23\x1a\xb9\x61\xa5\x6c\x3a\x15\x87\x4c\x52\x39\x96\x47\x0e\x00\x54\x2d\xc6\xf2\xd8\x02\x44\x4a\x91\xaf\xb6\x06\x88\xea\xa1\x5f\x09\x02\x3b\xea\xe2\x18\x80\x18\xc3\x0d\xd3\xf3\xc1\xd3\x0e\x39\xba\xdf\x4e\x8b\x10\xdd\x1e\xaa\x8f\x22\xc4\xec\x28\xc2\x4f\xee\xe4\x13\x19\x27\xe7\xf3\x78\x81\xf0\x3c\x5e\x00\xee\xc0\x00\x1b\x39\x44\x83\xf2\xb1\xec\x8c\x00\xb1\xd2\x49\x5b\x35\x43\x07\xc4\x52\x67\xdf\x49\xc3\x83\xe2\xbb\x4d\xcd\x71\xf2\xcd\x01\xd0\x43\x7e\x49\x04\x5e\x20\x59\x85\x51\xf9\x13\xc8\x1e\x80\x57\x04\x14\xd4\xa0\xb0\x42\x5e\x0d\xd3\x73\x4f\xbf\x5b\x5f\xc3\x96\xe5\x4b\x6a\x2d\xeb\x5a\x18\x1a\x44\x29\xc7\x73\x75\x41\xa4\xc7\x5c\x5b\x10\xc5\x30\xd7\x4b\xcd\x36\x97\x73\x94\xc2\xfc\xf1\x50\x37\x67\xda\x24\x7f\x3c\x34\x67\x43\x47\xc9\x27\xda\x50\x53\x72\x00\x13\xe8\x2e\xb6\x70\x35\xbc\x5b\x5f\xeb\xe9\xb9\x07\xd7\x15\x65\x78\xde\x36\x41\x77\xcf\x75\xdc\xfb\x4b\x37\xcb\x88\xdc\x9b\xcc\xf3\x61\xf5\x83\xaa\xe1\xe2\xfb\x62\x18\x46\xcb\x1c\xa7\xf2\xb3\x24\x59\x62\x37\x06\xc3\xaf\x49\x14\xcb\x52\x8f\x78\xbb\xc4\x7c\x98\xc8\x2a\x74\xeb\x3e\xdd\x6f\x61\x61\x57\x10\x07\x11\xe2\xfa\x3b\x20\x66\xd1\x75\x9b\x42\x6b\x8c\x6f\xce\x05\x50\xd8\x74\x50\x34\x84\x50\x3c\x8c\xe2\x00\xdf\x1e\x87\x72\x0a\xfa\xfd\x98\xa9\xa9\xb4\x62\x7a\x3c\x4c\x71\xb0\xf6\x71\x2d\x60\xe9\xd6\x12\x77\x94\x6a\x37\x54\x5b\x0b\xd1\x72\x76\xe6\x53\x1e\x9c\x37\xc2\xb8\x88\x97\xba\xf5\x61\x06\x32\x04\x09\xc7\x56\xc2\x54\xce\x32\xe8\xc6\xcc\x41\xe9\x89\xc5\x85\x27\x56\x88\xeb\x49\x01\x4e\x1b\xe0\x62\x5e\xe4\xf0\x21\xbe\xd0\x4d\xaf\x52\x04\x96\x8e\xdc\xb9\xcc\x31\x1b\x17\xac\xcb\xb7\x05\x72\x25\xbb\x77\xfc\xbe\x18\xdc\xd7\xd1\xac\xe6\x56\x1c\x4c\x10\xf5\xad\xe4\xf4\x89\x36\x4b\x15\x6d\xa2\x12\x99\xa1\x4d\xdd\xc7\xe9\xd4\x1d\x0c\x40\x32\x77\x15\x6d\x51\x57\x9b\xbb\x95\xd1\x26\xb6\x67\x0b\xe4\xbc\xd8\x75\x2b\xdc\xb6\x79\xbc\x28\xd5\x78\x02\x00\xd8\x6e\x85\x4e\x3d\x38\x3c\x22\xed\xbc\xe8\x2b\xde\xb6\x0e\xcc\xee\xec\x85\xf8\xff\xe6\xa0\x54\x8d\xfd\xb9\x01\xa9\xdb\xf8\xf0\x90\xfc\xf2\xa6\xe3\x46\x6b\xcf\xe5\x18\x08\x6c\xd8\x02\xbe\xfd\xdb\xd6\x49\xfa\x7f\xa9\x95\xd5\x62\x68\x69\xe1\x7f\x7e\x12\x34\xdb\xf8\xe0\x3c\x10\x5a\xbc\xdd\x6e\x65\x3c\x4f\x17\x30\x9f\xa7\x0b\x7a\x54\x8e\x48\x5d\x78\xd7\x10\x7c\x95\xae\xdd\x6d\x75\x5a\xb4\x3a\x7e\xa2\xcf\x62\x45\x27\xad\x5e\x22\x7d\xba\x7c\x1c\x4f\x97\x83\x01\x48\xe7\x4b\x45\xe7\x5b\xbd\x5c\x4c\xab\x7d\x36\x5e\x5a\x52\x2a\xc3\x28\x63\xd4\x72\xb0\xd9\xc8\x39\x9a\xe7\x0b\x16\xab\xca\x50\x3e\xbc\x72\x57\x72\xd7\x41\x43\xd0\x94\x6d\xd0\x47\xd9\x8e\x78\xe7\xf6\x4c\x9a\x56\x6e\x2f\x9f\x71\x2a\x0c\xcd\xb9\x1f\x30\x7f\x40\x72\x4e\x6a\xc3\x11\xc9\x3f\x29\xc1\xa9\xa7\x42\x26\x10\xda\x11\x25\x45\x01\x24\x16\x73\x8b\x75\x4c\x8f\x90\xee\x56\x82\x4d\x86\xe3\x8a\xc9\x29\x00\x00\x40\xbc\x85\xdc\xc2\x92\xa4\x42\xc0\xdd\x6f\xb7\xb5\xcb\x56\x77\x78\xb3\x29\xfc\x67\x0e\x06\x49\xb7\x44\xa5\xeb\xb3\xb6\x82\x62\x26\xf4\xfb\x55\x2d\xd6\x07\x7f\x0b\xcf\x1a\x36\xe3\xae\x89\x21\x92\xc4\x60\x87\x67\x7c\xa4\x96\xda\x6d\x8f\xfe\xe5\xb9\x19\x7e\x34\xcc\x71\x96\xcb\x31\x98\x5d\xc9\xd4\x92\x9b\x48\x99\x4f\x9e\x41\x18\x11\xcf\x08\x68\x20\x4d\xd8\xb3\x09\x0f\x08\x00\xe6\xc5\xf4\xbe\x45\x72\xd3\xb6\x95\x55\x98\x71\xc6\x19\xd7\xce\x72\xee\xe7\xbb\x73\x3f\x2e\xe6\x7e\xfe\xc4\x98\xe5\x8a\x41\xe6\x7e\x8a\x8c\x69\xfa\x38\x9f\xa6\x83\x01\x88\xe7\xa9\x62\xf0\x73\x3f\x5d\x14\xe6\x72\x93\xd2\x13\x8d\x3b\x56\x53\xe3\x6b\x8b\x19\xff\x63\x72\xbf\x85\xcb\xdd\xba\x7a\x6b\x5d\x9d\xaf\xab\xd3\xba\x3e\xaa\xa6\x21\x33\xf3\xe1\xba\x36\x3a\xfc\x99\x37\xf1\x61\x88\x92\xa1\x57\xbb\x00\x19\x0c\x6a\x8c\x90\x1e\xc8\x9a\x84\x70\x85\x6e\xe5\x25\x0c\xc0\x74\xd5\xef\xcb\xcb\xdd\xf9\xb8\x82\x4b\xc0\x96\xed\x15\xba\x78\x68\x11\xb2\xf3\x83\x4b\x7a\x02\x69\x3d\xcf\x17\x74\x9e\x6e\x89\x2d\x2a\x4e\x8b\xe5\xee\xb4\xe0\xa8\x50\x53\xef\xa2\x32\xf5\x72\x30\x93\x2b\xba\x10\x83\x49\x41\xf4\x0c\x61\xf9\x0a\xc0\x1b\x74\x2d\x9f\xc3\xb3\x72\xde\x8b\x7c\xbf\x83\x3a\x68\x2c\xa5\x9b\x6a\x29\xc5\x00\x6c\x89\xf9\xbd\xe3\x27\xed\x18\x89\xd4\x8f\xc1\xb7\x39\x8e\x03\xba\x62\xcb\x1f\x00\xee\x88\x9d\xfa\xec\x73\x19\xbc\x98\xd0\xb0\x9b\x68\xf6\xc7\x50\x07\x30\x41\x29\x31\xfb\x5d\x94\xce\xb5\xc5\x14\x23\x39\xa7\x36\xf2\x3c\x59\x6c\x36\xfe\x3c\x59\x40\xa9\x9a\xee\x08\x21\x97\x3e\xf9\x8c\xee\x4d\xf3\xfe\xa2\xe8\x16\xc9\x12\xe1\xb6\xaa\x4a\x60\x56\x1e\xe5\x68\x0b\x84\x76\xf9\xa2\xe0\x3e\x1d\x46\x71\x94\xf3\xa1\xe7\xaa\x38\x29\xbc\xba\x96\x03\x60\xe5\x01\x0b\xe2\x9c\xd7\xe7\x03\xfe\xeb\xfd\x05\xee\xd1\x6b\xcf\x38\xc6\xa9\x54\x45\x39\x7a\x57\xeb\x2c\xef\x79\xb8\x47\x44\xe0\xdd\x0a\x57\xfe\xfc\xb0\xf7\x0e\xfb\x38\xba\xc6\x01\x83\xff\xd7\xa0\xa2\x5f\x37\xc3\xe5\xfc\x99\xda\x18\x1f\x9e\x5d\xb9\xb7\xaf\x8a\x47\x65\xb3\xb4\x1c\xeb\xd7\x1c\x74\xd2\xc0\xaa\x69\x16\xa7\x4a\x89\xb7\x40\xb8\xb7\x84\x11\xcc\xa0\x4f\x14\x5b\x22\xc7\x5c\x8c\x4f\x8e\xc8\x93\xf0\x35\x3d\x0d\x36\xe3\x7f\xb5\x1d\xdd\x87\x55\xe9\x7e\xb2\x8e\x73\xa4\x82\x89\x5c\xad\xec\x68\x18\xe3\x9b\xb2\x29\x84\xb7\x43\x7c\x45\xa6\x0b\x07\x95\x48\x9b\x86\xe5\xf8\xcd\xea\xaf\x93\x98\xb8\xe0\x75\x4b\x60\x86\x22\xa2\x5a\xeb\x86\x66\x80\x81\x50\x0c\x07\x03\xb1\x19\x2c\x7e\xdf\x7a\x94\xa4\x97\xcd\x8a\x6a\xe9\x6c\x1e\xc3\x6c\x31\x99\x67\x30\x5e\x4c\xd2\x59\x36\x5c\xc7\xd9\x45\x14\x92\x55\x33\xc9\xca\xf8\x20\x94\x97\x88\xb0\x03\x3c\x51\xfb\xfd\x4a\x7e\x2d\xfb\xfd\xbd\x6c\x78\xe3\xa6\x31\x0e\xc0\x7d\xf9\x0d\xed\xb1\xd8\xc2\x9a\x86\x55\x8b\x03\x24\x27\x49\x46\xaf\xec\xf4\x0e\x49\xfb\x0e\xaf\xa2\x3c\xc7\x69\xef\x0a\x5f\x25\xe9\x5d\x6f\x89\xdd\xcb\x5e\x80\x73\xec\xe7\x38\x18\xf6\xa4\x41\xf9\x84\x81\xd4\x93\x06\xc5\xd9\xa7\x1c\x0c\xa4\x5e\x39\x2e\x59\xcf\x0d\x02\x82\xfb\x21\xc3\x3d\xcc\xa8\x0d\x33\x2c\x4c\x02\x19\xf4\xf2\xa4\x17\xc5\x84\x51\x19\xee\x2d\xa3\xab\x28\x97\xc0\x74\x4d\xf7\x63\x90\xc4\xa3\x1e\xde\xfa\x18\x07\x38\xf8\x54\x5e\xe2\x5e\x0f\x0b\xa2\x08\xc3\xf5\x90\x46\x84\x73\xb8\x1e\xfa\x94\xbb\x95\x16\xf1\xd1\x1a\xfa\x49\x9c\x25\x4b\xdc\xef\x17\x5f\xe8\x20\x88\xbf\x64\xbf\x5e\xc8\xf5\x4c\x8c\x64\xb6\x69\x47\x0f\x5b\x85\x51\x8a\x03\xc0\xc5\xb8\x86\xb9\x9b\x9e\xe3\x7c\x98\xe2\xab\xe4\x1a\x97\x0d\x95\x59\x11\x3d\x80\x48\xbe\xdd\xa4\xee\xea\xa8\x3c\xb0\x45\x69\xa0\x3d\x95\x2a\xfc\xa6\xae\x99\x51\x94\x72\xf8\xea\x55\x5f\x3c\x07\x4c\xc4\xf2\xfa\x50\x64\x81\xc0\x9f\x8d\xac\xba\x90\x89\xa1\xd1\x7b\xda\x82\xc9\x9e\x06\x59\xbb\xca\x5d\x39\x46\x61\x82\x21\x69\xf8\x24\x87\xf5\xe4\xde\xc2\x04\x45\xec\xaa\x49\xed\xdc\x27\x55\x33\x50\x0c\xd3\xa2\x8f\x28\x81\x49\xfd\x60\x5f\x7c\x70\x35\xed\x05\x87\x3e\x2d\xc6\x73\x5e\xea\xee\x74\x9e\x2f\x76\x42\xaa\xc9\x6c\xbe\x68\xb3\xd8\x92\x59\x3c\x9b\xd7\x4d\xd9\x6c\x92\xc5\x64\x9e\x2c\x26\xf1\xac\xdd\xc4\x20\x73\x9d\xd9\x14\xe5\xa1\x39\x00\x63\xa4\x4e\xe3\xc7\x65\x24\x7e\x3a\x18\xc4\xa0\x0a\x60\x71\x94\xc9\xcf\x2a\x9c\xb5\x95\x13\x30\x09\xe5\x04\x26\x25\x99\xba\xdb\xeb\x7a\xcb\x9e\x1d\xd1\x6b\xf6\x7a\x8f\x18\xe6\x85\x5a\xca\xe7\x78\xd1\x7e\x8e\xac\x57\x5d\x61\xd2\x84\xaa\x15\x38\x2e\x1e\x5d\xce\x5a\xb5\x6e\x42\xd8\x74\x2b\xea\x7e\xe7\x44\xfb\xa9\xd4\x86\x1a\x0c\x52\x40\xa3\x30\xc4\x43\xa9\x82\x36\xf4\xea\x59\x00\x57\x68\x27\x64\xfb\x0e\x87\x4b\xec\xe7\xb3\xe2\x2f\x8d\x6c\x43\x4f\x3c\x83\x5c\x21\xaf\xd8\xec\x9c\x15\x7f\xbb\x6e\x38\x54\xaa\xa6\x0e\x2c\x52\xfc\xea\xde\x05\x8c\xc1\x76\x1a\x74\x3e\x24\xb9\x89\xff\xc0\x77\xd9\xac\xfa\x36\x79\xe8\xec\xc2\xac\xf3\x14\x69\xdb\xc6\x03\x31\x97\x0b\xdb\xe4\x21\xa2\xd4\x04\x68\xd9\x3d\x79\x98\xf2\x96\x4e\xf7\x0b\xc4\x8e\xce\x0c\xe9\xe1\xca\xd6\x9d\x0e\xbc\x87\x30\xbf\x99\x96\xd6\x67\x85\x87\x49\xec\x37\xcd\x23\x6e\xaf\xff\x24\x4d\xae\xa2\xac\x19\x7e\xe3\xec\x07\x70\x5f\x1b\xb6\xc4\x76\x69\x88\x31\x09\x13\xad\x20\x41\x17\xc0\x58\x9e\x2f\xd8\x59\x3f\xc6\x98\x5a\xce\x00\x3a\x5f\xdc\x69\x81\xbc\x47\xf7\xf4\x65\x17\xf1\x4e\xf9\x0e\xe5\x1c\x26\xec\x32\x09\xf1\xc8\x48\x27\xb8\x67\x81\x12\x44\x90\x88\xb1\x94\x0e\x79\x6d\x84\x52\x98\x72\x93\xa5\x54\xf7\x85\x10\x6b\x29\x2a\x74\xbd\x58\xc4\x9b\x1d\x7c\xa4\xfd\x0a\x69\xea\xf4\x47\xf7\xfa\x78\x9d\xb4\x73\xc7\xaf\x7d\x0f\xfc\x6a\x0b\x33\x2c\x6e\xb1\x91\x45\x5f\x5c\x9a\xaa\xed\xb6\xcd\x06\x3f\x56\x37\x9b\x8b\xfa\x3a\x08\x61\xe4\x3b\x37\x3e\xe7\x6d\x38\xba\xa9\x40\xac\xb5\xd6\x16\xf5\xa2\xac\x97\xac\x73\x52\x9e\x92\x8a\xc3\xde\xcb\xda\xc4\x73\x7b\x71\x12\x2b\x31\x3e\x77\xe9\x79\x50\xd6\x00\xce\xce\xfb\xaf\x01\x1e\x48\x43\x09\x4c\xaf\x10\xde\x6e\x01\x64\xf6\xa7\xb0\x95\x5d\xc9\x30\x4e\xb8\xf5\xfb\xfc\xaf\xfa\xac\xc6\x39\xce\xb9\x03\x87\xcc\x82\x2d\xb1\x36\x1b\x99\xaf\xd4\x6a\xb1\xf1\x08\xa5\xd1\x56\x00\x05\x16\xee\x82\x36\x9b\xc2\xdc\x16\x18\xdf\x30\x3e\x9a\x1b\x24\x7f\x9f\x21\xf1\x7f\xd3\xf0\xf3\x46\x86\xd8\x55\x66\x54\x88\xdd\x3a\xef\xea\x56\xbd\xa3\x59\x78\x0f\x42\x35\x62\x3d\xa1\x76\x45\x39\x5f\xc0\x18\x69\xd3\xf8\x71\xd3\x44\x99\xc6\x83\x01\xc8\x99\xc5\x59\x3b\xc0\x71\x11\x47\x4a\x51\xb1\x90\x89\x0f\x00\x93\x6e\x05\x98\x80\x14\xa5\xa5\x63\x4d\x14\xfc\x90\xd6\xab\x8c\xe1\xbd\xd2\x2e\xd8\xa3\xda\xaf\x70\x04\x5c\x76\xfb\xb6\x30\x6c\x55\x2a\x68\xf2\xb9\xba\x00\xd0\xe5\x6f\xaa\x50\xce\x14\xec\x72\x69\xbb\x96\xbc\x95\xfb\x21\xbe\x70\xe3\x60\x89\x83\x1e\x7d\xe6\x50\x1a\xc8\xee\x4c\xea\xc9\xd2\xc0\x1d\x5e\xe1\x2c\x73\xcf\xf1\x40\x02\xd2\x44\x92\x00\x28\x0e\x59\x2f\xd9\xa9\x8a\xdb\x1c\xb9\x70\x49\x25\x5e\x84\x92\x42\x75\x57\x7d\x88\x84\x26\xb7\xa8\xab\x08\x78\x72\x44\xd9\x07\x73\x76\x6e\xe7\x9e\x05\xdf\xa2\xda\x56\x0d\xe5\x08\x66\x6c\xbb\x85\x19\x26\x19\xb5\x48\x3c\xd9\xa7\x11\x16\x56\x77\x5b\x5d\x5e\x13\xf8\xe9\x06\x41\x39\x07\xda\xb5\xc2\xb2\x38\xfd\x05\x73\xb8\xa7\x35\x26\x43\x12\xa3\x0e\x5a\x02\xda\x2a\xc5\x2b\x1c\xff\x1b\xcf\x51\x77\x9e\xd3\xa5\xb3\x12\x7a\xac\x85\xcc\x18\x5a\x90\x55\x44\x00\x68\x99\xf3\x45\x43\x8e\x63\x1f\x3f\xdc\x98\x9a\x6c\xa3\xed\x3f\x7e\x86\xa8\xb6\x5a\x4f\x7e\xa4\xd0\x85\x4b\x18\x31\xdf\x34\xe7\x7d\xd3\x54\x98\xfd\x80\xf7\x1b\x84\x69\x23\xc7\x28\x9d\xe3\xc5\x0e\x42\x8c\x10\xca\x37\x9b\xda\xcd\xa4\x01\x5d\x15\x21\x45\xd9\x11\x8b\xb3\x1f\x49\xd2\x89\x5c\x44\x0f\xc9\xa3\x60\xda\xe8\x59\x21\xbe\x99\xc7\x2b\x16\x49\x10\x73\x4e\xef\x66\x93\x03\xd0\xe2\xb1\xee\xd5\x46\x2b\x15\x22\x2e\x52\x34\xb8\x44\xa5\x89\xaa\x68\xd3\xe5\x13\xa4\x4e\x97\x8a\x02\x68\x46\x85\xe5\xa2\xe8\xdc\x7c\xb9\x10\xfb\x77\x1f\x21\x01\x08\x5d\xb4\x9c\x7a\x29\x76\x2f\xb7\x51\x28\xbb\x8f\x55\x61\x98\xa8\x13\x35\x8b\x87\xcc\x17\x06\x93\xdd\xb0\xfa\x34\x1f\x68\x8f\x4b\x63\x7f\x9a\x0f\x06\x80\x06\xaf\xf0\x3c\x1f\x68\x8b\x29\x1e\xae\x92\x95\x0c\xb6\x72\x4c\xac\x1c\xb6\xa7\x59\x86\x5b\xd9\xed\x83\x98\x8a\x97\x4a\x6e\xfd\x9b\x23\x17\x91\x01\xdb\xf2\x67\x27\xc4\xb5\x10\x86\xa8\x7b\xbe\xb5\x4c\xc5\xa7\xcb\x65\xbb\xf2\x2a\x4f\xa3\xa4\xcd\xa9\xf5\x73\x73\x30\x6e\x3c\xba\x44\x6c\x75\x52\xff\xcf\xd4\xf6\xa4\xf6\x60\xe6\x78\xd1\xef\xcb\xff\xa7\x33\xb9\x98\xc8\x84\x0a\x7b\x12\xe9\x49\x5b\x43\xd9\xa8\x24\xd0\xe5\xef\x9f\x96\x47\xaf\x98\x13\xe4\xd6\x5e\x5f\x0a\x9a\xfc\xdb\x43\x48\x4e\x90\x3b\x4f\x17\xa0\x60\xf3\x2e\x13\xe4\x44\xd4\xd5\x2d\x18\x4d\xb2\xe2\xf0\xfc\xec\xf8\x31\xe9\xd4\x7e\xe3\x28\xa7\x43\x4a\x0c\x94\xaa\x05\x9c\x8c\xcb\xeb\x05\xcb\xf9\x9f\x6c\x0c\xf2\x7a\x81\xa6\x64\x81\xa6\x8a\xd2\x45\x85\x0c\xc3\xb4\x73\x26\x2f\x5b\x67\x65\xb9\xf7\x51\x4a\xd9\x1d\x65\x90\xba\x37\xed\xf3\x79\xa7\x26\x53\x57\xe5\x63\xd8\xa0\xb4\x6e\x3c\xed\xaa\x5d\x2c\x56\x9b\x35\x7e\xcb\x39\x98\xac\xf9\x4c\x14\x6d\xfd\x62\x0f\x5c\x8b\xe6\x13\xe1\x0f\xf5\xe7\x3a\x0e\x4a\x09\x2c\x7c\xa2\xce\x02\x61\xe5\x80\xc9\x7c\xf1\xd0\xb1\xd4\xe1\x41\x94\xad\xdc\xdc\xbf\xc0\xec\x10\xbc\xfa\x60\x1e\x05\x82\xd1\x76\xf9\xe0\xef\x1e\x73\xca\xb9\xa2\x32\x66\xc8\xce\x23\x99\x6a\x75\x1e\xc9\x32\xe9\x79\xa4\xb6\x4c\x18\x65\xe0\xb7\xdf\x97\x32\xea\x2f\x73\xc1\x9b\xb2\xac\xcd\x2d\xaf\xee\xa1\xb7\x79\xd6\xb8\x35\x1a\xc0\x3d\x0b\x0f\xb9\x4b\x50\x44\x34\x70\x45\x7b\xdc\xcf\x9a\x9b\xb3\xb2\x79\x93\xea\xc9\xd3\xfa\xd4\xd2\xcf\xf6\x0c\x2d\xe5\x7a\x2b\xb2\xad\x5b\x1d\xc7\x55\x96\x32\x6e\x8f\x21\xfc\xd2\x9e\x3e\xd4\x98\x87\xe6\x5a\x35\x3f\x62\xd9\xd4\x00\xac\x27\xc8\x64\x4f\x6d\xbd\x81\xa5\xab\x00\xc6\xb2\xee\x00\xc8\x4f\x58\x43\x05\xc3\x90\xbf\x9b\xf1\xc0\x69\x4e\xed\x27\x13\x31\x88\xfb\x9a\xd4\x67\x20\xe6\x59\x79\x35\x97\x98\x68\xc5\x19\x39\x00\xfd\xca\xee\xae\xce\x2d\x13\x5f\x2f\x7b\x82\xfc\x19\xbd\xb8\xcc\xc6\x67\x22\xbb\x28\xa2\xf7\x69\xf6\x93\x00\x3f\xcd\xe5\x0c\x80\xc7\xa6\xa9\x3b\xd6\x66\xe3\x3e\x31\x2d\x43\x73\x36\x9b\x6c\x40\x8c\x07\x7f\xb3\x91\x97\x0d\xe4\x81\x46\xd0\x2d\x43\x57\x37\x9b\xe5\x13\x73\x6c\x8c\x8c\x19\x9e\x45\xe5\x05\x9d\x0c\x4c\xdc\x09\xf9\xcd\x6e\x52\x66\x30\x1b\xe8\x60\xb2\x54\x68\x8d\x81\xec\x2a\xf4\x49\x8f\x1f\x6b\x2a\x18\x58\xa6\x69\x58\x0f\xe5\xab\x30\x47\x0f\x5d\xac\x2b\xf3\x79\xb4\x1d\xa6\x12\x0f\x3d\xd5\xd7\xf6\xf9\x30\x4e\x75\x1a\x82\xc5\xa5\x69\x64\x4e\xbc\xcd\xdf\x7a\xd0\xab\xc6\x87\x69\x59\xc3\x68\xab\x01\x93\xd6\x3a\xf4\x9c\x6f\xe7\xa5\x96\x2a\x35\x00\x1f\xfb\xfe\xf9\x0b\xc9\x0f\x6c\x9e\xb5\x27\xe2\xa8\x9a\xf0\x40\x26\x8e\xce\x9b\x4c\x86\x5d\x65\x8a\x61\x57\x09\x6c\x22\x36\xef\xb7\xd3\x58\xb6\x80\xbc\x84\x3b\x97\x95\x3a\x0e\xe0\xf2\xeb\x68\x77\x99\xf2\x07\xe7\x97\xf0\x3e\xc6\xb7\xf9\x84\x65\x9a\xd9\x02\xe8\x12\x3c\xfe\xda\xcf\xcf\x24\xb6\x51\x8b\x2b\x6b\xc6\x4e\x82\x9b\xb6\x58\x59\x84\xb3\x86\x21\x9e\xc8\xb8\x3e\x41\x18\xc3\x25\x72\x89\xab\x14\xa1\x65\xe9\xf7\x66\x48\x9d\x46\xf4\x10\x3c\xcd\x8c\x13\xa3\x25\x3d\x06\x0f\x73\xfe\x10\xe9\x43\x37\xc0\x8b\xa3\xe6\xa6\x5d\x9c\xe4\x77\xfe\x0d\x39\x01\x97\x8c\x4e\x44\x05\x43\x4e\x04\x43\x22\x57\x46\x24\x5c\x23\x57\x5e\x42\x9f\x66\xfe\xc2\xfd\x7e\xbc\x87\x0a\x27\x67\xea\x3f\x59\x4f\x89\x3f\x23\x47\x28\x9b\xaf\x07\x83\x05\xd8\xab\x83\x00\xea\x96\x5a\x5d\x15\xe2\x7a\x30\xa0\xb8\x78\xb3\x59\xf7\xa2\xb8\x97\x81\x7e\x3f\x9b\xaf\x89\x23\x54\x27\x2b\xdb\x6c\xd6\x9b\x8d\x5a\x74\x78\x0f\xf7\xfb\x8a\xf6\xd0\x7a\x67\x62\xb1\xbc\x60\xf7\x83\xc3\xd0\x4f\xd4\x59\xc2\x04\x80\xa3\xaa\x63\xcd\x71\x74\x73\x34\x1e\xa9\x8e\xa3\x81\x49\x9b\xd8\x6e\x7b\x88\x7b\x0b\xdd\x87\x9f\x57\x5b\x62\x32\xbb\xb8\x01\x1e\x93\xe7\xe2\x41\x0e\x55\x30\x71\x7f\x94\x4a\x89\xbb\x02\xc4\xc7\xbc\xfb\xfd\xb4\x82\x17\xf7\x7a\x7e\x78\xa9\x43\x2b\xe6\x42\xf3\x9e\xce\x72\xe7\x22\xd9\xee\x55\x15\x31\x6e\xd9\x1e\x97\x67\x57\x3e\xe8\x8d\x64\x30\xc3\x73\xb7\x75\x83\x4a\x50\xcf\xec\xe2\x5f\x9d\x50\x85\x2f\x9b\x09\xbf\xea\xb6\x4d\x84\x2a\xac\x79\xb3\x25\xdd\x7b\xf9\x59\xa9\x63\x95\x17\x9e\x47\xe2\x8d\x67\x47\x5c\xcd\xc6\x08\xc8\xec\x7e\x6f\x71\x3d\xaf\x79\x31\xb4\xc8\x72\xb1\x14\x12\x5c\x14\xdf\x2e\x51\xbe\x9b\xea\xa2\x4a\x74\x51\xee\x84\x5d\xd6\x29\x2f\x06\x83\x6a\x96\x6f\x36\xf1\x93\x2a\x89\x45\xe9\x5d\xe6\x65\x48\x3f\x91\x35\x00\x26\x89\xac\x42\x76\x39\x8e\xdd\xec\xa9\x6f\x2a\xe6\xf4\x30\xd2\x64\x1e\x43\xf2\x77\x01\xb6\xb0\xbe\x59\xe7\x0e\xab\x6b\x0e\xe5\xcd\x5d\x98\x56\x97\xec\x52\xee\x0e\x5e\xca\xdd\x54\xfc\x09\xe5\x01\x9a\x39\x90\x7e\x98\xa6\xa5\xb8\xa2\x5e\xa4\xe6\xd8\xc3\x0f\xdf\x48\xe3\x2c\x2c\xcb\xfa\x19\x0b\xcb\x1a\x13\x0b\x6b\x6c\xd0\xff\x8f\xe8\xff\x4d\xd1\xda\xd2\xca\x94\x75\x3f\xab\xab\x00\x7f\x81\xbf\xb8\xac\xac\x99\xec\xce\xad\x31\x66\x77\x6e\x2d\x1b\x0c\xff\x38\xfc\x4c\xef\xdd\x16\xd7\x6e\x75\xab\xb8\x76\x6b\x03\x18\x14\x29\xf3\x56\xf4\x22\x0e\xf4\x8a\xeb\xce\x17\xe4\xaf\x06\xe0\x15\xa1\xe0\x00\x78\x4e\x54\x8d\x0a\xe0\x75\xa1\x6b\xee\x8a\x3b\x81\x67\xc5\x2a\xbe\x65\x02\xfe\xa6\x48\x6f\x76\x59\x68\xd0\xc3\x42\xa3\x1e\x93\xea\x1a\x80\x27\xe4\xaf\x0e\xe0\x3e\x81\xeb\x00\x7e\x65\x1a\xec\x94\x69\x2e\xf8\x1a\x9d\x0c\x43\xf8\x1e\x7d\x1d\x86\xf0\x1d\x3a\x1e\x86\xf0\x15\x2a\xaf\x4e\xc3\x37\x28\x1d\xfe\x7e\x7a\xfc\x06\x3e\x45\x6f\xfa\xfd\x37\x43\x76\x8e\x28\x0a\xef\xe0\x01\x5a\xc9\xd2\x59\x91\xbf\x1c\xc0\x97\xe4\x67\x9e\x9c\x94\x29\x35\x24\x00\x5f\x74\x25\x9a\x81\x47\x68\x2d\x17\xd6\xb6\x92\xe2\xf3\x28\xcb\xd3\x3b\x09\xc0\x0f\x35\x98\xcc\xbd\xef\xe4\x67\xb2\x52\x6a\xc8\xb7\x1d\x11\x05\x3f\xb7\xf9\x20\xaf\xfa\xfd\xbd\xbd\xfd\x61\x08\x3f\xa1\x74\xf8\x96\xd5\x81\x1f\xd1\xde\xa7\xcd\x66\xef\x53\x5d\x59\xfc\x35\x0c\xa3\xe2\x1a\x21\x7c\x86\xdc\x7e\xdf\x6f\xbf\xf4\x79\x28\xbf\xff\x41\xae\xbd\xf7\xcc\x39\xa7\x18\x6c\x7a\x8f\xe9\x4d\xcf\x2d\xa0\xf7\x3d\x77\x92\xdf\xb1\x99\xf5\x5a\xfe\x06\x73\x30\x4d\xab\xf3\x8c\xdf\xe6\xf9\x02\xbe\x2f\x90\x60\xca\xfc\x96\x6f\xfd\xfe\x7b\x82\x48\x4c\xc6\xc9\x7b\xf8\x47\xcb\x7d\xf8\x0f\x73\xbc\x40\x87\xf2\xab\xba\x67\xdc\x75\x93\xb3\x4b\x7a\xd3\x04\xfe\x89\x3e\xb7\x78\x9e\xaf\x86\xa5\x8d\xd5\xe2\xa3\xed\x60\x77\xf8\xa0\xbc\x5c\x7e\xb5\x85\xcf\xbb\xee\xbf\x63\x44\xbb\xf3\x5c\xfe\xce\x7a\x78\xcd\x72\xea\xdd\x14\x39\xf5\xae\x69\x4e\x3d\xf9\x03\xcc\xc1\x4c\x8e\x87\xf5\x6e\xe2\x4c\x4e\x64\x0c\x0f\x40\xbf\x8f\xe7\x07\x8b\x79\xbe\xa0\x39\xf0\xe8\x37\xb4\xa7\x01\x18\xa3\x43\x59\x4c\xea\x7a\x29\xab\x34\x50\x02\xc0\xa4\xa8\xbb\xd9\x90\x81\x3d\x80\x97\xb2\x06\xef\xb7\x00\xc0\x8a\x80\x0a\xe0\xb3\xa2\xa1\x60\x52\x8e\xfe\x16\xbe\x6d\x06\xb9\x45\xcb\x2d\x45\x57\x72\x8e\x6e\xa9\x17\x95\x20\x95\xb8\xff\xa5\x0b\xe5\x3e\x49\xa6\xe0\x39\x35\xde\xd2\x79\xd2\x62\xbc\xc1\xbf\x5a\x98\xf8\xa2\x3e\xe4\x02\x31\xba\x91\x69\x8c\xa8\xac\xb4\x47\xe1\x6c\xf8\xc8\x08\x61\x40\x53\x83\x7c\x87\x18\x80\x7e\x5f\xde\x93\xf3\xcd\x66\x2f\x29\x6f\xb4\xd3\xef\x1f\xe8\x97\x02\x76\x50\xc4\xed\x48\x9f\xf1\x02\xd0\x58\x2c\xfc\xbd\xe5\x3c\x21\xba\x15\x79\x42\x67\x60\x41\x2f\xa7\xf4\xbe\xc3\x1c\x54\xc9\x93\x58\x50\xad\x68\x63\x2c\xe0\x09\x0c\xdb\x6c\x04\x86\xd2\x41\x8f\xb7\x5b\xf8\x5b\xfb\xa6\x1b\x8c\xd1\x3b\xf9\x96\x26\xb0\x4d\xd1\x7c\x01\x5d\xa4\x4e\xcb\x70\xf4\x13\x77\x0a\xe8\x53\x50\x3c\x77\x89\xa5\xb9\xd9\xe4\x08\x1d\xd0\xff\x67\x9b\x4d\xca\x36\xe4\x72\x3e\x07\x2c\xc6\x9d\x8f\xa1\x53\x12\xa6\xe8\x9d\x1c\xcf\xbe\x4f\xd8\x23\x5d\xf2\xc8\x25\x52\xa7\x69\x75\x70\x6c\x0a\x58\xcf\x50\x3a\x5f\xb2\x67\xc6\x94\x01\xdf\x68\x67\x5d\xf6\xcc\x0f\xf4\x10\x79\xb9\xcd\xb8\x9d\x7e\xde\x6c\xe4\x48\x96\x5f\xf1\xca\xb2\x25\x9b\xdc\xab\xdd\x1c\x50\x4c\x14\xd7\x9e\x16\x67\x0f\x11\x67\x8b\x99\x14\xc1\x4e\xf6\xb9\x27\x2a\x77\x12\x57\x5d\x14\x5e\x3b\x61\x27\xef\xbc\xd6\x33\xa9\x38\x4d\xf9\x1d\xd2\x75\x57\x4d\x14\xf6\x75\x7e\xb0\xa0\xd3\x4c\xae\xe7\x0d\x5d\x6c\xcf\xca\x40\xe4\x65\x91\xb2\xb3\xea\x71\xbf\xff\xb1\xdf\x7f\x26\x7f\x83\x78\x37\x2f\x4d\x86\xf3\x49\xbe\x05\xf0\x0f\x9a\x6a\x83\x93\xe6\xd5\x95\x54\xa9\xc3\xc5\x1b\x9e\x5d\x6e\x01\x3c\x19\x86\xe8\x77\xf8\x75\x18\xa2\xe7\x30\x96\x47\x1a\x18\x86\x44\x71\xa1\xdf\x60\x2c\x6b\x0e\xf9\xf5\x17\xdc\x1f\x86\x08\x63\xe8\xd2\x1b\xfc\xda\x88\x26\x0c\xfc\x46\xef\xd7\xee\xe8\x23\x09\xfe\x45\xa7\xb7\x37\x0c\xdb\x3c\x84\x3f\xe4\x15\x3d\xcb\x02\xe0\x52\x5e\x0e\x9f\x0f\x96\xc3\x4f\x83\xe5\xf0\xe8\x9f\x7b\x9f\xe1\x3d\xe3\xcd\xe4\xd5\xb6\x96\x06\x39\x46\xd2\x85\x9b\xbd\x2c\x78\x0a\xa3\x6c\x9f\x1e\x96\x39\x5d\xa5\xd8\x0d\xa8\xfe\x2b\xa5\x2c\xa4\xf7\x30\x61\x8a\x57\x4b\xd7\xc7\x30\xc3\x6e\xea\x5f\xc0\x6c\x85\xfd\x08\x67\x90\x1e\xcc\x85\x9c\x4e\x85\x7c\xca\xe8\x75\x9c\xf9\xc9\x8a\x90\xcb\x84\xc4\x2e\x31\x46\xea\x34\x2f\xcd\xc6\x27\x31\x9e\x82\x95\x9c\xe3\x79\x8c\xc9\x54\xad\xef\xab\x61\x74\x2a\xaf\x58\x12\x5d\x00\x13\x52\x29\xad\x2a\x25\x78\x0a\x2e\xe4\x14\xcf\x13\x56\x89\xf4\xfb\xb4\xec\x73\x31\x1f\x25\x48\x96\x4e\x9b\x06\x48\xe4\x23\x88\x07\x48\x92\xc0\xec\x68\x8e\x17\x13\xf2\x3f\xf4\x8a\xb0\x1a\x5e\xe2\xbb\xa3\x46\xa5\x28\x94\xf7\xfe\xfc\x51\x9a\xcf\x1e\xd3\x3d\x64\xba\x57\xc3\x4c\x9c\xc5\x23\xe2\x3a\x1e\x11\xc1\xcd\xdf\x8c\xdb\xc2\x75\x86\x4f\x71\x9e\xe3\x94\x57\xcf\x1f\x69\xba\x4c\x52\x14\x5d\xad\x96\xb8\x51\xa4\x6d\x0b\x06\xd7\x1d\x2d\xee\x0e\xc3\x7b\xb6\x09\x31\x69\xdd\x46\xe5\x2e\x5f\x1c\xca\x18\x4c\xde\xca\x87\x54\x68\x82\x2d\x6c\xe4\x91\x7d\x0e\x77\xc2\x01\x6f\xa1\x78\x0a\xea\x00\xb3\x0c\x03\x49\x3a\xf9\x1d\xb6\x1c\x90\x9a\xfc\x06\x5b\xcf\x5a\x4d\x30\xde\x32\x49\xe0\x62\x24\x18\x2e\xfb\xc3\x50\x26\xaa\x8f\xe3\xa1\x8b\xb9\xae\xb5\x93\x6b\xe1\x2a\x21\xc4\x8e\x75\x6f\x01\x7c\xd3\xef\xd7\xf4\xe4\xbd\xcf\x9b\x8d\xf0\x50\x26\x92\x5e\xc9\x55\xc2\xda\x39\xf1\xcb\x16\xd2\x1e\x7a\x2a\x33\x8d\x23\xdd\x6f\xe9\xaf\x7b\x77\x82\xb7\xdc\xef\xea\x1a\x37\xd1\xd6\x50\x22\xa6\xa7\x04\xef\x2b\xab\x73\xd2\x21\xb9\x89\x62\xc0\x0b\x98\x20\x6d\xba\x23\x06\x93\x29\x48\x9b\xe7\x32\x12\x3a\xad\xe9\x66\x72\x4e\xcf\xb5\x43\x99\x5d\xfe\xa9\x76\x82\xa8\x4e\xfd\x93\xcb\x8f\x77\x5e\x5e\x0e\xda\x51\x93\x6d\x67\x14\x89\xa4\x2c\x93\xd3\x71\x5b\xe9\x7b\x7f\x12\x13\xa1\x9a\xa7\x00\x92\x67\xa3\x1c\x3e\x2d\xa2\x75\x6f\x88\x5d\xb7\x05\x90\x33\xde\xe6\x2f\x17\x9b\x0d\x8d\x83\x71\x40\xf8\x92\x47\xa9\xd2\xea\xc1\x50\x7e\x55\xad\x4f\xf2\xeb\xb5\x9b\x5f\x40\x89\xfc\x5f\xa2\x12\x2e\x94\x0b\x73\xbe\x18\xd9\xbd\xb6\xcd\x93\x2a\xc1\xc7\x18\xc8\xd2\x15\xce\xdd\x32\x3b\x5d\x99\x4a\x96\xf9\x3c\x34\x97\x19\xf1\x74\xab\xe8\x40\x94\x1d\xde\xe6\x38\xa6\x47\xa5\xb9\xd8\x00\x97\x53\x18\xfa\x9d\x89\xad\x33\xb9\xb2\xed\xe9\x3e\x50\x41\x2a\x89\x33\x99\x58\x68\x5b\xe2\x45\xf1\xdc\x5f\xca\x18\xa6\xa5\x81\x7d\x1f\x4d\xa4\x63\x69\xd0\x1b\x0c\x22\x78\x43\xd3\x9a\x90\x7e\xf1\x99\xcf\xff\x38\xfc\x3c\x49\xe1\x9b\xc3\xc3\x83\xc9\x9e\x06\x43\x37\xcb\xff\xc0\x77\x93\x1d\x5e\xee\x25\x35\xcb\x77\x0d\xde\x19\x9e\xc8\xbb\x57\x29\x66\xd2\xa9\x34\x91\x4e\x24\x30\xa0\x49\xf5\xf7\x5c\xd2\x32\xc0\xc8\x65\x1c\xb9\x23\x89\x25\x37\x2c\x7f\x1f\x4a\xd3\x35\x91\x8a\xd5\xa5\x94\x74\x31\x8c\xb6\x64\x81\x7f\xc2\xee\x65\x4b\xdb\x3a\x08\xef\xa9\x02\xdd\x3d\x6d\x97\xec\xcd\x16\x26\xf1\x51\x8a\xf1\x77\xdc\xb6\xb6\xfd\x7e\x3f\x1c\x92\xb1\xe9\xf7\x09\xdd\x7e\xbf\x78\x54\xbf\x4f\x28\xc1\x56\xa7\xbc\x9a\x25\x85\x43\x4c\x3c\x4b\x1a\x45\xe9\x0c\x31\x32\xcb\xb6\x48\x5a\x92\x0c\x43\xba\xfe\xaa\xfb\xf4\x4b\xea\x40\xd3\x64\x21\xee\x30\x84\x3e\x52\xa7\xe5\x36\xc4\x13\x7f\x0a\xb2\x72\x27\x72\x89\xa2\xb9\xcf\x52\x81\x14\xc7\xad\x96\xb5\x7f\xf3\xd3\xf9\x3f\x84\x6b\x7f\x6d\x71\xac\x2a\x15\x53\xfa\x70\x32\x8f\x22\xc0\x4a\x6d\x10\xe8\xf2\xa9\x22\xe1\x72\xf7\xa8\xb0\x98\x78\xbe\x7a\x45\x44\x8b\xa4\x9f\x3d\x70\x4a\xb6\x48\x0c\x31\x99\x2f\xea\x1e\xb5\x9b\x2e\xcb\x7e\x5f\x9a\x17\x09\x2f\x59\xe2\x9b\x85\x84\x90\x5b\xa6\xa9\x14\xbc\x3c\x2e\xbf\x06\x59\x04\xcd\x8c\xe0\xbd\x65\xb1\x15\x03\xb6\x5b\xa2\xe7\x92\xd6\x04\xb3\xc2\xcc\x70\xc4\xa8\xbe\x53\x64\x90\x31\xb8\x14\xd4\x2c\x41\xbb\xdf\x9e\xe6\xa4\xd6\x86\x75\x8a\x76\x7f\x77\x5d\x60\x7a\xb9\x03\xe6\x68\x59\xb8\x29\x19\x9f\x89\xdd\x67\xa1\x55\x21\x0f\x7b\x44\x61\x55\xc6\x50\x79\x2f\x1d\x86\xf5\x4e\x37\xc4\xc4\x74\xdf\x09\x64\xb5\x85\x97\x0c\x0d\xc8\x92\x9b\xdd\xc5\xfe\xcb\x07\x76\xeb\x0a\xbc\xc4\xcb\x70\x7a\x4d\x0d\xce\x1f\x6d\x48\xb7\xed\x2e\xa7\x74\x30\xc7\xf4\x4d\x0b\x72\x8a\x68\x12\x28\x7e\x7f\x3a\xad\xf7\xa7\x53\x7e\x8f\xb6\xda\xea\x14\xa7\x79\x7d\xa6\x51\x9b\xe6\xbb\xc7\x19\xf3\x32\x21\x20\x77\xf7\x76\x9e\x2f\xc4\x7c\x07\xf1\xcf\xa5\xbf\x88\xa9\x28\xa1\x17\x7e\xe9\x6b\x61\xb8\x0b\x25\x3f\x19\xec\x1b\xdb\x3f\x13\xec\x1b\x3b\x3b\xa1\xbd\xa2\x7d\x6e\x96\x45\xe7\xf1\x03\x53\xd5\x04\xd3\x54\x4e\x87\xa7\x83\x74\x78\xc4\x59\x47\xac\xde\x24\x96\x6d\x75\x27\x67\x70\x67\x84\xb0\x4c\xcb\x67\x14\x69\xf9\x8a\xb0\x32\x59\x0e\x51\x11\xb9\xcb\x8a\x24\xfa\xd5\xc4\x67\x0f\xe2\x04\xd4\x9e\x4f\xb4\xbe\xa8\x29\x99\x59\x75\xbf\x85\x39\xf9\x5f\x8c\x8a\xf4\xd2\xc4\x2d\x96\x5c\xcf\x0f\x70\x78\x7e\x11\x7d\xbd\x5c\x5e\xc5\xc9\xea\x5b\x9a\xe5\x52\xfd\xa6\x9d\x78\x81\xc6\x30\xad\x13\x15\x0d\xc3\x24\x3d\x74\xfd\x0b\xe1\xca\x69\x4e\x0c\x75\xbc\x05\x70\xbc\x87\x7c\x7a\xc3\x17\xcc\xe3\x45\xf5\xea\x1c\x7a\x4c\x88\xc2\x73\x50\x5e\x45\x96\xc0\x1e\x4a\x1b\x31\x2d\xfe\x82\x45\x44\x56\xa6\xbf\x7b\x85\x75\x8d\x34\x18\x52\x41\x1f\xa0\xe5\x30\x64\x5b\x46\x65\xb5\x15\xf4\x50\xc6\x59\x6b\x74\x0b\x09\x5e\xa0\x70\x96\xc8\x5e\x75\xf7\x20\x94\x3d\x1a\x00\xa7\x49\x4e\x2e\x4a\xc2\xe7\x48\x9d\x5e\x3d\x39\x9f\x82\x15\xba\x98\x9f\x0f\x06\x0b\x9a\x91\x2e\x60\x13\xd1\x83\x2b\x62\xc3\xc5\xf3\xd5\x02\x79\xf3\x55\xed\x9f\xc7\xdb\x89\xff\x9f\x3c\x23\x62\xeb\xd5\x19\x11\xdb\x04\x80\x5b\xad\x3b\xd2\x4d\xbc\xa0\xce\xe7\x6b\x8e\x42\x59\xbc\xe2\x5d\x1b\xcf\xa0\xb9\x9d\xc7\xa5\x30\x99\x2f\x60\x4a\x5f\xaa\x84\xf6\x34\xb8\x2c\x4f\xf9\x13\x89\x59\x8e\x7f\x04\xb3\xc6\xed\x5a\x0c\xa6\x7b\x72\x8a\xe4\x08\x65\x34\xb5\xa0\x0c\xc0\x30\x48\x62\x1a\x11\x28\xf2\x9b\x44\xe5\x1b\x28\xf6\xd8\xe1\x4c\xca\x0e\x7a\xa4\x6a\x4a\x1e\x07\xa6\xb5\x08\x4e\xc8\xe3\x97\x08\x6f\xc3\x28\x76\x97\xcb\x3b\xaa\x7a\xf6\xd2\x7e\x3f\x1b\xb2\x76\xd7\xdf\x64\x50\x21\x45\xa1\x9c\x14\x4e\xe2\xb2\xda\xf8\x8e\xb7\x2c\xe8\xd4\x76\xaf\x53\x7a\x19\x5f\xbb\xcb\x28\xe8\xb9\x79\x8e\xaf\x56\x34\xe5\x73\x80\x59\xe8\x64\x9d\x62\x7a\xe4\x9c\x7a\xe2\xde\xb2\x0e\x5a\xb6\xee\xfb\xb6\xca\x24\xdb\xf8\xa9\x23\x1e\x0e\x3d\xe2\xa1\x8a\x92\xc9\x1e\x3d\x60\x77\x8f\xf4\x3a\x45\x17\xb7\xe7\x5d\x6c\x51\x4d\x1b\x12\x2e\xca\x5e\x16\x7d\x68\x31\xb6\xaa\x19\x21\xde\x1c\x23\x6c\xa1\xf7\x77\xf9\x7c\x95\x2c\x19\xaa\xdb\xbc\xa9\x4b\x93\x66\xfd\xf4\x98\xfc\xd4\xa6\x4c\xc7\x98\x8c\x7f\x22\xd9\xa0\x6d\xef\x0c\xc0\x39\xce\x4b\xe5\xdb\x96\x1d\x96\x74\xbe\xfd\x40\x43\xfe\x50\xc8\xa1\x9c\x18\xdc\x71\x86\x54\xce\xab\x6c\xe3\x0f\xbd\x1c\xe5\xdf\x62\x1f\xd7\xfa\xd7\x34\xc1\x7b\x5b\x6a\xf8\xbd\x3a\xb8\x81\x29\xdf\x70\x33\xd3\xa8\x3b\x27\x62\xe9\xc1\x73\x73\x3c\x9f\x1c\xf5\x67\xf8\xe4\x68\x5d\xfa\x94\xa8\x82\x07\x98\x65\x57\x1a\x70\x1a\xcb\x8e\x5e\x26\x39\x6f\x89\xe9\xb5\x86\x8f\x0a\xc3\xf2\xa1\xe9\x60\x0a\x39\x83\xed\x1f\xe4\x21\x44\x72\x52\xb4\x9c\x5d\x5d\xc7\x95\x52\x9b\xe3\x05\x3b\x69\xb2\x24\xba\x2f\x97\x63\x00\x2b\x3b\xe0\x9f\x2e\xaf\x7c\x63\x1a\x46\xa9\x6d\x83\x65\xdb\x1c\x10\x12\x85\x54\x1a\xa3\x2a\x2e\x4f\x13\x75\xbc\x1f\xa4\x3c\xdd\x03\x1a\xef\x08\xa9\x4f\xfd\xcc\xd5\x45\xf3\x4d\x21\x42\x21\x8c\xe7\x5a\x89\x61\x74\x63\xc0\x78\xae\x2f\x76\xdf\x22\x02\x63\xee\xba\x66\xca\x47\xb9\xf6\xf6\x64\x3c\x2b\xc3\x83\x13\x15\xf4\xfb\x2d\x87\xa8\xf2\xbd\xfa\xe4\x94\x58\x4c\xef\xd7\x95\xaf\x70\xab\xdf\xcb\xe0\x22\x45\x9b\x0e\x06\xee\xe3\x84\x1e\x17\xc9\x65\x3c\x77\x17\xd0\x85\xb5\x92\x73\x8b\xb5\xa7\x68\x5b\x9a\xd8\x90\xcb\x57\xae\x68\xd0\xad\x48\x4d\x07\x83\xf4\xb1\x4b\xa9\x50\x2b\x93\x3b\xc3\x95\x0a\x24\x72\xf0\x44\xd1\x1a\xb9\x02\xf8\x0b\x85\x55\x89\xdb\xb4\x64\x70\x9d\x79\x46\x9d\xc6\x8a\x32\x05\xd4\xaa\xa2\xf7\xfa\xd2\x6a\xd7\xbe\x97\x36\x6e\xeb\xf3\x24\x14\x0d\xa6\x35\x1d\xb6\x4f\x34\x5f\x4c\x07\x83\xf8\x71\x3a\x2d\xae\xf4\xd3\xeb\xb5\xd3\x25\xbb\x2f\x28\x9d\x9d\x2d\x93\xc0\xcd\x2e\xce\x68\x80\xf8\x22\x59\x06\x38\x3d\x3b\x93\xf6\x10\x5a\x6e\x36\x34\x01\x09\xea\xc2\x81\x2e\x8d\x72\xa1\xfa\x0d\x32\x2e\x7f\x7d\xbb\xee\xf7\x25\x71\x04\xff\x2a\xd2\xc1\x56\x18\x19\xf7\x2e\x28\x79\x8f\xe0\xb4\x79\xc9\x7b\x7b\xcf\xfa\xfd\x67\x34\xf5\x19\x71\x0a\x89\x39\xb0\x2b\x82\xe9\x03\x9e\x97\xa2\x73\x22\x49\x55\x54\xb0\xf0\x4c\xcb\xcb\xae\xc4\x37\xcd\x37\x9b\x0a\xfe\x1c\xc7\x4c\xc2\x09\x08\xdb\x9d\xb6\xb0\xc7\xb0\xeb\x45\x85\xb8\x6c\x7d\xff\x0f\xf7\xfa\x08\x62\x71\xe4\x88\x4c\xeb\x81\x24\xf1\xde\x61\x15\x49\x20\x8d\x7e\x3b\x39\x00\x2c\xe1\x4b\x87\x50\xe6\x9d\xe6\x3f\xca\x1e\x72\xd4\xf8\x9c\x95\x03\x49\xe2\x73\x56\x16\x63\x20\xd1\x71\xdb\xb9\x1c\x2e\xae\x18\x61\xae\x73\xf7\x47\x60\x44\x8a\xb2\xea\xb8\x3a\xf4\xd1\xef\xb2\xab\x2c\xa1\x0a\xe0\xba\x48\x12\x93\x0d\x7c\x00\x43\xb4\x97\x4e\x07\x83\xe8\x71\x36\x05\xeb\x79\xb4\x40\xf9\x3c\x62\xbe\xdc\x74\x30\x48\x1e\x2f\xa7\x40\x0e\x37\x9b\xe4\xb1\x4b\x38\xb8\x9e\xc7\xf3\x64\xb1\x40\x44\xd5\x14\xf9\x58\x7d\x32\xdf\xd7\xf3\x88\x4c\x28\x4c\xe7\x55\x39\xdf\xd7\xc2\x05\xef\x1f\x37\x5d\xd1\x88\x13\x54\x9f\x6f\x53\x34\xe8\xd7\xed\x5f\xd3\xf6\x47\xa4\xfd\x61\xd1\xfe\x35\x69\x7f\xc0\xda\x9f\x3c\x26\x5e\xc2\x3c\x61\x6d\xab\x84\xc1\x0a\x25\xd3\xc1\x20\x7b\xec\x93\xc2\xd5\x20\x23\xdd\xcb\xaa\xee\x2d\x1f\x47\x53\x20\x07\x55\xf7\x08\x4a\x3c\x5f\x2e\xca\x9e\x54\xfa\x3d\x14\x2e\x8a\xef\xaa\xa8\x72\xa2\x55\x8e\x49\x79\x3a\x34\xff\xf7\xdf\xf7\xd4\x22\xc8\xeb\x12\x98\xb7\x48\xf1\x46\x31\xcc\x89\x08\x67\x38\xa3\x87\x70\x60\x3e\x37\x4a\x44\xf3\x87\x88\x30\x9f\x8f\x4a\x6c\xeb\xe7\xb0\x61\x3e\x37\xcb\x2a\xe3\x7f\xa3\x0a\xcc\xe7\xd6\x02\x6c\x2b\x3f\x91\x3b\xaf\x40\xc5\x24\x53\x4a\x71\xbd\xa5\xdc\xbb\x94\x53\x30\x4b\x27\x31\x27\xa6\x82\x72\xce\xc1\x84\x26\x43\xf1\x58\xc2\x79\x9a\x67\x1e\x69\xba\xdd\xcf\xe1\x1d\xd2\xfa\x39\xbc\x45\x7a\x3f\x87\x37\x48\x1f\xf5\x73\x78\x89\x4c\x8d\xfc\x3c\x44\xb7\xb3\xe2\x30\x73\xc8\x19\xc9\x15\xf5\x63\xb9\x9e\xca\x27\xbb\x4e\xec\x7e\x31\x45\x4f\x00\xfc\x8a\x4e\xa6\x5f\xc9\x22\xd9\x9f\x7f\xe5\x53\x2f\x7d\xa5\x57\x21\x6f\x00\xa1\x70\x8a\x2e\xe4\x63\x00\x5f\x23\x57\xde\x87\xa7\xd4\x2a\x4d\xfb\x7d\x79\x1f\xf9\xf2\x3e\xed\xc0\x0d\x00\x30\xa2\x90\xb5\xbc\x4f\xbb\x43\x20\x27\x0a\x7a\x0d\x6f\xfa\xfd\x93\xc7\x45\xc7\xde\xa3\x25\x23\x50\x34\x77\x45\xc7\x20\x80\xc7\x43\x4e\x03\xc0\x18\xee\xc3\xf7\x6c\x3c\x94\x13\x36\xcc\xef\xd0\xdd\x2c\xa6\xb9\x32\xe0\x2b\x74\x3b\x7b\x37\xc7\x8b\x09\x2e\xa9\x9c\xa0\xfd\xb2\x5f\xde\x6c\x1f\x9d\xc9\xfb\xd0\x03\x93\xcb\x7e\xff\xe4\x89\xd6\xef\xef\x0f\x53\x7c\x8d\x53\x9a\xf0\xfa\xba\xdf\xbf\x7a\x7c\x42\xda\x59\x54\x40\x57\xec\xe0\x1b\x3b\x55\xc0\x4e\xa9\x34\xf7\xb5\x8f\xfb\x7d\xf9\x15\x3a\xdc\x6c\x42\xf9\x15\x00\xf0\x55\xc1\xde\x77\x70\x1f\x70\xec\x5c\x71\xec\xa4\x07\xd4\x61\x06\x7d\xd6\xeb\x35\xb2\xfb\xf9\x34\xdf\xa0\xf5\xcc\xd0\x27\xd6\x08\x8e\xfa\x72\xde\x47\xff\x4b\x5e\xcf\xac\xd1\xc4\xd0\x01\xdd\x9d\xe9\x23\x65\xc4\x36\xc2\x42\xc4\x8c\x8f\x04\xae\x67\x6e\x99\xcc\x63\x3d\x5b\xd6\x5f\x0b\xce\xbb\xf5\xd7\xf2\x81\xd5\x44\xe0\x47\x14\xa5\x30\xc7\x72\x48\x77\x72\xea\x16\x7b\xf4\x21\xac\xbd\x42\x6b\xf5\x3e\xcd\xd0\xb9\xb7\x6e\x57\x45\xad\x99\x88\xa4\xc3\xdb\x15\x4d\x23\xc3\x9d\xa7\x2e\x76\xf8\x3d\x94\xcc\x92\xca\x00\x23\x94\xbd\xa2\xbf\xce\x18\x26\xc8\x45\xe5\xf6\x7e\x56\x67\xce\xca\x66\xd9\xe4\x77\xf9\x58\xce\x00\xa4\xb1\x26\x2e\xe9\x96\x3f\x39\x96\x7d\x00\x3d\x05\xb9\x33\xb7\x22\x0b\xad\x51\xbf\xb0\x99\xaf\x50\x02\xcf\x91\x3b\xad\x49\x6f\xd9\xa2\x9a\xd7\xfd\xbd\x82\xe7\xac\xcf\x74\x92\x63\x74\x4d\x97\x3b\xba\x26\x0b\x3e\x45\xd7\x64\xc9\x27\xe8\x9a\x2c\x7a\x17\x5d\x93\x65\xbf\x27\xfb\xe8\x7a\xee\x2c\x10\xcb\xc3\x47\xbe\xce\xd6\x33\x75\x52\x59\x96\xbf\xcb\x04\xa6\x78\x50\x25\xb6\x04\x59\xab\x7d\xca\x65\x45\x37\x01\xcc\xfb\x7d\x8d\x98\x98\x77\xc8\xa6\x76\x82\x66\x21\x94\x8b\xa7\xa9\x8a\xfb\xd0\x09\x6a\x5d\xce\x2e\xb7\x9c\xa3\xdd\xe5\x5c\xec\x0a\xc8\x11\xbd\x02\x01\xd7\xe8\x42\x76\x01\x53\x7d\x19\x7d\xc7\x49\xb5\xaa\xfd\x45\x31\xc5\xa2\xc7\x46\xbf\x9f\xcd\xd5\xc5\x1e\x42\x6b\xf2\x2d\x52\x34\xfa\x7d\x36\x5f\x4c\x96\x72\x06\xd7\x55\xc2\xaf\x48\x41\x61\xa9\x27\x1e\xa7\xb3\x72\xd1\xba\xc2\xa2\x2d\xe6\x66\x06\xc3\xf2\x6b\x99\xc0\x41\x89\xc0\x84\xbd\xa4\xe2\xa1\x55\xe6\xce\x92\x09\xbb\x08\x0f\x33\x1a\xa7\x87\x39\xf4\xc1\xc4\xd0\xf7\x88\x39\x69\x18\x7b\x88\xba\xf8\xc5\x91\xd2\x40\xcc\x39\x76\x2d\xde\x13\x65\x17\x0f\xe8\x9e\x32\x95\xa0\xcb\xf6\x41\xcd\x85\x41\xa5\xe6\xc8\xce\xc8\xfa\x04\xbe\x46\xe5\xfc\xe5\x74\x7b\x46\x74\xfb\x8f\x7a\x95\xcf\x96\x13\x3c\x1d\x0c\x7c\xa6\xfe\xfd\x05\x4a\x08\x0b\xa8\x7e\xcf\x08\x7b\x42\xba\x23\xc4\x31\x68\x30\x88\xea\xcc\x2d\x72\x00\xdd\x59\xca\xe4\x5e\x58\x8e\x0a\xe9\x1c\xbb\xff\x47\x1a\x7e\xd7\xfa\x2e\xcc\x94\xf6\xbb\x63\x32\xe5\x95\x13\xfb\x43\xae\xe4\x84\x2b\x65\x82\xb7\xb4\x14\xc1\xfc\xbd\x8c\x62\xf2\x56\xfb\x58\x58\xbe\x6b\xc8\x99\x0b\x21\x7f\x37\x37\x67\x6a\x94\x2b\xde\xe3\x6d\x3d\xab\x50\xbc\x66\xae\x14\x77\x34\x85\x25\x7f\x68\xab\x97\xc9\x31\x98\x95\xa9\x8f\x6a\xca\xe7\xb5\x95\x5d\xe6\x0e\x7f\x57\x37\x76\x46\x34\x7c\x11\x5a\x7e\x45\x6f\xef\x55\x15\xaf\xf9\x26\xe5\xb5\x07\x15\x2b\x5a\x55\x9d\xf8\x6b\x72\xfc\x44\x9b\x49\xfd\x1e\x4d\x2e\x30\xc8\x69\x66\x50\x94\xb3\x18\x73\xfc\x44\x9f\x49\x90\x14\xf5\xe8\x7b\x17\x8a\xd3\x32\xf2\x7b\x28\xdd\x7f\x89\x1f\xfd\xb3\x37\xbf\x49\xdd\xd5\x0a\x07\xbd\x9b\x28\xbf\xe8\x49\x83\x7c\x20\x2d\x7a\xff\x7c\xf4\x25\x96\xb8\xf1\xbb\x6b\xb8\xb3\x79\x21\x83\xf2\x59\xf3\xd4\xff\x24\x27\x56\xe2\xce\x8b\x9b\xf1\x66\xf3\x82\xf9\x02\xd4\xcf\xc1\x4f\x14\xad\xdf\xc7\xff\x43\x43\x48\xed\xf7\xf1\xe3\xbc\x7e\xd4\xd9\x03\xae\xe3\x6f\x95\xb1\x48\x8f\x59\xb5\x06\x2b\x04\x37\x91\xbd\xff\x82\x1e\x3c\x28\xd2\xb4\x02\x50\x7a\x8c\x55\x3a\xa6\x29\xef\xb5\xb0\x57\x51\x14\x0b\x97\x0c\xe5\x94\xba\xc4\x77\xb2\x0b\x63\x30\x4b\xe6\x6e\x79\x14\xac\x25\xad\xd7\xad\x30\x5d\xba\x82\xfa\x8a\x06\x13\xc4\xc7\x03\x68\x83\x92\x7e\x7f\x4f\xdb\x43\xc2\xe9\x32\x26\x8c\xa5\xb3\xa1\x34\x88\xe7\xea\x62\x9a\xf7\xe3\xb9\xb6\xa0\xef\xee\x23\xee\x7c\xbf\x8f\x59\xf0\x38\x01\x5b\x96\x4e\x73\x0a\xa6\x5b\xf9\x94\xb0\x39\x4b\xd2\x5c\xe6\x18\x78\x23\xae\x4c\xa2\x77\xed\x86\x90\x6c\xfd\x93\xa3\xb8\x9c\xef\xdc\x31\x40\x41\xb1\xdf\x08\x32\x98\x5b\x51\x97\x5c\xca\xaa\x62\x16\x94\xc7\x1b\xf7\x30\x99\x24\xf5\xfe\x6e\xe3\xf5\x3a\x5c\xc3\x0f\xf9\x95\x3b\x93\x71\x57\x72\x15\x2e\x37\x23\x1f\xe2\xff\x99\x83\xb8\xed\xae\x39\x6e\x7b\xb7\xff\x96\xee\xef\x57\x2e\x36\xdb\x42\x22\x7e\x75\xe5\xa0\x6f\xb9\x4d\x86\x37\xee\x1b\xd2\x06\x32\x0e\xe5\x40\xb4\x5e\xe6\x28\x0e\xa0\xcc\xaa\x6f\x32\x98\xe0\x29\x46\x97\x72\x0e\x66\xf9\x40\x92\x26\x39\xbd\xa3\xbd\xfb\x66\xff\xe2\x41\xec\xb6\x25\x9e\x0c\x48\xad\x7a\x8d\xbf\x86\x52\x61\xff\xc4\xe8\x69\xb9\xfa\x2a\xc1\xbe\xd9\xbc\x2c\x61\xb3\x23\x19\x17\xfb\xd3\x3a\x80\xf1\x4c\x9f\xd8\x60\xf2\xa6\x2a\x7d\xe3\xbe\x99\x0c\x58\x88\x02\x21\xa4\x3d\x52\x37\x1b\x8c\x10\x52\xb4\x47\xea\x4c\x1b\x8e\x9d\xb1\xe5\x18\x9a\x31\xb2\x2d\xdd\xd0\xcc\x31\x36\x54\xfb\x9f\x32\x7e\xac\xce\x14\x6d\xa2\x81\x09\x66\x6d\x53\x27\x65\x2b\x39\xd1\x78\x5c\x4f\x91\x43\x76\xb2\x21\xff\x1f\xb5\x6c\xa3\x97\x32\x66\xb9\x12\x4f\xf2\x09\x33\xa0\x4e\xe0\x3e\xfc\x0a\x4f\xd1\x7c\x2e\xb9\xe9\x9d\x04\x35\xdd\x5e\xc0\xb9\xe4\x45\x71\x20\x41\xad\xfc\xfa\x07\xbe\x93\xa0\x4e\x7e\xf9\xeb\x94\xa0\xd9\xd5\xf7\x77\xd1\xf9\x45\x2e\x41\xcd\x22\x90\x70\x19\xad\x24\x68\x6a\x14\x75\xe5\xa6\x79\xe4\x2e\x25\x68\xf0\x3f\x0b\x7c\x6b\x44\x60\x29\x76\xd3\x73\x09\xea\xa6\xb5\x58\xc0\xd7\xe8\xd1\xbf\xbe\x64\x83\xcd\x97\x6c\xf0\xdb\xa3\x73\xf8\x1e\x3d\xfa\x72\x2f\xcf\x26\x5f\xe2\x2f\x8f\xbe\xfc\xb3\xf7\x45\x14\xab\xc3\xc1\x97\x45\xef\xcb\x3f\xbf\x3c\x02\xb3\x2f\xf1\xec\x11\x7c\x47\xb0\xdb\x51\xe5\xe1\x00\x50\xe4\x47\xf0\x15\x7a\x04\x67\xbd\x7e\xef\x11\x7c\x83\x1e\xfd\x6b\xae\x0c\x16\xea\xed\x5c\x55\x1c\x57\x09\x17\x83\xdf\x1e\x45\xf0\x29\x7a\xf4\x2f\xd5\x9b\xab\x1a\xfb\x79\x40\x9a\x54\xce\xcd\xe1\x60\xb6\x5f\x1f\x75\xfd\xb2\xf8\xed\x11\x7c\x49\xd0\x93\xb9\xaa\x8c\x19\xfe\x0b\xf4\xe8\x5f\xf2\x6c\xa2\x6e\xe6\x9a\xe2\x2c\xbe\x04\xff\x04\xbf\x3d\x82\x47\x68\xe5\xa6\x19\x7e\x19\xe7\xf0\xc3\xee\x11\x8c\xbc\x4f\x8c\xca\xbc\x88\x07\xa3\x32\x25\x53\xbf\x9f\xc3\xef\xbb\xd8\xf5\x4b\xff\x8b\x57\xff\xef\x54\x23\x50\xf8\x0d\x7d\xd8\x6c\xbe\x6f\x36\x9d\x6f\xfc\x80\x9f\xd1\x6e\x4a\x37\xf8\x69\xf7\x3e\xc3\x47\xf4\xad\xfd\x8d\x9c\xf0\x19\x92\x4f\xd0\xa3\xf9\xbf\x86\xa4\xeb\x43\x7c\x8b\x7d\xf9\x63\xbf\xff\xb1\x78\x63\x1b\xfb\x3b\x2c\xef\x73\x6d\x36\x92\x04\xc0\xac\x7c\x03\x71\x96\xfa\xe0\x4c\x1b\x4a\x83\x93\x89\x24\xc1\x3f\xd0\xe7\xfa\x94\xca\x9f\xe8\x53\x63\xcb\x07\x3e\x47\x9f\xea\xf2\xb7\xe8\x1d\x3e\x3f\xbc\x5d\xc9\xd2\xbf\xa4\x41\x11\xe3\xfa\x13\x54\x2b\xf4\xd1\xfc\xcb\x97\x7f\xfd\x36\xfc\xe7\x60\x26\x83\xf9\x97\xc5\xfd\x76\xb3\x78\x74\x0e\xa5\x2f\x5f\x7e\xeb\x4b\x1c\x96\xf8\x84\x4d\x25\xd5\xc0\xf0\x9f\x33\x79\x86\xbe\x7c\xf9\x22\x83\x4d\x2f\x4c\x52\xc2\x75\x06\x58\x00\x42\xe8\x37\x6d\xf8\xcf\x99\x04\x06\xd2\x6f\x12\x80\x7f\x89\x59\x21\xe0\xef\xf5\xf5\xbb\xdf\xaa\xeb\x77\x10\x63\x24\xef\xa3\xab\x62\x3b\x94\xa6\x27\xe3\x8e\x61\x4a\x00\xca\x5f\xd1\x15\xcd\xa3\x09\xfa\xfd\xaf\x55\x3a\xe3\xd9\x7e\x7d\x44\x1a\x23\x8c\xdb\xef\x69\xc0\x04\x11\x79\x56\xed\xac\x63\x59\x38\xb5\xbc\x73\xd4\x99\xcf\x82\xa6\x15\x2f\xe4\x94\x53\x74\x2d\x27\xf0\x56\x3e\x97\x13\x00\x63\xd0\x76\x9f\x35\xa5\x2f\x29\x6e\xbd\x60\xb1\x9d\x0a\x0a\x0b\xd1\xfc\xdd\xe5\xee\xc7\xcd\x16\x70\xa7\x0e\x63\x79\x64\x80\xff\x9e\xec\x07\xc3\x7a\x63\xbb\x7d\x5f\x3b\x96\x1d\x13\x4c\xf3\x61\x7e\x41\xcf\x99\xa5\x49\x7e\x93\x84\xc9\x3a\xa5\x2f\x2c\x63\x67\x53\x09\x8e\x45\x70\xdc\x95\x9b\x5f\xdc\xa1\x94\x38\x58\x45\x1e\x2a\xd9\x19\xd3\x92\xec\x02\x67\x28\x95\x97\xac\x20\x22\x05\x36\x2d\xc8\xf1\x32\xc2\xe9\xc1\x3a\xc6\x28\x95\xa3\x32\x3f\x7b\x2c\x3b\x0e\x57\x7c\x94\xa4\x38\xcb\x51\x2a\x67\x0c\xc1\xa7\x7b\x87\x2a\x87\xf1\x02\xbb\xf9\x05\x4a\x65\x1f\x94\x79\x5e\x65\x4d\xd5\x38\x84\x57\xee\x25\xce\xa2\x80\x3c\x65\x5d\x87\x12\x34\x55\xe7\x70\x4e\xb1\x5b\xa0\x84\x0c\x85\xde\xe8\x52\x0d\x82\xe2\xe1\x6c\x15\xc5\x28\x95\x03\x56\x44\xdf\xd4\xa2\x8e\x68\x51\x8a\x6f\x30\x19\x90\x55\xe9\xd4\x93\x22\x93\x15\x11\x39\x8d\x52\xb9\x78\x4b\xe2\x05\x2d\xa2\x83\xe5\x5f\xb8\xcb\x4b\x94\xca\x17\x65\x72\x6b\x52\x42\x07\xcb\x4f\x02\x9c\xf9\x17\x49\xb2\x44\xa9\x7c\xc5\x8a\xcf\x69\xb1\xcd\x8a\x97\x49\x4a\x06\xf3\xbc\x78\xf3\x22\x2d\x72\xf8\xe3\x06\xa9\x7c\xcd\xca\xd8\xdd\x32\x3a\x50\x98\xb4\x24\xa2\x5c\xb8\x63\x85\xf4\xc2\x99\x46\x07\x09\x13\x5b\x85\x14\x9d\xb1\xa2\x5b\x5a\x44\xc7\x26\x5c\xba\x84\xe0\x2d\x2b\xb8\xa1\x05\x74\x44\xce\x93\xe4\x7c\x49\x06\xeb\x86\x15\xd1\x8b\x6a\x1a\x1d\x91\xf3\xd4\xbd\xcb\x7c\x97\x96\x5e\xb2\xd2\x43\x5a\x6a\xb2\x52\x8c\xe3\xcc\x27\xff\x47\xa9\x7c\xc8\xca\x8f\x69\x39\x1d\x99\x0b\x37\xbd\x4a\xe2\xc8\x47\xa9\x7c\xcc\x0a\x4f\x68\x21\x1d\x9c\x8b\x64\x95\xf9\x49\xee\x13\x66\x9f\xb0\xd2\x7d\x5a\x4a\xc7\x26\xca\x92\x3c\xa1\x57\xc1\xf7\x59\xd9\x57\x5a\x46\x07\xe7\xca\x4d\x53\x32\x09\x48\xcd\xaf\xac\x94\x5e\x96\xd3\xe9\xf0\x5c\x25\xfe\x85\x8b\x52\xf9\x94\x95\xbc\xa6\x25\x1a\x2b\x89\x93\x4b\x37\x42\xa9\xfc\x9a\x95\xbd\xa7\x65\x74\x70\x12\x1f\xbb\xa4\x13\xef\x59\xc9\x3b\x5a\x42\x47\x67\xe5\xa6\x6e\x94\x25\x28\x25\x3e\x15\x29\x7b\x45\xcb\xe8\xf0\xac\x92\x15\x4a\xe5\x57\x0c\xfe\x86\xc2\xe9\xc0\xa4\x6e\xb4\xcc\x7c\x37\xcb\x09\x27\xde\xb0\xe2\xa7\xb4\x98\x8e\x4b\x76\xe1\xae\x30\xcd\xfd\x44\x67\xdb\x53\x86\x70\x40\x11\xe8\xd8\x64\xc9\xd2\x4d\xa3\xef\x38\x40\xa9\x7c\xc0\x4a\x5f\xd2\x52\x3a\x36\xd9\xfa\xea\x0a\xa7\x61\xba\x8e\x08\x3f\x5f\xb2\xf2\x17\xb4\x9c\x8e\x4f\x9e\x5c\x25\x69\x9a\xdc\xa0\x54\x7e\xc1\x0a\x8f\xe8\x6e\x2f\x1d\x9e\x7c\xed\x91\x51\x3d\x62\x05\x1f\x68\x01\x1d\x9d\xfc\x26\x5a\x16\x73\xfc\xc3\xbf\x79\xfe\x0d\xd6\x13\xf6\x9e\xa5\xdb\x9f\x48\x4d\x31\x23\x41\x77\x9d\x5f\x24\xe9\x44\xfa\xea\xc6\xbd\x7c\xd8\xcb\x92\x3c\xef\xc9\x17\x79\xbe\x9a\x3c\x7a\x74\x1e\xe5\x17\x6b\x6f\xe8\x27\x57\x8f\xa2\x60\x89\x3d\x9c\x9e\x03\x09\xb2\x24\xe4\x13\xe9\x1f\xaa\xa3\x1a\xaa\x5a\x00\xb4\x89\xf4\x0f\xc3\x35\x46\x86\x5e\x00\xf4\x89\xf4\x8f\x91\x3b\x32\x47\x46\x01\x30\x26\xd2\x3f\x4c\xdf\xb4\x4d\xb3\x00\x8c\x26\xd2\x3f\x6c\x75\x1c\x8c\xfd\x02\x60\x4e\xa4\x7f\xb8\xa6\xab\xbb\x25\x0d\x6b\x22\xfd\x23\xb0\x02\x33\x18\x15\x80\xf1\x44\xfa\x47\x38\x26\x9f\x02\x60\x13\x0c\x4f\x0f\xf4\xb2\x1d\xce\x44\xfa\x07\xb6\x3d\x2f\x28\x01\x4f\x49\x95\x00\x07\x6a\x49\xf4\x19\x69\xba\xe6\xea\x66\x09\xd8\x9f\x48\xff\xf0\x4c\x3c\x0a\xcb\xa7\x1c\x30\x0c\x15\x97\x80\x43\xd2\x30\xcd\x72\x9d\x12\x70\x34\x91\xfe\xe1\x07\xae\x67\x1a\x12\xaf\x47\xaa\x11\xff\xfb\x9c\x62\xc2\x5e\xe0\x4f\x1c\x5d\xf6\xb2\x88\xb0\xa1\x60\x51\x26\xf2\x88\xa1\x64\x02\x8b\x0c\xed\xa9\x66\x71\x2c\x52\x9f\x19\x23\xfd\x80\x63\x91\x66\x8f\x0e\x47\x26\xc7\x22\xfd\x99\x65\x9b\x87\x1c\x8b\xcc\x23\x67\xdf\xd1\x39\x16\xd9\xda\x33\xf3\xe9\x3e\xc7\xa2\xa7\xe3\xfd\xc3\x7d\x9b\x63\xd1\x81\x7e\x38\x3e\x1c\x71\x2c\x32\x0e\x1d\xcb\xb6\x39\x16\x19\x87\x63\xc7\xb1\x38\x16\x19\x87\xa3\xfd\x0a\x40\x58\x64\xdb\xa4\x12\xc7\x22\xc7\x22\x38\x1c\x8b\x08\x49\xe3\x90\x63\x11\xa1\x50\x01\x8e\x8a\xc7\x9a\xcf\x7e\x19\x8b\x88\xd6\xfd\xbb\x1c\xd2\xf6\x75\x55\x37\xf8\x45\xe4\x18\x47\x15\x43\x08\x87\x4c\xcb\x3c\xb4\x78\x0e\x8d\x47\xe3\x7d\x7b\xc4\x71\xe8\xe9\xc1\x33\xe3\xd9\x53\x8e\x43\xfb\xe3\xfd\xfd\x03\x8d\xe3\xd0\xc1\xd1\xa1\x7e\x68\x72\x1c\x3a\x32\x8e\x46\x47\x26\xc7\xa1\xfd\xf1\xd3\x43\xc7\xe4\x38\xb4\x3f\xde\x1f\x57\x00\xc2\xa1\xa7\x87\x1c\x80\x70\xc8\x31\x49\x25\x9e\x43\x26\xc1\xe1\x38\x44\x48\x56\x80\x43\x4a\x94\xe0\x70\x1c\x22\x00\xc7\xfc\x65\x1c\x62\x86\x47\x2f\x58\xc7\xb8\x66\x94\x97\xba\x57\xbd\x00\xf7\x2e\x5c\x37\xae\x64\x5d\x81\x4a\xca\x86\x05\xc7\xa2\xe4\x51\x76\x17\xe7\xee\xad\x72\x11\x9d\x5f\x50\x31\x1c\xc5\xe7\x25\xa6\xc2\x1e\x92\x3d\x22\xc4\x79\x8e\xea\xaa\xae\x6a\x01\xc7\x51\xdd\xd1\x6d\x7d\xc4\x71\xd4\xc2\x96\x67\x62\x9e\xa3\xc1\xd8\xb5\x6c\x8e\xa3\x8e\xe3\x98\xb6\xca\x8b\x45\xcb\xd5\x6d\x9f\xe3\x28\xb6\xf1\xc8\x0f\x79\xb1\x88\x43\x0f\xfb\xbc\x58\x1c\x1b\x63\x63\xcc\x71\xd4\xb3\x4c\x4b\xd3\x38\x8e\xfa\xa1\xa7\x6a\x63\x8e\xa3\x96\xea\xfa\x86\xc3\x71\x54\x0b\xdd\xc0\x36\x38\x8e\x5a\x96\x3d\xc2\x1a\xc7\x51\xcf\x36\x47\x01\x2f\x16\x83\x91\x61\x9a\xfa\xaf\xe6\x68\x48\x4d\xd6\x5f\xc6\x53\x46\x5e\x58\xa7\x9e\xe6\x68\x36\xcf\x55\x5f\x1f\xe9\x1a\xcf\x55\xdb\xd2\x44\xae\x5a\x84\xd1\x3c\x57\x7d\x67\xe4\x68\x3c\x57\x6d\x57\x73\x42\x9e\xab\x16\xd6\xb1\xca\x73\x55\xc3\x21\xc6\x1c\x57\x43\x5d\xf7\x47\xbc\xb2\x0b\x42\xd3\xd0\x79\x65\x17\x98\x8e\xa6\xb9\x1c\x57\x4d\xd7\x1b\x1b\x36\xc7\x55\x55\x75\x03\xc7\xe7\xb8\x3a\x52\xc7\x18\xf3\xeb\xd4\xb2\x2c\x0b\xbb\xbc\xb2\x33\x8c\x30\xfc\x75\xca\xae\xe0\xea\x05\xa6\x97\xae\x7e\x11\x53\x29\xf5\x06\x4f\x6d\xcd\x13\x57\xaa\xa1\x3b\x3c\x4f\x1d\x33\xb0\x1c\x9e\xa7\x63\xcb\x19\x8f\x79\x9e\x62\x3b\x74\x30\xcf\x53\xcf\xf1\x5c\x8f\x37\x60\x6c\xdf\x0d\x6c\xd1\x80\x31\x04\x03\xc6\x77\x47\xaa\xee\x71\x3c\x75\x2d\xd3\xd1\x79\xed\xe8\x79\xb6\x6b\xf0\xb2\xd7\x18\x3b\x6e\xb5\xb8\xe9\x4a\x35\x1d\xc3\xe1\x57\xaa\xa9\x59\x6e\x25\x0f\x08\x4f\xc7\x9e\xe9\xf8\x2a\xcf\x53\xdf\x30\x7c\xff\x57\xf3\x74\x59\x78\x86\xbf\x8c\xad\xe5\x03\x1e\x09\xac\xb5\x34\x4f\x10\xc2\x5a\xa8\x3b\x3a\xe6\xd5\xaa\x66\x05\x63\x8f\xb7\x4d\xdd\xb1\x57\x89\x58\xc2\xda\xb1\xe6\x98\xae\xcd\xb1\x76\x8c\x5d\xdd\x1b\x71\xac\xf5\x35\x3c\x0a\x2d\x8e\xb5\xd8\x0b\xed\x30\xe4\x85\xb0\xae\x07\x63\x9d\x63\xad\x63\x98\xbe\xce\xab\x55\xdb\xb5\x5d\x35\xe4\x97\xab\x65\xfb\x86\xc7\xb1\x56\x0f\xec\xd0\x0a\x39\xd6\xea\xe6\x38\x74\x03\x8e\xb5\x66\x60\x06\x9e\xc6\xb1\xd6\x1b\xeb\x41\xf0\xcb\x85\x70\xc6\x1c\xfa\x5f\xc6\xd9\x82\xbe\xc8\x58\x43\x33\x35\xde\x5e\xd2\x47\xba\x23\x68\x57\x13\x5b\x58\x90\xc3\x96\x3d\x0e\x04\xed\x6a\xab\x8e\x23\x68\x57\xdb\x77\x2d\x41\xbb\xfa\x21\xb6\x45\xed\xaa\x86\x61\xa8\x72\x8c\xc5\x96\xe6\x18\x3e\xc7\x58\x7b\x3c\xd6\xaa\x19\x47\xb5\xab\xe1\x1b\x3a\xef\x74\xe8\x8e\x5b\x4b\x17\xba\x66\x1d\xc7\xf1\xf8\x35\x6b\x04\x96\x1e\x9a\xbc\xd3\x11\xe8\x5e\x25\xfe\x8f\x8a\xc7\xfa\xbf\x4c\x0e\xb3\x28\x4c\xab\x53\xc8\x1b\x38\xb6\xae\x69\x3e\x6f\xb2\x5a\x86\x26\xae\x2d\x6c\x06\xa6\xcf\xb3\x80\xfe\xe3\xd7\x96\x33\xae\xe5\xa8\xc9\x96\x81\x63\x5b\x1c\x0b\x9c\xc0\xf1\x9c\x31\xc7\x02\xcf\x75\xb1\xc3\xab\x42\x3f\xb4\xdc\x11\xcf\x02\x3f\x1c\x07\xc6\x88\xf7\xfb\x1c\x8c\x1d\x9b\x5f\x5b\x23\x0f\xab\x01\xc7\x02\x37\xf4\x47\x81\xc7\x8b\x4d\xec\xd6\x9a\x8f\xb0\xc0\xf1\x6c\xd3\x09\x38\x16\x38\xc6\x58\xd3\xb5\x5f\xc6\x02\x1a\xed\xaa\x59\x90\x47\x57\x49\x7e\xf1\xff\xfe\x6f\xdc\x5b\x25\x51\x96\xb4\x3a\xe7\x39\x2b\x12\x1c\x3f\x5f\x0d\x54\xcc\x2f\x13\xac\x87\x86\x2a\xc8\x3f\x53\x37\x79\xdf\x7c\x6c\x8c\x47\x63\xde\x37\x77\x4c\xc7\x72\x78\x1e\x79\x63\xcf\xf6\x1c\x5e\xb5\xb9\x81\x17\xf8\xfc\x32\xf1\xc3\x20\xe4\x79\x84\x0d\xcd\xd5\x78\x1e\x61\xcb\x34\x55\x7e\x99\x04\xbe\xab\x5a\x2a\xaf\xda\x34\xd7\x30\x47\x1c\x8f\x6c\xd5\xd3\x02\x61\x99\x68\xb6\xee\xf1\xf2\x6f\x6c\x5a\x9e\x28\xff\x34\xd3\xd1\xed\x5f\xc7\x23\xb6\x3d\x54\xf2\xc8\xbf\x48\xa3\xac\x77\x89\xaf\x56\x59\x52\xcb\x3d\x0a\x2d\x80\x84\x4d\x02\x7b\xe8\x3f\x7e\x09\xa9\xe4\xc3\xb3\x47\x25\x1f\x8e\x3d\x9e\x4a\x3e\x1c\x7b\x02\x95\x7c\x38\xf6\x60\x95\x7c\x38\xf6\x84\x26\xf9\xf0\xec\xa1\xff\x78\x6b\xd2\x53\x35\x21\x74\x12\xfa\x56\xa0\x0b\x4b\x28\x70\x0d\x43\xe3\xd8\xe3\x6a\xbe\x65\xf2\x52\x6c\x6c\xf9\x63\x8f\xf7\xfa\xac\xd0\x33\x02\x9d\x63\x4f\x60\xd8\x9a\x6f\xf0\xec\xc1\xd6\xc8\xf8\x65\x96\x07\x0d\xfd\xfe\x1d\xee\x68\x26\xf9\xf0\x8b\x47\x25\x1f\x8e\x3b\x02\xbb\x8c\x26\xbb\x46\x4d\x76\x99\x4d\x76\x59\x4d\x76\x8d\x9b\xec\x62\xdc\x71\xc2\x6a\x5a\xd3\xc5\x13\xb8\x8e\x3d\xe6\x17\x4f\xe0\xe9\x16\x6f\x3c\xb8\xbe\xaf\x5b\x82\x5d\xa8\xfb\x61\x65\xf5\x31\xee\xf8\x3a\x0e\x39\xee\x60\xcd\x35\x04\x1d\x13\x60\x37\xb4\xc3\x5f\xc6\x9d\x2a\xfc\xce\xdb\x0d\x38\xcf\x93\xb0\x92\xff\x54\xcb\x18\xba\x5f\x4d\x3c\x6a\xc1\xf9\x86\x65\x8e\x39\x26\xe8\xae\x31\x32\x5c\x8e\x09\x46\x38\x72\x46\x7c\x60\xc4\x1e\xd9\x8e\xcd\x47\x17\x1d\xec\x8e\x5d\x5e\xcb\xb8\x63\x3f\x74\x0d\x5e\xcb\x98\xc4\xda\xe2\x98\xa0\xbb\x66\xed\xb4\x11\x26\x8c\x0c\x5b\x17\x24\x98\xab\x1a\x9e\x86\x79\x45\x6f\x8c\x6b\x5d\x46\xa3\x8b\x2a\x27\x7c\xa9\xc3\x65\x8f\x82\xb1\xc3\x31\xc1\x37\x1d\x5b\x17\x8c\x73\xc7\x36\x46\xa3\x5f\xc7\x84\x65\x92\x72\xb1\xab\xab\xf4\x2a\xcd\xea\xb5\xb1\x4c\xb3\xa1\xef\x0b\x4b\x82\xfe\xe3\x05\x16\xfd\xc7\x0b\x2c\xfa\x4f\x70\x95\xc8\x3f\x5e\x9f\xd0\x7f\xbc\x3e\xa1\xff\x78\x7d\x42\xff\x3d\x28\xb0\xc2\x91\x66\x58\xbc\xc0\x0a\x6d\xb3\xf2\xe1\xa8\xc0\x0a\x03\x5f\xe5\xf5\x89\x8e\xfd\xda\x63\xa6\x02\x2b\x0c\xbc\x90\xb7\xa7\x55\x75\x3c\x0a\x78\x6e\x78\x9a\x1a\xf8\x0e\xc7\x0d\xdb\xd4\x46\x23\xef\x57\x71\xa3\x00\xfc\x2d\x91\x65\x93\x0f\x2f\xb2\x6c\xf2\xe1\x45\x96\x4d\x3e\xbc\xc8\xb2\xc9\x87\x17\x59\x36\xf9\xf0\x22\xcb\x26\x1f\x5e\x64\xd9\xe4\xc3\xf3\xc7\x26\x1f\x8e\x3f\xae\x37\xb2\x46\xbc\xbf\x13\xf8\x8e\x65\xf2\xae\x6c\x38\xf6\x5d\xdb\x16\x14\x8a\x67\x5a\x3e\xaf\xef\x2d\x5f\xab\xcc\x0c\xc2\x9f\xb1\xef\x86\x3e\xaf\x50\x3c\xd7\xf6\xdc\x90\xe3\x8f\xab\x59\xce\xc8\xfa\x55\xfc\x29\xf7\xf6\xfe\x0e\x83\xf4\x80\x7c\xf8\x05\x44\xfc\x75\x47\x34\xc8\x34\x53\xe3\x17\xd0\x68\x6c\x54\xc1\x87\x11\x15\x34\x4e\x58\xf9\xf8\x94\x41\x46\xa0\xfa\x0d\x06\x59\x81\xe0\xb7\xe8\xa1\x2a\x44\x05\x43\x7d\x3c\x1e\xbb\xfc\x02\x72\x1c\xcd\x1c\x0b\x0b\xc8\xf7\x2d\x3e\x12\xef\x38\xbe\xef\xf0\x1a\xdf\xb2\x7c\xdf\xe7\xe3\x47\x96\x45\x70\x78\x71\xe6\x73\x00\xaa\x53\xf4\xf1\x2f\xdc\x2c\x61\xfb\xab\x7f\x3b\x14\x6f\x69\x86\x7a\xc4\xaf\xa1\x7d\xdd\x12\xd4\xfe\xc8\x30\x9e\x55\x1b\x5c\x74\x0d\x3d\x35\xd5\x11\x2f\xe3\xec\xa7\xb6\x5a\x19\xd1\x84\x45\x4f\x0d\xe7\xa9\xc3\xab\xfd\x67\x87\xcf\xac\x2a\x04\x4e\x37\x4b\x9e\x1d\x58\x55\xb0\x9e\xb0\xc8\xd6\xad\x03\x93\x0f\xdc\x92\xa5\x2c\xb0\xc8\x3a\xe0\x00\xd4\xaf\x19\x93\x4a\x1c\x8b\xcc\x31\xc1\xe1\x59\x74\x40\x70\x38\x16\x11\x0a\x55\x95\xa3\x02\x60\x8e\x7f\x15\x8b\xc2\xa5\xfb\xb7\x04\x9c\xbe\x6f\x1c\x9a\x82\xc5\x3c\x1a\x39\xd5\x3e\x15\x61\xce\xf8\xc8\xde\xb7\x0f\x38\xe6\x38\xe6\x53\xf3\x29\xef\x74\x3e\x3b\xd8\x37\xf6\xc7\xff\x96\xc5\x7c\xb8\x7f\xa4\x1e\xf1\xcc\x39\x1c\x8f\xf6\x8d\x7d\x8e\x39\x87\xd6\xf8\xb0\x72\xf3\x09\x73\x8e\xb4\xfd\x51\x35\x8d\xa8\x02\x3a\xdc\xdf\x1f\x6b\xbc\x4d\xf6\xf4\xd9\xbe\xc3\xef\x64\x19\x23\xc7\x3e\x78\xc6\x31\xc7\x79\x66\x3a\xcf\xac\xff\x98\xc5\xcc\xce\x1a\xd4\xec\xc9\x70\x7e\xd1\xbb\xa1\x5e\x4e\xc5\x1c\x02\x73\x19\x6c\x47\xf9\x04\x5a\xa8\x6b\xa2\xf2\x71\x85\x80\x80\x31\x36\xbc\x11\x2f\xdb\x1c\xcb\xb1\x1d\x9e\x37\xde\xc8\x1b\x57\xb1\x35\x93\x5a\x44\xbe\xed\x5b\x0f\xda\xcb\x4d\xe3\x60\x7f\xdf\x18\xe9\xcf\x38\xde\x1c\x39\xd6\xd3\x4a\xe7\x51\xde\x3c\x7b\xea\x08\x31\x19\xcd\xb1\xed\x11\xef\x6c\x1a\xce\x58\x3b\x3c\xe0\x79\xc3\x03\x0e\xe9\x8a\xb6\x9e\x56\xb3\xe8\xa8\xc6\xf8\x55\xbc\x29\x0f\x7b\xd4\xec\x71\x97\xf8\xd6\x8d\x83\x14\xf7\xce\xdd\xeb\x28\x59\x46\xad\x12\x8e\x60\xdd\xea\x62\xf0\x4c\x25\x1f\x9e\x51\x26\xf9\xf0\x12\xce\x22\x1f\x5e\xc2\x51\x14\x5e\x09\x79\x6e\x1d\xdf\xa6\x56\x9c\x43\x3e\x3c\xa3\x0c\xf2\xe1\x19\xd5\xdc\xb1\x1f\xfb\xe4\xc3\x31\x4a\x30\x0d\x99\x4d\x4d\x3e\x1c\xa3\xa8\xe5\x81\x05\x2b\x81\x7c\x78\x09\x47\x21\x1c\xa3\xc6\x23\xf2\xe1\x18\x65\x62\xf2\xf9\x75\x8c\xc2\x38\xee\xb1\x83\x37\x7f\x2f\x36\xa0\x69\x42\x6c\x40\x55\x8d\xfa\x9c\x85\x4e\x01\xa6\xa9\xf2\xde\xa7\xaa\x8e\xc7\x2a\xef\x7d\xaa\xaa\xe3\xa8\xbc\xf7\xa9\xaa\x9e\xa7\xf2\x92\x4e\x55\x83\x40\xe5\x57\x93\xaa\x86\xa1\xca\x47\x38\x05\xa2\x4e\x93\xe8\xd3\x26\xc6\xb3\xe6\x53\xf6\x9b\x2d\x3d\x68\xd2\x38\x6c\x56\x39\xaa\xab\xfc\x22\x26\x95\x87\x9f\x34\xeb\x6f\x1f\xad\xf0\x34\x5f\xe7\xa3\xa0\xba\x6e\x78\x26\x1f\x88\x1e\xa9\xa6\x3f\xe6\x37\x8f\x2c\x7d\x8c\x1d\xc1\xa0\x73\x3d\xdf\xe7\x37\x8f\x7c\x2f\xb0\x30\x7f\xfa\x05\x9b\xd8\x0b\x35\x71\x2d\x39\xa1\xc7\xb1\xc9\x0b\x6d\xcf\xe4\x3d\x22\x2f\xf4\x42\xc1\xe2\xb6\x3d\x0e\xc0\x76\x18\x48\x25\xde\x5a\xb0\x08\x0e\xc7\x26\x42\xd2\xe3\x83\x04\x84\x42\x55\xe5\xa8\x00\x98\xbf\xcc\xe2\xae\x8e\xa1\xfd\x28\x16\x6d\xe8\xba\x23\x44\x09\x46\x86\xe1\x8d\xf8\x33\x48\xa6\x6f\x8e\x4c\x7e\x9f\x67\xec\x8c\x8d\x31\xcf\x05\xc7\x76\x46\x8e\x2d\x4a\x34\xd3\xe3\xcd\xea\xc0\x0c\x8c\xe0\xc1\x40\x5a\x10\x8c\x2c\x21\x16\x1d\x06\xb6\xa7\xf1\x12\x2d\x0c\x7c\xbf\x8a\x9b\x51\x89\x16\xfa\x9a\xc1\x4b\x34\x6d\xe4\x78\xc2\x16\x9e\xa6\x3b\xaa\xc0\x05\xdf\x36\xf1\x98\x37\xab\x3d\xc3\x30\xd5\x5f\x16\xe7\x2c\x8e\xfb\xfd\x88\x07\x3b\xc1\xcc\x91\x4a\x3e\x1c\x0f\x2c\xd5\xaa\x63\xbc\x06\x0d\xe9\x92\x0f\xc7\x03\x5f\x25\x1f\xde\xb5\xf9\x71\xb8\x6c\x37\x36\xc0\xb5\x83\xc5\x06\x1a\x02\x8b\x60\x38\x3c\x0f\x0c\x83\x13\x7a\xfb\x85\x14\x6c\xc4\x06\x2c\x2b\x14\x78\xe0\x13\x1c\x5e\xfd\x1b\x14\xf0\x8b\x78\x50\x1d\xab\xfc\xa1\xfa\xff\xa1\xf6\xd7\x55\xcd\xaa\x4e\xc1\xb1\xa0\xb3\x8e\x05\xc5\x62\x86\xa6\x57\x1d\x08\xa1\x12\xcb\xb7\xec\xea\x74\xd2\x88\x69\x5d\xcd\xe0\xb5\xbf\x33\xb2\xf1\x88\x5f\x2b\xbe\xef\x1b\x95\x83\x49\xf9\xe4\x86\xaa\xcb\x87\x35\x7d\xc3\x34\xaa\x95\x40\x25\x96\x61\x69\x23\x3e\xe8\xec\xda\xb6\x61\xf0\x7c\xd2\x6c\x67\x3c\xe2\xd7\xca\xd8\x74\xeb\x33\x0d\x34\xa2\x36\x1e\xfb\x2e\x7f\x30\xc5\xb6\x2d\xdb\x13\x82\xce\x86\x69\xdb\xbf\x4c\xfb\xd3\x03\xae\x7f\x47\xed\x13\xcf\x52\xe7\x23\x38\xa6\x31\xb2\xaa\x50\x17\x5d\x45\x23\x53\x1f\xf1\xab\x68\x8c\xc7\xaa\xe9\xf2\x46\xb4\xed\xd6\x1b\xc3\x6c\x15\x35\x8d\x68\x07\x6b\x62\x84\xcd\xc4\x18\xf3\xfa\xc4\xf7\x2c\x75\xcc\x7b\x9f\x81\x6e\x7b\x63\xfe\xd8\x50\x38\xf2\x7c\x9b\xf7\x3e\x3d\xec\x99\x26\xaf\x4f\xc6\x9e\x17\xb8\xfc\x69\x4a\xdb\xf5\x0c\x4f\xd8\xd8\xb4\x1d\xcf\xe3\x23\x6c\x9e\xe7\x98\xf6\x2f\x8b\x77\x16\x87\x8c\x6b\xfe\xdc\x44\x57\x38\xed\x5d\xb8\xdf\x71\xec\xe1\xf4\xbc\xe2\xd0\xcd\xcd\x4d\x79\x22\x79\x18\x2f\x85\xd5\x33\xd6\x6d\x5d\x58\x3d\xb6\x61\x0b\x5b\x36\x23\x67\x64\x1b\xc2\x01\x20\x73\x5c\x9f\x08\xa2\xfa\xde\x74\x42\x9b\x8f\x0e\xd0\xf8\x19\xaf\xef\x43\x33\x1c\x89\xfa\xde\x09\x6d\x71\x53\xc0\xd1\x2d\xe1\x44\x41\x18\x38\x63\x2d\x14\xf9\x13\x8e\xf9\xc3\x22\xae\x85\x75\x9d\x5f\x3d\xae\x86\x43\xcc\xf3\xc7\xb2\x02\x47\xd8\x14\x70\xb1\xad\x09\x52\xce\xf7\x2d\xcb\xf8\x65\x01\x1c\x7a\xd0\xfb\x6f\x85\x07\x3c\x43\x35\xf8\xa3\x3c\xc6\xc8\x08\x46\xfc\xea\x19\x85\xa6\x67\xf1\x9e\x8d\x65\x8e\x8d\xb1\xc0\x9d\xb1\x1b\x78\x2e\x6f\x8d\xa9\xbe\x59\x99\x67\xd4\x0e\x08\xb1\x26\xc4\x3f\x71\x18\x6a\x02\x77\xbc\xd0\xd2\x2c\x3e\xbc\x16\xa8\xf6\x78\xcc\xeb\x20\xec\xf9\x5e\x65\x49\x51\xee\x18\x1e\xb6\xf9\xf8\xa7\x63\x79\xa6\x27\xac\x9e\xd0\xd5\x2a\x51\x46\xad\xb1\x91\x8d\x5d\x7e\x4f\xda\xf5\xc6\x8e\xf5\xcb\x62\x37\xc5\x61\xfb\x1f\x9e\x0b\x08\x35\xac\xf3\x7b\xce\x23\xcd\xd0\x8d\x50\xe0\xc1\x48\x1f\xf1\xe7\x02\xc6\x63\x0b\x57\xb2\x85\xea\x97\xc0\xb6\x6c\x3e\x44\xe3\x1a\x0e\x76\xf8\x3d\x02\xcf\xf1\x2c\x8f\xb7\x03\xf0\x18\x3b\x01\x2f\xc1\x70\x68\x69\x26\x7f\x94\x35\x74\x1c\x4f\xe3\xcf\xdc\x84\xd8\x1f\x69\x7c\x0c\x7a\x64\x7b\x56\xb5\x30\xa9\x45\xec\xf9\x23\xc1\x22\x56\x2d\xcf\x12\x56\x88\xad\x99\x9e\xcb\x7b\x97\xd8\xb1\x3c\xf7\x97\xd9\x62\xab\x64\xf5\xdf\xba\xe1\xfc\x1f\xda\xd2\x6c\xda\x68\xd8\x53\x55\x5b\x08\x3f\xeb\x4e\xbd\x8f\x44\x79\x63\xfb\xae\xc6\x87\x68\x8c\xb1\x67\x8c\x1c\xc1\x46\x73\x5d\x8f\x3f\xb3\xa1\x62\xb3\x3e\x9a\x4f\xd7\x87\xa1\x61\x9b\x5f\x1f\x63\x57\x0f\x7e\x9d\x53\x59\x5f\x2c\xa9\x59\x94\xde\xb9\x71\xcf\x73\x73\x5c\x6f\xab\xd5\x68\xbb\xd2\x8b\x7c\x78\xee\x8c\x75\xc7\xe0\xe3\x32\x86\x3b\x52\x85\xdd\x35\xd3\xb5\x46\x82\xf4\x0a\x46\x7e\xe8\xf3\xbb\x6b\xd8\xc2\x5a\xc0\x1f\x6a\x22\x9a\x05\x07\xa2\x6e\x19\x87\x06\xef\xc5\xb8\xa3\x7a\x4f\x82\x9e\xa8\xf1\xc7\xb6\xc8\x9d\xd0\xb7\xac\x80\x97\x5e\xa6\xaf\x5b\x7c\x70\xd3\xd4\x9c\xd0\x14\x36\x9c\x03\xc7\xf7\x30\xcf\x1d\xcb\x33\xb0\xe0\x4b\xfa\xce\xc8\xfc\x65\x2b\x87\xbf\xd7\xc3\x9d\xab\xb9\x5b\xe2\xb4\xe7\xe1\xf8\x7b\x74\x8e\xd3\x8a\x47\xf9\x1d\x01\xfd\x70\xf5\x50\x07\x87\xf7\xf5\x35\x55\x57\x35\x9e\x3f\xc6\x88\x7c\x38\xfe\x08\xfb\xa3\x66\x33\x90\xd6\xbe\x7a\x1c\xf2\xe1\x57\x8f\xa3\x87\x7a\xc8\xf1\x07\xab\xce\x68\xc4\x07\x38\x83\x20\x08\xaa\xe3\x71\x34\x24\x83\x03\x5b\x38\xd2\xad\x1b\x38\x08\x5c\x8e\x3f\x06\xd1\x26\xbc\x76\x09\x1d\xa7\x0e\x42\x1c\xb1\x53\xb0\x23\x3d\xf8\x65\xfc\x29\xaf\x55\xd5\xcc\xc1\xf9\x85\x1b\xf7\xe8\x29\x81\x38\xb9\xe6\xd8\x43\x0b\x6a\x38\xf5\x74\xaa\xfa\x22\xc7\x74\xcf\x10\x2e\xbe\x8c\x0d\x4b\x8c\x0b\xd8\x16\x1e\x9b\xa2\x3d\xe0\xd9\x82\xaf\x63\x38\xa3\x2a\x46\x4d\x7d\x1d\xc3\xd5\x5c\xfe\x5a\x05\xc6\xd8\x16\xe3\x02\x41\x68\x61\x61\x45\xf9\x86\x2e\x70\xcc\xf7\x46\x9e\x26\x1c\xed\x35\x6d\x47\x08\xa2\xd9\x26\xe7\xb5\x52\x8e\xb9\xae\xe6\xf0\xbe\x8e\x6e\xd9\x9e\x70\xc0\xc6\xf2\xc7\x9a\x2f\x1c\xc2\x37\x0c\xcb\xfe\x65\xe7\x3f\xb9\xab\x6e\x0d\x9d\x94\x27\xab\x0b\x9c\xf6\xfc\x24\x5d\xe2\xbb\x5a\x31\x65\x0c\xc0\x1d\xff\xfc\xef\x3e\x6e\xf3\x4c\x25\x1f\x8e\x57\x07\x2a\xf9\x70\xbc\x3a\x54\xc9\x87\xe3\xd5\x11\xfd\xc7\xf1\xea\xe8\x48\x55\x6d\x3e\x92\x76\x74\x60\x8b\xf1\x83\xa7\xcf\x9e\xda\x8d\x80\xe7\x7e\x7d\x2b\xa0\xb8\x30\xe1\xba\xc2\xea\x1a\x8f\xc7\x87\x16\xc7\xab\xa7\x07\xaa\xfa\x54\xfb\x8f\x59\xd6\xe5\xb5\xc3\xbf\x75\xb8\xe0\xff\x27\xfb\x3b\x64\xa4\x2c\x9e\x41\x01\x76\x0c\x53\x70\x7d\x54\xdf\xaa\x42\xfe\xd4\x35\x35\xbd\xfa\xec\xef\x3e\xf3\x44\xb1\x70\x5a\xcd\xd6\x5c\x5d\x54\x4f\xba\x33\xf2\x78\xf5\xe4\x1a\x96\x6d\xba\xbf\x8a\x41\xcb\x24\x0e\x92\xb8\x97\xaf\xbd\x1f\x06\xda\x74\x43\x0b\x75\x5e\x0d\x69\xbe\x11\x3a\xc2\x5d\x31\xd7\x1c\x9b\xfc\x21\x8f\xb1\x31\xd6\x04\x03\xdb\xf9\xff\xd8\x7b\xd7\x35\xb7\x6d\x24\x01\xf4\x55\xd8\xdc\x1d\x0d\xe1\x86\x68\x51\x7d\x35\x15\xa6\x3f\xc7\x76\x36\xde\xd8\x8e\xd7\x76\x32\x3b\xab\xf4\xd7\xc3\x26\xa1\x16\x63\x0a\x54\x40\xa8\x2f\x69\xf1\x2d\xce\xcf\xf3\x74\xe7\x49\xce\x87\xc2\x85\xe0\x45\xea\x6e\xc7\x33\x93\xd9\xf5\x37\x13\xb7\x88\x6b\x01\x28\x14\xaa\x0a\x85\xaa\x83\x27\xb5\x9e\x04\x98\xb8\x27\x6d\x23\x8f\x23\x72\xd4\x34\xf2\xe8\x30\x71\x64\x4c\x8c\x91\x20\x30\x71\x7b\xc7\x87\x71\x43\x45\x30\x4b\xc7\xa3\xfd\xc6\x46\x39\x3e\x68\x28\x3b\x8f\x0f\x12\x72\x6e\xdb\x10\x8c\x46\x4f\xd2\xd4\xb6\x21\x78\x72\x3c\x1a\x1d\xd8\x4c\xdc\xf9\xe8\x30\x08\xfe\x6e\x4c\x9c\x7e\x69\x5b\x2f\x42\x1a\x5f\x66\xa9\x33\x8f\x59\x7d\x09\x2a\x3e\x86\x29\xb9\xec\xec\x10\x22\xfe\x67\xad\xcc\xde\x78\xef\x60\xcf\x36\x56\xdb\x3f\xdc\x3f\x6f\xd8\x73\x1e\xcc\x0e\xe2\xc3\x51\xe3\xb8\x39\x0e\x8e\xed\x1d\x12\x1f\x89\xff\x59\x2b\x93\xec\x89\xff\x6d\xdd\x21\x1d\x93\xe8\x34\x3e\x3e\x6c\xa8\xa1\xdb\x26\xd1\xc7\xb3\x27\xa9\x91\x58\x7b\x4d\xa2\x8f\x0e\x8e\x6b\xa3\xb9\x7e\x93\xe8\xf3\xa3\xd1\xde\xbd\x55\xa0\x4d\xd7\x09\xd2\x0d\x0c\xf8\xa1\x60\xc5\x8a\xa6\x10\x6c\x72\x84\xc7\x07\x07\x08\x19\x87\x1a\x5e\x70\x68\x3c\xd8\x04\x91\x71\x8a\x75\xe2\x8e\xdc\x5d\x1e\xf2\x4a\x3b\x53\xd8\xdb\xdb\x1e\x47\x67\x3f\x8a\xea\xe0\xc0\xcc\x1b\x1f\x1c\x3c\x22\xd3\xbd\x53\xdb\xbf\xee\xbf\xb9\xbb\xcc\x23\xd3\xd1\x29\x82\xbf\x81\xfa\x3b\x3e\x45\xbb\x7d\x91\x72\x9a\x83\x69\xc4\x59\x2b\x51\x36\xf3\xc0\xfb\x8d\x9f\xd1\x94\x5c\xff\x30\xb3\xe2\x58\x95\x53\x7e\xea\x11\x64\x20\x0f\xf6\x74\x40\xe5\x3d\x15\x2a\x2a\xd8\x3b\x54\xb1\x2f\x64\x80\xdc\x5b\xf7\xdf\xdc\x30\xc6\xf3\x32\x0f\xfb\x3d\xb6\x63\x1a\x65\x56\xd4\x46\x31\x56\x3d\x51\x83\x81\x0a\x38\x00\x7e\x4d\x31\xad\x30\xbb\x38\x0f\xf3\x6a\xc2\x7c\x76\x71\x1e\xe5\x98\xf9\xf3\x32\x8f\x0a\xf1\x97\x5c\x47\xb1\xb5\x90\x6c\xfb\x98\x31\x37\x1e\xba\x89\x74\x21\xf3\x6d\x5e\xc4\x5c\x46\x73\x6b\xb9\x76\x8d\x61\x65\xf7\x0e\x47\xc6\x57\x77\xd3\x99\xab\xcc\x07\xcf\x13\x4d\x37\xae\x2a\x03\x55\x66\xba\xf6\xd5\x6c\x6d\x5e\x70\x2b\x2a\x8f\xbf\x88\x97\x1e\xeb\x06\xa7\xe9\xaf\xb8\xbf\x53\xe3\xc8\x60\x70\x60\x7d\xad\xd7\xde\x86\x30\x98\x91\xfb\x6f\x2e\xa6\x51\x30\xa1\x5f\x19\x1f\x64\x54\x07\x83\x61\x11\xf1\x93\x79\xcc\x9e\x72\x8f\xa2\x09\xdf\x8d\xd8\x2e\x6b\xb8\x4c\x96\xaf\xfe\x79\x34\xd5\x2e\x78\x3c\xe2\x97\xab\xf3\x52\xa1\x3e\xde\x43\x38\x38\x44\xb8\x37\x77\x0f\x1f\x6c\xc9\x3d\xc0\x47\x90\x0b\xbe\x26\x9f\x58\xc8\xaf\xdd\xa6\x59\x2b\xe6\xf5\xb6\x70\x84\x9f\x40\x0b\x8f\xc5\x86\xf4\x79\xf1\x6d\x76\x4d\x52\x6f\x0c\xe1\x37\x00\xa3\x6a\x57\xd9\x77\x6d\x0f\xcb\x49\x1a\xff\x6a\xef\x64\x18\xec\x58\x3b\xc3\xfd\x93\x8b\x4e\x2c\x2a\x20\xb6\x66\x6c\x81\x84\x83\x11\x52\xc8\xf1\x58\xfc\x13\xf6\x64\x0a\x10\x75\xba\xc1\x42\xc0\x9b\x7f\x00\xda\x98\xa0\x7a\xd2\x0b\x6b\x24\x48\xc8\xe3\xbd\xc3\x11\xce\x22\x41\x45\x04\xd0\xb8\x8c\x04\x21\x11\x3f\x27\x92\x34\xe8\x00\xff\xd3\x38\x12\x03\x2e\x71\x8c\xe3\xd3\x09\x8f\xc6\x8f\xca\xa1\x47\xa3\xf2\x2b\xff\xe0\xa4\x7c\xe4\x05\xbb\x19\x0a\xcb\xdd\x6c\x58\x3e\xca\x10\x2e\xa2\xe9\x08\x8f\xf0\xa8\xf6\xe5\x9c\x44\xa3\x49\xf2\xd5\xde\x24\xd9\xdd\x45\x1e\x8b\xf2\xdd\xe0\xf1\xde\xa3\xa1\x97\x0c\x03\x84\xbe\x1a\x81\xab\x75\xcc\xbe\x0e\x06\x03\x36\x1c\xe2\x38\x3a\x7c\xc4\xbe\x0a\x4e\xf8\xee\xe1\x23\x8f\x0e\x39\x7a\xc4\xc2\x31\xa4\xd0\x70\xef\x11\xfb\x6a\x7c\xc2\x77\x65\xba\x37\x7e\xbc\x37\x64\xe8\xd1\x61\xc8\x71\x31\x4d\x4e\x01\x46\xed\x5d\xde\x29\xfe\x4f\xb8\xf2\x6f\x39\xa2\xe7\xb5\x23\x7a\xcb\x8d\x3e\x38\x16\x24\xd3\x62\x97\x9e\x46\xdc\x72\x2d\x48\xfa\x9d\xe6\x5f\x6c\x70\x0c\xdf\x17\xd8\xe1\x81\x3e\xea\x7a\x5a\x30\xce\xdd\x7b\x9c\xe2\x75\xbc\xe8\x75\x7d\x34\x46\x6d\x57\x8f\x95\x67\x88\xc8\x60\xb0\xd3\x3d\x8a\xba\x29\x0f\x76\x03\xe8\x11\x74\x92\x7e\x56\xdf\xf7\xfa\xbf\xc1\xc0\xc4\x2c\x73\xc5\x5f\x42\x5c\x88\xfe\x7c\xde\x49\xb5\x9a\x35\x71\xff\x45\x73\x69\x1d\xce\x04\x96\x71\x67\xc7\x9b\x0f\x06\x62\xe2\xa6\x73\xe5\x99\x1b\x9c\x30\x3f\xc8\x89\x5a\xf9\xc9\x4e\xd4\x92\x28\x5b\xaf\xcb\x6d\x4e\xd4\x56\x5d\x7f\x69\xb3\x68\xd5\x76\x5f\x96\x46\xab\xda\x7d\xd9\x32\x4a\x74\x2c\xfb\xf3\x68\xd5\x1f\x8e\x7e\x1e\x2d\x4f\x96\x7e\x37\x4e\xaf\x76\x15\xbd\xa8\xdd\x8c\x5d\x34\x23\x07\xe2\xcb\xc8\x8b\x7b\xd0\xc4\x3a\x5c\x1d\x22\x76\xa3\xf4\x66\x2d\xb3\x5b\x4e\xef\x81\xf4\xc5\xeb\xb5\x17\x47\x05\xc2\xd9\x7a\xed\x65\xd1\xf4\x14\x69\x8f\xf6\xca\x9d\x34\x78\xb4\xa7\x5f\x8f\x06\x83\xd8\x5b\xa1\x13\xfa\x75\x70\x42\xbc\x15\xa6\xc3\x40\x35\x1d\x32\x2f\xc3\x2b\x14\xe6\xa2\x81\xa9\x0e\x9d\x78\x1a\xad\x0c\x71\xc8\x2a\x71\xbc\x20\x64\x1c\x90\x46\x5c\x92\x5d\x19\x01\x0a\x62\x5a\xf4\x47\xca\x01\xd7\x9c\x0f\x70\x58\xdd\xf5\x9c\x5f\xd3\x9e\x91\x20\x3d\x27\xa2\x45\x4d\xab\x9a\xce\x70\x43\x3b\x68\x35\x10\x24\x3e\x41\x0c\xdc\x9c\x5a\x8e\xcd\x98\x15\xd1\xbb\xc2\x79\xb4\xf0\x8c\x73\xeb\xdc\x38\xb4\x1e\x06\x21\x84\x42\xe8\x81\x83\xd4\xce\x82\x31\x97\xf4\x70\x61\x88\x81\x0c\xa0\x50\x18\x87\xab\x93\xdd\x5d\x2e\xc8\x62\x31\xe5\xa7\x11\x99\xe6\xbb\x5c\x1c\x6c\xc3\x60\x52\xfb\x3d\x96\x45\xf3\xdd\x40\x16\xce\x27\x28\x93\x85\xb9\xa1\xa0\xd9\x34\x3f\x8d\x0a\x4c\xbd\x58\x3a\x68\xce\x50\x65\x9f\xdc\x97\x0f\x77\xdc\xa6\x36\x43\xd3\xbf\x9d\xc7\xb1\x5b\x4b\x88\xae\x8e\x90\xba\x33\xaa\x10\xe6\xfe\xcd\xea\x72\x2c\xd8\xe4\x0d\xc7\x3d\x1c\xf5\x38\x86\x63\x1e\x0e\xfe\x71\xed\x5b\x36\x0a\x1e\x15\xbb\xa3\x47\xf1\x6e\xe0\x07\x7b\x4f\x8e\xf7\x1e\xe5\x82\x4d\x7c\x54\xec\x0e\xfd\xbd\x27\xfb\x87\x07\x8f\xe2\xdd\xa1\x7f\x70\x3c\x3a\x7c\x94\x63\x06\x19\x63\x7f\x34\xde\x0b\x82\x47\xf1\xee\xe8\x51\x8e\xb9\xf1\xd0\xe7\xe9\xed\xe4\x8d\x30\x47\x18\x62\xf6\xf7\xe5\x51\xc8\x63\xbd\x79\x0c\xf2\xa6\xe2\x18\xe7\x82\x5b\x7a\x44\xe1\x5f\x76\x5a\x61\x2e\x04\x81\xf1\xcd\xea\xb2\x67\x63\x02\x2b\x33\x3e\x38\xc0\x54\xb2\x32\xe2\x27\x93\xac\xcc\xf8\xe0\x40\x8d\x75\xea\x8f\x9f\x3c\x79\xc4\x77\xfd\x83\xe3\xa3\x47\x74\xd7\x0f\x82\xfd\x47\x0c\x0f\xfd\x60\xff\x28\xd8\x7b\xc4\x77\x87\xfe\xf8\xf8\xf8\xf8\x50\x64\xed\xef\x1d\x3e\x62\xd8\x3f\x0c\x0e\x20\xfd\x20\xd8\x7f\xf2\xe4\x11\xdd\x1d\xfa\xc1\x68\x34\x0a\x04\x38\x0f\x70\xbe\x27\xb3\xf5\x7c\x43\x54\x95\x93\xfe\x35\x16\x45\xd5\xc2\xd2\x86\x8b\xc1\x11\x6e\x3b\x20\xbc\x62\x19\x57\xbf\x2b\x04\x2e\x9f\x23\x8a\x49\x2d\xac\xed\x43\x0c\x25\x7b\x8b\xd4\x84\x0b\xdd\x32\x89\x8e\xee\x99\x40\xcd\xf3\x38\xf9\x58\xba\x58\x7b\x49\x34\x79\x59\xf9\x3c\x2b\x97\x31\x4f\xe6\xe0\x03\xb1\x27\xff\xbb\x98\xa6\x39\x49\x7b\xf3\xde\x12\x9a\xf6\xd7\xcb\xe3\x92\xbf\x7c\xde\x93\xb1\x94\x55\xde\xc6\x37\x79\x11\x5b\xad\xca\x08\xf1\x06\x54\x08\x95\x08\x49\x0d\x08\xa3\x9d\xc0\x24\x2b\xc0\xec\x92\x0a\x9e\x3a\x49\x82\x11\x05\x95\xf6\x80\x6d\x4e\x1f\xb3\x37\x7c\x46\x2e\xb2\x92\x93\xbe\x08\x64\xee\xcb\xe7\x67\xee\xae\xdd\x54\x1d\x2d\xa8\x05\x30\x90\x0c\xcc\x05\x0a\xaf\x68\x6f\x93\xed\x0a\xe4\x74\xbd\x2e\xbc\x9d\x00\xe1\x94\xe4\x84\x93\x4e\x8b\x04\x36\xc4\x55\x9c\xf1\x6f\x8b\xbe\xa6\x1a\x33\xa3\xda\xaa\x63\xac\x47\xa3\x09\xaf\xc5\x40\x2b\x26\x28\x10\xb6\xd6\x8c\x4d\xe9\xe9\x49\x6b\x5e\x21\x7c\x24\xb4\x19\x7a\x6d\xc8\x4c\x96\x9e\x79\x7a\x59\x7c\x24\xcf\x54\xbe\x47\x21\xc8\x1a\xf7\x53\x05\xde\x5d\xb0\x0f\x06\x76\x63\x25\x8f\x19\xb7\x72\x3d\x82\x1a\x21\x0e\x61\x77\xb5\x20\x42\xed\xf1\xf0\xd3\xf5\xba\x17\x36\x5e\x47\x25\xd4\xdd\x15\x4b\xbb\x37\x09\x7a\x13\xeb\xba\x3e\x3e\x7b\x46\x21\xaa\xb5\x7a\xeb\x1d\xb8\x06\x91\x9c\x82\x0e\xb0\xb3\xe8\x6a\xb6\x9b\x1b\x05\xb5\xd1\x5e\x56\x87\x3e\xdb\x13\xd6\xaf\x1c\xb8\xdf\xac\xf5\x6c\x30\x99\x3a\xe9\x83\x2a\x22\xfd\x9b\x54\xc3\xd5\x98\x59\x7b\x16\x1b\x18\xdf\x6c\x73\xc3\xb6\xaf\x30\xa9\x3c\xfb\xd0\x8d\xef\xa0\xce\x9b\xa2\xca\xd5\xec\x4c\x27\xd6\x01\x8b\x04\x8b\x24\xf9\x01\xfa\xf5\xf8\x84\x0e\xc7\x21\x70\x13\xe3\x49\xf1\x15\x9d\x14\xbb\xbb\x88\x4d\x8b\xe1\xd8\x0e\x57\x50\x80\x52\x63\x47\x91\x8c\x58\x7c\x18\x76\x86\xa3\x18\x5a\x54\x0c\xd7\xeb\x8c\x66\xb3\x8c\xa4\x0e\xb9\x4e\xc8\x52\xca\x63\x49\xb2\x62\x8c\xa4\x13\x47\x80\xce\xe7\x32\xb2\xe5\x42\x17\x4c\xc9\xa5\x43\xe8\x65\xc6\x0a\x2a\x7a\x03\x27\xbb\xa2\xd0\x6c\x95\xe7\x0e\x11\xad\x3a\x0b\x52\x96\xf1\x05\x71\x62\x9a\x3a\x71\x9a\x66\xa2\xd9\x38\x77\xe6\x24\x5f\xce\x56\xb9\x73\x15\x33\x9a\xd1\x8b\xd2\x77\x65\xc8\x04\x15\xa9\x6c\x34\xf1\x6c\xd0\x78\xed\xed\xf7\x4f\xe5\xe3\x8b\x1e\x9f\xb6\x4a\x01\xca\xa6\xf9\xee\xee\x29\xaa\x10\x42\xe0\x85\x37\x82\x40\x9d\x2c\x8b\x29\x77\x7e\xca\x8a\x3c\x06\x2e\xb2\x92\xfc\x66\xec\xcf\x58\xbc\x20\xe5\x87\xe2\x6d\xb1\x8c\x02\x1c\xf7\xc5\x22\xff\x43\x9c\xa8\xb6\x7c\x5c\x3b\xf3\xb7\x63\xe7\xca\x48\x94\xbd\xa1\xae\xa5\x88\x52\x6a\x2d\xdb\xb6\x32\x40\xca\x06\x03\x8f\x45\xcc\x9f\x65\x39\x27\xac\xa1\xaa\x50\x83\xbd\x23\xa0\x36\x80\xe8\xd7\xe3\xac\x10\xc2\x52\xaf\x6a\xe2\x2e\x31\x54\x07\x59\xb5\x42\xa8\x3c\x28\x74\xb4\x14\xd8\xed\x00\xd2\x27\xf6\x47\x78\x5b\x4d\xf8\x9f\xc6\x27\x85\x0e\x5d\x4b\x11\xde\x19\xf5\x04\x27\x16\xe3\x92\xab\x09\xa1\xb9\x51\x78\xc7\xf0\xca\xde\x55\xce\x48\xe9\x11\x7c\x57\x55\x71\xf2\x84\x16\x44\xfd\xe0\x6c\xc6\xa2\xbb\x66\x9e\x62\x8e\x50\x85\x7a\xa2\x2f\xe4\x26\xe8\xef\x8e\x47\x1a\x01\x44\x50\xbf\xf4\xf5\x2c\xa6\xb4\xe0\x8e\xa0\xc5\x4e\xec\x24\x79\x5c\x96\x4e\x5c\x36\x64\xb1\x46\xcc\xbf\x26\xe9\x1a\x4d\xe8\x57\xbc\x47\xc5\x2b\xd5\x3e\x16\x72\x44\xf6\xc7\x7a\xbd\x13\x60\xe6\xdb\x9b\x42\x1c\x41\x2e\xec\x1d\x37\xa3\x0e\x13\xb8\xe9\xeb\x4d\x12\xed\x8c\x10\xde\x34\x59\x4c\xec\x0b\x81\x68\x8d\xb8\x83\xcd\x4d\x3b\x18\x34\x62\x83\x09\x81\x81\x42\x9a\x10\x0f\x48\x2b\x70\x9e\x09\xc9\x62\x02\x6b\x97\x84\xbf\xd5\x75\x7f\x98\xd9\xaa\x2a\x4b\x87\x4b\xfc\xb3\x33\xe8\xe1\xec\x2c\xe2\x98\x54\xc8\x6b\x06\x5f\x59\x99\x85\xe9\x0b\x23\x3b\x18\x48\x34\x8f\x78\xff\x2a\xbd\x5f\x2d\x09\x73\xc8\xf5\x92\x91\xb2\x84\x40\x2d\xab\x92\x3b\x24\xe3\x73\x30\xdb\x01\xad\x96\x53\xb0\xa6\x08\x6d\x0d\xb9\xe9\xf4\xdc\x03\xc5\x4b\x3d\x1f\xb7\x49\xed\x23\x3f\x54\x14\x8c\xd8\x34\xaa\x4d\xbf\xaa\x0a\x42\x29\x25\xad\x31\x5a\x21\xef\xbc\x59\xff\xec\x9d\xd4\xa8\x6d\xa5\xf6\xfa\x28\xaf\x27\xd4\x84\x0f\x6f\xd6\xf2\x08\x12\xd3\x8c\x1a\xa1\xdc\x0c\x00\x69\x5f\x98\x09\x49\xff\x06\x83\x4e\xfc\x0b\x99\xe1\xeb\x78\xb8\x27\x3d\x00\x19\xd5\x62\x2f\xb4\x76\x88\xae\x4e\x77\xc4\xb7\x66\x38\x8a\x22\x93\xbe\xa3\x7f\xd7\xab\x71\xa2\x61\x0b\x4d\x87\xcd\x41\x2e\x55\xd0\x0f\x73\xbc\xdb\x81\xc0\xde\x91\x19\x61\x84\x26\x1a\x71\x20\x8e\xd0\x3c\x2e\xe9\x9f\xb9\x73\x4e\x08\x75\x32\x9a\xf1\x2c\xce\xb3\x92\xa4\xce\xd0\x29\x05\x5e\x79\xa8\x51\x02\x54\x7e\x69\xad\x82\x21\xad\x48\x65\x46\xf3\xca\xd7\x6b\xad\xa7\xdb\x89\xa2\xd4\xe3\xa8\x37\x4e\x19\x3f\x11\x10\x87\xbc\x15\x88\xa8\xa5\x1e\x95\x5b\x63\x45\xe5\x06\x4f\xeb\x39\x7c\x47\x66\x39\x44\xdd\xdd\x51\xbf\xea\xb9\x54\xd7\x05\x32\x6a\x67\x27\xd7\x2f\xe7\xf1\xa2\x51\xa4\x67\x85\xde\xb2\xe2\xfa\x46\x17\x92\x21\xcc\xd5\xb0\x9f\xc7\xdc\xda\x40\x46\x1b\x28\x15\x2c\x9d\xce\x3c\x51\x1c\x4f\x4f\x6d\x96\x45\x1c\x88\x3b\xa3\x3a\x60\xa7\x06\xa5\x12\x0c\x64\x7f\x64\x48\x8a\x99\x0c\x15\x95\xcd\x3c\x5e\x07\x22\x13\xab\x88\x6c\x1c\x9a\xd0\xa8\x0b\x02\xab\x55\x60\xb8\x40\x15\x44\xa6\xa2\x11\xeb\xd7\x91\x69\x00\xce\x95\xb2\xc8\x26\xa0\x0b\x05\x0d\x81\xb0\x5a\x76\xbf\x62\x07\x3e\x27\x2c\xbb\x24\xe9\x7b\x1e\x73\xf2\x2d\x2b\x16\x82\x18\x97\x52\x58\x58\x8a\x9f\x92\x71\x2e\x45\x36\x9a\x18\x75\xbb\x4c\x24\x1c\xaa\x35\xf0\xf9\xc2\x08\x25\x26\xdb\x3e\x25\x55\xe0\xa7\x7b\x03\x62\xc7\xa2\x92\xbd\xd3\x13\x1a\x8a\x5f\x95\x7f\x9e\xd1\x54\x4e\x26\xea\x04\x98\x12\x4b\x6f\xf5\x25\x87\xc2\xa2\x7a\x30\x93\x3a\x5d\xcb\x1b\x90\x1e\x71\x25\x2a\x9c\x31\x12\x27\xfc\x25\xe5\x84\xd1\x38\x7f\x4f\xe3\x65\x39\x2f\xf8\xb7\x79\x7c\x51\x0b\x58\xfd\x65\x64\x2f\x17\x84\xeb\x84\x6f\xc8\xac\x60\xe4\xc7\x65\x2a\x66\x03\xf8\xa8\x86\xb0\x28\x81\xa0\x36\x10\xac\x6a\x04\xaa\xaa\x63\x6d\xd5\x9a\x06\xc1\x14\x88\x7d\x24\xa4\xca\x77\x02\x8c\x67\xc5\x62\x59\x50\x42\xed\x43\xa7\x66\x0b\x9c\x82\xe6\x37\xce\xb2\xc8\x6f\x66\x59\x9e\x2b\xd6\x20\xd1\x55\x4a\x77\x53\x4c\x74\xb2\x71\x6d\xfa\xc9\xc3\xa6\x71\xd7\x21\x8c\x6a\x46\x50\x88\x46\xe2\x4f\x01\x7f\x36\xec\x69\x78\x91\x2f\xa1\xfc\x4b\x96\xe7\xaf\x8b\x15\xe5\x27\x34\x72\xbb\xa9\x6e\xd8\x5b\xfd\xc7\x37\xef\x9f\x7e\xfb\xe2\xac\x5b\x7e\x30\xf0\x68\xe4\x6e\xcc\x76\x11\xbe\x1b\x9c\x77\x24\x21\xd9\x25\xb0\x30\xe5\x09\x6b\x41\x65\x67\x3e\x00\x38\xbb\x1a\xb0\xf5\xbd\x30\x36\x1a\xbf\x0f\xa8\x72\x1d\x4e\x8a\x16\x90\x32\xf9\x01\xe0\xc9\x0a\x83\x81\x57\xf4\x03\xa6\x1a\x44\x58\x31\x41\x74\xbd\x56\xbf\x98\xf9\x65\xe2\x08\x12\x50\xe7\xe4\xf1\xcd\x9b\x78\x41\xd6\x6b\x02\xf2\x1f\xce\xfb\x83\x4a\x6d\xc2\xc4\x13\x77\x23\xfd\x40\x6e\xe8\x6e\xda\x89\xc8\x9d\xc8\x9d\xa2\x76\xc9\x8f\xb4\x8c\x67\xc4\xc9\xc9\x45\x9c\xdc\x38\x79\x36\x23\xc9\x4d\x92\x93\xd2\xb9\x12\x3b\x46\xf0\xd5\xe7\x44\x9d\xa7\x20\x33\xd7\xbb\xc7\x59\x95\x19\xbd\x80\x1d\x67\x12\x9d\xa7\x6f\x5f\x96\xfe\xcf\xf4\x67\xea\xee\xc6\xbb\xae\x10\xc5\x4b\xc7\xdd\xcd\x77\x5d\xe7\x7c\xc5\x9d\x38\x2f\x0b\x27\x29\x28\x8f\x33\x5a\x4a\xf1\xbb\xc8\xf3\xe2\x4a\xb4\xd3\x81\x20\x74\x77\x3d\x3d\x9b\x27\xee\xcf\xd4\x71\xdc\x5d\x0a\x91\xf1\x74\x32\xd3\xc9\xac\x91\x5c\xe8\xe4\x02\x92\x5d\x01\xce\x87\x39\x71\xe2\xf3\xe2\x92\xd8\x43\x2c\xe7\xc5\x2a\x4f\xc5\x00\x19\x59\x14\x97\x24\xf5\x9d\x57\x24\x66\xd4\x59\x14\x0c\x8a\xaf\x38\xe8\x55\xb4\xe8\xef\xcc\x09\x23\xe1\xcf\x54\xbf\x27\x9f\x9d\xfb\x0b\xf2\x18\xc8\xe1\x30\x2e\x6f\x68\x32\x34\x13\x31\x34\xdd\x0c\xe7\x45\xf1\xb1\x74\x51\xd5\xbf\xd3\xb7\x91\x1a\xaf\x8f\x0c\x44\x0b\xbc\x65\x3b\x46\x17\x1b\x76\xc5\x26\x0a\xb5\x89\x9d\xaf\xbb\x78\x9e\xa5\xaa\x6c\x1f\x89\x15\x18\x62\x08\xec\x46\xa4\x6b\xa3\x0e\x9f\xc7\xdc\x49\x0b\xc0\x2f\xc9\x2e\x39\xdd\xfe\x3c\xe4\x14\x14\xb0\xc4\x1c\x00\xae\x0c\xe8\xd2\xd9\x78\xd1\xa5\x8a\x93\xd3\x07\xf7\xa4\x2f\x71\x43\xa8\xcb\xbb\x8e\xc1\x93\x6d\x05\x42\x3a\xc9\xac\xdb\x2b\x19\xcd\xb2\xea\x11\x71\xcf\x8c\x24\xa5\x62\x51\xaa\x83\xe2\xb6\x9a\x28\x16\xaa\x13\x14\x71\x7b\xd9\xdb\x0a\xc7\x6d\x25\x8b\x10\x71\x59\x34\x9a\xb0\xaf\xf4\x4d\xe0\x84\xed\xee\x22\x1a\xc5\x10\x58\xd2\x18\xea\x50\xf4\x75\x34\x5a\xaf\xbd\x42\xc7\x51\x44\xb5\x41\x88\x64\x45\xee\xa5\xae\x89\xef\x54\xd7\x3c\x10\xa0\xf6\x25\x77\xef\xad\xb5\xbe\xe5\xa7\x48\xd0\x66\x33\x82\xca\x8c\xc0\xcc\xf9\x75\xbf\x41\x83\x9e\xcc\x16\x77\x0c\xc6\x42\x82\xe9\x7d\xfc\x73\xe9\x4d\xe3\xe1\x6f\x4f\x87\xff\x73\xba\x8b\x1e\xa3\x69\x70\xea\xf3\xe2\x55\x71\x45\xd8\xb3\xb8\x24\x1e\xaa\xea\x58\x7c\xb5\xf5\x45\x04\x11\x7b\xa3\xac\x7c\x13\xbf\xf1\x08\x3a\x71\x69\x4c\xdd\xd0\x1b\xad\x09\xda\x89\xc8\x89\x3b\xcb\x8b\x58\x1c\xdd\x19\xe5\xe4\x82\x30\x17\x61\xde\x13\xe3\x51\x23\xce\x7a\x2d\xd8\xc6\x22\x27\x3e\xd1\x22\x11\x59\x10\x21\xf1\x28\x02\x4d\xa8\x53\x12\x71\x76\x77\xef\x5d\x9e\x6b\x1b\x8d\x60\x27\x12\x4c\xac\x4b\x0b\x4a\x84\xb0\x43\x20\x9a\xe6\x4b\xad\x96\xf0\xbe\x55\x92\xf5\x7b\x7e\x93\x67\xf4\x02\x79\x3f\xe2\x5b\x65\xf1\xf9\x4d\x5c\x92\xe0\x30\xe4\x52\x8a\xf3\x08\x6a\x59\xd6\x7c\x94\x28\xaa\x10\x94\xaf\xd7\xfc\x6b\xcb\xa2\xc4\xe3\xb5\x91\xda\xa4\x79\x03\x5e\xeb\x88\x39\x9a\xd0\xaf\x38\xe8\x5f\x58\x3b\x9e\x27\x6b\x44\x91\x54\x9b\x41\x51\x2e\x15\x4a\xb1\x1b\x35\x52\xc2\x34\x69\xa8\x1f\x37\x89\x42\x04\xa9\xa0\x89\xc7\x78\x18\x98\xc5\xfc\x41\xdb\x6f\x44\xb4\x25\xfe\x02\x0f\xd5\x48\x91\x71\xd4\xb0\xfb\x3a\x5e\x42\x85\xf5\xda\x7d\x4f\x64\xdd\x13\x69\x2a\x31\x63\xc5\x02\x6c\x5f\x8c\xed\x89\x2a\x08\xa1\xfb\x7e\xcc\xd6\x2f\x11\xe5\xde\x49\x78\xbc\x0e\x0e\xd7\x7b\x63\xe4\x9d\x84\xcf\xf2\x78\xb1\x24\x29\x92\x2d\xfc\xfb\x63\x19\xc7\x91\xa2\x13\x39\x36\x1d\xbf\xb4\x11\x85\xd1\xa8\x5c\x27\x1b\x64\x51\xb9\x1f\x25\x4f\x12\x45\x64\xda\xd2\x1a\x9c\xc2\xb4\x36\xac\x3b\xc0\x28\xc6\xa3\x10\xd8\x11\xad\xd7\x1c\x6c\x63\xba\x86\x46\xc6\x04\x8c\x0e\x06\x1e\x89\xa8\x9c\x7b\x16\x8d\x6c\x52\x86\x6e\x2b\x35\xbd\xb7\x65\x58\x60\x1a\x76\xd5\xe3\xec\xeb\xda\xa0\xf7\x36\x2d\x28\x28\x6b\x42\xf5\x4b\xc7\x8e\x23\x53\xb6\xbb\x7b\x5a\x55\x98\x84\xcd\x7b\x21\x71\x40\x91\x0a\xcf\xc2\xa2\xaa\x7a\xd5\x50\x2f\xe9\x65\x9c\x67\xa9\x13\x73\x4e\x16\x4b\xee\xf0\xc2\x91\x63\x97\xf7\x05\xf0\xfb\x3c\xaf\xf5\x8e\xfe\xcf\xf4\x25\x75\x0a\x96\x12\x26\xca\x9e\x13\x47\x17\xc1\x50\x21\x16\x53\xe4\x48\x1d\x42\x29\x75\x5a\xf3\xf8\x92\x38\xb1\xd3\x99\x5a\x0f\x39\x0b\xc2\xe7\x45\xea\xbb\xc6\xac\x48\x88\x55\x59\xb4\x13\xd4\xb3\x62\xcd\x08\xed\x59\x1f\x0f\x55\xcd\x69\x93\x52\x2e\xf5\x29\xb9\xe6\xb5\x50\x9e\x0b\x26\xb3\xa0\x04\x93\xf6\x1c\x65\xa2\xcb\x38\x82\x49\xb2\x9a\x11\xb2\xa3\x41\x0b\xea\xcb\x66\xd6\x6b\xfd\xcb\xab\xc5\xb7\x6c\xe6\x65\x8a\x15\x88\xab\xca\x42\xbf\xb7\x1b\xac\xdc\x7a\x10\xca\xda\xa5\xca\xea\xaa\x55\xc1\x42\xdd\x9d\xb6\x2a\xaa\x35\x25\x4e\xa6\xf5\xfc\x56\xc3\x8d\x4d\x27\x7b\x78\xd1\xec\x47\x63\xcb\xdd\xf8\x51\x82\x29\xd4\x3f\x16\x3d\xec\x98\xbf\xcf\xea\x18\xdb\xcf\x34\x29\x8b\xcb\x32\xbb\xa0\xcd\x69\xbb\xff\x85\x84\x7d\xfb\x60\x48\x32\x4c\x24\x45\x1d\x5a\xd9\xb4\x2b\x93\x14\x53\xc8\xf3\x62\x9b\x4f\xd9\x69\x44\xa7\xcc\x32\xbd\xac\x50\xbf\xb2\xa6\x1e\xce\x2f\x02\x56\x12\x11\x9f\xb3\x6c\xe1\xc9\x0b\x69\xb1\xe2\x53\x41\x10\x3d\x12\xfd\xe7\xfb\x1f\xde\xf8\x92\xae\x67\xb3\x1b\x0f\x3e\xc1\x02\x58\x2c\x2f\x9a\x8e\x4e\xf5\x1a\xbf\xf7\x5c\x98\x5e\x17\x37\xcb\x00\xe9\xbb\x15\xad\x91\x66\x69\xa5\xe9\xeb\x29\x4e\xcc\x69\x3f\x3c\xf9\x39\xdd\xfd\xd9\xff\x39\xdd\x7d\x0c\x81\x99\xfb\xd2\xa7\xa3\xd3\xa8\x66\xc4\x44\xcb\xf2\x3c\xc7\x0d\x43\xe5\xbe\x86\xc9\xb0\xbf\x61\x95\xbe\xb1\xe1\x37\x40\x6d\x37\x34\xda\xd3\x5e\x5f\x53\x9a\xd7\xb0\x0c\xcb\xfb\x61\xfc\x79\x77\x03\x90\x2a\x63\x4b\xd3\x35\x9c\xb5\xce\xb0\x32\xaf\x09\x48\x93\x73\x92\xd6\xc2\xd6\x4e\x0f\xeb\x16\xeb\x44\x6d\xd6\x02\x36\xc0\x92\x8f\xaa\x8b\x89\x4f\xfc\x26\x7e\xa3\x73\x57\x79\xde\xc8\x16\xdf\x20\x92\xab\x02\x9c\xad\x88\x5d\xe0\xbc\x28\x72\x70\x54\xb1\xa3\x3b\x98\xc5\x79\xb9\xa1\x48\x80\x26\x3a\xfa\xa7\x98\xb5\x48\xaa\x57\x35\x1a\xd5\x35\x40\x13\x80\x05\x5d\x79\x2e\x15\x85\x66\x77\xbc\xf7\x76\x02\x09\x4e\xbd\x1d\xde\xdb\x0a\xe9\x5b\xb1\xe3\x42\xa2\x4e\x39\x6e\x11\xd7\xd7\xb6\xa6\x1e\xde\x2d\xdc\x56\x08\xdf\x96\xfc\x26\x27\x61\xec\xc5\xde\xed\x25\x61\x3c\x4b\xe2\xfc\x69\x9e\x5d\xd0\xd0\x5d\x64\x69\x9a\x83\x77\x1c\x84\x6f\x2b\x7c\x0b\x1e\xa2\x43\x22\xc3\x61\x9e\xa8\xbf\xd6\x53\xe8\x39\x38\xc5\x0d\xdd\x80\x2c\x5c\x7c\x95\xa5\x7c\x2e\x7f\x57\xb6\x5a\xf5\x43\x43\x2d\x17\x33\x42\xf9\x19\xdc\xbf\x08\x7e\x48\xf0\x40\xe5\x32\x4e\x08\x04\x6e\xff\x75\x55\x70\x52\xfe\x40\xbf\x27\x37\x25\x18\x57\x03\xd3\x2a\x4e\x1e\xff\x97\xf2\xf2\x5d\x51\x70\x9c\xab\x4a\x38\xab\x55\x22\x40\xb8\xbf\x27\x37\xb8\x54\x99\x27\xf2\x4f\x6d\x34\xbc\x13\xaf\xd7\xc0\xcd\xe6\xe6\xca\x29\x3f\x51\x94\x20\x8a\xf8\x49\x76\xf2\xd4\x8f\x15\x33\xfb\x22\x27\x82\xfc\x78\x6e\xb9\x14\x4b\xd8\x20\x9e\xde\x6d\x85\xaf\xbc\x02\xcb\xaa\xc3\x8f\xe4\xc6\x45\xf8\xf6\x23\xb9\x09\x69\x85\xf0\xc6\x36\x6e\x41\x8b\xf8\x06\x40\xaa\x6b\x56\xb8\xdc\x52\x07\xba\x11\x13\x4e\x5d\x84\xdd\xd0\x45\x28\xdc\x58\x16\xd0\x63\x73\x76\xff\x10\x24\x65\x1b\x8a\x89\x7a\xf0\x20\x54\x5d\x39\x0a\x36\x18\x6c\xae\x24\x91\xad\x8d\x68\xbc\x58\xba\x55\x85\xff\xec\xfe\x79\x4b\x87\xa0\xf2\x2c\xd1\xef\x6c\xff\x73\x4e\x71\x8d\xd4\xef\x6c\xa4\x06\x2c\x9d\x68\x92\xe5\x67\x49\x41\x85\x44\x44\x14\xb1\xe2\x2c\x8b\xe9\x45\x5e\x53\x88\x6e\x27\x37\xa4\x6f\x91\x38\x76\xc9\xf5\x32\xa6\x29\x49\x87\xa2\x51\xb1\x4c\xd6\x22\x34\xf3\x2a\xa4\xa8\x51\xf9\xeb\x2a\x66\xdb\x3a\x5b\x7c\x9e\xce\x34\x5d\xdb\xd8\xcf\xf9\x67\xe9\xc7\xa2\x24\xaf\x3e\xef\xa4\x5f\x6e\x82\x2f\x29\xf2\x3c\x5e\x96\xfd\x00\xb6\x32\xef\x3f\xed\x17\x9f\xab\xbb\x3b\x27\x7e\xfe\x99\x7a\xaa\x2a\xea\x33\x8f\xd7\x31\x74\x47\x08\x3f\x15\x62\x83\xf7\x06\x4d\x16\xfe\xd9\x59\xb9\x5a\xc2\xd5\xfc\x73\xb2\x64\x24\x01\xeb\xa3\xbf\x48\xb5\xa7\x10\x18\x2e\xee\x2c\x71\x79\x57\x09\x15\x7d\xb7\x76\xb2\xf2\xcb\xe5\xb0\xe3\x28\x7f\x11\x27\xce\x45\x9c\xd1\x82\xd5\x8f\x71\xd9\xc5\x79\xec\x8d\xb0\xa3\xfe\x8f\xea\x57\xb9\xec\xe2\xdc\x1b\xef\x1f\x60\x47\xff\x83\xea\x07\xba\x90\xb7\x27\x92\xd5\x3f\xc8\x7e\xcd\x6e\x3b\x7e\xd8\xef\x74\xe1\xef\x21\xeb\xd9\x6e\xc3\xb1\xc4\x61\xdb\xf5\xc4\x51\xdb\x39\xc5\x71\xed\xae\x61\x8b\x97\x88\x86\x1f\x89\x5e\x2f\x11\x0d\x1f\x10\xcf\xdb\x70\xbc\x68\xfb\x91\xf8\xb6\xf6\x23\x51\xe1\x97\xcd\x69\xbe\x60\xe4\xe6\x1e\x73\x1c\x60\x47\xfe\xbf\x35\xc7\x76\x8e\x1f\x34\xe7\xb8\x31\x6d\x63\x6b\x8e\xdb\xf5\xcc\x94\xf6\x4c\xf7\xbe\x35\xdd\xed\x7a\x66\xdd\x0e\xbb\x79\x87\xa8\x5e\x84\x76\xde\x11\xaa\xd7\xa3\x9d\x77\x8c\xea\xa5\xd9\x98\xf7\x74\x4b\xde\x37\x5b\xf2\x9e\x6d\xc9\x7b\xbe\x25\xef\xc5\x96\xbc\x6f\x7b\xf3\x2a\xfc\x5d\x74\x7b\x35\xcf\x38\x91\x4f\xc7\x5d\x7c\x9e\xc7\xc9\x47\xc9\xd4\xb9\x98\xb3\x98\x96\x92\x49\xeb\x5b\xe0\x8b\xbc\x38\x8f\xf3\x6f\x0b\xca\xbf\x8d\x17\x59\x7e\x23\x9d\xea\x01\x03\xa7\x33\x9f\xad\x58\x09\x0f\xe7\xf5\x36\xcd\x68\x4a\x28\xff\x26\x2f\x92\x8f\x7f\x91\x2c\xe2\xc1\xf2\xda\xc5\xe7\x2c\x4e\x88\x68\xe9\x2f\x8a\x89\x3c\x2f\xf2\x54\x25\xeb\x36\x96\x85\x90\x12\x98\x8b\x49\x9e\x67\xcb\x32\x2b\x45\xf9\xf7\xd9\x6f\x24\x74\x83\x63\xd1\x88\x4e\x7f\x95\x51\xf2\x9d\x66\x46\x47\x76\x4e\xa7\xa9\x8f\xe4\xe6\x75\xcc\x2e\x32\x1a\xba\xa3\xe5\xb5\x03\xb0\x7c\x24\x37\xaf\x08\xe7\x84\xbd\x5f\xc6\x49\x46\x2f\x42\x77\xe4\xeb\x0c\xe8\x12\x98\x0c\xa9\x06\x15\x69\xdf\x80\xf0\xfe\x2e\x4e\xb3\x55\x19\xba\x7b\xaa\xe4\x33\xc1\x49\x34\x47\xf3\x91\xdc\xfc\xd4\xe5\x4c\x44\xf2\x0f\xa2\x27\x7e\x23\x7a\x3a\x3e\xb0\x53\xbe\x2b\x2e\x09\x0b\xdd\x40\x56\x8e\xf3\xb7\x71\x9a\x66\xf4\xe2\x43\xb1\xac\x7b\xaa\x93\xbf\x29\x38\x2f\x16\x7d\x39\xef\x24\x1c\x07\x75\x86\x84\xfa\x15\x99\x89\x49\x5a\x5e\x3b\x65\x91\x67\x69\x33\x53\x75\x3e\x6e\xe7\xaa\x36\x55\xf6\x9e\x9c\x37\x47\xfc\xdd\x17\xed\x2f\x57\xe5\x9c\xa4\xcf\x0a\xca\x09\xe5\x72\x76\x65\x37\x87\x22\x17\x2c\x54\xcf\x73\xf2\x93\x10\x50\x9a\xd0\x41\xbe\x60\xad\xac\x85\x0d\xec\xb4\xe6\x74\x8a\x54\xd5\x80\x1c\xc2\x58\x97\xed\x59\x11\x1a\xd3\x4e\xab\x32\xa9\xd5\x68\x4c\x7b\xda\x8c\x69\x4f\x93\x46\xc2\x6c\x37\xdc\xc8\x68\x36\x6f\xb2\xba\x9d\x98\xac\x9e\xae\xd2\x98\xc7\x1f\x6e\x96\xa4\xdd\x93\x4e\x97\xb3\xac\xa6\x71\x5f\xe7\x80\x85\x98\x85\x59\x2e\x96\x9c\xba\x68\xa1\xa7\x93\x3a\xd3\xc2\xf2\x8c\xc7\x79\x96\xd8\x99\xf6\x7e\x39\x5c\x5e\x3b\x23\xf9\x9f\x8b\x93\x3c\x5b\x9e\x17\x31\x4b\x3b\xbb\xac\xce\x99\x93\xe4\xa3\x8d\x12\xc3\x00\xc6\xbe\x20\x3c\x7e\x1e\xf3\xd8\xcc\xca\xa8\x6e\xd8\x91\x5b\x18\x24\xa5\xff\x60\xc5\x6a\xf9\x9a\x6c\x28\x08\x03\x17\xec\xca\x33\x79\x41\x4c\x98\x22\x31\xc1\x91\xc8\xe1\x45\x91\xf3\x6c\x69\xaa\x42\x71\x92\x66\xfc\x25\x5d\xae\xf8\xeb\x8c\xea\xd2\x7b\xa3\x46\x4e\x73\xaa\xc6\x8d\x3c\xd3\xd8\x41\xb3\xb1\xce\x7a\x98\xac\x0d\xe4\x12\xc0\x6e\xcf\x9b\x48\xb4\x96\xfc\x40\x8f\xaf\xb9\x6d\x34\x26\xc8\x2d\x65\xcf\xee\x58\x57\x68\xc0\x23\x31\x8a\x70\x78\x70\xf8\xae\xb8\xea\xd0\x94\x38\x4d\xbf\x17\x04\xec\x92\xb0\x6f\xe2\xe4\xe3\x05\x3c\xbf\x57\x07\xc0\xf8\x40\xf0\x42\xe6\x1f\x79\x2a\x5b\x15\xde\x16\x25\x98\xbd\x87\x6e\x7c\x5e\x16\xf9\x8a\x93\xde\xec\xb7\xd7\xb0\x74\x3a\xef\x75\x91\xc6\xb9\x9a\xfe\xf1\xa8\x9d\xa1\x31\x2e\x5e\xf1\xa2\x91\x51\x6f\xa2\x76\x15\x1b\xb1\x2b\xfc\x6d\x44\xbd\xfd\x03\x84\x7f\xec\x79\x56\xd4\x73\x95\x77\x6e\x06\xfd\x4c\xa9\x3c\x24\x93\x53\x1f\x23\x76\xf2\x13\x75\x4a\xd9\x69\x47\x58\x4b\x2e\x2f\x93\x82\xea\xd4\xe7\xd8\x70\xd5\x76\xf2\x0b\x7d\x5c\x58\xd5\x63\xa5\xc4\x68\x24\x3f\xb3\x76\xa1\x4e\xdb\xc7\x49\xb1\xbc\xf9\x50\x3c\xd3\x5b\x4c\x67\x7c\xdb\xce\x80\xbd\x57\x83\x22\x9b\x92\xb8\xad\x53\xc7\x86\xa0\x94\xe1\xad\x52\x5b\xd5\x50\x0a\x14\xab\xeb\x83\x5e\x51\x7f\x7e\x63\xee\x0d\xeb\x02\x4a\xa5\x57\xc3\x23\x35\xb2\xf5\xb4\xd1\xba\xf1\x63\x20\xdb\xfa\xeb\x69\x4d\x0b\x75\xd2\x01\x66\xe4\x82\x5c\x2f\xeb\x22\xf5\x22\x19\xe8\x2b\xd8\x65\x3f\xa9\x03\x26\xbc\x85\x3d\xd7\x98\xe8\x24\xa6\x09\xc9\xed\xb4\x27\x58\x5a\x6e\x34\xd3\xe2\xb4\xb5\x42\x89\x98\xbc\x66\x52\x17\x80\x00\x27\xf6\x72\x3d\xc5\xe7\x8d\xd9\x3d\xaa\x6c\x0c\x0d\x6f\xbb\x0d\x1c\xb4\x6a\xec\xb7\x1b\xcc\xe3\x73\x92\x37\x70\x22\xa8\x30\x5c\x37\x80\xa8\xf4\x6d\x9c\xe5\x2b\x46\xfa\x9a\x7e\xa2\x08\xa3\x5d\x15\xcf\x0a\xca\x5b\xad\x55\xd6\x6d\xf4\xad\x1b\x2f\x97\xc3\x44\x13\x53\x37\xbc\x9d\xd5\xc4\xeb\x3b\xbf\xcd\xfe\xe1\x44\xd2\x2f\x9d\x23\xc9\x19\x6e\xef\x26\xee\xb7\x52\xf0\xd2\xd0\x0c\x46\xf2\x98\x67\x97\xa0\x74\x54\x7b\x2d\xbc\x55\x7a\xbd\xd0\xcd\x68\x9e\x51\x32\x3c\x17\xcc\xa3\xab\xa6\x86\xfb\x8d\x4d\x09\x43\x82\xfd\xf1\x9d\xdf\x66\x13\x71\x5e\xf3\x85\x75\x6e\xcd\x2c\xd6\xf0\x37\xd9\xc5\x0a\xbb\xb0\xc3\x87\xac\xb8\x72\x37\x83\xd3\x22\xde\x95\x24\x0b\x77\x95\xff\xce\xb7\x78\x5c\x80\xfe\x2f\x1a\xc2\x16\x4f\x6c\x06\x5c\x93\x9b\xaa\xad\x3d\x09\x6f\xcd\xac\x58\xf4\xa7\xea\xc8\xfa\x75\xb9\x06\x45\xaa\xa0\x0f\xba\x11\xe6\x85\x24\xc4\xdf\xf9\x86\x6d\x36\x40\x69\x12\x86\xfb\x74\x6f\x8a\xd8\x7c\x0f\xdc\xa3\x75\x97\x58\x9b\x28\x18\x35\xf4\xed\xb2\x3e\x8d\xa0\x9f\x06\xd3\x8b\x97\xf6\xc9\xd7\xca\x87\x44\x5d\x42\xb1\xc2\xad\x22\x32\x55\xed\x32\x38\x23\x75\x81\x9a\x1d\xde\x75\x1d\x77\x97\xfb\x36\x7d\xc4\x6e\x38\x17\xa7\x97\x1b\x6a\xf0\xa0\x2e\xf5\xad\xaf\x61\xb0\xeb\x82\x24\xb3\xa9\x6d\x60\x97\x7b\x1a\xaf\x2a\x30\x3c\xc6\x96\x1a\x75\x78\x19\xe7\x43\x5a\x0c\x65\x5b\x75\xaf\xed\xe1\x54\xd8\x95\xcc\x36\x6c\x52\x42\xb9\x1b\xde\x2e\xea\xf3\xff\x3b\x7f\x03\x2b\x5e\x35\xb9\xf0\xb0\xef\x31\x47\xbd\x22\x1b\x90\xa1\xb5\x14\x1b\xf9\xfa\xfe\xed\xcd\xed\x21\x83\xd6\xf9\xce\x8d\x6e\x50\x2c\x6f\x48\x67\x30\x27\x0d\x81\x4d\x12\x01\x00\x1f\x32\x0d\x3b\xdb\x42\x4e\x39\x9b\x76\x12\x2e\x14\xbb\x0c\x59\x8a\x77\xb6\x56\xbf\x2f\x1b\xd6\x55\x0c\xa6\xd6\xe5\xdf\x39\x94\xc6\xf1\xfe\x47\x1d\x8f\x5a\x9c\x32\xfb\x8d\x58\x04\xa3\x66\x41\x14\xaa\x2b\x56\xeb\x3b\xbf\x5f\xb8\x68\x00\xdf\x23\x62\xd4\x44\xa5\x2d\x62\x18\x92\xaa\x95\x07\x15\x76\x05\x7f\x32\x14\x72\xcd\x10\x8e\x42\x75\x24\x29\x82\xdf\x96\x92\x54\xd3\x1a\x43\x7b\x84\x25\x6b\x7e\x5a\x02\x53\x85\x35\xff\x73\xd7\x5a\x1a\x96\xc9\x57\x35\x2a\xc9\x29\xdd\xbf\x9e\x28\x2e\xc7\x46\x86\xf2\xf9\x57\x6b\x1b\xf7\x30\xf7\x95\x62\xc0\xee\xdf\x0b\x94\xaf\xdf\x7c\x3e\xa4\xa6\xaa\xd2\x39\xe3\x30\xa8\x8e\x04\x96\x92\xd0\x5d\x32\x32\x14\xed\x88\x65\xd2\x35\xcc\x70\x66\x1d\x89\xb2\x32\x0c\xe2\xfd\x01\x51\x35\x2a\xcd\x4a\xde\xbf\xa6\xac\x50\x01\xcf\x79\xff\x5a\x34\xa6\x36\x43\x61\xe9\x10\x9a\x27\x75\x43\x93\xd0\xc3\xf0\x58\x28\x62\xf2\x70\x4d\xd3\x6b\x9d\x43\x7b\x4b\xb5\x14\x0f\x95\x64\x93\x1f\x30\x80\x55\x9e\x37\x46\x60\x29\x57\x5a\x43\x68\xa8\x58\x3e\x61\x0c\xb5\x32\xa6\x33\x88\x96\x46\xa6\xb2\xd8\xfb\xfb\x0f\xc5\xd4\xb1\xc7\xd3\x51\xc1\x58\x10\xb5\x55\x2d\x6d\xb0\x7a\xf5\x2d\xf7\x1c\x78\xa5\xc5\x91\xfb\xc3\x2f\x2b\x00\x2b\xb6\xbc\x19\xf2\x62\x68\x94\x22\x82\xb8\x6a\x66\xb0\xa5\x43\xd1\xc5\x3b\x4c\x5b\x43\xb2\xb3\x67\xc4\x56\x19\xb4\xe8\x5f\x4b\x11\xd0\xcf\xa9\xd5\xfd\x0d\x93\x62\x99\x91\x74\x73\xb7\x20\x50\xe2\x06\xa1\xda\xa4\xe8\x31\xa7\xa3\x98\xbe\xe5\x70\x41\x78\x3c\x14\x53\xb3\xf9\xa4\xac\xd7\xb1\x57\xf1\x53\x1f\x4f\x0f\x6a\xab\xa5\x67\xaa\xb0\xab\x46\x5a\x8b\x38\x1b\xda\x90\xf6\x0c\x72\x12\x9b\xea\xa5\x4a\x2b\x96\x6c\x36\xad\xa9\x6b\xaa\x94\x90\xf9\x53\xcc\x40\x86\xec\xbb\xa0\xc6\x77\x89\x39\x96\x68\xeb\xd7\x32\x6b\x2d\x47\xd4\x1a\xa4\x4f\xc3\x07\x90\x4e\x3f\x1b\x84\x4a\x82\xfe\x9c\xe0\xa9\xf6\x3f\x0f\x7c\x5a\x37\xf0\x39\x01\x74\x45\xa3\xc3\xfb\x62\xd4\x86\xed\x27\x36\x8e\xde\xef\x77\x89\x8e\x16\xc8\xbd\x83\x34\x5a\x8b\x8d\x23\x6a\x31\xf1\x6d\xe5\xa2\x00\x08\x54\x26\x9f\x0d\x22\xa3\x80\xf9\x1d\x20\xc9\x69\xa6\xcb\x15\xdf\x0c\xd1\x42\xab\x73\xbf\xf3\x3b\x2a\xde\xf6\x49\xd0\xab\xe9\xed\x39\x09\x1a\x23\xb1\x4e\xc1\xfe\x91\x4a\xd5\x86\xa1\x08\x6d\x95\x71\x0b\x99\xfa\x54\xc7\xb8\xa1\x6e\xe9\xd1\x20\x0b\xa6\x51\xe9\x71\x95\x72\xa2\x21\x3d\xf7\xea\x78\x2b\xec\x0a\x09\x73\x51\xa4\x71\x3e\x64\xe4\xd7\x15\x29\xc5\x34\x1a\x21\xed\x3b\xbf\x47\x5d\x8b\x39\x34\xd8\xab\xc8\xc5\xb9\xa4\xfd\xfd\x99\x4c\x8d\xaf\x3f\xf7\x5c\x4b\xe9\x1b\xb2\x5b\x4b\xd0\x28\xf7\x8d\x75\x1a\xd7\x43\x72\xc3\x5b\x4d\xaa\xdb\xaa\xe5\x9e\x15\xb5\x8a\xd8\x0b\xda\x38\xd3\x3a\x8a\xe8\xd6\xca\x75\xf3\xad\xb3\xab\xa3\xab\x6e\xe3\x5e\x47\x67\xad\x5a\x07\x6d\xbc\xd4\xf8\xf7\xca\xcf\xd6\x22\x6a\x49\x28\xe9\x19\x54\xad\x30\xb4\x07\x25\x2f\x06\x64\x82\xbe\x33\x84\xae\x66\xcd\xfb\xa5\x46\x37\xb0\xe1\x1a\x84\x4d\xac\xc2\x2c\x2f\xae\x42\x77\x9e\xa5\x29\xa1\x3d\xe5\xcd\x72\xb8\xc1\x68\xf4\xa7\xfa\x28\x86\x5b\xc3\x43\xdd\x65\xcf\x7d\x48\xdf\x60\xe4\x96\x52\x2a\x52\x75\xff\x7a\x5e\x5c\xbf\xcf\x7e\x83\x26\x65\xc6\xf0\xbc\x30\x5a\x18\xfb\xce\xa6\x01\x9b\x24\x43\x6e\xd8\x51\xf5\x6f\x3e\x67\x97\x3d\x17\x1b\x62\x57\xc8\x6b\x0c\x89\xe6\xf2\x77\xb3\xef\x91\xba\x1a\x55\x7f\xfb\x14\x85\x1d\xc0\xda\xfc\xde\x86\x15\xdd\x44\x41\xe1\xda\x7e\x56\xb0\x45\xe8\xb2\x02\xde\x92\xef\x1f\xa4\xe4\x02\x35\xbb\x2a\x57\xe7\x8b\x8c\xdb\x2a\xc3\xbe\xf3\x7b\x23\x95\xee\x99\x0e\x35\x09\x63\x79\xd9\xa6\x6f\x93\x7a\xc6\x6b\xc4\x43\xad\x68\xbd\x5b\x63\xf2\x8f\xd0\xf2\xd6\x8a\xf4\xe1\x4c\x6a\xd2\x85\xe4\x0a\x62\xb6\xcb\x64\x20\x80\x2e\xfe\x76\x31\xad\x2b\x26\xeb\x41\x74\x34\xf5\xbe\xd1\xc0\xf7\x10\xa7\x6e\x69\x5b\x00\xe9\x81\xd6\x50\x02\x9b\x40\xc1\xad\x7a\x7f\xf1\x24\x27\x31\xb3\x69\x7f\x4d\x60\xfa\xb8\x93\x87\x8c\xcb\x5c\x36\x7c\x02\x92\x56\x15\xfe\xad\xe9\x36\x4d\x9b\xfc\xf1\xfa\x91\x8f\x7a\x21\xc9\x11\xa6\x1b\x9e\x0b\xac\x3c\x8e\x89\x7e\xbf\x35\xb7\xbc\xe0\x97\x1e\xc7\x53\xb0\x70\x75\x19\xa1\xa9\x18\xc8\x65\x53\x0d\xda\xf0\xfc\xa0\xfc\x39\x44\x1e\xf1\xd9\x2f\x97\x2f\x53\x4c\x7c\x7e\xb3\x24\x67\xf2\xc1\x16\xad\x0d\x8f\x9f\x6b\x59\x0f\xac\x97\xa5\x45\xa2\xf6\xc1\x70\x6f\x8b\x62\xcb\x04\xaf\xad\xeb\xaa\xf0\x95\xc7\xba\x2a\x30\x84\x30\x47\xd2\xb7\x43\x75\x8a\x30\xaf\x3c\xd1\xd7\xdb\x15\x23\xb5\x6b\x03\xfc\xeb\x1f\x6d\x42\x27\x1b\xed\x15\xdd\x34\xbb\x74\xf1\x95\xa7\xed\xbd\x8d\xfd\x7c\x9f\xed\xee\x6f\xed\xf9\x33\x4b\x13\x42\x3d\xb0\x5e\x47\x98\xf8\x00\xd0\x89\x32\xdf\x57\x36\xfa\x68\xcb\x84\xfd\xf5\x5f\x78\xc2\x94\x23\x81\x87\xcd\x16\x54\x92\xb3\xf5\x69\xb8\xaa\x75\x97\x55\x1b\x14\x95\x5e\x2f\x03\x3c\xe2\x48\xe2\x9c\x7c\xc8\x16\x44\x39\xb3\x73\x09\x1d\xae\x4a\x17\xdf\x5e\x11\xf2\x31\x15\xe7\x40\x39\x2f\x18\x77\xf1\x0d\x89\xc5\x61\xbf\x5a\x10\x96\x25\x2e\x5e\x14\x54\x70\x13\x2a\x13\x0a\x9a\xbc\x79\xb1\x62\xa1\x3b\x1e\xa6\xd9\x45\xc6\x41\x04\x58\x71\x52\x27\x54\x08\x6d\x5b\xf1\xbf\xfc\x0b\xaf\xb8\x7c\xe4\xf3\xd0\x25\x97\xb5\xe4\x9a\xd7\xdd\xca\x35\xda\x36\x53\x3f\x45\xd4\xdb\x3f\x44\xf8\x9b\x88\x92\x2b\x8f\x7a\xfb\x47\xc8\xd7\x0e\x22\x09\x43\xf8\x7b\x48\xdf\x38\x9b\x30\xce\x89\x99\xcc\xfa\xf9\x58\xd7\x09\x64\x61\x3d\xf0\x65\x08\x67\xd1\x68\x92\x7d\xc5\x26\xd9\xee\x2e\x2a\xa6\x99\xed\xfa\x31\xd3\x8f\x7d\x3d\x12\x51\x78\x62\x66\x1c\xf0\x4d\x45\x47\xa7\x7e\x02\x5e\xc9\xbd\x02\x21\xa4\xae\x1a\xc0\xb1\x2d\xf1\x4b\x62\x59\x81\x28\x07\xce\xe8\xb6\xf6\x2c\xa5\x4b\x4f\xf9\xe9\x60\xe0\xd9\x9f\xf0\x82\xa6\xb7\xe0\x94\xb6\xcb\x4e\xa9\x2c\xde\x4a\x9b\xb2\xd3\xa8\x10\x50\x5c\xf4\x42\xa1\x50\xa0\xb7\x8f\xf5\x7a\x53\xd7\xe6\x71\x6e\xa7\xaf\x93\x22\xec\x26\x8a\xee\xe7\xe0\x5f\xf4\x29\x74\x1f\xf5\xb8\x1e\x52\x67\x1f\x8b\xa4\x4e\x53\x9b\xda\x73\xf9\x6e\x59\x5a\xd9\xbf\x7b\xf1\xfe\xc5\x07\x37\x24\x3e\x59\x64\xdc\x73\x19\x29\x09\x1f\xba\xbb\x14\x4d\xce\x19\x89\x3f\x4a\xcb\xf8\x9f\x9e\xbe\x7b\xf9\xf4\x9b\x57\x2f\xce\x7e\x7c\xfb\xfc\xe9\x87\x17\xcf\x5d\xa5\x25\xf5\x57\xe0\x55\x21\x3d\x2b\x59\x12\x11\xf5\xf5\x9e\x25\xf0\x52\x50\xae\x92\x47\xb1\x1b\x4b\x37\x13\x82\x9f\x91\x9c\xea\x50\x96\x74\x31\x3c\x61\xaa\x44\xe1\xdb\x0a\xcb\x67\x50\x75\x21\xc1\xdb\x92\x54\x90\x00\xac\xc1\x6b\x35\xb0\x19\xd0\x77\x2f\x5e\xff\xf0\xd3\x3f\x0a\x50\xe5\x47\xe4\xd3\x20\x7d\xfa\xfc\xf9\x3f\x0a\xce\x38\x4d\x1f\x08\xe5\xd3\xe7\xcf\xcf\x0c\xa4\xdf\xbf\xf8\xeb\xd9\xbb\x17\xff\xf5\xe3\x8b\xf7\x80\x30\x6d\x58\x28\xb9\x82\x7b\x70\xad\x9f\x90\x10\xcb\x7e\xe2\x34\xb5\xf3\xa0\x9f\x4a\xa0\xb0\x19\x61\x63\x1f\x69\xbf\x1c\x54\x3e\x14\x2b\xd4\x0f\xf9\xcc\x2c\x86\x67\xcf\x57\x67\x40\xf2\x70\x1e\x79\xd4\x27\xd7\x59\xc9\x33\x7a\xa1\xd2\xa8\xb9\xd8\x3e\x53\x4b\x83\x26\x85\x0f\xb1\x43\x3d\x49\xe8\x33\x78\x68\x76\x41\xb8\xc7\xb1\x2b\x2d\x5f\x5c\xec\x96\x2c\x71\x11\x16\x13\x9f\x12\xb2\x7c\x56\x2c\x6f\xbc\x12\xbf\x15\xd4\x07\xaf\xa2\x04\xcf\xa2\x1f\xbc\xa2\x76\xa5\x3c\xf3\x4b\x0f\x4d\x76\xbc\x2c\x9a\xf9\xd4\x43\x08\x5e\x5e\x4f\xd0\x2a\x5a\x4d\x33\x49\x8f\x4f\xeb\xe7\x90\x33\x5f\xfa\x0e\x53\xaf\xa9\x67\xfe\xcc\x33\xef\x03\xad\x77\x6c\xd7\xde\x0a\x9d\xac\xfc\x72\x09\x8e\x09\x18\x0e\x50\xa8\x3c\xfd\xae\xa6\xec\x34\x34\x1e\x70\xc4\x57\x14\x87\x09\x84\x78\x2a\x7b\x86\x81\x13\x84\x13\x31\xc1\x7a\x24\x7d\xf3\x8b\x8b\xe8\xda\xe3\x10\xa6\xca\xcc\x8e\x72\x83\xa0\x01\x2a\x4e\x58\xf4\xd6\xe3\x28\xac\x03\x5b\x14\xe0\xa8\x09\xd0\x8c\x23\x4d\x4a\xe5\xc3\x3c\x8f\x4d\xf3\x53\x7b\xfa\xf8\x34\x3f\xc5\x14\x21\xcc\x2a\x4c\x36\x9f\xb4\xbc\xf2\x7e\xf2\x5f\x5c\x12\xca\x5f\x2c\x32\xce\x09\x43\x68\xf2\x8d\xf1\x2b\xee\x7d\xdf\xa0\x74\xd2\x19\xda\xf7\x2a\xdc\xd2\x7f\x47\xdf\xe3\xff\xd8\xc4\x05\x28\x83\x41\x36\xe9\x30\x03\x1e\x53\x27\x8e\x72\xda\x02\x41\xca\x2e\x2e\x72\xf2\x4c\xdb\xd6\x34\x7c\x56\xd7\x1e\xde\x6e\x8d\xf1\x4d\xb8\xc3\xa4\x0b\xb3\xda\x1e\xa7\xb2\xbd\xe8\xfd\x37\x2c\x0c\x53\x07\xb4\x22\xc5\xea\xab\xc6\xe6\xda\xca\xc7\xc5\x9d\xf6\x50\x85\x2a\x0c\x2e\xe3\x74\x5c\x90\xe7\x52\x6c\xe9\xb1\x89\x5c\x7a\x0c\x35\x79\x10\xb2\x59\x80\x91\x2e\xc9\x6c\xde\xa1\x8e\xd1\xa6\xdd\x62\x3c\xc1\xc3\x00\xd5\x7e\x8d\x7f\xbe\x9d\xfe\x5c\xfe\xfc\xfe\x74\xf7\x31\x76\x7b\xb9\x96\x9e\x47\x86\x46\x67\x60\x0d\x52\x3d\xf7\x9b\xb5\xcd\xac\xab\xea\x8e\x77\x84\xee\xed\xb6\x6e\xaf\x3c\xae\x19\x2b\xa3\xa0\x40\xd8\xf5\x7d\x7f\x5b\x2d\xd9\x70\xe5\x22\x84\xc2\xfb\x4c\x47\xa5\xd7\x28\xb2\xf0\xe0\xbf\x81\x90\xd4\x92\x66\xff\xe2\xee\x8c\x44\xed\xcf\x2e\xe1\xd6\x9e\xf4\x6a\xb4\x79\x08\x13\x6a\xbc\xae\x3e\x94\x0f\xd5\x15\x1f\x26\x7e\x54\x7d\x9d\x6b\x61\xa3\x81\x3b\xec\x97\xcb\xa1\x85\x3f\x5a\x83\x89\x0b\xfa\x2c\xcf\x92\x8f\x21\x0c\xbc\xb5\x67\x2b\xc5\x13\x77\x37\x8c\xc7\xb7\x0b\x12\xff\xf5\xcf\x16\x24\xee\x5c\x32\x8b\xd9\x57\xd3\x47\x41\xbe\x76\xdf\xc4\x6f\xb6\x4a\xc5\xff\xf3\xaf\x38\xb4\x55\x9e\xc3\xd8\x7e\x7c\xf5\x6a\xeb\xe0\xfe\xf3\x9f\x3d\xb8\xdf\x21\x00\xd6\x6e\xa0\x1e\xb6\xf5\x32\xfa\x29\x02\xe0\xbf\xff\x0b\xcf\x94\xb4\xc3\x78\xf0\x44\xa9\x6a\xfd\x73\x65\x51\xf8\x6d\xd3\x46\xc8\xff\x72\xe6\xe2\x9f\x78\x9c\xf5\x9e\x5d\x3d\x67\x5c\x9d\x2d\x57\xac\x7c\x3a\xe3\x84\xbd\xd2\x91\xbc\x34\x9a\xb0\x48\xe9\xaa\x70\x11\x69\xaf\x02\x1d\x73\x44\xed\x89\xca\xec\xbe\x28\x02\x26\x78\x30\x60\x4a\x81\xf1\x35\x1f\x0c\xbc\xc2\x87\x06\x7c\x59\x3f\xaa\xd5\xe7\x7d\x30\x03\x43\x7c\x17\xaf\x55\xc7\xe2\x84\xc0\x4f\x5b\x18\x19\xda\x64\x61\x1c\xe0\x61\x50\xff\x21\xab\x76\x0a\xc5\xda\x27\xda\x43\xf7\x88\xaa\xf6\xc9\x4a\x44\x59\xdf\xa8\x11\x0b\x7c\x7b\xc7\x01\xfd\x67\xf7\xcf\x98\x49\xbf\x0e\x5b\xb6\x1d\xdf\xb8\xed\xfe\xc0\xa7\x56\xed\xbe\x06\x35\x3e\xb6\x8c\x93\x92\xe8\x8d\xbf\x2a\xc9\xab\xf8\xa6\x58\xf1\x17\xb3\x19\x49\x38\x66\xa4\x87\xbf\x57\xce\xae\xa0\xf4\x3b\x32\x43\xf5\x93\x0f\x87\x5a\x8e\xa7\xd1\x2d\xf7\x21\x24\x0a\xe5\x11\x11\xfc\x50\x85\x0b\xd2\xf2\x2a\xd9\xe7\xfb\xf8\x84\x98\x6a\x3c\x24\x1e\x47\x15\x8e\xdb\xf5\x6c\xb7\x7a\x16\x20\x06\x0e\x3b\x47\x47\xf0\x41\x35\x68\x0c\xdd\xd6\x9d\x30\x4c\xf5\xef\xc1\xa0\x20\x9e\xf9\x92\x7e\x3a\xea\xdc\x88\x63\x59\x02\xdc\x6a\x62\x88\x81\x81\x73\x12\xdd\xba\x8b\x8c\x0e\xa5\xff\x1a\x37\x74\x47\x2e\x76\x17\xf1\x75\x9d\x20\xaf\x9a\xb5\x7f\x9b\x91\x8b\x2f\xb3\x32\x3b\xcf\x72\x78\x58\xa9\xee\xbe\x71\xe7\x36\xbc\xf7\x8e\xd4\xfd\x6d\x08\x5e\x2a\xdd\xd0\x1d\x06\xf2\x91\x35\x5c\x22\xd7\x57\xc8\x6e\x85\xb3\xe6\xa2\xd9\x6e\x39\x73\xd2\x1f\x47\x83\x28\x22\x23\x23\x0d\xe8\x08\x76\x39\x99\xf2\x53\xec\x66\x8b\x65\xc1\x78\x4c\xb9\x0b\x84\xba\x24\xd2\x81\x74\x42\x1a\x7e\xf5\xf0\x8a\x44\x53\x75\x8b\x2e\xad\x02\xc0\x6c\xc2\xc5\x6e\xfd\xa6\xa1\x99\x02\x37\x8c\xcd\xa4\x0f\xc5\xb2\x4e\x50\x57\xf3\x2e\x76\xeb\x5b\x7e\xf5\xf1\x3e\xfb\x8d\xe8\x9f\x02\x70\xf5\x5b\x8a\x71\x2e\x76\x1b\x36\xf1\xe2\xdb\x5c\xe0\xba\xd8\x6d\xbc\xf1\xa8\xbf\x05\x80\xf5\xd7\xbb\x66\xe1\x0f\xc5\xd2\xc5\x2e\x8f\xcf\x55\xcf\x9c\x5c\xf3\x97\xf0\x18\x5d\x7d\xbc\x83\xdd\x2b\x7b\x13\xdf\x1f\xf4\x05\xa5\x8b\x5d\xb0\x61\x70\x4f\xf1\x8c\x44\x3b\x3b\x69\x91\x00\x55\xf0\xf5\x0f\xb5\x9b\x35\x96\x49\xb3\xf6\xb4\x17\xe3\xe1\x60\x49\xe2\x64\x4e\x5e\x93\xb8\x5c\x31\xa2\x3c\xd5\x47\xc4\x5f\xc4\xd7\xef\x8a\xab\x12\xfc\x1b\x2d\x32\x0a\xbf\xf3\x88\xf8\x05\x7d\x36\x8f\xe9\x05\xc1\x59\x64\xc5\x66\x4c\x48\x98\x83\xe2\xa9\xa0\x72\x5a\x54\xa1\xa4\x2e\x54\x8a\x42\x25\x5e\xfd\x51\xfc\xc0\x4e\xdd\xce\xc0\xe5\x46\x13\x43\x15\xbf\xe4\xa0\x5d\xec\xea\x21\xc3\x4f\x7b\x78\xee\x29\xc2\xb3\xc8\xa8\x89\x56\xea\x8c\x4e\xbb\x84\x44\xee\xfc\x65\x14\x13\x2f\x15\x74\xfd\xbc\x5b\x64\x84\xf0\xbc\x87\x02\xe1\x45\xd4\xe1\x34\x53\x43\x51\x78\xc4\x06\x83\xb9\xfe\x3c\x31\xbf\x7a\x02\xb0\x5f\x65\x34\x2d\xae\x04\x07\x24\x88\xf4\x8a\x93\x14\x30\x43\x45\x36\x90\x6b\x10\xe9\xf8\x0d\xe0\xa6\x5f\xaf\x43\xe4\xd1\x88\xe3\x15\xf1\x19\x49\x57\x89\x45\x91\x1b\xc1\x56\x20\x60\x92\xd8\xdf\xa4\xc2\x10\x67\xa1\x88\x98\x6f\x36\x9d\xe6\x4d\x04\x53\x52\x9c\x80\xdd\xb8\x37\x23\x83\x81\x6d\x26\x13\x29\x0d\x9c\x0f\xf8\x6d\x47\xfe\x56\x49\x68\xb7\x91\xd6\xde\xf5\xbd\xd9\x86\x4c\xb4\x72\xed\x6d\xd9\x9f\x25\x6a\x22\x78\x45\x85\xf0\x6d\x09\xa3\x90\x6f\x06\x8c\x1d\x1d\x18\x12\xf4\x55\x95\xa4\xa0\xbf\xd9\x0f\xc5\x12\x29\x33\x8d\x6e\xfd\x0e\xb9\xeb\x1d\x93\xa6\x6a\x82\x7c\xd6\x91\x29\xe6\xf5\xb9\xa2\x18\x83\xa6\x4b\x68\xcc\xac\x6b\x24\x0a\x3e\x5f\x03\xeb\xbe\x08\x02\xfc\x44\xc1\xe3\x11\xc2\x25\x59\xaf\x3d\xaf\x24\x91\xa1\x2d\x2d\x3e\x41\x10\xa4\x98\x91\xd8\x45\x48\x90\xf8\xa7\x9c\xb3\xec\x7c\xc5\x89\x27\x28\x9a\x3a\x51\xb0\x3b\x0c\x5c\xd1\x56\xab\x44\xcc\xb2\x78\xa8\xcf\x24\x79\x03\x8e\x70\x46\xbc\x52\xb0\x69\x0a\x0d\x4b\xed\x8c\xed\x4d\x91\x92\xc1\xc0\x80\x71\x5e\xa4\x37\x82\x23\x22\x34\x7d\x36\xcf\xf2\x54\x54\x9a\xc8\x88\x1c\xc4\xb7\x16\x05\xa8\x56\x3d\xc7\x40\xb8\xac\x15\xc4\x59\x94\x5b\xa8\xd9\x38\xd0\x7a\xce\xb3\xda\xb1\xd3\xa4\x54\x07\x9b\x40\xf6\x7c\xca\x4f\x2b\x0d\xba\x18\x27\xec\x7e\x35\xf9\xed\xd0\x72\x2a\xbe\xa2\x5f\x26\xac\xc8\x73\x49\x44\xf4\x9e\x68\x6e\x01\x6e\x43\x5a\x43\x79\x42\x77\xb9\x35\xa4\x90\x0e\xb9\x3d\xe2\xca\x2b\x89\xe0\x08\x0d\x18\xee\xb5\x3b\x91\x91\xd7\xcb\x66\xaf\xc3\x02\xaf\xa2\xe4\x11\x9d\xb4\xfa\xcd\x06\x03\x6f\x15\xad\x76\x8b\xdd\x18\xe1\xd2\xc4\x46\xf6\x56\xb8\x94\x73\x3c\x8b\x92\x47\xac\x1f\x66\x51\x77\x16\xcd\x64\xdd\x69\x59\x87\x76\x9d\xe1\x12\xe1\xe4\xb4\x12\xec\xaa\x04\x6c\xbd\x26\x3e\xe8\x5d\xe7\x45\x9e\x12\xb6\x5e\xbb\xd7\x2e\x8e\x71\x01\xba\xfa\xe9\xe8\x14\x67\x11\x9d\x06\xa7\x93\x73\x8d\xcd\x4a\xff\x6e\xbe\xa3\x1c\xf7\x71\x17\xae\x62\x8f\x70\x2e\x1f\x3e\xda\x5c\x06\x4e\xbc\x1c\xdf\xb2\xe2\x4a\x19\x62\x65\xe0\x3f\xaa\x8f\xb7\xb3\x99\x55\xe4\x2d\x10\xa6\x11\x23\xe2\xef\xe6\x42\x1d\xea\x6c\xe3\x8d\xe1\xf5\x3c\x82\x4c\x87\x8a\x12\xc7\x69\x0a\x17\x03\xaf\xb2\x92\x13\x4a\x18\x5c\x51\x02\x37\x40\x1a\xc1\x8d\x55\x71\x79\xdf\xb3\xb1\x46\x55\xe1\xe9\xa9\x05\x67\x63\xc7\x22\x6b\xcb\xe2\x67\x9e\x60\xae\x40\x9a\x81\x53\xac\x71\x56\xcc\xd6\xeb\x85\x87\x70\x26\xe0\xc5\x8c\xcc\xc2\xa5\x98\x2b\x6c\xc2\x55\x79\x6f\xc4\xfe\xb8\x8a\x59\x0a\xc7\x53\x4a\x10\x3e\xff\xa7\x0b\x34\xbd\x82\x35\xa0\x08\xa6\xd1\x19\x9c\xf5\xf0\xe5\xd6\x2c\xc0\x66\x99\xb6\x57\x54\xbc\xbc\xe8\xd1\xf7\xbe\xf6\x38\xc2\xb7\x97\x19\xb9\xfa\xa6\xb8\x0e\xdd\x91\x33\x72\xc6\xfb\xce\x78\xdf\xc5\xb3\x2c\xcf\x43\x57\x2d\x3d\x18\x9e\xb9\x78\xc9\x48\x49\xd8\x25\x79\x5a\x2e\x49\xc2\xdf\xc5\x3c\x2b\x42\xf7\xfa\x75\x96\xfe\xf5\x75\x96\x3a\x0b\x42\xb8\xdb\xef\xe1\x70\x19\x0b\xfe\xf5\x36\x0d\xdd\xd7\xc1\x18\x8f\x47\xcf\x8e\xfc\x83\x27\x78\x3c\x72\xf6\x71\x70\xe8\xef\x07\xe2\xef\xf8\xd9\x3e\x16\xc9\x0e\xe4\xed\x3b\xc1\x18\xef\x3f\x83\x5c\xbc\xef\x8c\x47\x32\x6f\x3c\x12\x05\xc5\xbf\x50\x4d\x66\x8f\x47\x0e\xb4\x0a\x6d\x3f\x0d\x46\x38\x10\xe3\x18\xe1\x91\x33\xc6\x41\x33\x41\x94\x68\x15\xe9\x2d\xf3\xfa\x08\x07\x7b\xdf\x05\x47\x3f\x05\xc1\x77\x47\x77\xd9\xb9\xcc\xbf\x60\xcf\x17\xec\x69\x60\x4f\xb0\x87\x8f\xbe\x0b\x02\xc0\x9e\x9f\x04\x22\x05\x3f\x05\x47\xdf\x05\x7b\x3f\x19\xa4\x0a\xf6\x7e\x3a\xfa\x9f\xbb\x10\x6b\xf1\xaf\x84\x58\x98\x45\x02\x1f\x64\xee\xef\xc0\xb2\x5b\xc0\x1d\xa6\x6c\xd3\xa5\xad\x3b\xf3\xe5\xd1\xa8\x35\x06\x8a\x99\x56\xd7\x95\x0c\x37\x50\x30\x38\x7a\x32\x86\x7f\xdc\xde\x4b\x4b\x1b\x9b\xf6\xf6\xf7\x9d\xe3\xd1\xe8\xf2\x70\xff\xd7\x91\x13\xec\x0f\x9f\x38\xe3\x3d\x3e\x1c\xef\x39\x4f\xe6\xc3\xe3\xbd\xf1\xaf\xc3\x60\xdf\x19\x0d\xc7\x7b\xc3\x27\x7c\xf8\x64\x38\xde\xbb\x1c\x8a\x92\x22\x55\x7c\x71\x91\x31\x17\xe5\x44\x31\x47\x54\xe3\xa2\x85\xdf\x16\xc1\xf8\xd8\xd9\xdf\x3f\xbe\x84\x46\x46\xc3\xc3\xc3\xe1\xfe\xd1\x30\x08\xf6\xb8\xf8\x67\xb8\x7f\xa4\x5a\x3f\x3c\x74\x46\x22\xc5\xd9\x3f\xe2\xc3\xfd\x23\x27\x08\xf6\x2e\xa1\x86\x73\x78\xe8\xc8\x6f\x2e\xb3\xa1\x17\x51\xdc\x91\x0d\x70\xd9\x1e\xf4\x24\xda\x52\xd5\x82\xe0\xc9\xf0\x78\xdf\x3f\x70\xc6\xa3\x3d\xff\x80\x0f\xe1\x8f\x23\x52\xf4\x78\x82\x27\x62\x40\x22\x19\x0a\x72\xf8\x57\x26\x68\x68\x45\x99\x3a\x95\xd7\x85\xe5\x50\x45\x0b\x4e\xdd\x30\xaf\xfb\xfb\xed\x2e\x7c\xbe\xf8\x82\xcf\xff\x3c\x7c\xde\x3b\x18\x5f\xee\x1d\x8c\xbb\x19\x87\xfb\x3d\x78\xbe\x77\x30\x86\x2a\xf7\xda\x02\xa2\x69\x28\xdc\x4a\x3f\xdc\x6f\xed\x0c\x01\x80\x28\xfd\x65\xc3\xdc\x6f\xc3\x5c\xfe\x2b\x6d\x98\xdf\xb3\x47\x2c\x9f\xe9\x92\xad\x90\x7d\x48\x43\x39\xdb\x43\x8c\x7c\x0f\xd2\xf7\xd2\x13\xb5\x76\xcd\x81\x13\x1c\xf4\x32\x22\x77\xed\x22\xb1\x41\xf2\xc3\x21\xfc\xef\xce\x15\xba\xf9\xb2\x42\xff\x8c\x15\x3a\xc8\x0f\x9d\x43\xe7\x3e\x0b\x74\xf6\x7f\x64\x81\xee\xc3\x9c\xef\x8b\xff\x7f\x66\xe6\xfc\x42\xdd\xa1\x6e\x5d\xb1\xc5\xde\xc8\xd9\x3b\x98\x0f\xc7\x07\x97\xc3\xf1\xd8\x3f\x98\x8f\x0f\x2e\x8f\xc4\x1f\x41\x44\x83\xb1\x7f\x90\x8c\x86\x81\xbf\x3f\x0c\xfc\x60\x38\x16\x84\x54\xfe\x37\x1f\x1e\x41\xd6\xd8\x3f\x1e\x8e\xfd\xf1\xf0\x40\xfc\xaf\x1c\x1e\x38\xf0\xe1\xa8\x7c\x51\x53\xd0\x68\xff\xc0\x51\xf5\x1d\xd1\xee\x18\xea\x3a\x22\x33\xf0\x03\x47\x25\x3b\xb2\xf7\x04\xea\x88\x8f\xa1\xca\x1c\x02\x2c\x02\x46\xff\xe0\xf2\xe0\x37\x67\x31\x1c\x8f\x86\x63\x05\x64\xd9\x2a\x68\x77\xa4\xff\x36\x7a\x09\xfc\xbd\x66\x8f\xb2\x0d\xbb\xcc\x7c\x38\x1e\xc1\xb0\x3b\xad\x41\xe7\x70\x46\xcc\xc5\x7c\x89\xa2\x07\x97\x32\x3d\x38\xf2\x0f\x86\x02\xce\x7c\x18\x8c\x84\xac\xe3\xc8\x3f\x02\xf2\x40\x8f\x20\x50\x6d\x04\x32\x7f\x0e\xf9\x7a\x3a\x75\x43\x30\x22\x31\x3c\x28\x2e\x52\x55\x03\xaa\xb2\xa8\xab\x3b\x56\x60\xc8\xbd\xb6\x6d\xb3\x5d\x7f\xd9\x6c\x7f\x80\xcd\x36\x3e\xf6\x0f\x9d\xf1\xc1\xaf\xa3\xe1\xc8\x3f\x18\x8e\xc4\xbe\xca\x87\xfb\xc3\x7d\x67\x7f\xb8\xff\xab\xf8\x1c\xf9\x07\x0e\x24\x3b\xa2\xc8\xa1\x2c\xe2\x07\xf9\x70\x3c\x1c\xff\x3a\x94\x25\xf6\x87\x81\xfa\x05\x3c\x97\x28\x9f\x0f\xf7\xfd\xc0\xd9\xf7\x03\xd1\x98\x1f\x6c\x2d\x39\x76\xa0\x25\xd1\xcf\x01\xfc\x15\x98\x3f\x72\x54\x8a\x13\xe4\x00\x8d\xb3\xdf\x2a\x04\x45\x8e\x1c\x55\x21\x17\xad\x8c\xc4\x4e\x15\x9b\xd8\x91\xbf\x80\xa7\x13\x1d\xe6\xfb\x1a\x1e\xf1\xdf\x96\x72\x62\x54\x0a\x54\x39\xec\xdf\x9c\xc5\xb1\x7f\x34\x3c\xf8\x75\xe4\xec\xfb\x47\xc3\xb1\xbf\xe7\x1c\xfb\x87\x7c\x78\xe8\xef\x39\x87\xfe\x78\x08\xf3\xe7\xef\x89\xbf\x22\x73\x28\xd2\xc4\x7f\x2a\x4d\xe7\x39\x2a\xdd\x51\xe5\x1c\x95\xe7\xa8\x76\x1c\x95\x76\xf7\xbe\xb9\xfa\xb2\x6f\xfe\x00\xfb\x66\x6f\x24\xa8\x73\xe0\xef\x0b\x6a\x77\xfc\xab\xb5\x37\x38\xa0\xb8\x20\x87\xfe\xd1\xa5\xf8\xa7\x9b\xb9\x2f\x4e\x8e\x27\xbf\xda\xbb\x80\x03\xc6\x05\x97\x07\xfe\x11\xd4\xb4\x33\x0f\xb8\x44\xf8\x4b\xe8\x49\xa2\xab\xf8\xe6\x90\x39\x17\xfd\x40\x37\x6a\xc7\xec\xab\x9c\xfd\xb9\xe8\xc4\x42\x6e\x0e\x20\x00\x4c\x73\xa8\x60\xb2\x0e\xb8\xc6\xf5\x23\x7f\x2c\xce\xc8\x3f\x0c\xb6\x7f\xfc\x82\xed\x7f\x04\x6c\x0f\xc4\xfa\x05\xfe\xe1\x7c\x18\x8c\x2e\x83\xd1\x7c\xb8\xe7\x8f\x2f\x87\xe2\x47\x30\xba\x14\x1f\x73\xf1\x37\x18\xcd\x45\x7a\x30\x12\x5f\x7b\xfe\xf8\xee\xe5\x7d\xf1\x65\x79\xff\x00\xcb\x1b\x3c\xf1\x8f\x9d\xf1\xa1\x38\xfe\x60\xbf\x1e\x0e\xf7\xfc\x7d\xf8\x6f\x0c\xfb\xf6\xf0\x32\xf0\xf7\xe6\x63\x7f\x7c\x39\xf6\x83\x79\xe0\x8f\x7f\x73\x16\x4f\xfc\xe3\x61\x70\x28\xc8\xd4\x9e\x3a\xd1\x8f\x9c\x51\x3e\x3c\xf2\x8f\x9d\x23\xff\xf8\x57\x7d\x80\xca\x43\x9a\xcb\xdc\x23\xff\x58\x14\xa8\x0f\x58\x51\x4b\x30\x98\xfe\xb1\x13\x08\xcc\xd9\xf7\xf7\x7e\x15\x9c\xf6\xe1\x30\xf0\x9f\x38\xa0\x42\xd9\x87\xe3\xfd\xc9\x7c\x18\x1c\xfb\x87\xbf\x02\x40\x23\x91\x28\x4a\x70\xf1\x8f\xf8\xb8\x94\xb9\x82\xb1\x3f\x72\x64\xda\x21\x97\x85\x8e\xe7\x90\xa5\x79\xf8\x43\x45\x24\xf7\x9c\x91\x3f\x56\xa7\xbf\xf8\x06\x82\x39\x94\x69\x47\x39\xf0\xef\x81\x64\x5b\x44\xc9\x3d\x39\x3c\x3f\x50\x5c\x52\x00\x64\x3c\xd0\x40\x35\x84\x0a\x3e\x04\xb6\xfd\x52\x42\x24\x65\x0a\x91\xc0\x21\x5f\x43\x73\xa0\x25\x0a\xae\x18\xfa\x4b\x38\x12\x46\x0a\x84\x31\x30\x23\x52\xcc\x39\xf8\x55\x02\x20\x00\x39\x16\xdd\x72\xc5\xb4\x48\xde\x3f\x18\x06\x87\xfe\x41\x7e\xe8\xef\x3b\x87\xa2\x41\x21\x29\xcf\x87\x87\xe2\x64\x3a\x04\xf6\xfc\x89\xff\xc4\xd9\xcb\x45\x49\x47\x10\xe5\x7d\xf1\x9f\x23\xea\x09\x4e\xe7\x10\x46\x16\xc0\xa8\x8e\x38\xc0\xe5\x1f\xe5\x7b\xfe\xbe\xb3\x27\x4e\x01\x98\x2f\xf9\x5f\x20\xcf\x21\xf8\x71\xf7\xc6\xfe\xe1\xcb\xc6\xfe\x03\x6c\xec\xbd\x40\x2c\xee\xa1\x58\xc9\x9a\x07\x01\x54\x10\xff\xb5\x98\x72\xae\xf9\xf1\x27\x7e\xe0\x3c\xf1\x03\x21\x40\xff\x5a\x8b\x05\x8d\x22\x80\x4c\x66\x93\x0f\x15\x2f\xad\x70\x65\xdf\x09\xf2\x63\x3f\x70\x8e\xb7\x72\xda\x81\x10\xcc\x83\xb1\x2c\xd3\xe0\xb7\x0f\xfc\x43\x67\x0f\x36\xcf\x1f\x83\x07\x79\xfb\x79\x5e\x0e\x48\x8f\x8e\x1f\xb2\x05\x61\x91\x32\x25\x97\xaf\x21\x9b\xaf\x3a\x8d\xd5\xdb\x9d\xc6\x48\x98\x47\xea\xa5\x00\xa6\x11\xf7\x93\x3c\x4b\x3e\x6a\x6b\x5d\x5c\x44\xdc\x2f\x59\x82\xe3\x88\xd7\x86\xff\x13\xe2\x67\x94\x12\xf6\xdd\x87\xd7\xaf\xda\x81\x5a\x59\xed\x3f\x12\xbc\xdd\x7a\x05\x52\xaf\xea\x1c\xc7\x45\x78\xb3\x51\x12\x91\xef\x99\x73\x92\x70\xcf\x2a\x47\xae\x49\xf2\xac\x58\x2c\x62\x9a\x7a\xe0\xd7\xb2\xd3\x88\x34\xf1\x30\x8d\xb0\xc6\x0c\x95\x84\x8b\x1f\xc5\x8a\x7b\x1b\x9f\x58\x88\xd2\xe1\x4e\x50\xa1\x0a\x1f\x1c\x8c\x46\xa2\x89\x6e\xf6\xa8\xf1\xdc\xa2\xb6\x98\x36\xa1\xaf\xe9\x60\x40\xbd\xdb\x92\x25\x61\x81\xcd\x4c\x85\x31\xfc\x0e\xe3\xa9\xb6\xba\x1c\x06\xa7\x55\xfd\x8c\xf3\x59\x9e\x2d\x97\x37\x2f\x13\xfb\xbd\xbe\x5e\x39\x66\x1b\x93\x9b\x28\xe8\xe6\xad\x81\x00\xeb\x8e\x07\x9d\xdd\xdc\xb3\x4e\x1c\xb9\x46\xb8\x38\xed\xa5\x14\x5e\xe5\xd9\x6e\x4b\x37\xbc\x36\xd0\x2f\x14\x48\x8f\xcb\x51\x84\xdd\xff\xef\xff\xfd\x7f\xfa\x23\x30\xfe\x0e\x30\xc4\xc4\x35\x31\xac\xb1\xab\x94\x4b\x83\x6b\x8f\xe8\xc0\xb2\x66\xa5\x42\xf8\x54\x6f\x80\x74\xa8\x3d\xfb\xd9\x4f\x3b\x0c\x1f\xa9\x1a\xef\x61\x34\x9a\xdc\xf3\x95\x4b\xa2\x77\xfe\x5f\xb2\x3c\xff\x91\x2e\x8a\x15\xe5\x9b\xde\xbc\x58\x18\x3b\x18\x78\xe0\xd2\x49\x63\x6d\x3b\x5f\x3d\x5c\x6a\x53\x01\x54\x55\xf8\x93\xdc\x21\x89\xcd\xad\x1e\xcc\x48\x47\x48\xd2\xc8\x10\x6c\x9c\x59\x71\x05\x8e\xc4\x49\x8a\x8b\x48\x85\x1b\x6c\xfb\xbd\x55\x4a\x79\x9c\x47\xca\xe9\x97\x6b\x5e\x21\x0c\x06\x5e\x1e\x49\xbb\xfb\x7b\xbe\x0f\xee\x34\x6f\x3f\xf4\xe4\x19\xcf\x49\xe8\x0a\x32\xe7\xf0\xc2\xa9\x41\xc0\x9b\x23\x86\x1a\x77\xa2\xec\xa4\xe9\x93\x4c\xbd\x07\xd8\xf6\xc8\xb8\x79\xff\x50\xc8\x6b\x07\xdd\x5e\x5e\xa1\xe6\xcb\xd3\x9a\x06\x57\xe6\xcd\x69\xbd\xbb\xbd\xed\x47\xc3\xb3\xcf\x73\x34\x5c\x10\xfe\x42\xb9\x44\xdd\x48\x4e\x80\x7f\xd1\xee\x10\xea\xc7\x52\x77\x3d\xbe\x6b\xac\x92\x18\xb5\x58\x26\x92\x66\xfc\x7e\xb3\xaf\xa8\xd6\x5c\x62\xd3\x03\xd6\xe2\xc5\x56\x4a\x61\x03\x52\x93\x0c\x2a\xbd\x8b\x2a\x37\xb3\x2e\xaa\x9f\x20\x35\x8e\x80\x25\x23\xcb\x62\x09\xde\x38\x3d\x8e\xaa\x4a\x11\x17\x2b\xb9\xb1\x28\xd9\xcc\x83\x78\xc3\x9a\x2c\x17\x54\xcc\x75\x5f\x04\x22\x48\x99\x74\x29\x91\x15\xe2\x9a\x47\xd6\x97\xed\x60\x03\x22\x5b\xf3\x08\x1e\xf1\xda\xe9\xea\x59\x56\xc8\x23\x62\x27\x83\x93\x8f\x70\x1b\x9d\x83\xc0\xaa\x35\x89\x93\x75\x35\xa1\xe3\xec\xe6\x96\xb7\x4f\x70\x62\x9d\xd7\xb5\xc3\x0a\x1e\xb9\x6e\x55\xd5\xfe\x19\x94\x61\xaa\xa0\x19\xbf\x00\x01\xb4\xce\x4c\x31\xfb\xaf\x8b\x94\x84\x3b\x23\xe5\xf0\x17\xe2\x58\xcb\x90\xe3\x29\x4c\x6d\x28\x7d\x91\x50\x70\xc3\xa6\x68\x15\x95\x4d\x56\x15\xaa\xd4\xf1\xf8\xce\xb8\x6e\x7c\x00\x3e\xd7\xef\x13\x9b\xf1\xa6\xe1\xfd\xe2\x27\xe1\xb9\xe4\x30\xfe\xce\x98\x7e\x7d\x2f\x4c\x97\xa0\xd4\xb8\x5e\x60\xb7\xe1\x96\x7a\x03\xb6\x7f\x03\xfe\xed\x60\x2d\x6f\xe5\x3b\xbf\x8e\x67\x1c\x0c\xf3\x13\xc6\x10\x3e\x2a\x94\xa5\x24\xcf\x67\xb1\x33\x14\x37\x5d\xab\x48\xbf\x81\x2b\x82\xdb\x0e\x56\x04\xc3\x54\xd5\x9b\xea\x82\xf0\xf6\x59\x5d\xdb\x77\xef\xf0\xc1\x40\xfa\xe3\xc3\x85\xed\xb6\x42\xed\x20\x2a\xb7\xcf\x4e\x10\x1a\x1e\x48\x13\x3a\xd8\xbb\xa8\xb9\x43\x36\x2e\x30\xe9\x4c\xb0\x1c\x82\xc2\x64\x41\xe2\x55\x53\xfa\x29\xe8\xe6\xb6\xfe\xf3\xbe\x4d\x49\xf7\x5c\x9b\x1b\xfa\xcb\x7d\x1b\xd2\x0e\xf4\x36\x37\xf5\xeb\xbd\x61\x32\x94\x62\x63\x5b\xff\x71\xdf\xb6\x1a\xb1\xf6\xbb\xed\xfc\x0f\x2e\x7a\x42\xf6\x77\xcb\xfd\x97\x29\x67\x11\xc9\x8d\xa5\x39\x31\xc5\x25\x01\xdc\x58\xf2\xaf\xf7\x1d\x47\x8b\x2d\xec\xb6\xf4\xef\xf7\x68\xe9\xce\x30\xce\x5d\x1a\xa3\x1c\xe0\xeb\xe7\xb2\x6d\x52\xac\x88\xac\x21\x88\x06\xeb\xef\x10\x17\x40\xa6\x93\x94\xc8\x10\xe0\xbb\x48\xdf\x06\x91\x61\xd9\xd9\x36\xd2\x8b\x94\x10\x20\x5d\x0c\x0e\x82\xdf\x91\x59\xc3\x1c\x5e\xf3\xcf\x62\x5b\xcf\x8a\x64\x55\x7a\xa8\x52\x34\x9e\x63\x6b\xf0\x0d\xcf\x5e\x85\xf4\xe5\xd1\x35\xae\x37\xe1\xc1\x63\x76\x41\x34\xbd\xd9\x70\xea\x3c\xfc\xa4\xc1\x05\xfd\x9e\xdc\x3c\x2f\xae\x68\xd8\x23\x47\x10\xff\x23\xb9\x51\xc7\xf7\x8b\x32\x89\x97\x02\xd9\x7a\x4f\xba\xc0\x3a\xe9\x5c\xb7\x6a\x78\xcd\x7a\x01\x4f\xc5\x43\x8f\xf8\x09\x67\xf9\xf7\xe4\x66\xbd\x26\x10\xc6\xe0\x7b\x72\x03\x0f\xcd\xa5\x1b\x5f\xb1\xb8\xde\xce\x08\x55\xc4\x2f\x79\xb1\x7c\xcb\x8a\x65\x7c\x11\xcb\x45\xae\xb0\xf5\xfc\x23\x74\xa5\x9b\x2c\x47\x70\x7e\x8e\xc4\x1d\xac\x5e\xde\x85\x63\x25\x35\x59\x0e\xd6\xb7\xbf\x15\x27\xfd\x3e\xef\xfb\xaa\x6c\x3d\xa3\xa0\x11\xe5\x94\x59\x4b\x6e\x96\x27\xe4\x4d\x6c\xd8\x7d\x26\x53\x9c\x23\x3d\xe0\xfc\x70\x37\x38\x73\x92\x7c\x74\x5a\x6f\xd2\x25\x68\xb5\x8f\xfe\x8d\x90\xd5\xab\xb2\x09\x02\x6b\xe7\x30\xbf\x9c\x17\x57\xcf\x95\xbb\x76\x0f\xa9\x93\xaf\x6e\xa4\xe7\x0d\xb7\xad\x77\x31\x3c\x4c\x61\xab\x5a\x40\xf1\x22\x3d\x2f\xe4\x7a\x4b\xc3\x43\x2c\x33\x41\xb8\x8c\x72\xdf\xc2\x77\x9c\x44\xd9\x84\x0c\x06\x25\x20\xbc\x10\x27\x23\xed\x0d\x04\x6f\x98\xed\x0a\xe1\x2d\x0c\x82\xf6\xf1\xd7\xc7\x20\xd0\x36\x83\x50\xb4\x19\x04\xaa\x37\xac\xf6\xd0\x16\x26\x3d\xcc\x42\x50\x29\xe7\x13\xd6\x14\x6e\x67\xf9\x24\x99\xa3\x91\x67\x71\x7f\x36\xef\x47\x8c\x13\x0c\x49\x06\xad\x19\x42\xb8\x88\x3c\x45\x0f\x34\x78\x40\x5d\x75\xcf\x8a\xaf\x80\x27\x82\x05\xfa\x34\xb2\x69\xbc\x02\xb4\xdc\xfb\x23\x5c\xf4\x63\xf2\x06\xdc\xd5\x95\x35\xf7\x19\x7b\xbd\xfc\x67\xc3\x1c\x4e\x06\x4c\x95\x02\xbb\x85\xe6\xda\x7c\xee\x2e\x64\x17\x24\xc8\x62\xdc\x1a\xb3\xd2\xb7\x28\x9d\x09\x96\x0b\x04\xd3\x6b\xb9\xe0\xa8\x39\x3b\x9c\x47\x85\x12\x3b\xb5\x54\xc5\x91\x71\x46\xc9\x8b\x57\xc5\x15\x61\xcf\xe2\x92\x78\x5a\x74\x52\xce\xe6\xb6\x9c\xac\x5b\xd5\x5e\xbd\x12\xfd\x95\x97\xab\xa0\x90\x6e\xc3\xb2\xb0\xe3\x22\x04\x55\x77\xf8\x1a\xeb\x69\xb7\x76\xd8\x71\x67\xd3\x77\xb8\x24\xfb\x1d\x40\x57\xae\x66\x6f\xa4\xfb\xbe\x7f\xde\xf4\x4d\xff\x15\xa7\xef\xd4\x4c\xdf\x27\xcb\x16\xf4\x73\x48\x15\xf4\xf7\xcb\x13\xf4\x73\x48\x12\xf4\xb3\xc8\x10\xf4\x5f\x5e\x7a\xa0\xe4\xca\x79\x2e\xce\x50\x8a\x60\x2c\xb6\x9a\x78\x13\x0f\x83\x95\x42\x40\xa4\x33\x22\x8e\xa9\xef\xc9\x8d\xf8\xe8\x72\xaa\x3b\x81\xe6\x52\xc1\x1d\xfc\xe7\xf3\x11\xa8\xbd\x03\xf6\xf0\x1c\x0c\x1e\x50\xd3\x8b\x9c\x48\x77\x1a\xc0\x84\x00\x05\xcf\x23\xed\x47\x31\x6b\xf0\x25\x65\x24\x7d\x46\x50\xe9\x27\x00\x27\x11\xf7\x09\x15\x8d\xd5\x21\xcd\x56\x11\x57\x6a\x32\x3c\x83\x9f\xcf\xc1\x77\x29\x4e\xe1\xe3\x3d\xdc\xf5\xe0\x65\xc4\xb5\xf3\xfd\xa7\x2a\xa2\x26\x3e\x8f\xb8\xff\xeb\xaa\xe0\xa4\xfc\x41\x70\xe7\x25\x9e\xdb\x7e\x0d\xf5\x1c\xdf\x25\xd0\xf4\xb8\x1a\xcc\x75\xc8\x31\x19\xc6\xd6\x6d\xda\x91\x97\x8f\x58\x05\xcc\xe0\xeb\x62\x55\x12\xe0\xda\x7b\x3c\x00\x91\x9a\x89\x52\x54\x85\x48\xc0\x24\x29\x31\x0b\x3d\x82\x37\xc0\xaa\xb1\x57\x24\xbe\xec\x73\x27\x74\xdf\xc6\x02\x68\xac\x4f\x76\x12\x4c\x05\x16\x28\x20\x79\xb1\x0a\x61\xcb\x59\xeb\xf2\xde\x31\x0b\xd4\xec\xd4\xf1\x4f\x91\xbc\x4f\x90\xad\xee\xba\x67\xee\x6e\x56\x21\xac\x18\xbe\xcd\xfc\x4e\x0e\x5e\xc1\x80\xab\x76\x43\x17\xc9\xa0\x06\x3d\x57\x40\xf7\x3a\x71\xb6\xad\xa0\x0c\x38\x8b\xfa\xa4\x69\x01\x3e\xee\x01\x7e\x67\xe7\x7c\x30\xb8\xeb\xa4\xe8\x63\xaf\xaa\x0a\x1c\x54\xdd\x79\xca\x6c\x08\x80\x52\x55\x6a\xde\x7e\x3f\x08\x5b\xaf\xe1\xda\x73\xbf\x71\x99\x36\x4b\x4d\x06\xad\x94\x54\xa9\x79\xc5\x9d\x20\x8a\xa2\x74\x30\x80\xbf\x2b\xe9\x8a\xa4\xed\x61\x9c\x45\x6f\xbd\x0c\x4d\x3c\x5e\x8b\xb9\xdc\x12\x73\x81\xcf\x5b\x9d\x90\x86\xe2\x9d\xa2\x10\xd2\xc1\x67\x9a\x76\x3a\x8c\x53\xbd\x19\xa8\xdc\x06\xb5\x7c\xc1\xe4\xdb\x0b\x39\xd8\x46\xb8\x62\xd7\x9c\xe2\x12\xd6\x13\x73\x98\x5b\x61\x6b\x50\xed\x10\x54\xde\x4e\x53\x3c\x47\x08\x27\x3d\xdb\xe4\x2d\x91\xce\x0f\xd4\x0e\xb4\xe8\x8f\xda\x94\x58\xde\x94\x85\x73\x5c\xb2\xc4\xc8\x39\x8d\xeb\xf3\x30\xc1\x40\x3b\xc3\xdc\x12\x91\xa6\xc6\x8d\xbd\x98\x2e\x3c\x95\xa8\x71\x8a\x2a\xb9\x57\xb0\x9c\xa7\xc1\x60\x14\x45\xf3\x13\x0d\xad\xbe\xd7\xf1\xec\x42\xb3\x56\xa1\x5a\x5d\xae\x8a\x6d\xbb\x73\xfa\x65\x8b\x69\xcd\x1f\xc0\xbb\xff\x05\xe1\x3f\x98\x58\xc2\x6d\xa9\x43\xc8\x17\xf5\x29\x56\x66\xbf\xc9\x13\xcc\x88\x14\xe6\x44\xa9\x9b\xd8\x22\xc6\xdd\xe5\xd2\xce\x8e\xa1\xac\xe2\xb7\xd8\x49\x08\x61\x8a\x5d\x27\xe3\x64\xe1\x62\x81\x7a\xf4\xc4\x75\x43\xb7\x74\x91\x0a\x09\xf0\x34\x4d\x8d\x27\x97\x1e\xb7\xfc\x7a\x28\x2c\xa2\xea\xa4\x6d\xfa\x37\xcf\xb5\xdb\xf3\x2c\xa2\x70\x5d\x5b\x46\x54\x09\xb7\x49\x44\xfd\x94\x2c\xf9\xfc\x4e\xd3\xa3\xde\xcb\x84\x38\xbd\xdf\xe5\x29\x7f\xc0\x35\xc6\xd5\xbd\xae\x31\xe2\x34\xad\xef\x30\x18\x76\xeb\xa8\x95\x1b\xb4\x31\x92\x8b\x91\x4a\x87\xe4\xeb\xd1\x49\x2e\xb7\x81\xa5\x78\xd0\x4e\xd2\x47\xb8\x30\xa6\x16\xa8\xad\x8d\xc8\xfa\x74\x0f\xe2\xa8\x90\xde\x0f\x81\xe3\x9a\xd4\xae\xcd\xa3\x6b\x2f\x43\x27\x5d\xdd\xc8\x46\x37\xf8\x4a\x47\x52\x4a\x1d\x09\xa9\x50\xb8\x45\xb1\x22\x3d\xfd\x37\xab\x68\x26\x40\xd1\x3d\xa3\x34\xe9\x10\x8d\x55\x9e\x0b\x92\x61\xee\x58\x48\x4d\x00\xe4\xfc\xdf\x13\xd3\x3c\x2a\xe9\x19\xb6\x50\x0e\x49\x9f\xfa\x02\xe7\x72\x85\x73\x99\xc6\x39\xb1\xbd\x04\xed\xd1\x93\x7c\xe7\xbe\xba\xcf\x8d\xda\xa7\x20\xda\xa7\xdd\x97\xb1\xcf\x7d\x5f\x96\xd9\xea\xb0\xf8\xc1\x08\x99\x6f\xbf\x39\x23\xbe\xe4\xeb\xb7\x13\x40\xed\xee\xd4\x62\xb1\x0b\xf8\x78\x9a\xa6\xa0\x40\x6c\x33\xe6\xb9\xb2\xea\xea\xb2\xf4\xf5\x89\xf7\x09\xdc\x35\xed\x09\xe8\xdb\xcb\xa0\xd5\xd9\x5d\x65\x14\x91\x7e\x10\xdb\xfa\x6f\x88\xba\xd2\x38\x14\x3c\x84\xe3\xbb\xcf\xed\xb2\x75\x22\xc7\x70\x5a\xe7\xea\x5c\xa6\xd6\x82\x65\x8d\x03\xb8\x38\xe9\xd0\x6e\xaf\xb4\x0b\xb0\x93\xce\x96\xd3\x05\xd0\x5d\xc1\x0d\x7a\x8e\xe3\xf7\x7f\x67\xbf\xc2\x46\xe7\x3c\x3d\x35\xc7\x39\x75\xb2\xda\xa2\x8c\x5c\x2f\x63\x9a\x92\x14\x71\x7f\xb9\x2a\xe7\x5e\x3b\x1d\x5c\x01\xf2\x29\x39\x8d\x76\xc4\xbf\x4d\x5d\xb2\x2a\x13\xf2\x86\x0f\x61\x93\x3c\x3d\xbd\xaf\x18\x2b\x58\x1d\x55\x0b\xb6\x67\x5b\x9e\x35\xc3\x68\x49\xb2\xf5\x16\x10\x5b\xfd\xbd\xed\x40\xc1\x96\x1c\xf5\x58\xc8\x69\x0f\xe6\xbc\xc3\xb7\x1a\x2b\x4c\x23\x82\xe1\xec\x91\x5e\x5e\x6d\x28\xfa\x10\x2b\xac\xae\x38\x2e\xb6\xa4\x18\x02\x04\xd8\x06\x96\xaa\xe1\xd9\xb8\x88\x04\x63\x23\x8e\x7b\xac\x42\xf6\xc0\xe6\x96\x43\x17\x5b\xfa\x97\xf2\xf2\x5d\x51\xf0\xce\xb6\xf6\xb8\x72\x0c\x77\x06\x22\xfd\x99\x98\x6e\x19\x15\xc4\xed\xef\xca\xc5\x2e\xf4\xe3\x62\x17\xa4\x2c\xec\x42\x27\x2e\x76\x55\x17\x2a\x43\xc5\xf9\x74\xad\xd6\xdd\x53\xc1\x4f\x47\x23\xbc\x8a\x0e\x1e\x59\x2e\x71\x2d\x5d\xc1\x24\x5f\xaf\xbd\x64\x63\xb6\xf6\x9b\xc6\x70\x2a\x5d\xa2\x25\x24\xcb\x3d\xaa\x68\xe8\xe3\xd9\x36\x3b\xeb\x3b\xa4\x9b\x5a\x3e\x14\xf2\x0d\x1c\x08\x31\xce\x4f\xc4\xa8\x86\x4c\x0c\x2b\xdc\xa6\x1e\x48\xfa\xef\x80\x3e\x58\xae\xc4\xef\x0a\x2d\xd1\xcd\xfd\xa5\x73\x8c\x95\xe0\xa8\x4e\x0d\xb7\xb2\x1b\x47\x08\xbf\xf5\x24\xab\x9d\x22\xe4\x2f\xe2\xa5\xe5\x3e\x16\xb3\x3b\x7d\x14\xb7\x3a\x12\x88\xca\xf0\xc6\xe9\x71\xac\x68\xef\x6a\xaa\xda\xb3\x63\x85\xa3\x3d\x6c\x5c\x41\xac\x36\x5c\x97\x69\x49\x35\x56\x5a\x58\x75\x25\xf2\xf0\x95\x6c\x5d\x56\x2a\xf0\x3a\x57\x98\xdd\xb3\x1d\xdc\xec\xb6\xa8\xa4\xc7\xea\xf3\xc5\xa6\x3e\x1e\x83\xd0\x48\x2d\xda\xc1\x7a\x69\x47\x67\x19\xc5\xec\x16\xbb\x0c\xc3\x46\x0a\x47\xd2\x0e\x78\x27\xc0\x96\x4b\xf7\x00\xf7\x6f\xbf\x70\x86\xc1\x1b\xe4\x59\x31\x9b\x95\x84\x87\xec\xd1\x4c\xc9\x98\x32\xf4\x88\xf8\x66\x8f\x66\xbb\x33\x64\x1f\x5f\x58\xde\xcb\x4b\xe5\x0f\xb6\xb6\xa4\x4a\x3b\x93\x2b\xa9\x4e\xbe\xb8\xc2\x65\xaf\x6d\xee\x66\xd5\x4b\x6c\x54\xe7\x0f\x98\x57\x1b\xbf\x2c\x84\x1a\xca\x96\x2a\x84\xdd\xa9\x7b\xdf\xf5\x57\x40\xd8\xcd\x6c\x62\x34\xfa\xcb\xf4\x3f\x82\x78\xa8\xfc\x17\x77\xe4\x3f\xb1\x20\xae\x33\x74\x5c\xb9\x2c\x5f\xeb\xdd\x7b\xa2\x7f\x84\x72\xb9\x90\xbc\x54\x40\xd5\x56\x6b\xd0\xd7\x77\xb3\x02\x9f\x23\xb6\x80\x39\x9d\x77\xda\x47\xfd\xa7\x44\x16\xd0\x75\xeb\xc0\x02\x86\xa1\x30\xd6\xee\x72\x8a\x9f\x15\x94\x13\xca\x5b\x7e\x56\xef\x24\x5e\xf6\x8a\x08\x06\x85\x48\xe3\x60\x42\xb9\xa3\x56\xc3\x26\x07\x9f\x7c\x36\xa8\x36\xa5\xb0\xd0\x74\xe7\xde\xec\x15\xd6\x5d\xb1\xe7\x8d\x81\x95\x10\x51\xcb\x5c\x9f\xbe\x50\x57\x59\x51\x57\xf9\x0b\x0e\x64\xd5\x6c\x09\x54\x3a\xd9\xa4\x2b\xdd\xb4\x19\x5a\x00\x5a\x51\x02\xec\x91\xd1\x22\x25\x75\x94\x66\xc3\x71\xb3\x1e\x67\xfc\xf2\x36\xad\xb1\x5c\xaf\x09\x8f\x9f\xc7\x3c\xde\x6a\xbe\xe0\x69\x23\x05\xc3\x89\x69\x2d\x76\x21\x04\x48\xe9\x5c\x96\x6a\xcd\xd9\xe6\x03\xbc\x7b\x1a\x5a\xac\x7c\x8c\xe1\x68\x2c\x2a\x8d\x7f\x72\x8e\xfb\x96\xa0\xd7\x6d\x77\x61\x00\x8e\xa3\x42\x31\x52\x79\x54\x34\x78\xa3\x2c\x2a\x7c\x9b\xf6\xe2\x32\x2a\x36\xf1\x63\x49\x54\x58\x3b\x60\x65\x96\x52\x62\x92\x6c\x6f\x16\x4d\x4f\x8d\x6b\x6b\xe5\x8c\x7b\xbd\xbe\xad\x50\xfd\xa0\x43\x2e\x61\x59\x30\x71\xae\x96\x83\x81\xa2\xe0\x52\x09\xe8\xa5\x51\x0a\x79\x1e\x42\x38\xed\x7a\xd9\x2d\xa4\x2f\x70\x50\xc2\x7d\x20\x5e\x81\xc9\xb4\x38\xd5\x57\x00\x8a\xe2\x47\xe0\x0b\x36\x1b\x0c\x3c\x29\xd4\x4b\x1f\xd1\x2f\x29\x57\xdf\x68\x37\x43\x10\x3d\xb2\xfc\xe1\x8a\x1a\xd7\xb0\x05\x42\xd9\xcc\xb3\x74\x21\xd2\x62\x02\xcd\xa4\x78\x70\xdf\x23\x50\xe9\x11\xe4\x29\x18\xef\x06\xd8\xb6\x1b\xb1\x15\xa7\xf5\x49\x96\x68\x55\x87\x56\x9a\xdb\x67\xd9\x0a\xa2\xcc\xa1\x09\xc9\x4b\xe2\x08\x00\xf5\x65\x87\x86\x0f\x16\x3b\x8d\xde\x91\x49\x39\x18\xa8\xc6\x75\x24\x8f\x12\x26\xf4\x3d\x41\x78\xe3\x28\xd2\xbf\xd7\x20\x36\x9e\xce\x6a\x44\x15\x8c\x68\x23\x5c\xcf\xb6\xcc\x2e\x5c\x70\xd4\x66\x35\x21\xc5\xf6\x5d\x5e\x78\x60\x83\x25\xe1\x30\x84\xe3\x66\x49\x54\xf7\x08\xcf\x2a\xe5\x15\x9a\x8b\xdd\x2f\x4f\x09\x82\x5a\x2f\x8f\xa2\xe6\x7b\x88\x25\x23\x97\x02\x65\xca\xf0\x56\xb0\x50\xf7\x16\xf2\xbe\x11\xc7\xff\x7b\x1e\xb3\xee\x9b\x18\xcb\x32\x18\x8e\x34\x66\x8b\x49\x62\x0f\xcb\xf7\x68\xcc\x08\x3e\xcc\x92\xf9\x0c\x46\xd4\xb8\xcf\xec\x0d\x7e\xa7\xba\x6a\xfb\x85\x54\x83\x09\xaa\x51\x8f\x9c\xb8\x53\x37\x04\x23\x11\x6e\xb4\xf1\x4d\xf2\xe9\x15\x4a\x37\x20\xe3\x4d\x46\xfc\xe4\x5d\xf8\xea\xee\x17\xa3\x0f\xb9\x1e\xeb\x53\xa5\xd0\x0e\x4f\x26\x6f\x4f\x9a\x0c\xf8\xdf\x8f\x03\xef\x6b\x39\xd3\xc2\x73\x6c\x09\xcf\xf9\xef\x12\xae\xee\x5c\x97\x7b\x2c\xcc\xef\x93\xdd\xe5\x71\xc2\x94\x14\x0f\xa2\x7a\x7d\x36\x28\x3b\x7a\x75\xa5\xde\x90\xc6\x51\xeb\x86\x5d\xcb\xf0\xa5\xad\xca\xc0\x49\x24\x85\x76\x2d\x95\x4b\xe1\xdd\x16\xc1\xb5\x9c\x2e\x44\xf0\xa6\x40\xde\x27\xbc\x9b\x96\xdd\x53\x84\x57\xd6\xe5\x3a\x9e\x45\xab\xc6\x01\x96\x46\x2b\xc3\xc9\xe1\x65\x74\x6b\xfc\x71\x67\xeb\x75\x7b\xa7\x15\x27\x9d\x94\xc1\xc0\x5b\x5a\x31\x05\xa2\x11\x5e\xea\xbb\x19\xf3\x60\x0c\x85\x4b\x3b\x7a\xc0\x46\xc5\xc0\xa7\x33\x75\x5a\xe0\xc7\x7f\xd8\xab\x7d\x79\xa3\x99\x6d\x51\x45\x2c\xad\xbb\xcb\x9a\x7a\x7a\x33\x9c\x22\x9c\xb6\x70\x5b\xb1\x41\x1e\xc5\x0c\xc7\xde\xad\xbe\x83\xac\x77\x5b\x59\xe1\x04\xa1\xd0\xdc\x2e\x2a\xe6\xd0\xbb\xe7\x3b\xbd\x9a\x74\xdc\xfb\xfd\xdc\x26\xcb\x2b\x5b\x6b\x90\x9e\x80\xe9\x62\xe8\x8e\x96\xd7\xd2\x04\xcb\xec\xe4\xd9\x89\x7b\xea\x86\x6e\xe5\x8a\xc1\x02\x9b\xbc\x61\x37\x33\xf9\xd2\xce\x3a\x6a\x9e\x13\x96\x5d\x92\x14\x56\xe2\x5b\x56\x2c\xe0\xb8\xea\x39\x75\xac\x28\xc3\xe6\x54\x9b\xd4\x6b\xc9\x12\x78\xfc\x55\xb2\x64\xbd\xb6\x62\x7d\x41\xa2\xf9\x12\x59\x62\x27\x42\xaa\xf8\xa1\x13\x60\x9b\x9a\x54\xf8\x12\x59\xc0\x34\x43\x32\xfc\x3a\x51\x93\xd5\x38\x80\xdb\x07\x2d\x51\x7a\xea\xcd\x12\xe4\xe4\x35\x31\x0d\x6c\x8c\x1c\xa5\x98\x51\x31\x20\xa4\x2f\x73\x69\xe4\xc1\xe5\x39\xb1\x47\xb4\x33\xda\x69\xa4\x0c\x06\xd6\xc7\xd7\x44\x52\x3e\x34\x18\x78\x3b\xc4\x2f\xe7\xc5\x2a\x4f\xf5\x61\xb3\x5e\xab\xd6\x9a\xc9\xea\x4a\x85\xd4\xec\x93\x7c\x86\x0a\x9c\xc9\xb5\x02\xc9\xe2\x59\xac\x19\xac\x10\x1a\x0c\x04\x3c\x3a\x0c\x44\x2d\xc5\x6e\x09\x53\x57\xcb\xa6\x14\x61\x8b\xb8\x85\xd6\x49\x01\xa4\x59\x07\x5e\x36\xd1\x8d\x7b\xd4\x28\xdb\x4a\x83\x7c\xc2\x2d\x5b\xaf\x4a\xb2\x54\x1f\xea\x65\x70\x88\x0c\xb8\x6c\xe4\x75\xb9\xa9\x81\x2f\xe7\xf2\xb7\x0c\x45\x41\xe5\x87\xe8\x28\xba\xf6\x28\xaa\x26\x37\xde\x6b\xc5\x59\xbd\x23\xd1\x6b\x82\x5f\xfd\x5d\x6e\xf0\xe3\x68\x34\x89\xbf\x62\x93\x18\x6e\xf0\x63\xfb\x06\x3f\x7e\xd0\x0d\xfe\xa6\x9b\xab\xa5\x47\x90\x39\x38\xa7\xf2\x68\x3c\xc5\x4c\x70\xec\x9a\x1d\x12\xb0\xf8\x59\x29\x61\x82\x13\x15\x0d\x06\x9b\xf4\xe2\x22\xa7\x64\x89\x89\xd6\xb7\xb1\x98\xc7\x80\xf5\xbf\x97\x82\x81\x11\xce\x6f\x86\xbf\x94\x36\x37\xf3\x10\x3d\xc3\x56\xcd\x42\xb7\x12\x6b\x9f\x61\xf6\x93\x3b\xad\x41\x54\xc7\x37\xf8\x22\xe0\xf2\x7d\xc1\x83\xaf\x99\xde\x7c\x26\x27\x14\x79\x51\x92\xd7\x45\x1a\xe7\xd1\x86\x5b\x53\x79\x3b\xda\x54\x1b\xc9\x9b\xd4\x77\x2f\xde\xbf\xf8\xe0\x56\xd6\xeb\x88\xa8\xf5\x2e\x17\xe4\x61\xc8\x31\xb7\x50\xf0\x90\xc5\xbe\x5b\x82\x84\x50\x65\x9c\xa8\xbf\xa1\xeb\xfe\x5d\xed\x25\xeb\x5b\x26\x39\x20\x21\x2a\x65\xe5\x4f\x71\x9e\xc1\x3d\x6b\x7d\xd1\x04\xd0\xe0\x3c\x2a\xbc\xf8\x77\x5c\x57\x08\xb6\x65\x21\x26\xd9\xc4\xd0\x57\xb7\xac\xdd\x0c\xd4\x8a\xb3\x58\x2f\x50\xbf\xce\x73\xcb\x15\xae\x69\xbc\x57\xcf\xbb\xf9\x7a\x76\xb3\x4d\x60\x03\xe2\x3c\x3e\x27\x10\xcb\xf7\x7b\x72\xe3\xc8\x71\x6e\xd9\x93\xca\x40\xa0\x8e\xc2\xc7\x48\x1e\xf3\xec\x72\xd3\xd3\x7d\xf9\xe0\xe9\xae\x91\xe9\x77\x51\xb8\x7f\xb6\x55\x23\xec\x3e\xcf\xda\xca\x25\xc9\xf3\x67\x73\x02\x36\x72\x0a\x9b\xe2\xe6\x63\x2d\xdf\xf7\xfb\x9e\xb6\xc9\x80\x7f\x46\x1d\x2b\x11\x98\x37\x9e\xb9\x99\xf7\x69\x6f\x19\x29\xcb\x46\xd5\x7c\x30\x50\xef\xca\x20\xfa\xd1\x47\x72\x73\x42\xf4\xa6\x41\xa1\x7e\xac\xa6\xf3\xe0\xcc\x36\x28\xa1\x9e\x33\xe5\x3d\xf7\x18\xdd\x27\x55\x9d\xb9\x93\x9d\x6c\x9c\x3c\x95\xdd\xbd\xd8\xe7\x36\x67\xac\x00\x15\x70\x48\xe9\xeb\x8e\x00\xa8\x75\xfb\xea\x85\x59\x5f\x85\x8f\x77\x03\xdf\x7a\x91\xd6\x3b\x02\xd5\x43\xdf\x3b\x99\x1e\x0a\xd7\xa6\x6a\xe0\x06\x68\x9b\x7a\xff\xe9\x1f\xdb\xf0\x4e\xd1\xb3\xcd\xe6\x43\xfa\x0e\x20\x92\x1c\x17\xc5\x6e\x2c\x9f\x02\x60\x97\x92\x2b\x90\xb5\x0c\x55\x32\x01\xec\x76\x22\x3e\x18\x0c\x05\x37\x68\x73\x9f\xcc\x6f\x9a\xc3\x20\x13\x86\x90\x83\xf1\x4b\xfb\x78\xf8\x7d\xa0\xf8\xc6\x9c\x2a\x02\x2e\xbb\xd3\x3b\xb6\x8a\x4c\xf9\xa9\xe9\x45\x19\x92\xca\xe7\x76\xf7\x35\xe8\xa2\xd2\x30\x88\x55\xdb\x8f\xe9\x4f\xf6\x41\x26\x46\x7a\x49\x5a\xe1\x8e\x1b\x3e\x0e\x78\xcf\xfe\x7e\x43\xb0\x41\x5c\x63\xc1\x20\x57\x5c\x1e\x1c\xfa\x38\x93\x53\xaf\xcc\x5e\xe1\xf7\x9d\x62\x07\x7e\xfe\xc7\xf4\xd6\xb6\x20\x65\x19\x5f\xc8\xa9\x52\xb3\xc6\xcc\xac\x15\xed\x59\xa3\x7d\x26\xf3\x77\x5a\x4d\xe7\x59\x0a\x47\xe1\x70\x16\x67\xf9\x8a\x19\x43\xd1\x9e\x9c\xbb\x2d\xce\xe4\x02\x15\x5d\xca\xb2\x8d\x48\xf6\x76\x66\x4e\xdb\xde\x08\xd3\x1f\xc9\xc6\x7a\xe0\x83\xc8\x45\xe8\xce\x35\x7f\xf9\x79\x18\x4a\x18\x72\xf4\x5c\xf0\x4e\xb4\xb8\xf2\x90\xe5\xb3\x44\x5e\x46\xe9\xe8\x6d\x3d\xf7\x68\xb7\x8c\xc0\x2d\xb9\x0f\x7f\xe1\x38\xad\x6d\xc3\x87\xf2\xa9\xb4\x1b\x32\x5f\xfe\x7a\xcf\x12\xb0\x37\x6d\x50\x87\x90\xf9\x71\x9a\x7e\x4f\x6e\xde\xc9\x04\x78\xd2\x63\xca\x77\x1e\x3d\x62\xae\x28\x8e\x92\xdb\x2d\xba\xd3\xee\x17\x01\xe3\x08\x92\x6e\xf7\x61\xaf\xa1\x36\xa0\x04\x6c\x52\x23\x9c\x45\x5e\xfd\x42\x47\x5b\x07\x62\xae\xc0\x4a\xcf\x40\x4a\x2e\xf5\x3b\x9d\xc4\x5c\x6e\xad\xa2\xa4\x7e\x75\x93\xd8\xaf\x6e\x12\x65\x12\xb8\x8c\x6e\x4d\x5f\x42\xfc\x36\x77\x90\x2c\xb1\xde\xe9\xc6\xd8\xea\x29\xcc\xd4\x9d\xc7\xb6\xb7\xbe\x79\xa5\x7d\x76\x94\xea\xdd\xa6\x99\x8d\x38\x15\xf2\x77\x48\xa2\xd4\x5b\x36\xde\xc6\x37\x7c\x00\xc8\x22\xab\x4d\x45\xd4\x1c\x88\x32\x33\x6f\x89\x2a\xb0\xc3\x23\x27\x9e\xbe\xa2\x56\x6b\x71\x91\x17\xe7\x71\xae\xb5\xa4\x59\xf3\xd9\x33\x8c\x45\x70\x1d\x76\x62\xbd\x01\xbe\x95\xf8\x0f\x2a\x3e\x81\x03\x0d\xac\xd8\x74\x93\xde\x28\x64\xaa\xd6\xd8\xb8\xa9\x5e\x4f\xb7\x01\x6e\x35\x16\x34\x2c\xea\xda\x99\xf0\xc6\xac\x99\xd2\xdb\xa8\x18\x35\xd7\xe7\xd8\x5b\x29\x60\x89\xc5\x96\x4e\x5e\x1a\xe9\x52\x53\x0d\x47\x43\x2b\x47\x12\xcc\xba\xfd\xd7\x92\xae\xb6\x8b\x75\x0a\xe0\x25\x23\x97\xef\xfb\x21\x10\x59\x6f\x36\x41\x21\x32\x3f\x6c\x84\xe4\xc1\x8e\xd8\x9e\x67\xe9\xeb\x0d\x5e\xd8\x24\x06\xc1\xb9\xd1\x8b\x44\x96\x68\x27\xb6\xdd\xc4\x3a\x67\x6c\xda\xe4\xd5\x1c\x1b\x77\x32\xea\x10\xf4\xdf\xbe\x60\x5b\x76\xdd\xa1\xbb\x6b\xb5\x2e\xb8\x0b\x34\x91\x8d\x6e\xc2\xa2\x9e\xb5\xb5\xec\x0b\xed\x41\xfd\x28\x29\x4d\xef\x3d\x9a\x7c\x5c\xdd\x44\xe3\xc1\xe0\x8e\x9e\x2b\x84\x55\xbd\x26\x08\x9d\x8a\x3d\x10\x62\xad\x2a\x6d\x4e\xd9\x60\xf0\xc0\x29\xee\x8e\x74\xbb\x1f\xbd\x07\xac\x88\x24\x23\x26\x1c\x68\xff\xea\x3c\xc8\x9f\x9e\xbc\x3a\x91\x9e\xa8\x5a\xbb\x4f\x3f\x81\x6f\x6d\x09\xc1\x80\x34\xa6\xdd\x72\x56\x05\x71\x77\xc1\x3a\x5b\xab\x48\x6d\x8e\x26\x89\x4a\xe5\x72\x6f\x15\x35\x59\xd3\x87\xf8\xb6\x62\x24\x4e\xb8\xd4\x6f\x5d\x66\xe4\xaa\xf6\x2e\xa0\x94\xf5\x05\x76\xe3\xe5\xd2\xbe\xc9\xd3\x4a\xfb\x04\xf5\x09\xde\xcf\x09\xbe\x55\x7c\x56\x48\xb1\xe4\xb2\x42\xae\xe8\x48\xa1\x58\xe2\x7a\x8e\x7b\x59\x99\x57\x3d\xe2\x9b\x35\xf0\x5b\x69\xb8\x0d\x34\x2b\x33\x2d\x2b\xa5\x71\x8e\x7a\xfa\xe8\xeb\xe4\x29\xc1\xb7\x0a\x3c\xb6\x11\x3c\x6c\x4f\x2b\x18\x33\x7e\xf2\x95\x42\x36\xf3\xf4\x8e\x90\xf7\x0a\xef\xe5\x05\x82\xba\x25\x90\x69\x6f\xd4\x4d\x01\xc0\x63\x52\x81\xf4\xe9\x4b\x89\xdb\x5a\x4f\x6e\x6b\xcf\xe5\x08\xc8\x46\xda\xdc\x87\x7b\x9a\x1c\x93\x26\x05\x26\x6d\xa2\xab\x5a\x35\x37\x7e\x5c\xb7\x45\xe4\xf6\x67\xa8\xb2\xe2\x9e\x6f\xb9\x8e\x78\x49\x1a\xe4\x5b\x8e\xe5\xb6\x52\xfc\x2d\xdc\x76\xa9\x81\xb8\xec\x97\xcb\xa1\x7e\xb7\xd7\x34\x95\xd4\x1f\x92\x2d\x6c\x98\x4b\x8a\x03\xae\x71\xc3\x00\x29\xca\xa0\x46\xfc\xb6\xdf\x12\x87\x3b\xa3\x4d\x66\x97\xc1\x68\x84\xad\xfb\xc6\x70\x1f\xb7\x1e\x4e\x88\xba\x9d\x97\x65\x56\xe2\xf3\x98\xc7\x1f\x6e\x96\x04\x3a\x91\x3c\x98\xe8\x5e\xb3\x60\xf2\xf7\xd3\x34\x95\x3f\xe4\x03\x68\xf1\xbb\xbe\x98\x73\x39\xcb\x62\x7a\x91\xd7\x6f\x63\xaa\x9e\x55\x75\x7f\x32\x49\xce\x0b\xc6\x0a\xe6\x36\x31\x16\xcc\x06\x5a\x8f\xaa\x41\x6d\xfc\x92\x34\xd7\xb0\xe7\x76\xc8\xdc\xf0\xea\x5b\x8d\x9d\x28\xba\xf6\xb4\x8b\xd0\xf5\xba\xe7\x45\x81\x7b\x1e\x97\x64\x34\x72\xb1\xfc\x11\xe8\x1f\x63\xfd\x63\x4f\xff\xd8\xd7\x3f\x0e\xf4\x8f\x43\xfd\xe3\x48\xff\x38\xd6\x3f\x9e\xe8\x1f\x4f\xf5\x8f\x6f\xf4\x8f\x67\xfa\xc7\x73\xfd\xe3\x85\xfe\xf1\xad\x7b\x3a\x69\x1a\x2e\x49\x17\x8c\xe6\xd1\x43\x34\x9a\xd0\xaf\xb8\x52\xa3\x4c\xe8\xee\x2e\xca\x66\xde\x8e\xc7\xa7\xf4\x14\x0e\x08\x65\x22\xb2\x13\xa8\x79\xd8\x19\x55\x3a\xa5\xb2\xe6\xc1\x4b\x0a\x5a\x16\x39\xf1\x89\x58\x00\xaf\x4d\x51\x1d\x48\x0e\xf5\xed\xbb\x23\x68\x98\xb3\x58\x95\xdc\x39\x27\x4e\xec\xc8\x44\xb1\x01\x9c\x82\x39\xb0\x26\x8e\x00\x7f\x18\x1c\xaa\x3c\x09\xbf\xef\xe2\x3f\xab\xa5\xcd\xe8\x85\xc3\x0b\xa7\xb1\x45\x64\xd9\x3f\x23\x6d\x77\x17\x35\x72\x11\x6e\xad\xa1\xbc\x48\xa9\x0d\xcc\xea\xb4\x7b\x8f\xa6\x64\x09\x8c\x85\x30\x7e\x63\x8d\x47\x8e\x40\x94\x57\x80\x0b\x99\x53\xde\x6a\xb9\x2f\xde\xbd\xfb\xe1\x9d\x8b\xe1\x96\x26\x32\xa7\xc4\xfd\x5b\xaa\x10\xae\x9f\xc5\x71\x54\xe1\x1b\xef\xa5\xf8\xa5\x89\x4a\xf4\x92\x54\xf0\x16\x0e\xb7\x4d\x4a\xdd\x55\x49\xc0\xfb\x53\xc2\xdd\x89\x11\x4d\x59\x5b\x99\x7b\x76\x46\xca\xd7\x45\xba\xca\xc9\x09\x09\x6f\xb5\x17\x37\x52\x55\xa6\x4a\x21\xb9\x29\x40\x14\xe2\x64\xb4\xe4\x31\x4d\x48\x31\x73\x38\x42\x7c\xce\x8a\x2b\x07\x0c\xf0\x6e\x96\xe4\x85\x9c\xbf\x67\x31\xa5\x05\x77\x84\x90\xeb\xc4\x0e\x1c\xbc\x4e\x5c\x3a\xb1\x63\x1c\x67\xa0\xba\xf5\xb8\x6e\x9d\x58\xcd\xbd\x23\x33\xc2\x08\x4d\x74\x9b\xe0\xed\x6b\x1e\x97\xf4\xcf\x62\xaa\x08\x75\x32\x9a\xf1\x2c\xce\xb3\x92\xa4\xce\xd0\x29\x57\x4b\xc2\x3c\xd4\x28\x21\xfa\x27\xa9\xd1\xc3\xed\xf0\xf5\xba\x46\x09\xe5\xb8\x9a\x0f\x06\xb5\x3b\x8f\x3a\xf5\x84\x84\xbc\x06\x31\x37\x20\xf6\x95\x1d\x0c\x04\xc5\x01\x37\x3e\xbd\xb3\xf1\x5e\x80\xe6\x90\xeb\x25\x23\x65\x29\x9a\x83\xe5\x26\x19\x9f\x13\x26\x56\x5d\xd4\x16\xfb\xa0\x9e\x1e\xec\x88\xe9\x73\x77\x75\x0f\x68\x02\xca\x38\x5e\xc0\x7d\xa8\x62\x13\xe4\xc9\xee\x09\xbe\xb4\xce\xc4\xb7\x02\x95\x39\x5b\x25\xbc\x60\xa1\x76\xd3\x87\x09\x5d\x2d\x08\x03\xf3\xb8\x9d\x00\x5f\xb1\x8c\xcb\xdf\x23\x9c\x14\x74\x96\x5d\xac\x54\xde\xa8\xaa\x10\xe6\x83\x81\xa7\xfa\x90\xd1\xf2\x65\xd3\x3f\xcc\x4e\x7a\x53\x61\x6e\x42\x81\x47\x00\xc5\xd9\x59\xc4\x51\xa5\x4a\x4a\x7f\x24\xc6\xaa\x92\x63\xb7\xc6\x36\x17\x2b\xf0\x84\xc8\xa8\xec\xc2\x2c\x06\xd3\xba\x32\x86\xc9\xdf\x42\xc1\x14\x93\x20\x68\xd8\x84\xf9\xf5\x58\x23\xfb\x63\xbd\xde\x09\xc0\x3d\x7a\x3d\xde\x68\x67\x04\xea\x9f\x15\x71\x33\xea\x30\x78\x84\xaf\xe7\x26\xda\x19\xe9\xc8\xf8\xed\x51\x10\xcc\xfc\x8f\xe4\x06\x33\x64\x5c\xc2\x5a\x0f\x51\xa8\xf5\x14\x85\x0e\x06\xc4\xb3\x57\x87\x22\xcc\x20\x0d\x33\xc1\x2e\x54\x1e\xc2\x65\x44\xbd\x11\xc2\x49\xc4\xbc\x12\xe1\x55\x44\xbd\x31\xc2\xb3\x88\x79\x2b\x84\xd3\x88\x7a\xc1\x13\x84\x97\x11\xf3\x52\x84\xcf\xc5\xe7\x01\xc2\xf3\x88\x79\xe7\x08\x2f\x22\xea\x1d\x23\x7c\x11\x31\x6f\x81\xf0\x65\x44\xbd\x27\x08\xdf\x44\xcc\xbb\x44\xf8\x4c\x14\x3d\x44\xf8\x3a\x62\xde\x19\xc2\x57\x77\x28\xdd\x05\xd4\x38\x9f\x14\x1d\xd5\x7b\xd6\x55\xbd\x97\x91\x54\xbb\x67\xf0\xd2\x69\x92\x7c\x95\x4d\x92\xdd\x5d\x54\x4e\x13\x5b\xed\x9e\x9c\x1a\x95\x62\xc4\xa2\x58\x36\xec\x91\x88\xd7\x78\xb2\x5e\xab\xf9\xbd\x68\xe2\x13\x47\xc8\xd6\xd3\x93\x96\x9e\x1e\x5e\x02\x19\x9d\x80\xf4\x99\x0f\xaf\xec\xf0\x52\x50\x56\xc1\x2b\xad\x28\x0f\x47\xf8\x97\xe2\xbc\x84\xc7\x77\x79\x44\x71\xec\x31\x9c\x1b\x96\x2d\x07\xc9\x19\x67\xb5\x9c\x3c\x23\x3c\x99\xf7\x3d\xb8\xeb\xd3\xe3\x92\x0d\xda\x5b\xc3\x2a\x6a\xfd\x5d\x8f\xd9\xbb\xed\x90\xb8\x12\x2c\x63\xaf\x50\x35\xb1\x8c\xc0\x00\xb4\x1f\xdf\xbd\x1a\x0c\xe0\x97\xd7\x93\xb5\xeb\x9e\x88\xc1\x47\x4a\x92\xd3\x3e\xc9\x2e\x08\x12\x27\x23\xf5\xfa\x2e\xf2\x7c\x71\xca\x78\xa8\x6a\x17\x69\xdd\xcc\xd9\x13\x2c\x67\x96\xfb\xf0\x57\xce\x2f\xf7\xc5\x9f\x4a\x3e\xc6\xee\x11\xcf\x37\xe9\x1c\x00\x4e\x39\x9e\x5a\xda\x94\x2a\xb7\xb7\xf1\x45\x8f\x3c\xaf\xaa\xd4\x80\xc1\x6a\x13\x25\x24\x41\x4b\x96\xd8\x3c\x27\xc9\x47\x92\xf6\xb5\xd2\x7d\x17\xa9\x47\xe8\x67\x34\xc9\x57\x29\x29\x3d\xd2\x6a\xe9\x8e\x87\x98\x9d\x66\xe4\x2d\x0e\x41\x13\xfe\x75\x34\x3a\xe9\x2b\xa3\x5e\x47\x73\x1c\xa0\xb0\x2f\x1f\xec\xa0\xb5\x79\x4c\xcf\x62\xf4\xd4\xa9\x5a\x40\x3f\xcd\xf3\xad\x72\xfb\xa4\xaf\x5f\x65\x46\xa2\x81\xee\xc3\x82\x0a\x85\x9e\x55\x55\x2c\x7f\xeb\x31\x9e\xbc\x24\xef\x8e\x87\xa3\xea\x81\x23\xaa\x87\x94\x82\x0c\x71\xbf\x31\xe9\x7b\x2b\x55\xa3\x7f\xeb\xd8\xd9\x42\x7a\xe7\xf3\x22\x0d\xdd\x65\x51\x0a\x96\xab\xb5\x25\xc4\x80\x6a\xdc\xf4\x02\x54\xb5\x01\x7b\xaf\x40\xbe\xcb\xa4\x62\x7a\xda\x3b\xed\x8d\xf9\xa3\xa2\x3b\x1b\x48\x18\x80\x7a\x98\x2c\xc7\xd1\xc9\xdf\x75\x1f\xbb\xbb\xd4\x4f\x33\x92\x9e\xc5\x5c\x7d\x65\x69\x67\x60\x62\x05\xde\xb2\x62\x91\x95\xc4\x87\x2b\x88\xbe\xa1\xea\x6d\x69\xab\x81\x38\xbb\x79\xc8\xe4\xeb\x0a\xfd\x73\x6f\xe5\xfe\x9e\xa9\x87\x66\xfe\x4e\x33\x0f\x6d\x6f\x9e\x78\x9d\xfd\x77\x9f\xf7\x3b\xd5\x6f\xfa\x98\x4d\xb4\x40\xb0\xd9\x4f\xe7\xf6\x22\x96\x82\xcc\x1b\xe1\x1b\x5d\x16\x79\x17\xa6\xda\x32\xa6\x24\xc7\xad\xef\xe7\xaa\x5c\x75\xff\xf6\x5b\x2d\x7c\x47\xe2\x34\xa3\x17\x15\x76\x9f\x93\x38\x75\xfe\xb3\x38\x2f\x5d\xf4\xc9\xad\x7d\x53\xa4\x37\x5b\x60\x59\xaa\xc9\xb0\x90\x41\x1e\x67\xae\xf3\x4b\x71\xee\x95\xc8\x89\x19\x71\x52\x12\xa7\xfe\x16\x20\x96\x3a\x1d\xcb\x73\xa8\x75\xec\x62\x5e\xf0\x38\x7f\x26\x8f\xcb\x76\x47\x4b\xc2\x04\x2e\x87\xe3\x11\xfe\x65\xb5\x58\x7e\x28\xfa\xac\x44\xfa\x18\x07\x6b\x1f\x70\x54\x81\x15\xcb\x27\x4c\x12\xb0\xb7\xef\x48\xb9\x2c\x68\x99\x5d\x92\x2d\x53\x05\x25\xb7\xb5\xb2\xad\xee\x79\x91\xde\xdc\x85\x77\x9c\xdd\x59\x62\x7e\x57\x09\x65\xae\xa4\xfc\x72\xc3\xc9\x77\x5e\x5c\xbb\x58\xb1\x00\xbd\xa7\xab\x3e\xe5\x7a\xec\x92\xac\xe9\xd6\xa7\xa8\x32\x18\xba\x1b\x46\xf7\x8d\x74\xca\x76\x8f\x92\x4f\x35\xd3\x78\xbf\xe2\x52\xcd\x75\xaf\xa2\xcf\x33\x92\x3a\x4f\xe1\x81\xe6\x5d\xa7\xf4\x5d\x94\x43\x2c\x0f\xd0\x21\xee\x67\xe9\xb6\xb5\x4e\x7f\xef\x22\xa9\xc9\x26\xa9\xc7\xd1\x3d\x16\x45\x45\x2c\xb9\x07\x44\xda\x55\xc4\xa7\xc3\x7e\x5d\xef\xf4\x98\x5d\x08\x86\x57\xfc\xb9\x6f\xef\x84\xb1\xdf\xd3\xf9\xbc\xee\x9c\x8b\xae\x79\x25\xdf\x4f\xa3\x4f\xdb\xfa\xe7\x9c\xfe\x07\x2b\x56\x4b\xcc\x8a\x9c\x84\xae\x76\x6e\xb0\xb1\xa5\xf3\x15\xe7\x05\x35\xeb\xa6\x3f\xef\x3e\x28\xce\x39\xc5\x8d\x2f\x7d\x48\xf4\xba\x5f\xd6\x6b\xdb\x64\xa5\x40\x2e\x70\xa5\xee\xd8\xd1\x89\x77\x1e\x0e\xff\x60\x90\x1b\x2c\x88\x84\xf8\x9d\x48\xfa\xc3\x02\x6c\xd8\xde\xc6\xf4\x3e\xcd\xf3\x3f\x1a\xa0\x9a\x47\xb4\x27\xb5\x06\xb3\xb6\x31\xac\xe1\xb5\xee\x5c\xae\x80\x4d\x83\xcb\x88\xe8\x56\x0b\xc9\xe1\xcc\x14\x95\x2e\x8d\xb1\x61\x9f\x37\x65\x49\x1e\xb5\x9b\xab\xd9\xbf\x0d\x39\x1b\xaa\x55\x96\xd6\xf6\xea\x8b\xbe\xf6\x8b\xbe\xf6\x8b\xbe\xf6\x7f\x9f\xbe\xf6\xb8\xa1\xae\x7d\x62\x69\x6b\xff\xb7\xaa\x60\x7f\x5d\x91\x15\xb9\xbf\xa6\xf5\x3e\xda\xc1\x7e\xa5\xc2\x8a\xe5\x3d\xca\x84\x15\xcb\x7f\xbf\x96\x53\x8d\x81\x57\xf7\x92\xbe\xef\x25\x72\x37\xe5\xe9\x79\x7d\x32\x2e\x5b\xf2\xf4\xf2\x77\xcb\xd3\xcb\x4d\xf2\xb4\x1c\xd6\x43\x84\xe9\xe5\xef\x15\xa6\x65\x97\x1a\x53\x5d\x07\xbe\x85\x58\x7d\x95\xf1\xb9\x13\x3b\x20\x19\x3b\xc5\xcc\x51\x46\x4f\x90\x9f\x3e\x53\x22\x78\xc6\xc9\x42\x14\x96\xa9\xbe\xfb\x10\x3e\x77\xf9\x59\x44\xdc\xe5\x3f\x4d\xc4\x7d\x80\xf8\x08\xd3\x75\xbf\xa2\xaf\x62\x4e\x68\x72\xe3\x78\x25\x49\x0a\x9a\x96\xe8\x9e\xd5\x8a\xe4\xa3\xf3\x80\x6e\x5e\xc7\xd7\xce\xb3\x82\xca\xc8\xdc\xc9\x4d\x4b\xfc\x54\x48\xd1\x10\x40\xc9\x03\x04\x50\x10\x60\xc1\x4b\xed\x7d\xc4\xd0\xba\xf4\x7d\x64\x2f\xa5\x96\xb9\x5f\xd1\x5c\xce\xe6\x3d\x0b\x17\xc9\xc7\xb3\x07\x34\xbe\x88\xaf\xcf\x92\x7a\x0e\xb5\xa4\x67\xe8\x91\xb5\x55\x5c\x7c\x41\x78\x97\x62\x8e\x7a\xdc\x3e\xf6\xcd\xbd\xa0\x7e\xbb\x91\xba\xc8\xa9\x10\x26\x5b\xb9\xe9\x85\xcd\x4d\xaf\x58\xbe\x95\xb1\x5d\x7c\x61\x6c\xbf\x30\xb6\x5f\x18\xdb\xff\x7d\x8c\xed\x17\x43\x84\xad\x5c\xf0\xff\x52\xe3\x83\x8d\x3c\xf7\xdf\xd1\xe4\xe0\x5f\xc6\xcc\xe0\xb3\x5d\xcf\xfd\x11\xee\xde\xa4\x8a\xed\x9f\x7d\xf9\x56\x26\x73\x22\x88\x72\xea\xf0\x42\x9c\x49\x8c\x70\x96\x91\x2f\x77\x71\x7f\xa4\xbb\xb8\x3f\xc4\x3d\x97\xd2\x08\xdf\x7d\xd1\xf5\x30\x39\xe3\x7e\x17\x5d\xe4\xb3\x5f\x2b\x91\x07\x5c\x2b\x91\xcf\x7b\xad\x44\xec\x6b\xa5\xfb\xab\xd5\xef\x12\x04\xbe\x68\xb8\xbf\x08\x02\x5f\x04\x81\x2f\x82\xc0\x17\x41\xe0\x8b\x20\xf0\x45\x10\xf8\x17\x12\x04\xde\x1b\x16\xfc\x0f\x23\x0c\x7c\x61\xff\xff\x4f\xb0\xff\x35\xe6\x7d\x5b\xb0\xff\x83\x8c\xfd\x7d\xf9\x75\xb6\xa2\x67\xf1\x3f\x85\x69\xff\x24\x2e\x8a\x45\xd4\x3b\x0c\xd0\x84\xfa\xa9\x28\xfa\xae\x58\x71\x41\x2c\xbb\xfb\x8a\xf9\xb1\xaa\x52\x44\xd4\xdb\xdb\x33\x55\x5e\x65\xf4\x63\x5f\x85\xc2\x54\x88\x23\xea\x1d\xd5\x7d\xbc\xa4\x29\xb9\xde\x54\x2b\x36\xb5\x72\x51\x6b\x6c\x6a\x5d\x65\x7c\xbe\x19\xba\xdc\x54\xcb\x44\xb5\xfd\x66\x67\xef\x48\x9a\x31\xf0\x83\xda\xad\x99\x99\x9a\x82\xb7\x3a\x3a\x68\xd5\x14\x3d\xf6\x55\x2b\x4d\xb5\x44\x4c\x47\xdd\xe1\xb6\xbe\x12\x53\x49\x70\x6e\x47\x87\xcd\x69\xef\xab\xb1\x32\x35\x66\x62\xa1\x4c\x05\x89\x83\x50\xad\xec\xab\x37\xf3\xcf\x55\x3d\xc1\x15\x8e\xdb\x0b\x0c\x6e\x98\xaf\x7b\x61\x4c\x4d\x8f\x4b\x51\xb3\x5e\xe7\xbc\x48\xe0\x5d\xf7\xfb\x79\xbc\xec\x85\x75\x29\x6a\x62\x55\x9a\x41\x3f\x5b\xca\x6a\xf8\x04\x9b\x7a\x74\x64\x7a\x59\xc4\xc0\x65\x75\x6b\x9c\x1b\xb8\xe6\x62\xc2\x8f\x4d\x8d\x55\x29\xe7\x81\x7d\x97\x95\xbc\x60\x37\x7d\x95\xe7\xa6\xb2\x60\x83\x83\x7a\x3a\x66\x05\x5b\xc4\xfc\x6d\xcc\x39\x61\xb4\xaf\xe6\xc2\xd4\xbc\x10\x0c\x74\x8d\x8f\xc0\xdf\xc9\x8e\x5f\x67\x69\x9a\x93\xab\x98\xf5\x0e\xf5\xc2\xb4\x20\x98\xee\xe3\x7a\x42\xcf\x59\x71\x55\x6e\x05\xfb\xd2\x54\xbd\x11\x55\xeb\xe5\x9f\xc7\xe5\x7c\x4b\xbd\x1b\x53\x4f\x70\xf6\x7b\x07\x2d\xb4\x79\x4d\x16\x05\xbb\xd9\x52\xff\x4c\xd4\x7f\x80\x8a\xa0\x8e\x8b\x70\x5b\xd5\x9e\x01\xa5\x57\x13\x6e\xde\x5c\x31\xf4\x75\x34\x32\x6c\xbc\x11\x73\x5a\xe1\x3d\xa4\xfb\x2d\x82\x19\x1a\x0c\x3c\x3a\x65\xa7\x11\x99\xb2\x53\xe3\x3e\x87\x56\x9a\x04\x05\x2a\x18\xa8\x57\x20\xc1\xbf\x0b\xb1\x28\x83\xef\x5c\x8a\x49\xfb\x08\xa2\xd0\x52\x2d\x28\xed\x09\x41\xc9\x13\x09\x2b\x84\xa9\xb7\x37\x42\x4a\x64\xda\x13\x22\x13\x6c\x12\x90\x99\x0e\x85\xc8\x44\xbd\xbd\xb1\x90\x99\x3c\xea\x05\xb5\x68\x27\xbd\x7f\x34\x3d\x0d\x18\x27\x2e\x51\x30\xe1\x5f\xb5\xe5\x9f\x09\xd7\x12\x27\xb5\xc4\x1d\x7e\xda\x9c\x25\x8a\xee\x35\x29\x54\x4e\x8a\x98\x8f\x48\xcc\x8c\x11\x27\x48\x25\x04\xbb\xdb\xb9\x5c\xd1\x50\xfb\xc9\xc7\xc9\x3c\xcb\x53\x46\x68\x98\xfa\x29\x86\x1d\x59\xca\x9f\xc0\x12\x87\x2b\x5f\x0c\x04\x37\xce\x33\x9d\x58\x50\xd0\x47\xd4\x9f\xd2\x97\x8f\xfe\x86\x6d\xaa\xa8\x88\xe9\xaf\xc2\x97\x51\xe2\x21\x4f\x47\x4d\x95\x0e\x5d\x34\xd1\x36\x27\x5d\x78\x81\xc1\x53\x09\x9c\x6a\xd2\x73\x78\x07\x05\x6f\x15\x88\x3d\x0c\x45\xd6\xf1\x9c\xb2\xf4\x63\x4c\x04\xa7\x27\xda\x7d\x29\x15\x3d\x20\x18\xf4\xb4\xab\xa9\x98\xf4\x44\xa1\xe6\x04\x7e\x2f\x63\x16\x2f\xd4\x6f\x23\xb5\x94\xca\xcb\x1c\x9e\xc7\x34\xcd\xa5\x8e\xa6\x01\x54\x36\xf3\x76\x2c\x99\x4b\x4d\x9b\x52\xed\x90\x49\x37\xab\xe1\x5e\xae\xc2\x16\x21\x57\x91\x82\xb6\x86\x94\xf4\xed\x89\x87\x80\xd2\xc8\x88\x94\x92\xe4\x4e\xea\x00\x25\xaa\x8a\x42\x0b\xbd\x83\x54\x44\xd0\xb9\x7f\x8e\x3c\xed\xca\x9b\xc5\x54\xba\xb1\x7d\x1d\xd3\xf8\x82\x30\x0b\xb4\x0f\xed\xbc\x6d\x5e\x0d\x3b\xe0\x99\xb0\x26\xa4\xdb\xc9\xa4\x33\x38\x05\x29\xb6\xc1\x87\x98\xc0\x72\x9d\x20\x64\x93\xc6\xe9\xda\xf7\xcb\x05\xe1\xcf\xc0\x2c\x83\xbf\x52\x8b\xbb\x5e\xc7\x1e\xf2\x76\x02\xbd\x71\xbd\x99\x1f\x23\x8f\xeb\xaf\x73\x31\x74\xb6\x5e\x17\x48\x8c\xd2\xf6\xe2\x04\x12\xea\x36\x63\xb7\xce\x20\x24\xa4\x1b\xa6\xca\x53\x5c\xb2\x5c\x19\xbb\xa8\xbd\xe0\xd6\x8b\x4d\x55\xfe\x6c\x45\x73\x70\x02\x15\xf5\x77\xea\xcb\x5c\x3b\xc6\x22\x45\xb7\xfc\x44\x90\x0d\x83\xa6\x1e\x47\xa1\x67\x16\x3b\x46\x1e\x51\x70\x60\x0a\xbe\xb0\xb4\xf0\x4c\x31\x31\x18\x2a\x37\x39\x02\xf9\xbd\x31\x31\xef\x48\x42\xb2\x4b\xd2\xda\xad\x04\xdd\xb6\xca\x29\x37\x58\x1d\xe9\xde\x8c\x48\x79\xea\x32\xdf\x1e\xaa\x70\x7b\xaf\x6f\xf0\x60\xa5\xb7\x2e\x38\xae\x32\x18\x21\x24\x49\xb1\x71\x71\x0c\xa1\x00\xf4\xbe\xc5\xb9\x8d\x43\x59\x94\x37\x69\x06\x2e\xa3\x5c\xf9\x81\x07\x65\x5a\x8e\xa7\x6e\xa3\x80\x8b\xb5\xe2\xc0\x3a\x7b\x56\x79\x1e\x45\x5c\x46\x79\xf0\x6c\x77\xb1\x17\xa8\x1b\x00\xab\xa6\x58\xd2\x5e\xdd\x49\xa6\xe4\xb4\x42\xb8\xf4\x16\x9e\x98\x36\x7c\x2b\x97\x23\xb4\x50\x04\x1b\xf2\xc4\x0d\x6d\xd2\x84\xa9\xb0\xa9\x52\xdc\xa2\xda\x19\x08\x1c\x15\x9a\x70\x3f\x8e\x2e\x1f\x74\x74\x83\x36\xaf\xc0\x31\xce\x71\x06\xf4\xac\xf4\x38\xc2\x3b\x8a\xf8\x24\x62\x17\x5f\x16\x59\x0a\x31\xe1\x38\x4a\xc0\xb9\xaf\xd2\x55\xbf\xce\x68\x36\xcb\x48\xea\x90\xeb\x84\x2c\xa5\xf6\x3f\x01\x13\xa9\x74\xe2\x88\x1e\xf9\x9c\x38\xb4\xa0\xc3\x85\x2e\x98\x92\x4b\x87\xd0\xcb\x8c\x15\x54\xc0\xed\xcc\x0a\x06\x85\x66\xab\x3c\x97\xae\x5c\x1c\xe5\x86\xc5\x89\x69\xea\xc4\x69\x0a\x78\x1f\xe7\xce\x9c\xe4\xcb\xd9\x2a\x77\xae\x62\x46\x33\x7a\x51\xfa\xae\x0c\xb5\x75\x2b\xd9\xe9\xa9\x35\x8a\x53\x3c\x8b\x46\x13\x1b\x52\xee\x33\x02\x9e\xaf\xbd\xc7\x7f\x2a\x1f\x5f\xf4\x31\xda\xd3\xd9\xee\xee\xa9\x14\x05\xa5\x73\x98\x97\x14\xdc\x3b\x52\xee\xfc\x94\x15\x39\xac\x8a\x5b\x49\xba\x9e\xf8\x33\x16\x2f\x48\xf9\xa1\x78\x5b\x2c\xa3\x00\x27\xcd\xeb\x8f\x5a\x17\xd1\xba\xb7\x10\x2b\xd1\xbe\xc9\x90\xe4\xfa\xb2\x1d\xe4\x8c\xa3\x93\xcb\x29\x3f\x85\xe3\x67\xf2\xb1\x9b\x3b\x18\x30\xcf\xfd\xe1\xa7\x17\xef\xde\xbd\x7c\xfe\xe2\xec\x9b\xa7\xef\x5f\x40\xa4\x31\x21\x7f\xc4\x09\x7f\x96\xc7\x65\xf9\x92\x72\xc2\x66\x71\x42\x42\xe7\xaf\xc5\x0a\x9e\x4e\x0a\x3e\x77\xb1\xd4\x0e\x7b\x8a\x4b\xc2\x58\x96\x12\xe7\x6f\x7f\x2a\xff\xe6\xcc\x58\xb1\x70\x6e\x8a\x15\x53\x57\x30\xe5\x92\x24\xd9\x2c\x93\xf8\xe8\x3b\x2f\x68\xb9\x62\x62\x45\x63\x2e\x4b\xc9\x57\xb4\xe0\x29\xa8\x74\xd2\x02\x2e\x21\x44\x8b\x79\xbc\x94\xf6\xa4\x00\x89\x2a\x56\xfa\x2e\xe6\x08\x13\x00\xfb\xf9\x8b\x6f\x5f\xbe\x79\x71\xf6\xfa\xe9\x9b\xbf\x02\xd0\xeb\xb5\x9d\x74\xf6\xfa\xc5\xbb\xff\x78\xf1\xfc\xe1\xc3\x91\x82\xaf\x1c\x4c\x41\xd5\x50\xf4\x96\x71\x16\x85\x84\x9e\x3a\x05\x4d\x88\xef\x7c\x98\x67\xa5\x93\x14\x74\x96\x67\x02\xca\xf8\xc6\x39\x27\x4e\xba\x22\xa2\xa5\xd8\x59\x64\xd7\x19\x05\x98\xeb\x25\x2c\x8d\xdf\x34\x8a\x6e\x59\xdf\xe5\x4e\x03\x5e\x00\xf3\xcf\x1d\x28\xc5\xb6\x88\x2d\xb8\xe4\x64\x17\xcc\xa9\x11\xa3\x34\x00\x38\x2f\x69\xc9\x49\x9c\x62\xe7\x97\x55\xc9\x55\x5d\x46\x2e\x56\x79\xcc\x8c\x1f\x26\x84\x99\xb7\xc3\x3d\x8a\x3e\xa1\xf7\x07\xf7\xa5\x34\x00\xd6\x0d\x13\x44\x2a\x3c\x3b\x03\xbf\x4c\x4f\x57\xbc\xf8\x26\xa3\xe9\xdb\x38\x63\xe5\x84\xb6\xd1\x36\x41\x83\xc1\x99\x0f\xdd\xc1\x64\xaa\x9f\xd6\x05\x82\xe4\x86\xc5\x14\xb7\xeb\x66\x68\x30\xc8\x76\xa2\x28\x91\x5b\xa6\x8c\xe8\x34\x3b\xc5\xab\xa8\xe8\x16\x14\x24\x2b\xf6\x56\x38\x43\xf8\xac\x9b\x8b\xce\xa6\xd9\xa9\x47\x70\x69\xd1\x8f\x59\x77\x03\x66\x42\x1a\xa8\xd7\x38\xd2\x6b\x5c\xe2\x79\x74\x3e\x18\xec\xcc\x06\x83\x9d\xd5\x60\x00\xee\x28\xa9\x1f\xaf\x78\x71\x9e\xd1\x54\x74\x3d\x47\xb9\x7c\x96\x9e\xe1\x12\x61\x70\x79\x5e\x9a\xa8\x80\x2b\x09\xfe\x22\xba\x04\xef\xe7\xde\x6c\x30\xf0\x36\x60\xff\xa2\xb9\x2f\x20\xa9\xb5\xc6\x3f\x52\x72\xbd\x94\x6f\xd9\xc4\x76\x75\x96\x45\x9e\x25\x37\xce\x9f\x4a\x20\xac\x1f\x09\xfc\xbc\x9a\x13\x2a\xd7\xf8\x42\x4c\x6f\xbd\xf8\xa2\x8a\xd8\x98\x0b\x31\x53\x9b\x80\x38\x81\x01\xa4\x9e\xf8\x83\x4b\x14\x76\x40\x1a\x0c\x20\x2f\x5a\xea\x22\x2a\x60\xa0\x1c\x78\x55\xc1\xd7\xa4\xde\x46\x2b\x73\x3d\xca\x51\x23\xde\xbb\x22\x86\x85\xbc\x9a\x13\xf9\xed\x35\xa1\x48\x16\x89\x23\xa8\x70\x36\x61\xde\x4e\x8c\xff\xdc\xc2\xfa\xcd\xa4\x41\xe0\x73\x49\xd8\x25\x49\x8d\x7f\x2f\x0c\xf4\x02\x4b\xba\x26\x7d\xf5\xd1\x9f\xe1\x4a\x58\xd0\x10\x71\x34\xb9\x82\x03\xc9\x92\xd2\x15\xf3\xe9\x3b\xcf\x65\x53\x99\xdc\x3b\xd4\x5c\x70\xd7\x1e\xc3\x32\xb9\x8f\x26\xa2\xcc\x55\x96\xe7\x4e\xc9\xc5\xbf\xe7\xc4\x89\x93\x84\x94\x65\x76\x9e\x9b\xc6\xad\xfb\x57\xff\xcf\x98\x1a\xcd\x17\xc8\xce\x62\x0e\x72\x39\xe2\x2c\xba\xe9\x4e\xc6\xc9\xcd\x94\xaa\x13\x42\x2b\xe9\x36\xe1\x52\xd6\xa1\x0d\xff\x20\x02\x4a\x11\x16\xdc\x83\x47\xa6\x54\x60\x91\xf8\x83\x05\xdf\x0d\xdf\x45\x65\x1d\x9c\xf2\x6a\xf8\x96\x79\x64\x30\xe0\x83\x41\xed\x26\x4f\x6d\x3c\xd2\x93\xc6\xb1\xbb\x20\xec\x82\xbc\xa4\xbc\xf8\x4b\xc6\xe7\x6f\x8a\xe7\xab\x65\x2e\xce\x2c\xf2\xbd\xe0\xcb\x50\xe8\x28\xeb\x02\x28\x06\xbc\x88\x6c\x03\xf8\x87\x16\xfa\xf5\xe0\xdb\x60\xe0\xb1\x9a\xfd\x01\xe8\xef\xec\xf1\x03\xcb\xa4\x89\x94\xec\x93\x5f\x15\x8a\x7c\x96\xf2\x5c\x14\xeb\x5e\xc6\x0b\x22\xf0\x29\x84\xd9\xbe\xd7\x64\x4e\x04\x90\xcb\x98\xf1\x2c\x11\x24\x19\x83\x1d\xb9\x2e\x9a\xc4\xab\x92\xa4\xce\xf9\x0d\x74\xd7\x12\x83\x3d\x24\xce\x97\x96\xcc\xed\x21\x7d\x34\x3b\x12\x79\x04\x12\x34\xe0\x14\x27\xd3\x5c\xa4\x0a\x16\x57\x2e\x25\xac\x9a\xd8\x9e\x86\x27\xb6\x58\x9c\x54\x2d\x60\xe7\x66\x46\x47\x46\xe8\xbd\x12\x85\xa0\x35\xbd\x39\x02\xfd\x25\xcb\x4d\xb5\x14\xc9\xea\x34\xa6\xd3\xa8\x3a\x92\xea\x00\x80\x33\xaf\x10\xc0\x8a\x3f\x0c\xe1\xc2\xc2\xb1\xe5\x26\x10\x37\x01\xb7\x01\x34\xab\xc9\xf3\x46\x58\x50\x5f\x1c\x02\x56\x6c\x52\x5a\x17\x9c\x37\xb5\x45\xa4\xf7\xcc\xc4\x1d\x83\x85\x68\x6c\x5b\x2c\x60\xa0\x8e\xbb\xc1\xe9\x04\xb4\x40\xa2\xf3\xc2\x86\x66\x51\x2b\x0d\x0a\x4b\x14\x11\x9c\xb1\x96\xc4\x7a\x7a\x55\xbd\x0d\x06\xf2\xfe\x18\x59\xce\x6d\x23\x22\x3f\x12\x29\xd3\x47\x85\x92\x68\xc9\xac\x8c\x32\xf9\x5b\xde\xb7\xb1\x28\x5e\xaf\xa9\x75\x2b\x14\x01\x61\x92\xf4\x4c\x7b\x3f\xb6\xb1\xf2\xa4\x2f\xd1\x43\x8a\x9e\x79\x9d\xbd\x9e\x0f\x06\x3b\xcd\xf8\x5b\x39\xc2\xee\x9f\xfa\x9a\x90\x36\x33\xfa\x46\x41\xbb\x6b\x14\x9b\x40\x34\xee\x62\xee\x5b\x1a\xaa\xf5\x5a\x91\x46\x41\xe9\xca\x8c\xd7\xfe\x61\x5d\xfb\x92\x2b\xca\x41\xba\xaa\x0d\x6b\x40\xba\xc0\xb6\xce\xce\x22\xe6\x11\x6f\xe4\xf4\x4d\x7a\x34\x3d\xc5\x17\x46\x6c\x2c\x25\xe6\xc8\x4b\x57\x24\xa4\x44\x8e\xaf\xe5\x1f\x22\xff\x5c\x21\x19\x7d\xcf\xde\xc4\x83\x81\xd7\x74\x40\x1e\x75\x8a\x78\x48\xf0\x87\x36\x30\x4a\xec\x55\xf2\x2e\x9c\x08\x9e\xef\xfb\x82\x56\x76\x19\x7f\x39\x95\xd9\x62\x29\x05\x4d\x27\x76\xfe\x26\xeb\xff\x4d\x91\x0f\x9b\x8e\xc6\x82\x44\x5d\x22\xab\xb3\x69\x7c\xba\x5e\x7b\xcd\x84\x48\x46\x9b\x35\xd1\xc0\xa4\x72\x7d\x7a\x8a\x2f\xa3\x5b\xc9\x10\x36\x99\x0c\xac\x0e\xe0\x56\x6a\xad\x4f\x6c\xa6\x2b\x4c\xed\xcd\x9a\x67\x79\xfa\x6c\x73\x7e\x5b\x2d\xd9\x77\x98\x76\x74\x8c\x9b\x0a\x3d\xb3\x3a\xeb\x2f\xa4\xf4\x1e\x3a\xef\x87\x37\xcf\x5e\xb8\x7d\xfa\xa8\xf6\xf8\x5a\x16\x15\x9b\xf2\x3b\x7a\x9b\xd6\xac\x2a\xc7\xc4\xaa\xb4\xd2\xef\x6e\x01\xa6\x55\xa2\x0b\xcd\xf6\x02\xb6\x7a\xa8\x59\xe4\xc7\x37\xef\x9f\x7e\xfb\xe2\xec\xce\x91\xf7\x95\xdb\x32\xc0\xbe\xe2\xbd\x20\x4a\x02\x66\x66\x22\x6c\xc9\xd5\x15\xbe\x89\x6e\x37\xf9\xd6\xee\x5d\xd9\x0a\x9f\x45\x0d\x25\xb8\xad\x8e\x11\x87\x8d\x95\x17\xf1\x0a\x2b\xac\x6f\x96\x6a\x32\xc8\x5d\x3b\x36\x21\x41\xc1\x51\x5c\xf5\xe0\x75\xbb\xc3\x4e\x89\x28\x97\x71\x5f\x3b\x19\xe0\xc7\x36\xd9\xda\x56\x5f\x33\xed\x16\x36\x6a\xf8\x55\x23\xad\xfc\x93\x4e\x8a\x60\x16\xdb\x69\xd2\x74\xb0\x5d\x90\x57\x16\x2d\x68\xf7\x53\xdf\xaf\x2b\x48\x4d\x02\x80\xa9\x49\x4b\xb3\x9a\x14\x51\x2a\xac\xa5\x3a\x5b\x35\x59\x55\xf8\x3a\xba\xed\x6e\xc2\x8e\xc6\xf3\x2c\x2b\x21\x87\xa4\xd1\xce\xa8\xaa\xf0\x95\x55\x6b\xab\xaa\xd4\xae\x18\x54\x15\xfe\x18\xdd\x2a\xed\x55\xeb\x52\x03\x60\xb5\x8f\x60\x9f\x50\x78\x51\xf8\xce\x2a\xae\xae\x1a\x60\x3c\xa6\xe1\xee\xc5\xc8\xce\x4e\xbb\xf3\xaa\xc2\x2f\x6c\xeb\xb4\xaa\x0e\x97\xf3\xc2\x92\xfe\x6d\x4d\xc0\x47\x84\x17\x95\xbe\xbb\x0f\xf6\x11\xce\xa2\xdb\x0a\x97\x51\x4b\x61\x1c\xb9\x12\xe1\xdd\x09\xf1\xc9\xf5\xb2\x60\xbc\x8c\xe2\xfb\xeb\x2c\xd1\xad\xad\x6d\x43\xb7\x95\xb6\x31\x38\xdc\x47\x93\x42\x46\x37\xf9\x8b\xd4\x12\x3e\x8b\x93\x39\x89\x18\xae\xfb\xd9\x68\x33\xaa\xd4\xa0\x39\x6c\xbc\x7c\x27\x8a\x62\x2d\x79\x59\x0a\xcf\x67\x71\x9e\x0b\x8e\xf8\xad\x46\x22\x47\x39\x2d\x2f\x58\xe9\xc8\x7b\xff\xfc\xc6\xc9\x4a\x50\x86\x95\xab\xa5\xe8\x53\xb1\xe5\x73\xe2\xfc\x4d\xe0\xde\x50\xcc\x54\xf9\x37\x67\x19\x27\x1f\xe3\x0b\xe2\x3b\x3f\x96\xa4\x6e\x4f\xfa\x50\x32\x9f\x1e\x12\x02\x00\x58\x46\xf3\x39\x59\xf8\xce\x3b\x12\xa7\x52\x12\x8b\xb9\x33\xe7\x7c\x19\x3e\x7e\x3c\x3b\xf7\x17\xe4\xf1\xaa\x24\x43\xa8\x3c\xac\x7b\x71\xd1\x44\x2a\x2c\xb3\x2d\x3a\x4d\x9c\x59\xfc\xa3\x6d\xc2\x58\x11\x3f\x2b\xdf\x91\x5f\x57\x19\x23\x69\x44\xd4\xc5\xd2\x2d\xf8\xe8\x0e\x09\x3e\x2f\x8a\x3c\x24\xb0\x6a\x21\xc1\x74\xb5\x38\x27\x2c\x24\x2a\x8a\x6d\x48\xb0\x34\x60\x11\x3f\x6e\x16\xe7\x50\x34\xa6\xa2\x1e\xd4\xff\x61\x16\x72\x4c\x94\xf2\x9a\xe8\x5f\x62\xcc\x21\xc1\x5a\xa4\x86\x42\xb4\x48\x89\x69\x16\x52\x0a\x4a\xea\xbf\x50\x85\xe3\x72\x1e\xc3\x5f\x72\x1d\x27\x3c\xe4\xb8\x39\x8d\x61\x81\x3b\x58\x11\x32\x83\xd0\xd4\x37\x25\x23\x8a\x69\x75\x07\x2a\xd6\xc8\xe4\xbe\x7f\xf1\xec\xdd\x8b\x0f\x67\xcf\x7f\x38\x7b\xf3\xc3\x87\xb3\xb7\x4f\xdf\xbf\x3f\xfb\xf0\xdd\xcb\xf7\x67\x3f\xbc\x3b\xfb\xeb\x0f\x3f\x9e\xfd\xe5\xe5\xab\x57\x67\xdf\xbc\x38\xfb\xf6\xe5\x3b\x38\x15\x1e\xa4\x95\xaf\x77\x26\xf1\x97\xb1\xe0\xce\xd5\x65\x4e\xec\x9f\x23\x4f\xa6\x21\xbf\x2c\x16\xa4\xef\xce\x81\xab\x6b\x91\x29\x39\x95\x61\x1b\xf4\x57\x85\x3a\xa6\xfa\x4a\x3e\x1b\x0c\xac\x6b\x15\xae\x7f\xc6\x91\x14\x7f\x71\xae\x7f\x64\xea\xc7\x44\x6a\x42\xa5\x36\x6e\x27\x98\xc4\x11\xf5\x67\x59\xce\x09\x6b\x78\x6d\xcc\x66\x5e\xa9\x3d\xd7\x8f\x94\x3d\x10\xc4\xdb\x2b\x8c\x65\x00\x45\xeb\x35\xf3\x28\x50\x29\xbd\x28\xf1\x60\xe0\x95\x60\x57\x1d\x57\x08\xc7\x3e\x23\x97\x84\x95\xc4\x13\x44\x65\x7a\x8a\x73\xf1\x4f\xd1\x7b\xeb\x22\xc5\x1f\xe8\x82\x5a\x0e\x5f\x31\x8b\x86\x81\xd8\xd7\x0d\x27\xb0\xeb\x35\x3b\xc9\xb4\x4b\xd7\x30\xd7\xbf\x2a\xa5\xc4\x8a\x4d\x5f\x59\x54\xe8\xc8\xce\x39\x89\x2f\x95\xed\x4d\x18\xe3\x04\x1c\xa2\xa9\xcf\x1c\x13\xca\x09\x53\x5f\x59\x65\x68\x53\x10\xc8\xbb\x99\xe2\x81\x66\x15\x7f\xa7\x67\x14\x45\x2f\xfd\xd3\x0a\x38\xa2\xf8\x8c\xaf\x28\x8e\x1b\x14\xd3\x70\x23\x1d\x3b\x6f\xa6\xec\xbc\xa9\x8e\xd3\x4c\x21\x4e\x33\xeb\xc4\x69\x86\xb0\x23\x4a\xa8\x06\xf1\x1c\xdd\x7a\x23\xcc\xa6\x4c\xb5\x34\x0c\x4e\x91\x87\x2a\xb3\x39\x21\xd2\x95\x17\x0b\x34\xb0\xa8\x14\xb7\x10\x9d\x91\x74\x95\x90\xd6\x65\xa8\xd9\xda\x05\x85\x60\xa4\xc6\x57\x28\xf1\x4c\x1a\xa6\x78\x0f\x27\x08\x61\x5e\xe1\xe9\xa9\x35\x3b\xf4\x41\xed\x4b\x8f\x78\xad\x0e\x64\x22\xa6\x78\x1f\xaf\x7a\x7a\x60\x9d\xeb\x20\x78\x69\x53\x44\xa4\x92\x4f\x5b\xb4\x05\x90\xd8\x69\xd4\xd3\x6a\x77\xb5\xf1\x0c\x21\x88\x91\x47\x6c\x7c\xa2\x20\xe6\x7b\x04\x33\x6c\xef\x08\xb2\x5e\x17\x27\x31\xe8\x0a\x42\x30\xed\xae\x30\x6d\x91\x01\x2a\x16\x22\xf1\x21\xba\x9e\xa7\xcd\xf6\xb8\xa5\xc9\x60\x5e\xac\xd7\xba\x81\xc0\x06\x69\x2c\x3c\x49\xfc\x79\x5c\x7a\xf1\x94\x9c\x82\x02\x4d\x2d\xb7\x22\x1f\x96\x76\x25\x51\x11\x8f\x64\x51\x54\x4d\xc4\x5f\x8f\x62\x8e\x0b\x54\x89\xff\x0c\x88\x19\xf4\x05\x5a\x8c\x55\x03\x48\xc1\x69\xd8\x40\xe6\x3d\x40\x1a\xcc\x6e\x20\xf3\x0a\x80\xcc\xef\x04\x72\xa5\x81\xcc\x15\x90\xe2\xaf\x27\x80\x11\xb3\x5d\x89\xff\x1a\x57\x44\xcd\xd7\x27\x10\x4f\x49\x73\xed\x5f\xb1\xc9\xee\x2e\x45\x64\x4a\x4f\xfd\x82\xbe\x12\x64\x64\x30\xb0\xbf\x94\xf1\xd3\x94\x9e\x0a\x7e\x4d\x59\x17\x92\x2b\x27\xc7\x2b\xf9\x57\x93\x20\xb6\x92\x28\xfc\x5d\x51\x7c\x84\xe3\x6d\xa5\x30\x4e\x26\x64\x22\x01\x5a\x94\xdf\x65\x55\x01\xf1\x31\xe6\x98\xe3\x11\xc2\xb9\x1d\xfa\xdd\x36\x35\x99\xb0\x66\x08\xf8\xb9\x68\x43\xd0\x40\xf8\x8a\xd3\x34\xea\x3d\x70\xa0\x98\x21\xa0\x5a\x4f\x24\xa6\x6e\x4b\xf9\x48\xd7\x6b\x9f\x1c\xd6\x0e\xdc\x89\x22\x08\x38\x2c\x81\x89\xcb\x9e\xe6\x86\x32\x2a\x98\x6c\xaa\xa6\xef\xaa\x0e\x20\x4c\xcf\x4b\x0b\x6e\x86\x56\xdd\x75\xe8\xf7\xd0\x65\x12\x45\xbc\x3e\xd8\x8c\x62\x92\xac\xd7\xca\x50\xa0\x8e\xd7\x92\xcd\xbc\xa6\xea\xca\x04\x73\x69\x87\x94\x47\xe2\x14\x96\xf8\x02\x21\x92\xb5\x66\x8e\xf8\xe2\xfc\xbb\xb1\x75\x7a\x35\x15\x62\x46\x14\x44\xad\x08\x33\x96\xee\xfa\xc4\x5d\x51\xa9\xe1\x4f\xdd\x30\x11\x10\x58\x88\x2a\x6d\xf5\xb2\x99\x77\x4f\xeb\x3c\x8a\x90\x6d\x17\x20\x30\x16\xe6\x44\xa6\x88\xb5\x10\x29\x7a\xfc\x70\x96\xc2\x51\x76\xaf\xe6\xc5\xec\x37\xe6\x6e\x87\xa9\x3d\x21\x1a\xad\x5b\xd5\x73\xaf\xcd\xe0\xd4\xa3\x19\x82\xa2\x28\x52\xbf\x79\x97\xdb\x91\x85\xdd\xc7\x2e\x60\x4c\x32\x8f\xd9\x53\xee\x8d\x04\x0d\xe0\x91\xfb\xd8\xdd\xe5\x08\xcb\x4c\xa2\x33\x89\x39\x9a\xc0\xfc\x6e\x57\x14\xd3\x85\x4c\x0b\xbc\x51\x88\xab\x42\x5c\x4c\x4e\x9f\x15\x80\x09\x9f\x4c\x70\x21\xb6\x96\x62\x34\x04\x77\x65\xa4\xfc\xfc\xab\x6c\xb2\xbb\xab\x6e\x80\x92\x88\x4f\xf3\x53\x88\xa1\x29\x38\xbf\xf5\xda\x75\x61\xad\x1f\x8b\x65\x5e\x35\xc6\xd1\x68\x14\x61\xf5\xde\x90\x0d\x06\xea\xf2\x71\xa6\x1e\x05\x7a\xa5\x9f\x20\x6f\x85\x19\x60\xcd\xec\xc4\x63\xd1\x4c\xec\xd8\x38\x13\x5c\xf2\xdb\x98\xcf\x55\x74\xd0\x69\x1d\x88\x19\xcf\x24\x2b\xf9\x26\x5e\x90\x12\x41\x0f\x3a\x2f\xd6\x79\x10\xea\xa9\x44\x28\x64\x91\x7c\x3b\x20\x40\x34\xfa\xfa\xa2\x8b\xc9\xf5\x5e\x57\xcb\x16\x4f\xf9\xa9\xb5\x8a\x54\x50\xde\xca\x3c\xa0\xdb\x09\x3a\x8f\x2d\x7b\x2c\x74\xa2\x48\x86\x9b\x82\x1d\x29\x77\x6d\xfb\x3c\x11\x22\x5f\xa6\x39\x58\xc9\x51\xc3\x88\x93\x08\x5c\xf3\xd4\x36\x73\x72\x0a\xe9\x60\xe0\xc9\x55\x2f\x1b\xf3\x5d\x02\xde\x94\x08\xef\xec\x78\x85\x57\x62\x6a\x5a\x42\xeb\xf5\x0e\x1f\x0c\x62\xaf\x84\x9e\xd0\x60\x90\x7b\x09\xa6\xb2\x71\x84\x80\x28\x67\xc6\xf8\x3c\x08\x90\x10\x8e\xbb\x77\xce\xef\x41\x82\x1a\x0c\x5c\x29\x4a\xb5\x33\xfc\x8c\x13\x26\x64\xd0\x93\x3e\x3a\xab\x6e\xcf\xaa\x0d\x11\xe3\xb7\x74\xd7\xd4\x68\x47\x91\x49\xdf\xd1\xbf\xeb\x8d\x7c\xa2\x61\x0b\x4d\x87\x0f\x96\x77\xe0\xca\xd7\xe8\x48\xd6\x6b\xeb\xa3\x6c\x31\x44\xca\x85\xef\xa6\xc2\xea\x69\x83\xb4\x0d\xb4\xcb\xd8\xdf\xa5\xc0\x7a\xc3\xbe\x68\xc3\x4c\x2c\x88\x9b\xe6\xb0\x72\xc1\x61\x15\x68\x30\x28\x36\xbe\x97\x53\xb0\x10\xc9\x53\x81\xd0\x20\x58\xac\x36\xd5\x69\xca\x6e\x4a\xb6\xb2\x59\x4a\xc1\x7f\xc9\x99\x10\xbc\x8f\x3a\xfd\xeb\xa3\x9a\x7a\x7b\x9f\x24\x41\x48\xb5\x86\x8a\xf6\x07\xca\x3e\x29\x9b\xa8\xf9\x9c\x6a\x37\x5b\x75\x0e\xb0\xe8\x3b\xa0\x66\x7b\xd6\x2d\x7f\xaa\x58\x88\x9d\x5a\x0e\xc4\x65\x74\x6b\xd9\xad\x29\x73\x35\x69\xae\x5c\xc1\x5e\x6a\x36\xe5\x95\xb8\xab\xe9\x8c\x76\x88\x11\x70\x53\x31\x49\x1c\xe1\xd8\x5e\x74\x2f\x8b\xa6\x04\xf3\x53\x34\x91\x33\x5a\xa1\x96\x41\xeb\x4a\xac\x55\x82\x06\x83\x64\xe3\x5a\x15\x72\xad\xec\x6e\x08\x02\x4e\x13\xc7\xd1\x4e\x80\xb3\xd6\xb2\x09\x0e\x4f\x19\xc5\x11\xc9\x55\xc0\x08\x50\xa6\xd6\xdc\x4e\x33\x16\x1e\x44\x5e\x48\x99\x0c\x45\x5e\x36\x4c\x91\xe0\x20\x67\x51\xab\x4e\x77\x86\x3c\x82\xbb\x13\x34\x1d\xc1\x89\x6f\x8f\x7f\x86\x06\x83\xd9\xc6\xf1\x67\x7d\xe3\x87\x56\x70\xa6\x70\xb7\x8d\x27\xeb\x75\x07\x13\x6e\xe5\xe3\x10\x0b\x42\x26\xa7\xa8\xb1\x47\x33\x8f\x68\x0e\x9d\x75\x98\x3b\x03\x92\xd2\x6d\xd4\xa3\x48\xc4\x28\xfa\x98\x78\xa0\xd4\x05\x30\xe1\x6a\x61\x9a\x02\x99\x40\xa2\xf5\x9a\xea\xed\x3c\x15\x05\xcd\xd1\xd4\x64\xb2\x28\x3a\xa1\x21\x5c\x62\x67\x1e\x6c\x37\x18\x39\xf3\x3a\x91\xda\xc4\xc4\xab\x1b\x39\x91\x75\x1e\x31\x83\x15\x4b\x34\x39\x1f\x0c\x96\x4d\xa9\xe1\x5c\x4f\xa2\xd7\xf6\x58\x60\x31\x6b\x5d\x81\xb2\x21\x46\xd1\xc1\x80\x4e\x99\x79\x0f\xdd\x62\x1b\xc5\xb9\x78\x22\xfe\x95\x7c\x76\x81\x42\xf1\x11\xc9\x08\xb2\x27\x53\xf1\x81\x8b\xd3\xb0\xc0\xa4\x12\x24\x69\xc3\x31\x29\x43\xd6\xf5\x1e\x88\x31\xce\xb0\x32\xb1\x5a\xa9\x23\x71\x1b\xab\x41\x23\x5e\x1f\x9b\xb1\xd4\x93\x58\x3c\x07\x05\x9e\x83\xb3\x9b\x5b\xf9\x14\xc9\x98\x63\x03\xdf\x41\xd1\x24\x3d\xf1\x68\xf4\xff\xb3\x77\xad\x4d\x6e\xdb\x56\xfb\xaf\x58\xcc\xfb\xb2\x40\x84\xd0\xd4\xae\xd7\x76\x29\xc3\x9a\x24\xcd\x6d\x9a\x36\xae\x27\x9e\x7c\xd0\xaa\x19\x8a\x82\x96\x6c\xb8\x80\x06\xa4\xec\x78\x24\xfe\xf7\x0e\x0e\x00\x12\x24\xa1\xbd\xb8\x71\xbb\x99\xf6\x93\x24\x8a\x04\x49\xe0\xe0\xe0\x39\x97\xe7\x60\xe3\x01\x1d\x7d\x60\xb1\xe9\x81\x8e\xc2\xf9\xaf\xb0\xff\xb5\xa0\x83\x03\xe8\x68\x32\xd8\xb1\x9e\xe1\x83\x82\xba\xca\xa8\x06\x14\xc2\xad\xf4\x1e\x4c\x7a\xad\x12\xa7\x76\x3a\xaa\xc9\xde\x3a\x1e\x60\x4c\xb5\x1a\x50\x9d\x32\x52\x59\x0c\xab\x96\xe7\x16\xda\x0e\x71\xbc\x71\xab\xcd\x11\xa7\x3b\xa3\xef\x31\x0c\x9a\x11\x19\xae\xba\x1f\xa4\xa5\x56\x72\xa4\xcf\xb0\x7b\xf1\xcc\x33\x2d\x77\x3b\xdc\x28\xd8\x63\x6c\x8b\x09\xe5\x6a\x3a\x66\xc3\xb9\xb8\xee\xe6\x22\xe8\x7a\xb9\xc8\x90\xc4\x89\x58\x54\x48\x90\x9a\x0c\xb3\xc4\x33\x54\xe3\x84\x2f\x90\x4d\xab\x8f\xf6\xbc\xca\x8b\xad\x32\xf6\x89\xb9\x2f\xc7\x38\xc9\x90\x5a\xd2\x40\x01\xea\xef\xb9\x11\x7f\xd5\x1b\x6b\x3c\xcf\xc3\x70\xdd\x17\xff\xdc\xbc\x50\x86\x86\x26\xb1\xeb\x5c\x1a\xba\x8e\x5e\x3e\x09\xc3\xd6\x6c\xe8\x5c\x45\x4f\x56\x0b\xf7\x47\x02\x70\x76\x74\xed\x85\xf7\xda\x0b\xf7\xda\x0b\x75\xed\xbc\x35\x55\x64\x0b\xe2\x3a\xc9\x1d\x58\x01\x3b\xd8\xdf\x97\x1c\xec\xdf\x09\x98\x05\xed\xd9\x0d\xd6\x49\x2b\x2d\xc8\x23\xae\xee\x62\x23\xdd\xc5\x49\x49\x2a\xd0\xe1\x60\xc3\x98\x3c\xe5\x41\xa0\xea\x78\xac\x17\xda\x7b\x90\x94\xad\x87\x46\x2d\xf7\x55\x4b\x32\x54\x40\x60\xaf\x81\x00\xd9\x1a\xb8\xb8\xb1\x0c\x25\x8e\x9e\x62\x4c\x76\xf4\xc1\x33\x95\x3e\x94\x56\xd6\xf2\x48\x54\xb7\x2f\x2c\xb1\x41\xb8\xbe\x70\x9f\x33\xf8\x8e\x06\x27\xd3\x6c\x33\xb6\xa2\xb5\xb6\x35\x08\xc7\x89\xc3\x35\x33\xd8\x4b\xde\xf2\xf4\x96\x51\x1b\x63\xe0\xaa\x70\x24\x81\xa4\x86\x9e\x00\x84\x8b\x38\x4a\x81\xa4\x86\xce\xcf\x31\xa9\x1e\xfe\x58\x91\x8c\x96\x23\x3e\x97\x43\xdf\x1d\x13\x37\x3a\xee\xef\x80\xa3\x55\x44\x29\xa9\x86\xfb\x66\x0b\x5e\xbe\xff\x1c\xb6\xbc\xfe\x81\x43\xb3\xc0\x4b\x6e\x79\x0c\xd9\x07\x55\x29\xea\xe7\xf6\x68\xd2\xf8\xf1\x18\x74\x59\x3d\x27\xe2\x1f\x50\x1a\x07\x58\xc6\x6c\x4b\x04\xdd\x8f\x5e\xfc\x27\x87\x81\x6c\x22\xf6\x4b\x23\x86\x3b\x25\x86\x86\xf3\x1a\xe0\x55\x3f\x7e\x6e\x79\x1e\xeb\x68\xed\x46\xaa\x7b\x87\xaf\x58\xfd\x93\x4c\x77\x3b\xb6\xf9\xce\x78\xfa\x3d\xbd\xca\x8f\xc7\xd2\xf0\x9a\xa0\x17\xdf\xf5\xaf\x38\x45\xa4\xd1\x4e\x3d\x22\x5d\xa6\x95\xbe\xf7\xf1\xe8\xe6\x76\x59\x02\x19\x38\x5c\x70\xc7\x73\x1e\x8c\x24\x73\x86\xd0\xfa\xa5\x65\x47\xc2\x91\x1d\x4f\x47\x21\x3f\x63\xe9\x14\x34\x1f\x0e\xbe\x79\x7d\xd9\xf1\x5b\x5a\x84\x9c\x5a\xf2\x4b\xd9\xa1\x7c\x65\x7c\x17\x91\x64\xdb\x9e\xf7\xaf\x1e\x76\x02\x65\x0d\x26\xbe\x87\x2e\x40\xac\x5a\xf9\x74\x33\x30\x1c\x6e\x39\x0a\xa6\x4a\x98\xa6\x01\x0e\x88\x88\xcc\x90\xb4\xa2\x43\x19\xd9\x20\x8c\x84\x82\x56\x7d\x7f\xea\x6c\x30\xc1\x63\xe0\xa0\x46\x5c\xd7\xbd\x01\x05\xb0\x87\xdf\x99\xd6\xdd\xcf\xce\x81\x7c\x1a\x71\xb4\x35\xec\xd3\x33\xcd\x3e\x3d\x3b\xc7\x24\x7f\xf8\x9a\xe1\x4e\x7a\xf0\x30\xce\x4b\xd1\x35\xa4\x7a\xbf\xdd\xf4\x35\x67\xc7\x72\x98\x73\x93\x78\x94\x48\x32\x89\xed\xe4\x9b\xc4\xce\x6c\x9a\xc4\x7a\xc3\xfd\x49\xdc\x10\x41\x0f\xdc\x5c\x5d\x9a\x7d\xd8\xe1\x54\xfd\xaa\xf0\x0c\x69\x59\x32\xa9\xbe\xb5\x9d\xa4\x7f\x14\xb5\xde\x8e\x3c\xf5\xb9\x61\xba\x02\x44\x4e\x57\x69\x47\x48\x35\xf7\xa4\x18\x74\x6e\x8d\x40\x07\xc3\x9d\x32\x60\x7a\x90\x4a\xea\x6d\x13\x70\xae\x82\x81\x69\x18\xa2\x92\x96\x16\xec\xde\xf4\x00\x0a\x72\xba\x95\x98\xe2\x79\xf1\xc2\xda\x51\xf3\xe9\xb4\xd0\x7b\x89\xcb\x65\xb9\x2c\x56\xab\xe3\x51\xd8\x2f\x60\x6f\xc0\x77\x8c\x15\x50\x67\xfa\x07\xad\xf5\x67\x87\xa3\x9b\x7b\x8e\x3e\x08\x7d\x6f\x15\x3c\x57\x93\x04\x99\x59\x62\x61\xcb\x0c\x0f\x78\xdb\xe7\x9a\xb8\x0d\xbc\xec\x3d\x15\xfe\xf5\xa7\xab\xb2\x60\x33\x78\x0e\x0e\xb1\xf2\x6b\x29\xae\x21\xa7\xd3\xd2\xe3\x06\x29\x33\x90\x36\xd9\xd9\xee\xb4\xd3\x17\xde\xcb\xc1\x49\xd0\x53\xdd\xb5\x48\x52\x53\xa1\xc3\xc9\x91\x20\xe0\xd6\x4b\x52\x4b\xbf\x86\xbc\xd1\xee\xa7\x89\x3a\x26\x59\x94\x75\xcc\xec\x2c\xca\x7c\x7a\xbb\xd0\x7a\xde\xae\x84\xfb\x8f\xda\xe3\x4f\xef\xd2\xe1\xba\x42\xc5\x6f\xd1\xdb\xad\xf3\x39\xc5\xe3\x9e\x55\x48\x4e\xf7\x50\x9b\x8e\x97\x45\xa9\x4b\x7d\xcc\xa2\x35\x71\xdd\x78\x49\xaa\x29\xe9\x3d\xd7\x9e\x39\xf8\x6f\xec\xda\x7b\x76\xea\x7d\xfa\xb3\x8a\xd2\x71\x1f\x59\xf9\x7b\x30\x1d\x75\x12\xb9\x7f\xec\x82\x10\x27\xd2\x59\x5a\x36\xb9\xe8\xe8\xc2\xa5\x4b\x23\xde\x81\x3d\xbb\x0c\x72\x5b\x08\x43\x03\xb8\x2a\xe8\x6a\x9d\x04\x2b\x3c\x57\xa8\xeb\x78\x34\x9d\x41\xb8\xb2\xc1\x5d\x09\xde\xd9\x32\x26\xae\x11\xc8\x49\xeb\x94\x5b\x63\x24\x30\x9e\x97\xb4\x5c\x70\xa3\x64\x2c\x4d\x1d\x95\x38\xe1\x1e\xfa\x3a\xc2\x64\xad\x99\xf4\xa8\xec\xbb\xc0\x84\x5d\x36\xba\x0c\x1c\x61\xd3\xd4\x5a\xdf\x0a\xf0\xfa\xd7\x44\xa8\x9b\x6e\x14\xea\x12\x2d\xd8\x2a\xfa\x85\x1b\x0e\x23\x66\x79\xb2\x26\xf6\xd4\xa6\xc1\x0d\xa4\x12\x84\xe1\xe8\xc1\x25\x49\xa3\xd7\x5f\xbd\xfa\xfe\xf3\x2f\xbf\xc2\xa4\x54\xe6\x6a\x0b\x84\x9e\xdd\x32\x2f\xce\x2f\xf4\xc4\x38\x37\xd6\xec\x53\x0d\x88\xce\xcf\x14\x20\x7a\xf0\xb8\xe7\xde\x1e\x78\x7c\xa8\xde\x15\x7a\x77\x5a\x48\x7c\xfb\x1a\x0a\xce\xe0\x43\x96\x56\x2c\x80\x89\x10\x24\xb7\x94\x85\x84\x18\x16\xe5\x8b\x65\xaa\xc9\x00\xc1\x32\x20\x92\x04\xab\x60\x15\xfd\x43\x14\x1c\x05\x01\x4e\xdc\xff\x52\x24\xe1\xeb\x8a\xaa\xef\x9c\x30\xdc\x9d\xd8\xcc\xe1\xc6\x6b\x99\x66\xbf\xb0\xda\x7b\xeb\xf1\x8d\x75\xdb\xce\x3d\xfc\x2d\xdb\x62\xb0\x1f\xd4\xa6\xaf\xc5\x21\xab\x1a\x86\x7f\x7e\xff\xfe\x34\x19\x20\x10\x66\x78\x7c\xb9\x44\x97\x9b\x4f\xf1\xe5\xea\xff\x1e\x47\xec\x57\x96\x21\x86\x09\x53\x1a\xc2\x12\xc4\x2f\x97\x97\x9b\x4f\xd5\xdf\x24\x08\x30\x99\xd4\xbd\x20\x84\x5c\xb2\x15\xe5\xd8\x71\x27\x2d\xd9\x2a\x0c\xf5\xf1\x43\x83\x89\xfa\xb2\xac\x97\xb3\xd5\x8a\xf2\xdb\x3a\x9b\xf5\xc6\xb9\xa6\x8f\xd1\xe5\xf2\x72\x85\x6f\x78\x30\xfb\x54\xf5\xa2\xf7\x00\x8b\xee\xd9\x96\x7c\x85\x13\xe7\x67\xeb\x28\x55\x3f\xc1\xaf\xe7\xbe\xc7\xe9\x41\xeb\xb0\x6b\x7b\x27\x88\xc2\xba\x9d\xa1\xfd\x25\x78\xce\xfb\x77\xe2\xe0\xa2\xef\x0d\x5f\xda\x73\x3f\xd7\x11\xe3\x99\xd8\xb0\x85\xae\x72\x96\xd5\x8b\x02\x31\x9c\xe8\x83\x6f\x5e\x7f\xd7\xae\x4e\x70\xb4\xe7\xc4\x6e\xdb\x18\xa6\x53\x2c\x58\x54\x09\x59\x23\x9c\x8c\x89\x83\x8b\xb2\x57\xac\x81\x61\xac\xcf\xf5\x46\xa0\xff\x0a\x89\xac\x88\xe1\xcf\xcc\xb7\x1a\x37\xf8\xd4\xf6\xac\x6c\x59\xaf\x1a\xf5\x8c\x6d\xe5\xb1\x3f\x6a\x2d\x37\x7b\xa2\x16\x4d\xf6\x6b\x2d\xd3\xac\xf6\xa5\xbf\x30\xd8\x66\xbe\x46\xc1\x22\xc0\xcb\xd9\xea\x78\x0c\x82\x86\x40\xd2\x66\xc5\xe8\x00\x40\xd1\x0a\x1d\x1c\x61\x4f\x02\x2e\x38\x53\xa7\x63\x93\x93\x2b\x50\x8d\x89\x1c\x94\xfa\x75\x59\x36\x23\xfb\x83\x2d\x64\x82\x94\x74\xd5\xb2\xb8\x46\xb8\x13\xb2\xbf\xa3\xcb\xc5\xf1\x93\x63\x88\x41\xd0\xf0\x02\xb5\x0f\x1a\x06\x7e\x0f\x9b\xa5\xb3\x75\x72\x3a\x7d\x7c\x45\x82\x47\x01\xb6\x57\xd2\x00\x43\x46\xa9\xf6\x32\x2b\xf8\x54\x3b\x9b\xd9\x9b\xf9\x4e\x03\x2d\x9a\x8f\xe2\xb9\x4d\x39\xa5\x94\xa6\xba\xd8\xc6\x86\x8d\x44\x03\x56\x17\xcf\x71\x81\x49\x4a\x24\x6e\xda\xa2\x4d\x30\xe6\xd2\x0c\x39\xf6\x05\x5f\xec\xc2\x21\xd5\x82\x61\xc6\xe7\x0b\x21\x4a\x96\x72\xa0\x80\x8e\x24\x8a\x8f\xa8\x68\x5c\x47\x65\x68\x89\xb8\x09\xc9\x70\xc2\x1a\xe2\x19\x11\x8c\x13\xa9\x46\x5a\x8f\x48\xb1\x7d\xef\x1b\x6d\x3d\x15\x94\x49\xaa\x27\x88\x36\x4e\x6f\x90\x00\x25\xa6\xad\x8b\x74\xd1\x97\x76\xfb\xe6\x3d\x19\xee\x72\x3d\x97\x72\xd5\xab\xf1\x21\xcc\x1c\xd7\x41\x1f\xa3\xb0\xed\xc1\x47\x6a\x71\xa9\xf1\x38\xa1\x49\x60\x0b\x4d\x96\xab\xce\xcb\x52\x95\x45\xc6\xd0\x09\xb9\xb1\x7e\x7b\x16\x86\x26\xef\x56\x01\x0b\x46\xac\xd5\x0a\x1b\xb3\x97\x46\x3c\xc2\xa0\x5d\x85\xf5\x23\x4c\x03\x1a\x4c\x53\x24\x20\x36\x77\x3a\xc0\xf8\x88\xb5\xa2\xd6\xe0\xae\xad\x24\x08\xee\x9e\xe6\xed\x6b\xd5\xa7\xab\xba\x29\xb0\x9c\xfc\x01\xe1\x4f\x57\x6e\xa5\x91\xf6\xda\xe0\xff\x83\xa9\x8e\x38\x7c\x29\x36\x0c\xa2\x0e\x5d\xc1\xdf\xd9\x53\xf5\xe3\xcd\x6e\xc7\xe4\x97\x69\xc5\xcc\x86\xf3\x1f\xe0\x05\xbd\xb1\x56\x7b\xed\xfc\x45\x4d\x52\xb6\x78\xf8\xe0\x0b\xec\xae\x0b\xc0\x97\xd2\x45\x97\xd2\x62\xcb\xd9\x99\xc6\x96\xcf\x4c\x69\xec\x8b\x7e\x6d\xec\x67\x98\xec\xfc\xba\xf8\x26\xf1\x01\x2b\xbe\x39\xa1\x38\xba\x75\x40\xcd\xb6\x95\x52\x72\x50\xaf\x88\x35\xe4\xa0\x43\xc6\x03\x67\x2c\x1b\xc7\xb0\x62\x6f\x0c\x2b\x76\x63\x58\xf1\x2a\x39\x34\xf3\xf1\x9a\x47\x0f\x8c\xd7\xb2\x60\x55\xc2\x9a\xc4\x2a\x79\x97\x31\x8f\x9c\x53\x20\xf9\xdf\x14\xdd\x1a\x3d\x55\xbe\xbc\x5e\x91\x9a\xa2\x98\x64\x46\x5d\xbd\x4a\xeb\x5c\x19\xe8\x84\xdb\x3c\x0f\x69\xcd\x0e\xa6\x5e\x16\xa2\xbf\x4c\xd7\x36\xa7\x50\x9b\xc1\x78\x43\xa1\x0d\x58\xc8\x74\x13\xad\x66\x42\x31\xa9\x06\x96\x04\x46\x42\x59\x28\x29\x39\x68\x67\x89\x6c\x74\xbd\x80\x47\x31\xe1\xb8\x21\x9c\x8e\x57\x9a\xeb\x29\x6b\xb9\xa3\x2f\x69\x1c\x86\xf5\x8b\xdc\x74\x65\x43\x64\xef\x82\x62\x8b\x58\x18\xaa\xaf\xf8\x70\x3d\x35\x14\x16\x49\x6b\x84\xe7\x7b\xa7\xaa\xd6\x8f\x42\x3f\x85\x24\x87\x14\xae\x4d\x36\xd1\xab\x1f\x5e\x41\x24\xc2\x4d\x80\x67\xd0\xca\x8c\x5c\xb7\x37\x0c\xc3\x1c\x16\xb9\x8c\xa1\x6b\x4c\x72\x9b\xfc\x4a\xde\x22\xdd\x31\x46\x7a\x70\xe3\x24\xdb\xaa\x66\x54\x6f\x53\xe6\x39\x6d\xaf\x7a\x6f\xdb\x95\x96\x16\x3a\x52\x7f\x18\x1b\x89\x49\x4d\xd4\xdd\xbe\x77\x7c\xe6\x5a\xfb\xb4\x47\x4a\x72\x25\x54\x87\x62\xb2\xa6\x8c\xe4\x74\x1d\x19\x49\x20\xd7\x74\x1d\xe9\x9d\xec\xea\xf9\x48\x6a\xf2\x45\x4e\x97\xf9\x2a\xe9\x4b\x5b\x8e\x8f\x47\x94\xd3\x65\xf0\x38\x58\x61\x92\xd3\xfc\x44\xbd\x5f\xef\x18\x33\x08\xa8\xc1\x4a\x72\xbd\xb8\xa6\x79\x9b\x2a\x99\x5c\xc3\x08\x76\x1d\x7a\x3c\xa2\x98\x14\xdd\xfb\x4f\x66\xb6\xf8\xe6\x0e\xe5\x98\xbc\xa5\xde\xe9\x77\x05\x40\xb4\x21\xef\x7d\xb3\x5b\xfd\xd9\x86\x0f\xa1\x3f\xf7\xe4\x90\xa5\xfc\x1b\x91\xf0\x06\x2b\x8b\xce\x16\xd8\x5d\xdf\xa2\x69\x87\x6a\xb3\x8e\x4a\x21\x76\x9f\x57\xef\x79\x36\x72\xfa\x6a\x49\x8b\x89\xa0\x93\x99\x4e\x2e\x2a\x21\xc3\xa8\xcb\x99\x72\xa6\xa0\xd5\x93\x63\xe5\x40\x6a\x6a\x67\x3b\x91\x34\x9e\xcb\x17\x6c\x2e\xa7\x53\x5c\x2b\xf5\xd8\xe9\x07\xbd\x84\x0b\xaa\x60\xc2\x20\x69\x4a\xe1\xf3\x7e\x98\xbe\xc6\xcd\x7c\xd2\xae\x1a\x19\xd2\x24\x13\x01\xae\xe6\x49\x4c\x26\xa9\x49\x0c\x4e\xd5\x3b\xaa\xe3\xf2\x85\x5a\xa2\xe7\x18\xde\xa0\x46\x72\x3a\x25\x19\xd1\xf5\x19\xe0\xcd\x44\x3f\x39\xaf\x7f\xb7\x02\xcf\xe5\x4b\x58\xe3\xc3\x50\x3f\x21\x47\x6a\x62\xa1\x5b\x17\xb6\x53\xc1\xd4\xb3\x99\x76\x31\xfc\x4e\x2a\x73\x82\xcb\xe0\xde\x83\xad\x09\xbf\x0c\x98\xbe\xf5\x92\xbb\x83\xcd\x57\xa6\xd0\x71\x7d\xaa\xe6\xb6\xe5\xf8\xf7\x8a\xfd\x76\x28\xc9\x60\x5b\xb5\x66\xde\xad\x89\x16\xe4\x78\xda\xc8\x7e\xb3\x15\x4e\xf6\x63\x76\xf3\x9b\xfc\x08\x95\x59\x8e\x5f\x17\x57\xf9\x09\x43\xae\x86\x34\xf5\x86\x30\x9d\x4f\xde\x31\x8a\x7c\x89\x71\xfe\xe6\x7a\x9e\x0b\x70\x54\x34\x64\x1c\xda\x4e\xa3\x94\x94\x46\x4f\xf7\xab\xf2\x65\x88\xf5\xcf\xc5\xba\x4c\xdf\x1d\x05\xff\xf9\xd0\xe7\xfc\x24\xd6\xd2\xe4\xf2\x7f\x44\x94\xde\xa7\x54\xaf\x5d\x14\x5d\x74\xd8\xd6\xe8\x34\xe0\xe0\xd0\xcc\xdb\x8c\x23\x86\x07\x1c\x81\x3b\x13\x04\xc2\x10\x81\xe0\x32\xb7\x5c\x4c\xa7\x6c\x19\xa9\x4f\x14\xcd\xbb\x37\x74\x4d\x7f\x07\xd0\xb5\xd4\x41\xe2\x82\x0a\x0b\x56\xcf\x4c\x84\xe0\x0c\xd0\xaa\xb4\x91\xe1\xe7\x17\x0a\xad\x4a\x1b\x17\x9e\x3d\x37\x71\x61\xd8\xc9\x45\x0c\x76\x2b\xff\xd7\x31\x65\x15\x65\x29\x7f\x53\xb1\x3f\xfd\xf0\x97\x53\x6b\xaf\x32\xee\xb7\x42\x66\xec\x35\xdb\x4a\x56\xe5\xc7\xe3\x04\xc5\x64\x17\x19\x7e\x73\x65\x0a\x49\x63\xa4\xb4\x56\xbd\xd8\x24\x7b\xa8\xd9\x7a\xc5\xea\x37\x15\x68\x9f\x6d\xa1\x8c\xd6\x42\x70\x90\xe6\x31\x94\x81\xd0\xb9\x0b\x66\xa0\x70\xf3\x00\xce\xa8\xce\x89\xae\x04\x59\xd3\xfe\x8e\xdd\x29\x3a\x78\xee\xa4\x6c\x6c\x3f\x6c\x12\x7d\xd8\x54\x8e\x60\x53\xa6\x60\xd3\x56\xc1\xa6\x6b\x1a\x93\x2b\xbb\x60\x0f\xa1\xc7\x8c\xd2\xe9\xf4\x3a\x0c\xd1\x15\xdd\x2b\xf8\x26\xeb\xef\xa1\xa0\x29\x93\x68\xdd\xc3\x96\xd8\xda\xe8\xf5\x62\x6d\x0a\xb7\x7e\xc1\xb6\x42\x32\xc4\x70\x62\x8f\x38\x1c\x36\x67\x70\x39\xc2\x24\xa6\xf4\xb3\xcf\xae\xc3\xf0\xca\x25\x45\x42\x02\xe7\x9a\x1c\xdc\xe6\x7c\xf9\xfd\x6f\x11\x23\x93\x58\xc1\x4f\x38\xf1\xe4\x29\x10\xe5\x71\xa1\xd0\x6d\x3b\x05\x0f\xa6\x21\xa9\x87\xc3\x45\xeb\xde\x88\x52\x5f\x99\x5e\x75\x50\xe8\x7f\x86\xe3\x67\x2d\x0d\xab\x0a\xcf\x9e\xe1\xd3\xfb\x1b\x78\xae\x0f\xc8\xc1\xdd\xc5\x2a\x1e\x6e\xc9\xdc\x92\x4b\x3d\xd7\x36\xcd\xa9\xbd\x9c\xd4\xbd\xc4\xdd\x9b\x16\x8d\xb3\x63\x82\xb2\x4d\x61\x23\x04\xa5\xc2\x3d\x7d\x31\x6a\x01\xc5\x44\x8c\x90\xf4\xbb\x82\x6f\xc4\xbb\x36\x86\x85\xc1\x67\xe8\xf6\xb3\x67\x84\x07\xd7\x44\xb9\x64\x5b\x35\x89\xd2\xa1\xa1\x37\x99\x35\x9e\x81\xbc\x43\x8b\xd6\xef\xe1\x69\x14\x5a\xbd\xeb\x7a\xf7\xec\x63\xac\x77\xff\xd5\x1e\x11\xbb\xc8\xa8\x65\x64\xaf\xba\xf8\xb9\x5a\x65\xc6\x36\xf2\x83\x80\x03\xd6\x51\xa3\xd6\xba\x76\x13\x33\x8f\x03\x0d\xf2\xd0\x9d\xa4\xdd\x85\xce\xd0\x65\x0d\xc9\xe9\x21\x4f\xab\x7c\x9d\xf2\xab\xc4\x38\x51\x95\x20\x7a\xf4\x5e\x30\xf1\x34\x32\x81\x46\xb4\x53\xf9\xee\xd7\x45\xd5\x7e\x5d\x19\xf7\x1d\x56\xc2\x44\xb8\xa8\xca\xb4\xca\x6f\x7b\x84\xf1\x7b\x0c\x9b\x72\x9f\x65\xdd\x90\x71\xab\xeb\xfe\x19\xcd\x7f\x04\x1c\x40\xda\xcb\x9f\xd9\x7b\x28\xcc\xad\xfa\xff\xc7\xf7\x3b\x36\x1f\xe7\x3c\x69\x8a\xe5\xcf\xbf\x04\xd6\x19\xc0\xc1\x87\x14\xc0\x6b\xa9\x83\x4a\xa4\xf2\xe3\xd1\x39\x66\x74\x43\xbe\xe4\x2b\x92\xd2\xad\x17\x54\x94\x1e\x66\xef\xd6\x17\x56\x97\x50\x04\x67\xef\xd3\x68\xdb\x9e\x16\x85\x58\xbb\x3a\xd7\xcb\x74\xde\x0e\x55\x64\x7b\x3a\x60\x93\x5d\xcf\x7b\xe3\xc3\x26\xe9\x29\x6c\x52\xf6\xb1\xc9\x7e\x84\x4d\x36\x80\x4d\xd4\xca\x72\x23\x3c\xe1\x3d\x78\xb2\xbd\x11\x9e\xc0\xa3\xb7\xcb\xd4\xbd\x21\x8a\x18\x42\x14\xbf\x13\xa6\x05\x22\x3f\x9f\xfc\x77\x86\x9b\x39\xb8\x0e\x2d\xae\xfc\x06\x0a\x6c\x8a\x7d\xfd\x9a\x95\x22\xdd\xbc\xa9\x0a\x7e\xf5\x6d\x5a\xe5\x18\xe1\x9e\x3b\x67\x88\x83\xde\x5b\xb4\xf3\x33\xf4\x96\x73\xbb\x75\x74\x25\x50\xb7\xb5\xc1\xb7\x92\x6d\x7d\xf3\xf2\x93\x60\x2a\xa3\x6e\x92\xa1\x75\xd4\x9d\xaf\x33\x96\x3e\x36\x56\xea\x0d\xd8\xff\xb0\xd3\x85\x82\x03\x3d\x2f\xc5\x60\xc9\x6a\x90\x80\xc5\xef\x0c\x6b\xae\xa4\x5a\xee\x20\xcf\xde\x84\x63\x9f\x79\xfd\x14\x3e\x60\x04\x3b\x0d\xd8\x04\xa5\xe0\x93\x76\x3b\x58\x28\x27\x53\x2f\x82\x20\x71\x95\x74\x3d\x9d\x9d\xd2\x28\xa3\xc6\xd3\x2a\xa7\xac\x19\xac\xbe\x5a\x85\xfa\x1e\xa4\xff\x10\xa7\x10\x97\xf7\x4a\x1d\x75\x8b\x49\xfd\x92\xc6\x8b\x3a\x89\xf1\x54\x89\x34\x03\x7d\x76\x33\xf6\xec\xe5\x4f\x75\x2b\x0b\xca\xa0\x8a\x24\x05\xe7\xee\x15\xab\xff\xa6\x14\xbe\x8e\x58\x01\xed\x0a\xaa\xe1\x01\xe0\xe3\xa4\x56\x18\xce\x48\xa0\x04\xfd\x0e\x57\xa9\x0e\xdb\xdd\x7a\x1d\xac\x31\x92\xa5\xba\xc8\x1e\x46\x12\x5b\x0e\x21\x34\xe2\x04\x15\x78\xab\x03\x4a\x53\x9f\x53\x10\x07\x7e\x76\x88\xb9\xb4\xf1\x04\x89\x1b\xb2\xb3\xea\x72\x4d\xd1\x70\x96\xf9\x3d\xb8\x03\xa1\x91\x34\x43\x3a\x98\xed\xe8\x07\xcd\x9f\x97\x13\x4a\x05\xde\x22\xe1\xd4\x15\x4f\xe9\x06\x9c\x48\xea\xff\x5d\x18\xa6\x3a\x6e\xb2\x53\x1f\x94\x52\xf8\x69\xd0\xd6\x7c\x47\x53\xc2\x50\xaa\x34\xa8\x80\x9b\xa4\xfd\x9b\x88\x4e\xe9\xa9\x65\x3b\x0c\xb7\x0a\xf0\xa1\x98\x94\x51\xba\xd9\x7c\xf5\x56\x8d\xa7\x79\x15\x6b\x26\xe8\x7d\xa2\x74\x7d\xa2\x80\x48\x3c\xde\xf6\x00\x2e\xd7\x35\x3a\xee\xd4\xc2\x08\xc9\xbb\x35\x83\x74\xe4\x2b\x35\x31\xa1\xb2\xff\xf8\x4e\x5c\xa0\x35\x0d\xe6\x2d\x0a\xd1\xce\x67\x38\x27\xdd\x6c\x86\x62\xf2\xa3\xd0\x97\x94\x50\x71\x85\x80\x90\x54\xe9\x5b\x66\x84\x24\x25\x02\xa8\x4f\x8c\x28\xd8\xab\xab\xb4\xfa\x4d\xa2\x1e\x0f\x73\x6d\xde\xc0\x9d\x8e\x19\xc2\x3a\x20\xbd\xd7\x35\xa0\x6e\x34\x87\xee\xde\xda\x56\xb7\xd6\xac\xf0\xfc\x9f\x01\x00\x00\xff\xff\x42\xaf\xf5\x6a\x9d\x88\x06\x00")
+var _workJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x0b\x97\x13\x37\xb6\x28\xfc\x57\xec\x3a\x5c\x47\x8a\xd5\xd5\x36\x10\x92\x94\x51\x3c\x04\x3a\x13\x66\x20\xf4\xd0\x90\xcc\xc4\x78\xfa\xaa\xcb\xb2\x5d\x50\x2d\x39\x2a\xb9\xa1\x63\xfb\xbf\x7f\x6b\xeb\x55\x2a\xbb\xba\x81\x9c\xb9\xf7\x9b\x7b\xd6\xac\x64\xd1\x2e\x3d\xb7\x5e\x5b\xfb\xad\xee\x7c\x2d\x72\x5d\x48\x81\x38\xde\xf8\xdf\x1d\x8d\x14\xde\x14\x73\x24\x26\x6a\x8a\x15\xd7\x6b\x25\x3a\xf0\x3b\xe5\x1f\x56\x52\xe9\x6a\x74\xc5\x54\x47\x52\x48\xa2\x9b\x22\x53\xa4\xcc\xba\x43\xe2\x32\xb3\xcd\x6e\x37\x72\x95\x38\x54\xca\x59\x59\x22\xe9\xeb\x12\x49\xea\xdf\x1a\x13\x99\x96\xb4\x3b\xa8\xd3\x76\xd0\xb6\xa0\x9b\xdd\x48\xa7\x97\x94\x13\x9d\xe6\x54\x10\x9d\xce\x68\x0d\x2a\x11\x44\xe1\x8d\x4e\x25\xfc\xc4\xdb\xed\x8b\x8b\xb7\x3c\xd7\xe9\x8c\xcf\x0b\xc1\x4f\x95\x5c\x71\xa5\xaf\x21\x8f\x6c\x72\x29\xe6\xc5\x62\xad\xd8\x45\xc9\x0d\x90\x62\x7d\xc9\xdd\xd7\x80\x2c\xb8\xce\xd4\x0e\xef\x88\x4e\x45\xd4\x3e\xde\x58\x20\x78\xaf\xc7\xd3\xf3\x73\x5e\x3d\x97\xb3\x75\xc9\xc7\xa1\x04\xde\xf8\x01\x42\xa7\x6c\x5d\xea\x5d\xd6\x92\x19\xe6\x41\xa7\x33\x24\x48\xc2\x12\x22\x30\x11\xd0\x9d\x8c\x87\xa3\x43\x15\x37\x92\x95\x92\x5a\xea\xeb\x15\x4f\x97\xac\x7a\xf1\x5e\xf8\x31\xd9\xb9\x84\x0a\xd0\xc6\x8a\x26\x09\xd1\x48\xa7\x15\xbd\x3f\xc4\x3b\x34\x89\x9b\x24\x02\x6f\x92\x75\xc5\x3b\x95\x56\x45\xae\x93\x11\xf7\x33\x4c\x05\xba\x7f\x17\xef\xc8\xad\xa5\x61\x02\x54\x03\x46\x98\x74\x22\x09\x23\x25\x29\xcc\xee\xe8\xba\x79\xaa\x46\xc5\x1c\x5d\xc9\x62\xd6\x19\x50\x4a\x35\xae\xa8\xe0\xef\x3b\x27\x4a\x49\x85\x92\xe7\x85\x28\xe6\x05\x9f\x75\xf8\x87\x9c\xaf\xa0\xbb\x8e\xcc\xf3\xb5\x52\x7c\x36\xea\x00\x78\x7a\xc9\x3b\x42\x8a\xa3\x4b\x5f\x70\xc6\xaf\x3a\x5c\x5c\x15\x4a\x8a\x4b\x2e\x74\x67\x2e\x95\x29\x34\x5f\x97\x65\x87\x43\xab\x9d\x4b\x5e\x55\x6c\xc1\x3b\x4c\xcc\x3a\x6c\x36\x2b\xa0\x59\x56\x76\x96\xbc\x5c\xcd\xd7\x65\xe7\x3d\x53\xa2\x10\x8b\x2a\x4d\xf0\x88\x97\x15\x37\x50\xe6\x74\x12\x0d\x60\x4a\xd6\x74\x30\x8a\x21\xd5\xa9\xe2\xab\x92\xe5\x1c\x1d\xff\xaf\xea\x78\x41\x0e\x97\x33\x9f\xac\xfb\xfd\xe9\x0e\x63\x52\xa5\x82\x5d\x72\x9a\x3c\x15\x57\x4c\x15\x4c\xe8\xce\xcf\x85\x2c\x19\x80\x91\xec\xf4\x52\xc9\xf7\x9d\x2a\x9d\x2b\x76\xc9\xab\x57\xf2\x54\xae\xe8\x90\x54\xbb\x5d\xb4\x02\xea\x70\xf6\x1b\xcb\x73\x0f\x23\x7c\x7b\x91\x07\xed\x45\x5a\xd6\x50\xa0\x01\x26\x12\xaa\xdc\xc5\x8d\x95\x52\xd8\x82\xea\x56\x2a\x57\x9c\x69\x7e\xa4\x38\xcb\xf5\x51\x5e\xb2\xaa\xea\xe4\x72\x5d\xce\x3a\x42\xea\xce\xbc\x10\x33\xb3\x0a\x2f\x21\xbb\x23\xed\x36\xed\x3c\x9d\x77\xae\xe5\xba\xc3\x14\xef\xac\xab\x42\x2c\x3a\x55\xae\x8a\x95\xee\x68\xb6\xa8\x48\xe7\x92\xbd\xe3\x9d\x6a\xad\x60\x91\x99\x76\x55\x8b\xaa\x73\xc1\xa1\x68\x29\xd9\x8c\xcf\x3a\x17\x7c\x2e\x15\xef\x1c\xf6\x0e\xab\x07\x0b\xc7\x28\x82\x55\x52\xe9\x63\x79\xb9\x92\x82\x0b\x8d\xd3\xf5\x6a\xc6\x34\x57\xd1\x8c\x4a\x14\x15\x20\x2a\x2d\xaa\x9f\x59\x59\xcc\x4e\x4a\x0e\x9b\x88\xb0\x4f\x9b\xab\x50\x04\x6f\x3e\xb2\x5c\x8d\xca\x3e\xb3\xa3\x10\x0f\xdb\x45\xac\xcb\x92\x52\xbe\xdd\xae\x53\xb6\x07\x0e\xe2\x78\x17\xea\xc8\xa8\x0e\xd4\xdf\x6e\x1f\x29\xc5\xae\xd3\xa2\x32\x7f\x11\xc7\x80\x85\xf8\x15\x57\xd7\x48\x45\xf5\x58\x03\x71\xcc\xd1\x66\x47\x20\xa1\x2e\x50\x7a\x44\xa6\x29\x4f\x01\x99\x10\x41\x19\xd2\x1e\x63\x01\x4e\xa9\x08\x4f\x57\xf0\xd7\xec\x0c\x91\xe6\xcb\xa2\x9c\x29\x2e\x6c\x3d\x45\x8b\x28\x8d\x08\x3c\x52\x69\xc9\xc5\x42\x2f\x7b\x3d\x9f\xf1\x52\xae\x35\xaf\xa8\xc2\x64\xc6\x4b\xae\x79\xa7\xae\xb0\x73\xa0\x89\x1a\xa4\xc2\xc2\x0c\x8d\x0b\x3a\x99\x7a\xf4\x08\x33\xf4\xd8\xd5\x4a\xe7\x52\x9d\xb0\x7c\x89\x78\x34\xe7\x06\xdf\xb4\xce\x23\x2e\xe6\xc8\x8e\x2e\xb5\x5b\xc8\x00\xf4\x83\x92\x97\x66\xbb\xb9\x82\x7e\x3c\x1f\x2f\x69\x00\x1c\xa9\x5e\x4f\xa4\xab\x75\xb5\x84\x19\x07\x14\xd2\x71\x9f\x30\xa7\x78\x87\x49\x34\xa6\x2a\x5a\x3f\x58\xcb\x31\xa7\x05\xe2\x38\xe3\xbd\x5e\xf7\x70\x25\x11\xa7\x13\x3e\xc5\x84\xef\xe0\x62\x93\x44\xa7\x8c\x96\x44\xa7\x17\xd4\xde\xaa\xb9\x3d\xaf\x6b\x2a\x52\x81\x72\x4c\xe6\xd4\x5d\x09\xac\xaa\x8a\x85\xd8\x6e\xe3\x49\x99\x4b\x85\xa0\x92\xa6\xc3\x91\x7e\xc8\xd4\x62\x0d\x43\xa8\xdc\x22\x8d\x74\xbf\xef\xe7\x3a\xe4\x4d\xf4\x74\xe4\xab\xa9\x4e\x21\x3a\x02\x7f\xd2\x9d\x23\x88\x32\xd0\xc3\x95\x0f\xf7\x3e\xf6\xab\xcb\x77\x1f\x39\x1c\x3a\xba\x41\xe1\xa6\xd7\x6e\xfa\x4f\x99\x5e\x52\x9d\xae\x98\xaa\xfc\xef\x05\xd7\x7f\x5b\x73\x75\x7d\xa6\x55\x21\x16\x3f\xb3\x72\x6d\xd6\xc7\xe5\xc2\x51\x5d\xdd\x92\xcf\x66\xb3\xfd\xdc\x57\xd2\xe4\x59\x94\x17\xf0\xe1\x57\x80\x0f\x51\x3c\x91\x7b\x17\xfd\x0e\x29\x4c\x6e\x6b\x70\x7f\xc0\xb6\x65\x86\x38\xb4\xac\xd2\x15\xd3\x4b\xb8\x21\x48\x41\x55\x5a\x71\xa6\xf2\x25\xa9\xa8\x82\x89\x5d\xfa\x3d\x5f\xa2\x8d\x2f\x96\x49\x62\x0b\x65\x45\x1f\x1d\x0d\x29\xa5\x45\x5a\x88\x19\xff\xf0\x62\x8e\x92\x71\x82\xc7\xc9\x38\xc9\x92\x5e\x82\xfb\xba\x9f\xd0\xa4\x2f\x08\x34\x94\x55\x96\x70\xb9\x7d\x5a\x62\x48\xc3\x66\x00\x38\x15\x15\x35\x9c\x92\x0a\x0f\x67\x41\xc5\x8d\x70\x2a\x0f\xa7\x0c\x97\x25\xa0\xe6\x97\x7c\x71\xf2\x61\x85\x12\x34\x19\xf7\xa6\x38\x31\x50\x4e\xd8\xd1\xef\x8f\x8e\x7e\x1d\x1c\x7d\x3b\xed\xa3\xde\x18\x27\xf8\x60\x97\xd8\x0e\x92\x71\x02\x54\xc3\x58\x67\x62\x87\xed\xc0\x0a\x3b\xb0\xdb\x76\xc3\xad\xc3\x72\x23\x81\x95\xb8\x64\x3a\x5f\x36\x80\x04\x18\x2d\x88\x28\x86\x11\x27\x18\xfb\x21\xcb\x5e\x4f\x4e\x86\xd3\x78\x5f\xd7\x88\xd4\xb6\x78\xfc\x4f\xb4\xd4\x7a\x55\x8d\x33\x3c\x7e\x73\xfc\xe6\x78\xf2\xcf\x37\xc7\xd3\x2f\x8f\x43\x13\x16\xf9\xeb\x31\xcf\x78\x5a\xad\x2f\x60\x8d\xc4\x02\xe9\xc9\x60\xea\x0e\x27\x20\x11\xd6\xd8\xfe\x87\x9d\x01\x36\x21\x02\x88\x3c\x05\xff\x40\xf1\xb0\x2d\xfe\x2b\xc1\xa3\xa3\x61\x97\x52\xd6\xeb\x21\x45\x75\xd4\x0b\xc3\x44\x37\x12\x06\x84\x61\x7b\x97\x96\x71\x13\xe3\xc4\x83\x6b\x1a\x2a\x01\xa9\x37\xea\x95\x87\x0d\x95\x18\x93\xc4\x2c\x58\xaf\x87\x34\x4d\x8e\x13\x4c\xea\xfd\xa1\xfd\xfe\x70\x3b\x54\xed\x76\xa4\xa4\x8d\x03\x1f\x8f\x12\xae\x1c\x7f\x49\x26\xb6\xcf\x84\x52\x40\x41\x72\xde\xe1\x9e\xf1\xe0\x23\x3f\xf5\x17\xac\xe2\xb0\x0f\x89\xa0\xbc\xde\xbb\x80\xd5\xc3\x8a\x1b\x82\x79\x49\x18\x45\x7a\xbb\x4d\x12\xdc\x17\x7e\x45\x54\xaf\x97\x8c\x93\x2e\xa5\xaa\xd7\x43\xac\x0f\x37\x96\xb4\xbf\x24\x26\xac\x05\x89\x05\x6c\x71\x1f\x8f\x0e\xa0\x83\x46\x14\x9d\x4c\x78\x5a\x10\x45\x92\x64\x3a\xc5\x8e\x29\xda\x2c\x2f\x55\xd6\x1d\xec\x46\x32\xd5\x8a\x89\x6a\x2e\xd5\xa5\x47\x40\x02\xdd\xfd\x16\x23\x45\xa4\xb9\x49\x65\xce\xca\x0a\x70\x6a\x4d\x67\xb8\xc4\x8f\x11\x2b\xed\xfc\x8e\x26\x49\x8d\xc2\x12\xb2\xb9\x02\x84\x05\xa0\x60\x12\x2e\xfd\xfa\xe0\xd4\xb7\x07\xa7\xfb\x37\x07\xd1\xd4\xdf\x58\x44\xd0\xc1\x48\x3c\xe4\x23\xd1\xef\x63\x3d\x11\xd3\xba\xf0\x44\x84\x0b\x5c\xa7\x6f\x65\x21\x50\xd2\x49\xf0\xc7\xae\x83\x1a\x09\x8f\xe0\x8a\x53\x1f\x1b\x6b\x2b\x6d\xc5\x03\xfe\x39\x9e\xa4\x5f\xf6\xc7\xff\xbc\xb3\xd9\x21\xbc\x9d\xbc\x99\xbe\x79\x33\x3d\x5e\x90\xe4\xcd\x9b\x3b\xbd\x24\xa2\x85\x64\xf3\xba\x4c\x12\x02\xf4\x07\x91\xf0\x0f\x73\xcb\x43\x4a\x3a\x20\x05\x3d\xce\x3c\x62\x38\xbf\x33\xad\x51\xc4\xf9\x9d\xe9\x97\x78\xfb\xe6\xcb\x37\x5f\x6e\xe1\x7f\xb4\x7d\x83\xb7\x6f\xde\xbc\x41\xf0\x0f\x3e\x5e\x8c\x18\x2d\x52\xfe\x81\xe7\x88\xe3\x11\x66\xf6\x94\xb9\x53\x25\x2d\xed\xc0\xd3\xaa\x2c\x72\x8e\x4a\xe2\xb2\x31\x26\xba\x4f\x55\x5b\x06\x26\x6c\x32\x9c\x8e\x91\xee\xd3\x04\x4d\xfe\x79\x0c\x08\x8a\x38\x1a\x04\x72\x30\xce\x92\x2f\xbf\x84\x83\xc8\x26\x03\x5f\x2e\xfd\xb2\x2e\x94\x54\xab\x92\xe9\xc4\x94\x3b\x28\x36\x6e\x2d\x87\x42\x39\x53\x6c\x9c\x25\x59\x82\x1b\x69\x18\xee\xa1\x37\x6f\x9a\x05\xe1\x1b\x52\x43\x51\xc0\x0c\x26\x1d\x90\xbe\x1b\x3b\x34\x81\x49\x49\x8b\xb4\x64\x95\x7e\x0a\x83\xf4\x7b\xa7\xec\x52\xca\xdd\xc6\x6b\x9b\x2c\x9f\x77\x38\x5b\x75\x8e\x45\x42\x9a\x2b\x91\x71\xa2\xf8\x82\x7f\x58\x9d\xc9\xb5\xca\x01\x25\xad\x98\x62\x97\x3f\x01\x2f\x96\x09\xa2\xe5\x3b\x2e\xaa\x4c\xee\xea\xad\xc1\xa2\x7d\x35\x9f\xf0\xe9\x76\x8b\xe0\x0f\x85\x2d\x83\x09\xfc\xac\xcb\x96\xf6\xbe\x49\x8e\x01\x91\xf0\x34\x5f\x32\xf5\x48\xa3\x81\xa1\x8c\x00\x19\xf6\x39\x1e\xed\x5d\x46\x31\x34\xc0\x86\xa5\x35\x3c\xa4\xa4\x22\xb5\x10\x8d\xf6\x9a\xf4\x43\x3b\x1a\x42\xdb\xaa\x4f\x93\xe3\x71\x82\x49\x62\x96\xbc\x9c\x94\x21\x7b\xea\xb2\xef\x38\x6e\xa9\xa0\xba\xe5\xd2\xfb\x67\xd2\x57\x24\x29\xe0\x8e\x0b\x48\xb7\xf0\x08\x16\xee\x2a\x53\xb7\xa2\xc5\x64\x30\x25\x79\xc0\xf9\xc8\x23\x04\x53\x2d\x37\x18\xdb\x02\x5a\x79\x40\x7d\x89\xa3\x21\x6e\xb4\x97\x9b\xe9\xc8\x1d\x95\xb8\x51\xfc\x92\x15\xc0\x94\x9f\xfa\xbb\x22\x8f\x17\x46\xda\x0f\x73\xc7\x57\x59\xe1\x56\x78\x88\xd3\x4b\xb6\x6a\x10\x6a\xae\x0b\xde\xeb\xcd\x78\x2e\x67\xfc\xf5\xcb\xa7\x81\xeb\x03\xae\x0a\x47\x0b\x5b\x44\x0b\x0b\xcb\x11\xcd\x7c\x5d\xa8\xb2\x2b\xaa\xa9\xde\x6e\x37\xbb\x40\x1a\x47\x0b\x68\x17\x88\x48\x3a\x20\x25\x5c\xc3\x05\x1d\x90\x0a\xf0\x46\xee\xf1\xc6\xdc\xff\x98\xf9\x1f\x2b\x3a\x20\x17\xd4\x73\x4b\xa3\xd5\xc3\x8b\x51\xbf\xbf\x02\x5e\xc5\xae\x20\xca\xa9\x9a\xac\xa6\x78\xbb\x75\xa7\x38\xc7\x33\xda\x64\x16\x74\x6a\x4e\x30\x1e\xbb\x1f\x93\xa2\xdf\x9f\x66\xee\x83\xc0\x1c\x77\xe9\x6c\xbb\x95\xdf\x0d\xb6\xdb\x35\xc2\xa8\x3b\xc4\x3e\xb5\xd7\x43\x65\x9f\x72\xe1\x26\x08\xcd\xb0\x95\x83\x74\xa0\x7f\x73\x78\x73\x5c\x4d\xe4\x14\x46\x23\xfb\x74\x58\x67\x62\x9b\x69\x88\x9d\x25\xad\xd2\x95\x5c\x21\x3c\x92\x47\x74\x48\xe4\xb8\x9a\xc8\xa3\xe1\xb4\x4f\x97\x59\xd9\xa7\xcb\x5d\xa8\xe4\x10\x42\x8e\x4b\x40\x1b\x49\xdd\x9a\xc3\x09\x36\x03\x47\x19\x99\xe9\xc6\x6f\xa1\x01\x86\x89\x99\xd3\x3c\xa2\x34\x86\x98\xcc\xa8\x9e\xcc\xa7\xb7\x8c\x94\xd2\x99\xd9\x91\x12\x6f\x2c\x68\x34\x49\xc2\x02\x5e\x52\x15\x28\x9d\x1c\x93\x05\x10\xe2\x66\x53\x5d\x12\xbf\x2c\x98\x5c\xd1\xa3\x21\xb9\xa6\x83\xd1\xf5\xc3\x85\x5f\xab\xeb\x7e\x1f\xfb\xa9\x58\x4c\xae\xa7\x78\x73\x45\xaf\x47\x17\x8a\xb3\x77\xbb\xab\x18\x84\x15\xbd\xec\x5f\x1d\x0d\x77\x76\x22\xea\xe9\xe1\xe2\x60\x63\xce\x70\x56\xde\x90\x31\xda\xab\x9d\x43\xc9\xdc\xe3\x46\xf9\x90\x46\x3d\x96\xf5\xad\xf7\xe6\xb8\x0f\xd7\xdc\x71\x82\x0d\x1f\x69\x39\xc8\x02\x78\x96\x88\x8f\x1c\xb6\xf1\x91\x96\x16\x33\x38\xe0\x8f\x5d\xbd\x7b\x32\x51\x9e\x6d\x1c\x6d\x91\xf1\xdd\xee\x90\xd9\x03\x8a\x06\x1a\xa9\x1e\x29\x7e\xf2\xdb\x9a\x01\x3d\x58\x69\xa6\x79\x9c\x60\x81\x7a\xe6\x8a\x86\x04\xc3\x7d\xb9\x33\xe5\xc8\xab\xc4\x03\x55\xd3\x62\x67\xd7\x97\x17\xb2\xec\xf5\x92\xca\xfc\xd8\xcf\x48\x0b\xcd\x15\xd3\x52\xd5\xe2\xdb\x7a\x34\xae\x24\xdf\x65\x2d\x99\xbc\xd7\xbb\xa5\x3b\x9e\xe6\x52\x54\x5a\xad\x73\x2d\x15\xa5\x34\xa4\x77\xfd\xef\x9a\xa5\x1e\x7b\xd8\xb2\xd0\x21\x61\xff\xfe\x9c\x3d\x5c\x50\x68\x88\x81\x8d\x45\x25\x26\x95\xe5\x9d\x73\x8a\x14\xaa\x30\x11\xe8\x6b\x6c\x76\x18\x1a\x7e\x0d\x82\x0a\xd4\x5c\xb6\x96\x09\x65\xa8\x65\x13\x12\x6e\xb8\xbd\xbd\x2d\x10\x6a\xe3\x4d\x3b\x8d\xfa\xdd\xa0\xd7\xb3\x3b\xa3\x4b\xeb\x4c\x20\x4a\xe2\x8f\x2c\x39\x4e\x88\x3e\xac\x3c\x6c\xad\x3c\x8c\x2b\x0f\xa7\xd9\x3a\x3d\x7d\x71\x4a\xc4\x61\xf5\xbb\xad\xd5\xef\xc6\xd5\xef\x4e\x33\x18\x1e\x51\xf4\x90\xb5\x19\xa3\x01\xc9\x6b\xd6\x0f\x1b\x71\x91\x3b\xf3\x11\xb7\x1d\x78\x9c\xed\x16\x86\xe1\x78\x2b\x2f\x51\x00\xfe\xc6\xb1\x59\xb0\xae\xf6\xdb\x9c\xac\x4c\xa5\xe6\x2f\x61\x66\xeb\x66\x9a\xbc\xe3\xd7\x99\x68\x50\xe6\x7e\x4d\x92\x89\x95\xe5\x76\x9e\x30\xcd\xa7\x80\x97\x0f\x36\x8d\x96\x96\x03\x77\xca\x07\xe0\x5c\x67\x87\x87\xd8\x37\xdd\xe1\xc8\x60\x92\x62\x8e\x34\xa5\x54\x38\xaa\xa0\xeb\xc5\x2f\xb5\xa2\x60\x9c\xac\x85\x55\xd9\xcc\x92\x4c\x22\x6d\x88\x0c\xd5\xa5\xb4\x16\x51\x8b\xbd\x32\x02\x7b\x2a\xa3\x3b\x84\xd2\xf1\xf1\x34\x8c\xdd\x80\x14\x9e\xd5\xb1\x58\x3a\xb1\xc3\x83\x81\x59\x9d\xd6\x1c\x69\xdc\xeb\xcd\x91\xc0\x6d\xc5\xf7\x24\x76\x1a\xdb\xed\x17\xce\xea\x3b\x7e\x5d\x21\x0d\x94\x6c\x9c\x20\x02\xdf\xcf\xdc\x0e\x01\x2a\xcd\xfd\xec\xf5\x98\x93\xde\x7a\x60\x91\x0a\x27\x82\x23\x3d\x51\x53\x62\x25\x6a\xe1\xe4\x35\x81\x00\x48\x75\xdd\xae\x08\xed\xea\xfd\x76\x35\x69\xb4\xbc\xd7\x6c\x77\xb8\x1b\xb5\xe1\xe3\x56\x45\x14\x87\x91\xc1\x2a\xc1\x5f\x40\xf9\x7e\x2f\x9a\x34\xff\x01\x19\x76\x37\x9a\x64\xfb\x13\x12\x61\x43\x9a\x24\xf8\xd1\xeb\xcd\x80\xc7\x81\xfd\x42\xdc\xbe\xf9\x28\x9b\xe8\x33\xe1\xee\x21\x7e\x43\xf1\x89\xae\xf5\x91\xb5\x8e\xe9\x61\xd2\x17\xfd\x2f\xbe\xeb\x54\xcb\xa0\xaf\x58\xb2\x2b\xde\x61\x9d\xe4\x8b\xbe\xee\x7f\x91\x74\x40\xc6\xfd\x85\xbd\x2a\x15\x11\xe9\x0c\x69\xa3\x8c\x0b\x10\x84\x61\x33\x90\xed\xda\xfc\x8b\xb6\xfc\xb2\xce\x9f\xb5\xe5\x57\x3b\x2f\x07\x10\xe8\x1e\x48\x78\x10\xdc\xbf\x12\x13\xbb\x5d\x90\x4c\xab\x25\x5b\x71\x8c\x36\x65\x51\x69\x2e\x32\x99\x42\x23\x69\x51\xbd\xe4\xbf\xad\x0b\xc5\x67\x04\xf8\xa5\x96\x64\x77\xf5\xb7\xe4\x2c\x64\x6b\xe2\xf7\x2c\x7f\xd7\x9a\xf1\x83\x54\xef\x99\x9a\x1d\xe6\xed\x22\x30\xa5\xe0\x2f\xe6\xaf\xae\x01\xd4\x89\x2d\x48\x64\x6a\x11\xd9\x14\xd7\xfb\x7f\xaf\x24\x23\x32\xb5\x07\x6e\x0a\xb7\xc6\x0d\xad\xd9\x12\xa0\xf2\xb5\x32\xf7\x29\x5c\x2d\xad\x65\x8b\x1a\x20\x06\x67\xe2\xc5\x1c\xa3\x02\x4f\x3f\x8f\x70\x09\x1c\x0f\xc8\x99\x02\x6a\xe1\x4e\xfb\x05\xb2\x41\xe8\xcd\xed\x25\x77\xac\xed\x9d\xdc\xc9\x99\x00\xed\xd7\x05\xef\x00\xee\xe3\xb3\xce\xfb\x42\x2f\x3b\x80\xd7\x3b\x52\x75\x6a\xd4\x14\xce\xbf\x03\x97\x63\xa3\xc6\x96\x6e\x54\xe9\x82\xeb\xe8\xde\xb5\xd4\x41\x45\xd8\x21\xae\x6d\x5e\xd0\xa4\x3c\x2c\xb1\x72\x79\x4f\xab\x93\xa0\xca\x8e\x54\x55\x61\x5e\xf0\x46\xab\x6b\x40\x78\x5d\xd7\x84\x1d\x52\x8d\x3e\x01\x40\x6e\x14\xa0\x16\xbd\xa3\x84\x5d\xe4\x89\x41\xc2\x7c\xf2\xd5\x94\x26\x33\x9e\x90\xe4\xab\xe8\x4a\x68\x8e\xc2\x30\x52\x88\x63\xe0\xec\x43\xa3\x35\xdd\xb2\xd9\x39\xd1\xd1\x70\xe0\x64\x47\xc9\x79\xd2\x77\x37\xc9\x5c\xc9\xcb\xc7\x4b\xa6\x1e\xcb\x19\x47\x02\x4f\xa9\x80\x6e\x93\xc1\xf0\xee\xbd\xfb\x5f\x3d\xf8\xfa\x9b\x6f\x93\xee\xad\xbd\xea\x1b\x39\x43\x0d\xfc\x3a\x76\xe2\xa8\x24\xba\x2d\x00\x2a\x05\x66\x05\xee\xde\x63\x17\xf9\x8c\xcf\x17\xcb\xe2\xed\xbb\xf2\x52\xc8\xd5\x6f\xaa\xd2\x09\x70\x57\x85\x46\x49\x82\x83\x1a\xaa\xd1\x07\xc8\x05\xf8\x0e\x93\xf6\xda\xf5\x4c\x99\x5b\xc1\xfd\xb6\xf3\x0e\xea\x39\x85\x6b\xc0\x76\xb9\x61\xd2\x03\xe0\xdd\xe1\x6e\x87\xf0\xb8\x51\x27\xa2\x49\x01\x33\xfb\xb9\x15\xa4\x00\x6d\x02\x08\x87\x73\x3a\x1c\xe5\x87\xe4\x61\x0e\xe4\xa1\x70\xc0\xa0\x9a\x26\xc9\xa7\x38\x90\x88\x6b\x4b\x22\x32\x4f\x04\xae\xe1\x3e\xac\x26\x6b\x50\xef\xac\xa7\x66\x1b\x48\xbc\x29\xa8\x44\xa2\xae\x34\xa7\x83\xd1\xfc\x61\xe1\xfb\x99\xf7\xfb\xb8\xf4\x0d\x14\x93\xf9\xd4\xb6\x01\xbf\xa0\x19\xf3\x17\xef\xfc\xad\x56\x7d\x0e\xce\xff\x44\x7e\x23\x54\x01\x53\x10\x6d\xce\x7a\x17\xf1\x4e\x21\x2a\xcd\x44\x0e\x4c\x80\xc6\xed\x07\xfd\xb1\x3d\xda\x00\x7c\x87\x75\xac\xbe\x9b\x55\x1d\xd6\xf1\x4d\x26\x87\xba\x56\x68\x3d\xc6\x1b\x2f\xf9\x9c\x2b\x2e\x72\xdf\xa6\x5e\x16\x55\x67\xc9\x2a\xf1\x05\xe0\x0c\x2e\x3a\x85\x28\x74\xc1\xca\xa2\xe2\xb3\xce\x51\xa7\x5a\xaf\xb8\x42\xb8\x51\x02\xfa\x8f\x10\x48\x17\xc4\xd6\x8e\x64\xe9\x7a\x7a\x51\xc7\x7c\x48\x9d\x3a\xe6\x99\x3e\x10\x4d\x35\x88\xa2\xb8\x05\x40\x5a\x5d\x30\xcf\x68\x9d\x8d\x33\x00\xad\xc3\x3f\xac\x14\xaf\x2a\x68\xee\x72\x5d\xe9\x0e\x2f\xf4\x92\xab\xce\x05\x0f\x28\xaf\x9e\x1e\x62\xee\xd9\xa4\xef\x7b\xc0\x23\x5e\x63\x29\xda\xa4\xf3\x75\xaf\x17\xa1\x30\xb2\x89\x18\xa7\xcc\x89\xa9\x79\xc3\x32\x67\x48\xde\xab\x42\x7b\x2b\x9d\xa6\x0d\xcf\x60\xb7\xc3\x44\xf7\x7a\xfe\x7c\x55\x5c\x9f\xfa\xa6\x5f\xcc\xc7\xad\xa9\x66\x73\x64\xb0\x8f\x0c\x14\xe7\xe7\x54\xe3\xdd\xe7\x4a\xd1\x9d\x7c\xcd\x4f\x00\x8a\x0c\xa6\xf8\xde\x09\x35\x88\xcf\xd3\x6c\x06\xfd\x39\x15\x21\x48\xd0\x47\x2a\xad\xc7\x4a\xe3\x8f\xed\xb6\x3b\x24\x2a\x8d\xc7\x0b\x4c\x74\x62\x60\x48\x0a\xd1\x01\x12\x57\xa5\x7e\x6e\x68\x77\x80\x49\xfb\x28\x38\x51\xe9\x3b\x7e\x4d\x54\x7d\xfc\x02\xdc\xc6\x92\x27\x9c\x2f\xd1\xeb\x71\x14\xaf\x8e\xc0\x44\x99\x34\x02\x4a\x50\xc0\x4a\x86\xf1\x1b\x00\xb6\x31\x7c\x1f\x70\x7c\x77\x81\xe1\x53\x68\x0d\xac\xc0\x0d\x26\x64\xa1\x07\x89\xf4\xb2\x30\xb6\x5e\xcc\xfe\x42\xba\x5e\x88\x60\xbc\xb5\x68\x2e\x98\xc6\x38\x65\xab\x55\x79\x6d\xab\x04\x1c\x86\x03\x91\x5c\x22\x4d\x38\x26\x05\xd2\x64\xb2\x01\x06\x27\x51\x5c\xcc\xb8\x4a\x88\x99\xae\x1a\x79\x7a\x4e\x19\x1a\x82\x71\xae\xaa\x54\x57\xcf\xab\x5e\xef\x39\xd3\xcb\x74\x5e\x4a\xa9\xd0\x5e\xde\xf1\x90\xdf\xc3\x94\x36\xab\x10\x4e\xc5\x18\xce\x0d\xb0\x4a\xfb\x35\x70\x16\x72\x86\xfc\xde\x97\x8d\x5c\x50\xa3\x89\xf1\xdd\x7b\xd9\xf0\x5b\x77\xcc\x3b\xb9\x67\x3b\xdc\x11\xf1\xd6\x07\x89\x2e\x2e\x61\xd7\x81\x79\xcb\xab\xe2\x12\xd4\x87\x5a\x3e\x3d\x7b\xe1\xae\x68\xbc\x23\x7b\x09\x4e\xaa\x35\x20\x1a\xd7\xd2\xa1\x23\x27\x1b\x0a\x29\xc9\xab\x84\x80\x72\x06\xef\x76\x53\x58\x54\x54\xf7\x1f\x84\x51\x78\x34\x33\x10\x03\x56\xa8\xe8\x46\x57\xd9\x3c\x14\x12\xeb\xcb\x0b\xae\x62\x32\x12\xc6\x7c\x50\x60\x17\x29\x9a\x66\xff\x41\xf6\xff\x41\xf6\xff\x41\xf6\xff\xd3\x90\xbd\x40\x5f\x7d\x05\xb2\x6f\x85\x66\x98\x5c\xd0\xe3\x7f\xa2\xc9\x9b\x99\x55\x92\xf6\x8f\xa7\x9b\xfb\x3b\xfc\x25\x6a\x26\xdd\xdd\x51\x8a\xb7\xcd\xb4\x7b\x3b\x8a\xf1\xf8\xce\x31\x69\x1a\x04\xfc\xfb\xde\x1e\x3c\xbe\x0a\x98\x5a\x54\xb5\x1e\x8d\xe3\x8f\xe1\xf4\x6a\xc5\x44\x62\x54\x16\x24\x81\x7f\x1d\x93\xb5\xc7\xec\xa1\x64\xc5\xae\xc1\x28\x32\xc1\xbd\xde\x45\xaa\x79\xa5\x11\x88\x5d\x4c\x1a\xc6\xc0\xcd\x85\x4f\xfa\x97\xb3\x17\x3f\x59\x01\x22\x62\x5a\x5e\xc4\x05\xc9\x70\x8f\x0f\xe1\xd8\xed\x4d\xa3\xa5\xac\x4b\x3a\x16\x44\xe3\xa8\xdd\x8d\x69\xf3\xdc\x98\xd9\x66\x09\x1c\x7a\xd6\xb9\x62\x65\x31\xeb\x40\x8f\x7e\x72\x42\xf9\xdd\xee\x23\x63\x5f\xf9\x74\xb2\xa9\x54\x9e\x71\x02\x52\x23\xb0\xc3\xce\x65\x59\xb2\x55\xc5\x67\x59\xfd\xdb\x5e\x72\xd5\xa3\xb9\xe6\xea\x99\x81\x38\xbb\x77\x97\xcc\x0a\x50\x79\x5d\x3f\x61\x9a\x99\xab\x29\xeb\x0e\x77\xb7\xdf\x63\xcb\xf8\x1e\x83\xc5\x8a\x2e\x2a\x67\xb9\x1a\x09\x3d\xa2\x3b\x6b\xf9\x79\xa6\x6c\x23\x9d\x9e\xbe\x3e\xfb\x91\x26\xf0\x6f\x42\x74\xfa\xf2\xe4\xf4\xd9\xa3\xc7\x27\x34\x71\x3f\x20\xed\xf4\xc5\x29\x4d\x4e\x5f\x9c\x26\x9f\xdd\x36\x9b\xcd\x4e\xae\xb8\xd0\xcf\x8c\xa4\x88\xef\x5b\x63\x87\x13\xc2\x0f\x4a\x8e\x0f\x93\x40\x1a\x48\xba\x43\x9c\xf1\x94\x69\xcd\xf2\xa5\xa9\x80\x12\x29\x92\x3e\x70\x62\x70\x75\x2b\x7e\x29\xaf\xf8\x27\xf6\xd9\x52\x78\xdc\x9a\x1a\xf5\x3c\xe3\x37\xf4\x5c\xad\x57\xc6\xd2\xf6\xc7\xa2\xd2\x52\x5d\x1f\x4a\xff\xdf\x17\x62\x26\xdf\xa7\x82\x5d\x15\x0b\xd0\xe5\xa4\xeb\x8a\xab\x47\x0b\x2e\xb4\xbb\x4b\xad\xdd\x1c\xaf\xad\x9b\x1e\x89\x99\x02\x59\xcf\xdd\x14\xce\xd3\x4d\xb9\xf7\xd3\x41\x82\xb7\xdb\xfd\xec\xe7\xf2\xa2\x28\x79\xe7\x8c\xcd\x99\x2a\x6c\x81\x6e\xa3\xc0\xe3\xa5\x92\x97\xbc\x2d\xe7\x17\x03\x69\xd5\x39\x5d\x4a\xc1\x13\x0c\x1c\xb1\x03\x7e\x69\x07\xd7\xeb\x25\x20\xe2\x3b\x03\x71\x28\xd0\xf4\xcd\xdc\xc6\x74\xfc\x59\xfe\x52\xe8\xa5\x5c\xeb\x97\x1c\xce\xf6\x6b\x30\xb1\xfe\x11\x24\xab\xd1\xfc\x78\x0b\x2e\x4a\x6f\x99\xa4\x1a\xbc\x1f\x0a\xc5\xe7\xf2\x43\xd2\xe8\xe7\x54\xae\x8c\x54\xf6\x85\x80\xd6\xf3\x25\x13\x0b\xfe\xdf\xe8\xe3\x95\x2a\x66\x5c\x68\xdb\x47\x51\x9d\x7c\x00\x43\x28\x2e\xd7\xa1\x1f\xb3\x03\xea\x0e\x6a\xfa\xaf\x96\xce\x59\x31\xb1\x5b\xb8\x5b\x7b\x7b\xac\x8a\x17\x67\x1f\xb7\x3b\xfa\x0f\xbd\xf9\x1f\x7a\xf3\x3f\xf4\xe6\xff\x83\xf4\xe6\x37\x11\xb9\xf9\x6f\x43\x2c\x5a\x55\xd3\xd9\x52\xbe\x3f\x20\x18\x6b\xec\x32\x34\xee\x1f\xdc\x89\x11\xb4\xd4\xac\x3c\x65\x0b\xbe\xdd\x1a\xc1\x03\xbb\xa8\x62\x21\xc2\x8a\x2d\xf8\x11\xc7\x0f\xe9\x70\xb7\x23\x1f\xa1\x48\x8b\x39\x1a\xec\xb7\xea\xa9\x50\x20\x30\x83\xbc\x96\x83\x9d\x92\xb5\x21\xd8\x2b\x6e\x4c\x08\x34\xa5\x7b\x10\x8c\xb9\x35\xbf\xbb\x89\x9e\x4b\xca\x22\xb1\xd0\x69\x62\xd8\x68\xd0\x02\x64\x81\xca\x4b\x41\xe3\x7c\xc5\x77\xe4\x53\x68\x61\x8d\x31\xce\x4c\xff\xf5\x6c\x22\x8d\x3f\x03\x84\x5b\xfa\x61\x09\xd9\x48\xf1\xb8\x2c\xf2\x77\x59\x34\xc6\xb7\xeb\xcb\xd5\x2b\xd0\x35\xef\x6e\xe8\xde\x18\xdc\xfd\x37\x27\x61\x56\x54\x70\x82\x66\x9f\x36\x0d\x49\x9a\x26\xb8\x36\x3e\xbf\xb1\xca\xba\x4c\xc8\xa6\xad\xbb\x15\x5b\x14\xc2\x18\xce\xec\xc0\x96\xc2\xef\x9e\xb0\xd4\x89\x71\x73\x8c\xb6\x8f\xeb\xc9\xec\xc2\x9c\x17\x65\x43\x96\x05\xb5\x1e\xcb\xb5\xd0\xc7\x51\xea\x8a\x2b\xb3\xc7\x6e\x25\xbb\x2f\x62\xb2\x1b\xb6\xf3\xad\x02\x24\xd7\xe4\xad\x65\x6a\x60\x6e\x2d\x66\x17\x35\x2a\xb2\xaf\xd7\x8c\x48\xfc\x8b\xcf\x20\x13\x6c\x76\x94\x62\x6e\x40\xe3\xae\x1a\x8c\x27\x81\x62\x41\x39\x9d\x4c\x81\x91\xcf\x99\x46\x46\x67\x5f\x63\x3c\x2b\x9f\xb3\x3a\x92\x08\xb3\xe0\x91\x68\xc7\x39\xf5\x9d\x2b\xed\x41\xef\x82\x5d\x7e\x05\x7d\x76\x0b\x6c\xa5\x0e\x05\xed\x0e\x46\x90\xce\x1e\xf2\x5e\xaf\x1a\xe1\x8a\x76\x87\x60\x42\x03\x9d\xc0\xaa\x11\xd6\xef\x13\x49\x94\xe1\x33\x0b\xc8\x2c\x63\x78\x3b\x8d\xbe\x73\x3c\x62\xdf\x51\x68\xa8\xd7\xb3\x83\x13\x08\xe3\x9d\x51\x60\x32\x63\xf4\xd8\x1d\x12\xd3\x88\xe9\xc6\x5b\x3d\x8e\x24\x6a\x00\xeb\x26\x33\xa4\xd8\xf9\x53\x78\x53\x6e\xb7\x48\x8f\x7d\xcb\x1a\xe3\x0c\xb1\x09\x9f\x52\x45\x50\x49\xfb\xfd\x82\x52\x2a\x71\xaf\x27\x0c\x3b\x0d\x9e\x0a\x5e\x77\xea\x6d\x60\x09\x03\x57\x31\x8b\xf3\xa8\xf4\x03\x09\xe5\x9d\x63\x83\x01\x72\x30\xe2\x2d\x8a\x3b\x58\x45\x6d\xdc\x81\xa3\x34\xf0\xb9\x45\xc2\xba\xcc\xc1\x7f\x60\x3a\xa7\x8c\x19\x9d\xfc\x44\x0b\xf6\x21\x38\xfb\x80\x7a\x5f\x81\xa2\xdf\x08\x4d\xc0\xa0\x57\x20\x06\xba\x6f\x81\xee\x1b\x59\x4a\x2a\x50\x01\xb7\x9b\xb1\x07\x58\x53\xe4\xec\xf1\x04\xfa\x7a\x80\xe1\x9e\x13\xe8\xee\x5d\x2b\x58\x79\x00\x17\xdd\xbf\xbf\x4d\xd8\x05\xbd\xc5\x28\xce\xdb\xb9\xfd\x3f\x62\x83\xb7\xa4\x15\xc2\x68\xe3\x44\x0c\x06\x8d\x27\xc6\x81\x50\x3d\x96\x42\xf3\x0f\x3a\x21\x97\xc5\x87\x42\x54\xd9\xc4\x29\x53\xe7\x29\xc3\x28\x51\xa6\x4c\x82\xa7\x24\xa0\xbc\x6c\x63\x13\xb3\xdc\x59\x40\x44\x08\x88\x78\xa3\x9b\xd6\x4c\x53\xaf\xca\xf2\x94\x39\xb3\x9f\x90\x63\x0c\x96\xab\xd6\x4a\xb9\xc7\xbb\xad\x15\x1b\x57\x47\x96\x1f\x18\x7a\xc0\x8d\xf0\x24\x72\x03\x8d\x89\x0b\x67\x86\xd6\x6c\xa2\x4c\x59\xf3\x3e\xda\xed\x88\xf1\xf3\x74\xf3\xf4\xd1\x29\x30\x5d\x3e\x8e\x6a\xb4\x74\xe9\x6a\x47\xf7\x8e\x4d\x81\xbe\x1a\xd0\xb4\x5a\x2c\x39\x69\xdc\x18\xfe\xc6\x57\x7e\xa3\xaa\xa9\xb1\x23\x96\xb2\x6a\x17\xf2\x81\xcf\x53\xa8\x4d\x04\xad\x4d\xa6\x08\xf8\x59\x19\x90\x2a\xef\xbb\xc5\x2e\x2b\x02\x26\xf7\xf5\x72\x90\xca\x17\x52\x70\xea\x81\x1a\x73\x00\x16\xbd\x1e\xca\x69\x91\x2a\x3e\x5b\xe7\xfc\x65\xb1\x58\xea\x1a\x49\x69\x22\x49\x19\xd9\xaa\x04\x3c\xa7\x1d\x67\xa0\x26\x25\x98\x9c\xbb\x6d\xb8\x86\x6d\x58\x10\x06\x18\x64\x13\x76\x97\xf0\x3b\x86\x11\x03\x66\x56\x10\x37\xa7\x95\xfd\x71\xea\x36\x94\xdf\x73\x6a\x07\x37\x84\x6b\x73\x96\xe6\x18\x88\xe1\x79\xf0\xe0\xa5\x3a\xd8\x6c\x6b\xec\xf1\xc8\x12\x0c\x07\xf4\xa7\xe1\x11\x4d\x96\x20\x00\x99\x4f\x96\x53\xaa\x27\x4b\x6b\x56\xe0\x59\x50\x1a\x5b\xfa\xc9\x86\xa5\xdf\x05\x92\xd8\x99\xde\x5d\x82\xcd\x86\xef\x7b\x01\x7d\xcb\x4f\xeb\x5b\x92\x05\xf4\x7d\x39\x59\x4c\x29\xdf\xdb\x05\x72\xb2\x98\x92\x15\x32\x84\xd2\x62\x47\xe6\x11\xfd\x75\x19\x50\xdd\x7e\x1d\x32\xc7\x3b\x92\x63\x6f\x7c\x4e\x73\x90\xe0\xdb\xbf\xe5\xa1\x6b\x72\x8e\xb7\x5b\xe9\x0c\xb7\xf3\xdd\x0e\x83\x40\xef\xa3\x02\xc6\x36\x21\x45\xf2\xa7\x3f\xe5\xf6\xc8\x9c\xad\x2f\xc0\xbf\xfe\x82\x2b\xf0\x34\x69\x5c\xbe\xce\x65\x90\x08\xf0\x3f\x05\xcb\x10\x46\x65\x3f\x39\x2e\x9d\x0c\xae\x4a\x48\x69\x12\x38\x48\x5e\x8c\xfb\x4d\x42\x0a\x93\x52\xf9\x46\x13\x3f\x03\x82\x6e\x0e\x8f\x36\x32\x16\x3d\x1a\xbc\x06\xaa\xe8\x58\x03\xab\x75\xcb\xc1\x06\xa0\xb8\x6f\x97\x43\x0b\x1c\x5a\xd8\xd4\x60\x98\x93\x0a\x5b\x3b\xc0\x61\x53\x8a\xe9\x8e\xf0\x5d\x8d\xe6\x7e\x29\xca\xf2\xb9\xa5\x04\xeb\xe6\x4d\x49\x36\x35\x4c\x0e\xfc\x2c\xa7\x74\xb0\x57\xe7\x25\xcf\x79\x71\xc5\x0f\xb0\x9c\xeb\xb6\xdf\x8f\xca\x3f\x29\x66\xaf\x4d\x60\x81\x76\xc4\x30\x72\xdd\x1d\x52\x18\x35\x12\xd2\x88\x4f\xca\x29\xd0\x13\x3b\x22\x26\xc5\xb4\x21\xe4\x82\xa6\x2c\x72\xf1\x53\xe2\x5b\x34\xec\x06\x8f\x5c\x64\xf1\x46\x4f\x18\x9c\x19\x36\x4d\xe7\x45\xa9\xb9\x6a\xed\x0d\x44\x8f\xb6\xaf\x7a\x37\xb0\x9b\x77\x03\xab\xf4\x4b\x83\xf9\xf8\xec\x24\xda\x08\x76\x6b\x2c\x99\x98\x95\xdc\x2d\xa2\x9d\x07\xbf\x47\xd6\xa2\x7d\x97\xdc\xb4\x41\xdc\xae\x78\x6a\x15\xa2\x67\xba\x6d\x4a\xe3\x29\x48\x5d\x4b\x13\x39\x1d\x23\xb7\x4d\x60\xf4\xcd\xac\xb4\xde\x35\x84\x63\x08\x33\xd3\x58\xba\xd6\xdd\x11\x55\x07\xb7\x34\x98\xee\xe2\xb0\xe1\x30\x3a\xe4\xb6\x05\xc6\x9f\xb8\x8d\x60\x49\xf9\xe8\xa0\x27\x93\x50\x71\x6d\x86\x8e\x3e\x75\x48\xfb\x9d\xbe\x16\x97\xad\x3b\xbe\x88\xc6\x82\x30\x71\xbf\xcc\x5d\x63\xf6\xc2\xa4\x6c\xee\x3b\xb0\xe8\xeb\x3a\x56\xdf\x88\x55\x27\x6c\xea\xb6\xc8\x68\x0f\x52\xb7\x88\x6c\x0a\x91\x77\x80\x0b\x20\x62\x07\x88\x0b\x02\x15\x5c\x50\xe6\x68\x6d\x43\xcb\x16\x86\xd6\x35\x6e\x01\x45\xca\xac\x5d\x2b\xda\x84\xa9\xcc\x20\x71\x8f\xee\x20\xf5\x70\x4d\xf6\x01\x2b\xb7\xfb\x98\x65\xe7\x6d\xb6\xb9\x72\x87\x3f\x66\xdb\x1b\x68\xf7\x7b\x40\xbb\x23\x47\xbc\xbb\xfb\x4f\x05\xdb\x5c\x38\x8e\x99\x3a\x80\xde\x59\x53\xb4\xe4\x2c\x64\x6b\xa2\x31\xc1\x55\xb7\x98\xe0\x1e\xe6\x55\x5c\x1b\x02\xf4\x19\x67\x57\xfc\x47\x29\xdb\xea\x17\xd5\x23\x23\x66\x39\xcc\x82\xe0\x34\xde\xaa\x34\x1e\x8f\x33\xd7\x36\x8e\x01\xaa\x10\x8b\xb8\xb5\xda\xad\xe0\x30\xcb\x79\x13\x38\x83\x5d\xe7\x4e\xd0\x56\x12\x2e\x52\x9f\xbe\xc3\x9f\xa9\xea\xfa\xbc\xb8\x0c\xfb\x4d\xbb\xc8\x1e\x20\x79\x04\xb3\x04\xcb\x33\x3e\xbc\x0b\x1c\xa5\xc2\xe6\xde\xf5\xbc\xbf\xfc\x83\x70\x7d\x63\x39\x3d\xf4\x35\x4c\xae\x40\x77\xef\x83\xfd\x73\xdd\x52\xd8\x60\xb7\x6b\x13\x3c\x5b\x08\xce\x32\xa0\x63\x1f\xde\x05\xb6\x70\x1f\x97\xd0\x7d\xbe\xee\x13\xfd\x5c\xe0\xd8\x52\x0e\xc6\xb2\x8f\x21\xb8\x92\xd0\xde\x93\xc6\x38\xcb\x2f\xb8\x7e\x5d\x19\xa6\x66\x5e\xa8\x4b\x23\x2b\x22\x0c\x9c\xe8\xd7\xd5\x32\x14\xcc\x69\x70\xab\x0e\x69\x6b\xa8\x2c\xc9\x9c\x1a\x7f\x00\xab\x94\x8d\xdd\x1c\xdd\x8f\x0b\xb8\x82\x97\xf0\xcf\x25\xfc\xb3\xa0\x87\xa7\x6f\xd5\xeb\xad\x8c\x78\x50\x0a\x13\xe0\xe2\xf4\xc5\xe9\xf8\x32\x68\x71\x56\xd0\x3c\xce\x66\x51\xd2\xcc\x26\x1d\x0d\x77\xe4\xaa\xe5\x1a\x5d\x20\x3c\x9a\x51\x4e\x66\x8d\x46\x5f\x9f\xfd\x38\xbe\x8c\xa4\x31\x97\xb5\x7d\x54\x7f\x88\xc9\xc4\x34\x3a\xc5\x59\xa3\x96\xd3\xd6\x1a\x62\x51\x4f\xa9\x29\x83\xc9\xb2\x45\x92\x50\x2f\x35\x9a\xc1\x25\x4f\x5a\xdd\x9c\x2e\xda\xee\x73\x9f\x47\x2f\x3e\xe9\x42\x3f\x6f\x6b\x78\x79\x4b\xc3\x4b\xba\xfc\xa4\x86\x3f\x44\x0d\x03\xf7\x84\x06\xe0\x10\x29\xe5\xea\x51\x75\x2d\x72\x8c\x2e\xdc\xbe\x23\xfb\x22\x7d\x34\x20\xa5\x17\xa3\x61\x74\x31\xd1\x53\xd2\x0c\xd1\xe3\xba\x82\x6b\xa8\x4b\xf9\x18\xec\xba\x32\x81\x70\x13\x1d\xe0\x8d\xe8\xf5\x5a\x3c\xa2\x04\x6a\x6d\x4c\xa3\x2e\xc4\xa6\x00\xcf\xde\x2c\xfa\xbd\x23\xef\x1b\xf3\x33\x33\xbe\x3c\xd5\xa1\x73\x0b\x46\x33\x02\x21\x95\x56\x37\x17\x58\x99\x02\x68\x45\x39\xf9\x10\x03\x61\x55\x72\x2b\x50\x46\x82\x43\xe8\x8a\x3a\xa1\x35\xa4\xf2\xfd\x4d\xe7\x25\x2f\x08\x22\xd9\xd5\xe1\x2e\x30\x58\xc9\xa8\xc3\x54\x8e\x47\xe0\x32\x28\x1c\x54\x4d\x57\x2a\x8c\x66\xce\x59\xc6\x69\x41\x81\x8d\x09\x3d\x86\xed\x8a\x77\x4d\x28\x5e\x9c\x8e\xaf\x60\xca\x9b\xa9\x70\x20\xcc\xb4\x01\x59\xd8\xeb\x1d\x96\x08\x9b\xdf\x94\xca\x43\xa9\xe0\xdf\x3b\xeb\xf5\x1a\x15\x4e\x5f\x9c\xda\xd1\x96\x74\xff\xb0\x92\x79\x94\x64\x30\x86\x0b\x52\x52\xf6\x7a\xe6\xef\xbc\xd7\x5b\xa3\xf2\x68\x8e\x77\x3b\x20\xb1\xde\xd1\x96\x25\x7f\x8f\xce\x10\x27\x6e\x5a\xf1\x8e\x9c\xdc\x56\xc8\xcf\x05\xde\x91\x17\x75\xb9\x50\x6c\x8d\x8e\x86\x78\x47\x4e\x5b\xb3\x20\xe7\x71\x4b\x8e\x91\x8b\x2b\x26\x66\xf2\x12\xe1\xe0\xfd\x86\xee\x3d\xc0\xde\x2d\xfe\x2e\x99\x6f\xb7\x0f\xf0\x8e\xbc\x6d\x81\xad\x65\xb1\x77\xe4\xac\x3d\x62\xcd\x3e\xae\xff\x34\xbf\xc2\xc7\xc8\x73\xab\x66\xf7\x34\x3d\x27\xb1\xbb\xda\xbc\xa3\xc3\xe6\xf0\x4a\xc8\x34\xb1\x8c\xe1\xf7\x26\xf6\x5a\x76\xed\x3e\xb3\x73\x02\x9a\xfa\xca\xc4\xd3\x7b\x25\xb3\xf7\xd6\x17\xe9\x5d\x20\x7c\x4e\xc8\x42\x66\x6b\x4f\xd8\xbc\x88\x08\x99\x53\x27\xa1\xf9\x2b\xbf\xce\x1e\xbb\xdf\xb0\xd5\xb3\x78\x2a\x5c\xfa\x8f\x8a\xcf\xb3\xb7\xa4\x09\x76\x76\xb6\xdb\x45\x57\x75\xfe\x99\x57\x35\x08\xbf\xc5\xeb\x8a\x3f\x79\xf1\x9c\x76\x51\x24\x4a\x08\x28\xc6\x1a\x47\x6c\xb7\x5d\x67\xc1\x30\x93\xb9\x99\xd1\xc3\x94\x26\xdf\xff\x99\xc4\x0c\xd1\xe9\x42\xd2\x10\x4c\xd0\x0f\x8f\xea\xc6\x5d\x0b\xbe\x72\x9a\xa9\x60\x23\x43\x75\xdb\x15\x4d\x75\xcb\x7d\xee\xae\xdc\x40\x9c\x00\x19\x01\xde\x66\xc3\x6f\x2c\x75\x72\xef\x5b\xa0\x4e\xc0\x37\xd7\x08\xa1\xef\x3e\x00\x6a\xa3\xa8\xa7\xa7\xd7\xeb\x9a\x1d\x7a\x9b\xed\x07\x46\x0d\xd2\x24\xdc\xb9\x86\xc0\x79\xc7\xaf\xeb\xdd\xa7\xdc\x64\x79\xe8\x62\x62\xd3\x4d\xab\x47\xb9\xc1\x83\xd0\x53\x9c\xfb\xf9\x2e\x88\x0f\x78\x0c\x1e\xe4\x41\xa2\x23\x47\x35\x78\xcf\xb2\x54\x71\x36\x33\xec\x15\xc8\xac\x32\x3b\x29\x3b\xe2\xe8\x11\xd0\xef\xad\xdb\xa7\xef\x80\xe0\x72\xf3\x69\xec\xeb\x68\xd3\x18\x07\x16\x49\x73\x08\x0c\x11\xd9\xca\xd1\x4d\x6d\xf5\x66\xce\xf6\x9c\xa2\xf6\xe5\x6b\x15\x53\x6a\x6f\x0e\x94\xdb\xa2\x10\x65\x64\x47\xf6\xf7\xc3\xe1\xdc\x87\x14\x7b\x67\xcb\x9b\x0d\x6b\x60\x42\xb6\x5b\x8e\x72\x88\x0c\x0a\xa9\x18\xef\x46\x66\xcd\xf7\x8d\xc2\xb0\x83\x85\x24\x2b\xd7\x40\x02\xd1\xef\xa0\xc3\xc6\x44\x39\xd0\x39\x5a\x23\x1c\x10\x4b\x07\x62\x17\xdd\xde\xea\x32\xec\x28\x13\xf8\x34\x6a\xd1\x80\xd3\x62\x2c\xd6\x0e\x11\xf1\x5d\xdd\x5a\xa3\xd9\xdb\x9e\x3d\x90\x47\xb9\xee\x4a\x35\x51\xa3\x60\x27\x07\x74\x6b\xef\x62\x96\x56\xec\x8a\xbb\x7d\xa5\x00\x68\x6d\xa5\x84\x6a\x47\x0c\x01\x54\x63\x33\xb8\xc5\x2d\x7f\xd1\x38\xd9\x75\xa7\x61\xde\xe6\x0d\xda\x26\xda\x0b\x7b\xbb\x2d\x98\x85\x81\xae\xca\x12\x1b\x3b\x67\x98\xd7\x44\x26\xff\x8d\x3e\x5c\x53\xad\xdd\x2c\xe2\xa0\xb4\x86\xf9\xda\xab\xbc\x90\x88\x1f\x4e\xac\xff\xea\x34\x27\x7a\x32\x9c\x1a\x97\x74\x49\xf9\xe4\x9e\xd1\xdf\x75\x6b\xe5\x1d\x7c\x36\x2c\x8f\x02\x9e\xbe\xd0\x92\x79\xa7\x6b\x85\xa4\xbf\xe9\x26\x22\xd0\xf4\x32\xad\x4c\x6c\x9f\xea\x06\x1f\xc0\xe4\xf8\xcb\xff\xea\xd8\x22\xaf\x5f\x3e\xa3\x49\xdf\x57\x78\x29\xa5\xee\xf3\x7e\xd2\xf9\xf2\x38\xd9\x61\xec\xdb\x03\x69\x88\xf3\xcb\x7b\x03\x8e\x00\x75\x87\x51\x62\xab\x64\xb6\xee\xe8\x39\x5b\xad\x0a\xb1\x80\xfe\x66\x4c\xb3\x0c\xf4\xab\x85\xdd\x11\xc7\x6f\x2b\x29\x46\x10\xeb\xa4\xe2\x9a\xae\xf5\xfc\xe8\x9b\x11\x44\x32\x7b\x70\x9f\x24\x7d\x18\x2d\x5a\x0b\x5e\xe5\x6c\xc5\x51\x4b\x84\x10\x30\xbb\x75\x7c\x75\x31\x87\x80\x5c\x18\x63\x37\x82\x16\xa7\xcf\x80\x2d\xea\xd8\x9a\x3a\x50\x30\x75\x31\x3f\x80\x8e\x91\xfe\x34\x66\xd1\xad\x1f\xb0\xbd\x9a\x70\x3f\xfb\x1d\x3d\xb9\x3b\x1d\x27\x7f\xba\xe4\xb3\x82\x81\x5d\xd6\xe4\xee\xb4\x9f\x6c\x92\xbe\xea\x27\xbb\x24\x53\x91\xc7\x25\xec\xd7\xc8\x8e\x09\x4c\x7e\x36\x87\xf4\x3e\x50\xb4\x74\x32\x31\xdb\x8f\xbb\xf8\x69\x5e\xc8\x0f\x5e\x9a\x10\x6e\x67\x24\x1f\x1a\xf8\x2c\x3f\x32\x92\x5e\xbf\xc9\x8c\x24\x6b\x22\xa7\x93\xc1\x74\x94\x58\x19\x52\xdd\x34\x03\xab\x79\x90\x9d\x76\x07\x78\x07\x4d\xda\x96\xf8\x41\x33\x25\x05\x59\xf4\x61\x03\xa5\x09\x61\xa5\x26\xf0\x77\xba\xdd\x22\xd1\xeb\x75\x4b\x18\x3e\xfc\x43\x45\x06\x88\xc2\xfc\x4c\x50\xd2\x87\x1f\xfd\x04\x9b\x48\xc4\x08\xdc\xd0\x13\x08\x7a\xa5\x5d\xb0\x50\x23\x40\xd3\x7b\x07\xe6\x50\x4b\xbe\x6f\xdb\xc5\x5b\x6c\xbb\xf8\x44\x4c\x89\xa4\x17\x13\x95\x16\xb3\xa9\xf3\xce\x04\x84\x38\xaf\xfa\xfd\xa0\x1f\x61\x74\x30\x62\x0f\x25\x58\x86\x07\xa2\x72\xc4\xfa\x7d\xec\x92\x26\x6c\x8a\x54\xf8\x69\xfd\x3a\x47\xec\xa1\xba\xb1\x82\x1d\xc8\x3a\xaa\x64\xc4\x82\xc0\x1b\x04\xa0\x4b\x60\xf9\x6d\xcf\x2d\x0d\x95\xed\x4d\x8c\xdc\x48\xe8\xa6\x98\x65\xf0\x8b\xc0\x50\xb2\x21\x68\xb0\x74\x95\x95\xbb\xdd\xa1\xbf\x8f\xef\xd0\x84\x6e\x8b\x76\xc9\xe1\xda\x32\xb3\xb6\x26\xf6\x20\x1c\xb5\x31\x84\x1f\xeb\xdb\xdf\x19\xfc\x26\x05\x85\x28\x6a\xa4\xa2\x6c\x72\x17\xd4\x6a\x6c\x72\x0f\x42\x42\x6f\xf2\xaa\xca\x0a\x62\xb6\x79\x56\x91\x70\xb6\xb3\x7c\x37\x02\x05\xdc\x18\xfe\x69\xcc\x0c\xce\x5c\x20\x35\xc8\x31\x83\x29\xdd\x10\x26\xeb\x69\x1d\xd1\xa1\x29\x9f\xaf\xb1\xe4\x25\xe2\x69\x21\x2a\xae\xf4\x53\xa1\xa5\x51\x8f\x75\x45\x64\x45\xe9\x2c\x28\x1f\x83\x7d\x14\xb8\x11\x99\x38\xcc\xac\x53\xe9\xeb\x92\x77\x34\x53\x0b\xae\xd3\xce\x2b\xb0\x0c\x5d\x29\x79\xc1\x2e\xca\xeb\xce\x25\x67\xa2\xb2\x11\x97\x21\x60\xb3\x31\x1e\x0b\x41\xb4\xbf\xa8\x3b\xfb\xa2\x63\x94\x85\x5c\x73\xd5\x29\xaa\x4e\x21\x8c\x9d\xbf\x8f\xba\xac\xe8\xf5\xe4\xda\xcd\xeb\xd1\xd0\x6c\xb8\x44\xcb\x55\xe2\xec\xa4\xa1\x91\x47\x1a\xab\xb1\x4a\x05\xff\xa0\xcf\x8a\x8b\xb2\x10\x8b\xb1\x70\x59\x96\xb1\x00\xaf\x92\x38\x1b\xe6\x8a\xad\x56\x5c\xcc\x8c\x22\x18\xe8\xb6\x83\x0a\x22\x9d\x17\xaa\xb2\x0a\x25\x4c\xae\xed\xdc\xea\x28\x7e\xd5\x85\xd4\x5a\x5e\xee\xc1\xb1\xdf\xae\x29\xbe\x89\x74\x8d\xc1\x58\xb5\xae\xb4\xdd\x76\xeb\x8f\xf4\xc2\x40\x7c\x38\xf3\x93\x33\x33\xd5\xcf\x20\x58\xb5\x9a\xbe\x11\x6f\x44\x07\xa2\x7e\x97\xc5\x2c\x9a\xd9\x7a\x22\xdd\xfc\x3e\xd2\x5f\x74\xd0\x17\xd2\xc4\x3b\xaf\x42\x27\x5f\xe0\xce\x5c\xae\xc5\x2c\x7d\x23\x3a\xcf\xc1\xe5\xea\x82\x77\xbe\xd0\x72\xf5\x05\xe9\x7c\x61\x87\xf5\x05\x01\xbf\x2b\xa7\xde\x7c\x23\x3a\x36\xc8\x68\x76\x7c\xbc\x28\xf4\x72\x7d\x91\xe6\xf2\xf2\xf8\x3d\xbf\x58\xb1\xfc\xdd\x11\x68\x11\x54\x71\x71\x6c\xf6\xc2\x11\xd8\x87\x73\xf5\x5f\xb6\x2b\xa6\x31\x5c\x5a\x2e\x12\x45\x73\x8f\xf5\x93\x4e\xd2\x3f\x1c\xf8\xe8\x60\x25\x64\x1c\x4b\xad\xdc\x0b\xa4\x00\x67\x80\x0b\xfd\x93\x9c\x79\xc3\xc3\xee\x70\x14\xa7\x3a\x52\xcd\x2e\x88\x8b\x8a\xa7\xe9\x75\x2d\x1d\xc0\x23\xfd\x1d\x1d\xf4\x7a\xd7\xc6\xe7\x3e\x07\x93\xd0\x61\x74\xcd\x16\x35\xe5\xdb\xce\x97\xa1\xc4\x0c\x3c\x18\x37\x77\x8c\x63\x83\xaa\x4c\x84\x67\x9a\x80\x6a\xe7\x38\xaf\xaa\x84\xe4\x60\xbc\x69\xf3\xc0\x2c\x14\x48\x14\x12\x99\x36\x57\x1f\xef\xa8\x2c\xc4\xbb\x8f\xf7\xe3\xd3\x15\x2f\x21\xe4\xcf\x75\xc9\xab\x25\xe7\xfa\xa3\x00\xe4\x26\x65\xd3\x0c\x30\x73\x28\x7b\x34\xf1\xdf\x2b\xae\x1f\x69\xad\x8a\x8b\x35\x84\xf0\x22\xe0\x60\x06\xb4\x5b\x68\x6b\x1d\xe1\x16\x1b\xad\x1f\x0e\xae\xae\x23\x91\x02\x03\x97\x57\x95\xb3\x65\x67\x34\xca\x42\x36\x07\xe3\x7d\x93\x5e\x1b\x0b\x3d\xaf\x2a\xca\x76\xa6\x35\x70\x43\x28\xb9\x96\x4e\xf8\x9e\xd3\xab\x7e\x7f\x24\xe8\x62\xbb\x45\x0b\x5a\x80\x09\x01\x51\x74\x9e\x5e\x14\x62\x66\xac\x19\x88\x20\x39\x78\x80\x10\x79\x98\x3a\x70\x82\x26\xee\x68\xb4\xe7\x6c\xd5\x4a\x16\xbe\x7e\xf9\xec\xa6\x74\xb7\x5c\x76\x02\x6f\x2b\xa7\xf8\x95\x7c\xf7\x91\x72\xdf\x97\xf2\xa2\x35\x03\x28\xb5\x31\x12\x14\x56\x87\x28\xba\x6a\x0c\x04\xde\xb1\x88\xc9\xab\x12\xc1\x3b\x0f\xe9\x52\xf1\x79\xaf\xd7\xd2\x35\xb2\x79\x20\xd5\x44\xc2\x4c\x19\x51\x74\x16\x37\xd9\xd2\xe0\x2e\x6c\x41\xd5\x10\x01\x5b\x91\xa4\xfb\x17\xd6\xd0\x1c\xd0\xbc\xaa\x7a\x3d\x9d\x9a\xab\xcc\x24\x98\x5f\x90\x14\x26\xda\x24\x87\x2f\xb7\xee\x23\x85\x38\x38\x4c\x9a\x55\x91\x28\x46\x02\x73\xff\x0c\x84\x5d\x77\x49\xc5\x38\x49\x32\x05\x5d\xc2\x36\x03\xd6\xea\xba\xe4\x67\xb0\xeb\x71\xfc\x01\x05\x5e\xf1\x0f\x9a\x7e\x30\x68\xa5\x7e\x93\x81\xed\x1f\x39\x28\x05\xd8\x03\x49\x10\x62\x40\x34\xcc\xa2\x9c\x41\x42\x35\x2a\x27\x7a\xda\xeb\x35\xf1\x0a\xa4\x61\xe2\x03\x2d\x8d\x79\x13\x85\x31\x62\xf2\x33\xde\xb8\x1c\x58\x3c\xa2\x59\x7c\x17\x1b\x38\x8d\x8d\x8f\x99\x2a\x18\x12\xf8\x46\x36\x0f\x5d\x62\xf2\x12\x70\x97\xfc\xf8\x78\xc5\x28\x10\x4a\x23\x1e\x5d\x6c\x23\xbc\x87\x1f\xe3\x4b\x6f\xd4\x84\xf7\xa6\x19\x12\x38\x1e\xc8\xca\x53\x96\x8e\x78\x37\x43\x01\xeb\xc4\xb0\xbc\x21\xcc\x2d\xb8\xb5\x03\xeb\x73\xc5\x95\x7e\x25\x1f\x5d\x54\xb2\x5c\x6b\xfe\x5a\x41\x2c\x62\x39\x42\x37\xe4\x6d\xb7\x0c\xc4\xd0\x8a\x9e\x23\x85\x6d\xe8\x64\x88\x4a\xfa\x46\x7c\x06\x0f\xf4\x87\xb9\x1e\x59\x73\x3d\x3e\x8e\x35\xb8\x98\xc0\x59\x45\x10\x3e\x6b\x03\x67\x34\xab\x71\xf1\x0e\x34\x6d\xdc\x1c\xb1\x91\xfd\x43\x5b\xf0\x04\x84\xb4\x2b\xda\x8f\x67\x61\x6d\x50\x2f\x80\xc6\x8c\xbc\xad\xfc\x4d\xe1\xcf\xe1\x21\x43\x55\x4f\x31\xa8\xe8\x29\x6f\x37\xf2\x05\xfc\xbf\x43\x87\xb5\x2d\xbb\xdd\xeb\xf9\x35\xaf\x7f\xa5\xac\x2c\x7b\x3d\x2f\xa9\x04\x97\xcf\x1d\x26\x97\x87\x90\xd5\x01\x6e\x6a\xe0\x6c\x30\xb6\x1a\x32\xb8\x34\x3c\x57\x11\xd9\x0c\x0b\x43\x7d\xaa\xd8\xbd\xc9\x75\xf7\xe3\xab\xe7\xcf\x9e\xfe\x00\x24\x8e\xbb\x12\x8d\x33\xaa\xa2\xc6\x99\x44\x83\xe1\x8c\x87\x72\xc9\xd9\xcc\x89\xca\x80\x1d\x37\x2a\x93\x1d\xdc\x52\x54\x79\x4a\x18\xbe\xe2\xc1\x07\x6e\xba\x13\xc6\xfa\xdb\x9a\xab\xeb\x33\x5e\x72\xb0\xea\x04\x89\x03\xc4\xef\x84\xa6\xc8\x15\x1d\x90\x6b\xa0\xfe\xcf\x21\x14\xe1\x3d\xdc\x16\xf8\x28\x78\x2a\xd5\x42\xe0\x40\xfd\x3d\x39\xf9\xfe\xf5\x9f\x7b\x3d\xf7\xe7\x80\x38\xf4\x10\x1c\x92\x81\xaf\x96\xbc\x13\x13\x5a\x51\x64\xa8\x35\xf8\x5f\x15\xa2\xc3\xcc\x33\x36\x17\x4a\xbe\xaf\xc0\xdb\xa9\x7e\xc1\x26\xc1\x23\x17\x5e\x16\xe2\x73\x02\x1d\x42\x7d\xcf\xe1\x76\x71\x19\x63\xf7\xd7\xa8\x73\xeb\x7b\x76\xbb\x4d\x2e\xa4\x2c\x39\x8b\xee\xa3\x46\x76\x7c\x29\xd3\x25\x82\x50\xc9\x0e\x17\x02\xc9\x07\x06\xd8\xd1\x27\x4d\x60\x99\x92\xba\xcc\x23\x1d\x95\x78\xa4\xa9\xa7\xb1\xbd\x24\x50\xba\x47\x31\xfc\xe5\x23\x80\x82\x89\x17\xd0\x33\x66\x60\xa8\x4d\x4a\x3a\x18\x95\x0f\x7d\x94\xba\x51\xe9\x79\xb2\x82\x0a\x63\xe7\x45\x2f\x26\x85\xe1\x61\x81\x54\x9a\x57\x47\x47\x84\x59\x2a\xbf\xc2\x40\x5e\xc0\x7e\x40\xd2\x51\x49\x96\x83\x87\xda\xb6\x55\x76\xd0\x2a\x70\x70\x65\xb0\x0e\xb7\x4d\xd6\x00\xe5\x74\x30\xca\x1f\x56\x4d\xae\x14\xa2\x23\xb9\xa4\x49\x3e\x05\x35\xb2\x7d\xb9\xe4\x62\x52\x01\x5c\xbb\xdd\x6e\x67\x06\xfe\xe1\x50\x56\x5c\x8b\x55\x42\x56\xc3\x2b\x76\xa2\xa7\x54\x10\xee\x15\xb1\xdf\xdb\x45\x6b\x48\x97\x76\x1f\x7d\xb6\xc7\x67\x76\x54\x3c\xb7\x1a\x4c\x1f\x39\x0e\xe6\x9a\x1f\xb1\x7e\x84\xf9\x73\x37\x7a\x77\xe0\x80\xee\x0e\x0f\x59\x6a\xff\xdd\x1c\x88\x0e\x16\x93\x5e\xe6\x88\x74\xb0\x93\x29\xc0\xf6\x14\xe8\x80\xeb\x20\x9f\x27\xd7\xde\x2e\xf6\xda\x59\xc5\x46\x44\x29\x74\x25\xf0\xe6\xa4\xd7\x3b\x09\x15\x80\xf4\x18\xcf\xd1\x09\x11\x38\x73\xcd\xe6\xb6\xd9\x48\x8c\xa9\xc1\x93\x4c\x8f\x81\xc2\xc9\xd4\x78\x8e\xd6\x26\x88\x16\xa9\xed\x5d\x21\x14\x97\xf0\x4a\xe6\xd0\xdf\xfc\x60\x68\x82\x40\x28\xab\x39\x12\xdb\x6d\x10\x41\xc2\x36\x96\xd8\x85\xd2\x47\x15\x74\x1e\x75\x6d\x88\x1c\x31\xd6\x48\xe0\x4c\x3b\x92\x0c\xfe\xb9\xa6\x06\x08\xe3\x66\xe8\x2e\x2b\xb0\xa2\xc5\x0d\x00\xc0\xf5\x01\xc8\xf5\xb1\x46\xbc\xae\xde\x0c\x09\x87\x18\xf4\x78\x0d\x6e\x65\x25\x95\x69\x09\xe6\x3e\xc6\xf0\x07\xec\x89\x25\x84\x46\x16\x0b\x9f\x90\x53\x99\x72\xa1\xb9\xb2\xdf\xa3\x77\xa8\x24\xd7\x40\xf7\xec\xeb\xfb\x03\x2e\x35\x5e\xb3\x79\xc4\x6c\xed\x6a\x8e\xe2\x12\x93\xf7\xa8\x20\xd7\xcd\x99\xb6\x13\xa4\x1b\x13\x04\xa9\xa3\x0f\x28\x27\x30\x9c\x78\x80\xb3\xfa\xbe\x09\x97\xda\xc7\x22\xab\xf6\x7a\xf1\x97\x3f\x46\x3c\x3d\x3f\x2f\x66\xe7\xe7\xdb\x2d\x04\xf9\xf0\x5f\xf4\x45\xbf\x1f\x75\xb7\x8a\x2e\x09\x7e\x53\xe0\xb7\xd3\x09\x40\x05\xb1\xdf\x6e\x9c\x95\x0e\x8f\x07\x71\x61\x57\xe9\xf6\xdd\x17\x58\x5e\xe5\xa7\xc5\xdc\xa3\x02\xe1\xd1\x09\x6d\xdb\x8f\x41\x14\x27\xe9\x0a\x35\x97\xfa\x04\xc7\xcb\x0c\xeb\xee\xb4\x55\x36\xa2\xb8\xf4\xf8\x09\xb6\x8b\x79\x38\xa0\x78\x58\x8d\xfa\xfd\x02\x97\x54\x82\xa5\x1f\x30\xe9\xa8\x6c\x2c\xc4\x12\x19\x10\xfd\xe9\xab\xb1\x05\xa7\xab\x3a\x95\x68\xdf\x93\xa0\x03\xa3\xf9\x70\x3d\x79\xb9\x6c\xb8\x00\xc1\x95\xf7\xa1\x1a\xf5\xfb\x02\x6b\x23\x72\x0c\x9a\xe6\x8e\x8e\xa8\xcd\xcb\x7a\xfd\x61\xd2\x47\xb0\x76\x0e\x89\x9e\x82\x5d\x88\x42\xa7\x60\x52\xf1\xb8\xd7\x43\x8f\x11\x26\x8f\x0d\x1d\x80\xc9\xdb\x5e\x0f\xbd\x45\x98\xbc\xb5\xdf\x38\x1a\xc8\x02\x05\xea\x55\xd2\x2e\xd4\x27\x8c\x82\xa9\x5f\x77\x10\x20\x38\x05\xa9\xae\x30\xa4\xe7\x63\x18\x43\xa4\xc2\x46\x17\x98\x34\x53\x5e\x0b\xb8\xa8\xa1\xc3\xbd\xa2\x36\x03\x99\x47\x03\x7c\xf7\x08\x6f\x2e\xc1\x27\xb0\x86\xe7\x0a\xed\x61\x10\xbc\xa9\xb1\x1d\x58\x6e\x8c\xdd\xb1\xbe\xc6\x10\x96\xb6\x6e\xc9\xbc\x31\x17\x50\x87\x1a\xd7\x2f\x55\x28\x9c\xc9\x5e\xcf\x55\x93\xb0\x8c\x9e\x06\xb3\x70\x47\x51\x64\xeb\xae\xa2\x6e\x04\x6a\x33\xef\x42\xc0\x6c\x1b\xcc\x72\x0d\xc4\xea\xb9\x47\x2f\x25\xec\x67\x4c\x3e\xd0\xf3\x54\xad\xc5\x09\x60\x0f\xb0\x26\xac\xc8\x7b\x9b\xf2\xd8\x20\x18\x9b\xf4\xce\x26\x05\x93\xc3\x8a\x9c\xf8\x0d\xf3\x82\x0e\xc9\xa9\x6b\xb3\x19\xb5\xf9\xb1\x2f\xf2\xd6\xfd\x70\xc0\x6f\x82\x75\xa2\x20\xe6\x21\x82\x4c\x36\xac\x0d\xa0\x97\x42\x2c\x0c\x2a\xcb\x16\xde\xf2\xe0\x0a\x42\x87\x33\x2a\xc3\x8b\x6f\x68\x38\x80\x08\xd3\x0f\xbe\x32\xc1\x46\x05\x7a\xf0\x00\x68\x7a\x81\x1e\x38\xeb\xb9\x07\xdf\x80\x8a\x5a\xa0\x07\xdf\x42\x8c\x9a\x7f\x7b\xaf\xa9\xcf\xba\xf2\x7d\xad\x56\x39\x04\x4f\xf5\x92\x5b\xa3\xdc\xcf\x79\x96\xae\x11\xbd\x43\x22\xe6\x6e\xb1\x43\x73\x53\x9d\xde\xb4\x5a\xb5\xdd\x29\xb8\x2f\xd9\x45\x36\x17\xf0\x01\x55\xe1\xf9\x69\xbf\x8b\x0d\x53\x6d\xe9\x02\x22\x83\x0f\x8d\xdf\xed\x3c\x94\x33\xb4\x93\x2d\x47\x15\xe1\xae\x1c\x95\xe6\xb5\xb4\x0b\xaa\x48\xbc\x47\xfe\x27\xad\x79\x3d\x69\x91\xb3\x8b\x01\x83\xe3\x3a\xd4\x85\xc2\xdf\xc1\x73\x00\x9f\x04\x17\xb7\x51\xdd\x01\x24\x0a\xa1\xdd\xf1\xe8\x50\x13\x11\xbf\x3c\x38\x00\x8a\x2c\xbd\x58\x6b\x2d\x9b\xba\x0a\x5f\xa2\xdb\x45\x3c\xbd\xe4\x9a\xfd\x95\x5f\x6f\xb7\x3c\x65\xa5\x76\xbf\x72\xad\x4a\xf7\xb3\x5a\x16\x73\x48\x8e\x30\x7b\xf9\x2f\x22\x62\x87\x6e\x00\xdd\x41\xdd\x76\x11\x6b\xba\xdb\xce\xca\x98\xa3\x7a\x17\xe2\x8c\x1b\x44\x19\x02\x0e\x80\xf9\xb7\x0b\x39\x70\xdf\xb8\x62\xa6\xc2\x07\x1d\xb8\x07\xbe\x98\xc6\x3e\x7c\x06\x68\x68\x88\x21\xd8\x15\x7c\xae\x30\x59\x52\x81\xee\xde\x03\xf6\xdb\xfa\x6e\x2e\xfe\xfd\xb7\x22\x58\xd0\x1e\x38\x3a\x3e\x03\xb1\xf6\xbe\x7f\xe3\x65\x7a\xd1\xf0\x6f\xcc\xdb\xfc\xfb\x96\xe9\xc5\x2e\xf6\x7c\xd4\xd2\x13\xf1\xb3\x46\xf8\xe6\x99\x93\xe1\x90\x99\x73\x08\x24\x33\x63\xb1\x3e\xc5\x26\x34\x3d\x18\x5a\x5c\x97\x3c\x0b\xb9\x36\xf1\x71\xf0\x71\x0f\xf5\xa5\x28\xaf\x2d\xc2\x79\x21\x8c\xb7\x44\x36\x4b\x81\x23\x8e\x4d\xd0\xbd\xb7\xbf\xed\x82\x58\xe5\x58\x68\xe2\x53\x5c\x1e\x0f\x7b\x01\xa7\x67\x10\xeb\x81\xb3\xc9\x8e\x38\x0f\x19\x13\x53\x20\x5e\x63\x10\x8a\xd7\xfe\x86\x0e\x8e\x5e\xef\x30\x0d\xc4\xb6\xdd\xf0\x64\xf0\xa9\xe2\x60\x36\xc3\x67\x9e\x96\x8a\x9d\x43\x9c\x0b\xe1\x48\x6f\xb7\x17\xce\x8b\xac\x6b\x4d\x38\xe1\xd4\xf6\x7a\x71\x8f\x76\xa8\xdb\x2d\x44\x01\xb3\x6d\xba\x81\xa2\xa0\x8a\x2e\x1a\xe5\x25\x30\x87\x20\x3f\xbc\xd5\x11\xd2\x79\x40\x02\x2f\xa8\xa5\x31\x23\xdf\x5b\x1f\xf3\xde\x5a\xb4\x90\xc6\xa4\xfc\x60\x12\x6d\xac\x62\x32\x49\xb4\x4c\x48\xb2\xd7\x44\x48\x31\x0d\x24\x24\x39\xa8\x9e\x4c\xe1\x84\xb6\x4d\x0d\x3c\x09\x6a\x26\xbf\xab\x3d\x71\x9e\xef\xbb\xa9\x22\x08\xf3\x5e\x59\x41\xc6\x1c\x04\xed\x64\x8d\x47\x95\x15\x09\xae\x5d\x51\xb0\x47\x44\x73\x4c\x80\x3d\x04\xda\xa6\x4b\x25\xa8\xfc\xc1\x05\xb1\xd7\x5b\x87\xab\x0e\xcd\x89\x11\x81\x0a\xf0\xc7\x4f\x43\x20\x86\x71\xf4\xbb\x4f\x41\xb9\x26\xb2\x28\x89\x0a\x2b\x2c\x05\xcf\x9b\xeb\x92\xd3\x05\x5c\xbc\xee\x83\x80\x5c\x73\x77\x2b\xe8\xb6\xf8\x5e\x28\x8b\x68\x1f\xee\x60\x15\xc1\x06\x89\xd1\xab\x8f\xdc\x37\xde\xac\xe3\xfe\x9e\xdb\xfa\x3d\x20\xb0\x90\xf3\x5b\xb7\xa8\x2e\x72\xed\x31\x6e\xe9\x40\x68\x0d\x87\xee\xad\x8f\x7b\x80\x2a\xe5\xa1\xdb\x34\x9f\x15\x0a\x64\x5a\xc6\x4c\xaf\xc8\xab\x6c\x73\xcb\x33\xab\xf5\x8e\x0b\x9c\x84\xc3\x20\x96\x13\x0f\xd7\x95\x36\xb1\xc2\x61\xbf\x1b\xfb\x41\x6a\xbf\x61\x5f\x4b\x4b\xd5\xc6\x82\xbf\x20\xff\xae\x49\x0a\xb3\x47\x1d\xe9\xe1\x25\xe0\x23\x50\x0c\x1f\x83\x0a\x19\x8c\x63\xa2\x67\x88\x18\x6d\x70\x83\xa9\x96\x5e\x5d\x01\xcf\x16\x41\xe1\x60\x3a\xe2\x68\x93\x70\x37\x6b\x98\xb5\x39\x10\xe8\x66\xbc\xa7\xf6\x35\x32\xe4\x69\x18\x52\x1e\x0d\x81\x70\x2d\x02\x3b\x70\xfc\xe6\xf8\xcb\x3b\xc7\x26\x4c\x6b\x1f\x5a\x1e\x35\x3b\xaf\x88\x74\x3a\x18\x16\x3d\x28\x3a\x12\x91\x7d\x25\x23\x46\x50\x9a\x39\x81\xe9\x76\xab\xec\x0f\x6f\x27\xe9\xad\x17\xdd\x6b\x20\xc6\x94\x6c\x47\xf6\x20\x8c\x16\xa2\x69\x62\x91\x24\x40\xb5\x8d\xd4\x77\x74\x30\x52\x47\x47\x9e\x37\x83\xdb\x85\x30\x0a\xf6\x24\xda\x3c\x39\x02\x93\x29\x28\x6b\x1d\x97\x20\x40\x56\xb8\x47\xed\x20\xfa\xd8\x71\x82\xb1\x7d\x4a\xc9\xdd\xd9\xc7\x49\x5f\xec\x1a\x77\x09\xb4\x9b\x31\x87\xb5\x09\x2c\x76\xfd\xa5\x65\xc6\x5a\x9c\x8f\xcc\xa8\x33\xe6\x6f\x11\x33\xda\xfa\xd3\xed\x93\x6c\x9d\xe6\xc4\x3b\x36\xc3\x47\x1b\xf2\x2b\x2c\xaa\xf5\x47\x6a\xfe\x19\x24\x9c\xdf\xc6\x39\x02\xc3\x40\x22\xe8\xc1\xfd\xd2\xd1\x41\x36\xcf\x10\x46\xd0\x97\xc0\x50\xd8\x12\xf3\xf5\xbb\x11\x0f\x8c\x25\xaf\x7d\x37\x02\x4c\x79\xef\x7d\xbd\x77\x24\xbf\x89\xc8\x97\xcf\x33\x4f\x76\xb6\x1d\xff\xfe\x04\x0b\x98\x34\x7f\xfd\xcd\xbf\xc6\xe1\xea\xae\x9d\xb5\xaf\xf7\x8d\x9a\x83\x15\xb3\x7f\xd9\xa2\x98\x5f\xc3\x7a\xd4\x7b\xf9\x7f\xdd\x1d\x40\x28\xe5\x3e\x84\xb2\x5b\x9b\x4d\xaf\x2a\x4e\xe6\x6d\xb0\x84\x24\xbf\x11\xfe\xb8\x23\x97\xa0\x1c\xc4\xad\xd2\xbd\x79\x6c\xe0\x32\x6f\x2a\xf9\xa8\x03\x15\x8f\x1e\xc6\x1d\xd5\x84\x6f\x90\xe3\xc0\x8d\x23\x69\x8e\x49\x4b\x1e\x18\xc8\x31\xba\xf6\x77\x62\xcb\x50\xf8\xd8\x09\xbd\xb8\x45\x28\xb0\x46\xf6\x17\xbc\x8a\xe7\xec\xb4\x9d\x2f\x04\x04\xa1\x1e\x82\x2c\x85\x03\x79\x4d\x66\x87\x7a\x18\xdb\x54\xb8\xa2\xb9\x53\x2a\x1c\xda\x04\x82\x5d\x77\xb5\xff\x2a\x12\xe0\x1a\x14\x2b\x1f\xe0\x5d\x09\xb2\x71\x26\xe4\x0c\x9e\x46\xee\xb3\x2c\x49\x76\x78\xb7\x5f\xe6\x50\x58\x12\x1f\x75\x57\x78\x8e\x44\xbb\x54\x65\xd7\x90\x5a\x64\x2d\xd3\x24\x1a\x9c\x72\x2d\x6f\x8c\x38\xed\xa6\xcf\x14\x27\xa0\xa6\x07\x86\x39\xb4\x7e\x5b\xbb\x51\x8b\x21\x8f\x23\x68\xc2\x34\x00\x3a\x93\xf6\xea\x90\x83\x40\xb5\xed\x56\x0d\x06\xe3\xb6\x74\x7b\x05\xbf\xdf\xf7\xea\xd4\xb6\xce\xed\xd5\xea\xfc\xf6\x9a\x40\x58\xdd\x56\x13\xf2\xdb\x6b\x1e\x2e\x58\x03\x33\xed\x1f\x2d\xa2\xdc\x7b\xb5\xfa\xbb\xe1\x58\x1f\x0d\xb3\x01\x9c\x9e\x21\x18\x90\x1a\x9b\x40\x65\x9f\x07\xac\xcf\x99\x9c\x3a\x21\x82\x48\x9b\x1d\x3a\x7d\xad\x20\x93\x18\xae\x60\x7a\xac\xea\x38\x11\x2e\x0f\x0e\x93\x3b\x1b\x68\x40\x0a\xd7\x9c\x39\x9e\xd8\x9f\x1a\x90\x34\x1a\xbb\x03\xdc\xf0\x71\x99\xff\x0f\x46\xdd\xe0\x23\xcb\xfe\x00\xca\x76\x37\x9e\xb9\xf0\x5a\x6a\xff\x9f\x41\xb2\xe1\x5d\xe9\xd6\x3e\xf9\x18\xc9\x5e\xcf\x23\x44\x5f\x16\xbc\x05\x9c\x21\x9c\x25\xc7\x52\x2d\x9f\xc9\xf7\x5c\x3d\x66\x15\x47\x38\x90\x3c\xb2\x99\x8e\xc7\xa8\xae\x12\xd7\xae\xb1\xa9\xd7\x03\x60\x52\xf7\x46\xa5\x7d\x75\xbb\xae\x00\x93\x1f\x3e\xe0\xad\x55\x8c\xb3\xa8\x7c\x92\x78\x8c\x1c\x6b\xc6\x2d\xb7\x24\xf7\x5f\xd6\x6e\x47\xc5\xe5\x01\x2a\x16\xd1\xcb\x5f\x84\x41\xa7\x10\x90\xcb\x79\xd8\x1e\x0d\xf1\x58\x66\xb2\x0f\x2f\xd5\x15\x2e\x4f\xd4\xb4\xf5\x58\xb8\x72\x43\x9c\xd5\x6f\x72\x03\x77\xa3\x49\x44\xd2\xf6\x8b\x3f\x8a\xc8\x8b\xff\xd3\x88\x9c\xc5\x88\xbc\xf8\xef\x23\xf2\xe2\x13\x11\x39\x18\x28\x7e\x2a\x02\x77\x65\x3f\x03\x71\x37\x6a\x7c\x12\xc2\x6e\xd4\xf8\xbf\x80\xa8\x1d\x0c\x05\xba\x19\x57\x03\x48\x31\x8e\x3e\x40\xb5\xc5\x47\x50\x6d\xab\xfc\xbd\x65\xd5\x3c\xdd\xce\x31\x38\x59\xed\x3e\x87\x70\xff\x3c\x64\x4f\x74\xed\xe0\x46\x75\xed\x94\xe4\x39\xd8\xcf\x0b\x99\xb0\xf9\xdb\x5a\x6a\x76\xf2\x21\xe7\x7c\xc6\x67\xc6\xa6\x1c\x42\xdf\xfe\xed\xf5\x8b\x57\x8f\xce\x4f\xfe\xfe\xf8\xe4\xe4\xc9\xc9\x93\xf3\x93\x97\x2f\x4d\x28\x5a\xc2\xe8\xe6\x8c\xe7\x6b\x55\xe8\x6b\x5f\x76\xd7\x4a\x80\x27\x7f\xfa\x93\x8b\xd8\x6d\x02\x12\x8d\x62\x40\x0f\xe8\x40\x67\x4c\x54\xd9\x18\xc0\x67\x5a\x2a\x88\x28\x09\x96\x44\x8e\x42\x1c\xb7\x96\x70\x86\x72\x4f\x35\xbf\x44\x20\x4b\xc6\x59\x7b\xb1\x8a\xeb\x50\x86\xec\xd9\x8f\x69\x1c\xbd\xb7\x55\xcc\x21\xfe\x60\x0a\x48\xcc\x3f\x5c\x06\x3c\xac\xf4\x69\xbd\xde\xa0\x8e\x73\xbd\xd7\x89\xc3\xcc\xae\x96\xb5\x12\xe2\xbb\x5d\x63\xb5\xe2\x69\x82\x65\xf2\xca\xd7\x11\x0c\x55\xdf\xd0\xf0\x22\x82\xfe\x76\x58\x77\x20\x89\xb0\x06\x58\x06\x8a\x4e\xf4\x14\x80\x8e\xaa\xee\x3e\x47\xff\xb0\x07\xa9\xdf\xed\x20\x76\xf1\x52\x09\x09\x52\x09\x8e\x41\xdf\xa8\xf7\xf7\xbd\xe1\xd3\xfe\xef\xba\xdd\x36\xa1\xff\x24\xe2\x62\x5f\x4b\xf5\x9f\x70\xde\xff\x09\xe7\xfd\xa9\xe1\xbc\xff\x13\x9d\xfb\x86\xe8\xdc\xf7\xcd\x6b\x30\x02\xdd\xff\x16\x34\x64\xca\xeb\xc7\xbe\x7a\x00\xfa\x31\x85\x96\xa0\x1e\x13\xe8\xab\xaf\xe1\xb1\x75\x85\x16\x98\x5c\x53\x81\xbe\xfa\x06\x93\x73\xaa\xd0\x35\x18\x28\x08\xf4\xd5\xb7\x98\xbc\xa7\x0a\x7d\xc0\xe4\x1d\xa8\xf6\x07\x98\x9c\x50\x81\xbe\xc1\xe4\x05\x55\xe8\x04\x93\x53\x2a\xd0\xb7\x60\x72\x60\x0c\x43\x9a\x41\x17\xfe\x7f\x0d\x03\x7e\x63\x84\xee\x8f\x05\x73\x9e\x15\x57\x8d\x68\xce\x2f\xea\x82\x52\x68\x56\x08\xae\xbc\xe6\xe9\x92\xa9\x45\x21\x5e\xc9\x55\x76\x6f\x40\xec\xc7\xf7\xc6\x3e\x33\x7b\x30\xd8\xdd\x12\x62\x1a\x8c\x3c\x01\x36\x38\x8e\xb7\x94\x1a\xfa\x30\xd4\x0b\xf9\x96\xbf\x3b\x7e\x2f\xd5\xbb\x04\xe3\x5b\x2a\xb8\x26\x31\xf9\x03\x83\x53\xf2\xfd\x2d\x10\x5f\xb2\x42\xdc\x38\x2b\xe5\xf3\xd9\x70\xb0\x23\x91\x1a\xcb\x0b\x73\x6f\x81\x84\x55\xc5\x8c\xdf\xd6\xe4\xdd\x5b\xc0\x11\xec\xea\x63\xb3\xb7\x17\x93\x1b\x0d\xc8\x63\x5f\x14\xa3\xba\x2b\xc1\xae\x48\xe3\xeb\xb4\x28\xcb\xaa\x99\x74\xa6\x59\xfe\x8e\xcf\xf0\x2d\x00\x95\xc5\x47\xe0\x79\x97\x82\x4e\x97\x6c\xb4\xcc\x92\xe3\x95\x92\x39\xaf\x2a\x5e\x25\x3b\x92\x9c\x86\x0f\x8c\xff\x55\x1d\xfc\xb6\xe6\x6b\xdb\xfa\xdf\xec\xaf\x7f\x5d\xd3\x8a\x6b\x75\x7d\xfe\x56\x5e\x98\xe6\x5f\xc2\x57\xe7\x2f\xf2\xe2\x5f\xd9\x45\x95\x2f\x39\x10\x09\xb3\xd0\xcd\x99\x4f\xf9\x57\x77\x35\xe3\xac\xee\xe5\x09\x67\xa1\x03\x50\xc2\xde\xfe\x94\xd1\xdb\x38\xa6\x7a\xd0\x5e\xd4\x91\xce\xdd\x0b\xca\x91\x36\xc4\xc4\x29\x98\xa5\x16\x33\x61\x74\x33\x80\x46\x4f\xa5\xc8\xc6\x39\xf7\x67\xef\xc0\xa8\x60\xe9\xe8\xf9\x1d\xf9\x48\x45\xcb\xb4\x9b\xe7\xf5\x83\x25\x6c\xf6\xf6\xd3\xab\xd5\xbb\xb3\x8e\x79\xf8\x19\x08\xf4\xc2\xa7\x93\xcd\xc5\xba\xba\xfe\x45\xaa\x77\x5c\xbd\x7e\xf9\x2c\x4b\xd2\x63\x48\x38\x07\x3c\x66\xe2\x9e\xda\x1f\xa7\x52\x96\x2e\xdb\x26\x9c\xaf\xa4\x2c\xc1\x53\x04\x28\x84\x4f\x05\xda\xed\xf8\x3f\x04\xf1\x95\x4f\x27\x9b\xb5\x2a\x01\x4e\x7f\x7e\x3e\x0b\x84\xe8\x64\xfc\x21\x30\xce\x0f\xc0\x88\xcf\xda\x67\x81\xb2\x77\x82\xfe\x10\x38\xef\x0f\xc0\xd9\x3f\x97\x9f\x05\x52\x7d\xd2\xfe\x10\x34\x97\x3e\x9d\x6c\xe6\x5c\xe7\x4b\xb7\x63\xa2\x56\xcd\x64\xb9\x64\xf3\xfb\xdc\x67\xba\xbc\x47\xa5\xdf\x67\xe6\xf3\x9c\x95\x65\x28\x52\x25\xc4\x5a\xc9\xba\x12\xf6\x23\x6a\xc1\x26\xd4\x4d\xb8\x02\xcd\x36\x3e\x36\x23\xc6\x6a\xc2\x6b\xe3\xc9\xc6\x68\x50\x93\x84\xec\xdf\x09\xa0\xb3\x09\xac\xd7\x82\x7b\xc5\xfc\xf7\xd7\x4f\x67\x28\x61\xab\x55\x82\x3f\x8f\x05\xab\x85\x3e\x89\x77\x5a\x56\xa0\xf0\x7f\x5b\xa5\x52\x2d\x8e\x67\x32\xaf\x8e\xcd\xb3\x72\x47\x33\x0e\x51\x2e\x54\xba\xd4\x97\xe5\x18\xdc\xce\x55\xc1\x84\xa6\x49\x9f\x13\x41\x87\x23\x71\x28\xb9\x06\x2a\x5a\xf7\x69\x02\x26\xe4\xd5\x64\x0a\x45\x0f\x7d\xc6\x42\x2d\xf0\x6f\x72\x2c\x52\xf2\xbc\x10\xc5\xbc\xe0\xb3\xce\x4b\x80\xa5\x63\x00\xe8\xfc\x57\x02\x51\x40\x46\x9d\xab\xa2\x2a\x0c\x5b\xd2\x4f\x82\x83\xfc\x7c\x5d\x96\x9d\x4b\x5e\x55\x6c\xc1\x81\x89\x81\x01\xeb\x25\x37\xbe\x3d\x97\xbe\xb1\x19\xbf\x8a\x1d\x7c\x4c\x65\x53\xd1\xb4\x5f\x99\x68\x10\x6c\x36\x33\x71\xb4\x58\xd9\x59\xf2\x72\x35\x5f\x97\x9d\xf7\x4c\x89\x42\x2c\xaa\x34\x69\x79\x38\xa1\x26\x6c\x20\xf4\x6a\x64\xee\x42\xb5\xfd\x04\xcf\x16\xfa\xc8\xfe\x5e\x9b\xf0\xbc\x8a\x8a\xed\xf6\xa7\xba\x2d\x86\xf0\xa6\xfe\x2a\xff\x85\x2d\x17\xbe\x2d\x58\x61\x45\x24\x98\x1a\x33\x63\xc5\x0d\x72\x2e\x88\x71\xee\x14\x7d\x5d\x6a\x03\x85\x1b\x5d\x42\x10\xc4\x83\x30\x6b\x6e\x5e\x97\x30\xbf\xb0\x0b\xd3\x64\x72\xde\x71\xa3\x43\xa1\x49\xd2\x37\xef\xa6\x63\xa2\xf1\x8f\xce\x2d\xcd\x18\xb4\x75\x7f\xd8\xd3\x40\x20\x48\x45\x12\x54\x0f\x1a\xcc\xdc\x8c\x1c\xa2\xa0\xfb\xbb\xe6\xe8\x2e\x88\x75\xc0\x11\xa2\xc0\xb2\x0e\x69\x2e\x42\x48\xf3\xe1\xc3\xa2\xde\xb6\x95\x13\x4c\x9a\xb7\x1a\xc0\x69\xa8\xb0\x7e\x42\x93\x3c\x16\x47\xe6\xfd\xbb\xd3\x51\xd4\x58\x05\xd2\x18\x23\x71\x73\xa7\x11\xb8\xb0\xaa\x9e\x81\x82\xee\xe5\x04\x2f\x3c\x00\xdf\x8f\xa2\x88\xec\x33\x37\x77\xee\x00\x3f\x22\xe7\xd9\x7b\x02\x3f\x32\x4e\x80\xd3\x60\x10\x1e\x23\x2b\x8d\xd1\x5d\x95\x49\x72\x2e\xdf\x0b\xae\xb2\xa7\x69\x6e\x35\x9c\x91\x74\xa3\x8a\x8d\x22\x0f\x9b\x33\xfe\xf1\xa6\x4d\x6d\xda\xe4\xb0\x22\xae\x5d\xee\x4c\xbe\x5c\xeb\x3c\xb5\x3f\xa2\xc6\xf3\x48\xf8\xb7\xef\xc3\xc6\x83\xe0\xc0\xcc\x88\xef\x19\x84\x69\x75\x03\xeb\x5a\xd8\xb4\x49\x68\x92\x25\x74\x90\x90\x24\x83\x1f\x77\x13\x2f\xf9\x4f\xee\x24\x7d\x94\x24\xfd\x58\x15\x3f\xa1\xd9\xf4\x78\x11\x61\x24\x0f\x87\x7b\xf2\xbf\xd5\x4d\xb9\x98\xa3\xdf\xdd\x76\xf0\x06\x2c\xbf\xa7\x2b\xb9\xaa\xdd\x1a\x20\x84\x4a\xb5\x2e\x35\xe5\x44\xc2\xf6\x3b\x55\x7c\x5e\x7c\xa0\x9a\x48\x63\x46\x48\x05\x91\xe1\xb8\x28\xf3\x7b\x2d\x34\x1d\x10\xe9\x98\xc2\x8d\xad\x6e\x97\xc9\x56\xce\xb4\x81\x32\x13\xc4\x55\xcc\x14\xc9\x4d\x20\xe7\x41\x34\x93\xe0\x38\xb1\xe1\xbe\x77\x98\x38\xc2\x23\x00\x5c\x02\x14\xf7\xbf\x3d\x18\xe1\xd3\x42\x32\x1c\x7c\xe7\xc7\xd8\xeb\xfd\xee\x23\x84\x1e\xb8\x06\x13\x67\xbb\xc4\xc2\x72\x8d\x22\x79\x1d\x98\x24\xf4\x7a\xc1\xcb\x10\x3e\xc1\x9e\xd0\x74\xed\x5d\x6e\xbb\xc3\xfa\xd1\x53\xca\x31\x3c\xe8\x62\xcf\x52\xf5\xbe\x00\xa1\x23\xc3\x9b\x9c\x55\xdc\x6b\x93\x32\xf3\xe1\xa2\xeb\x64\xa6\xb4\x31\xfd\x19\x99\x74\xb7\x79\x32\x57\xb7\xde\x2e\xb6\x91\xce\x7b\x53\xbd\xf3\xce\x54\xdc\xc1\x31\x0b\x4f\xd8\x08\x24\x4d\xcc\x20\xb0\xdf\x1a\x27\x69\xd2\x5f\x22\x4e\x06\x38\xd3\x98\x18\x08\x4b\x3a\x20\x9a\xd6\xf9\x99\xee\x27\x59\x42\x1e\xb9\x47\x32\xcc\x5f\x10\x24\xfb\xa3\x5f\xd0\xc1\xa8\xa8\x83\xee\x14\x60\x92\x0b\x01\x64\x0a\xab\x24\xae\xa8\xee\x2f\x11\x23\x05\x1e\x95\x7d\xba\x42\x8c\x54\x44\x04\xeb\xac\x68\x46\x5a\xa4\x71\x7c\x5c\x99\x29\xcc\x50\x45\x9f\xf5\x7a\x7c\xf2\x6c\xba\xdd\xf2\x49\xf2\xa7\x3f\xf9\x07\x51\x12\xf0\x88\xac\xa5\x70\xe1\x30\x55\xe3\x2a\x83\x9a\x98\xb4\x65\x1a\x0c\xc3\x69\xe5\xcc\xa4\x41\x5b\x3a\x18\x75\x11\xd8\x5f\x42\xbc\x15\x84\x71\x3a\x93\x82\x8f\x30\xa3\x2c\x35\xc2\x09\x12\x86\xd1\xef\x63\xd2\x18\x48\x40\x88\xf5\x89\xa6\xcc\x8b\xfe\x00\x2f\x73\x62\xd4\x00\x48\xa1\x7b\x43\x92\x4c\x6c\x29\x17\xa5\x64\x0a\xd3\x2c\xc6\xae\x6a\xe7\x7d\xa1\x97\x9d\x77\xfc\xba\xea\x6c\x92\xfe\xde\x23\xb4\xd6\x09\x92\x74\x12\x6c\x82\x46\x09\x92\x24\xb5\x3a\xbd\xac\x37\xec\x85\xdd\xb0\xe1\x7c\x3b\xd5\xeb\x78\x90\xc1\x56\x06\x52\xa6\xe1\x73\xb0\xb4\x3e\x07\x9f\x82\x94\x5c\x68\x5a\x80\x68\xbc\x46\xe6\xb8\xe1\xac\x0e\x90\x05\x96\x57\x75\xbb\x97\xb6\x5d\x6e\xf0\x80\x9b\x68\x7f\x06\x89\xf6\x07\xb0\xe1\xa8\xb6\x68\x5c\x93\xd4\x9f\x6e\x22\x69\x74\xb4\x47\x9c\x7e\xbc\xcd\x83\xdd\x0a\xb1\x5d\x89\x6a\x3c\x61\x14\x26\x88\xef\x70\xe6\x86\x06\x4f\x8c\x58\xfb\x60\x4e\xe1\x22\x90\x7d\xd4\x35\x7d\x1b\x07\x3b\x73\xc1\xc2\xe1\x85\xbf\x10\xbb\xc1\x20\x59\xf8\xa8\x11\xed\x6b\x92\xdc\xe9\x81\xf1\x1e\xe8\x94\xfb\x02\xdc\x8b\x3c\x4e\x89\x46\x7a\x65\x47\x4a\x54\x8d\x56\x92\x64\x64\x81\x00\x03\x59\x46\xa1\x69\x71\x43\xb3\x44\xd3\x39\xd2\x84\x99\xea\x04\xd6\x7b\x01\xc2\xba\x19\xd2\x51\x17\xd7\xc1\x18\xf9\x37\x7f\xcb\x35\xf0\x8f\xdd\xa0\x61\x67\xde\x1d\x46\xb6\x19\xc6\xce\x1f\x7c\xc4\x87\xf7\x41\xc4\xd8\x72\x84\xfc\x4b\x41\xee\x95\xa0\xb9\x54\xe4\x3d\xfd\x30\xae\x3f\x51\x62\xc8\x57\xcf\x61\x27\x38\x7b\x30\x18\x0e\xee\x91\x77\xad\xa5\xc0\x07\x9d\x95\xae\xd0\x03\x72\xd2\x5a\x68\xae\xd8\x22\x6a\xeb\x6b\xf2\xa2\xb5\x18\xe0\xcf\x5c\x9f\x5f\xca\x19\x77\x25\xbf\x21\xa7\xad\x25\x57\x4a\xce\x8b\x92\x2b\x5b\x6c\x78\x9f\x3c\xbe\xa9\xd8\x55\x31\xf3\xc5\x06\xdf\x92\xb7\xad\xc5\xdc\x3e\x74\x8d\x0d\xc8\x59\x6b\xa9\xb9\x8d\xf8\x7a\xae\xf8\xdc\x95\xbc\x4b\x9e\xb7\x96\xac\xd6\xd5\x8a\x8b\xca\x0d\x62\x78\x8f\xbc\x6a\x2d\x76\xc9\x2f\xa5\x2b\xf2\x15\x79\xd9\x5a\xa4\x64\xbf\x5f\xbb\x22\x0f\xc8\xb3\x4f\x58\x4e\x8f\x5d\xc9\x4f\x74\x53\x54\xe6\x65\x08\x3e\x3b\x64\x04\xbb\xc3\x1d\xe1\xc2\xf0\xe1\x3f\x48\x95\xf3\xc3\xd7\x3f\x42\xfe\xcb\x28\x6e\x62\x6b\x81\x33\xae\x0f\x33\x77\xe4\x11\x44\x64\x90\xb5\x8c\xdd\x48\x6a\x58\xae\x03\xdf\x02\xa4\x74\x9c\xef\xdf\x62\x88\x84\xdc\x5e\x57\x73\x70\xbb\xb4\xea\x7a\x6a\x3c\xb7\x77\x44\xbe\xf9\x0a\xe3\x51\x4c\xe4\xa7\x7b\xa0\x5b\xc1\x37\x1c\xec\xc4\x43\x91\xe0\x26\x74\xf3\x7a\x9a\x1a\x52\xf8\xb6\x66\xa3\x29\xf5\x2d\x27\x51\x7d\x68\x9a\xd5\x4d\xd3\xa8\x1b\x73\xef\x3e\xa1\xd1\xe3\x5d\x14\x54\x68\x6c\xf4\xa4\xf1\xde\x57\x49\xce\xd1\x93\x18\x3c\x4c\x9e\xa4\x45\x75\xba\x56\x7c\x6f\x8e\x9d\xf5\xd4\x53\xba\x71\x98\xc4\xe0\xcb\x1d\xf9\xd1\x2b\x9f\x6e\xb2\x76\x22\x3f\x50\x13\xfe\xb3\x3b\x30\xc4\x72\x77\x40\xce\xcf\x2b\x5e\xfa\x5f\x26\xb8\x0a\x28\xcd\xc9\x6b\x7a\xfc\xe6\xb8\x7f\xbc\x20\xbf\x43\x0c\x84\xdf\xf6\x3b\xfa\x07\xdd\x58\x90\x6c\xf7\x4f\x8a\x6a\x05\xbe\x91\x5c\x65\xbf\x91\x38\xe3\x7b\x48\x35\x31\x80\x17\xd9\xc6\x1f\x20\xd7\x46\x5c\xee\x05\xd0\xe5\xd9\x53\xf2\xb4\x3a\x93\x97\xdc\x3d\xea\xa2\xc0\x71\x42\x2c\xb2\xd0\x37\xec\x6f\xeb\x94\x94\x9d\x83\xe5\x99\x89\x28\x03\xef\x3a\x6d\x2e\xd9\xaa\xde\xa8\xee\xd2\x0a\xc8\x95\x37\x0d\x84\x54\x14\xf6\xc0\xdd\x42\x40\x81\x42\x1d\x02\x2e\x88\x36\x52\xd5\x27\x35\x07\x78\xbf\x76\xa6\x87\x62\x06\xfb\x5f\x06\xec\xef\x08\xe5\x96\x4b\xee\x22\x76\xcf\x0f\xa9\xd0\xd8\xce\xb4\x06\xd6\xed\xd2\xdc\x9a\x11\x24\x9e\xd5\x68\x0e\x40\x9b\x0a\x37\xdc\xa4\x44\xef\x08\x38\xa1\x34\x5a\x01\x65\x2e\xb0\x3e\x7b\x27\x6a\x78\xff\x5e\x7c\xe9\xec\x48\x24\xbb\x35\x4f\xa6\xfc\xe0\x30\x3e\x3d\x81\x97\xe0\x1d\xb6\xa6\xa7\xf0\xb1\x56\xbc\x2e\x5b\x12\x9d\x02\x0d\x92\xeb\xe7\x72\xc6\xe9\x0b\xf8\x74\x8b\x4f\x9f\x13\x50\x5e\x9d\x9d\x3c\x7e\x79\xf2\xea\xfc\xe9\x4f\xaf\x4e\x5e\xfe\xf4\xe8\xd9\xd9\xf9\x93\x17\xe7\x3f\xbd\x78\x75\xfe\xfa\xec\xe4\xfc\xc5\xcb\xf3\x7f\xbc\x78\x7d\xfe\xcb\xd3\x67\xcf\xce\xbf\x3f\x39\xff\xe1\xe9\xcb\x93\x27\xf4\x1f\xf0\x20\x64\x29\x85\x97\x0c\xd1\x1b\xd7\x07\x1e\xa9\x0d\x7c\xe9\x3e\xd2\xb8\xfb\xe0\x6b\xc2\xb1\x0f\x25\x77\x0e\x16\x59\x8e\x4b\x04\x9d\xbf\x21\x2d\x4c\xa4\x26\x60\x22\x81\xdb\xb5\x1c\xa3\xbf\xa8\x41\x1c\x50\x07\x9f\x39\x94\x04\x90\x82\x06\x16\xf6\x23\x52\x01\xcb\xb8\x02\x63\x09\x7f\xf7\xb8\x6a\xe3\xd5\xd7\x92\x63\xfc\xe8\x72\xe0\xc4\x23\xa1\x42\xde\x2a\x54\xc8\xad\x50\x21\x9f\xd2\x30\x17\x7a\x92\x4f\x23\x73\xc2\x6a\x5c\x4d\xf2\x69\x06\xa9\x56\x21\x9c\xdf\x22\x73\xc8\x6f\x92\x39\xe4\x78\xe3\x65\x0d\x79\x1d\xf3\x74\x4d\x07\xa3\xf5\xc3\x7c\xb4\x36\x32\x87\x75\x2c\x73\x58\x1f\xc8\x1c\x3e\xce\xda\xdf\x20\x2e\x28\x8c\xa9\x8a\x15\x18\xba\x87\x9e\x1a\xdb\x22\x9c\x83\x7a\x0a\x8c\x09\x08\x2c\x25\x26\x9c\xd6\x5d\xbe\x25\xe7\x39\x2b\xf3\x75\xc9\x34\xb7\xae\xe4\xb3\xef\x0b\x5d\x65\x9a\x9c\xbb\xe5\xfc\xd9\xa9\xea\x1b\xdf\x77\x21\x41\x2f\x21\x76\xb7\x79\x63\x36\x1b\x90\x53\x47\x9c\x18\x24\x47\x1e\x4b\x51\x81\x7e\xdc\xa1\x3c\x9e\xfa\xec\xa8\xef\xc7\xe4\xdc\x91\x2a\x60\xdf\xc8\x53\x5f\x87\xf2\x7a\x74\x7e\xd3\x17\x21\xe5\x07\x96\x43\x0c\x63\x7a\x88\x1c\x8a\x28\xee\x5a\xec\x78\x04\xdd\x81\x2c\xae\x6e\xf5\x25\x8f\xec\xe5\xfd\x64\x35\x31\xfd\x8e\x04\x12\xa9\x51\x3a\xe0\x98\x7a\x1c\x67\xde\x01\xc5\x62\x8e\xe6\xfb\x71\x34\x27\x96\xee\xb9\xb5\x89\x97\xe4\x1c\x46\x05\xb3\x0a\x5e\x2f\xeb\x2a\x3b\x1a\x92\x73\x27\x92\x08\xf0\x00\x85\xd5\xba\xce\x75\x43\xaf\xdc\x16\x32\x62\x73\xa6\x78\x56\xef\x00\xf7\xa0\xa2\x69\x69\x5d\xf1\xc7\xac\x2c\x2f\x58\xfe\xae\xb5\xc1\xce\x35\xc2\x71\x21\xf7\xdc\xa2\x36\x69\xb7\x6d\x38\x5f\xcf\x96\x89\xab\x3d\xe1\x17\xeb\x85\xd9\x3c\x75\x4d\x20\xbb\x4c\x9b\x27\xf3\x39\xcf\x6f\x6f\xd2\x16\x89\x5b\x7c\x7a\xb9\x02\xea\xb0\xb8\xe2\x3f\x1a\x47\xba\x46\x75\x22\xf6\x1b\xd8\x2f\xee\x4a\xb9\xc6\x9e\xb1\x6b\xb9\xd6\x9f\x00\x47\x5c\x30\x86\xe6\xf9\x4d\x8b\xe3\x2b\x42\x81\xb8\xc2\x4b\xf3\x7c\x64\xc3\xef\x2d\xe6\x96\x5d\x2d\x57\xaa\x09\x6c\xfb\x8e\xac\xab\x40\xf4\x25\x57\x74\x9f\x06\xdd\x2f\xec\x02\x88\x9b\x96\xaf\xb8\x02\x3b\x20\x9a\x0c\x1f\xa4\xc3\xfb\xe9\x20\xf9\x74\xc5\x41\x2c\xb0\x86\x07\x8f\xdd\xab\xc3\x02\xdd\xbf\x8f\x47\x46\x36\xc7\xf5\x2f\x56\x6c\xfe\x98\xe5\x4b\x6e\xc2\x02\x1c\x84\xfc\x8a\xac\x40\xdc\xf2\x10\x15\xde\xd3\x2c\x41\x98\x15\x42\x50\xd5\x21\xbd\x60\x23\x17\x62\xd1\x39\xf5\x0a\xd6\x8e\x09\xeb\x0a\x6c\x43\xd5\xb1\xda\x93\xf2\x1a\x22\xe3\x82\xed\x92\x7b\x2d\x81\xcf\x3a\x17\xd7\x46\x4f\xf0\xbf\x01\xb5\x1e\xc1\xa1\xa9\xfe\x77\x67\xc5\xf2\x77\x60\xaa\xd8\x79\x5d\xf1\xba\xbd\x34\x5f\xf2\xfc\x5d\xf8\x44\xb8\xa3\xa5\xb1\xe1\x82\x06\x2e\x53\x50\x49\xcc\x3a\x97\x52\xf1\x0e\xd3\x1d\xd0\x99\x64\xc7\xc7\xf3\x8b\xf4\x92\x1f\xaf\x2b\x7e\x64\x2a\x1f\xd5\xbd\x40\x14\x31\x43\x7c\x14\xc6\x4c\x91\x26\x4f\xbd\xe6\xa4\xf3\x73\x21\x4b\x63\xed\x9c\x90\x62\xb7\x6b\x33\x88\xe1\x3b\x1e\xa9\x88\xa9\xf7\xc1\xd9\x98\x87\x63\x33\x4e\x2e\x8a\x45\x21\x34\xfc\x90\xb2\xcc\x2c\xa1\x05\x06\xdf\x46\x78\x97\x71\x62\x39\x8f\x8c\x13\xe0\x4d\xc5\x02\x7e\x18\x26\x2d\xe3\x84\x89\x6b\xf8\x17\x1a\x7a\x31\xcf\x34\x71\xfc\x32\x18\x69\xd9\x5f\x30\xf8\x8c\x13\x6f\x66\x67\x0a\x09\x39\xe3\xa1\x59\x93\x62\xdc\xc6\xfd\x5f\x53\x45\x13\xf3\x7c\x19\x34\xf9\x81\xe5\x3a\xd3\xa4\x39\x9f\x99\x24\x07\xdb\x23\x53\xc1\x78\x5c\xa4\xa1\x24\x15\x44\x7c\xcc\x1a\xb2\xde\x55\x89\x23\xb5\x1c\x81\x75\xfa\xe8\xec\xec\xfc\xd5\x8f\x4f\xcf\x5a\xc9\xac\xcf\xda\xea\x37\x04\xa5\x3b\x3f\x7f\x79\xf2\xe8\xf1\xab\xf3\x27\x27\x3f\xbf\x7a\xf1\xe2\xd9\xd9\xf9\x9f\x9f\xbd\xf8\xfe\xd1\xb3\xf3\x1f\x5f\xbc\xf8\xeb\xf9\x79\xaf\xd7\xc2\xee\xde\x5e\xc5\x6e\xbc\x27\x8f\x4f\x8c\x99\xe8\x27\x96\x45\x2a\x32\x1e\xcd\xa5\xa8\x64\xc9\x53\xa3\xcf\x02\xa4\xb0\x53\x08\x47\x27\x4f\xa0\xfb\x0f\xfe\xa3\x1e\xfc\xbf\xa7\x1e\x34\xe8\x8c\x91\x92\x14\xa4\xf2\x41\x77\x3f\xe5\xa1\x79\x72\x0f\x5b\xb3\xe3\x60\x2b\x9f\x47\xab\x0c\x4c\x79\x2a\xc5\x49\x58\xe4\xd0\x2d\x0b\xdd\xba\x4e\x49\x8e\x37\xbf\x49\x78\xff\xfd\x1f\xd2\x48\xb4\x89\x74\x4d\x7e\x2f\x23\x53\xb9\x03\xed\x23\x91\xbe\x3e\x59\x1b\x6c\xcc\xda\x6d\xec\xc8\x6f\x36\x00\x59\xf7\x37\xb9\xcf\x63\x7d\xfb\x8d\x63\x3f\xe6\xf4\x1f\x72\xd4\x04\xe2\x17\xb9\xdd\xa2\x5f\x24\x58\x4a\xfe\x2c\xe9\x1c\xef\x6e\x52\x59\x42\x0c\x83\xeb\x15\xdf\x6e\x93\xb5\x78\x27\xe4\x7b\x71\x64\xe2\x24\x24\x23\xee\xd9\x8f\x57\x26\x88\x02\xfd\xb3\x84\xd0\xbd\x25\x52\x44\x3b\x7e\x04\x4c\xfe\xf6\x4b\xc1\x0c\xd4\x5d\x55\xf6\x6c\xff\x4d\x06\x95\x02\x07\x96\xe3\x57\x27\x1a\xd5\xf4\x57\x39\xe1\x53\x22\xe8\xdf\x64\xf0\x0b\xe2\x26\xda\x66\x17\x1d\x0d\x1f\x8a\x7d\xb6\xf2\xdb\x07\x86\xe5\x82\xfc\xbf\x48\xd0\x66\x43\xeb\x5d\x9d\xf2\x0f\x5a\xb1\x5c\x9b\xe7\x4f\xaa\xfd\x3a\x96\x4d\x33\xe5\xa9\x36\xcf\x56\x9b\x11\x02\x2a\xad\x02\x9b\x21\x01\x2e\x37\x27\xde\x1b\x1e\x34\xb8\x13\x39\x25\x05\xd5\xa4\xa2\x12\xc0\xba\x23\xf7\xb9\xa3\xea\x00\xc6\x6f\x49\x85\xf1\xe8\x8e\x9c\x54\x53\x5a\x9a\xf5\x59\x83\xc0\x66\xc9\x2a\x3e\x7b\xc9\x17\x45\xa5\x81\x68\x92\x02\x0c\xe3\x2a\x17\xab\x01\xc0\x60\x00\xc2\x1a\xaf\xf7\x7b\x80\xd8\x0a\x39\x5a\xc3\x0b\x03\xb0\xc9\x47\x0c\xf4\x3e\x86\x6d\x2a\x53\xb5\xd7\xde\x18\xe5\xe8\x30\x15\xf6\x19\x30\xa5\xdd\x01\xce\x98\xd3\x56\x75\xd9\x3e\xdc\xdf\x10\x09\x33\xd5\x78\x5f\x20\xf7\x7b\x05\xb4\xb9\x6c\xc2\xa7\x7b\x95\x86\x83\x01\xd4\x19\x99\x3c\xd0\x9a\xdb\xbf\xd1\x0c\x4f\xc4\x34\x9d\x71\x88\xda\xcb\x45\x5e\xf0\xaa\x4d\xe1\x49\x04\x00\x05\x73\x50\x87\xc1\xd9\x97\x37\x81\x15\x6a\xf0\xb4\xd7\x26\xae\x65\xf7\xd7\x83\xd5\x80\xf7\x7d\x7e\x95\x13\x3d\xed\x52\x6a\xcf\x8d\xf9\x3a\x80\xfa\x2e\xc4\xf9\x18\x99\x3c\x2a\xa1\xfb\xc1\x6e\x27\x7a\xbd\x0a\x45\x62\xf6\xb9\x93\x6f\x70\xfa\x77\x88\x2c\x72\x93\x55\xb8\xda\x9f\xc8\xbb\xdf\x0c\xdc\xb9\xd4\xfe\x51\x9d\x9f\xe4\x8c\x9b\x58\x70\x9a\xfe\x55\x82\x83\x94\x62\x28\xca\x22\x8e\x41\xd5\x38\x3e\xa7\x46\x0f\x2a\xd9\x98\xc1\xff\x5e\xd3\x90\x31\x46\x27\x7c\x9a\x49\x46\xa3\x77\xae\x57\xf6\x9c\xf9\xf7\x61\x38\x95\x8c\x68\xca\x18\xcc\x12\x63\x54\x3a\x8b\x04\x18\x11\xd1\x4e\x45\x36\x18\xf1\xda\x1a\x9b\xf7\xfb\x78\x8e\x40\x5f\x1c\x43\x70\xd1\xa0\xba\x79\x43\x09\xb1\x0c\x88\x50\xc6\x25\x5c\x4a\x5d\xee\xb2\x41\xc9\x2e\x10\x76\x4e\x2d\x54\x32\xef\x3b\x48\x19\xdb\x6e\xd1\x25\xc2\x64\x85\x5a\x54\x29\x66\x68\x15\x0b\xb2\x32\xe8\x04\x8f\x2a\xd8\xce\xa3\xc8\xdb\xa3\x74\xa8\x19\xef\xe6\x85\x60\x65\x79\xbd\x81\x22\x43\xb2\x68\x44\xea\xbe\xae\x09\xf6\x6e\x77\xcd\xac\xa6\x69\xc6\xcc\x4b\x87\xe1\x7b\x0e\xdf\xa0\x30\x62\xa9\xe6\x15\xc4\xaa\x1c\xcf\xcc\xc6\xee\x0e\x32\x34\x77\xbf\x48\x77\x18\x03\x7b\xee\xe7\x23\xc8\x8f\xec\xd3\x49\xc0\x29\x0a\xb3\xbc\x6e\x00\xdd\xe1\xc8\xa9\x76\xdd\x06\xd2\x4e\x3b\xec\x9b\x72\xfa\x61\x4b\x47\x26\x99\xab\x35\xb0\xda\x61\xaf\x87\xf6\xc9\x60\xfc\xee\x3b\x1b\x77\x45\xca\xf2\x9c\xaf\x74\xe5\xe2\xab\x56\x59\x02\x8f\xee\x1c\x25\x5d\x4a\x11\xa7\xfb\xae\x98\xfe\x05\xd0\xaf\x20\x9e\x4b\xc2\x54\x61\x4b\x72\x08\xfc\x6a\x4c\x33\x7c\x2f\xc3\x68\x06\x3f\x1c\x0c\x14\xa4\x21\x91\xa0\x4c\x6f\xb7\xf5\x64\xf8\x41\x0f\x60\x2b\xfa\x50\x91\x16\x03\x79\xb0\xb1\x9b\x0e\x37\x4b\x66\x32\x3a\xf7\x7c\xd7\xda\x8c\xbb\x73\x3f\x80\x02\x3d\xd8\xb4\xaf\x5c\x5a\xa7\xa8\x7e\x62\x3f\x81\x2f\xbc\x49\x7e\xb0\x9f\xbc\xdd\x0e\xbf\xd3\xbb\xc3\x48\xaf\xef\x3d\x94\x40\x42\xb8\x4b\x7f\x6f\xfe\xe8\x5d\xe8\x6e\xbb\xbd\x67\xff\xdc\x87\x3f\xd6\x1c\x08\xa2\x11\x9b\xc0\xe7\x80\x60\xa9\x6a\x49\xac\x56\x2c\xe7\x20\xec\x84\x9c\xcb\x75\x05\xcf\xa0\x79\x44\x45\x45\x6d\xaf\x0d\xbe\x09\xa6\x11\x67\x83\x04\xb3\xe0\x7b\xa9\x18\xf8\xbe\xfc\xce\xe1\xf9\x24\x56\x43\xfe\x2e\x62\x3b\xf9\x64\x38\x4d\xb5\x7c\xbd\x5a\xf9\x75\xad\xcb\x9d\x84\x75\x70\xa8\x73\xc5\x0e\x31\xe6\x78\xc5\x26\x7a\x6a\x84\x34\x23\xbf\x28\x72\x6c\x4c\x71\xcc\x8a\x64\x66\x93\xdd\x0d\xc8\xa2\xd7\x43\x89\x04\xe5\xb6\x9e\x0c\xe0\x21\xab\x17\xfe\x37\xc8\x09\x13\xa0\xc5\xa9\xb6\x4f\x5c\xfd\xe4\x7f\x63\x0c\x51\x2f\x21\xc8\xbd\x20\xd2\xc5\x5f\x73\xa1\x2f\x95\x0d\x2d\x04\xbd\x8d\x01\x1a\xb7\x9f\xe1\xd4\x8c\x7d\x2c\xf8\x3a\xc8\xbc\xf1\x3f\x69\x84\x9d\xd7\xc4\xa8\x53\x71\x26\xf7\xe7\x78\xcc\x27\xb2\x31\xc1\x53\x1a\x5a\xbe\xd7\xf5\xa3\xeb\xf5\x92\x24\x13\x19\xd2\x54\x36\x97\x8f\xa8\xfd\x14\xb3\xa0\xe4\x76\xe8\x90\x74\xed\x12\x41\x61\xd3\x48\xbb\x69\x20\x6e\x12\xcc\xa8\x79\x17\x00\x00\x26\x6a\xdc\x1c\xc8\x4f\x67\x86\xb2\x12\x2d\x03\x14\xb8\x11\x11\xf4\x45\xb4\xf8\x0e\x98\x76\x0b\x0b\xc8\xcc\x10\xa7\xcf\x98\x31\xb0\x60\x2d\x16\x16\x2d\x1c\x14\x78\x9e\x43\xcd\xa8\xc7\x53\x77\x13\x9a\xe0\xb9\x3c\x75\x12\x35\xbc\x09\x3f\xe9\x20\xdc\x77\x46\xec\x06\x61\xbd\x0d\x5b\xe4\x24\x6e\xb0\x9d\x4d\x74\xc6\x86\xc3\xef\x20\x6e\xcd\x5c\x8e\xc1\x2f\x95\xd4\x4d\x0f\xe3\x66\x62\xd6\xaa\xa5\x85\xba\xd6\xdd\x66\xad\xf8\x0a\x78\x8c\x78\x9b\x0e\xc6\x1b\xeb\xb5\x4d\x89\x2f\xc3\xd3\x28\x4e\x1c\xc4\xfa\x03\x81\x83\xdd\xc0\xa6\xea\x81\xbb\x78\xa8\xe8\x71\xbe\xc7\x6f\x1f\x98\xc3\x52\x89\xd7\x84\x24\x16\xa9\x9d\x87\x8c\x53\xab\x39\xb7\xc9\xef\xea\x64\xaf\xda\xb6\x19\xef\x43\x46\xad\x26\x71\x59\x6f\xeb\x2c\xa7\x32\x71\x19\x67\x07\x19\xf0\x42\x61\xb2\x6b\x58\xba\x84\x21\xdc\x64\x88\xf4\x22\xb4\xe2\x84\x93\x41\xde\xec\xba\x39\x39\x28\xe0\x65\xd6\xae\xc0\x63\x96\xf9\x6d\x63\x05\xbe\x5e\x44\xa1\xa9\x6e\xce\xb4\x76\x33\x9d\x24\x64\x6f\x0d\x50\x02\x57\x96\x1e\x27\xee\xc1\xd5\x97\x7c\x8e\x0c\x67\x8a\x93\x2c\x4a\x4b\xdc\xe5\xf0\xdc\xc3\xd4\x79\x8c\x2c\xd5\xe5\x32\x5e\x36\x32\x9c\x93\x80\xcd\x7a\xc5\x32\x43\x06\x36\x76\xff\x38\xec\x2f\x83\x39\xfd\x42\xc3\xe6\x72\x57\x4d\xa7\xc9\x0b\xbd\xad\xe5\xea\x49\x32\x9a\xc9\x0d\xaf\x4d\xbc\x34\x5b\xb8\x49\xbd\x97\xb9\xeb\xce\x5d\x65\xe6\xcf\xd7\x36\x71\x38\xb0\x7f\xbf\xcd\x5c\xa0\x98\xc4\xda\x8d\x75\x78\xb8\xaf\x3d\x4b\x77\x3e\x03\xe1\xb0\xd1\x85\x1a\x1b\x1b\xfb\x7d\x66\xb4\xb2\x84\xd1\x7a\xec\xc2\xe0\x44\x70\x6e\x43\x82\x3e\x46\xca\x4e\x09\x3c\xcd\xcc\x20\x90\x41\x42\xe4\x98\xd1\xa4\x83\x18\xf0\xfb\x32\x85\x9d\x07\x2b\x12\xac\x57\x16\x8c\x24\x60\xbb\x92\x41\x6e\x59\x08\xfe\x93\x91\x8c\xf5\x13\x9c\x98\xf7\xe0\x4c\x65\xab\x23\x30\x12\xc2\xf0\x12\x9c\xa0\xc9\x1b\xd1\xe9\x74\x80\xe0\x4f\xfa\x48\x6d\xb7\xc9\x6b\xcb\x80\x26\xb8\xcf\x76\xba\x4f\x05\x01\x8a\xc5\xf9\xf6\xbe\x5f\x16\x20\x60\xf6\xf2\x89\x4e\xe4\xb3\x79\x06\x13\xeb\xa6\x32\x6c\xda\x4d\x93\x5a\x6a\x58\xdc\x35\xcc\xec\x0e\x0d\xf3\x6a\x41\x94\xdf\x11\xf5\xfc\xba\xfd\x9c\x44\xe8\xe4\x79\xbd\xae\x76\x52\x1d\x88\x86\xde\x02\x71\x1e\xcc\x17\xd0\x57\x85\x58\xad\xe1\x60\xed\x93\x61\x70\x6b\x1a\xb9\xd4\x85\xfc\x00\xd9\x7a\xbb\x4d\x14\x9b\x15\xf6\x8e\x8d\xd0\xf0\xab\xba\x27\xe8\x74\x6c\x6b\xf1\x59\x92\x39\x67\x48\x22\xbc\xd6\x7e\xc1\x75\x74\xc9\x3f\xe1\x55\xae\x8a\x95\x79\x33\x22\xb6\x13\xa8\x65\x24\xa0\x66\x56\xa0\x53\x0c\xbc\x55\x0b\xff\x15\x69\xfc\x44\xab\xf0\xcd\xc4\x99\xb8\x21\xa7\xe2\x2e\x92\xab\xa4\xa6\x18\xc4\xaf\x82\x0b\xd0\x2f\xe8\x4d\x2e\x9b\x9a\x6c\xf6\x3c\x57\x21\x50\x5b\xad\x83\x0e\x57\xa1\x74\x5a\xcc\x65\x51\xe1\x1d\xa9\xe2\x32\x70\x5f\x9a\xc1\x11\x47\xe0\x03\x9d\x45\x40\xae\x73\xb3\xab\xa8\x26\x9b\xda\x07\x35\x13\x91\x43\xea\x0e\x9b\x08\x4a\x3f\xdf\xe0\xd9\xa8\x4c\xe7\x7b\xb9\x01\x82\x1d\xa9\xb4\x5c\xbd\x52\x2c\x7f\x57\x88\x45\x5c\x9d\xa7\xe7\x66\x15\x4d\x1e\x57\xf6\x5c\xba\xd8\xe1\xb0\x2a\x0d\xbe\xfc\x25\xe2\x07\x35\xc0\x2c\x75\xaf\x8d\x57\x4d\xdb\xb4\x67\xee\xe6\xeb\xfa\x0b\xad\x3b\xac\xef\xed\xb8\xe2\x28\x8e\x1d\xe9\x4c\x44\xdc\xa3\xcb\x66\x60\xc8\xee\x16\xbf\x76\xdc\xbc\x7f\x63\xf6\x24\xbc\x4c\x64\x36\xe5\x38\xd1\x6a\xcd\x93\x2c\x99\xb3\xb2\xe2\x49\xc6\x53\xd3\x01\x26\x88\x53\x85\xdd\xb3\xb2\x3a\xf5\x53\x05\x9c\x69\x77\x10\xc1\xfa\x53\x1c\xfb\x57\xfb\x56\x7d\x8f\xaf\xa5\x0b\x90\xe2\x0e\xe6\x63\x9b\xed\x94\x73\xc4\xa5\x9a\x96\x7d\xda\x55\xfc\xe1\x9a\x73\xc6\x81\x62\x2c\xc0\xad\xf9\xbd\x82\xe7\x85\x94\x51\xe0\xa4\xce\xe9\xdc\x35\x1c\x5b\x51\x3f\x8a\x21\x73\x11\x1a\x3c\xdd\x62\x7a\x1c\x27\x49\x16\x28\x19\x93\x42\xec\x72\x76\xeb\x81\x8c\xc3\xaf\xba\xa8\xeb\x6b\x24\xe8\x99\xa3\xc3\xb5\x9d\xb4\xb1\xfb\x9b\x09\x4c\xf6\xe0\xa4\x9b\x26\xa0\x99\x22\x2e\xc1\x74\xec\x4c\xad\x95\x2c\x4b\x3e\xcb\x9a\x68\xc6\xcb\xfd\x6a\x5c\x63\x52\xc6\x7b\x90\x66\x0d\x50\xa2\x3d\xf8\xc4\xce\x83\xcd\x46\xba\xae\x81\x7b\x3d\x60\x3c\x02\x7a\x22\x1a\x78\xe5\xba\xe2\x53\x5b\xd1\xd6\x77\x7b\xeb\x0c\xb9\x0e\x60\x67\x59\x0d\x73\xcd\x23\x0a\xec\x71\x37\xa5\x54\x8d\x4d\x7c\x21\xc0\x40\x80\x20\xdd\xbe\x02\x62\xcc\xfc\x80\xd2\x86\x12\x34\x5f\x70\xe6\x0c\x49\xed\xf2\xcc\xf7\x7e\x76\xb0\x41\x48\xaa\xf5\xc5\x65\x61\x70\x34\xdc\x47\x46\x89\x62\x3e\xdc\x49\xe8\x98\xdd\x73\x48\xf7\x3b\xf4\x0b\xa1\x11\xf7\x90\xa6\xcf\x19\xff\x00\x83\x75\x8c\x01\xce\x0e\xcb\xc5\xbb\x25\xc1\xbd\x5e\x54\xfe\x0c\x85\x1d\x62\xd6\x14\x63\xc7\x83\x84\x09\xf7\x76\x6d\xfb\x3b\xc9\x0c\xb4\x99\x44\xbb\xdd\xfd\x52\xd1\xca\xfc\x18\x49\x5c\x6e\x1c\xcb\x76\x7b\x98\xd5\x04\xdf\x8b\x96\xeb\x75\xec\x86\xb9\xed\x52\xaa\x7a\x3d\x37\xb7\xf0\xe1\x25\x07\x40\xc5\xd9\x55\xf1\xc3\xf1\xdf\x5e\x88\x30\x02\xca\xa9\x7f\xc3\x41\x35\x53\x43\xc4\x76\xab\xe3\x4d\x11\x16\x5a\xc3\xc9\x89\xa1\xa4\x7a\x07\x81\x7b\x81\xac\x24\x86\x84\x04\x6a\x85\x3b\x0d\x62\x82\x49\xcb\xc4\xdd\xd0\xb3\x2b\x70\xd0\x4a\x6c\x8a\xfd\x83\x9f\xd8\x68\x23\x6b\x30\xd9\x31\x46\x41\xe1\x95\x28\x1b\x03\xd8\x1b\x3a\x18\xcb\x79\xc7\xa6\x88\x71\x00\xe8\x67\xbf\x73\x6f\x9b\x89\xac\x59\x3c\xde\xf9\xfb\xcd\x88\xf8\x8a\x78\x5d\xd3\x18\x35\x7e\xff\x41\x06\x03\xb9\xf0\x58\x4a\x64\x73\xc6\x3d\x12\x80\x7b\x40\xf7\xa9\x79\x97\x2a\x22\xd0\x7e\x6f\x8a\x11\x29\x20\x6f\x6f\xb4\xe3\x10\xf2\x0e\x08\x10\xa4\xe9\x6b\x78\x65\xcd\x65\x81\x50\x0a\xb9\x97\xee\xc0\x56\x08\x96\xb0\x6e\xf4\xb7\x5a\xb4\x61\x88\x73\x9e\xba\x47\x45\xa1\x2b\x1d\x47\x8b\xb7\xcf\x1c\x87\x67\x97\x20\xec\x9d\x9e\x80\x23\x8d\x98\xc8\x29\xbc\x86\x6c\x94\x00\x2d\x0f\x0c\x4b\x7a\xb8\xd1\xef\x00\x89\x24\xa6\x1e\x57\x99\xdf\x95\x79\x90\x8b\xcf\x40\xa6\x00\x20\xc7\x69\x54\x9a\x28\xc7\xca\xa7\xbb\xc9\x3f\xf3\xd9\xfe\x99\x47\xa3\x06\x84\xd8\xb2\xfd\x33\x50\xb3\x68\xa7\x4a\x6a\x79\xc5\x17\x46\x3b\x91\x0e\x02\x40\x84\x1e\x39\xf1\x89\x8c\xfa\xed\x0e\x8c\x96\x06\xd9\x9e\x65\x6b\xcf\xd8\xd9\xa5\x83\x68\xcb\x96\x29\x2a\x20\x77\x66\xf0\xea\x95\x79\x28\x18\xef\x7c\x09\x58\xda\xb8\xf5\x98\xa3\xfe\x47\xb0\xc4\x0d\x78\x08\x0c\x9f\x94\x5c\x57\xe5\xf5\x19\xd7\x4f\x85\xe0\xea\xc7\x57\xcf\x9f\xed\x49\xca\xbf\x8d\x2c\xd3\xc3\x8d\xde\xb8\xa7\xdb\x2e\x72\xbf\x21\xb2\x8f\x1c\x81\xf8\xce\xfe\x25\xbe\xb3\x1d\x5a\xf1\xf7\x0b\x4c\xa1\x11\x65\xd2\x7a\xef\x91\x48\x16\xe1\x30\x8b\xbb\x89\xa2\x51\xee\x8f\xe6\xae\x55\x4d\x35\x1d\x14\x04\x76\xf1\x74\x86\xdf\x51\xbf\x07\x0f\x54\x46\x60\x2c\x29\xa8\x98\x0c\xa6\x3b\x4d\xc5\xce\xa1\x77\x98\x72\x83\x8b\x04\xd5\xbb\x9b\xee\x7d\x03\x5d\x06\x9b\x26\x5a\x90\x9f\xe3\xe1\x36\xae\xd6\x83\xcb\x24\x72\x4d\x30\xdb\x4f\x10\xd1\x0d\x08\x34\xba\x29\x45\x7d\xeb\xc4\xf5\x7b\xbd\x03\x44\xd3\x82\x65\x84\xbb\xb3\xba\x54\x1d\x66\x26\x49\x5f\x45\x4b\xf5\x7d\x8d\x81\x78\x0a\x52\x03\x23\x5d\x10\x7a\x64\x10\xe2\x2d\xeb\x0d\x04\x81\xdd\xa9\xd1\x9e\xf5\xe0\xc7\x7c\xd4\x5f\x23\x86\xd1\x73\x8a\xd5\xd5\xc2\xb3\x7b\x89\xb3\x38\x79\xff\xfe\x7d\xfa\xfe\x9e\x51\xc2\xdf\x1d\x0c\x06\xc7\x50\xc4\x08\x04\x92\x4b\xa6\x97\xb7\x94\x1e\x7e\xfb\xed\x37\xc7\xcf\x99\x5e\x9a\x7f\x9e\x3f\x4b\xf6\xd9\xc7\xd6\x2a\xdf\x1e\x7f\x58\xea\xcb\x32\x66\x2d\xff\xde\x40\x9c\x4e\x5e\xb5\xdd\xde\x5e\x1f\x66\x69\xfc\x57\x78\x19\xec\xb6\x81\x40\x29\xe0\xd4\xa4\xe2\xc5\x42\xbc\xf0\x42\x1f\xf0\x0b\xbb\xb5\xf5\x2c\x42\xc1\x7f\x0e\xc7\x3e\xec\xb5\xc6\x5b\x9e\x70\x52\x7a\x3d\x01\x5d\xa5\x25\x73\xa9\xbd\x1e\x88\x45\x85\xe1\x8c\x5f\xd5\x5a\x11\x43\x5a\x21\x9b\xec\xee\x67\xbc\x6b\xac\x3f\x8d\x6e\x94\xbf\xc5\xfb\xbb\x7e\xd8\x51\x4c\xf6\x58\xeb\x29\xd5\xcd\x04\x22\x26\xc9\x2f\xfc\xe2\x5d\xa1\x93\x3e\x9f\xd2\xe4\xbd\xfb\xad\x21\xe3\xb9\xfc\xdd\xa6\x5e\xc2\x0f\x4d\xa2\xe7\x3e\x7e\x75\x9c\xd3\x53\x50\xfe\x78\x90\xcd\x07\x9c\xf8\xee\x93\x38\xd9\x05\x8f\x24\x82\x9a\xe4\x5a\xaf\x29\x40\xaf\x29\xf6\xaf\x14\x10\x79\x1b\xb5\xe7\x8f\x41\xc5\x65\x1a\xa6\x02\x58\x71\xdf\x66\x0d\xca\x5f\xea\xf3\xf1\x57\x06\x9c\x74\x24\x15\xa9\x75\x30\x06\x77\x80\x6b\x00\x3c\x70\xfa\x57\x06\x7c\x96\x99\xb3\xc6\xc5\x7c\x27\x3a\x6a\x10\xf6\x13\xc6\x62\xde\x2f\xe1\x4a\x30\xcd\x8d\xa2\x70\xa4\x9d\x08\x66\x84\x35\x0d\xbf\xcd\x95\xc5\xa9\x06\x39\x16\x54\x1e\x74\x29\x68\x55\x1f\xf4\x74\xca\x8d\xc5\xdf\x2b\xb6\x80\xeb\x5b\x84\x2a\x60\x25\xeb\x7f\x07\x69\x8e\x97\x94\xc1\x96\xd0\xa9\x66\x8b\xb1\xc8\x9a\x52\x33\xce\xdd\xcc\x0f\xa1\x8c\x93\x94\x79\xec\x00\xe6\x9b\xc5\xef\xdc\x86\x97\xab\xd1\x79\x84\x02\x0c\x61\x50\x0f\xc8\x3d\x3d\xda\xa8\xe7\x31\x53\x1d\x88\x19\x30\xe4\xf2\x7a\xa6\x98\xe6\xb3\x76\x59\x9e\xf6\x50\xc1\x0c\x76\x0f\x6d\xc3\x87\xdf\x7c\x13\x13\x55\x82\x47\x13\x5d\xcf\xaf\xe3\xb2\xfd\x8d\x42\x29\x45\x9a\x42\x8b\xfb\xf7\x83\x69\xce\xaf\xb1\x86\xfe\x0c\x93\x06\x67\xd1\xd1\x38\x02\xbc\x74\xa9\x1e\x8d\xbc\x05\x83\x70\x53\x1d\xcd\x0a\xc3\x46\x5a\xe8\x7c\x56\x59\x13\x0e\x57\xa6\x8c\x6e\x71\x8a\xa4\x89\x5e\x0f\x2b\x86\xf1\x46\x50\x39\x02\xeb\xe2\x42\xac\xf9\xce\xb4\x04\xf2\x62\x66\xaf\x4c\xa8\x6a\x7f\x59\xc7\xf1\x92\xba\x8c\x51\x39\x32\x4d\x96\x31\xa5\xa2\x39\x62\x98\xf0\x91\x4b\x0f\x61\x57\x6d\xba\x1e\x95\xb4\x4c\x2b\xfb\x32\xfe\xae\x6d\x62\x01\x44\x07\x18\xd0\x5c\x1e\x46\x41\x19\x91\xb4\xac\x9f\x16\x86\x91\x16\xa0\x03\xae\x02\x38\x95\x05\xa7\x32\xe0\x6c\x0a\xd0\xe3\xfa\x6a\x61\x4c\x90\x29\x5d\xa6\xa4\x8c\x88\x90\x59\xd1\x2a\x00\x06\x8b\xe7\xdc\xe4\x2b\x3f\xf8\xf6\xe6\x4b\x22\x29\xbb\xa9\xf9\x92\x08\xca\x6e\x6c\x7e\x7f\xf4\xdf\x82\x85\x80\x19\x7e\x58\x3d\x98\x81\xfd\xfd\xf2\xed\xc0\xce\x12\xa8\xb9\x84\x39\x33\xb7\x6c\x28\x51\x1b\x22\x78\x63\x1e\x00\xbf\x19\x18\x4f\xf9\x1d\xdf\x05\xdf\x67\xf8\xf0\x5c\x99\x11\x73\x07\x62\x5b\x53\x0e\x9b\xb0\x98\xa3\xaf\xfc\x99\xde\x6e\x1f\xf8\x9f\x61\xa1\x61\xe9\x1d\xb5\x85\xdd\x5f\xb7\x8a\xa0\x2a\xf2\x84\x98\x5d\x49\x28\x0a\x67\xdf\x6d\x5f\xe8\x69\xd4\xd5\x7e\xa2\x46\xde\x06\xc8\x56\x07\xe6\xd4\x35\xb4\xaf\xdb\xa9\xd1\xd7\x2e\xd4\xf6\x65\x7d\x8e\xe9\xdb\xe5\xed\xda\xcf\xbe\x74\x71\xed\xc2\xa1\xd1\x7b\x93\x7b\x6f\x10\xcd\xad\xf3\xd0\xd5\xd9\x81\xdb\x6a\x33\x41\xdb\xf8\xc7\xeb\x6a\xe9\xcc\xc0\xa0\x0f\xc2\x71\xe6\x52\xc1\x68\x84\xe3\xbd\x56\x34\x1e\x4f\x78\x08\xef\xaa\x71\x36\xe1\x44\x4f\x6b\x50\x99\x37\xc9\xde\x34\xeb\x19\x39\x9d\x67\xe4\x20\x3f\xe3\xf0\xfc\xb9\x8b\x5f\x1d\xbb\xc8\x97\x7e\xd9\x23\xfc\x75\x3e\x73\x3e\x5a\xa0\x34\xe2\x82\xab\x0a\xee\x8e\x3a\xf9\xa9\xb3\x67\xad\x0e\x89\x61\x5d\xfb\x96\x2b\x78\x3d\x23\xd2\x29\x77\xc1\x0e\x17\xf8\x2c\xb6\x30\xd6\x4c\x67\x5a\xae\x56\x7c\x06\xf1\x08\xfa\x7d\x6c\xc2\x6c\x4c\xd4\x94\x98\x90\xda\x66\x5f\x40\x38\x46\x6f\xca\x36\x6a\x83\xca\x87\x03\x38\x04\xcc\xe7\x14\xd5\x29\x18\x68\xc3\x28\x34\xc2\xdb\x6d\x53\x38\xae\x78\xc9\x81\x8a\x68\xd8\xff\x15\x7e\x42\x1c\x8e\x04\x96\xf7\xef\x8c\x4a\x8e\xfe\x0e\x46\x23\x70\xcb\xfd\x9d\x91\xbf\x3b\x83\x1b\x17\xa8\x14\x96\xa1\xe4\x98\xfc\xfd\xc0\xe6\x0a\x1c\x0f\x8b\x39\xfa\xc5\x9f\x60\x4e\x7f\x96\x04\xcc\xf6\x86\xe4\x67\x67\xc9\x17\x8b\xdc\x0c\x34\x35\x23\xcd\xc3\x03\x47\x3c\xad\x54\xee\xe4\x06\xdb\xad\x0d\x2d\x6a\x1c\xb1\x95\xe2\xd5\x4a\x8a\x19\x3c\x3f\x5e\x79\xc9\x02\xd0\xc5\xf4\xc6\x5c\x4c\xec\x55\xeb\x29\xb4\x31\xc4\xd6\x06\xac\x00\xa6\x4a\x31\xfd\x97\xfb\xb9\xe8\x0a\x4f\xba\x74\x87\x23\x4e\x13\x29\x92\xbe\x0f\xa1\x6d\x0c\xfe\x7c\x38\x1d\x7f\x2c\xb4\x61\x30\x43\x90\x9d\x66\xa0\x1e\x13\x25\x0f\xde\xb1\x69\xa8\xc1\x39\x49\xdc\x8d\x06\xef\x33\xb7\x79\xba\x1a\xeb\xa5\x98\xbc\x59\x1b\xf8\x80\x24\x5c\x3d\xe3\x57\xbc\x84\xc1\xb8\x39\x4d\x85\x71\x73\x30\x06\x83\x3e\xc9\xce\x25\xec\x5e\x9f\x02\x56\xd9\x10\xf4\xcb\xdb\xd3\xda\x98\x12\x7f\xf6\x6f\x2d\xf7\x7a\x7f\x0e\x76\x59\x75\xaf\xf3\x60\x68\x6f\x16\x3f\x94\xf6\xda\x8f\x3f\xb3\xfd\x38\x1b\x0d\xf8\x20\xda\x86\xb1\x9e\x3b\xbb\xae\x34\xbf\xfc\xa1\x64\x8b\xca\xc4\xd7\x88\x21\xd6\x44\x36\xc0\xad\x83\x6e\xc4\x6d\x81\xa5\xf9\x5e\x4b\x99\x22\x51\x3b\x99\x76\x8f\x81\xc1\xa0\x33\x41\xc2\x78\xb3\xc9\x34\xda\x75\xb3\x06\xf9\x52\xd7\x00\x5b\x4a\x20\x00\xe1\x26\x14\x78\x13\xcf\x97\x99\x15\x81\xdd\xbd\xe6\x62\x32\x03\x3e\x80\xad\xa5\xcc\x8d\xa4\xa8\x7b\x7e\x07\xb6\x55\x1d\xdc\xf1\xa9\x98\xcb\xfa\x06\x1f\x29\x87\x98\x47\x58\xd1\xf0\x5b\x51\xb8\xd8\x4c\x33\x96\x10\xba\xb1\x25\xb8\x04\xbb\xca\xdd\x1c\xda\xde\x85\xe4\x2b\x20\xf6\x7a\xbd\x07\x4e\xf0\x71\x08\x36\x11\xf4\xef\x1c\xcc\xbe\x2d\x9d\x2a\x70\x43\x42\x54\x17\x77\x62\x19\x01\x71\x31\x34\x8d\x72\x20\xa0\x29\x0c\x5a\x52\x38\xb1\xf1\xd2\xe1\x11\xa8\x67\xe3\x55\x72\xef\x2d\x34\x4a\x19\xc7\xc1\xfd\xb5\x1b\x39\xb1\x37\x2a\xb7\xf4\xc1\x7d\x1c\xae\xdc\xc2\x6e\xd7\x8a\x0e\x46\xd5\xc3\xbf\xf8\x20\xf9\xa3\xca\x07\x58\xcd\xe9\x5f\xc0\x16\x75\x94\x83\x45\x1b\xcd\x9b\xc6\xb2\xc6\xe8\x84\x81\x31\xb2\x11\xb7\x15\x80\xc9\x0a\x92\x03\xf9\xc0\x51\x11\x63\xbe\x55\xb8\x4c\x60\x4e\x0d\x1b\x03\x54\xc0\x01\x23\x9d\x83\xb2\x21\xc9\x1e\x43\xb0\x54\xff\x45\xba\x03\xb7\x17\x2c\x03\x3d\x97\xf9\xba\x72\x76\x6e\x17\xe5\x5a\xf9\xe2\x36\x1d\x4a\x13\x9b\x60\x32\xcd\xb7\x30\x2c\x8c\x4b\x80\x01\x87\x24\x57\xc9\xa4\xc5\x9d\xe4\xb0\x1c\xa5\xeb\x25\x2f\x25\x68\xa1\x0c\xca\xea\xf5\x4c\xe3\x7c\x1f\x2a\x30\xa9\x2f\x8b\x99\xab\xe1\xe4\xd5\xf6\xc3\x8a\xab\x33\xdb\xba\xe7\xe6\x8d\x39\xcc\xf7\xf5\x23\x4e\xc0\x5f\x9c\x9a\x59\xc2\x3b\xe1\x38\x2e\x03\x55\x34\x8b\x17\x8e\x7a\x80\x85\x11\xf4\x2f\x60\xb4\x66\xa3\x2a\x18\xa1\xaa\x2f\x06\xe7\x2d\xcc\xf7\x0e\x13\xf6\xd1\x22\x75\x0f\x4b\x7e\x68\x99\xb9\xb9\x28\x25\x88\xfa\x5f\x88\x8c\x93\x06\x8a\xd0\x87\x28\xe2\xde\xdd\xad\x68\x60\x09\x49\xc2\x99\xca\xe2\x90\x47\x97\x6e\x2c\x7b\x3b\xe0\x70\x71\xff\x62\xaf\xc3\x78\xae\x67\x10\xdc\x1b\x5e\x29\x73\x05\xe1\xdb\xbc\x01\x9d\x64\x77\x0e\x4b\x5f\xca\x75\xc5\xe5\x55\x28\x6d\xbf\xd7\x3a\xc9\x78\x79\x50\x78\x25\x0b\x68\x38\x2a\xee\x53\xa0\x82\x2e\x53\xab\x54\x85\x77\xd3\x6c\xfa\xd3\x59\x63\x1b\x2c\xa4\x76\x19\x39\x5b\xe9\xb5\xe2\xae\x95\x52\x56\x07\x19\xa2\xb5\xb5\x68\x8e\x16\xd1\x6a\x10\xb6\x27\xcb\x01\xf9\x7e\xe3\xdc\x83\x43\xd6\x18\x71\xba\x0c\x96\xb4\x84\xd5\xfc\x69\xcd\xd5\x6a\xfa\x67\xb0\x36\xc3\xbd\xde\x6b\xe6\x28\x43\x74\x88\x30\xb6\x54\x35\x88\xb9\xab\xc6\xce\x70\xab\x16\xac\x4f\xed\xaa\x39\xf8\xfe\xc2\xe8\x82\xa3\xbf\x30\x52\x57\x20\xdd\xc1\xc1\xd2\xb9\xd2\x77\x4c\xe9\x3b\xad\xa5\xa3\xa5\x73\xa5\x79\x09\xa5\x79\xd9\x56\xda\xcd\xa1\x5d\x3b\x38\x23\xe6\x41\x35\x3f\xb1\x81\x7c\x28\xcd\xe9\x62\x64\xc1\x91\x2e\x8d\x0c\x84\x61\x6b\x86\x15\x35\x5a\xb7\xda\xb2\xa2\xae\xa5\x46\xf3\x44\x44\xed\x8a\x5b\xdb\x6d\xb1\x26\xbd\x8e\x6e\xc9\xbf\x03\xd6\xb7\x17\x25\xf6\xdc\x35\xac\xa0\x3f\xf7\x77\x50\x23\xdd\x08\x86\x8c\x80\x03\xb9\x3b\x0a\xc7\x34\x26\xc8\x2c\xe0\x16\x0a\x1c\x17\x4f\xc3\x79\xa6\xce\x19\xe3\x77\x99\xae\x81\xbc\xbd\x28\xf9\xb9\x5a\x8b\x5f\x0a\xbd\x3c\x55\x85\x84\x97\x0a\x80\x83\x70\x3f\x6b\x9d\x0d\xde\xfc\xce\x40\x66\x5c\x07\x6b\xba\xe7\x04\x27\xd1\x55\xea\x84\x20\xbe\x5b\xe8\x08\xc5\x7d\x43\x15\x73\xa5\x8e\xc5\x4d\x17\xb0\x33\x25\x8c\x6b\x35\xd9\xa9\xf3\x7d\x82\xba\x2e\xb9\x6f\xa4\xf0\x8a\xa3\xe6\xd5\x49\x0e\xf7\x3c\x89\xfa\x6f\x92\x79\xad\x0b\x61\x8e\x91\xdf\x56\x2e\x53\x98\x53\x65\x54\xed\x35\xd0\xa0\xc2\xde\x1d\xbe\xb3\xfb\xc1\x1d\x29\xbc\x31\xe3\xe8\xf5\x44\xc0\x07\xd1\xc1\x7b\xcf\x21\x2e\xba\x54\xe8\x6f\x0c\x1c\x1d\x06\x0f\x7f\xf5\x44\xa1\x93\xd7\x70\xfa\x2b\x9b\x0c\xa6\xa3\xf6\x69\xd8\x70\x38\xee\x71\x8a\x47\x09\xbc\xd7\xfb\x01\xde\x3e\x8d\xe8\xac\xff\xfe\x34\xb9\xb6\xf5\x38\xea\x91\xea\xec\x57\x66\x1f\x31\x46\x41\x27\xf4\x17\xd6\xeb\x9d\x03\x96\x00\xca\xc1\xe1\xf8\x00\xda\x1d\x9b\x79\xc7\x64\xde\xd9\xcb\xe4\xa5\xc9\xe4\x25\x64\x3a\x14\x8e\x89\xae\x6f\xb9\x0f\xf0\x50\x60\xe3\xb3\x9e\xcd\x77\xee\xda\x89\xc1\xf3\xc2\xfe\x3a\x05\xc0\x20\x7f\x03\xc7\x02\x98\xf4\x01\x89\x0f\x88\x8f\xa4\x1a\x9c\xb1\xe3\xcc\x9f\xa4\xba\x64\xa5\x3f\x3c\xe4\x3d\x6f\x18\xdf\x9e\xf0\xbd\xd0\xea\xb5\x7f\xc4\x3b\x40\xda\xdc\xc8\x5d\xa2\x15\xc6\x9b\x77\x1c\x99\xd5\x25\xbc\xa6\xd9\x6c\x38\xcf\x5f\x59\x4b\x34\xfc\x5f\x19\x50\x8f\xaa\x31\x38\xe0\x32\xe3\x14\x4f\x55\x80\xce\xb0\x5e\x8b\x77\xb0\x16\x84\x87\x59\x86\x25\x78\x07\x4b\x10\xa5\xc1\xcc\xc3\x04\x96\x84\x37\x26\x5c\x37\xe6\x1b\xbe\x8c\x3e\x54\x95\x31\x84\x8a\xaa\x72\x22\xa6\xe4\x13\x80\x33\x23\x1d\x0d\xea\x06\x8c\x30\x58\x95\x30\x0f\xee\xf2\x13\x75\x1d\x3c\xc2\xd7\x1c\x05\x15\x53\x9c\xd5\xeb\xa9\x32\x6c\xbc\x30\xef\x2f\xdc\x1e\xa8\xe7\x73\x4f\x7b\x4b\xef\xfa\xf9\x04\xb5\x2b\xd8\x40\x4e\x44\x7f\x38\x05\x9b\x46\xe0\x4e\x91\x9c\x0c\xf6\xac\xe6\xfb\xfe\xbd\x26\x50\x4a\x32\xba\xb9\xc1\x61\x2b\xdb\x5c\xac\x2f\x40\x47\x9a\x31\xe2\x6e\x93\x59\xc6\xfa\xc9\x63\x77\xb3\xec\x48\xec\xed\x94\x81\xcc\xc2\x1c\x3e\xbf\xa1\x32\xbd\x23\x95\xbd\x67\x14\x88\x78\x0a\xff\x9b\x61\x52\x96\x13\x39\xa5\x2c\x22\x1f\x1c\x39\xb9\xf1\x04\x6b\x6c\x46\xf3\x38\xe0\x1e\x3b\xd0\xca\xde\x58\x1a\x7b\x5b\xe3\xa0\x0d\x50\xe3\xbb\x99\x72\x06\xa6\x83\x4c\xd1\xb7\x3c\x0a\xec\xa0\xc9\x90\x78\x0c\x62\xae\xcb\xce\x30\x53\xf4\xec\xc6\x22\x9e\xfa\x55\xf4\xf9\x61\x99\x1d\xd8\x29\xb0\xd9\xcc\x50\x33\x5e\x12\x83\x34\x51\x40\x6b\x67\x37\x65\xc5\xa3\x7a\xeb\x46\x65\x14\xfb\x6c\xbb\xbd\x44\x3e\xc4\xca\x73\x4e\x18\x2d\xd8\xa8\x08\x9e\x3f\x4b\x13\xef\xd0\x16\x0e\x5e\x3f\xa8\x60\x14\x6e\xed\xa6\xdf\xcf\x59\xd4\xec\xaa\x44\xb3\x92\x34\xa0\xf7\x79\x11\x20\xcf\x63\x40\xe6\xe8\xa2\xc4\xcd\x83\xdd\xeb\x1d\x0d\x1f\xca\x32\xa2\xfe\xb1\x21\xdc\x1a\xed\x91\x5f\x83\x84\xc0\x32\xb5\x76\x28\xaf\xea\xb6\x3d\xce\x37\x32\x67\x43\x51\xab\xda\x20\xa9\xbd\x07\x79\x63\xf3\x50\xa7\x7b\x15\x97\xc0\x1b\xd7\x26\xe1\x74\xce\xa3\x18\x45\xd6\xfd\xf5\x0a\x81\xf7\x59\x3d\x79\x46\x64\xd2\xb0\x34\x8c\x40\x85\x69\x10\xb4\xe2\x48\x05\x74\x82\x0c\xa7\x0c\xa4\x89\x1b\xd9\x1d\x24\xc2\x45\x6b\x86\x64\xf1\x41\x3d\x78\xa0\xb8\x34\x5b\x8c\x3c\xc5\xc3\x1a\x94\x8e\x00\x4a\x47\xd6\x94\x8e\x18\x39\x7a\xc1\x8f\xae\xae\x22\x6f\x26\x54\xa0\x90\xe9\x65\x1c\x17\x3a\xa4\x4b\x1a\x8d\x4b\xa7\xba\x76\x08\x6c\xb7\xab\x27\xec\xf6\xd9\x6a\x95\x12\xbf\x74\xd3\xb6\x47\xf1\x83\x6d\xaf\x37\x4a\xae\x05\x57\x60\xd7\x0e\xd4\x17\x18\x0e\x8a\xed\xd6\xdb\x08\x05\x57\x0e\xbd\xdd\x3a\xc7\xaa\x65\xb9\xaf\x3e\x04\xa6\x73\x59\x4e\xf8\x74\x0c\x71\x05\x35\x4e\xb5\x2a\x2e\x11\x86\xd0\x9e\xab\x0f\x91\xbf\xf4\x33\x87\x49\x40\x6a\x68\xde\xcf\xa8\xef\x22\x90\xd0\x69\xdc\x66\xf2\x25\x82\x29\x17\xf4\x2f\xc2\x4e\x4c\x8e\x8e\x12\x78\x21\xf0\x25\x47\x82\x68\x40\xb0\x0a\x8f\x92\x79\x29\x99\x31\x9e\x73\xc6\x04\x79\x55\xfd\x60\x92\xb0\xf7\x76\xa9\x1b\x26\x12\x67\x80\x99\xa9\x8c\xf6\xda\x4f\x0e\x46\x00\xc5\x6c\x8b\x05\x0c\x2c\x78\xb8\x39\xad\x80\xe2\xe1\x39\xf2\x9b\x0d\x4d\xf6\x04\xad\xc3\x7b\x5f\x9b\xc8\xa8\x18\x8f\x3e\xc1\x4a\xa5\xde\x91\x75\x97\x7b\x0d\x3e\x18\x38\x3f\xe4\x43\x8f\x89\x1b\xdb\xed\xf5\x92\xf3\x73\xa3\x45\x2f\xc4\xcd\xa5\xf6\x41\x7f\x00\x6e\x86\x11\x40\x66\xf1\x7a\xbd\x03\x7f\x1f\x97\xb3\x5f\xfb\xae\x19\x75\x34\xc9\x8f\xea\x49\x76\xfe\x3c\xf5\xaa\x26\xfe\xe0\x79\x8b\xf8\x68\x50\x45\xb5\xe7\xc3\x92\x98\xb7\x9f\x8c\xb0\xfe\xe8\xc3\x65\x10\xb4\xc8\x52\xaa\x23\x17\x7f\xd1\xa5\xcd\xa5\xd0\x47\x73\x96\x1f\x7c\x1f\x55\x2a\x3f\x48\x5b\xab\xe2\x20\x6d\x0e\x44\x99\x3e\x48\x06\x3b\x3b\x97\x78\x59\x54\x55\x21\x16\x47\x8b\xf2\x7a\x15\x8c\x33\xba\xc3\x20\xac\x09\xb4\x7b\x3d\x13\x4f\xdc\x4c\x70\xfa\x6d\x43\xe0\xbd\xdd\x0e\x87\x7b\x12\xf0\x6c\xcf\x52\xcf\x59\xad\x82\x2e\x1e\x7c\x9d\x58\xe3\xb5\xd0\x86\x66\xc3\xa8\x2f\x56\x1c\x41\x40\x6e\xc2\x1b\xf1\x59\x9f\x02\x5b\x50\x7f\xfe\xe8\x39\xa1\x70\x6f\x83\x94\x7e\xbb\x6d\x0d\x8d\xe1\x65\xe7\x63\xff\xc3\x59\x47\x45\xa8\xd3\xe8\xbc\x6a\xff\x58\xde\xb4\x2e\x04\xc9\xc3\x85\x9c\x5d\xbb\xa0\x07\x35\x19\xeb\x92\x6b\xb8\x7e\xe0\x3e\x4c\xc5\xc8\x84\xaf\x8e\x2c\x3c\x30\xa7\x8d\x6f\xdf\x44\x5d\xf9\xb5\x9b\x63\x3b\x5f\xa6\xa9\x11\xf8\x1e\x87\xc9\x1a\x09\xab\xc1\xdb\xb3\x0a\x81\x24\x45\x79\x5f\xc4\x26\x20\x6e\x4a\x09\x7f\x08\xe4\xbe\xfa\x2e\xa8\xec\x37\x50\x2f\x13\x44\xce\xe7\x60\xf8\xaf\x8f\xf8\x6e\xc4\xa9\xda\xf1\xcc\xc2\xed\xfa\x10\x26\x06\xf0\x99\x55\xef\x81\x46\xbb\x91\xe0\x9d\x69\x76\x90\x5e\x6b\x3c\x76\xc2\x85\x03\xd8\x19\xf8\x1b\x76\x56\xbf\xbb\xd1\xb9\xcd\x85\xba\xf0\x5a\x15\x18\x74\x20\x6e\xf1\xb5\x49\xb9\xd7\x8d\x37\x13\xe4\x6a\x6b\x05\xa3\x43\xe2\xd8\xb6\x14\x75\x8b\xb3\xc4\x5d\x58\x55\x02\x3e\xf1\xe3\x70\x81\x55\x60\xdb\x03\xd6\xa8\x2e\x22\x95\xdf\x93\xa7\xb2\x32\x81\x33\x7a\xbd\x6e\x17\x0d\x1f\xf4\x6e\x2c\x00\x92\xa3\x98\xd2\xf9\xcd\xb3\xa7\xb0\x20\xdc\xbd\xc3\x47\x34\xfd\x91\x23\x3c\xd2\xf1\x33\x70\x3c\x05\x0c\xf5\xf4\x07\xc5\x2e\xfd\x3e\x1a\xe1\x8d\xb9\x1a\xdb\x9f\xae\x76\xb1\x53\x85\xfe\xc5\x34\x9a\x96\xee\x55\xcc\x74\xa9\xf8\xbc\x8e\xb7\x01\xfe\xff\xc0\x37\x75\x85\x13\xd5\x73\xba\x57\xd3\x82\xc3\x53\xbf\xdd\xc3\xb5\x1b\xe9\x7b\xfe\x11\xeb\x29\x7a\xbd\xda\xfb\x26\xfe\xdd\xb4\x07\x0a\x12\x25\x70\xc2\x09\x0e\x3a\xc0\x4b\x26\xb0\xef\x9c\xb7\x8e\xc1\x09\x89\x7d\x59\xbb\x91\xa4\x79\xd9\xf8\x5e\xab\xe6\xf7\x8a\x55\xd5\x7b\xa9\x66\x75\x22\x36\xb5\x3e\x68\xa6\x38\xf3\xce\x3e\xc6\x51\xc2\x94\x70\x43\x3e\x99\x15\x86\x17\x8d\xd6\xe8\x17\xb7\xd3\xf6\x50\xf0\xc5\x5a\xeb\xe0\x31\x6e\xe1\x77\xd2\x6b\x63\xa9\xe9\x3e\x42\x87\x1e\x2d\x76\x75\xca\xd6\x5a\xfe\x00\x32\xbf\x16\x51\xd6\xcf\x8d\x6d\xdd\x80\x17\x46\x65\x0d\x6d\xfd\x97\x90\xd6\xb9\xc8\x7f\xb7\x6c\x81\x70\x6b\xd7\xd6\xcf\x6d\x99\x9f\x71\x91\x3a\x7a\xf1\xa3\x25\x6e\x2c\x90\xda\x9b\xb8\x1e\xf3\xf7\x35\x96\x13\xeb\xb2\xfc\xff\xb8\x7b\xf3\xe6\xb6\x6d\x6e\x71\xf8\xab\x58\x9e\x0c\x07\xb8\x81\xfd\x48\xde\x12\x53\x46\x34\x69\xe2\x6e\xd9\xda\x24\x6d\xd3\xe6\x66\x3c\x34\x05\x59\x8c\x69\xd0\x25\x21\x2f\xb5\xf4\xdd\xdf\x39\xc0\xc1\x46\x52\x4e\xda\xfb\x3c\xf7\xbd\xf3\xfb\xc7\xa6\x40\x10\xcb\xc1\xc1\xc1\xc1\x59\x07\x5c\xe8\x70\xd4\x11\xc1\x40\xd4\x72\xbb\xd6\x86\x34\xb4\x0e\xe2\x06\x79\x57\x5d\x2a\xf8\x42\x37\x0e\xed\x5d\xd6\xe2\xaa\xa8\x16\x8d\xa5\x39\x76\xdb\x29\x3e\x1c\x0b\x43\xa5\x1e\x47\x67\x8f\x25\x9d\x62\x1b\x3c\xf9\xa1\x47\xb8\x73\xdf\x96\xcb\x25\xfc\xbf\xc6\xff\x37\xa5\xfe\x74\xc8\x03\x33\x26\x31\x56\x5b\x5b\x46\xbc\x07\x55\x4f\xca\x24\x51\x0f\x1f\xae\x7a\x46\xd1\xcf\xc2\x7e\x08\xb7\xd3\xc7\xcf\xa5\x16\x4f\xb9\xd6\x95\x1b\x3a\x8c\xcd\xd3\x2d\x4b\x6b\x15\x97\x1f\x5f\x95\x9f\x96\x4b\x09\x9f\x3a\x03\x5b\x67\xb6\x62\x6f\x10\x88\x01\x96\xa3\x03\x16\xdc\x3e\xa1\x7d\x08\xf4\x23\xb8\x06\xa1\x95\x4d\x21\xa4\x24\x17\xba\x71\x3b\xfc\x31\x56\xb3\xf3\x51\x2b\x01\x11\xae\xa2\xf1\xf5\xcf\xf5\x3b\x3d\x57\x7c\x25\x4c\xb3\x60\x13\xfd\xaa\xfc\xc4\x80\x84\xef\x6b\x12\xae\xb2\x33\xa3\x36\xc4\xc7\xd1\xae\x7f\x76\x8f\xce\x34\xcb\xb6\xfd\xb3\x3d\xd7\xf7\xad\x05\x9b\xb1\x88\x11\xa1\x45\x4c\x14\x28\x24\xe2\xe0\x76\x77\x43\x5a\xfd\x47\x34\xd0\x8f\xef\x60\x98\xf1\x54\x7e\xd4\x35\xa6\xd5\x5d\xd7\x7b\x32\x49\xdc\x3c\x1c\xe5\x13\xed\xef\x1f\xe0\xe6\xb7\x4b\xeb\xc7\x65\x08\x34\x7e\x07\x9d\xe2\xc5\xfc\x45\x85\x97\x3e\x9f\x40\x1b\x6f\x57\x10\x5f\x65\x2c\xd2\x96\xaa\xa1\x92\xcf\xca\x22\x3f\xdf\x4c\xc3\x5f\x56\x7e\x62\x0b\x9f\x57\x8b\xd3\x52\xc4\x15\x83\xb2\x76\xf5\x57\xa0\x66\x78\x5e\x5d\xcb\x6e\x49\x6f\xd5\x57\xd5\x55\x4f\x49\x6f\xd5\x5f\x2e\xdb\xbf\x7b\xab\x1d\x83\x7e\x61\x33\x25\x15\x1f\x54\xce\x90\x1e\xc2\x1e\x08\x24\xfd\xac\xe2\x03\x62\x29\x36\x12\x4b\xef\x13\xba\x5c\x5a\x9a\x8d\xbf\x22\xaa\xab\x0d\x4b\x06\x55\xc7\xdf\x57\xe0\xc1\x19\x5b\x3a\xc1\xd6\x08\xdd\x30\x1d\xd7\xd8\xbe\xc5\xec\xec\x8e\x98\x62\xee\xa5\x43\x8a\xc0\xf2\xd5\xd8\x8d\x82\x4f\x10\x51\x5c\xe3\x86\xdc\xb6\x06\x35\x26\x1c\xf3\xba\xd0\x46\x10\x77\x07\x98\x1d\xe9\x2d\x70\xac\xd8\xa7\x01\x35\x73\x5f\x39\x48\xc4\x64\x9f\xc5\x4e\x5c\xdf\x95\xb3\xc8\xbf\x51\x29\xdc\x69\x9a\x4f\xfd\xba\x51\x7a\xce\x07\x28\xfb\x49\x64\xdd\xf0\xf1\xd3\x58\x8d\x29\x66\x1b\x57\x60\x76\xf2\x23\x08\xf6\x35\xe5\x53\xce\x9a\x7f\x3c\x3c\x52\x5b\x5b\x63\x2a\x14\x01\x93\x5d\xb6\x69\xc5\x82\x9b\x56\xe2\x0b\xc4\x5d\x79\x0f\x14\x48\x63\xe0\x2a\xa3\x34\x11\xea\x06\x8c\xa5\x74\x50\x87\xa0\xe5\x5a\xad\x10\xcf\xa6\x1d\xe2\x29\x49\xd6\x2e\x4f\xbb\xea\xff\xf2\xa2\xd4\xca\x25\xdf\xfe\xc2\xc0\x92\x04\xa6\x1d\xaf\x01\xc8\xaa\x42\xe1\x7f\xa5\x5b\x33\x86\x6d\xa1\x4d\x7f\xa6\x4c\x08\xa4\x97\x2e\x19\xca\x4b\x43\x9e\x34\x18\xf9\xdb\x92\xd5\x6e\xc1\x58\xc5\xd1\x97\xad\x90\x1b\xef\xcb\xc9\xfb\x12\xdd\x2b\xdf\x97\xe1\x6d\x83\x81\xa8\x0a\x97\xcc\x87\x4c\xaa\x93\x44\x82\x75\x36\xe7\x10\xba\x4c\x07\x4e\x42\x8b\xda\x7a\x4b\xe0\x6a\x8f\xc6\xea\x88\x97\x50\xb1\xde\x52\xa6\x6a\xb6\xa5\x3e\xe9\x85\xb7\x5b\xec\x65\xc9\xad\xc0\x59\xb0\xd1\x91\x9a\x8c\xb6\xdc\x1d\xce\x4f\xab\xf4\xa1\x2a\x43\xb5\x53\x11\x14\x07\x7c\x5b\x83\x58\x03\xb2\x3a\x70\x71\x6e\x01\xdc\x26\xb9\x0a\x00\x6c\xe3\xcc\x04\x0a\x20\xed\x77\x6f\x93\x61\x39\x63\xb8\x1f\x80\xba\xc1\x55\xdc\x1d\xfb\x3a\x62\x9a\xa0\x1d\x3f\xf1\x0a\xd0\xcb\xba\x0f\x4d\xa0\x21\x10\x6a\x2b\x22\x69\xba\x69\x3a\x06\x8a\x56\xe9\x37\xa8\x23\xe5\x75\x6a\xeb\x49\xf8\xca\xc2\x08\x0a\xb7\x8b\xe6\xb9\x8d\x5b\xad\x05\xe9\x62\xca\x51\x16\x22\xb7\xa7\xad\x37\x93\x6e\x51\x3a\x00\x0e\xcd\x9a\x0b\x6b\xd7\x02\x3a\x29\x55\x5a\xe0\xcc\xfb\x8c\x0e\x39\xbe\xf4\x90\xcd\x03\xc8\x02\x17\x04\x03\xd3\xa3\x81\xf4\x89\x2d\x03\xaf\xd6\xcb\xc8\xd8\x4b\xbf\xd3\x76\x96\x81\x10\xd7\x1a\x6f\x6d\x80\xbd\x3e\xd4\x70\x7d\xf9\x01\x2c\x2c\x69\x6b\x27\xe6\x06\x77\xf7\x36\x55\x7f\x74\x08\x21\xd9\xb6\xa7\xc2\x2e\x1f\xa1\x60\xb8\xd6\x3b\xea\x24\x69\x8f\xb7\x63\xd0\xa6\xbb\x46\x1d\x22\x54\x81\x38\xfe\x02\x74\x0d\xf0\x43\x4c\x79\x0e\xf9\x59\xd0\x5a\x92\x2f\x82\x0b\xd9\x54\xf5\x5e\x5a\xce\xc5\xed\xe2\xd2\x8a\x68\xb6\x46\x03\xce\x9f\x7b\x21\xb7\x0e\x6a\xfe\x0c\x2e\xc0\x63\x5b\x79\xaa\x8f\x75\x84\xd1\xce\xce\xa1\x8d\x83\x0e\xb5\x5c\x25\x9d\x73\x1b\x4f\x64\x6d\x73\x30\xf5\xcc\x80\xb1\x42\xb1\xdb\xe8\xbe\xe0\x56\x97\x2a\xe6\x03\xb7\xa7\x42\x65\x45\xc9\x3a\x77\x13\x91\x24\x3a\xb4\x96\xbd\x9b\xc3\x73\x1a\x33\x52\xa7\xfd\xb3\x87\x4b\x39\x5e\xc4\x85\xf4\x31\x26\x2e\x95\x0d\x60\x15\xcc\x06\xdf\xed\xee\xe8\x29\x5f\xcf\x8b\x7c\x8e\x51\x7d\x7e\x87\xec\x4f\xec\xaf\x12\x3f\x01\xb2\xf5\x83\xe6\x25\x6c\x7b\x70\x83\x86\x41\x31\x90\x44\xfc\x55\x26\xc9\xef\x25\x72\xa6\xad\xf9\x6b\x96\x21\x00\xc1\x1c\x87\x0d\x66\xa9\x96\x98\xb6\x07\x6d\x58\x94\xc1\x0f\x65\x92\xe0\x1a\x83\xd5\x0a\xd0\x60\xf6\xb2\xe4\x6f\x4b\xfe\xbe\xc4\x30\x94\x90\x1c\x8b\x09\x93\x85\xa7\x2d\x3f\xbc\xcc\x1a\xe5\x4d\x31\x74\x8d\xd6\xfc\x81\xa7\x04\x8f\x50\x55\x97\x2f\xc4\x2d\xd8\x76\x67\xa5\xc2\xa7\x0b\xa1\xb2\x17\xe2\x96\x2e\x97\xae\x02\x18\x31\x9b\x0a\xe8\xc1\x9c\xcf\xb3\x3a\x49\x46\x47\x70\xc9\xc8\x6a\xbb\x4f\xb1\x43\x53\xa8\x2f\x35\x06\xb4\xf6\x05\x04\xd2\x91\x67\xdb\x90\x1f\xf2\xd9\x3c\xab\x01\xcd\x5c\x95\xf0\xfa\x30\xbe\x6f\x3d\x7f\x29\x93\x64\xf3\xbc\x02\x9f\x60\xa5\xc5\x1e\x25\x3a\x6d\x98\x65\xf9\xc2\x22\x5c\xa8\x7f\x2a\xca\x08\xa4\x18\x93\xc1\xe0\x9b\x12\x5c\xa2\x6e\x2f\xc5\xa7\x34\x16\x3b\xf8\xae\xce\x90\xb4\x05\x68\xdf\x28\xbf\xbb\xc9\x0b\xf0\x6d\x00\x51\xb5\x21\x58\x36\xdf\x00\xdf\x34\xa5\x9b\x6c\x0a\x8a\x5b\x7d\x2e\x87\xfe\xb9\x57\x50\x70\xa7\xcd\xa5\x7d\xe1\xad\xa5\x62\x2f\xc9\xcf\x91\x0f\x41\xf0\xe1\x89\xc7\x3f\xdb\x45\x68\xca\x1f\x8c\x1c\xd2\x79\xdd\x7d\x28\x93\x84\x7c\x00\x33\x2c\x95\xe5\x73\x7d\x82\x91\xcd\x4a\xda\xb8\x5f\x76\x90\xd7\x8a\xb2\xef\x4a\xfe\x01\x6d\x0d\x7c\x23\xd7\x76\x48\xc8\x12\x40\x5f\x51\xd4\xb0\x24\xb9\x55\xe4\xbb\x92\x82\x82\x44\xf0\x33\x78\x66\x82\x81\x5d\x27\xa5\xac\xc8\xa8\x9e\x22\x7a\x2a\x38\xcd\xe4\x29\xb9\x52\xa1\xa6\xa8\xf0\xa1\x08\x7d\xd7\xe7\x0e\xf2\x68\x7d\x05\x7d\x4f\x08\x4c\x8b\x7d\x28\xb9\x82\x01\x4b\xf6\xa1\x84\x28\x64\x5f\x98\x1b\x4d\x8d\x65\x24\xb4\x90\x24\x37\x91\xae\xfc\xd8\xcd\xd0\x5c\x30\x8a\x4a\xe2\x97\x78\xd3\x30\xf4\xd8\xff\xd0\x94\x33\x84\xb9\x01\x80\x6f\xf1\x4d\xb8\x44\xfa\x86\xd6\xaa\x1d\x72\x66\x3f\x05\x95\xa3\xeb\x4e\x30\x88\x35\x9f\x3e\x0b\x76\x41\x9b\x4d\x71\x27\x2a\xa0\xea\xab\x6a\x0a\xd1\x8a\x8d\x53\xe8\xa4\x5b\x44\x04\x48\x4c\x89\xe0\x0f\x40\xa1\x04\x59\x2e\x06\x60\x2d\xee\x7b\xfa\xec\x19\xaa\x8d\x67\x01\x8a\xbd\xc3\xc1\xe3\x2b\x81\xc2\xc1\x21\x5c\xa3\x97\xcb\xd1\xbf\x04\xe7\xa3\x7f\x41\x84\x41\x88\x2d\x01\x4e\x14\x83\x68\x6f\xbd\xf2\x73\x6f\xb4\xaf\x82\x45\xf8\xc1\x70\xdc\x9b\xb7\x68\xb9\xbc\x27\xa2\x9b\x72\x6f\x55\x6c\x1a\x25\x79\x9c\x50\x8e\xd5\x51\x01\x9a\x3e\x21\xf9\xd3\x96\xd3\x11\x25\xc4\xd8\xab\xc6\x23\xc3\xdd\x52\x40\x6f\x01\xf4\x37\x2f\x30\x66\x8e\xf1\xbd\x58\x2e\x07\x30\x95\x8f\xf0\xe3\x13\xe8\xfe\xe0\xbf\x9b\xd6\x68\xdc\x65\x56\xdf\x23\x10\xac\xf3\xb5\x91\x2f\xc3\x2c\x26\xca\xc9\x73\xd3\xc3\x58\x1e\xae\x52\xd5\xd2\xb8\xe0\x12\xcc\x0b\x0b\x85\x69\xb1\x5c\x4e\x8b\x01\xe7\xdf\x83\xe9\x08\x9e\x8b\x92\x4f\x0b\x86\x37\x69\xcd\xd0\x65\xb5\x82\x33\x5a\x26\xc9\xef\xa6\x1a\xbf\x6b\x54\x56\xab\x54\x6e\xc7\xb5\x98\x90\xd3\xb0\xf0\x58\x4e\x57\x29\x91\x9c\xc8\x78\x20\x49\xd2\x2a\xb0\x2c\xe7\xaf\x85\xb8\xb6\xbe\x17\x14\x50\xf0\x9d\x6d\x0a\xfc\x4e\xf9\x5d\x26\xf3\x79\x55\x83\x8c\x2c\x95\xdb\xfe\x07\x58\xdd\xcf\xab\xfa\x8d\x51\x5d\xd8\x37\xe6\x27\xd3\x74\x01\x3f\x71\xcf\xa6\xd4\x7d\x10\xfc\x5a\x51\x76\x5a\x24\xc9\x2b\x45\x4e\x0b\xe6\x60\x72\x5a\x68\x9e\x3e\xa2\xea\xb3\x02\x67\xca\x2e\x0b\x20\xec\x9e\xac\xa3\x14\xc2\x39\x44\x00\x40\x91\xba\x87\xd7\xba\xb7\xc1\xde\x14\x8e\x1b\xb3\xec\x02\x1e\x9b\x00\x79\x31\xb1\xaa\xac\x6d\x5b\x4c\x93\x64\x84\xe6\x86\x10\x56\x6d\x97\xa6\x82\x2b\x36\x1a\xa2\x31\x91\x2e\x62\xbb\x3b\x47\xb0\x0b\x74\x45\x88\x49\x18\x60\xd4\x4b\xdd\xf7\xf0\xc9\xeb\x02\xc4\x2b\xce\x69\xed\x65\xf1\xf1\x75\xf1\x89\x99\x7f\x9a\xce\xb3\xd7\xc5\xd6\x56\x30\xe8\xd7\x88\x8a\xaf\x8b\x87\x0f\x6d\x3d\xf7\xbd\x8f\x65\x1d\x6e\xe1\xa7\x11\xf6\x1a\x20\xd9\xc4\x84\x80\xa9\x4d\x2c\x16\x7b\x5a\x60\x66\xa8\x96\xe0\xac\x4e\x92\x7a\xfb\xe4\x44\x47\x76\xd7\x77\x29\x99\x95\xaf\xd0\x0d\xf5\x17\x79\x91\x35\xe7\x62\xaa\xfd\xa3\x31\x54\x5e\x28\xc8\x5d\xfb\xe1\xab\xce\x67\xba\xf3\x8a\x65\x36\xfe\x05\x86\xb5\xce\xe0\x6a\xa5\x3e\x56\xce\x9f\xb8\x46\x17\x20\x37\x46\x26\xd6\x75\xd2\x3b\x3a\xc5\xc4\xd7\x0f\x8a\x67\x94\x05\xc1\x4b\x9f\x87\x3c\x36\x0a\x74\x11\x3d\xfc\x37\x1a\xb4\x61\xc4\xb1\x80\x43\xf8\x01\x88\xf5\x4b\x45\x7e\x28\x28\x7b\xa9\xc8\xf3\x22\x58\xe1\xef\xdd\x99\x5a\xcc\xc8\xf3\xc2\xae\xe8\x80\xf3\xa7\x1d\x6f\xca\x03\x70\x82\x7c\x0d\x0d\x00\xfe\xbf\x86\x06\x23\xfd\xec\xb7\xae\x2d\x8c\x3b\x1d\x2f\xa9\xe0\xaa\x3b\xe4\x20\x29\xa9\xa3\xdb\x15\xec\xbb\x10\x20\x76\x61\xe5\xb8\xe2\x9d\x97\xc4\x5b\x09\xea\x88\xe0\x15\x30\x1e\x03\xa2\x9f\xbb\x79\xb6\x86\x8f\xd9\x33\xa2\x68\x10\x3d\x8f\x65\x5e\x8e\x67\x82\x6a\x48\x06\x99\xf4\x82\x89\xfd\x12\xdf\x72\x62\x4c\xa0\x49\xb2\x7e\x6d\x45\x7d\x16\xaf\xed\x72\xf9\xb4\x60\xdf\x17\xdc\x83\x9a\x19\x88\x0a\x0b\xd1\x1f\xdc\x1b\x30\xc8\xf6\x83\xf8\xeb\x7e\xe8\x0e\xaa\xf6\x54\x0f\xe0\x56\x2b\xe1\xa2\x81\x0b\xf3\x7d\x41\x59\xf5\xf5\x43\xe5\x82\x45\x48\xe3\x06\x4a\x53\x2c\xef\xa2\xc0\x9f\x8a\xb8\x0b\xdc\x6f\x05\xa1\x68\x44\xf7\x6b\x61\xf9\xf6\xc3\xc3\xb1\x2e\xf9\xc6\x97\x3c\x36\x25\x2f\x7c\xc9\x23\x53\xf2\xc1\x97\x1c\x98\x92\xef\x7c\xc9\xbe\xbb\x11\xc4\xd3\xde\xdd\xdd\x89\xcc\x37\x7e\x57\xdd\xe8\x15\x1b\x87\x87\xb6\x9d\x5f\x0b\xd3\xf2\xe1\x63\x5b\xf2\x8d\x2d\x79\x64\x4b\x5e\xd8\x12\x17\x29\xf9\x83\x2d\x71\x21\x95\xbf\x2b\xbe\x72\x3c\xbf\x21\x7d\xc4\xef\x04\xd7\x03\x64\xdf\x1a\x76\xc7\xd7\xfb\xd5\x2d\x77\xab\xe6\x2f\xd6\x89\xd3\xd7\xfd\xa6\x4d\x21\x38\xe7\x0f\x8a\x09\x79\x50\x40\xec\x73\x26\x1a\xfe\x4b\x41\x7e\x2d\xd8\x07\x45\x69\xfa\xa0\xb0\x22\x0b\xf6\x73\xe1\xdb\x78\x81\x02\x41\xab\x31\xc2\xec\x10\x82\x8b\x66\x6c\x3d\x40\xff\x2a\xe0\x76\xf2\x21\xe2\x96\x3f\xe0\x77\x03\xd5\x38\x4d\xd4\x83\x82\xde\xa9\x86\x63\x20\x3c\x01\xd2\x5c\x54\x79\x2b\xfe\xa0\x18\xff\xa6\xc8\xe1\x61\x68\x5a\x0f\xfb\xb7\x1d\x5d\x1d\x0f\x10\x60\x3b\xc1\x67\x4e\x72\x49\x06\x43\xe7\x61\x66\xfd\x00\x20\x22\xe1\x03\xe3\xd0\xe5\x0d\x33\x0c\x42\xb8\xb1\x80\x3d\x75\xc1\x1f\x14\x56\x7a\xf8\x70\x44\x29\x33\x00\x79\x01\x42\x6a\x77\xe9\x80\x21\x8f\xc2\x0b\xc7\x77\xed\x35\x90\xff\xe2\x23\xf0\x69\x7c\xb4\xfb\x68\x6f\xf4\x78\x67\xb4\x45\x46\x0f\x09\x09\x7e\x8b\x87\xea\x5f\xa3\x21\xfd\x97\x5c\x0e\x29\xfd\xaf\x40\x2d\xf0\x33\x2e\x7b\x6f\x96\xf7\x3b\xc5\x31\x9c\x0f\xa8\x2d\xe2\x97\x8e\xb8\x49\x43\xd0\x9c\xb1\x0b\x98\x91\x81\x14\x1b\x8c\xc2\xc0\x32\xac\x4f\xc3\xff\x87\x5e\x9c\x86\x97\x0d\xcf\x9a\x96\x23\xc1\x8f\x01\x43\x52\x35\x96\xe6\x8c\x5f\x2a\x52\x35\x96\xbb\xd9\xb6\xf9\xd1\xb6\xa3\xe4\x6b\xe1\x79\xff\x00\x27\x06\xc3\x0c\xd5\x8d\xf6\xfc\x77\x0a\x4c\x20\x52\x78\x66\x1d\xdf\x5c\x16\x46\xbe\xfe\xbe\xb8\x10\x47\x8a\xf6\x96\x43\xf2\x09\x5c\x67\xe0\x21\x7b\xbf\xd4\x92\xf9\xde\x6f\xa9\x73\x86\x1f\x90\x2f\x36\x43\xd1\x8c\x62\x4d\x5b\xab\x40\x35\xe8\x67\x2e\x30\xc9\x56\xd6\x70\xc1\x0c\x90\x5d\x46\x4a\x7b\x44\x87\xb6\xcd\xd4\xed\x0f\x6b\x03\x64\x60\x0b\xac\xc5\xb6\x88\xba\x7c\x02\xf6\x3a\xe4\x29\x6c\x21\xca\xe2\xda\xed\xfb\xb8\xc2\x51\x14\x33\x52\x34\xd0\x76\x92\x0c\x40\x44\xa8\x92\x04\x6e\x5d\xda\x4e\xb1\x6b\x46\x90\x24\x0e\x69\x41\x11\x0b\xfe\xc5\x05\x4c\x43\x71\x5f\x0e\xfa\x9b\x3b\x5c\x7f\x9d\x62\xa9\x11\xf5\x95\xcb\xce\x07\x8a\x7e\xcc\xad\x87\x14\xa7\x6c\x1c\x01\x01\xa3\xd3\x76\x5e\x91\xdd\x21\xb0\x0f\x65\xc3\x15\xcb\x9a\x08\x30\xfc\x2e\x9e\x7d\x3a\x64\xe1\x8c\x53\xc5\xd0\xed\x5a\xd4\x26\x95\xf9\x0a\xd3\x79\x00\xcc\xb5\x45\x12\x77\xd7\x1d\x11\x63\xaa\x87\x93\xd4\x61\x4d\x05\xa6\x96\xfd\x79\x21\x16\x82\xdf\x9d\x66\x98\x7f\x2c\x6d\x05\x39\x61\xf0\x46\x57\xd2\x1d\x42\x02\xa9\x5a\x4c\xd3\x3b\x58\xcd\x42\x9e\xd9\xa4\x82\x3a\x74\x8b\x1d\x93\xeb\xaa\xc6\x25\x11\x3c\xea\x8e\xa9\xf0\x17\xf2\xee\x6a\xfd\x80\xdc\x73\x30\x18\xb1\xed\x9e\xed\x98\xc4\xb6\x79\x70\xa3\x11\x18\x52\xa6\x09\xbd\x1c\x5b\x19\xe1\x44\x07\xe6\x82\xd9\x24\xb1\x98\x34\x16\x7c\x9d\xcf\xd2\x21\xbb\xcc\x6e\xcb\x2a\x9b\xea\x49\xb2\x1c\x3d\x40\xcc\xaf\x00\x07\x4c\xba\xf8\x30\xc7\x46\x86\x3d\xfa\x33\x85\xb4\x00\x42\xd1\xba\x56\x0f\x1f\x2f\xe6\x62\x1b\x41\x3c\x46\x3c\x92\x13\x85\x4b\x9c\x12\x7c\x32\x56\x68\x4c\x62\x39\x65\xee\x23\xae\x82\x65\x28\x71\x00\x5d\x4a\x84\xe3\x91\x49\x52\x4b\x1d\xc0\x81\xb5\x97\x4a\xf2\x10\xd2\x6e\x28\x24\x28\xe5\x76\x5c\xcc\x3e\xd0\xb5\x23\xf4\x83\x6a\x64\x3b\xc5\x40\xd4\xf1\xb8\x81\x33\x08\x5d\x9c\xab\x60\x08\x5a\xfb\xa5\x01\xe5\x00\xe4\x01\x1b\x45\xa1\x71\x19\xea\x32\x3d\x88\xb1\xf9\xc7\x4b\xfd\x8f\x99\x7f\xbc\x58\x65\xbc\x64\xed\x26\x35\x6d\x61\x45\x08\x2b\x4b\xcd\x0a\x47\xbc\x48\xc1\x8b\x70\xc8\xa0\xbe\x2a\x02\xb0\x94\x81\xf5\x2d\xa4\xcb\x73\x03\x81\x49\x35\x38\x29\x83\xd7\x39\x1f\xb2\x05\xa6\x5a\xc1\xf8\xbd\xdc\x29\xe4\xb1\x5f\x17\x00\xe3\x92\x17\x18\x29\x85\x94\xfc\xb2\x45\x30\xe9\x11\xc2\xf3\xb4\x83\xd8\xed\xaa\x6d\x3c\xbf\xdc\x8e\x0b\x34\xda\x5f\x6a\xc7\x77\x8b\xfa\x97\xdb\xf8\xe4\xf1\xff\x72\xdb\x3e\x06\xbb\xc0\xe2\xec\x74\x42\x66\x7c\xca\x4f\xd9\x82\x37\x34\x9d\xf2\xe9\x36\xd4\xe1\xa7\xac\x7c\x62\x9c\xca\x4b\x0c\x62\x88\x93\x9c\x26\x09\x71\xb5\xda\xe3\xf7\x04\xf9\x3f\x38\x72\xca\xfe\xa8\x49\xc9\xda\x2d\xd2\xb1\x48\x35\x58\xe7\x5c\xb0\x0b\x7e\x69\x35\x12\x20\x6c\x3d\xe5\x92\x5d\x04\x91\xd8\x47\x69\x6f\x32\x00\x32\xe7\x17\x76\x10\xe0\xfd\xce\xe7\x1a\x72\xe4\x94\x35\xcc\xfa\x9f\x6f\x88\x55\xc3\xe7\xce\x58\x03\x03\xbb\xcf\x7d\x68\x2c\xbe\xb5\x37\x3c\x7c\x94\x04\x25\xcb\x83\x3d\xc3\x82\x0f\xd3\x22\xea\xc3\x6e\x56\x52\xf6\xc5\xbc\x98\x4f\xa2\xee\xd3\x39\x0d\x12\xb1\x94\xd4\x8e\xa0\x41\x7e\x2c\x1c\xa2\xe9\x6e\x27\x85\x1d\x0a\xd9\x8f\xcc\xd2\x79\x68\x9a\x63\xdc\x8d\x8f\xef\xee\xe8\x4d\x8b\xb4\xd8\x0e\xab\x9c\xb8\x22\xfe\xf1\xf2\x53\x8a\xfa\xc3\x4b\x6f\xb3\x0e\xc2\x9f\x4b\x7e\xa9\x51\x86\x2e\x97\x97\x1c\x36\x41\x70\xb0\x92\x2e\x29\xb0\xec\xcb\x25\xef\xdf\xec\x2c\xa0\x24\x7c\xed\xc6\x5f\x61\x1c\x45\x3e\x9d\x2c\x78\x93\x22\x3a\xce\xf0\x63\xbd\x63\xf9\x22\x6a\x6a\xca\x7e\xac\x49\x8e\xe9\xfc\x02\x9c\xe5\x39\x6b\x9d\xa7\xbc\x09\x08\x73\x8e\x34\x10\x23\x91\xda\x10\x68\x0d\x73\x4f\x11\x23\x25\xa8\xb7\x09\x71\x1e\x6b\xca\x5e\x11\xc0\x53\x4d\x81\x9f\x5a\xe5\x56\x22\x20\x1f\xd6\xf5\xc5\xbe\xb2\x61\x42\x21\x58\xbf\xec\x95\x3d\xb4\x6f\xd1\x87\x23\x70\x61\x1e\x63\xb4\xf4\x2c\x52\x48\x2c\x02\x62\xde\x89\x93\xc6\xe0\xca\xa2\x5d\xd6\x6c\xd4\x6b\x2e\x03\x64\x93\x13\x95\xda\x18\x9e\x92\x76\xe0\x25\xd9\x10\x38\x84\x16\x60\x35\x8e\x05\xb4\x37\x58\x99\xf0\x3e\x38\x73\xe3\x82\x4c\x3d\xac\x0c\x8e\xfd\x40\x6a\xc0\x7a\xf6\x07\x9c\xc6\xd5\xa2\x9c\xba\x6c\x87\x26\x43\xff\x64\x4d\x39\xa9\x75\xf3\xe9\x20\xc8\x7b\xbf\x5c\x86\xbf\x7a\xb3\xe8\x83\x9d\xf7\x2b\x45\x24\xab\x21\x8b\xd4\x2b\x45\x20\x9f\x50\x30\xfe\x29\x8e\xdf\x3a\xd3\x0c\x46\xac\xe2\x4f\x0b\x96\x59\x2b\x67\x23\x3c\x42\x9e\xcf\xca\xfd\xdd\x24\x32\x77\x56\x65\x93\x8c\x2b\x49\x32\x0a\x66\x66\xcf\x41\x63\x3c\xf9\xbe\x48\x03\xd9\x4b\x1d\xb7\xd8\xb0\x8c\x13\x1b\x7b\x9c\xd7\x81\x38\xad\xa6\x13\x2d\xd9\xac\x68\xfa\xb4\x00\xce\x58\xdb\x1e\x10\xc9\xb2\x9e\xb5\xb3\x96\x9a\x1a\xd4\x41\x23\x58\x32\xc1\xff\x9a\x75\x72\x5c\x61\xcd\x67\x0d\x0b\x96\x47\x33\x16\xb1\xac\xe6\xdb\xe2\x14\x92\x53\xb3\xff\xa9\x34\xb2\xfa\xbb\xd2\xc8\xe0\xbe\x77\xe9\x70\x0b\xb8\x37\x9c\x49\x1f\x2a\x01\x60\x71\xbd\x7f\x2b\xca\xf2\xad\xc8\x45\x71\xa5\x33\x06\x34\x49\x72\xcf\x4b\x8d\x16\xfd\x0d\xfe\xf2\xfa\xdd\xd3\x6f\x8f\x4f\xee\x6d\xf7\x4b\x75\x4c\xf3\x38\x6e\xa0\x2e\x49\x32\x6b\xb6\x85\xfc\x13\x28\xda\x5b\x93\xa5\x43\xef\x5f\xa2\x6c\x2d\x0c\x4a\x62\x47\xb4\x71\xda\xc3\xc3\xb9\xa5\x18\x9b\x1c\x4a\x0d\x97\x0c\x9d\xe9\x3a\x84\x01\xb2\x11\xcf\x1a\xbe\x68\x98\xbe\x94\x20\xb7\x17\xa3\xf6\xbd\x48\x5d\xd9\xaa\x16\xb9\xb3\x5e\xe4\xf6\xd5\x34\xea\x66\x94\x32\xcd\x7e\x9a\x9c\x52\x6b\x87\x07\xbb\xec\x4c\xa8\xe7\xa2\x2e\xae\xb0\xec\xdb\xba\xba\xd0\x10\xee\x5b\x97\x2c\x49\x08\x52\xc2\x8c\xc9\xb5\xed\xae\x59\xd3\x75\x3d\x2d\x97\x3d\xd5\xb5\xf0\xf7\x9d\xcc\x2e\x9b\x79\xa5\xbe\x11\xb3\xaa\x16\x86\x40\x2d\x97\x7d\xb4\xbc\x17\x61\x5e\x55\x0b\x50\x4a\xf5\xd6\xef\x56\xd4\x81\xb7\x70\x46\x7d\x13\xe8\xfb\x26\x49\xfa\x4a\x49\x2f\x00\xee\x1d\xe3\x3d\x2f\x09\x65\x10\xd8\x12\x47\xb6\x16\x87\x2b\xbb\x1a\x06\x87\xbf\x66\xfd\xd7\x0c\xd3\x0d\xe1\x79\x31\xc5\xe1\xc5\xfc\xce\x5e\xb0\x43\xe6\xb8\x43\xc2\x7b\x09\xc4\x41\x04\xc4\xa7\xbd\xb0\xef\xf3\xd0\xc3\x38\x17\xdb\x27\x5a\x7d\x88\x16\xec\xd1\xcf\xd1\x9a\x78\x8d\xbb\x43\x90\x7d\xdb\xec\x29\x6e\x6f\xae\x7a\xe5\xe4\x7b\x26\xe1\x29\xd4\xce\x20\xa2\xb3\x18\xc7\xba\x16\x1f\x47\x06\xa2\x1e\xce\x92\xa4\x07\x3e\xf8\x46\xff\x83\x9c\x5a\x60\x10\x03\x39\xd2\xe1\x08\xd2\x85\x90\x30\xcd\x7e\x16\xc8\xdd\xe0\x55\xa3\x23\x35\x63\x46\x2f\xa4\x0d\x77\x2b\xe7\xdb\x2f\x26\x98\xd4\x44\x7d\xcc\x3e\xa5\xf0\x87\x0b\xc8\x95\x1e\xf4\x92\x41\x4e\xf7\x20\x9f\x56\x00\xc1\x78\xae\x3b\x8f\xf7\xd0\x0d\xd3\x81\xb1\x55\xe1\x50\xe7\x27\xb5\xa2\xc4\xe0\x3a\x7f\xe1\xaf\xf3\xde\x32\x66\xe0\xdc\x74\xe2\x66\x76\x47\x6c\xf3\xa3\x59\x4e\x4c\x54\xf3\x09\xec\x16\x50\xc9\xee\x99\x03\x55\xa1\xed\x90\x66\xae\x14\x9d\x20\x0e\x6c\x5c\x17\x6a\xbe\x01\xca\xf8\x8d\xbb\xcd\x87\xb1\x6e\x7e\xfb\x73\x55\x48\xb2\xc9\x36\x20\x8d\xd2\x6a\x33\x85\x84\x76\x21\xf3\x70\x26\xdb\x11\x27\x2c\xa7\x69\xd9\x09\xa5\xa3\x2b\x9b\x04\xfa\x56\x16\x50\x4f\x48\xad\x19\x5e\x4c\xc0\x2f\x59\x58\x8b\xeb\x1c\x14\x5a\x28\x85\x05\xf1\x5b\xbc\xe9\xdb\x9f\x70\xac\x4b\xbf\x37\xf8\xe3\x80\x67\x94\xc4\x1a\x45\x0e\x44\x27\x22\xa8\x1b\xcd\x98\x2a\x5d\x8f\x41\x14\x37\x8c\xaf\x19\xa2\xa5\x6f\xaf\xf2\xe2\x57\x08\x34\xaa\x03\x18\xdb\x66\xc0\x18\xdb\x62\xee\xb9\xb8\x05\x2e\x4e\x28\xa2\x9f\x99\xcd\x0c\x48\x94\x31\x25\x04\x46\x35\x08\xe6\x39\xee\x62\x40\xd6\x92\x20\xdd\x9a\xae\x19\x3a\xc4\xf2\x21\x70\x2f\x66\xa8\x18\x10\xd0\x7f\xab\xcd\x21\x58\xed\xbe\xc6\x4e\x21\xe0\x12\xe6\x4f\xe1\xa4\x0e\xdd\x5d\xe8\x84\xc0\xd4\x75\x2d\x56\x1f\xc9\x09\xb1\x97\x83\xf7\xd9\x19\xdf\x81\x18\x9c\x35\x4d\xbb\x85\x81\xc8\xbd\x08\x62\x8d\x60\x8e\x0c\xce\xc3\x3e\x92\x24\xfe\x3e\xe2\x73\xbc\x51\xa9\x6d\xc3\x36\xb0\x5c\x82\x4b\x8b\x09\xbf\x4c\x14\xbf\xae\x40\x84\xb4\x7d\x01\x1c\x75\x4d\x99\x0b\xa7\x0a\xb0\x81\x5d\x9f\xc1\xd4\x5b\xe5\xbe\x9b\xbc\xb7\x1b\x68\x1f\x98\xa4\x20\xf1\xbd\x4b\x8b\x0a\x80\xd1\x6d\x1a\x3e\x83\x32\x08\xf3\x37\xe3\x8e\xe4\x32\x1b\xf6\x0f\x18\x45\x9a\x92\x9a\x9f\x54\x44\x62\x2a\x15\xbd\xf4\xf8\x25\x1a\x8b\xbb\x91\xdf\xdb\x8e\x1f\xf1\xe2\x1e\xc0\xec\x59\xc0\x80\x91\xe1\x9a\x48\x02\x9a\x64\x47\x25\x71\xe5\xe2\xe2\xd2\xcc\x5a\xdf\x7a\x74\xed\xb8\x68\x42\x14\x3f\xff\x1a\xa0\x07\xbe\x72\x1f\x3f\xad\x5d\x81\x59\x37\xfc\x5b\x30\xa3\x47\xe1\x52\xdf\x84\xbd\xb2\xaa\xd5\xe4\x97\x17\x7b\x8a\xa0\xbd\xeb\xcb\x7d\x08\x9e\x8d\x1d\x39\xbd\xa5\x10\x1a\xcd\x20\x5e\x81\xed\xbe\xd5\x49\x68\x1d\xe5\xbf\xf6\x07\x97\xd3\x80\xaa\x76\x56\xc2\x5b\x97\xc7\x4f\x02\xa2\x60\x0e\x1d\xa4\x11\x3d\x88\x02\x41\x67\x3c\xa2\x60\x6c\x0c\x66\xed\xc5\xb9\x60\xb2\x95\x97\x71\x43\xaf\xd6\xba\x81\xc3\x91\x3c\x05\xb2\xbe\x5c\xbe\x01\x3f\x5b\xf7\xcd\x4d\xf8\x0d\x72\x2f\xd1\x8c\xf1\x40\x5a\xf5\x52\xc5\x4b\x8f\xa4\xc8\x05\x20\x20\x26\x7a\x66\xa9\x95\x32\x76\x56\xa1\xcf\xe9\x52\xd2\x78\x6b\x56\x9a\x64\xa5\x86\x42\x98\xe4\xa4\xb4\x77\x01\x02\xdf\x3c\xb7\x00\xf2\x9e\x05\x80\xa1\x69\x5b\x7f\xb3\x5d\xc1\x4f\x31\x9b\x20\x7e\x9a\xa5\x70\x28\xad\xf1\x2f\xf5\xd4\x23\x75\x06\xb6\x01\xe4\x7d\x83\x7e\xd3\xea\x8a\x08\x75\xa9\xa1\x2e\x69\xff\xfc\xfc\xc6\x80\x9f\xd4\x00\x5c\xae\x01\xf8\xa9\x05\x38\xab\xfa\xd1\xbb\xee\x41\x6f\x9b\xdf\x58\xcb\x25\x20\x16\x8f\xb4\xd1\xe3\x1a\x58\x7c\xc8\x54\x0b\xd3\xec\x85\x6d\xed\x60\x5b\x3b\xd8\xd6\xeb\x61\xeb\x7a\x30\x3b\xbb\x06\xc8\x4c\x64\xaa\xff\xdb\x4e\xeb\x08\xea\x30\x80\xba\x0d\xf5\x8a\x99\x2f\xd2\x5c\x0f\x50\x8f\xae\x05\xf4\xaf\xe9\x69\xe1\xbe\xc6\x95\xa8\xf5\x4a\xd4\x74\x2d\x40\x70\x3c\xac\xf2\x8b\xa1\x80\x2c\xf7\x2e\xc6\x5c\xcb\x65\x0a\x16\x38\x6b\xe5\xe6\x48\x76\xd2\xf6\x8c\xcd\x79\xc6\x87\xec\x42\x17\x58\x86\x61\x96\x24\xf3\xa3\xc2\xca\xd8\xe6\x0f\x1f\xd2\xbb\x99\x39\xa9\x9f\xcc\x27\xe4\x82\xcf\x50\x4e\x4f\xd3\x0b\x3e\x73\xac\x02\xec\xb0\x33\x7e\x49\x6a\x36\x63\xc5\xc7\xf9\x27\x48\xba\x8f\x7c\x3f\xe7\xfc\xcc\x65\x30\x9f\x25\x09\x99\xf1\x0b\x94\xa8\xae\x44\x92\xcc\xdc\xb1\x7c\x16\x1e\xcb\x10\xde\x69\x06\x09\xf8\x4b\x72\xc6\x32\x36\x77\xbc\xf0\x62\x92\xf3\xb3\x74\xe1\xd8\x8c\x33\xb6\xe0\x67\x6c\xc6\x2f\x00\x8c\x73\x90\x94\xe2\xd8\x2d\x1c\x41\x02\x37\xa3\x2c\x0f\xc6\x33\x43\x5d\x75\x6b\xa2\x08\x01\x32\xe3\x53\x52\xdb\x69\x80\x6e\x03\x46\x31\xeb\x8c\x62\x16\x8c\x62\xc6\x16\x7c\xe6\x6c\x88\x72\x1d\x66\x6c\xc6\x2b\xdd\xf5\xba\x6e\x2e\xf8\x29\x99\xb1\x9a\xcd\xc3\xae\x7c\x26\xaf\x8b\x10\x1c\x33\x1b\x23\x0f\xfb\xbf\x00\x54\x9a\xcc\x53\xfd\xdf\x80\xe9\xa2\x33\xc0\x8b\x60\x80\x17\x6c\xc1\x2f\xdc\x00\x01\xee\x2e\x72\x99\xc5\x99\xc0\x80\x04\xa0\xaf\x53\x52\xe5\x1e\xa3\x2e\x48\xc6\x0a\xd6\xb0\xdc\xd0\xd3\x05\x7f\x43\xcc\x22\xf7\x5c\xe5\x16\xed\x0b\xd6\x3e\xc6\x7e\x31\xa3\x23\x0d\x5f\x18\x61\x6a\xd3\x4d\xdc\xb0\x0f\xc1\xc4\x2c\xce\xce\x38\xa2\xeb\x9c\x17\xec\x82\x17\x7c\xc8\x90\xb3\xbc\xe2\x8d\x66\xb8\x89\x8b\xbe\x07\x11\xd1\xaf\xb6\xa7\x95\x14\xe3\x8b\x87\x0f\x83\x0a\xf4\x6e\x8e\x18\x7c\x31\x21\x67\x7c\xce\xe6\x88\xc1\x67\x7c\x6e\x01\xa4\x25\x30\xb7\xfc\x92\x64\x6c\xce\xae\x8c\x73\x1b\xcb\x43\x24\xbe\x75\x48\x3c\x4f\x12\x32\xe7\x67\x01\x12\xcf\x1d\x12\xdf\xc6\x48\xac\x97\xa4\xe0\x25\xb9\x65\x05\xbb\x70\xab\x33\x9b\x2c\xf8\x6d\xea\xb6\x0f\xbf\x65\x33\x7e\xcb\xe6\xfc\x0c\x90\xd8\xcc\xc1\xa3\xaf\x6e\x63\x11\x8c\x64\x8e\xe8\xbb\x66\xb6\x08\x0e\x72\xc5\xa7\x24\x0b\xe6\x02\xe8\x05\x43\xb9\xea\x0c\xe5\x2a\x18\xca\x15\x9b\xf1\x2b\x87\x28\x0b\x8d\xc9\x73\x5e\x01\x5c\xe8\x97\x7b\x3c\x25\x73\x96\xb1\x8b\x56\xaf\x1e\xa9\xaf\x42\xf0\xcc\x5b\x48\x7d\x05\xc8\x3c\xb9\x48\xf5\x7f\xca\xfe\xee\x58\x61\x1d\xee\x47\xea\xcc\x20\xf5\xc2\x92\x4c\x5f\x09\xa2\x39\x33\xb4\x77\xca\x79\xe7\xa4\x29\xdd\x49\x53\x26\x49\xe9\xcf\x08\xb7\xec\x25\x0c\x59\x87\x8b\x86\x44\x1c\xf1\x89\x61\xe4\x0a\x8b\x7b\x5b\x85\xd5\x5d\xd8\xac\xfe\x65\xcf\x19\x86\x61\x59\x16\xa6\x27\x96\xf3\xca\xa2\x7d\x6e\x34\xa2\x39\x1e\xf4\x0b\x77\x14\xe6\x81\x66\xee\x11\xa8\xc8\x82\x81\xd3\x3b\x38\xc9\x73\x0b\x49\x08\x4c\x95\x91\x9c\x75\x86\xce\x6c\xa6\x10\x2e\x98\xe0\xce\x99\x7a\x15\xc7\xb5\xd3\xdd\xc7\x17\x15\xd3\xd7\xfd\xdd\x80\x9c\xd0\xb1\x91\x39\x2b\xd7\xf6\xb6\xd2\xad\xd8\xfd\x06\x82\xcc\x9c\xb2\x9c\xbb\x76\x57\xc1\xcc\x26\xa4\xe2\x37\x15\x69\xcf\xc4\xf2\x91\x0d\xd3\x00\x6c\x77\x45\x53\xd2\xf0\x93\x0a\x41\x64\xea\xb0\xb2\x87\xf7\x6d\x28\x6b\xfc\xa0\x2b\x18\x74\x13\x0e\xba\x71\xe7\x71\x41\x84\x67\x0c\x70\xf9\x72\xb3\x7c\x76\xe9\xaa\x31\xea\xa6\xcc\xd2\xe5\xfa\xd7\x9e\x8d\xc4\x06\x32\xc4\x35\x17\x28\x8d\x71\x51\x49\x5c\x39\xbe\x2d\xe9\xda\x71\x91\x59\x98\x2a\x5e\x98\x8a\x95\xad\xfb\xd2\x9a\xf5\xd0\xdf\x86\xcb\x01\x19\xfb\xb8\x6b\x6d\x55\x01\xbb\x1f\x82\x2c\x6e\x27\x84\x50\x28\xb3\xf2\x1b\xa3\x87\x51\xb4\x7e\x75\x1b\x25\x08\xe8\x9c\x66\xb0\x4a\x92\x03\x0b\xb0\x09\xe9\x9f\x54\xbb\x7f\x9a\x9a\x8a\x30\xe8\xeb\xfb\x06\x4a\x19\x0c\x11\xf6\xe6\xb4\x21\xa5\xe3\xcc\xe6\x8e\x5e\xc0\xab\x37\xc1\x9b\x8b\xe8\xcd\x22\x49\x34\xc3\x5c\x52\xe6\x15\xcc\x49\x32\xc8\xed\x46\x17\x91\xee\x1c\x75\xd9\x36\xab\x05\x46\x40\xf0\xe7\xe2\x0e\x5b\x93\x4b\x7f\xd3\x69\xd5\x36\xbd\xdc\x0e\x3a\xae\x42\xab\xd3\x2b\x89\x3e\x4d\xb0\x3d\xe7\x6d\xd3\xac\xd1\xa3\x3d\x4a\x7b\x24\x3e\xb7\x28\xf3\xc3\x11\xbf\x56\xe4\xaa\x41\xd3\x6f\x08\x89\xab\x2d\x96\x2f\x1a\x36\x07\x7b\xc1\xc0\x19\x05\x27\x75\x88\x59\xd8\x47\xa9\x82\x08\xdc\xde\x77\x05\x23\x21\x51\xb0\xec\x81\x90\x02\x97\x59\x2e\x7e\x79\xfb\x43\xfa\x41\x9f\xb6\x6c\x73\x93\x8e\x63\x12\x23\xb8\x8e\xf1\x32\x09\xe3\x3d\x41\x2e\x06\x2e\xa2\x06\x90\x55\xd6\xd0\xcb\xce\xc0\xe3\x8f\x29\xfe\x01\x18\x67\xba\x7a\xa9\xc8\x45\x63\x07\x1c\xde\xc9\x4f\xc0\x00\x15\xdf\xbe\x84\x79\x69\x73\xe7\xab\x26\xa8\x72\xa3\x81\x77\x25\xc9\x95\x33\x93\xa4\x18\x62\xf9\x4a\x92\x0b\x5f\x08\x79\x10\xa0\x3b\xcc\x15\xaf\x30\x42\x62\x0b\x5c\x51\xfe\xd0\x6b\xdd\xf6\x99\x6b\x03\xcd\xc2\xa2\x01\x05\xd5\xcf\xa5\x8d\xba\x83\x9e\x24\x96\x9a\xa8\xb1\xcf\xfa\xa5\x7c\xd6\x2f\xf0\x1f\x8a\xa4\xfb\x96\x93\xb0\xc1\x1b\xd1\xa6\x40\x72\xf0\x04\xc7\xb0\x90\x53\xb8\xa6\x68\xaf\x4b\x20\xaa\x65\xf0\xe3\xda\x63\xbb\x72\x81\xb0\x47\x87\xb6\xcf\x48\xd5\x69\xbb\xd5\x7a\xa3\x6d\x70\x2f\xcf\xca\x37\xf5\x14\x25\xf7\x90\x00\xed\x60\x2f\x4a\x7f\xd6\x6d\xd9\xce\x0d\x03\xdb\xdc\xe1\x43\x5f\xe6\x23\x97\x69\xab\x2f\xfb\x11\xce\xd2\x0b\x37\x43\x7b\x0a\x2b\x54\xf8\x77\x66\x42\x0a\x3e\xf6\x6b\x77\x8c\xbb\x09\x43\xb0\x39\x9b\xc6\x54\x30\x7d\x5c\xa5\xa1\xf1\xda\x1b\x40\xcb\x78\x97\xee\xee\x8c\x42\xcc\xf9\x09\x9b\x0b\x66\x82\x83\x46\xff\x34\x1f\xe7\xd7\x1a\x56\x27\x49\x2b\x63\x2b\x48\xa1\x8d\x77\xda\x27\x06\xf6\xc4\x76\x75\x7b\xfd\xd2\x9e\xa1\x64\xd0\xda\x14\x14\x33\x72\xdd\xf0\x92\x9d\x83\x49\x67\x0b\xcd\x78\xa4\xda\xd6\xb6\x0a\xb6\xa8\x65\x2a\x32\x64\x27\x1e\xf9\x71\x22\x81\x5b\x5f\xdc\xec\xe4\x73\x93\xbe\x6b\x98\xe0\x52\x9b\x0c\x74\x5b\xe3\xfc\xba\xa1\x77\x25\x1f\x82\xcd\x38\xa0\x42\xb7\xbb\x01\xd9\xd9\x7f\x52\xb6\x6f\x1e\xbb\x43\xb8\x79\x94\x0f\xf9\x88\xbd\x69\xf8\x71\xb3\x76\x02\xc1\x68\x5f\xf9\x91\x60\xc4\xa0\xfe\xf1\xc0\x31\x17\x7c\xf6\xac\xc1\x74\xb5\x03\xce\x8f\xbd\xd1\xfc\xb1\xe1\xa8\xd9\x75\xc3\x87\x38\x86\x73\x1c\xc6\x4f\x60\x14\xc8\xba\x79\xb3\x86\xbd\x54\xfb\x33\xa0\x0e\x2c\xbe\xe0\x77\x11\xf4\xb4\xe8\x87\x39\x93\x11\xff\x33\x30\x74\xfd\xd3\x3f\x06\x76\x6d\x01\x3e\x83\x1a\xa7\x99\x9c\x37\xad\xf5\x7e\xd3\x70\x91\xbe\x69\xf8\x1b\x34\xc8\x15\xec\x4d\xe3\x87\xf4\x4e\x7a\xe7\x02\x0e\x53\xb5\x03\x3c\x6f\xfc\x9d\x60\xec\x2c\x27\x20\x40\x40\x77\xe4\x86\xe0\x08\x0b\x28\xa4\xc0\xeb\xc7\x94\xe2\x58\x02\x92\xa7\xe8\x1b\x40\xd6\xe3\x86\x0b\x67\x1e\x8e\x0d\x74\x55\x58\x00\x5d\xa8\xc9\x3a\x70\x3c\x6e\xf5\x14\xc0\xf4\xb8\xe9\xb5\xdb\xc5\x62\x0d\x68\xf6\xa7\x2d\xd2\x0f\x81\x15\x1e\x5b\x3f\x99\x2e\x80\x2d\x91\x09\xe1\xfc\x2a\x22\x31\x7d\x7a\xca\x09\x58\xfd\x87\x89\xea\xde\x07\xea\x48\x58\x26\x48\x52\x64\x06\x66\xe1\x06\x9a\x87\x0e\x70\x60\xb7\x48\xad\x03\x7b\x0b\x76\xdc\xb5\x98\xbe\x15\xd3\x45\x0e\xe6\x29\xa8\x77\x3d\x06\x63\x9a\xc0\x5a\x8c\x95\x10\x26\xf2\xdf\x62\xbb\x1a\xda\xa0\x81\x39\x9b\x6b\x97\x5b\xe1\xa6\x6f\x2f\xc3\xb6\x34\x8f\xea\x96\x46\x8f\xb1\xe1\x05\xc7\xb8\x0b\x39\xcf\x80\x62\x40\xe9\x82\xe7\xad\x5d\x0c\xa3\x5d\x1c\x5d\x23\xd2\xce\x3a\x16\xa6\xf9\x17\x2c\x4c\xf3\xb6\x9d\x26\x04\x16\xad\x64\x9a\xeb\x08\xa3\x95\x64\x22\x3b\x13\x35\xc2\x2f\xcd\xb7\xc3\x9f\xe6\x9d\x1e\xb3\x7d\x83\xd6\x65\x7e\x77\xe2\x22\x35\x13\x52\xf0\x86\xcf\xc0\xec\x90\xa6\x0d\xde\xd0\xf9\x8c\x2d\x9e\x9c\x37\xad\x21\x26\x09\xe9\x94\xf1\x05\x98\xf3\x2d\x28\xa6\xb8\x40\x10\x82\x5a\xda\xb5\xf5\xf5\xb6\xa9\xff\xe1\x39\x6b\x7b\xd5\x05\x6b\xf7\x02\x4c\x7d\xdc\x16\xec\xec\x49\xd8\x48\x2a\x48\xc5\xec\x28\xe8\x18\x2e\x92\x30\x35\x24\xe0\x38\xe9\x3c\x49\x72\x8d\x3e\x1e\xb6\x25\xaf\x52\x84\x42\xc1\x9a\x82\x54\xed\x23\x0f\x42\x97\xa1\xc7\x46\xfb\x30\x84\xba\x0e\xf5\x78\x89\xbf\x0c\xfe\x36\x2c\xde\x46\xf8\x05\xee\xee\x8f\xad\xa6\x82\x68\x55\x81\x37\xfe\xdb\xff\xd4\x1e\xf6\xbd\xb1\xcc\xef\x5e\x56\xde\xc3\x55\x66\xf4\x2e\xde\x8e\x63\xdc\xd1\x76\x4f\x4f\xab\xbb\x92\x0b\x52\xb2\xc2\x2e\x02\x03\x8b\xf2\x60\x11\xc0\x47\x3d\xa3\xe3\xa6\x20\xe5\xdf\x00\x72\x69\xa9\x67\x00\x5d\xad\xe1\x0d\x20\x4f\x7b\x81\x5d\x5a\x60\x97\xac\x0a\xa0\xfa\x32\x80\x2a\x9c\xa9\xe3\xb5\x54\xd5\x38\x61\x0b\x42\xbb\xa3\x0a\xbb\x07\x29\x0a\xae\x0b\x8f\x3c\x49\x98\x85\xb2\xf9\xd5\xb3\x22\xe9\x2b\x19\x15\x23\x2a\xaf\xb4\xe3\x82\xfd\x9a\x7f\x90\x41\x88\xfe\x73\x7d\xe5\xe8\x20\x50\x18\xc5\xe1\xb5\xec\xe8\x72\x05\xbf\x03\xfb\x77\xc1\x4c\x8e\xc1\x54\x31\x1d\x15\xa9\xba\x4d\x25\x24\x6a\x68\xd2\x3a\xd8\x85\x4c\xf1\xf3\x26\x64\x91\xdc\x12\x80\xb6\xf4\xce\x5b\x48\x60\xf5\xb8\xb2\xe6\x1a\x03\x2b\x0a\xeb\x42\x02\x37\xf4\xc8\x46\xc3\xb6\x2a\x27\x61\x29\x06\x1b\xe5\x02\x54\xdc\xb1\xc3\x05\x64\x70\x81\xdf\xbc\x6e\xf5\x00\x61\x01\xfd\xf4\x9f\x4a\x1f\xc4\x02\x8e\xbe\x18\x52\xbe\xde\xf3\x00\x4c\x80\xcc\x95\x41\x87\xf3\xc6\x5f\x5e\x96\x90\x4f\x38\xfe\x9e\xbf\x96\x64\xb4\x84\xcf\x30\x0f\xbf\x13\x03\xd4\x98\x84\x2f\x60\xe6\x7f\xe8\x74\x01\x03\x1a\xd7\xbc\xf3\x11\xda\x29\x99\xf2\x60\xdf\x59\x9e\xaa\xe4\x6d\xee\x04\x2a\x65\x1c\x22\xbe\xe8\x85\xb4\xc2\x93\x3a\x49\x7e\x02\xf5\x08\xbc\xb8\x6c\xdc\xbd\x4e\x37\xfc\x1a\xb4\x4b\x92\x65\xa0\x61\xfa\xea\x69\x66\x91\x99\xc0\xf7\x11\x2b\xb2\xf1\x5c\x92\xfd\xd1\x01\xdb\x63\xb1\x46\xfc\xdb\xb8\xd6\x0f\xfd\xb5\x7e\xe9\xd4\xda\x63\x3b\xad\x3a\x7f\x7d\x99\xf5\x31\x7b\x94\x01\x07\x14\x7a\x9f\x1a\x55\x1d\x5d\x99\xa0\x4b\xc0\x25\x86\xf7\x58\xf7\x95\xe3\xdf\x45\xf4\xb1\x2b\x86\x8f\x57\x14\x43\xd2\xf9\x71\xfd\x89\x2b\xeb\x46\x2f\x2d\x97\x2b\x83\x6e\xe4\x44\xda\xb4\xb4\x10\x70\x45\x8f\x84\xe1\x3c\xff\x0a\x77\x35\x08\x33\x22\xdf\xdf\xdf\x65\x14\x14\xfd\xb7\x18\x54\x9f\x3b\x68\xcd\x3f\x0a\x2f\x90\x52\x18\x62\xe9\x53\xb8\x2d\x7e\xc5\x26\x00\x9b\xa4\x3e\x4f\xc6\x8a\x77\x3e\xc1\xb0\x0d\x32\x6e\xdd\x5e\x47\x70\x8a\x5e\x49\xab\x34\xb6\x29\x56\x7f\x1c\x7d\xa2\x93\xfa\xe3\xf0\x53\x4a\x5a\xdf\xc2\xc8\xd4\xa7\x28\x7a\xe1\x37\xff\x5b\x43\x31\x6b\xfc\xe5\x01\xbd\x70\xab\x69\xfa\x04\x7f\x77\xed\xcf\xfc\xf8\x49\x3d\x01\x1f\xf2\x10\x37\x84\xf6\x58\xa6\x0c\xde\x3f\x3a\xaa\x27\x87\x8f\xe2\xf7\xa6\x89\x9b\xc6\x31\x31\xe3\xe0\xb9\x67\x9a\x10\x37\x5c\x90\xc1\x88\x32\x49\x7c\xa8\xa4\xf0\x9b\x7a\x15\xfa\xfc\x7d\x68\x0d\xf6\x87\x9a\x68\x06\xc9\x7f\x30\xae\xf9\xf7\x35\xa8\xfb\x8c\x3c\xb4\xcd\xde\xd5\x6d\xae\xae\xb2\x6c\x5c\x8b\x7f\x83\x01\x86\x5c\x5b\xfb\xfe\x68\x2d\x9c\xf1\xd0\xb3\x64\x0b\xdc\x44\x27\x95\xa1\xd9\x55\x4a\xf0\x09\xf9\x75\xe4\xfd\xb5\x95\x0d\x7e\xc7\x21\x52\x47\xe8\x9c\x06\x17\xfe\xf3\xc6\xc5\x42\xce\x92\x24\xd3\x25\x14\x6e\xcc\x43\xc8\x0c\x1b\x4d\x88\x5f\x37\xac\xcb\xf6\x5e\x37\xee\x2e\xb8\xc6\xe7\xc2\x8e\xd5\xa4\x15\xc9\x5a\x15\xbc\x5f\x2f\xc9\xf0\xdc\x6a\x9d\xde\x94\x5a\xff\xf7\xb2\x55\x41\x83\x8b\x15\x3c\x23\x25\x33\x31\x81\xab\x98\x7d\xcd\x18\x16\xe8\x9a\x86\xf5\x2c\x98\x13\xc3\xf9\x90\xf0\xab\x6f\x6b\x02\x66\x5c\x81\x0c\xe9\xbb\x68\xf7\x5c\x54\x64\x9f\x39\xbf\x1a\x36\x04\xde\x2f\x54\xdb\x6c\x3e\x3f\x7e\x79\xfc\xfe\xf8\xf9\x26\x43\xeb\x93\xb0\xc0\x69\x17\xc0\x29\xdb\x8a\xbe\x44\x6c\xe4\x68\x0f\x16\x11\x1c\xb9\x13\x12\xfe\x8a\x0d\x2c\xc3\x37\x60\x60\x89\x7e\xce\x58\x10\xbf\xf5\x93\xfa\x39\x96\x49\x87\x52\xf4\xfd\xd4\xba\x7a\xaa\xdb\xcb\xf6\xfe\x87\x30\xa3\x83\x30\x64\x12\x88\x35\xa3\x80\x70\xee\x6d\x37\x56\x1c\xee\x40\x50\xa2\x06\xa6\xfe\x5c\xe9\x8c\xa8\xba\xef\x83\xb4\xd3\x9d\xce\x51\xe3\x5c\x53\xd1\xa8\x7d\x37\x1a\xc3\x97\xdb\x1d\xed\xa6\x56\x0a\x6e\x45\x73\xc1\x0a\xff\x61\xc5\xfa\x2f\xf1\xf4\x57\xfc\xad\x0e\xb0\xe3\x56\x5d\xc1\xaf\x01\x02\x0d\x03\x62\x2a\xfe\x8d\x68\xe5\x76\x00\x3f\x1c\x5b\x09\x27\x12\x18\x77\xf3\xad\xd1\x70\x67\x3f\x09\xcd\xbd\x77\xd8\x4b\x2d\x94\x02\x2a\x45\xde\x37\x5c\xd0\xf1\x77\x92\xbc\x6f\xe0\x6c\x82\x9f\xec\x6d\x03\xbd\xa0\xd1\xac\xf6\x27\xc1\x7b\xe5\xd7\xb5\x0b\x6d\x04\x13\xfd\xd1\xc9\xbd\xbd\x2b\xbe\x15\x7d\x87\x61\xba\x83\xb8\xe2\x61\xb8\xf1\x31\x0d\x3e\xd3\x4d\xbb\x96\x1f\x38\xcd\xc8\x80\xf3\xf7\x0d\xce\x7e\x30\xd2\x70\x7b\x69\x7f\x9b\x01\xe8\x39\x0f\x19\x3a\xe8\x2a\x8b\x68\x90\xbc\xdf\xf6\xb4\x5c\x6e\xce\x45\x06\x89\x47\x80\x8d\xd8\x84\xd4\x20\xf8\x3c\xf8\x55\xe7\x24\x73\x47\x8c\x46\x08\x8a\xbe\x6d\x6f\x1b\x88\x9c\x8c\x1b\x96\xe9\x05\x52\xd1\x02\xc1\x70\xcc\x18\x46\xbb\x2e\x16\xba\x1e\x76\x5b\x46\xca\x06\x24\x12\xa4\x79\xb1\xbe\xe6\x2a\x3a\x82\xcf\xd1\x23\x08\x7e\x9a\x3a\xe0\x06\xbd\xb2\xbf\x19\x65\xff\x24\x8c\xaa\x7f\x67\x30\x20\x4e\x09\xe0\xb4\x88\x3e\xd4\xfe\x00\xa2\xf3\x27\x89\x1c\x40\x74\x7e\xf3\xff\xa6\x5c\x2e\x6d\xe8\xfd\xe0\xeb\xd5\x5b\x8c\x61\x61\x10\xe9\x6d\xc3\xdf\x37\x93\x6f\x44\xb8\x75\xa2\xce\xf4\x7c\x7b\x04\xd9\xa2\x26\x7a\x74\xef\x51\xbc\x0a\xab\x1a\x44\x05\x56\x75\x10\x15\xd8\x68\x1c\x9c\x60\x7a\x72\xda\x10\x8c\xb2\x0c\xef\xd3\x4b\xf8\x89\xd1\x2b\x5a\x11\x68\xa5\x6b\x06\x4c\xe2\x40\xc1\x52\xeb\x53\xc1\x1d\x86\xb5\x98\x59\x1a\x25\x80\x1f\xa9\x28\xab\xb9\x13\xb2\xd7\x2c\x83\x43\xd9\x76\xbc\x5c\x3e\x6d\x22\x1b\xe8\x25\x1f\x31\x1c\x29\x9a\xa2\xea\x41\x80\xf5\x6b\x74\xc9\x8a\xfc\x05\x9d\x87\xe5\xfb\xec\x2c\xe1\x5b\xfb\xa3\x47\x1d\xdf\xcd\x23\x5e\xf5\x04\xa4\xe0\x43\xca\x4e\x4d\x6f\x55\xa8\x84\xa8\x83\x49\x32\x9f\x9a\x0c\x46\x4c\xf1\xc0\x93\x21\x35\xee\x31\x17\x2a\x97\xcb\xb3\x8a\x94\xce\x11\x17\x64\x82\x51\x04\x14\x9f\xea\xc0\x26\x68\x09\xaa\x06\x01\x8c\xab\xcb\x06\x98\xf5\xc0\xda\x19\x96\xa9\x46\x1b\x55\x63\x01\xa0\xbd\xf5\x40\x83\x0f\xba\x9e\x19\xb3\x34\x41\x5f\x45\x35\xfc\xf4\xf5\x53\x2b\xb3\xf8\x68\x9f\x19\x6b\x58\x70\x98\x35\xf3\x2c\x71\x9e\x4e\xd1\x5a\xda\x30\x5c\xac\x3a\xca\x92\x84\x54\xbc\x8c\x37\x38\x93\x7e\xd8\x8c\x78\xde\x7f\x22\xd3\x57\x8a\x12\x70\xc4\x81\x70\x30\x30\x24\xf4\x2f\xa1\x13\x04\x74\x16\xdb\xb7\xc3\x82\x6b\xa3\xfb\x92\xd5\x5f\x31\x0b\xbf\x46\x55\x6b\x8d\x70\xe8\x38\x12\xa1\xc3\xdf\x79\x1a\xa2\x41\xde\x1d\x15\x86\x23\x19\xc1\x3c\xe9\xa4\xab\x57\x69\x23\x8c\xc5\x96\x8c\xd2\xb4\xf4\x03\xc8\x02\xdc\xc9\xea\x90\x3d\xd1\x53\x19\x3b\xec\x71\x3c\x95\xcb\x5d\x06\x7a\x51\x98\xe9\x0c\x0a\x69\x6c\xbb\xbf\xe4\xa3\x9d\xc7\x41\xd3\x41\x8f\x15\xc5\x34\x7a\xcf\xc1\x9a\x32\xf6\x90\xb3\xdb\x2f\xe3\x4f\xc1\x4d\x04\x90\x03\x37\xa2\xfc\x47\x1b\x51\xfe\xff\xb7\x11\x8b\x68\xc6\x10\xcb\x0d\xa6\x6b\xe7\x3e\x18\x8e\x7f\x01\x07\x41\x43\x38\x21\xb6\x2a\x9c\x27\x6e\xb2\x56\x6e\xe6\xc8\xa8\x35\xea\xd2\x82\x2d\xcf\x6f\x5b\xa5\x58\xa7\x20\x00\xc6\x0e\x65\x53\x89\xde\x1e\xec\x14\x9f\xa0\x97\x1a\xe2\x41\x85\x9a\xdd\x90\x48\x04\x7d\xb3\xa2\xad\x3c\x1e\xa3\x2d\x0e\x2f\x50\x6c\x5f\x5a\xc7\x46\x96\x73\x79\xbf\xdb\x64\xee\x10\x29\x9f\xe4\xe0\x2e\x99\xd3\x94\xe4\x7d\xc8\xc0\x72\x83\x05\x39\xfa\x82\x2d\xb8\x5c\xef\x06\x39\xeb\x0b\x2e\xb0\xe8\xf5\x59\x2c\xd7\xf9\x2c\x8e\x67\xbd\x5e\x8b\xe5\x17\x5d\x58\x93\xa4\xf7\xb3\xb5\xf5\x21\xb8\x0f\x5c\xc6\x97\x4b\x08\x10\x94\xd3\x24\xb9\x84\x75\x01\x62\x96\x53\xe6\x23\x8e\x4c\x3b\x62\xdd\x12\x5d\x04\xa7\xe0\x38\x08\x59\x49\x4b\x58\xc9\xa6\x5d\x8f\x61\xf3\x53\xd0\x17\x2c\x97\x3e\x82\xdd\x72\xd9\x34\x93\x9e\xa0\x10\x1b\x0b\xe3\x33\x0a\xc8\xb1\x60\x75\x4f\x93\x94\x81\x32\xa3\x59\x2e\x67\xa6\x56\xc1\x6a\x36\xd5\x06\xa8\x74\x42\xfe\x0e\xe0\xd0\x57\xf1\xcb\x10\xb3\xee\x9e\x3d\xa3\xed\xab\x99\x24\x7d\xa5\xfd\x4e\x9e\xf7\x8e\xec\x9e\x97\x84\xae\x69\xae\xcf\x19\x33\xda\x85\x7b\x94\xa6\xe4\x9f\x7e\x1a\x08\xb1\x35\xfe\xf0\xba\x23\xd6\x6e\xa8\x35\x90\xe3\x20\x41\x34\x58\xd2\x30\xb7\x31\x79\xce\x6a\x5e\xfc\x4f\xc6\x00\x5e\xff\x48\xaf\x62\xf2\x80\xd1\x95\xba\x64\xc2\x8d\x08\x4f\x6d\xa0\x68\xc1\x05\x77\x52\xa4\x3f\x2b\xeb\xdf\x52\x50\x76\x0f\x19\x61\xff\x1e\x32\xc2\xee\x25\x21\xe4\xdf\x41\x43\xe8\xff\x1d\x12\xd2\x43\x16\x3c\x62\x84\xe4\x63\xba\x8e\x7c\x40\xd3\xd3\x7f\x44\x3e\x3a\x4d\x52\x46\x16\x6d\xf2\xd1\xb0\xe9\xdf\x27\x1f\x06\xcc\x5f\x03\x2e\xeb\x88\xfe\x05\x94\x0f\x1b\xed\x2d\x26\x40\xe8\xf2\xbf\x41\x47\x7c\x6b\xeb\xdf\x62\xa3\x5f\x41\x4e\x6c\x6b\x9d\x0d\xf9\x77\xd0\xb2\xfd\xf9\xce\xfe\x41\x4c\x90\x06\xf7\x75\xbe\x5c\x16\x91\xe9\x0f\x62\x6c\xd3\xb5\x07\x5a\x2e\xe3\x7e\xa2\x61\x0e\xbe\x38\xcc\x7f\xde\x11\x4c\xe8\x2b\xc8\xe4\xb4\x8f\x4c\x4e\x5b\x64\x72\xf1\xff\x1e\x64\x34\xf1\xb6\xdc\x75\xe3\x59\xd3\x2c\x0a\xab\x1b\xbc\x80\x4b\xd5\x1d\x5e\x08\x30\x3d\x50\x8f\x85\x20\x30\xad\x83\x3a\x49\x06\xce\x1e\xb8\x4a\x92\xbf\x80\xda\x4a\x48\x8a\xee\xef\x1c\xe3\x3a\x3a\x32\x5e\x7b\xe3\x2b\x85\xea\xe8\xb2\x77\x4b\xf7\x92\x6b\x2d\x29\x41\xed\x1b\xde\xe1\x9d\x32\x78\x23\x9a\xfb\xc8\x89\x40\x93\xa4\x84\x4b\x92\xbe\x09\xf0\x58\x48\x00\x2c\x74\xe6\xae\x09\xe6\x25\x7c\xc6\x0a\x08\x82\x93\xe2\x55\x02\x7e\x74\xd0\xa9\xc6\x58\x0e\xc1\xac\x87\xae\x25\x0f\xd7\xbc\xf6\x8a\xeb\x40\x36\x32\x76\x72\x74\x8c\xec\x32\x31\xc1\x9f\x5b\xa5\x9d\x02\x10\x5f\x59\x84\xa5\xa9\x7b\x4c\x12\xfc\x1c\x7f\xeb\x15\xb8\x95\xae\xc8\x59\xa7\x07\x2b\xbe\xa8\x23\x8d\x04\xab\xb8\xbd\x97\x7b\xe5\x00\x9e\xe5\xfc\xd6\x2d\x1a\x2b\xf0\xc2\x42\x6a\xde\x67\x37\xba\x5c\xe2\x8b\x9d\xa4\xa4\x5e\x5c\x8f\x56\x84\x83\x8e\x15\x21\x85\xfc\xda\xa4\x00\x0d\x41\xd0\x4e\xc2\xb7\x0e\xf6\x9d\x0e\x10\xbd\x56\xfa\xf0\xdd\x29\x66\xb2\xed\x19\x86\x95\x5a\x2e\x07\xa6\x60\x01\x89\xd6\x4e\x4b\x71\x92\x41\xad\xf7\xf3\xa2\xf9\xd6\x55\x21\xe5\x92\x8f\xb4\x11\xf0\x6d\xc3\x46\x49\xe9\xef\x94\x41\x16\xe3\x41\xd8\x6c\x92\xfc\x21\x89\xa2\xcc\xc4\xff\x2a\x82\x37\x2c\x03\x3f\x07\xf8\x9e\x55\x6c\x88\xee\xb1\x99\x97\x66\x0c\x39\x27\x3b\x89\x01\xae\x91\x31\x3a\x99\x60\x0b\xa9\x26\x88\x3e\xe6\x6f\x8a\xbf\x58\x86\xe8\xe9\x82\x25\x8a\x31\x75\x72\x86\x4c\x9b\x1d\xa0\xb1\xab\xdd\x09\x12\x46\x54\xb0\xca\x79\xeb\x3a\x3d\x81\x62\x99\xb5\x8c\xe5\xb2\x83\xd4\xcf\x1b\xb7\x17\x32\x26\xad\x60\xa5\xe2\x38\x82\x5a\x74\x3f\xc1\x5b\xa7\x7e\xcf\xbd\x44\xae\x02\xe1\x33\x5e\x2e\x7b\x56\xdd\x4a\x4b\x51\x5e\xe3\x67\x80\xf0\xcd\x42\xf8\x4a\x90\xb4\x08\x1f\x59\x52\x63\x65\x34\xa7\x08\xc4\xda\xbb\x0a\xfb\xfd\x2a\x00\x53\x3d\x40\x9f\xb0\x13\x9d\xd5\x79\x61\xe1\x5d\x8c\x69\x61\x3b\x93\xda\x42\xa6\x05\xef\x0a\x46\x08\x74\xd3\xfb\x2d\x80\x6e\x06\x6b\xf1\x8a\xf5\x07\xb3\x1d\xde\xb7\x00\x92\x39\xff\x0c\x19\x93\xad\x60\x35\x24\xbd\x7f\x41\xe4\x2a\x02\xf4\xdf\x47\x5e\xec\x0b\x7c\xc9\x71\x25\xb5\x18\x02\xdf\x67\xf4\xff\x32\x76\x07\x1a\xb1\x25\xdf\x61\xd9\xdf\x5f\x83\x60\x13\xdc\x0b\x66\xb3\x3c\xb6\x07\xd8\x26\xa1\x59\xc0\x0c\x85\x6b\x1d\xa9\x52\x5f\xb8\x61\x8e\x87\xae\x0c\x95\xab\x16\x34\xe0\xc4\xde\xd7\x48\xbb\x10\x02\xb1\x42\x30\x68\x84\x4b\x64\x9c\x31\x6d\x9d\xf4\x70\x36\x95\xed\x0d\x6a\x6d\xed\xca\xb6\xf5\x2f\xbf\x2b\x9a\x6f\xb2\xfc\xfc\x3a\xab\xa7\x8d\x8e\x06\x0c\x72\x74\x67\x3c\xe5\x7e\xea\xb4\x24\x18\x40\xb8\xcc\x1a\x95\xd6\x0c\x92\x91\xa5\x52\xff\xf3\x6b\x90\x0e\x75\xc1\x2b\x48\x13\x52\x31\xaf\x66\x4c\xb3\x55\x4a\xca\xed\xa0\x33\x0e\x46\xa7\xae\x7d\x8d\x63\x61\x81\xeb\x90\x0f\x59\xa9\xf5\x95\xfa\x36\x0c\x8d\x73\x89\x0f\xbe\x5b\x5d\xc9\x76\xcc\x2b\x56\x86\x1a\xce\x50\x30\x7a\x19\x9f\x92\xed\xa3\xb1\xe2\x75\xe8\x4e\xc1\x32\x5e\xeb\x9e\x80\x3b\x42\xee\xa1\x8e\x76\x2b\x9c\x99\x3b\x09\xa9\x83\x23\x15\x32\x49\xf1\x51\x52\x2f\x77\x62\xe9\xdd\xc1\x9e\xd3\x85\xdb\x9d\xa1\xe3\x48\x93\x83\xbd\x50\x4d\x47\xa9\x48\x81\x6a\xb9\x94\x0e\xc1\x3e\x72\x6e\x28\x5a\x0b\xe7\x24\x89\xf1\x92\x26\x89\x46\x50\x49\x9d\x44\x50\x7b\x91\x98\x4f\xda\xaf\x5c\x13\xe8\x06\x82\x0f\x76\x03\x82\x19\x80\xe8\x71\x03\x11\x3e\xc5\xf4\x86\x88\x1c\x41\xfc\xee\x8e\x34\x16\xd8\x20\x64\xfa\xc7\xa6\xc3\x06\xbc\x06\x71\xe5\xbe\xb7\xf5\xec\x9b\x90\x72\xac\xea\x84\x8f\x60\x1c\xe6\xc0\xaf\xdb\x64\xab\x6f\x73\x1b\x68\xa0\x6a\xbb\xc2\xb4\x6f\xb3\xaa\xd6\xc8\xb8\xa9\x41\x2e\x2d\xc8\x59\x15\xb9\xee\x4b\xd0\x71\x4a\xbf\x7d\x03\xda\x89\xf3\xd3\xbe\x43\x5a\x47\x01\x31\x3c\xb8\x3b\x2a\x20\xef\xb0\x65\x45\x24\x78\x50\xda\x0e\xf0\x3f\xfa\x4b\x93\xca\x7f\x12\x9c\x33\xfa\x25\x9b\xd6\x44\x31\x08\xd3\xa8\xed\xc0\x42\xa3\x3b\xeb\xcb\xa5\xa7\x72\x6a\x37\x96\x9d\x0b\x86\xe3\xec\xeb\xd1\x4e\xac\xf2\x8b\x04\x6a\x7c\xc1\x2b\x3f\x49\x1a\x4f\xce\xeb\xeb\xd0\x5d\x71\x25\xbc\x63\xa2\xf7\x0d\x84\x78\x4d\xbc\x62\x15\x17\x2b\x33\xee\x21\x52\xf5\xfb\x86\xae\xaa\x33\xa1\xe6\xa2\xde\x4c\xed\x5c\xbd\x2d\x05\x1a\xf8\xf5\x7d\x8b\xda\xa9\xb4\x6f\xb5\x3d\x99\x6f\x31\xf0\x78\x8f\x91\xd4\x06\x08\x06\x39\xbc\x8a\x63\xa6\xb7\x62\xcb\xa3\xc1\x6e\x5b\x2f\x03\x34\x01\xc2\xc0\x57\x49\xf2\x63\x4d\xbc\x76\xc2\x53\x25\xa8\x75\x14\x45\x38\x09\x8c\x0a\x21\x43\x97\xf9\x20\xd8\x7f\xb1\xfe\x78\xb4\xbf\xeb\xbd\xfb\x43\x67\xad\x88\x3e\xac\xe3\xa7\xf0\x35\xac\x88\xdd\x4b\x2e\x24\x97\xdb\x49\x63\x1a\x6c\xab\x10\x73\xbf\xc4\xa3\x8d\x63\x34\x5d\x0b\xef\x79\xdd\xb1\x29\x31\x54\x1a\x77\xe0\xbc\x98\x4e\x85\xdc\x4c\xe1\x3a\x05\xaf\x02\xf7\xaa\x10\x53\xc3\x70\x5b\x0e\x45\xe1\x3e\xc2\x5b\xb1\xb5\xdc\x66\x33\xcd\xe9\x0d\x96\xc6\x63\x0d\x51\x2f\xaf\xca\x32\xbb\x6c\xc4\x74\x33\x95\xed\x11\xd4\xd1\x08\xa4\x1b\x41\x40\x09\x12\x20\xfd\xed\x2d\x8f\xc1\x49\x26\x2a\x70\xb5\x82\x86\xa3\x21\x99\xe7\x68\x5c\xa9\x0b\x47\x86\xaa\x78\x07\xc4\x8b\xe8\xd4\xaa\x5a\xa7\x96\x8d\x1c\x8d\xbe\x89\x18\x4b\x59\xff\x1b\x1d\xa0\xa3\xe8\xbe\xf5\x82\xb5\x7e\xa3\xe8\xbd\xae\xff\x3d\xc6\xd2\x9d\x34\x72\x2e\xdd\x0b\xed\x6e\xd0\x64\xc6\x16\x3d\xb7\xe2\x5e\x9a\x24\x90\x03\x89\xf9\x3a\xbb\xb6\x0e\xb8\x7f\xb6\xd2\xdc\xc8\x48\x76\x20\x5b\xb7\x61\x58\x4f\x7b\x05\xe6\xed\x97\x9d\xda\x1c\x99\x45\xb7\x9d\xec\x93\xcd\xe2\x3f\x78\x00\x37\xbc\x1e\x69\xcd\x2b\x08\x5f\x16\x8c\x78\x3f\xbd\x86\x9a\x4c\xf2\x1e\x47\x54\xb0\x3b\xb3\xa6\x28\xed\xbe\x82\xc9\xd0\xf7\x05\x86\xe9\x84\xab\x12\xb3\xda\x54\xab\xe0\x8d\x86\x00\x0a\x55\xc7\x0b\x0c\x2a\x47\x83\x5b\x8a\xc2\x16\x31\x38\x38\xf0\x2e\x69\xd6\x17\x47\xb4\xbd\x64\xf5\x94\xe9\x5d\x15\x36\xc4\xdc\x14\xac\x42\xd0\xd2\xcb\x08\x7d\xaa\x8f\x9f\xcb\x4f\x5c\xb1\x0a\x32\xf9\x73\x10\xa4\x98\x1d\x5a\xcc\xea\xec\xc2\x25\x98\x37\x01\x1c\xcc\x0f\x71\x71\x0a\x1b\xe7\x27\x41\x36\x21\x04\xf9\xa6\xf3\x5d\xd7\xc8\xb2\x79\x55\x4c\x45\x85\x55\xb3\xc5\xb4\xa8\x36\x91\xb1\x19\x8e\xc5\xd1\x37\x99\x75\x96\x84\x8c\x34\x3f\x09\xf2\x4d\x06\xf9\x74\xe2\x16\x9a\x6a\x51\xe7\xc2\xf4\x20\x40\x54\xd4\xee\xa2\xb8\x38\xc3\x0e\x8a\x8b\xec\xcc\x0e\xb2\x2c\xe4\x79\xeb\x23\xb6\x6e\x90\xb3\xaa\xbe\x30\x75\x6b\xd1\x08\xe5\xea\x36\x8b\xd3\x8b\x42\xb5\x6b\x43\x66\xcf\xa2\x6c\xcc\x07\xaa\x3a\x3b\x2b\x45\xbb\x8a\xc9\x2b\x99\x3e\x35\x0e\xf2\x50\xaf\x90\x57\x59\x59\x4c\xa1\x22\x7b\x2e\x88\x64\x9b\x95\x7c\x66\x12\x4e\x46\x5f\x62\xb6\xbb\xb4\xda\x3e\xb9\xae\xb3\xcb\x4b\x6b\x98\x78\x77\x9d\x35\xaf\x16\xa5\x2a\x2e\x4b\x91\x0e\x06\xe5\xf6\x05\xfe\x58\xfd\xad\xe6\x5d\x64\xc9\xf4\xb7\xaf\x1b\xdb\xea\xb5\x20\x10\x82\x9a\x21\x03\x65\xe9\x62\x01\xd9\x72\x4a\x48\x7e\x52\xb6\x13\x6e\x17\xa8\x16\x6f\x78\xf9\xb1\xf8\x34\xde\xb4\xac\x32\x98\xeb\x15\x93\x4e\x3c\x82\x66\x52\x85\x59\xce\xd1\x25\x4a\xf0\x8f\xfe\x43\xd6\x7c\xa2\x69\x27\x68\x41\x93\x24\xed\x2f\x37\x37\x1f\x76\x3f\x86\xc2\x4f\x34\x15\x59\x77\xa0\x76\x0f\x37\x49\xa2\x27\x5e\xd0\x15\x6e\x04\x87\xf8\x66\x25\xdf\xc2\xd9\xfe\x3d\x80\x4c\x9b\x0e\xf6\x42\x54\xd7\xf9\x86\x54\xbd\xeb\xa9\xeb\x56\x97\x40\xc9\x37\xd3\x98\x75\xf1\x72\x52\x37\xb5\x72\xbb\x92\xcf\x20\x91\x29\xb0\x93\xdb\x95\xcc\xe1\x99\xff\x20\xe8\x0a\xd8\xf1\xd8\xee\x41\x5a\xea\x27\xdb\x24\x66\x0f\xb3\x20\xe0\x94\x0a\x0e\x57\x00\x19\x18\x47\xa6\xad\xcc\x91\x3a\x37\xf3\x15\x24\xb0\x15\xfc\x05\x81\x78\xc6\xa6\x60\xb2\xd9\xe4\x75\x71\x09\x31\x5b\x78\x06\x36\x40\xc5\xb6\x71\x5f\xc1\x48\x03\x64\x73\x5a\x5c\x6d\x82\xd1\x4c\x21\xa5\xa8\xbf\x7f\xff\xea\x25\xdf\x3c\x32\xdf\x3c\x39\xfa\xef\x7f\xe1\xd3\xa6\x66\xdf\x6b\x71\x51\x5d\x09\x6d\xb1\x48\x6c\xea\x1d\xf8\x41\x69\xda\xc1\x8d\x6a\xbb\x68\x26\xdd\xee\x32\x76\x57\x34\x29\xbc\x5c\xd1\xb4\x67\x38\x19\xb5\x29\x37\xf5\x88\xb5\xf0\x48\x3b\x56\xe0\x9e\x99\x14\xee\x91\x0f\x86\x29\x30\xae\x7f\x01\x0b\x58\xe8\x07\xcd\xd2\xfe\x25\x28\xa5\x69\xa7\xe9\xd7\xef\x74\xa0\x67\x26\x90\x4e\x0a\x4d\x27\x2b\xf6\xce\x10\xfe\xc1\x48\x4b\x69\x03\xba\xcb\x05\x2b\xf8\x53\xd8\x43\x15\xfd\x27\xd4\x54\x80\x22\xb5\xfa\x0a\x8a\xda\xf0\xe1\xb8\x09\x28\x6a\xe3\x28\x6a\x03\x26\xf6\xe3\x56\x2b\x3d\x54\xb5\xdb\xd5\x57\x52\x56\x41\xd9\x7d\x03\xee\x50\x57\x11\x53\xd7\xee\x17\xbd\x14\xb6\x5b\xcd\x51\x59\x08\xc6\xc1\x1a\xfe\x5a\x47\xe5\x88\x49\x9a\xb8\x9f\x1e\xda\x1d\xd9\xf0\xbf\x82\xb0\x2b\xf1\xd6\x15\xf7\x93\x62\x8f\x56\x2b\x66\xf3\x5a\x54\xec\x4e\x07\xb9\xb2\xde\x2a\x7f\x6f\x50\x9e\xa4\xfc\x66\xa7\xf6\xfb\xdf\x9a\x9a\xa5\x2c\x0d\xaf\x0c\xfd\xc6\xfb\x4b\xce\x1b\xcd\xd8\x96\x40\xbc\x73\x20\xde\x79\x9b\x26\x96\x48\xbc\x17\x3c\xff\x58\x7e\x1a\x6f\x36\xea\xb6\xd4\x09\xaf\xcb\xc9\x4b\x41\x04\x5b\xd0\x74\x73\x0a\x9d\xd5\xd5\xa2\x29\x6f\xdf\x09\xf5\x83\xdd\xf2\xa6\x96\x21\x46\x64\xc1\x17\x93\xc5\xf6\xc9\xc9\x5c\x5d\x94\x08\x05\x9a\x24\x4f\x33\x6c\xc2\x51\x67\xfd\x4d\x67\xdf\x2f\x26\x71\x10\xe4\x6c\xb9\xdc\x84\xff\x0b\x9a\x24\xdf\x61\x13\xed\xe3\x60\x61\x5e\x6d\x6e\x3e\x84\x0e\x9a\xc5\xa5\xce\xd9\x8e\x67\xc3\xf1\xb4\xd0\x7a\x83\xdf\xb2\x5a\x42\x4f\x60\x04\x99\x24\xae\xd6\xf7\x3a\x30\x47\x51\xc9\xd6\xfb\x6c\xa1\xaa\x6f\x21\x9d\x2d\x16\x90\xee\x21\x52\x52\x9c\xf2\x02\xcf\x90\xd2\xea\x38\x16\x49\x72\x0c\x01\x6a\xd8\x82\x15\x74\xfd\xc1\x22\xe0\x60\x11\xac\x02\xca\xd1\x8f\x05\xba\xce\x37\x44\x44\xaf\x2d\xe6\x9a\xbe\x2a\x13\x12\x0c\x2c\xf8\x1a\xa1\x9e\x2a\x55\x17\xa7\x0b\x25\x6c\x12\x71\x88\xf4\xf8\x8e\x60\x25\xba\x06\xcb\x3d\x49\x0c\x70\x1a\x6e\xf2\x18\x6e\xcc\xf4\x24\x27\x7f\x12\xc1\xe2\x2a\x30\x74\x37\x10\x44\x3e\x9d\x1e\x2c\x49\x3a\x95\xe3\x0a\xc1\x69\x7a\xcf\x71\xd8\x04\xc7\xa1\x88\x8e\xc3\xdf\x00\xbb\xab\x8e\xb5\xe2\x1e\xb5\xf9\x63\xd6\xf2\xde\xf6\xb2\xea\x6f\x00\x07\x10\xc6\xab\x97\xad\x7f\x6b\xa8\xbb\x17\x9b\xa2\xa0\xd0\x33\xf0\x16\x83\x9d\xa6\xb3\x72\x92\x93\x2f\x71\xf3\xed\x0b\x07\xeb\xe3\xe6\x27\x24\xbe\x35\x55\x6d\x06\x9e\x49\x3c\x91\xcc\xf9\xae\xa1\x6f\xe4\x12\x6d\xc8\x50\x9d\x44\xfa\x4b\xac\x00\xc5\x73\xef\xbd\xb8\x51\xd0\x23\xf0\x36\xb6\x8b\x60\x20\x3c\x8e\x57\xea\x7c\x2a\xb0\xec\x25\x88\xe6\x28\x23\xe1\x70\x35\x3f\xcb\xfa\xf4\x8c\x3d\x66\xaf\x52\xc7\xfd\x75\x66\xbd\x20\x54\x42\xc9\x10\x48\x33\x27\x4e\xa7\xd7\x82\x86\x53\x85\x24\x89\xbe\x0c\x41\x06\x87\x96\xd0\x1b\xc5\x7b\xa0\x0f\x64\x68\x03\x0b\xbc\x82\xd3\x69\xea\x2f\x34\x99\xb2\x17\x72\xc0\xb2\x92\x47\x61\xca\x2d\xeb\xa5\x95\xd1\xa1\x77\x4d\xc6\xb2\xd0\x19\x07\xc4\x7b\x71\x85\x50\x96\xd5\xae\x6d\x15\x36\x0e\x34\xfc\x31\xa5\x4c\x26\xc9\xa0\x32\xc2\x62\x27\xe4\x0c\x94\xb1\x49\x32\xe8\x03\xc4\x7d\x0a\x53\x68\x6a\x94\x04\x42\xeb\x49\x9d\x73\xce\x5f\x00\x0b\x5d\xe7\xfc\xe7\x06\xa2\x5b\xe7\x03\x53\xa2\x1f\x7e\x6e\x40\x17\x9c\xf3\x3f\x1a\x0a\x2b\xc8\x1b\x6f\xb7\x25\x20\x3e\xdf\x4f\x15\x11\x39\x93\x39\x65\xcf\xf4\x53\x93\x53\x4a\x29\x23\x72\xb9\xec\xdb\xa4\x18\x6b\x55\x23\x8d\x13\x34\x68\x89\x41\xfb\x76\x3e\x1a\xda\xd7\x3f\xaa\xd6\x9b\x47\x5f\x21\x8e\x18\x1d\xc2\xde\x46\x6c\x44\x88\x75\x91\x92\xb6\xa5\x74\x19\xef\x41\x53\x8b\x7d\x3a\x4f\x94\x53\x55\x50\x7d\x81\xcf\xe8\xbc\x26\x86\x92\x5b\xe1\x3a\x02\x70\xb9\xbc\x5f\xde\xaf\x8f\x65\x2b\xcd\xb3\xe2\xa6\xb2\x2d\x9a\x3d\x97\x10\x0f\xcd\xc8\xfe\x22\x58\x1e\xec\x31\xdb\x33\xcb\x5a\xa6\xd0\xf8\x79\xd6\xc9\x86\x98\xb1\xbe\xf5\xd0\x01\xdf\x3c\x72\x26\x49\x0b\x73\x6d\xa8\xe8\x00\x7f\xc3\xfa\xac\x6a\xcf\xa2\x1a\xd3\x0c\xd4\x31\x5c\x86\x38\x9d\xf0\x9d\x2e\xd6\xb3\xac\xd3\x15\xcb\xc2\x9e\x30\x00\x59\xe6\xa5\x6f\x76\xcc\x62\x42\xd6\x29\x03\xdb\x0e\x85\x20\xd7\xb0\x72\x51\x68\x2f\x8b\xf7\x4c\xbb\xd0\x0b\x94\x59\x16\xc1\x0f\x8b\x22\xb1\x31\xca\xf2\xfb\x87\x22\xfa\x4a\xbb\xc3\x6b\xab\x0f\xdd\x68\xf1\xfb\xce\x80\xdb\x07\x53\x7b\xec\x6d\xd2\x97\xdd\x63\x38\x5f\xb6\x24\xdf\x7d\x13\x74\x8c\x5e\xda\xf6\x68\x2d\xdb\x43\x8f\x12\x8d\x96\x78\xdd\x43\x61\x5e\x90\x75\xb4\xdc\xf6\x3f\x56\x51\xbc\x43\x2b\xed\xb2\x66\x1d\x9e\x5c\x2d\x77\x9c\x7c\x7b\x05\x81\x41\xf1\x03\x93\xbc\x14\xf6\xcd\x20\xa3\xbd\xbb\x07\xf4\x78\x21\xe2\x1f\xec\x31\x30\xe1\x67\xb2\x7f\xe3\xc8\xce\xc6\x91\x9d\x8d\x63\x36\xdf\x30\xd8\x41\x70\x83\x49\x12\x2b\xda\x76\x45\x20\xf5\x06\x03\x2b\x8f\xc0\x96\xe8\x28\xbe\x7e\x4f\xe1\x48\x94\x1e\x49\xe7\xa4\xf0\xc1\x9b\x76\xfe\xab\x6e\x08\xdd\xaa\x7a\x54\xa8\x4f\xaa\x96\xba\x34\x49\x46\x47\x1d\x91\x81\x05\x85\x23\x26\x9d\x13\x59\xf5\xe1\x30\x97\x5b\x23\x3a\xae\x42\xd5\xee\x84\x78\xc1\x36\x7e\x63\x57\x8b\x97\xfa\x4c\x37\x54\xc3\x41\x79\xe2\x05\xf4\xa5\x35\x24\x00\xcf\x20\x5d\x8b\x97\x11\x9f\x31\xb0\xe0\x9c\x90\xa1\x03\x6d\x38\x35\xd2\x2e\xe2\x00\x98\x87\xfb\x43\x58\x22\xac\xcf\x02\x30\xe9\x6c\x52\xf0\x85\x97\xe1\xb3\x2a\x5c\x8e\x70\x6d\x58\x1f\x7c\x75\x07\x2d\xbd\x1d\x53\xa1\x95\x95\xc1\xe0\x6c\x32\x4a\xd4\x72\x27\x1d\x25\x70\x8c\x61\xb8\xf8\x16\x5f\xb8\x7f\x00\x9e\x51\x70\x2c\x78\x1d\xca\x99\x36\x3a\xc3\x4b\x84\x08\xa4\xfc\xa3\xf4\x39\x58\x05\x04\x07\x9f\xf3\x9e\x74\x0b\x6b\x37\xd1\xde\xf0\xf0\x20\x81\x40\x0d\x9d\xd4\x8f\x6a\x79\xb0\xc7\x44\x18\xe6\x7e\x17\x8e\xcc\x1e\xf1\x3d\x9e\x5e\x24\xea\xa0\x13\x69\x7a\xe7\xf1\xbe\x97\x54\xaf\xeb\xce\x4a\xde\xb1\x9a\x8e\xd5\xc8\xee\xe1\x23\xa3\x2e\xd9\xdf\x99\xcc\xe8\xb0\xd5\x94\x7f\x15\x33\x1e\x6b\x58\x0e\x3b\x2e\x7b\x49\xc1\x37\x2d\x15\xcd\x15\xea\xb9\xcc\x5b\x14\x01\x08\x66\x64\x2d\xa9\x62\x8d\x82\x04\xa1\x9f\xc1\x51\xc8\x7f\x74\xdb\x72\xd3\x32\xb5\x19\x5a\x58\xe6\xe7\x4e\x97\xe4\x58\x2d\x09\xfc\x19\xff\x0c\xfe\x47\x6e\xf7\x24\xc9\x33\x74\xcb\x03\x30\xa9\xe8\xca\x06\x3c\xc7\xc8\x6a\xff\xa1\x22\x62\x8b\xce\x1a\x9f\x57\xb2\xa9\x4a\xb1\xad\x65\x39\x30\x32\xe7\xf1\xde\x08\x8d\xd8\xd5\x42\xf9\x80\xcf\x36\x66\xa2\x58\x85\x53\x38\xc1\x29\x40\x7b\x6a\xfb\xb2\xf7\x4c\xc2\x4b\x43\xe7\x2c\x6a\x65\xaa\xfb\x45\x5e\x18\x3f\x0f\x1c\x87\xa2\x77\x0b\x50\x42\x46\x20\xbb\x89\x0c\x30\xc1\xaf\xcd\xd3\x79\x93\x90\xbb\x7b\x87\x54\x3a\x46\x80\x8d\x45\xd2\x69\x1c\xce\x8c\x0d\x65\xf7\x6a\x2b\x95\xfb\x35\xce\xaf\x47\xc7\xd6\x52\xa6\x59\x1d\xdb\xce\x0e\xa2\x08\xe2\x12\xec\xa5\x9d\xfd\x83\x90\x91\xf4\x9c\xb3\x5d\xfb\x36\xc8\xaa\x36\xb0\xc6\x22\xba\x04\xc2\x76\x58\x63\x5f\x4c\x22\xd7\x0c\x7d\x0b\x85\x25\x9f\xc8\xc0\x47\x03\x52\xc9\xd1\x6e\xa6\xc2\xbe\xe6\xb8\x42\xea\x6b\x49\x03\x6e\x5c\xbc\x37\xe3\x3e\x8a\xf9\xf1\x36\x51\x3b\xd8\x0d\xc9\xd9\x39\x82\x14\xce\x62\x1e\x9d\xae\x16\x69\x89\x0b\xe2\xa8\xa2\xb8\x44\xe8\x65\x6d\xf7\x0b\xa6\x89\xc6\x78\x94\xb0\x07\xb2\xb3\x44\x50\xee\xe0\x0a\x81\x4b\x30\x34\xcf\xd8\x3d\xf1\x28\x66\x10\x78\x5e\x24\x49\x4d\x40\xbc\x2e\xa3\x70\x65\xd0\x7b\x88\x79\xc7\xff\xab\xc3\x36\x57\xf0\x60\xd4\x5f\x33\xc4\x37\x4e\x4f\x8c\xe8\x2a\xff\x06\xba\x6e\x68\x80\x1c\xd7\x64\x17\x0c\x84\x3c\xee\x8a\x30\xcd\x1b\xdb\x4b\x02\x33\x3c\xcb\x63\x01\x92\x51\x11\x19\xd5\xa3\x93\x95\x91\x92\xd8\x88\x67\xbd\x39\x97\x5a\xd7\x56\xc0\x52\x74\x32\x6e\xbd\xa1\xe3\xb8\x0b\x44\xf7\x4e\xb4\xb8\xaf\xc3\x6b\xc7\x54\xc0\xb4\x1d\xa7\xa8\xb8\x0c\x57\x96\x26\x49\x2e\x89\xd4\xa1\x81\x10\x26\xfa\x6c\x5c\x57\x1d\xe3\x40\x3b\xf3\x15\xa0\xd8\x68\xb4\xe1\x56\x44\xff\x0c\xd6\x65\x3f\x15\xb6\x92\x87\x73\x20\xa6\xdb\x18\xf5\x55\x58\xb9\x71\x45\xdb\xd3\x1d\xa8\xf1\xaa\xb9\x39\xc2\x4a\x25\x49\xb4\x88\x49\xf2\x9b\xb0\x10\xf7\x51\x7a\x0c\xc4\x41\xa8\x38\x03\x21\x28\xb1\xd3\x6f\xed\xf8\x16\xad\xdb\x4d\xdb\x40\xd5\xb2\xa6\x68\x79\x1c\xf1\x23\x92\xcb\x8e\xbd\x91\x2d\x8f\x3e\x69\xbd\xf3\x61\x17\x68\x92\x1c\x0b\x38\x0c\xb1\x7f\x6b\xba\x80\x86\x0d\x3b\x88\xf3\x3b\xa3\xaf\x22\x4c\x3f\xb5\xb7\x4f\xcf\x59\x72\x9c\x27\xc9\x71\x0e\x72\x88\xfb\x8e\x82\xbd\xce\x1e\x0b\x90\x46\xc4\xd4\x23\x98\x9c\x88\x02\xbc\x20\xc5\xa8\x31\x52\x83\x8e\x85\xf4\xe8\x48\x42\xac\x23\xd9\x8e\x75\x24\x78\x6d\x83\x6c\xc2\x61\x82\x44\x63\x1c\xda\xbe\x7b\xeb\x11\x7d\xf8\x4b\x77\xce\x0a\x7d\xce\x56\x80\x4a\x2e\x2c\x04\x52\x18\x01\xe4\x91\xae\xe8\x2a\x42\xc7\x9b\x9a\xa8\xb6\x2d\x47\x0f\xa8\x64\xef\xf1\x9e\x24\x27\x60\xe4\x25\x43\x49\xf4\xc6\xbe\x69\x33\x2c\xda\x4b\xdf\xda\xe5\x08\x28\xdc\xb3\xe8\xf8\x77\xc8\x33\xb6\x56\x82\x28\x25\x08\xaf\xf8\xad\x83\xd4\x16\x06\x2b\x60\x8b\x3a\x97\x5d\x16\x74\x61\x0b\x3a\x42\x8a\x38\x9a\x8e\xa9\x84\x96\x2a\x81\x4c\x41\xf4\x09\x1a\x02\x0f\x92\x28\x2d\xb7\x4a\x92\x67\x00\x0f\x3f\xef\xcf\x7a\xde\xb8\xb9\xf6\x2d\x43\xb7\x5c\x3a\x63\xd0\xe5\x72\xcf\x3e\xfa\xaf\xde\xe9\xaf\x30\x00\x89\xe7\x99\xc2\x08\x2f\x18\xdc\xfc\x33\x74\xe7\x4e\x28\x1f\x4a\xc4\xc5\xe0\xee\xec\x9c\xa1\xd7\x6b\xa8\x88\xd6\x84\x67\x8e\x09\x1b\x04\x82\xdb\x70\x69\x91\x87\xd8\x4b\x15\x6f\xf9\xb3\x80\x8c\x77\xd8\x52\x0a\xb4\x3b\x86\x48\xdd\xa3\x83\x98\x82\x91\xef\x88\x82\xe0\xf6\xa1\x8d\x78\xc2\xb7\x46\x8f\x20\xfc\x8a\x42\x83\x48\x31\x1e\x1b\xf1\x99\x65\xab\xdd\x8d\xcd\xcb\xda\x34\xb9\xb2\xb6\xaa\x9f\xc1\x77\xc0\xfc\xa0\x3a\xcc\x88\x33\x1e\x83\x5c\x0d\xae\xa2\x4e\xe5\xe2\xda\xc2\x42\xf7\x36\xb2\x0d\xdb\xb7\x49\x55\x93\xe4\xc0\x3f\x8e\x1e\xdb\x67\x33\x90\x9d\x70\x72\xd4\xda\xdd\x6e\x28\xcb\xea\x72\x77\xaa\x98\x55\x37\x10\x0f\xea\xd9\xd3\xc2\x0e\x45\x8f\x41\x17\xad\x40\x36\xd2\xea\x40\x4f\xcd\x2f\xa0\x9b\xe1\xaa\x9a\xbc\x82\x2d\xa8\x05\xf1\xef\xed\x93\xc7\xae\x57\x8e\x5c\x5a\x32\xa5\xb2\x33\x56\x71\x40\xcf\x7a\xb9\x3c\x80\x7f\x30\xe4\x8a\x0a\x5e\x4d\x02\x5c\x4f\x83\xe7\xed\x02\xa4\xd4\x32\x17\x4c\x4d\x1e\xb7\x14\x13\x41\x4a\x02\xa8\x26\x6a\x3c\xc0\xa1\x5b\x9a\xca\x9e\x32\xd2\x6a\x02\x18\xc9\xa0\x15\xa6\xda\xdf\x48\x0a\xe2\x79\xed\x3a\x03\x6a\x5e\x39\x45\xe3\x04\x7f\xbd\xd2\x27\x8e\x61\x27\xde\x56\x95\x96\x67\x69\x74\xa5\x61\x88\x41\x27\xe2\x55\x56\x45\xa5\xcd\xce\x02\x75\x95\x97\x0a\xef\x45\x11\xf3\xbc\x37\x87\x11\x04\x3b\xa0\x86\xf6\xce\x76\xb3\x8a\x31\xed\x7d\xef\xd7\xe4\xfd\xbf\x7b\x4d\xfa\xa0\x2c\x5b\xb0\xfa\xca\xa9\xbd\xef\x1b\x7a\x30\xb5\xf7\xf7\x4f\xcd\x1d\x07\x8e\x9a\xe9\x60\xf9\x1c\x7d\xd8\xcc\xd6\x19\x80\x4b\x0c\x2f\x11\xf3\xc7\xc6\x94\x7e\x1c\xed\xef\xa2\xa3\x8a\x83\x68\xe4\x48\xca\x2a\x5e\x78\x38\xb0\x22\x22\x65\x99\x23\x63\x1b\xa2\x45\xc8\x40\x68\x19\x13\xb2\xcc\x11\xb2\x0d\xb1\x02\x17\x23\xa4\x15\xe0\x1a\x07\x9b\x10\x16\x04\xbc\x18\xce\xcc\x46\xd1\x8f\xf4\xce\x8c\x17\x96\x0e\xe2\x7c\xe5\x5c\x67\x95\x63\x33\x9e\x8f\xc7\xc0\x6b\xff\x54\x93\x86\xcd\xd8\xc2\x21\xe7\xcc\xa0\x4e\x92\x00\x52\xcd\x74\x1b\x58\x84\xfd\xe9\xb4\x72\x58\xe4\x34\x96\x10\x0f\x27\xa7\x76\x26\x21\x45\x9c\xf5\x52\xc4\x19\x36\xb6\x5c\xda\xa7\xb8\x01\x57\x61\xe5\xbe\xb7\xf5\xec\x1b\x36\xf3\x6d\xaf\xb2\x09\x69\x78\xc5\x20\x69\x8f\x87\x35\xec\xdc\xc6\xef\xdc\x26\xdc\xfc\xa1\xdd\x50\x4e\xd3\xa6\x55\x40\xd3\x2a\x2a\x09\x9a\xc5\xdb\x3d\xa6\xff\x41\x28\xe3\xcc\x40\xaf\x8f\xf8\x79\x57\x85\x63\xe9\x59\x4a\x9b\xc1\xc7\xce\xab\x64\x25\x2f\xdb\x8e\x11\xae\x2f\xcd\x48\x96\x4c\xba\x75\xc2\xaa\xf4\xee\x6b\x9a\x01\xc3\x3a\xef\x5f\x11\x79\x57\x94\xbd\xeb\x63\xd1\x7d\xb9\xb4\x4f\x41\xc6\x8a\x71\xe9\xde\x33\x07\x03\x30\x78\x02\x3f\xe2\x95\x6b\xd0\x7e\xe8\xea\x46\x62\x75\xb7\x09\x5f\xe2\x25\xd8\x9e\xfc\x7f\x8b\x07\x0e\xee\x06\x1b\xe7\x70\xcf\x54\x96\x6f\x4f\xe3\x3b\x0c\xb2\x21\x7e\x45\xbc\x90\x07\x29\x5b\xa8\xc0\x43\xc5\x47\x5f\x86\x03\xfd\x2a\xad\xc6\xa2\x6d\x01\x1b\x70\x7f\xd0\x76\x54\x10\xb1\x62\xa5\x61\x19\x24\x1a\x7b\xa1\xed\x06\xb0\x5a\x49\xb2\x59\x67\x60\xd5\x6a\xa4\xd0\xb7\x97\xce\x7e\xa0\xd2\xa9\x73\x92\xe4\x39\xd1\x51\x8b\x9e\xc2\xe1\x04\x2e\xc7\x60\x0c\xa6\x6d\x79\x32\x3e\x1c\x67\x47\xa5\xb5\xd7\xca\x1e\xf2\x1d\x1b\x9d\xbf\xfc\x98\x7d\x62\x60\x3e\x99\x3d\x1c\x85\x36\x38\x05\xd8\xe0\x48\xd6\xdc\x6f\x83\x53\x4c\x9e\x66\x58\x2b\x34\xb3\x29\x26\xdf\x99\xd2\x63\x30\x74\x64\x3a\x4b\x0f\xae\xa0\x88\x6d\x52\x7e\xd0\x43\xee\xb7\x45\xf9\x95\xc8\x35\x66\x52\x70\xca\x46\x86\x52\xdb\x81\x9d\x14\xbb\xe7\x5d\x6c\x6f\x22\x5a\xf6\x26\x62\xf2\x27\x91\xb1\x09\x89\x00\xcd\x44\x0a\xf6\x9e\x61\x31\xea\xc2\xdb\x26\x28\xdd\xcf\xbb\x16\x28\x69\x4f\x1d\xfb\x38\xf9\xf8\x29\xdd\xdc\x84\x1e\xe9\x6a\x15\x5d\xb3\x0f\xd2\xaf\x33\xd9\xde\xf1\x82\x70\x60\x16\x48\x50\xd7\xdb\x6a\xb4\x51\x19\x37\x85\xbb\x4b\xab\xb0\x0b\x86\xed\xe0\x0d\x38\x49\xfc\x33\x04\x0a\x39\x86\xb8\x90\x11\xf9\xa2\xee\x72\xdc\xb9\xab\xc3\x1c\xc0\x6c\xc4\xce\xc3\x8e\x42\x2f\xd3\x04\xb8\xf6\x94\x00\x43\xce\xbc\x63\xd4\x22\xd7\xca\x0e\x47\xd8\xa4\xf3\x56\x93\x78\xca\xba\xcb\x09\x2d\x43\x0f\x79\x56\x4d\x88\x26\x29\x80\xd0\x7d\xb7\xc5\x12\x32\xa6\x5b\xd3\xaa\x49\xf4\x0b\x6c\x4c\x75\x7a\xae\x4d\xb6\x29\x2b\x09\x36\x4d\xc5\xc5\x65\x55\xab\x4c\xaa\x4d\x9a\x96\x36\x7b\x17\x37\x6f\x69\x4a\xe2\xae\x43\x03\x10\xe8\xa1\xc1\x51\x64\x28\x0a\x34\x1a\x72\x9c\x11\x04\xba\x6d\x1b\x7a\xb9\xfe\xe9\x24\xb3\x9d\x61\x3c\x79\xd3\x94\x1b\xc1\x5b\x11\x0c\x36\xa3\x9e\x35\x3a\x70\x60\x11\xbd\x38\x50\x4d\x36\x37\xd3\xd6\x30\xdd\x81\xed\x7d\xfe\xdc\x30\x45\x9f\x50\xa5\xeb\x52\x1f\x84\xa8\x84\xdc\x3d\xc8\x8f\x59\xc2\xce\x2c\xb5\xd7\x99\x58\xca\xe8\x08\x72\xfd\xe0\xc9\x65\x3f\x0d\x3e\x10\xed\x93\xcb\xba\x06\xca\x9e\xa3\x4b\xf4\x1e\x5d\xbd\x8e\x81\xf2\x7f\xe0\x18\x18\x1c\x31\xaf\xe1\x4e\xdb\xd6\x01\x75\xde\x7c\x9d\xcc\xfa\x75\x24\x77\x68\x9d\x1e\x08\x2a\xed\x12\xdc\x3e\x47\x9c\xf3\xaf\x5b\x74\x0b\x10\x09\x54\x2b\x7c\xc1\xa5\xb8\xde\xf8\x5e\x7b\x6e\x75\xb2\x6d\xa2\x92\xa8\xe6\x97\x55\x10\xb1\x1c\x8e\xe2\xb1\x04\x6c\x35\x8e\x2e\x72\x3b\x9b\x4e\x51\x36\x35\x17\x92\xd4\xac\xa6\x34\x52\xd7\x3c\x75\x4c\xb4\xe4\x15\x48\x10\x51\x8d\x0c\xb8\xc5\x77\xc1\xc7\x26\xbb\x05\xeb\x63\x7e\x87\xb2\xda\x20\xca\x74\x6d\x75\x4b\x96\xa4\x49\x9d\x6b\x16\x0c\x8a\xb8\x1b\x28\xbd\xbb\xcc\x97\x4b\x72\x99\x03\xdd\x38\xcd\x79\x4d\x19\x6a\xb9\x56\x2c\x08\x35\xfc\xfc\xde\x71\x60\x77\x46\x57\xb5\x36\x6e\x48\x9c\x23\xd7\x11\x12\x17\xe6\xdf\x8e\xd6\x4f\xca\x56\xf6\xb9\x09\x70\x6c\xac\x26\x90\xd3\x0f\xba\xcd\xa2\xb5\xb2\x33\x75\xb4\xa1\xa7\xc3\x2c\x92\x4a\x3f\x03\xf5\x12\x2c\x6d\x2f\x70\x7a\x02\xa3\xd4\xde\x92\x6b\x9e\x4f\xe6\xb9\x46\x83\x77\x42\x91\x8f\x6a\x5e\x80\xf7\xc3\x3c\x37\xcb\x3a\x2f\x1a\x07\x4c\xeb\x54\x6e\x15\x85\x50\xb5\x3b\x0c\x82\x20\x60\x77\xee\xd5\x3b\xad\x87\xc4\xf9\xc8\x89\x4c\x37\x37\x57\x90\x90\x38\x58\x9b\x1f\x6a\x07\x1f\xf2\xa0\x49\xc8\xaf\xcd\xf2\x9b\x86\x42\x54\x85\xdf\x9b\x89\x4b\x4b\x33\xda\x22\x70\x12\xfc\x6b\x34\x5c\x0e\x69\x0a\x16\x61\xd7\xf9\xe4\x3a\x4f\xaf\x73\xde\x5f\xc7\x77\xf0\xbd\x5b\x7c\x70\xa1\xd4\x01\x07\xb4\x26\x48\xdb\xb5\x39\x4b\x2c\xd7\xca\x2e\x3a\x60\xfe\x09\xfa\x04\xfc\x62\x2f\xf1\xd1\x94\x0f\xc1\xa0\xb1\xf2\x23\xdb\xf5\xb9\x73\x76\x00\x47\x60\x12\xbf\x36\x66\xfc\xf6\x1b\xe9\xd2\x56\x03\x18\xa8\xe0\xdf\x41\xc0\x95\x21\xc4\xad\x36\x7a\xcf\x57\xcd\x72\xb9\x2f\x76\xd9\xce\xfe\x10\x89\x78\xec\x3a\xbc\x71\x78\x98\x0a\x3f\xd3\xdd\x80\x2d\xda\x38\x7c\x9c\x62\x83\xa3\xfd\x21\x1b\x0d\x23\xaf\x91\x8d\x43\x14\x4e\x1f\x1e\xd8\x5a\xae\x9f\xb0\x16\xa8\x05\x76\xf0\x3b\x64\x5a\xd2\x98\x44\xed\xee\x1c\x04\x69\x29\x91\x5c\xe7\x49\xa2\x29\x70\x9e\x24\x5b\x5b\x22\xcc\x3d\xf0\xad\xd7\x5f\xed\x0f\x8f\x4e\x72\x64\x56\x4e\x72\x3e\x64\x37\x98\xc3\xdc\x51\x3f\xad\xc2\xf7\x10\x22\x82\xff\x82\x88\x67\x85\x84\x7a\x35\xfc\xfc\xe1\xc6\x31\x01\x48\xff\x86\x90\x4e\x92\x10\x79\x38\x00\x7f\xf2\x1b\x50\xd1\x94\xfc\x09\xff\xd8\x03\x88\x78\xf4\x7b\x93\x24\x2f\x14\xa1\x34\x35\x85\x64\x2f\x79\xd0\x80\x2a\xec\xf7\x66\xb9\x3c\xd4\x72\xb1\x24\x39\x3c\x84\xff\xcb\xa5\xdd\x26\xb7\xf9\xe4\xd6\x6c\x93\x57\xd9\x25\xf9\xa8\x53\x09\x7c\xa2\x29\x8a\xba\xc1\xbe\x4f\xf2\xdb\x1c\xe8\x06\x38\x2e\x2f\x97\xf2\x09\x84\x01\xbf\xcd\x81\xa7\x30\x93\x08\x28\x22\xce\xeb\xae\x6d\x79\xf5\x1f\x8d\xe6\xe0\x08\x1c\x1e\x61\xe8\x6f\x8e\x00\x07\x21\x4d\x92\xb8\x33\x9f\x56\x11\x49\xd2\xc8\xe8\x4e\x56\x90\xb8\xe0\x89\xca\x6b\x3f\x26\x56\xf7\xd9\xea\xe8\xe1\xf4\xbe\xe1\x68\x58\x69\xa7\xb1\xee\x63\x79\xdf\xc7\x30\x6e\x3c\x94\xcd\xf0\x6b\x3d\xfc\xbb\x8a\xd7\x6d\xf1\xe2\xaa\x76\x55\x5b\x18\xac\x23\xb3\x82\x2d\x2a\x3c\xfc\x08\x07\x35\xd3\xa6\xa9\x7f\x34\x49\xf2\x13\x44\x65\x91\x10\xfe\xef\x19\x3c\x29\x4a\x59\xe5\x57\xf2\xaf\xe8\x8c\xd6\x42\x79\x18\xa6\x98\x86\xde\xda\xf6\x5a\x6c\xa4\xa9\x20\x0f\xd7\x02\xfd\x9f\x8c\xc0\x1e\x6a\xb2\xc1\x9b\x2a\x8a\xd6\xae\xdc\x92\x43\x9b\x3f\x15\xf2\x0c\x9b\xc4\xf7\x56\x67\xf2\x42\x56\xd7\x12\x1b\x7a\x29\xae\x44\x09\x8e\x73\x4f\xc0\xd7\x4a\xb0\x9d\x27\x70\x61\x84\x7b\x8b\x98\x0c\xd3\x60\x57\x6a\xb4\xc7\xac\x9d\xdd\x51\x53\xe1\x4e\x91\x16\xc8\xfd\xce\x63\xbe\xce\x4f\x75\x51\xd5\x85\xba\xe5\x87\x87\x41\xa9\xe6\x2d\xbe\x51\xe4\xb7\x3a\x64\x1d\x90\x37\x45\x78\x69\xd8\x31\xc9\xe3\xaf\x90\xda\x72\xe5\x5c\x9f\x61\x71\xe2\x86\x51\x91\xb1\x66\x98\xc3\xde\xd1\x21\x49\x45\x96\x06\xce\x1b\xe8\xa9\x45\x4c\x6a\x7e\x78\x98\x82\xed\x8a\x5a\x2e\x77\x6c\xc9\x3e\x64\x24\x1a\x0d\xff\xcb\x57\x1e\x6d\x29\xba\xd5\x2a\xa9\x21\x4c\xda\xf0\x09\xaf\x27\x87\x87\xe9\xce\xbe\x79\x7a\x9c\xee\xdb\xc7\x47\xe9\xe1\x7e\x70\x85\x41\x7e\xa1\x3b\x52\x18\xd5\xda\xa9\x69\x4d\x6a\xf5\x84\xd7\x88\x27\x63\x08\xaf\xfe\x73\x91\x24\x7f\x15\x44\xd2\xd5\xda\xef\x54\x1f\x48\x6a\xa6\x82\xc3\x44\xcf\xb6\x67\xc5\xd2\x5f\x15\xa9\xd9\xef\x71\x29\xbb\xc3\x23\x2b\xed\xc2\x05\x4e\xdf\x15\x0d\x3a\x84\x55\xe5\x6a\x15\xd0\xbf\xdf\xfd\xb1\x70\x0d\x47\x81\xdf\x20\x7a\x61\xd8\x67\xb3\x19\x98\x46\x54\x16\xb0\xb4\x1a\x65\xec\xb6\x92\x68\x17\x11\xf7\xc4\x3a\xfc\x43\xeb\x86\xbc\xbb\x03\x11\xf2\x8b\x19\x29\x2a\x02\x62\x5f\x73\x80\x49\x7d\x80\x2d\x97\x1f\x60\x68\x5e\x88\xa6\x72\xcb\xd9\x3d\x68\xc6\x0f\x9a\x25\xff\xb5\x71\x3e\xa9\x19\xff\x19\xb0\x68\xac\x2d\x7d\x44\x45\xf0\x2c\xf5\xc6\x3e\xdf\x21\x13\x5a\xcc\xc8\x1f\x60\x20\xfe\x00\x24\x90\x7f\x39\x0b\x3d\x9e\x19\x2a\xf3\xc1\xe6\x6f\x56\xbc\xca\x99\x1d\x00\xd8\xb6\xc3\x7f\x03\x83\x50\x0f\xa3\x5c\xce\x69\xe0\x1b\x67\x85\x2c\x9a\xb9\x98\xfe\x56\xd5\xe7\xb0\x95\x8c\x45\x51\x40\x95\x7d\x95\x16\x52\x48\x56\xf1\x3a\x67\x02\xcf\x61\xcb\x64\xbc\x68\x0c\xaf\xf0\xa1\x69\x9f\xfd\x7b\xfb\xf6\x5e\xff\x5d\x93\xea\x35\xda\x39\x92\x93\x9d\x34\xd6\xab\xfe\xdc\x80\xa0\xc2\x0e\xbf\x42\x02\xf3\x4e\x67\x9e\x99\x1a\x12\xc3\x24\xd2\xda\x35\x44\x8c\xd7\x95\x76\x07\x8d\xb0\x33\xcb\x93\x64\x34\x3c\x22\x19\x5f\xe4\x0f\x67\xb9\xc6\x33\x63\x06\x9f\xe3\x22\x95\x5d\x72\x89\xc4\xa4\x5c\x2e\xcb\x27\xb0\xeb\xda\x15\xb8\xb4\x00\xc7\xc5\x5b\x21\x72\x91\xd2\x20\x1b\x4d\x92\x72\xe0\xae\x87\x16\xf5\xaa\x24\xa9\x06\xbc\xb7\x41\x74\x19\x5c\x09\xcb\xd5\x7d\x9f\xc9\x69\x29\xf8\x9b\x92\x54\xd1\x3d\x0a\xfc\x33\xb1\x6e\x55\xc5\xde\x56\x1b\x7f\xfc\xfb\x60\x98\x83\xa3\x04\x10\x54\xed\x61\x12\x0f\x96\x2e\x97\x19\x80\xe5\x2b\xe0\x62\xc1\x92\x39\xb0\x64\xff\x08\x2c\x11\xcd\x05\x59\x67\x3e\xa9\xda\xc4\xb5\xcc\x0d\x15\x09\xf2\x51\xea\xf5\x9f\x54\x7c\x98\x92\x4e\xf5\x2c\xa7\x5b\xc0\xce\x66\x68\x13\xdb\x7e\x2f\xe9\x56\x06\xe9\xe3\xb7\x2a\x36\x7c\x02\x58\x57\x41\xd4\xf5\x8a\x93\xd1\xce\xf0\x49\x35\x19\xed\x0c\xd3\xbd\xc7\xf0\xb4\xf7\x78\x98\x8e\x86\xfa\x11\xfe\xa5\xa3\x43\x53\xe1\x70\x67\x98\xee\x8a\xdd\x27\xd5\x64\x57\xec\xa6\x7b\xbb\xba\x14\xfe\xa5\xa3\xc3\x83\xe1\x7f\xfd\xd2\x90\xea\x5f\xf0\x44\xe9\x56\xc5\xe4\x91\xe9\x42\x6a\xfc\x3d\xaa\xe8\xdd\x57\x60\x82\x15\x71\x76\x31\xe1\x47\x8d\x09\x6e\x36\x90\x0e\x25\xf3\x5e\x30\x45\x0e\x12\x96\x2c\xc7\x28\x98\x85\xbe\x61\x54\x7c\xb8\x2c\xb6\x4f\x17\xcd\xed\xab\x42\x3e\x5f\x98\x1d\xff\xaa\x61\xc3\x27\xbc\x32\x20\xcc\x5c\x8d\xe7\xa2\xcc\x6e\x5f\x41\x7c\x46\x80\xdd\x16\x69\x81\xae\xa4\x5b\x04\xaa\xc6\x17\x14\x60\x81\x78\x79\xc4\xb3\xc9\x30\xcd\x1e\x56\x5b\xa5\x9d\xa9\x45\xd8\xbf\x33\xe3\x68\xca\xeb\x2e\x1d\x87\x54\x47\x02\x34\xb4\xb0\x75\xb2\x04\xfc\x55\xeb\x94\x5a\xf5\x27\xee\xfe\xed\x4b\x3c\x9b\x02\x3f\x1c\xb0\xa7\x0b\x10\xf0\x1f\x1e\x2b\x2a\x3c\x56\x54\xe7\x58\x91\x3d\xc7\x4a\x15\x1e\x2b\x0f\xea\xaf\x3a\x56\x64\x78\xac\x54\xad\x63\x45\xba\x63\x45\xe1\xb1\xe2\x8f\xd6\xe0\x46\xaa\xf2\xd6\x8c\x76\x0e\x20\xa3\xe8\xff\xe4\x84\x51\xfe\x70\x81\x45\x36\x9d\xf6\x2f\xca\xaf\xb5\x4f\x29\x0d\x29\x64\x10\x40\x82\xdf\xe6\xe3\x5b\x6c\x44\x74\x65\x54\x00\x88\xbb\xcf\x15\x84\xce\xd3\x73\x52\x20\x57\x80\xcb\x5d\xc0\x6d\x7c\x83\xdc\x46\x04\xf0\x91\x36\x48\xc2\xa1\x08\xa2\x7c\x02\x36\xf2\xa0\xe1\x92\x06\xf7\xc4\xa0\xa9\x17\xdd\xa6\x12\xbe\xb5\xc3\x60\x09\x7f\x6b\xfe\x51\x93\xb8\x30\x77\x2d\x38\xdb\x09\xf7\x03\x76\xe8\xae\x07\xd1\x46\x83\xd5\xdc\x1a\x39\x9e\x39\xde\x84\x5b\x23\xf6\x53\x19\x1a\x71\xab\x9c\x62\x04\xb2\x1c\xaf\x45\xee\x56\x39\xb6\xa2\x3f\x69\x95\xd4\x78\xad\xb2\xaa\x33\x88\x51\x77\x7b\x89\xc2\x58\xf4\xb7\x01\xeb\x87\xc6\x36\x5e\x07\xa6\x0a\x35\xfa\x09\x04\x64\x6d\x37\xed\x9a\xfa\x87\x64\x4f\x87\xc7\xa9\xa3\xa2\x3d\xfd\x49\x58\x32\x42\x5d\xf8\xe8\x30\x35\xbe\x76\xd1\xcb\x61\xfa\xa3\x22\x35\x0d\x8d\x66\x44\x0e\xe1\x2c\x72\x1d\x67\xca\xe2\xb1\x95\x02\xe6\x5c\xc1\xbe\x79\xd1\xb0\x0a\xd1\xad\xcc\x79\x16\x08\x96\x76\x59\x81\x2f\x1a\xe0\x59\xf3\x3c\xca\x32\x84\x9b\xf2\xce\xea\xfe\x01\x15\xec\x06\x8d\x93\xc5\xff\xd4\x50\xbb\xd5\x25\x6f\xe7\x05\xef\x59\x01\xcc\xfc\xeb\xe1\x4a\x6a\x6b\xeb\x65\x1d\x72\xac\x89\xae\x49\xe1\xdf\x9f\x6e\xde\x31\x8f\xce\xf9\xd4\x2d\xbb\x25\x9f\x75\xce\x3f\xe8\xe9\x2b\x00\x12\xd4\x1a\x8b\xd4\x5e\x54\x58\xe6\x3f\x00\x0b\x88\x1c\x22\xf6\x03\xc2\x29\x2e\x73\x56\x7a\xdb\x9e\x25\xdf\x19\xee\x3d\x66\x65\x64\xb5\x16\x05\x47\x0c\x75\x9d\x45\x92\x74\xa2\xf3\x17\xbd\x22\xcf\x62\x5b\xcd\x05\x5e\x9c\x1a\x5e\x58\xa9\xdc\x4e\x52\x62\x04\x3e\xfd\x06\x14\xfc\x8e\x32\x8d\x73\x70\x0b\x0a\xc5\xe3\x79\xf8\x8b\xf9\xe4\x3e\x5a\xa1\xc1\xf3\xf8\x37\x6b\xbb\x97\x75\x52\x89\xd3\xb4\xd5\x3e\x2a\x68\xe2\x66\xa1\x90\xae\x60\x74\x0b\xde\x71\x82\x65\x33\x9f\xab\x7c\x0a\x93\x9a\xf2\x11\xc8\x31\x8c\x65\x85\x2e\xbe\xe4\xb3\x78\x60\x01\xd5\xbe\xa4\x53\xab\x84\xbe\x0c\x14\x2f\xfe\xae\x7b\x1a\x7c\x6c\x34\x3c\x53\xaf\x82\x3a\x0d\x3c\x97\x89\x76\xe8\x3d\xbd\xd7\x85\x77\xb0\xa0\x2b\x38\x77\xa6\x66\x19\xe6\x7c\x16\xce\xde\x0e\x8b\x73\x3e\x37\x15\x2e\xac\x60\x79\x7c\xa1\xe5\xc9\x0d\x65\xd1\x17\xfc\xc2\xd8\x2f\xcc\xf1\xad\x5f\xd4\x99\x5d\x54\xb0\x1d\x09\x91\xeb\x60\x2f\x44\x36\xa0\xbe\x87\x8f\x47\x6c\x64\xad\x0c\x02\xf3\xf0\xd0\xe9\x4d\xbf\xe3\xa3\x47\x1e\x2e\x67\xa0\x03\x08\x36\x37\x7c\x44\xc7\x67\xba\xde\x0e\xcb\x24\x29\xd9\x19\x5d\x75\x30\xc0\x7f\x11\x58\xda\xa0\x75\x7f\xc9\x8d\xd8\xe6\x8a\x57\xdb\x97\x85\x3c\x7b\x96\xe5\xf3\x10\x28\x57\x13\x12\xbd\xd2\xc0\xf9\xb6\x61\x85\x85\x12\xbb\xd2\xe2\xc2\x06\x02\x38\x60\x14\x0b\x60\xdc\x0b\x7e\xa5\x05\x8b\x0d\x05\xa7\xe6\xde\xda\x6c\x50\x68\x95\x0c\x78\x2a\x14\x1a\x98\xa5\x91\x1a\xde\xf2\x59\xc8\x79\x55\xac\x61\x25\x1d\x37\x7a\x2f\x91\x5b\x76\x4b\x57\x11\x74\xc1\xc9\x89\xcd\xda\x93\x56\x6e\xae\x81\xdd\x0d\xfa\x03\xa0\x51\x10\x1d\x17\xdc\x30\x0e\xe4\x19\x29\xf5\xe1\x00\xc9\x2c\x9e\x6e\xbc\x05\x2b\xb6\x0d\xa7\x0b\xd8\xa4\x0f\x37\x37\x1a\x7b\x91\xd9\xd0\x8d\x6c\x38\xf7\x36\xb6\x71\xba\x50\x1b\xb2\xda\xb0\x68\xb9\xf1\xcb\x0f\x1b\xd7\x59\xb3\xd1\x5c\x8a\xbc\x98\x15\x62\xba\xfd\xdf\xf2\xbf\xe5\xd3\xe9\x74\x23\xdb\x38\x32\x77\xca\x46\xb8\xda\x7c\x7b\x7b\xfb\x89\xef\x6b\x63\x5e\x9c\xcd\x45\xbd\x51\xc8\x0d\x35\x17\x1b\xaa\x16\x62\x43\x55\x1b\x97\x75\x05\x61\xc2\x36\xb2\x0d\xd0\x3a\x15\xf2\x6c\xa3\x90\xd3\x22\xcf\x54\x55\x6f\x54\xf5\xc6\x65\x99\xe5\x62\x5e\x95\x53\x51\x43\x6d\xd4\xac\x6e\x6f\x3e\xfc\x0c\xee\x9c\x2b\xed\x60\xfd\x23\xfa\xac\x7f\xd7\x50\x56\xf0\xab\x5a\xe7\xf0\xb4\x3b\x19\x4f\x4c\xdc\xc0\x78\xdc\x35\xbc\x60\x5f\x05\xe9\x52\x92\x19\x7b\x5a\x93\x99\x36\x99\xa0\xb1\xf1\xd7\x28\xb5\x59\x93\x4e\xf8\x4c\x03\x99\xdd\xf0\x59\x20\xe8\xc4\xfd\x73\xb0\x97\x04\x9d\x01\xd6\xf4\x10\xd4\x93\xb5\x5a\x2e\x67\x5a\x78\xd3\x4b\x89\x6f\xfa\x95\x4f\x56\x9f\xb4\x5c\x0e\xe6\x3a\xd0\x0b\xb9\xa1\xe0\xff\xfa\xf5\xd3\x7e\xde\x99\xf6\xea\x1e\x8c\x5b\xc1\x21\x55\x11\x95\x07\x46\xe5\x8a\x0b\xaf\x1a\xc6\x0b\x86\x9d\xc0\xc6\xcf\xb5\xb3\x5c\xf7\xec\xb2\x55\xb7\x05\x0c\xf4\xb3\xc6\x9e\x96\x62\xf2\xac\x09\x25\xa7\x7f\x58\x66\xed\x08\x2e\x61\x3b\x47\x10\xc5\x29\xcb\x39\x0a\xa9\x80\x89\x4e\x12\x71\x54\xba\x77\x25\x30\x1c\x45\xce\x43\x13\xd6\x1f\x81\x09\xbe\x13\x4f\x20\x98\x01\x69\xf2\x28\xb7\x1b\xb0\xfb\xde\x5c\x18\xda\xcb\xc7\x54\xe5\x5c\x99\x69\xba\x7a\xa2\xea\xd4\x4b\x92\xc1\x1f\x05\xa1\xbd\xd5\x55\xe5\x6f\x3c\x3f\x34\x61\x0a\x30\x26\x20\x7c\x82\x85\x81\x57\xe4\xff\x84\x4e\x75\xc8\x68\x60\xcc\x0f\xe4\x1b\xc0\x00\x04\x20\x2f\x28\x65\x7f\x7a\xb0\xc1\x50\x98\xf2\xbd\xea\x1a\x77\x2a\xe7\xc2\x9e\x6f\x0a\x58\x08\xd7\x37\x50\x46\x11\x30\x15\x9a\xf0\x0f\xf7\x1e\x27\x2a\x0f\x70\x17\x85\x7c\x17\x60\xc0\xaf\x72\x18\xad\x26\xf6\x32\x5f\x2e\x81\xd3\x55\x79\x9f\x76\xc0\xdb\x6b\x4a\x3e\x64\xb5\xab\x65\xc1\x55\x23\x7b\x05\x5a\x82\x18\x15\x75\xf8\xe5\x9e\x16\xc7\xd5\x13\xa3\x3c\x07\x53\x2a\x7c\xcc\x40\xe4\xeb\x02\x68\xae\xfa\x87\xc2\x65\x60\xd9\xe0\x05\x9d\x76\x24\x22\x49\xdc\xbc\x03\xcf\x52\xea\x37\x54\x64\xfe\xaf\xf9\xfa\x90\xb1\x52\x79\xf8\x3a\xe0\xed\x03\x86\x0b\xdb\xf2\xc2\x7e\xdf\x94\xff\x19\xfa\x79\xb7\x1b\x0d\xeb\xc5\x4d\x53\x36\x3a\x0a\x97\xab\xbf\xab\xc9\xfa\x8e\xd2\xf6\x74\xda\x9d\x51\x8c\x2a\xe7\x81\x48\x14\x3f\xab\x61\x3f\x78\x6d\x49\x30\x00\xe0\x3e\x1f\xb1\x10\xbe\x2d\x88\x45\xed\xe3\x35\xcb\x7d\x6e\x98\xd7\x30\xed\x00\x68\x89\x73\xbb\xc6\x81\x7e\x06\xf0\x3a\xa6\x48\xca\x6f\xa4\x28\xb0\xc9\x8f\xe8\xff\xeb\x77\x46\x1d\xec\xc7\xb6\xba\xcf\xed\x45\x2e\xfa\xd0\x89\xa9\x27\x62\xa2\x42\x9a\x54\x05\x8d\x69\x05\x29\x36\x00\xae\x3b\x87\x2c\x8b\xe4\x2e\x70\xa2\xb4\xc6\x92\x81\xcc\x46\xd1\xbb\x69\x75\x07\x92\x8b\x78\x4a\x67\x39\xb5\xda\xec\x2f\xcb\x3e\xec\x95\x34\xbc\xc7\xb2\x2a\x28\x88\x67\x12\x30\x46\x9d\x78\xc5\x71\x23\xee\xf6\xdf\xdb\x0e\x1f\x6a\x51\xa8\x13\x4c\xb4\xc6\x36\x7a\x04\x63\x6b\xc9\x8c\xb0\x41\x5b\xd6\x69\xb0\x47\x5b\x72\x38\x64\xeb\x24\xad\x43\x0c\xda\x5a\x57\xc4\x24\xfd\x46\x84\xc3\x4a\xba\xcd\x8c\x55\x47\x7d\x62\xdc\x09\xd6\x8d\x0a\xfb\x2a\xae\x53\xf8\xf1\x61\xaa\x5b\xee\xb6\xe2\x31\x3f\x6e\xa8\xda\x1a\x51\x3f\x1a\x2f\xa3\x75\x04\xc1\x17\xf1\x61\x50\x33\x90\x92\x79\xda\xe1\xcb\xf8\xd0\x8b\xbd\x08\xec\x0e\xbc\x2d\xcb\x1c\xde\x8c\x8e\x02\xf7\x0f\x0c\x61\xc6\x65\xb0\x15\x27\x44\xae\x21\x12\x92\x65\x5c\x86\x5b\x98\xa6\x19\x97\x69\xab\xd0\x52\x3e\x97\x8e\x00\x05\x3d\xdf\x34\x9d\x33\xea\xbc\xe4\xa7\x25\x8a\x4c\xff\x14\x46\x11\xf8\xbb\xd0\x81\x44\xc1\xbc\xc7\x04\xe2\x2b\x2a\xa9\x83\x2d\x6c\x16\x72\xa3\xa0\x50\xb9\xe1\x77\x0d\x94\xa4\xc5\x76\x5c\x85\x09\x39\x0d\x0b\x8f\xe5\x74\xa5\x2f\x1a\x1b\x22\xbd\x6b\x38\x69\x78\xd1\x8a\x76\x95\x24\x8d\xb3\x0a\x2d\xc4\xf5\x72\x79\x5d\xc8\x69\x75\x6d\xa3\xe6\x01\x63\xf6\xce\xb6\x96\x24\xf1\x6f\x33\xde\x5c\xc7\xf5\xe1\xf9\x76\x0d\x96\xb9\xcf\xc0\xcb\x8d\xde\x35\x3c\xdf\xce\x64\x3e\xaf\x6a\xc0\x72\x4c\x7b\x69\x8b\xde\xcc\x66\x8d\x50\x6c\xc6\x73\xe3\x5e\xa9\xab\xe4\xf6\x97\x79\xab\x25\x58\xde\x22\x9e\xcd\xdc\xa3\x67\xb1\x9a\xd8\x27\x09\x3a\x99\xf2\x21\xbb\xe4\x5b\x23\x76\x0a\x7f\xe6\x7c\xc8\x2e\xf8\x90\x9d\xf1\x82\x5d\x99\xca\xca\xf9\x44\xd8\x73\xf9\x76\x7c\x06\x01\x9d\x74\x54\x28\xbe\x30\x79\xbe\xcf\x5c\x6f\x60\xcd\xc5\xa7\x0f\x17\x94\x41\xad\x99\xa9\x95\xf7\xd4\x3a\xe5\xd3\x87\x39\x65\xbb\x3c\x2c\x4f\x12\x32\x7d\x88\xbf\xb5\xa5\x23\x1a\x3d\xbb\xd3\x91\xdc\xf2\xb3\x30\x14\xe8\x98\x5e\xf1\x33\x76\xc6\x6f\xc7\x76\x98\xc5\x8c\x9c\x41\xfc\x58\xb4\x0a\xd4\xea\xb7\x2b\xf0\x16\x48\x92\x87\x0f\xe7\x5c\x0f\x19\x86\x48\x19\x94\xce\xa0\xf4\x02\x1c\x14\x92\x84\x9c\xf2\x69\xdc\x51\x98\xc3\x1a\xf5\x25\x67\xfc\x8a\x5d\xf1\xb3\xc0\xdf\x60\x75\xc6\x6f\x57\x0d\xdf\x02\xde\xe6\x72\xb9\xd4\xff\x4f\x31\xe8\x8d\xc1\xba\x4b\x8d\x66\xa7\x2b\x7d\x1c\x6e\x60\xea\xec\x06\x40\x88\x68\x39\xd4\x15\x86\x51\x85\xf1\x71\xc9\xef\xb2\x5c\x15\x57\x36\xa4\xe8\x73\xa1\xe0\x5a\x3a\xd5\x46\x76\x4c\x63\x82\x98\xc2\xbb\xb4\x60\x0e\x83\xdf\x02\x52\xa5\xcd\x8a\x9d\x96\x20\x3b\x9a\xe6\x46\x46\x01\xd8\x51\x56\xa1\x13\xa7\xa7\xe1\xd3\xb6\xf8\x78\x77\x17\x7c\x5c\x16\x15\x99\xe6\x20\x9c\x9d\xe6\x7c\x9a\x07\xdb\x7a\x15\x9f\x34\xd3\x9c\x8e\xc3\x6e\x60\x21\x0a\x2e\x20\x0b\xa1\x3d\xca\xa7\x39\x72\x6d\x27\xd0\x92\x23\x26\xb0\x34\xa3\x83\xe4\x04\xe2\x31\x4e\x73\x7f\x17\xd2\xbe\x79\xa3\x9d\xc7\xc9\x89\xf9\xea\x06\xbe\x8a\x98\x4f\x6c\xf7\xc6\xbc\xbf\xe6\x37\x10\xbc\xcf\xf6\x76\xdd\x7f\x69\xba\x9e\x5c\xeb\x21\xd3\xf4\x3a\x22\x2a\x74\x65\x3d\x13\x47\xc3\x5d\xdd\x27\xc6\x44\x7f\x57\x93\x69\x4e\x59\x38\xe2\x84\x6f\x45\xb6\x5b\x07\xeb\x2a\xb1\x97\x35\x09\x07\xcd\xa6\x79\x4b\xc6\xb9\xb3\x97\xb6\x3e\x82\xd4\xfe\xad\x3a\x8f\xfb\xea\x7c\xa9\xed\xbd\xf4\x0b\x15\x1e\xa7\x0b\x3e\xcd\xd9\x5b\xb8\xfc\x2e\x58\x43\xd9\xb3\x9a\x2c\xe8\xaa\xbb\xce\xff\x19\x64\x01\x59\x27\x3f\x2e\xd9\x8d\x26\xe1\xec\x84\x5f\x6f\x07\xc8\xcc\x1a\x7e\xed\x09\xb2\x46\x67\x76\x33\xe0\xfc\x24\x01\x44\x39\x89\xc9\x71\x92\xfc\x25\x48\xab\x6c\x7b\x8a\x0f\xb8\x6d\xd8\x09\x75\xe9\x1b\x9a\x24\xf9\x5d\x90\x13\xe0\xd1\x6f\x38\x18\x76\x67\xb5\x62\xd7\xbc\xd9\x16\x72\x8a\x21\x1e\xb8\x41\xa0\x6b\x7e\x43\x59\xcf\x81\x72\x32\x21\x27\x7e\x78\xba\x98\xdf\xb0\xa0\xe8\x58\x4e\xf9\xab\x4c\xcd\xb7\x2f\x0a\x49\xae\xd9\xc9\xf6\x55\x48\xc7\x68\x4a\xae\x39\xb9\xe1\xad\x31\x2f\x97\x76\xd0\x34\x49\x6e\xfa\x0e\x18\x76\xdd\x3a\x5a\xc8\x35\x8f\x8b\x08\x65\x0b\x7e\x12\xc6\xb9\xc6\x4e\x59\xe1\x07\x64\xe7\xbc\x80\x58\xb1\x6e\xc2\x7a\xfe\x93\x22\x0d\xaa\x01\x40\x16\x94\x0d\xae\xb7\xc5\x8d\x12\x72\x9a\x24\xc5\x13\xe0\x8a\x17\xbc\x61\x20\x11\x29\xf8\x02\xfa\xfb\x45\x90\x13\x56\x50\x36\x33\x4f\x0d\x65\x8b\x24\x99\x25\x09\x81\x1b\xde\x75\x70\xc4\x2d\x97\xd7\xc1\x01\x07\x11\x5a\x35\xd1\xf7\xc5\xe6\x18\xd3\x2f\x2a\xfd\x08\xaf\xdc\x71\x07\x67\x89\xfb\x20\x38\xf6\x74\xb9\xa9\x6f\x16\xf5\x06\xc3\x51\x6a\xc4\x21\x94\xdd\x80\x5c\x4e\x2f\x13\x59\xe8\x06\x98\x6d\x9f\xb2\x6b\xf4\x03\x7b\x5a\x96\xba\x3a\xe8\xa0\x8b\x27\xcd\x84\x5c\x83\x1c\x4e\x17\x91\x1b\xa8\x66\x40\x40\xcc\x99\xca\x5c\x87\x34\x25\xba\xf9\xe3\x9e\x77\x2c\x6a\x03\xa4\x29\x94\xdd\xf0\x8f\x9f\xf4\x39\x75\xcd\x4f\xc6\xb0\x7c\xfe\x10\x19\x53\x38\x36\xae\x83\x83\xf0\x66\xfb\x72\xd1\xcc\x89\xb3\xac\xbe\x66\xa5\x98\xa9\xf4\x7a\xbb\xc9\xeb\xaa\x2c\x5f\x8a\x99\x62\xaa\xba\x74\x05\xef\xab\xcb\x15\xd5\xad\xf7\xd0\xbe\x13\xb3\xc3\x60\xff\x60\x80\x06\x76\xc2\x87\xe3\x93\xa3\x1b\xc4\x91\xf1\xc9\xc3\x87\xf4\x9a\xdf\x7c\x3c\xf9\x04\xf3\x35\x7d\x06\x5d\xf1\xeb\x6d\xe8\xbe\xf3\xee\x7d\x75\xc9\xaf\xb7\x55\x75\xb9\x82\xf3\x66\x70\x5e\xb2\xe3\x92\x9f\x97\x96\xef\x77\x74\x36\x3a\x88\x60\x90\x27\x5c\x74\x4e\x87\xf3\xce\xe9\xb0\x7b\x90\x9c\x27\xc9\x9b\x9a\x9c\xb0\x36\x41\xd3\x07\xc4\x39\xbd\xbb\x41\x34\xd6\x3c\xd3\x31\xb4\x10\x87\xf9\x39\x36\x67\xc4\x1b\x1e\x9e\x30\x56\x37\x36\xcd\x03\x51\xdf\x7e\x7a\xc3\xdf\x20\x9d\xc4\x1d\x08\x25\xab\x1e\x78\x1e\x4f\x8e\xc9\x0d\x4d\x8f\xdd\x04\x6f\x56\xff\x6b\xe4\x73\x8a\xdc\xf9\x8f\x85\xd1\x20\x63\x44\xb7\x00\xd8\x70\xae\x5e\xe4\xf4\x02\xf4\x5c\xec\x0c\x04\x59\x57\x39\x57\xde\x14\x54\xaf\x85\x6b\x71\x4c\x55\xdc\x27\x8b\x7e\x99\xce\xa6\xb9\x55\x19\x75\x6f\x48\x90\x4e\x09\xc4\x67\x04\xac\xd1\xe1\x40\x65\x5e\xd4\x0e\x6f\x26\x70\xb7\xb8\xc9\x27\x27\xf9\xc3\x87\x29\xb1\x16\xc4\x82\xa6\xfa\xb1\x07\xba\xe7\x79\x92\x9c\xe7\x24\x0c\x13\x50\x59\xbd\xf7\xa5\x05\x20\xf8\x0c\x8c\x98\xe0\xa7\x39\x3b\x45\x88\xd8\x1b\x78\x68\x58\x6c\x18\x31\xf2\xc2\x86\xf9\x0c\x64\x6b\x65\x5b\x14\xe7\x10\x51\xc4\x88\x08\x9a\x9f\x9d\xfd\x83\x04\xa2\x8e\x5c\x77\x8f\x56\x98\x3f\xd9\x1f\xed\x24\x82\x2e\x97\x17\xe0\xcf\x70\xa1\xfd\x19\x7e\x85\x08\x1c\x61\xe8\x0d\xbc\x2a\xc3\x65\x5d\x8f\x65\x45\xfb\x16\xdc\x8d\x0f\xea\xc1\xc9\x7b\x08\x2c\xf4\x95\x53\xab\x1f\x3e\x3a\xba\xca\xc1\x2a\xf1\x2a\xb7\x12\x87\xab\x9c\x1f\x0e\xd9\x6f\x60\x1d\xde\x54\xa1\x8a\xba\xa9\x48\x88\x7d\x67\x39\x5e\xd7\x31\xcf\xbc\xe0\x67\xda\xee\xe4\x0c\x01\xf8\x45\x69\xc1\xee\x08\xa5\x05\x0a\x54\xe8\x80\x4b\x78\x51\x13\x81\x79\x41\x70\xb5\xb3\x90\x15\xa8\x57\x45\x41\x03\x5a\x1d\x69\xa0\x05\x97\x4b\x6a\xad\xe3\xe4\x3d\x8e\xa2\xde\x41\xf4\xbc\x26\xfb\x60\xb3\x72\x6c\xfe\xdb\xfd\xa6\xc2\xfd\x26\xd6\x6d\x37\x90\x9c\x40\x8e\x84\x00\xf4\x4c\x74\xd0\x5e\x70\x97\x02\xf0\x41\xc3\x15\xd3\x68\x34\x18\x7a\x00\xe7\x95\x75\x4e\x50\xa0\x4c\x80\x90\x07\xe0\xb9\xa9\x85\xcd\xc1\x36\xa0\xa0\x96\x0a\xcd\x48\xac\x89\x7c\x50\x85\x26\x89\x46\x71\xdf\x38\x0e\x13\xa6\xe3\xcd\xbb\x75\x8f\xd0\x94\xdf\xcf\x56\x7c\xd3\x35\x05\xc0\x2f\x2d\x3c\x2b\x22\xcd\xa7\xde\xa2\xcb\xbf\xb5\x4a\x6b\x4f\x24\xfb\x3d\x66\xe4\xfd\x7e\x36\xcb\x65\xcf\x37\xf5\xd7\xea\x1d\x6a\x50\x3b\x08\x00\xa5\x02\x72\x28\xf8\x73\x00\x6a\x04\x27\x00\xa5\x84\x97\x12\x40\x28\xa3\x57\x76\xea\x1a\x94\xce\x02\x2e\x34\x24\xf0\xd0\x9d\xb9\xa5\xb3\xe6\xf5\x5e\xf3\x87\xed\xd4\x49\x52\x6f\x4f\x45\x29\x14\x18\x85\x80\x49\x8c\x16\xfb\x4a\xf8\x2f\x27\x68\x68\xbe\x5c\xea\x87\x9f\x9b\x24\xf1\x43\x41\xab\x47\x6d\x9a\xb5\xc8\x8f\x66\xf9\x44\x5b\x8b\xc8\x9c\xa6\x60\x7e\x30\x4c\xb5\xd5\xb8\x04\x9e\x05\x76\x37\xb1\xc2\x4d\x2f\xc0\xa1\x49\xa2\x8e\xe4\x72\xd9\x95\xec\x48\x43\x0a\x43\x87\xb3\x4b\xc4\x14\x8b\x09\x7e\x0d\x3d\x44\x64\x30\x13\xc5\x87\x8e\x6a\xa3\xe5\xae\xe2\xdf\x6b\xa0\x6b\x0c\xa5\x1d\x44\x55\x3d\xf8\x79\x5a\xe1\xe1\xb6\xb9\x90\x53\x31\x2b\xa4\x98\xfa\x25\x3f\x39\x79\x7b\xfc\xf4\xd9\xfb\x93\xe7\xc7\xbf\xbe\x7f\xf3\xe6\xe5\xbb\x93\xef\x5e\xbe\xf9\xe6\xe9\xcb\x93\xef\xdf\xbc\x79\x71\x72\x12\xd3\x21\xc5\xef\xaf\x0d\xf4\x42\x6d\x17\xcd\xf3\xa2\x81\x10\xd5\xd3\xe5\x72\xa0\xb6\x21\x24\x7f\x55\xab\xe6\xdb\xe2\x54\x58\xab\xea\xc1\x70\xec\xc9\x0c\x84\xef\x80\x8c\x19\x44\xd0\xf1\x79\xee\xfd\xaa\x84\x8d\x3f\x58\xc9\x67\xd5\xc5\x45\xa1\x74\x0b\x10\xae\x43\xa3\x1a\xaa\x84\x0f\xf6\x38\x06\x5f\xb6\x74\x2d\xa0\x53\xfe\x68\x5f\xad\xd8\xf1\x17\xdb\xb6\xb1\x0a\x01\x6d\xc3\x4f\x83\x67\x3b\x7e\x0f\xdd\x39\xe2\x27\xab\x21\x96\x62\xd1\xc0\x2e\x05\x23\x98\x79\xd1\x6c\x9f\x8b\x5b\x88\x42\x02\x8f\x28\xdf\xe6\xba\x8a\x56\x7f\x98\x47\xf4\x7b\xd4\xcf\x0e\x1d\xcc\x2b\xd8\x94\xe6\x09\x99\x39\x10\xbd\xa0\x12\x08\x4a\x0b\x39\x15\x37\x60\xdc\x0d\x3f\x6a\x31\x0b\x5e\x85\x5a\x25\xae\x4c\x8d\x28\x10\x92\x2e\xb1\xba\x28\x63\x44\xa1\x8b\x42\xd3\x81\xa8\x4e\x10\xe0\xc8\x94\xc3\x30\x6b\xd3\xb2\x83\xb8\x1d\x8c\x17\x33\x9a\xf1\x07\x47\x8d\x29\x68\x13\x70\x0f\x95\x96\xa4\x58\xbf\x88\xe5\xf7\xb6\x13\xc7\x66\xb6\x82\x3b\x5e\x04\x0b\x82\x87\x82\x14\xd7\xe1\x3a\xf9\xba\x67\x95\x8f\xc0\x34\x20\x03\x1d\xb7\xe5\xb2\xae\x54\x85\xea\xf5\x81\xd8\x2e\x1a\xad\x60\x7f\x66\x09\x63\xf0\xf5\x95\xdb\x58\x5d\x52\xea\xc2\x00\xeb\x2e\x26\xa3\x74\x18\xc5\xed\x72\x61\x05\x30\x99\xb5\xee\xf9\xc1\x03\xe8\xb6\x9a\x81\x21\xdb\xb3\xcc\x36\x30\xd2\xa9\xbc\x81\x39\x7b\xe5\xcb\xf6\xec\x79\xb7\xe3\x87\x73\xdb\xa2\x2e\x0e\x40\x96\xff\x40\x42\x2e\x27\x44\x72\x80\x92\x0e\x23\x03\x9e\x0b\xe7\xe2\x96\x09\xbd\xa4\xe0\xad\x19\x62\x9b\x08\x7f\x31\x73\xa6\x80\x51\x1c\x28\xc6\x83\x33\x28\xa4\x65\x2c\x88\xff\x06\x1e\xc5\xc1\x2f\x49\x53\x22\x23\x95\x27\x78\xaa\x47\xf8\x23\x3b\xb8\x11\x09\xa1\x6d\x51\x80\x61\x68\xa2\xd5\x8b\x3e\xfd\x1a\x9e\x8e\xef\x56\x5b\x45\x64\x5b\xb3\x1a\x22\x26\x3b\x7a\xdb\xe8\x77\xf0\xfe\x5d\x6f\x50\xd4\x28\xa0\x60\xec\x6b\xcc\x54\x3b\x40\xb7\xec\x0d\xd0\xad\xfa\x03\x74\xb7\xe3\x39\xb3\x30\x22\x77\xaa\xb6\xc3\x9f\xcc\xc7\xe4\x4e\x55\x18\xa0\x3b\x08\x73\xec\xbc\xae\x99\x44\x02\x23\xcc\x7f\x9d\x70\x72\xa6\xbd\xdb\x66\xa1\x63\xe9\x89\xdd\x57\x3a\x70\x8f\x8d\x78\xa1\x7d\x34\x2b\xde\x1b\x14\x40\x50\xbd\x27\xb4\xa5\x4d\x10\x58\xbf\x93\x34\x46\xd0\x82\xef\x5b\x31\x7f\x1c\xd9\x62\xe3\x26\x43\x0f\xef\x0d\x48\xeb\xee\xb3\xf7\x66\xac\x74\x01\x48\x7e\xca\xd2\x82\x3f\x66\xd9\x92\x3f\x0a\xc5\x6a\xd7\xae\x38\x8a\x5f\x76\xee\x5a\x14\xb0\x39\x46\x3b\x0c\x22\x44\x3e\x5e\x66\x94\x45\x9b\x80\x9f\x67\xcc\x6c\x00\xf3\x14\x83\x9f\x97\x36\x0a\xf2\xe7\x56\x7b\xbb\xba\x3d\xdd\x9a\xfe\xf8\x73\xd6\x6a\xf7\xf3\x7d\xad\xbd\x6b\xb5\x76\xe8\x5b\x0b\xdb\x78\xb7\xa6\x0d\x7b\x4f\x06\x30\xb7\x8d\x01\x43\x52\x64\xa1\xec\x49\x91\xe1\xee\x8f\x01\x66\x23\x17\xfb\xc8\xcc\xf0\x0d\x14\x1e\xc6\x65\xcf\xa0\x6c\xd4\x8a\xa8\xf4\x4a\x17\xee\xc5\x85\xef\x75\xe1\x81\xcd\xca\x1b\xbd\x7b\x0b\xef\x76\xd0\x51\x16\x22\xd6\x45\x97\x83\xd1\xee\x10\x2d\x25\xc4\x44\xa4\x76\x16\x6c\x73\xd3\x3b\xce\x2a\x00\x52\x61\x61\x14\xc7\xce\x85\x7c\x6b\xf0\x11\x87\x18\xa3\x1d\x58\x05\xe6\x15\x37\xfe\xc4\xb0\x77\x42\x8d\x1a\x8f\x98\x60\x35\x5c\x12\x3a\xa0\x96\xa1\x6b\xee\x35\x7e\x1e\x7f\x7c\x80\xac\xdb\x97\xbf\x3f\x6f\x7f\xaf\x27\xb5\x67\x39\x3e\x24\x4d\xb5\x90\x13\xff\x98\x7e\xfc\x84\xe4\x5c\xf5\x84\x7a\x97\x51\xa6\x97\xbb\x28\xa2\x48\xda\x0e\x90\x84\x54\xfa\x99\x6d\x19\xba\x65\xc5\xc5\xa5\xc1\x36\xdd\x68\x2a\xb6\xe3\x82\x55\x68\x9d\x72\xec\xc6\xef\xd8\x22\xe4\x44\x90\x53\x0b\x8f\xfe\xb0\x6b\xcb\x3d\x79\x33\xbe\x90\xa7\xb1\x03\x0a\xbe\xee\x57\x7f\xf3\x61\xfc\xd6\x2b\xba\xf5\x47\x1d\xdb\xed\xa8\x13\xcc\x46\xea\x06\x67\x53\x93\x9a\x26\xd1\x08\xd4\xf2\x76\x5d\xcd\x77\x54\x1c\x6a\xb8\x3d\x7b\x14\xe8\x75\x5d\xa1\xbf\x56\x78\x36\xa9\xab\x8f\x76\xd5\x63\xad\x33\x4e\xb6\x5e\x5f\x1e\x08\x83\x78\xc0\xc1\xbe\x69\xf1\x0d\xdd\x36\x2c\x03\x61\x43\x99\x46\x2f\x75\xe2\x18\x99\x24\xf2\x09\xdc\x53\xc4\x11\x0f\x70\xe0\xa7\x2f\x36\xcd\xea\xbe\x36\xc7\x12\x3d\xb5\xbb\x1f\x80\x53\x33\xa9\x9f\xa8\xe5\x72\xc8\xc1\x3f\xca\x69\xca\x3b\xb5\xd4\x57\x6b\xe0\x5d\xcd\x60\x55\x5c\xd5\x70\xa5\xc2\x80\x07\xcf\x70\x6e\xea\x89\xe8\x80\xd7\x8f\x3d\x84\x79\xe0\x67\xde\x9d\xd7\x18\xc1\x48\xd4\x13\x9d\xe8\xb8\x67\xe6\x7f\xcf\x68\x41\x3d\xe9\xfb\xc0\x4d\x2b\x6e\x46\x3d\x1c\x51\xb0\x7c\xe9\x6f\xed\x1e\x27\x38\x15\xde\x6f\x3f\xb7\x96\xbb\x05\xbe\x31\x98\x4e\x82\xcf\xbf\xf1\xdd\xef\x81\x6f\xc8\x5d\xbf\x43\xfa\xc1\x2a\xd3\x20\x24\xbc\x45\xca\xc1\x4a\x73\x19\x2e\x78\xde\x6c\x37\x68\xa4\x3a\x2e\xe1\x6a\x5c\xb2\x8c\x15\x10\x20\x14\xc4\x69\x26\x08\x66\x1c\x36\x5a\xdf\xf4\xc6\x2a\x05\xbe\xfd\x01\x91\x20\x7e\x94\xc6\xd2\xcd\x88\x5f\x5a\x67\xcd\xa3\x21\x8a\xd2\x1a\x2e\x03\xdb\xd3\xa6\x65\x7b\x1a\x5c\xde\x2c\xbd\xc0\xd3\x4b\xa1\x96\x10\xbc\xcc\x9e\x2b\x62\xae\x75\x94\xde\xc5\xdf\xb4\x62\x5b\xbf\x42\xee\xf1\x95\xa8\xcf\x84\x09\x5f\xf9\x2c\x6e\x75\xb5\x82\x06\x50\x5a\x12\x09\x9f\x1b\x3a\x6e\xcf\x61\x44\x69\x8f\x14\x29\xc7\x60\xdd\xc0\xaa\x3d\x57\x24\x07\xfd\x1b\xff\x56\x11\xc9\x72\x66\x5d\x3d\x20\x62\xa8\xe4\x8d\x91\x5d\x4b\xfe\xb4\x68\xdd\x25\x94\x9d\xed\xc4\x3d\x71\x99\xba\x54\xd6\x38\x68\x20\x94\x60\x0d\x5e\x82\x0e\x4a\x75\xc3\xd1\x88\x15\xab\x50\x3f\x00\x3e\x97\x86\xd3\xad\xec\x49\x07\xee\x7e\xca\x51\x53\x5e\x69\x29\x5d\x06\x07\xe8\xb7\x35\x01\x9e\x93\x05\xb7\xbf\x57\xf6\x4e\x16\x88\x3a\xd9\x00\x4f\x48\x7b\x7d\x82\x86\xad\x6e\x01\x5f\x05\xeb\xb9\x9f\x5a\x76\x09\xab\xdb\x2a\x6e\xb9\x02\xf1\xd4\x7b\x44\x79\x1c\x2b\xe9\xf0\xfe\x3e\x12\x74\x18\xb7\x09\x62\x70\xd7\x42\xd5\xb7\x2e\x08\x43\xf0\x3b\xb2\x66\x7d\x8b\x1d\x60\x47\x4c\xf7\xe0\xae\x54\x34\x49\xf0\x85\xff\xe2\xa5\x3b\x78\x6d\x9e\x35\x48\x35\xa6\xb7\x9e\x3d\xb9\xc6\x28\x45\x14\xd7\xfe\x98\x66\x15\xf0\x17\xbb\x41\x9a\x7e\xe3\xa0\xff\xc8\xf8\xeb\x4f\x76\xd3\x21\x1d\xd7\x16\xa6\xbc\x62\x95\x07\x08\xaf\x99\x94\x90\x52\x4e\x7c\x7c\x55\x7e\xe2\xae\x16\x24\x39\x03\xba\xa6\x92\xe4\x14\xc2\xe4\x41\x50\x17\xe1\xb4\x67\x13\x08\x50\x1a\x29\x58\xa9\x39\x50\x4f\x0a\xdc\x07\x20\xed\xe1\xb5\x9f\xda\xeb\xf8\xbe\x2e\xcc\xc6\xf5\x2d\x26\xc9\x61\xeb\xf7\xa8\x5d\x81\x3c\x8e\x0a\x96\xcb\xcd\x0d\xbd\xf5\xb6\xb4\xec\x67\xeb\xb2\x2a\xa4\xda\xb2\x2e\x1a\x1b\x9b\xae\xb2\xb6\x73\x09\x89\xdd\x53\x5c\x18\x10\x7a\x41\x60\x58\x2e\x26\xed\xe9\xb5\x75\xdc\xd6\x16\x53\x6f\xe8\x14\x33\xca\x0c\xc8\x40\xa1\xa9\x6d\x30\xaa\x81\x02\x03\xef\x20\xf1\xe3\x34\x53\xd9\x96\x1e\x69\x5d\x55\x6a\x13\x14\x95\x03\xe5\x1d\x9d\xc6\x96\xde\xea\xb6\xc7\x34\x8e\xaf\x29\x9d\xd1\x22\x88\x42\x34\x82\x0c\x99\x9a\xdc\x21\x3a\xa4\x83\xe1\x0a\xdd\x21\x82\x09\x3e\x47\xcc\x60\xb5\x27\xc1\xbd\x51\x73\x81\x80\x38\xab\xb0\x2c\x5e\xbd\x35\xd2\x69\x6c\xb0\xe0\xd5\xb8\xf2\x62\x39\xab\x3b\x79\x55\x91\x92\x8e\x0b\xbd\xe7\x41\x94\xb9\x7a\x07\x0e\x88\x25\x03\x05\x93\x33\x56\x5d\x33\x18\xfe\x14\xc4\xe7\x35\x65\x9d\xa1\xb0\xf5\xe3\x68\xee\x1b\x47\xe3\xc7\xf1\xa2\xf6\x9e\x91\xf4\x2e\x1c\x95\xbb\x7a\xe8\xb1\x7b\x20\xfe\x60\xb7\x17\x0a\xee\x77\x8f\xb2\xfa\x6c\x71\xf1\xff\xb1\xf7\x27\xda\x6d\xe3\x4a\xa3\x28\xfc\x2a\x34\x77\xb7\x36\xd9\x81\x64\x0d\x1e\xa9\xb0\xfd\x27\x1e\x62\x27\x76\xec\x78\x88\xe3\x76\xbc\xb5\x20\x0a\x92\x18\x53\x24\x43\x42\x1e\x22\xf3\xdd\xff\x55\x18\x48\x80\xa2\x94\xf4\xd9\x7d\xee\x3d\xf7\xac\xaf\x07\x0b\x04\x0a\x05\xa0\x00\x14\x0a\x05\xa0\x8a\x84\x34\x15\x67\xba\xca\x3b\x3e\x9c\x8c\xd8\x70\x49\x6f\x3b\x77\x3b\xea\x07\x1b\x2b\xa2\x0b\x67\x72\x6f\xe6\xf4\x30\xba\x27\xcf\x2c\xcd\x75\x13\xce\x35\x4d\xf3\x55\x82\xf2\xed\x00\x41\x9a\x64\xed\xd0\xb2\x04\xaf\x6a\xda\x0f\xc5\x80\x86\x8a\x86\x6e\xfb\xd7\x2a\xda\x56\x2b\xda\x16\x15\x05\x7b\xd4\x1f\x23\x30\x3e\xae\xaf\x44\xed\x66\xd3\xce\x47\xa2\xa4\x0c\xcc\x82\x90\xbf\xde\x3a\x88\xdc\xd0\x6a\xda\xe8\x0a\x7e\x5b\x6b\x36\xfa\x01\x81\xb5\x4d\x76\x19\x65\xe5\x20\x2a\xa3\xcb\x6f\xc5\x7e\x07\xbb\x86\xe8\x86\xef\x25\xd1\x35\xfb\xfa\x2c\xbe\xde\x46\xee\x2c\x0a\x59\x15\x1c\xd9\x56\xe0\x22\x90\xa7\x09\x79\x48\x96\xa1\x0f\x02\xf8\x8b\xf8\x7d\x27\x7e\x3f\x89\xdf\xbf\x22\x77\x96\xa1\xf7\x91\x7b\x7b\x87\x7e\x63\x61\x82\xe1\x2f\x65\x7f\x43\xec\xae\x54\x6a\xd7\xf9\xa5\x90\x97\x97\x7c\x6d\xe3\x11\x39\x5f\x58\x9c\x22\xee\x47\x08\xc6\x61\xa3\x04\x8b\x77\xbe\xe2\x17\x8b\xdf\x00\xbb\x7d\xe4\x83\x15\x63\x94\xb2\xbf\x1e\x76\x57\xff\x73\xeb\xbc\xa9\xff\xd5\xc3\xf5\x1f\x5f\xa7\xcd\xe6\x6e\xb3\x0e\x3f\x7b\x1b\xec\xef\x16\xfb\x38\x60\x1f\x07\xec\xa3\x7d\x70\xf0\x75\xda\xec\x6c\x32\xb0\xce\xe6\x1e\xfb\x7b\x50\xff\x3a\x6d\x1d\x40\x4a\xbb\xd9\xdc\xad\xb3\x9f\x3d\xf8\xcb\xc0\xda\xad\x2d\x48\xd9\x6d\xb2\x8f\x83\xfd\x83\xaf\xd3\x4e\xb3\xd9\xaa\x7f\x9d\xee\x6d\x42\x9e\x83\x6d\x96\x72\xb0\xb7\x0b\x1f\x7b\x07\xec\xe3\xe0\x60\xef\xee\xff\xd4\x8a\x7d\xad\x37\x9a\xf5\x6d\xa8\xc7\xdb\x4d\x28\xb3\xc9\xcb\xdc\x80\x56\x36\x3b\x50\x66\xbb\xb9\xd6\xbc\xfb\xe3\xb7\x55\x34\xc5\xee\x29\x53\xa1\x14\x9a\xe2\x92\x69\x49\x34\x64\xa3\x62\xc0\xfe\xc6\xf0\xb7\x70\x9a\x6f\x2c\x70\xb6\x6c\x08\x99\x83\x2d\x33\xf2\x63\x77\x4c\xbc\x7b\x32\x30\x72\x2f\xec\xc6\x72\x37\xc8\xc6\x22\xff\xc7\x06\x33\x68\x69\x36\xd2\x38\xf0\xa9\x65\x1a\xa6\x5d\xf1\xd2\xdb\x9e\xc5\xf8\x96\xdc\x31\xa9\xe0\x91\xad\x10\x2b\x2d\xa9\xad\x00\xb3\xc2\x36\xba\xbd\x35\xb1\xe7\x91\x98\xee\x8e\x71\x92\x12\x6a\x22\xf1\x5d\xf7\x44\xc4\x1d\xba\x35\xbd\x00\xa7\xe9\x47\x70\x88\x8e\x78\x98\xc5\x82\xe3\xe8\x83\x28\x31\x91\x39\x8c\x12\x11\x43\xe3\xfd\xef\x53\xff\xc1\x44\x2c\x5c\x27\xec\xe3\xee\xae\xb2\x6e\xe2\x32\xff\x6d\xf3\xae\x1b\xe3\x5b\x2a\xab\x49\x51\x8b\x55\xf3\xb6\x75\xa7\xd5\xd4\xf4\x74\x0a\x99\xc8\x1c\x24\x78\x34\x12\xe1\x34\x26\x41\xc0\xc8\x6b\x22\xe1\x53\xf9\xee\x17\x48\xd2\x66\x65\x35\x68\x74\x1c\x3d\x92\x64\x17\xa7\xc4\xb2\xf5\x62\xc1\xc3\xf4\x39\x79\x20\x49\x0a\xcd\x87\x1b\x49\x6c\x25\x24\xdc\x0b\x58\x7a\x0e\x6d\x4c\xc8\x80\x91\xc1\x9b\xa6\xa2\x3a\xd0\x69\x24\x79\x20\x6f\x82\x78\x8c\xff\x46\x4d\xd4\xb2\x4d\x1c\x04\xd1\xe3\xc1\x34\x08\x2e\xbc\x84\x90\xd0\xc0\xe9\x73\xe8\x19\xb9\xcb\x6b\x16\x3a\x0b\xf0\xb3\x01\xa4\x49\xa2\x20\x95\xc3\x0c\x7e\x49\x62\x0c\xc4\xb1\x9d\x0c\x9c\xf9\x1e\x9d\x26\xe4\x28\x14\x01\x03\x1c\xbf\x7f\x8c\x3e\xe3\xc0\x07\x5d\xb6\xc1\x5d\x21\x1a\x41\x14\xc5\x46\x18\x9d\x44\x83\x69\x40\x8c\xb0\x48\x8f\x62\x12\xc2\x63\xbf\xe7\xf4\x28\x0c\xfc\x90\x80\x9c\x35\x38\x0d\x83\x67\x23\x11\x54\x30\x12\x4e\xa9\x81\x91\x7a\x51\x4c\x06\x46\x4a\xf0\x24\x20\x69\x6a\xf8\x94\x4c\x2e\x20\xee\x6f\x8e\xd9\xce\x4f\x3b\xc8\x83\x4e\x67\x1d\x20\x2d\x02\xb3\x20\x65\x51\xfc\x7a\x20\x19\xfc\x52\x17\x74\xd0\x4a\xb3\x34\x3f\x4c\x0f\xc7\x40\x33\x18\x6d\xd1\x63\x08\xbb\x9c\x5f\x42\xb5\x36\xd7\x9b\xb7\xa6\x17\x05\xa9\x89\xcc\x24\x7a\x84\x9f\xd4\xff\x01\x03\x25\x8d\x71\xf8\x4b\x18\x37\x2a\x30\x26\xd1\xe3\x05\xe4\x47\x26\xbb\x5d\xf8\x4b\x78\xd6\x97\x13\x94\xad\xbe\x7d\xec\xae\xde\x7e\xad\x3b\x77\xd6\x2d\xae\xff\xb8\xb3\x57\x47\x5d\xc6\x17\x42\x5a\x1f\x13\x7f\x34\xa6\x06\x0e\xfc\x51\x08\xcb\x5a\xbd\x8f\x53\xc2\x06\x03\x4e\x70\xdf\xf7\xea\x30\xa6\x0c\x19\x59\x4f\xc7\xfe\x90\x1a\x1e\x8e\x65\x46\x2f\xf0\xe3\x7a\x8c\xe9\x98\x87\x12\x18\x62\x5e\x14\x44\x49\x9d\x09\x99\x71\x14\x30\x39\xa6\x2a\xae\x3e\xf4\x61\x97\x94\x8a\xb4\x38\x89\x86\x7e\x9e\x3b\x7f\x10\x6b\x0c\xa2\x89\x1f\x62\xb5\x66\x24\x84\xd1\x5f\x07\x8d\xde\x28\x89\xa6\xe1\xc0\x18\xfa\x41\x50\x8f\x62\xec\xf9\xf4\x99\x7f\xb0\x8a\x0c\x83\x28\x1a\xd4\x19\x42\x11\xce\x61\xa2\x90\xd6\x87\x78\xe2\x07\x22\x0c\xdd\x57\x84\xea\x78\xf0\x6d\x9a\x52\x11\x41\x13\x42\xbd\xb1\xfc\x78\x0e\x04\xe0\x03\x4e\x7c\x1c\x0a\xa0\x47\x4e\x8e\x51\xf0\x1c\x8f\xeb\x60\x88\x5c\x04\xa3\xc4\x97\xb2\x5c\x7d\x1c\x25\xfe\x0f\x10\xf7\x82\x8a\xc4\x07\x92\x50\xdf\xc3\x81\xc1\xa0\xea\x78\xf0\x50\x7f\x12\xe1\x28\xf1\x47\x7e\x58\x7f\x32\xfc\x09\x1e\x11\x85\x34\x01\xa1\x94\x24\xf5\x14\x1a\x05\x9f\x50\x05\x3f\x1c\x89\x16\x4f\x70\x72\x4f\x92\x3a\x09\x07\x32\x38\xf1\xf3\x20\x1b\x60\x46\xf4\x40\x12\xd6\xaf\x71\x94\xfa\xec\xfd\x65\x1e\x43\xc7\xbe\x77\x1f\xc2\x54\x8f\x31\xec\xb8\xa2\x04\x9e\x03\xc7\x38\x8c\x52\x52\x6f\x19\x6c\x1b\x46\x92\x3a\x79\x00\x29\xb3\x78\xbf\xcc\xba\x38\xa4\x46\x3a\xc6\xb1\x5a\xd5\x94\x46\xb1\xa8\x17\x0b\xca\x8e\x80\x33\xa5\x7b\x02\x62\xe3\x74\x34\x2e\xaa\xa1\x47\x17\x75\x01\x07\x4b\xf7\xa4\x3e\xc0\xe9\x18\x27\x09\x7e\x56\x23\xf8\x8d\x51\x19\x03\x8d\xf0\x70\xac\x7e\x7e\x8b\xfc\x50\x7e\x4f\x7c\x0a\x0d\x9d\xf8\x79\x06\xa5\x46\x00\xf0\xe8\x0f\xe8\xd8\x00\x3d\x51\x9d\x5f\xac\xe5\xe1\x01\xf1\x22\xbe\x72\xf3\xef\xa2\x85\x20\x5d\x96\x88\x59\x44\x15\x2d\x98\x86\xbe\x17\x0d\x48\xbd\xef\x0f\xfc\xfc\x83\xdd\xef\x85\x2f\x9a\xd6\x63\xa0\xea\xc4\x78\xa8\x63\x58\x6b\xfa\x84\xfa\x9e\xf1\x50\x1f\xe3\x70\x04\xa5\x3c\xd4\xfd\x01\x89\x46\x09\x8e\xc7\x2c\x7e\x82\xe9\x98\x4c\x30\x1f\x3a\x0f\xc4\xa3\x51\x52\xe7\xc7\xb5\x06\x8c\x28\x36\x8e\x9e\x79\x30\x1f\x46\xea\xd7\xb3\xf1\x18\x25\x83\x7c\x08\x3d\x26\x3e\x1b\x41\x70\xd4\x6c\x3c\x4d\x82\x30\x75\x9e\x02\x3f\xbc\x37\x9e\xc4\x84\xff\x29\xbb\x17\x62\x40\x23\x21\xec\x19\xb9\xd5\xc7\xe8\xde\xae\x94\x08\x54\xde\x67\xb2\x52\x1c\xec\xd1\x29\xac\x4c\xe2\x2b\x81\xbb\xb1\xf2\x4b\x09\xa6\xe3\xe8\x51\x04\xa9\x4f\xf3\x68\xfa\xfc\x0b\xcb\xd1\xdf\xa8\x1f\x13\x7a\x9c\xd5\xd5\xc7\xc7\xc7\xc6\x63\xa7\x11\x25\xa3\xd5\xd6\xf6\xf6\xf6\x2a\x2b\xd9\xcc\xb9\xf6\xd3\x24\x70\x80\x41\x9a\x88\x05\x03\x1c\x8e\x44\x10\xa8\xba\x48\x6e\xf9\xef\xea\xf1\xe5\xe4\x18\xea\xb2\xb5\x0a\xac\x86\x17\x93\xd7\x87\xe2\xfe\x11\x1c\x08\x9b\xc8\xf4\x92\x28\x4d\x4f\x59\x4f\xff\xd2\x62\xd2\x5a\xbe\x98\xa0\x18\x37\x58\xe3\x0f\xd9\x6d\x16\x96\xc9\xcc\x23\x4c\xde\xaf\xa2\x2b\xc7\x2c\xe6\x67\x24\x6c\x02\x01\xd3\xc4\x03\x48\x9e\x01\x9e\xea\x44\x21\x13\xbf\x92\xc9\x1b\xfe\xf1\x5f\x56\xbd\x29\xd7\xc1\x31\x76\x0f\xa2\x46\xaf\x77\xb1\xbf\x7b\xbe\x7f\xd9\x3b\xfa\x78\xb9\x7f\xfe\xf1\xcd\xf1\x45\x6f\xef\xb4\xf7\xf1\xf4\xb2\x77\x75\xb1\xdf\x3b\x3d\xef\xdd\x9c\x5e\xf5\xae\x8f\x8e\x8f\x7b\x6f\xf7\x7b\x07\x47\xe7\xfb\x7b\xdd\xf1\xbc\x71\x7a\x7e\xe9\x84\x6b\xd1\xf6\xfc\x34\x86\x1b\x48\x24\x31\xc1\x20\xf8\x18\x37\xaa\x53\xdd\x99\x50\xbb\xb1\x5d\x78\x66\xa3\x9f\xe0\x7d\x0b\xd9\x76\xa3\x70\xe8\x8f\xaa\x10\x2b\xc9\xee\x4c\x6a\xd9\x05\x6a\xb6\xee\x4f\x30\x1a\xc1\x6e\xd3\x6a\xfc\x61\xdf\x7e\xfd\xfa\x75\xf5\x6e\x15\x3d\x60\xb7\x42\xcd\x72\xf1\x3c\xe9\x47\x41\xad\xc6\x7f\x81\xdc\xe8\x19\xbb\x0f\x78\xa7\x88\xb0\x4c\xa6\xdc\x92\x47\xb5\xa6\xed\x6c\x34\x5b\xcd\x0e\xea\x2d\x80\x63\xae\x02\x02\x01\xb6\x81\x9e\x16\x80\x0d\x13\x3c\x52\xf0\x6d\xa2\xc7\x05\x80\xb0\x24\x78\xb4\x07\x9c\x49\xc0\x6e\xa1\xfb\x05\xb0\x42\x96\x48\x38\x60\x6b\x0d\xed\x2f\x06\x7c\xf0\x07\x12\xb0\xb9\x8d\x4e\x17\x00\x0a\xdd\xb9\x40\xd8\x44\x67\x8b\xe1\x44\x1f\x2b\x35\x6d\xb5\xd0\xee\x02\xf8\x61\x94\x3c\xe2\x64\xd0\x83\xc1\xcf\x61\xdb\xe8\xdb\x02\x58\xd9\xc5\x02\xb0\x83\x2e\x7e\x02\xd8\x0b\xfc\x54\x10\xb6\xdd\x44\x27\x0b\xa0\xe1\x26\x8c\x40\xb9\x8e\x2e\x17\x00\x05\xf8\xc7\xb3\x00\xda\x40\xe7\x0b\x80\xfa\x41\xe4\xdd\x8b\xf2\x5a\xe8\xf8\x57\x46\x1a\x2c\xc3\x60\x77\x04\x7d\xc4\xee\x0c\xb6\xa0\xce\x42\x96\x01\xa9\x26\x82\x05\x6f\x21\xd4\xd6\x2a\x3c\xbd\x61\x7f\x4e\x8e\x4d\x94\x3e\x8c\xaa\x00\xdb\xcd\x66\x73\x35\x7d\x18\x99\x19\x7a\x83\x0b\xa5\x62\xae\xb8\x56\x14\x45\xb9\xbd\xf8\x93\x8b\x37\x71\x5c\xab\xb1\x9f\x06\x79\x22\xde\x55\x98\xe2\x21\x39\x8e\x3c\x1c\x1c\x08\x0c\x3b\x39\xaa\x5c\x29\xbb\x14\xbe\xe0\x66\xb2\x64\x83\x30\x27\x8d\x99\x9d\x39\x24\x53\x98\x9d\x50\x63\x93\x46\xce\xee\xaf\xce\x8f\x56\x5c\xf7\x23\x6e\xa4\x0f\xa3\x97\x17\x33\x57\x40\xc0\x63\x5d\x62\x93\x46\x1e\x21\x1e\x2c\xb0\x0b\xfa\x13\xec\x4e\xf0\xcb\x4b\xb5\x0a\x0b\x5c\x68\x3c\x98\x36\x9a\x60\x25\xb3\xf9\x3a\x7d\x18\xfd\x69\xbe\x12\x26\xed\x4f\x87\x96\xdd\xa0\xd1\x05\xbb\x08\x64\xd9\xaf\xcc\xd7\x40\xc7\x3f\x4d\x44\xdd\x09\x56\x54\xe7\x5d\x55\x8f\x5e\xd6\x75\xab\x69\xfc\xa5\x4d\x97\x96\xc0\x55\x4f\x67\x6a\x9a\x9d\x65\x36\xda\xc3\xee\x0c\x87\x3e\x08\x3d\x60\x50\x67\xe0\x7c\xb2\xcc\x37\xf2\xdb\x44\x45\x78\x3f\x1c\x98\x70\x16\x25\x3e\xf9\x50\x03\xa5\xea\xa2\x0c\x47\x12\x42\xcd\xc6\x24\xe5\x85\x59\xd8\x93\x28\xd3\x46\x34\xc1\x61\xea\x2b\x35\xba\xcc\x23\x4c\xa4\x7c\xb0\x3a\x65\xe8\x88\xa9\x9e\x0e\xe1\x6f\x37\xc4\xb5\x9a\x75\x88\xdd\xa5\xdd\xc2\x3d\x9e\x28\x05\xef\x3f\x00\xd3\xf4\xc3\x5c\x85\x69\xf1\x6b\xc9\xc6\x1e\x6e\xe4\x75\x27\xe1\xa0\xf8\x40\x15\x00\x39\x4d\x96\x83\x31\x1a\x14\x9f\xb6\xd6\xa2\x72\x45\x8a\xfc\x1a\x51\x94\x2f\xbe\x1a\x1f\x60\x74\x85\xd1\x0f\x8c\xbe\x63\xf7\x2f\xcb\xcc\xb1\x13\x20\x11\xba\xd1\x23\xf3\x8a\x9a\x36\xba\xd6\x93\x52\xd1\x05\x9f\x59\xb4\x56\xa6\x69\xa3\xb7\xd8\x35\x71\x3f\x4a\x60\xd7\x1a\x82\xb2\x43\xfe\x8a\xcd\x85\x31\x10\xf6\x41\x3d\x10\xb1\x89\x41\x26\x31\xf5\xc9\xc0\x20\xa1\x97\x3c\xc7\x94\x85\x06\xf0\x17\xf4\xd3\xcc\x5e\x12\x19\xc0\x51\x8f\x08\x4e\x08\xc5\xf9\x27\xab\x89\x11\xe3\x69\x4a\x98\x5e\x85\xfd\x01\xa9\x3d\x4e\xa2\x11\x68\x05\x0d\x38\xdb\x13\x05\xa5\x84\x80\xea\x10\x7e\x00\x24\xa5\x38\x00\xdd\x8e\xb0\x0c\x65\xc0\xf5\x17\x7e\x27\xd1\x78\x88\x82\xe9\x44\x66\x7b\xc4\x4c\x42\x57\xe5\x5c\xf4\x01\x83\x04\x57\x75\x8c\x73\x4d\xf0\xfd\x09\x8e\x77\xc4\xaf\x73\x82\x63\x1b\x7d\x11\xaa\xe9\x77\x18\xb4\xe5\x9f\x98\x56\xfa\x2f\x16\x7e\x2f\x52\x7e\x13\xbf\x44\xbc\x40\xa3\x81\x34\xfc\x8f\xc2\x22\x98\x04\x90\x27\x0a\x5c\x73\x12\xc1\xb3\xd3\xe8\x31\x34\x58\x68\x1a\x1b\x34\x9a\x7a\x63\x0f\xdc\x19\x06\x3c\xcc\xda\x04\x01\x4e\x24\x3c\x7d\x62\x5e\x1a\x8d\x41\x3f\xe0\x01\xb1\xa3\x14\x79\xc4\x17\xc3\x29\xc2\xd3\xd8\x00\x0d\x21\x20\x82\x5f\x8e\x67\x90\x44\xb1\xc1\x1e\x45\xe4\x9d\xae\x7e\x72\xa0\x7b\xf2\xcc\x10\xdd\x93\x67\x50\xe4\xa5\xc6\x3d\x79\x9e\xc6\x06\xf3\xbe\xc5\xb6\x71\x47\x2c\xe4\x05\x51\x4a\x0c\x51\x01\x2f\x8a\x9f\x0d\x6f\x4a\x8d\x18\xa7\x94\x80\x76\xc3\xbb\x37\x44\x1f\x08\x61\x60\x42\xc2\xa9\x01\x8a\x41\x6a\xa4\xd3\xfe\xc4\xa7\x5a\xa7\xe0\xc0\xe5\x2a\x44\xa3\x1f\x4c\x13\x51\x75\x0a\x6a\xbc\x04\x8f\x02\x82\x1f\x08\x27\x16\xec\xb8\x45\x08\x4a\xe3\x6d\x65\x91\x32\x3c\xa5\xc6\x28\xa2\xe2\x4b\x28\xb0\x8c\x20\x4a\x4b\x51\x5a\xe9\x41\x00\x0c\xc6\x2f\x3a\x2b\x2d\x82\x5e\xe0\xde\xf2\x39\x01\xfa\x61\xfe\xfb\x1d\xa3\x62\x46\x01\x93\x42\x37\x6a\x4c\xc1\x19\xd1\xb5\x1a\xcf\xd9\x1f\x32\xc5\x9c\x82\x2d\x07\x0e\xcf\xf2\x90\x32\xcf\x8a\xa4\xcb\x3c\x42\x9f\x7a\x4a\xc4\xae\x8c\x10\xb3\x51\x0f\xc9\x99\x39\x17\x1e\xe8\xbf\x30\x63\x95\xdf\x39\x1a\xf2\xb8\x33\x1e\xb7\x9b\xc7\x31\xa5\xa0\xf6\xc3\x67\x7c\xfe\xb1\xa7\x7e\x48\x06\x90\x47\x9c\xe8\x11\xa9\xa0\x10\x84\x25\xb5\x2a\xfa\x8e\x47\xce\xd5\x45\x30\x10\x3d\x24\x98\x89\x1e\x14\x7c\x44\x0f\x71\x9e\xa2\x87\x38\x7f\xd1\x42\x05\xaf\x11\x1f\x57\xe2\xe3\x33\x46\x0a\x43\x65\xc3\xc2\x94\x0c\xa8\x08\xdd\x75\x4f\x89\x65\xb2\x51\xce\xfe\xc8\x19\x24\x7e\xf8\xcc\x11\x7f\xd9\x0c\xe3\xf3\x4c\x99\x44\x22\x7c\xc2\xc3\xf1\x73\x31\xfb\xbc\xa9\xc2\x2b\xf0\xf4\x69\x57\x67\x1a\x83\x68\xda\x0f\x88\x88\x54\x98\xc3\x7e\x89\x49\xe0\xd1\x45\xc1\x2e\xd8\x1f\x3e\x35\xf9\x5f\xce\x08\xe4\xdf\x07\xd0\x8f\xe7\xbf\x0a\xef\xd8\x2b\xf3\x90\x33\x85\x99\xdc\x93\xe7\xab\xd8\x28\x31\xc2\x3d\x95\x25\xb2\xb4\xab\x58\x30\x14\xf9\x97\xad\x15\xfa\x8a\x21\x99\x80\x20\xa0\xf8\xda\x5d\xc8\x18\xf7\x74\x26\x29\x42\x57\xb1\xba\xda\x40\x70\x57\x04\x19\xc7\x12\x7c\xab\x58\x84\xd8\xda\x03\x5c\x4c\xfe\xcc\xb1\xf0\xdd\x2a\x76\xbe\xaf\xf3\x75\x16\xe4\xb4\xd6\x96\x2d\xfe\x21\xe6\xb5\xca\xa8\x9a\x36\x82\xd1\x03\xec\xd1\xc8\xff\x14\x8c\x72\x3f\x0f\x91\x27\x1f\xfa\x0f\x8f\xf6\x65\x80\x33\x51\x08\x1d\xe7\x21\xc6\x38\x21\xea\x34\x67\xab\x20\x79\xf2\x4e\x38\xc9\x43\xd1\x94\xf2\xa8\xd3\x29\x2d\xb3\xe1\x53\x85\xf7\xb2\xbc\x22\x7c\xa2\x84\x15\x56\x7d\x5a\xcd\xb5\x19\x96\x14\x14\x58\x81\xfc\xa1\xd1\x68\x14\x90\xe2\x67\xea\x8d\x19\x7e\x46\x33\x86\xfd\x71\x4c\x48\xc0\xff\x6a\xdc\xbc\xc5\x88\xe4\x05\xa8\x6d\xe7\x56\xb2\xa7\x81\x6b\x0a\xe2\xe6\x46\x13\xc4\xb7\xb2\xa4\x95\x97\xc2\xc5\x4b\xa5\x60\x00\x6a\xb1\xc3\xc0\x6d\x76\x87\xc1\xeb\x69\xee\x34\x73\x18\xbc\x7a\x65\xa7\xcc\x4f\xa0\x35\x0d\x6e\x87\xc1\x1d\x6a\x72\x69\x6e\x10\xb8\x3f\xa2\xc2\x74\xea\x55\x4a\x92\xb7\xb0\x1d\x64\xaf\x9c\xf8\xfd\x5a\x14\xeb\x30\xc9\x34\xbc\xf6\xe9\x38\x4f\xee\x07\x70\xe0\x3f\x0e\xdc\xd9\xfc\xba\xc3\x8c\x1f\x38\xe0\xdd\x8c\x29\xa2\x8f\x40\x13\x7e\x3a\xa5\x29\x29\x47\x5e\x04\xbe\x47\x4a\x71\xd7\xa0\xcb\xe5\x71\x4f\x07\x01\x79\x52\x82\xef\x92\x68\x1a\x8b\xef\xd3\x64\x00\xe6\xaa\xf3\x28\x0f\xc6\x6c\x51\x32\xff\x4c\x01\x78\x28\x90\xc0\xef\xbb\x24\x7a\x94\xe1\x33\x46\xda\x07\x22\xbf\x2f\xc6\x09\xa8\x2e\xc5\xd7\x47\x32\xc2\x6a\xea\x29\x54\x10\x3e\x46\x89\x3f\x78\x93\x10\x2c\xc3\xe7\xd1\xa3\x12\xdc\x0f\x07\xca\x17\x1c\x10\xa9\x9f\x30\xd5\xe4\xf7\x2e\xab\xa1\xfe\xa5\xe4\xe6\x11\x2a\x02\x11\x23\x71\xc0\x49\xc6\x35\x53\xf3\xc2\x17\xe8\xb2\x77\x03\x3c\x89\xe5\xc7\x61\x9e\x24\x74\xe5\x90\x10\xc9\x46\x44\x49\x3c\xc6\x9c\x3c\x14\xf7\x2f\xfc\x1f\x8c\x0a\x8f\xfe\x20\x7a\x64\x91\x3f\x98\x52\x92\x85\xa2\x68\x02\xbf\x70\x50\x73\x5a\x60\x62\x27\x34\xca\x37\x9c\x13\x68\x9f\xa0\xa6\xdf\x93\x9a\x7f\x3d\x8a\xeb\xfe\x8b\xb8\x93\x5c\xbd\x5f\xc4\xcd\xe1\x92\xc3\x22\x43\x13\x90\x88\xae\x49\xff\xde\x87\xe5\x79\x02\xeb\xe9\x49\xf4\xc3\x44\xe6\xa9\x79\xd7\x15\x97\x09\xee\xc9\x73\x6a\x8d\x83\x6a\xad\xf2\x24\x98\x8f\xa6\xf6\x8c\xba\xf4\x15\x5c\x4e\xc4\xc9\x1b\x6a\x35\x61\xeb\x7c\x05\x1e\x59\xb9\x76\xf2\x15\x69\xa4\xd3\x3e\xa8\xb5\xc2\x91\xd5\xb2\xd1\x38\x00\xdd\xef\x38\xb8\x25\x77\x99\xd4\x58\x8e\x02\xf7\x2a\xb2\x66\xb0\x48\xc2\x89\x2b\xdc\x03\x43\x33\x2c\x86\x0a\x28\x9d\xd9\x6f\x22\x86\x27\xfc\x90\x49\x9f\xb0\x1e\x1f\xb3\x58\x7f\x32\x62\x3f\xb0\xb6\x41\xe0\x9e\x3c\x8f\x48\x28\x7a\x94\x8d\x4c\x10\x60\xe0\x37\xc6\x09\x66\xdd\xc2\x4f\xc2\x21\x44\x13\x70\x5b\x07\xbd\xd8\x07\x64\x99\x8d\x1e\x02\xd0\x39\x80\x26\x06\x3d\x07\xae\xf9\x9b\x89\x7a\x81\x6b\xae\xfe\x66\xa2\x27\xf8\xdc\x31\xd1\x23\xfc\xae\x98\x48\x1a\xaf\xd8\x17\xbf\xa7\x41\x95\x36\x28\x25\x14\x2e\x58\x46\x53\xba\x53\x04\xc5\x4d\x37\x74\x56\x99\xc5\x0b\x08\x4e\x64\x26\xf5\x43\x66\xdb\x0d\xb8\x9d\x96\x04\x87\x83\x68\xa2\x2a\x2c\x3a\x1b\x76\x23\x05\xfe\x60\xb5\x6d\xf4\x2d\x70\xcd\xd2\xad\xde\x23\xe1\x81\xfd\x37\xf3\xd5\x6e\x80\x2e\x0a\x00\xb6\xd9\xe6\x6e\x4c\x92\x94\xa7\x9e\x14\xa9\xf9\xd5\x36\xc2\x93\x2e\x45\x93\xcf\xc5\xef\x31\xff\xed\x5e\x45\x56\xaa\x5c\x4a\x41\xb3\x18\x8e\xd7\xc1\x20\x15\xbb\x59\x52\x5c\x7e\x12\x2f\x32\xc4\xe9\xff\x19\x87\x22\x03\x70\x6e\x0e\xac\x56\x3c\x19\x08\x19\x33\x61\x55\xeb\x82\x19\x56\xd2\xd0\xf1\xed\x94\x23\x2c\xdb\x31\xa7\xe1\x3d\x5c\x11\x2f\x1c\x21\xca\xe7\xee\xec\x5e\x8b\xbc\xe6\x2a\xbf\xc1\x5b\xb5\x78\xcb\x9a\xee\x95\x6b\x13\x50\x3b\x63\x93\xf4\x2c\x89\x62\x0c\xac\x2d\x0a\xd5\x36\x2c\xad\x6a\x29\xdf\xce\x5c\xcc\x82\xca\x72\x99\xe8\xed\xb4\xdf\x67\x0e\x88\xf5\x08\x77\xa5\x99\x57\x57\x41\x75\x41\xa3\x38\x96\x15\x8e\x49\x92\xfa\xe9\x3c\xb1\xfd\xf4\x8c\xa7\xc0\xc3\x97\x80\x66\x48\x8d\x70\x7c\x8a\x06\x24\xa5\xc9\xd4\xa3\x51\x32\xd7\x48\x54\x3c\x44\x91\x30\x0d\x76\x9d\x7d\x88\x3d\xc2\x16\x6c\xc2\x4c\x30\xdb\x00\xc5\x0e\x2d\xe0\xb6\x60\x99\x32\x1c\x47\x8f\xe2\x64\x04\xf7\x8e\x52\x11\x31\x10\xc7\x07\x42\xd1\x5f\x3c\x5f\xa9\x6c\xe3\xa2\xce\xf2\xc5\xd3\x9e\x9e\x44\x27\xc7\x7a\xea\xea\xf1\xc7\xac\xc5\x24\x11\xe6\xd5\x32\x1b\xa5\xb4\x68\x8c\x3b\x83\x9e\x10\x37\x63\x59\x45\x79\x58\xe8\xbc\x2f\x79\xd4\xbc\x5e\x13\x80\x32\xc4\x2a\x73\x36\xc6\xe2\x94\x02\xf5\x59\x37\xa6\x02\x05\xd4\x26\x80\x93\x7d\x81\xde\x9f\x90\x0b\x0a\xcb\xcf\xbc\x86\xd6\xe0\xfe\x55\x58\xf2\xcb\xcb\x1e\xf8\xb7\x0e\xa3\x47\xcb\xce\x50\x79\xce\x70\x5c\x7e\x7a\x99\x4c\x53\xf9\x09\xa3\x56\xd8\x22\xd2\xb4\xbf\x32\x6c\x50\xcb\x9e\x15\xd7\x2e\x95\x66\x24\x0d\x1c\xc7\xc1\x33\xf3\x0a\x8a\xf2\x6b\x95\xfc\x6e\x64\xc2\x08\xd9\x55\x26\xb8\x9b\x14\x61\xe1\x4d\x13\xb6\xe0\x54\x5e\xb0\xbc\x02\x77\x7a\x61\x01\x63\xab\x1f\xae\x96\xa4\x0e\x2d\x37\x44\xa1\xd2\x23\xb0\x3e\x64\x48\x19\x70\xcc\xa6\x83\x6c\x5f\x22\x02\x68\x48\x2d\x70\xea\x95\x41\x20\x15\x0f\x5d\x3e\x06\x6e\x4e\x09\x6b\x06\xbb\x58\x79\x30\xf5\x66\x61\xca\x5e\xe0\xde\x6e\xa3\x56\x07\xb5\x37\x51\xa7\x7d\x87\x8e\x02\x17\x14\x98\xe6\x6e\x21\x49\x96\x54\x93\xe8\x50\xf0\x3f\x06\x27\xb5\x9c\x27\x70\x3a\xe2\x87\x86\xfc\xae\xd5\xac\xc3\xa0\xd0\x81\xca\x00\x80\x09\x75\xa1\x28\xe8\x12\x9e\xa6\xeb\x25\xd4\x6a\x2b\x87\x01\xba\xe2\x00\xd6\xca\x51\xf0\xf2\x72\x18\xd4\x6a\x5b\xaf\xe1\x6f\xab\xf5\xa7\x7b\x18\xd8\xe8\x47\xe0\x72\xbd\x75\x63\x98\x44\x13\xb8\x20\xb7\x1b\x0d\x88\xd5\x69\xdb\xe8\x7b\xe0\xce\xfa\x64\x18\xc1\x5d\x2a\x58\x27\x67\x31\x8c\xd1\xc1\x39\x19\xf9\x29\xe5\x2a\x0a\xb8\x2e\x97\x3a\x33\x3e\x62\x07\x8e\x19\x85\x6f\x8b\x0c\x26\x12\xdb\xf8\x72\x82\xdc\xc8\x67\x48\x7d\x31\xec\xdc\x9a\x8a\xdc\x4d\xd8\xae\x5a\x6e\x2b\x61\x03\x2e\xa5\x77\xd8\xe0\xc3\x2e\xd1\xbc\xcb\x90\x92\x01\x24\xba\x5f\xaa\xa1\xda\x25\xe1\x40\xaf\xa4\x9e\xb6\xa0\x9e\x7c\x4f\xaf\xd7\x75\x91\x32\x2d\xdf\xf9\xaa\x1b\x17\xad\xde\x6c\x57\xf8\xb7\x6b\x2e\x74\x26\x0b\xea\xce\x52\x7f\xb5\xf6\x4b\x95\x81\x3f\xaf\xff\x15\xdb\x20\xfd\xed\x06\x48\x5d\xca\x82\x16\xf0\xe4\x5f\x6d\x82\xd0\x08\xff\xad\x36\x64\xe8\x86\xd9\xb0\xbc\x66\x7f\x3f\x07\xee\x8c\xf1\x61\x78\x01\x91\x3a\xdf\x03\x44\x9e\x40\xce\xe3\x73\x2a\x55\x98\xad\x7c\x5a\x0b\x93\x2f\x82\x0b\xde\x47\x81\x0d\xe6\x5c\xc5\xb3\x1c\xfe\xb8\x4a\x1d\xc9\x8c\xbe\xa6\x03\xf0\xd8\xfd\x1e\x34\x94\x24\xd6\x4f\xf2\xc5\x52\xb7\x9c\x11\xa6\x80\x53\xce\xb2\x1f\x0e\x16\x66\x10\x7b\xd5\xb9\x3c\x9c\x98\x32\x5b\x26\x5d\xcd\xb3\x27\x09\xc6\x75\xb0\x33\x00\xd3\x4e\xec\x49\x62\x45\x69\xb6\x63\x0a\xba\x9a\xf0\x4c\xa4\x56\x6b\xb7\xb7\xd9\x9b\x9c\x7b\xf2\x0c\xac\xa2\x22\x17\x6b\x56\x7e\x61\x1e\xef\x58\x57\x41\xad\x66\xde\x47\xf0\x2a\x25\x6c\x04\x70\x38\x08\x52\xca\x75\xf0\xf2\x02\xce\xe2\x2a\x32\xef\x60\xb7\x1c\xbf\x0f\x56\x02\xaf\x83\x5a\xcd\x8a\x5c\xcc\xbc\xf4\x5a\x97\x81\x9b\xa0\xf3\xc0\x15\x37\x61\xfd\xd0\xb8\x0c\x76\x2e\x03\x7e\x28\xe7\x5c\x06\xaa\xb5\x42\xd6\xcf\x4d\xdb\x46\xd8\xfd\x18\x80\x79\xa1\xb3\x28\x0a\xc8\xc0\xc2\xa2\x3f\x51\x04\xee\xf4\x31\xc5\x6e\xe4\x88\x87\x4f\x56\xe4\xc6\xb0\x3c\x30\xc2\x88\x34\x1b\x45\xd4\xc2\x36\xb8\x8d\xb3\x1d\x71\xc9\xde\x22\xee\x41\xb0\xd3\xe7\x44\x74\xc6\xfc\xd7\xde\xb1\xa8\xfb\x46\x2d\xe9\x7b\xd0\x50\xb8\xa9\x2c\x96\x72\xc4\x04\xf0\x52\xdb\x76\xc4\x7b\x5d\xf1\x4e\x2d\xda\xa1\xe2\x9d\x84\x4b\x77\x22\xe7\x36\x42\xf4\x2e\xcb\xd0\xdb\xc0\x9d\xb1\x5b\x63\xb0\x1d\x81\xbe\x95\xbf\xb0\xfc\x43\xd8\x94\x1f\x75\x46\x6d\x13\xe2\xc8\x04\xfb\x01\x04\x26\x51\xc8\xf7\xfd\xe1\x74\xd2\xe7\x5b\xed\x18\xa7\x29\xdc\x78\x82\x30\xbb\x75\x05\x81\x94\xe0\xc4\x63\x80\x94\xb0\x8c\x40\x4e\xf6\x2b\x4a\x99\x26\x2c\xfa\x91\x10\xd8\x0e\x65\xe8\x03\x54\x8b\xe9\x5a\x7e\x91\x1d\x08\x7d\xb6\xc6\x03\x58\xdc\xf2\x89\x2f\x8e\x1b\x98\x7a\x53\x55\x55\x2a\xf3\x7f\x1a\x97\xd5\x3f\xa5\xc9\xff\x45\xec\x42\xde\x89\xdf\x4f\xc0\x06\xf8\xa1\xe3\xa7\xc0\xf5\x88\x65\x32\x9c\x26\x74\xfe\x4a\xe5\xfa\xfb\xf2\xb2\xfd\xba\x32\x41\x3c\xee\xf8\x4b\x67\x29\x1f\x02\xd4\xf3\x53\xd6\xf7\x8c\xa7\x5c\x70\x03\x43\x64\xe0\x7c\xfa\x45\x66\xe3\xd2\x9d\x4f\x60\x29\xca\x11\x02\x04\x76\x23\xf6\xf2\x0a\x08\x5b\xab\x15\x61\xfd\xb6\x4f\xb7\x30\x5d\x0d\x13\x18\xb3\x63\x71\x68\x99\x2b\x7c\xc8\xfb\x01\x81\x84\x88\x3d\xaa\x64\x06\xad\x03\xb7\x27\xec\xf9\x81\xa9\x3f\x6a\x45\x36\x78\xcf\xf9\x14\xd8\x81\x7b\x26\xce\xcd\x03\x77\x9f\x0a\x4b\xd9\xf7\x94\x31\x12\x4b\xad\x8e\x5d\xab\x29\xa5\xe8\x15\x02\xfb\xb9\xec\x36\x73\x3f\x7a\x2a\x0a\x7e\x79\x31\x13\x3c\xf0\x23\xa5\x2a\xb5\x9a\x15\xb8\xa7\x94\xbd\xa2\x81\x79\x1f\xb8\x01\x50\xa4\x30\xef\x3f\xa2\x56\xc0\xe8\xd3\xf5\x6b\x35\xdf\x22\x08\xdc\x30\x23\xb6\x3c\x0b\x5e\x65\x11\x37\x6a\xf4\x1e\x13\x38\x39\x4f\xe4\xbb\x46\x6e\x32\x00\x74\x8f\xf0\xa0\xd1\xe4\xb3\xa0\x28\xb7\x56\x3b\xb0\x22\x24\xa3\x51\xc4\xd9\x89\x9d\x65\xe8\xbd\x26\xfe\x3d\xf8\xe4\x91\x4d\x4e\x34\x20\x14\xa6\x96\x10\x05\x7f\x0b\xdc\xd9\x9b\x80\x3a\x26\x0e\xe8\x07\xf2\x6c\x22\xd8\x08\x27\x51\xe0\x98\x1e\x4d\x02\x16\x03\x87\x21\x8e\x09\x2a\x06\xf6\x79\x01\xd7\x87\x1d\x93\xdd\x22\x86\x88\x0c\x11\xdf\x85\x69\x06\x86\x65\x7c\x58\x9e\x12\xf6\x37\xf2\xdd\xf7\x41\x5e\x7e\xea\x25\x84\x84\x5f\x1c\xe1\x7a\x0c\x3e\x6e\xc4\x9e\x21\x80\xdb\xb5\x22\x85\x7f\x88\x94\x18\x8f\x88\x88\x87\xa0\x84\xe7\xf5\x12\x98\x44\x2d\xf8\x17\x6f\x03\x0f\x8b\xfa\xf2\x8f\x11\x81\xb1\x0e\xae\x77\x38\x5d\x9d\x6f\x14\xf5\xa7\x94\x46\xc2\xe4\x02\x0f\x8b\x4d\x4c\x42\x02\x78\x3d\x5a\xde\x07\xa9\x1b\x17\x0d\x84\xd9\x25\x03\x79\x54\x9c\xf6\x43\x67\x36\xd2\xc4\x13\x9f\x3b\x70\xb1\x5b\x84\x1d\x0d\xd0\xce\x10\xe8\x8e\x21\xf8\x45\x2b\x07\x26\x41\x9e\x02\x22\x72\x6e\xe8\x88\x34\xf2\x78\x61\x2d\x8c\xe4\xaf\x85\x89\x0f\xe5\x72\x3a\xa3\xd0\xdf\x31\x73\xcd\x39\x0c\x17\x6e\x4e\x65\x27\x87\xa8\x53\xa7\xe9\x58\xa1\x0f\xea\xda\xa6\x52\x93\x9b\x85\x35\xb9\x59\x50\x93\x1b\x51\x13\x9a\xd7\x84\x16\x35\xb9\x41\xc9\x4f\x6a\x72\xc3\x6b\x92\xc8\x9a\x64\x36\xc2\xbe\x1b\xf9\xf9\xd0\x11\xca\xf8\x23\xb1\x0f\x64\x97\x7a\x79\x90\x5f\x67\x15\x23\x04\x64\xa8\x69\x22\x37\x9e\xc0\x74\x43\xea\xe3\xe0\x4c\x8f\xf7\x03\x39\xd4\xa8\x1f\x50\x31\xa4\xe8\xa3\x9f\x4a\x34\xbc\x30\xe0\x82\x72\xdb\x79\x96\xf8\x13\x9c\x3c\xcb\x19\x13\xf8\xee\x8c\x51\x96\x1d\x6b\x38\xb3\xa4\xb4\x6a\xc0\xba\x70\x92\xa7\x9b\xfa\x9a\x70\x6b\xca\xa3\x0b\xd0\x51\xca\x33\x0b\xd8\x1c\xb0\x78\x76\x06\xb2\x04\x25\x4b\xff\x65\x94\xa2\x2d\x4b\xea\x29\xce\x2a\xab\x6b\x2a\xb2\x73\xc4\xf2\x43\x47\xbd\xa4\xbe\x02\x75\x75\x8d\x97\xa0\xce\x90\xef\x6b\x4b\x51\xe0\xff\x64\xc1\x29\x1e\xce\x2a\xcd\x87\x11\xff\xf2\xa2\xe1\x86\x28\x24\x2f\x3a\x40\xab\x4a\x30\x22\x06\x18\x38\xe6\xfe\x6c\x3a\xed\x5a\x04\x4b\x6a\x58\x9e\xf0\xa1\x36\x8d\x5f\x5e\x56\x82\x5a\x6d\x05\x97\xfd\x7f\x60\x37\x69\xf0\xd5\x0f\xfc\xfb\xef\x24\x0e\x8b\xd1\xdf\x62\x83\x1c\x27\x1e\xa5\x31\x17\x0a\x58\x18\x42\xbe\x66\xf9\xe4\xe2\x19\xb0\xab\x63\x81\x4b\xe5\x73\x7d\x8b\xba\xe0\xbd\x68\xbe\x5e\x39\xab\xb1\x77\xbe\xb0\xe5\x17\x32\x08\xaf\x82\xbe\xfb\x9b\x45\xed\xae\x05\xce\xf4\xfd\x97\x97\x75\x78\x33\xde\xa0\xe0\xee\x66\x43\x06\xa1\xb5\xb9\x29\x78\x58\x28\x0d\xb1\xef\x67\xc5\xbb\x2e\x2d\xb7\x71\x8e\x9a\x7a\x17\xd8\xe2\xe1\xaf\x8f\x3c\x37\xf0\x1b\xc5\x20\x47\xd3\xfc\x9b\x8d\x3e\x34\x14\x1d\x63\x76\xa1\x58\xb5\x53\xe0\x75\x78\xad\x96\xc7\x00\x6a\x88\x7a\x79\xb1\x52\x17\x0b\xc4\x22\x51\x41\x2d\x62\x72\xe4\xe2\xdb\x64\x0b\x34\x11\x06\xbf\x82\x1d\xec\x7c\x22\x56\x00\x82\x36\x8f\xa1\x3c\x86\xda\xc8\x73\x53\x45\x1e\xf6\x10\x5f\xbc\x91\xc7\xf8\xa8\x3b\x7c\x65\xb2\xa3\x4b\x13\x22\xd8\xa8\x70\x09\xf2\xf4\xfe\x70\x31\x0a\x35\x24\x53\x29\x47\x87\x39\x12\xd0\xec\x25\x26\x18\x7d\xcb\xb3\x94\x90\x10\x94\xb8\x01\x1a\x82\x63\xcf\x5a\x6d\x08\xbb\x37\x50\x46\xa6\x6e\x82\xa6\xee\x10\x05\x6e\x13\x11\x37\xed\x92\x2e\x71\xdf\x13\x8b\xd8\x76\xf0\xea\x15\x57\x57\xc2\xa2\xec\x4e\xbb\xb4\x4b\x21\x85\xda\x36\x11\x29\xdd\xe6\xeb\xa0\x4e\xba\x76\x0a\xf1\xa9\x8d\x82\x7a\x5d\xc6\x93\x7a\xd0\xb5\xa7\x10\x3f\xb5\x11\x91\xf1\x00\xc0\x46\x60\x0a\x3e\x23\x5e\x5e\xd8\x4f\x61\x72\x4e\xb8\x97\x20\x5d\x89\x50\x20\xc8\x84\xfe\x51\xf5\xe4\x00\xe5\x70\xbb\xe9\xb7\x77\x5d\xb0\x29\x0a\x56\xce\x0b\xfb\xa7\x56\xe0\x26\x0a\xe2\x97\x17\x18\xec\x53\xbb\x6b\xa7\xdc\x04\x78\x02\xce\xaa\xde\x13\x2b\xe1\x67\xa8\x09\xb3\x22\x5e\xab\x0d\xe7\xb0\x0c\x2b\xb1\x24\x1c\xcb\x10\x8c\xb4\xbf\x27\xd6\x90\x63\x19\xc2\x09\xe9\x6b\xf9\x02\xbb\x3b\x7c\xf5\xca\x0e\xa9\x95\xde\x0e\xef\x90\x29\x36\x00\x26\xf2\x24\x6c\x22\xe1\x9a\xaf\x87\x40\x97\x90\x5a\x09\x03\x95\x1b\x03\x13\x15\x96\x00\x84\x1b\xbb\xc8\xde\xb9\xf5\xee\x9c\x5b\x0f\x85\xb0\x23\x4a\xfd\xaa\x43\x11\x71\x46\xe5\xa7\x3b\x79\xc8\xb9\xa0\xc8\xf3\x7f\xfa\x14\x76\xea\xbb\x3f\xd1\xcb\x81\x02\xad\x52\xfc\x47\x43\xdf\x9d\xf1\xcd\xc7\xaf\x6d\x83\xb8\xd9\x7d\x7d\x1b\xc4\xe3\x7e\xa2\xff\x28\x6e\x8a\xc8\x1b\x60\x7c\x37\xa4\xef\x83\xe6\x6f\xa1\x2d\xdf\x19\x0d\x7c\x36\xae\x50\x2c\x7e\xfb\xe2\x77\xcc\x64\xd0\x89\xbe\x94\x0c\x7f\xbe\x94\x20\xcc\x06\x7a\xe4\xe2\x97\x17\x4b\x67\xdf\x39\xdd\x1c\x50\x29\x24\x85\x09\x8a\xc4\x29\x73\x75\xb4\x62\x61\x77\x25\x02\xfb\xbd\xce\x2c\x72\xdf\x83\xd5\x0e\xec\x52\xdc\x90\xb4\xca\x6f\x00\xc0\xe9\x7c\xf0\x1a\xcb\x31\x05\x47\xf3\xf0\x0c\x3f\x82\x37\x05\x16\xbe\x0d\xee\x6c\x7b\x16\xc1\x66\x4f\xea\x43\x22\x77\xa5\x99\x01\x72\x30\x36\x83\xab\x8c\xad\xcc\x6d\xbd\x84\x3d\x3f\x7e\x93\xcd\x74\xf8\x26\xe9\xe5\xc5\xa4\xc9\x54\x6c\xa6\x4a\xef\x78\x61\x15\x18\xf8\x6e\x04\x54\xa5\x92\xa4\xc2\x58\x0d\x57\xfc\x40\x9f\x9a\x4e\xdf\x77\x63\xdf\x15\x5d\xa0\x26\x17\x4a\x2c\x07\x3a\x42\xd8\xb4\x93\x2a\xa0\x7c\x24\x98\x4e\x01\x3d\x8d\xc5\x97\x18\x1d\xa6\x23\x9a\x06\x08\x5a\xe8\x92\x5a\xc0\x7e\x39\x8a\xf2\x90\x00\xb3\x3f\x53\x5f\x78\xa5\x61\x10\x62\x50\x09\x94\x6c\x68\xe5\x08\x05\x2a\x69\x4b\x02\x86\x4b\x96\xa1\x91\xaf\x6e\x97\xf2\x6b\x74\x30\x01\x1c\x71\x8f\x12\xc7\x29\x37\xa1\x24\x44\xc5\x94\x4c\x07\xb9\x64\x2f\xa4\xc3\x07\x0d\x0d\xbc\xa7\xec\x47\x38\x61\xd7\xcd\x2a\xb6\x12\xa6\x06\x00\x53\x96\x80\xa1\x39\x2d\x97\xb0\x59\xa0\x45\xc2\x15\xea\x67\x6d\x7f\xa5\xad\x19\x52\x54\xed\xf9\xee\x6c\x3f\xf5\x1c\x73\x3f\xf5\x70\x4c\x4c\x74\x01\xd7\xce\xfb\x38\x71\x4c\xc3\x44\xe0\x36\xc1\x31\xdf\x24\x49\xf4\x08\x41\x13\x5d\xc5\xe2\xf3\x2a\x36\xd1\x39\x13\xad\xf9\x37\x0b\x9b\x68\x2f\x7a\x0c\x05\x04\x04\x4d\xb4\x47\x02\xc7\xdc\x63\x57\x85\x4d\x74\xed\x87\x8e\x79\x7a\x61\x22\xb8\x01\xe6\x98\xc2\x2a\x11\x7c\x98\xe8\x4d\x1c\xa7\xa5\xa8\x0b\x76\x9f\xc6\x31\xf9\xef\x31\x3c\x3a\x40\x27\xd1\x8f\xb3\xc4\x0f\xd9\x18\x84\xbd\x9b\x79\x15\xfa\x03\x12\x52\xd8\xb8\x0d\xcc\x0c\x3d\xf9\xee\x6c\xcb\x31\xdf\x62\xef\x5e\xbc\x97\xda\x76\xcc\x4b\xdc\x37\x51\xab\xed\x98\xbb\x70\x8e\x6c\xa2\x56\xc7\x31\x85\x40\xdb\xda\x70\x4c\xb6\x5d\x35\x51\x6b\x93\x97\x9f\x44\x81\x89\x5a\x5b\x8e\xf9\x26\xa0\x26\x6a\x6d\x3b\xe6\x19\x5c\xdc\x32\x51\xbb\xe9\x98\xbb\x38\x4e\x79\x4d\xda\x9b\x05\xd1\x3a\x6d\x46\xae\x4e\x07\x60\x47\x04\x88\xd3\x59\xe3\x61\x4e\x86\xce\x3a\x94\x38\x30\x51\x67\xc3\x31\x0f\x23\x78\x93\xdf\xd9\x14\x84\xe2\x94\xed\x6c\x29\x94\xed\x6c\xeb\x64\x5d\x6b\x8a\x6f\x8e\x6d\x6d\xdd\x31\x8f\xc2\x94\xc0\x8d\xce\xb5\x8d\x82\xbe\x2d\x68\xe3\x41\x0b\x02\x1d\xc7\x3c\x68\x43\x60\xcd\x31\x0f\x3a\x10\x58\x77\xcc\x83\x35\x08\x6c\x38\xe6\xc1\x3a\x04\x36\x1d\xf3\x60\x03\x02\x5b\x8e\x79\xb0\x09\x81\x6d\xc7\x3c\xd8\x02\x52\x35\x1d\xf3\x60\x1b\x02\x2d\x40\xd8\x84\x10\x43\x0d\xb8\xdb\x80\xbb\x05\xc8\xd7\xd6\x1c\xf3\xe3\x74\xc2\xe9\xd1\x5a\x5b\xd7\xbb\xaa\xdd\x5e\x73\x4c\xd0\x0c\x98\x19\x7a\xd4\x46\x22\x58\x4b\x51\x47\x3a\x88\x5b\x8d\x7b\xf2\x2c\x9f\xcb\xf5\xfc\x5b\x66\x3d\xf1\xee\xe5\x85\xfd\x82\x3c\xa6\x77\xf4\x8a\x22\x67\x52\x31\x4d\x4d\xa9\x26\x57\x76\x90\x16\x71\xcf\xa9\x45\x6c\xd4\x62\x26\xe1\x77\x44\xb7\x3b\x55\x47\x44\xc4\x2e\xa9\x86\xa5\x1e\x87\xf3\x86\x02\xe9\x93\xa8\x1e\x68\x8b\xef\x5e\x5e\xf4\x9a\x39\xa6\x99\x21\x50\x52\x42\xe3\xfe\xd7\xd5\x11\x09\x89\x09\x16\x1b\x4e\xc0\x16\x90\xc5\x6a\x0a\x4f\xb4\xa0\x8a\x7b\x54\xd0\x84\x11\xc4\x69\x66\x48\x34\x61\x41\xb6\x9f\x92\x21\xa7\x01\xe0\x7a\x1c\xfb\xde\xf8\x6f\x55\xe0\x6f\x97\x91\xd9\xe8\x5e\xdb\xf5\x83\xa2\x99\x3d\x50\x18\x92\x44\xf2\xb3\x7d\x6d\xa0\xb1\x2b\x77\x44\x28\x6d\xf8\x89\xfb\xa5\x1a\xc5\x17\x87\x81\x16\xb7\xb0\x4b\x96\x74\x63\x45\xaf\x64\x36\x3a\xd5\x18\x7d\x2c\x44\xb1\xbf\xbb\x5c\x9c\xe9\x6d\x26\x01\xc5\x5f\xaa\x08\xcd\x53\xe4\x02\x21\xe0\x4c\x76\xc9\x70\x4f\x49\xaa\x93\x86\x12\x07\x5d\xc7\x40\x6f\x16\xa2\xbc\xd1\x50\xde\xa8\x28\x6f\x2a\x50\x6a\x00\x15\xe9\x79\x89\x7f\x49\x5d\x63\x40\x31\x48\xa3\xb2\xbd\xbb\xa5\xbd\xfd\xaf\x2a\x93\x7d\x76\x36\x01\xd6\xeb\x99\x74\x54\x25\xf6\x48\x29\x27\x1f\x93\x20\x15\x34\x5d\x17\x78\x44\x98\x6b\x61\x59\x8e\xc5\x22\x02\x71\x1f\x7d\x55\x58\xe1\x72\x8e\x2a\x3d\x11\xf7\x59\x83\x60\x77\x98\x59\x59\x60\xc9\x2d\x6c\x70\x55\xe2\x7c\x71\xf2\x02\xb4\xc0\x26\x6f\x3f\x9b\x4e\x59\x60\x2a\xbe\x99\xc2\xac\x4a\x44\xca\xf7\xdc\xea\x27\xec\x8b\x9d\x79\x09\x8b\xb8\x91\x56\x5f\x10\xaf\xca\x92\x96\xf2\x45\x73\x2c\xf2\xbe\xae\xf2\xc9\x77\xbc\xc5\xb7\x52\x66\x7e\x51\x3b\xff\x8e\x62\x20\xd6\xbd\x56\xb8\x72\x1d\x59\xc0\xc9\x8b\xc8\xea\xa7\xd2\xee\xe2\x56\x32\x20\xdb\x57\x91\x19\xdf\x31\xcb\x63\xdc\x88\xdf\x6b\xec\x10\x77\xa4\x81\x7c\x86\xa8\x53\x35\xca\x4c\x99\xbc\x01\xd8\xde\x0b\x83\xc8\xbc\x7f\xd8\x20\x87\xe8\x33\x0d\x1c\x6e\xb2\x8b\xba\x78\x39\xc1\xd9\xbd\x6f\x80\x7d\xd0\x60\xe7\x1f\x27\xf0\x8c\x15\x4f\x05\x04\x1e\x19\xa9\x10\x44\x00\x2a\x63\x41\xc4\x28\x54\x11\x31\xc5\x08\x90\x11\x45\x7f\x88\x18\x3e\xa6\xb1\xac\xa6\x50\x35\x39\xc4\x4d\xe5\xc2\x0a\x2a\x64\x45\x45\x11\x49\x15\x05\x3b\xe1\x43\x34\xcb\x60\x7d\xfe\x54\xb6\x0a\xd6\x6a\x82\xf7\x9b\x4f\x91\xfb\x26\x49\xf0\xb3\xb2\x33\x65\x57\xf3\xb8\x2d\x37\xf3\x5c\x1a\x3f\x67\x8a\xbb\xb3\x60\x3a\xf2\x43\xe3\x82\x19\x47\x53\x63\xf6\x73\xcd\x8d\x1a\xcb\xaf\x99\xab\x31\x7c\xe7\xa4\xc6\x28\xb7\x34\x94\x68\x75\x87\x68\xa3\xd4\xe2\x07\x5c\xdf\x7c\xf7\x1d\xe9\x7e\x88\xdc\xbf\x08\xfa\x12\xb9\xdf\x7c\xf4\x2e\x72\x3f\x11\x34\xb5\x66\x73\x55\x72\x76\x7d\x54\x59\x2b\xc7\xf7\xd1\x5c\xc5\x9c\xbf\x02\x34\x57\x37\x67\xe2\xa3\xea\xea\x39\x9f\xe5\xd3\xee\x0b\x1f\x9d\xf8\xe8\xd2\x47\xe7\x3e\x3a\xf6\xe1\x1d\xd6\x47\x1f\x5c\x8f\xbe\xf1\xe1\xdd\xcf\x9e\x5f\x3c\x35\x7f\xe3\x67\xe8\x48\xf9\x5e\x69\x65\xe8\xd0\x77\xdf\xf8\xe8\xc0\x5f\x7a\x1d\xfb\x4a\x4f\x4e\xbd\x31\x01\x0b\x42\xbb\xc2\xce\x27\xfa\xa1\xa7\xf3\x91\x98\xa7\x7e\xd7\x53\xc1\xa2\x10\x49\xe9\x19\xd8\x12\x41\x37\x7a\x5a\x18\x3d\xa2\x6b\x3d\x6a\x44\xe4\x23\x77\x59\x1d\x66\x35\x17\x7d\xd6\xc1\x8e\x26\x13\x32\xf0\x31\x25\x79\xa5\xdf\xea\x00\x95\xd7\xd0\x3f\xe8\x30\x1f\xa3\x64\x82\x83\x3c\xf5\x8b\x9e\x7a\x1c\x3d\xe6\x49\xef\xf4\xa4\xa3\x41\x50\x14\xfc\x89\x11\xfe\x2f\x1d\x22\x1d\x47\xd3\x60\x70\xe3\x93\x60\x80\xde\xfb\xe2\x36\x02\x5c\x04\xf0\x77\xbe\xfb\xc5\x82\x65\xcf\x32\xf4\x9b\x50\x3e\x08\x87\x02\x88\xa6\xb0\x75\x0d\x53\xf7\xc6\xb7\x6c\x94\xa4\x6e\x8b\xac\xfd\x19\xa6\x3b\x37\x5a\x3e\x31\x0f\x01\xa6\x1e\xa6\x19\x8a\xd2\xa2\xa3\x01\x5d\x86\xb0\xc0\x17\x88\x5f\x5f\xfc\xa6\x0c\xbf\x97\xba\x8b\x8d\x0a\xa0\x69\xea\x5a\x21\x79\x34\x0e\xa2\x46\xe1\x5e\x03\xfc\x0b\xa4\x68\x98\xba\x33\x3f\x3d\x81\xbb\xf1\x64\x50\x21\x22\xac\x70\x5f\x1d\xfa\xfd\x5a\x66\xda\xd7\xae\xd5\x7e\xb3\x88\x0d\x52\x73\x86\x48\xf8\x7d\x4a\xa6\xe4\x82\xf9\x16\xa4\xaa\xc4\x09\xbc\xc4\x9e\x2d\xc0\x21\xcc\xa3\x32\x1b\xc3\x91\x66\x63\x38\x01\x1b\xc3\x09\x33\xf9\x88\x22\x30\x69\x0b\xcf\xb0\x51\x94\x9b\xb4\xa5\xc2\x3d\xa5\x30\x89\x9d\x7b\xf5\xb1\xa2\xc2\x80\x6d\x28\xdc\x4c\x45\xcc\x80\x2d\x81\xfd\xbe\xac\xe8\x39\xb7\x6a\xf2\xbf\xb9\xb2\xe0\xa1\xa6\xf5\xcf\x55\xfa\x20\x4a\x3c\x22\x6e\x2f\xa9\x75\x5e\x5e\xe3\x90\xd7\x38\xd1\x6a\x1c\x42\x8d\xc1\xbb\x0f\x53\xa6\x46\x21\xd3\x86\xa0\x84\xd5\xb8\x5d\xd4\x92\xe6\xb5\xa4\xb5\x9a\x95\x14\xb5\xa4\xa2\x96\x89\xa8\x25\xb8\x1b\x43\x83\x54\x2c\x05\x7e\xca\x7e\x51\x9c\xba\xa3\xd0\x82\xcb\xbc\x7d\x1e\x82\xdb\xf1\x29\xcc\xaf\x89\x32\xbe\xc7\x69\x86\x46\xa5\xef\x87\xd2\xf7\xb3\xf2\xdd\xcc\x50\x2f\x75\x17\x1a\xe7\x40\x4f\x4b\xe7\xc2\x63\xea\x36\xd1\xbd\x98\x3c\xfb\xe2\xf7\x54\xfc\x9e\xb1\xc9\xb4\x9b\xba\x33\x30\xa1\x26\x74\x15\x0e\x0d\xd1\x34\xcd\xf9\xa5\x73\xca\x3f\x45\x22\xff\xe2\x2e\x52\x44\xd2\xd1\x24\x66\xaf\x5d\x1e\x08\xbf\x6a\x2e\xa2\x8f\xf1\x73\x34\xa5\x1a\x24\x98\x9f\x16\xc1\x73\x32\x98\x7a\x24\xc9\xbf\x86\x22\xc4\x87\x28\x0f\xef\x91\xfe\x74\xc4\x2e\x78\x8b\x88\x7c\x75\xcd\x01\x86\x24\x49\xc8\x40\x85\x29\x5e\x78\x3b\xa7\x61\x86\xbe\x2d\x6f\xdc\xb5\xd6\x38\xaa\x36\xee\xb0\xba\x71\xe5\xd9\x23\x58\x99\x34\x89\x0c\xd3\xb3\x18\xf5\x3b\x61\xc3\x8b\x42\x0f\x53\xeb\x96\xdc\xd9\x6c\x47\x80\xf6\x42\x6b\x0d\xb5\xd1\x8f\x50\xf1\xef\x4f\xd9\xe5\x57\x3b\x9b\xa3\x9b\x3e\xee\x45\x59\x02\x03\x5c\xb5\xc8\x72\xb2\xea\x90\x7c\x26\x7c\x0b\xad\x5c\x2d\x4f\x05\x1b\x2f\x5c\xbe\x50\x44\x5c\x66\x75\x4d\x37\x2c\xed\xde\x12\x44\xef\x10\xc9\xd4\x6e\x52\xb1\x17\x46\x67\xab\xf0\x83\xff\xb5\x1d\x78\x45\xe2\x50\x94\x94\x30\x27\x0d\x78\xfb\x21\xdc\x42\x21\xe2\x26\x0d\xc6\x46\xdd\x99\x30\xeb\x2d\x76\x50\x62\x6c\x0b\x65\x01\x4e\xe9\x39\x1c\xa4\x26\x64\x20\x6b\x43\xb4\x58\x86\xcf\xa1\x19\x34\xa7\x21\xef\x83\xbb\x5f\x54\xfa\xde\xa7\x40\xe0\xdb\x52\x7d\x10\xb9\x13\x8d\x1c\x3a\xf3\x36\x8e\xd4\xc6\x91\x62\x3a\x92\x0c\xd1\x52\xb3\x38\xa9\x18\x4a\xe7\xb8\x3c\x72\x6f\x4a\x23\x77\xbf\x62\xe4\x56\xf5\xdd\x31\x54\x05\x25\x6e\x78\xdb\xbc\x03\x67\x26\xb7\xad\x3b\x59\x9b\xc3\xb0\x78\xad\x23\xc1\x9f\x14\x6e\xa7\x84\xe7\x3b\x9d\x79\x48\x03\x73\xd7\xd9\x10\x5e\x8d\x05\xcf\x33\x15\x3c\xcc\x32\xc4\xfa\xdf\x46\x49\x56\x9a\x4e\xf3\x24\x3a\xe6\x3c\x2e\x74\xe9\x6d\x33\xaf\x1d\x75\xe9\x6d\xeb\x0e\xdd\x5e\x87\xd6\x87\xb9\x31\x7e\x4b\x11\xb9\xb3\xf9\x91\xd0\xc5\xf2\xa9\xf9\x79\xf1\xd4\x3c\xa8\x9e\x9a\xdf\xe7\xf9\xce\x55\xc1\x77\xde\x8a\x8e\xe0\x43\xe8\x52\x7c\x0d\x9d\x37\x0a\xdf\x51\xc8\x2a\x5a\x73\x19\x5a\x27\xa1\x9d\xfd\x43\x9d\xca\xb1\x55\xf7\xea\xc1\xff\x29\xbd\x2a\x2a\x59\xdd\xab\x9f\x7f\xd6\xab\x27\xff\x6f\xf6\xea\xf9\x2f\xf6\xea\xf9\x3f\xda\xab\xe7\xff\x5f\xe8\xd5\xf3\xff\xaa\x57\x2f\x85\xc4\x70\x2e\x7e\x8f\x99\xe4\xf0\x71\x4e\xf4\x38\x85\x9b\x1e\xe8\x0d\x4b\xdd\x4b\xdd\x59\xe1\x99\x40\xaa\x81\x85\x2f\x02\xa7\x99\x75\x2f\x7c\xe5\x1d\x0b\x70\x3e\x79\x42\x18\x82\x4b\x0e\x30\x9c\x93\xfb\xaf\xec\x32\xa5\xfa\xba\x74\x34\xf1\xf2\xb2\x91\xfb\x9c\xd0\xcd\xee\x28\xce\xe3\xec\xfc\x8a\xe4\x9a\xf4\xc2\x51\x48\xa1\xc2\x71\xc3\x4c\x04\xc4\xeb\x31\x78\xa3\x22\xd3\xba\xa0\x53\xf2\xc3\x29\x81\x53\xc7\x10\x7a\x45\x9c\xb6\xe5\x3e\x83\x59\x15\x84\x5b\xb3\xae\xea\x76\x56\xba\x1b\x85\xdb\x29\x02\x71\xa1\xdf\xef\x2a\xee\x48\xf3\xec\x12\x4c\xa6\xa0\xb0\x40\x9d\x65\xe8\x44\xa1\x14\xec\xba\x2e\x7d\x77\xf1\x95\xa0\xc2\x49\x1c\x9c\x96\xa7\xa0\xcc\xc0\xe0\xcd\x54\x5a\xd2\x57\x9c\x35\x49\x45\xe2\x43\x68\x4d\x72\xff\x49\xe0\x77\x55\x58\x4f\xe7\xea\x45\x7e\x51\xd4\xc1\xee\x47\x2b\x40\x18\x24\x68\x08\x24\x00\x77\x7b\xa7\x6a\x83\xa2\x18\x6a\x64\x3a\xd8\xfd\x21\x21\x7f\x54\x43\x8a\xfb\xae\x0e\x16\xef\x80\x30\x9a\xb1\x5b\x9c\xe2\x31\x64\x06\x59\xc5\x0b\xa1\xb9\x94\x32\x2e\x60\x33\xf0\xb6\x14\xca\xbd\x91\xe5\xde\xcc\x95\x2b\x15\x41\xc5\x35\x84\xfc\x7d\x1e\x1c\x52\x33\x63\x0c\xb5\x5a\x91\x9a\x5f\x52\x48\x8a\x54\x2b\x68\x44\x21\x53\x7f\xba\x47\xc4\xce\x3e\x12\xb6\x91\x60\xdb\x3a\x1f\xa5\xdd\xb0\xb8\xf0\xe1\x1b\x7e\x68\x60\xb8\x96\xbb\x92\x94\x8d\xe5\xf9\x76\xad\x36\x67\x41\xcf\xcf\x1d\x7a\xe0\x5b\xff\x0e\x32\x9a\xcc\x76\x12\x1c\x1e\xf8\xcc\x1f\x03\xdc\xa2\x06\x47\x08\x90\x1c\x94\xb3\xa7\x70\x80\x0d\xfe\x62\x43\x77\x96\xd9\x28\xbc\x4d\xef\x5c\xd3\xe4\x53\xc0\x5c\x60\x1b\xdc\x84\x4b\x51\xb5\x5a\x6e\x43\x5c\x7e\x2f\x37\x06\x5e\x86\x2a\xdb\x04\x97\xe9\xb9\x81\x68\x11\x61\x91\x8a\x36\xef\x10\xb8\x4a\xea\xde\xde\xd9\x0e\x6c\xbc\x5f\x5e\x6e\xef\x6c\x7e\x61\xc5\x17\x9e\x68\x0b\x6a\x8a\x01\xec\xb9\xc9\xad\x7f\x07\xa3\x3a\x10\x32\x37\xde\x01\x92\x89\x01\x82\x2a\xc9\xed\xad\xb8\x6e\x20\x6e\xcb\xac\xb8\xde\xcb\x0b\x0f\x04\x76\x99\xd0\x80\x96\x33\x22\x4e\x6e\x7b\xa5\x82\xd4\x2f\x2f\x5e\xad\xe6\x55\xc5\x57\x75\x41\x8e\xcc\xb3\x2b\xf2\xd4\x6a\xc1\x6d\x7a\xb7\xe2\xba\xde\x6d\x7a\x37\xdf\x87\x10\x6b\x0b\x37\x39\x40\x2a\x49\x2e\x44\x72\x32\x81\xb7\x5e\xd7\x5b\xde\xd3\xd0\xb6\x1d\xcb\x73\xbd\x1d\xaf\xd1\xeb\xc1\x43\x68\x49\xae\xc0\x0d\x76\x82\x52\x9c\xa0\x52\xad\x06\xbc\xd7\xab\xd5\xe6\xba\xc6\x83\xa3\xc5\x7c\xd8\x30\xe4\x70\x09\xc9\x7b\x79\x91\xde\x16\xf3\xb9\xe5\x15\x37\xb8\x8b\xb8\x97\x97\x39\x94\xa6\xf9\xca\xb3\x9d\x7f\x66\xec\x55\x0f\x35\xd9\xf9\xb5\xda\x1e\xb1\x22\x70\x19\x44\x5e\x5e\x44\xb5\x39\x51\x2b\x06\xa1\x67\xdb\x59\x38\x47\x01\x31\x62\xb8\x77\x1b\x82\x2c\xaa\xf9\xe1\x64\x17\x28\x15\x87\xc1\x2f\xee\x1a\xe8\x0b\xce\xe7\x99\xb6\x3d\x0b\x81\x33\x57\x80\x33\x9e\x72\x94\xa2\xc3\xb4\xea\xde\x14\xd8\xc5\xba\x20\x74\x47\xfc\x3a\x17\x84\xa2\x83\xd4\xfd\x35\x4b\x5a\xe8\x2a\xe5\x2f\xcd\x3d\xe2\x07\xe8\xc7\x32\xdd\xc2\xf7\x05\x0b\xfc\x0d\x68\x15\xae\x53\x77\x0b\x7d\x4e\xdd\xd6\x06\x7a\x9b\xba\x9d\x36\xfa\x00\xb1\x5f\x52\xb7\x85\xde\xa5\x6e\x1b\x7d\x4a\xdd\x0e\xfa\x2b\x75\xd7\xd0\xfb\xd4\x5d\x47\xbf\xa5\xee\x4d\x0a\x4e\xb4\xa1\x1b\x10\x15\xbf\xa1\xe7\x36\x51\xe2\xb9\x1f\x52\x14\x89\x28\xec\xb9\x85\x27\x6d\x14\x68\x5f\xbe\x80\x49\x21\x9b\xe7\x81\x98\x31\x85\xe0\xd0\x73\xd7\x9b\x4d\x70\xa7\xcf\x92\x63\x96\xd2\x17\x5f\x63\xf1\x3b\x61\xb1\xd2\xdb\x3d\xf7\x9a\xff\x2c\xbe\x7a\x80\xe5\x49\x7c\x3c\x7a\x6e\xb3\x7b\x94\x96\xba\x2b\x7f\xe5\x51\xf2\x7f\xd8\x15\x0b\x3f\xdc\xd0\x94\x6b\x70\xee\xa9\x2a\x5f\x82\x4b\xab\x32\x38\x3b\x79\x79\x39\xf2\xf3\x45\x17\x64\xa6\x66\x57\x3a\xa1\x89\x5e\x87\xb6\x7c\xb2\x06\x29\x2d\x44\x15\x4f\x52\x1d\xc7\x4b\xe0\xa8\x82\x24\x96\x7a\x45\xc9\x58\x87\x33\xb9\x27\xd8\x7c\xa3\xb5\x1a\x65\x8e\x77\x6b\x35\xf0\x00\x14\xb2\x05\x87\xd9\xd5\x97\xc7\x74\x73\x5e\x1c\x85\xc8\xb5\xaf\xc7\xb6\x50\xe9\xa2\x93\xd1\x72\xf6\xa8\x45\xe5\x0b\x90\x2b\x38\x33\x51\x93\xd7\x9c\xe7\xd0\xa2\xaa\x47\x48\xdd\x0b\xa3\x06\xdc\x6a\x3a\x40\x4f\x8d\x32\xfc\x39\x07\x02\x1a\x42\x19\x8d\x9e\x38\xdb\x43\x1f\xa9\x15\xa5\x08\x37\x7a\x82\x64\x6c\x7f\x6d\x97\x23\xdc\x48\x2b\xa0\xe3\x14\xbd\x53\xda\xb7\x4b\x4a\x80\xe6\xcf\x8a\xa4\xc8\x59\x45\x05\xbb\x56\x8b\xc0\x7b\xde\x34\x11\x23\xc1\xb1\x3e\x52\xeb\x39\x45\xad\xda\xb3\x22\x36\x51\xb7\x2f\x01\xe4\xa5\x65\xba\x43\xa5\x18\xc7\x04\x60\xbb\x5b\x91\x51\xab\xf0\x36\x54\x38\xaa\xee\x8d\x3f\xdd\x10\x35\x57\xd8\xf5\x4a\x52\xf0\x0b\x9b\x89\x9e\x91\x6c\x4e\x2c\xeb\xd0\xd5\x78\xca\xc6\x1a\xc8\xb1\xd8\xa5\x65\x17\xc2\xbc\xa2\x98\xbd\xa1\xcb\xcd\x82\x8b\x99\xd8\x80\x77\x33\x2c\x6c\x03\xf9\x9f\x53\xa4\xb6\x57\x3f\x94\x96\x87\x6b\x39\x0d\x32\x36\x70\xc5\x9d\x6a\x16\x96\xe2\xe6\xdc\xd8\x6b\x6a\xc3\xbb\x2d\x49\x00\xfd\x2f\x2b\x48\x80\x09\x17\x37\x5c\x05\x17\x99\x8f\x50\x9a\xdc\x06\x09\x55\x9f\x89\x08\xbb\x6f\xa8\x45\xd1\x5e\x31\xf1\x10\x81\xf1\x0a\xca\x73\x77\x37\x94\xdb\x9f\x08\x11\x84\x81\xbd\x6b\x08\x5b\x68\xce\xcb\x2d\xce\xb7\x14\xb8\x52\x78\x94\x34\x95\x5a\x41\x78\xd4\xa5\x78\xc1\x05\x27\x5f\xd0\x72\xb7\x35\xa7\x55\x12\xed\x51\x17\x16\x16\xb5\x07\x57\x19\xa5\x2c\xbf\xd2\xec\xb2\x19\x28\x2f\xae\xaf\xb4\xba\x55\x78\xa0\x7a\x7c\x42\x2a\xda\x49\x11\xb3\x23\x7e\xd9\xf8\x04\x77\x6b\xe2\x95\xbd\xef\x46\x70\x48\xba\x47\x12\xff\x41\xe0\x3a\x48\xa2\x09\x23\x63\xb7\xa2\xa5\x7e\xad\x36\x05\x4a\x46\xc8\x87\xdd\x23\x16\x4b\x62\xe2\x0e\x53\xcd\x3f\x2c\x46\xb8\x4a\x79\xef\x52\xd4\xe7\xd9\xe1\x75\x26\xa2\x6e\x9a\x14\x9d\x01\x76\x5f\x60\x44\xb1\x56\xb2\xa1\x02\x37\xbd\x73\x00\xde\x53\x72\xce\xe4\x9b\x5b\x31\xa3\x36\x1c\xc2\xbc\x32\x62\x37\xb7\x02\x7d\xf9\xcf\x0f\xac\x33\x0b\xdb\x08\x78\x2d\x6e\xf4\x80\x9e\xd3\x54\x9c\x1f\xb3\xd6\xa6\xd3\x80\xca\xd1\x8f\xdd\x3c\x4a\xba\x0c\xc6\x08\xb6\x65\xd0\xac\x87\x08\xf0\x10\xf7\x13\xb5\x30\x90\x31\x10\x73\xa2\xe9\x50\x37\x50\x1a\x0c\x44\x92\x17\x64\x45\x3b\x1d\xea\xfa\xcb\x21\x5a\xf0\x7a\x75\x39\xc8\x9a\x43\xdd\x44\x01\x81\x7a\xb0\x3a\x42\x65\x22\x05\xbe\xe4\x3c\xb9\xd3\xdc\x40\x58\xf7\x95\xdc\x15\x15\x17\xdf\xf9\x84\x2e\xaf\x8e\xa8\xd4\x31\x70\x3d\x17\x9e\x24\x50\x2b\x82\xbd\x5c\xc0\xf9\x49\xc4\xe6\xa3\xa8\xe5\x7f\x8b\xd3\x9f\xc7\xc9\x16\x0a\xbe\xac\x46\xae\x36\xed\x84\x53\x68\x10\x00\x45\x68\xce\x61\xd8\x56\xdb\x66\xd7\x74\xa2\xaa\x6a\x68\xd3\x51\xbc\xba\x80\x71\xb2\x83\x65\x0d\x85\x6e\x84\xc9\x19\x36\x4a\xf9\x34\x10\x5b\x70\x64\xa9\x2b\x24\xc3\x21\xb3\xc1\x61\x24\xb6\x41\x08\x50\x97\x9e\x5c\x86\xb0\xb0\xba\xd5\x97\x2e\x1a\x41\x36\x3d\x4f\xdd\xb7\xc4\x5a\xb8\x44\xab\x96\x8b\xd1\x65\xea\x52\x84\x5d\x50\xf8\x34\x6d\x84\xd9\x4e\x34\x74\xfb\xa9\x25\x5c\xac\xc1\x98\x40\x62\xe6\xb9\x61\x37\xec\xda\xaa\xe3\xfd\x7a\xa7\xa6\x7c\xbe\xb4\x9a\xed\x35\x55\xb5\xc1\xea\x6a\x50\xd9\x1b\xa1\x10\x69\xf2\xa9\x5c\x1a\x4b\xeb\xb2\xdf\xb9\x8c\x23\xfb\xa5\x56\xfb\x2b\x94\xfd\x56\x3d\x1a\xe4\x4e\xd1\x85\xab\x64\x92\x96\x2c\x49\xbc\x78\x73\x71\xee\xeb\x1a\x7d\x86\xdd\x01\xb6\x77\xf8\xc9\xb6\x7c\x6c\x1e\xd4\x6a\x2c\x21\x98\x13\xee\x5b\x1b\x36\xc2\xac\x09\x4b\xc4\xae\x9d\xf9\x45\xaf\x72\x89\x87\x85\x80\x95\x6b\x3b\x96\xa0\x8b\xaf\x71\x37\x1b\x09\x62\x6c\x48\x62\x94\xc9\x90\x5f\xc0\x32\x5a\x1d\x09\x93\x4b\x2e\x52\x48\x13\xf1\x3f\x91\xd5\xd0\xdc\x80\x96\x85\xed\x88\xea\xb8\xb1\x36\x12\x1c\xb5\x2f\x05\x88\xa8\xcb\x7f\x3d\x69\xc3\xf9\x49\xbb\x29\x71\x8a\x62\xcb\x95\x2d\xd5\x61\x8b\x5d\x14\x32\x5a\xed\xa5\xd9\x8a\x91\x30\xd7\x86\x26\x2c\x25\xd1\x9c\x64\x3a\xd7\x10\x5f\x99\xb2\x72\x04\x62\x2e\xd8\x0a\xa7\xbf\x25\x14\xc0\x3c\xb8\x7c\x9b\xea\xe2\xac\x5d\x8e\x70\x03\xb9\x70\xf9\xa0\xbb\x48\x5d\x5f\x08\xcc\x4d\xd7\x75\xad\xc0\x4d\x7d\x2b\x45\x81\xbd\xd3\x74\x9a\x2f\x95\x1e\x32\x1b\x3d\x0f\x07\xde\x34\xc8\x2d\x6b\x0e\xde\xfa\x34\xdd\x59\x10\xcf\x90\x39\xc5\x0e\xcc\xe6\xd2\xa6\x9f\x13\x09\x78\x50\xfe\x51\xab\xad\x28\x5b\x9a\x99\xca\x95\xe4\xb2\xc1\x25\x15\xc6\x45\xc4\xbb\xbe\x34\x1f\xda\xb5\x9a\x25\x7d\x0f\xbb\xd4\x96\xca\xdf\xb4\x2b\x15\x3f\x69\x43\x7d\x66\xa3\xec\xbb\x3c\x7b\xe6\xbb\xa9\xe8\x2a\xa9\x45\x9e\xba\x9e\xe0\x64\x82\xc6\x02\x78\xca\x75\xb5\xd3\x86\xa0\x3d\x30\xf4\x5a\x0d\xe4\xea\x69\x23\xea\x33\x7f\x69\x8c\x24\xb5\xc0\xb6\x67\xe0\xa3\x96\x39\x65\xae\xd5\xac\x29\xdc\x75\x08\x19\xf9\x6d\x34\x15\x97\x07\x70\x68\xa5\x68\x6a\x43\x3f\xe9\xf3\xfb\x35\xa8\x0d\xca\x91\x70\xdf\x61\xea\xa6\x85\x74\x21\x3b\x73\x5a\xab\x4d\xab\x10\x94\x23\x01\xc1\x6f\x34\x27\x13\x0c\x71\xaf\x2a\x5f\x39\xd2\x95\x5d\x90\x4d\xdd\x29\xf3\xb9\x2d\x7a\xc2\x77\x5b\xcd\xa2\x8d\xdc\x8d\x34\x28\xbb\x59\x80\x9f\x43\x48\xc2\x16\xf4\xf6\x6d\x5f\xf6\x53\xda\xcd\x3b\xd4\x77\x53\xd9\x67\x3e\xa7\xb1\x0f\x98\xec\x99\xfa\x5a\x26\x2b\xb0\xb0\xc1\x2b\x56\x02\xf0\x5c\x2d\x51\x4a\xdc\x08\x10\x8a\x4c\x79\x64\x96\xba\x7e\x26\xe6\xad\xc2\xb4\x55\x16\x59\x5a\x35\xb6\xe5\x6c\x97\x5b\x4a\x14\x94\xe7\x6b\xe4\x06\x05\xaa\x62\x3f\x40\x43\x0b\xa3\xa0\xb8\x17\xa5\x8e\x0e\x10\x13\x98\xac\xa6\xaf\x05\x68\x31\x03\x5c\xab\xa8\x07\x88\x57\x25\xf6\x63\x8b\x68\x01\x62\xa3\x44\x34\x16\xf1\xc5\x56\x60\xcb\xd7\xc2\x48\xf2\x30\x06\x5f\x6e\x58\x91\x61\xf3\xbf\xe6\xbf\xff\x8d\xbc\x2c\x77\x39\x6c\xf7\xb2\x63\x11\x78\xf9\xce\x76\x2e\xb6\x43\x40\xbd\x21\x89\x3e\x80\x5f\x56\x9c\xd8\x0d\x00\xb3\x47\xa5\xbd\x00\x29\x5a\xb5\xed\x94\xb7\xbd\x25\xc1\xb4\xb5\xbe\x21\x36\x97\x42\xa9\x76\x2f\x94\x3c\xfb\xfc\xb7\x7b\x1c\x29\x77\x39\xf9\x66\x4d\x51\xfc\x30\x6f\xbc\xa4\xc2\x7d\xb4\x90\xcf\x80\x17\x64\x48\xc3\x31\x0d\x99\xcb\x67\xb7\x6c\x6e\xcf\xad\x40\x42\xdd\xd2\x92\xdb\xbd\x88\x78\x53\x85\x8f\x3d\x05\x09\x65\x3e\xb0\x21\x36\xb3\x33\x74\xa0\xbb\x00\xf1\x87\x16\x7f\x12\x52\xf8\x61\xa7\xee\x3b\x6a\xb1\xcb\x50\xad\xf5\x26\x6a\x35\x9b\x76\xf7\x40\x88\x29\xc2\xf9\x77\x96\xa1\x2b\x1d\x4d\x81\xa3\x56\xb3\x18\x74\x47\x40\x77\x6c\x3b\x43\x3f\x7e\xa1\x50\x28\xb1\x4b\xe1\xc2\x15\x15\x8d\xb0\xd1\x7c\xb9\x89\x8a\x89\xad\x10\x52\xdd\x45\xf5\x53\x27\x30\xe0\x64\x89\x2d\x61\xc8\x1c\x89\xa0\xc2\x10\x49\x28\x0c\x82\x00\x51\x56\x5c\x71\x82\x18\xba\xa4\x1b\x8a\x37\xe5\x20\xd3\x74\xed\xd0\xd5\xbe\x39\x50\x08\x97\x4f\x92\x67\x7e\x8d\x35\x4a\xde\x04\x81\x30\x29\x73\x0b\x85\xb8\xe6\xab\xf7\x17\xa7\x1f\x1b\x5c\x9d\xed\x0f\x9f\x2d\xd3\x7c\x45\xed\x57\xff\xbe\xbb\x85\x22\x5d\x51\x87\xbb\x7f\x43\xbd\x9a\x5d\xfa\x3a\x94\xef\x19\xe9\xab\x57\x52\x53\x18\xde\xd2\x3b\x58\xa5\x22\x90\x3c\xc1\x02\x0c\x78\x25\x64\x04\x86\x80\xd4\x15\xfe\x45\xac\x88\xed\x1e\x56\x70\x69\x5f\xb1\x0d\x6e\x8d\x8f\xe1\x25\xe5\x11\x13\x81\xb2\x2c\xab\x3c\xf7\xfa\x6c\x15\x3b\x39\xfd\x7c\x8d\x13\xc6\x02\xd2\x31\x29\xc1\xae\xd5\xbe\x5b\x04\xad\xac\x84\x0d\xe9\xa6\x12\x51\xe6\x25\x2b\x43\x7d\xf7\x6d\x82\xc6\x4b\x0e\x16\x7f\x4b\xbb\xbf\xa5\x2f\xee\x1a\xbb\x6c\x20\xa6\xdd\x35\xb5\xb6\xb7\x10\xd1\xce\x8e\x79\xa6\xe2\xd0\xda\xfa\x2d\x75\x31\x6c\x56\x6e\xd2\x5a\xed\x03\xb5\xa0\xb0\x49\x51\x8e\x3d\xb3\x7e\x4b\x6b\x56\xeb\xe5\x73\xfa\xf2\x36\xb5\x25\xa0\xf5\x19\x86\xae\x1f\x59\x30\xf0\x02\x7d\xb8\xc8\x93\x74\x51\xa3\xb6\x5a\x23\x78\x06\xaa\x15\x1d\xea\x45\x33\x26\x70\xea\xb9\x33\xf1\xfe\xe2\xf6\x1d\x41\x9f\x08\xfa\x8b\xa0\x29\xfa\x2d\x42\x11\x45\x45\x49\xf6\x0c\x83\x7b\xf9\x04\x2e\x5a\x0d\x50\x8c\x4e\x08\xf2\x09\xf2\x23\x94\x5f\x07\x5a\x69\x65\x77\x59\x77\xa5\xca\x45\xda\xd0\x0f\x07\xec\x5e\xe2\xdb\xe7\xc3\x28\xa5\xd2\x72\x65\xb7\x1f\x59\xfc\x68\x93\xa0\x19\x3c\x27\x48\xfc\x01\x39\x8c\xa2\xfb\x8b\x42\x0b\x23\xa3\x95\x2d\x4a\x4a\xe8\x85\x38\xf9\x17\x16\x5d\x45\xbc\xb8\xdf\x2c\x2e\x4a\xaa\x66\x2d\x0b\x45\xfe\x39\x19\x3a\x8b\xb5\xfc\x50\x51\xb5\x86\x6f\x9f\x59\xb5\xb5\x4b\x04\x92\xb8\x6e\x02\x0f\xcb\x8a\x6d\x00\x04\x1c\x52\x6c\x20\x32\xb4\xa0\xd9\x55\xd8\xe8\x0e\x7b\x39\x05\x38\xb2\xb9\x5a\xa4\x07\x51\x72\x4e\x86\x09\x49\xc7\x7a\x4b\xab\x23\xa3\x48\x6c\xa3\x53\x42\x05\x84\x46\xa7\xe2\x6a\x36\xab\x9c\x78\x9d\x63\x67\xd6\x6c\x51\x85\xbf\x10\xd4\x9f\x02\x06\x58\x0b\x9d\x26\x02\x97\xb2\x70\x63\xc6\x6c\x6d\x34\x5a\x6b\x8d\xa6\x89\xf8\x6a\x41\x92\x33\xec\xdd\xe3\x11\x61\x2f\xa1\x4c\xa6\xda\xaa\x0f\xa2\x89\x99\xc1\xb2\xf7\xf7\xdd\xb6\xb9\xa7\x1e\x92\xde\x74\xce\x98\x37\x32\xf7\x30\x42\xe0\x4f\x28\x1c\xec\x9d\x9e\x00\x0b\x53\x26\x83\x72\xef\x80\x94\x2d\x55\x80\xf0\x4a\xf2\x47\xdb\x32\x95\x48\x33\x3a\x95\x77\x68\xfd\xa1\x55\xdc\x43\x61\xc8\x2b\x36\x11\x72\xa1\x2c\xf1\xab\xd6\xd6\x96\x6d\x77\xf5\xb8\xf6\xc6\x16\x12\x8f\xf9\x99\x29\x6a\x62\x17\x2a\x22\x36\x9a\xf2\xfb\x06\x0b\xc6\x13\x6d\x0c\x83\x69\x3a\xbe\x78\x0e\xbd\x12\x0f\x00\x1d\x07\x70\x0e\xc1\x37\x56\x60\x96\xcf\xd5\x48\xba\x72\xcf\x99\x45\xab\xcc\xbe\xb6\x75\xf6\xa5\x30\x2e\x60\x1e\x48\x30\x2c\x2a\x15\x28\x5a\x25\x60\xdd\xfa\x25\xaf\xf4\x7b\xf9\xb2\x4e\xc1\xbd\x2f\x5c\x29\xa3\x82\x88\xff\x00\xc2\x96\x40\x28\x44\x8f\xfc\xd6\xfb\x1b\x5a\x35\x5a\x00\x39\x29\x23\x5f\x2b\x70\xaf\xac\xc8\xa1\x71\x1e\x45\x85\x99\xe8\x5a\xcd\xfa\x90\xa8\xb7\x99\x64\x15\x44\x3d\x56\x5a\x05\xe7\xb4\x67\x95\x28\xc4\x5b\x01\x55\x90\xb1\xc1\x9b\x22\x10\x23\x97\xb2\xfb\x8c\x67\x0d\x38\x43\x4b\x61\x51\x52\x12\xb5\x69\x51\x79\x3b\xf6\x90\xc9\x36\xa8\xfd\x3a\x37\x74\x2b\x56\x66\x55\xf5\x2d\x93\x6e\xdb\x77\x3b\xea\x07\x63\x0b\x7a\x75\x78\x27\x5d\x4c\xfb\x34\x21\xe4\x28\xa4\x51\xd1\x1a\xb5\x7c\x14\xa2\x28\xa7\x6e\x58\xdd\x75\xc5\x4c\x55\x1c\xf4\x57\x4e\xc3\x52\xf6\xce\x96\xd6\xf1\xd0\xbe\x10\x81\xc1\x31\x56\x55\xc1\x98\xdc\x9c\x31\x65\xca\x12\x06\xa0\xf6\xcc\x04\x4f\x1d\x20\xcb\x78\xd4\xec\x12\xd8\x1b\x46\x09\x4d\xdd\xd0\x5a\xdb\xb2\x7f\x02\x2d\x13\x8d\x44\x5d\x79\x89\x14\x77\xc4\x3d\x04\x6a\x77\x89\x03\x82\x55\x57\xec\xd6\x13\x37\xac\xb7\xfe\xfc\xf3\xcf\x16\x8a\x5c\x72\x9b\x30\x39\x68\x45\xb0\x96\x15\xbe\xe3\x7e\x1d\x58\x60\x10\xce\xb6\x85\x6e\xa0\x0b\x70\x2e\x45\xe4\x36\xbc\x03\x3b\xc2\x6e\x92\x15\xe6\x8c\x23\x6d\x15\x22\x70\xd3\xae\x20\xa1\x60\x1b\x05\x34\x56\x16\xe2\xdb\x26\x2b\x3c\x2f\x5a\x69\x43\x1c\xc5\x16\xef\x16\x1e\x0f\x68\xdd\x50\xb4\x04\xb2\x27\x6e\x13\x45\x45\x63\x93\xd7\x91\x68\x1e\x76\xdb\x7f\x58\xc9\xab\x96\x5d\x6f\x21\xdf\x25\xb7\xf8\x0e\xa5\x2e\x7e\xd5\x42\x9e\x4b\x6e\x53\xbd\x40\xbf\x56\x6b\xfe\x19\x58\xa0\xc7\xb3\xf3\x48\x8f\x47\x7a\xc8\xb7\x77\x2c\xd6\x70\x0f\x41\x4e\x37\x44\x89\x9b\xda\x0e\x8f\xf3\x11\xa0\x66\x71\xb8\xd0\xec\xae\x58\xf3\x68\xc2\x32\x1d\x55\x74\x59\x96\xef\x89\x65\x00\x28\x56\xd0\x2b\xd0\xfb\x36\x8d\x12\xca\xfc\x1e\xd4\x69\x11\x96\x03\x50\x5c\xf1\x76\x48\xc3\x1f\xd4\x69\xc3\x1f\x14\x68\x7c\x20\xbb\xa4\x1d\x75\x23\xeb\x63\xae\xc5\xa1\xda\x0d\x39\x9a\xbf\xad\xb0\xb1\xf5\x51\x6d\x19\xd3\x4a\x27\xcc\xa6\xfe\x6b\x97\x88\x36\x75\x01\x08\x29\x75\x99\x3b\x84\x47\x89\x75\x0c\x3b\x0b\x5e\xa8\x32\x6e\x52\xc1\xf4\xae\x60\x97\x09\xf5\x43\x2b\x07\xb6\xa8\x08\x8c\x43\xeb\xd8\xb6\x0f\x60\x37\x3a\xb4\x3c\x51\x8f\x8a\xca\xd7\x6a\x03\x4b\x1c\x63\xf1\xba\xd5\x89\x5a\x88\xc7\x04\xf0\xd9\x01\x94\x71\x55\xab\xf1\xc2\x62\xcb\xb6\xd1\xa1\xb4\x7e\x9f\xb8\x47\x6c\xe1\x01\xea\xf8\x60\xd3\x7a\x8f\x95\x2d\x4b\xd8\x03\x33\x96\xd6\x5e\xa9\x55\x7f\x86\xf6\xcb\x0b\xa9\xd5\x56\xfa\x96\x6d\x8b\x91\x17\xb8\x7b\x39\xf1\x14\x95\x4c\x60\xcf\x8a\x78\xc6\x5c\xd1\x91\xbb\xd7\x88\xd5\x77\x68\x6c\xe1\xf7\xdc\x60\xae\x9c\xd7\xa0\x1e\x0a\x5d\x85\xeb\x31\xbb\xe4\xa8\x62\xe9\xf7\x76\x94\x62\x3c\x67\x0f\x94\x04\xd6\x31\xdc\x68\xb3\x8e\x6d\x04\x2d\xe3\x7a\x25\x0c\x8d\xe3\x6d\x54\x54\x3e\x7b\x36\x54\x61\x9a\x5f\x75\x80\xaf\xa1\x46\xea\x21\x27\xf5\x50\x21\x35\xd3\x99\xad\xb4\xe4\xc8\x9d\xe6\xeb\xf3\x9e\x6c\x67\x02\x74\x6e\x29\x1d\x32\xb5\x48\xbe\xa1\x14\xaf\xa4\xf3\x53\xa3\x7a\xab\x2b\x8e\x99\x05\xc2\xf6\x7a\xb3\x74\xbe\x50\xe8\x3b\x4b\xfa\xf2\x16\x59\xcb\x2f\x12\x8a\xa8\x75\xd2\xc9\x98\x25\xf5\x21\xdb\x22\xf4\xd1\x18\x7a\x45\x71\xc6\x99\x93\x4e\x7a\x1a\x2c\xa8\x9a\xdf\x88\x3a\x21\x69\x8a\x47\x4c\xf1\x1a\x92\x80\x77\xf4\x84\xb7\x4e\xd8\xce\x7f\x28\x56\x9b\x7c\x22\xad\xb8\xee\xc4\x86\x41\x25\xf4\x0b\xa5\xee\xeb\x4e\x2c\x50\x96\xd8\x88\x63\xca\x3c\x2c\xc8\xc1\x17\x97\xc2\x73\x85\xf5\x80\x9a\x36\x22\x59\x86\x9e\xdd\xc2\x2a\x7d\x57\x1f\x0e\x6a\xf9\xa2\xe9\x05\x6c\xfd\x39\x43\xc3\x02\x82\xd8\x33\x59\x3f\xc5\x41\x86\x35\x44\x50\x1d\xc7\x9a\xb8\x04\x95\x4a\x87\x0d\x96\x92\x1f\xd6\xf3\x91\xab\xc0\x00\x7b\xca\x50\x5c\x80\xd8\x33\xd5\x89\x86\x35\xb2\x61\x03\xab\xa4\x0a\x51\xa6\x95\xa1\xb1\x4a\x98\x21\x3c\x2a\x3b\x48\xf0\x84\x9c\x6b\xb2\x9c\x3d\xe3\xfa\x50\x46\xf9\x9e\x2b\x2c\xd0\xc4\x24\x81\x9d\x3a\x6c\x47\xd0\x93\x8c\x84\x56\xa3\x47\xf9\x55\xd4\x11\xdd\xcb\x38\xb5\x66\xa5\xc1\x50\xdc\x81\x8b\xc2\x34\x0a\xc4\x2a\xb5\x9f\xe7\x84\xa2\x82\xdc\x5b\x26\xab\x68\xb7\x62\xbc\x08\x70\xf1\x3c\x55\x87\xaf\xd5\x04\xee\x06\x61\xe2\x83\x79\x39\xf6\x53\xa3\x9f\x44\x8f\x29\x49\x8c\x41\x44\xd2\xf0\xdf\xd4\x80\x8b\x76\xe0\x5e\xb2\x12\x45\xc3\x38\xc1\xf7\xc4\x00\x23\x92\x06\x1d\x63\x6a\x3c\x47\x53\xe6\x2a\xd2\xc0\x46\x1c\x05\xcf\xe0\x02\x07\xae\x47\x46\x01\x78\xd7\x17\xa8\xd3\x86\x01\x4e\x6b\x53\x67\x75\x75\xd8\x6f\x4c\xc8\x2a\xdb\x5d\xd4\x25\x7c\x6a\xda\xa8\xa2\x25\xfb\x7f\xaf\xba\x55\x04\xfa\xdf\x54\x5b\x60\x5b\x73\xf7\x3d\x7a\x95\xb7\x3c\x7a\x30\x0b\xec\x9f\xce\x97\x9e\x70\xf7\x50\xf0\xbe\x53\xf7\xe9\x57\x27\x9b\x00\xac\x9f\x72\x6e\x73\x06\x4b\xcc\x2e\x9b\xd8\xe8\x1b\x3c\xa1\xbe\x70\xd7\xd1\x89\xdb\xec\x56\xcc\x02\x43\x47\x6e\xd9\x7f\xba\x27\x99\xaa\xea\x81\x3b\xe5\xbf\x30\x49\x88\x3d\x6b\xfe\x49\x5e\x5e\x5a\xed\xf5\xd7\x64\xa7\xd4\x6d\x7a\x26\x83\xe2\x7b\x92\xb2\xe1\xc2\x7d\x4b\x19\x7e\x48\x8d\x3e\xa1\x8f\x84\x84\x46\xd3\xc0\xe1\xc0\x68\xb5\xd7\x91\x01\xd9\xc0\x91\xe8\x10\x72\xc2\xfe\x2a\x35\xc6\xfe\x68\x4c\x12\x18\x78\x21\xc0\x18\xc3\x38\x35\xfc\xd4\x08\x23\x6a\x4c\x43\x31\x10\xc8\xc0\xb4\x9d\x0b\xb7\xf9\x9a\xec\xb0\xbb\x8c\xe0\x85\x29\xb1\x5a\xa4\xb3\x4a\x6c\x67\x9d\x6b\x7a\x2f\xb9\xeb\x48\x8d\xb5\xa2\x73\xf7\xb2\x01\x76\x9d\xdb\x5d\xfe\xdb\x6a\x44\xe1\x84\x83\x54\x73\xd8\xdd\x5c\x7d\x5b\x22\x62\xf7\xc4\x25\xaf\x2e\xd8\xa2\xbe\xcb\x19\xed\xce\x79\x23\x8e\x52\x2a\x4a\xb4\xc4\x31\xae\xd2\x53\x76\x99\x07\x57\x64\x40\xf2\x8c\x0c\xf2\x95\xb9\xea\xae\x4b\xd0\xd9\xcb\x8b\x75\x06\x12\x4b\x45\xe6\x32\x1b\x0d\xed\xd9\x37\xf7\x51\xdd\xb4\x11\xab\xdc\x0e\x3b\x43\x61\x99\xb7\xde\x5b\xdf\x6c\x36\xac\xf8\x60\x3b\x66\x2f\xf5\xe1\xcf\x1b\xb7\x85\xf2\x65\xb7\xc3\x96\x5d\xc4\xc5\x1e\xf8\xf3\xc3\x1d\x77\x69\xf5\x4b\x73\x77\x1d\xd1\x25\x2f\xe0\xdd\x96\x9a\xac\xbc\x5e\x77\xd7\x10\x5d\xf4\xe8\xdd\xed\xa8\x69\x67\xcc\x7b\x7a\x7e\x79\x8d\x2e\x7f\x4e\xef\xb6\x11\x5d\x64\x05\x40\xa1\x20\x6c\x64\x35\xa9\x4a\x9b\x28\xf2\x89\xc8\xfe\x13\xf1\xa6\xd0\x47\x2a\x0d\x0f\x5e\x5e\x0e\x5f\x5e\xac\x5c\xb8\xd4\xb7\x95\x0b\x4c\x05\x54\x4c\xde\xa3\x72\xbe\x03\x76\xa8\x29\x2a\xa5\x6f\xf0\xf3\x4c\x4c\xee\x52\xf3\xc1\x91\x5f\x01\x57\x48\x47\x47\xb9\x74\x24\x84\x22\x71\x1b\x06\xba\x9d\xba\x9d\xd2\xe3\x09\xea\x1e\x65\xe2\x95\x75\xf7\xc8\xe5\x0f\x94\x44\x89\xc4\x2a\x14\x27\x47\xec\x71\x92\x52\x3a\x73\x18\x59\x49\x25\x0d\x4c\x2c\x46\xcc\xdc\x82\xfb\x03\xd1\x85\x56\x1e\x94\x96\x20\x9a\xb7\x85\x2c\x68\x8b\x90\xe1\x84\x8c\xa7\xb7\x88\xb8\x1d\xa5\x45\x44\x6d\x11\x5d\xd6\x22\xa9\x8d\xac\x18\x33\x28\x44\xb8\x78\x6c\x93\xe7\xe0\x4c\xa3\x6a\x51\x51\x2e\x11\x4a\xb3\xb5\x18\xcc\x13\xe1\xe7\xae\xef\xca\xdb\xf3\x39\x34\x6c\x27\x5f\xfb\x3b\xc1\x2b\xdf\x09\x10\x9e\x4f\xc7\xcc\xc9\x10\xb8\x19\xcb\x43\x0e\xc8\xc3\x42\x2e\x77\x21\x8c\x7c\x37\x90\xfb\x39\xec\xfa\xaf\x30\x22\xee\xcc\x1f\x38\x6f\x5e\xbd\x42\x72\xb8\x3b\x21\xd2\x76\x0f\x0e\x41\xb9\x4c\xee\xf8\x48\xdf\x41\x38\x18\xe5\x9b\x33\xa7\xde\xca\x90\xff\x67\xb0\x63\x11\x65\xc7\xe6\xa3\xc4\xfa\x88\x0a\xdd\x31\x8c\xd0\x5a\x8d\x5d\xf2\xb0\x3e\xc2\xa5\x9a\xab\x9d\xd8\xb2\x9d\x2b\x98\x2e\xb0\x03\xf0\xeb\x81\x0d\x4e\x24\x54\x1c\x98\x6d\xf3\x88\x8d\xe6\xe6\x16\x88\xaf\x88\x56\x9a\xb0\xa8\x38\x88\x9b\xeb\x14\x4b\x78\xeb\x09\xd9\x86\x45\x52\x06\x2e\xe7\xef\xe5\x7d\x53\x84\x14\x6b\x06\x39\x73\xa8\xd5\xc2\x62\xcb\xf2\x1a\x8e\x7b\xc2\xf2\x26\xab\xbc\xeb\x7a\x79\xe9\x5b\xfa\x24\x7d\x4c\x70\x5c\xc9\x84\xc4\xd1\x9a\xac\x58\xa9\x45\x55\xb3\x71\x81\xcb\x27\x6d\x3c\x67\x7f\x43\xf5\x93\x73\x16\x02\x06\xfa\x7b\x3d\x92\x9e\x44\x30\xfe\x77\x88\x33\xcb\xe7\x92\xb2\x03\x8b\x72\x1d\xed\x0a\x73\x23\xc6\x95\xfa\xd1\xd0\xc8\xd5\x9a\xb0\x38\x83\x5e\x9a\xeb\xc8\xcc\x5d\x1c\xc2\x0a\x0f\xf4\x34\xb0\xe1\x05\x38\x4d\x0d\x0c\x12\x84\x44\x69\xda\x05\x76\x5c\x60\x27\x0a\xba\x73\x78\xab\x49\x42\x4f\xe2\xa4\x20\x4a\x8e\x31\x93\x20\xfb\x20\x78\xf8\xa1\x0f\x16\xd2\xfd\x94\x0c\x8c\x3a\xc8\xc0\x70\xdf\x4c\x83\x80\xf2\x41\xb8\x10\xa4\x5e\xa1\x2f\x2f\x72\xbe\xe6\x32\x2b\xad\xd5\x2a\x24\x59\xba\x43\x1c\x3a\xa7\x52\xd1\x14\xe8\x2a\x06\x31\x82\x68\x35\x35\x2e\xa0\x6a\x06\x79\x62\xcf\x5c\x00\xdd\x64\x9a\x52\x83\xf8\x14\x24\xa3\x3e\x61\x2a\x7e\x23\x4a\x14\xf2\x20\x26\x20\x99\xaf\x64\x09\x76\x97\x14\x87\xd2\xd2\x9e\x2e\x57\x9f\x5a\xb4\x56\x53\x6c\xeb\xa2\x99\xe2\xa6\xcb\x11\xcf\xed\x08\x22\xe1\x14\x24\x32\xf0\x6c\xb6\xd2\x42\x8f\x09\x7f\x89\xc3\x7d\x36\x82\x6d\x89\xa9\x48\x6b\x82\x69\x4e\x30\x98\x21\xca\x48\x09\x3d\x93\xa8\x4f\x87\x3b\x95\xb1\xac\xfb\x1c\x18\x47\xac\x16\xbd\x9e\x4b\xed\x4c\x40\xf2\xad\x52\xfe\x72\x87\x22\xb3\x18\x6d\xa6\x7c\x0d\x08\x6e\x1d\xc5\x35\x65\x49\x00\xcb\x9e\xc9\xa0\x41\xca\x0f\x4a\x9b\xdd\xf0\x35\x95\x6a\xbc\x50\x1e\xd1\x26\x2e\xbd\x0d\xef\xba\x49\xa3\x68\xab\xab\x7e\xbc\xbc\x80\x6f\x87\x86\xda\x5e\xe0\x38\x85\x3f\x9b\x84\xd9\x09\x91\xb4\x61\x57\x24\xab\x5b\x41\x50\x02\xa6\xff\xc0\xbe\x89\xd4\x63\xe4\xf5\x16\x4f\x81\x44\x74\x58\xab\x11\x4b\xed\x1d\x78\x5a\xcc\xe2\x50\xc2\xac\x53\x59\x36\x02\xcd\x6d\x13\xec\x75\x27\xdc\xf6\x74\x68\xb5\xc1\xbe\x73\x62\x4d\x6d\x34\x70\x43\xab\xb5\x6e\xa3\xd8\x4d\xac\x81\x8d\xfa\x6e\x68\xad\x37\x6d\x34\x76\x13\xab\xcf\x54\x01\xd6\x96\x8d\x46\x6e\x62\x4d\x6c\xf4\x00\xb0\x1b\x36\x7a\x76\x13\xeb\xc1\x46\x3d\x37\xb4\xb6\x6d\xf4\xe4\x26\x56\xcf\x46\x8f\x8b\xbd\xcc\x89\xaa\x46\x9c\xbd\x80\x75\x14\x1e\xb2\x68\xd1\xa3\x2f\x2f\x82\x12\x23\xbd\xe7\xa9\x6d\x57\xf3\xa6\xfc\x58\x28\xb0\x98\xad\x00\xdf\xa2\xe8\x96\xd9\xd6\x34\x63\x9c\xa4\xe4\x7d\x1a\x85\x26\x7a\x28\x3d\xc3\x66\x4c\xa0\xe9\xba\xb9\x92\x56\x3b\x04\x53\x38\x22\x3b\xce\x67\x88\x2c\x22\xe5\x70\x2a\x9b\x32\x63\x09\x3d\xb6\x95\x71\x4c\x98\x48\xd8\x78\xc0\x81\x3f\x60\xd9\x64\xa9\x24\xcb\xb2\x0c\xf1\x2a\xf1\x63\x81\xb9\xfa\xe4\x4b\xcc\xd8\x4f\x25\xb7\xf6\xa4\xbd\x7b\x31\xfd\x84\x41\x44\xcb\x1c\xf8\x0f\x26\x9a\x31\x56\xc7\xce\x0e\x47\x39\x20\x9b\x6a\xdc\xdc\x49\xea\x3f\x90\x0c\x2d\xc4\xc1\x20\x97\x61\x59\x96\xb7\x1f\x0d\x9e\x4d\x76\x98\xbb\x04\x28\xf9\x29\xc4\x58\x40\x98\x50\xbe\x69\xff\x0a\xe4\x1b\xd9\xed\xbf\x06\xce\x9c\x4e\x91\x81\xf1\x86\xfe\x1a\xfc\x2e\xf8\xaf\xa9\xfb\xe1\xdf\xce\x00\xf6\xd3\x60\x28\x03\x77\x8c\xd3\xc6\x63\x94\xdc\x93\xa4\x31\xc1\x71\xb1\x87\xca\x07\xcd\xe2\x8e\xa5\x89\xc9\x87\x09\x15\x18\x7a\xfe\x60\x59\x3f\x0c\x44\x35\x68\xe3\x5b\xd4\xef\xc1\xfd\x13\xfb\x17\xa0\x17\x41\x3c\xcb\x78\xf0\xb9\x3b\x4a\x1d\xd2\xc8\xe7\x8f\x45\x1b\x10\xd5\xfb\x96\x46\xa1\x9d\xd9\xff\x4d\x29\xb1\x8c\x47\x33\x9a\x3a\x42\x35\x4e\x06\x3d\x4c\xff\x59\xbc\xcc\x15\x91\x1f\xfe\x32\xde\x3c\x87\x0d\x0d\xb4\xed\x2c\xbb\x03\xb6\x69\x15\x39\x0b\xa3\x60\xdd\x47\xd6\xcf\x20\x85\xa4\xee\x8c\x77\x95\x33\xcc\x01\x71\x92\xe0\xe7\xd3\xa1\x55\xc4\x70\x49\xc0\x6e\xf8\xe9\x39\xf9\x3e\xf5\x13\x32\x10\x97\xca\x16\xf3\x4a\x48\x26\xc0\xe1\x51\xd0\xcd\x19\x66\x6e\x0c\xdd\x2f\xce\x22\x05\xff\x42\xc2\xac\x94\xe5\x03\x83\x6f\x76\xbd\xd7\x7e\xd7\x7b\xf5\xca\x4e\x6f\xbd\x3b\xe5\xe0\xd2\xcb\x8d\x38\x84\x6e\xe2\x4a\xfe\x4b\xdc\x5f\xe4\xc0\x20\xe6\x08\x36\x4c\xd0\x2d\x64\xbe\x93\xa6\x8a\x52\xdb\xb6\x51\x02\xfd\x49\x89\x3b\xeb\x4f\xd3\xe7\x6b\x4e\x98\xdb\x3b\xc4\x49\x04\x86\x13\x9d\xdb\xbb\x0c\x05\x6e\x88\xb0\x95\xa0\x60\x09\xef\xf6\x24\xb5\xf7\xfc\x01\x33\xbc\xb6\x9c\x67\x2a\x93\xaf\x28\xfa\xea\xfc\xb8\x56\x1b\x12\xc6\xb2\x17\xa4\xdb\x0d\x3a\x26\x8a\xb1\x0d\xe5\xb4\xb0\x01\xa3\xdd\xb2\xb3\x32\x08\x78\xba\x06\x61\x36\x15\x66\xdc\x2c\xb5\xb1\x34\xb3\xb3\x0a\x56\x00\x4d\xaf\xae\x8d\x96\xfe\xbf\x56\x1b\x18\x2a\xa1\x7b\x7b\xd7\xa5\x3a\xc7\x21\xf6\xcc\x34\x57\x5c\xd2\x18\x47\x29\xd8\x28\xe3\x47\xae\x04\x2a\xa8\xd6\xbe\xa8\x81\x13\x42\xed\xf3\xb5\x6a\x44\xe8\xdb\x69\xfa\x0c\x29\x9c\x5e\x73\x5d\x90\x6f\x31\x6e\x0b\xdb\x20\xd0\x34\x36\x08\x14\x42\xeb\xf5\x0a\x41\x3b\x92\x33\xb8\xb4\xe1\x87\x5e\x30\x1d\x90\xd4\x0a\x8b\x58\x9b\x89\x9a\x50\x5f\x60\x38\x88\xe6\x95\xfa\x27\x16\xd0\x94\xb0\x8a\xfc\x84\xa3\x98\x63\x82\x07\x44\x2e\x63\xe6\x59\x12\x79\x24\x4d\xc9\xd2\xa5\x27\x16\xd0\x0a\x15\x0a\xf2\xca\x99\x6a\x1a\x9c\x9c\x46\xcc\x51\x5a\x24\xb5\x1b\x86\x89\xaa\x49\x97\x67\xc2\x1e\x53\x88\x72\x7c\x56\x6a\x1b\xd1\x94\x1a\xd1\x50\x66\xe4\xf1\xbb\x30\x55\x90\xd9\x30\xed\x05\x75\xd0\x7a\x42\x39\xbb\x9d\xeb\x6c\x78\xa0\xfb\x33\x42\x72\x49\x44\x5b\xb1\xe2\x28\x0a\x7a\xfe\x00\x15\x82\x85\xd5\x44\x4f\x12\x81\x6d\x15\x62\x46\x8c\x41\xb1\x5a\xfa\x16\xae\x9c\xed\x0c\xfd\x5f\x2f\xfe\x14\x2b\x0f\xcc\x4f\x64\x3a\xd0\x93\x8d\xd8\x1f\xfc\xca\x72\x95\x8b\x36\xe6\x2f\xaf\x88\x7f\x77\xa5\x35\x8f\x71\x4a\x8d\x43\x82\x13\xda\x27\x98\xfe\x8d\x92\xc6\x32\xcf\xaf\x97\xb5\x1b\x85\xfc\x6e\xa4\xf7\xcc\xc8\xe0\x15\xdf\xb6\xfd\xdf\x92\x19\x9c\x6a\x5e\xc4\x38\x74\xcc\x35\x33\x43\xe6\x05\x49\x1e\x7c\x76\x7a\xb0\xb8\x49\x63\x19\x8f\x66\x3e\x25\x13\xa7\x10\xb1\xd2\xcc\x66\xf3\xeb\x1f\xae\x55\xb8\x64\xa2\xc3\xa9\x87\x89\xf2\x29\xe6\x0f\xe4\xa2\x5f\x57\x72\xf9\x83\x80\xfc\xf3\xd5\xfa\xe5\x59\xf8\x0f\xcf\xf2\x47\x24\xe5\xaa\x90\x89\x63\xf0\x5f\x66\x17\x6b\x93\xc2\xed\x4c\x54\xf2\xc2\x0e\xeb\x21\x71\x9b\x92\x7b\xfd\x1a\x1f\x24\xaf\xdc\x0a\x02\xc3\x52\xb9\x54\x12\xbc\x57\x25\xc1\x82\x6b\x5f\x9d\x1f\x2b\x02\x21\xdc\xa0\x0a\x47\x8a\x14\x54\x95\x9c\x21\x2a\x63\xdc\xfb\xff\xd1\xb0\xfd\x8f\x86\xed\x7f\x34\x6c\xff\xf7\x69\xd8\xd6\x5b\x8a\x86\x6d\xe1\xde\xef\xff\x69\x3d\xd9\x3f\x21\x53\x4f\x03\x6d\x39\xc8\x45\x82\xc6\x34\xc8\xd4\xfd\x10\xac\xa6\x25\xe6\xab\xda\xc3\x7d\xdd\xd9\x59\x58\x44\xe0\x0b\x61\x33\x44\x55\x05\x05\x7e\x06\xda\xe1\x0e\x5c\x33\xfc\x6f\x70\x10\xb5\xa6\x62\x9d\xed\x20\xd3\x98\x44\x09\x31\x6d\x61\xec\x2a\xfb\x89\x86\xa0\xaf\xae\x0b\x80\x69\xa9\x7e\x80\xaf\x00\x9a\x7e\x40\x59\x0d\xfa\xf3\xab\x81\xb8\x2a\x6b\xad\xb7\xed\xae\xb4\x9d\x95\x9f\xe4\x31\xed\xb2\x7b\x7b\x4b\x1a\x3e\x4a\x90\x69\xde\xdd\xf1\x53\xaa\xc8\x9d\x8d\x27\xe0\x17\x3c\xeb\x46\x0d\x0a\xb6\x3c\xe1\x96\x92\xb0\x0a\xda\x0d\xad\xf6\xb6\x6d\xc1\x13\x9c\x6e\xd2\x60\xbe\x76\x52\x66\x3b\x45\x5e\xfa\x95\x91\x15\x17\x7f\xa9\x5b\x80\x85\x56\x7b\xcb\x66\xf6\x5d\xc5\xfe\x8d\x55\xe3\xdf\x8d\x5e\xbb\xdd\xf1\xf7\xeb\xd3\xc0\x98\x7d\x0d\x0d\x03\x0c\xee\x06\xf8\xd9\x31\xfc\x30\xf0\x43\xd2\x85\xb8\xc0\x4f\x69\x9d\x99\xc8\x72\x8c\x30\x12\x91\x31\x1e\xc0\x1b\x47\xc7\x68\x76\xbf\x86\xd9\xd7\xf0\x6b\xd8\xe8\xb5\xd6\x1e\x3b\x8f\xf5\xc0\x5f\x84\xaa\x04\xe6\xe0\x21\x25\x09\x07\x16\xfe\xdb\x40\xd6\x36\xcc\x0a\xd0\x00\xa7\xb4\xce\xde\x74\x3a\xdc\xab\x7b\x39\x1b\x93\xc5\x96\xe6\xab\x2c\x4d\x94\xf5\x6f\xe8\x0e\x78\xdf\xc1\x69\xe9\xce\xa6\x81\x63\xe6\xa4\x31\x51\xe0\x3b\x66\x8e\xd4\x2c\x1d\xb4\xd9\xb3\x82\xcc\xf3\x5b\xf0\xaa\xdb\x62\xfc\xbe\x57\xad\x26\xee\x7d\x49\x7f\x4c\x70\xb9\x6c\x45\x5d\xdf\xc4\xda\x36\xf4\x9f\xae\x92\x20\x35\xe0\x3c\xdd\x4f\x48\x6a\x94\xf2\x71\x97\xa0\x2b\xa4\xc2\x5e\x5b\xe9\x99\x08\x5c\x0a\x65\x6b\x9c\x17\x05\xaf\xcc\xd5\x55\xf3\x95\xd8\xe5\x24\x6e\xf8\x0a\x84\x40\x3a\x06\x59\xba\x91\x70\x0b\xf3\xd6\xea\xd7\xd5\xdb\xff\x7c\x5d\xbd\xfb\xe3\xb7\x55\x64\xae\xe6\x8b\xbc\xa1\x40\x4c\x93\xe0\x6b\xfa\xc7\x57\xcb\xb2\x76\x9c\xdb\xff\xd8\xd6\xdd\xcb\xd7\x3c\xf8\xea\xe5\xab\xc5\x02\x7f\x7c\xb5\x6d\xf6\xbf\xfd\xd5\x5e\x1d\xf9\x25\xf2\x49\x43\x5c\x34\xf1\x27\x96\x5d\xa0\xfe\x8f\x69\x35\xfe\xb0\xcd\xdf\x56\x4b\xf0\xa2\x12\x34\x53\x61\xff\x0d\xb0\xff\x5e\x02\x0b\x34\x5a\xfd\x8f\xf5\xaf\x17\x70\xcc\xe4\xbc\xc0\x75\x31\xe7\xeb\xea\xd7\x55\x16\x4a\x79\x70\xe8\x07\x84\x85\xbe\xae\xda\xab\x7e\x83\x92\x14\x1e\xb8\xea\x34\xc4\x92\x08\xd8\x6d\xc2\x71\x77\xc3\x87\x03\xf1\xd3\xa1\x05\xf4\xb4\x77\x22\xa7\x1c\x6b\xda\x3b\xe1\xab\xc8\x49\x5e\x45\x4a\x75\xbf\x36\xbe\xae\xae\x22\x13\x6e\xd1\x4d\x93\xc0\x9a\x7b\x9f\x88\xed\x57\xa6\x6d\x32\xad\xcf\xff\xc2\xb4\x5e\xfd\x63\xe5\x6b\x68\xfc\x61\xbc\x23\x21\x98\xdf\x25\x03\x63\x9a\xc2\xc6\x8a\x8e\x89\xf1\x36\x8a\x28\x78\xc7\x8c\x8d\xdd\x69\x4a\xa3\x89\xff\x83\x24\x86\x25\x6f\xcf\x8d\x08\xed\x4b\x80\x86\x17\x4d\x56\x07\x91\x97\xae\x76\x1a\x6b\xab\x9e\x84\x5e\xb5\x19\x6e\x6e\x92\xde\x48\xf1\x03\x19\x18\x34\xca\xef\xdf\x8d\xfc\x94\x36\x46\x3e\x1d\x4f\xfb\x1c\x01\xde\x26\x6b\xa4\xbd\xbe\xd5\xee\x6c\xb5\x37\xd7\xf1\x96\xb7\x41\xbc\xed\x41\xbb\xd3\xef\xf7\xdb\x64\xbb\x0d\xc8\x56\x65\x85\x8b\xca\x3d\x74\x1a\x6b\x8d\xd6\x92\x8a\xc9\x5a\xc4\xcf\x09\xb8\xa1\x33\xda\xcd\x56\xab\xde\x6e\xb6\xb6\x8d\xcb\x47\x9f\x82\xd3\x5c\xe3\x28\xf4\x1a\x0c\xea\xd8\xf7\x48\x08\xa7\xc4\x30\x19\x13\xe3\xe4\xe8\x52\x41\x5c\x54\x95\x3e\xf6\xd3\xd5\xbc\x94\xd5\x7e\x10\xf5\x57\x27\xe0\x2a\x27\x59\x3d\x3e\xda\xdd\xff\x78\xb1\x6f\xcb\xda\x1a\x21\xdc\x24\x0d\xfc\x1f\xa4\xe1\xa5\xa9\xf1\xd0\x69\x34\x1b\x1d\xe3\x85\xa1\x16\xa5\x19\x2f\x86\x42\x86\x90\x78\x51\x80\xd3\x55\x3d\xdf\x1f\xab\x60\xbd\x71\x36\x8c\x42\x5a\x1f\xe2\x89\x1f\x3c\x3b\x29\x0e\xd3\x7a\x4a\x12\x7f\xd8\xad\x4f\xd2\x3a\xd8\x34\xa8\xa7\xfe\x0f\x52\xc7\x83\x6f\xd3\x94\x3a\xad\x66\xf3\xf7\x6e\xfd\x91\xf4\xef\x7d\x5a\x9d\x9a\xc1\x41\xd0\x6c\x82\x93\x91\x1f\x3a\xcd\x0c\x27\xd4\xf7\x02\x82\x70\xea\x0f\x88\xf0\x9e\x9f\xa2\xa1\x3f\x02\x27\x3f\x7e\x14\x42\x70\x9a\x10\x34\x8c\x22\xa0\x1a\x57\xaf\xa1\xf1\x28\x89\xa6\x31\x9a\x60\x3f\x44\x13\x12\x4e\x51\x88\x1f\x90\xd0\xd0\xa1\x74\x3a\x99\xe0\xe4\x79\x26\x79\x7c\x1f\xae\x63\x65\x78\x3a\xf0\x23\xe4\xe1\xf0\x01\xa7\x28\x4e\xa2\x11\x9c\x76\xa3\x07\x7f\x40\xa2\x1c\x92\xaf\x2b\x75\x96\xa1\xfb\x40\xa0\x6a\x38\xa8\xe3\xc0\x1f\x85\x4e\x1f\xa7\x04\x52\x39\x22\x27\x8c\xa8\x75\x0b\x1c\x3e\x89\x82\xf4\xce\xce\x51\xb0\x35\x48\x78\x4e\x6f\x66\xb7\xdc\x22\xcb\x1d\xa2\x64\x12\x83\x19\x0c\x0d\x2e\xc3\x33\xb8\x7d\x01\x6d\x09\x07\x75\x2f\x0a\xa2\xc4\x61\x4b\x2c\x7f\x40\x9c\x61\x87\xab\xef\x10\x76\xc6\xf0\x4c\x73\x16\x4d\x29\x54\x01\xc8\xd6\xef\x27\xb7\xd4\xa7\x01\xb9\x9b\xf5\xa3\x64\x40\x92\x7a\x3f\xa2\x34\x9a\x30\xc4\x5d\x46\xf9\x01\xf1\x22\x7e\x03\xc4\x61\x23\x2b\xf0\x97\xa5\x18\x83\x88\x52\x32\xc8\xfa\x28\xa5\x49\x14\x8e\x78\xb7\x3f\xf2\x96\xf4\xa3\x60\x90\x0d\x86\x21\x8f\xe4\xcb\xad\x4f\x71\xe0\x7b\xd9\xb8\x25\x22\xfd\x1f\xc4\x69\x93\x49\x57\x76\x6d\x63\x63\x93\x4c\x8c\x66\x36\xc1\xc9\xbd\xd2\x4e\xe7\x5f\xc3\x61\xb3\xcb\x1b\xfb\xaf\x66\xb3\x99\xa5\x13\x1c\x88\x41\x06\x63\xc5\xd9\x6a\xfe\x9e\xa5\xd3\x3e\x4a\xa7\xb1\x12\xbb\xb9\xfe\x7b\x17\xaa\x59\x97\xb4\xed\xc6\x91\x30\x40\xcd\x9c\x73\xfa\x0f\x64\x61\x8f\x01\x26\x1a\xc5\x4e\xbd\xd9\x58\x27\x13\x40\x3e\x13\xc4\xaa\x37\x1b\x6d\x88\xf2\x27\x23\x41\x46\xa7\x99\xa5\x0f\x23\xd6\xbd\x4e\x12\x45\xd4\x66\xef\x66\x87\x41\xf4\xe8\xf0\xbe\xcc\xf8\x80\x94\x23\xb8\x45\x26\xc6\x5a\x33\x7e\xca\xc6\xc9\x4c\x8e\xfb\x7e\xf4\x04\x93\x02\x24\x10\xb1\x98\xd7\xfb\xd1\x53\xb7\x3e\x89\x7e\x2c\x4a\x5b\x10\x9d\x0f\xa4\x38\x21\x45\x45\xc0\xdc\x6d\xe6\x81\x7b\xe1\xfb\xfe\x00\xc5\x09\x41\x29\x9e\xc4\xda\x3c\x9d\x44\x61\xc4\x9c\x78\x22\x23\x0f\x76\x0b\x6a\xb6\xc8\x24\xe3\xae\xd4\x10\x7b\x87\x8e\xa2\x98\xc2\x30\x8c\x51\xca\xde\xa8\x23\xf9\xe0\x1b\x14\x3b\x51\xe2\xf8\xe1\x98\x24\x3e\x65\x18\xf2\x0f\xd9\xd1\x02\x53\x51\xbf\x07\x1f\x2c\x6d\x10\x59\x02\x47\x39\x03\x94\xf5\x5c\x82\x64\xe3\x54\x42\x00\x9b\x31\x58\x45\xc4\x8b\x77\x1e\x6f\xde\x21\x35\x32\x21\x29\xa1\xa5\xb8\x74\xda\x9f\xf8\xd4\xbc\xcb\x69\x0f\x06\xba\x71\x02\xf7\x7f\x1c\x8e\xa4\xeb\x4d\x93\x34\x4a\x1c\xe1\xb2\x4b\x14\x79\x3b\xf0\x53\x0c\x4e\x97\xef\xd4\xc2\xf3\xc8\x99\xc8\x24\xa4\x69\x91\xc9\x71\x58\x17\x32\x47\xbf\x75\x3f\x04\xf3\xe3\x2c\xdf\x7c\x7c\x3e\x98\xba\x52\x14\x6d\x66\x0c\x74\xa6\x8e\x61\xce\x6d\x33\xb5\x3d\xec\x3c\xb1\x1f\x3d\x95\x9b\xce\x2d\x00\x54\x8d\xb0\x7c\xf6\xcf\x0f\x30\x25\xa9\x3a\xb6\x54\x39\x51\x98\xb8\xe4\x77\xe7\x38\xb2\x38\xd6\xd6\x7a\x1a\xfb\x61\x5d\xf4\xd8\x72\xf8\x68\x4a\x75\xf8\x99\x68\x31\x1b\xb9\x6a\xde\x94\xe0\xc4\x1b\x57\xf7\x1f\x0c\x98\x21\x38\x86\xca\x17\x94\x05\xb3\xe4\xef\x4f\xac\xca\x3a\x14\xf5\xe7\xb5\xaa\xf3\x9b\xba\x55\x4d\x5e\x98\xa5\xe0\xac\x55\x2d\x62\x63\x9e\x35\x29\x25\x54\x8e\x91\x56\xfc\x64\xa4\x11\xdc\xff\xf8\x97\xd7\x84\x7f\x73\x0e\x6a\xb4\x63\xa5\x8f\x1a\x9d\x75\xe0\xa7\x8d\x0d\xe0\x58\x46\xb3\xb1\x09\x8c\x2b\x20\xe0\x63\xb0\x6a\xb8\xe5\x33\x58\x67\x1b\x72\xa2\xcf\xb3\x77\xa6\xf4\x10\x98\x60\x31\x62\x1e\x36\x1d\x19\xe8\x8a\x04\xe0\x24\xa2\x80\x01\xa2\xe3\x59\x51\x20\xc8\x1d\x17\xd1\x34\xf1\x88\x63\x54\x88\x2f\xe3\xf5\x7e\xcc\xe4\x89\xf5\x7a\x3f\xf2\x03\x92\xb0\xf5\x50\x13\x63\xd2\xc4\x5b\xf5\xd2\x74\x15\x96\x75\x21\x80\xfc\xff\xd8\xdd\x6d\x23\x06\xbf\xc5\xb3\x3f\xd0\x1f\x62\x83\x85\xfe\xe0\xfb\x33\xc1\x9e\x60\x1d\x31\x56\xfc\x09\x88\x9d\x38\xa4\x7c\x91\x4b\xc7\x78\x10\x3d\x32\x3e\xa3\xa6\x29\x2b\x91\xb2\xd6\xaa\x10\xb2\xe3\xd8\xd0\x59\x80\x64\x61\x52\x86\x11\x66\x0c\x90\x92\xc1\x6c\xe1\x62\x9b\xe1\xdb\x71\x42\x86\x77\x79\x2b\xd8\x80\x75\x4c\xc3\x32\x0d\x4c\x69\x62\x41\xaa\x6d\x80\x90\xad\xac\xf4\x0b\xa1\x99\x20\x20\xc0\x19\xe2\xff\xb8\xe6\xbf\x4c\x81\x1d\xe5\x51\xdf\xf0\x03\x4e\xbd\xc4\x8f\xa9\x63\xce\x21\x33\x33\x58\x48\x98\xe8\xf3\x7d\x1a\x51\x52\x31\x3c\xb7\xb7\xb7\xbb\x31\x1e\x91\x3a\xbb\xd6\x5c\xf7\x43\x90\xd9\x1c\x0c\xba\x80\x8c\x82\x64\x96\x4b\x37\x6c\x2c\xd5\x21\x8a\x24\x75\xa0\x76\x9c\xd1\x04\xc1\x2a\xbb\x28\x3f\xa4\x4d\xf0\x53\xfd\xd1\x1f\xd0\x31\x13\x23\x55\xa2\xc6\x68\xdc\x46\xe3\xce\x2c\x4a\xe2\x31\x0e\x53\xa7\xd3\x7d\xf4\x07\xd1\x63\xea\x74\x32\x9e\xa0\xa0\x65\x8d\x16\x58\x1b\x4f\x3f\xea\xf8\x6d\x3d\xc4\x0f\x7d\x9c\xe8\xb2\x57\xa3\xd7\xa9\x7f\xb9\x0a\xea\x7d\x1a\xfe\xd9\xb8\x1e\x7e\x39\xbf\xa9\x7b\x38\x21\x14\x35\x9e\x3e\x9d\xac\x7d\xa9\x0f\x92\x28\x9e\xc6\x7f\x2e\x02\x93\x13\x85\x46\x71\xbd\x72\x10\x66\x8d\x5e\x67\x74\xff\xee\xa2\x1e\xe0\x3e\x09\x2a\xa8\x09\xb2\x4f\x63\x78\x78\xb8\x1e\xd4\x97\x4f\x3d\x0d\xa9\x92\xc1\xa0\x03\xa4\x22\x30\xe8\x78\x5e\x9e\xfc\xd7\x70\x38\x2c\xd5\xea\xfb\xd5\xfd\x39\xcf\x51\xe7\x45\xc2\x16\x69\x8c\x16\xa5\x0c\x2a\xea\x3e\x18\x0c\x54\xa4\xd9\x1f\x39\xb7\x53\xf8\xed\x7f\xb1\x32\x65\x73\x13\xfd\x1f\xc6\x5f\x6c\x6c\x98\x74\xd9\x6a\xc6\x4f\xf9\xbc\xa7\x38\xae\xc3\xbb\xa0\x00\x78\xa3\x20\x63\x32\xea\x63\xab\x89\xd8\xbf\x36\xdf\xc2\xa8\xe2\x96\x79\x48\x82\x07\x02\xc2\xa7\xf1\x91\x4c\x89\x89\xf2\x6f\xf4\x26\xf1\x71\x80\x94\x7d\x93\x52\xea\x5a\xfc\xa4\x09\xb5\xad\xc6\x5a\x7b\x6b\x7d\xb3\xb5\xd6\x91\x02\x72\xa7\xd3\xe9\x56\xf6\x29\x5f\xc1\x90\x58\x9b\xca\x52\x9b\x5a\x37\x55\x76\xe3\xe5\xca\x18\xb5\x68\x11\x97\x49\x89\xef\x5f\x9d\xce\x26\xee\x6f\xce\xed\x17\x80\xdd\x65\x62\x3f\x82\xb0\xc3\x64\x21\x99\xa5\xdd\x59\x6f\x6f\x7a\x8b\xb7\x18\x99\x84\x97\xfb\x98\xf5\xf8\xc9\x00\x91\xc0\x90\xa4\x67\xc9\x75\xd0\x12\x71\xba\x77\x05\x64\x3d\x1a\x0e\x53\x42\x9d\x7a\x3b\x7e\x2a\x09\xe0\x4d\xc6\x3a\x4a\xa2\xff\xc4\x1f\x0c\x02\x98\xe3\xed\x1f\xe7\x34\xad\xfb\x93\x51\x3d\xc9\x8f\xf8\x73\x3e\xc0\x98\x5d\x57\xe7\x3a\x5d\x41\x0f\xa8\x56\x06\x3a\xbe\xd6\xe3\x35\xcf\x0f\x4b\x06\x91\x93\xa1\x0e\x36\x99\xa6\xa9\xb3\x11\x3f\xc1\x44\x9f\x9c\x7d\x7e\xcb\xa0\xe8\x78\x3a\xe9\x87\xd8\x0f\xf2\x85\x71\x49\x1f\x57\x76\x6c\xb7\x72\xb6\x75\xf5\x62\xd7\xd4\xe1\x5a\xb8\xe2\x81\xd3\xb6\x46\x3b\x35\x08\x4e\x49\xdd\x0f\xeb\xd1\x94\x76\xeb\xd1\xcf\x20\x7e\x92\x5c\xb9\x39\x5e\x4e\xb4\xf8\xdd\xbb\x09\x23\x87\xe7\x27\x5e\x40\x4a\x34\x5b\x6f\xfe\x0e\xbb\x25\xde\x7f\x75\xd8\x98\xb5\x61\xfa\x89\x6f\xb1\x33\x63\x51\x82\x14\x4d\xd9\x7a\x80\x55\xe8\x42\x08\xeb\xe2\x78\x50\xff\xab\x9e\x26\xf5\x28\x0c\x9e\x67\xf9\xae\x10\xf7\xd3\x28\x98\x52\xd2\x15\xd5\x8c\xf3\xdd\x54\x4b\x95\xaa\xa4\xb0\x55\x87\xd8\x5c\x54\xe2\x5b\xbd\xae\x17\xf8\xb1\x93\x10\x8f\x5a\x4d\x64\x88\xff\x6c\x51\x19\xa7\x09\x65\x47\xe7\x8f\x53\x59\x36\xdf\x00\xb0\x63\x34\xb1\x6f\x5f\x0c\xc0\x42\x45\x65\xe1\x02\x8f\xef\x89\xaa\x02\x15\x55\x8a\xca\x2a\x36\xbb\xe5\x1d\x16\xaf\x20\xc0\x64\xb7\x49\x14\x28\x1b\xa7\x59\x69\xd7\x33\x6e\xf1\x15\x14\x8d\xd7\xd0\x78\x1d\x8d\x37\x50\xe3\xc0\xdb\x7b\xbb\x5d\x1f\xb7\x50\xa3\xd7\x3e\xa7\xfb\x5b\xf5\x71\x1b\x82\x87\x3f\xb6\x6f\xea\xe3\x0e\x04\x9b\x57\xfb\xcd\xfa\x78\x0d\x56\x06\x7f\xb4\xf6\xa5\x3e\x5e\x47\x8d\x5e\xeb\xe8\xba\xef\xd7\xc7\x1b\x8b\x79\x8c\x10\x2a\xd7\x9b\xcd\xd2\xc0\x6f\x09\xae\x26\x79\xcd\xb8\x65\xb0\xcd\x3f\x1a\xb7\x65\xa0\x23\x03\x6b\x32\xb0\x2e\x03\x1b\x22\x90\x57\x5c\x7e\xe7\xd5\x2f\x22\x44\x23\x8a\x08\xd1\x94\x3c\x42\x36\x28\x8f\x90\xcd\x12\x11\xe3\x96\xd1\xe8\xb5\x9e\xbe\xef\x27\x75\x11\xd1\x2e\x47\x74\xca\x11\x6b\xe5\x88\xf5\x72\xc4\x46\x29\xa2\x68\x4a\x29\xbe\x68\xd2\x5c\x82\x6c\xda\x5c\x82\x6c\x62\x39\x21\x6f\x6a\x39\x21\x6f\xb2\x96\xa0\x6d\x0c\xd6\xca\x7d\x28\xd7\xa5\xcd\xcd\xcd\x6c\xdc\x2a\x1a\x00\xa3\x4b\x19\x47\xe3\x8e\x52\xd5\x9f\xcc\x74\x58\x7b\x8b\xa1\x50\x90\xa4\x34\x34\xe6\xba\x59\xed\x5e\x49\x94\x05\x9d\x57\x20\xd5\xe3\xab\xe8\x2b\x0b\xf9\x49\x6f\x2b\x85\x6a\x09\x8a\x50\xb1\xb1\xfe\x7b\x36\x5e\x53\x46\x1f\xcc\xbc\xa2\x3f\xd8\x24\x2c\xa6\x93\x42\xa4\xd6\x22\x22\xad\x19\x73\xbd\x5d\x9a\x25\x4a\x77\x97\xa7\x4d\xd1\xdf\x0b\xc6\xab\x82\xf5\x27\x03\x59\x29\xe5\x67\x23\xbc\x28\x75\x11\x99\x36\xd7\x7f\xd7\x86\x92\x92\xd4\x81\xe5\x55\x1b\x59\x6a\x22\x23\x89\x36\xd0\x8a\xc4\xf6\x5a\xfc\xa4\x13\x5f\xc9\xd9\xda\x82\x44\xb5\x2f\xd4\x44\x96\x53\xeb\x1a\x25\x11\xe4\x8f\x58\xf4\x95\xd3\x34\x9a\x06\xf4\x55\xd6\x78\x7b\x19\x1d\x4f\xea\x01\x6c\x81\xf4\x7e\x63\xe3\x5d\xc9\xbf\x11\x3f\x69\x4c\xb2\x33\xc7\x24\xd7\x32\xb1\xe7\xb5\x26\x7e\x28\xd6\xd8\xcd\x8d\xad\xf8\xc9\x9e\xa9\xc5\x14\x38\xdb\xad\xf8\x29\xcb\x72\x72\x57\xd2\x78\x6b\xfd\x77\xa6\x87\x05\x80\xdd\xab\x61\x50\x87\x8f\x5c\x44\x69\x80\xda\xb6\x42\x18\xf1\x86\x5b\xa4\x03\xeb\xf9\x60\x7f\xfc\x8e\x6b\xf3\x03\x32\x14\x9a\x3c\x2e\x68\xc1\x37\x40\x74\xea\xe7\xc7\x1c\x82\x9d\x77\xa8\x20\x2c\x02\x60\xda\x9f\xee\xef\x39\x8c\x47\xc0\x0e\x9c\x0a\xc4\x63\x60\x45\x3d\x7a\xfb\x3e\xe2\x50\x70\xa0\xe0\x0f\x9f\x55\x30\x11\x05\x70\x7b\x5b\x9d\x90\xc3\x85\xd1\x63\x82\xe3\xd9\xe3\xd8\xa7\x84\xe9\x27\x40\xd1\x02\x51\x20\x99\xc5\x47\xc7\xd7\xa2\xea\xd1\x23\x49\xe0\x5d\x6e\x59\x13\x99\x27\x64\x8d\xfd\xc3\x77\x83\x03\x0e\x3e\x8d\xe3\x6a\xf0\x3c\x21\x6b\x7c\xfc\x70\x16\xfd\x10\x4d\xc2\x31\x53\x88\xff\x98\x83\x2f\x52\xb2\xc6\xe5\xf1\x89\x2f\x32\x4c\xa6\xa0\x21\x50\xd8\x69\x63\x52\xff\x4c\x5b\x3c\x31\x4e\x7c\x76\x84\x21\x92\xb9\x2c\x9e\xe1\x0a\x10\x29\x89\x57\x25\xe9\xc2\xf9\xd6\x46\x73\x1b\x64\x96\xd6\xe8\xfd\xa7\x3d\x71\x36\x33\xf5\xe0\x2e\xb1\x04\xe9\x78\x9b\x1b\x9d\x41\x86\xab\x60\xf2\x72\xaa\xd2\xf4\x82\xfa\xeb\x6b\x6d\x0f\x68\x1f\x7e\x78\xf7\x9e\x03\xfa\xe1\x30\xca\x4b\x69\x6d\x36\xb7\x86\x19\x9e\x03\x28\x8a\x28\x27\xe8\xf8\xd7\xd6\xdb\x1b\xdb\xd0\x90\xe3\xad\x33\x8f\xe3\x7f\xe4\xfe\xe0\x24\xc8\x16\xde\x18\x74\xfa\x19\xae\x82\x51\x1a\x32\x9f\xa6\x15\xb4\xb1\xb1\xde\x62\x0d\x69\x7f\x22\xdf\x9f\x38\xe0\x00\xac\x98\xe7\x6a\x27\xbc\xbd\xb6\xb6\xd6\xce\x70\x05\x48\x51\xcc\x7c\x92\x56\xca\xd6\x5a\x67\xbd\xb3\x96\x35\x3e\xbf\xdb\xda\xb8\xa8\xf7\x47\xb2\xf7\x64\x3a\xdb\x12\xcc\xcd\xcd\x7c\x44\x94\xb3\xe5\xe5\xce\x25\xf0\x52\xe7\xa7\xb9\x1c\x18\x07\xe3\xe6\x36\x86\x0a\x88\x5e\xad\x00\x1d\x0c\x87\xcd\xc1\x56\x86\xe7\x60\xf3\x52\xe7\x12\x16\x95\xea\xb5\x48\xbb\x0f\xcc\xa5\xb3\x57\x5f\x8f\x00\x15\x1b\x23\x15\x65\x6e\x93\xc1\x70\x33\xc3\x25\xc8\xbc\xc4\x52\xf4\xa2\xf2\xf0\x70\xb0\xcd\xb7\x0b\x9d\x9b\x8f\x21\x80\x8b\x3e\xaf\x80\x15\x8c\x0f\xcf\x03\x17\xa5\xce\xa5\x2c\x2a\x78\xb8\x49\xbc\xfe\x3a\x0c\xd7\xd1\xc6\xe6\x1b\xc0\x25\xc6\x50\x05\x68\x7b\x40\x06\x24\xc3\x73\xb0\x45\xb1\xe5\x84\x45\xa5\x92\xb5\xfe\x76\x1f\x26\x49\x07\x3f\x1f\x8f\xeb\x4c\x3d\xc6\xd5\x71\x92\xeb\xcb\x15\x6a\x3b\x97\x35\x1c\x38\xc7\x32\x9a\x86\xb2\xf5\x92\x40\xa5\x4d\xd7\x34\x40\x51\x20\x57\x3a\x10\xeb\xe4\x36\x2a\x87\x87\x55\x71\x1a\x18\x0c\x10\xfe\x4e\x03\x23\x62\xe1\x22\x9f\x00\x05\x96\xd4\xa6\xfd\x8d\xf3\x3a\xbb\xca\x03\xef\xd3\x9f\x03\x32\xc8\xab\x09\x2b\x8c\xd3\xec\x2a\xf7\x7c\x84\xfa\xae\x8d\xcf\xfc\x29\xcf\xc4\xb7\xa6\x3f\xc9\x22\xab\xc8\x10\xd6\xd7\x61\xd9\x9e\xc7\xf1\x67\xe0\xcf\x2a\x37\xbc\x12\x37\x5b\xcd\x9c\xf5\x62\x0b\xc9\x4b\x03\x74\x83\xa5\x24\x01\xaa\x66\x03\x8a\x06\x83\x59\xb5\x3a\x20\x1b\x50\x4d\xf6\xde\x6c\x36\xb3\x41\x2e\x4f\xb0\x52\x9a\x4b\x64\x83\x5e\x7b\xef\xaf\x68\x52\x1f\x04\xf5\x71\x94\xf8\x3f\xc0\x70\x64\x60\x00\xca\x20\xc2\xd4\x81\xec\x72\x27\xbc\x01\xfd\xcb\xec\x46\xf1\x68\x65\x7d\x65\xad\x9b\xdb\x06\x33\x80\x3c\x92\x04\x81\x1f\xa7\x7e\xda\xad\x5c\x70\x2b\x6b\xa1\xb7\xa2\xb5\x05\xa4\x50\x15\xdb\x88\x85\xe1\xea\x4b\x3d\x4a\xfc\x11\xd8\x0d\xa8\x8b\xc3\x6d\xb1\x8f\x1d\x93\x20\x86\xd1\xbc\xfd\xfe\x7e\xaf\x9e\xdf\xfb\x9d\x28\x22\xce\x76\xf3\xf7\xee\xc2\x95\x5a\x51\x6b\x8b\x6e\x63\x12\xb5\xa1\x6c\x47\x98\x34\x57\x16\xd7\x36\x1b\xeb\xc5\x5c\x90\x1d\xad\xce\x84\x02\xb1\x11\x2b\xf7\xb9\x14\x3d\xba\x31\x0d\x16\x24\x44\x6a\xc2\x4c\x1f\x2d\x4d\x15\xb3\xb8\xfb\xa0\xc4\x70\x69\x4f\x89\xd0\x05\x3f\x39\x82\x19\x80\xd2\x9e\xad\xe6\xef\xdd\xea\xc1\xa7\xee\xeb\x14\xb4\xbc\x64\xa9\x8b\x55\x12\x58\x39\x15\xf1\x5a\x3d\x44\x7a\x71\xc6\xf0\xf5\x6b\xbb\xd9\x5a\x33\x0c\xe3\xeb\xd7\x37\x4d\x13\xfa\xf3\xf4\xfe\xe8\xb8\x5e\xe4\xaf\x27\x04\x2c\x8c\xaa\x28\x1b\xbd\xd6\xde\xe3\xd1\x79\x3d\x9e\x06\x81\x10\x2e\xe5\xcc\x63\x5f\x4e\x6b\x6e\x32\x36\xe7\x87\xb4\xe8\xc2\x7c\xf6\x2a\x7d\xa8\x75\x6f\x73\x49\xa5\x44\x3f\xcc\xb7\x7a\xbe\x8a\x25\xd0\x25\x38\x17\x10\xb2\x02\xa5\x06\xb9\x04\x63\x55\x17\x2c\xc7\xbc\xbc\xd3\xcc\x5f\x20\x09\x3b\x73\xf9\x25\x8a\x70\xc8\x25\x18\x79\x25\x7e\x01\xa1\x0a\xf8\xcb\xe4\xf8\x05\xbc\x15\x19\xd4\x11\xfc\xa6\xc9\xc6\x2f\x0c\x64\x33\xc3\x83\x01\x5c\x23\x5a\xbc\xe9\x13\xab\x0f\x9c\xf3\x2f\x98\x79\x59\xe3\xdd\xf0\xf3\xf0\xa6\x9e\xbb\x66\xf8\xe9\xf0\x66\x03\x5e\x94\x98\x94\xb5\x84\x9c\xc5\x42\xc4\x92\xb5\xa2\x5c\xa2\x48\x5d\x67\x7c\x79\x3e\xdb\xf6\x76\x7b\x49\xb6\xed\xcd\x05\xd9\x5a\xed\x66\x73\x49\xbe\x56\x8b\x67\x6c\xf4\x5a\x97\xa3\xc3\x8b\x22\xbd\x3e\x0c\xa6\xfe\xe0\x1f\x23\x43\xa3\xd7\xf9\xf2\x6d\xf4\xb9\x9e\x44\x8f\xb2\x9f\x38\x78\x5d\xc5\xc0\xe0\x59\x0c\x0c\xf7\xfa\xfe\xd3\x17\xc8\x50\x0f\xa3\xfa\x68\x0a\xf7\xf5\xf2\x3e\x4e\xc4\xf5\x23\x35\x5f\x73\x41\x1e\xe3\x96\xdd\x17\xff\xc3\x35\xbd\x28\xa8\x9b\x77\xa5\x36\xe5\xc7\xf5\x05\x96\xb5\xa3\xd6\x0d\x93\x43\xeb\x4f\x69\xbd\x85\x8c\xc6\x5f\xdf\x3e\xe0\x0e\x8b\x48\x27\x2c\xa2\xd7\xfe\xfe\xe9\x8b\xc7\x62\x26\x03\x11\xd3\xa4\x02\x26\x18\x89\x98\xbd\x30\x7e\x96\x68\xda\x10\xd3\xf2\xdb\xd3\x91\xc4\xc3\x62\xda\xb4\xb3\x79\x2d\xf1\xf0\x98\xc3\xa0\xf3\x2c\xf1\x40\xcc\xe9\x97\x78\xab\x27\xd1\x74\x18\xc8\x6e\xb8\x7e\x20\xd1\x40\xcc\x9b\x7e\x87\x1c\x4a\x2c\x1d\x86\x65\xe3\x5d\x7a\x23\xb1\xf0\x98\xf3\x37\xf4\x87\x44\xb3\x86\x8c\xc6\x10\x37\x8f\x12\x89\x05\x22\xc6\x71\x73\x7f\x2a\xb1\x40\x44\xaf\xb5\xf9\xb0\xfd\x5d\x62\x61\x31\xed\x9b\xce\xe3\xb9\xc4\xb2\x0e\x31\x9d\x71\xef\xed\x3b\x89\x06\x62\xd2\xfa\xc9\x7d\x5b\xa2\x81\x88\x5e\x27\x8e\x2e\xdb\x12\x0d\x8b\x69\x1f\x1f\x0f\x7d\x89\x66\x83\xc5\x4c\x1f\x92\x0b\x16\x93\x4e\x78\x4c\x07\x5f\xf7\xd7\x25\x1e\x16\xd3\xba\xff\x40\x37\x24\x1e\x1e\x13\xdc\xb4\x3e\x4b\x3c\x9b\x10\xd3\x39\xf9\x6b\x98\x93\x98\xc7\x78\xef\xa3\x0d\x89\x87\xc7\x3c\xfd\x38\x6f\x4a\x3c\x2c\xa6\x7d\xf8\x74\xf0\x24\xf1\x6c\xb1\x98\x91\x3f\xd8\x97\x78\x78\xcc\xd9\x39\xbe\x90\x78\x20\xe6\xf8\x7d\xbd\x9e\x4a\x34\x5b\x0c\x4d\x74\x3a\xfc\x26\xd1\x6c\xb3\x98\x8d\xb7\x47\x6b\x12\x0d\x8f\xd9\x1b\x0f\xde\x49\x34\x2c\xa6\xf3\x7d\x23\x39\x92\x78\x20\x26\x9e\xf8\x6f\xbf\x48\x34\xad\x26\xd0\x74\xfd\x9e\x6f\x2b\x00\x0d\x8b\xe9\xb5\xa2\x8f\xbb\x27\x12\x0f\x8f\x6a\xef\xdd\x5c\xff\x25\x11\xb1\xa8\xe7\xeb\xd0\xcf\xe9\xdc\x82\x51\x19\xf8\x1f\xaf\xf2\xb1\xc3\x62\x7a\xed\xdd\xc9\x15\xc9\x31\xb1\xa8\xd6\xf7\x6f\x17\x7b\x39\x26\x16\xd5\x1e\x8c\xbc\x7a\x8e\x0a\x06\x66\xaf\xf3\x1c\xec\xae\xe7\xb8\x58\x54\x7b\x6f\xf7\xba\x98\x17\x3c\xea\xe0\x63\x12\xe6\xb8\xda\xb3\xf9\x2b\x83\xc0\xe4\x24\x4b\x56\x18\xcc\x32\x9e\x53\x35\x4b\xe7\x27\x5c\xc5\xd4\x29\xcf\x82\x8a\x11\x3d\x3f\x38\xe7\x87\x59\xc5\x80\x29\xf7\xfd\x7c\x2f\xce\xf7\x46\x99\xaa\xca\x46\x21\x9b\x4f\x14\x2c\xbb\xd9\xfc\x3d\x2b\xe3\x92\xcb\x40\xab\xb1\xc1\xff\xd9\xfc\x3d\x2b\xd7\x40\xc0\x6c\x75\x1a\x1d\xf1\xcf\xef\x59\xb9\xde\x02\x06\xd4\xc1\xe5\x46\x8a\xa4\x8d\x0d\xb5\x88\x12\x69\x04\xcc\xfa\x96\x5e\x84\x46\x50\x09\x03\xad\x28\x51\x5f\x24\xad\x69\xad\x28\xf5\x99\x80\xe9\x68\xad\xd0\x3b\x5a\x80\xb4\x79\x23\xb4\x41\x21\x92\x5a\x7a\x23\xf4\xa1\x24\x60\xb4\x36\x4c\xef\xb7\x8e\x3f\x49\x08\x26\xa7\xb4\xda\x33\x31\x40\x59\x7f\x84\x9f\xce\xea\x39\xad\x39\x40\x4b\x00\xe8\x9d\xd2\xeb\x5c\xb7\x0f\xf3\xba\x72\xc8\xa6\x80\x2c\x75\x4d\x6b\xeb\xfb\xd1\x58\x83\xdc\x16\x80\xac\x7f\x76\xf7\x47\x71\xa0\x25\x6f\x89\xe4\x52\x1f\x75\xae\x3e\x9f\xe8\x78\x36\x05\x60\xa9\xa3\x5a\x67\xfb\xe3\x07\x0d\x70\x43\x02\x42\x1b\x3f\x3f\x1d\x3c\x5c\x6b\x6d\x5c\x17\xc9\xa5\x1e\xeb\x5c\x7f\x8f\xa9\x06\xb8\x26\x00\xf5\x6e\xeb\xb5\xda\x7f\x91\x9c\xf0\x0c\xb0\x23\x00\x79\xe7\x75\xe2\x3a\xd5\xc9\x9e\x53\x5d\x6b\xe2\xf9\xde\xfe\xf7\x12\xf5\x05\x9c\xde\xc0\xf6\x41\xeb\x5a\xc7\x27\x49\x2f\x4f\xcd\xdf\x9c\x3d\x1c\x15\x00\xe9\xb8\xde\x6a\xcf\x98\x60\x00\x47\xec\xd0\xb4\xdd\xcd\xce\x5e\xd1\x34\x00\x68\x71\x00\xbd\x9b\xc7\xeb\x1b\x87\xf9\xdc\x81\x77\x17\xd0\xcb\x0c\xae\xdc\xc9\x67\xdb\x9d\x33\x0d\x70\x9b\xe3\x13\x73\xf0\x63\xff\xf1\x51\x4b\xde\xe2\xc9\xe5\x79\xf8\xa1\x43\xde\x68\x70\x9b\x1c\xae\x3c\x17\xc3\xb7\xde\xa5\x06\xb7\x21\xe0\x78\xf3\x62\x42\xf5\xda\xac\xf3\xe4\xf2\x9c\x3c\xfa\xfe\x5d\x19\x0a\xe9\xb8\xbe\xc6\xe1\xf4\x0e\x3e\xd9\xa5\xd3\x0d\x0d\xac\xc3\xc1\x44\xf7\x9e\x9d\x0c\x92\xa2\x7b\xd3\x71\x5d\x12\xbb\xd4\xb8\xc7\xab\xed\x8e\xd6\x38\x41\x73\xbd\x6d\xad\xc9\xa7\x4f\xb1\xd6\xb6\xe6\x4c\x15\x41\xdb\xdb\xfb\x7f\xe5\xac\x8e\x5f\x66\x81\xee\x55\x85\x48\xd1\xcb\xed\xf1\xb0\x9e\x96\x21\x5b\x1a\xa4\xde\xdd\x67\x57\xfe\xd9\xb4\x9c\xa1\xa9\x65\x28\xf5\x7b\x7b\xe3\xfa\xe0\xbc\x94\x63\x5b\xcb\xc0\x07\x40\xcb\xdb\x3d\x24\x25\xb8\x2d\x0d\xae\x3c\xdb\xbf\x5c\xd2\xed\x52\x86\x4d\x2d\x43\x69\x48\x74\x92\xe9\xf7\x56\x29\x43\x7e\x14\xaa\x8c\x8d\xf6\xfb\x87\xc1\x63\x09\x6e\x5d\x83\x2b\xb3\x81\x63\xaf\xb5\x57\xca\xb0\xa6\x65\x28\xb3\x83\x93\x77\x9f\xfb\xa5\x0c\x1d\x2d\x83\x18\x37\x9f\xf7\x8f\x3f\x97\xe0\x4a\xfd\x58\x1a\x40\xdf\x2f\x0f\xcb\x4d\xd4\xbb\xb3\x44\x92\x78\x6d\x72\x56\x82\x6f\xfe\xb2\x16\x70\x7e\xbb\x50\x21\xf8\x97\x65\xf8\x39\x71\x7c\x5e\xb2\xae\x12\x92\xe7\xc4\xdd\x0a\xc1\xb5\x2c\x83\xce\x4b\x93\x15\x52\x61\x49\xb8\xd3\x25\x93\x72\xa2\x2a\x99\x94\x70\x89\x34\x7d\xba\x94\x6a\x20\x60\xe6\x66\x88\x5a\x6f\x5d\x32\xd1\x1a\x29\x92\xca\xf3\x40\x23\x8d\x80\x29\x73\x43\x8d\xa0\x12\x06\x5a\x51\xa2\xbe\x48\xd2\x07\xb8\xde\x65\x02\xa4\x34\xa4\xf5\x7e\x16\x30\x7c\x14\xeb\x83\xa2\x52\x32\xd1\x47\x92\x00\x29\x35\x21\x18\xe1\x8f\x12\xa4\x4a\x32\xe9\x6d\xef\xed\xb7\xce\x75\x88\x6a\xd1\xe4\x7e\xff\xc7\x7d\x5d\x07\xac\x96\x4c\x3e\xd2\xf6\xb7\xbc\xfb\xe6\x05\x93\x5e\x27\x7d\x3c\xdb\xd5\xd2\x17\x49\x26\x87\x9f\x07\xd7\x1a\x60\xb5\x64\xf2\x78\xb9\x7f\x80\x35\x38\x4d\x30\xb9\x3c\xfe\xbe\xfb\xac\x25\x2f\x10\x4c\x5a\xeb\xbd\xf7\x7a\xc5\xaa\x05\x93\xfb\x0f\xd3\xd1\x07\x0d\x4e\x97\x4b\x5a\xa3\xe1\xe3\x5f\x5a\x7a\xb5\x5c\x72\x75\xfc\xee\xed\x99\x06\x27\x29\x5f\xea\xc4\xcd\x8d\x96\x4e\x07\x5d\x2e\x79\xf8\xfc\x3d\x7d\x28\xd2\x2b\xc4\x92\x24\xf9\xf1\x56\x07\xa8\x14\x4b\x7a\xad\x0d\xff\x50\xa9\xf9\x62\xb9\xa4\x33\x3a\x5d\xeb\x69\x80\xba\x5c\xe2\x5f\xaa\xc3\x6e\xb1\x5c\xb2\x7e\xf2\xfd\x52\x83\xab\x96\x4b\x3a\xd7\x6f\x77\xf7\x34\x38\x5d\x2e\x79\xf6\x37\x9e\xb4\xe4\x4a\xb9\xe4\xd0\xef\x51\x4f\x03\xab\x14\x4b\x7a\x9d\xdd\xbd\xf6\x8d\x06\xa7\xc9\x25\xed\x0f\x67\x9f\xa7\x5a\xf2\x02\xb9\xe4\x21\xa1\x44\x83\xab\x94\x4b\xda\xfe\xbb\x8b\x63\x0d\xac\x24\x97\x8c\xde\x9e\xe6\x3c\x62\xa9\x5c\xd2\x0a\xef\xbf\xd7\xcb\x90\xcb\xe4\x92\x5e\xc7\xeb\x1f\xcf\xe1\x5e\x2a\x98\xb4\x6e\xbe\x5f\xdd\x97\x72\x54\x08\x26\xc1\xfa\xf1\x65\xbb\x04\xb6\x54\x2e\x69\xf5\x82\xa7\x66\x29\xc3\x32\xb9\xe4\xd3\x90\x9e\x3f\x95\xe0\xab\xc4\x92\xd6\xae\xff\xe6\x4d\x09\x6e\xb9\x58\xf2\xe1\xf4\xf9\xa4\x94\x61\xa9\x58\xd2\xf9\xb2\x75\x71\x51\xca\x50\x25\x96\xb4\xa7\x4f\x0f\xad\x12\xdc\x52\xb1\xa4\x73\xbc\x77\xfc\xbe\x94\x61\x99\x58\xd2\xfe\xbe\x4f\x3f\x94\xe0\xcb\x62\xc9\x12\xd5\x71\x95\xd6\xb2\xac\x7f\x9c\x53\x25\xce\x69\x05\x2b\xf4\x7b\x73\x9a\xba\x79\x9d\xdb\x9c\xf6\xac\x42\x11\x56\xa5\xd2\x9a\xd7\x4d\x95\x54\x4c\x25\x95\x49\x29\x51\x15\x4c\xca\xc8\xc4\x72\x5a\x66\x90\x7a\x25\xe4\x9a\xab\x8f\x08\xbd\xee\xba\x68\xa2\x35\x54\x24\x95\xa6\x82\x4e\x1e\x01\x53\x66\x88\x1a\x51\x25\x0c\xb4\x43\xef\x00\x91\xa2\x8f\x71\xbd\xd3\x04\x88\x3e\xaa\xf5\x8e\x16\x20\x62\x1c\x6b\x83\x42\x24\x95\x86\xae\x3e\x94\x04\x8c\xd6\x82\xfe\xb7\x4f\x17\x89\x84\xa8\x14\x4c\xda\xe7\xcd\xd1\xa5\x0e\x51\x2d\x98\xf4\x5a\xcf\x8f\x61\x4e\x8c\x65\x92\xc9\x4d\x67\x52\xff\xa6\x01\xea\x92\x49\xfb\xfe\xf9\x7e\x5b\x4b\xaf\x96\x4c\x06\x61\x72\x92\x77\xf0\x52\x95\xc9\x8f\x8f\x37\x43\x0d\x50\x93\x4c\x7a\xad\x60\xf4\x94\x93\x79\x89\x68\x32\x3c\xea\xd5\xf3\x61\xb3\x54\x65\xf2\xbc\xdb\xd2\xc9\xaa\x8b\x26\xed\x64\xfa\xa1\xa5\xa5\x57\x8b\x26\xbd\x4e\x7c\xff\xf4\x49\x03\x5c\x20\x9b\x7c\xdc\x1b\x52\x0d\x4e\x97\x4d\x7a\xed\xc1\x7d\x70\x5a\x00\xcc\x0b\x27\xed\xd3\xce\xa5\xd2\xb6\xc5\xc2\x49\xe7\x70\xf3\x66\x4d\x07\xac\x16\x4e\xda\x67\x47\x17\x4d\x0d\x50\x13\x4e\x5a\xdf\x3f\x7d\xf2\xb5\xe4\x4a\xe1\xe4\x66\xeb\xe4\x5c\x19\x7f\x8b\x65\x93\xde\xe5\xf5\x27\x0d\x4e\x97\x4d\x3e\x9c\x26\xca\x98\x5b\xac\x33\x39\x9b\x5e\x6c\x69\x70\xd5\xc2\x49\xfb\xc7\x51\xbd\xa7\xc1\xe9\x4a\x93\xbd\xc4\xd7\x8b\x5b\x20\x9c\x9c\x6c\xd6\x4b\xc4\xac\x12\x4e\x5a\xf7\x67\xf8\x51\x03\xd3\x85\x93\xce\xd9\xe3\x30\x9f\x09\xcb\x84\x93\xf4\xb0\xef\x3f\x96\x01\x97\xcb\x26\x23\xf2\xbd\x55\xce\xb1\x54\x36\xe9\x3c\xc7\xef\xbd\x52\x8e\x0a\xd9\xe4\x79\xb0\x37\xbd\x28\x81\x2d\x97\x4d\x4e\x2e\x3a\x6f\x4b\x19\x96\xea\x4c\x5a\x6f\xb6\x9a\xe3\x52\x86\x2a\xe1\xa4\x33\xea\xc4\xbd\x12\xdc\x52\xe1\xa4\x7d\xf0\xed\x53\xb9\x85\xcb\x84\x93\x2f\xa4\xff\x23\x2c\xc1\x57\xc8\x26\x5f\x1e\xb7\x93\x49\x09\x6c\x99\x68\xb2\x7b\x19\x1d\x0e\x4a\xf0\x4b\x24\x93\xe8\xcb\xbe\x77\x5c\x02\xff\x05\xc1\x24\x3f\x9c\xae\x3a\x3d\x2d\x9f\x83\x56\x9c\x69\xce\x9f\x4f\xce\x1f\x35\xce\x1f\x1a\xce\x1d\xff\xcd\x1f\xe4\xcd\x1d\xc9\x55\x9c\xad\x55\x1e\x92\x95\xce\xba\x74\xd9\xa4\x94\x28\x68\x20\x24\x7b\x1d\x99\x58\x50\x4b\x13\xa6\x54\x09\xb9\xea\x96\xe6\x88\x56\x77\x01\xc3\xa6\x85\xde\x4e\x91\x52\x9a\x09\x3a\x71\x04\x4c\x79\xf0\x6b\x24\x95\x30\x42\x7f\xac\xd2\x5f\x24\x95\x86\xb8\xde\x6b\x02\xa6\xcc\x03\xb5\xbe\xd6\xa5\x13\x6d\x60\x88\xa4\x12\xef\xd3\x87\x93\x80\xd1\x1b\xd1\x69\xf7\x1e\x89\x6c\x44\xb5\x78\xb2\x46\xf6\x1f\x75\x88\x05\xe2\x49\x7b\xfb\x3b\xce\x5b\xb4\x4c\x3c\xe9\xb5\x2e\x36\x6f\x22\x0d\xb2\x24\x9f\xec\x8e\x4e\xdf\x6a\xe9\x8b\x34\x27\xef\x1f\x9a\xc7\x1a\xe0\x22\x01\x25\x3e\x3c\xf9\xa1\x01\xea\x02\x4a\xfb\xc7\x43\x9a\x93\x73\x89\x80\x92\x1e\xa4\x93\xbc\x47\x96\x08\x28\x57\x7e\xe7\x08\x6b\x70\x9a\x7c\x72\x3d\x19\x7d\x79\xd0\x92\x17\x88\x27\xed\x0f\x27\xfb\x13\x0d\xb0\x52\x3c\xf9\x74\x7d\x7e\xe9\x69\x60\x65\xe9\xe4\xe0\xf4\xe4\xba\x00\x98\x93\x4e\x8e\x06\xd7\x3f\x12\x3d\x7d\x81\x70\xf2\xfd\xb1\xd3\xd7\x01\xab\x85\x93\xce\xf1\x97\x6f\xf9\x14\xaa\x10\x4e\x36\xbe\xfb\x9f\xb5\xe4\x6a\xcd\x49\xfb\xf4\xd3\x7d\x3e\xcb\x96\x49\x27\x51\x2b\x55\x06\x72\x59\x3a\x69\x0f\x26\xcf\x1f\xb4\xe4\x6a\xe9\xa4\x13\x27\xd3\x6d\x0d\x6e\x81\x74\x12\xbc\xbd\x52\xc6\xc1\x9c\x74\x72\x30\xdd\xd3\xc9\x5d\x2d\x9d\xb4\x6f\xae\x0f\xeb\x1a\x9c\xa0\xba\xde\xb8\x96\xff\xf9\xa3\x32\x07\xcb\xd2\xc9\xf4\xd3\xf9\x4d\xce\x35\x97\x09\x27\xbd\x16\xbd\x7e\xea\x97\x21\x97\x4a\x27\xad\xe6\x51\x2b\x29\xe7\x58\x2e\x9d\xfc\xf8\x72\x5e\x2e\xa3\x42\x3a\xe9\x75\x0e\x3f\x4e\x9e\x4a\x70\x4b\xc5\x93\xce\xe4\xe8\x3e\x2c\x65\xd8\x9c\x4d\x70\xf2\xff\xa7\xee\x4d\x78\x1b\xd7\x95\x36\xe1\xbf\x92\xcf\xc0\xc5\x77\x0e\xda\xcc\x68\xb1\xbc\x05\x37\x98\xce\x9e\x8e\xb3\xef\x79\x71\x61\xc8\x96\x6c\x29\x91\x97\xd8\x72\x56\xf4\x7f\x1f\x90\x22\x25\x16\x45\x4a\xb4\x93\xbe\x67\x06\xfd\xbe\xe7\xc6\xe4\x53\x4f\x55\x91\x55\x25\x5a\xa6\xa8\x54\x40\x88\x09\xcb\xba\xbc\xde\x12\x34\xc8\xef\x9d\x5c\xd4\xfa\x02\xae\x70\x79\x62\xfe\xbc\x7d\x8a\x05\x81\xc2\x7b\x27\xd6\xd6\xc7\xc7\x4f\x41\x40\x76\xef\xc4\xde\x1d\xbe\x85\x02\xae\x68\x81\xd2\xb5\xcf\x0f\x1e\xf7\x05\x81\x82\x15\x4a\xd7\x7e\xbc\x7a\x12\x5d\xcd\x2d\x51\xe8\x19\x10\x45\xe7\x81\x11\xc8\x5a\x7f\x12\xe5\xb6\x95\x09\xcf\x11\xb3\xfd\xbf\x44\x00\x2b\x5e\x8c\xc6\x1b\xc9\x82\x80\x6c\x9e\xa7\xc7\x45\x78\x02\x4f\x95\xb6\x07\x4b\xf2\xfb\x51\xc4\xb3\xd3\x93\xdb\xd2\xcd\x6e\xf1\x64\xda\x6e\x72\x3b\x69\xe8\x2e\x49\xdc\x94\xed\x3a\xe6\xb7\xec\xe2\xb0\xfa\x1d\x07\xfc\x83\x5f\xa4\x09\x9c\x96\x91\x2d\x61\xc4\x27\xf8\xf3\x9b\x31\xc1\xb1\x19\x9b\xe4\xa8\x92\xcd\x78\xb6\x19\x07\xe0\xfc\x8c\x4d\x72\x48\xbf\xb4\x03\x6f\x5e\x95\x76\xa4\x54\x9e\x8a\xca\x53\x51\xa5\x3b\x1c\xc9\xe0\x28\xb6\x65\x0b\xe7\x1a\xc4\x93\xa9\xe2\x59\x78\xcf\xf3\x94\x5e\x8a\xa7\x23\x24\x13\xc0\x88\xd8\x38\x15\x70\xd1\x19\xfd\xc1\x38\xdb\x03\xfc\x5a\xd0\x64\xe3\x7a\x6e\x4c\xfa\x93\x08\x3f\x8b\x32\xd5\x43\x13\x10\x44\x94\xd0\x17\xda\xe2\x2d\x65\x8b\xb7\xac\x2d\xe9\xf1\x0f\x78\xf4\xe1\xf1\x2d\xc9\x94\xff\x20\xff\xe5\x51\x05\xe3\xba\xc6\x7f\x90\x3c\xc4\x33\x18\x0c\x70\xdd\x8b\x1e\xd0\x3e\x3d\x95\xa5\x3f\x19\x7b\xe4\xac\x48\x7e\x7a\xab\x4a\x0c\x1f\xcf\x2a\x0c\x1f\xda\xa5\xba\x3c\x0d\x5d\x9e\x86\xae\x2c\xf6\xe9\x86\x5b\xc9\xc9\x33\x74\x10\x73\x41\x2e\x05\x0b\xe3\x21\x87\x80\xe1\x90\x43\xc0\x68\x94\x28\xf2\xca\x15\x79\xe5\x8a\xfe\x80\x9f\x99\x85\x9f\x20\xc7\x69\x91\xc4\xcf\x30\xaf\x77\xad\xf7\xf9\xde\x29\x15\xc5\x07\xf3\x4e\x39\xc3\xdb\xe3\x38\x40\x93\x01\x8a\xdf\xa7\xfe\x5f\x13\xcf\xfb\x5b\x16\x9c\xad\x41\x6b\x40\x9e\x30\x3b\xf7\x9c\x07\x4a\x44\x9e\x53\xcb\x68\xc8\x47\x99\xac\x33\x70\x06\x8e\x90\x3e\x99\xd5\xf8\x36\xf0\xcb\xfd\x3d\x62\xa7\x68\x00\x18\xe5\x2e\x83\x65\xe3\x5b\x08\x4b\x95\x06\x7a\x4a\x03\x3d\xa5\x81\x8e\x52\x88\xc9\x57\x23\xaa\xb4\x0c\x46\x95\x96\xc1\x14\x4a\x03\x3d\xa5\x81\x9e\xd2\xa0\x60\xb2\x0b\x02\x25\x37\x4b\xf4\x81\xc7\x62\x99\x60\x69\x19\x99\x00\x4d\x52\xa5\x0c\x45\x41\xd1\x15\xb4\xc8\x86\xc6\x6f\xe2\x7f\x45\x79\x70\x3a\xdc\xb7\xd8\xb3\xb4\xf2\x99\x2a\xc5\xd1\xa9\x2a\xc5\xa5\x7a\x03\x4d\xbd\x81\xa6\xde\x40\x4b\xaf\x00\xca\x07\x30\xd5\x5b\x8a\xa3\x7a\x4b\x71\x2a\xbd\xea\x8c\x28\xc6\xa9\xf4\x06\xea\x07\xa9\x8b\xa2\x28\x3f\x5f\xda\x49\xb1\xa4\x90\x54\x42\x3f\x2d\x78\xd9\x55\xf4\x48\xc7\xc7\xf0\x5b\xfd\x7a\x51\x62\x1c\xc6\x87\x0e\x79\x10\x5c\x3e\x5b\xc5\x20\x3a\x55\xc5\xa0\x54\x5d\xa0\xa3\x2e\xd0\x51\x17\x94\xab\xe3\x11\xf9\x98\xa5\xea\x8a\x41\x54\x5d\x31\x48\xaa\x4e\x1d\xfa\x05\x20\xa9\x3a\xe9\xa4\x26\x4f\xf2\x17\x85\x88\x30\x23\xda\x11\xbf\x8c\x44\x1e\xae\x1f\xeb\xa9\xe0\xd2\x1a\x64\x03\xd2\xaf\xf9\xf6\xc0\x56\x47\xf9\xd1\xec\xe2\xb8\xc9\x0e\x18\x90\x4f\x4d\x19\x8c\x4e\x4e\x19\x2c\x55\x1a\xe8\x29\x0d\xf4\x94\x06\x3a\x4a\x21\x26\x1f\xa9\x54\x69\x19\x8c\x2a\x2d\x83\x29\x94\xaa\x22\xbf\x04\xa6\x50\x1a\xa8\x0f\x95\x28\x8a\x9b\xdc\x2c\x69\xc6\xff\xb2\x32\x32\x25\xba\x39\x00\x44\x57\xd0\x22\x1d\x1a\x77\x60\xf5\xfb\xea\x3c\xd8\x7e\xdd\x1e\x9e\xd0\x13\x2f\xe4\xf3\x54\x82\xa2\xd3\x54\x82\x4a\x35\x06\x5a\x1a\x03\x2d\x8d\x81\x86\x46\x00\xc9\x87\x2c\xd5\x58\x82\xa2\x1a\x4b\x50\x72\x8d\xaa\xe8\x2f\x46\xc9\x35\x4a\x27\x38\x39\xd8\xa4\x28\x5a\xc4\xb9\xd1\x0c\xfd\x25\x45\x24\x2a\x74\x03\x9f\x97\x5c\x5e\x87\x6c\x54\xfc\x5e\xbf\x8f\xc3\xbe\x6b\x4f\xf6\xdf\x1e\x29\x17\x77\x4c\x29\xf7\x6c\xe2\xba\x61\xfe\x2b\x3d\x96\x03\xbd\x81\xe7\xbb\xe7\xfd\x99\xef\x8f\xd7\xf0\x3b\x86\xfe\xca\xee\x84\x36\xea\x8d\xe4\xe7\x6d\x05\xb9\xf2\x7e\x29\x7e\xc4\x31\x53\xf5\xce\xce\x00\xc1\x6f\xfb\x48\x5b\xc9\x11\x2d\x6d\xdc\x84\x0d\x09\x42\x72\x5b\x77\xde\x9f\x4d\xa2\xa8\xe7\xce\x36\x94\x37\x34\xa4\xa6\x6c\xf2\x51\xf5\x09\x8d\x31\xf4\xa4\xc4\xbb\x22\x3a\x12\x74\xb6\x96\x90\xa0\xf1\xbe\x84\x44\x6a\x95\xb7\xb4\x55\xde\xd2\x56\x79\x8a\x13\xd0\x14\x24\xd2\x7b\x47\xec\x5e\x94\xb1\xac\x20\x3f\x03\xfc\x2d\xd3\xea\xd2\x3c\xd9\xbc\x7c\x8d\x27\x9b\xad\xaf\xf1\x64\x73\xf8\x4d\x7e\x79\xdf\xe4\x17\xe0\x61\x37\xfa\xe8\xaf\x49\xab\x7b\x19\xf0\x87\xd5\xac\xee\xe4\xd7\x68\xb8\xb9\x8b\xdc\x6f\x99\xba\x6f\x71\xca\xfb\x1e\xa7\x78\x1a\x36\x6f\xf4\x40\x88\xdf\xab\x1a\xc7\x51\x16\xd7\xa7\x62\xe3\xbe\x4a\x23\xb3\xc6\xfb\x1e\x6b\x84\x7b\xd9\x6d\xe3\x37\x7f\x84\xbf\xfc\xd0\x2e\xe1\x62\x02\x4e\xde\xa4\x87\xb2\x70\xbf\x31\x26\x67\x50\x4a\x8e\xb5\x12\x5f\x2c\x94\x9c\x2e\x9e\xbc\x00\x6b\xb2\xe8\x07\xe4\x4e\xdf\x64\xdc\x1e\xb9\xe3\x70\xba\xc0\x27\x0b\x4c\xc6\x1b\xea\x1e\x78\x34\x33\x7f\xb8\x5f\x38\x72\x87\xf4\xb0\xb2\xdc\x35\x94\xfb\x21\x98\xfd\x92\x8a\x4f\x1f\x5f\x33\xad\xe9\x9b\xe6\x99\xf2\xea\x33\xc4\x17\x73\xfc\x6a\x0f\x72\x88\x7c\xa2\x9e\x1c\xa8\x2f\x69\x9d\xe7\xa1\x62\x03\x3f\x2d\xc9\x79\xef\x55\xbe\x85\xde\x15\xcd\x75\x08\x77\x4d\x65\xfd\x7e\x67\x7b\x07\xe5\x3a\xe8\x61\xdb\xca\x7e\x48\x0c\x60\x5f\x3c\x88\x9e\x37\x22\x5d\x7b\xa6\x2d\x32\x43\x38\xd5\x74\x11\x88\x0f\xf8\x97\x9e\xb1\xcf\x53\x65\x37\x9d\xe5\x4e\x7d\xca\x63\x88\x79\x67\xc8\x5e\x69\x12\x8e\xe7\x7e\xbc\x66\xac\xd9\xd3\xb7\x35\x7c\x20\x16\xff\x86\x83\x75\xd3\x72\xfe\xde\x58\x06\xcc\x4f\xfa\x7a\xd7\xba\x3e\x1b\xf5\x11\x7b\x9b\x12\x6f\x37\xf7\xde\x25\xf6\x1e\x9c\xac\x69\x8d\x03\xb2\x03\xcc\xc7\x13\xbc\x05\x00\x1f\xd1\xea\x6d\x0c\xc2\x88\x1c\x36\x15\x4d\x03\xf7\xaf\x09\x7e\x11\x4d\xfc\xfe\xef\xba\xf3\xf7\x06\xfd\xbb\xbd\x5e\x77\x64\xae\xd2\x84\x02\x9f\x7f\xbb\x9c\xb2\x9c\xc5\x12\xdb\x78\xfc\x27\xcd\x5d\xe4\xbf\xe0\xd7\xe4\xb2\x09\x6b\xa0\x9a\xef\xe1\x7e\x44\xdf\x1b\xf5\x59\xf6\x16\x07\x96\x92\xb4\x25\xf9\x3a\x90\xa3\x49\xb3\x28\xd7\xa3\x0c\xa9\xbc\x36\xbf\x8e\xff\x09\x0a\x9b\x7d\xfc\x4f\xaa\x93\x04\xf4\x0a\x94\xae\x87\xff\x49\x29\xd3\x30\xce\x75\xc1\x70\xae\xae\xf7\xa3\xee\xf8\x09\x4d\xa6\xfe\x18\xdf\x75\x3b\x1a\xdf\xef\x92\xf7\xb1\x78\x93\x57\x7c\xde\xe4\x70\x18\xf9\x12\x12\x3d\x63\xa5\x99\xb2\xa4\x0b\x69\xb6\x97\x38\xc2\x70\x2b\xb9\xa3\x56\xc2\xac\x28\x0a\x0b\xde\x0a\x8a\x5b\xcd\x0a\xa5\x12\x6a\x05\x08\xc1\x72\x63\x04\xf8\x4a\x36\x01\x8e\xe2\x59\xf7\x6a\xf8\x9f\x76\xd4\x8b\x85\x40\x3d\x07\x59\x65\xa0\x18\x69\x39\xcb\x89\xa9\x09\x73\xaa\x95\x03\xca\xa9\x4e\x30\x9a\xaa\x95\x84\xa2\x6a\x61\x8e\x8a\x2c\x80\x50\x3d\x43\x80\xcc\xe7\x17\xea\x22\xde\x86\x7f\x77\x67\x7f\xa0\x9e\xeb\x0d\xfd\xb2\x03\x93\xf1\x61\xc3\xe6\x4d\xef\xac\x43\x28\xe8\xa9\xc8\x65\x42\xf8\x94\x65\xc1\x18\xcb\xaf\x7b\x6e\x4d\x46\x96\xe6\x4a\xae\x07\xba\x5c\xa8\x33\x39\x8e\x59\xd0\x69\x5a\x56\xaf\x66\x48\x75\x82\x3a\xbd\x04\xa5\x65\xd4\xbc\x86\xdc\x8d\xb4\x4e\xe7\xba\x60\x2e\xeb\xa5\x6f\x8e\x44\xcf\x58\x8d\x3a\x5d\xea\x42\x9a\x6d\x25\x8e\x30\xdc\x4a\xee\xa8\x95\x30\x2b\x8a\xc2\x82\xb7\x82\xe2\x56\xb3\x42\xa9\x84\x5a\x01\x42\xb0\xdc\x18\x01\xbe\x92\x4d\x80\xa3\x64\xd6\xc9\x54\x6a\x47\x7d\xae\x58\x2a\xe7\x20\x2b\x45\x74\x9e\xa4\x35\x6a\x89\x49\xcd\xa9\x56\x0e\xa8\x5e\x9d\x5e\x62\x26\x45\xd5\xc2\x1c\x15\x59\x00\xa1\x7a\x86\x94\xd5\xe9\x25\xab\xa3\xbc\x5a\x33\x92\x1c\x3b\xdd\xfc\x78\x66\x3c\xc5\xc4\x28\xba\x6b\xa0\x38\x8c\x9c\x7e\xaf\xe9\xf4\x05\x93\x6a\x7d\xd7\xaf\xf5\x65\x64\xd9\x50\x8b\x3d\xd0\xf7\x42\x9d\xb5\x5a\xcb\xab\x89\x4b\x0c\xcb\x71\xea\x96\x23\xd5\xa9\x51\xb0\xa5\x94\x76\xab\x59\xb3\x5b\x52\xca\xac\x60\x8b\x5d\x30\xa9\x35\xf3\x58\x24\xd1\x33\x56\xa3\x60\x97\xba\x90\xa5\x5d\xb1\x23\x0c\xb7\x92\x3b\x6a\x25\xcc\x8a\xa2\xb0\xe0\xad\xa0\xb8\xd5\xac\x50\x2a\xa1\x56\x80\x10\x2c\x37\x46\x80\xaf\x64\x13\xe0\x28\x9e\xf5\x64\x2a\xb5\xa3\x3e\x57\x35\x95\x73\x90\xd5\x24\x3a\x4f\xf2\x62\xa5\x3f\xa9\x39\xd5\xca\x01\xd5\x2c\xd8\xfa\x33\x29\xaa\x16\xe6\xa8\xc8\x02\x08\xd5\x33\xa4\xac\x60\x2f\x59\x1d\xe5\x05\x9b\x91\xe4\xd8\x93\x82\x6d\x39\xef\xaf\x16\x31\x8a\x7f\xe7\x8b\x3c\x86\x9c\x5e\xdf\xf0\xc4\x1a\x51\xab\xf7\x9a\x9e\x9b\x63\x4a\x07\x19\x36\x43\x97\x0b\xb5\xd9\x66\xcf\xf0\x1c\x41\x9b\xd9\xab\x7b\x4d\x27\xaf\x4d\xa3\x48\x4b\xf9\xac\x7a\xcb\xed\xf5\xf3\x7c\x69\x85\x86\xed\x30\x85\xf5\xb2\x16\x32\xe8\xd9\xa8\x51\x9b\x8b\x2d\x4f\xd3\xab\xc8\x7e\x06\x5a\xde\x0b\x05\x3d\x53\xae\x9c\x7b\x5e\x39\x05\xad\xa0\x5c\x4e\x4f\x95\x83\x08\x2b\xb1\x41\xc0\x2e\x6f\x0a\x20\x28\x9e\xda\x64\xbe\xf4\xc2\x39\x57\x02\xe5\xc3\x9d\x55\x17\x3a\x1f\xd2\xb2\xa3\x35\x73\x39\x8d\xf2\xe1\xd3\xab\xb8\x50\x46\x41\x25\x6a\x14\xe6\x42\xa9\x18\xe2\x34\xf4\x97\x56\xd9\x25\xaa\x9a\xa2\xc4\x52\x86\x1c\x35\x29\xb1\x28\x1e\xee\x9d\x12\x06\xba\xb5\xaa\x38\x4e\x06\x86\xeb\xd5\x44\x73\x7c\xdf\xb5\xec\xba\x84\x8b\x0d\x6e\xae\x03\x7a\x5d\xa8\xd1\xef\xb7\x1a\xa6\x78\x0b\xa7\xd5\x74\x06\x86\x27\xd3\xa8\x51\x6a\xa5\x8c\x9e\xd3\x74\x4c\x4b\xc6\xc8\xaa\x69\xae\x07\x66\xab\x56\x82\xe6\x38\xf4\x2c\xd5\x28\xb8\x65\xf6\xb3\xcc\x2a\xf1\x82\xc1\x56\xf1\x45\xa9\x82\x99\x50\x10\x0d\xbc\x09\x14\xb6\x92\x09\x2a\x15\xd4\x04\x10\x77\xa5\x96\x08\xe8\x55\x0c\x02\x14\xc5\x93\x9d\xcc\xa0\x6e\xa0\xe7\x4a\xa2\x6a\xf0\xb3\xda\x43\xe7\x47\x56\x94\xf4\xe7\x32\xa7\x57\x35\x94\x5a\xc5\x38\x27\xa5\xa4\x13\xf5\x0a\x73\x53\xa0\x1e\x22\xb5\xac\x28\x2b\xca\xcb\x55\x41\x79\x59\x66\x1c\x79\x72\x5c\x96\xbb\xe6\xe0\xf4\xb6\x47\x58\xe8\x0b\xde\x8a\x63\xa7\xe5\xd8\xb5\x5c\x49\xab\xd9\x03\xdb\x95\x70\xb1\x41\xce\x75\x40\xbf\x0b\x35\xf6\x5b\xb6\x61\x89\x0b\x86\x46\xdd\xec\x9b\x2d\x99\x46\x8d\xb2\x2c\x65\x74\xfb\x56\xcb\x72\x64\x8c\xac\xea\xe6\x7a\x60\x0e\x6b\xa5\x6d\x8e\x43\xcf\x52\x8d\xb2\x5c\x66\x3f\xcb\xb3\x12\x2f\x18\x6c\x15\x5f\x94\x2a\x98\x09\x05\xd1\xc0\x9b\x40\x61\x2b\x99\xa0\x52\x41\x4d\x00\x71\x57\x6a\x89\x80\x5e\xc5\x20\x40\x51\x3c\xd9\xc9\x0c\xea\x06\x7a\xae\x3c\xaa\x06\x3f\xab\x3f\x74\x7e\x64\x85\x49\x7f\x2e\x73\x7a\x55\x43\xa9\x55\x96\x73\x52\x4a\x3a\x51\xaf\x30\x37\x05\xea\x21\x52\xcb\x8a\xb2\xb2\xbc\x5c\x15\x94\x97\x65\xc6\xa1\xba\x21\xf1\xe4\xbc\x5c\x13\x93\xa2\x70\xfc\x94\x7b\xbb\xb9\x70\x1b\x1a\xec\x90\x32\x72\xe2\x55\xb1\x81\xa6\x43\xae\x1d\xc6\x7f\xae\x3b\x1b\x34\xf9\x38\x42\x74\xe1\xa9\x20\xda\x1b\x5e\x44\xd6\x9c\x51\x69\xa8\x0a\xcd\x2c\x32\x84\x66\xb6\xf5\x88\x9f\x3b\xce\xae\xdf\x4b\xd1\xb3\xc9\xb4\x6c\xc7\x6a\xf4\x73\x3b\xa2\x16\x63\xcf\x9f\xe1\xfd\x57\x1b\x45\x43\xf1\x5b\x3d\xcc\x54\xb9\xc6\x60\x2b\xcc\xd4\xcb\xc2\x22\xd7\xd2\x43\x4e\xf2\x3b\xbd\x6a\xf7\xcd\xe7\xc3\x44\xd5\x10\x6b\x6e\x6d\xbf\x5f\x92\x8f\x38\x5b\xa6\x28\x1a\x6e\x82\xcd\x47\x74\xf7\x1f\x79\x83\x65\xf6\x46\xf1\xf4\xd5\xe6\xc2\xf6\x3f\x7a\x10\x8d\x10\xdb\xf8\xcd\xea\xeb\x5d\xdb\x38\x0d\xa7\x98\x14\xcd\x47\x58\xb1\xbf\x7b\xba\xcd\x29\x9e\x8f\xa4\x8a\xf1\x76\x31\xd3\x80\x7a\xad\x9c\x5e\x47\xd0\x68\x13\x8d\xd6\xd3\xf6\xcb\x2e\x66\x43\x6f\x38\xa6\xcc\x9f\xd6\xd5\x1b\xa7\xf1\x6d\x2e\x77\x35\xd9\x77\xb6\x8a\x42\x7b\x14\xf6\x93\x22\x42\xf6\x84\x0a\xef\xc6\xcc\x1e\x83\xc8\x43\x7f\xe4\x85\xe9\x86\x52\x7c\xae\x07\x3e\xb8\x22\x1c\x4f\x17\xf1\xff\xc4\xef\x53\xff\xdf\x95\xf9\xa2\x37\x0a\xe3\xca\x7f\x72\x42\x55\x1e\x35\xf3\xe7\x7e\x29\xa8\xb7\x88\xe3\xc9\x58\x82\x62\x27\xce\xd1\x43\xe2\xde\x67\xc7\xa7\xd9\xd8\x55\xd7\xfd\x93\xa3\xf7\x23\x6e\x30\xd9\xb6\x55\xc9\x7b\xaf\xa4\xdb\x65\xa5\xdb\x5c\xf3\x8a\xf8\x19\x52\x2b\x85\xf3\x98\x33\x00\x1e\x4f\xa8\x56\xc0\xd2\x51\x47\x4d\x96\xba\x05\x7c\xb4\x9a\x69\xf1\xa5\x95\xaf\x80\x8f\x55\x78\x2d\xc2\xec\x72\xa0\x66\x14\x2f\x1d\x3a\xc4\x50\xe6\xf3\x03\x85\x63\xcf\x7f\x6b\x5b\xf9\xb1\xe5\xf7\x58\xfe\xe0\xfe\xae\x96\x21\x85\x5e\x29\x5e\x68\x29\xe5\xcf\xe3\x85\x96\x4f\xf8\x76\x46\x52\x41\xcc\xab\xbb\x7a\xf2\x3b\x45\x3c\x99\xe0\xe7\x8d\x20\xc8\x91\x83\x78\x6f\xaa\xf2\x7e\x41\xb7\x0a\x15\x0d\xa7\x0f\x88\xe4\x34\xb5\x11\x84\x72\x4e\x64\xb3\x44\xf1\xa6\x96\xe2\x4d\x99\x62\xde\x6f\xfa\x3e\x6b\xc8\xc4\xeb\x6e\x8f\x27\xf1\x5f\xfc\x23\x23\x7f\x27\x2d\xd9\x1e\xfb\xa4\x41\xb1\x22\xff\xfb\x13\x16\x57\xa3\x44\x1b\xff\x6c\x0a\x6f\xe7\x12\x72\xab\xd9\x17\x4f\xa6\xc9\xeb\x35\x53\x4b\x37\xe0\x21\x38\xb0\xb3\xc4\x9c\x4c\x7b\x7e\xfc\xf2\x21\xad\xfa\x3a\x93\x97\xe5\xad\xc5\x91\xa3\x32\x16\xf4\x49\x6d\x15\x5a\x84\x33\xe9\xcb\xe0\x1a\x51\xc1\x8f\x07\x33\x7b\x19\x8b\x0a\xa7\x54\x31\xd8\xd5\x6f\xe0\x95\xce\xc4\x77\x46\x89\x68\x4f\xa6\x3e\x3f\xaa\xc0\x4f\xae\xe3\x9b\xe2\x60\x4d\x15\x78\xaa\x0b\x0e\x77\x5f\x42\x25\x9b\x3e\x1c\x21\xf7\x3e\x75\xe7\x87\x4a\x1e\xbe\xec\x96\x3f\x01\x10\x7b\x83\x4f\xbd\x93\x12\xf3\x8b\x61\x4d\x6e\xd3\x12\xc9\x4d\x4b\xc6\xae\xe3\xf5\x7f\xe3\x51\x89\xe5\xcd\x12\xbf\x5a\x7c\xea\x7f\xeb\x4b\x27\x6a\x6d\xfd\x76\x70\x77\x71\x8f\xfa\xee\xcc\x8f\x73\x45\x99\x1f\x76\x88\xa4\x91\x48\xcf\x5a\xa6\x6e\x89\x01\x9a\xf4\x1a\xbf\xd7\xdf\xce\x8f\x6b\x77\xc4\x7c\x72\x89\xd7\xa4\x35\x08\x29\xb9\x88\xe9\xac\x77\x0a\x57\x45\xc2\xe8\x2e\x83\xdd\x94\x3d\x52\x46\xdf\xa2\x9f\x1e\x70\xb9\xa1\x3c\x7a\xf2\xf7\xaa\xaa\xb8\xd3\x33\x8b\x28\x14\x2b\x37\x4d\x89\x2f\x8c\x8b\xb6\xbe\xbc\x9c\xd0\xc2\xe2\x0e\x7f\x85\x42\xa6\xf0\xfe\x6d\x43\x6f\xf6\x35\x2e\x5a\x2c\xba\xb2\x92\xa9\x45\x5c\x74\x49\x51\x95\x6a\xfc\x1c\x9d\xea\xaa\xc2\xf5\x49\xaf\x2b\xc5\x55\x5e\xcb\xe4\xa2\xab\x4e\xe9\xd5\x65\xb9\xcb\x60\xde\x1b\x9e\xb4\x56\x92\xbc\x42\x20\x68\x4c\x22\xef\x27\x73\x45\x6b\x74\x44\x55\x45\xd3\xaa\x18\xcc\xea\x37\xf2\xcb\x2f\x34\x05\x03\x6d\x14\x0c\xf3\x72\x9e\x17\x45\x07\xf0\x9c\xeb\x58\x21\x6a\x7e\xaf\x0f\x3f\x8e\x5b\x7d\xce\xa6\xc7\xc5\x3c\x0e\x07\xa1\xef\xa5\x65\x94\x3c\xfd\xcb\xd7\x4e\xd2\x80\x22\xf7\x7d\xb2\x88\xdb\x83\xf0\xcd\xf7\x98\x82\xfe\x24\x8a\xdc\xe9\xdc\x6f\xcf\xfd\xa9\x3b\x73\x63\xbf\x80\x9f\xf7\xa2\x5a\x08\x13\xc6\xe6\xb3\xf0\x14\x63\x66\xe8\xbf\x7e\x2f\xc3\xc9\x7f\xcb\x04\x37\x6b\x96\xe3\x70\xdc\xfd\xd6\x6b\x16\x30\x23\x7f\xbc\xe0\x8e\x1e\xff\x1f\xcf\x8d\x5d\x1a\x45\xec\x66\xd1\xbc\xf2\x1f\x7e\x20\xd6\xc0\x3d\x27\xd7\x0b\x27\x95\xff\x54\xd5\x72\x82\x05\x5f\x60\x92\xcb\xf5\x03\xbf\xff\xd4\x9b\xbc\x7d\x8b\x11\x19\x59\x76\x5b\xc9\xed\xcd\x27\xd1\x22\xf6\x37\xfa\x51\x38\x6d\xcf\xfc\x7e\xfc\x97\x51\x5d\xa3\xff\xf7\xf7\x86\xfc\x71\x4d\xb3\x79\x63\x1f\xa3\xb1\xfb\x92\xae\x26\xc9\x18\x1b\xb9\xc7\xc6\xa3\x70\x1e\xd3\x33\x4f\x44\xd1\xcd\x28\x94\xdc\xdc\x62\x91\x45\x56\x0e\x02\x7c\xd3\x2d\x13\x60\x2b\xd9\x36\xbe\xc7\xba\x46\x5f\x39\x0f\x29\xd2\x3b\x5d\x42\x33\xb9\x61\xf5\x29\xbb\xcb\x2c\xf9\x7d\xc4\xf7\x45\x57\xc4\xdf\x86\x36\x5d\xee\xe6\x75\x29\x56\x6a\x94\x04\xa6\x75\x57\x7c\x43\xf2\xfc\x6f\xc9\xbd\xff\x54\xed\x1a\xb7\xa0\xde\x74\xab\xca\x9e\xbc\xc1\x42\xbf\xea\x67\x2b\xdf\x17\x7f\x35\x4e\x1e\x63\x80\x46\x74\xed\xc7\xd7\xc0\xc7\x7f\x23\x2f\x7c\x09\x3d\x7f\xf6\xc9\xee\x55\xa7\xeb\x9e\x76\x8b\xac\xa5\xd9\xc9\x3a\xec\xb4\x1d\x89\x4a\xc7\x77\x7c\x87\x57\x40\x62\x69\x33\x1c\x0d\x3f\xb3\x45\x28\x0d\x4f\xab\x1f\xde\x9f\x11\xc5\xb1\xdb\x9b\xc3\x2b\x4d\xfe\x58\x1e\x88\xc6\x11\x9d\xdd\x43\x10\x72\x81\xde\x82\xcb\x49\x6c\xba\x6c\x5d\x47\xdf\xb7\x51\x76\x08\x81\xe2\x60\x03\x6a\x29\xbd\xac\xd4\xa6\x6f\x6b\xf8\xff\x8d\x35\x43\xae\x94\xee\x1d\x83\x53\xe1\xfb\xfe\x5a\xf2\x1f\x85\x7b\xf0\x66\x29\x09\x90\x52\x4c\x1a\x2a\xe5\x48\x10\xde\x8e\xe3\xb0\x48\xa6\x0f\x2d\x6e\x14\x3d\xf0\x28\x4c\xce\x06\x98\x39\x69\xd0\x43\x7b\xd6\xbb\xf6\xb8\xf3\xeb\x27\x99\xfa\xf4\x12\xc3\x5d\x88\x20\x61\xdb\xd0\x23\xc8\x42\x42\x1a\x5f\x2a\x99\x2c\x28\xa8\x3a\x7c\xb2\x54\xee\xbc\x0d\x4d\x3a\x72\x86\xc8\xcd\x4e\x7a\x51\x54\x5c\x25\xf1\xd7\x0a\x7c\x91\xdc\xc8\x2e\x97\xea\x97\x65\x6b\xfa\x21\x5b\x28\xd0\x21\xfd\xd7\xea\x83\x61\xfc\x5e\x41\x36\xc9\x2e\x23\x9f\x27\x9a\x5c\xa5\xa1\xaf\x27\xa6\xc8\x06\x4d\x61\x5a\x55\x65\x01\xff\xf5\xc9\xda\x74\x0b\xcb\xdd\x12\x15\xe6\xff\x91\x11\x84\xc5\x01\xd7\x12\x1c\x58\x76\xe8\x3c\xdd\x12\x1b\xa6\x61\x14\x09\x45\x5d\xda\x9f\x0d\x1c\x88\xaa\x3c\xf0\x47\x14\xb2\x70\x24\x49\x66\x29\x70\xa2\xd5\x55\x1d\x50\x3a\x2e\x1a\x50\x8d\xad\x40\xe9\x65\xd9\x9e\x0e\x6e\x1e\x88\xe6\x79\xec\xf6\x9f\x24\x35\x4d\x86\xe0\x5c\xa5\xaf\x72\x10\x6f\x57\x94\xd4\x5b\x39\x20\xaf\xbb\x24\xed\x4b\xea\xe7\x7f\xad\x5e\xae\x54\x1f\x35\x9d\x23\xf5\xd0\x6c\xce\xcf\x3b\x69\x36\x64\x02\x30\xd6\xdb\x46\x01\x14\x70\x17\xd5\x4b\x25\x81\x18\x68\xd5\x25\xb0\x69\xfc\xea\x4b\xac\x58\x0e\x95\x0a\xbe\x56\x03\xff\x6f\x1c\x15\x69\x89\xdb\x1b\xf6\xce\x76\xf0\x74\xe0\x97\x90\xc4\xfe\x38\xc6\x1c\xcf\xe6\xcd\x1e\x69\x9b\xba\x63\x3f\x8d\xcb\x24\xcb\xa4\x02\x9c\xd2\x4f\xf1\x5b\x1b\x2c\xcd\x8a\x04\x12\x6f\x67\xae\x72\xef\x64\x6c\x3a\xc7\x63\x34\x75\x87\xe1\x98\x7c\x61\xfb\x94\xee\xce\x90\x7e\x49\x25\xaf\x37\x5a\x93\x06\xb8\xc8\xca\x67\x6a\xb2\xed\x43\x8a\xc1\xf1\x2e\x6b\x9e\x4f\xdd\xe2\x7d\x1c\xf9\x53\xc9\xc4\x1f\xef\x55\x5f\x09\x40\xb1\x96\x7f\x1b\x5c\x62\xd1\xac\xf0\x8a\xc5\xa0\xca\xb7\xa2\x7e\x1a\x8e\x05\xc2\xa4\x3f\xdb\x77\xb1\x01\xb7\xb3\xe9\x7c\x83\x54\x59\xce\xdf\xb2\x53\xcc\x0d\x80\x60\x73\x58\xf9\xa3\xa1\xa2\x88\x49\x8d\xdb\xb9\x32\x65\x91\x5b\x66\x0e\x87\x20\xd6\xa8\x42\x3f\x6f\x80\xd8\x2d\xb1\x40\xac\x14\xd5\x52\x08\xb6\xa1\x1c\x95\x16\x29\x1d\x3a\x5d\xac\x3a\x70\x64\xa4\x30\x88\xd2\xbc\xc0\x91\x5e\xfa\x1d\x12\x6e\x60\x05\x8d\xf2\x41\x84\x77\x65\xd4\x63\x94\xc7\x15\x39\x2f\x41\x17\x8c\x00\x44\xbb\x5a\x20\x7d\xed\xc2\x0a\x11\xef\xbf\xd4\xb9\xa9\xc4\x55\x16\xd6\x42\x72\xb3\xe3\x1c\xd6\x86\x9c\x42\xbc\x2d\x13\x5f\x6b\xab\xaa\x1e\xec\xfd\x27\xbc\x97\xa7\xbb\x59\x53\xa5\x4d\x2c\x06\x1a\x28\x31\x03\xf9\x1c\xaf\x17\x56\x00\xb2\x45\x54\xa1\x21\x72\x35\xcc\xd0\xad\x03\xf5\xe2\x3a\x40\xb7\xaa\xce\xee\xad\x67\x5e\xc7\x7c\x44\x87\x5f\xd5\x05\xc6\x5f\x73\xcf\xaa\x52\x91\x38\xf2\x3a\xb0\xa2\xa1\xb7\x0b\x87\x9e\x6e\x5d\x95\xab\x88\x5c\x1d\x43\x74\x07\xdf\x2e\x1e\x7c\x62\x49\xef\xa5\x7b\x3c\x21\x6b\xaa\x88\x5d\x5a\xe8\xca\x12\x2f\x3f\x74\x2f\xce\x85\x37\xfa\x64\xdb\xd8\x8d\x35\x2c\x6c\x8a\x9b\x28\x0c\xb8\xe1\x42\x8d\xc2\x6b\xb8\xd7\x97\x0e\xae\x12\x63\x3f\x42\xf8\x70\xda\x2c\x1f\xe9\x8b\xe7\xcc\xd1\x6b\x93\xf6\xe3\xe3\x82\xf1\x03\x9e\xb9\xdb\xef\x1b\xaa\xd5\xb4\xc4\xa3\x82\x79\x96\x0d\xbd\xdc\x04\xd9\xb7\x37\xf9\x8f\x88\xc9\x78\x87\xe3\xc0\x9f\x85\x78\xc3\x97\x69\x5a\xe7\xe7\x94\x2c\x0e\xe3\xec\x40\x75\xfc\xfd\x35\xff\xcb\x06\x97\x0c\x38\x09\x4b\xd9\x48\xc0\xe5\x5b\xe7\x23\x37\x8a\xa4\x3d\xeb\x5d\xf3\xed\x79\x77\x86\xd4\x08\xd2\xb3\xe9\x96\x4b\x6f\xba\x79\x6f\x9f\xe3\x9e\x43\x25\xf0\xf1\xca\xfe\x4c\x36\x79\xf9\xe8\x24\xaf\x9f\xe3\xa6\x45\xf1\x05\x49\x91\x0f\x65\x79\xcb\x65\x0b\xf6\xe0\xe5\xf8\xe8\x16\x91\x9f\x90\xe8\xb6\x0b\xa1\xbf\x6e\x5d\x85\xd4\x07\xf6\xbb\xa7\x44\x4c\xc8\x3b\xa3\x4c\xcd\xda\xfa\x2c\x78\xe8\x9c\x70\x2d\x28\x8c\xfd\xd1\x8a\xda\x15\x6c\xac\xa8\xd0\x6f\xfb\x92\x6f\x22\xa5\x76\xf2\x05\x53\xa1\x85\x87\xac\x68\xff\x92\x5a\xb8\x62\xd9\x36\x56\xce\xec\x12\xc7\x23\xb7\xcc\xa2\xc8\xfd\xaa\xdb\xcb\xe8\x60\x4e\xa7\xc5\xe1\x3b\xb3\x40\x52\xe4\x7e\x68\xbb\xb1\xdc\x8c\x01\x53\x0c\xe5\x14\x19\xf2\xd2\xfb\xe3\xcb\xea\xd3\x9d\x70\x39\xa2\x1f\xd2\x8a\x25\x13\x05\x83\xc7\xbd\xee\x41\x8c\x03\x8d\x37\x44\xe8\x85\x0e\x27\x50\x52\x69\x38\xe4\x1a\x7d\x2f\xf0\x0a\x56\xa9\x44\x4b\xad\x63\x82\xac\xd0\xd3\x9f\x44\x48\xa5\x67\x4d\x38\x00\xe8\x05\x1e\xb0\x73\x34\xfc\xe4\xe9\x99\xcf\x4b\x28\xa9\x54\x61\xb8\x5c\xad\x50\x90\x97\xbe\x9f\xf8\x1b\x1d\x59\x56\x97\x92\x06\x2f\xba\xf4\x69\xbe\x66\x72\x89\xae\x7f\x76\x72\xd6\x62\xef\x8f\x39\xfe\x25\xd5\xab\x0e\xe7\x9f\x74\xe8\x4b\xaa\x57\x1f\xa6\xa0\x80\xf5\x0f\xcf\x50\xb0\x8a\x43\xa5\xc3\x14\xfc\x73\x33\x14\x68\xa6\xde\x37\xa6\x57\xe4\xfe\x11\x57\xbf\xa2\x79\xf5\xf1\xfb\x63\xee\x7c\x45\xf3\xea\x63\x14\xa8\x49\xff\xf0\xec\x04\x2b\xb8\x53\x3a\x46\xc1\x3f\x36\x3b\xbc\xe6\xcf\xd5\x2e\x5b\x4b\x9b\x1e\xb9\x72\xcb\xf3\x96\x7c\xc7\x97\x05\xb9\x06\x3a\x34\x7c\xc3\xec\x4f\x78\xb2\x9c\x1e\x15\x07\xbe\x1b\xa1\xcb\xf1\x15\x5b\x0b\xf5\xfc\xe3\x93\xb2\xda\x12\x41\xc7\xef\x2f\xa8\x5d\x69\x28\xff\x9c\x27\x5f\x50\xbb\xe2\xe0\xac\xb2\x28\xd0\xf1\xe4\x0b\x6a\x57\x1c\x9c\xe0\x9f\x99\x13\xa0\xf6\xf3\xbf\x92\x45\x91\xfb\x07\xdc\x5c\x5d\xeb\x8a\x03\xf7\x87\xfc\x58\x5d\xeb\x8a\x23\xb3\xc2\xd5\x5f\xc7\x8f\xd5\xb5\xae\x38\x32\xc1\x3f\x32\x1f\x81\xfe\x25\x4a\x48\x9f\xdc\xcf\x39\x3f\x78\x1b\xaa\xa5\x60\x85\x53\x82\x20\x47\xf9\x23\xcf\xa2\x37\x4e\x12\xc1\x4f\xe5\xef\x0d\xea\x22\x41\x63\xa2\x70\x3d\x58\xfd\x8a\x74\x3a\x01\xd8\x2a\xf1\x56\x9f\xf4\xed\x8c\x7a\xfe\x2b\x84\xa9\x36\x3d\x4d\xe0\xad\xa4\x9c\xd5\x5f\xb2\x40\x9b\xb4\xe8\xfd\x96\xdf\x6b\x90\x26\x69\xd1\x9b\x32\xbf\xd7\x20\x4d\xd2\xe2\xd1\xf5\xbe\xd1\x20\x4d\xd2\xe2\xd1\xf5\xfe\xc4\x94\x79\x5f\x98\x32\xef\x4f\x4c\x19\x20\x65\xc9\xcd\x76\x2b\x2f\x35\x81\xcb\x5f\x1c\xbe\xc8\x59\x3c\xd2\xdf\x68\x8e\x1e\x67\xf1\x38\x7f\xa3\x39\x7a\x9c\xc5\x03\xeb\x7d\x9f\x39\x7a\x9c\xc5\x03\xeb\xfd\x81\xc9\xf2\x56\x9f\x2c\xef\x0f\x4c\x16\xcf\xc9\xf2\x8c\x6e\x37\x5f\x2a\xd1\xf8\x7c\xdd\x8c\xbf\x6a\x9e\x26\x69\xd1\x48\x7f\xaf\x41\x9a\xa4\xfa\xce\x04\x7f\x62\x84\x02\x3d\x83\xa4\xce\x04\x7f\x62\x84\x02\xf1\xd7\xf8\xdf\x4b\x19\x18\xb9\x45\x83\xbd\x9a\x7d\xc5\x9c\x45\xc9\xf2\xad\xe6\xe8\x71\x6a\x7b\xf2\x5d\xb3\x57\xcc\xa9\xed\x49\xf0\x07\x46\xa7\x3c\x96\xa4\x3a\xc4\x7d\x00\xf4\x76\x21\x0e\xc5\xae\x75\xd3\xbc\xbd\xa0\xdf\x6d\x64\xdb\x93\xf0\xb6\x40\x19\x6c\x8d\xd7\xac\x50\x20\x3c\xd5\x58\x48\xf1\x43\xc6\xc7\x4e\xe7\x94\x0a\xcb\xf6\x7c\xe4\xc7\x47\x57\x52\xbd\x87\x25\xff\xb5\xf1\xeb\x9c\xe2\x7e\x92\xa2\x6f\x94\x52\x6d\x85\xbb\x4f\xda\x86\xb6\x94\xc2\xc4\x35\xf5\x97\x5e\x3a\xb4\x79\x2b\xbd\x70\xcf\xa5\x78\xba\xb9\x9d\x89\xd0\xcd\x7a\x4a\x9c\x7c\x57\x51\xf1\xeb\x80\xe1\xce\xbf\xd5\x34\xe8\xcf\x39\xf3\x04\xff\x3c\xb3\x9a\x2e\xc5\x8b\x08\xa8\x17\x39\xf7\x92\x97\xdc\xca\xd9\xb5\x67\xb1\xc0\x11\x7a\x97\x86\x6a\x4b\x66\xc6\x3c\x74\xac\x98\x62\xe9\x9b\x1e\x19\x5c\x7c\x08\x53\x02\x2d\x9e\xc4\xa2\x87\x3a\x37\xbe\xaa\xe4\x4b\xf3\xb8\xbc\x3a\xf9\x54\x32\x5f\x72\x4e\xe2\xe7\x78\x55\xec\xdf\x3f\x95\x99\x3b\xa3\xdd\xa1\x45\xe1\xf4\x35\x70\x4c\x82\x42\xbd\xba\xdf\xea\xd7\xe5\xd0\xe2\xd9\xb4\xfb\x8d\xba\x2d\x7b\xe0\xc2\x1b\x0c\x0c\xaf\xb9\xf1\x55\x3d\x5f\x9a\xd0\xe5\xd5\xc9\x27\x94\xf9\x92\x73\x32\xf1\x5e\xa9\xe0\x0f\xa4\x27\xf3\xc8\x9a\x5d\x6f\xd3\x4b\x24\x79\xe9\x14\x83\x53\x5c\xaf\xef\x37\x07\xa6\x04\x57\x32\x9b\x66\xc3\x68\xca\xd2\xd3\x6b\xf9\xde\xa0\xb1\xf1\x25\x25\x5f\x9a\xca\x25\x75\x29\xe6\x91\x7a\x91\x9f\x47\xe2\xb7\x9c\xfd\xfb\x27\x31\xf5\xc5\x9c\x7c\x3c\x5d\x53\x38\x7d\x4b\x0d\x93\xa0\xd0\x81\xeb\xf7\xfa\x7d\x39\xb4\x78\x2a\x9b\x6e\xdd\xb3\x7b\x12\x5f\x07\xfd\x41\xd3\xb7\x37\xbe\xaa\xe7\x4b\xb3\xb9\xbc\x3a\xf9\x84\x32\x5f\x72\x4e\x26\xde\x2b\x15\x7c\xff\x9c\xa6\x1e\xd9\xde\x83\x85\x28\x9c\xbe\xc5\x86\x0a\x50\x24\xc6\x79\xa6\x14\x59\x3c\xa3\x6e\xab\x56\xab\x59\xb2\x19\xb5\x3c\xdf\xf3\x37\xbe\xa8\xe6\x4b\x13\xba\xb4\x36\xc5\x7c\x52\x4f\x72\x2e\x26\xbe\xab\xf8\xbf\x7f\x3a\x53\x7f\xac\x6b\xe7\xa6\x8f\xfa\x91\xef\xce\x06\xe1\x5b\xbb\xe7\x0f\x26\x33\xbf\x9a\x6b\x77\x07\x31\x7e\x7e\xb1\x6b\xed\x3c\x4c\x46\xc8\x8b\x50\x30\x99\x85\x1f\x93\x71\xec\x46\x6b\x9e\xc7\x89\xc9\xfb\xa9\xf8\xfe\xe0\x66\x70\x4f\x1e\x7d\x77\xc3\xb1\x3f\x4b\xc5\xc4\x76\xa6\xcd\xbc\x1a\x1e\x5c\x66\xed\x68\x10\x2d\x42\x4e\x99\xbc\x9b\x09\xdb\x77\x8f\xc3\x1b\x34\x9b\xbc\x66\x02\x59\x53\xa6\x41\x38\xb3\x9c\x67\x17\xbb\xa8\xd0\x12\x27\x19\x32\xb2\x25\x44\x52\xcb\xd8\x91\x59\x9c\x45\x69\x13\x03\x89\x93\x9d\x61\x73\x3d\x44\x24\x7d\x96\x9e\x7c\x05\xdf\xa0\x67\x0a\xb4\x2b\x6b\x95\x7c\x28\x94\x4c\xb9\x62\x4a\x8b\xa7\x2e\x3f\x37\xea\x89\x58\x7e\xb4\xf3\x43\xa7\x1c\x27\xd2\xf1\x49\xc2\xbe\xdd\x9b\xc4\x01\xae\xa4\xfe\xe0\xda\x41\xc9\xf9\x24\xf4\xcd\x15\x6c\xb4\xc8\x27\xf6\x1c\x5a\x72\xbf\x94\x9c\xd7\x44\x5b\xb2\x63\x48\xd6\xbb\xe6\xce\xeb\xe1\x05\x9a\x2e\xa2\x28\xf9\x5d\x98\x9e\x99\x42\xfe\x5e\xfb\xff\xc2\xd1\x74\x32\x8b\x5d\x72\x08\x96\x7d\x77\x77\x7b\x94\x20\x31\x01\x05\xe2\x3f\x01\x6e\xd4\xf7\x1f\x4e\x50\x10\x7a\xf0\x68\x08\x81\x6b\xfb\xf9\x0c\x9f\xfc\x3c\x79\x4d\x41\xc4\x66\x88\xb2\x1e\x86\x8f\x77\x28\x1c\xbf\x84\xf3\x10\x3f\x33\x41\xfe\x37\x8c\xc2\xf8\x9d\x1e\xe1\x86\x47\xe1\xbd\x79\x3b\x42\xe4\x38\x03\xa2\x13\x3f\x6c\xd7\x36\xfe\x97\xb1\xe6\xd2\x07\xed\xf8\xc7\x07\x09\x8c\x3e\xd1\x28\x3f\xf1\x20\xff\xb0\x21\xf9\xc6\x6e\x3a\xa3\x51\x84\x9d\xf2\xfc\xb1\xda\x2d\xf4\xeb\xe3\xe8\x11\xb9\x83\x41\xf8\x96\x1d\xe2\x40\xce\xdc\xfc\xfd\xbf\xd1\x68\x8e\x5e\x42\xff\x15\x83\xe9\xd9\x35\x9e\xff\x12\xf6\xfd\xe4\x79\x14\x3c\xbe\x67\x8f\xa3\x37\x44\x7d\x25\x2f\x6e\x79\xd9\x1b\xf6\xfd\xb4\x85\xbc\x3c\xc6\x7e\xbe\x7a\x68\xa5\x4d\x23\xaf\xba\x1e\x3f\x35\x47\xb7\x69\x4b\x34\x54\x9b\xd7\x35\x77\x02\xf7\x29\x85\xbe\xcd\xe9\x5b\x51\xd6\xbb\xd6\xc9\x2f\x74\xc5\x77\x24\x47\x57\xe0\x92\x35\x7b\x7c\x7d\xc8\xf7\x64\x92\xfd\xfd\xe9\x63\xda\x3f\x1f\xa5\x1d\x66\xed\x74\x2b\xf3\x66\x3e\xe2\x28\x0f\xb7\xb6\xfd\x7c\x4f\x26\xd9\x6b\x18\x27\x69\xff\xc8\x4b\x3b\xac\xbd\x93\xd9\x1d\xdf\x91\x51\x1e\x6f\x9f\x48\x7a\x98\xe4\xfb\xc7\xf6\xb0\x93\x76\x47\xc3\x94\xd1\xfc\xe9\x39\x16\xdf\x91\x32\x5a\xc1\xf6\xe8\x34\xdf\x23\xe4\x99\x38\xc4\xe9\x49\x32\xe9\x59\x81\x8d\x7a\x83\x9e\xea\x23\xcc\xae\x3a\xee\x49\xa9\x2b\x10\x20\xfd\x40\x60\x56\x86\xc6\x57\x15\x20\x11\xe4\x25\xaa\xb1\x57\x4a\xd3\xf7\xa3\x88\xe7\x29\x72\x58\x1e\x6c\x6a\xb7\x8b\xb8\x14\xf1\x99\x92\x25\x93\xa3\x6d\x59\x51\x4c\x0b\x9c\xa8\xc0\x4e\xe1\xb8\xa0\x35\x77\xec\xf1\xfa\x5a\x2d\x93\xe8\x13\xb3\x58\x3d\x04\x64\x66\xd5\x78\xd9\xc4\x17\x83\x25\xf3\x2e\x0a\xe0\x69\x2f\x21\x51\xcf\xba\xe6\x08\x28\xaa\x84\x7a\x20\x96\xd7\xa0\x28\x37\x5a\x11\xa2\xad\xa3\xa8\x70\xad\x12\x37\xad\x96\x25\xd1\x67\x9a\xad\x16\xad\x19\x42\xb1\x57\x0f\x18\xab\x19\x2a\x01\x79\xcd\x28\x44\x4b\x6b\x86\x20\x81\x83\xa7\x8c\x46\x23\x7a\xca\xc6\x41\x7e\x45\x50\x8f\xc6\x0a\x2a\x14\xd7\x96\xe5\xe2\xa7\xd4\x8f\xa2\xcb\xd4\x2a\x01\x64\x5a\x86\x41\xa8\x95\x8b\x80\x1c\x0b\x99\x14\xf5\xa2\x41\x16\x28\xc5\x60\x49\x9c\x88\x02\x38\x4c\x4a\x48\x34\xa2\x24\x75\x56\x7e\x39\x57\xbb\x5c\xc4\xa5\x5a\x02\x2c\x37\xf1\x19\x9d\xde\xba\xa1\x7c\x8a\x25\x57\xad\xe7\xe8\xe4\x17\x5d\x82\xf2\x57\xe6\xf1\xa4\xd4\xbc\xb2\xba\x76\xdd\x3f\x6b\x32\xe6\xf9\x68\x19\xe6\x92\x88\xbf\x3a\xec\x3c\xed\x32\xe6\x91\xb7\x0c\x33\x37\xa4\xaf\x77\xee\x0e\x23\x89\x86\x6a\x92\xf5\xae\xfd\x73\xe7\xb1\x97\x8e\xfe\x74\x16\x8e\x63\x25\x9c\xaa\x4c\x40\xc5\xa2\xca\x72\x5b\x20\x23\xaf\xb8\x65\x02\xd2\xa2\x9b\x17\xc2\x09\xa5\x41\x96\x4b\xaa\xf5\xae\x3d\xf4\x6e\xfb\x50\x48\x88\xcd\xb2\x71\x2a\x25\xc8\x47\xf5\x7a\xd7\x3a\xd8\xfe\x35\x10\xa4\x84\x44\x2b\xd1\xab\xc1\x20\x49\xd5\xf5\xae\x35\x79\xbf\x11\xed\x95\xe6\x64\x99\x7e\x5d\x1e\xbe\x11\xd8\x02\x09\xdf\x1e\xfb\xe6\x3e\x8b\x6a\x38\x79\xa2\x25\xbf\xff\xff\x6a\xa5\xf2\x9f\xbf\xab\xf1\x7a\x34\xe9\xbb\xd1\xfc\xdf\x9f\x63\xf7\x05\xdf\x71\xa8\xbc\x7d\x20\x77\x0b\xdf\x60\xe9\xb9\xb3\x4a\x15\xbf\xd9\xad\x92\x9d\xe7\x5f\xa9\x92\x57\x00\xb5\x2b\xdc\xfb\x80\x2a\x55\x7c\xb6\xcd\x62\xda\xae\xf0\x6f\x10\xaa\x54\x23\xb7\xe7\x47\xed\x4a\xd7\x1e\x3e\xed\x5f\x22\xf2\xa9\x52\x25\x21\xdc\xae\x70\x7b\x9d\x2b\xd5\x0a\xdc\x0b\x51\x69\x57\xa4\x7b\x24\xa8\xf0\x16\xfd\xa8\x44\x55\xc2\xd1\x90\xdb\x0d\x81\xe9\xac\x8f\x8b\x78\x8e\x84\xf6\x6a\x38\x1a\x5e\xa4\x1f\x95\xa8\x84\x0e\xff\x4e\x92\x98\x66\xd6\xcc\xd7\x5b\xc4\x37\x12\xa2\xe4\x6f\x79\x3f\xa1\x88\x83\xc5\xa8\x37\x76\xc3\x08\x93\xd8\xa3\xb3\x9b\x2d\x04\x9b\x31\xcd\x15\xfb\xa4\xc2\x10\xaa\x7e\x38\xeb\x47\x3e\xe6\x31\xa7\xfb\xfb\x23\xc4\xb5\x61\x92\x6d\xf2\xa7\xb4\xb7\x32\x9f\xa1\xc9\x38\x7a\xc7\xb2\xd6\xd4\x43\x0f\x88\x35\x54\xe7\xb3\xd3\x71\xf4\x9e\x6f\x67\x22\x88\x9c\xc9\x86\xc7\x9a\x08\x4f\x2e\x5e\x17\x28\xdf\x45\x69\xf6\x58\x43\x11\x34\x30\xdb\x95\xbd\xfe\xce\x56\x0b\x05\x66\xa5\x1a\x58\x18\x7b\x11\xef\x36\x51\x60\x55\xaa\x81\x8d\x3f\x1e\x7c\xb4\xee\x51\x60\x57\xaa\x41\x0d\x7f\x34\xae\x77\x0d\x14\xd4\x2a\xd5\xc0\xc1\x03\x14\x0e\x6b\x77\x28\x70\x2a\xd5\xa0\x8e\xbd\x3d\xbc\xed\x85\x28\xa8\x57\xaa\xe4\x64\xa2\x76\x85\x3f\x83\xa8\x52\x8d\xf0\x83\xae\x95\xad\xab\x49\x07\x3f\x31\xe7\x7a\x95\xea\xc8\x9d\x3d\x61\xd4\xf6\xf5\x20\x42\xf8\x03\x0e\x45\x7c\x37\x07\xdf\x7f\xc2\x4e\x9a\xde\x6e\xb0\x8f\xb2\xa6\x2a\xfe\xb3\x83\x6f\x74\x49\xfa\x12\x51\x72\x8f\x8b\xc8\xda\xe8\xa2\x83\xb8\x36\x22\x7c\x81\xbb\xa5\xbd\x89\x78\x72\xe7\x8d\xc8\x5b\xe7\x4f\x4f\x88\x6f\x24\x04\xdb\x04\x20\xef\x4f\x28\x92\x23\x6e\x93\x19\x3e\xdc\xfa\x35\x41\xa0\x95\x90\xfc\x4a\x20\x0a\x44\x42\x33\x9e\xbc\xce\xdc\x29\x61\xd9\x69\xda\x63\xc4\x37\x12\x92\x13\x02\x90\xf7\xd3\x61\x9c\xbc\xfa\xb3\xbe\x3b\x27\x91\x6a\x4f\x0f\x3b\xb7\x48\x68\x27\x44\x1d\xf6\x51\x89\x4a\xe8\x16\xd3\x29\x6d\x68\x57\x76\x0f\xf6\xbd\x3d\x24\x34\x13\xb6\x6b\xf6\x51\x05\xa2\x03\xed\x4e\xc3\xd8\x8d\xc2\x0f\x6c\xdc\xc9\xd1\xd9\xe4\x03\x89\xed\x84\x6e\x3b\xfd\xac\x84\x25\x84\xa3\x45\x4c\xea\xc3\x55\xe7\x38\xa4\xa0\xa4\x89\xd0\x1c\xe3\x3f\x65\x9d\x89\x30\xdd\x21\x50\x69\x57\x46\xe8\x26\x36\x11\x68\x24\x04\x67\x09\x42\x0e\x48\x48\xe8\x4f\xd2\x78\xb0\xcd\xe1\xaf\xf3\x1d\x04\x5a\x09\xcb\x65\x02\x51\x20\x12\x1a\xfc\x43\x33\xe6\xb0\xc7\x47\xfb\xbf\x50\xd6\x44\x08\x0e\xc7\x83\x89\xac\x2f\x11\xa5\x3f\x6f\x62\x69\xb3\xd3\x3c\xeb\x23\xd0\x4a\x08\x6e\x13\x88\x02\x91\xd0\x24\xbf\x93\x61\x16\xeb\xdc\x7f\x7e\x43\x7c\x23\x21\xd9\x21\x00\x79\x7f\xa5\x37\x4c\x07\xa6\x5d\xb9\xd9\x6f\xd6\x2f\x11\xd7\x54\xed\x0d\xd3\xa1\xcc\x77\x62\x61\x36\x1c\xed\xca\x5e\x60\xb4\x5c\xc4\x35\x55\x7b\xc3\x74\x04\xf3\x9d\x58\x38\x1d\xbc\x1d\xe4\x4c\x10\x6b\xa8\xf6\x86\x6c\xe0\x60\x3b\x16\x61\xce\x63\x77\xec\xfb\x93\x31\xe2\xda\xaa\xbd\x61\x36\x60\xf9\x5e\x2c\x4e\xfd\xc6\x03\x3a\xac\x37\x7e\xa2\xac\xa9\xda\x1b\xa6\x03\x95\xeb\xab\x4c\xdd\x21\x3e\xbd\xd2\xf5\x12\x61\xdb\x7d\xef\x04\x88\x6f\xac\xe2\x0f\x07\xe4\x6f\x79\x7f\x85\x9c\x8f\xb4\x18\x93\xf7\xeb\xe0\xd0\xef\x5a\x71\xaf\x7e\x81\x60\x73\x15\x7f\xbc\xa6\x9f\x54\x98\x84\x2a\x59\xe5\x10\x22\xf7\x2c\x5c\x20\xbe\x91\xd0\x1c\x12\x80\xbc\xbf\x02\x7e\x9c\x21\x24\xf9\xdf\x6c\x2a\x55\x2f\x3a\x48\x3f\xa9\x30\xe1\x38\x8c\x43\x37\x0a\xe7\x23\xec\x78\xeb\xd7\xd3\x0e\xca\x9a\xf0\xa8\xe3\x65\xd8\xf3\x62\x12\xe3\x6d\xaf\x2f\xfe\x8c\x16\xb8\xd3\xa7\xc3\x0e\x92\xf4\x55\xb3\xb6\x8b\xa4\xa9\x10\x5d\xc9\x7e\x37\xc1\xb4\xe2\x6f\x29\x95\x2a\xfe\x3b\xbd\x8e\xe4\x7a\xb3\x5f\xa1\x2a\xc2\xcf\x52\x95\x6a\x25\xfd\x3b\xf9\x15\x91\xf0\xcb\x7e\xa3\xe2\x68\xf6\xf0\x67\x35\x0e\xff\x7c\x55\xc9\x7e\xca\xaa\x54\x2b\xb3\xc9\x2b\x1a\x4f\xd0\x70\x11\xc7\xfe\x0c\xa7\x51\xd7\x46\xbb\x6f\x77\x48\x68\xaf\xce\x26\xaf\x27\x93\xfd\x04\xa5\x04\x55\xfa\x93\x08\xdf\x4d\x35\x31\x4f\xed\xd0\xbc\x27\xbf\xa9\x24\x2d\xd5\xfe\x24\xba\x9b\x9b\x92\x0e\x22\x35\x1f\x11\xa9\x87\xc7\x23\xd7\x46\x69\x03\x16\xba\x1c\x99\xf9\x76\x22\x33\xf2\x88\x4c\xd7\x7a\x3e\xbf\xeb\xa3\xb4\x05\x0b\x1d\x7b\xa6\xa4\x83\x48\x45\x43\x2a\x65\xc4\x94\x92\xb4\x60\xa9\xce\xd0\x94\x74\x30\xaf\x2c\x22\xb5\x33\x9e\xbe\x33\xe3\x2d\xea\x95\x25\xe9\x60\x5e\x11\x29\x33\xb4\x16\x43\x66\xbe\x45\xdd\xb2\x24\x1d\xcc\x2f\x22\x65\xc5\x76\xe3\x96\x99\x6f\x51\xbf\x2c\x49\x07\xf3\x2b\x91\x3a\x88\xec\x77\x66\x7e\x22\xd5\x19\x5a\x92\x0e\xe6\x97\x5d\x69\x57\x4e\xef\xa6\xcd\x2e\xb3\xde\xa6\x6e\xd9\xf9\x76\xe6\x15\x96\xe9\x5a\xdb\x63\x67\x8f\x19\x6f\x53\xaf\x6c\x49\x07\xf3\x0a\x4b\xfd\xec\xd9\xfe\x01\xb3\xdd\xa6\x4e\xd9\xf9\x76\xe6\x93\x4d\x7c\xaa\xef\xcf\xef\x99\x4f\x36\xf5\xc9\x96\x74\x30\x9f\x6a\x44\xea\xe2\x67\xfc\xc1\x8c\xaf\x51\xa7\x6a\x92\x0e\xe6\x15\x96\x1a\xb8\xc6\xe1\x8c\xd9\x5e\xa3\x4e\xd5\xf2\xed\xcc\x27\x2c\x13\x4c\x8d\xdd\x05\xb3\xbd\x46\x7d\xaa\xe5\xdb\x99\x4f\x58\xa6\x6b\x36\x5e\x5a\xcf\xcc\xf4\x1a\xf5\xa9\x26\xe9\x60\x3e\x39\x58\xca\xba\xb7\x5f\x2f\x98\xe9\x0e\xf5\xc9\x91\x74\x30\x9f\x88\x94\x1d\x74\xb7\xf6\x99\xf1\x0e\x75\xca\x91\x74\x30\xaf\xb0\xd4\x1c\x1d\x3f\x59\xcc\x7a\x87\x7a\xe5\xe4\xdb\x99\x57\x58\xa6\x6b\x4f\x27\x57\x16\x33\xde\xa1\x5e\x39\x92\x0e\xe6\x55\x1d\x4b\x59\x9d\xce\x20\x64\xc6\xd7\xa9\x57\x75\x49\x07\xf3\x2a\x91\x5a\xbc\xcc\x2e\x89\xd4\x7c\x44\xa5\x2e\x47\x75\x49\x07\xf3\x8a\x48\xd9\xee\x6d\xcf\x61\xe6\xd7\xa9\x5b\x75\x49\x07\xf3\x8b\x48\x99\x4f\x47\x71\x9d\x99\x5f\xa7\x7e\xd5\x25\x1d\xcc\xaf\x06\x91\x8a\xee\xcd\x1b\x66\x7e\x83\xfa\xd5\x90\x74\x30\xbf\x88\x94\x7d\xfc\x30\x48\x8b\x42\x83\xfa\xd5\x90\x74\x30\xbf\x12\xa9\xfe\xaf\x49\x9d\x99\xdf\xa0\x7e\x35\x24\x1d\xcc\xaf\x44\xea\xed\xe3\xc2\x60\xe6\x37\xa8\x5f\x0d\x49\x07\xf3\xab\x89\xa5\xac\x83\xb7\xbd\x37\x66\x7e\x93\xfa\xd5\x94\x74\x30\xbf\x12\xa9\x61\xe8\xed\x32\xf3\x9b\xd4\xaf\xa6\xa4\x83\xf9\x95\x48\x9d\x5d\xb8\x97\xcc\xfc\x26\xf5\xab\x29\xe9\x60\x7e\x61\xa9\xce\x2f\x84\xe6\xcc\xfa\x26\x75\xab\x99\x6f\x67\x5e\xb5\x88\xa6\xc9\xe9\xe0\x91\x19\xdf\xa2\x5e\xb5\x24\x1d\xcc\xab\x44\xaa\xbe\x75\x58\x63\xc6\xb7\xa8\x57\x2d\x49\x07\xf3\x2a\x91\xda\x09\xbc\x7d\x66\x7c\x8b\x7a\xd5\x92\x74\x30\xaf\x88\x94\xfd\x5c\x9f\x1d\x32\xf3\x5b\xd4\xad\x96\xa4\x83\xf9\x65\x1a\x95\x76\x65\x3a\x0a\xb7\xee\x98\xf9\xa6\x41\x1d\x33\x0d\x49\x0f\xf3\x8c\xc8\xcd\x9d\xa7\x64\xbb\x29\x6d\xa1\x57\x63\x43\xd2\xc3\x7c\x23\x72\x5d\x73\x72\xb2\x7d\xcc\x7c\xa0\x82\xc7\x9e\x69\xc8\xba\x98\x7f\x89\xa4\xb5\x73\x7f\xfb\xc0\xfc\xa0\x92\x9d\xa1\x69\xc8\xba\x52\x1f\xf1\xe5\xfc\xfd\x76\x1c\xa6\x85\xc2\x4c\x97\x1b\xa6\xa4\x27\xf5\x11\xcb\x45\xe1\xc9\x75\x7a\xa9\xa2\x72\x97\x23\xd3\x94\xf4\xa4\x3e\x62\xb9\xae\xb5\x3d\xba\xf6\x53\x47\xd2\x55\x87\x29\xeb\x4a\x7d\x24\x92\xe6\xf3\xe3\xe5\x4e\xea\x48\xba\xf2\x30\x65\x5d\xa9\x8f\x16\x96\xb4\xbc\x61\x1f\xa5\xae\xb0\xd5\x87\x69\xc9\xba\x52\x2f\x89\xa4\xfd\x1e\x6d\x3b\xa9\x33\x6c\x05\x62\x5a\xb2\xae\xd4\x4f\xba\xe0\xd9\xbe\xcd\xd6\x50\x16\xf3\xd3\x92\x75\xa5\x7e\x26\x92\x7b\x27\xb3\x71\xea\x8c\xc5\xfc\xb4\x64\x5d\xcc\x4f\xbc\x5a\xc6\x0d\xed\xca\xe2\xa9\xd9\x39\x47\x42\x33\xa6\xb8\x9b\x9f\x2d\xa2\xc8\xb4\x54\x10\x48\x85\x47\x7c\x7c\x7e\x86\xee\x90\xd0\xcc\x51\x99\x2a\x08\xa4\x22\x01\x6a\xdf\x5a\x07\x5d\x24\xb4\x73\x5c\x86\x12\x03\xc8\x48\x36\x9b\xcd\xe7\xc3\x00\xe0\x58\xd1\xc1\x54\x2d\x15\x02\x10\xe1\x62\xb7\xbd\x3b\x9c\x46\x00\xc5\x4a\x32\xe6\x69\x2a\x00\x80\x26\xb9\x16\x5c\xdf\x1c\x43\x6d\xec\x92\x85\x79\x1a\x2a\x04\x20\x4a\x2e\x96\x67\xbb\xc1\x0b\x80\xb1\x6b\x3a\x26\xaa\xab\x10\x80\xc8\xa9\xb4\x2b\x37\x6f\x7b\x2f\xb7\x00\xc5\x56\x3c\x98\xc7\x51\x00\x00\x0d\x59\x6a\xd9\xb7\xcf\xd3\x18\xc0\xd8\x6a\x10\xf3\xd4\x54\x08\x40\x44\xd6\xa1\xa6\xf5\xe0\x7b\x08\x36\x67\x44\xb6\x0a\x01\x88\x70\x70\x77\xed\x29\x8a\x61\xe8\xf2\xc1\x6d\xa9\x10\x80\x08\x87\xf6\xc5\xce\xee\xb3\x10\xb7\x1c\x8f\xa9\x00\x00\x1a\x12\xd6\xd6\x9e\x79\x0b\xb5\xf1\x51\x6d\xa8\x10\x19\xd1\x3c\xa0\x59\x6f\xfe\x3c\x7b\x39\xcc\x70\x49\x3b\xa3\x9a\x07\x38\x6f\x15\x18\x48\x86\xbd\xeb\xda\xdb\x0d\x7b\x07\x02\xb3\xcc\x9d\x07\xa6\xa9\xc4\x40\x32\xec\x63\xe0\xd4\x0f\xde\x90\xd0\xcc\x71\x19\x2a\x08\xa0\x4a\x12\xf7\xac\x65\x9f\x01\x5c\x96\xb8\xf3\xa0\xa5\x42\x00\x22\x9c\xb8\x5d\xeb\xa4\xf7\xfa\x0a\x60\x59\xe6\xce\x83\xa6\x0a\x01\x88\x48\xea\x9a\x47\xb6\xff\x13\xc0\xb2\xd4\x9d\x07\x0d\x15\x02\x10\x91\xd4\xb5\xc6\x5b\xfd\x2b\x00\xcb\x52\x77\x1e\xd4\x55\x08\x40\x44\xbf\x08\xf8\x31\x1c\x81\x2c\x77\xe7\xc9\x0f\x02\x32\x04\x20\x22\xc9\x6b\x1d\x3e\x3f\x73\x39\x4e\x9a\x33\xa2\x9a\x0a\x01\x88\x70\xf2\x1e\x6f\xc7\x8b\x3a\x40\x65\xb9\x3b\x0f\x6c\x05\x00\xd0\x90\x00\xb7\xcf\x8e\xbd\x19\x08\x70\x3e\xbe\x2d\x15\x02\x10\x91\xe0\x36\x5f\xaf\x5b\x36\x0c\x6e\x8e\xc8\x54\x21\x00\x91\x41\x88\x46\xe7\xe7\x53\x40\xc4\x07\xb6\xa1\x42\x30\xa2\xc9\x60\x30\xf7\x63\x76\xd1\x6e\xed\x3e\x3c\xa2\x5c\x4f\x42\x77\x4a\x3e\x9b\x56\x01\x4e\x24\x25\x9e\x5a\xc1\x00\xcd\x45\xb0\x09\x49\xcd\x02\x9c\x48\x8a\xbd\x3e\xbb\x0e\xcf\x16\x22\xd6\x80\x9c\x86\x1a\x26\x50\xd2\x95\xfc\xed\xde\x05\x12\x3b\x78\xc6\x96\x1a\x25\x10\x92\xf4\x36\xfb\xdb\x07\x3e\x12\x3b\x78\xc2\xa6\x1a\x25\x10\x92\x34\xb7\xef\xae\xe2\x96\x00\x65\x89\x9e\x10\x36\xd4\x28\x81\x90\xa4\xbb\x3d\x5b\x3c\x9b\x02\x94\x25\x7c\x42\x58\x57\xa3\x04\x42\x92\xf6\xd6\xaf\x17\xef\x15\x89\x1d\x3c\xa1\xa3\x46\x09\x84\x24\xfd\xed\x4e\xdf\xdc\x41\x62\x07\x4f\x58\x53\xa3\x04\x42\x5c\x06\xba\xe6\xf1\xfe\x4d\x4f\x80\xb2\x4a\x90\x10\xda\x6a\x94\x40\x48\x52\xc6\xbe\xd9\xed\xdc\x20\xb1\x83\x27\xb4\xd4\x28\x81\x90\x7e\x41\xb8\x3a\x10\x87\x5b\xc8\x16\x35\x4a\x20\xc4\xa9\xd2\xb5\xa7\xb5\xd1\x19\x12\x3b\x78\x42\x43\x8d\x62\x5f\x24\xd8\x02\x1b\x4f\x5f\x34\x74\x4f\x90\xd0\x8e\xe9\x2e\x47\x6c\x6d\xae\xc0\x40\x32\xe2\x6d\x6b\x67\xd7\xbc\x80\x40\xf6\x35\x8c\xad\xce\x15\x18\x48\x86\x3d\x7d\xda\xfd\x78\x42\x10\xc7\xbe\xb6\xb2\xd5\xb9\x1c\x02\xa8\x70\x31\x38\x89\xad\x47\x0f\xc1\xd6\x8c\xa8\xa5\x00\x00\x1a\x52\x02\xec\xf9\xeb\xd9\x36\x80\xb1\x1b\x1f\x74\x6d\x2e\x47\x00\xa2\x24\xf5\x0f\x6e\xbc\x5b\x00\x63\x77\x86\xd2\xc5\xb9\x0c\x01\x88\x70\xca\xbf\x5e\xed\xee\xb9\x00\xc5\xee\x9c\xd1\xb5\xb9\x14\x00\x68\x70\xa2\x5f\x75\x9e\xb7\xdf\x01\x8a\xdd\x56\xa4\x4b\x73\x29\x00\xd0\x90\xf4\x36\x9d\xee\x2f\xe8\x3d\xbb\xe7\xca\x96\xe6\x52\x04\x20\xc2\x69\xfd\x74\xb4\x18\x1e\x01\x14\xbb\x21\x4d\x57\xe6\x52\x00\xa0\x21\xa1\x6d\x0e\x07\xaf\x0f\x20\xb4\xf9\xc8\xb6\x54\x08\x40\x84\xc3\xfa\xba\xb3\xbf\x75\x06\x50\x20\xa8\x15\x00\x40\x83\x03\xba\x6b\x35\xea\x26\x9c\x54\x3e\xa0\x0d\x15\x22\x23\x62\x0b\xf3\x97\x9b\xe7\xf9\x4b\x06\xa3\x6b\x6e\xca\x94\xac\xcb\xe5\x10\x48\x85\x7d\xeb\xda\xb3\xd9\xc7\x16\x04\x66\xde\xb1\x65\xb9\x14\x03\xc9\x88\x87\x66\x3d\x3c\xe0\xc6\x33\x5b\x97\x53\x32\x43\x89\x01\x64\x38\x69\xbb\xf6\xf0\xb4\xd6\x05\xb8\x2c\x6b\x93\x85\xb9\x14\x01\x88\x48\xda\x5a\xe1\x15\x5f\xbd\xd2\x85\x79\x42\xd4\x54\x21\x00\x11\x49\x5b\xd3\x39\x7e\xbe\xca\xd2\x36\x5d\x98\x27\x44\x0d\x15\x02\x10\xe1\xb4\xed\xda\xb7\x5b\xdb\x3b\x00\x96\xe5\x6d\xb2\x30\x97\x22\x00\x91\x43\x88\xde\xc3\xfa\x1b\x20\xca\x32\x97\x2e\xcc\x65\x08\x40\x84\x53\xf7\x20\xec\xc6\x7d\x80\xca\x32\x97\xac\xcb\xa5\x00\x40\x83\x13\xb7\x6b\x6f\xef\x58\xf7\x00\x96\x65\x2e\x59\x97\xcb\x11\x80\x08\x07\x78\xd7\x3a\x3a\xbb\x59\x00\x18\x1f\xe0\x96\x0a\x01\x88\x48\x78\x9b\x2f\xb3\xd8\x87\xe1\xcd\x11\x99\x2a\x04\x20\x22\xa1\x6d\x85\xfb\x97\x1d\x00\xe3\x23\xdb\x50\x21\x18\x11\x5c\x98\x0f\xb7\x4e\xf7\x50\xae\x27\xa1\xa3\xab\x02\xab\x00\x27\x92\x26\x9e\x8e\x9f\x9e\x11\xca\xf5\x00\x52\xb3\x00\x27\x92\x12\xaf\xed\x7e\xaf\x93\xb3\xc0\x80\xa4\x46\x01\x4e\x20\x25\x89\x6d\xde\x3f\x5f\x3f\x09\x58\x96\xda\xd9\xd2\x5c\x81\x12\x08\x71\x82\x47\x4e\xe7\xca\x12\x90\x2c\xc3\xd3\x95\xb9\x02\x24\xd0\x25\x69\xde\x8d\xde\x0c\x24\x76\xf0\x7c\x0d\x35\x4a\x20\xc4\xe9\x7e\x3e\x88\x2f\xde\x90\xd8\xce\xf3\xd5\x95\x20\x81\x8e\x24\xbd\xb9\x1d\xfe\xfc\x89\xc4\x0e\x9e\xcf\x51\xa3\x04\x42\x9c\xfc\x5d\xfb\xe8\xf4\xfd\x58\x80\xb2\xfc\xe7\x96\xe5\x72\x94\x40\x98\x94\x81\xbb\xe6\xe5\x25\x12\x3b\x78\x42\x5b\x8d\x12\x08\x93\x84\x59\xbc\xbd\x98\x42\xc2\xc0\x7c\xb1\xd4\x28\x81\x90\x24\x8b\xdd\xd9\xe9\xfc\x12\xa0\x42\xae\xa8\x51\x02\x21\x49\x14\xeb\x79\x37\x3e\x42\x62\x07\x4f\x68\xa8\x51\xec\x2e\x3d\x5b\x5a\xb7\x2b\xbd\xc7\xf3\xcb\x19\x12\x9a\x31\xdb\xb1\xc7\x56\xe5\x72\x08\xa4\x22\xbe\x5a\x17\xc6\xf0\x0a\x02\xd9\x4f\x1c\xe9\xa2\x5c\x8e\x81\x64\xc4\x4f\xf3\xfd\x75\xec\x20\xa1\x9d\x23\x33\x94\x18\x40\x86\x0b\xc1\xbd\x3d\x42\x8f\x00\xc6\x7e\x3b\xa3\xcb\x72\x29\x00\xd0\xe0\xf4\xef\x5a\x4f\xef\x4f\x2d\x00\x63\xbf\x2c\xb2\x65\xb9\x14\x01\x88\x70\xe2\x7b\xe3\xd9\xf1\x3e\x82\xad\x19\x4f\x43\x01\x00\x34\x38\xdd\xbb\xe6\xc7\xc9\xfd\x00\xc0\xd8\x2f\xd3\xe9\x1d\x73\x19\x02\x10\x25\x89\x1e\x0d\xdf\x0e\x10\x6c\xce\x88\x1c\x15\x02\x10\xe1\x04\x1f\x1c\x76\xd1\x25\x40\xb1\x7d\x0d\x74\x5d\x2e\x05\x00\x1a\x92\xd6\xe6\xfb\xb6\x09\x23\x8e\xed\xf9\x48\xef\x98\xcb\x10\x80\xc8\xc2\x44\xd6\x6c\x71\x64\x02\x22\x3e\xb8\x2d\x15\x02\x10\x91\xd0\xb6\xa7\x4f\x6f\xe7\x30\xb4\x39\x22\x53\x85\x00\x44\x24\xac\xad\x93\x9d\x41\x0c\xc2\x9a\x8f\x6a\x43\x85\xc8\x88\xd2\x7b\xe6\x96\xf7\x14\x9d\x66\xb8\x6c\x6d\x7e\xec\xa5\xf7\xcc\xe5\x18\x48\x46\xfc\xb3\x4e\xed\x2b\x6e\x62\xb2\xc5\x39\x25\x33\x95\x18\x48\x46\x7c\xb4\x0f\x1a\xf7\xb5\xcc\xc7\x6c\x71\x4e\xc9\x0c\x25\x06\x90\xe1\xd4\xed\x5a\x67\x87\x97\x06\xc0\x65\xb9\x9b\x2c\xce\xa5\x08\x40\x44\x92\xd7\x7c\x3e\x3f\x0f\xb3\xe4\x4d\x17\xe7\x09\x51\x53\x85\x00\x44\x38\x79\xef\x9b\xc7\x17\x5c\x01\x4b\xd7\xe6\x09\x4f\x43\x01\x00\x34\x24\x79\xed\xee\xd5\xed\x39\x80\x65\xc9\x4b\x97\xe6\x32\x04\x20\x72\xe8\x45\x75\xc6\x15\xaf\x74\x69\x9e\x10\x39\x2a\x04\x20\xc2\xc9\xdb\xb5\xce\x16\x97\x4d\x00\xcb\xb2\x97\xde\x33\x97\x21\x00\x11\x49\x5f\xeb\xe3\x10\x75\xb3\xf4\x4d\x17\xe7\x09\x91\xad\x42\x00\x22\x12\xe2\xf6\xce\x2c\x84\x86\xf3\x11\x6e\xa9\x10\x80\x88\x84\xb7\x79\xdc\x40\x42\xb4\x71\x44\xa6\x0a\x01\x88\x48\x68\x9b\x4f\x67\xee\x2b\x08\x6d\x3e\xb2\x0d\x15\x82\x11\xd1\x2b\xb1\x49\x7f\x13\x78\x1d\xec\xa3\x5c\x4f\x42\x47\xd7\x06\x56\x01\x4e\x24\xc5\x9e\xce\x0f\x7a\xe1\x2b\xca\x75\x00\x4e\x53\x0d\x13\x29\x89\xcf\xf6\xd0\x7f\x36\x45\xb0\x01\x39\x8d\x02\x9c\x40\x4a\xd2\xda\x7e\x9f\xfe\xea\x23\xb1\x83\xa7\x6c\xa9\x51\x02\x21\x4e\xef\x77\x6f\x67\x71\x89\xc4\x76\x9e\xaf\xa9\x04\x09\x74\x38\xc9\xbb\xe6\xf1\xa5\xbd\x25\x40\x59\x9e\x73\x4b\x73\x39\x4a\x20\x24\xe9\x6e\xfe\x6c\x1a\x01\x12\x3b\x78\xc2\xba\x1a\x25\x10\x26\x69\x3f\xb4\xa7\x5d\x24\x76\xf0\x84\x8e\x1a\x25\x10\x26\xe9\xbf\xf7\x78\x2e\x0e\x36\x2b\x00\xd9\xe2\x5c\x81\x12\x08\x71\x19\xb8\xf3\x7b\x1f\x63\x01\xc9\xea\x40\xba\x36\x57\x80\x04\x3a\x9c\x2e\x77\xaf\xad\xd9\x48\x40\xc2\x64\xb1\x94\x20\x81\x0e\x27\xca\xf6\xd5\xe4\xc0\x13\x13\x05\xd0\x99\x4a\x90\x40\x87\x93\x64\x72\xb7\xdb\xef\x08\x48\x98\x22\x86\x12\xc4\x36\xc0\xb0\xa5\x75\xbb\xd2\xb5\xad\xee\xab\x8f\x84\x76\xcc\xd6\x19\xa6\xf7\xca\xe5\x18\x48\x86\x3d\xed\x5a\x35\x7f\xf7\x15\x02\xd9\xfe\xa1\x6c\x59\x2e\xc5\x40\x32\x83\x90\xb5\x9e\xdd\x67\x24\xb4\x73\x64\x86\x12\x03\xc8\x48\x11\x30\x2f\x1b\xf7\x13\x80\x63\xbb\xd3\xe8\xba\x5c\x8e\x00\x44\x38\xf9\xbb\xd6\xf6\xf0\x74\x0b\xc0\xd8\xee\xbd\x74\x61\x2e\x43\x00\x22\x92\xf6\xf6\xaf\x17\xa3\x03\x60\x6c\x77\x63\x7a\xbf\x5c\x86\x00\x44\x49\xba\x4f\x0f\x8e\x3f\x00\x8c\x6d\xff\x4c\x97\xe6\x32\x04\x20\x22\x69\x6e\x7d\xbc\xcc\xdf\x11\x6c\xce\x88\x1c\x15\x02\x10\xe1\xf4\x9e\xef\xcd\x47\xf7\x08\xb6\x66\x3c\x35\x05\x00\xd0\xe0\xa4\xbe\x0e\xed\x43\x17\xa0\xd8\xc6\x6a\xba\x32\x97\x02\x00\x0d\x0e\xf0\xdb\xd1\xf0\xee\x05\xa0\xf8\xf0\xb6\x14\x00\x40\x93\x84\xf6\xd1\xf1\xee\x08\x86\x36\xc7\x63\xaa\x10\x80\x08\x87\xf5\xf9\xed\xc5\x55\x1f\xa0\xf8\xa0\x36\x14\x80\x8c\x26\x5b\x94\xef\x9d\x1e\xdf\x66\xb8\x6c\x51\xde\x19\x66\x8b\x72\x29\x06\x92\x61\xef\x0e\xbd\xdb\x8f\x19\xc4\x65\x79\x9b\xac\xc9\xe5\x10\x48\x85\xfd\xeb\xda\xcf\xaf\x76\x0f\x02\x33\x0f\xd9\x92\x5c\x8a\x01\x64\x24\x6d\xed\xce\xdd\xa3\x81\x60\x73\x46\xd5\x52\x21\x00\x11\x49\x5b\xb3\xfe\x1c\xde\x64\x69\x9b\x2e\xc9\x13\xa2\xa6\x0a\x01\x88\x48\xda\x5a\xa7\xe7\x4f\x75\x04\x9b\x33\xa2\x86\x0a\x01\x88\x48\xda\xda\x13\x73\xce\xd5\xd4\x74\x51\x9e\x10\xd5\x55\x08\x40\x94\xa4\xad\x37\x7a\x3f\xca\xd2\x36\x5d\x94\x27\x44\x8e\x0a\x01\x88\x70\xda\x76\xed\xe9\x6c\xd1\x02\xb0\x2c\x6f\x93\x45\xb9\x14\x01\x88\x70\xe2\x76\xad\x68\xeb\x9a\xcb\xef\x74\x51\x9e\x10\xd9\x2a\x04\x20\x22\x21\x6e\xef\x2d\x76\x60\xf8\xf2\x11\x6e\xa9\x10\x80\x08\x87\x77\xd7\xba\xbf\x3d\x40\x00\x06\xc2\x5b\x85\x00\x44\x24\xb8\xcd\xf0\xe6\x84\xbb\x78\xa5\x8b\xf2\x84\xc8\x50\x21\x18\x11\xbd\x12\x93\x45\xf9\xe2\xfc\xe2\x7e\x07\xe5\x3a\x12\xb3\xe8\xba\xc0\x52\xc3\x44\x4a\xe2\xa7\x19\xdf\xbe\xf5\x50\xae\x07\x70\x9a\x05\x38\x91\x34\xf1\xd9\x38\x34\x67\x22\xd8\x80\xa4\x46\x01\x4e\x20\x4d\x12\xfb\xe3\xee\x42\x34\x80\xa5\x36\xb7\x28\x97\xa3\x04\x42\x92\xe0\xf6\xc1\xc9\xe8\x0d\x89\x1d\x3c\x61\x53\x8d\x12\x08\x49\xa2\xdb\xa3\xc3\xa7\x31\x12\x3b\x78\xc2\x86\x1a\x25\x10\x92\x84\xb7\xac\xcb\xeb\x2d\x24\x76\xf0\x84\x75\x35\x4a\x20\xa4\xf7\xcc\x2f\x6a\x7d\x24\x76\xf0\x84\x8e\x1a\x25\x10\x92\x02\x60\xfe\xbc\x7d\x8a\x91\xd8\xc1\x13\xd6\xd4\x28\x81\x30\x29\x04\x5b\x1f\x1f\x3f\x91\xd8\xc1\x13\xda\x6a\x94\x40\x88\x53\xa6\x6b\xef\x0e\xdf\x42\x01\x0a\x53\xc6\x52\xa3\x04\x42\x92\x30\xf6\xf9\xc1\xe3\xbe\x98\x30\x80\xd0\x54\xa3\x04\x42\x92\x2c\xf6\xe3\xd5\x93\x38\xdc\x30\x57\x0c\x35\x8a\x1e\x5d\xd1\x9f\x8c\x3d\x7f\x3c\xa7\x07\x44\x44\x0f\x68\x1f\x89\x1d\xc9\xe1\x15\xdb\xec\xb3\x1a\x47\x29\xe7\xf1\x2c\x9c\x26\x84\xd6\xfb\x7c\xef\x14\xc1\xe6\x84\xee\x32\xf9\xa4\xc2\x50\xaa\x60\xf2\xe2\xcf\x2a\xed\x4a\xd7\x3e\xf7\x9c\x87\xff\xc3\xdd\xfb\xa8\xb7\x6d\x63\xfb\xa2\xaf\x22\xb1\x1d\x6e\x20\x82\x18\xfe\x11\x25\x91\x0a\xa2\x93\x38\x76\xa7\x33\x69\xe3\x69\xd2\x76\x3a\x8a\xc6\x1f\x45\x82\x36\x1b\x99\x54\x49\xca\x8e\x6b\xe9\xbc\xcf\x79\x8d\x7b\x5f\xec\x7e\x0b\x04\x40\x52\xa2\x9d\x74\x77\x67\xef\xf3\xdd\x6a\x26\x96\xf0\x1f\xf8\x2d\x2c\x2c\x2c\x2c\x2c\x88\x44\x55\x60\x55\xcc\x5f\xe1\x3b\x74\xf2\x38\x3e\x08\x4b\xe1\x1c\xa3\xbc\xf9\xe5\x97\x61\xf5\x53\x23\xe2\xd2\x33\x54\xfc\xe6\xf2\x1b\xbb\xbe\x04\x0d\xf7\x9a\x21\xf4\xdb\xf2\x5b\x57\x5c\x72\x16\xd7\x95\x7d\xed\xef\xf9\x0f\xdf\x4d\xeb\xdb\xcb\x91\xb8\x9c\x7c\x72\x7b\x72\xf9\x7d\x7d\x31\x59\xf8\xac\xa9\x3d\x70\xf8\xda\x03\xef\x9c\x88\xd6\xb7\x7c\x78\x3c\x94\x92\x3b\xaf\x80\x86\xb1\xd7\x27\xaf\x2a\x27\x14\x1a\x89\x92\x02\x8a\x8c\x20\xfc\xc7\xf3\xeb\x70\x28\x03\xe0\x92\x6f\x99\xca\x37\x18\x00\x83\xc9\x70\xc4\xa2\x61\x33\x10\x5c\xa3\xbc\xaa\xbe\x77\xc7\x67\x1b\x96\xfa\x5a\xb8\xbe\x48\x3f\x0c\xe1\x3b\xdc\x51\xce\xb3\x4d\x94\xdd\x82\x7f\xd7\xcb\x4b\xe1\x48\xe3\xef\xe9\x2f\xa7\xc3\xc3\x08\x22\x03\xde\xf1\xdf\x0f\xa7\xe3\x9e\x95\x7d\xad\xe9\x67\x59\x34\x5e\x5e\x6a\x87\xc8\x9f\x56\xe7\xaf\x87\xcd\x40\x68\xbc\xba\x07\xdf\x15\xcf\x8b\x90\xa8\x42\x11\xe7\xe6\x87\x72\xd8\x0c\x84\x22\x1a\xfe\x04\x8e\xe3\x79\x11\xf2\x42\xbc\xed\xde\xdd\xda\x43\x15\x02\x99\xc5\x95\xf8\xc3\x18\x9e\x4d\x12\x89\xaf\x0d\xcb\xcb\xb3\x37\xc3\x66\x18\xe4\x55\xb7\xe2\x3b\xa2\x79\x01\x82\x9c\xa0\xe5\xf1\x9b\x9f\x57\xc3\x46\x18\xe4\xaf\x2f\xc6\x1f\xc5\xf2\xec\xeb\x24\xfd\xc0\x9b\xfd\xc1\xbd\xf9\x71\xa8\x42\x20\xeb\xeb\x24\xfd\xd0\x11\x53\x65\x83\x16\x5f\x8c\x7e\x99\xfe\xf6\xed\x50\xfc\xe6\x59\x2e\x8f\x42\xb5\xda\x99\x6f\x95\xc9\xf6\x4e\xee\xde\x36\x5c\xfc\x8a\xac\xdf\xc0\x8f\xd7\x97\x0f\x24\xe0\xa5\x14\xd7\x90\xdf\x31\xdf\x24\x9b\xa1\xf8\x0d\x95\xbe\xbd\x3e\x0a\x6d\x54\x5a\x65\xb2\xd9\xe9\x9b\x93\x46\x99\x22\x2b\xaf\xf4\xed\xf5\x03\x09\x78\x29\x1f\x39\x55\xd8\x1f\x4e\x6e\x4e\x87\xe2\x37\x54\xfa\xcf\xe2\x28\xb4\x51\x69\x95\xc9\x7a\x61\xbf\xfb\xd8\x28\x53\x64\xe5\x95\xfe\xb3\x78\x20\x01\x2f\x85\x5f\xa4\x87\x22\x9c\xeb\x24\xac\x30\xad\x82\xa0\xea\x97\xf0\xad\x2b\xae\x6e\x00\x64\x3d\xf0\x97\x5c\x57\xdd\x15\xa7\x1d\xbb\x5c\xd6\x7c\xed\x21\x6f\xcc\x75\x59\x3f\x89\x90\xc7\xd2\x6a\x0d\x3f\xcf\xd0\xb0\x23\xe7\xcf\xbc\xb8\x77\xc2\x11\x74\x67\xbc\x96\xa4\x9b\xad\x78\x52\x48\x58\x51\x6e\xfe\x35\x6c\x06\x12\xfe\x43\xf5\xef\x38\xbe\xd1\xc3\xca\x01\x4c\xc2\xd7\x9c\xcb\xdf\xbf\xf3\xc2\x61\x57\x9c\xea\xe3\xdf\x64\xd0\xa3\xa9\x6b\x96\x77\xcd\xd2\x2d\x34\xd2\x0d\xbe\xf1\x6e\x6b\x46\xc6\x83\x15\xbb\xfb\x8e\xa5\xdb\x87\xd2\x80\x2f\x6b\xb8\xc0\x24\xfc\x5a\x6b\x44\x4b\x83\x9b\x61\x94\xdc\x24\xd2\x87\xc5\xaf\xb7\x57\x6c\xd8\x0c\x24\x69\x70\xf3\xaa\xfa\xde\x1d\xcf\x8b\x28\x83\x15\x27\x4c\x3b\x4c\x7e\x39\x1f\xaa\x10\xc8\xfc\x2e\x58\x15\x1d\x31\x3c\x5b\xdd\x4b\x28\x3b\x7d\xfd\xb7\x17\xc3\x76\x30\x14\xd0\x18\xa5\xee\x34\xb2\x93\x50\x46\xf6\xcd\x4f\xaf\x54\xaf\x45\x2d\x9b\x64\xbd\xe6\xad\x73\x12\xf7\xc3\xcf\xc3\x3a\x08\x4a\x3f\x87\x6f\x5d\x71\x3c\x6b\x51\x06\xe1\x07\xd1\xbc\x4d\xfc\xd3\xbf\x86\xcd\x40\xc8\xfe\xb6\xfa\xde\x1d\xaf\x86\xa6\xd1\x5a\x20\xd3\x69\xf1\x8f\xd7\xc3\x8e\x38\x39\x5c\xcd\x1e\x3f\x9c\x18\x44\x93\xa1\x70\xbd\xae\xf9\xda\xd9\xe5\xea\xfc\xd5\xb0\x19\x06\x6b\xfb\x89\x74\xcd\xde\x11\xcd\x0b\x00\x67\xe6\xd0\x3d\xfb\x37\xeb\xa7\xb3\xa1\x0a\x81\xbc\xe7\x41\xca\x3a\x62\x36\xc1\x65\x92\x06\xdc\x89\xb6\x96\x5a\xee\x77\xe9\xb0\x0e\xd1\x88\x56\xff\x00\xde\xed\x6b\xaf\xdd\x6f\x47\x97\x8d\x24\x10\xda\x28\xe3\xf5\xe5\x03\x49\x9a\x05\x09\xfe\x9c\xff\x62\xff\xd6\x4c\x06\xbc\xb6\xfe\xc9\xb9\xed\x03\x69\x52\x70\xa3\xd6\x78\x2e\x8d\xb7\x53\x7a\x71\x87\xb2\x0f\x3d\xbb\x8b\x4c\x2f\xe1\x55\xf2\xae\x58\xad\xf5\x4a\x03\x94\xd0\xf1\x78\x83\x28\x04\x3c\xc0\xf0\x95\xb6\x3b\x8d\x28\xaa\x4c\x4a\xe1\x90\xcc\xb2\xff\xf1\x0f\x91\xa8\x0a\xac\x8a\x79\x07\xdf\xbb\xe3\x45\x11\xd5\x9b\x0e\xbc\x8c\xa3\x97\x1e\x44\x21\x67\xfc\xc7\x03\x29\xb4\xfa\x79\x35\x5e\xc8\xc1\x93\x6b\x95\x07\x19\xc9\x09\x0f\x1f\x64\x53\xad\x90\x8f\x48\xf0\x22\x3a\x1e\x97\x10\x2d\x39\x11\x3f\x1f\x4c\xd5\x68\xcd\x30\x29\x19\x90\x40\x7e\xf5\xaf\xd7\xdf\x37\xea\xac\xc2\xeb\x66\x7d\x5b\xb2\xeb\x07\x53\x89\xe6\xd5\xac\xfe\xf0\xb9\x37\xd1\x30\xc5\xea\x8f\xe3\x45\x11\x52\x3e\x85\x42\x8e\xdf\x1b\x13\xc5\xd4\x82\x6d\x67\x1a\x51\x94\x94\x16\x7d\xad\xeb\xbd\x2b\x51\x54\x43\xcc\xec\x4a\x23\x8a\x92\x52\xa3\xaf\x75\x3d\xb3\x24\x8a\x6a\x88\x9b\x5d\x69\x44\x51\x4a\xe4\x3c\x78\xe6\x47\x14\x22\xc5\xce\xa3\x58\x91\x5d\xca\x91\xbe\xd6\xf5\xae\x8c\x28\xa4\xf6\xca\xd4\x99\x46\x14\x25\x44\x4a\x5f\xeb\x78\xd1\x44\x14\xa4\x04\xd1\xae\x14\xea\x19\x0a\xed\xe0\x5d\x0a\xd0\xa8\x34\xde\x68\x80\xde\x1e\xbf\xdc\xa0\x91\xea\x97\x14\x8c\xba\x52\x68\xea\xe9\x05\x28\xe3\xe0\x35\x86\xca\xd5\x90\xf0\x77\x77\x14\x07\x2f\x23\xf8\x5a\xfd\x32\x83\x46\xe0\xe9\x05\x5f\x6b\xbc\xc3\x00\xdb\x41\xe1\xdd\xd2\xd7\x0e\x5f\x5e\x90\xde\xbe\x78\x5e\x5f\x3b\x7c\x74\xa1\xf2\xf4\xf5\x57\x5e\xc9\x71\x5c\xe5\xe3\xd2\xd7\x5a\x8f\x28\x68\x84\x3f\x93\xe0\x6b\x8d\x37\x13\x34\xa2\x89\xfa\x40\x8c\xf4\xb5\x23\x6f\xf4\x1a\x11\xdf\xff\x59\x74\xc6\xaa\xec\x9c\x8d\x1f\x7a\x35\x57\xb9\xdf\x5e\x77\x45\xaa\xcc\xd7\xc2\xab\x65\xdb\xab\xb5\xca\xfd\x5d\xd4\x19\xab\xb2\xf3\xb5\xe8\xd0\xdb\xb1\xca\xfd\xfa\xb2\x2b\x52\x3b\x74\x93\x0f\x2d\xe8\x76\xa0\xaf\x4a\xfa\x67\xa1\x88\xe5\x81\x84\xcd\x52\x85\xeb\x2d\x5f\x7b\xc8\x97\x7e\xa3\xdc\xda\x75\xd7\x43\x49\x8f\x4b\x16\x75\xfa\xda\xe3\xde\xf5\x8f\x6a\x51\x7d\x78\x3c\x97\x76\xe8\x3b\x1e\x06\xa8\xdb\xab\xbc\xaa\xe2\xed\xb5\x2c\xfc\xa1\x84\xcd\x52\x45\xd7\x7c\xed\x21\x57\xf2\x8d\x72\xd5\x00\x3d\x98\xf4\xb8\x64\x51\x27\x64\x7a\xcc\x8d\xfc\x51\x2d\x6a\x80\x1e\xcf\xa5\x1d\x7a\x47\x87\x01\xea\xf6\x9b\xae\xaa\xf8\x2e\x52\x85\x3f\x90\xb0\x59\xaa\xe8\x9a\xaf\x3d\xe4\x2b\xbd\x51\x6e\x4d\x41\x0f\x25\x3d\x2e\x59\xd4\xe9\x6b\x8f\xbb\x49\x3f\xaa\x45\xf5\xe1\xf1\x5c\xda\xa1\xbf\x70\x30\x36\xe9\x74\x24\xae\x6a\x78\x7d\x29\xca\x7e\x28\x5d\xb3\x4c\xd1\x31\x5f\x7b\xc8\xa3\x78\xa3\xd8\x9a\x7e\x1e\x4a\x7a\x5c\xb2\xa8\xd3\xd7\x1e\x77\x31\x7e\x54\x8b\x1c\x9e\x4f\xe4\xd2\x84\x27\x62\xb1\xed\x6f\xfb\x1a\x97\x3c\x9c\xb3\xdd\xa3\x38\x99\x95\x33\xdd\x43\x67\xe2\x32\xeb\xdb\xeb\xae\x38\x99\x95\xb3\xdc\x03\x67\xe1\x32\xe7\x77\x51\x47\x94\xcc\x28\xf4\x31\x6d\x0f\xe1\x32\xe7\xeb\xcb\xae\x38\x35\xb4\xdc\xe9\x32\x64\xef\x70\xa4\xad\x86\xf1\x1c\x12\x3d\x94\xa6\x5d\x94\x18\x4a\x5f\x7b\xd8\x47\x76\xbb\x58\x09\xcd\x23\xc9\x0f\x6a\x10\xe4\xe1\x6b\x8f\xb8\xc3\x6e\xd7\x51\xcf\xc4\x47\x32\x74\xd6\x22\x9a\xe0\x6b\x9f\x74\x7d\xdd\x55\xa3\x22\xbb\x4f\xe6\x95\x50\x4a\x38\x8e\x1d\x63\x4b\x3c\x05\x16\x1d\x09\xf6\x7b\x12\x6f\xd3\x10\x36\x67\x88\x91\x92\xa4\xf8\xbe\xaf\x02\x4a\x92\xe3\x7b\x66\xb0\x8f\xe0\x4f\xbb\xa0\x39\x4a\x91\x89\xf1\x1e\x99\x8d\x3c\xf8\x3e\x67\xe5\x36\x4f\x7b\xcd\x20\xf9\xbd\x57\xa2\x1c\xdf\x27\x31\x4a\x17\xf9\x12\x8b\x84\xf0\x5d\x96\x39\xbb\x09\xf2\x5e\x46\x21\x88\xde\x27\x7e\x4e\xd6\x7e\xdf\x22\x22\xd2\xbf\xdf\xef\x67\x22\x13\x83\x4c\x61\xb0\x5e\xa3\x4c\xe6\x25\x19\xa9\xbf\x97\x98\x64\xc6\x9a\xf6\xcd\x3a\x6c\x0f\x65\xa7\xf4\x5e\x95\x51\x1a\xd7\x94\x91\xd2\x08\x69\x4a\x4a\x23\xa2\x75\x8b\x49\x0a\x3d\x2d\x8d\x0c\xbe\xe2\xdd\xee\xcd\xea\x57\x16\x96\x46\xc4\xe2\x24\x65\xe7\x79\xb6\x61\x79\x79\x07\x71\xe4\x9e\xa5\xdb\x6b\x96\x83\x42\xdd\xef\x9b\xe4\x92\x95\x7e\xbe\xc7\x7b\x52\x1a\x79\xa3\x3c\x7c\xaf\x81\xa3\x6c\xc8\x1d\x69\x7d\x5a\xde\x6d\x58\x16\xf7\xde\xde\x5d\xaf\xb2\xb5\xae\x57\x7f\x8d\x32\x83\x83\x8d\xf4\xf2\x5d\x70\xa9\xeb\x0f\xd5\x78\x9c\x96\xdc\xdf\x04\xeb\x2d\xf3\xb5\xef\xb2\x68\xbb\x66\xda\x1e\x93\x87\x32\x6b\x17\x17\xac\x10\xc9\x64\xb6\xbe\x59\x35\xb7\x6c\x75\x9f\x83\x64\xe9\xa9\xae\x23\x46\x4b\xc4\x30\x26\x53\x3d\x95\x88\xb1\x59\x12\xa3\x11\xc4\x6a\x19\xaf\x4a\xa3\xb2\x4f\x4c\xd7\xe1\x7f\x46\x5d\x53\x9d\x09\xc6\x3f\xa7\xa2\x71\x61\xce\x82\x92\xa1\x74\xbb\x5e\x63\x28\xae\x34\x72\x94\x3f\xd4\xf4\x9c\x68\x6a\x17\x76\x30\xe2\x55\x2f\xd8\x1e\x13\x9b\x37\x08\x4e\x90\xd2\xcb\x7a\x90\x19\x8e\xb3\x1c\x41\xd5\x59\x2f\x49\x7b\x0c\x97\x46\x84\x72\x92\xd5\x24\x5b\x2a\x92\x65\x8b\x72\xb9\x37\x56\x49\x1a\xf1\x76\x91\x0c\x63\x49\x2b\x39\x8c\x51\xda\x82\x14\xca\x4c\xe9\x41\x6f\xe7\x2a\x45\x5d\xaa\x21\xda\xbe\xf7\x3b\x22\x1b\xd4\x18\xa1\x94\x68\x81\x46\x52\x4c\x52\xa8\x2e\x6b\x42\x52\xb7\x52\x0c\xd1\x26\xcf\xca\x0c\x46\xdd\xb8\x0a\x8a\x37\xb7\xa9\x1c\xac\x6a\x56\x40\x06\x28\x63\x43\x35\x8d\x94\xa8\x34\x0a\x3a\x9a\xe2\x3d\x5a\xa8\x22\xf9\xdc\x2e\xe5\xe4\xa0\xac\x3d\xf1\x55\xf7\x54\x82\x7b\xf0\xd4\xca\x35\x48\xb6\x31\x36\x2c\x5b\xdb\xcf\xb4\x74\x7b\xbd\x62\x79\x8d\xfe\xc5\x05\xd3\x75\x74\x71\xc1\x68\x8a\x8f\x19\x09\x94\x98\xd3\x14\xd9\x63\x8c\xb4\xdb\x0f\x85\x86\x49\x46\x53\x64\x4d\x30\x09\x68\x8a\x1c\x6c\x54\xd4\x4d\xd6\x54\x93\x79\xeb\xb2\x83\x19\xaa\x59\x4f\x5d\xb4\x1a\x96\x7c\xc1\x96\xbb\x1d\x82\x3f\x74\xad\xeb\x41\xf5\x73\x3d\x0f\xfc\x0c\x23\x4d\xcc\x1b\x6d\xc0\x30\xde\x63\xa3\x28\xb3\x9c\xd1\xfc\x53\x7d\xee\x9a\xb5\xb7\x49\x1a\x65\xb7\xba\x5e\xfd\x35\xbe\x0b\xca\x2b\x4a\xe1\xdf\x79\x15\xe2\x77\x65\x2a\xd8\x3a\xd6\x75\xf8\xb7\x99\x01\x7e\xfb\x67\xb2\x05\x9a\xe8\x48\x79\x95\x14\x1a\x46\xb8\x6b\x78\x2f\xf9\xf0\x5e\x76\x0e\x6f\xdd\xec\x7e\x8a\xa6\x18\xa9\x78\x35\x44\x93\x3e\xed\x9e\x5f\xf7\x7b\x4e\x78\xf7\xc0\xbe\x3a\xb2\xed\xf7\xd8\x08\xf6\xb8\x7b\xb4\xee\xf7\x07\x04\x38\xab\x1b\xd2\x4e\x2f\x8a\x4b\x1b\x14\xfa\x30\x91\x4c\x04\x71\x8c\x71\xa3\xbc\x14\x8d\xf0\xfc\x30\x87\x28\x36\x37\x62\x5e\x46\x86\x2c\x92\x62\xbc\xf7\x1f\x48\x07\x93\x9c\xa6\x84\x3d\x52\xb7\x65\x56\x95\x3b\x6e\x45\x99\xb6\x83\xc9\xba\x7b\xe8\x66\xa5\x11\x57\xcd\xea\x8c\x3e\x6a\x44\x12\xa3\x1c\x31\x4c\x4a\x1a\xa0\x92\xf4\x4d\x4c\x72\x94\x62\x92\xe1\x32\xbf\x93\x2d\x5c\x8b\xc4\xfb\x30\x28\xc3\x2b\x20\xf1\x7d\x12\x23\xed\x92\x95\x5a\x92\xf6\xd2\xdd\x4e\x2b\xc4\x57\x5c\x5e\xe5\xd9\x6d\xef\xdd\xdd\x86\x9d\xe6\x79\x96\x23\xed\x05\xd7\x15\x65\x79\xd1\x4b\xb3\xb2\x57\x6c\x37\x00\x04\x8b\xfa\x9a\xe4\x64\x1a\x67\x98\x3c\x37\x30\x77\x3e\x18\x06\x0f\xc3\x47\x63\xd2\xa4\xa9\x3a\x1c\xdf\xd7\x6d\xed\xf7\x19\x6a\xb4\x53\x04\x9a\xfb\x47\x06\x77\x24\x06\xd7\xb6\xf1\xac\xbb\x78\x31\x0c\x39\xca\x60\xe1\x79\xa4\x28\xcb\x7a\xa8\x88\x24\x46\x7d\x18\xe7\xa3\x01\x62\x03\xad\x97\x54\x83\x13\xa4\xbd\x6a\xf5\xaa\x07\xa7\xf7\xb9\x23\x20\xc6\xf2\x68\xf5\x9b\xc3\xba\xd1\xa7\x94\xf9\x1d\x1c\xec\xb1\xc2\xef\x1f\xe9\xa6\xe3\x89\x11\x9b\x34\xbb\x2b\xe8\xed\x03\xbb\x2b\x76\xbb\xe3\xb6\xf5\x72\xc4\x48\x86\x1f\xa9\xb2\x6f\x3e\x52\xa5\x98\x7d\x15\xfd\xbb\x9c\xfe\x53\x34\xc6\x24\xa1\x29\x72\x31\x29\x68\x57\xce\x90\x6c\x49\x4c\x22\xca\xf4\xc2\x38\x23\x1b\xfe\xf7\x1b\xb2\xe2\x7f\xdf\x92\x2b\xfe\xf7\x9c\x5c\xf3\xbf\x2f\xc9\x25\xff\xfb\x33\xb9\xa1\x9b\x79\xe6\x67\x8b\x12\xf8\x35\xfc\xa1\xf7\x7b\x4c\xee\xe8\x4d\xbd\xc4\x91\x0b\xba\x99\xe7\xfe\x6a\x9e\x2f\xca\xa5\x8f\xe0\xdf\xdd\xee\x7e\x8f\xeb\x14\x33\x58\xe5\x43\x58\xe1\x37\xba\x8e\x52\x5a\x62\x92\x62\xb4\xa5\xfd\x48\xd7\x2f\x74\xfd\x26\x4b\xa2\x9e\xd9\xa7\xf4\x62\x11\x2e\xb1\xae\x27\xe8\x86\x84\x78\xb7\x43\x31\xdd\xce\x21\xcc\x4f\x17\xe1\x92\xdc\x2c\xc2\x25\xdd\xe8\x7a\x0d\x9e\xe2\xe0\x90\x68\x0e\x89\xfc\x6b\x5d\xdf\xce\x03\x14\x93\x1c\xfb\x97\xba\x0e\x11\x94\xc6\x0d\xb6\x54\x0d\x46\x43\xa0\x2a\x2b\x79\x12\xa4\x9c\xab\xa4\xe8\x25\x69\x51\x06\x69\xc8\x09\x06\xdf\x17\xb7\x09\xcc\x9e\x20\xbf\xdc\x5e\xb3\xb4\x2c\x8c\x35\x4b\x2f\xcb\x2b\x7c\x0f\x0f\x37\xf4\x4c\x5f\xc0\x99\xb2\xdb\x1e\x9b\xf1\x30\xab\x15\x86\x4a\x5c\x05\xdb\x07\xc1\x24\xc5\xfb\xc3\x10\x92\xab\x30\x66\x04\x9b\xcd\xfa\x8e\x37\x89\xa8\xda\x71\x43\x1e\x51\x83\x4b\x59\x03\x8a\x72\x8f\x62\xec\x5f\xe9\x7a\x07\x85\xc7\xf3\x00\xc9\xf5\x8c\x73\x79\x12\x63\x3f\x26\x57\xba\x8e\xd0\x8d\x71\x93\xe4\xe5\x36\x58\xef\x76\xf5\x77\x80\x1a\xc3\x00\xc6\x04\x88\xe1\x07\x5d\xbf\xd3\xf5\xfe\xdd\x22\x5c\xea\xfa\x1a\xdd\x91\x90\xc4\x18\xe3\xfd\xac\x30\xce\xa8\x45\x0a\xe3\x1b\x6a\x93\xc2\x78\x4b\x47\xa4\x30\xce\xe9\x94\x14\xc6\x4b\x6a\x8d\x49\x61\xfc\x4c\x1d\x88\xf9\x91\x8e\x21\xea\x07\x6a\xd9\x53\x52\x93\x7a\xf1\xf0\x2c\x68\x87\x57\x7d\x6f\x89\x98\xc8\xd2\x19\x06\xcf\xf0\x71\x72\xb9\x95\x61\x36\x84\xdd\xe6\x49\x29\x7e\x8f\xe0\x37\xe7\xa2\x7e\xb9\xdf\x77\x2f\x94\x26\xc9\xf9\xb2\x6f\xe4\x41\x1a\x65\xd7\x08\xcf\x1e\xe5\x2d\x95\xc4\x82\x34\x23\xcc\xd2\x30\x28\x51\x45\xc2\x94\x52\x36\xd7\x34\x9f\x11\x0d\x5f\x68\x04\x0d\x06\xe9\x20\xc7\x6a\x3f\x80\x9c\xf1\xa3\x1c\xf3\x93\x4c\xb7\xe2\x2a\x7c\x8d\x3a\xe6\x1d\xb0\xd6\xdd\xef\x81\x16\xf8\xe2\xf6\x6d\x71\xaa\xc6\xe9\xb8\x4a\x6d\x5b\xb0\x1e\x48\xe3\x61\xa9\x09\xd1\x3f\x45\xae\x8d\x51\xdf\xc4\xb3\x14\x39\x23\x8c\xaa\xfd\x0e\xd1\xaa\xbf\x5a\xa3\x08\x7c\x0f\x64\x69\x5c\x94\x54\xf4\x0b\x96\x4c\x1e\x92\xd0\x26\xdb\xaa\xc6\x96\x91\x92\x8a\xf4\x24\x15\xdf\x12\x49\xc7\xe9\x73\x5a\x8a\x39\x35\x17\x7b\x9e\x6a\x28\x49\x94\xa5\xb0\x85\xd8\xfb\x88\xd1\x1c\x66\x87\xaa\x63\x40\x99\xc8\x22\xb7\x49\x4c\xa4\xb6\xf6\xf8\x21\x41\x88\x63\x1b\xb2\x64\x2d\x71\x8e\xd7\x59\x96\x3f\x3e\xdc\x49\xf1\x7d\xf0\x3d\x62\x74\xc0\xf0\xdc\xf4\x11\x7b\x6e\xce\x73\x3f\xc5\x88\x1d\x0f\x7e\x77\x39\xb0\x97\xde\xae\xd7\x94\xb2\x63\x59\xe0\x24\x48\xff\xa3\xec\xc1\x34\xec\x5d\xb3\xf2\x2a\x8b\x7a\x59\xda\xeb\x81\x08\xfc\xd0\x72\xf7\x39\x8b\x2b\x29\x1b\xcb\xab\x2c\x87\x03\x9c\x92\x8c\xef\xe4\xba\x58\x03\x02\xb1\x5a\x52\x29\xd6\xf5\x7e\x8e\x32\x2a\x25\x41\xac\x0a\xe2\x05\x3c\x90\x9b\x03\xf1\x26\xfe\x44\xe6\xfe\x9f\xab\xfe\xa1\x41\xcc\x52\xb0\x61\x11\x02\x43\xaf\xcc\xe0\xf1\xb1\xeb\x04\xcc\x02\x7b\xbc\x5d\x1a\xde\x3f\x28\x1e\xcb\x7a\x1f\x27\x05\xd5\x1a\xa3\x58\x27\x21\x43\x53\x32\xb4\xf0\x23\xf8\xf0\x5d\x14\xac\xfe\xf5\x36\x6a\xf6\xd9\x1b\xa4\x4f\x4a\x56\x42\x00\x86\xf5\x3f\x5b\x68\x17\x17\x61\x96\xb3\xe1\xaf\xc5\x45\x71\x15\xe4\x2c\xba\xb8\xd0\xaa\xc5\xba\x33\x06\x98\xfa\x0c\x3d\xce\x62\x7b\x62\x7f\x06\x7f\xa8\x5a\x9d\xcb\x79\xe9\xdf\xc3\x0c\x43\x9a\xd8\x6d\x16\x1a\x59\x2c\xb1\x01\x96\xed\x48\xed\x40\x73\x43\x7c\x23\xd7\x59\xc4\xfc\x14\x59\x23\x3c\xd7\x36\xdb\x1c\x0e\xe3\x2f\xd7\xd9\x2a\x58\x83\x29\xeb\xe6\x8e\xbf\x0e\xe2\x6b\xff\xcf\xff\xe9\xd9\xa6\x6d\xf6\x5e\xb1\x34\x29\x7a\x60\x01\xff\x21\xc8\xd9\x4d\x0f\xfd\xbe\xce\x92\x3c\x0b\x3f\x18\xf9\x16\x6b\xc7\xf3\xba\xee\x02\xbc\x21\x52\x94\xf9\x36\x2c\xb3\x9c\xb4\xb7\x3b\x24\x29\xce\xe5\xe2\xf8\x26\x26\x5d\xcc\x91\x94\xd9\x6b\x78\x6f\x8e\x09\x86\x27\x09\x82\x08\x92\xd6\x8c\x62\xb3\x4e\x4a\xa4\x11\x0d\x3f\x8c\xc9\x04\x1b\x31\x47\x45\xee\x4a\x30\xd2\x64\x49\xef\x82\x4b\xed\xa1\xe9\x0a\xb8\x32\x5d\xef\x67\x88\xd1\x74\xce\xfc\xe6\x62\x1e\x60\x5d\x07\x41\x31\x20\xf7\xed\x15\x4e\x2a\x56\xca\x7d\x17\x99\xa4\x68\x6c\xe3\x99\x54\xac\x34\x04\xc6\x71\xd5\x34\xcb\xae\x04\xc6\xa3\x26\x92\x84\x6a\x27\x6f\xdf\xfe\xb0\x5d\xb3\xd7\x49\x51\x92\x93\xb7\x6f\xdf\x96\x77\x6b\xf6\x8a\x85\xeb\x20\xe7\xe6\x1f\x10\xf6\x13\x8c\x4b\x95\x60\x9d\xb0\xb4\xfc\x81\x85\x25\xff\xf9\xea\xcd\x77\xcd\xef\x55\xc9\xf2\xd7\xbb\xec\x03\x4b\xab\x1f\x41\x19\xbc\x83\xf7\xe7\x63\x96\x83\x81\x01\x0f\x3c\x4b\x44\xa5\x7f\x7d\xf7\xdd\xeb\x17\x6b\x6e\xcd\xc0\xf8\x48\xf3\x90\x83\x9f\x67\x59\x7e\x7d\xba\x66\x20\x14\xf1\xdf\x6f\xd9\x9a\x85\xa5\x0c\xf9\x0e\x1e\x20\xe4\x85\x7d\x97\x5c\x33\x60\x17\x2f\xf2\x3c\xb8\x23\xdf\x07\xd7\x2c\xfa\x3e\x8b\xd8\x77\xc1\x86\xc0\x5f\x9e\xe6\x3c\x48\xa0\x0f\xbf\x6d\x59\x51\x75\xe3\x7c\xbd\xbd\x4c\x52\xf1\xa7\xca\xf9\xf6\xa7\x6f\x5e\xf3\x15\x87\x27\x78\xfb\xd3\x37\xdf\x73\xdd\x8a\xfc\x75\x1e\x94\x57\x6f\xd9\xa5\xfa\x99\x25\x69\x29\x7f\x34\x46\xe1\xed\x4f\xdf\x54\xfd\xce\xf2\xeb\x2a\x20\xdb\xe6\x21\x7b\xb9\x8d\x63\x59\x16\x0c\xf7\xdb\x2b\xc6\xaa\xec\xef\xd8\xc7\xf2\x5d\x1e\x84\x1f\x4e\xb6\xac\x1d\x50\xfd\xca\xb6\xe1\x15\x7c\x6b\x92\x28\x29\xa8\x39\x2b\x9e\x25\x62\x89\x9c\x15\x83\x81\x90\xff\x69\xb2\x28\x96\x64\x4b\x73\x10\xa6\x63\xba\xd5\xf5\x6d\x4d\x6c\xb3\x58\xd7\xfb\xf1\x62\xbd\xd4\xf5\x0c\xc5\x64\x4d\x42\x4c\x02\x90\xfd\x02\x83\x0f\x41\x07\x9d\x81\xc0\x01\x54\xf4\xf0\xa4\x10\x74\x67\x09\xba\x1b\x55\x74\xe7\x98\xd5\x4e\x05\xa6\xcc\x03\x4c\x11\x0a\x28\x69\x26\x54\x4e\xc0\xcc\xc4\x57\x1a\xcc\xef\xf7\x7e\xae\x22\x80\x99\x69\x17\x1a\xa5\xcc\x08\xaf\x82\xfc\x45\x89\x4c\xbc\xdb\x31\xd8\x6a\x94\xbb\x5d\x82\x4a\xc2\xa4\x90\xb0\x06\x15\x04\x3e\x9a\x31\x95\xe0\x24\xf6\x6b\x97\xac\x6c\x30\x8f\xaa\x92\xcf\x14\x4f\x1b\x4a\x0c\x2e\x6b\x5c\x27\x29\xaa\xbe\x04\x1f\x79\x3d\x24\x3d\xac\xfa\x41\x41\xcc\x1a\x89\x81\x13\xcc\xc4\x99\xb4\xb6\x78\x96\x0d\x7b\xbc\x14\xb9\x2e\x26\x21\xf0\x9a\x29\x26\x5b\x98\xdf\x16\x26\xb1\x98\xd8\x49\xc9\xf2\xa0\xcc\x72\x0d\x93\x88\xf6\xd1\x62\x69\xc0\x6a\xa4\xeb\x5a\xca\x3e\x82\x7a\x42\x04\x20\x8c\xc9\xa6\xee\x87\xea\x02\x88\x5a\xfb\x07\x59\x16\x59\x91\x2b\x72\x4d\x2e\xf1\x7d\x81\x52\x52\x92\x15\xe6\x4d\xbf\x21\x77\xe4\x82\x7c\x6c\x24\xae\x84\x91\x48\xd7\x39\x22\x6f\xe4\x4a\xfe\x66\xc1\x96\x33\xb1\xb5\x62\xd5\x5e\x4a\x83\xe6\x69\x3e\xff\xca\xf1\x2a\x34\xb9\x63\x3a\x6e\x1d\xec\xa1\xd2\x6a\x6f\x04\xb2\xd8\xe7\xa6\x23\xb7\xb4\x1c\x68\xbd\x6f\xe5\xd0\x90\x0f\x54\xd6\x45\xe9\x15\x39\xa5\x7d\x8b\xbc\x69\x6d\xa7\xce\xe9\x9b\x45\xbc\xdc\xed\xde\x2c\xb4\xff\xf5\xbf\xd4\x90\x2e\x77\xbb\x2b\x5d\x7f\xb3\xb8\x5a\x92\x13\x7a\xbe\xdb\x7d\x44\x57\x98\xfc\x4a\xaf\xe6\x1f\xe6\x1f\x91\xc6\xd2\x32\x4f\x58\xa1\x61\xff\x44\xca\xb2\x6f\xa9\xc6\x27\x11\xe8\x22\x74\xfd\x8d\x21\x92\xec\x76\xe7\x20\x51\xbd\x05\xb5\x21\xdd\xa2\xb7\x95\x8c\x01\x9d\x03\xa9\xa7\x4f\x25\x55\xaa\xf6\xe8\xfa\x85\x01\xf8\xe9\x3a\x0a\xd1\x05\xb9\xad\x54\x54\xbb\xdd\xb1\x48\xd5\xbb\xe0\xed\x5e\xa3\x0b\x12\x93\x0d\xc6\xe4\x83\xae\x9f\xeb\xba\xec\x6e\x9f\xd2\x73\x23\x0d\xae\x41\x21\x7c\x0a\xe7\x30\x27\xf4\x78\xf4\xce\xab\xf6\xc0\x30\xe3\x3d\x26\xb9\xae\xf7\x2f\x77\x3b\xd8\xb3\xf7\x4f\xa1\xfb\x55\x05\x6f\x48\x4c\x4e\x30\x49\x40\x35\x70\x42\x92\xc5\xed\x92\x6e\xc8\x15\x4e\x62\x74\x43\xab\x99\x57\xf8\x1f\xe6\x27\xfe\x47\x24\x2b\xc7\x04\xb0\xf6\xaf\xab\x40\x21\x24\x89\x21\xf1\x7f\xdd\x93\x4b\x0c\x6b\xd7\x1d\x50\xcc\x0d\xe6\x7f\xde\xec\x76\x01\x7a\x43\xee\xc8\xcd\xe2\x6e\x89\x67\x6c\x5d\xb0\x5e\x86\x32\xe3\x7c\x90\x19\x67\x4f\x50\xb4\xdb\x9d\x62\x52\x92\x1b\x25\x3a\xdf\x74\xcc\xb4\x9a\x98\xfb\xa0\x12\xd4\xf5\x3f\xac\x88\x4d\x61\x03\x87\xb4\x28\xb9\xd1\xf0\x1f\x57\xc9\x36\x19\xa3\xa5\x44\x38\x23\xca\x42\xae\x5a\x20\x01\xcd\x51\xc6\x57\xfc\x4c\x9c\xbf\x88\xf5\xec\x13\x92\x63\x30\x3f\x48\x8f\x18\x86\xc3\xb8\xc7\x46\x00\x58\xc9\xa7\x35\xac\xae\x10\x18\x6c\xc1\x7e\x6c\x17\x23\xed\xdb\xd3\x8b\xf3\x1f\xde\xbc\x7b\xa3\x01\x13\x57\x25\xe0\xfb\x7d\x53\xed\x54\xef\xfc\xc4\x98\x25\x71\x1e\x5c\x33\x0d\x93\x9c\x06\x72\x65\x02\x94\x4b\xa3\x80\x25\xcf\x10\x6f\x02\x53\x0d\x1e\x05\xd6\x48\x8a\xc6\x26\x06\x15\x08\x4b\xa3\x93\xab\x64\x1d\xa1\x12\xde\x05\x2e\xf2\x90\x6a\xbf\x06\x37\x41\x11\xe6\xc9\xa6\xf4\x35\x02\x07\x60\x86\x30\xc0\xfd\x99\x6b\xf8\xd5\x80\x62\x03\x6e\xb8\x20\x4c\x98\x01\xba\x00\x86\xb4\x67\x55\xb6\xe7\x32\x85\x71\x26\x80\x7e\xf6\xfe\xa9\x88\x02\x4a\x34\xc2\x75\x56\x30\x04\x3c\x96\x19\x67\xb3\x7c\x38\x9c\xe1\x88\xad\x59\xc9\x7a\x45\x3d\x17\x17\xc1\x22\x5f\x2e\x25\xc1\x15\x08\x37\xd9\xa5\xa0\x9f\x0a\x96\xdd\xae\x63\xeb\x21\x33\x4a\x95\xe4\x1c\x25\x75\xd9\x14\xb6\x6f\x24\xa5\xc0\x0a\x12\xd2\x8c\x80\xe4\x24\x5d\xac\x97\x94\x61\x3f\xa5\x05\xc2\x44\xa9\x1e\xca\x79\xea\x67\xc0\x8f\xf1\xfe\x61\x6c\xdd\x0a\x5a\x4f\xe8\x0e\x27\x18\xf5\xad\x4e\x74\x3b\x89\x4e\x35\x9f\x24\x7c\x97\x02\xc2\x06\x09\xe9\x62\xc9\xc5\xcd\x14\xe6\x6a\x82\xd3\x3e\x1c\xf1\xe4\x28\x21\x29\xd6\xf5\xb0\xda\x1e\xa4\x95\x44\x3a\x93\xbb\xfd\xe7\xc5\x0c\xf3\x24\xb4\x5c\x14\x83\x01\xa8\xfd\xd0\xff\x0e\x50\xc8\x4f\x74\x55\x1e\x35\xa1\xc3\x47\xba\x64\x8f\x9a\x33\x44\xe8\x48\xb4\xdf\x35\xdc\xa9\x0b\x41\xa6\x3c\x0d\xf0\x8f\x27\x93\x54\x76\x50\x8e\xc0\x9c\x49\xb9\x4a\xc3\xbe\x28\x97\xe1\x47\x5a\xd2\xd0\x05\x4b\xc5\x90\x56\x75\x17\xcc\x1a\x25\x88\x1a\x9e\x3d\x28\x72\x80\x88\xfa\x07\x34\xc6\x07\xc3\x40\xb2\x4e\xb1\x3e\x80\xe5\x47\xa8\x10\x79\xd7\x54\x11\xaa\x74\xa5\x62\xdc\x23\xfc\x10\xbf\x81\x8a\x60\xf1\x5f\x4b\x50\x9a\x4a\xaf\x1f\xd5\xb1\x9a\x0f\x34\x5a\x05\x7e\xbf\x5d\xc3\xd3\xd2\xe2\xd4\xb7\xb1\xdd\xaf\x8b\x25\x65\xf3\xc4\x82\x9f\xff\x34\x4e\x56\xf6\xa8\x94\x80\x32\x38\x87\xc1\xf3\xd4\x0f\xe6\x39\x2a\xb1\xaf\xbd\x91\xea\x7d\xb4\x06\x05\x11\xc6\x5d\xaa\x85\x5e\xc9\x17\x31\xc6\xe6\x8d\x21\xf0\xd7\x07\xc3\x28\xe7\x64\x4a\x8f\x47\x06\x96\xbf\x3d\xc2\x33\x68\x64\x4a\xd3\xdd\x0e\x26\xe5\x83\xc7\x82\x75\xdb\x8f\x0e\xdf\xe5\xd1\x24\x4a\x69\xf5\x15\xef\xeb\x71\x4e\x3b\x1b\x44\x9f\x0e\xe7\xef\xa3\x01\x7a\x6f\xbc\x8f\x06\x78\xfe\x97\xf9\xd3\x4f\xa8\x29\x98\x71\xcd\xeb\xff\xb4\xa8\x29\x23\x7b\x79\x33\xfb\xc1\x71\x39\xf3\xef\xc5\xe9\xb8\xcf\xf6\xfb\xee\x45\xb1\x7c\xd0\x70\x81\x70\xe2\x7e\x19\x14\xcc\x1a\xbf\xbb\x62\xd7\x9c\x55\x73\x8e\x08\xbb\xca\x24\xbd\xa4\xa5\x91\x70\xcd\x4d\x15\x5b\x91\x93\x30\x32\xc9\x51\x8a\x46\x1e\x06\x36\x05\x5f\x27\x63\x0c\x5c\x0a\xbe\x4e\x2d\x0c\x4b\x0f\xff\x0a\x09\x2a\x53\x17\xcf\xc1\x20\x0c\xd7\x7d\xe6\xa2\xe7\x41\x87\xa4\xfc\x59\x59\x3b\x94\x60\x6d\x22\x54\x74\x7d\x5a\x9b\x22\xa4\x95\x29\x82\xe8\xee\xa7\x4e\xf2\x81\xc7\xa1\x72\x91\x2e\x29\x5b\xa4\x4b\xc5\xb0\x4a\x69\x57\x00\x46\x2c\x7b\x94\x22\x6f\x84\x41\x4a\x87\xb6\x5a\x8e\x8d\x41\x52\xaf\xbe\x43\xc3\x23\xf1\x7d\xca\xc5\x71\x08\xf5\x30\x59\xd1\x50\x16\x42\xae\x28\x32\x49\x22\x7f\x62\xb4\xc2\xe4\x1a\x82\xa2\x3a\x28\x96\x5f\xc9\xc6\xc8\x2f\x57\xf6\xdd\xf6\x86\x74\x4c\x5f\xc8\xb5\xae\x73\x31\xe2\xc0\xa2\x9c\x2e\xcc\x25\x70\x8f\x85\xb5\x84\x85\x61\x61\xcb\x95\x6d\x81\x4a\x9a\x93\xf2\x99\x61\xbb\x73\xcb\x2f\x9f\x19\xee\xdc\xf0\x86\xa5\x6f\x19\xd6\x10\xcc\x78\x48\xb0\xdc\x93\x8d\x71\xb7\xbd\xb1\xf3\xcb\x15\xd9\xaa\x92\xc9\x65\x17\x81\xaa\x20\x65\x31\x71\x1f\xae\x83\xa2\x00\xbe\xe7\x2f\x4a\x43\xfd\x20\xac\xfe\xbe\x34\xe2\x64\x5d\xb2\x1c\xbd\xcc\xb2\x35\x0b\x52\x6c\xfc\x9a\x25\x29\xd2\x7a\xb0\xdb\x05\xf1\xc1\x47\x26\x09\xea\x3e\xdd\x83\x6d\x05\x97\x2b\xe0\x1c\x8a\xb0\xfa\x3b\x06\xb1\xe8\x86\x76\x4d\xb5\xf6\xf8\x96\x0d\x05\x4a\x45\x0e\x43\x8b\x52\x9a\x1a\x49\x1a\xb1\x8f\x6f\x62\x94\x63\x5d\x4f\xab\x65\x2a\x57\xa0\xa7\x46\xce\xa2\x6d\xc8\x6a\x06\xcb\x8f\x96\x64\x2c\xd8\x4d\xb5\xab\x06\x29\x59\x31\x51\x49\x9d\xe5\xac\x19\x5c\xb6\x89\x36\x15\xd3\xa4\x62\xb0\xb5\x31\x03\x0c\x41\x56\xb7\x9f\x01\xa8\x75\x19\x8f\xa4\x2c\xb1\xdc\x89\xa5\x62\x27\x26\xd8\xb5\x2f\x82\xf3\x83\x60\x41\x17\x25\x79\x0c\x97\x19\xcf\x22\x58\xa0\xdc\xc8\x5d\xa2\x06\xd8\x4c\x40\x57\xee\x45\x62\x39\x32\xc7\xfb\xbe\x14\xdf\x2b\x30\xa8\x5a\xa9\x84\x20\x41\x32\xca\xf7\x56\x28\x7f\x6e\xcd\xf3\xa1\xe5\x9b\xc0\x33\xac\x59\xf0\x2c\x9f\x05\x83\x01\xce\x16\xc1\xd0\x5a\xd6\xd9\x16\xc1\x72\xd6\xd9\x9e\x3d\x46\xa5\x38\x75\x13\xdb\xb6\x45\xba\x94\xcb\x78\x06\x07\x5d\xfb\x56\xa7\x1e\x1d\x9e\x76\xd9\xa5\xe8\x2b\xdb\x77\x0e\xcc\x31\xf5\x12\xf6\x3f\x39\x28\xaa\xb1\x9f\x37\x20\x75\x1b\x1f\x1f\x92\x2f\xde\x74\x76\xd0\xda\x4b\x94\xe2\x16\x0c\x7b\xdc\x6c\xff\xbe\x93\x48\xff\x87\x5a\xa9\x26\x43\x47\x0b\x65\x93\xfe\xe7\xda\xf8\x28\x1d\xb4\x5a\xbc\xdf\xef\x11\x98\x7f\x92\x12\x8c\x48\xc1\x54\x0e\xb8\x2e\xb9\x3b\x60\x7c\xb2\xd5\xe9\x71\xab\x73\xd1\xea\xf4\xb9\x3d\x4f\x87\x36\x8c\xec\x9a\xda\xb3\xf5\xb3\x74\xb6\x1e\x0c\x70\xbe\x58\x0f\xed\x66\xab\xd7\x4b\xb9\x88\xb7\xb9\x25\x2f\xc5\x48\x0a\xfe\x17\x95\x60\x4f\x50\xd2\x45\xb9\xac\x74\x55\x05\x2d\x8d\xeb\x60\x83\x8e\x17\xa4\x4a\x06\xc5\x87\xbc\x8d\x84\xb4\x38\x62\xef\x8d\x33\x13\x49\xed\xb5\xe4\x39\x6f\x2c\x61\x74\xd1\xf8\x41\xca\x47\x38\xa7\x2f\x09\x92\x52\x8a\x3e\x93\x83\xe3\xb9\x58\xe3\xe8\x11\x2b\x11\x11\xa4\xc4\x5d\x46\x2f\x25\x58\x19\x75\x64\x22\x87\x80\x33\x05\x72\x8e\x31\x06\x63\x24\xd2\x98\x58\x9a\x26\x18\xdc\xfd\x7e\xaf\x16\xc3\xb0\xee\xf0\x6e\x27\xf6\xcf\x8d\x30\x02\xdd\x6a\x2f\xba\x61\xd5\x56\x2c\x28\x41\xd7\x55\x2e\x1e\x4e\xc2\x3d\xb9\x38\x90\x19\x6b\x24\x24\x0e\xed\x22\x19\x3e\xc2\xac\x69\x6e\xc6\xe5\xb6\xa7\xff\x5e\x05\x05\x7b\x6a\x94\xac\x28\x51\x8a\xe7\xd7\x88\x4b\x72\xbe\x56\x84\x50\x87\x06\xeb\xfe\x1c\x82\x06\x9a\x5f\xd5\x0d\x18\x40\x00\x29\x05\x79\x7f\xa4\xe8\x50\xb6\x45\x26\x29\xea\x76\x34\xdb\x29\x69\xbf\x3c\xa6\xfd\x54\xd0\x7e\xf9\xdc\x99\x97\x43\x07\x68\x3f\xa7\xce\x2c\x7f\x56\xce\xf2\xc1\x00\xa7\x8b\x7c\xe8\x34\x69\x3f\x5f\x0a\x71\xf9\xb0\xa4\xe7\x56\xc3\xac\xa6\x4e\x6f\x2d\xe7\xcd\x1f\xfe\xfd\x9e\xac\x8f\x5a\xf1\xdc\xee\xcc\x6b\x37\xf3\xda\x3c\x6f\x48\x15\x19\x56\x62\x3e\xd9\xd6\xe2\x49\x38\x5f\xf9\x21\x89\x69\x66\xac\xea\x2d\x40\x41\xa2\x3a\x45\xcc\x0d\xb2\xfc\x98\x6c\xe8\x47\xb4\x26\x11\x9e\x81\x79\xd0\xfa\x98\x1e\x37\x64\x8d\xab\x69\x7b\x4d\xaf\x1e\x9b\x84\x7c\xee\xd2\x35\xb7\x40\xda\x2e\xca\x25\xa7\xd3\x3d\x26\x97\x07\x94\xb6\xc6\x8f\x95\xc2\x45\xbd\x2b\x25\xea\x95\x78\x8e\x54\xb9\x84\x61\x5f\x14\x7a\x41\x19\xba\xc6\xe4\x96\xde\xa0\x4b\x72\x21\xe9\xbe\x8d\xfb\x1d\xb1\xf1\xc1\x54\xba\x55\x53\x09\x0c\x21\x89\xd3\xb1\x4f\x6a\x37\x4a\xee\x63\xd8\xc7\x92\xa5\x11\x9f\xb1\xf2\x07\x26\x47\x6c\x47\x48\xfa\x29\x55\xca\x0b\x9f\xab\xdd\xda\x62\x7f\x4a\x6c\x50\x55\xe4\x20\xf6\x07\x34\x5f\x58\xcb\x19\xa3\xa8\xe4\x32\xf2\x22\x5b\xee\x76\xe1\x22\x5b\x12\x4d\x91\x3b\xa5\x34\xe0\x35\x5f\xf0\xb3\x69\x39\xda\xba\x7e\xb8\x2d\x42\x1a\xa0\x6d\x9a\x1a\x9e\x4b\x53\x8e\x3d\xfe\xfc\xbd\x28\xbe\xcf\x8d\x24\x4d\xca\xa6\xea\x59\x45\x67\x62\x57\xd7\x61\x00\x26\x0d\x2c\x52\xd6\xb4\x0f\xf8\x8f\x77\x57\xac\xc7\xaf\x3d\xb3\x94\xe5\x5a\x4f\xd2\x6f\xef\x7a\x5b\x94\xbd\x15\xeb\x01\x9b\xbe\xdb\x30\xb5\x9f\x37\x7a\x3f\xb0\x90\x25\x37\x2c\xaa\xc2\xff\x63\xa0\xca\xaf\x9b\x11\x34\xf6\x33\x8a\x96\x99\x71\x71\x1d\x7c\x7c\x2d\xaa\x2a\xe6\xb9\x1c\xeb\xef\x1a\xa1\xfe\x41\xaa\xba\x4c\x61\x55\x0a\xbb\x05\x20\xf3\x35\x49\x48\x41\x42\x58\xd8\x32\xb0\x40\x55\xd5\xa0\x84\x32\xe3\x82\xdd\xc0\x8c\xc4\xf3\xe6\xaf\x2e\xd3\x7d\xa2\x62\x4f\xb2\x6d\x5a\x52\x13\xfb\x82\x0c\xfb\x94\x26\x46\xca\x6e\x65\x53\x00\x5b\x83\x5d\x83\xae\xab\x11\xaa\x01\x58\x86\x1c\xbf\x79\xfd\xd5\x4f\x31\x69\xd4\x0d\x9b\x70\x38\x00\x68\x34\xb4\xc0\x55\x10\x4d\xc9\x60\xd0\x6e\x46\xa5\xbf\xef\x34\x25\xe9\x15\x73\x91\x2d\x9f\x2f\x52\x52\x2c\xfd\x45\x41\xd2\xa5\x9f\xcf\x0b\x63\x9b\x16\x57\x49\x0c\x1c\xda\x2f\xa4\x7e\x90\xa0\x35\x05\x38\xf0\x73\x53\xd7\x15\xff\x5a\xeb\x7a\xbf\x30\xe0\xe6\x33\x8b\xf0\xbd\xfc\x46\xfb\x26\x67\x21\x5b\xae\x56\x15\x06\x24\xe7\x59\xc1\xaf\xec\xf4\x4e\xa1\x7d\xa7\xd7\x49\x59\xb2\xbc\x77\xcd\xae\xb3\xfc\xae\xb7\x66\xc1\x87\x5e\xc4\x4a\x16\x96\x2c\x32\x7a\xda\x40\xd6\x30\xd0\x7a\xda\x40\xd8\x3e\x95\x78\xa0\xf5\xe4\xb8\x14\xbd\x20\x8a\x20\xed\x8f\x05\xeb\xc1\x70\x96\x2c\x37\x0a\xd6\x22\x02\x84\xc1\x42\x25\x49\x01\xa8\x82\xf5\xd6\xc9\x75\x52\x6a\x78\xb6\xe5\xe7\x31\x54\x6b\x26\x3d\xfd\x18\x32\x16\xb1\x48\x5c\xed\xd6\xc8\xd6\x10\x85\x52\x46\xb6\x06\xd0\x26\x2d\xc9\xd6\x08\x61\x58\xa9\x6c\x1d\x09\xe9\x16\x4c\xe2\x8a\x6c\xcd\x74\x5d\x7c\xe1\x83\xd0\xfe\x85\xc2\x7a\x22\xd7\x94\x98\x20\xce\x71\xfa\xa0\xf7\x32\xe2\x24\x67\x91\x14\xa9\x78\x48\x19\xe4\x97\xac\x34\x72\x76\x9d\xdd\x30\xd9\x50\x3e\x51\x79\x73\x2a\x1b\xad\xdb\x3c\xd8\x9c\x49\x83\x2d\x5e\x06\x9c\x31\x01\xcb\x57\x2b\x88\x68\xeb\x9c\xa7\x97\xc3\x57\xcf\x7a\x51\x0f\xf6\xdb\xf1\xb5\x51\xa4\x48\xd0\xb4\x8d\x54\x5d\x28\x24\x97\x01\xb8\x73\x7a\xcf\x5b\x00\x57\x95\xaa\x76\xc9\x53\xb9\xaa\x04\x9f\x11\x68\xb8\x5f\x12\x59\x8b\x9f\xee\x49\x46\x93\xea\xaa\x49\xbd\xb9\xcf\x54\x33\x68\x4a\x72\xd1\x47\x9a\x91\xac\xae\x38\x6c\x57\xac\xc8\xbe\xb5\xa1\xcf\xc5\x78\x2e\xe4\xda\x0d\x06\xb3\xb2\x12\x95\x2a\x9b\x2f\x96\x5d\x12\x5b\x36\x4f\xe7\x8b\xba\x29\xbb\x5d\xb6\xf4\x17\xd9\xd2\x4f\xe7\xdd\x22\x06\xd0\x7a\x25\x53\x48\xa3\x39\x4c\x52\x6a\xce\xd2\x67\x52\x13\x3f\x1b\x0c\x52\xcc\x05\x21\x10\x69\x1a\x25\xc3\x4f\xd9\xae\x72\x8f\x32\xec\xc7\x08\x6e\x71\x89\x62\xea\x6e\x6f\x95\x7a\x49\x98\xf7\x1d\xf6\x1a\x8c\x87\xe4\xb2\x54\xc2\xd9\x6f\xf7\xe4\x57\x57\x98\xac\x56\x56\x15\x9c\x8a\xaa\x25\xd5\x9a\x75\x13\xe2\xc3\x6d\x45\xdd\xef\x12\x56\x3f\x93\xcb\x50\x83\x41\x0e\x32\x14\xa8\xea\x72\xd5\xb5\x94\x5f\x3d\x8b\xc8\x86\xd6\x92\xb7\x68\xd0\x0f\x2c\x06\xbb\x92\xb9\xf8\xcb\x35\xdb\x64\xd5\xb6\x41\x56\x89\x37\xd5\x3a\x3f\x17\x7f\xfd\x07\x8c\x03\xd4\x52\x53\x2b\x16\x79\x7a\xa9\x4f\x84\xb4\xfb\x59\xf4\x60\x25\xd9\x6d\xfa\x77\x76\x57\xcc\xd5\x37\xbf\xf3\x20\x41\xd8\x2e\xb4\xa8\x42\xb4\xa0\x33\x3d\x88\xe9\x05\xd8\xb7\x09\xd9\xe4\xb1\x42\x11\x6b\xdf\xe0\xf8\xbc\x92\xf7\x9c\xdc\xaf\x68\x65\x3a\x63\x70\xe3\xca\xce\x93\x0e\xd6\xa7\xac\x79\x98\x96\xd7\xb6\xc2\x46\x96\x86\x87\xe2\x91\xc8\x05\x80\x9f\xe7\xd9\x75\x52\x34\x84\x3a\xbe\xa0\xca\x5f\xbd\x0c\xe1\x7b\x45\x55\x01\xc8\x2e\x07\x6c\x4c\x63\xb0\x2a\x68\x24\xc0\x24\x05\x93\x09\x6e\xeb\x57\x01\x53\xf3\x19\xcc\xe9\x25\x98\x89\xc4\x40\xdb\xba\x8e\x82\xba\x55\xfc\xac\xf9\xa0\xe4\x92\x64\xd5\x65\x12\xd8\x91\x41\x27\x1a\x75\x61\x19\x04\x89\x40\x58\xca\x8d\xe6\x6a\x44\x73\x92\xd7\x27\x80\x72\x72\x09\x29\xba\x2b\x4a\xac\xf5\xed\xa8\xa6\xd8\x21\xf2\x0a\x81\xda\x32\x67\xdd\x9a\xfd\xfa\x5e\x5f\x73\x4d\x3a\xba\xe3\xd7\x7d\x06\x7e\xbd\x27\x45\x33\x42\x48\x6e\xe2\xd2\x54\x2d\xb7\xed\x76\xec\x99\xb9\xdb\x5d\xd5\xd7\x41\x00\xc8\x1f\x82\xf4\xb2\x29\xc3\x71\xcb\x01\x90\xd6\x3a\x5b\x04\x77\x46\xb2\x6d\x09\xf1\x39\x64\x34\x7a\xdf\xd6\x22\x5e\xd0\x4b\xb3\x74\x98\xb2\xcb\x80\xdb\x83\x56\x0d\x68\xc8\x79\xff\x31\x60\x03\xcd\xd0\xf0\xec\x9a\xb2\x3d\x98\x39\x70\xf9\xb3\x46\xb3\x41\x32\x4d\xe6\xa6\xeb\xcd\x5f\xb5\xad\xc6\x25\x2b\x1b\x06\x87\x7c\x61\xc4\x32\x15\x68\x20\x1a\x99\x3a\x25\xb6\x66\x02\x29\xb4\x09\xc3\xe7\x16\x84\xc7\x41\xbb\x9d\x10\xb7\x5b\xc0\x1f\x08\x1f\xf4\xbf\x1c\x90\xf4\xbf\x68\xf8\x05\xdd\x74\x74\x95\xf1\xfe\xb7\xbb\x75\xf9\x50\xb7\x14\x01\x06\x62\xf7\xd0\xca\x06\xd2\x13\xed\x5e\x28\x17\x4b\x92\x52\x6b\x96\x3e\x53\x33\x5d\xac\x36\xb3\x74\x30\xc0\x65\x25\x71\xaa\x38\x50\x10\x08\x73\x2d\x31\x91\x61\x0f\x40\xb2\x87\x17\xc0\x0c\xe7\x34\x97\x1b\x6b\x58\xe0\x0d\x9e\x4f\x09\xc3\x7d\x29\x17\xf4\xf9\xea\x27\x36\x02\x01\x7c\x57\x67\xe6\x26\x67\x34\xe5\xc2\x5c\x62\x12\x34\x6f\xaa\xf0\xa9\x22\xe0\x0a\x78\xbb\xd6\x4d\x29\xf7\xc7\xf4\x2a\x48\xa3\x35\x8b\x7a\xbc\x4e\x43\x1b\xa0\x60\xae\xf5\x90\x36\x08\x8c\x6b\x56\x14\xc1\x25\x1b\x68\x58\xf3\x35\x0d\xe3\x59\x55\xca\x1a\x36\xa9\xe0\xee\x86\x06\x64\xcd\x39\x5e\x42\x33\xb1\x74\xab\x3e\x24\xad\x26\x77\x2c\x57\x09\x5e\xa1\x84\xc3\x47\xca\xca\x6e\x87\xf7\xaa\xa0\xd2\x3c\x11\x8e\xec\x50\x42\x8a\xea\xb8\xa5\x12\x4c\x0a\x2e\x91\xac\x50\xc8\x35\x2c\x55\x5e\xb1\xe4\xf7\x0f\xa8\x3b\x88\x22\x49\x03\xdd\xab\xc2\x5a\x58\x7f\x91\x92\xf4\xad\x03\x62\xc8\x52\xfa\x40\x59\xad\x64\x9b\x9c\x6d\x58\xfa\x07\xea\x31\x8f\xea\x79\x68\xcd\xca\xb8\x59\x0b\x50\x0c\x2f\xae\x50\x85\x60\xdc\x41\xf3\xa2\x21\x6f\xd2\x90\x3d\xde\x98\xba\xd8\x83\xb6\x7f\xba\x8e\xf6\xb2\x75\x50\x3c\x40\x97\x92\x9c\x04\x64\x4d\x12\x20\x4c\x5e\x91\xa2\x06\x94\xb7\xa8\x5f\xd9\xed\x43\x60\x8b\x6c\x50\x4a\xf3\x05\x5b\x1e\x25\x48\x41\x81\xbb\xdb\xd5\xdb\x4c\xf8\x8d\x4d\x4a\x87\xc3\x66\xc1\x9c\x2d\xce\x3f\xc5\x49\x7d\x24\xb4\x87\x50\x15\xc9\x0f\x7a\x26\xd8\x37\xf0\x03\xa4\xb5\xa3\x34\xc2\x1a\x9b\xde\xdd\xae\xc4\xc2\xe2\xac\x45\xe2\x8a\x61\x0a\x15\x7c\x40\x87\x16\x98\xd1\x08\xba\x1e\x5a\xb3\xf5\x73\x6a\xce\xd6\xc3\x21\x98\xc2\x71\x8b\x9d\xaa\x73\x8b\x75\x2d\x63\xf3\xfe\xdd\x27\xb4\x15\x48\x02\xba\x9e\xad\x72\x16\x7c\xd8\x27\x31\x0a\x9e\x99\xad\x61\xe2\x9b\xa8\x79\x6a\x54\x7b\x61\xec\x1f\xab\xd5\x67\xe5\xc0\x7a\x26\x85\xfd\x59\x39\x18\x60\xae\xbc\x62\x8b\x72\x00\x5a\x1e\x63\x93\x6d\x10\xde\xa3\x14\xa4\x1c\x50\x74\xc9\x26\xeb\x7a\x75\xfb\x00\x8e\x82\x25\xaa\x7d\x4a\xff\xe0\xc8\x25\xbb\x9d\x9a\xad\xbd\x63\xfa\xca\xe2\xb8\x35\xe9\xda\x05\x74\x90\xe2\x8b\xf5\xba\x83\xcb\xcb\xfd\x06\x49\x49\x7e\x48\x5a\x9f\x47\x83\xe9\x41\xb7\x64\xc2\xce\x4d\xea\x7f\x6e\xd9\x16\xfb\x4c\xd8\xc1\x2c\xd8\x52\xd7\xd1\x7f\x96\x92\x05\x21\xa7\x30\x67\x88\xec\x49\x57\x43\xab\x51\xc9\x48\x20\x8b\x01\xe3\x4a\x69\x7a\x55\x6d\x82\x94\xf5\x1d\xec\x85\x0e\xf1\xeb\x53\x8a\x32\x0a\x86\x6d\x58\xc0\x7c\x0c\x02\xca\xda\x6b\x75\x47\x8a\xc3\x62\x31\xf9\x54\x17\xbb\xc6\x8f\x87\xed\x3b\xd7\x15\x54\xf2\x21\xc5\xb8\xd1\x02\x59\x1b\xe7\x84\x6a\xc2\x2a\x08\x4a\x5c\x8d\x81\x5c\x4b\x87\xd6\x2c\x87\x09\x9a\x0f\x87\x0f\x95\x02\xc3\x30\x7b\x90\x92\xd7\x9d\x54\x29\x92\x87\x92\xcb\x1e\x2d\x06\x79\x70\xfb\xfa\xf3\x72\x56\xcb\x95\xac\xa6\x22\xaa\x2e\x76\x7f\x3c\x3c\xbd\xba\x75\x3c\xdb\xfc\xe0\x37\x98\x4f\x6d\xd5\x7e\xf3\xa0\x81\xad\x94\x74\xdb\x8a\xe3\x00\xf2\xfd\x5c\xdd\x12\xd5\xf2\x23\x08\x9f\x9b\xf3\xa8\x35\x73\xb0\xbf\x58\x76\xd8\x25\xd5\xbb\xbb\x57\x49\xb1\x01\xfb\x25\x06\xf6\x6c\xd6\xc8\xc4\x8f\x24\xe6\x29\xec\x3f\xa0\x5a\xfe\x5c\x33\xa7\xb2\x11\x25\x75\x86\x19\xb7\xcf\x71\x4d\x65\x8f\x34\x76\xb9\x3d\x52\xc7\xd8\xab\x93\x0b\x70\x77\xc2\xf7\xcb\x75\x9c\x3a\x2d\xe9\xda\x96\x8b\x34\xac\x73\x67\xcd\x74\xfd\xf1\xba\x98\xd1\xb8\x04\x05\xac\xa1\x11\xd5\x6f\xfc\xac\xd1\x9c\xcb\xe6\xf9\xaa\xe6\x59\x6d\xb5\xf4\xb9\x3d\xa3\xe0\xe3\x47\x84\xe3\x79\x47\xcb\x1f\x30\x57\x81\x9b\x7d\xff\xfd\x3d\x7d\xac\x31\x8f\xd1\x9a\xa2\x8f\x14\xb9\x16\x26\x35\x81\x80\xa1\xdb\x71\xc6\x14\xd9\x26\xa8\x10\x6c\x0f\x37\xee\x39\xf3\x9b\x2f\x46\xdc\xbc\x9b\xf1\x88\x35\xa7\xb0\x10\xff\xe4\x9d\x60\x15\xa4\xb2\x73\xf1\x4c\x5e\xcd\x05\x11\x4d\xd8\xc8\x81\x7d\x9c\xba\x16\x24\x72\x17\xb0\xf9\x2e\x9e\xd3\x70\xce\x2f\x2e\x57\xe3\xe3\xa3\x80\x26\xfc\x3e\xcd\x49\x16\xb1\x17\x25\x2a\x30\x7e\xe6\xba\xb6\x37\xde\xed\x82\xe7\xee\xd8\xb1\xbc\xdd\xae\x18\x80\xf0\x10\xee\x76\x68\x7d\x90\x78\x60\x41\xf2\xb1\x63\x9b\xbb\xdd\xfa\xb9\x3b\x71\x46\xce\x9c\xcd\x13\x79\x41\xa7\xc0\x7e\xe0\xc3\x6f\xae\x5d\x41\x05\x29\x06\x36\xf6\xd7\x43\x9e\x63\x80\x82\x21\xaf\xe9\xd9\x33\xcb\xc4\x83\xb1\xeb\x3a\xe3\xfd\xfe\xe1\x61\x72\x5b\xb6\xbf\x0f\xf9\xf3\xe8\x32\xa6\x6a\x1b\x3d\xd5\xd7\xf6\x55\x19\xb5\xca\x8e\x09\xbd\x34\xd7\xcc\xb5\x6f\xf3\x77\x1a\x7a\xd5\xe9\xe1\x46\x3f\xb7\x32\xe9\x39\x5d\x39\x48\xd6\x99\x07\xd4\x40\xfb\xc3\xe4\xf8\xfe\xd0\x35\x40\x53\xf7\x7d\x30\x44\x4d\xda\xad\xc3\x3f\x75\x78\xd6\xed\x88\x43\x35\xe1\x11\x4f\x1c\x47\x7c\x57\xe2\xe3\x4c\xc5\x4d\x26\x79\x95\x60\x0a\x6c\xf3\x7e\x3f\x83\x1b\x08\x68\x4d\x8e\x2e\x2b\x1d\xf7\x17\x56\x90\x7d\x73\x1e\x1d\x56\xdc\xb8\xb0\x43\x73\xb4\x26\xf7\x70\x45\xc6\xaf\x3c\xcd\xec\x31\x09\x20\x5d\xf3\xda\x0f\x7e\x84\x9e\xa4\x63\x1b\x53\x5c\x59\x73\x9a\xe4\xf5\xa0\x27\x19\xb8\xb8\xd2\x2c\x11\xdf\xc3\x51\xa5\xb2\x20\x4c\xe1\x90\x1d\xb6\x4a\x09\x5d\x8b\xf9\x07\x86\xf3\xb3\x84\x1b\xc1\xc3\xb5\x34\x92\xd2\x35\x37\x83\x27\x65\xd3\x88\xf4\xb1\x1b\xe0\xc2\xd4\xdc\x9d\x0a\x4b\x7e\xaf\x7b\x22\xa8\x61\x54\x41\x40\x62\xeb\xaa\x9c\x84\x33\x86\x12\x18\x43\x86\x94\x10\x49\xb6\x34\x40\x70\x01\x10\x24\x6b\xa6\xeb\x69\x9f\x8a\x4d\xce\x2c\x7c\xbe\x9d\xc1\x7e\x06\x25\xb4\x58\x6c\xc1\x6c\xbf\x5f\x2b\x01\xcc\x3d\x97\xba\x54\xc2\xed\x60\xc0\xd3\xb2\xdd\x6e\x0b\x36\x94\x05\xd6\xf5\x62\xb1\x5d\xd2\x86\x4a\x1f\xe2\x76\x3b\x53\x74\xb8\xcf\x74\x7d\x68\x3d\x36\xdf\x2b\xb6\x28\x2f\xd8\x3d\xde\x63\xb8\xaf\x9f\x55\x0c\xc0\x33\xcd\x89\xe5\x79\xb6\x3b\x9a\x8c\x4c\xcf\xb3\xb0\x6f\x7e\x66\x25\xc1\x47\x12\x3c\x5e\x5f\x2d\x89\x71\x4f\x05\x0c\xe3\x67\x50\x2f\x1b\x94\xc4\xc4\x7e\xf0\x29\x57\x4a\x8d\x2b\x40\x8d\xe2\x73\x5d\xcf\x55\xb8\xb8\xd7\xf3\xc9\x4b\x1d\x96\xa0\x85\xc3\x7b\x3a\xca\x3b\x92\x9a\x27\x8d\x9a\x3a\xf5\x96\xdd\x7a\xf9\xea\xca\x07\x5c\x44\x08\xf0\x9c\x2d\x82\xce\x03\xaa\xd6\xf2\x5c\x5d\xfc\x53\x6a\xaa\x56\xdc\xbc\xf5\xab\x9e\xc3\x7e\x2b\x4b\xd5\xbc\xf9\x9a\x9f\xbd\x7c\x2e\xd7\x19\xcb\x0b\xcf\xa3\xf6\x8d\xe7\xd6\x1c\xa9\xbc\x5c\xf0\x33\x22\x22\xae\xe7\xb5\x4a\xaf\xbd\x5c\x80\xac\x22\x76\x28\x89\xd8\x97\x18\x17\x1f\x68\xb9\x27\x87\x17\x9e\xc4\xe6\xb3\x54\x2e\x2f\x3e\xd4\x2e\x2f\x06\x03\x45\xe5\xbb\x5d\xfa\x5c\x39\xb1\x98\x0b\x19\xb9\x94\x2a\xfd\x0c\x59\x18\xfb\x19\x32\x49\x75\x29\x12\xac\xbf\x52\x5f\xde\x9e\x83\x5f\x70\x32\xe7\x2f\x52\x02\x7f\x97\x78\x4f\xea\x9b\x75\x70\x5b\x57\x2c\x0a\xf2\xe6\x2e\xc9\xd5\x25\xbb\xbc\x71\x07\x2f\x6f\xdc\x54\xfc\x8c\xc5\x03\xdf\xef\x3f\x23\x55\x63\x32\x88\x8b\x00\xa5\x70\xcd\xd1\x67\x5d\xd3\xba\x53\xc2\x1a\x8f\x3f\x47\xc2\x1a\x4f\x40\xc2\x9a\x38\xfc\xdf\x11\xff\xd7\x6d\x4b\x5b\x96\x74\x59\xf7\x99\x54\xe3\xe0\xe6\x05\x7e\x71\x59\xd9\x72\xab\xcb\xca\x70\x05\x17\x0a\x1d\x4f\xb1\xf1\xf7\xd3\x5f\xf8\xbd\x5b\x71\xed\xd6\x1e\x8b\x6b\xb7\x53\x30\xe4\xe7\xbe\x1e\xb8\x11\xbf\x0d\x26\xfc\x5c\xe8\x23\x57\x50\xbc\x05\x36\xfb\x29\x1a\x7b\x60\x8f\x94\xa2\x89\x89\xc9\x8d\x58\x6b\xee\xc4\x9d\xc0\x0b\x31\x8b\x3f\x72\x62\x25\xb7\xc2\xbd\xd9\x07\xb1\x82\x9e\x8a\x15\xf5\x0d\x38\x1c\xb0\x30\x39\x87\xbf\x36\x26\x27\x10\x6e\xc3\x1d\x54\xbe\x82\xbd\x85\xe4\x0e\x26\xdf\xd1\x73\x23\x26\xef\xe8\xaf\x46\x4c\x7e\xa0\x6f\x8c\x98\xbc\xa6\xf2\xea\x34\xf9\x9e\xe6\xc6\xdf\xde\xbe\xf9\x9e\xbc\xa0\xdf\xeb\xfa\xf7\x06\xcc\xa1\xf4\x32\x89\xef\xc8\x2b\xba\x41\xda\x85\xf0\x5f\x8e\xc9\xb7\xf0\xb3\xcc\xce\xa5\x4b\x0d\x0d\x93\xbf\x3e\xe4\x68\x86\x9c\xd1\x2d\x12\xd2\xf6\x30\x67\x97\x49\x51\xe6\x77\x1a\x26\x3f\xd6\xc1\x40\xa5\xbf\xc3\xcf\x6c\x33\xac\x43\x7e\x3b\x62\x51\xe4\x97\xae\x3d\xc8\x6b\x5d\xef\xf7\x4f\x8c\x98\xfc\x4c\x73\xe3\x1f\x55\x1e\xf2\x13\xed\xff\xbc\xdb\xf5\x7f\xae\x33\xb7\x7f\x19\x71\x22\xae\x11\x92\x97\x60\xc6\x14\xd6\x07\x84\x8a\xb9\x4d\xfa\xf4\x14\xbd\xfb\x84\xaf\xbd\x77\x7c\xaa\x56\x57\x3f\x2b\xf2\x9e\xf0\x9b\x9e\x7b\x0c\xff\xe2\x79\x37\x7f\xfe\x0e\xfd\x06\x2a\x89\x5c\xd9\x33\xfe\x06\x96\x69\xef\x44\x22\xb8\x57\xcb\xfa\x94\xfe\xa6\xeb\xef\x20\x21\x88\x8c\xfe\x3b\xf2\xf7\x63\x65\x17\xfd\x11\xd4\x73\xa7\xe8\x75\xdd\x33\x25\x29\x94\xc0\x90\xe0\xa6\x09\xf9\x27\xfd\xa5\x63\xe7\xf9\xda\x90\x32\x56\xc7\x1e\xed\x28\xf5\x03\x7b\xd0\x26\x5f\x7e\xbd\x27\xdf\xd0\x07\x8e\xb8\x19\xe5\xdd\xf9\x06\xfd\x5e\xf5\xf0\x86\xf3\x4f\x7a\x2b\x7c\xea\xdd\x80\x45\x53\x86\x7e\x24\x25\x9e\xa3\xd4\xa8\x4f\x13\xe7\x08\x5c\xba\xbe\xc2\xba\xce\x16\xaf\x96\x8b\x12\x74\x66\xe2\x1b\x85\x5b\x8a\x29\x3d\x45\x6d\xa7\xae\x1f\x90\xc9\x15\x25\x18\xfb\x22\xef\x6e\x07\x03\xfb\x8a\x7c\x40\x16\x18\xe9\x61\xa2\x0a\x30\x31\x79\x29\x1a\x8a\x7d\x39\xfa\x7b\xf2\x8f\x56\x2f\xf0\xfd\x4d\x5b\x72\xcb\xe9\x35\x2a\xe9\x47\xbe\x8b\xca\xa8\x49\x02\x9a\x0b\xbe\x3d\x0b\x9e\x67\x33\xfc\x0d\x17\xde\xf2\x45\xd6\x21\xbc\x91\x7f\x75\x80\xf8\x57\x21\xe8\x03\x21\x31\x7a\x8b\xb8\x8e\x48\x66\xea\x73\x02\xab\x86\x0f\x46\x88\x61\xee\x1a\xe4\x77\xc2\xe0\x7a\x1b\xea\x83\x85\x5e\x3f\x13\x99\x31\xff\x0e\x89\x76\x3b\x11\xf6\x4a\xe8\xed\xa0\xcf\x6c\x89\xb9\x2e\x96\xfc\xed\xa0\x87\x20\xd5\xd1\x8f\x6d\x4c\x38\x05\x8a\xf2\x4a\x5e\xde\xef\xa4\xc4\xd2\x66\xe3\x3b\x18\x2d\xd5\xc6\xb4\x95\xae\x05\xd8\x6e\xd7\x02\x94\x42\xd1\xe9\x7e\x4f\xbe\x6e\x0d\x84\x1c\xdd\x92\xa4\xf4\x07\x04\x2d\x01\x13\x8d\x05\x58\x22\x9a\x33\xa9\x8e\x7e\x1e\xcc\x30\x0c\x01\xa8\xfa\x02\x90\x34\x77\xbb\x92\xd2\x57\xfc\xdf\x62\xb7\xcb\xab\x03\x39\xd5\xaa\x5e\xbe\x27\x8c\x3d\x58\x0d\x27\x49\x92\xd3\x1f\x50\x3a\xff\xdd\xaf\xaa\x0c\xe8\x62\x49\xd6\xa0\x03\x94\x55\xae\x67\xb8\xea\x19\xcd\x17\xeb\xaa\xce\x94\x03\x00\x13\x78\xb7\x0b\xaa\x3a\x7f\x04\x4b\x37\x59\x6d\xb0\x9f\xfd\xb2\xdb\xa1\x04\xa1\xd7\x75\xe5\x9d\xde\xe4\x5e\x1f\xed\xb2\x84\xdf\xd3\x7a\xa7\xd5\x90\x87\x60\xb3\x05\x8d\x67\x34\xaa\x4f\x1d\x65\x3b\xcd\x86\x25\xae\xb9\x14\x5a\x0d\x80\x53\xb5\x00\xdc\x73\x28\x4a\x12\xd6\x94\xbf\xc3\x5c\x6c\x10\x4a\xf5\x75\xf1\x6a\xc9\xc9\x4c\xfe\x00\x1e\x03\x93\xed\xa5\xa0\x32\x3e\x93\xc0\x52\x55\xf5\x58\xd7\x7f\xd2\xf5\x97\xe8\x37\xc2\x8e\xfd\xd2\xc0\x99\x7f\xb9\xc7\xe4\xef\xa0\x51\x69\x78\x00\x24\xea\x4a\x6a\xc3\x91\x98\x62\x18\x42\x56\xda\x63\x72\x6e\xc4\xf4\x6f\xe4\x57\x23\xa6\xdf\x90\x14\x8d\x2c\x0c\x17\x65\x8d\x98\x7e\x4d\x52\x64\x79\xf0\xeb\x5f\xe4\xc4\x88\x29\x63\x24\xe0\x37\xf8\x2d\xb8\xc9\x9f\xa0\xdf\x88\xd6\xb5\x1e\x69\xe4\x5f\x30\xbd\xc8\xca\x88\x69\x07\x47\xfb\x3b\xda\x00\x25\xec\x31\x59\xa3\xb5\xf1\xcd\x60\x6d\xfc\x3c\x58\x1b\x67\x4f\xfa\xbf\x90\xfb\x0a\x1b\xff\xf5\xbe\xe6\x06\x25\xa3\xda\x55\x50\x7c\x2b\x30\x25\x49\x71\xc2\x8d\x65\xde\x6e\x72\x16\x44\x50\x1f\x91\x5c\x96\xf0\x7b\x98\x24\x67\x9b\x75\x10\x32\x52\xb0\x20\x0f\xaf\x48\xb1\x61\x61\xc2\x0a\xc2\x0d\x73\x49\x63\x4d\x25\x8d\x0b\xbb\x64\x9b\x16\x61\xb6\x81\xe2\x8a\x96\x63\x97\x94\x51\x73\x56\x4a\xb1\xf1\x79\xca\x66\x78\x83\x4a\xb6\x48\x19\x90\xaa\x6a\x65\xce\xe8\x5b\xb4\xa9\x9c\xe8\x62\x92\x41\xa6\x5c\x65\xca\xd8\x0c\x5f\xa1\x9c\x2d\xb2\x2a\x13\xf4\xfb\xad\xec\xb3\xa0\x47\x8d\xc0\x66\xaf\x6b\x05\xc8\xd0\x19\x61\x03\xaa\x69\x78\x7e\xb6\x60\x4b\x1f\xfe\xa1\xaf\x01\x6a\x70\xe9\x70\x76\x90\x09\x0e\xb5\xff\x59\x9b\x11\x3c\xa0\x5d\xa8\xd6\x9e\xbe\x56\x77\xa0\x84\xcd\xe2\x19\x6c\x1d\xcf\x80\x71\x37\x6f\xc6\xed\xc9\xb6\x60\x6f\x19\x98\xc5\xd4\x55\xe1\xfb\x9f\x28\x1c\x0c\x43\x54\x72\xbd\x59\xb3\x83\x28\x6b\x2f\x00\xae\x3b\x2a\xee\x0e\x93\xfb\xea\x10\xc2\xef\x12\x60\x6b\x5b\xbc\x72\x7e\x0a\x3e\x15\xfe\x81\xe0\x0f\x5c\xaf\x27\x6d\x4b\x19\xff\x9b\x76\x00\xa8\x03\xfe\x41\xda\x56\x50\xaf\x58\xe5\x61\x20\xcb\xfd\xbf\x1d\x44\x71\x85\xba\xff\x35\xe9\xb4\xb5\xf2\x19\x5c\x1a\x83\x61\x09\x18\x6d\x09\x2e\x27\x46\x8c\x60\xe9\x6b\x60\x18\xb0\x46\xd7\xba\x8b\xeb\x40\x15\x0a\xaa\xcc\xba\xf7\x98\x7c\xaf\xeb\x75\x79\xa8\xff\xcb\x6e\xd7\xaa\x14\x1a\xc2\xe8\x6b\x24\xf9\x9f\xb6\x80\x7d\xd9\x52\xeb\xd3\x17\x08\x4e\x64\x76\x3b\xed\x7e\xcf\x7f\xdd\x07\x3e\xdb\x37\x7e\xab\x6b\xdc\x18\x56\x65\x0d\x44\x4f\x8d\xdc\x2b\xa9\xb3\xd5\x30\x45\x09\xa0\x41\xa3\x70\x7e\x9b\xc1\xbd\xa5\x43\x36\x98\x81\xf6\xe4\xc0\x2e\x03\xd6\x61\xee\xb2\x3c\xa5\x25\xb7\x6b\x27\x08\x4c\x00\xa5\x71\x0c\xb8\x5a\x80\x35\xf5\x9f\x0d\xff\x78\x97\xf2\x72\xd0\xd1\x32\xd9\x21\x80\x82\xd3\xf5\x52\x3a\xa7\x13\x1c\x12\x64\x83\xfe\x3f\x51\xa9\x4a\x04\x0e\x08\x75\xd3\x92\xbc\x10\xda\xba\xef\x41\xae\xdb\x63\xd2\x10\xde\x16\xdf\x2e\x77\x3b\xae\x07\x6b\x04\x92\x6f\x9b\x49\x94\x5b\x3d\x12\xa3\xd7\x6a\x7e\x62\x12\x73\xd7\x42\x44\x83\x7f\x35\xce\xe1\x62\x24\xc4\x79\x31\xb2\xfd\xae\xc3\x13\xb9\xd3\xb1\x26\x18\x69\xd7\xac\x0c\xa4\x77\x3a\xe1\xa1\xc9\xad\xf6\x3c\xdc\x97\x19\xec\x74\x95\x76\x20\x29\x4e\xe1\xf2\x01\x37\x95\x6e\xe8\x06\x24\x09\xc1\xec\x0b\x1f\x74\x6c\x5d\x20\x51\xca\x26\xe7\xe7\x40\xa2\xa8\x2c\x2d\x10\x48\x68\x7b\xd8\x45\xa9\x5c\x0c\xdf\xc3\xa9\x54\x2e\x05\xec\xfb\xc4\xd7\xde\x68\x83\xde\x60\x90\x90\x5b\xee\xd6\x04\xfa\xd5\xf4\x7c\xfe\xf7\xd3\x5f\xfc\x9c\x7c\x7f\x7a\xfa\xca\xef\x5b\x24\x0e\x8a\xf2\xef\xec\xce\x3f\xc2\xb2\x9f\xd5\x90\x1f\x0b\xbc\x73\xe6\xa3\xe3\xab\x14\x73\xed\xad\xe6\x6b\xe7\x1a\x1e\x30\x20\xa9\x7e\x00\x2d\xc3\x9c\x34\xfa\x45\xa3\xb8\x33\x8d\x47\x4b\xed\xb2\x76\xaa\xcd\xc0\x26\x56\xea\x70\xc1\xda\xd4\x48\xf6\x30\xc1\x7f\x66\xc1\x87\x8e\xb6\x3d\x50\x70\xdf\x6c\x95\xdb\xb7\x8e\x8b\xbd\xdd\x93\x2c\x3d\xcb\x19\xfb\xbd\xc9\xc5\xd4\xd8\x87\xba\x1e\x1b\x30\x36\xba\x0e\xe5\xea\xba\xe8\x83\xae\x43\x49\xa4\x73\x53\xae\xa8\x44\x6c\x88\x1d\x5b\x68\x51\x1e\x54\x31\x42\x8e\x52\x3a\x2d\xc9\x8c\x18\x5a\x9d\xaa\xfb\xf4\x70\x1a\x01\xc9\x48\x41\x03\x23\x26\x21\x68\x3e\xe5\x14\x0e\x67\xb8\x90\x27\x91\x6b\x9a\x2c\x42\x98\xbe\xba\x2e\xcc\xad\xd6\x4a\xcc\x2a\x3f\xdb\xff\x47\xeb\xda\x5f\x97\x1e\x4b\xb9\x62\xca\xbb\x4f\x7d\x0e\x14\xac\x5c\x06\x21\x41\xd3\x55\x24\x59\x1f\x9b\x0a\xb7\x1d\xcf\xab\x27\x22\xda\x4c\x98\x73\xfa\xf9\xc3\x51\x48\x38\x86\xf0\x17\xcb\xba\x47\xdd\xa2\xcb\x5a\xd7\xb5\x85\x70\x78\xf9\x33\xcf\xb5\xd4\xe0\x2c\x4c\xb8\xa9\x6c\xed\xf2\x1a\xfe\x35\x60\x12\xd4\x3e\x2a\x64\x59\xe2\x28\x06\xc3\xdd\x4d\xec\x57\x3a\xd3\xc7\x86\xc6\x12\x63\x23\xb5\xfa\x9e\xf0\x20\xe3\x34\x5c\x50\x57\x0e\xda\x43\xda\xd9\xdb\x7a\x35\xac\x5d\xb4\x87\xc7\xf3\x82\xf1\xcb\x1d\x04\x34\x70\xd5\x36\xa5\x68\x7a\x62\xe7\xd6\xf6\x8d\xde\xec\x93\x18\x25\x3c\x4c\xcc\x97\x5e\x86\xfa\xb9\x11\x4b\xfa\x2a\x61\x33\x58\x2e\x0f\x3b\x86\xef\x8f\x3b\xca\xb5\x35\x48\x0b\x8a\xbb\x34\x6c\x9c\x1b\x3c\x94\x2e\x5b\x15\x2c\xbf\x01\x89\x4d\xc3\x9f\x50\x36\x75\x9d\x2e\xe7\x7c\x30\x27\x13\x18\x4c\x94\x53\xee\x04\xaa\x91\x6c\x9e\xd7\xe7\xd3\x79\xf3\x8c\x56\x1d\x75\xee\x76\x9d\xab\x27\xb5\x66\xe5\xb1\x39\x63\x29\x1d\x02\x36\xee\xde\xc2\x0d\x04\x99\x2d\x07\xf1\x2b\xc5\x02\xb7\xc7\xdd\x5f\xa4\x9c\x95\xf0\x0b\xbf\x14\x0c\xeb\x6b\xde\xd4\x41\x3d\xf5\x1c\x55\xdd\x49\xd1\x64\xfa\x39\xca\xbe\xc9\xc1\x41\xaa\x85\x0d\xd1\xbe\xa0\x28\x92\xcb\xf4\x11\x52\x75\xf1\x2c\x47\xb9\xf1\x76\x90\x1b\x67\x0d\xe9\xa8\xca\xe7\xa7\x68\x6a\xe2\xfd\xa7\x40\x93\x85\x09\x0f\x3b\x96\x23\xdc\xf2\x09\xb5\x32\x4c\x87\x44\x68\xee\x0a\xe1\x44\x5f\x11\x7e\x55\x51\x83\x41\xf5\xc3\xdd\xee\x70\xa5\x84\x0a\x18\x85\x9b\xff\xf0\x4f\x4a\x85\x7b\x69\xd8\x16\x6b\xc1\x2a\x8c\x58\x7c\x79\x95\xfc\xfa\x61\x7d\x9d\x66\x9b\xdf\xf2\xa2\xd4\x24\x5f\x04\x0d\x30\x9d\x90\x5c\xee\x13\x34\x6c\xc4\x59\x7e\x1a\x84\x57\x75\xf1\xc0\x01\x40\x50\x87\x67\x58\x26\x7d\x1a\x82\x92\x8b\xe1\x45\xba\x54\x4f\xe7\x80\x7a\x18\xf1\xf0\x12\xcb\xab\xc8\x1a\xee\xd3\xfc\x40\xa7\x55\xd3\x56\x4a\x13\x98\x99\xe1\x91\x09\x12\xd9\x52\x8b\xc4\x9c\xd1\x47\x74\x6d\xc4\xfc\x24\x48\x2d\x05\x1b\xb2\xa2\x45\x43\x5a\xe3\x47\x48\xe4\x8a\xc6\xf3\x0c\xad\xd4\xdd\x83\x18\xad\xb8\x02\x9c\x3b\x39\xb9\x92\x05\x5f\x52\x73\x76\xfd\xfc\x72\x86\x37\xf4\x6a\x71\x09\xea\x16\xf0\x48\x17\x55\x84\xb8\x22\x1b\x90\xe1\xd2\xc5\x66\x49\x57\x8b\x4d\xbd\x3f\x4f\xf7\x7e\xf8\x09\x74\xff\x4b\x6d\x44\xa6\xb6\xb2\x11\x99\xba\x18\x37\x66\x6b\xb3\x0d\x7c\xa5\x6f\xad\x54\xf5\xa2\xdf\xe3\xb2\x46\xfb\x8a\x77\x2d\x3c\xcb\x44\xed\xd2\x2a\x48\x16\x4b\x92\xc3\x45\xab\x0c\x1c\x19\xae\xc5\x71\x02\x77\x5c\x24\xc7\x1f\x0e\xfc\xda\xb7\x6b\x19\x9e\xf5\x51\x4e\xe1\x40\x8f\xbb\x16\x44\x18\x1b\xa0\xb1\x87\x69\x2d\xfc\x9b\x24\xf2\x05\x8a\x3e\xd8\x2f\x0a\x38\xb8\x49\xd5\x0c\xaa\xc3\xb3\x9a\x05\x67\x50\xfd\x9a\xb2\x7d\x9c\xa4\xc1\x7a\x7d\xc7\x1f\xa3\xe8\xe7\x70\x37\xaf\x6a\x77\xfd\x0d\x61\x95\x08\x2c\x49\xc5\x26\x71\xad\x0e\xbe\xd3\x3d\xef\xda\xac\xeb\x5e\xa7\xf6\x6d\x7a\x13\xac\x93\xa8\x17\x94\x25\xbb\xde\x94\x70\xa1\x2e\x62\xd5\x51\xd2\x36\x67\xdc\xe2\x9f\xef\xc4\x57\xeb\x5a\x69\xd9\x79\xee\xdb\xc9\x93\xa6\xce\xe7\xf0\x24\xdb\x83\x43\x07\x30\xf4\xa8\x0b\x49\xd1\x74\xd4\xc1\x4c\x14\xff\xb0\x85\x51\x47\xfb\xcc\x5b\x1c\x51\x35\x78\x04\x3f\xbc\x48\x8a\x6f\x45\x1f\x3a\x84\x2d\x45\x11\x92\xd0\xd5\xfe\xa6\xe4\xf7\x77\x9b\xfe\x2a\x2b\x67\xa8\xc1\xe1\x4d\x5d\xee\x34\xeb\xb3\xc7\x64\xfc\x27\xc6\x64\xf2\x19\xce\x06\xa7\xd3\xa6\x14\xc7\x07\xe0\x92\x95\x72\xf1\xed\x18\x01\x10\x65\x66\xdd\x06\x0d\xe5\x63\x2a\x07\x49\x18\x0d\x73\x06\xf0\x43\x28\x04\x27\xbc\x7f\xb8\xa9\x7f\x0c\xbe\x46\xeb\xbf\xe3\x0e\xde\x5b\x7d\x10\x2e\x2b\xfa\xb5\x72\x83\x71\xdc\xd8\xa1\xa7\xd1\x60\x01\xd2\xd8\xf2\x73\x71\xf2\xcc\xcf\xc1\xc9\xb3\x1e\x5a\x4f\x61\x29\x78\x78\x04\x2c\x69\xce\xe1\xc0\x2b\x01\x9e\x2d\x9d\x9c\xd7\x19\x14\x03\xad\x8b\x50\x41\x4a\xb0\x7c\x8c\x1c\xe4\x59\x76\x35\xae\x2d\xa2\xe8\xe2\x79\x28\x13\x2d\xaf\xae\xae\x33\xb5\xa8\x81\xd6\x80\x5b\x9a\xac\x61\xed\x83\x6b\xc3\x44\xc9\x01\x4f\x82\xe6\xe2\x0b\xdd\xdf\xe3\x5a\x36\x58\x77\xd1\x40\x9d\xa1\x6c\xdc\x28\x53\xd1\xd2\x9a\x48\x70\xc7\xc3\xf7\x41\xa4\x75\x0f\x3e\x78\x23\xa4\xb6\xfa\x01\xa3\xeb\x83\x97\x42\x5a\x91\x24\x5d\x58\x4b\x7c\x60\x4b\x74\x9c\x82\xa4\x0b\xbb\x21\x85\x29\x53\xa1\xb4\x71\x3d\x36\x6f\x6a\xb9\xfa\x7d\xc4\xe6\x52\x3d\xe8\x9b\x58\xd7\x0f\x7b\x0e\xaa\xec\x7e\x6d\x39\xd5\x8e\x06\xab\x27\xb9\xb8\x64\xf5\xbb\x0c\x60\x0d\x3f\x1b\x0c\x82\x67\x19\x37\x17\x29\x11\x58\x0b\x90\x80\xd4\x8b\x5c\x20\xe6\xde\xd0\x02\x3e\x9f\x35\xd4\x97\x60\x48\x1f\xa8\xa2\x66\x83\x41\xfe\x2c\xe0\xa5\x70\x29\xb3\x61\xc3\x95\xb7\x8a\x28\xf1\xf3\xa1\x55\x77\x32\x6b\xae\xe5\x70\xa1\x50\xc5\x04\x87\x92\x8c\x6a\x35\x58\x48\xa7\xe0\xed\x93\x4b\x55\xfc\x5e\x5f\xae\x4e\xed\x7b\x79\x5d\xc4\xfa\xb0\x88\xa1\x45\xf2\xba\x9c\xea\x9c\x68\xb1\x84\x7b\x2c\xcf\xf2\x99\xb8\xd2\xcf\xfd\xc3\xcd\xd6\xc0\xa1\x75\x5d\xbb\xb8\x58\x67\x51\x50\x5c\x5d\x70\x05\xf1\x55\xb6\x8e\x58\x7e\x71\xa1\xf5\x29\x5d\xef\x76\x08\x92\xd2\x87\xd2\x90\x80\x9f\x36\xd1\xfa\x05\x99\xa0\x6e\x5a\xd2\xe8\xf7\x07\xd8\x08\xfe\x4b\xb8\x83\x55\x29\x8a\x3a\x45\x1f\xf5\x21\x4d\xd7\x2e\xb9\xdf\x7f\xa9\xeb\x2f\x61\x2b\xc0\x60\x53\x08\xe2\x40\x33\x11\xf4\xa8\xa4\x90\x79\xfe\x8d\x64\x9d\xbe\x26\xc5\x52\xb5\x33\x95\x97\x5d\x61\x6f\x5a\xee\x76\x2a\xfc\x1b\x96\x56\x1c\xae\x95\x60\x7f\xd4\x96\xaa\x9a\xbe\xd5\x70\xd3\xa7\xeb\x1d\x0c\xbf\xf1\x7c\x04\x48\x1c\x25\x05\xb2\x1e\x68\x5a\x73\x77\x28\x06\x85\xd7\x32\xff\x87\xff\x0a\x57\x0e\x5f\x1e\x60\xca\xf7\x8d\x8d\xe6\xdf\x65\x0f\x1b\xa5\x35\xa2\xd9\x40\xd3\x1a\x31\xa2\x22\x4d\x83\x8a\xf0\xd1\xe5\xf0\xf6\x8c\x69\xd1\x7a\xe3\xfe\x08\x49\x60\x1a\x14\xca\x5c\x9d\x84\xf4\x6f\x28\x18\xae\x89\x09\xda\xb2\x4a\x62\x2c\x06\x21\x18\x1e\xf4\xf3\xd9\x60\x90\x3c\x2b\x66\x78\xbb\x48\x96\xb4\x5c\x24\xd5\x5e\x6e\x36\x18\x64\xcf\xd6\x33\x8c\xe2\xdd\x2e\x7b\x06\x6f\x09\xa0\xed\x22\x5d\x64\x4b\xb8\xfe\x9c\x89\x03\x83\x59\x08\xf4\xbe\x5d\x24\x40\x50\x8c\xd3\x95\xa4\xf7\x6d\xdd\xf4\xed\xe7\x34\x7d\x68\xc1\xe6\x5f\xfe\x2e\xa0\xfd\x61\xdd\xfe\x2d\x6f\x7f\x02\xed\x8f\x45\xfb\xb7\xd0\xfe\xa8\x6a\x7f\xf6\x6c\x3b\xc3\xf1\x22\xe3\x8d\xa8\xf7\xa2\x1b\x9a\xcd\x06\x83\xe2\x59\x08\x91\x9b\x41\x01\xdd\x2b\x54\xf7\xd6\xcf\x92\x19\x46\x91\xea\x1e\x24\x81\xab\x34\xb2\x27\x6a\x7d\x8f\xeb\xae\xc4\x35\x89\xd7\xac\x4c\x12\x9a\xda\x98\x48\xeb\xd0\xf2\x01\x7e\xfe\xc8\x7b\x4f\x1d\x8c\xbc\x8e\x21\x65\x07\x17\x3f\x88\x26\x25\xb0\xf0\x2a\xcd\xe8\xb1\x34\xa4\x5c\x38\x32\xa1\xfb\xc9\x84\xa4\x5c\x8c\x64\xea\xf1\xe7\xa5\x26\xe5\xc2\x95\x59\x26\x7f\x20\x0b\x29\x17\xe3\x25\x16\x2f\xba\x26\xa8\x61\x9f\x09\x1b\x5a\x69\xbf\x9a\xd6\x47\xca\xbd\x0f\x28\xc7\xf0\xb6\x4e\x83\x4d\x45\x92\xe6\x48\xc6\x9d\xa1\xac\x2a\x87\xf3\x50\xe8\x0d\x3c\x20\xa5\x97\xe4\x8e\x5a\x7a\x49\x3e\x52\x5b\x2f\xc9\x2d\xb5\x47\x7a\x49\x3e\x50\xd7\x82\x9f\xa7\xf4\xe3\x5c\x18\x33\xc7\x0d\x21\x59\x95\xfe\x06\xd5\xa4\x7c\x7e\xbc\x89\x3d\x11\x24\x7a\x0e\x36\x35\xe7\xb3\x5f\x61\x92\x9c\x2c\x7e\x6d\xba\x5e\xfa\x95\x5f\x85\xbc\xc5\x50\xc2\x5b\x7a\x85\xde\x80\xbd\x4d\x80\x4e\xc8\x5b\x7e\xfc\x90\xeb\x3a\x3a\xa1\x21\x3a\xe1\x1d\xb8\x05\x97\xa5\x3c\x64\x8b\x4e\x78\x77\x20\xe4\x7c\x48\xbf\x23\xb7\xba\x7e\xfe\x4c\x74\xec\x1d\x5d\x57\x05\x88\xe6\x6e\xf8\x18\x44\xe4\x8d\xd1\x58\x01\x48\x4a\x4e\xc8\xbb\x6a\x3c\x86\xe7\xd5\x30\xff\x40\xef\xe6\x29\xf7\x95\x41\x5e\xd3\x8f\xf3\x1f\xe0\x70\x8e\xc9\x52\xce\xe9\x89\xec\xd7\x6a\x7e\x42\x2f\xd0\x09\x59\x61\x1f\x1c\xc2\x83\x97\xa8\x13\x03\xb4\xf2\x39\x77\x78\x7d\xa3\xeb\xd7\xcf\xce\xa1\x9d\x22\x03\xbd\xae\xee\xee\x54\xb7\x81\xc0\x46\x40\xd7\x0f\xcf\xb5\xdf\xe8\x3a\x7a\x4d\x4f\x77\xbb\x18\xbd\xc6\x70\xc0\x51\xc1\xfb\x03\x39\xc1\x0d\x38\x37\x0d\x38\xb9\x81\x3a\xf8\xb7\xa9\x7a\xbd\xa5\x53\xbd\x9c\x95\x3b\xba\x9d\x3b\xb6\x3f\x1e\x91\x91\x8e\x4a\x9d\xfe\x6f\xb4\x9d\x8f\x47\x3e\xf8\x4a\x85\x8b\xd3\x3a\x1d\x8e\xaa\x83\x30\xb0\xb5\x82\xc2\x32\xb2\x9d\x07\xe2\xf0\x9b\x6c\xe7\xeb\xfa\xab\x40\x3e\xa8\xbf\xca\x0a\xe5\x98\xc4\xcd\x11\x05\x07\xa7\x0c\xc5\xfc\x24\xa7\x6e\xf1\x8a\xb7\xb8\x6a\x6f\xab\xb5\xb6\x5e\x02\xc2\xfd\x6d\xf7\x52\x84\x3b\x77\xac\xa7\x1f\x37\xdc\x8d\x4c\xc3\x9e\x5a\x9c\xf0\xaf\x68\x36\x97\x4e\x35\x7c\xae\xf0\x5f\x89\xfe\x7a\x13\x92\xd1\x40\xec\xe3\x41\xb5\xa4\x4e\x24\x8b\x79\xe1\xff\x0d\xbd\x41\x05\x06\x86\x1a\xd6\x11\xe1\x3c\xf4\xdf\xa0\x10\x93\xd5\x90\x06\x73\x79\xd9\xcb\x37\xc9\x78\xa4\x0b\x99\xf9\x9a\x66\xe4\x92\x06\xb3\xba\x68\x4e\x42\x37\x74\x51\xf7\xf7\x9a\x5c\x56\x03\xc6\x89\x9c\xd1\x1b\x90\x2f\x4b\x7a\x03\x13\x3e\xa7\x37\x0b\x7b\x49\x32\x7a\x03\x93\x3e\xa0\x37\x8b\xd1\x92\xf4\x51\x48\x6f\x16\xde\x92\xc2\x2a\x4d\xf9\xd7\xf9\x76\x6e\xfa\x72\x7d\xf0\xff\x86\x20\x6c\xb8\x22\x26\x58\xb2\xc0\x5c\x85\x83\x34\x9d\x0e\x6d\x17\x93\x52\xd7\x2d\x10\x31\xef\xe8\x94\xcb\x09\xd6\x18\x0e\x5d\xe5\x38\x55\x38\x88\xbb\x6d\xb4\x73\x3a\x07\x8d\xe9\x9c\x1c\x4f\x67\x71\x2a\x80\x12\x18\xab\x84\x6c\xe9\x15\x0a\x70\xb5\xf4\x15\x8b\xb0\x39\xab\xc3\xa5\x20\xb1\xe4\x99\xa3\xeb\xc5\xc2\x5c\xf6\x29\xbc\x94\x52\x2c\x92\xa1\xc5\xbf\x83\x5f\x96\x35\x2a\xc8\x56\xb6\x02\x25\x43\x1a\x8b\x9a\xf0\xb3\x7c\x2e\x27\x6d\xd0\x9a\xb4\x82\x36\x0b\x12\x8b\xdb\x08\xf2\x4f\x3e\x4c\xb0\x9f\xa2\x4f\xcd\xb2\x60\x9e\x81\xa7\x1a\x98\xe1\x05\xd7\xd3\x93\x92\x84\xd8\x77\xec\x3e\x88\x93\x8e\xd3\x87\x71\x93\x78\xcf\x23\x31\x09\x65\x55\xed\x7b\xa2\xc0\x92\xc4\x55\x11\xce\x41\xd7\xdd\x83\x5a\xb6\x06\x95\x8b\x23\x47\x23\x1b\xc2\x32\xbf\xa5\x92\x7e\x1b\x6b\x7b\x01\x6b\xfb\xa7\x7a\x55\xce\xd7\x3e\x9b\x0d\x06\x61\xb5\xfc\x87\x4b\x9a\x2d\x42\xb1\xbe\x17\x00\x4f\xcc\x4f\x84\x1a\x00\x0d\x06\x49\xed\xb9\x05\x45\x24\x98\xe7\x15\xdf\x8b\xe5\xa8\x40\xe7\xaa\xfb\x7f\xd0\xc3\x3b\xda\xbd\xd3\x84\x7e\x67\x0f\xf7\x5b\x40\xfb\xe9\xf6\x67\x3e\x93\x0e\xde\x72\xc9\x82\x55\x6b\x55\x93\x54\x25\x25\x43\x77\x07\x7c\xa6\x79\x12\xc3\x9a\x34\x53\x27\xb9\x6e\xee\x78\x3b\x6d\x15\xc4\x33\x73\x92\xdd\xc1\x39\x87\xd0\x9f\x89\x04\x05\x78\x36\x94\xae\x8f\xea\x92\x2f\x6b\x29\x5b\xfa\x0e\xff\xa1\x6e\xec\x1c\xc4\x15\xa1\x5a\x7e\xcd\x6f\xef\xa9\x8c\x37\xcd\x26\x29\x29\x2f\xa7\xe9\xd0\x52\xd9\x61\xbf\x86\xd2\xe7\xd6\x5c\xd3\x7b\xdc\xb9\xc0\x00\x9c\x82\x82\x6d\x74\xa5\x63\x4e\x9f\xdb\x73\x8d\x40\x14\x78\x72\x86\xcb\xe7\xbc\xf7\xe8\x1d\xd1\xee\xdf\xa7\x4f\x9f\xf4\x16\xe0\x64\x69\xc3\xa2\xde\x6d\x52\x5e\xf5\xb4\x41\x39\xd0\x96\xbd\x27\x4f\xdf\xa7\x5a\x63\xfc\xee\x0e\xb6\xb3\xa5\xe0\x41\xe5\xfc\xd0\xea\xdf\x2f\x41\x08\x96\x2e\x2f\xd4\xd1\x1d\xdb\xed\xfe\x5a\xed\x05\xf8\x3e\x87\x3d\x1f\x5a\xba\xce\xfe\x62\x51\x6a\xea\x3a\x7b\x56\xd6\x55\x5d\x3c\xb2\x75\xfc\x5a\x09\x8b\xdc\xcc\xea\x00\xa6\x8e\x6d\x22\xa7\xf2\x12\x58\xbd\x98\x33\x39\xc6\x72\xc7\xa8\xdc\x31\xa9\xb1\x84\xcd\x44\xf5\x14\x85\x98\xb8\x30\x94\x33\xbe\x25\xbe\x43\x01\x49\xf1\x3c\x03\x83\x7c\x81\xef\xb1\x5b\xaf\x8f\x2d\x72\x69\x37\xae\xee\xcf\xd0\x22\x19\x6d\xea\x03\x78\x83\x32\x5d\xef\x5b\x7d\x5a\x77\x49\xcc\xa2\x8c\x6a\x17\x86\x36\x80\x0b\xe3\xb3\x52\x07\xf5\x03\x7f\xbb\x0f\xb6\xf3\xa0\x3d\xe7\xca\xe3\x0c\xef\xf9\x6e\x16\xcf\xf0\x6c\x8f\xde\x02\xcc\x45\x96\x97\xa8\x01\xe0\x6d\x7b\x66\xc2\xba\x3b\x3d\x60\x92\x9d\x7f\x4a\x9a\x4a\x7a\xaf\xe9\x3c\x6f\xce\x20\x7a\xdb\xfc\x45\x1a\x33\xea\x43\x4d\xf7\x92\x0a\x44\x09\x7d\xb8\x84\x82\x1a\xe7\xbb\x07\xcf\xeb\x34\x1a\x7e\xda\x9c\xb9\x73\xc4\x1e\x72\xae\x52\x53\x9a\x54\x5d\x70\x15\x7f\x33\xf5\x43\x76\x09\xdd\x5b\x73\xd6\xf5\xb6\x3f\x50\x48\xe3\x50\xb8\x3a\x42\x82\x7d\xb5\xda\xa0\xef\x1b\x87\x0c\xdf\x07\xdf\x43\x1b\x60\x1c\xe4\x40\xb4\xba\x29\x0a\x95\x06\x28\x73\x65\x8a\x82\xb0\xcf\x66\x8c\x7e\x40\x25\x9e\x97\x03\x4d\xf3\x4b\x38\x77\x95\xb6\x13\xfd\xa3\xae\x82\x54\x02\x57\x6c\x07\x90\xab\x9e\xe3\xdf\x11\x4d\xc8\x3f\x29\x7d\x21\x67\x9f\x04\x31\xdd\xed\xbe\x95\x61\xf3\x33\xc4\xc4\xf9\xb4\x8d\x49\x3a\xb7\xfd\x29\xf6\xbf\x57\xb1\xdf\x07\xdf\xfb\x83\x4a\x45\x41\x29\xb5\x9e\x9a\xbb\x1d\xd8\x7a\x0e\xad\xa7\xe6\xdc\x32\x26\xde\x64\xec\x39\x96\x33\x9a\x8e\x6d\xc7\x72\x27\xcc\x31\xa7\x4f\x10\x7b\x66\xce\x87\x96\x6f\x61\x9f\x55\x6d\x33\x7d\xd9\xca\x06\x6b\x7c\x53\x93\x08\x00\x0d\x17\x39\xfe\x52\xf3\x36\x10\x50\xd2\x79\x39\x4c\xfd\xd2\xaf\x04\xa8\x73\x72\x42\x7e\x25\x6f\xe9\x62\xa1\x05\xf9\x9d\x46\x2c\x7b\xba\x24\x0b\x0d\xbc\xc8\x69\xc4\x92\x5f\xff\xce\xee\x34\x62\xc3\xaf\x70\x9b\x43\xb2\xa9\xfa\xfe\x03\xbc\x87\xa8\x11\x6b\x0c\x21\xf1\x3a\xd9\x68\xc4\xb5\x78\xd2\x4d\x90\x97\x09\x3c\x9b\xe8\x34\x7f\x8a\xf4\xe3\x11\x84\xe5\x2c\xc8\x2f\x35\x62\xbb\xe3\xe5\x92\x7c\x47\x9f\xfe\xfb\x7d\x31\xd8\xbd\x2f\x06\x5f\x3f\xbd\x24\xef\xe8\xd3\xf7\xf7\x68\xee\xbf\x4f\xdf\x3f\x7d\xff\xa4\xf7\xbe\xcd\x56\x8d\xc1\xfb\x65\xef\xfd\x93\xf7\x4f\xf1\xfc\x7d\x3a\x7f\x4a\x7e\x80\xd4\xdd\x49\x91\x31\xc0\x3c\xf1\x53\xf2\x9a\x3e\x25\xf3\x9e\xde\x7b\x4a\xbe\xa7\x4f\xff\xbd\x18\x0e\x96\xe6\xc7\x85\x39\xf4\x82\x61\xbc\x1c\x7c\xfd\x34\x21\x2f\xe8\xd3\x7f\x9b\xab\x85\x69\x55\x3f\x5f\x41\x93\x24\x6d\x1a\x83\xf9\x49\x6d\xea\xfa\x7e\xf9\xf5\x53\xf2\x2d\x24\xcf\x16\xe6\x70\x52\xa5\xff\x2b\x7d\xfa\x6f\x34\xf7\xcd\xdd\xc2\x1a\x7a\xcb\xf7\xd1\x13\xfc\xf5\x53\x72\x46\x37\x41\x5e\xb0\x6f\xd3\x92\xfc\x78\x6c\x82\x51\xea\x20\x54\x96\x42\x1f\x4c\xa5\x4b\x26\x5d\x2f\xc9\xef\xc7\xa9\xeb\x47\xff\xc5\xd3\xff\x47\xd9\x20\x94\xfc\x46\x7f\xdc\xed\x7e\xdf\xed\x1e\x7c\xf1\x83\xfc\x42\x65\x5c\xbd\xcf\x25\x3f\x8b\x52\x1a\x41\x3f\xd1\xdf\xba\x5f\xe4\x24\x2f\x29\x3a\xa7\x4f\x17\xff\x36\xa0\xeb\x06\xfb\xc8\x42\xf4\x93\xae\xff\x24\x5e\x6c\xab\xfe\x1a\xf2\x3e\xd7\x6e\x07\x5e\x7a\xe6\xc2\x16\x0c\x15\x79\x88\x2f\x2c\x43\x1b\x9c\xfb\x9a\x46\xfe\x4e\x7f\xa9\xad\x54\xfe\x49\x7f\x3e\x38\xf2\x21\xdf\xd0\x9f\xeb\xf8\x7f\xd0\x1f\xd8\xe5\xe9\xc7\x0d\xd2\xfe\xad\x0d\x84\x8e\xeb\x9f\x58\xcd\xd0\xa7\x8b\xf7\xef\xff\xfd\xb5\xf1\x64\x30\x47\x78\xf1\x7e\x79\xbf\xdf\x2d\x9f\x5e\x12\xed\xfd\xfb\xaf\x75\xad\x91\xaa\x5d\xc3\x4e\xe9\xd3\xb0\xf1\x64\x8e\xe6\xf4\xfd\xfb\xf7\x08\xef\x7a\x71\x96\xf7\x8c\x81\x08\x58\x62\x28\xe8\x6b\xcb\x78\x32\xd7\xf0\x40\xfb\x5a\xc3\xe4\x5f\x72\xbc\x2a\x83\x4c\xf2\xb7\xfa\xfa\xdd\xd7\xea\xfa\x1d\x18\x78\xa3\x13\x7a\x2d\x8e\x43\x89\xd6\xb2\xba\x84\x7b\x26\xe8\x57\x7a\x6d\xa4\xc1\x35\x70\xc2\x5f\xc5\x22\xf6\xdc\x9e\xcb\x87\xd8\x30\x29\x19\x65\xac\xbd\xb3\x90\x0b\x0f\xc9\xe0\x75\xb8\xfa\x64\x9d\xa1\x96\xd5\xf2\x91\xa9\x73\xe3\x1a\x42\xdf\x12\x0f\x72\xa2\x9c\xde\xa0\x8c\x7c\x44\x97\x28\x83\x37\xce\xbb\xee\xb3\xe6\x7b\x30\x38\xf0\x8f\xf9\x3a\x5c\xc0\x6f\x2d\x58\x60\x0b\x50\x9f\x7e\xdc\xee\xb1\x50\xf7\x83\x8c\x99\xa2\x91\x83\x3b\xce\x51\xfe\x13\x27\xdb\xf2\x30\xd8\x67\x0f\x9c\x6b\xa7\xc8\x73\xe1\x24\xbb\xbc\xe2\x76\x66\x79\x56\xde\x66\x71\xb6\xcd\xf9\x83\x65\x3c\x0d\x1c\xd5\x78\x63\x48\x13\x6c\x82\xf2\xea\x8e\xe6\xb0\xc1\x12\x7e\xa8\x90\x37\xe1\x31\xc5\x15\x2b\xe0\x82\x6e\x15\x01\x06\x13\xde\x94\x47\x94\x6c\x9d\xb0\xfc\xd5\x36\x65\x34\x47\x89\xf4\xcf\x9e\x22\xcf\x6b\x44\x9f\x65\x39\x2b\xc0\xc8\xac\xa8\x12\xc0\x05\x2c\xcb\x34\x1b\x29\xfe\xca\x82\xf2\x8a\xe6\x28\xc4\xd2\xcf\x2b\xb2\x4c\xab\x91\xe0\x75\xf0\x81\x15\x49\x04\xb5\x6c\x6b\x55\x82\x65\xda\x8d\x34\x6f\x59\x20\x92\xc4\x55\x12\x7e\xa3\xcb\x74\x20\xc9\x8a\x15\x9b\x24\xa5\x39\x8a\xaa\xa8\x0d\x8f\x1a\xf1\xa8\x9c\xdd\x82\x22\x01\x6d\xe4\xa6\x1e\xa2\xdc\x2a\x0a\xf8\x34\xcd\x91\x78\x25\x11\xee\x82\x59\x26\x1f\xac\xf0\x2a\x58\x7f\xa0\x39\xba\x92\xce\xad\x21\x86\x0f\x56\x98\x45\xac\x08\xaf\xb2\x0c\xde\xbc\xb9\xae\xa2\x2f\x79\xf4\xb4\x8a\x5e\x67\x39\x0c\xe6\xa5\x78\x79\x91\x47\x79\x4d\x73\x83\x1c\xdd\x54\x71\xd5\xdd\x32\x3e\x50\x0c\x5a\x92\x70\x14\xee\xaa\x48\x7e\xe1\x0c\x1e\x93\x2e\x0d\x06\xb2\x0a\x44\x5d\x54\x51\x1f\x79\x14\x1f\x9b\x78\x1d\xc0\xc8\x7f\xac\x22\x6e\x79\x04\x1f\x91\xcb\x2c\xbb\x5c\xc3\x78\xde\x56\x51\xfc\xa2\x9a\xc5\x47\xe4\x32\x0f\xee\x0a\x78\x56\x97\xe6\xe8\x43\x15\x7b\xca\x63\xdd\x2a\x96\xb1\xb4\x08\x73\xc6\x60\x3c\x4f\xab\xf8\x37\x3c\x9e\x8f\xcc\x55\x90\x5f\x67\x69\x12\xd2\x1c\xbd\xa9\x22\xcf\x79\x24\x1f\x9c\xab\x6c\x53\x84\x59\x19\x02\xd8\xe7\x55\xec\x09\x8f\xe5\x63\x93\x14\x59\x99\x6d\xa0\x51\x27\x55\xdc\xaf\x3c\x8e\x0f\xce\x75\x90\xe7\x40\x04\x90\xf3\xd7\x2a\x96\x5f\x96\xb3\xf9\xf0\x5c\x67\xe1\x15\x98\x6e\xbc\xad\x62\xbe\xe3\x31\x56\x15\x93\x66\x1f\x02\x78\x74\xe8\xbb\x2a\xee\x1d\x8f\xe3\x83\x93\x85\x2c\x80\x4e\xbc\xab\x62\x7e\xe0\x31\x7c\x74\x36\x41\x1e\x24\x05\xf8\x0e\xf9\xa1\x8a\x7b\xcd\xe3\xf8\xf0\x6c\xb2\x0d\xcd\xd1\xeb\x2a\xfc\x7b\x1e\xce\x07\x26\x0f\x92\x75\x11\x06\x05\xdc\x01\x46\xdf\x57\xd1\x2f\x78\x34\x1f\x97\xe2\x2a\xd8\x30\xee\xfb\x89\x53\xdb\x8b\x2a\xc1\x2b\x9e\x80\x8f\x4d\x91\xad\x83\x3c\xf9\x9d\x45\x34\x47\xaf\xaa\xd8\x6f\x79\x2c\x1f\x9b\x62\x7b\x7d\xcd\xf2\x38\xdf\x26\x80\xe7\xb7\x55\xfc\x5f\x79\x3c\x1f\x9f\x32\xbb\xce\xf2\x3c\xbb\xa5\x39\xfa\x6b\x15\x79\x06\x91\x0e\x1f\x9e\x72\xbb\x82\x51\x3d\xab\x22\x7e\xe4\x11\x7c\x74\xca\xdb\x64\x2d\x68\xfc\xc7\x4f\xb1\xa4\x03\x0e\x43\x6a\x82\xbd\xaf\xdc\xed\xfb\xda\x21\x9b\xd1\x48\xb0\x2d\xaf\xb2\xdc\xd7\x7e\x0d\xd2\x5e\x69\xf4\x8a\xac\x2c\x7b\xe8\xaa\x2c\x37\xfe\xd3\xa7\x97\x49\x79\xb5\x5d\x19\x61\x76\xfd\x34\x89\xd6\x6c\xc5\xf2\x4b\xac\x91\xca\x09\xb9\xaf\x7d\x65\x7a\xa6\x63\x9a\x22\xc0\xf2\xb5\xaf\x9c\xc0\x19\x39\xb6\x08\xb0\x7d\xed\xab\x51\x30\x72\x47\x8e\x08\x70\x7c\xed\x2b\x37\x74\xa7\xae\x2b\x02\x46\xbe\xf6\xd5\xd4\x9c\x44\x93\x50\x04\xb8\xbe\xf6\x55\xe0\x06\x76\x20\xcb\x18\xfb\xda\x57\xd1\x38\x72\xa3\x91\x08\x98\xf8\xda\x57\xf1\x04\x3e\x22\x60\x0a\x29\x56\x76\x64\xcb\x76\x78\xbe\xf6\x15\x9b\xae\x56\x91\x0c\x78\x01\x59\x22\x16\x99\xb2\xd0\x97\xd0\x74\x2b\xb0\x5d\x19\x70\xe2\x6b\x5f\xad\x5c\x36\x8a\x65\x2d\xaf\xaa\x14\x26\x93\x01\xa7\xd0\x30\x6b\x1c\x78\x32\xe0\xcc\xd7\xbe\x0a\xa3\x60\xe5\x3a\x5a\x73\x1d\x51\x23\xfe\xe7\x91\xaa\x98\x7d\x0b\x9f\x34\xf9\xd0\x2b\x12\x80\x41\x40\x54\xb4\x31\xaa\x92\x14\x2d\x88\x1c\xeb\x85\x35\x16\x01\x00\x91\xf9\xd2\x19\xd9\xaf\x44\x00\x40\x64\x4d\x47\xa7\x23\x89\x08\x40\x64\xbf\x1c\x4f\xdd\x53\x11\x00\x10\xb9\x67\xde\x89\x27\xc7\x0a\x20\x9a\x5a\x2f\xdd\x17\x27\x22\x00\x20\x7a\x31\x39\x39\x3d\x99\x8a\x00\x80\xe8\x95\x7d\x3a\x39\x95\x63\x05\x10\x39\xa7\xde\x78\x2a\x53\x00\x44\xce\xe9\xc4\xf3\x64\xc3\x00\x22\xe7\x74\x74\xa2\x02\x00\xa2\xe9\xd4\x39\x55\x01\x00\x91\x37\x86\x34\x22\xe0\x15\x0f\x80\x34\x22\x00\x20\x82\x12\x54\xc0\x99\xa8\xd6\x7d\xf9\xc5\x20\x2a\xae\x58\xf1\x67\x11\xb2\x4e\x6c\xd3\x96\x53\x04\x10\x72\x3c\xe7\x4c\x01\x02\x08\xb9\x63\xf7\x74\xdc\x44\x68\x32\x9a\x9c\x4c\xe5\xe8\x02\x42\x2f\x5e\xbd\x74\x5e\xbe\x10\x01\x80\xd0\xc9\xe4\xe4\xe4\x95\x25\x02\x00\xa1\x57\x67\xa7\xf6\xa9\x2c\x03\x10\x3a\x73\xce\x46\x67\x32\x00\x10\x3a\x99\xbc\x38\xf5\x64\x80\xc7\x03\x4e\x26\x2a\x00\x10\x7a\x71\xda\x08\x00\x84\x3c\x17\x32\x89\x00\x8e\x90\x0b\x69\x44\x00\x20\x04\x45\xaa\x00\x40\x08\x4a\x50\x59\xce\x44\x80\xe7\x7e\x31\x84\x2a\xf1\xa7\x17\x6d\x53\x56\x03\xb5\xca\x83\xeb\x5e\xc4\x7a\x57\x41\x90\x2a\x5e\x27\x64\x14\x88\x33\x04\x62\x49\xf6\xb4\xb8\x4b\xcb\xe0\xe3\xf0\x2a\xb9\xbc\xe2\x6c\x38\x49\x2f\x65\xca\x61\x55\x49\xf1\x14\x0a\x6f\x22\x0a\x2f\xee\x5b\x91\x08\x00\x44\x6d\xcf\x9e\xda\x12\x2f\x40\x74\xcc\xc6\x2b\x97\x89\x00\x8e\x68\x34\x09\xc6\x72\x7a\x00\xa2\x9e\xe7\xb9\x53\xc9\xc1\x00\xd1\x60\x1c\xd8\x53\xc9\x27\x01\x51\x36\x65\xa3\x30\x16\x01\x80\x68\xcc\xe2\x15\x93\x29\x00\xd1\x68\xe2\x4c\x1c\x39\xf8\x80\xe8\x6a\xec\x8e\x2d\x49\x15\x80\x68\x18\xaf\x4c\x4b\xa6\x00\x44\xc7\x66\x10\x3a\x9e\x08\x00\x44\xad\x38\x88\xa6\x92\x3a\x01\xd1\xf1\x78\x3a\x62\xb2\x0c\x40\x74\x35\x75\x47\x8a\x3d\x03\xa2\xd1\xc8\x71\x5d\xfb\x4b\x23\x1a\x73\x91\xf5\x8b\x61\x5a\x15\xdf\x9a\xa7\x2b\xcb\xb3\x24\x44\x1c\xd5\xd0\x1e\xd9\x72\x24\x38\xaa\xd3\xb1\xd5\x46\x75\x0c\x40\x8b\x00\x8e\x6a\xe8\x8d\x3c\x99\x85\xa3\x3a\x0d\x2c\x4f\x82\xc8\x51\x1d\x33\x9b\x49\xdc\x39\xaa\x16\x8b\x99\x2c\x14\x50\x8d\x6d\x3b\x1c\xc9\x14\x80\x6a\x14\xbb\x8e\x5a\xfd\x00\xd5\xc8\xf5\x2c\x2b\x10\x01\x80\xaa\x1b\xac\x26\x8e\x6c\x3a\xa0\x6a\x9a\x41\xe4\x49\x52\x01\x54\x47\xe6\x84\x31\x49\x08\x80\xea\x78\x3c\x1e\x33\x59\x06\xa0\x1a\x3a\x4e\x1c\x3b\x5f\x1a\xd5\x2b\xd8\x66\x7c\x31\x50\x79\xe9\x07\x98\x4e\xad\x55\x7b\xa6\x3a\xb6\xa4\x7f\x8e\xa9\xe7\x46\x63\x19\xc0\x67\xea\x64\xec\x4d\xe4\x48\x71\x4c\xd9\x34\xf6\x24\x40\x1c\xd3\x95\xb7\x0a\x64\xa1\x80\x69\x34\x0d\x83\x68\xda\x16\x60\x9c\x96\x00\x13\x06\x23\xd3\x96\x59\x00\xd3\x60\xec\x7a\xb6\x5c\xfb\x00\xd3\xd5\x6a\x1a\x38\x4d\xde\xeb\x4c\xbc\x40\x4d\x6e\xc0\xd4\x72\x3d\xc7\x6b\xce\x54\xd7\x1a\x07\x8a\x1f\x00\xa6\x93\x95\xeb\x85\x92\x50\x38\xa6\xa1\xe3\x84\xe1\x97\xc6\x74\x2d\x76\x86\x5f\x0c\x56\x59\xc1\xd3\x16\xb4\x63\x6b\xd5\x62\xc2\x56\x6c\x7b\xb6\xc4\x09\xa0\x75\xad\x71\x34\x91\x83\x0e\xd0\xba\xc1\x64\xa5\x58\x2c\x40\x3b\xb1\x3c\x37\x90\xc0\x01\xb4\x13\x16\xd8\x2b\xc9\xeb\x00\xda\xd0\x62\xa3\x58\xe2\x04\xd0\xb2\x55\x3c\x8d\xe5\x84\x86\xe9\x1a\xd9\x76\x34\x91\xc2\x13\x40\xeb\x39\x6e\x68\x4b\x24\x01\xda\x69\x30\x0d\x4c\x99\x05\xa0\x75\xc7\xd3\xd0\x91\x0d\x03\x68\xed\x68\x1a\x8f\x65\x0a\x80\xd6\x76\x27\x71\x20\x3b\x07\xd0\xba\x91\x1b\xad\x24\x63\x01\x68\x57\x13\x3b\x8a\xbe\x38\x13\x2e\x58\xf0\x45\x91\x15\xe5\xb7\x81\x75\x2c\xd7\x92\x74\xce\xe7\xec\xc8\xf6\x5a\xab\xab\xcb\xc6\xac\xc5\x87\xc7\xd3\x49\xd4\x5a\x5d\xa7\xa6\xe7\xb5\x56\xd7\x69\x18\x8c\x5b\xab\x6b\x18\xb3\x69\x7b\x75\x35\xe3\x38\x96\x59\x00\x58\x36\xb6\x3c\x47\x66\x01\x60\xa7\x93\x89\xa5\x28\x0e\x80\x0d\x9d\xd0\xb1\x25\xf4\x00\xac\xed\x05\x35\x77\x01\x60\x2d\xcf\xf3\x56\xcd\x39\xeb\x44\x63\x3b\x96\xc4\x01\xc0\x06\x91\xbd\x52\xec\xff\x4c\x54\x1b\x7e\x31\x3e\x5c\x69\x61\x3a\x37\x85\xa2\x0d\x30\xb7\xec\xa9\x6d\x59\xb2\xeb\x00\x81\x33\x76\xac\xf6\xdc\x62\x6e\xe4\xca\x14\x8e\x58\x4f\xc6\x72\xa2\xf0\xb9\xe5\x4d\x6a\x3e\x0a\x73\x6b\x1a\x4c\xbd\xa9\x4c\x01\x73\xcb\x8b\xbc\x95\x27\x53\xc0\xdc\x5a\x05\x01\x53\x9c\x16\x20\x08\xe3\x71\x30\x92\xb5\x00\x04\x61\x3c\x89\x1c\x49\x0a\x00\x41\xec\x31\xe6\x49\xe4\x01\x02\x77\xb4\x62\xa6\x04\x09\x20\x08\xe2\x70\x14\xc9\xc9\x06\x10\xb8\x2c\xa8\x57\x3e\x80\xc0\x5b\x4d\x5d\x4f\x66\x01\x08\x3c\x67\x62\xd9\xd6\x17\x83\x80\x6b\xbb\x6a\x08\xca\xe4\x3a\x2b\xaf\xfe\xdf\xff\xc3\x7a\x9b\x0c\xd4\x2f\x6a\x5a\x89\xa9\x04\x1b\xbf\xb2\x8a\x6a\x4e\x13\x33\x34\x23\x53\x0e\x16\x60\x64\x33\x3b\x76\x24\x01\xdb\xd0\x51\xcb\xb5\x5d\x49\x7c\x80\xd1\xc4\x99\x8c\x26\x92\xf8\x00\x23\xcf\xf5\xc6\x0a\x01\xc0\x68\x35\x59\x4d\x57\x92\x80\x01\xa3\x28\x88\x56\x91\x44\x00\x30\x8a\xc3\x38\x8a\x9b\x18\x31\xc7\x0a\x14\xad\x00\x46\x6c\xec\xba\x0a\x01\xc0\x28\x0a\x03\x73\x2c\x1b\x06\x18\x39\x56\xe0\xb8\x12\x45\xc0\x68\x6a\xae\xac\xa8\x35\x4d\xac\xa9\xbd\x92\x65\x00\x46\x13\x77\xbc\x6a\xf3\x3f\xcb\xf5\xec\xe9\x97\xc3\x08\xf6\x02\x35\x46\xe1\x55\x9e\x14\xbd\x0f\xec\x7a\x53\x64\x35\xdf\xe3\xa1\x22\x10\x76\x7f\x2d\x78\xf8\x7f\x22\x00\xe0\x71\x4c\xf8\x88\x00\x0e\x8f\xe9\x9a\xae\x0c\x70\xa0\x4b\xe6\xca\x5c\xc9\x00\x80\x27\x32\xe1\x23\x02\x00\x1e\x66\xc2\x47\x04\x00\x3c\xb1\x0b\x1f\x11\xc0\xe1\xe1\xff\x89\x00\x80\x07\x36\x00\x2d\xd5\x49\x1c\x8e\x23\xc5\x4d\x01\x9e\x38\x0a\x1c\x47\x0e\x2d\xc0\x13\x58\xe1\xd8\x6d\x72\xb1\xc9\x38\x9c\xac\x24\x99\x00\x3c\xe3\x78\xe5\x44\x92\xf1\x01\x3c\x91\x33\xb5\x42\x09\x20\x4c\xa1\x15\x1b\x8f\x9c\x2f\x26\x79\x84\x57\xc1\xfa\xc3\x9f\x41\xc7\x72\xe1\x23\x02\x2c\xb1\xa5\x53\xc3\x64\x1f\xc2\xe5\x1c\xc2\x35\x3a\x84\xcb\x3d\x84\x6b\x7c\x08\xd7\xe4\x10\xae\x0a\x1d\x2f\x56\x64\xcd\x27\x4f\x14\x78\x53\x39\xd4\x80\x4e\x14\xad\x6c\x25\x1a\x70\x74\xc2\xd0\x1e\xcb\x14\x80\x8e\x65\x87\xb1\x92\xfa\x2a\x74\x42\x9b\xc9\x2c\x80\x0e\xb3\x02\xa7\xb5\xc6\x44\x2c\x88\xa7\xf1\x17\x43\x47\xa9\xdf\x6b\x88\x56\x39\x2b\xcb\x2c\x56\xfc\x1f\x40\xb0\x1d\x3b\x54\x84\x07\x20\x58\xa1\x33\x76\x65\xd7\x00\x04\x1b\xd4\x8d\x92\x55\x03\x08\x4e\x3c\xf2\x46\x92\x78\x01\x84\xe9\x68\xea\xa9\x75\x1d\x40\xf0\x58\x30\x09\x9a\xab\x4c\x30\x09\xe3\x40\x92\x26\x80\xb0\x72\x41\xda\x12\x01\x00\x82\x1d\xb8\xf5\xa6\x0d\x40\x18\x39\x53\xbb\xc5\xc1\x02\xd3\x59\x59\x72\x00\x01\x04\xdb\x99\xd4\x6b\x19\x80\xb0\x32\x1b\xcc\x17\x40\x18\x4d\x47\xd1\x44\x4e\x22\x00\x21\x74\xbd\xa9\xa2\x30\x98\x22\xa1\x37\x75\x46\xa3\x2f\x07\x02\x1c\x72\xd4\x00\x5c\xe7\xd7\x79\x51\xcf\x8d\x75\x5e\x18\x61\xd8\x9a\x12\xfc\x3f\x11\x00\x68\x38\xfc\x3f\x11\x00\x68\xb8\xfc\x3f\x11\x00\x68\x4c\xf8\x7f\x6d\xa5\x86\xe7\xc9\x4e\x03\x1a\x2b\xfe\x9f\x08\x00\x34\x22\xfe\xdf\xa3\x0c\x2b\x1e\x59\x8e\x1c\x5a\x40\x23\x8e\xa7\xae\xda\xc3\xc1\x94\x88\xe3\x28\x54\xac\x95\xa3\xc1\xc2\x7a\xc7\x0c\x68\x4c\xe2\x68\xa5\x0a\x05\x34\x4c\x73\x32\x8a\x9a\x68\xac\x2c\x33\x0a\x65\x00\xa0\x31\x75\xad\xd1\x68\xf5\xa5\xd0\x10\x01\x7f\x8a\x65\x4d\xad\x69\x5b\x3d\x31\xb5\xa7\xb6\x0c\x00\x7c\x9c\x29\x7c\x44\x00\xe0\xe3\x4e\xe1\x23\x02\x60\xb6\xac\xa6\xf0\x11\x01\x80\x4f\x34\x85\x4f\x5b\xe9\x34\x65\x32\x00\x66\x4b\x3c\x85\x8f\x08\x00\x7c\x82\xd5\x68\x3c\x92\xbc\x1f\xf0\x89\x42\x6f\xec\x4a\xc0\x38\x3e\x93\x30\x50\xaa\x60\xc0\x27\xb0\x56\xee\x58\x4e\x52\xc0\x67\x3a\x0e\x2d\x25\x66\x00\x3e\x93\x30\x88\xc3\xe6\x82\xb2\x0a\xa6\xab\x40\x22\x08\xf8\x04\xd6\xd8\x1b\x8d\xbf\x14\x3e\xf2\x6c\xef\xcf\x00\x64\x47\xf0\x69\xeb\x79\x3d\xc7\x6b\x0b\x64\x96\x6b\xb5\xf5\xbc\x8e\x52\x3e\x00\x40\x81\xe9\xc5\x6a\x8f\xcf\x01\x72\x22\x33\x3c\x00\x68\x1c\xc9\x81\xe1\x00\xd9\xb1\xd9\xd2\x0a\xc6\xf6\x64\x32\x91\x4c\x13\x00\x8a\x3d\xcf\x52\x6c\x95\x03\x14\x87\xa1\x92\xd5\x01\x20\xcf\x0b\x43\x35\x6f\x01\xa0\xf1\x38\x0c\x43\x59\x28\x00\x34\x1e\x7b\x9e\x0a\x00\x80\xc2\xb0\x11\x00\x00\x45\xf6\xe4\x0b\x1e\x96\x54\xe7\xab\x7f\x5a\x15\x3f\xb6\x1c\xf3\x4c\x04\x00\x8f\xb3\x4f\xec\x71\x6b\xd9\x1f\x39\xce\x4b\x75\xc0\x05\x10\xb9\x2f\x5c\x73\x24\x07\x0f\x20\x9a\xbe\x98\x9a\x4a\x88\x06\x88\x5e\x38\xde\x0b\x4f\x96\x01\x10\xbd\x3c\x7d\x39\x56\x2a\x70\x80\xe8\xd5\xcb\x57\x63\xa5\xac\x87\x39\x34\xb5\xc7\xaf\x14\x22\x00\x11\x4c\xe5\x16\x44\xe3\x57\x8d\x00\x80\xc8\x9d\x40\x26\x11\x70\xc2\x03\x20\x8d\x08\xe0\x10\xbd\x72\x27\x2a\x00\x20\x82\x12\x54\x96\x33\x11\xe0\x4e\xbe\x14\x44\x70\xce\xfd\xa7\xe6\xcf\x89\x73\xaa\x04\x2c\x00\xc7\x19\x8d\x3c\x75\x4e\x05\x0c\x6e\x72\x36\x3d\x99\xca\x0e\x01\x38\x9e\xfb\xc2\x7d\x21\x09\x19\xc0\x79\xf9\xea\xc4\x39\x99\xfc\x21\x89\xf9\xf4\xe4\xcc\x3c\x6b\x82\x73\x3a\x19\x9d\x38\xf2\x14\x0a\xc0\x39\x1d\x4f\x4e\xd5\x36\x1f\xe6\xcf\x99\x75\x32\x52\x64\x04\xe0\xd8\xa7\x27\x27\x13\x59\x06\x80\x63\xbd\x78\x79\xe2\x35\x4f\xb2\x9c\x91\x37\x7d\xf5\x52\x04\x00\x38\xde\x4b\xd7\x7b\x39\xfe\x6f\x93\x98\x2b\x5b\x83\x1a\x9e\x82\x95\x57\xbd\xdb\x1c\xd8\x9e\x02\x07\xc2\x82\x2a\xec\x10\x1b\x2b\xb2\x62\xdb\x6a\x2f\x3e\x41\x4b\x21\xe0\x4c\x9c\xd5\x48\xa6\xe0\xd8\x8c\xbd\xa9\x3a\xdd\x03\x6c\x56\xa3\xd5\x44\xe9\xd6\x60\xe2\x84\x6e\x38\x0d\xc7\x8f\xca\xcb\x87\xc2\xc1\xc9\x89\x33\xb2\xe5\x30\x02\x36\x67\xde\xf8\x85\x5a\xf3\x38\x36\x2f\x5f\x78\x2d\x9d\x8c\xe5\x4d\xa7\x4a\x64\x04\x6c\x1c\x6f\x62\x9d\x4a\x2a\x7a\x75\x18\x00\xd8\xbc\x70\xc6\x2f\x14\x15\x9d\xd5\x29\xbe\x14\x36\xd2\xd8\xa3\x86\x27\x58\xb3\x8f\x41\x1a\xe5\xac\x77\x19\xdc\x24\xd9\x3a\xe9\xe4\x70\x90\xea\xa3\xdd\x56\x9e\x99\x96\x69\xc9\x21\x84\x49\x64\xbb\xf0\x11\x01\x30\x89\x46\x63\xf8\x88\x00\x00\x8a\x27\x90\x23\x06\x40\x05\xab\xa0\xd6\x6f\x03\x50\x2b\x0f\x3e\x22\x80\x03\xe5\x30\x87\x39\x8f\x9d\xd8\x4f\x42\xf8\x88\x00\xef\x50\x34\x04\xa0\x02\x13\x3e\x22\x00\x26\x11\x97\x3c\x24\x49\x01\x50\xd3\x31\x7c\x44\x00\x00\xc5\x7f\x4b\xb0\x01\xa8\xc9\x08\x3e\x22\x00\x80\x72\x19\x7c\xbe\xd8\x24\x02\x8b\x9b\x5e\x65\x78\xf3\x67\x38\x9d\x69\x5a\x56\x4b\x37\x60\x9a\x4e\x6d\x67\x01\x20\x99\xa6\xeb\xaa\x00\x87\x07\x4c\x26\x2a\x60\xc4\x03\x3c\x4f\x05\xb8\x3c\x60\xb5\x52\x01\x63\x1e\x10\x45\x2a\x60\xc2\x03\xe2\x58\x05\x4c\x0f\x0b\xf5\x0e\x0b\x7d\x71\x98\xe2\xe5\x61\x2d\x27\x87\x2d\x7d\x75\x58\xc6\xe9\x61\x96\xb3\x3a\xcb\x17\x02\x49\x1a\x3f\x59\xe3\x1a\xa2\xff\x9c\xb4\x60\xae\xac\xd0\x96\x34\xcc\xe7\x92\xed\xac\x94\xde\x0a\x60\x1a\x99\x6e\xa8\x36\x79\x00\xd3\xd8\x9e\x30\x45\xe5\x00\x53\x10\xac\xc2\x50\x12\x35\xc0\x14\xae\xa2\x31\x93\xb3\x0d\x60\x62\x2e\x5b\xc5\x56\x7b\x2e\x79\xb1\x9c\x7e\x00\xd3\x2a\x9e\xae\x94\x80\x0d\x30\xad\xe2\x55\xdc\x92\xb8\xa7\xab\x46\x00\xc0\xe4\x8e\x21\x93\x08\x38\xe1\x01\x90\x46\x04\x00\x4c\x50\xa4\x0a\x00\x98\xa0\x04\x95\xe5\x4c\x04\xb8\x5f\x4c\xe2\x56\x66\x68\x9f\xd2\x45\x3b\xb6\xed\xb5\xb4\x04\x23\xc7\x59\xa9\x0d\x08\xa0\xe0\x86\xee\xc8\x95\x2d\x07\x14\x26\xde\xc4\x51\xb0\x00\x0a\xde\xd4\x1b\x79\xd3\x36\x47\x73\xd5\x46\x08\x50\x88\xdc\xc8\x89\xdc\xc7\x96\x9e\x28\x1a\x8d\x5b\xba\xe8\x38\x9a\xae\x2c\x59\x0b\x4c\x96\x38\x0a\x43\xa5\x37\x03\x14\xa6\x71\x68\x39\x4d\x8e\x66\x8d\xbc\x55\xeb\x08\xcf\xb2\x3d\xb3\x85\x42\x38\x75\x99\xe2\x9b\x1c\x05\xc7\x71\xcd\x2f\xa6\xe7\x14\xe6\x7e\x9f\xc2\xe0\x48\x99\x39\x32\xe1\x23\x02\x00\x83\xb1\x39\xae\x75\xbc\x80\xc1\xd4\x84\x8f\x08\x00\x0c\x42\x13\x3e\x7f\x4c\x5d\x76\x88\x41\x1c\x37\xda\x01\x33\x21\x8e\x0f\x18\x16\xa4\x50\x13\x10\x30\x80\x53\x6d\x95\xe2\x44\x70\x41\x55\x28\xcc\x04\xd3\x1c\x8f\x55\x00\xcc\x84\x30\x34\x4d\x15\x00\x18\x00\x73\x8e\xbf\x98\xba\x4c\x99\x55\x7e\x72\xf9\xff\xe4\xea\x6f\x9b\xd6\x58\x59\xc1\x01\xc7\x72\x4c\x9b\xa9\xde\x03\x4e\x6e\xec\xae\x94\x41\x08\xe0\x34\x0e\xc7\x53\x65\x9d\x04\x38\x4d\xc7\x53\x4b\x1d\x4e\x02\xc7\xf2\x46\x53\x36\x6a\xce\x95\x30\x0c\x1d\xb5\xc1\xe4\x73\x25\x88\xcd\x40\x4e\x1e\xc0\x29\x74\x5c\x47\xcd\x04\xc0\x69\xe5\x8c\x2d\x25\x84\xc1\x5c\x09\xa6\x53\x47\xed\x8d\x01\x27\x6b\xea\x4d\x46\xcd\xb9\x32\x71\x83\xda\xa6\x01\x70\x1a\x4d\x26\x61\x20\x19\x01\xe0\x34\x9d\x8e\xa7\xea\x70\x0d\x70\x5a\x39\xee\x74\xfa\xc5\x56\x7f\x6e\xe0\xfa\x67\x96\x7d\xd8\x59\x2a\x85\x0d\xa0\xe3\x3a\xa3\xb1\x52\x75\x01\x3a\xe3\x91\x6b\xab\x69\x05\xe8\x4c\xd8\xc4\x74\xe5\x50\x03\x3a\xab\x69\x50\x1f\x0c\x03\x3a\x91\x79\x28\x44\x7b\xcc\x6a\x6b\xd8\x5c\xc6\x98\x04\x94\xa3\xb3\x1a\x9b\x4a\x6d\x07\xe8\x44\xf6\x74\xa5\xb6\x2f\x80\x4e\x3c\x5a\x85\x4a\x0b\x0d\xe8\xac\xd8\xca\x55\x2c\x96\xa3\xb3\x5a\x45\x81\x04\x14\xd0\x99\x06\x2b\x67\x25\x69\x00\xd0\x09\xa6\xde\x4a\x09\x8d\x1c\x9d\x95\xe7\x4e\xbf\x98\xbe\x53\x18\x19\xd7\xf8\xdc\x26\xd7\x60\x6f\x12\xfc\xce\x52\x30\x6b\x55\x08\xdd\xde\xde\x4a\x8b\x64\x23\x5d\xb7\x66\xcf\xc4\x9e\xaa\xad\x83\x25\x15\x6a\xcd\xd9\x33\xf2\x46\x53\xc5\xd0\x39\x3e\xee\xa4\xb6\x08\x02\x7c\x02\xd7\x8b\xa7\x72\x18\x5c\xa9\x3f\xb3\xdb\x1b\xd0\x51\x7b\xbd\xf7\xe2\xa9\xda\x91\x02\x3e\xb1\x67\x8f\x5b\x16\x05\x71\xe4\x4d\xac\xb8\x8d\x4f\xac\x74\x10\x80\x4f\x30\x66\xb6\xda\x8e\x01\x3e\x01\x58\x15\x35\xf1\x19\x8f\x23\xaf\x75\x28\x10\xb0\xa9\xd5\xe2\x72\xa0\x13\x72\xbe\x98\x02\x87\x1b\x7a\xff\x99\xd9\x63\xaf\x1c\x53\xf1\x25\x8e\xce\xc8\x89\xd4\x46\x86\xa3\x13\xbb\x2b\xa5\xd6\x02\x74\xc6\xee\xc4\x99\xb4\xd0\x99\x04\xd1\x4a\x4e\x27\x40\x27\x34\x43\x57\x89\x67\x80\x4e\x14\x33\xab\xa5\xff\x64\x71\x6c\xb5\xd0\x59\xc5\x63\x6b\x2c\xcb\xe0\xb3\xc7\x9c\x4e\x26\x92\x4a\x00\x1d\xb6\x0a\x57\x4a\x92\xe2\xe8\x38\x2b\xa6\x0e\x29\x00\x1d\x6f\xbc\x72\xd5\x56\x18\xd0\x99\xc6\x81\xa5\x58\x19\xcc\x9e\xd5\x68\xca\xd4\x3c\x87\xd9\x13\xac\x26\xde\xf8\x8b\xe9\x6e\x84\xb1\xfd\xa7\xe4\x00\x3b\xb6\x98\x22\x33\xc0\x60\x64\x39\xb6\x23\x89\xa8\xc2\x60\x64\x8f\xc2\xb6\x39\x15\x53\xbc\x05\x30\x98\x46\xd3\xb1\xe2\x2d\x80\x41\xe0\x78\xcc\x93\xc3\x05\x18\xac\xbc\xd5\x58\x1d\xac\xc1\xfa\xc2\x26\xcc\x53\x47\xf8\x30\x43\x58\x3c\xb6\x5c\xb7\xad\xe2\x5c\xa9\xf3\x3c\xc0\x20\x66\xe1\x48\x69\xcb\x01\x83\xd1\x74\x35\x56\x13\x13\x30\x70\x57\xe1\x48\x89\x5e\x80\x81\x39\x5e\x8d\x5b\x33\x64\x6a\xb9\x2b\xc5\xe3\x00\x03\xe6\x8d\x57\xc1\x17\x93\xc5\x36\xd9\xe6\xcf\xcc\x8f\x23\x19\xed\xbf\xe9\x48\xf3\x50\x46\x63\x2b\xd3\x9c\x36\xe7\x47\x6c\x7b\xf5\x39\x12\xc7\x66\x1a\x06\x96\xe4\x6f\x80\x8d\x33\x59\x39\x23\xb9\x54\x00\x36\xa6\x19\x04\xea\xd8\x88\x63\xc3\xdc\xda\x34\x9f\xcf\x0f\xc7\x62\xd3\xe6\xfc\x98\x04\x76\xf4\xe5\x36\x95\xf5\xc5\x92\x1a\xa2\xfc\x2e\x48\x7b\xab\xa0\x64\xf5\xb1\x5a\x9d\xec\x98\x7b\xc1\x47\x04\xc0\xcc\xb1\x27\xb6\xa7\xec\xfd\x60\xe6\x38\xc1\xc8\x6c\x9d\xae\xb9\xc1\x78\xd4\xe2\x5e\xd1\x28\x8c\xd5\x99\x15\xa0\xc3\xc6\xcc\x52\xc6\x19\x30\x73\xe2\x51\x6c\xb1\xa8\xbd\xb6\x4c\x62\x39\xf6\x80\x4e\x14\x8c\xea\x33\x09\x40\x27\x0c\x27\xd3\x36\x3a\x71\x38\x1e\xcb\x32\x00\x9d\xc0\x0d\xed\xb1\x9c\xc1\x80\x8e\x6b\x79\xb1\x22\x1b\x40\x67\x1c\x79\xe1\x4a\xb6\x94\xa3\x33\x5e\x39\x4a\xe4\x00\x74\x56\xa1\x37\x72\xbf\xd8\xcc\x69\xde\xeb\xa9\xf1\x29\xef\xd6\x2c\xef\xad\x58\xfa\x7b\x72\xc9\x72\x85\x51\x79\x07\x41\x9f\x9c\x3d\x7c\x83\x23\x29\x0e\xf0\xb1\xc0\xe8\xbb\x89\x8f\x33\x82\x8f\x08\x18\x1d\x9e\x8f\xba\x87\x8a\xb4\xee\xd9\xe3\xc5\x5e\x2c\xe1\x00\x7c\x98\x67\xc7\xb6\x9c\x4e\x80\x0f\x33\xbd\x91\x92\xbe\x5f\x88\x13\x54\x65\x1e\x07\xf8\x98\x2c\x9a\x2a\x48\x01\x1f\xdb\x61\x51\x24\x67\x20\xe0\xe3\xc0\x6a\x22\xb3\x00\x3e\xb1\xe7\xd5\x4a\x08\xc0\x67\xec\xb9\x23\x3b\xfa\x62\xf8\xc8\x6b\x55\x35\x38\xa0\x6a\x4e\x7b\xfc\x92\x5e\x9a\xdd\x34\xe0\xe1\x11\x75\x38\xe0\xf4\x54\x5d\xcb\x6a\x23\x66\xaf\x94\xf0\x0c\x33\xca\x9c\x38\xf5\xc1\x24\x20\xe6\x4e\xc7\x4c\x49\x49\x80\xd8\xd8\x9d\xac\x94\xad\x3b\x20\x36\x75\xbc\x91\xd2\x51\x03\x62\x9e\x13\x58\x6a\x8f\xc1\x11\x63\x6c\xda\xd6\x0b\x44\xf1\x58\x8d\x25\x20\x16\x81\x0d\x60\x13\xb1\x70\x35\x5a\xa9\x1b\x39\x80\xd8\xca\x9d\xd6\x9b\x54\x40\x6c\xea\x36\x76\xad\x1c\xb1\x20\xb0\x94\x7e\x02\x10\xb3\xc7\xd3\x55\xcb\xc0\x66\x1c\x4e\xac\x50\x12\x1b\x20\x16\x39\xce\x78\xfa\xc5\xec\x3f\x1b\x57\xdd\x6a\xcc\xf8\xea\x53\x66\x9b\x2b\x96\xf7\xc2\x2c\x5f\xb3\x3b\x85\x5a\x58\x54\x01\x0d\xf3\xcf\xff\x6a\x73\x9b\x97\x26\x7c\x44\x00\x60\xf5\xca\x84\x8f\x08\x00\xac\x4e\x4d\xf8\x88\x00\xe0\x7e\x67\xfc\x3f\x11\x30\xe5\x01\xa6\xa9\x34\xca\x80\xd5\xd9\xab\x06\x34\x80\xd5\x8b\x97\x2f\xa6\x2a\x00\xb0\x32\xcd\x93\xfa\x56\x80\xb8\x30\x11\x04\xad\xd9\x35\x99\x4c\x4e\x65\xa1\x30\xbb\x5e\xbc\x32\xcd\x17\xd6\x7f\x9b\x64\x2d\xaf\x1d\xfe\x19\xe1\xe1\xff\x96\xf3\x1d\x18\x29\x25\xc2\x03\x40\x11\xf3\x1c\x57\xa6\x00\x80\x62\x33\x1c\x2b\x95\x3f\x00\xb4\x72\x57\xb5\xed\x2f\x00\x34\x0d\x56\xac\x65\xad\x36\xb5\x02\xbb\xbd\x3c\xd9\xde\x48\x89\x17\x00\x50\xe0\x8c\xa7\x6e\xf0\xa5\x00\x5a\x67\x69\x94\xa5\x3d\xb8\x01\xfa\x49\x01\xdb\xb1\x62\xdb\x6c\x9b\x44\xc5\xea\x4a\x16\xe0\xe0\x06\xee\x44\xd9\x74\x00\x53\x9b\x38\x13\xab\x25\x60\x7b\xae\x57\xeb\x49\x60\xa2\x44\xde\xa1\x91\xc7\x84\x4d\xd8\xf4\x31\x1c\x18\xb3\x99\x32\x12\x04\x1c\x62\x67\x3a\x0e\xac\xb6\x11\x8e\x6d\x36\x71\x30\xcd\xa9\xdb\x52\x76\x4e\xdd\x90\xad\xc2\xf6\xc9\x40\xa4\xc4\x15\x98\x28\xde\xd4\x34\x5d\x29\x6a\x00\x0e\x2b\x73\x6c\x59\x5f\x4c\x88\x93\x37\x6d\x6b\x10\xa2\xe0\x26\x89\x7a\x57\x81\x3c\x13\xf0\x9f\x3e\x85\x1f\xc3\x88\xdd\x1c\xcd\x10\x66\x31\x65\x02\x06\xcb\x8d\x63\x3b\xae\x33\x69\x1f\xac\xad\x14\xc7\x02\x64\xdc\xd8\x0d\x94\x4e\x14\x66\xc8\xd4\x99\x5a\xea\x5a\x1f\x20\x13\x4c\xe0\x23\x02\xc6\xc2\xa2\x3c\x74\x1e\x43\xe6\xd8\x24\x3a\x0a\xa6\x63\xef\x31\x93\xe8\x69\xec\x45\xe3\xe0\x31\x93\xe8\x89\x3b\xad\x8d\xe6\xba\x4d\xa2\x57\x13\xd3\xf9\x6c\x15\xa8\xfc\x5d\x3d\x0a\x00\x17\x9e\xcb\xca\x0f\x45\x9e\x6d\xd3\x88\x3f\x36\x69\x12\xdb\x75\x31\x56\x0e\x35\x90\x35\x56\x1e\x6c\x2c\xaa\x9c\x62\xcd\x35\x53\x1b\xc0\xab\x78\xd2\x99\x82\xd3\x7a\x54\xbc\xae\x58\x56\x33\x02\x5f\x34\x32\x73\x8e\x6c\xd7\x7d\xc2\x16\xce\xb2\xe9\x5f\xf7\x2b\x6d\x90\x23\x06\xae\x46\xf9\x5f\x4b\xfc\xb5\x97\x78\x50\xee\x3f\xd5\x19\xe9\xee\x89\xbf\xb3\x56\x80\x67\x65\xee\xfd\xc6\x48\xd2\x88\x7d\x7c\x13\x37\xde\xb1\x2a\x16\xe5\x12\x31\xac\x5a\x6e\x39\xf2\x41\x65\x47\x3c\x15\x65\x39\x63\xf1\xf6\x45\xf5\x40\xee\xbd\xf6\x95\xe6\x07\xe4\xaa\x58\xfb\xdd\x1e\xdb\x09\x78\x04\xad\xdd\x35\x8d\x68\x3d\x50\xba\x2e\x1e\x1c\xe0\x7e\x4d\x49\xba\x27\xf9\xe5\xca\x5f\xef\x67\xb9\x91\x5f\xae\xe8\x9a\xe4\xc6\x55\xb1\xa6\x19\xfc\x65\x1f\x69\xd0\x00\x32\x7f\xbc\xcf\xa4\x54\x1e\xba\x59\xe5\x42\xe6\x6c\x9d\x05\xe0\x3f\x88\x94\x87\xae\x5d\x03\x8e\xac\x33\x36\x85\xe3\x53\xcb\x6f\x3b\x73\xad\xe2\xb9\xe7\x89\xb6\x1b\x57\x11\x81\xf7\x6a\xb8\x46\x62\xb4\x1e\x06\x5c\x64\x05\x67\xd5\xc6\x75\xb0\x41\xf9\xa1\xeb\x71\x7c\xdf\x9d\x71\xd4\xaf\x69\x44\xd7\xdd\xc6\x2f\xf0\x1e\xdd\x4a\xaa\xe0\xa6\xda\x57\x1a\x49\xa9\x35\x4b\x9f\xc9\xc4\xb3\x54\x3e\x06\x03\x7e\x59\xc3\xab\x20\x7f\x01\x9e\xd2\x67\xe5\x80\xe6\x83\xbc\xe5\x32\xb9\xba\xf5\x5f\xd2\x85\x74\xc1\x03\x6e\x99\xb6\xab\x42\x90\x3e\x71\x30\x81\xe7\x80\x3a\x63\x1d\xe2\x3e\x12\xeb\x92\x09\x8f\xe5\xbe\x26\xbd\x06\xf1\x4b\xb7\x69\x0d\xc4\x50\x67\x09\x13\xe2\xf1\x12\x9e\xc2\x84\x34\xca\xec\x2c\xf9\xc8\x22\x64\xf3\xe7\x37\x38\x45\xd5\xae\xb2\x3f\x35\x3d\x1a\x4e\xd2\xca\x67\xce\x7c\x68\xf5\x1b\x33\x43\xfb\x8b\x86\xe7\x0d\x2e\x00\x53\x33\x68\x34\x89\x58\x26\x16\xc4\xf1\x14\x28\xc4\xef\x88\x84\x26\xca\x70\x45\x85\x9c\x6e\xfe\x1b\xc8\x46\x3d\xaa\xc7\xbd\x9a\xae\x29\xb0\x90\xa7\xce\xd8\x24\x09\x65\x0b\x6b\x09\x8d\x26\x05\x65\x0b\x9b\x7f\x05\x3c\x4c\xaa\x1e\xf8\x5f\x04\x14\x3a\x5c\x90\x80\x04\xcb\x59\x49\xed\x27\xc5\x10\xa5\xb4\x78\x66\xb8\xf3\xe2\x09\xb2\x06\x09\xf6\x8b\x41\x32\x2c\x9e\x24\xe0\x00\x6f\x61\x12\x93\x98\xb5\x2f\x67\x78\xf7\x2b\x7c\xe6\xcc\xc2\xc1\x00\xa3\x9c\xae\x07\xd6\x53\xe7\xc9\x10\x85\x43\x0b\xe3\x67\xa6\xae\xe7\x83\x01\xc9\xc1\x23\x6d\x3e\x1c\x92\x80\x8e\x9f\xe4\xcf\xac\x79\x39\x18\x3f\x41\xe9\xb0\xc4\x4f\x72\xdf\xe6\x21\xa9\xef\x3c\xc9\x9f\xd9\xf3\x72\x50\x85\x23\xfb\xa9\x33\xcc\xf1\x93\xb1\x5f\x12\x70\x1e\xc9\xdb\x28\xbd\xcb\xf7\xb2\x0e\xb8\xff\xff\xe7\xca\xff\xc0\x11\xbd\x64\xa8\xa4\xf6\xc8\xcf\xfd\xf8\xe5\x33\x0c\x8e\xb1\x53\xf0\xa2\x5d\xbb\x16\x64\xdd\x4e\xf3\x2f\x1f\x70\x0c\xdf\xfb\xac\xa0\x47\x7d\xd4\x75\x94\xa0\x9c\xbb\x1f\xc7\x1d\x7b\xd1\x3b\xf6\xd1\x48\x0f\x5d\x3d\xee\x91\x62\x22\xba\xde\x6f\x16\x0a\xe3\x54\xd2\xe3\x10\x59\xf8\x67\xbb\x01\x04\x37\xf2\xd1\x7f\xa9\xef\x7b\xf9\x7f\x5d\x57\x6f\x96\x69\xf0\x97\x31\x0d\x1c\x57\xf6\x57\x47\xa1\x8d\x62\xd5\xbb\xff\x50\x9f\x6a\x17\x78\x5f\x84\x77\xa2\xfb\xe8\x4a\xd7\xc1\xf3\xd3\xe2\x4a\x78\xe6\xe6\x4e\x98\xff\x90\x13\xb5\xe2\x38\xf5\x67\x3a\x51\x0b\x69\xb2\xdb\x15\x8f\x39\x51\xdb\x1e\xfb\x4b\x8b\xe9\xf6\xd0\x7d\x59\x44\xb7\x4a\xda\x22\x1b\x1a\xca\xb7\xec\x57\x74\xdb\xfd\x1c\xfd\x15\xdd\xcc\x37\xc6\xf1\x3b\xbd\xc2\x1f\x18\xb9\xae\xdd\x8c\x5d\xd2\xd6\x7b\x4c\xe4\x86\xa2\xa0\x83\x4c\x1a\x8b\x6b\x8f\xc1\x6c\x24\x7c\x20\x2b\x2a\x3a\x70\x7a\xcf\x59\x5f\xb0\xdb\xa1\x80\x66\x98\x24\xf0\x4c\x34\x5d\x2c\xb1\xf4\x68\x2f\xdc\x49\x73\x8f\xf6\xe9\x73\x53\xd7\x03\xb4\xc5\x73\xf0\x97\xca\xd0\x96\xa4\xe0\x5f\x9f\x17\xed\xe7\x28\x21\x5b\xec\xc3\xc3\x10\xc9\x42\x3e\x9d\xb8\xa4\x5b\xc5\x1c\x12\xf0\xf5\x6a\x61\x2c\xa2\xc0\x55\x21\xaf\x5b\xbc\x00\xc5\xdf\xb4\xe8\x7e\x29\x87\xbb\xe6\xfc\x03\x0e\xab\x45\x85\x32\xa4\xe1\x21\x38\xa5\x26\xb0\x9e\x39\x94\x28\x79\x55\xdb\x19\xae\xaf\xbe\x82\xc3\x50\x60\x48\xe5\x0c\xe7\xdc\xcd\xa9\x60\x7e\x90\x3e\x57\x95\xe4\xfb\x3d\x59\xd3\x6b\xa4\x9c\x5b\xaf\x95\x43\xeb\xa1\xe5\xf3\xa7\x10\x3a\xda\xc1\x6a\x67\xc1\xa4\x04\x3c\xe0\x05\x77\xc9\x0c\xaa\x07\x14\x32\xe5\x70\x75\x36\x18\x94\xcf\xf2\x19\xce\xe0\xa1\x61\xb6\x58\x0f\x4a\x58\xd8\x86\x96\x5a\xb4\x12\x91\x74\x3d\xb0\x00\xb7\x12\x1e\x54\x48\xaa\xc4\xa5\xe2\xa0\xc9\x62\xbd\xa4\x19\x49\x51\x50\x39\x68\x4e\xf0\xbe\xb9\x72\xdf\xfc\x71\xc7\x6d\x62\x32\xb4\xfd\xdb\xa1\x92\x68\xf5\x0e\x51\x93\x2f\xa4\xf6\xcd\x3d\x26\xa5\x71\xb7\xbd\xb1\x41\x4c\x3e\x22\xd9\xca\x87\x3e\x5f\xea\x49\xc0\x97\x79\xbe\xf0\xdb\xaa\xf9\x25\xb5\x9e\x64\x03\xf3\x49\x30\xb0\x0c\xcb\xf1\xa6\xce\x93\x35\x88\x89\x4f\xb2\xc1\xd0\x70\xbc\xd1\xd8\x7d\x12\x0c\x86\x86\x3b\x35\xc7\x4f\xd6\x24\xe7\x11\xb6\x61\xda\x8e\x65\x3d\x09\x06\xe6\x93\x35\x11\x3b\xa3\xeb\x24\x45\x72\x3a\x21\x93\x94\x98\xf0\x37\xfb\xbb\xe2\x52\x1e\x97\x77\xe6\xcb\x79\xdc\x02\x96\xf1\x12\x76\x58\x4f\x52\xfe\x6f\xbe\xdc\x93\x12\x36\x02\xf6\xdd\xf6\xe6\xb8\x97\xbc\x7f\x20\xff\x91\x94\xf7\x91\x7f\x05\xe2\xb2\xf9\x57\xd1\xd7\x85\x61\x7b\xde\x93\x72\x60\xb8\xd3\xc9\x93\x74\x60\x58\xd6\xe8\x49\x4e\x86\x86\x35\x9a\x58\xce\x93\x72\x30\x34\xec\xe9\x74\x3a\x86\xa8\x91\x33\x7e\x92\x13\x63\x6c\xb9\x3c\xdc\xb5\x46\x9e\xf7\x24\x1d\x0c\x0d\xcb\x34\x4d\xeb\x49\xbe\xdc\x7f\x02\x43\x19\x59\x2d\xd2\x10\x2d\xc7\x9b\xbf\xaa\x32\xef\xc6\x18\xa8\x41\x00\x9b\xb6\x5c\x0c\x9a\xe4\xd0\x01\xe1\x6d\x9e\x94\xe2\xfb\x1e\xfb\x40\x90\x34\x25\xac\xde\xac\x8d\x2c\x90\x23\x55\x1b\x1b\xb2\x0e\x03\x6f\x84\xd5\xf4\xd4\x2e\x60\xea\xad\x82\xf0\x43\xa1\x09\xc7\xba\x98\xa8\xb8\xa4\x78\x95\x14\x1b\x78\xda\x04\x9c\xbb\x76\xc5\xff\x35\x48\xa3\x35\x8b\x3a\xe3\xce\x59\x1a\x75\xe7\x5b\x07\x45\xf9\xed\xab\x8e\x88\x4d\x95\xe5\x3c\xb8\x5b\x67\x41\xa3\x54\x88\x36\xea\xa6\x82\x67\xc4\x2a\xa8\xd5\x42\x78\xf7\x4e\x06\x8b\x86\x35\x53\x8a\xf6\xd4\x41\x55\x33\xa8\xb5\x97\x1e\xb0\xd5\xea\xa3\xe6\x86\x91\xb3\xcb\xa4\x28\x59\xd7\x0b\x64\xda\xb7\xaf\x2e\xb4\x41\xb3\xa8\xfa\xb5\xa0\x83\x06\x03\x38\x8c\x94\x40\xc2\xdb\xb4\xb3\xc8\xc3\x0c\xf0\x92\x55\x86\xe0\x25\xfe\x88\xad\x59\xc9\x8e\x4a\x64\x7c\x42\xdc\x06\x49\x79\x96\x75\x15\xd5\x1a\x19\x51\x96\xe2\x6b\x25\x3c\x25\xff\x8c\x75\xbc\x09\x0a\x74\x34\x3b\x18\xb1\x45\xba\x9c\x1f\x8c\x2b\x7f\x3e\x92\x97\xe9\xa3\xc3\x96\xa9\x28\x39\xf2\xe9\x4d\xf6\x81\x9d\x88\x78\x94\x62\xd8\xe5\x96\x46\x24\x9a\xf7\xa9\xb6\xeb\x7a\xb3\xb0\xa2\x0c\xf2\xb2\x11\x0b\x7e\xb1\x9b\x4f\x1c\x72\xad\xc6\x41\x8b\xf0\x61\x7f\xca\xe5\x6e\xd7\xd9\xb6\xb2\x7e\x95\x50\x56\x97\x6d\x9a\xb5\x55\x4d\x6f\xb5\xaf\x6e\x7f\x3d\xc5\x8f\x7b\x01\xd9\x0e\x6a\xeb\xec\xb8\x6c\x22\x5b\x72\x1d\xe0\xc1\xd0\xb2\xa5\x18\xed\xf6\x44\xc1\xe4\x10\x1e\x9e\x9d\xd7\x79\x38\x60\xad\x5a\xff\xe0\xa8\x75\x4c\xb0\x2a\x74\xd6\xd5\x2a\xca\x48\xc7\x40\xd4\xed\xca\x36\x9d\xcd\xc2\xf7\x2d\x8a\x6f\x97\xd9\x5d\xa2\xb5\x27\x6c\x8f\x9a\x8b\x6e\xf0\x09\xee\x5c\xa7\x6c\x26\xab\x07\xa4\xf1\x38\xae\x98\x24\x24\xa7\x20\x22\x55\xf2\x00\xb8\xae\x4f\x87\xb6\xcf\x1f\x2f\xb4\x67\xd9\xb3\x74\x96\x0d\x06\x38\x5f\x64\x43\xbb\xf9\x9e\x44\xc6\x95\x1a\x7d\xc1\x32\x02\xf8\xa1\xc4\x99\x12\x07\xbc\x44\x21\x70\x7d\x97\xa4\x49\x9c\xb0\xa8\xc7\x3e\x86\x6c\x03\x2d\xea\x65\x21\x38\x89\x66\xd1\xac\x07\xc2\x41\x79\x55\xbd\x6c\x79\x2d\x13\x46\xec\xa6\xc7\xd2\x9b\x24\xcf\x52\xa8\x8d\x3b\xd9\x85\x44\xf1\x76\xbd\xee\x31\x28\xb5\x77\xcd\x8a\x22\xb8\x64\xbd\x20\x8d\x7a\x41\x14\x25\x50\x6c\xb0\xee\x5d\xb1\xf5\x26\xde\xae\x7b\xb7\x41\x9e\x26\xe9\x65\x61\x68\xd5\x93\x09\xe2\xa5\x32\x73\x86\x9a\x4d\x2b\x6b\x6f\xbf\x7f\x29\x9e\x5e\x92\x63\x7a\x17\x0a\xd0\x7c\xb1\x86\x07\x5d\xf7\x18\x63\xee\x85\x97\xf2\x87\x3a\xf3\x24\x48\xcb\xde\x4f\x49\xb6\x0e\xa0\x7a\x6d\x5f\xc9\x9b\x81\x11\xe7\xf0\x1e\xf6\xbb\xec\x3c\xdb\x50\x8b\x04\xfb\xff\x5b\x57\x54\x55\x6f\xd6\x74\xe6\x2f\x6a\x03\xe7\xcc\xe2\x25\xca\xce\xa7\xae\xab\x2d\x4a\x21\xb5\x6c\x8f\xa5\x81\x62\x4a\x5d\x47\x39\xcd\x8d\x38\x59\x97\x2c\x6f\xa9\x2a\x44\x67\x3b\x4b\xa8\x1f\xd4\xe6\x4d\x34\xea\x7e\xee\x31\x26\x95\x5e\x55\x48\xe5\x29\xc9\xd5\xc6\x21\xad\xfb\x16\xfc\xb1\xa7\xa3\xab\x0d\x7b\x4d\xe8\xe5\x72\xde\xfc\xe1\xdf\xef\x67\xe5\x5f\xec\x79\x26\x9f\xae\x4d\x31\xe9\x9b\x1d\x8f\x13\x43\xbf\xa0\xc9\x24\xe5\x4f\x73\x63\xff\x13\xdd\x2b\x3a\x51\x4e\x58\x81\x18\xf9\x54\x56\x58\x79\xfc\x46\x8b\xba\x9b\xd3\x55\x7e\x45\x45\x9f\x28\x1f\xa5\xf0\xf4\xf8\x1e\xef\x8f\x15\x2b\xe2\xc1\x40\x60\x05\xa8\x7e\x91\x96\xbf\x54\xda\xfd\x5c\xd0\x49\x90\xa6\x59\xd9\x83\x15\xac\x17\xf4\xc2\x75\x50\x14\xbd\xa0\x68\xed\xc5\xea\xd2\x93\x43\xd6\x65\xce\xd2\x67\x65\x87\x8a\xb7\x52\xfb\x34\x88\x83\x36\x7f\xec\x76\x7d\x8b\xe4\x46\x73\x52\xc0\x12\xa4\x71\x69\x54\x4b\xd2\x1e\xbc\x69\x95\x1b\x72\x92\xc0\xd3\xbf\xe4\xa1\xc1\xca\xc1\x69\x39\x10\x5a\xdd\xca\xe2\x70\xd2\xea\x7a\xeb\x6d\x30\xd8\x30\xa4\x3c\x0c\x1e\xd0\x68\x0c\x5e\xd8\xd4\xca\x22\xf5\xb0\x76\xc1\xca\x73\x99\xf7\x4d\xdc\x54\x55\x35\x74\xb8\xf0\x86\x33\xaf\xe1\xe2\x82\x96\x84\xed\x31\x6a\x3f\xbe\xb2\x55\xc0\x74\x6c\x8e\x4b\x5d\xaf\xc8\x9c\x96\xdd\x28\xbd\xdd\x6e\x58\xde\x63\x1f\x37\x39\x2b\x0a\xe8\xe3\xf5\xb6\x28\x7b\x2c\x29\xc1\xbe\x60\xc5\x7a\x90\xbb\x97\xe5\xed\x2d\x74\xa3\xcb\xb2\x2b\x95\xd3\x73\x04\x5a\x96\xc6\x78\xdc\x87\xb5\x8f\x7c\x5f\x70\x30\xd6\xe4\x51\x87\xfc\x6b\x0f\x1b\x41\x5d\x17\xef\xe2\x77\x3d\x79\x87\xe2\xee\xd1\x93\x93\xea\xb2\x15\xda\x3a\xc2\x39\x1e\xd0\xdd\xae\x33\x17\x62\x18\x86\xb9\xd1\x80\xa8\xd1\x80\xa8\xeb\x99\x89\x8a\x47\xea\xfa\xd1\xfb\x17\x55\x84\x21\xdf\xc3\x9d\x77\x34\x48\xa4\x64\xdd\x1e\xd5\x75\xfd\x91\xea\x98\xd1\x18\x61\x4a\xc5\xab\xeb\xba\xce\xfa\xf2\x7b\x8d\xc6\x5c\xb6\xcd\x57\x15\xb6\x3b\xb9\x11\x8f\x7e\xa8\xe5\xbd\xf9\x10\xd8\x0f\x2c\x66\x39\x4b\x43\x49\x38\x20\xc4\xf4\xae\x82\x22\xfd\x8f\xb2\xb7\x82\x1b\x79\x49\x9a\xc0\x93\x0e\x49\xc1\xa2\xde\xb0\x57\x00\x5d\x21\xdc\x4a\x01\xac\x80\x45\xb5\x0a\xa6\x31\x41\x56\xcd\x09\x02\x8f\x74\x4b\x3d\x5d\x9f\xd2\x08\x95\xb8\x39\x08\x35\x71\xcf\xa1\xc5\x7e\xe3\x75\x9b\xab\x7a\x67\x23\xc3\x10\xef\x92\xb6\x4d\xab\x09\x1e\xd5\x63\xf8\x03\x8b\xd7\xfc\xd5\xdd\xbe\xf8\x56\x8f\xa5\x38\x2e\xa8\x5e\xed\x3c\x8a\x35\x8a\xab\xe0\xba\x95\xa4\x03\xa1\xf3\x3c\xfb\x78\x27\x13\x55\x4f\x98\x8b\x6e\xbf\x0a\xca\xc6\x04\x52\xda\xc0\x4a\xc1\x72\x54\x19\x82\xe4\x64\xb1\x6c\x8a\x2c\xb0\x20\xf6\xcd\xfa\xc1\x4e\xd9\x94\xfd\x1e\xa9\xc1\x6d\x24\x07\xce\x99\x92\xbc\x7a\x2a\x0a\xde\xb2\xad\x1f\x22\x2b\xaf\x92\x02\xd7\x95\x65\xf9\x2c\xa5\xc7\x4d\xc8\x6b\x15\x18\xc9\xf0\x1e\xc4\xac\x5e\x4a\xf3\x6e\x1d\x99\x6c\xc0\x4a\x28\x8b\x9a\x0c\xf4\x5a\xb4\x86\x51\x88\x6b\xd6\x0b\x33\xf0\x15\xcb\x93\x1b\x16\xbd\x2d\x83\x92\x9d\xe5\xd9\x35\x30\xe3\x82\x97\x02\xc3\xb5\x29\x2a\xc1\xb9\x80\x68\x3c\x53\xea\xf6\x2a\x90\x95\x3c\x5b\x8b\x9e\x2f\xd5\xa6\x44\x45\xcb\x38\x39\x06\xe9\x1f\x68\x48\xf3\x2d\xaa\xaa\xf6\x74\x9e\xfa\xe9\x76\xbd\xde\x1b\xff\x1f\x7b\xef\xbe\xdc\x36\x8e\x34\x8e\xbe\x0a\x8d\xfa\x8e\x96\x5c\x83\x1c\x51\xbe\x86\x5e\x8e\xcb\x71\x3c\x5f\xf2\xe5\x32\xf9\xec\x4c\x66\x77\x1d\x57\x8a\x16\x21\x8b\x13\x8a\x54\x48\xc8\x97\x91\xf9\x16\xe7\xcf\xf3\x74\xe7\x49\x7e\xd5\x0d\x80\x04\x6f\xb2\x9c\x64\x77\x33\xbf\x9a\xda\x9d\x58\xc4\xb5\xd1\x68\x34\xba\x1b\x8d\x06\xbc\x8b\x82\x90\x5a\x56\xeb\x81\x29\x98\x7a\xad\x2f\x31\x94\xcc\xaf\x06\x73\x50\xa5\x2b\x7d\x03\xd3\x7d\x2e\x55\x85\x8f\x19\x0b\xc6\xfc\x45\xc2\x59\x96\x04\xf1\x59\x12\xcc\xf3\x69\xca\x7f\x8a\x83\xab\x4a\xc1\xea\x2e\x23\x7a\xb9\x62\x5c\x25\x3c\x65\x10\x73\xf5\x97\x79\x08\xc8\x42\x39\xaa\xa6\x2c\x22\x70\x7e\xa2\x03\x91\x69\xf3\x77\x57\xad\x2f\x8d\x84\x61\x91\xc0\x9a\xdd\x00\xad\xf2\x14\xc0\x38\x4e\x67\xf3\x34\x61\x89\xbe\xe9\x48\xbe\x71\x1c\x24\x46\x9a\xc4\x77\xc6\x3c\x8d\xef\x26\x51\x1c\x4b\xd1\x60\xac\xaa\xe4\xa4\xef\x4d\x74\xd6\x3b\x37\xdd\xec\xa1\x6f\xdc\x72\x55\x1a\x4c\x3e\xde\x03\x93\x09\xaa\x11\xfc\x49\xf1\x4f\xcf\x9a\xe6\x4e\x09\xe5\xaf\x51\x1c\xbf\x4e\x17\x09\x3f\x4c\x7c\xd2\x4e\x25\x5e\x67\xf5\x5f\xde\x9c\x1d\xfd\x74\xf2\xb1\x5d\x7e\x30\x30\x13\x9f\xf4\x66\x13\x8b\x3e\x0c\xce\x29\x1b\xb3\xe8\x1a\xe5\xbd\xfc\x30\xf3\x49\x6f\xe6\x23\x80\xd3\xab\x81\xe8\xe4\x93\x87\x4a\xad\x05\xaa\x98\x87\xc3\xb4\x01\xa4\x48\x7e\x04\x78\xa2\xc2\x60\x60\xa6\xdd\x80\xc9\x06\x2d\x2a\x85\xa0\xe4\xfe\x5e\xfe\xca\xca\x5f\xe5\x3b\x82\x0c\xcd\x39\x71\x70\xf7\x26\x98\xb1\xfb\x7b\x86\xfa\x1f\x8d\xbb\x1f\x95\xea\xa3\xc4\x43\xd2\x97\x63\x5a\xc4\x23\x7d\x2b\xd1\x22\x07\x62\xa5\xc8\x55\xf2\x4b\x92\x07\x13\x66\xc4\xec\x2a\x18\xdf\x19\x71\x34\x61\xe3\xbb\x71\xcc\x72\xe3\x06\x56\x0c\xc8\xd5\x97\x0c\x45\x6b\x16\xa2\xce\x5c\x8e\x3a\x37\x16\x79\x94\x5c\xa1\x30\x5e\x26\x1a\x47\x6f\x5f\xe4\xce\x87\xe4\x43\x42\x36\x83\x4d\x02\xaa\x78\x6e\x90\xcd\x78\x93\x18\x97\x0b\x6e\x04\x71\x9e\x1a\xe3\x34\xe1\x41\x94\xe4\x42\xfd\x4e\xe3\x38\xbd\x81\x76\x5a\x10\x78\x64\x53\xbe\x30\xed\x27\x87\xe4\x43\x62\x18\x64\x33\xc1\x97\xf1\x54\x72\xa6\x92\xb3\x5a\x72\xaa\x92\x53\x4c\x26\x00\xce\xbb\x29\x33\x82\xcb\xf4\x9a\xe9\x43\xcc\xa7\xe9\x22\x0e\x8d\x4b\x66\x64\x6c\x96\x5e\xb3\xd0\x31\x5e\xb1\x20\x4b\x8c\x59\x9a\x61\xf1\x05\x47\xbb\x8a\x52\xfd\x8d\x29\xcb\x98\xf7\x21\x51\xf7\xc9\x27\x97\xce\x8c\xfd\x80\xec\xd0\x0e\xf2\xbb\x64\x6c\x97\x88\xb0\xcb\x6e\xec\x69\x9a\x7e\xca\x89\x55\x74\xaf\xf4\x55\xac\xc6\x6c\x10\x33\xae\x50\x7f\x46\xb9\xd3\xbb\x28\xfc\xab\x9e\x55\xd1\xc7\xa1\xfa\xc4\xf9\xaa\x8b\x67\x51\x28\xcb\x76\xb1\x58\xa0\x90\x92\xc1\xf6\x12\x5d\x93\x74\xf8\x34\xe0\x46\x98\x1a\x50\x5b\x88\x4b\x46\xbb\x3f\xd3\x32\xc0\x8f\x72\xca\x8c\x72\x03\x20\xe0\xa5\xd2\xb1\xf0\xfc\x6b\xf9\x4e\x4e\x17\xdc\x07\x5d\x89\x7e\xe7\xbb\x46\xfe\x43\xdb\xe0\xe1\xaa\x02\x5e\x72\x10\x69\xa7\x57\xd0\x30\x68\x75\x6a\x13\x68\xbd\x8d\x28\x9f\x50\xf7\x7d\xb5\x51\x2c\x8b\x03\x29\x42\xb5\x1e\x45\x5c\x5d\x76\x59\xd0\xa0\x69\x64\x01\xeb\x1c\xbc\xd2\x9f\xfd\x4d\x9d\x04\x1e\x64\x9b\x9b\x56\xe2\x07\xf8\xb0\x64\xe9\xa8\x93\x58\x3f\xfa\xc3\xfb\x7b\x33\x55\xef\x28\x96\xb2\x47\x2a\x9f\xc5\x5c\xcb\x5c\x13\xf8\xab\xca\x7c\x01\x40\xb2\xb9\x72\xee\x3b\x4f\xad\xd5\x29\x7f\x02\x87\xfe\xd5\x08\x14\xce\xd3\x0a\xe7\xb7\x1d\x12\x7b\x29\xd1\x2e\x8b\x86\x74\x8c\x3e\x66\xe0\xda\xf2\xc3\x87\xdc\x3c\x0f\xec\xdf\x8f\xec\x7f\x5e\x6c\x5a\x3f\x58\xf0\xc8\x27\x4f\x5f\xa5\x37\x2c\x3b\x0e\xe0\x21\x68\x70\x1a\x38\x68\x7a\x5f\xc0\x3b\xb3\x26\xf7\xa3\xfc\x4d\xf0\x06\x7c\x1f\x48\x12\x24\xc4\x33\x87\xf7\xcc\xda\xf0\xd9\x21\x99\x80\x33\x13\xf1\x48\x94\x70\x76\xc5\x32\x62\x51\xde\xf1\xc6\xa3\x1c\x04\xbb\xbf\x07\xb1\x31\x8d\x99\xc3\x94\x4a\xc4\x66\x10\x94\x39\x97\x0c\x1a\xc2\x94\x30\x4e\xb4\x33\xde\x72\xac\xcf\x24\x6c\xf8\x28\x25\x1b\x0c\x48\x92\x26\x8c\xc0\x6f\x7c\x4d\xf3\x85\x32\x4b\x98\x3f\x49\xcd\xfa\x8c\xdf\xc5\x51\x72\x65\x99\xbf\xd0\xa5\xf4\xf8\x7c\x1a\xe4\xcc\xdd\xf5\xb8\x50\x55\x4d\x66\x35\x3c\x6b\x3e\x09\xcf\x1a\x49\xa0\xfc\xfe\x9e\xff\xa8\x4e\x4b\x60\x5a\x78\xe5\xa4\x56\x9e\xa9\x88\x13\xf0\xca\x46\xcc\x2d\x30\xc3\xa0\xfd\x25\x53\xb3\x28\x21\x37\xb4\xa7\x28\x4f\xca\xc5\x20\x39\x97\x7c\x4a\xb1\x62\xa7\x72\x75\x48\x98\xa4\xdc\xd5\x22\xa5\xd6\x64\x8b\x47\x13\xf7\xa9\xed\x96\x93\xf9\xb3\xf2\xdf\xf0\x93\x86\xfa\x8b\x32\x54\x2d\x05\x77\x70\x8b\x92\xd7\xc1\x1c\x2b\xdc\xdf\x93\x33\xc6\xf1\xe7\x21\x0e\xd0\x99\x64\xe9\x0c\x74\x48\x52\xfa\x9e\xc8\x82\xf8\x74\xdf\x2f\xd1\xfd\x0b\x2b\xe1\xe6\xa1\xb7\x7f\xef\xee\xde\x6f\x8d\x2c\xf3\xd0\x3b\x8e\x83\xd9\x9c\x85\x96\x68\xe1\xbf\x7e\x10\xef\x38\x26\xd6\xa1\x18\x9b\xf4\xcb\xd0\x84\xe6\x9f\x35\x93\xeb\x41\x8f\x2e\x2a\xd6\xac\x90\x49\x7c\x9f\x9d\x37\xac\x06\x17\x88\xd6\x9a\x77\x07\x3a\xc5\x98\x09\x3e\xec\x68\xdd\xdf\x73\xf4\x8d\x69\x3b\x1a\xa9\x29\x5e\x26\x83\x81\xc9\xfc\x44\xf8\x40\x66\xfe\x50\x67\x65\xd6\xb2\x90\xe8\x5d\xe6\x5e\x4a\x93\xca\x0e\x51\x52\x7b\xf6\x63\x49\x2e\x87\xcb\x30\x4d\xd0\x58\xe3\xc9\x5f\xea\xed\x38\x76\x9e\x6d\x6e\xc2\xa9\x32\xab\x5a\x40\x15\x0c\x36\x28\x56\xd0\x89\x97\x16\x45\xa7\x19\x0a\x8c\xec\x71\x14\x1a\x01\xe7\x6c\x36\xe7\x06\x4f\x0d\x61\x31\x11\xe7\x05\xf8\xfb\x32\xae\xec\x8e\xce\x87\xe4\x45\x62\xa4\x59\xc8\x32\x28\x7b\xc9\x0c\x55\x84\x62\x05\x88\xe6\x70\x67\x08\x1b\x42\x2e\x6c\x5a\xd3\xe0\x9a\x19\x81\xd1\x42\xad\x69\x19\x33\xc6\xa7\x69\xe8\x90\xd2\xad\x08\xd4\xaa\xc8\xdf\x50\x6f\x65\x2e\x73\x1d\x23\x49\xc7\xfc\x98\x56\x51\x47\x1b\x34\xc4\xfc\xc4\x49\xd8\x2d\xaf\x94\xf2\xd8\x67\x0e\xa0\x8c\xb2\x26\x8e\x22\xe8\x32\xf0\x11\x49\x5a\x33\xa0\x3b\x96\x64\x91\x38\x02\x9c\xfb\x7b\xf5\xcb\xac\xd4\xb7\x68\x62\x46\x52\xdb\x82\x63\x88\x8a\xfc\xde\x6a\xc6\xa4\xb2\x65\xd6\x49\x50\xda\x2a\x95\x5e\x57\x8d\x0a\x1a\xe9\x6e\x34\x4d\x51\x0d\x94\xc0\x79\x86\xe4\x61\x55\xc3\xb5\x45\x27\x7a\x38\xa9\xf7\x63\x2d\xd7\xa5\x8f\x1c\x5d\xa1\xfe\xbd\xe4\xa1\xbf\xf9\x7b\x5c\x2e\x0e\xf3\x58\x6d\xb2\x41\x9e\x47\x57\x49\x1d\x6d\xeb\x1f\x48\x94\x79\x70\x7e\xad\xaa\x21\x22\x13\xab\xc5\x2b\xeb\x7e\x65\x82\x63\x82\x3e\x0f\xcb\x1c\x9e\x54\x4e\xce\x33\xcd\xf5\xb2\x50\x2f\x7c\x37\x8c\x35\xd5\x70\x7e\x03\x58\xe1\x79\x5b\x9e\x45\x33\x53\x1c\x48\xc3\x8c\x9f\x03\x43\x34\x99\xff\x3f\x67\x3f\xbf\x71\x04\x5f\x8f\x26\x77\x26\x7e\xa2\x67\x30\xf0\x1f\x0b\x1c\x46\x65\x5f\x67\x26\x41\xf4\x12\x5a\x2f\x83\xac\x6f\x09\xad\xb1\x7a\x69\x69\xe9\xeb\x28\xae\xde\xf3\xfe\xe1\x83\x7d\xf8\x21\xdc\xfc\xe0\x7c\x08\x37\x7f\xb0\x06\x83\xee\xf4\xf3\xe1\x85\x5f\x09\x62\xd0\xb2\xd8\xcf\x69\xcd\x51\xb9\xab\x61\x66\x77\x37\xcc\xec\xd5\x0d\xbf\x41\x6e\xdb\xd3\x68\x47\x7b\x5d\x4d\x29\x59\x43\x73\x2c\xef\x6c\x8e\x7d\xd8\xec\x01\xf2\xc3\xe6\x43\x4d\x57\x70\x56\x36\xc3\xa2\xbc\x4d\xc0\xea\x92\x93\xf0\x16\xd6\x56\xba\xf2\xef\x3d\xd3\x77\x2e\xe5\xd6\x82\x3e\xc0\x42\x8e\xaa\x8a\xc1\x27\x7d\x13\xbc\x51\xb9\x8b\x38\xae\x65\xc3\x37\xaa\xe4\xb2\x00\xcf\x16\x4c\x2f\x70\x99\xa6\x31\x06\xaa\xd8\x50\x1d\x4c\x82\x38\xef\x29\xe2\x5a\x07\x52\x20\xf2\x60\x66\x7d\xb0\x97\x56\x64\x54\xd5\x00\x9d\x83\x50\xe0\x2b\x50\x02\xf2\xd4\xea\x38\x33\x37\x5c\x01\x4e\xb5\x1c\xce\x74\x83\xf4\x12\x56\x9c\xc7\xe4\x2e\xc7\x35\xe6\xfa\x5a\x63\xae\x0c\xef\x2d\x2c\x0b\x8b\x2e\x73\xb8\xf6\xec\x05\x66\x60\x2e\xaf\x59\xc6\xa3\x71\x10\x1f\xc5\xd1\x55\xe2\x91\x59\x14\x86\x31\x46\xc7\xb1\xe8\xb2\xa0\x4b\x8c\x10\xed\x81\xd4\x12\xa7\xd9\xa1\xfc\xab\x5d\x85\x9e\x62\x50\x5c\x8f\xb8\x6c\x46\xe8\x4d\x14\xf2\xa9\xf8\x5d\xe8\x66\xd5\x77\x35\xb3\x5c\x90\xb1\x84\x7f\x04\x90\xc1\x91\x0c\x65\xa0\x7c\x1e\x8c\x19\x3e\xdc\xfe\x79\x91\x72\x96\xff\x9c\xbc\x64\x77\x39\x3a\x57\x73\xb8\x1e\x07\x5e\x75\xce\x6f\xf9\xf5\x69\x9a\x72\x70\xad\xc3\x4a\x34\xaa\x4c\x22\xc8\xb8\x5f\xb2\x3b\x70\xad\xc7\xcc\x43\x61\x1e\xa9\x9c\x86\x37\x02\x38\x7c\xdb\xf0\xfd\xb8\x3c\x72\x8a\x0f\x25\x27\x80\x97\xa6\xa3\xc3\x23\x27\x90\xc2\xec\x49\xcc\x80\xcf\x99\x24\x9f\xc3\x14\xd6\x98\xa7\xb9\x2c\xe8\x8d\x99\x52\x51\xd5\xfe\xc4\xe0\x51\xdc\xe5\x27\x76\xe7\x25\x85\x45\x7b\xdb\x58\xa2\x15\x11\x2c\x37\x9e\x56\xb3\xa0\xf9\x8a\x3a\xd8\x0d\x20\x3c\x01\xa3\x95\x47\x2c\xcb\xeb\x2d\x0b\x43\xb2\xbc\x47\x0e\x41\x70\x36\x1b\x10\xf5\xe8\x41\xc8\xba\x30\xfe\x82\x66\x83\x41\x7f\x25\x41\x6c\x4d\x42\xe3\xe9\x9c\x14\x05\xfd\x0b\xf9\xcb\x8a\x0e\x61\x54\x80\xa2\xaf\x6b\xff\x5b\xa2\xb8\x22\xea\x53\x9d\xa8\x91\x4a\x0f\x14\xcb\x72\xa2\x71\x9a\x80\x46\xc4\x24\xb3\xe2\xe0\xa0\x71\x15\x57\x1c\xa2\xdd\xc9\x1d\xeb\x9a\x24\x4e\x09\xbb\x9d\x07\x49\xc8\x42\x1b\x1a\x25\x56\x6d\x12\xea\x79\x85\x25\xb9\x51\xfe\x79\x11\x64\xab\x3a\x9b\x7d\x9b\xce\x14\x5f\xeb\xed\xe7\xf2\x9b\xf4\xa3\x71\x92\x57\xdf\x16\xe9\xd7\x7d\xf0\x8d\xd3\x38\x0e\xe6\x79\x37\x80\x8d\xcc\xf5\xd1\x7e\xf5\xad\xba\x7b\x10\xf1\xd3\x6f\xd4\x53\x51\x24\x4e\x66\x4a\x8d\x18\xde\xd0\x1d\x5a\xf4\x08\xd4\x06\x78\x38\x77\xe6\x7c\xfc\x98\x2f\xe6\x78\x34\xff\x8c\xcd\x33\x36\x0e\x60\x92\x7e\x15\x66\x4f\x50\x18\xae\x1e\x2c\x71\xfd\x50\x09\xf9\xfa\x6e\x79\x3f\x37\xfb\xed\xda\x96\xc3\xaf\xae\xe8\xce\x82\xb1\x71\x15\x44\x49\x9a\x55\x97\x71\xb3\xab\xcb\xc0\x1c\x52\x43\xfe\x5f\x5d\xd3\x75\x31\xc7\x1c\x6d\xef\x50\x43\xfd\xa3\xf2\x46\x32\x6f\x0b\x92\xe5\x3f\x2a\x6f\xab\x19\xf8\x61\xbb\xd5\x85\xb3\xa5\x0a\xef\x34\x03\x4b\xec\x36\x43\x4f\xec\x35\x83\x53\xec\x57\xe1\x1a\x56\x44\x89\xa8\xc5\x91\xe8\x8c\x12\x51\x8b\x01\xf1\xac\x09\xc7\x49\x33\x8e\xc4\x4f\x55\x1c\x89\x82\xbe\xa8\xae\x41\x03\x9a\xaf\x32\x76\xb7\x06\x8e\x5d\x6a\x88\xff\x0f\xd5\xf0\xdd\x56\x8e\xe3\xaa\xbc\x51\x1b\x6d\x23\x95\xb7\xd5\xae\xb7\x65\xf5\xa3\x7b\x5b\xe5\xed\xb4\xeb\x95\x73\xba\xdb\xce\xdb\x55\x79\x7b\xed\xbc\x3d\x95\xb7\xdf\xce\xdb\x57\x79\x4f\x56\xe4\x1d\xad\xc8\x7b\xba\x22\xef\x78\x45\xde\xb3\x15\x79\x27\x2b\xf2\x7e\xea\xcc\x2b\xe8\x73\x7f\x79\x33\x8d\x38\x83\x7b\xe0\x78\x6f\x3c\x0e\xc6\x9f\x90\x20\x87\x84\xf2\x2c\x48\x72\x21\xa4\xb5\xaa\x5b\x84\x5e\xc5\xe9\x65\x10\xff\x94\x26\xfc\xa7\x60\x16\xc5\x77\x1e\x81\x38\x79\x28\xc0\xa9\xcc\xe3\x45\x96\x03\xc5\x94\xcb\x14\x4c\xb4\x09\x7f\x1a\xa7\xe3\x4f\xbf\x0a\x11\x71\x67\x7e\x4b\xe8\x65\x16\x8c\x19\xb4\xf4\xab\x14\x22\x2f\xd3\x38\x94\xc9\xaa\x8d\x79\x0a\x0a\x48\x46\x28\x8b\xe3\x68\x9e\x47\x39\x94\x3f\x8b\x7e\x67\x1e\x71\xf7\xe7\xb7\x55\xfa\xab\x28\x61\xcf\x95\x30\x3a\xd4\x73\x5a\x4d\x7d\x62\x77\xaf\x83\xec\x2a\x4a\x3c\x32\x9c\xdf\x1a\x08\xcb\x27\x76\xf7\x8a\x71\xce\xb2\xb3\x79\x30\x8e\x92\x2b\x8f\x0c\x1d\x95\x81\x5d\xc2\xc6\xe2\x09\x33\x28\xa4\x3d\x45\xe5\xfd\x34\x08\xa3\x45\xee\x91\x2d\x59\xf2\x18\x84\xb5\xfa\x68\x3e\xb1\xbb\xf7\x6d\xc9\x04\x9a\xf8\x19\x7a\xe2\x77\xd0\xd3\xfe\x8e\x9e\xf2\x1c\x62\xda\x78\xc4\xc5\xb4\xf7\x41\xfc\x16\xdc\x4a\x93\xab\x77\xe9\xbc\xea\xa9\x4a\x7e\x9a\x72\x9e\xce\xba\x72\x4e\x05\x1c\x6a\x18\xef\x83\x58\x40\xfd\x8a\x4d\x00\x49\xf3\x5b\x23\x4f\xe3\x28\xac\x67\xca\xce\x47\xcd\x5c\xd9\xa6\xcc\xde\x12\x78\x33\xe0\xef\x36\xb4\x0f\xde\x8f\x2c\x3c\x4e\x13\xce\x12\x2e\xb0\x2b\xba\xd9\x85\x5c\xf4\x50\xbd\x8c\xd9\x7b\x50\x50\xea\xd0\x61\x3e\xc8\x79\xda\xc4\xba\x7a\x5a\x1d\x9d\x90\x2a\x1b\x10\x43\x18\xa9\xb2\x1d\x33\x92\x04\x49\xab\xd5\x20\xe9\x68\x34\x48\x3a\xda\x0c\x92\x8e\x26\x4b\x0d\xb3\xd9\x70\x2d\xa3\xde\x7c\x99\xd5\xee\xa4\xcc\xea\xe8\x2a\x0c\x78\x00\x26\xa6\x66\x4f\x2a\x5d\x60\x59\xa2\x71\x5b\xe5\x80\x5e\xa5\x53\x16\xa1\x42\x52\x87\x16\x3a\x3a\xa9\x32\x35\x2a\x8f\x78\x10\x47\x63\x3d\x53\x5f\x2f\xbb\xf3\x5b\x03\xfe\xe2\x22\x1b\xc7\xd1\xfc\x32\x0d\xb2\xb0\xb5\xca\xaa\x9c\x29\x1b\x7f\xd2\x49\xc2\x76\x71\xd2\x66\x8c\x07\xcf\x02\x1e\x94\x58\x91\x8d\x62\xe3\x2e\xb6\x8e\x9a\xd2\x7f\x67\xe9\x62\xfe\x9a\xf5\x14\xc4\x81\x83\x2c\x03\xb4\x17\x44\x09\xcb\x24\x8b\x71\xf7\x20\x87\xa7\x69\xcc\xa3\x79\x59\x15\x8b\xb3\x30\xe2\x2f\x92\xf9\x82\xbf\x8e\x12\x55\x7a\x6b\x58\xcb\xa9\xa3\x6a\x54\xcb\x2b\x1b\xdb\xa9\x37\xd6\x9a\x8f\x32\xab\x87\x5d\x22\xd8\x4d\xbc\x41\xa2\x36\xe5\x3b\x6a\x7c\xb2\x57\xd9\xbe\xa2\x04\xb1\xa4\x74\xec\x8e\x54\x85\x1a\x7d\xe0\x64\x87\x8c\xb3\x31\x67\xe1\x69\x7a\xd3\xe2\x29\x41\x18\xbe\x64\x77\xc7\xb0\xbc\x9f\x06\xe3\x4f\x57\x18\x84\x43\x6e\x00\x70\xd1\xcb\xa8\xfe\x11\xbb\xb2\x56\xe1\x6d\x9a\xa3\xdb\xbb\x47\x82\xcb\x3c\x8d\x17\x9c\x75\x66\xbf\xbd\x45\x02\x52\x79\xaf\xd3\x30\x88\x25\xfa\x47\xc3\x66\x86\xa2\xb8\x60\xc1\xd3\x5a\x86\x84\x5c\x71\x79\x2d\x47\x27\xec\x82\xfe\x04\xe1\x21\x76\x2c\xfa\x4b\xed\xe0\xae\xf7\x28\xef\xb2\x1c\xf4\xb1\x34\x79\xe0\x16\x3a\xac\xb6\x11\x3d\xf9\x89\xdc\xa5\xf4\xb4\x3d\xaa\x34\x97\x17\xe3\x34\x51\xa9\xcf\x68\x29\x55\xeb\xc9\x27\x6a\xbb\xd0\xaa\x07\xd2\x88\x51\x4b\x3e\xd6\x56\xa1\x4a\xdb\xa6\xe3\x74\x7e\xf7\x2e\x3d\x56\x4b\x4c\x65\xfc\xd4\xcc\xc0\xb5\xa7\x72\x9f\xc9\xa6\x04\x6d\xab\xd4\x51\xc9\x50\x72\x6f\x29\xcd\x56\x2a\xef\x04\xf2\x98\xfa\x7a\x46\xd1\x60\xa9\x3e\x9f\x96\xe7\x86\x55\x01\x69\xd2\x53\x09\x3f\x51\x61\x91\x55\xdf\x4f\x68\x52\x35\xbe\x8f\x1a\xb5\xfa\x3a\xaa\x78\xa1\x4a\xda\xa1\x19\xbb\x62\xb7\xf3\xaa\x48\x35\x49\x2a\x6d\x54\xe0\x02\x7c\x2f\x37\x18\x6f\x09\x5f\x75\x44\x8f\xc1\xe2\x1e\xeb\x69\x4f\xa8\xf0\xdc\xa8\xa7\x05\x61\x6d\xe2\x4e\xe8\x18\x90\x57\x4f\x6a\x03\xe0\x52\x65\x22\x53\x30\xd6\xb0\xbb\x57\xe8\x14\xea\x2d\xdb\x0d\xec\x34\x6a\x6c\x37\x1b\x8c\x83\x4b\x16\xd7\x68\xc2\x2d\xc0\xd4\x17\x85\xa8\x6e\xfd\x14\x44\xf1\x22\x63\x5d\x4d\x3f\x41\x3e\x50\xaf\x4a\x27\x69\xc2\x1b\xad\x15\xda\x69\xf4\x92\x04\xf3\xb9\x2d\xbd\x6d\x58\x46\xbc\xe5\xa4\x62\x5e\xcf\x9d\xa6\xf8\x47\xc7\x82\x7f\xa9\x1c\xb1\x0d\x68\x78\x12\x5d\x71\xa7\x91\x42\xe7\x25\xcf\xc8\x18\xdc\x5a\xb9\x46\xa3\xa3\x5c\x6b\xde\x52\xda\xf5\xe0\xac\x3b\x8e\x12\x66\x5f\x82\xf0\x48\x24\x6a\xb8\x53\x5b\x94\x38\x24\x64\x97\xcf\xcb\x0c\xc5\x41\x69\x5c\xc9\x85\xcf\x9d\xb6\xb0\x58\xc1\xaf\xf2\xc4\x08\x0a\x4a\x70\x85\xdb\x59\x7a\x43\xfa\xc1\x69\x30\xef\x42\xb0\x85\x87\xca\x3f\x77\x34\x19\x97\x4e\x2a\x81\x41\x66\x54\x22\x44\x39\xe0\x8a\xdd\x14\x4d\xeb\x89\x27\x8d\xb4\xdc\xd1\xf9\x4f\xd1\xd2\xf5\xab\x72\x35\x8e\x54\x60\x1f\x49\x2f\xcc\x33\xc1\x88\x9f\x3b\xa5\xd8\x5c\x02\xa5\x58\x18\xed\xb2\xbd\x49\x66\xf3\x12\x65\x4b\xed\x2c\xb1\x72\x51\x90\x96\xbb\xc0\x5c\xce\xab\xdd\xe8\xb9\x53\x93\x37\xdf\xa5\x73\x2a\x73\x4f\x25\x8a\x6a\xf9\x98\x48\xe7\x35\x51\xb8\x51\x44\xa4\xca\x55\x86\x7b\xe4\x73\xa7\x29\x0e\x6f\x12\x83\x6c\x72\x47\xe7\x8f\x94\x78\x53\xd8\x0d\x89\xa7\xc0\x83\x82\x5e\xe2\x68\x5f\xb6\xbb\x49\x60\x13\xea\x6d\x1b\xc5\xe5\x8e\xc6\x8b\x02\x5c\x21\x8a\xd2\x04\xfb\x89\xdd\xd9\xd7\x41\x6c\x27\xa9\x2d\xda\xaa\x7a\x6d\x8e\xb8\xa0\x44\x08\xdb\xb8\x48\x19\xf8\x72\x2e\x67\xd5\xfe\xff\xdc\xe9\x11\xc5\x8b\xba\x14\xae\xcf\x08\x6f\xcf\x48\x0f\x31\x34\xa6\xa2\x57\xae\xef\x5e\xde\x5c\x1f\x32\x5a\x9d\xfb\x57\x4a\x93\xc4\xe2\x9a\x76\xf6\xdc\x69\x2a\x6c\xb8\x8c\xce\x90\xa0\x9e\x3b\xba\xd2\xd6\x20\xce\xe7\x4e\x53\x23\xa3\xa9\x14\x97\x9f\x3b\x95\x0e\xa6\xcd\x7e\x57\x36\xce\x6b\x51\xe8\xa7\x00\x0f\x0e\xa5\xb6\xbd\x7f\xaf\xe3\x91\x93\x93\x47\xbf\x33\x8d\xb1\x54\x22\x08\xbd\xd4\x05\xe3\xe7\x4e\xb7\x72\x51\x03\xbe\x2a\x52\x8d\xa1\x64\x2a\x55\xa6\xe2\x2d\x0d\xe3\x41\x41\x09\xc8\x27\x36\xe8\x35\x36\x6e\x85\x72\x4b\x92\x0c\x5f\x09\x2f\x25\xc3\x9f\x69\x62\x6f\x95\xad\x09\xc3\x1a\x7e\x1a\x0a\x53\x41\x95\xfc\xf3\xd0\x5c\xaa\x66\x73\x47\xd6\x28\x84\xa4\xb4\x7e\x3d\x28\x2e\xc6\xc6\x60\xe5\x2f\x58\x73\x19\x77\x08\xf7\x85\x14\xc0\xd6\xef\x05\xcb\x57\x77\x3e\x1f\x01\x9f\xaa\xd2\xda\xe3\x28\x9a\x8e\x80\x4a\x21\x1c\x79\xc6\x6c\x80\x80\x14\x95\xff\x68\x39\x9c\x8a\x08\x94\x46\x59\x94\x02\xe2\xfa\x80\xc8\x1a\x85\x12\x25\xd7\xaf\x29\x2a\x14\x28\x73\xae\x5f\x2b\x09\x12\x5d\xa0\xd0\x6c\x08\xf5\x9d\x5a\x66\xc8\x7d\xba\x2d\xf0\x54\x2d\x56\x79\x6a\xa3\x12\xd5\x25\xc3\x6c\x2e\xa9\x86\xe1\xa1\x10\x62\xf2\x23\x06\x00\x87\x66\xfa\x08\xa4\x21\xa5\x63\x08\x32\xe7\x8b\xc7\x50\x19\x63\x5a\x83\x68\x58\x64\x0a\x4d\xbc\x5f\x7f\x28\x65\x1d\x7d\x3c\x65\x62\x39\xa8\x0a\xa2\x32\xaf\x07\xac\x32\x5f\x87\x6d\xcd\x81\x17\x4a\x1d\x59\x1f\x7e\x51\x01\x45\xb1\xf9\x9d\xcd\x53\xbb\x34\x8a\x00\x73\x55\xc2\x60\x65\x29\x51\xa2\x27\x68\x71\x6d\xa1\xad\xa6\xd9\xe9\x18\xd1\x4d\x06\x0d\xfe\xd7\x30\x04\x74\x4b\x6a\x55\x7f\xf6\x38\x9d\x47\x2c\xec\xef\x16\x15\x4a\x5a\x63\x54\x7d\x86\x9e\x72\x77\x04\xda\x9f\xdb\x60\xee\xb1\x01\x35\xfd\x3b\x65\x35\x8f\x9d\x86\x9f\x6a\x7b\x7a\x54\x5b\x0d\x3b\x53\x41\x89\x1c\x69\xa5\xe2\xf4\xb4\x21\xfc\x19\x9e\x3b\x6d\xf3\x52\xa1\x0c\x4b\xba\x98\x56\xb7\x35\x15\x52\xc9\x7c\x1f\x64\xa8\x43\x76\x1d\x50\xd3\x9e\x8e\x15\xf2\x75\xd5\xd6\xa9\x74\x56\xc5\x93\x25\x64\x95\x1a\xf1\x68\x7a\x40\xed\xf4\x9b\x41\x28\x35\xe8\x6f\x09\x9e\x6c\xff\xdb\xc0\xa7\x6c\x03\xdf\x12\x40\x02\x8d\xda\xeb\x52\x54\xc7\x08\x60\xf9\xc1\xc2\x51\xeb\xbd\x6f\x40\x1d\x20\x77\x0e\xb2\xb4\x5a\xf4\x8e\x48\x52\xac\x3e\x24\x49\xb3\x6a\x4c\xc2\x64\xf2\xcd\x20\x2a\x0d\x30\x5f\x01\x12\x8c\xd1\x8e\xc0\xce\xda\x0f\xd1\x4c\x99\x73\x9f\x3b\x2d\x13\x6f\x73\x27\x28\x0b\x3c\xb0\x13\xd4\x46\x52\x65\xf7\x8c\x14\x12\x35\xd6\x53\x76\x22\x07\xd3\x20\xa6\x32\x5b\xa3\x28\x5a\x33\xb7\x74\x58\x90\x41\x68\x94\x76\x5c\x69\x9c\x90\xfd\x09\xed\xb9\xd3\xc6\x5b\x50\x02\x1a\xe6\x0c\x6c\x50\x76\xc6\x3e\x2f\x58\x0e\x6a\x63\xa9\xa4\x3d\x77\x3a\xcc\xb5\x94\xa7\xf3\xee\x9c\xb7\xb7\x34\x16\xbc\xbf\x3b\x33\x93\xe3\xeb\xce\xbd\x54\x5a\x7a\x4f\x76\x63\x0a\x9e\x3b\x9d\xa6\x69\x7d\x48\xc4\x5b\x2a\x56\xad\x19\xdb\xe4\xb4\x37\x9a\xe3\x7a\x11\x5d\xac\xa9\xed\x69\x5a\x19\xa9\x97\xd4\x67\xae\x9d\x5f\x4d\xba\x96\xd7\x23\x85\x68\x25\x24\xdd\x89\xd6\x61\x06\xa5\xc5\xbf\x53\x7f\xd6\x26\x51\x69\x42\x8a\x0c\xb5\x26\x9d\xca\x60\xa8\x0f\x4a\x1c\x0c\x88\x04\x69\x28\x21\xd8\x55\xb9\x26\xc5\xf9\x52\xad\x1b\x5c\x70\x35\xc6\x06\x74\x30\x89\xd3\x1b\x8f\x4c\xa3\x30\x64\x49\x1d\x2c\xb5\x40\x95\x27\xe0\x70\xf8\xff\x54\x5b\x31\x1c\x51\x1a\xbb\xaa\x4b\x49\xe3\xfa\x79\x48\xd7\x60\x30\x4d\x22\x50\x9d\xbf\x5e\xa6\xb7\x67\xd1\xef\xd8\xa4\xc8\xb0\x2f\xd3\x5b\x52\xc7\x32\x19\x35\xc7\x22\x98\x1a\xf1\x96\x6d\x92\xe8\xdb\x67\xe7\x1d\x07\x1b\xb0\x2a\xc4\x31\x86\x20\x73\xf1\xbb\xde\xf7\x50\x1e\x8d\xca\xbf\xa4\xa5\x44\x75\x00\xa6\xb8\xed\x03\x33\xda\xc7\x41\xf1\xd8\x7e\x92\x66\x33\x8f\x64\x29\x5c\x3e\x36\xb7\x77\x42\x76\x65\xd5\xbb\xca\x17\x97\xb3\x88\x6b\xbd\x74\xee\xdf\x7d\x7d\x74\xa1\x43\x22\x01\xb0\x8d\x0c\x83\xf4\x8d\xb7\x54\x0f\x95\xa1\x75\xc5\xce\xa2\x80\xfb\x37\x58\x79\x2b\x43\xba\x3d\x11\x96\x74\xd0\x5c\x41\x6d\xf6\x08\x8e\xad\x8b\x7e\xdb\x94\xd6\x1a\x71\x39\x88\x96\xa5\xde\x29\x2d\xf0\x1d\xcc\xa9\x5d\xba\x2a\xd3\x09\x6d\x69\x13\xd1\x19\x14\x81\x55\xd6\x5d\x7c\x1c\xb3\x20\xd3\x79\x7f\xc5\x60\xba\x94\x83\xc7\x8c\xab\x3c\x6c\xe8\x25\xa0\x7e\x22\x2d\x0a\xfa\x7b\xed\x58\x4e\xfd\x36\x78\x79\x8f\xc1\x90\x37\x24\x21\x8a\x4d\xcf\x75\x81\x85\xc9\x29\x53\xf7\xb7\xa6\x5a\x14\xfc\xdc\xe4\xf4\x1c\xdd\x74\x49\xc6\x92\x10\x06\x72\x5d\x37\x83\xd6\x22\x3f\xc8\x78\x0e\xbe\xc9\x9c\xec\xb7\xeb\x17\x21\x65\x0e\xd8\x89\x3e\x82\xd1\x12\x22\x6e\x96\x8e\xc7\xcf\x94\xae\x87\xde\xcb\xc2\x23\x51\x76\x99\xac\xed\x51\xac\xb9\xe0\x35\x6d\x5d\x05\xbd\x31\xb3\xb6\x09\xcc\xb2\x28\xb7\x3c\x30\x0c\x14\xc5\x05\x5c\x03\x34\xa1\xaf\xb7\x8b\x8c\x55\xa1\x0d\xe8\xe7\xef\x0d\xa1\x07\xbd\xfe\x8a\x24\x8c\xae\x09\xbd\x31\x25\x0a\x69\xe9\x3f\xdf\xe5\xbb\xfb\x7b\x13\x7f\xe5\xd4\x80\xc7\x45\x0a\x48\x63\x96\x45\x99\x83\x33\x7c\x28\xdd\xf7\xa5\x8f\xbe\xb5\x02\x61\xff\xf8\x03\x23\x4c\x06\x12\x78\x1c\xb6\xb0\x92\xc0\xd6\x97\xd1\xaa\xb2\x5d\x16\x4d\x50\x64\x7a\x35\x0d\x78\x89\x63\x1c\xc4\xec\x5d\x34\x63\xe2\x1a\xa5\x49\x58\x62\x2f\x72\x42\x97\x37\x8c\x7d\x0a\x61\x1f\xc8\xa7\x69\xc6\x09\xbd\x63\x41\xe6\xc1\x65\x41\x96\x81\x1f\xca\x2c\x4d\xf8\xb4\xcc\xc4\x82\x65\xde\x34\x5d\x80\xbb\x92\x1d\x46\x57\x11\x47\x15\x60\xc1\x59\x95\x50\x58\xd6\xaa\x19\xff\xf5\x0f\x3c\xe3\xb8\x49\x3d\x7a\xca\x45\x2d\x31\xe7\x55\xb7\x62\x8e\x56\x61\xea\x3d\xb8\x53\xec\x5a\xf4\x29\x44\x2d\x34\x13\x73\x7b\xcf\x72\x54\x80\x48\x96\x59\xf4\x25\xa6\xf7\x62\x13\xc7\x79\x50\x22\xb3\xba\x3e\xe6\x37\x6f\x9c\xd1\x54\xbb\xe0\x9b\xc1\xd3\x27\xc3\x83\xe8\x6f\xd9\x41\xb4\xb9\x69\xa5\xe7\x91\x1e\xfa\x31\x52\x97\x7d\xe1\xe6\x28\x5e\x31\x93\x53\x94\xd0\x73\xe8\xe8\x02\x2e\xdb\x8e\x03\x6e\xa6\x10\x2e\x51\x5e\xa7\x83\xd8\xb4\x0c\x02\xf2\x54\x53\x2f\xdf\x6b\xb0\x96\x55\x64\x29\x55\xfa\x9c\x5f\xc0\x8d\x35\xed\x13\x6f\xd0\x74\x16\x3c\x4f\x9a\x65\xe1\x4e\x32\x14\x6f\xa4\xc1\xfd\xb7\x14\xa0\xb8\xea\x84\x42\x92\x40\x67\x1f\xf7\xf7\x7d\x5d\x97\x97\x73\xeb\xc9\xe7\xd9\xc5\x61\xea\x35\xca\x9e\x43\xa8\x67\xe6\x4c\x31\x80\xef\x11\x76\xaf\xc1\xa1\xee\xfb\x71\xb9\xf7\x65\xf0\xce\x55\xc0\x03\xe5\x6a\xcf\xf1\x6a\x8d\xf4\xb2\x3f\x3d\x39\x3b\x79\x47\x3c\xe6\xb0\x59\xc4\x21\xea\x7c\xce\xb8\x4d\x36\x13\xeb\xe0\x32\x63\xc1\x27\x71\x21\xe1\xfd\xd1\xe9\x8b\xa3\xa7\xaf\x4e\x3e\xfe\xf2\xf6\xd9\xd1\xbb\x93\x67\x44\x5a\x49\x9d\x05\x46\x55\x08\x3f\xe6\xd9\xd8\x67\xf2\xeb\x2c\x1b\x9b\x09\xcd\x00\x6b\x39\xe3\x66\x42\x49\x80\x80\x11\x90\x67\x84\xa4\x6a\x8b\x92\x84\xe2\x15\xa6\x02\x0a\xc3\x65\x25\x20\x73\xaf\x2a\x04\xb2\x2d\x0b\x09\x44\xa3\x52\xe0\x35\x1a\xe8\x07\xf4\xf4\xe4\xf5\xcf\xef\xff\x5d\x80\xca\x38\x22\x5f\x06\xe9\xd1\xb3\x67\xff\x2e\x38\x83\x30\x7c\x24\x94\x47\xcf\x9e\x7d\x2c\x21\x7d\x79\xf2\x8f\x8f\xa7\x27\xff\xfb\xcb\xc9\x19\x12\x4c\x13\x96\x84\xdd\xc0\xa5\xa8\xd2\x3e\x21\x20\x16\xf3\x16\x84\xa1\x9e\x87\xfd\xc0\x3d\xee\x6a\x84\xb5\x75\xa4\xe2\x72\x24\x48\xa9\xc0\x51\xb4\x6b\x66\x01\x7c\xb1\x9b\x8f\xc8\xf2\x68\xec\x9b\x89\xc3\x6e\xa3\x9c\x47\xc9\x95\x4c\x4b\x1c\x35\xae\x8f\x72\x6a\xac\x83\xd4\xc1\xb7\x43\x4d\xc1\xe8\x23\xbc\x68\x76\xc5\x38\xdc\xa9\x10\x9e\x2f\x84\x92\x3c\x1b\x13\x8b\x02\x25\x87\x8c\xcd\x8f\xd3\xf9\x9d\x99\xd3\xb7\xc0\x7d\xe8\xc2\x1f\xd3\x89\xff\xb3\x99\x56\xa1\x94\x27\x4e\x6e\x5a\x07\x1b\x66\xe4\x4f\x9c\xc4\xb4\x2c\xbc\x79\x7d\x60\x2d\xfc\xc5\x79\x24\xf8\xf1\x45\x75\x1d\x72\xe2\xc0\xad\xd3\xf2\x36\xf5\xc4\x99\x98\xe5\xfd\x40\xed\x1e\xdb\x2d\xbc\x6f\xb0\x70\xf2\x39\x06\x26\xc8\xa8\x6b\x79\x32\xd2\xef\xe2\x3c\xbb\xf0\xe4\xc5\xb7\xec\x10\xbe\xfc\xc0\x1b\xe3\x13\x4f\x79\xc7\x30\xe8\xd8\xa2\xe3\x82\x56\x23\xe9\xc2\x2f\x4d\xfd\x5b\x93\xe3\x33\x55\x25\x76\x64\x18\x04\x05\x50\x7a\x98\xf9\x6f\x4d\x6e\xa9\x0b\x63\xc4\xf7\x53\x0c\xd4\x84\xeb\x96\x5b\x8a\x95\xc2\x75\x3c\x48\x87\x38\xff\x1a\xfa\xf8\x79\x7c\x41\x13\xcb\xa2\x19\x84\x1f\xee\xdd\x69\x79\x61\xbe\x77\x4e\xae\x59\xc2\x4f\x66\x11\x1c\xa2\x5b\xd6\xc1\xd3\x32\xae\xb8\xf9\xb2\xc6\xe9\x44\x30\xb4\x97\x96\x98\xc9\xbf\xfb\x2f\xe9\x7f\xfb\x3d\xfb\x96\x74\x18\xcc\x0e\x5a\xc2\x80\x99\xf9\x89\x1e\xb4\x05\x1f\x29\xbb\xba\x8a\xc1\x47\x47\xdc\xa1\xa9\xda\xb4\x96\x59\x15\xe1\x6d\x59\x3a\xdf\x78\x1b\x99\x08\x61\x56\xf9\xe3\x68\xd1\x79\xad\xe5\xdf\xa1\x92\x99\xc9\x0d\x5a\xb2\x62\xf9\x55\x51\x73\xe5\xe5\x43\x68\xab\x3d\xab\xb0\x0a\x8a\x21\xe3\xd4\xbb\x20\xb0\x65\xc7\xc1\x5d\x87\x4f\xe4\xdc\xcc\xac\xba\x0c\xc2\xfa\x15\x18\x20\xa4\x12\x18\x25\xdf\x49\xa9\x4e\x85\xc5\x78\x02\x61\x31\xaa\xb8\xc6\x1f\x96\xe7\x1f\xf2\x0f\x67\x17\x9b\x3f\x50\x42\x56\xc8\x9b\xba\x84\xa9\xa0\xb4\xb5\x41\xca\xeb\x7e\xda\x41\xa6\x70\xb3\x2e\x8a\xfe\x36\x11\x5c\xb2\x5c\xd5\xed\x8d\xc9\x95\x60\xa5\x6c\x04\x70\xeb\xd2\x71\x9c\x55\xb5\x44\xc3\x05\xb1\x2c\xcb\x5b\x07\x1d\x85\x9a\x23\x5f\xa3\x83\xbf\xc3\x04\x69\x9a\x66\xf7\xe4\x6e\x0c\x61\x2e\xbf\xad\xb4\x49\xb9\x16\xe6\xaf\x22\x1b\xd5\xe4\x3a\x42\xa8\x6c\xfc\xf1\x72\xa8\xaa\xf8\x38\xf5\xa3\xe8\xea\x5c\x29\x1b\x35\xda\xc9\x7e\xbb\xb6\xcb\x02\x95\x05\x93\xa6\xc9\x71\x1c\x8d\x3f\x79\x38\xf0\xc6\x9a\x2d\xa4\x4c\xdc\x5e\x30\x26\x5f\xad\x48\xfc\x6f\x6d\x45\xfd\x07\x14\x09\x59\xb4\x7f\xca\xaa\x59\x57\xe8\x83\x6b\xf0\x16\x25\x6f\x82\x37\x2b\xb5\xe2\x7f\xfe\x11\x87\x06\x57\xf8\x61\x6c\xbf\xbc\x7a\xb5\x72\x70\xff\xf3\x07\x56\x00\xa5\x67\xcc\xa3\x97\x5e\x94\x7c\x89\x02\xf8\x5f\x7f\x60\x4c\x09\x3f\x8c\x47\x23\x4a\x56\xeb\xc6\x95\xc6\xe1\x57\xa1\x8d\xb1\x3e\xbc\xfd\x5f\x22\x5c\xfc\x07\xb7\xb3\xce\xbd\xab\x49\x26\x94\xfb\x55\xb6\xd8\x93\xf3\xa3\x09\x07\x77\x5d\x34\x37\x24\xca\x6e\x8b\x16\x5c\xec\x83\xa6\xbe\xf4\x84\x5d\xb6\xdc\x11\x55\x24\xaa\x32\x7a\x89\xef\xa3\x10\x3c\x18\x64\xd2\x80\xf1\x23\x44\x71\x4b\x1d\x6c\xc0\x11\xf5\xfd\xca\x7c\xde\x05\x33\x08\xbe\xfe\x6a\x19\x23\xd3\xde\xe2\xc4\x87\x9f\x7a\x8b\xdf\x98\x49\x5d\x84\x31\x50\x86\xb1\xba\x37\x59\xb9\x52\x12\xaa\x62\xa2\x3d\x76\x8d\xc8\x6a\x5f\x6c\x44\x14\xf5\xe5\x0e\x5e\xd0\x94\x2e\x1f\xd8\xa0\xff\x42\xfe\x42\x33\x8c\x1b\xb1\x6a\xd9\xf1\xde\x65\xf7\xef\x62\x57\xb2\x68\x3f\xce\x35\x86\x22\xd9\x54\xe9\x9d\x46\xac\xda\xc7\x8a\x71\x26\xcc\x7f\xe3\x2c\x72\xf6\x2a\xb8\x4b\x17\xfc\x64\x32\x61\x63\x4e\xb3\xfa\xe0\x01\x7e\x2e\x43\x40\x99\x58\xfa\x94\x4d\xac\xea\xca\x87\x91\x68\x81\xa7\xad\x25\x07\xa2\x85\xeb\xbf\x3e\x03\x79\xa8\xa0\xa9\xde\x1c\x44\x9d\xe9\x88\xfc\xc9\x0e\x59\x59\x8d\x7b\xcc\xe4\x56\x41\x83\x66\x3d\x3d\xac\x9e\x06\x48\x09\x87\x9e\xa3\x5e\xf0\xb1\x2a\xd0\x32\x6b\x59\x75\x92\xd1\x44\xfd\x1e\x0c\x52\x66\x96\x5f\xb8\x5e\xac\x2a\x17\xa2\x54\x63\x09\x0e\xd1\xa4\x29\xbe\x81\x41\x63\xe6\x2f\xc9\x2c\x4a\x6c\x11\xbf\x86\x78\x64\x48\x28\x99\x05\xb7\x55\x82\x38\x6a\x56\xf1\x6d\x86\x84\x5e\x47\x79\x74\x19\xc5\xe0\x59\xad\xce\xbe\x69\xeb\x34\xbc\xf3\x8c\x94\xfc\x6e\xc3\x15\xe8\x5b\x02\x77\x1f\x31\x6c\x0e\x9e\x94\x0e\xab\x23\x64\x52\xd0\x48\xc7\x56\xf9\x46\x06\x86\xe5\x8c\x59\xf7\x3b\x1a\x4c\x32\x99\x9c\x71\xed\x05\xbb\x18\xde\x97\xa2\x24\x9a\xc1\xe3\x3b\x41\xc2\x09\x32\xea\x9c\x61\xe4\x68\x3a\xd6\xba\xb1\x96\x05\x5d\x30\xff\x9c\x88\xf3\x4c\xe1\x15\xf0\x2b\x1c\xdd\x13\x2a\xd3\xc0\x1f\xa2\x9e\x82\x27\x8c\xf5\xa4\x77\xe9\xbc\x4a\x90\x47\xf3\x84\x92\xea\x94\x5f\x7e\x9c\x81\x57\xb9\xfc\x09\x80\xcb\xdf\x42\x8d\x23\x94\xd4\x7c\xe2\xe1\xbb\x3c\xc0\x25\x94\xc8\x53\x58\x01\x64\xf5\x0d\x00\x56\x5f\xa7\xf5\xc2\xef\xc0\x43\x8c\xf0\xe0\x52\xf6\xcc\xd9\x2d\x7f\x91\x84\x70\x69\x42\x7c\x9c\xe2\xea\x15\xbd\xc1\xf7\x3b\x75\x40\x49\x28\x41\x1f\x06\x72\x41\x27\xcc\xdf\xd8\x08\xd3\x31\x8a\xe9\x8e\xfa\x21\x57\xb3\xa2\x32\x1c\x0f\x0d\x3b\x29\x1e\x37\x96\x71\x30\x9e\xb2\xd7\x2c\xc8\x17\x19\x56\x14\xd1\x8d\x66\xc1\xed\x69\x7a\x93\x63\x7c\xa3\x59\x94\xe0\x6f\x88\x6f\x94\x26\xc7\xd3\x20\xb9\x62\x34\xf2\x4b\x7b\x6f\x7c\x38\x66\x5e\x8c\x86\xa7\x34\x11\xe7\xda\xb2\xd0\xb8\x2a\x94\x43\xa1\x9c\x2e\xbe\x97\x38\xb0\xe7\xa4\x35\x70\xb1\xd0\x60\xa8\xf0\x4b\x0c\x9a\x50\xa2\x86\x8c\x3f\xf5\xe1\x91\x0b\x8b\x4e\xe4\x08\x37\x7c\x7f\x21\xc4\x2f\x1a\xb6\x19\x09\x0c\xd1\xa2\x73\x3f\x60\x66\x08\x7b\xe4\x65\xbb\xc8\xd0\xa2\xd3\x76\xaa\x45\x67\x7e\x4b\xd2\x0c\xd5\xdc\x52\xee\x67\x83\xc1\x54\x7d\x1e\x96\xbf\xbc\x36\x97\xbd\x89\x92\x30\xbd\x01\x09\x08\x98\xf4\x82\x43\x78\xe6\xbb\x98\xc9\x97\x0d\x00\x40\x7c\x96\x4a\xa2\x08\xbe\xd5\x3c\xf8\x66\xe2\x73\xba\x60\x4e\xc6\xc2\xc5\x98\x99\x5d\xf7\x73\x0c\x58\xda\x3e\xbc\xe3\x03\x11\x1a\x97\x60\x2f\x4e\xfd\xcc\x29\x17\x9d\x44\x3d\x81\x28\x76\xe9\x21\xb4\xee\x99\x13\x88\xbb\xa9\xb9\xc9\xf8\xd2\x02\xe7\x20\x7d\xeb\x2f\x7f\xcb\x24\x6b\xb3\x96\xd6\x5c\xf5\x9d\xd9\x25\x9b\x68\xe4\xea\xcb\xb2\x3b\x0b\x6a\x5a\x78\x8b\x0a\xe2\x92\xe1\x28\x10\x63\x5e\xe9\x47\x07\x6b\xd7\xeb\xaa\x2a\x58\x41\x77\xb3\xef\xd2\xb9\x25\xdd\x34\xda\xf5\x5b\xec\xae\xd1\x46\x9d\xab\x01\xfb\xac\x5e\xa6\x28\x27\xdf\xe7\x52\x30\xd0\x27\x0a\x0e\x92\xb4\x63\x24\x08\x6a\x9a\xf8\xae\x76\x5e\x84\x0f\xfc\xf8\xee\x0f\x43\x8b\xe6\x10\x90\xcd\xcc\x99\x5f\xf2\x96\x86\x9c\x00\x0c\x29\xc8\x58\x40\x2c\x0b\xa4\xf5\x23\xce\xb3\xe8\x72\xc1\x99\x09\x1c\x4d\xee\x28\x94\xd8\x2e\x81\xb6\x1a\x25\xc0\x7e\x6d\xab\x3d\x49\x9c\x80\x5b\x34\x62\x66\x0e\x62\x9a\x24\xc3\x5c\x05\x63\x7b\x93\x86\x6c\x30\x28\xc1\xb8\x4c\xc3\x3b\x90\x88\x58\x12\x1e\x4f\xa3\x38\x84\x4a\x38\x56\xe0\x58\x12\xbf\x80\x54\xe4\x5a\x15\x8e\x31\x30\x9b\x36\x83\x34\xf2\x63\x8d\x34\x6b\x1b\x5a\xc7\x7e\x56\x2e\x21\x76\x90\xcb\x8d\x0d\x88\x3d\x3e\xe7\x17\x85\x02\x1d\xc6\x89\xab\x5f\x22\xbf\xf9\xb4\x9c\x7c\x5f\xd1\xc9\xc7\x59\x1a\xc7\x82\x89\xa8\x35\x51\x5f\x02\x5c\x87\xb4\x82\xf2\x30\xd9\xe4\x92\x3c\x60\x48\x5e\x62\x73\x7d\xc4\x85\x99\x33\x90\x08\x4b\x30\xc8\x2d\x41\x40\xc6\x7e\x5e\xef\xd5\x4e\xc1\xe4\xff\xd7\xe4\xa0\xd1\x6f\x34\x18\x98\x0b\x7f\xb1\x99\x6e\x06\x16\xcd\xe5\xeb\xac\xc1\xad\xb9\xa0\xb9\x10\x34\x27\xfe\xf8\xaf\x59\x37\xcc\x50\x77\xe2\x4f\x44\xdd\x73\x55\x39\x4a\xcc\x09\xc4\x48\x1b\x5f\x14\x20\xae\x0a\xc0\xe0\x79\x00\xb4\xbb\x4e\xd3\x38\x64\xd9\xfd\x3d\x81\xdb\xf5\x34\x45\x5b\xfd\xf9\xf0\x82\x46\x7e\x72\xee\x5e\x1c\x5c\x2a\x6a\x96\xf6\xf7\xf2\xdb\x8f\x69\x97\x74\x41\xa4\x78\x44\x63\x5c\xb2\x35\x29\x83\x8e\xcd\x98\x2e\xb3\xf4\x46\x3a\x62\x45\x18\x3f\x4a\x0e\x45\xc5\x5a\x6d\x09\xab\x96\x39\x03\x87\x9a\x8c\xc1\xdf\xfe\x42\x2d\xee\x5c\x4d\xbc\xb5\x2c\x65\x3d\x93\x59\x4a\x3f\x33\x24\x27\x0e\xc2\x10\x0f\x06\x5e\xc1\x51\x40\xc2\x32\x3c\xa2\x44\x69\x80\x69\x81\xaf\xad\xa5\x2c\x2e\xce\x7b\x7a\x6b\x14\x05\x3d\xbf\xd0\xe0\xac\xad\x58\x4b\x5b\xb2\xf4\x18\x6c\x95\x0b\xd4\x66\x70\x93\xae\xed\x15\x93\xfb\xfb\x99\x69\xd1\x08\xe0\xa5\x19\x9b\x78\x73\xc0\x15\x2d\x9f\xab\x32\xdf\xc0\xfa\xb8\x09\xb2\x10\xb7\xa7\x90\x59\xf4\xb2\x3e\xe4\xff\x80\x42\xd3\xa9\x58\xa3\x00\x4a\x13\x1f\x62\xd3\x9f\x13\xfc\x22\x95\x08\xd0\xab\x0f\x26\x9d\xaa\xe2\xf5\x55\x87\xbd\xf7\x35\x9c\x30\x2d\xaf\x23\x76\xf3\x34\x85\x00\x12\xc6\xd0\x18\x6d\x1b\xa3\x6d\x42\xe1\xf1\x00\x8f\xc8\xa9\x47\xc7\x33\x42\x21\x90\x18\xcb\xae\xd9\x51\x3e\x67\x63\x7e\x0a\xbe\x6f\x1e\xb9\x7d\x1d\x85\xff\x78\x1d\x85\xc6\x8c\x31\x4e\xba\x23\x1c\xce\x03\x90\x7a\x97\xa1\x47\x5e\xbb\x23\x3a\x1a\x1e\xef\x39\x3b\x4f\xe8\x68\x68\x6c\x53\x77\xd7\xd9\x76\xe1\xef\xe8\x78\x9b\x42\xb2\x01\xff\xd0\x6d\xc3\x1d\xd1\xed\x63\xcc\xa5\xdb\xc6\x68\x28\xf2\x46\x43\x28\x08\xff\x42\x86\x21\xb2\x47\x43\x28\x3c\x1a\x62\xdb\x47\xee\x90\xba\x43\x63\x68\x0c\xe9\xd0\x18\x51\xb7\x9e\x00\x25\xea\x29\xa3\xce\x32\xaf\xf7\xa8\xbb\xf5\xdc\xdd\x7b\xef\xba\xcf\xf7\x1e\xf2\x73\x99\xfe\x49\x3d\x7f\x52\x4f\x8d\x7a\xdc\x2d\xba\xf7\xdc\x75\x91\x7a\xde\x03\x21\xb9\xef\xdd\xbd\xe7\xee\xd6\xfb\x92\xa8\xdc\xad\xf7\x7b\xff\x7c\x88\xb0\x66\x7f\x24\xc2\xa2\x99\x0f\xf4\x20\xf6\xb5\xaf\xa0\xb2\x25\xd2\x4e\x26\x7d\xd3\x51\x68\xf6\x32\x47\x6c\x8d\xca\x62\x20\x85\x69\x9a\x4b\x61\xb6\x46\x82\xee\xde\x93\x11\xfe\x43\x8a\x87\xa8\x69\x6b\x7b\xdb\xd8\x1f\x0e\xaf\x77\xb7\x3f\x0f\x0d\x77\xdb\x7e\x62\x8c\xb6\xb8\x3d\xda\x32\x9e\x4c\xed\xfd\xad\xd1\x67\xdb\xdd\x36\x86\xf6\x68\xcb\x7e\xc2\xed\x27\xf6\x68\xeb\xda\x86\x92\x90\x0a\x5f\x1c\x32\xa6\x50\x0e\x8a\x19\x50\x8d\x43\x0b\xbf\xcf\xdc\xd1\xbe\xb1\xbd\xbd\x7f\x8d\x8d\x0c\xed\xdd\x5d\x7b\x7b\xcf\x76\xdd\x2d\x0e\xff\xd8\xdb\x7b\xb2\xf5\xdd\x5d\x63\x08\x29\xc6\xf6\x1e\xb7\xb7\xf7\x0c\xd7\xdd\xba\x86\x8c\xa1\xb1\xbb\x6b\x88\x6f\x2e\xb2\xb1\x17\x28\x0e\x69\xf6\xf6\x1e\x17\xed\x61\x4f\xd0\x96\xac\xe6\xba\x4f\xec\xfd\x6d\x67\xc7\x18\x0d\xb7\x9c\x1d\x6e\xe3\x1f\x03\x52\xd4\x78\xdc\x27\x30\x20\x48\xc6\x82\x1c\xff\x15\x09\x0a\x5a\xd7\x7d\x62\x54\xa9\xbc\x2a\x8c\x40\x40\xee\xd0\xa8\x1a\xe6\x55\x7f\xbf\x3f\x44\xcf\x57\x7f\xd2\xf3\x7f\x8e\x9e\xb7\x76\x46\xd7\x5b\x3b\xa3\x76\xc6\xee\x76\x07\x9d\x6f\xed\x8c\xb0\xca\x5a\x4b\x00\x9a\xc6\xc2\x8d\xf4\xdd\xed\xc6\xca\x00\x00\xa6\x5b\x3b\x7f\x2e\x98\x35\x17\xcc\xf5\x1f\x69\xc1\x7c\xcd\x1a\x51\xa1\x51\x02\x25\x56\x88\x6d\x44\x78\x1e\x4a\x1d\x51\xbb\x68\xd5\x75\xd3\xd3\x6a\xac\x9a\x1d\xc3\xdd\xe9\x14\x44\x1e\x5a\x45\xb0\x40\xe2\x5d\x1b\xff\xf7\xe0\x0c\xdd\xfd\x39\x43\xff\x89\x19\xda\x89\x77\x8d\x5d\x63\x9d\x09\xfa\xf8\xa7\x70\x5e\x0a\xe7\xdb\x43\x63\x7b\xf8\x8d\x85\xf3\x2b\x79\x86\xba\x72\xc6\x66\x5b\x43\x63\x6b\x67\x6a\x8f\x76\xae\xed\xd1\xc8\xd9\x99\x8e\x76\xae\xf7\xe0\x0f\x48\x1d\xee\xc8\xd9\x19\x0f\x6d\xd7\xd9\xb6\x5d\xc7\xb5\x47\xce\x8e\xfa\x6f\x6a\xef\x61\xd6\xc8\xd9\xb7\x47\xce\xc8\xde\x81\xff\xe5\xf6\x8e\x81\x1f\x86\xcc\x87\x9a\xc0\xa3\x9d\x1d\x43\xd6\x37\x46\xce\xce\xf5\x08\xeb\x1a\x90\xe9\x3a\xae\x21\x93\xe1\xbf\xe9\x68\x67\x0c\xc9\x43\xf8\xb0\x65\x26\xd4\xbf\xb6\x01\x46\x67\xe7\x7a\xe7\x77\x63\x66\x8f\x86\xf6\x48\x02\x99\x37\x0a\xea\x1d\xa9\xbf\xb5\x5e\x5c\x67\xab\xfc\x3d\xaa\xda\xd0\xcb\x4c\xed\xd1\x10\x87\x5d\x03\x1b\x5a\xc3\xce\xa1\xd0\x70\x0a\xf8\x82\xa2\x3b\xd7\x22\xdd\xdd\x73\x76\xec\x9d\x6b\x7b\x27\xb6\xdd\x21\x68\xca\x86\xf8\x03\x90\xbb\x6a\x04\xae\x6c\x03\x0a\x1b\xee\x70\xba\xe7\xa8\x66\xf6\x9c\xb2\x21\xe8\x0b\x0a\x88\xe2\xd0\xbc\x6c\x40\x56\x86\xba\xaa\x63\x09\x86\x60\x86\xab\x16\xdb\xed\x9f\x8b\xed\x3b\x58\x6c\xa3\x7d\x67\xd7\x18\xed\x7c\x1e\xda\x43\x67\xc7\x1e\xc2\xba\x8a\xed\x6d\x7b\xdb\xd8\xb6\xb7\x3f\xc3\xe7\xd0\xd9\x31\xe0\xaf\x6b\x40\x91\x5d\x51\xc4\x71\x63\x7b\x64\x8f\x3e\xe3\x17\xfc\xe7\xca\x5f\x28\x73\x41\xf9\xd8\xde\x76\x5c\x63\xdb\x71\xa1\x31\xc7\x5d\x59\x72\x64\x60\x4b\xd0\x0f\x80\x80\x34\x0e\x6a\x35\xf6\xbc\x63\xb8\x31\x42\x63\x6c\x37\x0a\x61\x91\x3d\x43\x56\x88\x47\xc6\x08\x00\x86\xce\x0d\x6c\xd8\xc0\x4e\x0c\xec\x30\xde\x56\xf0\x00\x4c\x2b\xca\xc1\xa8\x24\xa8\x90\x67\xbb\xbf\x1b\xb3\x7d\x67\xcf\xde\xf9\x3c\x34\xb6\x9d\x3d\x7b\xe4\x6c\x19\xfb\xce\x2e\xb7\x77\x9d\x2d\x63\xd7\x19\xd9\x88\x3f\x67\x0b\xfe\x42\xa6\x0d\x69\xf0\x1f\xfc\xd6\xf2\x0c\x99\x6e\xc8\x72\x86\xcc\x33\x64\x3b\x86\x4c\x7b\x78\xdd\xdc\xfc\xb9\x6e\xbe\x83\x75\xb3\x35\x04\xee\xec\x3a\xdb\xc0\xed\xf6\x3f\x6b\x6b\x83\xdb\x40\x71\xc0\x0e\x9d\xbd\x6b\xf8\xa7\x9d\xb9\x0d\x3b\xc7\x93\xcf\xfa\x2a\xe0\x90\x6f\xb8\xd7\x3b\xce\x1e\xd6\xd4\x33\x77\x20\x73\xc7\x70\xaf\xb1\x27\x41\xae\x40\xf4\x1c\x33\xa7\x3b\xce\x1e\x54\xfb\xac\x56\xcc\xb6\xcc\xd9\x9e\x42\x27\x1a\x71\x73\x24\x68\x84\x09\x2a\x69\x59\x3b\x5c\xd1\xfa\x9e\x33\x82\x3d\xf2\xbb\xa1\xf6\x4f\x7f\x52\xfb\xf7\x40\xed\xae\xb3\x6b\x8c\x5c\x67\x77\x6a\xbb\xc3\x6b\x77\x38\xb5\xb7\x9c\xd1\xb5\x0d\x3f\xdc\xe1\x35\x7c\x4c\xe1\xaf\x3b\x9c\x42\xba\x3b\x84\xaf\x2d\x67\xf4\xf0\xf4\x9e\xfc\x39\xbd\xdf\xc1\xf4\xba\x4f\x9c\x7d\x63\xb4\x0b\xdb\x1f\xee\x4e\xbb\xf6\x96\xb3\x8d\xff\x8d\x60\xde\x9d\xdd\x6b\xd7\xd9\x9a\x8e\x9c\xd1\xf5\xc8\x71\xa7\xae\x33\xfa\xdd\x98\x3d\x71\xf6\x6d\x77\x17\xd8\xd4\x96\xdc\xd1\xf7\x8c\x61\x6c\xef\x39\xfb\xc6\x9e\xb3\xff\x59\x6d\xa0\x62\x93\xe6\x22\x77\xcf\xd9\x87\x02\xd5\x06\x0b\xb5\x7e\x37\x66\xae\xb3\x6f\xb8\x40\x39\xdb\xce\xd6\x67\x90\xb4\x77\x6d\xd7\x79\x62\x80\x01\xc4\x06\x8b\xa1\xeb\x3c\x99\xda\xee\xbe\xb3\xfb\x19\x80\x33\x86\x90\x08\x25\x38\xfc\x03\x1f\xd7\x22\x17\x04\xfb\x3d\x43\xa4\xed\x72\x51\x68\x7f\x8a\x59\x4a\x86\xdf\x95\x4c\x72\x0b\x9e\x3b\x91\xbb\x3f\x30\x4d\x60\xa5\xb0\xeb\x8f\x00\xdc\x18\x24\x1c\x10\x2a\x60\x18\x50\x12\x86\x08\x92\x86\x2b\xa5\x24\x17\x79\xbc\xab\x80\xaa\x29\x15\xdc\x46\xd1\xff\x1a\xb3\xa4\x4e\x01\x09\x1c\xf3\x15\x34\x3b\x4a\xa3\xe0\x52\xa0\x87\x7d\xe4\x09\x6e\x15\x00\xc2\x08\xf0\x13\x0b\x35\x67\xe7\xb3\x00\x00\x00\xd9\x87\x6e\xb9\x14\x5a\x40\x6e\x1f\x39\xae\xed\xee\x3a\x3b\xf1\xae\xb3\x6d\xec\x42\x83\x60\xcb\x98\xda\xbb\xb0\x33\xed\xa2\x78\xfe\xc4\x79\x62\x6c\xc5\xf6\x08\x36\x2c\x48\x87\xff\x0c\xa8\x07\x92\x0e\x08\x74\x80\x32\x18\xd5\x1e\x47\xb8\x9c\xbd\x78\xcb\xd9\x36\xb6\x60\x17\x00\x6c\xcb\xff\x5c\x98\x0d\xf9\xe3\xe1\x85\xfd\xf3\x9f\x0b\xfb\x3b\x58\xd8\x5b\xae\xb3\x87\x47\x57\xba\x0c\x82\xa4\x60\x8f\x5a\x42\x39\x57\xf2\xf8\x13\xc7\x35\x9e\x38\x2e\x28\xd0\x9f\x2b\xb5\xa0\x56\x04\x89\xa9\x5c\xe4\xb6\x94\xa5\x25\xad\x6c\x1b\x6e\xbc\xef\xb8\xc6\xfe\x4a\x49\xdb\x05\xc5\xdc\x05\x30\x9a\xf2\xf6\x8e\xb3\x6b\x6c\x39\xbb\xdf\x8d\x0c\xf2\xb6\x97\x96\x1f\x75\x73\x40\x44\x74\x84\xa8\x0b\x99\x2f\x5d\xc9\xc5\x6d\xc8\xfa\xad\x4e\x45\xb9\x0f\x3b\x23\x81\x3b\x9c\xa4\x6d\xb8\x23\x3e\x86\xdb\x54\xca\x5b\x97\xa6\x3e\x77\xf2\x6c\x4c\x03\x9f\x57\x8e\xff\x07\xcc\x89\x92\x84\x65\xcf\xdf\xbd\x7e\xd5\x7c\xa8\x35\xab\xe2\x47\x62\xb4\x5b\x33\xb5\xe4\xad\x3a\xc3\x20\x16\xed\x77\x4a\x62\x70\x3b\x38\x67\x31\xb8\x4b\x68\xe5\xd8\x2d\x1b\x1f\xa7\xb3\x59\x90\x84\x26\xc6\xb5\x6c\x35\x22\x5c\x3c\xca\x46\xb2\x1a\x86\x72\xc6\xe1\x47\xba\xe0\x66\xef\x15\x0b\x28\xed\x6d\xb8\xe0\x55\xbb\xb3\x33\x1c\x5a\xb4\x2b\x7b\x58\xbb\x6e\x51\x79\x4c\x97\x4f\x5f\x27\x83\x41\x62\x2e\xf3\x6c\xec\xa5\xb4\xc4\x94\x17\xe0\x6f\x2f\x38\x57\x5e\x97\xb6\x7b\x51\x54\xd7\x38\x21\xfc\xe7\xfc\x0e\x82\x34\xb5\x67\x4e\x4e\x8a\x7c\x16\x4f\x92\x46\x75\x6b\x03\xa0\x7e\xe0\x42\x67\x3b\xf7\x23\x5b\xe1\xba\x5f\x46\x0d\x25\x78\x2b\x4f\x0f\x5b\x6a\x59\x2b\x6f\x28\xb0\x8e\x90\xa3\x16\x25\xff\xff\xff\xf7\xff\x76\xbf\xc0\xf8\x15\x60\x14\xb4\x49\x61\x15\xe6\x98\xb5\x94\x21\x0d\xe0\x99\x7f\x19\xd0\x40\xe5\x12\x0f\x3f\xe5\x1d\x20\xf5\xd4\x9e\x7e\xed\xa7\xf9\x0c\x1f\x2b\x6a\xf7\x61\x14\x99\xac\x79\xcb\x65\xac\x56\xfe\xaf\x51\x1c\xff\x92\xcc\xd2\x45\xc2\x3b\xf6\x17\x58\xd7\x3a\xc5\x0e\x06\x26\x86\x74\x52\x54\xdb\xcc\xb7\x68\x33\x05\xb9\x00\x44\x62\x7f\xfc\x4e\x66\x32\x5c\xdc\x4c\xd0\x18\xf8\x6d\x31\x47\x38\x19\xa2\x8f\x33\xf8\x7e\x81\x47\x3c\x0b\x69\xea\xcb\xe7\x06\x9b\x71\x6f\xa5\x51\x9e\xc6\xbe\x0c\xfa\xa5\x1e\x68\x35\xc0\x59\x32\xf6\x45\x88\xb7\x15\xd4\xd3\x9a\x7a\xbd\x79\x2d\x54\x1d\xe5\x11\x87\x27\xbb\x80\xcd\xc1\x7b\xdc\x15\x08\xf2\x0c\x73\x65\x38\xd1\xec\xb0\x1e\x93\x4c\xde\x07\x58\x75\xc9\xb8\x7e\xfe\x90\x8a\x83\x21\xd5\x5e\x5c\x58\xf5\x9b\xa7\x15\x0f\x2e\xca\x3b\xa7\xd5\xea\x36\x57\x6f\x0d\xc7\xdf\x66\x6b\xb8\x62\xfc\x04\x02\x4c\xae\x62\x27\xe8\xe5\xa5\xc2\x21\x54\x97\xa5\x54\xfb\x1d\xd8\xc0\x2b\x45\xb5\x59\x82\x51\x03\x15\x40\x40\x8e\xf5\xb0\x2f\xb9\x16\x3e\xc0\xc0\xc2\x47\xcc\xc5\xc9\x4a\x4e\xa1\x03\x52\xb1\x0c\xb8\x2f\x55\x85\x99\x25\x56\x75\x05\x49\x43\x0a\x70\x57\x36\x4f\xe7\x18\x8d\x13\xae\xb7\x14\x92\xb9\x68\xc9\xb5\x49\x89\x26\xe6\x86\xbb\xe1\x97\x6c\x39\x4d\x00\xd7\xca\xc9\x55\x2f\x89\x29\x07\x6d\x4e\x54\xdd\x00\xf2\xb8\xaf\xdd\x07\xd2\x03\x6c\xc0\x1d\x5e\xc8\x85\x4b\xbc\x7a\xba\xbc\x96\xe5\x71\x9f\xe9\xc9\x10\xa4\x43\x32\xb6\x1e\x3e\xc7\xfd\x1a\x8b\x13\x75\x15\xa3\x83\xd0\x15\xbc\xb9\x83\x33\x6d\xbf\xae\x02\x56\x70\x9f\x90\x42\x45\xa8\xe0\x85\x29\x1d\x53\x81\x67\xfc\x86\x22\xb5\xb6\x67\xc2\x74\xbc\x4e\x43\xe6\x6d\x0c\xe5\x6b\x3e\xc0\x8a\xb8\x78\x72\x3c\x44\xd4\x7a\x78\xe7\xcc\x4b\x30\x0c\x9b\xe4\x55\x10\xa6\x23\x5e\xb0\x02\xde\x9a\x16\x51\x0e\x4e\xcb\xd0\x8d\x8f\xa0\xe7\x72\xf3\x6d\xbc\x37\x8d\x11\x17\xbe\x88\xce\x85\x84\xf1\x2f\xa6\xf4\xdb\xb5\x28\x5d\x80\x52\xd1\x7a\x4a\x49\x2d\x2c\x75\x0f\xb5\x3f\xc5\xf8\x76\x38\x97\x4b\xc0\x8f\xd7\x8e\x8c\x43\x11\x3f\x5e\x00\x0f\x1f\x04\x9e\x28\x25\x64\x3e\x4d\x9c\x49\x68\x3d\xb4\x8a\xc7\xe5\xed\x8d\x66\x80\x15\x6f\x63\x58\x14\xd5\xa2\xba\x62\xbc\xb9\x57\x57\xfe\xdd\x1b\x1c\x5e\xae\x47\x42\x48\xf5\xb0\x15\x72\x05\x25\x62\xf9\x6c\xb8\x6a\x5f\xce\x4a\x46\x07\x94\x64\x5a\xf5\x15\xd2\x3b\xc1\xac\x85\x60\x04\xdd\x93\x94\x0c\x2c\x5e\x36\xa5\xae\x82\xf6\xb7\xf5\x3f\xeb\x36\x35\x81\x2b\x08\x2b\x1a\xfa\x75\xdd\x86\x54\x00\xbd\xfe\xa6\x3e\xaf\x0d\x93\x9c\x83\x15\x6d\xfd\xf7\xba\x6d\xd5\xde\xda\x6f\xb7\xf3\x4f\x9a\x76\x3c\xd9\xdf\x2e\xf7\xbf\x65\x39\x8d\x49\xf6\x96\xe6\xac\x2c\x2e\x18\x60\x6f\xc9\x7f\xac\x3b\x8e\x86\x58\xd8\x6e\xe9\xbf\xd6\x68\xa9\x21\x3f\xae\xc3\x63\x64\x00\x7c\x75\x5d\xb6\xc9\x8a\x25\x93\x2d\x19\x62\x49\xf5\xbd\xfc\x50\x32\x3c\xd0\xe9\xc4\x9e\x5b\x32\xe0\x87\x58\x5f\x8f\xca\x30\x6f\x2d\x1b\x58\xa9\x1e\xba\xc6\x13\x1a\xc1\x12\x3c\x65\x13\xaf\xfd\x04\x9f\xc1\x60\x59\x4f\xd2\xf1\x22\x37\xad\x42\xf2\x78\x4e\xb5\xc1\x2b\xa6\x61\x03\x8c\x60\xc7\xe8\x74\xae\x2f\x9f\x07\x0f\xb2\x2b\xa6\xf8\x4d\xcf\xae\xf3\xf8\x9d\x86\xa6\xc9\x4b\x76\xf7\x2c\xbd\x49\x6a\x9d\x4a\xde\xc3\xe0\x7e\x8b\xdc\xbe\x4f\xf2\x71\x30\x87\xe7\xc0\x3b\x77\x3a\x57\xdb\xe9\x08\x29\xd4\x4e\x2b\x6a\x62\xcc\x5c\xcf\x64\xce\x98\x67\xf1\x4b\x76\x07\xd7\x39\xe0\x19\x83\x97\xec\x0e\x2f\x9a\x8b\x30\xbe\x30\xb9\x26\x84\x85\x01\x03\x53\x3a\x87\x4b\x1a\xc1\x15\x78\xbc\x27\x80\x3f\xed\xfa\x87\x47\x44\x98\x2c\x03\x04\x4d\x03\x11\x82\x91\x12\xe1\xe6\x9d\x37\x92\x5a\x93\x16\x60\x7d\xf5\x5d\x71\xd6\x1d\xf3\xde\x7a\xec\x1e\x85\x1d\xca\xa0\xcc\x4a\x73\xd3\x22\x21\xf7\x89\x61\xeb\x20\xb3\x28\x3a\x47\xf0\xf3\xc3\xe0\x40\xf0\x7c\xa3\x71\x27\x5d\x80\x56\xc5\xe8\xef\x85\xac\x9a\x95\x3e\x08\xb4\x95\x93\x39\xf9\x34\xbd\x79\x26\xc3\xb5\x83\xc0\x8f\x4a\x7e\x35\xb5\x7e\x9b\xa8\x95\x0c\x03\x76\x17\xb5\x18\xd0\xe4\x52\xc9\x30\x41\x19\xb6\x2f\x56\x4b\x1a\x2f\x62\x95\x08\xa2\xb9\x1f\x3b\x1a\xbd\xd3\xb1\x1f\x1d\xb0\xc1\x20\x47\xd1\x0a\xd4\x49\x5f\x45\x03\xa1\x3d\xd8\x2e\x2c\xba\x42\x40\x50\x31\xfe\xba\x04\x84\xa4\x29\x20\xa4\x4d\x01\x41\x2e\x35\x5a\x46\x68\xf3\xc6\x1d\xc2\x82\x5b\xc8\xe0\x13\x1a\x0a\x7b\x59\x1c\xaa\x30\x82\xcd\x25\x10\xd1\xb7\xc4\x9c\x2e\xfb\xa9\x78\x15\x8a\x0d\x6a\x18\x82\x4b\x96\xa6\xe4\x07\x0a\x3c\xe4\xae\xaa\x67\x29\x57\xe0\x15\xc1\xd4\xfa\x32\xb6\x59\x46\x05\x68\x84\xf7\xb7\x68\xda\x51\xfa\x67\x46\x7b\x68\x57\x55\x56\xd2\x67\x60\x76\xca\x9f\x35\x77\x38\x08\xd9\x0d\x2b\x90\xd7\xc9\x5c\xb9\xcf\x3d\x44\xec\x1b\xc3\x9a\xe0\x56\xc3\x4a\xd7\xa4\xb4\x10\x2c\x26\x08\xe4\xb9\x44\x0b\xc1\x51\x49\x76\x34\xf6\x53\xa9\x76\x2a\xad\x8a\x5b\x92\xe3\x72\x0c\x2c\x7b\xc3\xb2\xe3\x20\x67\xa6\x52\x9d\x64\xb0\x39\xef\x41\x0b\x7b\xcf\x64\x74\x69\xf4\x37\x66\x2c\x1f\x85\xac\x50\x03\x2a\x7e\x2b\x44\x88\x55\x3c\x10\x6b\xac\xa3\xdd\x2a\x60\xc7\x83\x4d\x3f\x10\x92\xec\x2b\x80\x86\x38\x66\x42\xbc\x11\xe1\xfb\xfe\x73\xe8\x3b\xff\x23\xa2\xef\x82\x58\x5f\xab\x5b\x24\xdf\x42\xab\x48\xbe\x5e\x9f\x48\xbe\x85\x26\x51\x83\x43\xb2\x81\xc7\xeb\x10\xc9\x1f\x5e\x7b\x48\xd8\x8d\xf1\x0c\xf6\xd0\x04\xc4\x47\xcb\xd2\xcd\xc4\x7d\x32\x0c\x95\x06\x01\x48\xcf\x18\x6c\x53\x2f\xd9\x1d\x7c\xb4\x25\xd5\x0d\x57\x49\xa9\x18\x0e\x9e\x66\xdf\xea\x00\x53\x45\x07\xec\x90\x39\x20\x24\x70\x1e\x25\x57\x31\x13\xe1\x34\x50\x08\xc1\x0d\x32\xf6\x55\x1c\xc5\xa8\x26\x97\xe4\xbe\x88\x19\x91\x88\x28\x02\x74\xec\x73\x87\x25\xd0\x58\xf5\xa4\xd9\xc2\xe7\xd2\x4c\x46\x27\xf8\xf3\x19\xc6\x2e\xa5\x21\x7e\x9c\xe1\x59\x0f\x9d\x43\x34\x62\x61\x3d\x39\x02\x2e\xf5\x92\xdd\xd1\x4b\x9f\x3b\x9f\x17\x29\x67\xf9\xcf\x20\x9d\xe7\x74\xaa\xc7\x35\x54\x38\x7e\x48\xa1\x69\x4c\x9e\x5c\xfd\xfa\x33\xb6\xa4\xee\x47\x9e\xff\x35\x2b\x50\x18\x7c\x9d\x2e\x72\x86\x52\x7b\x6d\x93\x94\x6a\x4d\x25\x44\x49\xae\x02\xf2\x7a\xc0\x25\x2b\x29\x27\x7a\x88\x77\x80\x65\x63\xaf\x58\x70\x5d\x9b\x16\x09\xfb\xba\x8d\xb9\xd8\x98\x26\x22\xa8\xa9\x43\xa1\x02\x1e\x1f\x97\xb2\x58\x61\xc9\x67\xda\x20\x32\xeb\xe1\xbc\xff\x84\xa8\x1b\x3b\xd5\xfb\xa7\x16\x5d\x56\xad\x6e\x92\x8f\x64\x33\x2a\xe0\x8c\x1d\x68\x77\x85\xbc\x13\xc3\x79\x4d\x8c\xf2\x3e\xf1\xe0\xf4\x07\xd6\xb8\xd7\x0b\xc5\xea\x1d\x67\xd5\x0c\xda\x00\x08\xb1\xba\xb4\x69\x00\x9f\x76\x00\xbf\xb1\x71\x39\x18\x3c\xb4\x53\x74\x89\x57\x45\x81\x01\xaa\xe8\x83\x75\x95\x19\xb0\x6e\xf6\x83\xd7\x81\x11\x92\xaf\x07\x61\xc5\x4e\xd7\xc6\x3d\x5d\x73\x65\x74\x91\x95\xd4\x2a\x95\xac\xb8\xe1\xfa\xbe\x1f\x0e\x06\xf8\x77\x21\x42\x91\x94\xd4\x2c\x2d\x79\x10\x19\x38\xb2\x0e\x4c\x5e\xa9\xb9\x5c\x53\x73\xd1\x7a\xbe\x38\x64\x35\xc3\x7b\x62\x79\x98\x1e\x42\x1c\x0d\x15\x74\x98\x86\x6a\x31\x24\x62\x19\x94\x6c\xc7\xcb\x50\x30\x95\x84\xa0\x80\x45\x26\x4b\xca\x5d\x1c\x61\x0c\x0f\xcb\xcd\xbc\x7a\xec\xa4\x50\x71\xff\x94\x3d\xd2\x4c\xe8\xd4\xb2\xe8\xb8\x63\x99\xbc\x65\x74\x59\x1d\x80\x79\x1a\xff\x91\x8b\x92\x8a\x93\x32\x6f\x4a\xe1\xd0\x57\x29\x12\xb5\xe3\x73\x6f\x4c\x51\xcc\xf5\x62\x4d\x45\x3a\x2f\xc3\xd8\x03\xba\xe8\xb9\x58\x52\x17\x56\x21\xd6\x0a\x45\x7c\x2c\x06\x83\xa1\xef\x4f\x0f\x15\xb4\xea\x5c\xc7\xd4\x0b\x4d\x1a\x85\x2a\x73\xb9\x2c\xb6\xea\xcc\xe9\xb7\xde\x33\xa7\xef\x22\xba\xff\x15\xe3\x62\xf5\x43\x9c\x8e\xa6\xd6\x01\xfa\x45\xb5\x8b\xc1\xe3\xc6\x34\x53\xfb\x14\x28\x6d\xe5\x8e\x52\x35\xb1\x42\x8d\xeb\xe4\x35\x6d\xae\x02\xdd\xa8\xf7\x5b\xf4\x24\x08\xc0\x42\x89\x11\x71\x36\x23\x14\x48\x2f\x39\x24\xc4\x23\x39\xb1\xe4\x93\x00\x47\x61\x58\x46\x72\xf1\x9b\x8b\x26\x29\x87\x02\xe7\x79\x38\x82\x46\x7c\xf3\x58\x7e\x41\x8c\x0f\x3c\xae\xcd\xfd\x44\x2a\xb7\x63\x3f\x71\x42\x36\xe7\xd3\x15\x5b\xa1\xe4\x2c\x5d\x87\x09\x41\x58\xaa\x95\x2b\x0f\x35\xf8\x23\x8e\x31\x6e\xd6\x3a\xc6\x08\x42\xf9\x88\xbd\xc4\x67\xf5\x6a\x65\x8f\x35\x06\x66\x9d\xf9\xc2\xe8\x30\xfe\x71\x78\x18\x23\x7d\xeb\x86\x07\x15\x24\x7d\x48\xd3\xd2\xd5\xc2\x6a\x5a\x23\xa2\x2e\xdb\x03\x6c\x15\x22\x42\x28\xb4\x59\x1c\xc8\xd9\x15\x91\x20\x23\xeb\xb0\x6d\x1b\xe9\x0d\x83\x4f\x71\x5e\xbc\x5c\xd8\x48\x58\x61\x79\x2b\x0c\x2b\x22\xd2\x7f\xbd\x8a\x12\x02\x24\xdf\x2b\x8d\x26\x2d\xa6\xb1\x88\x63\x60\x19\xe5\x19\x0b\xab\x18\x80\xc0\xff\x9a\x94\x66\x26\xe2\xac\x4a\xee\x50\xc8\x65\x2d\x1a\x28\x9a\x8b\x25\xcd\x45\x8a\xe6\x60\x79\x01\xef\x51\x48\x7e\x70\x5d\xad\x73\xa2\xf6\x25\x84\xf6\x65\xe7\x65\xd9\xb7\x3e\x2f\x8b\x74\x73\x58\xf0\x68\x82\x8c\x57\x9f\x9c\x41\xf4\x30\x70\xe7\x58\xcd\x00\x55\xb8\x53\x4d\xc4\x4e\x51\xc4\x3e\x0a\x43\x1a\x74\x08\xe6\xb1\xf4\xea\x6a\x8b\xf4\xd5\x8e\xf7\x05\xd2\x75\xd2\xf1\xa0\x6f\xa7\x80\x56\x65\xb7\x8d\x51\xcc\x5a\x76\xd9\xbf\xf1\xd5\x95\xda\xa6\x60\x5a\x34\x78\x78\xdf\xce\x1b\x3b\x72\x80\xbb\x75\x2c\xf7\xe5\x44\x9b\xb0\xa8\xb6\x01\xa7\x87\x2d\xde\x6d\xe6\x7a\x81\xec\xb0\xb5\xe4\x54\x01\xeb\xa1\xc7\x0d\x3a\xb6\xe3\xb3\xde\xed\xf8\x51\x2e\x20\x8d\xf8\xaa\xb5\x36\x01\x14\xee\x9f\x5f\x94\xdb\x79\x62\x44\x95\x47\x19\xbb\x9d\x07\x49\xc8\x42\x8b\x3b\xf3\x45\x3e\x35\x9b\xe9\x18\x0a\x90\x9f\xb3\x0b\x7f\x03\xfe\xad\xdb\x92\x65\x19\x8f\xd7\x62\x08\x97\xc9\xe7\x17\xeb\x7a\x4d\xc1\x19\x97\xac\x85\xbb\x41\x53\x9f\x2d\x87\xd1\xd0\x64\xab\x25\x00\xdb\xca\x99\x1e\x40\x41\xd7\x1c\x65\xd3\xe7\xec\xa2\x83\x72\x4e\xe9\x52\x51\x45\xd9\x08\x08\x9c\x1d\xda\xcb\xab\x9e\xa2\x8f\xf1\xc2\x6a\xab\xe3\xb0\x24\x61\x08\xf0\x3c\xe0\x1c\x95\xe1\x5a\x64\xe3\xd4\x37\xb9\xd8\xee\xa9\x7c\xb2\x07\x17\x37\x02\x82\x4b\xfa\xb7\xfc\xfa\x34\x4d\x79\x6b\x59\x9b\x5c\x06\x86\xfb\x88\x2a\xfd\x47\x40\x37\x3e\x6e\x42\x49\x77\x57\x04\x8c\xd6\x73\xfc\x0b\xfd\x40\xc4\x39\xe8\x84\x50\x22\xbb\x90\x19\xf2\x9d\x4f\xa2\xb5\x4e\x2e\x40\x9e\xf6\x87\x74\xe1\xef\xfc\xb5\x1a\x9f\x6e\x2b\x38\x88\xef\xef\xcd\x71\x6f\xb6\x8a\x9b\x96\xd1\x50\x84\x44\x1b\xb3\x28\x36\x13\xc9\x43\x7f\x98\x94\x64\xb3\x26\x67\x6a\xb3\x1f\x78\x79\xe6\x3a\x88\x51\x98\x0b\x68\x7c\x08\xa3\xb2\x33\x18\x96\xb7\xca\x3c\x30\xee\x3e\x03\x7a\xa7\x85\x12\xb7\xe8\x63\x35\xdd\xdf\x5a\xdb\x18\xc8\x99\x9e\x1a\xae\x74\x26\x13\x8d\x5b\x16\x7d\x6b\x22\x5d\x98\xa1\x65\x39\xb3\x60\x5e\x39\xb5\x42\x34\x5c\x65\xa1\x58\x13\x31\x40\xa8\x19\xed\x45\x8f\xa1\xbd\xf6\x2e\x51\xd5\xc4\x8e\xf6\x1c\xed\xae\x0a\xef\x88\x5f\x8b\x62\xb5\xa6\x1a\x48\x2b\xac\x3c\x12\x79\xfc\x4c\x36\x0e\x2b\x25\x78\xad\x23\xcc\xf6\xde\xce\x61\x7b\x69\x70\x49\x33\xab\xf6\x17\x9d\xfb\x98\x19\x3e\x8d\xd4\xe0\x1d\x59\x27\xef\x68\x4d\x23\x60\x37\xdd\xcc\x28\x2e\x24\x6f\x88\x1b\x0d\x18\xfc\xca\x28\xe1\xf0\xd1\xbd\xfc\xbc\x09\x85\xf5\x70\xfb\x31\x9d\x4c\x72\xc6\xbd\xec\xaf\x13\xa9\x63\x8a\x97\x58\xe0\x3b\xfb\xeb\x64\x73\x62\xe9\xdb\x17\x85\xc5\xed\x49\xe3\x0f\xd5\x96\xa4\x4c\xfb\x88\x9d\x11\xb9\xf3\x05\x05\xcd\x3b\x7d\x73\xe5\x1c\x35\x46\x23\x11\x2c\x4d\xe7\x8f\xc0\xab\x4e\x5f\x1a\x41\xd9\xa2\x25\xb0\x56\x9d\x93\x75\xe7\x5f\x02\xa1\x37\xd3\x27\x68\x74\x97\xe9\xbe\x04\xf1\x58\xfd\x2f\x68\xe9\x7f\x30\x21\xc4\xb0\x0d\x22\xa6\xe5\x47\xb5\x7a\x0f\xd5\x0f\x98\xc2\xcd\x89\x65\x89\x43\x05\xab\x58\xe9\x0d\xfa\xfa\x61\x51\xe0\xcb\x64\x80\xc6\x71\xbb\x24\xe8\xea\x69\x01\x45\xe2\x5f\xf2\xb2\x80\xaa\x4b\x68\xb3\xb5\xca\xdb\x5d\xa0\xf8\x38\x4d\x38\x44\xa4\x2c\xfb\xc0\x38\xab\x0f\x32\x2f\x7d\x46\x40\x40\x61\x21\xae\x74\x96\x70\x43\xce\x86\xce\x0e\xd6\xa4\xa8\xf6\x2c\xcb\x36\x91\xe1\x65\xf5\x70\xee\xf5\x5e\x71\xde\xa5\x78\x5e\x1b\x18\x18\xe5\x93\xea\xf8\xf4\x44\x1e\x65\xd5\xa6\x41\xf0\x69\x0c\x20\x2b\xb1\x05\xa4\x74\xd8\x67\x2b\xed\x5b\x0c\x0d\x00\xab\x53\xb3\xda\x5a\x48\xd2\x90\xd9\x65\x5e\x29\x71\x67\x4d\x42\x81\xc5\x88\xa7\x69\xb5\xe9\x7a\xcd\x78\x00\xaf\xe2\xfa\x6d\x19\x4c\x76\x4f\x13\x10\x4c\x50\x1d\xa2\xa5\x24\xa6\xac\xd8\x60\xcb\x80\xb1\xa1\x4a\x29\x2d\x67\xfd\x1b\x78\x7b\x37\xd4\x44\xf9\x80\x42\x43\x5e\x5a\x28\xfa\x13\x38\xee\x9a\x82\x2e\x3f\x43\x9a\x96\x0e\x04\x81\x9f\x4a\x41\x0a\x4e\xa2\x35\x56\x49\x23\x3f\x75\x74\xde\x4b\x73\x3f\xed\x93\xc7\xc6\x7e\xaa\xad\x80\x45\x39\x95\x82\x1c\x45\x7b\x13\xff\xfc\x82\x86\xf5\x60\xdc\xf7\xf7\xcb\xa2\x14\x62\x24\x48\x4e\x9e\x66\xb0\xaf\xe6\x83\x81\xe4\xe0\xc2\x08\x68\x86\x7e\x88\x79\xa6\x65\xd1\xb0\x1d\x65\x37\x15\xb1\xc0\xd1\x08\xf7\x8e\x99\x29\x65\xe7\xe9\x85\x45\x6b\x1c\x1f\x62\x8d\x0f\x06\x10\x6d\x56\x28\xf5\x22\x46\xf4\x8b\x84\xcb\x6f\x6b\x33\x82\x6d\x6e\x1a\xe4\x3f\xdf\x24\x65\x68\xd8\xd4\xb2\xa2\x89\x29\x97\x05\xd8\x42\x84\xc7\x84\x35\x11\xea\x41\x7b\xee\xba\xb7\x40\x69\x47\x40\x6c\x7b\xc1\xa6\x4b\x75\xbf\x11\xdd\x70\x5a\x62\xd2\x1b\x2b\x53\x87\x32\x9a\xeb\x7b\xd9\xa2\xa0\xf0\x74\xd3\x01\x8b\x73\x66\x00\x80\xea\xb0\x43\xc1\x87\x93\x1d\xfa\xa7\xec\x20\x1f\x0c\x64\xe3\x92\x0b\xff\x98\x23\x42\xcf\x20\x52\x6c\xdf\x28\xc2\x7f\xd5\x20\x7a\x77\x67\x39\xa2\x02\x47\xd4\x0b\xd7\xf1\x0a\xec\xe2\xe9\x4c\xe5\x56\xe3\x25\x54\x3f\xcb\xf3\x76\x74\xb0\x84\x94\xa0\xa8\x0e\x3e\x64\xf7\x16\x9d\x14\x32\x2a\x34\x07\xbe\x25\x54\xbb\xea\x51\x09\x49\xdd\x7e\xfd\x3e\xc4\x3c\x63\xd7\x40\x32\xb9\xb7\x04\x11\x6a\x6d\x25\xef\x29\x6c\xff\x67\x3c\xc8\xda\x77\x62\xb4\xc8\xcf\xb8\xa5\x65\xba\x9a\x04\x6b\x18\xd4\xa4\xc0\xcf\x24\xa7\x01\xb7\xa8\x52\x07\x3b\x28\x29\xa2\xa2\xfd\x4c\x5f\xe0\x0f\x9a\xab\x7a\xc4\xf4\x96\xe4\x4a\xb4\x73\x36\x9f\x1d\x92\x73\xe2\xa1\x93\x08\x2f\xad\xf1\x75\xf6\x69\xa6\xd2\x36\x80\xa8\x89\x7c\x7e\x78\xea\xbd\x3a\xf8\x3a\x68\x1a\x04\xd1\x92\xc9\x30\x94\x72\x83\xc9\x9b\x56\xa1\xcb\x72\x42\x00\xff\xd7\x49\xe0\x5d\x2d\x47\x4a\x79\x0e\x34\xe5\x39\xfe\x2a\xe5\xea\xc1\x79\x59\x63\x62\xbe\x4e\x77\x47\x06\x87\xa7\x10\x40\x9e\xa8\xaa\x97\xab\x4e\xaa\xeb\x54\x1e\xa9\xd7\xb4\x71\x88\xe0\xad\x36\xcd\x48\xd3\xe1\x73\xdd\x94\x41\xc7\xbe\x50\xda\x95\x56\x2e\x94\xf7\xb2\x03\xf9\x9b\x50\x02\x34\xde\x50\xc8\xbb\x94\xf7\xb2\x65\x72\x61\xd1\x85\x76\xb8\x4e\x27\xfe\x42\xbe\x2d\x8c\xe0\xd1\xd0\x5f\x94\x92\x1c\x9d\xfb\xcb\x32\x1e\x77\x74\x7f\xdf\x5c\x69\xe9\x61\x2b\x65\x30\x30\xe7\xda\x9b\x02\xfe\x90\xce\xd5\xd9\x4c\x79\x61\xcc\xf2\xe6\x2a\x28\x3b\x28\x8f\xbd\x86\x81\x2f\x20\xd2\xba\x46\x4b\xe8\x77\x7b\xb4\x0f\x6b\x32\xa6\xd1\x0a\x53\xc4\x5c\x3b\xbb\xac\xb8\xa7\x39\xa1\xa1\x45\xc3\x06\x6d\x4b\x31\x08\x5e\x09\xa6\x81\x29\x57\x5b\xac\xad\xb6\xbc\xa0\x63\xcb\xf2\x54\x2d\x25\xa3\x9a\x2b\x96\x98\x8e\xd6\x8a\x75\x14\x2b\x2a\xac\xe5\x79\xa5\x5b\x0d\xc2\x43\x74\x5d\xf4\xc8\x10\x1c\x18\xad\x42\x5b\xc9\x93\x43\x72\x41\x3c\x52\xc0\xed\x5f\x21\x26\xf7\xac\xe6\x0c\xb6\xd2\xe2\x42\xdb\x6a\x9e\xb1\x2c\xba\x86\x77\x3b\x02\xce\x7e\xca\xd2\x19\x6e\x57\x1d\xbb\x8e\x7a\x05\xd7\x4f\x9c\x72\x57\x53\xc4\xce\xe0\xe0\x0d\x2f\x7f\xe5\xd9\xf8\xfe\x5e\x7b\xeb\x0b\x13\xcb\x2f\xc8\x82\x55\x89\xa9\xf0\x43\x25\xe0\x32\x2d\x53\xf1\x0b\xb2\x50\x68\xc6\x64\xfc\x75\x28\x91\x55\xdb\x80\x9b\x1b\x2d\x93\x76\xea\x7e\x0d\xf2\xe0\x35\x2b\x1b\xe8\x10\xdb\xe5\x72\x11\xc2\x28\x0c\xcc\x92\xf2\x11\x08\xf2\x1b\x70\x82\xa9\x8d\xef\xfe\x7e\x63\xb8\x51\x4b\x19\x0c\xb4\x8f\x1f\x99\x10\xa4\xad\xc1\xc0\xdc\x60\xe0\xbf\xbb\x88\x43\xb5\xd9\xdc\xdf\xcb\xd6\xea\xc9\xf2\x48\x85\x55\xe2\x13\xa2\x57\x48\x26\xb7\x12\x24\x4d\x66\xd1\x30\x58\x58\xd6\x60\x00\xf0\xa8\x67\x20\x2a\x2d\x76\xc5\x33\x75\xaa\x0c\xa1\x89\x45\x35\xe6\xa6\xc4\x31\x84\x11\x7a\x57\x0f\x2f\xab\xb5\x57\x17\xd4\x1e\x2e\x8d\xfa\x09\xd7\x7c\xbd\x0a\x21\x52\xbd\xab\xa6\xc1\x60\xa8\x1e\x2e\x4b\x7d\x5d\x2c\x6a\x80\x16\x5e\xb6\x82\xdf\x48\x99\x7e\x22\x3e\xa0\x23\xff\xd6\x4c\xac\xe2\xe0\xce\x7c\x2d\x25\xab\x53\xe6\xbf\x66\xf4\x55\x7d\x72\xcb\x1e\xbe\xee\x04\x3f\xf0\x87\x07\xc1\xdf\xb2\x83\x00\xdf\xe7\x0f\xf4\x13\xfc\xe0\x51\x27\xf8\x7d\x27\x57\x73\x93\x29\x3f\xe0\xc4\x3f\x17\x5b\xe3\x05\xcd\x40\x62\x97\xab\x0d\x61\x71\xa2\x1c\xff\x9a\x68\x17\xb7\x06\x83\x3e\xbb\x38\xe4\xe4\xd9\x58\x0e\xe7\xc7\xfe\x62\x66\xe6\x9f\xb1\x4e\x0e\xd7\x36\x30\x64\x8c\xf3\x3b\xfb\xb7\x5c\x97\x66\x1e\x63\x67\xe8\xd8\x84\x2a\xcb\x42\xbb\x52\xd6\xdc\xc3\x4a\xea\xf5\x92\xd2\x82\x28\xb7\x6f\xb8\x5a\x87\x22\xfb\x97\x1c\x33\xbd\xe9\xa5\x19\xc9\x1e\xd6\xbb\x69\x3c\x8e\xd3\x9c\xbd\x4e\xc3\x20\xf6\x7b\x4e\x4d\x71\x19\x96\x7a\x06\x7e\xe1\x92\xf6\xc8\xe9\xc9\xd9\xc9\x3b\x22\x0f\x8a\xd0\xeb\x5c\x6f\x43\xd5\x10\xf7\x26\xca\x53\x28\xbc\x67\xa4\x9f\x2d\x61\x82\x27\x33\x0e\xe5\x5f\x8f\x90\x75\x0f\x9a\xbe\x48\xc8\xab\x4e\x99\xc4\x80\xe0\x94\x35\xca\xdf\x07\x71\x84\xe7\xac\xd5\x41\x13\x42\x03\x6e\xee\x66\x50\x76\xde\x47\x2a\x8d\x99\xd7\x08\x07\xce\x29\x66\x80\xe4\xf2\x0d\x7d\x79\xca\xda\xce\xd0\x4c\xdd\x08\x73\x35\x41\x85\xb5\xae\xdc\xd4\x6c\xbc\xd3\xce\xdb\x7f\x3c\xdb\xd7\x4b\x03\xe2\x38\xb8\x64\xf8\x96\xef\x4b\x76\x67\x88\x05\xd2\x5f\x57\x39\xc4\x55\xaf\xf0\x65\x2c\x0e\x78\x74\xdd\xe3\x14\x40\x10\xf1\x0f\x8e\x4c\xdd\x8b\xaa\x2d\xd3\x66\x2e\x3e\xe4\xa2\x8f\xbb\xf3\x5a\x5b\x3e\x67\x71\x7c\x0c\xb7\x2c\x2a\xc7\xdd\xa0\x7e\x59\x0b\x6c\x6a\x1d\x57\xdb\xf0\x24\xa2\x14\x11\x25\x41\xf3\xda\x35\xb7\xf2\x7e\xda\xdb\x8c\xe5\x79\xad\x6a\x3c\x18\xc8\x7b\x65\xf0\x00\x19\x5c\x53\x3b\x64\x6a\xd1\x58\x9e\xba\xac\xa6\xf2\x00\xe4\x8a\x24\x70\xbe\x2c\x1a\x1f\xae\x73\xa5\xaa\x85\x3b\xd1\x49\x2f\xf2\x64\x76\xfb\x60\x5f\x7b\xf5\xac\x04\x14\xe0\x40\x61\x66\xa5\x6e\x57\xeb\x5e\xde\x30\xeb\xaa\xf0\xe9\x61\xe0\x1b\x37\xd2\x3a\x47\x20\x7b\xa0\x0f\xf8\x85\x48\x0e\xd7\xe4\x6a\xe0\xb8\xb1\xd2\xbc\x7f\xf4\x7d\x3b\xde\x49\x7e\x56\xc1\xd8\x74\x1f\x92\xbc\x2f\xf3\x85\xc4\x95\x50\x12\x60\x41\x50\x42\xd9\x0d\xea\x5a\x25\x57\x92\x1d\x13\xb2\xe1\xf3\xc1\xc0\x06\x69\x50\xce\x10\x4a\x9f\x99\x53\x77\x87\xb1\xca\x67\x08\x39\x6c\x6e\x92\x4c\xbe\x15\x28\x4e\x79\x07\xcd\x47\x29\xbb\xd5\x3b\xd5\x8a\xc0\x5b\x65\xaa\x17\xe9\x48\x8a\x6e\xa6\x74\x5d\x87\xae\x44\x38\x06\x65\xc5\xea\x6d\xfa\x71\x5b\x92\xdc\x8b\xc0\xfd\x07\x46\x7a\xad\x45\xf0\xa0\x59\x33\xc6\x01\xef\x58\xdf\x6f\x18\x2d\x09\xb7\xf4\x60\x10\x33\x2e\xb4\x41\xb5\x9d\x09\xd4\x4b\xb7\x57\xfc\xfd\xa0\xda\x41\x9f\xad\xa0\x6c\x09\xd3\x7f\x22\x5a\xdb\x8c\xe5\x79\x70\x25\x50\x25\xb1\x96\x95\x58\x4b\x9b\x58\x4b\x0e\xd7\xdc\x2e\x35\xde\x71\x0d\xf8\x43\x4f\x25\x7b\x12\x44\xf1\x22\x2b\x1d\x45\x3b\x72\xba\x76\xd5\x2e\xce\x92\xb6\x39\xcb\x2a\x26\xd9\xd9\x59\xb9\xdb\x76\xbe\x30\xfd\x89\xf5\xd6\xc3\x18\x44\xc4\xb2\x1e\x9c\xf3\x17\xdf\x46\xa0\xc4\x21\xfb\x70\xab\xc6\x49\xd2\x1b\xd3\xd2\x62\x96\x88\xc3\x28\xf5\x7a\x9b\x76\xb4\xa3\x88\x6a\x09\x6f\x84\xc1\x8b\x26\xf8\x17\xf5\xfc\xca\x37\xdc\x5e\xcc\xe5\x2d\x1f\x47\xfc\x3a\xcb\xc6\x94\x80\xff\xa9\xce\x1d\xbc\xcc\x09\xc2\xf0\x25\xbb\x3b\x15\xec\xa2\x28\xa8\x56\x5e\xef\x12\x86\xc4\x28\x97\x1c\x47\xea\xed\x1a\xdf\x69\xf6\x0b\x01\x52\xa4\xf9\xaf\x7d\xb1\xb7\xe4\x36\x68\x04\xac\x73\x23\x1a\x81\x0d\xb1\xe9\x1d\x48\xb9\x04\x2b\xfc\x08\x5a\x11\xcd\xd5\x3d\x9d\x71\x79\xb8\xb5\xf0\xc7\xd5\xad\x9b\xb1\x7e\xeb\x66\x2c\x5d\x02\xe7\xfe\xb2\xec\x0b\x8e\x60\x94\x90\x0d\x4a\x78\x09\x92\x17\x50\xad\x27\x2f\x92\x67\x1e\xb4\xd2\x4c\x5a\x77\x7d\xe3\x42\x45\xbd\xc9\xe5\xbd\xcd\x12\x1b\x41\x18\xc2\xa5\x2c\xe6\x87\xe6\xbc\x76\x37\xbe\x2c\x01\xb7\x7a\x44\x91\x45\x5f\x11\x89\x03\x28\x33\x31\xe7\x56\x81\x7e\x78\xec\xd0\x54\x47\xd4\x72\x2e\xae\xe2\xf4\x12\x8c\x7d\xc2\xc5\x29\xaa\x5f\x7b\xc6\xb1\x80\xd4\xa1\x27\x56\x0b\xe0\x27\x41\xff\xa0\x63\xa1\xb6\x51\xa3\x8a\x1a\xe9\x69\xd5\x6b\x85\xca\xaa\x15\x35\xf6\xd5\xeb\xe8\xd6\xa5\x8d\xc6\xdc\x42\xd7\x7a\x9a\x99\x18\x74\xa0\x9e\xd2\xd9\x28\x8c\x9a\xab\x7d\x0c\xcd\x48\xa0\x54\x53\x19\xe4\xa5\x96\x0e\x69\xd2\x47\xa4\x91\x83\x89\x5a\xfb\xaf\x05\x5f\x6d\x36\xd0\x2a\x00\x8f\xf8\x5d\x9f\x75\x43\x00\x59\x6f\xfa\xa0\x80\xcc\x77\xbd\x90\x3c\x3a\x10\xdb\xb3\x28\x7c\xdd\x13\x85\x4d\x50\x10\x6c\x45\xdd\x44\xa4\xf9\x10\xc2\xb2\x3b\xd0\xf6\x19\x9d\x37\x99\x95\xc4\xc6\xc1\xb7\x92\x59\x7f\x77\x40\xd2\xdf\x24\x36\xd9\xd4\x5a\x67\xf0\x6a\xf9\x01\x26\x54\xd4\xf0\xf0\xdc\x6a\xfe\x85\xfa\xa0\x7e\xc1\x65\xda\x1a\x15\x9e\xa3\xe1\x12\xe1\x75\xe6\x36\x18\x3c\xd0\x73\x61\x51\x59\xaf\x0e\x42\xab\x62\x07\x84\x54\x99\x4a\xeb\x28\x1b\x0c\x1e\x89\xe2\xf6\x48\x57\xc7\xd1\x7b\xc4\x8c\x08\x36\xa2\x8a\xf4\xcc\x4e\xd9\xff\x3a\xb2\x06\xb2\x4f\x19\x89\xaa\xb1\xfa\x68\x52\x4b\x55\x4b\x02\x04\x90\x1a\xda\xb5\x60\x55\x31\x58\x4c\xd1\x3b\x5b\x99\x48\x75\x89\x66\xec\xe7\x32\xe4\xde\xc2\xaf\x8b\xa6\x8a\xf6\xd7\xb1\x49\x65\x2c\x18\x73\x61\xdf\x82\x20\xb3\x84\x36\x8c\xf5\x29\x25\xc1\x7c\xae\x99\xba\x4a\xa3\xfd\xd8\xea\x52\xbc\x9f\x31\xba\x94\x72\x96\x97\x50\xd8\x0d\xaf\x21\x0e\x0b\x2e\x54\x2f\x95\x22\x71\x85\xe3\x4e\x51\xe6\x55\x87\xfa\xa6\x0d\x1c\x63\x6b\x8a\x8b\x54\x5e\x54\xb6\x2c\x8d\xc6\xb1\xd5\xd1\x47\x57\x27\x47\x8c\x2e\x25\x78\x59\x2f\x78\x54\x47\xab\xb7\x28\xbe\xe2\x48\x21\x9a\x98\x6a\x45\x88\x73\x85\xb3\x6c\xac\x9d\x12\x88\xb4\x37\xf2\xa4\x00\xe1\x29\x53\x91\xf5\x49\x21\xca\x5f\x56\x76\x72\xdd\x7a\x8e\x35\x3c\x45\x39\x2d\x3a\xf3\xd8\x0a\x76\xcc\xea\x1c\x98\x35\x99\xae\x6c\xb5\x3c\xf1\xe3\xaa\x2d\x38\x7a\xe2\x0c\xbc\x26\x65\xce\x6a\x19\xf1\xe0\x05\xab\xb1\x6f\x31\x96\x65\x21\xe5\x5b\x3c\xed\x92\x03\x21\xd9\x6f\xd7\xb6\x2c\x4b\xea\xae\x92\xea\x43\x84\xb2\xd3\x2d\xbb\x90\x5b\x3f\x61\xc0\x14\xe9\x50\x03\xbf\xf5\xbb\xc4\x10\x94\xae\xdb\x42\xec\xb9\xc3\x21\xd5\xce\x1b\xbd\x6d\xda\xb8\x38\x01\x75\x5b\x37\xcb\xb4\x44\x38\x81\x7a\x77\x37\x67\x39\xa4\x09\x19\x0c\xba\x57\x22\x98\xf8\x7d\x14\x86\xe2\x87\xb8\x00\x0d\xbf\xab\x83\x39\xc2\xb3\x28\x00\xdf\x0d\xb5\x22\x97\x45\xc7\xac\x92\xf7\x65\x92\x71\x92\x65\x10\x3a\x49\x22\xed\x7d\x79\x73\x9c\x36\x2e\x55\x83\x5c\x42\x5f\x94\xf4\xc0\xfa\x4e\x87\xca\x13\x5e\x75\xaa\xb1\x01\xd7\xa0\x24\x2d\x58\xf7\xf7\xed\x1a\xe7\xe4\x32\xc8\xd9\x70\x48\xa8\xf8\xe1\xaa\x1f\x23\xf5\x63\x4b\xfd\xd8\x56\x3f\x76\xd4\x8f\x5d\xf5\x63\x4f\xfd\xd8\x57\x3f\x9e\xa8\x1f\x47\xea\xc7\x53\xf5\xe3\x58\xfd\x78\xa6\x7e\x9c\xa8\x1f\x3f\x91\x8b\x83\xba\xe3\x92\x08\xc1\xa8\xb6\x81\xc4\x1f\x1e\x24\x7f\xe3\xd2\x8c\x72\x90\x6c\x6e\x82\x9f\xd3\x86\xc9\xcf\x93\x0b\xdc\x20\xa4\x8b\xc8\x86\x2b\xf1\xb0\x31\x94\x64\xbe\xe1\x16\x1a\x1e\xcc\x71\x9a\xe4\x69\xcc\x1c\x06\x13\x60\x36\x39\xaa\x81\xc9\x9e\x72\x9d\x37\x80\x79\x1b\xb3\x45\xce\x8d\x4b\x66\x04\x06\xb6\x62\xc0\x02\x30\xd2\x0c\x42\x38\x45\xa1\x01\xc8\xb3\xdd\x5d\x99\x27\xb0\xef\x10\xfa\x17\x39\xb5\x51\x72\x05\x71\x4f\x6b\x4b\x44\x94\xfd\x8b\xa5\xfc\xee\xfc\x5a\xae\x45\x1b\x73\x08\xb2\x8b\xee\x60\x56\xa5\xad\x3d\x9a\x3c\x1b\xe3\x58\xc0\x47\x4c\x1b\x8f\x18\x01\x94\x97\xa7\x24\xe0\x0a\x83\x0c\xc5\x27\x27\xa7\xa7\x3f\x9f\x12\x8a\xa7\x34\x7e\xb9\x4b\xac\xdf\x52\x61\x51\xb9\x2f\x31\x50\x64\x0b\x7a\x67\xbe\x80\x5f\x8a\xa9\xf8\x2f\x58\x81\x77\xe1\x68\xd3\xa5\x94\x2c\x72\x86\xd1\x9f\xc6\x9c\x1c\xa8\x4c\x23\x6b\x1a\x73\x3f\x7e\x64\xf9\xeb\x34\x5c\xc4\xec\x90\x79\x4b\xd9\xaa\xc7\x8a\xa2\xac\x92\x8a\xf7\xe8\x91\x50\x98\x11\x25\x39\x07\x1b\x61\x3a\x31\xb8\x65\xf1\x69\x96\xde\x18\x60\x8c\x83\xa5\x8f\xcb\xd1\x24\xc7\x41\x92\xa4\xdc\x00\x25\xd7\x08\x0c\x34\x15\x18\x41\x6e\x04\x86\x6a\x92\x58\x55\xeb\x41\xd5\x3a\xd3\x9a\x3b\x65\x13\x96\xb1\x64\xac\xda\x84\xfd\xc9\x98\x06\x79\xf2\x17\x40\x3a\x83\xdb\x3b\x11\x8f\x82\x38\xca\x59\x68\xd8\x46\xbe\x98\xb3\xcc\xb4\x6a\x25\xa0\x7f\x16\x96\x76\xb8\x0d\x7e\x7f\x5f\x91\x84\x0c\x5c\xcd\x07\x83\x2a\x9c\x47\x95\x7a\xc8\x3c\x5e\x81\x18\x97\x20\x76\x95\x1d\x0c\x80\xe3\x80\xd0\xd7\x8d\x8d\x33\x00\xcd\x60\xb7\x10\xa7\x3e\x87\xe6\x70\xba\x59\xc4\xa7\x2c\x83\x59\x87\xda\xb0\x0e\x2a\xf4\x50\x03\xd0\x47\x36\x55\x0f\xd6\x01\x1a\xe3\x78\x0a\x09\xca\x86\x28\x76\x76\x93\xc3\x09\x60\x99\x49\x97\x40\xca\x3c\x5b\x8c\x79\x9a\x79\x32\x64\x07\xa3\x2c\x59\xcc\x58\x06\xdc\x1c\x18\xee\x4d\x16\x71\xf1\x7b\x48\xc7\x69\x32\x89\xae\x16\x32\x0f\xc2\x56\x52\x3e\x18\x98\xb2\x0f\xf1\x5a\xbe\xe8\xf7\xe7\xc9\x61\x67\x2a\xe2\xc6\x03\x3a\x42\x28\x3e\x7e\xf4\xb9\x55\xc8\x92\x22\x9a\x61\xe9\x55\xc9\x29\xa9\xa8\x8d\x50\x09\x1e\xa8\x8c\xd2\x2f\x4c\x21\xc0\xd4\x6c\x29\x0c\x3b\x58\xc5\xc1\xa4\x90\x00\x3c\xec\x20\x73\xaa\xb1\xfa\xfa\x07\x1c\xc7\x43\xf4\x6c\x6d\xbc\xfe\xc6\x10\x4c\x25\x70\x7d\x1e\xae\x82\xc1\x19\xa9\xa3\x70\xe3\x6f\x0c\xd5\xcb\xf8\xcd\x51\x80\x7f\xef\x27\x76\x47\x33\xab\x0c\x09\x5b\xc2\xcd\x69\xa2\x5d\x45\x49\x06\x03\x66\xea\xb3\x93\x58\x34\xc3\x34\x9a\x81\x49\xa9\x30\x2d\x9a\xfb\x89\x39\x84\x4b\x43\x99\x99\x83\x37\x52\x62\x8e\x2c\x3a\xf1\x33\x73\x61\xd1\xd0\x4f\x4c\xf7\x89\x45\xe7\x7e\x66\x86\x16\xbd\x84\xcf\x1d\x8b\x4e\xfd\xcc\xbc\xb4\xe8\xcc\x4f\xcc\x7d\x8b\x5e\xf9\x99\x39\xb3\xe8\xb5\x9f\x98\x4f\x2c\x7a\xe7\x67\xe6\xb5\x45\x3f\x42\xd1\x5d\x8b\xde\xfa\x99\xf9\xd1\xa2\x37\xb5\xad\xae\x6d\x74\x07\xa8\x69\x7c\x90\x2a\x23\x55\xa9\x36\x44\x6d\xd3\x7b\xee\xe3\xa6\x0a\xb7\xf7\xc7\xfe\xf0\x60\xfc\xb7\xe8\x60\xbc\xb9\x69\xe5\xe7\x63\xdd\xec\x3e\x56\x66\x77\x23\xf1\x33\x3f\x10\x0d\x9b\xcc\xe7\x15\x9d\xdc\xdf\x4b\xfc\x5e\xd5\xe9\x89\x5b\x96\x6e\xa7\x67\x0d\x3b\x3d\xde\x04\x2a\x6d\x02\x22\x66\x3e\xde\xb2\xa3\x73\x90\x0e\x5c\x3a\x06\x35\x17\x4e\x8c\xd3\xcb\x1c\x2f\xdf\xc5\x7e\x42\x03\x33\xa3\x71\x29\xb2\xc5\x68\x90\xa6\x51\xa5\x27\x4f\x18\x1f\x4f\xdb\x42\x6c\x03\x59\xb2\x3a\xeb\xb1\xde\xca\x6c\x5e\xda\xef\x2a\xb4\x6b\x75\x2b\xe3\x5e\x01\x22\x63\xa7\x52\x75\x50\x89\xfe\x0e\x82\xf6\xcb\xe9\xab\xc1\x00\x7f\x99\x1d\x59\x9b\xe4\x10\x06\xef\x4b\x4d\x4e\xc5\x24\xbb\x62\x16\xec\x8c\x49\xd5\x87\xc6\xfb\x1d\xd8\x65\x4c\xab\x68\x16\x69\x9c\xcc\xe9\x08\x16\x98\xe5\x0e\xfe\x15\xf8\xe5\x0e\xfc\xc1\xab\xd8\x56\x41\xd7\xb7\x39\x20\x9c\x08\xbe\x59\xe9\xf5\xc2\xe4\xf6\x36\xb8\x62\x5d\x33\xd1\x50\xbf\x61\x78\x1e\x93\x4a\x12\xb6\xa4\x19\x08\xe0\x48\x92\x85\x5d\xad\xc8\xe1\x6b\x78\x52\x23\x74\xa2\x64\x1c\x2f\x42\x96\x9b\xac\xd1\xd2\x03\x17\x31\x5b\xcd\x88\x53\x1c\x66\x1d\xf0\x1f\xfd\xe1\x61\x57\x19\x79\x3b\x9a\x53\xd7\xf2\xba\xf2\xd1\x0f\x5a\xb9\xc7\x74\x4c\x46\x47\x1d\xdd\x3e\x02\xc3\x3f\x8a\xe3\x16\xdc\x6d\x12\x6b\xf4\x2b\xdd\x48\x86\x87\x7d\x1d\x9f\x5f\x14\x96\x67\x6a\x55\x61\xfa\x1b\x97\xf1\x90\x80\xea\xed\xe2\x78\xb8\x55\x3c\x72\x44\xd5\x90\x42\x0c\x9e\xb4\xde\x98\xd4\xb9\x95\xac\xd1\xbd\x74\xf4\x6c\xd0\xde\xf9\x34\x0d\x3d\x32\x4f\xc1\xcf\xa0\xb9\x24\x60\x40\x15\x6d\x9a\xae\xa5\xe1\x5a\xb4\x73\x26\x41\x5e\x09\x1d\xc5\xfb\xc7\x1d\x03\xad\xe3\x2f\x81\xee\x74\x20\x71\x00\xf2\x62\x32\x12\xba\xd9\xca\xdf\x24\x3f\x90\xcd\xc4\x09\x23\x16\x7e\x0c\xb8\xfc\x8a\xc2\xd6\xc0\x60\x06\xde\x66\xe9\x2c\xca\x99\x83\xd7\xa6\xba\x86\xaa\x96\x65\x35\xc8\x8c\xf1\xec\xee\x31\xc8\x57\x15\xba\x71\xaf\xe5\x7e\x0d\xea\xb1\x99\x7f\x11\xe6\xb1\xed\x7e\xc4\xab\xec\x7f\x39\xde\x1f\x34\xbf\xa9\x6d\x76\xac\x14\x82\xfe\x38\x9d\xab\x8b\x68\x06\x32\x73\x48\xef\x54\x59\xcb\xbc\x2a\xab\xcd\x83\x84\xc5\xb4\xf1\xfd\x4c\x96\x2b\xd6\x6f\xbf\xd1\xc2\x73\x16\x80\xfb\x73\x41\xc9\x33\x16\x84\xc6\xff\xa4\x97\x39\xb1\xbe\xb8\xb5\xa7\x69\x78\xb7\x02\x96\xb9\x44\x86\x46\x0c\x62\x3b\x23\xc6\x6f\xe9\xa5\x99\x5b\x46\x90\x31\x23\x64\x41\xe8\xac\x00\x62\xae\xd2\x21\x86\x1a\x9c\x02\x54\xad\xc1\x37\xe5\x29\x0f\xe2\x63\x68\x57\xcf\x12\x1d\xcd\x59\x06\xb4\xec\x8d\x86\xf4\xb7\xc5\x6c\xfe\x2e\xad\x26\xb6\xf2\x12\xd1\xe6\x5a\xa6\xd4\xd6\x01\x3c\xd9\x50\x58\xfd\xf0\xad\x40\x12\x8a\xfe\xa7\x2c\x9f\xa7\x49\x1e\x5d\xb3\x15\xa8\xc2\x92\xab\x5a\x59\x55\x17\x5e\x27\x7a\x88\xee\x78\xf6\x60\x89\xe9\x43\x25\xa4\xbb\x92\x88\x1e\x28\x76\xbe\xcb\xf4\x96\x50\xfc\xd5\xbd\xaf\x48\x49\xf6\xc7\x61\x87\x5f\x92\x86\x6e\xb5\x8b\x4a\x87\xa1\x87\x61\x24\x80\x23\xb2\x56\xc9\x23\x25\x34\xae\x57\x1c\x75\xe0\xf5\x8a\x3e\x8b\x58\x68\x1c\x71\xa2\x1c\xe6\x57\xec\xd2\x72\xa4\xfd\x8d\x66\x44\xf0\x21\xee\x44\xe1\xaa\xb9\x0e\x65\xe7\x5f\x3c\x49\x12\xd9\x2c\x34\xb9\xb5\xc6\xa4\xc8\x17\x4b\xd6\x80\x48\x85\x8a\x78\xb8\x64\x5f\x89\x5b\x95\x4e\x97\x41\x76\x05\x02\x2f\xfc\x59\xb7\x77\x96\x65\x5f\xd3\xf9\x54\xa5\xd3\x25\x87\xae\x39\xe5\xf9\x6b\xfc\xf1\x71\x96\x83\xaf\x15\xfc\xf7\x65\x6c\xe0\x92\x27\xff\x0d\x86\x60\x9a\xa5\xf0\xb0\x90\x0a\x74\xd0\xdb\xd2\xe5\x82\xf3\x34\x29\xe7\x50\x7d\x3e\xbc\x69\x5c\xf2\x44\xdb\x32\x2e\x79\xa2\x36\x8c\x2e\x17\xb3\x72\x9e\xeb\x62\x15\x2c\x41\xd8\x1d\x20\xcd\x50\x89\x0f\x6e\x14\xff\x66\x90\x6b\xe2\x88\x80\xf8\x14\x92\xbe\x5b\x80\x4b\x11\xb8\x86\xde\xa3\x38\xfe\x2e\x31\x5b\xc2\x29\x90\x5a\x81\x59\xf9\x1b\x56\xf0\x6a\xe7\x2f\x37\x28\xd1\x81\x75\x32\xf7\x97\x4a\x61\xf6\x26\x65\x51\xb0\x90\x26\x57\xb4\x14\xa5\xfb\xb2\x84\xaa\xd0\xce\x55\xa2\x60\x4f\x4e\x4f\xb5\x42\xb3\xe0\xde\xfc\x69\xbb\xfd\xd3\x76\xfb\xa7\xed\xf6\xff\x3e\xdb\xed\x7e\xcd\x74\xfb\x44\xb3\xdc\xf6\x5a\x18\xff\xe0\xe6\xd8\xcf\x0b\xb6\x60\xeb\x5b\x5d\xd7\xb1\x14\x76\x1b\x18\x16\x59\xdc\x61\x58\x58\x64\x71\x53\xb1\x7e\xbc\xc5\x53\x8e\x81\x17\x6b\x69\xe2\xb2\xf1\xb5\x25\x30\x73\x48\x4b\xa9\xce\xaa\xf4\x48\xa1\xbb\xd2\xc6\xb7\xda\x1d\x0b\xba\x76\xfb\xf3\x3e\xdd\x5a\x0c\x8b\x58\x5f\xdc\xd4\xe3\x15\x6b\xd1\xa5\xa2\x54\x62\xe0\x37\xa8\xd8\x37\x11\x9f\xc2\x39\x30\x68\xc9\x46\x3a\x31\xa4\x03\x14\xe6\x87\xc7\x52\x1d\x87\x98\xb2\x50\x58\xa4\x3a\xc4\xfa\x22\xd0\xbf\x46\xdd\x6d\xb4\xf2\x6f\x54\x77\x1f\xa1\x4a\x22\xba\xd6\x2b\xfa\x2a\xe0\x2c\x19\xdf\x19\x66\xce\xc6\x69\x12\xe6\xd6\x9a\xd5\xd2\xf1\x27\xe3\x11\xdd\xbc\x0e\x6e\x8d\xe3\x34\x11\xaf\x74\x8f\xef\x1a\xaa\xa8\x24\x8a\x9a\x32\x5a\x2d\xd2\xfe\xe6\x33\x42\x97\x9f\xd8\x9d\xc7\xfe\x0f\x7b\xdf\xde\xe4\xb6\x8d\xed\xf9\x55\x24\xce\x46\x43\x4c\xc3\x74\xb7\x1d\xc7\x59\x2a\x88\x2a\x71\x9c\x89\x6b\x93\x38\xeb\x24\x93\x9a\x52\xb4\x1d\x8a\x82\x5a\xbc\xa6\x00\x5d\x92\xb2\xdd\x25\xf1\xbb\x6f\xfd\xf0\x22\xf8\x50\x3f\xb2\xc9\x5e\xcf\x1d\xff\x61\xb7\x48\xe2\x79\x70\x70\x70\xde\x80\xc5\x41\x65\xac\xad\xef\x20\x59\x35\xa5\xc9\x9d\x4a\x2b\x15\xcd\xdd\x8a\xe6\x1a\x9a\x77\x2c\x2c\xd3\xd7\x97\xf7\x68\x7c\x9b\xbc\xbb\x4c\x1b\x18\x5a\x49\xcf\xd1\x23\x6f\xab\x04\xf4\x8a\x57\x7d\x8a\x79\x6e\x29\xfe\x2d\xb0\x87\xbd\xe7\x8c\x19\xa3\x0e\x12\xc1\xdd\xc8\x4d\x6f\x7d\x6e\x7a\x5f\xe4\x37\x32\xb6\xdb\x0f\x4e\x09\x1f\x9c\x12\x3e\x38\x25\x7c\x70\x4a\xf8\x37\x73\x4a\xf8\x6f\xea\x88\x70\x92\xe7\xfe\x13\xdd\x0f\xfe\x65\x5c\x0e\xfe\x30\x53\xdd\xe1\x76\x2d\xda\x9f\x6e\x87\xd3\x2a\xb6\xdb\xb4\x80\x7f\xb6\x21\xae\x4c\x37\x1c\x9e\x62\x2b\x78\x7e\x2e\xf9\x08\xfa\xb4\x8c\x7f\xb0\xcb\xbd\x4f\x76\xb9\xf7\xc2\xe6\x65\x34\xc2\xb7\x1b\xbd\x1a\xfa\x73\x17\x39\xe3\x6e\x46\x2f\xfe\x87\x9b\x98\xf8\x3d\x4c\x4c\xfc\x8f\x35\x31\x71\x6b\x62\xe2\x1d\x13\xd3\xdd\x55\xec\xb7\x09\x05\x6f\x3f\x08\x05\x1f\x84\x82\x0f\x42\xc1\x07\xa1\xe0\x83\x50\xf0\x41\x28\xf8\x20\x14\xfc\xeb\x08\x05\x3f\x3a\x76\xfc\xbd\x11\x0c\x3e\x88\x02\xff\x16\xa2\x40\x83\x79\x5f\xcb\xe2\xdf\x90\xc9\xbf\x2b\xef\x5e\xec\xc5\x65\x52\xfd\x57\x30\xed\xbf\x8b\x8b\x2a\x98\x08\x3f\xb9\x20\x53\x11\xad\xc0\x70\xbd\x92\x7b\x5c\x53\x4a\xfb\xfb\xaa\x88\x12\x53\x45\x32\x11\x3e\x7e\xec\xaa\x7c\x9b\x89\xd7\x43\x15\xa4\xab\x90\x30\x11\x3e\x6d\xfa\x40\x52\xf8\x77\xa7\x6a\x25\xae\x56\x8e\x5a\x8f\x5c\x2d\x98\x2b\x4f\x8f\x2e\x77\xd5\x32\x54\xfb\xb8\xdd\xd9\x2b\xbe\xca\x0a\x68\xf7\x07\x6a\x66\xae\x26\x14\xae\x4f\x9f\x74\x6a\xa2\xc7\xa1\x6a\xa5\xab\x96\x02\x1c\x4d\x87\x37\xf5\x95\xba\x4a\x50\xe7\x3e\xfd\xa4\xa9\x74\xaa\x9b\xbd\xab\xb1\xc6\x42\xb9\x0a\x1a\x07\x15\x3c\xca\xa1\x7a\xeb\x68\x69\xea\x41\x55\xfc\xa8\xbb\xc0\x2a\x3d\xf3\xbb\xc1\x31\xae\x5c\x8f\x3b\xd4\x6c\xd6\x39\x97\xa9\x4a\x20\xf1\xe3\x26\xd9\x0d\x8e\x75\x87\x9a\xd4\x94\x2e\x30\xb4\xe2\x86\xb2\x76\x7c\x60\x53\x9f\x3e\x75\xbd\x6c\x91\x80\x6a\xa8\xc6\xd2\x8d\x6b\x03\x80\x7f\xea\x6a\xec\x4b\xae\x27\xf5\x4d\x56\x56\xb2\xb8\x1e\xaa\xbc\x71\x95\xa1\x1b\xbf\x68\xc0\xb1\x96\xc5\x36\xa9\x7e\x48\xaa\x8a\x17\x62\xa8\xe6\xd6\xd5\xbc\x02\x03\xdd\xe0\xa3\x62\x37\x75\xc7\xdf\x65\xab\x55\xce\xdf\x26\xc5\xe0\x54\xaf\x5c\x0b\xd0\xc4\x7f\xda\x00\x74\x59\xc8\xb7\xe5\x8d\xc3\x7e\xe3\xaa\x5e\xa3\x6a\xb3\xfc\x9b\xa4\xdc\xdc\x50\xef\xda\xd5\x83\xba\xff\x71\x83\xd3\x1a\x6d\xbe\xe3\x5b\x59\x5c\xdf\x50\xff\x12\xf5\xef\xa1\x22\x68\xee\x4b\x38\xd4\x4e\x18\x28\x74\xfe\x99\xca\xc5\x62\x15\xe4\x73\x76\xee\xd8\x78\x27\xe6\x74\xae\xfd\x50\xcc\x3c\xa4\x26\x24\x74\x16\xf3\x62\xc1\xf8\xbc\x58\x58\xa9\x7c\x24\x6a\x4b\x82\x2e\xcc\x25\xa1\xa1\x44\x06\x7b\x65\x2b\xc9\xd4\x73\xae\x6d\x27\x1f\x43\x4c\xc2\xb3\x11\x94\x1e\x43\x50\x0a\xf1\x62\x4f\xa8\x08\x1f\x9f\x13\x23\x32\x3d\x86\x1d\x45\x6d\x12\x65\x48\xf9\x04\x22\x93\x08\x1f\x3f\x82\x21\x25\x14\xe1\x45\x23\xda\xe9\xac\x20\xed\x0c\x04\x76\xba\x15\xbb\x98\x56\x9f\x75\xe5\x9f\x69\x65\x25\x4e\xe1\x89\x3b\x55\x73\x03\xa2\x82\x92\x20\x77\x02\x8a\xd0\x40\x01\x3c\x18\x20\xe3\xc4\x09\x5e\x43\xb0\x3b\x6c\xf4\x8a\xc6\x36\x7f\x3e\x4d\x37\x59\xbe\x2a\xb8\x88\x57\xd1\x8a\xaa\x1d\x59\xea\x9f\x4a\x4f\x1e\xef\x23\x4c\x84\xb6\xce\x33\xfb\x52\x0a\xa5\x8f\x68\x1e\x75\x8e\x1f\xfb\xac\xb6\xa9\xa1\x22\xae\xbf\x9a\xbe\x61\x69\x48\x42\x7b\x9b\x2a\x78\x91\xd8\x1d\x29\xee\xa4\x8b\xaf\x60\x2c\x37\x5c\x31\x96\xbd\x8c\x7b\x28\x78\x30\x43\xf4\x21\x6d\x26\x9b\xf5\x32\xaa\xec\xa2\x84\x22\x5c\xb0\x46\xbb\x2f\xb4\xf5\x57\x49\x29\x03\xed\x5a\x2a\x16\xab\x43\xdd\xc0\x44\xfd\xde\x25\x45\xb2\x35\xbf\x9d\xd4\x52\x9a\xec\x73\x74\x93\x88\x55\xae\x0d\xb7\x4d\xab\x5c\x69\x79\xc6\x9e\xcc\x65\xc0\x66\x54\x3b\x7c\xda\xff\xd4\x4a\x3b\x57\x1b\xe0\x6b\x18\x69\x24\x38\x15\xe1\xa8\x5b\xf1\x01\xaf\x2e\x9a\xb6\xf7\x89\xe0\xe6\x54\x34\x62\x52\x51\x79\x55\x0c\x5a\xd8\x1d\x64\x6e\x0a\xdd\x44\x4b\x12\xda\x14\xdf\x45\x22\x74\x7a\xdb\xef\x12\x91\x5c\xf1\xc2\x1b\xda\x4f\xdd\x6f\xc3\x22\xd4\x89\xe1\xb9\xeb\x4e\x78\xbf\x93\x69\x6f\x72\x66\xa4\xd4\x1f\xbe\xba\x2b\x58\x4d\x0d\x77\xaf\x88\xc8\xe2\xb4\x9d\x8e\xb2\x12\x3e\x53\x2e\x1a\xd5\xb7\x66\x71\x8f\xc7\x24\x24\xe1\xf8\xc2\x6e\xdc\x70\x1d\x25\x24\xac\xec\xd3\x12\x53\x2f\x8e\x47\x49\x48\xdd\x2c\x35\xb2\x3b\x29\x09\x75\x78\x82\xd3\x61\x48\xe9\x91\x9e\x00\x55\x68\xb8\x64\x35\x7c\x73\x8b\x4c\x7f\xc1\xbd\x48\x4e\x53\xfe\x72\x2f\x72\x95\x1c\x8a\x0d\x77\x1a\xe9\xaf\x9e\x6c\x0e\xf2\x5c\xcd\x78\xe4\xa1\x69\x58\x91\xd8\x68\xe6\xc2\x0d\xe6\xcf\x0d\x86\xc0\xd8\xec\xf9\xda\x09\xca\x0d\xf8\xed\x26\x07\xeb\xda\x01\xcc\x2b\x9e\xf2\xec\x0d\xef\xec\x56\x4e\x0e\x35\x1d\x4a\x8f\xe5\x83\xb0\x3d\xa3\xc9\xa4\xfd\x8c\x00\xec\xee\x5e\x3f\x91\xd9\xca\x6e\x5d\x95\xd0\xca\x61\x04\x82\xbd\xb0\x71\x69\xa2\xae\x08\x30\x63\x29\x69\xee\xe3\x50\xc6\xf2\x36\xcd\xa0\x25\xcb\xcd\xbd\x5d\xca\xc2\x9e\xd3\x79\xd0\x2a\x10\x50\xab\x38\xf0\xce\x9e\x7d\x9e\x33\x56\xe9\xdb\x1f\x0c\x68\xa1\xdb\x2b\xc3\x2b\xd2\xbf\x18\xab\xa1\x58\xda\x77\x7d\x94\xce\xf9\xa2\x26\xb4\x0c\xb7\xc8\xfd\x9a\xd2\x83\x9a\x43\x61\xb2\x3e\xa9\xdf\xd4\x91\xa7\xca\xd2\x6b\x61\x09\x93\x6c\x20\x5d\xc6\x09\x6d\x8d\x36\xce\x20\x6c\xd4\x35\x99\x56\x51\xc2\xde\xdc\xeb\xe8\x56\xda\x3c\x49\x13\x9a\xd3\x4c\xd1\xb3\x12\x01\x40\x63\x43\x7c\x52\x10\x99\x37\x32\x5b\x8d\xce\x91\xca\x99\xa4\x2a\x57\xbf\xd1\x55\x7f\x97\x89\x6c\x8d\x58\x27\xfe\x2e\xe5\x3b\xf4\x37\x92\xa9\x72\x39\x5b\x4d\x47\x10\x4d\xaa\x0d\x1f\x09\x29\x1e\x6c\x6d\xc1\x15\x7f\x33\xe2\xe2\x4d\x56\x48\x81\x71\x8f\xd6\xb2\x40\x06\x99\xd1\x1a\x5a\x6d\x95\xe2\x65\x64\xd2\xb3\x8c\x12\xb1\x1a\xe1\x6a\x0e\x34\x9b\xe4\xa3\x0d\xcf\x77\xeb\x7d\x3e\x7a\x9b\x14\x22\x13\x57\x65\x14\x90\x29\x2e\xac\x52\xa3\xdc\xb3\xb9\x37\x8b\x05\x5d\x43\xd5\xe8\x8d\xb4\x8a\x0a\xae\x32\x62\x87\x0f\x3f\x2a\x1f\x5e\xd1\xde\xb9\x30\xda\xcf\xd7\x67\x67\x0b\xad\x2b\x80\x2d\x89\x05\x2f\x84\xca\x0c\x29\xaa\xd1\x3f\x32\x89\x94\xdf\xb8\x2c\x5e\xd3\xf5\x34\x5a\x17\x48\x42\xf9\x93\xfc\x41\xee\xd8\x05\x4d\xdb\xe6\x0f\xd7\x28\x6f\x5e\x27\x76\x25\xda\x6f\x2c\x6f\xf0\xa6\x73\xe0\x87\x15\x99\xbd\x99\x57\x0b\x75\xfc\x4c\x5f\xf7\xbf\x4e\x26\x45\x18\xbc\xfc\xc7\xf3\x57\xaf\x5e\x7c\xf5\xfc\xf2\xcb\x2f\x7e\x7c\x8e\x6b\x21\x04\xe4\x8f\x24\xad\x9e\x41\x15\xf1\x02\xc9\xb9\xd7\xc8\xe0\x3f\xfa\xa7\xdc\xab\x90\x4a\xf0\xb9\xdb\x9d\x4d\xe4\x83\x4b\x22\x8a\x6c\xc5\x47\xbf\x7d\x54\xfe\x36\x5a\x17\x72\x3b\xba\x96\xfb\xc2\x98\x60\xca\x1d\x4f\xb3\x75\xa6\xf1\x31\x1a\x3d\x17\xe5\xbe\xc0\x8a\x26\x95\x2e\xa5\x63\x86\x55\x06\xa1\x72\xb4\x92\xca\x08\x81\x16\xf3\x64\x37\x82\xb0\x36\x52\x23\x31\xc5\xca\x28\x40\xca\x5d\xae\x86\xfd\xd5\xf3\xaf\x5f\x7c\xff\xfc\xf2\xbb\x2f\xbe\xff\xa7\x1a\xf4\xf1\xe8\xbf\xba\xfc\xee\xf9\xab\xbf\x3f\xff\xea\xfe\xd3\xd1\x8a\x77\x3d\x19\x29\xcc\x54\xec\x96\x19\x6d\xa5\x1e\xbd\x18\x49\x91\xf2\x68\xf4\x13\x2c\x44\xd0\xef\xe7\x59\x5a\x8d\xb6\xc9\x35\x3c\xae\x56\x7b\x8e\x96\x92\xd1\x36\x7b\x97\x09\x35\xe6\x66\x09\x4b\x97\x4f\x4d\x90\x43\x31\x64\xdc\x69\x8d\x57\x0d\xf3\xaf\xbd\x51\x62\x5b\x24\xde\xb8\x34\xb0\x65\xe1\x54\x59\xda\xf6\xa5\x07\x30\x7a\x21\xca\x8a\x27\x2b\x3a\xfa\x0f\xb8\x86\xe9\xba\x05\xbf\xda\xe7\x89\xbd\x0c\x02\xea\xb7\x22\x1c\x57\xa1\x20\xbf\xa3\xf7\x7b\xf7\x65\x34\x00\x9e\x85\x09\x97\x0c\x44\x97\x97\x2a\x5f\xd3\x17\xfb\x4a\x7e\x99\x89\xd5\x0f\x49\x56\x94\x53\xd1\x45\x5b\xe4\x79\xba\x8c\xd4\xd4\x14\x30\xcd\x4f\xcf\x80\xa0\xb9\x61\x80\xb8\x5b\x37\x23\x93\x49\x36\x66\x2c\xd5\x5b\xa6\x64\x62\x9e\x2d\xe8\x9e\xc9\x7e\x41\x90\xac\x24\xdc\xd3\x8c\xd0\xcb\xfe\x57\x72\x39\xcf\x16\x21\xa7\xa5\x47\x3f\xd6\xfd\x0d\x98\xc1\x82\xd2\xac\x31\xb3\x6b\x5c\xd2\x0d\x5b\x4e\x26\xe3\xf5\x64\x32\xde\x4f\x26\x2a\x4d\xa5\x88\x92\x7d\x25\x97\x99\x58\xa1\xeb\x0d\xc9\x75\xb8\x7a\x46\x4b\x42\x55\x2a\xf4\xd2\xdd\x16\xb8\xd7\xc3\xdf\xb2\x37\x2a\x2b\x7a\xb8\x9e\x4c\xc2\x13\xd8\xbf\x6d\xef\x0b\xf5\xaa\xb3\xc6\x3f\x0b\xfe\x6e\xa7\xe3\xda\xb0\x5d\x47\x3b\x99\x67\xe9\xf5\xe8\xa3\x52\x11\xd6\xd7\x5c\xfd\x7c\xbb\xe1\x42\xaf\xf1\x15\xc0\xdb\x2c\x3e\xaa\x60\x63\x6e\x01\xa9\x53\x83\x98\xa9\x09\xac\x42\xfc\xa1\x25\x89\x7b\x43\x9a\x4c\xd4\x37\xb6\xb3\x45\xcc\x45\x82\x78\x62\x65\x5d\xab\xa7\x69\xb3\x8d\xf6\xce\x3c\x5a\x11\xbb\xee\xb0\xcf\x8e\x0c\x31\x94\xda\x34\x87\xef\xdd\x35\x11\x44\x17\x49\x98\xaa\x70\x39\x2d\xc2\x71\x42\xff\xda\xc1\xfa\xd3\xa4\x01\xf8\x5c\xf2\xe2\x0d\x5f\xb9\xbc\x5f\x54\xd1\x0b\x0a\xca\x50\x8d\x74\x0e\x3f\xf1\xab\x32\x09\x8f\x60\xf2\xda\xf0\x51\x00\xce\x2c\x4b\xcb\x60\xf4\x9a\x5f\x47\xa3\xaf\x74\x53\x59\xa5\xf6\xa9\x70\x06\xee\x26\x93\x18\xde\xf0\x64\x35\x1d\x65\xd5\xe8\x6d\x96\xe7\xa3\xb2\xc2\xff\xc8\x52\x96\xa6\xf0\xf4\x5c\xe6\xae\x71\xcf\xfe\x1a\xfd\x95\x0a\xa7\xf9\xc2\xf4\x38\x90\x29\xd7\x33\xce\xd8\x75\x1f\x18\xb3\xeb\xb9\x30\x27\x84\x39\x70\x8a\xf0\xc4\x32\x66\x34\xb8\x33\x94\xfe\x50\x02\x2a\x08\x05\xf7\x10\xf2\xb9\x58\x30\xfd\x87\x82\xef\xc6\x0f\x26\x6b\xef\xe0\xd4\xfe\xa2\x87\x22\xe4\x93\x09\xec\xec\xc6\xf6\xee\x36\x1e\x1f\x78\x07\x85\x11\x2f\xae\xf8\x0b\x51\xc9\x5f\xb2\x6a\xf3\xbd\xfc\x6a\x8f\xac\x2e\x49\xc5\x91\x69\x31\x24\xf1\xc8\x78\x17\xa8\x62\x8a\x17\xd1\xed\x2a\xfe\xa1\x83\x7e\x03\xf8\x06\xd7\xcf\x86\xfd\xc1\xa0\x6f\xef\xf1\xa7\x02\x9c\x4e\x25\x47\xaa\xe0\xa8\x7a\x2b\x0d\xf9\x2c\xf5\xb9\x88\x75\x2f\x91\x77\x0f\x76\x2e\x85\x7e\x77\x02\xe6\x14\x38\xb1\x4b\x8a\x2a\x4b\x41\xfe\x81\xb2\x59\x69\x8b\xa6\xc9\x1e\x9e\x0d\xcb\x6b\xd5\x5d\x47\x0c\x0e\x09\xbc\x88\x3b\x32\x77\x48\xec\xd1\x0c\x9f\xae\xbd\x62\xaa\xda\xe3\xc4\xc9\xb4\xc1\x5b\xb0\xb8\x7a\x29\x01\x00\xb5\x3d\xc9\xb4\xcf\xe2\xac\xcc\x02\xf6\x2c\x33\xf6\xc6\x84\x41\x93\xa8\xba\xb1\x70\xf0\x0b\xd0\x1f\xc8\xcd\x98\xb0\x52\x64\xd1\xbc\x2b\xec\x3b\x61\x8e\xa4\xe6\x62\xc0\x75\x28\x31\x58\xfc\x29\x08\x95\x1e\x8e\xed\x4e\x0d\xf1\xd4\xe0\x4e\x0c\xcd\x6b\x72\xe9\xb3\x71\x55\x84\x43\xc0\xbb\xb3\x54\x34\x05\x37\x6d\x6d\x11\x1f\x3c\x33\x69\xcf\x61\x81\x3d\xf2\x3d\x16\xa8\xa2\x8e\x67\x17\x8b\xa9\xd2\x02\xa1\x73\xe9\x8f\x66\xdb\x28\x0d\xa4\x27\x8a\x50\x49\x13\x2b\x89\x0d\xf4\x6a\x7a\x9b\x4c\xb4\xfd\xd8\x48\xa1\x20\x68\x25\x43\x86\x57\x88\xad\x5a\xe5\xc0\xa4\x91\x68\xf9\xba\x64\xc8\x81\x9b\x95\xc6\xde\x56\xb0\xe4\x78\x34\xfa\x04\x50\x4c\xce\xb0\x4e\x86\x9e\xd9\xac\xc8\x3e\x56\xce\x86\x5e\x86\xc4\xd0\xb3\xb0\xb7\xd7\xf3\xc9\x64\xdc\xbe\x97\x2b\x27\x34\xf8\x68\xa8\x09\xed\x48\x6f\x96\x20\xb1\x69\x1c\xb1\x09\xd0\x38\x12\x41\x7a\x1a\xaa\xe3\xd1\x90\x46\x50\xba\x32\xab\x9a\xbc\xb1\x81\x01\x84\x9e\x4e\xae\xa4\x2b\xc7\xf6\x68\xe9\x82\x7a\x6f\x22\x8f\x98\xb3\xaa\xf5\x65\x08\xe8\xb8\x97\xf9\xca\x89\x8d\xa5\xc6\x1c\x8c\x0f\x57\x6b\x51\xdc\xca\xf1\x0e\xc2\xa2\x72\x43\xc0\x9f\xb7\xc0\xc6\xce\x26\x9e\x4c\x42\x67\x1f\x52\x2f\x58\xaf\x08\xae\x6d\x2e\x7c\xb7\x12\x2b\xf6\x1a\x79\x57\x71\x12\x61\x14\x45\xa0\x95\x7d\xc6\x5f\x83\x32\xdb\xee\xb4\xa0\x39\x4a\x46\xbf\xe9\xfa\xbf\x19\xf2\xe1\xd3\xd1\x04\x24\xea\x0d\xf1\x3a\x9b\x27\x8b\xe3\xd1\xef\x7d\x9e\x2c\x14\x62\xb8\x1d\x52\xd5\xa8\x79\x05\x68\xbc\x61\x07\x45\xe9\xca\x36\x93\x41\xcd\x01\xdc\x79\xdb\xe8\x13\xdb\xef\x0d\xa6\x0e\x7e\x82\xee\xe8\xd9\xe9\xef\x1d\xd0\xc5\x43\x87\x69\x4f\xc7\x78\xaa\xd0\x33\xaf\xb3\xe1\x96\x8c\xde\xc3\x7e\x7b\xf9\xfd\xb3\xe7\x41\x5b\x9d\xa2\xf5\x51\x7e\xe5\x80\xf6\x3c\x2a\x4e\x7d\xef\xe9\x6d\xda\xe5\x6c\xc2\x62\x53\xda\xe8\x77\x6f\x18\x4c\xa7\x44\x7f\x34\x37\x17\xf0\xd5\x43\xed\x22\x3f\x7f\xff\xe3\x17\x5f\x3f\xbf\xbc\x75\xe6\x43\xe5\x6e\x98\xe0\x50\xf1\xc1\x21\x6a\x02\xe6\x20\x11\x77\xe4\xea\x9a\x5e\xb3\xc3\xa9\x9c\xdb\x83\x2b\x5b\xd3\x4b\xd6\x52\x82\x7b\x94\x58\xb9\xd1\x78\xdf\x58\x55\x53\x83\xf5\xed\x52\x6d\x06\xb9\xef\xc7\x06\x09\x0a\x47\x02\xa9\x07\xf0\xba\xdb\x61\xaf\x04\xcb\xa1\x84\x1a\xf8\x00\x91\x97\xa6\x37\xb6\x35\xd4\x8c\xf7\x4e\xb5\xd0\xdd\x4a\xdd\x46\x3a\xdf\x67\xbd\x37\x60\x16\xbb\xef\xb4\xeb\x60\xb7\x60\x55\x7b\xb4\xa0\xdb\x8f\xfb\x62\x47\xea\x5e\xa8\x61\x5a\xd2\xd2\xae\xa6\x45\x94\x9a\x5a\xa9\xae\xf9\x4c\x0e\x75\x4d\xdf\xb1\x43\x7f\x13\x7a\x45\xcc\x39\x9b\x95\xea\x0b\x5f\x31\x84\x4f\xd1\xb7\xec\x30\xb8\x17\x6e\xac\x78\x51\xd7\xf4\x35\x3b\x18\xed\x55\xc7\xa8\x81\x41\x1e\xfc\x23\x38\xe2\x42\xc5\x24\xbe\xf2\x8a\x1b\x53\x83\x9a\xad\x6b\xd8\x1f\xad\x71\x77\x1d\x77\x3b\xaf\x6b\xfa\xdc\xf7\x4e\x73\xec\x55\x1e\x3e\x6f\xe8\x39\xf5\x35\x01\xaf\x09\xdd\xd6\xd6\x76\x7f\xf1\x31\xa1\x19\x3b\xd4\xd4\xbb\x30\x47\x2b\x8c\x59\xa0\x11\x3e\x98\x72\xdc\xec\x2c\x8b\xaa\x64\xc9\xdd\x75\x96\xe4\xd0\xf0\x3b\x32\x24\x87\xda\xfa\x18\x7c\xf2\x31\x99\xca\x08\x22\x5e\xf5\x8b\xd6\x12\x3e\x4b\xd2\x0d\x67\x05\x6d\xfa\x39\xe9\x33\x6a\xd4\xa0\xb9\xda\x78\xf0\xcf\x4d\xac\xe4\xe5\x29\x3c\x9f\x25\x79\x0e\x8e\xf8\x07\x8b\x44\x23\x93\xcc\x5c\x16\xe5\x48\xdb\xfd\xf3\xeb\x51\x56\x2a\x65\x58\xb9\xdf\xa1\x4f\xc3\x96\x6f\xf8\xe8\x37\xe0\xde\x03\x40\xaa\xfc\x6d\xb4\x4b\xd2\xd7\xc9\x15\x8f\x46\x3f\x97\xbc\x69\x4f\xe7\x56\x72\x8f\x21\x81\x00\x00\x8b\x11\x44\xd1\x6d\x04\x9d\xda\x4a\x4b\x62\x49\x35\xda\x54\xd5\x2e\x7e\xf8\x70\xbd\x8c\xb6\xfc\xe1\xbe\xe4\x0f\x54\xe5\x07\x4d\x2f\x01\x99\x6a\x85\x65\x76\x83\x4e\x93\x66\x75\x3d\xe8\xc2\x58\xf3\x28\x2b\x5f\xf1\xff\xdc\x67\x05\x5f\x31\x6e\x0c\x4b\x07\x75\xe5\x6c\xcc\xe9\x52\xca\x3c\xe6\x6a\xd5\x62\x4e\xc5\x7e\xbb\xe4\x45\xcc\xcd\xed\xb6\x31\xa7\x70\x4b\x11\x57\xf8\x71\xbd\x5d\xaa\xa2\x89\xb8\xc6\xff\xa8\xff\x72\x1d\x57\x94\x1b\xe5\x35\xb7\xbf\x30\xe7\x98\x53\x2b\x52\xab\x42\x42\xae\xb8\x6b\x56\xbd\x91\x82\x37\x7f\x55\x95\x8a\x96\x70\x34\x40\x93\xef\x92\xb4\x8a\x2b\xda\x06\x23\xee\x7f\xe8\x62\x45\x5c\x38\x84\x16\x91\x2b\xc9\x04\x15\xf5\x2d\xa8\xd8\x20\x53\xf0\xe3\xf3\x67\xaf\x9e\xff\x74\xf9\xd5\xcb\xcb\xef\x5f\xfe\x74\xf9\xc3\x17\x3f\xfe\x78\xf9\xd3\x37\x2f\x7e\xbc\x7c\xf9\xea\xf2\x9f\x2f\x7f\xbe\xfc\xe5\xc5\xb7\xdf\x5e\x7e\xf9\xfc\xf2\xeb\x17\xaf\xd4\xa9\x70\x63\xb3\x5d\xad\x7c\xb3\x33\x61\xe2\xc0\xf5\xb1\xc6\x98\x93\xc0\x7c\xa5\xdf\x91\xa8\x94\x5b\x3e\x64\x73\xa8\x8c\x59\x64\xce\x17\x4a\x41\xe5\x9e\x6a\xd2\x73\xd5\x37\xf2\xd9\x64\xe2\x99\x55\x8c\x39\xb1\xa4\x09\xd3\xe2\x2f\xcd\xed\x8f\xcc\xfc\x50\xb2\x98\xd5\xc6\x8d\x2f\xa6\xb0\xc6\xaf\xb3\xbc\xe2\x45\x33\x20\x7d\xff\x44\x69\x64\xb5\xf1\xb9\xf1\x07\x52\xf7\xf0\x49\xe7\x19\x20\xc8\xf1\x58\x84\x82\x82\xa0\xd9\x45\x49\x26\x93\xb0\x54\x7e\xd5\xf0\x2a\x49\xa2\x82\xbf\xe1\x45\xc9\x43\x10\x95\xf9\x82\xe6\x60\x18\xe5\xa0\xd5\x05\x5d\x54\xba\x0b\xe1\xba\xe0\x84\x16\xec\x01\x94\x75\x89\xf7\x6e\x5a\x1d\x8f\xc5\x2c\xb3\xa9\x5e\x63\xa3\xb8\xe3\x30\x80\x41\x6d\x35\x4a\x5c\x5f\x19\x93\x66\x68\x87\x9c\x27\x6f\x8c\xef\x0d\x0c\x31\xea\x56\x4d\xf3\x98\x53\x0e\x35\xb5\x79\xca\x6a\x47\x9b\xe0\x73\x02\xdb\x8c\xbc\x17\x16\xfc\x69\x61\x14\x72\x90\xfe\x59\x05\x1c\x37\x7c\xc6\x67\x82\x26\x2d\x8a\xe9\xb8\x11\x27\x0a\x9b\x92\xb4\x30\x7e\xde\xc2\xde\xdf\x2c\xd4\xfd\xcd\x45\xef\xfe\x66\x18\x85\xad\x50\x8d\x60\x57\x49\x0e\xe1\x39\x2d\xe6\x85\x69\xe9\xc1\xc5\x82\xc0\x0d\xd8\xe2\x81\xba\x17\x2d\x4c\x08\x4d\x9a\xd1\x57\x9e\x07\x68\x15\x15\x7c\xb5\x4f\xbd\x4d\xe0\xed\x9d\x91\xc0\x45\x64\x58\x11\x97\x43\x94\x87\xee\x1d\x15\xf4\x31\x6e\xa1\xa7\x55\x4d\xe7\x0b\x0f\x3a\xe2\x5e\xed\x3f\x53\x08\xd0\xe9\x40\xbf\xa4\x82\x7e\x4c\xf7\x03\x3d\xb8\x3d\xee\xbf\x21\x07\xc9\x78\x8d\x15\x77\x66\x73\xb5\xd3\x44\x68\x1d\xef\xcc\xc6\x73\x84\x00\x56\x5d\x1f\x9f\x84\x12\xf3\xe1\x3d\xe3\xbd\x25\x07\x7e\x3c\xca\x59\xa2\x74\x05\xb1\x4a\xbe\x52\x53\xe1\x0d\x06\x11\x3b\x82\x4a\x72\x48\x23\x75\xeb\x9e\xe9\x2e\x61\x95\xa7\xc9\x28\xc2\xc4\xae\x75\xd3\x34\xad\x1a\xa4\x71\x6f\xd1\xce\x26\x29\xc3\x64\xce\x17\x70\xe2\x29\xcc\x72\x1b\xf2\xe1\xb0\x01\xa6\x36\x7d\x13\x92\x2e\x4a\xea\x29\xfe\xc2\x29\x81\x4a\xdc\x3b\xeb\x0d\x31\x53\x7d\x29\x2d\xc6\xbe\x35\x48\x78\xfe\xf8\x83\xcc\x07\x06\xe9\x30\xbb\x85\xcc\x7b\x68\xfb\xc2\xfc\xd6\x41\xee\xed\x20\x73\x33\x48\xfc\x0d\x2b\x2a\x15\xb4\x6b\xfc\xb3\xad\x8e\xca\x5e\xf4\x89\xba\x14\xd3\x72\xed\x9f\x15\xd3\xb3\x33\x41\xa0\x45\x8b\xa4\xf8\x16\x64\x64\x32\xf1\x9f\xb4\x73\x08\xde\x80\x5f\x33\xde\x85\xfc\xed\x28\x87\x07\x13\xfe\x9a\x89\x1e\x8a\xbd\x46\xeb\x6f\xa4\x7c\x0d\xd3\x70\xb1\x37\x68\xa8\x5f\x64\xb4\xd8\xeb\x16\xf5\x73\x59\xd7\x8a\xf8\x98\x75\x15\xe1\xa3\x73\x38\x4d\xb9\x71\x73\xa3\xa5\xab\x8c\x3b\xbc\xf5\x4f\xa1\xf8\x1b\x6d\xd0\x06\x08\xa1\x7a\x4a\x56\x2b\x36\x78\xe0\xa8\x62\x66\x0f\x10\xe3\x8b\xaf\x41\x77\x43\x79\x66\xeb\x75\x4f\x0e\x6f\x07\xe2\x8e\xbd\xda\xb6\xb8\x49\xda\xfc\xa4\x06\x88\xa2\xec\xb6\xa9\x86\xbe\x9b\x3a\x0a\x61\x9a\x5a\xbd\x31\xcc\x17\xf5\x6d\x87\xfe\x00\x5d\xe6\xb0\x87\xbb\x83\xcd\x29\x26\xf9\xf1\xa8\x7f\xb8\x8f\x17\x38\x29\xdb\xaa\x2b\x77\xc9\xcb\xa8\xfd\x1e\x86\x5d\x8b\x2f\xb0\xb7\x9b\x9f\x78\x89\xf3\xef\xba\x81\x0f\xf7\xa8\x50\xe1\x44\x41\xd2\xb9\x79\xc6\xd3\x5d\xcf\x82\xbd\xd0\x1a\xfe\x55\x10\xa7\xb8\x91\xc6\x43\x54\x65\x6f\x20\xd9\xda\x7a\x35\x38\x9e\xbe\xa3\x12\x37\x08\x4a\x05\x21\xbe\x5f\x00\x30\x56\xc1\x44\xbf\xc1\x5a\xe0\x8d\x9d\xbf\x32\x01\xe1\xf3\xf8\x4e\xcd\x83\x30\xba\xba\x98\xd0\xb8\x30\x7b\x02\x8d\x36\xad\x76\x6e\xc7\x19\x99\xa0\x19\x4e\x18\x63\xe6\x77\xd5\xe7\x76\x74\xad\xe0\x21\xae\x9f\xa9\xa2\x74\x93\x14\x5f\x54\xe1\x39\x08\x55\xc5\x90\x49\xba\x22\x54\x7f\xe4\xf6\xa3\x5d\x90\x07\x17\x28\xc5\xcf\x50\x8c\xd0\x4e\x0b\x76\xa9\x74\xa1\xca\x14\xaa\x00\x9c\x21\x2f\x00\x03\xf8\x82\xe1\x02\xd8\xf9\x82\x1a\x46\x03\xdc\x95\x6d\x69\x9a\x7f\x96\x4d\xcf\xce\x8c\x05\x28\x65\xd5\x3c\x87\xc1\x33\x55\x9c\xdf\xf1\x18\x04\x00\x4d\xf0\x10\x46\xb8\xbd\x1d\x85\x9a\x47\xab\x51\x42\x4d\xbc\x61\x31\x99\x18\xe3\xe3\xda\x04\x05\x86\x65\x94\x92\x70\x4f\x0b\x85\x35\xeb\x59\x58\xb0\x35\x88\x5d\x92\x41\x76\xfa\x21\xa9\x36\xe6\xd6\xd0\xb9\xcb\xb1\x20\xe9\x5a\x33\x96\x50\x6b\x94\x38\xeb\x9b\x6f\x89\xfd\xf6\x0f\x84\xeb\x94\x84\xc4\x85\x52\xf5\xd1\x20\x60\x9e\xbe\x5e\xf6\x31\xd9\xad\x8a\x5d\xc2\x04\xd7\x13\x36\xab\x28\x40\x79\x6b\x17\x40\x37\xbe\xe8\x05\x5b\x9a\xea\x66\xe3\xcd\xcc\x4e\x8c\xdd\x8e\x54\x3b\xc4\xc3\x85\xcc\x89\x7c\x99\xe5\x60\x35\x47\xad\x66\x9c\x32\x1e\xfd\xe7\x9e\x37\x3e\x73\x68\x67\xcc\x04\x6c\xb5\x0a\x35\xca\x16\xbc\x4b\xac\xf5\x59\x49\xe8\x78\x1c\xca\xb0\xa4\xc2\xb5\x44\x8e\xc7\x71\x35\x99\x24\x61\xa9\x7a\x22\x93\x49\x1e\xa6\x54\xe8\xc6\x09\x51\x44\x39\x73\xce\xe7\x17\x17\x70\x59\x1d\xb0\x39\xff\xa8\x24\xa8\xc9\x24\x28\xd5\x8f\xee\x87\x28\xab\x78\x91\x54\xb2\x98\xf5\x09\xe3\xc8\x94\xe4\x75\x3c\xf0\x91\x4f\x26\x37\x74\xd7\xd6\x68\x33\xe6\xde\x8f\xed\xef\x66\x23\xcf\xec\xd8\x62\xd7\xe1\xbd\x28\xaa\xf1\xac\xa8\x1a\x17\xae\xe3\xd1\x7b\xb0\x12\x84\x65\x88\x4c\x6a\xdf\x53\x85\x4d\x68\x83\x52\x86\x3b\xbd\xde\xf1\xd8\x7e\x2e\x81\xf5\x8e\x7d\x29\x2c\xed\x01\x61\x35\xb1\x14\x61\x0e\xbf\x39\x49\x26\x13\x79\x32\x5e\xce\x8c\x05\x67\x8d\x30\x42\x03\x58\xac\x2e\xd5\x69\xcb\x6e\x46\xb6\x72\xcd\x01\x15\x25\x9c\x4a\xb8\xe1\x7d\xcc\xe9\xdf\x1c\xd5\x22\x7c\xfc\xbb\x24\x08\xb0\xf5\x60\xed\x70\x5e\x69\x65\x9f\x96\x4d\x0c\x3c\xe7\x26\xb2\xc6\xfb\xa2\x58\xf4\xb1\x52\xb3\x3d\xeb\x97\x5f\x18\x16\x62\xdc\xc8\x81\xb4\x64\x07\xcf\x6f\xcd\xb8\xab\xe5\xa1\x40\xcc\x2b\x4d\x59\xb7\xa9\xd0\x9b\xb8\x3d\x4b\x2b\x36\xe6\x4e\xc0\x5d\x01\x48\x15\xa1\x89\xe9\x54\x2d\x7a\x98\xb1\x39\xa7\xb8\xb5\x54\xd3\xf1\xda\x31\x7d\xa6\xd6\x1e\x6b\x05\x0f\x93\xf4\xe4\x5a\x49\xbd\x56\x7e\x37\x1c\x3e\xa0\x12\x84\x0c\xb7\xe0\x75\x96\x0d\x1c\x9e\x71\x8a\xe3\x5a\x6a\x54\x33\x20\x99\x59\x73\xff\x9d\xf3\xf0\x50\xd3\x6d\x82\x47\x2c\x79\x39\x01\x22\x70\x90\x6b\xd6\xa9\xd3\x87\x50\xc8\x69\x1f\x40\xf3\x73\x50\x45\x8b\xab\x6a\xfe\x6b\x32\x99\xac\x4f\xce\xdf\x8c\xdb\x6f\x85\xeb\x56\x68\x66\x70\xb7\x8b\x27\xc7\x63\x0f\x13\xd4\x84\x76\x1e\x13\x06\xf6\xda\xf7\xf5\xc5\x72\x8d\xb2\xd0\x5c\x8b\x9b\xb3\xa2\xc7\xdc\xb9\x21\x19\xdd\x46\x33\x8b\x14\xb3\x18\x62\xe2\x41\x3f\x0f\x52\xf1\xeb\x66\x61\xdc\x47\x47\x3c\x8e\x47\x61\xb7\xf3\x1c\x05\xdd\xd1\xd4\x66\xb2\x04\x99\x89\x18\xac\xc4\x34\x03\x1f\x6f\x66\x5e\x84\xbd\x1b\xdc\xc8\xc1\x40\x0c\xe8\x56\xd3\x25\xb3\x5b\x2a\xa1\xb8\x20\x7a\x32\xd9\xb5\xa5\x86\xa5\x05\x62\x48\x06\xce\xfc\x93\x02\x65\x4b\x8c\x12\x93\x09\x7c\xfb\xa7\x83\xec\xa1\xba\x18\x76\x86\xff\x35\x9f\x2d\x49\x8c\x07\xa6\x6f\x96\x9d\xcd\xf1\x40\xe5\x22\x96\x94\xd7\x94\x7b\x83\x68\x1f\x93\xfa\x2a\xbb\xc1\x03\x31\xa1\x19\x35\x2e\x56\x7b\xc6\x6f\x65\x35\x60\xf2\x76\xc7\x26\x98\x01\x9a\xf9\x3c\x87\x50\x3c\x47\x55\x5c\xab\x06\x57\x96\xef\x58\x6b\xbe\x43\x90\xe9\x6a\x16\x0a\xb6\x1a\x60\x3a\xda\x8c\xc5\xaa\xc5\x74\x64\x1e\xd3\x91\xd1\x55\x87\xe9\x10\x8a\xe9\xa8\x53\x78\xa2\x03\xf7\xc1\xea\x42\xa8\x56\x5c\x88\xc1\x8f\x1d\x3b\x18\xf7\x5a\xa0\x93\xdb\x8e\x09\xb6\x81\x85\xbc\xc2\x62\x4d\x06\x00\x94\x0e\x5e\x00\xdd\xd1\xf2\xd4\xb2\xb6\xed\x85\xaa\x8c\xa7\x92\x98\x86\x82\xed\x0c\xbd\x27\x6a\xd1\x0c\xca\x08\x80\x1f\x95\x91\x27\xce\x96\xb0\x77\xf4\x4c\x53\x8d\x77\x3b\x52\x83\xed\x31\xb2\xc5\x98\x89\xe3\xb1\xb5\x3b\x75\x27\xcb\x66\x2f\x2a\x5a\x5f\xcc\xd2\xb0\x20\xb1\x9c\x95\xa1\xa4\x55\x33\x72\x85\x85\xd5\x2c\x85\x67\xb8\x98\x85\xd6\xad\x3e\xda\x8b\x72\x93\xad\x21\xec\x53\xd3\xaf\x20\x24\x4e\xe1\x9a\x0d\x54\xcf\xcc\xef\x8d\x41\x7f\x40\x63\x49\xa6\x9b\xc9\x64\xd9\x46\xff\x8d\x99\x50\xea\xa3\x7f\xa9\xd1\xbf\x41\x70\x27\x5a\x9b\x4d\xfe\xf9\xc7\x93\x89\x13\x1b\xdc\xc7\xf9\xc7\x8b\x99\xff\x80\xab\xaf\x92\x7e\xdd\x27\x83\x75\x9f\xf8\x75\x9f\x2c\xe2\xf9\x62\xea\x44\x95\xc2\x31\x71\x0d\xe6\xb6\x50\xba\x62\x3b\x6c\x8f\x8a\x1e\xec\xe7\x18\xec\x5d\x53\xba\x86\x45\xdd\xab\xed\xa2\x0c\x14\xed\xb2\xc2\x42\x03\x76\x41\x73\x5a\x82\x94\x68\x19\xc6\xf8\x29\xbb\xcf\x6a\x63\xf2\xe3\xb1\x9a\x29\x50\x91\x38\x77\x1a\x1a\xb0\x87\xa5\x0b\x32\x04\x23\xb0\xd7\x8c\x00\x5d\x1b\x76\x71\x65\x23\x94\x10\x1c\x88\x38\xa6\xf7\x3e\x52\xe9\x5e\xdc\x8b\x1d\x50\x63\x7c\xd2\x24\x69\x66\xe4\xd4\x50\xfa\xba\xf0\x21\x65\xf0\x9d\x86\x5b\x51\x0e\xb9\x09\x62\x06\xab\xb4\xac\x41\x05\x89\xbd\x58\x33\xc3\x7b\x15\xb7\x8c\xde\x46\xd4\x9e\x13\x15\xab\x22\xc2\x02\x9c\x85\x8a\x45\xcb\xd5\x73\x02\xf5\xb5\x0a\x9f\xa5\xe5\xfb\xbf\x56\x34\x65\x79\x2f\x9e\xcb\x0b\xdf\x35\x0e\x0c\x6e\xe0\xee\x80\x91\xbd\x18\xad\x2c\x4a\x68\xd9\xbd\x4f\x5b\x8a\xfc\xfa\x0b\x75\x15\xf6\x4b\xa1\x9a\x55\xd9\x5d\x5c\x1c\x43\x7a\x0f\x5c\x71\x5d\xb7\x8c\xeb\xf6\x9e\xeb\xe3\x31\x70\x6c\x7f\xd0\xe3\xcd\x8d\xef\x16\x34\xb8\x70\xd0\x7b\xc5\xd7\x54\xb2\x7d\x6f\xe2\xbf\x78\x11\xc8\xc6\x62\x3f\x37\x68\xb8\x03\x1a\x06\x8a\x9c\x16\x01\x59\xb4\xed\xe7\x36\xce\x63\x19\x2d\x7d\x4b\x75\xeb\xf5\x15\xaf\x7e\x29\x92\xdd\x8e\xaf\x5e\x18\x4d\x7f\xdf\x44\x3b\x12\xc7\x63\x6e\xe2\x9a\x14\x14\xdf\xb6\x6b\x9c\x0a\xa4\xd1\x4a\x3d\x6a\xe2\x8f\xcc\xdd\x59\xaa\xef\xe3\xd1\xf7\xed\x32\x2f\x15\xfb\xef\xa4\xf5\xb2\xb7\x92\xdc\x5b\x42\xab\x97\x2e\x9a\x20\x9c\x22\xb2\x8c\x2e\x05\xe7\x67\x24\x9d\x8c\x6d\xba\x8b\x6f\xa6\x5f\xd8\xe3\x57\x36\xc1\x2f\x89\x0d\x7e\xc9\x1b\x2e\x1f\xc2\x77\x16\x15\x7c\xed\x31\x9e\xe4\x50\x75\x81\xc0\x38\x42\x6c\x06\x06\x9d\x91\xba\x69\x4c\xfa\x48\xc2\xbc\xd8\xf2\x30\x38\x03\x32\x9d\x05\x24\xa0\x32\x32\x4b\xe2\x50\x87\x71\xba\x0a\x49\x28\xc1\x5a\xb5\xf5\xa9\x17\x9d\x0d\x7e\xae\x62\x50\x23\xa1\x93\x61\x2a\x02\xb0\x57\x04\x20\x45\x14\xaa\x08\x9f\x3e\x06\xed\xc6\x8b\xb5\x89\x3e\x7d\xa4\xa3\x4f\x1f\x3d\x46\xf8\xe9\x7b\x4f\x19\xee\x44\x07\x0f\x3d\xe7\x12\x93\x43\xaa\xf5\xec\xbb\xaf\x79\x37\x99\x63\x65\xf0\xd8\xf1\xf6\xc0\x2b\xb3\xf9\xc6\xe7\xde\x6e\x1a\x9f\x53\x50\x38\x10\x10\x2a\xd9\x41\x98\xda\xb9\xb9\x9f\x5d\x15\xd5\x44\x10\xaf\x41\xf8\x78\x81\x5f\x0e\x48\xfa\x21\xab\xae\x55\x13\xc9\x90\x1a\xc6\x00\xed\x8a\x57\x1e\xa8\xb4\x22\xa4\xf4\x5c\x19\xba\x90\x01\xef\x59\x2a\x45\x96\x97\x06\x4c\x6f\xcd\x9c\x0d\xb6\x89\xb9\x23\x0a\x6b\x0a\x23\x68\x8e\xd8\x35\xcd\xec\xde\x34\x00\x24\x42\x6a\x3c\xf1\x32\x76\x3e\xcd\x3e\xb3\x72\xd4\xf4\xec\x2c\x83\xbe\x76\x1c\x16\xf3\x7c\x9e\x2d\x16\xc7\xa3\xb4\x3f\x94\xbc\xa1\x7e\x13\x02\x46\x9d\xeb\x0f\xd0\x36\xe2\x6f\xc3\x47\xd7\xf7\x5c\x7d\x85\xf4\xad\x53\xf0\x31\x36\x49\x68\x76\x89\x65\x5b\x2e\x48\x27\x6e\xfb\xb1\x0e\xdc\x56\x71\xd9\x7b\x26\x87\xcf\x9f\x26\xcb\x82\xf5\xe0\x39\x78\x81\x95\x5f\x17\x72\xab\x7c\x3a\xcd\xf6\x6f\x28\xa2\xa2\xf7\xc8\x63\x6c\xc2\xd2\x55\x71\xd6\xd0\x8b\xc1\xea\xd0\x95\xd7\x2d\xd2\x5d\xc9\x38\x31\xb9\xb6\x3d\x1f\x09\xaa\xd4\x7a\x71\x62\xc3\xaf\x31\x34\xde\x3c\x1a\xab\x63\x9c\x46\x69\x13\x99\x9d\x46\xe9\x10\xdd\xce\x34\x9d\xb7\x27\xe1\xfe\x4f\x85\xf8\x27\x77\x01\x38\x00\xf3\xc7\x40\xdb\x9c\x99\xa5\xe2\x96\xbb\x90\x05\x27\xa7\x21\x64\x89\x6e\x9c\x46\x49\xf3\x54\xc6\x69\xb4\xa4\xbe\x1a\x2f\x4e\x74\x48\x7a\x4b\xb5\x67\x5e\xfe\x7f\x04\xed\x3d\x81\x7a\x1f\x78\x22\xd7\x48\x0f\x46\x16\xff\xde\x1b\x40\x9d\xe4\xdc\xff\xec\x84\x10\xc3\xec\x1c\x77\xd1\xe4\xb2\x09\x17\xce\xfd\x30\x62\x24\xcf\xe6\x74\x1e\x98\x72\x81\x49\x5a\x52\x06\x4d\xae\x93\x60\x41\xa6\xe0\xba\x8e\x47\x03\x0c\x2a\x20\x83\xfb\x18\xbc\xb3\x69\x4c\xcc\x4b\x25\x04\x0a\x2b\x12\xee\xc1\x18\x4a\x42\xa6\x39\xcb\x67\xc2\x10\x19\x1b\xa6\x1e\xe6\x24\x16\x03\xe1\xeb\x21\xa1\x4b\x1d\x49\x1f\xe6\x3e\xcc\x95\x70\x8f\xce\xac\x2b\x0e\x58\x35\xf3\x2a\xb3\xfd\xaf\xd1\xa3\xa0\x4b\x2a\xd1\xe9\x0a\x5c\x97\x74\xcc\x56\xd6\x4e\xdc\x70\xe8\x45\x96\xc7\x4b\x6a\x8b\x42\xf3\x50\x01\xee\x93\x49\x6f\xe0\x05\x4d\xa2\x57\xcf\x7f\xf8\xf6\x8b\x67\xcf\x09\xae\xf4\x6e\xb4\xd5\x17\x4f\x6f\xd9\x17\x8f\x9f\x68\x6a\xf3\xd8\x48\xb3\x9f\x68\x86\x08\xe9\x36\x56\xef\x3f\xdf\x73\x6f\x0d\x3c\x39\x94\x6f\x33\xa5\x89\x8a\x94\xe3\xdc\xd7\x2a\xe1\x0c\x39\xa4\x49\x89\xe4\xf2\x2b\xfe\x2e\x88\x4d\xeb\xae\x4d\xa3\x2e\x31\xaf\xa1\x90\x81\xe6\x6a\x36\x4f\xf4\x8d\xe9\xc1\x3c\xa0\x05\x0d\x16\xc1\x22\xfa\x0f\x99\x89\x30\x08\x48\xec\x7f\x43\x22\x43\x4e\x68\xb0\x60\xf8\x2d\x28\x27\x4d\xc1\x7a\xaa\x3a\x5e\x16\x49\xfa\x9a\x57\x83\x5d\xf7\x3b\xd6\x6d\x7b\x7d\x0c\xb7\x6c\x18\xb9\xdf\xd7\xe6\x50\x8b\x9e\x5f\xa2\x74\x8e\x5d\xd3\xfb\xc3\xd3\x78\x80\xc0\xbc\xc4\x1e\xfe\x3a\x0f\x7f\x5d\xfd\x8d\xfc\xba\xf8\x1f\x0f\x23\xfe\x8e\x43\x87\x47\x39\xe3\x4d\x80\xf8\xaf\xf3\x5f\x57\x7f\xc3\x67\x1a\x04\x84\x8e\xad\x15\x5f\x1b\x21\x0a\xa8\x08\x04\xf1\xd4\x49\x73\xbe\x80\xc9\x15\xef\x0f\x35\xa1\x28\x30\xaf\xe6\x17\x8b\x05\x13\xb7\x01\xdb\x8c\xcb\xbc\xae\xd8\xc3\xf0\xd7\xf9\xaf\x0b\x72\xc3\xc0\xec\xa8\xaa\x59\x6b\x00\xb3\x66\x6c\x50\x6b\xc7\xde\xa3\x53\x94\xe2\x2b\xec\xea\xde\x47\x71\xc3\xa2\x19\xbc\xf6\x8d\xfc\x50\x8f\xb4\x80\x81\x17\xac\x22\x53\xd1\xee\x09\x8f\xb4\x6a\x2d\x5f\xd2\x52\x3f\x57\x11\x17\xa9\x5c\xf1\x99\xce\x72\x96\x56\x33\x18\x0b\x62\xfd\xf2\xe7\x57\x2f\xdc\xe9\xa4\xde\x36\xad\xe4\x9e\x98\xdf\xd1\x8b\x93\x19\x8f\x4a\x59\x54\x21\x89\x7b\x81\x43\x7c\x96\x5b\xce\x19\x91\x6c\xe0\xe6\x74\x59\xdb\x70\x6b\x70\xdf\x2b\x47\xd6\x90\x93\x07\xe6\x17\x74\xff\xed\xe4\x76\x4d\x61\xe8\xdb\x6b\x8c\xd1\x65\x1e\xfb\x9f\xfa\xf4\xbf\xf8\x18\x8c\x1b\x7f\x57\x15\x49\x5a\xb5\x24\x56\x5b\x33\x2a\x77\x79\x56\x85\xc1\x2c\x20\xf3\x8b\x05\xcc\xf9\x35\x42\x86\x92\xa2\xf4\xdd\x65\x30\xb0\x8a\x95\xe1\xc1\x43\xf6\x38\x10\x52\xf0\x00\x46\x42\xe3\x93\x2b\x91\xb1\xa1\xe8\xa4\xfa\xf5\xa3\x6c\x7a\xf2\x07\x9f\x15\x71\x08\xb4\xaf\x8a\x6c\x1b\x92\x06\xc9\xfe\x4f\xf8\xeb\xec\xf8\x97\xe3\x84\x28\x44\x23\xb3\xd0\x0d\x74\x12\x0c\x6b\xd8\x6c\x38\x5b\x83\xa7\x67\x0f\xaf\x68\x30\x0a\x88\xad\xc9\x02\xf0\x4e\x55\xa4\xb5\xcc\xd0\x88\x59\x17\x87\xcf\xcf\x67\x95\xd9\xef\x2c\x20\xb1\x39\xd5\xac\xcb\x29\x63\x2c\x51\xa6\xfc\x78\xc5\x7b\xa8\xa1\x4e\x97\x81\xf7\xb0\xe5\xc1\xf0\x68\xb5\xb2\x7a\xcd\x0b\xb3\xe4\x64\xc8\xf8\x62\x0f\x8e\x02\x07\x86\x59\x9f\x2f\xa5\xcc\x79\x02\x07\xd6\x81\xb0\x53\xd1\x0b\x45\x13\xda\x2a\xc3\xf2\x50\x18\x93\x8c\x80\x09\x66\x60\x45\xe0\x16\x81\x95\xd6\x2b\x92\xad\xaf\x87\x56\x5b\x6f\x05\x88\xa4\x28\x96\x56\xf8\x75\x23\x06\x14\x61\xe3\x39\xcb\x67\x6d\x6c\xb7\x33\x6f\xe1\xb0\x53\xc7\x43\xef\xd7\xca\xf1\x21\xcd\x1e\x0f\x82\x26\xd8\xd2\xbd\x1c\xe1\x70\xa9\x48\xdf\xa1\x49\x1a\x83\x47\x8e\xeb\xf5\x4d\x59\x19\x95\x79\x96\xf2\xf0\x04\xde\x58\xbd\x3d\x9f\x4c\x8c\xdf\x2d\xec\x17\x9c\x5a\xa9\x15\x69\x68\x68\x6e\xd0\x63\x12\xb8\x53\x58\x0f\xe1\x2c\x60\xc1\x59\x02\x03\x07\xf6\xe7\x49\x03\xe3\xc8\xea\xe3\x3f\x3f\xaf\x49\xd3\x56\x1c\x04\x77\x77\xf3\x1e\x6a\x75\x88\x56\x35\x5b\x60\x3e\xfe\x6b\x48\xfe\xb6\xf0\x33\x8d\xb8\xba\xc1\x47\xc1\x19\x8c\x38\x49\xf1\x4c\xae\xb8\xf2\x3d\x6a\x12\xfe\x5e\x7c\x42\xa2\x4a\xfe\xbc\xdb\xf1\xe2\x59\x02\x6f\xe7\x9a\xdc\x36\xce\x61\x2d\xe8\x8d\xb9\xda\x2b\xef\x13\x33\x4e\xd9\xf2\xfd\x67\xbe\x94\xdc\xf5\x44\xf1\x97\x85\xcf\x5d\x16\x96\xb7\xbc\x78\xa4\x79\xcb\xa7\x26\x35\xf6\x93\x76\x6e\xec\xa7\x50\xb5\xf9\xd3\xb2\x2d\xdf\x88\x3e\x10\x79\x79\x7d\x82\x70\xd8\x52\x73\xb5\xdb\x16\x0c\xfb\x3a\xa9\x38\xf6\xfe\x01\x46\x9c\x25\xeb\x65\x35\xea\x42\xef\xf3\xf3\x41\x1b\xd6\xb9\x6f\xc3\x3a\x5f\xc4\x87\x7a\xda\xde\x71\x9c\xcc\x38\x3b\x70\x81\xfb\x38\xca\x98\xd7\xb1\x25\xf2\xcd\xc1\x07\x26\xb6\x29\xa2\x9c\xff\x4d\xd2\xad\xde\xa8\x36\xf3\xed\x82\x56\x2c\x3c\xa7\xa9\x21\x57\x30\xc5\x12\x70\x47\xc2\x90\x63\x5a\x98\x1f\x53\x8e\xc9\xc2\x60\xc2\xd4\x2f\x5a\x30\x18\xd5\xad\xd7\xb0\x6a\x43\x1d\x64\xba\x09\x47\x99\xc2\x73\x5a\x76\x24\x09\x12\x4a\x48\x28\x09\x3d\x28\xb8\xc5\x45\xad\xf3\x05\x8c\xce\xe1\xa1\x4c\x45\x6b\xb9\xd0\x7c\xc5\xb6\x67\xdc\x12\x98\xea\x73\x76\x3e\x99\x54\x9f\x6d\x0c\x28\x6b\x5a\xb4\x2a\x64\x6b\x24\x13\xc0\x4f\x72\xd8\x9e\x99\x10\x96\x82\x55\x21\x99\xee\xbd\xac\x5a\x3f\x49\x3d\x8a\x82\x1e\x12\x55\x37\x5e\x45\x3f\xbc\xfc\xa1\x56\xba\x09\xcf\x67\x98\xab\x56\x2e\xe8\xd6\x75\x38\x99\x6c\xd4\x21\x97\x72\xdc\xb4\xb4\xb1\xce\xaf\xf4\x4d\xa8\x01\x63\xb0\x87\xd4\x9e\xb3\x2d\x90\x0b\xd0\x66\x7c\xa0\xd8\x1e\x2b\xe0\x92\xc0\x1a\xe8\x70\x7a\xe8\x0b\x89\xf0\xb4\xd9\x97\x1b\xf7\x98\x50\x43\x7d\xdc\x9b\x9c\x5e\x49\x00\x14\x8a\x65\x8e\xac\x25\x91\xc1\x04\xba\x65\xcb\x48\xa5\x69\x12\xd5\xb4\x87\x35\x9b\xd9\x86\xcd\x37\x8b\xb8\x8d\x6d\x1b\x72\x3c\x86\x1b\x36\x0f\x1e\x06\x0b\x68\xa8\x37\xed\xe3\xc4\x6d\x98\xc1\x35\x46\x3c\x85\x72\x09\x60\x6c\x3b\xdb\x32\x0b\xbd\x07\x17\xf1\x56\xad\x60\x03\xd0\xe3\x31\x3c\xa7\x59\x33\xff\xf1\x85\x4d\xbe\xb9\x0b\x37\xb8\xcb\x6a\x70\xfb\x5d\x81\x01\xad\x10\xa7\xd9\x1f\x90\xfa\xe8\xcc\x87\x0a\x9e\x7b\x7a\x48\x13\xf1\x77\x19\x8b\x9a\xd4\x53\x17\x40\xcd\x96\xb7\x50\xda\x2e\xd9\xac\xa2\x5c\xca\xdd\x17\xe5\xb5\x48\x5b\xe3\xa2\xc6\xcb\xa0\x60\xe7\x54\xb2\xf1\x85\x76\x2e\xca\xf1\x9f\xe7\x33\xe5\x6a\x34\xe4\xb5\x4f\x1c\x68\x65\xe2\x2a\x54\xfc\xca\xf9\xb4\xf8\x8c\x4f\x8b\xb3\x33\x52\x41\x36\x6d\xe8\x83\x3e\xc2\x25\xae\x28\xe8\x3a\x4d\x81\x3f\x6f\x9b\xe9\x2b\x52\x4f\xc7\xb6\xf7\x51\x1a\x2a\x0d\xf5\x58\x2a\x55\xf3\xf8\x9c\x8e\x13\xe3\x18\x9c\x60\x8e\x78\x5f\x7c\x86\x23\x7a\x4a\xd4\x0c\xaa\xb0\x38\x3b\xa3\x29\xd2\xe7\x20\xcd\x0e\x5e\x39\xe6\x40\xcd\xad\xd3\x5b\x46\xa6\xc5\xe7\x0c\x0d\x20\x51\x0c\x46\x28\x42\x6c\xac\x90\xd4\x77\xd4\xbc\x75\x8d\xa9\x48\xd4\x99\xff\xeb\x64\xe6\x54\x2a\x83\x7b\x2f\xb6\xce\x03\xc1\xd5\x35\x76\x70\xed\xf6\xc6\x28\x16\x26\xd1\x71\x75\x62\x0f\x8e\x6c\x8c\x7f\x2b\xd9\x6f\xc3\x25\x19\xde\x16\x67\xe6\xdd\x9a\x70\x4c\xce\x40\x1b\x69\xff\x2c\xf9\x9d\x27\x5c\x61\xe8\x86\x51\x7b\xda\x2d\x3b\xa8\x47\x28\xcd\x71\xfc\x2a\xbb\xda\x9c\x10\xe4\xa0\xbb\xc2\x19\x82\xfb\x33\x04\xf1\x22\x8a\x9a\xd2\xae\x6c\x36\xdc\x5c\x4b\x73\xa1\x94\x20\x35\xed\x9b\xb6\x93\x28\xa1\xb9\xa1\xd3\xad\x2f\xf0\xb2\x6f\x97\x55\x17\xf9\xd4\x77\x45\xfc\x4f\xbb\x3a\xe7\x8f\xcf\x21\x55\x26\x86\x53\xd3\xf1\x3f\x32\x4a\xee\x93\xaa\xd7\x1e\x8a\x3e\x77\x68\xb7\x2f\x37\xcc\xc1\xa1\xb6\x9c\xff\x98\xf1\x26\x04\x1d\xfb\x81\xdf\x6d\x3f\x00\xf2\x50\xfb\x03\x71\xb9\x9f\x2e\xa6\x21\xb6\x9c\x56\x27\x92\xe6\xdd\x9b\x75\x4d\xd8\x7b\x4f\x05\x60\x1d\x0e\x2f\xc0\xac\x4a\xcb\xac\x3e\x32\x16\x82\x47\x8a\x5b\x2d\xac\x65\xf8\xd3\x27\x50\x84\x16\xd6\x2e\x7c\xf1\xa9\xb1\x0b\xab\xeb\x1d\x65\xe7\xe6\xf2\xff\xc7\x1d\x77\xa8\xa7\x65\x94\x26\xe2\xe7\x92\x7f\xf5\xf2\xbb\x53\x67\x2f\x84\xfb\xb5\x2c\x52\xfe\x8a\xaf\x0b\x5e\x6e\x8e\xc7\x71\x78\x4e\x77\x91\x89\x6f\x2e\x4d\x22\x69\x12\x82\x55\xac\x66\xab\x78\xaf\x72\xb6\x5e\xf1\xea\xe7\x52\x51\x9f\x75\x06\xb5\x05\x54\xee\x92\x0d\xe9\xbb\x01\x9c\xc8\x67\x66\x00\x17\x2b\x4c\xb9\x77\x6b\xd4\x95\x74\xc9\xda\xb7\x77\x27\xe1\x61\xa0\xa7\xb8\x38\xc5\x36\xc9\x36\xdb\x94\xf7\xd8\xa6\x14\x6c\xd3\x1a\x6c\xd3\x96\x9d\xd3\x2b\x7b\x60\x77\x59\x8f\x0b\xc6\xce\xce\xb6\x93\x49\x78\xc5\xf6\x60\xdf\x8a\xea\x5b\x95\x94\x95\x17\xe1\xb2\xc5\x5b\x1a\xbe\x18\xa0\x59\x9a\xc4\xad\x5f\xf2\xb5\x2c\x38\xb4\x5b\xf6\x8d\x17\xc3\xe6\x2d\xae\x08\x09\x3d\x67\xec\xc1\x83\xed\x64\x72\xe5\x07\x45\x2a\x07\xce\x25\x3d\xf8\xcd\xc5\x03\x74\xed\x0d\xfc\x86\xcf\xc1\x7e\xaa\x82\x27\x8b\x28\x4b\x63\x3d\xbd\xfb\xad\xc1\x9d\x6d\x48\xab\xa8\x03\x46\x56\xb5\x56\x94\x0d\xa5\xe9\xc5\x4b\xe8\x85\x06\xd6\xcf\x40\xdd\x91\xc2\x47\x4f\xc9\xe9\xfb\x0d\x06\xea\x07\xf4\xd0\x5c\xe6\x04\xed\x49\xe7\x7a\x66\x33\xf9\x62\xa8\xef\xba\xd1\x1d\x0d\xf4\x25\xef\xde\xb4\xac\x8d\xbc\x25\x8d\x6c\x9a\x28\xd9\x74\x3a\x08\x8b\x5e\x0b\xe1\x39\x95\xe6\xf4\xb0\x85\x48\xf8\x36\x13\x2b\xf9\xd6\xd9\xb0\x10\x14\xd7\x86\xf3\xc0\x0a\x77\xea\x44\x1b\x38\xd1\x84\xe7\x34\xe9\x0a\x7a\xe3\x8b\x7a\x60\x21\xef\xd0\xa2\xa9\x12\x0e\x34\x4a\xe8\xf8\xe2\xce\xe7\xdd\xd3\x3f\xe3\xbc\xfb\xdd\xc7\xca\x7f\x07\x8d\x88\x3d\x64\x70\x8c\x40\x25\xf2\xe9\xa7\x38\x65\xfc\x89\xbc\x47\xec\x80\x55\xd4\x3c\x7a\xe2\x5d\x62\x36\xa0\x40\x53\x7e\xe8\xbc\xf1\x43\x9f\x69\x0f\x5d\x5e\xd3\x0d\x3b\xe0\x6a\x84\x65\x22\xae\x62\xa3\x44\x05\x76\xc7\x03\x8d\x8c\x07\x1a\x19\x07\x67\xbc\xa6\x2b\x7e\xbf\x7a\x51\xb9\x5f\x96\x46\x7d\x07\x5b\x40\x4d\x85\x2c\x91\x3b\xef\xb6\x21\x3c\xbc\xb5\x29\x7f\x2c\xcb\x9a\xf6\x5b\x5d\xb6\x4b\xd4\xff\x25\xcc\x81\x72\x7b\xf9\x5f\xfc\x5a\x25\xe6\x06\xfc\xe1\x25\x33\xed\xd9\x1c\x94\xdb\x0d\x0b\x2e\x5f\x07\x56\x19\x00\x9f\x3e\xc1\x02\x35\x2d\xbc\x04\x87\xb9\x39\x1e\xbd\x77\x86\x36\x6c\xe0\x39\x9d\xb0\xf5\x10\xb9\xf6\x55\x2b\x16\xb4\xa3\xf5\x00\x89\x55\x6a\xe3\x9a\xee\x59\x7f\x29\x46\xeb\x16\x15\x85\xd5\x5b\x95\x5d\x0d\x97\xed\x90\x48\x57\x5c\xf1\x26\xee\xce\x2b\xa5\xbd\x19\x18\x70\x9c\x9c\xe2\x4d\xf2\x36\x6f\xb2\xef\xf1\x26\x2b\xc5\x9b\xe0\x64\xb9\x91\x3d\x11\x2d\xf6\x64\x7d\x23\x7b\xa2\x86\xee\x8e\xa9\x7b\xb3\x28\xb2\xcb\xa2\x0c\x2b\x61\x1c\x23\x72\x79\xf2\xeb\x05\xa9\xa7\x4a\x75\x68\xf9\xca\xbf\xab\x04\x9b\x72\x5f\xbd\xe2\xb9\x4c\x56\x3f\x97\x99\xb8\xfa\x26\x29\x37\x24\x74\x03\x91\x43\x7c\xd0\xb5\xe5\x76\x2e\x15\xb4\xbc\xee\x96\xd1\x95\x0c\x9b\xab\x0d\xbe\x29\xf8\x7a\x68\x5f\xfe\x25\x38\x2b\x8c\x89\x12\x5b\x37\x5c\x9a\x33\x0d\xe5\x71\xa4\xfd\xf9\xbc\x52\x6b\xc1\x3e\xf0\x4e\x4f\xc0\x3a\x79\x52\x3e\x39\x74\x8e\xac\x1a\x97\xc0\x28\x1e\x0b\xaa\x21\x7d\xdc\xc1\x1c\xfb\xd8\x98\x63\x9f\x0e\xea\x29\x86\x18\x23\x75\xd3\x80\x75\x50\x0a\xfe\xe2\xae\x83\x55\xe9\x64\xaa\x59\x10\xc4\x3e\x91\xae\xce\x2e\x4e\x51\x94\x5e\xe3\x49\xb9\x61\xbc\xee\x9c\xbe\x98\x61\x35\x38\x10\xe7\x25\xa5\x06\xd1\x2d\x61\x30\xa8\xcb\x0f\x2a\xde\xce\x58\xdd\xce\x29\xd4\xe3\xb3\x2a\x3e\x27\x67\xc1\x5f\x82\x33\xe0\xfd\x6a\x18\x97\x86\x8c\xa1\x3c\x6a\x4e\x96\x30\x55\x59\x24\x41\xdd\x54\xba\xcd\xff\x0d\x82\xaf\x43\xb5\x55\xd8\x15\x3c\x00\x51\x0e\x8e\x47\x15\x78\x38\x83\x81\x88\xb5\x11\xba\x16\x0e\x83\xdd\xad\xf5\x94\x00\x5a\xf0\x64\xa5\x52\x97\x91\xb0\x30\xc2\x53\xae\x1b\xf1\x8c\x0a\xc2\xd1\x80\xdc\xe4\xe7\x94\xd4\x63\x3f\xed\xd4\x48\x98\x5b\x7b\x02\x82\x51\x77\x66\x64\x90\x22\xbb\xbb\x6c\x58\x83\xeb\xde\xda\x17\x69\x88\x09\x5a\x17\x06\x8c\x3e\xd4\xf1\xf3\xc5\x98\x31\x49\xe0\x90\xd5\xe4\x15\x4f\x18\xae\xa8\x12\xea\xfb\x6e\x32\x49\xa0\xed\x47\xb4\xd7\x6b\x7e\x0d\xe3\x36\x1e\x0d\xb7\x35\xdd\xb1\x84\xf2\x30\x81\x56\x54\xb2\xd4\x58\xc9\xbd\x4e\xa4\x9b\xb0\xc4\xb1\x3d\x99\xac\xc3\x84\x60\xca\x39\x12\xe4\x3c\x7f\xc3\x85\x9b\x8a\x15\x13\x68\x80\xc3\x38\x55\x79\x41\x02\xe4\xda\x19\x92\x31\x72\x93\xa3\xe3\x4e\x2d\xf4\x38\xf9\x26\xac\xcb\xd8\x30\x68\x62\x6c\x42\x79\x7b\xf8\x9e\x5d\xc0\x89\x06\xc6\x8b\x06\x70\x53\xca\x67\x55\x26\x59\xad\xba\x68\xf2\x93\xd4\x55\x72\xc4\x27\xaa\x39\x67\x51\x99\xbc\xe1\x06\x49\x12\x24\x94\xa6\x3b\xc6\x29\x0c\x81\xca\x5b\xb1\x45\x4e\xdb\x23\x76\x1b\x74\x69\x66\xd0\x7c\x46\xac\x20\x41\xb2\x91\xc9\x64\x8f\x63\x82\xdc\x2c\x0e\xdd\xbd\xb5\x75\xc8\x49\x4d\xea\x7a\x41\xa6\xff\x77\x00\xd9\xb5\xf8\x0d\x33\x89\x06\x00")
 
 func workJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func workJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "work.js", size: 428189, mode: os.FileMode(420), modTime: time.Unix(1728026848, 0)}
+	info := bindataFileInfo{name: "work.js", size: 428339, mode: os.FileMode(420), modTime: time.Unix(1792176763, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
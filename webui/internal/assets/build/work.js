@@ -1 +1 @@
-!function(e){function t(r){if(n[r])return n[r].exports;var o=n[r]={i:r,l:!1,exports:{}};return e[r].call(o.exports,o,o.exports,t),o.l=!0,o.exports}var n={};t.m=e,t.c=n,t.d=function(e,n,r){t.o(e,n)||Object.defineProperty(e,n,{configurable:!1,enumerable:!0,get:r})},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=41)}([function(e,t,n){"use strict";e.exports=n(42)},function(e,t,n){"use strict";var r=function(e,t,n,r,o,a,l,i){if(!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var c=[n,r,o,a,l,i],u=0;s=new Error(t.replace(/%s/g,function(){return c[u++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}};e.exports=r},function(e,t,n){e.exports=n(43)()},function(e,t,n){e.exports=n(63)()},function(e,t,n){"use strict";var r=n(0),o=n(62);if(void 0===r)throw Error("create-react-class could not find the React object. If you are using script tags, make sure that React is being loaded before create-react-class.");var a=(new r.Component).updater;e.exports=o(r.Component,r.isValidElement,a)},function(e,t,n){"use strict";var r=function(){};e.exports=r},function(e,t,n){"use strict";function r(e){return null==e||u.a.isValidElement(e)}function o(e){return r(e)||Array.isArray(e)&&e.every(r)}function a(e,t){return f({},e,t)}function l(e){var t=e.type,n=a(t.defaultProps,e.props);if(n.children){var r=i(n.children,n);r.length&&(n.childRoutes=r),delete n.children}return n}function i(e,t){var n=[];return u.a.Children.forEach(e,function(e){if(u.a.isValidElement(e))if(e.type.createRouteFromReactElement){var r=e.type.createRouteFromReactElement(e,t);r&&n.push(r)}else n.push(l(e))}),n}function s(e){return o(e)?e=i(e):e&&!Array.isArray(e)&&(e=[e]),e}t.c=o,t.a=l,t.b=s;var c=n(0),u=n.n(c),f=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";t.__esModule=!0,t.createPath=t.parsePath=t.getQueryStringValueFromPath=t.stripQueryStringValueFromPath=t.addQueryStringValueToPath=void 0;var r=n(5),o=(function(e){e&&e.__esModule}(r),t.addQueryStringValueToPath=function(e,t,n){var r=a(e),o=r.pathname,i=r.search,s=r.hash;return l({pathname:o,search:i+(-1===i.indexOf("?")?"?":"&")+t+"="+n,hash:s})},t.stripQueryStringValueFromPath=function(e,t){var n=a(e),r=n.pathname,o=n.search,i=n.hash;return l({pathname:r,search:o.replace(new RegExp("([?&])"+t+"=[a-zA-Z0-9]+(&?)"),function(e,t,n){return"?"===t?t:n}),hash:i})},t.getQueryStringValueFromPath=function(e,t){var n=a(e),r=n.search,o=r.match(new RegExp("[?&]"+t+"=([a-zA-Z0-9]+)"));return o&&o[1]},function(e){var t=e.match(/^(https?:)?\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}),a=t.parsePath=function(e){var t=o(e),n="",r="",a=t.indexOf("#");-1!==a&&(r=t.substring(a),t=t.substring(0,a));var l=t.indexOf("?");return-1!==l&&(n=t.substring(l),t=t.substring(0,l)),""===t&&(t="/"),{pathname:t,search:n,hash:r}},l=t.createPath=function(e){if(null==e||"string"==typeof e)return e;var t=e.basename,n=e.pathname,r=e.search,o=e.hash,a=(t||"")+n;return r&&"?"!==r&&(a+=r),o&&(a+=o),a}},function(e,t,n){var r=n(54);"string"==typeof r&&(r=[[e.i,r,""]]);var o={hmr:!0};o.transform=void 0;n(29)(r,o);r.locals&&(e.exports=r.locals)},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";var r=n(5);n.n(r)},function(e,t,n){"use strict";function r(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function o(e){for(var t="",n=[],o=[],a=void 0,l=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)|\\\(|\\\)/g;a=i.exec(e);)a.index!==l&&(o.push(e.slice(l,a.index)),t+=r(e.slice(l,a.index))),a[1]?(t+="([^/]+)",n.push(a[1])):"**"===a[0]?(t+="(.*)",n.push("splat")):"*"===a[0]?(t+="(.*?)",n.push("splat")):"("===a[0]?t+="(?:":")"===a[0]?t+=")?":"\\("===a[0]?t+="\\(":"\\)"===a[0]&&(t+="\\)"),o.push(a[0]),l=i.lastIndex;return l!==e.length&&(o.push(e.slice(l,e.length)),t+=r(e.slice(l,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:o}}function a(e){return f[e]||(f[e]=o(e)),f[e]}function l(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=a(e),r=n.regexpSource,o=n.paramNames,l=n.tokens;"/"!==e.charAt(e.length-1)&&(r+="/?"),"*"===l[l.length-1]&&(r+="$");var i=t.match(new RegExp("^"+r,"i"));if(null==i)return null;var s=i[0],c=t.substr(s.length);if(c){if("/"!==s.charAt(s.length-1))return null;c="/"+c}return{remainingPathname:c,paramNames:o,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function i(e){return a(e).paramNames}function s(e,t){t=t||{};for(var n=a(e),r=n.tokens,o=0,l="",i=0,s=[],c=void 0,f=void 0,d=void 0,p=0,b=r.length;p<b;++p)if("*"===(c=r[p])||"**"===c)d=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=d||o>0||u()(!1),null!=d&&(l+=encodeURI(d));else if("("===c)s[o]="",o+=1;else if(")"===c){var h=s.pop();o-=1,o?s[o-1]+=h:l+=h}else if("\\("===c)l+="(";else if("\\)"===c)l+=")";else if(":"===c.charAt(0))if(f=c.substring(1),d=t[f],null!=d||o>0||u()(!1),null==d){if(o){s[o-1]="";for(var m=r.indexOf(c),g=r.slice(m,r.length),v=-1,y=0;y<g.length;y++)if(")"==g[y]){v=y;break}v>0||u()(!1),p=m+v-1}}else o?s[o-1]+=encodeURIComponent(d):l+=encodeURIComponent(d);else o?s[o-1]+=c:l+=c;return o<=0||u()(!1),l.replace(/\/+/g,"/")}t.c=l,t.b=i,t.a=s;var c=n(1),u=n.n(c),f=Object.create(null)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.locationsAreEqual=t.statesAreEqual=t.createLocation=t.createQuery=void 0;var o="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},l=n(1),i=r(l),s=n(5),c=(r(s),n(7)),u=n(17),f=(t.createQuery=function(e){return a(Object.create(null),e)},t.createLocation=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"/",t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:u.POP,n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,r="string"==typeof e?(0,c.parsePath)(e):e;return{pathname:r.pathname||"/",search:r.search||"",hash:r.hash||"",state:r.state,action:t,key:n}},function(e){return"[object Date]"===Object.prototype.toString.call(e)}),d=t.statesAreEqual=function e(t,n){if(t===n)return!0;var r=void 0===t?"undefined":o(t);if(r!==(void 0===n?"undefined":o(n)))return!1;if("function"===r&&(0,i.default)(!1),"object"===r){if(f(t)&&f(n)&&(0,i.default)(!1),!Array.isArray(t)){var a=Object.keys(t),l=Object.keys(n);return a.length===l.length&&a.every(function(r){return e(t[r],n[r])})}return Array.isArray(n)&&t.length===n.length&&t.every(function(t,r){return e(t,n[r])})}return!1};t.locationsAreEqual=function(e,t){return e.key===t.key&&e.pathname===t.pathname&&e.search===t.search&&e.hash===t.hash&&d(e.state,t.state)}},function(e,t,n){"use strict";function r(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.c=r,n.d(t,"a",function(){return a}),n.d(t,"b",function(){return l}),n.d(t,"d",function(){return s});var o=n(3),a=(n.n(o),Object(o.shape)({listen:o.func.isRequired,push:o.func.isRequired,replace:o.func.isRequired,go:o.func.isRequired,goBack:o.func.isRequired,goForward:o.func.isRequired}),Object(o.oneOfType)([o.func,o.string])),l=Object(o.oneOfType)([a,o.object]),i=Object(o.oneOfType)([o.object,o.element]),s=Object(o.oneOfType)([i,Object(o.arrayOf)(i)])},function(e,t,n){"use strict";function r(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}var o=Object.getOwnPropertySymbols,a=Object.prototype.hasOwnProperty,l=Object.prototype.propertyIsEnumerable;e.exports=function(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;if("0123456789"!==Object.getOwnPropertyNames(t).map(function(e){return t[e]}).join(""))return!1;var r={};return"abcdefghijklmnopqrst".split("").forEach(function(e){r[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},r)).join("")}catch(e){return!1}}()?Object.assign:function(e,t){for(var n,i,s=r(e),c=1;c<arguments.length;c++){n=Object(arguments[c]);for(var u in n)a.call(n,u)&&(s[u]=n[u]);if(o){i=o(n);for(var f=0;f<i.length;f++)l.call(n,i[f])&&(s[i[f]]=n[i[f]])}}return s}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var e=new Date(1e3*this.props.ts);return c.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,19).replace(/-/g,"/").replace("T"," "))}}]),t}(c.default.Component);d.propTypes={ts:f.default.number.isRequired},t.default=d},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(55),p=r(d),b=/^([\da-zA-Z+/]{4})*(([\da-zA-Z+/]{2}==)|([\da-zA-Z+/]{3}=))?$/,h=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var e=this.props.args;if(null==e)return c.default.createElement("span",null,"null");if(e.hasOwnProperty("payload")&&b.test(e.payload))try{e.payload=JSON.parse(atob(e.payload)),1===Object.keys(e).length&&(e=e.payload)}catch(t){e.payload={parse_error:"not a valid JSON",value:e.payload}}return c.default.createElement(p.default,{src:e,name:!1,collapsed:1,collapseStringsAfterLength:32,displayDataTypes:!1})}}]),t}(c.default.Component);h.propTypes={args:f.default.object.isRequired},t.default=h},function(e,t,n){"use strict";t.__esModule=!0;t.PUSH="PUSH",t.REPLACE="REPLACE",t.POP="POP"},function(e,t,n){"use strict";t.__esModule=!0;t.addEventListener=function(e,t,n){return e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)},t.removeEventListener=function(e,t,n){return e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)},t.supportsHistory=function(){var e=window.navigator.userAgent;return(-1===e.indexOf("Android 2.")&&-1===e.indexOf("Android 4.0")||-1===e.indexOf("Mobile Safari")||-1!==e.indexOf("Chrome")||-1!==e.indexOf("Windows Phone"))&&(window.history&&"pushState"in window.history)},t.supportsGoWithoutReloadUsingHash=function(){return-1===window.navigator.userAgent.indexOf("Firefox")},t.supportsPopstateOnHashchange=function(){return-1===window.navigator.userAgent.indexOf("Trident")},t.isExtraneousPopstateEvent=function(e){return void 0===e.state&&-1===navigator.userAgent.indexOf("CriOS")}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(8),p=r(d),b=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(c.default.createElement("li",{key:t,className:p.default.active},c.default.createElement("span",null,t))):this.shouldShow(t)?e.push(c.default.createElement("li",{key:t},c.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(c.default.createElement("li",{key:t,className:p.default.disabled},c.default.createElement("span",null,"..")));return c.default.createElement("ul",{className:p.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(c.default.Component);b.propTypes={page:f.default.number.isRequired,perPage:f.default.number.isRequired,totalCount:f.default.number.isRequired,jumpTo:f.default.func.isRequired},t.default=b},function(e,t,n){"use strict";function r(e,t,n){function r(){if(l=!0,i)return void(c=[].concat(Array.prototype.slice.call(arguments)));n.apply(this,arguments)}function o(){if(!l&&(s=!0,!i)){for(i=!0;!l&&a<e&&s;)s=!1,t.call(this,a++,o,r);if(i=!1,l)return void n.apply(this,c);a>=e&&s&&(l=!0,n())}}var a=0,l=!1,i=!1,s=!1,c=void 0;o()}function o(e,t,n){function r(e,t,r){l||(t?(l=!0,n(t)):(a[e]=r,(l=++i===o)&&n(null,a)))}var o=e.length,a=[];if(0===o)return n(null,a);var l=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){r(n,e,t)})})}t.a=r,t.b=o},function(e,t,n){"use strict";var r=n(1),o=n.n(r),a=n(0),l=n.n(a),i=n(4),s=n.n(i),c=n(3),u=(n.n(c),n(70)),f=n(22),d=n(6),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},b="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},h=s()({displayName:"RouterContext",mixins:[Object(f.a)("router")],propTypes:{router:c.object.isRequired,location:c.object.isRequired,routes:c.array.isRequired,params:c.object.isRequired,components:c.array.isRequired,createElement:c.func.isRequired},getDefaultProps:function(){return{createElement:l.a.createElement}},childContextTypes:{router:c.object.isRequired},getChildContext:function(){return{router:this.props.router}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.location,r=t.routes,a=t.params,i=t.components,s=t.router,c=null;return i&&(c=i.reduceRight(function(t,o,l){if(null==o)return t;var i=r[l],c=Object(u.a)(i,a),f={location:n,params:a,route:i,router:s,routeParams:c,routes:r};if(Object(d.c)(t))f.children=t;else if(t)for(var h in t)Object.prototype.hasOwnProperty.call(t,h)&&(f[h]=t[h]);if("object"===(void 0===o?"undefined":b(o))){var m={};for(var g in o)Object.prototype.hasOwnProperty.call(o,g)&&(m[g]=e.createElement(o[g],p({key:g},f)));return m}return e.createElement(o,f)},c)),null===c||!1===c||l.a.isValidElement(c)||o()(!1),c}});t.a=h},function(e,t,n){"use strict";function r(e){return"@@contextSubscriber/"+e}function o(e){var t,n,o=r(e),a=o+"/listeners",l=o+"/eventIndex",i=o+"/subscribe";return n={childContextTypes:(t={},t[o]=s.isRequired,t),getChildContext:function(){var e;return e={},e[o]={eventIndex:this[l],subscribe:this[i]},e},componentWillMount:function(){this[a]=[],this[l]=0},componentWillReceiveProps:function(){this[l]++},componentDidUpdate:function(){var e=this;this[a].forEach(function(t){return t(e[l])})}},n[i]=function(e){var t=this;return this[a].push(e),function(){t[a]=t[a].filter(function(t){return t!==e})}},n}function a(e){var t,n,o=r(e),a=o+"/lastRenderedEventIndex",l=o+"/handleContextUpdate",i=o+"/unsubscribe";return n={contextTypes:(t={},t[o]=s,t),getInitialState:function(){var e;return this.context[o]?(e={},e[a]=this.context[o].eventIndex,e):{}},componentDidMount:function(){this.context[o]&&(this[i]=this.context[o].subscribe(this[l]))},componentWillReceiveProps:function(){var e;this.context[o]&&this.setState((e={},e[a]=this.context[o].eventIndex,e))},componentWillUnmount:function(){this[i]&&(this[i](),this[i]=null)}},n[l]=function(e){if(e!==this.state[a]){var t;this.setState((t={},t[a]=e,t))}},n}t.a=o,t.b=a;var l=n(3),i=n.n(l),s=i.a.shape({subscribe:i.a.func.isRequired,eventIndex:i.a.number.isRequired})},function(e,t,n){"use strict";n.d(t,"b",function(){return o}),n.d(t,"a",function(){return a});var r=n(3),o=(n.n(r),Object(r.shape)({push:r.func.isRequired,replace:r.func.isRequired,go:r.func.isRequired,goBack:r.func.isRequired,goForward:r.func.isRequired,setRouteLeaveHook:r.func.isRequired,isActive:r.func.isRequired})),a=Object(r.shape)({pathname:r.string.isRequired,search:r.string.isRequired,state:r.object,action:r.string.isRequired,key:r.string})},function(e,t,n){"use strict";t.__esModule=!0;var r=n(5),o=(function(e){e&&e.__esModule}(r),function(e,t,n){var r=e(t,n);e.length<2&&n(r)});t.default=o},function(e,t,n){"use strict";t.__esModule=!0;var r=n(81),o=n(7),a=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(a),i=n(17),s=n(12),c=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.getCurrentLocation,n=e.getUserConfirmation,a=e.pushLocation,c=e.replaceLocation,u=e.go,f=e.keyLength,d=void 0,p=void 0,b=[],h=[],m=[],g=function(){return p&&p.action===i.POP?m.indexOf(p.key):d?m.indexOf(d.key):-1},v=function(e){var t=g();d=e,d.action===i.PUSH?m=[].concat(m.slice(0,t+1),[d.key]):d.action===i.REPLACE&&(m[t]=d.key),h.forEach(function(e){return e(d)})},y=function(e){return b.push(e),function(){return b=b.filter(function(t){return t!==e})}},_=function(e){return h.push(e),function(){return h=h.filter(function(t){return t!==e})}},x=function(e,t){(0,r.loopAsync)(b.length,function(t,n,r){(0,l.default)(b[t],e,function(e){return null!=e?r(e):n()})},function(e){n&&"string"==typeof e?n(e,function(e){return t(!1!==e)}):t(!1!==e)})},w=function(e){d&&(0,s.locationsAreEqual)(d,e)||p&&(0,s.locationsAreEqual)(p,e)||(p=e,x(e,function(t){if(p===e)if(p=null,t){if(e.action===i.PUSH){var n=(0,o.createPath)(d),r=(0,o.createPath)(e);r===n&&(0,s.statesAreEqual)(d.state,e.state)&&(e.action=i.REPLACE)}e.action===i.POP?v(e):e.action===i.PUSH?!1!==a(e)&&v(e):e.action===i.REPLACE&&!1!==c(e)&&v(e)}else if(d&&e.action===i.POP){var l=m.indexOf(d.key),f=m.indexOf(e.key);-1!==l&&-1!==f&&u(l-f)}}))},k=function(e){return w(S(e,i.PUSH))},E=function(e){return w(S(e,i.REPLACE))},O=function(){return u(-1)},P=function(){return u(1)},C=function(){return Math.random().toString(36).substr(2,f||6)},j=function(e){return(0,o.createPath)(e)},S=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:C();return(0,s.createLocation)(e,t,n)};return{getCurrentLocation:t,listenBefore:y,listen:_,transitionTo:w,push:k,replace:E,go:u,goBack:O,goForward:P,createKey:C,createPath:o.createPath,createHref:j,createLocation:S}};t.default=c},function(e,t,n){"use strict";t.__esModule=!0;t.canUseDOM=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";t.__esModule=!0,t.go=t.replaceLocation=t.pushLocation=t.startListener=t.getUserConfirmation=t.getCurrentLocation=void 0;var r=n(12),o=n(18),a=n(39),l=n(7),i=n(26),s=i.canUseDOM&&!(0,o.supportsPopstateOnHashchange)(),c=function(e){var t=e&&e.key;return(0,r.createLocation)({pathname:window.location.pathname,search:window.location.search,hash:window.location.hash,state:t?(0,a.readState)(t):void 0},void 0,t)},u=t.getCurrentLocation=function(){var e=void 0;try{e=window.history.state||{}}catch(t){e={}}return c(e)},f=(t.getUserConfirmation=function(e,t){return t(window.confirm(e))},t.startListener=function(e){var t=function(t){(0,o.isExtraneousPopstateEvent)(t)||e(c(t.state))};(0,o.addEventListener)(window,"popstate",t);var n=function(){return e(u())};return s&&(0,o.addEventListener)(window,"hashchange",n),function(){(0,o.removeEventListener)(window,"popstate",t),s&&(0,o.removeEventListener)(window,"hashchange",n)}},function(e,t){var n=e.state,r=e.key;void 0!==n&&(0,a.saveState)(r,n),t({key:r},(0,l.createPath)(e))});t.pushLocation=function(e){return f(e,function(e,t){return window.history.pushState(e,null,t)})},t.replaceLocation=function(e){return f(e,function(e,t){return window.history.replaceState(e,null,t)})},t.go=function(e){e&&window.history.go(e)}},function(e,t){function n(e,t){var n=e[1]||"",o=e[3];if(!o)return n;if(t&&"function"==typeof btoa){var a=r(o);return[n].concat(o.sources.map(function(e){return"/*# sourceURL="+o.sourceRoot+e+" */"})).concat([a]).join("\n")}return[n].join("\n")}function r(e){return"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(e))))+" */"}e.exports=function(e){var t=[];return t.toString=function(){return this.map(function(t){var r=n(t,e);return t[2]?"@media "+t[2]+"{"+r+"}":r}).join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var r={},o=0;o<this.length;o++){var a=this[o][0];"number"==typeof a&&(r[a]=!0)}for(o=0;o<e.length;o++){var l=e[o];"number"==typeof l[0]&&r[l[0]]||(n&&!l[2]?l[2]=n:n&&(l[2]="("+l[2]+") and ("+n+")"),t.push(l))}},t}},function(e,t,n){function r(e,t){for(var n=0;n<e.length;n++){var r=e[n],o=b[r.id];if(o){o.refs++;for(var a=0;a<o.parts.length;a++)o.parts[a](r.parts[a]);for(;a<r.parts.length;a++)o.parts.push(u(r.parts[a],t))}else{for(var l=[],a=0;a<r.parts.length;a++)l.push(u(r.parts[a],t));b[r.id]={id:r.id,refs:1,parts:l}}}}function o(e,t){for(var n=[],r={},o=0;o<e.length;o++){var a=e[o],l=t.base?a[0]+t.base:a[0],i=a[1],s=a[2],c=a[3],u={css:i,media:s,sourceMap:c};r[l]?r[l].parts.push(u):n.push(r[l]={id:l,parts:[u]})}return n}function a(e,t){var n=m(e.insertInto);if(!n)throw new Error("Couldn't find a style target. This probably means that the value for the 'insertInto' parameter is invalid.");var r=y[y.length-1];if("top"===e.insertAt)r?r.nextSibling?n.insertBefore(t,r.nextSibling):n.appendChild(t):n.insertBefore(t,n.firstChild),y.push(t);else if("bottom"===e.insertAt)n.appendChild(t);else{if("object"!=typeof e.insertAt||!e.insertAt.before)throw new Error("[Style Loader]\n\n Invalid value for parameter 'insertAt' ('options.insertAt') found.\n Must be 'top', 'bottom', or Object.\n (https://github.com/webpack-contrib/style-loader#insertat)\n");var o=m(e.insertInto+" "+e.insertAt.before);n.insertBefore(t,o)}}function l(e){if(null===e.parentNode)return!1;e.parentNode.removeChild(e);var t=y.indexOf(e);t>=0&&y.splice(t,1)}function i(e){var t=document.createElement("style");return e.attrs.type="text/css",c(t,e.attrs),a(e,t),t}function s(e){var t=document.createElement("link");return e.attrs.type="text/css",e.attrs.rel="stylesheet",c(t,e.attrs),a(e,t),t}function c(e,t){Object.keys(t).forEach(function(n){e.setAttribute(n,t[n])})}function u(e,t){var n,r,o,a;if(t.transform&&e.css){if(!(a=t.transform(e.css)))return function(){};e.css=a}if(t.singleton){var c=v++;n=g||(g=i(t)),r=f.bind(null,n,c,!1),o=f.bind(null,n,c,!0)}else e.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=s(t),r=p.bind(null,n,t),o=function(){l(n),n.href&&URL.revokeObjectURL(n.href)}):(n=i(t),r=d.bind(null,n),o=function(){l(n)});return r(e),function(t){if(t){if(t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap)return;r(e=t)}else o()}}function f(e,t,n,r){var o=n?"":r.css;if(e.styleSheet)e.styleSheet.cssText=x(t,o);else{var a=document.createTextNode(o),l=e.childNodes;l[t]&&e.removeChild(l[t]),l.length?e.insertBefore(a,l[t]):e.appendChild(a)}}function d(e,t){var n=t.css,r=t.media;if(r&&e.setAttribute("media",r),e.styleSheet)e.styleSheet.cssText=n;else{for(;e.firstChild;)e.removeChild(e.firstChild);e.appendChild(document.createTextNode(n))}}function p(e,t,n){var r=n.css,o=n.sourceMap,a=void 0===t.convertToAbsoluteUrls&&o;(t.convertToAbsoluteUrls||a)&&(r=_(r)),o&&(r+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(o))))+" */");var l=new Blob([r],{type:"text/css"}),i=e.href;e.href=URL.createObjectURL(l),i&&URL.revokeObjectURL(i)}var b={},h=function(e){var t;return function(){return void 0===t&&(t=e.apply(this,arguments)),t}}(function(){return window&&document&&document.all&&!window.atob}),m=function(e){var t={};return function(n){if(void 0===t[n]){var r=e.call(this,n);if(r instanceof window.HTMLIFrameElement)try{r=r.contentDocument.head}catch(e){r=null}t[n]=r}return t[n]}}(function(e){return document.querySelector(e)}),g=null,v=0,y=[],_=n(53);e.exports=function(e,t){if("undefined"!=typeof DEBUG&&DEBUG&&"object"!=typeof document)throw new Error("The style-loader cannot be used in a non-browser environment");t=t||{},t.attrs="object"==typeof t.attrs?t.attrs:{},t.singleton||"boolean"==typeof t.singleton||(t.singleton=h()),t.insertInto||(t.insertInto="head"),t.insertAt||(t.insertAt="bottom");var n=o(e,t);return r(n,t),function(e){for(var a=[],l=0;l<n.length;l++){var i=n[l],s=b[i.id];s.refs--,a.push(s)}if(e){r(o(e,t),t)}for(var l=0;l<a.length;l++){var s=a[l];if(0===s.refs){for(var c=0;c<s.parts.length;c++)s.parts[c]();delete b[s.id]}}}};var x=function(){var e=[];return function(t,n){return e[t]=n,e.filter(Boolean).join("\n")}}()},function(e,t,n){"use strict";function r(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function o(e,t){function n(t,n){return t=e.createLocation(t),Object(i.a)(t,n,y.location,y.routes,y.params)}function o(e,n){E&&E.location===e?f(E,n):Object(c.a)(t,e,function(t,r){t?n(t):r?f(u({},r,{location:e}),n):n()})}function f(e,t){function n(n,o){if(n||o)return r(n,o);Object(s.a)(e,function(n,r){n?t(n):t(null,null,y=u({},e,{components:r}))})}function r(e,n){e?t(e):t(null,n)}var o=Object(a.a)(y,e),l=o.leaveRoutes,i=o.changeRoutes,c=o.enterRoutes;k(l,y),l.filter(function(e){return-1===c.indexOf(e)}).forEach(m),w(i,y,e,function(t,o){if(t||o)return r(t,o);x(c,e,n)})}function d(e){var t=arguments.length>1&&void 0!==arguments[1]&&arguments[1];return e.__id__||t&&(e.__id__=O++)}function p(e){return e.map(function(e){return P[d(e)]}).filter(function(e){return e})}function b(e,n){Object(c.a)(t,e,function(t,r){if(null==r)return void n();E=u({},r,{location:e});for(var o=p(Object(a.a)(y,E).leaveRoutes),l=void 0,i=0,s=o.length;null==l&&i<s;++i)l=o[i](e);n(l)})}function h(){if(y.routes){for(var e=p(y.routes),t=void 0,n=0,r=e.length;"string"!=typeof t&&n<r;++n)t=e[n]();return t}}function m(e){var t=d(e);t&&(delete P[t],r(P)||(C&&(C(),C=null),j&&(j(),j=null)))}function g(t,n){var o=!r(P),a=d(t,!0);return P[a]=n,o&&(C=e.listenBefore(b),e.listenBeforeUnload&&(j=e.listenBeforeUnload(h))),function(){m(t)}}function v(t){function n(n){y.location===n?t(null,y):o(n,function(n,r,o){n?t(n):r?e.replace(r):o&&t(null,o)})}var r=e.listen(n);return y.location?t(null,y):n(e.getCurrentLocation()),r}var y={},_=Object(l.a)(),x=_.runEnterHooks,w=_.runChangeHooks,k=_.runLeaveHooks,E=void 0,O=1,P=Object.create(null),C=void 0,j=void 0;return{isActive:n,match:o,listenBeforeLeavingRoute:g,listen:v}}t.a=o;var a=(n(10),n(65)),l=n(66),i=n(67),s=n(68),c=n(69),u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e){return e&&"function"==typeof e.then}t.a=r},function(e,t,n){"use strict";function r(e,t,n){return o(a({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive}),n)}function o(e,t){var n=t.location,r=t.params,o=t.routes;return e.location=n,e.params=r,e.routes=o,e}t.b=r,t.a=o;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}function o(e){return 0===e.button}function a(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function l(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function i(e,t){return"function"==typeof e?e(t.location):e}var s=n(0),c=n.n(s),u=n(4),f=n.n(u),d=n(3),p=(n.n(d),n(1)),b=n.n(p),h=n(23),m=n(22),g=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},v=f()({displayName:"Link",mixins:[Object(m.b)("router")],contextTypes:{router:h.b},propTypes:{to:Object(d.oneOfType)([d.string,d.object,d.func]),activeStyle:d.object,activeClassName:d.string,onlyActiveOnIndex:d.bool.isRequired,onClick:d.func,target:d.string},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented){var t=this.context.router;t||b()(!1),!a(e)&&o(e)&&(this.props.target||(e.preventDefault(),t.push(i(this.props.to,t))))}},render:function(){var e=this.props,t=e.to,n=e.activeClassName,o=e.activeStyle,a=e.onlyActiveOnIndex,s=r(e,["to","activeClassName","activeStyle","onlyActiveOnIndex"]),u=this.context.router;if(u){if(!t)return c.a.createElement("a",s);var f=i(t,u);s.href=u.createHref(f),(n||null!=o&&!l(o))&&u.isActive(f,a)&&(n&&(s.className?s.className+=" "+n:s.className=n),o&&(s.style=g({},s.style,o)))}return c.a.createElement("a",g({},s,{onClick:this.handleClick}))}});t.a=v},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(1)),i=n.n(l),s=n(6),c=n(11),u=n(13),f=o()({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=Object(s.a)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var r=e.location,o=e.params,a=void 0;if("/"===t.to.charAt(0))a=Object(c.a)(t.to,o);else if(t.to){var l=e.routes.indexOf(t),i=f.getRoutePattern(e.routes,l-1),s=i.replace(/\/*$/,"/")+t.to;a=Object(c.a)(s,o)}else a=r.pathname;n({pathname:a,query:t.query||r.query,state:t.state||r.state})},t},getRoutePattern:function(e,t){for(var n="",r=t;r>=0;r--){var o=e[r],a=o.path||"";if(n=a.replace(/\/*$/,"/")+n,0===a.indexOf("/"))break}return"/"+n}},propTypes:{path:a.string,from:a.string,to:a.string.isRequired,query:a.object,state:a.object,onEnter:u.c,children:u.c},render:function(){i()(!1)}});t.a=f},function(e,t,n){"use strict";function r(e){var t=c()(e),n=function(){return t};return a()(i()(n))(e)}t.a=r;var o=n(36),a=n.n(o),l=n(37),i=n.n(l),s=n(80),c=n.n(s)},function(e,t,n){"use strict";t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},o=n(78),a=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(a),i=n(12),s=n(7),c=function(e){return(0,o.stringify)(e).replace(/%20/g,"+")},u=o.parse,f=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),o=t.stringifyQuery,a=t.parseQueryString;"function"!=typeof o&&(o=c),"function"!=typeof a&&(a=u);var f=function(e){return e?(null==e.query&&(e.query=a(e.search.substring(1))),e):e},d=function(e,t){if(null==t)return e;var n="string"==typeof e?(0,s.parsePath)(e):e,a=o(t);return r({},n,{search:a?"?"+a:""})};return r({},n,{getCurrentLocation:function(){return f(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,l.default)(e,f(t),n)})},listen:function(e){return n.listen(function(t){return e(f(t))})},push:function(e){return n.push(d(e,e.query))},replace:function(e){return n.replace(d(e,e.query))},createPath:function(e){return n.createPath(d(e,e.query))},createHref:function(e){return n.createHref(d(e,e.query))},createLocation:function(e){for(var t=arguments.length,r=Array(t>1?t-1:0),o=1;o<t;o++)r[o-1]=arguments[o];var a=n.createLocation.apply(n,[d(e,e.query)].concat(r));return e.query&&(a.query=(0,i.createQuery)(e.query)),f(a)}})}};t.default=f},function(e,t,n){"use strict";t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},o=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(o),l=n(7),i=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),o=t.basename,i=function(e){return e?(o&&null==e.basename&&(0===e.pathname.toLowerCase().indexOf(o.toLowerCase())?(e.pathname=e.pathname.substring(o.length),e.basename=o,""===e.pathname&&(e.pathname="/")):e.basename=""),e):e},s=function(e){if(!o)return e;var t="string"==typeof e?(0,l.parsePath)(e):e,n=t.pathname,a="/"===o.slice(-1)?o:o+"/",i="/"===n.charAt(0)?n.slice(1):n;return r({},t,{pathname:a+i})};return r({},n,{getCurrentLocation:function(){return i(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,a.default)(e,i(t),n)})},listen:function(e){return n.listen(function(t){return e(i(t))})},push:function(e){return n.push(s(e))},replace:function(e){return n.replace(s(e))},createPath:function(e){return n.createPath(s(e))},createHref:function(e){return n.createHref(s(e))},createLocation:function(e){for(var t=arguments.length,r=Array(t>1?t-1:0),o=1;o<t;o++)r[o-1]=arguments[o];return i(n.createLocation.apply(n,[s(e)].concat(r)))}})}};t.default=i},function(e,t,n){"use strict";function r(e){return function(t){return a()(i()(e))(t)}}t.a=r;var o=n(36),a=n.n(o),l=n(37),i=n.n(l)},function(e,t,n){"use strict";t.__esModule=!0,t.readState=t.saveState=void 0;var r=n(5),o=(function(e){e&&e.__esModule}(r),{QuotaExceededError:!0,QUOTA_EXCEEDED_ERR:!0}),a={SecurityError:!0},l=function(e){return"@@History/"+e};t.saveState=function(e,t){if(window.sessionStorage)try{null==t?window.sessionStorage.removeItem(l(e)):window.sessionStorage.setItem(l(e),JSON.stringify(t))}catch(e){if(a[e.name])return;if(o[e.name]&&0===window.sessionStorage.length)return;throw e}},t.readState=function(e){var t=void 0;try{t=window.sessionStorage.getItem(l(e))}catch(e){if(a[e.name])return}if(t)try{return JSON.parse(t)}catch(e){}}},function(e,t,n){"use strict";function r(e){var t=void 0;return a&&(t=Object(o.a)(e)()),t}t.a=r;var o=n(38),a=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(45),p=n(49),b=r(p),h=n(56),m=r(h),g=n(57),v=r(g),y=n(58),_=r(y),x=n(59),w=r(x),k=n(60),E=n(8),O=r(E),P=n(9),C=r(P),j=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){return c.default.createElement("div",{className:O.default.container,style:{marginTop:30,marginBottom:60}},c.default.createElement("header",null,c.default.createElement("h1",null,"gojek/work")),c.default.createElement("hr",null),c.default.createElement("div",{className:O.default.row},c.default.createElement("main",{className:O.default.colMd10},this.props.children),c.default.createElement("aside",{className:O.default.colMd2},c.default.createElement("nav",null,c.default.createElement("ul",{className:(0,C.default)(O.default.nav,O.default.navPills,O.default.navStacked)},c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/processes"},"Processes")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/queues"},"Queues")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/retry_jobs"},"Retry Jobs")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/dead_jobs"},"Dead Jobs")))))))}}]),t}(c.default.Component);j.propTypes={children:f.default.element.isRequired},(0,d.render)(c.default.createElement(k.Router,{history:k.hashHistory},c.default.createElement(k.Route,{path:"/",component:j},c.default.createElement(k.Route,{path:"/processes",component:function(){return c.default.createElement(b.default,{busyWorkerURL:"./busy_workers",workerPoolURL:"./worker_pools"})}}),c.default.createElement(k.Route,{path:"/queues",component:function(){return c.default.createElement(v.default,{url:"./queues"})}}),c.default.createElement(k.Route,{path:"/retry_jobs",component:function(){return c.default.createElement(_.default,{url:"./retry_jobs"})}}),c.default.createElement(k.Route,{path:"/scheduled_jobs",component:function(){return c.default.createElement(w.default,{url:"./scheduled_jobs"})}}),c.default.createElement(k.Route,{path:"/dead_jobs",component:function(){return c.default.createElement(m.default,{fetchURL:"./dead_jobs",retryURL:"./retry_dead_job",retryAllURL:"./retry_all_dead_jobs",deleteURL:"./delete_dead_job",deleteAllURL:"./delete_all_dead_jobs"})}}),c.default.createElement(k.IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";function r(e){for(var t="https://reactjs.org/docs/error-decoder.html?invariant="+e,n=1;n<arguments.length;n++)t+="&args[]="+encodeURIComponent(arguments[n]);return"Minified React error #"+e+"; visit "+t+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function o(e,t,n){this.props=e,this.context=t,this.refs=A,this.updater=n||N}function a(){}function l(e,t,n){this.props=e,this.context=t,this.refs=A,this.updater=n||N}function i(e,t,n){var r,o={},a=null,l=null;if(null!=t)for(r in void 0!==t.ref&&(l=t.ref),void 0!==t.key&&(a=""+t.key),t)H.call(t,r)&&!F.hasOwnProperty(r)&&(o[r]=t[r]);var i=arguments.length-2;if(1===i)o.children=n;else if(1<i){for(var s=Array(i),c=0;c<i;c++)s[c]=arguments[c+2];o.children=s}if(e&&e.defaultProps)for(r in i=e.defaultProps)void 0===o[r]&&(o[r]=i[r]);return{$$typeof:w,type:e,key:a,ref:l,props:o,_owner:I.current}}function s(e,t){return{$$typeof:w,type:e.type,key:t,ref:e.ref,props:e.props,_owner:e._owner}}function c(e){return"object"==typeof e&&null!==e&&e.$$typeof===w}function u(e){var t={"=":"=0",":":"=2"};return"$"+(""+e).replace(/[=:]/g,function(e){return t[e]})}function f(e,t,n,r){if(z.length){var o=z.pop();return o.result=e,o.keyPrefix=t,o.func=n,o.context=r,o.count=0,o}return{result:e,keyPrefix:t,func:n,context:r,count:0}}function d(e){e.result=null,e.keyPrefix=null,e.func=null,e.context=null,e.count=0,10>z.length&&z.push(e)}function p(e,t,n,o){var a=typeof e;"undefined"!==a&&"boolean"!==a||(e=null);var l=!1;if(null===e)l=!0;else switch(a){case"string":case"number":l=!0;break;case"object":switch(e.$$typeof){case w:case k:l=!0}}if(l)return n(o,e,""===t?"."+h(e,0):t),1;if(l=0,t=""===t?".":t+":",Array.isArray(e))for(var i=0;i<e.length;i++){a=e[i];var s=t+h(a,i);l+=p(a,s,n,o)}else if(null===e||"object"!=typeof e?s=null:(s=L&&e[L]||e["@@iterator"],s="function"==typeof s?s:null),"function"==typeof s)for(e=s.call(e),i=0;!(a=e.next()).done;)a=a.value,s=t+h(a,i++),l+=p(a,s,n,o);else if("object"===a)throw n=""+e,Error(r(31,"[object Object]"===n?"object with keys {"+Object.keys(e).join(", ")+"}":n,""));return l}function b(e,t,n){return null==e?0:p(e,"",t,n)}function h(e,t){return"object"==typeof e&&null!==e&&null!=e.key?u(e.key):t.toString(36)}function m(e,t){e.func.call(e.context,t,e.count++)}function g(e,t,n){var r=e.result,o=e.keyPrefix;e=e.func.call(e.context,t,e.count++),Array.isArray(e)?v(e,r,n,function(e){return e}):null!=e&&(c(e)&&(e=s(e,o+(!e.key||t&&t.key===e.key?"":(""+e.key).replace(U,"$&/")+"/")+n)),r.push(e))}function v(e,t,n,r,o){var a="";null!=n&&(a=(""+n).replace(U,"$&/")+"/"),t=f(t,a,r,o),b(e,g,t),d(t)}function y(){var e=q.current;if(null===e)throw Error(r(321));return e}var _=n(14),x="function"==typeof Symbol&&Symbol.for,w=x?Symbol.for("react.element"):60103,k=x?Symbol.for("react.portal"):60106,E=x?Symbol.for("react.fragment"):60107,O=x?Symbol.for("react.strict_mode"):60108,P=x?Symbol.for("react.profiler"):60114,C=x?Symbol.for("react.provider"):60109,j=x?Symbol.for("react.context"):60110,S=x?Symbol.for("react.forward_ref"):60112,M=x?Symbol.for("react.suspense"):60113,T=x?Symbol.for("react.memo"):60115,R=x?Symbol.for("react.lazy"):60116,L="function"==typeof Symbol&&Symbol.iterator,N={isMounted:function(){return!1},enqueueForceUpdate:function(){},enqueueReplaceState:function(){},enqueueSetState:function(){}},A={};o.prototype.isReactComponent={},o.prototype.setState=function(e,t){if("object"!=typeof e&&"function"!=typeof e&&null!=e)throw Error(r(85));this.updater.enqueueSetState(this,e,t,"setState")},o.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this,e,"forceUpdate")},a.prototype=o.prototype;var D=l.prototype=new a;D.constructor=l,_(D,o.prototype),D.isPureReactComponent=!0;var I={current:null},H=Object.prototype.hasOwnProperty,F={key:!0,ref:!0,__self:!0,__source:!0},U=/\/+/g,z=[],q={current:null},Y={ReactCurrentDispatcher:q,ReactCurrentBatchConfig:{suspense:null},ReactCurrentOwner:I,IsSomeRendererActing:{current:!1},assign:_};t.Children={map:function(e,t,n){if(null==e)return e;var r=[];return v(e,r,null,t,n),r},forEach:function(e,t,n){if(null==e)return e;t=f(null,null,t,n),b(e,m,t),d(t)},count:function(e){return b(e,function(){return null},null)},toArray:function(e){var t=[];return v(e,t,null,function(e){return e}),t},only:function(e){if(!c(e))throw Error(r(143));return e}},t.Component=o,t.Fragment=E,t.Profiler=P,t.PureComponent=l,t.StrictMode=O,t.Suspense=M,t.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=Y,t.cloneElement=function(e,t,n){if(null===e||void 0===e)throw Error(r(267,e));var o=_({},e.props),a=e.key,l=e.ref,i=e._owner;if(null!=t){if(void 0!==t.ref&&(l=t.ref,i=I.current),void 0!==t.key&&(a=""+t.key),e.type&&e.type.defaultProps)var s=e.type.defaultProps;for(c in t)H.call(t,c)&&!F.hasOwnProperty(c)&&(o[c]=void 0===t[c]&&void 0!==s?s[c]:t[c])}var c=arguments.length-2;if(1===c)o.children=n;else if(1<c){s=Array(c);for(var u=0;u<c;u++)s[u]=arguments[u+2];o.children=s}return{$$typeof:w,type:e.type,key:a,ref:l,props:o,_owner:i}},t.createContext=function(e,t){return void 0===t&&(t=null),e={$$typeof:j,_calculateChangedBits:t,_currentValue:e,_currentValue2:e,_threadCount:0,Provider:null,Consumer:null},e.Provider={$$typeof:C,_context:e},e.Consumer=e},t.createElement=i,t.createFactory=function(e){var t=i.bind(null,e);return t.type=e,t},t.createRef=function(){return{current:null}},t.forwardRef=function(e){return{$$typeof:S,render:e}},t.isValidElement=c,t.lazy=function(e){return{$$typeof:R,_ctor:e,_status:-1,_result:null}},t.memo=function(e,t){return{$$typeof:T,type:e,compare:void 0===t?null:t}},t.useCallback=function(e,t){return y().useCallback(e,t)},t.useContext=function(e,t){return y().useContext(e,t)},t.useDebugValue=function(){},t.useEffect=function(e,t){return y().useEffect(e,t)},t.useImperativeHandle=function(e,t,n){return y().useImperativeHandle(e,t,n)},t.useLayoutEffect=function(e,t){return y().useLayoutEffect(e,t)},t.useMemo=function(e,t){return y().useMemo(e,t)},t.useReducer=function(e,t,n){return y().useReducer(e,t,n)},t.useRef=function(e){return y().useRef(e)},t.useState=function(e){return y().useState(e)},t.version="16.14.0"},function(e,t,n){"use strict";function r(){}function o(){}var a=n(44);o.resetWarningCache=r,e.exports=function(){function e(e,t,n,r,o,l){if(l!==a){var i=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types");throw i.name="Invariant Violation",i}}function t(){return e}e.isRequired=e;var n={array:e,bigint:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,elementType:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t,checkPropTypes:o,resetWarningCache:r};return n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function r(){if("undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE)try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(r)}catch(e){console.error(e)}}r(),e.exports=n(46)},function(e,t,n){"use strict";function r(e){for(var t="https://reactjs.org/docs/error-decoder.html?invariant="+e,n=1;n<arguments.length;n++)t+="&args[]="+encodeURIComponent(arguments[n]);return"Minified React error #"+e+"; visit "+t+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function o(e,t,n,r,o,a,l,i,s){var c=Array.prototype.slice.call(arguments,3);try{t.apply(n,c)}catch(e){this.onError(e)}}function a(e,t,n,r,a,l,i,s,c){qo=!1,Yo=null,o.apply(Bo,arguments)}function l(e,t,n,o,l,i,s,c,u){if(a.apply(this,arguments),qo){if(!qo)throw Error(r(198));var f=Yo;qo=!1,Yo=null,Wo||(Wo=!0,Vo=f)}}function i(e,t,n){var r=e.type||"unknown-event";e.currentTarget=Go(n),l(r,t,void 0,e),e.currentTarget=null}function s(){if(Qo)for(var e in Zo){var t=Zo[e],n=Qo.indexOf(e);if(!(-1<n))throw Error(r(96,e));if(!Jo[n]){if(!t.extractEvents)throw Error(r(97,e));Jo[n]=t,n=t.eventTypes;for(var o in n){var a=void 0,l=n[o],i=t,s=o;if($o.hasOwnProperty(s))throw Error(r(99,s));$o[s]=l;var u=l.phasedRegistrationNames;if(u){for(a in u)u.hasOwnProperty(a)&&c(u[a],i,s);a=!0}else l.registrationName?(c(l.registrationName,i,s),a=!0):a=!1;if(!a)throw Error(r(98,o,e))}}}}function c(e,t,n){if(ea[e])throw Error(r(100,e));ea[e]=t,ta[e]=t.eventTypes[n].dependencies}function u(e){var t,n=!1;for(t in e)if(e.hasOwnProperty(t)){var o=e[t];if(!Zo.hasOwnProperty(t)||Zo[t]!==o){if(Zo[t])throw Error(r(102,t));Zo[t]=o,n=!0}}n&&s()}function f(e){if(e=Xo(e)){if("function"!=typeof ra)throw Error(r(280));var t=e.stateNode;t&&(t=Ko(t),ra(e.stateNode,e.type,t))}}function d(e){oa?aa?aa.push(e):aa=[e]:oa=e}function p(){if(oa){var e=oa,t=aa;if(aa=oa=null,f(e),t)for(e=0;e<t.length;e++)f(t[e])}}function b(e,t){return e(t)}function h(e,t,n,r,o){return e(t,n,r,o)}function m(){}function g(){null===oa&&null===aa||(m(),p())}function v(e,t,n){if(sa)return e(t,n);sa=!0;try{return la(e,t,n)}finally{sa=!1,g()}}function y(e){return!!ua.call(da,e)||!ua.call(fa,e)&&(ca.test(e)?da[e]=!0:(fa[e]=!0,!1))}function _(e,t,n,r){if(null!==n&&0===n.type)return!1;switch(typeof t){case"function":case"symbol":return!0;case"boolean":return!r&&(null!==n?!n.acceptsBooleans:"data-"!==(e=e.toLowerCase().slice(0,5))&&"aria-"!==e);default:return!1}}function x(e,t,n,r){if(null===t||void 0===t||_(e,t,n,r))return!0;if(r)return!1;if(null!==n)switch(n.type){case 3:return!t;case 4:return!1===t;case 5:return isNaN(t);case 6:return isNaN(t)||1>t}return!1}function w(e,t,n,r,o,a){this.acceptsBooleans=2===t||3===t||4===t,this.attributeName=r,this.attributeNamespace=o,this.mustUseProperty=n,this.propertyName=e,this.type=t,this.sanitizeURL=a}function k(e){return e[1].toUpperCase()}function E(e,t,n,r){var o=pa.hasOwnProperty(t)?pa[t]:null;(null!==o?0===o.type:!r&&(2<t.length&&("o"===t[0]||"O"===t[0])&&("n"===t[1]||"N"===t[1])))||(x(t,n,o,r)&&(n=null),r||null===o?y(t)&&(null===n?e.removeAttribute(t):e.setAttribute(t,""+n)):o.mustUseProperty?e[o.propertyName]=null===n?3!==o.type&&"":n:(t=o.attributeName,r=o.attributeNamespace,null===n?e.removeAttribute(t):(o=o.type,n=3===o||4===o&&!0===n?"":""+n,r?e.setAttributeNS(r,t,n):e.setAttribute(t,n))))}function O(e){return null===e||"object"!=typeof e?null:(e=La&&e[La]||e["@@iterator"],"function"==typeof e?e:null)}function P(e){if(-1===e._status){e._status=0;var t=e._ctor;t=t(),e._result=t,t.then(function(t){0===e._status&&(t=t.default,e._status=1,e._result=t)},function(t){0===e._status&&(e._status=2,e._result=t)})}}function C(e){if(null==e)return null;if("function"==typeof e)return e.displayName||e.name||null;if("string"==typeof e)return e;switch(e){case xa:return"Fragment";case _a:return"Portal";case ka:return"Profiler";case wa:return"StrictMode";case ja:return"Suspense";case Sa:return"SuspenseList"}if("object"==typeof e)switch(e.$$typeof){case Oa:return"Context.Consumer";case Ea:return"Context.Provider";case Ca:var t=e.render;return t=t.displayName||t.name||"",e.displayName||(""!==t?"ForwardRef("+t+")":"ForwardRef");case Ma:return C(e.type);case Ra:return C(e.render);case Ta:if(e=1===e._status?e._result:null)return C(e)}return null}function j(e){var t="";do{e:switch(e.tag){case 3:case 4:case 6:case 7:case 10:case 9:var n="";break e;default:var r=e._debugOwner,o=e._debugSource,a=C(e.type);n=null,r&&(n=C(r.type)),r=a,a="",o?a=" (at "+o.fileName.replace(ga,"")+":"+o.lineNumber+")":n&&(a=" (created by "+n+")"),n="\n    in "+(r||"Unknown")+a}t+=n,e=e.return}while(e);return t}function S(e){switch(typeof e){case"boolean":case"number":case"object":case"string":case"undefined":return e;default:return""}}function M(e){var t=e.type;return(e=e.nodeName)&&"input"===e.toLowerCase()&&("checkbox"===t||"radio"===t)}function T(e){var t=M(e)?"checked":"value",n=Object.getOwnPropertyDescriptor(e.constructor.prototype,t),r=""+e[t];if(!e.hasOwnProperty(t)&&void 0!==n&&"function"==typeof n.get&&"function"==typeof n.set){var o=n.get,a=n.set;return Object.defineProperty(e,t,{configurable:!0,get:function(){return o.call(this)},set:function(e){r=""+e,a.call(this,e)}}),Object.defineProperty(e,t,{enumerable:n.enumerable}),{getValue:function(){return r},setValue:function(e){r=""+e},stopTracking:function(){e._valueTracker=null,delete e[t]}}}}function R(e){e._valueTracker||(e._valueTracker=T(e))}function L(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var n=t.getValue(),r="";return e&&(r=M(e)?e.checked?"true":"false":e.value),(e=r)!==n&&(t.setValue(e),!0)}function N(e,t){var n=t.checked;return Uo({},t,{defaultChecked:void 0,defaultValue:void 0,value:void 0,checked:null!=n?n:e._wrapperState.initialChecked})}function A(e,t){var n=null==t.defaultValue?"":t.defaultValue,r=null!=t.checked?t.checked:t.defaultChecked;n=S(null!=t.value?t.value:n),e._wrapperState={initialChecked:r,initialValue:n,controlled:"checkbox"===t.type||"radio"===t.type?null!=t.checked:null!=t.value}}function D(e,t){null!=(t=t.checked)&&E(e,"checked",t,!1)}function I(e,t){D(e,t);var n=S(t.value),r=t.type;if(null!=n)"number"===r?(0===n&&""===e.value||e.value!=n)&&(e.value=""+n):e.value!==""+n&&(e.value=""+n);else if("submit"===r||"reset"===r)return void e.removeAttribute("value");t.hasOwnProperty("value")?F(e,t.type,n):t.hasOwnProperty("defaultValue")&&F(e,t.type,S(t.defaultValue)),null==t.checked&&null!=t.defaultChecked&&(e.defaultChecked=!!t.defaultChecked)}function H(e,t,n){if(t.hasOwnProperty("value")||t.hasOwnProperty("defaultValue")){var r=t.type;if(!("submit"!==r&&"reset"!==r||void 0!==t.value&&null!==t.value))return;t=""+e._wrapperState.initialValue,n||t===e.value||(e.value=t),e.defaultValue=t}n=e.name,""!==n&&(e.name=""),e.defaultChecked=!!e._wrapperState.initialChecked,""!==n&&(e.name=n)}function F(e,t,n){"number"===t&&e.ownerDocument.activeElement===e||(null==n?e.defaultValue=""+e._wrapperState.initialValue:e.defaultValue!==""+n&&(e.defaultValue=""+n))}function U(e){var t="";return Fo.Children.forEach(e,function(e){null!=e&&(t+=e)}),t}function z(e,t){return e=Uo({children:void 0},t),(t=U(t.children))&&(e.children=t),e}function q(e,t,n,r){if(e=e.options,t){t={};for(var o=0;o<n.length;o++)t["$"+n[o]]=!0;for(n=0;n<e.length;n++)o=t.hasOwnProperty("$"+e[n].value),e[n].selected!==o&&(e[n].selected=o),o&&r&&(e[n].defaultSelected=!0)}else{for(n=""+S(n),t=null,o=0;o<e.length;o++){if(e[o].value===n)return e[o].selected=!0,void(r&&(e[o].defaultSelected=!0));null!==t||e[o].disabled||(t=e[o])}null!==t&&(t.selected=!0)}}function Y(e,t){if(null!=t.dangerouslySetInnerHTML)throw Error(r(91));return Uo({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue})}function W(e,t){var n=t.value;if(null==n){if(n=t.children,t=t.defaultValue,null!=n){if(null!=t)throw Error(r(92));if(Array.isArray(n)){if(!(1>=n.length))throw Error(r(93));n=n[0]}t=n}null==t&&(t=""),n=t}e._wrapperState={initialValue:S(n)}}function V(e,t){var n=S(t.value),r=S(t.defaultValue);null!=n&&(n=""+n,n!==e.value&&(e.value=n),null==t.defaultValue&&e.defaultValue!==n&&(e.defaultValue=n)),null!=r&&(e.defaultValue=""+r)}function B(e){var t=e.textContent;t===e._wrapperState.initialValue&&""!==t&&null!==t&&(e.value=t)}function K(e){switch(e){case"svg":return"http://www.w3.org/2000/svg";case"math":return"http://www.w3.org/1998/Math/MathML";default:return"http://www.w3.org/1999/xhtml"}}function X(e,t){return null==e||"http://www.w3.org/1999/xhtml"===e?K(t):"http://www.w3.org/2000/svg"===e&&"foreignObject"===t?"http://www.w3.org/1999/xhtml":e}function G(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&3===n.nodeType)return void(n.nodeValue=t)}e.textContent=t}function Q(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n}function Z(e){if(Ia[e])return Ia[e];if(!Da[e])return e;var t,n=Da[e];for(t in n)if(n.hasOwnProperty(t)&&t in Ha)return Ia[e]=n[t];return e}function J(e){var t=Ka.get(e);return void 0===t&&(t=new Map,Ka.set(e,t)),t}function $(e){var t=e,n=e;if(e.alternate)for(;t.return;)t=t.return;else{e=t;do{t=e,0!=(1026&t.effectTag)&&(n=t.return),e=t.return}while(e)}return 3===t.tag?n:null}function ee(e){if(13===e.tag){var t=e.memoizedState;if(null===t&&null!==(e=e.alternate)&&(t=e.memoizedState),null!==t)return t.dehydrated}return null}function te(e){if($(e)!==e)throw Error(r(188))}function ne(e){var t=e.alternate;if(!t){if(null===(t=$(e)))throw Error(r(188));return t!==e?null:e}for(var n=e,o=t;;){var a=n.return;if(null===a)break;var l=a.alternate;if(null===l){if(null!==(o=a.return)){n=o;continue}break}if(a.child===l.child){for(l=a.child;l;){if(l===n)return te(a),e;if(l===o)return te(a),t;l=l.sibling}throw Error(r(188))}if(n.return!==o.return)n=a,o=l;else{for(var i=!1,s=a.child;s;){if(s===n){i=!0,n=a,o=l;break}if(s===o){i=!0,o=a,n=l;break}s=s.sibling}if(!i){for(s=l.child;s;){if(s===n){i=!0,n=l,o=a;break}if(s===o){i=!0,o=l,n=a;break}s=s.sibling}if(!i)throw Error(r(189))}}if(n.alternate!==o)throw Error(r(190))}if(3!==n.tag)throw Error(r(188));return n.stateNode.current===n?e:t}function re(e){if(!(e=ne(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function oe(e,t){if(null==t)throw Error(r(30));return null==e?t:Array.isArray(e)?Array.isArray(t)?(e.push.apply(e,t),e):(e.push(t),e):Array.isArray(t)?[e].concat(t):[e,t]}function ae(e,t,n){Array.isArray(e)?e.forEach(t,n):e&&t.call(n,e)}function le(e){if(e){var t=e._dispatchListeners,n=e._dispatchInstances;if(Array.isArray(t))for(var r=0;r<t.length&&!e.isPropagationStopped();r++)i(e,t[r],n[r]);else t&&i(e,t,n);e._dispatchListeners=null,e._dispatchInstances=null,e.isPersistent()||e.constructor.release(e)}}function ie(e){if(null!==e&&(Xa=oe(Xa,e)),e=Xa,Xa=null,e){if(ae(e,le),Xa)throw Error(r(95));if(Wo)throw e=Vo,Wo=!1,Vo=null,e}}function se(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),3===e.nodeType?e.parentNode:e}function ce(e){if(!na)return!1;e="on"+e;var t=e in document;return t||(t=document.createElement("div"),t.setAttribute(e,"return;"),t="function"==typeof t[e]),t}function ue(e){e.topLevelType=null,e.nativeEvent=null,e.targetInst=null,e.ancestors.length=0,10>Ga.length&&Ga.push(e)}function fe(e,t,n,r){if(Ga.length){var o=Ga.pop();return o.topLevelType=e,o.eventSystemFlags=r,o.nativeEvent=t,o.targetInst=n,o}return{topLevelType:e,eventSystemFlags:r,nativeEvent:t,targetInst:n,ancestors:[]}}function de(e){var t=e.targetInst,n=t;do{if(!n){e.ancestors.push(n);break}var r=n;if(3===r.tag)r=r.stateNode.containerInfo;else{for(;r.return;)r=r.return;r=3!==r.tag?null:r.stateNode.containerInfo}if(!r)break;t=n.tag,5!==t&&6!==t||e.ancestors.push(n),n=Xe(r)}while(n);for(n=0;n<e.ancestors.length;n++){t=e.ancestors[n];var o=se(e.nativeEvent);r=e.topLevelType;var a=e.nativeEvent,l=e.eventSystemFlags;0===n&&(l|=64);for(var i=null,s=0;s<Jo.length;s++){var c=Jo[s];c&&(c=c.extractEvents(r,t,a,o,l))&&(i=oe(i,c))}ie(i)}}function pe(e,t,n){if(!n.has(e)){switch(e){case"scroll":Ce(t,"scroll",!0);break;case"focus":case"blur":Ce(t,"focus",!0),Ce(t,"blur",!0),n.set("blur",null),n.set("focus",null);break;case"cancel":case"close":ce(e)&&Ce(t,e,!0);break;case"invalid":case"submit":case"reset":break;default:-1===Ba.indexOf(e)&&Pe(e,t)}n.set(e,null)}}function be(e,t){var n=J(t);ol.forEach(function(e){pe(e,t,n)}),al.forEach(function(e){pe(e,t,n)})}function he(e,t,n,r,o){return{blockedOn:e,topLevelType:t,eventSystemFlags:32|n,nativeEvent:o,container:r}}function me(e,t){switch(e){case"focus":case"blur":Ja=null;break;case"dragenter":case"dragleave":$a=null;break;case"mouseover":case"mouseout":el=null;break;case"pointerover":case"pointerout":tl.delete(t.pointerId);break;case"gotpointercapture":case"lostpointercapture":nl.delete(t.pointerId)}}function ge(e,t,n,r,o,a){return null===e||e.nativeEvent!==a?(e=he(t,n,r,o,a),null!==t&&null!==(t=Ge(t))&&Ua(t),e):(e.eventSystemFlags|=r,e)}function ve(e,t,n,r,o){switch(t){case"focus":return Ja=ge(Ja,e,t,n,r,o),!0;case"dragenter":return $a=ge($a,e,t,n,r,o),!0;case"mouseover":return el=ge(el,e,t,n,r,o),!0;case"pointerover":var a=o.pointerId;return tl.set(a,ge(tl.get(a)||null,e,t,n,r,o)),!0;case"gotpointercapture":return a=o.pointerId,nl.set(a,ge(nl.get(a)||null,e,t,n,r,o)),!0}return!1}function ye(e){var t=Xe(e.target);if(null!==t){var n=$(t);if(null!==n)if(13===(t=n.tag)){if(null!==(t=ee(n)))return e.blockedOn=t,void zo.unstable_runWithPriority(e.priority,function(){za(n)})}else if(3===t&&n.stateNode.hydrate)return void(e.blockedOn=3===n.tag?n.stateNode.containerInfo:null)}e.blockedOn=null}function _e(e){if(null!==e.blockedOn)return!1;var t=Te(e.topLevelType,e.eventSystemFlags,e.container,e.nativeEvent);if(null!==t){var n=Ge(t);return null!==n&&Ua(n),e.blockedOn=t,!1}return!0}function xe(e,t,n){_e(e)&&n.delete(t)}function we(){for(Qa=!1;0<Za.length;){var e=Za[0];if(null!==e.blockedOn){e=Ge(e.blockedOn),null!==e&&Fa(e);break}var t=Te(e.topLevelType,e.eventSystemFlags,e.container,e.nativeEvent);null!==t?e.blockedOn=t:Za.shift()}null!==Ja&&_e(Ja)&&(Ja=null),null!==$a&&_e($a)&&($a=null),null!==el&&_e(el)&&(el=null),tl.forEach(xe),nl.forEach(xe)}function ke(e,t){e.blockedOn===t&&(e.blockedOn=null,Qa||(Qa=!0,zo.unstable_scheduleCallback(zo.unstable_NormalPriority,we)))}function Ee(e){function t(t){return ke(t,e)}if(0<Za.length){ke(Za[0],e);for(var n=1;n<Za.length;n++){var r=Za[n];r.blockedOn===e&&(r.blockedOn=null)}}for(null!==Ja&&ke(Ja,e),null!==$a&&ke($a,e),null!==el&&ke(el,e),tl.forEach(t),nl.forEach(t),n=0;n<rl.length;n++)r=rl[n],r.blockedOn===e&&(r.blockedOn=null);for(;0<rl.length&&(n=rl[0],null===n.blockedOn);)ye(n),null===n.blockedOn&&rl.shift()}function Oe(e,t){for(var n=0;n<e.length;n+=2){var r=e[n],o=e[n+1],a="on"+(o[0].toUpperCase()+o.slice(1));a={phasedRegistrationNames:{bubbled:a,captured:a+"Capture"},dependencies:[r],eventPriority:t},sl.set(r,t),il.set(r,a),ll[o]=a}}function Pe(e,t){Ce(t,e,!1)}function Ce(e,t,n){var r=sl.get(t);switch(void 0===r?2:r){case 0:r=je.bind(null,t,1,e);break;case 1:r=Se.bind(null,t,1,e);break;default:r=Me.bind(null,t,1,e)}n?e.addEventListener(t,r,!0):e.addEventListener(t,r,!1)}function je(e,t,n,r){ia||m();var o=Me,a=ia;ia=!0;try{h(o,e,t,n,r)}finally{(ia=a)||g()}}function Se(e,t,n,r){pl(dl,Me.bind(null,e,t,n,r))}function Me(e,t,n,r){if(bl)if(0<Za.length&&-1<ol.indexOf(e))e=he(null,e,t,n,r),Za.push(e);else{var o=Te(e,t,n,r);if(null===o)me(e,r);else if(-1<ol.indexOf(e))e=he(o,e,t,n,r),Za.push(e);else if(!ve(o,e,t,n,r)){me(e,r),e=fe(e,r,null,t);try{v(de,e)}finally{ue(e)}}}}function Te(e,t,n,r){if(n=se(r),null!==(n=Xe(n))){var o=$(n);if(null===o)n=null;else{var a=o.tag;if(13===a){if(null!==(n=ee(o)))return n;n=null}else if(3===a){if(o.stateNode.hydrate)return 3===o.tag?o.stateNode.containerInfo:null;n=null}else o!==n&&(n=null)}}e=fe(e,r,n,t);try{v(de,e)}finally{ue(e)}return null}function Re(e,t,n){return null==t||"boolean"==typeof t||""===t?"":n||"number"!=typeof t||0===t||hl.hasOwnProperty(e)&&hl[e]?(""+t).trim():t+"px"}function Le(e,t){e=e.style;for(var n in t)if(t.hasOwnProperty(n)){var r=0===n.indexOf("--"),o=Re(n,t[n],r);"float"===n&&(n="cssFloat"),r?e.setProperty(n,o):e[n]=o}}function Ne(e,t){if(t){if(gl[e]&&(null!=t.children||null!=t.dangerouslySetInnerHTML))throw Error(r(137,e,""));if(null!=t.dangerouslySetInnerHTML){if(null!=t.children)throw Error(r(60));if(!("object"==typeof t.dangerouslySetInnerHTML&&"__html"in t.dangerouslySetInnerHTML))throw Error(r(61))}if(null!=t.style&&"object"!=typeof t.style)throw Error(r(62,""))}}function Ae(e,t){if(-1===e.indexOf("-"))return"string"==typeof t.is;switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}function De(e,t){e=9===e.nodeType||11===e.nodeType?e:e.ownerDocument;var n=J(e);t=ta[t];for(var r=0;r<t.length;r++)pe(t[r],e,n)}function Ie(){}function He(e){if(void 0===(e=e||("undefined"!=typeof document?document:void 0)))return null;try{return e.activeElement||e.body}catch(t){return e.body}}function Fe(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function Ue(e,t){var n=Fe(e);e=0;for(var r;n;){if(3===n.nodeType){if(r=e+n.textContent.length,e<=t&&r>=t)return{node:n,offset:t-e};e=r}e:{for(;n;){if(n.nextSibling){n=n.nextSibling;break e}n=n.parentNode}n=void 0}n=Fe(n)}}function ze(e,t){return!(!e||!t)&&(e===t||(!e||3!==e.nodeType)&&(t&&3===t.nodeType?ze(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}function qe(){for(var e=window,t=He();t instanceof e.HTMLIFrameElement;){try{var n="string"==typeof t.contentWindow.location.href}catch(e){n=!1}if(!n)break;e=t.contentWindow,t=He(e.document)}return t}function Ye(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&("text"===e.type||"search"===e.type||"tel"===e.type||"url"===e.type||"password"===e.type)||"textarea"===t||"true"===e.contentEditable)}function We(e,t){switch(e){case"button":case"input":case"select":case"textarea":return!!t.autoFocus}return!1}function Ve(e,t){return"textarea"===e||"option"===e||"noscript"===e||"string"==typeof t.children||"number"==typeof t.children||"object"==typeof t.dangerouslySetInnerHTML&&null!==t.dangerouslySetInnerHTML&&null!=t.dangerouslySetInnerHTML.__html}function Be(e){for(;null!=e;e=e.nextSibling){var t=e.nodeType;if(1===t||3===t)break}return e}function Ke(e){e=e.previousSibling;for(var t=0;e;){if(8===e.nodeType){var n=e.data;if(n===yl||n===wl||n===xl){if(0===t)return e;t--}else n===_l&&t++}e=e.previousSibling}return null}function Xe(e){var t=e[jl];if(t)return t;for(var n=e.parentNode;n;){if(t=n[Ml]||n[jl]){if(n=t.alternate,null!==t.child||null!==n&&null!==n.child)for(e=Ke(e);null!==e;){if(n=e[jl])return n;e=Ke(e)}return t}e=n,n=e.parentNode}return null}function Ge(e){return e=e[jl]||e[Ml],!e||5!==e.tag&&6!==e.tag&&13!==e.tag&&3!==e.tag?null:e}function Qe(e){if(5===e.tag||6===e.tag)return e.stateNode;throw Error(r(33))}function Ze(e){return e[Sl]||null}function Je(e){do{e=e.return}while(e&&5!==e.tag);return e||null}function $e(e,t){var n=e.stateNode;if(!n)return null;var o=Ko(n);if(!o)return null;n=o[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":case"onMouseEnter":(o=!o.disabled)||(e=e.type,o=!("button"===e||"input"===e||"select"===e||"textarea"===e)),e=!o;break e;default:e=!1}if(e)return null;if(n&&"function"!=typeof n)throw Error(r(231,t,typeof n));return n}function et(e,t,n){(t=$e(e,n.dispatchConfig.phasedRegistrationNames[t]))&&(n._dispatchListeners=oe(n._dispatchListeners,t),n._dispatchInstances=oe(n._dispatchInstances,e))}function tt(e){if(e&&e.dispatchConfig.phasedRegistrationNames){for(var t=e._targetInst,n=[];t;)n.push(t),t=Je(t);for(t=n.length;0<t--;)et(n[t],"captured",e);for(t=0;t<n.length;t++)et(n[t],"bubbled",e)}}function nt(e,t,n){e&&n&&n.dispatchConfig.registrationName&&(t=$e(e,n.dispatchConfig.registrationName))&&(n._dispatchListeners=oe(n._dispatchListeners,t),n._dispatchInstances=oe(n._dispatchInstances,e))}function rt(e){e&&e.dispatchConfig.registrationName&&nt(e._targetInst,null,e)}function ot(e){ae(e,tt)}function at(){if(Ll)return Ll;var e,t,n=Rl,r=n.length,o="value"in Tl?Tl.value:Tl.textContent,a=o.length;for(e=0;e<r&&n[e]===o[e];e++);var l=r-e;for(t=1;t<=l&&n[r-t]===o[a-t];t++);return Ll=o.slice(e,1<t?1-t:void 0)}function lt(){return!0}function it(){return!1}function st(e,t,n,r){this.dispatchConfig=e,this._targetInst=t,this.nativeEvent=n,e=this.constructor.Interface;for(var o in e)e.hasOwnProperty(o)&&((t=e[o])?this[o]=t(n):"target"===o?this.target=r:this[o]=n[o]);return this.isDefaultPrevented=(null!=n.defaultPrevented?n.defaultPrevented:!1===n.returnValue)?lt:it,this.isPropagationStopped=it,this}function ct(e,t,n,r){if(this.eventPool.length){var o=this.eventPool.pop();return this.call(o,e,t,n,r),o}return new this(e,t,n,r)}function ut(e){if(!(e instanceof this))throw Error(r(279));e.destructor(),10>this.eventPool.length&&this.eventPool.push(e)}function ft(e){e.eventPool=[],e.getPooled=ct,e.release=ut}function dt(e,t){switch(e){case"keyup":return-1!==Dl.indexOf(t.keyCode);case"keydown":return 229!==t.keyCode;case"keypress":case"mousedown":case"blur":return!0;default:return!1}}function pt(e){return e=e.detail,"object"==typeof e&&"data"in e?e.data:null}function bt(e,t){switch(e){case"compositionend":return pt(t);case"keypress":return 32!==t.which?null:(Yl=!0,zl);case"textInput":return e=t.data,e===zl&&Yl?null:e;default:return null}}function ht(e,t){if(Wl)return"compositionend"===e||!Il&&dt(e,t)?(e=at(),Ll=Rl=Tl=null,Wl=!1,e):null;switch(e){case"paste":return null;case"keypress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"compositionend":return Ul&&"ko"!==t.locale?null:t.data;default:return null}}function mt(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return"input"===t?!!Bl[e.type]:"textarea"===t}function gt(e,t,n){return e=st.getPooled(Kl.change,e,t,n),e.type="change",d(n),ot(e),e}function vt(e){ie(e)}function yt(e){if(L(Qe(e)))return e}function _t(e,t){if("change"===e)return t}function xt(){Xl&&(Xl.detachEvent("onpropertychange",wt),Gl=Xl=null)}function wt(e){if("value"===e.propertyName&&yt(Gl))if(e=gt(Gl,e,se(e)),ia)ie(e);else{ia=!0;try{b(vt,e)}finally{ia=!1,g()}}}function kt(e,t,n){"focus"===e?(xt(),Xl=t,Gl=n,Xl.attachEvent("onpropertychange",wt)):"blur"===e&&xt()}function Et(e){if("selectionchange"===e||"keyup"===e||"keydown"===e)return yt(Gl)}function Ot(e,t){if("click"===e)return yt(t)}function Pt(e,t){if("input"===e||"change"===e)return yt(t)}function Ct(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):!!(e=$l[e])&&!!t[e]}function jt(){return Ct}function St(e,t){return e===t&&(0!==e||1/e==1/t)||e!==e&&t!==t}function Mt(e,t){if(si(e,t))return!0;if("object"!=typeof e||null===e||"object"!=typeof t||null===t)return!1;var n=Object.keys(e),r=Object.keys(t);if(n.length!==r.length)return!1;for(r=0;r<n.length;r++)if(!ci.call(t,n[r])||!si(e[n[r]],t[n[r]]))return!1;return!0}function Tt(e,t){var n=t.window===t?t.document:9===t.nodeType?t:t.ownerDocument;return hi||null==di||di!==He(n)?null:(n=di,"selectionStart"in n&&Ye(n)?n={start:n.selectionStart,end:n.selectionEnd}:(n=(n.ownerDocument&&n.ownerDocument.defaultView||window).getSelection(),n={anchorNode:n.anchorNode,anchorOffset:n.anchorOffset,focusNode:n.focusNode,focusOffset:n.focusOffset}),bi&&Mt(bi,n)?null:(bi=n,e=st.getPooled(fi.select,pi,e,t),e.type="select",e.target=di,ot(e),e))}function Rt(e){var t=e.keyCode;return"charCode"in e?0===(e=e.charCode)&&13===t&&(e=13):e=t,10===e&&(e=13),32<=e||13===e?e:0}function Lt(e){0>Ni||(e.current=Li[Ni],Li[Ni]=null,Ni--)}function Nt(e,t){Ni++,Li[Ni]=e.current,e.current=t}function At(e,t){var n=e.type.contextTypes;if(!n)return Ai;var r=e.stateNode;if(r&&r.__reactInternalMemoizedUnmaskedChildContext===t)return r.__reactInternalMemoizedMaskedChildContext;var o,a={};for(o in n)a[o]=t[o];return r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=t,e.__reactInternalMemoizedMaskedChildContext=a),a}function Dt(e){return null!==(e=e.childContextTypes)&&void 0!==e}function It(){Lt(Ii),Lt(Di)}function Ht(e,t,n){if(Di.current!==Ai)throw Error(r(168));Nt(Di,t),Nt(Ii,n)}function Ft(e,t,n){var o=e.stateNode;if(e=t.childContextTypes,"function"!=typeof o.getChildContext)return n;o=o.getChildContext();for(var a in o)if(!(a in e))throw Error(r(108,C(t)||"Unknown",a));return Uo({},n,{},o)}function Ut(e){return e=(e=e.stateNode)&&e.__reactInternalMemoizedMergedChildContext||Ai,Hi=Di.current,Nt(Di,e),Nt(Ii,Ii.current),!0}function zt(e,t,n){var o=e.stateNode;if(!o)throw Error(r(169));n?(e=Ft(e,t,Hi),o.__reactInternalMemoizedMergedChildContext=e,Lt(Ii),Lt(Di),Nt(Di,e)):Lt(Ii),Nt(Ii,n)}function qt(){switch(Wi()){case Vi:return 99;case Bi:return 98;case Ki:return 97;case Xi:return 96;case Gi:return 95;default:throw Error(r(332))}}function Yt(e){switch(e){case 99:return Vi;case 98:return Bi;case 97:return Ki;case 96:return Xi;case 95:return Gi;default:throw Error(r(332))}}function Wt(e,t){return e=Yt(e),Fi(e,t)}function Vt(e,t,n){return e=Yt(e),Ui(e,t,n)}function Bt(e){return null===$i?($i=[e],es=Ui(Vi,Xt)):$i.push(e),Qi}function Kt(){if(null!==es){var e=es;es=null,zi(e)}Xt()}function Xt(){if(!ts&&null!==$i){ts=!0;var e=0;try{var t=$i;Wt(99,function(){for(;e<t.length;e++){var n=t[e];do{n=n(!0)}while(null!==n)}}),$i=null}catch(t){throw null!==$i&&($i=$i.slice(e+1)),Ui(Vi,Kt),t}finally{ts=!1}}}function Gt(e,t,n){return n/=10,1073741821-(1+((1073741821-e+t/10)/n|0))*n}function Qt(e,t){if(e&&e.defaultProps){t=Uo({},t),e=e.defaultProps;for(var n in e)void 0===t[n]&&(t[n]=e[n])}return t}function Zt(){is=ls=as=null}function Jt(e){var t=os.current;Lt(os),e.type._context._currentValue=t}function $t(e,t){for(;null!==e;){var n=e.alternate;if(e.childExpirationTime<t)e.childExpirationTime=t,null!==n&&n.childExpirationTime<t&&(n.childExpirationTime=t);else{if(!(null!==n&&n.childExpirationTime<t))break;n.childExpirationTime=t}e=e.return}}function en(e,t){as=e,is=ls=null,null!==(e=e.dependencies)&&null!==e.firstContext&&(e.expirationTime>=t&&(As=!0),e.firstContext=null)}function tn(e,t){if(is!==e&&!1!==t&&0!==t)if("number"==typeof t&&1073741823!==t||(is=e,t=1073741823),t={context:e,observedBits:t,next:null},null===ls){if(null===as)throw Error(r(308));ls=t,as.dependencies={expirationTime:0,firstContext:t,responders:null}}else ls=ls.next=t;return e._currentValue}function nn(e){e.updateQueue={baseState:e.memoizedState,baseQueue:null,shared:{pending:null},effects:null}}function rn(e,t){e=e.updateQueue,t.updateQueue===e&&(t.updateQueue={baseState:e.baseState,baseQueue:e.baseQueue,shared:e.shared,effects:e.effects})}function on(e,t){return e={expirationTime:e,suspenseConfig:t,tag:0,payload:null,callback:null,next:null},e.next=e}function an(e,t){if(null!==(e=e.updateQueue)){e=e.shared;var n=e.pending;null===n?t.next=t:(t.next=n.next,n.next=t),e.pending=t}}function ln(e,t){var n=e.alternate;null!==n&&rn(n,e),e=e.updateQueue,n=e.baseQueue,null===n?(e.baseQueue=t.next=t,t.next=t):(t.next=n.next,n.next=t)}function sn(e,t,n,r){var o=e.updateQueue;ss=!1;var a=o.baseQueue,l=o.shared.pending;if(null!==l){if(null!==a){var i=a.next;a.next=l.next,l.next=i}a=l,o.shared.pending=null,i=e.alternate,null!==i&&null!==(i=i.updateQueue)&&(i.baseQueue=l)}if(null!==a){i=a.next;var s=o.baseState,c=0,u=null,f=null,d=null;if(null!==i)for(var p=i;;){if((l=p.expirationTime)<r){var b={expirationTime:p.expirationTime,suspenseConfig:p.suspenseConfig,tag:p.tag,payload:p.payload,callback:p.callback,next:null};null===d?(f=d=b,u=s):d=d.next=b,l>c&&(c=l)}else{null!==d&&(d=d.next={expirationTime:1073741823,suspenseConfig:p.suspenseConfig,tag:p.tag,payload:p.payload,callback:p.callback,next:null}),Zr(l,p.suspenseConfig);e:{var h=e,m=p;switch(l=t,b=n,m.tag){case 1:if("function"==typeof(h=m.payload)){s=h.call(b,s,l);break e}s=h;break e;case 3:h.effectTag=-4097&h.effectTag|64;case 0:if(h=m.payload,null===(l="function"==typeof h?h.call(b,s,l):h)||void 0===l)break e;s=Uo({},s,l);break e;case 2:ss=!0}}null!==p.callback&&(e.effectTag|=32,l=o.effects,null===l?o.effects=[p]:l.push(p))}if(null===(p=p.next)||p===i){if(null===(l=o.shared.pending))break;p=a.next=l.next,l.next=i,o.baseQueue=a=l,o.shared.pending=null}}null===d?u=s:d.next=f,o.baseState=u,o.baseQueue=d,Jr(c),e.expirationTime=c,e.memoizedState=s}}function cn(e,t,n){if(e=t.effects,t.effects=null,null!==e)for(t=0;t<e.length;t++){var o=e[t],a=o.callback;if(null!==a){if(o.callback=null,o=a,a=n,"function"!=typeof o)throw Error(r(191,o));o.call(a)}}}function un(e,t,n,r){t=e.memoizedState,n=n(r,t),n=null===n||void 0===n?t:Uo({},t,n),e.memoizedState=n,0===e.expirationTime&&(e.updateQueue.baseState=n)}function fn(e,t,n,r,o,a,l){return e=e.stateNode,"function"==typeof e.shouldComponentUpdate?e.shouldComponentUpdate(r,a,l):!t.prototype||!t.prototype.isPureReactComponent||(!Mt(n,r)||!Mt(o,a))}function dn(e,t,n){var r=!1,o=Ai,a=t.contextType;return"object"==typeof a&&null!==a?a=tn(a):(o=Dt(t)?Hi:Di.current,r=t.contextTypes,a=(r=null!==r&&void 0!==r)?At(e,o):Ai),t=new t(n,a),e.memoizedState=null!==t.state&&void 0!==t.state?t.state:null,t.updater=fs,e.stateNode=t,t._reactInternalFiber=e,r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=o,e.__reactInternalMemoizedMaskedChildContext=a),t}function pn(e,t,n,r){e=t.state,"function"==typeof t.componentWillReceiveProps&&t.componentWillReceiveProps(n,r),"function"==typeof t.UNSAFE_componentWillReceiveProps&&t.UNSAFE_componentWillReceiveProps(n,r),t.state!==e&&fs.enqueueReplaceState(t,t.state,null)}function bn(e,t,n,r){var o=e.stateNode;o.props=n,o.state=e.memoizedState,o.refs=us,nn(e);var a=t.contextType;"object"==typeof a&&null!==a?o.context=tn(a):(a=Dt(t)?Hi:Di.current,o.context=At(e,a)),sn(e,n,o,r),o.state=e.memoizedState,a=t.getDerivedStateFromProps,"function"==typeof a&&(un(e,t,a,n),o.state=e.memoizedState),"function"==typeof t.getDerivedStateFromProps||"function"==typeof o.getSnapshotBeforeUpdate||"function"!=typeof o.UNSAFE_componentWillMount&&"function"!=typeof o.componentWillMount||(t=o.state,"function"==typeof o.componentWillMount&&o.componentWillMount(),"function"==typeof o.UNSAFE_componentWillMount&&o.UNSAFE_componentWillMount(),t!==o.state&&fs.enqueueReplaceState(o,o.state,null),sn(e,n,o,r),o.state=e.memoizedState),"function"==typeof o.componentDidMount&&(e.effectTag|=4)}function hn(e,t,n){if(null!==(e=n.ref)&&"function"!=typeof e&&"object"!=typeof e){if(n._owner){if(n=n._owner){if(1!==n.tag)throw Error(r(309));var o=n.stateNode}if(!o)throw Error(r(147,e));var a=""+e;return null!==t&&null!==t.ref&&"function"==typeof t.ref&&t.ref._stringRef===a?t.ref:(t=function(e){var t=o.refs;t===us&&(t=o.refs={}),null===e?delete t[a]:t[a]=e},t._stringRef=a,t)}if("string"!=typeof e)throw Error(r(284));if(!n._owner)throw Error(r(290,e))}return e}function mn(e,t){if("textarea"!==e.type)throw Error(r(31,"[object Object]"===Object.prototype.toString.call(t)?"object with keys {"+Object.keys(t).join(", ")+"}":t,""))}function gn(e){function t(t,n){if(e){var r=t.lastEffect;null!==r?(r.nextEffect=n,t.lastEffect=n):t.firstEffect=t.lastEffect=n,n.nextEffect=null,n.effectTag=8}}function n(n,r){if(!e)return null;for(;null!==r;)t(n,r),r=r.sibling;return null}function o(e,t){for(e=new Map;null!==t;)null!==t.key?e.set(t.key,t):e.set(t.index,t),t=t.sibling;return e}function a(e,t){return e=yo(e,t),e.index=0,e.sibling=null,e}function l(t,n,r){return t.index=r,e?null!==(r=t.alternate)?(r=r.index,r<n?(t.effectTag=2,n):r):(t.effectTag=2,n):n}function i(t){return e&&null===t.alternate&&(t.effectTag=2),t}function s(e,t,n,r){return null===t||6!==t.tag?(t=wo(n,e.mode,r),t.return=e,t):(t=a(t,n),t.return=e,t)}function c(e,t,n,r){return null!==t&&t.elementType===n.type?(r=a(t,n.props),r.ref=hn(e,t,n),r.return=e,r):(r=_o(n.type,n.key,n.props,null,e.mode,r),r.ref=hn(e,t,n),r.return=e,r)}function u(e,t,n,r){return null===t||4!==t.tag||t.stateNode.containerInfo!==n.containerInfo||t.stateNode.implementation!==n.implementation?(t=ko(n,e.mode,r),t.return=e,t):(t=a(t,n.children||[]),t.return=e,t)}function f(e,t,n,r,o){return null===t||7!==t.tag?(t=xo(n,e.mode,r,o),t.return=e,t):(t=a(t,n),t.return=e,t)}function d(e,t,n){if("string"==typeof t||"number"==typeof t)return t=wo(""+t,e.mode,n),t.return=e,t;if("object"==typeof t&&null!==t){switch(t.$$typeof){case ya:return n=_o(t.type,t.key,t.props,null,e.mode,n),n.ref=hn(e,null,t),n.return=e,n;case _a:return t=ko(t,e.mode,n),t.return=e,t}if(ds(t)||O(t))return t=xo(t,e.mode,n,null),t.return=e,t;mn(e,t)}return null}function p(e,t,n,r){var o=null!==t?t.key:null;if("string"==typeof n||"number"==typeof n)return null!==o?null:s(e,t,""+n,r);if("object"==typeof n&&null!==n){switch(n.$$typeof){case ya:return n.key===o?n.type===xa?f(e,t,n.props.children,r,o):c(e,t,n,r):null;case _a:return n.key===o?u(e,t,n,r):null}if(ds(n)||O(n))return null!==o?null:f(e,t,n,r,null);mn(e,n)}return null}function b(e,t,n,r,o){if("string"==typeof r||"number"==typeof r)return e=e.get(n)||null,s(t,e,""+r,o);if("object"==typeof r&&null!==r){switch(r.$$typeof){case ya:return e=e.get(null===r.key?n:r.key)||null,r.type===xa?f(t,e,r.props.children,o,r.key):c(t,e,r,o);case _a:return e=e.get(null===r.key?n:r.key)||null,u(t,e,r,o)}if(ds(r)||O(r))return e=e.get(n)||null,f(t,e,r,o,null);mn(t,r)}return null}function h(r,a,i,s){for(var c=null,u=null,f=a,h=a=0,m=null;null!==f&&h<i.length;h++){f.index>h?(m=f,f=null):m=f.sibling;var g=p(r,f,i[h],s);if(null===g){null===f&&(f=m);break}e&&f&&null===g.alternate&&t(r,f),a=l(g,a,h),null===u?c=g:u.sibling=g,u=g,f=m}if(h===i.length)return n(r,f),c;if(null===f){for(;h<i.length;h++)null!==(f=d(r,i[h],s))&&(a=l(f,a,h),null===u?c=f:u.sibling=f,u=f);return c}for(f=o(r,f);h<i.length;h++)null!==(m=b(f,r,h,i[h],s))&&(e&&null!==m.alternate&&f.delete(null===m.key?h:m.key),a=l(m,a,h),null===u?c=m:u.sibling=m,u=m);return e&&f.forEach(function(e){return t(r,e)}),c}function m(a,i,s,c){var u=O(s);if("function"!=typeof u)throw Error(r(150));if(null==(s=u.call(s)))throw Error(r(151));for(var f=u=null,h=i,m=i=0,g=null,v=s.next();null!==h&&!v.done;m++,v=s.next()){h.index>m?(g=h,h=null):g=h.sibling;var y=p(a,h,v.value,c);if(null===y){null===h&&(h=g);break}e&&h&&null===y.alternate&&t(a,h),i=l(y,i,m),null===f?u=y:f.sibling=y,f=y,h=g}if(v.done)return n(a,h),u;if(null===h){for(;!v.done;m++,v=s.next())null!==(v=d(a,v.value,c))&&(i=l(v,i,m),null===f?u=v:f.sibling=v,f=v);return u}for(h=o(a,h);!v.done;m++,v=s.next())null!==(v=b(h,a,m,v.value,c))&&(e&&null!==v.alternate&&h.delete(null===v.key?m:v.key),i=l(v,i,m),null===f?u=v:f.sibling=v,f=v);return e&&h.forEach(function(e){return t(a,e)}),u}return function(e,o,l,s){var c="object"==typeof l&&null!==l&&l.type===xa&&null===l.key;c&&(l=l.props.children);var u="object"==typeof l&&null!==l;if(u)switch(l.$$typeof){case ya:e:{for(u=l.key,c=o;null!==c;){if(c.key===u){switch(c.tag){case 7:if(l.type===xa){n(e,c.sibling),o=a(c,l.props.children),o.return=e,e=o;break e}break;default:if(c.elementType===l.type){n(e,c.sibling),o=a(c,l.props),o.ref=hn(e,c,l),o.return=e,e=o;break e}}n(e,c);break}t(e,c),c=c.sibling}l.type===xa?(o=xo(l.props.children,e.mode,s,l.key),o.return=e,e=o):(s=_o(l.type,l.key,l.props,null,e.mode,s),s.ref=hn(e,o,l),s.return=e,e=s)}return i(e);case _a:e:{for(c=l.key;null!==o;){if(o.key===c){if(4===o.tag&&o.stateNode.containerInfo===l.containerInfo&&o.stateNode.implementation===l.implementation){n(e,o.sibling),o=a(o,l.children||[]),o.return=e,e=o;break e}n(e,o);break}t(e,o),o=o.sibling}o=ko(l,e.mode,s),o.return=e,e=o}return i(e)}if("string"==typeof l||"number"==typeof l)return l=""+l,null!==o&&6===o.tag?(n(e,o.sibling),o=a(o,l),o.return=e,e=o):(n(e,o),o=wo(l,e.mode,s),o.return=e,e=o),i(e);if(ds(l))return h(e,o,l,s);if(O(l))return m(e,o,l,s);if(u&&mn(e,l),void 0===l&&!c)switch(e.tag){case 1:case 0:throw e=e.type,Error(r(152,e.displayName||e.name||"Component"))}return n(e,o)}}function vn(e){if(e===hs)throw Error(r(174));return e}function yn(e,t){switch(Nt(vs,t),Nt(gs,e),Nt(ms,hs),e=t.nodeType){case 9:case 11:t=(t=t.documentElement)?t.namespaceURI:X(null,"");break;default:e=8===e?t.parentNode:t,t=e.namespaceURI||null,e=e.tagName,t=X(t,e)}Lt(ms),Nt(ms,t)}function _n(){Lt(ms),Lt(gs),Lt(vs)}function xn(e){vn(vs.current);var t=vn(ms.current),n=X(t,e.type);t!==n&&(Nt(gs,e),Nt(ms,n))}function wn(e){gs.current===e&&(Lt(ms),Lt(gs))}function kn(e){for(var t=e;null!==t;){if(13===t.tag){var n=t.memoizedState;if(null!==n&&(null===(n=n.dehydrated)||n.data===xl||n.data===wl))return t}else if(19===t.tag&&void 0!==t.memoizedProps.revealOrder){if(0!=(64&t.effectTag))return t}else if(null!==t.child){t.child.return=t,t=t.child;continue}if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}return null}function En(e,t){return{responder:e,props:t}}function On(){throw Error(r(321))}function Pn(e,t){if(null===t)return!1;for(var n=0;n<t.length&&n<e.length;n++)if(!si(e[n],t[n]))return!1;return!0}function Cn(e,t,n,o,a,l){if(ws=l,ks=t,t.memoizedState=null,t.updateQueue=null,t.expirationTime=0,_s.current=null===e||null===e.memoizedState?js:Ss,e=n(o,a),t.expirationTime===ws){l=0;do{if(t.expirationTime=0,!(25>l))throw Error(r(301));l+=1,Os=Es=null,t.updateQueue=null,_s.current=Ms,e=n(o,a)}while(t.expirationTime===ws)}if(_s.current=Cs,t=null!==Es&&null!==Es.next,ws=0,Os=Es=ks=null,Ps=!1,t)throw Error(r(300));return e}function jn(){var e={memoizedState:null,baseState:null,baseQueue:null,queue:null,next:null};return null===Os?ks.memoizedState=Os=e:Os=Os.next=e,Os}function Sn(){if(null===Es){var e=ks.alternate;e=null!==e?e.memoizedState:null}else e=Es.next;var t=null===Os?ks.memoizedState:Os.next;if(null!==t)Os=t,Es=e;else{if(null===e)throw Error(r(310));Es=e,e={memoizedState:Es.memoizedState,baseState:Es.baseState,baseQueue:Es.baseQueue,queue:Es.queue,next:null},null===Os?ks.memoizedState=Os=e:Os=Os.next=e}return Os}function Mn(e,t){return"function"==typeof t?t(e):t}function Tn(e){var t=Sn(),n=t.queue;if(null===n)throw Error(r(311));n.lastRenderedReducer=e;var o=Es,a=o.baseQueue,l=n.pending;if(null!==l){if(null!==a){var i=a.next;a.next=l.next,l.next=i}o.baseQueue=a=l,n.pending=null}if(null!==a){a=a.next,o=o.baseState;var s=i=l=null,c=a;do{var u=c.expirationTime;if(u<ws){var f={expirationTime:c.expirationTime,suspenseConfig:c.suspenseConfig,action:c.action,eagerReducer:c.eagerReducer,eagerState:c.eagerState,next:null};null===s?(i=s=f,l=o):s=s.next=f,u>ks.expirationTime&&(ks.expirationTime=u,Jr(u))}else null!==s&&(s=s.next={expirationTime:1073741823,suspenseConfig:c.suspenseConfig,action:c.action,eagerReducer:c.eagerReducer,eagerState:c.eagerState,next:null}),Zr(u,c.suspenseConfig),o=c.eagerReducer===e?c.eagerState:e(o,c.action);c=c.next}while(null!==c&&c!==a);null===s?l=o:s.next=i,si(o,t.memoizedState)||(As=!0),t.memoizedState=o,t.baseState=l,t.baseQueue=s,n.lastRenderedState=o}return[t.memoizedState,n.dispatch]}function Rn(e){var t=Sn(),n=t.queue;if(null===n)throw Error(r(311));n.lastRenderedReducer=e;var o=n.dispatch,a=n.pending,l=t.memoizedState;if(null!==a){n.pending=null;var i=a=a.next;do{l=e(l,i.action),i=i.next}while(i!==a);si(l,t.memoizedState)||(As=!0),t.memoizedState=l,null===t.baseQueue&&(t.baseState=l),n.lastRenderedState=l}return[l,o]}function Ln(e){var t=jn();return"function"==typeof e&&(e=e()),t.memoizedState=t.baseState=e,e=t.queue={pending:null,dispatch:null,lastRenderedReducer:Mn,lastRenderedState:e},e=e.dispatch=Xn.bind(null,ks,e),[t.memoizedState,e]}function Nn(e,t,n,r){return e={tag:e,create:t,destroy:n,deps:r,next:null},t=ks.updateQueue,null===t?(t={lastEffect:null},ks.updateQueue=t,t.lastEffect=e.next=e):(n=t.lastEffect,null===n?t.lastEffect=e.next=e:(r=n.next,n.next=e,e.next=r,t.lastEffect=e)),e}function An(){return Sn().memoizedState}function Dn(e,t,n,r){var o=jn();ks.effectTag|=e,o.memoizedState=Nn(1|t,n,void 0,void 0===r?null:r)}function In(e,t,n,r){var o=Sn();r=void 0===r?null:r;var a=void 0;if(null!==Es){var l=Es.memoizedState;if(a=l.destroy,null!==r&&Pn(r,l.deps))return void Nn(t,n,a,r)}ks.effectTag|=e,o.memoizedState=Nn(1|t,n,a,r)}function Hn(e,t){return Dn(516,4,e,t)}function Fn(e,t){return In(516,4,e,t)}function Un(e,t){return In(4,2,e,t)}function zn(e,t){return"function"==typeof t?(e=e(),t(e),function(){t(null)}):null!==t&&void 0!==t?(e=e(),t.current=e,function(){t.current=null}):void 0}function qn(e,t,n){return n=null!==n&&void 0!==n?n.concat([e]):null,In(4,2,zn.bind(null,t,e),n)}function Yn(){}function Wn(e,t){return jn().memoizedState=[e,void 0===t?null:t],e}function Vn(e,t){var n=Sn();t=void 0===t?null:t;var r=n.memoizedState;return null!==r&&null!==t&&Pn(t,r[1])?r[0]:(n.memoizedState=[e,t],e)}function Bn(e,t){var n=Sn();t=void 0===t?null:t;var r=n.memoizedState;return null!==r&&null!==t&&Pn(t,r[1])?r[0]:(e=e(),n.memoizedState=[e,t],e)}function Kn(e,t,n){var r=qt();Wt(98>r?98:r,function(){e(!0)}),Wt(97<r?97:r,function(){var r=xs.suspense;xs.suspense=void 0===t?null:t;try{e(!1),n()}finally{xs.suspense=r}})}function Xn(e,t,n){var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o={expirationTime:r,suspenseConfig:o,action:n,eagerReducer:null,eagerState:null,next:null};var a=t.pending;if(null===a?o.next=o:(o.next=a.next,a.next=o),t.pending=o,a=e.alternate,e===ks||null!==a&&a===ks)Ps=!0,o.expirationTime=ws,ks.expirationTime=ws;else{if(0===e.expirationTime&&(null===a||0===a.expirationTime)&&null!==(a=t.lastRenderedReducer))try{var l=t.lastRenderedState,i=a(l,n);if(o.eagerReducer=a,o.eagerState=i,si(i,l))return}catch(e){}Fr(e,r)}}function Gn(e,t){var n=mo(5,null,null,0);n.elementType="DELETED",n.type="DELETED",n.stateNode=t,n.return=e,n.effectTag=8,null!==e.lastEffect?(e.lastEffect.nextEffect=n,e.lastEffect=n):e.firstEffect=e.lastEffect=n}function Qn(e,t){switch(e.tag){case 5:var n=e.type;return null!==(t=1!==t.nodeType||n.toLowerCase()!==t.nodeName.toLowerCase()?null:t)&&(e.stateNode=t,!0);case 6:return null!==(t=""===e.pendingProps||3!==t.nodeType?null:t)&&(e.stateNode=t,!0);case 13:default:return!1}}function Zn(e){if(Ls){var t=Rs;if(t){var n=t;if(!Qn(e,t)){if(!(t=Be(n.nextSibling))||!Qn(e,t))return e.effectTag=-1025&e.effectTag|2,Ls=!1,void(Ts=e);Gn(Ts,n)}Ts=e,Rs=Be(t.firstChild)}else e.effectTag=-1025&e.effectTag|2,Ls=!1,Ts=e}}function Jn(e){for(e=e.return;null!==e&&5!==e.tag&&3!==e.tag&&13!==e.tag;)e=e.return;Ts=e}function $n(e){if(e!==Ts)return!1;if(!Ls)return Jn(e),Ls=!0,!1;var t=e.type;if(5!==e.tag||"head"!==t&&"body"!==t&&!Ve(t,e.memoizedProps))for(t=Rs;t;)Gn(e,t),t=Be(t.nextSibling);if(Jn(e),13===e.tag){if(e=e.memoizedState,!(e=null!==e?e.dehydrated:null))throw Error(r(317));e:{for(e=e.nextSibling,t=0;e;){if(8===e.nodeType){var n=e.data;if(n===_l){if(0===t){Rs=Be(e.nextSibling);break e}t--}else n!==yl&&n!==wl&&n!==xl||t++}e=e.nextSibling}Rs=null}}else Rs=Ts?Be(e.stateNode.nextSibling):null;return!0}function er(){Rs=Ts=null,Ls=!1}function tr(e,t,n,r){t.child=null===e?bs(t,null,n,r):ps(t,e.child,n,r)}function nr(e,t,n,r,o){n=n.render;var a=t.ref;return en(t,o),r=Cn(e,t,n,r,a,o),null===e||As?(t.effectTag|=1,tr(e,t,r,o),t.child):(t.updateQueue=e.updateQueue,t.effectTag&=-517,e.expirationTime<=o&&(e.expirationTime=0),br(e,t,o))}function rr(e,t,n,r,o,a){if(null===e){var l=n.type;return"function"!=typeof l||go(l)||void 0!==l.defaultProps||null!==n.compare||void 0!==n.defaultProps?(e=_o(n.type,null,r,null,t.mode,a),e.ref=t.ref,e.return=t,t.child=e):(t.tag=15,t.type=l,or(e,t,l,r,o,a))}return l=e.child,o<a&&(o=l.memoizedProps,n=n.compare,(n=null!==n?n:Mt)(o,r)&&e.ref===t.ref)?br(e,t,a):(t.effectTag|=1,e=yo(l,r),e.ref=t.ref,e.return=t,t.child=e)}function or(e,t,n,r,o,a){return null!==e&&Mt(e.memoizedProps,r)&&e.ref===t.ref&&(As=!1,o<a)?(t.expirationTime=e.expirationTime,br(e,t,a)):lr(e,t,n,r,a)}function ar(e,t){var n=t.ref;(null===e&&null!==n||null!==e&&e.ref!==n)&&(t.effectTag|=128)}function lr(e,t,n,r,o){var a=Dt(n)?Hi:Di.current;return a=At(t,a),en(t,o),n=Cn(e,t,n,r,a,o),null===e||As?(t.effectTag|=1,tr(e,t,n,o),t.child):(t.updateQueue=e.updateQueue,t.effectTag&=-517,e.expirationTime<=o&&(e.expirationTime=0),br(e,t,o))}function ir(e,t,n,r,o){if(Dt(n)){var a=!0;Ut(t)}else a=!1;if(en(t,o),null===t.stateNode)null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),dn(t,n,r),bn(t,n,r,o),r=!0;else if(null===e){var l=t.stateNode,i=t.memoizedProps;l.props=i;var s=l.context,c=n.contextType;"object"==typeof c&&null!==c?c=tn(c):(c=Dt(n)?Hi:Di.current,c=At(t,c));var u=n.getDerivedStateFromProps,f="function"==typeof u||"function"==typeof l.getSnapshotBeforeUpdate;f||"function"!=typeof l.UNSAFE_componentWillReceiveProps&&"function"!=typeof l.componentWillReceiveProps||(i!==r||s!==c)&&pn(t,l,r,c),ss=!1;var d=t.memoizedState;l.state=d,sn(t,r,l,o),s=t.memoizedState,i!==r||d!==s||Ii.current||ss?("function"==typeof u&&(un(t,n,u,r),s=t.memoizedState),(i=ss||fn(t,n,i,r,d,s,c))?(f||"function"!=typeof l.UNSAFE_componentWillMount&&"function"!=typeof l.componentWillMount||("function"==typeof l.componentWillMount&&l.componentWillMount(),"function"==typeof l.UNSAFE_componentWillMount&&l.UNSAFE_componentWillMount()),"function"==typeof l.componentDidMount&&(t.effectTag|=4)):("function"==typeof l.componentDidMount&&(t.effectTag|=4),t.memoizedProps=r,t.memoizedState=s),l.props=r,l.state=s,l.context=c,r=i):("function"==typeof l.componentDidMount&&(t.effectTag|=4),r=!1)}else l=t.stateNode,rn(e,t),i=t.memoizedProps,l.props=t.type===t.elementType?i:Qt(t.type,i),s=l.context,c=n.contextType,"object"==typeof c&&null!==c?c=tn(c):(c=Dt(n)?Hi:Di.current,c=At(t,c)),u=n.getDerivedStateFromProps,(f="function"==typeof u||"function"==typeof l.getSnapshotBeforeUpdate)||"function"!=typeof l.UNSAFE_componentWillReceiveProps&&"function"!=typeof l.componentWillReceiveProps||(i!==r||s!==c)&&pn(t,l,r,c),ss=!1,s=t.memoizedState,l.state=s,sn(t,r,l,o),d=t.memoizedState,i!==r||s!==d||Ii.current||ss?("function"==typeof u&&(un(t,n,u,r),d=t.memoizedState),(u=ss||fn(t,n,i,r,s,d,c))?(f||"function"!=typeof l.UNSAFE_componentWillUpdate&&"function"!=typeof l.componentWillUpdate||("function"==typeof l.componentWillUpdate&&l.componentWillUpdate(r,d,c),"function"==typeof l.UNSAFE_componentWillUpdate&&l.UNSAFE_componentWillUpdate(r,d,c)),"function"==typeof l.componentDidUpdate&&(t.effectTag|=4),"function"==typeof l.getSnapshotBeforeUpdate&&(t.effectTag|=256)):("function"!=typeof l.componentDidUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=4),"function"!=typeof l.getSnapshotBeforeUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=256),t.memoizedProps=r,t.memoizedState=d),l.props=r,l.state=d,l.context=c,r=u):("function"!=typeof l.componentDidUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=4),"function"!=typeof l.getSnapshotBeforeUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=256),r=!1);return sr(e,t,n,r,a,o)}function sr(e,t,n,r,o,a){ar(e,t);var l=0!=(64&t.effectTag);if(!r&&!l)return o&&zt(t,n,!1),br(e,t,a);r=t.stateNode,Ns.current=t;var i=l&&"function"!=typeof n.getDerivedStateFromError?null:r.render();return t.effectTag|=1,null!==e&&l?(t.child=ps(t,e.child,null,a),t.child=ps(t,null,i,a)):tr(e,t,i,a),t.memoizedState=r.state,o&&zt(t,n,!0),t.child}function cr(e){var t=e.stateNode;t.pendingContext?Ht(e,t.pendingContext,t.pendingContext!==t.context):t.context&&Ht(e,t.context,!1),yn(e,t.containerInfo)}function ur(e,t,n){var r,o=t.mode,a=t.pendingProps,l=ys.current,i=!1;if((r=0!=(64&t.effectTag))||(r=0!=(2&l)&&(null===e||null!==e.memoizedState)),r?(i=!0,t.effectTag&=-65):null!==e&&null===e.memoizedState||void 0===a.fallback||!0===a.unstable_avoidThisFallback||(l|=1),Nt(ys,1&l),null===e){if(void 0!==a.fallback&&Zn(t),i){if(i=a.fallback,a=xo(null,o,0,null),a.return=t,0==(2&t.mode))for(e=null!==t.memoizedState?t.child.child:t.child,a.child=e;null!==e;)e.return=a,e=e.sibling;return n=xo(i,o,n,null),n.return=t,a.sibling=n,t.memoizedState=Ds,t.child=a,n}return o=a.children,t.memoizedState=null,t.child=bs(t,null,o,n)}if(null!==e.memoizedState){if(e=e.child,o=e.sibling,i){if(a=a.fallback,n=yo(e,e.pendingProps),n.return=t,0==(2&t.mode)&&(i=null!==t.memoizedState?t.child.child:t.child)!==e.child)for(n.child=i;null!==i;)i.return=n,i=i.sibling;return o=yo(o,a),o.return=t,n.sibling=o,n.childExpirationTime=0,t.memoizedState=Ds,t.child=n,o}return n=ps(t,e.child,a.children,n),t.memoizedState=null,t.child=n}if(e=e.child,i){if(i=a.fallback,a=xo(null,o,0,null),a.return=t,a.child=e,null!==e&&(e.return=a),0==(2&t.mode))for(e=null!==t.memoizedState?t.child.child:t.child,a.child=e;null!==e;)e.return=a,e=e.sibling;return n=xo(i,o,n,null),n.return=t,a.sibling=n,n.effectTag|=2,a.childExpirationTime=0,t.memoizedState=Ds,t.child=a,n}return t.memoizedState=null,t.child=ps(t,e,a.children,n)}function fr(e,t){e.expirationTime<t&&(e.expirationTime=t);var n=e.alternate;null!==n&&n.expirationTime<t&&(n.expirationTime=t),$t(e.return,t)}function dr(e,t,n,r,o,a){var l=e.memoizedState;null===l?e.memoizedState={isBackwards:t,rendering:null,renderingStartTime:0,last:r,tail:n,tailExpiration:0,tailMode:o,lastEffect:a}:(l.isBackwards=t,l.rendering=null,l.renderingStartTime=0,l.last=r,l.tail=n,l.tailExpiration=0,l.tailMode=o,l.lastEffect=a)}function pr(e,t,n){var r=t.pendingProps,o=r.revealOrder,a=r.tail;if(tr(e,t,r.children,n),0!=(2&(r=ys.current)))r=1&r|2,t.effectTag|=64;else{if(null!==e&&0!=(64&e.effectTag))e:for(e=t.child;null!==e;){if(13===e.tag)null!==e.memoizedState&&fr(e,n);else if(19===e.tag)fr(e,n);else if(null!==e.child){e.child.return=e,e=e.child;continue}if(e===t)break e;for(;null===e.sibling;){if(null===e.return||e.return===t)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}r&=1}if(Nt(ys,r),0==(2&t.mode))t.memoizedState=null;else switch(o){case"forwards":for(n=t.child,o=null;null!==n;)e=n.alternate,null!==e&&null===kn(e)&&(o=n),n=n.sibling;n=o,null===n?(o=t.child,t.child=null):(o=n.sibling,n.sibling=null),dr(t,!1,o,n,a,t.lastEffect);break;case"backwards":for(n=null,o=t.child,t.child=null;null!==o;){if(null!==(e=o.alternate)&&null===kn(e)){t.child=o;break}e=o.sibling,o.sibling=n,n=o,o=e}dr(t,!0,n,null,a,t.lastEffect);break;case"together":dr(t,!1,null,null,void 0,t.lastEffect);break;default:t.memoizedState=null}return t.child}function br(e,t,n){null!==e&&(t.dependencies=e.dependencies);var o=t.expirationTime;if(0!==o&&Jr(o),t.childExpirationTime<n)return null;if(null!==e&&t.child!==e.child)throw Error(r(153));if(null!==t.child){for(e=t.child,n=yo(e,e.pendingProps),t.child=n,n.return=t;null!==e.sibling;)e=e.sibling,n=n.sibling=yo(e,e.pendingProps),n.return=t;n.sibling=null}return t.child}function hr(e,t){switch(e.tailMode){case"hidden":t=e.tail;for(var n=null;null!==t;)null!==t.alternate&&(n=t),t=t.sibling;null===n?e.tail=null:n.sibling=null;break;case"collapsed":n=e.tail;for(var r=null;null!==n;)null!==n.alternate&&(r=n),n=n.sibling;null===r?t||null===e.tail?e.tail=null:e.tail.sibling=null:r.sibling=null}}function mr(e,t,n){var o=t.pendingProps;switch(t.tag){case 2:case 16:case 15:case 0:case 11:case 7:case 8:case 12:case 9:case 14:return null;case 1:return Dt(t.type)&&It(),null;case 3:return _n(),Lt(Ii),Lt(Di),n=t.stateNode,n.pendingContext&&(n.context=n.pendingContext,n.pendingContext=null),null!==e&&null!==e.child||!$n(t)||(t.effectTag|=4),Mi(t),null;case 5:wn(t),n=vn(vs.current);var a=t.type;if(null!==e&&null!=t.stateNode)Ti(e,t,a,o,n),e.ref!==t.ref&&(t.effectTag|=128);else{if(!o){if(null===t.stateNode)throw Error(r(166));return null}if(e=vn(ms.current),$n(t)){o=t.stateNode,a=t.type;var l=t.memoizedProps;switch(o[jl]=t,o[Sl]=l,a){case"iframe":case"object":case"embed":Pe("load",o);break;case"video":case"audio":for(e=0;e<Ba.length;e++)Pe(Ba[e],o);break;case"source":Pe("error",o);break;case"img":case"image":case"link":Pe("error",o),Pe("load",o);break;case"form":Pe("reset",o),Pe("submit",o);break;case"details":Pe("toggle",o);break;case"input":A(o,l),Pe("invalid",o),De(n,"onChange");break;case"select":o._wrapperState={wasMultiple:!!l.multiple},Pe("invalid",o),De(n,"onChange");break;case"textarea":W(o,l),Pe("invalid",o),De(n,"onChange")}Ne(a,l),e=null;for(var i in l)if(l.hasOwnProperty(i)){var s=l[i];"children"===i?"string"==typeof s?o.textContent!==s&&(e=["children",s]):"number"==typeof s&&o.textContent!==""+s&&(e=["children",""+s]):ea.hasOwnProperty(i)&&null!=s&&De(n,i)}switch(a){case"input":R(o),H(o,l,!0);break;case"textarea":R(o),B(o);break;case"select":case"option":break;default:"function"==typeof l.onClick&&(o.onclick=Ie)}n=e,t.updateQueue=n,null!==n&&(t.effectTag|=4)}else{switch(i=9===n.nodeType?n:n.ownerDocument,e===vl&&(e=K(a)),e===vl?"script"===a?(e=i.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):"string"==typeof o.is?e=i.createElement(a,{is:o.is}):(e=i.createElement(a),"select"===a&&(i=e,o.multiple?i.multiple=!0:o.size&&(i.size=o.size))):e=i.createElementNS(e,a),e[jl]=t,e[Sl]=o,Si(e,t,!1,!1),t.stateNode=e,i=Ae(a,o),a){case"iframe":case"object":case"embed":Pe("load",e),s=o;break;case"video":case"audio":for(s=0;s<Ba.length;s++)Pe(Ba[s],e);s=o;break;case"source":Pe("error",e),s=o;break;case"img":case"image":case"link":Pe("error",e),Pe("load",e),s=o;break;case"form":Pe("reset",e),Pe("submit",e),s=o;break;case"details":Pe("toggle",e),s=o;break;case"input":A(e,o),s=N(e,o),Pe("invalid",e),De(n,"onChange");break;case"option":s=z(e,o);break;case"select":e._wrapperState={wasMultiple:!!o.multiple},s=Uo({},o,{value:void 0}),Pe("invalid",e),De(n,"onChange");break;case"textarea":W(e,o),s=Y(e,o),Pe("invalid",e),De(n,"onChange");break;default:s=o}Ne(a,s);var c=s;for(l in c)if(c.hasOwnProperty(l)){var u=c[l];"style"===l?Le(e,u):"dangerouslySetInnerHTML"===l?null!=(u=u?u.__html:void 0)&&Aa(e,u):"children"===l?"string"==typeof u?("textarea"!==a||""!==u)&&G(e,u):"number"==typeof u&&G(e,""+u):"suppressContentEditableWarning"!==l&&"suppressHydrationWarning"!==l&&"autoFocus"!==l&&(ea.hasOwnProperty(l)?null!=u&&De(n,l):null!=u&&E(e,l,u,i))}switch(a){case"input":R(e),H(e,o,!1);break;case"textarea":R(e),B(e);break;case"option":null!=o.value&&e.setAttribute("value",""+S(o.value));break;case"select":e.multiple=!!o.multiple,n=o.value,null!=n?q(e,!!o.multiple,n,!1):null!=o.defaultValue&&q(e,!!o.multiple,o.defaultValue,!0);break;default:"function"==typeof s.onClick&&(e.onclick=Ie)}We(a,o)&&(t.effectTag|=4)}null!==t.ref&&(t.effectTag|=128)}return null;case 6:if(e&&null!=t.stateNode)Ri(e,t,e.memoizedProps,o);else{if("string"!=typeof o&&null===t.stateNode)throw Error(r(166));n=vn(vs.current),vn(ms.current),$n(t)?(n=t.stateNode,o=t.memoizedProps,n[jl]=t,n.nodeValue!==o&&(t.effectTag|=4)):(n=(9===n.nodeType?n:n.ownerDocument).createTextNode(o),n[jl]=t,t.stateNode=n)}return null;case 13:return Lt(ys),(o=t.memoizedState,0!=(64&t.effectTag))?(t.expirationTime=n,t):(n=null!==o,o=!1,null===e?void 0!==t.memoizedProps.fallback&&$n(t):(a=e.memoizedState,o=null!==a,n||null===a||null!==(a=e.child.sibling)&&(l=t.firstEffect,null!==l?(t.firstEffect=a,a.nextEffect=l):(t.firstEffect=t.lastEffect=a,a.nextEffect=null),a.effectTag=8)),n&&!o&&0!=(2&t.mode)&&(null===e&&!0!==t.memoizedProps.unstable_avoidThisFallback||0!=(1&ys.current)?rc===Ks&&(rc=Qs):(rc!==Ks&&rc!==Qs||(rc=Zs),0!==sc&&null!==ec&&(Po(ec,nc),Co(ec,sc)))),(n||o)&&(t.effectTag|=4),null);case 4:return _n(),Mi(t),null;case 10:return Jt(t),null;case 17:return Dt(t.type)&&It(),null;case 19:if(Lt(ys),null===(o=t.memoizedState))return null;if(a=0!=(64&t.effectTag),null===(l=o.rendering)){if(a)hr(o,!1);else if(rc!==Ks||null!==e&&0!=(64&e.effectTag))for(l=t.child;null!==l;){if(null!==(e=kn(l))){for(t.effectTag|=64,hr(o,!1),a=e.updateQueue,null!==a&&(t.updateQueue=a,t.effectTag|=4),null===o.lastEffect&&(t.firstEffect=null),t.lastEffect=o.lastEffect,o=t.child;null!==o;)a=o,l=n,a.effectTag&=2,a.nextEffect=null,a.firstEffect=null,a.lastEffect=null,e=a.alternate,null===e?(a.childExpirationTime=0,a.expirationTime=l,a.child=null,a.memoizedProps=null,a.memoizedState=null,a.updateQueue=null,a.dependencies=null):(a.childExpirationTime=e.childExpirationTime,a.expirationTime=e.expirationTime,a.child=e.child,a.memoizedProps=e.memoizedProps,a.memoizedState=e.memoizedState,a.updateQueue=e.updateQueue,l=e.dependencies,a.dependencies=null===l?null:{expirationTime:l.expirationTime,firstContext:l.firstContext,responders:l.responders}),o=o.sibling;return Nt(ys,1&ys.current|2),t.child}l=l.sibling}}else{if(!a)if(null!==(e=kn(l))){if(t.effectTag|=64,a=!0,n=e.updateQueue,null!==n&&(t.updateQueue=n,t.effectTag|=4),hr(o,!0),null===o.tail&&"hidden"===o.tailMode&&!l.alternate)return t=t.lastEffect=o.lastEffect,null!==t&&(t.nextEffect=null),null}else 2*rs()-o.renderingStartTime>o.tailExpiration&&1<n&&(t.effectTag|=64,a=!0,hr(o,!1),t.expirationTime=t.childExpirationTime=n-1);o.isBackwards?(l.sibling=t.child,t.child=l):(n=o.last,null!==n?n.sibling=l:t.child=l,o.last=l)}return null!==o.tail?(0===o.tailExpiration&&(o.tailExpiration=rs()+500),n=o.tail,o.rendering=n,o.tail=n.sibling,o.lastEffect=t.lastEffect,o.renderingStartTime=rs(),n.sibling=null,t=ys.current,Nt(ys,a?1&t|2:1&t),n):null}throw Error(r(156,t.tag))}function gr(e){switch(e.tag){case 1:Dt(e.type)&&It();var t=e.effectTag;return 4096&t?(e.effectTag=-4097&t|64,e):null;case 3:if(_n(),Lt(Ii),Lt(Di),0!=(64&(t=e.effectTag)))throw Error(r(285));return e.effectTag=-4097&t|64,e;case 5:return wn(e),null;case 13:return Lt(ys),t=e.effectTag,4096&t?(e.effectTag=-4097&t|64,e):null;case 19:return Lt(ys),null;case 4:return _n(),null;case 10:return Jt(e),null;default:return null}}function vr(e,t){return{value:e,source:t,stack:j(t)}}function yr(e,t){var n=t.source,r=t.stack;null===r&&null!==n&&(r=j(n)),null!==n&&C(n.type),t=t.value,null!==e&&1===e.tag&&C(e.type);try{console.error(t)}catch(e){setTimeout(function(){throw e})}}function _r(e,t){try{t.props=e.memoizedProps,t.state=e.memoizedState,t.componentWillUnmount()}catch(t){uo(e,t)}}function xr(e){var t=e.ref;if(null!==t)if("function"==typeof t)try{t(null)}catch(t){uo(e,t)}else t.current=null}function wr(e,t){switch(t.tag){case 0:case 11:case 15:case 22:return;case 1:if(256&t.effectTag&&null!==e){var n=e.memoizedProps,o=e.memoizedState;e=t.stateNode,t=e.getSnapshotBeforeUpdate(t.elementType===t.type?n:Qt(t.type,n),o),e.__reactInternalSnapshotBeforeUpdate=t}return;case 3:case 5:case 6:case 4:case 17:return}throw Error(r(163))}function kr(e,t){if(t=t.updateQueue,null!==(t=null!==t?t.lastEffect:null)){var n=t=t.next;do{if((n.tag&e)===e){var r=n.destroy;n.destroy=void 0,void 0!==r&&r()}n=n.next}while(n!==t)}}function Er(e,t){if(t=t.updateQueue,null!==(t=null!==t?t.lastEffect:null)){var n=t=t.next;do{if((n.tag&e)===e){var r=n.create;n.destroy=r()}n=n.next}while(n!==t)}}function Or(e,t,n){switch(n.tag){case 0:case 11:case 15:case 22:return void Er(3,n);case 1:if(e=n.stateNode,4&n.effectTag)if(null===t)e.componentDidMount();else{var o=n.elementType===n.type?t.memoizedProps:Qt(n.type,t.memoizedProps);e.componentDidUpdate(o,t.memoizedState,e.__reactInternalSnapshotBeforeUpdate)}return void(null!==(t=n.updateQueue)&&cn(n,t,e));case 3:if(null!==(t=n.updateQueue)){if(e=null,null!==n.child)switch(n.child.tag){case 5:e=n.child.stateNode;break;case 1:e=n.child.stateNode}cn(n,t,e)}return;case 5:return e=n.stateNode,void(null===t&&4&n.effectTag&&We(n.type,n.memoizedProps)&&e.focus());case 6:case 4:case 12:return;case 13:return void(null===n.memoizedState&&null!==(n=n.alternate)&&null!==(n=n.memoizedState)&&null!==(n=n.dehydrated)&&Ee(n));case 19:case 17:case 20:case 21:return}throw Error(r(163))}function Pr(e,t,n){switch("function"==typeof Ec&&Ec(t),t.tag){case 0:case 11:case 14:case 15:case 22:if(null!==(e=t.updateQueue)&&null!==(e=e.lastEffect)){var r=e.next;Wt(97<n?97:n,function(){var e=r;do{var n=e.destroy;if(void 0!==n){var o=t;try{n()}catch(e){uo(o,e)}}e=e.next}while(e!==r)})}break;case 1:xr(t),n=t.stateNode,"function"==typeof n.componentWillUnmount&&_r(t,n);break;case 5:xr(t);break;case 4:Rr(e,t,n)}}function Cr(e){var t=e.alternate;e.return=null,e.child=null,e.memoizedState=null,e.updateQueue=null,e.dependencies=null,e.alternate=null,e.firstEffect=null,e.lastEffect=null,e.pendingProps=null,e.memoizedProps=null,e.stateNode=null,null!==t&&Cr(t)}function jr(e){return 5===e.tag||3===e.tag||4===e.tag}function Sr(e){e:{for(var t=e.return;null!==t;){if(jr(t)){var n=t;break e}t=t.return}throw Error(r(160))}switch(t=n.stateNode,n.tag){case 5:var o=!1;break;case 3:case 4:t=t.containerInfo,o=!0;break;default:throw Error(r(161))}16&n.effectTag&&(G(t,""),n.effectTag&=-17);e:t:for(n=e;;){for(;null===n.sibling;){if(null===n.return||jr(n.return)){n=null;break e}n=n.return}for(n.sibling.return=n.return,n=n.sibling;5!==n.tag&&6!==n.tag&&18!==n.tag;){if(2&n.effectTag)continue t;if(null===n.child||4===n.tag)continue t;n.child.return=n,n=n.child}if(!(2&n.effectTag)){n=n.stateNode;break e}}o?Mr(e,n,t):Tr(e,n,t)}function Mr(e,t,n){var r=e.tag,o=5===r||6===r;if(o)e=o?e.stateNode:e.stateNode.instance,t?8===n.nodeType?n.parentNode.insertBefore(e,t):n.insertBefore(e,t):(8===n.nodeType?(t=n.parentNode,t.insertBefore(e,n)):(t=n,t.appendChild(e)),null!==(n=n._reactRootContainer)&&void 0!==n||null!==t.onclick||(t.onclick=Ie));else if(4!==r&&null!==(e=e.child))for(Mr(e,t,n),e=e.sibling;null!==e;)Mr(e,t,n),e=e.sibling}function Tr(e,t,n){var r=e.tag,o=5===r||6===r;if(o)e=o?e.stateNode:e.stateNode.instance,t?n.insertBefore(e,t):n.appendChild(e);else if(4!==r&&null!==(e=e.child))for(Tr(e,t,n),e=e.sibling;null!==e;)Tr(e,t,n),e=e.sibling}function Rr(e,t,n){for(var o,a,l=t,i=!1;;){if(!i){i=l.return;e:for(;;){if(null===i)throw Error(r(160));switch(o=i.stateNode,i.tag){case 5:a=!1;break e;case 3:case 4:o=o.containerInfo,a=!0;break e}i=i.return}i=!0}if(5===l.tag||6===l.tag){e:for(var s=e,c=l,u=n,f=c;;)if(Pr(s,f,u),null!==f.child&&4!==f.tag)f.child.return=f,f=f.child;else{if(f===c)break e;for(;null===f.sibling;){if(null===f.return||f.return===c)break e;f=f.return}f.sibling.return=f.return,f=f.sibling}a?(s=o,c=l.stateNode,8===s.nodeType?s.parentNode.removeChild(c):s.removeChild(c)):o.removeChild(l.stateNode)}else if(4===l.tag){if(null!==l.child){o=l.stateNode.containerInfo,a=!0,l.child.return=l,l=l.child;continue}}else if(Pr(e,l,n),null!==l.child){l.child.return=l,l=l.child;continue}if(l===t)break;for(;null===l.sibling;){if(null===l.return||l.return===t)return;l=l.return,4===l.tag&&(i=!1)}l.sibling.return=l.return,l=l.sibling}}function Lr(e,t){switch(t.tag){case 0:case 11:case 14:case 15:case 22:return void kr(3,t);case 1:return;case 5:var n=t.stateNode;if(null!=n){var o=t.memoizedProps,a=null!==e?e.memoizedProps:o;e=t.type;var l=t.updateQueue;if(t.updateQueue=null,null!==l){for(n[Sl]=o,"input"===e&&"radio"===o.type&&null!=o.name&&D(n,o),Ae(e,a),t=Ae(e,o),a=0;a<l.length;a+=2){var i=l[a],s=l[a+1];"style"===i?Le(n,s):"dangerouslySetInnerHTML"===i?Aa(n,s):"children"===i?G(n,s):E(n,i,s,t)}switch(e){case"input":I(n,o);break;case"textarea":V(n,o);break;case"select":t=n._wrapperState.wasMultiple,n._wrapperState.wasMultiple=!!o.multiple,e=o.value,null!=e?q(n,!!o.multiple,e,!1):t!==!!o.multiple&&(null!=o.defaultValue?q(n,!!o.multiple,o.defaultValue,!0):q(n,!!o.multiple,o.multiple?[]:"",!1))}}}return;case 6:if(null===t.stateNode)throw Error(r(162));return void(t.stateNode.nodeValue=t.memoizedProps);case 3:return t=t.stateNode,void(t.hydrate&&(t.hydrate=!1,Ee(t.containerInfo)));case 12:return;case 13:if(n=t,null===t.memoizedState?o=!1:(o=!0,n=t.child,uc=rs()),null!==n)e:for(e=n;;){if(5===e.tag)l=e.stateNode,o?(l=l.style,"function"==typeof l.setProperty?l.setProperty("display","none","important"):l.display="none"):(l=e.stateNode,a=e.memoizedProps.style,a=void 0!==a&&null!==a&&a.hasOwnProperty("display")?a.display:null,l.style.display=Re("display",a));else if(6===e.tag)e.stateNode.nodeValue=o?"":e.memoizedProps;else{if(13===e.tag&&null!==e.memoizedState&&null===e.memoizedState.dehydrated){l=e.child.sibling,l.return=e,e=l;continue}if(null!==e.child){e.child.return=e,e=e.child;continue}}if(e===n)break;for(;null===e.sibling;){if(null===e.return||e.return===n)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}return void Nr(t);case 19:return void Nr(t);case 17:return}throw Error(r(163))}function Nr(e){var t=e.updateQueue;if(null!==t){e.updateQueue=null;var n=e.stateNode;null===n&&(n=e.stateNode=new Hs),t.forEach(function(t){var r=po.bind(null,e,t);n.has(t)||(n.add(t),t.then(r,r))})}}function Ar(e,t,n){n=on(n,null),n.tag=3,n.payload={element:null};var r=t.value;return n.callback=function(){pc||(pc=!0,bc=r),yr(e,t)},n}function Dr(e,t,n){n=on(n,null),n.tag=3;var r=e.type.getDerivedStateFromError;if("function"==typeof r){var o=t.value;n.payload=function(){return yr(e,t),r(o)}}var a=e.stateNode;return null!==a&&"function"==typeof a.componentDidCatch&&(n.callback=function(){"function"!=typeof r&&(null===hc?hc=new Set([this]):hc.add(this),yr(e,t));var n=t.stack;this.componentDidCatch(t.value,{componentStack:null!==n?n:""})}),n}function Ir(){return($s&(Vs|Bs))!==Ys?1073741821-(rs()/10|0):0!==wc?wc:wc=1073741821-(rs()/10|0)}function Hr(e,t,n){if(0==(2&(t=t.mode)))return 1073741823;var o=qt();if(0==(4&t))return 99===o?1073741823:1073741822;if(($s&Vs)!==Ys)return nc;if(null!==n)e=Gt(e,0|n.timeoutMs||5e3,250);else switch(o){case 99:e=1073741823;break;case 98:e=Gt(e,150,100);break;case 97:case 96:e=Gt(e,5e3,250);break;case 95:e=2;break;default:throw Error(r(326))}return null!==ec&&e===nc&&--e,e}function Fr(e,t){if(50<_c)throw _c=0,xc=null,Error(r(185));if(null!==(e=Ur(e,t))){var n=qt();1073741823===t?($s&Ws)!==Ys&&($s&(Vs|Bs))===Ys?Wr(e):(qr(e),$s===Ys&&Kt()):qr(e),(4&$s)===Ys||98!==n&&99!==n||(null===yc?yc=new Map([[e,t]]):(void 0===(n=yc.get(e))||n>t)&&yc.set(e,t))}}function Ur(e,t){e.expirationTime<t&&(e.expirationTime=t);var n=e.alternate;null!==n&&n.expirationTime<t&&(n.expirationTime=t);var r=e.return,o=null;if(null===r&&3===e.tag)o=e.stateNode;else for(;null!==r;){if(n=r.alternate,r.childExpirationTime<t&&(r.childExpirationTime=t),null!==n&&n.childExpirationTime<t&&(n.childExpirationTime=t),null===r.return&&3===r.tag){o=r.stateNode;break}r=r.return}return null!==o&&(ec===o&&(Jr(t),rc===Zs&&Po(o,nc)),Co(o,t)),o}function zr(e){var t=e.lastExpiredTime;if(0!==t)return t;if(t=e.firstPendingTime,!Oo(e,t))return t;var n=e.lastPingedTime;return e=e.nextKnownPendingLevel,e=n>e?n:e,2>=e&&t!==e?0:e}function qr(e){if(0!==e.lastExpiredTime)e.callbackExpirationTime=1073741823,e.callbackPriority=99,e.callbackNode=Bt(Wr.bind(null,e));else{var t=zr(e),n=e.callbackNode;if(0===t)null!==n&&(e.callbackNode=null,e.callbackExpirationTime=0,e.callbackPriority=90);else{var r=Ir();if(1073741823===t?r=99:1===t||2===t?r=95:(r=10*(1073741821-t)-10*(1073741821-r),r=0>=r?99:250>=r?98:5250>=r?97:95),null!==n){var o=e.callbackPriority;if(e.callbackExpirationTime===t&&o>=r)return;n!==Qi&&zi(n)}e.callbackExpirationTime=t,e.callbackPriority=r,t=1073741823===t?Bt(Wr.bind(null,e)):Vt(r,Yr.bind(null,e),{timeout:10*(1073741821-t)-rs()}),e.callbackNode=t}}}function Yr(e,t){if(wc=0,t)return t=Ir(),jo(e,t),qr(e),null;var n=zr(e);if(0!==n){if(t=e.callbackNode,($s&(Vs|Bs))!==Ys)throw Error(r(327));if(io(),e===ec&&n===nc||Xr(e,n),null!==tc){var o=$s;$s|=Vs;for(var a=Qr();;)try{eo();break}catch(t){Gr(e,t)}if(Zt(),$s=o,zs.current=a,rc===Xs)throw t=oc,Xr(e,n),Po(e,n),qr(e),t;if(null===tc)switch(a=e.finishedWork=e.current.alternate,e.finishedExpirationTime=n,o=rc,ec=null,o){case Ks:case Xs:throw Error(r(345));case Gs:jo(e,2<n?2:n);break;case Qs:if(Po(e,n),o=e.lastSuspendedTime,n===o&&(e.nextKnownPendingLevel=ro(a)),1073741823===ac&&10<(a=uc+fc-rs())){if(cc){var l=e.lastPingedTime;if(0===l||l>=n){e.lastPingedTime=n,Xr(e,n);break}}if(0!==(l=zr(e))&&l!==n)break;if(0!==o&&o!==n){e.lastPingedTime=o;break}e.timeoutHandle=Ol(oo.bind(null,e),a);break}oo(e);break;case Zs:if(Po(e,n),o=e.lastSuspendedTime,n===o&&(e.nextKnownPendingLevel=ro(a)),cc&&(0===(a=e.lastPingedTime)||a>=n)){e.lastPingedTime=n,Xr(e,n);break}if(0!==(a=zr(e))&&a!==n)break;if(0!==o&&o!==n){e.lastPingedTime=o;break}if(1073741823!==lc?o=10*(1073741821-lc)-rs():1073741823===ac?o=0:(o=10*(1073741821-ac)-5e3,a=rs(),n=10*(1073741821-n)-a,o=a-o,0>o&&(o=0),o=(120>o?120:480>o?480:1080>o?1080:1920>o?1920:3e3>o?3e3:4320>o?4320:1960*Us(o/1960))-o,n<o&&(o=n)),10<o){e.timeoutHandle=Ol(oo.bind(null,e),o);break}oo(e);break;case Js:if(1073741823!==ac&&null!==ic){l=ac;var i=ic;if(o=0|i.busyMinDurationMs,0>=o?o=0:(a=0|i.busyDelayMs,l=rs()-(10*(1073741821-l)-(0|i.timeoutMs||5e3)),o=l<=a?0:a+o-l),10<o){Po(e,n),e.timeoutHandle=Ol(oo.bind(null,e),o);break}}oo(e);break;default:throw Error(r(329))}if(qr(e),e.callbackNode===t)return Yr.bind(null,e)}}return null}function Wr(e){var t=e.lastExpiredTime;if(t=0!==t?t:1073741823,($s&(Vs|Bs))!==Ys)throw Error(r(327));if(io(),e===ec&&t===nc||Xr(e,t),null!==tc){var n=$s;$s|=Vs;for(var o=Qr();;)try{$r();break}catch(t){Gr(e,t)}if(Zt(),$s=n,zs.current=o,rc===Xs)throw n=oc,Xr(e,t),Po(e,t),qr(e),n;if(null!==tc)throw Error(r(261));e.finishedWork=e.current.alternate,e.finishedExpirationTime=t,ec=null,oo(e),qr(e)}return null}function Vr(){if(null!==yc){var e=yc;yc=null,e.forEach(function(e,t){jo(t,e),qr(t)}),Kt()}}function Br(e,t){var n=$s;$s|=1;try{return e(t)}finally{($s=n)===Ys&&Kt()}}function Kr(e,t){var n=$s;$s&=-2,$s|=Ws;try{return e(t)}finally{($s=n)===Ys&&Kt()}}function Xr(e,t){e.finishedWork=null,e.finishedExpirationTime=0;var n=e.timeoutHandle;if(-1!==n&&(e.timeoutHandle=-1,Pl(n)),null!==tc)for(n=tc.return;null!==n;){var r=n;switch(r.tag){case 1:r=r.type.childContextTypes,null!==r&&void 0!==r&&It();break;case 3:_n(),Lt(Ii),Lt(Di);break;case 5:wn(r);break;case 4:_n();break;case 13:case 19:Lt(ys);break;case 10:Jt(r)}n=n.return}ec=e,tc=yo(e.current,null),nc=t,rc=Ks,oc=null,lc=ac=1073741823,ic=null,sc=0,cc=!1}function Gr(e,t){for(;;){try{if(Zt(),_s.current=Cs,Ps)for(var n=ks.memoizedState;null!==n;){var r=n.queue;null!==r&&(r.pending=null),n=n.next}if(ws=0,Os=Es=ks=null,Ps=!1,null===tc||null===tc.return)return rc=Xs,oc=t,tc=null;e:{var o=e,a=tc.return,l=tc,i=t;if(t=nc,l.effectTag|=2048,l.firstEffect=l.lastEffect=null,null!==i&&"object"==typeof i&&"function"==typeof i.then){var s=i;if(0==(2&l.mode)){var c=l.alternate;c?(l.updateQueue=c.updateQueue,l.memoizedState=c.memoizedState,l.expirationTime=c.expirationTime):(l.updateQueue=null,l.memoizedState=null)}var u=0!=(1&ys.current),f=a;do{var d;if(d=13===f.tag){var p=f.memoizedState;if(null!==p)d=null!==p.dehydrated;else{var b=f.memoizedProps;d=void 0!==b.fallback&&(!0!==b.unstable_avoidThisFallback||!u)}}if(d){var h=f.updateQueue;if(null===h){var m=new Set;m.add(s),f.updateQueue=m}else h.add(s);if(0==(2&f.mode)){if(f.effectTag|=64,l.effectTag&=-2981,1===l.tag)if(null===l.alternate)l.tag=17;else{var g=on(1073741823,null);g.tag=2,an(l,g)}l.expirationTime=1073741823;break e}i=void 0,l=t;var v=o.pingCache;if(null===v?(v=o.pingCache=new Fs,i=new Set,v.set(s,i)):void 0===(i=v.get(s))&&(i=new Set,v.set(s,i)),!i.has(l)){i.add(l);var y=fo.bind(null,o,s,l);s.then(y,y)}f.effectTag|=4096,f.expirationTime=t;break e}f=f.return}while(null!==f);i=Error((C(l.type)||"A React component")+" suspended while rendering, but no fallback UI was specified.\n\nAdd a <Suspense fallback=...> component higher in the tree to provide a loading indicator or placeholder to display."+j(l))}rc!==Js&&(rc=Gs),i=vr(i,l),f=a;do{switch(f.tag){case 3:s=i,f.effectTag|=4096,f.expirationTime=t;ln(f,Ar(f,s,t));break e;case 1:s=i;var _=f.type,x=f.stateNode;if(0==(64&f.effectTag)&&("function"==typeof _.getDerivedStateFromError||null!==x&&"function"==typeof x.componentDidCatch&&(null===hc||!hc.has(x)))){f.effectTag|=4096,f.expirationTime=t;ln(f,Dr(f,s,t));break e}}f=f.return}while(null!==f)}tc=no(tc)}catch(e){t=e;continue}break}}function Qr(){var e=zs.current;return zs.current=Cs,null===e?Cs:e}function Zr(e,t){e<ac&&2<e&&(ac=e),null!==t&&e<lc&&2<e&&(lc=e,ic=t)}function Jr(e){e>sc&&(sc=e)}function $r(){for(;null!==tc;)tc=to(tc)}function eo(){for(;null!==tc&&!Zi();)tc=to(tc)}function to(e){var t=Is(e.alternate,e,nc);return e.memoizedProps=e.pendingProps,null===t&&(t=no(e)),qs.current=null,t}function no(e){tc=e;do{var t=tc.alternate;if(e=tc.return,0==(2048&tc.effectTag)){if(t=mr(t,tc,nc),1===nc||1!==tc.childExpirationTime){for(var n=0,r=tc.child;null!==r;){var o=r.expirationTime,a=r.childExpirationTime;o>n&&(n=o),a>n&&(n=a),r=r.sibling}tc.childExpirationTime=n}if(null!==t)return t;null!==e&&0==(2048&e.effectTag)&&(null===e.firstEffect&&(e.firstEffect=tc.firstEffect),null!==tc.lastEffect&&(null!==e.lastEffect&&(e.lastEffect.nextEffect=tc.firstEffect),e.lastEffect=tc.lastEffect),1<tc.effectTag&&(null!==e.lastEffect?e.lastEffect.nextEffect=tc:e.firstEffect=tc,e.lastEffect=tc))}else{if(null!==(t=gr(tc)))return t.effectTag&=2047,t;null!==e&&(e.firstEffect=e.lastEffect=null,e.effectTag|=2048)}if(null!==(t=tc.sibling))return t;tc=e}while(null!==tc);return rc===Ks&&(rc=Js),null}function ro(e){var t=e.expirationTime;return e=e.childExpirationTime,t>e?t:e}function oo(e){var t=qt();return Wt(99,ao.bind(null,e,t)),null}function ao(e,t){do{io()}while(null!==gc);if(($s&(Vs|Bs))!==Ys)throw Error(r(327));var n=e.finishedWork,o=e.finishedExpirationTime;if(null===n)return null;if(e.finishedWork=null,e.finishedExpirationTime=0,n===e.current)throw Error(r(177));e.callbackNode=null,e.callbackExpirationTime=0,e.callbackPriority=90,e.nextKnownPendingLevel=0;var a=ro(n);if(e.firstPendingTime=a,o<=e.lastSuspendedTime?e.firstSuspendedTime=e.lastSuspendedTime=e.nextKnownPendingLevel=0:o<=e.firstSuspendedTime&&(e.firstSuspendedTime=o-1),o<=e.lastPingedTime&&(e.lastPingedTime=0),o<=e.lastExpiredTime&&(e.lastExpiredTime=0),e===ec&&(tc=ec=null,nc=0),1<n.effectTag?null!==n.lastEffect?(n.lastEffect.nextEffect=n,a=n.firstEffect):a=n:a=n.firstEffect,null!==a){var l=$s;$s|=Bs,qs.current=null,kl=bl;var i=qe();if(Ye(i)){if("selectionStart"in i)var s={start:i.selectionStart,end:i.selectionEnd};else e:{s=(s=i.ownerDocument)&&s.defaultView||window;var c=s.getSelection&&s.getSelection();if(c&&0!==c.rangeCount){s=c.anchorNode;var u=c.anchorOffset,f=c.focusNode;c=c.focusOffset;try{s.nodeType,f.nodeType}catch(e){s=null;break e}var d=0,p=-1,b=-1,h=0,m=0,g=i,v=null;t:for(;;){for(var y;g!==s||0!==u&&3!==g.nodeType||(p=d+u),g!==f||0!==c&&3!==g.nodeType||(b=d+c),3===g.nodeType&&(d+=g.nodeValue.length),null!==(y=g.firstChild);)v=g,g=y;for(;;){if(g===i)break t;if(v===s&&++h===u&&(p=d),v===f&&++m===c&&(b=d),null!==(y=g.nextSibling))break;g=v,v=g.parentNode}g=y}s=-1===p||-1===b?null:{start:p,end:b}}else s=null}s=s||{start:0,end:0}}else s=null;El={activeElementDetached:null,focusedElem:i,selectionRange:s},bl=!1,dc=a;do{try{lo()}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);dc=a;do{try{for(i=e,s=t;null!==dc;){var _=dc.effectTag;if(16&_&&G(dc.stateNode,""),128&_){var x=dc.alternate;if(null!==x){var w=x.ref;null!==w&&("function"==typeof w?w(null):w.current=null)}}switch(1038&_){case 2:Sr(dc),dc.effectTag&=-3;break;case 6:Sr(dc),dc.effectTag&=-3,Lr(dc.alternate,dc);break;case 1024:dc.effectTag&=-1025;break;case 1028:dc.effectTag&=-1025,Lr(dc.alternate,dc);break;case 4:Lr(dc.alternate,dc);break;case 8:u=dc,Rr(i,u,s),Cr(u)}dc=dc.nextEffect}}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);if(w=El,x=qe(),_=w.focusedElem,s=w.selectionRange,x!==_&&_&&_.ownerDocument&&ze(_.ownerDocument.documentElement,_)){null!==s&&Ye(_)&&(x=s.start,w=s.end,void 0===w&&(w=x),"selectionStart"in _?(_.selectionStart=x,_.selectionEnd=Math.min(w,_.value.length)):(w=(x=_.ownerDocument||document)&&x.defaultView||window,w.getSelection&&(w=w.getSelection(),u=_.textContent.length,i=Math.min(s.start,u),s=void 0===s.end?i:Math.min(s.end,u),!w.extend&&i>s&&(u=s,s=i,i=u),u=Ue(_,i),f=Ue(_,s),u&&f&&(1!==w.rangeCount||w.anchorNode!==u.node||w.anchorOffset!==u.offset||w.focusNode!==f.node||w.focusOffset!==f.offset)&&(x=x.createRange(),x.setStart(u.node,u.offset),w.removeAllRanges(),i>s?(w.addRange(x),w.extend(f.node,f.offset)):(x.setEnd(f.node,f.offset),w.addRange(x)))))),x=[];for(w=_;w=w.parentNode;)1===w.nodeType&&x.push({element:w,left:w.scrollLeft,top:w.scrollTop});for("function"==typeof _.focus&&_.focus(),_=0;_<x.length;_++)w=x[_],w.element.scrollLeft=w.left,w.element.scrollTop=w.top}bl=!!kl,El=kl=null,e.current=n,dc=a;do{try{for(_=e;null!==dc;){var k=dc.effectTag;if(36&k&&Or(_,dc.alternate,dc),128&k){x=void 0;var E=dc.ref;if(null!==E){var O=dc.stateNode;switch(dc.tag){case 5:x=O;break;default:x=O}"function"==typeof E?E(x):E.current=x}}dc=dc.nextEffect}}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);dc=null,Ji(),$s=l}else e.current=n;if(mc)mc=!1,gc=e,vc=t;else for(dc=a;null!==dc;)t=dc.nextEffect,dc.nextEffect=null,dc=t;if(t=e.firstPendingTime,0===t&&(hc=null),1073741823===t?e===xc?_c++:(_c=0,xc=e):_c=0,"function"==typeof kc&&kc(n.stateNode,o),qr(e),pc)throw pc=!1,e=bc,bc=null,e;return($s&Ws)!==Ys?null:(Kt(),null)}function lo(){for(;null!==dc;){var e=dc.effectTag;0!=(256&e)&&wr(dc.alternate,dc),0==(512&e)||mc||(mc=!0,Vt(97,function(){return io(),null})),dc=dc.nextEffect}}function io(){if(90!==vc){var e=97<vc?97:vc;return vc=90,Wt(e,so)}}function so(){if(null===gc)return!1;var e=gc;if(gc=null,($s&(Vs|Bs))!==Ys)throw Error(r(331));var t=$s;for($s|=Bs,e=e.current.firstEffect;null!==e;){try{var n=e;if(0!=(512&n.effectTag))switch(n.tag){case 0:case 11:case 15:case 22:kr(5,n),Er(5,n)}}catch(t){if(null===e)throw Error(r(330));uo(e,t)}n=e.nextEffect,e.nextEffect=null,e=n}return $s=t,Kt(),!0}function co(e,t,n){t=vr(n,t),t=Ar(e,t,1073741823),an(e,t),null!==(e=Ur(e,1073741823))&&qr(e)}function uo(e,t){if(3===e.tag)co(e,e,t);else for(var n=e.return;null!==n;){if(3===n.tag){co(n,e,t);break}if(1===n.tag){var r=n.stateNode;if("function"==typeof n.type.getDerivedStateFromError||"function"==typeof r.componentDidCatch&&(null===hc||!hc.has(r))){e=vr(t,e),e=Dr(n,e,1073741823),an(n,e),n=Ur(n,1073741823),null!==n&&qr(n);break}}n=n.return}}function fo(e,t,n){var r=e.pingCache;null!==r&&r.delete(t),ec===e&&nc===n?rc===Zs||rc===Qs&&1073741823===ac&&rs()-uc<fc?Xr(e,nc):cc=!0:Oo(e,n)&&(0!==(t=e.lastPingedTime)&&t<n||(e.lastPingedTime=n,qr(e)))}function po(e,t){var n=e.stateNode;null!==n&&n.delete(t),t=0,0===t&&(t=Ir(),t=Hr(t,e,null)),null!==(e=Ur(e,t))&&qr(e)}function bo(e){if("undefined"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__)return!1;var t=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(t.isDisabled||!t.supportsFiber)return!0;try{var n=t.inject(e);kc=function(e){try{t.onCommitFiberRoot(n,e,void 0,64==(64&e.current.effectTag))}catch(e){}},Ec=function(e){try{t.onCommitFiberUnmount(n,e)}catch(e){}}}catch(e){}return!0}function ho(e,t,n,r){this.tag=e,this.key=n,this.sibling=this.child=this.return=this.stateNode=this.type=this.elementType=null,this.index=0,this.ref=null,this.pendingProps=t,this.dependencies=this.memoizedState=this.updateQueue=this.memoizedProps=null,this.mode=r,this.effectTag=0,this.lastEffect=this.firstEffect=this.nextEffect=null,this.childExpirationTime=this.expirationTime=0,this.alternate=null}function mo(e,t,n,r){return new ho(e,t,n,r)}function go(e){return!(!(e=e.prototype)||!e.isReactComponent)}function vo(e){if("function"==typeof e)return go(e)?1:0;if(void 0!==e&&null!==e){if((e=e.$$typeof)===Ca)return 11;if(e===Ma)return 14}return 2}function yo(e,t){var n=e.alternate;return null===n?(n=mo(e.tag,t,e.key,e.mode),n.elementType=e.elementType,n.type=e.type,n.stateNode=e.stateNode,n.alternate=e,e.alternate=n):(n.pendingProps=t,n.effectTag=0,n.nextEffect=null,n.firstEffect=null,n.lastEffect=null),n.childExpirationTime=e.childExpirationTime,n.expirationTime=e.expirationTime,n.child=e.child,n.memoizedProps=e.memoizedProps,n.memoizedState=e.memoizedState,n.updateQueue=e.updateQueue,t=e.dependencies,n.dependencies=null===t?null:{expirationTime:t.expirationTime,firstContext:t.firstContext,responders:t.responders},n.sibling=e.sibling,n.index=e.index,n.ref=e.ref,n}function _o(e,t,n,o,a,l){var i=2;if(o=e,"function"==typeof e)go(e)&&(i=1);else if("string"==typeof e)i=5;else e:switch(e){case xa:return xo(n.children,a,l,t);case Pa:i=8,a|=7;break;case wa:i=8,a|=1;break;case ka:return e=mo(12,n,t,8|a),e.elementType=ka,e.type=ka,e.expirationTime=l,e;case ja:return e=mo(13,n,t,a),e.type=ja,e.elementType=ja,e.expirationTime=l,e;case Sa:return e=mo(19,n,t,a),e.elementType=Sa,e.expirationTime=l,e;default:if("object"==typeof e&&null!==e)switch(e.$$typeof){case Ea:i=10;break e;case Oa:i=9;break e;case Ca:i=11;break e;case Ma:i=14;break e;case Ta:i=16,o=null;break e;case Ra:i=22;break e}throw Error(r(130,null==e?e:typeof e,""))}return t=mo(i,n,t,a),t.elementType=e,t.type=o,t.expirationTime=l,t}function xo(e,t,n,r){return e=mo(7,e,r,t),e.expirationTime=n,e}function wo(e,t,n){return e=mo(6,e,null,t),e.expirationTime=n,e}function ko(e,t,n){return t=mo(4,null!==e.children?e.children:[],e.key,t),t.expirationTime=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function Eo(e,t,n){this.tag=t,this.current=null,this.containerInfo=e,this.pingCache=this.pendingChildren=null,this.finishedExpirationTime=0,this.finishedWork=null,this.timeoutHandle=-1,this.pendingContext=this.context=null,this.hydrate=n,this.callbackNode=null,this.callbackPriority=90,this.lastExpiredTime=this.lastPingedTime=this.nextKnownPendingLevel=this.lastSuspendedTime=this.firstSuspendedTime=this.firstPendingTime=0}function Oo(e,t){var n=e.firstSuspendedTime;return e=e.lastSuspendedTime,0!==n&&n>=t&&e<=t}function Po(e,t){var n=e.firstSuspendedTime,r=e.lastSuspendedTime;n<t&&(e.firstSuspendedTime=t),(r>t||0===n)&&(e.lastSuspendedTime=t),t<=e.lastPingedTime&&(e.lastPingedTime=0),t<=e.lastExpiredTime&&(e.lastExpiredTime=0)}function Co(e,t){t>e.firstPendingTime&&(e.firstPendingTime=t);var n=e.firstSuspendedTime;0!==n&&(t>=n?e.firstSuspendedTime=e.lastSuspendedTime=e.nextKnownPendingLevel=0:t>=e.lastSuspendedTime&&(e.lastSuspendedTime=t+1),t>e.nextKnownPendingLevel&&(e.nextKnownPendingLevel=t))}function jo(e,t){var n=e.lastExpiredTime;(0===n||n>t)&&(e.lastExpiredTime=t)}function So(e,t,n,o){var a=t.current,l=Ir(),i=cs.suspense;l=Hr(l,a,i);e:if(n){n=n._reactInternalFiber;t:{if($(n)!==n||1!==n.tag)throw Error(r(170));var s=n;do{switch(s.tag){case 3:s=s.stateNode.context;break t;case 1:if(Dt(s.type)){s=s.stateNode.__reactInternalMemoizedMergedChildContext;break t}}s=s.return}while(null!==s);throw Error(r(171))}if(1===n.tag){var c=n.type;if(Dt(c)){n=Ft(n,c,s);break e}}n=s}else n=Ai;return null===t.context?t.context=n:t.pendingContext=n,t=on(l,i),t.payload={element:e},o=void 0===o?null:o,null!==o&&(t.callback=o),an(a,t),Fr(a,l),l}function Mo(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:default:return e.child.stateNode}}function To(e,t){null!==(e=e.memoizedState)&&null!==e.dehydrated&&e.retryTime<t&&(e.retryTime=t)}function Ro(e,t){To(e,t),(e=e.alternate)&&To(e,t)}function Lo(e,t,n){n=null!=n&&!0===n.hydrate;var r=new Eo(e,t,n),o=mo(3,null,null,2===t?7:1===t?3:0);r.current=o,o.stateNode=r,nn(o),e[Ml]=r.current,n&&0!==t&&be(e,9===e.nodeType?e:e.ownerDocument),this._internalRoot=r}function No(e){return!(!e||1!==e.nodeType&&9!==e.nodeType&&11!==e.nodeType&&(8!==e.nodeType||" react-mount-point-unstable "!==e.nodeValue))}function Ao(e,t){if(t||(t=e?9===e.nodeType?e.documentElement:e.firstChild:null,t=!(!t||1!==t.nodeType||!t.hasAttribute("data-reactroot"))),!t)for(var n;n=e.lastChild;)e.removeChild(n);return new Lo(e,0,t?{hydrate:!0}:void 0)}function Do(e,t,n,r,o){var a=n._reactRootContainer;if(a){var l=a._internalRoot;if("function"==typeof o){var i=o;o=function(){var e=Mo(l);i.call(e)}}So(t,l,e,o)}else{if(a=n._reactRootContainer=Ao(n,r),l=a._internalRoot,"function"==typeof o){var s=o;o=function(){var e=Mo(l);s.call(e)}}Kr(function(){So(t,l,e,o)})}return Mo(l)}function Io(e,t,n){var r=3<arguments.length&&void 0!==arguments[3]?arguments[3]:null;return{$$typeof:_a,key:null==r?null:""+r,children:e,containerInfo:t,implementation:n}}function Ho(e,t){var n=2<arguments.length&&void 0!==arguments[2]?arguments[2]:null;if(!No(t))throw Error(r(200));return Io(e,t,null,n)}var Fo=n(0),Uo=n(14),zo=n(47);if(!Fo)throw Error(r(227));var qo=!1,Yo=null,Wo=!1,Vo=null,Bo={onError:function(e){qo=!0,Yo=e}},Ko=null,Xo=null,Go=null,Qo=null,Zo={},Jo=[],$o={},ea={},ta={},na=!("undefined"==typeof window||void 0===window.document||void 0===window.document.createElement),ra=null,oa=null,aa=null,la=b,ia=!1,sa=!1,ca=/^[:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD][:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD\-.0-9\u00B7\u0300-\u036F\u203F-\u2040]*$/,ua=Object.prototype.hasOwnProperty,fa={},da={},pa={};"children dangerouslySetInnerHTML defaultValue defaultChecked innerHTML suppressContentEditableWarning suppressHydrationWarning style".split(" ").forEach(function(e){pa[e]=new w(e,0,!1,e,null,!1)}),[["acceptCharset","accept-charset"],["className","class"],["htmlFor","for"],["httpEquiv","http-equiv"]].forEach(function(e){var t=e[0];pa[t]=new w(t,1,!1,e[1],null,!1)}),["contentEditable","draggable","spellCheck","value"].forEach(function(e){pa[e]=new w(e,2,!1,e.toLowerCase(),null,!1)}),["autoReverse","externalResourcesRequired","focusable","preserveAlpha"].forEach(function(e){pa[e]=new w(e,2,!1,e,null,!1)}),"allowFullScreen async autoFocus autoPlay controls default defer disabled disablePictureInPicture formNoValidate hidden loop noModule noValidate open playsInline readOnly required reversed scoped seamless itemScope".split(" ").forEach(function(e){pa[e]=new w(e,3,!1,e.toLowerCase(),null,!1)}),["checked","multiple","muted","selected"].forEach(function(e){pa[e]=new w(e,3,!0,e,null,!1)}),["capture","download"].forEach(function(e){pa[e]=new w(e,4,!1,e,null,!1)}),["cols","rows","size","span"].forEach(function(e){pa[e]=new w(e,6,!1,e,null,!1)}),["rowSpan","start"].forEach(function(e){pa[e]=new w(e,5,!1,e.toLowerCase(),null,!1)});var ba=/[\-:]([a-z])/g;"accent-height alignment-baseline arabic-form baseline-shift cap-height clip-path clip-rule color-interpolation color-interpolation-filters color-profile color-rendering dominant-baseline enable-background fill-opacity fill-rule flood-color flood-opacity font-family font-size font-size-adjust font-stretch font-style font-variant font-weight glyph-name glyph-orientation-horizontal glyph-orientation-vertical horiz-adv-x horiz-origin-x image-rendering letter-spacing lighting-color marker-end marker-mid marker-start overline-position overline-thickness paint-order panose-1 pointer-events rendering-intent shape-rendering stop-color stop-opacity strikethrough-position strikethrough-thickness stroke-dasharray stroke-dashoffset stroke-linecap stroke-linejoin stroke-miterlimit stroke-opacity stroke-width text-anchor text-decoration text-rendering underline-position underline-thickness unicode-bidi unicode-range units-per-em v-alphabetic v-hanging v-ideographic v-mathematical vector-effect vert-adv-y vert-origin-x vert-origin-y word-spacing writing-mode xmlns:xlink x-height".split(" ").forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,null,!1)}),"xlink:actuate xlink:arcrole xlink:role xlink:show xlink:title xlink:type".split(" ").forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,"http://www.w3.org/1999/xlink",!1)}),["xml:base","xml:lang","xml:space"].forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,"http://www.w3.org/XML/1998/namespace",!1)}),["tabIndex","crossOrigin"].forEach(function(e){pa[e]=new w(e,1,!1,e.toLowerCase(),null,!1)}),pa.xlinkHref=new w("xlinkHref",1,!1,"xlink:href","http://www.w3.org/1999/xlink",!0),["src","href","action","formAction"].forEach(function(e){pa[e]=new w(e,1,!1,e.toLowerCase(),null,!0)});var ha=Fo.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED;ha.hasOwnProperty("ReactCurrentDispatcher")||(ha.ReactCurrentDispatcher={current:null}),ha.hasOwnProperty("ReactCurrentBatchConfig")||(ha.ReactCurrentBatchConfig={suspense:null});var ma,ga=/^(.*)[\\\/]/,va="function"==typeof Symbol&&Symbol.for,ya=va?Symbol.for("react.element"):60103,_a=va?Symbol.for("react.portal"):60106,xa=va?Symbol.for("react.fragment"):60107,wa=va?Symbol.for("react.strict_mode"):60108,ka=va?Symbol.for("react.profiler"):60114,Ea=va?Symbol.for("react.provider"):60109,Oa=va?Symbol.for("react.context"):60110,Pa=va?Symbol.for("react.concurrent_mode"):60111,Ca=va?Symbol.for("react.forward_ref"):60112,ja=va?Symbol.for("react.suspense"):60113,Sa=va?Symbol.for("react.suspense_list"):60120,Ma=va?Symbol.for("react.memo"):60115,Ta=va?Symbol.for("react.lazy"):60116,Ra=va?Symbol.for("react.block"):60121,La="function"==typeof Symbol&&Symbol.iterator,Na={html:"http://www.w3.org/1999/xhtml",mathml:"http://www.w3.org/1998/Math/MathML",svg:"http://www.w3.org/2000/svg"},Aa=function(e){return"undefined"!=typeof MSApp&&MSApp.execUnsafeLocalFunction?function(t,n,r,o){MSApp.execUnsafeLocalFunction(function(){return e(t,n)})}:e}(function(e,t){if(e.namespaceURI!==Na.svg||"innerHTML"in e)e.innerHTML=t;else{for(ma=ma||document.createElement("div"),ma.innerHTML="<svg>"+t.valueOf().toString()+"</svg>",t=ma.firstChild;e.firstChild;)e.removeChild(e.firstChild);for(;t.firstChild;)e.appendChild(t.firstChild)}}),Da={animationend:Q("Animation","AnimationEnd"),animationiteration:Q("Animation","AnimationIteration"),animationstart:Q("Animation","AnimationStart"),transitionend:Q("Transition","TransitionEnd")},Ia={},Ha={};na&&(Ha=document.createElement("div").style,"AnimationEvent"in window||(delete Da.animationend.animation,delete Da.animationiteration.animation,delete Da.animationstart.animation),"TransitionEvent"in window||delete Da.transitionend.transition);var Fa,Ua,za,qa=Z("animationend"),Ya=Z("animationiteration"),Wa=Z("animationstart"),Va=Z("transitionend"),Ba="abort canplay canplaythrough durationchange emptied encrypted ended error loadeddata loadedmetadata loadstart pause play playing progress ratechange seeked seeking stalled suspend timeupdate volumechange waiting".split(" "),Ka=new("function"==typeof WeakMap?WeakMap:Map),Xa=null,Ga=[],Qa=!1,Za=[],Ja=null,$a=null,el=null,tl=new Map,nl=new Map,rl=[],ol="mousedown mouseup touchcancel touchend touchstart auxclick dblclick pointercancel pointerdown pointerup dragend dragstart drop compositionend compositionstart keydown keypress keyup input textInput close cancel copy cut paste click change contextmenu reset submit".split(" "),al="focus blur dragenter dragleave mouseover mouseout pointerover pointerout gotpointercapture lostpointercapture".split(" "),ll={},il=new Map,sl=new Map,cl=["abort","abort",qa,"animationEnd",Ya,"animationIteration",Wa,"animationStart","canplay","canPlay","canplaythrough","canPlayThrough","durationchange","durationChange","emptied","emptied","encrypted","encrypted","ended","ended","error","error","gotpointercapture","gotPointerCapture","load","load","loadeddata","loadedData","loadedmetadata","loadedMetadata","loadstart","loadStart","lostpointercapture","lostPointerCapture","playing","playing","progress","progress","seeking","seeking","stalled","stalled","suspend","suspend","timeupdate","timeUpdate",Va,"transitionEnd","waiting","waiting"];Oe("blur blur cancel cancel click click close close contextmenu contextMenu copy copy cut cut auxclick auxClick dblclick doubleClick dragend dragEnd dragstart dragStart drop drop focus focus input input invalid invalid keydown keyDown keypress keyPress keyup keyUp mousedown mouseDown mouseup mouseUp paste paste pause pause play play pointercancel pointerCancel pointerdown pointerDown pointerup pointerUp ratechange rateChange reset reset seeked seeked submit submit touchcancel touchCancel touchend touchEnd touchstart touchStart volumechange volumeChange".split(" "),0),Oe("drag drag dragenter dragEnter dragexit dragExit dragleave dragLeave dragover dragOver mousemove mouseMove mouseout mouseOut mouseover mouseOver pointermove pointerMove pointerout pointerOut pointerover pointerOver scroll scroll toggle toggle touchmove touchMove wheel wheel".split(" "),1),Oe(cl,2);for(var ul="change selectionchange textInput compositionstart compositionend compositionupdate".split(" "),fl=0;fl<ul.length;fl++)sl.set(ul[fl],0);var dl=zo.unstable_UserBlockingPriority,pl=zo.unstable_runWithPriority,bl=!0,hl={animationIterationCount:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridArea:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},ml=["Webkit","ms","Moz","O"];Object.keys(hl).forEach(function(e){ml.forEach(function(t){t=t+e.charAt(0).toUpperCase()+e.substring(1),hl[t]=hl[e]})});var gl=Uo({menuitem:!0},{area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0}),vl=Na.html,yl="$",_l="/$",xl="$?",wl="$!",kl=null,El=null,Ol="function"==typeof setTimeout?setTimeout:void 0,Pl="function"==typeof clearTimeout?clearTimeout:void 0,Cl=Math.random().toString(36).slice(2),jl="__reactInternalInstance$"+Cl,Sl="__reactEventHandlers$"+Cl,Ml="__reactContainere$"+Cl,Tl=null,Rl=null,Ll=null;Uo(st.prototype,{preventDefault:function(){this.defaultPrevented=!0;var e=this.nativeEvent;e&&(e.preventDefault?e.preventDefault():"unknown"!=typeof e.returnValue&&(e.returnValue=!1),this.isDefaultPrevented=lt)},stopPropagation:function(){var e=this.nativeEvent;e&&(e.stopPropagation?e.stopPropagation():"unknown"!=typeof e.cancelBubble&&(e.cancelBubble=!0),this.isPropagationStopped=lt)},persist:function(){this.isPersistent=lt},isPersistent:it,destructor:function(){var e,t=this.constructor.Interface;for(e in t)this[e]=null;this.nativeEvent=this._targetInst=this.dispatchConfig=null,this.isPropagationStopped=this.isDefaultPrevented=it,this._dispatchInstances=this._dispatchListeners=null}}),st.Interface={type:null,target:null,currentTarget:function(){return null},eventPhase:null,bubbles:null,cancelable:null,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:null,isTrusted:null},st.extend=function(e){function t(){}function n(){return r.apply(this,arguments)}var r=this;t.prototype=r.prototype;var o=new t;return Uo(o,n.prototype),n.prototype=o,n.prototype.constructor=n,n.Interface=Uo({},r.Interface,e),n.extend=r.extend,ft(n),n},ft(st);var Nl=st.extend({data:null}),Al=st.extend({data:null}),Dl=[9,13,27,32],Il=na&&"CompositionEvent"in window,Hl=null;na&&"documentMode"in document&&(Hl=document.documentMode);var Fl=na&&"TextEvent"in window&&!Hl,Ul=na&&(!Il||Hl&&8<Hl&&11>=Hl),zl=String.fromCharCode(32),ql={beforeInput:{phasedRegistrationNames:{bubbled:"onBeforeInput",captured:"onBeforeInputCapture"},dependencies:["compositionend","keypress","textInput","paste"]},compositionEnd:{phasedRegistrationNames:{bubbled:"onCompositionEnd",captured:"onCompositionEndCapture"},dependencies:"blur compositionend keydown keypress keyup mousedown".split(" ")},compositionStart:{phasedRegistrationNames:{bubbled:"onCompositionStart",captured:"onCompositionStartCapture"},dependencies:"blur compositionstart keydown keypress keyup mousedown".split(" ")},compositionUpdate:{phasedRegistrationNames:{bubbled:"onCompositionUpdate",captured:"onCompositionUpdateCapture"},dependencies:"blur compositionupdate keydown keypress keyup mousedown".split(" ")}},Yl=!1,Wl=!1,Vl={eventTypes:ql,extractEvents:function(e,t,n,r){var o;if(Il)e:{switch(e){case"compositionstart":var a=ql.compositionStart;break e;case"compositionend":a=ql.compositionEnd;break e;case"compositionupdate":a=ql.compositionUpdate;break e}a=void 0}else Wl?dt(e,n)&&(a=ql.compositionEnd):"keydown"===e&&229===n.keyCode&&(a=ql.compositionStart);return a?(Ul&&"ko"!==n.locale&&(Wl||a!==ql.compositionStart?a===ql.compositionEnd&&Wl&&(o=at()):(Tl=r,Rl="value"in Tl?Tl.value:Tl.textContent,Wl=!0)),a=Nl.getPooled(a,t,n,r),o?a.data=o:null!==(o=pt(n))&&(a.data=o),ot(a),o=a):o=null,(e=Fl?bt(e,n):ht(e,n))?(t=Al.getPooled(ql.beforeInput,t,n,r),t.data=e,ot(t)):t=null,null===o?t:null===t?o:[o,t]}},Bl={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0},Kl={change:{phasedRegistrationNames:{bubbled:"onChange",captured:"onChangeCapture"},dependencies:"blur change click focus input keydown keyup selectionchange".split(" ")}},Xl=null,Gl=null,Ql=!1;na&&(Ql=ce("input")&&(!document.documentMode||9<document.documentMode));var Zl={eventTypes:Kl,_isInputEventSupported:Ql,extractEvents:function(e,t,n,r){var o=t?Qe(t):window,a=o.nodeName&&o.nodeName.toLowerCase();if("select"===a||"input"===a&&"file"===o.type)var l=_t;else if(mt(o))if(Ql)l=Pt;else{l=Et;var i=kt}else(a=o.nodeName)&&"input"===a.toLowerCase()&&("checkbox"===o.type||"radio"===o.type)&&(l=Ot);if(l&&(l=l(e,t)))return gt(l,n,r);i&&i(e,o,t),"blur"===e&&(e=o._wrapperState)&&e.controlled&&"number"===o.type&&F(o,"number",o.value)}},Jl=st.extend({view:null,detail:null}),$l={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"},ei=0,ti=0,ni=!1,ri=!1,oi=Jl.extend({screenX:null,screenY:null,clientX:null,clientY:null,pageX:null,pageY:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,getModifierState:jt,button:null,buttons:null,relatedTarget:function(e){return e.relatedTarget||(e.fromElement===e.srcElement?e.toElement:e.fromElement)},movementX:function(e){if("movementX"in e)return e.movementX;var t=ei;return ei=e.screenX,ni?"mousemove"===e.type?e.screenX-t:0:(ni=!0,0)},movementY:function(e){if("movementY"in e)return e.movementY;var t=ti;return ti=e.screenY,ri?"mousemove"===e.type?e.screenY-t:0:(ri=!0,0)}}),ai=oi.extend({pointerId:null,width:null,height:null,pressure:null,tangentialPressure:null,tiltX:null,tiltY:null,twist:null,pointerType:null,isPrimary:null}),li={mouseEnter:{registrationName:"onMouseEnter",dependencies:["mouseout","mouseover"]},mouseLeave:{registrationName:"onMouseLeave",dependencies:["mouseout","mouseover"]},pointerEnter:{registrationName:"onPointerEnter",dependencies:["pointerout","pointerover"]},pointerLeave:{registrationName:"onPointerLeave",dependencies:["pointerout","pointerover"]}},ii={eventTypes:li,extractEvents:function(e,t,n,r,o){var a="mouseover"===e||"pointerover"===e,l="mouseout"===e||"pointerout"===e;if(a&&0==(32&o)&&(n.relatedTarget||n.fromElement)||!l&&!a)return null;if(a=r.window===r?r:(a=r.ownerDocument)?a.defaultView||a.parentWindow:window,l){if(l=t,null!==(t=(t=n.relatedTarget||n.toElement)?Xe(t):null)){var i=$(t);(t!==i||5!==t.tag&&6!==t.tag)&&(t=null)}}else l=null;if(l===t)return null;if("mouseout"===e||"mouseover"===e)var s=oi,c=li.mouseLeave,u=li.mouseEnter,f="mouse";else"pointerout"!==e&&"pointerover"!==e||(s=ai,c=li.pointerLeave,u=li.pointerEnter,f="pointer");if(e=null==l?a:Qe(l),a=null==t?a:Qe(t),c=s.getPooled(c,l,n,r),c.type=f+"leave",c.target=e,c.relatedTarget=a,n=s.getPooled(u,t,n,r),n.type=f+"enter",n.target=a,n.relatedTarget=e,r=l,f=t,r&&f)e:{for(s=r,u=f,l=0,e=s;e;e=Je(e))l++;for(e=0,t=u;t;t=Je(t))e++;for(;0<l-e;)s=Je(s),l--;for(;0<e-l;)u=Je(u),e--;for(;l--;){if(s===u||s===u.alternate)break e;s=Je(s),u=Je(u)}s=null}else s=null;for(u=s,s=[];r&&r!==u&&(null===(l=r.alternate)||l!==u);)s.push(r),r=Je(r);for(r=[];f&&f!==u&&(null===(l=f.alternate)||l!==u);)r.push(f),f=Je(f);for(f=0;f<s.length;f++)nt(s[f],"bubbled",c);for(f=r.length;0<f--;)nt(r[f],"captured",n);return 0==(64&o)?[c]:[c,n]}},si="function"==typeof Object.is?Object.is:St,ci=Object.prototype.hasOwnProperty,ui=na&&"documentMode"in document&&11>=document.documentMode,fi={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:"blur contextmenu dragend focus keydown keyup mousedown mouseup selectionchange".split(" ")}},di=null,pi=null,bi=null,hi=!1,mi={eventTypes:fi,extractEvents:function(e,t,n,r,o,a){if(o=a||(r.window===r?r.document:9===r.nodeType?r:r.ownerDocument),!(a=!o)){e:{o=J(o),a=ta.onSelect;for(var l=0;l<a.length;l++)if(!o.has(a[l])){o=!1;break e}o=!0}a=!o}if(a)return null;switch(o=t?Qe(t):window,e){case"focus":(mt(o)||"true"===o.contentEditable)&&(di=o,pi=t,bi=null);break;case"blur":bi=pi=di=null;break;case"mousedown":hi=!0;break;case"contextmenu":case"mouseup":case"dragend":return hi=!1,Tt(n,r);case"selectionchange":if(ui)break;case"keydown":case"keyup":return Tt(n,r)}return null}},gi=st.extend({animationName:null,elapsedTime:null,pseudoElement:null}),vi=st.extend({clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),yi=Jl.extend({relatedTarget:null}),_i={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},xi={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"},wi=Jl.extend({key:function(e){if(e.key){var t=_i[e.key]||e.key;if("Unidentified"!==t)return t}return"keypress"===e.type?(e=Rt(e),13===e?"Enter":String.fromCharCode(e)):"keydown"===e.type||"keyup"===e.type?xi[e.keyCode]||"Unidentified":""},location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:jt,charCode:function(e){return"keypress"===e.type?Rt(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?Rt(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}}),ki=oi.extend({dataTransfer:null}),Ei=Jl.extend({touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:jt}),Oi=st.extend({propertyName:null,elapsedTime:null,pseudoElement:null}),Pi=oi.extend({deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null}),Ci={eventTypes:ll,extractEvents:function(e,t,n,r){var o=il.get(e);if(!o)return null;switch(e){case"keypress":if(0===Rt(n))return null;case"keydown":case"keyup":e=wi;break;case"blur":case"focus":e=yi;break;case"click":if(2===n.button)return null;case"auxclick":case"dblclick":case"mousedown":case"mousemove":case"mouseup":case"mouseout":case"mouseover":case"contextmenu":e=oi;break;case"drag":case"dragend":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"dragstart":case"drop":e=ki;break;case"touchcancel":case"touchend":case"touchmove":case"touchstart":e=Ei;break;case qa:case Ya:case Wa:e=gi;break;case Va:e=Oi;break;case"scroll":e=Jl;break;case"wheel":e=Pi;break;case"copy":case"cut":case"paste":e=vi;break;case"gotpointercapture":case"lostpointercapture":case"pointercancel":case"pointerdown":case"pointermove":case"pointerout":case"pointerover":case"pointerup":e=ai;break;default:e=st}return t=e.getPooled(o,t,n,r),ot(t),t}};if(Qo)throw Error(r(101));Qo=Array.prototype.slice.call("ResponderEventPlugin SimpleEventPlugin EnterLeaveEventPlugin ChangeEventPlugin SelectEventPlugin BeforeInputEventPlugin".split(" ")),s();var ji=Ge;Ko=Ze,Xo=ji,Go=Qe,u({SimpleEventPlugin:Ci,EnterLeaveEventPlugin:ii,ChangeEventPlugin:Zl,SelectEventPlugin:mi,BeforeInputEventPlugin:Vl});var Si,Mi,Ti,Ri,Li=[],Ni=-1,Ai={},Di={current:Ai},Ii={current:!1},Hi=Ai,Fi=zo.unstable_runWithPriority,Ui=zo.unstable_scheduleCallback,zi=zo.unstable_cancelCallback,qi=zo.unstable_requestPaint,Yi=zo.unstable_now,Wi=zo.unstable_getCurrentPriorityLevel,Vi=zo.unstable_ImmediatePriority,Bi=zo.unstable_UserBlockingPriority,Ki=zo.unstable_NormalPriority,Xi=zo.unstable_LowPriority,Gi=zo.unstable_IdlePriority,Qi={},Zi=zo.unstable_shouldYield,Ji=void 0!==qi?qi:function(){},$i=null,es=null,ts=!1,ns=Yi(),rs=1e4>ns?Yi:function(){return Yi()-ns},os={current:null},as=null,ls=null,is=null,ss=!1,cs=ha.ReactCurrentBatchConfig,us=(new Fo.Component).refs,fs={isMounted:function(e){return!!(e=e._reactInternalFiber)&&$(e)===e},enqueueSetState:function(e,t,n){e=e._reactInternalFiber;var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o=on(r,o),o.payload=t,void 0!==n&&null!==n&&(o.callback=n),an(e,o),Fr(e,r)},enqueueReplaceState:function(e,t,n){e=e._reactInternalFiber;var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o=on(r,o),o.tag=1,o.payload=t,void 0!==n&&null!==n&&(o.callback=n),an(e,o),Fr(e,r)},enqueueForceUpdate:function(e,t){e=e._reactInternalFiber;var n=Ir(),r=cs.suspense;n=Hr(n,e,r),r=on(n,r),r.tag=2,void 0!==t&&null!==t&&(r.callback=t),an(e,r),Fr(e,n)}},ds=Array.isArray,ps=gn(!0),bs=gn(!1),hs={},ms={current:hs},gs={current:hs},vs={current:hs},ys={current:0},_s=ha.ReactCurrentDispatcher,xs=ha.ReactCurrentBatchConfig,ws=0,ks=null,Es=null,Os=null,Ps=!1,Cs={readContext:tn,useCallback:On,useContext:On,useEffect:On,useImperativeHandle:On,useLayoutEffect:On,useMemo:On,useReducer:On,useRef:On,useState:On,useDebugValue:On,useResponder:On,useDeferredValue:On,useTransition:On},js={readContext:tn,useCallback:Wn,useContext:tn,useEffect:Hn,useImperativeHandle:function(e,t,n){return n=null!==n&&void 0!==n?n.concat([e]):null,Dn(4,2,zn.bind(null,t,e),n)},useLayoutEffect:function(e,t){return Dn(4,2,e,t)},useMemo:function(e,t){var n=jn();return t=void 0===t?null:t,e=e(),n.memoizedState=[e,t],e},useReducer:function(e,t,n){var r=jn();return t=void 0!==n?n(t):t,r.memoizedState=r.baseState=t,e=r.queue={pending:null,dispatch:null,lastRenderedReducer:e,lastRenderedState:t},e=e.dispatch=Xn.bind(null,ks,e),[r.memoizedState,e]},useRef:function(e){var t=jn();return e={current:e},t.memoizedState=e},useState:Ln,useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Ln(e),r=n[0],o=n[1];return Hn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Ln(!1),n=t[0];return t=t[1],[Wn(Kn.bind(null,t,e),[t,e]),n]}},Ss={readContext:tn,useCallback:Vn,useContext:tn,useEffect:Fn,useImperativeHandle:qn,useLayoutEffect:Un,useMemo:Bn,useReducer:Tn,useRef:An,useState:function(){return Tn(Mn)},useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Tn(Mn),r=n[0],o=n[1];return Fn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Tn(Mn),n=t[0];return t=t[1],[Vn(Kn.bind(null,t,e),[t,e]),n]}},Ms={readContext:tn,useCallback:Vn,useContext:tn,useEffect:Fn,useImperativeHandle:qn,useLayoutEffect:Un,useMemo:Bn,useReducer:Rn,useRef:An,useState:function(){return Rn(Mn)},useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Rn(Mn),r=n[0],o=n[1];return Fn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Rn(Mn),n=t[0];return t=t[1],[Vn(Kn.bind(null,t,e),[t,e]),n]}},Ts=null,Rs=null,Ls=!1,Ns=ha.ReactCurrentOwner,As=!1,Ds={dehydrated:null,retryTime:0};Si=function(e,t){for(var n=t.child;null!==n;){if(5===n.tag||6===n.tag)e.appendChild(n.stateNode);else if(4!==n.tag&&null!==n.child){n.child.return=n,n=n.child;continue}if(n===t)break;for(;null===n.sibling;){if(null===n.return||n.return===t)return;n=n.return}n.sibling.return=n.return,n=n.sibling}},Mi=function(){},Ti=function(e,t,n,r,o){var a=e.memoizedProps;if(a!==r){var l=t.stateNode;switch(vn(ms.current),e=null,n){case"input":a=N(l,a),r=N(l,r),e=[];break;case"option":a=z(l,a),r=z(l,r),e=[];break;case"select":a=Uo({},a,{value:void 0}),r=Uo({},r,{value:void 0}),e=[];break;case"textarea":a=Y(l,a),r=Y(l,r),e=[];break;default:"function"!=typeof a.onClick&&"function"==typeof r.onClick&&(l.onclick=Ie)}Ne(n,r);var i,s;n=null;for(i in a)if(!r.hasOwnProperty(i)&&a.hasOwnProperty(i)&&null!=a[i])if("style"===i)for(s in l=a[i])l.hasOwnProperty(s)&&(n||(n={}),n[s]="");else"dangerouslySetInnerHTML"!==i&&"children"!==i&&"suppressContentEditableWarning"!==i&&"suppressHydrationWarning"!==i&&"autoFocus"!==i&&(ea.hasOwnProperty(i)?e||(e=[]):(e=e||[]).push(i,null));for(i in r){var c=r[i];if(l=null!=a?a[i]:void 0,r.hasOwnProperty(i)&&c!==l&&(null!=c||null!=l))if("style"===i)if(l){for(s in l)!l.hasOwnProperty(s)||c&&c.hasOwnProperty(s)||(n||(n={}),n[s]="");for(s in c)c.hasOwnProperty(s)&&l[s]!==c[s]&&(n||(n={}),n[s]=c[s])}else n||(e||(e=[]),e.push(i,n)),n=c;else"dangerouslySetInnerHTML"===i?(c=c?c.__html:void 0,l=l?l.__html:void 0,null!=c&&l!==c&&(e=e||[]).push(i,c)):"children"===i?l===c||"string"!=typeof c&&"number"!=typeof c||(e=e||[]).push(i,""+c):"suppressContentEditableWarning"!==i&&"suppressHydrationWarning"!==i&&(ea.hasOwnProperty(i)?(null!=c&&De(o,i),e||l===c||(e=[])):(e=e||[]).push(i,c))}n&&(e=e||[]).push("style",n),o=e,(t.updateQueue=o)&&(t.effectTag|=4)}},Ri=function(e,t,n,r){n!==r&&(t.effectTag|=4)};var Is,Hs="function"==typeof WeakSet?WeakSet:Set,Fs="function"==typeof WeakMap?WeakMap:Map,Us=Math.ceil,zs=ha.ReactCurrentDispatcher,qs=ha.ReactCurrentOwner,Ys=0,Ws=8,Vs=16,Bs=32,Ks=0,Xs=1,Gs=2,Qs=3,Zs=4,Js=5,$s=Ys,ec=null,tc=null,nc=0,rc=Ks,oc=null,ac=1073741823,lc=1073741823,ic=null,sc=0,cc=!1,uc=0,fc=500,dc=null,pc=!1,bc=null,hc=null,mc=!1,gc=null,vc=90,yc=null,_c=0,xc=null,wc=0;Is=function(e,t,n){var o=t.expirationTime;if(null!==e){var a=t.pendingProps;if(e.memoizedProps!==a||Ii.current)As=!0;else{if(o<n){switch(As=!1,t.tag){case 3:cr(t),er();break;case 5:if(xn(t),4&t.mode&&1!==n&&a.hidden)return t.expirationTime=t.childExpirationTime=1,null;break;case 1:Dt(t.type)&&Ut(t);break;case 4:yn(t,t.stateNode.containerInfo);break;case 10:o=t.memoizedProps.value,a=t.type._context,Nt(os,a._currentValue),a._currentValue=o;break;case 13:if(null!==t.memoizedState)return 0!==(o=t.child.childExpirationTime)&&o>=n?ur(e,t,n):(Nt(ys,1&ys.current),t=br(e,t,n),null!==t?t.sibling:null);Nt(ys,1&ys.current);break;case 19:if(o=t.childExpirationTime>=n,0!=(64&e.effectTag)){if(o)return pr(e,t,n);t.effectTag|=64}if(a=t.memoizedState,null!==a&&(a.rendering=null,a.tail=null),Nt(ys,ys.current),!o)return null}return br(e,t,n)}As=!1}}else As=!1;switch(t.expirationTime=0,t.tag){case 2:if(o=t.type,null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),e=t.pendingProps,a=At(t,Di.current),en(t,n),a=Cn(null,t,o,e,a,n),t.effectTag|=1,"object"==typeof a&&null!==a&&"function"==typeof a.render&&void 0===a.$$typeof){if(t.tag=1,t.memoizedState=null,t.updateQueue=null,Dt(o)){var l=!0;Ut(t)}else l=!1;t.memoizedState=null!==a.state&&void 0!==a.state?a.state:null,nn(t);var i=o.getDerivedStateFromProps;"function"==typeof i&&un(t,o,i,e),a.updater=fs,t.stateNode=a,a._reactInternalFiber=t,bn(t,o,e,n),t=sr(null,t,o,!0,l,n)}else t.tag=0,tr(null,t,a,n),t=t.child;return t;case 16:e:{if(a=t.elementType,null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),e=t.pendingProps,P(a),1!==a._status)throw a._result;switch(a=a._result,t.type=a,l=t.tag=vo(a),e=Qt(a,e),l){case 0:t=lr(null,t,a,e,n);break e;case 1:t=ir(null,t,a,e,n);break e;case 11:t=nr(null,t,a,e,n);break e;case 14:t=rr(null,t,a,Qt(a.type,e),o,n);break e}throw Error(r(306,a,""))}return t;case 0:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),lr(e,t,o,a,n);case 1:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),ir(e,t,o,a,n);case 3:if(cr(t),o=t.updateQueue,null===e||null===o)throw Error(r(282));if(o=t.pendingProps,a=t.memoizedState,a=null!==a?a.element:null,rn(e,t),sn(t,o,null,n),(o=t.memoizedState.element)===a)er(),t=br(e,t,n);else{if((a=t.stateNode.hydrate)&&(Rs=Be(t.stateNode.containerInfo.firstChild),Ts=t,a=Ls=!0),a)for(n=bs(t,null,o,n),t.child=n;n;)n.effectTag=-3&n.effectTag|1024,n=n.sibling;else tr(e,t,o,n),er();t=t.child}return t;case 5:return xn(t),null===e&&Zn(t),o=t.type,a=t.pendingProps,l=null!==e?e.memoizedProps:null,i=a.children,Ve(o,a)?i=null:null!==l&&Ve(o,l)&&(t.effectTag|=16),ar(e,t),4&t.mode&&1!==n&&a.hidden?(t.expirationTime=t.childExpirationTime=1,t=null):(tr(e,t,i,n),t=t.child),t;case 6:return null===e&&Zn(t),null;case 13:return ur(e,t,n);case 4:return yn(t,t.stateNode.containerInfo),o=t.pendingProps,null===e?t.child=ps(t,null,o,n):tr(e,t,o,n),t.child;case 11:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),nr(e,t,o,a,n);case 7:return tr(e,t,t.pendingProps,n),t.child;case 8:case 12:return tr(e,t,t.pendingProps.children,n),t.child;case 10:e:{o=t.type._context,a=t.pendingProps,i=t.memoizedProps,l=a.value;var s=t.type._context;if(Nt(os,s._currentValue),s._currentValue=l,null!==i)if(s=i.value,0===(l=si(s,l)?0:0|("function"==typeof o._calculateChangedBits?o._calculateChangedBits(s,l):1073741823))){if(i.children===a.children&&!Ii.current){t=br(e,t,n);break e}}else for(null!==(s=t.child)&&(s.return=t);null!==s;){var c=s.dependencies;if(null!==c){i=s.child;for(var u=c.firstContext;null!==u;){if(u.context===o&&0!=(u.observedBits&l)){1===s.tag&&(u=on(n,null),u.tag=2,an(s,u)),s.expirationTime<n&&(s.expirationTime=n),u=s.alternate,null!==u&&u.expirationTime<n&&(u.expirationTime=n),$t(s.return,n),c.expirationTime<n&&(c.expirationTime=n);break}u=u.next}}else i=10===s.tag&&s.type===t.type?null:s.child;if(null!==i)i.return=s;else for(i=s;null!==i;){if(i===t){i=null;break}if(null!==(s=i.sibling)){s.return=i.return,i=s;break}i=i.return}s=i}tr(e,t,a.children,n),t=t.child}return t;case 9:return a=t.type,l=t.pendingProps,o=l.children,en(t,n),a=tn(a,l.unstable_observedBits),o=o(a),t.effectTag|=1,tr(e,t,o,n),t.child;case 14:return a=t.type,l=Qt(a,t.pendingProps),l=Qt(a.type,l),rr(e,t,a,l,o,n);case 15:return or(e,t,t.type,t.pendingProps,o,n);case 17:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),t.tag=1,Dt(o)?(e=!0,Ut(t)):e=!1,en(t,n),dn(t,o,a),bn(t,o,a,n),sr(null,t,o,!0,e,n);case 19:return pr(e,t,n)}throw Error(r(156,t.tag))};var kc=null,Ec=null;Lo.prototype.render=function(e){So(e,this._internalRoot,null,null)},Lo.prototype.unmount=function(){var e=this._internalRoot,t=e.containerInfo;So(null,e,null,function(){t[Ml]=null})},Fa=function(e){if(13===e.tag){var t=Gt(Ir(),150,100);Fr(e,t),Ro(e,t)}},Ua=function(e){13===e.tag&&(Fr(e,3),Ro(e,3))},za=function(e){if(13===e.tag){var t=Ir();t=Hr(t,e,null),Fr(e,t),Ro(e,t)}},ra=function(e,t,n){switch(t){case"input":if(I(e,n),t=n.name,"radio"===n.type&&null!=t){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var o=n[t];if(o!==e&&o.form===e.form){var a=Ze(o);if(!a)throw Error(r(90));L(o),I(o,a)}}}break;case"textarea":V(e,n);break;case"select":null!=(t=n.value)&&q(e,!!n.multiple,t,!1)}},b=Br,h=function(e,t,n,r,o){var a=$s;$s|=4;try{return Wt(98,e.bind(null,t,n,r,o))}finally{($s=a)===Ys&&Kt()}},m=function(){($s&(1|Vs|Bs))===Ys&&(Vr(),io())},la=function(e,t){var n=$s;$s|=2;try{return e(t)}finally{($s=n)===Ys&&Kt()}};var Oc={Events:[Ge,Qe,Ze,u,$o,ot,function(e){ae(e,rt)},d,p,Me,ie,io,{current:!1}]};!function(e){var t=e.findFiberByHostInstance;bo(Uo({},e,{overrideHookState:null,overrideProps:null,setSuspenseHandler:null,scheduleUpdate:null,currentDispatcherRef:ha.ReactCurrentDispatcher,findHostInstanceByFiber:function(e){return e=re(e),null===e?null:e.stateNode},findFiberByHostInstance:function(e){return t?t(e):null},findHostInstancesForRefresh:null,scheduleRefresh:null,scheduleRoot:null,setRefreshHandler:null,getCurrentFiber:null}))}({findFiberByHostInstance:Xe,bundleType:0,version:"16.14.0",rendererPackageName:"react-dom"}),t.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=Oc,t.createPortal=Ho,t.findDOMNode=function(e){if(null==e)return null;if(1===e.nodeType)return e;var t=e._reactInternalFiber;if(void 0===t){if("function"==typeof e.render)throw Error(r(188));throw Error(r(268,Object.keys(e)))}return e=re(t),e=null===e?null:e.stateNode},t.flushSync=function(e,t){if(($s&(Vs|Bs))!==Ys)throw Error(r(187));var n=$s;$s|=1;try{return Wt(99,e.bind(null,t))}finally{$s=n,Kt()}},t.hydrate=function(e,t,n){if(!No(t))throw Error(r(200));return Do(null,e,t,!0,n)},t.render=function(e,t,n){if(!No(t))throw Error(r(200));return Do(null,e,t,!1,n)},t.unmountComponentAtNode=function(e){if(!No(e))throw Error(r(40));return!!e._reactRootContainer&&(Kr(function(){Do(null,null,e,!1,function(){e._reactRootContainer=null,e[Ml]=null})}),!0)},t.unstable_batchedUpdates=Br,t.unstable_createPortal=function(e,t){return Ho(e,t,2<arguments.length&&void 0!==arguments[2]?arguments[2]:null)},t.unstable_renderSubtreeIntoContainer=function(e,t,n,o){if(!No(n))throw Error(r(200));if(null==e||void 0===e._reactInternalFiber)throw Error(r(38));return Do(e,t,n,!1,o)},t.version="16.14.0"},function(e,t,n){"use strict";e.exports=n(48)},function(e,t,n){"use strict";function r(e,t){var n=e.length;e.push(t);e:for(;;){var r=n-1>>>1,o=e[r];if(!(void 0!==o&&0<l(o,t)))break e;e[r]=t,e[n]=o,n=r}}function o(e){return e=e[0],void 0===e?null:e}function a(e){var t=e[0];if(void 0!==t){var n=e.pop();if(n!==t){e[0]=n;e:for(var r=0,o=e.length;r<o;){var a=2*(r+1)-1,i=e[a],s=a+1,c=e[s];if(void 0!==i&&0>l(i,n))void 0!==c&&0>l(c,i)?(e[r]=c,e[s]=n,r=s):(e[r]=i,e[a]=n,r=a);else{if(!(void 0!==c&&0>l(c,n)))break e;e[r]=c,e[s]=n,r=s}}}return t}return null}function l(e,t){var n=e.sortIndex-t.sortIndex;return 0!==n?n:e.id-t.id}function i(e){for(var t=o(N);null!==t;){if(null===t.callback)a(N);else{if(!(t.startTime<=e))break;a(N),t.sortIndex=t.expirationTime,r(L,t)}t=o(N)}}function s(e){if(U=!1,i(e),!F)if(null!==o(L))F=!0,f(c);else{var t=o(N);null!==t&&d(s,t.startTime-e)}}function c(e,n){F=!1,U&&(U=!1,p()),H=!0;var r=I;try{for(i(n),D=o(L);null!==D&&(!(D.expirationTime>n)||e&&!b());){var l=D.callback;if(null!==l){D.callback=null,I=D.priorityLevel;var c=l(D.expirationTime<=n);n=t.unstable_now(),"function"==typeof c?D.callback=c:D===o(L)&&a(L),i(n)}else a(L);D=o(L)}if(null!==D)var u=!0;else{var f=o(N);null!==f&&d(s,f.startTime-n),u=!1}return u}finally{D=null,I=r,H=!1}}function u(e){switch(e){case 1:return-1;case 2:return 250;case 5:return 1073741823;case 4:return 1e4;default:return 5e3}}var f,d,p,b,h;if("undefined"==typeof window||"function"!=typeof MessageChannel){var m=null,g=null,v=function(){if(null!==m)try{var e=t.unstable_now();m(!0,e),m=null}catch(e){throw setTimeout(v,0),e}},y=Date.now();t.unstable_now=function(){return Date.now()-y},f=function(e){null!==m?setTimeout(f,0,e):(m=e,setTimeout(v,0))},d=function(e,t){g=setTimeout(e,t)},p=function(){clearTimeout(g)},b=function(){return!1},h=t.unstable_forceFrameRate=function(){}}else{var _=window.performance,x=window.Date,w=window.setTimeout,k=window.clearTimeout;if("undefined"!=typeof console){var E=window.cancelAnimationFrame;"function"!=typeof window.requestAnimationFrame&&console.error("This browser doesn't support requestAnimationFrame. Make sure that you load a polyfill in older browsers. https://fb.me/react-polyfills"),"function"!=typeof E&&console.error("This browser doesn't support cancelAnimationFrame. Make sure that you load a polyfill in older browsers. https://fb.me/react-polyfills")}if("object"==typeof _&&"function"==typeof _.now)t.unstable_now=function(){return _.now()};else{var O=x.now();t.unstable_now=function(){return x.now()-O}}var P=!1,C=null,j=-1,S=5,M=0;b=function(){return t.unstable_now()>=M},h=function(){},t.unstable_forceFrameRate=function(e){0>e||125<e?console.error("forceFrameRate takes a positive int between 0 and 125, forcing framerates higher than 125 fps is not unsupported"):S=0<e?Math.floor(1e3/e):5};var T=new MessageChannel,R=T.port2;T.port1.onmessage=function(){if(null!==C){var e=t.unstable_now();M=e+S;try{C(!0,e)?R.postMessage(null):(P=!1,C=null)}catch(e){throw R.postMessage(null),e}}else P=!1},f=function(e){C=e,P||(P=!0,R.postMessage(null))},d=function(e,n){j=w(function(){e(t.unstable_now())},n)},p=function(){k(j),j=-1}}var L=[],N=[],A=1,D=null,I=3,H=!1,F=!1,U=!1,z=h;t.unstable_IdlePriority=5,t.unstable_ImmediatePriority=1,t.unstable_LowPriority=4,t.unstable_NormalPriority=3,t.unstable_Profiling=null,t.unstable_UserBlockingPriority=2,t.unstable_cancelCallback=function(e){e.callback=null},t.unstable_continueExecution=function(){F||H||(F=!0,f(c))},t.unstable_getCurrentPriorityLevel=function(){return I},t.unstable_getFirstCallbackNode=function(){return o(L)},t.unstable_next=function(e){switch(I){case 1:case 2:case 3:var t=3;break;default:t=I}var n=I;I=t;try{return e()}finally{I=n}},t.unstable_pauseExecution=function(){},t.unstable_requestPaint=z,t.unstable_runWithPriority=function(e,t){switch(e){case 1:case 2:case 3:case 4:case 5:break;default:e=3}var n=I;I=e;try{return t()}finally{I=n}},t.unstable_scheduleCallback=function(e,n,a){var l=t.unstable_now();if("object"==typeof a&&null!==a){var i=a.delay;i="number"==typeof i&&0<i?l+i:l,a="number"==typeof a.timeout?a.timeout:u(e)}else a=u(e),i=l;return a=i+a,e={id:A++,callback:n,priorityLevel:e,startTime:i,expirationTime:a,sortIndex:-1},i>l?(e.sortIndex=i,r(N,e),null===o(L)&&e===o(N)&&(U?p():U=!0,d(s,i-l))):(e.sortIndex=a,r(L,e),F||H||(F=!0,f(c))),e},t.unstable_shouldYield=function(){var e=t.unstable_now();i(e);var n=o(L);return n!==D&&null!==D&&null!==n&&null!==n.callback&&n.startTime<=e&&n.expirationTime<D.expirationTime||b()},t.unstable_wrapCallback=function(e){var t=I;return function(){var n=I;I=t;try{return e.apply(this,arguments)}finally{I=n}}}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(15),p=r(d),b=n(50),h=r(b),m=n(8),g=r(m),v=n(16),y=r(v),_=n(9),x=r(_),w=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"parseJson",value:function(e){if(0===e.length)return null;try{return JSON.parse(e)}catch(t){return{parse_error:"not a valid JSON",value:e}}}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Started At"),c.default.createElement("th",null,"Check-in At"),c.default.createElement("th",null,"Check-in")),this.props.worker.map(function(t){return c.default.createElement("tr",{key:t.worker_id},c.default.createElement("td",null,t.job_name),c.default.createElement("td",null,c.default.createElement(y.default,{args:e.parseJson(t.args_json)})),c.default.createElement("td",null,c.default.createElement(p.default,{ts:t.started_at})),c.default.createElement("td",null,c.default.createElement(p.default,{ts:t.checkin_at})),c.default.createElement("td",null,t.checkin))}))))}}]),t}(c.default.Component);w.propTypes={worker:f.default.arrayOf(f.default.object).isRequired};var k=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={busyWorker:[],workerPool:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"componentDidMount",value:function(){var e=this;this.props.busyWorkerURL&&fetch(this.props.busyWorkerURL).then(function(e){return e.json()}).then(function(t){t&&e.setState({busyWorker:t})}),this.props.workerPoolURL&&fetch(this.props.workerPoolURL).then(function(e){return e.json()}).then(function(t){var n=[];t.map(function(e){""!=e.host&&n.push(e)}),e.setState({workerPool:n})})}},{key:"getBusyPoolWorker",value:function(e){var t=[];return this.state.busyWorker.map(function(n){e.worker_ids.includes(n.worker_id)&&t.push(n)}),t}},{key:"render",value:function(){var e=this;return c.default.createElement("section",null,c.default.createElement("header",null,"Processes"),c.default.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(function(t){var n=e.getBusyPoolWorker(t);return c.default.createElement("div",{key:t.worker_pool_id,className:(0,x.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("td",null,t.host,": ",t.pid),c.default.createElement("td",null,"Started ",c.default.createElement(p.default,{ts:t.started_at})),c.default.createElement("td",null,"Last Heartbeat ",c.default.createElement(p.default,{ts:t.heartbeat_at})),c.default.createElement("td",null,"Concurrency ",t.concurrency)),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},"Servicing ",c.default.createElement(h.default,{item:t.job_names}),".")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},n.length," active worker(s) and ",t.worker_ids.length-n.length," idle.")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},c.default.createElement("div",{className:(0,x.default)(g.default.panel,g.default.panelDefault)},c.default.createElement(w,{worker:n}))))))))}))}},{key:"workerCount",get:function(){var e=0;return this.state.workerPool.map(function(t){e+=t.worker_ids.length}),e}}]),t}(c.default.Component);k.propTypes={busyWorkerURL:f.default.string,workerPoolURL:f.default.string},t.default=k},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(51),p=r(d),b=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var e=this;return c.default.createElement("ul",{className:p.default.ul},this.props.item.map(function(t,n){return n<3?c.default.createElement("li",{key:n,className:p.default.li},t):3==n?c.default.createElement("li",{key:n,className:p.default.li},e.props.item.length-3," more"):void 0}))}}]),t}(c.default.Component);b.propTypes={item:f.default.arrayOf(f.default.string).isRequired},t.default=b},function(e,t,n){var r=n(52);"string"==typeof r&&(r=[[e.i,r,""]]);var o={hmr:!0};o.transform=void 0;n(29)(r,o);r.locals&&(e.exports=r.locals)},function(e,t,n){t=e.exports=n(28)(!1),t.push([e.i,'._223iE-ul {\n  display: inline;\n  list-style: none;\n  padding: 0;\n}\n\n._14w3w-li {\n  display: inline;\n}\n\n._14w3w-li:after {\n  content: ", ";\n}\n\n._14w3w-li:last-child:before {\n  content: "and "\n}\n\n._14w3w-li:last-child:after {\n  content: "";\n}\n',""]),t.locals={ul:"_223iE-ul",li:"_14w3w-li"}},function(e,t){e.exports=function(e){var t="undefined"!=typeof window&&window.location;if(!t)throw new Error("fixUrls requires window.location");if(!e||"string"!=typeof e)return e;var n=t.protocol+"//"+t.host,r=n+t.pathname.replace(/\/[^\/]*$/,"/");return e.replace(/url\s*\(((?:[^)(]|\((?:[^)(]+|\([^)(]*\))*\))*)\)/gi,function(e,t){var o=t.trim().replace(/^"(.*)"$/,function(e,t){return t}).replace(/^'(.*)'$/,function(e,t){return t});if(/^(#|data:|http:\/\/|https:\/\/|file:\/\/\/)/i.test(o))return e;var a;return a=0===o.indexOf("//")?o:0===o.indexOf("/")?n+o:r+o.replace(/^\.\//,""),"url("+JSON.stringify(a)+")"})}},function(e,t,n){t=e.exports=n(28)(!1),t.push([e.i,'/*!\n * Generated using the Bootstrap Customizer (https://getbootstrap.com/docs/3.4/customize/)\n * Config saved to https://gist.github.com/da9e4e258238275a8c6ec9d23bbb2e92\n *//*!\n * Bootstrap v3.4.1 (https://getbootstrap.com/)\n * Copyright 2011-2019 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n *//*! normalize.css v3.0.3 | MIT License | github.com/necolas/normalize.css */html{font-family:sans-serif;-ms-text-size-adjust:100%;-webkit-text-size-adjust:100%}body{margin:0}article,aside,details,figcaption,figure,footer,header,hgroup,main,menu,nav,section,summary{display:block}audio,canvas,progress,video{display:inline-block;vertical-align:baseline}audio:not([controls]){display:none;height:0}[hidden],template{display:none}a{background-color:transparent}a:active,a:hover{outline:0}abbr[title]{border-bottom:none;text-decoration:underline;text-decoration:underline dotted}b,strong{font-weight:bold}dfn{font-style:italic}h1{font-size:2em;margin:0.67em 0}mark{background:#ff0;color:#000}small{font-size:80%}sub,sup{font-size:75%;line-height:0;position:relative;vertical-align:baseline}sup{top:-0.5em}sub{bottom:-0.25em}img{border:0}svg:not(:root){overflow:hidden}figure{margin:1em 40px}hr{-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box;height:0}pre{overflow:auto}code,kbd,pre,samp{font-family:monospace, monospace;font-size:1em}button,input,optgroup,select,textarea{color:inherit;font:inherit;margin:0}button{overflow:visible}button,select{text-transform:none}button,html input[type="button"],input[type="reset"],input[type="submit"]{-webkit-appearance:button;cursor:pointer}button[disabled],html input[disabled]{cursor:default}button::-moz-focus-inner,input::-moz-focus-inner{border:0;padding:0}input{line-height:normal}input[type="checkbox"],input[type="radio"]{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box;padding:0}input[type="number"]::-webkit-inner-spin-button,input[type="number"]::-webkit-outer-spin-button{height:auto}input[type="search"]{-webkit-appearance:textfield;-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box}input[type="search"]::-webkit-search-cancel-button,input[type="search"]::-webkit-search-decoration{-webkit-appearance:none}fieldset{border:1px solid #c0c0c0;margin:0 2px;padding:0.35em 0.625em 0.75em}legend{border:0;padding:0}textarea{overflow:auto}optgroup{font-weight:bold}table{border-collapse:collapse;border-spacing:0}td,th{padding:0}/*! Source: https://github.com/h5bp/html5-boilerplate/blob/master/src/css/main.css */@media print{*,*:before,*:after{color:#000 !important;text-shadow:none !important;background:transparent !important;-webkit-box-shadow:none !important;box-shadow:none !important}a,a:visited{text-decoration:underline}a[href]:after{content:" (" attr(href) ")"}abbr[title]:after{content:" (" attr(title) ")"}a[href^="#"]:after,a[href^="javascript:"]:after{content:""}pre,blockquote{border:1px solid #999;page-break-inside:avoid}thead{display:table-header-group}tr,img{page-break-inside:avoid}img{max-width:100% !important}p,h2,h3{orphans:3;widows:3}h2,h3{page-break-after:avoid}.xz-aB-navbar{display:none}._3-XUl-btn>.WfXRY-caret,.xQM4X-dropup>._3-XUl-btn>.WfXRY-caret{border-top-color:#000 !important}._3gkGS-label{border:1px solid #000}.fHH5l-table{border-collapse:collapse !important}.fHH5l-table td,.fHH5l-table th{background-color:#fff !important}._3qUkR-table-bordered th,._3qUkR-table-bordered td{border:1px solid #ddd !important}}*{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}*:before,*:after{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}html{font-size:10px;-webkit-tap-highlight-color:rgba(0,0,0,0)}body{font-family:"Helvetica Neue",Helvetica,Arial,sans-serif;font-size:14px;line-height:1.42857143;color:#333;background-color:#fff}input,button,select,textarea{font-family:inherit;font-size:inherit;line-height:inherit}a{color:#337ab7;text-decoration:none}a:hover,a:focus{color:#23527c;text-decoration:underline}a:focus{outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}figure{margin:0}img{vertical-align:middle}._2zRts-img-responsive{display:block;max-width:100%;height:auto}._141wW-img-rounded{border-radius:6px}._3mPVB-img-thumbnail{padding:4px;line-height:1.42857143;background-color:#fff;border:1px solid #ddd;border-radius:4px;-webkit-transition:all .2s ease-in-out;-o-transition:all .2s ease-in-out;transition:all .2s ease-in-out;display:inline-block;max-width:100%;height:auto}._1pGGm-img-circle{border-radius:50%}hr{margin-top:20px;margin-bottom:20px;border:0;border-top:1px solid #eee}._2pd-Z-sr-only{position:absolute;width:1px;height:1px;padding:0;margin:-1px;overflow:hidden;clip:rect(0, 0, 0, 0);border:0}._2oRwu-sr-only-focusable:active,._2oRwu-sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}[role="button"]{cursor:pointer}h1,h2,h3,h4,h5,h6,.FcDB9-h1,._2RtE8-h2,._2Hz9Y-h3,._20UE0-h4,._3ig4X-h5,._1IWbi-h6{font-family:inherit;font-weight:500;line-height:1.1;color:inherit}h1 small,h2 small,h3 small,h4 small,h5 small,h6 small,.FcDB9-h1 small,._2RtE8-h2 small,._2Hz9Y-h3 small,._20UE0-h4 small,._3ig4X-h5 small,._1IWbi-h6 small,h1 ._1xqEr-small,h2 ._1xqEr-small,h3 ._1xqEr-small,h4 ._1xqEr-small,h5 ._1xqEr-small,h6 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-weight:400;line-height:1;color:#777}h1,.FcDB9-h1,h2,._2RtE8-h2,h3,._2Hz9Y-h3{margin-top:20px;margin-bottom:10px}h1 small,.FcDB9-h1 small,h2 small,._2RtE8-h2 small,h3 small,._2Hz9Y-h3 small,h1 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,h2 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,h3 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small{font-size:65%}h4,._20UE0-h4,h5,._3ig4X-h5,h6,._1IWbi-h6{margin-top:10px;margin-bottom:10px}h4 small,._20UE0-h4 small,h5 small,._3ig4X-h5 small,h6 small,._1IWbi-h6 small,h4 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,h5 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,h6 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-size:75%}h1,.FcDB9-h1{font-size:36px}h2,._2RtE8-h2{font-size:30px}h3,._2Hz9Y-h3{font-size:24px}h4,._20UE0-h4{font-size:18px}h5,._3ig4X-h5{font-size:14px}h6,._1IWbi-h6{font-size:12px}p{margin:0 0 10px}.BToLm-lead{margin-bottom:20px;font-size:16px;font-weight:300;line-height:1.4}@media (min-width:768px){.BToLm-lead{font-size:21px}}small,._1xqEr-small{font-size:85%}mark,._1CUfl-mark{padding:.2em;background-color:#fcf8e3}._1dEhG-text-left{text-align:left}._13-RL-text-right{text-align:right}._12Qkk-text-center{text-align:center}._2IBJo-text-justify{text-align:justify}._2D83n-text-nowrap{white-space:nowrap}._3pILW-text-lowercase{text-transform:lowercase}.EHGdF-text-uppercase{text-transform:uppercase}.NKPoz-text-capitalize{text-transform:capitalize}.TLMiz-text-muted{color:#777}.m-Vt1-text-primary{color:#337ab7}a.m-Vt1-text-primary:hover,a.m-Vt1-text-primary:focus{color:#286090}._1gJQD-text-success{color:#3c763d}a._1gJQD-text-success:hover,a._1gJQD-text-success:focus{color:#2b542c}._3nKGJ-text-info{color:#31708f}a._3nKGJ-text-info:hover,a._3nKGJ-text-info:focus{color:#245269}._1L8Pc-text-warning{color:#8a6d3b}a._1L8Pc-text-warning:hover,a._1L8Pc-text-warning:focus{color:#66512c}._2Qeqx-text-danger{color:#a94442}a._2Qeqx-text-danger:hover,a._2Qeqx-text-danger:focus{color:#843534}.VG86S-bg-primary{color:#fff;background-color:#337ab7}a.VG86S-bg-primary:hover,a.VG86S-bg-primary:focus{background-color:#286090}.Fh09a-bg-success{background-color:#dff0d8}a.Fh09a-bg-success:hover,a.Fh09a-bg-success:focus{background-color:#c1e2b3}._3D-5o-bg-info{background-color:#d9edf7}a._3D-5o-bg-info:hover,a._3D-5o-bg-info:focus{background-color:#afd9ee}._23YNn-bg-warning{background-color:#fcf8e3}a._23YNn-bg-warning:hover,a._23YNn-bg-warning:focus{background-color:#f7ecb5}._1g67A-bg-danger{background-color:#f2dede}a._1g67A-bg-danger:hover,a._1g67A-bg-danger:focus{background-color:#e4b9b9}._3ayLh-page-header{padding-bottom:9px;margin:40px 0 20px;border-bottom:1px solid #eee}ul,ol{margin-top:0;margin-bottom:10px}ul ul,ol ul,ul ol,ol ol{margin-bottom:0}._2tb6R-list-unstyled{padding-left:0;list-style:none}._2aPiu-list-inline{padding-left:0;list-style:none;margin-left:-5px}._2aPiu-list-inline>li{display:inline-block;padding-right:5px;padding-left:5px}dl{margin-top:0;margin-bottom:20px}dt,dd{line-height:1.42857143}dt{font-weight:700}dd{margin-left:0}@media (min-width:768px){._2DZom-dl-horizontal dt{float:left;width:160px;clear:left;text-align:right;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}._2DZom-dl-horizontal dd{margin-left:180px}}abbr[title],abbr[data-original-title]{cursor:help}._39JkD-initialism{font-size:90%;text-transform:uppercase}blockquote{padding:10px 20px;margin:0 0 20px;font-size:17.5px;border-left:5px solid #eee}blockquote p:last-child,blockquote ul:last-child,blockquote ol:last-child{margin-bottom:0}blockquote footer,blockquote small,blockquote ._1xqEr-small{display:block;font-size:80%;line-height:1.42857143;color:#777}blockquote footer:before,blockquote small:before,blockquote ._1xqEr-small:before{content:"\\2014   \\A0"}._3OkIL-blockquote-reverse,blockquote._1DwIR-pull-right{padding-right:15px;padding-left:0;text-align:right;border-right:5px solid #eee;border-left:0}._3OkIL-blockquote-reverse footer:before,blockquote._1DwIR-pull-right footer:before,._3OkIL-blockquote-reverse small:before,blockquote._1DwIR-pull-right small:before,._3OkIL-blockquote-reverse ._1xqEr-small:before,blockquote._1DwIR-pull-right ._1xqEr-small:before{content:""}._3OkIL-blockquote-reverse footer:after,blockquote._1DwIR-pull-right footer:after,._3OkIL-blockquote-reverse small:after,blockquote._1DwIR-pull-right small:after,._3OkIL-blockquote-reverse ._1xqEr-small:after,blockquote._1DwIR-pull-right ._1xqEr-small:after{content:"\\A0   \\2014"}address{margin-bottom:20px;font-style:normal;line-height:1.42857143}.GfVfY-container{padding-right:15px;padding-left:15px;margin-right:auto;margin-left:auto}@media (min-width:768px){.GfVfY-container{width:750px}}@media (min-width:992px){.GfVfY-container{width:970px}}@media (min-width:1200px){.GfVfY-container{width:1170px}}._1TgHS-container-fluid{padding-right:15px;padding-left:15px;margin-right:auto;margin-left:auto}._3XjgV-row{margin-right:-15px;margin-left:-15px}._3-ExX-row-no-gutters{margin-right:0;margin-left:0}._3-ExX-row-no-gutters [class*="col-"]{padding-right:0;padding-left:0}._4I1Yd-col-xs-1, .ZjKa3-col-sm-1, ._2qQXc-col-md-1, ._20ta3-col-lg-1, ._2Dnpy-col-xs-2, ._1i2ug-col-sm-2, ._2t37W-col-md-2, ._2Hl3y-col-lg-2, .OXp8_-col-xs-3, ._2Cn5F-col-sm-3, .Ab3eH-col-md-3, ._26GsY-col-lg-3, ._2RAtz-col-xs-4, .fa0Ir-col-sm-4, .hp0Eu-col-md-4, ._17v9q-col-lg-4, ._2Y3wR-col-xs-5, ._3h_BG-col-sm-5, .s-Mk2-col-md-5, ._3poT2-col-lg-5, ._2LLfi-col-xs-6, ._2uvrS-col-sm-6, ._3aWb5-col-md-6, ._1kKt6-col-lg-6, ._1lY1V-col-xs-7, ._3MZfg-col-sm-7, ._3cJo6-col-md-7, ._3xzR0-col-lg-7, ._2HxFx-col-xs-8, ._2gidE-col-sm-8, ._2PRaS-col-md-8, .LJ--s-col-lg-8, ._2oOfj-col-xs-9, ._26BI4-col-sm-9, ._2DhdG-col-md-9, ._3q6rI-col-lg-9, .pmiBX-col-xs-10, .s5knd-col-sm-10, ._1oNCM-col-md-10, ._2DYWZ-col-lg-10, .yWnii-col-xs-11, .liNUF-col-sm-11, ._2CmUe-col-md-11, ._1qjSD-col-lg-11, ._2dgc--col-xs-12, ._3ylC5-col-sm-12, ._2DCWc-col-md-12, ._2FNrn-col-lg-12{position:relative;min-height:1px;padding-right:15px;padding-left:15px}._4I1Yd-col-xs-1, ._2Dnpy-col-xs-2, .OXp8_-col-xs-3, ._2RAtz-col-xs-4, ._2Y3wR-col-xs-5, ._2LLfi-col-xs-6, ._1lY1V-col-xs-7, ._2HxFx-col-xs-8, ._2oOfj-col-xs-9, .pmiBX-col-xs-10, .yWnii-col-xs-11, ._2dgc--col-xs-12{float:left}._2dgc--col-xs-12{width:100%}.yWnii-col-xs-11{width:91.66666667%}.pmiBX-col-xs-10{width:83.33333333%}._2oOfj-col-xs-9{width:75%}._2HxFx-col-xs-8{width:66.66666667%}._1lY1V-col-xs-7{width:58.33333333%}._2LLfi-col-xs-6{width:50%}._2Y3wR-col-xs-5{width:41.66666667%}._2RAtz-col-xs-4{width:33.33333333%}.OXp8_-col-xs-3{width:25%}._2Dnpy-col-xs-2{width:16.66666667%}._4I1Yd-col-xs-1{width:8.33333333%}.uk8LQ-col-xs-pull-12{right:100%}.nQP-X-col-xs-pull-11{right:91.66666667%}._3W2H_-col-xs-pull-10{right:83.33333333%}._18qIh-col-xs-pull-9{right:75%}.CEgpl-col-xs-pull-8{right:66.66666667%}._3UVMh-col-xs-pull-7{right:58.33333333%}._1PEhv-col-xs-pull-6{right:50%}.VxFvW-col-xs-pull-5{right:41.66666667%}._3Wqpt-col-xs-pull-4{right:33.33333333%}._12Zed-col-xs-pull-3{right:25%}._3p-tQ-col-xs-pull-2{right:16.66666667%}.RDEqX-col-xs-pull-1{right:8.33333333%}._2F1WQ-col-xs-pull-0{right:auto}._1APvI-col-xs-push-12{left:100%}._3C73D-col-xs-push-11{left:91.66666667%}.h56Hx-col-xs-push-10{left:83.33333333%}._1P93P-col-xs-push-9{left:75%}._2Nbww-col-xs-push-8{left:66.66666667%}._1K3eA-col-xs-push-7{left:58.33333333%}._2nBcT-col-xs-push-6{left:50%}._3petP-col-xs-push-5{left:41.66666667%}._2IqqW-col-xs-push-4{left:33.33333333%}.MCtu6-col-xs-push-3{left:25%}._3PMdr-col-xs-push-2{left:16.66666667%}._1wU93-col-xs-push-1{left:8.33333333%}._1mQQp-col-xs-push-0{left:auto}._29EZj-col-xs-offset-12{margin-left:100%}._2hf-s-col-xs-offset-11{margin-left:91.66666667%}.PUiPu-col-xs-offset-10{margin-left:83.33333333%}._26WFR-col-xs-offset-9{margin-left:75%}._1cCHe-col-xs-offset-8{margin-left:66.66666667%}._3XTt9-col-xs-offset-7{margin-left:58.33333333%}._3ruq1-col-xs-offset-6{margin-left:50%}._2Jvdw-col-xs-offset-5{margin-left:41.66666667%}._3Lc1D-col-xs-offset-4{margin-left:33.33333333%}._1MGVb-col-xs-offset-3{margin-left:25%}._3VELV-col-xs-offset-2{margin-left:16.66666667%}._1qTH1-col-xs-offset-1{margin-left:8.33333333%}._3p4mP-col-xs-offset-0{margin-left:0}@media (min-width:768px){.ZjKa3-col-sm-1, ._1i2ug-col-sm-2, ._2Cn5F-col-sm-3, .fa0Ir-col-sm-4, ._3h_BG-col-sm-5, ._2uvrS-col-sm-6, ._3MZfg-col-sm-7, ._2gidE-col-sm-8, ._26BI4-col-sm-9, .s5knd-col-sm-10, .liNUF-col-sm-11, ._3ylC5-col-sm-12{float:left}._3ylC5-col-sm-12{width:100%}.liNUF-col-sm-11{width:91.66666667%}.s5knd-col-sm-10{width:83.33333333%}._26BI4-col-sm-9{width:75%}._2gidE-col-sm-8{width:66.66666667%}._3MZfg-col-sm-7{width:58.33333333%}._2uvrS-col-sm-6{width:50%}._3h_BG-col-sm-5{width:41.66666667%}.fa0Ir-col-sm-4{width:33.33333333%}._2Cn5F-col-sm-3{width:25%}._1i2ug-col-sm-2{width:16.66666667%}.ZjKa3-col-sm-1{width:8.33333333%}._2lgaN-col-sm-pull-12{right:100%}._9DE1R-col-sm-pull-11{right:91.66666667%}.kEzk--col-sm-pull-10{right:83.33333333%}.Nt2jd-col-sm-pull-9{right:75%}._3swPC-col-sm-pull-8{right:66.66666667%}._3HVdW-col-sm-pull-7{right:58.33333333%}.wTEFa-col-sm-pull-6{right:50%}.TLqCy-col-sm-pull-5{right:41.66666667%}._15_JC-col-sm-pull-4{right:33.33333333%}.kKugK-col-sm-pull-3{right:25%}._1gfwZ-col-sm-pull-2{right:16.66666667%}.ULGBP-col-sm-pull-1{right:8.33333333%}._2761W-col-sm-pull-0{right:auto}.vVqsv-col-sm-push-12{left:100%}._3rrzB-col-sm-push-11{left:91.66666667%}._16iHZ-col-sm-push-10{left:83.33333333%}._3gO4_-col-sm-push-9{left:75%}._2iTaN-col-sm-push-8{left:66.66666667%}._15MqT-col-sm-push-7{left:58.33333333%}._3WBCD-col-sm-push-6{left:50%}._3yi6x-col-sm-push-5{left:41.66666667%}.Hi_tc-col-sm-push-4{left:33.33333333%}._3CD2Y-col-sm-push-3{left:25%}._2KPVu-col-sm-push-2{left:16.66666667%}._1vrte-col-sm-push-1{left:8.33333333%}._2iGSL-col-sm-push-0{left:auto}._2gBOF-col-sm-offset-12{margin-left:100%}._1nkq--col-sm-offset-11{margin-left:91.66666667%}._3cbLF-col-sm-offset-10{margin-left:83.33333333%}._1YqUk-col-sm-offset-9{margin-left:75%}.l5LT2-col-sm-offset-8{margin-left:66.66666667%}._1_lx0-col-sm-offset-7{margin-left:58.33333333%}.QftRx-col-sm-offset-6{margin-left:50%}._1CiAA-col-sm-offset-5{margin-left:41.66666667%}._3KOyM-col-sm-offset-4{margin-left:33.33333333%}._3X8SS-col-sm-offset-3{margin-left:25%}._2uxv1-col-sm-offset-2{margin-left:16.66666667%}._3LDLJ-col-sm-offset-1{margin-left:8.33333333%}._2qEtK-col-sm-offset-0{margin-left:0}}@media (min-width:992px){._2qQXc-col-md-1, ._2t37W-col-md-2, .Ab3eH-col-md-3, .hp0Eu-col-md-4, .s-Mk2-col-md-5, ._3aWb5-col-md-6, ._3cJo6-col-md-7, ._2PRaS-col-md-8, ._2DhdG-col-md-9, ._1oNCM-col-md-10, ._2CmUe-col-md-11, ._2DCWc-col-md-12{float:left}._2DCWc-col-md-12{width:100%}._2CmUe-col-md-11{width:91.66666667%}._1oNCM-col-md-10{width:83.33333333%}._2DhdG-col-md-9{width:75%}._2PRaS-col-md-8{width:66.66666667%}._3cJo6-col-md-7{width:58.33333333%}._3aWb5-col-md-6{width:50%}.s-Mk2-col-md-5{width:41.66666667%}.hp0Eu-col-md-4{width:33.33333333%}.Ab3eH-col-md-3{width:25%}._2t37W-col-md-2{width:16.66666667%}._2qQXc-col-md-1{width:8.33333333%}.bjQSr-col-md-pull-12{right:100%}._2R0gT-col-md-pull-11{right:91.66666667%}._1ywn5-col-md-pull-10{right:83.33333333%}.Y3m-j-col-md-pull-9{right:75%}._2kyk9-col-md-pull-8{right:66.66666667%}.dnrMG-col-md-pull-7{right:58.33333333%}._1zNYf-col-md-pull-6{right:50%}._1lgxH-col-md-pull-5{right:41.66666667%}.fI_-S-col-md-pull-4{right:33.33333333%}._1yC1r-col-md-pull-3{right:25%}._2ruK1-col-md-pull-2{right:16.66666667%}._3pkxQ-col-md-pull-1{right:8.33333333%}._2NDft-col-md-pull-0{right:auto}._2dklO-col-md-push-12{left:100%}._2O3TS-col-md-push-11{left:91.66666667%}._3H7Y4-col-md-push-10{left:83.33333333%}._2PIS0-col-md-push-9{left:75%}._1qQQi-col-md-push-8{left:66.66666667%}.Y8MRT-col-md-push-7{left:58.33333333%}._3_TWQ-col-md-push-6{left:50%}._3KOrj-col-md-push-5{left:41.66666667%}._2PuS8-col-md-push-4{left:33.33333333%}._2zI-_-col-md-push-3{left:25%}._3Drij-col-md-push-2{left:16.66666667%}._1M7-4-col-md-push-1{left:8.33333333%}._1kPaw-col-md-push-0{left:auto}._3PwfG-col-md-offset-12{margin-left:100%}.sHbiw-col-md-offset-11{margin-left:91.66666667%}._3geq1-col-md-offset-10{margin-left:83.33333333%}._3ypJc-col-md-offset-9{margin-left:75%}.ydDuS-col-md-offset-8{margin-left:66.66666667%}._1MS3B-col-md-offset-7{margin-left:58.33333333%}._1A80h-col-md-offset-6{margin-left:50%}._3g3p_-col-md-offset-5{margin-left:41.66666667%}._2FjQc-col-md-offset-4{margin-left:33.33333333%}.Xebzn-col-md-offset-3{margin-left:25%}.Xw9rm-col-md-offset-2{margin-left:16.66666667%}.CToHd-col-md-offset-1{margin-left:8.33333333%}.oXEcL-col-md-offset-0{margin-left:0}}@media (min-width:1200px){._20ta3-col-lg-1, ._2Hl3y-col-lg-2, ._26GsY-col-lg-3, ._17v9q-col-lg-4, ._3poT2-col-lg-5, ._1kKt6-col-lg-6, ._3xzR0-col-lg-7, .LJ--s-col-lg-8, ._3q6rI-col-lg-9, ._2DYWZ-col-lg-10, ._1qjSD-col-lg-11, ._2FNrn-col-lg-12{float:left}._2FNrn-col-lg-12{width:100%}._1qjSD-col-lg-11{width:91.66666667%}._2DYWZ-col-lg-10{width:83.33333333%}._3q6rI-col-lg-9{width:75%}.LJ--s-col-lg-8{width:66.66666667%}._3xzR0-col-lg-7{width:58.33333333%}._1kKt6-col-lg-6{width:50%}._3poT2-col-lg-5{width:41.66666667%}._17v9q-col-lg-4{width:33.33333333%}._26GsY-col-lg-3{width:25%}._2Hl3y-col-lg-2{width:16.66666667%}._20ta3-col-lg-1{width:8.33333333%}._32_we-col-lg-pull-12{right:100%}._24eEw-col-lg-pull-11{right:91.66666667%}._29qaq-col-lg-pull-10{right:83.33333333%}._1S7Yo-col-lg-pull-9{right:75%}._2CgOB-col-lg-pull-8{right:66.66666667%}._3Jv0L-col-lg-pull-7{right:58.33333333%}._1pHMz-col-lg-pull-6{right:50%}._2zvsy-col-lg-pull-5{right:41.66666667%}.sFsmY-col-lg-pull-4{right:33.33333333%}.Ui3Ia-col-lg-pull-3{right:25%}.WmgXv-col-lg-pull-2{right:16.66666667%}._2KMEm-col-lg-pull-1{right:8.33333333%}.QWRTc-col-lg-pull-0{right:auto}._2FOMW-col-lg-push-12{left:100%}.IdWzr-col-lg-push-11{left:91.66666667%}._3qw3b-col-lg-push-10{left:83.33333333%}._3LXj0-col-lg-push-9{left:75%}._16qiV-col-lg-push-8{left:66.66666667%}._2OQk6-col-lg-push-7{left:58.33333333%}._3o1se-col-lg-push-6{left:50%}._2dmyK-col-lg-push-5{left:41.66666667%}._3pru9-col-lg-push-4{left:33.33333333%}._2lBUY-col-lg-push-3{left:25%}._3FuDW-col-lg-push-2{left:16.66666667%}._2YWH--col-lg-push-1{left:8.33333333%}._1iVNw-col-lg-push-0{left:auto}.uQRYD-col-lg-offset-12{margin-left:100%}._1tWxb-col-lg-offset-11{margin-left:91.66666667%}._10I1r-col-lg-offset-10{margin-left:83.33333333%}._3zXRb-col-lg-offset-9{margin-left:75%}._3HNmx-col-lg-offset-8{margin-left:66.66666667%}._3mIkn-col-lg-offset-7{margin-left:58.33333333%}._22SUB-col-lg-offset-6{margin-left:50%}._1CR4c-col-lg-offset-5{margin-left:41.66666667%}._1AWkt-col-lg-offset-4{margin-left:33.33333333%}._2BzzA-col-lg-offset-3{margin-left:25%}._3Egxi-col-lg-offset-2{margin-left:16.66666667%}._3QHjG-col-lg-offset-1{margin-left:8.33333333%}._3jTkc-col-lg-offset-0{margin-left:0}}table{background-color:transparent}table col[class*="col-"]{position:static;display:table-column;float:none}table td[class*="col-"],table th[class*="col-"]{position:static;display:table-cell;float:none}caption{padding-top:8px;padding-bottom:8px;color:#777;text-align:left}th{text-align:left}.fHH5l-table{width:100%;max-width:100%;margin-bottom:20px}.fHH5l-table>thead>tr>th,.fHH5l-table>tbody>tr>th,.fHH5l-table>tfoot>tr>th,.fHH5l-table>thead>tr>td,.fHH5l-table>tbody>tr>td,.fHH5l-table>tfoot>tr>td{padding:8px;line-height:1.42857143;vertical-align:top;border-top:1px solid #ddd}.fHH5l-table>thead>tr>th{vertical-align:bottom;border-bottom:2px solid #ddd}.fHH5l-table>caption+thead>tr:first-child>th,.fHH5l-table>colgroup+thead>tr:first-child>th,.fHH5l-table>thead:first-child>tr:first-child>th,.fHH5l-table>caption+thead>tr:first-child>td,.fHH5l-table>colgroup+thead>tr:first-child>td,.fHH5l-table>thead:first-child>tr:first-child>td{border-top:0}.fHH5l-table>tbody+tbody{border-top:2px solid #ddd}.fHH5l-table .fHH5l-table{background-color:#fff}._1lZ-G-table-condensed>thead>tr>th,._1lZ-G-table-condensed>tbody>tr>th,._1lZ-G-table-condensed>tfoot>tr>th,._1lZ-G-table-condensed>thead>tr>td,._1lZ-G-table-condensed>tbody>tr>td,._1lZ-G-table-condensed>tfoot>tr>td{padding:5px}._3qUkR-table-bordered{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>tbody>tr>th,._3qUkR-table-bordered>tfoot>tr>th,._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>tbody>tr>td,._3qUkR-table-bordered>tfoot>tr>td{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>thead>tr>td{border-bottom-width:2px}._2ysFO-table-striped>tbody>tr:nth-of-type(odd){background-color:#f9f9f9}._3Qd5Z-table-hover>tbody>tr:hover{background-color:#f5f5f5}.fHH5l-table>thead>tr>td._2tvYY-active,.fHH5l-table>tbody>tr>td._2tvYY-active,.fHH5l-table>tfoot>tr>td._2tvYY-active,.fHH5l-table>thead>tr>th._2tvYY-active,.fHH5l-table>tbody>tr>th._2tvYY-active,.fHH5l-table>tfoot>tr>th._2tvYY-active,.fHH5l-table>thead>tr._2tvYY-active>td,.fHH5l-table>tbody>tr._2tvYY-active>td,.fHH5l-table>tfoot>tr._2tvYY-active>td,.fHH5l-table>thead>tr._2tvYY-active>th,.fHH5l-table>tbody>tr._2tvYY-active>th,.fHH5l-table>tfoot>tr._2tvYY-active>th{background-color:#f5f5f5}._3Qd5Z-table-hover>tbody>tr>td._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr>th._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2tvYY-active,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>th{background-color:#e8e8e8}.fHH5l-table>thead>tr>td._2OgG2-success,.fHH5l-table>tbody>tr>td._2OgG2-success,.fHH5l-table>tfoot>tr>td._2OgG2-success,.fHH5l-table>thead>tr>th._2OgG2-success,.fHH5l-table>tbody>tr>th._2OgG2-success,.fHH5l-table>tfoot>tr>th._2OgG2-success,.fHH5l-table>thead>tr._2OgG2-success>td,.fHH5l-table>tbody>tr._2OgG2-success>td,.fHH5l-table>tfoot>tr._2OgG2-success>td,.fHH5l-table>thead>tr._2OgG2-success>th,.fHH5l-table>tbody>tr._2OgG2-success>th,.fHH5l-table>tfoot>tr._2OgG2-success>th{background-color:#dff0d8}._3Qd5Z-table-hover>tbody>tr>td._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr>th._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2OgG2-success,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>th{background-color:#d0e9c6}.fHH5l-table>thead>tr>td._2ItI5-info,.fHH5l-table>tbody>tr>td._2ItI5-info,.fHH5l-table>tfoot>tr>td._2ItI5-info,.fHH5l-table>thead>tr>th._2ItI5-info,.fHH5l-table>tbody>tr>th._2ItI5-info,.fHH5l-table>tfoot>tr>th._2ItI5-info,.fHH5l-table>thead>tr._2ItI5-info>td,.fHH5l-table>tbody>tr._2ItI5-info>td,.fHH5l-table>tfoot>tr._2ItI5-info>td,.fHH5l-table>thead>tr._2ItI5-info>th,.fHH5l-table>tbody>tr._2ItI5-info>th,.fHH5l-table>tfoot>tr._2ItI5-info>th{background-color:#d9edf7}._3Qd5Z-table-hover>tbody>tr>td._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr>th._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2ItI5-info,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>th{background-color:#c4e3f3}.fHH5l-table>thead>tr>td.KrRM8-warning,.fHH5l-table>tbody>tr>td.KrRM8-warning,.fHH5l-table>tfoot>tr>td.KrRM8-warning,.fHH5l-table>thead>tr>th.KrRM8-warning,.fHH5l-table>tbody>tr>th.KrRM8-warning,.fHH5l-table>tfoot>tr>th.KrRM8-warning,.fHH5l-table>thead>tr.KrRM8-warning>td,.fHH5l-table>tbody>tr.KrRM8-warning>td,.fHH5l-table>tfoot>tr.KrRM8-warning>td,.fHH5l-table>thead>tr.KrRM8-warning>th,.fHH5l-table>tbody>tr.KrRM8-warning>th,.fHH5l-table>tfoot>tr.KrRM8-warning>th{background-color:#fcf8e3}._3Qd5Z-table-hover>tbody>tr>td.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr>th.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.KrRM8-warning,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>th{background-color:#faf2cc}.fHH5l-table>thead>tr>td.CwCgN-danger,.fHH5l-table>tbody>tr>td.CwCgN-danger,.fHH5l-table>tfoot>tr>td.CwCgN-danger,.fHH5l-table>thead>tr>th.CwCgN-danger,.fHH5l-table>tbody>tr>th.CwCgN-danger,.fHH5l-table>tfoot>tr>th.CwCgN-danger,.fHH5l-table>thead>tr.CwCgN-danger>td,.fHH5l-table>tbody>tr.CwCgN-danger>td,.fHH5l-table>tfoot>tr.CwCgN-danger>td,.fHH5l-table>thead>tr.CwCgN-danger>th,.fHH5l-table>tbody>tr.CwCgN-danger>th,.fHH5l-table>tfoot>tr.CwCgN-danger>th{background-color:#f2dede}._3Qd5Z-table-hover>tbody>tr>td.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr>th.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.CwCgN-danger,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>th{background-color:#ebcccc}._3oGxj-table-responsive{min-height:.01%;overflow-x:auto}@media screen and (max-width:767px){._3oGxj-table-responsive{width:100%;margin-bottom:15px;overflow-y:hidden;-ms-overflow-style:-ms-autohiding-scrollbar;border:1px solid #ddd}._3oGxj-table-responsive>.fHH5l-table{margin-bottom:0}._3oGxj-table-responsive>.fHH5l-table>thead>tr>th,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>th,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>th,._3oGxj-table-responsive>.fHH5l-table>thead>tr>td,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>td,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>td{white-space:nowrap}._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td{border-bottom:0}}._3-XUl-btn{display:inline-block;margin-bottom:0;font-weight:normal;text-align:center;white-space:nowrap;vertical-align:middle;-ms-touch-action:manipulation;touch-action:manipulation;cursor:pointer;background-image:none;border:1px solid transparent;padding:6px 12px;font-size:14px;line-height:1.42857143;border-radius:4px;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}._3-XUl-btn:focus,._3-XUl-btn:active:focus,._3-XUl-btn._2tvYY-active:focus,._3-XUl-btn._2eLCD-focus,._3-XUl-btn:active._2eLCD-focus,._3-XUl-btn._2tvYY-active._2eLCD-focus{outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}._3-XUl-btn:hover,._3-XUl-btn:focus,._3-XUl-btn._2eLCD-focus{color:#333;text-decoration:none}._3-XUl-btn:active,._3-XUl-btn._2tvYY-active{background-image:none;outline:0;-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._3-XUl-btn._2UPmc-disabled,._3-XUl-btn[disabled],fieldset[disabled] ._3-XUl-btn{cursor:not-allowed;filter:alpha(opacity=65);opacity:.65;-webkit-box-shadow:none;box-shadow:none}a._3-XUl-btn._2UPmc-disabled,fieldset[disabled] a._3-XUl-btn{pointer-events:none}._7-4ed-btn-default{color:#333;background-color:#fff;border-color:#ccc}._7-4ed-btn-default:focus,._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#e6e6e6;border-color:#8c8c8c}._7-4ed-btn-default:hover{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{color:#333;background-color:#e6e6e6;background-image:none;border-color:#adadad}._7-4ed-btn-default:active:hover,._7-4ed-btn-default._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:hover,._7-4ed-btn-default:active:focus,._7-4ed-btn-default._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:focus,._7-4ed-btn-default:active._2eLCD-focus,._7-4ed-btn-default._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#d4d4d4;border-color:#8c8c8c}._7-4ed-btn-default._2UPmc-disabled:hover,._7-4ed-btn-default[disabled]:hover,fieldset[disabled] ._7-4ed-btn-default:hover,._7-4ed-btn-default._2UPmc-disabled:focus,._7-4ed-btn-default[disabled]:focus,fieldset[disabled] ._7-4ed-btn-default:focus,._7-4ed-btn-default._2UPmc-disabled._2eLCD-focus,._7-4ed-btn-default[disabled]._2eLCD-focus,fieldset[disabled] ._7-4ed-btn-default._2eLCD-focus{background-color:#fff;border-color:#ccc}._7-4ed-btn-default ._1XX3z-badge{color:#fff;background-color:#333}._1VbPL-btn-primary{color:#fff;background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#286090;border-color:#122b40}._1VbPL-btn-primary:hover{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{color:#fff;background-color:#286090;background-image:none;border-color:#204d74}._1VbPL-btn-primary:active:hover,._1VbPL-btn-primary._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:hover,._1VbPL-btn-primary:active:focus,._1VbPL-btn-primary._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:focus,._1VbPL-btn-primary:active._2eLCD-focus,._1VbPL-btn-primary._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#204d74;border-color:#122b40}._1VbPL-btn-primary._2UPmc-disabled:hover,._1VbPL-btn-primary[disabled]:hover,fieldset[disabled] ._1VbPL-btn-primary:hover,._1VbPL-btn-primary._2UPmc-disabled:focus,._1VbPL-btn-primary[disabled]:focus,fieldset[disabled] ._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2UPmc-disabled._2eLCD-focus,._1VbPL-btn-primary[disabled]._2eLCD-focus,fieldset[disabled] ._1VbPL-btn-primary._2eLCD-focus{background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary ._1XX3z-badge{color:#337ab7;background-color:#fff}._1P0kt-btn-success{color:#fff;background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success:focus,._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#449d44;border-color:#255625}._1P0kt-btn-success:hover{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{color:#fff;background-color:#449d44;background-image:none;border-color:#398439}._1P0kt-btn-success:active:hover,._1P0kt-btn-success._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:hover,._1P0kt-btn-success:active:focus,._1P0kt-btn-success._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:focus,._1P0kt-btn-success:active._2eLCD-focus,._1P0kt-btn-success._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#398439;border-color:#255625}._1P0kt-btn-success._2UPmc-disabled:hover,._1P0kt-btn-success[disabled]:hover,fieldset[disabled] ._1P0kt-btn-success:hover,._1P0kt-btn-success._2UPmc-disabled:focus,._1P0kt-btn-success[disabled]:focus,fieldset[disabled] ._1P0kt-btn-success:focus,._1P0kt-btn-success._2UPmc-disabled._2eLCD-focus,._1P0kt-btn-success[disabled]._2eLCD-focus,fieldset[disabled] ._1P0kt-btn-success._2eLCD-focus{background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success ._1XX3z-badge{color:#5cb85c;background-color:#fff}._25yw2-btn-info{color:#fff;background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info:focus,._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#31b0d5;border-color:#1b6d85}._25yw2-btn-info:hover{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{color:#fff;background-color:#31b0d5;background-image:none;border-color:#269abc}._25yw2-btn-info:active:hover,._25yw2-btn-info._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:hover,._25yw2-btn-info:active:focus,._25yw2-btn-info._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:focus,._25yw2-btn-info:active._2eLCD-focus,._25yw2-btn-info._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#269abc;border-color:#1b6d85}._25yw2-btn-info._2UPmc-disabled:hover,._25yw2-btn-info[disabled]:hover,fieldset[disabled] ._25yw2-btn-info:hover,._25yw2-btn-info._2UPmc-disabled:focus,._25yw2-btn-info[disabled]:focus,fieldset[disabled] ._25yw2-btn-info:focus,._25yw2-btn-info._2UPmc-disabled._2eLCD-focus,._25yw2-btn-info[disabled]._2eLCD-focus,fieldset[disabled] ._25yw2-btn-info._2eLCD-focus{background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info ._1XX3z-badge{color:#5bc0de;background-color:#fff}.-tgFO-btn-warning{color:#fff;background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning:focus,.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#ec971f;border-color:#985f0d}.-tgFO-btn-warning:hover{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{color:#fff;background-color:#ec971f;background-image:none;border-color:#d58512}.-tgFO-btn-warning:active:hover,.-tgFO-btn-warning._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:hover,.-tgFO-btn-warning:active:focus,.-tgFO-btn-warning._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:focus,.-tgFO-btn-warning:active._2eLCD-focus,.-tgFO-btn-warning._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#d58512;border-color:#985f0d}.-tgFO-btn-warning._2UPmc-disabled:hover,.-tgFO-btn-warning[disabled]:hover,fieldset[disabled] .-tgFO-btn-warning:hover,.-tgFO-btn-warning._2UPmc-disabled:focus,.-tgFO-btn-warning[disabled]:focus,fieldset[disabled] .-tgFO-btn-warning:focus,.-tgFO-btn-warning._2UPmc-disabled._2eLCD-focus,.-tgFO-btn-warning[disabled]._2eLCD-focus,fieldset[disabled] .-tgFO-btn-warning._2eLCD-focus{background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning ._1XX3z-badge{color:#f0ad4e;background-color:#fff}._1fOWb-btn-danger{color:#fff;background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#c9302c;border-color:#761c19}._1fOWb-btn-danger:hover{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{color:#fff;background-color:#c9302c;background-image:none;border-color:#ac2925}._1fOWb-btn-danger:active:hover,._1fOWb-btn-danger._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:hover,._1fOWb-btn-danger:active:focus,._1fOWb-btn-danger._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:focus,._1fOWb-btn-danger:active._2eLCD-focus,._1fOWb-btn-danger._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#ac2925;border-color:#761c19}._1fOWb-btn-danger._2UPmc-disabled:hover,._1fOWb-btn-danger[disabled]:hover,fieldset[disabled] ._1fOWb-btn-danger:hover,._1fOWb-btn-danger._2UPmc-disabled:focus,._1fOWb-btn-danger[disabled]:focus,fieldset[disabled] ._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2UPmc-disabled._2eLCD-focus,._1fOWb-btn-danger[disabled]._2eLCD-focus,fieldset[disabled] ._1fOWb-btn-danger._2eLCD-focus{background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger ._1XX3z-badge{color:#d9534f;background-color:#fff}._2k5vU-btn-link{font-weight:400;color:#337ab7;border-radius:0}._2k5vU-btn-link,._2k5vU-btn-link:active,._2k5vU-btn-link._2tvYY-active,._2k5vU-btn-link[disabled],fieldset[disabled] ._2k5vU-btn-link{background-color:transparent;-webkit-box-shadow:none;box-shadow:none}._2k5vU-btn-link,._2k5vU-btn-link:hover,._2k5vU-btn-link:focus,._2k5vU-btn-link:active{border-color:transparent}._2k5vU-btn-link:hover,._2k5vU-btn-link:focus{color:#23527c;text-decoration:underline;background-color:transparent}._2k5vU-btn-link[disabled]:hover,fieldset[disabled] ._2k5vU-btn-link:hover,._2k5vU-btn-link[disabled]:focus,fieldset[disabled] ._2k5vU-btn-link:focus{color:#777;text-decoration:none}._4Y8qI-btn-lg,._29CyS-btn-group-lg>._3-XUl-btn{padding:10px 16px;font-size:18px;line-height:1.3333333;border-radius:6px}._30Oip-btn-sm,._2eEOC-btn-group-sm>._3-XUl-btn{padding:5px 10px;font-size:12px;line-height:1.5;border-radius:3px}._2kCvE-btn-xs,._1A2Tx-btn-group-xs>._3-XUl-btn{padding:1px 5px;font-size:12px;line-height:1.5;border-radius:3px}._3micb-btn-block{display:block;width:100%}._3micb-btn-block+._3micb-btn-block{margin-top:5px}input[type="submit"]._3micb-btn-block,input[type="reset"]._3micb-btn-block,input[type="button"]._3micb-btn-block{width:100%}._2yrMO-btn-group,.eNKyK-btn-group-vertical{position:relative;display:inline-block;vertical-align:middle}._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{position:relative;float:left}._2yrMO-btn-group>._3-XUl-btn:hover,.eNKyK-btn-group-vertical>._3-XUl-btn:hover,._2yrMO-btn-group>._3-XUl-btn:focus,.eNKyK-btn-group-vertical>._3-XUl-btn:focus,._2yrMO-btn-group>._3-XUl-btn:active,.eNKyK-btn-group-vertical>._3-XUl-btn:active,._2yrMO-btn-group>._3-XUl-btn._2tvYY-active,.eNKyK-btn-group-vertical>._3-XUl-btn._2tvYY-active{z-index:2}._2yrMO-btn-group ._3-XUl-btn+._3-XUl-btn,._2yrMO-btn-group ._3-XUl-btn+._2yrMO-btn-group,._2yrMO-btn-group ._2yrMO-btn-group+._3-XUl-btn,._2yrMO-btn-group ._2yrMO-btn-group+._2yrMO-btn-group{margin-left:-1px}._1TX6t-btn-toolbar{margin-left:-5px}._1TX6t-btn-toolbar ._3-XUl-btn,._1TX6t-btn-toolbar ._2yrMO-btn-group,._1TX6t-btn-toolbar ._2lgpZ-input-group{float:left}._1TX6t-btn-toolbar>._3-XUl-btn,._1TX6t-btn-toolbar>._2yrMO-btn-group,._1TX6t-btn-toolbar>._2lgpZ-input-group{margin-left:5px}._2yrMO-btn-group>._3-XUl-btn:not(:first-child):not(:last-child):not(._2KnYE-dropdown-toggle){border-radius:0}._2yrMO-btn-group>._3-XUl-btn:first-child{margin-left:0}._2yrMO-btn-group>._3-XUl-btn:first-child:not(:last-child):not(._2KnYE-dropdown-toggle){border-top-right-radius:0;border-bottom-right-radius:0}._2yrMO-btn-group>._3-XUl-btn:last-child:not(:first-child),._2yrMO-btn-group>._2KnYE-dropdown-toggle:not(:first-child){border-top-left-radius:0;border-bottom-left-radius:0}._2yrMO-btn-group>._2yrMO-btn-group{float:left}._2yrMO-btn-group>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-top-right-radius:0;border-bottom-right-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-left-radius:0;border-bottom-left-radius:0}._2yrMO-btn-group ._2KnYE-dropdown-toggle:active,._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{outline:0}._2yrMO-btn-group>._3-XUl-btn+._2KnYE-dropdown-toggle{padding-right:8px;padding-left:8px}._2yrMO-btn-group>._4Y8qI-btn-lg+._2KnYE-dropdown-toggle{padding-right:12px;padding-left:12px}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle._2k5vU-btn-link{-webkit-box-shadow:none;box-shadow:none}._3-XUl-btn .WfXRY-caret{margin-left:0}._4Y8qI-btn-lg .WfXRY-caret{border-width:5px 5px 0;border-bottom-width:0}.xQM4X-dropup ._4Y8qI-btn-lg .WfXRY-caret{border-width:0 5px 5px}.eNKyK-btn-group-vertical>._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{display:block;float:none;width:100%;max-width:100%}.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{float:none}.eNKyK-btn-group-vertical>._3-XUl-btn+._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._2yrMO-btn-group{margin-top:-1px;margin-left:0}.eNKyK-btn-group-vertical>._3-XUl-btn:not(:first-child):not(:last-child){border-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:first-child:not(:last-child){border-top-left-radius:4px;border-top-right-radius:4px;border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:last-child:not(:first-child){border-top-left-radius:0;border-top-right-radius:0;border-bottom-right-radius:4px;border-bottom-left-radius:4px}.eNKyK-btn-group-vertical>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-left-radius:0;border-top-right-radius:0}.gzM9c-btn-group-justified{display:table;width:100%;table-layout:fixed;border-collapse:separate}.gzM9c-btn-group-justified>._3-XUl-btn,.gzM9c-btn-group-justified>._2yrMO-btn-group{display:table-cell;float:none;width:1%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._3-XUl-btn{width:100%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._5aG9w-dropdown-menu{left:auto}[data-toggle="buttons"]>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._3-XUl-btn input[type="checkbox"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="checkbox"]{position:absolute;clip:rect(0, 0, 0, 0);pointer-events:none}._18V3M-nav{padding-left:0;margin-bottom:0;list-style:none}._18V3M-nav>li{position:relative;display:block}._18V3M-nav>li>a{position:relative;display:block;padding:10px 15px}._18V3M-nav>li>a:hover,._18V3M-nav>li>a:focus{text-decoration:none;background-color:#eee}._18V3M-nav>li._2UPmc-disabled>a{color:#777}._18V3M-nav>li._2UPmc-disabled>a:hover,._18V3M-nav>li._2UPmc-disabled>a:focus{color:#777;text-decoration:none;cursor:not-allowed;background-color:transparent}._18V3M-nav .cl_nk-open>a,._18V3M-nav .cl_nk-open>a:hover,._18V3M-nav .cl_nk-open>a:focus{background-color:#eee;border-color:#337ab7}._18V3M-nav ._3jwhe-nav-divider{height:1px;margin:9px 0;overflow:hidden;background-color:#e5e5e5}._18V3M-nav>li>a>img{max-width:none}._2ciYP-nav-tabs{border-bottom:1px solid #ddd}._2ciYP-nav-tabs>li{float:left;margin-bottom:-1px}._2ciYP-nav-tabs>li>a{margin-right:2px;line-height:1.42857143;border:1px solid transparent;border-radius:4px 4px 0 0}._2ciYP-nav-tabs>li>a:hover{border-color:#eee #eee #ddd}._2ciYP-nav-tabs>li._2tvYY-active>a,._2ciYP-nav-tabs>li._2tvYY-active>a:hover,._2ciYP-nav-tabs>li._2tvYY-active>a:focus{color:#555;cursor:default;background-color:#fff;border:1px solid #ddd;border-bottom-color:transparent}._2ciYP-nav-tabs._3nLJA-nav-justified{width:100%;border-bottom:0}._2ciYP-nav-tabs._3nLJA-nav-justified>li{float:none}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:5px;text-align:center}._2ciYP-nav-tabs._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li{display:table-cell;width:1%}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:0}}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-right:0;border-radius:4px}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}._3i5kW-nav-pills>li{float:left}._3i5kW-nav-pills>li>a{border-radius:4px}._3i5kW-nav-pills>li+li{margin-left:2px}._3i5kW-nav-pills>li._2tvYY-active>a,._3i5kW-nav-pills>li._2tvYY-active>a:hover,._3i5kW-nav-pills>li._2tvYY-active>a:focus{color:#fff;background-color:#337ab7}._3pfVZ-nav-stacked>li{float:none}._3pfVZ-nav-stacked>li+li{margin-top:2px;margin-left:0}._3nLJA-nav-justified{width:100%}._3nLJA-nav-justified>li{float:none}._3nLJA-nav-justified>li>a{margin-bottom:5px;text-align:center}._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._3nLJA-nav-justified>li{display:table-cell;width:1%}._3nLJA-nav-justified>li>a{margin-bottom:0}}._18sQL-nav-tabs-justified{border-bottom:0}._18sQL-nav-tabs-justified>li>a{margin-right:0;border-radius:4px}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._18sQL-nav-tabs-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}.FgbPD-tab-content>._2q1VF-tab-pane{display:none}.FgbPD-tab-content>._2tvYY-active{display:block}._2ciYP-nav-tabs ._5aG9w-dropdown-menu{margin-top:-1px;border-top-left-radius:0;border-top-right-radius:0}.n15Mn-pagination{display:inline-block;padding-left:0;margin:20px 0;border-radius:4px}.n15Mn-pagination>li{display:inline}.n15Mn-pagination>li>a,.n15Mn-pagination>li>span{position:relative;float:left;padding:6px 12px;margin-left:-1px;line-height:1.42857143;color:#337ab7;text-decoration:none;background-color:#fff;border:1px solid #ddd}.n15Mn-pagination>li>a:hover,.n15Mn-pagination>li>span:hover,.n15Mn-pagination>li>a:focus,.n15Mn-pagination>li>span:focus{z-index:2;color:#23527c;background-color:#eee;border-color:#ddd}.n15Mn-pagination>li:first-child>a,.n15Mn-pagination>li:first-child>span{margin-left:0;border-top-left-radius:4px;border-bottom-left-radius:4px}.n15Mn-pagination>li:last-child>a,.n15Mn-pagination>li:last-child>span{border-top-right-radius:4px;border-bottom-right-radius:4px}.n15Mn-pagination>._2tvYY-active>a,.n15Mn-pagination>._2tvYY-active>span,.n15Mn-pagination>._2tvYY-active>a:hover,.n15Mn-pagination>._2tvYY-active>span:hover,.n15Mn-pagination>._2tvYY-active>a:focus,.n15Mn-pagination>._2tvYY-active>span:focus{z-index:3;color:#fff;cursor:default;background-color:#337ab7;border-color:#337ab7}.n15Mn-pagination>._2UPmc-disabled>span,.n15Mn-pagination>._2UPmc-disabled>span:hover,.n15Mn-pagination>._2UPmc-disabled>span:focus,.n15Mn-pagination>._2UPmc-disabled>a,.n15Mn-pagination>._2UPmc-disabled>a:hover,.n15Mn-pagination>._2UPmc-disabled>a:focus{color:#777;cursor:not-allowed;background-color:#fff;border-color:#ddd}.L5I4g-pagination-lg>li>a,.L5I4g-pagination-lg>li>span{padding:10px 16px;font-size:18px;line-height:1.3333333}.L5I4g-pagination-lg>li:first-child>a,.L5I4g-pagination-lg>li:first-child>span{border-top-left-radius:6px;border-bottom-left-radius:6px}.L5I4g-pagination-lg>li:last-child>a,.L5I4g-pagination-lg>li:last-child>span{border-top-right-radius:6px;border-bottom-right-radius:6px}._3rY2q-pagination-sm>li>a,._3rY2q-pagination-sm>li>span{padding:5px 10px;font-size:12px;line-height:1.5}._3rY2q-pagination-sm>li:first-child>a,._3rY2q-pagination-sm>li:first-child>span{border-top-left-radius:3px;border-bottom-left-radius:3px}._3rY2q-pagination-sm>li:last-child>a,._3rY2q-pagination-sm>li:last-child>span{border-top-right-radius:3px;border-bottom-right-radius:3px}.bv_Mo-panel{margin-bottom:20px;background-color:#fff;border:1px solid transparent;border-radius:4px;-webkit-box-shadow:0 1px 1px rgba(0,0,0,0.05);box-shadow:0 1px 1px rgba(0,0,0,0.05)}._2wvLn-panel-body{padding:15px}._31mw8-panel-heading{padding:10px 15px;border-bottom:1px solid transparent;border-top-left-radius:3px;border-top-right-radius:3px}._31mw8-panel-heading>._3oGVD-dropdown ._2KnYE-dropdown-toggle{color:inherit}._112QQ-panel-title{margin-top:0;margin-bottom:0;font-size:16px;color:inherit}._112QQ-panel-title>a,._112QQ-panel-title>small,._112QQ-panel-title>._1xqEr-small,._112QQ-panel-title>small>a,._112QQ-panel-title>._1xqEr-small>a{color:inherit}._1qtb5-panel-footer{padding:10px 15px;background-color:#f5f5f5;border-top:1px solid #ddd;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group{margin-bottom:0}.bv_Mo-panel>._1vMKW-list-group .rhZLN-list-group-item,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item{border-width:1px 0;border-radius:0}.bv_Mo-panel>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child{border-top:0;border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child{border-bottom:0;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-left-radius:0;border-top-right-radius:0}._31mw8-panel-heading+._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-width:0}._1vMKW-list-group+._1qtb5-panel-footer{border-top-width:0}.bv_Mo-panel>.fHH5l-table,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table{margin-bottom:0}.bv_Mo-panel>.fHH5l-table caption,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table caption,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table caption{padding-right:15px;padding-left:15px}.bv_Mo-panel>.fHH5l-table:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child{border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child{border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child{border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child{border-bottom-right-radius:3px}.bv_Mo-panel>._2wvLn-panel-body+.fHH5l-table,.bv_Mo-panel>._2wvLn-panel-body+._3oGxj-table-responsive,.bv_Mo-panel>.fHH5l-table+._2wvLn-panel-body,.bv_Mo-panel>._3oGxj-table-responsive+._2wvLn-panel-body{border-top:1px solid #ddd}.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child th,.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child td{border-top:0}.bv_Mo-panel>._3qUkR-table-bordered,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>th{border-bottom:0}.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th{border-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive{margin-bottom:0;border:0}._2V8WR-panel-group{margin-bottom:20px}._2V8WR-panel-group .bv_Mo-panel{margin-bottom:0;border-radius:4px}._2V8WR-panel-group .bv_Mo-panel+.bv_Mo-panel{margin-top:5px}._2V8WR-panel-group ._31mw8-panel-heading{border-bottom:0}._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body,._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group{border-top:1px solid #ddd}._2V8WR-panel-group ._1qtb5-panel-footer{border-top:0}._2V8WR-panel-group ._1qtb5-panel-footer+._162Ti-panel-collapse ._2wvLn-panel-body{border-bottom:1px solid #ddd}._2diFa-panel-default{border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading{color:#333;background-color:#f5f5f5;border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading ._1XX3z-badge{color:#f5f5f5;background-color:#333}._2diFa-panel-default>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ddd}._1I52t-panel-primary{border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading{color:#fff;background-color:#337ab7;border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading ._1XX3z-badge{color:#337ab7;background-color:#fff}._1I52t-panel-primary>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#337ab7}._1mEg2-panel-success{border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading{color:#3c763d;background-color:#dff0d8;border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading ._1XX3z-badge{color:#dff0d8;background-color:#3c763d}._1mEg2-panel-success>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#d6e9c6}._2rUCo-panel-info{border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading{color:#31708f;background-color:#d9edf7;border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading ._1XX3z-badge{color:#d9edf7;background-color:#31708f}._2rUCo-panel-info>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#bce8f1}._1ozkU-panel-warning{border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading{color:#8a6d3b;background-color:#fcf8e3;border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading ._1XX3z-badge{color:#fcf8e3;background-color:#8a6d3b}._1ozkU-panel-warning>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#faebcc}._3dZ2--panel-danger{border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading{color:#a94442;background-color:#f2dede;border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading ._1XX3z-badge{color:#f2dede;background-color:#a94442}._3dZ2--panel-danger>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ebccd1}._2U5Vc-clearfix:before,._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:before,._2DZom-dl-horizontal dd:after,.GfVfY-container:before,.GfVfY-container:after,._1TgHS-container-fluid:before,._1TgHS-container-fluid:after,._3XjgV-row:before,._3XjgV-row:after,._1TX6t-btn-toolbar:before,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:before,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:before,._18V3M-nav:after,._2wvLn-panel-body:before,._2wvLn-panel-body:after{display:table;content:" "}._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:after,.GfVfY-container:after,._1TgHS-container-fluid:after,._3XjgV-row:after,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:after,._2wvLn-panel-body:after{clear:both}._1efU5-center-block{display:block;margin-right:auto;margin-left:auto}._1DwIR-pull-right{float:right !important}._3XXWK-pull-left{float:left !important}.mceZN-hide{display:none !important}._3CqPx-show{display:block !important}._2ZgjX-invisible{visibility:hidden}._1y8Wm-text-hide{font:0/0 a;color:transparent;text-shadow:none;background-color:transparent;border:0}._15mml-hidden{display:none !important}.-JzKj-affix{position:fixed}@-ms-viewport{width:device-width}._3Pjmx-visible-xs,.vFgce-visible-sm,._3qTZ9-visible-md,.tk8mW-visible-lg{display:none !important}._1Dhak-visible-xs-block,._2NJ-T-visible-xs-inline,._1rjwZ-visible-xs-inline-block,._2cGpj-visible-sm-block,._14OBx-visible-sm-inline,._1IBCe-visible-sm-inline-block,._1b70N-visible-md-block,._2FNrX-visible-md-inline,._1MCNX-visible-md-inline-block,.yzCgL-visible-lg-block,._1Ad52-visible-lg-inline,._2hCmO-visible-lg-inline-block{display:none !important}@media (max-width:767px){._3Pjmx-visible-xs{display:block !important}table._3Pjmx-visible-xs{display:table !important}tr._3Pjmx-visible-xs{display:table-row !important}th._3Pjmx-visible-xs,td._3Pjmx-visible-xs{display:table-cell !important}}@media (max-width:767px){._1Dhak-visible-xs-block{display:block !important}}@media (max-width:767px){._2NJ-T-visible-xs-inline{display:inline !important}}@media (max-width:767px){._1rjwZ-visible-xs-inline-block{display:inline-block !important}}@media (min-width:768px) and (max-width:991px){.vFgce-visible-sm{display:block !important}table.vFgce-visible-sm{display:table !important}tr.vFgce-visible-sm{display:table-row !important}th.vFgce-visible-sm,td.vFgce-visible-sm{display:table-cell !important}}@media (min-width:768px) and (max-width:991px){._2cGpj-visible-sm-block{display:block !important}}@media (min-width:768px) and (max-width:991px){._14OBx-visible-sm-inline{display:inline !important}}@media (min-width:768px) and (max-width:991px){._1IBCe-visible-sm-inline-block{display:inline-block !important}}@media (min-width:992px) and (max-width:1199px){._3qTZ9-visible-md{display:block !important}table._3qTZ9-visible-md{display:table !important}tr._3qTZ9-visible-md{display:table-row !important}th._3qTZ9-visible-md,td._3qTZ9-visible-md{display:table-cell !important}}@media (min-width:992px) and (max-width:1199px){._1b70N-visible-md-block{display:block !important}}@media (min-width:992px) and (max-width:1199px){._2FNrX-visible-md-inline{display:inline !important}}@media (min-width:992px) and (max-width:1199px){._1MCNX-visible-md-inline-block{display:inline-block !important}}@media (min-width:1200px){.tk8mW-visible-lg{display:block !important}table.tk8mW-visible-lg{display:table !important}tr.tk8mW-visible-lg{display:table-row !important}th.tk8mW-visible-lg,td.tk8mW-visible-lg{display:table-cell !important}}@media (min-width:1200px){.yzCgL-visible-lg-block{display:block !important}}@media (min-width:1200px){._1Ad52-visible-lg-inline{display:inline !important}}@media (min-width:1200px){._2hCmO-visible-lg-inline-block{display:inline-block !important}}@media (max-width:767px){._1qlNJ-hidden-xs{display:none !important}}@media (min-width:768px) and (max-width:991px){._1UcP8-hidden-sm{display:none !important}}@media (min-width:992px) and (max-width:1199px){.TILkE-hidden-md{display:none !important}}@media (min-width:1200px){._2wXaD-hidden-lg{display:none !important}}._3ADjb-visible-print{display:none !important}@media print{._3ADjb-visible-print{display:block !important}table._3ADjb-visible-print{display:table !important}tr._3ADjb-visible-print{display:table-row !important}th._3ADjb-visible-print,td._3ADjb-visible-print{display:table-cell !important}}._3gdWc-visible-print-block{display:none !important}@media print{._3gdWc-visible-print-block{display:block !important}}._2HCJf-visible-print-inline{display:none !important}@media print{._2HCJf-visible-print-inline{display:inline !important}}._2oyVc-visible-print-inline-block{display:none !important}@media print{._2oyVc-visible-print-inline-block{display:inline-block !important}}@media print{.xjc1G-hidden-print{display:none !important}}',""]),t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","row-no-gutters":"_3-ExX-row-no-gutters",rowNoGutters:"_3-ExX-row-no-gutters","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t,n){!function(t,r){e.exports=r(n(0))}(0,function(e){return function(e){function t(r){if(n[r])return n[r].exports;var o=n[r]={i:r,l:!1,exports:{}};return e[r].call(o.exports,o,o.exports,t),o.l=!0,o.exports}var n={};return t.m=e,t.c=n,t.d=function(e,n,r){t.o(e,n)||Object.defineProperty(e,n,{enumerable:!0,get:r})},t.r=function(e){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(e,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(e,"__esModule",{value:!0})},t.t=function(e,n){if(1&n&&(e=t(e)),8&n)return e;if(4&n&&"object"==typeof e&&e&&e.__esModule)return e;var r=Object.create(null);if(t.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:e}),2&n&&"string"!=typeof e)for(var o in e)t.d(r,o,function(t){return e[t]}.bind(null,o));return r},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=48)}([function(t,n){t.exports=e},function(e,t){var n=e.exports={version:"2.6.12"};"number"==typeof __e&&(__e=n)},function(e,t,n){var r=n(26)("wks"),o=n(17),a=n(3).Symbol,l="function"==typeof a;(e.exports=function(e){return r[e]||(r[e]=l&&a[e]||(l?a:o)("Symbol."+e))}).store=r},function(e,t){var n=e.exports="undefined"!=typeof window&&window.Math==Math?window:"undefined"!=typeof self&&self.Math==Math?self:Function("return this")();"number"==typeof __g&&(__g=n)},function(e,t,n){e.exports=!n(8)(function(){return 7!=Object.defineProperty({},"a",{get:function(){return 7}}).a})},function(e,t){var n={}.hasOwnProperty;e.exports=function(e,t){return n.call(e,t)}},function(e,t,n){var r=n(7),o=n(16);e.exports=n(4)?function(e,t,n){return r.f(e,t,o(1,n))}:function(e,t,n){return e[t]=n,e}},function(e,t,n){var r=n(10),o=n(35),a=n(23),l=Object.defineProperty;t.f=n(4)?Object.defineProperty:function(e,t,n){if(r(e),t=a(t,!0),r(n),o)try{return l(e,t,n)}catch(e){}if("get"in n||"set"in n)throw TypeError("Accessors not supported!");return"value"in n&&(e[t]=n.value),e}},function(e,t){e.exports=function(e){try{return!!e()}catch(e){return!0}}},function(e,t,n){var r=n(40),o=n(22);e.exports=function(e){return r(o(e))}},function(e,t,n){var r=n(11);e.exports=function(e){if(!r(e))throw TypeError(e+" is not an object!");return e}},function(e,t){e.exports=function(e){return"object"==typeof e?null!==e:"function"==typeof e}},function(e,t){e.exports={}},function(e,t,n){var r=n(39),o=n(27);e.exports=Object.keys||function(e){return r(e,o)}},function(e,t){e.exports=!0},function(e,t,n){var r=n(3),o=n(1),a=n(53),l=n(6),i=n(5),s=function(e,t,n){var c,u,f,d=e&s.F,p=e&s.G,b=e&s.S,h=e&s.P,m=e&s.B,g=e&s.W,v=p?o:o[t]||(o[t]={}),y=v.prototype,_=p?r:b?r[t]:(r[t]||{}).prototype;for(c in p&&(n=t),n)(u=!d&&_&&void 0!==_[c])&&i(v,c)||(f=u?_[c]:n[c],v[c]=p&&"function"!=typeof _[c]?n[c]:m&&u?a(f,r):g&&_[c]==f?function(e){var t=function(t,n,r){if(this instanceof e){switch(arguments.length){case 0:return new e;case 1:return new e(t);case 2:return new e(t,n)}return new e(t,n,r)}return e.apply(this,arguments)};return t.prototype=e.prototype,t}(f):h&&"function"==typeof f?a(Function.call,f):f,h&&((v.virtual||(v.virtual={}))[c]=f,e&s.R&&y&&!y[c]&&l(y,c,f)))};s.F=1,s.G=2,s.S=4,s.P=8,s.B=16,s.W=32,s.U=64,s.R=128,e.exports=s},function(e,t){e.exports=function(e,t){return{enumerable:!(1&e),configurable:!(2&e),writable:!(4&e),value:t}}},function(e,t){var n=0,r=Math.random();e.exports=function(e){return"Symbol(".concat(void 0===e?"":e,")_",(++n+r).toString(36))}},function(e,t,n){var r=n(22);e.exports=function(e){return Object(r(e))}},function(e,t){t.f={}.propertyIsEnumerable},function(e,t,n){"use strict";var r=n(52)(!0);n(34)(String,"String",function(e){this._t=String(e),this._i=0},function(){var e,t=this._t,n=this._i;return n>=t.length?{value:void 0,done:!0}:(e=r(t,n),this._i+=e.length,{value:e,done:!1})})},function(e,t){var n=Math.ceil,r=Math.floor;e.exports=function(e){return isNaN(e=+e)?0:(e>0?r:n)(e)}},function(e,t){e.exports=function(e){if(null==e)throw TypeError("Can't call method on  "+e);return e}},function(e,t,n){var r=n(11);e.exports=function(e,t){if(!r(e))return e;var n,o;if(t&&"function"==typeof(n=e.toString)&&!r(o=n.call(e)))return o;if("function"==typeof(n=e.valueOf)&&!r(o=n.call(e)))return o;if(!t&&"function"==typeof(n=e.toString)&&!r(o=n.call(e)))return o;throw TypeError("Can't convert object to primitive value")}},function(e,t){var n={}.toString;e.exports=function(e){return n.call(e).slice(8,-1)}},function(e,t,n){var r=n(26)("keys"),o=n(17);e.exports=function(e){return r[e]||(r[e]=o(e))}},function(e,t,n){var r=n(1),o=n(3),a=o["__core-js_shared__"]||(o["__core-js_shared__"]={});(e.exports=function(e,t){return a[e]||(a[e]=void 0!==t?t:{})})("versions",[]).push({version:r.version,mode:n(14)?"pure":"global",copyright:"© 2020 Denis Pushkarev (zloirock.ru)"})},function(e,t){e.exports="constructor,hasOwnProperty,isPrototypeOf,propertyIsEnumerable,toLocaleString,toString,valueOf".split(",")},function(e,t,n){var r=n(7).f,o=n(5),a=n(2)("toStringTag");e.exports=function(e,t,n){e&&!o(e=n?e:e.prototype,a)&&r(e,a,{configurable:!0,value:t})}},function(e,t,n){n(62);for(var r=n(3),o=n(6),a=n(12),l=n(2)("toStringTag"),i="CSSRuleList,CSSStyleDeclaration,CSSValueList,ClientRectList,DOMRectList,DOMStringList,DOMTokenList,DataTransferItemList,FileList,HTMLAllCollection,HTMLCollection,HTMLFormElement,HTMLSelectElement,MediaList,MimeTypeArray,NamedNodeMap,NodeList,PaintRequestList,Plugin,PluginArray,SVGLengthList,SVGNumberList,SVGPathSegList,SVGPointList,SVGStringList,SVGTransformList,SourceBufferList,StyleSheetList,TextTrackCueList,TextTrackList,TouchList".split(","),s=0;s<i.length;s++){var c=i[s],u=r[c],f=u&&u.prototype;f&&!f[l]&&o(f,l,c),a[c]=a.Array}},function(e,t,n){t.f=n(2)},function(e,t,n){var r=n(3),o=n(1),a=n(14),l=n(30),i=n(7).f;e.exports=function(e){var t=o.Symbol||(o.Symbol=a?{}:r.Symbol||{});"_"==e.charAt(0)||e in t||i(t,e,{value:l.f(e)})}},function(e,t){t.f=Object.getOwnPropertySymbols},function(e,t){e.exports=function(e,t,n){return Math.min(Math.max(e,t),n)}},function(e,t,n){"use strict";var r=n(14),o=n(15),a=n(37),l=n(6),i=n(12),s=n(55),c=n(28),u=n(61),f=n(2)("iterator"),d=!([].keys&&"next"in[].keys()),p=function(){return this};e.exports=function(e,t,n,b,h,m,g){s(n,t,b);var v,y,_,x=function(e){if(!d&&e in O)return O[e];switch(e){case"keys":case"values":return function(){return new n(this,e)}}return function(){return new n(this,e)}},w=t+" Iterator",k="values"==h,E=!1,O=e.prototype,P=O[f]||O["@@iterator"]||h&&O[h],C=P||x(h),j=h?k?x("entries"):C:void 0,S="Array"==t&&O.entries||P;if(S&&(_=u(S.call(new e)))!==Object.prototype&&_.next&&(c(_,w,!0),r||"function"==typeof _[f]||l(_,f,p)),k&&P&&"values"!==P.name&&(E=!0,C=function(){return P.call(this)}),r&&!g||!d&&!E&&O[f]||l(O,f,C),i[t]=C,i[w]=p,h)if(v={values:k?C:x("values"),keys:m?C:x("keys"),entries:j},g)for(y in v)y in O||a(O,y,v[y]);else o(o.P+o.F*(d||E),t,v);return v}},function(e,t,n){e.exports=!n(4)&&!n(8)(function(){return 7!=Object.defineProperty(n(36)("div"),"a",{get:function(){return 7}}).a})},function(e,t,n){var r=n(11),o=n(3).document,a=r(o)&&r(o.createElement);e.exports=function(e){return a?o.createElement(e):{}}},function(e,t,n){e.exports=n(6)},function(e,t,n){var r=n(10),o=n(56),a=n(27),l=n(25)("IE_PROTO"),i=function(){},s=function(){var e,t=n(36)("iframe"),r=a.length;for(t.style.display="none",n(60).appendChild(t),t.src="javascript:",(e=t.contentWindow.document).open(),e.write("<script>document.F=Object<\/script>"),e.close(),s=e.F;r--;)delete s.prototype[a[r]];return s()};e.exports=Object.create||function(e,t){var n;return null!==e?(i.prototype=r(e),n=new i,i.prototype=null,n[l]=e):n=s(),void 0===t?n:o(n,t)}},function(e,t,n){var r=n(5),o=n(9),a=n(57)(!1),l=n(25)("IE_PROTO");e.exports=function(e,t){var n,i=o(e),s=0,c=[];for(n in i)n!=l&&r(i,n)&&c.push(n);for(;t.length>s;)r(i,n=t[s++])&&(~a(c,n)||c.push(n));return c}},function(e,t,n){var r=n(24);e.exports=Object("z").propertyIsEnumerable(0)?Object:function(e){return"String"==r(e)?e.split(""):Object(e)}},function(e,t,n){var r=n(39),o=n(27).concat("length","prototype");t.f=Object.getOwnPropertyNames||function(e){return r(e,o)}},function(e,t,n){var r=n(24),o=n(2)("toStringTag"),a="Arguments"==r(function(){return arguments}());e.exports=function(e){var t,n,l;return void 0===e?"Undefined":null===e?"Null":"string"==typeof(n=function(e,t){try{return e[t]}catch(e){}}(t=Object(e),o))?n:a?r(t):"Object"==(l=r(t))&&"function"==typeof t.callee?"Arguments":l}},function(e,t){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(e){"object"==typeof window&&(n=window)}e.exports=n},function(e,t){var n=/-?\d+(\.\d+)?%?/g;e.exports=function(e){return e.match(n)}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}Object.defineProperty(t,"__esModule",{value:!0}),t.getBase16Theme=t.createStyling=t.invertTheme=void 0;var o=r(n(49)),a=r(n(76)),l=r(n(81)),i=r(n(89)),s=r(n(93)),c=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(n(94)),u=r(n(132)),f=r(n(133)),d=r(n(138)),p=n(139),b=c.default,h=(0,i.default)(b),m=(0,d.default)(f.default,p.rgb2yuv,function(e){var t,n=(0,l.default)(e,3),r=n[0],o=n[1],a=n[2];return[(t=r,t<.25?1:t<.5?.9-t:1.1-t),o,a]},p.yuv2rgb,u.default),g=function(e){return function(t){return{className:[t.className,e.className].filter(Boolean).join(" "),style:(0,a.default)({},t.style||{},e.style||{})}}},v=function(e,t){var n=(0,i.default)(t);for(var r in e)-1===n.indexOf(r)&&n.push(r);return n.reduce(function(n,r){return n[r]=function(e,t){if(void 0===e)return t;if(void 0===t)return e;var n=void 0===e?"undefined":(0,o.default)(e),r=void 0===t?"undefined":(0,o.default)(t);switch(n){case"string":switch(r){case"string":return[t,e].filter(Boolean).join(" ");case"object":return g({className:e,style:t});case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return g({className:e})(t.apply(void 0,[n].concat(o)))}}case"object":switch(r){case"string":return g({className:t,style:e});case"object":return(0,a.default)({},t,e);case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return g({style:e})(t.apply(void 0,[n].concat(o)))}}case"function":switch(r){case"string":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[g(n)({className:t})].concat(o))};case"object":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[g(n)({style:t})].concat(o))};case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[t.apply(void 0,[n].concat(o))].concat(o))}}}}(e[r],t[r]),n},{})},y=function(e,t){for(var n=arguments.length,r=Array(n>2?n-2:0),l=2;l<n;l++)r[l-2]=arguments[l];if(null===t)return e;Array.isArray(t)||(t=[t]);var s=t.map(function(t){return e[t]}).filter(Boolean),c=s.reduce(function(e,t){return"string"==typeof t?e.className=[e.className,t].filter(Boolean).join(" "):"object"===(void 0===t?"undefined":(0,o.default)(t))?e.style=(0,a.default)({},e.style,t):"function"==typeof t&&(e=(0,a.default)({},e,t.apply(void 0,[e].concat(r)))),e},{className:"",style:{}});return c.className||delete c.className,0===(0,i.default)(c.style).length&&delete c.style,c},_=t.invertTheme=function(e){return(0,i.default)(e).reduce(function(t,n){return t[n]=/^base/.test(n)?m(e[n]):"scheme"===n?e[n]+":inverted":e[n],t},{})},x=(t.createStyling=(0,s.default)(function(e){for(var t=arguments.length,n=Array(t>3?t-3:0),r=3;r<t;r++)n[r-3]=arguments[r];var o=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},l=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},c=o.defaultBase16,u=void 0===c?b:c,f=o.base16Themes,d=void 0===f?null:f,p=x(l,d);p&&(l=(0,a.default)({},p,l));var m=h.reduce(function(e,t){return e[t]=l[t]||u[t],e},{}),g=(0,i.default)(l).reduce(function(e,t){return-1===h.indexOf(t)?(e[t]=l[t],e):e},{}),_=e(m),w=v(g,_);return(0,s.default)(y,2).apply(void 0,[w].concat(n))},3),t.getBase16Theme=function(e,t){if(e&&e.extend&&(e=e.extend),"string"==typeof e){var n=e.split(":"),r=(0,l.default)(n,2),o=r[0],a=r[1];e=(t||{})[o]||c[o],"inverted"===a&&(e=_(e))}return e&&e.hasOwnProperty("base00")?e:void 0})},function(e,t,n){"use strict";function r(){r.init.call(this)}function o(e){if("function"!=typeof e)throw new TypeError('The "listener" argument must be of type Function. Received type '+typeof e)}function a(e){return void 0===e._maxListeners?r.defaultMaxListeners:e._maxListeners}function l(e,t,n,r){var l,i,s,c;if(o(n),void 0===(i=e._events)?(i=e._events=Object.create(null),e._eventsCount=0):(void 0!==i.newListener&&(e.emit("newListener",t,n.listener?n.listener:n),i=e._events),s=i[t]),void 0===s)s=i[t]=n,++e._eventsCount;else if("function"==typeof s?s=i[t]=r?[n,s]:[s,n]:r?s.unshift(n):s.push(n),(l=a(e))>0&&s.length>l&&!s.warned){s.warned=!0;var u=new Error("Possible EventEmitter memory leak detected. "+s.length+" "+String(t)+" listeners added. Use emitter.setMaxListeners() to increase limit");u.name="MaxListenersExceededWarning",u.emitter=e,u.type=t,u.count=s.length,c=u,console&&console.warn&&console.warn(c)}return e}function i(){if(!this.fired)return this.target.removeListener(this.type,this.wrapFn),this.fired=!0,0===arguments.length?this.listener.call(this.target):this.listener.apply(this.target,arguments)}function s(e,t,n){var r={fired:!1,wrapFn:void 0,target:e,type:t,listener:n},o=i.bind(r);return o.listener=n,r.wrapFn=o,o}function c(e,t,n){var r=e._events;if(void 0===r)return[];var o=r[t];return void 0===o?[]:"function"==typeof o?n?[o.listener||o]:[o]:n?function(e){for(var t=new Array(e.length),n=0;n<t.length;++n)t[n]=e[n].listener||e[n];return t}(o):f(o,o.length)}function u(e){var t=this._events;if(void 0!==t){var n=t[e];if("function"==typeof n)return 1;if(void 0!==n)return n.length}return 0}function f(e,t){for(var n=new Array(t),r=0;r<t;++r)n[r]=e[r];return n}var d,p="object"==typeof Reflect?Reflect:null,b=p&&"function"==typeof p.apply?p.apply:function(e,t,n){return Function.prototype.apply.call(e,t,n)};d=p&&"function"==typeof p.ownKeys?p.ownKeys:Object.getOwnPropertySymbols?function(e){return Object.getOwnPropertyNames(e).concat(Object.getOwnPropertySymbols(e))}:function(e){return Object.getOwnPropertyNames(e)};var h=Number.isNaN||function(e){return e!=e};e.exports=r,e.exports.once=function(e,t){return new Promise(function(n,r){function o(){void 0!==a&&e.removeListener("error",a),n([].slice.call(arguments))}var a;"error"!==t&&(a=function(n){e.removeListener(t,o),r(n)},e.once("error",a)),e.once(t,o)})},r.EventEmitter=r,r.prototype._events=void 0,r.prototype._eventsCount=0,r.prototype._maxListeners=void 0;var m=10;Object.defineProperty(r,"defaultMaxListeners",{enumerable:!0,get:function(){return m},set:function(e){if("number"!=typeof e||e<0||h(e))throw new RangeError('The value of "defaultMaxListeners" is out of range. It must be a non-negative number. Received '+e+".");m=e}}),r.init=function(){void 0!==this._events&&this._events!==Object.getPrototypeOf(this)._events||(this._events=Object.create(null),this._eventsCount=0),this._maxListeners=this._maxListeners||void 0},r.prototype.setMaxListeners=function(e){if("number"!=typeof e||e<0||h(e))throw new RangeError('The value of "n" is out of range. It must be a non-negative number. Received '+e+".");return this._maxListeners=e,this},r.prototype.getMaxListeners=function(){return a(this)},r.prototype.emit=function(e){for(var t=[],n=1;n<arguments.length;n++)t.push(arguments[n]);var r="error"===e,o=this._events;if(void 0!==o)r=r&&void 0===o.error;else if(!r)return!1;if(r){var a;if(t.length>0&&(a=t[0]),a instanceof Error)throw a;var l=new Error("Unhandled error."+(a?" ("+a.message+")":""));throw l.context=a,l}var i=o[e];if(void 0===i)return!1;if("function"==typeof i)b(i,this,t);else{var s=i.length,c=f(i,s);for(n=0;n<s;++n)b(c[n],this,t)}return!0},r.prototype.addListener=function(e,t){return l(this,e,t,!1)},r.prototype.on=r.prototype.addListener,r.prototype.prependListener=function(e,t){return l(this,e,t,!0)},r.prototype.once=function(e,t){return o(t),this.on(e,s(this,e,t)),this},r.prototype.prependOnceListener=function(e,t){return o(t),this.prependListener(e,s(this,e,t)),this},r.prototype.removeListener=function(e,t){var n,r,a,l,i;if(o(t),void 0===(r=this._events))return this;if(void 0===(n=r[e]))return this;if(n===t||n.listener===t)0==--this._eventsCount?this._events=Object.create(null):(delete r[e],r.removeListener&&this.emit("removeListener",e,n.listener||t));else if("function"!=typeof n){for(a=-1,l=n.length-1;l>=0;l--)if(n[l]===t||n[l].listener===t){i=n[l].listener,a=l;break}if(a<0)return this;0===a?n.shift():function(e,t){for(;t+1<e.length;t++)e[t]=e[t+1];e.pop()}(n,a),1===n.length&&(r[e]=n[0]),void 0!==r.removeListener&&this.emit("removeListener",e,i||t)}return this},r.prototype.off=r.prototype.removeListener,r.prototype.removeAllListeners=function(e){var t,n,r;if(void 0===(n=this._events))return this;if(void 0===n.removeListener)return 0===arguments.length?(this._events=Object.create(null),this._eventsCount=0):void 0!==n[e]&&(0==--this._eventsCount?this._events=Object.create(null):delete n[e]),this;if(0===arguments.length){var o,a=Object.keys(n);for(r=0;r<a.length;++r)"removeListener"!==(o=a[r])&&this.removeAllListeners(o);return this.removeAllListeners("removeListener"),this._events=Object.create(null),this._eventsCount=0,this}if("function"==typeof(t=n[e]))this.removeListener(e,t);else if(void 0!==t)for(r=t.length-1;r>=0;r--)this.removeListener(e,t[r]);return this},r.prototype.listeners=function(e){return c(this,e,!0)},r.prototype.rawListeners=function(e){return c(this,e,!1)},r.listenerCount=function(e,t){return"function"==typeof e.listenerCount?e.listenerCount(t):u.call(e,t)},r.prototype.listenerCount=u,r.prototype.eventNames=function(){return this._eventsCount>0?d(this._events):[]}},function(e,t,n){e.exports.Dispatcher=n(140)},function(e,t,n){e.exports=n(142)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=r(n(50)),a=r(n(65)),l="function"==typeof a.default&&"symbol"==typeof o.default?function(e){return typeof e}:function(e){return e&&"function"==typeof a.default&&e.constructor===a.default&&e!==a.default.prototype?"symbol":typeof e};t.default="function"==typeof a.default&&"symbol"===l(o.default)?function(e){return void 0===e?"undefined":l(e)}:function(e){return e&&"function"==typeof a.default&&e.constructor===a.default&&e!==a.default.prototype?"symbol":void 0===e?"undefined":l(e)}},function(e,t,n){e.exports={default:n(51),__esModule:!0}},function(e,t,n){n(20),n(29),e.exports=n(30).f("iterator")},function(e,t,n){var r=n(21),o=n(22);e.exports=function(e){return function(t,n){var a,l,i=String(o(t)),s=r(n),c=i.length;return s<0||s>=c?e?"":void 0:(a=i.charCodeAt(s))<55296||a>56319||s+1===c||(l=i.charCodeAt(s+1))<56320||l>57343?e?i.charAt(s):a:e?i.slice(s,s+2):l-56320+(a-55296<<10)+65536}}},function(e,t,n){var r=n(54);e.exports=function(e,t,n){if(r(e),void 0===t)return e;switch(n){case 1:return function(n){return e.call(t,n)};case 2:return function(n,r){return e.call(t,n,r)};case 3:return function(n,r,o){return e.call(t,n,r,o)}}return function(){return e.apply(t,arguments)}}},function(e,t){e.exports=function(e){if("function"!=typeof e)throw TypeError(e+" is not a function!");return e}},function(e,t,n){"use strict";var r=n(38),o=n(16),a=n(28),l={};n(6)(l,n(2)("iterator"),function(){return this}),e.exports=function(e,t,n){e.prototype=r(l,{next:o(1,n)}),a(e,t+" Iterator")}},function(e,t,n){var r=n(7),o=n(10),a=n(13);e.exports=n(4)?Object.defineProperties:function(e,t){o(e);for(var n,l=a(t),i=l.length,s=0;i>s;)r.f(e,n=l[s++],t[n]);return e}},function(e,t,n){var r=n(9),o=n(58),a=n(59);e.exports=function(e){return function(t,n,l){var i,s=r(t),c=o(s.length),u=a(l,c);if(e&&n!=n){for(;c>u;)if((i=s[u++])!=i)return!0}else for(;c>u;u++)if((e||u in s)&&s[u]===n)return e||u||0;return!e&&-1}}},function(e,t,n){var r=n(21),o=Math.min;e.exports=function(e){return e>0?o(r(e),9007199254740991):0}},function(e,t,n){var r=n(21),o=Math.max,a=Math.min;e.exports=function(e,t){return(e=r(e))<0?o(e+t,0):a(e,t)}},function(e,t,n){var r=n(3).document;e.exports=r&&r.documentElement},function(e,t,n){var r=n(5),o=n(18),a=n(25)("IE_PROTO"),l=Object.prototype;e.exports=Object.getPrototypeOf||function(e){return e=o(e),r(e,a)?e[a]:"function"==typeof e.constructor&&e instanceof e.constructor?e.constructor.prototype:e instanceof Object?l:null}},function(e,t,n){"use strict";var r=n(63),o=n(64),a=n(12),l=n(9);e.exports=n(34)(Array,"Array",function(e,t){this._t=l(e),this._i=0,this._k=t},function(){var e=this._t,t=this._k,n=this._i++;return!e||n>=e.length?(this._t=void 0,o(1)):o(0,"keys"==t?n:"values"==t?e[n]:[n,e[n]])},"values"),a.Arguments=a.Array,r("keys"),r("values"),r("entries")},function(e,t){e.exports=function(){}},function(e,t){e.exports=function(e,t){return{value:t,done:!!e}}},function(e,t,n){e.exports={default:n(66),__esModule:!0}},function(e,t,n){n(67),n(73),n(74),n(75),e.exports=n(1).Symbol},function(e,t,n){"use strict";var r=n(3),o=n(5),a=n(4),l=n(15),i=n(37),s=n(68).KEY,c=n(8),u=n(26),f=n(28),d=n(17),p=n(2),b=n(30),h=n(31),m=n(69),g=n(70),v=n(10),y=n(11),_=n(18),x=n(9),w=n(23),k=n(16),E=n(38),O=n(71),P=n(72),C=n(32),j=n(7),S=n(13),M=P.f,T=j.f,R=O.f,L=r.Symbol,N=r.JSON,A=N&&N.stringify,D=p("_hidden"),I=p("toPrimitive"),H={}.propertyIsEnumerable,F=u("symbol-registry"),U=u("symbols"),z=u("op-symbols"),q=Object.prototype,Y="function"==typeof L&&!!C.f,W=r.QObject,V=!W||!W.prototype||!W.prototype.findChild,B=a&&c(function(){return 7!=E(T({},"a",{get:function(){return T(this,"a",{value:7}).a}})).a})?function(e,t,n){var r=M(q,t);r&&delete q[t],T(e,t,n),r&&e!==q&&T(q,t,r)}:T,K=function(e){var t=U[e]=E(L.prototype);return t._k=e,t},X=Y&&"symbol"==typeof L.iterator?function(e){return"symbol"==typeof e}:function(e){return e instanceof L},G=function(e,t,n){return e===q&&G(z,t,n),v(e),t=w(t,!0),v(n),o(U,t)?(n.enumerable?(o(e,D)&&e[D][t]&&(e[D][t]=!1),n=E(n,{enumerable:k(0,!1)})):(o(e,D)||T(e,D,k(1,{})),e[D][t]=!0),B(e,t,n)):T(e,t,n)},Q=function(e,t){v(e);for(var n,r=m(t=x(t)),o=0,a=r.length;a>o;)G(e,n=r[o++],t[n]);return e},Z=function(e){var t=H.call(this,e=w(e,!0));return!(this===q&&o(U,e)&&!o(z,e))&&(!(t||!o(this,e)||!o(U,e)||o(this,D)&&this[D][e])||t)},J=function(e,t){if(e=x(e),t=w(t,!0),e!==q||!o(U,t)||o(z,t)){var n=M(e,t);return!n||!o(U,t)||o(e,D)&&e[D][t]||(n.enumerable=!0),n}},$=function(e){for(var t,n=R(x(e)),r=[],a=0;n.length>a;)o(U,t=n[a++])||t==D||t==s||r.push(t);return r},ee=function(e){for(var t,n=e===q,r=R(n?z:x(e)),a=[],l=0;r.length>l;)!o(U,t=r[l++])||n&&!o(q,t)||a.push(U[t]);return a};Y||(i((L=function(){if(this instanceof L)throw TypeError("Symbol is not a constructor!");var e=d(arguments.length>0?arguments[0]:void 0),t=function(n){this===q&&t.call(z,n),o(this,D)&&o(this[D],e)&&(this[D][e]=!1),B(this,e,k(1,n))};return a&&V&&B(q,e,{configurable:!0,set:t}),K(e)}).prototype,"toString",function(){return this._k}),P.f=J,j.f=G,n(41).f=O.f=$,n(19).f=Z,C.f=ee,a&&!n(14)&&i(q,"propertyIsEnumerable",Z,!0),b.f=function(e){return K(p(e))}),l(l.G+l.W+l.F*!Y,{Symbol:L});for(var te="hasInstance,isConcatSpreadable,iterator,match,replace,search,species,split,toPrimitive,toStringTag,unscopables".split(","),ne=0;te.length>ne;)p(te[ne++]);for(var re=S(p.store),oe=0;re.length>oe;)h(re[oe++]);l(l.S+l.F*!Y,"Symbol",{for:function(e){return o(F,e+="")?F[e]:F[e]=L(e)},keyFor:function(e){if(!X(e))throw TypeError(e+" is not a symbol!");for(var t in F)if(F[t]===e)return t},useSetter:function(){V=!0},useSimple:function(){V=!1}}),l(l.S+l.F*!Y,"Object",{create:function(e,t){return void 0===t?E(e):Q(E(e),t)},defineProperty:G,defineProperties:Q,getOwnPropertyDescriptor:J,getOwnPropertyNames:$,getOwnPropertySymbols:ee});var ae=c(function(){C.f(1)});l(l.S+l.F*ae,"Object",{getOwnPropertySymbols:function(e){return C.f(_(e))}}),N&&l(l.S+l.F*(!Y||c(function(){var e=L();return"[null]"!=A([e])||"{}"!=A({a:e})||"{}"!=A(Object(e))})),"JSON",{stringify:function(e){for(var t,n,r=[e],o=1;arguments.length>o;)r.push(arguments[o++]);if(n=t=r[1],(y(t)||void 0!==e)&&!X(e))return g(t)||(t=function(e,t){if("function"==typeof n&&(t=n.call(this,e,t)),!X(t))return t}),r[1]=t,A.apply(N,r)}}),L.prototype[I]||n(6)(L.prototype,I,L.prototype.valueOf),f(L,"Symbol"),f(Math,"Math",!0),f(r.JSON,"JSON",!0)},function(e,t,n){var r=n(17)("meta"),o=n(11),a=n(5),l=n(7).f,i=0,s=Object.isExtensible||function(){return!0},c=!n(8)(function(){return s(Object.preventExtensions({}))}),u=function(e){l(e,r,{value:{i:"O"+ ++i,w:{}}})},f=e.exports={KEY:r,NEED:!1,fastKey:function(e,t){if(!o(e))return"symbol"==typeof e?e:("string"==typeof e?"S":"P")+e;if(!a(e,r)){if(!s(e))return"F";if(!t)return"E";u(e)}return e[r].i},getWeak:function(e,t){if(!a(e,r)){if(!s(e))return!0;if(!t)return!1;u(e)}return e[r].w},onFreeze:function(e){return c&&f.NEED&&s(e)&&!a(e,r)&&u(e),e}}},function(e,t,n){var r=n(13),o=n(32),a=n(19);e.exports=function(e){var t=r(e),n=o.f;if(n)for(var l,i=n(e),s=a.f,c=0;i.length>c;)s.call(e,l=i[c++])&&t.push(l);return t}},function(e,t,n){var r=n(24);e.exports=Array.isArray||function(e){return"Array"==r(e)}},function(e,t,n){var r=n(9),o=n(41).f,a={}.toString,l="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[];e.exports.f=function(e){return l&&"[object Window]"==a.call(e)?function(e){try{return o(e)}catch(e){return l.slice()}}(e):o(r(e))}},function(e,t,n){var r=n(19),o=n(16),a=n(9),l=n(23),i=n(5),s=n(35),c=Object.getOwnPropertyDescriptor;t.f=n(4)?c:function(e,t){if(e=a(e),t=l(t,!0),s)try{return c(e,t)}catch(e){}if(i(e,t))return o(!r.f.call(e,t),e[t])}},function(e,t){},function(e,t,n){n(31)("asyncIterator")},function(e,t,n){n(31)("observable")},function(e,t,n){"use strict";t.__esModule=!0;var r,o=n(77),a=(r=o)&&r.__esModule?r:{default:r};t.default=a.default||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){e.exports={default:n(78),__esModule:!0}},function(e,t,n){n(79),e.exports=n(1).Object.assign},function(e,t,n){var r=n(15);r(r.S+r.F,"Object",{assign:n(80)})},function(e,t,n){"use strict";var r=n(4),o=n(13),a=n(32),l=n(19),i=n(18),s=n(40),c=Object.assign;e.exports=!c||n(8)(function(){var e={},t={},n=Symbol(),r="abcdefghijklmnopqrst";return e[n]=7,r.split("").forEach(function(e){t[e]=e}),7!=c({},e)[n]||Object.keys(c({},t)).join("")!=r})?function(e,t){for(var n=i(e),c=arguments.length,u=1,f=a.f,d=l.f;c>u;)for(var p,b=s(arguments[u++]),h=f?o(b).concat(f(b)):o(b),m=h.length,g=0;m>g;)p=h[g++],r&&!d.call(b,p)||(n[p]=b[p]);return n}:c},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=r(n(82)),a=r(n(85));t.default=function(e,t){if(Array.isArray(e))return e;if((0,o.default)(Object(e)))return function(e,t){var n=[],r=!0,o=!1,l=void 0;try{for(var i,s=(0,a.default)(e);!(r=(i=s.next()).done)&&(n.push(i.value),!t||n.length!==t);r=!0);}catch(e){o=!0,l=e}finally{try{!r&&s.return&&s.return()}finally{if(o)throw l}}return n}(e,t);throw new TypeError("Invalid attempt to destructure non-iterable instance")}},function(e,t,n){e.exports={default:n(83),__esModule:!0}},function(e,t,n){n(29),n(20),e.exports=n(84)},function(e,t,n){var r=n(42),o=n(2)("iterator"),a=n(12);e.exports=n(1).isIterable=function(e){var t=Object(e);return void 0!==t[o]||"@@iterator"in t||a.hasOwnProperty(r(t))}},function(e,t,n){e.exports={default:n(86),__esModule:!0}},function(e,t,n){n(29),n(20),e.exports=n(87)},function(e,t,n){var r=n(10),o=n(88);e.exports=n(1).getIterator=function(e){var t=o(e);if("function"!=typeof t)throw TypeError(e+" is not iterable!");return r(t.call(e))}},function(e,t,n){var r=n(42),o=n(2)("iterator"),a=n(12);e.exports=n(1).getIteratorMethod=function(e){if(null!=e)return e[o]||e["@@iterator"]||a[r(e)]}},function(e,t,n){e.exports={default:n(90),__esModule:!0}},function(e,t,n){n(91),e.exports=n(1).Object.keys},function(e,t,n){var r=n(18),o=n(13);n(92)("keys",function(){return function(e){return o(r(e))}})},function(e,t,n){var r=n(15),o=n(1),a=n(8);e.exports=function(e,t){var n=(o.Object||{})[e]||Object[e],l={};l[e]=t(n),r(r.S+r.F*a(function(){n(1)}),"Object",l)}},function(e,t,n){(function(t){function n(e,t,n){switch(n.length){case 0:return e.call(t);case 1:return e.call(t,n[0]);case 2:return e.call(t,n[0],n[1]);case 3:return e.call(t,n[0],n[1],n[2])}return e.apply(t,n)}function r(e,t){return!!(e?e.length:0)&&function(e,t,n){if(t!=t)return function(e,t,n,r){for(var o=e.length,a=-1;++a<o;)if(t(e[a],a,e))return a;return-1}(e,o);for(var r=-1,a=e.length;++r<a;)if(e[r]===t)return r;return-1}(e,t)>-1}function o(e){return e!=e}function a(e,t){for(var n=e.length,r=0;n--;)e[n]===t&&r++;return r}function l(e,t){for(var n=-1,r=e.length,o=0,a=[];++n<r;){var l=e[n];l!==t&&"__lodash_placeholder__"!==l||(e[n]="__lodash_placeholder__",a[o++]=n)}return a}function i(e){return k(e)?Z(e):{}}function s(e){return!(!k(e)||function(e){return!!B&&B in e}(e))&&(function(e){var t=k(e)?G.call(e):"";return"[object Function]"==t||"[object GeneratorFunction]"==t}(e)||function(e){var t=!1;if(null!=e&&"function"!=typeof e.toString)try{t=!!(e+"")}catch(e){}return t}(e)?Q:D).test(function(e){if(null!=e){try{return K.call(e)}catch(e){}try{return e+""}catch(e){}}return""}(e))}function c(e,t,n,r){for(var o=-1,a=e.length,l=n.length,i=-1,s=t.length,c=J(a-l,0),u=Array(s+c),f=!r;++i<s;)u[i]=t[i];for(;++o<l;)(f||o<a)&&(u[n[o]]=e[o]);for(;c--;)u[i++]=e[o++];return u}function u(e,t,n,r){for(var o=-1,a=e.length,l=-1,i=n.length,s=-1,c=t.length,u=J(a-i,0),f=Array(u+c),d=!r;++o<u;)f[o]=e[o];for(var p=o;++s<c;)f[p+s]=t[s];for(;++l<i;)(d||o<a)&&(f[p+n[l]]=e[o++]);return f}function f(e){return function(){var t=arguments;switch(t.length){case 0:return new e;case 1:return new e(t[0]);case 2:return new e(t[0],t[1]);case 3:return new e(t[0],t[1],t[2]);case 4:return new e(t[0],t[1],t[2],t[3]);case 5:return new e(t[0],t[1],t[2],t[3],t[4]);case 6:return new e(t[0],t[1],t[2],t[3],t[4],t[5]);case 7:return new e(t[0],t[1],t[2],t[3],t[4],t[5],t[6])}var n=i(e.prototype),r=e.apply(n,t);return k(r)?r:n}}function d(e,t,n,r,o,i,s,b,m,g){var v=128&t,y=1&t,x=2&t,w=24&t,k=512&t,E=x?void 0:f(e);return function O(){for(var P=arguments.length,C=Array(P),j=P;j--;)C[j]=arguments[j];if(w)var S=h(O),M=a(C,S);if(r&&(C=c(C,r,o,w)),i&&(C=u(C,i,s,w)),P-=M,w&&P<g){var T=l(C,S);return p(e,t,d,O.placeholder,n,C,T,b,m,g-P)}var R=y?n:this,L=x?R[e]:e;return P=C.length,b?C=_(C,b):k&&P>1&&C.reverse(),v&&m<P&&(C.length=m),this&&this!==q&&this instanceof O&&(L=E||f(L)),L.apply(R,C)}}function p(e,t,n,r,o,a,l,i,s,c){var u=8&t;t|=u?32:64,4&(t&=~(u?64:32))||(t&=-4);var f=n(e,t,o,u?a:void 0,u?l:void 0,u?void 0:a,u?void 0:l,i,s,c);return f.placeholder=r,te(f,e,t)}function b(e,t,r,o,a,i,s,c){var u=2&t;if(!u&&"function"!=typeof e)throw new TypeError("Expected a function");var b=o?o.length:0;if(b||(t&=-97,o=a=void 0),s=void 0===s?s:J(O(s),0),c=void 0===c?c:O(c),b-=a?a.length:0,64&t){var m=o,g=a;o=a=void 0}var v=[e,t,r,o,a,m,g,i,s,c];if(e=v[0],t=v[1],r=v[2],o=v[3],a=v[4],!(c=v[9]=null==v[9]?u?0:e.length:J(v[9]-b,0))&&24&t&&(t&=-25),t&&1!=t)y=8==t||16==t?function(e,t,r){var o=f(e);return function a(){for(var i=arguments.length,s=Array(i),c=i,u=h(a);c--;)s[c]=arguments[c];var f=i<3&&s[0]!==u&&s[i-1]!==u?[]:l(s,u);return(i-=f.length)<r?p(e,t,d,a.placeholder,void 0,s,f,void 0,void 0,r-i):n(this&&this!==q&&this instanceof a?o:e,this,s)}}(e,t,c):32!=t&&33!=t||a.length?d.apply(void 0,v):function(e,t,r,o){var a=1&t,l=f(e);return function t(){for(var i=-1,s=arguments.length,c=-1,u=o.length,f=Array(u+s),d=this&&this!==q&&this instanceof t?l:e;++c<u;)f[c]=o[c];for(;s--;)f[c++]=arguments[++i];return n(d,a?r:this,f)}}(e,t,r,o);else var y=function(e,t,n){var r=1&t,o=f(e);return function t(){return(this&&this!==q&&this instanceof t?o:e).apply(r?n:this,arguments)}}(e,t,r);return te(y,e,t)}function h(e){return e.placeholder}function m(e,t){var n=function(e,t){return null==e?void 0:e[t]}(e,t);return s(n)?n:void 0}function g(e){var t=e.match(R);return t?t[1].split(L):[]}function v(e,t){var n=t.length,r=n-1;return t[r]=(n>1?"& ":"")+t[r],t=t.join(n>2?", ":" "),e.replace(T,"{\n/* [wrapped with "+t+"] */\n")}function y(e,t){return!!(t=null==t?9007199254740991:t)&&("number"==typeof e||H.test(e))&&e>-1&&e%1==0&&e<t}function _(e,t){for(var n=e.length,r=$(t.length,n),o=function(e,t){var n=-1,r=e.length;for(t||(t=Array(r));++n<r;)t[n]=e[n];return t}(e);r--;){var a=t[r];e[r]=y(a,n)?o[a]:void 0}return e}function x(e,t){return function(e,t){for(var n=-1,o=e?e.length:0;++n<o&&!1!==function(n){var o="_."+n[0];t&n[1]&&!r(e,o)&&e.push(o)}(e[n]););}(S),e.sort()}function w(e,t,n){var r=b(e,8,void 0,void 0,void 0,void 0,void 0,t=n?void 0:t);return r.placeholder=w.placeholder,r}function k(e){var t=typeof e;return!!e&&("object"==t||"function"==t)}function E(e){return e?(e=function(e){if("number"==typeof e)return e;if(function(e){return"symbol"==typeof e||function(e){return!!e&&"object"==typeof e}(e)&&"[object Symbol]"==G.call(e)}(e))return NaN;if(k(e)){var t="function"==typeof e.valueOf?e.valueOf():e;e=k(t)?t+"":t}if("string"!=typeof e)return 0===e?e:+e;e=e.replace(M,"");var n=A.test(e);return n||I.test(e)?F(e.slice(2),n?2:8):N.test(e)?NaN:+e}(e))===1/0||e===-1/0?1.7976931348623157e308*(e<0?-1:1):e==e?e:0:0===e?e:0}function O(e){var t=E(e),n=t%1;return t==t?n?t-n:t:0}var P,C,j,S=[["ary",128],["bind",1],["bindKey",2],["curry",8],["curryRight",16],["flip",512],["partial",32],["partialRight",64],["rearg",256]],M=/^\s+|\s+$/g,T=/\{(?:\n\/\* \[wrapped with .+\] \*\/)?\n?/,R=/\{\n\/\* \[wrapped with (.+)\] \*/,L=/,? & /,N=/^[-+]0x[0-9a-f]+$/i,A=/^0b[01]+$/i,D=/^\[object .+?Constructor\]$/,I=/^0o[0-7]+$/i,H=/^(?:0|[1-9]\d*)$/,F=parseInt,U="object"==typeof t&&t&&t.Object===Object&&t,z="object"==typeof self&&self&&self.Object===Object&&self,q=U||z||Function("return this")(),Y=Function.prototype,W=Object.prototype,V=q["__core-js_shared__"],B=(P=/[^.]+$/.exec(V&&V.keys&&V.keys.IE_PROTO||""))?"Symbol(src)_1."+P:"",K=Y.toString,X=W.hasOwnProperty,G=W.toString,Q=RegExp("^"+K.call(X).replace(/[\\^$.*+?()[\]{}|]/g,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$"),Z=Object.create,J=Math.max,$=Math.min,ee=(C=m(Object,"defineProperty"),(j=m.name)&&j.length>2?C:void 0),te=ee?function(e,t,n){var r,o=t+"";return ee(e,"toString",{configurable:!0,enumerable:!1,value:(r=v(o,x(g(o),n)),function(){return r})})}:function(e){return e};w.placeholder={},e.exports=w}).call(this,n(43))},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e.default:e}t.__esModule=!0;var o=n(95);t.threezerotwofour=r(o);var a=n(96);t.apathy=r(a);var l=n(97);t.ashes=r(l);var i=n(98);t.atelierDune=r(i);var s=n(99);t.atelierForest=r(s);var c=n(100);t.atelierHeath=r(c);var u=n(101);t.atelierLakeside=r(u);var f=n(102);t.atelierSeaside=r(f);var d=n(103);t.bespin=r(d);var p=n(104);t.brewer=r(p);var b=n(105);t.bright=r(b);var h=n(106);t.chalk=r(h);var m=n(107);t.codeschool=r(m);var g=n(108);t.colors=r(g);var v=n(109);t.default=r(v);var y=n(110);t.eighties=r(y);var _=n(111);t.embers=r(_);var x=n(112);t.flat=r(x);var w=n(113);t.google=r(w);var k=n(114);t.grayscale=r(k);var E=n(115);t.greenscreen=r(E);var O=n(116);t.harmonic=r(O);var P=n(117);t.hopscotch=r(P);var C=n(118);t.isotope=r(C);var j=n(119);t.marrakesh=r(j);var S=n(120);t.mocha=r(S);var M=n(121);t.monokai=r(M);var T=n(122);t.ocean=r(T);var R=n(123);t.paraiso=r(R);var L=n(124);t.pop=r(L);var N=n(125);t.railscasts=r(N);var A=n(126);t.shapeshifter=r(A);var D=n(127);t.solarized=r(D);var I=n(128);t.summerfruit=r(I);var H=n(129);t.tomorrow=r(H);var F=n(130);t.tube=r(F);var U=n(131);t.twilight=r(U)},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"threezerotwofour",author:"jan t. sott (http://github.com/idleberg)",base00:"#090300",base01:"#3a3432",base02:"#4a4543",base03:"#5c5855",base04:"#807d7c",base05:"#a5a2a2",base06:"#d6d5d4",base07:"#f7f7f7",base08:"#db2d20",base09:"#e8bbd0",base0A:"#fded02",base0B:"#01a252",base0C:"#b5e4f4",base0D:"#01a0e4",base0E:"#a16a94",base0F:"#cdab53"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"apathy",author:"jannik siebert (https://github.com/janniks)",base00:"#031A16",base01:"#0B342D",base02:"#184E45",base03:"#2B685E",base04:"#5F9C92",base05:"#81B5AC",base06:"#A7CEC8",base07:"#D2E7E4",base08:"#3E9688",base09:"#3E7996",base0A:"#3E4C96",base0B:"#883E96",base0C:"#963E4C",base0D:"#96883E",base0E:"#4C963E",base0F:"#3E965B"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"ashes",author:"jannik siebert (https://github.com/janniks)",base00:"#1C2023",base01:"#393F45",base02:"#565E65",base03:"#747C84",base04:"#ADB3BA",base05:"#C7CCD1",base06:"#DFE2E5",base07:"#F3F4F5",base08:"#C7AE95",base09:"#C7C795",base0A:"#AEC795",base0B:"#95C7AE",base0C:"#95AEC7",base0D:"#AE95C7",base0E:"#C795AE",base0F:"#C79595"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier dune",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/dune)",base00:"#20201d",base01:"#292824",base02:"#6e6b5e",base03:"#7d7a68",base04:"#999580",base05:"#a6a28c",base06:"#e8e4cf",base07:"#fefbec",base08:"#d73737",base09:"#b65611",base0A:"#cfb017",base0B:"#60ac39",base0C:"#1fad83",base0D:"#6684e1",base0E:"#b854d4",base0F:"#d43552"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier forest",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/forest)",base00:"#1b1918",base01:"#2c2421",base02:"#68615e",base03:"#766e6b",base04:"#9c9491",base05:"#a8a19f",base06:"#e6e2e0",base07:"#f1efee",base08:"#f22c40",base09:"#df5320",base0A:"#d5911a",base0B:"#5ab738",base0C:"#00ad9c",base0D:"#407ee7",base0E:"#6666ea",base0F:"#c33ff3"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier heath",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/heath)",base00:"#1b181b",base01:"#292329",base02:"#695d69",base03:"#776977",base04:"#9e8f9e",base05:"#ab9bab",base06:"#d8cad8",base07:"#f7f3f7",base08:"#ca402b",base09:"#a65926",base0A:"#bb8a35",base0B:"#379a37",base0C:"#159393",base0D:"#516aec",base0E:"#7b59c0",base0F:"#cc33cc"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier lakeside",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/lakeside/)",base00:"#161b1d",base01:"#1f292e",base02:"#516d7b",base03:"#5a7b8c",base04:"#7195a8",base05:"#7ea2b4",base06:"#c1e4f6",base07:"#ebf8ff",base08:"#d22d72",base09:"#935c25",base0A:"#8a8a0f",base0B:"#568c3b",base0C:"#2d8f6f",base0D:"#257fad",base0E:"#5d5db1",base0F:"#b72dd2"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier seaside",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/seaside/)",base00:"#131513",base01:"#242924",base02:"#5e6e5e",base03:"#687d68",base04:"#809980",base05:"#8ca68c",base06:"#cfe8cf",base07:"#f0fff0",base08:"#e6193c",base09:"#87711d",base0A:"#c3c322",base0B:"#29a329",base0C:"#1999b3",base0D:"#3d62f5",base0E:"#ad2bee",base0F:"#e619c3"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"bespin",author:"jan t. sott",base00:"#28211c",base01:"#36312e",base02:"#5e5d5c",base03:"#666666",base04:"#797977",base05:"#8a8986",base06:"#9d9b97",base07:"#baae9e",base08:"#cf6a4c",base09:"#cf7d34",base0A:"#f9ee98",base0B:"#54be0d",base0C:"#afc4db",base0D:"#5ea6ea",base0E:"#9b859d",base0F:"#937121"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"brewer",author:"timothée poisot (http://github.com/tpoisot)",base00:"#0c0d0e",base01:"#2e2f30",base02:"#515253",base03:"#737475",base04:"#959697",base05:"#b7b8b9",base06:"#dadbdc",base07:"#fcfdfe",base08:"#e31a1c",base09:"#e6550d",base0A:"#dca060",base0B:"#31a354",base0C:"#80b1d3",base0D:"#3182bd",base0E:"#756bb1",base0F:"#b15928"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"bright",author:"chris kempson (http://chriskempson.com)",base00:"#000000",base01:"#303030",base02:"#505050",base03:"#b0b0b0",base04:"#d0d0d0",base05:"#e0e0e0",base06:"#f5f5f5",base07:"#ffffff",base08:"#fb0120",base09:"#fc6d24",base0A:"#fda331",base0B:"#a1c659",base0C:"#76c7b7",base0D:"#6fb3d2",base0E:"#d381c3",base0F:"#be643c"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"chalk",author:"chris kempson (http://chriskempson.com)",base00:"#151515",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#b0b0b0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#f5f5f5",base08:"#fb9fb1",base09:"#eda987",base0A:"#ddb26f",base0B:"#acc267",base0C:"#12cfc0",base0D:"#6fc2ef",base0E:"#e1a3ee",base0F:"#deaf8f"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"codeschool",author:"brettof86",base00:"#232c31",base01:"#1c3657",base02:"#2a343a",base03:"#3f4944",base04:"#84898c",base05:"#9ea7a6",base06:"#a7cfa3",base07:"#b5d8f6",base08:"#2a5491",base09:"#43820d",base0A:"#a03b1e",base0B:"#237986",base0C:"#b02f30",base0D:"#484d79",base0E:"#c59820",base0F:"#c98344"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"colors",author:"mrmrs (http://clrs.cc)",base00:"#111111",base01:"#333333",base02:"#555555",base03:"#777777",base04:"#999999",base05:"#bbbbbb",base06:"#dddddd",base07:"#ffffff",base08:"#ff4136",base09:"#ff851b",base0A:"#ffdc00",base0B:"#2ecc40",base0C:"#7fdbff",base0D:"#0074d9",base0E:"#b10dc9",base0F:"#85144b"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"default",author:"chris kempson (http://chriskempson.com)",base00:"#181818",base01:"#282828",base02:"#383838",base03:"#585858",base04:"#b8b8b8",base05:"#d8d8d8",base06:"#e8e8e8",base07:"#f8f8f8",base08:"#ab4642",base09:"#dc9656",base0A:"#f7ca88",base0B:"#a1b56c",base0C:"#86c1b9",base0D:"#7cafc2",base0E:"#ba8baf",base0F:"#a16946"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"eighties",author:"chris kempson (http://chriskempson.com)",base00:"#2d2d2d",base01:"#393939",base02:"#515151",base03:"#747369",base04:"#a09f93",base05:"#d3d0c8",base06:"#e8e6df",base07:"#f2f0ec",base08:"#f2777a",base09:"#f99157",base0A:"#ffcc66",base0B:"#99cc99",base0C:"#66cccc",base0D:"#6699cc",base0E:"#cc99cc",base0F:"#d27b53"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"embers",author:"jannik siebert (https://github.com/janniks)",base00:"#16130F",base01:"#2C2620",base02:"#433B32",base03:"#5A5047",base04:"#8A8075",base05:"#A39A90",base06:"#BEB6AE",base07:"#DBD6D1",base08:"#826D57",base09:"#828257",base0A:"#6D8257",base0B:"#57826D",base0C:"#576D82",base0D:"#6D5782",base0E:"#82576D",base0F:"#825757"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"flat",author:"chris kempson (http://chriskempson.com)",base00:"#2C3E50",base01:"#34495E",base02:"#7F8C8D",base03:"#95A5A6",base04:"#BDC3C7",base05:"#e0e0e0",base06:"#f5f5f5",base07:"#ECF0F1",base08:"#E74C3C",base09:"#E67E22",base0A:"#F1C40F",base0B:"#2ECC71",base0C:"#1ABC9C",base0D:"#3498DB",base0E:"#9B59B6",base0F:"#be643c"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"google",author:"seth wright (http://sethawright.com)",base00:"#1d1f21",base01:"#282a2e",base02:"#373b41",base03:"#969896",base04:"#b4b7b4",base05:"#c5c8c6",base06:"#e0e0e0",base07:"#ffffff",base08:"#CC342B",base09:"#F96A38",base0A:"#FBA922",base0B:"#198844",base0C:"#3971ED",base0D:"#3971ED",base0E:"#A36AC7",base0F:"#3971ED"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"grayscale",author:"alexandre gavioli (https://github.com/alexx2/)",base00:"#101010",base01:"#252525",base02:"#464646",base03:"#525252",base04:"#ababab",base05:"#b9b9b9",base06:"#e3e3e3",base07:"#f7f7f7",base08:"#7c7c7c",base09:"#999999",base0A:"#a0a0a0",base0B:"#8e8e8e",base0C:"#868686",base0D:"#686868",base0E:"#747474",base0F:"#5e5e5e"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"green screen",author:"chris kempson (http://chriskempson.com)",base00:"#001100",base01:"#003300",base02:"#005500",base03:"#007700",base04:"#009900",base05:"#00bb00",base06:"#00dd00",base07:"#00ff00",base08:"#007700",base09:"#009900",base0A:"#007700",base0B:"#00bb00",base0C:"#005500",base0D:"#009900",base0E:"#00bb00",base0F:"#005500"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"harmonic16",author:"jannik siebert (https://github.com/janniks)",base00:"#0b1c2c",base01:"#223b54",base02:"#405c79",base03:"#627e99",base04:"#aabcce",base05:"#cbd6e2",base06:"#e5ebf1",base07:"#f7f9fb",base08:"#bf8b56",base09:"#bfbf56",base0A:"#8bbf56",base0B:"#56bf8b",base0C:"#568bbf",base0D:"#8b56bf",base0E:"#bf568b",base0F:"#bf5656"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"hopscotch",author:"jan t. sott",base00:"#322931",base01:"#433b42",base02:"#5c545b",base03:"#797379",base04:"#989498",base05:"#b9b5b8",base06:"#d5d3d5",base07:"#ffffff",base08:"#dd464c",base09:"#fd8b19",base0A:"#fdcc59",base0B:"#8fc13e",base0C:"#149b93",base0D:"#1290bf",base0E:"#c85e7c",base0F:"#b33508"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"isotope",author:"jan t. sott",base00:"#000000",base01:"#404040",base02:"#606060",base03:"#808080",base04:"#c0c0c0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#ffffff",base08:"#ff0000",base09:"#ff9900",base0A:"#ff0099",base0B:"#33ff00",base0C:"#00ffff",base0D:"#0066ff",base0E:"#cc00ff",base0F:"#3300ff"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"marrakesh",author:"alexandre gavioli (http://github.com/alexx2/)",base00:"#201602",base01:"#302e00",base02:"#5f5b17",base03:"#6c6823",base04:"#86813b",base05:"#948e48",base06:"#ccc37a",base07:"#faf0a5",base08:"#c35359",base09:"#b36144",base0A:"#a88339",base0B:"#18974e",base0C:"#75a738",base0D:"#477ca1",base0E:"#8868b3",base0F:"#b3588e"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"mocha",author:"chris kempson (http://chriskempson.com)",base00:"#3B3228",base01:"#534636",base02:"#645240",base03:"#7e705a",base04:"#b8afad",base05:"#d0c8c6",base06:"#e9e1dd",base07:"#f5eeeb",base08:"#cb6077",base09:"#d28b71",base0A:"#f4bc87",base0B:"#beb55b",base0C:"#7bbda4",base0D:"#8ab3b5",base0E:"#a89bb9",base0F:"#bb9584"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"monokai",author:"wimer hazenberg (http://www.monokai.nl)",base00:"#272822",base01:"#383830",base02:"#49483e",base03:"#75715e",base04:"#a59f85",base05:"#f8f8f2",base06:"#f5f4f1",base07:"#f9f8f5",base08:"#f92672",base09:"#fd971f",base0A:"#f4bf75",base0B:"#a6e22e",base0C:"#a1efe4",base0D:"#66d9ef",base0E:"#ae81ff",base0F:"#cc6633"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"ocean",author:"chris kempson (http://chriskempson.com)",base00:"#2b303b",base01:"#343d46",base02:"#4f5b66",base03:"#65737e",base04:"#a7adba",base05:"#c0c5ce",base06:"#dfe1e8",base07:"#eff1f5",base08:"#bf616a",base09:"#d08770",base0A:"#ebcb8b",base0B:"#a3be8c",base0C:"#96b5b4",base0D:"#8fa1b3",base0E:"#b48ead",base0F:"#ab7967"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"paraiso",author:"jan t. sott",base00:"#2f1e2e",base01:"#41323f",base02:"#4f424c",base03:"#776e71",base04:"#8d8687",base05:"#a39e9b",base06:"#b9b6b0",base07:"#e7e9db",base08:"#ef6155",base09:"#f99b15",base0A:"#fec418",base0B:"#48b685",base0C:"#5bc4bf",base0D:"#06b6ef",base0E:"#815ba4",base0F:"#e96ba8"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"pop",author:"chris kempson (http://chriskempson.com)",base00:"#000000",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#b0b0b0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#ffffff",base08:"#eb008a",base09:"#f29333",base0A:"#f8ca12",base0B:"#37b349",base0C:"#00aabb",base0D:"#0e5a94",base0E:"#b31e8d",base0F:"#7a2d00"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"railscasts",author:"ryan bates (http://railscasts.com)",base00:"#2b2b2b",base01:"#272935",base02:"#3a4055",base03:"#5a647e",base04:"#d4cfc9",base05:"#e6e1dc",base06:"#f4f1ed",base07:"#f9f7f3",base08:"#da4939",base09:"#cc7833",base0A:"#ffc66d",base0B:"#a5c261",base0C:"#519f50",base0D:"#6d9cbe",base0E:"#b6b3eb",base0F:"#bc9458"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"shapeshifter",author:"tyler benziger (http://tybenz.com)",base00:"#000000",base01:"#040404",base02:"#102015",base03:"#343434",base04:"#555555",base05:"#ababab",base06:"#e0e0e0",base07:"#f9f9f9",base08:"#e92f2f",base09:"#e09448",base0A:"#dddd13",base0B:"#0ed839",base0C:"#23edda",base0D:"#3b48e3",base0E:"#f996e2",base0F:"#69542d"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"solarized",author:"ethan schoonover (http://ethanschoonover.com/solarized)",base00:"#002b36",base01:"#073642",base02:"#586e75",base03:"#657b83",base04:"#839496",base05:"#93a1a1",base06:"#eee8d5",base07:"#fdf6e3",base08:"#dc322f",base09:"#cb4b16",base0A:"#b58900",base0B:"#859900",base0C:"#2aa198",base0D:"#268bd2",base0E:"#6c71c4",base0F:"#d33682"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"summerfruit",author:"christopher corley (http://cscorley.github.io/)",base00:"#151515",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#B0B0B0",base05:"#D0D0D0",base06:"#E0E0E0",base07:"#FFFFFF",base08:"#FF0086",base09:"#FD8900",base0A:"#ABA800",base0B:"#00C918",base0C:"#1faaaa",base0D:"#3777E6",base0E:"#AD00A1",base0F:"#cc6633"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"tomorrow",author:"chris kempson (http://chriskempson.com)",base00:"#1d1f21",base01:"#282a2e",base02:"#373b41",base03:"#969896",base04:"#b4b7b4",base05:"#c5c8c6",base06:"#e0e0e0",base07:"#ffffff",base08:"#cc6666",base09:"#de935f",base0A:"#f0c674",base0B:"#b5bd68",base0C:"#8abeb7",base0D:"#81a2be",base0E:"#b294bb",base0F:"#a3685a"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"london tube",author:"jan t. sott",base00:"#231f20",base01:"#1c3f95",base02:"#5a5758",base03:"#737171",base04:"#959ca1",base05:"#d9d8d8",base06:"#e7e7e8",base07:"#ffffff",base08:"#ee2e24",base09:"#f386a1",base0A:"#ffd204",base0B:"#00853e",base0C:"#85cebc",base0D:"#009ddc",base0E:"#98005d",base0F:"#b06110"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"twilight",author:"david hart (http://hart-dev.com)",base00:"#1e1e1e",base01:"#323537",base02:"#464b50",base03:"#5f5a60",base04:"#838184",base05:"#a7a7a7",base06:"#c3c3c3",base07:"#ffffff",base08:"#cf6a4c",base09:"#cda869",base0A:"#f9ee98",base0B:"#8f9d6a",base0C:"#afc4db",base0D:"#7587a6",base0E:"#9b859d",base0F:"#9b703f"},e.exports=t.default},function(e,t,n){function r(e){var t=Math.round(o(e,0,255)).toString(16);return 1==t.length?"0"+t:t}var o=n(33);e.exports=function(e){var t=4===e.length?r(255*e[3]):"";return"#"+r(e[0])+r(e[1])+r(e[2])+t}},function(e,t,n){function r(e){for(var t in s)if(0===e.indexOf(t))return s[t](e)}var o=n(134),a=n(135),l=n(136),i=n(137),s={"#":a,hsl:function(e){var t=o(e),n=i(t);return 4===t.length&&n.push(t[3]),n},rgb:l};r.rgb=l,r.hsl=o,r.hex=a,e.exports=r},function(e,t,n){function r(e,t){switch(e=parseFloat(e),t){case 0:return a(e,0,360);case 1:case 2:return a(e,0,100);case 3:return a(e,0,1)}}var o=n(44),a=n(33);e.exports=function(e){return o(e).map(r)}},function(e,t){e.exports=function(e){4!==e.length&&5!==e.length||(e=function(e){for(var t="#",n=1;n<e.length;n++){var r=e.charAt(n);t+=r+r}return t}(e));var t=[parseInt(e.substring(1,3),16),parseInt(e.substring(3,5),16),parseInt(e.substring(5,7),16)];if(9===e.length){var n=parseFloat((parseInt(e.substring(7,9),16)/255).toFixed(2));t.push(n)}return t}},function(e,t,n){function r(e,t){return t<3?-1!=e.indexOf("%")?Math.round(255*a(parseInt(e,10),0,100)/100):a(parseInt(e,10),0,255):a(parseFloat(e),0,1)}var o=n(44),a=n(33);e.exports=function(e){return o(e).map(r)}},function(e,t){e.exports=function(e){var t,n,r,o,a,l=e[0]/360,i=e[1]/100,s=e[2]/100;if(0==i)return[a=255*s,a,a];t=2*s-(n=s<.5?s*(1+i):s+i-s*i),o=[0,0,0];for(var c=0;c<3;c++)(r=l+1/3*-(c-1))<0&&r++,r>1&&r--,a=6*r<1?t+6*(n-t)*r:2*r<1?n:3*r<2?t+(n-t)*(2/3-r)*6:t,o[c]=255*a;return o}},function(e,t,n){(function(t){function n(e,t,n){switch(n.length){case 0:return e.call(t);case 1:return e.call(t,n[0]);case 2:return e.call(t,n[0],n[1]);case 3:return e.call(t,n[0],n[1],n[2])}return e.apply(t,n)}function r(e,t){for(var n=-1,r=t.length,o=e.length;++n<r;)e[o+n]=t[n];return e}function o(e){return g(e)||function(e){return function(e){return function(e){return!!e&&"object"==typeof e}(e)&&function(e){return null!=e&&function(e){return"number"==typeof e&&e>-1&&e%1==0&&e<=9007199254740991}(e.length)&&!function(e){var t=function(e){var t=typeof e;return!!e&&("object"==t||"function"==t)}(e)?d.call(e):"";return"[object Function]"==t||"[object GeneratorFunction]"==t}(e)}(e)}(e)&&f.call(e,"callee")&&(!b.call(e,"callee")||"[object Arguments]"==d.call(e))}(e)||!!(h&&e&&e[h])}var a,l,i="object"==typeof t&&t&&t.Object===Object&&t,s="object"==typeof self&&self&&self.Object===Object&&self,c=i||s||Function("return this")(),u=Object.prototype,f=u.hasOwnProperty,d=u.toString,p=c.Symbol,b=u.propertyIsEnumerable,h=p?p.isConcatSpreadable:void 0,m=Math.max,g=Array.isArray,v=(a=function(e){var t=(e=function e(t,n,a,l,i){var s=-1,c=t.length;for(a||(a=o),i||(i=[]);++s<c;){var u=t[s];n>0&&a(u)?n>1?e(u,n-1,a,l,i):r(i,u):l||(i[i.length]=u)}return i}(e,1)).length,n=t;for(void 0;n--;)if("function"!=typeof e[n])throw new TypeError("Expected a function");return function(){for(var n=0,r=t?e[n].apply(this,arguments):arguments[0];++n<t;)r=e[n].call(this,r);return r}},l=m(void 0===l?a.length-1:l,0),function(){for(var e=arguments,t=-1,r=m(e.length-l,0),o=Array(r);++t<r;)o[t]=e[l+t];t=-1;for(var i=Array(l+1);++t<l;)i[t]=e[t];return i[l]=o,n(a,this,i)});e.exports=v}).call(this,n(43))},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.yuv2rgb=function(e){var t,n,r,o=e[0],a=e[1],l=e[2];return t=1*o+0*a+1.13983*l,n=1*o+-.39465*a+-.5806*l,r=1*o+2.02311*a+0*l,t=Math.min(Math.max(0,t),1),n=Math.min(Math.max(0,n),1),r=Math.min(Math.max(0,r),1),[255*t,255*n,255*r]},t.rgb2yuv=function(e){var t=e[0]/255,n=e[1]/255,r=e[2]/255;return[.299*t+.587*n+.114*r,-.14713*t+-.28886*n+.436*r,.615*t+-.51499*n+-.10001*r]}},function(e,t,n){"use strict";function r(e,t,n){return t in e?Object.defineProperty(e,t,{value:n,enumerable:!0,configurable:!0,writable:!0}):e[t]=n,e}var o=n(141),a=function(){function e(){r(this,"_callbacks",void 0),r(this,"_isDispatching",void 0),r(this,"_isHandled",void 0),r(this,"_isPending",void 0),r(this,"_lastID",void 0),r(this,"_pendingPayload",void 0),this._callbacks={},this._isDispatching=!1,this._isHandled={},this._isPending={},this._lastID=1}var t=e.prototype;return t.register=function(e){var t="ID_"+this._lastID++;return this._callbacks[t]=e,t},t.unregister=function(e){this._callbacks[e]||o(!1),delete this._callbacks[e]},t.waitFor=function(e){this._isDispatching||o(!1);for(var t=0;t<e.length;t++){var n=e[t];this._isPending[n]?this._isHandled[n]||o(!1):(this._callbacks[n]||o(!1),this._invokeCallback(n))}},t.dispatch=function(e){this._isDispatching&&o(!1),this._startDispatching(e);try{for(var t in this._callbacks)this._isPending[t]||this._invokeCallback(t)}finally{this._stopDispatching()}},t.isDispatching=function(){return this._isDispatching},t._invokeCallback=function(e){this._isPending[e]=!0,this._callbacks[e](this._pendingPayload),this._isHandled[e]=!0},t._startDispatching=function(e){for(var t in this._callbacks)this._isPending[t]=!1,this._isHandled[t]=!1;this._pendingPayload=e,this._isDispatching=!0},t._stopDispatching=function(){delete this._pendingPayload,this._isDispatching=!1},e}();e.exports=a},function(e,t,n){"use strict";e.exports=function(e,t){for(var n=arguments.length,r=new Array(n>2?n-2:0),o=2;o<n;o++)r[o-2]=arguments[o];if(!e){var a;if(void 0===t)a=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var l=0;(a=new Error(t.replace(/%s/g,function(){return String(r[l++])}))).name="Invariant Violation"}throw a.framesToPop=1,a}}},function(e,t,n){"use strict";function r(e,t,n){return t in e?Object.defineProperty(e,t,{value:n,enumerable:!0,configurable:!0,writable:!0}):e[t]=n,e}function o(e,t){var n=Object.keys(e);if(Object.getOwnPropertySymbols){var r=Object.getOwnPropertySymbols(e);t&&(r=r.filter(function(t){return Object.getOwnPropertyDescriptor(e,t).enumerable})),n.push.apply(n,r)}return n}function a(e){for(var t=1;t<arguments.length;t++){var n=null!=arguments[t]?arguments[t]:{};t%2?o(Object(n),!0).forEach(function(t){r(e,t,n[t])}):Object.getOwnPropertyDescriptors?Object.defineProperties(e,Object.getOwnPropertyDescriptors(n)):o(Object(n)).forEach(function(t){Object.defineProperty(e,t,Object.getOwnPropertyDescriptor(n,t))})}return e}function l(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function i(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}function s(e,t,n){return t&&i(e.prototype,t),n&&i(e,n),e}function c(e,t){return(c=Object.setPrototypeOf||function(e,t){return e.__proto__=t,e})(e,t)}function u(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function");e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,writable:!0,configurable:!0}}),t&&c(e,t)}function f(e){return(f=Object.setPrototypeOf?Object.getPrototypeOf:function(e){return e.__proto__||Object.getPrototypeOf(e)})(e)}function d(e){return(d="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e})(e)}function p(e){if(void 0===e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return e}function b(e,t){return!t||"object"!==d(t)&&"function"!=typeof t?p(e):t}function h(e){var t=function(){if("undefined"==typeof Reflect||!Reflect.construct)return!1;if(Reflect.construct.sham)return!1;if("function"==typeof Proxy)return!0;try{return Date.prototype.toString.call(Reflect.construct(Date,[],function(){})),!0}catch(e){return!1}}();return function(){var n,r=f(e);if(t){var o=f(this).constructor;n=Reflect.construct(r,arguments,o)}else n=r.apply(this,arguments);return b(this,n)}}function m(){var e=this.constructor.getDerivedStateFromProps(this.props,this.state);null!=e&&this.setState(e)}function g(e){this.setState(function(t){var n=this.constructor.getDerivedStateFromProps(e,t);return null!=n?n:null}.bind(this))}function v(e,t){try{var n=this.props,r=this.state;this.props=e,this.state=t,this.__reactInternalSnapshotFlag=!0,this.__reactInternalSnapshot=this.getSnapshotBeforeUpdate(n,r)}finally{this.props=n,this.state=r}}function y(e){var t=e.prototype;if(!t||!t.isReactComponent)throw new Error("Can only polyfill class components");if("function"!=typeof e.getDerivedStateFromProps&&"function"!=typeof t.getSnapshotBeforeUpdate)return e;var n=null,r=null,o=null;if("function"==typeof t.componentWillMount?n="componentWillMount":"function"==typeof t.UNSAFE_componentWillMount&&(n="UNSAFE_componentWillMount"),"function"==typeof t.componentWillReceiveProps?r="componentWillReceiveProps":"function"==typeof t.UNSAFE_componentWillReceiveProps&&(r="UNSAFE_componentWillReceiveProps"),"function"==typeof t.componentWillUpdate?o="componentWillUpdate":"function"==typeof t.UNSAFE_componentWillUpdate&&(o="UNSAFE_componentWillUpdate"),null!==n||null!==r||null!==o){var a=e.displayName||e.name,l="function"==typeof e.getDerivedStateFromProps?"getDerivedStateFromProps()":"getSnapshotBeforeUpdate()";throw Error("Unsafe legacy lifecycles will not be called for components using new component APIs.\n\n"+a+" uses "+l+" but also contains the following legacy lifecycles:"+(null!==n?"\n  "+n:"")+(null!==r?"\n  "+r:"")+(null!==o?"\n  "+o:"")+"\n\nThe above lifecycles should be removed. Learn more about this warning here:\nhttps://fb.me/react-async-component-lifecycle-hooks")}if("function"==typeof e.getDerivedStateFromProps&&(t.componentWillMount=m,t.componentWillReceiveProps=g),"function"==typeof t.getSnapshotBeforeUpdate){if("function"!=typeof t.componentDidUpdate)throw new Error("Cannot polyfill getSnapshotBeforeUpdate() for components that do not define componentDidUpdate() on the prototype");t.componentWillUpdate=v;var i=t.componentDidUpdate;t.componentDidUpdate=function(e,t,n){var r=this.__reactInternalSnapshotFlag?this.__reactInternalSnapshot:n;i.call(this,e,t,r)}}return e}function _(e,t){if(null==e)return{};var n,r,o=function(e,t){if(null==e)return{};var n,r,o={},a=Object.keys(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||(o[n]=e[n]);return o}(e,t);if(Object.getOwnPropertySymbols){var a=Object.getOwnPropertySymbols(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||Object.prototype.propertyIsEnumerable.call(e,n)&&(o[n]=e[n])}return o}function x(e){var t=function(e){return{}.toString.call(e).match(/\s([a-zA-Z]+)/)[1].toLowerCase()}(e);return"number"===t&&(t=isNaN(e)?"nan":(0|e)!=e?"float":"integer"),t}function w(e,t,n){return e||console.error("theme has not been set"),function(e){var t=D;return!1!==e&&"none"!==e||(t=I),Object(F.createStyling)(U,{defaultBase16:t})(e)}(e)(t,n)}function k(e,t){(null==t||t>e.length)&&(t=e.length);for(var n=0,r=new Array(t);n<t;n++)r[n]=e[n];return r}function E(e,t){if(e){if("string"==typeof e)return k(e,t);var n=Object.prototype.toString.call(e).slice(8,-1);return"Object"===n&&e.constructor&&(n=e.constructor.name),"Map"===n||"Set"===n?Array.from(e):"Arguments"===n||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(n)?k(e,t):void 0}}function O(e,t){var n;if("undefined"==typeof Symbol||null==e[Symbol.iterator]){if(Array.isArray(e)||(n=E(e))||t&&e&&"number"==typeof e.length){n&&(e=n);var r=0,o=function(){};return{s:o,n:function(){return r>=e.length?{done:!0}:{done:!1,value:e[r++]}},e:function(e){throw e},f:o}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var a,l=!0,i=!1;return{s:function(){n=e[Symbol.iterator]()},n:function(){var e=n.next();return l=e.done,e},e:function(e){i=!0,a=e},f:function(){try{l||null==n.return||n.return()}finally{if(i)throw a}}}}function P(e){return function(e){if(Array.isArray(e))return k(e)}(e)||function(e){if("undefined"!=typeof Symbol&&Symbol.iterator in Object(e))return Array.from(e)}(e)||E(e)||function(){throw new TypeError("Invalid attempt to spread non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}()}function C(){return(C=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}).apply(this,arguments)}function j(e){e=e.trim();try{if("["===(e=JSON.stringify(JSON.parse(e)))[0])return S("array",JSON.parse(e));if("{"===e[0])return S("object",JSON.parse(e));if(e.match(/\-?\d+\.\d+/)&&e.match(/\-?\d+\.\d+/)[0]===e)return S("float",parseFloat(e));if(e.match(/\-?\d+e-\d+/)&&e.match(/\-?\d+e-\d+/)[0]===e)return S("float",Number(e));if(e.match(/\-?\d+/)&&e.match(/\-?\d+/)[0]===e)return S("integer",parseInt(e));if(e.match(/\-?\d+e\+\d+/)&&e.match(/\-?\d+e\+\d+/)[0]===e)return S("integer",Number(e))}catch(e){}switch(e=e.toLowerCase()){case"undefined":return S("undefined",void 0);case"nan":return S("nan",NaN);case"null":return S("null",null);case"true":return S("boolean",!0);case"false":return S("boolean",!1);default:if(e=Date.parse(e))return S("date",new Date(e))}return S(!1,null)}function S(e,t){return{type:e,value:t}}function M(e){return e||(e={}),{style:a(a({verticalAlign:"middle"},e),{},{color:e.color?e.color:"#000000",height:"1em",width:"1em"})}}function T(e){var t=e.parent_type,n=e.namespace,r=e.quotesOnKeys,o=e.theme,a=e.jsvRoot,l=e.name,i=e.displayArrayKey,s=e.name?e.name:"";return!a||!1!==l&&null!==l?"array"==t?i?A.a.createElement("span",Object.assign({},w(o,"array-key"),{key:n}),A.a.createElement("span",{className:"array-key"},s),A.a.createElement("span",w(o,"colon"),":")):A.a.createElement("span",null):A.a.createElement("span",Object.assign({},w(o,"object-name"),{key:n}),A.a.createElement("span",{className:"object-key"},r&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"'),A.a.createElement("span",null,s),r&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"')),A.a.createElement("span",w(o,"colon"),":")):A.a.createElement("span",null)}function R(e){var t=e.theme;switch(e.iconStyle){case"triangle":return A.a.createElement(ye,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}));case"square":return A.a.createElement(me,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}));default:return A.a.createElement(be,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}))}}function L(e){var t=e.theme;switch(e.iconStyle){case"triangle":return A.a.createElement(ve,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}));case"square":return A.a.createElement(ge,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}));default:return A.a.createElement(he,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}))}}n.r(t);var N=n(0),A=n.n(N);m.__suppressDeprecationWarning=!0,g.__suppressDeprecationWarning=!0,v.__suppressDeprecationWarning=!0;var D={scheme:"rjv-default",author:"mac gainor",base00:"rgba(0, 0, 0, 0)",base01:"rgb(245, 245, 245)",base02:"rgb(235, 235, 235)",base03:"#93a1a1",base04:"rgba(0, 0, 0, 0.3)",base05:"#586e75",base06:"#073642",base07:"#002b36",base08:"#d33682",base09:"#cb4b16",base0A:"#dc322f",base0B:"#859900",base0C:"#6c71c4",base0D:"#586e75",base0E:"#2aa198",base0F:"#268bd2"},I={scheme:"rjv-grey",author:"mac gainor",base00:"rgba(1, 1, 1, 0)",base01:"rgba(1, 1, 1, 0.1)",base02:"rgba(0, 0, 0, 0.2)",base03:"rgba(1, 1, 1, 0.3)",base04:"rgba(0, 0, 0, 0.4)",base05:"rgba(1, 1, 1, 0.5)",base06:"rgba(1, 1, 1, 0.6)",base07:"rgba(1, 1, 1, 0.7)",base08:"rgba(1, 1, 1, 0.8)",base09:"rgba(1, 1, 1, 0.8)",base0A:"rgba(1, 1, 1, 0.8)",base0B:"rgba(1, 1, 1, 0.8)",base0C:"rgba(1, 1, 1, 0.8)",base0D:"rgba(1, 1, 1, 0.8)",base0E:"rgba(1, 1, 1, 0.8)",base0F:"rgba(1, 1, 1, 0.8)"},H={white:"#fff",black:"#000",transparent:"rgba(1, 1, 1, 0)",globalFontFamily:"monospace",globalCursor:"default",indentBlockWidth:"5px",braceFontWeight:"bold",braceCursor:"pointer",ellipsisFontSize:"18px",ellipsisLineHeight:"10px",ellipsisCursor:"pointer",keyMargin:"0px 5px",keyLetterSpacing:"0.5px",keyFontStyle:"none",keyBorderRadius:"3px",keyColonWeight:"bold",keyVerticalAlign:"top",keyOpacity:"0.85",keyOpacityHover:"1",keyValPaddingTop:"3px",keyValPaddingBottom:"3px",keyValPaddingRight:"5px",keyValBorderLeft:"1px solid",keyValBorderHover:"2px solid",keyValPaddingHover:"3px 5px 3px 4px",pushedContentMarginLeft:"6px",variableValuePaddingRight:"6px",nullFontSize:"11px",nullFontWeight:"bold",nullPadding:"1px 2px",nullBorderRadius:"3px",nanFontSize:"11px",nanFontWeight:"bold",nanPadding:"1px 2px",nanBorderRadius:"3px",undefinedFontSize:"11px",undefinedFontWeight:"bold",undefinedPadding:"1px 2px",undefinedBorderRadius:"3px",dataTypeFontSize:"11px",dataTypeMarginRight:"4px",datatypeOpacity:"0.8",objectSizeBorderRadius:"3px",objectSizeFontStyle:"italic",objectSizeMargin:"0px 6px 0px 0px",clipboardCursor:"pointer",clipboardCheckMarginLeft:"-12px",metaDataPadding:"0px 0px 0px 10px",arrayGroupMetaPadding:"0px 0px 0px 4px",iconContainerWidth:"17px",tooltipPadding:"4px",editInputMinWidth:"130px",editInputBorderRadius:"2px",editInputPadding:"5px",editInputMarginRight:"4px",editInputFontFamily:"monospace",iconCursor:"pointer",iconFontSize:"15px",iconPaddingRight:"1px",dateValueMarginLeft:"2px",iconMarginRight:"3px",detectedRowPaddingTop:"3px",addKeyCoverBackground:"rgba(255, 255, 255, 0.3)",addKeyCoverPosition:"absolute",addKeyCoverPositionPx:"0px",addKeyModalWidth:"200px",addKeyModalMargin:"auto",addKeyModalPadding:"10px",addKeyModalRadius:"3px"},F=n(45),U=function(e){var t=function(e){return{backgroundColor:e.base00,ellipsisColor:e.base09,braceColor:e.base07,expandedIcon:e.base0D,collapsedIcon:e.base0E,keyColor:e.base07,arrayKeyColor:e.base0C,objectSize:e.base04,copyToClipboard:e.base0F,copyToClipboardCheck:e.base0D,objectBorder:e.base02,dataTypes:{boolean:e.base0E,date:e.base0D,float:e.base0B,function:e.base0D,integer:e.base0F,string:e.base09,nan:e.base08,null:e.base0A,undefined:e.base05,regexp:e.base0A,background:e.base02},editVariable:{editIcon:e.base0E,cancelIcon:e.base09,removeIcon:e.base09,addIcon:e.base0E,checkIcon:e.base0E,background:e.base01,color:e.base0A,border:e.base07},addKeyModal:{background:e.base05,border:e.base04,color:e.base0A,labelColor:e.base01},validationFailure:{background:e.base09,iconColor:e.base01,fontColor:e.base01}}}(e);return{"app-container":{fontFamily:H.globalFontFamily,cursor:H.globalCursor,backgroundColor:t.backgroundColor,position:"relative"},ellipsis:{display:"inline-block",color:t.ellipsisColor,fontSize:H.ellipsisFontSize,lineHeight:H.ellipsisLineHeight,cursor:H.ellipsisCursor},"brace-row":{display:"inline-block",cursor:"pointer"},brace:{display:"inline-block",cursor:H.braceCursor,fontWeight:H.braceFontWeight,color:t.braceColor},"expanded-icon":{color:t.expandedIcon},"collapsed-icon":{color:t.collapsedIcon},colon:{display:"inline-block",margin:H.keyMargin,color:t.keyColor,verticalAlign:"top"},objectKeyVal:function(e,n){return{style:a({paddingTop:H.keyValPaddingTop,paddingRight:H.keyValPaddingRight,paddingBottom:H.keyValPaddingBottom,borderLeft:H.keyValBorderLeft+" "+t.objectBorder,":hover":{paddingLeft:n.paddingLeft-1+"px",borderLeft:H.keyValBorderHover+" "+t.objectBorder}},n)}},"object-key-val-no-border":{padding:H.keyValPadding},"pushed-content":{marginLeft:H.pushedContentMarginLeft},variableValue:function(e,t){return{style:a({display:"inline-block",paddingRight:H.variableValuePaddingRight,position:"relative"},t)}},"object-name":{display:"inline-block",color:t.keyColor,letterSpacing:H.keyLetterSpacing,fontStyle:H.keyFontStyle,verticalAlign:H.keyVerticalAlign,opacity:H.keyOpacity,":hover":{opacity:H.keyOpacityHover}},"array-key":{display:"inline-block",color:t.arrayKeyColor,letterSpacing:H.keyLetterSpacing,fontStyle:H.keyFontStyle,verticalAlign:H.keyVerticalAlign,opacity:H.keyOpacity,":hover":{opacity:H.keyOpacityHover}},"object-size":{color:t.objectSize,borderRadius:H.objectSizeBorderRadius,fontStyle:H.objectSizeFontStyle,margin:H.objectSizeMargin,cursor:"default"},"data-type-label":{fontSize:H.dataTypeFontSize,marginRight:H.dataTypeMarginRight,opacity:H.datatypeOpacity},boolean:{display:"inline-block",color:t.dataTypes.boolean},date:{display:"inline-block",color:t.dataTypes.date},"date-value":{marginLeft:H.dateValueMarginLeft},float:{display:"inline-block",color:t.dataTypes.float},function:{display:"inline-block",color:t.dataTypes.function,cursor:"pointer",whiteSpace:"pre-line"},"function-value":{fontStyle:"italic"},integer:{display:"inline-block",color:t.dataTypes.integer},string:{display:"inline-block",color:t.dataTypes.string},nan:{display:"inline-block",color:t.dataTypes.nan,fontSize:H.nanFontSize,fontWeight:H.nanFontWeight,backgroundColor:t.dataTypes.background,padding:H.nanPadding,borderRadius:H.nanBorderRadius},null:{display:"inline-block",color:t.dataTypes.null,fontSize:H.nullFontSize,fontWeight:H.nullFontWeight,backgroundColor:t.dataTypes.background,padding:H.nullPadding,borderRadius:H.nullBorderRadius},undefined:{display:"inline-block",color:t.dataTypes.undefined,fontSize:H.undefinedFontSize,padding:H.undefinedPadding,borderRadius:H.undefinedBorderRadius,backgroundColor:t.dataTypes.background},regexp:{display:"inline-block",color:t.dataTypes.regexp},"copy-to-clipboard":{cursor:H.clipboardCursor},"copy-icon":{color:t.copyToClipboard,fontSize:H.iconFontSize,marginRight:H.iconMarginRight,verticalAlign:"top"},"copy-icon-copied":{color:t.copyToClipboardCheck,marginLeft:H.clipboardCheckMarginLeft},"array-group-meta-data":{display:"inline-block",padding:H.arrayGroupMetaPadding},"object-meta-data":{display:"inline-block",padding:H.metaDataPadding},"icon-container":{display:"inline-block",width:H.iconContainerWidth},tooltip:{padding:H.tooltipPadding},removeVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.removeIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},addVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.addIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},editVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.editIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},"edit-icon-container":{display:"inline-block",verticalAlign:"top"},"check-icon":{display:"inline-block",cursor:H.iconCursor,color:t.editVariable.checkIcon,fontSize:H.iconFontSize,paddingRight:H.iconPaddingRight},"cancel-icon":{display:"inline-block",cursor:H.iconCursor,color:t.editVariable.cancelIcon,fontSize:H.iconFontSize,paddingRight:H.iconPaddingRight},"edit-input":{display:"inline-block",minWidth:H.editInputMinWidth,borderRadius:H.editInputBorderRadius,backgroundColor:t.editVariable.background,color:t.editVariable.color,padding:H.editInputPadding,marginRight:H.editInputMarginRight,fontFamily:H.editInputFontFamily},"detected-row":{paddingTop:H.detectedRowPaddingTop},"key-modal-request":{position:H.addKeyCoverPosition,top:H.addKeyCoverPositionPx,left:H.addKeyCoverPositionPx,right:H.addKeyCoverPositionPx,bottom:H.addKeyCoverPositionPx,backgroundColor:H.addKeyCoverBackground},"key-modal":{width:H.addKeyModalWidth,backgroundColor:t.addKeyModal.background,marginLeft:H.addKeyModalMargin,marginRight:H.addKeyModalMargin,padding:H.addKeyModalPadding,borderRadius:H.addKeyModalRadius,marginTop:"15px",position:"relative"},"key-modal-label":{color:t.addKeyModal.labelColor,marginLeft:"2px",marginBottom:"5px",fontSize:"11px"},"key-modal-input-container":{overflow:"hidden"},"key-modal-input":{width:"100%",padding:"3px 6px",fontFamily:"monospace",color:t.addKeyModal.color,border:"none",boxSizing:"border-box",borderRadius:"2px"},"key-modal-cancel":{backgroundColor:t.editVariable.removeIcon,position:"absolute",top:"0px",right:"0px",borderRadius:"0px 3px 0px 3px",cursor:"pointer"},"key-modal-cancel-icon":{color:t.addKeyModal.labelColor,fontSize:H.iconFontSize,transform:"rotate(45deg)"},"key-modal-submit":{color:t.editVariable.addIcon,fontSize:H.iconFontSize,position:"absolute",right:"2px",top:"3px",cursor:"pointer"},"function-ellipsis":{display:"inline-block",color:t.ellipsisColor,fontSize:H.ellipsisFontSize,lineHeight:H.ellipsisLineHeight,cursor:H.ellipsisCursor},"validation-failure":{float:"right",padding:"3px 6px",borderRadius:"2px",cursor:"pointer",color:t.validationFailure.fontColor,backgroundColor:t.validationFailure.background},"validation-failure-label":{marginRight:"6px"},"validation-failure-clear":{position:"relative",verticalAlign:"top",cursor:"pointer",color:t.validationFailure.iconColor,fontSize:H.iconFontSize,transform:"rotate(45deg)"}}},z=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=(e.rjvId,e.type_name),n=e.displayDataTypes,r=e.theme;return n?A.a.createElement("span",Object.assign({className:"data-type-label"},w(r,"data-type-label")),t):null}}]),t}(A.a.PureComponent),q=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"boolean"),A.a.createElement(z,Object.assign({type_name:"bool"},e)),e.value?"true":"false")}}]),t}(A.a.PureComponent),Y=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"date"),A.a.createElement(z,Object.assign({type_name:"date"},e)),A.a.createElement("span",Object.assign({className:"date-value"},w(e.theme,"date-value")),e.value.toLocaleTimeString("en-us",{weekday:"short",year:"numeric",month:"short",day:"numeric",hour:"2-digit",minute:"2-digit"})))}}]),t}(A.a.PureComponent),W=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"float"),A.a.createElement(z,Object.assign({type_name:"float"},e)),this.props.value)}}]),t}(A.a.PureComponent),V=n(46),B=new(n(47).Dispatcher),K=new(function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).objects={},e.set=function(t,n,r,o){void 0===e.objects[t]&&(e.objects[t]={}),void 0===e.objects[t][n]&&(e.objects[t][n]={}),e.objects[t][n][r]=o},e.get=function(t,n,r,o){return void 0===e.objects[t]||void 0===e.objects[t][n]||null==e.objects[t][n][r]?o:e.objects[t][n][r]},e.handleAction=function(t){var n=t.rjvId,r=t.data;switch(t.name){case"RESET":e.emit("reset-"+n);break;case"VARIABLE_UPDATED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-edited"})),e.emit("variable-update-"+n);break;case"VARIABLE_REMOVED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-removed"})),e.emit("variable-update-"+n);break;case"VARIABLE_ADDED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-added"})),e.emit("variable-update-"+n);break;case"ADD_VARIABLE_KEY_REQUEST":e.set(n,"action","new-key-request",r),e.emit("add-key-request-"+n)}},e.updateSrc=function(t,n){var r=n.name,o=n.namespace,a=n.new_value,l=(n.existing_value,n.variable_removed);o.shift();var i,s=e.get(t,"global","src"),c=e.deepCopy(s,P(o)),u=c,f=O(o);try{for(f.s();!(i=f.n()).done;)u=u[i.value]}catch(e){f.e(e)}finally{f.f()}return l?"array"==x(u)?u.splice(r,1):delete u[r]:null!==r?u[r]=a:c=a,e.set(t,"global","src",c),c},e.deepCopy=function(t,n){var r,o=x(t),l=n.shift();return"array"==o?r=P(t):"object"==o&&(r=a({},t)),void 0!==l&&(r[l]=e.deepCopy(t[l],n)),r},e}u(t,e);var n=h(t);return t}(V.EventEmitter));B.register(K.handleAction.bind(K));var X=K,G=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({collapsed:!r.state.collapsed},function(){X.set(r.props.rjvId,r.props.namespace,"collapsed",r.state.collapsed)})},r.getFunctionDisplay=function(e){var t=p(r).props;return e?A.a.createElement("span",null,r.props.value.toString().slice(9,-1).replace(/\{[\s\S]+/,""),A.a.createElement("span",{className:"function-collapsed",style:{fontWeight:"bold"}},A.a.createElement("span",null,"{"),A.a.createElement("span",w(t.theme,"ellipsis"),"..."),A.a.createElement("span",null,"}"))):r.props.value.toString().slice(9,-1)},r.state={collapsed:X.get(e.rjvId,e.namespace,"collapsed",!0)},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=this.state.collapsed;return A.a.createElement("div",w(e.theme,"function"),A.a.createElement(z,Object.assign({type_name:"function"},e)),A.a.createElement("span",Object.assign({},w(e.theme,"function-value"),{className:"rjv-function-container",onClick:this.toggleCollapsed}),this.getFunctionDisplay(t)))}}]),t}(A.a.PureComponent),Q=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"nan"),"NaN")}}]),t}(A.a.PureComponent),Z=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"null"),"NULL")}}]),t}(A.a.PureComponent),J=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"integer"),A.a.createElement(z,Object.assign({type_name:"int"},e)),this.props.value)}}]),t}(A.a.PureComponent),$=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"regexp"),A.a.createElement(z,Object.assign({type_name:"regexp"},e)),this.props.value.toString())}}]),t}(A.a.PureComponent),ee=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({collapsed:!r.state.collapsed},function(){X.set(r.props.rjvId,r.props.namespace,"collapsed",r.state.collapsed)})},r.state={collapsed:X.get(e.rjvId,e.namespace,"collapsed",!0)},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){this.state.collapsed;var e=this.props,t=e.collapseStringsAfterLength,n=e.theme,r=e.value,o={style:{cursor:"default"}};return"integer"===x(t)&&r.length>t&&(o.style.cursor="pointer",this.state.collapsed&&(r=A.a.createElement("span",null,r.substring(0,t),A.a.createElement("span",w(n,"ellipsis")," ...")))),A.a.createElement("div",w(n,"string"),A.a.createElement(z,Object.assign({type_name:"string"},e)),A.a.createElement("span",Object.assign({className:"string-value"},o,{onClick:this.toggleCollapsed}),'"',r,'"'))}}]),t}(A.a.PureComponent),te=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"undefined"),"undefined")}}]),t}(A.a.PureComponent),ne=N.useLayoutEffect,re=function(e){var t=Object(N.useRef)(e);return ne(function(){t.current=e}),t},oe=function(e,t){"function"!=typeof e?e.current=t:e(t)},ae=function(e,t){var n=Object(N.useRef)();return Object(N.useCallback)(function(r){e.current=r,n.current&&oe(n.current,null),n.current=t,t&&oe(t,r)},[t])},le={"min-height":"0","max-height":"none",height:"0",visibility:"hidden",overflow:"hidden",position:"absolute","z-index":"-1000",top:"0",right:"0"},ie=function(e){Object.keys(le).forEach(function(t){e.style.setProperty(t,le[t],"important")})},se=null,ce=function(){},ue=["borderBottomWidth","borderLeftWidth","borderRightWidth","borderTopWidth","boxSizing","fontFamily","fontSize","fontStyle","fontWeight","letterSpacing","lineHeight","paddingBottom","paddingLeft","paddingRight","paddingTop","tabSize","textIndent","textRendering","textTransform","width"],fe=!!document.documentElement.currentStyle,de=function(e,t){var n,r=e.cacheMeasurements,o=e.maxRows,a=e.minRows,l=e.onChange,i=void 0===l?ce:l,s=e.onHeightChange,c=void 0===s?ce:s,u=function(e,t){if(null==e)return{};var n,r,o={},a=Object.keys(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||(o[n]=e[n]);return o}(e,["cacheMeasurements","maxRows","minRows","onChange","onHeightChange"]),f=void 0!==u.value,d=Object(N.useRef)(null),p=ae(d,t),b=Object(N.useRef)(0),h=Object(N.useRef)(),m=function(){var e=d.current,t=r&&h.current?h.current:function(e){var t=window.getComputedStyle(e);if(null===t)return null;var n,r=(n=t,ue.reduce(function(e,t){return e[t]=n[t],e},{})),o=r.boxSizing;return""===o?null:(fe&&"border-box"===o&&(r.width=parseFloat(r.width)+parseFloat(r.borderRightWidth)+parseFloat(r.borderLeftWidth)+parseFloat(r.paddingRight)+parseFloat(r.paddingLeft)+"px"),{sizingStyle:r,paddingSize:parseFloat(r.paddingBottom)+parseFloat(r.paddingTop),borderSize:parseFloat(r.borderBottomWidth)+parseFloat(r.borderTopWidth)})}(e);if(t){h.current=t;var n=function(e,t,n,r){void 0===n&&(n=1),void 0===r&&(r=1/0),se||((se=document.createElement("textarea")).setAttribute("tab-index","-1"),se.setAttribute("aria-hidden","true"),ie(se)),null===se.parentNode&&document.body.appendChild(se);var o=e.paddingSize,a=e.borderSize,l=e.sizingStyle,i=l.boxSizing;Object.keys(l).forEach(function(e){var t=e;se.style[t]=l[t]}),ie(se),se.value=t;var s=function(e,t){var n=e.scrollHeight;return"border-box"===t.sizingStyle.boxSizing?n+t.borderSize:n-t.paddingSize}(se,e);se.value="x";var c=se.scrollHeight-o,u=c*n;"border-box"===i&&(u=u+o+a),s=Math.max(u,s);var f=c*r;return"border-box"===i&&(f=f+o+a),[s=Math.min(f,s),c]}(t,e.value||e.placeholder||"x",a,o),l=n[0],i=n[1];b.current!==l&&(b.current=l,e.style.setProperty("height",l+"px","important"),c(l,{rowHeight:i}))}};return Object(N.useLayoutEffect)(m),n=re(m),Object(N.useLayoutEffect)(function(){var e=function(e){n.current(e)};return window.addEventListener("resize",e),function(){window.removeEventListener("resize",e)}},[]),Object(N.createElement)("textarea",C({},u,{onChange:function(e){f||m(),i(e)},ref:p}))},pe=Object(N.forwardRef)(de),be=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 24 24",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("path",{d:"M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M7,13H17V11H7"})))}}]),t}(A.a.PureComponent),he=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 24 24",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("path",{d:"M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M13,7H11V11H7V13H11V17H13V13H17V11H13V7Z"})))}}]),t}(A.a.PureComponent),me=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]),r=M(t).style;return A.a.createElement("span",n,A.a.createElement("svg",{fill:r.color,width:r.height,height:r.width,style:r,viewBox:"0 0 1792 1792"},A.a.createElement("path",{d:"M1344 800v64q0 14-9 23t-23 9h-832q-14 0-23-9t-9-23v-64q0-14 9-23t23-9h832q14 0 23 9t9 23zm128 448v-832q0-66-47-113t-113-47h-832q-66 0-113 47t-47 113v832q0 66 47 113t113 47h832q66 0 113-47t47-113zm128-832v832q0 119-84.5 203.5t-203.5 84.5h-832q-119 0-203.5-84.5t-84.5-203.5v-832q0-119 84.5-203.5t203.5-84.5h832q119 0 203.5 84.5t84.5 203.5z"})))}}]),t}(A.a.PureComponent),ge=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]),r=M(t).style;return A.a.createElement("span",n,A.a.createElement("svg",{fill:r.color,width:r.height,height:r.width,style:r,viewBox:"0 0 1792 1792"},A.a.createElement("path",{d:"M1344 800v64q0 14-9 23t-23 9h-352v352q0 14-9 23t-23 9h-64q-14 0-23-9t-9-23v-352h-352q-14 0-23-9t-9-23v-64q0-14 9-23t23-9h352v-352q0-14 9-23t23-9h64q14 0 23 9t9 23v352h352q14 0 23 9t9 23zm128 448v-832q0-66-47-113t-113-47h-832q-66 0-113 47t-47 113v832q0 66 47 113t113 47h832q66 0 113-47t47-113zm128-832v832q0 119-84.5 203.5t-203.5 84.5h-832q-119 0-203.5-84.5t-84.5-203.5v-832q0-119 84.5-203.5t203.5-84.5h832q119 0 203.5 84.5t84.5 203.5z"})))}}]),t}(A.a.PureComponent),ve=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",{style:a(a({},M(t).style),{},{paddingLeft:"2px",verticalAlign:"top"}),viewBox:"0 0 15 15",fill:"currentColor"},A.a.createElement("path",{d:"M0 14l6-6-6-6z"})))}}]),t}(A.a.PureComponent),ye=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",{style:a(a({},M(t).style),{},{paddingLeft:"2px",verticalAlign:"top"}),viewBox:"0 0 15 15",fill:"currentColor"},A.a.createElement("path",{d:"M0 5l6 6 6-6z"})))}}]),t}(A.a.PureComponent),_e=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m30 35h-25v-22.5h25v7.5h2.5v-12.5c0-1.4-1.1-2.5-2.5-2.5h-7.5c0-2.8-2.2-5-5-5s-5 2.2-5 5h-7.5c-1.4 0-2.5 1.1-2.5 2.5v27.5c0 1.4 1.1 2.5 2.5 2.5h25c1.4 0 2.5-1.1 2.5-2.5v-5h-2.5v5z m-20-27.5h2.5s2.5-1.1 2.5-2.5 1.1-2.5 2.5-2.5 2.5 1.1 2.5 2.5 1.3 2.5 2.5 2.5h2.5s2.5 1.1 2.5 2.5h-20c0-1.5 1.1-2.5 2.5-2.5z m-2.5 20h5v-2.5h-5v2.5z m17.5-5v-5l-10 7.5 10 7.5v-5h12.5v-5h-12.5z m-17.5 10h7.5v-2.5h-7.5v2.5z m12.5-17.5h-12.5v2.5h12.5v-2.5z m-7.5 5h-5v2.5h5v-2.5z"}))))}}]),t}(A.a.PureComponent),xe=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m28.6 25q0-0.5-0.4-1l-4-4 4-4q0.4-0.5 0.4-1 0-0.6-0.4-1.1l-2-2q-0.4-0.4-1-0.4-0.6 0-1 0.4l-4.1 4.1-4-4.1q-0.4-0.4-1-0.4-0.6 0-1 0.4l-2 2q-0.5 0.5-0.5 1.1 0 0.5 0.5 1l4 4-4 4q-0.5 0.5-0.5 1 0 0.7 0.5 1.1l2 2q0.4 0.4 1 0.4 0.6 0 1-0.4l4-4.1 4.1 4.1q0.4 0.4 1 0.4 0.6 0 1-0.4l2-2q0.4-0.4 0.4-1z m8.7-5q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),we=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m30.1 21.4v-2.8q0-0.6-0.4-1t-1-0.5h-5.7v-5.7q0-0.6-0.4-1t-1-0.4h-2.9q-0.6 0-1 0.4t-0.4 1v5.7h-5.7q-0.6 0-1 0.5t-0.5 1v2.8q0 0.6 0.5 1t1 0.5h5.7v5.7q0 0.5 0.4 1t1 0.4h2.9q0.6 0 1-0.4t0.4-1v-5.7h5.7q0.6 0 1-0.5t0.4-1z m7.2-1.4q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),ke=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m31.6 21.6h-10v10h-3.2v-10h-10v-3.2h10v-10h3.2v10h10v3.2z"}))))}}]),t}(A.a.PureComponent),Ee=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m19.8 26.4l2.6-2.6-3.4-3.4-2.6 2.6v1.3h2.2v2.1h1.2z m9.8-16q-0.3-0.4-0.7 0l-7.8 7.8q-0.4 0.4 0 0.7t0.7 0l7.8-7.8q0.4-0.4 0-0.7z m1.8 13.2v4.3q0 2.6-1.9 4.5t-4.5 1.9h-18.6q-2.6 0-4.5-1.9t-1.9-4.5v-18.6q0-2.7 1.9-4.6t4.5-1.8h18.6q1.4 0 2.6 0.5 0.3 0.2 0.4 0.5 0.1 0.4-0.2 0.7l-1.1 1.1q-0.3 0.3-0.7 0.1-0.5-0.1-1-0.1h-18.6q-1.4 0-2.5 1.1t-1 2.5v18.6q0 1.4 1 2.5t2.5 1h18.6q1.5 0 2.5-1t1.1-2.5v-2.9q0-0.2 0.2-0.4l1.4-1.5q0.3-0.3 0.8-0.1t0.4 0.6z m-2.1-16.5l6.4 6.5-15 15h-6.4v-6.5z m9.9 3l-2.1 2-6.4-6.4 2.1-2q0.6-0.7 1.5-0.7t1.5 0.7l3.4 3.4q0.6 0.6 0.6 1.5t-0.6 1.5z"}))))}}]),t}(A.a.PureComponent),Oe=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m31.7 16.4q0-0.6-0.4-1l-2.1-2.1q-0.4-0.4-1-0.4t-1 0.4l-9.1 9.1-5-5q-0.5-0.4-1-0.4t-1 0.4l-2.1 2q-0.4 0.4-0.4 1 0 0.6 0.4 1l8.1 8.1q0.4 0.4 1 0.4 0.6 0 1-0.4l12.2-12.1q0.4-0.4 0.4-1z m5.6 3.6q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),Pe=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).copiedTimer=null,r.handleCopy=function(){var e=document.createElement("textarea"),t=r.props,n=t.clickCallback,o=t.src,a=t.namespace;e.innerHTML=JSON.stringify(r.clipboardValue(o),null,"  "),document.body.appendChild(e),e.select(),document.execCommand("copy"),document.body.removeChild(e),r.copiedTimer=setTimeout(function(){r.setState({copied:!1})},5500),r.setState({copied:!0},function(){"function"==typeof n&&n({src:o,namespace:a,name:a[a.length-1]})})},r.getClippyIcon=function(){var e=r.props.theme;return r.state.copied?A.a.createElement("span",null,A.a.createElement(_e,Object.assign({className:"copy-icon"},w(e,"copy-icon"))),A.a.createElement("span",w(e,"copy-icon-copied"),"✔")):A.a.createElement(_e,Object.assign({className:"copy-icon"},w(e,"copy-icon")))},r.clipboardValue=function(e){switch(x(e)){case"function":case"regexp":return e.toString();default:return e}},r.state={copied:!1},r}u(t,e);var n=h(t);return s(t,[{key:"componentWillUnmount",value:function(){this.copiedTimer&&(clearTimeout(this.copiedTimer),this.copiedTimer=null)}},{key:"render",value:function(){var e=this.props,t=(e.src,e.theme),n=e.hidden,r=e.rowHovered,o=w(t,"copy-to-clipboard").style,l="inline";return n&&(l="none"),A.a.createElement("span",{className:"copy-to-clipboard-container",title:"Copy to clipboard",style:{verticalAlign:"top",display:r?"inline-block":"none"}},A.a.createElement("span",{style:a(a({},o),{},{display:l}),onClick:this.handleCopy},this.getClippyIcon()))}}]),t}(A.a.PureComponent),Ce=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).getEditIcon=function(){var e=r.props,t=e.variable,n=e.theme;return A.a.createElement("div",{className:"click-to-edit",style:{verticalAlign:"top",display:r.state.hovered?"inline-block":"none"}},A.a.createElement(Ee,Object.assign({className:"click-to-edit-icon"},w(n,"editVarIcon"),{onClick:function(){r.prepopInput(t)}})))},r.prepopInput=function(e){if(!1!==r.props.onEdit){var t=function(e){var t;switch(x(e)){case"undefined":t="undefined";break;case"nan":t="NaN";break;case"string":t=e;break;case"date":case"function":case"regexp":t=e.toString();break;default:try{t=JSON.stringify(e,null,"  ")}catch(e){t=""}}return t}(e.value),n=j(t);r.setState({editMode:!0,editValue:t,parsedInput:{type:n.type,value:n.value}})}},r.getRemoveIcon=function(){var e=r.props,t=e.variable,n=e.namespace,o=e.theme,a=e.rjvId;return A.a.createElement("div",{className:"click-to-remove",style:{verticalAlign:"top",display:r.state.hovered?"inline-block":"none"}},A.a.createElement(xe,Object.assign({className:"click-to-remove-icon"},w(o,"removeVarIcon"),{onClick:function(){B.dispatch({name:"VARIABLE_REMOVED",rjvId:a,data:{name:t.name,namespace:n,existing_value:t.value,variable_removed:!0}})}})))},r.getValue=function(e,t){var n=!t&&e.type,o=p(r).props;switch(n){case!1:return r.getEditInput();case"string":return A.a.createElement(ee,Object.assign({value:e.value},o));case"integer":return A.a.createElement(J,Object.assign({value:e.value},o));case"float":return A.a.createElement(W,Object.assign({value:e.value},o));case"boolean":return A.a.createElement(q,Object.assign({value:e.value},o));case"function":return A.a.createElement(G,Object.assign({value:e.value},o));case"null":return A.a.createElement(Z,o);case"nan":return A.a.createElement(Q,o);case"undefined":return A.a.createElement(te,o);case"date":return A.a.createElement(Y,Object.assign({value:e.value},o));case"regexp":return A.a.createElement($,Object.assign({value:e.value},o));default:return A.a.createElement("div",{className:"object-value"},JSON.stringify(e.value))}},r.getEditInput=function(){var e=r.props.theme,t=r.state.editValue;return A.a.createElement("div",null,A.a.createElement(pe,Object.assign({type:"text",inputRef:function(e){return e&&e.focus()},value:t,className:"variable-editor",onChange:function(e){var t=e.target.value,n=j(t);r.setState({editValue:t,parsedInput:{type:n.type,value:n.value}})},onKeyDown:function(e){switch(e.key){case"Escape":r.setState({editMode:!1,editValue:""});break;case"Enter":(e.ctrlKey||e.metaKey)&&r.submitEdit(!0)}e.stopPropagation()},placeholder:"update this value",minRows:2},w(e,"edit-input"))),A.a.createElement("div",w(e,"edit-icon-container"),A.a.createElement(xe,Object.assign({className:"edit-cancel"},w(e,"cancel-icon"),{onClick:function(){r.setState({editMode:!1,editValue:""})}})),A.a.createElement(Oe,Object.assign({className:"edit-check string-value"},w(e,"check-icon"),{onClick:function(){r.submitEdit()}})),A.a.createElement("div",null,r.showDetected())))},r.submitEdit=function(e){var t=r.props,n=t.variable,o=t.namespace,a=t.rjvId,l=r.state,i=l.editValue,s=l.parsedInput,c=i;e&&s.type&&(c=s.value),r.setState({editMode:!1}),B.dispatch({name:"VARIABLE_UPDATED",rjvId:a,data:{name:n.name,namespace:o,existing_value:n.value,new_value:c,variable_removed:!1}})},r.showDetected=function(){var e=r.props,t=e.theme,n=(e.variable,e.namespace,e.rjvId,r.state.parsedInput),o=(n.type,n.value,r.getDetectedInput());if(o)return A.a.createElement("div",null,A.a.createElement("div",w(t,"detected-row"),o,A.a.createElement(Oe,{className:"edit-check detected",style:a({verticalAlign:"top",paddingLeft:"3px"},w(t,"check-icon").style),onClick:function(){r.submitEdit(!0)}})))},r.getDetectedInput=function(){var e=r.state.parsedInput,t=e.type,n=e.value,o=p(r).props,l=o.theme;if(!1!==t)switch(t.toLowerCase()){case"object":return A.a.createElement("span",null,A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"{"),A.a.createElement("span",{style:a(a({},w(l,"ellipsis").style),{},{cursor:"default"})},"..."),A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"}"));case"array":return A.a.createElement("span",null,A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"["),A.a.createElement("span",{style:a(a({},w(l,"ellipsis").style),{},{cursor:"default"})},"..."),A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"]"));case"string":return A.a.createElement(ee,Object.assign({value:n},o));case"integer":return A.a.createElement(J,Object.assign({value:n},o));case"float":return A.a.createElement(W,Object.assign({value:n},o));case"boolean":return A.a.createElement(q,Object.assign({value:n},o));case"function":return A.a.createElement(G,Object.assign({value:n},o));case"null":return A.a.createElement(Z,o);case"nan":return A.a.createElement(Q,o);case"undefined":return A.a.createElement(te,o);case"date":return A.a.createElement(Y,Object.assign({value:new Date(n)},o))}},r.state={editMode:!1,editValue:"",hovered:!1,renameKey:!1,parsedInput:{type:!1,value:null}},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this,t=this.props,n=t.variable,r=t.singleIndent,o=t.type,l=t.theme,i=t.namespace,s=t.indentWidth,c=t.enableClipboard,u=t.onEdit,f=t.onDelete,d=t.onSelect,p=t.displayArrayKey,b=t.quotesOnKeys,h=this.state.editMode;return A.a.createElement("div",Object.assign({},w(l,"objectKeyVal",{paddingLeft:s*r}),{onMouseEnter:function(){return e.setState(a(a({},e.state),{},{hovered:!0}))},onMouseLeave:function(){return e.setState(a(a({},e.state),{},{hovered:!1}))},className:"variable-row",key:n.name}),"array"==o?p?A.a.createElement("span",Object.assign({},w(l,"array-key"),{key:n.name+"_"+i}),n.name,A.a.createElement("div",w(l,"colon"),":")):null:A.a.createElement("span",null,A.a.createElement("span",Object.assign({},w(l,"object-name"),{className:"object-key",key:n.name+"_"+i}),!!b&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"'),A.a.createElement("span",{style:{display:"inline-block"}},n.name),!!b&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"')),A.a.createElement("span",w(l,"colon"),":")),A.a.createElement("div",Object.assign({className:"variable-value",onClick:!1===d&&!1===u?null:function(t){var r=P(i);(t.ctrlKey||t.metaKey)&&!1!==u?e.prepopInput(n):!1!==d&&(r.shift(),d(a(a({},n),{},{namespace:r})))}},w(l,"variableValue",{cursor:!1===d?"default":"pointer"})),this.getValue(n,h)),c?A.a.createElement(Pe,{rowHovered:this.state.hovered,hidden:h,src:n.value,clickCallback:c,theme:l,namespace:[].concat(P(i),[n.name])}):null,!1!==u&&0==h?this.getEditIcon():null,!1!==f&&0==h?this.getRemoveIcon():null)}}]),t}(A.a.PureComponent),je=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).getObjectSize=function(){var t=e.props,n=t.size,r=t.theme;if(t.displayObjectSize)return A.a.createElement("span",Object.assign({className:"object-size"},w(r,"object-size")),n," item",1===n?"":"s")},e.getAddAttribute=function(t){var n=e.props,r=n.theme,o=n.namespace,l=n.name,i=n.src,s=n.rjvId,c=n.depth;return A.a.createElement("span",{className:"click-to-add",style:{verticalAlign:"top",display:t?"inline-block":"none"}},A.a.createElement(we,Object.assign({className:"click-to-add-icon"},w(r,"addVarIcon"),{onClick:function(){var e={name:c>0?l:null,namespace:o.splice(0,o.length-1),existing_value:i,variable_removed:!1,key_name:null};"object"===x(i)?B.dispatch({name:"ADD_VARIABLE_KEY_REQUEST",rjvId:s,data:e}):B.dispatch({name:"VARIABLE_ADDED",rjvId:s,data:a(a({},e),{},{new_value:[].concat(P(i),[null])})})}})))},e.getRemoveObject=function(t){var n=e.props,r=n.theme,o=(n.hover,n.namespace),a=n.name,l=n.src,i=n.rjvId;if(1!==o.length)return A.a.createElement("span",{className:"click-to-remove",style:{display:t?"inline-block":"none"}},A.a.createElement(xe,Object.assign({className:"click-to-remove-icon"},w(r,"removeVarIcon"),{onClick:function(){B.dispatch({name:"VARIABLE_REMOVED",rjvId:i,data:{name:a,namespace:o.splice(0,o.length-1),existing_value:l,variable_removed:!0}})}})))},e.render=function(){var t=e.props,n=t.theme,r=t.onDelete,o=t.onAdd,a=t.enableClipboard,l=t.src,i=t.namespace,s=t.rowHovered;return A.a.createElement("div",Object.assign({},w(n,"object-meta-data"),{className:"object-meta-data",onClick:function(e){e.stopPropagation()}}),e.getObjectSize(),a?A.a.createElement(Pe,{rowHovered:s,clickCallback:a,src:l,theme:n,namespace:i}):null,!1!==o?e.getAddAttribute(s):null,!1!==r?e.getRemoveObject(s):null)},e}u(t,e);var n=h(t);return t}(A.a.PureComponent),Se=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(e){var t=[];for(var n in r.state.expanded)t.push(r.state.expanded[n]);t[e]=!t[e],r.setState({expanded:t})},r.state={expanded:[]},r}u(t,e);var n=h(t);return s(t,[{key:"getExpandedIcon",value:function(e){var t=this.props,n=t.theme,r=t.iconStyle;return this.state.expanded[e]?A.a.createElement(R,{theme:n,iconStyle:r}):A.a.createElement(L,{theme:n,iconStyle:r})}},{key:"render",value:function(){var e=this,t=this.props,n=t.src,r=t.groupArraysAfterLength,o=(t.depth,t.name),a=t.theme,l=t.jsvRoot,i=t.namespace,s=(t.parent_type,_(t,["src","groupArraysAfterLength","depth","name","theme","jsvRoot","namespace","parent_type"])),c=0,u=5*this.props.indentWidth;l||(c=5*this.props.indentWidth);var f=r,d=Math.ceil(n.length/f);return A.a.createElement("div",Object.assign({className:"object-key-val"},w(a,l?"jsv-root":"objectKeyVal",{paddingLeft:c})),A.a.createElement(T,this.props),A.a.createElement("span",null,A.a.createElement(je,Object.assign({size:n.length},this.props))),P(Array(d)).map(function(t,r){return A.a.createElement("div",Object.assign({key:r,className:"object-key-val array-group"},w(a,"objectKeyVal",{marginLeft:6,paddingLeft:u})),A.a.createElement("span",w(a,"brace-row"),A.a.createElement("div",Object.assign({className:"icon-container"},w(a,"icon-container"),{onClick:function(t){e.toggleCollapsed(r)}}),e.getExpandedIcon(r)),e.state.expanded[r]?A.a.createElement(Re,Object.assign({key:o+r,depth:0,name:!1,collapsed:!1,groupArraysAfterLength:f,index_offset:r*f,src:n.slice(r*f,r*f+f),namespace:i,type:"array",parent_type:"array_group",theme:a},s)):A.a.createElement("span",Object.assign({},w(a,"brace"),{onClick:function(t){e.toggleCollapsed(r)},className:"array-group-brace"}),"[",A.a.createElement("div",Object.assign({},w(a,"array-group-meta-data"),{className:"array-group-meta-data"}),A.a.createElement("span",Object.assign({className:"object-size"},w(a,"object-size")),r*f," - ",r*f+f>n.length?n.length:r*f+f)),"]")))}))}}]),t}(A.a.PureComponent),Me=function(e){function t(e){var r;l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({expanded:!r.state.expanded},function(){X.set(r.props.rjvId,r.props.namespace,"expanded",r.state.expanded)})},r.getObjectContent=function(e,t,n){return A.a.createElement("div",{className:"pushed-content object-container"},A.a.createElement("div",Object.assign({className:"object-content"},w(r.props.theme,"pushed-content")),r.renderObjectContents(t,n)))},r.getEllipsis=function(){return 0===r.state.size?null:A.a.createElement("div",Object.assign({},w(r.props.theme,"ellipsis"),{className:"node-ellipsis",onClick:r.toggleCollapsed}),"...")},r.getObjectMetaData=function(e){var t=r.props,n=(t.rjvId,t.theme,r.state),o=n.size,a=n.hovered;return A.a.createElement(je,Object.assign({rowHovered:a,size:o},r.props))},r.renderObjectContents=function(e,t){var n,o=r.props,a=o.depth,l=o.parent_type,i=o.index_offset,s=o.groupArraysAfterLength,c=o.namespace,u=r.state.object_type,f=[],d=Object.keys(e||{});return r.props.sortKeys&&"array"!==u&&(d=d.sort()),d.forEach(function(o){if(n=new Te(o,e[o]),"array_group"===l&&i&&(n.name=parseInt(n.name)+i),e.hasOwnProperty(o))if("object"===n.type)f.push(A.a.createElement(Re,Object.assign({key:n.name,depth:a+1,name:n.name,src:n.value,namespace:c.concat(n.name),parent_type:u},t)));else if("array"===n.type){var d=Re;s&&n.value.length>s&&(d=Se),f.push(A.a.createElement(d,Object.assign({key:n.name,depth:a+1,name:n.name,src:n.value,namespace:c.concat(n.name),type:"array",parent_type:u},t)))}else f.push(A.a.createElement(Ce,Object.assign({key:n.name+"_"+c,variable:n,singleIndent:5,namespace:c,type:r.props.type},t)))}),f};var o=t.getState(e);return r.state=a(a({},o),{},{prevProps:{}}),r}u(t,e);var n=h(t);return s(t,[{key:"getBraceStart",value:function(e,t){var n=this,r=this.props,o=r.src,a=r.theme,l=r.iconStyle;if("array_group"===r.parent_type)return A.a.createElement("span",null,A.a.createElement("span",w(a,"brace"),"array"===e?"[":"{"),t?this.getObjectMetaData(o):null);var i=t?R:L;return A.a.createElement("span",null,A.a.createElement("span",Object.assign({onClick:function(e){n.toggleCollapsed()}},w(a,"brace-row")),A.a.createElement("div",Object.assign({className:"icon-container"},w(a,"icon-container")),A.a.createElement(i,{theme:a,iconStyle:l})),A.a.createElement(T,this.props),A.a.createElement("span",w(a,"brace"),"array"===e?"[":"{")),t?this.getObjectMetaData(o):null)}},{key:"render",value:function(){var e=this,t=this.props,n=t.depth,r=t.src,o=(t.namespace,t.name,t.type,t.parent_type),l=t.theme,i=t.jsvRoot,s=t.iconStyle,c=_(t,["depth","src","namespace","name","type","parent_type","theme","jsvRoot","iconStyle"]),u=this.state,f=u.object_type,d=u.expanded,p={};return i||"array_group"===o?"array_group"===o&&(p.borderLeft=0,p.display="inline"):p.paddingLeft=5*this.props.indentWidth,A.a.createElement("div",Object.assign({className:"object-key-val",onMouseEnter:function(){return e.setState(a(a({},e.state),{},{hovered:!0}))},onMouseLeave:function(){return e.setState(a(a({},e.state),{},{hovered:!1}))}},w(l,i?"jsv-root":"objectKeyVal",p)),this.getBraceStart(f,d),d?this.getObjectContent(n,r,a({theme:l,iconStyle:s},c)):this.getEllipsis(),A.a.createElement("span",{className:"brace-row"},A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{paddingLeft:d?"3px":"0px"})},"array"===f?"]":"}"),d?null:this.getObjectMetaData(r)))}}],[{key:"getDerivedStateFromProps",value:function(e,n){var r=n.prevProps;return e.src!==r.src||e.collapsed!==r.collapsed||e.name!==r.name||e.namespace!==r.namespace||e.rjvId!==r.rjvId?a(a({},t.getState(e)),{},{prevProps:e}):null}}]),t}(A.a.PureComponent);Me.getState=function(e){var t=Object.keys(e.src).length,n=(!1===e.collapsed||!0!==e.collapsed&&e.collapsed>e.depth)&&(!e.shouldCollapse||!1===e.shouldCollapse({name:e.name,src:e.src,type:x(e.src),namespace:e.namespace}))&&0!==t;return{expanded:X.get(e.rjvId,e.namespace,"expanded",n),object_type:"array"===e.type?"array":"object",parent_type:"array"===e.type?"array":"object",size:t,hovered:!1}};var Te=function e(t,n){l(this,e),this.name=t,this.value=n,this.type=x(n)};y(Me);var Re=Me,Le=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),a=0;a<r;a++)o[a]=arguments[a];return(e=n.call.apply(n,[this].concat(o))).render=function(){var t=p(e).props,n=[t.name],r=Re;return Array.isArray(t.src)&&t.groupArraysAfterLength&&t.src.length>t.groupArraysAfterLength&&(r=Se),A.a.createElement("div",{className:"pretty-json-container object-container"},A.a.createElement("div",{className:"object-content"},A.a.createElement(r,Object.assign({namespace:n,depth:0,jsvRoot:!0},t))))},e}u(t,e);var n=h(t);return t}(A.a.PureComponent),Ne=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).closeModal=function(){B.dispatch({rjvId:r.props.rjvId,name:"RESET"})},r.submit=function(){r.props.submit(r.state.input)},r.state={input:e.input?e.input:""},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this,t=this.props,n=t.theme,r=t.rjvId,o=t.isValid,a=this.state.input,l=o(a);return A.a.createElement("div",Object.assign({className:"key-modal-request"},w(n,"key-modal-request"),{onClick:this.closeModal}),A.a.createElement("div",Object.assign({},w(n,"key-modal"),{onClick:function(e){e.stopPropagation()}}),A.a.createElement("div",w(n,"key-modal-label"),"Key Name:"),A.a.createElement("div",{style:{position:"relative"}},A.a.createElement("input",Object.assign({},w(n,"key-modal-input"),{className:"key-modal-input",ref:function(e){return e&&e.focus()},spellCheck:!1,value:a,placeholder:"...",onChange:function(t){e.setState({input:t.target.value})},onKeyPress:function(t){l&&"Enter"===t.key?e.submit():"Escape"===t.key&&e.closeModal()}})),l?A.a.createElement(Oe,Object.assign({},w(n,"key-modal-submit"),{className:"key-modal-submit",onClick:function(t){return e.submit()}})):null),A.a.createElement("span",w(n,"key-modal-cancel"),A.a.createElement(ke,Object.assign({},w(n,"key-modal-cancel-icon"),{className:"key-modal-cancel",onClick:function(){B.dispatch({rjvId:r,name:"RESET"})}})))))}}]),t}(A.a.PureComponent),Ae=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).isValid=function(t){var n=e.props.rjvId,r=X.get(n,"action","new-key-request");return""!=t&&-1===Object.keys(r.existing_value).indexOf(t)},e.submit=function(t){var n=e.props.rjvId,r=X.get(n,"action","new-key-request");r.new_value=a({},r.existing_value),r.new_value[t]=e.props.defaultValue,B.dispatch({name:"VARIABLE_ADDED",rjvId:n,data:r})},e}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.active,n=e.theme,r=e.rjvId;return t?A.a.createElement(Ne,{rjvId:r,theme:n,isValid:this.isValid,submit:this.submit}):null}}]),t}(A.a.PureComponent),De=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.message,n=e.active,r=e.theme,o=e.rjvId;return n?A.a.createElement("div",Object.assign({className:"validation-failure"},w(r,"validation-failure"),{onClick:function(){B.dispatch({rjvId:o,name:"RESET"})}}),A.a.createElement("span",w(r,"validation-failure-label"),t),A.a.createElement(ke,w(r,"validation-failure-clear"))):null}}]),t}(A.a.PureComponent),Ie=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).rjvId=Date.now().toString(),r.getListeners=function(){return{reset:r.resetState,"variable-update":r.updateSrc,"add-key-request":r.addKeyRequest}},r.updateSrc=function(){var e,t=X.get(r.rjvId,"action","variable-update"),n=t.name,o=t.namespace,a=t.new_value,l=t.existing_value,i=(t.variable_removed,t.updated_src),s=t.type,c=r.props,u=c.onEdit,f=c.onDelete,d=c.onAdd,p={existing_src:r.state.src,new_value:a,updated_src:i,name:n,namespace:o,existing_value:l};switch(s){case"variable-added":e=d(p);break;case"variable-edited":e=u(p);break;case"variable-removed":e=f(p)}!1!==e?(X.set(r.rjvId,"global","src",i),r.setState({src:i})):r.setState({validationFailure:!0})},r.addKeyRequest=function(){r.setState({addKeyRequest:!0})},r.resetState=function(){r.setState({validationFailure:!1,addKeyRequest:!1})},r.state={addKeyRequest:!1,editKeyRequest:!1,validationFailure:!1,src:t.defaultProps.src,name:t.defaultProps.name,theme:t.defaultProps.theme,validationMessage:t.defaultProps.validationMessage,prevSrc:t.defaultProps.src,prevName:t.defaultProps.name,prevTheme:t.defaultProps.theme},r}u(t,e);var n=h(t);return s(t,[{key:"componentDidMount",value:function(){X.set(this.rjvId,"global","src",this.state.src);var e=this.getListeners();for(var t in e)X.on(t+"-"+this.rjvId,e[t]);this.setState({addKeyRequest:!1,editKeyRequest:!1})}},{key:"componentDidUpdate",value:function(e,t){!1!==t.addKeyRequest&&this.setState({addKeyRequest:!1}),!1!==t.editKeyRequest&&this.setState({editKeyRequest:!1}),e.src!==this.state.src&&X.set(this.rjvId,"global","src",this.state.src)}},{key:"componentWillUnmount",value:function(){var e=this.getListeners();for(var t in e)X.removeListener(t+"-"+this.rjvId,e[t])}},{key:"render",value:function(){var e=this.state,t=e.validationFailure,n=e.validationMessage,r=e.addKeyRequest,o=e.theme,l=e.src,i=e.name,s=this.props,c=s.style,u=s.defaultValue;return A.a.createElement("div",{className:"react-json-view",style:a(a({},w(o,"app-container").style),c)},A.a.createElement(De,{message:n,active:t,theme:o,rjvId:this.rjvId}),A.a.createElement(Le,Object.assign({},this.props,{src:l,name:i,theme:o,type:x(l),rjvId:this.rjvId})),A.a.createElement(Ae,{active:r,theme:o,rjvId:this.rjvId,defaultValue:u}))}}],[{key:"getDerivedStateFromProps",value:function(e,n){if(e.src!==n.prevSrc||e.name!==n.prevName||e.theme!==n.prevTheme){var r={src:e.src,name:e.name,theme:e.theme,validationMessage:e.validationMessage,prevSrc:e.src,prevName:e.name,prevTheme:e.theme};return t.validateState(r)}return null}}]),t}(A.a.PureComponent);Ie.defaultProps={src:{},name:"root",theme:"rjv-default",collapsed:!1,collapseStringsAfterLength:!1,shouldCollapse:!1,sortKeys:!1,quotesOnKeys:!0,groupArraysAfterLength:100,indentWidth:4,enableClipboard:!0,displayObjectSize:!0,displayDataTypes:!0,onEdit:!1,onDelete:!1,onAdd:!1,onSelect:!1,iconStyle:"triangle",style:{},validationMessage:"Validation Error",defaultValue:null,displayArrayKey:!0},Ie.validateState=function(e){var t={};return"object"!==x(e.theme)||function(e){var t=["base00","base01","base02","base03","base04","base05","base06","base07","base08","base09","base0A","base0B","base0C","base0D","base0E","base0F"];if("object"===x(e)){for(var n=0;n<t.length;n++)if(!(t[n]in e))return!1;return!0}return!1}(e.theme)||(console.error("react-json-view error:","theme prop must be a theme name or valid base-16 theme object.",'defaulting to "rjv-default" theme'),t.theme="rjv-default"),"object"!==x(e.src)&&"array"!==x(e.src)&&(console.error("react-json-view error:","src property must be a valid json object"),t.name="ERROR",t.src={message:"src property must be a valid json object"}),a(a({},e),t)},y(Ie),t.default=Ie}])})},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={selected:[],page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return c.default.createElement("div",null,c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Dead Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) are dead."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,c.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return c.default.createElement("tr",{key:t.id},c.default.createElement("td",null,c.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),c.default.createElement("td",null,t.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:t.args})),c.default.createElement("td",null,t.err),c.default.createElement("td",null,c.default.createElement(h.default,{ts:t.t})))}))))),c.default.createElement("div",{className:g.default.btnGroup,role:"group"},c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(c.default.Component);w.propTypes={fetchURL:f.default.string,deleteURL:f.default.string,deleteAllURL:f.default.string,retryURL:f.default.string,retryAllURL:f.default.string},t.default=w},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(8),p=r(d),b=n(9),h=r(b),m=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={queues:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"componentDidMount",value:function(){var e=this;this.props.url&&fetch(this.props.url).then(function(e){return e.json()}).then(function(t){e.setState({queues:t})})}},{key:"render",value:function(){return c.default.createElement("div",{className:(0,h.default)(p.default.panel,p.default.panelDefault)},c.default.createElement("div",{className:p.default.panelHeading},"queues"),c.default.createElement("div",{className:p.default.panelBody},c.default.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued.")),c.default.createElement("div",{className:p.default.tableResponsive},c.default.createElement("table",{className:p.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Count"),c.default.createElement("th",null,"Latency (seconds)"),c.default.createElement("th",null,"Lock Count"),c.default.createElement("th",null,"Max Concurrency")),this.state.queues.map(function(e){return c.default.createElement("tr",{key:e.job_name},c.default.createElement("td",null,e.job_name),c.default.createElement("td",null,e.count),c.default.createElement("td",null,e.latency),c.default.createElement("td",null,e.lock_count),c.default.createElement("td",null,e.max_concurrency))})))))}},{key:"queuedCount",get:function(){var e=0;return this.state.queues.map(function(t){e+=t.count}),e}}]),t}(c.default.Component);m.propTypes={url:f.default.string},t.default=m},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Retry Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:e.args})),c.default.createElement("td",null,e.err),c.default.createElement("td",null,c.default.createElement(h.default,{ts:e.t})))})))))}}]),t}(c.default.Component);w.propTypes={url:f.default.string},t.default=w},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Scheduled Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:e.args})),c.default.createElement("td",null,c.default.createElement(h.default,{ts:e.run_at})))})))))}}]),t}(c.default.Component);w.propTypes={url:f.default.string},t.default=w},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var r=n(61);n.d(t,"Router",function(){return r.a});var o=n(33);n.d(t,"Link",function(){return o.a});var a=n(71);n.d(t,"IndexLink",function(){return a.a});var l=n(72);n.d(t,"withRouter",function(){return l.a});var i=n(74);n.d(t,"IndexRedirect",function(){return i.a});var s=n(75);n.d(t,"IndexRoute",function(){return s.a});var c=n(34);n.d(t,"Redirect",function(){return c.a});var u=n(76);n.d(t,"Route",function(){return u.a});var f=n(6);n.d(t,"createRoutes",function(){return f.b});var d=n(21);n.d(t,"RouterContext",function(){return d.a});var p=n(23);n.d(t,"locationShape",function(){return p.a}),n.d(t,"routerShape",function(){return p.b});var b=n(77);n.d(t,"match",function(){return b.a});var h=n(38);n.d(t,"useRouterHistory",function(){return h.a});var m=n(11);n.d(t,"formatPattern",function(){return m.a});var g=n(82);n.d(t,"applyRouterMiddleware",function(){return g.a});var v=n(83);n.d(t,"browserHistory",function(){return v.a});var y=n(86);n.d(t,"hashHistory",function(){return y.a});var _=n(35);n.d(t,"createMemoryHistory",function(){return _.a})},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}var o=n(1),a=n.n(o),l=n(0),i=n.n(l),s=n(4),c=n.n(s),u=n(3),f=(n.n(u),n(30)),d=n(13),p=n(21),b=n(6),h=n(32),m=(n(10),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}),g={history:u.object,children:d.d,routes:d.d,render:u.func,createElement:u.func,onError:u.func,onUpdate:u.func,matchContext:u.object},v=c()({displayName:"Router",propTypes:g,getDefaultProps:function(){return{render:function(e){return i.a.createElement(p.a,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},createRouterObject:function(e){var t=this.props.matchContext;if(t)return t.router;var n=this.props.history;return Object(h.b)(n,this.transitionManager,e)},createTransitionManager:function(){var e=this.props.matchContext;if(e)return e.transitionManager;var t=this.props.history,n=this.props,r=n.routes,o=n.children;return t.getCurrentLocation||a()(!1),Object(f.a)(t,Object(b.b)(r||o))},componentWillMount:function(){var e=this;this.transitionManager=this.createTransitionManager(),this.router=this.createRouterObject(this.state),this._unlisten=this.transitionManager.listen(function(t,n){t?e.handleError(t):(Object(h.a)(e.router,n),e.setState(n,e.props.onUpdate))})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function(){var e=this.state,t=e.location,n=e.routes,o=e.params,a=e.components,l=this.props,i=l.createElement,s=l.render,c=r(l,["createElement","render"]);return null==t?null:(Object.keys(g).forEach(function(e){return delete c[e]}),s(m({},c,{router:this.router,location:t,routes:n,params:o,components:a,createElement:i})))}});t.a=v},function(e,t,n){"use strict";function r(e,t,n,r,o,a,l,i){if(s(t),!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,r,o,a,l,i],f=0;c=new Error(t.replace(/%s/g,function(){return u[f++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}}function o(e){return e}function a(e,t,n){function a(e,t){var n=v.hasOwnProperty(t)?v[t]:null;k.hasOwnProperty(t)&&r("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&r("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function s(e,n){if(n){r("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),r(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&_.mixins(e,n.mixins);for(var i in n)if(n.hasOwnProperty(i)&&i!==c){var s=n[i],u=o.hasOwnProperty(i);if(a(u,i),_.hasOwnProperty(i))_[i](e,s);else{var f=v.hasOwnProperty(i),b="function"==typeof s,h=b&&!f&&!u&&!1!==n.autobind;if(h)l.push(i,s),o[i]=s;else if(u){var m=v[i];r(f&&("DEFINE_MANY_MERGED"===m||"DEFINE_MANY"===m),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",m,i),"DEFINE_MANY_MERGED"===m?o[i]=d(o[i],s):"DEFINE_MANY"===m&&(o[i]=p(o[i],s))}else o[i]=s}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var a=n in _;r(!a,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;if(l){var i=y.hasOwnProperty(n)?y[n]:null;return r("DEFINE_MANY_MERGED"===i,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),void(e[n]=d(e[n],o))}e[n]=o}}}function f(e,t){r(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(r(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function d(e,t){return function(){var n=e.apply(this,arguments),r=t.apply(this,arguments);if(null==n)return r;if(null==r)return n;var o={};return f(o,n),f(o,r),o}}function p(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function b(e,t){var n=t.bind(e);return n}function h(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var r=t[n],o=t[n+1];e[r]=b(e,o)}}function m(e){var t=o(function(e,o,a){this.__reactAutoBindPairs.length&&h(this),this.props=e,this.context=o,this.refs=i,this.updater=a||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;r("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new E,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(s.bind(null,t)),s(t,x),s(t,e),s(t,w),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),r(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var a in v)t.prototype[a]||(t.prototype[a]=null);return t}var g=[],v={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",UNSAFE_componentWillMount:"DEFINE_MANY",UNSAFE_componentWillReceiveProps:"DEFINE_MANY",UNSAFE_componentWillUpdate:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},y={getDerivedStateFromProps:"DEFINE_MANY_MERGED"},_={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)s(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=l({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=l({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=d(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=l({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},x={componentDidMount:function(){this.__isMounted=!0}},w={componentWillUnmount:function(){this.__isMounted=!1}},k={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},E=function(){};return l(E.prototype,e.prototype,k),m}var l=n(14),i={},s=function(e){},c="mixins";e.exports=a},function(e,t,n){"use strict";function r(){}function o(){}var a=n(64);o.resetWarningCache=r,e.exports=function(){function e(e,t,n,r,o,l){if(l!==a){var i=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types");throw i.name="Invariant Violation",i}}function t(){return e}e.isRequired=e;var n={array:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,elementType:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t,checkPropTypes:o,resetWarningCache:r};return n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function r(e,t,n){return!!e.path&&Object(a.b)(e.path).some(function(e){return t.params[e]!==n.params[e]})}function o(e,t){var n=e&&e.routes,o=t.routes,a=void 0,l=void 0,i=void 0;if(n){var s=!1;a=n.filter(function(n){if(s)return!0;var a=-1===o.indexOf(n)||r(n,e,t);return a&&(s=!0),a}),a.reverse(),i=[],l=[],o.forEach(function(e){var t=-1===n.indexOf(e),r=-1!==a.indexOf(e);t||r?i.push(e):l.push(e)})}else a=[],l=[],i=o;return{leaveRoutes:a,changeRoutes:l,enterRoutes:i}}var a=n(11);t.a=o},function(e,t,n){"use strict";function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function o(){function e(e,t,n,r){var o=e.length<n,a=function(){for(var n=arguments.length,r=Array(n),a=0;a<n;a++)r[a]=arguments[a];if(e.apply(t,r),o){(0,r[r.length-1])()}};return r.add(a),a}function t(t){return t.reduce(function(t,n){return n.onEnter&&t.push(e(n.onEnter,n,3,c)),t},[])}function n(t){return t.reduce(function(t,n){return n.onChange&&t.push(e(n.onChange,n,4,u)),t},[])}function r(e,t,n){function r(e){o=e}if(!e)return void n();var o=void 0;Object(a.a)(e,function(e,n,a){t(e,r,function(e){e||o?a(e,o):n()})},n)}function o(e,n,o){c.clear();var a=t(e);return r(a.length,function(e,t,r){var o=function(){c.has(a[e])&&(r.apply(void 0,arguments),c.remove(a[e]))};a[e](n,t,o)},o)}function i(e,t,o,a){u.clear();var l=n(e);return r(l.length,function(e,n,r){var a=function(){u.has(l[e])&&(r.apply(void 0,arguments),u.remove(l[e]))};l[e](t,o,n,a)},a)}function s(e,t){for(var n=0,r=e.length;n<r;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}var c=new l,u=new l;return{runEnterHooks:o,runChangeHooks:i,runLeaveHooks:s}}t.a=o;var a=n(20),l=function e(){var t=this;r(this,e),this.hooks=[],this.add=function(e){return t.hooks.push(e)},this.remove=function(e){return t.hooks=t.hooks.filter(function(t){return t!==e})},this.has=function(e){return-1!==t.hooks.indexOf(e)},this.clear=function(){return t.hooks=[]}}},function(e,t,n){"use strict";function r(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return r(e,t[n])});if("object"===(void 0===e?"undefined":c(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!r(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function o(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function a(e,t,n){for(var r=e,o=[],a=[],l=0,i=t.length;l<i;++l){var c=t[l],u=c.path||"";if("/"===u.charAt(0)&&(r=e,o=[],a=[]),null!==r&&u){var f=Object(s.c)(u,r);if(f?(r=f.remainingPathname,o=[].concat(o,f.paramNames),a=[].concat(a,f.paramValues)):r=null,""===r)return o.every(function(e,t){return String(a[t])===String(n[e])})}}return!1}function l(e,t){return null==t?null==e:null==e||r(e,t)}function i(e,t,n,r,i){var s=e.pathname,c=e.query;return null!=n&&("/"!==s.charAt(0)&&(s="/"+s),!!(o(s,n.pathname)||!t&&a(s,r,i))&&l(c,n.query))}t.a=i;var s=n(11),c="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e}},function(e,t,n){"use strict";function r(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var r=t.getComponent||t.getComponents;if(r){var o=r.call(t,e,n);Object(l.a)(o)&&o.then(function(e){return n(null,e)},n)}else n()}function o(e,t){Object(a.b)(e.routes,function(t,n,o){r(e,t,o)},t)}var a=n(20),l=n(31);t.a=o},function(e,t,n){"use strict";function r(e,t,n,r,o){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var a=!0,i=void 0,s={location:t,params:l(n,r)},c=e.getChildRoutes(s,function(e,t){if(t=!e&&Object(d.b)(t),a)return void(i=[e,t]);o(e,t)});return Object(u.a)(c)&&c.then(function(e){return o(null,Object(d.b)(e))},o),a=!1,i}function o(e,t,n,a,i){if(e.indexRoute)i(null,e.indexRoute);else if(e.getIndexRoute){var s={location:t,params:l(n,a)},f=e.getIndexRoute(s,function(e,t){i(e,!e&&Object(d.b)(t)[0])});Object(u.a)(f)&&f.then(function(e){return i(null,Object(d.b)(e)[0])},i)}else if(e.childRoutes||e.getChildRoutes){var p=function(e,r){if(e)return void i(e);var l=r.filter(function(e){return!e.path});Object(c.a)(l.length,function(e,r,i){o(l[e],t,n,a,function(t,n){if(t||n){var o=[l[e]].concat(Array.isArray(n)?n:[n]);i(t,o)}else r()})},function(e,t){i(null,t)})},b=r(e,t,n,a,p);b&&p.apply(void 0,b)}else i()}function a(e,t,n){return t.reduce(function(e,t,r){var o=n&&n[r];return Array.isArray(e[t])?e[t].push(o):e[t]=t in e?[e[t],o]:o,e},e)}function l(e,t){return a({},e,t)}function i(e,t,n,a,i,c){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,a=[],i=[]),null!==n&&u){try{var d=Object(f.c)(u,n);d?(n=d.remainingPathname,a=[].concat(a,d.paramNames),i=[].concat(i,d.paramValues)):n=null}catch(e){c(e)}if(""===n){var p={routes:[e],params:l(a,i)};return void o(e,t,a,i,function(e,t){if(e)c(e);else{if(Array.isArray(t)){var n;(n=p.routes).push.apply(n,t)}else t&&p.routes.push(t);c(null,p)}})}}if(null!=n||e.childRoutes){var b=function(r,o){r?c(r):o?s(o,t,function(t,n){t?c(t):n?(n.routes.unshift(e),c(null,n)):c()},n,a,i):c()},h=r(e,t,a,i,b);h&&b.apply(void 0,h)}else c()}function s(e,t,n,r){var o=arguments.length>4&&void 0!==arguments[4]?arguments[4]:[],a=arguments.length>5&&void 0!==arguments[5]?arguments[5]:[];void 0===r&&("/"!==t.pathname.charAt(0)&&(t=p({},t,{pathname:"/"+t.pathname})),r=t.pathname),Object(c.a)(e.length,function(n,l,s){i(e[n],t,r,o,a,function(e,t){e||t?s(e,t):l()})},n)}t.a=s;var c=n(20),u=n(31),f=n(11),d=(n(10),n(6)),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e,t){var n={};return e.path?(Object(o.b)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}var o=n(11);t.a=r},function(e,t,n){"use strict";var r=n(0),o=n.n(r),a=n(4),l=n.n(a),i=n(33),s=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},c=l()({displayName:"IndexLink",render:function(){return o.a.createElement(i.a,s({},this.props,{onlyActiveOnIndex:!0}))}});t.a=c},function(e,t,n){"use strict";function r(e){return e.displayName||e.name||"Component"}function o(e,t){var n=t&&t.withRef,o=u()({displayName:"WithRouter",mixins:[Object(p.b)("router")],contextTypes:{router:b.b},propTypes:{router:b.b},getWrappedInstance:function(){return n||l()(!1),this.wrappedInstance},render:function(){var t=this,r=this.props.router||this.context.router;if(!r)return s.a.createElement(e,this.props);var o=r.params,a=r.location,l=r.routes,i=h({},this.props,{router:r,params:o,location:a,routes:l});return n&&(i.ref=function(e){t.wrappedInstance=e}),s.a.createElement(e,i)}});return o.displayName="withRouter("+r(e)+")",o.WrappedComponent=e,d()(o,e)}t.a=o;var a=n(1),l=n.n(a),i=n(0),s=n.n(i),c=n(4),u=n.n(c),f=n(73),d=n.n(f),p=n(22),b=n(23),h=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";var r={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},o={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},a="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,n){if("string"!=typeof t){var l=Object.getOwnPropertyNames(t);a&&(l=l.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<l.length;++i)if(!(r[l[i]]||o[l[i]]||n&&n[l[i]]))try{e[l[i]]=t[l[i]]}catch(e){}}return e}},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(10),n(1)),i=n.n(l),s=n(34),c=n(13),u=o()({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=s.a.createRouteFromReactElement(e))}},propTypes:{to:a.string.isRequired,query:a.object,state:a.object,onEnter:c.c,children:c.c},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(10),n(1)),i=n.n(l),s=n(6),c=n(13),u=o()({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=Object(s.a)(e))}},propTypes:{path:c.c,component:c.a,components:c.b,getComponent:a.func,getComponents:a.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(1)),i=n.n(l),s=n(6),c=n(13),u=o()({displayName:"Route",statics:{createRouteFromReactElement:s.a},propTypes:{path:a.string,component:c.a,components:c.b,getComponent:a.func,getComponents:a.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}function o(e,t){var n=e.history,o=e.routes,l=e.location,p=r(e,["history","routes","location"]);n||l||i()(!1),n=n||Object(s.a)(p);var b=Object(c.a)(n,Object(u.b)(o));l=l?n.createLocation(l):n.getCurrentLocation(),b.match(l,function(e,r,o){var l=void 0;if(o){var i=Object(f.b)(n,b,o);l=d({},o,{router:i,matchContext:{transitionManager:b,router:i}})}t(e,r&&n.createLocation(r,a.REPLACE),l)})}var a=n(17),l=(n.n(a),n(1)),i=n.n(l),s=n(35),c=n(30),u=n(6),f=n(32),d=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e};t.a=o},function(e,t,n){"use strict";function r(e){switch(e.arrayFormat){case"index":return function(t,n,r){return null===n?[a(t,e),"[",r,"]"].join(""):[a(t,e),"[",a(r,e),"]=",a(n,e)].join("")};case"bracket":return function(t,n){return null===n?a(t,e):[a(t,e),"[]=",a(n,e)].join("")};default:return function(t,n){return null===n?a(t,e):[a(t,e),"=",a(n,e)].join("")}}}function o(e){var t;switch(e.arrayFormat){case"index":return function(e,n,r){if(t=/\[(\d*)\]$/.exec(e),e=e.replace(/\[\d*\]$/,""),!t)return void(r[e]=n);void 0===r[e]&&(r[e]={}),r[e][t[1]]=n};case"bracket":return function(e,n,r){return t=/(\[\])$/.exec(e),e=e.replace(/\[\]$/,""),t?void 0===r[e]?void(r[e]=[n]):void(r[e]=[].concat(r[e],n)):void(r[e]=n)};default:return function(e,t,n){if(void 0===n[e])return void(n[e]=t);n[e]=[].concat(n[e],t)}}}function a(e,t){return t.encode?t.strict?i(e):encodeURIComponent(e):e}function l(e){return Array.isArray(e)?e.sort():"object"==typeof e?l(Object.keys(e)).sort(function(e,t){return Number(e)-Number(t)}).map(function(t){return e[t]}):e}var i=n(79),s=n(14);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e,t){t=s({arrayFormat:"none"},t);var n=o(t),r=Object.create(null);return"string"!=typeof e?r:(e=e.trim().replace(/^(\?|#|&)/,""))?(e.split("&").forEach(function(e){var t=e.replace(/\+/g," ").split("="),o=t.shift(),a=t.length>0?t.join("="):void 0;a=void 0===a?null:decodeURIComponent(a),n(decodeURIComponent(o),a,r)}),Object.keys(r).sort().reduce(function(e,t){var n=r[t];return Boolean(n)&&"object"==typeof n&&!Array.isArray(n)?e[t]=l(n):e[t]=n,e},Object.create(null))):r},t.stringify=function(e,t){t=s({encode:!0,strict:!0,arrayFormat:"none"},t);var n=r(t);return e?Object.keys(e).sort().map(function(r){var o=e[r];if(void 0===o)return"";if(null===o)return a(r,t);if(Array.isArray(o)){var l=[];return o.slice().forEach(function(e){void 0!==e&&l.push(n(r,e,l.length))}),l.join("&")}return a(r,t)+"="+a(o,t)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t,n){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},a=n(5),l=(r(a),n(1)),i=r(l),s=n(12),c=n(7),u=n(25),f=r(u),d=n(17),p=function(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})},b=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};Array.isArray(e)?e={entries:e}:"string"==typeof e&&(e={entries:[e]});var t=function(){var e=h[m],t=(0,c.createPath)(e),n=void 0,r=void 0;e.key&&(n=e.key,r=y(n));var a=(0,c.parsePath)(t);return(0,s.createLocation)(o({},a,{state:r}),void 0,n)},n=function(e){var t=m+e;return t>=0&&t<h.length},r=function(e){if(e&&n(e)){m+=e;var r=t();u.transitionTo(o({},r,{action:d.POP}))}},a=function(e){m+=1,m<h.length&&h.splice(m),h.push(e),v(e.key,e.state)},l=function(e){h[m]=e,v(e.key,e.state)},u=(0,f.default)(o({},e,{getCurrentLocation:t,pushLocation:a,replaceLocation:l,go:r})),b=e,h=b.entries,m=b.current;"string"==typeof h?h=[h]:Array.isArray(h)||(h=["/"]),h=h.map(function(e){return(0,s.createLocation)(e)}),null==m?m=h.length-1:m>=0&&m<h.length||(0,i.default)(!1);var g=p(h),v=function(e,t){return g[e]=t},y=function(e){return g[e]};return o({},u,{canGo:n})};t.default=b},function(e,t,n){"use strict";t.__esModule=!0;t.loopAsync=function(e,t,n){var r=0,o=!1,a=!1,l=!1,i=void 0,s=function(){for(var e=arguments.length,t=Array(e),r=0;r<e;r++)t[r]=arguments[r];if(o=!0,a)return void(i=t);n.apply(void 0,t)};!function c(){if(!o&&(l=!0,!a)){for(a=!0;!o&&r<e&&l;)l=!1,t(r++,c,s);if(a=!1,o)return void n.apply(void 0,i);r>=e&&l&&(o=!0,n())}}()}},function(e,t,n){"use strict";var r=n(0),o=n.n(r),a=n(21),l=(n(10),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e});t.a=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var i=t.map(function(e){return e.renderRouterContext}).filter(Boolean),s=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),c=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:r.createElement;return function(t,n){return s.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return i.reduceRight(function(t,n){return n(t,e)},o.a.createElement(a.a,l({},e,{createElement:c(e.createElement)})))}}},function(e,t,n){"use strict";var r=n(84),o=n.n(r),a=n(40);t.a=Object(a.a)(o.a)},function(e,t,n){"use strict";function r(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},l=n(1),i=o(l),s=n(26),c=n(27),u=r(c),f=n(85),d=r(f),p=n(18),b=n(25),h=o(b),m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};s.canUseDOM||(0,i.default)(!1);var t=e.forceRefresh||!(0,p.supportsHistory)(),n=t?d:u,r=n.getUserConfirmation,o=n.getCurrentLocation,l=n.pushLocation,c=n.replaceLocation,f=n.go,b=(0,h.default)(a({getUserConfirmation:r},e,{getCurrentLocation:o,pushLocation:l,replaceLocation:c,go:f})),m=0,g=void 0,v=function(e,t){1==++m&&(g=u.startListener(b.transitionTo));var n=t?b.listenBefore(e):b.listen(e);return function(){n(),0==--m&&g()}};return a({},b,{listenBefore:function(e){return v(e,!0)},listen:function(e){return v(e,!1)}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var r=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return r.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return r.go}});var o=n(12),a=n(7);t.getCurrentLocation=function(){return(0,o.createLocation)(window.location)},t.pushLocation=function(e){return window.location.href=(0,a.createPath)(e),!1},t.replaceLocation=function(e){return window.location.replace((0,a.createPath)(e)),!1}},function(e,t,n){"use strict";var r=n(87),o=n.n(r),a=n(40);t.a=Object(a.a)(o.a)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},a=n(5),l=(r(a),n(1)),i=r(l),s=n(26),c=n(18),u=n(88),f=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(u),d=n(25),p=r(d),b=function(e){return"/"===e.charAt(0)?e:"/"+e},h={hashbang:{encodePath:function(e){return"!"===e.charAt(0)?e:"!"+e},decodePath:function(e){return"!"===e.charAt(0)?e.substring(1):e}},noslash:{encodePath:function(e){return"/"===e.charAt(0)?e.substring(1):e},decodePath:b},slash:{encodePath:b,decodePath:b}},m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};s.canUseDOM||(0,i.default)(!1);var t=e.queryKey,n=e.hashType;"string"!=typeof t&&(t="_k"),null==n&&(n="slash"),n in h||(n="slash");var r=h[n],a=f.getUserConfirmation,l=function(){return f.getCurrentLocation(r,t)},u=function(e){return f.pushLocation(e,r,t)},d=function(e){return f.replaceLocation(e,r,t)},b=(0,p.default)(o({getUserConfirmation:a},e,{getCurrentLocation:l,pushLocation:u,replaceLocation:d,go:f.go})),m=0,g=void 0,v=function(e,n){1==++m&&(g=f.startListener(b.transitionTo,r,t));var o=n?b.listenBefore(e):b.listen(e);return function(){o(),0==--m&&g()}},y=function(e){return v(e,!0)},_=function(e){return v(e,!1)};(0,c.supportsGoWithoutReloadUsingHash)();return o({},b,{listenBefore:y,listen:_,go:function(e){b.go(e)},createHref:function(e){return"#"+r.encodePath(b.createHref(e))}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.startListener=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var r=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return r.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return r.go}});var o=n(5),a=(function(e){e&&e.__esModule}(o),n(12)),l=n(18),i=n(39),s=n(7),c=function(){var e=window.location.href,t=e.indexOf("#");return-1===t?"":e.substring(t+1)},u=function(e){return window.location.hash=e},f=function(e){var t=window.location.href.indexOf("#");window.location.replace(window.location.href.slice(0,t>=0?t:0)+"#"+e)},d=t.getCurrentLocation=function(e,t){var n=e.decodePath(c()),r=(0,s.getQueryStringValueFromPath)(n,t),o=void 0;r&&(n=(0,s.stripQueryStringValueFromPath)(n,t),o=(0,i.readState)(r));var l=(0,s.parsePath)(n);return l.state=o,(0,a.createLocation)(l,void 0,r)},p=void 0,b=(t.startListener=function(e,t,n){var r=function(){var r=c(),o=t.encodePath(r);if(r!==o)f(o);else{var a=d(t,n);if(p&&a.key&&p.key===a.key)return;p=a,e(a)}},o=c(),a=t.encodePath(o);return o!==a&&f(a),(0,l.addEventListener)(window,"hashchange",r),function(){return(0,l.removeEventListener)(window,"hashchange",r)}},function(e,t,n,r){var o=e.state,a=e.key,l=t.encodePath((0,s.createPath)(e));void 0!==o&&(l=(0,s.addQueryStringValueToPath)(l,n,a),(0,i.saveState)(a,o)),p=e,r(l)});t.pushLocation=function(e,t,n){return b(e,t,n,function(e){c()!==e&&u(e)})},t.replaceLocation=function(e,t,n){return b(e,t,n,function(e){c()!==e&&f(e)})}}]);
\ No newline at end of file
+!function(e){function t(r){if(n[r])return n[r].exports;var o=n[r]={i:r,l:!1,exports:{}};return e[r].call(o.exports,o,o.exports,t),o.l=!0,o.exports}var n={};t.m=e,t.c=n,t.d=function(e,n,r){t.o(e,n)||Object.defineProperty(e,n,{configurable:!1,enumerable:!0,get:r})},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=41)}([function(e,t,n){"use strict";e.exports=n(42)},function(e,t,n){"use strict";var r=function(e,t,n,r,o,a,l,i){if(!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var c=[n,r,o,a,l,i],u=0;s=new Error(t.replace(/%s/g,function(){return c[u++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}};e.exports=r},function(e,t,n){e.exports=n(43)()},function(e,t,n){e.exports=n(63)()},function(e,t,n){"use strict";var r=n(0),o=n(62);if(void 0===r)throw Error("create-react-class could not find the React object. If you are using script tags, make sure that React is being loaded before create-react-class.");var a=(new r.Component).updater;e.exports=o(r.Component,r.isValidElement,a)},function(e,t,n){"use strict";var r=function(){};e.exports=r},function(e,t,n){"use strict";function r(e){return null==e||u.a.isValidElement(e)}function o(e){return r(e)||Array.isArray(e)&&e.every(r)}function a(e,t){return f({},e,t)}function l(e){var t=e.type,n=a(t.defaultProps,e.props);if(n.children){var r=i(n.children,n);r.length&&(n.childRoutes=r),delete n.children}return n}function i(e,t){var n=[];return u.a.Children.forEach(e,function(e){if(u.a.isValidElement(e))if(e.type.createRouteFromReactElement){var r=e.type.createRouteFromReactElement(e,t);r&&n.push(r)}else n.push(l(e))}),n}function s(e){return o(e)?e=i(e):e&&!Array.isArray(e)&&(e=[e]),e}t.c=o,t.a=l,t.b=s;var c=n(0),u=n.n(c),f=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";t.__esModule=!0,t.createPath=t.parsePath=t.getQueryStringValueFromPath=t.stripQueryStringValueFromPath=t.addQueryStringValueToPath=void 0;var r=n(5),o=(function(e){e&&e.__esModule}(r),t.addQueryStringValueToPath=function(e,t,n){var r=a(e),o=r.pathname,i=r.search,s=r.hash;return l({pathname:o,search:i+(-1===i.indexOf("?")?"?":"&")+t+"="+n,hash:s})},t.stripQueryStringValueFromPath=function(e,t){var n=a(e),r=n.pathname,o=n.search,i=n.hash;return l({pathname:r,search:o.replace(new RegExp("([?&])"+t+"=[a-zA-Z0-9]+(&?)"),function(e,t,n){return"?"===t?t:n}),hash:i})},t.getQueryStringValueFromPath=function(e,t){var n=a(e),r=n.search,o=r.match(new RegExp("[?&]"+t+"=([a-zA-Z0-9]+)"));return o&&o[1]},function(e){var t=e.match(/^(https?:)?\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}),a=t.parsePath=function(e){var t=o(e),n="",r="",a=t.indexOf("#");-1!==a&&(r=t.substring(a),t=t.substring(0,a));var l=t.indexOf("?");return-1!==l&&(n=t.substring(l),t=t.substring(0,l)),""===t&&(t="/"),{pathname:t,search:n,hash:r}},l=t.createPath=function(e){if(null==e||"string"==typeof e)return e;var t=e.basename,n=e.pathname,r=e.search,o=e.hash,a=(t||"")+n;return r&&"?"!==r&&(a+=r),o&&(a+=o),a}},function(e,t,n){var r=n(54);"string"==typeof r&&(r=[[e.i,r,""]]);var o={hmr:!0};o.transform=void 0;n(29)(r,o);r.locals&&(e.exports=r.locals)},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";var r=n(5);n.n(r)},function(e,t,n){"use strict";function r(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function o(e){for(var t="",n=[],o=[],a=void 0,l=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)|\\\(|\\\)/g;a=i.exec(e);)a.index!==l&&(o.push(e.slice(l,a.index)),t+=r(e.slice(l,a.index))),a[1]?(t+="([^/]+)",n.push(a[1])):"**"===a[0]?(t+="(.*)",n.push("splat")):"*"===a[0]?(t+="(.*?)",n.push("splat")):"("===a[0]?t+="(?:":")"===a[0]?t+=")?":"\\("===a[0]?t+="\\(":"\\)"===a[0]&&(t+="\\)"),o.push(a[0]),l=i.lastIndex;return l!==e.length&&(o.push(e.slice(l,e.length)),t+=r(e.slice(l,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:o}}function a(e){return f[e]||(f[e]=o(e)),f[e]}function l(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=a(e),r=n.regexpSource,o=n.paramNames,l=n.tokens;"/"!==e.charAt(e.length-1)&&(r+="/?"),"*"===l[l.length-1]&&(r+="$");var i=t.match(new RegExp("^"+r,"i"));if(null==i)return null;var s=i[0],c=t.substr(s.length);if(c){if("/"!==s.charAt(s.length-1))return null;c="/"+c}return{remainingPathname:c,paramNames:o,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function i(e){return a(e).paramNames}function s(e,t){t=t||{};for(var n=a(e),r=n.tokens,o=0,l="",i=0,s=[],c=void 0,f=void 0,d=void 0,p=0,b=r.length;p<b;++p)if("*"===(c=r[p])||"**"===c)d=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=d||o>0||u()(!1),null!=d&&(l+=encodeURI(d));else if("("===c)s[o]="",o+=1;else if(")"===c){var h=s.pop();o-=1,o?s[o-1]+=h:l+=h}else if("\\("===c)l+="(";else if("\\)"===c)l+=")";else if(":"===c.charAt(0))if(f=c.substring(1),d=t[f],null!=d||o>0||u()(!1),null==d){if(o){s[o-1]="";for(var m=r.indexOf(c),g=r.slice(m,r.length),v=-1,y=0;y<g.length;y++)if(")"==g[y]){v=y;break}v>0||u()(!1),p=m+v-1}}else o?s[o-1]+=encodeURIComponent(d):l+=encodeURIComponent(d);else o?s[o-1]+=c:l+=c;return o<=0||u()(!1),l.replace(/\/+/g,"/")}t.c=l,t.b=i,t.a=s;var c=n(1),u=n.n(c),f=Object.create(null)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.locationsAreEqual=t.statesAreEqual=t.createLocation=t.createQuery=void 0;var o="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},l=n(1),i=r(l),s=n(5),c=(r(s),n(7)),u=n(17),f=(t.createQuery=function(e){return a(Object.create(null),e)},t.createLocation=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"/",t=arguments.length>1&&void 0!==arguments[1]?arguments[1]:u.POP,n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:null,r="string"==typeof e?(0,c.parsePath)(e):e;return{pathname:r.pathname||"/",search:r.search||"",hash:r.hash||"",state:r.state,action:t,key:n}},function(e){return"[object Date]"===Object.prototype.toString.call(e)}),d=t.statesAreEqual=function e(t,n){if(t===n)return!0;var r=void 0===t?"undefined":o(t);if(r!==(void 0===n?"undefined":o(n)))return!1;if("function"===r&&(0,i.default)(!1),"object"===r){if(f(t)&&f(n)&&(0,i.default)(!1),!Array.isArray(t)){var a=Object.keys(t),l=Object.keys(n);return a.length===l.length&&a.every(function(r){return e(t[r],n[r])})}return Array.isArray(n)&&t.length===n.length&&t.every(function(t,r){return e(t,n[r])})}return!1};t.locationsAreEqual=function(e,t){return e.key===t.key&&e.pathname===t.pathname&&e.search===t.search&&e.hash===t.hash&&d(e.state,t.state)}},function(e,t,n){"use strict";function r(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.c=r,n.d(t,"a",function(){return a}),n.d(t,"b",function(){return l}),n.d(t,"d",function(){return s});var o=n(3),a=(n.n(o),Object(o.shape)({listen:o.func.isRequired,push:o.func.isRequired,replace:o.func.isRequired,go:o.func.isRequired,goBack:o.func.isRequired,goForward:o.func.isRequired}),Object(o.oneOfType)([o.func,o.string])),l=Object(o.oneOfType)([a,o.object]),i=Object(o.oneOfType)([o.object,o.element]),s=Object(o.oneOfType)([i,Object(o.arrayOf)(i)])},function(e,t,n){"use strict";function r(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}var o=Object.getOwnPropertySymbols,a=Object.prototype.hasOwnProperty,l=Object.prototype.propertyIsEnumerable;e.exports=function(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;if("0123456789"!==Object.getOwnPropertyNames(t).map(function(e){return t[e]}).join(""))return!1;var r={};return"abcdefghijklmnopqrst".split("").forEach(function(e){r[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},r)).join("")}catch(e){return!1}}()?Object.assign:function(e,t){for(var n,i,s=r(e),c=1;c<arguments.length;c++){n=Object(arguments[c]);for(var u in n)a.call(n,u)&&(s[u]=n[u]);if(o){i=o(n);for(var f=0;f<i.length;f++)l.call(n,i[f])&&(s[i[f]]=n[i[f]])}}return s}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var n=this.props.tsMs&&Math.floor(this.props.tsMs/1e3)===this.props.ts,e=n?new Date(this.props.tsMs):new Date(1e3*this.props.ts),t=n?23:19;return c.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,t).replace(/-/g,"/").replace("T"," "))}}]),t}(c.default.Component);d.propTypes={ts:f.default.number.isRequired,tsMs:f.default.number},t.default=d},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(55),p=r(d),b=/^([\da-zA-Z+/]{4})*(([\da-zA-Z+/]{2}==)|([\da-zA-Z+/]{3}=))?$/,h=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var e=this.props.args;if(null==e)return c.default.createElement("span",null,"null");if(e.hasOwnProperty("payload")&&b.test(e.payload))try{e.payload=JSON.parse(atob(e.payload)),1===Object.keys(e).length&&(e=e.payload)}catch(t){e.payload={parse_error:"not a valid JSON",value:e.payload}}return c.default.createElement(p.default,{src:e,name:!1,collapsed:1,collapseStringsAfterLength:32,displayDataTypes:!1})}}]),t}(c.default.Component);h.propTypes={args:f.default.object.isRequired},t.default=h},function(e,t,n){"use strict";t.__esModule=!0;t.PUSH="PUSH",t.REPLACE="REPLACE",t.POP="POP"},function(e,t,n){"use strict";t.__esModule=!0;t.addEventListener=function(e,t,n){return e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)},t.removeEventListener=function(e,t,n){return e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)},t.supportsHistory=function(){var e=window.navigator.userAgent;return(-1===e.indexOf("Android 2.")&&-1===e.indexOf("Android 4.0")||-1===e.indexOf("Mobile Safari")||-1!==e.indexOf("Chrome")||-1!==e.indexOf("Windows Phone"))&&(window.history&&"pushState"in window.history)},t.supportsGoWithoutReloadUsingHash=function(){return-1===window.navigator.userAgent.indexOf("Firefox")},t.supportsPopstateOnHashchange=function(){return-1===window.navigator.userAgent.indexOf("Trident")},t.isExtraneousPopstateEvent=function(e){return void 0===e.state&&-1===navigator.userAgent.indexOf("CriOS")}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(8),p=r(d),b=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(c.default.createElement("li",{key:t,className:p.default.active},c.default.createElement("span",null,t))):this.shouldShow(t)?e.push(c.default.createElement("li",{key:t},c.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(c.default.createElement("li",{key:t,className:p.default.disabled},c.default.createElement("span",null,"..")));return c.default.createElement("ul",{className:p.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(c.default.Component);b.propTypes={page:f.default.number.isRequired,perPage:f.default.number.isRequired,totalCount:f.default.number.isRequired,jumpTo:f.default.func.isRequired},t.default=b},function(e,t,n){"use strict";function r(e,t,n){function r(){if(l=!0,i)return void(c=[].concat(Array.prototype.slice.call(arguments)));n.apply(this,arguments)}function o(){if(!l&&(s=!0,!i)){for(i=!0;!l&&a<e&&s;)s=!1,t.call(this,a++,o,r);if(i=!1,l)return void n.apply(this,c);a>=e&&s&&(l=!0,n())}}var a=0,l=!1,i=!1,s=!1,c=void 0;o()}function o(e,t,n){function r(e,t,r){l||(t?(l=!0,n(t)):(a[e]=r,(l=++i===o)&&n(null,a)))}var o=e.length,a=[];if(0===o)return n(null,a);var l=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){r(n,e,t)})})}t.a=r,t.b=o},function(e,t,n){"use strict";var r=n(1),o=n.n(r),a=n(0),l=n.n(a),i=n(4),s=n.n(i),c=n(3),u=(n.n(c),n(70)),f=n(22),d=n(6),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},b="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},h=s()({displayName:"RouterContext",mixins:[Object(f.a)("router")],propTypes:{router:c.object.isRequired,location:c.object.isRequired,routes:c.array.isRequired,params:c.object.isRequired,components:c.array.isRequired,createElement:c.func.isRequired},getDefaultProps:function(){return{createElement:l.a.createElement}},childContextTypes:{router:c.object.isRequired},getChildContext:function(){return{router:this.props.router}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.location,r=t.routes,a=t.params,i=t.components,s=t.router,c=null;return i&&(c=i.reduceRight(function(t,o,l){if(null==o)return t;var i=r[l],c=Object(u.a)(i,a),f={location:n,params:a,route:i,router:s,routeParams:c,routes:r};if(Object(d.c)(t))f.children=t;else if(t)for(var h in t)Object.prototype.hasOwnProperty.call(t,h)&&(f[h]=t[h]);if("object"===(void 0===o?"undefined":b(o))){var m={};for(var g in o)Object.prototype.hasOwnProperty.call(o,g)&&(m[g]=e.createElement(o[g],p({key:g},f)));return m}return e.createElement(o,f)},c)),null===c||!1===c||l.a.isValidElement(c)||o()(!1),c}});t.a=h},function(e,t,n){"use strict";function r(e){return"@@contextSubscriber/"+e}function o(e){var t,n,o=r(e),a=o+"/listeners",l=o+"/eventIndex",i=o+"/subscribe";return n={childContextTypes:(t={},t[o]=s.isRequired,t),getChildContext:function(){var e;return e={},e[o]={eventIndex:this[l],subscribe:this[i]},e},componentWillMount:function(){this[a]=[],this[l]=0},componentWillReceiveProps:function(){this[l]++},componentDidUpdate:function(){var e=this;this[a].forEach(function(t){return t(e[l])})}},n[i]=function(e){var t=this;return this[a].push(e),function(){t[a]=t[a].filter(function(t){return t!==e})}},n}function a(e){var t,n,o=r(e),a=o+"/lastRenderedEventIndex",l=o+"/handleContextUpdate",i=o+"/unsubscribe";return n={contextTypes:(t={},t[o]=s,t),getInitialState:function(){var e;return this.context[o]?(e={},e[a]=this.context[o].eventIndex,e):{}},componentDidMount:function(){this.context[o]&&(this[i]=this.context[o].subscribe(this[l]))},componentWillReceiveProps:function(){var e;this.context[o]&&this.setState((e={},e[a]=this.context[o].eventIndex,e))},componentWillUnmount:function(){this[i]&&(this[i](),this[i]=null)}},n[l]=function(e){if(e!==this.state[a]){var t;this.setState((t={},t[a]=e,t))}},n}t.a=o,t.b=a;var l=n(3),i=n.n(l),s=i.a.shape({subscribe:i.a.func.isRequired,eventIndex:i.a.number.isRequired})},function(e,t,n){"use strict";n.d(t,"b",function(){return o}),n.d(t,"a",function(){return a});var r=n(3),o=(n.n(r),Object(r.shape)({push:r.func.isRequired,replace:r.func.isRequired,go:r.func.isRequired,goBack:r.func.isRequired,goForward:r.func.isRequired,setRouteLeaveHook:r.func.isRequired,isActive:r.func.isRequired})),a=Object(r.shape)({pathname:r.string.isRequired,search:r.string.isRequired,state:r.object,action:r.string.isRequired,key:r.string})},function(e,t,n){"use strict";t.__esModule=!0;var r=n(5),o=(function(e){e&&e.__esModule}(r),function(e,t,n){var r=e(t,n);e.length<2&&n(r)});t.default=o},function(e,t,n){"use strict";t.__esModule=!0;var r=n(81),o=n(7),a=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(a),i=n(17),s=n(12),c=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},t=e.getCurrentLocation,n=e.getUserConfirmation,a=e.pushLocation,c=e.replaceLocation,u=e.go,f=e.keyLength,d=void 0,p=void 0,b=[],h=[],m=[],g=function(){return p&&p.action===i.POP?m.indexOf(p.key):d?m.indexOf(d.key):-1},v=function(e){var t=g();d=e,d.action===i.PUSH?m=[].concat(m.slice(0,t+1),[d.key]):d.action===i.REPLACE&&(m[t]=d.key),h.forEach(function(e){return e(d)})},y=function(e){return b.push(e),function(){return b=b.filter(function(t){return t!==e})}},_=function(e){return h.push(e),function(){return h=h.filter(function(t){return t!==e})}},x=function(e,t){(0,r.loopAsync)(b.length,function(t,n,r){(0,l.default)(b[t],e,function(e){return null!=e?r(e):n()})},function(e){n&&"string"==typeof e?n(e,function(e){return t(!1!==e)}):t(!1!==e)})},w=function(e){d&&(0,s.locationsAreEqual)(d,e)||p&&(0,s.locationsAreEqual)(p,e)||(p=e,x(e,function(t){if(p===e)if(p=null,t){if(e.action===i.PUSH){var n=(0,o.createPath)(d),r=(0,o.createPath)(e);r===n&&(0,s.statesAreEqual)(d.state,e.state)&&(e.action=i.REPLACE)}e.action===i.POP?v(e):e.action===i.PUSH?!1!==a(e)&&v(e):e.action===i.REPLACE&&!1!==c(e)&&v(e)}else if(d&&e.action===i.POP){var l=m.indexOf(d.key),f=m.indexOf(e.key);-1!==l&&-1!==f&&u(l-f)}}))},k=function(e){return w(S(e,i.PUSH))},E=function(e){return w(S(e,i.REPLACE))},O=function(){return u(-1)},P=function(){return u(1)},C=function(){return Math.random().toString(36).substr(2,f||6)},j=function(e){return(0,o.createPath)(e)},S=function(e,t){var n=arguments.length>2&&void 0!==arguments[2]?arguments[2]:C();return(0,s.createLocation)(e,t,n)};return{getCurrentLocation:t,listenBefore:y,listen:_,transitionTo:w,push:k,replace:E,go:u,goBack:O,goForward:P,createKey:C,createPath:o.createPath,createHref:j,createLocation:S}};t.default=c},function(e,t,n){"use strict";t.__esModule=!0;t.canUseDOM=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";t.__esModule=!0,t.go=t.replaceLocation=t.pushLocation=t.startListener=t.getUserConfirmation=t.getCurrentLocation=void 0;var r=n(12),o=n(18),a=n(39),l=n(7),i=n(26),s=i.canUseDOM&&!(0,o.supportsPopstateOnHashchange)(),c=function(e){var t=e&&e.key;return(0,r.createLocation)({pathname:window.location.pathname,search:window.location.search,hash:window.location.hash,state:t?(0,a.readState)(t):void 0},void 0,t)},u=t.getCurrentLocation=function(){var e=void 0;try{e=window.history.state||{}}catch(t){e={}}return c(e)},f=(t.getUserConfirmation=function(e,t){return t(window.confirm(e))},t.startListener=function(e){var t=function(t){(0,o.isExtraneousPopstateEvent)(t)||e(c(t.state))};(0,o.addEventListener)(window,"popstate",t);var n=function(){return e(u())};return s&&(0,o.addEventListener)(window,"hashchange",n),function(){(0,o.removeEventListener)(window,"popstate",t),s&&(0,o.removeEventListener)(window,"hashchange",n)}},function(e,t){var n=e.state,r=e.key;void 0!==n&&(0,a.saveState)(r,n),t({key:r},(0,l.createPath)(e))});t.pushLocation=function(e){return f(e,function(e,t){return window.history.pushState(e,null,t)})},t.replaceLocation=function(e){return f(e,function(e,t){return window.history.replaceState(e,null,t)})},t.go=function(e){e&&window.history.go(e)}},function(e,t){function n(e,t){var n=e[1]||"",o=e[3];if(!o)return n;if(t&&"function"==typeof btoa){var a=r(o);return[n].concat(o.sources.map(function(e){return"/*# sourceURL="+o.sourceRoot+e+" */"})).concat([a]).join("\n")}return[n].join("\n")}function r(e){return"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(e))))+" */"}e.exports=function(e){var t=[];return t.toString=function(){return this.map(function(t){var r=n(t,e);return t[2]?"@media "+t[2]+"{"+r+"}":r}).join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var r={},o=0;o<this.length;o++){var a=this[o][0];"number"==typeof a&&(r[a]=!0)}for(o=0;o<e.length;o++){var l=e[o];"number"==typeof l[0]&&r[l[0]]||(n&&!l[2]?l[2]=n:n&&(l[2]="("+l[2]+") and ("+n+")"),t.push(l))}},t}},function(e,t,n){function r(e,t){for(var n=0;n<e.length;n++){var r=e[n],o=b[r.id];if(o){o.refs++;for(var a=0;a<o.parts.length;a++)o.parts[a](r.parts[a]);for(;a<r.parts.length;a++)o.parts.push(u(r.parts[a],t))}else{for(var l=[],a=0;a<r.parts.length;a++)l.push(u(r.parts[a],t));b[r.id]={id:r.id,refs:1,parts:l}}}}function o(e,t){for(var n=[],r={},o=0;o<e.length;o++){var a=e[o],l=t.base?a[0]+t.base:a[0],i=a[1],s=a[2],c=a[3],u={css:i,media:s,sourceMap:c};r[l]?r[l].parts.push(u):n.push(r[l]={id:l,parts:[u]})}return n}function a(e,t){var n=m(e.insertInto);if(!n)throw new Error("Couldn't find a style target. This probably means that the value for the 'insertInto' parameter is invalid.");var r=y[y.length-1];if("top"===e.insertAt)r?r.nextSibling?n.insertBefore(t,r.nextSibling):n.appendChild(t):n.insertBefore(t,n.firstChild),y.push(t);else if("bottom"===e.insertAt)n.appendChild(t);else{if("object"!=typeof e.insertAt||!e.insertAt.before)throw new Error("[Style Loader]\n\n Invalid value for parameter 'insertAt' ('options.insertAt') found.\n Must be 'top', 'bottom', or Object.\n (https://github.com/webpack-contrib/style-loader#insertat)\n");var o=m(e.insertInto+" "+e.insertAt.before);n.insertBefore(t,o)}}function l(e){if(null===e.parentNode)return!1;e.parentNode.removeChild(e);var t=y.indexOf(e);t>=0&&y.splice(t,1)}function i(e){var t=document.createElement("style");return e.attrs.type="text/css",c(t,e.attrs),a(e,t),t}function s(e){var t=document.createElement("link");return e.attrs.type="text/css",e.attrs.rel="stylesheet",c(t,e.attrs),a(e,t),t}function c(e,t){Object.keys(t).forEach(function(n){e.setAttribute(n,t[n])})}function u(e,t){var n,r,o,a;if(t.transform&&e.css){if(!(a=t.transform(e.css)))return function(){};e.css=a}if(t.singleton){var c=v++;n=g||(g=i(t)),r=f.bind(null,n,c,!1),o=f.bind(null,n,c,!0)}else e.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=s(t),r=p.bind(null,n,t),o=function(){l(n),n.href&&URL.revokeObjectURL(n.href)}):(n=i(t),r=d.bind(null,n),o=function(){l(n)});return r(e),function(t){if(t){if(t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap)return;r(e=t)}else o()}}function f(e,t,n,r){var o=n?"":r.css;if(e.styleSheet)e.styleSheet.cssText=x(t,o);else{var a=document.createTextNode(o),l=e.childNodes;l[t]&&e.removeChild(l[t]),l.length?e.insertBefore(a,l[t]):e.appendChild(a)}}function d(e,t){var n=t.css,r=t.media;if(r&&e.setAttribute("media",r),e.styleSheet)e.styleSheet.cssText=n;else{for(;e.firstChild;)e.removeChild(e.firstChild);e.appendChild(document.createTextNode(n))}}function p(e,t,n){var r=n.css,o=n.sourceMap,a=void 0===t.convertToAbsoluteUrls&&o;(t.convertToAbsoluteUrls||a)&&(r=_(r)),o&&(r+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(o))))+" */");var l=new Blob([r],{type:"text/css"}),i=e.href;e.href=URL.createObjectURL(l),i&&URL.revokeObjectURL(i)}var b={},h=function(e){var t;return function(){return void 0===t&&(t=e.apply(this,arguments)),t}}(function(){return window&&document&&document.all&&!window.atob}),m=function(e){var t={};return function(n){if(void 0===t[n]){var r=e.call(this,n);if(r instanceof window.HTMLIFrameElement)try{r=r.contentDocument.head}catch(e){r=null}t[n]=r}return t[n]}}(function(e){return document.querySelector(e)}),g=null,v=0,y=[],_=n(53);e.exports=function(e,t){if("undefined"!=typeof DEBUG&&DEBUG&&"object"!=typeof document)throw new Error("The style-loader cannot be used in a non-browser environment");t=t||{},t.attrs="object"==typeof t.attrs?t.attrs:{},t.singleton||"boolean"==typeof t.singleton||(t.singleton=h()),t.insertInto||(t.insertInto="head"),t.insertAt||(t.insertAt="bottom");var n=o(e,t);return r(n,t),function(e){for(var a=[],l=0;l<n.length;l++){var i=n[l],s=b[i.id];s.refs--,a.push(s)}if(e){r(o(e,t),t)}for(var l=0;l<a.length;l++){var s=a[l];if(0===s.refs){for(var c=0;c<s.parts.length;c++)s.parts[c]();delete b[s.id]}}}};var x=function(){var e=[];return function(t,n){return e[t]=n,e.filter(Boolean).join("\n")}}()},function(e,t,n){"use strict";function r(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function o(e,t){function n(t,n){return t=e.createLocation(t),Object(i.a)(t,n,y.location,y.routes,y.params)}function o(e,n){E&&E.location===e?f(E,n):Object(c.a)(t,e,function(t,r){t?n(t):r?f(u({},r,{location:e}),n):n()})}function f(e,t){function n(n,o){if(n||o)return r(n,o);Object(s.a)(e,function(n,r){n?t(n):t(null,null,y=u({},e,{components:r}))})}function r(e,n){e?t(e):t(null,n)}var o=Object(a.a)(y,e),l=o.leaveRoutes,i=o.changeRoutes,c=o.enterRoutes;k(l,y),l.filter(function(e){return-1===c.indexOf(e)}).forEach(m),w(i,y,e,function(t,o){if(t||o)return r(t,o);x(c,e,n)})}function d(e){var t=arguments.length>1&&void 0!==arguments[1]&&arguments[1];return e.__id__||t&&(e.__id__=O++)}function p(e){return e.map(function(e){return P[d(e)]}).filter(function(e){return e})}function b(e,n){Object(c.a)(t,e,function(t,r){if(null==r)return void n();E=u({},r,{location:e});for(var o=p(Object(a.a)(y,E).leaveRoutes),l=void 0,i=0,s=o.length;null==l&&i<s;++i)l=o[i](e);n(l)})}function h(){if(y.routes){for(var e=p(y.routes),t=void 0,n=0,r=e.length;"string"!=typeof t&&n<r;++n)t=e[n]();return t}}function m(e){var t=d(e);t&&(delete P[t],r(P)||(C&&(C(),C=null),j&&(j(),j=null)))}function g(t,n){var o=!r(P),a=d(t,!0);return P[a]=n,o&&(C=e.listenBefore(b),e.listenBeforeUnload&&(j=e.listenBeforeUnload(h))),function(){m(t)}}function v(t){function n(n){y.location===n?t(null,y):o(n,function(n,r,o){n?t(n):r?e.replace(r):o&&t(null,o)})}var r=e.listen(n);return y.location?t(null,y):n(e.getCurrentLocation()),r}var y={},_=Object(l.a)(),x=_.runEnterHooks,w=_.runChangeHooks,k=_.runLeaveHooks,E=void 0,O=1,P=Object.create(null),C=void 0,j=void 0;return{isActive:n,match:o,listenBeforeLeavingRoute:g,listen:v}}t.a=o;var a=(n(10),n(65)),l=n(66),i=n(67),s=n(68),c=n(69),u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e){return e&&"function"==typeof e.then}t.a=r},function(e,t,n){"use strict";function r(e,t,n){return o(a({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive}),n)}function o(e,t){var n=t.location,r=t.params,o=t.routes;return e.location=n,e.params=r,e.routes=o,e}t.b=r,t.a=o;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}function o(e){return 0===e.button}function a(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function l(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function i(e,t){return"function"==typeof e?e(t.location):e}var s=n(0),c=n.n(s),u=n(4),f=n.n(u),d=n(3),p=(n.n(d),n(1)),b=n.n(p),h=n(23),m=n(22),g=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},v=f()({displayName:"Link",mixins:[Object(m.b)("router")],contextTypes:{router:h.b},propTypes:{to:Object(d.oneOfType)([d.string,d.object,d.func]),activeStyle:d.object,activeClassName:d.string,onlyActiveOnIndex:d.bool.isRequired,onClick:d.func,target:d.string},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented){var t=this.context.router;t||b()(!1),!a(e)&&o(e)&&(this.props.target||(e.preventDefault(),t.push(i(this.props.to,t))))}},render:function(){var e=this.props,t=e.to,n=e.activeClassName,o=e.activeStyle,a=e.onlyActiveOnIndex,s=r(e,["to","activeClassName","activeStyle","onlyActiveOnIndex"]),u=this.context.router;if(u){if(!t)return c.a.createElement("a",s);var f=i(t,u);s.href=u.createHref(f),(n||null!=o&&!l(o))&&u.isActive(f,a)&&(n&&(s.className?s.className+=" "+n:s.className=n),o&&(s.style=g({},s.style,o)))}return c.a.createElement("a",g({},s,{onClick:this.handleClick}))}});t.a=v},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(1)),i=n.n(l),s=n(6),c=n(11),u=n(13),f=o()({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=Object(s.a)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var r=e.location,o=e.params,a=void 0;if("/"===t.to.charAt(0))a=Object(c.a)(t.to,o);else if(t.to){var l=e.routes.indexOf(t),i=f.getRoutePattern(e.routes,l-1),s=i.replace(/\/*$/,"/")+t.to;a=Object(c.a)(s,o)}else a=r.pathname;n({pathname:a,query:t.query||r.query,state:t.state||r.state})},t},getRoutePattern:function(e,t){for(var n="",r=t;r>=0;r--){var o=e[r],a=o.path||"";if(n=a.replace(/\/*$/,"/")+n,0===a.indexOf("/"))break}return"/"+n}},propTypes:{path:a.string,from:a.string,to:a.string.isRequired,query:a.object,state:a.object,onEnter:u.c,children:u.c},render:function(){i()(!1)}});t.a=f},function(e,t,n){"use strict";function r(e){var t=c()(e),n=function(){return t};return a()(i()(n))(e)}t.a=r;var o=n(36),a=n.n(o),l=n(37),i=n.n(l),s=n(80),c=n.n(s)},function(e,t,n){"use strict";t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},o=n(78),a=n(24),l=function(e){return e&&e.__esModule?e:{default:e}}(a),i=n(12),s=n(7),c=function(e){return(0,o.stringify)(e).replace(/%20/g,"+")},u=o.parse,f=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),o=t.stringifyQuery,a=t.parseQueryString;"function"!=typeof o&&(o=c),"function"!=typeof a&&(a=u);var f=function(e){return e?(null==e.query&&(e.query=a(e.search.substring(1))),e):e},d=function(e,t){if(null==t)return e;var n="string"==typeof e?(0,s.parsePath)(e):e,a=o(t);return r({},n,{search:a?"?"+a:""})};return r({},n,{getCurrentLocation:function(){return f(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,l.default)(e,f(t),n)})},listen:function(e){return n.listen(function(t){return e(f(t))})},push:function(e){return n.push(d(e,e.query))},replace:function(e){return n.replace(d(e,e.query))},createPath:function(e){return n.createPath(d(e,e.query))},createHref:function(e){return n.createHref(d(e,e.query))},createLocation:function(e){for(var t=arguments.length,r=Array(t>1?t-1:0),o=1;o<t;o++)r[o-1]=arguments[o];var a=n.createLocation.apply(n,[d(e,e.query)].concat(r));return e.query&&(a.query=(0,i.createQuery)(e.query)),f(a)}})}};t.default=f},function(e,t,n){"use strict";t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},o=n(24),a=function(e){return e&&e.__esModule?e:{default:e}}(o),l=n(7),i=function(e){return function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{},n=e(t),o=t.basename,i=function(e){return e?(o&&null==e.basename&&(0===e.pathname.toLowerCase().indexOf(o.toLowerCase())?(e.pathname=e.pathname.substring(o.length),e.basename=o,""===e.pathname&&(e.pathname="/")):e.basename=""),e):e},s=function(e){if(!o)return e;var t="string"==typeof e?(0,l.parsePath)(e):e,n=t.pathname,a="/"===o.slice(-1)?o:o+"/",i="/"===n.charAt(0)?n.slice(1):n;return r({},t,{pathname:a+i})};return r({},n,{getCurrentLocation:function(){return i(n.getCurrentLocation())},listenBefore:function(e){return n.listenBefore(function(t,n){return(0,a.default)(e,i(t),n)})},listen:function(e){return n.listen(function(t){return e(i(t))})},push:function(e){return n.push(s(e))},replace:function(e){return n.replace(s(e))},createPath:function(e){return n.createPath(s(e))},createHref:function(e){return n.createHref(s(e))},createLocation:function(e){for(var t=arguments.length,r=Array(t>1?t-1:0),o=1;o<t;o++)r[o-1]=arguments[o];return i(n.createLocation.apply(n,[s(e)].concat(r)))}})}};t.default=i},function(e,t,n){"use strict";function r(e){return function(t){return a()(i()(e))(t)}}t.a=r;var o=n(36),a=n.n(o),l=n(37),i=n.n(l)},function(e,t,n){"use strict";t.__esModule=!0,t.readState=t.saveState=void 0;var r=n(5),o=(function(e){e&&e.__esModule}(r),{QuotaExceededError:!0,QUOTA_EXCEEDED_ERR:!0}),a={SecurityError:!0},l=function(e){return"@@History/"+e};t.saveState=function(e,t){if(window.sessionStorage)try{null==t?window.sessionStorage.removeItem(l(e)):window.sessionStorage.setItem(l(e),JSON.stringify(t))}catch(e){if(a[e.name])return;if(o[e.name]&&0===window.sessionStorage.length)return;throw e}},t.readState=function(e){var t=void 0;try{t=window.sessionStorage.getItem(l(e))}catch(e){if(a[e.name])return}if(t)try{return JSON.parse(t)}catch(e){}}},function(e,t,n){"use strict";function r(e){var t=void 0;return a&&(t=Object(o.a)(e)()),t}t.a=r;var o=n(38),a=!("undefined"==typeof window||!window.document||!window.document.createElement)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(45),p=n(49),b=r(p),h=n(56),m=r(h),g=n(57),v=r(g),y=n(58),_=r(y),x=n(59),w=r(x),k=n(60),E=n(8),O=r(E),P=n(9),C=r(P),j=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){return c.default.createElement("div",{className:O.default.container,style:{marginTop:30,marginBottom:60}},c.default.createElement("header",null,c.default.createElement("h1",null,"gojek/work")),c.default.createElement("hr",null),c.default.createElement("div",{className:O.default.row},c.default.createElement("main",{className:O.default.colMd10},this.props.children),c.default.createElement("aside",{className:O.default.colMd2},c.default.createElement("nav",null,c.default.createElement("ul",{className:(0,C.default)(O.default.nav,O.default.navPills,O.default.navStacked)},c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/processes"},"Processes")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/queues"},"Queues")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/retry_jobs"},"Retry Jobs")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),c.default.createElement("li",null,c.default.createElement(k.Link,{to:"/dead_jobs"},"Dead Jobs")))))))}}]),t}(c.default.Component);j.propTypes={children:f.default.element.isRequired},(0,d.render)(c.default.createElement(k.Router,{history:k.hashHistory},c.default.createElement(k.Route,{path:"/",component:j},c.default.createElement(k.Route,{path:"/processes",component:function(){return c.default.createElement(b.default,{busyWorkerURL:"./busy_workers",workerPoolURL:"./worker_pools"})}}),c.default.createElement(k.Route,{path:"/queues",component:function(){return c.default.createElement(v.default,{url:"./queues"})}}),c.default.createElement(k.Route,{path:"/retry_jobs",component:function(){return c.default.createElement(_.default,{url:"./retry_jobs"})}}),c.default.createElement(k.Route,{path:"/scheduled_jobs",component:function(){return c.default.createElement(w.default,{url:"./scheduled_jobs"})}}),c.default.createElement(k.Route,{path:"/dead_jobs",component:function(){return c.default.createElement(m.default,{fetchURL:"./dead_jobs",retryURL:"./retry_dead_job",retryAllURL:"./retry_all_dead_jobs",deleteURL:"./delete_dead_job",deleteAllURL:"./delete_all_dead_jobs"})}}),c.default.createElement(k.IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";function r(e){for(var t="https://reactjs.org/docs/error-decoder.html?invariant="+e,n=1;n<arguments.length;n++)t+="&args[]="+encodeURIComponent(arguments[n]);return"Minified React error #"+e+"; visit "+t+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function o(e,t,n){this.props=e,this.context=t,this.refs=A,this.updater=n||N}function a(){}function l(e,t,n){this.props=e,this.context=t,this.refs=A,this.updater=n||N}function i(e,t,n){var r,o={},a=null,l=null;if(null!=t)for(r in void 0!==t.ref&&(l=t.ref),void 0!==t.key&&(a=""+t.key),t)H.call(t,r)&&!F.hasOwnProperty(r)&&(o[r]=t[r]);var i=arguments.length-2;if(1===i)o.children=n;else if(1<i){for(var s=Array(i),c=0;c<i;c++)s[c]=arguments[c+2];o.children=s}if(e&&e.defaultProps)for(r in i=e.defaultProps)void 0===o[r]&&(o[r]=i[r]);return{$$typeof:w,type:e,key:a,ref:l,props:o,_owner:I.current}}function s(e,t){return{$$typeof:w,type:e.type,key:t,ref:e.ref,props:e.props,_owner:e._owner}}function c(e){return"object"==typeof e&&null!==e&&e.$$typeof===w}function u(e){var t={"=":"=0",":":"=2"};return"$"+(""+e).replace(/[=:]/g,function(e){return t[e]})}function f(e,t,n,r){if(z.length){var o=z.pop();return o.result=e,o.keyPrefix=t,o.func=n,o.context=r,o.count=0,o}return{result:e,keyPrefix:t,func:n,context:r,count:0}}function d(e){e.result=null,e.keyPrefix=null,e.func=null,e.context=null,e.count=0,10>z.length&&z.push(e)}function p(e,t,n,o){var a=typeof e;"undefined"!==a&&"boolean"!==a||(e=null);var l=!1;if(null===e)l=!0;else switch(a){case"string":case"number":l=!0;break;case"object":switch(e.$$typeof){case w:case k:l=!0}}if(l)return n(o,e,""===t?"."+h(e,0):t),1;if(l=0,t=""===t?".":t+":",Array.isArray(e))for(var i=0;i<e.length;i++){a=e[i];var s=t+h(a,i);l+=p(a,s,n,o)}else if(null===e||"object"!=typeof e?s=null:(s=L&&e[L]||e["@@iterator"],s="function"==typeof s?s:null),"function"==typeof s)for(e=s.call(e),i=0;!(a=e.next()).done;)a=a.value,s=t+h(a,i++),l+=p(a,s,n,o);else if("object"===a)throw n=""+e,Error(r(31,"[object Object]"===n?"object with keys {"+Object.keys(e).join(", ")+"}":n,""));return l}function b(e,t,n){return null==e?0:p(e,"",t,n)}function h(e,t){return"object"==typeof e&&null!==e&&null!=e.key?u(e.key):t.toString(36)}function m(e,t){e.func.call(e.context,t,e.count++)}function g(e,t,n){var r=e.result,o=e.keyPrefix;e=e.func.call(e.context,t,e.count++),Array.isArray(e)?v(e,r,n,function(e){return e}):null!=e&&(c(e)&&(e=s(e,o+(!e.key||t&&t.key===e.key?"":(""+e.key).replace(U,"$&/")+"/")+n)),r.push(e))}function v(e,t,n,r,o){var a="";null!=n&&(a=(""+n).replace(U,"$&/")+"/"),t=f(t,a,r,o),b(e,g,t),d(t)}function y(){var e=q.current;if(null===e)throw Error(r(321));return e}var _=n(14),x="function"==typeof Symbol&&Symbol.for,w=x?Symbol.for("react.element"):60103,k=x?Symbol.for("react.portal"):60106,E=x?Symbol.for("react.fragment"):60107,O=x?Symbol.for("react.strict_mode"):60108,P=x?Symbol.for("react.profiler"):60114,C=x?Symbol.for("react.provider"):60109,j=x?Symbol.for("react.context"):60110,S=x?Symbol.for("react.forward_ref"):60112,M=x?Symbol.for("react.suspense"):60113,T=x?Symbol.for("react.memo"):60115,R=x?Symbol.for("react.lazy"):60116,L="function"==typeof Symbol&&Symbol.iterator,N={isMounted:function(){return!1},enqueueForceUpdate:function(){},enqueueReplaceState:function(){},enqueueSetState:function(){}},A={};o.prototype.isReactComponent={},o.prototype.setState=function(e,t){if("object"!=typeof e&&"function"!=typeof e&&null!=e)throw Error(r(85));this.updater.enqueueSetState(this,e,t,"setState")},o.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this,e,"forceUpdate")},a.prototype=o.prototype;var D=l.prototype=new a;D.constructor=l,_(D,o.prototype),D.isPureReactComponent=!0;var I={current:null},H=Object.prototype.hasOwnProperty,F={key:!0,ref:!0,__self:!0,__source:!0},U=/\/+/g,z=[],q={current:null},Y={ReactCurrentDispatcher:q,ReactCurrentBatchConfig:{suspense:null},ReactCurrentOwner:I,IsSomeRendererActing:{current:!1},assign:_};t.Children={map:function(e,t,n){if(null==e)return e;var r=[];return v(e,r,null,t,n),r},forEach:function(e,t,n){if(null==e)return e;t=f(null,null,t,n),b(e,m,t),d(t)},count:function(e){return b(e,function(){return null},null)},toArray:function(e){var t=[];return v(e,t,null,function(e){return e}),t},only:function(e){if(!c(e))throw Error(r(143));return e}},t.Component=o,t.Fragment=E,t.Profiler=P,t.PureComponent=l,t.StrictMode=O,t.Suspense=M,t.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=Y,t.cloneElement=function(e,t,n){if(null===e||void 0===e)throw Error(r(267,e));var o=_({},e.props),a=e.key,l=e.ref,i=e._owner;if(null!=t){if(void 0!==t.ref&&(l=t.ref,i=I.current),void 0!==t.key&&(a=""+t.key),e.type&&e.type.defaultProps)var s=e.type.defaultProps;for(c in t)H.call(t,c)&&!F.hasOwnProperty(c)&&(o[c]=void 0===t[c]&&void 0!==s?s[c]:t[c])}var c=arguments.length-2;if(1===c)o.children=n;else if(1<c){s=Array(c);for(var u=0;u<c;u++)s[u]=arguments[u+2];o.children=s}return{$$typeof:w,type:e.type,key:a,ref:l,props:o,_owner:i}},t.createContext=function(e,t){return void 0===t&&(t=null),e={$$typeof:j,_calculateChangedBits:t,_currentValue:e,_currentValue2:e,_threadCount:0,Provider:null,Consumer:null},e.Provider={$$typeof:C,_context:e},e.Consumer=e},t.createElement=i,t.createFactory=function(e){var t=i.bind(null,e);return t.type=e,t},t.createRef=function(){return{current:null}},t.forwardRef=function(e){return{$$typeof:S,render:e}},t.isValidElement=c,t.lazy=function(e){return{$$typeof:R,_ctor:e,_status:-1,_result:null}},t.memo=function(e,t){return{$$typeof:T,type:e,compare:void 0===t?null:t}},t.useCallback=function(e,t){return y().useCallback(e,t)},t.useContext=function(e,t){return y().useContext(e,t)},t.useDebugValue=function(){},t.useEffect=function(e,t){return y().useEffect(e,t)},t.useImperativeHandle=function(e,t,n){return y().useImperativeHandle(e,t,n)},t.useLayoutEffect=function(e,t){return y().useLayoutEffect(e,t)},t.useMemo=function(e,t){return y().useMemo(e,t)},t.useReducer=function(e,t,n){return y().useReducer(e,t,n)},t.useRef=function(e){return y().useRef(e)},t.useState=function(e){return y().useState(e)},t.version="16.14.0"},function(e,t,n){"use strict";function r(){}function o(){}var a=n(44);o.resetWarningCache=r,e.exports=function(){function e(e,t,n,r,o,l){if(l!==a){var i=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types");throw i.name="Invariant Violation",i}}function t(){return e}e.isRequired=e;var n={array:e,bigint:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,elementType:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t,checkPropTypes:o,resetWarningCache:r};return n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function r(){if("undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE)try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(r)}catch(e){console.error(e)}}r(),e.exports=n(46)},function(e,t,n){"use strict";function r(e){for(var t="https://reactjs.org/docs/error-decoder.html?invariant="+e,n=1;n<arguments.length;n++)t+="&args[]="+encodeURIComponent(arguments[n]);return"Minified React error #"+e+"; visit "+t+" for the full message or use the non-minified dev environment for full errors and additional helpful warnings."}function o(e,t,n,r,o,a,l,i,s){var c=Array.prototype.slice.call(arguments,3);try{t.apply(n,c)}catch(e){this.onError(e)}}function a(e,t,n,r,a,l,i,s,c){qo=!1,Yo=null,o.apply(Bo,arguments)}function l(e,t,n,o,l,i,s,c,u){if(a.apply(this,arguments),qo){if(!qo)throw Error(r(198));var f=Yo;qo=!1,Yo=null,Wo||(Wo=!0,Vo=f)}}function i(e,t,n){var r=e.type||"unknown-event";e.currentTarget=Go(n),l(r,t,void 0,e),e.currentTarget=null}function s(){if(Qo)for(var e in Zo){var t=Zo[e],n=Qo.indexOf(e);if(!(-1<n))throw Error(r(96,e));if(!Jo[n]){if(!t.extractEvents)throw Error(r(97,e));Jo[n]=t,n=t.eventTypes;for(var o in n){var a=void 0,l=n[o],i=t,s=o;if($o.hasOwnProperty(s))throw Error(r(99,s));$o[s]=l;var u=l.phasedRegistrationNames;if(u){for(a in u)u.hasOwnProperty(a)&&c(u[a],i,s);a=!0}else l.registrationName?(c(l.registrationName,i,s),a=!0):a=!1;if(!a)throw Error(r(98,o,e))}}}}function c(e,t,n){if(ea[e])throw Error(r(100,e));ea[e]=t,ta[e]=t.eventTypes[n].dependencies}function u(e){var t,n=!1;for(t in e)if(e.hasOwnProperty(t)){var o=e[t];if(!Zo.hasOwnProperty(t)||Zo[t]!==o){if(Zo[t])throw Error(r(102,t));Zo[t]=o,n=!0}}n&&s()}function f(e){if(e=Xo(e)){if("function"!=typeof ra)throw Error(r(280));var t=e.stateNode;t&&(t=Ko(t),ra(e.stateNode,e.type,t))}}function d(e){oa?aa?aa.push(e):aa=[e]:oa=e}function p(){if(oa){var e=oa,t=aa;if(aa=oa=null,f(e),t)for(e=0;e<t.length;e++)f(t[e])}}function b(e,t){return e(t)}function h(e,t,n,r,o){return e(t,n,r,o)}function m(){}function g(){null===oa&&null===aa||(m(),p())}function v(e,t,n){if(sa)return e(t,n);sa=!0;try{return la(e,t,n)}finally{sa=!1,g()}}function y(e){return!!ua.call(da,e)||!ua.call(fa,e)&&(ca.test(e)?da[e]=!0:(fa[e]=!0,!1))}function _(e,t,n,r){if(null!==n&&0===n.type)return!1;switch(typeof t){case"function":case"symbol":return!0;case"boolean":return!r&&(null!==n?!n.acceptsBooleans:"data-"!==(e=e.toLowerCase().slice(0,5))&&"aria-"!==e);default:return!1}}function x(e,t,n,r){if(null===t||void 0===t||_(e,t,n,r))return!0;if(r)return!1;if(null!==n)switch(n.type){case 3:return!t;case 4:return!1===t;case 5:return isNaN(t);case 6:return isNaN(t)||1>t}return!1}function w(e,t,n,r,o,a){this.acceptsBooleans=2===t||3===t||4===t,this.attributeName=r,this.attributeNamespace=o,this.mustUseProperty=n,this.propertyName=e,this.type=t,this.sanitizeURL=a}function k(e){return e[1].toUpperCase()}function E(e,t,n,r){var o=pa.hasOwnProperty(t)?pa[t]:null;(null!==o?0===o.type:!r&&(2<t.length&&("o"===t[0]||"O"===t[0])&&("n"===t[1]||"N"===t[1])))||(x(t,n,o,r)&&(n=null),r||null===o?y(t)&&(null===n?e.removeAttribute(t):e.setAttribute(t,""+n)):o.mustUseProperty?e[o.propertyName]=null===n?3!==o.type&&"":n:(t=o.attributeName,r=o.attributeNamespace,null===n?e.removeAttribute(t):(o=o.type,n=3===o||4===o&&!0===n?"":""+n,r?e.setAttributeNS(r,t,n):e.setAttribute(t,n))))}function O(e){return null===e||"object"!=typeof e?null:(e=La&&e[La]||e["@@iterator"],"function"==typeof e?e:null)}function P(e){if(-1===e._status){e._status=0;var t=e._ctor;t=t(),e._result=t,t.then(function(t){0===e._status&&(t=t.default,e._status=1,e._result=t)},function(t){0===e._status&&(e._status=2,e._result=t)})}}function C(e){if(null==e)return null;if("function"==typeof e)return e.displayName||e.name||null;if("string"==typeof e)return e;switch(e){case xa:return"Fragment";case _a:return"Portal";case ka:return"Profiler";case wa:return"StrictMode";case ja:return"Suspense";case Sa:return"SuspenseList"}if("object"==typeof e)switch(e.$$typeof){case Oa:return"Context.Consumer";case Ea:return"Context.Provider";case Ca:var t=e.render;return t=t.displayName||t.name||"",e.displayName||(""!==t?"ForwardRef("+t+")":"ForwardRef");case Ma:return C(e.type);case Ra:return C(e.render);case Ta:if(e=1===e._status?e._result:null)return C(e)}return null}function j(e){var t="";do{e:switch(e.tag){case 3:case 4:case 6:case 7:case 10:case 9:var n="";break e;default:var r=e._debugOwner,o=e._debugSource,a=C(e.type);n=null,r&&(n=C(r.type)),r=a,a="",o?a=" (at "+o.fileName.replace(ga,"")+":"+o.lineNumber+")":n&&(a=" (created by "+n+")"),n="\n    in "+(r||"Unknown")+a}t+=n,e=e.return}while(e);return t}function S(e){switch(typeof e){case"boolean":case"number":case"object":case"string":case"undefined":return e;default:return""}}function M(e){var t=e.type;return(e=e.nodeName)&&"input"===e.toLowerCase()&&("checkbox"===t||"radio"===t)}function T(e){var t=M(e)?"checked":"value",n=Object.getOwnPropertyDescriptor(e.constructor.prototype,t),r=""+e[t];if(!e.hasOwnProperty(t)&&void 0!==n&&"function"==typeof n.get&&"function"==typeof n.set){var o=n.get,a=n.set;return Object.defineProperty(e,t,{configurable:!0,get:function(){return o.call(this)},set:function(e){r=""+e,a.call(this,e)}}),Object.defineProperty(e,t,{enumerable:n.enumerable}),{getValue:function(){return r},setValue:function(e){r=""+e},stopTracking:function(){e._valueTracker=null,delete e[t]}}}}function R(e){e._valueTracker||(e._valueTracker=T(e))}function L(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var n=t.getValue(),r="";return e&&(r=M(e)?e.checked?"true":"false":e.value),(e=r)!==n&&(t.setValue(e),!0)}function N(e,t){var n=t.checked;return Uo({},t,{defaultChecked:void 0,defaultValue:void 0,value:void 0,checked:null!=n?n:e._wrapperState.initialChecked})}function A(e,t){var n=null==t.defaultValue?"":t.defaultValue,r=null!=t.checked?t.checked:t.defaultChecked;n=S(null!=t.value?t.value:n),e._wrapperState={initialChecked:r,initialValue:n,controlled:"checkbox"===t.type||"radio"===t.type?null!=t.checked:null!=t.value}}function D(e,t){null!=(t=t.checked)&&E(e,"checked",t,!1)}function I(e,t){D(e,t);var n=S(t.value),r=t.type;if(null!=n)"number"===r?(0===n&&""===e.value||e.value!=n)&&(e.value=""+n):e.value!==""+n&&(e.value=""+n);else if("submit"===r||"reset"===r)return void e.removeAttribute("value");t.hasOwnProperty("value")?F(e,t.type,n):t.hasOwnProperty("defaultValue")&&F(e,t.type,S(t.defaultValue)),null==t.checked&&null!=t.defaultChecked&&(e.defaultChecked=!!t.defaultChecked)}function H(e,t,n){if(t.hasOwnProperty("value")||t.hasOwnProperty("defaultValue")){var r=t.type;if(!("submit"!==r&&"reset"!==r||void 0!==t.value&&null!==t.value))return;t=""+e._wrapperState.initialValue,n||t===e.value||(e.value=t),e.defaultValue=t}n=e.name,""!==n&&(e.name=""),e.defaultChecked=!!e._wrapperState.initialChecked,""!==n&&(e.name=n)}function F(e,t,n){"number"===t&&e.ownerDocument.activeElement===e||(null==n?e.defaultValue=""+e._wrapperState.initialValue:e.defaultValue!==""+n&&(e.defaultValue=""+n))}function U(e){var t="";return Fo.Children.forEach(e,function(e){null!=e&&(t+=e)}),t}function z(e,t){return e=Uo({children:void 0},t),(t=U(t.children))&&(e.children=t),e}function q(e,t,n,r){if(e=e.options,t){t={};for(var o=0;o<n.length;o++)t["$"+n[o]]=!0;for(n=0;n<e.length;n++)o=t.hasOwnProperty("$"+e[n].value),e[n].selected!==o&&(e[n].selected=o),o&&r&&(e[n].defaultSelected=!0)}else{for(n=""+S(n),t=null,o=0;o<e.length;o++){if(e[o].value===n)return e[o].selected=!0,void(r&&(e[o].defaultSelected=!0));null!==t||e[o].disabled||(t=e[o])}null!==t&&(t.selected=!0)}}function Y(e,t){if(null!=t.dangerouslySetInnerHTML)throw Error(r(91));return Uo({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue})}function W(e,t){var n=t.value;if(null==n){if(n=t.children,t=t.defaultValue,null!=n){if(null!=t)throw Error(r(92));if(Array.isArray(n)){if(!(1>=n.length))throw Error(r(93));n=n[0]}t=n}null==t&&(t=""),n=t}e._wrapperState={initialValue:S(n)}}function V(e,t){var n=S(t.value),r=S(t.defaultValue);null!=n&&(n=""+n,n!==e.value&&(e.value=n),null==t.defaultValue&&e.defaultValue!==n&&(e.defaultValue=n)),null!=r&&(e.defaultValue=""+r)}function B(e){var t=e.textContent;t===e._wrapperState.initialValue&&""!==t&&null!==t&&(e.value=t)}function K(e){switch(e){case"svg":return"http://www.w3.org/2000/svg";case"math":return"http://www.w3.org/1998/Math/MathML";default:return"http://www.w3.org/1999/xhtml"}}function X(e,t){return null==e||"http://www.w3.org/1999/xhtml"===e?K(t):"http://www.w3.org/2000/svg"===e&&"foreignObject"===t?"http://www.w3.org/1999/xhtml":e}function G(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&3===n.nodeType)return void(n.nodeValue=t)}e.textContent=t}function Q(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n}function Z(e){if(Ia[e])return Ia[e];if(!Da[e])return e;var t,n=Da[e];for(t in n)if(n.hasOwnProperty(t)&&t in Ha)return Ia[e]=n[t];return e}function J(e){var t=Ka.get(e);return void 0===t&&(t=new Map,Ka.set(e,t)),t}function $(e){var t=e,n=e;if(e.alternate)for(;t.return;)t=t.return;else{e=t;do{t=e,0!=(1026&t.effectTag)&&(n=t.return),e=t.return}while(e)}return 3===t.tag?n:null}function ee(e){if(13===e.tag){var t=e.memoizedState;if(null===t&&null!==(e=e.alternate)&&(t=e.memoizedState),null!==t)return t.dehydrated}return null}function te(e){if($(e)!==e)throw Error(r(188))}function ne(e){var t=e.alternate;if(!t){if(null===(t=$(e)))throw Error(r(188));return t!==e?null:e}for(var n=e,o=t;;){var a=n.return;if(null===a)break;var l=a.alternate;if(null===l){if(null!==(o=a.return)){n=o;continue}break}if(a.child===l.child){for(l=a.child;l;){if(l===n)return te(a),e;if(l===o)return te(a),t;l=l.sibling}throw Error(r(188))}if(n.return!==o.return)n=a,o=l;else{for(var i=!1,s=a.child;s;){if(s===n){i=!0,n=a,o=l;break}if(s===o){i=!0,o=a,n=l;break}s=s.sibling}if(!i){for(s=l.child;s;){if(s===n){i=!0,n=l,o=a;break}if(s===o){i=!0,o=l,n=a;break}s=s.sibling}if(!i)throw Error(r(189))}}if(n.alternate!==o)throw Error(r(190))}if(3!==n.tag)throw Error(r(188));return n.stateNode.current===n?e:t}function re(e){if(!(e=ne(e)))return null;for(var t=e;;){if(5===t.tag||6===t.tag)return t;if(t.child)t.child.return=t,t=t.child;else{if(t===e)break;for(;!t.sibling;){if(!t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}}return null}function oe(e,t){if(null==t)throw Error(r(30));return null==e?t:Array.isArray(e)?Array.isArray(t)?(e.push.apply(e,t),e):(e.push(t),e):Array.isArray(t)?[e].concat(t):[e,t]}function ae(e,t,n){Array.isArray(e)?e.forEach(t,n):e&&t.call(n,e)}function le(e){if(e){var t=e._dispatchListeners,n=e._dispatchInstances;if(Array.isArray(t))for(var r=0;r<t.length&&!e.isPropagationStopped();r++)i(e,t[r],n[r]);else t&&i(e,t,n);e._dispatchListeners=null,e._dispatchInstances=null,e.isPersistent()||e.constructor.release(e)}}function ie(e){if(null!==e&&(Xa=oe(Xa,e)),e=Xa,Xa=null,e){if(ae(e,le),Xa)throw Error(r(95));if(Wo)throw e=Vo,Wo=!1,Vo=null,e}}function se(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),3===e.nodeType?e.parentNode:e}function ce(e){if(!na)return!1;e="on"+e;var t=e in document;return t||(t=document.createElement("div"),t.setAttribute(e,"return;"),t="function"==typeof t[e]),t}function ue(e){e.topLevelType=null,e.nativeEvent=null,e.targetInst=null,e.ancestors.length=0,10>Ga.length&&Ga.push(e)}function fe(e,t,n,r){if(Ga.length){var o=Ga.pop();return o.topLevelType=e,o.eventSystemFlags=r,o.nativeEvent=t,o.targetInst=n,o}return{topLevelType:e,eventSystemFlags:r,nativeEvent:t,targetInst:n,ancestors:[]}}function de(e){var t=e.targetInst,n=t;do{if(!n){e.ancestors.push(n);break}var r=n;if(3===r.tag)r=r.stateNode.containerInfo;else{for(;r.return;)r=r.return;r=3!==r.tag?null:r.stateNode.containerInfo}if(!r)break;t=n.tag,5!==t&&6!==t||e.ancestors.push(n),n=Xe(r)}while(n);for(n=0;n<e.ancestors.length;n++){t=e.ancestors[n];var o=se(e.nativeEvent);r=e.topLevelType;var a=e.nativeEvent,l=e.eventSystemFlags;0===n&&(l|=64);for(var i=null,s=0;s<Jo.length;s++){var c=Jo[s];c&&(c=c.extractEvents(r,t,a,o,l))&&(i=oe(i,c))}ie(i)}}function pe(e,t,n){if(!n.has(e)){switch(e){case"scroll":Ce(t,"scroll",!0);break;case"focus":case"blur":Ce(t,"focus",!0),Ce(t,"blur",!0),n.set("blur",null),n.set("focus",null);break;case"cancel":case"close":ce(e)&&Ce(t,e,!0);break;case"invalid":case"submit":case"reset":break;default:-1===Ba.indexOf(e)&&Pe(e,t)}n.set(e,null)}}function be(e,t){var n=J(t);ol.forEach(function(e){pe(e,t,n)}),al.forEach(function(e){pe(e,t,n)})}function he(e,t,n,r,o){return{blockedOn:e,topLevelType:t,eventSystemFlags:32|n,nativeEvent:o,container:r}}function me(e,t){switch(e){case"focus":case"blur":Ja=null;break;case"dragenter":case"dragleave":$a=null;break;case"mouseover":case"mouseout":el=null;break;case"pointerover":case"pointerout":tl.delete(t.pointerId);break;case"gotpointercapture":case"lostpointercapture":nl.delete(t.pointerId)}}function ge(e,t,n,r,o,a){return null===e||e.nativeEvent!==a?(e=he(t,n,r,o,a),null!==t&&null!==(t=Ge(t))&&Ua(t),e):(e.eventSystemFlags|=r,e)}function ve(e,t,n,r,o){switch(t){case"focus":return Ja=ge(Ja,e,t,n,r,o),!0;case"dragenter":return $a=ge($a,e,t,n,r,o),!0;case"mouseover":return el=ge(el,e,t,n,r,o),!0;case"pointerover":var a=o.pointerId;return tl.set(a,ge(tl.get(a)||null,e,t,n,r,o)),!0;case"gotpointercapture":return a=o.pointerId,nl.set(a,ge(nl.get(a)||null,e,t,n,r,o)),!0}return!1}function ye(e){var t=Xe(e.target);if(null!==t){var n=$(t);if(null!==n)if(13===(t=n.tag)){if(null!==(t=ee(n)))return e.blockedOn=t,void zo.unstable_runWithPriority(e.priority,function(){za(n)})}else if(3===t&&n.stateNode.hydrate)return void(e.blockedOn=3===n.tag?n.stateNode.containerInfo:null)}e.blockedOn=null}function _e(e){if(null!==e.blockedOn)return!1;var t=Te(e.topLevelType,e.eventSystemFlags,e.container,e.nativeEvent);if(null!==t){var n=Ge(t);return null!==n&&Ua(n),e.blockedOn=t,!1}return!0}function xe(e,t,n){_e(e)&&n.delete(t)}function we(){for(Qa=!1;0<Za.length;){var e=Za[0];if(null!==e.blockedOn){e=Ge(e.blockedOn),null!==e&&Fa(e);break}var t=Te(e.topLevelType,e.eventSystemFlags,e.container,e.nativeEvent);null!==t?e.blockedOn=t:Za.shift()}null!==Ja&&_e(Ja)&&(Ja=null),null!==$a&&_e($a)&&($a=null),null!==el&&_e(el)&&(el=null),tl.forEach(xe),nl.forEach(xe)}function ke(e,t){e.blockedOn===t&&(e.blockedOn=null,Qa||(Qa=!0,zo.unstable_scheduleCallback(zo.unstable_NormalPriority,we)))}function Ee(e){function t(t){return ke(t,e)}if(0<Za.length){ke(Za[0],e);for(var n=1;n<Za.length;n++){var r=Za[n];r.blockedOn===e&&(r.blockedOn=null)}}for(null!==Ja&&ke(Ja,e),null!==$a&&ke($a,e),null!==el&&ke(el,e),tl.forEach(t),nl.forEach(t),n=0;n<rl.length;n++)r=rl[n],r.blockedOn===e&&(r.blockedOn=null);for(;0<rl.length&&(n=rl[0],null===n.blockedOn);)ye(n),null===n.blockedOn&&rl.shift()}function Oe(e,t){for(var n=0;n<e.length;n+=2){var r=e[n],o=e[n+1],a="on"+(o[0].toUpperCase()+o.slice(1));a={phasedRegistrationNames:{bubbled:a,captured:a+"Capture"},dependencies:[r],eventPriority:t},sl.set(r,t),il.set(r,a),ll[o]=a}}function Pe(e,t){Ce(t,e,!1)}function Ce(e,t,n){var r=sl.get(t);switch(void 0===r?2:r){case 0:r=je.bind(null,t,1,e);break;case 1:r=Se.bind(null,t,1,e);break;default:r=Me.bind(null,t,1,e)}n?e.addEventListener(t,r,!0):e.addEventListener(t,r,!1)}function je(e,t,n,r){ia||m();var o=Me,a=ia;ia=!0;try{h(o,e,t,n,r)}finally{(ia=a)||g()}}function Se(e,t,n,r){pl(dl,Me.bind(null,e,t,n,r))}function Me(e,t,n,r){if(bl)if(0<Za.length&&-1<ol.indexOf(e))e=he(null,e,t,n,r),Za.push(e);else{var o=Te(e,t,n,r);if(null===o)me(e,r);else if(-1<ol.indexOf(e))e=he(o,e,t,n,r),Za.push(e);else if(!ve(o,e,t,n,r)){me(e,r),e=fe(e,r,null,t);try{v(de,e)}finally{ue(e)}}}}function Te(e,t,n,r){if(n=se(r),null!==(n=Xe(n))){var o=$(n);if(null===o)n=null;else{var a=o.tag;if(13===a){if(null!==(n=ee(o)))return n;n=null}else if(3===a){if(o.stateNode.hydrate)return 3===o.tag?o.stateNode.containerInfo:null;n=null}else o!==n&&(n=null)}}e=fe(e,r,n,t);try{v(de,e)}finally{ue(e)}return null}function Re(e,t,n){return null==t||"boolean"==typeof t||""===t?"":n||"number"!=typeof t||0===t||hl.hasOwnProperty(e)&&hl[e]?(""+t).trim():t+"px"}function Le(e,t){e=e.style;for(var n in t)if(t.hasOwnProperty(n)){var r=0===n.indexOf("--"),o=Re(n,t[n],r);"float"===n&&(n="cssFloat"),r?e.setProperty(n,o):e[n]=o}}function Ne(e,t){if(t){if(gl[e]&&(null!=t.children||null!=t.dangerouslySetInnerHTML))throw Error(r(137,e,""));if(null!=t.dangerouslySetInnerHTML){if(null!=t.children)throw Error(r(60));if(!("object"==typeof t.dangerouslySetInnerHTML&&"__html"in t.dangerouslySetInnerHTML))throw Error(r(61))}if(null!=t.style&&"object"!=typeof t.style)throw Error(r(62,""))}}function Ae(e,t){if(-1===e.indexOf("-"))return"string"==typeof t.is;switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}function De(e,t){e=9===e.nodeType||11===e.nodeType?e:e.ownerDocument;var n=J(e);t=ta[t];for(var r=0;r<t.length;r++)pe(t[r],e,n)}function Ie(){}function He(e){if(void 0===(e=e||("undefined"!=typeof document?document:void 0)))return null;try{return e.activeElement||e.body}catch(t){return e.body}}function Fe(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function Ue(e,t){var n=Fe(e);e=0;for(var r;n;){if(3===n.nodeType){if(r=e+n.textContent.length,e<=t&&r>=t)return{node:n,offset:t-e};e=r}e:{for(;n;){if(n.nextSibling){n=n.nextSibling;break e}n=n.parentNode}n=void 0}n=Fe(n)}}function ze(e,t){return!(!e||!t)&&(e===t||(!e||3!==e.nodeType)&&(t&&3===t.nodeType?ze(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}function qe(){for(var e=window,t=He();t instanceof e.HTMLIFrameElement;){try{var n="string"==typeof t.contentWindow.location.href}catch(e){n=!1}if(!n)break;e=t.contentWindow,t=He(e.document)}return t}function Ye(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&("text"===e.type||"search"===e.type||"tel"===e.type||"url"===e.type||"password"===e.type)||"textarea"===t||"true"===e.contentEditable)}function We(e,t){switch(e){case"button":case"input":case"select":case"textarea":return!!t.autoFocus}return!1}function Ve(e,t){return"textarea"===e||"option"===e||"noscript"===e||"string"==typeof t.children||"number"==typeof t.children||"object"==typeof t.dangerouslySetInnerHTML&&null!==t.dangerouslySetInnerHTML&&null!=t.dangerouslySetInnerHTML.__html}function Be(e){for(;null!=e;e=e.nextSibling){var t=e.nodeType;if(1===t||3===t)break}return e}function Ke(e){e=e.previousSibling;for(var t=0;e;){if(8===e.nodeType){var n=e.data;if(n===yl||n===wl||n===xl){if(0===t)return e;t--}else n===_l&&t++}e=e.previousSibling}return null}function Xe(e){var t=e[jl];if(t)return t;for(var n=e.parentNode;n;){if(t=n[Ml]||n[jl]){if(n=t.alternate,null!==t.child||null!==n&&null!==n.child)for(e=Ke(e);null!==e;){if(n=e[jl])return n;e=Ke(e)}return t}e=n,n=e.parentNode}return null}function Ge(e){return e=e[jl]||e[Ml],!e||5!==e.tag&&6!==e.tag&&13!==e.tag&&3!==e.tag?null:e}function Qe(e){if(5===e.tag||6===e.tag)return e.stateNode;throw Error(r(33))}function Ze(e){return e[Sl]||null}function Je(e){do{e=e.return}while(e&&5!==e.tag);return e||null}function $e(e,t){var n=e.stateNode;if(!n)return null;var o=Ko(n);if(!o)return null;n=o[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":case"onMouseEnter":(o=!o.disabled)||(e=e.type,o=!("button"===e||"input"===e||"select"===e||"textarea"===e)),e=!o;break e;default:e=!1}if(e)return null;if(n&&"function"!=typeof n)throw Error(r(231,t,typeof n));return n}function et(e,t,n){(t=$e(e,n.dispatchConfig.phasedRegistrationNames[t]))&&(n._dispatchListeners=oe(n._dispatchListeners,t),n._dispatchInstances=oe(n._dispatchInstances,e))}function tt(e){if(e&&e.dispatchConfig.phasedRegistrationNames){for(var t=e._targetInst,n=[];t;)n.push(t),t=Je(t);for(t=n.length;0<t--;)et(n[t],"captured",e);for(t=0;t<n.length;t++)et(n[t],"bubbled",e)}}function nt(e,t,n){e&&n&&n.dispatchConfig.registrationName&&(t=$e(e,n.dispatchConfig.registrationName))&&(n._dispatchListeners=oe(n._dispatchListeners,t),n._dispatchInstances=oe(n._dispatchInstances,e))}function rt(e){e&&e.dispatchConfig.registrationName&&nt(e._targetInst,null,e)}function ot(e){ae(e,tt)}function at(){if(Ll)return Ll;var e,t,n=Rl,r=n.length,o="value"in Tl?Tl.value:Tl.textContent,a=o.length;for(e=0;e<r&&n[e]===o[e];e++);var l=r-e;for(t=1;t<=l&&n[r-t]===o[a-t];t++);return Ll=o.slice(e,1<t?1-t:void 0)}function lt(){return!0}function it(){return!1}function st(e,t,n,r){this.dispatchConfig=e,this._targetInst=t,this.nativeEvent=n,e=this.constructor.Interface;for(var o in e)e.hasOwnProperty(o)&&((t=e[o])?this[o]=t(n):"target"===o?this.target=r:this[o]=n[o]);return this.isDefaultPrevented=(null!=n.defaultPrevented?n.defaultPrevented:!1===n.returnValue)?lt:it,this.isPropagationStopped=it,this}function ct(e,t,n,r){if(this.eventPool.length){var o=this.eventPool.pop();return this.call(o,e,t,n,r),o}return new this(e,t,n,r)}function ut(e){if(!(e instanceof this))throw Error(r(279));e.destructor(),10>this.eventPool.length&&this.eventPool.push(e)}function ft(e){e.eventPool=[],e.getPooled=ct,e.release=ut}function dt(e,t){switch(e){case"keyup":return-1!==Dl.indexOf(t.keyCode);case"keydown":return 229!==t.keyCode;case"keypress":case"mousedown":case"blur":return!0;default:return!1}}function pt(e){return e=e.detail,"object"==typeof e&&"data"in e?e.data:null}function bt(e,t){switch(e){case"compositionend":return pt(t);case"keypress":return 32!==t.which?null:(Yl=!0,zl);case"textInput":return e=t.data,e===zl&&Yl?null:e;default:return null}}function ht(e,t){if(Wl)return"compositionend"===e||!Il&&dt(e,t)?(e=at(),Ll=Rl=Tl=null,Wl=!1,e):null;switch(e){case"paste":return null;case"keypress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"compositionend":return Ul&&"ko"!==t.locale?null:t.data;default:return null}}function mt(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return"input"===t?!!Bl[e.type]:"textarea"===t}function gt(e,t,n){return e=st.getPooled(Kl.change,e,t,n),e.type="change",d(n),ot(e),e}function vt(e){ie(e)}function yt(e){if(L(Qe(e)))return e}function _t(e,t){if("change"===e)return t}function xt(){Xl&&(Xl.detachEvent("onpropertychange",wt),Gl=Xl=null)}function wt(e){if("value"===e.propertyName&&yt(Gl))if(e=gt(Gl,e,se(e)),ia)ie(e);else{ia=!0;try{b(vt,e)}finally{ia=!1,g()}}}function kt(e,t,n){"focus"===e?(xt(),Xl=t,Gl=n,Xl.attachEvent("onpropertychange",wt)):"blur"===e&&xt()}function Et(e){if("selectionchange"===e||"keyup"===e||"keydown"===e)return yt(Gl)}function Ot(e,t){if("click"===e)return yt(t)}function Pt(e,t){if("input"===e||"change"===e)return yt(t)}function Ct(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):!!(e=$l[e])&&!!t[e]}function jt(){return Ct}function St(e,t){return e===t&&(0!==e||1/e==1/t)||e!==e&&t!==t}function Mt(e,t){if(si(e,t))return!0;if("object"!=typeof e||null===e||"object"!=typeof t||null===t)return!1;var n=Object.keys(e),r=Object.keys(t);if(n.length!==r.length)return!1;for(r=0;r<n.length;r++)if(!ci.call(t,n[r])||!si(e[n[r]],t[n[r]]))return!1;return!0}function Tt(e,t){var n=t.window===t?t.document:9===t.nodeType?t:t.ownerDocument;return hi||null==di||di!==He(n)?null:(n=di,"selectionStart"in n&&Ye(n)?n={start:n.selectionStart,end:n.selectionEnd}:(n=(n.ownerDocument&&n.ownerDocument.defaultView||window).getSelection(),n={anchorNode:n.anchorNode,anchorOffset:n.anchorOffset,focusNode:n.focusNode,focusOffset:n.focusOffset}),bi&&Mt(bi,n)?null:(bi=n,e=st.getPooled(fi.select,pi,e,t),e.type="select",e.target=di,ot(e),e))}function Rt(e){var t=e.keyCode;return"charCode"in e?0===(e=e.charCode)&&13===t&&(e=13):e=t,10===e&&(e=13),32<=e||13===e?e:0}function Lt(e){0>Ni||(e.current=Li[Ni],Li[Ni]=null,Ni--)}function Nt(e,t){Ni++,Li[Ni]=e.current,e.current=t}function At(e,t){var n=e.type.contextTypes;if(!n)return Ai;var r=e.stateNode;if(r&&r.__reactInternalMemoizedUnmaskedChildContext===t)return r.__reactInternalMemoizedMaskedChildContext;var o,a={};for(o in n)a[o]=t[o];return r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=t,e.__reactInternalMemoizedMaskedChildContext=a),a}function Dt(e){return null!==(e=e.childContextTypes)&&void 0!==e}function It(){Lt(Ii),Lt(Di)}function Ht(e,t,n){if(Di.current!==Ai)throw Error(r(168));Nt(Di,t),Nt(Ii,n)}function Ft(e,t,n){var o=e.stateNode;if(e=t.childContextTypes,"function"!=typeof o.getChildContext)return n;o=o.getChildContext();for(var a in o)if(!(a in e))throw Error(r(108,C(t)||"Unknown",a));return Uo({},n,{},o)}function Ut(e){return e=(e=e.stateNode)&&e.__reactInternalMemoizedMergedChildContext||Ai,Hi=Di.current,Nt(Di,e),Nt(Ii,Ii.current),!0}function zt(e,t,n){var o=e.stateNode;if(!o)throw Error(r(169));n?(e=Ft(e,t,Hi),o.__reactInternalMemoizedMergedChildContext=e,Lt(Ii),Lt(Di),Nt(Di,e)):Lt(Ii),Nt(Ii,n)}function qt(){switch(Wi()){case Vi:return 99;case Bi:return 98;case Ki:return 97;case Xi:return 96;case Gi:return 95;default:throw Error(r(332))}}function Yt(e){switch(e){case 99:return Vi;case 98:return Bi;case 97:return Ki;case 96:return Xi;case 95:return Gi;default:throw Error(r(332))}}function Wt(e,t){return e=Yt(e),Fi(e,t)}function Vt(e,t,n){return e=Yt(e),Ui(e,t,n)}function Bt(e){return null===$i?($i=[e],es=Ui(Vi,Xt)):$i.push(e),Qi}function Kt(){if(null!==es){var e=es;es=null,zi(e)}Xt()}function Xt(){if(!ts&&null!==$i){ts=!0;var e=0;try{var t=$i;Wt(99,function(){for(;e<t.length;e++){var n=t[e];do{n=n(!0)}while(null!==n)}}),$i=null}catch(t){throw null!==$i&&($i=$i.slice(e+1)),Ui(Vi,Kt),t}finally{ts=!1}}}function Gt(e,t,n){return n/=10,1073741821-(1+((1073741821-e+t/10)/n|0))*n}function Qt(e,t){if(e&&e.defaultProps){t=Uo({},t),e=e.defaultProps;for(var n in e)void 0===t[n]&&(t[n]=e[n])}return t}function Zt(){is=ls=as=null}function Jt(e){var t=os.current;Lt(os),e.type._context._currentValue=t}function $t(e,t){for(;null!==e;){var n=e.alternate;if(e.childExpirationTime<t)e.childExpirationTime=t,null!==n&&n.childExpirationTime<t&&(n.childExpirationTime=t);else{if(!(null!==n&&n.childExpirationTime<t))break;n.childExpirationTime=t}e=e.return}}function en(e,t){as=e,is=ls=null,null!==(e=e.dependencies)&&null!==e.firstContext&&(e.expirationTime>=t&&(As=!0),e.firstContext=null)}function tn(e,t){if(is!==e&&!1!==t&&0!==t)if("number"==typeof t&&1073741823!==t||(is=e,t=1073741823),t={context:e,observedBits:t,next:null},null===ls){if(null===as)throw Error(r(308));ls=t,as.dependencies={expirationTime:0,firstContext:t,responders:null}}else ls=ls.next=t;return e._currentValue}function nn(e){e.updateQueue={baseState:e.memoizedState,baseQueue:null,shared:{pending:null},effects:null}}function rn(e,t){e=e.updateQueue,t.updateQueue===e&&(t.updateQueue={baseState:e.baseState,baseQueue:e.baseQueue,shared:e.shared,effects:e.effects})}function on(e,t){return e={expirationTime:e,suspenseConfig:t,tag:0,payload:null,callback:null,next:null},e.next=e}function an(e,t){if(null!==(e=e.updateQueue)){e=e.shared;var n=e.pending;null===n?t.next=t:(t.next=n.next,n.next=t),e.pending=t}}function ln(e,t){var n=e.alternate;null!==n&&rn(n,e),e=e.updateQueue,n=e.baseQueue,null===n?(e.baseQueue=t.next=t,t.next=t):(t.next=n.next,n.next=t)}function sn(e,t,n,r){var o=e.updateQueue;ss=!1;var a=o.baseQueue,l=o.shared.pending;if(null!==l){if(null!==a){var i=a.next;a.next=l.next,l.next=i}a=l,o.shared.pending=null,i=e.alternate,null!==i&&null!==(i=i.updateQueue)&&(i.baseQueue=l)}if(null!==a){i=a.next;var s=o.baseState,c=0,u=null,f=null,d=null;if(null!==i)for(var p=i;;){if((l=p.expirationTime)<r){var b={expirationTime:p.expirationTime,suspenseConfig:p.suspenseConfig,tag:p.tag,payload:p.payload,callback:p.callback,next:null};null===d?(f=d=b,u=s):d=d.next=b,l>c&&(c=l)}else{null!==d&&(d=d.next={expirationTime:1073741823,suspenseConfig:p.suspenseConfig,tag:p.tag,payload:p.payload,callback:p.callback,next:null}),Zr(l,p.suspenseConfig);e:{var h=e,m=p;switch(l=t,b=n,m.tag){case 1:if("function"==typeof(h=m.payload)){s=h.call(b,s,l);break e}s=h;break e;case 3:h.effectTag=-4097&h.effectTag|64;case 0:if(h=m.payload,null===(l="function"==typeof h?h.call(b,s,l):h)||void 0===l)break e;s=Uo({},s,l);break e;case 2:ss=!0}}null!==p.callback&&(e.effectTag|=32,l=o.effects,null===l?o.effects=[p]:l.push(p))}if(null===(p=p.next)||p===i){if(null===(l=o.shared.pending))break;p=a.next=l.next,l.next=i,o.baseQueue=a=l,o.shared.pending=null}}null===d?u=s:d.next=f,o.baseState=u,o.baseQueue=d,Jr(c),e.expirationTime=c,e.memoizedState=s}}function cn(e,t,n){if(e=t.effects,t.effects=null,null!==e)for(t=0;t<e.length;t++){var o=e[t],a=o.callback;if(null!==a){if(o.callback=null,o=a,a=n,"function"!=typeof o)throw Error(r(191,o));o.call(a)}}}function un(e,t,n,r){t=e.memoizedState,n=n(r,t),n=null===n||void 0===n?t:Uo({},t,n),e.memoizedState=n,0===e.expirationTime&&(e.updateQueue.baseState=n)}function fn(e,t,n,r,o,a,l){return e=e.stateNode,"function"==typeof e.shouldComponentUpdate?e.shouldComponentUpdate(r,a,l):!t.prototype||!t.prototype.isPureReactComponent||(!Mt(n,r)||!Mt(o,a))}function dn(e,t,n){var r=!1,o=Ai,a=t.contextType;return"object"==typeof a&&null!==a?a=tn(a):(o=Dt(t)?Hi:Di.current,r=t.contextTypes,a=(r=null!==r&&void 0!==r)?At(e,o):Ai),t=new t(n,a),e.memoizedState=null!==t.state&&void 0!==t.state?t.state:null,t.updater=fs,e.stateNode=t,t._reactInternalFiber=e,r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=o,e.__reactInternalMemoizedMaskedChildContext=a),t}function pn(e,t,n,r){e=t.state,"function"==typeof t.componentWillReceiveProps&&t.componentWillReceiveProps(n,r),"function"==typeof t.UNSAFE_componentWillReceiveProps&&t.UNSAFE_componentWillReceiveProps(n,r),t.state!==e&&fs.enqueueReplaceState(t,t.state,null)}function bn(e,t,n,r){var o=e.stateNode;o.props=n,o.state=e.memoizedState,o.refs=us,nn(e);var a=t.contextType;"object"==typeof a&&null!==a?o.context=tn(a):(a=Dt(t)?Hi:Di.current,o.context=At(e,a)),sn(e,n,o,r),o.state=e.memoizedState,a=t.getDerivedStateFromProps,"function"==typeof a&&(un(e,t,a,n),o.state=e.memoizedState),"function"==typeof t.getDerivedStateFromProps||"function"==typeof o.getSnapshotBeforeUpdate||"function"!=typeof o.UNSAFE_componentWillMount&&"function"!=typeof o.componentWillMount||(t=o.state,"function"==typeof o.componentWillMount&&o.componentWillMount(),"function"==typeof o.UNSAFE_componentWillMount&&o.UNSAFE_componentWillMount(),t!==o.state&&fs.enqueueReplaceState(o,o.state,null),sn(e,n,o,r),o.state=e.memoizedState),"function"==typeof o.componentDidMount&&(e.effectTag|=4)}function hn(e,t,n){if(null!==(e=n.ref)&&"function"!=typeof e&&"object"!=typeof e){if(n._owner){if(n=n._owner){if(1!==n.tag)throw Error(r(309));var o=n.stateNode}if(!o)throw Error(r(147,e));var a=""+e;return null!==t&&null!==t.ref&&"function"==typeof t.ref&&t.ref._stringRef===a?t.ref:(t=function(e){var t=o.refs;t===us&&(t=o.refs={}),null===e?delete t[a]:t[a]=e},t._stringRef=a,t)}if("string"!=typeof e)throw Error(r(284));if(!n._owner)throw Error(r(290,e))}return e}function mn(e,t){if("textarea"!==e.type)throw Error(r(31,"[object Object]"===Object.prototype.toString.call(t)?"object with keys {"+Object.keys(t).join(", ")+"}":t,""))}function gn(e){function t(t,n){if(e){var r=t.lastEffect;null!==r?(r.nextEffect=n,t.lastEffect=n):t.firstEffect=t.lastEffect=n,n.nextEffect=null,n.effectTag=8}}function n(n,r){if(!e)return null;for(;null!==r;)t(n,r),r=r.sibling;return null}function o(e,t){for(e=new Map;null!==t;)null!==t.key?e.set(t.key,t):e.set(t.index,t),t=t.sibling;return e}function a(e,t){return e=yo(e,t),e.index=0,e.sibling=null,e}function l(t,n,r){return t.index=r,e?null!==(r=t.alternate)?(r=r.index,r<n?(t.effectTag=2,n):r):(t.effectTag=2,n):n}function i(t){return e&&null===t.alternate&&(t.effectTag=2),t}function s(e,t,n,r){return null===t||6!==t.tag?(t=wo(n,e.mode,r),t.return=e,t):(t=a(t,n),t.return=e,t)}function c(e,t,n,r){return null!==t&&t.elementType===n.type?(r=a(t,n.props),r.ref=hn(e,t,n),r.return=e,r):(r=_o(n.type,n.key,n.props,null,e.mode,r),r.ref=hn(e,t,n),r.return=e,r)}function u(e,t,n,r){return null===t||4!==t.tag||t.stateNode.containerInfo!==n.containerInfo||t.stateNode.implementation!==n.implementation?(t=ko(n,e.mode,r),t.return=e,t):(t=a(t,n.children||[]),t.return=e,t)}function f(e,t,n,r,o){return null===t||7!==t.tag?(t=xo(n,e.mode,r,o),t.return=e,t):(t=a(t,n),t.return=e,t)}function d(e,t,n){if("string"==typeof t||"number"==typeof t)return t=wo(""+t,e.mode,n),t.return=e,t;if("object"==typeof t&&null!==t){switch(t.$$typeof){case ya:return n=_o(t.type,t.key,t.props,null,e.mode,n),n.ref=hn(e,null,t),n.return=e,n;case _a:return t=ko(t,e.mode,n),t.return=e,t}if(ds(t)||O(t))return t=xo(t,e.mode,n,null),t.return=e,t;mn(e,t)}return null}function p(e,t,n,r){var o=null!==t?t.key:null;if("string"==typeof n||"number"==typeof n)return null!==o?null:s(e,t,""+n,r);if("object"==typeof n&&null!==n){switch(n.$$typeof){case ya:return n.key===o?n.type===xa?f(e,t,n.props.children,r,o):c(e,t,n,r):null;case _a:return n.key===o?u(e,t,n,r):null}if(ds(n)||O(n))return null!==o?null:f(e,t,n,r,null);mn(e,n)}return null}function b(e,t,n,r,o){if("string"==typeof r||"number"==typeof r)return e=e.get(n)||null,s(t,e,""+r,o);if("object"==typeof r&&null!==r){switch(r.$$typeof){case ya:return e=e.get(null===r.key?n:r.key)||null,r.type===xa?f(t,e,r.props.children,o,r.key):c(t,e,r,o);case _a:return e=e.get(null===r.key?n:r.key)||null,u(t,e,r,o)}if(ds(r)||O(r))return e=e.get(n)||null,f(t,e,r,o,null);mn(t,r)}return null}function h(r,a,i,s){for(var c=null,u=null,f=a,h=a=0,m=null;null!==f&&h<i.length;h++){f.index>h?(m=f,f=null):m=f.sibling;var g=p(r,f,i[h],s);if(null===g){null===f&&(f=m);break}e&&f&&null===g.alternate&&t(r,f),a=l(g,a,h),null===u?c=g:u.sibling=g,u=g,f=m}if(h===i.length)return n(r,f),c;if(null===f){for(;h<i.length;h++)null!==(f=d(r,i[h],s))&&(a=l(f,a,h),null===u?c=f:u.sibling=f,u=f);return c}for(f=o(r,f);h<i.length;h++)null!==(m=b(f,r,h,i[h],s))&&(e&&null!==m.alternate&&f.delete(null===m.key?h:m.key),a=l(m,a,h),null===u?c=m:u.sibling=m,u=m);return e&&f.forEach(function(e){return t(r,e)}),c}function m(a,i,s,c){var u=O(s);if("function"!=typeof u)throw Error(r(150));if(null==(s=u.call(s)))throw Error(r(151));for(var f=u=null,h=i,m=i=0,g=null,v=s.next();null!==h&&!v.done;m++,v=s.next()){h.index>m?(g=h,h=null):g=h.sibling;var y=p(a,h,v.value,c);if(null===y){null===h&&(h=g);break}e&&h&&null===y.alternate&&t(a,h),i=l(y,i,m),null===f?u=y:f.sibling=y,f=y,h=g}if(v.done)return n(a,h),u;if(null===h){for(;!v.done;m++,v=s.next())null!==(v=d(a,v.value,c))&&(i=l(v,i,m),null===f?u=v:f.sibling=v,f=v);return u}for(h=o(a,h);!v.done;m++,v=s.next())null!==(v=b(h,a,m,v.value,c))&&(e&&null!==v.alternate&&h.delete(null===v.key?m:v.key),i=l(v,i,m),null===f?u=v:f.sibling=v,f=v);return e&&h.forEach(function(e){return t(a,e)}),u}return function(e,o,l,s){var c="object"==typeof l&&null!==l&&l.type===xa&&null===l.key;c&&(l=l.props.children);var u="object"==typeof l&&null!==l;if(u)switch(l.$$typeof){case ya:e:{for(u=l.key,c=o;null!==c;){if(c.key===u){switch(c.tag){case 7:if(l.type===xa){n(e,c.sibling),o=a(c,l.props.children),o.return=e,e=o;break e}break;default:if(c.elementType===l.type){n(e,c.sibling),o=a(c,l.props),o.ref=hn(e,c,l),o.return=e,e=o;break e}}n(e,c);break}t(e,c),c=c.sibling}l.type===xa?(o=xo(l.props.children,e.mode,s,l.key),o.return=e,e=o):(s=_o(l.type,l.key,l.props,null,e.mode,s),s.ref=hn(e,o,l),s.return=e,e=s)}return i(e);case _a:e:{for(c=l.key;null!==o;){if(o.key===c){if(4===o.tag&&o.stateNode.containerInfo===l.containerInfo&&o.stateNode.implementation===l.implementation){n(e,o.sibling),o=a(o,l.children||[]),o.return=e,e=o;break e}n(e,o);break}t(e,o),o=o.sibling}o=ko(l,e.mode,s),o.return=e,e=o}return i(e)}if("string"==typeof l||"number"==typeof l)return l=""+l,null!==o&&6===o.tag?(n(e,o.sibling),o=a(o,l),o.return=e,e=o):(n(e,o),o=wo(l,e.mode,s),o.return=e,e=o),i(e);if(ds(l))return h(e,o,l,s);if(O(l))return m(e,o,l,s);if(u&&mn(e,l),void 0===l&&!c)switch(e.tag){case 1:case 0:throw e=e.type,Error(r(152,e.displayName||e.name||"Component"))}return n(e,o)}}function vn(e){if(e===hs)throw Error(r(174));return e}function yn(e,t){switch(Nt(vs,t),Nt(gs,e),Nt(ms,hs),e=t.nodeType){case 9:case 11:t=(t=t.documentElement)?t.namespaceURI:X(null,"");break;default:e=8===e?t.parentNode:t,t=e.namespaceURI||null,e=e.tagName,t=X(t,e)}Lt(ms),Nt(ms,t)}function _n(){Lt(ms),Lt(gs),Lt(vs)}function xn(e){vn(vs.current);var t=vn(ms.current),n=X(t,e.type);t!==n&&(Nt(gs,e),Nt(ms,n))}function wn(e){gs.current===e&&(Lt(ms),Lt(gs))}function kn(e){for(var t=e;null!==t;){if(13===t.tag){var n=t.memoizedState;if(null!==n&&(null===(n=n.dehydrated)||n.data===xl||n.data===wl))return t}else if(19===t.tag&&void 0!==t.memoizedProps.revealOrder){if(0!=(64&t.effectTag))return t}else if(null!==t.child){t.child.return=t,t=t.child;continue}if(t===e)break;for(;null===t.sibling;){if(null===t.return||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}return null}function En(e,t){return{responder:e,props:t}}function On(){throw Error(r(321))}function Pn(e,t){if(null===t)return!1;for(var n=0;n<t.length&&n<e.length;n++)if(!si(e[n],t[n]))return!1;return!0}function Cn(e,t,n,o,a,l){if(ws=l,ks=t,t.memoizedState=null,t.updateQueue=null,t.expirationTime=0,_s.current=null===e||null===e.memoizedState?js:Ss,e=n(o,a),t.expirationTime===ws){l=0;do{if(t.expirationTime=0,!(25>l))throw Error(r(301));l+=1,Os=Es=null,t.updateQueue=null,_s.current=Ms,e=n(o,a)}while(t.expirationTime===ws)}if(_s.current=Cs,t=null!==Es&&null!==Es.next,ws=0,Os=Es=ks=null,Ps=!1,t)throw Error(r(300));return e}function jn(){var e={memoizedState:null,baseState:null,baseQueue:null,queue:null,next:null};return null===Os?ks.memoizedState=Os=e:Os=Os.next=e,Os}function Sn(){if(null===Es){var e=ks.alternate;e=null!==e?e.memoizedState:null}else e=Es.next;var t=null===Os?ks.memoizedState:Os.next;if(null!==t)Os=t,Es=e;else{if(null===e)throw Error(r(310));Es=e,e={memoizedState:Es.memoizedState,baseState:Es.baseState,baseQueue:Es.baseQueue,queue:Es.queue,next:null},null===Os?ks.memoizedState=Os=e:Os=Os.next=e}return Os}function Mn(e,t){return"function"==typeof t?t(e):t}function Tn(e){var t=Sn(),n=t.queue;if(null===n)throw Error(r(311));n.lastRenderedReducer=e;var o=Es,a=o.baseQueue,l=n.pending;if(null!==l){if(null!==a){var i=a.next;a.next=l.next,l.next=i}o.baseQueue=a=l,n.pending=null}if(null!==a){a=a.next,o=o.baseState;var s=i=l=null,c=a;do{var u=c.expirationTime;if(u<ws){var f={expirationTime:c.expirationTime,suspenseConfig:c.suspenseConfig,action:c.action,eagerReducer:c.eagerReducer,eagerState:c.eagerState,next:null};null===s?(i=s=f,l=o):s=s.next=f,u>ks.expirationTime&&(ks.expirationTime=u,Jr(u))}else null!==s&&(s=s.next={expirationTime:1073741823,suspenseConfig:c.suspenseConfig,action:c.action,eagerReducer:c.eagerReducer,eagerState:c.eagerState,next:null}),Zr(u,c.suspenseConfig),o=c.eagerReducer===e?c.eagerState:e(o,c.action);c=c.next}while(null!==c&&c!==a);null===s?l=o:s.next=i,si(o,t.memoizedState)||(As=!0),t.memoizedState=o,t.baseState=l,t.baseQueue=s,n.lastRenderedState=o}return[t.memoizedState,n.dispatch]}function Rn(e){var t=Sn(),n=t.queue;if(null===n)throw Error(r(311));n.lastRenderedReducer=e;var o=n.dispatch,a=n.pending,l=t.memoizedState;if(null!==a){n.pending=null;var i=a=a.next;do{l=e(l,i.action),i=i.next}while(i!==a);si(l,t.memoizedState)||(As=!0),t.memoizedState=l,null===t.baseQueue&&(t.baseState=l),n.lastRenderedState=l}return[l,o]}function Ln(e){var t=jn();return"function"==typeof e&&(e=e()),t.memoizedState=t.baseState=e,e=t.queue={pending:null,dispatch:null,lastRenderedReducer:Mn,lastRenderedState:e},e=e.dispatch=Xn.bind(null,ks,e),[t.memoizedState,e]}function Nn(e,t,n,r){return e={tag:e,create:t,destroy:n,deps:r,next:null},t=ks.updateQueue,null===t?(t={lastEffect:null},ks.updateQueue=t,t.lastEffect=e.next=e):(n=t.lastEffect,null===n?t.lastEffect=e.next=e:(r=n.next,n.next=e,e.next=r,t.lastEffect=e)),e}function An(){return Sn().memoizedState}function Dn(e,t,n,r){var o=jn();ks.effectTag|=e,o.memoizedState=Nn(1|t,n,void 0,void 0===r?null:r)}function In(e,t,n,r){var o=Sn();r=void 0===r?null:r;var a=void 0;if(null!==Es){var l=Es.memoizedState;if(a=l.destroy,null!==r&&Pn(r,l.deps))return void Nn(t,n,a,r)}ks.effectTag|=e,o.memoizedState=Nn(1|t,n,a,r)}function Hn(e,t){return Dn(516,4,e,t)}function Fn(e,t){return In(516,4,e,t)}function Un(e,t){return In(4,2,e,t)}function zn(e,t){return"function"==typeof t?(e=e(),t(e),function(){t(null)}):null!==t&&void 0!==t?(e=e(),t.current=e,function(){t.current=null}):void 0}function qn(e,t,n){return n=null!==n&&void 0!==n?n.concat([e]):null,In(4,2,zn.bind(null,t,e),n)}function Yn(){}function Wn(e,t){return jn().memoizedState=[e,void 0===t?null:t],e}function Vn(e,t){var n=Sn();t=void 0===t?null:t;var r=n.memoizedState;return null!==r&&null!==t&&Pn(t,r[1])?r[0]:(n.memoizedState=[e,t],e)}function Bn(e,t){var n=Sn();t=void 0===t?null:t;var r=n.memoizedState;return null!==r&&null!==t&&Pn(t,r[1])?r[0]:(e=e(),n.memoizedState=[e,t],e)}function Kn(e,t,n){var r=qt();Wt(98>r?98:r,function(){e(!0)}),Wt(97<r?97:r,function(){var r=xs.suspense;xs.suspense=void 0===t?null:t;try{e(!1),n()}finally{xs.suspense=r}})}function Xn(e,t,n){var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o={expirationTime:r,suspenseConfig:o,action:n,eagerReducer:null,eagerState:null,next:null};var a=t.pending;if(null===a?o.next=o:(o.next=a.next,a.next=o),t.pending=o,a=e.alternate,e===ks||null!==a&&a===ks)Ps=!0,o.expirationTime=ws,ks.expirationTime=ws;else{if(0===e.expirationTime&&(null===a||0===a.expirationTime)&&null!==(a=t.lastRenderedReducer))try{var l=t.lastRenderedState,i=a(l,n);if(o.eagerReducer=a,o.eagerState=i,si(i,l))return}catch(e){}Fr(e,r)}}function Gn(e,t){var n=mo(5,null,null,0);n.elementType="DELETED",n.type="DELETED",n.stateNode=t,n.return=e,n.effectTag=8,null!==e.lastEffect?(e.lastEffect.nextEffect=n,e.lastEffect=n):e.firstEffect=e.lastEffect=n}function Qn(e,t){switch(e.tag){case 5:var n=e.type;return null!==(t=1!==t.nodeType||n.toLowerCase()!==t.nodeName.toLowerCase()?null:t)&&(e.stateNode=t,!0);case 6:return null!==(t=""===e.pendingProps||3!==t.nodeType?null:t)&&(e.stateNode=t,!0);case 13:default:return!1}}function Zn(e){if(Ls){var t=Rs;if(t){var n=t;if(!Qn(e,t)){if(!(t=Be(n.nextSibling))||!Qn(e,t))return e.effectTag=-1025&e.effectTag|2,Ls=!1,void(Ts=e);Gn(Ts,n)}Ts=e,Rs=Be(t.firstChild)}else e.effectTag=-1025&e.effectTag|2,Ls=!1,Ts=e}}function Jn(e){for(e=e.return;null!==e&&5!==e.tag&&3!==e.tag&&13!==e.tag;)e=e.return;Ts=e}function $n(e){if(e!==Ts)return!1;if(!Ls)return Jn(e),Ls=!0,!1;var t=e.type;if(5!==e.tag||"head"!==t&&"body"!==t&&!Ve(t,e.memoizedProps))for(t=Rs;t;)Gn(e,t),t=Be(t.nextSibling);if(Jn(e),13===e.tag){if(e=e.memoizedState,!(e=null!==e?e.dehydrated:null))throw Error(r(317));e:{for(e=e.nextSibling,t=0;e;){if(8===e.nodeType){var n=e.data;if(n===_l){if(0===t){Rs=Be(e.nextSibling);break e}t--}else n!==yl&&n!==wl&&n!==xl||t++}e=e.nextSibling}Rs=null}}else Rs=Ts?Be(e.stateNode.nextSibling):null;return!0}function er(){Rs=Ts=null,Ls=!1}function tr(e,t,n,r){t.child=null===e?bs(t,null,n,r):ps(t,e.child,n,r)}function nr(e,t,n,r,o){n=n.render;var a=t.ref;return en(t,o),r=Cn(e,t,n,r,a,o),null===e||As?(t.effectTag|=1,tr(e,t,r,o),t.child):(t.updateQueue=e.updateQueue,t.effectTag&=-517,e.expirationTime<=o&&(e.expirationTime=0),br(e,t,o))}function rr(e,t,n,r,o,a){if(null===e){var l=n.type;return"function"!=typeof l||go(l)||void 0!==l.defaultProps||null!==n.compare||void 0!==n.defaultProps?(e=_o(n.type,null,r,null,t.mode,a),e.ref=t.ref,e.return=t,t.child=e):(t.tag=15,t.type=l,or(e,t,l,r,o,a))}return l=e.child,o<a&&(o=l.memoizedProps,n=n.compare,(n=null!==n?n:Mt)(o,r)&&e.ref===t.ref)?br(e,t,a):(t.effectTag|=1,e=yo(l,r),e.ref=t.ref,e.return=t,t.child=e)}function or(e,t,n,r,o,a){return null!==e&&Mt(e.memoizedProps,r)&&e.ref===t.ref&&(As=!1,o<a)?(t.expirationTime=e.expirationTime,br(e,t,a)):lr(e,t,n,r,a)}function ar(e,t){var n=t.ref;(null===e&&null!==n||null!==e&&e.ref!==n)&&(t.effectTag|=128)}function lr(e,t,n,r,o){var a=Dt(n)?Hi:Di.current;return a=At(t,a),en(t,o),n=Cn(e,t,n,r,a,o),null===e||As?(t.effectTag|=1,tr(e,t,n,o),t.child):(t.updateQueue=e.updateQueue,t.effectTag&=-517,e.expirationTime<=o&&(e.expirationTime=0),br(e,t,o))}function ir(e,t,n,r,o){if(Dt(n)){var a=!0;Ut(t)}else a=!1;if(en(t,o),null===t.stateNode)null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),dn(t,n,r),bn(t,n,r,o),r=!0;else if(null===e){var l=t.stateNode,i=t.memoizedProps;l.props=i;var s=l.context,c=n.contextType;"object"==typeof c&&null!==c?c=tn(c):(c=Dt(n)?Hi:Di.current,c=At(t,c));var u=n.getDerivedStateFromProps,f="function"==typeof u||"function"==typeof l.getSnapshotBeforeUpdate;f||"function"!=typeof l.UNSAFE_componentWillReceiveProps&&"function"!=typeof l.componentWillReceiveProps||(i!==r||s!==c)&&pn(t,l,r,c),ss=!1;var d=t.memoizedState;l.state=d,sn(t,r,l,o),s=t.memoizedState,i!==r||d!==s||Ii.current||ss?("function"==typeof u&&(un(t,n,u,r),s=t.memoizedState),(i=ss||fn(t,n,i,r,d,s,c))?(f||"function"!=typeof l.UNSAFE_componentWillMount&&"function"!=typeof l.componentWillMount||("function"==typeof l.componentWillMount&&l.componentWillMount(),"function"==typeof l.UNSAFE_componentWillMount&&l.UNSAFE_componentWillMount()),"function"==typeof l.componentDidMount&&(t.effectTag|=4)):("function"==typeof l.componentDidMount&&(t.effectTag|=4),t.memoizedProps=r,t.memoizedState=s),l.props=r,l.state=s,l.context=c,r=i):("function"==typeof l.componentDidMount&&(t.effectTag|=4),r=!1)}else l=t.stateNode,rn(e,t),i=t.memoizedProps,l.props=t.type===t.elementType?i:Qt(t.type,i),s=l.context,c=n.contextType,"object"==typeof c&&null!==c?c=tn(c):(c=Dt(n)?Hi:Di.current,c=At(t,c)),u=n.getDerivedStateFromProps,(f="function"==typeof u||"function"==typeof l.getSnapshotBeforeUpdate)||"function"!=typeof l.UNSAFE_componentWillReceiveProps&&"function"!=typeof l.componentWillReceiveProps||(i!==r||s!==c)&&pn(t,l,r,c),ss=!1,s=t.memoizedState,l.state=s,sn(t,r,l,o),d=t.memoizedState,i!==r||s!==d||Ii.current||ss?("function"==typeof u&&(un(t,n,u,r),d=t.memoizedState),(u=ss||fn(t,n,i,r,s,d,c))?(f||"function"!=typeof l.UNSAFE_componentWillUpdate&&"function"!=typeof l.componentWillUpdate||("function"==typeof l.componentWillUpdate&&l.componentWillUpdate(r,d,c),"function"==typeof l.UNSAFE_componentWillUpdate&&l.UNSAFE_componentWillUpdate(r,d,c)),"function"==typeof l.componentDidUpdate&&(t.effectTag|=4),"function"==typeof l.getSnapshotBeforeUpdate&&(t.effectTag|=256)):("function"!=typeof l.componentDidUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=4),"function"!=typeof l.getSnapshotBeforeUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=256),t.memoizedProps=r,t.memoizedState=d),l.props=r,l.state=d,l.context=c,r=u):("function"!=typeof l.componentDidUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=4),"function"!=typeof l.getSnapshotBeforeUpdate||i===e.memoizedProps&&s===e.memoizedState||(t.effectTag|=256),r=!1);return sr(e,t,n,r,a,o)}function sr(e,t,n,r,o,a){ar(e,t);var l=0!=(64&t.effectTag);if(!r&&!l)return o&&zt(t,n,!1),br(e,t,a);r=t.stateNode,Ns.current=t;var i=l&&"function"!=typeof n.getDerivedStateFromError?null:r.render();return t.effectTag|=1,null!==e&&l?(t.child=ps(t,e.child,null,a),t.child=ps(t,null,i,a)):tr(e,t,i,a),t.memoizedState=r.state,o&&zt(t,n,!0),t.child}function cr(e){var t=e.stateNode;t.pendingContext?Ht(e,t.pendingContext,t.pendingContext!==t.context):t.context&&Ht(e,t.context,!1),yn(e,t.containerInfo)}function ur(e,t,n){var r,o=t.mode,a=t.pendingProps,l=ys.current,i=!1;if((r=0!=(64&t.effectTag))||(r=0!=(2&l)&&(null===e||null!==e.memoizedState)),r?(i=!0,t.effectTag&=-65):null!==e&&null===e.memoizedState||void 0===a.fallback||!0===a.unstable_avoidThisFallback||(l|=1),Nt(ys,1&l),null===e){if(void 0!==a.fallback&&Zn(t),i){if(i=a.fallback,a=xo(null,o,0,null),a.return=t,0==(2&t.mode))for(e=null!==t.memoizedState?t.child.child:t.child,a.child=e;null!==e;)e.return=a,e=e.sibling;return n=xo(i,o,n,null),n.return=t,a.sibling=n,t.memoizedState=Ds,t.child=a,n}return o=a.children,t.memoizedState=null,t.child=bs(t,null,o,n)}if(null!==e.memoizedState){if(e=e.child,o=e.sibling,i){if(a=a.fallback,n=yo(e,e.pendingProps),n.return=t,0==(2&t.mode)&&(i=null!==t.memoizedState?t.child.child:t.child)!==e.child)for(n.child=i;null!==i;)i.return=n,i=i.sibling;return o=yo(o,a),o.return=t,n.sibling=o,n.childExpirationTime=0,t.memoizedState=Ds,t.child=n,o}return n=ps(t,e.child,a.children,n),t.memoizedState=null,t.child=n}if(e=e.child,i){if(i=a.fallback,a=xo(null,o,0,null),a.return=t,a.child=e,null!==e&&(e.return=a),0==(2&t.mode))for(e=null!==t.memoizedState?t.child.child:t.child,a.child=e;null!==e;)e.return=a,e=e.sibling;return n=xo(i,o,n,null),n.return=t,a.sibling=n,n.effectTag|=2,a.childExpirationTime=0,t.memoizedState=Ds,t.child=a,n}return t.memoizedState=null,t.child=ps(t,e,a.children,n)}function fr(e,t){e.expirationTime<t&&(e.expirationTime=t);var n=e.alternate;null!==n&&n.expirationTime<t&&(n.expirationTime=t),$t(e.return,t)}function dr(e,t,n,r,o,a){var l=e.memoizedState;null===l?e.memoizedState={isBackwards:t,rendering:null,renderingStartTime:0,last:r,tail:n,tailExpiration:0,tailMode:o,lastEffect:a}:(l.isBackwards=t,l.rendering=null,l.renderingStartTime=0,l.last=r,l.tail=n,l.tailExpiration=0,l.tailMode=o,l.lastEffect=a)}function pr(e,t,n){var r=t.pendingProps,o=r.revealOrder,a=r.tail;if(tr(e,t,r.children,n),0!=(2&(r=ys.current)))r=1&r|2,t.effectTag|=64;else{if(null!==e&&0!=(64&e.effectTag))e:for(e=t.child;null!==e;){if(13===e.tag)null!==e.memoizedState&&fr(e,n);else if(19===e.tag)fr(e,n);else if(null!==e.child){e.child.return=e,e=e.child;continue}if(e===t)break e;for(;null===e.sibling;){if(null===e.return||e.return===t)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}r&=1}if(Nt(ys,r),0==(2&t.mode))t.memoizedState=null;else switch(o){case"forwards":for(n=t.child,o=null;null!==n;)e=n.alternate,null!==e&&null===kn(e)&&(o=n),n=n.sibling;n=o,null===n?(o=t.child,t.child=null):(o=n.sibling,n.sibling=null),dr(t,!1,o,n,a,t.lastEffect);break;case"backwards":for(n=null,o=t.child,t.child=null;null!==o;){if(null!==(e=o.alternate)&&null===kn(e)){t.child=o;break}e=o.sibling,o.sibling=n,n=o,o=e}dr(t,!0,n,null,a,t.lastEffect);break;case"together":dr(t,!1,null,null,void 0,t.lastEffect);break;default:t.memoizedState=null}return t.child}function br(e,t,n){null!==e&&(t.dependencies=e.dependencies);var o=t.expirationTime;if(0!==o&&Jr(o),t.childExpirationTime<n)return null;if(null!==e&&t.child!==e.child)throw Error(r(153));if(null!==t.child){for(e=t.child,n=yo(e,e.pendingProps),t.child=n,n.return=t;null!==e.sibling;)e=e.sibling,n=n.sibling=yo(e,e.pendingProps),n.return=t;n.sibling=null}return t.child}function hr(e,t){switch(e.tailMode){case"hidden":t=e.tail;for(var n=null;null!==t;)null!==t.alternate&&(n=t),t=t.sibling;null===n?e.tail=null:n.sibling=null;break;case"collapsed":n=e.tail;for(var r=null;null!==n;)null!==n.alternate&&(r=n),n=n.sibling;null===r?t||null===e.tail?e.tail=null:e.tail.sibling=null:r.sibling=null}}function mr(e,t,n){var o=t.pendingProps;switch(t.tag){case 2:case 16:case 15:case 0:case 11:case 7:case 8:case 12:case 9:case 14:return null;case 1:return Dt(t.type)&&It(),null;case 3:return _n(),Lt(Ii),Lt(Di),n=t.stateNode,n.pendingContext&&(n.context=n.pendingContext,n.pendingContext=null),null!==e&&null!==e.child||!$n(t)||(t.effectTag|=4),Mi(t),null;case 5:wn(t),n=vn(vs.current);var a=t.type;if(null!==e&&null!=t.stateNode)Ti(e,t,a,o,n),e.ref!==t.ref&&(t.effectTag|=128);else{if(!o){if(null===t.stateNode)throw Error(r(166));return null}if(e=vn(ms.current),$n(t)){o=t.stateNode,a=t.type;var l=t.memoizedProps;switch(o[jl]=t,o[Sl]=l,a){case"iframe":case"object":case"embed":Pe("load",o);break;case"video":case"audio":for(e=0;e<Ba.length;e++)Pe(Ba[e],o);break;case"source":Pe("error",o);break;case"img":case"image":case"link":Pe("error",o),Pe("load",o);break;case"form":Pe("reset",o),Pe("submit",o);break;case"details":Pe("toggle",o);break;case"input":A(o,l),Pe("invalid",o),De(n,"onChange");break;case"select":o._wrapperState={wasMultiple:!!l.multiple},Pe("invalid",o),De(n,"onChange");break;case"textarea":W(o,l),Pe("invalid",o),De(n,"onChange")}Ne(a,l),e=null;for(var i in l)if(l.hasOwnProperty(i)){var s=l[i];"children"===i?"string"==typeof s?o.textContent!==s&&(e=["children",s]):"number"==typeof s&&o.textContent!==""+s&&(e=["children",""+s]):ea.hasOwnProperty(i)&&null!=s&&De(n,i)}switch(a){case"input":R(o),H(o,l,!0);break;case"textarea":R(o),B(o);break;case"select":case"option":break;default:"function"==typeof l.onClick&&(o.onclick=Ie)}n=e,t.updateQueue=n,null!==n&&(t.effectTag|=4)}else{switch(i=9===n.nodeType?n:n.ownerDocument,e===vl&&(e=K(a)),e===vl?"script"===a?(e=i.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):"string"==typeof o.is?e=i.createElement(a,{is:o.is}):(e=i.createElement(a),"select"===a&&(i=e,o.multiple?i.multiple=!0:o.size&&(i.size=o.size))):e=i.createElementNS(e,a),e[jl]=t,e[Sl]=o,Si(e,t,!1,!1),t.stateNode=e,i=Ae(a,o),a){case"iframe":case"object":case"embed":Pe("load",e),s=o;break;case"video":case"audio":for(s=0;s<Ba.length;s++)Pe(Ba[s],e);s=o;break;case"source":Pe("error",e),s=o;break;case"img":case"image":case"link":Pe("error",e),Pe("load",e),s=o;break;case"form":Pe("reset",e),Pe("submit",e),s=o;break;case"details":Pe("toggle",e),s=o;break;case"input":A(e,o),s=N(e,o),Pe("invalid",e),De(n,"onChange");break;case"option":s=z(e,o);break;case"select":e._wrapperState={wasMultiple:!!o.multiple},s=Uo({},o,{value:void 0}),Pe("invalid",e),De(n,"onChange");break;case"textarea":W(e,o),s=Y(e,o),Pe("invalid",e),De(n,"onChange");break;default:s=o}Ne(a,s);var c=s;for(l in c)if(c.hasOwnProperty(l)){var u=c[l];"style"===l?Le(e,u):"dangerouslySetInnerHTML"===l?null!=(u=u?u.__html:void 0)&&Aa(e,u):"children"===l?"string"==typeof u?("textarea"!==a||""!==u)&&G(e,u):"number"==typeof u&&G(e,""+u):"suppressContentEditableWarning"!==l&&"suppressHydrationWarning"!==l&&"autoFocus"!==l&&(ea.hasOwnProperty(l)?null!=u&&De(n,l):null!=u&&E(e,l,u,i))}switch(a){case"input":R(e),H(e,o,!1);break;case"textarea":R(e),B(e);break;case"option":null!=o.value&&e.setAttribute("value",""+S(o.value));break;case"select":e.multiple=!!o.multiple,n=o.value,null!=n?q(e,!!o.multiple,n,!1):null!=o.defaultValue&&q(e,!!o.multiple,o.defaultValue,!0);break;default:"function"==typeof s.onClick&&(e.onclick=Ie)}We(a,o)&&(t.effectTag|=4)}null!==t.ref&&(t.effectTag|=128)}return null;case 6:if(e&&null!=t.stateNode)Ri(e,t,e.memoizedProps,o);else{if("string"!=typeof o&&null===t.stateNode)throw Error(r(166));n=vn(vs.current),vn(ms.current),$n(t)?(n=t.stateNode,o=t.memoizedProps,n[jl]=t,n.nodeValue!==o&&(t.effectTag|=4)):(n=(9===n.nodeType?n:n.ownerDocument).createTextNode(o),n[jl]=t,t.stateNode=n)}return null;case 13:return Lt(ys),(o=t.memoizedState,0!=(64&t.effectTag))?(t.expirationTime=n,t):(n=null!==o,o=!1,null===e?void 0!==t.memoizedProps.fallback&&$n(t):(a=e.memoizedState,o=null!==a,n||null===a||null!==(a=e.child.sibling)&&(l=t.firstEffect,null!==l?(t.firstEffect=a,a.nextEffect=l):(t.firstEffect=t.lastEffect=a,a.nextEffect=null),a.effectTag=8)),n&&!o&&0!=(2&t.mode)&&(null===e&&!0!==t.memoizedProps.unstable_avoidThisFallback||0!=(1&ys.current)?rc===Ks&&(rc=Qs):(rc!==Ks&&rc!==Qs||(rc=Zs),0!==sc&&null!==ec&&(Po(ec,nc),Co(ec,sc)))),(n||o)&&(t.effectTag|=4),null);case 4:return _n(),Mi(t),null;case 10:return Jt(t),null;case 17:return Dt(t.type)&&It(),null;case 19:if(Lt(ys),null===(o=t.memoizedState))return null;if(a=0!=(64&t.effectTag),null===(l=o.rendering)){if(a)hr(o,!1);else if(rc!==Ks||null!==e&&0!=(64&e.effectTag))for(l=t.child;null!==l;){if(null!==(e=kn(l))){for(t.effectTag|=64,hr(o,!1),a=e.updateQueue,null!==a&&(t.updateQueue=a,t.effectTag|=4),null===o.lastEffect&&(t.firstEffect=null),t.lastEffect=o.lastEffect,o=t.child;null!==o;)a=o,l=n,a.effectTag&=2,a.nextEffect=null,a.firstEffect=null,a.lastEffect=null,e=a.alternate,null===e?(a.childExpirationTime=0,a.expirationTime=l,a.child=null,a.memoizedProps=null,a.memoizedState=null,a.updateQueue=null,a.dependencies=null):(a.childExpirationTime=e.childExpirationTime,a.expirationTime=e.expirationTime,a.child=e.child,a.memoizedProps=e.memoizedProps,a.memoizedState=e.memoizedState,a.updateQueue=e.updateQueue,l=e.dependencies,a.dependencies=null===l?null:{expirationTime:l.expirationTime,firstContext:l.firstContext,responders:l.responders}),o=o.sibling;return Nt(ys,1&ys.current|2),t.child}l=l.sibling}}else{if(!a)if(null!==(e=kn(l))){if(t.effectTag|=64,a=!0,n=e.updateQueue,null!==n&&(t.updateQueue=n,t.effectTag|=4),hr(o,!0),null===o.tail&&"hidden"===o.tailMode&&!l.alternate)return t=t.lastEffect=o.lastEffect,null!==t&&(t.nextEffect=null),null}else 2*rs()-o.renderingStartTime>o.tailExpiration&&1<n&&(t.effectTag|=64,a=!0,hr(o,!1),t.expirationTime=t.childExpirationTime=n-1);o.isBackwards?(l.sibling=t.child,t.child=l):(n=o.last,null!==n?n.sibling=l:t.child=l,o.last=l)}return null!==o.tail?(0===o.tailExpiration&&(o.tailExpiration=rs()+500),n=o.tail,o.rendering=n,o.tail=n.sibling,o.lastEffect=t.lastEffect,o.renderingStartTime=rs(),n.sibling=null,t=ys.current,Nt(ys,a?1&t|2:1&t),n):null}throw Error(r(156,t.tag))}function gr(e){switch(e.tag){case 1:Dt(e.type)&&It();var t=e.effectTag;return 4096&t?(e.effectTag=-4097&t|64,e):null;case 3:if(_n(),Lt(Ii),Lt(Di),0!=(64&(t=e.effectTag)))throw Error(r(285));return e.effectTag=-4097&t|64,e;case 5:return wn(e),null;case 13:return Lt(ys),t=e.effectTag,4096&t?(e.effectTag=-4097&t|64,e):null;case 19:return Lt(ys),null;case 4:return _n(),null;case 10:return Jt(e),null;default:return null}}function vr(e,t){return{value:e,source:t,stack:j(t)}}function yr(e,t){var n=t.source,r=t.stack;null===r&&null!==n&&(r=j(n)),null!==n&&C(n.type),t=t.value,null!==e&&1===e.tag&&C(e.type);try{console.error(t)}catch(e){setTimeout(function(){throw e})}}function _r(e,t){try{t.props=e.memoizedProps,t.state=e.memoizedState,t.componentWillUnmount()}catch(t){uo(e,t)}}function xr(e){var t=e.ref;if(null!==t)if("function"==typeof t)try{t(null)}catch(t){uo(e,t)}else t.current=null}function wr(e,t){switch(t.tag){case 0:case 11:case 15:case 22:return;case 1:if(256&t.effectTag&&null!==e){var n=e.memoizedProps,o=e.memoizedState;e=t.stateNode,t=e.getSnapshotBeforeUpdate(t.elementType===t.type?n:Qt(t.type,n),o),e.__reactInternalSnapshotBeforeUpdate=t}return;case 3:case 5:case 6:case 4:case 17:return}throw Error(r(163))}function kr(e,t){if(t=t.updateQueue,null!==(t=null!==t?t.lastEffect:null)){var n=t=t.next;do{if((n.tag&e)===e){var r=n.destroy;n.destroy=void 0,void 0!==r&&r()}n=n.next}while(n!==t)}}function Er(e,t){if(t=t.updateQueue,null!==(t=null!==t?t.lastEffect:null)){var n=t=t.next;do{if((n.tag&e)===e){var r=n.create;n.destroy=r()}n=n.next}while(n!==t)}}function Or(e,t,n){switch(n.tag){case 0:case 11:case 15:case 22:return void Er(3,n);case 1:if(e=n.stateNode,4&n.effectTag)if(null===t)e.componentDidMount();else{var o=n.elementType===n.type?t.memoizedProps:Qt(n.type,t.memoizedProps);e.componentDidUpdate(o,t.memoizedState,e.__reactInternalSnapshotBeforeUpdate)}return void(null!==(t=n.updateQueue)&&cn(n,t,e));case 3:if(null!==(t=n.updateQueue)){if(e=null,null!==n.child)switch(n.child.tag){case 5:e=n.child.stateNode;break;case 1:e=n.child.stateNode}cn(n,t,e)}return;case 5:return e=n.stateNode,void(null===t&&4&n.effectTag&&We(n.type,n.memoizedProps)&&e.focus());case 6:case 4:case 12:return;case 13:return void(null===n.memoizedState&&null!==(n=n.alternate)&&null!==(n=n.memoizedState)&&null!==(n=n.dehydrated)&&Ee(n));case 19:case 17:case 20:case 21:return}throw Error(r(163))}function Pr(e,t,n){switch("function"==typeof Ec&&Ec(t),t.tag){case 0:case 11:case 14:case 15:case 22:if(null!==(e=t.updateQueue)&&null!==(e=e.lastEffect)){var r=e.next;Wt(97<n?97:n,function(){var e=r;do{var n=e.destroy;if(void 0!==n){var o=t;try{n()}catch(e){uo(o,e)}}e=e.next}while(e!==r)})}break;case 1:xr(t),n=t.stateNode,"function"==typeof n.componentWillUnmount&&_r(t,n);break;case 5:xr(t);break;case 4:Rr(e,t,n)}}function Cr(e){var t=e.alternate;e.return=null,e.child=null,e.memoizedState=null,e.updateQueue=null,e.dependencies=null,e.alternate=null,e.firstEffect=null,e.lastEffect=null,e.pendingProps=null,e.memoizedProps=null,e.stateNode=null,null!==t&&Cr(t)}function jr(e){return 5===e.tag||3===e.tag||4===e.tag}function Sr(e){e:{for(var t=e.return;null!==t;){if(jr(t)){var n=t;break e}t=t.return}throw Error(r(160))}switch(t=n.stateNode,n.tag){case 5:var o=!1;break;case 3:case 4:t=t.containerInfo,o=!0;break;default:throw Error(r(161))}16&n.effectTag&&(G(t,""),n.effectTag&=-17);e:t:for(n=e;;){for(;null===n.sibling;){if(null===n.return||jr(n.return)){n=null;break e}n=n.return}for(n.sibling.return=n.return,n=n.sibling;5!==n.tag&&6!==n.tag&&18!==n.tag;){if(2&n.effectTag)continue t;if(null===n.child||4===n.tag)continue t;n.child.return=n,n=n.child}if(!(2&n.effectTag)){n=n.stateNode;break e}}o?Mr(e,n,t):Tr(e,n,t)}function Mr(e,t,n){var r=e.tag,o=5===r||6===r;if(o)e=o?e.stateNode:e.stateNode.instance,t?8===n.nodeType?n.parentNode.insertBefore(e,t):n.insertBefore(e,t):(8===n.nodeType?(t=n.parentNode,t.insertBefore(e,n)):(t=n,t.appendChild(e)),null!==(n=n._reactRootContainer)&&void 0!==n||null!==t.onclick||(t.onclick=Ie));else if(4!==r&&null!==(e=e.child))for(Mr(e,t,n),e=e.sibling;null!==e;)Mr(e,t,n),e=e.sibling}function Tr(e,t,n){var r=e.tag,o=5===r||6===r;if(o)e=o?e.stateNode:e.stateNode.instance,t?n.insertBefore(e,t):n.appendChild(e);else if(4!==r&&null!==(e=e.child))for(Tr(e,t,n),e=e.sibling;null!==e;)Tr(e,t,n),e=e.sibling}function Rr(e,t,n){for(var o,a,l=t,i=!1;;){if(!i){i=l.return;e:for(;;){if(null===i)throw Error(r(160));switch(o=i.stateNode,i.tag){case 5:a=!1;break e;case 3:case 4:o=o.containerInfo,a=!0;break e}i=i.return}i=!0}if(5===l.tag||6===l.tag){e:for(var s=e,c=l,u=n,f=c;;)if(Pr(s,f,u),null!==f.child&&4!==f.tag)f.child.return=f,f=f.child;else{if(f===c)break e;for(;null===f.sibling;){if(null===f.return||f.return===c)break e;f=f.return}f.sibling.return=f.return,f=f.sibling}a?(s=o,c=l.stateNode,8===s.nodeType?s.parentNode.removeChild(c):s.removeChild(c)):o.removeChild(l.stateNode)}else if(4===l.tag){if(null!==l.child){o=l.stateNode.containerInfo,a=!0,l.child.return=l,l=l.child;continue}}else if(Pr(e,l,n),null!==l.child){l.child.return=l,l=l.child;continue}if(l===t)break;for(;null===l.sibling;){if(null===l.return||l.return===t)return;l=l.return,4===l.tag&&(i=!1)}l.sibling.return=l.return,l=l.sibling}}function Lr(e,t){switch(t.tag){case 0:case 11:case 14:case 15:case 22:return void kr(3,t);case 1:return;case 5:var n=t.stateNode;if(null!=n){var o=t.memoizedProps,a=null!==e?e.memoizedProps:o;e=t.type;var l=t.updateQueue;if(t.updateQueue=null,null!==l){for(n[Sl]=o,"input"===e&&"radio"===o.type&&null!=o.name&&D(n,o),Ae(e,a),t=Ae(e,o),a=0;a<l.length;a+=2){var i=l[a],s=l[a+1];"style"===i?Le(n,s):"dangerouslySetInnerHTML"===i?Aa(n,s):"children"===i?G(n,s):E(n,i,s,t)}switch(e){case"input":I(n,o);break;case"textarea":V(n,o);break;case"select":t=n._wrapperState.wasMultiple,n._wrapperState.wasMultiple=!!o.multiple,e=o.value,null!=e?q(n,!!o.multiple,e,!1):t!==!!o.multiple&&(null!=o.defaultValue?q(n,!!o.multiple,o.defaultValue,!0):q(n,!!o.multiple,o.multiple?[]:"",!1))}}}return;case 6:if(null===t.stateNode)throw Error(r(162));return void(t.stateNode.nodeValue=t.memoizedProps);case 3:return t=t.stateNode,void(t.hydrate&&(t.hydrate=!1,Ee(t.containerInfo)));case 12:return;case 13:if(n=t,null===t.memoizedState?o=!1:(o=!0,n=t.child,uc=rs()),null!==n)e:for(e=n;;){if(5===e.tag)l=e.stateNode,o?(l=l.style,"function"==typeof l.setProperty?l.setProperty("display","none","important"):l.display="none"):(l=e.stateNode,a=e.memoizedProps.style,a=void 0!==a&&null!==a&&a.hasOwnProperty("display")?a.display:null,l.style.display=Re("display",a));else if(6===e.tag)e.stateNode.nodeValue=o?"":e.memoizedProps;else{if(13===e.tag&&null!==e.memoizedState&&null===e.memoizedState.dehydrated){l=e.child.sibling,l.return=e,e=l;continue}if(null!==e.child){e.child.return=e,e=e.child;continue}}if(e===n)break;for(;null===e.sibling;){if(null===e.return||e.return===n)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}return void Nr(t);case 19:return void Nr(t);case 17:return}throw Error(r(163))}function Nr(e){var t=e.updateQueue;if(null!==t){e.updateQueue=null;var n=e.stateNode;null===n&&(n=e.stateNode=new Hs),t.forEach(function(t){var r=po.bind(null,e,t);n.has(t)||(n.add(t),t.then(r,r))})}}function Ar(e,t,n){n=on(n,null),n.tag=3,n.payload={element:null};var r=t.value;return n.callback=function(){pc||(pc=!0,bc=r),yr(e,t)},n}function Dr(e,t,n){n=on(n,null),n.tag=3;var r=e.type.getDerivedStateFromError;if("function"==typeof r){var o=t.value;n.payload=function(){return yr(e,t),r(o)}}var a=e.stateNode;return null!==a&&"function"==typeof a.componentDidCatch&&(n.callback=function(){"function"!=typeof r&&(null===hc?hc=new Set([this]):hc.add(this),yr(e,t));var n=t.stack;this.componentDidCatch(t.value,{componentStack:null!==n?n:""})}),n}function Ir(){return($s&(Vs|Bs))!==Ys?1073741821-(rs()/10|0):0!==wc?wc:wc=1073741821-(rs()/10|0)}function Hr(e,t,n){if(0==(2&(t=t.mode)))return 1073741823;var o=qt();if(0==(4&t))return 99===o?1073741823:1073741822;if(($s&Vs)!==Ys)return nc;if(null!==n)e=Gt(e,0|n.timeoutMs||5e3,250);else switch(o){case 99:e=1073741823;break;case 98:e=Gt(e,150,100);break;case 97:case 96:e=Gt(e,5e3,250);break;case 95:e=2;break;default:throw Error(r(326))}return null!==ec&&e===nc&&--e,e}function Fr(e,t){if(50<_c)throw _c=0,xc=null,Error(r(185));if(null!==(e=Ur(e,t))){var n=qt();1073741823===t?($s&Ws)!==Ys&&($s&(Vs|Bs))===Ys?Wr(e):(qr(e),$s===Ys&&Kt()):qr(e),(4&$s)===Ys||98!==n&&99!==n||(null===yc?yc=new Map([[e,t]]):(void 0===(n=yc.get(e))||n>t)&&yc.set(e,t))}}function Ur(e,t){e.expirationTime<t&&(e.expirationTime=t);var n=e.alternate;null!==n&&n.expirationTime<t&&(n.expirationTime=t);var r=e.return,o=null;if(null===r&&3===e.tag)o=e.stateNode;else for(;null!==r;){if(n=r.alternate,r.childExpirationTime<t&&(r.childExpirationTime=t),null!==n&&n.childExpirationTime<t&&(n.childExpirationTime=t),null===r.return&&3===r.tag){o=r.stateNode;break}r=r.return}return null!==o&&(ec===o&&(Jr(t),rc===Zs&&Po(o,nc)),Co(o,t)),o}function zr(e){var t=e.lastExpiredTime;if(0!==t)return t;if(t=e.firstPendingTime,!Oo(e,t))return t;var n=e.lastPingedTime;return e=e.nextKnownPendingLevel,e=n>e?n:e,2>=e&&t!==e?0:e}function qr(e){if(0!==e.lastExpiredTime)e.callbackExpirationTime=1073741823,e.callbackPriority=99,e.callbackNode=Bt(Wr.bind(null,e));else{var t=zr(e),n=e.callbackNode;if(0===t)null!==n&&(e.callbackNode=null,e.callbackExpirationTime=0,e.callbackPriority=90);else{var r=Ir();if(1073741823===t?r=99:1===t||2===t?r=95:(r=10*(1073741821-t)-10*(1073741821-r),r=0>=r?99:250>=r?98:5250>=r?97:95),null!==n){var o=e.callbackPriority;if(e.callbackExpirationTime===t&&o>=r)return;n!==Qi&&zi(n)}e.callbackExpirationTime=t,e.callbackPriority=r,t=1073741823===t?Bt(Wr.bind(null,e)):Vt(r,Yr.bind(null,e),{timeout:10*(1073741821-t)-rs()}),e.callbackNode=t}}}function Yr(e,t){if(wc=0,t)return t=Ir(),jo(e,t),qr(e),null;var n=zr(e);if(0!==n){if(t=e.callbackNode,($s&(Vs|Bs))!==Ys)throw Error(r(327));if(io(),e===ec&&n===nc||Xr(e,n),null!==tc){var o=$s;$s|=Vs;for(var a=Qr();;)try{eo();break}catch(t){Gr(e,t)}if(Zt(),$s=o,zs.current=a,rc===Xs)throw t=oc,Xr(e,n),Po(e,n),qr(e),t;if(null===tc)switch(a=e.finishedWork=e.current.alternate,e.finishedExpirationTime=n,o=rc,ec=null,o){case Ks:case Xs:throw Error(r(345));case Gs:jo(e,2<n?2:n);break;case Qs:if(Po(e,n),o=e.lastSuspendedTime,n===o&&(e.nextKnownPendingLevel=ro(a)),1073741823===ac&&10<(a=uc+fc-rs())){if(cc){var l=e.lastPingedTime;if(0===l||l>=n){e.lastPingedTime=n,Xr(e,n);break}}if(0!==(l=zr(e))&&l!==n)break;if(0!==o&&o!==n){e.lastPingedTime=o;break}e.timeoutHandle=Ol(oo.bind(null,e),a);break}oo(e);break;case Zs:if(Po(e,n),o=e.lastSuspendedTime,n===o&&(e.nextKnownPendingLevel=ro(a)),cc&&(0===(a=e.lastPingedTime)||a>=n)){e.lastPingedTime=n,Xr(e,n);break}if(0!==(a=zr(e))&&a!==n)break;if(0!==o&&o!==n){e.lastPingedTime=o;break}if(1073741823!==lc?o=10*(1073741821-lc)-rs():1073741823===ac?o=0:(o=10*(1073741821-ac)-5e3,a=rs(),n=10*(1073741821-n)-a,o=a-o,0>o&&(o=0),o=(120>o?120:480>o?480:1080>o?1080:1920>o?1920:3e3>o?3e3:4320>o?4320:1960*Us(o/1960))-o,n<o&&(o=n)),10<o){e.timeoutHandle=Ol(oo.bind(null,e),o);break}oo(e);break;case Js:if(1073741823!==ac&&null!==ic){l=ac;var i=ic;if(o=0|i.busyMinDurationMs,0>=o?o=0:(a=0|i.busyDelayMs,l=rs()-(10*(1073741821-l)-(0|i.timeoutMs||5e3)),o=l<=a?0:a+o-l),10<o){Po(e,n),e.timeoutHandle=Ol(oo.bind(null,e),o);break}}oo(e);break;default:throw Error(r(329))}if(qr(e),e.callbackNode===t)return Yr.bind(null,e)}}return null}function Wr(e){var t=e.lastExpiredTime;if(t=0!==t?t:1073741823,($s&(Vs|Bs))!==Ys)throw Error(r(327));if(io(),e===ec&&t===nc||Xr(e,t),null!==tc){var n=$s;$s|=Vs;for(var o=Qr();;)try{$r();break}catch(t){Gr(e,t)}if(Zt(),$s=n,zs.current=o,rc===Xs)throw n=oc,Xr(e,t),Po(e,t),qr(e),n;if(null!==tc)throw Error(r(261));e.finishedWork=e.current.alternate,e.finishedExpirationTime=t,ec=null,oo(e),qr(e)}return null}function Vr(){if(null!==yc){var e=yc;yc=null,e.forEach(function(e,t){jo(t,e),qr(t)}),Kt()}}function Br(e,t){var n=$s;$s|=1;try{return e(t)}finally{($s=n)===Ys&&Kt()}}function Kr(e,t){var n=$s;$s&=-2,$s|=Ws;try{return e(t)}finally{($s=n)===Ys&&Kt()}}function Xr(e,t){e.finishedWork=null,e.finishedExpirationTime=0;var n=e.timeoutHandle;if(-1!==n&&(e.timeoutHandle=-1,Pl(n)),null!==tc)for(n=tc.return;null!==n;){var r=n;switch(r.tag){case 1:r=r.type.childContextTypes,null!==r&&void 0!==r&&It();break;case 3:_n(),Lt(Ii),Lt(Di);break;case 5:wn(r);break;case 4:_n();break;case 13:case 19:Lt(ys);break;case 10:Jt(r)}n=n.return}ec=e,tc=yo(e.current,null),nc=t,rc=Ks,oc=null,lc=ac=1073741823,ic=null,sc=0,cc=!1}function Gr(e,t){for(;;){try{if(Zt(),_s.current=Cs,Ps)for(var n=ks.memoizedState;null!==n;){var r=n.queue;null!==r&&(r.pending=null),n=n.next}if(ws=0,Os=Es=ks=null,Ps=!1,null===tc||null===tc.return)return rc=Xs,oc=t,tc=null;e:{var o=e,a=tc.return,l=tc,i=t;if(t=nc,l.effectTag|=2048,l.firstEffect=l.lastEffect=null,null!==i&&"object"==typeof i&&"function"==typeof i.then){var s=i;if(0==(2&l.mode)){var c=l.alternate;c?(l.updateQueue=c.updateQueue,l.memoizedState=c.memoizedState,l.expirationTime=c.expirationTime):(l.updateQueue=null,l.memoizedState=null)}var u=0!=(1&ys.current),f=a;do{var d;if(d=13===f.tag){var p=f.memoizedState;if(null!==p)d=null!==p.dehydrated;else{var b=f.memoizedProps;d=void 0!==b.fallback&&(!0!==b.unstable_avoidThisFallback||!u)}}if(d){var h=f.updateQueue;if(null===h){var m=new Set;m.add(s),f.updateQueue=m}else h.add(s);if(0==(2&f.mode)){if(f.effectTag|=64,l.effectTag&=-2981,1===l.tag)if(null===l.alternate)l.tag=17;else{var g=on(1073741823,null);g.tag=2,an(l,g)}l.expirationTime=1073741823;break e}i=void 0,l=t;var v=o.pingCache;if(null===v?(v=o.pingCache=new Fs,i=new Set,v.set(s,i)):void 0===(i=v.get(s))&&(i=new Set,v.set(s,i)),!i.has(l)){i.add(l);var y=fo.bind(null,o,s,l);s.then(y,y)}f.effectTag|=4096,f.expirationTime=t;break e}f=f.return}while(null!==f);i=Error((C(l.type)||"A React component")+" suspended while rendering, but no fallback UI was specified.\n\nAdd a <Suspense fallback=...> component higher in the tree to provide a loading indicator or placeholder to display."+j(l))}rc!==Js&&(rc=Gs),i=vr(i,l),f=a;do{switch(f.tag){case 3:s=i,f.effectTag|=4096,f.expirationTime=t;ln(f,Ar(f,s,t));break e;case 1:s=i;var _=f.type,x=f.stateNode;if(0==(64&f.effectTag)&&("function"==typeof _.getDerivedStateFromError||null!==x&&"function"==typeof x.componentDidCatch&&(null===hc||!hc.has(x)))){f.effectTag|=4096,f.expirationTime=t;ln(f,Dr(f,s,t));break e}}f=f.return}while(null!==f)}tc=no(tc)}catch(e){t=e;continue}break}}function Qr(){var e=zs.current;return zs.current=Cs,null===e?Cs:e}function Zr(e,t){e<ac&&2<e&&(ac=e),null!==t&&e<lc&&2<e&&(lc=e,ic=t)}function Jr(e){e>sc&&(sc=e)}function $r(){for(;null!==tc;)tc=to(tc)}function eo(){for(;null!==tc&&!Zi();)tc=to(tc)}function to(e){var t=Is(e.alternate,e,nc);return e.memoizedProps=e.pendingProps,null===t&&(t=no(e)),qs.current=null,t}function no(e){tc=e;do{var t=tc.alternate;if(e=tc.return,0==(2048&tc.effectTag)){if(t=mr(t,tc,nc),1===nc||1!==tc.childExpirationTime){for(var n=0,r=tc.child;null!==r;){var o=r.expirationTime,a=r.childExpirationTime;o>n&&(n=o),a>n&&(n=a),r=r.sibling}tc.childExpirationTime=n}if(null!==t)return t;null!==e&&0==(2048&e.effectTag)&&(null===e.firstEffect&&(e.firstEffect=tc.firstEffect),null!==tc.lastEffect&&(null!==e.lastEffect&&(e.lastEffect.nextEffect=tc.firstEffect),e.lastEffect=tc.lastEffect),1<tc.effectTag&&(null!==e.lastEffect?e.lastEffect.nextEffect=tc:e.firstEffect=tc,e.lastEffect=tc))}else{if(null!==(t=gr(tc)))return t.effectTag&=2047,t;null!==e&&(e.firstEffect=e.lastEffect=null,e.effectTag|=2048)}if(null!==(t=tc.sibling))return t;tc=e}while(null!==tc);return rc===Ks&&(rc=Js),null}function ro(e){var t=e.expirationTime;return e=e.childExpirationTime,t>e?t:e}function oo(e){var t=qt();return Wt(99,ao.bind(null,e,t)),null}function ao(e,t){do{io()}while(null!==gc);if(($s&(Vs|Bs))!==Ys)throw Error(r(327));var n=e.finishedWork,o=e.finishedExpirationTime;if(null===n)return null;if(e.finishedWork=null,e.finishedExpirationTime=0,n===e.current)throw Error(r(177));e.callbackNode=null,e.callbackExpirationTime=0,e.callbackPriority=90,e.nextKnownPendingLevel=0;var a=ro(n);if(e.firstPendingTime=a,o<=e.lastSuspendedTime?e.firstSuspendedTime=e.lastSuspendedTime=e.nextKnownPendingLevel=0:o<=e.firstSuspendedTime&&(e.firstSuspendedTime=o-1),o<=e.lastPingedTime&&(e.lastPingedTime=0),o<=e.lastExpiredTime&&(e.lastExpiredTime=0),e===ec&&(tc=ec=null,nc=0),1<n.effectTag?null!==n.lastEffect?(n.lastEffect.nextEffect=n,a=n.firstEffect):a=n:a=n.firstEffect,null!==a){var l=$s;$s|=Bs,qs.current=null,kl=bl;var i=qe();if(Ye(i)){if("selectionStart"in i)var s={start:i.selectionStart,end:i.selectionEnd};else e:{s=(s=i.ownerDocument)&&s.defaultView||window;var c=s.getSelection&&s.getSelection();if(c&&0!==c.rangeCount){s=c.anchorNode;var u=c.anchorOffset,f=c.focusNode;c=c.focusOffset;try{s.nodeType,f.nodeType}catch(e){s=null;break e}var d=0,p=-1,b=-1,h=0,m=0,g=i,v=null;t:for(;;){for(var y;g!==s||0!==u&&3!==g.nodeType||(p=d+u),g!==f||0!==c&&3!==g.nodeType||(b=d+c),3===g.nodeType&&(d+=g.nodeValue.length),null!==(y=g.firstChild);)v=g,g=y;for(;;){if(g===i)break t;if(v===s&&++h===u&&(p=d),v===f&&++m===c&&(b=d),null!==(y=g.nextSibling))break;g=v,v=g.parentNode}g=y}s=-1===p||-1===b?null:{start:p,end:b}}else s=null}s=s||{start:0,end:0}}else s=null;El={activeElementDetached:null,focusedElem:i,selectionRange:s},bl=!1,dc=a;do{try{lo()}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);dc=a;do{try{for(i=e,s=t;null!==dc;){var _=dc.effectTag;if(16&_&&G(dc.stateNode,""),128&_){var x=dc.alternate;if(null!==x){var w=x.ref;null!==w&&("function"==typeof w?w(null):w.current=null)}}switch(1038&_){case 2:Sr(dc),dc.effectTag&=-3;break;case 6:Sr(dc),dc.effectTag&=-3,Lr(dc.alternate,dc);break;case 1024:dc.effectTag&=-1025;break;case 1028:dc.effectTag&=-1025,Lr(dc.alternate,dc);break;case 4:Lr(dc.alternate,dc);break;case 8:u=dc,Rr(i,u,s),Cr(u)}dc=dc.nextEffect}}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);if(w=El,x=qe(),_=w.focusedElem,s=w.selectionRange,x!==_&&_&&_.ownerDocument&&ze(_.ownerDocument.documentElement,_)){null!==s&&Ye(_)&&(x=s.start,w=s.end,void 0===w&&(w=x),"selectionStart"in _?(_.selectionStart=x,_.selectionEnd=Math.min(w,_.value.length)):(w=(x=_.ownerDocument||document)&&x.defaultView||window,w.getSelection&&(w=w.getSelection(),u=_.textContent.length,i=Math.min(s.start,u),s=void 0===s.end?i:Math.min(s.end,u),!w.extend&&i>s&&(u=s,s=i,i=u),u=Ue(_,i),f=Ue(_,s),u&&f&&(1!==w.rangeCount||w.anchorNode!==u.node||w.anchorOffset!==u.offset||w.focusNode!==f.node||w.focusOffset!==f.offset)&&(x=x.createRange(),x.setStart(u.node,u.offset),w.removeAllRanges(),i>s?(w.addRange(x),w.extend(f.node,f.offset)):(x.setEnd(f.node,f.offset),w.addRange(x)))))),x=[];for(w=_;w=w.parentNode;)1===w.nodeType&&x.push({element:w,left:w.scrollLeft,top:w.scrollTop});for("function"==typeof _.focus&&_.focus(),_=0;_<x.length;_++)w=x[_],w.element.scrollLeft=w.left,w.element.scrollTop=w.top}bl=!!kl,El=kl=null,e.current=n,dc=a;do{try{for(_=e;null!==dc;){var k=dc.effectTag;if(36&k&&Or(_,dc.alternate,dc),128&k){x=void 0;var E=dc.ref;if(null!==E){var O=dc.stateNode;switch(dc.tag){case 5:x=O;break;default:x=O}"function"==typeof E?E(x):E.current=x}}dc=dc.nextEffect}}catch(e){if(null===dc)throw Error(r(330));uo(dc,e),dc=dc.nextEffect}}while(null!==dc);dc=null,Ji(),$s=l}else e.current=n;if(mc)mc=!1,gc=e,vc=t;else for(dc=a;null!==dc;)t=dc.nextEffect,dc.nextEffect=null,dc=t;if(t=e.firstPendingTime,0===t&&(hc=null),1073741823===t?e===xc?_c++:(_c=0,xc=e):_c=0,"function"==typeof kc&&kc(n.stateNode,o),qr(e),pc)throw pc=!1,e=bc,bc=null,e;return($s&Ws)!==Ys?null:(Kt(),null)}function lo(){for(;null!==dc;){var e=dc.effectTag;0!=(256&e)&&wr(dc.alternate,dc),0==(512&e)||mc||(mc=!0,Vt(97,function(){return io(),null})),dc=dc.nextEffect}}function io(){if(90!==vc){var e=97<vc?97:vc;return vc=90,Wt(e,so)}}function so(){if(null===gc)return!1;var e=gc;if(gc=null,($s&(Vs|Bs))!==Ys)throw Error(r(331));var t=$s;for($s|=Bs,e=e.current.firstEffect;null!==e;){try{var n=e;if(0!=(512&n.effectTag))switch(n.tag){case 0:case 11:case 15:case 22:kr(5,n),Er(5,n)}}catch(t){if(null===e)throw Error(r(330));uo(e,t)}n=e.nextEffect,e.nextEffect=null,e=n}return $s=t,Kt(),!0}function co(e,t,n){t=vr(n,t),t=Ar(e,t,1073741823),an(e,t),null!==(e=Ur(e,1073741823))&&qr(e)}function uo(e,t){if(3===e.tag)co(e,e,t);else for(var n=e.return;null!==n;){if(3===n.tag){co(n,e,t);break}if(1===n.tag){var r=n.stateNode;if("function"==typeof n.type.getDerivedStateFromError||"function"==typeof r.componentDidCatch&&(null===hc||!hc.has(r))){e=vr(t,e),e=Dr(n,e,1073741823),an(n,e),n=Ur(n,1073741823),null!==n&&qr(n);break}}n=n.return}}function fo(e,t,n){var r=e.pingCache;null!==r&&r.delete(t),ec===e&&nc===n?rc===Zs||rc===Qs&&1073741823===ac&&rs()-uc<fc?Xr(e,nc):cc=!0:Oo(e,n)&&(0!==(t=e.lastPingedTime)&&t<n||(e.lastPingedTime=n,qr(e)))}function po(e,t){var n=e.stateNode;null!==n&&n.delete(t),t=0,0===t&&(t=Ir(),t=Hr(t,e,null)),null!==(e=Ur(e,t))&&qr(e)}function bo(e){if("undefined"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__)return!1;var t=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(t.isDisabled||!t.supportsFiber)return!0;try{var n=t.inject(e);kc=function(e){try{t.onCommitFiberRoot(n,e,void 0,64==(64&e.current.effectTag))}catch(e){}},Ec=function(e){try{t.onCommitFiberUnmount(n,e)}catch(e){}}}catch(e){}return!0}function ho(e,t,n,r){this.tag=e,this.key=n,this.sibling=this.child=this.return=this.stateNode=this.type=this.elementType=null,this.index=0,this.ref=null,this.pendingProps=t,this.dependencies=this.memoizedState=this.updateQueue=this.memoizedProps=null,this.mode=r,this.effectTag=0,this.lastEffect=this.firstEffect=this.nextEffect=null,this.childExpirationTime=this.expirationTime=0,this.alternate=null}function mo(e,t,n,r){return new ho(e,t,n,r)}function go(e){return!(!(e=e.prototype)||!e.isReactComponent)}function vo(e){if("function"==typeof e)return go(e)?1:0;if(void 0!==e&&null!==e){if((e=e.$$typeof)===Ca)return 11;if(e===Ma)return 14}return 2}function yo(e,t){var n=e.alternate;return null===n?(n=mo(e.tag,t,e.key,e.mode),n.elementType=e.elementType,n.type=e.type,n.stateNode=e.stateNode,n.alternate=e,e.alternate=n):(n.pendingProps=t,n.effectTag=0,n.nextEffect=null,n.firstEffect=null,n.lastEffect=null),n.childExpirationTime=e.childExpirationTime,n.expirationTime=e.expirationTime,n.child=e.child,n.memoizedProps=e.memoizedProps,n.memoizedState=e.memoizedState,n.updateQueue=e.updateQueue,t=e.dependencies,n.dependencies=null===t?null:{expirationTime:t.expirationTime,firstContext:t.firstContext,responders:t.responders},n.sibling=e.sibling,n.index=e.index,n.ref=e.ref,n}function _o(e,t,n,o,a,l){var i=2;if(o=e,"function"==typeof e)go(e)&&(i=1);else if("string"==typeof e)i=5;else e:switch(e){case xa:return xo(n.children,a,l,t);case Pa:i=8,a|=7;break;case wa:i=8,a|=1;break;case ka:return e=mo(12,n,t,8|a),e.elementType=ka,e.type=ka,e.expirationTime=l,e;case ja:return e=mo(13,n,t,a),e.type=ja,e.elementType=ja,e.expirationTime=l,e;case Sa:return e=mo(19,n,t,a),e.elementType=Sa,e.expirationTime=l,e;default:if("object"==typeof e&&null!==e)switch(e.$$typeof){case Ea:i=10;break e;case Oa:i=9;break e;case Ca:i=11;break e;case Ma:i=14;break e;case Ta:i=16,o=null;break e;case Ra:i=22;break e}throw Error(r(130,null==e?e:typeof e,""))}return t=mo(i,n,t,a),t.elementType=e,t.type=o,t.expirationTime=l,t}function xo(e,t,n,r){return e=mo(7,e,r,t),e.expirationTime=n,e}function wo(e,t,n){return e=mo(6,e,null,t),e.expirationTime=n,e}function ko(e,t,n){return t=mo(4,null!==e.children?e.children:[],e.key,t),t.expirationTime=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function Eo(e,t,n){this.tag=t,this.current=null,this.containerInfo=e,this.pingCache=this.pendingChildren=null,this.finishedExpirationTime=0,this.finishedWork=null,this.timeoutHandle=-1,this.pendingContext=this.context=null,this.hydrate=n,this.callbackNode=null,this.callbackPriority=90,this.lastExpiredTime=this.lastPingedTime=this.nextKnownPendingLevel=this.lastSuspendedTime=this.firstSuspendedTime=this.firstPendingTime=0}function Oo(e,t){var n=e.firstSuspendedTime;return e=e.lastSuspendedTime,0!==n&&n>=t&&e<=t}function Po(e,t){var n=e.firstSuspendedTime,r=e.lastSuspendedTime;n<t&&(e.firstSuspendedTime=t),(r>t||0===n)&&(e.lastSuspendedTime=t),t<=e.lastPingedTime&&(e.lastPingedTime=0),t<=e.lastExpiredTime&&(e.lastExpiredTime=0)}function Co(e,t){t>e.firstPendingTime&&(e.firstPendingTime=t);var n=e.firstSuspendedTime;0!==n&&(t>=n?e.firstSuspendedTime=e.lastSuspendedTime=e.nextKnownPendingLevel=0:t>=e.lastSuspendedTime&&(e.lastSuspendedTime=t+1),t>e.nextKnownPendingLevel&&(e.nextKnownPendingLevel=t))}function jo(e,t){var n=e.lastExpiredTime;(0===n||n>t)&&(e.lastExpiredTime=t)}function So(e,t,n,o){var a=t.current,l=Ir(),i=cs.suspense;l=Hr(l,a,i);e:if(n){n=n._reactInternalFiber;t:{if($(n)!==n||1!==n.tag)throw Error(r(170));var s=n;do{switch(s.tag){case 3:s=s.stateNode.context;break t;case 1:if(Dt(s.type)){s=s.stateNode.__reactInternalMemoizedMergedChildContext;break t}}s=s.return}while(null!==s);throw Error(r(171))}if(1===n.tag){var c=n.type;if(Dt(c)){n=Ft(n,c,s);break e}}n=s}else n=Ai;return null===t.context?t.context=n:t.pendingContext=n,t=on(l,i),t.payload={element:e},o=void 0===o?null:o,null!==o&&(t.callback=o),an(a,t),Fr(a,l),l}function Mo(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:default:return e.child.stateNode}}function To(e,t){null!==(e=e.memoizedState)&&null!==e.dehydrated&&e.retryTime<t&&(e.retryTime=t)}function Ro(e,t){To(e,t),(e=e.alternate)&&To(e,t)}function Lo(e,t,n){n=null!=n&&!0===n.hydrate;var r=new Eo(e,t,n),o=mo(3,null,null,2===t?7:1===t?3:0);r.current=o,o.stateNode=r,nn(o),e[Ml]=r.current,n&&0!==t&&be(e,9===e.nodeType?e:e.ownerDocument),this._internalRoot=r}function No(e){return!(!e||1!==e.nodeType&&9!==e.nodeType&&11!==e.nodeType&&(8!==e.nodeType||" react-mount-point-unstable "!==e.nodeValue))}function Ao(e,t){if(t||(t=e?9===e.nodeType?e.documentElement:e.firstChild:null,t=!(!t||1!==t.nodeType||!t.hasAttribute("data-reactroot"))),!t)for(var n;n=e.lastChild;)e.removeChild(n);return new Lo(e,0,t?{hydrate:!0}:void 0)}function Do(e,t,n,r,o){var a=n._reactRootContainer;if(a){var l=a._internalRoot;if("function"==typeof o){var i=o;o=function(){var e=Mo(l);i.call(e)}}So(t,l,e,o)}else{if(a=n._reactRootContainer=Ao(n,r),l=a._internalRoot,"function"==typeof o){var s=o;o=function(){var e=Mo(l);s.call(e)}}Kr(function(){So(t,l,e,o)})}return Mo(l)}function Io(e,t,n){var r=3<arguments.length&&void 0!==arguments[3]?arguments[3]:null;return{$$typeof:_a,key:null==r?null:""+r,children:e,containerInfo:t,implementation:n}}function Ho(e,t){var n=2<arguments.length&&void 0!==arguments[2]?arguments[2]:null;if(!No(t))throw Error(r(200));return Io(e,t,null,n)}var Fo=n(0),Uo=n(14),zo=n(47);if(!Fo)throw Error(r(227));var qo=!1,Yo=null,Wo=!1,Vo=null,Bo={onError:function(e){qo=!0,Yo=e}},Ko=null,Xo=null,Go=null,Qo=null,Zo={},Jo=[],$o={},ea={},ta={},na=!("undefined"==typeof window||void 0===window.document||void 0===window.document.createElement),ra=null,oa=null,aa=null,la=b,ia=!1,sa=!1,ca=/^[:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD][:A-Z_a-z\u00C0-\u00D6\u00D8-\u00F6\u00F8-\u02FF\u0370-\u037D\u037F-\u1FFF\u200C-\u200D\u2070-\u218F\u2C00-\u2FEF\u3001-\uD7FF\uF900-\uFDCF\uFDF0-\uFFFD\-.0-9\u00B7\u0300-\u036F\u203F-\u2040]*$/,ua=Object.prototype.hasOwnProperty,fa={},da={},pa={};"children dangerouslySetInnerHTML defaultValue defaultChecked innerHTML suppressContentEditableWarning suppressHydrationWarning style".split(" ").forEach(function(e){pa[e]=new w(e,0,!1,e,null,!1)}),[["acceptCharset","accept-charset"],["className","class"],["htmlFor","for"],["httpEquiv","http-equiv"]].forEach(function(e){var t=e[0];pa[t]=new w(t,1,!1,e[1],null,!1)}),["contentEditable","draggable","spellCheck","value"].forEach(function(e){pa[e]=new w(e,2,!1,e.toLowerCase(),null,!1)}),["autoReverse","externalResourcesRequired","focusable","preserveAlpha"].forEach(function(e){pa[e]=new w(e,2,!1,e,null,!1)}),"allowFullScreen async autoFocus autoPlay controls default defer disabled disablePictureInPicture formNoValidate hidden loop noModule noValidate open playsInline readOnly required reversed scoped seamless itemScope".split(" ").forEach(function(e){pa[e]=new w(e,3,!1,e.toLowerCase(),null,!1)}),["checked","multiple","muted","selected"].forEach(function(e){pa[e]=new w(e,3,!0,e,null,!1)}),["capture","download"].forEach(function(e){pa[e]=new w(e,4,!1,e,null,!1)}),["cols","rows","size","span"].forEach(function(e){pa[e]=new w(e,6,!1,e,null,!1)}),["rowSpan","start"].forEach(function(e){pa[e]=new w(e,5,!1,e.toLowerCase(),null,!1)});var ba=/[\-:]([a-z])/g;"accent-height alignment-baseline arabic-form baseline-shift cap-height clip-path clip-rule color-interpolation color-interpolation-filters color-profile color-rendering dominant-baseline enable-background fill-opacity fill-rule flood-color flood-opacity font-family font-size font-size-adjust font-stretch font-style font-variant font-weight glyph-name glyph-orientation-horizontal glyph-orientation-vertical horiz-adv-x horiz-origin-x image-rendering letter-spacing lighting-color marker-end marker-mid marker-start overline-position overline-thickness paint-order panose-1 pointer-events rendering-intent shape-rendering stop-color stop-opacity strikethrough-position strikethrough-thickness stroke-dasharray stroke-dashoffset stroke-linecap stroke-linejoin stroke-miterlimit stroke-opacity stroke-width text-anchor text-decoration text-rendering underline-position underline-thickness unicode-bidi unicode-range units-per-em v-alphabetic v-hanging v-ideographic v-mathematical vector-effect vert-adv-y vert-origin-x vert-origin-y word-spacing writing-mode xmlns:xlink x-height".split(" ").forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,null,!1)}),"xlink:actuate xlink:arcrole xlink:role xlink:show xlink:title xlink:type".split(" ").forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,"http://www.w3.org/1999/xlink",!1)}),["xml:base","xml:lang","xml:space"].forEach(function(e){var t=e.replace(ba,k);pa[t]=new w(t,1,!1,e,"http://www.w3.org/XML/1998/namespace",!1)}),["tabIndex","crossOrigin"].forEach(function(e){pa[e]=new w(e,1,!1,e.toLowerCase(),null,!1)}),pa.xlinkHref=new w("xlinkHref",1,!1,"xlink:href","http://www.w3.org/1999/xlink",!0),["src","href","action","formAction"].forEach(function(e){pa[e]=new w(e,1,!1,e.toLowerCase(),null,!0)});var ha=Fo.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED;ha.hasOwnProperty("ReactCurrentDispatcher")||(ha.ReactCurrentDispatcher={current:null}),ha.hasOwnProperty("ReactCurrentBatchConfig")||(ha.ReactCurrentBatchConfig={suspense:null});var ma,ga=/^(.*)[\\\/]/,va="function"==typeof Symbol&&Symbol.for,ya=va?Symbol.for("react.element"):60103,_a=va?Symbol.for("react.portal"):60106,xa=va?Symbol.for("react.fragment"):60107,wa=va?Symbol.for("react.strict_mode"):60108,ka=va?Symbol.for("react.profiler"):60114,Ea=va?Symbol.for("react.provider"):60109,Oa=va?Symbol.for("react.context"):60110,Pa=va?Symbol.for("react.concurrent_mode"):60111,Ca=va?Symbol.for("react.forward_ref"):60112,ja=va?Symbol.for("react.suspense"):60113,Sa=va?Symbol.for("react.suspense_list"):60120,Ma=va?Symbol.for("react.memo"):60115,Ta=va?Symbol.for("react.lazy"):60116,Ra=va?Symbol.for("react.block"):60121,La="function"==typeof Symbol&&Symbol.iterator,Na={html:"http://www.w3.org/1999/xhtml",mathml:"http://www.w3.org/1998/Math/MathML",svg:"http://www.w3.org/2000/svg"},Aa=function(e){return"undefined"!=typeof MSApp&&MSApp.execUnsafeLocalFunction?function(t,n,r,o){MSApp.execUnsafeLocalFunction(function(){return e(t,n)})}:e}(function(e,t){if(e.namespaceURI!==Na.svg||"innerHTML"in e)e.innerHTML=t;else{for(ma=ma||document.createElement("div"),ma.innerHTML="<svg>"+t.valueOf().toString()+"</svg>",t=ma.firstChild;e.firstChild;)e.removeChild(e.firstChild);for(;t.firstChild;)e.appendChild(t.firstChild)}}),Da={animationend:Q("Animation","AnimationEnd"),animationiteration:Q("Animation","AnimationIteration"),animationstart:Q("Animation","AnimationStart"),transitionend:Q("Transition","TransitionEnd")},Ia={},Ha={};na&&(Ha=document.createElement("div").style,"AnimationEvent"in window||(delete Da.animationend.animation,delete Da.animationiteration.animation,delete Da.animationstart.animation),"TransitionEvent"in window||delete Da.transitionend.transition);var Fa,Ua,za,qa=Z("animationend"),Ya=Z("animationiteration"),Wa=Z("animationstart"),Va=Z("transitionend"),Ba="abort canplay canplaythrough durationchange emptied encrypted ended error loadeddata loadedmetadata loadstart pause play playing progress ratechange seeked seeking stalled suspend timeupdate volumechange waiting".split(" "),Ka=new("function"==typeof WeakMap?WeakMap:Map),Xa=null,Ga=[],Qa=!1,Za=[],Ja=null,$a=null,el=null,tl=new Map,nl=new Map,rl=[],ol="mousedown mouseup touchcancel touchend touchstart auxclick dblclick pointercancel pointerdown pointerup dragend dragstart drop compositionend compositionstart keydown keypress keyup input textInput close cancel copy cut paste click change contextmenu reset submit".split(" "),al="focus blur dragenter dragleave mouseover mouseout pointerover pointerout gotpointercapture lostpointercapture".split(" "),ll={},il=new Map,sl=new Map,cl=["abort","abort",qa,"animationEnd",Ya,"animationIteration",Wa,"animationStart","canplay","canPlay","canplaythrough","canPlayThrough","durationchange","durationChange","emptied","emptied","encrypted","encrypted","ended","ended","error","error","gotpointercapture","gotPointerCapture","load","load","loadeddata","loadedData","loadedmetadata","loadedMetadata","loadstart","loadStart","lostpointercapture","lostPointerCapture","playing","playing","progress","progress","seeking","seeking","stalled","stalled","suspend","suspend","timeupdate","timeUpdate",Va,"transitionEnd","waiting","waiting"];Oe("blur blur cancel cancel click click close close contextmenu contextMenu copy copy cut cut auxclick auxClick dblclick doubleClick dragend dragEnd dragstart dragStart drop drop focus focus input input invalid invalid keydown keyDown keypress keyPress keyup keyUp mousedown mouseDown mouseup mouseUp paste paste pause pause play play pointercancel pointerCancel pointerdown pointerDown pointerup pointerUp ratechange rateChange reset reset seeked seeked submit submit touchcancel touchCancel touchend touchEnd touchstart touchStart volumechange volumeChange".split(" "),0),Oe("drag drag dragenter dragEnter dragexit dragExit dragleave dragLeave dragover dragOver mousemove mouseMove mouseout mouseOut mouseover mouseOver pointermove pointerMove pointerout pointerOut pointerover pointerOver scroll scroll toggle toggle touchmove touchMove wheel wheel".split(" "),1),Oe(cl,2);for(var ul="change selectionchange textInput compositionstart compositionend compositionupdate".split(" "),fl=0;fl<ul.length;fl++)sl.set(ul[fl],0);var dl=zo.unstable_UserBlockingPriority,pl=zo.unstable_runWithPriority,bl=!0,hl={animationIterationCount:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridArea:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},ml=["Webkit","ms","Moz","O"];Object.keys(hl).forEach(function(e){ml.forEach(function(t){t=t+e.charAt(0).toUpperCase()+e.substring(1),hl[t]=hl[e]})});var gl=Uo({menuitem:!0},{area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0}),vl=Na.html,yl="$",_l="/$",xl="$?",wl="$!",kl=null,El=null,Ol="function"==typeof setTimeout?setTimeout:void 0,Pl="function"==typeof clearTimeout?clearTimeout:void 0,Cl=Math.random().toString(36).slice(2),jl="__reactInternalInstance$"+Cl,Sl="__reactEventHandlers$"+Cl,Ml="__reactContainere$"+Cl,Tl=null,Rl=null,Ll=null;Uo(st.prototype,{preventDefault:function(){this.defaultPrevented=!0;var e=this.nativeEvent;e&&(e.preventDefault?e.preventDefault():"unknown"!=typeof e.returnValue&&(e.returnValue=!1),this.isDefaultPrevented=lt)},stopPropagation:function(){var e=this.nativeEvent;e&&(e.stopPropagation?e.stopPropagation():"unknown"!=typeof e.cancelBubble&&(e.cancelBubble=!0),this.isPropagationStopped=lt)},persist:function(){this.isPersistent=lt},isPersistent:it,destructor:function(){var e,t=this.constructor.Interface;for(e in t)this[e]=null;this.nativeEvent=this._targetInst=this.dispatchConfig=null,this.isPropagationStopped=this.isDefaultPrevented=it,this._dispatchInstances=this._dispatchListeners=null}}),st.Interface={type:null,target:null,currentTarget:function(){return null},eventPhase:null,bubbles:null,cancelable:null,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:null,isTrusted:null},st.extend=function(e){function t(){}function n(){return r.apply(this,arguments)}var r=this;t.prototype=r.prototype;var o=new t;return Uo(o,n.prototype),n.prototype=o,n.prototype.constructor=n,n.Interface=Uo({},r.Interface,e),n.extend=r.extend,ft(n),n},ft(st);var Nl=st.extend({data:null}),Al=st.extend({data:null}),Dl=[9,13,27,32],Il=na&&"CompositionEvent"in window,Hl=null;na&&"documentMode"in document&&(Hl=document.documentMode);var Fl=na&&"TextEvent"in window&&!Hl,Ul=na&&(!Il||Hl&&8<Hl&&11>=Hl),zl=String.fromCharCode(32),ql={beforeInput:{phasedRegistrationNames:{bubbled:"onBeforeInput",captured:"onBeforeInputCapture"},dependencies:["compositionend","keypress","textInput","paste"]},compositionEnd:{phasedRegistrationNames:{bubbled:"onCompositionEnd",captured:"onCompositionEndCapture"},dependencies:"blur compositionend keydown keypress keyup mousedown".split(" ")},compositionStart:{phasedRegistrationNames:{bubbled:"onCompositionStart",captured:"onCompositionStartCapture"},dependencies:"blur compositionstart keydown keypress keyup mousedown".split(" ")},compositionUpdate:{phasedRegistrationNames:{bubbled:"onCompositionUpdate",captured:"onCompositionUpdateCapture"},dependencies:"blur compositionupdate keydown keypress keyup mousedown".split(" ")}},Yl=!1,Wl=!1,Vl={eventTypes:ql,extractEvents:function(e,t,n,r){var o;if(Il)e:{switch(e){case"compositionstart":var a=ql.compositionStart;break e;case"compositionend":a=ql.compositionEnd;break e;case"compositionupdate":a=ql.compositionUpdate;break e}a=void 0}else Wl?dt(e,n)&&(a=ql.compositionEnd):"keydown"===e&&229===n.keyCode&&(a=ql.compositionStart);return a?(Ul&&"ko"!==n.locale&&(Wl||a!==ql.compositionStart?a===ql.compositionEnd&&Wl&&(o=at()):(Tl=r,Rl="value"in Tl?Tl.value:Tl.textContent,Wl=!0)),a=Nl.getPooled(a,t,n,r),o?a.data=o:null!==(o=pt(n))&&(a.data=o),ot(a),o=a):o=null,(e=Fl?bt(e,n):ht(e,n))?(t=Al.getPooled(ql.beforeInput,t,n,r),t.data=e,ot(t)):t=null,null===o?t:null===t?o:[o,t]}},Bl={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0},Kl={change:{phasedRegistrationNames:{bubbled:"onChange",captured:"onChangeCapture"},dependencies:"blur change click focus input keydown keyup selectionchange".split(" ")}},Xl=null,Gl=null,Ql=!1;na&&(Ql=ce("input")&&(!document.documentMode||9<document.documentMode));var Zl={eventTypes:Kl,_isInputEventSupported:Ql,extractEvents:function(e,t,n,r){var o=t?Qe(t):window,a=o.nodeName&&o.nodeName.toLowerCase();if("select"===a||"input"===a&&"file"===o.type)var l=_t;else if(mt(o))if(Ql)l=Pt;else{l=Et;var i=kt}else(a=o.nodeName)&&"input"===a.toLowerCase()&&("checkbox"===o.type||"radio"===o.type)&&(l=Ot);if(l&&(l=l(e,t)))return gt(l,n,r);i&&i(e,o,t),"blur"===e&&(e=o._wrapperState)&&e.controlled&&"number"===o.type&&F(o,"number",o.value)}},Jl=st.extend({view:null,detail:null}),$l={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"},ei=0,ti=0,ni=!1,ri=!1,oi=Jl.extend({screenX:null,screenY:null,clientX:null,clientY:null,pageX:null,pageY:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,getModifierState:jt,button:null,buttons:null,relatedTarget:function(e){return e.relatedTarget||(e.fromElement===e.srcElement?e.toElement:e.fromElement)},movementX:function(e){if("movementX"in e)return e.movementX;var t=ei;return ei=e.screenX,ni?"mousemove"===e.type?e.screenX-t:0:(ni=!0,0)},movementY:function(e){if("movementY"in e)return e.movementY;var t=ti;return ti=e.screenY,ri?"mousemove"===e.type?e.screenY-t:0:(ri=!0,0)}}),ai=oi.extend({pointerId:null,width:null,height:null,pressure:null,tangentialPressure:null,tiltX:null,tiltY:null,twist:null,pointerType:null,isPrimary:null}),li={mouseEnter:{registrationName:"onMouseEnter",dependencies:["mouseout","mouseover"]},mouseLeave:{registrationName:"onMouseLeave",dependencies:["mouseout","mouseover"]},pointerEnter:{registrationName:"onPointerEnter",dependencies:["pointerout","pointerover"]},pointerLeave:{registrationName:"onPointerLeave",dependencies:["pointerout","pointerover"]}},ii={eventTypes:li,extractEvents:function(e,t,n,r,o){var a="mouseover"===e||"pointerover"===e,l="mouseout"===e||"pointerout"===e;if(a&&0==(32&o)&&(n.relatedTarget||n.fromElement)||!l&&!a)return null;if(a=r.window===r?r:(a=r.ownerDocument)?a.defaultView||a.parentWindow:window,l){if(l=t,null!==(t=(t=n.relatedTarget||n.toElement)?Xe(t):null)){var i=$(t);(t!==i||5!==t.tag&&6!==t.tag)&&(t=null)}}else l=null;if(l===t)return null;if("mouseout"===e||"mouseover"===e)var s=oi,c=li.mouseLeave,u=li.mouseEnter,f="mouse";else"pointerout"!==e&&"pointerover"!==e||(s=ai,c=li.pointerLeave,u=li.pointerEnter,f="pointer");if(e=null==l?a:Qe(l),a=null==t?a:Qe(t),c=s.getPooled(c,l,n,r),c.type=f+"leave",c.target=e,c.relatedTarget=a,n=s.getPooled(u,t,n,r),n.type=f+"enter",n.target=a,n.relatedTarget=e,r=l,f=t,r&&f)e:{for(s=r,u=f,l=0,e=s;e;e=Je(e))l++;for(e=0,t=u;t;t=Je(t))e++;for(;0<l-e;)s=Je(s),l--;for(;0<e-l;)u=Je(u),e--;for(;l--;){if(s===u||s===u.alternate)break e;s=Je(s),u=Je(u)}s=null}else s=null;for(u=s,s=[];r&&r!==u&&(null===(l=r.alternate)||l!==u);)s.push(r),r=Je(r);for(r=[];f&&f!==u&&(null===(l=f.alternate)||l!==u);)r.push(f),f=Je(f);for(f=0;f<s.length;f++)nt(s[f],"bubbled",c);for(f=r.length;0<f--;)nt(r[f],"captured",n);return 0==(64&o)?[c]:[c,n]}},si="function"==typeof Object.is?Object.is:St,ci=Object.prototype.hasOwnProperty,ui=na&&"documentMode"in document&&11>=document.documentMode,fi={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:"blur contextmenu dragend focus keydown keyup mousedown mouseup selectionchange".split(" ")}},di=null,pi=null,bi=null,hi=!1,mi={eventTypes:fi,extractEvents:function(e,t,n,r,o,a){if(o=a||(r.window===r?r.document:9===r.nodeType?r:r.ownerDocument),!(a=!o)){e:{o=J(o),a=ta.onSelect;for(var l=0;l<a.length;l++)if(!o.has(a[l])){o=!1;break e}o=!0}a=!o}if(a)return null;switch(o=t?Qe(t):window,e){case"focus":(mt(o)||"true"===o.contentEditable)&&(di=o,pi=t,bi=null);break;case"blur":bi=pi=di=null;break;case"mousedown":hi=!0;break;case"contextmenu":case"mouseup":case"dragend":return hi=!1,Tt(n,r);case"selectionchange":if(ui)break;case"keydown":case"keyup":return Tt(n,r)}return null}},gi=st.extend({animationName:null,elapsedTime:null,pseudoElement:null}),vi=st.extend({clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),yi=Jl.extend({relatedTarget:null}),_i={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},xi={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"},wi=Jl.extend({key:function(e){if(e.key){var t=_i[e.key]||e.key;if("Unidentified"!==t)return t}return"keypress"===e.type?(e=Rt(e),13===e?"Enter":String.fromCharCode(e)):"keydown"===e.type||"keyup"===e.type?xi[e.keyCode]||"Unidentified":""},location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:jt,charCode:function(e){return"keypress"===e.type?Rt(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?Rt(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}}),ki=oi.extend({dataTransfer:null}),Ei=Jl.extend({touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:jt}),Oi=st.extend({propertyName:null,elapsedTime:null,pseudoElement:null}),Pi=oi.extend({deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null}),Ci={eventTypes:ll,extractEvents:function(e,t,n,r){var o=il.get(e);if(!o)return null;switch(e){case"keypress":if(0===Rt(n))return null;case"keydown":case"keyup":e=wi;break;case"blur":case"focus":e=yi;break;case"click":if(2===n.button)return null;case"auxclick":case"dblclick":case"mousedown":case"mousemove":case"mouseup":case"mouseout":case"mouseover":case"contextmenu":e=oi;break;case"drag":case"dragend":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"dragstart":case"drop":e=ki;break;case"touchcancel":case"touchend":case"touchmove":case"touchstart":e=Ei;break;case qa:case Ya:case Wa:e=gi;break;case Va:e=Oi;break;case"scroll":e=Jl;break;case"wheel":e=Pi;break;case"copy":case"cut":case"paste":e=vi;break;case"gotpointercapture":case"lostpointercapture":case"pointercancel":case"pointerdown":case"pointermove":case"pointerout":case"pointerover":case"pointerup":e=ai;break;default:e=st}return t=e.getPooled(o,t,n,r),ot(t),t}};if(Qo)throw Error(r(101));Qo=Array.prototype.slice.call("ResponderEventPlugin SimpleEventPlugin EnterLeaveEventPlugin ChangeEventPlugin SelectEventPlugin BeforeInputEventPlugin".split(" ")),s();var ji=Ge;Ko=Ze,Xo=ji,Go=Qe,u({SimpleEventPlugin:Ci,EnterLeaveEventPlugin:ii,ChangeEventPlugin:Zl,SelectEventPlugin:mi,BeforeInputEventPlugin:Vl});var Si,Mi,Ti,Ri,Li=[],Ni=-1,Ai={},Di={current:Ai},Ii={current:!1},Hi=Ai,Fi=zo.unstable_runWithPriority,Ui=zo.unstable_scheduleCallback,zi=zo.unstable_cancelCallback,qi=zo.unstable_requestPaint,Yi=zo.unstable_now,Wi=zo.unstable_getCurrentPriorityLevel,Vi=zo.unstable_ImmediatePriority,Bi=zo.unstable_UserBlockingPriority,Ki=zo.unstable_NormalPriority,Xi=zo.unstable_LowPriority,Gi=zo.unstable_IdlePriority,Qi={},Zi=zo.unstable_shouldYield,Ji=void 0!==qi?qi:function(){},$i=null,es=null,ts=!1,ns=Yi(),rs=1e4>ns?Yi:function(){return Yi()-ns},os={current:null},as=null,ls=null,is=null,ss=!1,cs=ha.ReactCurrentBatchConfig,us=(new Fo.Component).refs,fs={isMounted:function(e){return!!(e=e._reactInternalFiber)&&$(e)===e},enqueueSetState:function(e,t,n){e=e._reactInternalFiber;var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o=on(r,o),o.payload=t,void 0!==n&&null!==n&&(o.callback=n),an(e,o),Fr(e,r)},enqueueReplaceState:function(e,t,n){e=e._reactInternalFiber;var r=Ir(),o=cs.suspense;r=Hr(r,e,o),o=on(r,o),o.tag=1,o.payload=t,void 0!==n&&null!==n&&(o.callback=n),an(e,o),Fr(e,r)},enqueueForceUpdate:function(e,t){e=e._reactInternalFiber;var n=Ir(),r=cs.suspense;n=Hr(n,e,r),r=on(n,r),r.tag=2,void 0!==t&&null!==t&&(r.callback=t),an(e,r),Fr(e,n)}},ds=Array.isArray,ps=gn(!0),bs=gn(!1),hs={},ms={current:hs},gs={current:hs},vs={current:hs},ys={current:0},_s=ha.ReactCurrentDispatcher,xs=ha.ReactCurrentBatchConfig,ws=0,ks=null,Es=null,Os=null,Ps=!1,Cs={readContext:tn,useCallback:On,useContext:On,useEffect:On,useImperativeHandle:On,useLayoutEffect:On,useMemo:On,useReducer:On,useRef:On,useState:On,useDebugValue:On,useResponder:On,useDeferredValue:On,useTransition:On},js={readContext:tn,useCallback:Wn,useContext:tn,useEffect:Hn,useImperativeHandle:function(e,t,n){return n=null!==n&&void 0!==n?n.concat([e]):null,Dn(4,2,zn.bind(null,t,e),n)},useLayoutEffect:function(e,t){return Dn(4,2,e,t)},useMemo:function(e,t){var n=jn();return t=void 0===t?null:t,e=e(),n.memoizedState=[e,t],e},useReducer:function(e,t,n){var r=jn();return t=void 0!==n?n(t):t,r.memoizedState=r.baseState=t,e=r.queue={pending:null,dispatch:null,lastRenderedReducer:e,lastRenderedState:t},e=e.dispatch=Xn.bind(null,ks,e),[r.memoizedState,e]},useRef:function(e){var t=jn();return e={current:e},t.memoizedState=e},useState:Ln,useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Ln(e),r=n[0],o=n[1];return Hn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Ln(!1),n=t[0];return t=t[1],[Wn(Kn.bind(null,t,e),[t,e]),n]}},Ss={readContext:tn,useCallback:Vn,useContext:tn,useEffect:Fn,useImperativeHandle:qn,useLayoutEffect:Un,useMemo:Bn,useReducer:Tn,useRef:An,useState:function(){return Tn(Mn)},useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Tn(Mn),r=n[0],o=n[1];return Fn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Tn(Mn),n=t[0];return t=t[1],[Vn(Kn.bind(null,t,e),[t,e]),n]}},Ms={readContext:tn,useCallback:Vn,useContext:tn,useEffect:Fn,useImperativeHandle:qn,useLayoutEffect:Un,useMemo:Bn,useReducer:Rn,useRef:An,useState:function(){return Rn(Mn)},useDebugValue:Yn,useResponder:En,useDeferredValue:function(e,t){var n=Rn(Mn),r=n[0],o=n[1];return Fn(function(){var n=xs.suspense;xs.suspense=void 0===t?null:t;try{o(e)}finally{xs.suspense=n}},[e,t]),r},useTransition:function(e){var t=Rn(Mn),n=t[0];return t=t[1],[Vn(Kn.bind(null,t,e),[t,e]),n]}},Ts=null,Rs=null,Ls=!1,Ns=ha.ReactCurrentOwner,As=!1,Ds={dehydrated:null,retryTime:0};Si=function(e,t){for(var n=t.child;null!==n;){if(5===n.tag||6===n.tag)e.appendChild(n.stateNode);else if(4!==n.tag&&null!==n.child){n.child.return=n,n=n.child;continue}if(n===t)break;for(;null===n.sibling;){if(null===n.return||n.return===t)return;n=n.return}n.sibling.return=n.return,n=n.sibling}},Mi=function(){},Ti=function(e,t,n,r,o){var a=e.memoizedProps;if(a!==r){var l=t.stateNode;switch(vn(ms.current),e=null,n){case"input":a=N(l,a),r=N(l,r),e=[];break;case"option":a=z(l,a),r=z(l,r),e=[];break;case"select":a=Uo({},a,{value:void 0}),r=Uo({},r,{value:void 0}),e=[];break;case"textarea":a=Y(l,a),r=Y(l,r),e=[];break;default:"function"!=typeof a.onClick&&"function"==typeof r.onClick&&(l.onclick=Ie)}Ne(n,r);var i,s;n=null;for(i in a)if(!r.hasOwnProperty(i)&&a.hasOwnProperty(i)&&null!=a[i])if("style"===i)for(s in l=a[i])l.hasOwnProperty(s)&&(n||(n={}),n[s]="");else"dangerouslySetInnerHTML"!==i&&"children"!==i&&"suppressContentEditableWarning"!==i&&"suppressHydrationWarning"!==i&&"autoFocus"!==i&&(ea.hasOwnProperty(i)?e||(e=[]):(e=e||[]).push(i,null));for(i in r){var c=r[i];if(l=null!=a?a[i]:void 0,r.hasOwnProperty(i)&&c!==l&&(null!=c||null!=l))if("style"===i)if(l){for(s in l)!l.hasOwnProperty(s)||c&&c.hasOwnProperty(s)||(n||(n={}),n[s]="");for(s in c)c.hasOwnProperty(s)&&l[s]!==c[s]&&(n||(n={}),n[s]=c[s])}else n||(e||(e=[]),e.push(i,n)),n=c;else"dangerouslySetInnerHTML"===i?(c=c?c.__html:void 0,l=l?l.__html:void 0,null!=c&&l!==c&&(e=e||[]).push(i,c)):"children"===i?l===c||"string"!=typeof c&&"number"!=typeof c||(e=e||[]).push(i,""+c):"suppressContentEditableWarning"!==i&&"suppressHydrationWarning"!==i&&(ea.hasOwnProperty(i)?(null!=c&&De(o,i),e||l===c||(e=[])):(e=e||[]).push(i,c))}n&&(e=e||[]).push("style",n),o=e,(t.updateQueue=o)&&(t.effectTag|=4)}},Ri=function(e,t,n,r){n!==r&&(t.effectTag|=4)};var Is,Hs="function"==typeof WeakSet?WeakSet:Set,Fs="function"==typeof WeakMap?WeakMap:Map,Us=Math.ceil,zs=ha.ReactCurrentDispatcher,qs=ha.ReactCurrentOwner,Ys=0,Ws=8,Vs=16,Bs=32,Ks=0,Xs=1,Gs=2,Qs=3,Zs=4,Js=5,$s=Ys,ec=null,tc=null,nc=0,rc=Ks,oc=null,ac=1073741823,lc=1073741823,ic=null,sc=0,cc=!1,uc=0,fc=500,dc=null,pc=!1,bc=null,hc=null,mc=!1,gc=null,vc=90,yc=null,_c=0,xc=null,wc=0;Is=function(e,t,n){var o=t.expirationTime;if(null!==e){var a=t.pendingProps;if(e.memoizedProps!==a||Ii.current)As=!0;else{if(o<n){switch(As=!1,t.tag){case 3:cr(t),er();break;case 5:if(xn(t),4&t.mode&&1!==n&&a.hidden)return t.expirationTime=t.childExpirationTime=1,null;break;case 1:Dt(t.type)&&Ut(t);break;case 4:yn(t,t.stateNode.containerInfo);break;case 10:o=t.memoizedProps.value,a=t.type._context,Nt(os,a._currentValue),a._currentValue=o;break;case 13:if(null!==t.memoizedState)return 0!==(o=t.child.childExpirationTime)&&o>=n?ur(e,t,n):(Nt(ys,1&ys.current),t=br(e,t,n),null!==t?t.sibling:null);Nt(ys,1&ys.current);break;case 19:if(o=t.childExpirationTime>=n,0!=(64&e.effectTag)){if(o)return pr(e,t,n);t.effectTag|=64}if(a=t.memoizedState,null!==a&&(a.rendering=null,a.tail=null),Nt(ys,ys.current),!o)return null}return br(e,t,n)}As=!1}}else As=!1;switch(t.expirationTime=0,t.tag){case 2:if(o=t.type,null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),e=t.pendingProps,a=At(t,Di.current),en(t,n),a=Cn(null,t,o,e,a,n),t.effectTag|=1,"object"==typeof a&&null!==a&&"function"==typeof a.render&&void 0===a.$$typeof){if(t.tag=1,t.memoizedState=null,t.updateQueue=null,Dt(o)){var l=!0;Ut(t)}else l=!1;t.memoizedState=null!==a.state&&void 0!==a.state?a.state:null,nn(t);var i=o.getDerivedStateFromProps;"function"==typeof i&&un(t,o,i,e),a.updater=fs,t.stateNode=a,a._reactInternalFiber=t,bn(t,o,e,n),t=sr(null,t,o,!0,l,n)}else t.tag=0,tr(null,t,a,n),t=t.child;return t;case 16:e:{if(a=t.elementType,null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),e=t.pendingProps,P(a),1!==a._status)throw a._result;switch(a=a._result,t.type=a,l=t.tag=vo(a),e=Qt(a,e),l){case 0:t=lr(null,t,a,e,n);break e;case 1:t=ir(null,t,a,e,n);break e;case 11:t=nr(null,t,a,e,n);break e;case 14:t=rr(null,t,a,Qt(a.type,e),o,n);break e}throw Error(r(306,a,""))}return t;case 0:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),lr(e,t,o,a,n);case 1:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),ir(e,t,o,a,n);case 3:if(cr(t),o=t.updateQueue,null===e||null===o)throw Error(r(282));if(o=t.pendingProps,a=t.memoizedState,a=null!==a?a.element:null,rn(e,t),sn(t,o,null,n),(o=t.memoizedState.element)===a)er(),t=br(e,t,n);else{if((a=t.stateNode.hydrate)&&(Rs=Be(t.stateNode.containerInfo.firstChild),Ts=t,a=Ls=!0),a)for(n=bs(t,null,o,n),t.child=n;n;)n.effectTag=-3&n.effectTag|1024,n=n.sibling;else tr(e,t,o,n),er();t=t.child}return t;case 5:return xn(t),null===e&&Zn(t),o=t.type,a=t.pendingProps,l=null!==e?e.memoizedProps:null,i=a.children,Ve(o,a)?i=null:null!==l&&Ve(o,l)&&(t.effectTag|=16),ar(e,t),4&t.mode&&1!==n&&a.hidden?(t.expirationTime=t.childExpirationTime=1,t=null):(tr(e,t,i,n),t=t.child),t;case 6:return null===e&&Zn(t),null;case 13:return ur(e,t,n);case 4:return yn(t,t.stateNode.containerInfo),o=t.pendingProps,null===e?t.child=ps(t,null,o,n):tr(e,t,o,n),t.child;case 11:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),nr(e,t,o,a,n);case 7:return tr(e,t,t.pendingProps,n),t.child;case 8:case 12:return tr(e,t,t.pendingProps.children,n),t.child;case 10:e:{o=t.type._context,a=t.pendingProps,i=t.memoizedProps,l=a.value;var s=t.type._context;if(Nt(os,s._currentValue),s._currentValue=l,null!==i)if(s=i.value,0===(l=si(s,l)?0:0|("function"==typeof o._calculateChangedBits?o._calculateChangedBits(s,l):1073741823))){if(i.children===a.children&&!Ii.current){t=br(e,t,n);break e}}else for(null!==(s=t.child)&&(s.return=t);null!==s;){var c=s.dependencies;if(null!==c){i=s.child;for(var u=c.firstContext;null!==u;){if(u.context===o&&0!=(u.observedBits&l)){1===s.tag&&(u=on(n,null),u.tag=2,an(s,u)),s.expirationTime<n&&(s.expirationTime=n),u=s.alternate,null!==u&&u.expirationTime<n&&(u.expirationTime=n),$t(s.return,n),c.expirationTime<n&&(c.expirationTime=n);break}u=u.next}}else i=10===s.tag&&s.type===t.type?null:s.child;if(null!==i)i.return=s;else for(i=s;null!==i;){if(i===t){i=null;break}if(null!==(s=i.sibling)){s.return=i.return,i=s;break}i=i.return}s=i}tr(e,t,a.children,n),t=t.child}return t;case 9:return a=t.type,l=t.pendingProps,o=l.children,en(t,n),a=tn(a,l.unstable_observedBits),o=o(a),t.effectTag|=1,tr(e,t,o,n),t.child;case 14:return a=t.type,l=Qt(a,t.pendingProps),l=Qt(a.type,l),rr(e,t,a,l,o,n);case 15:return or(e,t,t.type,t.pendingProps,o,n);case 17:return o=t.type,a=t.pendingProps,a=t.elementType===o?a:Qt(o,a),null!==e&&(e.alternate=null,t.alternate=null,t.effectTag|=2),t.tag=1,Dt(o)?(e=!0,Ut(t)):e=!1,en(t,n),dn(t,o,a),bn(t,o,a,n),sr(null,t,o,!0,e,n);case 19:return pr(e,t,n)}throw Error(r(156,t.tag))};var kc=null,Ec=null;Lo.prototype.render=function(e){So(e,this._internalRoot,null,null)},Lo.prototype.unmount=function(){var e=this._internalRoot,t=e.containerInfo;So(null,e,null,function(){t[Ml]=null})},Fa=function(e){if(13===e.tag){var t=Gt(Ir(),150,100);Fr(e,t),Ro(e,t)}},Ua=function(e){13===e.tag&&(Fr(e,3),Ro(e,3))},za=function(e){if(13===e.tag){var t=Ir();t=Hr(t,e,null),Fr(e,t),Ro(e,t)}},ra=function(e,t,n){switch(t){case"input":if(I(e,n),t=n.name,"radio"===n.type&&null!=t){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var o=n[t];if(o!==e&&o.form===e.form){var a=Ze(o);if(!a)throw Error(r(90));L(o),I(o,a)}}}break;case"textarea":V(e,n);break;case"select":null!=(t=n.value)&&q(e,!!n.multiple,t,!1)}},b=Br,h=function(e,t,n,r,o){var a=$s;$s|=4;try{return Wt(98,e.bind(null,t,n,r,o))}finally{($s=a)===Ys&&Kt()}},m=function(){($s&(1|Vs|Bs))===Ys&&(Vr(),io())},la=function(e,t){var n=$s;$s|=2;try{return e(t)}finally{($s=n)===Ys&&Kt()}};var Oc={Events:[Ge,Qe,Ze,u,$o,ot,function(e){ae(e,rt)},d,p,Me,ie,io,{current:!1}]};!function(e){var t=e.findFiberByHostInstance;bo(Uo({},e,{overrideHookState:null,overrideProps:null,setSuspenseHandler:null,scheduleUpdate:null,currentDispatcherRef:ha.ReactCurrentDispatcher,findHostInstanceByFiber:function(e){return e=re(e),null===e?null:e.stateNode},findFiberByHostInstance:function(e){return t?t(e):null},findHostInstancesForRefresh:null,scheduleRefresh:null,scheduleRoot:null,setRefreshHandler:null,getCurrentFiber:null}))}({findFiberByHostInstance:Xe,bundleType:0,version:"16.14.0",rendererPackageName:"react-dom"}),t.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=Oc,t.createPortal=Ho,t.findDOMNode=function(e){if(null==e)return null;if(1===e.nodeType)return e;var t=e._reactInternalFiber;if(void 0===t){if("function"==typeof e.render)throw Error(r(188));throw Error(r(268,Object.keys(e)))}return e=re(t),e=null===e?null:e.stateNode},t.flushSync=function(e,t){if(($s&(Vs|Bs))!==Ys)throw Error(r(187));var n=$s;$s|=1;try{return Wt(99,e.bind(null,t))}finally{$s=n,Kt()}},t.hydrate=function(e,t,n){if(!No(t))throw Error(r(200));return Do(null,e,t,!0,n)},t.render=function(e,t,n){if(!No(t))throw Error(r(200));return Do(null,e,t,!1,n)},t.unmountComponentAtNode=function(e){if(!No(e))throw Error(r(40));return!!e._reactRootContainer&&(Kr(function(){Do(null,null,e,!1,function(){e._reactRootContainer=null,e[Ml]=null})}),!0)},t.unstable_batchedUpdates=Br,t.unstable_createPortal=function(e,t){return Ho(e,t,2<arguments.length&&void 0!==arguments[2]?arguments[2]:null)},t.unstable_renderSubtreeIntoContainer=function(e,t,n,o){if(!No(n))throw Error(r(200));if(null==e||void 0===e._reactInternalFiber)throw Error(r(38));return Do(e,t,n,!1,o)},t.version="16.14.0"},function(e,t,n){"use strict";e.exports=n(48)},function(e,t,n){"use strict";function r(e,t){var n=e.length;e.push(t);e:for(;;){var r=n-1>>>1,o=e[r];if(!(void 0!==o&&0<l(o,t)))break e;e[r]=t,e[n]=o,n=r}}function o(e){return e=e[0],void 0===e?null:e}function a(e){var t=e[0];if(void 0!==t){var n=e.pop();if(n!==t){e[0]=n;e:for(var r=0,o=e.length;r<o;){var a=2*(r+1)-1,i=e[a],s=a+1,c=e[s];if(void 0!==i&&0>l(i,n))void 0!==c&&0>l(c,i)?(e[r]=c,e[s]=n,r=s):(e[r]=i,e[a]=n,r=a);else{if(!(void 0!==c&&0>l(c,n)))break e;e[r]=c,e[s]=n,r=s}}}return t}return null}function l(e,t){var n=e.sortIndex-t.sortIndex;return 0!==n?n:e.id-t.id}function i(e){for(var t=o(N);null!==t;){if(null===t.callback)a(N);else{if(!(t.startTime<=e))break;a(N),t.sortIndex=t.expirationTime,r(L,t)}t=o(N)}}function s(e){if(U=!1,i(e),!F)if(null!==o(L))F=!0,f(c);else{var t=o(N);null!==t&&d(s,t.startTime-e)}}function c(e,n){F=!1,U&&(U=!1,p()),H=!0;var r=I;try{for(i(n),D=o(L);null!==D&&(!(D.expirationTime>n)||e&&!b());){var l=D.callback;if(null!==l){D.callback=null,I=D.priorityLevel;var c=l(D.expirationTime<=n);n=t.unstable_now(),"function"==typeof c?D.callback=c:D===o(L)&&a(L),i(n)}else a(L);D=o(L)}if(null!==D)var u=!0;else{var f=o(N);null!==f&&d(s,f.startTime-n),u=!1}return u}finally{D=null,I=r,H=!1}}function u(e){switch(e){case 1:return-1;case 2:return 250;case 5:return 1073741823;case 4:return 1e4;default:return 5e3}}var f,d,p,b,h;if("undefined"==typeof window||"function"!=typeof MessageChannel){var m=null,g=null,v=function(){if(null!==m)try{var e=t.unstable_now();m(!0,e),m=null}catch(e){throw setTimeout(v,0),e}},y=Date.now();t.unstable_now=function(){return Date.now()-y},f=function(e){null!==m?setTimeout(f,0,e):(m=e,setTimeout(v,0))},d=function(e,t){g=setTimeout(e,t)},p=function(){clearTimeout(g)},b=function(){return!1},h=t.unstable_forceFrameRate=function(){}}else{var _=window.performance,x=window.Date,w=window.setTimeout,k=window.clearTimeout;if("undefined"!=typeof console){var E=window.cancelAnimationFrame;"function"!=typeof window.requestAnimationFrame&&console.error("This browser doesn't support requestAnimationFrame. Make sure that you load a polyfill in older browsers. https://fb.me/react-polyfills"),"function"!=typeof E&&console.error("This browser doesn't support cancelAnimationFrame. Make sure that you load a polyfill in older browsers. https://fb.me/react-polyfills")}if("object"==typeof _&&"function"==typeof _.now)t.unstable_now=function(){return _.now()};else{var O=x.now();t.unstable_now=function(){return x.now()-O}}var P=!1,C=null,j=-1,S=5,M=0;b=function(){return t.unstable_now()>=M},h=function(){},t.unstable_forceFrameRate=function(e){0>e||125<e?console.error("forceFrameRate takes a positive int between 0 and 125, forcing framerates higher than 125 fps is not unsupported"):S=0<e?Math.floor(1e3/e):5};var T=new MessageChannel,R=T.port2;T.port1.onmessage=function(){if(null!==C){var e=t.unstable_now();M=e+S;try{C(!0,e)?R.postMessage(null):(P=!1,C=null)}catch(e){throw R.postMessage(null),e}}else P=!1},f=function(e){C=e,P||(P=!0,R.postMessage(null))},d=function(e,n){j=w(function(){e(t.unstable_now())},n)},p=function(){k(j),j=-1}}var L=[],N=[],A=1,D=null,I=3,H=!1,F=!1,U=!1,z=h;t.unstable_IdlePriority=5,t.unstable_ImmediatePriority=1,t.unstable_LowPriority=4,t.unstable_NormalPriority=3,t.unstable_Profiling=null,t.unstable_UserBlockingPriority=2,t.unstable_cancelCallback=function(e){e.callback=null},t.unstable_continueExecution=function(){F||H||(F=!0,f(c))},t.unstable_getCurrentPriorityLevel=function(){return I},t.unstable_getFirstCallbackNode=function(){return o(L)},t.unstable_next=function(e){switch(I){case 1:case 2:case 3:var t=3;break;default:t=I}var n=I;I=t;try{return e()}finally{I=n}},t.unstable_pauseExecution=function(){},t.unstable_requestPaint=z,t.unstable_runWithPriority=function(e,t){switch(e){case 1:case 2:case 3:case 4:case 5:break;default:e=3}var n=I;I=e;try{return t()}finally{I=n}},t.unstable_scheduleCallback=function(e,n,a){var l=t.unstable_now();if("object"==typeof a&&null!==a){var i=a.delay;i="number"==typeof i&&0<i?l+i:l,a="number"==typeof a.timeout?a.timeout:u(e)}else a=u(e),i=l;return a=i+a,e={id:A++,callback:n,priorityLevel:e,startTime:i,expirationTime:a,sortIndex:-1},i>l?(e.sortIndex=i,r(N,e),null===o(L)&&e===o(N)&&(U?p():U=!0,d(s,i-l))):(e.sortIndex=a,r(L,e),F||H||(F=!0,f(c))),e},t.unstable_shouldYield=function(){var e=t.unstable_now();i(e);var n=o(L);return n!==D&&null!==D&&null!==n&&null!==n.callback&&n.startTime<=e&&n.expirationTime<D.expirationTime||b()},t.unstable_wrapCallback=function(e){var t=I;return function(){var n=I;I=t;try{return e.apply(this,arguments)}finally{I=n}}}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(15),p=r(d),b=n(50),h=r(b),m=n(8),g=r(m),v=n(16),y=r(v),_=n(9),x=r(_),w=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"parseJson",value:function(e){if(0===e.length)return null;try{return JSON.parse(e)}catch(t){return{parse_error:"not a valid JSON",value:e}}}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Started At"),c.default.createElement("th",null,"Check-in At"),c.default.createElement("th",null,"Check-in")),this.props.worker.map(function(t){return c.default.createElement("tr",{key:t.worker_id},c.default.createElement("td",null,t.job_name),c.default.createElement("td",null,c.default.createElement(y.default,{args:e.parseJson(t.args_json)})),c.default.createElement("td",null,c.default.createElement(p.default,{ts:t.started_at})),c.default.createElement("td",null,c.default.createElement(p.default,{ts:t.checkin_at})),c.default.createElement("td",null,t.checkin))}))))}}]),t}(c.default.Component);w.propTypes={worker:f.default.arrayOf(f.default.object).isRequired};var k=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={busyWorker:[],workerPool:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"componentDidMount",value:function(){var e=this;this.props.busyWorkerURL&&fetch(this.props.busyWorkerURL).then(function(e){return e.json()}).then(function(t){t&&e.setState({busyWorker:t})}),this.props.workerPoolURL&&fetch(this.props.workerPoolURL).then(function(e){return e.json()}).then(function(t){var n=[];t.map(function(e){""!=e.host&&n.push(e)}),e.setState({workerPool:n})})}},{key:"getBusyPoolWorker",value:function(e){var t=[];return this.state.busyWorker.map(function(n){e.worker_ids.includes(n.worker_id)&&t.push(n)}),t}},{key:"render",value:function(){var e=this;return c.default.createElement("section",null,c.default.createElement("header",null,"Processes"),c.default.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(function(t){var n=e.getBusyPoolWorker(t);return c.default.createElement("div",{key:t.worker_pool_id,className:(0,x.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("td",null,t.host,": ",t.pid),c.default.createElement("td",null,"Started ",c.default.createElement(p.default,{ts:t.started_at})),c.default.createElement("td",null,"Last Heartbeat ",c.default.createElement(p.default,{ts:t.heartbeat_at})),c.default.createElement("td",null,"Concurrency ",t.concurrency)),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},"Servicing ",c.default.createElement(h.default,{item:t.job_names}),".")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},n.length," active worker(s) and ",t.worker_ids.length-n.length," idle.")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},c.default.createElement("div",{className:(0,x.default)(g.default.panel,g.default.panelDefault)},c.default.createElement(w,{worker:n}))))))))}))}},{key:"workerCount",get:function(){var e=0;return this.state.workerPool.map(function(t){e+=t.worker_ids.length}),e}}]),t}(c.default.Component);k.propTypes={busyWorkerURL:f.default.string,workerPoolURL:f.default.string},t.default=k},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(51),p=r(d),b=function(e){function t(){return o(this,t),a(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return l(t,e),i(t,[{key:"render",value:function(){var e=this;return c.default.createElement("ul",{className:p.default.ul},this.props.item.map(function(t,n){return n<3?c.default.createElement("li",{key:n,className:p.default.li},t):3==n?c.default.createElement("li",{key:n,className:p.default.li},e.props.item.length-3," more"):void 0}))}}]),t}(c.default.Component);b.propTypes={item:f.default.arrayOf(f.default.string).isRequired},t.default=b},function(e,t,n){var r=n(52);"string"==typeof r&&(r=[[e.i,r,""]]);var o={hmr:!0};o.transform=void 0;n(29)(r,o);r.locals&&(e.exports=r.locals)},function(e,t,n){t=e.exports=n(28)(!1),t.push([e.i,'._223iE-ul {\n  display: inline;\n  list-style: none;\n  padding: 0;\n}\n\n._14w3w-li {\n  display: inline;\n}\n\n._14w3w-li:after {\n  content: ", ";\n}\n\n._14w3w-li:last-child:before {\n  content: "and "\n}\n\n._14w3w-li:last-child:after {\n  content: "";\n}\n',""]),t.locals={ul:"_223iE-ul",li:"_14w3w-li"}},function(e,t){e.exports=function(e){var t="undefined"!=typeof window&&window.location;if(!t)throw new Error("fixUrls requires window.location");if(!e||"string"!=typeof e)return e;var n=t.protocol+"//"+t.host,r=n+t.pathname.replace(/\/[^\/]*$/,"/");return e.replace(/url\s*\(((?:[^)(]|\((?:[^)(]+|\([^)(]*\))*\))*)\)/gi,function(e,t){var o=t.trim().replace(/^"(.*)"$/,function(e,t){return t}).replace(/^'(.*)'$/,function(e,t){return t});if(/^(#|data:|http:\/\/|https:\/\/|file:\/\/\/)/i.test(o))return e;var a;return a=0===o.indexOf("//")?o:0===o.indexOf("/")?n+o:r+o.replace(/^\.\//,""),"url("+JSON.stringify(a)+")"})}},function(e,t,n){t=e.exports=n(28)(!1),t.push([e.i,'/*!\n * Generated using the Bootstrap Customizer (https://getbootstrap.com/docs/3.4/customize/)\n * Config saved to https://gist.github.com/da9e4e258238275a8c6ec9d23bbb2e92\n *//*!\n * Bootstrap v3.4.1 (https://getbootstrap.com/)\n * Copyright 2011-2019 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n *//*! normalize.css v3.0.3 | MIT License | github.com/necolas/normalize.css */html{font-family:sans-serif;-ms-text-size-adjust:100%;-webkit-text-size-adjust:100%}body{margin:0}article,aside,details,figcaption,figure,footer,header,hgroup,main,menu,nav,section,summary{display:block}audio,canvas,progress,video{display:inline-block;vertical-align:baseline}audio:not([controls]){display:none;height:0}[hidden],template{display:none}a{background-color:transparent}a:active,a:hover{outline:0}abbr[title]{border-bottom:none;text-decoration:underline;text-decoration:underline dotted}b,strong{font-weight:bold}dfn{font-style:italic}h1{font-size:2em;margin:0.67em 0}mark{background:#ff0;color:#000}small{font-size:80%}sub,sup{font-size:75%;line-height:0;position:relative;vertical-align:baseline}sup{top:-0.5em}sub{bottom:-0.25em}img{border:0}svg:not(:root){overflow:hidden}figure{margin:1em 40px}hr{-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box;height:0}pre{overflow:auto}code,kbd,pre,samp{font-family:monospace, monospace;font-size:1em}button,input,optgroup,select,textarea{color:inherit;font:inherit;margin:0}button{overflow:visible}button,select{text-transform:none}button,html input[type="button"],input[type="reset"],input[type="submit"]{-webkit-appearance:button;cursor:pointer}button[disabled],html input[disabled]{cursor:default}button::-moz-focus-inner,input::-moz-focus-inner{border:0;padding:0}input{line-height:normal}input[type="checkbox"],input[type="radio"]{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box;padding:0}input[type="number"]::-webkit-inner-spin-button,input[type="number"]::-webkit-outer-spin-button{height:auto}input[type="search"]{-webkit-appearance:textfield;-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box}input[type="search"]::-webkit-search-cancel-button,input[type="search"]::-webkit-search-decoration{-webkit-appearance:none}fieldset{border:1px solid #c0c0c0;margin:0 2px;padding:0.35em 0.625em 0.75em}legend{border:0;padding:0}textarea{overflow:auto}optgroup{font-weight:bold}table{border-collapse:collapse;border-spacing:0}td,th{padding:0}/*! Source: https://github.com/h5bp/html5-boilerplate/blob/master/src/css/main.css */@media print{*,*:before,*:after{color:#000 !important;text-shadow:none !important;background:transparent !important;-webkit-box-shadow:none !important;box-shadow:none !important}a,a:visited{text-decoration:underline}a[href]:after{content:" (" attr(href) ")"}abbr[title]:after{content:" (" attr(title) ")"}a[href^="#"]:after,a[href^="javascript:"]:after{content:""}pre,blockquote{border:1px solid #999;page-break-inside:avoid}thead{display:table-header-group}tr,img{page-break-inside:avoid}img{max-width:100% !important}p,h2,h3{orphans:3;widows:3}h2,h3{page-break-after:avoid}.xz-aB-navbar{display:none}._3-XUl-btn>.WfXRY-caret,.xQM4X-dropup>._3-XUl-btn>.WfXRY-caret{border-top-color:#000 !important}._3gkGS-label{border:1px solid #000}.fHH5l-table{border-collapse:collapse !important}.fHH5l-table td,.fHH5l-table th{background-color:#fff !important}._3qUkR-table-bordered th,._3qUkR-table-bordered td{border:1px solid #ddd !important}}*{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}*:before,*:after{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}html{font-size:10px;-webkit-tap-highlight-color:rgba(0,0,0,0)}body{font-family:"Helvetica Neue",Helvetica,Arial,sans-serif;font-size:14px;line-height:1.42857143;color:#333;background-color:#fff}input,button,select,textarea{font-family:inherit;font-size:inherit;line-height:inherit}a{color:#337ab7;text-decoration:none}a:hover,a:focus{color:#23527c;text-decoration:underline}a:focus{outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}figure{margin:0}img{vertical-align:middle}._2zRts-img-responsive{display:block;max-width:100%;height:auto}._141wW-img-rounded{border-radius:6px}._3mPVB-img-thumbnail{padding:4px;line-height:1.42857143;background-color:#fff;border:1px solid #ddd;border-radius:4px;-webkit-transition:all .2s ease-in-out;-o-transition:all .2s ease-in-out;transition:all .2s ease-in-out;display:inline-block;max-width:100%;height:auto}._1pGGm-img-circle{border-radius:50%}hr{margin-top:20px;margin-bottom:20px;border:0;border-top:1px solid #eee}._2pd-Z-sr-only{position:absolute;width:1px;height:1px;padding:0;margin:-1px;overflow:hidden;clip:rect(0, 0, 0, 0);border:0}._2oRwu-sr-only-focusable:active,._2oRwu-sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}[role="button"]{cursor:pointer}h1,h2,h3,h4,h5,h6,.FcDB9-h1,._2RtE8-h2,._2Hz9Y-h3,._20UE0-h4,._3ig4X-h5,._1IWbi-h6{font-family:inherit;font-weight:500;line-height:1.1;color:inherit}h1 small,h2 small,h3 small,h4 small,h5 small,h6 small,.FcDB9-h1 small,._2RtE8-h2 small,._2Hz9Y-h3 small,._20UE0-h4 small,._3ig4X-h5 small,._1IWbi-h6 small,h1 ._1xqEr-small,h2 ._1xqEr-small,h3 ._1xqEr-small,h4 ._1xqEr-small,h5 ._1xqEr-small,h6 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-weight:400;line-height:1;color:#777}h1,.FcDB9-h1,h2,._2RtE8-h2,h3,._2Hz9Y-h3{margin-top:20px;margin-bottom:10px}h1 small,.FcDB9-h1 small,h2 small,._2RtE8-h2 small,h3 small,._2Hz9Y-h3 small,h1 ._1xqEr-small,.FcDB9-h1 ._1xqEr-small,h2 ._1xqEr-small,._2RtE8-h2 ._1xqEr-small,h3 ._1xqEr-small,._2Hz9Y-h3 ._1xqEr-small{font-size:65%}h4,._20UE0-h4,h5,._3ig4X-h5,h6,._1IWbi-h6{margin-top:10px;margin-bottom:10px}h4 small,._20UE0-h4 small,h5 small,._3ig4X-h5 small,h6 small,._1IWbi-h6 small,h4 ._1xqEr-small,._20UE0-h4 ._1xqEr-small,h5 ._1xqEr-small,._3ig4X-h5 ._1xqEr-small,h6 ._1xqEr-small,._1IWbi-h6 ._1xqEr-small{font-size:75%}h1,.FcDB9-h1{font-size:36px}h2,._2RtE8-h2{font-size:30px}h3,._2Hz9Y-h3{font-size:24px}h4,._20UE0-h4{font-size:18px}h5,._3ig4X-h5{font-size:14px}h6,._1IWbi-h6{font-size:12px}p{margin:0 0 10px}.BToLm-lead{margin-bottom:20px;font-size:16px;font-weight:300;line-height:1.4}@media (min-width:768px){.BToLm-lead{font-size:21px}}small,._1xqEr-small{font-size:85%}mark,._1CUfl-mark{padding:.2em;background-color:#fcf8e3}._1dEhG-text-left{text-align:left}._13-RL-text-right{text-align:right}._12Qkk-text-center{text-align:center}._2IBJo-text-justify{text-align:justify}._2D83n-text-nowrap{white-space:nowrap}._3pILW-text-lowercase{text-transform:lowercase}.EHGdF-text-uppercase{text-transform:uppercase}.NKPoz-text-capitalize{text-transform:capitalize}.TLMiz-text-muted{color:#777}.m-Vt1-text-primary{color:#337ab7}a.m-Vt1-text-primary:hover,a.m-Vt1-text-primary:focus{color:#286090}._1gJQD-text-success{color:#3c763d}a._1gJQD-text-success:hover,a._1gJQD-text-success:focus{color:#2b542c}._3nKGJ-text-info{color:#31708f}a._3nKGJ-text-info:hover,a._3nKGJ-text-info:focus{color:#245269}._1L8Pc-text-warning{color:#8a6d3b}a._1L8Pc-text-warning:hover,a._1L8Pc-text-warning:focus{color:#66512c}._2Qeqx-text-danger{color:#a94442}a._2Qeqx-text-danger:hover,a._2Qeqx-text-danger:focus{color:#843534}.VG86S-bg-primary{color:#fff;background-color:#337ab7}a.VG86S-bg-primary:hover,a.VG86S-bg-primary:focus{background-color:#286090}.Fh09a-bg-success{background-color:#dff0d8}a.Fh09a-bg-success:hover,a.Fh09a-bg-success:focus{background-color:#c1e2b3}._3D-5o-bg-info{background-color:#d9edf7}a._3D-5o-bg-info:hover,a._3D-5o-bg-info:focus{background-color:#afd9ee}._23YNn-bg-warning{background-color:#fcf8e3}a._23YNn-bg-warning:hover,a._23YNn-bg-warning:focus{background-color:#f7ecb5}._1g67A-bg-danger{background-color:#f2dede}a._1g67A-bg-danger:hover,a._1g67A-bg-danger:focus{background-color:#e4b9b9}._3ayLh-page-header{padding-bottom:9px;margin:40px 0 20px;border-bottom:1px solid #eee}ul,ol{margin-top:0;margin-bottom:10px}ul ul,ol ul,ul ol,ol ol{margin-bottom:0}._2tb6R-list-unstyled{padding-left:0;list-style:none}._2aPiu-list-inline{padding-left:0;list-style:none;margin-left:-5px}._2aPiu-list-inline>li{display:inline-block;padding-right:5px;padding-left:5px}dl{margin-top:0;margin-bottom:20px}dt,dd{line-height:1.42857143}dt{font-weight:700}dd{margin-left:0}@media (min-width:768px){._2DZom-dl-horizontal dt{float:left;width:160px;clear:left;text-align:right;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}._2DZom-dl-horizontal dd{margin-left:180px}}abbr[title],abbr[data-original-title]{cursor:help}._39JkD-initialism{font-size:90%;text-transform:uppercase}blockquote{padding:10px 20px;margin:0 0 20px;font-size:17.5px;border-left:5px solid #eee}blockquote p:last-child,blockquote ul:last-child,blockquote ol:last-child{margin-bottom:0}blockquote footer,blockquote small,blockquote ._1xqEr-small{display:block;font-size:80%;line-height:1.42857143;color:#777}blockquote footer:before,blockquote small:before,blockquote ._1xqEr-small:before{content:"\\2014   \\A0"}._3OkIL-blockquote-reverse,blockquote._1DwIR-pull-right{padding-right:15px;padding-left:0;text-align:right;border-right:5px solid #eee;border-left:0}._3OkIL-blockquote-reverse footer:before,blockquote._1DwIR-pull-right footer:before,._3OkIL-blockquote-reverse small:before,blockquote._1DwIR-pull-right small:before,._3OkIL-blockquote-reverse ._1xqEr-small:before,blockquote._1DwIR-pull-right ._1xqEr-small:before{content:""}._3OkIL-blockquote-reverse footer:after,blockquote._1DwIR-pull-right footer:after,._3OkIL-blockquote-reverse small:after,blockquote._1DwIR-pull-right small:after,._3OkIL-blockquote-reverse ._1xqEr-small:after,blockquote._1DwIR-pull-right ._1xqEr-small:after{content:"\\A0   \\2014"}address{margin-bottom:20px;font-style:normal;line-height:1.42857143}.GfVfY-container{padding-right:15px;padding-left:15px;margin-right:auto;margin-left:auto}@media (min-width:768px){.GfVfY-container{width:750px}}@media (min-width:992px){.GfVfY-container{width:970px}}@media (min-width:1200px){.GfVfY-container{width:1170px}}._1TgHS-container-fluid{padding-right:15px;padding-left:15px;margin-right:auto;margin-left:auto}._3XjgV-row{margin-right:-15px;margin-left:-15px}._3-ExX-row-no-gutters{margin-right:0;margin-left:0}._3-ExX-row-no-gutters [class*="col-"]{padding-right:0;padding-left:0}._4I1Yd-col-xs-1, .ZjKa3-col-sm-1, ._2qQXc-col-md-1, ._20ta3-col-lg-1, ._2Dnpy-col-xs-2, ._1i2ug-col-sm-2, ._2t37W-col-md-2, ._2Hl3y-col-lg-2, .OXp8_-col-xs-3, ._2Cn5F-col-sm-3, .Ab3eH-col-md-3, ._26GsY-col-lg-3, ._2RAtz-col-xs-4, .fa0Ir-col-sm-4, .hp0Eu-col-md-4, ._17v9q-col-lg-4, ._2Y3wR-col-xs-5, ._3h_BG-col-sm-5, .s-Mk2-col-md-5, ._3poT2-col-lg-5, ._2LLfi-col-xs-6, ._2uvrS-col-sm-6, ._3aWb5-col-md-6, ._1kKt6-col-lg-6, ._1lY1V-col-xs-7, ._3MZfg-col-sm-7, ._3cJo6-col-md-7, ._3xzR0-col-lg-7, ._2HxFx-col-xs-8, ._2gidE-col-sm-8, ._2PRaS-col-md-8, .LJ--s-col-lg-8, ._2oOfj-col-xs-9, ._26BI4-col-sm-9, ._2DhdG-col-md-9, ._3q6rI-col-lg-9, .pmiBX-col-xs-10, .s5knd-col-sm-10, ._1oNCM-col-md-10, ._2DYWZ-col-lg-10, .yWnii-col-xs-11, .liNUF-col-sm-11, ._2CmUe-col-md-11, ._1qjSD-col-lg-11, ._2dgc--col-xs-12, ._3ylC5-col-sm-12, ._2DCWc-col-md-12, ._2FNrn-col-lg-12{position:relative;min-height:1px;padding-right:15px;padding-left:15px}._4I1Yd-col-xs-1, ._2Dnpy-col-xs-2, .OXp8_-col-xs-3, ._2RAtz-col-xs-4, ._2Y3wR-col-xs-5, ._2LLfi-col-xs-6, ._1lY1V-col-xs-7, ._2HxFx-col-xs-8, ._2oOfj-col-xs-9, .pmiBX-col-xs-10, .yWnii-col-xs-11, ._2dgc--col-xs-12{float:left}._2dgc--col-xs-12{width:100%}.yWnii-col-xs-11{width:91.66666667%}.pmiBX-col-xs-10{width:83.33333333%}._2oOfj-col-xs-9{width:75%}._2HxFx-col-xs-8{width:66.66666667%}._1lY1V-col-xs-7{width:58.33333333%}._2LLfi-col-xs-6{width:50%}._2Y3wR-col-xs-5{width:41.66666667%}._2RAtz-col-xs-4{width:33.33333333%}.OXp8_-col-xs-3{width:25%}._2Dnpy-col-xs-2{width:16.66666667%}._4I1Yd-col-xs-1{width:8.33333333%}.uk8LQ-col-xs-pull-12{right:100%}.nQP-X-col-xs-pull-11{right:91.66666667%}._3W2H_-col-xs-pull-10{right:83.33333333%}._18qIh-col-xs-pull-9{right:75%}.CEgpl-col-xs-pull-8{right:66.66666667%}._3UVMh-col-xs-pull-7{right:58.33333333%}._1PEhv-col-xs-pull-6{right:50%}.VxFvW-col-xs-pull-5{right:41.66666667%}._3Wqpt-col-xs-pull-4{right:33.33333333%}._12Zed-col-xs-pull-3{right:25%}._3p-tQ-col-xs-pull-2{right:16.66666667%}.RDEqX-col-xs-pull-1{right:8.33333333%}._2F1WQ-col-xs-pull-0{right:auto}._1APvI-col-xs-push-12{left:100%}._3C73D-col-xs-push-11{left:91.66666667%}.h56Hx-col-xs-push-10{left:83.33333333%}._1P93P-col-xs-push-9{left:75%}._2Nbww-col-xs-push-8{left:66.66666667%}._1K3eA-col-xs-push-7{left:58.33333333%}._2nBcT-col-xs-push-6{left:50%}._3petP-col-xs-push-5{left:41.66666667%}._2IqqW-col-xs-push-4{left:33.33333333%}.MCtu6-col-xs-push-3{left:25%}._3PMdr-col-xs-push-2{left:16.66666667%}._1wU93-col-xs-push-1{left:8.33333333%}._1mQQp-col-xs-push-0{left:auto}._29EZj-col-xs-offset-12{margin-left:100%}._2hf-s-col-xs-offset-11{margin-left:91.66666667%}.PUiPu-col-xs-offset-10{margin-left:83.33333333%}._26WFR-col-xs-offset-9{margin-left:75%}._1cCHe-col-xs-offset-8{margin-left:66.66666667%}._3XTt9-col-xs-offset-7{margin-left:58.33333333%}._3ruq1-col-xs-offset-6{margin-left:50%}._2Jvdw-col-xs-offset-5{margin-left:41.66666667%}._3Lc1D-col-xs-offset-4{margin-left:33.33333333%}._1MGVb-col-xs-offset-3{margin-left:25%}._3VELV-col-xs-offset-2{margin-left:16.66666667%}._1qTH1-col-xs-offset-1{margin-left:8.33333333%}._3p4mP-col-xs-offset-0{margin-left:0}@media (min-width:768px){.ZjKa3-col-sm-1, ._1i2ug-col-sm-2, ._2Cn5F-col-sm-3, .fa0Ir-col-sm-4, ._3h_BG-col-sm-5, ._2uvrS-col-sm-6, ._3MZfg-col-sm-7, ._2gidE-col-sm-8, ._26BI4-col-sm-9, .s5knd-col-sm-10, .liNUF-col-sm-11, ._3ylC5-col-sm-12{float:left}._3ylC5-col-sm-12{width:100%}.liNUF-col-sm-11{width:91.66666667%}.s5knd-col-sm-10{width:83.33333333%}._26BI4-col-sm-9{width:75%}._2gidE-col-sm-8{width:66.66666667%}._3MZfg-col-sm-7{width:58.33333333%}._2uvrS-col-sm-6{width:50%}._3h_BG-col-sm-5{width:41.66666667%}.fa0Ir-col-sm-4{width:33.33333333%}._2Cn5F-col-sm-3{width:25%}._1i2ug-col-sm-2{width:16.66666667%}.ZjKa3-col-sm-1{width:8.33333333%}._2lgaN-col-sm-pull-12{right:100%}._9DE1R-col-sm-pull-11{right:91.66666667%}.kEzk--col-sm-pull-10{right:83.33333333%}.Nt2jd-col-sm-pull-9{right:75%}._3swPC-col-sm-pull-8{right:66.66666667%}._3HVdW-col-sm-pull-7{right:58.33333333%}.wTEFa-col-sm-pull-6{right:50%}.TLqCy-col-sm-pull-5{right:41.66666667%}._15_JC-col-sm-pull-4{right:33.33333333%}.kKugK-col-sm-pull-3{right:25%}._1gfwZ-col-sm-pull-2{right:16.66666667%}.ULGBP-col-sm-pull-1{right:8.33333333%}._2761W-col-sm-pull-0{right:auto}.vVqsv-col-sm-push-12{left:100%}._3rrzB-col-sm-push-11{left:91.66666667%}._16iHZ-col-sm-push-10{left:83.33333333%}._3gO4_-col-sm-push-9{left:75%}._2iTaN-col-sm-push-8{left:66.66666667%}._15MqT-col-sm-push-7{left:58.33333333%}._3WBCD-col-sm-push-6{left:50%}._3yi6x-col-sm-push-5{left:41.66666667%}.Hi_tc-col-sm-push-4{left:33.33333333%}._3CD2Y-col-sm-push-3{left:25%}._2KPVu-col-sm-push-2{left:16.66666667%}._1vrte-col-sm-push-1{left:8.33333333%}._2iGSL-col-sm-push-0{left:auto}._2gBOF-col-sm-offset-12{margin-left:100%}._1nkq--col-sm-offset-11{margin-left:91.66666667%}._3cbLF-col-sm-offset-10{margin-left:83.33333333%}._1YqUk-col-sm-offset-9{margin-left:75%}.l5LT2-col-sm-offset-8{margin-left:66.66666667%}._1_lx0-col-sm-offset-7{margin-left:58.33333333%}.QftRx-col-sm-offset-6{margin-left:50%}._1CiAA-col-sm-offset-5{margin-left:41.66666667%}._3KOyM-col-sm-offset-4{margin-left:33.33333333%}._3X8SS-col-sm-offset-3{margin-left:25%}._2uxv1-col-sm-offset-2{margin-left:16.66666667%}._3LDLJ-col-sm-offset-1{margin-left:8.33333333%}._2qEtK-col-sm-offset-0{margin-left:0}}@media (min-width:992px){._2qQXc-col-md-1, ._2t37W-col-md-2, .Ab3eH-col-md-3, .hp0Eu-col-md-4, .s-Mk2-col-md-5, ._3aWb5-col-md-6, ._3cJo6-col-md-7, ._2PRaS-col-md-8, ._2DhdG-col-md-9, ._1oNCM-col-md-10, ._2CmUe-col-md-11, ._2DCWc-col-md-12{float:left}._2DCWc-col-md-12{width:100%}._2CmUe-col-md-11{width:91.66666667%}._1oNCM-col-md-10{width:83.33333333%}._2DhdG-col-md-9{width:75%}._2PRaS-col-md-8{width:66.66666667%}._3cJo6-col-md-7{width:58.33333333%}._3aWb5-col-md-6{width:50%}.s-Mk2-col-md-5{width:41.66666667%}.hp0Eu-col-md-4{width:33.33333333%}.Ab3eH-col-md-3{width:25%}._2t37W-col-md-2{width:16.66666667%}._2qQXc-col-md-1{width:8.33333333%}.bjQSr-col-md-pull-12{right:100%}._2R0gT-col-md-pull-11{right:91.66666667%}._1ywn5-col-md-pull-10{right:83.33333333%}.Y3m-j-col-md-pull-9{right:75%}._2kyk9-col-md-pull-8{right:66.66666667%}.dnrMG-col-md-pull-7{right:58.33333333%}._1zNYf-col-md-pull-6{right:50%}._1lgxH-col-md-pull-5{right:41.66666667%}.fI_-S-col-md-pull-4{right:33.33333333%}._1yC1r-col-md-pull-3{right:25%}._2ruK1-col-md-pull-2{right:16.66666667%}._3pkxQ-col-md-pull-1{right:8.33333333%}._2NDft-col-md-pull-0{right:auto}._2dklO-col-md-push-12{left:100%}._2O3TS-col-md-push-11{left:91.66666667%}._3H7Y4-col-md-push-10{left:83.33333333%}._2PIS0-col-md-push-9{left:75%}._1qQQi-col-md-push-8{left:66.66666667%}.Y8MRT-col-md-push-7{left:58.33333333%}._3_TWQ-col-md-push-6{left:50%}._3KOrj-col-md-push-5{left:41.66666667%}._2PuS8-col-md-push-4{left:33.33333333%}._2zI-_-col-md-push-3{left:25%}._3Drij-col-md-push-2{left:16.66666667%}._1M7-4-col-md-push-1{left:8.33333333%}._1kPaw-col-md-push-0{left:auto}._3PwfG-col-md-offset-12{margin-left:100%}.sHbiw-col-md-offset-11{margin-left:91.66666667%}._3geq1-col-md-offset-10{margin-left:83.33333333%}._3ypJc-col-md-offset-9{margin-left:75%}.ydDuS-col-md-offset-8{margin-left:66.66666667%}._1MS3B-col-md-offset-7{margin-left:58.33333333%}._1A80h-col-md-offset-6{margin-left:50%}._3g3p_-col-md-offset-5{margin-left:41.66666667%}._2FjQc-col-md-offset-4{margin-left:33.33333333%}.Xebzn-col-md-offset-3{margin-left:25%}.Xw9rm-col-md-offset-2{margin-left:16.66666667%}.CToHd-col-md-offset-1{margin-left:8.33333333%}.oXEcL-col-md-offset-0{margin-left:0}}@media (min-width:1200px){._20ta3-col-lg-1, ._2Hl3y-col-lg-2, ._26GsY-col-lg-3, ._17v9q-col-lg-4, ._3poT2-col-lg-5, ._1kKt6-col-lg-6, ._3xzR0-col-lg-7, .LJ--s-col-lg-8, ._3q6rI-col-lg-9, ._2DYWZ-col-lg-10, ._1qjSD-col-lg-11, ._2FNrn-col-lg-12{float:left}._2FNrn-col-lg-12{width:100%}._1qjSD-col-lg-11{width:91.66666667%}._2DYWZ-col-lg-10{width:83.33333333%}._3q6rI-col-lg-9{width:75%}.LJ--s-col-lg-8{width:66.66666667%}._3xzR0-col-lg-7{width:58.33333333%}._1kKt6-col-lg-6{width:50%}._3poT2-col-lg-5{width:41.66666667%}._17v9q-col-lg-4{width:33.33333333%}._26GsY-col-lg-3{width:25%}._2Hl3y-col-lg-2{width:16.66666667%}._20ta3-col-lg-1{width:8.33333333%}._32_we-col-lg-pull-12{right:100%}._24eEw-col-lg-pull-11{right:91.66666667%}._29qaq-col-lg-pull-10{right:83.33333333%}._1S7Yo-col-lg-pull-9{right:75%}._2CgOB-col-lg-pull-8{right:66.66666667%}._3Jv0L-col-lg-pull-7{right:58.33333333%}._1pHMz-col-lg-pull-6{right:50%}._2zvsy-col-lg-pull-5{right:41.66666667%}.sFsmY-col-lg-pull-4{right:33.33333333%}.Ui3Ia-col-lg-pull-3{right:25%}.WmgXv-col-lg-pull-2{right:16.66666667%}._2KMEm-col-lg-pull-1{right:8.33333333%}.QWRTc-col-lg-pull-0{right:auto}._2FOMW-col-lg-push-12{left:100%}.IdWzr-col-lg-push-11{left:91.66666667%}._3qw3b-col-lg-push-10{left:83.33333333%}._3LXj0-col-lg-push-9{left:75%}._16qiV-col-lg-push-8{left:66.66666667%}._2OQk6-col-lg-push-7{left:58.33333333%}._3o1se-col-lg-push-6{left:50%}._2dmyK-col-lg-push-5{left:41.66666667%}._3pru9-col-lg-push-4{left:33.33333333%}._2lBUY-col-lg-push-3{left:25%}._3FuDW-col-lg-push-2{left:16.66666667%}._2YWH--col-lg-push-1{left:8.33333333%}._1iVNw-col-lg-push-0{left:auto}.uQRYD-col-lg-offset-12{margin-left:100%}._1tWxb-col-lg-offset-11{margin-left:91.66666667%}._10I1r-col-lg-offset-10{margin-left:83.33333333%}._3zXRb-col-lg-offset-9{margin-left:75%}._3HNmx-col-lg-offset-8{margin-left:66.66666667%}._3mIkn-col-lg-offset-7{margin-left:58.33333333%}._22SUB-col-lg-offset-6{margin-left:50%}._1CR4c-col-lg-offset-5{margin-left:41.66666667%}._1AWkt-col-lg-offset-4{margin-left:33.33333333%}._2BzzA-col-lg-offset-3{margin-left:25%}._3Egxi-col-lg-offset-2{margin-left:16.66666667%}._3QHjG-col-lg-offset-1{margin-left:8.33333333%}._3jTkc-col-lg-offset-0{margin-left:0}}table{background-color:transparent}table col[class*="col-"]{position:static;display:table-column;float:none}table td[class*="col-"],table th[class*="col-"]{position:static;display:table-cell;float:none}caption{padding-top:8px;padding-bottom:8px;color:#777;text-align:left}th{text-align:left}.fHH5l-table{width:100%;max-width:100%;margin-bottom:20px}.fHH5l-table>thead>tr>th,.fHH5l-table>tbody>tr>th,.fHH5l-table>tfoot>tr>th,.fHH5l-table>thead>tr>td,.fHH5l-table>tbody>tr>td,.fHH5l-table>tfoot>tr>td{padding:8px;line-height:1.42857143;vertical-align:top;border-top:1px solid #ddd}.fHH5l-table>thead>tr>th{vertical-align:bottom;border-bottom:2px solid #ddd}.fHH5l-table>caption+thead>tr:first-child>th,.fHH5l-table>colgroup+thead>tr:first-child>th,.fHH5l-table>thead:first-child>tr:first-child>th,.fHH5l-table>caption+thead>tr:first-child>td,.fHH5l-table>colgroup+thead>tr:first-child>td,.fHH5l-table>thead:first-child>tr:first-child>td{border-top:0}.fHH5l-table>tbody+tbody{border-top:2px solid #ddd}.fHH5l-table .fHH5l-table{background-color:#fff}._1lZ-G-table-condensed>thead>tr>th,._1lZ-G-table-condensed>tbody>tr>th,._1lZ-G-table-condensed>tfoot>tr>th,._1lZ-G-table-condensed>thead>tr>td,._1lZ-G-table-condensed>tbody>tr>td,._1lZ-G-table-condensed>tfoot>tr>td{padding:5px}._3qUkR-table-bordered{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>tbody>tr>th,._3qUkR-table-bordered>tfoot>tr>th,._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>tbody>tr>td,._3qUkR-table-bordered>tfoot>tr>td{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>th,._3qUkR-table-bordered>thead>tr>td{border-bottom-width:2px}._2ysFO-table-striped>tbody>tr:nth-of-type(odd){background-color:#f9f9f9}._3Qd5Z-table-hover>tbody>tr:hover{background-color:#f5f5f5}.fHH5l-table>thead>tr>td._2tvYY-active,.fHH5l-table>tbody>tr>td._2tvYY-active,.fHH5l-table>tfoot>tr>td._2tvYY-active,.fHH5l-table>thead>tr>th._2tvYY-active,.fHH5l-table>tbody>tr>th._2tvYY-active,.fHH5l-table>tfoot>tr>th._2tvYY-active,.fHH5l-table>thead>tr._2tvYY-active>td,.fHH5l-table>tbody>tr._2tvYY-active>td,.fHH5l-table>tfoot>tr._2tvYY-active>td,.fHH5l-table>thead>tr._2tvYY-active>th,.fHH5l-table>tbody>tr._2tvYY-active>th,.fHH5l-table>tfoot>tr._2tvYY-active>th{background-color:#f5f5f5}._3Qd5Z-table-hover>tbody>tr>td._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr>th._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2tvYY-active,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>th{background-color:#e8e8e8}.fHH5l-table>thead>tr>td._2OgG2-success,.fHH5l-table>tbody>tr>td._2OgG2-success,.fHH5l-table>tfoot>tr>td._2OgG2-success,.fHH5l-table>thead>tr>th._2OgG2-success,.fHH5l-table>tbody>tr>th._2OgG2-success,.fHH5l-table>tfoot>tr>th._2OgG2-success,.fHH5l-table>thead>tr._2OgG2-success>td,.fHH5l-table>tbody>tr._2OgG2-success>td,.fHH5l-table>tfoot>tr._2OgG2-success>td,.fHH5l-table>thead>tr._2OgG2-success>th,.fHH5l-table>tbody>tr._2OgG2-success>th,.fHH5l-table>tfoot>tr._2OgG2-success>th{background-color:#dff0d8}._3Qd5Z-table-hover>tbody>tr>td._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr>th._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2OgG2-success,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>th{background-color:#d0e9c6}.fHH5l-table>thead>tr>td._2ItI5-info,.fHH5l-table>tbody>tr>td._2ItI5-info,.fHH5l-table>tfoot>tr>td._2ItI5-info,.fHH5l-table>thead>tr>th._2ItI5-info,.fHH5l-table>tbody>tr>th._2ItI5-info,.fHH5l-table>tfoot>tr>th._2ItI5-info,.fHH5l-table>thead>tr._2ItI5-info>td,.fHH5l-table>tbody>tr._2ItI5-info>td,.fHH5l-table>tfoot>tr._2ItI5-info>td,.fHH5l-table>thead>tr._2ItI5-info>th,.fHH5l-table>tbody>tr._2ItI5-info>th,.fHH5l-table>tfoot>tr._2ItI5-info>th{background-color:#d9edf7}._3Qd5Z-table-hover>tbody>tr>td._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr>th._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>._2ItI5-info,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>th{background-color:#c4e3f3}.fHH5l-table>thead>tr>td.KrRM8-warning,.fHH5l-table>tbody>tr>td.KrRM8-warning,.fHH5l-table>tfoot>tr>td.KrRM8-warning,.fHH5l-table>thead>tr>th.KrRM8-warning,.fHH5l-table>tbody>tr>th.KrRM8-warning,.fHH5l-table>tfoot>tr>th.KrRM8-warning,.fHH5l-table>thead>tr.KrRM8-warning>td,.fHH5l-table>tbody>tr.KrRM8-warning>td,.fHH5l-table>tfoot>tr.KrRM8-warning>td,.fHH5l-table>thead>tr.KrRM8-warning>th,.fHH5l-table>tbody>tr.KrRM8-warning>th,.fHH5l-table>tfoot>tr.KrRM8-warning>th{background-color:#fcf8e3}._3Qd5Z-table-hover>tbody>tr>td.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr>th.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.KrRM8-warning,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>th{background-color:#faf2cc}.fHH5l-table>thead>tr>td.CwCgN-danger,.fHH5l-table>tbody>tr>td.CwCgN-danger,.fHH5l-table>tfoot>tr>td.CwCgN-danger,.fHH5l-table>thead>tr>th.CwCgN-danger,.fHH5l-table>tbody>tr>th.CwCgN-danger,.fHH5l-table>tfoot>tr>th.CwCgN-danger,.fHH5l-table>thead>tr.CwCgN-danger>td,.fHH5l-table>tbody>tr.CwCgN-danger>td,.fHH5l-table>tfoot>tr.CwCgN-danger>td,.fHH5l-table>thead>tr.CwCgN-danger>th,.fHH5l-table>tbody>tr.CwCgN-danger>th,.fHH5l-table>tfoot>tr.CwCgN-danger>th{background-color:#f2dede}._3Qd5Z-table-hover>tbody>tr>td.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr>th.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>td,._3Qd5Z-table-hover>tbody>tr:hover>.CwCgN-danger,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>th{background-color:#ebcccc}._3oGxj-table-responsive{min-height:.01%;overflow-x:auto}@media screen and (max-width:767px){._3oGxj-table-responsive{width:100%;margin-bottom:15px;overflow-y:hidden;-ms-overflow-style:-ms-autohiding-scrollbar;border:1px solid #ddd}._3oGxj-table-responsive>.fHH5l-table{margin-bottom:0}._3oGxj-table-responsive>.fHH5l-table>thead>tr>th,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>th,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>th,._3oGxj-table-responsive>.fHH5l-table>thead>tr>td,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>td,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>td{white-space:nowrap}._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td{border-bottom:0}}._3-XUl-btn{display:inline-block;margin-bottom:0;font-weight:normal;text-align:center;white-space:nowrap;vertical-align:middle;-ms-touch-action:manipulation;touch-action:manipulation;cursor:pointer;background-image:none;border:1px solid transparent;padding:6px 12px;font-size:14px;line-height:1.42857143;border-radius:4px;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}._3-XUl-btn:focus,._3-XUl-btn:active:focus,._3-XUl-btn._2tvYY-active:focus,._3-XUl-btn._2eLCD-focus,._3-XUl-btn:active._2eLCD-focus,._3-XUl-btn._2tvYY-active._2eLCD-focus{outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}._3-XUl-btn:hover,._3-XUl-btn:focus,._3-XUl-btn._2eLCD-focus{color:#333;text-decoration:none}._3-XUl-btn:active,._3-XUl-btn._2tvYY-active{background-image:none;outline:0;-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._3-XUl-btn._2UPmc-disabled,._3-XUl-btn[disabled],fieldset[disabled] ._3-XUl-btn{cursor:not-allowed;filter:alpha(opacity=65);opacity:.65;-webkit-box-shadow:none;box-shadow:none}a._3-XUl-btn._2UPmc-disabled,fieldset[disabled] a._3-XUl-btn{pointer-events:none}._7-4ed-btn-default{color:#333;background-color:#fff;border-color:#ccc}._7-4ed-btn-default:focus,._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#e6e6e6;border-color:#8c8c8c}._7-4ed-btn-default:hover{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default:active,._7-4ed-btn-default._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{color:#333;background-color:#e6e6e6;background-image:none;border-color:#adadad}._7-4ed-btn-default:active:hover,._7-4ed-btn-default._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:hover,._7-4ed-btn-default:active:focus,._7-4ed-btn-default._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:focus,._7-4ed-btn-default:active._2eLCD-focus,._7-4ed-btn-default._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default._2eLCD-focus{color:#333;background-color:#d4d4d4;border-color:#8c8c8c}._7-4ed-btn-default._2UPmc-disabled:hover,._7-4ed-btn-default[disabled]:hover,fieldset[disabled] ._7-4ed-btn-default:hover,._7-4ed-btn-default._2UPmc-disabled:focus,._7-4ed-btn-default[disabled]:focus,fieldset[disabled] ._7-4ed-btn-default:focus,._7-4ed-btn-default._2UPmc-disabled._2eLCD-focus,._7-4ed-btn-default[disabled]._2eLCD-focus,fieldset[disabled] ._7-4ed-btn-default._2eLCD-focus{background-color:#fff;border-color:#ccc}._7-4ed-btn-default ._1XX3z-badge{color:#fff;background-color:#333}._1VbPL-btn-primary{color:#fff;background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#286090;border-color:#122b40}._1VbPL-btn-primary:hover{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary:active,._1VbPL-btn-primary._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{color:#fff;background-color:#286090;background-image:none;border-color:#204d74}._1VbPL-btn-primary:active:hover,._1VbPL-btn-primary._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:hover,._1VbPL-btn-primary:active:focus,._1VbPL-btn-primary._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:focus,._1VbPL-btn-primary:active._2eLCD-focus,._1VbPL-btn-primary._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary._2eLCD-focus{color:#fff;background-color:#204d74;border-color:#122b40}._1VbPL-btn-primary._2UPmc-disabled:hover,._1VbPL-btn-primary[disabled]:hover,fieldset[disabled] ._1VbPL-btn-primary:hover,._1VbPL-btn-primary._2UPmc-disabled:focus,._1VbPL-btn-primary[disabled]:focus,fieldset[disabled] ._1VbPL-btn-primary:focus,._1VbPL-btn-primary._2UPmc-disabled._2eLCD-focus,._1VbPL-btn-primary[disabled]._2eLCD-focus,fieldset[disabled] ._1VbPL-btn-primary._2eLCD-focus{background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary ._1XX3z-badge{color:#337ab7;background-color:#fff}._1P0kt-btn-success{color:#fff;background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success:focus,._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#449d44;border-color:#255625}._1P0kt-btn-success:hover{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success:active,._1P0kt-btn-success._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{color:#fff;background-color:#449d44;background-image:none;border-color:#398439}._1P0kt-btn-success:active:hover,._1P0kt-btn-success._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:hover,._1P0kt-btn-success:active:focus,._1P0kt-btn-success._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:focus,._1P0kt-btn-success:active._2eLCD-focus,._1P0kt-btn-success._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success._2eLCD-focus{color:#fff;background-color:#398439;border-color:#255625}._1P0kt-btn-success._2UPmc-disabled:hover,._1P0kt-btn-success[disabled]:hover,fieldset[disabled] ._1P0kt-btn-success:hover,._1P0kt-btn-success._2UPmc-disabled:focus,._1P0kt-btn-success[disabled]:focus,fieldset[disabled] ._1P0kt-btn-success:focus,._1P0kt-btn-success._2UPmc-disabled._2eLCD-focus,._1P0kt-btn-success[disabled]._2eLCD-focus,fieldset[disabled] ._1P0kt-btn-success._2eLCD-focus{background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success ._1XX3z-badge{color:#5cb85c;background-color:#fff}._25yw2-btn-info{color:#fff;background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info:focus,._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#31b0d5;border-color:#1b6d85}._25yw2-btn-info:hover{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info:active,._25yw2-btn-info._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{color:#fff;background-color:#31b0d5;background-image:none;border-color:#269abc}._25yw2-btn-info:active:hover,._25yw2-btn-info._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:hover,._25yw2-btn-info:active:focus,._25yw2-btn-info._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:focus,._25yw2-btn-info:active._2eLCD-focus,._25yw2-btn-info._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info._2eLCD-focus{color:#fff;background-color:#269abc;border-color:#1b6d85}._25yw2-btn-info._2UPmc-disabled:hover,._25yw2-btn-info[disabled]:hover,fieldset[disabled] ._25yw2-btn-info:hover,._25yw2-btn-info._2UPmc-disabled:focus,._25yw2-btn-info[disabled]:focus,fieldset[disabled] ._25yw2-btn-info:focus,._25yw2-btn-info._2UPmc-disabled._2eLCD-focus,._25yw2-btn-info[disabled]._2eLCD-focus,fieldset[disabled] ._25yw2-btn-info._2eLCD-focus{background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info ._1XX3z-badge{color:#5bc0de;background-color:#fff}.-tgFO-btn-warning{color:#fff;background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning:focus,.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#ec971f;border-color:#985f0d}.-tgFO-btn-warning:hover{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning:active,.-tgFO-btn-warning._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{color:#fff;background-color:#ec971f;background-image:none;border-color:#d58512}.-tgFO-btn-warning:active:hover,.-tgFO-btn-warning._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:hover,.-tgFO-btn-warning:active:focus,.-tgFO-btn-warning._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:focus,.-tgFO-btn-warning:active._2eLCD-focus,.-tgFO-btn-warning._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning._2eLCD-focus{color:#fff;background-color:#d58512;border-color:#985f0d}.-tgFO-btn-warning._2UPmc-disabled:hover,.-tgFO-btn-warning[disabled]:hover,fieldset[disabled] .-tgFO-btn-warning:hover,.-tgFO-btn-warning._2UPmc-disabled:focus,.-tgFO-btn-warning[disabled]:focus,fieldset[disabled] .-tgFO-btn-warning:focus,.-tgFO-btn-warning._2UPmc-disabled._2eLCD-focus,.-tgFO-btn-warning[disabled]._2eLCD-focus,fieldset[disabled] .-tgFO-btn-warning._2eLCD-focus{background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning ._1XX3z-badge{color:#f0ad4e;background-color:#fff}._1fOWb-btn-danger{color:#fff;background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#c9302c;border-color:#761c19}._1fOWb-btn-danger:hover{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger:active,._1fOWb-btn-danger._2tvYY-active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{color:#fff;background-color:#c9302c;background-image:none;border-color:#ac2925}._1fOWb-btn-danger:active:hover,._1fOWb-btn-danger._2tvYY-active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:hover,._1fOWb-btn-danger:active:focus,._1fOWb-btn-danger._2tvYY-active:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:focus,._1fOWb-btn-danger:active._2eLCD-focus,._1fOWb-btn-danger._2tvYY-active._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger._2eLCD-focus{color:#fff;background-color:#ac2925;border-color:#761c19}._1fOWb-btn-danger._2UPmc-disabled:hover,._1fOWb-btn-danger[disabled]:hover,fieldset[disabled] ._1fOWb-btn-danger:hover,._1fOWb-btn-danger._2UPmc-disabled:focus,._1fOWb-btn-danger[disabled]:focus,fieldset[disabled] ._1fOWb-btn-danger:focus,._1fOWb-btn-danger._2UPmc-disabled._2eLCD-focus,._1fOWb-btn-danger[disabled]._2eLCD-focus,fieldset[disabled] ._1fOWb-btn-danger._2eLCD-focus{background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger ._1XX3z-badge{color:#d9534f;background-color:#fff}._2k5vU-btn-link{font-weight:400;color:#337ab7;border-radius:0}._2k5vU-btn-link,._2k5vU-btn-link:active,._2k5vU-btn-link._2tvYY-active,._2k5vU-btn-link[disabled],fieldset[disabled] ._2k5vU-btn-link{background-color:transparent;-webkit-box-shadow:none;box-shadow:none}._2k5vU-btn-link,._2k5vU-btn-link:hover,._2k5vU-btn-link:focus,._2k5vU-btn-link:active{border-color:transparent}._2k5vU-btn-link:hover,._2k5vU-btn-link:focus{color:#23527c;text-decoration:underline;background-color:transparent}._2k5vU-btn-link[disabled]:hover,fieldset[disabled] ._2k5vU-btn-link:hover,._2k5vU-btn-link[disabled]:focus,fieldset[disabled] ._2k5vU-btn-link:focus{color:#777;text-decoration:none}._4Y8qI-btn-lg,._29CyS-btn-group-lg>._3-XUl-btn{padding:10px 16px;font-size:18px;line-height:1.3333333;border-radius:6px}._30Oip-btn-sm,._2eEOC-btn-group-sm>._3-XUl-btn{padding:5px 10px;font-size:12px;line-height:1.5;border-radius:3px}._2kCvE-btn-xs,._1A2Tx-btn-group-xs>._3-XUl-btn{padding:1px 5px;font-size:12px;line-height:1.5;border-radius:3px}._3micb-btn-block{display:block;width:100%}._3micb-btn-block+._3micb-btn-block{margin-top:5px}input[type="submit"]._3micb-btn-block,input[type="reset"]._3micb-btn-block,input[type="button"]._3micb-btn-block{width:100%}._2yrMO-btn-group,.eNKyK-btn-group-vertical{position:relative;display:inline-block;vertical-align:middle}._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{position:relative;float:left}._2yrMO-btn-group>._3-XUl-btn:hover,.eNKyK-btn-group-vertical>._3-XUl-btn:hover,._2yrMO-btn-group>._3-XUl-btn:focus,.eNKyK-btn-group-vertical>._3-XUl-btn:focus,._2yrMO-btn-group>._3-XUl-btn:active,.eNKyK-btn-group-vertical>._3-XUl-btn:active,._2yrMO-btn-group>._3-XUl-btn._2tvYY-active,.eNKyK-btn-group-vertical>._3-XUl-btn._2tvYY-active{z-index:2}._2yrMO-btn-group ._3-XUl-btn+._3-XUl-btn,._2yrMO-btn-group ._3-XUl-btn+._2yrMO-btn-group,._2yrMO-btn-group ._2yrMO-btn-group+._3-XUl-btn,._2yrMO-btn-group ._2yrMO-btn-group+._2yrMO-btn-group{margin-left:-1px}._1TX6t-btn-toolbar{margin-left:-5px}._1TX6t-btn-toolbar ._3-XUl-btn,._1TX6t-btn-toolbar ._2yrMO-btn-group,._1TX6t-btn-toolbar ._2lgpZ-input-group{float:left}._1TX6t-btn-toolbar>._3-XUl-btn,._1TX6t-btn-toolbar>._2yrMO-btn-group,._1TX6t-btn-toolbar>._2lgpZ-input-group{margin-left:5px}._2yrMO-btn-group>._3-XUl-btn:not(:first-child):not(:last-child):not(._2KnYE-dropdown-toggle){border-radius:0}._2yrMO-btn-group>._3-XUl-btn:first-child{margin-left:0}._2yrMO-btn-group>._3-XUl-btn:first-child:not(:last-child):not(._2KnYE-dropdown-toggle){border-top-right-radius:0;border-bottom-right-radius:0}._2yrMO-btn-group>._3-XUl-btn:last-child:not(:first-child),._2yrMO-btn-group>._2KnYE-dropdown-toggle:not(:first-child){border-top-left-radius:0;border-bottom-left-radius:0}._2yrMO-btn-group>._2yrMO-btn-group{float:left}._2yrMO-btn-group>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-top-right-radius:0;border-bottom-right-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-left-radius:0;border-bottom-left-radius:0}._2yrMO-btn-group ._2KnYE-dropdown-toggle:active,._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{outline:0}._2yrMO-btn-group>._3-XUl-btn+._2KnYE-dropdown-toggle{padding-right:8px;padding-left:8px}._2yrMO-btn-group>._4Y8qI-btn-lg+._2KnYE-dropdown-toggle{padding-right:12px;padding-left:12px}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,0.125);box-shadow:inset 0 3px 5px rgba(0,0,0,0.125)}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle._2k5vU-btn-link{-webkit-box-shadow:none;box-shadow:none}._3-XUl-btn .WfXRY-caret{margin-left:0}._4Y8qI-btn-lg .WfXRY-caret{border-width:5px 5px 0;border-bottom-width:0}.xQM4X-dropup ._4Y8qI-btn-lg .WfXRY-caret{border-width:0 5px 5px}.eNKyK-btn-group-vertical>._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{display:block;float:none;width:100%;max-width:100%}.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{float:none}.eNKyK-btn-group-vertical>._3-XUl-btn+._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._3-XUl-btn,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._2yrMO-btn-group{margin-top:-1px;margin-left:0}.eNKyK-btn-group-vertical>._3-XUl-btn:not(:first-child):not(:last-child){border-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:first-child:not(:last-child){border-top-left-radius:4px;border-top-right-radius:4px;border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:last-child:not(:first-child){border-top-left-radius:0;border-top-right-radius:0;border-bottom-right-radius:4px;border-bottom-left-radius:4px}.eNKyK-btn-group-vertical>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child,.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle{border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-left-radius:0;border-top-right-radius:0}.gzM9c-btn-group-justified{display:table;width:100%;table-layout:fixed;border-collapse:separate}.gzM9c-btn-group-justified>._3-XUl-btn,.gzM9c-btn-group-justified>._2yrMO-btn-group{display:table-cell;float:none;width:1%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._3-XUl-btn{width:100%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._5aG9w-dropdown-menu{left:auto}[data-toggle="buttons"]>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="radio"],[data-toggle="buttons"]>._3-XUl-btn input[type="checkbox"],[data-toggle="buttons"]>._2yrMO-btn-group>._3-XUl-btn input[type="checkbox"]{position:absolute;clip:rect(0, 0, 0, 0);pointer-events:none}._18V3M-nav{padding-left:0;margin-bottom:0;list-style:none}._18V3M-nav>li{position:relative;display:block}._18V3M-nav>li>a{position:relative;display:block;padding:10px 15px}._18V3M-nav>li>a:hover,._18V3M-nav>li>a:focus{text-decoration:none;background-color:#eee}._18V3M-nav>li._2UPmc-disabled>a{color:#777}._18V3M-nav>li._2UPmc-disabled>a:hover,._18V3M-nav>li._2UPmc-disabled>a:focus{color:#777;text-decoration:none;cursor:not-allowed;background-color:transparent}._18V3M-nav .cl_nk-open>a,._18V3M-nav .cl_nk-open>a:hover,._18V3M-nav .cl_nk-open>a:focus{background-color:#eee;border-color:#337ab7}._18V3M-nav ._3jwhe-nav-divider{height:1px;margin:9px 0;overflow:hidden;background-color:#e5e5e5}._18V3M-nav>li>a>img{max-width:none}._2ciYP-nav-tabs{border-bottom:1px solid #ddd}._2ciYP-nav-tabs>li{float:left;margin-bottom:-1px}._2ciYP-nav-tabs>li>a{margin-right:2px;line-height:1.42857143;border:1px solid transparent;border-radius:4px 4px 0 0}._2ciYP-nav-tabs>li>a:hover{border-color:#eee #eee #ddd}._2ciYP-nav-tabs>li._2tvYY-active>a,._2ciYP-nav-tabs>li._2tvYY-active>a:hover,._2ciYP-nav-tabs>li._2tvYY-active>a:focus{color:#555;cursor:default;background-color:#fff;border:1px solid #ddd;border-bottom-color:transparent}._2ciYP-nav-tabs._3nLJA-nav-justified{width:100%;border-bottom:0}._2ciYP-nav-tabs._3nLJA-nav-justified>li{float:none}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:5px;text-align:center}._2ciYP-nav-tabs._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li{display:table-cell;width:1%}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:0}}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-right:0;border-radius:4px}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}._3i5kW-nav-pills>li{float:left}._3i5kW-nav-pills>li>a{border-radius:4px}._3i5kW-nav-pills>li+li{margin-left:2px}._3i5kW-nav-pills>li._2tvYY-active>a,._3i5kW-nav-pills>li._2tvYY-active>a:hover,._3i5kW-nav-pills>li._2tvYY-active>a:focus{color:#fff;background-color:#337ab7}._3pfVZ-nav-stacked>li{float:none}._3pfVZ-nav-stacked>li+li{margin-top:2px;margin-left:0}._3nLJA-nav-justified{width:100%}._3nLJA-nav-justified>li{float:none}._3nLJA-nav-justified>li>a{margin-bottom:5px;text-align:center}._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._3nLJA-nav-justified>li{display:table-cell;width:1%}._3nLJA-nav-justified>li>a{margin-bottom:0}}._18sQL-nav-tabs-justified{border-bottom:0}._18sQL-nav-tabs-justified>li>a{margin-right:0;border-radius:4px}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border:1px solid #ddd}@media (min-width:768px){._18sQL-nav-tabs-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus{border-bottom-color:#fff}}.FgbPD-tab-content>._2q1VF-tab-pane{display:none}.FgbPD-tab-content>._2tvYY-active{display:block}._2ciYP-nav-tabs ._5aG9w-dropdown-menu{margin-top:-1px;border-top-left-radius:0;border-top-right-radius:0}.n15Mn-pagination{display:inline-block;padding-left:0;margin:20px 0;border-radius:4px}.n15Mn-pagination>li{display:inline}.n15Mn-pagination>li>a,.n15Mn-pagination>li>span{position:relative;float:left;padding:6px 12px;margin-left:-1px;line-height:1.42857143;color:#337ab7;text-decoration:none;background-color:#fff;border:1px solid #ddd}.n15Mn-pagination>li>a:hover,.n15Mn-pagination>li>span:hover,.n15Mn-pagination>li>a:focus,.n15Mn-pagination>li>span:focus{z-index:2;color:#23527c;background-color:#eee;border-color:#ddd}.n15Mn-pagination>li:first-child>a,.n15Mn-pagination>li:first-child>span{margin-left:0;border-top-left-radius:4px;border-bottom-left-radius:4px}.n15Mn-pagination>li:last-child>a,.n15Mn-pagination>li:last-child>span{border-top-right-radius:4px;border-bottom-right-radius:4px}.n15Mn-pagination>._2tvYY-active>a,.n15Mn-pagination>._2tvYY-active>span,.n15Mn-pagination>._2tvYY-active>a:hover,.n15Mn-pagination>._2tvYY-active>span:hover,.n15Mn-pagination>._2tvYY-active>a:focus,.n15Mn-pagination>._2tvYY-active>span:focus{z-index:3;color:#fff;cursor:default;background-color:#337ab7;border-color:#337ab7}.n15Mn-pagination>._2UPmc-disabled>span,.n15Mn-pagination>._2UPmc-disabled>span:hover,.n15Mn-pagination>._2UPmc-disabled>span:focus,.n15Mn-pagination>._2UPmc-disabled>a,.n15Mn-pagination>._2UPmc-disabled>a:hover,.n15Mn-pagination>._2UPmc-disabled>a:focus{color:#777;cursor:not-allowed;background-color:#fff;border-color:#ddd}.L5I4g-pagination-lg>li>a,.L5I4g-pagination-lg>li>span{padding:10px 16px;font-size:18px;line-height:1.3333333}.L5I4g-pagination-lg>li:first-child>a,.L5I4g-pagination-lg>li:first-child>span{border-top-left-radius:6px;border-bottom-left-radius:6px}.L5I4g-pagination-lg>li:last-child>a,.L5I4g-pagination-lg>li:last-child>span{border-top-right-radius:6px;border-bottom-right-radius:6px}._3rY2q-pagination-sm>li>a,._3rY2q-pagination-sm>li>span{padding:5px 10px;font-size:12px;line-height:1.5}._3rY2q-pagination-sm>li:first-child>a,._3rY2q-pagination-sm>li:first-child>span{border-top-left-radius:3px;border-bottom-left-radius:3px}._3rY2q-pagination-sm>li:last-child>a,._3rY2q-pagination-sm>li:last-child>span{border-top-right-radius:3px;border-bottom-right-radius:3px}.bv_Mo-panel{margin-bottom:20px;background-color:#fff;border:1px solid transparent;border-radius:4px;-webkit-box-shadow:0 1px 1px rgba(0,0,0,0.05);box-shadow:0 1px 1px rgba(0,0,0,0.05)}._2wvLn-panel-body{padding:15px}._31mw8-panel-heading{padding:10px 15px;border-bottom:1px solid transparent;border-top-left-radius:3px;border-top-right-radius:3px}._31mw8-panel-heading>._3oGVD-dropdown ._2KnYE-dropdown-toggle{color:inherit}._112QQ-panel-title{margin-top:0;margin-bottom:0;font-size:16px;color:inherit}._112QQ-panel-title>a,._112QQ-panel-title>small,._112QQ-panel-title>._1xqEr-small,._112QQ-panel-title>small>a,._112QQ-panel-title>._1xqEr-small>a{color:inherit}._1qtb5-panel-footer{padding:10px 15px;background-color:#f5f5f5;border-top:1px solid #ddd;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group{margin-bottom:0}.bv_Mo-panel>._1vMKW-list-group .rhZLN-list-group-item,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item{border-width:1px 0;border-radius:0}.bv_Mo-panel>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child{border-top:0;border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child{border-bottom:0;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-left-radius:0;border-top-right-radius:0}._31mw8-panel-heading+._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-width:0}._1vMKW-list-group+._1qtb5-panel-footer{border-top-width:0}.bv_Mo-panel>.fHH5l-table,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table{margin-bottom:0}.bv_Mo-panel>.fHH5l-table caption,.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table caption,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table caption{padding-right:15px;padding-left:15px}.bv_Mo-panel>.fHH5l-table:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child{border-top-left-radius:3px;border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child{border-top-left-radius:3px}.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child{border-top-right-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child{border-bottom-left-radius:3px}.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child{border-bottom-right-radius:3px}.bv_Mo-panel>._2wvLn-panel-body+.fHH5l-table,.bv_Mo-panel>._2wvLn-panel-body+._3oGxj-table-responsive,.bv_Mo-panel>.fHH5l-table+._2wvLn-panel-body,.bv_Mo-panel>._3oGxj-table-responsive+._2wvLn-panel-body{border-top:1px solid #ddd}.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child th,.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child td{border-top:0}.bv_Mo-panel>._3qUkR-table-bordered,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child{border-left:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child{border-right:0}.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>th{border-bottom:0}.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th{border-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive{margin-bottom:0;border:0}._2V8WR-panel-group{margin-bottom:20px}._2V8WR-panel-group .bv_Mo-panel{margin-bottom:0;border-radius:4px}._2V8WR-panel-group .bv_Mo-panel+.bv_Mo-panel{margin-top:5px}._2V8WR-panel-group ._31mw8-panel-heading{border-bottom:0}._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body,._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group{border-top:1px solid #ddd}._2V8WR-panel-group ._1qtb5-panel-footer{border-top:0}._2V8WR-panel-group ._1qtb5-panel-footer+._162Ti-panel-collapse ._2wvLn-panel-body{border-bottom:1px solid #ddd}._2diFa-panel-default{border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading{color:#333;background-color:#f5f5f5;border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading ._1XX3z-badge{color:#f5f5f5;background-color:#333}._2diFa-panel-default>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ddd}._1I52t-panel-primary{border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading{color:#fff;background-color:#337ab7;border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading ._1XX3z-badge{color:#337ab7;background-color:#fff}._1I52t-panel-primary>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#337ab7}._1mEg2-panel-success{border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading{color:#3c763d;background-color:#dff0d8;border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading ._1XX3z-badge{color:#dff0d8;background-color:#3c763d}._1mEg2-panel-success>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#d6e9c6}._2rUCo-panel-info{border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading{color:#31708f;background-color:#d9edf7;border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading ._1XX3z-badge{color:#d9edf7;background-color:#31708f}._2rUCo-panel-info>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#bce8f1}._1ozkU-panel-warning{border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading{color:#8a6d3b;background-color:#fcf8e3;border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading ._1XX3z-badge{color:#fcf8e3;background-color:#8a6d3b}._1ozkU-panel-warning>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#faebcc}._3dZ2--panel-danger{border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading{color:#a94442;background-color:#f2dede;border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading ._1XX3z-badge{color:#f2dede;background-color:#a94442}._3dZ2--panel-danger>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ebccd1}._2U5Vc-clearfix:before,._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:before,._2DZom-dl-horizontal dd:after,.GfVfY-container:before,.GfVfY-container:after,._1TgHS-container-fluid:before,._1TgHS-container-fluid:after,._3XjgV-row:before,._3XjgV-row:after,._1TX6t-btn-toolbar:before,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:before,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:before,._18V3M-nav:after,._2wvLn-panel-body:before,._2wvLn-panel-body:after{display:table;content:" "}._2U5Vc-clearfix:after,._2DZom-dl-horizontal dd:after,.GfVfY-container:after,._1TgHS-container-fluid:after,._3XjgV-row:after,._1TX6t-btn-toolbar:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,._18V3M-nav:after,._2wvLn-panel-body:after{clear:both}._1efU5-center-block{display:block;margin-right:auto;margin-left:auto}._1DwIR-pull-right{float:right !important}._3XXWK-pull-left{float:left !important}.mceZN-hide{display:none !important}._3CqPx-show{display:block !important}._2ZgjX-invisible{visibility:hidden}._1y8Wm-text-hide{font:0/0 a;color:transparent;text-shadow:none;background-color:transparent;border:0}._15mml-hidden{display:none !important}.-JzKj-affix{position:fixed}@-ms-viewport{width:device-width}._3Pjmx-visible-xs,.vFgce-visible-sm,._3qTZ9-visible-md,.tk8mW-visible-lg{display:none !important}._1Dhak-visible-xs-block,._2NJ-T-visible-xs-inline,._1rjwZ-visible-xs-inline-block,._2cGpj-visible-sm-block,._14OBx-visible-sm-inline,._1IBCe-visible-sm-inline-block,._1b70N-visible-md-block,._2FNrX-visible-md-inline,._1MCNX-visible-md-inline-block,.yzCgL-visible-lg-block,._1Ad52-visible-lg-inline,._2hCmO-visible-lg-inline-block{display:none !important}@media (max-width:767px){._3Pjmx-visible-xs{display:block !important}table._3Pjmx-visible-xs{display:table !important}tr._3Pjmx-visible-xs{display:table-row !important}th._3Pjmx-visible-xs,td._3Pjmx-visible-xs{display:table-cell !important}}@media (max-width:767px){._1Dhak-visible-xs-block{display:block !important}}@media (max-width:767px){._2NJ-T-visible-xs-inline{display:inline !important}}@media (max-width:767px){._1rjwZ-visible-xs-inline-block{display:inline-block !important}}@media (min-width:768px) and (max-width:991px){.vFgce-visible-sm{display:block !important}table.vFgce-visible-sm{display:table !important}tr.vFgce-visible-sm{display:table-row !important}th.vFgce-visible-sm,td.vFgce-visible-sm{display:table-cell !important}}@media (min-width:768px) and (max-width:991px){._2cGpj-visible-sm-block{display:block !important}}@media (min-width:768px) and (max-width:991px){._14OBx-visible-sm-inline{display:inline !important}}@media (min-width:768px) and (max-width:991px){._1IBCe-visible-sm-inline-block{display:inline-block !important}}@media (min-width:992px) and (max-width:1199px){._3qTZ9-visible-md{display:block !important}table._3qTZ9-visible-md{display:table !important}tr._3qTZ9-visible-md{display:table-row !important}th._3qTZ9-visible-md,td._3qTZ9-visible-md{display:table-cell !important}}@media (min-width:992px) and (max-width:1199px){._1b70N-visible-md-block{display:block !important}}@media (min-width:992px) and (max-width:1199px){._2FNrX-visible-md-inline{display:inline !important}}@media (min-width:992px) and (max-width:1199px){._1MCNX-visible-md-inline-block{display:inline-block !important}}@media (min-width:1200px){.tk8mW-visible-lg{display:block !important}table.tk8mW-visible-lg{display:table !important}tr.tk8mW-visible-lg{display:table-row !important}th.tk8mW-visible-lg,td.tk8mW-visible-lg{display:table-cell !important}}@media (min-width:1200px){.yzCgL-visible-lg-block{display:block !important}}@media (min-width:1200px){._1Ad52-visible-lg-inline{display:inline !important}}@media (min-width:1200px){._2hCmO-visible-lg-inline-block{display:inline-block !important}}@media (max-width:767px){._1qlNJ-hidden-xs{display:none !important}}@media (min-width:768px) and (max-width:991px){._1UcP8-hidden-sm{display:none !important}}@media (min-width:992px) and (max-width:1199px){.TILkE-hidden-md{display:none !important}}@media (min-width:1200px){._2wXaD-hidden-lg{display:none !important}}._3ADjb-visible-print{display:none !important}@media print{._3ADjb-visible-print{display:block !important}table._3ADjb-visible-print{display:table !important}tr._3ADjb-visible-print{display:table-row !important}th._3ADjb-visible-print,td._3ADjb-visible-print{display:table-cell !important}}._3gdWc-visible-print-block{display:none !important}@media print{._3gdWc-visible-print-block{display:block !important}}._2HCJf-visible-print-inline{display:none !important}@media print{._2HCJf-visible-print-inline{display:inline !important}}._2oyVc-visible-print-inline-block{display:none !important}@media print{._2oyVc-visible-print-inline-block{display:inline-block !important}}@media print{.xjc1G-hidden-print{display:none !important}}',""]),t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","row-no-gutters":"_3-ExX-row-no-gutters",rowNoGutters:"_3-ExX-row-no-gutters","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t,n){!function(t,r){e.exports=r(n(0))}(0,function(e){return function(e){function t(r){if(n[r])return n[r].exports;var o=n[r]={i:r,l:!1,exports:{}};return e[r].call(o.exports,o,o.exports,t),o.l=!0,o.exports}var n={};return t.m=e,t.c=n,t.d=function(e,n,r){t.o(e,n)||Object.defineProperty(e,n,{enumerable:!0,get:r})},t.r=function(e){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(e,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(e,"__esModule",{value:!0})},t.t=function(e,n){if(1&n&&(e=t(e)),8&n)return e;if(4&n&&"object"==typeof e&&e&&e.__esModule)return e;var r=Object.create(null);if(t.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:e}),2&n&&"string"!=typeof e)for(var o in e)t.d(r,o,function(t){return e[t]}.bind(null,o));return r},t.n=function(e){var n=e&&e.__esModule?function(){return e.default}:function(){return e};return t.d(n,"a",n),n},t.o=function(e,t){return Object.prototype.hasOwnProperty.call(e,t)},t.p="",t(t.s=48)}([function(t,n){t.exports=e},function(e,t){var n=e.exports={version:"2.6.12"};"number"==typeof __e&&(__e=n)},function(e,t,n){var r=n(26)("wks"),o=n(17),a=n(3).Symbol,l="function"==typeof a;(e.exports=function(e){return r[e]||(r[e]=l&&a[e]||(l?a:o)("Symbol."+e))}).store=r},function(e,t){var n=e.exports="undefined"!=typeof window&&window.Math==Math?window:"undefined"!=typeof self&&self.Math==Math?self:Function("return this")();"number"==typeof __g&&(__g=n)},function(e,t,n){e.exports=!n(8)(function(){return 7!=Object.defineProperty({},"a",{get:function(){return 7}}).a})},function(e,t){var n={}.hasOwnProperty;e.exports=function(e,t){return n.call(e,t)}},function(e,t,n){var r=n(7),o=n(16);e.exports=n(4)?function(e,t,n){return r.f(e,t,o(1,n))}:function(e,t,n){return e[t]=n,e}},function(e,t,n){var r=n(10),o=n(35),a=n(23),l=Object.defineProperty;t.f=n(4)?Object.defineProperty:function(e,t,n){if(r(e),t=a(t,!0),r(n),o)try{return l(e,t,n)}catch(e){}if("get"in n||"set"in n)throw TypeError("Accessors not supported!");return"value"in n&&(e[t]=n.value),e}},function(e,t){e.exports=function(e){try{return!!e()}catch(e){return!0}}},function(e,t,n){var r=n(40),o=n(22);e.exports=function(e){return r(o(e))}},function(e,t,n){var r=n(11);e.exports=function(e){if(!r(e))throw TypeError(e+" is not an object!");return e}},function(e,t){e.exports=function(e){return"object"==typeof e?null!==e:"function"==typeof e}},function(e,t){e.exports={}},function(e,t,n){var r=n(39),o=n(27);e.exports=Object.keys||function(e){return r(e,o)}},function(e,t){e.exports=!0},function(e,t,n){var r=n(3),o=n(1),a=n(53),l=n(6),i=n(5),s=function(e,t,n){var c,u,f,d=e&s.F,p=e&s.G,b=e&s.S,h=e&s.P,m=e&s.B,g=e&s.W,v=p?o:o[t]||(o[t]={}),y=v.prototype,_=p?r:b?r[t]:(r[t]||{}).prototype;for(c in p&&(n=t),n)(u=!d&&_&&void 0!==_[c])&&i(v,c)||(f=u?_[c]:n[c],v[c]=p&&"function"!=typeof _[c]?n[c]:m&&u?a(f,r):g&&_[c]==f?function(e){var t=function(t,n,r){if(this instanceof e){switch(arguments.length){case 0:return new e;case 1:return new e(t);case 2:return new e(t,n)}return new e(t,n,r)}return e.apply(this,arguments)};return t.prototype=e.prototype,t}(f):h&&"function"==typeof f?a(Function.call,f):f,h&&((v.virtual||(v.virtual={}))[c]=f,e&s.R&&y&&!y[c]&&l(y,c,f)))};s.F=1,s.G=2,s.S=4,s.P=8,s.B=16,s.W=32,s.U=64,s.R=128,e.exports=s},function(e,t){e.exports=function(e,t){return{enumerable:!(1&e),configurable:!(2&e),writable:!(4&e),value:t}}},function(e,t){var n=0,r=Math.random();e.exports=function(e){return"Symbol(".concat(void 0===e?"":e,")_",(++n+r).toString(36))}},function(e,t,n){var r=n(22);e.exports=function(e){return Object(r(e))}},function(e,t){t.f={}.propertyIsEnumerable},function(e,t,n){"use strict";var r=n(52)(!0);n(34)(String,"String",function(e){this._t=String(e),this._i=0},function(){var e,t=this._t,n=this._i;return n>=t.length?{value:void 0,done:!0}:(e=r(t,n),this._i+=e.length,{value:e,done:!1})})},function(e,t){var n=Math.ceil,r=Math.floor;e.exports=function(e){return isNaN(e=+e)?0:(e>0?r:n)(e)}},function(e,t){e.exports=function(e){if(null==e)throw TypeError("Can't call method on  "+e);return e}},function(e,t,n){var r=n(11);e.exports=function(e,t){if(!r(e))return e;var n,o;if(t&&"function"==typeof(n=e.toString)&&!r(o=n.call(e)))return o;if("function"==typeof(n=e.valueOf)&&!r(o=n.call(e)))return o;if(!t&&"function"==typeof(n=e.toString)&&!r(o=n.call(e)))return o;throw TypeError("Can't convert object to primitive value")}},function(e,t){var n={}.toString;e.exports=function(e){return n.call(e).slice(8,-1)}},function(e,t,n){var r=n(26)("keys"),o=n(17);e.exports=function(e){return r[e]||(r[e]=o(e))}},function(e,t,n){var r=n(1),o=n(3),a=o["__core-js_shared__"]||(o["__core-js_shared__"]={});(e.exports=function(e,t){return a[e]||(a[e]=void 0!==t?t:{})})("versions",[]).push({version:r.version,mode:n(14)?"pure":"global",copyright:"© 2020 Denis Pushkarev (zloirock.ru)"})},function(e,t){e.exports="constructor,hasOwnProperty,isPrototypeOf,propertyIsEnumerable,toLocaleString,toString,valueOf".split(",")},function(e,t,n){var r=n(7).f,o=n(5),a=n(2)("toStringTag");e.exports=function(e,t,n){e&&!o(e=n?e:e.prototype,a)&&r(e,a,{configurable:!0,value:t})}},function(e,t,n){n(62);for(var r=n(3),o=n(6),a=n(12),l=n(2)("toStringTag"),i="CSSRuleList,CSSStyleDeclaration,CSSValueList,ClientRectList,DOMRectList,DOMStringList,DOMTokenList,DataTransferItemList,FileList,HTMLAllCollection,HTMLCollection,HTMLFormElement,HTMLSelectElement,MediaList,MimeTypeArray,NamedNodeMap,NodeList,PaintRequestList,Plugin,PluginArray,SVGLengthList,SVGNumberList,SVGPathSegList,SVGPointList,SVGStringList,SVGTransformList,SourceBufferList,StyleSheetList,TextTrackCueList,TextTrackList,TouchList".split(","),s=0;s<i.length;s++){var c=i[s],u=r[c],f=u&&u.prototype;f&&!f[l]&&o(f,l,c),a[c]=a.Array}},function(e,t,n){t.f=n(2)},function(e,t,n){var r=n(3),o=n(1),a=n(14),l=n(30),i=n(7).f;e.exports=function(e){var t=o.Symbol||(o.Symbol=a?{}:r.Symbol||{});"_"==e.charAt(0)||e in t||i(t,e,{value:l.f(e)})}},function(e,t){t.f=Object.getOwnPropertySymbols},function(e,t){e.exports=function(e,t,n){return Math.min(Math.max(e,t),n)}},function(e,t,n){"use strict";var r=n(14),o=n(15),a=n(37),l=n(6),i=n(12),s=n(55),c=n(28),u=n(61),f=n(2)("iterator"),d=!([].keys&&"next"in[].keys()),p=function(){return this};e.exports=function(e,t,n,b,h,m,g){s(n,t,b);var v,y,_,x=function(e){if(!d&&e in O)return O[e];switch(e){case"keys":case"values":return function(){return new n(this,e)}}return function(){return new n(this,e)}},w=t+" Iterator",k="values"==h,E=!1,O=e.prototype,P=O[f]||O["@@iterator"]||h&&O[h],C=P||x(h),j=h?k?x("entries"):C:void 0,S="Array"==t&&O.entries||P;if(S&&(_=u(S.call(new e)))!==Object.prototype&&_.next&&(c(_,w,!0),r||"function"==typeof _[f]||l(_,f,p)),k&&P&&"values"!==P.name&&(E=!0,C=function(){return P.call(this)}),r&&!g||!d&&!E&&O[f]||l(O,f,C),i[t]=C,i[w]=p,h)if(v={values:k?C:x("values"),keys:m?C:x("keys"),entries:j},g)for(y in v)y in O||a(O,y,v[y]);else o(o.P+o.F*(d||E),t,v);return v}},function(e,t,n){e.exports=!n(4)&&!n(8)(function(){return 7!=Object.defineProperty(n(36)("div"),"a",{get:function(){return 7}}).a})},function(e,t,n){var r=n(11),o=n(3).document,a=r(o)&&r(o.createElement);e.exports=function(e){return a?o.createElement(e):{}}},function(e,t,n){e.exports=n(6)},function(e,t,n){var r=n(10),o=n(56),a=n(27),l=n(25)("IE_PROTO"),i=function(){},s=function(){var e,t=n(36)("iframe"),r=a.length;for(t.style.display="none",n(60).appendChild(t),t.src="javascript:",(e=t.contentWindow.document).open(),e.write("<script>document.F=Object<\/script>"),e.close(),s=e.F;r--;)delete s.prototype[a[r]];return s()};e.exports=Object.create||function(e,t){var n;return null!==e?(i.prototype=r(e),n=new i,i.prototype=null,n[l]=e):n=s(),void 0===t?n:o(n,t)}},function(e,t,n){var r=n(5),o=n(9),a=n(57)(!1),l=n(25)("IE_PROTO");e.exports=function(e,t){var n,i=o(e),s=0,c=[];for(n in i)n!=l&&r(i,n)&&c.push(n);for(;t.length>s;)r(i,n=t[s++])&&(~a(c,n)||c.push(n));return c}},function(e,t,n){var r=n(24);e.exports=Object("z").propertyIsEnumerable(0)?Object:function(e){return"String"==r(e)?e.split(""):Object(e)}},function(e,t,n){var r=n(39),o=n(27).concat("length","prototype");t.f=Object.getOwnPropertyNames||function(e){return r(e,o)}},function(e,t,n){var r=n(24),o=n(2)("toStringTag"),a="Arguments"==r(function(){return arguments}());e.exports=function(e){var t,n,l;return void 0===e?"Undefined":null===e?"Null":"string"==typeof(n=function(e,t){try{return e[t]}catch(e){}}(t=Object(e),o))?n:a?r(t):"Object"==(l=r(t))&&"function"==typeof t.callee?"Arguments":l}},function(e,t){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(e){"object"==typeof window&&(n=window)}e.exports=n},function(e,t){var n=/-?\d+(\.\d+)?%?/g;e.exports=function(e){return e.match(n)}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}Object.defineProperty(t,"__esModule",{value:!0}),t.getBase16Theme=t.createStyling=t.invertTheme=void 0;var o=r(n(49)),a=r(n(76)),l=r(n(81)),i=r(n(89)),s=r(n(93)),c=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(n(94)),u=r(n(132)),f=r(n(133)),d=r(n(138)),p=n(139),b=c.default,h=(0,i.default)(b),m=(0,d.default)(f.default,p.rgb2yuv,function(e){var t,n=(0,l.default)(e,3),r=n[0],o=n[1],a=n[2];return[(t=r,t<.25?1:t<.5?.9-t:1.1-t),o,a]},p.yuv2rgb,u.default),g=function(e){return function(t){return{className:[t.className,e.className].filter(Boolean).join(" "),style:(0,a.default)({},t.style||{},e.style||{})}}},v=function(e,t){var n=(0,i.default)(t);for(var r in e)-1===n.indexOf(r)&&n.push(r);return n.reduce(function(n,r){return n[r]=function(e,t){if(void 0===e)return t;if(void 0===t)return e;var n=void 0===e?"undefined":(0,o.default)(e),r=void 0===t?"undefined":(0,o.default)(t);switch(n){case"string":switch(r){case"string":return[t,e].filter(Boolean).join(" ");case"object":return g({className:e,style:t});case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return g({className:e})(t.apply(void 0,[n].concat(o)))}}case"object":switch(r){case"string":return g({className:t,style:e});case"object":return(0,a.default)({},t,e);case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return g({style:e})(t.apply(void 0,[n].concat(o)))}}case"function":switch(r){case"string":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[g(n)({className:t})].concat(o))};case"object":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[g(n)({style:t})].concat(o))};case"function":return function(n){for(var r=arguments.length,o=Array(r>1?r-1:0),a=1;a<r;a++)o[a-1]=arguments[a];return e.apply(void 0,[t.apply(void 0,[n].concat(o))].concat(o))}}}}(e[r],t[r]),n},{})},y=function(e,t){for(var n=arguments.length,r=Array(n>2?n-2:0),l=2;l<n;l++)r[l-2]=arguments[l];if(null===t)return e;Array.isArray(t)||(t=[t]);var s=t.map(function(t){return e[t]}).filter(Boolean),c=s.reduce(function(e,t){return"string"==typeof t?e.className=[e.className,t].filter(Boolean).join(" "):"object"===(void 0===t?"undefined":(0,o.default)(t))?e.style=(0,a.default)({},e.style,t):"function"==typeof t&&(e=(0,a.default)({},e,t.apply(void 0,[e].concat(r)))),e},{className:"",style:{}});return c.className||delete c.className,0===(0,i.default)(c.style).length&&delete c.style,c},_=t.invertTheme=function(e){return(0,i.default)(e).reduce(function(t,n){return t[n]=/^base/.test(n)?m(e[n]):"scheme"===n?e[n]+":inverted":e[n],t},{})},x=(t.createStyling=(0,s.default)(function(e){for(var t=arguments.length,n=Array(t>3?t-3:0),r=3;r<t;r++)n[r-3]=arguments[r];var o=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{},l=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},c=o.defaultBase16,u=void 0===c?b:c,f=o.base16Themes,d=void 0===f?null:f,p=x(l,d);p&&(l=(0,a.default)({},p,l));var m=h.reduce(function(e,t){return e[t]=l[t]||u[t],e},{}),g=(0,i.default)(l).reduce(function(e,t){return-1===h.indexOf(t)?(e[t]=l[t],e):e},{}),_=e(m),w=v(g,_);return(0,s.default)(y,2).apply(void 0,[w].concat(n))},3),t.getBase16Theme=function(e,t){if(e&&e.extend&&(e=e.extend),"string"==typeof e){var n=e.split(":"),r=(0,l.default)(n,2),o=r[0],a=r[1];e=(t||{})[o]||c[o],"inverted"===a&&(e=_(e))}return e&&e.hasOwnProperty("base00")?e:void 0})},function(e,t,n){"use strict";function r(){r.init.call(this)}function o(e){if("function"!=typeof e)throw new TypeError('The "listener" argument must be of type Function. Received type '+typeof e)}function a(e){return void 0===e._maxListeners?r.defaultMaxListeners:e._maxListeners}function l(e,t,n,r){var l,i,s,c;if(o(n),void 0===(i=e._events)?(i=e._events=Object.create(null),e._eventsCount=0):(void 0!==i.newListener&&(e.emit("newListener",t,n.listener?n.listener:n),i=e._events),s=i[t]),void 0===s)s=i[t]=n,++e._eventsCount;else if("function"==typeof s?s=i[t]=r?[n,s]:[s,n]:r?s.unshift(n):s.push(n),(l=a(e))>0&&s.length>l&&!s.warned){s.warned=!0;var u=new Error("Possible EventEmitter memory leak detected. "+s.length+" "+String(t)+" listeners added. Use emitter.setMaxListeners() to increase limit");u.name="MaxListenersExceededWarning",u.emitter=e,u.type=t,u.count=s.length,c=u,console&&console.warn&&console.warn(c)}return e}function i(){if(!this.fired)return this.target.removeListener(this.type,this.wrapFn),this.fired=!0,0===arguments.length?this.listener.call(this.target):this.listener.apply(this.target,arguments)}function s(e,t,n){var r={fired:!1,wrapFn:void 0,target:e,type:t,listener:n},o=i.bind(r);return o.listener=n,r.wrapFn=o,o}function c(e,t,n){var r=e._events;if(void 0===r)return[];var o=r[t];return void 0===o?[]:"function"==typeof o?n?[o.listener||o]:[o]:n?function(e){for(var t=new Array(e.length),n=0;n<t.length;++n)t[n]=e[n].listener||e[n];return t}(o):f(o,o.length)}function u(e){var t=this._events;if(void 0!==t){var n=t[e];if("function"==typeof n)return 1;if(void 0!==n)return n.length}return 0}function f(e,t){for(var n=new Array(t),r=0;r<t;++r)n[r]=e[r];return n}var d,p="object"==typeof Reflect?Reflect:null,b=p&&"function"==typeof p.apply?p.apply:function(e,t,n){return Function.prototype.apply.call(e,t,n)};d=p&&"function"==typeof p.ownKeys?p.ownKeys:Object.getOwnPropertySymbols?function(e){return Object.getOwnPropertyNames(e).concat(Object.getOwnPropertySymbols(e))}:function(e){return Object.getOwnPropertyNames(e)};var h=Number.isNaN||function(e){return e!=e};e.exports=r,e.exports.once=function(e,t){return new Promise(function(n,r){function o(){void 0!==a&&e.removeListener("error",a),n([].slice.call(arguments))}var a;"error"!==t&&(a=function(n){e.removeListener(t,o),r(n)},e.once("error",a)),e.once(t,o)})},r.EventEmitter=r,r.prototype._events=void 0,r.prototype._eventsCount=0,r.prototype._maxListeners=void 0;var m=10;Object.defineProperty(r,"defaultMaxListeners",{enumerable:!0,get:function(){return m},set:function(e){if("number"!=typeof e||e<0||h(e))throw new RangeError('The value of "defaultMaxListeners" is out of range. It must be a non-negative number. Received '+e+".");m=e}}),r.init=function(){void 0!==this._events&&this._events!==Object.getPrototypeOf(this)._events||(this._events=Object.create(null),this._eventsCount=0),this._maxListeners=this._maxListeners||void 0},r.prototype.setMaxListeners=function(e){if("number"!=typeof e||e<0||h(e))throw new RangeError('The value of "n" is out of range. It must be a non-negative number. Received '+e+".");return this._maxListeners=e,this},r.prototype.getMaxListeners=function(){return a(this)},r.prototype.emit=function(e){for(var t=[],n=1;n<arguments.length;n++)t.push(arguments[n]);var r="error"===e,o=this._events;if(void 0!==o)r=r&&void 0===o.error;else if(!r)return!1;if(r){var a;if(t.length>0&&(a=t[0]),a instanceof Error)throw a;var l=new Error("Unhandled error."+(a?" ("+a.message+")":""));throw l.context=a,l}var i=o[e];if(void 0===i)return!1;if("function"==typeof i)b(i,this,t);else{var s=i.length,c=f(i,s);for(n=0;n<s;++n)b(c[n],this,t)}return!0},r.prototype.addListener=function(e,t){return l(this,e,t,!1)},r.prototype.on=r.prototype.addListener,r.prototype.prependListener=function(e,t){return l(this,e,t,!0)},r.prototype.once=function(e,t){return o(t),this.on(e,s(this,e,t)),this},r.prototype.prependOnceListener=function(e,t){return o(t),this.prependListener(e,s(this,e,t)),this},r.prototype.removeListener=function(e,t){var n,r,a,l,i;if(o(t),void 0===(r=this._events))return this;if(void 0===(n=r[e]))return this;if(n===t||n.listener===t)0==--this._eventsCount?this._events=Object.create(null):(delete r[e],r.removeListener&&this.emit("removeListener",e,n.listener||t));else if("function"!=typeof n){for(a=-1,l=n.length-1;l>=0;l--)if(n[l]===t||n[l].listener===t){i=n[l].listener,a=l;break}if(a<0)return this;0===a?n.shift():function(e,t){for(;t+1<e.length;t++)e[t]=e[t+1];e.pop()}(n,a),1===n.length&&(r[e]=n[0]),void 0!==r.removeListener&&this.emit("removeListener",e,i||t)}return this},r.prototype.off=r.prototype.removeListener,r.prototype.removeAllListeners=function(e){var t,n,r;if(void 0===(n=this._events))return this;if(void 0===n.removeListener)return 0===arguments.length?(this._events=Object.create(null),this._eventsCount=0):void 0!==n[e]&&(0==--this._eventsCount?this._events=Object.create(null):delete n[e]),this;if(0===arguments.length){var o,a=Object.keys(n);for(r=0;r<a.length;++r)"removeListener"!==(o=a[r])&&this.removeAllListeners(o);return this.removeAllListeners("removeListener"),this._events=Object.create(null),this._eventsCount=0,this}if("function"==typeof(t=n[e]))this.removeListener(e,t);else if(void 0!==t)for(r=t.length-1;r>=0;r--)this.removeListener(e,t[r]);return this},r.prototype.listeners=function(e){return c(this,e,!0)},r.prototype.rawListeners=function(e){return c(this,e,!1)},r.listenerCount=function(e,t){return"function"==typeof e.listenerCount?e.listenerCount(t):u.call(e,t)},r.prototype.listenerCount=u,r.prototype.eventNames=function(){return this._eventsCount>0?d(this._events):[]}},function(e,t,n){e.exports.Dispatcher=n(140)},function(e,t,n){e.exports=n(142)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=r(n(50)),a=r(n(65)),l="function"==typeof a.default&&"symbol"==typeof o.default?function(e){return typeof e}:function(e){return e&&"function"==typeof a.default&&e.constructor===a.default&&e!==a.default.prototype?"symbol":typeof e};t.default="function"==typeof a.default&&"symbol"===l(o.default)?function(e){return void 0===e?"undefined":l(e)}:function(e){return e&&"function"==typeof a.default&&e.constructor===a.default&&e!==a.default.prototype?"symbol":void 0===e?"undefined":l(e)}},function(e,t,n){e.exports={default:n(51),__esModule:!0}},function(e,t,n){n(20),n(29),e.exports=n(30).f("iterator")},function(e,t,n){var r=n(21),o=n(22);e.exports=function(e){return function(t,n){var a,l,i=String(o(t)),s=r(n),c=i.length;return s<0||s>=c?e?"":void 0:(a=i.charCodeAt(s))<55296||a>56319||s+1===c||(l=i.charCodeAt(s+1))<56320||l>57343?e?i.charAt(s):a:e?i.slice(s,s+2):l-56320+(a-55296<<10)+65536}}},function(e,t,n){var r=n(54);e.exports=function(e,t,n){if(r(e),void 0===t)return e;switch(n){case 1:return function(n){return e.call(t,n)};case 2:return function(n,r){return e.call(t,n,r)};case 3:return function(n,r,o){return e.call(t,n,r,o)}}return function(){return e.apply(t,arguments)}}},function(e,t){e.exports=function(e){if("function"!=typeof e)throw TypeError(e+" is not a function!");return e}},function(e,t,n){"use strict";var r=n(38),o=n(16),a=n(28),l={};n(6)(l,n(2)("iterator"),function(){return this}),e.exports=function(e,t,n){e.prototype=r(l,{next:o(1,n)}),a(e,t+" Iterator")}},function(e,t,n){var r=n(7),o=n(10),a=n(13);e.exports=n(4)?Object.defineProperties:function(e,t){o(e);for(var n,l=a(t),i=l.length,s=0;i>s;)r.f(e,n=l[s++],t[n]);return e}},function(e,t,n){var r=n(9),o=n(58),a=n(59);e.exports=function(e){return function(t,n,l){var i,s=r(t),c=o(s.length),u=a(l,c);if(e&&n!=n){for(;c>u;)if((i=s[u++])!=i)return!0}else for(;c>u;u++)if((e||u in s)&&s[u]===n)return e||u||0;return!e&&-1}}},function(e,t,n){var r=n(21),o=Math.min;e.exports=function(e){return e>0?o(r(e),9007199254740991):0}},function(e,t,n){var r=n(21),o=Math.max,a=Math.min;e.exports=function(e,t){return(e=r(e))<0?o(e+t,0):a(e,t)}},function(e,t,n){var r=n(3).document;e.exports=r&&r.documentElement},function(e,t,n){var r=n(5),o=n(18),a=n(25)("IE_PROTO"),l=Object.prototype;e.exports=Object.getPrototypeOf||function(e){return e=o(e),r(e,a)?e[a]:"function"==typeof e.constructor&&e instanceof e.constructor?e.constructor.prototype:e instanceof Object?l:null}},function(e,t,n){"use strict";var r=n(63),o=n(64),a=n(12),l=n(9);e.exports=n(34)(Array,"Array",function(e,t){this._t=l(e),this._i=0,this._k=t},function(){var e=this._t,t=this._k,n=this._i++;return!e||n>=e.length?(this._t=void 0,o(1)):o(0,"keys"==t?n:"values"==t?e[n]:[n,e[n]])},"values"),a.Arguments=a.Array,r("keys"),r("values"),r("entries")},function(e,t){e.exports=function(){}},function(e,t){e.exports=function(e,t){return{value:t,done:!!e}}},function(e,t,n){e.exports={default:n(66),__esModule:!0}},function(e,t,n){n(67),n(73),n(74),n(75),e.exports=n(1).Symbol},function(e,t,n){"use strict";var r=n(3),o=n(5),a=n(4),l=n(15),i=n(37),s=n(68).KEY,c=n(8),u=n(26),f=n(28),d=n(17),p=n(2),b=n(30),h=n(31),m=n(69),g=n(70),v=n(10),y=n(11),_=n(18),x=n(9),w=n(23),k=n(16),E=n(38),O=n(71),P=n(72),C=n(32),j=n(7),S=n(13),M=P.f,T=j.f,R=O.f,L=r.Symbol,N=r.JSON,A=N&&N.stringify,D=p("_hidden"),I=p("toPrimitive"),H={}.propertyIsEnumerable,F=u("symbol-registry"),U=u("symbols"),z=u("op-symbols"),q=Object.prototype,Y="function"==typeof L&&!!C.f,W=r.QObject,V=!W||!W.prototype||!W.prototype.findChild,B=a&&c(function(){return 7!=E(T({},"a",{get:function(){return T(this,"a",{value:7}).a}})).a})?function(e,t,n){var r=M(q,t);r&&delete q[t],T(e,t,n),r&&e!==q&&T(q,t,r)}:T,K=function(e){var t=U[e]=E(L.prototype);return t._k=e,t},X=Y&&"symbol"==typeof L.iterator?function(e){return"symbol"==typeof e}:function(e){return e instanceof L},G=function(e,t,n){return e===q&&G(z,t,n),v(e),t=w(t,!0),v(n),o(U,t)?(n.enumerable?(o(e,D)&&e[D][t]&&(e[D][t]=!1),n=E(n,{enumerable:k(0,!1)})):(o(e,D)||T(e,D,k(1,{})),e[D][t]=!0),B(e,t,n)):T(e,t,n)},Q=function(e,t){v(e);for(var n,r=m(t=x(t)),o=0,a=r.length;a>o;)G(e,n=r[o++],t[n]);return e},Z=function(e){var t=H.call(this,e=w(e,!0));return!(this===q&&o(U,e)&&!o(z,e))&&(!(t||!o(this,e)||!o(U,e)||o(this,D)&&this[D][e])||t)},J=function(e,t){if(e=x(e),t=w(t,!0),e!==q||!o(U,t)||o(z,t)){var n=M(e,t);return!n||!o(U,t)||o(e,D)&&e[D][t]||(n.enumerable=!0),n}},$=function(e){for(var t,n=R(x(e)),r=[],a=0;n.length>a;)o(U,t=n[a++])||t==D||t==s||r.push(t);return r},ee=function(e){for(var t,n=e===q,r=R(n?z:x(e)),a=[],l=0;r.length>l;)!o(U,t=r[l++])||n&&!o(q,t)||a.push(U[t]);return a};Y||(i((L=function(){if(this instanceof L)throw TypeError("Symbol is not a constructor!");var e=d(arguments.length>0?arguments[0]:void 0),t=function(n){this===q&&t.call(z,n),o(this,D)&&o(this[D],e)&&(this[D][e]=!1),B(this,e,k(1,n))};return a&&V&&B(q,e,{configurable:!0,set:t}),K(e)}).prototype,"toString",function(){return this._k}),P.f=J,j.f=G,n(41).f=O.f=$,n(19).f=Z,C.f=ee,a&&!n(14)&&i(q,"propertyIsEnumerable",Z,!0),b.f=function(e){return K(p(e))}),l(l.G+l.W+l.F*!Y,{Symbol:L});for(var te="hasInstance,isConcatSpreadable,iterator,match,replace,search,species,split,toPrimitive,toStringTag,unscopables".split(","),ne=0;te.length>ne;)p(te[ne++]);for(var re=S(p.store),oe=0;re.length>oe;)h(re[oe++]);l(l.S+l.F*!Y,"Symbol",{for:function(e){return o(F,e+="")?F[e]:F[e]=L(e)},keyFor:function(e){if(!X(e))throw TypeError(e+" is not a symbol!");for(var t in F)if(F[t]===e)return t},useSetter:function(){V=!0},useSimple:function(){V=!1}}),l(l.S+l.F*!Y,"Object",{create:function(e,t){return void 0===t?E(e):Q(E(e),t)},defineProperty:G,defineProperties:Q,getOwnPropertyDescriptor:J,getOwnPropertyNames:$,getOwnPropertySymbols:ee});var ae=c(function(){C.f(1)});l(l.S+l.F*ae,"Object",{getOwnPropertySymbols:function(e){return C.f(_(e))}}),N&&l(l.S+l.F*(!Y||c(function(){var e=L();return"[null]"!=A([e])||"{}"!=A({a:e})||"{}"!=A(Object(e))})),"JSON",{stringify:function(e){for(var t,n,r=[e],o=1;arguments.length>o;)r.push(arguments[o++]);if(n=t=r[1],(y(t)||void 0!==e)&&!X(e))return g(t)||(t=function(e,t){if("function"==typeof n&&(t=n.call(this,e,t)),!X(t))return t}),r[1]=t,A.apply(N,r)}}),L.prototype[I]||n(6)(L.prototype,I,L.prototype.valueOf),f(L,"Symbol"),f(Math,"Math",!0),f(r.JSON,"JSON",!0)},function(e,t,n){var r=n(17)("meta"),o=n(11),a=n(5),l=n(7).f,i=0,s=Object.isExtensible||function(){return!0},c=!n(8)(function(){return s(Object.preventExtensions({}))}),u=function(e){l(e,r,{value:{i:"O"+ ++i,w:{}}})},f=e.exports={KEY:r,NEED:!1,fastKey:function(e,t){if(!o(e))return"symbol"==typeof e?e:("string"==typeof e?"S":"P")+e;if(!a(e,r)){if(!s(e))return"F";if(!t)return"E";u(e)}return e[r].i},getWeak:function(e,t){if(!a(e,r)){if(!s(e))return!0;if(!t)return!1;u(e)}return e[r].w},onFreeze:function(e){return c&&f.NEED&&s(e)&&!a(e,r)&&u(e),e}}},function(e,t,n){var r=n(13),o=n(32),a=n(19);e.exports=function(e){var t=r(e),n=o.f;if(n)for(var l,i=n(e),s=a.f,c=0;i.length>c;)s.call(e,l=i[c++])&&t.push(l);return t}},function(e,t,n){var r=n(24);e.exports=Array.isArray||function(e){return"Array"==r(e)}},function(e,t,n){var r=n(9),o=n(41).f,a={}.toString,l="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[];e.exports.f=function(e){return l&&"[object Window]"==a.call(e)?function(e){try{return o(e)}catch(e){return l.slice()}}(e):o(r(e))}},function(e,t,n){var r=n(19),o=n(16),a=n(9),l=n(23),i=n(5),s=n(35),c=Object.getOwnPropertyDescriptor;t.f=n(4)?c:function(e,t){if(e=a(e),t=l(t,!0),s)try{return c(e,t)}catch(e){}if(i(e,t))return o(!r.f.call(e,t),e[t])}},function(e,t){},function(e,t,n){n(31)("asyncIterator")},function(e,t,n){n(31)("observable")},function(e,t,n){"use strict";t.__esModule=!0;var r,o=n(77),a=(r=o)&&r.__esModule?r:{default:r};t.default=a.default||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){e.exports={default:n(78),__esModule:!0}},function(e,t,n){n(79),e.exports=n(1).Object.assign},function(e,t,n){var r=n(15);r(r.S+r.F,"Object",{assign:n(80)})},function(e,t,n){"use strict";var r=n(4),o=n(13),a=n(32),l=n(19),i=n(18),s=n(40),c=Object.assign;e.exports=!c||n(8)(function(){var e={},t={},n=Symbol(),r="abcdefghijklmnopqrst";return e[n]=7,r.split("").forEach(function(e){t[e]=e}),7!=c({},e)[n]||Object.keys(c({},t)).join("")!=r})?function(e,t){for(var n=i(e),c=arguments.length,u=1,f=a.f,d=l.f;c>u;)for(var p,b=s(arguments[u++]),h=f?o(b).concat(f(b)):o(b),m=h.length,g=0;m>g;)p=h[g++],r&&!d.call(b,p)||(n[p]=b[p]);return n}:c},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=r(n(82)),a=r(n(85));t.default=function(e,t){if(Array.isArray(e))return e;if((0,o.default)(Object(e)))return function(e,t){var n=[],r=!0,o=!1,l=void 0;try{for(var i,s=(0,a.default)(e);!(r=(i=s.next()).done)&&(n.push(i.value),!t||n.length!==t);r=!0);}catch(e){o=!0,l=e}finally{try{!r&&s.return&&s.return()}finally{if(o)throw l}}return n}(e,t);throw new TypeError("Invalid attempt to destructure non-iterable instance")}},function(e,t,n){e.exports={default:n(83),__esModule:!0}},function(e,t,n){n(29),n(20),e.exports=n(84)},function(e,t,n){var r=n(42),o=n(2)("iterator"),a=n(12);e.exports=n(1).isIterable=function(e){var t=Object(e);return void 0!==t[o]||"@@iterator"in t||a.hasOwnProperty(r(t))}},function(e,t,n){e.exports={default:n(86),__esModule:!0}},function(e,t,n){n(29),n(20),e.exports=n(87)},function(e,t,n){var r=n(10),o=n(88);e.exports=n(1).getIterator=function(e){var t=o(e);if("function"!=typeof t)throw TypeError(e+" is not iterable!");return r(t.call(e))}},function(e,t,n){var r=n(42),o=n(2)("iterator"),a=n(12);e.exports=n(1).getIteratorMethod=function(e){if(null!=e)return e[o]||e["@@iterator"]||a[r(e)]}},function(e,t,n){e.exports={default:n(90),__esModule:!0}},function(e,t,n){n(91),e.exports=n(1).Object.keys},function(e,t,n){var r=n(18),o=n(13);n(92)("keys",function(){return function(e){return o(r(e))}})},function(e,t,n){var r=n(15),o=n(1),a=n(8);e.exports=function(e,t){var n=(o.Object||{})[e]||Object[e],l={};l[e]=t(n),r(r.S+r.F*a(function(){n(1)}),"Object",l)}},function(e,t,n){(function(t){function n(e,t,n){switch(n.length){case 0:return e.call(t);case 1:return e.call(t,n[0]);case 2:return e.call(t,n[0],n[1]);case 3:return e.call(t,n[0],n[1],n[2])}return e.apply(t,n)}function r(e,t){return!!(e?e.length:0)&&function(e,t,n){if(t!=t)return function(e,t,n,r){for(var o=e.length,a=-1;++a<o;)if(t(e[a],a,e))return a;return-1}(e,o);for(var r=-1,a=e.length;++r<a;)if(e[r]===t)return r;return-1}(e,t)>-1}function o(e){return e!=e}function a(e,t){for(var n=e.length,r=0;n--;)e[n]===t&&r++;return r}function l(e,t){for(var n=-1,r=e.length,o=0,a=[];++n<r;){var l=e[n];l!==t&&"__lodash_placeholder__"!==l||(e[n]="__lodash_placeholder__",a[o++]=n)}return a}function i(e){return k(e)?Z(e):{}}function s(e){return!(!k(e)||function(e){return!!B&&B in e}(e))&&(function(e){var t=k(e)?G.call(e):"";return"[object Function]"==t||"[object GeneratorFunction]"==t}(e)||function(e){var t=!1;if(null!=e&&"function"!=typeof e.toString)try{t=!!(e+"")}catch(e){}return t}(e)?Q:D).test(function(e){if(null!=e){try{return K.call(e)}catch(e){}try{return e+""}catch(e){}}return""}(e))}function c(e,t,n,r){for(var o=-1,a=e.length,l=n.length,i=-1,s=t.length,c=J(a-l,0),u=Array(s+c),f=!r;++i<s;)u[i]=t[i];for(;++o<l;)(f||o<a)&&(u[n[o]]=e[o]);for(;c--;)u[i++]=e[o++];return u}function u(e,t,n,r){for(var o=-1,a=e.length,l=-1,i=n.length,s=-1,c=t.length,u=J(a-i,0),f=Array(u+c),d=!r;++o<u;)f[o]=e[o];for(var p=o;++s<c;)f[p+s]=t[s];for(;++l<i;)(d||o<a)&&(f[p+n[l]]=e[o++]);return f}function f(e){return function(){var t=arguments;switch(t.length){case 0:return new e;case 1:return new e(t[0]);case 2:return new e(t[0],t[1]);case 3:return new e(t[0],t[1],t[2]);case 4:return new e(t[0],t[1],t[2],t[3]);case 5:return new e(t[0],t[1],t[2],t[3],t[4]);case 6:return new e(t[0],t[1],t[2],t[3],t[4],t[5]);case 7:return new e(t[0],t[1],t[2],t[3],t[4],t[5],t[6])}var n=i(e.prototype),r=e.apply(n,t);return k(r)?r:n}}function d(e,t,n,r,o,i,s,b,m,g){var v=128&t,y=1&t,x=2&t,w=24&t,k=512&t,E=x?void 0:f(e);return function O(){for(var P=arguments.length,C=Array(P),j=P;j--;)C[j]=arguments[j];if(w)var S=h(O),M=a(C,S);if(r&&(C=c(C,r,o,w)),i&&(C=u(C,i,s,w)),P-=M,w&&P<g){var T=l(C,S);return p(e,t,d,O.placeholder,n,C,T,b,m,g-P)}var R=y?n:this,L=x?R[e]:e;return P=C.length,b?C=_(C,b):k&&P>1&&C.reverse(),v&&m<P&&(C.length=m),this&&this!==q&&this instanceof O&&(L=E||f(L)),L.apply(R,C)}}function p(e,t,n,r,o,a,l,i,s,c){var u=8&t;t|=u?32:64,4&(t&=~(u?64:32))||(t&=-4);var f=n(e,t,o,u?a:void 0,u?l:void 0,u?void 0:a,u?void 0:l,i,s,c);return f.placeholder=r,te(f,e,t)}function b(e,t,r,o,a,i,s,c){var u=2&t;if(!u&&"function"!=typeof e)throw new TypeError("Expected a function");var b=o?o.length:0;if(b||(t&=-97,o=a=void 0),s=void 0===s?s:J(O(s),0),c=void 0===c?c:O(c),b-=a?a.length:0,64&t){var m=o,g=a;o=a=void 0}var v=[e,t,r,o,a,m,g,i,s,c];if(e=v[0],t=v[1],r=v[2],o=v[3],a=v[4],!(c=v[9]=null==v[9]?u?0:e.length:J(v[9]-b,0))&&24&t&&(t&=-25),t&&1!=t)y=8==t||16==t?function(e,t,r){var o=f(e);return function a(){for(var i=arguments.length,s=Array(i),c=i,u=h(a);c--;)s[c]=arguments[c];var f=i<3&&s[0]!==u&&s[i-1]!==u?[]:l(s,u);return(i-=f.length)<r?p(e,t,d,a.placeholder,void 0,s,f,void 0,void 0,r-i):n(this&&this!==q&&this instanceof a?o:e,this,s)}}(e,t,c):32!=t&&33!=t||a.length?d.apply(void 0,v):function(e,t,r,o){var a=1&t,l=f(e);return function t(){for(var i=-1,s=arguments.length,c=-1,u=o.length,f=Array(u+s),d=this&&this!==q&&this instanceof t?l:e;++c<u;)f[c]=o[c];for(;s--;)f[c++]=arguments[++i];return n(d,a?r:this,f)}}(e,t,r,o);else var y=function(e,t,n){var r=1&t,o=f(e);return function t(){return(this&&this!==q&&this instanceof t?o:e).apply(r?n:this,arguments)}}(e,t,r);return te(y,e,t)}function h(e){return e.placeholder}function m(e,t){var n=function(e,t){return null==e?void 0:e[t]}(e,t);return s(n)?n:void 0}function g(e){var t=e.match(R);return t?t[1].split(L):[]}function v(e,t){var n=t.length,r=n-1;return t[r]=(n>1?"& ":"")+t[r],t=t.join(n>2?", ":" "),e.replace(T,"{\n/* [wrapped with "+t+"] */\n")}function y(e,t){return!!(t=null==t?9007199254740991:t)&&("number"==typeof e||H.test(e))&&e>-1&&e%1==0&&e<t}function _(e,t){for(var n=e.length,r=$(t.length,n),o=function(e,t){var n=-1,r=e.length;for(t||(t=Array(r));++n<r;)t[n]=e[n];return t}(e);r--;){var a=t[r];e[r]=y(a,n)?o[a]:void 0}return e}function x(e,t){return function(e,t){for(var n=-1,o=e?e.length:0;++n<o&&!1!==function(n){var o="_."+n[0];t&n[1]&&!r(e,o)&&e.push(o)}(e[n]););}(S),e.sort()}function w(e,t,n){var r=b(e,8,void 0,void 0,void 0,void 0,void 0,t=n?void 0:t);return r.placeholder=w.placeholder,r}function k(e){var t=typeof e;return!!e&&("object"==t||"function"==t)}function E(e){return e?(e=function(e){if("number"==typeof e)return e;if(function(e){return"symbol"==typeof e||function(e){return!!e&&"object"==typeof e}(e)&&"[object Symbol]"==G.call(e)}(e))return NaN;if(k(e)){var t="function"==typeof e.valueOf?e.valueOf():e;e=k(t)?t+"":t}if("string"!=typeof e)return 0===e?e:+e;e=e.replace(M,"");var n=A.test(e);return n||I.test(e)?F(e.slice(2),n?2:8):N.test(e)?NaN:+e}(e))===1/0||e===-1/0?1.7976931348623157e308*(e<0?-1:1):e==e?e:0:0===e?e:0}function O(e){var t=E(e),n=t%1;return t==t?n?t-n:t:0}var P,C,j,S=[["ary",128],["bind",1],["bindKey",2],["curry",8],["curryRight",16],["flip",512],["partial",32],["partialRight",64],["rearg",256]],M=/^\s+|\s+$/g,T=/\{(?:\n\/\* \[wrapped with .+\] \*\/)?\n?/,R=/\{\n\/\* \[wrapped with (.+)\] \*/,L=/,? & /,N=/^[-+]0x[0-9a-f]+$/i,A=/^0b[01]+$/i,D=/^\[object .+?Constructor\]$/,I=/^0o[0-7]+$/i,H=/^(?:0|[1-9]\d*)$/,F=parseInt,U="object"==typeof t&&t&&t.Object===Object&&t,z="object"==typeof self&&self&&self.Object===Object&&self,q=U||z||Function("return this")(),Y=Function.prototype,W=Object.prototype,V=q["__core-js_shared__"],B=(P=/[^.]+$/.exec(V&&V.keys&&V.keys.IE_PROTO||""))?"Symbol(src)_1."+P:"",K=Y.toString,X=W.hasOwnProperty,G=W.toString,Q=RegExp("^"+K.call(X).replace(/[\\^$.*+?()[\]{}|]/g,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$"),Z=Object.create,J=Math.max,$=Math.min,ee=(C=m(Object,"defineProperty"),(j=m.name)&&j.length>2?C:void 0),te=ee?function(e,t,n){var r,o=t+"";return ee(e,"toString",{configurable:!0,enumerable:!1,value:(r=v(o,x(g(o),n)),function(){return r})})}:function(e){return e};w.placeholder={},e.exports=w}).call(this,n(43))},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e.default:e}t.__esModule=!0;var o=n(95);t.threezerotwofour=r(o);var a=n(96);t.apathy=r(a);var l=n(97);t.ashes=r(l);var i=n(98);t.atelierDune=r(i);var s=n(99);t.atelierForest=r(s);var c=n(100);t.atelierHeath=r(c);var u=n(101);t.atelierLakeside=r(u);var f=n(102);t.atelierSeaside=r(f);var d=n(103);t.bespin=r(d);var p=n(104);t.brewer=r(p);var b=n(105);t.bright=r(b);var h=n(106);t.chalk=r(h);var m=n(107);t.codeschool=r(m);var g=n(108);t.colors=r(g);var v=n(109);t.default=r(v);var y=n(110);t.eighties=r(y);var _=n(111);t.embers=r(_);var x=n(112);t.flat=r(x);var w=n(113);t.google=r(w);var k=n(114);t.grayscale=r(k);var E=n(115);t.greenscreen=r(E);var O=n(116);t.harmonic=r(O);var P=n(117);t.hopscotch=r(P);var C=n(118);t.isotope=r(C);var j=n(119);t.marrakesh=r(j);var S=n(120);t.mocha=r(S);var M=n(121);t.monokai=r(M);var T=n(122);t.ocean=r(T);var R=n(123);t.paraiso=r(R);var L=n(124);t.pop=r(L);var N=n(125);t.railscasts=r(N);var A=n(126);t.shapeshifter=r(A);var D=n(127);t.solarized=r(D);var I=n(128);t.summerfruit=r(I);var H=n(129);t.tomorrow=r(H);var F=n(130);t.tube=r(F);var U=n(131);t.twilight=r(U)},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"threezerotwofour",author:"jan t. sott (http://github.com/idleberg)",base00:"#090300",base01:"#3a3432",base02:"#4a4543",base03:"#5c5855",base04:"#807d7c",base05:"#a5a2a2",base06:"#d6d5d4",base07:"#f7f7f7",base08:"#db2d20",base09:"#e8bbd0",base0A:"#fded02",base0B:"#01a252",base0C:"#b5e4f4",base0D:"#01a0e4",base0E:"#a16a94",base0F:"#cdab53"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"apathy",author:"jannik siebert (https://github.com/janniks)",base00:"#031A16",base01:"#0B342D",base02:"#184E45",base03:"#2B685E",base04:"#5F9C92",base05:"#81B5AC",base06:"#A7CEC8",base07:"#D2E7E4",base08:"#3E9688",base09:"#3E7996",base0A:"#3E4C96",base0B:"#883E96",base0C:"#963E4C",base0D:"#96883E",base0E:"#4C963E",base0F:"#3E965B"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"ashes",author:"jannik siebert (https://github.com/janniks)",base00:"#1C2023",base01:"#393F45",base02:"#565E65",base03:"#747C84",base04:"#ADB3BA",base05:"#C7CCD1",base06:"#DFE2E5",base07:"#F3F4F5",base08:"#C7AE95",base09:"#C7C795",base0A:"#AEC795",base0B:"#95C7AE",base0C:"#95AEC7",base0D:"#AE95C7",base0E:"#C795AE",base0F:"#C79595"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier dune",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/dune)",base00:"#20201d",base01:"#292824",base02:"#6e6b5e",base03:"#7d7a68",base04:"#999580",base05:"#a6a28c",base06:"#e8e4cf",base07:"#fefbec",base08:"#d73737",base09:"#b65611",base0A:"#cfb017",base0B:"#60ac39",base0C:"#1fad83",base0D:"#6684e1",base0E:"#b854d4",base0F:"#d43552"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier forest",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/forest)",base00:"#1b1918",base01:"#2c2421",base02:"#68615e",base03:"#766e6b",base04:"#9c9491",base05:"#a8a19f",base06:"#e6e2e0",base07:"#f1efee",base08:"#f22c40",base09:"#df5320",base0A:"#d5911a",base0B:"#5ab738",base0C:"#00ad9c",base0D:"#407ee7",base0E:"#6666ea",base0F:"#c33ff3"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier heath",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/heath)",base00:"#1b181b",base01:"#292329",base02:"#695d69",base03:"#776977",base04:"#9e8f9e",base05:"#ab9bab",base06:"#d8cad8",base07:"#f7f3f7",base08:"#ca402b",base09:"#a65926",base0A:"#bb8a35",base0B:"#379a37",base0C:"#159393",base0D:"#516aec",base0E:"#7b59c0",base0F:"#cc33cc"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier lakeside",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/lakeside/)",base00:"#161b1d",base01:"#1f292e",base02:"#516d7b",base03:"#5a7b8c",base04:"#7195a8",base05:"#7ea2b4",base06:"#c1e4f6",base07:"#ebf8ff",base08:"#d22d72",base09:"#935c25",base0A:"#8a8a0f",base0B:"#568c3b",base0C:"#2d8f6f",base0D:"#257fad",base0E:"#5d5db1",base0F:"#b72dd2"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"atelier seaside",author:"bram de haan (http://atelierbram.github.io/syntax-highlighting/atelier-schemes/seaside/)",base00:"#131513",base01:"#242924",base02:"#5e6e5e",base03:"#687d68",base04:"#809980",base05:"#8ca68c",base06:"#cfe8cf",base07:"#f0fff0",base08:"#e6193c",base09:"#87711d",base0A:"#c3c322",base0B:"#29a329",base0C:"#1999b3",base0D:"#3d62f5",base0E:"#ad2bee",base0F:"#e619c3"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"bespin",author:"jan t. sott",base00:"#28211c",base01:"#36312e",base02:"#5e5d5c",base03:"#666666",base04:"#797977",base05:"#8a8986",base06:"#9d9b97",base07:"#baae9e",base08:"#cf6a4c",base09:"#cf7d34",base0A:"#f9ee98",base0B:"#54be0d",base0C:"#afc4db",base0D:"#5ea6ea",base0E:"#9b859d",base0F:"#937121"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"brewer",author:"timothée poisot (http://github.com/tpoisot)",base00:"#0c0d0e",base01:"#2e2f30",base02:"#515253",base03:"#737475",base04:"#959697",base05:"#b7b8b9",base06:"#dadbdc",base07:"#fcfdfe",base08:"#e31a1c",base09:"#e6550d",base0A:"#dca060",base0B:"#31a354",base0C:"#80b1d3",base0D:"#3182bd",base0E:"#756bb1",base0F:"#b15928"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"bright",author:"chris kempson (http://chriskempson.com)",base00:"#000000",base01:"#303030",base02:"#505050",base03:"#b0b0b0",base04:"#d0d0d0",base05:"#e0e0e0",base06:"#f5f5f5",base07:"#ffffff",base08:"#fb0120",base09:"#fc6d24",base0A:"#fda331",base0B:"#a1c659",base0C:"#76c7b7",base0D:"#6fb3d2",base0E:"#d381c3",base0F:"#be643c"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"chalk",author:"chris kempson (http://chriskempson.com)",base00:"#151515",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#b0b0b0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#f5f5f5",base08:"#fb9fb1",base09:"#eda987",base0A:"#ddb26f",base0B:"#acc267",base0C:"#12cfc0",base0D:"#6fc2ef",base0E:"#e1a3ee",base0F:"#deaf8f"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"codeschool",author:"brettof86",base00:"#232c31",base01:"#1c3657",base02:"#2a343a",base03:"#3f4944",base04:"#84898c",base05:"#9ea7a6",base06:"#a7cfa3",base07:"#b5d8f6",base08:"#2a5491",base09:"#43820d",base0A:"#a03b1e",base0B:"#237986",base0C:"#b02f30",base0D:"#484d79",base0E:"#c59820",base0F:"#c98344"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"colors",author:"mrmrs (http://clrs.cc)",base00:"#111111",base01:"#333333",base02:"#555555",base03:"#777777",base04:"#999999",base05:"#bbbbbb",base06:"#dddddd",base07:"#ffffff",base08:"#ff4136",base09:"#ff851b",base0A:"#ffdc00",base0B:"#2ecc40",base0C:"#7fdbff",base0D:"#0074d9",base0E:"#b10dc9",base0F:"#85144b"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"default",author:"chris kempson (http://chriskempson.com)",base00:"#181818",base01:"#282828",base02:"#383838",base03:"#585858",base04:"#b8b8b8",base05:"#d8d8d8",base06:"#e8e8e8",base07:"#f8f8f8",base08:"#ab4642",base09:"#dc9656",base0A:"#f7ca88",base0B:"#a1b56c",base0C:"#86c1b9",base0D:"#7cafc2",base0E:"#ba8baf",base0F:"#a16946"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"eighties",author:"chris kempson (http://chriskempson.com)",base00:"#2d2d2d",base01:"#393939",base02:"#515151",base03:"#747369",base04:"#a09f93",base05:"#d3d0c8",base06:"#e8e6df",base07:"#f2f0ec",base08:"#f2777a",base09:"#f99157",base0A:"#ffcc66",base0B:"#99cc99",base0C:"#66cccc",base0D:"#6699cc",base0E:"#cc99cc",base0F:"#d27b53"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"embers",author:"jannik siebert (https://github.com/janniks)",base00:"#16130F",base01:"#2C2620",base02:"#433B32",base03:"#5A5047",base04:"#8A8075",base05:"#A39A90",base06:"#BEB6AE",base07:"#DBD6D1",base08:"#826D57",base09:"#828257",base0A:"#6D8257",base0B:"#57826D",base0C:"#576D82",base0D:"#6D5782",base0E:"#82576D",base0F:"#825757"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"flat",author:"chris kempson (http://chriskempson.com)",base00:"#2C3E50",base01:"#34495E",base02:"#7F8C8D",base03:"#95A5A6",base04:"#BDC3C7",base05:"#e0e0e0",base06:"#f5f5f5",base07:"#ECF0F1",base08:"#E74C3C",base09:"#E67E22",base0A:"#F1C40F",base0B:"#2ECC71",base0C:"#1ABC9C",base0D:"#3498DB",base0E:"#9B59B6",base0F:"#be643c"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"google",author:"seth wright (http://sethawright.com)",base00:"#1d1f21",base01:"#282a2e",base02:"#373b41",base03:"#969896",base04:"#b4b7b4",base05:"#c5c8c6",base06:"#e0e0e0",base07:"#ffffff",base08:"#CC342B",base09:"#F96A38",base0A:"#FBA922",base0B:"#198844",base0C:"#3971ED",base0D:"#3971ED",base0E:"#A36AC7",base0F:"#3971ED"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"grayscale",author:"alexandre gavioli (https://github.com/alexx2/)",base00:"#101010",base01:"#252525",base02:"#464646",base03:"#525252",base04:"#ababab",base05:"#b9b9b9",base06:"#e3e3e3",base07:"#f7f7f7",base08:"#7c7c7c",base09:"#999999",base0A:"#a0a0a0",base0B:"#8e8e8e",base0C:"#868686",base0D:"#686868",base0E:"#747474",base0F:"#5e5e5e"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"green screen",author:"chris kempson (http://chriskempson.com)",base00:"#001100",base01:"#003300",base02:"#005500",base03:"#007700",base04:"#009900",base05:"#00bb00",base06:"#00dd00",base07:"#00ff00",base08:"#007700",base09:"#009900",base0A:"#007700",base0B:"#00bb00",base0C:"#005500",base0D:"#009900",base0E:"#00bb00",base0F:"#005500"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"harmonic16",author:"jannik siebert (https://github.com/janniks)",base00:"#0b1c2c",base01:"#223b54",base02:"#405c79",base03:"#627e99",base04:"#aabcce",base05:"#cbd6e2",base06:"#e5ebf1",base07:"#f7f9fb",base08:"#bf8b56",base09:"#bfbf56",base0A:"#8bbf56",base0B:"#56bf8b",base0C:"#568bbf",base0D:"#8b56bf",base0E:"#bf568b",base0F:"#bf5656"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"hopscotch",author:"jan t. sott",base00:"#322931",base01:"#433b42",base02:"#5c545b",base03:"#797379",base04:"#989498",base05:"#b9b5b8",base06:"#d5d3d5",base07:"#ffffff",base08:"#dd464c",base09:"#fd8b19",base0A:"#fdcc59",base0B:"#8fc13e",base0C:"#149b93",base0D:"#1290bf",base0E:"#c85e7c",base0F:"#b33508"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"isotope",author:"jan t. sott",base00:"#000000",base01:"#404040",base02:"#606060",base03:"#808080",base04:"#c0c0c0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#ffffff",base08:"#ff0000",base09:"#ff9900",base0A:"#ff0099",base0B:"#33ff00",base0C:"#00ffff",base0D:"#0066ff",base0E:"#cc00ff",base0F:"#3300ff"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"marrakesh",author:"alexandre gavioli (http://github.com/alexx2/)",base00:"#201602",base01:"#302e00",base02:"#5f5b17",base03:"#6c6823",base04:"#86813b",base05:"#948e48",base06:"#ccc37a",base07:"#faf0a5",base08:"#c35359",base09:"#b36144",base0A:"#a88339",base0B:"#18974e",base0C:"#75a738",base0D:"#477ca1",base0E:"#8868b3",base0F:"#b3588e"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"mocha",author:"chris kempson (http://chriskempson.com)",base00:"#3B3228",base01:"#534636",base02:"#645240",base03:"#7e705a",base04:"#b8afad",base05:"#d0c8c6",base06:"#e9e1dd",base07:"#f5eeeb",base08:"#cb6077",base09:"#d28b71",base0A:"#f4bc87",base0B:"#beb55b",base0C:"#7bbda4",base0D:"#8ab3b5",base0E:"#a89bb9",base0F:"#bb9584"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"monokai",author:"wimer hazenberg (http://www.monokai.nl)",base00:"#272822",base01:"#383830",base02:"#49483e",base03:"#75715e",base04:"#a59f85",base05:"#f8f8f2",base06:"#f5f4f1",base07:"#f9f8f5",base08:"#f92672",base09:"#fd971f",base0A:"#f4bf75",base0B:"#a6e22e",base0C:"#a1efe4",base0D:"#66d9ef",base0E:"#ae81ff",base0F:"#cc6633"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"ocean",author:"chris kempson (http://chriskempson.com)",base00:"#2b303b",base01:"#343d46",base02:"#4f5b66",base03:"#65737e",base04:"#a7adba",base05:"#c0c5ce",base06:"#dfe1e8",base07:"#eff1f5",base08:"#bf616a",base09:"#d08770",base0A:"#ebcb8b",base0B:"#a3be8c",base0C:"#96b5b4",base0D:"#8fa1b3",base0E:"#b48ead",base0F:"#ab7967"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"paraiso",author:"jan t. sott",base00:"#2f1e2e",base01:"#41323f",base02:"#4f424c",base03:"#776e71",base04:"#8d8687",base05:"#a39e9b",base06:"#b9b6b0",base07:"#e7e9db",base08:"#ef6155",base09:"#f99b15",base0A:"#fec418",base0B:"#48b685",base0C:"#5bc4bf",base0D:"#06b6ef",base0E:"#815ba4",base0F:"#e96ba8"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"pop",author:"chris kempson (http://chriskempson.com)",base00:"#000000",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#b0b0b0",base05:"#d0d0d0",base06:"#e0e0e0",base07:"#ffffff",base08:"#eb008a",base09:"#f29333",base0A:"#f8ca12",base0B:"#37b349",base0C:"#00aabb",base0D:"#0e5a94",base0E:"#b31e8d",base0F:"#7a2d00"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"railscasts",author:"ryan bates (http://railscasts.com)",base00:"#2b2b2b",base01:"#272935",base02:"#3a4055",base03:"#5a647e",base04:"#d4cfc9",base05:"#e6e1dc",base06:"#f4f1ed",base07:"#f9f7f3",base08:"#da4939",base09:"#cc7833",base0A:"#ffc66d",base0B:"#a5c261",base0C:"#519f50",base0D:"#6d9cbe",base0E:"#b6b3eb",base0F:"#bc9458"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"shapeshifter",author:"tyler benziger (http://tybenz.com)",base00:"#000000",base01:"#040404",base02:"#102015",base03:"#343434",base04:"#555555",base05:"#ababab",base06:"#e0e0e0",base07:"#f9f9f9",base08:"#e92f2f",base09:"#e09448",base0A:"#dddd13",base0B:"#0ed839",base0C:"#23edda",base0D:"#3b48e3",base0E:"#f996e2",base0F:"#69542d"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"solarized",author:"ethan schoonover (http://ethanschoonover.com/solarized)",base00:"#002b36",base01:"#073642",base02:"#586e75",base03:"#657b83",base04:"#839496",base05:"#93a1a1",base06:"#eee8d5",base07:"#fdf6e3",base08:"#dc322f",base09:"#cb4b16",base0A:"#b58900",base0B:"#859900",base0C:"#2aa198",base0D:"#268bd2",base0E:"#6c71c4",base0F:"#d33682"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"summerfruit",author:"christopher corley (http://cscorley.github.io/)",base00:"#151515",base01:"#202020",base02:"#303030",base03:"#505050",base04:"#B0B0B0",base05:"#D0D0D0",base06:"#E0E0E0",base07:"#FFFFFF",base08:"#FF0086",base09:"#FD8900",base0A:"#ABA800",base0B:"#00C918",base0C:"#1faaaa",base0D:"#3777E6",base0E:"#AD00A1",base0F:"#cc6633"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"tomorrow",author:"chris kempson (http://chriskempson.com)",base00:"#1d1f21",base01:"#282a2e",base02:"#373b41",base03:"#969896",base04:"#b4b7b4",base05:"#c5c8c6",base06:"#e0e0e0",base07:"#ffffff",base08:"#cc6666",base09:"#de935f",base0A:"#f0c674",base0B:"#b5bd68",base0C:"#8abeb7",base0D:"#81a2be",base0E:"#b294bb",base0F:"#a3685a"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"london tube",author:"jan t. sott",base00:"#231f20",base01:"#1c3f95",base02:"#5a5758",base03:"#737171",base04:"#959ca1",base05:"#d9d8d8",base06:"#e7e7e8",base07:"#ffffff",base08:"#ee2e24",base09:"#f386a1",base0A:"#ffd204",base0B:"#00853e",base0C:"#85cebc",base0D:"#009ddc",base0E:"#98005d",base0F:"#b06110"},e.exports=t.default},function(e,t,n){"use strict";t.__esModule=!0,t.default={scheme:"twilight",author:"david hart (http://hart-dev.com)",base00:"#1e1e1e",base01:"#323537",base02:"#464b50",base03:"#5f5a60",base04:"#838184",base05:"#a7a7a7",base06:"#c3c3c3",base07:"#ffffff",base08:"#cf6a4c",base09:"#cda869",base0A:"#f9ee98",base0B:"#8f9d6a",base0C:"#afc4db",base0D:"#7587a6",base0E:"#9b859d",base0F:"#9b703f"},e.exports=t.default},function(e,t,n){function r(e){var t=Math.round(o(e,0,255)).toString(16);return 1==t.length?"0"+t:t}var o=n(33);e.exports=function(e){var t=4===e.length?r(255*e[3]):"";return"#"+r(e[0])+r(e[1])+r(e[2])+t}},function(e,t,n){function r(e){for(var t in s)if(0===e.indexOf(t))return s[t](e)}var o=n(134),a=n(135),l=n(136),i=n(137),s={"#":a,hsl:function(e){var t=o(e),n=i(t);return 4===t.length&&n.push(t[3]),n},rgb:l};r.rgb=l,r.hsl=o,r.hex=a,e.exports=r},function(e,t,n){function r(e,t){switch(e=parseFloat(e),t){case 0:return a(e,0,360);case 1:case 2:return a(e,0,100);case 3:return a(e,0,1)}}var o=n(44),a=n(33);e.exports=function(e){return o(e).map(r)}},function(e,t){e.exports=function(e){4!==e.length&&5!==e.length||(e=function(e){for(var t="#",n=1;n<e.length;n++){var r=e.charAt(n);t+=r+r}return t}(e));var t=[parseInt(e.substring(1,3),16),parseInt(e.substring(3,5),16),parseInt(e.substring(5,7),16)];if(9===e.length){var n=parseFloat((parseInt(e.substring(7,9),16)/255).toFixed(2));t.push(n)}return t}},function(e,t,n){function r(e,t){return t<3?-1!=e.indexOf("%")?Math.round(255*a(parseInt(e,10),0,100)/100):a(parseInt(e,10),0,255):a(parseFloat(e),0,1)}var o=n(44),a=n(33);e.exports=function(e){return o(e).map(r)}},function(e,t){e.exports=function(e){var t,n,r,o,a,l=e[0]/360,i=e[1]/100,s=e[2]/100;if(0==i)return[a=255*s,a,a];t=2*s-(n=s<.5?s*(1+i):s+i-s*i),o=[0,0,0];for(var c=0;c<3;c++)(r=l+1/3*-(c-1))<0&&r++,r>1&&r--,a=6*r<1?t+6*(n-t)*r:2*r<1?n:3*r<2?t+(n-t)*(2/3-r)*6:t,o[c]=255*a;return o}},function(e,t,n){(function(t){function n(e,t,n){switch(n.length){case 0:return e.call(t);case 1:return e.call(t,n[0]);case 2:return e.call(t,n[0],n[1]);case 3:return e.call(t,n[0],n[1],n[2])}return e.apply(t,n)}function r(e,t){for(var n=-1,r=t.length,o=e.length;++n<r;)e[o+n]=t[n];return e}function o(e){return g(e)||function(e){return function(e){return function(e){return!!e&&"object"==typeof e}(e)&&function(e){return null!=e&&function(e){return"number"==typeof e&&e>-1&&e%1==0&&e<=9007199254740991}(e.length)&&!function(e){var t=function(e){var t=typeof e;return!!e&&("object"==t||"function"==t)}(e)?d.call(e):"";return"[object Function]"==t||"[object GeneratorFunction]"==t}(e)}(e)}(e)&&f.call(e,"callee")&&(!b.call(e,"callee")||"[object Arguments]"==d.call(e))}(e)||!!(h&&e&&e[h])}var a,l,i="object"==typeof t&&t&&t.Object===Object&&t,s="object"==typeof self&&self&&self.Object===Object&&self,c=i||s||Function("return this")(),u=Object.prototype,f=u.hasOwnProperty,d=u.toString,p=c.Symbol,b=u.propertyIsEnumerable,h=p?p.isConcatSpreadable:void 0,m=Math.max,g=Array.isArray,v=(a=function(e){var t=(e=function e(t,n,a,l,i){var s=-1,c=t.length;for(a||(a=o),i||(i=[]);++s<c;){var u=t[s];n>0&&a(u)?n>1?e(u,n-1,a,l,i):r(i,u):l||(i[i.length]=u)}return i}(e,1)).length,n=t;for(void 0;n--;)if("function"!=typeof e[n])throw new TypeError("Expected a function");return function(){for(var n=0,r=t?e[n].apply(this,arguments):arguments[0];++n<t;)r=e[n].call(this,r);return r}},l=m(void 0===l?a.length-1:l,0),function(){for(var e=arguments,t=-1,r=m(e.length-l,0),o=Array(r);++t<r;)o[t]=e[l+t];t=-1;for(var i=Array(l+1);++t<l;)i[t]=e[t];return i[l]=o,n(a,this,i)});e.exports=v}).call(this,n(43))},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.yuv2rgb=function(e){var t,n,r,o=e[0],a=e[1],l=e[2];return t=1*o+0*a+1.13983*l,n=1*o+-.39465*a+-.5806*l,r=1*o+2.02311*a+0*l,t=Math.min(Math.max(0,t),1),n=Math.min(Math.max(0,n),1),r=Math.min(Math.max(0,r),1),[255*t,255*n,255*r]},t.rgb2yuv=function(e){var t=e[0]/255,n=e[1]/255,r=e[2]/255;return[.299*t+.587*n+.114*r,-.14713*t+-.28886*n+.436*r,.615*t+-.51499*n+-.10001*r]}},function(e,t,n){"use strict";function r(e,t,n){return t in e?Object.defineProperty(e,t,{value:n,enumerable:!0,configurable:!0,writable:!0}):e[t]=n,e}var o=n(141),a=function(){function e(){r(this,"_callbacks",void 0),r(this,"_isDispatching",void 0),r(this,"_isHandled",void 0),r(this,"_isPending",void 0),r(this,"_lastID",void 0),r(this,"_pendingPayload",void 0),this._callbacks={},this._isDispatching=!1,this._isHandled={},this._isPending={},this._lastID=1}var t=e.prototype;return t.register=function(e){var t="ID_"+this._lastID++;return this._callbacks[t]=e,t},t.unregister=function(e){this._callbacks[e]||o(!1),delete this._callbacks[e]},t.waitFor=function(e){this._isDispatching||o(!1);for(var t=0;t<e.length;t++){var n=e[t];this._isPending[n]?this._isHandled[n]||o(!1):(this._callbacks[n]||o(!1),this._invokeCallback(n))}},t.dispatch=function(e){this._isDispatching&&o(!1),this._startDispatching(e);try{for(var t in this._callbacks)this._isPending[t]||this._invokeCallback(t)}finally{this._stopDispatching()}},t.isDispatching=function(){return this._isDispatching},t._invokeCallback=function(e){this._isPending[e]=!0,this._callbacks[e](this._pendingPayload),this._isHandled[e]=!0},t._startDispatching=function(e){for(var t in this._callbacks)this._isPending[t]=!1,this._isHandled[t]=!1;this._pendingPayload=e,this._isDispatching=!0},t._stopDispatching=function(){delete this._pendingPayload,this._isDispatching=!1},e}();e.exports=a},function(e,t,n){"use strict";e.exports=function(e,t){for(var n=arguments.length,r=new Array(n>2?n-2:0),o=2;o<n;o++)r[o-2]=arguments[o];if(!e){var a;if(void 0===t)a=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var l=0;(a=new Error(t.replace(/%s/g,function(){return String(r[l++])}))).name="Invariant Violation"}throw a.framesToPop=1,a}}},function(e,t,n){"use strict";function r(e,t,n){return t in e?Object.defineProperty(e,t,{value:n,enumerable:!0,configurable:!0,writable:!0}):e[t]=n,e}function o(e,t){var n=Object.keys(e);if(Object.getOwnPropertySymbols){var r=Object.getOwnPropertySymbols(e);t&&(r=r.filter(function(t){return Object.getOwnPropertyDescriptor(e,t).enumerable})),n.push.apply(n,r)}return n}function a(e){for(var t=1;t<arguments.length;t++){var n=null!=arguments[t]?arguments[t]:{};t%2?o(Object(n),!0).forEach(function(t){r(e,t,n[t])}):Object.getOwnPropertyDescriptors?Object.defineProperties(e,Object.getOwnPropertyDescriptors(n)):o(Object(n)).forEach(function(t){Object.defineProperty(e,t,Object.getOwnPropertyDescriptor(n,t))})}return e}function l(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function i(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}function s(e,t,n){return t&&i(e.prototype,t),n&&i(e,n),e}function c(e,t){return(c=Object.setPrototypeOf||function(e,t){return e.__proto__=t,e})(e,t)}function u(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function");e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,writable:!0,configurable:!0}}),t&&c(e,t)}function f(e){return(f=Object.setPrototypeOf?Object.getPrototypeOf:function(e){return e.__proto__||Object.getPrototypeOf(e)})(e)}function d(e){return(d="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e})(e)}function p(e){if(void 0===e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return e}function b(e,t){return!t||"object"!==d(t)&&"function"!=typeof t?p(e):t}function h(e){var t=function(){if("undefined"==typeof Reflect||!Reflect.construct)return!1;if(Reflect.construct.sham)return!1;if("function"==typeof Proxy)return!0;try{return Date.prototype.toString.call(Reflect.construct(Date,[],function(){})),!0}catch(e){return!1}}();return function(){var n,r=f(e);if(t){var o=f(this).constructor;n=Reflect.construct(r,arguments,o)}else n=r.apply(this,arguments);return b(this,n)}}function m(){var e=this.constructor.getDerivedStateFromProps(this.props,this.state);null!=e&&this.setState(e)}function g(e){this.setState(function(t){var n=this.constructor.getDerivedStateFromProps(e,t);return null!=n?n:null}.bind(this))}function v(e,t){try{var n=this.props,r=this.state;this.props=e,this.state=t,this.__reactInternalSnapshotFlag=!0,this.__reactInternalSnapshot=this.getSnapshotBeforeUpdate(n,r)}finally{this.props=n,this.state=r}}function y(e){var t=e.prototype;if(!t||!t.isReactComponent)throw new Error("Can only polyfill class components");if("function"!=typeof e.getDerivedStateFromProps&&"function"!=typeof t.getSnapshotBeforeUpdate)return e;var n=null,r=null,o=null;if("function"==typeof t.componentWillMount?n="componentWillMount":"function"==typeof t.UNSAFE_componentWillMount&&(n="UNSAFE_componentWillMount"),"function"==typeof t.componentWillReceiveProps?r="componentWillReceiveProps":"function"==typeof t.UNSAFE_componentWillReceiveProps&&(r="UNSAFE_componentWillReceiveProps"),"function"==typeof t.componentWillUpdate?o="componentWillUpdate":"function"==typeof t.UNSAFE_componentWillUpdate&&(o="UNSAFE_componentWillUpdate"),null!==n||null!==r||null!==o){var a=e.displayName||e.name,l="function"==typeof e.getDerivedStateFromProps?"getDerivedStateFromProps()":"getSnapshotBeforeUpdate()";throw Error("Unsafe legacy lifecycles will not be called for components using new component APIs.\n\n"+a+" uses "+l+" but also contains the following legacy lifecycles:"+(null!==n?"\n  "+n:"")+(null!==r?"\n  "+r:"")+(null!==o?"\n  "+o:"")+"\n\nThe above lifecycles should be removed. Learn more about this warning here:\nhttps://fb.me/react-async-component-lifecycle-hooks")}if("function"==typeof e.getDerivedStateFromProps&&(t.componentWillMount=m,t.componentWillReceiveProps=g),"function"==typeof t.getSnapshotBeforeUpdate){if("function"!=typeof t.componentDidUpdate)throw new Error("Cannot polyfill getSnapshotBeforeUpdate() for components that do not define componentDidUpdate() on the prototype");t.componentWillUpdate=v;var i=t.componentDidUpdate;t.componentDidUpdate=function(e,t,n){var r=this.__reactInternalSnapshotFlag?this.__reactInternalSnapshot:n;i.call(this,e,t,r)}}return e}function _(e,t){if(null==e)return{};var n,r,o=function(e,t){if(null==e)return{};var n,r,o={},a=Object.keys(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||(o[n]=e[n]);return o}(e,t);if(Object.getOwnPropertySymbols){var a=Object.getOwnPropertySymbols(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||Object.prototype.propertyIsEnumerable.call(e,n)&&(o[n]=e[n])}return o}function x(e){var t=function(e){return{}.toString.call(e).match(/\s([a-zA-Z]+)/)[1].toLowerCase()}(e);return"number"===t&&(t=isNaN(e)?"nan":(0|e)!=e?"float":"integer"),t}function w(e,t,n){return e||console.error("theme has not been set"),function(e){var t=D;return!1!==e&&"none"!==e||(t=I),Object(F.createStyling)(U,{defaultBase16:t})(e)}(e)(t,n)}function k(e,t){(null==t||t>e.length)&&(t=e.length);for(var n=0,r=new Array(t);n<t;n++)r[n]=e[n];return r}function E(e,t){if(e){if("string"==typeof e)return k(e,t);var n=Object.prototype.toString.call(e).slice(8,-1);return"Object"===n&&e.constructor&&(n=e.constructor.name),"Map"===n||"Set"===n?Array.from(e):"Arguments"===n||/^(?:Ui|I)nt(?:8|16|32)(?:Clamped)?Array$/.test(n)?k(e,t):void 0}}function O(e,t){var n;if("undefined"==typeof Symbol||null==e[Symbol.iterator]){if(Array.isArray(e)||(n=E(e))||t&&e&&"number"==typeof e.length){n&&(e=n);var r=0,o=function(){};return{s:o,n:function(){return r>=e.length?{done:!0}:{done:!1,value:e[r++]}},e:function(e){throw e},f:o}}throw new TypeError("Invalid attempt to iterate non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}var a,l=!0,i=!1;return{s:function(){n=e[Symbol.iterator]()},n:function(){var e=n.next();return l=e.done,e},e:function(e){i=!0,a=e},f:function(){try{l||null==n.return||n.return()}finally{if(i)throw a}}}}function P(e){return function(e){if(Array.isArray(e))return k(e)}(e)||function(e){if("undefined"!=typeof Symbol&&Symbol.iterator in Object(e))return Array.from(e)}(e)||E(e)||function(){throw new TypeError("Invalid attempt to spread non-iterable instance.\nIn order to be iterable, non-array objects must have a [Symbol.iterator]() method.")}()}function C(){return(C=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}).apply(this,arguments)}function j(e){e=e.trim();try{if("["===(e=JSON.stringify(JSON.parse(e)))[0])return S("array",JSON.parse(e));if("{"===e[0])return S("object",JSON.parse(e));if(e.match(/\-?\d+\.\d+/)&&e.match(/\-?\d+\.\d+/)[0]===e)return S("float",parseFloat(e));if(e.match(/\-?\d+e-\d+/)&&e.match(/\-?\d+e-\d+/)[0]===e)return S("float",Number(e));if(e.match(/\-?\d+/)&&e.match(/\-?\d+/)[0]===e)return S("integer",parseInt(e));if(e.match(/\-?\d+e\+\d+/)&&e.match(/\-?\d+e\+\d+/)[0]===e)return S("integer",Number(e))}catch(e){}switch(e=e.toLowerCase()){case"undefined":return S("undefined",void 0);case"nan":return S("nan",NaN);case"null":return S("null",null);case"true":return S("boolean",!0);case"false":return S("boolean",!1);default:if(e=Date.parse(e))return S("date",new Date(e))}return S(!1,null)}function S(e,t){return{type:e,value:t}}function M(e){return e||(e={}),{style:a(a({verticalAlign:"middle"},e),{},{color:e.color?e.color:"#000000",height:"1em",width:"1em"})}}function T(e){var t=e.parent_type,n=e.namespace,r=e.quotesOnKeys,o=e.theme,a=e.jsvRoot,l=e.name,i=e.displayArrayKey,s=e.name?e.name:"";return!a||!1!==l&&null!==l?"array"==t?i?A.a.createElement("span",Object.assign({},w(o,"array-key"),{key:n}),A.a.createElement("span",{className:"array-key"},s),A.a.createElement("span",w(o,"colon"),":")):A.a.createElement("span",null):A.a.createElement("span",Object.assign({},w(o,"object-name"),{key:n}),A.a.createElement("span",{className:"object-key"},r&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"'),A.a.createElement("span",null,s),r&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"')),A.a.createElement("span",w(o,"colon"),":")):A.a.createElement("span",null)}function R(e){var t=e.theme;switch(e.iconStyle){case"triangle":return A.a.createElement(ye,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}));case"square":return A.a.createElement(me,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}));default:return A.a.createElement(be,Object.assign({},w(t,"expanded-icon"),{className:"expanded-icon"}))}}function L(e){var t=e.theme;switch(e.iconStyle){case"triangle":return A.a.createElement(ve,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}));case"square":return A.a.createElement(ge,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}));default:return A.a.createElement(he,Object.assign({},w(t,"collapsed-icon"),{className:"collapsed-icon"}))}}n.r(t);var N=n(0),A=n.n(N);m.__suppressDeprecationWarning=!0,g.__suppressDeprecationWarning=!0,v.__suppressDeprecationWarning=!0;var D={scheme:"rjv-default",author:"mac gainor",base00:"rgba(0, 0, 0, 0)",base01:"rgb(245, 245, 245)",base02:"rgb(235, 235, 235)",base03:"#93a1a1",base04:"rgba(0, 0, 0, 0.3)",base05:"#586e75",base06:"#073642",base07:"#002b36",base08:"#d33682",base09:"#cb4b16",base0A:"#dc322f",base0B:"#859900",base0C:"#6c71c4",base0D:"#586e75",base0E:"#2aa198",base0F:"#268bd2"},I={scheme:"rjv-grey",author:"mac gainor",base00:"rgba(1, 1, 1, 0)",base01:"rgba(1, 1, 1, 0.1)",base02:"rgba(0, 0, 0, 0.2)",base03:"rgba(1, 1, 1, 0.3)",base04:"rgba(0, 0, 0, 0.4)",base05:"rgba(1, 1, 1, 0.5)",base06:"rgba(1, 1, 1, 0.6)",base07:"rgba(1, 1, 1, 0.7)",base08:"rgba(1, 1, 1, 0.8)",base09:"rgba(1, 1, 1, 0.8)",base0A:"rgba(1, 1, 1, 0.8)",base0B:"rgba(1, 1, 1, 0.8)",base0C:"rgba(1, 1, 1, 0.8)",base0D:"rgba(1, 1, 1, 0.8)",base0E:"rgba(1, 1, 1, 0.8)",base0F:"rgba(1, 1, 1, 0.8)"},H={white:"#fff",black:"#000",transparent:"rgba(1, 1, 1, 0)",globalFontFamily:"monospace",globalCursor:"default",indentBlockWidth:"5px",braceFontWeight:"bold",braceCursor:"pointer",ellipsisFontSize:"18px",ellipsisLineHeight:"10px",ellipsisCursor:"pointer",keyMargin:"0px 5px",keyLetterSpacing:"0.5px",keyFontStyle:"none",keyBorderRadius:"3px",keyColonWeight:"bold",keyVerticalAlign:"top",keyOpacity:"0.85",keyOpacityHover:"1",keyValPaddingTop:"3px",keyValPaddingBottom:"3px",keyValPaddingRight:"5px",keyValBorderLeft:"1px solid",keyValBorderHover:"2px solid",keyValPaddingHover:"3px 5px 3px 4px",pushedContentMarginLeft:"6px",variableValuePaddingRight:"6px",nullFontSize:"11px",nullFontWeight:"bold",nullPadding:"1px 2px",nullBorderRadius:"3px",nanFontSize:"11px",nanFontWeight:"bold",nanPadding:"1px 2px",nanBorderRadius:"3px",undefinedFontSize:"11px",undefinedFontWeight:"bold",undefinedPadding:"1px 2px",undefinedBorderRadius:"3px",dataTypeFontSize:"11px",dataTypeMarginRight:"4px",datatypeOpacity:"0.8",objectSizeBorderRadius:"3px",objectSizeFontStyle:"italic",objectSizeMargin:"0px 6px 0px 0px",clipboardCursor:"pointer",clipboardCheckMarginLeft:"-12px",metaDataPadding:"0px 0px 0px 10px",arrayGroupMetaPadding:"0px 0px 0px 4px",iconContainerWidth:"17px",tooltipPadding:"4px",editInputMinWidth:"130px",editInputBorderRadius:"2px",editInputPadding:"5px",editInputMarginRight:"4px",editInputFontFamily:"monospace",iconCursor:"pointer",iconFontSize:"15px",iconPaddingRight:"1px",dateValueMarginLeft:"2px",iconMarginRight:"3px",detectedRowPaddingTop:"3px",addKeyCoverBackground:"rgba(255, 255, 255, 0.3)",addKeyCoverPosition:"absolute",addKeyCoverPositionPx:"0px",addKeyModalWidth:"200px",addKeyModalMargin:"auto",addKeyModalPadding:"10px",addKeyModalRadius:"3px"},F=n(45),U=function(e){var t=function(e){return{backgroundColor:e.base00,ellipsisColor:e.base09,braceColor:e.base07,expandedIcon:e.base0D,collapsedIcon:e.base0E,keyColor:e.base07,arrayKeyColor:e.base0C,objectSize:e.base04,copyToClipboard:e.base0F,copyToClipboardCheck:e.base0D,objectBorder:e.base02,dataTypes:{boolean:e.base0E,date:e.base0D,float:e.base0B,function:e.base0D,integer:e.base0F,string:e.base09,nan:e.base08,null:e.base0A,undefined:e.base05,regexp:e.base0A,background:e.base02},editVariable:{editIcon:e.base0E,cancelIcon:e.base09,removeIcon:e.base09,addIcon:e.base0E,checkIcon:e.base0E,background:e.base01,color:e.base0A,border:e.base07},addKeyModal:{background:e.base05,border:e.base04,color:e.base0A,labelColor:e.base01},validationFailure:{background:e.base09,iconColor:e.base01,fontColor:e.base01}}}(e);return{"app-container":{fontFamily:H.globalFontFamily,cursor:H.globalCursor,backgroundColor:t.backgroundColor,position:"relative"},ellipsis:{display:"inline-block",color:t.ellipsisColor,fontSize:H.ellipsisFontSize,lineHeight:H.ellipsisLineHeight,cursor:H.ellipsisCursor},"brace-row":{display:"inline-block",cursor:"pointer"},brace:{display:"inline-block",cursor:H.braceCursor,fontWeight:H.braceFontWeight,color:t.braceColor},"expanded-icon":{color:t.expandedIcon},"collapsed-icon":{color:t.collapsedIcon},colon:{display:"inline-block",margin:H.keyMargin,color:t.keyColor,verticalAlign:"top"},objectKeyVal:function(e,n){return{style:a({paddingTop:H.keyValPaddingTop,paddingRight:H.keyValPaddingRight,paddingBottom:H.keyValPaddingBottom,borderLeft:H.keyValBorderLeft+" "+t.objectBorder,":hover":{paddingLeft:n.paddingLeft-1+"px",borderLeft:H.keyValBorderHover+" "+t.objectBorder}},n)}},"object-key-val-no-border":{padding:H.keyValPadding},"pushed-content":{marginLeft:H.pushedContentMarginLeft},variableValue:function(e,t){return{style:a({display:"inline-block",paddingRight:H.variableValuePaddingRight,position:"relative"},t)}},"object-name":{display:"inline-block",color:t.keyColor,letterSpacing:H.keyLetterSpacing,fontStyle:H.keyFontStyle,verticalAlign:H.keyVerticalAlign,opacity:H.keyOpacity,":hover":{opacity:H.keyOpacityHover}},"array-key":{display:"inline-block",color:t.arrayKeyColor,letterSpacing:H.keyLetterSpacing,fontStyle:H.keyFontStyle,verticalAlign:H.keyVerticalAlign,opacity:H.keyOpacity,":hover":{opacity:H.keyOpacityHover}},"object-size":{color:t.objectSize,borderRadius:H.objectSizeBorderRadius,fontStyle:H.objectSizeFontStyle,margin:H.objectSizeMargin,cursor:"default"},"data-type-label":{fontSize:H.dataTypeFontSize,marginRight:H.dataTypeMarginRight,opacity:H.datatypeOpacity},boolean:{display:"inline-block",color:t.dataTypes.boolean},date:{display:"inline-block",color:t.dataTypes.date},"date-value":{marginLeft:H.dateValueMarginLeft},float:{display:"inline-block",color:t.dataTypes.float},function:{display:"inline-block",color:t.dataTypes.function,cursor:"pointer",whiteSpace:"pre-line"},"function-value":{fontStyle:"italic"},integer:{display:"inline-block",color:t.dataTypes.integer},string:{display:"inline-block",color:t.dataTypes.string},nan:{display:"inline-block",color:t.dataTypes.nan,fontSize:H.nanFontSize,fontWeight:H.nanFontWeight,backgroundColor:t.dataTypes.background,padding:H.nanPadding,borderRadius:H.nanBorderRadius},null:{display:"inline-block",color:t.dataTypes.null,fontSize:H.nullFontSize,fontWeight:H.nullFontWeight,backgroundColor:t.dataTypes.background,padding:H.nullPadding,borderRadius:H.nullBorderRadius},undefined:{display:"inline-block",color:t.dataTypes.undefined,fontSize:H.undefinedFontSize,padding:H.undefinedPadding,borderRadius:H.undefinedBorderRadius,backgroundColor:t.dataTypes.background},regexp:{display:"inline-block",color:t.dataTypes.regexp},"copy-to-clipboard":{cursor:H.clipboardCursor},"copy-icon":{color:t.copyToClipboard,fontSize:H.iconFontSize,marginRight:H.iconMarginRight,verticalAlign:"top"},"copy-icon-copied":{color:t.copyToClipboardCheck,marginLeft:H.clipboardCheckMarginLeft},"array-group-meta-data":{display:"inline-block",padding:H.arrayGroupMetaPadding},"object-meta-data":{display:"inline-block",padding:H.metaDataPadding},"icon-container":{display:"inline-block",width:H.iconContainerWidth},tooltip:{padding:H.tooltipPadding},removeVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.removeIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},addVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.addIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},editVarIcon:{verticalAlign:"top",display:"inline-block",color:t.editVariable.editIcon,cursor:H.iconCursor,fontSize:H.iconFontSize,marginRight:H.iconMarginRight},"edit-icon-container":{display:"inline-block",verticalAlign:"top"},"check-icon":{display:"inline-block",cursor:H.iconCursor,color:t.editVariable.checkIcon,fontSize:H.iconFontSize,paddingRight:H.iconPaddingRight},"cancel-icon":{display:"inline-block",cursor:H.iconCursor,color:t.editVariable.cancelIcon,fontSize:H.iconFontSize,paddingRight:H.iconPaddingRight},"edit-input":{display:"inline-block",minWidth:H.editInputMinWidth,borderRadius:H.editInputBorderRadius,backgroundColor:t.editVariable.background,color:t.editVariable.color,padding:H.editInputPadding,marginRight:H.editInputMarginRight,fontFamily:H.editInputFontFamily},"detected-row":{paddingTop:H.detectedRowPaddingTop},"key-modal-request":{position:H.addKeyCoverPosition,top:H.addKeyCoverPositionPx,left:H.addKeyCoverPositionPx,right:H.addKeyCoverPositionPx,bottom:H.addKeyCoverPositionPx,backgroundColor:H.addKeyCoverBackground},"key-modal":{width:H.addKeyModalWidth,backgroundColor:t.addKeyModal.background,marginLeft:H.addKeyModalMargin,marginRight:H.addKeyModalMargin,padding:H.addKeyModalPadding,borderRadius:H.addKeyModalRadius,marginTop:"15px",position:"relative"},"key-modal-label":{color:t.addKeyModal.labelColor,marginLeft:"2px",marginBottom:"5px",fontSize:"11px"},"key-modal-input-container":{overflow:"hidden"},"key-modal-input":{width:"100%",padding:"3px 6px",fontFamily:"monospace",color:t.addKeyModal.color,border:"none",boxSizing:"border-box",borderRadius:"2px"},"key-modal-cancel":{backgroundColor:t.editVariable.removeIcon,position:"absolute",top:"0px",right:"0px",borderRadius:"0px 3px 0px 3px",cursor:"pointer"},"key-modal-cancel-icon":{color:t.addKeyModal.labelColor,fontSize:H.iconFontSize,transform:"rotate(45deg)"},"key-modal-submit":{color:t.editVariable.addIcon,fontSize:H.iconFontSize,position:"absolute",right:"2px",top:"3px",cursor:"pointer"},"function-ellipsis":{display:"inline-block",color:t.ellipsisColor,fontSize:H.ellipsisFontSize,lineHeight:H.ellipsisLineHeight,cursor:H.ellipsisCursor},"validation-failure":{float:"right",padding:"3px 6px",borderRadius:"2px",cursor:"pointer",color:t.validationFailure.fontColor,backgroundColor:t.validationFailure.background},"validation-failure-label":{marginRight:"6px"},"validation-failure-clear":{position:"relative",verticalAlign:"top",cursor:"pointer",color:t.validationFailure.iconColor,fontSize:H.iconFontSize,transform:"rotate(45deg)"}}},z=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=(e.rjvId,e.type_name),n=e.displayDataTypes,r=e.theme;return n?A.a.createElement("span",Object.assign({className:"data-type-label"},w(r,"data-type-label")),t):null}}]),t}(A.a.PureComponent),q=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"boolean"),A.a.createElement(z,Object.assign({type_name:"bool"},e)),e.value?"true":"false")}}]),t}(A.a.PureComponent),Y=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"date"),A.a.createElement(z,Object.assign({type_name:"date"},e)),A.a.createElement("span",Object.assign({className:"date-value"},w(e.theme,"date-value")),e.value.toLocaleTimeString("en-us",{weekday:"short",year:"numeric",month:"short",day:"numeric",hour:"2-digit",minute:"2-digit"})))}}]),t}(A.a.PureComponent),W=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"float"),A.a.createElement(z,Object.assign({type_name:"float"},e)),this.props.value)}}]),t}(A.a.PureComponent),V=n(46),B=new(n(47).Dispatcher),K=new(function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).objects={},e.set=function(t,n,r,o){void 0===e.objects[t]&&(e.objects[t]={}),void 0===e.objects[t][n]&&(e.objects[t][n]={}),e.objects[t][n][r]=o},e.get=function(t,n,r,o){return void 0===e.objects[t]||void 0===e.objects[t][n]||null==e.objects[t][n][r]?o:e.objects[t][n][r]},e.handleAction=function(t){var n=t.rjvId,r=t.data;switch(t.name){case"RESET":e.emit("reset-"+n);break;case"VARIABLE_UPDATED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-edited"})),e.emit("variable-update-"+n);break;case"VARIABLE_REMOVED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-removed"})),e.emit("variable-update-"+n);break;case"VARIABLE_ADDED":t.data.updated_src=e.updateSrc(n,r),e.set(n,"action","variable-update",a(a({},r),{},{type:"variable-added"})),e.emit("variable-update-"+n);break;case"ADD_VARIABLE_KEY_REQUEST":e.set(n,"action","new-key-request",r),e.emit("add-key-request-"+n)}},e.updateSrc=function(t,n){var r=n.name,o=n.namespace,a=n.new_value,l=(n.existing_value,n.variable_removed);o.shift();var i,s=e.get(t,"global","src"),c=e.deepCopy(s,P(o)),u=c,f=O(o);try{for(f.s();!(i=f.n()).done;)u=u[i.value]}catch(e){f.e(e)}finally{f.f()}return l?"array"==x(u)?u.splice(r,1):delete u[r]:null!==r?u[r]=a:c=a,e.set(t,"global","src",c),c},e.deepCopy=function(t,n){var r,o=x(t),l=n.shift();return"array"==o?r=P(t):"object"==o&&(r=a({},t)),void 0!==l&&(r[l]=e.deepCopy(t[l],n)),r},e}u(t,e);var n=h(t);return t}(V.EventEmitter));B.register(K.handleAction.bind(K));var X=K,G=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({collapsed:!r.state.collapsed},function(){X.set(r.props.rjvId,r.props.namespace,"collapsed",r.state.collapsed)})},r.getFunctionDisplay=function(e){var t=p(r).props;return e?A.a.createElement("span",null,r.props.value.toString().slice(9,-1).replace(/\{[\s\S]+/,""),A.a.createElement("span",{className:"function-collapsed",style:{fontWeight:"bold"}},A.a.createElement("span",null,"{"),A.a.createElement("span",w(t.theme,"ellipsis"),"..."),A.a.createElement("span",null,"}"))):r.props.value.toString().slice(9,-1)},r.state={collapsed:X.get(e.rjvId,e.namespace,"collapsed",!0)},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=this.state.collapsed;return A.a.createElement("div",w(e.theme,"function"),A.a.createElement(z,Object.assign({type_name:"function"},e)),A.a.createElement("span",Object.assign({},w(e.theme,"function-value"),{className:"rjv-function-container",onClick:this.toggleCollapsed}),this.getFunctionDisplay(t)))}}]),t}(A.a.PureComponent),Q=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"nan"),"NaN")}}]),t}(A.a.PureComponent),Z=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"null"),"NULL")}}]),t}(A.a.PureComponent),J=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"integer"),A.a.createElement(z,Object.assign({type_name:"int"},e)),this.props.value)}}]),t}(A.a.PureComponent),$=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props;return A.a.createElement("div",w(e.theme,"regexp"),A.a.createElement(z,Object.assign({type_name:"regexp"},e)),this.props.value.toString())}}]),t}(A.a.PureComponent),ee=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({collapsed:!r.state.collapsed},function(){X.set(r.props.rjvId,r.props.namespace,"collapsed",r.state.collapsed)})},r.state={collapsed:X.get(e.rjvId,e.namespace,"collapsed",!0)},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){this.state.collapsed;var e=this.props,t=e.collapseStringsAfterLength,n=e.theme,r=e.value,o={style:{cursor:"default"}};return"integer"===x(t)&&r.length>t&&(o.style.cursor="pointer",this.state.collapsed&&(r=A.a.createElement("span",null,r.substring(0,t),A.a.createElement("span",w(n,"ellipsis")," ...")))),A.a.createElement("div",w(n,"string"),A.a.createElement(z,Object.assign({type_name:"string"},e)),A.a.createElement("span",Object.assign({className:"string-value"},o,{onClick:this.toggleCollapsed}),'"',r,'"'))}}]),t}(A.a.PureComponent),te=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){return A.a.createElement("div",w(this.props.theme,"undefined"),"undefined")}}]),t}(A.a.PureComponent),ne=N.useLayoutEffect,re=function(e){var t=Object(N.useRef)(e);return ne(function(){t.current=e}),t},oe=function(e,t){"function"!=typeof e?e.current=t:e(t)},ae=function(e,t){var n=Object(N.useRef)();return Object(N.useCallback)(function(r){e.current=r,n.current&&oe(n.current,null),n.current=t,t&&oe(t,r)},[t])},le={"min-height":"0","max-height":"none",height:"0",visibility:"hidden",overflow:"hidden",position:"absolute","z-index":"-1000",top:"0",right:"0"},ie=function(e){Object.keys(le).forEach(function(t){e.style.setProperty(t,le[t],"important")})},se=null,ce=function(){},ue=["borderBottomWidth","borderLeftWidth","borderRightWidth","borderTopWidth","boxSizing","fontFamily","fontSize","fontStyle","fontWeight","letterSpacing","lineHeight","paddingBottom","paddingLeft","paddingRight","paddingTop","tabSize","textIndent","textRendering","textTransform","width"],fe=!!document.documentElement.currentStyle,de=function(e,t){var n,r=e.cacheMeasurements,o=e.maxRows,a=e.minRows,l=e.onChange,i=void 0===l?ce:l,s=e.onHeightChange,c=void 0===s?ce:s,u=function(e,t){if(null==e)return{};var n,r,o={},a=Object.keys(e);for(r=0;r<a.length;r++)n=a[r],t.indexOf(n)>=0||(o[n]=e[n]);return o}(e,["cacheMeasurements","maxRows","minRows","onChange","onHeightChange"]),f=void 0!==u.value,d=Object(N.useRef)(null),p=ae(d,t),b=Object(N.useRef)(0),h=Object(N.useRef)(),m=function(){var e=d.current,t=r&&h.current?h.current:function(e){var t=window.getComputedStyle(e);if(null===t)return null;var n,r=(n=t,ue.reduce(function(e,t){return e[t]=n[t],e},{})),o=r.boxSizing;return""===o?null:(fe&&"border-box"===o&&(r.width=parseFloat(r.width)+parseFloat(r.borderRightWidth)+parseFloat(r.borderLeftWidth)+parseFloat(r.paddingRight)+parseFloat(r.paddingLeft)+"px"),{sizingStyle:r,paddingSize:parseFloat(r.paddingBottom)+parseFloat(r.paddingTop),borderSize:parseFloat(r.borderBottomWidth)+parseFloat(r.borderTopWidth)})}(e);if(t){h.current=t;var n=function(e,t,n,r){void 0===n&&(n=1),void 0===r&&(r=1/0),se||((se=document.createElement("textarea")).setAttribute("tab-index","-1"),se.setAttribute("aria-hidden","true"),ie(se)),null===se.parentNode&&document.body.appendChild(se);var o=e.paddingSize,a=e.borderSize,l=e.sizingStyle,i=l.boxSizing;Object.keys(l).forEach(function(e){var t=e;se.style[t]=l[t]}),ie(se),se.value=t;var s=function(e,t){var n=e.scrollHeight;return"border-box"===t.sizingStyle.boxSizing?n+t.borderSize:n-t.paddingSize}(se,e);se.value="x";var c=se.scrollHeight-o,u=c*n;"border-box"===i&&(u=u+o+a),s=Math.max(u,s);var f=c*r;return"border-box"===i&&(f=f+o+a),[s=Math.min(f,s),c]}(t,e.value||e.placeholder||"x",a,o),l=n[0],i=n[1];b.current!==l&&(b.current=l,e.style.setProperty("height",l+"px","important"),c(l,{rowHeight:i}))}};return Object(N.useLayoutEffect)(m),n=re(m),Object(N.useLayoutEffect)(function(){var e=function(e){n.current(e)};return window.addEventListener("resize",e),function(){window.removeEventListener("resize",e)}},[]),Object(N.createElement)("textarea",C({},u,{onChange:function(e){f||m(),i(e)},ref:p}))},pe=Object(N.forwardRef)(de),be=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 24 24",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("path",{d:"M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M7,13H17V11H7"})))}}]),t}(A.a.PureComponent),he=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 24 24",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("path",{d:"M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M13,7H11V11H7V13H11V17H13V13H17V11H13V7Z"})))}}]),t}(A.a.PureComponent),me=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]),r=M(t).style;return A.a.createElement("span",n,A.a.createElement("svg",{fill:r.color,width:r.height,height:r.width,style:r,viewBox:"0 0 1792 1792"},A.a.createElement("path",{d:"M1344 800v64q0 14-9 23t-23 9h-832q-14 0-23-9t-9-23v-64q0-14 9-23t23-9h832q14 0 23 9t9 23zm128 448v-832q0-66-47-113t-113-47h-832q-66 0-113 47t-47 113v832q0 66 47 113t113 47h832q66 0 113-47t47-113zm128-832v832q0 119-84.5 203.5t-203.5 84.5h-832q-119 0-203.5-84.5t-84.5-203.5v-832q0-119 84.5-203.5t203.5-84.5h832q119 0 203.5 84.5t84.5 203.5z"})))}}]),t}(A.a.PureComponent),ge=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]),r=M(t).style;return A.a.createElement("span",n,A.a.createElement("svg",{fill:r.color,width:r.height,height:r.width,style:r,viewBox:"0 0 1792 1792"},A.a.createElement("path",{d:"M1344 800v64q0 14-9 23t-23 9h-352v352q0 14-9 23t-23 9h-64q-14 0-23-9t-9-23v-352h-352q-14 0-23-9t-9-23v-64q0-14 9-23t23-9h352v-352q0-14 9-23t23-9h64q14 0 23 9t9 23v352h352q14 0 23 9t9 23zm128 448v-832q0-66-47-113t-113-47h-832q-66 0-113 47t-47 113v832q0 66 47 113t113 47h832q66 0 113-47t47-113zm128-832v832q0 119-84.5 203.5t-203.5 84.5h-832q-119 0-203.5-84.5t-84.5-203.5v-832q0-119 84.5-203.5t203.5-84.5h832q119 0 203.5 84.5t84.5 203.5z"})))}}]),t}(A.a.PureComponent),ve=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",{style:a(a({},M(t).style),{},{paddingLeft:"2px",verticalAlign:"top"}),viewBox:"0 0 15 15",fill:"currentColor"},A.a.createElement("path",{d:"M0 14l6-6-6-6z"})))}}]),t}(A.a.PureComponent),ye=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",{style:a(a({},M(t).style),{},{paddingLeft:"2px",verticalAlign:"top"}),viewBox:"0 0 15 15",fill:"currentColor"},A.a.createElement("path",{d:"M0 5l6 6 6-6z"})))}}]),t}(A.a.PureComponent),_e=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m30 35h-25v-22.5h25v7.5h2.5v-12.5c0-1.4-1.1-2.5-2.5-2.5h-7.5c0-2.8-2.2-5-5-5s-5 2.2-5 5h-7.5c-1.4 0-2.5 1.1-2.5 2.5v27.5c0 1.4 1.1 2.5 2.5 2.5h25c1.4 0 2.5-1.1 2.5-2.5v-5h-2.5v5z m-20-27.5h2.5s2.5-1.1 2.5-2.5 1.1-2.5 2.5-2.5 2.5 1.1 2.5 2.5 1.3 2.5 2.5 2.5h2.5s2.5 1.1 2.5 2.5h-20c0-1.5 1.1-2.5 2.5-2.5z m-2.5 20h5v-2.5h-5v2.5z m17.5-5v-5l-10 7.5 10 7.5v-5h12.5v-5h-12.5z m-17.5 10h7.5v-2.5h-7.5v2.5z m12.5-17.5h-12.5v2.5h12.5v-2.5z m-7.5 5h-5v2.5h5v-2.5z"}))))}}]),t}(A.a.PureComponent),xe=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m28.6 25q0-0.5-0.4-1l-4-4 4-4q0.4-0.5 0.4-1 0-0.6-0.4-1.1l-2-2q-0.4-0.4-1-0.4-0.6 0-1 0.4l-4.1 4.1-4-4.1q-0.4-0.4-1-0.4-0.6 0-1 0.4l-2 2q-0.5 0.5-0.5 1.1 0 0.5 0.5 1l4 4-4 4q-0.5 0.5-0.5 1 0 0.7 0.5 1.1l2 2q0.4 0.4 1 0.4 0.6 0 1-0.4l4-4.1 4.1 4.1q0.4 0.4 1 0.4 0.6 0 1-0.4l2-2q0.4-0.4 0.4-1z m8.7-5q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),we=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m30.1 21.4v-2.8q0-0.6-0.4-1t-1-0.5h-5.7v-5.7q0-0.6-0.4-1t-1-0.4h-2.9q-0.6 0-1 0.4t-0.4 1v5.7h-5.7q-0.6 0-1 0.5t-0.5 1v2.8q0 0.6 0.5 1t1 0.5h5.7v5.7q0 0.5 0.4 1t1 0.4h2.9q0.6 0 1-0.4t0.4-1v-5.7h5.7q0.6 0 1-0.5t0.4-1z m7.2-1.4q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),ke=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m31.6 21.6h-10v10h-3.2v-10h-10v-3.2h10v-10h3.2v10h10v3.2z"}))))}}]),t}(A.a.PureComponent),Ee=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m19.8 26.4l2.6-2.6-3.4-3.4-2.6 2.6v1.3h2.2v2.1h1.2z m9.8-16q-0.3-0.4-0.7 0l-7.8 7.8q-0.4 0.4 0 0.7t0.7 0l7.8-7.8q0.4-0.4 0-0.7z m1.8 13.2v4.3q0 2.6-1.9 4.5t-4.5 1.9h-18.6q-2.6 0-4.5-1.9t-1.9-4.5v-18.6q0-2.7 1.9-4.6t4.5-1.8h18.6q1.4 0 2.6 0.5 0.3 0.2 0.4 0.5 0.1 0.4-0.2 0.7l-1.1 1.1q-0.3 0.3-0.7 0.1-0.5-0.1-1-0.1h-18.6q-1.4 0-2.5 1.1t-1 2.5v18.6q0 1.4 1 2.5t2.5 1h18.6q1.5 0 2.5-1t1.1-2.5v-2.9q0-0.2 0.2-0.4l1.4-1.5q0.3-0.3 0.8-0.1t0.4 0.6z m-2.1-16.5l6.4 6.5-15 15h-6.4v-6.5z m9.9 3l-2.1 2-6.4-6.4 2.1-2q0.6-0.7 1.5-0.7t1.5 0.7l3.4 3.4q0.6 0.6 0.6 1.5t-0.6 1.5z"}))))}}]),t}(A.a.PureComponent),Oe=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.style,n=_(e,["style"]);return A.a.createElement("span",n,A.a.createElement("svg",Object.assign({},M(t),{viewBox:"0 0 40 40",fill:"currentColor",preserveAspectRatio:"xMidYMid meet"}),A.a.createElement("g",null,A.a.createElement("path",{d:"m31.7 16.4q0-0.6-0.4-1l-2.1-2.1q-0.4-0.4-1-0.4t-1 0.4l-9.1 9.1-5-5q-0.5-0.4-1-0.4t-1 0.4l-2.1 2q-0.4 0.4-0.4 1 0 0.6 0.4 1l8.1 8.1q0.4 0.4 1 0.4 0.6 0 1-0.4l12.2-12.1q0.4-0.4 0.4-1z m5.6 3.6q0 4.7-2.3 8.6t-6.3 6.2-8.6 2.3-8.6-2.3-6.2-6.2-2.3-8.6 2.3-8.6 6.2-6.2 8.6-2.3 8.6 2.3 6.3 6.2 2.3 8.6z"}))))}}]),t}(A.a.PureComponent),Pe=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).copiedTimer=null,r.handleCopy=function(){var e=document.createElement("textarea"),t=r.props,n=t.clickCallback,o=t.src,a=t.namespace;e.innerHTML=JSON.stringify(r.clipboardValue(o),null,"  "),document.body.appendChild(e),e.select(),document.execCommand("copy"),document.body.removeChild(e),r.copiedTimer=setTimeout(function(){r.setState({copied:!1})},5500),r.setState({copied:!0},function(){"function"==typeof n&&n({src:o,namespace:a,name:a[a.length-1]})})},r.getClippyIcon=function(){var e=r.props.theme;return r.state.copied?A.a.createElement("span",null,A.a.createElement(_e,Object.assign({className:"copy-icon"},w(e,"copy-icon"))),A.a.createElement("span",w(e,"copy-icon-copied"),"✔")):A.a.createElement(_e,Object.assign({className:"copy-icon"},w(e,"copy-icon")))},r.clipboardValue=function(e){switch(x(e)){case"function":case"regexp":return e.toString();default:return e}},r.state={copied:!1},r}u(t,e);var n=h(t);return s(t,[{key:"componentWillUnmount",value:function(){this.copiedTimer&&(clearTimeout(this.copiedTimer),this.copiedTimer=null)}},{key:"render",value:function(){var e=this.props,t=(e.src,e.theme),n=e.hidden,r=e.rowHovered,o=w(t,"copy-to-clipboard").style,l="inline";return n&&(l="none"),A.a.createElement("span",{className:"copy-to-clipboard-container",title:"Copy to clipboard",style:{verticalAlign:"top",display:r?"inline-block":"none"}},A.a.createElement("span",{style:a(a({},o),{},{display:l}),onClick:this.handleCopy},this.getClippyIcon()))}}]),t}(A.a.PureComponent),Ce=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).getEditIcon=function(){var e=r.props,t=e.variable,n=e.theme;return A.a.createElement("div",{className:"click-to-edit",style:{verticalAlign:"top",display:r.state.hovered?"inline-block":"none"}},A.a.createElement(Ee,Object.assign({className:"click-to-edit-icon"},w(n,"editVarIcon"),{onClick:function(){r.prepopInput(t)}})))},r.prepopInput=function(e){if(!1!==r.props.onEdit){var t=function(e){var t;switch(x(e)){case"undefined":t="undefined";break;case"nan":t="NaN";break;case"string":t=e;break;case"date":case"function":case"regexp":t=e.toString();break;default:try{t=JSON.stringify(e,null,"  ")}catch(e){t=""}}return t}(e.value),n=j(t);r.setState({editMode:!0,editValue:t,parsedInput:{type:n.type,value:n.value}})}},r.getRemoveIcon=function(){var e=r.props,t=e.variable,n=e.namespace,o=e.theme,a=e.rjvId;return A.a.createElement("div",{className:"click-to-remove",style:{verticalAlign:"top",display:r.state.hovered?"inline-block":"none"}},A.a.createElement(xe,Object.assign({className:"click-to-remove-icon"},w(o,"removeVarIcon"),{onClick:function(){B.dispatch({name:"VARIABLE_REMOVED",rjvId:a,data:{name:t.name,namespace:n,existing_value:t.value,variable_removed:!0}})}})))},r.getValue=function(e,t){var n=!t&&e.type,o=p(r).props;switch(n){case!1:return r.getEditInput();case"string":return A.a.createElement(ee,Object.assign({value:e.value},o));case"integer":return A.a.createElement(J,Object.assign({value:e.value},o));case"float":return A.a.createElement(W,Object.assign({value:e.value},o));case"boolean":return A.a.createElement(q,Object.assign({value:e.value},o));case"function":return A.a.createElement(G,Object.assign({value:e.value},o));case"null":return A.a.createElement(Z,o);case"nan":return A.a.createElement(Q,o);case"undefined":return A.a.createElement(te,o);case"date":return A.a.createElement(Y,Object.assign({value:e.value},o));case"regexp":return A.a.createElement($,Object.assign({value:e.value},o));default:return A.a.createElement("div",{className:"object-value"},JSON.stringify(e.value))}},r.getEditInput=function(){var e=r.props.theme,t=r.state.editValue;return A.a.createElement("div",null,A.a.createElement(pe,Object.assign({type:"text",inputRef:function(e){return e&&e.focus()},value:t,className:"variable-editor",onChange:function(e){var t=e.target.value,n=j(t);r.setState({editValue:t,parsedInput:{type:n.type,value:n.value}})},onKeyDown:function(e){switch(e.key){case"Escape":r.setState({editMode:!1,editValue:""});break;case"Enter":(e.ctrlKey||e.metaKey)&&r.submitEdit(!0)}e.stopPropagation()},placeholder:"update this value",minRows:2},w(e,"edit-input"))),A.a.createElement("div",w(e,"edit-icon-container"),A.a.createElement(xe,Object.assign({className:"edit-cancel"},w(e,"cancel-icon"),{onClick:function(){r.setState({editMode:!1,editValue:""})}})),A.a.createElement(Oe,Object.assign({className:"edit-check string-value"},w(e,"check-icon"),{onClick:function(){r.submitEdit()}})),A.a.createElement("div",null,r.showDetected())))},r.submitEdit=function(e){var t=r.props,n=t.variable,o=t.namespace,a=t.rjvId,l=r.state,i=l.editValue,s=l.parsedInput,c=i;e&&s.type&&(c=s.value),r.setState({editMode:!1}),B.dispatch({name:"VARIABLE_UPDATED",rjvId:a,data:{name:n.name,namespace:o,existing_value:n.value,new_value:c,variable_removed:!1}})},r.showDetected=function(){var e=r.props,t=e.theme,n=(e.variable,e.namespace,e.rjvId,r.state.parsedInput),o=(n.type,n.value,r.getDetectedInput());if(o)return A.a.createElement("div",null,A.a.createElement("div",w(t,"detected-row"),o,A.a.createElement(Oe,{className:"edit-check detected",style:a({verticalAlign:"top",paddingLeft:"3px"},w(t,"check-icon").style),onClick:function(){r.submitEdit(!0)}})))},r.getDetectedInput=function(){var e=r.state.parsedInput,t=e.type,n=e.value,o=p(r).props,l=o.theme;if(!1!==t)switch(t.toLowerCase()){case"object":return A.a.createElement("span",null,A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"{"),A.a.createElement("span",{style:a(a({},w(l,"ellipsis").style),{},{cursor:"default"})},"..."),A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"}"));case"array":return A.a.createElement("span",null,A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"["),A.a.createElement("span",{style:a(a({},w(l,"ellipsis").style),{},{cursor:"default"})},"..."),A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{cursor:"default"})},"]"));case"string":return A.a.createElement(ee,Object.assign({value:n},o));case"integer":return A.a.createElement(J,Object.assign({value:n},o));case"float":return A.a.createElement(W,Object.assign({value:n},o));case"boolean":return A.a.createElement(q,Object.assign({value:n},o));case"function":return A.a.createElement(G,Object.assign({value:n},o));case"null":return A.a.createElement(Z,o);case"nan":return A.a.createElement(Q,o);case"undefined":return A.a.createElement(te,o);case"date":return A.a.createElement(Y,Object.assign({value:new Date(n)},o))}},r.state={editMode:!1,editValue:"",hovered:!1,renameKey:!1,parsedInput:{type:!1,value:null}},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this,t=this.props,n=t.variable,r=t.singleIndent,o=t.type,l=t.theme,i=t.namespace,s=t.indentWidth,c=t.enableClipboard,u=t.onEdit,f=t.onDelete,d=t.onSelect,p=t.displayArrayKey,b=t.quotesOnKeys,h=this.state.editMode;return A.a.createElement("div",Object.assign({},w(l,"objectKeyVal",{paddingLeft:s*r}),{onMouseEnter:function(){return e.setState(a(a({},e.state),{},{hovered:!0}))},onMouseLeave:function(){return e.setState(a(a({},e.state),{},{hovered:!1}))},className:"variable-row",key:n.name}),"array"==o?p?A.a.createElement("span",Object.assign({},w(l,"array-key"),{key:n.name+"_"+i}),n.name,A.a.createElement("div",w(l,"colon"),":")):null:A.a.createElement("span",null,A.a.createElement("span",Object.assign({},w(l,"object-name"),{className:"object-key",key:n.name+"_"+i}),!!b&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"'),A.a.createElement("span",{style:{display:"inline-block"}},n.name),!!b&&A.a.createElement("span",{style:{verticalAlign:"top"}},'"')),A.a.createElement("span",w(l,"colon"),":")),A.a.createElement("div",Object.assign({className:"variable-value",onClick:!1===d&&!1===u?null:function(t){var r=P(i);(t.ctrlKey||t.metaKey)&&!1!==u?e.prepopInput(n):!1!==d&&(r.shift(),d(a(a({},n),{},{namespace:r})))}},w(l,"variableValue",{cursor:!1===d?"default":"pointer"})),this.getValue(n,h)),c?A.a.createElement(Pe,{rowHovered:this.state.hovered,hidden:h,src:n.value,clickCallback:c,theme:l,namespace:[].concat(P(i),[n.name])}):null,!1!==u&&0==h?this.getEditIcon():null,!1!==f&&0==h?this.getRemoveIcon():null)}}]),t}(A.a.PureComponent),je=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).getObjectSize=function(){var t=e.props,n=t.size,r=t.theme;if(t.displayObjectSize)return A.a.createElement("span",Object.assign({className:"object-size"},w(r,"object-size")),n," item",1===n?"":"s")},e.getAddAttribute=function(t){var n=e.props,r=n.theme,o=n.namespace,l=n.name,i=n.src,s=n.rjvId,c=n.depth;return A.a.createElement("span",{className:"click-to-add",style:{verticalAlign:"top",display:t?"inline-block":"none"}},A.a.createElement(we,Object.assign({className:"click-to-add-icon"},w(r,"addVarIcon"),{onClick:function(){var e={name:c>0?l:null,namespace:o.splice(0,o.length-1),existing_value:i,variable_removed:!1,key_name:null};"object"===x(i)?B.dispatch({name:"ADD_VARIABLE_KEY_REQUEST",rjvId:s,data:e}):B.dispatch({name:"VARIABLE_ADDED",rjvId:s,data:a(a({},e),{},{new_value:[].concat(P(i),[null])})})}})))},e.getRemoveObject=function(t){var n=e.props,r=n.theme,o=(n.hover,n.namespace),a=n.name,l=n.src,i=n.rjvId;if(1!==o.length)return A.a.createElement("span",{className:"click-to-remove",style:{display:t?"inline-block":"none"}},A.a.createElement(xe,Object.assign({className:"click-to-remove-icon"},w(r,"removeVarIcon"),{onClick:function(){B.dispatch({name:"VARIABLE_REMOVED",rjvId:i,data:{name:a,namespace:o.splice(0,o.length-1),existing_value:l,variable_removed:!0}})}})))},e.render=function(){var t=e.props,n=t.theme,r=t.onDelete,o=t.onAdd,a=t.enableClipboard,l=t.src,i=t.namespace,s=t.rowHovered;return A.a.createElement("div",Object.assign({},w(n,"object-meta-data"),{className:"object-meta-data",onClick:function(e){e.stopPropagation()}}),e.getObjectSize(),a?A.a.createElement(Pe,{rowHovered:s,clickCallback:a,src:l,theme:n,namespace:i}):null,!1!==o?e.getAddAttribute(s):null,!1!==r?e.getRemoveObject(s):null)},e}u(t,e);var n=h(t);return t}(A.a.PureComponent),Se=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).toggleCollapsed=function(e){var t=[];for(var n in r.state.expanded)t.push(r.state.expanded[n]);t[e]=!t[e],r.setState({expanded:t})},r.state={expanded:[]},r}u(t,e);var n=h(t);return s(t,[{key:"getExpandedIcon",value:function(e){var t=this.props,n=t.theme,r=t.iconStyle;return this.state.expanded[e]?A.a.createElement(R,{theme:n,iconStyle:r}):A.a.createElement(L,{theme:n,iconStyle:r})}},{key:"render",value:function(){var e=this,t=this.props,n=t.src,r=t.groupArraysAfterLength,o=(t.depth,t.name),a=t.theme,l=t.jsvRoot,i=t.namespace,s=(t.parent_type,_(t,["src","groupArraysAfterLength","depth","name","theme","jsvRoot","namespace","parent_type"])),c=0,u=5*this.props.indentWidth;l||(c=5*this.props.indentWidth);var f=r,d=Math.ceil(n.length/f);return A.a.createElement("div",Object.assign({className:"object-key-val"},w(a,l?"jsv-root":"objectKeyVal",{paddingLeft:c})),A.a.createElement(T,this.props),A.a.createElement("span",null,A.a.createElement(je,Object.assign({size:n.length},this.props))),P(Array(d)).map(function(t,r){return A.a.createElement("div",Object.assign({key:r,className:"object-key-val array-group"},w(a,"objectKeyVal",{marginLeft:6,paddingLeft:u})),A.a.createElement("span",w(a,"brace-row"),A.a.createElement("div",Object.assign({className:"icon-container"},w(a,"icon-container"),{onClick:function(t){e.toggleCollapsed(r)}}),e.getExpandedIcon(r)),e.state.expanded[r]?A.a.createElement(Re,Object.assign({key:o+r,depth:0,name:!1,collapsed:!1,groupArraysAfterLength:f,index_offset:r*f,src:n.slice(r*f,r*f+f),namespace:i,type:"array",parent_type:"array_group",theme:a},s)):A.a.createElement("span",Object.assign({},w(a,"brace"),{onClick:function(t){e.toggleCollapsed(r)},className:"array-group-brace"}),"[",A.a.createElement("div",Object.assign({},w(a,"array-group-meta-data"),{className:"array-group-meta-data"}),A.a.createElement("span",Object.assign({className:"object-size"},w(a,"object-size")),r*f," - ",r*f+f>n.length?n.length:r*f+f)),"]")))}))}}]),t}(A.a.PureComponent),Me=function(e){function t(e){var r;l(this,t),(r=n.call(this,e)).toggleCollapsed=function(){r.setState({expanded:!r.state.expanded},function(){X.set(r.props.rjvId,r.props.namespace,"expanded",r.state.expanded)})},r.getObjectContent=function(e,t,n){return A.a.createElement("div",{className:"pushed-content object-container"},A.a.createElement("div",Object.assign({className:"object-content"},w(r.props.theme,"pushed-content")),r.renderObjectContents(t,n)))},r.getEllipsis=function(){return 0===r.state.size?null:A.a.createElement("div",Object.assign({},w(r.props.theme,"ellipsis"),{className:"node-ellipsis",onClick:r.toggleCollapsed}),"...")},r.getObjectMetaData=function(e){var t=r.props,n=(t.rjvId,t.theme,r.state),o=n.size,a=n.hovered;return A.a.createElement(je,Object.assign({rowHovered:a,size:o},r.props))},r.renderObjectContents=function(e,t){var n,o=r.props,a=o.depth,l=o.parent_type,i=o.index_offset,s=o.groupArraysAfterLength,c=o.namespace,u=r.state.object_type,f=[],d=Object.keys(e||{});return r.props.sortKeys&&"array"!==u&&(d=d.sort()),d.forEach(function(o){if(n=new Te(o,e[o]),"array_group"===l&&i&&(n.name=parseInt(n.name)+i),e.hasOwnProperty(o))if("object"===n.type)f.push(A.a.createElement(Re,Object.assign({key:n.name,depth:a+1,name:n.name,src:n.value,namespace:c.concat(n.name),parent_type:u},t)));else if("array"===n.type){var d=Re;s&&n.value.length>s&&(d=Se),f.push(A.a.createElement(d,Object.assign({key:n.name,depth:a+1,name:n.name,src:n.value,namespace:c.concat(n.name),type:"array",parent_type:u},t)))}else f.push(A.a.createElement(Ce,Object.assign({key:n.name+"_"+c,variable:n,singleIndent:5,namespace:c,type:r.props.type},t)))}),f};var o=t.getState(e);return r.state=a(a({},o),{},{prevProps:{}}),r}u(t,e);var n=h(t);return s(t,[{key:"getBraceStart",value:function(e,t){var n=this,r=this.props,o=r.src,a=r.theme,l=r.iconStyle;if("array_group"===r.parent_type)return A.a.createElement("span",null,A.a.createElement("span",w(a,"brace"),"array"===e?"[":"{"),t?this.getObjectMetaData(o):null);var i=t?R:L;return A.a.createElement("span",null,A.a.createElement("span",Object.assign({onClick:function(e){n.toggleCollapsed()}},w(a,"brace-row")),A.a.createElement("div",Object.assign({className:"icon-container"},w(a,"icon-container")),A.a.createElement(i,{theme:a,iconStyle:l})),A.a.createElement(T,this.props),A.a.createElement("span",w(a,"brace"),"array"===e?"[":"{")),t?this.getObjectMetaData(o):null)}},{key:"render",value:function(){var e=this,t=this.props,n=t.depth,r=t.src,o=(t.namespace,t.name,t.type,t.parent_type),l=t.theme,i=t.jsvRoot,s=t.iconStyle,c=_(t,["depth","src","namespace","name","type","parent_type","theme","jsvRoot","iconStyle"]),u=this.state,f=u.object_type,d=u.expanded,p={};return i||"array_group"===o?"array_group"===o&&(p.borderLeft=0,p.display="inline"):p.paddingLeft=5*this.props.indentWidth,A.a.createElement("div",Object.assign({className:"object-key-val",onMouseEnter:function(){return e.setState(a(a({},e.state),{},{hovered:!0}))},onMouseLeave:function(){return e.setState(a(a({},e.state),{},{hovered:!1}))}},w(l,i?"jsv-root":"objectKeyVal",p)),this.getBraceStart(f,d),d?this.getObjectContent(n,r,a({theme:l,iconStyle:s},c)):this.getEllipsis(),A.a.createElement("span",{className:"brace-row"},A.a.createElement("span",{style:a(a({},w(l,"brace").style),{},{paddingLeft:d?"3px":"0px"})},"array"===f?"]":"}"),d?null:this.getObjectMetaData(r)))}}],[{key:"getDerivedStateFromProps",value:function(e,n){var r=n.prevProps;return e.src!==r.src||e.collapsed!==r.collapsed||e.name!==r.name||e.namespace!==r.namespace||e.rjvId!==r.rjvId?a(a({},t.getState(e)),{},{prevProps:e}):null}}]),t}(A.a.PureComponent);Me.getState=function(e){var t=Object.keys(e.src).length,n=(!1===e.collapsed||!0!==e.collapsed&&e.collapsed>e.depth)&&(!e.shouldCollapse||!1===e.shouldCollapse({name:e.name,src:e.src,type:x(e.src),namespace:e.namespace}))&&0!==t;return{expanded:X.get(e.rjvId,e.namespace,"expanded",n),object_type:"array"===e.type?"array":"object",parent_type:"array"===e.type?"array":"object",size:t,hovered:!1}};var Te=function e(t,n){l(this,e),this.name=t,this.value=n,this.type=x(n)};y(Me);var Re=Me,Le=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),a=0;a<r;a++)o[a]=arguments[a];return(e=n.call.apply(n,[this].concat(o))).render=function(){var t=p(e).props,n=[t.name],r=Re;return Array.isArray(t.src)&&t.groupArraysAfterLength&&t.src.length>t.groupArraysAfterLength&&(r=Se),A.a.createElement("div",{className:"pretty-json-container object-container"},A.a.createElement("div",{className:"object-content"},A.a.createElement(r,Object.assign({namespace:n,depth:0,jsvRoot:!0},t))))},e}u(t,e);var n=h(t);return t}(A.a.PureComponent),Ne=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).closeModal=function(){B.dispatch({rjvId:r.props.rjvId,name:"RESET"})},r.submit=function(){r.props.submit(r.state.input)},r.state={input:e.input?e.input:""},r}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this,t=this.props,n=t.theme,r=t.rjvId,o=t.isValid,a=this.state.input,l=o(a);return A.a.createElement("div",Object.assign({className:"key-modal-request"},w(n,"key-modal-request"),{onClick:this.closeModal}),A.a.createElement("div",Object.assign({},w(n,"key-modal"),{onClick:function(e){e.stopPropagation()}}),A.a.createElement("div",w(n,"key-modal-label"),"Key Name:"),A.a.createElement("div",{style:{position:"relative"}},A.a.createElement("input",Object.assign({},w(n,"key-modal-input"),{className:"key-modal-input",ref:function(e){return e&&e.focus()},spellCheck:!1,value:a,placeholder:"...",onChange:function(t){e.setState({input:t.target.value})},onKeyPress:function(t){l&&"Enter"===t.key?e.submit():"Escape"===t.key&&e.closeModal()}})),l?A.a.createElement(Oe,Object.assign({},w(n,"key-modal-submit"),{className:"key-modal-submit",onClick:function(t){return e.submit()}})):null),A.a.createElement("span",w(n,"key-modal-cancel"),A.a.createElement(ke,Object.assign({},w(n,"key-modal-cancel-icon"),{className:"key-modal-cancel",onClick:function(){B.dispatch({rjvId:r,name:"RESET"})}})))))}}]),t}(A.a.PureComponent),Ae=function(e){function t(){var e;l(this,t);for(var r=arguments.length,o=new Array(r),i=0;i<r;i++)o[i]=arguments[i];return(e=n.call.apply(n,[this].concat(o))).isValid=function(t){var n=e.props.rjvId,r=X.get(n,"action","new-key-request");return""!=t&&-1===Object.keys(r.existing_value).indexOf(t)},e.submit=function(t){var n=e.props.rjvId,r=X.get(n,"action","new-key-request");r.new_value=a({},r.existing_value),r.new_value[t]=e.props.defaultValue,B.dispatch({name:"VARIABLE_ADDED",rjvId:n,data:r})},e}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.active,n=e.theme,r=e.rjvId;return t?A.a.createElement(Ne,{rjvId:r,theme:n,isValid:this.isValid,submit:this.submit}):null}}]),t}(A.a.PureComponent),De=function(e){function t(){return l(this,t),n.apply(this,arguments)}u(t,e);var n=h(t);return s(t,[{key:"render",value:function(){var e=this.props,t=e.message,n=e.active,r=e.theme,o=e.rjvId;return n?A.a.createElement("div",Object.assign({className:"validation-failure"},w(r,"validation-failure"),{onClick:function(){B.dispatch({rjvId:o,name:"RESET"})}}),A.a.createElement("span",w(r,"validation-failure-label"),t),A.a.createElement(ke,w(r,"validation-failure-clear"))):null}}]),t}(A.a.PureComponent),Ie=function(e){function t(e){var r;return l(this,t),(r=n.call(this,e)).rjvId=Date.now().toString(),r.getListeners=function(){return{reset:r.resetState,"variable-update":r.updateSrc,"add-key-request":r.addKeyRequest}},r.updateSrc=function(){var e,t=X.get(r.rjvId,"action","variable-update"),n=t.name,o=t.namespace,a=t.new_value,l=t.existing_value,i=(t.variable_removed,t.updated_src),s=t.type,c=r.props,u=c.onEdit,f=c.onDelete,d=c.onAdd,p={existing_src:r.state.src,new_value:a,updated_src:i,name:n,namespace:o,existing_value:l};switch(s){case"variable-added":e=d(p);break;case"variable-edited":e=u(p);break;case"variable-removed":e=f(p)}!1!==e?(X.set(r.rjvId,"global","src",i),r.setState({src:i})):r.setState({validationFailure:!0})},r.addKeyRequest=function(){r.setState({addKeyRequest:!0})},r.resetState=function(){r.setState({validationFailure:!1,addKeyRequest:!1})},r.state={addKeyRequest:!1,editKeyRequest:!1,validationFailure:!1,src:t.defaultProps.src,name:t.defaultProps.name,theme:t.defaultProps.theme,validationMessage:t.defaultProps.validationMessage,prevSrc:t.defaultProps.src,prevName:t.defaultProps.name,prevTheme:t.defaultProps.theme},r}u(t,e);var n=h(t);return s(t,[{key:"componentDidMount",value:function(){X.set(this.rjvId,"global","src",this.state.src);var e=this.getListeners();for(var t in e)X.on(t+"-"+this.rjvId,e[t]);this.setState({addKeyRequest:!1,editKeyRequest:!1})}},{key:"componentDidUpdate",value:function(e,t){!1!==t.addKeyRequest&&this.setState({addKeyRequest:!1}),!1!==t.editKeyRequest&&this.setState({editKeyRequest:!1}),e.src!==this.state.src&&X.set(this.rjvId,"global","src",this.state.src)}},{key:"componentWillUnmount",value:function(){var e=this.getListeners();for(var t in e)X.removeListener(t+"-"+this.rjvId,e[t])}},{key:"render",value:function(){var e=this.state,t=e.validationFailure,n=e.validationMessage,r=e.addKeyRequest,o=e.theme,l=e.src,i=e.name,s=this.props,c=s.style,u=s.defaultValue;return A.a.createElement("div",{className:"react-json-view",style:a(a({},w(o,"app-container").style),c)},A.a.createElement(De,{message:n,active:t,theme:o,rjvId:this.rjvId}),A.a.createElement(Le,Object.assign({},this.props,{src:l,name:i,theme:o,type:x(l),rjvId:this.rjvId})),A.a.createElement(Ae,{active:r,theme:o,rjvId:this.rjvId,defaultValue:u}))}}],[{key:"getDerivedStateFromProps",value:function(e,n){if(e.src!==n.prevSrc||e.name!==n.prevName||e.theme!==n.prevTheme){var r={src:e.src,name:e.name,theme:e.theme,validationMessage:e.validationMessage,prevSrc:e.src,prevName:e.name,prevTheme:e.theme};return t.validateState(r)}return null}}]),t}(A.a.PureComponent);Ie.defaultProps={src:{},name:"root",theme:"rjv-default",collapsed:!1,collapseStringsAfterLength:!1,shouldCollapse:!1,sortKeys:!1,quotesOnKeys:!0,groupArraysAfterLength:100,indentWidth:4,enableClipboard:!0,displayObjectSize:!0,displayDataTypes:!0,onEdit:!1,onDelete:!1,onAdd:!1,onSelect:!1,iconStyle:"triangle",style:{},validationMessage:"Validation Error",defaultValue:null,displayArrayKey:!0},Ie.validateState=function(e){var t={};return"object"!==x(e.theme)||function(e){var t=["base00","base01","base02","base03","base04","base05","base06","base07","base08","base09","base0A","base0B","base0C","base0D","base0E","base0F"];if("object"===x(e)){for(var n=0;n<t.length;n++)if(!(t[n]in e))return!1;return!0}return!1}(e.theme)||(console.error("react-json-view error:","theme prop must be a theme name or valid base-16 theme object.",'defaulting to "rjv-default" theme'),t.theme="rjv-default"),"object"!==x(e.src)&&"array"!==x(e.src)&&(console.error("react-json-view error:","src property must be a valid json object"),t.name="ERROR",t.src={message:"src property must be a valid json object"}),a(a({},e),t)},y(Ie),t.default=Ie}])})},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={selected:[],page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return c.default.createElement("div",null,c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Dead Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) are dead."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,c.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return c.default.createElement("tr",{key:t.id},c.default.createElement("td",null,c.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),c.default.createElement("td",null,t.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:t.args})),c.default.createElement("td",null,t.err),c.default.createElement("td",null,c.default.createElement(h.default,{ts:t.t,tsMs:t.t_ms})))}))))),c.default.createElement("div",{className:g.default.btnGroup,role:"group"},c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),c.default.createElement("button",{type:"button",className:(0,y.default)(g.default.btn,g.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(c.default.Component);w.propTypes={fetchURL:f.default.string,deleteURL:f.default.string,deleteAllURL:f.default.string,retryURL:f.default.string,retryAllURL:f.default.string},t.default=w},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(8),p=r(d),b=n(9),h=r(b),m=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={queues:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"componentDidMount",value:function(){var e=this;this.props.url&&fetch(this.props.url).then(function(e){return e.json()}).then(function(t){e.setState({queues:t})})}},{key:"render",value:function(){return c.default.createElement("div",{className:(0,h.default)(p.default.panel,p.default.panelDefault)},c.default.createElement("div",{className:p.default.panelHeading},"queues"),c.default.createElement("div",{className:p.default.panelBody},c.default.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued.")),c.default.createElement("div",{className:p.default.tableResponsive},c.default.createElement("table",{className:p.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Count"),c.default.createElement("th",null,"Latency (seconds)"),c.default.createElement("th",null,"Lock Count"),c.default.createElement("th",null,"Max Concurrency")),this.state.queues.map(function(e){return c.default.createElement("tr",{key:e.job_name},c.default.createElement("td",null,e.job_name),c.default.createElement("td",null,e.count),c.default.createElement("td",null,e.latency),c.default.createElement("td",null,e.lock_count),c.default.createElement("td",null,e.max_concurrency))})))))}},{key:"queuedCount",get:function(){var e=0;return this.state.queues.map(function(t){e+=t.count}),e}}]),t}(c.default.Component);m.propTypes={url:f.default.string},t.default=m},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Retry Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:e.args})),c.default.createElement("td",null,e.err),c.default.createElement("td",null,c.default.createElement(h.default,{ts:e.t,tsMs:e.t_ms})))})))))}}]),t}(c.default.Component);w.propTypes={url:f.default.string},t.default=w},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function a(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function l(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var r=t[n];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(t,n,r){return n&&e(t.prototype,n),r&&e(t,r),t}}(),s=n(0),c=r(s),u=n(2),f=r(u),d=n(19),p=r(d),b=n(15),h=r(b),m=n(8),g=r(m),v=n(9),y=r(v),_=n(16),x=r(_),w=function(e){function t(){var e,n,r,l;o(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=r=a(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),r.state={page:1,count:0,jobs:[]},l=n,a(r,l)}return l(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentDidMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,y.default)(g.default.panel,g.default.panelDefault)},c.default.createElement("div",{className:g.default.panelHeading},"Scheduled Jobs"),c.default.createElement("div",{className:g.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled."),c.default.createElement(p.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:g.default.tableResponsive},c.default.createElement("table",{className:g.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,c.default.createElement(x.default,{args:e.args})),c.default.createElement("td",null,c.default.createElement(h.default,{ts:e.run_at})))})))))}}]),t}(c.default.Component);w.propTypes={url:f.default.string},t.default=w},function(e,t,n){"use strict";Object.defineProperty(t,"__esModule",{value:!0});var r=n(61);n.d(t,"Router",function(){return r.a});var o=n(33);n.d(t,"Link",function(){return o.a});var a=n(71);n.d(t,"IndexLink",function(){return a.a});var l=n(72);n.d(t,"withRouter",function(){return l.a});var i=n(74);n.d(t,"IndexRedirect",function(){return i.a});var s=n(75);n.d(t,"IndexRoute",function(){return s.a});var c=n(34);n.d(t,"Redirect",function(){return c.a});var u=n(76);n.d(t,"Route",function(){return u.a});var f=n(6);n.d(t,"createRoutes",function(){return f.b});var d=n(21);n.d(t,"RouterContext",function(){return d.a});var p=n(23);n.d(t,"locationShape",function(){return p.a}),n.d(t,"routerShape",function(){return p.b});var b=n(77);n.d(t,"match",function(){return b.a});var h=n(38);n.d(t,"useRouterHistory",function(){return h.a});var m=n(11);n.d(t,"formatPattern",function(){return m.a});var g=n(82);n.d(t,"applyRouterMiddleware",function(){return g.a});var v=n(83);n.d(t,"browserHistory",function(){return v.a});var y=n(86);n.d(t,"hashHistory",function(){return y.a});var _=n(35);n.d(t,"createMemoryHistory",function(){return _.a})},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}var o=n(1),a=n.n(o),l=n(0),i=n.n(l),s=n(4),c=n.n(s),u=n(3),f=(n.n(u),n(30)),d=n(13),p=n(21),b=n(6),h=n(32),m=(n(10),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}),g={history:u.object,children:d.d,routes:d.d,render:u.func,createElement:u.func,onError:u.func,onUpdate:u.func,matchContext:u.object},v=c()({displayName:"Router",propTypes:g,getDefaultProps:function(){return{render:function(e){return i.a.createElement(p.a,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},createRouterObject:function(e){var t=this.props.matchContext;if(t)return t.router;var n=this.props.history;return Object(h.b)(n,this.transitionManager,e)},createTransitionManager:function(){var e=this.props.matchContext;if(e)return e.transitionManager;var t=this.props.history,n=this.props,r=n.routes,o=n.children;return t.getCurrentLocation||a()(!1),Object(f.a)(t,Object(b.b)(r||o))},componentWillMount:function(){var e=this;this.transitionManager=this.createTransitionManager(),this.router=this.createRouterObject(this.state),this._unlisten=this.transitionManager.listen(function(t,n){t?e.handleError(t):(Object(h.a)(e.router,n),e.setState(n,e.props.onUpdate))})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function(){var e=this.state,t=e.location,n=e.routes,o=e.params,a=e.components,l=this.props,i=l.createElement,s=l.render,c=r(l,["createElement","render"]);return null==t?null:(Object.keys(g).forEach(function(e){return delete c[e]}),s(m({},c,{router:this.router,location:t,routes:n,params:o,components:a,createElement:i})))}});t.a=v},function(e,t,n){"use strict";function r(e,t,n,r,o,a,l,i){if(s(t),!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,r,o,a,l,i],f=0;c=new Error(t.replace(/%s/g,function(){return u[f++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}}function o(e){return e}function a(e,t,n){function a(e,t){var n=v.hasOwnProperty(t)?v[t]:null;k.hasOwnProperty(t)&&r("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&r("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function s(e,n){if(n){r("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),r(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&_.mixins(e,n.mixins);for(var i in n)if(n.hasOwnProperty(i)&&i!==c){var s=n[i],u=o.hasOwnProperty(i);if(a(u,i),_.hasOwnProperty(i))_[i](e,s);else{var f=v.hasOwnProperty(i),b="function"==typeof s,h=b&&!f&&!u&&!1!==n.autobind;if(h)l.push(i,s),o[i]=s;else if(u){var m=v[i];r(f&&("DEFINE_MANY_MERGED"===m||"DEFINE_MANY"===m),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",m,i),"DEFINE_MANY_MERGED"===m?o[i]=d(o[i],s):"DEFINE_MANY"===m&&(o[i]=p(o[i],s))}else o[i]=s}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var a=n in _;r(!a,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;if(l){var i=y.hasOwnProperty(n)?y[n]:null;return r("DEFINE_MANY_MERGED"===i,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),void(e[n]=d(e[n],o))}e[n]=o}}}function f(e,t){r(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(r(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function d(e,t){return function(){var n=e.apply(this,arguments),r=t.apply(this,arguments);if(null==n)return r;if(null==r)return n;var o={};return f(o,n),f(o,r),o}}function p(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function b(e,t){var n=t.bind(e);return n}function h(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var r=t[n],o=t[n+1];e[r]=b(e,o)}}function m(e){var t=o(function(e,o,a){this.__reactAutoBindPairs.length&&h(this),this.props=e,this.context=o,this.refs=i,this.updater=a||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;r("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new E,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(s.bind(null,t)),s(t,x),s(t,e),s(t,w),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),r(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var a in v)t.prototype[a]||(t.prototype[a]=null);return t}var g=[],v={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",UNSAFE_componentWillMount:"DEFINE_MANY",UNSAFE_componentWillReceiveProps:"DEFINE_MANY",UNSAFE_componentWillUpdate:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},y={getDerivedStateFromProps:"DEFINE_MANY_MERGED"},_={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)s(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=l({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=l({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=d(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=l({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},x={componentDidMount:function(){this.__isMounted=!0}},w={componentWillUnmount:function(){this.__isMounted=!1}},k={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},E=function(){};return l(E.prototype,e.prototype,k),m}var l=n(14),i={},s=function(e){},c="mixins";e.exports=a},function(e,t,n){"use strict";function r(){}function o(){}var a=n(64);o.resetWarningCache=r,e.exports=function(){function e(e,t,n,r,o,l){if(l!==a){var i=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use PropTypes.checkPropTypes() to call them. Read more at http://fb.me/use-check-prop-types");throw i.name="Invariant Violation",i}}function t(){return e}e.isRequired=e;var n={array:e,bool:e,func:e,number:e,object:e,string:e,symbol:e,any:e,arrayOf:t,element:e,elementType:e,instanceOf:t,node:e,objectOf:t,oneOf:t,oneOfType:t,shape:t,exact:t,checkPropTypes:o,resetWarningCache:r};return n.PropTypes=n,n}},function(e,t,n){"use strict";e.exports="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED"},function(e,t,n){"use strict";function r(e,t,n){return!!e.path&&Object(a.b)(e.path).some(function(e){return t.params[e]!==n.params[e]})}function o(e,t){var n=e&&e.routes,o=t.routes,a=void 0,l=void 0,i=void 0;if(n){var s=!1;a=n.filter(function(n){if(s)return!0;var a=-1===o.indexOf(n)||r(n,e,t);return a&&(s=!0),a}),a.reverse(),i=[],l=[],o.forEach(function(e){var t=-1===n.indexOf(e),r=-1!==a.indexOf(e);t||r?i.push(e):l.push(e)})}else a=[],l=[],i=o;return{leaveRoutes:a,changeRoutes:l,enterRoutes:i}}var a=n(11);t.a=o},function(e,t,n){"use strict";function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function o(){function e(e,t,n,r){var o=e.length<n,a=function(){for(var n=arguments.length,r=Array(n),a=0;a<n;a++)r[a]=arguments[a];if(e.apply(t,r),o){(0,r[r.length-1])()}};return r.add(a),a}function t(t){return t.reduce(function(t,n){return n.onEnter&&t.push(e(n.onEnter,n,3,c)),t},[])}function n(t){return t.reduce(function(t,n){return n.onChange&&t.push(e(n.onChange,n,4,u)),t},[])}function r(e,t,n){function r(e){o=e}if(!e)return void n();var o=void 0;Object(a.a)(e,function(e,n,a){t(e,r,function(e){e||o?a(e,o):n()})},n)}function o(e,n,o){c.clear();var a=t(e);return r(a.length,function(e,t,r){var o=function(){c.has(a[e])&&(r.apply(void 0,arguments),c.remove(a[e]))};a[e](n,t,o)},o)}function i(e,t,o,a){u.clear();var l=n(e);return r(l.length,function(e,n,r){var a=function(){u.has(l[e])&&(r.apply(void 0,arguments),u.remove(l[e]))};l[e](t,o,n,a)},a)}function s(e,t){for(var n=0,r=e.length;n<r;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}var c=new l,u=new l;return{runEnterHooks:o,runChangeHooks:i,runLeaveHooks:s}}t.a=o;var a=n(20),l=function e(){var t=this;r(this,e),this.hooks=[],this.add=function(e){return t.hooks.push(e)},this.remove=function(e){return t.hooks=t.hooks.filter(function(t){return t!==e})},this.has=function(e){return-1!==t.hooks.indexOf(e)},this.clear=function(){return t.hooks=[]}}},function(e,t,n){"use strict";function r(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return r(e,t[n])});if("object"===(void 0===e?"undefined":c(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!r(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function o(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function a(e,t,n){for(var r=e,o=[],a=[],l=0,i=t.length;l<i;++l){var c=t[l],u=c.path||"";if("/"===u.charAt(0)&&(r=e,o=[],a=[]),null!==r&&u){var f=Object(s.c)(u,r);if(f?(r=f.remainingPathname,o=[].concat(o,f.paramNames),a=[].concat(a,f.paramValues)):r=null,""===r)return o.every(function(e,t){return String(a[t])===String(n[e])})}}return!1}function l(e,t){return null==t?null==e:null==e||r(e,t)}function i(e,t,n,r,i){var s=e.pathname,c=e.query;return null!=n&&("/"!==s.charAt(0)&&(s="/"+s),!!(o(s,n.pathname)||!t&&a(s,r,i))&&l(c,n.query))}t.a=i;var s=n(11),c="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e}},function(e,t,n){"use strict";function r(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var r=t.getComponent||t.getComponents;if(r){var o=r.call(t,e,n);Object(l.a)(o)&&o.then(function(e){return n(null,e)},n)}else n()}function o(e,t){Object(a.b)(e.routes,function(t,n,o){r(e,t,o)},t)}var a=n(20),l=n(31);t.a=o},function(e,t,n){"use strict";function r(e,t,n,r,o){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var a=!0,i=void 0,s={location:t,params:l(n,r)},c=e.getChildRoutes(s,function(e,t){if(t=!e&&Object(d.b)(t),a)return void(i=[e,t]);o(e,t)});return Object(u.a)(c)&&c.then(function(e){return o(null,Object(d.b)(e))},o),a=!1,i}function o(e,t,n,a,i){if(e.indexRoute)i(null,e.indexRoute);else if(e.getIndexRoute){var s={location:t,params:l(n,a)},f=e.getIndexRoute(s,function(e,t){i(e,!e&&Object(d.b)(t)[0])});Object(u.a)(f)&&f.then(function(e){return i(null,Object(d.b)(e)[0])},i)}else if(e.childRoutes||e.getChildRoutes){var p=function(e,r){if(e)return void i(e);var l=r.filter(function(e){return!e.path});Object(c.a)(l.length,function(e,r,i){o(l[e],t,n,a,function(t,n){if(t||n){var o=[l[e]].concat(Array.isArray(n)?n:[n]);i(t,o)}else r()})},function(e,t){i(null,t)})},b=r(e,t,n,a,p);b&&p.apply(void 0,b)}else i()}function a(e,t,n){return t.reduce(function(e,t,r){var o=n&&n[r];return Array.isArray(e[t])?e[t].push(o):e[t]=t in e?[e[t],o]:o,e},e)}function l(e,t){return a({},e,t)}function i(e,t,n,a,i,c){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,a=[],i=[]),null!==n&&u){try{var d=Object(f.c)(u,n);d?(n=d.remainingPathname,a=[].concat(a,d.paramNames),i=[].concat(i,d.paramValues)):n=null}catch(e){c(e)}if(""===n){var p={routes:[e],params:l(a,i)};return void o(e,t,a,i,function(e,t){if(e)c(e);else{if(Array.isArray(t)){var n;(n=p.routes).push.apply(n,t)}else t&&p.routes.push(t);c(null,p)}})}}if(null!=n||e.childRoutes){var b=function(r,o){r?c(r):o?s(o,t,function(t,n){t?c(t):n?(n.routes.unshift(e),c(null,n)):c()},n,a,i):c()},h=r(e,t,a,i,b);h&&b.apply(void 0,h)}else c()}function s(e,t,n,r){var o=arguments.length>4&&void 0!==arguments[4]?arguments[4]:[],a=arguments.length>5&&void 0!==arguments[5]?arguments[5]:[];void 0===r&&("/"!==t.pathname.charAt(0)&&(t=p({},t,{pathname:"/"+t.pathname})),r=t.pathname),Object(c.a)(e.length,function(n,l,s){i(e[n],t,r,o,a,function(e,t){e||t?s(e,t):l()})},n)}t.a=s;var c=n(20),u=n(31),f=n(11),d=(n(10),n(6)),p=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";function r(e,t){var n={};return e.path?(Object(o.b)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}var o=n(11);t.a=r},function(e,t,n){"use strict";var r=n(0),o=n.n(r),a=n(4),l=n.n(a),i=n(33),s=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},c=l()({displayName:"IndexLink",render:function(){return o.a.createElement(i.a,s({},this.props,{onlyActiveOnIndex:!0}))}});t.a=c},function(e,t,n){"use strict";function r(e){return e.displayName||e.name||"Component"}function o(e,t){var n=t&&t.withRef,o=u()({displayName:"WithRouter",mixins:[Object(p.b)("router")],contextTypes:{router:b.b},propTypes:{router:b.b},getWrappedInstance:function(){return n||l()(!1),this.wrappedInstance},render:function(){var t=this,r=this.props.router||this.context.router;if(!r)return s.a.createElement(e,this.props);var o=r.params,a=r.location,l=r.routes,i=h({},this.props,{router:r,params:o,location:a,routes:l});return n&&(i.ref=function(e){t.wrappedInstance=e}),s.a.createElement(e,i)}});return o.displayName="withRouter("+r(e)+")",o.WrappedComponent=e,d()(o,e)}t.a=o;var a=n(1),l=n.n(a),i=n(0),s=n.n(i),c=n(4),u=n.n(c),f=n(73),d=n.n(f),p=n(22),b=n(23),h=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e}},function(e,t,n){"use strict";var r={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},o={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},a="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,n){if("string"!=typeof t){var l=Object.getOwnPropertyNames(t);a&&(l=l.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<l.length;++i)if(!(r[l[i]]||o[l[i]]||n&&n[l[i]]))try{e[l[i]]=t[l[i]]}catch(e){}}return e}},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(10),n(1)),i=n.n(l),s=n(34),c=n(13),u=o()({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=s.a.createRouteFromReactElement(e))}},propTypes:{to:a.string.isRequired,query:a.object,state:a.object,onEnter:c.c,children:c.c},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(10),n(1)),i=n.n(l),s=n(6),c=n(13),u=o()({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=Object(s.a)(e))}},propTypes:{path:c.c,component:c.a,components:c.b,getComponent:a.func,getComponents:a.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";var r=n(4),o=n.n(r),a=n(3),l=(n.n(a),n(1)),i=n.n(l),s=n(6),c=n(13),u=o()({displayName:"Route",statics:{createRouteFromReactElement:s.a},propTypes:{path:a.string,component:c.a,components:c.b,getComponent:a.func,getComponents:a.func},render:function(){i()(!1)}});t.a=u},function(e,t,n){"use strict";function r(e,t){var n={};for(var r in e)t.indexOf(r)>=0||Object.prototype.hasOwnProperty.call(e,r)&&(n[r]=e[r]);return n}function o(e,t){var n=e.history,o=e.routes,l=e.location,p=r(e,["history","routes","location"]);n||l||i()(!1),n=n||Object(s.a)(p);var b=Object(c.a)(n,Object(u.b)(o));l=l?n.createLocation(l):n.getCurrentLocation(),b.match(l,function(e,r,o){var l=void 0;if(o){var i=Object(f.b)(n,b,o);l=d({},o,{router:i,matchContext:{transitionManager:b,router:i}})}t(e,r&&n.createLocation(r,a.REPLACE),l)})}var a=n(17),l=(n.n(a),n(1)),i=n.n(l),s=n(35),c=n(30),u=n(6),f=n(32),d=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e};t.a=o},function(e,t,n){"use strict";function r(e){switch(e.arrayFormat){case"index":return function(t,n,r){return null===n?[a(t,e),"[",r,"]"].join(""):[a(t,e),"[",a(r,e),"]=",a(n,e)].join("")};case"bracket":return function(t,n){return null===n?a(t,e):[a(t,e),"[]=",a(n,e)].join("")};default:return function(t,n){return null===n?a(t,e):[a(t,e),"=",a(n,e)].join("")}}}function o(e){var t;switch(e.arrayFormat){case"index":return function(e,n,r){if(t=/\[(\d*)\]$/.exec(e),e=e.replace(/\[\d*\]$/,""),!t)return void(r[e]=n);void 0===r[e]&&(r[e]={}),r[e][t[1]]=n};case"bracket":return function(e,n,r){return t=/(\[\])$/.exec(e),e=e.replace(/\[\]$/,""),t?void 0===r[e]?void(r[e]=[n]):void(r[e]=[].concat(r[e],n)):void(r[e]=n)};default:return function(e,t,n){if(void 0===n[e])return void(n[e]=t);n[e]=[].concat(n[e],t)}}}function a(e,t){return t.encode?t.strict?i(e):encodeURIComponent(e):e}function l(e){return Array.isArray(e)?e.sort():"object"==typeof e?l(Object.keys(e)).sort(function(e,t){return Number(e)-Number(t)}).map(function(t){return e[t]}):e}var i=n(79),s=n(14);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e,t){t=s({arrayFormat:"none"},t);var n=o(t),r=Object.create(null);return"string"!=typeof e?r:(e=e.trim().replace(/^(\?|#|&)/,""))?(e.split("&").forEach(function(e){var t=e.replace(/\+/g," ").split("="),o=t.shift(),a=t.length>0?t.join("="):void 0;a=void 0===a?null:decodeURIComponent(a),n(decodeURIComponent(o),a,r)}),Object.keys(r).sort().reduce(function(e,t){var n=r[t];return Boolean(n)&&"object"==typeof n&&!Array.isArray(n)?e[t]=l(n):e[t]=n,e},Object.create(null))):r},t.stringify=function(e,t){t=s({encode:!0,strict:!0,arrayFormat:"none"},t);var n=r(t);return e?Object.keys(e).sort().map(function(r){var o=e[r];if(void 0===o)return"";if(null===o)return a(r,t);if(Array.isArray(o)){var l=[];return o.slice().forEach(function(e){void 0!==e&&l.push(n(r,e,l.length))}),l.join("&")}return a(r,t)+"="+a(o,t)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t,n){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},a=n(5),l=(r(a),n(1)),i=r(l),s=n(12),c=n(7),u=n(25),f=r(u),d=n(17),p=function(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})},b=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};Array.isArray(e)?e={entries:e}:"string"==typeof e&&(e={entries:[e]});var t=function(){var e=h[m],t=(0,c.createPath)(e),n=void 0,r=void 0;e.key&&(n=e.key,r=y(n));var a=(0,c.parsePath)(t);return(0,s.createLocation)(o({},a,{state:r}),void 0,n)},n=function(e){var t=m+e;return t>=0&&t<h.length},r=function(e){if(e&&n(e)){m+=e;var r=t();u.transitionTo(o({},r,{action:d.POP}))}},a=function(e){m+=1,m<h.length&&h.splice(m),h.push(e),v(e.key,e.state)},l=function(e){h[m]=e,v(e.key,e.state)},u=(0,f.default)(o({},e,{getCurrentLocation:t,pushLocation:a,replaceLocation:l,go:r})),b=e,h=b.entries,m=b.current;"string"==typeof h?h=[h]:Array.isArray(h)||(h=["/"]),h=h.map(function(e){return(0,s.createLocation)(e)}),null==m?m=h.length-1:m>=0&&m<h.length||(0,i.default)(!1);var g=p(h),v=function(e,t){return g[e]=t},y=function(e){return g[e]};return o({},u,{canGo:n})};t.default=b},function(e,t,n){"use strict";t.__esModule=!0;t.loopAsync=function(e,t,n){var r=0,o=!1,a=!1,l=!1,i=void 0,s=function(){for(var e=arguments.length,t=Array(e),r=0;r<e;r++)t[r]=arguments[r];if(o=!0,a)return void(i=t);n.apply(void 0,t)};!function c(){if(!o&&(l=!0,!a)){for(a=!0;!o&&r<e&&l;)l=!1,t(r++,c,s);if(a=!1,o)return void n.apply(void 0,i);r>=e&&l&&(o=!0,n())}}()}},function(e,t,n){"use strict";var r=n(0),o=n.n(r),a=n(21),l=(n(10),Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e});t.a=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var i=t.map(function(e){return e.renderRouterContext}).filter(Boolean),s=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),c=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:r.createElement;return function(t,n){return s.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return i.reduceRight(function(t,n){return n(t,e)},o.a.createElement(a.a,l({},e,{createElement:c(e.createElement)})))}}},function(e,t,n){"use strict";var r=n(84),o=n.n(r),a=n(40);t.a=Object(a.a)(o.a)},function(e,t,n){"use strict";function r(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},l=n(1),i=o(l),s=n(26),c=n(27),u=r(c),f=n(85),d=r(f),p=n(18),b=n(25),h=o(b),m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};s.canUseDOM||(0,i.default)(!1);var t=e.forceRefresh||!(0,p.supportsHistory)(),n=t?d:u,r=n.getUserConfirmation,o=n.getCurrentLocation,l=n.pushLocation,c=n.replaceLocation,f=n.go,b=(0,h.default)(a({getUserConfirmation:r},e,{getCurrentLocation:o,pushLocation:l,replaceLocation:c,go:f})),m=0,g=void 0,v=function(e,t){1==++m&&(g=u.startListener(b.transitionTo));var n=t?b.listenBefore(e):b.listen(e);return function(){n(),0==--m&&g()}};return a({},b,{listenBefore:function(e){return v(e,!0)},listen:function(e){return v(e,!1)}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var r=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return r.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return r.go}});var o=n(12),a=n(7);t.getCurrentLocation=function(){return(0,o.createLocation)(window.location)},t.pushLocation=function(e){return window.location.href=(0,a.createPath)(e),!1},t.replaceLocation=function(e){return window.location.replace((0,a.createPath)(e)),!1}},function(e,t,n){"use strict";var r=n(87),o=n.n(r),a=n(40);t.a=Object(a.a)(o.a)},function(e,t,n){"use strict";function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var o=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var r in n)Object.prototype.hasOwnProperty.call(n,r)&&(e[r]=n[r])}return e},a=n(5),l=(r(a),n(1)),i=r(l),s=n(26),c=n(18),u=n(88),f=function(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}(u),d=n(25),p=r(d),b=function(e){return"/"===e.charAt(0)?e:"/"+e},h={hashbang:{encodePath:function(e){return"!"===e.charAt(0)?e:"!"+e},decodePath:function(e){return"!"===e.charAt(0)?e.substring(1):e}},noslash:{encodePath:function(e){return"/"===e.charAt(0)?e.substring(1):e},decodePath:b},slash:{encodePath:b,decodePath:b}},m=function(){var e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:{};s.canUseDOM||(0,i.default)(!1);var t=e.queryKey,n=e.hashType;"string"!=typeof t&&(t="_k"),null==n&&(n="slash"),n in h||(n="slash");var r=h[n],a=f.getUserConfirmation,l=function(){return f.getCurrentLocation(r,t)},u=function(e){return f.pushLocation(e,r,t)},d=function(e){return f.replaceLocation(e,r,t)},b=(0,p.default)(o({getUserConfirmation:a},e,{getCurrentLocation:l,pushLocation:u,replaceLocation:d,go:f.go})),m=0,g=void 0,v=function(e,n){1==++m&&(g=f.startListener(b.transitionTo,r,t));var o=n?b.listenBefore(e):b.listen(e);return function(){o(),0==--m&&g()}},y=function(e){return v(e,!0)},_=function(e){return v(e,!1)};(0,c.supportsGoWithoutReloadUsingHash)();return o({},b,{listenBefore:y,listen:_,go:function(e){b.go(e)},createHref:function(e){return"#"+r.encodePath(b.createHref(e))}})};t.default=m},function(e,t,n){"use strict";t.__esModule=!0,t.replaceLocation=t.pushLocation=t.startListener=t.getCurrentLocation=t.go=t.getUserConfirmation=void 0;var r=n(27);Object.defineProperty(t,"getUserConfirmation",{enumerable:!0,get:function(){return r.getUserConfirmation}}),Object.defineProperty(t,"go",{enumerable:!0,get:function(){return r.go}});var o=n(5),a=(function(e){e&&e.__esModule}(o),n(12)),l=n(18),i=n(39),s=n(7),c=function(){var e=window.location.href,t=e.indexOf("#");return-1===t?"":e.substring(t+1)},u=function(e){return window.location.hash=e},f=function(e){var t=window.location.href.indexOf("#");window.location.replace(window.location.href.slice(0,t>=0?t:0)+"#"+e)},d=t.getCurrentLocation=function(e,t){var n=e.decodePath(c()),r=(0,s.getQueryStringValueFromPath)(n,t),o=void 0;r&&(n=(0,s.stripQueryStringValueFromPath)(n,t),o=(0,i.readState)(r));var l=(0,s.parsePath)(n);return l.state=o,(0,a.createLocation)(l,void 0,r)},p=void 0,b=(t.startListener=function(e,t,n){var r=function(){var r=c(),o=t.encodePath(r);if(r!==o)f(o);else{var a=d(t,n);if(p&&a.key&&p.key===a.key)return;p=a,e(a)}},o=c(),a=t.encodePath(o);return o!==a&&f(a),(0,l.addEventListener)(window,"hashchange",r),function(){return(0,l.removeEventListener)(window,"hashchange",r)}},function(e,t,n,r){var o=e.state,a=e.key,l=t.encodePath((0,s.createPath)(e));void 0!==o&&(l=(0,s.addQueryStringValueToPath)(l,n,a),(0,i.saveState)(a,o)),p=e,r(l)});t.pushLocation=function(e,t,n){return b(e,t,n,function(e){c()!==e&&u(e)})},t.replaceLocation=function(e,t,n){return b(e,t,n,function(e){c()!==e&&f(e)})}}]);
\ No newline at end of file
@@ -147,7 +147,7 @@ export default class DeadJobs extends React.Component {
                         <td>{job.name}</td>
                         <td><Args args={job.args}/></td>
                         <td>{job.err}</td>
-                        <td><UnixTime ts={job.t} /></td>
+                        <td><UnixTime ts={job.t} tsMs={job.t_ms} /></td>
                       </tr>
                     );
                   })
@@ -4,12 +4,17 @@ import PropTypes from 'prop-types';
 export default class UnixTime extends React.Component {
   static propTypes = {
     ts: PropTypes.number.isRequired,
+    // tsMs, if set, is the same time to the millisecond, and is shown instead of ts. It's ignored if it's not within
+    // ts's second, since requeuing a job restamps ts but not tsMs.
+    tsMs: PropTypes.number,
   }
 
   render() {
-    let t = new Date(this.props.ts * 1e3);
+    let ms = this.props.tsMs && Math.floor(this.props.tsMs / 1000) === this.props.ts;
+    let t = ms ? new Date(this.props.tsMs) : new Date(this.props.ts * 1e3);
+    let length = ms ? 23 : 19;
     return (
-      <time dateTime={t.toISOString()}>{t.toISOString().slice(0, 19).replace(/-/g, '/').replace('T', ' ')}</time>
+      <time dateTime={t.toISOString()}>{t.toISOString().slice(0, length).replace(/-/g, '/').replace('T', ' ')}</time>
     );
   }
 }
@@ -12,4 +12,20 @@ describe('UnixTime', () => {
     expect(time.props().dateTime).toEqual('2016-07-05T21:20:03.000Z');
     expect(time.text()).toEqual('2016/07/05 21:20:03');
   });
+
+  it('formats milliseconds when given them', () => {
+    let output = mount(<UnixTime ts={1467753603} tsMs={1467753603250} />);
+
+    let time = output.find('time');
+    expect(time.props().dateTime).toEqual('2016-07-05T21:20:03.250Z');
+    expect(time.text()).toEqual('2016/07/05 21:20:03.250');
+  });
+
+  it('ignores milliseconds from a different second', () => {
+    let output = mount(<UnixTime ts={1467753703} tsMs={1467753603250} />);
+
+    let time = output.find('time');
+    expect(time.props().dateTime).toEqual('2016-07-05T21:21:43.000Z');
+    expect(time.text()).toEqual('2016/07/05 21:21:43');
+  });
 });
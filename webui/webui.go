@@ -1,10 +1,12 @@
 package webui
 
 import (
+	"bytes"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,7 +23,8 @@ type Server struct {
 }
 
 type context struct {
-	client *work.Client
+	client   *work.Client
+	jsonCase JSONCase
 }
 
 // NewServer creates and returns a new server. The 'namespace' param is the redis namespace to use. The hostPort param is the address to bind on to expose the API.
@@ -57,23 +60,23 @@ func (w *Server) Stop() {
 }
 
 func (c *context) ping(rw http.ResponseWriter, _ *http.Request) {
-	render(rw, map[string]string{"ping": "pong", "current_time": time.Now().Format(time.RFC3339)}, nil)
+	c.render(rw, map[string]string{"ping": "pong", "current_time": time.Now().Format(time.RFC3339)}, nil)
 }
 
 func (c *context) queues(rw http.ResponseWriter, _ *http.Request) {
 	response, err := c.client.Queues()
-	render(rw, response, err)
+	c.render(rw, response, err)
 }
 
 func (c *context) workerPools(rw http.ResponseWriter, _ *http.Request) {
 	response, err := c.client.WorkerPoolHeartbeats()
-	render(rw, response, err)
+	c.render(rw, response, err)
 }
 
 func (c *context) busyWorkers(rw http.ResponseWriter, _ *http.Request) {
 	observations, err := c.client.WorkerObservations()
 	if err != nil {
-		renderError(rw, err)
+		c.renderError(rw, err)
 		return
 	}
 
@@ -84,19 +87,40 @@ func (c *context) busyWorkers(rw http.ResponseWriter, _ *http.Request) {
 		}
 	}
 
-	render(rw, busyObservations, err)
+	c.render(rw, busyObservations, err)
+}
+
+func (c *context) summary(rw http.ResponseWriter, _ *http.Request) {
+	summary, err := c.client.Summary()
+	c.render(rw, summary, err)
+}
+
+func (c *context) poolStats(rw http.ResponseWriter, _ *http.Request) {
+	stats := c.client.PoolStats()
+	response := struct {
+		ActiveCount  int   `json:"active_count"`
+		IdleCount    int   `json:"idle_count"`
+		WaitCount    int64 `json:"wait_count"`
+		WaitDuration int64 `json:"wait_duration_ms"`
+	}{
+		ActiveCount:  stats.ActiveCount,
+		IdleCount:    stats.IdleCount,
+		WaitCount:    stats.WaitCount,
+		WaitDuration: stats.WaitDuration.Milliseconds(),
+	}
+	c.render(rw, response, nil)
 }
 
 func (c *context) retryJobs(rw http.ResponseWriter, r *http.Request) {
 	page, err := parsePage(r)
 	if err != nil {
-		renderError(rw, err)
+		c.renderBadRequest(rw, err)
 		return
 	}
 
 	jobs, count, err := c.client.RetryJobs(page)
 	if err != nil {
-		renderError(rw, err)
+		c.renderError(rw, err)
 		return
 	}
 
@@ -105,19 +129,36 @@ func (c *context) retryJobs(rw http.ResponseWriter, r *http.Request) {
 		Jobs  []*work.RetryJob `json:"jobs"`
 	}{Count: count, Jobs: jobs}
 
-	render(rw, response, err)
+	c.render(rw, response, err)
 }
 
 func (c *context) scheduledJobs(rw http.ResponseWriter, r *http.Request) {
 	page, err := parsePage(r)
 	if err != nil {
-		renderError(rw, err)
+		c.renderBadRequest(rw, err)
 		return
 	}
 
-	jobs, count, err := c.client.ScheduledJobs(page)
+	// from and to, in epoch seconds, list the jobs due in that range instead of a page
+	var jobs []*work.ScheduledJob
+	var count int64
+	if r.Form.Get("from") != "" || r.Form.Get("to") != "" {
+		var from, to time.Time
+		if from, err = parseEpochParam(r, "from"); err != nil {
+			c.renderBadRequest(rw, err)
+			return
+		}
+		if to, err = parseEpochParam(r, "to"); err != nil {
+			c.renderBadRequest(rw, err)
+			return
+		}
+		jobs, err = c.client.ScheduledJobsBetween(from, to)
+		count = int64(len(jobs))
+	} else {
+		jobs, count, err = c.client.ScheduledJobs(page)
+	}
 	if err != nil {
-		renderError(rw, err)
+		c.renderError(rw, err)
 		return
 	}
 
@@ -126,19 +167,25 @@ func (c *context) scheduledJobs(rw http.ResponseWriter, r *http.Request) {
 		Jobs  []*work.ScheduledJob `json:"jobs"`
 	}{Count: count, Jobs: jobs}
 
-	render(rw, response, err)
+	c.render(rw, response, err)
 }
 
 func (c *context) deadJobs(rw http.ResponseWriter, r *http.Request) {
 	page, err := parsePage(r)
 	if err != nil {
-		renderError(rw, err)
+		c.renderBadRequest(rw, err)
 		return
 	}
 
-	jobs, count, err := c.client.DeadJobs(page)
+	var jobs []*work.DeadJob
+	var count int64
+	if jobName := r.Form.Get("job_name"); jobName != "" {
+		jobs, count, err = c.client.DeadJobsForJob(jobName, page)
+	} else {
+		jobs, count, err = c.client.DeadJobs(page)
+	}
 	if err != nil {
-		renderError(rw, err)
+		c.renderError(rw, err)
 		return
 	}
 
@@ -147,41 +194,137 @@ func (c *context) deadJobs(rw http.ResponseWriter, r *http.Request) {
 		Jobs  []*work.DeadJob `json:"jobs"`
 	}{Count: count, Jobs: jobs}
 
-	render(rw, response, err)
+	c.render(rw, response, err)
+}
+
+func (c *context) completedJobs(rw http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	limit := 20
+	if limitStr := r.Form.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			c.renderBadRequest(rw, err)
+			return
+		}
+	}
+
+	jobs, err := c.client.RecentCompleted(limit)
+	c.render(rw, jobs, err)
 }
 
 func (c *context) deleteDeadJob(rw http.ResponseWriter, r *http.Request) {
 	diedAt, err := strconv.ParseInt(r.PathValue("died_at"), 10, 64)
 	if err != nil {
-		renderError(rw, err)
+		c.renderBadRequest(rw, err)
 		return
 	}
 
-	err = c.client.DeleteDeadJob(diedAt, r.PathValue("job_id"))
+	// job_name picks the dead set of a job registered with SeparateDeadSet, as it does for dead_jobs
+	if jobName := r.FormValue("job_name"); jobName != "" {
+		err = c.client.DeleteDeadJobForJob(jobName, diedAt, r.PathValue("job_id"))
+	} else {
+		err = c.client.DeleteDeadJob(diedAt, r.PathValue("job_id"))
+	}
 
-	render(rw, map[string]string{"status": "ok"}, err)
+	c.render(rw, map[string]string{"status": "ok"}, err)
 }
 
 func (c *context) retryDeadJob(rw http.ResponseWriter, r *http.Request) {
 	diedAt, err := strconv.ParseInt(r.PathValue("died_at"), 10, 64)
 	if err != nil {
-		renderError(rw, err)
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	if jobName := r.FormValue("job_name"); jobName != "" {
+		err = c.client.RetryDeadJobForJob(jobName, diedAt, r.PathValue("job_id"))
+	} else {
+		err = c.client.RetryDeadJob(diedAt, r.PathValue("job_id"))
+	}
+
+	c.render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) rescheduleDeadJob(rw http.ResponseWriter, r *http.Request) {
+	diedAt, err := strconv.ParseInt(r.PathValue("died_at"), 10, 64)
+	if err != nil {
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	// delay is in seconds from now
+	delay, err := strconv.ParseInt(r.FormValue("delay"), 10, 64)
+	if err != nil {
+		c.renderBadRequest(rw, err)
 		return
 	}
 
-	err = c.client.RetryDeadJob(diedAt, r.PathValue("job_id"))
+	err = c.client.RescheduleDeadJob(diedAt, r.PathValue("job_id"), time.Now().Add(time.Duration(delay)*time.Second))
 
-	render(rw, map[string]string{"status": "ok"}, err)
+	c.render(rw, map[string]string{"status": "ok"}, err)
 }
 
-func (c *context) deleteAllDeadJobs(rw http.ResponseWriter, _ *http.Request) {
-	err := c.client.DeleteAllDeadJobs()
-	render(rw, map[string]string{"status": "ok"}, err)
+func (c *context) runRetryJobNow(rw http.ResponseWriter, r *http.Request) {
+	retryAt, err := strconv.ParseInt(r.PathValue("retry_at"), 10, 64)
+	if err != nil {
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	err = c.client.RetryJobNow(retryAt, r.PathValue("job_id"))
+
+	c.render(rw, map[string]string{"status": "ok"}, err)
 }
 
-func (c *context) retryAllDeadJobs(rw http.ResponseWriter, _ *http.Request) {
-	err := c.client.RetryAllDeadJobs()
-	render(rw, map[string]string{"status": "ok"}, err)
+func (c *context) rerunCompletedJob(rw http.ResponseWriter, r *http.Request) {
+	job, err := c.client.RerunCompletedJob(r.PathValue("job_id"))
+	if err != nil {
+		c.renderError(rw, err)
+		return
+	}
+
+	c.render(rw, map[string]string{"status": "ok", "job_id": job.ID}, nil)
+}
+
+func (c *context) deleteAllDeadJobs(rw http.ResponseWriter, r *http.Request) {
+	var err error
+	if jobName := r.FormValue("job_name"); jobName != "" {
+		err = c.client.DeleteAllDeadJobsForJob(jobName)
+	} else {
+		err = c.client.DeleteAllDeadJobs()
+	}
+	c.render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) retryAllDeadJobs(rw http.ResponseWriter, r *http.Request) {
+	var err error
+	if jobName := r.FormValue("job_name"); jobName != "" {
+		err = c.client.RetryAllDeadJobsForJob(jobName)
+	} else {
+		err = c.client.RetryAllDeadJobs()
+	}
+	c.render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) retryDeadJobs(rw http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	limit, err := strconv.ParseInt(r.Form.Get("limit"), 10, 64)
+	if err != nil {
+		c.renderBadRequest(rw, err)
+		return
+	}
+
+	retried, err := c.client.RetryDeadJobs(limit)
+	c.render(rw, map[string]int64{"retried": retried}, err)
 }
 
 func (c *context) indexPage(rw http.ResponseWriter, _ *http.Request) {
@@ -194,25 +337,102 @@ func (c *context) workJS(rw http.ResponseWriter, _ *http.Request) {
 	_, _ = rw.Write(mustAsset("work.js"))
 }
 
-func render(rw http.ResponseWriter, jsonable interface{}, err error) {
+func (c *context) render(rw http.ResponseWriter, jsonable interface{}, err error) {
 	if err != nil {
-		renderError(rw, err)
+		c.renderError(rw, err)
 		return
 	}
 
+	c.renderJSON(rw, http.StatusOK, jsonable)
+}
+
+// renderError renders err in a {"error": "..."} envelope. Jobs that the client couldn't find to delete or retry are a
+// 404, everything else is a 500.
+func (c *context) renderError(rw http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, work.ErrNotDeleted) || errors.Is(err, work.ErrNotRetried) || errors.Is(err, work.ErrJobNotFound) {
+		status = http.StatusNotFound
+	}
+	c.renderJSON(rw, status, map[string]string{"error": err.Error()})
+}
+
+// renderBadRequest renders err, from parsing a request's params, as a 400.
+func (c *context) renderBadRequest(rw http.ResponseWriter, err error) {
+	c.renderJSON(rw, http.StatusBadRequest, map[string]string{"error": err.Error()})
+}
+
+func (c *context) renderJSON(rw http.ResponseWriter, status int, jsonable interface{}) {
+	if c.jsonCase == CamelCase {
+		jsonable = camelCaseKeys(jsonable)
+	}
 	jsonData, err := json.MarshalIndent(jsonable, "", "\t")
 	if err != nil {
-		renderError(rw, err)
-		return
+		status = http.StatusInternalServerError
+		jsonData, _ = json.Marshal(map[string]string{"error": err.Error()})
 	}
 	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.WriteHeader(status)
 	_, _ = rw.Write(jsonData)
 }
 
-func renderError(rw http.ResponseWriter, err error) {
-	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
-	rw.WriteHeader(500)
-	_, _ = fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+// camelCaseKeys returns jsonable as generic JSON values with every object key camel cased, except within job args,
+// which are the caller's own data. If jsonable can't be round tripped through JSON, it's returned as is, so that
+// marshaling it reports the error.
+func camelCaseKeys(jsonable interface{}) interface{} {
+	b, err := json.Marshal(jsonable)
+	if err != nil {
+		return jsonable
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return jsonable
+	}
+	return camelCaseValue(v)
+}
+
+func camelCaseValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, vv := range v {
+			if k != "args" {
+				vv = camelCaseValue(vv)
+			}
+			m[snakeToCamel(k)] = vv
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = camelCaseValue(v[i])
+		}
+		return v
+	}
+	return v
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// parseEpochParam parses the form value name as epoch seconds. It returns the zero time if it's missing.
+func parseEpochParam(r *http.Request, name string) (time.Time, error) {
+	str := r.Form.Get(name)
+	if str == "" {
+		return time.Time{}, nil
+	}
+	epoch, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(epoch, 0), nil
 }
 
 func parsePage(r *http.Request) (uint, error) {
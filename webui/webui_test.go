@@ -205,6 +205,44 @@ func (s *TestWebUIServerSuite) TestBusyWorkers() {
 	}
 }
 
+func (s *TestWebUIServerSuite) TestBusyWorkersCheckinFlushInterval() {
+	release := make(chan struct{})
+	wp := work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool)
+	wp.SetObserverFlushInterval(20 * time.Millisecond)
+	wp.Job("wat", func(job *work.Job) error {
+		job.Checkin("halfway")
+		<-release
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+	defer close(release)
+
+	_, err := s.enqueuer.Enqueue("wat", nil)
+	s.NoError(err)
+
+	// With the default interval of a second, the checkin could take up to that long to show
+	deadline := time.Now().Add(500 * time.Millisecond)
+	var checkin interface{}
+	for checkin == nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+
+		resp, err := http.Get("http://127.0.0.1:6666/busy_workers")
+		s.NoError(err)
+		var res []map[string]interface{}
+		s.NoError(json.NewDecoder(resp.Body).Decode(&res))
+		resp.Body.Close()
+		if len(res) == 1 && res[0]["checkin"] != "" {
+			checkin = res[0]["checkin"]
+		}
+	}
+	s.Equal("halfway", checkin)
+
+	s.Panics(func() {
+		work.NewWorkerPool(TestContext{}, 1, s.ns, s.pool).SetObserverFlushInterval(time.Millisecond)
+	})
+}
+
 func (s *TestWebUIServerSuite) TestRetryJobs() {
 
 	enqueuer := s.enqueuer
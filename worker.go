@@ -1,9 +1,14 @@
 package work
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -21,8 +26,30 @@ type worker struct {
 	middleware    []*middlewareHandler
 	contextType   reflect.Type
 
+	keepCompletedJobs   int64
+	maxDeadJobs         int64
+	maxRetryJobs        int64
+	retryEvictionPolicy RetryEvictionPolicy
+	priorityBands       []uint
+	priorityAging       float64
+	decodeErrorPolicy   DecodeErrorPolicy
+	panicHandler        PanicHandler
+	defaultBackoff      BackoffCalculator
+	errorClassifier     ErrorClassifier
+	retryPolicy         RetryPolicy
+	executionSlots      chan struct{}  // if set, shared by the pool's workers to limit how many run handlers at once
+	fetchSlots          chan struct{}  // if set, shared by the pool's workers to limit how many fetch jobs at once
+	queueActivity       *queueActivity // if set, shared by the pool's workers to call OnQueueActive
+	readyGate           *readyGate     // if set, shared by the pool's workers to hold fetching until SetReadyCheck passes
+
+	consolidateInProgress bool
+	lazyArgs              bool
+	publishCompletions    bool
+	generation            string
+
 	redisFetchScript *redis.Script
 	sampler          prioritySampler
+	agedAt           time.Time // when priorities were last aged
 	*observer
 
 	stopChan         chan struct{}
@@ -30,6 +57,15 @@ type worker struct {
 
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
+
+	quiesceChan chan struct{}
+	quiesced    atomic.Bool // set by quiesce until the worker is started again
+
+	// fetchMtx is held while fetching and abandonMtx while a job is taken out of progress, so that once abandon
+	// returns, the worker won't claim or finish any more jobs
+	fetchMtx   sync.Mutex
+	abandonMtx sync.Mutex
+	abandoned  bool
 }
 
 func newWorker(namespace string, poolID string, pool *redis.Pool, contextType reflect.Type, middleware []*middlewareHandler, jobTypes map[string]*jobType, sleepBackoffs []int64) *worker {
@@ -55,6 +91,8 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 
 		drainChan:        make(chan struct{}),
 		doneDrainingChan: make(chan struct{}),
+
+		quiesceChan: make(chan struct{}),
 	}
 
 	w.updateMiddlewareAndJobTypes(middleware, jobTypes)
@@ -67,20 +105,37 @@ func (w *worker) updateMiddlewareAndJobTypes(middleware []*middlewareHandler, jo
 	w.middleware = middleware
 	sampler := prioritySampler{}
 	for _, jt := range jobTypes {
-		sampler.add(jt.Priority,
-			redisKeyJobs(w.namespace, jt.Name),
-			redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
+		inProgQueue := redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name)
+		if w.consolidateInProgress {
+			inProgQueue = redisKeyPoolInProgress(w.namespace, w.poolID)
+		}
+		sampler.addInBand(priorityBand(w.priorityBands, jt.Priority), jt.sampleWeight(),
+			redisKeyJobsGeneration(w.namespace, jt.Name, w.generation),
+			inProgQueue,
 			redisKeyJobsPaused(w.namespace, jt.Name),
 			redisKeyJobsLock(w.namespace, jt.Name),
 			redisKeyJobsLockInfo(w.namespace, jt.Name),
 			redisKeyJobsConcurrency(w.namespace, jt.Name))
+		sampler.samples[len(sampler.samples)-1].jobName = jt.Name
 	}
 	w.sampler = sampler
+	w.agedAt = time.Time{}
 	w.jobTypes = jobTypes
 	w.redisFetchScript = redis.NewScript(len(jobTypes)*fetchKeysPerJobType, redisLuaFetchJob)
 }
 
+// priorityBand returns the index of the first band (sorted highest first) that priority reaches, or len(bands) if none.
+func priorityBand(bands []uint, priority uint) int {
+	for i, b := range bands {
+		if priority >= b {
+			return i
+		}
+	}
+	return len(bands)
+}
+
 func (w *worker) start() {
+	w.quiesced.Store(false)
 	go w.loop()
 	go w.observer.start()
 }
@@ -98,10 +153,53 @@ func (w *worker) drain() {
 	w.observer.drain()
 }
 
+// abandon stops the worker from fetching any more jobs or finishing the one it's running, if any, which is left in
+// progress for the pool to requeue. Unlike quiesce, it doesn't wait for the job to be done.
+func (w *worker) abandon() {
+	w.fetchMtx.Lock()
+	defer w.fetchMtx.Unlock()
+	w.abandonMtx.Lock()
+	w.abandoned = true
+	w.abandonMtx.Unlock()
+}
+
+// fetchUnlessAbandoned fetches a job unless the worker has been abandoned. It holds off abandon while it fetches, so
+// that the pool doesn't requeue the worker's jobs only for it to claim another.
+func (w *worker) fetchUnlessAbandoned() (job *Job, abandoned bool, err error) {
+	w.fetchMtx.Lock()
+	defer w.fetchMtx.Unlock()
+	w.abandonMtx.Lock()
+	abandoned = w.abandoned
+	w.abandonMtx.Unlock()
+	if abandoned {
+		return nil, true, nil
+	}
+
+	if w.fetchSlots != nil {
+		w.fetchSlots <- struct{}{}
+		defer func() { <-w.fetchSlots }()
+	}
+	job, err = w.fetchJob()
+	return job, false, err
+}
+
+// quiesce stops the worker from fetching any more jobs. Since jobs are processed inline in the loop, it returns true
+// once the job the worker was running, if any, is done. If cancel is closed first it returns false, and the worker
+// stops fetching once the job is done all the same. The worker still responds to drain and stop.
+func (w *worker) quiesce(cancel <-chan struct{}) bool {
+	w.quiesced.Store(true)
+	select {
+	case w.quiesceChan <- struct{}{}:
+		return true
+	case <-cancel:
+		return false
+	}
+}
+
 var sleepBackoffsInMilliseconds = []int64{0, 10, 100, 1000, 5000}
 
 func (w *worker) loop() {
-	var drained bool
+	var drained, ready bool
 	var consequtiveNoJobs int64
 
 	// Begin immediately. We'll change the duration on each tick with a timer.Reset()
@@ -116,16 +214,44 @@ func (w *worker) loop() {
 		case <-w.drainChan:
 			drained = true
 			timer.Reset(0)
+		case <-w.quiesceChan:
+			// quiesce is only waiting to hear that the worker isn't running a job
 		case <-timer.C:
-			job, err := w.fetchJob()
+			if w.quiesced.Load() {
+				if drained {
+					w.doneDrainingChan <- struct{}{}
+					drained = false
+				}
+				continue
+			}
+			if !ready {
+				if ready = w.readyGate == nil || w.readyGate.isReady(); !ready {
+					timer.Reset(readyCheckInterval)
+					continue
+				}
+			}
+			job, abandoned, err := w.fetchUnlessAbandoned()
+			if abandoned {
+				continue
+			}
 			if err != nil {
 				logError("worker.fetch", err)
 				timer.Reset(10 * time.Millisecond)
 			} else if job != nil {
-				w.processJob(job)
-				consequtiveNoJobs = 0
-				timer.Reset(0)
+				if w.queueActivity != nil {
+					w.queueActivity.sawJob(job.Name)
+				}
+				if w.processJob(job) {
+					consequtiveNoJobs = 0
+					timer.Reset(0)
+				} else {
+					// The job had to wait its turn, so give the one holding it up a moment before fetching again
+					timer.Reset(serialLockPollInterval)
+				}
 			} else {
+				if w.queueActivity != nil {
+					w.queueActivity.sawEmpty()
+				}
 				if drained {
 					w.doneDrainingChan <- struct{}{}
 					drained = false
@@ -142,6 +268,10 @@ func (w *worker) loop() {
 }
 
 func (w *worker) fetchJob() (*Job, error) {
+	if w.priorityAging > 0 {
+		w.agePriorities()
+	}
+
 	// resort queues
 	// NOTE: we could optimize this to only resort every second, or something.
 	w.sampler.sample()
@@ -181,15 +311,169 @@ func (w *worker) fetchJob() (*Job, error) {
 		return nil, fmt.Errorf("response in prog not bytes")
 	}
 
-	job, err := newJob(rawJSON, dequeuedFrom, inProgQueue)
+	decode := newJob
+	if w.lazyArgs {
+		decode = newLazyJob
+	}
+	job, err := decode(rawJSON, dequeuedFrom, inProgQueue)
 	if err != nil {
+		// The job is already in progress, so get it out of there. Otherwise it'd hold its lock until the reaper
+		// puts it back on the queue, where it would fail the same way again.
+		w.removeUndecodableJob(rawJSON, dequeuedFrom, inProgQueue, err)
 		return nil, err
 	}
 
+	// For Client.StuckJobs. The job is already claimed, so a failure here only hides it from that.
+	if _, err := conn.Do("HSET", redisKeyPoolClaimedAt(w.namespace, w.poolID), job.ID, nowEpochSeconds()); err != nil {
+		logError("worker.fetch_job.claimed_at", err)
+	}
+
 	return job, nil
 }
 
-func (w *worker) processJob(job *Job) {
+// queueActiveDebounce is the least time between two calls to an OnQueueActive callback for the same job.
+const queueActiveDebounce = time.Second
+
+// queueActivity tracks which of a pool's queues its workers last found empty, to call the pool's OnQueueActive
+// callback when one of them has jobs again. It's shared by the pool's workers.
+type queueActivity struct {
+	fn func(jobName string)
+
+	mtx     sync.Mutex
+	active  map[string]bool
+	firedAt map[string]time.Time
+}
+
+func newQueueActivity(fn func(jobName string)) *queueActivity {
+	return &queueActivity{
+		fn:      fn,
+		active:  make(map[string]bool),
+		firedAt: make(map[string]time.Time),
+	}
+}
+
+// sawEmpty records that a worker found no jobs to fetch, so every queue was empty, or paused or at its max concurrency.
+func (q *queueActivity) sawEmpty() {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	for jobName := range q.active {
+		delete(q.active, jobName)
+	}
+}
+
+// sawJob records that a worker fetched a jobName job, calling the callback if the queue was empty before.
+func (q *queueActivity) sawJob(jobName string) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.active[jobName] {
+		return
+	}
+	q.active[jobName] = true
+
+	now := time.Now()
+	if now.Sub(q.firedAt[jobName]) < queueActiveDebounce {
+		return
+	}
+	q.firedAt[jobName] = now
+	go q.fn(jobName)
+}
+
+// readyCheckInterval is how often workers call a pool's SetReadyCheck function until it first passes.
+const readyCheckInterval = 100 * time.Millisecond
+
+// readyGate holds a pool's workers back from fetching until its check passes. Once it has, it isn't called again.
+type readyGate struct {
+	check func() bool
+
+	mtx   sync.Mutex
+	ready bool
+}
+
+func (g *readyGate) isReady() bool {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if !g.ready {
+		g.ready = g.check()
+	}
+	return g.ready
+}
+
+// priorityAgingInterval is how often workers look at how long the next job in each queue has waited, when aging
+// priorities. Ages are only needed to the second, and it saves a round trip to Redis on most fetches.
+const priorityAgingInterval = time.Second
+
+// agePriorities raises the priority of each queue by w.priorityAging for every second its next job has been waiting,
+// and moves it up the priority bands to match.
+func (w *worker) agePriorities() {
+	if !w.agedAt.IsZero() && time.Since(w.agedAt) < priorityAgingInterval {
+		return
+	}
+	w.agedAt = time.Now()
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	for _, s := range w.sampler.samples {
+		conn.Send("LINDEX", s.redisJobs, -1)
+	}
+	if err := conn.Flush(); err != nil {
+		logError("worker.age_priorities.flush", err)
+		return
+	}
+
+	now := nowEpochSeconds()
+	for i := range w.sampler.samples {
+		sample := &w.sampler.samples[i]
+		rawJSON, err := redis.Bytes(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			logError("worker.age_priorities.receive", err)
+			return
+		}
+		jt := w.jobTypes[sample.jobName]
+		if jt == nil {
+			continue
+		}
+
+		var age int64
+		if rawJSON != nil {
+			var next struct {
+				EnqueuedAt int64 `json:"t"`
+			}
+			if err := json.Unmarshal(rawJSON, &next); err == nil && now > next.EnqueuedAt {
+				age = now - next.EnqueuedAt
+			}
+		}
+
+		aged := *jt
+		aged.Priority += uint(w.priorityAging * float64(age))
+		sample.priority = aged.sampleWeight()
+		sample.band = priorityBand(w.priorityBands, aged.Priority)
+	}
+	w.sampler.reweigh()
+}
+
+func (w *worker) removeUndecodableJob(rawJSON, dequeuedFrom, inProgQueue []byte, decodeErr error) {
+	job := &Job{
+		Name:         strings.TrimPrefix(string(dequeuedFrom), redisKeyJobsPrefix(w.namespace)),
+		ID:           makeIdentifier(),
+		EnqueuedAt:   nowEpochSeconds(),
+		Args:         map[string]interface{}{"raw_json": string(rawJSON)},
+		rawJSON:      rawJSON,
+		dequeuedFrom: dequeuedFrom,
+		inProgQueue:  inProgQueue,
+	}
+	job.failed(fmt.Errorf("decoding job: %v", decodeErr))
+
+	fate := terminateOnly
+	if w.decodeErrorPolicy == DecodeErrorBury {
+		fate = terminateAndDead(w, job, DeadReasonUndecodable)
+	}
+	w.removeJobFromInProgress(job, fate)
+}
+
+// processJob runs job and takes it out of progress. It returns false if it put the job back on its queue instead,
+// because of its SerializeByArg.
+func (w *worker) processJob(job *Job) bool {
 	if job.Unique {
 		updatedJob := w.getAndDeleteUniqueJob(job)
 		// This is to support the old way of doing it, where we used the job off the queue and just deleted the unique key
@@ -200,23 +484,164 @@ func (w *worker) processJob(job *Job) {
 		}
 	}
 	var runErr error
+	var duration time.Duration
 	jt := w.jobTypes[job.Name]
 	if jt == nil {
 		runErr = fmt.Errorf("stray job: no handler")
 		logError("process_job.stray", runErr)
+	} else if job.pastDeadline() {
+		// Running the job late is pointless, so bury it without running the handler.
+		job.failed(errDeadlineExceeded)
+		w.removeJobFromInProgress(job, w.publishCompletion(w.followUp(terminateAndDead(w, job, DeadReasonDeadline), job.OnFailure), job, errDeadlineExceeded))
+		return true
+	} else if err := jt.validateArgs(job); err != nil {
+		// Invalid args won't become valid on retry, so bury the job without running the handler.
+		job.failed(err)
+		w.removeJobFromInProgress(job, w.publishCompletion(w.followUp(terminateAndDead(w, job, DeadReasonInvalidArgs), job.OnFailure), job, err))
+		return true
 	} else {
-		w.observeStarted(job.Name, job.ID, job.Args)
+		unlock, locked := w.lockSerialArg(jt, job)
+		if !locked {
+			w.putBackSerialJob(job)
+			return false
+		}
+		if w.executionSlots != nil {
+			w.executionSlots <- struct{}{}
+		}
+		if job.rawArgs != nil {
+			w.observeStartedRaw(job.Name, job.ID, job.rawArgs)
+		} else {
+			w.observeStarted(job.Name, job.ID, job.Args)
+		}
 		job.observer = w.observer // for Checkin
-		_, runErr = runJob(job, w.contextType, w.middleware, jt)
+		job.workerPoolID = w.poolID
+		job.workerID = w.workerID
+		if jt.inFlight != nil {
+			jt.inFlight.start(job)
+		}
+		startedAt := time.Now()
+		if jt.AtMostOnce {
+			runErr = w.consumeJob(job)
+		}
+		if runErr == nil {
+			_, runErr = runJob(job, w.contextType, w.middleware, jt, w.panicHandler)
+		}
+		duration = time.Since(startedAt)
+		if jt.SlowThreshold > 0 && duration > jt.SlowThreshold {
+			logWarning("worker.slow_job", fmt.Sprintf("job_name=%s job_id=%s duration=%v threshold=%v", job.Name, job.ID, duration, jt.SlowThreshold))
+		}
 		w.observeDone(job.Name, job.ID, runErr)
+		if w.executionSlots != nil {
+			<-w.executionSlots
+		}
+		unlock()
 	}
 
 	fate := terminateOnly
 	if runErr != nil {
 		job.failed(runErr)
-		fate = w.jobFate(jt, job)
+		fate = w.countFailure(w.jobFate(jt, job, runErr), runErr)
+	} else {
+		if w.keepCompletedJobs > 0 {
+			fate = terminateAndRecordCompleted(w, job, duration)
+		}
+		fate = w.followUp(fate, job.OnSuccess)
+	}
+	w.removeJobFromInProgress(job, w.publishCompletion(fate, job, runErr))
+	return true
+}
+
+const (
+	serialLockTTL          = 30 * time.Second
+	serialLockPollInterval = 50 * time.Millisecond
+)
+
+// lockSerialArg takes the lock on job's value of jt's SerializeByArg, and holds it until the returned func is called.
+// If another worker is running a job with the same value, it returns false instead. The lock expires unless it's
+// renewed, so a worker that dies mid-job doesn't hold it forever.
+func (w *worker) lockSerialArg(jt *jobType, job *Job) (func(), bool) {
+	arg := jt.SerializeByArg
+	if arg == "" {
+		return func() {}, true
+	}
+	value, ok := job.arg(arg)
+	if !ok {
+		return func() {}, true
+	}
+	key := redisKeyJobsSerialLock(w.namespace, job.Name, fmt.Sprint(value))
+	ttl := serialLockTTL.Milliseconds()
+
+	conn := w.pool.Get()
+	_, err := redis.String(conn.Do("SET", key, w.workerID, "NX", "PX", ttl))
+	conn.Close()
+	if err == redis.ErrNil {
+		return nil, false
+	} else if err != nil {
+		// Rather than stall the worker while Redis is unavailable, run the job unserialized.
+		logError("worker.serial_lock.acquire", err)
+		return func() {}, true
+	}
+
+	script := redis.NewScript(1, redisLuaSerialLockCmd)
+	done := make(chan struct{})
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		ticker := time.NewTicker(serialLockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				conn := w.pool.Get()
+				if _, err := script.Do(conn, key, w.workerID, ttl); err != nil {
+					logError("worker.serial_lock.renew", err)
+				}
+				conn.Close()
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-renewed
+		conn := w.pool.Get()
+		defer conn.Close()
+		if _, err := script.Do(conn, key, w.workerID, ""); err != nil {
+			logError("worker.serial_lock.release", err)
+		}
+	}, true
+}
+
+// putBackSerialJob takes a job whose SerializeByArg value is locked by another worker out of progress without running
+// it, and puts it on the back of its queue to try again once the jobs ahead of it have had their turn. It isn't
+// counted as a failure.
+func (w *worker) putBackSerialJob(job *Job) {
+	w.abandonMtx.Lock()
+	defer w.abandonMtx.Unlock()
+	if w.abandoned {
+		// The pool was stopped with StopAndRequeue, which has already put the job back on its queue
+		return
+	}
+	rawJSON, err := job.serialize()
+	if err != nil {
+		logError("worker.put_back_serial_job.serialize", err)
+		return
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
+	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
+	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	conn.Send("HDEL", redisKeyPoolClaimedAt(w.namespace, w.poolID), job.ID)
+	conn.Send("LPUSH", redisKeyJobsGeneration(w.namespace, job.Name, job.Generation), rawJSON)
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError("worker.put_back_serial_job.exec", err)
 	}
-	w.removeJobFromInProgress(job, fate)
 }
 
 func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
@@ -226,6 +651,7 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 	if job.UniqueKey != "" {
 		uniqueKey = job.UniqueKey
 	} else { // For jobs put in queue prior to this change. In the future this can be deleted as there will always be a UniqueKey
+		job.LoadArgs()
 		uniqueKey, err = redisKeyUniqueJob(w.namespace, job.Name, job.Args)
 		if err != nil {
 			logError("worker.delete_unique_job.key", err)
@@ -265,59 +691,243 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 }
 
 func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
+	w.abandonMtx.Lock()
+	defer w.abandonMtx.Unlock()
+	if w.abandoned {
+		if jt := w.jobTypes[job.Name]; jt == nil || !jt.AtMostOnce {
+			// The pool was stopped with StopAndRequeue, which has already put the job back on its queue and released its lock
+			return
+		}
+		// An AtMostOnce job was already off the in-progress list, so StopAndRequeue didn't requeue it, but it did clear
+		// the pool's share of the lock, which releaseConsumedLock checks for
+	}
+	if jt := w.jobTypes[job.Name]; jt != nil && jt.inFlight != nil && jt.inFlight.finish(job) {
+		// The job outlived its MaxInFlight, so the pool has already failed it and released its lock
+		return
+	}
+
 	conn := w.pool.Get()
 	defer conn.Close()
 
 	conn.Send("MULTI")
 	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
-	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
-	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	if jt := w.jobTypes[job.Name]; jt != nil && jt.AtMostOnce {
+		// Its pool may have been stopped or reaped while the handler ran, which has already released the lock
+		conn.Send("EVAL", redisLuaReleaseConsumedLock, 2, redisKeyJobsLock(w.namespace, job.Name), redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID)
+	} else {
+		conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
+		conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	}
+	conn.Send("HDEL", redisKeyPoolClaimedAt(w.namespace, w.poolID), job.ID)
+	now := nowEpochSeconds()
+	throughputKey := redisKeyJobsThroughput(w.namespace, job.Name, now/60)
+	conn.Send("HINCRBY", throughputKey, now, 1)
+	conn.Send("EXPIRE", throughputKey, int64(throughputRetention/time.Second)+60)
 	fate(conn)
 	if _, err := conn.Do("EXEC"); err != nil {
 		logError("worker.remove_job_from_in_progress.lrem", err)
 	}
 }
 
+// consumeJob takes an AtMostOnce job off the in-progress list before its handler runs, so that it's never recovered
+// and run again. If that fails, the handler isn't run and the job fails with the error, still in progress.
+func (w *worker) consumeJob(job *Job) error {
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("LREM", job.inProgQueue, 1, job.rawJSON); err != nil {
+		logError("worker.consume_job.lrem", err)
+		return fmt.Errorf("marking the job consumed: %v", err)
+	}
+	return nil
+}
+
 type terminateOp func(conn redis.Conn)
 
 func terminateOnly(_ redis.Conn) { return }
 func terminateAndRetry(w *worker, jt *jobType, job *Job) terminateOp {
-	rawJSON, err := job.serialize()
+	if jt.StrictFIFO {
+		return terminateAndRetryFirst(w, jt, job)
+	}
+	retried := job
+	if jt.RetryQueue != "" {
+		// Copy it, since the original's name is still needed to release its lock
+		renamed := *job
+		renamed.Name = jt.RetryQueue
+		retried = &renamed
+	}
+	rawJSON, err := retried.serialize()
 	if err != nil {
 		logError("worker.terminate_and_retry.serialize", err)
 		return terminateOnly
 	}
 	return func(conn redis.Conn) {
-		conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+jt.calcBackoff(job), rawJSON)
+		now := nowEpochSeconds()
+		conn.Send("ZADD", redisKeyRetry(w.namespace), now+jt.calcBackoff(job, w.defaultBackoff), rawJSON)
+		if w.maxRetryJobs > 0 {
+			bury := ""
+			if w.retryEvictionPolicy == RetryEvictionBury {
+				bury = "1"
+			}
+			// EVAL rather than a redis.Script, since EVALSHA can't fall back to EVAL inside a MULTI
+			conn.Send("EVAL", redisLuaTrimRetryCmd, 2, redisKeyRetry(w.namespace), redisKeyDead(w.namespace), w.maxRetryJobs, now, bury, w.maxDeadJobs)
+		}
+	}
+}
+
+// terminateAndRetryFirst puts a StrictFIFO job back at the front of its queue, and pauses the queue for the job's backoff.
+func terminateAndRetryFirst(w *worker, jt *jobType, job *Job) terminateOp {
+	rawJSON, err := job.serialize()
+	if err != nil {
+		logError("worker.terminate_and_retry_first.serialize", err)
+		return terminateOnly
+	}
+	return func(conn redis.Conn) {
+		// Workers pop jobs off the right of their queues
+		conn.Send("RPUSH", redisKeyJobsGeneration(w.namespace, job.Name, job.Generation), rawJSON)
+		if backoff := jt.calcBackoff(job, w.defaultBackoff); backoff > 0 {
+			// NX, so that a pause that was already there isn't lifted when the backoff is over
+			conn.Send("SET", redisKeyJobsPaused(w.namespace, job.Name), "1", "EX", backoff, "NX")
+		}
 	}
 }
-func terminateAndDead(w *worker, job *Job) terminateOp {
+
+func terminateAndDead(w *worker, job *Job, reason DeadReason) terminateOp {
+	job.DeadReason = reason
 	rawJSON, err := job.serialize()
 	if err != nil {
 		logError("worker.terminate_and_dead.serialize", err)
 		return terminateOnly
 	}
+	deadKey := redisKeyDead(w.namespace)
+	var deadTTL time.Duration
+	if jt := w.jobTypes[job.Name]; jt != nil && jt.SeparateDeadSet {
+		deadKey = redisKeyJobsDead(w.namespace, job.Name)
+		deadTTL = jt.DeadTTL
+	}
+	return func(conn redis.Conn) {
+		now := nowEpochSeconds()
+		conn.Send("ZADD", deadKey, now, rawJSON)
+		if deadTTL > 0 {
+			// The set only holds this job, so its expired jobs can go by score alone
+			conn.Send("ZREMRANGEBYSCORE", deadKey, "-inf", now-int64(deadTTL/time.Second))
+		}
+		if w.maxDeadJobs > 0 {
+			// The dead set is scored by died-at, so the lowest ranks are the oldest
+			conn.Send("ZREMRANGEBYRANK", deadKey, 0, -w.maxDeadJobs-1)
+		}
+	}
+}
+
+func terminateAndRecordCompleted(w *worker, job *Job, duration time.Duration) terminateOp {
+	if err := job.LoadArgs(); err != nil {
+		logError("worker.terminate_and_record_completed.load_args", err)
+	}
+	rawJSON, err := json.Marshal(&CompletedJob{
+		Name:       job.Name,
+		ID:         job.ID,
+		DurationMS: duration.Milliseconds(),
+		FinishedAt: nowEpochSeconds(),
+		Args:       job.Args,
+	})
+	if err != nil {
+		logError("worker.terminate_and_record_completed.serialize", err)
+		return terminateOnly
+	}
 	return func(conn redis.Conn) {
-		// NOTE: sidekiq limits the # of jobs: only keep jobs for 6 months, and only keep a max # of jobs
-		// The max # of jobs seems really horrible. Seems like operations should be on top of it.
-		// conn.Send("ZREMRANGEBYSCORE", redisKeyDead(w.namespace), "-inf", now - keepInterval)
-		// conn.Send("ZREMRANGEBYRANK", redisKeyDead(w.namespace), 0, -maxJobs)
+		conn.Send("LPUSH", redisKeyCompleted(w.namespace), rawJSON)
+		conn.Send("LTRIM", redisKeyCompleted(w.namespace), 0, w.keepCompletedJobs-1)
+	}
+}
 
-		conn.Send("ZADD", redisKeyDead(w.namespace), nowEpochSeconds(), rawJSON)
+// countFailure adds counting err under its ErrorClassifier category to fate.
+func (w *worker) countFailure(fate terminateOp, err error) terminateOp {
+	category := defaultErrorCategory
+	if w.errorClassifier != nil {
+		if c := w.errorClassifier(err); c != "" {
+			category = c
+		}
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("HINCRBY", redisKeyFailureCategories(w.namespace), category, 1)
 	}
 }
 
-func (w *worker) jobFate(jt *jobType, job *Job) terminateOp {
+func (w *worker) jobFate(jt *jobType, job *Job, runErr error) terminateOp {
+	reason := DeadReasonNoHandler
 	if jt != nil {
-		failsRemaining := int64(jt.MaxFails) - job.Fails
-		if failsRemaining > 0 {
-			return terminateAndRetry(w, jt, job)
+		reason = DeadReasonMaxFails
+		retry := int64(jt.MaxFails)-job.Fails > 0
+		if job.retryDisabled {
+			reason, retry = DeadReasonFatal, false
+		} else if w.retryPolicy != nil {
+			var delay time.Duration
+			if retry, delay = w.retryPolicy(job, runErr); !retry {
+				reason = DeadReasonRetryPolicy
+			} else if delay > 0 && job.retryIn == 0 {
+				job.retryIn = delay
+			}
+		}
+		if retry {
+			if !job.pastDeadline() {
+				return terminateAndRetry(w, jt, job)
+			}
+			job.LastErr = fmt.Sprintf("%v: %s", errDeadlineExceeded, job.LastErr)
+			reason = DeadReasonDeadline
 		}
 		if jt.SkipDead {
-			return terminateOnly
+			return w.followUp(terminateOnly, job.OnFailure)
 		}
 	}
-	return terminateAndDead(w, job)
+	return w.followUp(terminateAndDead(w, job, reason), job.OnFailure)
+}
+
+// errDeadlineExceeded is the error jobs are buried with when they miss their deadline.
+var errDeadlineExceeded = errors.New("deadline exceeded")
+
+// followUp adds enqueueing f, if there is one, to fate.
+func (w *worker) followUp(fate terminateOp, f *FollowUp) terminateOp {
+	if f == nil {
+		return fate
+	}
+	rawJSON, err := (&Job{
+		Name:       f.Name,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       f.Args,
+		Generation: w.generation,
+	}).serialize()
+	if err != nil {
+		logError("worker.follow_up.serialize", err)
+		return fate
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("LPUSH", redisKeyJobsGeneration(w.namespace, f.Name, w.generation), rawJSON)
+		conn.Send("SADD", redisKeyKnownJobs(w.namespace), f.Name)
+	}
+}
+
+// publishCompletion adds publishing job's CompletionEvent to fate, in pools with WorkerPoolOptions.PublishCompletions.
+func (w *worker) publishCompletion(fate terminateOp, job *Job, runErr error) terminateOp {
+	if !w.publishCompletions {
+		return fate
+	}
+	event := CompletionEvent{JobName: job.Name, JobID: job.ID, Result: CompletionSucceeded, Fails: job.Fails, At: nowEpochSeconds()}
+	if runErr != nil {
+		event.Result = CompletionFailed
+		event.Err = runErr.Error()
+	}
+	rawJSON, err := json.Marshal(event)
+	if err != nil {
+		logError("worker.publish_completion.marshal", err)
+		return fate
+	}
+	return func(conn redis.Conn) {
+		fate(conn)
+		conn.Send("PUBLISH", redisKeyCompletions(w.namespace), rawJSON)
+	}
 }
 
 // Default algorithm returns an fastly increasing backoff counter which grows in an unbounded fashion
@@ -1,13 +1,16 @@
 package work
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
-	"github.com/robfig/cron/v3"
 )
 
 // WorkerPool represents a pool of workers. It forms the primary API of opendoor-labs/work. WorkerPools provide the public API of opendoor-labs/work. You can attach jobs and middlware to them. You can start and stop them. Based on their concurrency setting, they'll spin up N worker goroutines.
@@ -24,12 +27,36 @@ type WorkerPool struct {
 	started      bool
 	periodicJobs []*periodicJob
 
+	maxRequeuesPerMinute int
+	reaperInterval       time.Duration
+	maxDeadJobs          int64
+	maxRetryJobs         int64
+	retryEvictionPolicy  RetryEvictionPolicy
+	priorityBands        []uint
+	priorityAging        float64
+	panicHandler         PanicHandler
+	errorClassifier      ErrorClassifier
+	retryPolicy          RetryPolicy
+	defaultJobOptions    JobOptions
+	defaultBackoff       BackoffCalculator
+	disableRequeuers     bool
+	disableReaper        bool
+	explicitID           string // WorkerPoolOptions.WorkerPoolID, which Start claims, or a suffixed version of it
+	duplicateIDPolicy    DuplicateIDPolicy
+	argsCtxExtractor     ArgsContextExtractor
+
+	// jobCtx is passed to JobWithContext handlers, and is cancelled on Drain and Stop
+	jobCtxMtx    sync.Mutex
+	jobCtx       context.Context
+	cancelJobCtx context.CancelFunc
+
 	workers          []*worker
 	heartbeater      *workerPoolHeartbeater
 	retrier          *requeuer
 	scheduler        *requeuer
 	deadPoolReaper   *deadPoolReaper
 	periodicEnqueuer *periodicEnqueuer
+	inFlightWatcher  *inFlightWatcher // only for pools with a MaxInFlight job
 }
 
 type jobType struct {
@@ -39,13 +66,40 @@ type jobType struct {
 	IsGeneric      bool
 	GenericHandler GenericHandler
 	DynamicHandler reflect.Value
+
+	inFlight *inFlightJobs // with MaxInFlight, the jobs the pool's workers are running
 }
 
-func (jt *jobType) calcBackoff(j *Job) int64 {
-	if jt.Backoff == nil {
-		return defaultBackoffCalculator(j)
+// calcBackoff uses the job's own Backoff if it has one, then poolDefault, then the builtin algorithm.
+func (jt *jobType) calcBackoff(j *Job, poolDefault BackoffCalculator) int64 {
+	if j.retryIn > 0 {
+		return int64((j.retryIn + time.Second - 1) / time.Second)
+	}
+	if jt.Backoff != nil {
+		return jt.Backoff(j)
+	}
+	if poolDefault != nil {
+		return poolDefault(j)
+	}
+	return defaultBackoffCalculator(j)
+}
+
+// sampleWeight is how heavily the job's queue is favored when workers pick which queue to fetch from next.
+func (jt *jobType) sampleWeight() uint {
+	if jt.Weight == 0 {
+		return jt.Priority
+	}
+	return jt.Priority * jt.Weight
+}
+
+func (jt *jobType) validateArgs(j *Job) error {
+	if jt.Validate == nil {
+		return nil
+	}
+	if err := j.LoadArgs(); err != nil {
+		return err
 	}
-	return jt.Backoff(j)
+	return jt.Validate(j.Args)
 }
 
 // You may provide your own backoff function for retrying failed jobs or use the builtin one.
@@ -54,23 +108,174 @@ func (jt *jobType) calcBackoff(j *Job) int64 {
 // The builtin backoff calculator provides an exponentially increasing wait function.
 type BackoffCalculator func(job *Job) int64
 
+// PanicHandler is called when a job's handler or middleware panics, with the value passed to panic and the stack of
+// the panicking goroutine. It runs before the job is failed as usual, so it can't change the job's fate.
+type PanicHandler func(job *Job, recovered interface{}, stack []byte)
+
+// ArgsValidator checks a job's args before its handler runs. A non-nil error sends the job straight to the dead queue.
+type ArgsValidator func(args map[string]interface{}) error
+
 // JobOptions can be passed to JobWithOptions.
 type JobOptions struct {
 	Priority       uint              // Priority from 1 to 10000
 	MaxFails       uint              // 1: send straight to dead (unless SkipDead)
 	SkipDead       bool              // If true, don't send failed jobs to the dead queue when retries are exhausted.
 	MaxConcurrency uint              // Max number of jobs to keep in flight (default is 0, meaning no max)
-	Backoff        BackoffCalculator // If not set, uses the default backoff algorithm
+	Backoff        BackoffCalculator // If not set, uses the pool's default backoff (see SetDefaultBackoff) or the builtin algorithm
+	Validate       ArgsValidator     // If set, runs before the handler; failing jobs bypass retries and go to dead
+	Weight         uint              // Weight from 1 to 100 (default 1). Multiplies Priority when picking which queue to fetch from, to favor a job over others of equal priority
+
+	// RetryQueue, if set, is the job name that failed jobs are retried as, instead of their own. The failed job is
+	// renamed and put on the retry queue with the usual backoff; when it's due, it's moved to RetryQueue's work queue and
+	// run by whatever handler is registered for RetryQueue, in this pool or another. Fails keep counting from the
+	// original job, and from then on RetryQueue's own JobOptions decide further retries.
+	RetryQueue string
+
+	// SeparateDeadSet buries the job's dead jobs in a dead set of its own instead of the one shared by all jobs, so a
+	// job that dies a lot doesn't crowd out the others. See them with Client.DeadJobsForJob.
+	SeparateDeadSet bool
+
+	// SerializeByArg names an arg whose values are processed one at a time: a job whose arg has the same value as a
+	// running job's is put on the back of its queue, without counting as a failure, so that it runs after that one is
+	// done while the worker moves on to jobs with other values. Jobs without the arg aren't held back.
+	SerializeByArg string
+
+	// SlowThreshold, if set, logs a warning with the job's name, ID and duration whenever its handler takes longer than
+	// this to return. The job is unaffected.
+	SlowThreshold time.Duration
+
+	// MaxInFlight, if set, is the longest a job may be in progress before the pool takes it back, as a safety net for
+	// handlers that hang and ignore their context. The job is failed with an error saying so, and retried or buried
+	// as usual; whatever its handler returns when it does return is ignored. It's checked about once a second, against
+	// the time the job was claimed. Keep it well above how long the job normally takes, since a job that's taken back
+	// can end up running twice at once.
+	MaxInFlight time.Duration
+
+	// StrictFIFO runs the job's queue strictly in the order jobs reach it. Jobs run one at a time across every pool,
+	// as if MaxConcurrency were 1, and a job that fails and has retries left goes back to the front of the queue
+	// rather than to the retry set: the queue is paused until the job's backoff has passed, so the web UI shows it as
+	// paused meanwhile, and then the job is run again before anything behind it. A job that dies lets the next one
+	// run. The price is throughput, since one slow or failing job holds up the whole queue. Every pool that registers
+	// the job must set it, and it can't be combined with a MaxConcurrency above 1 or a RetryQueue. Jobs recovered
+	// from a pool that died go to the back of the queue. Jobs taken back because of MaxInFlight fail like any other,
+	// so they go back to the front and pause the queue too.
+	StrictFIFO bool
+
+	// DeadTTL, if set, is how long the job's dead jobs are kept, counted from when each was buried. Expired ones are
+	// deleted by the dead pool reaper each time it runs, every 10 minutes by default (see SetReaperInterval), so
+	// they can outlive it by that much; pools with DisableDeadPoolReaper don't delete them. With SeparateDeadSet,
+	// they're also deleted whenever another is buried. To give every job on a pool a retention, pass it to
+	// SetDefaultJobOptions: a job's own DeadTTL wins. SetMaxDeadJobs still caps the dead set on top of it. It must be
+	// at least a second.
+	DeadTTL time.Duration
+
+	// AtMostOnce runs the job at most once, for jobs like charging a card where running twice is worse than not
+	// running at all. By default a job stays in its pool's in-progress list until its handler returns, so if the
+	// process dies mid-job the job is recovered and run again (at least once). With AtMostOnce, the job is taken off
+	// the list just before its handler runs, so a crash, StopAndRequeue, or Client.RequeueInProgressByIDs loses it
+	// instead: nothing records that it didn't finish, and it's neither retried nor buried. Only use it for jobs that
+	// can be reconciled some other way. A handler that returns an error is still retried as usual, since it ran to
+	// the end; set MaxFails to 1 to bury it instead. It can't be combined with MaxInFlight, which re-runs jobs it takes
+	// back.
+	AtMostOnce bool
 }
 
+// DecodeErrorPolicy decides what a worker does with a job it fetched but couldn't decode.
+type DecodeErrorPolicy int
+
+const (
+	// DecodeErrorBury moves the job to the dead queue. Since the original payload can't be decoded, the dead job is
+	// a stand-in with a fresh ID whose "raw_json" arg holds the original payload.
+	DecodeErrorBury DecodeErrorPolicy = iota
+	// DecodeErrorDiscard drops the job entirely.
+	DecodeErrorDiscard
+)
+
+// DuplicateIDPolicy decides what Start does when a pool given WorkerPoolOptions.WorkerPoolID finds another live pool
+// already heartbeating under that ID.
+type DuplicateIDPolicy int
+
+const (
+	// DuplicateIDError doesn't start the pool: StartE returns ErrWorkerPoolIDInUse, and Start logs it. A process
+	// started with a copied config fails rather than clobbering the other pool's heartbeat and in-progress jobs, and a
+	// process restarted right after crashing can try again once its predecessor's heartbeat goes stale.
+	DuplicateIDError DuplicateIDPolicy = iota
+	// DuplicateIDSuffix appends a random suffix to the ID, logs a warning, and starts under that.
+	DuplicateIDSuffix
+)
+
+// ErrWorkerPoolIDInUse is returned by StartE when another live pool is heartbeating under the pool's
+// WorkerPoolOptions.WorkerPoolID, or under every suffixed ID tried with DuplicateIDSuffix.
+var ErrWorkerPoolIDInUse = fmt.Errorf("worker pool ID is in use by another live pool")
+
 // WorkerPoolOptions can be passed to NewWorkerPoolWithOptions.
 type WorkerPoolOptions struct {
-	SleepBackoffs []int64 // Sleep backoffs in milliseconds
+	SleepBackoffs     []int64           // Sleep backoffs in milliseconds
+	KeepCompletedJobs int64             // If > 0, record this many of the most recently completed jobs for Client.RecentCompleted
+	DecodeErrorPolicy DecodeErrorPolicy // What to do with jobs that fail to decode. Defaults to DecodeErrorBury
+
+	// ConsolidateInProgress keeps the jobs the pool is working on in one list for the whole pool, rather than one list
+	// per job type. Large fleets end up with far fewer keys this way. The tradeoffs: finishing a job has to search
+	// every job the pool has in flight instead of just those of its type, and when the pool dies the reaper has to
+	// decode each job to find its queue. Jobs are still recovered per pool either way.
+	ConsolidateInProgress bool
+
+	// DisableRequeuers stops the pool from moving due jobs off the retry and scheduled sets onto their queues, and
+	// DisableDeadPoolReaper stops it from recovering the in-progress jobs of pools that died. Set both for pools that
+	// only register handlers or back a UI, so that recovery is left to the pools that actually work jobs. Something
+	// in the namespace still has to run them, or scheduled jobs, retries, and the jobs of crashed pools will sit
+	// there. Either way, a pool requeues its own in-progress jobs when it's stopped.
+	DisableRequeuers      bool
+	DisableDeadPoolReaper bool
+
+	// LazyArgs leaves each job's args as JSON when it's fetched, for jobs with large args that middleware and handlers
+	// mostly don't need all of. Job.ArgString and the other Arg methods decode just the arg asked for, and Job.LoadArgs
+	// decodes the lot: until it's called, Job.Args is nil. The pool decodes them itself for Validate and
+	// ArgsContextExtractor, and retried and dead jobs keep their args either way.
+	LazyArgs bool
+
+	// PublishCompletions publishes a CompletionEvent to a Redis pub/sub channel for the namespace every time one of
+	// the pool's jobs finishes, successfully or not, for Client.SubscribeCompletions. It costs a PUBLISH per job.
+	PublishCompletions bool
+
+	// WorkerPoolID, if set, is the pool's ID in place of a random one, eg to give each process a stable ID to
+	// AdoptInProgress from after a restart. Start checks that no other pool is heartbeating under it, and applies
+	// DuplicateIDPolicy if one is. A pool that crashed, rather than being stopped, counts as live until its
+	// heartbeat goes stale, about 10 seconds. With the default DuplicateIDError, Start then only logs and the pool
+	// doesn't run, so a process restarted that quickly would sit idle: pools with a WorkerPoolID should be started
+	// with StartE, and retry or exit on its error, or check Started after Start.
+	WorkerPoolID      string
+	DuplicateIDPolicy DuplicateIDPolicy
+
+	// Generation makes the pool work only the jobs enqueued with the same EnqueuerOption.Generation, and the jobs it
+	// enqueues as follow-ups get it too. A pool without one works only jobs enqueued without one. Periodic jobs are
+	// enqueued without a generation. Client methods that count or list queued jobs, and the web UI's queue sizes,
+	// only see the jobs without a generation; the retry, scheduled, and dead sets hold jobs of every generation.
+	Generation string
 }
 
 // GenericHandler is a job handler without any custom context.
 type GenericHandler func(*Job) error
 
+// ContextHandler is a job handler that's given a context.Context, which is cancelled when the pool is drained or stopped.
+type ContextHandler func(context.Context, *Job) error
+
+// ArgsContextExtractor rebuilds a context from a job's args, such as one carrying the trace and baggage an Enqueuer's
+// ContextInjector put there. It can return nil if there's nothing to rebuild, which means context.Background().
+type ArgsContextExtractor func(args map[string]interface{}) context.Context
+
+// ErrorClassifier names the category of a job's error, such as "timeout" or "connection refused", so failures can be
+// counted by cause with Client.FailureCategories.
+type ErrorClassifier func(err error) string
+
+// RetryPolicy decides whether a job whose handler returned err is retried, and after how long. The job's Fails already
+// counts this failure. A delay of zero leaves it to the job's backoff. A delay the handler asked for with Job.RetryIn
+// takes precedence over the policy's.
+type RetryPolicy func(job *Job, err error) (retry bool, delay time.Duration)
+
+// defaultErrorCategory is what failures are counted as without an ErrorClassifier, or when it returns "".
+const defaultErrorCategory = "error"
+
 // GenericMiddlewareHandler is a middleware without any custom context.
 type GenericMiddlewareHandler func(*Job, NextMiddlewareFunc) error
 
@@ -98,18 +303,34 @@ func NewWorkerPoolWithOptions(ctx interface{}, concurrency uint, namespace strin
 
 	ctxType := reflect.TypeOf(ctx)
 	validateContextType(ctxType)
+	validateGeneration(workerPoolOpts.Generation)
 	wp := &WorkerPool{
-		workerPoolID:  makeIdentifier(),
+		workerPoolID:  workerPoolOpts.WorkerPoolID,
 		concurrency:   concurrency,
 		namespace:     namespace,
 		pool:          pool,
 		sleepBackoffs: workerPoolOpts.SleepBackoffs,
 		contextType:   ctxType,
 		jobTypes:      make(map[string]*jobType),
+
+		disableRequeuers: workerPoolOpts.DisableRequeuers,
+		disableReaper:    workerPoolOpts.DisableDeadPoolReaper,
+
+		explicitID:        workerPoolOpts.WorkerPoolID,
+		duplicateIDPolicy: workerPoolOpts.DuplicateIDPolicy,
+	}
+	if wp.workerPoolID == "" {
+		wp.workerPoolID = makeIdentifier()
 	}
 
 	for i := uint(0); i < wp.concurrency; i++ {
 		w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, nil, wp.jobTypes, wp.sleepBackoffs)
+		w.keepCompletedJobs = workerPoolOpts.KeepCompletedJobs
+		w.decodeErrorPolicy = workerPoolOpts.DecodeErrorPolicy
+		w.consolidateInProgress = workerPoolOpts.ConsolidateInProgress
+		w.lazyArgs = workerPoolOpts.LazyArgs
+		w.publishCompletions = workerPoolOpts.PublishCompletions
+		w.generation = workerPoolOpts.Generation
 		wp.workers = append(wp.workers, w)
 	}
 
@@ -141,6 +362,273 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 	return wp
 }
 
+// SetMaxRequeuesPerMinute caps how many times per minute jobs of any one name are moved from the retry queue back onto
+// their work queue by this pool. Once a job name hits the cap, its remaining retries are held until the next minute
+// instead of hot-looping through Redis. 0 (the default) means no cap. It must be called before Start.
+func (wp *WorkerPool) SetMaxRequeuesPerMinute(n int) *WorkerPool {
+	wp.maxRequeuesPerMinute = n
+	return wp
+}
+
+// SetReaperInterval sets how often the pool's dead pool reaper looks for pools that have stopped heartbeating, in place
+// of every 10 minutes. Each pool adds up to a twentieth of d at random to every wait, so that a fleet's reapers don't
+// all scan Redis at the same moment. A pool that dies is still reaped at most 1.05 x d after its heartbeat goes stale.
+// It must be called before Start.
+func (wp *WorkerPool) SetReaperInterval(d time.Duration) *WorkerPool {
+	if d <= 0 {
+		panic("work: reaper interval must be positive")
+	}
+	wp.reaperInterval = d
+	return wp
+}
+
+// SetMaxDeadJobs caps the dead set at n jobs, to bound the memory it uses. When burying a job takes it past the cap,
+// the jobs that died longest ago are evicted. There's no cap by default, and n <= 0 removes it. Jobs already in the
+// dead set are only trimmed the next time a job is buried.
+func (wp *WorkerPool) SetMaxDeadJobs(n int64) *WorkerPool {
+	wp.maxDeadJobs = n
+
+	for _, w := range wp.workers {
+		w.maxDeadJobs = wp.maxDeadJobs
+	}
+
+	return wp
+}
+
+// RetryEvictionPolicy decides what happens to jobs evicted from the retry set by SetMaxRetryJobs.
+type RetryEvictionPolicy int
+
+const (
+	// RetryEvictionBury moves evicted jobs to the dead queue, with DeadReasonEvicted, where they can be retried by hand.
+	RetryEvictionBury RetryEvictionPolicy = iota
+	// RetryEvictionDiscard drops evicted jobs entirely.
+	RetryEvictionDiscard
+)
+
+// SetMaxRetryJobs caps the retry set at n jobs, so that many jobs failing at once can't fill Redis's memory. When
+// retrying a job takes the set past the cap, the jobs due to be retried last are evicted first; with exponential
+// backoff, they're mostly the ones that have failed the most. The job just added is evicted too if it's due last.
+// Evicted jobs are buried unless SetRetryEvictionPolicy says otherwise, and always in the shared dead set, even with
+// SeparateDeadSet. There's no cap by default, and n <= 0 removes it.
+func (wp *WorkerPool) SetMaxRetryJobs(n int64) *WorkerPool {
+	wp.maxRetryJobs = n
+
+	for _, w := range wp.workers {
+		w.maxRetryJobs = wp.maxRetryJobs
+	}
+
+	return wp
+}
+
+// SetRetryEvictionPolicy sets what happens to jobs evicted from the retry set by SetMaxRetryJobs. The default is
+// RetryEvictionBury.
+func (wp *WorkerPool) SetRetryEvictionPolicy(p RetryEvictionPolicy) *WorkerPool {
+	wp.retryEvictionPolicy = p
+
+	for _, w := range wp.workers {
+		w.retryEvictionPolicy = wp.retryEvictionPolicy
+	}
+
+	return wp
+}
+
+// SetPriorityBands groups job priorities into bands that workers fetch from in strict order. Each value is the lowest
+// priority in its band: with bands []int{100, 10}, jobs of priority 100 and up are always fetched before jobs of priority
+// 10 to 99, which are always fetched before jobs below 10. Within a band, queues are picked at random weighted by
+// Priority (times Weight), so a lower priority job in a band still gets a share of the workers.
+//
+// By default there are no bands: every queue is picked at random weighted by its priority, so a job of priority 10 is
+// fetched from about ten times as often as one of priority 1 but is never guaranteed to go first.
+func (wp *WorkerPool) SetPriorityBands(bands []int) *WorkerPool {
+	sorted := make([]uint, 0, len(bands))
+	for _, b := range bands {
+		if b < 1 {
+			panic("work: priority bands must be at least 1")
+		}
+		sorted = append(sorted, uint(b))
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	wp.priorityBands = sorted
+
+	for _, w := range wp.workers {
+		w.priorityBands = wp.priorityBands
+		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+	}
+
+	return wp
+}
+
+// SetMaxConcurrentExecution limits how many of the pool's workers run a handler at once to n, for jobs that use a lot
+// of memory while they run. The other workers still fetch jobs, and wait with them in progress until one of the n is
+// free, so a job is started as soon as there's room rather than after a round trip to Redis. Jobs that are waiting
+// count towards MaxConcurrency and show in Client.InProgressJobs, but not as busy workers. n of 0, the default, or the
+// pool's concurrency or more, lets every worker run at once. It must be called before Start.
+func (wp *WorkerPool) SetMaxConcurrentExecution(n int) *WorkerPool {
+	if n < 0 {
+		panic("work: max concurrent execution must not be negative")
+	}
+
+	var slots chan struct{}
+	if n > 0 && n < int(wp.concurrency) {
+		slots = make(chan struct{}, n)
+	}
+	for _, w := range wp.workers {
+		w.executionSlots = slots
+	}
+
+	return wp
+}
+
+// SetFetchConcurrency limits how many of the pool's workers fetch jobs from Redis at once to n. Idle workers poll
+// Redis for jobs, so a pool with many of them can send a small Redis more commands than it can keep up with; with a
+// limit, the others wait their turn instead. Workers running jobs aren't affected. n of 0, the default, or the pool's
+// concurrency or more, lets every worker fetch at once. It must be called before Start.
+func (wp *WorkerPool) SetFetchConcurrency(n int) *WorkerPool {
+	if n < 0 {
+		panic("work: fetch concurrency must not be negative")
+	}
+
+	var slots chan struct{}
+	if n > 0 && n < int(wp.concurrency) {
+		slots = make(chan struct{}, n)
+	}
+	for _, w := range wp.workers {
+		w.fetchSlots = slots
+	}
+
+	return wp
+}
+
+// SetObserverFlushInterval sets how often workers write what they're doing, including Job.Checkin messages, to Redis
+// for the web UI and Client.WorkerObservations. The default is a second. Shorter intervals make the web UI fresher at
+// the cost of a Redis write per busy worker per interval; d must be at least 10ms. It must be called before Start.
+func (wp *WorkerPool) SetObserverFlushInterval(d time.Duration) *WorkerPool {
+	if d < minObserverFlushInterval {
+		panic(fmt.Sprintf("work: observer flush interval must be at least %v", minObserverFlushInterval))
+	}
+
+	for _, w := range wp.workers {
+		w.observer.flushInterval = d
+	}
+
+	return wp
+}
+
+// SetPriorityAging keeps low priority jobs from starving behind a steady stream of higher priority ones. Each queue's
+// priority is raised by rate for every second its next job has been waiting, which makes it more likely to be fetched
+// from and, with SetPriorityBands, eventually moves it up into the higher bands. With a rate of 0.1, a priority 1 job
+// that has waited 90 seconds is fetched like a priority 10 one. Workers check how long jobs have waited about once a
+// second. A rate of 0, the default, turns aging off.
+func (wp *WorkerPool) SetPriorityAging(rate float64) *WorkerPool {
+	if rate < 0 {
+		panic("work: priority aging rate must not be negative")
+	}
+	wp.priorityAging = rate
+
+	for _, w := range wp.workers {
+		w.priorityAging = wp.priorityAging
+		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+	}
+
+	return wp
+}
+
+// SetPanicHandler sets a function to call when a job panics, eg to report the panic and its stack to an error tracker.
+// The job is still failed and retried as usual afterwards. A panic in panicHandler is recovered and logged.
+func (wp *WorkerPool) SetPanicHandler(panicHandler PanicHandler) *WorkerPool {
+	wp.panicHandler = panicHandler
+
+	for _, w := range wp.workers {
+		w.panicHandler = wp.panicHandler
+	}
+
+	return wp
+}
+
+// OnQueueActive sets a function to call with a job's name when a worker finds jobs in its queue after finding it empty,
+// eg to scale up workers as soon as there's work. Queues are taken to start out empty, so the first job fetched from
+// each calls it too. It's best effort: workers only notice an empty queue when they find nothing to fetch at all, and
+// it's called at most once a second per job. fn runs on a goroutine of its own, so it doesn't hold up the worker. It
+// must be called before Start.
+func (wp *WorkerPool) OnQueueActive(fn func(jobName string)) *WorkerPool {
+	activity := newQueueActivity(fn)
+	for _, w := range wp.workers {
+		w.queueActivity = activity
+	}
+
+	return wp
+}
+
+// SetReadyCheck holds the pool's workers back from fetching jobs after Start until check returns true, eg until the
+// connections its handlers need are warmed up, so that the first jobs don't fail and retry needlessly. check is
+// called every 100ms until it first returns true, and never again after that. The pool still heartbeats, and its
+// requeuers and periodic enqueuer run, while it waits, so it's visible in the web UI; Drain waits for it too. It must
+// be called before Start.
+func (wp *WorkerPool) SetReadyCheck(check func() bool) *WorkerPool {
+	gate := &readyGate{check: check}
+	for _, w := range wp.workers {
+		w.readyGate = gate
+	}
+
+	return wp
+}
+
+// SetErrorClassifier sets the function that picks the category each failed job is counted under. Without one, every
+// failure is counted as "error".
+func (wp *WorkerPool) SetErrorClassifier(classifier ErrorClassifier) *WorkerPool {
+	wp.errorClassifier = classifier
+
+	for _, w := range wp.workers {
+		w.errorClassifier = wp.errorClassifier
+	}
+
+	return wp
+}
+
+// SetRetryPolicy sets the function that decides whether each failed job is retried, in place of its MaxFails, so that
+// the whole pool's policy can live in one place, eg to retry timeouts up to 10 times but never retry validation errors.
+// Jobs it doesn't retry are buried with DeadReasonRetryPolicy (or dropped, with SkipDead). A job that called
+// Job.DisableRetry, or that's past its deadline, isn't retried whatever it returns, and one that called Job.RetryIn
+// keeps its own delay.
+func (wp *WorkerPool) SetRetryPolicy(policy RetryPolicy) *WorkerPool {
+	wp.retryPolicy = policy
+
+	for _, w := range wp.workers {
+		w.retryPolicy = wp.retryPolicy
+	}
+
+	return wp
+}
+
+// SetDefaultJobOptions sets options for every job registered on the pool afterwards, so that jobs don't each have to
+// repeat them. Options given to JobWithOptions take precedence: defaults only fill in the fields left at their zero
+// value. Fields left unset by both fall back to the package defaults, eg a MaxFails of 4. Jobs that are already
+// registered aren't affected, so call this before Job and JobWithOptions.
+func (wp *WorkerPool) SetDefaultJobOptions(jobOpts JobOptions) *WorkerPool {
+	wp.defaultJobOptions = jobOpts
+	return wp
+}
+
+// SetDefaultBackoff sets the backoff used by every job on the pool that doesn't have its own JobOptions.Backoff
+// (including one given through SetDefaultJobOptions), replacing the builtin algorithm. ConstantBackoff, LinearBackoff
+// and ExponentialBackoff cover the common cases.
+func (wp *WorkerPool) SetDefaultBackoff(backoff BackoffCalculator) *WorkerPool {
+	wp.defaultBackoff = backoff
+
+	for _, w := range wp.workers {
+		w.defaultBackoff = wp.defaultBackoff
+	}
+
+	return wp
+}
+
+// SetArgsContextExtractor sets a function that builds the context given to JobWithContext handlers from each job's
+// args. The extracted context is still cancelled when the pool drains or stops. Call it before Start.
+func (wp *WorkerPool) SetArgsContextExtractor(extractor ArgsContextExtractor) *WorkerPool {
+	wp.argsCtxExtractor = extractor
+	return wp
+}
+
 // Job registers the job name to the specified handler fn. For instance, when workers pull jobs from the name queue they'll be processed by the specified handler function.
 // fn can take one of these forms:
 // (*ContextType).func(*Job) error, (ContextType matches the type of ctx specified when creating a pool)
@@ -149,10 +637,37 @@ func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 	return wp.JobWithOptions(name, JobOptions{}, fn)
 }
 
+// JobWithContext registers fn for 'name' jobs like Job does, but fn is also given a context that's cancelled when the
+// pool starts draining or stopping. Long running handlers can watch it to wind down early instead of holding up
+// shutdown; what they return is handled as usual, so returning ctx.Err() fails the job and it's retried later.
+// Handlers registered with Job and JobWithOptions aren't affected and always run to completion.
+func (wp *WorkerPool) JobWithContext(name string, jobOpts JobOptions, fn ContextHandler) *WorkerPool {
+	return wp.JobWithOptions(name, jobOpts, func(job *Job) error {
+		if wp.argsCtxExtractor == nil {
+			return fn(wp.jobContext(), job)
+		}
+
+		job.LoadArgs()
+		extracted := wp.argsCtxExtractor(job.Args)
+		if extracted == nil {
+			extracted = context.Background()
+		}
+		ctx, cancel := context.WithCancel(extracted)
+		defer cancel()
+		stop := context.AfterFunc(wp.jobContext(), cancel)
+		defer stop()
+
+		return fn(ctx, job)
+	})
+}
+
 // JobWithOptions adds a handler for 'name' jobs as per the Job function, but permits you specify additional options
 // such as a job's priority, retry count, and whether to send dead jobs to the dead job queue or trash them.
 func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interface{}) *WorkerPool {
-	jobOpts = applyDefaultsAndValidate(jobOpts)
+	if err := validateJobName(name); err != nil {
+		panic(err.Error())
+	}
+	jobOpts = applyDefaultsAndValidate(mergeJobOptions(jobOpts, wp.defaultJobOptions))
 
 	vfn := reflect.ValueOf(fn)
 	validateHandlerType(wp.contextType, vfn)
@@ -165,6 +680,9 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 		jt.IsGeneric = true
 		jt.GenericHandler = gh
 	}
+	if jobOpts.MaxInFlight > 0 {
+		jt.inFlight = newInFlightJobs()
+	}
 
 	wp.jobTypes[name] = jt
 
@@ -180,9 +698,7 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 // Note that the first value is the seconds!
 // If you have multiple worker pools on different machines, they'll all coordinate and only enqueue your job once.
 func (wp *WorkerPool) PeriodicallyEnqueue(spec string, jobName string) *WorkerPool {
-	p := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
-
-	schedule, err := p.Parse(spec)
+	schedule, err := cronParser.Parse(spec)
 	if err != nil {
 		panic(err)
 	}
@@ -197,16 +713,33 @@ func (wp *WorkerPool) Started() bool {
 	return wp.started
 }
 
-// Start starts the workers and associated processes.
+// Start starts the workers and associated processes. If the pool can't start, because of WorkerPoolOptions.WorkerPoolID,
+// it only logs why and Started stays false, so pools with a WorkerPoolID should use StartE to get the error instead.
 func (wp *WorkerPool) Start() {
+	if err := wp.StartE(); err != nil {
+		logError("worker_pool.start", err)
+	}
+}
+
+// StartE is Start, but returns an error instead of logging it. The only error is ErrWorkerPoolIDInUse, wrapped, for
+// pools with WorkerPoolOptions.WorkerPoolID; pools with random IDs always start.
+func (wp *WorkerPool) StartE() error {
 	if wp.started {
-		return
+		return nil
+	}
+	if wp.explicitID != "" {
+		if err := wp.claimWorkerPoolID(); err != nil {
+			return err
+		}
 	}
 	wp.started = true
+	wp.resetJobContext()
 
 	// TODO: we should cleanup stale keys on startup from previously registered jobs
 	wp.writeConcurrencyControlsToRedis()
-	go wp.writeKnownJobsToRedis()
+	if err := wp.RegisterJobs(); err != nil {
+		logError("write_known_jobs", err)
+	}
 
 	for _, w := range wp.workers {
 		go w.start()
@@ -215,16 +748,85 @@ func (wp *WorkerPool) Start() {
 	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs())
 	wp.heartbeater.start()
 	wp.startRequeuers()
+	wp.startPeriodicEnqueuer()
+	wp.startInFlightWatcher()
+	return nil
+}
+
+// duplicateIDSuffixAttempts is how many suffixed IDs claimWorkerPoolID tries before giving up.
+const duplicateIDSuffixAttempts = 5
+
+// claimWorkerPoolID makes sure no other live pool has the pool's explicit ID, claiming it with a first heartbeat so
+// that two pools started at once can't both get it. It starts from the explicit ID every time, so that a pool that was
+// suffixed and then restarted doesn't pile on another suffix.
+func (wp *WorkerPool) claimWorkerPoolID() error {
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	script := redis.NewScript(1, redisLuaClaimWorkerPoolID)
+	id := wp.explicitID
+	for i := 0; i <= duplicateIDSuffixAttempts; i++ {
+		claimed, err := redis.Bool(script.Do(conn, redisKeyHeartbeat(wp.namespace, id), nowEpochSeconds(), int64(deadTime/time.Second)))
+		if err != nil {
+			// Redis being unavailable is no reason not to start; the heartbeater will keep trying
+			logError("worker_pool.claim_worker_pool_id", err)
+			claimed = true
+		}
+		if claimed {
+			if id != wp.explicitID {
+				logWarning("worker_pool.duplicate_id", fmt.Sprintf("worker pool ID %s is in use, starting as %s", wp.explicitID, id))
+			}
+			if id != wp.workerPoolID {
+				wp.setWorkerPoolID(id)
+			}
+			return nil
+		}
+		if wp.duplicateIDPolicy != DuplicateIDSuffix {
+			return fmt.Errorf("%w: %s", ErrWorkerPoolIDInUse, id)
+		}
+		id = wp.explicitID + "-" + makeIdentifier()[:6]
+	}
+	return fmt.Errorf("%w: no free ID starting with %s", ErrWorkerPoolIDInUse, wp.explicitID)
+}
+
+func (wp *WorkerPool) setWorkerPoolID(id string) {
+	wp.workerPoolID = id
+	for _, w := range wp.workers {
+		w.poolID = id
+		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+	}
+}
+
+func (wp *WorkerPool) startInFlightWatcher() {
+	var jobTypes []*jobType
+	for _, jt := range wp.jobTypes {
+		if jt.inFlight != nil {
+			jobTypes = append(jobTypes, jt)
+		}
+	}
+	if len(jobTypes) == 0 || len(wp.workers) == 0 {
+		wp.inFlightWatcher = nil
+		return
+	}
+	wp.inFlightWatcher = newInFlightWatcher(wp.namespace, wp.workerPoolID, wp.pool, jobTypes, wp.workers[0])
+	wp.inFlightWatcher.start()
+}
+
+func (wp *WorkerPool) startPeriodicEnqueuer() {
 	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.periodicJobs)
 	wp.periodicEnqueuer.start()
 }
 
-// Stop stops the workers and associated processes.
+// Stop stops the workers and associated processes. The pool stops enqueueing periodic jobs first, before it waits for
+// its workers to finish their jobs, so that a pool that's shutting down doesn't schedule work it won't be around for.
+// Periodic jobs are still enqueued by any other pools running with the same namespace.
 func (wp *WorkerPool) Stop() {
 	if !wp.started {
 		return
 	}
 	wp.started = false
+	wp.cancelJobContext()
+	wp.periodicEnqueuer.stop()
 
 	wg := sync.WaitGroup{}
 	for _, w := range wp.workers {
@@ -235,10 +837,56 @@ func (wp *WorkerPool) Stop() {
 		}(w)
 	}
 	wg.Wait()
+	wp.finishStopping()
+}
+
+// StopAndRequeue stops the pool like Stop does, but without waiting for the jobs its workers are running: they're put
+// straight back on their queues, for other pools to pick up without waiting for the dead pool reaper. Handlers
+// registered with JobWithContext see their context cancelled, so they can return early. Handlers that don't return
+// keep running until they're done, or the process exits, but whatever they return is ignored: the job isn't retried,
+// buried, or counted as done, and its follow-ups aren't enqueued.
+//
+// Jobs are delivered at least once either way, but this makes running a job twice more likely: a requeued job can be
+// picked up by another pool while its handler is still running here, and a job whose handler had already done its work
+// runs again. Only use it for jobs that are safe to repeat.
+func (wp *WorkerPool) StopAndRequeue() {
+	if !wp.started {
+		return
+	}
+	wp.started = false
+	wp.cancelJobContext()
+	wp.periodicEnqueuer.stop()
+
+	for _, w := range wp.workers {
+		w.abandon()
+		// The worker stops once its handler returns, which may be never
+		go w.stop()
+	}
+
+	if err := wp.deadPoolReaper.requeuePoolInProgressJobs(wp.workerPoolID, wp.jobNames()); err != nil {
+		logError("dead_pool_reaper.requeue_pool_in_progress_jobs", err)
+	}
+	wp.finishStopping()
+
+	conn := wp.pool.Get()
+	defer conn.Close()
+	if _, err := conn.Do("DEL", redisKeyPoolClaimedAt(wp.namespace, wp.workerPoolID)); err != nil {
+		logError("worker_pool.stop_and_requeue.claimed_at", err)
+	}
+}
+
+func (wp *WorkerPool) jobNames() []string {
 	jobTypes := make([]string, 0, len(wp.jobTypes))
 	for k := range wp.jobTypes {
 		jobTypes = append(jobTypes, k)
 	}
+	return jobTypes
+}
+
+// finishStopping requeues the jobs the pool's workers left in progress and stops its other processes, once its workers
+// are stopped.
+func (wp *WorkerPool) finishStopping() {
+	jobTypes := wp.jobNames()
 
 	err := wp.deadPoolReaper.requeueInProgressJobs(wp.workerPoolID, jobTypes)
 	if err != nil {
@@ -249,15 +897,29 @@ func (wp *WorkerPool) Stop() {
 	if err != nil {
 		logError("dead_pool_reaper.clean_stale_lock_info", err)
 	}
+	wp.removeWorkerObservations()
 	wp.heartbeater.stop()
-	wp.retrier.stop()
-	wp.scheduler.stop()
-	wp.deadPoolReaper.stop()
-	wp.periodicEnqueuer.stop()
+	if !wp.disableRequeuers {
+		wp.retrier.stop()
+		wp.scheduler.stop()
+	}
+	if !wp.disableReaper {
+		wp.deadPoolReaper.stop()
+	}
+	if wp.inFlightWatcher != nil {
+		wp.inFlightWatcher.stop()
+	}
 }
 
 // Drain drains all jobs in the queue before returning. Note that if jobs are added faster than we can process them, this function wouldn't return.
+// Handlers registered with JobWithContext see their context cancelled for the duration of the drain, and the pool
+// doesn't enqueue periodic jobs until the drain is done.
 func (wp *WorkerPool) Drain() {
+	wp.cancelJobContext()
+	if wp.started {
+		wp.periodicEnqueuer.stop()
+	}
+
 	wg := sync.WaitGroup{}
 	for _, w := range wp.workers {
 		wg.Add(1)
@@ -267,6 +929,152 @@ func (wp *WorkerPool) Drain() {
 		}(w)
 	}
 	wg.Wait()
+
+	if wp.started {
+		wp.resetJobContext()
+		wp.startPeriodicEnqueuer()
+	}
+}
+
+func (wp *WorkerPool) jobContext() context.Context {
+	wp.jobCtxMtx.Lock()
+	defer wp.jobCtxMtx.Unlock()
+	if wp.jobCtx == nil {
+		return context.Background()
+	}
+	return wp.jobCtx
+}
+
+func (wp *WorkerPool) resetJobContext() {
+	wp.jobCtxMtx.Lock()
+	defer wp.jobCtxMtx.Unlock()
+	wp.jobCtx, wp.cancelJobCtx = context.WithCancel(context.Background())
+}
+
+func (wp *WorkerPool) cancelJobContext() {
+	wp.jobCtxMtx.Lock()
+	defer wp.jobCtxMtx.Unlock()
+	if wp.cancelJobCtx != nil {
+		wp.cancelJobCtx()
+	}
+}
+
+// WorkerPoolState is what a worker pool hands over to its successor, as exported by ExportState.
+type WorkerPoolState struct {
+	WorkerPoolID string   `json:"worker_pool_id"`
+	JobNames     []string `json:"job_names"`
+}
+
+// ExportState returns the pool's identity as JSON (a WorkerPoolState), for a successor to pass to AdoptInProgress.
+func (wp *WorkerPool) ExportState() ([]byte, error) {
+	state := WorkerPoolState{WorkerPoolID: wp.workerPoolID}
+	for name := range wp.jobTypes {
+		state.JobNames = append(state.JobNames, name)
+	}
+	sort.Strings(state.JobNames)
+	return json.Marshal(state)
+}
+
+// AdoptInProgress immediately puts the jobs another pool had in progress back on their queues and unregisters that
+// pool, rather than waiting for the dead pool reaper to notice it's gone. It's meant for rolling deploys, where a new
+// pool takes over from one whose process was killed mid-job.
+//
+// It's only safe once the other pool can no longer finish any of those jobs: its process has exited, or at least its
+// workers have stopped (eg QuiesceAndWait timed out and the process is about to be killed). Otherwise jobs may run
+// twice. Note that a pool that was cleanly stopped has already requeued its own jobs.
+func (wp *WorkerPool) AdoptInProgress(poolID string) error {
+	if poolID == wp.workerPoolID {
+		return fmt.Errorf("worker pool %s can't adopt its own jobs", poolID)
+	}
+
+	conn := wp.pool.Get()
+	jobNamesList, err := redis.String(conn.Do("HGET", redisKeyHeartbeat(wp.namespace, poolID), "job_names"))
+	conn.Close()
+	if err != nil && err != redis.ErrNil {
+		return err
+	}
+
+	// The heartbeat knows which jobs the pool ran; without it, assume the same ones as this pool
+	var jobNames []string
+	if jobNamesList != "" {
+		jobNames = strings.Split(jobNamesList, ",")
+	} else {
+		for name := range wp.jobTypes {
+			jobNames = append(jobNames, name)
+		}
+	}
+
+	return newDeadPoolReaper(wp.namespace, wp.pool, jobNames).reapPool(poolID, jobNames)
+}
+
+// ErrQuiesceTimeout is returned by QuiesceAndWait when jobs are still running once its timeout is up.
+var ErrQuiesceTimeout = fmt.Errorf("timed out waiting for in-progress jobs to finish")
+
+// QuiesceAndWait stops the pool's workers from fetching any more jobs and waits for the jobs they're running to
+// finish. Once it returns nil, the pool isn't working on anything and won't pick anything up, so it's safe to Stop
+// and shut down. Unlike Drain, it doesn't care about jobs still waiting on the queues; those are left for other pools.
+// If jobs are still running after timeout, it returns ErrQuiesceTimeout; workers keep finishing their jobs and stop
+// fetching as they do. The pool stays quiesced until it's stopped and started again.
+func (wp *WorkerPool) QuiesceAndWait(timeout time.Duration) error {
+	if !wp.started {
+		return nil
+	}
+
+	done := make(chan struct{})
+	cancel := make(chan struct{})
+	go func() {
+		wg := sync.WaitGroup{}
+		for _, w := range wp.workers {
+			wg.Add(1)
+			go func(w *worker) {
+				w.quiesce(cancel)
+				wg.Done()
+			}(w)
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		// Don't leave anything waiting on the workers, which could be stopped and started again before they're done
+		close(cancel)
+		return ErrQuiesceTimeout
+	}
+
+	count, err := wp.inProgressCount()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%d jobs still in progress for worker pool %s", count, wp.workerPoolID)
+	}
+	return nil
+}
+
+// inProgressCount returns how many jobs Redis has in progress for this pool.
+func (wp *WorkerPool) inProgressCount() (int64, error) {
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	conn.Send("LLEN", redisKeyPoolInProgress(wp.namespace, wp.workerPoolID))
+	for name := range wp.jobTypes {
+		conn.Send("LLEN", redisKeyJobsInProgress(wp.namespace, wp.workerPoolID, name))
+	}
+	if err := conn.Flush(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for i := 0; i < len(wp.jobTypes)+1; i++ {
+		n, err := redis.Int64(conn.Receive())
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+	return count, nil
 }
 
 func (wp *WorkerPool) startRequeuers() {
@@ -274,12 +1082,60 @@ func (wp *WorkerPool) startRequeuers() {
 	for k := range wp.jobTypes {
 		jobNames = append(jobNames, k)
 	}
-	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames)
+	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), append(jobNames, wp.retryQueueNames()...))
+	wp.retrier.maxRequeuesPerMinute = wp.maxRequeuesPerMinute
 	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames)
+	wp.scheduler.priorities = wp.jobPriorities()
+	wp.retrier.priorities = wp.scheduler.priorities
 	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames)
-	wp.retrier.start()
-	wp.scheduler.start()
-	wp.deadPoolReaper.start()
+	wp.deadPoolReaper.deadTTLs = wp.deadTTLs()
+	if wp.reaperInterval > 0 {
+		wp.deadPoolReaper.reapPeriod = wp.reaperInterval
+	}
+	if !wp.disableRequeuers {
+		wp.retrier.start()
+		wp.scheduler.start()
+	}
+	if !wp.disableReaper {
+		wp.deadPoolReaper.start()
+	}
+}
+
+// jobPriorities returns the priority of each of the pool's jobs, so the requeuers can requeue the higher priority ones
+// of those that are due at the same time first.
+func (wp *WorkerPool) jobPriorities() map[string]uint {
+	priorities := make(map[string]uint, len(wp.jobTypes))
+	for name, jt := range wp.jobTypes {
+		priorities[name] = jt.Priority
+	}
+	return priorities
+}
+
+// deadTTLs returns the DeadTTL of each of the pool's jobs that has one.
+func (wp *WorkerPool) deadTTLs() map[string]time.Duration {
+	ttls := map[string]time.Duration{}
+	for name, jt := range wp.jobTypes {
+		if jt.DeadTTL > 0 {
+			ttls[name] = jt.DeadTTL
+		}
+	}
+	return ttls
+}
+
+// retryQueueNames returns the RetryQueues of the pool's jobs that aren't registered on the pool themselves. The pool's
+// retrier has to know about them, or it'd bury the retries as unknown jobs.
+func (wp *WorkerPool) retryQueueNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, jt := range wp.jobTypes {
+		if jt.RetryQueue == "" || seen[jt.RetryQueue] || wp.jobTypes[jt.RetryQueue] != nil {
+			continue
+		}
+		seen[jt.RetryQueue] = true
+		names = append(names, jt.RetryQueue)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (wp *WorkerPool) workerIDs() []string {
@@ -291,11 +1147,33 @@ func (wp *WorkerPool) workerIDs() []string {
 	return wids
 }
 
-func (wp *WorkerPool) writeKnownJobsToRedis() {
-	if len(wp.jobTypes) == 0 {
+// removeWorkerObservations deletes the observation hashes of this pool's workers, so that they don't show up as busy
+// once the pool has stopped. Other pools' workers are left alone.
+func (wp *WorkerPool) removeWorkerObservations() {
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	keys := make([]interface{}, 0, len(wp.workers))
+	for _, w := range wp.workers {
+		keys = append(keys, redisKeyWorkerObservation(wp.namespace, w.workerID))
+	}
+	if len(keys) == 0 {
 		return
 	}
 
+	if _, err := conn.Do("DEL", keys...); err != nil {
+		logError("remove_worker_observations", err)
+	}
+}
+
+// RegisterJobs adds the names of the pool's jobs to the namespace's known jobs, so that Client.Queues, KnownJobNames
+// and the web UI show them before any have been enqueued. Start does it too, before it returns; call RegisterJobs to
+// do it without starting the pool, or to find out whether it worked.
+func (wp *WorkerPool) RegisterJobs() error {
+	if len(wp.jobTypes) == 0 {
+		return nil
+	}
+
 	conn := wp.pool.Get()
 	defer conn.Close()
 	key := redisKeyKnownJobs(wp.namespace)
@@ -304,10 +1182,12 @@ func (wp *WorkerPool) writeKnownJobsToRedis() {
 	for k := range wp.jobTypes {
 		jobNames = append(jobNames, k)
 	}
-
-	if _, err := conn.Do("SADD", jobNames...); err != nil {
-		logError("write_known_jobs", err)
+	for _, k := range wp.retryQueueNames() {
+		jobNames = append(jobNames, k)
 	}
+
+	_, err := conn.Do("SADD", jobNames...)
+	return err
 }
 
 func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
@@ -467,6 +1347,56 @@ func isValidMiddlewareType(ctxType reflect.Type, vfn reflect.Value) bool {
 	return true
 }
 
+// mergeJobOptions fills the zero-valued fields of jobOpts from defaults.
+func mergeJobOptions(jobOpts, defaults JobOptions) JobOptions {
+	if jobOpts.Priority == 0 {
+		jobOpts.Priority = defaults.Priority
+	}
+	if jobOpts.MaxFails == 0 {
+		jobOpts.MaxFails = defaults.MaxFails
+	}
+	if !jobOpts.SkipDead {
+		jobOpts.SkipDead = defaults.SkipDead
+	}
+	if jobOpts.MaxConcurrency == 0 {
+		jobOpts.MaxConcurrency = defaults.MaxConcurrency
+	}
+	if jobOpts.Backoff == nil {
+		jobOpts.Backoff = defaults.Backoff
+	}
+	if jobOpts.Validate == nil {
+		jobOpts.Validate = defaults.Validate
+	}
+	if jobOpts.Weight == 0 {
+		jobOpts.Weight = defaults.Weight
+	}
+	if jobOpts.RetryQueue == "" {
+		jobOpts.RetryQueue = defaults.RetryQueue
+	}
+	if !jobOpts.SeparateDeadSet {
+		jobOpts.SeparateDeadSet = defaults.SeparateDeadSet
+	}
+	if jobOpts.SerializeByArg == "" {
+		jobOpts.SerializeByArg = defaults.SerializeByArg
+	}
+	if jobOpts.SlowThreshold == 0 {
+		jobOpts.SlowThreshold = defaults.SlowThreshold
+	}
+	if jobOpts.MaxInFlight == 0 {
+		jobOpts.MaxInFlight = defaults.MaxInFlight
+	}
+	if !jobOpts.StrictFIFO {
+		jobOpts.StrictFIFO = defaults.StrictFIFO
+	}
+	if jobOpts.DeadTTL == 0 {
+		jobOpts.DeadTTL = defaults.DeadTTL
+	}
+	if !jobOpts.AtMostOnce {
+		jobOpts.AtMostOnce = defaults.AtMostOnce
+	}
+	return jobOpts
+}
+
 func applyDefaultsAndValidate(jobOpts JobOptions) JobOptions {
 	if jobOpts.Priority == 0 {
 		jobOpts.Priority = 1
@@ -476,9 +1406,36 @@ func applyDefaultsAndValidate(jobOpts JobOptions) JobOptions {
 		jobOpts.MaxFails = 4
 	}
 
+	if jobOpts.Weight == 0 {
+		jobOpts.Weight = 1
+	}
+
 	if jobOpts.Priority > 100000 {
 		panic("work: JobOptions.Priority must be between 1 and 100000")
 	}
 
+	if jobOpts.Weight > 100 {
+		panic("work: JobOptions.Weight must be between 1 and 100")
+	}
+
+	if jobOpts.StrictFIFO {
+		if jobOpts.MaxConcurrency > 1 {
+			panic("work: JobOptions.StrictFIFO can't be combined with a MaxConcurrency above 1")
+		}
+		if jobOpts.RetryQueue != "" {
+			panic("work: JobOptions.StrictFIFO can't be combined with a RetryQueue")
+		}
+		jobOpts.MaxConcurrency = 1
+	}
+
+	if jobOpts.AtMostOnce && jobOpts.MaxInFlight > 0 {
+		panic("work: JobOptions.AtMostOnce can't be combined with MaxInFlight")
+	}
+
+	// Dead jobs are scored to the second, so a shorter DeadTTL would round down to deleting them straight away
+	if jobOpts.DeadTTL != 0 && jobOpts.DeadTTL < time.Second {
+		panic("work: JobOptions.DeadTTL must be at least a second")
+	}
+
 	return jobOpts
 }
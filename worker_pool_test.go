@@ -2,8 +2,14 @@ package work
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -96,6 +102,1446 @@ func TestWorkerPoolStarted(t *testing.T) {
 	assert.False(t, wp.Started())
 }
 
+func TestWorkerPoolJobIdentity(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var poolID, workerID string
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		poolID = job.WorkerPoolID()
+		workerID = job.WorkerID()
+		return nil
+	})
+
+	_, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+
+	hbs, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	wp.Stop()
+
+	if assert.Equal(t, 1, len(hbs)) {
+		assert.Equal(t, hbs[0].WorkerPoolID, poolID)
+		assert.Contains(t, hbs[0].WorkerIDs, workerID)
+	}
+}
+
+func TestWorkerPoolDefaultJobOptions(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.SetDefaultJobOptions(JobOptions{MaxFails: 1, Priority: 5})
+	wp.Job("wat", func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.JobWithOptions("bob", JobOptions{MaxFails: 3}, func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+
+	assert.EqualValues(t, 1, wp.jobTypes["wat"].MaxFails)
+	assert.EqualValues(t, 5, wp.jobTypes["wat"].Priority)
+	assert.EqualValues(t, 3, wp.jobTypes["bob"].MaxFails)
+	assert.EqualValues(t, 5, wp.jobTypes["bob"].Priority)
+	assert.EqualValues(t, 1, wp.jobTypes["bob"].Weight)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("bob", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// wat goes straight to dead per the pool default; bob keeps its own MaxFails and is retried
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "wat", job.Name)
+	_, job = jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "bob", job.Name)
+}
+
+func TestWorkerPoolDefaultBackoff(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.JobWithOptions("bob", JobOptions{Backoff: ConstantBackoff(5)}, func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.SetDefaultBackoff(ConstantBackoff(1000))
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("bob", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	jobs, _, err := NewClient(ns, pool).RetryJobs(1)
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(jobs)) {
+		// The job's own backoff wins over the pool default
+		assert.Equal(t, "bob", jobs[0].Name)
+		assert.EqualValues(t, 1425263409+5, jobs[0].RetryAt)
+		assert.Equal(t, "wat", jobs[1].Name)
+		assert.EqualValues(t, 1425263409+1000, jobs[1].RetryAt)
+	}
+}
+
+func TestWorkerPoolRetryPolicy(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	errTransient := errors.New("timeout")
+	errInvalid := errors.New("invalid")
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("transient", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("calling out: %w", errTransient)
+	})
+	wp.JobWithOptions("invalid", JobOptions{MaxFails: 10}, func(job *Job) error {
+		return errInvalid
+	})
+	wp.SetRetryPolicy(func(job *Job, err error) (bool, time.Duration) {
+		if errors.Is(err, errTransient) {
+			return job.Fails < 10, 30 * time.Second
+		}
+		return false, 0
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("transient", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("invalid", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// The transient error is retried though its MaxFails is used up, after the policy's delay
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	retryAt, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "transient", job.Name)
+	assert.EqualValues(t, 1425263409+30, retryAt)
+
+	// The invalid one is buried straight away
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job = jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "invalid", job.Name)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, DeadReasonRetryPolicy, job.DeadReason)
+}
+
+func TestWorkerPoolRetryPolicyKeepsRetryIn(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("rate_limited", func(job *Job) error {
+		job.RetryIn(5 * time.Second)
+		return errors.New("429")
+	})
+	wp.SetRetryPolicy(func(job *Job, err error) (bool, time.Duration) {
+		return true, 30 * time.Second
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("rate_limited", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// The handler's RetryIn wins over the policy's delay
+	retryAt, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "rate_limited", job.Name)
+	assert.EqualValues(t, 1425263409+5, retryAt)
+}
+
+func TestWorkerPoolQuiesceAndWait(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	<-started
+
+	assert.Equal(t, ErrQuiesceTimeout, wp.QuiesceAndWait(20*time.Millisecond))
+
+	quiesced := make(chan error)
+	go func() {
+		quiesced <- wp.QuiesceAndWait(time.Second)
+	}()
+
+	select {
+	case <-quiesced:
+		t.Fatal("QuiesceAndWait returned while a job was still running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	assert.NoError(t, <-quiesced)
+
+	// Nothing new gets picked up
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWorkerPoolQuiesceTimeoutThenRestart(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	wp.Start()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	<-started
+	assert.Equal(t, ErrQuiesceTimeout, wp.QuiesceAndWait(20*time.Millisecond))
+
+	// Stop while the job is still running, so the worker's next move is to stop
+	stopped := make(chan struct{})
+	go func() {
+		wp.Stop()
+		close(stopped)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	<-stopped
+
+	// Once started again, the pool isn't quiesced
+	wp.Start()
+	defer wp.Stop()
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the restarted pool didn't pick up the job")
+	}
+}
+
+func TestWorkerPoolRetryQueue(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{RetryQueue: "wat_retry"}, func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "wat_retry", job.Name)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.EqualValues(t, 1, job.ArgInt64("a"))
+
+	// Once it's due, the pool moves it to the retry queue even though it has no handler for it
+	setNowEpochSecondsMock(nowEpochSeconds() + 3600)
+	defer resetNowEpochSecondsMock()
+	for wp.retrier.process() {
+	}
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat_retry")))
+	assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), "wat_retry")
+
+	// And another pool picks it up from there
+	var ran bool
+	wp2 := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp2.Job("wat_retry", func(job *Job) error {
+		ran = true
+		return nil
+	})
+	wp2.Start()
+	wp2.Drain()
+	wp2.Stop()
+	assert.True(t, ran)
+}
+
+func TestWorkerPoolDrainCancelsJobContext(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	var sawCancel, plainFinished bool
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithContext("wat", JobOptions{}, func(ctx context.Context, job *Job) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		sawCancel = true
+		return nil
+	})
+	wp.Job("bob", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		plainFinished = true
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("bob", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	<-started
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	wp.Drain()
+
+	assert.True(t, sawCancel)
+	assert.True(t, plainFinished)
+
+	// Jobs started after the drain get a fresh context
+	assert.NoError(t, wp.jobContext().Err())
+	wp.Stop()
+	assert.Error(t, wp.jobContext().Err())
+}
+
+func TestWorkerPoolFollowUps(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("charge", func(job *Job) error { return nil })
+	wp.JobWithOptions("flaky", JobOptions{MaxFails: 2}, func(job *Job) error { return fmt.Errorf("ohno") })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueWithOptions("charge", Q{"order": 7}, EnqueueOptions{
+		OnSuccess: &FollowUp{Name: "ship", Args: Q{"order": 7}},
+		OnFailure: &FollowUp{Name: "refund", Args: Q{"order": 7}},
+	})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueWithOptions("flaky", nil, EnqueueOptions{
+		OnSuccess: &FollowUp{Name: "ship"},
+		OnFailure: &FollowUp{Name: "alert"},
+	})
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "ship")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "refund")))
+	shipped := jobOnQueue(pool, redisKeyJobs(ns, "ship"))
+	assert.Equal(t, "ship", shipped.Name)
+	assert.EqualValues(t, 7, shipped.ArgInt64("order"))
+	assert.Nil(t, shipped.OnSuccess)
+	assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), "ship")
+
+	// flaky will be retried, so it hasn't failed for good yet
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "alert")))
+
+	// Put the retry straight back on the queue rather than wait out its backoff, so it runs to its death
+	_, retry := jobOnZset(pool, redisKeyRetry(ns))
+	assert.EqualValues(t, 1, retry.Fails)
+	rawJSON, err := retry.serialize()
+	assert.NoError(t, err)
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("DEL", redisKeyRetry(ns))
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobs(ns, "flaky"), rawJSON)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "alert")))
+
+	_, err = enqueuer.EnqueueWithOptions("charge", nil, EnqueueOptions{OnSuccess: &FollowUp{Name: "bad name"}})
+	assert.Error(t, err)
+}
+
+func TestWorkerPoolMaxDeadJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	now := int64(1425263400)
+	setNowEpochSecondsMock(now)
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		// Each job dies a second after the last. The pool reads the clock, so it's only changed while none is running
+		setNowEpochSecondsMock(now + int64(i))
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+
+		wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+		wp.SetMaxDeadJobs(3)
+		wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error {
+			return fmt.Errorf("ohno")
+		})
+		wp.Start()
+		wp.Drain()
+		wp.Stop()
+	}
+
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+
+	jobs, count, err := NewClient(ns, pool).DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, count)
+	var kept []int64
+	for _, j := range jobs {
+		kept = append(kept, j.ArgInt64("i"))
+	}
+	assert.ElementsMatch(t, []int64{2, 3, 4}, kept)
+}
+
+func TestWorkerPoolMaxRetryJobs(t *testing.T) {
+	for _, policy := range []RetryEvictionPolicy{RetryEvictionBury, RetryEvictionDiscard} {
+		pool := newTestPool(t)
+		ns := "work"
+		cleanKeyspace(ns, pool)
+
+		now := int64(1425263400)
+		setNowEpochSecondsMock(now)
+
+		wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+		wp.SetMaxRetryJobs(3)
+		wp.SetRetryEvictionPolicy(policy)
+		// Later jobs are retried later, so they're the ones evicted
+		backoff := func(job *Job) int64 { return 100 + job.ArgInt64("i") }
+		wp.JobWithOptions("wat", JobOptions{MaxFails: 5, Backoff: backoff}, func(job *Job) error {
+			return fmt.Errorf("ohno")
+		})
+		wp.Start()
+
+		enqueuer := NewEnqueuer(ns, pool)
+		for i := 0; i < 5; i++ {
+			_, err := enqueuer.Enqueue("wat", Q{"i": i})
+			assert.NoError(t, err)
+			wp.Drain()
+		}
+		wp.Stop()
+		resetNowEpochSecondsMock()
+
+		client := NewClient(ns, pool)
+		retries, count, err := client.RetryJobs(1)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, count)
+		var kept []int64
+		for _, j := range retries {
+			kept = append(kept, j.ArgInt64("i"))
+		}
+		assert.ElementsMatch(t, []int64{0, 1, 2}, kept)
+
+		dead, count, err := client.DeadJobs(1)
+		assert.NoError(t, err)
+		if policy == RetryEvictionDiscard {
+			assert.EqualValues(t, 0, count)
+			continue
+		}
+		assert.EqualValues(t, 2, count)
+		var evicted []int64
+		for _, j := range dead {
+			evicted = append(evicted, j.ArgInt64("i"))
+			assert.Equal(t, DeadReasonEvicted, j.DeadReason)
+			assert.Equal(t, "ohno", j.LastErr)
+		}
+		assert.ElementsMatch(t, []int64{3, 4}, evicted)
+	}
+}
+
+func TestWorkerPoolLazyArgs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var tenantID string
+	var argsBefore, argsAfter map[string]interface{}
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{LazyArgs: true})
+	wp.Middleware(func(job *Job, next NextMiddlewareFunc) error {
+		tenantID = job.ArgString("tenant_id")
+		argsBefore = job.Args
+		return next()
+	})
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 3}, func(job *Job) error {
+		assert.NoError(t, job.LoadArgs())
+		argsAfter = job.Args
+		return fmt.Errorf("ohno")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", Q{"tenant_id": "t1", "blob": "x"})
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, "t1", tenantID)
+	assert.Nil(t, argsBefore)
+	assert.Equal(t, map[string]interface{}{"tenant_id": "t1", "blob": "x"}, argsAfter)
+
+	// The retried job keeps its args
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "t1", job.ArgString("tenant_id"))
+	assert.Equal(t, "x", job.ArgString("blob"))
+}
+
+func TestWorkerPoolOnQueueActive(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	active := make(chan string, 10)
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.OnQueueActive(func(jobName string) { active <- jobName })
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	select {
+	case jobName := <-active:
+		assert.Equal(t, "wat", jobName)
+	case <-time.After(time.Second):
+		t.Fatal("OnQueueActive wasn't called")
+	}
+
+	// The queue is empty again, but it's too soon after the last call for another
+	wp.Drain()
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp.Drain()
+	select {
+	case <-active:
+		t.Fatal("OnQueueActive wasn't debounced")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWorkerPoolReadyCheck(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var ready bool
+	var processedAt time.Time
+	readyAt := time.Now().Add(300 * time.Millisecond)
+
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.SetReadyCheck(func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		ready = ready || time.Now().After(readyAt)
+		return ready
+	})
+	wp.Job("wat", func(job *Job) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		processedAt = time.Now()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	defer wp.Stop()
+
+	// The pool heartbeats while it waits, but doesn't touch the job
+	time.Sleep(150 * time.Millisecond)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	heartbeats, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Len(t, heartbeats, 1)
+
+	wp.Drain()
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.False(t, processedAt.Before(readyAt))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWorkerPoolStrictFIFO(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var order []int64
+	var running, maxRunning int
+	failed := false
+	wp := NewWorkerPool(TestContext{}, 5, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{StrictFIFO: true, Backoff: func(job *Job) int64 { return 1 }}, func(job *Job) error {
+		n := job.ArgInt64("n")
+		mtx.Lock()
+		order = append(order, n)
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		fail := n == 3 && !failed
+		failed = failed || fail
+		mtx.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+		if fail {
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 10; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"n": i})
+		assert.NoError(t, err)
+	}
+
+	waitForJobs := func(n int) {
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			mtx.Lock()
+			done := len(order) >= n
+			mtx.Unlock()
+			if done || time.Now().After(deadline) {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	wp.Start()
+	defer wp.Stop()
+
+	// Job 3 fails and goes back to the front of the queue, which is paused for its backoff
+	waitForJobs(4)
+	time.Sleep(50 * time.Millisecond)
+	mtx.Lock()
+	assert.Len(t, order, 4)
+	mtx.Unlock()
+	conn := pool.Get()
+	defer conn.Close()
+	ttl, err := redis.Int64(conn.Do("TTL", redisKeyJobsPaused(ns, "wat")))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, ttl)
+	assert.EqualValues(t, 7, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// The test Redis doesn't expire keys on its own
+	_, err = conn.Do("DEL", redisKeyJobsPaused(ns, "wat"))
+	assert.NoError(t, err)
+	waitForJobs(11)
+
+	// Job 3 was retried before anything behind it ran
+	assert.Equal(t, []int64{0, 1, 2, 3, 3, 4, 5, 6, 7, 8, 9}, order)
+	assert.Equal(t, 1, maxRunning)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	assert.Panics(t, func() {
+		NewWorkerPool(TestContext{}, 1, ns, pool).JobWithOptions("foo", JobOptions{StrictFIFO: true, MaxConcurrency: 2}, func(job *Job) error { return nil })
+	})
+}
+
+func TestWorkerPoolContextPropagation(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	type baggageKey struct{}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetContextInjector(func(ctx context.Context, args map[string]interface{}) {
+		if baggage, ok := ctx.Value(baggageKey{}).(string); ok {
+			args["_baggage"] = baggage
+		}
+	})
+
+	args := Q{"a": 1}
+	ctx := context.WithValue(context.Background(), baggageKey{}, "user=7")
+	_, err := enqueuer.EnqueueContext(ctx, "wat", args)
+	assert.NoError(t, err)
+	assert.Equal(t, Q{"a": 1}, args)
+	_, err = enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+
+	var mtx sync.Mutex
+	var seen []interface{}
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.SetArgsContextExtractor(func(args map[string]interface{}) context.Context {
+		if args["_baggage"] == nil {
+			return nil
+		}
+		return context.WithValue(context.Background(), baggageKey{}, args["_baggage"])
+	})
+	wp.JobWithContext("wat", JobOptions{}, func(ctx context.Context, job *Job) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		seen = append(seen, ctx.Value(baggageKey{}))
+		assert.EqualValues(t, 1, job.ArgInt64("a"))
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []interface{}{"user=7", nil}, seen)
+}
+
+func TestWorkerPoolAdoptInProgress(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	// The old pool claims two jobs and is killed before finishing them, while its heartbeat is still fresh
+	oldPool := NewWorkerPool(TestContext{}, 1, ns, pool)
+	oldPool.Job("wat", func(job *Job) error { return nil })
+	oldPool.heartbeater = newWorkerPoolHeartbeater(ns, pool, oldPool.workerPoolID, oldPool.jobTypes, 1, oldPool.workerIDs())
+	oldPool.heartbeater.heartbeat()
+	for i := 0; i < 2; i++ {
+		job, err := oldPool.workers[0].fetchJob()
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+	}
+	rawState, err := oldPool.ExportState()
+	assert.NoError(t, err)
+
+	var state WorkerPoolState
+	assert.NoError(t, json.Unmarshal(rawState, &state))
+	assert.Equal(t, oldPool.workerPoolID, state.WorkerPoolID)
+	assert.Equal(t, []string{"wat"}, state.JobNames)
+
+	var mtx sync.Mutex
+	runs := make(map[int64]int)
+	newPool := NewWorkerPool(TestContext{}, 3, ns, pool)
+	newPool.Job("wat", func(job *Job) error {
+		mtx.Lock()
+		runs[job.ArgInt64("i")]++
+		mtx.Unlock()
+		return nil
+	})
+	assert.Error(t, newPool.AdoptInProgress(newPool.workerPoolID))
+	assert.NoError(t, newPool.AdoptInProgress(state.WorkerPoolID))
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, state.WorkerPoolID, "wat")))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+
+	newPool.Start()
+	newPool.Drain()
+	newPool.Stop()
+
+	assert.Equal(t, map[int64]int{0: 1, 1: 1, 2: 1}, runs)
+
+	// The old pool is gone, so the reaper has nothing left to requeue
+	hbs, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(hbs))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWorkerPoolPriorityBands(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions("high", JobOptions{Priority: 50}, func(job *Job) error { return nil })
+	wp.JobWithOptions("mid", JobOptions{Priority: 5}, func(job *Job) error { return nil })
+	wp.JobWithOptions("low", JobOptions{Priority: 1}, func(job *Job) error { return nil })
+	wp.SetPriorityBands([]int{2, 10})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, name := range []string{"low", "mid", "high"} {
+		for i := 0; i < 5; i++ {
+			_, err := enqueuer.Enqueue(name, nil)
+			assert.NoError(t, err)
+		}
+	}
+
+	var order []string
+	for {
+		job, err := wp.workers[0].fetchJob()
+		assert.NoError(t, err)
+		if job == nil {
+			break
+		}
+		order = append(order, job.Name)
+	}
+
+	expected := []string{}
+	for _, name := range []string{"high", "mid", "low"} {
+		for i := 0; i < 5; i++ {
+			expected = append(expected, name)
+		}
+	}
+	assert.Equal(t, expected, order)
+}
+
+func TestWorkerPoolPriorityAging(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+
+	fetchOrder := func(aging float64) []string {
+		cleanKeyspace(ns, pool)
+		defer resetNowEpochSecondsMock()
+
+		wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+		wp.JobWithOptions("high", JobOptions{Priority: 10}, func(job *Job) error { return nil })
+		wp.JobWithOptions("low", JobOptions{Priority: 1}, func(job *Job) error { return nil })
+		wp.SetPriorityBands([]int{50, 10})
+		wp.SetPriorityAging(aging)
+
+		// The low priority job has been waiting a minute behind a flood of high priority ones
+		now := nowEpochSeconds()
+		enqueuer := NewEnqueuer(ns, pool)
+		setNowEpochSecondsMock(now - 60)
+		_, err := enqueuer.Enqueue("low", nil)
+		assert.NoError(t, err)
+		setNowEpochSecondsMock(now)
+		for i := 0; i < 20; i++ {
+			_, err := enqueuer.Enqueue("high", nil)
+			assert.NoError(t, err)
+		}
+
+		var order []string
+		for {
+			job, err := wp.workers[0].fetchJob()
+			assert.NoError(t, err)
+			if job == nil {
+				break
+			}
+			order = append(order, job.Name)
+		}
+		return order
+	}
+
+	order := fetchOrder(0)
+	assert.Len(t, order, 21)
+	assert.Equal(t, "low", order[len(order)-1])
+
+	// 1 + 60 seconds * 1 reaches the top band, ahead of the high priority jobs
+	order = fetchOrder(1)
+	assert.Len(t, order, 21)
+	assert.Equal(t, "low", order[0])
+
+	func() {
+		defer func() {
+			assert.NotNil(t, recover())
+		}()
+		NewWorkerPool(TestContext{}, 1, ns, pool).SetPriorityAging(-1)
+	}()
+}
+
+func TestWorkerPoolMaxConcurrentExecution(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var running, maxRunning int
+	wp := NewWorkerPool(TestContext{}, 10, ns, pool)
+	wp.SetMaxConcurrentExecution(3)
+	wp.Job("wat", func(job *Job) error {
+		mtx.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mtx.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 30; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.LessOrEqual(t, maxRunning, 3)
+}
+
+// fetchCountingConn tracks how many fetches are running on all its conns at once, slowing each down so they overlap.
+type fetchCountingConn struct {
+	redis.Conn
+	hash string
+
+	mtx                *sync.Mutex
+	fetching, maxFetch *int
+}
+
+func (c fetchCountingConn) Do(commandName string, args ...interface{}) (interface{}, error) {
+	isFetch := (commandName == "EVALSHA" && args[0] == c.hash) || (commandName == "EVAL" && args[0] == redisLuaFetchJob)
+	if !isFetch {
+		return c.Conn.Do(commandName, args...)
+	}
+
+	c.mtx.Lock()
+	*c.fetching++
+	if *c.fetching > *c.maxFetch {
+		*c.maxFetch = *c.fetching
+	}
+	c.mtx.Unlock()
+
+	time.Sleep(2 * time.Millisecond)
+	reply, err := c.Conn.Do(commandName, args...)
+
+	c.mtx.Lock()
+	*c.fetching--
+	c.mtx.Unlock()
+	return reply, err
+}
+
+func TestWorkerPoolFetchConcurrency(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var fetching, maxFetch int
+	hash := redis.NewScript(0, redisLuaFetchJob).Hash()
+	dial := pool.Dial
+	pool.Dial = func() (redis.Conn, error) {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		return fetchCountingConn{Conn: conn, hash: hash, mtx: &mtx, fetching: &fetching, maxFetch: &maxFetch}, nil
+	}
+
+	wp := NewWorkerPool(TestContext{}, 8, ns, pool)
+	wp.SetFetchConcurrency(2)
+	wp.Job("wat", func(job *Job) error { return nil })
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 40; i++ {
+		_, err := enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.Greater(t, maxFetch, 0)
+	assert.LessOrEqual(t, maxFetch, 2)
+}
+
+func TestWorkerPoolSerializeByArg(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	running := map[string]int{}
+	maxRunning := map[string]int{}
+	var total, maxTotal int
+	wp := NewWorkerPool(TestContext{}, 4, ns, pool)
+	wp.JobWithOptions("charge", JobOptions{SerializeByArg: "account"}, func(job *Job) error {
+		account := job.ArgString("account")
+		mtx.Lock()
+		running[account]++
+		total++
+		if running[account] > maxRunning[account] {
+			maxRunning[account] = running[account]
+		}
+		if total > maxTotal {
+			maxTotal = total
+		}
+		mtx.Unlock()
+
+		time.Sleep(30 * time.Millisecond)
+
+		mtx.Lock()
+		running[account]--
+		total--
+		mtx.Unlock()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, account := range []string{"a", "a", "b", "b"} {
+		_, err := enqueuer.Enqueue("charge", Q{"account": account})
+		assert.NoError(t, err)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "charge")))
+	assert.Equal(t, 1, maxRunning["a"])
+	assert.Equal(t, 1, maxRunning["b"])
+	assert.Equal(t, 2, maxTotal)
+
+	// Each lock is released once its job is done
+	conn := pool.Get()
+	defer conn.Close()
+	n, err := redis.Int(conn.Do("EXISTS", redisKeyJobsSerialLock(ns, "charge", "a"), redisKeyJobsSerialLock(ns, "charge", "b")))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestWorkerPoolSerializeByArgPutsBackWaitingJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	release := make(chan struct{})
+	ranB := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("charge", JobOptions{MaxFails: 1, SerializeByArg: "account"}, func(job *Job) error {
+		if job.ArgString("account") == "b" {
+			close(ranB)
+			return nil
+		}
+		<-release
+		return nil
+	})
+
+	// The jobs for "a" would tie up both workers if the second waited its turn in progress
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, account := range []string{"a", "a", "a", "b"} {
+		_, err := enqueuer.Enqueue("charge", Q{"account": account})
+		assert.NoError(t, err)
+	}
+	wp.Start()
+
+	select {
+	case <-ranB:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a job for another account was held up")
+	}
+	close(release)
+	wp.Drain()
+	wp.Stop()
+
+	// The jobs that were put back ran without failing
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "charge")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "charge")))
+}
+
+// lockedBuffer is a bytes.Buffer that's safe to log to from the workers while a test reads it.
+type lockedBuffer struct {
+	mtx sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.buf.String()
+}
+
+func TestWorkerPoolSlowThreshold(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var logged lockedBuffer
+	logOutput = &logged
+	defer func() { logOutput = os.Stdout }()
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.SetDefaultJobOptions(JobOptions{SlowThreshold: 10 * time.Millisecond})
+	wp.Job("slow", func(job *Job) error {
+		time.Sleep(30 * time.Millisecond)
+		return nil
+	})
+	wp.JobWithOptions("allowed_slow", JobOptions{SlowThreshold: time.Hour}, func(job *Job) error {
+		time.Sleep(30 * time.Millisecond)
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	slow, err := enqueuer.Enqueue("slow", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("allowed_slow", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Contains(t, logged.String(), "WARNING: worker.slow_job - job_name=slow job_id="+slow.ID+" duration=")
+	assert.NotContains(t, logged.String(), "job_name=allowed_slow")
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+}
+
+func TestWorkerPoolStopAndRequeue(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	var ctxCancelled bool
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithContext("slow", JobOptions{}, func(ctx context.Context, job *Job) error {
+		close(started)
+		<-ctx.Done()
+		ctxCancelled = true
+		<-release // even once cancelled, it takes its time
+		close(done)
+		return fmt.Errorf("interrupted")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueued, err := enqueuer.Enqueue("slow", Q{"a": 1})
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	wp.StopAndRequeue()
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "slow")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "slow")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "slow")))
+
+	// The handler's error is ignored: the job isn't retried, nor its lock released twice
+	close(release)
+	<-done
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, ctxCancelled)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "slow")))
+
+	job := jobOnQueue(pool, redisKeyJobs(ns, "slow"))
+	assert.Equal(t, enqueued.ID, job.ID)
+	assert.EqualValues(t, 0, job.Fails)
+}
+
+func TestWorkerPoolGeneration(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var processed []string
+	wp := NewWorkerPoolWithOptions(TestContext{}, 3, ns, pool, WorkerPoolOptions{Generation: "green"})
+	wp.Job("wat", func(job *Job) error {
+		mtx.Lock()
+		processed = append(processed, job.ArgString("color"))
+		mtx.Unlock()
+		assert.Equal(t, "green", job.Generation)
+		return nil
+	})
+
+	blue := NewEnqueuerWithOptions(ns, pool, EnqueuerOption{Generation: "blue"})
+	green := NewEnqueuerWithOptions(ns, pool, EnqueuerOption{Generation: "green"})
+	for i := 0; i < 2; i++ {
+		_, err := blue.Enqueue("wat", Q{"color": "blue"})
+		assert.NoError(t, err)
+		_, err = green.Enqueue("wat", Q{"color": "green"})
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsGeneration(ns, "wat", "blue")))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsGeneration(ns, "wat", "green")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Scheduled jobs are moved onto their generation's queue when they're due
+	_, err := green.EnqueueIn("wat", 0, Q{"color": "green"})
+	assert.NoError(t, err)
+
+	wp.Start()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mtx.Lock()
+		done := len(processed) >= 3
+		mtx.Unlock()
+		if done || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	wp.Stop()
+
+	assert.Equal(t, []string{"green", "green", "green"}, processed)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsGeneration(ns, "wat", "blue")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsGeneration(ns, "wat", "green")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+
+	assert.Panics(t, func() {
+		NewEnqueuerWithOptions(ns, pool, EnqueuerOption{Generation: "blue:green"})
+	})
+}
+
+func TestWorkerPoolDuplicateID(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	newPool := func(policy DuplicateIDPolicy) *WorkerPool {
+		wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{WorkerPoolID: "pool-1", DuplicateIDPolicy: policy})
+		wp.Job("wat", func(job *Job) error { return nil })
+		return wp
+	}
+
+	wp1 := newPool(DuplicateIDError)
+	assert.NoError(t, wp1.StartE())
+	assert.Equal(t, "pool-1", wp1.workerPoolID)
+
+	wp2 := newPool(DuplicateIDError)
+	assert.ErrorIs(t, wp2.StartE(), ErrWorkerPoolIDInUse)
+	assert.False(t, wp2.Started())
+	wp2.Start()
+	assert.False(t, wp2.Started())
+
+	wp3 := newPool(DuplicateIDSuffix)
+	wp3.Start()
+	assert.True(t, strings.HasPrefix(wp3.workerPoolID, "pool-1-"))
+	assert.Equal(t, wp3.workerPoolID, wp3.workers[0].poolID)
+
+	// The renamed pool works jobs under its own ID
+	_, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	wp3.Drain()
+	wp3.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	heartbeats, err := NewClient(ns, pool).WorkerPoolHeartbeats()
+	assert.NoError(t, err)
+	if assert.Len(t, heartbeats, 1) {
+		assert.Equal(t, "pool-1", heartbeats[0].WorkerPoolID)
+	}
+
+	// Restarted, it's suffixed afresh rather than suffixed again
+	suffixed := wp3.workerPoolID
+	assert.NoError(t, wp3.StartE())
+	assert.True(t, strings.HasPrefix(wp3.workerPoolID, "pool-1-"))
+	assert.Len(t, wp3.workerPoolID, len(suffixed))
+	wp3.Stop()
+
+	// Once the first pool's stopped, its ID is free again
+	wp1.Stop()
+	wp4 := newPool(DuplicateIDError)
+	assert.NoError(t, wp4.StartE())
+	assert.Equal(t, "pool-1", wp4.workerPoolID)
+	wp4.Stop()
+
+	// And a suffixed pool restarted once it's free gets it back
+	assert.NoError(t, wp3.StartE())
+	assert.Equal(t, "pool-1", wp3.workerPoolID)
+	assert.Equal(t, "pool-1", wp3.workers[0].poolID)
+	wp3.Stop()
+}
+
+func TestWorkerPoolAtMostOnce(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	runs := map[string]int{}
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	handler := func(job *Job) error {
+		mtx.Lock()
+		runs[job.Name]++
+		mtx.Unlock()
+		started <- struct{}{}
+		<-release
+		return nil
+	}
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("charge", JobOptions{AtMostOnce: true}, handler)
+	wp.Job("plain", handler)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("charge", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("plain", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	<-started
+
+	// The pool crashes mid-handler, as far as the rest of the namespace can tell, and is reaped
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "charge")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "plain")))
+	jobNames := []string{"charge", "plain"}
+	assert.NoError(t, newDeadPoolReaper(ns, pool, jobNames).reapPool(wp.workerPoolID, jobNames))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "charge")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "plain")))
+
+	close(release)
+	wp.Drain()
+
+	// The reaper released the AtMostOnce job's lock, so finishing it didn't release it again
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "charge")))
+	assert.False(t, hexists(pool, redisKeyJobsLockInfo(ns, "charge"), wp.workerPoolID))
+	wp.Stop()
+
+	// The plain job was recovered and run again, but the AtMostOnce one wasn't
+	assert.Equal(t, map[string]int{"charge": 1, "plain": 2}, runs)
+
+	assert.Panics(t, func() {
+		wp.JobWithOptions("both", JobOptions{AtMostOnce: true, MaxInFlight: time.Minute}, handler)
+	})
+}
+
+func TestWorkerPoolStopHaltsPeriodicEnqueuer(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		close(started)
+		<-release
+		return nil
+	})
+	wp.Job("periodic", func(job *Job) error { return nil })
+	wp.PeriodicallyEnqueue("0 * * * * *", "periodic")
+	wp.Start()
+
+	waitForPeriodicJobs := func() bool {
+		for i := 0; i < 100; i++ {
+			if zsetSize(pool, redisKeyScheduled(ns)) > 0 {
+				return true
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return false
+	}
+	assert.True(t, waitForPeriodicJobs())
+
+	// Draining pauses the periodic enqueuer, which picks up again afterwards
+	cleanKeyspace(ns, pool)
+	wp.Drain()
+	assert.True(t, waitForPeriodicJobs())
+
+	// Stop halts it straight away, even while a job is still running
+	_, err := NewEnqueuer(ns, pool).Enqueue("wat", nil)
+	assert.NoError(t, err)
+	<-started
+	stopped := make(chan struct{})
+	go func() {
+		wp.Stop()
+		close(stopped)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cleanKeyspace(ns, pool)
+	close(release)
+	<-stopped
+
+	time.Sleep(20 * time.Millisecond)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
 func TestWorkerPoolValidations(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -124,6 +1570,18 @@ func TestWorkerPoolValidations(t *testing.T) {
 
 		wp.Job("wat", TestWorkerPoolValidations)
 	}()
+
+	func() {
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				assert.Regexp(t, "job name .* doesn't match", fmt.Sprintf("%v", panicErr))
+			} else {
+				t.Errorf("expected a panic when using a bad job name")
+			}
+		}()
+
+		wp.Job("wat wat", func(job *Job) error { return nil })
+	}()
 }
 
 func TestWorkersPoolRunSingleThreaded(t *testing.T) {
@@ -2,6 +2,8 @@ package work
 
 import (
 	"fmt"
+	"io"
+	"net"
 	"strconv"
 	"sync/atomic"
 	"testing"
@@ -300,9 +302,384 @@ func TestWorkerDead(t *testing.T) {
 	assert.Equal(t, job1, job.Name) // basics are preserved
 	assert.EqualValues(t, 1, job.Fails)
 	assert.Equal(t, "sorry kid1", job.LastErr)
+	assert.Equal(t, DeadReasonMaxFails, job.DeadReason)
 	assert.True(t, (nowEpochSeconds()-job.FailedAt) <= 2)
 }
 
+func TestWorkerDisableRetry(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			job.DisableRetry()
+			return fmt.Errorf("half done")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, job1, job.Name)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, "half done", job.LastErr)
+	assert.Equal(t, DeadReasonFatal, job.DeadReason)
+}
+
+func TestWorkerRetryIn(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			job.RetryIn(30 * time.Second)
+			return fmt.Errorf("rate limited")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	retryAt, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.EqualValues(t, 1425263409+30, retryAt)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, "rate limited", job.LastErr)
+}
+
+func TestWorkerSeparateDeadSet(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	job2 := "job2"
+	cleanKeyspace(ns, pool)
+
+	failing := func(job *Job) error { return fmt.Errorf("ohno") }
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:           job1,
+		JobOptions:     JobOptions{Priority: 1, MaxFails: 1, SeparateDeadSet: true},
+		IsGeneric:      true,
+		GenericHandler: failing,
+	}
+	jobTypes[job2] = &jobType{
+		Name:           job2,
+		JobOptions:     JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:      true,
+		GenericHandler: failing,
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"a": 2})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job2, Q{"a": 3})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyJobsDead(ns, job1)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, job2, job.Name)
+
+	client := NewClient(ns, pool)
+	deadJobs, count, err := client.DeadJobsForJob(job1, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, deadJobs, 2) {
+		assert.Equal(t, job1, deadJobs[0].Name)
+		assert.Equal(t, "ohno", deadJobs[0].LastErr)
+	}
+}
+
+func TestWorkerDeadline(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var handled int
+	var lateBy time.Duration
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			handled++
+			if lateBy > 0 {
+				// Runs past its deadline, then fails
+				setNowEpochSecondsMock(job.Deadline + int64(lateBy/time.Second))
+				return fmt.Errorf("ohno")
+			}
+			return nil
+		},
+	}
+	defer resetNowEpochSecondsMock()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueWithOptions(job1, nil, EnqueueOptions{Deadline: time.Now().Add(-time.Minute)})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueWithOptions(job1, nil, EnqueueOptions{Deadline: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	// The job that missed its deadline was buried without running; the other ran
+	assert.Equal(t, 1, handled)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "deadline exceeded", job.LastErr)
+	assert.Equal(t, DeadReasonDeadline, job.DeadReason)
+	assert.EqualValues(t, 1, job.Fails)
+
+	// A job that fails after its deadline is buried rather than retried
+	cleanKeyspace(ns, pool)
+	handled = 0
+	lateBy = time.Minute
+	_, err = enqueuer.EnqueueWithOptions(job1, nil, EnqueueOptions{Deadline: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+
+	w = newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, 1, handled)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job = jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "deadline exceeded: ohno", job.LastErr)
+	assert.Equal(t, DeadReasonDeadline, job.DeadReason)
+}
+
+func TestWorkerValidateArgs(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	var handlerRan bool
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name: job1,
+		JobOptions: JobOptions{
+			Priority: 1,
+			MaxFails: 3,
+			Validate: func(args map[string]interface{}) error {
+				if _, ok := args["user_id"]; !ok {
+					return fmt.Errorf("missing user_id")
+				}
+				return nil
+			},
+		},
+		IsGeneric: true,
+		GenericHandler: func(job *Job) error {
+			handlerRan = true
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.False(t, handlerRan)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, job1, job.Name)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, "missing user_id", job.LastErr)
+	assert.Equal(t, DeadReasonInvalidArgs, job.DeadReason)
+}
+
+func TestWorkerCloneJob(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var cloneErr error
+	var parentArgs, childArgs map[string]interface{}
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			if job.ArgInt64("page") == 1 {
+				parentArgs = job.Args
+				_, cloneErr = job.Clone().SetArg("page", 2).Enqueue()
+			} else {
+				childArgs = job.Args
+			}
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"page": 1, "user_id": "u1"})
+	assert.NoError(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.NoError(t, cloneErr)
+	assert.EqualValues(t, 1, parentArgs["page"])
+	assert.EqualValues(t, 2, childArgs["page"])
+	assert.Equal(t, "u1", childArgs["user_id"])
+
+	// Outside a handler there's nowhere to enqueue to, but it can still be a follow up
+	job := &Job{Name: job1, Args: Q{"page": 1, "user_id": "u1"}}
+	_, err = job.Clone().Enqueue()
+	assert.Error(t, err)
+	follow := job.Clone().Name("job2").DeleteArg("page").FollowUp()
+	assert.Equal(t, &FollowUp{Name: "job2", Args: map[string]interface{}{"user_id": "u1"}}, follow)
+	assert.EqualValues(t, 1, job.Args["page"])
+}
+
+func TestWorkerDecodeErrorPolicy(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	job1 := "job1"
+
+	for _, policy := range []DecodeErrorPolicy{DecodeErrorBury, DecodeErrorDiscard} {
+		deleteQueue(pool, ns, job1)
+		deleteRetryAndDead(pool, ns)
+		deletePausedAndLockedKeys(ns, job1, pool)
+
+		var handlerRuns int
+		jobTypes := make(map[string]*jobType)
+		jobTypes[job1] = &jobType{
+			Name:       job1,
+			JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				handlerRuns++
+				return nil
+			},
+		}
+
+		conn := pool.Get()
+		_, err := conn.Do("LPUSH", redisKeyJobs(ns, job1), `{"name":"job1","id":"abc","args":{"a":`)
+		conn.Close()
+		assert.NoError(t, err)
+
+		w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+		w.decodeErrorPolicy = policy
+		w.start()
+		w.drain()
+		w.stop()
+
+		assert.Equal(t, 0, handlerRuns)
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+		assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+		assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+		if policy == DecodeErrorDiscard {
+			assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+			continue
+		}
+		if assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns))) {
+			_, job := jobOnZset(pool, redisKeyDead(ns))
+			assert.Equal(t, job1, job.Name)
+			assert.EqualValues(t, 1, job.Fails)
+			assert.Contains(t, job.LastErr, "decoding job")
+			assert.Equal(t, `{"name":"job1","id":"abc","args":{"a":`, job.Args["raw_json"])
+		}
+	}
+}
+
+func TestWorkerWeightedFetch(t *testing.T) {
+	pool := newTestPool(t)
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := make(map[string]*jobType)
+	for name, weight := range map[string]uint{"heavy": 4, "light": 1} {
+		jobTypes[name] = &jobType{
+			Name:           name,
+			JobOptions:     JobOptions{Priority: 1, MaxFails: 1, Weight: weight},
+			IsGeneric:      true,
+			GenericHandler: func(job *Job) error { return nil },
+		}
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 500; i++ {
+		_, err := enqueuer.Enqueue("heavy", nil)
+		assert.NoError(t, err)
+		_, err = enqueuer.Enqueue("light", nil)
+		assert.NoError(t, err)
+	}
+
+	// Both queues stay non-empty throughout, so which job comes back is decided by the sampler alone.
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	counts := map[string]int{}
+	for i := 0; i < 300; i++ {
+		job, err := w.fetchJob()
+		assert.NoError(t, err)
+		if assert.NotNil(t, job) {
+			counts[job.Name]++
+		}
+	}
+
+	ratio := float64(counts["heavy"]) / float64(counts["light"])
+	assert.True(t, ratio > 2.5 && ratio < 6.5, fmt.Sprintf("heavy=%d light=%d", counts["heavy"], counts["light"]))
+}
+
 func TestWorkersPaused(t *testing.T) {
 	pool := newTestPool(t)
 	ns := "work"
@@ -488,6 +865,33 @@ func newTestPool(t testing.TB) *redis.Pool {
 	}
 }
 
+// newUnresponsiveTestPool returns a pool connected to a server that accepts commands but never replies to them.
+func newUnresponsiveTestPool(t testing.TB) *redis.Pool {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { c.Close() })
+			go io.Copy(io.Discard, c)
+		}
+	}()
+
+	return &redis.Pool{
+		MaxActive: 10,
+		MaxIdle:   10,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", l.Addr().String())
+		},
+	}
+}
+
 func newMockTestPool(t testing.TB) (*redis.Pool, *redigomock.Conn) {
 	t.Helper()
 